@@ -13,6 +13,21 @@ namespace BrandishBot.Client
     /// </summary>
     public class ApiErrorResponse
     {
+        /// <summary>
+        /// Stable error code such as ITEM_NOT_FOUND; branch on this, not the message
+        /// </summary>
+        [JsonProperty("code")]
+        public string Code { get; set; }
+
+        [JsonProperty("message")]
+        public string Message { get; set; }
+
+        [JsonProperty("request_id")]
+        public string RequestId { get; set; }
+
+        /// <summary>
+        /// Same as Message; kept for older scripts
+        /// </summary>
         [JsonProperty("error")]
         public string Error { get; set; }
 
@@ -12,37 +12,82 @@ import (
 	"time"
 
 	_ "github.com/osse101/BrandishBot_Go/docs/swagger"
+	"github.com/osse101/BrandishBot_Go/internal/announce"
+	"github.com/osse101/BrandishBot_Go/internal/apitoken"
+	"github.com/osse101/BrandishBot_Go/internal/balance"
+	"github.com/osse101/BrandishBot_Go/internal/bank"
 	"github.com/osse101/BrandishBot_Go/internal/bootstrap"
+	"github.com/osse101/BrandishBot_Go/internal/boss"
+	"github.com/osse101/BrandishBot_Go/internal/brigade"
+	"github.com/osse101/BrandishBot_Go/internal/celebration"
+	"github.com/osse101/BrandishBot_Go/internal/chatdrop"
+	"github.com/osse101/BrandishBot_Go/internal/community"
+	"github.com/osse101/BrandishBot_Go/internal/communitypool"
 	"github.com/osse101/BrandishBot_Go/internal/compost"
 	"github.com/osse101/BrandishBot_Go/internal/config"
+	"github.com/osse101/BrandishBot_Go/internal/configcheck"
+	"github.com/osse101/BrandishBot_Go/internal/configreload"
 	"github.com/osse101/BrandishBot_Go/internal/cooldown"
 	"github.com/osse101/BrandishBot_Go/internal/crafting"
 	"github.com/osse101/BrandishBot_Go/internal/database"
+	"github.com/osse101/BrandishBot_Go/internal/database/postgres"
+	"github.com/osse101/BrandishBot_Go/internal/digging"
 	"github.com/osse101/BrandishBot_Go/internal/domain"
 	"github.com/osse101/BrandishBot_Go/internal/economy"
+	"github.com/osse101/BrandishBot_Go/internal/effects"
 	"github.com/osse101/BrandishBot_Go/internal/eventlog"
 	"github.com/osse101/BrandishBot_Go/internal/expedition"
+	"github.com/osse101/BrandishBot_Go/internal/featureflag"
+	"github.com/osse101/BrandishBot_Go/internal/fishing"
 	"github.com/osse101/BrandishBot_Go/internal/gamble"
+	"github.com/osse101/BrandishBot_Go/internal/gameevent"
+	"github.com/osse101/BrandishBot_Go/internal/gamestate"
+	"github.com/osse101/BrandishBot_Go/internal/garden"
+	"github.com/osse101/BrandishBot_Go/internal/gift"
+	"github.com/osse101/BrandishBot_Go/internal/giveguard"
+	"github.com/osse101/BrandishBot_Go/internal/grpcserver"
 	"github.com/osse101/BrandishBot_Go/internal/harvest"
+	"github.com/osse101/BrandishBot_Go/internal/inbox"
+	"github.com/osse101/BrandishBot_Go/internal/inventorysync"
+	"github.com/osse101/BrandishBot_Go/internal/itemalias"
+	"github.com/osse101/BrandishBot_Go/internal/itemflags"
+	"github.com/osse101/BrandishBot_Go/internal/itemhandler"
+	"github.com/osse101/BrandishBot_Go/internal/jackpot"
 	"github.com/osse101/BrandishBot_Go/internal/job"
 	"github.com/osse101/BrandishBot_Go/internal/linking"
+	"github.com/osse101/BrandishBot_Go/internal/loan"
 	"github.com/osse101/BrandishBot_Go/internal/lootbox"
+	"github.com/osse101/BrandishBot_Go/internal/moderation"
+	"github.com/osse101/BrandishBot_Go/internal/monetization"
 	"github.com/osse101/BrandishBot_Go/internal/naming"
+	"github.com/osse101/BrandishBot_Go/internal/personaltrack"
+	"github.com/osse101/BrandishBot_Go/internal/playershop"
 	"github.com/osse101/BrandishBot_Go/internal/prediction"
 	"github.com/osse101/BrandishBot_Go/internal/progression"
+	"github.com/osse101/BrandishBot_Go/internal/progressionbulk"
 	"github.com/osse101/BrandishBot_Go/internal/quest"
+	"github.com/osse101/BrandishBot_Go/internal/raid"
+	"github.com/osse101/BrandishBot_Go/internal/reconcile"
+	"github.com/osse101/BrandishBot_Go/internal/reminder"
+	"github.com/osse101/BrandishBot_Go/internal/rng"
 	"github.com/osse101/BrandishBot_Go/internal/scenario"
 	"github.com/osse101/BrandishBot_Go/internal/scenario/providers"
 	"github.com/osse101/BrandishBot_Go/internal/scheduler"
 	"github.com/osse101/BrandishBot_Go/internal/search"
+	"github.com/osse101/BrandishBot_Go/internal/season"
 	"github.com/osse101/BrandishBot_Go/internal/server"
 	"github.com/osse101/BrandishBot_Go/internal/slots"
+	"github.com/osse101/BrandishBot_Go/internal/snapshot"
 	"github.com/osse101/BrandishBot_Go/internal/sse"
 	"github.com/osse101/BrandishBot_Go/internal/stats"
+	"github.com/osse101/BrandishBot_Go/internal/stream"
 	"github.com/osse101/BrandishBot_Go/internal/streamerbot"
 	"github.com/osse101/BrandishBot_Go/internal/subscription"
+	"github.com/osse101/BrandishBot_Go/internal/undo"
 	"github.com/osse101/BrandishBot_Go/internal/user"
+	"github.com/osse101/BrandishBot_Go/internal/usersettings"
 	"github.com/osse101/BrandishBot_Go/internal/utils"
+	"github.com/osse101/BrandishBot_Go/internal/webhook"
 	"github.com/osse101/BrandishBot_Go/internal/worker"
 )
 
@@ -74,6 +119,17 @@ func main() {
 		os.Exit(1)
 	}
 	defer logFile.Close()
+
+	// Cross-check config files before touching the database; broken references fail fast here
+	if report := configcheck.Run(configcheck.DefaultPaths()); !report.OK() {
+		for _, issue := range report.Issues {
+			slog.Error(configcheck.LogMsgIssue, "source", issue.Source, "issue", issue.Message)
+		}
+		slog.Error(configcheck.LogMsgFailed, "issues", len(report.Issues))
+		slog.Info("💡 Hint: Run: go run ./cmd/devtool validate")
+		os.Exit(1)
+	}
+
 	// Connect to database with retry logic
 	dbPool, err := database.NewPool(cfg.GetDBConnString(), cfg.DBMaxConns, cfg.DBMaxConnIdleTime, cfg.DBMaxConnLifetime)
 	if err != nil {
@@ -90,6 +146,12 @@ func main() {
 	}
 	defer dbPool.Close()
 
+	// Optional read replica for leaderboards, inventory reads and tree queries
+	replicaPool := database.NewReplicaPool(cfg.DBReplicaURL, cfg.DBMaxConns, cfg.DBMaxConnIdleTime, cfg.DBMaxConnLifetime)
+	if replicaPool != nil {
+		defer replicaPool.Close()
+	}
+
 	// Initialize Event System
 	eventBus, resilientPublisher, err := bootstrap.InitializeEventSystem(cfg)
 	if err != nil {
@@ -97,18 +159,55 @@ func main() {
 		os.Exit(1)
 	}
 
+	// Forward selected events to other processes over Postgres NOTIFY
+	if bridge := bootstrap.InitializeEventBridge(cfg, dbPool, eventBus); bridge != nil {
+		defer bridge.Close()
+	}
+
 	// Initialize all repositories
-	repos := bootstrap.InitializeRepositories(dbPool, eventBus)
+	postgres.ConfigureItemCache(cfg.ItemCacheTTL)
+	repos := bootstrap.InitializeRepositories(dbPool, replicaPool, eventBus)
+
+	// Seeded randomness for lootboxes, searches, gambles and voting
+	rngProvider := rng.New(cfg.RNGSeed, cfg.RNGLogSeeds)
+	slog.Info("RNG initialized", "seed", rngProvider.Seed(), "log_seeds", cfg.RNGLogSeeds)
 
 	// Initialize core services
-	statsService := stats.NewService(repos.Stats)
-	progressionService := progression.NewService(repos.Progression, repos.User, eventBus, resilientPublisher, nil, cfg.DisableProgressionGains)
+	rollupBuckets := make([]domain.StatsBucket, 0, len(cfg.StatsRollupBuckets))
+	for _, bucket := range cfg.StatsRollupBuckets {
+		rollupBuckets = append(rollupBuckets, domain.StatsBucket(bucket))
+	}
+	statsService := stats.NewService(repos.Stats, stats.WithRollups(repos.StatsRollups, rollupBuckets))
+	voteWeights := make([]progression.VoteWeightTier, 0, len(cfg.VoteWeightTiers))
+	for _, tier := range cfg.VoteWeightTiers {
+		voteWeights = append(voteWeights, progression.VoteWeightTier{MinScore: tier.MinScore, Weight: tier.Weight})
+	}
+	// Timed effects are checked by the progression, job, economy, gamble, user and search services
+	effectsService := effects.NewService(repos.Effects, repos.User)
+
+	// Each community progresses through the shared tree on its own
+	progressionService := progression.NewRouter(cfg.Communities(), func(communityID string) progression.Service {
+		return progression.NewService(repos.Progression, repos.User, eventBus, resilientPublisher, nil, cfg.DisableProgressionGains,
+			progression.WithContributionConfig(progression.ContributionConfig{
+				DecayRate:            cfg.ContributionDecayRate,
+				CatchUpThreshold:     cfg.ContributionCatchUpThreshold,
+				CatchUpMaxMultiplier: cfg.ContributionCatchUpMax,
+			}),
+			progression.WithVoteWeights(voteWeights),
+			progression.WithRNG(rngProvider),
+			progression.WithCommunityEffects(effectsService),
+			progression.WithCommunity(communityID))
+	})
 
 	// Sync configuration files to database
 	if err := bootstrap.SyncProgressionTree(context.Background(), repos.Progression); err != nil {
 		slog.Error("Progression tree sync failed", "error", err)
 		os.Exit(1)
 	}
+	if err := bootstrap.SeedCommunities(context.Background(), dbPool, cfg.Communities()); err != nil {
+		slog.Error("Community seeding failed", "error", err)
+		os.Exit(1)
+	}
 
 	itemRepo, err := bootstrap.SyncItems(context.Background(), dbPool)
 	if err != nil {
@@ -121,18 +220,109 @@ func main() {
 		os.Exit(1)
 	}
 
+	seasonPacks, err := bootstrap.SyncSeasons(context.Background(), repos.Crafting, itemRepo)
+	if err != nil {
+		slog.Error("Season packs sync failed", "error", err)
+		os.Exit(1)
+	}
+
+	// Initialize Cooldown Service
+	// Load search regions (non-fatal if missing); locations with their own cooldown register it here
+	var regions []search.Region
+	if loaded, err := search.LoadSearchRegions(domain.SearchRegionConfigPath); err == nil {
+		regions = loaded
+	} else {
+		slog.Warn("Search regions not loaded, searching without locations", "error", err)
+	}
+
+	cooldowns := search.LocationCooldowns(regions)
+	cooldowns[domain.ActionSearch] = cfg.CooldownSearch
+	cooldowns[domain.ActionSlots] = cfg.CooldownSlots
+	cooldowns[domain.ActionGambleStart] = cfg.CooldownGambleStart
+	cooldowns[domain.ActionGive] = cfg.CooldownGive
+	cooldowns[domain.ActionUseItem] = cfg.CooldownUseItem
+	cooldowns[domain.ActionJobSwitch] = cfg.CooldownJobSwitch
+	cooldowns[domain.ActionUndo] = cfg.CooldownUndo
+	cooldowns[domain.ActionDig] = cfg.CooldownDig
+	cooldowns[domain.ActionFish] = cfg.CooldownFish
+	cooldowns[domain.ActionBossAttack] = cfg.CooldownBossAttack
+
+	cooldownSvc := cooldown.NewPostgresService(dbPool, cooldown.Config{
+		DevMode:   cfg.DevMode,
+		Cooldowns: cooldowns,
+		Modifiers: []cooldown.Modifier{search.NewMasteryCooldownModifier(repos.Search)},
+	}, progressionService)
+	slog.Info("Cooldown service initialized", "dev_mode", cfg.DevMode)
+
+	// Load the job perk tables (non-fatal if missing); they are only shown to players
+	jobPerks, err := job.NewPerkTable(config.ConfigPathJobPerks)
+	if err != nil {
+		slog.Warn("Job perks not loaded, perk tables will be empty", "error", err)
+	}
+
+	// Load the scheduled global XP events (non-fatal if missing); without them no event boosts XP
+	jobXPEvents, err := job.NewXPEventTable(config.ConfigPathJobXPEvents)
+	if err != nil {
+		slog.Warn("Job XP events not loaded, no global XP events will apply", "error", err)
+	}
+
+	// Load the personal progression tracks (non-fatal if missing); without them no milestones unlock
+	personalTracks, err := personaltrack.NewTrackTable(config.ConfigPathPersonalTracks)
+	if err != nil {
+		slog.Warn("Personal tracks not loaded, no personal milestones will unlock", "error", err)
+	}
+
+	// Load the chat drop rates (non-fatal if missing); without them chat activity drops nothing
+	chatDrops, err := chatdrop.NewTable(config.ConfigPathChatDrops)
+	if err != nil {
+		slog.Warn("Chat drops not loaded, chat activity will not drop items", "error", err)
+	}
+
+	// Load the raid and host bonus tiers (non-fatal if missing); without them raids grant nothing
+	raidBonuses, err := raid.NewTable(config.ConfigPathRaidBonuses)
+	if err != nil {
+		slog.Warn("Raid bonuses not loaded, raids and hosts will not grant bonuses", "error", err)
+	}
+
+	// Load the scripted item effects (non-fatal if missing); without them only built-in item handlers run
+	itemScripts, err := itemhandler.NewScriptTable(config.ConfigPathItemEffects)
+	if err != nil {
+		slog.Warn("Item effect scripts not loaded, only built-in item effects will run", "error", err)
+	}
+
+	// Admin game events boost XP, drop rates and shop prices for everyone while they run
+	gameEventService := gameevent.NewService(repos.GameEvents, gameevent.Config{})
+
 	// Initialize Job service (needed by user, economy, crafting, gamble)
-	jobService := job.NewService(repos.Job, progressionService, eventBus, resilientPublisher, cfg.DisableJobXPGains)
+	jobService := job.NewService(repos.Job, progressionService, eventBus, resilientPublisher, cfg.DisableJobXPGains,
+		job.WithCooldowns(cooldownSvc), job.WithPerks(jobPerks), job.WithEffects(effectsService), job.WithXPEvents(jobXPEvents),
+		job.WithSourceCaps(cfg.JobXPSourceCaps), job.WithGameEvents(gameEventService))
 
 	// Initialize Worker Pool
-	// Start with 5 workers as per plan
-	workerPool := worker.NewPool(5, 100)
+	workerPool := worker.NewPool(cfg.WorkerPoolSize, cfg.WorkerQueueSize)
+	// Periodic maintenance jobs never need more than one run in flight
+	workerPool.SetTypeLimit(eventlog.CleanupJobType, 1)
+	workerPool.SetTypeLimit(progression.UnlockCheckerJobType, 1)
+	workerPool.SetTypeLimit(progression.ContributionDecayJobType, 1)
+	workerPool.SetTypeLimit(reconcile.JobType, 1)
+	workerPool.SetTypeLimit(celebration.JobType, 1)
+	workerPool.SetTypeLimit(chatdrop.JobType, 1)
+	workerPool.SetTypeLimit(gamble.RecoveryJobType, 1)
+	workerPool.SetTypeLimit(brigade.JobType, 1)
+	workerPool.SetTypeLimit(progressionbulk.JobType, 1)
 	workerPool.Start()
 	defer workerPool.Stop()
 
 	// Initialize Event Logger (needed by event handlers)
 	eventLogService := eventlog.NewService(repos.EventLog)
 
+	// Persist event handlers that exhaust their retries
+	deadLetterService, err := bootstrap.InitializeDeadLetterQueue(eventBus, repos.DeadLetter)
+	if err != nil {
+		slog.Error("Failed to initialize dead-letter queue", "error", err)
+		os.Exit(1)
+	}
+
 	// Initialize Quest Service (needed by economy service)
 	questService, err := quest.NewService(repos.Quest, resilientPublisher)
 	if err != nil {
@@ -141,6 +331,9 @@ func main() {
 	}
 	slog.Info("Quest service initialized")
 
+	// Personal tracks unlock milestones from each user's own engagement
+	personalTrackService := personaltrack.NewService(repos.PersonalTrack, repos.User, personalTracks, resilientPublisher)
+
 	// Register all event handlers
 	if err := bootstrap.RegisterEventHandlers(bootstrap.EventHandlerDependencies{
 		EventBus:           eventBus,
@@ -149,6 +342,7 @@ func main() {
 		JobService:         jobService,
 		QuestService:       questService,
 		StatsService:       statsService,
+		PersonalTracks:     personalTrackService,
 		Config:             cfg,
 	}); err != nil {
 		slog.Error("Failed to register event handlers", "error", err)
@@ -156,32 +350,61 @@ func main() {
 	}
 
 	// Initialize Job Scheduler
-	jobScheduler := scheduler.New(workerPool)
-	// Schedule event log cleanup every 24 hours
+	jobScheduler := scheduler.NewWithRepository(workerPool, repos.ScheduledJob)
+	// Schedule event log cleanup daily at 04:00 UTC
 	cleanupJob := eventlog.NewCleanupJob(eventLogService, 10)
-	jobScheduler.Schedule(24*time.Hour, cleanupJob)
+	if err := jobScheduler.ScheduleCron(eventlog.CleanupJobType, "0 4 * * *", worker.WithPriority(cleanupJob, worker.PriorityLow)); err != nil {
+		slog.Error("Failed to schedule event log cleanup", "error", err)
+		os.Exit(1)
+	}
 	// Schedule progression unlock checker every 30 minutes
 	unlockCheckerJob := progression.NewUnlockCheckerJob(progressionService)
-	jobScheduler.Schedule(30*time.Minute, unlockCheckerJob)
+	jobScheduler.Schedule(30*time.Minute, worker.PerCommunity(unlockCheckerJob, cfg.Communities()))
+	if cfg.ContributionDecayRate > 0 {
+		jobScheduler.Schedule(cfg.ContributionDecayInterval, worker.WithPriority(progression.NewContributionDecayJob(progressionService), worker.PriorityLow))
+	}
+	jobScheduler.Schedule(cfg.StatsRollupInterval, worker.WithPriority(stats.NewJob(statsService), worker.PriorityLow))
 	jobScheduler.Start()
 	defer jobScheduler.Stop()
 	slog.Info("Job scheduler initialized")
 
 	// Initialize progression state (ensure valid target on startup)
-	if err := progressionService.InitializeProgressionState(context.Background()); err != nil {
-		slog.Warn("Failed to initialize progression state", "error", err)
-		// Don't exit - this is a non-critical error
+	for _, ctx := range community.Each(context.Background(), cfg.Communities()) {
+		if err := progressionService.InitializeProgressionState(ctx); err != nil {
+			slog.Warn("Failed to initialize progression state", "community", community.FromContext(ctx), "error", err)
+			// Don't exit - this is a non-critical error
+		}
+	}
+
+	// Initialize Balance service. Changes that took effect while the bot was
+	// down are applied before the lootbox cache is built.
+	balanceService := balance.NewService(repos.Balance, repos.User, resilientPublisher)
+	if _, err := balanceService.ApplyDue(context.Background()); err != nil {
+		slog.Warn("Failed to apply item balance changes", "error", err)
 	}
+	jobScheduler.Schedule(cfg.BalanceApplyInterval, balance.NewJob(balanceService))
+
+	// Initialize guild API token service
+	apiTokenService := apitoken.NewService(repos.APIToken, apitoken.Config{MaxPerGuild: cfg.APITokenMaxPerGuild})
+	featureFlagService := featureflag.NewService(repos.FeatureFlags, featureflag.Config{})
+
+	// Seasons add loot, limited recipes and themed names during their window;
+	// the job publishes a season change when one starts or ends
+	seasonService := season.NewService(seasonPacks, resilientPublisher)
+	jobScheduler.Schedule(season.CheckInterval, season.NewJob(seasonService))
 
 	// Initialize Lootbox Service
-	lootboxSvc, err := lootbox.NewService(repos.User, progressionService, eventBus, config.ConfigPathLootTables)
+	lootboxSvc, err := lootbox.NewService(repos.User, progressionService, eventBus, config.ConfigPathLootTables,
+		lootbox.WithPityRepository(repos.LootboxPity), lootbox.WithWeightOverrides(balanceService), lootbox.WithRNG(rngProvider), lootbox.WithGameEvents(gameEventService),
+		lootbox.WithSeasonalLoot(seasonService))
 	if err != nil {
 		slog.Error("Failed to initialize lootbox service", "error", err)
 		os.Exit(1)
 	}
 
 	// Initialize Naming Resolver for item display names
-	namingResolver, err := naming.NewResolver(config.ConfigPathItemAliases, config.ConfigPathItemThemes)
+	namingResolver, err := naming.NewResolver(config.ConfigPathItemAliases, config.ConfigPathItemThemes,
+		naming.WithAliasOverrides(itemalias.OverrideLoader(repos.ItemAliases)), naming.WithThemePacks(seasonService.ThemePacks()))
 
 	if err != nil {
 		slog.Error("Failed to initialize naming resolver", "error", err)
@@ -199,28 +422,118 @@ func main() {
 		namingResolver.RegisterItem(item.InternalName, item.PublicName)
 	}
 	slog.Info("Items registered with naming resolver", "count", len(allItems))
+	itemAliasService := itemalias.NewService(repos.ItemAliases, repos.User, namingResolver)
 
-	// Initialize Cooldown Service
-	cooldownSvc := cooldown.NewPostgresService(dbPool, cooldown.Config{
-		DevMode: cfg.DevMode,
-	}, progressionService)
-	slog.Info("Cooldown service initialized", "dev_mode", cfg.DevMode)
+	// Event themes run by date (naming themes and season packs) or through a game event
+	eventThemes := gameevent.NewThemeResolver(namingResolver, gameEventService)
 
-	// Initialize services that depend on naming resolver
-	economyService := economy.NewService(repos.Economy, resilientPublisher, namingResolver, progressionService)
-	gambleService := gamble.NewService(repos.Gamble, eventBus, resilientPublisher, lootboxSvc, cfg.GambleJoinDuration, progressionService, namingResolver, nil)
-	// Refactored Crafting Service (event-driven)
-	craftingService := crafting.NewService(repos.Crafting, resilientPublisher, namingResolver, progressionService, jobService)
+	// Load the search difficulty curve (non-fatal if missing); searchers are counted from search progress
+	searchDifficulty, err := search.NewDifficulty(domain.SearchDifficultyConfigPath, repos.Search)
+	if err != nil {
+		slog.Warn("Search difficulty not loaded, searching at fixed difficulty", "error", err)
+	}
+
+	// Hot-reload JSON game data on file change or POST /admin/config/reload
+	reloadSources := []configreload.Source{
+		{Name: configreload.SourceLootTables, Path: config.ConfigPathLootTables, Reload: lootboxSvc.Reload},
+		{Name: configreload.SourceItemAliases, Path: config.ConfigPathItemAliases, Reload: func(context.Context) error { return namingResolver.Reload() }},
+		{Name: configreload.SourceItemThemes, Path: config.ConfigPathItemThemes, Reload: func(context.Context) error { return namingResolver.Reload() }},
+		{Name: configreload.SourceProgressionTree, Path: config.ConfigPathProgressionTree, Reload: func(ctx context.Context) error {
+			return bootstrap.SyncProgressionTree(ctx, repos.Progression)
+		}},
+	}
+	if searchDifficulty != nil {
+		reloadSources = append(reloadSources, configreload.Source{Name: configreload.SourceSearchDifficulty, Path: domain.SearchDifficultyConfigPath, Reload: searchDifficulty.Reload})
+	}
+	if jobPerks != nil {
+		reloadSources = append(reloadSources, configreload.Source{Name: configreload.SourceJobPerks, Path: config.ConfigPathJobPerks, Reload: jobPerks.Reload})
+	}
+	if jobXPEvents != nil {
+		reloadSources = append(reloadSources, configreload.Source{Name: configreload.SourceJobXPEvents, Path: config.ConfigPathJobXPEvents, Reload: jobXPEvents.Reload})
+	}
+	if personalTracks != nil {
+		reloadSources = append(reloadSources, configreload.Source{Name: configreload.SourcePersonalTracks, Path: config.ConfigPathPersonalTracks, Reload: personalTracks.Reload})
+	}
+	if chatDrops != nil {
+		reloadSources = append(reloadSources, configreload.Source{Name: configreload.SourceChatDrops, Path: config.ConfigPathChatDrops, Reload: chatDrops.Reload})
+	}
+	if raidBonuses != nil {
+		reloadSources = append(reloadSources, configreload.Source{Name: configreload.SourceRaidBonuses, Path: config.ConfigPathRaidBonuses, Reload: raidBonuses.Reload})
+	}
+	if itemScripts != nil {
+		reloadSources = append(reloadSources, configreload.Source{Name: configreload.SourceItemEffects, Path: config.ConfigPathItemEffects, Reload: itemScripts.Reload})
+	}
+	configReloader := configreload.New(configreload.DefaultDebounce, reloadSources...)
+	if cfg.ConfigWatchEnabled {
+		if err := configReloader.Start(); err != nil {
+			slog.Warn("Failed to start config watcher, reload is only available via the admin API", "error", err)
+		} else {
+			defer configReloader.Stop()
+		}
+	}
+
+	jobScheduler.Schedule(cfg.EffectExpiryInterval, effects.NewJob(effectsService))
+
+	// Sells, disassembles and gives can be undone for a short while. Undo checks
+	// slot limits through the user service, which records into undo and so is
+	// created below.
+	var userService user.Service
+	undoService := undo.NewService(repos.Undo, repos.User, repos.User, cooldownSvc, cfg.UndoWindow, undo.WithCapacity(undo.CapacityFunc(func(ctx context.Context, userID string, itemID int, qualityLevel domain.QualityLevel) error {
+		return userService.EnsureInventoryRoom(ctx, userID, itemID, qualityLevel)
+	})))
+
+	// Gives are rate limited and watched for alt accounts funneling items
+	giveGuardService := giveguard.NewService(repos.GiveGuard, resilientPublisher, giveguard.Config{
+		DailyLimit:     cfg.GiveDailyLimit,
+		MinAccountAge:  cfg.GiveMinAccountAge,
+		CircularWindow: cfg.GiveCircularWindow,
+	})
+
+	// Moderators can freeze accounts out of the economy, wipe inventories and inspect accounts
+	moderationService := moderation.NewService(repos.Moderation, repos.User, jobService, eventLogService)
 
 	// Initialize services that depend on job service and naming resolver
-	userService := user.NewService(repos.User, repos.Trap, statsService, resilientPublisher, lootboxSvc, namingResolver, cooldownSvc, progressionService, jobService, eventBus, cfg.DevMode)
+	userService = user.NewService(repos.User, repos.Trap, statsService, resilientPublisher, lootboxSvc, namingResolver, cooldownSvc, progressionService, jobService, eventBus, cfg.DevMode, user.WithTargetingPreferences(repos.UserSettings), user.WithEffects(effectsService), user.WithGiveTax(cfg.GiveTaxPercent, cfg.GiveTaxThreshold), user.WithScriptedItems(itemScripts), user.WithSlotLimit(cfg.InventorySlotLimit), user.WithItemFlags(repos.ItemFlags), user.WithUndo(undoService), user.WithGiveGuard(giveGuardService), user.WithTimeoutStore(repos.Timeouts))
 
-	// Load search regions (non-fatal if missing)
-	var regions []search.Region
-	if loaded, err := search.LoadSearchRegions(domain.SearchRegionConfigPath); err == nil {
-		regions = loaded
+	// Timeouts are kept in the database; re-arm expiry cleanup for the ones still running
+	if err := userService.RestoreTimeouts(context.Background()); err != nil {
+		slog.Warn("Failed to restore active timeouts", "error", err)
 	}
 
+	// Initialize services that depend on naming resolver
+	economyOpts := []economy.Option{economy.WithLoanChecker(repos.Loan), economy.WithItemLocks(repos.ItemFlags), economy.WithEffects(effectsService), economy.WithSellTax(cfg.SellTaxPercent), economy.WithUndo(undoService), economy.WithGameEvents(gameEventService), economy.WithCapacity(userService)}
+	if cfg.MarketPriceSensitivity > 0 {
+		economyOpts = append(economyOpts, economy.WithMarket(repos.Market, economy.MarketConfig{
+			Sensitivity:      cfg.MarketPriceSensitivity,
+			HalfLife:         cfg.MarketPriceHalfLife,
+			Floor:            cfg.MarketPriceFloor,
+			Ceiling:          cfg.MarketPriceCeiling,
+			HistoryRetention: cfg.MarketHistoryRetention,
+		}))
+	}
+	economyService := economy.NewService(repos.Economy, resilientPublisher, namingResolver, progressionService, economyOpts...)
+	if cfg.MarketPriceSensitivity > 0 {
+		jobScheduler.Schedule(cfg.MarketSnapshotInterval, worker.WithPriority(worker.PerCommunity(economy.NewJob(economyService), cfg.Communities()), worker.PriorityLow))
+	}
+	gambleService := gamble.NewService(repos.Gamble, eventBus, resilientPublisher, lootboxSvc, cfg.GambleJoinDuration, progressionService, namingResolver, nil, gamble.WithEffects(effectsService), gamble.WithCooldowns(cooldownSvc), gamble.WithRake(cfg.GambleRakePercent), gamble.WithRNG(rngProvider), gamble.WithOverflow(userService), gamble.WithLimits(gamble.Limits{
+		MinParticipants: cfg.GambleMinParticipants,
+		MaxParticipants: cfg.GambleMaxParticipants,
+		MaxWagerValue:   cfg.GambleMaxWagerValue,
+	}))
+	// Refactored Crafting Service (event-driven)
+	craftingService := crafting.NewService(repos.Crafting, resilientPublisher, namingResolver, progressionService, jobService, crafting.WithLoanChecker(repos.Loan), crafting.WithItemLocks(repos.ItemFlags), crafting.WithUndo(undoService), crafting.WithEventThemes(eventThemes), crafting.WithOverflow(userService),
+		crafting.WithTiers(crafting.TierConfig{
+			FineChance:       cfg.CraftFineChance,
+			MasterworkChance: cfg.CraftMasterworkChance,
+			LegendaryChance:  cfg.CraftLegendaryChance,
+			JobLevelBonus:    cfg.CraftTierJobLevelBonus,
+			ToolBonuses:      cfg.CraftToolBonuses,
+		}),
+		crafting.WithMastery(statsService, crafting.MasteryConfig{
+			Threshold:       cfg.CraftMasteryThreshold,
+			MasterworkBonus: cfg.CraftMasteryMasterworkBonus,
+		}))
+
 	// Wire up search service using userService adapters
 	searchService := search.New(search.Deps{
 		UserResolver:   userService,
@@ -232,7 +545,12 @@ func main() {
 		ProgressionSvc: progressionService,
 		Publisher:      resilientPublisher,
 		Rnd:            utils.RandomFloat,
+		RNG:            rngProvider,
 		Regions:        regions,
+		Progress:       repos.Search,
+		Effects:        effectsService,
+		Difficulty:     searchDifficulty,
+		GameEvents:     gameEventService,
 	})
 
 	// Initialize Harvest Service
@@ -244,10 +562,43 @@ func main() {
 	slog.Info("Compost service initialized")
 
 	// Initialize Gamble Worker
-	gambleWorker := worker.NewGambleWorker(gambleService)
+	gambleWorker := worker.NewGambleWorker(gambleService, cfg.Communities()...)
 	gambleWorker.Subscribe(eventBus)
 	gambleWorker.Start() // Checks for existing active gamble on startup
 
+	// Serve status polls from memory; workers keep using the services directly
+	gameState := gamestate.NewProjection(gambleService, progressionService, cfg.StateProjectionMaxAge)
+	gameState.Subscribe(eventBus)
+	for _, ctx := range community.Each(context.Background(), cfg.Communities()) {
+		gameState.Rebuild(ctx)
+	}
+
+	reconcileJob := reconcile.NewJob(
+		reconcile.NewVoteCountCheck(repos.Reconcile, cfg.ReconcileHealMax),
+		reconcile.NewGameStateCheck(gameState, gambleService, progressionService, cfg.ReconcileHealMax, cfg.Communities()...),
+	)
+	if err := jobScheduler.ScheduleCron(reconcile.JobType, cfg.ReconcileCron, worker.WithPriority(reconcileJob, worker.PriorityLow)); err != nil {
+		slog.Error("Failed to schedule reconciliation job", "error", err)
+		os.Exit(1)
+	}
+
+	// Resume or refund gambles left unresolved by a crash
+	gambleRecoveryJob := gamble.NewRecoveryJob(gameState.GambleService(gambleService), cfg.GambleStaleTimeout)
+	jobScheduler.Schedule(5*time.Minute, worker.WithPriority(gambleRecoveryJob, worker.PriorityLow))
+
+	// Flag bursts of votes from never-active accounts for admin review
+	voteReviewService := brigade.NewService(repos.VoteReview, resilientPublisher, brigade.Config{
+		Window:      cfg.VoteBrigadeWindow,
+		MinVotes:    cfg.VoteBrigadeMinVotes,
+		AutoExclude: cfg.VoteBrigadeAutoExclude,
+	})
+	jobScheduler.Schedule(cfg.VoteBrigadeWindow, worker.WithPriority(brigade.NewJob(voteReviewService), worker.PriorityLow))
+
+	// Admin bulk grants and revokes of user progressions run on the worker pool
+	progressionBulkService := progressionbulk.NewService(repos.BulkProgress, func(job *progressionbulk.Job) error {
+		return workerPool.TryEnqueue(worker.WithPriority(job, worker.PriorityLow))
+	}, progressionbulk.Config{})
+
 	// Initialize Expedition Service and Worker
 	expeditionConfig, err := expedition.LoadEncounterConfig(config.ConfigPathExpeditionEncounters)
 	if err != nil {
@@ -314,6 +665,32 @@ func main() {
 	sseSubscriber.Subscribe()
 	slog.Info("SSE hub initialized")
 
+	// Forward inventory diffs to an external sync endpoint if configured
+	if cfg.InventoryWebhookURL != "" {
+		inventoryWebhook := inventorysync.NewWebhook(cfg.InventoryWebhookURL, cfg.InventoryWebhookSecret, eventBus)
+		inventoryWebhook.Subscribe()
+		inventoryWebhook.Start()
+		defer inventoryWebhook.Stop()
+	}
+
+	// Queue supported events for admin-registered webhooks and send them on a schedule
+	webhookService := webhook.NewService(repos.Webhook, webhook.Config{MaxAttempts: cfg.WebhookMaxAttempts})
+	webhookService.Subscribe(eventBus)
+	jobScheduler.Schedule(cfg.WebhookDeliveryInterval, webhook.NewJob(webhookService))
+
+	// Announce events by the admin-configured announcement routes
+	announceService := announce.NewService(repos.Announcements, resilientPublisher)
+	announceService.Subscribe(eventBus)
+
+	// Admin game state snapshots, also taken on a schedule unless SNAPSHOT_CRON is empty
+	snapshotService := snapshot.NewService(repos.Snapshots, resilientPublisher, snapshot.Config{Retention: cfg.SnapshotRetention})
+	if cfg.SnapshotCron != "" {
+		if err := jobScheduler.ScheduleCron(snapshot.JobType, cfg.SnapshotCron, worker.WithPriority(snapshot.NewJob(snapshotService), worker.PriorityLow)); err != nil {
+			slog.Error("Failed to schedule snapshot job", "error", err)
+			os.Exit(1)
+		}
+	}
+
 	// Initialize Streamer.bot WebSocket client if enabled
 	var sbClient *streamerbot.Client
 	if cfg.StreamerbotEnabled && cfg.StreamerbotWebhookURL != "" {
@@ -345,6 +722,109 @@ func main() {
 	subscriptionWorker.Start()
 	slog.Info("Subscription worker started", "interval", cfg.SubscriptionCheckInterval)
 
+	// Initialize Monetization service (sub/donation rewards)
+	monetizationConfig, err := monetization.LoadConfig(config.ConfigPathMonetizationRewards)
+	if err != nil {
+		slog.Error("Failed to load monetization reward config", "error", err)
+		os.Exit(1)
+	}
+	monetizationService, err := monetization.NewService(monetizationConfig, repos.Monetization, userService, gameState.ProgressionService(progressionService), jobService)
+	if err != nil {
+		slog.Error("Failed to initialize monetization service", "error", err)
+		os.Exit(1)
+	}
+	slog.Info("Monetization service initialized", "rules", len(monetizationConfig.Rules))
+
+	// Initialize Celebration service (birthday and anniversary rewards)
+	celebrationService := celebration.NewService(repos.Celebration, userService, resilientPublisher, cfg.CelebrationRewardItem)
+	if err := jobScheduler.ScheduleCron(celebration.JobType, cfg.CelebrationCron, worker.WithPriority(celebration.NewJob(celebrationService), worker.PriorityLow)); err != nil {
+		slog.Error("Failed to schedule celebration job", "error", err)
+		os.Exit(1)
+	}
+
+	// Initialize Chat Drop service: chat activity drops lootboxes to active chatters
+	chatDropService := chatdrop.NewService(chatDrops, userService, repos.User, resilientPublisher, chatdrop.WithRNG(rngProvider), chatdrop.WithStats(statsService), chatdrop.WithEventThemes(eventThemes))
+	chatdrop.NewEventHandler(chatDropService).Register(eventBus)
+	jobScheduler.Schedule(cfg.ChatDropInterval, worker.PerCommunity(chatdrop.NewJob(chatDropService), cfg.Communities()))
+
+	// Initialize Raid service: raids and hosts grant timed community bonuses
+	raidService := raid.NewService(raidBonuses, effectsService, gameState.ProgressionService(progressionService), resilientPublisher)
+
+	// Initialize Stream service: sessions tag stats events and gambles for per-stream summaries
+	streamService := stream.NewService(repos.Streams, resilientPublisher)
+
+	// Initialize User Settings service (leaderboard privacy)
+	userSettingsService := usersettings.NewService(repos.UserSettings, userService, usersettings.WithNameThemes(namingResolver, progressionService))
+
+	// Initialize Reminder service and dispatch due reminders through the event bus
+	reminderService := reminder.NewService(repos.Reminder, userService, cooldownSvc, gameState.ProgressionService(progressionService), repos.Compost, resilientPublisher, reminder.Config{
+		VoteLead:   cfg.ReminderVoteLead,
+		MaxPending: cfg.ReminderMaxPending,
+	})
+	jobScheduler.Schedule(cfg.ReminderDispatchInterval, reminder.NewJob(reminderService))
+
+	// Initialize Loan service and return due loans on a schedule
+	loanService := loan.NewService(repos.Loan, userService, repos.User, namingResolver, resilientPublisher, loan.Config{
+		DefaultDuration: cfg.LoanDefaultDuration,
+		MaxDuration:     cfg.LoanMaxDuration,
+	}, loan.WithItemLocks(repos.ItemFlags), loan.WithCapacity(userService))
+	jobScheduler.Schedule(cfg.LoanReturnInterval, loan.NewJob(loanService))
+
+	// Initialize Player Shop service
+	playerShopService := playershop.NewService(repos.PlayerShop, userService, repos.User, namingResolver, resilientPublisher, playershop.Config{
+		FeePercent:         cfg.PlayerShopFeePercent,
+		ListingFeePercent:  cfg.PlayerShopListingFeePercent,
+		MaxListingsPerUser: cfg.PlayerShopMaxListings,
+	}, playershop.WithLoanChecker(repos.Loan), playershop.WithItemLocks(repos.ItemFlags), playershop.WithCapacity(userService))
+	itemFlagsService := itemflags.NewService(repos.ItemFlags, userService, repos.User, namingResolver)
+	communityPoolService := communitypool.NewService(repos.CommunityPool, userService, repos.User, namingResolver, progressionService, cfg.CommunityPoolMoneyPerPoint, communitypool.WithLoanChecker(repos.Loan), communitypool.WithItemLocks(repos.ItemFlags))
+
+	// Initialize Jackpot service: lootbox openings and gambles feed a pool any opening can win
+	jackpotService := jackpot.NewService(repos.Jackpot, repos.User, repos.User, resilientPublisher, cfg.JackpotContributionPercent, cfg.JackpotTriggerChance, int64(cfg.JackpotMaxBalance), jackpot.WithRNG(rngProvider))
+	jackpot.NewEventHandler(jackpotService).Register(eventBus)
+
+	// Initialize Digging service: the community dig site, whose levels grant contribution as it deepens
+	diggingService := digging.NewService(repos.Digging, userService, cooldownSvc, progressionService, resilientPublisher, digging.WithRNG(rngProvider))
+	fishingService := fishing.NewService(repos.Fishing, userService, cooldownSvc, progressionService, resilientPublisher, fishing.WithRNG(rngProvider))
+
+	// Initialize Garden service: seeds grow into crops in real time, worked out when the garden is read
+	gardenService := garden.NewService(repos.Garden, userService, progressionService, resilientPublisher)
+
+	// Initialize Bank service and pay interest on a schedule
+	bankService := bank.NewService(repos.Bank, userService, repos.User, progressionService, bank.Config{
+		InterestRate:     cfg.BankInterestRate,
+		InterestCap:      int64(cfg.BankInterestCap),
+		WithdrawLimit:    int64(cfg.BankWithdrawLimit),
+		GoalBonusPercent: cfg.BankGoalBonusPercent,
+	})
+	if err := jobScheduler.ScheduleCron(bank.JobType, cfg.BankInterestCron, bank.NewJob(bankService)); err != nil {
+		slog.Error("Failed to schedule bank interest job", "error", err)
+		os.Exit(1)
+	}
+
+	// Initialize Gift service and deliver due gifts on a schedule
+	giftService := gift.NewService(repos.Gift, userService, repos.User, namingResolver, resilientPublisher, gift.Config{
+		MaxPending: cfg.GiftMaxPending,
+		MaxDelay:   cfg.GiftMaxDelay,
+	}, gift.WithGiveGuard(giveGuardService), gift.WithLoanChecker(repos.Loan), gift.WithItemLocks(repos.ItemFlags), gift.WithCapacity(userService))
+	jobScheduler.Schedule(cfg.GiftDeliveryInterval, gift.NewJob(giftService))
+
+	// Initialize Inbox service, filled from the event bus
+	inboxService := inbox.NewService(repos.Inbox, userService, inbox.Config{MaxMessages: cfg.InboxMaxMessages})
+	inbox.NewEventHandler(inboxService).Register(eventBus)
+
+	// Initialize Boss service: community raids whose loot is shared out by damage dealt
+	bossService := boss.NewService(repos.Boss, userService, cooldownSvc, lootboxSvc, resilientPublisher, boss.WithRNG(rngProvider), boss.WithStats(statsService))
+	bossWorker := worker.NewBossWorker(bossService, cfg.Communities()...)
+	bossWorker.Subscribe(eventBus)
+	bossWorker.Start() // Schedules the escape of bosses still active on startup
+	if cfg.BossSpawnCron != "" {
+		if err := jobScheduler.ScheduleCron(boss.JobType, cfg.BossSpawnCron, worker.WithPriority(worker.PerCommunity(boss.NewJob(bossService), cfg.Communities()), worker.PriorityLow)); err != nil {
+			slog.Error("Failed to schedule boss spawn job", "error", err)
+			os.Exit(1)
+		}
+	}
+
 	// Initialize Scenario Engine for admin testing
 	scenarioRegistry := scenario.NewRegistry()
 
@@ -358,7 +838,19 @@ func main() {
 	scenarioEngine := scenario.NewEngine(scenarioRegistry)
 	slog.Info("Scenario engine initialized", "features", scenarioRegistry.Features())
 
-	srv := server.NewServer(cfg.Port, cfg.APIKey, cfg.TrustedProxies, dbPool, userService, economyService, craftingService, statsService, progressionService, searchService, gambleService, jobService, linkingService, harvestService, predictionService, expeditionService, questService, subscriptionService, slotsService, compostService, namingResolver, eventBus, sseHub, repos.User, scenarioEngine, eventLogService)
+	scopedKeys := make([]server.ScopedAPIKey, 0, len(cfg.ExternalContributionKeys))
+	for _, k := range cfg.ExternalContributionKeys {
+		scopedKeys = append(scopedKeys, server.ScopedAPIKey{Key: k.Key, Source: k.Source, HourlyCap: k.HourlyCap})
+	}
+	communityKeys := make([]server.CommunityAPIKey, 0, len(cfg.CommunityKeys))
+	for _, k := range cfg.CommunityKeys {
+		communityKeys = append(communityKeys, server.CommunityAPIKey{Key: k.Key, Community: k.Community})
+	}
+	srv := server.NewServer(cfg.Port, cfg.APIKey, scopedKeys, communityKeys, cfg.TrustedProxies, dbPool, userService, economyService, craftingService, statsService, gameState.ProgressionService(progressionService), searchService, gameState.GambleService(gambleService), jobService, linkingService, harvestService, predictionService, expeditionService, questService, subscriptionService, monetizationService, celebrationService, userSettingsService, reminderService, loanService, playerShopService, communityPoolService, itemFlagsService, undoService, effectsService, cooldownSvc, slotsService, compostService, namingResolver, eventBus, sseHub, repos.User, scenarioEngine, eventLogService, deadLetterService, voteReviewService, giveGuardService, moderationService, progressionBulkService, balanceService, apiTokenService, featureFlagService, itemAliasService, personalTrackService, webhookService, snapshotService, raidService, streamService, announceService, jackpotService, gameEventService, diggingService, fishingService, bossService, gardenService, bankService, giftService, inboxService, chatDropService, configReloader, cfg.ChatCommandPrefix, server.AccessLogConfig{
+		Enabled:        cfg.AccessLogEnabled,
+		BodySampleRate: cfg.AccessLogBodySampleRate,
+		MaxBodyBytes:   cfg.AccessLogMaxBodyBytes,
+	})
 
 	// Run server in a goroutine
 	go func() {
@@ -369,6 +861,25 @@ func main() {
 		}
 	}()
 
+	var grpcSrv *grpcserver.Server
+	if cfg.GRPCPort != 0 {
+		grpcSrv = grpcserver.NewServer(cfg.GRPCPort, cfg.APIKey, cfg.Communities(), grpcserver.Services{
+			User:        userService,
+			Economy:     economyService,
+			Progression: gameState.ProgressionService(progressionService),
+			Moderation:  moderationService,
+			EventBus:    eventBus,
+			SSEHub:      sseHub,
+			ChatDrops:   chatDropService,
+		})
+		go func() {
+			if err := grpcSrv.Start(); err != nil {
+				slog.Error("gRPC server failed", "error", err)
+				os.Exit(1)
+			}
+		}()
+	}
+
 	// Wait for interrupt signal for graceful shutdown
 	quit := make(chan os.Signal, 1)
 	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
@@ -381,6 +892,7 @@ func main() {
 	// Perform graceful shutdown
 	bootstrap.GracefulShutdown(shutdownCtx, bootstrap.ShutdownComponents{
 		Server:              srv,
+		GRPCServer:          grpcSrv,
 		ProgressionService:  progressionService,
 		UserService:         userService,
 		EconomyService:      economyService,
@@ -392,10 +904,12 @@ func main() {
 		SlotsService:        slotsService,
 		CompostService:      compostService,
 		GambleWorker:        gambleWorker,
+		BossWorker:          bossWorker,
 		ExpeditionWorker:    expeditionWorker,
 		DailyResetWorker:    dailyResetWorker,
 		WeeklyResetWorker:   weeklyResetWorker,
 		SubscriptionWorker:  subscriptionWorker,
 		ResilientPublisher:  resilientPublisher,
+		EventBus:            eventBus,
 	})
 }
@@ -60,6 +60,9 @@ func (c *BenchCommand) runHot() error {
 	fmt.Println("  → Service: AddItem")
 	c.runBenchOrWarn("./internal/user", "BenchmarkService_AddItem")
 
+	fmt.Println("  → Naming: GetDisplayName (large inventory)")
+	c.runBenchOrWarn("./internal/naming", "BenchmarkGetDisplayName_LargeInventory")
+
 	fmt.Println("  → Utils: Inventory operations (existing)")
 	// This one shouldn't fail silently as it catches all in utils
 
@@ -40,6 +40,7 @@ func main() {
 	registry.Register(&TestLootboxCommand{})
 	registry.Register(&GenerateMocksCommand{})
 	registry.Register(&TestCommand{})
+	registry.Register(&ValidateCommand{})
 
 	if len(os.Args) < 2 {
 		registry.PrintHelp()
@@ -0,0 +1,47 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+
+	"github.com/osse101/BrandishBot_Go/internal/configcheck"
+)
+
+type ValidateCommand struct{}
+
+func (c *ValidateCommand) Name() string {
+	return "validate"
+}
+
+func (c *ValidateCommand) Description() string {
+	return "Cross-check item, loot table, recipe, season and progression configs (same check as server startup)"
+}
+
+func (c *ValidateCommand) Run(args []string) error {
+	paths := configcheck.DefaultPaths()
+
+	fs := flag.NewFlagSet("validate", flag.ContinueOnError)
+	fs.StringVar(&paths.Items, "items", paths.Items, "Path to items config")
+	fs.StringVar(&paths.LootTables, "loot-tables", paths.LootTables, "Path to loot tables config")
+	fs.StringVar(&paths.ProgressionTree, "progression-tree", paths.ProgressionTree, "Path to progression tree config")
+	fs.StringVar(&paths.RecipesCrafting, "crafting", paths.RecipesCrafting, "Path to crafting recipes config")
+	fs.StringVar(&paths.RecipesDisassemble, "disassemble", paths.RecipesDisassemble, "Path to disassemble recipes config")
+	fs.StringVar(&paths.Seasons, "seasons", paths.Seasons, "Path to season packs directory")
+
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	PrintHeader("Validating configs...")
+
+	report := configcheck.Run(paths)
+	if !report.OK() {
+		for _, issue := range report.Issues {
+			PrintError("[%s] %s", issue.Source, issue.Message)
+		}
+		return fmt.Errorf("config validation failed with %d issue(s)", len(report.Issues))
+	}
+
+	PrintSuccess("All configs are consistent")
+	return nil
+}
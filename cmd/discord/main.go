@@ -173,9 +173,44 @@ func getCommandFactories(bot *discord.Bot, infoLoader *info.Loader) []CommandFac
 		discord.CompostHarvestCommand,
 		discord.CompostStatusCommand,
 
+		// Garden commands
+		discord.GardenPlantCommand,
+		discord.GardenHarvestCommand,
+		discord.GardenStatusCommand,
+
+		// Bank commands
+		discord.BankDepositCommand,
+		discord.BankWithdrawCommand,
+		discord.BankGoalCommand,
+		discord.BankStatusCommand,
+
+		// Market commands
+		discord.MarketListCommand,
+		discord.MarketBuyCommand,
+		discord.MarketCancelCommand,
+		discord.MarketSearchCommand,
+
+		// Gift commands
+		discord.GiftWrapCommand,
+		discord.GiftListCommand,
+		discord.GiftCancelCommand,
+
 		// Slots commands
 		discord.SlotsCommand,
 
+		// Digging commands
+		func() (*discordgo.ApplicationCommand, discord.CommandHandler) {
+			return discord.DigCommand(bot.DiggingGameChannelID)
+		},
+		discord.DigSiteCommand,
+
+		// Fishing commands
+		discord.FishCommand,
+
+		// Boss commands
+		discord.BossCommand,
+		discord.AttackCommand,
+
 		// Expedition commands
 		discord.ExploreCommand,
 		discord.ExpeditionJournalCommand,
@@ -189,6 +224,7 @@ func getCommandFactories(bot *discord.Bot, infoLoader *info.Loader) []CommandFac
 		discord.UnlockProgressCommand,
 		discord.EngagementCommand,
 		discord.VotingSessionCommand,
+		discord.VotingHistoryCommand,
 
 		// Admin progression commands
 		discord.AdminUnlockCommand,
@@ -238,6 +274,18 @@ func getCommandFactories(bot *discord.Bot, infoLoader *info.Loader) []CommandFac
 		discord.AdminTimeoutClearCommand,
 		discord.AdminSetTimeoutCommand,
 
+		// Celebration commands
+		discord.BirthdaySetCommand,
+		discord.BirthdayClearCommand,
+		discord.AdminCelebrationsCommand,
+
+		// Reminder commands
+		discord.RemindCommand,
+		discord.RemindersCommand,
+
+		// Inbox commands
+		discord.InboxCommand,
+
 		// Linking commands
 		discord.LinkCommand,
 		discord.UnlinkCommand,
@@ -0,0 +1,582 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// apiPrefix is stripped from paths when naming operations
+const apiPrefix = "/api/v1"
+
+// Spec is the subset of a Swagger 2.0 document the generator reads
+type Spec struct {
+	Paths       map[string]map[string]*Operation `json:"paths"`
+	Definitions map[string]*Schema               `json:"definitions"`
+}
+
+// Operation is a single method on a path
+type Operation struct {
+	Summary    string               `json:"summary"`
+	Parameters []Parameter          `json:"parameters"`
+	Responses  map[string]*Response `json:"responses"`
+}
+
+// Parameter is an operation parameter
+type Parameter struct {
+	Name        string  `json:"name"`
+	In          string  `json:"in"`
+	Type        string  `json:"type"`
+	Format      string  `json:"format"`
+	Description string  `json:"description"`
+	Required    bool    `json:"required"`
+	Schema      *Schema `json:"schema"`
+}
+
+// Response is an operation response
+type Response struct {
+	Schema *Schema `json:"schema"`
+}
+
+// Schema is a definition or inline schema
+type Schema struct {
+	Ref                  string             `json:"$ref"`
+	Type                 string             `json:"type"`
+	Format               string             `json:"format"`
+	Description          string             `json:"description"`
+	Properties           map[string]*Schema `json:"properties"`
+	Required             []string           `json:"required"`
+	Items                *Schema            `json:"items"`
+	AdditionalProperties json.RawMessage    `json:"additionalProperties"`
+	AllOf                []*Schema          `json:"allOf"`
+	Enum                 []interface{}      `json:"enum"`
+	EnumVarNames         []string           `json:"x-enum-varnames"`
+}
+
+func main() {
+	specPath := flag.String("spec", "docs/swagger/swagger.json", "Path to the swagger spec")
+	outputPath := flag.String("output", "pkg/apiclient/zz_generated.go", "Path to the generated client file")
+	flag.Parse()
+
+	data, err := os.ReadFile(*specPath)
+	if err != nil {
+		log.Fatalf("Failed to read spec: %v", err)
+	}
+
+	var spec Spec
+	if err := json.Unmarshal(data, &spec); err != nil {
+		log.Fatalf("Failed to parse spec: %v", err)
+	}
+
+	g := newGenerator(&spec)
+	code, err := g.generate()
+	if err != nil {
+		log.Fatalf("Failed to generate client: %v", err)
+	}
+
+	formattedCode, err := format.Source([]byte(code))
+	if err != nil {
+		log.Fatalf("Failed to format generated code: %v\nCode:\n%s", err, code)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(*outputPath), 0755); err != nil {
+		log.Fatalf("Failed to create output directory: %v", err)
+	}
+
+	if err := os.WriteFile(*outputPath, formattedCode, 0600); err != nil {
+		log.Fatalf("Failed to write output file: %v", err)
+	}
+
+	fmt.Printf("✓ Generated %s with %d models and %d operations\n", *outputPath, len(spec.Definitions), g.operationCount)
+}
+
+type generator struct {
+	spec           *Spec
+	typeNames      map[string]string // definition key -> Go type name
+	operationCount int
+	usesURL        bool
+	usesStrconv    bool
+}
+
+func newGenerator(spec *Spec) *generator {
+	g := &generator{spec: spec, typeNames: make(map[string]string)}
+
+	// Drop the Go package prefix from definition names unless two packages
+	// define the same name
+	counts := make(map[string]int)
+	for key := range spec.Definitions {
+		counts[shortName(key)]++
+	}
+	for key := range spec.Definitions {
+		name := exportName(shortName(key))
+		if counts[shortName(key)] > 1 {
+			name = exportName(strings.ReplaceAll(key, ".", "_"))
+		}
+		g.typeNames[key] = name
+	}
+	return g
+}
+
+func (g *generator) generate() (string, error) {
+	var models, ops strings.Builder
+
+	g.writeModels(&models)
+	if err := g.writeOperations(&ops); err != nil {
+		return "", err
+	}
+
+	var sb strings.Builder
+	sb.WriteString("// Code generated by cmd/gen-apiclient from docs/swagger/swagger.json. DO NOT EDIT.\n\n")
+	sb.WriteString("package apiclient\n\nimport (\n\t\"context\"\n\t\"net/http\"\n")
+	if g.usesURL {
+		sb.WriteString("\t\"net/url\"\n")
+	}
+	if g.usesStrconv {
+		sb.WriteString("\t\"strconv\"\n")
+	}
+	sb.WriteString(")\n")
+	sb.WriteString(models.String())
+	sb.WriteString(ops.String())
+	return sb.String(), nil
+}
+
+func (g *generator) writeModels(sb *strings.Builder) {
+	keys := sortedKeys(g.spec.Definitions)
+	sort.Slice(keys, func(i, j int) bool { return g.typeNames[keys[i]] < g.typeNames[keys[j]] })
+
+	for _, key := range keys {
+		def := g.spec.Definitions[key]
+		name := g.typeNames[key]
+
+		sb.WriteString("\n")
+		writeComment(sb, "", name, def.Description, "is the "+key+" model")
+
+		if def.Type != "" && def.Type != "object" {
+			fmt.Fprintf(sb, "type %s %s\n", name, primitiveType(def.Type, def.Format))
+			g.writeEnumConsts(sb, name, def)
+			continue
+		}
+
+		fmt.Fprintf(sb, "type %s struct {\n", name)
+		required := make(map[string]bool, len(def.Required))
+		for _, r := range def.Required {
+			required[r] = true
+		}
+		for _, prop := range sortedKeys(def.Properties) {
+			schema := def.Properties[prop]
+			if !redundantComment(schema.Description, prop) {
+				writeComment(sb, "\t", "", schema.Description, "")
+			}
+			tag := prop
+			if !required[prop] {
+				tag += ",omitempty"
+			}
+			fmt.Fprintf(sb, "\t%s %s `json:\"%s\"`\n", exportName(prop), g.fieldType(schema), tag)
+		}
+		sb.WriteString("}\n")
+	}
+}
+
+func (g *generator) writeEnumConsts(sb *strings.Builder, typeName string, def *Schema) {
+	if len(def.Enum) == 0 || len(def.EnumVarNames) != len(def.Enum) {
+		return
+	}
+	fmt.Fprintf(sb, "\n// %s values\nconst (\n", typeName)
+	for i, v := range def.Enum {
+		value, err := json.Marshal(v)
+		if err != nil {
+			continue
+		}
+		fmt.Fprintf(sb, "\t%s %s = %s\n", def.EnumVarNames[i], typeName, value)
+	}
+	sb.WriteString(")\n")
+}
+
+// opInfo is everything needed to write one operation method
+type opInfo struct {
+	Name       string
+	Method     string
+	Path       string
+	Op         *Operation
+	PathParams []Parameter
+	Query      []Parameter
+	Body       *Parameter
+	Result     string
+}
+
+func (g *generator) writeOperations(sb *strings.Builder) error {
+	var infos []opInfo
+	seen := make(map[string]string)
+	for _, path := range sortedKeys(g.spec.Paths) {
+		for _, method := range sortedKeys(g.spec.Paths[path]) {
+			op := g.spec.Paths[path][method]
+			info := opInfo{
+				Name:   operationName(method, path),
+				Method: strings.ToUpper(method),
+				Path:   path,
+				Op:     op,
+			}
+			if prev, ok := seen[info.Name]; ok {
+				return fmt.Errorf("operation name %s used by both %s and %s %s", info.Name, prev, info.Method, path)
+			}
+			seen[info.Name] = info.Method + " " + path
+
+			for i := range op.Parameters {
+				p := op.Parameters[i]
+				switch p.In {
+				case "path":
+					info.PathParams = append(info.PathParams, p)
+				case "query":
+					info.Query = append(info.Query, p)
+				case "body":
+					info.Body = &p
+				}
+			}
+			info.Result = g.resultType(op)
+			infos = append(infos, info)
+		}
+	}
+
+	sort.Slice(infos, func(i, j int) bool { return infos[i].Name < infos[j].Name })
+	for _, info := range infos {
+		if len(info.Query) > 0 {
+			g.writeParamsStruct(sb, info)
+		}
+		g.writeMethod(sb, info)
+	}
+	g.operationCount = len(infos)
+	return nil
+}
+
+func (g *generator) writeParamsStruct(sb *strings.Builder, info opInfo) {
+	fmt.Fprintf(sb, "\n// %sParams are the query parameters for %s\ntype %sParams struct {\n", info.Name, info.Name, info.Name)
+	for _, p := range info.Query {
+		if !redundantComment(p.Description, p.Name) {
+			writeComment(sb, "\t", "", p.Description, "")
+		}
+		fmt.Fprintf(sb, "\t%s %s\n", exportName(p.Name), primitiveType(p.Type, p.Format))
+	}
+	sb.WriteString("}\n")
+}
+
+func (g *generator) writeMethod(sb *strings.Builder, info opInfo) {
+	args := []string{"ctx context.Context"}
+	for _, p := range info.PathParams {
+		args = append(args, paramName(p.Name)+" "+primitiveType(p.Type, p.Format))
+	}
+	if len(info.Query) > 0 {
+		args = append(args, "params "+info.Name+"Params")
+	}
+	if info.Body != nil {
+		args = append(args, "body "+g.fieldType(info.Body.Schema))
+	}
+
+	returns := "error"
+	if info.Result != "" {
+		returns = "(" + info.Result + ", error)"
+	}
+
+	summary := strings.TrimSuffix(info.Op.Summary, ".")
+	fmt.Fprintf(sb, "\n// %s calls %s %s", info.Name, info.Method, info.Path)
+	if summary != "" {
+		fmt.Fprintf(sb, " (%s)", summary)
+	}
+	fmt.Fprintf(sb, "\nfunc (c *Client) %s(%s) %s {\n", info.Name, strings.Join(args, ", "), returns)
+
+	fmt.Fprintf(sb, "\tpath := %s\n", g.pathExpr(info))
+	if len(info.Query) > 0 {
+		g.usesURL = true
+		sb.WriteString("\tquery := url.Values{}\n")
+		for _, p := range info.Query {
+			g.writeQuerySet(sb, p)
+		}
+		sb.WriteString("\tif len(query) > 0 {\n\t\tpath += \"?\" + query.Encode()\n\t}\n")
+	}
+
+	body := "nil"
+	if info.Body != nil {
+		body = "body"
+	}
+	method := "http.Method" + strings.ToUpper(info.Method[:1]) + strings.ToLower(info.Method[1:])
+
+	if info.Result == "" {
+		fmt.Fprintf(sb, "\treturn c.Do(ctx, %s, path, %s, nil)\n}\n", method, body)
+		return
+	}
+
+	elem := strings.TrimPrefix(info.Result, "*")
+	fmt.Fprintf(sb, "\tvar out %s\n", elem)
+	fmt.Fprintf(sb, "\tif err := c.Do(ctx, %s, path, %s, &out); err != nil {\n\t\treturn nil, err\n\t}\n", method, body)
+	if strings.HasPrefix(info.Result, "*") {
+		sb.WriteString("\treturn &out, nil\n}\n")
+	} else {
+		sb.WriteString("\treturn out, nil\n}\n")
+	}
+}
+
+func (g *generator) writeQuerySet(sb *strings.Builder, p Parameter) {
+	field := "params." + exportName(p.Name)
+	var value, zeroCheck string
+	switch primitiveType(p.Type, p.Format) {
+	case "int":
+		g.usesStrconv = true
+		value, zeroCheck = "strconv.Itoa("+field+")", field+" != 0"
+	case "int64":
+		g.usesStrconv = true
+		value, zeroCheck = "strconv.FormatInt("+field+", 10)", field+" != 0"
+	case "float64":
+		g.usesStrconv = true
+		value, zeroCheck = "strconv.FormatFloat("+field+", 'f', -1, 64)", field+" != 0"
+	case "bool":
+		g.usesStrconv = true
+		value, zeroCheck = "strconv.FormatBool("+field+")", field
+	default:
+		value, zeroCheck = field, field+` != ""`
+	}
+
+	if p.Required {
+		fmt.Fprintf(sb, "\tquery.Set(%q, %s)\n", p.Name, value)
+		return
+	}
+	fmt.Fprintf(sb, "\tif %s {\n\t\tquery.Set(%q, %s)\n\t}\n", zeroCheck, p.Name, value)
+}
+
+// pathExpr builds a Go expression for the request path with path parameters
+// escaped in place
+func (g *generator) pathExpr(info opInfo) string {
+	if len(info.PathParams) == 0 {
+		return strconv.Quote(info.Path)
+	}
+
+	types := make(map[string]string, len(info.PathParams))
+	for _, p := range info.PathParams {
+		types[p.Name] = primitiveType(p.Type, p.Format)
+	}
+
+	var parts []string
+	rest := info.Path
+	for {
+		start := strings.Index(rest, "{")
+		if start < 0 {
+			break
+		}
+		end := strings.Index(rest[start:], "}") + start
+		if start > 0 {
+			parts = append(parts, strconv.Quote(rest[:start]))
+		}
+		name := rest[start+1 : end]
+		switch types[name] {
+		case "int":
+			g.usesStrconv = true
+			parts = append(parts, "strconv.Itoa("+paramName(name)+")")
+		case "int64":
+			g.usesStrconv = true
+			parts = append(parts, "strconv.FormatInt("+paramName(name)+", 10)")
+		default:
+			g.usesURL = true
+			parts = append(parts, "url.PathEscape("+paramName(name)+")")
+		}
+		rest = rest[end+1:]
+	}
+	if rest != "" {
+		parts = append(parts, strconv.Quote(rest))
+	}
+	return strings.Join(parts, " + ")
+}
+
+// resultType is the Go return type of the lowest 2xx response with a body
+func (g *generator) resultType(op *Operation) string {
+	for _, code := range sortedKeys(op.Responses) {
+		if !strings.HasPrefix(code, "2") || op.Responses[code].Schema == nil {
+			continue
+		}
+		schema := op.Responses[code].Schema
+		if ref := refOf(schema); ref != "" && !g.isPrimitiveDef(ref) {
+			return "*" + g.typeNames[ref]
+		}
+		return g.fieldType(schema)
+	}
+	return ""
+}
+
+// fieldType maps a schema to a Go type. Struct references are pointers so
+// optional nested objects stay nil.
+func (g *generator) fieldType(s *Schema) string {
+	if s == nil {
+		return "interface{}"
+	}
+	if ref := refOf(s); ref != "" {
+		if g.isPrimitiveDef(ref) {
+			return g.typeNames[ref]
+		}
+		return "*" + g.typeNames[ref]
+	}
+
+	switch s.Type {
+	case "array":
+		return "[]" + strings.TrimPrefix(g.fieldType(s.Items), "*")
+	case "object":
+		var additional Schema
+		if len(s.AdditionalProperties) > 0 && json.Unmarshal(s.AdditionalProperties, &additional) == nil {
+			return "map[string]" + strings.TrimPrefix(g.fieldType(&additional), "*")
+		}
+		return "map[string]interface{}"
+	case "":
+		return "interface{}"
+	}
+	return primitiveType(s.Type, s.Format)
+}
+
+func (g *generator) isPrimitiveDef(ref string) bool {
+	def, ok := g.spec.Definitions[ref]
+	return ok && def.Type != "" && def.Type != "object"
+}
+
+// refOf returns the definition key a schema points at, looking through the
+// single-element allOf swag emits for documented references
+func refOf(s *Schema) string {
+	if s.Ref != "" {
+		return strings.TrimPrefix(s.Ref, "#/definitions/")
+	}
+	for _, sub := range s.AllOf {
+		if sub.Ref != "" {
+			return strings.TrimPrefix(sub.Ref, "#/definitions/")
+		}
+	}
+	return ""
+}
+
+func primitiveType(typ, format string) string {
+	switch typ {
+	case "integer":
+		if format == "int64" {
+			return "int64"
+		}
+		return "int"
+	case "number":
+		return "float64"
+	case "boolean":
+		return "bool"
+	case "string":
+		return "string"
+	}
+	return "interface{}"
+}
+
+// operationName derives a method name from the HTTP method and path, e.g.
+// DELETE /api/v1/user/reminders/{id} -> DeleteUserRemindersByID
+func operationName(method, path string) string {
+	var sb strings.Builder
+	sb.WriteString(exportName(strings.ToLower(method)))
+	for _, segment := range strings.Split(strings.TrimPrefix(path, apiPrefix), "/") {
+		if segment == "" {
+			continue
+		}
+		if strings.HasPrefix(segment, "{") && strings.HasSuffix(segment, "}") {
+			sb.WriteString("By" + exportName(strings.Trim(segment, "{}")))
+			continue
+		}
+		sb.WriteString(exportName(segment))
+	}
+	return sb.String()
+}
+
+// initialisms are written in upper case in Go names
+var initialisms = map[string]bool{
+	"api": true, "db": true, "dlq": true, "http": true, "id": true, "ip": true,
+	"json": true, "sse": true, "ttl": true, "url": true, "uuid": true, "xp": true,
+}
+
+// exportName converts snake, kebab or dotted names to an exported Go name
+func exportName(s string) string {
+	words := strings.FieldsFunc(s, func(r rune) bool {
+		return r == '_' || r == '-' || r == '.' || r == ' '
+	})
+	var sb strings.Builder
+	for _, w := range words {
+		if initialisms[strings.ToLower(w)] {
+			sb.WriteString(strings.ToUpper(w))
+			continue
+		}
+		runes := []rune(w)
+		runes[0] = unicode.ToUpper(runes[0])
+		sb.WriteString(string(runes))
+	}
+	name := sb.String()
+	if name == "" || !unicode.IsLetter([]rune(name)[0]) {
+		name = "X" + name
+	}
+	return name
+}
+
+// paramName converts a parameter name to an unexported Go identifier
+func paramName(s string) string {
+	name := exportName(s)
+	for i, r := range name {
+		if !unicode.IsUpper(r) {
+			if i > 1 {
+				i--
+			}
+			return strings.ToLower(name[:i]) + name[i:]
+		}
+	}
+	return strings.ToLower(name)
+}
+
+func shortName(key string) string {
+	if i := strings.LastIndex(key, "."); i >= 0 {
+		return key[i+1:]
+	}
+	return key
+}
+
+func writeComment(sb *strings.Builder, indent, name, description, fallback string) {
+	text := strings.TrimSpace(description)
+	if text == "" {
+		text = fallback
+	}
+	if text == "" {
+		return
+	}
+	if name != "" && !strings.HasPrefix(text, name+" ") {
+		text = name + " " + lowerFirst(text)
+	}
+	for _, line := range strings.Split(text, "\n") {
+		fmt.Fprintf(sb, "%s// %s\n", indent, strings.TrimSpace(line))
+	}
+}
+
+// redundantComment reports whether a description is empty or only repeats the
+// field name
+func redundantComment(description, name string) bool {
+	return exportName(strings.TrimSpace(description)) == exportName(name)
+}
+
+func lowerFirst(s string) string {
+	if s == "" || strings.HasPrefix(s, "is the ") {
+		return s
+	}
+	runes := []rune(s)
+	if len(runes) > 1 && unicode.IsUpper(runes[1]) {
+		return s // Leave acronyms alone
+	}
+	runes[0] = unicode.ToLower(runes[0])
+	return string(runes)
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -80,6 +80,8 @@ func generateKeysFile(tree ProgressionTree) string {
 
 	sb.WriteString(")\n")
 
+	writeKeyList(&sb, tree)
+
 	return sb.String()
 }
 
@@ -151,6 +153,22 @@ func writeJobKeys(sb *strings.Builder, groups map[string][]Node) {
 	}
 }
 
+// writeKeyList emits every generated key so config checks can compare them against the tree
+func writeKeyList(sb *strings.Builder, tree ProgressionTree) {
+	keys := make([]string, 0, len(tree.Nodes))
+	for _, node := range tree.Nodes {
+		keys = append(keys, node.Key)
+	}
+	sort.Strings(keys)
+
+	sb.WriteString("\n// GeneratedKeys lists every key above, sorted.\n")
+	sb.WriteString("var GeneratedKeys = []string{\n")
+	for _, key := range keys {
+		sb.WriteString(fmt.Sprintf("\t%q,\n", key))
+	}
+	sb.WriteString("}\n")
+}
+
 // stripPrefix removes a prefix from a string if present
 func stripPrefix(s, prefix string) string {
 	if strings.HasPrefix(s, prefix) {
@@ -0,0 +1,121 @@
+// Command simulate is a load-test harness that runs virtual users against a
+// live Core API. Each user follows a behavior profile (a weighted mix of
+// search, craft, gamble and vote) and the run ends with a latency and error
+// distribution report per action.
+//
+// Usage:
+//
+//	go run ./cmd/simulate -users 50 -duration 2m -profile grinder,gambler
+//	go run ./cmd/simulate -mix search=5,vote=1 -think 500ms
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/joho/godotenv"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/pkg/apiclient"
+)
+
+func main() {
+	// Load .env file if it exists
+	_ = godotenv.Load()
+
+	var (
+		baseURL   = flag.String("url", envOr("API_URL", "http://localhost:8080"), "Core API base URL")
+		apiKey    = flag.String("key", os.Getenv("API_KEY"), "API key (defaults to $API_KEY)")
+		users     = flag.Int("users", 10, "number of virtual users")
+		duration  = flag.Duration("duration", time.Minute, "how long to run")
+		profile   = flag.String("profile", "balanced", "comma-separated profiles assigned round-robin ("+strings.Join(profileNames(), ", ")+")")
+		mix       = flag.String("mix", "", "custom action weights for every user, e.g. search=5,gamble=1 (overrides -profile)")
+		think     = flag.Duration("think", 0, "override the profile think time between actions")
+		platform  = flag.String("platform", domain.PlatformTwitch, "platform the virtual users are on")
+		prefix    = flag.String("prefix", "simbot", "username and platform ID prefix for virtual users")
+		craftItem = flag.String("craft-item", domain.ItemLootbox0, "item the craft action upgrades")
+		betItem   = flag.String("bet-item", domain.ItemLootbox1, "item bet when starting a gamble")
+		timeout   = flag.Duration("timeout", apiclient.DefaultTimeout, "per-request timeout")
+		retries   = flag.Int("retries", 0, "client retries on 5xx and connection errors")
+		seed      = flag.Int64("seed", time.Now().UnixNano(), "random seed for a repeatable action sequence")
+	)
+	flag.Parse()
+
+	if *users < 1 {
+		log.Fatal("-users must be at least 1")
+	}
+
+	profiles, err := resolveProfiles(*profile, *mix, *think)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	client := apiclient.New(strings.TrimRight(*baseURL, "/"), *apiKey)
+	client.HTTPClient.Timeout = *timeout
+	client.MaxRetries = *retries
+
+	cfg := Config{
+		Users:     *users,
+		Duration:  *duration,
+		Platform:  *platform,
+		Prefix:    *prefix,
+		CraftItem: *craftItem,
+		BetItem:   *betItem,
+		Seed:      *seed,
+		Profiles:  profiles,
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	names := make([]string, len(profiles))
+	for i, p := range profiles {
+		names[i] = p.Name
+	}
+	fmt.Printf("Simulating %d users against %s for %s (profiles: %s, seed: %d)\n",
+		cfg.Users, *baseURL, cfg.Duration, strings.Join(names, ", "), cfg.Seed)
+
+	rec := NewRecorder()
+	start := time.Now()
+	Run(ctx, client, cfg, rec)
+	rec.WriteReport(os.Stdout, time.Since(start))
+}
+
+// resolveProfiles applies -mix and -think on top of the -profile list
+func resolveProfiles(profileSpec, mixSpec string, think time.Duration) ([]Profile, error) {
+	if mixSpec != "" {
+		if think == 0 {
+			think = builtinProfiles["balanced"].ThinkTime
+		}
+		p, err := parseMix(mixSpec, think)
+		if err != nil {
+			return nil, err
+		}
+		return []Profile{p}, nil
+	}
+
+	profiles, err := parseProfiles(profileSpec)
+	if err != nil {
+		return nil, err
+	}
+	if think > 0 {
+		for i := range profiles {
+			profiles[i].ThinkTime = think
+		}
+	}
+	return profiles, nil
+}
+
+func envOr(key, fallback string) string {
+	if v := os.Getenv(key); v != "" {
+		return v
+	}
+	return fallback
+}
@@ -0,0 +1,158 @@
+package main
+
+import (
+	"fmt"
+	"math/rand"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Action is one kind of request a virtual user can make
+type Action string
+
+// Actions the simulator knows how to perform
+const (
+	ActionSearch Action = "search"
+	ActionCraft  Action = "craft"
+	ActionGamble Action = "gamble"
+	ActionVote   Action = "vote"
+)
+
+// allActions lists every action in report order
+var allActions = []Action{ActionSearch, ActionCraft, ActionGamble, ActionVote}
+
+// Profile describes how a virtual user behaves: how often it picks each
+// action and how long it waits between actions
+type Profile struct {
+	Name      string
+	Weights   map[Action]int
+	ThinkTime time.Duration
+}
+
+// builtinProfiles are the profiles selectable by name with -profile
+var builtinProfiles = map[string]Profile{
+	"balanced": {
+		Name:      "balanced",
+		Weights:   map[Action]int{ActionSearch: 4, ActionCraft: 2, ActionGamble: 2, ActionVote: 1},
+		ThinkTime: 2 * time.Second,
+	},
+	"grinder": {
+		Name:      "grinder",
+		Weights:   map[Action]int{ActionSearch: 8, ActionCraft: 1, ActionVote: 1},
+		ThinkTime: time.Second,
+	},
+	"crafter": {
+		Name:      "crafter",
+		Weights:   map[Action]int{ActionSearch: 3, ActionCraft: 6, ActionVote: 1},
+		ThinkTime: 2 * time.Second,
+	},
+	"gambler": {
+		Name:      "gambler",
+		Weights:   map[Action]int{ActionSearch: 2, ActionGamble: 7, ActionVote: 1},
+		ThinkTime: 3 * time.Second,
+	},
+	"voter": {
+		Name:      "voter",
+		Weights:   map[Action]int{ActionSearch: 1, ActionVote: 5},
+		ThinkTime: 5 * time.Second,
+	},
+}
+
+// profileNames returns the built-in profile names, sorted
+func profileNames() []string {
+	names := make([]string, 0, len(builtinProfiles))
+	for name := range builtinProfiles {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// parseProfiles resolves a comma-separated list of built-in profile names.
+// Virtual users are assigned the profiles round-robin.
+func parseProfiles(spec string) ([]Profile, error) {
+	var profiles []Profile
+	for _, name := range strings.Split(spec, ",") {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+		p, ok := builtinProfiles[name]
+		if !ok {
+			return nil, fmt.Errorf("unknown profile %q (available: %s)", name, strings.Join(profileNames(), ", "))
+		}
+		profiles = append(profiles, p)
+	}
+	if len(profiles) == 0 {
+		return nil, fmt.Errorf("no profiles given")
+	}
+	return profiles, nil
+}
+
+// parseMix builds a custom profile from "action=weight" pairs, e.g.
+// "search=5,gamble=1"
+func parseMix(spec string, thinkTime time.Duration) (Profile, error) {
+	p := Profile{Name: "custom", Weights: map[Action]int{}, ThinkTime: thinkTime}
+	for _, pair := range strings.Split(spec, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+		key, value, ok := strings.Cut(pair, "=")
+		if !ok {
+			return Profile{}, fmt.Errorf("invalid mix entry %q, expected action=weight", pair)
+		}
+		action := Action(strings.TrimSpace(key))
+		if !isKnownAction(action) {
+			return Profile{}, fmt.Errorf("unknown action %q in mix", key)
+		}
+		weight, err := strconv.Atoi(strings.TrimSpace(value))
+		if err != nil || weight < 0 {
+			return Profile{}, fmt.Errorf("invalid weight %q for %s", value, action)
+		}
+		p.Weights[action] = weight
+	}
+	if p.totalWeight() == 0 {
+		return Profile{}, fmt.Errorf("mix %q has no positive weights", spec)
+	}
+	return p, nil
+}
+
+func isKnownAction(a Action) bool {
+	for _, known := range allActions {
+		if a == known {
+			return true
+		}
+	}
+	return false
+}
+
+func (p Profile) totalWeight() int {
+	total := 0
+	for _, w := range p.Weights {
+		total += w
+	}
+	return total
+}
+
+// pick chooses an action at random, proportionally to the profile weights
+func (p Profile) pick(rng *rand.Rand) Action {
+	n := rng.Intn(p.totalWeight())
+	for _, a := range allActions {
+		n -= p.Weights[a]
+		if n < 0 {
+			return a
+		}
+	}
+	return allActions[0]
+}
+
+// think returns a jittered pause of 50%-150% of the profile's think time
+func (p Profile) think(rng *rand.Rand) time.Duration {
+	if p.ThinkTime <= 0 {
+		return 0
+	}
+	return p.ThinkTime/2 + time.Duration(rng.Int63n(int64(p.ThinkTime)))
+}
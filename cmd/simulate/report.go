@@ -0,0 +1,172 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"sync"
+	"text/tabwriter"
+	"time"
+
+	"github.com/osse101/BrandishBot_Go/pkg/apiclient"
+)
+
+// Outcome labels that are not API error codes
+const (
+	OutcomeOK        = "OK"
+	OutcomeTransport = "TRANSPORT_ERROR"
+)
+
+// Recorder collects latencies and outcomes from all virtual users
+type Recorder struct {
+	mu        sync.Mutex
+	latencies map[Action][]time.Duration
+	outcomes  map[Action]map[string]int
+}
+
+// NewRecorder creates an empty recorder
+func NewRecorder() *Recorder {
+	return &Recorder{
+		latencies: make(map[Action][]time.Duration),
+		outcomes:  make(map[Action]map[string]int),
+	}
+}
+
+// Record stores the result of one action
+func (r *Recorder) Record(action Action, latency time.Duration, err error) {
+	outcome := classify(err)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.latencies[action] = append(r.latencies[action], latency)
+	if r.outcomes[action] == nil {
+		r.outcomes[action] = make(map[string]int)
+	}
+	r.outcomes[action][outcome]++
+}
+
+// classify turns an action error into an outcome label: the API error code
+// when the server sent one, HTTP_<status> when it did not, or a transport error
+func classify(err error) string {
+	if err == nil {
+		return OutcomeOK
+	}
+	var apiErr *apiclient.Error
+	if errors.As(err, &apiErr) {
+		if apiErr.Code != "" {
+			return string(apiErr.Code)
+		}
+		return fmt.Sprintf("HTTP_%d", apiErr.StatusCode)
+	}
+	return OutcomeTransport
+}
+
+// ActionStats summarises one action's results
+type ActionStats struct {
+	Action   Action
+	Count    int
+	OK       int
+	P50      time.Duration
+	P90      time.Duration
+	P99      time.Duration
+	Max      time.Duration
+	Outcomes map[string]int
+}
+
+// Stats returns per-action summaries in report order, skipping actions that
+// never ran
+func (r *Recorder) Stats() []ActionStats {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var stats []ActionStats
+	for _, action := range allActions {
+		samples := r.latencies[action]
+		if len(samples) == 0 {
+			continue
+		}
+		sorted := append([]time.Duration(nil), samples...)
+		sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+
+		outcomes := make(map[string]int, len(r.outcomes[action]))
+		for k, v := range r.outcomes[action] {
+			outcomes[k] = v
+		}
+		stats = append(stats, ActionStats{
+			Action:   action,
+			Count:    len(sorted),
+			OK:       outcomes[OutcomeOK],
+			P50:      percentile(sorted, 50),
+			P90:      percentile(sorted, 90),
+			P99:      percentile(sorted, 99),
+			Max:      sorted[len(sorted)-1],
+			Outcomes: outcomes,
+		})
+	}
+	return stats
+}
+
+// percentile returns the nearest-rank percentile of sorted samples
+func percentile(sorted []time.Duration, p int) time.Duration {
+	if len(sorted) == 0 {
+		return 0
+	}
+	rank := (p*len(sorted) + 99) / 100
+	if rank < 1 {
+		rank = 1
+	}
+	return sorted[rank-1]
+}
+
+// WriteReport prints the latency table and the error distribution
+func (r *Recorder) WriteReport(w io.Writer, elapsed time.Duration) {
+	stats := r.Stats()
+	if len(stats) == 0 {
+		fmt.Fprintln(w, "No requests were made.")
+		return
+	}
+
+	total := 0
+	for _, s := range stats {
+		total += s.Count
+	}
+	fmt.Fprintf(w, "\n%d requests in %s (%.1f req/s)\n\n", total, elapsed.Round(time.Millisecond), float64(total)/elapsed.Seconds())
+
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "ACTION\tCOUNT\tOK%\tP50\tP90\tP99\tMAX")
+	for _, s := range stats {
+		fmt.Fprintf(tw, "%s\t%d\t%.1f\t%s\t%s\t%s\t%s\n",
+			s.Action, s.Count, 100*float64(s.OK)/float64(s.Count),
+			fmtLatency(s.P50), fmtLatency(s.P90), fmtLatency(s.P99), fmtLatency(s.Max))
+	}
+	_ = tw.Flush()
+
+	fmt.Fprintln(w, "\nOutcomes:")
+	tw = tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	for _, s := range stats {
+		for _, o := range sortedOutcomes(s.Outcomes) {
+			fmt.Fprintf(tw, "  %s\t%s\t%d\t%.1f%%\n", s.Action, o, s.Outcomes[o], 100*float64(s.Outcomes[o])/float64(s.Count))
+		}
+	}
+	_ = tw.Flush()
+}
+
+// sortedOutcomes orders outcomes by count, most frequent first
+func sortedOutcomes(outcomes map[string]int) []string {
+	keys := make([]string, 0, len(outcomes))
+	for k := range outcomes {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if outcomes[keys[i]] != outcomes[keys[j]] {
+			return outcomes[keys[i]] > outcomes[keys[j]]
+		}
+		return keys[i] < keys[j]
+	})
+	return keys
+}
+
+func fmtLatency(d time.Duration) string {
+	return d.Round(100 * time.Microsecond).String()
+}
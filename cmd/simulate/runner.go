@@ -0,0 +1,172 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/pkg/apiclient"
+)
+
+// Paths of endpoints the swagger spec does not describe yet
+const (
+	pathGambleStart = "/api/v1/gamble/start"
+	pathGambleJoin  = "/api/v1/gamble/join"
+)
+
+// Config controls a simulation run
+type Config struct {
+	Users     int
+	Duration  time.Duration
+	Platform  string
+	Prefix    string
+	CraftItem string
+	BetItem   string
+	Seed      int64
+	Profiles  []Profile
+}
+
+// gambleStartRequest mirrors handler.StartGambleRequest
+type gambleStartRequest struct {
+	Platform   string              `json:"platform"`
+	PlatformID string              `json:"platform_id"`
+	Username   string              `json:"username"`
+	Bets       []domain.LootboxBet `json:"bets"`
+}
+
+// gambleJoinRequest mirrors handler.JoinGambleRequest
+type gambleJoinRequest struct {
+	Platform   string `json:"platform"`
+	PlatformID string `json:"platform_id"`
+	Username   string `json:"username"`
+}
+
+// virtualUser is one simulated player
+type virtualUser struct {
+	client     *apiclient.Client
+	cfg        Config
+	profile    Profile
+	platformID string
+	username   string
+	rng        *rand.Rand
+}
+
+// Run starts cfg.Users virtual users and blocks until cfg.Duration has passed
+// or ctx is cancelled
+func Run(ctx context.Context, client *apiclient.Client, cfg Config, rec *Recorder) {
+	ctx, cancel := context.WithTimeout(ctx, cfg.Duration)
+	defer cancel()
+
+	var wg sync.WaitGroup
+	for i := 0; i < cfg.Users; i++ {
+		u := &virtualUser{
+			client:     client,
+			cfg:        cfg,
+			profile:    cfg.Profiles[i%len(cfg.Profiles)],
+			platformID: fmt.Sprintf("%s-%d", cfg.Prefix, i),
+			username:   fmt.Sprintf("%s_%d", cfg.Prefix, i),
+			rng:        rand.New(rand.NewSource(cfg.Seed + int64(i))),
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			u.loop(ctx, rec)
+		}()
+	}
+	wg.Wait()
+}
+
+func (u *virtualUser) loop(ctx context.Context, rec *Recorder) {
+	// Stagger start so users do not all fire on the first tick
+	if !sleep(ctx, u.profile.think(u.rng)) {
+		return
+	}
+	for {
+		action := u.profile.pick(u.rng)
+		start := time.Now()
+		err := u.do(ctx, action)
+		// Requests cut short by the end of the run are not results
+		if ctx.Err() != nil {
+			return
+		}
+		rec.Record(action, time.Since(start), err)
+
+		if !sleep(ctx, u.profile.think(u.rng)) {
+			return
+		}
+	}
+}
+
+func (u *virtualUser) do(ctx context.Context, action Action) error {
+	switch action {
+	case ActionSearch:
+		_, err := u.client.PostUserSearch(ctx, apiclient.PostUserSearchParams{}, &apiclient.SearchRequest{
+			Platform: u.cfg.Platform, PlatformID: u.platformID, Username: u.username,
+		})
+		return err
+	case ActionCraft:
+		_, err := u.client.PostUserItemUpgrade(ctx, &apiclient.CraftingActionRequest{
+			Platform: u.cfg.Platform, PlatformID: u.platformID, Username: u.username,
+			Item: u.cfg.CraftItem, Quantity: 1,
+		})
+		return err
+	case ActionGamble:
+		return u.gamble(ctx)
+	case ActionVote:
+		return u.vote(ctx)
+	default:
+		return fmt.Errorf("unknown action %q", action)
+	}
+}
+
+// gamble joins the active gamble, or starts one when none is open
+func (u *virtualUser) gamble(ctx context.Context) error {
+	err := u.client.Do(ctx, http.MethodPost, pathGambleJoin, &gambleJoinRequest{
+		Platform: u.cfg.Platform, PlatformID: u.platformID, Username: u.username,
+	}, nil)
+	var apiErr *apiclient.Error
+	if !errors.As(err, &apiErr) || apiErr.Code != apiclient.ErrCodeGambleNotFound {
+		return err
+	}
+	return u.client.Do(ctx, http.MethodPost, pathGambleStart, &gambleStartRequest{
+		Platform: u.cfg.Platform, PlatformID: u.platformID, Username: u.username,
+		Bets: []domain.LootboxBet{{ItemName: u.cfg.BetItem, Quantity: 1}},
+	}, nil)
+}
+
+// vote picks a random option in the current voting session
+func (u *virtualUser) vote(ctx context.Context) error {
+	resp, err := u.client.GetProgressionSession(ctx)
+	if err != nil {
+		return err
+	}
+	option := 1
+	if resp.Session != nil && len(resp.Session.Options) > 0 {
+		option = 1 + u.rng.Intn(len(resp.Session.Options))
+	}
+	_, err = u.client.PostProgressionVote(ctx, &apiclient.VoteRequest{
+		Platform: u.cfg.Platform, PlatformID: u.platformID, Username: u.username,
+		OptionIndex: option,
+	})
+	return err
+}
+
+// sleep waits for d, returning false if ctx ends first
+func sleep(ctx context.Context, d time.Duration) bool {
+	if d <= 0 {
+		return ctx.Err() == nil
+	}
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-ctx.Done():
+		return false
+	case <-t.C:
+		return true
+	}
+}
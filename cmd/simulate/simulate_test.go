@@ -0,0 +1,140 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/osse101/BrandishBot_Go/pkg/apiclient"
+)
+
+func TestParseProfiles(t *testing.T) {
+	profiles, err := parseProfiles("grinder, gambler")
+	require.NoError(t, err)
+	require.Len(t, profiles, 2)
+	assert.Equal(t, "grinder", profiles[0].Name)
+	assert.Equal(t, "gambler", profiles[1].Name)
+
+	_, err = parseProfiles("grinder,unknown")
+	assert.ErrorContains(t, err, "unknown profile")
+
+	_, err = parseProfiles(" , ")
+	assert.Error(t, err)
+}
+
+func TestParseMix(t *testing.T) {
+	p, err := parseMix("search=5, vote=1", time.Second)
+	require.NoError(t, err)
+	assert.Equal(t, map[Action]int{ActionSearch: 5, ActionVote: 1}, p.Weights)
+	assert.Equal(t, time.Second, p.ThinkTime)
+
+	for _, spec := range []string{"search", "dance=1", "search=-1", "search=0"} {
+		_, err := parseMix(spec, time.Second)
+		assert.Error(t, err, spec)
+	}
+}
+
+func TestProfilePick_OnlyWeightedActions(t *testing.T) {
+	p := Profile{Weights: map[Action]int{ActionCraft: 1, ActionVote: 3}}
+	rng := rand.New(rand.NewSource(1))
+
+	counts := map[Action]int{}
+	for i := 0; i < 4000; i++ {
+		counts[p.pick(rng)]++
+	}
+
+	assert.Zero(t, counts[ActionSearch])
+	assert.Zero(t, counts[ActionGamble])
+	assert.InDelta(t, 3.0, float64(counts[ActionVote])/float64(counts[ActionCraft]), 0.5)
+}
+
+func TestPercentile(t *testing.T) {
+	var samples []time.Duration
+	for i := 1; i <= 100; i++ {
+		samples = append(samples, time.Duration(i)*time.Millisecond)
+	}
+
+	assert.Equal(t, 50*time.Millisecond, percentile(samples, 50))
+	assert.Equal(t, 99*time.Millisecond, percentile(samples, 99))
+	assert.Equal(t, 100*time.Millisecond, percentile(samples, 100))
+	assert.Zero(t, percentile(nil, 50))
+}
+
+func TestRecorder_ClassifiesOutcomes(t *testing.T) {
+	rec := NewRecorder()
+	rec.Record(ActionSearch, time.Millisecond, nil)
+	rec.Record(ActionSearch, 2*time.Millisecond, &apiclient.Error{StatusCode: http.StatusTooManyRequests, Code: apiclient.ErrCodeOnCooldown})
+	rec.Record(ActionSearch, 3*time.Millisecond, &apiclient.Error{StatusCode: http.StatusBadGateway})
+	rec.Record(ActionSearch, 4*time.Millisecond, fmt.Errorf("dial tcp: connection refused"))
+
+	stats := rec.Stats()
+	require.Len(t, stats, 1)
+	assert.Equal(t, 4, stats[0].Count)
+	assert.Equal(t, 1, stats[0].OK)
+	assert.Equal(t, 4*time.Millisecond, stats[0].Max)
+	assert.Equal(t, map[string]int{
+		OutcomeOK:        1,
+		"ON_COOLDOWN":    1,
+		"HTTP_502":       1,
+		OutcomeTransport: 1,
+	}, stats[0].Outcomes)
+
+	var buf bytes.Buffer
+	rec.WriteReport(&buf, time.Second)
+	assert.Contains(t, buf.String(), "4 requests")
+	assert.Contains(t, buf.String(), "ON_COOLDOWN")
+}
+
+func TestRun_AgainstFakeAPI(t *testing.T) {
+	var mu sync.Mutex
+	hits := map[string]int{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		hits[r.URL.Path]++
+		mu.Unlock()
+
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/api/v1/progression/session":
+			_ = json.NewEncoder(w).Encode(apiclient.ProgressionVotingSession{Options: make([]apiclient.ProgressionVotingOption, 3)})
+		case pathGambleJoin:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"code":"GAMBLE_NOT_FOUND","message":"no active gamble"}`))
+		default:
+			_, _ = w.Write([]byte(`{}`))
+		}
+	}))
+	defer srv.Close()
+
+	profile := Profile{Name: "test", Weights: map[Action]int{ActionSearch: 1, ActionCraft: 1, ActionGamble: 1, ActionVote: 1}}
+	client := apiclient.New(srv.URL, "key")
+	client.MaxRetries = 0
+	rec := NewRecorder()
+
+	Run(context.Background(), client, Config{
+		Users: 3, Duration: 200 * time.Millisecond, Platform: "twitch", Prefix: "sim",
+		CraftItem: "lootbox_tier0", BetItem: "lootbox_tier1", Seed: 1, Profiles: []Profile{profile},
+	}, rec)
+
+	stats := rec.Stats()
+	require.NotEmpty(t, stats)
+	for _, s := range stats {
+		assert.Equal(t, s.Count, s.OK, "every %s should succeed against the fake API", s.Action)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	if hits[pathGambleJoin] > 0 {
+		assert.Positive(t, hits[pathGambleStart], "a missing gamble is started")
+	}
+}
@@ -18,85 +18,124 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
-        "/admin/jobs/reset-daily-xp": {
-            "post": {
-                "description": "Triggers an immediate reset of all users' daily XP counters",
+        "/api/v1/admin/cache/stats": {
+            "get": {
+                "description": "Returns cache hit/miss statistics for monitoring (admin only)",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "admin"
                 ],
-                "summary": "Manually trigger daily job XP reset",
+                "summary": "Get user cache stats",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": true
-                        }
-                    },
-                    "500": {
-                        "description": "Internal Server Error",
-                        "schema": {
-                            "type": "object",
-                            "additionalProperties": true
+                            "$ref": "#/definitions/user.CacheStats"
                         }
                     }
                 }
             }
         },
-        "/admin/jobs/reset-status": {
-            "get": {
-                "description": "Returns information about the last daily reset and when the next one is scheduled",
+        "/api/v1/admin/config/reload": {
+            "post": {
+                "description": "Re-reads the hot-reloadable JSON configs. Each file is validated before it replaces the running version; invalid files keep the previous version.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "admin"
                 ],
-                "summary": "Get daily reset status",
+                "summary": "Reload game data configuration",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/domain.DailyResetStatus"
+                            "$ref": "#/definitions/admin.ConfigReloadResponse"
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "422": {
+                        "description": "Unprocessable Entity",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": true
+                            "$ref": "#/definitions/admin.ConfigReloadResponse"
                         }
                     }
-                }
+                },
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ]
             }
         },
-        "/admin/progression/reload-weights": {
-            "post": {
-                "description": "Invalidate engagement weight cache to force reload from database (admin only)",
+        "/api/v1/admin/events": {
+            "get": {
+                "description": "Query the event log, newest first",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "progression",
                     "admin"
                 ],
-                "summary": "Admin reload weights",
+                "summary": "List event log entries",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Only events for this user",
+                        "name": "user_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only events of this type",
+                        "name": "event_type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only events at or after this time (RFC3339)",
+                        "name": "since",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only events before this time (RFC3339)",
+                        "name": "until",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Number of events (default 50, max 1000)",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.SuccessResponse"
+                            "$ref": "#/definitions/admin.EventsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
                         }
                     }
                 }
             }
         },
-        "/admin/reload-aliases": {
+        "/api/v1/admin/jobs/award-xp": {
             "post": {
-                "description": "Reloads the item name aliases and themes from JSON configuration files",
+                "description": "Award job XP to a user identified by platform and username (admin only)",
                 "consumes": [
                     "application/json"
                 ],
@@ -106,69 +145,114 @@ const docTemplate = `{
                 "tags": [
                     "admin"
                 ],
-                "summary": "Reload alias configuration",
+                "summary": "Award job XP",
+                "parameters": [
+                    {
+                        "description": "XP award",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/admin.AdminAwardXPRequest"
+                        }
+                    }
+                ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": true
+                            "$ref": "#/definitions/admin.AdminAwardXPResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "User not found",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/handler.ErrorResponse"
                         }
                     }
-                },
-                "security": [
-                    {
-                        "ApiKeyAuth": []
-                    }
-                ]
+                }
             }
         },
-        "/admin/timeout/clear": {
+        "/api/v1/admin/jobs/reset-daily-xp": {
             "post": {
-                "description": "Remove a user's timeout (admin only)",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Triggers an immediate reset of all users' daily XP counters",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "admin"
                 ],
-                "summary": "Clear user timeout",
-                "parameters": [
-                    {
-                        "description": "Clear timeout request",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
+                "summary": "Manually trigger daily job XP reset",
+                "responses": {
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/admin.ClearTimeoutRequest"
+                            "$ref": "#/definitions/admin.ManualResetResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
                         }
                     }
+                }
+            }
+        },
+        "/api/v1/admin/jobs/reset-status": {
+            "get": {
+                "description": "Returns information about the last daily reset and when the next one is scheduled",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "admin"
                 ],
+                "summary": "Get daily reset status",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": true
+                            "$ref": "#/definitions/domain.DailyResetStatus"
                         }
                     },
-                    "400": {
-                        "description": "Bad Request",
+                    "500": {
+                        "description": "Internal Server Error",
                         "schema": {
                             "$ref": "#/definitions/handler.ErrorResponse"
                         }
+                    }
+                }
+            }
+        },
+        "/api/v1/admin/metrics": {
+            "get": {
+                "description": "Summarises the HTTP, event, business and SSE metrics for the admin dashboard",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "admin"
+                ],
+                "summary": "Get dashboard metrics",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/admin.MetricsResponse"
+                        }
                     },
                     "500": {
                         "description": "Internal Server Error",
@@ -179,26 +263,65 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/admin/cache/stats": {
-            "get": {
-                "description": "Returns cache hit/miss statistics for monitoring (admin only)",
+        "/api/v1/admin/progression/reload-weights": {
+            "post": {
+                "description": "Invalidate engagement weight cache to force reload from database (admin only)",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
+                    "progression",
                     "admin"
                 ],
-                "summary": "Get user cache stats",
+                "summary": "Admin reload weights",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/user.CacheStats"
+                            "$ref": "#/definitions/handler.SuccessResponse"
                         }
                     }
                 }
             }
         },
+        "/api/v1/admin/reload-aliases": {
+            "post": {
+                "description": "Reloads the item name aliases and themes from JSON configuration files",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "admin"
+                ],
+                "summary": "Reload alias configuration",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ]
+            }
+        },
         "/api/v1/admin/simulate/capabilities": {
             "get": {
                 "description": "Returns all available scenario capabilities for UI rendering",
@@ -378,9 +501,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/harvest": {
+        "/api/v1/admin/sse/broadcast": {
             "post": {
-                "description": "Collect rewards that have accumulated since the last harvest",
+                "description": "Send a manual event to the SSE clients of the request's community",
                 "consumes": [
                     "application/json"
                 ],
@@ -388,35 +511,29 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "harvest"
+                    "admin"
                 ],
-                "summary": "Harvest accumulated rewards",
+                "summary": "Broadcast an SSE event",
                 "parameters": [
                     {
-                        "description": "Harvest request",
+                        "description": "Event type and payload",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/handler.HarvestRewardsRequest"
+                            "$ref": "#/definitions/admin.SSEBroadcastRequest"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Harvest successful",
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/domain.HarvestResponse"
+                            "$ref": "#/definitions/admin.SSEBroadcastResponse"
                         }
                     },
                     "400": {
-                        "description": "Invalid request or harvest too soon",
-                        "schema": {
-                            "$ref": "#/definitions/handler.ErrorResponse"
-                        }
-                    },
-                    "500": {
-                        "description": "Internal server error",
+                        "description": "Bad Request",
                         "schema": {
                             "$ref": "#/definitions/handler.ErrorResponse"
                         }
@@ -424,9 +541,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/message/handle": {
+        "/api/v1/admin/timeout/clear": {
             "post": {
-                "description": "Process a chat message for potential commands or triggers",
+                "description": "Remove a user's timeout (admin only)",
                 "consumes": [
                     "application/json"
                 ],
@@ -434,17 +551,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "message"
+                    "admin"
                 ],
-                "summary": "Handle chat message",
+                "summary": "Clear user timeout",
                 "parameters": [
                     {
-                        "description": "Message details",
+                        "description": "Clear timeout request",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/handler.HandleMessageRequest"
+                            "$ref": "#/definitions/admin.ClearTimeoutRequest"
                         }
                     }
                 ],
@@ -452,7 +569,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/domain.MessageResult"
+                            "$ref": "#/definitions/admin.ClearTimeoutResponse"
                         }
                     },
                     "400": {
@@ -470,35 +587,74 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/prediction": {
-            "post": {
-                "description": "Convert channel points to progression contribution and award XP to participants",
-                "consumes": [
-                    "application/json"
-                ],
+        "/api/v1/admin/users/active": {
+            "get": {
+                "description": "List the users who recently sent chat messages",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "prediction"
+                    "admin"
                 ],
-                "summary": "Process prediction outcome",
+                "summary": "List active chatters",
                 "parameters": [
                     {
-                        "description": "Prediction outcome data",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
+                        "type": "integer",
+                        "description": "Only chatters seen in the last N minutes (default all tracked chatters)",
+                        "name": "minutes",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/domain.PredictionOutcomeRequest"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/activechatter.Chatter"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
                         }
                     }
+                }
+            }
+        },
+        "/api/v1/admin/users/lookup": {
+            "get": {
+                "description": "Find a user by platform and username",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "admin"
+                ],
+                "summary": "Look up a user",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Platform (twitch, youtube, discord)",
+                        "name": "platform",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Username",
+                        "name": "username",
+                        "in": "query",
+                        "required": true
+                    }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/domain.PredictionResult"
+                            "$ref": "#/definitions/admin.UserLookupResponse"
                         }
                     },
                     "400": {
@@ -507,8 +663,8 @@ const docTemplate = `{
                             "$ref": "#/definitions/handler.ErrorResponse"
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
                             "$ref": "#/definitions/handler.ErrorResponse"
                         }
@@ -516,26 +672,40 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/prices": {
+        "/api/v1/admin/users/recent": {
             "get": {
-                "description": "Get current sell prices for items",
+                "description": "List the users who were active most recently",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "economy"
+                    "admin"
+                ],
+                "summary": "List recent users",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Number of users (default 10, max 100)",
+                        "name": "limit",
+                        "in": "query"
+                    }
                 ],
-                "summary": "Get item prices",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/domain.Item"
+                                "$ref": "#/definitions/domain.User"
                             }
                         }
                     },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -545,24 +715,21 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/prices/buy": {
+        "/api/v1/announcements/discord-events": {
             "get": {
-                "description": "Get current buy prices for items",
+                "description": "The SSE event types that announcement routes post to Discord channels. The Discord bot skips its built-in announcement for these, and reloads the list on announcement.routes_changed events.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "economy"
+                    "announcements"
                 ],
-                "summary": "Get item buy prices",
+                "summary": "List events routed to Discord",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/domain.Item"
-                            }
+                            "$ref": "#/definitions/handler.DiscordAnnouncementEventsResponse"
                         }
                     },
                     "500": {
@@ -574,21 +741,21 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/progression/available": {
+        "/api/v1/boss": {
             "get": {
-                "description": "Returns nodes that are available for voting (prerequisites met, not maxed out)",
+                "description": "The community's active boss with its remaining HP, reward and expiry, and the users who have dealt it the most damage. boss is left out when there is no boss to fight.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "progression"
+                    "boss"
                 ],
-                "summary": "Get available unlocks",
+                "summary": "Get boss status",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.AvailableUnlocksResponse"
+                            "$ref": "#/definitions/domain.BossStatus"
                         }
                     },
                     "500": {
@@ -600,37 +767,35 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/progression/engagement": {
-            "get": {
-                "description": "Returns user's engagement contribution breakdown by type",
+        "/api/v1/boss/attack": {
+            "post": {
+                "description": "Hits the community's active boss, on the per-user COOLDOWN_BOSS_ATTACK cooldown. A weapon (grenade, missile, bomb, tnt or hugemissile) is used up for bonus damage. The attack that brings the boss to 0 HP shares its reward lootboxes out among every attacker by damage dealt, and the response then lists each share and what it dropped.",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "progression"
+                    "boss"
                 ],
-                "summary": "Get user engagement",
+                "summary": "Attack the boss",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Platform (twitch, youtube, discord)",
-                        "name": "platform",
-                        "in": "query",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Platform-specific user ID",
-                        "name": "platform_id",
-                        "in": "query",
-                        "required": true
+                        "description": "Attacker",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.BossAttackRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/domain.ContributionBreakdown"
+                            "$ref": "#/definitions/domain.BossAttackResult"
                         }
                     },
                     "400": {
@@ -639,6 +804,18 @@ const docTemplate = `{
                             "$ref": "#/definitions/handler.ErrorResponse"
                         }
                     },
+                    "404": {
+                        "description": "No boss to fight",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -648,28 +825,47 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/progression/engagement-by-username": {
+        "/api/v1/bot/bootstrap": {
             "get": {
-                "description": "Returns user's engagement contribution breakdown by type using username",
+                "description": "Returns active gamble, voting session, unlock progress, featured shop, and feature flags in one response. Sections that fail to load are left empty and named in errors.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "progression"
+                    "bot"
                 ],
-                "summary": "Get user engagement by username",
+                "summary": "Bot bootstrap state",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.BotBootstrapResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/celebrations/birthday": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "celebrations"
+                ],
+                "summary": "Get birthday",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Platform (twitch, youtube, discord)",
+                        "description": "Platform",
                         "name": "platform",
                         "in": "query",
                         "required": true
                     },
                     {
                         "type": "string",
-                        "description": "Username",
-                        "name": "username",
+                        "description": "Platform user ID",
+                        "name": "platform_id",
                         "in": "query",
                         "required": true
                     }
@@ -678,7 +874,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/domain.ContributionBreakdown"
+                            "$ref": "#/definitions/handler.BirthdayResponse"
                         }
                     },
                     "400": {
@@ -687,6 +883,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/handler.ErrorResponse"
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -694,34 +896,41 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/api/v1/progression/leaderboard": {
-            "get": {
-                "description": "Returns top contributors by total contributions",
+            },
+            "post": {
+                "description": "Registers the month and day of a user's birthday for the daily celebration reward. The year is never stored.",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "progression"
+                    "celebrations"
                 ],
-                "summary": "Get contribution leaderboard",
+                "summary": "Set birthday",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "Number of entries (default 10, max 100)",
-                        "name": "limit",
-                        "in": "query"
+                        "description": "Birthday",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.SetBirthdayRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/domain.ContributionLeaderboardEntry"
-                            }
+                            "$ref": "#/definitions/handler.SuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
                         }
                     },
                     "500": {
@@ -731,23 +940,48 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/api/v1/progression/status": {
-            "get": {
-                "description": "Returns current community progression status including unlocks and engagement",
+            },
+            "delete": {
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "progression"
+                    "celebrations"
+                ],
+                "summary": "Clear birthday",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Platform",
+                        "name": "platform",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Platform user ID",
+                        "name": "platform_id",
+                        "in": "query",
+                        "required": true
+                    }
                 ],
-                "summary": "Get progression status",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/domain.ProgressionStatus"
+                            "$ref": "#/definitions/handler.SuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
                         }
                     },
                     "500": {
@@ -759,21 +993,29 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/progression/tree": {
+        "/api/v1/celebrations/guilds/{guildID}": {
             "get": {
-                "description": "Returns the complete progression tree with unlock status for each node",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "progression"
+                    "celebrations"
+                ],
+                "summary": "Get guild celebration setting",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Discord guild ID",
+                        "name": "guildID",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
-                "summary": "Get progression tree",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.ProgressionTreeResponse"
+                            "$ref": "#/definitions/handler.CelebrationGuildResponse"
                         }
                     },
                     "500": {
@@ -783,11 +1025,9 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/api/v1/progression/vote": {
-            "post": {
-                "description": "Cast a vote for the next unlock by selecting an option index (1-based)",
+            },
+            "put": {
+                "description": "Announcements are off until a guild opts in.",
                 "consumes": [
                     "application/json"
                 ],
@@ -795,17 +1035,24 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "progression"
+                    "celebrations"
                 ],
-                "summary": "Vote for unlock",
+                "summary": "Set guild celebration setting",
                 "parameters": [
                     {
-                        "description": "Vote request",
+                        "type": "string",
+                        "description": "Discord guild ID",
+                        "name": "guildID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Setting",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/handler.VoteRequest"
+                            "$ref": "#/definitions/handler.SetCelebrationGuildRequest"
                         }
                     }
                 ],
@@ -813,7 +1060,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.SuccessResponse"
+                            "$ref": "#/definitions/handler.CelebrationGuildResponse"
                         }
                     },
                     "400": {
@@ -831,9 +1078,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/stats/event": {
+        "/api/v1/community/donate": {
             "post": {
-                "description": "Record a custom user event",
+                "description": "Money is worth 1 per unit and items their base value. Every COMMUNITY_POOL_MONEY_PER_POINT of value adds a contribution point to the current unlock; the remainder stays in the pool. Borrowed items cannot be donated.",
                 "consumes": [
                     "application/json"
                 ],
@@ -841,17 +1088,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "stats"
+                    "progression"
                 ],
-                "summary": "Record event",
+                "summary": "Donate to community pool",
                 "parameters": [
                     {
-                        "description": "Event details",
+                        "description": "Donation",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/handler.RecordEventRequest"
+                            "$ref": "#/definitions/handler.DonateRequest"
                         }
                     }
                 ],
@@ -859,7 +1106,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.SuccessResponse"
+                            "$ref": "#/definitions/domain.CommunityDonation"
                         }
                     },
                     "400": {
@@ -868,8 +1115,14 @@ const docTemplate = `{
                             "$ref": "#/definitions/handler.ErrorResponse"
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
                         "schema": {
                             "$ref": "#/definitions/handler.ErrorResponse"
                         }
@@ -877,33 +1130,20 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/stats/leaderboard": {
+        "/api/v1/community/donors": {
             "get": {
-                "description": "Get leaderboard for a specific event type",
+                "description": "Donors ranked by the total value they have given.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "stats"
+                    "progression"
                 ],
-                "summary": "Get leaderboard",
+                "summary": "Get community pool donors",
                 "parameters": [
-                    {
-                        "type": "string",
-                        "description": "Event Type",
-                        "name": "event_type",
-                        "in": "query",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Period (daily, weekly, all_time)",
-                        "name": "period",
-                        "in": "query"
-                    },
                     {
                         "type": "integer",
-                        "description": "Limit (default 10)",
+                        "description": "Number of donors (max 100)",
                         "name": "limit",
                         "in": "query"
                     }
@@ -912,8 +1152,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": true
+                            "$ref": "#/definitions/handler.CommunityDonorsResponse"
                         }
                     },
                     "400": {
@@ -931,29 +1170,21 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/stats/system": {
+        "/api/v1/community/pool": {
             "get": {
-                "description": "Get system-wide statistics",
+                "description": "The money the pool holds, everything donated so far and the contribution points it bought.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "stats"
-                ],
-                "summary": "Get system stats",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "Period (daily, weekly, all_time)",
-                        "name": "period",
-                        "in": "query"
-                    }
+                    "progression"
                 ],
+                "summary": "Get community pool status",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/domain.StatsSummary"
+                            "$ref": "#/definitions/domain.CommunityPoolStatus"
                         }
                     },
                     "500": {
@@ -965,48 +1196,35 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/stats/user": {
-            "get": {
-                "description": "Get statistics for a specific user",
+        "/api/v1/compost/deposit": {
+            "post": {
+                "description": "Put items into the user's compost bin, starting it if it was idle",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "stats"
+                    "compost"
                 ],
-                "summary": "Get user stats",
+                "summary": "Deposit into the compost bin",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Platform (twitch, youtube, discord)",
-                        "name": "platform",
-                        "in": "query",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Platform-specific user ID (self-mode)",
-                        "name": "platform_id",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Username (target-mode)",
-                        "name": "username",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Period (daily, weekly, all_time)",
-                        "name": "period",
-                        "in": "query"
+                        "description": "Items to compost",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.CompostDepositRequest"
+                        }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "201": {
+                        "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/domain.StatsSummary"
+                            "$ref": "#/definitions/handler.CompostDepositResponse"
                         }
                     },
                     "400": {
@@ -1015,8 +1233,8 @@ const docTemplate = `{
                             "$ref": "#/definitions/handler.ErrorResponse"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "403": {
+                        "description": "Feature locked",
                         "schema": {
                             "$ref": "#/definitions/handler.ErrorResponse"
                         }
@@ -1030,9 +1248,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/subscriptions/event": {
+        "/api/v1/compost/harvest": {
             "post": {
-                "description": "Processes subscription lifecycle events (subscribed, renewed, upgraded, downgraded, cancelled)",
+                "description": "Collect the bin's output when it is ready; otherwise reports how long is left",
                 "consumes": [
                     "application/json"
                 ],
@@ -1040,25 +1258,25 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "subscriptions"
+                    "compost"
                 ],
-                "summary": "Receive subscription event from Streamer.bot",
+                "summary": "Harvest the compost bin",
                 "parameters": [
                     {
-                        "description": "Subscription event",
-                        "name": "event",
+                        "description": "Bin owner",
+                        "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/domain.SubscriptionEvent"
+                            "$ref": "#/definitions/handler.CompostHarvestRequest"
                         }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "201": {
+                        "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/handler.SuccessResponse"
+                            "$ref": "#/definitions/handler.CompostHarvestResponse"
                         }
                     },
                     "400": {
@@ -1067,6 +1285,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/handler.ErrorResponse"
                         }
                     },
+                    "403": {
+                        "description": "Feature locked",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -1076,20 +1300,20 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/subscriptions/user": {
+        "/api/v1/compost/status": {
             "get": {
-                "description": "Retrieves subscription information for a user on a specific platform",
+                "description": "The bin's state, harvesting it first when it is ready",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "subscriptions"
+                    "compost"
                 ],
-                "summary": "Get user subscription status",
+                "summary": "Get compost bin status",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Platform (twitch or youtube)",
+                        "description": "Platform (twitch, youtube, discord)",
                         "name": "platform",
                         "in": "query",
                         "required": true
@@ -1100,13 +1324,19 @@ const docTemplate = `{
                         "name": "platform_id",
                         "in": "query",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Username",
+                        "name": "username",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/domain.SubscriptionWithTier"
+                            "$ref": "#/definitions/domain.HarvestResult"
                         }
                     },
                     "400": {
@@ -1115,12 +1345,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/handler.ErrorResponse"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/handler.ErrorResponse"
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -1130,9 +1354,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/user/inventory": {
-            "get": {
-                "description": "Get the user's inventory",
+        "/api/v1/digging/dig": {
+            "post": {
+                "description": "Moves the community dig site a few metres deeper, on the per-user COOLDOWN_DIG cooldown. The first dig to reach a level grants that level's contribution toward the current unlock for everyone.",
                 "consumes": [
                     "application/json"
                 ],
@@ -1140,36 +1364,25 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "inventory"
+                    "progression"
                 ],
-                "summary": "Get inventory",
+                "summary": "Dig at the dig site",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Platform ID",
-                        "name": "platform_id",
-                        "in": "query",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Username",
-                        "name": "username",
-                        "in": "query",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Filter by item type (upgrade, sellable, consumable)",
-                        "name": "filter",
-                        "in": "query"
+                        "description": "Digger",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.DigRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.GetInventoryResponse"
+                            "$ref": "#/definitions/domain.DigResult"
                         }
                     },
                     "400": {
@@ -1178,6 +1391,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/handler.ErrorResponse"
                         }
                     },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -1187,53 +1406,48 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/user/inventory-by-username": {
+        "/api/v1/digging/site": {
             "get": {
-                "description": "Get a user's inventory using only platform and username",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "How deep the community dig site is, the level it has reached and the next one, every level with its milestone contribution, and the users who have dug the most.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "inventory"
-                ],
-                "summary": "Get inventory by username",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "Platform",
-                        "name": "platform",
-                        "in": "query",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Username",
-                        "name": "username",
-                        "in": "query",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Filter by item type",
-                        "name": "filter",
-                        "in": "query"
-                    }
+                    "progression"
                 ],
+                "summary": "Get dig site status",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.GetInventoryResponse"
+                            "$ref": "#/definitions/domain.DigSiteStatus"
                         }
                     },
-                    "400": {
-                        "description": "Bad Request",
+                    "500": {
+                        "description": "Internal Server Error",
                         "schema": {
                             "$ref": "#/definitions/handler.ErrorResponse"
                         }
+                    }
+                }
+            }
+        },
+        "/api/v1/events/bonuses": {
+            "get": {
+                "description": "Timed community-wide bonuses, such as those granted by raids and hosts, soonest to expire first.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "events"
+                ],
+                "summary": "List active community bonuses",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.CommunityBonusesResponse"
+                        }
                     },
                     "500": {
                         "description": "Internal Server Error",
@@ -1244,9 +1458,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/user/item/add": {
+        "/api/v1/events/host": {
             "post": {
-                "description": "Add an item to a user's inventory using only platform and username. This is an admin/system action.",
+                "description": "Called by platform adapters when another channel hosts the stream. Grants the community the timed bonuses of the host tier the viewer count reaches; a host below every tier grants nothing.",
                 "consumes": [
                     "application/json"
                 ],
@@ -1254,17 +1468,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "inventory"
+                    "events"
                 ],
-                "summary": "Add item by username",
+                "summary": "Report a host",
                 "parameters": [
                     {
-                        "description": "Item details including platform, username, and quantity",
+                        "description": "Host details",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/handler.AddItemByUsernameRequest"
+                            "$ref": "#/definitions/domain.RaidEvent"
                         }
                     }
                 ],
@@ -1272,38 +1486,27 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.SuccessResponse"
+                            "$ref": "#/definitions/domain.RaidBonus"
                         }
                     },
                     "400": {
-                        "description": "Invalid request data",
-                        "schema": {
-                            "$ref": "#/definitions/handler.ErrorResponse"
-                        }
-                    },
-                    "401": {
-                        "description": "Unauthorized",
+                        "description": "Bad Request",
                         "schema": {
                             "$ref": "#/definitions/handler.ErrorResponse"
                         }
                     },
                     "500": {
-                        "description": "Internal server error",
+                        "description": "Internal Server Error",
                         "schema": {
                             "$ref": "#/definitions/handler.ErrorResponse"
                         }
                     }
-                },
-                "security": [
-                    {
-                        "ApiKeyAuth": []
-                    }
-                ]
+                }
             }
         },
-        "/api/v1/user/item/buy": {
+        "/api/v1/events/raid": {
             "post": {
-                "description": "Buy an item with currency",
+                "description": "Called by platform adapters when another channel raids the stream. Grants the community the timed XP and contribution bonuses of the tier the viewer count reaches; a raid below every tier grants nothing.",
                 "consumes": [
                     "application/json"
                 ],
@@ -1311,17 +1514,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "economy"
+                    "events"
                 ],
-                "summary": "Buy item",
+                "summary": "Report a raid",
                 "parameters": [
                     {
-                        "description": "Buy details",
+                        "description": "Raid details",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/handler.BuyItemRequest"
+                            "$ref": "#/definitions/domain.RaidEvent"
                         }
                     }
                 ],
@@ -1329,7 +1532,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.BuyItemResponse"
+                            "$ref": "#/definitions/domain.RaidBonus"
                         }
                     },
                     "400": {
@@ -1338,12 +1541,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/handler.ErrorResponse"
                         }
                     },
-                    "403": {
-                        "description": "Feature locked",
-                        "schema": {
-                            "$ref": "#/definitions/handler.ErrorResponse"
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -1353,9 +1550,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/user/item/give": {
+        "/api/v1/expedition/join": {
             "post": {
-                "description": "Transfer an item from one user's inventory to another user.",
+                "description": "Join the expedition with the given ID, or the active one when no ID is given",
                 "consumes": [
                     "application/json"
                 ],
@@ -1363,17 +1560,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "inventory"
+                    "expedition"
                 ],
-                "summary": "Give item to another user",
+                "summary": "Join an expedition",
                 "parameters": [
                     {
-                        "description": "Transfer details including owner and receiver info",
+                        "type": "string",
+                        "description": "Expedition ID",
+                        "name": "id",
+                        "in": "query"
+                    },
+                    {
+                        "description": "Participant details",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/handler.GiveItemRequest"
+                            "$ref": "#/definitions/handler.JoinExpeditionRequest"
                         }
                     }
                 ],
@@ -1385,13 +1588,19 @@ const docTemplate = `{
                         }
                     },
                     "400": {
-                        "description": "Invalid request or self-gifting attempt",
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Feature locked",
                         "schema": {
                             "$ref": "#/definitions/handler.ErrorResponse"
                         }
                     },
                     "500": {
-                        "description": "Internal server error",
+                        "description": "Internal Server Error",
                         "schema": {
                             "$ref": "#/definitions/handler.ErrorResponse"
                         }
@@ -1399,35 +1608,33 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/user/item/remove": {
-            "post": {
-                "description": "Remove an item from a user's inventory using only platform and username",
-                "consumes": [
-                    "application/json"
-                ],
+        "/api/v1/expedition/journal": {
+            "get": {
+                "description": "The turn-by-turn journal of an expedition",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "inventory"
+                    "expedition"
                 ],
-                "summary": "Remove item by username",
+                "summary": "Get an expedition journal",
                 "parameters": [
                     {
-                        "description": "Item details",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/handler.RemoveItemByUsernameRequest"
-                        }
+                        "type": "string",
+                        "description": "Expedition ID",
+                        "name": "id",
+                        "in": "query",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.RemoveItemResponse"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/domain.ExpeditionJournalEntry"
+                            }
                         }
                     },
                     "400": {
@@ -1436,6 +1643,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/handler.ErrorResponse"
                         }
                     },
+                    "403": {
+                        "description": "Feature locked",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -1445,9 +1658,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/user/item/sell": {
+        "/api/v1/expedition/start": {
             "post": {
-                "description": "Sell an item from inventory for currency. Requires Economy feature to be unlocked.",
+                "description": "Start an expedition that other users can join before the deadline",
                 "consumes": [
                     "application/json"
                 ],
@@ -1455,41 +1668,41 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "economy"
+                    "expedition"
                 ],
-                "summary": "Sell item",
+                "summary": "Start an expedition",
                 "parameters": [
                     {
-                        "description": "Details of the item to sell and quantity",
+                        "description": "Expedition details",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/handler.SellItemRequest"
+                            "$ref": "#/definitions/handler.StartExpeditionRequest"
                         }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "201": {
+                        "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/handler.SellItemResponse"
+                            "$ref": "#/definitions/handler.StartExpeditionResponse"
                         }
                     },
                     "400": {
-                        "description": "Item not found or not sellable",
+                        "description": "Invalid request, expedition running or on cooldown",
                         "schema": {
                             "$ref": "#/definitions/handler.ErrorResponse"
                         }
                     },
                     "403": {
-                        "description": "Economy feature locked",
+                        "description": "Feature locked",
                         "schema": {
                             "$ref": "#/definitions/handler.ErrorResponse"
                         }
                     },
                     "500": {
-                        "description": "Internal server error",
+                        "description": "Internal Server Error",
                         "schema": {
                             "$ref": "#/definitions/handler.ErrorResponse"
                         }
@@ -1497,9 +1710,41 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/user/item/use": {
+        "/api/v1/expedition/status": {
+            "get": {
+                "description": "Whether an expedition is running, with its details, and when the cooldown ends",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "expedition"
+                ],
+                "summary": "Get expedition status",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/domain.ExpeditionStatus"
+                        }
+                    },
+                    "403": {
+                        "description": "Feature locked",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/fishing/cast": {
             "post": {
-                "description": "Use an item from inventory",
+                "description": "Puts the user's line in the water, on the per-user COOLDOWN_FISH cooldown. A fish bites 5-30 seconds later and a fishing.bite event is sent; the user then has a few seconds to reel in. Tackle (worm, lure or bobber) is used up by the cast. channel_id is passed on with the bite so the platform can announce it where the cast was made.",
                 "consumes": [
                     "application/json"
                 ],
@@ -1507,17 +1752,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "inventory"
+                    "fishing"
                 ],
-                "summary": "Use item",
+                "summary": "Cast a fishing line",
                 "parameters": [
                     {
-                        "description": "Usage details",
+                        "description": "Cast details",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/handler.UseItemRequest"
+                            "$ref": "#/definitions/handler.CastRequest"
                         }
                     }
                 ],
@@ -1525,7 +1770,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.UseItemResponse"
+                            "$ref": "#/definitions/domain.FishingCast"
                         }
                     },
                     "400": {
@@ -1535,7 +1780,19 @@ const docTemplate = `{
                         }
                     },
                     "403": {
-                        "description": "Item locked by progression",
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "A line is already in the water",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
                         "schema": {
                             "$ref": "#/definitions/handler.ErrorResponse"
                         }
@@ -1549,9 +1806,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/user/register": {
+        "/api/v1/fishing/reel": {
             "post": {
-                "description": "Register a new user or link an existing user to a new platform",
+                "description": "Reels in the user's line. While the fish is biting this lands a fish of random rarity into the user's inventory and awards Fisher XP; reeling in before the bite or after the window closes loses it.",
                 "consumes": [
                     "application/json"
                 ],
@@ -1559,35 +1816,35 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "user"
+                    "fishing"
                 ],
-                "summary": "Register or link a user",
+                "summary": "Reel in a fishing line",
                 "parameters": [
                     {
-                        "description": "Registration details",
+                        "description": "Angler",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/handler.RegisterUserRequest"
+                            "$ref": "#/definitions/handler.ReelRequest"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "User updated",
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/domain.User"
+                            "$ref": "#/definitions/domain.ReelResult"
                         }
                     },
-                    "201": {
-                        "description": "User created",
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
-                            "$ref": "#/definitions/domain.User"
+                            "$ref": "#/definitions/handler.ErrorResponse"
                         }
                     },
-                    "400": {
-                        "description": "Bad Request",
+                    "404": {
+                        "description": "No line in the water",
                         "schema": {
                             "$ref": "#/definitions/handler.ErrorResponse"
                         }
@@ -1601,9 +1858,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/user/search": {
+        "/api/v1/gamble/join": {
             "post": {
-                "description": "Allows players to search for loot boxes. Results depend on daily usage and character progression.",
+                "description": "Join the running gamble, paying the same lootbox bets as the starter",
                 "consumes": [
                     "application/json"
                 ],
@@ -1611,17 +1868,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "user"
+                    "gamble"
                 ],
-                "summary": "Perform environment search",
+                "summary": "Join the active gamble",
                 "parameters": [
                     {
-                        "description": "User identification",
+                        "description": "Participant details",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/handler.SearchRequest"
+                            "$ref": "#/definitions/handler.JoinGambleRequest"
                         }
                     }
                 ],
@@ -1629,17 +1886,11 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.SearchResponse"
-                        }
-                    },
-                    "401": {
-                        "description": "Invalid API Key",
-                        "schema": {
-                            "$ref": "#/definitions/handler.ErrorResponse"
+                            "$ref": "#/definitions/handler.SuccessResponse"
                         }
                     },
-                    "429": {
-                        "description": "Action on cooldown",
+                    "400": {
+                        "description": "Invalid request, no active gamble or missing bets",
                         "schema": {
                             "$ref": "#/definitions/handler.ErrorResponse"
                         }
@@ -1653,52 +1904,51 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/user/timeout": {
-            "get": {
-                "description": "Get the remaining timeout duration for a user",
+        "/api/v1/gamble/start": {
+            "post": {
+                "description": "Start a new gamble with lootbox bets that other users can join before the deadline",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "user"
+                    "gamble"
                 ],
-                "summary": "Get user timeout",
+                "summary": "Start a gamble",
                 "parameters": [
                     {
-                        "enum": [
-                            "twitch",
-                            "youtube",
-                            "discord"
-                        ],
-                        "type": "string",
-                        "description": "Platform (default: twitch)",
-                        "name": "platform",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Username to check",
-                        "name": "username",
-                        "in": "query",
-                        "required": true
+                        "description": "Gamble details",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.StartGambleRequest"
+                        }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "201": {
+                        "description": "Created",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": true
+                            "$ref": "#/definitions/handler.StartGambleResponse"
                         }
                     },
                     "400": {
-                        "description": "Bad Request",
+                        "description": "Invalid request or a gamble is already running",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Feature locked",
                         "schema": {
                             "$ref": "#/definitions/handler.ErrorResponse"
                         }
                     },
                     "500": {
-                        "description": "Internal Server Error",
+                        "description": "Internal server error",
                         "schema": {
                             "$ref": "#/definitions/handler.ErrorResponse"
                         }
@@ -1706,29 +1956,35 @@ const docTemplate = `{
                 }
             }
         },
-        "/healthz": {
+        "/api/v1/game-events": {
             "get": {
-                "description": "Returns OK if the service is running",
+                "description": "The global events running now, such as a double XP weekend, and what they add up to: the XP and drop rate multipliers and the shop discount.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "health"
+                    "events"
                 ],
-                "summary": "Liveness check",
+                "summary": "Get active game events",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.HealthResponse"
+                            "$ref": "#/definitions/domain.GameEventStatus"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
                         }
                     }
                 }
             }
         },
-        "/progression/admin/contribution": {
+        "/api/v1/harvest": {
             "post": {
-                "description": "Manually add contribution points to the current unlock progress (admin only)",
+                "description": "Collect rewards that have accumulated since the last harvest",
                 "consumes": [
                     "application/json"
                 ],
@@ -1736,36 +1992,35 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "progression",
-                    "admin"
+                    "harvest"
                 ],
-                "summary": "Admin add contribution",
+                "summary": "Harvest accumulated rewards",
                 "parameters": [
                     {
-                        "description": "Contribution request",
+                        "description": "Harvest request",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/handler.AdminAddContributionRequest"
+                            "$ref": "#/definitions/handler.HarvestRewardsRequest"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
+                        "description": "Harvest successful",
                         "schema": {
-                            "$ref": "#/definitions/handler.SuccessResponse"
+                            "$ref": "#/definitions/domain.HarvestResponse"
                         }
                     },
                     "400": {
-                        "description": "Bad Request",
+                        "description": "Invalid request or harvest too soon",
                         "schema": {
                             "$ref": "#/definitions/handler.ErrorResponse"
                         }
                     },
                     "500": {
-                        "description": "Internal Server Error",
+                        "description": "Internal server error",
                         "schema": {
                             "$ref": "#/definitions/handler.ErrorResponse"
                         }
@@ -1773,28 +2028,21 @@ const docTemplate = `{
                 }
             }
         },
-        "/progression/admin/end-voting": {
-            "post": {
-                "description": "Freeze the current voting session until the next unlock completes (admin only)",
+        "/api/v1/jackpot": {
+            "get": {
+                "description": "The money the progressive jackpot holds, the share of each lootbox opening and gamble added to it, the chance each opening wins it, and the most recent winners.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "progression",
-                    "admin"
+                    "economy"
                 ],
-                "summary": "Admin freeze voting",
+                "summary": "Get jackpot status",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.SuccessResponse"
-                        }
-                    },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "$ref": "#/definitions/handler.ErrorResponse"
+                            "$ref": "#/definitions/domain.JackpotStatus"
                         }
                     },
                     "500": {
@@ -1806,22 +2054,21 @@ const docTemplate = `{
                 }
             }
         },
-        "/progression/admin/force-end-voting": {
-            "post": {
-                "description": "Force end the current voting session and determine winner immediately (admin only)",
+        "/api/v1/jobs": {
+            "get": {
+                "description": "Every job in the game, whether or not it is unlocked yet.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "progression",
-                    "admin"
+                    "jobs"
                 ],
-                "summary": "Admin force end voting",
+                "summary": "List jobs",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.AdminEndVotingResponse"
+                            "$ref": "#/definitions/handler.JobsResponse"
                         }
                     },
                     "500": {
@@ -1833,36 +2080,73 @@ const docTemplate = `{
                 }
             }
         },
-        "/progression/admin/instant-unlock": {
+        "/api/v1/jobs/active": {
             "post": {
-                "description": "Force immediate unlock of current vote leader (overrides 24hr timer)",
+                "description": "The active job earns bonus XP. Switching is on a cooldown set by COOLDOWN_JOB_SWITCH; choosing the current active job is rejected without using it up.",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "progression",
-                    "admin"
+                    "jobs"
+                ],
+                "summary": "Switch active job",
+                "parameters": [
+                    {
+                        "description": "Job to switch to",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.JobChoiceRequest"
+                        }
+                    }
                 ],
-                "summary": "Admin instant unlock",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.AdminInstantUnlockResponse"
+                            "$ref": "#/definitions/domain.UserJobInfo"
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
                             "$ref": "#/definitions/handler.ErrorResponse"
                         }
-                    }
-                }
-            }
-        },
-        "/progression/admin/reset": {
-            "post": {
-                "description": "Reset progression tree (annual reset, clears unlocks/voting)",
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/handler.CooldownErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/jobs/award-xp": {
+            "post": {
+                "description": "Award XP to one of a user's jobs, applying the usual multipliers",
                 "consumes": [
                     "application/json"
                 ],
@@ -1870,18 +2154,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "progression",
-                    "admin"
+                    "jobs"
                 ],
-                "summary": "Admin reset tree",
+                "summary": "Award job XP",
                 "parameters": [
                     {
-                        "description": "Reset request",
+                        "description": "XP award",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/handler.AdminResetRequest"
+                            "$ref": "#/definitions/handler.AwardXPRequest"
                         }
                     }
                 ],
@@ -1889,7 +2172,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.SuccessResponse"
+                            "$ref": "#/definitions/domain.XPAwardResult"
                         }
                     },
                     "400": {
@@ -1907,22 +2190,35 @@ const docTemplate = `{
                 }
             }
         },
-        "/progression/admin/start-voting": {
+        "/api/v1/jobs/prestige": {
             "post": {
-                "description": "Resume a frozen voting session OR start a new one if nodes are available (admin only)",
+                "description": "Resets a job at the level cap to level 0. Each prestige permanently adds to the XP the job earns, up to a maximum number of prestiges.",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "progression",
-                    "admin"
+                    "jobs"
+                ],
+                "summary": "Prestige job",
+                "parameters": [
+                    {
+                        "description": "Job to prestige",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.JobChoiceRequest"
+                        }
+                    }
                 ],
-                "summary": "Admin start/resume voting",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.SuccessResponse"
+                            "$ref": "#/definitions/domain.JobPrestigeResult"
                         }
                     },
                     "400": {
@@ -1931,38 +2227,17 @@ const docTemplate = `{
                             "$ref": "#/definitions/handler.ErrorResponse"
                         }
                     },
-                    "409": {
-                        "description": "Conflict",
+                    "403": {
+                        "description": "Forbidden",
                         "schema": {
                             "$ref": "#/definitions/handler.ErrorResponse"
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
                             "$ref": "#/definitions/handler.ErrorResponse"
                         }
-                    }
-                }
-            }
-        },
-        "/progression/admin/unlock-all": {
-            "post": {
-                "description": "Unlocks all progression nodes at their maximum level (for debugging)",
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "progression",
-                    "admin"
-                ],
-                "summary": "Admin unlock all nodes",
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/handler.SuccessResponse"
-                        }
                     },
                     "500": {
                         "description": "Internal Server Error",
@@ -1973,30 +2248,48 @@ const docTemplate = `{
                 }
             }
         },
-        "/progression/estimate/{nodeKey}": {
+        "/api/v1/jobs/user": {
             "get": {
-                "description": "Returns estimated unlock time and requirements for a specific node",
+                "description": "A user's progress in every job and their primary job. Pass platform_id for the caller or username for another user.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "progression"
+                    "jobs"
                 ],
-                "summary": "Get unlock estimate",
+                "summary": "Get user jobs",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Node Key",
-                        "name": "nodeKey",
-                        "in": "path",
+                        "description": "Platform (twitch, youtube, discord)",
+                        "name": "platform",
+                        "in": "query",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Platform user ID",
+                        "name": "platform_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Username, used when platform_id is empty",
+                        "name": "username",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/domain.UnlockEstimate"
+                            "$ref": "#/definitions/handler.GetUserJobsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
                         }
                     },
                     "404": {
@@ -2014,21 +2307,54 @@ const docTemplate = `{
                 }
             }
         },
-        "/progression/session": {
+        "/api/v1/jobs/{job_key}/perks": {
             "get": {
-                "description": "Returns the current voting session with all available options",
+                "description": "The perks a job grants by level. With platform and platform_id, the perks the user has reached are marked and their prestige bonus is included.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "progression"
+                    "jobs"
+                ],
+                "summary": "Get job perks",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Job key",
+                        "name": "job_key",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Platform",
+                        "name": "platform",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Platform user ID",
+                        "name": "platform_id",
+                        "in": "query"
+                    }
                 ],
-                "summary": "Get voting session",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/domain.ProgressionVotingSession"
+                            "$ref": "#/definitions/domain.JobPerks"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
                         }
                     },
                     "500": {
@@ -2040,25 +2366,39 @@ const docTemplate = `{
                 }
             }
         },
-        "/progression/unlock-progress": {
-            "get": {
-                "description": "Returns the current unlock progress including accumulated contributions",
+        "/api/v1/link/claim": {
+            "post": {
+                "description": "Enter a link token from the other platform; the link then waits for confirmation there",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "progression"
+                    "linking"
+                ],
+                "summary": "Claim a link token",
+                "parameters": [
+                    {
+                        "description": "Token and claiming account",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.ClaimRequest"
+                        }
+                    }
                 ],
-                "summary": "Get unlock progress",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/domain.UnlockProgress"
+                            "$ref": "#/definitions/handler.ClaimResponse"
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
                             "$ref": "#/definitions/handler.ErrorResponse"
                         }
@@ -2066,34 +2406,39 @@ const docTemplate = `{
                 }
             }
         },
-        "/progression/velocity": {
-            "get": {
-                "description": "Returns engagement velocity metrics (points/day) and trend",
+        "/api/v1/link/confirm": {
+            "post": {
+                "description": "Confirm a claimed link from the account that started it, merging the two accounts",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "progression",
-                    "admin"
+                    "linking"
                 ],
-                "summary": "Get engagement velocity",
+                "summary": "Confirm an account link",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "Number of days (default 7)",
-                        "name": "days",
-                        "in": "query"
+                        "description": "Account that started the link",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.ConfirmRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/domain.VelocityMetrics"
+                            "$ref": "#/definitions/linking.LinkResult"
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
                             "$ref": "#/definitions/handler.ErrorResponse"
                         }
@@ -2101,74 +2446,83 @@ const docTemplate = `{
                 }
             }
         },
-        "/readyz": {
-            "get": {
-                "description": "Returns OK if the service is ready to accept traffic (database connected)",
+        "/api/v1/link/initiate": {
+            "post": {
+                "description": "Issue a token to enter on the other platform to link the two accounts",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "health"
+                    "linking"
+                ],
+                "summary": "Start an account link",
+                "parameters": [
+                    {
+                        "description": "Account starting the link",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.InitiateRequest"
+                        }
+                    }
                 ],
-                "summary": "Readiness check",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.HealthResponse"
+                            "$ref": "#/definitions/handler.InitiateResponse"
                         }
                     },
-                    "503": {
-                        "description": "Service Unavailable",
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
-                            "$ref": "#/definitions/handler.HealthResponse"
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
                         }
                     }
                 }
             }
         },
-        "/recipes": {
+        "/api/v1/link/status": {
             "get": {
-                "description": "Get recipe information. Can filter by item or get all unlocked recipes for a user.",
+                "description": "The platforms linked to the account and any pending link token",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "crafting"
+                    "linking"
                 ],
-                "summary": "Get recipes",
+                "summary": "Get link status",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Item name to get recipe for",
-                        "name": "item",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Username to get unlocked recipes for",
-                        "name": "user",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Platform (required if user provided)",
+                        "description": "Platform (twitch, youtube, discord)",
                         "name": "platform",
-                        "in": "query"
+                        "in": "query",
+                        "required": true
                     },
                     {
                         "type": "string",
-                        "description": "Platform ID (self-mode, optional for target-mode)",
+                        "description": "Platform user ID",
                         "name": "platform_id",
-                        "in": "query"
+                        "in": "query",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Recipes or single recipe",
+                        "description": "OK",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": true
+                            "$ref": "#/definitions/linking.LinkStatus"
                         }
                     },
                     "400": {
@@ -2177,12 +2531,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/handler.ErrorResponse"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/handler.ErrorResponse"
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -2192,9 +2540,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/user/item/disassemble": {
+        "/api/v1/link/unlink": {
             "post": {
-                "description": "Disassemble an item into materials",
+                "description": "Without confirm, starts an unlink that must be confirmed in time; with confirm, removes the link",
                 "consumes": [
                     "application/json"
                 ],
@@ -2202,17 +2550,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "crafting"
+                    "linking"
                 ],
-                "summary": "Disassemble item",
+                "summary": "Unlink a platform",
                 "parameters": [
                     {
-                        "description": "Disassemble details",
+                        "description": "Platform to unlink",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/handler.CraftingActionRequest"
+                            "$ref": "#/definitions/handler.UnlinkRequest"
                         }
                     }
                 ],
@@ -2220,7 +2568,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.DisassembleItemResponse"
+                            "$ref": "#/definitions/handler.UnlinkResponse"
                         }
                     },
                     "400": {
@@ -2229,12 +2577,58 @@ const docTemplate = `{
                             "$ref": "#/definitions/handler.ErrorResponse"
                         }
                     },
-                    "403": {
-                        "description": "Feature locked",
+                    "500": {
+                        "description": "Internal Server Error",
                         "schema": {
                             "$ref": "#/definitions/handler.ErrorResponse"
                         }
-                    },
+                    }
+                }
+            }
+        },
+        "/api/v1/market/buy": {
+            "post": {
+                "description": "Same as buying from the player shop, with the listing ID in the body. Publishes a \"player_shop.sold\" event.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "economy"
+                ],
+                "summary": "Buy from market",
+                "parameters": [
+                    {
+                        "description": "Purchase",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.MarketBuyRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/domain.PlayerShopPurchase"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -2244,9 +2638,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/user/item/upgrade": {
+        "/api/v1/market/cancel": {
             "post": {
-                "description": "Upgrade an item to a higher tier",
+                "description": "Same as cancelling a player shop listing, with the listing ID in the body. Only the seller can cancel, and the unsold items go back to their inventory.",
                 "consumes": [
                     "application/json"
                 ],
@@ -2254,17 +2648,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "crafting"
+                    "economy"
                 ],
-                "summary": "Upgrade item",
+                "summary": "Cancel market listing",
                 "parameters": [
                     {
-                        "description": "Upgrade details",
+                        "description": "Listing to cancel",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/handler.CraftingActionRequest"
+                            "$ref": "#/definitions/handler.MarketCancelRequest"
                         }
                     }
                 ],
@@ -2272,7 +2666,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.UpgradeItemResponse"
+                            "$ref": "#/definitions/domain.PlayerListing"
                         }
                     },
                     "400": {
@@ -2281,8 +2675,8 @@ const docTemplate = `{
                             "$ref": "#/definitions/handler.ErrorResponse"
                         }
                     },
-                    "403": {
-                        "description": "Feature locked",
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
                             "$ref": "#/definitions/handler.ErrorResponse"
                         }
@@ -2296,9 +2690,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/user/timeout": {
-            "put": {
-                "description": "Apply or extend a timeout for a user (accumulates with existing timeout)",
+        "/api/v1/market/list": {
+            "post": {
+                "description": "Takes the items from one quality slot of the seller's inventory and holds them until they are bought or the listing is cancelled. The seller pays a listing fee, set by PLAYER_SHOP_LISTING_FEE_PERCENT of the total asking price, which is not refunded on cancel. Money, borrowed and locked items cannot be listed.",
                 "consumes": [
                     "application/json"
                 ],
@@ -2306,26 +2700,25 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "user"
+                    "economy"
                 ],
-                "summary": "Set user timeout",
+                "summary": "List item in player shop",
                 "parameters": [
                     {
-                        "description": "Timeout details",
+                        "description": "Listing",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/handler.SetTimeoutRequest"
+                            "$ref": "#/definitions/handler.ListItemRequest"
                         }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "201": {
+                        "description": "Created",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": true
+                            "$ref": "#/definitions/domain.PlayerListing"
                         }
                     },
                     "400": {
@@ -2342,683 +2735,10569 @@ const docTemplate = `{
                     }
                 }
             }
-        }
-    },
-    "definitions": {
-        "admin.ClearTimeoutRequest": {
-            "type": "object",
-            "required": [
-                "platform",
-                "username"
-            ],
-            "properties": {
-                "platform": {
-                    "type": "string"
-                },
-                "username": {
-                    "type": "string",
-                    "maxLength": 100
-                }
-            }
         },
-        "domain.ContributionBreakdown": {
-            "type": "object",
-            "properties": {
-                "by_type": {
-                    "type": "object",
-                    "additionalProperties": {
-                        "type": "integer"
+        "/api/v1/message/handle": {
+            "post": {
+                "description": "Process a chat message for string triggers, and run it as a text command (e.g. \"!buy junkbox 2\") when it starts with the command prefix. The command's reply is in command.reply.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "message"
+                ],
+                "summary": "Handle chat message",
+                "parameters": [
+                    {
+                        "description": "Message details",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.HandleMessageRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/domain.MessageResult"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
                     }
-                },
-                "commands_used": {
-                    "type": "integer"
-                },
-                "items_crafted": {
-                    "type": "integer"
-                },
-                "items_used": {
-                    "type": "integer"
-                },
-                "messages_sent": {
-                    "type": "integer"
-                },
-                "total_score": {
-                    "type": "integer"
                 }
             }
         },
-        "domain.ContributionLeaderboardEntry": {
-            "type": "object",
-            "properties": {
-                "contribution": {
-                    "type": "integer"
-                },
-                "rank": {
-                    "type": "integer"
-                },
-                "user_id": {
-                    "type": "string"
+        "/api/v1/monetization/event": {
+            "post": {
+                "description": "Processes a Twitch subscription/cheer or Streamlabs donation event relayed by Streamer.bot and grants the rewards configured for it. Events are deduplicated by source and event ID, so redeliveries are acknowledged without granting rewards again.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "monetization"
+                ],
+                "summary": "Receive monetization event",
+                "parameters": [
+                    {
+                        "description": "Monetization event",
+                        "name": "event",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/domain.MonetizationEvent"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/domain.MonetizationResult"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
                 }
             }
         },
-        "domain.DailyResetStatus": {
-            "type": "object",
-            "properties": {
-                "last_reset_time": {
-                    "type": "string"
-                },
-                "next_reset_time": {
-                    "type": "string"
-                },
-                "records_affected": {
-                    "type": "integer"
+        "/api/v1/prediction": {
+            "post": {
+                "description": "Convert channel points to progression contribution and award XP to participants",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "prediction"
+                ],
+                "summary": "Process prediction outcome",
+                "parameters": [
+                    {
+                        "description": "Prediction outcome data",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/domain.PredictionOutcomeRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/domain.PredictionResult"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
                 }
             }
         },
-        "domain.FoundString": {
-            "type": "object",
-            "properties": {
-                "code": {
-                    "type": "string"
-                },
-                "value": {
-                    "type": "string"
-                }
+        "/api/v1/prices": {
+            "get": {
+                "description": "Get current sell prices for items",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "economy"
+                ],
+                "summary": "Get item prices",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/domain.Item"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/prices/buy": {
+            "get": {
+                "description": "Get current buy prices for items",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "economy"
+                ],
+                "summary": "Get item buy prices",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/domain.Item"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/prices/history": {
+            "get": {
+                "description": "Get an item's recorded market prices, oldest first, along with its price now",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "economy"
+                ],
+                "summary": "Get item price history",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Item name (public or internal)",
+                        "name": "item",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Earliest point, RFC3339 (default 7 days ago)",
+                        "name": "since",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Maximum points, most recent kept (default 200, max 1000)",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/domain.PriceHistory"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/progression/admin/contribution": {
+            "post": {
+                "description": "Manually add contribution points to the current unlock progress (admin only)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "progression",
+                    "admin"
+                ],
+                "summary": "Admin add contribution",
+                "parameters": [
+                    {
+                        "description": "Contribution request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.AdminAddContributionRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.SuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/progression/admin/end-voting": {
+            "post": {
+                "description": "Freeze the current voting session until the next unlock completes (admin only)",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "progression",
+                    "admin"
+                ],
+                "summary": "Admin freeze voting",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.SuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/progression/admin/force-end-voting": {
+            "post": {
+                "description": "Force end the current voting session and determine winner immediately (admin only)",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "progression",
+                    "admin"
+                ],
+                "summary": "Admin force end voting",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.AdminEndVotingResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/progression/admin/instant-unlock": {
+            "post": {
+                "description": "Force immediate unlock of current vote leader (overrides 24hr timer)",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "progression",
+                    "admin"
+                ],
+                "summary": "Admin instant unlock",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.AdminInstantUnlockResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/progression/admin/relock": {
+            "post": {
+                "description": "Relocks a progression node level",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "progression",
+                    "admin"
+                ],
+                "summary": "Admin relock node",
+                "parameters": [
+                    {
+                        "description": "Node and level",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.AdminNodeRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.SuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/progression/admin/reset": {
+            "post": {
+                "description": "Reset progression tree (annual reset, clears unlocks/voting)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "progression",
+                    "admin"
+                ],
+                "summary": "Admin reset tree",
+                "parameters": [
+                    {
+                        "description": "Reset request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.AdminResetRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.SuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/progression/admin/start-voting": {
+            "post": {
+                "description": "Resume a frozen voting session OR start a new one if nodes are available (admin only)",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "progression",
+                    "admin"
+                ],
+                "summary": "Admin start/resume voting",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.SuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/progression/admin/tree": {
+            "put": {
+                "description": "Validate a full tree (schema, unique keys, existing prerequisites, no cycles, tiers not below prerequisites), diff it against the database and apply new and changed nodes without a reset. Nodes left out are reported as missing and kept. With dry_run=true nothing is applied.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "progression",
+                    "admin"
+                ],
+                "summary": "Admin replace progression tree",
+                "parameters": [
+                    {
+                        "type": "boolean",
+                        "description": "Only validate and diff",
+                        "name": "dry_run",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/progression.TreeDiff"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "description": "Add or replace the given nodes, validate the merged tree, diff it against the database and apply it without a reset. With dry_run=true nothing is applied.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "progression",
+                    "admin"
+                ],
+                "summary": "Admin patch progression tree",
+                "parameters": [
+                    {
+                        "type": "boolean",
+                        "description": "Only validate and diff",
+                        "name": "dry_run",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/progression.TreeDiff"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/progression/admin/unlock": {
+            "post": {
+                "description": "Force-unlocks a progression node at the given level",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "progression",
+                    "admin"
+                ],
+                "summary": "Admin unlock node",
+                "parameters": [
+                    {
+                        "description": "Node and level",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.AdminNodeRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.SuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/progression/admin/unlock-all": {
+            "post": {
+                "description": "Unlocks all progression nodes at their maximum level (for debugging)",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "progression",
+                    "admin"
+                ],
+                "summary": "Admin unlock all nodes",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.SuccessResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/progression/available": {
+            "get": {
+                "description": "Returns nodes that are available for voting (prerequisites met, not maxed out)",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "progression"
+                ],
+                "summary": "Get available unlocks",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.AvailableUnlocksResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/progression/contribution/external": {
+            "post": {
+                "description": "Add contribution points from another system such as donation alerts or sub events. Callers with a scoped API key are bound to that key's source and hourly cap; callers with the main key must name the source and are uncapped. Naming a user credits the points to their contribution breakdown.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "progression"
+                ],
+                "summary": "Add external contribution",
+                "parameters": [
+                    {
+                        "description": "Contribution request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.ExternalContributionRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/domain.ExternalContributionResult"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/progression/engagement": {
+            "get": {
+                "description": "Returns user's engagement contribution breakdown by type",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "progression"
+                ],
+                "summary": "Get user engagement",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Platform (twitch, youtube, discord)",
+                        "name": "platform",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Platform-specific user ID",
+                        "name": "platform_id",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/domain.ContributionBreakdown"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/progression/engagement-by-username": {
+            "get": {
+                "description": "Returns user's engagement contribution breakdown by type using username",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "progression"
+                ],
+                "summary": "Get user engagement by username",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Platform (twitch, youtube, discord)",
+                        "name": "platform",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Username",
+                        "name": "username",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/domain.ContributionBreakdown"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/progression/estimate/{nodeKey}": {
+            "get": {
+                "description": "Returns estimated unlock time and requirements for a specific node",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "progression"
+                ],
+                "summary": "Get unlock estimate",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Node Key",
+                        "name": "nodeKey",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/domain.UnlockEstimate"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/progression/leaderboard": {
+            "get": {
+                "description": "Returns top contributors by total contributions",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "progression"
+                ],
+                "summary": "Get contribution leaderboard",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Number of entries (default 10, max 100)",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/domain.ContributionLeaderboardEntry"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/progression/session": {
+            "get": {
+                "description": "Returns the current voting session with all available options",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "progression"
+                ],
+                "summary": "Get voting session",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.VotingSessionResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/progression/sessions/history": {
+            "get": {
+                "description": "Returns past voting sessions with their options, vote counts, winner and the node the winner unlocked",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "progression"
+                ],
+                "summary": "Get voting session history",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Sessions per page (default 10, max 50)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Number of sessions to skip (default 0)",
+                        "name": "offset",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/domain.VotingSessionHistory"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/progression/status": {
+            "get": {
+                "description": "Returns current community progression status including unlocks and engagement",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "progression"
+                ],
+                "summary": "Get progression status",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/domain.ProgressionStatus"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/progression/tree": {
+            "get": {
+                "description": "Returns the complete progression tree with unlock status for each node",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "progression"
+                ],
+                "summary": "Get progression tree",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ProgressionTreeResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/progression/unlock-progress": {
+            "get": {
+                "description": "Returns the current unlock progress including accumulated contributions",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "progression"
+                ],
+                "summary": "Get unlock progress",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.UnlockProgressResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/progression/velocity": {
+            "get": {
+                "description": "Returns engagement velocity metrics (points/day) and trend",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "progression",
+                    "admin"
+                ],
+                "summary": "Get engagement velocity",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Number of days (default 7)",
+                        "name": "days",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/domain.VelocityMetrics"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/progression/vote": {
+            "post": {
+                "description": "Cast a vote for the next unlock by selecting an option index (1-based)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "progression"
+                ],
+                "summary": "Vote for unlock",
+                "parameters": [
+                    {
+                        "description": "Vote request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.VoteRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.SuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/progression/vote/delegate": {
+            "get": {
+                "description": "Returns who the user has delegated their votes to",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "progression"
+                ],
+                "summary": "Get vote delegation",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Platform (twitch, youtube, discord)",
+                        "name": "platform",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Platform-specific user ID",
+                        "name": "platform_id",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/domain.VoteDelegation"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Delegate future session votes to another user on the same platform. When the delegate votes, the same option is voted for on the user's behalf unless the user has already voted. Delegations do not chain.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "progression"
+                ],
+                "summary": "Delegate votes",
+                "parameters": [
+                    {
+                        "description": "Delegation request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.DelegateVoteRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.SuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Stop delegating votes. Votes the delegate already cast stand until the user votes themselves.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "progression"
+                ],
+                "summary": "Revoke vote delegation",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Platform (twitch, youtube, discord)",
+                        "name": "platform",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Platform-specific user ID",
+                        "name": "platform_id",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.SuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/quests/active": {
+            "get": {
+                "description": "The current week's quests",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "quests"
+                ],
+                "summary": "List active quests",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/domain.Quest"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Feature locked",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/quests/claim": {
+            "post": {
+                "description": "Pay out the money for a completed quest",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "quests"
+                ],
+                "summary": "Claim a quest reward",
+                "parameters": [
+                    {
+                        "description": "Quest to claim",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.ClaimQuestRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ClaimQuestResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Feature locked",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/quests/progress": {
+            "get": {
+                "description": "A user's progress on this week's quests",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "quests"
+                ],
+                "summary": "Get quest progress",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Internal user ID",
+                        "name": "user_id",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/domain.QuestProgress"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Feature locked",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/recipes": {
+            "get": {
+                "description": "Get recipe information. Can filter by item or get all unlocked recipes for a user.\nWithout item the response is a recipe list (unlocked recipes carry only item_id and item_name); with item it is that item's recipe.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "crafting"
+                ],
+                "summary": "Get recipes",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Item name to get recipe for",
+                        "name": "item",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Username to get unlocked recipes for",
+                        "name": "user",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Platform (required if user provided)",
+                        "name": "platform",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Platform ID (self-mode, optional for target-mode)",
+                        "name": "platform_id",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Recipes, or the single recipe when item is set",
+                        "schema": {
+                            "$ref": "#/definitions/handler.AllRecipesResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/shop/player": {
+            "get": {
+                "description": "Active listings, cheapest first. Filter by item, seller, quality and unit price range, or sort the newest first.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "economy"
+                ],
+                "summary": "List player shop listings",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Item name",
+                        "name": "item",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Seller username",
+                        "name": "seller",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Quality level",
+                        "name": "quality",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Lowest unit price",
+                        "name": "min_price",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Highest unit price",
+                        "name": "max_price",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "price (default) or newest",
+                        "name": "sort",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ListingsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Takes the items from one quality slot of the seller's inventory and holds them until they are bought or the listing is cancelled. The seller pays a listing fee, set by PLAYER_SHOP_LISTING_FEE_PERCENT of the total asking price, which is not refunded on cancel. Money, borrowed and locked items cannot be listed.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "economy"
+                ],
+                "summary": "List item in player shop",
+                "parameters": [
+                    {
+                        "description": "Listing",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.ListItemRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/domain.PlayerListing"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/shop/player/pool": {
+            "get": {
+                "description": "The money collected from player shop fees.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "economy"
+                ],
+                "summary": "Get community pool",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.CommunityPoolResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/shop/player/{id}": {
+            "delete": {
+                "description": "Only the seller can cancel a listing. The unsold items go back to their inventory.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "economy"
+                ],
+                "summary": "Cancel player shop listing",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Listing ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Platform",
+                        "name": "platform",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Platform user ID",
+                        "name": "platform_id",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/domain.PlayerListing"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/shop/player/{id}/buy": {
+            "post": {
+                "description": "Pays the seller for some or all of the listed items. A share of the price, set by PLAYER_SHOP_FEE_PERCENT, goes to the community pool instead of the seller. Publishes a \"player_shop.sold\" event.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "economy"
+                ],
+                "summary": "Buy from player shop",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Listing ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Purchase",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.BuyListingRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/domain.PlayerShopPurchase"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/slots/spin": {
+            "post": {
+                "description": "Bet money on a spin of the slot machine",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "slots"
+                ],
+                "summary": "Spin the slots",
+                "parameters": [
+                    {
+                        "description": "Bet",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.SpinSlotsRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/domain.SlotsResult"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid bet or insufficient funds",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Feature locked",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/handler.CooldownErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/stats/event": {
+            "post": {
+                "description": "Record a custom user event",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "stats"
+                ],
+                "summary": "Record event",
+                "parameters": [
+                    {
+                        "description": "Event details",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.RecordEventRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.SuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/stats/leaderboard": {
+            "get": {
+                "description": "Get a page of the leaderboard for a specific event type. Passing platform with platform_id or username centres the page on that user instead of using offset.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "stats"
+                ],
+                "summary": "Get leaderboard",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Event Type",
+                        "name": "event_type",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Period (daily, weekly, all_time)",
+                        "name": "period",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Limit (default 10, max 100)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Number of ranks to skip (default 0)",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Platform of the user to centre on",
+                        "name": "platform",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Platform ID of the user to centre on",
+                        "name": "platform_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Username of the user to centre on",
+                        "name": "username",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/domain.LeaderboardPage"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/stats/query": {
+            "get": {
+                "description": "Count events over a range, grouped by time bucket, user and/or event type. Closed buckets are read from rollups.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "stats"
+                ],
+                "summary": "Query stats",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Bucket size (hour, day, week; default day)",
+                        "name": "bucket",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Range start, RFC3339 (default 7 days before end); widened to the start of its bucket",
+                        "name": "start",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Range end, RFC3339, exclusive (default now)",
+                        "name": "end",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma-separated grouping: time, user, event_type",
+                        "name": "group_by",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only count this user's events",
+                        "name": "user_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only count this event type",
+                        "name": "event_type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Maximum groups (default 100, max 1000)",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/domain.StatsQueryResult"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/stats/system": {
+            "get": {
+                "description": "Get system-wide statistics",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "stats"
+                ],
+                "summary": "Get system stats",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Period (daily, weekly, all_time)",
+                        "name": "period",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/domain.StatsSummary"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/stats/user": {
+            "get": {
+                "description": "Get statistics for a specific user",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "stats"
+                ],
+                "summary": "Get user stats",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Platform (twitch, youtube, discord)",
+                        "name": "platform",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Platform-specific user ID (self-mode)",
+                        "name": "platform_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Username (target-mode)",
+                        "name": "username",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Period (daily, weekly, all_time)",
+                        "name": "period",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/domain.StatsSummary"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/stream/end": {
+            "post": {
+                "description": "Called by platform adapters when the stream goes offline. Publishes a stream.ended event, on which the Discord bot posts the stream's summary.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "stream"
+                ],
+                "summary": "End the stream session",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/domain.StreamSession"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/stream/start": {
+            "post": {
+                "description": "Called by platform adapters when the stream goes live. Stats events, chat drops and gambles are tagged with the session until it ends. If a stream is already live its session is returned with created false.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "stream"
+                ],
+                "summary": "Start a stream session",
+                "parameters": [
+                    {
+                        "description": "Stream details",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/domain.StartStreamRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.StartStreamResponse"
+                        }
+                    },
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/handler.StartStreamResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/stream/{id}/recap": {
+            "get": {
+                "description": "The session's summary plus its notable moments: the biggest gamble win, the top contributor by weighted engagement and the progression nodes unlocked. The same recap is published as a stream.recap event when a stream ends.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "stream"
+                ],
+                "summary": "Get a stream recap",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Stream session ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/domain.StreamRecap"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/stream/{id}/summary": {
+            "get": {
+                "description": "Totals the stats events, chat drops and gambles of a stream session, with its most active users. Live sessions are summarised up to now.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "stream"
+                ],
+                "summary": "Get a stream summary",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Stream session ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/domain.StreamSummary"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/subscriptions/event": {
+            "post": {
+                "description": "Processes subscription lifecycle events (subscribed, renewed, upgraded, downgraded, cancelled)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "subscriptions"
+                ],
+                "summary": "Receive subscription event from Streamer.bot",
+                "parameters": [
+                    {
+                        "description": "Subscription event",
+                        "name": "event",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/domain.SubscriptionEvent"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.SuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/subscriptions/user": {
+            "get": {
+                "description": "Retrieves subscription information for a user on a specific platform",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "subscriptions"
+                ],
+                "summary": "Get user subscription status",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Platform (twitch or youtube)",
+                        "name": "platform",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Platform user ID",
+                        "name": "platform_id",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/domain.SubscriptionWithTier"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/test": {
+            "post": {
+                "description": "Registers the user if needed and answers with a greeting, for checking a bot's connection",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user"
+                ],
+                "summary": "Greet a user",
+                "parameters": [
+                    {
+                        "description": "User to greet",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.TestRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.TestResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/user/bank": {
+            "get": {
+                "description": "The user's balance, savings goal, interest earned and what they have withdrawn today, with the bank's interest rate and daily withdrawal limit.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user"
+                ],
+                "summary": "Get bank account",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Platform",
+                        "name": "platform",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Platform user ID",
+                        "name": "platform_id",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/domain.BankAccount"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/user/bank/deposit": {
+            "post": {
+                "description": "Moves money from the user's inventory into their bank account, where it earns interest. A deposit that reaches the savings goal pays the goal bonus.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user"
+                ],
+                "summary": "Deposit money",
+                "parameters": [
+                    {
+                        "description": "Amount to deposit",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.BankTransferRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/domain.BankTransaction"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/user/bank/goal": {
+            "put": {
+                "description": "Sets a savings goal above the user's balance, or clears it with a goal of 0. Reaching the goal pays a bonus into the account. A new goal can only be set a day after the last.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user"
+                ],
+                "summary": "Set savings goal",
+                "parameters": [
+                    {
+                        "description": "Savings goal",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.BankGoalRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/domain.BankAccount"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "A goal was set too recently",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/user/bank/withdraw": {
+            "post": {
+                "description": "Moves money from the user's bank account back into their inventory, up to the daily withdrawal limit.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user"
+                ],
+                "summary": "Withdraw money",
+                "parameters": [
+                    {
+                        "description": "Amount to withdraw",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.BankTransferRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/domain.BankTransaction"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Daily withdrawal limit reached",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/user/cooldowns": {
+            "get": {
+                "description": "Actions such as search, slots, starting a gamble, giving and using items that are still on cooldown, soonest ready first. Actions that are ready are not listed.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user"
+                ],
+                "summary": "List cooldowns",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Platform",
+                        "name": "platform",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Platform user ID",
+                        "name": "platform_id",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.CooldownsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/user/crafting/mastery": {
+            "get": {
+                "description": "Get how many times a user has crafted each recipe and which recipes they have mastered. Mastered recipes craft masterworks more often.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "crafting"
+                ],
+                "summary": "Get recipe mastery",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Platform (twitch, youtube, discord)",
+                        "name": "platform",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Platform-specific user ID (self-mode)",
+                        "name": "platform_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Username (target-mode)",
+                        "name": "username",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.RecipeMasteryResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/user/effects": {
+            "get": {
+                "description": "Timed buffs from items such as the clover or the aegis, soonest to expire first.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user"
+                ],
+                "summary": "List active effects",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Platform",
+                        "name": "platform",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Platform user ID",
+                        "name": "platform_id",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.EffectsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/user/garden": {
+            "get": {
+                "description": "The user's planted plots, each marked ready once its crop has grown, and how many plots they can plant. Garden plot upgrades add plots on top of the base two.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user"
+                ],
+                "summary": "Get garden",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Platform",
+                        "name": "platform",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Platform user ID",
+                        "name": "platform_id",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/domain.Garden"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/user/garden/harvest": {
+            "post": {
+                "description": "Clears every plot whose crop has grown and puts the crops in the user's inventory. Awards Farmer XP.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user"
+                ],
+                "summary": "Harvest garden",
+                "parameters": [
+                    {
+                        "description": "Gardener",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.GardenHarvestRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/domain.GardenHarvest"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Nothing is ready to harvest",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/user/garden/plant": {
+            "post": {
+                "description": "Uses up one seed item and plants it in the user's lowest free plot. The crop is ready to harvest once the seed's grow time has passed.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user"
+                ],
+                "summary": "Plant a seed",
+                "parameters": [
+                    {
+                        "description": "Seed to plant",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.PlantRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/domain.GardenPlot"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Every plot is planted",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/user/gifts": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "inventory"
+                ],
+                "summary": "List sent gifts",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Platform",
+                        "name": "platform",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Platform user ID",
+                        "name": "platform_id",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.GiftsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Takes items from the sender and delivers them to the recipient at the chosen time, with an optional message. Gifts count toward the daily give limit; money, borrowed and locked items cannot be gifted. Publishes a \"gift.delivered\" event on delivery.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "inventory"
+                ],
+                "summary": "Wrap gift",
+                "parameters": [
+                    {
+                        "description": "Gift",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.WrapGiftRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/domain.Gift"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Too many gifts waiting to be delivered",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/user/gifts/{id}": {
+            "delete": {
+                "description": "Only the sender can cancel a gift, and only before it is delivered. The items go back to the sender.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "inventory"
+                ],
+                "summary": "Cancel gift",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Gift ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Platform",
+                        "name": "platform",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Platform user ID",
+                        "name": "platform_id",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/domain.Gift"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/user/inbox": {
+            "get": {
+                "description": "The user's newest notifications, such as delivered gifts, market sales, returned loans, boss loot, celebrations and unlocked milestones, with how many are unread.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user"
+                ],
+                "summary": "Get inbox",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Platform",
+                        "name": "platform",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Platform user ID",
+                        "name": "platform_id",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Only unread messages",
+                        "name": "unread",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Number of messages (default 20, max 50)",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/domain.Inbox"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/user/inbox/read": {
+            "post": {
+                "description": "Marks the given messages read, or every message when no IDs are given. Messages that are already read or belong to someone else are skipped.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user"
+                ],
+                "summary": "Mark inbox messages read",
+                "parameters": [
+                    {
+                        "description": "Messages to mark",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.MarkInboxReadRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.MarkInboxReadResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/user/inventory": {
+            "get": {
+                "description": "Get the user's inventory and how many of its slots are filled",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "inventory"
+                ],
+                "summary": "Get inventory",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Platform",
+                        "name": "platform",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Platform ID",
+                        "name": "platform_id",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Username",
+                        "name": "username",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by item type (upgrade, sellable, consumable)",
+                        "name": "filter",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.GetInventoryResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/user/inventory-by-username": {
+            "get": {
+                "description": "Get a user's inventory using only platform and username",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "inventory"
+                ],
+                "summary": "Get inventory by username",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Platform",
+                        "name": "platform",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Username",
+                        "name": "username",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by item type",
+                        "name": "filter",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.GetInventoryResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/user/item/add": {
+            "post": {
+                "description": "Add an item to a user's inventory using only platform and username. This is an admin/system action.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "inventory"
+                ],
+                "summary": "Add item by username",
+                "parameters": [
+                    {
+                        "description": "Item details including platform, username, and quantity",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.AddItemByUsernameRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.SuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request data",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ]
+            }
+        },
+        "/api/v1/user/item/buy": {
+            "post": {
+                "description": "Buy an item with currency",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "economy"
+                ],
+                "summary": "Buy item",
+                "parameters": [
+                    {
+                        "description": "Buy details",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.BuyItemRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.BuyItemResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Feature locked",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/user/item/disassemble": {
+            "post": {
+                "description": "Disassemble an item into materials",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "crafting"
+                ],
+                "summary": "Disassemble item",
+                "parameters": [
+                    {
+                        "description": "Disassemble details",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.CraftingActionRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.DisassembleItemResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Feature locked",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/user/item/favorite": {
+            "post": {
+                "description": "Favorites are marked in the inventory listing and have no other effect.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "inventory"
+                ],
+                "summary": "Favorite or unfavorite an item",
+                "parameters": [
+                    {
+                        "description": "Favorite",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.SetItemFavoriteRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/domain.ItemFlags"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/user/item/give": {
+            "post": {
+                "description": "Transfer an item from one user's inventory to another user.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "inventory"
+                ],
+                "summary": "Give item to another user",
+                "parameters": [
+                    {
+                        "description": "Transfer details including owner and receiver info",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.GiveItemRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.SuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request or self-gifting attempt",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Account too new to give items",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "429": {
+                        "description": "Daily give limit reached",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/user/item/lock": {
+            "post": {
+                "description": "A locked item cannot be used, sold, given or disassembled until it is unlocked. The lock covers every quality of the item.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "inventory"
+                ],
+                "summary": "Lock or unlock an item",
+                "parameters": [
+                    {
+                        "description": "Lock",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.SetItemLockRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/domain.ItemFlags"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/user/item/remove": {
+            "post": {
+                "description": "Remove an item from a user's inventory using only platform and username",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "inventory"
+                ],
+                "summary": "Remove item by username",
+                "parameters": [
+                    {
+                        "description": "Item details",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.RemoveItemByUsernameRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.RemoveItemResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/user/item/sell": {
+            "post": {
+                "description": "Sell an item from inventory for currency. Requires Economy feature to be unlocked.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "economy"
+                ],
+                "summary": "Sell item",
+                "parameters": [
+                    {
+                        "description": "Details of the item to sell and quantity",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.SellItemRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.SellItemResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Item not found or not sellable",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Economy feature locked",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/user/item/upgrade": {
+            "post": {
+                "description": "Upgrade an item to a higher tier",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "crafting"
+                ],
+                "summary": "Upgrade item",
+                "parameters": [
+                    {
+                        "description": "Upgrade details",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.CraftingActionRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.UpgradeItemResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Feature locked",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/user/item/upgrade/preview": {
+            "post": {
+                "description": "Show exact material costs, masterwork chance, and expected outputs for upgrading a quantity of an item, without crafting anything",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "crafting"
+                ],
+                "summary": "Preview upgrade",
+                "parameters": [
+                    {
+                        "description": "Upgrade details",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.CraftingActionRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/crafting.UpgradePreview"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Feature locked",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/user/item/use": {
+            "post": {
+                "description": "Use an item from inventory",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "inventory"
+                ],
+                "summary": "Use item",
+                "parameters": [
+                    {
+                        "description": "Usage details",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.UseItemRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.UseItemResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Item locked by progression",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/user/loans": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "inventory"
+                ],
+                "summary": "List item loans",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Platform",
+                        "name": "platform",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Platform user ID",
+                        "name": "platform_id",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.LoansResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Moves items to the borrower until the loan falls due, when they go back to the lender automatically. Borrowed items cannot be sold, disassembled or lent on. A borrower who no longer has all the items returns what they have and the loan is marked defaulted. Publishes \"item.loaned\" and \"item.loan_returned\" events.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "inventory"
+                ],
+                "summary": "Lend item",
+                "parameters": [
+                    {
+                        "description": "Loan",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.LendItemRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/domain.ItemLoan"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/user/loans/{id}/return": {
+            "post": {
+                "description": "Only the borrower can return a loan early, and only with all of the borrowed items.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "inventory"
+                ],
+                "summary": "Return loan",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Loan ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Platform",
+                        "name": "platform",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Platform user ID",
+                        "name": "platform_id",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/domain.ItemLoan"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/user/preferences": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user"
+                ],
+                "summary": "Get user preferences",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Platform",
+                        "name": "platform",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Platform user ID",
+                        "name": "platform_id",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/domain.UserSettings"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Opts the user out of targeted items such as weapons and traps, or back in. Opted-out users cannot be targeted, are skipped by random-target items, and cannot target others.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user"
+                ],
+                "summary": "Update user preferences",
+                "parameters": [
+                    {
+                        "description": "Preferences",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.UpdateUserPreferencesRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/domain.UserSettings"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/user/progression": {
+            "get": {
+                "description": "Lists the personal tracks with the user's progress toward each milestone. Milestones unlock from the user's own engagement and publish a \"progression.milestone_unlocked\" event.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "progression"
+                ],
+                "summary": "Get personal progression",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Platform",
+                        "name": "platform",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Platform user ID",
+                        "name": "platform_id",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.PersonalProgressionResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/user/register": {
+            "post": {
+                "description": "Register a new user or link an existing user to a new platform",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user"
+                ],
+                "summary": "Register or link a user",
+                "parameters": [
+                    {
+                        "description": "Registration details",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.RegisterUserRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "User updated",
+                        "schema": {
+                            "$ref": "#/definitions/domain.User"
+                        }
+                    },
+                    "201": {
+                        "description": "User created",
+                        "schema": {
+                            "$ref": "#/definitions/domain.User"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/user/reminders": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user"
+                ],
+                "summary": "List reminders",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Platform",
+                        "name": "platform",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Platform user ID",
+                        "name": "platform_id",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.RemindersResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Pings the user when a cooldown expires (kind \"cooldown\", target is the action), shortly before the open vote closes (\"vote\") or when their compost bin is ready (\"compost\"). Asking again for the same thing moves the existing reminder. Due reminders are published as \"reminder.due\" SSE events.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user"
+                ],
+                "summary": "Create reminder",
+                "parameters": [
+                    {
+                        "description": "Reminder",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.CreateReminderRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/domain.Reminder"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/user/reminders/{id}": {
+            "delete": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user"
+                ],
+                "summary": "Cancel reminder",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Reminder ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Platform",
+                        "name": "platform",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Platform user ID",
+                        "name": "platform_id",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.SuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/user/search": {
+            "post": {
+                "description": "Allows players to search for loot boxes. Results depend on daily usage and character progression.\nPass a location to search a specific region instead of the one picked for the player.\nThe response includes the player's search streak (bonus lootboxes on the first search of each day from 3 days on) and mastery level (each level shortens the search cooldown by 2%).",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user"
+                ],
+                "summary": "Perform environment search",
+                "parameters": [
+                    {
+                        "description": "User identification",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.SearchRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Region key to search (see /user/search/locations)",
+                        "name": "location",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.SearchResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Unknown location",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Invalid API Key",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Location locked",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "429": {
+                        "description": "Action on cooldown",
+                        "schema": {
+                            "$ref": "#/definitions/handler.CooldownErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/user/search/locations": {
+            "get": {
+                "description": "Lists every search region with its unlock requirements, whether the player has unlocked it, and how long until it is off cooldown.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user"
+                ],
+                "summary": "List search locations",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Platform",
+                        "name": "platform",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Platform ID",
+                        "name": "platform_id",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Username",
+                        "name": "username",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.SearchLocationsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/user/settings": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user"
+                ],
+                "summary": "Get user settings",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Platform",
+                        "name": "platform",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Platform user ID",
+                        "name": "platform_id",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/domain.UserSettings"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Sets leaderboard privacy. Private users still accrue stats and contribution but appear as \"Anonymous\" on leaderboards and contribution rankings.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user"
+                ],
+                "summary": "Update user settings",
+                "parameters": [
+                    {
+                        "description": "Settings",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.UpdateUserSettingsRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/domain.UserSettings"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/user/theme": {
+            "get": {
+                "description": "Lists every item name theme with whether progression has unlocked it, the theme the calendar shows today, and the user's pick (empty follows the calendar).",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user"
+                ],
+                "summary": "Get item name themes",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Platform",
+                        "name": "platform",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Platform user ID",
+                        "name": "platform_id",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/domain.NameThemes"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Item names in the user's messages use the picked theme all year. Themes tied to a progression feature can only be picked once it is unlocked. An empty theme follows the calendar again.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user"
+                ],
+                "summary": "Pick item name theme",
+                "parameters": [
+                    {
+                        "description": "Theme",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.UpdateUserThemeRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/domain.UserSettings"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/user/timeout": {
+            "get": {
+                "description": "Get the remaining timeout duration for a user",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user"
+                ],
+                "summary": "Get user timeout",
+                "parameters": [
+                    {
+                        "enum": [
+                            "twitch",
+                            "youtube",
+                            "discord"
+                        ],
+                        "type": "string",
+                        "description": "Platform (default: twitch)",
+                        "name": "platform",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Username to check",
+                        "name": "username",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.GetUserTimeoutResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Apply or extend a timeout for a user (accumulates with existing timeout)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user"
+                ],
+                "summary": "Set user timeout",
+                "parameters": [
+                    {
+                        "description": "Timeout details",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.SetTimeoutRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.SetTimeoutResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/user/undo": {
+            "post": {
+                "description": "Reverses the user's latest sell, disassemble or give within the undo window (UNDO_WINDOW), provided the items and money it takes back are still held. Taxes are not refunded. Undo is on a cooldown set by COOLDOWN_UNDO.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "inventory"
+                ],
+                "summary": "Undo last operation",
+                "parameters": [
+                    {
+                        "description": "User",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.UndoRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/domain.UndoEntry"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/handler.CooldownErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/healthz": {
+            "get": {
+                "description": "Returns OK if the service is running",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "health"
+                ],
+                "summary": "Liveness check",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.HealthResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/readyz": {
+            "get": {
+                "description": "Returns OK if the service is ready to accept traffic (database connected)",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "health"
+                ],
+                "summary": "Readiness check",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.HealthResponse"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/handler.HealthResponse"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "activechatter.Chatter": {
+            "type": "object",
+            "properties": {
+                "last_message_at": {
+                    "type": "string"
+                },
+                "platform": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "string"
+                },
+                "username": {
+                    "type": "string"
+                }
+            }
+        },
+        "admin.AdminAwardXPRequest": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "description": "XP amount to award",
+                    "type": "integer"
+                },
+                "job_key": {
+                    "description": "explorer, blacksmith, etc.",
+                    "type": "string"
+                },
+                "platform": {
+                    "description": "discord, twitch, youtube",
+                    "type": "string"
+                },
+                "username": {
+                    "description": "Platform username",
+                    "type": "string"
+                }
+            }
+        },
+        "admin.AdminAwardXPResponse": {
+            "type": "object",
+            "properties": {
+                "job_key": {
+                    "type": "string"
+                },
+                "result": {
+                    "$ref": "#/definitions/domain.XPAwardResult"
+                },
+                "success": {
+                    "type": "boolean"
+                },
+                "user_id": {
+                    "type": "string"
+                },
+                "username": {
+                    "type": "string"
+                },
+                "xp_awarded": {
+                    "type": "integer"
+                }
+            }
+        },
+        "admin.BusinessMetrics": {
+            "type": "object",
+            "properties": {
+                "items_bought": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "number",
+                        "format": "float64"
+                    }
+                },
+                "items_sold": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "number",
+                        "format": "float64"
+                    }
+                }
+            }
+        },
+        "admin.ClearTimeoutRequest": {
+            "type": "object",
+            "required": [
+                "platform",
+                "username"
+            ],
+            "properties": {
+                "platform": {
+                    "type": "string"
+                },
+                "username": {
+                    "type": "string",
+                    "maxLength": 100
+                }
+            }
+        },
+        "admin.ClearTimeoutResponse": {
+            "type": "object",
+            "properties": {
+                "message": {
+                    "type": "string"
+                },
+                "platform": {
+                    "type": "string"
+                },
+                "username": {
+                    "type": "string"
+                }
+            }
+        },
+        "admin.ConfigReloadResponse": {
+            "type": "object",
+            "properties": {
+                "message": {
+                    "type": "string"
+                },
+                "results": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/configreload.Result"
+                    }
+                }
+            }
+        },
+        "admin.EventLogEntry": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "event_type": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "metadata": {},
+                "payload": {},
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "admin.EventMetrics": {
+            "type": "object",
+            "properties": {
+                "handler_errors_by_type": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "number",
+                        "format": "float64"
+                    }
+                },
+                "published_total_by_type": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "number",
+                        "format": "float64"
+                    }
+                }
+            }
+        },
+        "admin.EventsResponse": {
+            "type": "object",
+            "properties": {
+                "events": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/admin.EventLogEntry"
+                    }
+                }
+            }
+        },
+        "admin.HTTPMetrics": {
+            "type": "object",
+            "properties": {
+                "avg_latency_ms": {
+                    "type": "number"
+                },
+                "in_flight": {
+                    "type": "number"
+                },
+                "p95_latency_ms": {
+                    "type": "number"
+                },
+                "requests_total_by_status": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "number",
+                        "format": "float64"
+                    }
+                }
+            }
+        },
+        "admin.ManualResetResponse": {
+            "type": "object",
+            "properties": {
+                "message": {
+                    "type": "string"
+                },
+                "records_affected": {
+                    "type": "integer"
+                },
+                "success": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "admin.MetricsResponse": {
+            "type": "object",
+            "properties": {
+                "business": {
+                    "$ref": "#/definitions/admin.BusinessMetrics"
+                },
+                "events": {
+                    "$ref": "#/definitions/admin.EventMetrics"
+                },
+                "http": {
+                    "$ref": "#/definitions/admin.HTTPMetrics"
+                },
+                "sse": {
+                    "$ref": "#/definitions/admin.SSEMetrics"
+                }
+            }
+        },
+        "admin.SSEBroadcastRequest": {
+            "type": "object",
+            "properties": {
+                "payload": {
+                    "type": "object"
+                },
+                "type": {
+                    "type": "string"
+                }
+            }
+        },
+        "admin.SSEBroadcastResponse": {
+            "type": "object",
+            "properties": {
+                "message": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string"
+                }
+            }
+        },
+        "admin.SSEMetrics": {
+            "type": "object",
+            "properties": {
+                "client_count": {
+                    "type": "integer"
+                }
+            }
+        },
+        "admin.UserLookupResponse": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "platform": {
+                    "type": "string"
+                },
+                "platform_id": {
+                    "type": "string"
+                },
+                "username": {
+                    "type": "string"
+                }
+            }
+        },
+        "compost.DepositItem": {
+            "type": "object",
+            "properties": {
+                "item_name": {
+                    "type": "string"
+                },
+                "quantity": {
+                    "type": "integer"
+                }
+            }
+        },
+        "configreload.Result": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "path": {
+                    "type": "string"
+                }
+            }
+        },
+        "crafting.MaterialCost": {
+            "type": "object",
+            "properties": {
+                "item_name": {
+                    "type": "string"
+                },
+                "owned": {
+                    "type": "integer"
+                },
+                "required": {
+                    "type": "integer"
+                },
+                "shortfall": {
+                    "type": "integer"
+                }
+            }
+        },
+        "crafting.RecipeMastery": {
+            "type": "object",
+            "properties": {
+                "crafts": {
+                    "type": "integer"
+                },
+                "item_name": {
+                    "type": "string"
+                },
+                "mastered": {
+                    "type": "boolean"
+                },
+                "masterwork_bonus": {
+                    "type": "number"
+                },
+                "recipe_key": {
+                    "type": "string"
+                },
+                "threshold": {
+                    "type": "integer"
+                }
+            }
+        },
+        "crafting.UpgradePreview": {
+            "type": "object",
+            "properties": {
+                "expected_output": {
+                    "type": "number"
+                },
+                "fine_chance": {
+                    "type": "number"
+                },
+                "item_name": {
+                    "type": "string"
+                },
+                "legendary_chance": {
+                    "type": "number"
+                },
+                "masterwork_chance": {
+                    "type": "number"
+                },
+                "materials": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/crafting.MaterialCost"
+                    }
+                },
+                "max_output": {
+                    "type": "integer"
+                },
+                "min_output": {
+                    "type": "integer"
+                },
+                "quantity_craftable": {
+                    "type": "integer"
+                },
+                "quantity_requested": {
+                    "type": "integer"
+                }
+            }
+        },
+        "domain.BankAccount": {
+            "type": "object",
+            "properties": {
+                "balance": {
+                    "type": "integer"
+                },
+                "goal": {
+                    "description": "Savings goal, 0 when none is set",
+                    "type": "integer"
+                },
+                "goal_set_at": {
+                    "type": "string"
+                },
+                "goal_start": {
+                    "description": "Balance when the goal was set",
+                    "type": "integer"
+                },
+                "goals_reached": {
+                    "type": "integer"
+                },
+                "interest_earned": {
+                    "description": "Total interest ever paid to the account",
+                    "type": "integer"
+                },
+                "interest_rate": {
+                    "description": "Share of the balance paid per interest payout",
+                    "type": "number"
+                },
+                "last_interest_at": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "string"
+                },
+                "withdraw_limit": {
+                    "description": "WithdrawLimit is the most that can be withdrawn per UTC day, 0 for no limit",
+                    "type": "integer"
+                },
+                "withdrawn_today": {
+                    "description": "WithdrawnToday counts what was withdrawn on WithdrawnOn, a UTC day",
+                    "type": "integer"
+                }
+            }
+        },
+        "domain.BankTransaction": {
+            "type": "object",
+            "properties": {
+                "account": {
+                    "$ref": "#/definitions/domain.BankAccount"
+                },
+                "amount": {
+                    "type": "integer"
+                },
+                "goal_bonus": {
+                    "description": "GoalBonus is paid onto the balance when a deposit reaches the savings goal",
+                    "type": "integer"
+                }
+            }
+        },
+        "domain.Birthday": {
+            "type": "object",
+            "properties": {
+                "day": {
+                    "type": "integer"
+                },
+                "month": {
+                    "type": "integer"
+                }
+            }
+        },
+        "domain.Boss": {
+            "type": "object",
+            "properties": {
+                "community_id": {
+                    "type": "string"
+                },
+                "ended_at": {
+                    "type": "string"
+                },
+                "expires_at": {
+                    "type": "string"
+                },
+                "hp": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "key": {
+                    "type": "string"
+                },
+                "max_hp": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "reward_boxes": {
+                    "description": "Lootboxes shared out among attackers on defeat",
+                    "type": "integer"
+                },
+                "reward_lootbox": {
+                    "type": "string"
+                },
+                "spawned_at": {
+                    "type": "string"
+                },
+                "spawned_by": {
+                    "type": "string"
+                },
+                "state": {
+                    "$ref": "#/definitions/domain.BossState"
+                }
+            }
+        },
+        "domain.BossAttackResult": {
+            "type": "object",
+            "properties": {
+                "boss_id": {
+                    "type": "string"
+                },
+                "boss_name": {
+                    "type": "string"
+                },
+                "damage": {
+                    "type": "integer"
+                },
+                "defeated": {
+                    "description": "This attack landed the killing blow",
+                    "type": "boolean"
+                },
+                "hp": {
+                    "type": "integer"
+                },
+                "loot": {
+                    "description": "Loot lists every attacker's share, only on the killing blow",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.BossLoot"
+                    }
+                },
+                "max_hp": {
+                    "type": "integer"
+                },
+                "user_id": {
+                    "type": "string"
+                },
+                "username": {
+                    "type": "string"
+                },
+                "weapon": {
+                    "description": "Weapon item used up by the attack",
+                    "type": "string"
+                }
+            }
+        },
+        "domain.BossAttacker": {
+            "type": "object",
+            "properties": {
+                "attacks": {
+                    "type": "integer"
+                },
+                "damage": {
+                    "type": "integer"
+                },
+                "reward_boxes": {
+                    "description": "Lootboxes won, set once the boss is defeated",
+                    "type": "integer"
+                },
+                "user_id": {
+                    "type": "string"
+                },
+                "username": {
+                    "type": "string"
+                }
+            }
+        },
+        "domain.BossLoot": {
+            "type": "object",
+            "properties": {
+                "boxes": {
+                    "type": "integer"
+                },
+                "damage": {
+                    "type": "integer"
+                },
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.BossLootItem"
+                    }
+                },
+                "user_id": {
+                    "type": "string"
+                },
+                "username": {
+                    "type": "string"
+                }
+            }
+        },
+        "domain.BossLootItem": {
+            "type": "object",
+            "properties": {
+                "item_name": {
+                    "type": "string"
+                },
+                "quality": {
+                    "$ref": "#/definitions/domain.QualityLevel"
+                },
+                "quantity": {
+                    "type": "integer"
+                }
+            }
+        },
+        "domain.BossState": {
+            "type": "string",
+            "enum": [
+                "active",
+                "defeated",
+                "escaped"
+            ],
+            "x-enum-comments": {
+                "BossStateActive": "Spawned and taking attacks",
+                "BossStateDefeated": "Brought to 0 HP before it escaped",
+                "BossStateEscaped": "Still standing when its time ran out"
+            },
+            "x-enum-descriptions": [
+                "Spawned and taking attacks",
+                "Brought to 0 HP before it escaped",
+                "Still standing when its time ran out"
+            ],
+            "x-enum-varnames": [
+                "BossStateActive",
+                "BossStateDefeated",
+                "BossStateEscaped"
+            ]
+        },
+        "domain.BossStatus": {
+            "type": "object",
+            "properties": {
+                "boss": {
+                    "$ref": "#/definitions/domain.Boss"
+                },
+                "top_attackers": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.BossAttacker"
+                    }
+                }
+            }
+        },
+        "domain.ChatDrop": {
+            "type": "object",
+            "properties": {
+                "item_name": {
+                    "type": "string"
+                },
+                "platform": {
+                    "type": "string"
+                },
+                "quantity": {
+                    "type": "integer"
+                },
+                "user_id": {
+                    "type": "string"
+                },
+                "username": {
+                    "type": "string"
+                }
+            }
+        },
+        "domain.CommandResult": {
+            "type": "object",
+            "properties": {
+                "args": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "code": {
+                    "type": "string"
+                },
+                "data": {
+                    "type": "object"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "ok": {
+                    "type": "boolean"
+                },
+                "reply": {
+                    "type": "string"
+                }
+            }
+        },
+        "domain.CommunityDonation": {
+            "type": "object",
+            "properties": {
+                "item_name": {
+                    "type": "string"
+                },
+                "points": {
+                    "description": "Progression contribution it bought",
+                    "type": "integer"
+                },
+                "quantity": {
+                    "type": "integer"
+                },
+                "user_id": {
+                    "type": "string"
+                },
+                "value": {
+                    "description": "What the donation was worth in money",
+                    "type": "integer"
+                }
+            }
+        },
+        "domain.CommunityDonor": {
+            "type": "object",
+            "properties": {
+                "donations": {
+                    "type": "integer"
+                },
+                "points": {
+                    "type": "integer"
+                },
+                "total_value": {
+                    "type": "integer"
+                },
+                "user_id": {
+                    "type": "string"
+                },
+                "username": {
+                    "type": "string"
+                }
+            }
+        },
+        "domain.CommunityEffect": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "expires_at": {
+                    "type": "string"
+                },
+                "magnitude": {
+                    "type": "number"
+                },
+                "source": {
+                    "type": "string"
+                },
+                "type": {
+                    "$ref": "#/definitions/domain.EffectType"
+                }
+            }
+        },
+        "domain.CommunityPoolStatus": {
+            "type": "object",
+            "properties": {
+                "balance": {
+                    "type": "integer"
+                },
+                "donors": {
+                    "type": "integer"
+                },
+                "money_per_point": {
+                    "type": "integer"
+                },
+                "points_funded": {
+                    "description": "Contribution points bought by donations",
+                    "type": "integer"
+                },
+                "total_donated": {
+                    "type": "integer"
+                }
+            }
+        },
+        "domain.CompostBinItem": {
+            "type": "object",
+            "properties": {
+                "base_value": {
+                    "type": "integer"
+                },
+                "content_types": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "item_id": {
+                    "type": "integer"
+                },
+                "item_name": {
+                    "type": "string"
+                },
+                "quality_level": {
+                    "$ref": "#/definitions/domain.QualityLevel"
+                },
+                "quantity": {
+                    "type": "integer"
+                }
+            }
+        },
+        "domain.CompostBinStatus": {
+            "type": "string",
+            "enum": [
+                "idle",
+                "composting",
+                "ready",
+                "sludge"
+            ],
+            "x-enum-varnames": [
+                "CompostBinStatusIdle",
+                "CompostBinStatusComposting",
+                "CompostBinStatusReady",
+                "CompostBinStatusSludge"
+            ]
+        },
+        "domain.CompostOutput": {
+            "type": "object",
+            "properties": {
+                "is_sludge": {
+                    "type": "boolean"
+                },
+                "items": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "integer"
+                    }
+                },
+                "message": {
+                    "type": "string"
+                },
+                "total_value": {
+                    "type": "integer"
+                }
+            }
+        },
+        "domain.CompostStatusResponse": {
+            "type": "object",
+            "properties": {
+                "capacity": {
+                    "type": "integer"
+                },
+                "item_count": {
+                    "type": "integer"
+                },
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.CompostBinItem"
+                    }
+                },
+                "ready_at": {
+                    "type": "string"
+                },
+                "sludge_at": {
+                    "type": "string"
+                },
+                "status": {
+                    "$ref": "#/definitions/domain.CompostBinStatus"
+                },
+                "time_left": {
+                    "type": "string"
+                }
+            }
+        },
+        "domain.ConfigDelta": {
+            "type": "object",
+            "properties": {
+                "delta": {
+                    "type": "number"
+                },
+                "key": {
+                    "type": "string"
+                }
+            }
+        },
+        "domain.ContributionBreakdown": {
+            "type": "object",
+            "properties": {
+                "by_type": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "integer"
+                    }
+                },
+                "commands_used": {
+                    "type": "integer"
+                },
+                "external": {
+                    "description": "Contribution points credited by external source",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "integer"
+                    }
+                },
+                "items_crafted": {
+                    "type": "integer"
+                },
+                "items_used": {
+                    "type": "integer"
+                },
+                "messages_sent": {
+                    "type": "integer"
+                },
+                "total_score": {
+                    "type": "integer"
+                }
+            }
+        },
+        "domain.ContributionLeaderboardEntry": {
+            "type": "object",
+            "properties": {
+                "contribution": {
+                    "type": "integer"
+                },
+                "rank": {
+                    "type": "integer"
+                },
+                "user_id": {
+                    "type": "string"
+                },
+                "username": {
+                    "description": "Only set to AnonymousDisplayName for private users",
+                    "type": "string"
+                }
+            }
+        },
+        "domain.CooldownTimer": {
+            "type": "object",
+            "properties": {
+                "action": {
+                    "type": "string"
+                },
+                "ready_at": {
+                    "type": "string"
+                },
+                "remaining_seconds": {
+                    "type": "integer"
+                }
+            }
+        },
+        "domain.DailyResetStatus": {
+            "type": "object",
+            "properties": {
+                "last_reset_time": {
+                    "type": "string"
+                },
+                "next_reset_time": {
+                    "type": "string"
+                },
+                "records_affected": {
+                    "type": "integer"
+                }
+            }
+        },
+        "domain.DigLevel": {
+            "type": "object",
+            "properties": {
+                "contribution": {
+                    "description": "Progression points granted the first time the site reaches the level",
+                    "type": "integer"
+                },
+                "level": {
+                    "type": "integer"
+                },
+                "min_depth": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "domain.DigResult": {
+            "type": "object",
+            "properties": {
+                "contribution": {
+                    "description": "Progression points the milestones granted",
+                    "type": "integer"
+                },
+                "depth": {
+                    "type": "integer"
+                },
+                "level": {
+                    "$ref": "#/definitions/domain.DigLevel"
+                },
+                "meters": {
+                    "type": "integer"
+                },
+                "milestones": {
+                    "description": "Levels this dig reached first",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.DigLevel"
+                    }
+                },
+                "next_level": {
+                    "$ref": "#/definitions/domain.DigLevel"
+                },
+                "user_id": {
+                    "type": "string"
+                },
+                "username": {
+                    "type": "string"
+                }
+            }
+        },
+        "domain.DigSiteStatus": {
+            "type": "object",
+            "properties": {
+                "depth": {
+                    "type": "integer"
+                },
+                "level": {
+                    "$ref": "#/definitions/domain.DigLevel"
+                },
+                "levels": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.DigLevel"
+                    }
+                },
+                "next_level": {
+                    "$ref": "#/definitions/domain.DigLevel"
+                },
+                "top_diggers": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.Digger"
+                    }
+                },
+                "total_digs": {
+                    "type": "integer"
+                }
+            }
+        },
+        "domain.Digger": {
+            "type": "object",
+            "properties": {
+                "digs": {
+                    "type": "integer"
+                },
+                "last_dug_at": {
+                    "type": "string"
+                },
+                "meters": {
+                    "type": "integer"
+                },
+                "user_id": {
+                    "type": "string"
+                },
+                "username": {
+                    "type": "string"
+                }
+            }
+        },
+        "domain.Effect": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "expires_at": {
+                    "type": "string"
+                },
+                "magnitude": {
+                    "type": "number"
+                },
+                "type": {
+                    "$ref": "#/definitions/domain.EffectType"
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "domain.EffectType": {
+            "type": "string",
+            "enum": [
+                "search_luck",
+                "sell_bonus",
+                "gamble_luck",
+                "timeout_immunity",
+                "job_xp_boost",
+                "contribution_boost"
+            ],
+            "x-enum-varnames": [
+                "EffectSearchLuck",
+                "EffectSellBonus",
+                "EffectGambleLuck",
+                "EffectTimeoutImmunity",
+                "EffectJobXPBoost",
+                "EffectContributionBoost"
+            ]
+        },
+        "domain.EventType": {
+            "type": "string",
+            "enum": [
+                "user_registered",
+                "item_added",
+                "item_removed",
+                "item_used",
+                "item_sold",
+                "item_bought",
+                "item_transferred",
+                "message_received",
+                "gamble_near_miss",
+                "gamble_tie_break_lost",
+                "gamble_critical_fail",
+                "gamble_won",
+                "daily_streak",
+                "search",
+                "search_near_miss",
+                "search_critical_fail",
+                "search_critical_success",
+                "crafting_critical_success",
+                "crafting_perfect_salvage",
+                "recipe_crafted",
+                "job_level_up",
+                "lootbox_jackpot",
+                "lootbox_big_win",
+                "slots_spin",
+                "slots_win",
+                "slots_mega_jackpot",
+                "chat_drop",
+                "boss_attack"
+            ],
+            "x-enum-varnames": [
+                "StatsEventUserRegistered",
+                "StatsEventItemAdded",
+                "StatsEventItemRemoved",
+                "StatsEventItemUsed",
+                "StatsEventItemSold",
+                "StatsEventItemBought",
+                "StatsEventItemTransferred",
+                "StatsEventMessageReceived",
+                "StatsEventGambleNearMiss",
+                "StatsEventGambleTieBreakLost",
+                "StatsEventGambleCriticalFail",
+                "StatsEventGambleWon",
+                "StatsEventDailyStreak",
+                "StatsEventSearch",
+                "StatsEventSearchNearMiss",
+                "StatsEventSearchCriticalFail",
+                "StatsEventSearchCriticalSuccess",
+                "EventTypeCraftingCriticalSuccess",
+                "EventTypeCraftingPerfectSalvage",
+                "StatsEventRecipeCrafted",
+                "EventTypeJobLevelUp",
+                "EventTypeLootboxJackpot",
+                "EventTypeLootboxBigWin",
+                "EventTypeSlotsSpin",
+                "EventTypeSlotsWin",
+                "EventTypeSlotsMegaJackpot",
+                "StatsEventChatDrop",
+                "StatsEventBossAttack"
+            ]
+        },
+        "domain.Expedition": {
+            "type": "object",
+            "properties": {
+                "completed_at": {
+                    "type": "string"
+                },
+                "completion_deadline": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "expedition_type": {
+                    "$ref": "#/definitions/domain.ExpeditionType"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "initiator_id": {
+                    "type": "string"
+                },
+                "join_deadline": {
+                    "type": "string"
+                },
+                "metadata": {
+                    "$ref": "#/definitions/domain.ExpeditionMetadata"
+                },
+                "state": {
+                    "$ref": "#/definitions/domain.ExpeditionState"
+                }
+            }
+        },
+        "domain.ExpeditionDetails": {
+            "type": "object",
+            "properties": {
+                "expedition": {
+                    "$ref": "#/definitions/domain.Expedition"
+                },
+                "participants": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.ExpeditionParticipant"
+                    }
+                }
+            }
+        },
+        "domain.ExpeditionJournalEntry": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "encounter_type": {
+                    "type": "string"
+                },
+                "expedition_id": {
+                    "type": "string"
+                },
+                "fatigue": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "narrative": {
+                    "type": "string"
+                },
+                "outcome": {
+                    "type": "string"
+                },
+                "primary_member": {
+                    "type": "string"
+                },
+                "purse": {
+                    "type": "integer"
+                },
+                "skill_checked": {
+                    "type": "string"
+                },
+                "skill_passed": {
+                    "type": "boolean"
+                },
+                "turn_number": {
+                    "type": "integer"
+                }
+            }
+        },
+        "domain.ExpeditionMetadata": {
+            "type": "object",
+            "properties": {
+                "difficulty": {
+                    "type": "string"
+                },
+                "loot_table_key": {
+                    "type": "string"
+                },
+                "max_participants": {
+                    "type": "integer"
+                },
+                "min_participants": {
+                    "type": "integer"
+                },
+                "modifiers": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "number",
+                        "format": "float64"
+                    }
+                },
+                "success_rate": {
+                    "type": "number"
+                }
+            }
+        },
+        "domain.ExpeditionParticipant": {
+            "type": "object",
+            "properties": {
+                "expedition_id": {
+                    "type": "string"
+                },
+                "final_items": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "final_money": {
+                    "type": "integer"
+                },
+                "final_xp": {
+                    "type": "integer"
+                },
+                "is_leader": {
+                    "type": "boolean"
+                },
+                "job_levels": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "integer"
+                    }
+                },
+                "joined_at": {
+                    "type": "string"
+                },
+                "rewards": {
+                    "$ref": "#/definitions/domain.ExpeditionRewards"
+                },
+                "user_id": {
+                    "type": "string"
+                },
+                "username": {
+                    "type": "string"
+                }
+            }
+        },
+        "domain.ExpeditionRewards": {
+            "type": "object",
+            "properties": {
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "money": {
+                    "type": "integer"
+                },
+                "xp": {
+                    "type": "integer"
+                }
+            }
+        },
+        "domain.ExpeditionState": {
+            "type": "string",
+            "enum": [
+                "Created",
+                "Recruiting",
+                "InProgress",
+                "Completed"
+            ],
+            "x-enum-varnames": [
+                "ExpeditionStateCreated",
+                "ExpeditionStateRecruiting",
+                "ExpeditionStateInProgress",
+                "ExpeditionStateCompleted"
+            ]
+        },
+        "domain.ExpeditionStatus": {
+            "type": "object",
+            "properties": {
+                "active_details": {
+                    "$ref": "#/definitions/domain.ExpeditionDetails"
+                },
+                "cooldown_expires": {
+                    "type": "string"
+                },
+                "has_active": {
+                    "type": "boolean"
+                },
+                "on_cooldown": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "domain.ExpeditionType": {
+            "type": "string",
+            "enum": [
+                "standard",
+                "normal"
+            ],
+            "x-enum-comments": {
+                "ExpeditionTypeNormal": "Alias for standard/tests"
+            },
+            "x-enum-descriptions": [
+                "",
+                "Alias for standard/tests"
+            ],
+            "x-enum-varnames": [
+                "ExpeditionTypeStandard",
+                "ExpeditionTypeNormal"
+            ]
+        },
+        "domain.ExternalContributionResult": {
+            "type": "object",
+            "properties": {
+                "accepted": {
+                    "type": "integer"
+                },
+                "capped": {
+                    "description": "True when the hourly cap reduced the amount",
+                    "type": "boolean"
+                },
+                "requested": {
+                    "type": "integer"
+                },
+                "source": {
+                    "type": "string"
+                }
+            }
+        },
+        "domain.FishCatch": {
+            "type": "object",
+            "properties": {
+                "item_name": {
+                    "type": "string"
+                },
+                "public_name": {
+                    "type": "string"
+                },
+                "quality": {
+                    "$ref": "#/definitions/domain.QualityLevel"
+                },
+                "xp": {
+                    "description": "Fisher XP for the catch",
+                    "type": "integer"
+                }
+            }
+        },
+        "domain.FishingCast": {
+            "type": "object",
+            "properties": {
+                "cast_at": {
+                    "type": "string"
+                },
+                "tackle": {
+                    "description": "Tackle item used up by the cast",
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "string"
+                },
+                "username": {
+                    "type": "string"
+                }
+            }
+        },
+        "domain.FishingOutcome": {
+            "type": "string",
+            "enum": [
+                "caught",
+                "too_early",
+                "got_away"
+            ],
+            "x-enum-comments": {
+                "FishingOutcomeCaught": "Reeled in while the fish was biting",
+                "FishingOutcomeGotAway": "Reeled in after the bite window closed",
+                "FishingOutcomeTooEarly": "Reeled in before the bite, scaring the fish off"
+            },
+            "x-enum-descriptions": [
+                "Reeled in while the fish was biting",
+                "Reeled in before the bite, scaring the fish off",
+                "Reeled in after the bite window closed"
+            ],
+            "x-enum-varnames": [
+                "FishingOutcomeCaught",
+                "FishingOutcomeTooEarly",
+                "FishingOutcomeGotAway"
+            ]
+        },
+        "domain.FoundString": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "string"
+                },
+                "value": {
+                    "type": "string"
+                }
+            }
+        },
+        "domain.Gamble": {
+            "type": "object",
+            "properties": {
+                "community_id": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "initiator_id": {
+                    "type": "string"
+                },
+                "join_deadline": {
+                    "type": "string"
+                },
+                "participants": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.Participant"
+                    }
+                },
+                "side_bets": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.SideBet"
+                    }
+                },
+                "state": {
+                    "$ref": "#/definitions/domain.GambleState"
+                },
+                "total_value": {
+                    "type": "integer"
+                },
+                "winner_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "domain.GambleState": {
+            "type": "string",
+            "enum": [
+                "Created",
+                "Joining",
+                "Opening",
+                "Completed",
+                "Refunded"
+            ],
+            "x-enum-varnames": [
+                "GambleStateCreated",
+                "GambleStateJoining",
+                "GambleStateOpening",
+                "GambleStateCompleted",
+                "GambleStateRefunded"
+            ]
+        },
+        "domain.GameEvent": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "created_by": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "drop_rate_multiplier": {
+                    "description": "DropRateMultiplier scales the chance of a search or lootbox finding an item; 1 leaves it alone",
+                    "type": "number"
+                },
+                "ends_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "shop_discount_percent": {
+                    "description": "ShopDiscountPercent comes off shop prices",
+                    "type": "integer"
+                },
+                "starts_at": {
+                    "type": "string"
+                },
+                "theme": {
+                    "description": "Theme opens the crafting recipes and chat keyword drops of an event\ntheme, such as emote_fest, while the event runs; empty runs none",
+                    "type": "string"
+                },
+                "xp_multiplier": {
+                    "description": "XPMultiplier scales job XP; 1 leaves it alone",
+                    "type": "number"
+                }
+            }
+        },
+        "domain.GameEventStatus": {
+            "type": "object",
+            "properties": {
+                "events": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.GameEvent"
+                    }
+                },
+                "modifiers": {
+                    "$ref": "#/definitions/domain.GameModifiers"
+                }
+            }
+        },
+        "domain.GameModifiers": {
+            "type": "object",
+            "properties": {
+                "drop_rate_multiplier": {
+                    "type": "number"
+                },
+                "events": {
+                    "description": "Events names the running events, in start order",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "shop_discount_percent": {
+                    "type": "integer"
+                },
+                "themes": {
+                    "description": "Themes lists the event themes the running events open",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "xp_multiplier": {
+                    "type": "number"
+                }
+            }
+        },
+        "domain.Garden": {
+            "type": "object",
+            "properties": {
+                "capacity": {
+                    "description": "Plots unlocked by progression",
+                    "type": "integer"
+                },
+                "plots": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.GardenPlot"
+                    }
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "domain.GardenCrop": {
+            "type": "object",
+            "properties": {
+                "item_name": {
+                    "type": "string"
+                },
+                "quantity": {
+                    "type": "integer"
+                }
+            }
+        },
+        "domain.GardenHarvest": {
+            "type": "object",
+            "properties": {
+                "crops": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.GardenCrop"
+                    }
+                },
+                "plots": {
+                    "description": "Plots cleared by the harvest",
+                    "type": "integer"
+                },
+                "user_id": {
+                    "type": "string"
+                },
+                "username": {
+                    "type": "string"
+                },
+                "xp": {
+                    "description": "Farmer XP for the harvest",
+                    "type": "integer"
+                }
+            }
+        },
+        "domain.GardenPlot": {
+            "type": "object",
+            "properties": {
+                "crop": {
+                    "description": "Item the seed grows into",
+                    "type": "string"
+                },
+                "planted_at": {
+                    "type": "string"
+                },
+                "quantity": {
+                    "description": "How many of the crop it yields",
+                    "type": "integer"
+                },
+                "ready": {
+                    "description": "Worked out on access from ready_at",
+                    "type": "boolean"
+                },
+                "ready_at": {
+                    "type": "string"
+                },
+                "seed": {
+                    "type": "string"
+                },
+                "slot": {
+                    "type": "integer"
+                }
+            }
+        },
+        "domain.Gift": {
+            "type": "object",
+            "properties": {
+                "channel_id": {
+                    "type": "string"
+                },
+                "closed_at": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "deliver_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "item_id": {
+                    "type": "integer"
+                },
+                "item_name": {
+                    "type": "string"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "platform": {
+                    "description": "Platform and ChannelID say where the delivery is announced. An empty\nchannel announces the delivery to the recipient directly, by their\nRecipientPlatformID.",
+                    "type": "string"
+                },
+                "quality_level": {
+                    "$ref": "#/definitions/domain.QualityLevel"
+                },
+                "quantity": {
+                    "type": "integer"
+                },
+                "recipient_id": {
+                    "description": "RecipientID is empty once the recipient's account has been deleted",
+                    "type": "string"
+                },
+                "recipient_name": {
+                    "type": "string"
+                },
+                "recipient_platform_id": {
+                    "type": "string"
+                },
+                "sender_id": {
+                    "type": "string"
+                },
+                "sender_name": {
+                    "type": "string"
+                },
+                "status": {
+                    "$ref": "#/definitions/domain.GiftStatus"
+                }
+            }
+        },
+        "domain.GiftStatus": {
+            "type": "string",
+            "enum": [
+                "pending",
+                "delivered",
+                "cancelled"
+            ],
+            "x-enum-varnames": [
+                "GiftStatusPending",
+                "GiftStatusDelivered",
+                "GiftStatusCancelled"
+            ]
+        },
+        "domain.HarvestResponse": {
+            "type": "object",
+            "properties": {
+                "hours_since_harvest": {
+                    "type": "number"
+                },
+                "items_gained": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "integer"
+                    }
+                },
+                "message": {
+                    "type": "string"
+                },
+                "next_harvest_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "domain.HarvestResult": {
+            "type": "object",
+            "properties": {
+                "harvested": {
+                    "type": "boolean"
+                },
+                "output": {
+                    "$ref": "#/definitions/domain.CompostOutput"
+                },
+                "status": {
+                    "$ref": "#/definitions/domain.CompostStatusResponse"
+                }
+            }
+        },
+        "domain.Inbox": {
+            "type": "object",
+            "properties": {
+                "messages": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.InboxMessage"
+                    }
+                },
+                "unread": {
+                    "description": "Unread counts every unread message, not only those on this page",
+                    "type": "integer"
+                }
+            }
+        },
+        "domain.InboxKind": {
+            "type": "string",
+            "enum": [
+                "reward",
+                "gift",
+                "achievement"
+            ],
+            "x-enum-varnames": [
+                "InboxKindReward",
+                "InboxKindGift",
+                "InboxKindAchievement"
+            ]
+        },
+        "domain.InboxMessage": {
+            "type": "object",
+            "properties": {
+                "body": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "kind": {
+                    "$ref": "#/definitions/domain.InboxKind"
+                },
+                "read_at": {
+                    "description": "Nil while unread",
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "domain.InventoryCapacity": {
+            "type": "object",
+            "properties": {
+                "max_slots": {
+                    "description": "0 means no limit",
+                    "type": "integer"
+                },
+                "used_slots": {
+                    "type": "integer"
+                }
+            }
+        },
+        "domain.InventoryChange": {
+            "type": "object",
+            "properties": {
+                "delta": {
+                    "type": "integer"
+                },
+                "item_id": {
+                    "type": "integer"
+                },
+                "item_name": {
+                    "type": "string"
+                },
+                "quality_level": {
+                    "$ref": "#/definitions/domain.QualityLevel"
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "domain.Item": {
+            "type": "object",
+            "properties": {
+                "base_value": {
+                    "description": "Buy price before market adjustment",
+                    "type": "integer"
+                },
+                "buy_price": {
+                    "description": "Market-adjusted buy price (only set for buyable items)",
+                    "type": "integer"
+                },
+                "content_type": {
+                    "description": "Content type categorization (weapon, material, etc.)",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "default_display": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "handler": {
+                    "description": "Nullable: some items have no handler",
+                    "type": "string"
+                },
+                "internal_name": {
+                    "type": "string"
+                },
+                "item_id": {
+                    "type": "integer"
+                },
+                "public_name": {
+                    "type": "string"
+                },
+                "sell_price": {
+                    "description": "Calculated sell price (only set for sellable items)",
+                    "type": "integer"
+                },
+                "types": {
+                    "description": "Populated from join/separate query",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "domain.ItemFlags": {
+            "type": "object",
+            "properties": {
+                "favorite": {
+                    "type": "boolean"
+                },
+                "item_name": {
+                    "type": "string"
+                },
+                "locked": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "domain.ItemLoan": {
+            "type": "object",
+            "properties": {
+                "borrower_id": {
+                    "description": "BorrowerID is empty once the borrower's account has been deleted",
+                    "type": "string"
+                },
+                "closed_at": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "due_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "item_id": {
+                    "type": "integer"
+                },
+                "item_name": {
+                    "type": "string"
+                },
+                "lender_id": {
+                    "type": "string"
+                },
+                "quality_level": {
+                    "$ref": "#/definitions/domain.QualityLevel"
+                },
+                "quantity": {
+                    "type": "integer"
+                },
+                "returned_quantity": {
+                    "type": "integer"
+                },
+                "status": {
+                    "$ref": "#/definitions/domain.LoanStatus"
+                }
+            }
+        },
+        "domain.JackpotStatus": {
+            "type": "object",
+            "properties": {
+                "balance": {
+                    "type": "integer"
+                },
+                "contribution_percent": {
+                    "description": "Share of each opening's value added to the pool",
+                    "type": "integer"
+                },
+                "max_balance": {
+                    "description": "Most the pool grows to, 0 for no maximum",
+                    "type": "integer"
+                },
+                "recent_wins": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.JackpotWin"
+                    }
+                },
+                "trigger_chance": {
+                    "description": "Chance each opening wins the pool",
+                    "type": "number"
+                }
+            }
+        },
+        "domain.JackpotWin": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "integer"
+                },
+                "source": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "string"
+                },
+                "username": {
+                    "type": "string"
+                },
+                "won_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "domain.Job": {
+            "type": "object",
+            "properties": {
+                "associated_features": {
+                    "description": "[\"upgrade\", \"craft\"]",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "display_name": {
+                    "description": "\"Blacksmith\"",
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "job_key": {
+                    "description": "\"blacksmith\", \"explorer\", etc.",
+                    "type": "string"
+                }
+            }
+        },
+        "domain.JobPerk": {
+            "type": "object",
+            "properties": {
+                "description": {
+                    "type": "string"
+                },
+                "level": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "unlocked": {
+                    "description": "Whether the user's level has reached it",
+                    "type": "boolean"
+                }
+            }
+        },
+        "domain.JobPerks": {
+            "type": "object",
+            "properties": {
+                "job_key": {
+                    "type": "string"
+                },
+                "level": {
+                    "type": "integer"
+                },
+                "perks": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.JobPerk"
+                    }
+                },
+                "prestige": {
+                    "type": "integer"
+                },
+                "xp_bonus": {
+                    "description": "Permanent XP bonus from prestige (0.04 = +4%)",
+                    "type": "number"
+                }
+            }
+        },
+        "domain.JobPrestigeResult": {
+            "type": "object",
+            "properties": {
+                "job_key": {
+                    "type": "string"
+                },
+                "prestige": {
+                    "type": "integer"
+                },
+                "xp_bonus": {
+                    "type": "number"
+                }
+            }
+        },
+        "domain.JobXPMetadata": {
+            "type": "object",
+            "properties": {
+                "bet_amount": {
+                    "type": "integer"
+                },
+                "expedition_id": {
+                    "type": "string"
+                },
+                "extras": {
+                    "description": "For remaining unstructured data",
+                    "type": "object",
+                    "additionalProperties": true
+                },
+                "gamble_id": {
+                    "type": "string"
+                },
+                "hours_elapsed": {
+                    "type": "number"
+                },
+                "hours_waited": {
+                    "type": "number"
+                },
+                "input_value": {
+                    "type": "integer"
+                },
+                "is_critical": {
+                    "type": "boolean"
+                },
+                "is_first_daily": {
+                    "type": "boolean"
+                },
+                "is_masterwork": {
+                    "type": "boolean"
+                },
+                "is_near_miss": {
+                    "type": "boolean"
+                },
+                "is_perfect_salvage": {
+                    "type": "boolean"
+                },
+                "is_sludge": {
+                    "type": "boolean"
+                },
+                "is_winner": {
+                    "type": "boolean"
+                },
+                "item_name": {
+                    "type": "string"
+                },
+                "job_name": {
+                    "type": "string"
+                },
+                "metric_type": {
+                    "type": "string"
+                },
+                "payout_amount": {
+                    "type": "integer"
+                },
+                "platform": {
+                    "type": "string"
+                },
+                "quantity": {
+                    "type": "integer"
+                },
+                "quest_id": {
+                    "type": "integer"
+                },
+                "quest_key": {
+                    "type": "string"
+                },
+                "source": {
+                    "type": "string"
+                },
+                "spoiled": {
+                    "type": "boolean"
+                },
+                "trigger_type": {
+                    "type": "string"
+                },
+                "username": {
+                    "type": "string"
+                },
+                "value": {
+                    "type": "integer"
+                },
+                "xp_total": {
+                    "type": "integer"
+                }
+            }
+        },
+        "domain.LeaderboardEntry": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer"
+                },
+                "event_type": {
+                    "type": "string"
+                },
+                "rank": {
+                    "type": "integer"
+                },
+                "user_id": {
+                    "type": "string"
+                },
+                "username": {
+                    "type": "string"
+                }
+            }
+        },
+        "domain.LeaderboardPage": {
+            "type": "object",
+            "properties": {
+                "entries": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.LeaderboardEntry"
+                    }
+                },
+                "event_type": {
+                    "type": "string"
+                },
+                "has_more": {
+                    "type": "boolean"
+                },
+                "limit": {
+                    "type": "integer"
+                },
+                "offset": {
+                    "type": "integer"
+                },
+                "period": {
+                    "type": "string"
+                },
+                "user_rank": {
+                    "description": "Around-user's rank; 0 when they are unranked",
+                    "type": "integer"
+                }
+            }
+        },
+        "domain.ListingStatus": {
+            "type": "string",
+            "enum": [
+                "active",
+                "sold",
+                "cancelled"
+            ],
+            "x-enum-varnames": [
+                "ListingStatusActive",
+                "ListingStatusSold",
+                "ListingStatusCancelled"
+            ]
+        },
+        "domain.LoanStatus": {
+            "type": "string",
+            "enum": [
+                "active",
+                "returned",
+                "defaulted"
+            ],
+            "x-enum-varnames": [
+                "LoanStatusActive",
+                "LoanStatusReturned",
+                "LoanStatusDefaulted"
+            ]
+        },
+        "domain.LootboxBet": {
+            "type": "object",
+            "required": [
+                "item_name"
+            ],
+            "properties": {
+                "item_name": {
+                    "type": "string"
+                },
+                "quality_level": {
+                    "$ref": "#/definitions/domain.QualityLevel"
+                },
+                "quantity": {
+                    "type": "integer",
+                    "minimum": 1
+                }
+            }
+        },
+        "domain.MessageResult": {
+            "type": "object",
+            "properties": {
+                "command": {
+                    "description": "Command is set when the message was a text command",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/domain.CommandResult"
+                        }
+                    ]
+                },
+                "keyword_drop": {
+                    "description": "KeywordDrop is set when the message said an event theme's keyword and\nit dropped an item to the sender",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/domain.ChatDrop"
+                        }
+                    ]
+                },
+                "matches": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.FoundString"
+                    }
+                },
+                "user": {
+                    "$ref": "#/definitions/domain.User"
+                }
+            }
+        },
+        "domain.MonetizationEvent": {
+            "type": "object",
+            "required": [
+                "event_id",
+                "kind",
+                "source"
+            ],
+            "properties": {
+                "amount": {
+                    "description": "Donation amount",
+                    "type": "number",
+                    "minimum": 0
+                },
+                "currency": {
+                    "description": "Donation currency",
+                    "type": "string",
+                    "maxLength": 10
+                },
+                "event_id": {
+                    "type": "string",
+                    "maxLength": 128
+                },
+                "kind": {
+                    "type": "string",
+                    "enum": [
+                        "sub",
+                        "resub",
+                        "gift_sub",
+                        "donation",
+                        "cheer"
+                    ]
+                },
+                "platform": {
+                    "type": "string"
+                },
+                "platform_id": {
+                    "type": "string",
+                    "maxLength": 100
+                },
+                "quantity": {
+                    "description": "Gifted subs, months or bits",
+                    "type": "integer",
+                    "minimum": 0
+                },
+                "source": {
+                    "type": "string",
+                    "enum": [
+                        "twitch",
+                        "streamlabs"
+                    ]
+                },
+                "tier": {
+                    "description": "Subscription tier, e.g. \"1000\"",
+                    "type": "string",
+                    "maxLength": 20
+                },
+                "username": {
+                    "type": "string",
+                    "maxLength": 100
+                }
+            }
+        },
+        "domain.MonetizationResult": {
+            "type": "object",
+            "properties": {
+                "contribution": {
+                    "type": "integer"
+                },
+                "event_id": {
+                    "type": "string"
+                },
+                "items": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "integer"
+                    }
+                },
+                "matched": {
+                    "description": "False when no rule matched the event",
+                    "type": "boolean"
+                },
+                "source": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "string"
+                },
+                "xp_boost_duration": {
+                    "type": "string"
+                },
+                "xp_multiplier": {
+                    "type": "number"
+                }
+            }
+        },
+        "domain.NameTheme": {
+            "type": "object",
+            "properties": {
+                "end": {
+                    "description": "MM-DD the theme ends for everyone",
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "start": {
+                    "description": "MM-DD the theme starts for everyone",
+                    "type": "string"
+                },
+                "unlocked": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "domain.NameThemes": {
+            "type": "object",
+            "properties": {
+                "active": {
+                    "description": "Active is the theme the calendar shows today; empty outside every period",
+                    "type": "string"
+                },
+                "selected": {
+                    "description": "Selected is the user's pick; empty follows the calendar",
+                    "type": "string"
+                },
+                "themes": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.NameTheme"
+                    }
+                }
+            }
+        },
+        "domain.NodeEffects": {
+            "type": "object",
+            "properties": {
+                "config_deltas": {
+                    "description": "Feature value changes",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.ConfigDelta"
+                    }
+                },
+                "features": {
+                    "description": "Feature flags turned on",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "items": {
+                    "description": "Item internal names enabled",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "domain.Participant": {
+            "type": "object",
+            "properties": {
+                "gamble_id": {
+                    "type": "string"
+                },
+                "lootbox_bets": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.LootboxBet"
+                    }
+                },
+                "user_id": {
+                    "type": "string"
+                },
+                "username": {
+                    "description": "Populated for display",
+                    "type": "string"
+                }
+            }
+        },
+        "domain.PersonalMilestone": {
+            "type": "object",
+            "properties": {
+                "description": {
+                    "type": "string"
+                },
+                "key": {
+                    "type": "string"
+                },
+                "metric": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "progress": {
+                    "description": "Progress is the user's total for the metric, capped at the threshold",
+                    "type": "integer"
+                },
+                "threshold": {
+                    "type": "integer"
+                },
+                "unlocked": {
+                    "type": "boolean"
+                },
+                "unlocked_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "domain.PersonalTrack": {
+            "type": "object",
+            "properties": {
+                "description": {
+                    "type": "string"
+                },
+                "key": {
+                    "type": "string"
+                },
+                "milestones": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.PersonalMilestone"
+                    }
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "domain.PlayerListing": {
+            "type": "object",
+            "properties": {
+                "closed_at": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "item_id": {
+                    "type": "integer"
+                },
+                "item_name": {
+                    "type": "string"
+                },
+                "listing_fee": {
+                    "description": "ListingFee is what the seller paid to post the listing. It is not\nrefunded if the listing is cancelled.",
+                    "type": "integer"
+                },
+                "quality_level": {
+                    "$ref": "#/definitions/domain.QualityLevel"
+                },
+                "quantity": {
+                    "description": "Quantity is how many items remain for sale",
+                    "type": "integer"
+                },
+                "seller_id": {
+                    "type": "string"
+                },
+                "seller_name": {
+                    "type": "string"
+                },
+                "status": {
+                    "$ref": "#/definitions/domain.ListingStatus"
+                },
+                "unit_price": {
+                    "type": "integer"
+                }
+            }
+        },
+        "domain.PlayerShopPurchase": {
+            "type": "object",
+            "properties": {
+                "fee": {
+                    "type": "integer"
+                },
+                "listing": {
+                    "$ref": "#/definitions/domain.PlayerListing"
+                },
+                "quantity": {
+                    "type": "integer"
+                },
+                "seller_gets": {
+                    "type": "integer"
+                },
+                "total_price": {
+                    "type": "integer"
+                }
+            }
+        },
+        "domain.PredictionOutcomeRequest": {
+            "type": "object",
+            "required": [
+                "participants",
+                "platform",
+                "total_points_spent",
+                "winner"
+            ],
+            "properties": {
+                "participants": {
+                    "type": "array",
+                    "minItems": 1,
+                    "items": {
+                        "$ref": "#/definitions/domain.PredictionParticipant"
+                    }
+                },
+                "platform": {
+                    "type": "string",
+                    "enum": [
+                        "twitch",
+                        "youtube"
+                    ]
+                },
+                "total_points_spent": {
+                    "type": "integer",
+                    "minimum": 0
+                },
+                "winner": {
+                    "$ref": "#/definitions/domain.PredictionWinner"
+                }
+            }
+        },
+        "domain.PredictionParticipant": {
+            "type": "object",
+            "properties": {
+                "platform_id": {
+                    "type": "string"
+                },
+                "points_spent": {
+                    "type": "integer"
+                },
+                "username": {
+                    "type": "string"
+                }
+            }
+        },
+        "domain.PredictionResult": {
+            "type": "object",
+            "properties": {
+                "contribution_awarded": {
+                    "type": "integer"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "participants_processed": {
+                    "type": "integer"
+                },
+                "total_points": {
+                    "type": "integer"
+                },
+                "winner_xp_awarded": {
+                    "type": "integer"
+                }
+            }
+        },
+        "domain.PredictionWinner": {
+            "type": "object",
+            "properties": {
+                "platform_id": {
+                    "type": "string"
+                },
+                "points_won": {
+                    "type": "integer"
+                },
+                "username": {
+                    "type": "string"
+                }
+            }
+        },
+        "domain.PriceHistory": {
+            "type": "object",
+            "properties": {
+                "base_value": {
+                    "type": "integer"
+                },
+                "current": {
+                    "$ref": "#/definitions/domain.PricePoint"
+                },
+                "item_name": {
+                    "type": "string"
+                },
+                "points": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.PricePoint"
+                    }
+                }
+            }
+        },
+        "domain.PricePoint": {
+            "type": "object",
+            "properties": {
+                "buy_price": {
+                    "type": "integer"
+                },
+                "multiplier": {
+                    "type": "number"
+                },
+                "recorded_at": {
+                    "type": "string"
+                },
+                "sell_price": {
+                    "type": "integer"
+                }
+            }
+        },
+        "domain.ProgressionNode": {
+            "type": "object",
+            "properties": {
+                "category": {
+                    "description": "Grouping: economy, combat, etc.",
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "display_name": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "max_level": {
+                    "type": "integer"
+                },
+                "node_key": {
+                    "type": "string"
+                },
+                "node_type": {
+                    "description": "'feature', 'item', 'mechanic', 'upgrade'",
+                    "type": "string"
+                },
+                "size": {
+                    "description": "small, medium, large",
+                    "type": "string"
+                },
+                "sort_order": {
+                    "type": "integer"
+                },
+                "tier": {
+                    "description": "Dynamic cost calculation fields (v2.0)",
+                    "type": "integer"
+                },
+                "unlock_cost": {
+                    "description": "Calculated from tier+size, stored for performance",
+                    "type": "integer"
+                }
+            }
+        },
+        "domain.ProgressionStatus": {
+            "type": "object",
+            "properties": {
+                "active_session": {
+                    "$ref": "#/definitions/domain.ProgressionVotingSession"
+                },
+                "active_unlock_progress": {
+                    "$ref": "#/definitions/domain.UnlockProgress"
+                },
+                "all_nodes_unlocked": {
+                    "type": "boolean"
+                },
+                "contribution_score": {
+                    "type": "integer"
+                },
+                "is_transitioning": {
+                    "description": "Bug #5: True when between unlock completion and new session start",
+                    "type": "boolean"
+                },
+                "total_nodes": {
+                    "type": "integer"
+                },
+                "total_unlocked": {
+                    "type": "integer"
+                }
+            }
+        },
+        "domain.ProgressionTreeNode": {
+            "type": "object",
+            "properties": {
+                "category": {
+                    "description": "Grouping: economy, combat, etc.",
+                    "type": "string"
+                },
+                "children": {
+                    "description": "Child node IDs",
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "display_name": {
+                    "type": "string"
+                },
+                "effects": {
+                    "description": "Effects previews what unlocking the node does; nil if it declares none",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/domain.NodeEffects"
+                        }
+                    ]
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "is_unlocked": {
+                    "type": "boolean"
+                },
+                "max_level": {
+                    "type": "integer"
+                },
+                "node_key": {
+                    "type": "string"
+                },
+                "node_type": {
+                    "description": "'feature', 'item', 'mechanic', 'upgrade'",
+                    "type": "string"
+                },
+                "prerequisite_groups": {
+                    "description": "PrerequisiteGroups lists prerequisite node IDs by group. Every group\nmust have at least one unlocked node, so a group with several IDs is\nan OR and the groups together are an AND.",
+                    "type": "array",
+                    "items": {
+                        "type": "array",
+                        "items": {
+                            "type": "integer"
+                        }
+                    }
+                },
+                "size": {
+                    "description": "small, medium, large",
+                    "type": "string"
+                },
+                "sort_order": {
+                    "type": "integer"
+                },
+                "tier": {
+                    "description": "Dynamic cost calculation fields (v2.0)",
+                    "type": "integer"
+                },
+                "unlock_cost": {
+                    "description": "Calculated from tier+size, stored for performance",
+                    "type": "integer"
+                },
+                "unlocked_level": {
+                    "description": "0 if not unlocked",
+                    "type": "integer"
+                }
+            }
+        },
+        "domain.ProgressionUnlock": {
+            "type": "object",
+            "properties": {
+                "current_level": {
+                    "type": "integer"
+                },
+                "engagement_score": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "node_id": {
+                    "type": "integer"
+                },
+                "unlocked_at": {
+                    "type": "string"
+                },
+                "unlocked_by": {
+                    "description": "'vote', 'admin', 'auto', 'instant_override'",
+                    "type": "string"
+                }
+            }
+        },
+        "domain.ProgressionVotingOption": {
+            "type": "object",
+            "properties": {
+                "estimated_unlock_date": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "last_highest_vote_at": {
+                    "description": "When first reached current highest",
+                    "type": "string"
+                },
+                "node_details": {
+                    "$ref": "#/definitions/domain.ProgressionNode"
+                },
+                "node_id": {
+                    "type": "integer"
+                },
+                "session_id": {
+                    "type": "integer"
+                },
+                "target_level": {
+                    "type": "integer"
+                },
+                "vote_count": {
+                    "description": "Weighted total of counted votes",
+                    "type": "integer"
+                },
+                "voter_count": {
+                    "description": "Counted votes, regardless of weight",
+                    "type": "integer"
+                }
+            }
+        },
+        "domain.ProgressionVotingSession": {
+            "type": "object",
+            "properties": {
+                "ended_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "options": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.ProgressionVotingOption"
+                    }
+                },
+                "started_at": {
+                    "type": "string"
+                },
+                "status": {
+                    "description": "'voting', 'completed'",
+                    "type": "string"
+                },
+                "voting_deadline": {
+                    "type": "string"
+                },
+                "winning_option_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "domain.QualityLevel": {
+            "type": "string",
+            "enum": [
+                "COMMON",
+                "UNCOMMON",
+                "RARE",
+                "EPIC",
+                "LEGENDARY",
+                "POOR",
+                "JUNK",
+                "CURSED"
+            ],
+            "x-enum-varnames": [
+                "QualityCommon",
+                "QualityUncommon",
+                "QualityRare",
+                "QualityEpic",
+                "QualityLegendary",
+                "QualityPoor",
+                "QualityJunk",
+                "QualityCursed"
+            ]
+        },
+        "domain.Quest": {
+            "type": "object",
+            "properties": {
+                "active": {
+                    "type": "boolean"
+                },
+                "base_requirement": {
+                    "type": "integer"
+                },
+                "base_reward_money": {
+                    "type": "integer"
+                },
+                "base_reward_xp": {
+                    "type": "integer"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "quest_id": {
+                    "type": "integer"
+                },
+                "quest_key": {
+                    "type": "string"
+                },
+                "quest_type": {
+                    "description": "'buy_items', 'sell_items', 'earn_money', 'craft_recipe', 'perform_searches'",
+                    "type": "string"
+                },
+                "target_category": {
+                    "description": "For: buy_items, sell_items",
+                    "type": "string"
+                },
+                "target_recipe_key": {
+                    "description": "For: craft_recipe",
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "week_number": {
+                    "type": "integer"
+                },
+                "year": {
+                    "type": "integer"
+                }
+            }
+        },
+        "domain.QuestProgress": {
+            "type": "object",
+            "properties": {
+                "claimed_at": {
+                    "type": "string"
+                },
+                "completed_at": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "progress_current": {
+                    "type": "integer"
+                },
+                "progress_required": {
+                    "type": "integer"
+                },
+                "quest_id": {
+                    "type": "integer"
+                },
+                "quest_key": {
+                    "description": "Joined fields",
+                    "type": "string"
+                },
+                "quest_type": {
+                    "type": "string"
+                },
+                "reward_money": {
+                    "type": "integer"
+                },
+                "reward_xp": {
+                    "type": "integer"
+                },
+                "started_at": {
+                    "type": "string"
+                },
+                "target_category": {
+                    "description": "For: buy_items, sell_items",
+                    "type": "string"
+                },
+                "target_recipe_key": {
+                    "description": "For: craft_recipe",
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "domain.RaidBonus": {
+            "type": "object",
+            "properties": {
+                "bonus_contribution": {
+                    "type": "integer"
+                },
+                "effects": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.CommunityEffect"
+                    }
+                },
+                "from_channel": {
+                    "type": "string"
+                },
+                "kind": {
+                    "$ref": "#/definitions/domain.RaidKind"
+                },
+                "platform": {
+                    "type": "string"
+                },
+                "viewers": {
+                    "type": "integer"
+                }
+            }
+        },
+        "domain.RaidEvent": {
+            "type": "object",
+            "required": [
+                "from_channel",
+                "platform"
+            ],
+            "properties": {
+                "from_channel": {
+                    "type": "string",
+                    "maxLength": 100
+                },
+                "platform": {
+                    "type": "string"
+                },
+                "viewers": {
+                    "type": "integer",
+                    "minimum": 0
+                }
+            }
+        },
+        "domain.RaidKind": {
+            "type": "string",
+            "enum": [
+                "raid",
+                "host"
+            ],
+            "x-enum-varnames": [
+                "RaidKindRaid",
+                "RaidKindHost"
+            ]
+        },
+        "domain.ReelResult": {
+            "type": "object",
+            "properties": {
+                "catch": {
+                    "$ref": "#/definitions/domain.FishCatch"
+                },
+                "outcome": {
+                    "$ref": "#/definitions/domain.FishingOutcome"
+                },
+                "reaction_ms": {
+                    "description": "Time from the bite to the reel, negative when too early",
+                    "type": "integer"
+                },
+                "tackle": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "string"
+                },
+                "username": {
+                    "type": "string"
+                }
+            }
+        },
+        "domain.Reminder": {
+            "type": "object",
+            "properties": {
+                "channel_id": {
+                    "description": "ChannelID is where the platform should post the reminder. When empty\nthe user is messaged directly.",
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "kind": {
+                    "$ref": "#/definitions/domain.ReminderKind"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "platform": {
+                    "type": "string"
+                },
+                "platform_id": {
+                    "type": "string"
+                },
+                "remind_at": {
+                    "type": "string"
+                },
+                "target": {
+                    "description": "Cooldown action; empty for other kinds",
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "domain.ReminderKind": {
+            "type": "string",
+            "enum": [
+                "cooldown",
+                "vote",
+                "compost"
+            ],
+            "x-enum-varnames": [
+                "ReminderKindCooldown",
+                "ReminderKindVote",
+                "ReminderKindCompost"
+            ]
+        },
+        "domain.SideBet": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "integer"
+                },
+                "backed_user_id": {
+                    "type": "string"
+                },
+                "backed_username": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "gamble_id": {
+                    "type": "string"
+                },
+                "payout": {
+                    "description": "Set once the gamble settles; refunds pay back the amount",
+                    "type": "integer"
+                },
+                "user_id": {
+                    "type": "string"
+                },
+                "username": {
+                    "type": "string"
+                }
+            }
+        },
+        "domain.SlotsResult": {
+            "type": "object",
+            "properties": {
+                "bet_amount": {
+                    "description": "Amount wagered",
+                    "type": "integer"
+                },
+                "is_near_miss": {
+                    "description": "True if 2/3 symbols match",
+                    "type": "boolean"
+                },
+                "is_win": {
+                    "description": "True if payout \u003e 0",
+                    "type": "boolean"
+                },
+                "message": {
+                    "description": "User-facing result text",
+                    "type": "string"
+                },
+                "payout_amount": {
+                    "description": "Amount won (0 if loss)",
+                    "type": "integer"
+                },
+                "payout_multiplier": {
+                    "description": "Multiplier applied to bet",
+                    "type": "number"
+                },
+                "reel1": {
+                    "description": "Symbol name",
+                    "type": "string"
+                },
+                "reel2": {
+                    "description": "Symbol name",
+                    "type": "string"
+                },
+                "reel3": {
+                    "description": "Symbol name",
+                    "type": "string"
+                },
+                "trigger_type": {
+                    "description": "\"normal\", \"big_win\", \"jackpot\", \"mega_jackpot\"",
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "string"
+                },
+                "username": {
+                    "type": "string"
+                }
+            }
+        },
+        "domain.StartStreamRequest": {
+            "type": "object",
+            "required": [
+                "platform"
+            ],
+            "properties": {
+                "platform": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string",
+                    "maxLength": 200
+                }
+            }
+        },
+        "domain.StatsBucket": {
+            "type": "string",
+            "enum": [
+                "hour",
+                "day",
+                "week"
+            ],
+            "x-enum-varnames": [
+                "StatsBucketHour",
+                "StatsBucketDay",
+                "StatsBucketWeek"
+            ]
+        },
+        "domain.StatsQueryResult": {
+            "type": "object",
+            "properties": {
+                "bucket": {
+                    "$ref": "#/definitions/domain.StatsBucket"
+                },
+                "end_time": {
+                    "type": "string"
+                },
+                "rows": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.StatsQueryRow"
+                    }
+                },
+                "start_time": {
+                    "type": "string"
+                },
+                "truncated": {
+                    "description": "Truncated is set when there were more groups than the limit",
+                    "type": "boolean"
+                }
+            }
+        },
+        "domain.StatsQueryRow": {
+            "type": "object",
+            "properties": {
+                "bucket_start": {
+                    "type": "string"
+                },
+                "count": {
+                    "type": "integer"
+                },
+                "event_type": {
+                    "$ref": "#/definitions/domain.EventType"
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "domain.StatsSummary": {
+            "type": "object",
+            "properties": {
+                "end_time": {
+                    "type": "string"
+                },
+                "event_counts": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "integer"
+                    }
+                },
+                "metrics": {
+                    "type": "object",
+                    "additionalProperties": true
+                },
+                "period": {
+                    "type": "string"
+                },
+                "start_time": {
+                    "type": "string"
+                },
+                "total_events": {
+                    "type": "integer"
+                }
+            }
+        },
+        "domain.StreamContributor": {
+            "type": "object",
+            "properties": {
+                "score": {
+                    "type": "number"
+                },
+                "user_id": {
+                    "type": "string"
+                },
+                "username": {
+                    "type": "string"
+                }
+            }
+        },
+        "domain.StreamGambleWin": {
+            "type": "object",
+            "properties": {
+                "gamble_id": {
+                    "type": "string"
+                },
+                "participants": {
+                    "type": "integer"
+                },
+                "total_value": {
+                    "type": "integer"
+                },
+                "username": {
+                    "type": "string"
+                },
+                "winner_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "domain.StreamRecap": {
+            "type": "object",
+            "properties": {
+                "biggest_gamble_win": {
+                    "$ref": "#/definitions/domain.StreamGambleWin"
+                },
+                "summary": {
+                    "$ref": "#/definitions/domain.StreamSummary"
+                },
+                "top_contributor": {
+                    "$ref": "#/definitions/domain.StreamContributor"
+                },
+                "unlocks": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.StreamUnlock"
+                    }
+                }
+            }
+        },
+        "domain.StreamSession": {
+            "type": "object",
+            "properties": {
+                "ended_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "platform": {
+                    "type": "string"
+                },
+                "started_at": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                }
+            }
+        },
+        "domain.StreamSummary": {
+            "type": "object",
+            "properties": {
+                "active_users": {
+                    "type": "integer"
+                },
+                "dropped_items": {
+                    "type": "integer"
+                },
+                "drops": {
+                    "type": "integer"
+                },
+                "duration_seconds": {
+                    "type": "integer"
+                },
+                "event_counts": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "integer"
+                    }
+                },
+                "gamble_entries": {
+                    "type": "integer"
+                },
+                "gamble_value": {
+                    "type": "integer"
+                },
+                "gambles": {
+                    "type": "integer"
+                },
+                "session": {
+                    "$ref": "#/definitions/domain.StreamSession"
+                },
+                "top_users": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.StreamTopUser"
+                    }
+                },
+                "total_events": {
+                    "type": "integer"
+                }
+            }
+        },
+        "domain.StreamTopUser": {
+            "type": "object",
+            "properties": {
+                "events": {
+                    "type": "integer"
+                },
+                "user_id": {
+                    "type": "string"
+                },
+                "username": {
+                    "type": "string"
+                }
+            }
+        },
+        "domain.StreamUnlock": {
+            "type": "object",
+            "properties": {
+                "display_name": {
+                    "type": "string"
+                },
+                "level": {
+                    "type": "integer"
+                },
+                "node_key": {
+                    "type": "string"
+                },
+                "unlocked_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "domain.SubscriptionEvent": {
+            "type": "object",
+            "required": [
+                "event_type",
+                "platform",
+                "platform_user_id",
+                "tier_name",
+                "timestamp",
+                "username"
+            ],
+            "properties": {
+                "event_type": {
+                    "description": "Use HistoryEventType constants",
+                    "type": "string"
+                },
+                "platform": {
+                    "type": "string",
+                    "enum": [
+                        "twitch",
+                        "youtube"
+                    ]
+                },
+                "platform_user_id": {
+                    "type": "string"
+                },
+                "tier_name": {
+                    "type": "string"
+                },
+                "timestamp": {
+                    "type": "integer"
+                },
+                "username": {
+                    "type": "string"
+                }
+            }
+        },
+        "domain.SubscriptionWithTier": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "display_name": {
+                    "type": "string"
+                },
+                "expires_at": {
+                    "type": "string"
+                },
+                "last_verified_at": {
+                    "type": "string"
+                },
+                "platform": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "subscribed_at": {
+                    "type": "string"
+                },
+                "tier_id": {
+                    "type": "integer"
+                },
+                "tier_level": {
+                    "type": "integer"
+                },
+                "tier_name": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "domain.UndoAction": {
+            "type": "string",
+            "enum": [
+                "sell",
+                "disassemble",
+                "give"
+            ],
+            "x-enum-varnames": [
+                "UndoActionSell",
+                "UndoActionDisassemble",
+                "UndoActionGive"
+            ]
+        },
+        "domain.UndoEntry": {
+            "type": "object",
+            "properties": {
+                "action": {
+                    "$ref": "#/definitions/domain.UndoAction"
+                },
+                "changes": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.InventoryChange"
+                    }
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "expires_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "domain.UnlockEstimate": {
+            "type": "object",
+            "properties": {
+                "confidence": {
+                    "description": "\"high\", \"medium\", \"low\"",
+                    "type": "string"
+                },
+                "current_progress": {
+                    "type": "integer"
+                },
+                "current_velocity": {
+                    "type": "number"
+                },
+                "estimated_days": {
+                    "type": "number"
+                },
+                "estimated_unlock_date": {
+                    "type": "string"
+                },
+                "node_key": {
+                    "type": "string"
+                },
+                "required_points": {
+                    "type": "integer"
+                }
+            }
+        },
+        "domain.UnlockProgress": {
+            "type": "object",
+            "properties": {
+                "contributions_accumulated": {
+                    "type": "integer"
+                },
+                "estimated_unlock_date": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "node_id": {
+                    "description": "NULL until vote ends",
+                    "type": "integer"
+                },
+                "started_at": {
+                    "type": "string"
+                },
+                "target_level": {
+                    "description": "NULL until vote ends",
+                    "type": "integer"
+                },
+                "unlocked_at": {
+                    "type": "string"
+                },
+                "voting_session_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "domain.User": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "discord_id": {
+                    "type": "string"
+                },
+                "internal_id": {
+                    "type": "string"
+                },
+                "platform_usernames": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "twitch_id": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "username": {
+                    "type": "string"
+                },
+                "youtube_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "domain.UserJobInfo": {
+            "type": "object",
+            "properties": {
+                "current_xp": {
+                    "type": "integer"
+                },
+                "display_name": {
+                    "type": "string"
+                },
+                "is_active": {
+                    "type": "boolean"
+                },
+                "job_key": {
+                    "type": "string"
+                },
+                "level": {
+                    "type": "integer"
+                },
+                "level_requirement": {
+                    "description": "Total XP needed for current level",
+                    "type": "integer"
+                },
+                "level_xp": {
+                    "description": "Progress within current level",
+                    "type": "integer"
+                },
+                "max_level": {
+                    "description": "From progression system",
+                    "type": "integer"
+                },
+                "prestige": {
+                    "type": "integer"
+                },
+                "xp_to_next_level": {
+                    "type": "integer"
+                }
+            }
+        },
+        "domain.UserSettings": {
+            "type": "object",
+            "properties": {
+                "leaderboard_private": {
+                    "description": "LeaderboardPrivate hides the user's name and ID from public rankings.\nTheir activity still counts; it is listed as AnonymousDisplayName.",
+                    "type": "boolean"
+                },
+                "name_theme": {
+                    "description": "NameTheme is the item name theme the user picked. Empty follows the\ncalendar like everyone else.",
+                    "type": "string"
+                },
+                "targeting_opt_out": {
+                    "description": "TargetingOptOut keeps other users from using weapons and traps on the\nuser, and stops the user from using them on others.",
+                    "type": "boolean"
+                }
+            }
+        },
+        "domain.VelocityMetrics": {
+            "type": "object",
+            "properties": {
+                "period_days": {
+                    "type": "integer"
+                },
+                "points_per_day": {
+                    "type": "number"
+                },
+                "sample_size": {
+                    "type": "integer"
+                },
+                "total_points": {
+                    "type": "integer"
+                },
+                "trend": {
+                    "description": "\"increasing\", \"stable\", \"decreasing\"",
+                    "type": "string"
+                }
+            }
+        },
+        "domain.VoteDelegation": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "delegate_id": {
+                    "type": "string"
+                },
+                "delegate_username": {
+                    "type": "string"
+                },
+                "delegator_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "domain.VotingSessionHistory": {
+            "type": "object",
+            "properties": {
+                "limit": {
+                    "type": "integer"
+                },
+                "offset": {
+                    "type": "integer"
+                },
+                "sessions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.VotingSessionRecap"
+                    }
+                },
+                "total": {
+                    "type": "integer"
+                }
+            }
+        },
+        "domain.VotingSessionRecap": {
+            "type": "object",
+            "properties": {
+                "ended_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "options": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.ProgressionVotingOption"
+                    }
+                },
+                "started_at": {
+                    "type": "string"
+                },
+                "status": {
+                    "description": "'voting', 'completed'",
+                    "type": "string"
+                },
+                "total_votes": {
+                    "type": "integer"
+                },
+                "unlocked_at": {
+                    "type": "string"
+                },
+                "unlocked_level": {
+                    "type": "integer"
+                },
+                "unlocked_node": {
+                    "description": "Nil until the winner's unlock completes",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/domain.ProgressionNode"
+                        }
+                    ]
+                },
+                "voting_deadline": {
+                    "type": "string"
+                },
+                "winner": {
+                    "$ref": "#/definitions/domain.ProgressionVotingOption"
+                },
+                "winning_option_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "domain.WeeklySale": {
+            "type": "object",
+            "properties": {
+                "description": {
+                    "type": "string"
+                },
+                "discount_percent": {
+                    "type": "number"
+                },
+                "target_category": {
+                    "description": "Item category on sale (nil = all items)",
+                    "type": "string"
+                },
+                "week_offset": {
+                    "description": "Which week in the rotation (0-indexed)",
+                    "type": "integer"
+                }
+            }
+        },
+        "domain.XPAwardResult": {
+            "type": "object",
+            "properties": {
+                "base_xp": {
+                    "type": "integer"
+                },
+                "breakdown": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.XPMultiplier"
+                    }
+                },
+                "job_key": {
+                    "type": "string"
+                },
+                "leveled_up": {
+                    "type": "boolean"
+                },
+                "multiplier": {
+                    "type": "number"
+                },
+                "new_level": {
+                    "type": "integer"
+                },
+                "new_xp": {
+                    "type": "integer"
+                },
+                "xp_gained": {
+                    "type": "integer"
+                }
+            }
+        },
+        "domain.XPMultiplier": {
+            "type": "object",
+            "properties": {
+                "multiplier": {
+                    "type": "number"
+                },
+                "name": {
+                    "description": "Set for global XP events",
+                    "type": "string"
+                },
+                "source": {
+                    "type": "string"
+                }
+            }
+        },
+        "handler.AddItemByUsernameRequest": {
+            "type": "object",
+            "required": [
+                "item_name",
+                "platform",
+                "username"
+            ],
+            "properties": {
+                "item_name": {
+                    "type": "string",
+                    "maxLength": 100
+                },
+                "platform": {
+                    "type": "string"
+                },
+                "quantity": {
+                    "type": "integer",
+                    "maximum": 10000,
+                    "minimum": 1
+                },
+                "username": {
+                    "type": "string",
+                    "maxLength": 100
+                }
+            }
+        },
+        "handler.AdminAddContributionRequest": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "integer"
+                }
+            }
+        },
+        "handler.AdminEndVotingResponse": {
+            "type": "object",
+            "properties": {
+                "message": {
+                    "type": "string"
+                },
+                "winner": {
+                    "$ref": "#/definitions/domain.ProgressionVotingOption"
+                }
+            }
+        },
+        "handler.AdminInstantUnlockResponse": {
+            "type": "object",
+            "properties": {
+                "message": {
+                    "type": "string"
+                },
+                "unlock": {
+                    "$ref": "#/definitions/domain.ProgressionUnlock"
+                }
+            }
+        },
+        "handler.AdminNodeRequest": {
+            "type": "object",
+            "required": [
+                "node_key"
+            ],
+            "properties": {
+                "level": {
+                    "type": "integer",
+                    "minimum": 0
+                },
+                "node_key": {
+                    "type": "string",
+                    "maxLength": 50
+                }
+            }
+        },
+        "handler.AdminResetRequest": {
+            "type": "object",
+            "required": [
+                "reset_by"
+            ],
+            "properties": {
+                "preserve_user_progression": {
+                    "type": "boolean"
+                },
+                "reason": {
+                    "type": "string",
+                    "maxLength": 255
+                },
+                "reset_by": {
+                    "type": "string",
+                    "maxLength": 100
+                }
+            }
+        },
+        "handler.AllRecipesResponse": {
+            "type": "object",
+            "properties": {
+                "recipes": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/repository.RecipeListItem"
+                    }
+                }
+            }
+        },
+        "handler.AvailableUnlocksResponse": {
+            "type": "object",
+            "properties": {
+                "available": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.ProgressionNode"
+                    }
+                }
+            }
+        },
+        "handler.AwardXPRequest": {
+            "type": "object",
+            "properties": {
+                "job_key": {
+                    "type": "string"
+                },
+                "metadata": {
+                    "$ref": "#/definitions/domain.JobXPMetadata"
+                },
+                "platform": {
+                    "type": "string"
+                },
+                "platform_id": {
+                    "type": "string"
+                },
+                "source": {
+                    "type": "string"
+                },
+                "xp_amount": {
+                    "type": "integer"
+                }
+            }
+        },
+        "handler.BankGoalRequest": {
+            "type": "object",
+            "required": [
+                "platform",
+                "platform_id",
+                "username"
+            ],
+            "properties": {
+                "goal": {
+                    "description": "Goal is the balance to save toward; 0 clears the goal",
+                    "type": "integer",
+                    "minimum": 0
+                },
+                "platform": {
+                    "type": "string"
+                },
+                "platform_id": {
+                    "type": "string"
+                },
+                "username": {
+                    "type": "string",
+                    "maxLength": 100
+                }
+            }
+        },
+        "handler.BankTransferRequest": {
+            "type": "object",
+            "required": [
+                "amount",
+                "platform",
+                "platform_id",
+                "username"
+            ],
+            "properties": {
+                "amount": {
+                    "type": "integer",
+                    "maximum": 10000,
+                    "minimum": 1
+                },
+                "platform": {
+                    "type": "string"
+                },
+                "platform_id": {
+                    "type": "string"
+                },
+                "username": {
+                    "type": "string",
+                    "maxLength": 100
+                }
+            }
+        },
+        "handler.BirthdayResponse": {
+            "type": "object",
+            "properties": {
+                "birthday": {
+                    "$ref": "#/definitions/domain.Birthday"
+                },
+                "registered": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "handler.BossAttackRequest": {
+            "type": "object",
+            "required": [
+                "platform",
+                "platform_id",
+                "username"
+            ],
+            "properties": {
+                "platform": {
+                    "type": "string"
+                },
+                "platform_id": {
+                    "type": "string"
+                },
+                "username": {
+                    "type": "string",
+                    "maxLength": 100
+                },
+                "weapon": {
+                    "type": "string",
+                    "maxLength": 100
+                }
+            }
+        },
+        "handler.BotBootstrapResponse": {
+            "type": "object",
+            "properties": {
+                "active_gamble": {
+                    "$ref": "#/definitions/domain.Gamble"
+                },
+                "errors": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "featured_shop": {
+                    "$ref": "#/definitions/handler.BotFeaturedShop"
+                },
+                "flags": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "boolean"
+                    }
+                },
+                "generated_at": {
+                    "type": "string"
+                },
+                "unlock_progress": {
+                    "$ref": "#/definitions/domain.UnlockProgress"
+                },
+                "voting_session": {
+                    "$ref": "#/definitions/domain.ProgressionVotingSession"
+                }
+            }
+        },
+        "handler.BotFeaturedShop": {
+            "type": "object",
+            "properties": {
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.Item"
+                    }
+                },
+                "weekly_sale": {
+                    "$ref": "#/definitions/domain.WeeklySale"
+                }
+            }
+        },
+        "handler.BuyItemRequest": {
+            "type": "object",
+            "required": [
+                "item_name",
+                "platform",
+                "platform_id",
+                "username"
+            ],
+            "properties": {
+                "item_name": {
+                    "type": "string",
+                    "maxLength": 100
+                },
+                "platform": {
+                    "type": "string"
+                },
+                "platform_id": {
+                    "type": "string"
+                },
+                "quantity": {
+                    "type": "integer",
+                    "maximum": 10000,
+                    "minimum": 1
+                },
+                "username": {
+                    "type": "string",
+                    "maxLength": 100
+                }
+            }
+        },
+        "handler.BuyItemResponse": {
+            "type": "object",
+            "properties": {
+                "items_bought": {
+                    "type": "integer"
+                },
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "handler.BuyListingRequest": {
+            "type": "object",
+            "required": [
+                "platform",
+                "platform_id",
+                "username"
+            ],
+            "properties": {
+                "platform": {
+                    "type": "string"
+                },
+                "platform_id": {
+                    "type": "string"
+                },
+                "quantity": {
+                    "type": "integer",
+                    "maximum": 10000,
+                    "minimum": 1
+                },
+                "username": {
+                    "type": "string",
+                    "maxLength": 100
+                }
+            }
+        },
+        "handler.CapabilitiesResponse": {
+            "type": "object",
+            "properties": {
+                "capabilities": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/scenario.CapabilityInfo"
+                    }
+                },
+                "features": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "handler.CastRequest": {
+            "type": "object",
+            "required": [
+                "platform",
+                "platform_id",
+                "username"
+            ],
+            "properties": {
+                "channel_id": {
+                    "type": "string",
+                    "maxLength": 100
+                },
+                "platform": {
+                    "type": "string"
+                },
+                "platform_id": {
+                    "type": "string"
+                },
+                "tackle": {
+                    "type": "string",
+                    "maxLength": 100
+                },
+                "username": {
+                    "type": "string",
+                    "maxLength": 100
+                }
+            }
+        },
+        "handler.CelebrationGuildResponse": {
+            "type": "object",
+            "properties": {
+                "enabled": {
+                    "type": "boolean"
+                },
+                "guild_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "handler.ClaimQuestRequest": {
+            "type": "object",
+            "properties": {
+                "quest_id": {
+                    "type": "integer"
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "handler.ClaimQuestResponse": {
+            "type": "object",
+            "properties": {
+                "message": {
+                    "type": "string"
+                },
+                "money_earned": {
+                    "type": "integer"
+                }
+            }
+        },
+        "handler.ClaimRequest": {
+            "type": "object",
+            "properties": {
+                "platform": {
+                    "type": "string"
+                },
+                "platform_id": {
+                    "type": "string"
+                },
+                "token": {
+                    "type": "string"
+                }
+            }
+        },
+        "handler.ClaimResponse": {
+            "type": "object",
+            "properties": {
+                "awaiting_confirmation": {
+                    "type": "boolean"
+                },
+                "source_platform": {
+                    "type": "string"
+                }
+            }
+        },
+        "handler.CommunityBonusesResponse": {
+            "type": "object",
+            "properties": {
+                "bonuses": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.CommunityEffect"
+                    }
+                }
+            }
+        },
+        "handler.CommunityDonorsResponse": {
+            "type": "object",
+            "properties": {
+                "donors": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.CommunityDonor"
+                    }
+                }
+            }
+        },
+        "handler.CommunityPoolResponse": {
+            "type": "object",
+            "properties": {
+                "balance": {
+                    "type": "integer"
+                }
+            }
+        },
+        "handler.CompostDepositRequest": {
+            "type": "object",
+            "required": [
+                "items",
+                "platform",
+                "platform_id"
+            ],
+            "properties": {
+                "items": {
+                    "type": "array",
+                    "minItems": 1,
+                    "items": {
+                        "$ref": "#/definitions/compost.DepositItem"
+                    }
+                },
+                "platform": {
+                    "type": "string"
+                },
+                "platform_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "handler.CompostDepositResponse": {
+            "type": "object",
+            "properties": {
+                "capacity": {
+                    "type": "integer"
+                },
+                "item_count": {
+                    "type": "integer"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "ready_at": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
+        "handler.CompostHarvestRequest": {
+            "type": "object",
+            "required": [
+                "platform",
+                "platform_id",
+                "username"
+            ],
+            "properties": {
+                "platform": {
+                    "type": "string"
+                },
+                "platform_id": {
+                    "type": "string"
+                },
+                "username": {
+                    "type": "string"
+                }
+            }
+        },
+        "handler.CompostHarvestResponse": {
+            "type": "object",
+            "properties": {
+                "harvested": {
+                    "type": "boolean"
+                },
+                "items": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "integer"
+                    }
+                },
+                "message": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "time_left": {
+                    "type": "string"
+                }
+            }
+        },
+        "handler.ConfirmRequest": {
+            "type": "object",
+            "properties": {
+                "platform": {
+                    "type": "string"
+                },
+                "platform_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "handler.CooldownErrorResponse": {
+            "type": "object",
+            "properties": {
+                "action": {
+                    "type": "string"
+                },
+                "code": {
+                    "$ref": "#/definitions/handler.ErrorCode"
+                },
+                "details": {},
+                "error": {
+                    "description": "Error repeats Message for clients written before the envelope had one.\nDeprecated: read Message instead.",
+                    "type": "string"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "next_available_at": {
+                    "type": "string"
+                },
+                "request_id": {
+                    "type": "string"
+                },
+                "retry_after_seconds": {
+                    "type": "integer"
+                }
+            }
+        },
+        "handler.CooldownsResponse": {
+            "type": "object",
+            "properties": {
+                "cooldowns": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.CooldownTimer"
+                    }
+                }
+            }
+        },
+        "handler.CraftingActionRequest": {
+            "type": "object",
+            "required": [
+                "item",
+                "platform",
+                "platform_id",
+                "username"
+            ],
+            "properties": {
+                "item": {
+                    "type": "string",
+                    "maxLength": 100
+                },
+                "platform": {
+                    "type": "string"
+                },
+                "platform_id": {
+                    "type": "string"
+                },
+                "quantity": {
+                    "type": "integer",
+                    "maximum": 10000,
+                    "minimum": 1
+                },
+                "username": {
+                    "type": "string",
+                    "maxLength": 100
+                }
+            }
+        },
+        "handler.CreateReminderRequest": {
+            "type": "object",
+            "required": [
+                "kind",
+                "platform",
+                "platform_id",
+                "username"
+            ],
+            "properties": {
+                "channel_id": {
+                    "type": "string",
+                    "maxLength": 100
+                },
+                "kind": {
+                    "type": "string",
+                    "enum": [
+                        "cooldown",
+                        "vote",
+                        "compost"
+                    ]
+                },
+                "platform": {
+                    "type": "string"
+                },
+                "platform_id": {
+                    "type": "string"
+                },
+                "remind_at": {
+                    "description": "RemindAt is used for cooldowns the server cannot look up itself; pass\nthe 429 response's \"next_available_at\"",
+                    "type": "string"
+                },
+                "target": {
+                    "description": "Target is the cooldown action, as returned in a 429 response's \"action\"",
+                    "type": "string",
+                    "maxLength": 100
+                },
+                "username": {
+                    "type": "string",
+                    "maxLength": 100
+                }
             }
         },
-        "domain.HarvestResponse": {
+        "handler.DelegateVoteRequest": {
             "type": "object",
+            "required": [
+                "delegate_username",
+                "platform",
+                "platform_id",
+                "username"
+            ],
             "properties": {
-                "hours_since_harvest": {
+                "delegate_username": {
+                    "type": "string",
+                    "maxLength": 100
+                },
+                "platform": {
+                    "type": "string",
+                    "maxLength": 20
+                },
+                "platform_id": {
+                    "type": "string",
+                    "maxLength": 100
+                },
+                "username": {
+                    "type": "string",
+                    "maxLength": 100
+                }
+            }
+        },
+        "handler.DigRequest": {
+            "type": "object",
+            "required": [
+                "platform",
+                "platform_id",
+                "username"
+            ],
+            "properties": {
+                "platform": {
+                    "type": "string"
+                },
+                "platform_id": {
+                    "type": "string"
+                },
+                "username": {
+                    "type": "string",
+                    "maxLength": 100
+                }
+            }
+        },
+        "handler.DisassembleItemResponse": {
+            "type": "object",
+            "properties": {
+                "is_perfect_salvage": {
+                    "type": "boolean"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "multiplier": {
                     "type": "number"
                 },
-                "items_gained": {
+                "outputs": {
                     "type": "object",
                     "additionalProperties": {
                         "type": "integer"
                     }
                 },
-                "message": {
+                "partial": {
+                    "type": "boolean"
+                },
+                "quantity_processed": {
+                    "type": "integer"
+                },
+                "quantity_requested": {
+                    "type": "integer"
+                }
+            }
+        },
+        "handler.DiscordAnnouncementEventsResponse": {
+            "type": "object",
+            "properties": {
+                "event_types": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "handler.DonateRequest": {
+            "type": "object",
+            "required": [
+                "item_name",
+                "platform",
+                "platform_id",
+                "username"
+            ],
+            "properties": {
+                "item_name": {
+                    "type": "string",
+                    "maxLength": 100
+                },
+                "platform": {
                     "type": "string"
                 },
-                "next_harvest_at": {
+                "platform_id": {
                     "type": "string"
+                },
+                "quantity": {
+                    "type": "integer",
+                    "maximum": 10000,
+                    "minimum": 1
+                },
+                "username": {
+                    "type": "string",
+                    "maxLength": 100
                 }
             }
         },
-        "domain.Item": {
+        "handler.EffectsResponse": {
             "type": "object",
             "properties": {
-                "base_value": {
-                    "description": "Buy price",
-                    "type": "integer"
-                },
-                "content_type": {
-                    "description": "Content type categorization (weapon, material, etc.)",
+                "effects": {
                     "type": "array",
                     "items": {
-                        "type": "string"
+                        "$ref": "#/definitions/domain.Effect"
                     }
+                }
+            }
+        },
+        "handler.ErrorCode": {
+            "type": "string",
+            "enum": [
+                "BAD_REQUEST",
+                "VALIDATION_FAILED",
+                "UNAUTHORIZED",
+                "FORBIDDEN",
+                "NOT_FOUND",
+                "METHOD_NOT_ALLOWED",
+                "CONFLICT",
+                "PAYLOAD_TOO_LARGE",
+                "RATE_LIMITED",
+                "INTERNAL_ERROR",
+                "UNAVAILABLE",
+                "USER_NOT_FOUND",
+                "ITEM_NOT_FOUND",
+                "RECIPE_NOT_FOUND",
+                "GAMBLE_NOT_FOUND",
+                "INVALID_PLATFORM",
+                "INSUFFICIENT_FUNDS",
+                "INSUFFICIENT_ITEMS",
+                "NOT_IN_INVENTORY",
+                "INVENTORY_FULL",
+                "NOT_SELLABLE",
+                "NOT_BUYABLE",
+                "ITEM_BORROWED",
+                "ITEM_LOCKED",
+                "FEATURE_LOCKED",
+                "FEATURE_DISABLED",
+                "RECIPE_OFF_EVENT",
+                "ON_COOLDOWN",
+                "LIMIT_REACHED",
+                "ACCOUNT_FROZEN",
+                "ACCOUNT_TOO_NEW",
+                "ALREADY_VOTED",
+                "GAMBLE_CLOSED",
+                "ALREADY_JOINED",
+                "ALREADY_BET",
+                "GAMBLE_FULL",
+                "WAGER_TOO_HIGH"
+            ],
+            "x-enum-varnames": [
+                "ErrCodeBadRequest",
+                "ErrCodeValidation",
+                "ErrCodeUnauthorized",
+                "ErrCodeForbidden",
+                "ErrCodeNotFound",
+                "ErrCodeMethodNotAllowed",
+                "ErrCodeConflict",
+                "ErrCodePayloadTooLarge",
+                "ErrCodeRateLimited",
+                "ErrCodeInternal",
+                "ErrCodeUnavailable",
+                "ErrCodeUserNotFound",
+                "ErrCodeItemNotFound",
+                "ErrCodeRecipeNotFound",
+                "ErrCodeGambleNotFound",
+                "ErrCodeInvalidPlatform",
+                "ErrCodeInsufficientFunds",
+                "ErrCodeInsufficientItems",
+                "ErrCodeNotInInventory",
+                "ErrCodeInventoryFull",
+                "ErrCodeNotSellable",
+                "ErrCodeNotBuyable",
+                "ErrCodeItemBorrowed",
+                "ErrCodeItemLocked",
+                "ErrCodeFeatureLocked",
+                "ErrCodeFeatureDisabled",
+                "ErrCodeRecipeOffEvent",
+                "ErrCodeOnCooldown",
+                "ErrCodeLimitReached",
+                "ErrCodeAccountFrozen",
+                "ErrCodeAccountTooNew",
+                "ErrCodeAlreadyVoted",
+                "ErrCodeGambleClosed",
+                "ErrCodeAlreadyJoined",
+                "ErrCodeAlreadyBet",
+                "ErrCodeGambleFull",
+                "ErrCodeWagerTooHigh"
+            ]
+        },
+        "handler.ErrorResponse": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "$ref": "#/definitions/handler.ErrorCode"
                 },
-                "default_display": {
+                "details": {},
+                "error": {
+                    "description": "Error repeats Message for clients written before the envelope had one.\nDeprecated: read Message instead.",
                     "type": "string"
                 },
-                "description": {
+                "message": {
                     "type": "string"
                 },
-                "handler": {
-                    "description": "Nullable: some items have no handler",
+                "request_id": {
                     "type": "string"
+                }
+            }
+        },
+        "handler.ExternalContributionRequest": {
+            "type": "object",
+            "required": [
+                "amount"
+            ],
+            "properties": {
+                "amount": {
+                    "type": "integer",
+                    "maximum": 10000,
+                    "minimum": 1
                 },
-                "internal_name": {
+                "platform": {
                     "type": "string"
                 },
-                "item_id": {
-                    "type": "integer"
+                "platform_id": {
+                    "type": "string",
+                    "maxLength": 100
                 },
-                "public_name": {
+                "source": {
+                    "type": "string",
+                    "maxLength": 32
+                },
+                "username": {
+                    "type": "string",
+                    "maxLength": 100
+                }
+            }
+        },
+        "handler.GardenHarvestRequest": {
+            "type": "object",
+            "required": [
+                "platform",
+                "platform_id",
+                "username"
+            ],
+            "properties": {
+                "platform": {
                     "type": "string"
                 },
-                "sell_price": {
-                    "description": "Calculated sell price (only set for sellable items)",
-                    "type": "integer"
+                "platform_id": {
+                    "type": "string"
                 },
-                "types": {
-                    "description": "Populated from join/separate query",
+                "username": {
+                    "type": "string",
+                    "maxLength": 100
+                }
+            }
+        },
+        "handler.GetInventoryResponse": {
+            "type": "object",
+            "properties": {
+                "capacity": {
+                    "$ref": "#/definitions/domain.InventoryCapacity"
+                },
+                "items": {
                     "type": "array",
                     "items": {
-                        "type": "string"
+                        "$ref": "#/definitions/user.InventoryItem"
                     }
                 }
             }
         },
-        "domain.MessageResult": {
+        "handler.GetUserJobsResponse": {
             "type": "object",
             "properties": {
-                "matches": {
+                "jobs": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/domain.FoundString"
+                        "$ref": "#/definitions/domain.UserJobInfo"
+                    }
+                },
+                "platform": {
+                    "type": "string"
+                },
+                "platform_id": {
+                    "type": "string"
+                },
+                "primary_job": {
+                    "$ref": "#/definitions/domain.UserJobInfo"
+                }
+            }
+        },
+        "handler.GetUserTimeoutResponse": {
+            "type": "object",
+            "properties": {
+                "is_timed_out": {
+                    "type": "boolean"
+                },
+                "platform": {
+                    "type": "string"
+                },
+                "remaining_seconds": {
+                    "type": "number"
+                },
+                "username": {
+                    "type": "string"
+                }
+            }
+        },
+        "handler.GiftsResponse": {
+            "type": "object",
+            "properties": {
+                "gifts": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.Gift"
                     }
+                }
+            }
+        },
+        "handler.GiveItemRequest": {
+            "type": "object",
+            "required": [
+                "item_name",
+                "owner",
+                "owner_platform",
+                "owner_platform_id",
+                "receiver",
+                "receiver_platform"
+            ],
+            "properties": {
+                "item_name": {
+                    "type": "string",
+                    "maxLength": 100
+                },
+                "owner": {
+                    "type": "string",
+                    "maxLength": 100
+                },
+                "owner_platform": {
+                    "type": "string"
+                },
+                "owner_platform_id": {
+                    "type": "string"
+                },
+                "quantity": {
+                    "type": "integer",
+                    "maximum": 10000,
+                    "minimum": 1
+                },
+                "receiver": {
+                    "type": "string",
+                    "maxLength": 100
                 },
-                "user": {
-                    "$ref": "#/definitions/domain.User"
+                "receiver_platform": {
+                    "type": "string"
                 }
             }
         },
-        "domain.PredictionOutcomeRequest": {
+        "handler.HandleMessageRequest": {
             "type": "object",
             "required": [
-                "participants",
                 "platform",
-                "total_points_spent",
-                "winner"
+                "platform_id",
+                "username"
             ],
             "properties": {
-                "participants": {
-                    "type": "array",
-                    "minItems": 1,
-                    "items": {
-                        "$ref": "#/definitions/domain.PredictionParticipant"
-                    }
+                "message": {
+                    "type": "string"
                 },
                 "platform": {
-                    "type": "string",
-                    "enum": [
-                        "twitch",
-                        "youtube"
-                    ]
+                    "type": "string"
                 },
-                "total_points_spent": {
-                    "type": "integer",
-                    "minimum": 0
+                "platform_id": {
+                    "type": "string"
                 },
-                "winner": {
-                    "$ref": "#/definitions/domain.PredictionWinner"
+                "username": {
+                    "type": "string",
+                    "maxLength": 100
                 }
             }
         },
-        "domain.PredictionParticipant": {
+        "handler.HarvestRewardsRequest": {
             "type": "object",
+            "required": [
+                "platform",
+                "platform_id",
+                "username"
+            ],
             "properties": {
-                "platform_id": {
+                "platform": {
                     "type": "string"
                 },
-                "points_spent": {
-                    "type": "integer"
+                "platform_id": {
+                    "type": "string"
                 },
                 "username": {
-                    "type": "string"
+                    "type": "string",
+                    "maxLength": 100
                 }
             }
         },
-        "domain.PredictionResult": {
+        "handler.HealthResponse": {
             "type": "object",
             "properties": {
-                "contribution_awarded": {
-                    "type": "integer"
-                },
                 "message": {
                     "type": "string"
                 },
-                "participants_processed": {
-                    "type": "integer"
-                },
-                "total_points": {
-                    "type": "integer"
-                },
-                "winner_xp_awarded": {
-                    "type": "integer"
+                "status": {
+                    "type": "string"
                 }
             }
         },
-        "domain.PredictionWinner": {
+        "handler.InitiateRequest": {
             "type": "object",
             "properties": {
-                "platform_id": {
+                "platform": {
                     "type": "string"
                 },
-                "points_won": {
-                    "type": "integer"
-                },
-                "username": {
+                "platform_id": {
                     "type": "string"
                 }
             }
         },
-        "domain.ProgressionNode": {
+        "handler.InitiateResponse": {
             "type": "object",
             "properties": {
-                "category": {
-                    "description": "Grouping: economy, combat, etc.",
-                    "type": "string"
+                "expires_in": {
+                    "type": "integer"
                 },
-                "created_at": {
+                "token": {
                     "type": "string"
+                }
+            }
+        },
+        "handler.JobChoiceRequest": {
+            "type": "object",
+            "required": [
+                "job_key",
+                "platform",
+                "platform_id"
+            ],
+            "properties": {
+                "job_key": {
+                    "type": "string",
+                    "maxLength": 50
                 },
-                "description": {
+                "platform": {
                     "type": "string"
                 },
-                "display_name": {
+                "platform_id": {
                     "type": "string"
-                },
-                "id": {
-                    "type": "integer"
-                },
-                "max_level": {
-                    "type": "integer"
-                },
-                "node_key": {
+                }
+            }
+        },
+        "handler.JobsResponse": {
+            "type": "object",
+            "properties": {
+                "jobs": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.Job"
+                    }
+                }
+            }
+        },
+        "handler.JoinExpeditionRequest": {
+            "type": "object",
+            "required": [
+                "platform",
+                "platform_id",
+                "username"
+            ],
+            "properties": {
+                "platform": {
                     "type": "string"
                 },
-                "node_type": {
-                    "description": "'feature', 'item', 'mechanic', 'upgrade'",
+                "platform_id": {
                     "type": "string"
                 },
-                "size": {
-                    "description": "small, medium, large",
+                "username": {
                     "type": "string"
-                },
-                "sort_order": {
-                    "type": "integer"
-                },
-                "tier": {
-                    "description": "Dynamic cost calculation fields (v2.0)",
-                    "type": "integer"
-                },
-                "unlock_cost": {
-                    "description": "Calculated from tier+size, stored for performance",
-                    "type": "integer"
                 }
             }
         },
-        "domain.ProgressionStatus": {
+        "handler.JoinGambleRequest": {
             "type": "object",
+            "required": [
+                "platform",
+                "platform_id",
+                "username"
+            ],
             "properties": {
-                "active_session": {
-                    "$ref": "#/definitions/domain.ProgressionVotingSession"
-                },
-                "active_unlock_progress": {
-                    "$ref": "#/definitions/domain.UnlockProgress"
-                },
-                "all_nodes_unlocked": {
-                    "type": "boolean"
-                },
-                "contribution_score": {
-                    "type": "integer"
-                },
-                "is_transitioning": {
-                    "description": "Bug #5: True when between unlock completion and new session start",
-                    "type": "boolean"
+                "platform": {
+                    "type": "string"
                 },
-                "total_nodes": {
-                    "type": "integer"
+                "platform_id": {
+                    "type": "string"
                 },
-                "total_unlocked": {
-                    "type": "integer"
+                "username": {
+                    "type": "string"
                 }
             }
         },
-        "domain.ProgressionTreeNode": {
+        "handler.LendItemRequest": {
             "type": "object",
+            "required": [
+                "borrower",
+                "item_name",
+                "platform",
+                "platform_id",
+                "username"
+            ],
             "properties": {
-                "category": {
-                    "description": "Grouping: economy, combat, etc.",
-                    "type": "string"
+                "borrower": {
+                    "type": "string",
+                    "maxLength": 100
                 },
-                "children": {
-                    "description": "Child node IDs",
-                    "type": "array",
-                    "items": {
-                        "type": "integer"
-                    }
+                "duration_minutes": {
+                    "description": "DurationMinutes is how long the loan lasts; 0 uses the server default",
+                    "type": "integer",
+                    "minimum": 0
                 },
-                "created_at": {
-                    "type": "string"
+                "item_name": {
+                    "type": "string",
+                    "maxLength": 100
                 },
-                "description": {
+                "platform": {
                     "type": "string"
                 },
-                "display_name": {
+                "platform_id": {
                     "type": "string"
                 },
-                "id": {
-                    "type": "integer"
-                },
-                "is_unlocked": {
-                    "type": "boolean"
-                },
-                "max_level": {
-                    "type": "integer"
+                "quantity": {
+                    "type": "integer",
+                    "maximum": 10000,
+                    "minimum": 1
                 },
-                "node_key": {
-                    "type": "string"
+                "username": {
+                    "type": "string",
+                    "maxLength": 100
+                }
+            }
+        },
+        "handler.ListItemRequest": {
+            "type": "object",
+            "required": [
+                "item_name",
+                "platform",
+                "platform_id",
+                "username"
+            ],
+            "properties": {
+                "item_name": {
+                    "type": "string",
+                    "maxLength": 100
                 },
-                "node_type": {
-                    "description": "'feature', 'item', 'mechanic', 'upgrade'",
+                "platform": {
                     "type": "string"
                 },
-                "size": {
-                    "description": "small, medium, large",
+                "platform_id": {
                     "type": "string"
                 },
-                "sort_order": {
-                    "type": "integer"
-                },
-                "tier": {
-                    "description": "Dynamic cost calculation fields (v2.0)",
-                    "type": "integer"
+                "quantity": {
+                    "type": "integer",
+                    "maximum": 10000,
+                    "minimum": 1
                 },
-                "unlock_cost": {
-                    "description": "Calculated from tier+size, stored for performance",
-                    "type": "integer"
+                "unit_price": {
+                    "type": "integer",
+                    "minimum": 1
                 },
-                "unlocked_level": {
-                    "description": "0 if not unlocked",
-                    "type": "integer"
+                "username": {
+                    "type": "string",
+                    "maxLength": 100
                 }
             }
         },
-        "domain.ProgressionUnlock": {
+        "handler.ListingsResponse": {
             "type": "object",
             "properties": {
-                "current_level": {
-                    "type": "integer"
-                },
-                "engagement_score": {
-                    "type": "integer"
-                },
-                "id": {
-                    "type": "integer"
-                },
-                "node_id": {
-                    "type": "integer"
-                },
-                "unlocked_at": {
-                    "type": "string"
-                },
-                "unlocked_by": {
-                    "description": "'vote', 'admin', 'auto', 'instant_override'",
-                    "type": "string"
+                "listings": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.PlayerListing"
+                    }
                 }
             }
         },
-        "domain.ProgressionVotingOption": {
+        "handler.LoansResponse": {
             "type": "object",
             "properties": {
-                "estimated_unlock_date": {
+                "loans": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.ItemLoan"
+                    }
+                }
+            }
+        },
+        "handler.LootboxRevealEntry": {
+            "type": "object",
+            "properties": {
+                "consolation": {
+                    "description": "money awarded because no item dropped",
+                    "type": "boolean"
+                },
+                "item_name": {
                     "type": "string"
                 },
-                "id": {
-                    "type": "integer"
+                "near_miss": {
+                    "description": "roll just missed the next better tier",
+                    "type": "boolean"
                 },
-                "last_highest_vote_at": {
-                    "description": "When first reached current highest",
+                "near_miss_tier": {
+                    "$ref": "#/definitions/domain.QualityLevel"
+                },
+                "pity": {
+                    "description": "guaranteed by the pity counter",
+                    "type": "boolean"
+                },
+                "quality_level": {
                     "type": "string"
                 },
-                "node_details": {
-                    "$ref": "#/definitions/domain.ProgressionNode"
+                "quality_roll": {
+                    "description": "raw roll in [0,1); lower is rarer",
+                    "type": "number"
                 },
-                "node_id": {
+                "quantity": {
                     "type": "integer"
                 },
-                "session_id": {
+                "tier_rank": {
+                    "description": "0 (CURSED) to 7 (LEGENDARY)",
                     "type": "integer"
                 },
-                "target_level": {
-                    "type": "integer"
+                "upgraded": {
+                    "description": "critical quality upgrade applied",
+                    "type": "boolean"
                 },
-                "vote_count": {
+                "value": {
                     "type": "integer"
                 }
             }
         },
-        "domain.ProgressionVotingSession": {
+        "handler.MarkInboxReadRequest": {
             "type": "object",
+            "required": [
+                "platform",
+                "platform_id"
+            ],
             "properties": {
-                "ended_at": {
-                    "type": "string"
-                },
-                "id": {
-                    "type": "integer"
-                },
-                "options": {
+                "ids": {
+                    "description": "IDs are the messages to mark; empty marks every message",
                     "type": "array",
+                    "maxItems": 100,
                     "items": {
-                        "$ref": "#/definitions/domain.ProgressionVotingOption"
+                        "type": "integer"
                     }
                 },
-                "started_at": {
-                    "type": "string"
-                },
-                "status": {
-                    "description": "'voting', 'completed'",
+                "platform": {
                     "type": "string"
                 },
-                "voting_deadline": {
+                "platform_id": {
                     "type": "string"
-                },
-                "winning_option_id": {
-                    "type": "integer"
                 }
             }
         },
-        "domain.StatsSummary": {
+        "handler.MarkInboxReadResponse": {
             "type": "object",
             "properties": {
-                "end_time": {
-                    "type": "string"
-                },
-                "event_counts": {
-                    "type": "object",
-                    "additionalProperties": {
-                        "type": "integer"
-                    }
-                },
-                "metrics": {
-                    "type": "object",
-                    "additionalProperties": true
-                },
-                "period": {
-                    "type": "string"
-                },
-                "start_time": {
-                    "type": "string"
-                },
-                "total_events": {
+                "marked": {
                     "type": "integer"
                 }
             }
         },
-        "domain.SubscriptionEvent": {
+        "handler.MarketBuyRequest": {
             "type": "object",
             "required": [
-                "event_type",
                 "platform",
-                "platform_user_id",
-                "tier_name",
-                "timestamp",
+                "platform_id",
                 "username"
             ],
             "properties": {
-                "event_type": {
-                    "description": "Use HistoryEventType constants",
-                    "type": "string"
+                "listing_id": {
+                    "type": "integer",
+                    "minimum": 1
                 },
                 "platform": {
-                    "type": "string",
-                    "enum": [
-                        "twitch",
-                        "youtube"
-                    ]
-                },
-                "platform_user_id": {
                     "type": "string"
                 },
-                "tier_name": {
+                "platform_id": {
                     "type": "string"
                 },
-                "timestamp": {
-                    "type": "integer"
+                "quantity": {
+                    "type": "integer",
+                    "maximum": 10000,
+                    "minimum": 1
                 },
                 "username": {
-                    "type": "string"
+                    "type": "string",
+                    "maxLength": 100
                 }
             }
         },
-        "domain.SubscriptionWithTier": {
+        "handler.MarketCancelRequest": {
             "type": "object",
+            "required": [
+                "platform",
+                "platform_id"
+            ],
             "properties": {
-                "created_at": {
-                    "type": "string"
-                },
-                "display_name": {
-                    "type": "string"
-                },
-                "expires_at": {
-                    "type": "string"
-                },
-                "last_verified_at": {
-                    "type": "string"
+                "listing_id": {
+                    "type": "integer",
+                    "minimum": 1
                 },
                 "platform": {
                     "type": "string"
                 },
-                "status": {
-                    "type": "string"
-                },
-                "subscribed_at": {
-                    "type": "string"
-                },
-                "tier_id": {
-                    "type": "integer"
-                },
-                "tier_level": {
-                    "type": "integer"
-                },
-                "tier_name": {
-                    "type": "string"
-                },
-                "updated_at": {
-                    "type": "string"
-                },
-                "user_id": {
+                "platform_id": {
                     "type": "string"
                 }
             }
         },
-        "domain.UnlockEstimate": {
+        "handler.PersonalProgressionResponse": {
             "type": "object",
             "properties": {
-                "confidence": {
-                    "description": "\"high\", \"medium\", \"low\"",
+                "tracks": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.PersonalTrack"
+                    }
+                }
+            }
+        },
+        "handler.PlantRequest": {
+            "type": "object",
+            "required": [
+                "platform",
+                "platform_id",
+                "seed",
+                "username"
+            ],
+            "properties": {
+                "platform": {
                     "type": "string"
                 },
-                "current_progress": {
-                    "type": "integer"
-                },
-                "current_velocity": {
-                    "type": "number"
-                },
-                "estimated_days": {
-                    "type": "number"
-                },
-                "estimated_unlock_date": {
+                "platform_id": {
                     "type": "string"
                 },
-                "node_key": {
-                    "type": "string"
+                "seed": {
+                    "type": "string",
+                    "maxLength": 100
                 },
-                "required_points": {
-                    "type": "integer"
+                "username": {
+                    "type": "string",
+                    "maxLength": 100
                 }
             }
         },
-        "domain.UnlockProgress": {
+        "handler.ProgressionTreeResponse": {
             "type": "object",
             "properties": {
-                "contributions_accumulated": {
-                    "type": "integer"
-                },
-                "estimated_unlock_date": {
-                    "type": "string"
-                },
-                "id": {
-                    "type": "integer"
-                },
-                "node_id": {
-                    "description": "NULL until vote ends",
-                    "type": "integer"
-                },
-                "started_at": {
-                    "type": "string"
-                },
-                "target_level": {
-                    "description": "NULL until vote ends",
-                    "type": "integer"
+                "nodes": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.ProgressionTreeNode"
+                    }
+                }
+            }
+        },
+        "handler.RecipeMasteryResponse": {
+            "type": "object",
+            "properties": {
+                "recipes": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/crafting.RecipeMastery"
+                    }
+                }
+            }
+        },
+        "handler.RecordEventRequest": {
+            "type": "object",
+            "required": [
+                "event_type",
+                "user_id"
+            ],
+            "properties": {
+                "event_data": {
+                    "type": "object",
+                    "additionalProperties": true
                 },
-                "unlocked_at": {
-                    "type": "string"
+                "event_type": {
+                    "type": "string",
+                    "maxLength": 50
                 },
-                "voting_session_id": {
-                    "type": "integer"
+                "user_id": {
+                    "type": "string",
+                    "maxLength": 100
                 }
             }
         },
-        "domain.User": {
+        "handler.ReelRequest": {
             "type": "object",
+            "required": [
+                "platform",
+                "platform_id",
+                "username"
+            ],
             "properties": {
-                "created_at": {
+                "platform": {
                     "type": "string"
                 },
-                "discord_id": {
+                "platform_id": {
                     "type": "string"
                 },
-                "internal_id": {
+                "username": {
+                    "type": "string",
+                    "maxLength": 100
+                }
+            }
+        },
+        "handler.RegisterUserRequest": {
+            "type": "object",
+            "required": [
+                "known_platform",
+                "known_platform_id",
+                "new_platform",
+                "new_platform_id",
+                "username"
+            ],
+            "properties": {
+                "known_platform": {
                     "type": "string"
                 },
-                "platform_usernames": {
-                    "type": "object",
-                    "additionalProperties": {
-                        "type": "string"
-                    }
-                },
-                "twitch_id": {
+                "known_platform_id": {
                     "type": "string"
                 },
-                "updated_at": {
+                "new_platform": {
                     "type": "string"
                 },
-                "username": {
+                "new_platform_id": {
                     "type": "string"
                 },
-                "youtube_id": {
-                    "type": "string"
+                "username": {
+                    "type": "string",
+                    "maxLength": 100
                 }
             }
         },
-        "domain.VelocityMetrics": {
+        "handler.RemindersResponse": {
             "type": "object",
             "properties": {
-                "period_days": {
-                    "type": "integer"
-                },
-                "points_per_day": {
-                    "type": "number"
-                },
-                "sample_size": {
-                    "type": "integer"
-                },
-                "total_points": {
-                    "type": "integer"
-                },
-                "trend": {
-                    "description": "\"increasing\", \"stable\", \"decreasing\"",
-                    "type": "string"
+                "reminders": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.Reminder"
+                    }
                 }
             }
         },
-        "handler.AddItemByUsernameRequest": {
+        "handler.RemoveItemByUsernameRequest": {
             "type": "object",
             "required": [
                 "item_name",
@@ -3044,67 +13323,107 @@ const docTemplate = `{
                 }
             }
         },
-        "handler.AdminAddContributionRequest": {
+        "handler.RemoveItemResponse": {
             "type": "object",
             "properties": {
-                "amount": {
+                "message": {
+                    "type": "string"
+                },
+                "removed": {
                     "type": "integer"
                 }
             }
         },
-        "handler.AdminEndVotingResponse": {
+        "handler.RunCustomScenarioRequest": {
             "type": "object",
             "properties": {
-                "message": {
-                    "type": "string"
+                "parameters": {
+                    "type": "object",
+                    "additionalProperties": true
                 },
-                "winner": {
-                    "$ref": "#/definitions/domain.ProgressionVotingOption"
+                "scenario": {
+                    "$ref": "#/definitions/scenario.Scenario"
                 }
             }
         },
-        "handler.AdminInstantUnlockResponse": {
+        "handler.RunScenarioRequest": {
             "type": "object",
             "properties": {
-                "message": {
+                "parameters": {
+                    "type": "object",
+                    "additionalProperties": true
+                },
+                "scenario_id": {
                     "type": "string"
+                }
+            }
+        },
+        "handler.ScenariosResponse": {
+            "type": "object",
+            "properties": {
+                "scenarios": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/scenario.Summary"
+                    }
                 },
-                "unlock": {
-                    "$ref": "#/definitions/domain.ProgressionUnlock"
+                "total": {
+                    "type": "integer"
                 }
             }
         },
-        "handler.AdminResetRequest": {
+        "handler.SearchLocationsResponse": {
+            "type": "object",
+            "properties": {
+                "locations": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/search.LocationStatus"
+                    }
+                }
+            }
+        },
+        "handler.SearchRequest": {
             "type": "object",
             "required": [
-                "reset_by"
+                "platform",
+                "platform_id",
+                "username"
             ],
             "properties": {
-                "preserve_user_progression": {
-                    "type": "boolean"
-                },
-                "reason": {
+                "item_hint": {
                     "type": "string",
-                    "maxLength": 255
+                    "maxLength": 50
                 },
-                "reset_by": {
+                "platform": {
+                    "type": "string"
+                },
+                "platform_id": {
+                    "type": "string"
+                },
+                "username": {
                     "type": "string",
                     "maxLength": 100
                 }
             }
         },
-        "handler.AvailableUnlocksResponse": {
+        "handler.SearchResponse": {
             "type": "object",
             "properties": {
-                "available": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/domain.ProgressionNode"
-                    }
+                "message": {
+                    "type": "string"
+                },
+                "progress": {
+                    "description": "Progress is the player's search streak and mastery after this search",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/search.ProgressStatus"
+                        }
+                    ]
                 }
             }
         },
-        "handler.BuyItemRequest": {
+        "handler.SellItemRequest": {
             "type": "object",
             "required": [
                 "item_name",
@@ -3134,44 +13453,72 @@ const docTemplate = `{
                 }
             }
         },
-        "handler.BuyItemResponse": {
+        "handler.SellItemResponse": {
             "type": "object",
             "properties": {
-                "items_bought": {
+                "items_sold": {
                     "type": "integer"
                 },
                 "message": {
                     "type": "string"
+                },
+                "money_gained": {
+                    "type": "integer"
                 }
             }
         },
-        "handler.CapabilitiesResponse": {
+        "handler.SetBirthdayRequest": {
             "type": "object",
+            "required": [
+                "platform",
+                "platform_id",
+                "username"
+            ],
             "properties": {
-                "capabilities": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/scenario.CapabilityInfo"
-                    }
+                "day": {
+                    "type": "integer",
+                    "maximum": 31,
+                    "minimum": 1
                 },
-                "features": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
+                "month": {
+                    "type": "integer",
+                    "maximum": 12,
+                    "minimum": 1
+                },
+                "platform": {
+                    "type": "string"
+                },
+                "platform_id": {
+                    "type": "string"
+                },
+                "username": {
+                    "type": "string",
+                    "maxLength": 100
                 }
             }
         },
-        "handler.CraftingActionRequest": {
+        "handler.SetCelebrationGuildRequest": {
+            "type": "object",
+            "properties": {
+                "enabled": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "handler.SetItemFavoriteRequest": {
             "type": "object",
             "required": [
-                "item",
+                "favorite",
+                "item_name",
                 "platform",
                 "platform_id",
                 "username"
             ],
             "properties": {
-                "item": {
+                "favorite": {
+                    "type": "boolean"
+                },
+                "item_name": {
                     "type": "string",
                     "maxLength": 100
                 },
@@ -3181,107 +13528,122 @@ const docTemplate = `{
                 "platform_id": {
                     "type": "string"
                 },
-                "quantity": {
+                "username": {
+                    "type": "string",
+                    "maxLength": 100
+                }
+            }
+        },
+        "handler.SetItemLockRequest": {
+            "type": "object",
+            "required": [
+                "item_name",
+                "locked",
+                "platform",
+                "platform_id",
+                "username"
+            ],
+            "properties": {
+                "item_name": {
+                    "type": "string",
+                    "maxLength": 100
+                },
+                "locked": {
+                    "type": "boolean"
+                },
+                "platform": {
+                    "type": "string"
+                },
+                "platform_id": {
+                    "type": "string"
+                },
+                "username": {
+                    "type": "string",
+                    "maxLength": 100
+                }
+            }
+        },
+        "handler.SetTimeoutRequest": {
+            "type": "object",
+            "required": [
+                "duration_seconds",
+                "platform",
+                "username"
+            ],
+            "properties": {
+                "duration_seconds": {
                     "type": "integer",
-                    "maximum": 10000,
+                    "maximum": 86400,
                     "minimum": 1
                 },
+                "platform": {
+                    "type": "string"
+                },
+                "reason": {
+                    "type": "string",
+                    "maxLength": 255
+                },
                 "username": {
                     "type": "string",
                     "maxLength": 100
                 }
             }
         },
-        "handler.DisassembleItemResponse": {
+        "handler.SetTimeoutResponse": {
             "type": "object",
             "properties": {
-                "is_perfect_salvage": {
-                    "type": "boolean"
+                "added_duration_seconds": {
+                    "type": "integer"
                 },
                 "message": {
                     "type": "string"
                 },
-                "multiplier": {
-                    "type": "number"
+                "platform": {
+                    "type": "string"
                 },
-                "outputs": {
-                    "type": "object",
-                    "additionalProperties": {
-                        "type": "integer"
-                    }
+                "total_remaining_seconds": {
+                    "type": "number"
                 },
-                "quantity_processed": {
-                    "type": "integer"
-                }
-            }
-        },
-        "handler.ErrorResponse": {
-            "type": "object",
-            "properties": {
-                "error": {
+                "username": {
                     "type": "string"
                 }
             }
         },
-        "handler.GetInventoryResponse": {
-            "type": "object",
-            "properties": {
-                "items": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/user.InventoryItem"
-                    }
-                }
-            }
-        },
-        "handler.GiveItemRequest": {
+        "handler.SpinSlotsRequest": {
             "type": "object",
             "required": [
-                "item_name",
-                "owner",
-                "owner_platform",
-                "owner_platform_id",
-                "receiver",
-                "receiver_platform"
+                "bet_amount",
+                "platform",
+                "platform_id",
+                "username"
             ],
             "properties": {
-                "item_name": {
-                    "type": "string",
-                    "maxLength": 100
-                },
-                "owner": {
-                    "type": "string",
-                    "maxLength": 100
+                "bet_amount": {
+                    "type": "integer",
+                    "maximum": 10000,
+                    "minimum": 10
                 },
-                "owner_platform": {
+                "platform": {
                     "type": "string"
                 },
-                "owner_platform_id": {
+                "platform_id": {
                     "type": "string"
                 },
-                "quantity": {
-                    "type": "integer",
-                    "maximum": 10000,
-                    "minimum": 1
-                },
-                "receiver": {
-                    "type": "string",
-                    "maxLength": 100
-                },
-                "receiver_platform": {
+                "username": {
                     "type": "string"
                 }
             }
         },
-        "handler.HandleMessageRequest": {
+        "handler.StartExpeditionRequest": {
             "type": "object",
             "required": [
+                "expedition_type",
                 "platform",
                 "platform_id",
                 "username"
             ],
             "properties": {
-                "message": {
+                "expedition_type": {
                     "type": "string"
                 },
                 "platform": {
@@ -3291,19 +13653,40 @@ const docTemplate = `{
                     "type": "string"
                 },
                 "username": {
-                    "type": "string",
-                    "maxLength": 100
+                    "type": "string"
                 }
             }
         },
-        "handler.HarvestRewardsRequest": {
+        "handler.StartExpeditionResponse": {
+            "type": "object",
+            "properties": {
+                "expedition_id": {
+                    "type": "string"
+                },
+                "join_deadline": {
+                    "type": "string"
+                },
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "handler.StartGambleRequest": {
             "type": "object",
             "required": [
+                "bets",
                 "platform",
                 "platform_id",
                 "username"
             ],
             "properties": {
+                "bets": {
+                    "type": "array",
+                    "minItems": 1,
+                    "items": {
+                        "$ref": "#/definitions/domain.LootboxBet"
+                    }
+                },
                 "platform": {
                     "type": "string"
                 },
@@ -3311,74 +13694,56 @@ const docTemplate = `{
                     "type": "string"
                 },
                 "username": {
-                    "type": "string",
-                    "maxLength": 100
+                    "type": "string"
                 }
             }
         },
-        "handler.HealthResponse": {
+        "handler.StartGambleResponse": {
             "type": "object",
             "properties": {
+                "gamble_id": {
+                    "type": "string"
+                },
                 "message": {
                     "type": "string"
                 },
-                "status": {
+                "short_code": {
                     "type": "string"
                 }
             }
         },
-        "handler.ProgressionTreeResponse": {
+        "handler.StartStreamResponse": {
             "type": "object",
             "properties": {
-                "nodes": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/domain.ProgressionTreeNode"
-                    }
+                "created": {
+                    "description": "Created is false when the stream was already live",
+                    "type": "boolean"
+                },
+                "session": {
+                    "$ref": "#/definitions/domain.StreamSession"
                 }
             }
         },
-        "handler.RecordEventRequest": {
+        "handler.SuccessResponse": {
             "type": "object",
-            "required": [
-                "event_type",
-                "user_id"
-            ],
             "properties": {
-                "event_data": {
-                    "type": "object",
-                    "additionalProperties": true
-                },
-                "event_type": {
-                    "type": "string",
-                    "maxLength": 50
-                },
-                "user_id": {
-                    "type": "string",
-                    "maxLength": 100
+                "message": {
+                    "type": "string"
                 }
             }
         },
-        "handler.RegisterUserRequest": {
+        "handler.TestRequest": {
             "type": "object",
             "required": [
-                "known_platform",
-                "known_platform_id",
-                "new_platform",
-                "new_platform_id",
+                "platform",
+                "platform_id",
                 "username"
             ],
             "properties": {
-                "known_platform": {
-                    "type": "string"
-                },
-                "known_platform_id": {
-                    "type": "string"
-                },
-                "new_platform": {
+                "platform": {
                     "type": "string"
                 },
-                "new_platform_id": {
+                "platform_id": {
                     "type": "string"
                 },
                 "username": {
@@ -3387,82 +13752,155 @@ const docTemplate = `{
                 }
             }
         },
-        "handler.RemoveItemByUsernameRequest": {
+        "handler.TestResponse": {
+            "type": "object",
+            "properties": {
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "handler.UndoRequest": {
             "type": "object",
             "required": [
-                "item_name",
                 "platform",
-                "username"
+                "platform_id"
             ],
             "properties": {
-                "item_name": {
-                    "type": "string",
-                    "maxLength": 100
+                "platform": {
+                    "type": "string"
+                },
+                "platform_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "handler.UnlinkRequest": {
+            "type": "object",
+            "properties": {
+                "confirm": {
+                    "type": "boolean"
                 },
                 "platform": {
                     "type": "string"
                 },
-                "quantity": {
-                    "type": "integer",
-                    "maximum": 10000,
-                    "minimum": 1
+                "platform_id": {
+                    "type": "string"
                 },
-                "username": {
-                    "type": "string",
-                    "maxLength": 100
+                "target_platform": {
+                    "type": "string"
                 }
             }
         },
-        "handler.RemoveItemResponse": {
+        "handler.UnlinkResponse": {
             "type": "object",
             "properties": {
+                "awaiting_confirmation": {
+                    "type": "boolean"
+                },
                 "message": {
                     "type": "string"
                 },
-                "removed": {
-                    "type": "integer"
+                "success": {
+                    "type": "boolean"
                 }
             }
         },
-        "handler.RunCustomScenarioRequest": {
+        "handler.UnlockProgressResponse": {
             "type": "object",
             "properties": {
-                "parameters": {
-                    "type": "object",
-                    "additionalProperties": true
+                "completion_percentage": {
+                    "type": "number"
                 },
-                "scenario": {
-                    "$ref": "#/definitions/scenario.Scenario"
+                "contributions_accumulated": {
+                    "type": "integer"
+                },
+                "estimated_unlock_date": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "is_already_unlocked": {
+                    "type": "boolean"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "node_id": {
+                    "description": "NULL until vote ends",
+                    "type": "integer"
+                },
+                "started_at": {
+                    "type": "string"
+                },
+                "target_level": {
+                    "description": "NULL until vote ends",
+                    "type": "integer"
+                },
+                "target_node_name": {
+                    "type": "string"
+                },
+                "target_unlock_cost": {
+                    "type": "integer"
+                },
+                "unlocked_at": {
+                    "type": "string"
+                },
+                "voting_session_id": {
+                    "type": "integer"
                 }
             }
         },
-        "handler.RunScenarioRequest": {
+        "handler.UpdateUserPreferencesRequest": {
             "type": "object",
+            "required": [
+                "platform",
+                "platform_id",
+                "targeting_opt_out",
+                "username"
+            ],
             "properties": {
-                "parameters": {
-                    "type": "object",
-                    "additionalProperties": true
+                "platform": {
+                    "type": "string"
                 },
-                "scenario_id": {
+                "platform_id": {
                     "type": "string"
+                },
+                "targeting_opt_out": {
+                    "type": "boolean"
+                },
+                "username": {
+                    "type": "string",
+                    "maxLength": 100
                 }
             }
         },
-        "handler.ScenariosResponse": {
+        "handler.UpdateUserSettingsRequest": {
             "type": "object",
-            "properties": {
-                "scenarios": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/scenario.Summary"
-                    }
+            "required": [
+                "leaderboard_private",
+                "platform",
+                "platform_id",
+                "username"
+            ],
+            "properties": {
+                "leaderboard_private": {
+                    "type": "boolean"
                 },
-                "total": {
-                    "type": "integer"
+                "platform": {
+                    "type": "string"
+                },
+                "platform_id": {
+                    "type": "string"
+                },
+                "username": {
+                    "type": "string",
+                    "maxLength": 100
                 }
             }
         },
-        "handler.SearchRequest": {
+        "handler.UpdateUserThemeRequest": {
             "type": "object",
             "required": [
                 "platform",
@@ -3470,31 +13908,60 @@ const docTemplate = `{
                 "username"
             ],
             "properties": {
-                "item_hint": {
-                    "type": "string",
-                    "maxLength": 50
-                },
                 "platform": {
                     "type": "string"
                 },
                 "platform_id": {
                     "type": "string"
                 },
+                "theme": {
+                    "description": "Empty follows the calendar",
+                    "type": "string",
+                    "maxLength": 50
+                },
                 "username": {
                     "type": "string",
                     "maxLength": 100
                 }
             }
         },
-        "handler.SearchResponse": {
+        "handler.UpgradeItemResponse": {
             "type": "object",
             "properties": {
+                "bonus_quantity": {
+                    "type": "integer"
+                },
+                "is_masterwork": {
+                    "type": "boolean"
+                },
                 "message": {
                     "type": "string"
+                },
+                "new_item": {
+                    "type": "string"
+                },
+                "partial": {
+                    "type": "boolean"
+                },
+                "quantity_processed": {
+                    "type": "integer"
+                },
+                "quantity_requested": {
+                    "type": "integer"
+                },
+                "quantity_upgraded": {
+                    "type": "integer"
+                },
+                "tiers": {
+                    "description": "Tiers counts the crafts that rolled each tier above standard",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "integer"
+                    }
                 }
             }
         },
-        "handler.SellItemRequest": {
+        "handler.UseItemRequest": {
             "type": "object",
             "required": [
                 "item_name",
@@ -3518,45 +13985,51 @@ const docTemplate = `{
                     "maximum": 10000,
                     "minimum": 1
                 },
+                "target_user": {
+                    "type": "string",
+                    "maxLength": 100
+                },
                 "username": {
                     "type": "string",
                     "maxLength": 100
                 }
             }
         },
-        "handler.SellItemResponse": {
+        "handler.UseItemResponse": {
             "type": "object",
             "properties": {
-                "items_sold": {
-                    "type": "integer"
-                },
                 "message": {
                     "type": "string"
                 },
-                "money_gained": {
-                    "type": "integer"
+                "reveal": {
+                    "description": "lootbox drops in roll order, for reveal animations",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handler.LootboxRevealEntry"
+                    }
                 }
             }
         },
-        "handler.SetTimeoutRequest": {
+        "handler.VoteRequest": {
             "type": "object",
             "required": [
-                "duration_seconds",
+                "option_index",
                 "platform",
+                "platform_id",
                 "username"
             ],
             "properties": {
-                "duration_seconds": {
+                "option_index": {
                     "type": "integer",
-                    "maximum": 86400,
                     "minimum": 1
                 },
                 "platform": {
-                    "type": "string"
+                    "type": "string",
+                    "maxLength": 20
                 },
-                "reason": {
+                "platform_id": {
                     "type": "string",
-                    "maxLength": 255
+                    "maxLength": 100
                 },
                 "username": {
                     "type": "string",
@@ -3564,47 +14037,47 @@ const docTemplate = `{
                 }
             }
         },
-        "handler.SuccessResponse": {
-            "type": "object",
-            "properties": {
-                "message": {
-                    "type": "string"
-                }
-            }
-        },
-        "handler.UpgradeItemResponse": {
+        "handler.VotingSessionResponse": {
             "type": "object",
             "properties": {
-                "bonus_quantity": {
-                    "type": "integer"
-                },
-                "is_masterwork": {
+                "is_completed": {
                     "type": "boolean"
                 },
                 "message": {
                     "type": "string"
                 },
-                "new_item": {
-                    "type": "string"
-                },
-                "quantity_upgraded": {
-                    "type": "integer"
+                "session": {
+                    "$ref": "#/definitions/domain.ProgressionVotingSession"
                 }
             }
         },
-        "handler.UseItemRequest": {
+        "handler.WrapGiftRequest": {
             "type": "object",
             "required": [
                 "item_name",
                 "platform",
                 "platform_id",
+                "recipient",
                 "username"
             ],
             "properties": {
+                "channel_id": {
+                    "description": "ChannelID is where the delivery is announced; omitted announces it to\nthe recipient directly",
+                    "type": "string",
+                    "maxLength": 100
+                },
+                "deliver_at": {
+                    "description": "DeliverAt schedules the delivery; omitted delivers on the next sweep",
+                    "type": "string"
+                },
                 "item_name": {
                     "type": "string",
                     "maxLength": 100
                 },
+                "message": {
+                    "type": "string",
+                    "maxLength": 200
+                },
                 "platform": {
                     "type": "string"
                 },
@@ -3616,7 +14089,7 @@ const docTemplate = `{
                     "maximum": 10000,
                     "minimum": 1
                 },
-                "target_user": {
+                "recipient": {
                     "type": "string",
                     "maxLength": 100
                 },
@@ -3626,38 +14099,120 @@ const docTemplate = `{
                 }
             }
         },
-        "handler.UseItemResponse": {
+        "linking.LinkResult": {
             "type": "object",
             "properties": {
-                "message": {
+                "linked_platforms": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "success": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "linking.LinkStatus": {
+            "type": "object",
+            "properties": {
+                "linked_platforms": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "pending_token": {
+                    "$ref": "#/definitions/repository.LinkToken"
+                }
+            }
+        },
+        "progression.TreeDiff": {
+            "type": "object",
+            "properties": {
+                "added": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "applied": {
+                    "type": "boolean"
+                },
+                "changed": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/progression.TreeNodeChange"
+                    }
+                },
+                "missing": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "unchanged": {
+                    "type": "integer"
+                }
+            }
+        },
+        "progression.TreeNodeChange": {
+            "type": "object",
+            "properties": {
+                "fields": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "key": {
                     "type": "string"
                 }
             }
         },
-        "handler.VoteRequest": {
+        "repository.LinkToken": {
             "type": "object",
-            "required": [
-                "option_index",
-                "platform",
-                "platform_id",
-                "username"
-            ],
             "properties": {
-                "option_index": {
-                    "type": "integer",
-                    "minimum": 1
+                "created_at": {
+                    "type": "string"
                 },
-                "platform": {
-                    "type": "string",
-                    "maxLength": 20
+                "expires_at": {
+                    "type": "string"
                 },
-                "platform_id": {
-                    "type": "string",
-                    "maxLength": 100
+                "source_platform": {
+                    "type": "string"
                 },
-                "username": {
-                    "type": "string",
-                    "maxLength": 100
+                "source_platform_id": {
+                    "type": "string"
+                },
+                "state": {
+                    "type": "string"
+                },
+                "target_platform": {
+                    "type": "string"
+                },
+                "target_platform_id": {
+                    "type": "string"
+                },
+                "token": {
+                    "type": "string"
+                }
+            }
+        },
+        "repository.RecipeListItem": {
+            "type": "object",
+            "properties": {
+                "description": {
+                    "type": "string"
+                },
+                "item_id": {
+                    "type": "integer"
+                },
+                "item_name": {
+                    "type": "string"
+                },
+                "required_job_level": {
+                    "type": "integer"
                 }
             }
         },
@@ -4000,6 +14555,75 @@ const docTemplate = `{
                 }
             }
         },
+        "search.LocationStatus": {
+            "type": "object",
+            "properties": {
+                "cooldown_minutes": {
+                    "description": "Set when the region has its own cooldown",
+                    "type": "integer"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "destination": {
+                    "description": "Only searched when chosen explicitly",
+                    "type": "boolean"
+                },
+                "has_rare_events": {
+                    "type": "boolean"
+                },
+                "key": {
+                    "type": "string"
+                },
+                "locked_reason": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "ready_in_seconds": {
+                    "description": "0 when the region can be searched now",
+                    "type": "integer"
+                },
+                "required_explorer_level": {
+                    "type": "integer"
+                },
+                "required_node": {
+                    "type": "string"
+                },
+                "unlocked": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "search.ProgressStatus": {
+            "type": "object",
+            "properties": {
+                "best_streak": {
+                    "type": "integer"
+                },
+                "cooldown_reduction_percent": {
+                    "type": "integer"
+                },
+                "current_streak": {
+                    "type": "integer"
+                },
+                "mastery_level": {
+                    "type": "integer"
+                },
+                "searches_to_next_level": {
+                    "description": "0 at the maximum level",
+                    "type": "integer"
+                },
+                "streak_bonus": {
+                    "description": "Bonus lootboxes on the first search of each day",
+                    "type": "integer"
+                },
+                "total_searches": {
+                    "type": "integer"
+                }
+            }
+        },
         "user.CacheStats": {
             "type": "object",
             "properties": {
@@ -4023,9 +14647,15 @@ const docTemplate = `{
         "user.InventoryItem": {
             "type": "object",
             "properties": {
+                "favorite": {
+                    "type": "boolean"
+                },
                 "item_name": {
                     "type": "string"
                 },
+                "locked": {
+                    "type": "boolean"
+                },
                 "public_name": {
                     "type": "string"
                 },
@@ -0,0 +1,66 @@
+package announce
+
+import (
+	"slices"
+	"time"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/event"
+	"github.com/osse101/BrandishBot_Go/internal/sse"
+)
+
+// EventTypes maps the event names routes are configured with to the bus
+// events they fire on. The names are the SSE event types clients already
+// see, so the Discord bot can tell which of its own announcements a route
+// replaces.
+var EventTypes = map[string]event.Type{
+	sse.EventTypeJobLevelUp:          event.Type(domain.EventTypeJobLevelUp),
+	sse.EventTypeVotingStarted:       event.ProgressionVotingStarted,
+	sse.EventTypeCycleCompleted:      event.ProgressionCycleCompleted,
+	sse.EventTypeAllUnlocked:         event.ProgressionAllUnlocked,
+	sse.EventTypeGambleCompleted:     event.Type(domain.EventGambleCompleted),
+	sse.EventTypeExpeditionStarted:   event.Type(domain.EventExpeditionStarted),
+	sse.EventTypeExpeditionCompleted: event.Type(domain.EventExpeditionCompleted),
+	sse.EventTypeCelebration:         event.CelebrationGranted,
+	sse.EventTypeGambleRecovered:     event.GambleRecovered,
+	sse.EventTypeVotesFlagged:        event.ProgressionVotesFlagged,
+	sse.EventTypeGiveFlagged:         event.GiveFlagged,
+	sse.EventTypeChatDrop:            event.ChatDropped,
+	sse.EventTypeKeywordDrop:         event.KeywordDropped,
+	sse.EventTypeCommunityBonus:      event.CommunityBonusGranted,
+	sse.EventTypeJackpotWon:          event.JackpotWon,
+	sse.EventTypeDigMilestone:        event.DigMilestoneReached,
+	sse.EventTypeBossSpawned:         event.BossSpawned,
+	sse.EventTypeBossDefeated:        event.BossDefeated,
+	sse.EventTypeBossEscaped:         event.BossEscaped,
+	sse.EventTypeStreamStarted:       event.StreamStarted,
+	sse.EventTypeStreamEnded:         event.StreamEnded,
+	sse.EventTypeStreamRecap:         event.StreamRecapGenerated,
+}
+
+// MaxMessageLength caps a rendered message at Discord's message limit
+const MaxMessageLength = 2000
+
+// DefaultRequestTimeout bounds one POST to a webhook destination
+const DefaultRequestTimeout = 10 * time.Second
+
+// Log messages
+const (
+	LogMsgRouteCreated      = "Announcement route created"
+	LogMsgRouteUpdated      = "Announcement route updated"
+	LogMsgRouteDeleted      = "Announcement route deleted"
+	LogWarnRenderFailed     = "Failed to render announcement"
+	LogWarnDeliveryFailed   = "Failed to deliver announcement"
+	LogWarnListRoutesFailed = "Failed to load announcement routes"
+)
+
+// SupportedEventTypes returns the event names routes can be set up for,
+// sorted
+func SupportedEventTypes() []string {
+	names := make([]string, 0, len(EventTypes))
+	for name := range EventTypes {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names
+}
@@ -0,0 +1,27 @@
+package announce
+
+import (
+	"context"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+)
+
+// Repository stores announcement routes
+type Repository interface {
+	// CreateRoute stores a route
+	CreateRoute(ctx context.Context, route domain.AnnouncementRoute) (*domain.AnnouncementRoute, error)
+
+	// UpdateRoute replaces a route's rule and returns nil if there is no
+	// route with its ID
+	UpdateRoute(ctx context.Context, route domain.AnnouncementRoute) (*domain.AnnouncementRoute, error)
+
+	// DeleteRoute removes a route and returns false if there is no route
+	// with that ID
+	DeleteRoute(ctx context.Context, id int64) (bool, error)
+
+	// ListRoutes returns every route, oldest first
+	ListRoutes(ctx context.Context) ([]domain.AnnouncementRoute, error)
+
+	// ListEnabledRoutes returns the enabled routes for an event type
+	ListEnabledRoutes(ctx context.Context, eventType string) ([]domain.AnnouncementRoute, error)
+}
@@ -0,0 +1,340 @@
+// Package announce turns events into announcements by admin-configured
+// routes. Each route renders a template with an event's payload and sends the
+// text to a Discord channel, a webhook URL or an SSE topic. Routes replace the
+// Discord bot's built-in announcement for their event type.
+package announce
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/event"
+	"github.com/osse101/BrandishBot_Go/internal/logger"
+)
+
+// Service manages announcement routes and announces events by them
+type Service interface {
+	// CreateRoute validates and stores a route
+	CreateRoute(ctx context.Context, req RouteRequest) (*domain.AnnouncementRoute, error)
+
+	// UpdateRoute replaces a route's rule. It returns
+	// domain.ErrAnnouncementRouteNotFound for unknown routes.
+	UpdateRoute(ctx context.Context, id int64, req RouteRequest) (*domain.AnnouncementRoute, error)
+
+	// DeleteRoute removes a route. It returns
+	// domain.ErrAnnouncementRouteNotFound for unknown routes.
+	DeleteRoute(ctx context.Context, id int64) error
+
+	// ListRoutes returns every route
+	ListRoutes(ctx context.Context) ([]domain.AnnouncementRoute, error)
+
+	// DiscordEventTypes returns the event types with an enabled Discord
+	// route, which the Discord bot no longer announces itself
+	DiscordEventTypes(ctx context.Context) ([]string, error)
+
+	// Subscribe announces every routable event published on this instance
+	Subscribe(bus event.Bus)
+}
+
+// RouteRequest describes a route to create, or what to replace a route with
+type RouteRequest struct {
+	EventType   string
+	Destination string
+	Target      string
+	Template    string
+	Enabled     bool
+	CreatedBy   string
+}
+
+// TemplateData is what route templates are rendered with. Payload is the
+// event payload decoded as JSON, so fields are addressed by their JSON
+// names, e.g. {{.Payload.winner_username}}.
+type TemplateData struct {
+	EventType string
+	Payload   map[string]any
+}
+
+// WebhookBody is what webhook destinations receive
+type WebhookBody struct {
+	EventType string `json:"event_type"`
+	Message   string `json:"message"`
+	Timestamp int64  `json:"timestamp"`
+}
+
+// Publisher publishes announcements for Discord and SSE destinations
+type Publisher interface {
+	PublishWithRetry(ctx context.Context, evt event.Event)
+}
+
+type service struct {
+	repo      Repository
+	publisher Publisher
+	client    *http.Client
+	now       func() time.Time
+}
+
+// NewService creates an announcement service. publisher may be nil, in which
+// case only webhook destinations are announced to.
+func NewService(repo Repository, publisher Publisher) Service {
+	return &service{
+		repo:      repo,
+		publisher: publisher,
+		client:    &http.Client{Timeout: DefaultRequestTimeout},
+		now:       time.Now,
+	}
+}
+
+func (s *service) CreateRoute(ctx context.Context, req RouteRequest) (*domain.AnnouncementRoute, error) {
+	if req.CreatedBy == "" {
+		return nil, fmt.Errorf("%w: creator is required", domain.ErrInvalidInput)
+	}
+	if err := validateRoute(req); err != nil {
+		return nil, err
+	}
+
+	route, err := s.repo.CreateRoute(ctx, domain.AnnouncementRoute{
+		EventType:   req.EventType,
+		Destination: domain.AnnouncementDestination(req.Destination),
+		Target:      req.Target,
+		Template:    req.Template,
+		Enabled:     req.Enabled,
+		CreatedBy:   req.CreatedBy,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	logger.FromContext(ctx).Info(LogMsgRouteCreated, "id", route.ID, "event_type", route.EventType, "destination", route.Destination, "created_by", route.CreatedBy)
+	s.publishRoutesChanged(ctx)
+	return route, nil
+}
+
+func (s *service) UpdateRoute(ctx context.Context, id int64, req RouteRequest) (*domain.AnnouncementRoute, error) {
+	if err := validateRoute(req); err != nil {
+		return nil, err
+	}
+
+	route, err := s.repo.UpdateRoute(ctx, domain.AnnouncementRoute{
+		ID:          id,
+		EventType:   req.EventType,
+		Destination: domain.AnnouncementDestination(req.Destination),
+		Target:      req.Target,
+		Template:    req.Template,
+		Enabled:     req.Enabled,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if route == nil {
+		return nil, domain.ErrAnnouncementRouteNotFound
+	}
+
+	logger.FromContext(ctx).Info(LogMsgRouteUpdated, "id", route.ID, "event_type", route.EventType, "destination", route.Destination, "enabled", route.Enabled)
+	s.publishRoutesChanged(ctx)
+	return route, nil
+}
+
+func (s *service) DeleteRoute(ctx context.Context, id int64) error {
+	deleted, err := s.repo.DeleteRoute(ctx, id)
+	if err != nil {
+		return err
+	}
+	if !deleted {
+		return domain.ErrAnnouncementRouteNotFound
+	}
+
+	logger.FromContext(ctx).Info(LogMsgRouteDeleted, "id", id)
+	s.publishRoutesChanged(ctx)
+	return nil
+}
+
+func (s *service) ListRoutes(ctx context.Context) ([]domain.AnnouncementRoute, error) {
+	return s.repo.ListRoutes(ctx)
+}
+
+func (s *service) DiscordEventTypes(ctx context.Context) ([]string, error) {
+	routes, err := s.repo.ListRoutes(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	eventTypes := []string{}
+	for _, route := range routes {
+		if route.Enabled && route.Destination == domain.AnnouncementDestinationDiscord && !slices.Contains(eventTypes, route.EventType) {
+			eventTypes = append(eventTypes, route.EventType)
+		}
+	}
+	slices.Sort(eventTypes)
+	return eventTypes, nil
+}
+
+// Subscribe registers a handler per routable event type. It subscribes
+// locally so that with several instances each event is announced once, by the
+// instance that published it.
+func (s *service) Subscribe(bus event.Bus) {
+	for name, eventType := range EventTypes {
+		bus.Subscribe(eventType, s.eventHandler(name))
+	}
+	slog.Info("Announcement event handlers registered", "event_types", len(EventTypes))
+}
+
+// eventHandler announces events of one type by each of its enabled routes.
+// A route that fails is logged and skipped so the others still go out.
+func (s *service) eventHandler(eventType string) event.Handler {
+	return func(ctx context.Context, evt event.Event) error {
+		log := logger.FromContext(ctx)
+		routes, err := s.repo.ListEnabledRoutes(ctx, eventType)
+		if err != nil {
+			log.Warn(LogWarnListRoutesFailed, "event_type", eventType, "error", err)
+			return nil
+		}
+		if len(routes) == 0 {
+			return nil
+		}
+
+		data := TemplateData{EventType: eventType, Payload: payloadFields(evt.Payload)}
+		for _, route := range routes {
+			message, err := render(route.Template, data)
+			if err != nil {
+				log.Warn(LogWarnRenderFailed, "route_id", route.ID, "event_type", eventType, "error", err)
+				continue
+			}
+			// A template can render nothing to skip an event
+			if message == "" {
+				continue
+			}
+			if err := s.deliver(ctx, route, message); err != nil {
+				log.Warn(LogWarnDeliveryFailed, "route_id", route.ID, "destination", route.Destination, "error", err)
+			}
+		}
+		return nil
+	}
+}
+
+// deliver sends a rendered message to its route's destination. Discord and
+// SSE messages are published for the SSE relay; webhooks are POSTed directly.
+func (s *service) deliver(ctx context.Context, route domain.AnnouncementRoute, message string) error {
+	if route.Destination == domain.AnnouncementDestinationWebhook {
+		return s.post(ctx, route, message)
+	}
+	if s.publisher != nil {
+		s.publisher.PublishWithRetry(ctx, event.NewAnnouncementEvent(route, message))
+	}
+	return nil
+}
+
+// post sends a message to a webhook destination, once
+func (s *service) post(ctx context.Context, route domain.AnnouncementRoute, message string) error {
+	body, err := json.Marshal(WebhookBody{
+		EventType: route.EventType,
+		Message:   message,
+		Timestamp: s.now().Unix(),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to encode announcement: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, route.Target, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to build announcement request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to post announcement: %w", err)
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("announcement webhook returned status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+func (s *service) publishRoutesChanged(ctx context.Context) {
+	if s.publisher == nil {
+		return
+	}
+	s.publisher.PublishWithRetry(ctx, event.NewAnnouncementRoutesChangedEvent())
+}
+
+// validateRoute checks the event type, that the target suits the
+// destination, and that the template parses
+func validateRoute(req RouteRequest) error {
+	if _, ok := EventTypes[req.EventType]; !ok {
+		return fmt.Errorf("%w: unsupported event type %q", domain.ErrInvalidInput, req.EventType)
+	}
+
+	switch domain.AnnouncementDestination(req.Destination) {
+	case domain.AnnouncementDestinationDiscord:
+		if _, err := strconv.ParseUint(req.Target, 10, 64); err != nil {
+			return fmt.Errorf("%w: discord target must be a channel ID", domain.ErrInvalidInput)
+		}
+	case domain.AnnouncementDestinationWebhook:
+		parsed, err := url.Parse(req.Target)
+		if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+			return fmt.Errorf("%w: webhook target must be an absolute http or https URL", domain.ErrInvalidInput)
+		}
+	case domain.AnnouncementDestinationSSE:
+		if req.Target == "" || strings.ContainsAny(req.Target, " \t\r\n") {
+			return fmt.Errorf("%w: sse target must be a topic without whitespace", domain.ErrInvalidInput)
+		}
+	default:
+		return fmt.Errorf("%w: destination must be discord, webhook or sse", domain.ErrInvalidInput)
+	}
+
+	if strings.TrimSpace(req.Template) == "" {
+		return fmt.Errorf("%w: template is required", domain.ErrInvalidInput)
+	}
+	if _, err := template.New("announcement").Parse(req.Template); err != nil {
+		return fmt.Errorf("%w: invalid template: %v", domain.ErrInvalidInput, err)
+	}
+	return nil
+}
+
+// render executes a route template and trims the result to a message
+func render(text string, data TemplateData) (string, error) {
+	tmpl, err := template.New("announcement").Option("missingkey=zero").Parse(text)
+	if err != nil {
+		return "", err
+	}
+
+	var out strings.Builder
+	if err := tmpl.Execute(&out, data); err != nil {
+		return "", err
+	}
+
+	message := strings.TrimSpace(out.String())
+	if runes := []rune(message); len(runes) > MaxMessageLength {
+		message = string(runes[:MaxMessageLength])
+	}
+	return message, nil
+}
+
+// payloadFields decodes an event payload into its JSON fields. Payloads that
+// are not JSON objects give no fields.
+func payloadFields(payload any) map[string]any {
+	raw, err := json.Marshal(payload)
+	if err != nil {
+		return nil
+	}
+	var fields map[string]any
+	if err := json.Unmarshal(raw, &fields); err != nil {
+		return nil
+	}
+	return fields
+}
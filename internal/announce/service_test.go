@@ -0,0 +1,223 @@
+package announce
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/osse101/BrandishBot_Go/internal/announce/mocks"
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/event"
+	"github.com/osse101/BrandishBot_Go/internal/sse"
+)
+
+// sentAt is when the service sends every test announcement
+var sentAt = time.Date(2026, 10, 15, 20, 0, 0, 0, time.UTC)
+
+func setupServiceTest(t *testing.T) (*service, *mocks.MockRepository, *mocks.MockPublisher) {
+	mockRepo := mocks.NewMockRepository(t)
+	mockPublisher := mocks.NewMockPublisher(t)
+	svc := NewService(mockRepo, mockPublisher).(*service)
+	svc.now = func() time.Time { return sentAt }
+	return svc, mockRepo, mockPublisher
+}
+
+func isRoutesChanged(evt event.Event) bool {
+	return evt.Type == event.AnnouncementRoutesChanged
+}
+
+func TestCreateRoute(t *testing.T) {
+	ctx := context.Background()
+	valid := RouteRequest{
+		EventType:   sse.EventTypeGambleCompleted,
+		Destination: string(domain.AnnouncementDestinationDiscord),
+		Target:      "123456789012345678",
+		Template:    "{{.Payload.winner_username}} won!",
+		Enabled:     true,
+		CreatedBy:   "admin",
+	}
+
+	t.Run("stores the route and announces the change", func(t *testing.T) {
+		svc, mockRepo, mockPublisher := setupServiceTest(t)
+		mockRepo.On("CreateRoute", mock.Anything, mock.MatchedBy(func(route domain.AnnouncementRoute) bool {
+			return route.EventType == valid.EventType && route.Destination == domain.AnnouncementDestinationDiscord && route.Enabled
+		})).Return(&domain.AnnouncementRoute{ID: 4, EventType: valid.EventType}, nil)
+		mockPublisher.On("PublishWithRetry", mock.Anything, mock.MatchedBy(isRoutesChanged)).Once()
+
+		route, err := svc.CreateRoute(ctx, valid)
+
+		require.NoError(t, err)
+		assert.Equal(t, int64(4), route.ID)
+	})
+
+	invalid := []struct {
+		name   string
+		modify func(*RouteRequest)
+	}{
+		{"unsupported event type", func(r *RouteRequest) { r.EventType = "nope" }},
+		{"unknown destination", func(r *RouteRequest) { r.Destination = "email" }},
+		{"discord target is not a channel ID", func(r *RouteRequest) { r.Target = "#general" }},
+		{"webhook target is not a URL", func(r *RouteRequest) {
+			r.Destination = string(domain.AnnouncementDestinationWebhook)
+			r.Target = "ftp://example.com"
+		}},
+		{"sse topic has whitespace", func(r *RouteRequest) {
+			r.Destination = string(domain.AnnouncementDestinationSSE)
+			r.Target = "big wins"
+		}},
+		{"blank template", func(r *RouteRequest) { r.Template = "  " }},
+		{"template does not parse", func(r *RouteRequest) { r.Template = "{{.Payload" }},
+		{"missing creator", func(r *RouteRequest) { r.CreatedBy = "" }},
+	}
+	for _, tt := range invalid {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, _, _ := setupServiceTest(t)
+			req := valid
+			tt.modify(&req)
+
+			_, err := svc.CreateRoute(ctx, req)
+
+			assert.ErrorIs(t, err, domain.ErrInvalidInput)
+		})
+	}
+}
+
+func TestUpdateRoute(t *testing.T) {
+	ctx := context.Background()
+	req := RouteRequest{
+		EventType:   sse.EventTypeStreamRecap,
+		Destination: string(domain.AnnouncementDestinationSSE),
+		Target:      "overlay.recap",
+		Template:    "Thanks for watching!",
+	}
+
+	t.Run("replaces the route", func(t *testing.T) {
+		svc, mockRepo, mockPublisher := setupServiceTest(t)
+		mockRepo.On("UpdateRoute", mock.Anything, mock.MatchedBy(func(route domain.AnnouncementRoute) bool {
+			return route.ID == 2 && route.Target == "overlay.recap" && !route.Enabled
+		})).Return(&domain.AnnouncementRoute{ID: 2}, nil)
+		mockPublisher.On("PublishWithRetry", mock.Anything, mock.MatchedBy(isRoutesChanged)).Once()
+
+		_, err := svc.UpdateRoute(ctx, 2, req)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("unknown route", func(t *testing.T) {
+		svc, mockRepo, _ := setupServiceTest(t)
+		mockRepo.On("UpdateRoute", mock.Anything, mock.Anything).Return(nil, nil)
+
+		_, err := svc.UpdateRoute(ctx, 9, req)
+
+		assert.ErrorIs(t, err, domain.ErrAnnouncementRouteNotFound)
+	})
+}
+
+func TestDeleteRoute(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("deletes the route", func(t *testing.T) {
+		svc, mockRepo, mockPublisher := setupServiceTest(t)
+		mockRepo.On("DeleteRoute", mock.Anything, int64(3)).Return(true, nil)
+		mockPublisher.On("PublishWithRetry", mock.Anything, mock.MatchedBy(isRoutesChanged)).Once()
+
+		require.NoError(t, svc.DeleteRoute(ctx, 3))
+	})
+
+	t.Run("unknown route", func(t *testing.T) {
+		svc, mockRepo, _ := setupServiceTest(t)
+		mockRepo.On("DeleteRoute", mock.Anything, int64(3)).Return(false, nil)
+
+		assert.ErrorIs(t, svc.DeleteRoute(ctx, 3), domain.ErrAnnouncementRouteNotFound)
+	})
+}
+
+func TestDiscordEventTypes(t *testing.T) {
+	svc, mockRepo, _ := setupServiceTest(t)
+	mockRepo.On("ListRoutes", mock.Anything).Return([]domain.AnnouncementRoute{
+		{EventType: sse.EventTypeStreamRecap, Destination: domain.AnnouncementDestinationDiscord, Enabled: true},
+		{EventType: sse.EventTypeGambleCompleted, Destination: domain.AnnouncementDestinationDiscord, Enabled: true},
+		{EventType: sse.EventTypeStreamRecap, Destination: domain.AnnouncementDestinationDiscord, Enabled: true},
+		{EventType: sse.EventTypeJobLevelUp, Destination: domain.AnnouncementDestinationDiscord, Enabled: false},
+		{EventType: sse.EventTypeChatDrop, Destination: domain.AnnouncementDestinationSSE, Enabled: true},
+	}, nil)
+
+	eventTypes, err := svc.DiscordEventTypes(context.Background())
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{sse.EventTypeGambleCompleted, sse.EventTypeStreamRecap}, eventTypes)
+}
+
+func TestEventHandler(t *testing.T) {
+	ctx := context.Background()
+	gamble := event.Event{
+		Type: event.Type(domain.EventGambleCompleted),
+		Payload: map[string]any{
+			"winner_username": "bob",
+			"total_value":     5000,
+		},
+	}
+
+	t.Run("renders each route and publishes discord and sse messages", func(t *testing.T) {
+		svc, mockRepo, mockPublisher := setupServiceTest(t)
+		mockRepo.On("ListEnabledRoutes", mock.Anything, sse.EventTypeGambleCompleted).Return([]domain.AnnouncementRoute{
+			{ID: 1, EventType: sse.EventTypeGambleCompleted, Destination: domain.AnnouncementDestinationDiscord, Target: "42", Template: "{{.Payload.winner_username}} won {{.Payload.total_value}}!"},
+			{ID: 2, EventType: sse.EventTypeGambleCompleted, Destination: domain.AnnouncementDestinationSSE, Target: "overlay.wins", Template: "{{.EventType}}"},
+		}, nil)
+		var messages []event.AnnouncementPayloadV1
+		mockPublisher.On("PublishWithRetry", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+			messages = append(messages, args.Get(1).(event.Event).Payload.(event.AnnouncementPayloadV1))
+		}).Times(2)
+
+		require.NoError(t, svc.eventHandler(sse.EventTypeGambleCompleted)(ctx, gamble))
+
+		require.Len(t, messages, 2)
+		assert.Equal(t, "bob won 5000!", messages[0].Message)
+		assert.Equal(t, "42", messages[0].Target)
+		assert.Equal(t, sse.EventTypeGambleCompleted, messages[1].Message)
+		assert.Equal(t, domain.AnnouncementDestinationSSE, messages[1].Destination)
+	})
+
+	t.Run("skips routes that render nothing or fail", func(t *testing.T) {
+		svc, mockRepo, _ := setupServiceTest(t)
+		mockRepo.On("ListEnabledRoutes", mock.Anything, sse.EventTypeGambleCompleted).Return([]domain.AnnouncementRoute{
+			{ID: 1, Destination: domain.AnnouncementDestinationDiscord, Target: "42", Template: "{{if gt .Payload.total_value 10000.0}}Huge win!{{end}}"},
+			{ID: 2, Destination: domain.AnnouncementDestinationDiscord, Target: "42", Template: "{{.Payload.winner_username.nope}}"},
+		}, nil)
+
+		require.NoError(t, svc.eventHandler(sse.EventTypeGambleCompleted)(ctx, gamble))
+	})
+
+	t.Run("posts webhook messages", func(t *testing.T) {
+		var got WebhookBody
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&got))
+			w.WriteHeader(http.StatusNoContent)
+		}))
+		defer server.Close()
+
+		svc, mockRepo, _ := setupServiceTest(t)
+		mockRepo.On("ListEnabledRoutes", mock.Anything, sse.EventTypeGambleCompleted).Return([]domain.AnnouncementRoute{
+			{ID: 3, EventType: sse.EventTypeGambleCompleted, Destination: domain.AnnouncementDestinationWebhook, Target: server.URL, Template: "{{.Payload.winner_username}} won"},
+		}, nil)
+
+		require.NoError(t, svc.eventHandler(sse.EventTypeGambleCompleted)(ctx, gamble))
+
+		assert.Equal(t, WebhookBody{EventType: sse.EventTypeGambleCompleted, Message: "bob won", Timestamp: sentAt.Unix()}, got)
+	})
+}
+
+func TestRender(t *testing.T) {
+	message, err := render("{{.EventType}}", TemplateData{EventType: strings.Repeat("é", MaxMessageLength+10)})
+
+	require.NoError(t, err)
+	assert.Len(t, []rune(message), MaxMessageLength)
+}
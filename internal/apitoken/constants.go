@@ -0,0 +1,32 @@
+package apitoken
+
+import "time"
+
+// Token format
+const (
+	// TokenPrefix starts every guild API token, so the auth middleware only
+	// looks up keys that could be tokens
+	TokenPrefix = "bbt_"
+	// TokenRandomBytes is how much randomness each token carries
+	TokenRandomBytes = 32
+	// DisplayPrefixLength is how much of a token is kept to tell tokens apart
+	DisplayPrefixLength = len(TokenPrefix) + 8
+)
+
+// Defaults, used when the configured values are not positive
+const (
+	// DefaultMaxPerGuild caps how many active tokens a guild can have
+	DefaultMaxPerGuild = 5
+	// DefaultCacheTTL is how long a token lookup is reused before the
+	// database is asked again. A token revoked on another instance keeps
+	// working there for up to this long.
+	DefaultCacheTTL = time.Minute
+)
+
+// Log messages
+const (
+	LogMsgTokenIssued  = "API token issued"
+	LogMsgTokenRevoked = "API token revoked"
+	LogMsgTouchFailed  = "Failed to record API token use"
+	LogMsgLookupFailed = "Failed to look up API token"
+)
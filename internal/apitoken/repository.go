@@ -0,0 +1,31 @@
+package apitoken
+
+import (
+	"context"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+)
+
+// Repository stores guild API tokens by the hash of the token
+type Repository interface {
+	// CreateToken stores a token under its hash and returns it with its ID
+	CreateToken(ctx context.Context, token domain.APIToken, hash string) (*domain.APIToken, error)
+
+	// GetActiveTokenByHash returns the unrevoked token with the hash, or nil
+	// if there is none
+	GetActiveTokenByHash(ctx context.Context, hash string) (*domain.APIToken, error)
+
+	// ListTokens returns a guild's tokens, or every guild's when guildID is
+	// empty, newest first and including revoked tokens
+	ListTokens(ctx context.Context, guildID string) ([]domain.APIToken, error)
+
+	// CountActiveTokens returns how many unrevoked tokens a guild has
+	CountActiveTokens(ctx context.Context, guildID string) (int, error)
+
+	// RevokeToken revokes a token and returns false if there is no
+	// unrevoked token with that ID
+	RevokeToken(ctx context.Context, id int64) (bool, error)
+
+	// TouchToken records that a token was used
+	TouchToken(ctx context.Context, id int64) error
+}
@@ -0,0 +1,195 @@
+package apitoken
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/logger"
+)
+
+// Service issues read-only API tokens to guilds and authenticates them
+type Service interface {
+	// IssueToken creates a token for a guild and returns it with the token
+	// itself, which is not stored and cannot be shown again
+	IssueToken(ctx context.Context, req IssueRequest) (*IssuedToken, error)
+
+	// ListTokens returns a guild's tokens, or every guild's when guildID is
+	// empty
+	ListTokens(ctx context.Context, guildID string) ([]domain.APIToken, error)
+
+	// RevokeToken stops a token working. It returns
+	// domain.ErrAPITokenNotFound for unknown or already revoked tokens.
+	RevokeToken(ctx context.Context, id int64) error
+
+	// Authenticate returns the active token matching a presented key, or
+	// nil if the key is not a valid token
+	Authenticate(ctx context.Context, key string) (*domain.APIToken, error)
+}
+
+// IssueRequest asks for a token for a guild
+type IssueRequest struct {
+	GuildID string
+	// Name describes what the token is for
+	Name      string
+	CreatedBy string
+}
+
+// IssuedToken is a newly issued token along with the token itself
+type IssuedToken struct {
+	domain.APIToken
+	Token string `json:"token"`
+}
+
+// Config tunes API tokens
+type Config struct {
+	// MaxPerGuild caps how many active tokens a guild can have
+	MaxPerGuild int
+	// CacheTTL is how long a token lookup is reused
+	CacheTTL time.Duration
+}
+
+type cachedLookup struct {
+	token     *domain.APIToken
+	expiresAt time.Time
+}
+
+type service struct {
+	repo Repository
+	cfg  Config
+	now  func() time.Time
+
+	mu    sync.Mutex
+	cache map[string]cachedLookup // keyed by token hash
+}
+
+// NewService creates an API token service
+func NewService(repo Repository, cfg Config) Service {
+	if cfg.MaxPerGuild <= 0 {
+		cfg.MaxPerGuild = DefaultMaxPerGuild
+	}
+	if cfg.CacheTTL <= 0 {
+		cfg.CacheTTL = DefaultCacheTTL
+	}
+	return &service{
+		repo:  repo,
+		cfg:   cfg,
+		now:   time.Now,
+		cache: make(map[string]cachedLookup),
+	}
+}
+
+// IssueToken generates a random token and stores its hash
+func (s *service) IssueToken(ctx context.Context, req IssueRequest) (*IssuedToken, error) {
+	if req.GuildID == "" || req.Name == "" || req.CreatedBy == "" {
+		return nil, fmt.Errorf("%w: guild, name and creator are required", domain.ErrInvalidInput)
+	}
+
+	active, err := s.repo.CountActiveTokens(ctx, req.GuildID)
+	if err != nil {
+		return nil, err
+	}
+	if active >= s.cfg.MaxPerGuild {
+		return nil, fmt.Errorf("%w: limit is %d", domain.ErrTooManyAPITokens, s.cfg.MaxPerGuild)
+	}
+
+	key, err := generateToken()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate API token: %w", err)
+	}
+
+	token, err := s.repo.CreateToken(ctx, domain.APIToken{
+		GuildID:   req.GuildID,
+		Name:      req.Name,
+		Prefix:    key[:DisplayPrefixLength],
+		CreatedBy: req.CreatedBy,
+	}, hashToken(key))
+	if err != nil {
+		return nil, err
+	}
+
+	logger.FromContext(ctx).Info(LogMsgTokenIssued, "id", token.ID, "guild_id", token.GuildID, "name", token.Name, "created_by", token.CreatedBy)
+	return &IssuedToken{APIToken: *token, Token: key}, nil
+}
+
+// ListTokens returns tokens without their hashes
+func (s *service) ListTokens(ctx context.Context, guildID string) ([]domain.APIToken, error) {
+	return s.repo.ListTokens(ctx, guildID)
+}
+
+// RevokeToken revokes a token and drops cached lookups so it stops working on
+// this instance straight away
+func (s *service) RevokeToken(ctx context.Context, id int64) error {
+	revoked, err := s.repo.RevokeToken(ctx, id)
+	if err != nil {
+		return err
+	}
+	if !revoked {
+		return domain.ErrAPITokenNotFound
+	}
+
+	s.mu.Lock()
+	s.cache = make(map[string]cachedLookup)
+	s.mu.Unlock()
+
+	logger.FromContext(ctx).Info(LogMsgTokenRevoked, "id", id)
+	return nil
+}
+
+// Authenticate looks a key up by its hash. Matches are cached for CacheTTL
+// and a token's last use is recorded once per lookup. Misses are not cached,
+// so random keys cannot grow the cache.
+func (s *service) Authenticate(ctx context.Context, key string) (*domain.APIToken, error) {
+	if !strings.HasPrefix(key, TokenPrefix) {
+		return nil, nil
+	}
+	hash := hashToken(key)
+	now := s.now()
+
+	s.mu.Lock()
+	cached, ok := s.cache[hash]
+	s.mu.Unlock()
+	if ok && now.Before(cached.expiresAt) {
+		return cached.token, nil
+	}
+
+	token, err := s.repo.GetActiveTokenByHash(ctx, hash)
+	if err != nil {
+		logger.FromContext(ctx).Error(LogMsgLookupFailed, "error", err)
+		return nil, err
+	}
+
+	if token == nil {
+		return nil, nil
+	}
+
+	s.mu.Lock()
+	s.cache[hash] = cachedLookup{token: token, expiresAt: now.Add(s.cfg.CacheTTL)}
+	s.mu.Unlock()
+
+	if err := s.repo.TouchToken(ctx, token.ID); err != nil {
+		logger.FromContext(ctx).Warn(LogMsgTouchFailed, "id", token.ID, "error", err)
+	}
+	return token, nil
+}
+
+// generateToken returns TokenPrefix followed by random URL-safe characters
+func generateToken() (string, error) {
+	bytes := make([]byte, TokenRandomBytes)
+	if _, err := rand.Read(bytes); err != nil {
+		return "", err
+	}
+	return TokenPrefix + base64.RawURLEncoding.EncodeToString(bytes), nil
+}
+
+func hashToken(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])
+}
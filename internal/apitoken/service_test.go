@@ -0,0 +1,127 @@
+package apitoken_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/osse101/BrandishBot_Go/internal/apitoken"
+	"github.com/osse101/BrandishBot_Go/internal/apitoken/mocks"
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+)
+
+func TestIssueToken(t *testing.T) {
+	ctx := context.Background()
+	req := apitoken.IssueRequest{GuildID: "g1", Name: "overlay", CreatedBy: "admin"}
+
+	t.Run("returns the token once and stores only its hash", func(t *testing.T) {
+		repo := mocks.NewMockRepository(t)
+		svc := apitoken.NewService(repo, apitoken.Config{MaxPerGuild: 2})
+		repo.On("CountActiveTokens", ctx, "g1").Return(1, nil)
+		var storedHash string
+		repo.On("CreateToken", ctx, mock.Anything, mock.Anything).Return(func(_ context.Context, tok domain.APIToken, hash string) (*domain.APIToken, error) {
+			storedHash = hash
+			tok.ID = 9
+			return &tok, nil
+		})
+
+		issued, err := svc.IssueToken(ctx, req)
+
+		require.NoError(t, err)
+		assert.Equal(t, int64(9), issued.ID)
+		assert.True(t, strings.HasPrefix(issued.Token, apitoken.TokenPrefix))
+		assert.True(t, strings.HasPrefix(issued.Token, issued.Prefix))
+		assert.NotEmpty(t, storedHash)
+		assert.NotContains(t, storedHash, issued.Token)
+	})
+
+	t.Run("rejects a guild at its limit", func(t *testing.T) {
+		repo := mocks.NewMockRepository(t)
+		svc := apitoken.NewService(repo, apitoken.Config{MaxPerGuild: 2})
+		repo.On("CountActiveTokens", ctx, "g1").Return(2, nil)
+
+		_, err := svc.IssueToken(ctx, req)
+
+		assert.ErrorIs(t, err, domain.ErrTooManyAPITokens)
+	})
+
+	t.Run("requires a guild, name and creator", func(t *testing.T) {
+		svc := apitoken.NewService(mocks.NewMockRepository(t), apitoken.Config{})
+
+		_, err := svc.IssueToken(ctx, apitoken.IssueRequest{GuildID: "g1"})
+
+		assert.ErrorIs(t, err, domain.ErrInvalidInput)
+	})
+}
+
+func TestAuthenticate(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("caches a match and touches it once", func(t *testing.T) {
+		repo := mocks.NewMockRepository(t)
+		svc := apitoken.NewService(repo, apitoken.Config{})
+		token := &domain.APIToken{ID: 3, GuildID: "g1"}
+		repo.On("GetActiveTokenByHash", ctx, mock.Anything).Return(token, nil).Once()
+		repo.On("TouchToken", ctx, int64(3)).Return(nil).Once()
+
+		for i := 0; i < 2; i++ {
+			got, err := svc.Authenticate(ctx, "bbt_abc")
+			require.NoError(t, err)
+			assert.Equal(t, token, got)
+		}
+	})
+
+	t.Run("does not cache a miss", func(t *testing.T) {
+		repo := mocks.NewMockRepository(t)
+		svc := apitoken.NewService(repo, apitoken.Config{})
+		repo.On("GetActiveTokenByHash", ctx, mock.Anything).Return(nil, nil).Twice()
+
+		for i := 0; i < 2; i++ {
+			got, err := svc.Authenticate(ctx, "bbt_unknown")
+			require.NoError(t, err)
+			assert.Nil(t, got)
+		}
+	})
+
+	t.Run("ignores keys without the token prefix", func(t *testing.T) {
+		svc := apitoken.NewService(mocks.NewMockRepository(t), apitoken.Config{})
+
+		got, err := svc.Authenticate(ctx, "some-api-key")
+
+		require.NoError(t, err)
+		assert.Nil(t, got)
+	})
+}
+
+func TestRevokeToken(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("drops cached lookups", func(t *testing.T) {
+		repo := mocks.NewMockRepository(t)
+		svc := apitoken.NewService(repo, apitoken.Config{})
+		repo.On("GetActiveTokenByHash", ctx, mock.Anything).Return(&domain.APIToken{ID: 3}, nil).Once()
+		repo.On("TouchToken", ctx, int64(3)).Return(nil)
+		_, err := svc.Authenticate(ctx, "bbt_abc")
+		require.NoError(t, err)
+
+		repo.On("RevokeToken", ctx, int64(3)).Return(true, nil)
+		require.NoError(t, svc.RevokeToken(ctx, 3))
+
+		repo.On("GetActiveTokenByHash", ctx, mock.Anything).Return(nil, nil).Once()
+		got, err := svc.Authenticate(ctx, "bbt_abc")
+		require.NoError(t, err)
+		assert.Nil(t, got)
+	})
+
+	t.Run("reports an unknown token", func(t *testing.T) {
+		repo := mocks.NewMockRepository(t)
+		svc := apitoken.NewService(repo, apitoken.Config{})
+		repo.On("RevokeToken", ctx, int64(5)).Return(false, nil)
+
+		assert.ErrorIs(t, svc.RevokeToken(ctx, 5), domain.ErrAPITokenNotFound)
+	})
+}
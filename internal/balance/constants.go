@@ -0,0 +1,21 @@
+package balance
+
+// JobType identifies balance apply runs in the worker pool and scheduler
+const JobType = "balance_apply"
+
+// Changelog limits
+const (
+	// DefaultChangelogLimit is how many changes the changelog shows when the
+	// caller does not say
+	DefaultChangelogLimit = 50
+	// MaxChangelogLimit caps how many changes one changelog request returns
+	MaxChangelogLimit = 200
+)
+
+// Log messages
+const (
+	LogMsgChangeScheduled = "Balance change scheduled"
+	LogMsgChangeCancelled = "Balance change cancelled"
+	LogMsgChangesApplied  = "Applied balance changes"
+	LogMsgApplyError      = "Failed to apply balance change"
+)
@@ -0,0 +1,24 @@
+package balance
+
+import "context"
+
+// Job applies balance changes that have taken effect
+type Job struct {
+	service Service
+}
+
+// NewJob creates a balance apply job
+func NewJob(service Service) *Job {
+	return &Job{service: service}
+}
+
+// JobType returns the worker pool job type
+func (j *Job) JobType() string {
+	return JobType
+}
+
+// Process brings item values up to date with the changes in force
+func (j *Job) Process(ctx context.Context) error {
+	_, err := j.service.ApplyDue(ctx)
+	return err
+}
@@ -0,0 +1,34 @@
+package balance
+
+import (
+	"context"
+	"time"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+)
+
+// Repository stores scheduled item balance changes
+type Repository interface {
+	// CreateChange stores a change and returns it with its ID
+	CreateChange(ctx context.Context, change domain.ItemBalanceChange) (*domain.ItemBalanceChange, error)
+
+	// DeleteUpcomingChange removes a change that has not taken effect by now
+	// and returns false if there is no such change
+	DeleteUpcomingChange(ctx context.Context, id int64, now time.Time) (bool, error)
+
+	// GetChanges returns up to limit changes to an item, or to every item
+	// when itemName is empty, newest effective time first
+	GetChanges(ctx context.Context, itemName string, limit int) ([]domain.ItemBalanceChange, error)
+
+	// GetEffectiveChanges returns, for each item and pool, the latest change
+	// to the field that took effect at or before the given time
+	GetEffectiveChanges(ctx context.Context, field domain.BalanceField, at time.Time) ([]domain.ItemBalanceChange, error)
+
+	// GetChangesEffectiveBetween returns changes that took effect after from
+	// and at or before to, oldest first
+	GetChangesEffectiveBetween(ctx context.Context, from, to time.Time) ([]domain.ItemBalanceChange, error)
+
+	// SetItemBaseValue writes an item's base value and reports whether it
+	// changed
+	SetItemBaseValue(ctx context.Context, itemName string, value int) (bool, error)
+}
@@ -0,0 +1,258 @@
+package balance
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/event"
+	"github.com/osse101/BrandishBot_Go/internal/logger"
+)
+
+// Service schedules item balance changes and applies them once they take
+// effect, keeping every change for the public changelog
+type Service interface {
+	// ScheduleChange stores a change to an item's base value or loot weight.
+	// A change without an effective time takes effect immediately.
+	ScheduleChange(ctx context.Context, req ScheduleRequest) (*domain.ItemBalanceChange, error)
+
+	// CancelChange removes a change that has not taken effect yet. It
+	// returns domain.ErrBalanceChangeNotFound for unknown or past changes.
+	CancelChange(ctx context.Context, id int64) error
+
+	// GetChangelog returns up to limit changes to an item, or to every item
+	// when itemName is empty, newest first and marked upcoming, active or
+	// superseded
+	GetChangelog(ctx context.Context, itemName string, limit int) ([]domain.ItemBalanceChange, error)
+
+	// ApplyDue writes the base values in force to the items table and
+	// publishes an item.balance_changed event for changes that took effect
+	// since the last run. It returns how many items were updated.
+	ApplyDue(ctx context.Context) (int, error)
+
+	// EffectiveLootWeights returns the loot weights in force, keyed by pool
+	// name and then item name
+	EffectiveLootWeights(ctx context.Context) (map[string]map[string]int, error)
+}
+
+// ScheduleRequest asks for a balance change
+type ScheduleRequest struct {
+	ItemName string
+	Field    domain.BalanceField
+	// Pool is the loot pool for loot weight changes
+	Pool  string
+	Value int
+	// EffectiveAt is when the change takes effect; zero means now
+	EffectiveAt time.Time
+	Reason      string
+	CreatedBy   string
+}
+
+// ItemLookup finds items by name
+type ItemLookup interface {
+	GetItemByName(ctx context.Context, itemName string) (*domain.Item, error)
+}
+
+// Publisher publishes balance events
+type Publisher interface {
+	PublishWithRetry(ctx context.Context, evt event.Event)
+}
+
+type service struct {
+	repo      Repository
+	items     ItemLookup
+	publisher Publisher
+	now       func() time.Time
+
+	// applyMu serialises apply runs; lastApplied is when the last one ran
+	applyMu     sync.Mutex
+	lastApplied time.Time
+}
+
+// NewService creates a balance service. Changes that took effect before the
+// service started are applied by the first ApplyDue without an event.
+func NewService(repo Repository, items ItemLookup, publisher Publisher) Service {
+	return &service{
+		repo:        repo,
+		items:       items,
+		publisher:   publisher,
+		now:         time.Now,
+		lastApplied: time.Now(),
+	}
+}
+
+// ScheduleChange validates and stores a balance change, applying it straight
+// away when it is already in effect
+func (s *service) ScheduleChange(ctx context.Context, req ScheduleRequest) (*domain.ItemBalanceChange, error) {
+	if err := validateRequest(req); err != nil {
+		return nil, err
+	}
+
+	now := s.now()
+	effectiveAt := req.EffectiveAt
+	if effectiveAt.IsZero() {
+		effectiveAt = now
+	} else if effectiveAt.Before(now) {
+		return nil, fmt.Errorf("%w: effective time is in the past", domain.ErrInvalidInput)
+	}
+
+	item, err := s.items.GetItemByName(ctx, req.ItemName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get item: %w", err)
+	}
+	if item == nil {
+		return nil, fmt.Errorf("%w: %s", domain.ErrItemNotFound, req.ItemName)
+	}
+
+	change, err := s.repo.CreateChange(ctx, domain.ItemBalanceChange{
+		ItemName:    item.InternalName,
+		Field:       req.Field,
+		Pool:        req.Pool,
+		Value:       req.Value,
+		EffectiveAt: effectiveAt,
+		Reason:      req.Reason,
+		CreatedBy:   req.CreatedBy,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	logger.FromContext(ctx).Info(LogMsgChangeScheduled,
+		"id", change.ID, "item", change.ItemName, "field", change.Field, "pool", change.Pool,
+		"value", change.Value, "effective_at", change.EffectiveAt, "created_by", change.CreatedBy)
+
+	if !effectiveAt.After(now) {
+		if _, err := s.ApplyDue(ctx); err != nil {
+			return nil, fmt.Errorf("failed to apply balance change: %w", err)
+		}
+		change.Status = domain.BalanceChangeActive
+	} else {
+		change.Status = domain.BalanceChangeUpcoming
+	}
+	return change, nil
+}
+
+func validateRequest(req ScheduleRequest) error {
+	if req.ItemName == "" {
+		return fmt.Errorf("%w: item name is required", domain.ErrInvalidInput)
+	}
+	if !req.Field.IsValid() {
+		return fmt.Errorf("%w: unknown balance field %q", domain.ErrInvalidInput, req.Field)
+	}
+	if req.Value < 0 {
+		return fmt.Errorf("%w: value must not be negative", domain.ErrInvalidInput)
+	}
+	if (req.Field == domain.BalanceFieldLootWeight) != (req.Pool != "") {
+		return fmt.Errorf("%w: a pool is required for loot weights and only for loot weights", domain.ErrInvalidInput)
+	}
+	return nil
+}
+
+// CancelChange removes an upcoming change
+func (s *service) CancelChange(ctx context.Context, id int64) error {
+	deleted, err := s.repo.DeleteUpcomingChange(ctx, id, s.now())
+	if err != nil {
+		return err
+	}
+	if !deleted {
+		return domain.ErrBalanceChangeNotFound
+	}
+	logger.FromContext(ctx).Info(LogMsgChangeCancelled, "id", id)
+	return nil
+}
+
+// GetChangelog lists changes and works out where each stands. The list is
+// newest first, so the first change already in effect for an item, field and
+// pool is the active one and every older change is superseded.
+func (s *service) GetChangelog(ctx context.Context, itemName string, limit int) ([]domain.ItemBalanceChange, error) {
+	if limit <= 0 || limit > MaxChangelogLimit {
+		limit = DefaultChangelogLimit
+	}
+	changes, err := s.repo.GetChanges(ctx, itemName, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	type target struct {
+		item  string
+		field domain.BalanceField
+		pool  string
+	}
+	now := s.now()
+	active := make(map[target]bool)
+	for i := range changes {
+		change := &changes[i]
+		key := target{item: change.ItemName, field: change.Field, pool: change.Pool}
+		switch {
+		case change.EffectiveAt.After(now):
+			change.Status = domain.BalanceChangeUpcoming
+		case active[key]:
+			change.Status = domain.BalanceChangeSuperseded
+		default:
+			change.Status = domain.BalanceChangeActive
+			active[key] = true
+		}
+	}
+	return changes, nil
+}
+
+// ApplyDue writes the base values in force. Base values are written every run
+// rather than only for new changes, so a run that failed part way or a
+// restart catches up. A failed item is logged and retried on the next run.
+func (s *service) ApplyDue(ctx context.Context) (int, error) {
+	s.applyMu.Lock()
+	defer s.applyMu.Unlock()
+
+	log := logger.FromContext(ctx)
+	now := s.now()
+
+	effective, err := s.repo.GetEffectiveChanges(ctx, domain.BalanceFieldBaseValue, now)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get effective balance changes: %w", err)
+	}
+
+	updated := 0
+	for _, change := range effective {
+		changed, err := s.repo.SetItemBaseValue(ctx, change.ItemName, change.Value)
+		if err != nil {
+			log.Error(LogMsgApplyError, "id", change.ID, "item", change.ItemName, "error", err)
+			continue
+		}
+		if changed {
+			updated++
+		}
+	}
+
+	took, err := s.repo.GetChangesEffectiveBetween(ctx, s.lastApplied, now)
+	if err != nil {
+		return updated, fmt.Errorf("failed to get new balance changes: %w", err)
+	}
+	s.lastApplied = now
+
+	if len(took) > 0 {
+		log.Info(LogMsgChangesApplied, "changes", len(took), "items_updated", updated)
+		if s.publisher != nil {
+			s.publisher.PublishWithRetry(ctx, event.NewItemBalanceChangedEvent(took))
+		}
+	}
+	return updated, nil
+}
+
+// EffectiveLootWeights groups the loot weights in force by pool
+func (s *service) EffectiveLootWeights(ctx context.Context) (map[string]map[string]int, error) {
+	changes, err := s.repo.GetEffectiveChanges(ctx, domain.BalanceFieldLootWeight, s.now())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get effective loot weights: %w", err)
+	}
+
+	weights := make(map[string]map[string]int)
+	for _, change := range changes {
+		if weights[change.Pool] == nil {
+			weights[change.Pool] = make(map[string]int)
+		}
+		weights[change.Pool][change.ItemName] = change.Value
+	}
+	return weights, nil
+}
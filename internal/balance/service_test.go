@@ -0,0 +1,224 @@
+package balance_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/osse101/BrandishBot_Go/internal/balance"
+	"github.com/osse101/BrandishBot_Go/internal/balance/mocks"
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/event"
+)
+
+func expectCreate(mockRepo *mocks.MockRepository) {
+	mockRepo.On("CreateChange", mock.Anything, mock.Anything).Return(func(_ context.Context, c domain.ItemBalanceChange) (*domain.ItemBalanceChange, error) {
+		c.ID = 4
+		return &c, nil
+	})
+}
+
+func TestScheduleChange(t *testing.T) {
+	ctx := context.Background()
+	sword := &domain.Item{ID: 7, InternalName: "sword"}
+
+	t.Run("stores a future change without applying it", func(t *testing.T) {
+		mockRepo := mocks.NewMockRepository(t)
+		mockItems := mocks.NewMockItemLookup(t)
+		mockPublisher := mocks.NewMockPublisher(t)
+		svc := balance.NewService(mockRepo, mockItems, mockPublisher)
+
+		mockItems.On("GetItemByName", ctx, "sword").Return(sword, nil)
+		expectCreate(mockRepo)
+		at := time.Now().Add(time.Hour)
+
+		got, err := svc.ScheduleChange(ctx, balance.ScheduleRequest{
+			ItemName: "sword", Field: domain.BalanceFieldBaseValue, Value: 120, EffectiveAt: at, CreatedBy: "admin",
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, int64(4), got.ID)
+		assert.Equal(t, domain.BalanceChangeUpcoming, got.Status)
+		assert.Equal(t, at, got.EffectiveAt)
+	})
+
+	t.Run("an immediate change is applied and announced", func(t *testing.T) {
+		mockRepo := mocks.NewMockRepository(t)
+		mockItems := mocks.NewMockItemLookup(t)
+		mockPublisher := mocks.NewMockPublisher(t)
+		svc := balance.NewService(mockRepo, mockItems, mockPublisher)
+
+		mockItems.On("GetItemByName", ctx, "sword").Return(sword, nil)
+		expectCreate(mockRepo)
+		applied := domain.ItemBalanceChange{ID: 4, ItemName: "sword", Field: domain.BalanceFieldBaseValue, Value: 120, EffectiveAt: time.Now()}
+		mockRepo.On("GetEffectiveChanges", ctx, domain.BalanceFieldBaseValue, mock.Anything).Return([]domain.ItemBalanceChange{applied}, nil)
+		mockRepo.On("SetItemBaseValue", ctx, "sword", 120).Return(true, nil)
+		mockRepo.On("GetChangesEffectiveBetween", ctx, mock.Anything, mock.Anything).Return([]domain.ItemBalanceChange{applied}, nil)
+		mockPublisher.On("PublishWithRetry", ctx, mock.MatchedBy(func(evt event.Event) bool {
+			payload := evt.Payload.(event.ItemBalanceChangedPayloadV1)
+			return evt.Type == event.ItemBalanceChanged && len(payload.Changes) == 1 && payload.Changes[0].Value == 120
+		}))
+
+		got, err := svc.ScheduleChange(ctx, balance.ScheduleRequest{
+			ItemName: "sword", Field: domain.BalanceFieldBaseValue, Value: 120, CreatedBy: "admin",
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, domain.BalanceChangeActive, got.Status)
+	})
+
+	t.Run("rejects invalid requests", func(t *testing.T) {
+		tests := []struct {
+			name string
+			req  balance.ScheduleRequest
+		}{
+			{"unknown field", balance.ScheduleRequest{ItemName: "sword", Field: "price", Value: 1}},
+			{"negative value", balance.ScheduleRequest{ItemName: "sword", Field: domain.BalanceFieldBaseValue, Value: -1}},
+			{"loot weight without pool", balance.ScheduleRequest{ItemName: "sword", Field: domain.BalanceFieldLootWeight, Value: 5}},
+			{"base value with pool", balance.ScheduleRequest{ItemName: "sword", Field: domain.BalanceFieldBaseValue, Pool: "common", Value: 5}},
+			{"past effective time", balance.ScheduleRequest{ItemName: "sword", Field: domain.BalanceFieldBaseValue, Value: 5, EffectiveAt: time.Now().Add(-time.Hour)}},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				mockRepo := mocks.NewMockRepository(t)
+				mockItems := mocks.NewMockItemLookup(t)
+				mockPublisher := mocks.NewMockPublisher(t)
+				svc := balance.NewService(mockRepo, mockItems, mockPublisher)
+
+				_, err := svc.ScheduleChange(ctx, tt.req)
+
+				assert.ErrorIs(t, err, domain.ErrInvalidInput)
+			})
+		}
+	})
+
+	t.Run("rejects unknown items", func(t *testing.T) {
+		mockRepo := mocks.NewMockRepository(t)
+		mockItems := mocks.NewMockItemLookup(t)
+		mockPublisher := mocks.NewMockPublisher(t)
+		svc := balance.NewService(mockRepo, mockItems, mockPublisher)
+
+		mockItems.On("GetItemByName", ctx, "nope").Return(nil, nil)
+
+		_, err := svc.ScheduleChange(ctx, balance.ScheduleRequest{
+			ItemName: "nope", Field: domain.BalanceFieldBaseValue, Value: 1, EffectiveAt: time.Now().Add(time.Hour),
+		})
+
+		assert.ErrorIs(t, err, domain.ErrItemNotFound)
+	})
+}
+
+func TestCancelChange(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("removes an upcoming change", func(t *testing.T) {
+		mockRepo := mocks.NewMockRepository(t)
+		mockItems := mocks.NewMockItemLookup(t)
+		mockPublisher := mocks.NewMockPublisher(t)
+		svc := balance.NewService(mockRepo, mockItems, mockPublisher)
+
+		mockRepo.On("DeleteUpcomingChange", ctx, int64(4), mock.Anything).Return(true, nil)
+
+		assert.NoError(t, svc.CancelChange(ctx, 4))
+	})
+
+	t.Run("changes in effect cannot be cancelled", func(t *testing.T) {
+		mockRepo := mocks.NewMockRepository(t)
+		mockItems := mocks.NewMockItemLookup(t)
+		mockPublisher := mocks.NewMockPublisher(t)
+		svc := balance.NewService(mockRepo, mockItems, mockPublisher)
+
+		mockRepo.On("DeleteUpcomingChange", ctx, int64(4), mock.Anything).Return(false, nil)
+
+		assert.ErrorIs(t, svc.CancelChange(ctx, 4), domain.ErrBalanceChangeNotFound)
+	})
+}
+
+func TestGetChangelog_Statuses(t *testing.T) {
+	ctx := context.Background()
+	mockRepo := mocks.NewMockRepository(t)
+	mockItems := mocks.NewMockItemLookup(t)
+	mockPublisher := mocks.NewMockPublisher(t)
+	svc := balance.NewService(mockRepo, mockItems, mockPublisher)
+
+	now := time.Now()
+	change := func(id int64, pool string, offset time.Duration) domain.ItemBalanceChange {
+		field := domain.BalanceFieldBaseValue
+		if pool != "" {
+			field = domain.BalanceFieldLootWeight
+		}
+		return domain.ItemBalanceChange{ID: id, ItemName: "sword", Field: field, Pool: pool, EffectiveAt: now.Add(offset)}
+	}
+	mockRepo.On("GetChanges", ctx, "sword", balance.DefaultChangelogLimit).Return([]domain.ItemBalanceChange{
+		change(5, "", time.Hour),
+		change(4, "", -time.Hour),
+		change(3, "common", -2*time.Hour),
+		change(2, "", -3*time.Hour),
+	}, nil)
+
+	got, err := svc.GetChangelog(ctx, "sword", balance.MaxChangelogLimit+1)
+
+	require.NoError(t, err)
+	statuses := make([]domain.BalanceChangeStatus, 0, len(got))
+	for _, c := range got {
+		statuses = append(statuses, c.Status)
+	}
+	assert.Equal(t, []domain.BalanceChangeStatus{
+		domain.BalanceChangeUpcoming,
+		domain.BalanceChangeActive,
+		domain.BalanceChangeActive,
+		domain.BalanceChangeSuperseded,
+	}, statuses)
+}
+
+func TestApplyDue(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("a failed item does not stop the run", func(t *testing.T) {
+		mockRepo := mocks.NewMockRepository(t)
+		mockItems := mocks.NewMockItemLookup(t)
+		mockPublisher := mocks.NewMockPublisher(t)
+		svc := balance.NewService(mockRepo, mockItems, mockPublisher)
+
+		mockRepo.On("GetEffectiveChanges", ctx, domain.BalanceFieldBaseValue, mock.Anything).Return([]domain.ItemBalanceChange{
+			{ID: 1, ItemName: "sword", Value: 10},
+			{ID: 2, ItemName: "shield", Value: 20},
+			{ID: 3, ItemName: "bow", Value: 30},
+		}, nil)
+		mockRepo.On("SetItemBaseValue", ctx, "sword", 10).Return(false, assert.AnError)
+		mockRepo.On("SetItemBaseValue", ctx, "shield", 20).Return(true, nil)
+		mockRepo.On("SetItemBaseValue", ctx, "bow", 30).Return(false, nil)
+		mockRepo.On("GetChangesEffectiveBetween", ctx, mock.Anything, mock.Anything).Return(nil, nil)
+
+		n, err := svc.ApplyDue(ctx)
+
+		require.NoError(t, err)
+		assert.Equal(t, 1, n)
+	})
+}
+
+func TestEffectiveLootWeights(t *testing.T) {
+	ctx := context.Background()
+	mockRepo := mocks.NewMockRepository(t)
+	mockItems := mocks.NewMockItemLookup(t)
+	mockPublisher := mocks.NewMockPublisher(t)
+	svc := balance.NewService(mockRepo, mockItems, mockPublisher)
+
+	mockRepo.On("GetEffectiveChanges", ctx, domain.BalanceFieldLootWeight, mock.Anything).Return([]domain.ItemBalanceChange{
+		{ItemName: "sword", Pool: "common", Value: 5},
+		{ItemName: "shield", Pool: "common", Value: 0},
+		{ItemName: "sword", Pool: "rare", Value: 2},
+	}, nil)
+
+	weights, err := svc.EffectiveLootWeights(ctx)
+
+	require.NoError(t, err)
+	assert.Equal(t, map[string]map[string]int{
+		"common": {"sword": 5, "shield": 0},
+		"rare":   {"sword": 2},
+	}, weights)
+}
@@ -0,0 +1,54 @@
+package bank
+
+import (
+	"math"
+	"time"
+)
+
+// featureBank is the progression node unlocking deposits and savings goals.
+// It mirrors progression.FeatureBank, which this package cannot import
+// because the postgres repositories depend on it.
+const featureBank = "feature_bank"
+
+// JobType identifies interest payouts in the worker pool and scheduler
+const JobType = "bank_interest"
+
+// Defaults, used when the configured values are out of range
+const (
+	// DefaultInterestRate is the share of a balance paid per payout
+	DefaultInterestRate = 0.01
+	// DefaultInterestCron pays interest at midnight UTC
+	DefaultInterestCron = "0 0 * * *"
+)
+
+// MinPayoutGap keeps a retried or doubled payout run from paying an account
+// twice; an account paid this recently is skipped
+const MinPayoutGap = time.Hour
+
+// GoalCooldown is how long after setting a savings goal it can be replaced
+// by another. Clearing a goal is always allowed but does not reset the wait.
+const GoalCooldown = 24 * time.Hour
+
+// noInterestCap stands in for a cap of 0, which pays interest uncapped
+const noInterestCap = math.MaxInt64
+
+// Error messages
+const (
+	ErrMsgCheckFeatureFailed = "failed to check bank feature: %w"
+	ErrMsgGetAccountFailed   = "failed to get bank account: %w"
+	ErrMsgDepositFailed      = "failed to deposit: %w"
+	ErrMsgWithdrawFailed     = "failed to withdraw: %w"
+	ErrMsgSetGoalFailed      = "failed to set savings goal: %w"
+	ErrMsgCompleteGoalFailed = "failed to pay savings goal bonus: %w"
+	ErrMsgPayInterestFailed  = "failed to pay interest: %w"
+)
+
+// Log messages
+const (
+	LogMsgDeposited       = "User deposited money in the bank"
+	LogMsgWithdrew        = "User withdrew money from the bank"
+	LogMsgGoalSet         = "User set a savings goal"
+	LogMsgGoalReached     = "User reached their savings goal"
+	LogMsgInterestPaid    = "Paid bank interest"
+	LogMsgGoalPayoutError = "Failed to pay savings goal bonus after interest"
+)
@@ -0,0 +1,24 @@
+package bank
+
+import "context"
+
+// Job pays interest on bank balances
+type Job struct {
+	service Service
+}
+
+// NewJob creates a bank interest job
+func NewJob(service Service) *Job {
+	return &Job{service: service}
+}
+
+// JobType returns the worker pool job type
+func (j *Job) JobType() string {
+	return JobType
+}
+
+// Process pays one round of interest
+func (j *Job) Process(ctx context.Context) error {
+	_, err := j.service.PayInterest(ctx)
+	return err
+}
@@ -0,0 +1,49 @@
+package bank
+
+import (
+	"context"
+	"time"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/repository"
+)
+
+// Repository stores users' bank accounts
+type Repository interface {
+	BeginTx(ctx context.Context) (Tx, error)
+
+	// GetInventory reads a user's inventory without locking it
+	GetInventory(ctx context.Context, userID string) (*domain.Inventory, error)
+
+	// GetAccount returns the user's account, or nil if they have never
+	// used the bank
+	GetAccount(ctx context.Context, userID string) (*domain.BankAccount, error)
+
+	// ApplyInterest pays rate of each balance, at most maxInterest, to every
+	// account not paid since paidBefore, and returns the accounts it paid
+	ApplyInterest(ctx context.Context, rate float64, maxInterest int64, paidBefore, paidAt time.Time) ([]domain.BankInterest, error)
+}
+
+// Tx moves money between a user's inventory and their account in one
+// transaction
+type Tx interface {
+	repository.Tx
+	repository.InventoryAdjuster
+
+	// LockAccount opens an account for the user if they have none and
+	// locks it
+	LockAccount(ctx context.Context, userID string) (*domain.BankAccount, error)
+
+	Deposit(ctx context.Context, userID string, amount int64) (*domain.BankAccount, error)
+
+	// Withdraw takes amount off the balance and counts it against the
+	// user's withdrawals on the UTC day today
+	Withdraw(ctx context.Context, userID string, amount int64, today time.Time) (*domain.BankAccount, error)
+
+	// SetGoal sets the savings goal, recording setAt; a goal of 0 clears it
+	// and leaves the time the last goal was set
+	SetGoal(ctx context.Context, userID string, goal int64, setAt time.Time) (*domain.BankAccount, error)
+
+	// CompleteGoal clears the reached goal and pays bonus onto the balance
+	CompleteGoal(ctx context.Context, userID string, bonus int64) (*domain.BankAccount, error)
+}
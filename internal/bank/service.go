@@ -0,0 +1,394 @@
+// Package bank keeps users' savings. Money deposited from a user's inventory
+// earns interest, paid by a scheduled job and capped per payout, and can be
+// withdrawn up to a daily limit. A user can set a savings goal; the deposit
+// or payout that reaches it pays a bonus on what was saved toward it.
+package bank
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/logger"
+	"github.com/osse101/BrandishBot_Go/internal/repository"
+)
+
+// Service runs the bank
+type Service interface {
+	// GetAccount returns the user's account. A user who has never used the
+	// bank gets an empty one.
+	GetAccount(ctx context.Context, platform, platformID string) (*domain.BankAccount, error)
+
+	// Deposit moves money from the user's inventory into their account
+	Deposit(ctx context.Context, platform, platformID, username string, amount int64) (*domain.BankTransaction, error)
+
+	// Withdraw moves money from the user's account back into their
+	// inventory, up to the daily withdrawal limit
+	Withdraw(ctx context.Context, platform, platformID, username string, amount int64) (*domain.BankTransaction, error)
+
+	// SetGoal sets a savings goal above the user's balance, or clears it
+	// when goal is 0
+	SetGoal(ctx context.Context, platform, platformID, username string, goal int64) (*domain.BankAccount, error)
+
+	// PayInterest pays interest to every account with money in it and
+	// reports how many were paid
+	PayInterest(ctx context.Context) (int, error)
+}
+
+// UserService defines the user operations needed by the bank
+type UserService interface {
+	GetUserOrRegister(ctx context.Context, platform, platformID, username string) (*domain.User, error)
+	GetUserIDByPlatformID(ctx context.Context, platform, platformID string) (string, error)
+}
+
+// ItemLookup finds items by name
+type ItemLookup interface {
+	GetItemByName(ctx context.Context, itemName string) (*domain.Item, error)
+}
+
+// FeatureChecker reports whether the bank has been unlocked
+type FeatureChecker interface {
+	IsFeatureUnlocked(ctx context.Context, featureKey string) (bool, error)
+}
+
+// Config tunes the bank
+type Config struct {
+	// InterestRate is the share of a balance paid per payout, 0 to pay none
+	InterestRate float64
+	// InterestCap is the most one account earns per payout, 0 for no cap
+	InterestCap int64
+	// WithdrawLimit is the most a user can withdraw per UTC day, 0 for no
+	// limit
+	WithdrawLimit int64
+	// GoalBonusPercent is the share of what was saved toward a goal, from
+	// the balance it was set at, paid as a bonus when it is reached. The
+	// bonus is capped at InterestCap.
+	GoalBonusPercent int
+}
+
+type service struct {
+	repo     Repository
+	users    UserService
+	items    ItemLookup
+	features FeatureChecker
+	cfg      Config
+	now      func() time.Time
+}
+
+// NewService creates a bank service
+func NewService(repo Repository, users UserService, items ItemLookup, features FeatureChecker, cfg Config) Service {
+	if cfg.InterestRate < 0 || cfg.InterestRate > 1 {
+		cfg.InterestRate = DefaultInterestRate
+	}
+	if cfg.InterestCap < 0 {
+		cfg.InterestCap = 0
+	}
+	if cfg.WithdrawLimit < 0 {
+		cfg.WithdrawLimit = 0
+	}
+	if cfg.GoalBonusPercent < 0 {
+		cfg.GoalBonusPercent = 0
+	}
+	return &service{
+		repo:     repo,
+		users:    users,
+		items:    items,
+		features: features,
+		cfg:      cfg,
+		now:      time.Now,
+	}
+}
+
+func (s *service) GetAccount(ctx context.Context, platform, platformID string) (*domain.BankAccount, error) {
+	userID, err := repository.RegisteredUserID(ctx, s.users, platform, platformID)
+	if err != nil {
+		return nil, err
+	}
+
+	account, err := s.repo.GetAccount(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf(ErrMsgGetAccountFailed, err)
+	}
+	if account == nil {
+		account = &domain.BankAccount{UserID: userID}
+	}
+	return s.present(account), nil
+}
+
+// Deposit locks the account before touching the inventory, in the same
+// order as Withdraw, so the two cannot deadlock
+func (s *service) Deposit(ctx context.Context, platform, platformID, username string, amount int64) (*domain.BankTransaction, error) {
+	if amount <= 0 || amount > domain.MaxTransactionQuantity {
+		return nil, fmt.Errorf("%w: amount must be between 1 and %d", domain.ErrInvalidInput, domain.MaxTransactionQuantity)
+	}
+	if err := s.checkFeature(ctx); err != nil {
+		return nil, err
+	}
+
+	user, err := s.users.GetUserOrRegister(ctx, platform, platformID, username)
+	if err != nil {
+		return nil, err
+	}
+	money, err := repository.MoneyItem(ctx, s.items)
+	if err != nil {
+		return nil, err
+	}
+	quality, err := repository.PaymentSlot(ctx, s.repo, user.ID, money.ID, amount)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := s.repo.BeginTx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer repository.SafeRollback(ctx, tx)
+
+	if _, err := tx.LockAccount(ctx, user.ID); err != nil {
+		return nil, fmt.Errorf(ErrMsgDepositFailed, err)
+	}
+	if _, err := tx.AdjustItemQuantity(ctx, user.ID, money.ID, quality, -int(amount)); err != nil {
+		if errors.Is(err, domain.ErrInsufficientQuantity) {
+			return nil, fmt.Errorf("%w: have less than %d", domain.ErrInsufficientFunds, amount)
+		}
+		return nil, fmt.Errorf("failed to update inventory: %w", err)
+	}
+	account, err := tx.Deposit(ctx, user.ID, amount)
+	if err != nil {
+		return nil, fmt.Errorf(ErrMsgDepositFailed, err)
+	}
+	result := &domain.BankTransaction{Amount: amount}
+	if account, result.GoalBonus, err = s.completeGoal(ctx, tx, account); err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	log := logger.FromContext(ctx)
+	log.Info(LogMsgDeposited, "user_id", user.ID, "amount", amount, "balance", account.Balance)
+	if result.GoalBonus > 0 {
+		log.Info(LogMsgGoalReached, "user_id", user.ID, "bonus", result.GoalBonus)
+	}
+	result.Account = *s.present(account)
+	return result, nil
+}
+
+// Withdraw is not gated on the feature, so money is never stuck in the bank
+// if it is locked again
+func (s *service) Withdraw(ctx context.Context, platform, platformID, username string, amount int64) (*domain.BankTransaction, error) {
+	if amount <= 0 || amount > domain.MaxTransactionQuantity {
+		return nil, fmt.Errorf("%w: amount must be between 1 and %d", domain.ErrInvalidInput, domain.MaxTransactionQuantity)
+	}
+
+	user, err := s.users.GetUserOrRegister(ctx, platform, platformID, username)
+	if err != nil {
+		return nil, err
+	}
+	money, err := repository.MoneyItem(ctx, s.items)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := s.repo.BeginTx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer repository.SafeRollback(ctx, tx)
+
+	account, err := tx.LockAccount(ctx, user.ID)
+	if err != nil {
+		return nil, fmt.Errorf(ErrMsgWithdrawFailed, err)
+	}
+	if account.Balance < amount {
+		return nil, fmt.Errorf("%w: the bank holds %d", domain.ErrInsufficientFunds, account.Balance)
+	}
+	today := s.today()
+	if s.cfg.WithdrawLimit > 0 {
+		if left := s.cfg.WithdrawLimit - s.withdrawnToday(account, today); amount > left {
+			return nil, fmt.Errorf("%w: %d more can be withdrawn today", domain.ErrBankWithdrawLimit, max(left, 0))
+		}
+	}
+
+	if account, err = tx.Withdraw(ctx, user.ID, amount, today); err != nil {
+		return nil, fmt.Errorf(ErrMsgWithdrawFailed, err)
+	}
+	if _, err := tx.AdjustItemQuantity(ctx, user.ID, money.ID, domain.QualityCommon, int(amount)); err != nil {
+		return nil, fmt.Errorf("failed to update inventory: %w", err)
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	logger.FromContext(ctx).Info(LogMsgWithdrew, "user_id", user.ID, "amount", amount, "balance", account.Balance)
+	return &domain.BankTransaction{Account: *s.present(account), Amount: amount}, nil
+}
+
+// SetGoal only accepts goals above the balance, and a new goal only once
+// GoalCooldown has passed since the last. The bonus is paid on the distance
+// from the balance to the goal and capped at InterestCap; a single deposit
+// that covers the distance earns all of it, so the cooldown is what limits
+// how often the bonus can be collected.
+func (s *service) SetGoal(ctx context.Context, platform, platformID, username string, goal int64) (*domain.BankAccount, error) {
+	if goal < 0 {
+		return nil, fmt.Errorf("%w: goal cannot be negative", domain.ErrInvalidInput)
+	}
+	if err := s.checkFeature(ctx); err != nil {
+		return nil, err
+	}
+
+	user, err := s.users.GetUserOrRegister(ctx, platform, platformID, username)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := s.repo.BeginTx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer repository.SafeRollback(ctx, tx)
+
+	account, err := tx.LockAccount(ctx, user.ID)
+	if err != nil {
+		return nil, fmt.Errorf(ErrMsgSetGoalFailed, err)
+	}
+	now := s.now()
+	if goal > 0 {
+		if goal <= account.Balance {
+			return nil, fmt.Errorf("%w: goal must be above your balance of %d", domain.ErrInvalidInput, account.Balance)
+		}
+		if account.GoalSetAt != nil {
+			if wait := account.GoalSetAt.Add(GoalCooldown).Sub(now); wait > 0 {
+				return nil, fmt.Errorf("%w: try again in %s", domain.ErrBankGoalCooldown, wait.Round(time.Minute))
+			}
+		}
+	}
+	if account, err = tx.SetGoal(ctx, user.ID, goal, now); err != nil {
+		return nil, fmt.Errorf(ErrMsgSetGoalFailed, err)
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	logger.FromContext(ctx).Info(LogMsgGoalSet, "user_id", user.ID, "goal", goal)
+	return s.present(account), nil
+}
+
+// PayInterest pays every account in one statement, then pays the bonus of
+// any goal the interest reached. A bonus that fails is logged and paid by a
+// later deposit or payout, since the goal stays reached.
+func (s *service) PayInterest(ctx context.Context) (int, error) {
+	if s.cfg.InterestRate <= 0 {
+		return 0, nil
+	}
+	log := logger.FromContext(ctx)
+
+	maxInterest := s.cfg.InterestCap
+	if maxInterest == 0 {
+		maxInterest = noInterestCap
+	}
+	now := s.now()
+	paid, err := s.repo.ApplyInterest(ctx, s.cfg.InterestRate, maxInterest, now.Add(-MinPayoutGap), now)
+	if err != nil {
+		return 0, fmt.Errorf(ErrMsgPayInterestFailed, err)
+	}
+
+	for _, p := range paid {
+		if p.Goal == 0 || p.Balance < p.Goal {
+			continue
+		}
+		if err := s.payGoalBonus(ctx, p.UserID); err != nil {
+			log.Error(LogMsgGoalPayoutError, "user_id", p.UserID, "error", err)
+		}
+	}
+
+	if len(paid) > 0 {
+		log.Info(LogMsgInterestPaid, "accounts", len(paid))
+	}
+	return len(paid), nil
+}
+
+func (s *service) payGoalBonus(ctx context.Context, userID string) error {
+	tx, err := s.repo.BeginTx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer repository.SafeRollback(ctx, tx)
+
+	account, err := tx.LockAccount(ctx, userID)
+	if err != nil {
+		return fmt.Errorf(ErrMsgCompleteGoalFailed, err)
+	}
+	account, bonus, err := s.completeGoal(ctx, tx, account)
+	if err != nil {
+		return err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	if bonus > 0 {
+		logger.FromContext(ctx).Info(LogMsgGoalReached, "user_id", userID, "bonus", bonus, "balance", account.Balance)
+	}
+	return nil
+}
+
+// completeGoal pays the goal bonus if the account has reached its goal and
+// returns the account as it now stands with the bonus paid, if any
+func (s *service) completeGoal(ctx context.Context, tx Tx, account *domain.BankAccount) (*domain.BankAccount, int64, error) {
+	if account.Goal == 0 || account.Balance < account.Goal {
+		return account, 0, nil
+	}
+	bonus := s.goalBonus(account)
+	completed, err := tx.CompleteGoal(ctx, account.UserID, bonus)
+	if err != nil {
+		return nil, 0, fmt.Errorf(ErrMsgCompleteGoalFailed, err)
+	}
+	return completed, bonus, nil
+}
+
+// goalBonus is GoalBonusPercent of what the goal asked to be saved, held to
+// the same cap as an interest payout
+func (s *service) goalBonus(account *domain.BankAccount) int64 {
+	saved := max(account.Goal-account.GoalStart, 0)
+	bonus := saved * int64(s.cfg.GoalBonusPercent) / 100
+	if s.cfg.InterestCap > 0 {
+		bonus = min(bonus, s.cfg.InterestCap)
+	}
+	return bonus
+}
+
+// present fills in what the account shows but does not store: today's
+// withdrawals and the bank's terms
+func (s *service) present(account *domain.BankAccount) *domain.BankAccount {
+	account.WithdrawnToday = s.withdrawnToday(account, s.today())
+	account.WithdrawLimit = s.cfg.WithdrawLimit
+	account.InterestRate = s.cfg.InterestRate
+	return account
+}
+
+// withdrawnToday is what the account has withdrawn today; the stored count
+// belongs to an earlier day once the UTC date has moved on
+func (s *service) withdrawnToday(account *domain.BankAccount, today time.Time) int64 {
+	if !account.WithdrawnOn.Equal(today) {
+		return 0
+	}
+	return account.WithdrawnToday
+}
+
+// today is the current UTC date at midnight
+func (s *service) today() time.Time {
+	return s.now().UTC().Truncate(24 * time.Hour)
+}
+
+func (s *service) checkFeature(ctx context.Context) error {
+	unlocked, err := s.features.IsFeatureUnlocked(ctx, featureBank)
+	if err != nil {
+		return fmt.Errorf(ErrMsgCheckFeatureFailed, err)
+	}
+	if !unlocked {
+		return fmt.Errorf("bank requires feature unlock: %w", domain.ErrFeatureLocked)
+	}
+	return nil
+}
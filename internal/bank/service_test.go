@@ -0,0 +1,476 @@
+package bank_test
+
+import (
+	"context"
+	"errors"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/osse101/BrandishBot_Go/internal/bank"
+	"github.com/osse101/BrandishBot_Go/internal/bank/mocks"
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+)
+
+const (
+	saverID = "saver-1"
+	moneyID = 1
+)
+
+// expectSaver lets the request through the feature gate and registers the user
+func expectSaver(ctx context.Context, mockUsers *mocks.MockUserService, mockFeatures *mocks.MockFeatureChecker, gated bool) {
+	if gated {
+		mockFeatures.On("IsFeatureUnlocked", ctx, "feature_bank").Return(true, nil)
+	}
+	mockUsers.On("GetUserOrRegister", ctx, domain.PlatformDiscord, "d-1", "saver").Return(&domain.User{ID: saverID, Username: "saver"}, nil)
+}
+
+func expectMoney(ctx context.Context, mockItems *mocks.MockItemLookup) {
+	mockItems.On("GetItemByName", ctx, domain.ItemMoney).Return(&domain.Item{ID: moneyID, InternalName: domain.ItemMoney}, nil)
+}
+
+func today() time.Time {
+	return time.Now().UTC().Truncate(24 * time.Hour)
+}
+
+func TestDeposit(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("moves money from the inventory into the account", func(t *testing.T) {
+		mockRepo := mocks.NewMockRepository(t)
+		mockTx := mocks.NewMockTx(t)
+		mockUsers := mocks.NewMockUserService(t)
+		mockItems := mocks.NewMockItemLookup(t)
+		mockFeatures := mocks.NewMockFeatureChecker(t)
+		svc := bank.NewService(mockRepo, mockUsers, mockItems, mockFeatures, bank.Config{InterestRate: 0.02, WithdrawLimit: 1000})
+
+		expectSaver(ctx, mockUsers, mockFeatures, true)
+		expectMoney(ctx, mockItems)
+		mockRepo.On("GetInventory", mock.Anything, saverID).Return(&domain.Inventory{Slots: []domain.InventorySlot{
+			{ItemID: moneyID, Quantity: 50, QualityLevel: domain.QualityCommon},
+			{ItemID: moneyID, Quantity: 500, QualityLevel: domain.QualityRare},
+		}}, nil)
+		mockRepo.On("BeginTx", mock.Anything).Return(mockTx, nil)
+		mockTx.On("Commit", mock.Anything).Return(nil)
+		mockTx.On("Rollback", mock.Anything).Return(nil).Maybe()
+		mockTx.On("LockAccount", ctx, saverID).Return(&domain.BankAccount{UserID: saverID}, nil)
+		mockTx.On("AdjustItemQuantity", ctx, saverID, moneyID, domain.QualityRare, -200).Return(300, nil)
+		mockTx.On("Deposit", ctx, saverID, int64(200)).Return(&domain.BankAccount{UserID: saverID, Balance: 200}, nil)
+
+		result, err := svc.Deposit(ctx, domain.PlatformDiscord, "d-1", "saver", 200)
+
+		require.NoError(t, err)
+		assert.Equal(t, int64(200), result.Amount)
+		assert.Equal(t, int64(200), result.Account.Balance)
+		assert.Zero(t, result.GoalBonus)
+		assert.Equal(t, int64(1000), result.Account.WithdrawLimit)
+		assert.InDelta(t, 0.02, result.Account.InterestRate, 1e-9)
+	})
+
+	t.Run("reaching the goal pays the bonus", func(t *testing.T) {
+		mockRepo := mocks.NewMockRepository(t)
+		mockTx := mocks.NewMockTx(t)
+		mockUsers := mocks.NewMockUserService(t)
+		mockItems := mocks.NewMockItemLookup(t)
+		mockFeatures := mocks.NewMockFeatureChecker(t)
+		svc := bank.NewService(mockRepo, mockUsers, mockItems, mockFeatures, bank.Config{GoalBonusPercent: 10})
+
+		expectSaver(ctx, mockUsers, mockFeatures, true)
+		expectMoney(ctx, mockItems)
+		mockRepo.On("GetInventory", mock.Anything, saverID).Return(&domain.Inventory{Slots: []domain.InventorySlot{
+			{ItemID: moneyID, Quantity: 500, QualityLevel: domain.QualityCommon},
+		}}, nil)
+		mockRepo.On("BeginTx", mock.Anything).Return(mockTx, nil)
+		mockTx.On("Commit", mock.Anything).Return(nil)
+		mockTx.On("Rollback", mock.Anything).Return(nil).Maybe()
+		mockTx.On("LockAccount", ctx, saverID).Return(&domain.BankAccount{UserID: saverID, Balance: 900, Goal: 1000, GoalStart: 400}, nil)
+		mockTx.On("AdjustItemQuantity", ctx, saverID, moneyID, domain.QualityCommon, -100).Return(400, nil)
+		mockTx.On("Deposit", ctx, saverID, int64(100)).Return(&domain.BankAccount{UserID: saverID, Balance: 1000, Goal: 1000, GoalStart: 400}, nil)
+		mockTx.On("CompleteGoal", ctx, saverID, int64(60)).Return(&domain.BankAccount{UserID: saverID, Balance: 1060, GoalsReached: 1}, nil)
+
+		result, err := svc.Deposit(ctx, domain.PlatformDiscord, "d-1", "saver", 100)
+
+		require.NoError(t, err)
+		assert.Equal(t, int64(60), result.GoalBonus)
+		assert.Equal(t, int64(1060), result.Account.Balance)
+		assert.Zero(t, result.Account.Goal)
+	})
+
+	t.Run("a goal one above the balance pays nothing on the balance", func(t *testing.T) {
+		mockRepo := mocks.NewMockRepository(t)
+		mockTx := mocks.NewMockTx(t)
+		mockUsers := mocks.NewMockUserService(t)
+		mockItems := mocks.NewMockItemLookup(t)
+		mockFeatures := mocks.NewMockFeatureChecker(t)
+		svc := bank.NewService(mockRepo, mockUsers, mockItems, mockFeatures, bank.Config{GoalBonusPercent: 5})
+
+		expectSaver(ctx, mockUsers, mockFeatures, true)
+		expectMoney(ctx, mockItems)
+		mockRepo.On("GetInventory", mock.Anything, saverID).Return(&domain.Inventory{Slots: []domain.InventorySlot{
+			{ItemID: moneyID, Quantity: 1, QualityLevel: domain.QualityCommon},
+		}}, nil)
+		mockRepo.On("BeginTx", mock.Anything).Return(mockTx, nil)
+		mockTx.On("Commit", mock.Anything).Return(nil)
+		mockTx.On("Rollback", mock.Anything).Return(nil).Maybe()
+		mockTx.On("LockAccount", ctx, saverID).Return(&domain.BankAccount{UserID: saverID, Balance: 100000, Goal: 100001, GoalStart: 100000}, nil)
+		mockTx.On("AdjustItemQuantity", ctx, saverID, moneyID, domain.QualityCommon, -1).Return(0, nil)
+		mockTx.On("Deposit", ctx, saverID, int64(1)).Return(&domain.BankAccount{UserID: saverID, Balance: 100001, Goal: 100001, GoalStart: 100000}, nil)
+		mockTx.On("CompleteGoal", ctx, saverID, int64(0)).Return(&domain.BankAccount{UserID: saverID, Balance: 100001, GoalsReached: 1}, nil)
+
+		result, err := svc.Deposit(ctx, domain.PlatformDiscord, "d-1", "saver", 1)
+
+		require.NoError(t, err)
+		assert.Zero(t, result.GoalBonus)
+		assert.Equal(t, int64(100001), result.Account.Balance)
+	})
+
+	t.Run("a single deposit from an empty account earns the whole bonus", func(t *testing.T) {
+		mockRepo := mocks.NewMockRepository(t)
+		mockTx := mocks.NewMockTx(t)
+		mockUsers := mocks.NewMockUserService(t)
+		mockItems := mocks.NewMockItemLookup(t)
+		mockFeatures := mocks.NewMockFeatureChecker(t)
+		svc := bank.NewService(mockRepo, mockUsers, mockItems, mockFeatures, bank.Config{GoalBonusPercent: 10})
+
+		expectSaver(ctx, mockUsers, mockFeatures, true)
+		expectMoney(ctx, mockItems)
+		mockRepo.On("GetInventory", mock.Anything, saverID).Return(&domain.Inventory{Slots: []domain.InventorySlot{
+			{ItemID: moneyID, Quantity: 1000, QualityLevel: domain.QualityCommon},
+		}}, nil)
+		mockRepo.On("BeginTx", mock.Anything).Return(mockTx, nil)
+		mockTx.On("Commit", mock.Anything).Return(nil)
+		mockTx.On("Rollback", mock.Anything).Return(nil).Maybe()
+		mockTx.On("LockAccount", ctx, saverID).Return(&domain.BankAccount{UserID: saverID, Goal: 1000}, nil)
+		mockTx.On("AdjustItemQuantity", ctx, saverID, moneyID, domain.QualityCommon, -1000).Return(0, nil)
+		mockTx.On("Deposit", ctx, saverID, int64(1000)).Return(&domain.BankAccount{UserID: saverID, Balance: 1000, Goal: 1000}, nil)
+		mockTx.On("CompleteGoal", ctx, saverID, int64(100)).Return(&domain.BankAccount{UserID: saverID, Balance: 1100, GoalsReached: 1}, nil)
+
+		result, err := svc.Deposit(ctx, domain.PlatformDiscord, "d-1", "saver", 1000)
+
+		require.NoError(t, err)
+		assert.Equal(t, int64(100), result.GoalBonus)
+		assert.Equal(t, int64(1100), result.Account.Balance)
+	})
+
+	t.Run("the bonus is capped like interest", func(t *testing.T) {
+		mockRepo := mocks.NewMockRepository(t)
+		mockTx := mocks.NewMockTx(t)
+		mockUsers := mocks.NewMockUserService(t)
+		mockItems := mocks.NewMockItemLookup(t)
+		mockFeatures := mocks.NewMockFeatureChecker(t)
+		svc := bank.NewService(mockRepo, mockUsers, mockItems, mockFeatures, bank.Config{InterestCap: 100, GoalBonusPercent: 10})
+
+		expectSaver(ctx, mockUsers, mockFeatures, true)
+		expectMoney(ctx, mockItems)
+		mockRepo.On("GetInventory", mock.Anything, saverID).Return(&domain.Inventory{Slots: []domain.InventorySlot{
+			{ItemID: moneyID, Quantity: 5000, QualityLevel: domain.QualityCommon},
+		}}, nil)
+		mockRepo.On("BeginTx", mock.Anything).Return(mockTx, nil)
+		mockTx.On("Commit", mock.Anything).Return(nil)
+		mockTx.On("Rollback", mock.Anything).Return(nil).Maybe()
+		mockTx.On("LockAccount", ctx, saverID).Return(&domain.BankAccount{UserID: saverID, Goal: 5000}, nil)
+		mockTx.On("AdjustItemQuantity", ctx, saverID, moneyID, domain.QualityCommon, -5000).Return(0, nil)
+		mockTx.On("Deposit", ctx, saverID, int64(5000)).Return(&domain.BankAccount{UserID: saverID, Balance: 5000, Goal: 5000}, nil)
+		mockTx.On("CompleteGoal", ctx, saverID, int64(100)).Return(&domain.BankAccount{UserID: saverID, Balance: 5100, GoalsReached: 1}, nil)
+
+		result, err := svc.Deposit(ctx, domain.PlatformDiscord, "d-1", "saver", 5000)
+
+		require.NoError(t, err)
+		assert.Equal(t, int64(100), result.GoalBonus)
+	})
+
+	t.Run("not enough money in any one slot", func(t *testing.T) {
+		mockRepo := mocks.NewMockRepository(t)
+		mockUsers := mocks.NewMockUserService(t)
+		mockItems := mocks.NewMockItemLookup(t)
+		mockFeatures := mocks.NewMockFeatureChecker(t)
+		svc := bank.NewService(mockRepo, mockUsers, mockItems, mockFeatures, bank.Config{})
+
+		expectSaver(ctx, mockUsers, mockFeatures, true)
+		expectMoney(ctx, mockItems)
+		mockRepo.On("GetInventory", mock.Anything, saverID).Return(&domain.Inventory{Slots: []domain.InventorySlot{
+			{ItemID: moneyID, Quantity: 50, QualityLevel: domain.QualityCommon},
+		}}, nil)
+
+		_, err := svc.Deposit(ctx, domain.PlatformDiscord, "d-1", "saver", 100)
+
+		assert.ErrorIs(t, err, domain.ErrInsufficientFunds)
+	})
+
+	t.Run("locked until the bank is unlocked", func(t *testing.T) {
+		mockRepo := mocks.NewMockRepository(t)
+		mockUsers := mocks.NewMockUserService(t)
+		mockItems := mocks.NewMockItemLookup(t)
+		mockFeatures := mocks.NewMockFeatureChecker(t)
+		svc := bank.NewService(mockRepo, mockUsers, mockItems, mockFeatures, bank.Config{})
+
+		mockFeatures.On("IsFeatureUnlocked", ctx, "feature_bank").Return(false, nil)
+
+		_, err := svc.Deposit(ctx, domain.PlatformDiscord, "d-1", "saver", 100)
+
+		assert.ErrorIs(t, err, domain.ErrFeatureLocked)
+	})
+
+	t.Run("amount must be positive", func(t *testing.T) {
+		mockRepo := mocks.NewMockRepository(t)
+		mockUsers := mocks.NewMockUserService(t)
+		mockItems := mocks.NewMockItemLookup(t)
+		mockFeatures := mocks.NewMockFeatureChecker(t)
+		svc := bank.NewService(mockRepo, mockUsers, mockItems, mockFeatures, bank.Config{})
+
+		_, err := svc.Deposit(ctx, domain.PlatformDiscord, "d-1", "saver", 0)
+
+		assert.ErrorIs(t, err, domain.ErrInvalidInput)
+	})
+}
+
+func TestWithdraw(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("moves money back into the inventory", func(t *testing.T) {
+		mockRepo := mocks.NewMockRepository(t)
+		mockTx := mocks.NewMockTx(t)
+		mockUsers := mocks.NewMockUserService(t)
+		mockItems := mocks.NewMockItemLookup(t)
+		mockFeatures := mocks.NewMockFeatureChecker(t)
+		svc := bank.NewService(mockRepo, mockUsers, mockItems, mockFeatures, bank.Config{WithdrawLimit: 1000})
+
+		expectSaver(ctx, mockUsers, mockFeatures, false)
+		expectMoney(ctx, mockItems)
+		mockRepo.On("BeginTx", mock.Anything).Return(mockTx, nil)
+		mockTx.On("Commit", mock.Anything).Return(nil)
+		mockTx.On("Rollback", mock.Anything).Return(nil).Maybe()
+		mockTx.On("LockAccount", ctx, saverID).Return(&domain.BankAccount{UserID: saverID, Balance: 800, WithdrawnToday: 900, WithdrawnOn: today().AddDate(0, 0, -1)}, nil)
+		mockTx.On("Withdraw", ctx, saverID, int64(300), today()).Return(&domain.BankAccount{UserID: saverID, Balance: 500, WithdrawnToday: 300, WithdrawnOn: today()}, nil)
+		mockTx.On("AdjustItemQuantity", ctx, saverID, moneyID, domain.QualityCommon, 300).Return(300, nil)
+
+		result, err := svc.Withdraw(ctx, domain.PlatformDiscord, "d-1", "saver", 300)
+
+		require.NoError(t, err)
+		assert.Equal(t, int64(500), result.Account.Balance)
+		assert.Equal(t, int64(300), result.Account.WithdrawnToday)
+	})
+
+	t.Run("today's withdrawals count against the limit", func(t *testing.T) {
+		mockRepo := mocks.NewMockRepository(t)
+		mockTx := mocks.NewMockTx(t)
+		mockUsers := mocks.NewMockUserService(t)
+		mockItems := mocks.NewMockItemLookup(t)
+		mockFeatures := mocks.NewMockFeatureChecker(t)
+		svc := bank.NewService(mockRepo, mockUsers, mockItems, mockFeatures, bank.Config{WithdrawLimit: 1000})
+
+		expectSaver(ctx, mockUsers, mockFeatures, false)
+		expectMoney(ctx, mockItems)
+		mockRepo.On("BeginTx", mock.Anything).Return(mockTx, nil)
+		mockTx.On("Rollback", mock.Anything).Return(nil)
+		mockTx.On("LockAccount", ctx, saverID).Return(&domain.BankAccount{UserID: saverID, Balance: 800, WithdrawnToday: 900, WithdrawnOn: today()}, nil)
+
+		_, err := svc.Withdraw(ctx, domain.PlatformDiscord, "d-1", "saver", 200)
+
+		assert.ErrorIs(t, err, domain.ErrBankWithdrawLimit)
+	})
+
+	t.Run("cannot take out more than the balance", func(t *testing.T) {
+		mockRepo := mocks.NewMockRepository(t)
+		mockTx := mocks.NewMockTx(t)
+		mockUsers := mocks.NewMockUserService(t)
+		mockItems := mocks.NewMockItemLookup(t)
+		mockFeatures := mocks.NewMockFeatureChecker(t)
+		svc := bank.NewService(mockRepo, mockUsers, mockItems, mockFeatures, bank.Config{})
+
+		expectSaver(ctx, mockUsers, mockFeatures, false)
+		expectMoney(ctx, mockItems)
+		mockRepo.On("BeginTx", mock.Anything).Return(mockTx, nil)
+		mockTx.On("Rollback", mock.Anything).Return(nil)
+		mockTx.On("LockAccount", ctx, saverID).Return(&domain.BankAccount{UserID: saverID, Balance: 50}, nil)
+
+		_, err := svc.Withdraw(ctx, domain.PlatformDiscord, "d-1", "saver", 100)
+
+		assert.ErrorIs(t, err, domain.ErrInsufficientFunds)
+	})
+}
+
+func TestSetGoal(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("sets a goal above the balance", func(t *testing.T) {
+		mockRepo := mocks.NewMockRepository(t)
+		mockTx := mocks.NewMockTx(t)
+		mockUsers := mocks.NewMockUserService(t)
+		mockItems := mocks.NewMockItemLookup(t)
+		mockFeatures := mocks.NewMockFeatureChecker(t)
+		svc := bank.NewService(mockRepo, mockUsers, mockItems, mockFeatures, bank.Config{})
+
+		expectSaver(ctx, mockUsers, mockFeatures, true)
+		mockRepo.On("BeginTx", mock.Anything).Return(mockTx, nil)
+		mockTx.On("Commit", mock.Anything).Return(nil)
+		mockTx.On("Rollback", mock.Anything).Return(nil).Maybe()
+		mockTx.On("LockAccount", ctx, saverID).Return(&domain.BankAccount{UserID: saverID, Balance: 100}, nil)
+		mockTx.On("SetGoal", ctx, saverID, int64(1000), mock.AnythingOfType("time.Time")).Return(&domain.BankAccount{UserID: saverID, Balance: 100, Goal: 1000}, nil)
+
+		account, err := svc.SetGoal(ctx, domain.PlatformDiscord, "d-1", "saver", 1000)
+
+		require.NoError(t, err)
+		assert.Equal(t, int64(1000), account.Goal)
+	})
+
+	t.Run("goal must be above the balance", func(t *testing.T) {
+		mockRepo := mocks.NewMockRepository(t)
+		mockTx := mocks.NewMockTx(t)
+		mockUsers := mocks.NewMockUserService(t)
+		mockItems := mocks.NewMockItemLookup(t)
+		mockFeatures := mocks.NewMockFeatureChecker(t)
+		svc := bank.NewService(mockRepo, mockUsers, mockItems, mockFeatures, bank.Config{})
+
+		expectSaver(ctx, mockUsers, mockFeatures, true)
+		mockRepo.On("BeginTx", mock.Anything).Return(mockTx, nil)
+		mockTx.On("Rollback", mock.Anything).Return(nil)
+		mockTx.On("LockAccount", ctx, saverID).Return(&domain.BankAccount{UserID: saverID, Balance: 1000}, nil)
+
+		_, err := svc.SetGoal(ctx, domain.PlatformDiscord, "d-1", "saver", 1000)
+
+		assert.ErrorIs(t, err, domain.ErrInvalidInput)
+	})
+
+	t.Run("a new goal waits out the cooldown", func(t *testing.T) {
+		mockRepo := mocks.NewMockRepository(t)
+		mockTx := mocks.NewMockTx(t)
+		mockUsers := mocks.NewMockUserService(t)
+		mockItems := mocks.NewMockItemLookup(t)
+		mockFeatures := mocks.NewMockFeatureChecker(t)
+		svc := bank.NewService(mockRepo, mockUsers, mockItems, mockFeatures, bank.Config{})
+
+		expectSaver(ctx, mockUsers, mockFeatures, true)
+		mockRepo.On("BeginTx", mock.Anything).Return(mockTx, nil)
+		mockTx.On("Rollback", mock.Anything).Return(nil)
+		setAt := time.Now().Add(-time.Hour)
+		mockTx.On("LockAccount", ctx, saverID).Return(&domain.BankAccount{UserID: saverID, GoalSetAt: &setAt}, nil)
+
+		_, err := svc.SetGoal(ctx, domain.PlatformDiscord, "d-1", "saver", 500)
+
+		assert.ErrorIs(t, err, domain.ErrBankGoalCooldown)
+	})
+
+	t.Run("clearing ignores the cooldown", func(t *testing.T) {
+		mockRepo := mocks.NewMockRepository(t)
+		mockTx := mocks.NewMockTx(t)
+		mockUsers := mocks.NewMockUserService(t)
+		mockItems := mocks.NewMockItemLookup(t)
+		mockFeatures := mocks.NewMockFeatureChecker(t)
+		svc := bank.NewService(mockRepo, mockUsers, mockItems, mockFeatures, bank.Config{})
+
+		expectSaver(ctx, mockUsers, mockFeatures, true)
+		mockRepo.On("BeginTx", mock.Anything).Return(mockTx, nil)
+		mockTx.On("Commit", mock.Anything).Return(nil)
+		mockTx.On("Rollback", mock.Anything).Return(nil).Maybe()
+		setAt := time.Now().Add(-time.Hour)
+		mockTx.On("LockAccount", ctx, saverID).Return(&domain.BankAccount{UserID: saverID, Goal: 500, GoalSetAt: &setAt}, nil)
+		mockTx.On("SetGoal", ctx, saverID, int64(0), mock.AnythingOfType("time.Time")).Return(&domain.BankAccount{UserID: saverID, GoalSetAt: &setAt}, nil)
+
+		account, err := svc.SetGoal(ctx, domain.PlatformDiscord, "d-1", "saver", 0)
+
+		require.NoError(t, err)
+		assert.Zero(t, account.Goal)
+	})
+}
+
+func TestPayInterest(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("pays capped interest and the goals it reaches", func(t *testing.T) {
+		mockRepo := mocks.NewMockRepository(t)
+		mockTx := mocks.NewMockTx(t)
+		mockUsers := mocks.NewMockUserService(t)
+		mockItems := mocks.NewMockItemLookup(t)
+		mockFeatures := mocks.NewMockFeatureChecker(t)
+		svc := bank.NewService(mockRepo, mockUsers, mockItems, mockFeatures, bank.Config{InterestRate: 0.05, InterestCap: 100, GoalBonusPercent: 5})
+
+		mockRepo.On("ApplyInterest", ctx, 0.05, int64(100), mock.AnythingOfType("time.Time"), mock.AnythingOfType("time.Time")).
+			Return(func(_ context.Context, _ float64, _ int64, paidBefore, paidAt time.Time) ([]domain.BankInterest, error) {
+				assert.Equal(t, bank.MinPayoutGap, paidAt.Sub(paidBefore))
+				return []domain.BankInterest{
+					{UserID: saverID, Interest: 50, Balance: 1050, Goal: 1000},
+					{UserID: "saver-2", Interest: 10, Balance: 210, Goal: 1000},
+				}, nil
+			})
+		mockRepo.On("BeginTx", mock.Anything).Return(mockTx, nil)
+		mockTx.On("Commit", mock.Anything).Return(nil)
+		mockTx.On("Rollback", mock.Anything).Return(nil).Maybe()
+		mockTx.On("LockAccount", ctx, saverID).Return(&domain.BankAccount{UserID: saverID, Balance: 1050, Goal: 1000}, nil)
+		mockTx.On("CompleteGoal", ctx, saverID, int64(50)).Return(&domain.BankAccount{UserID: saverID, Balance: 1100}, nil)
+
+		paid, err := svc.PayInterest(ctx)
+
+		require.NoError(t, err)
+		assert.Equal(t, 2, paid)
+	})
+
+	t.Run("a cap of zero pays uncapped", func(t *testing.T) {
+		mockRepo := mocks.NewMockRepository(t)
+		mockUsers := mocks.NewMockUserService(t)
+		mockItems := mocks.NewMockItemLookup(t)
+		mockFeatures := mocks.NewMockFeatureChecker(t)
+		svc := bank.NewService(mockRepo, mockUsers, mockItems, mockFeatures, bank.Config{InterestRate: 0.01})
+
+		mockRepo.On("ApplyInterest", ctx, 0.01, int64(math.MaxInt64), mock.Anything, mock.Anything).Return(nil, nil)
+
+		paid, err := svc.PayInterest(ctx)
+
+		require.NoError(t, err)
+		assert.Zero(t, paid)
+	})
+
+	t.Run("a failed payout fails the job", func(t *testing.T) {
+		mockRepo := mocks.NewMockRepository(t)
+		mockUsers := mocks.NewMockUserService(t)
+		mockItems := mocks.NewMockItemLookup(t)
+		mockFeatures := mocks.NewMockFeatureChecker(t)
+		svc := bank.NewService(mockRepo, mockUsers, mockItems, mockFeatures, bank.Config{InterestRate: 0.01})
+
+		mockRepo.On("ApplyInterest", ctx, 0.01, mock.Anything, mock.Anything, mock.Anything).Return(nil, errors.New("db down"))
+
+		_, err := svc.PayInterest(ctx)
+
+		assert.Error(t, err)
+	})
+}
+
+func TestGetAccount(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("a user who never banked gets an empty account", func(t *testing.T) {
+		mockRepo := mocks.NewMockRepository(t)
+		mockUsers := mocks.NewMockUserService(t)
+		mockItems := mocks.NewMockItemLookup(t)
+		mockFeatures := mocks.NewMockFeatureChecker(t)
+		svc := bank.NewService(mockRepo, mockUsers, mockItems, mockFeatures, bank.Config{WithdrawLimit: 5000})
+
+		mockUsers.On("GetUserIDByPlatformID", ctx, domain.PlatformDiscord, "d-1").Return(saverID, nil)
+		mockRepo.On("GetAccount", ctx, saverID).Return(nil, nil)
+
+		account, err := svc.GetAccount(ctx, domain.PlatformDiscord, "d-1")
+
+		require.NoError(t, err)
+		assert.Equal(t, saverID, account.UserID)
+		assert.Zero(t, account.Balance)
+		assert.Equal(t, int64(5000), account.WithdrawLimit)
+	})
+
+	t.Run("unknown user", func(t *testing.T) {
+		mockRepo := mocks.NewMockRepository(t)
+		mockUsers := mocks.NewMockUserService(t)
+		mockItems := mocks.NewMockItemLookup(t)
+		mockFeatures := mocks.NewMockFeatureChecker(t)
+		svc := bank.NewService(mockRepo, mockUsers, mockItems, mockFeatures, bank.Config{})
+
+		mockUsers.On("GetUserIDByPlatformID", ctx, domain.PlatformDiscord, "d-1").Return("", nil)
+
+		_, err := svc.GetAccount(ctx, domain.PlatformDiscord, "d-1")
+
+		assert.ErrorIs(t, err, domain.ErrUserNotFound)
+	})
+}
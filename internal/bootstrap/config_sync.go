@@ -7,12 +7,16 @@ import (
 
 	"github.com/jackc/pgx/v5/pgxpool"
 
+	"github.com/osse101/BrandishBot_Go/internal/community"
 	"github.com/osse101/BrandishBot_Go/internal/config"
 	"github.com/osse101/BrandishBot_Go/internal/crafting"
+	"github.com/osse101/BrandishBot_Go/internal/database"
+	"github.com/osse101/BrandishBot_Go/internal/database/generated"
 	"github.com/osse101/BrandishBot_Go/internal/database/postgres"
 	"github.com/osse101/BrandishBot_Go/internal/item"
 	"github.com/osse101/BrandishBot_Go/internal/progression"
 	"github.com/osse101/BrandishBot_Go/internal/repository"
+	"github.com/osse101/BrandishBot_Go/internal/season"
 )
 
 // SyncProgressionTree loads, validates, and syncs the progression tree configuration to database.
@@ -31,7 +35,8 @@ func SyncProgressionTree(ctx context.Context, progressionRepo repository.Progres
 		return fmt.Errorf("%s: %w", ErrMsgInvalidProgressionTree, err)
 	}
 
-	syncResult, err := treeLoader.SyncToDatabase(ctx, treeConfig, progressionRepo, config.ConfigPathProgressionTree)
+	// The sync diffs the file against the nodes it reads, so read from the primary
+	syncResult, err := treeLoader.SyncToDatabase(database.WithPrimaryReads(ctx), treeConfig, progressionRepo, config.ConfigPathProgressionTree)
 	if err != nil {
 		return fmt.Errorf("%s: %w", ErrMsgFailedSyncProgressionTree, err)
 	}
@@ -49,6 +54,26 @@ func SyncProgressionTree(ctx context.Context, progressionRepo repository.Progres
 	return nil
 }
 
+// SeedCommunities gives every non-default community the unlocks the tree
+// starts with. The tree sync only auto-unlocks in the default community, so
+// this runs after it. Communities that already have them are left alone.
+func SeedCommunities(ctx context.Context, dbPool *pgxpool.Pool, communities []string) error {
+	q := generated.New(dbPool)
+	for _, id := range communities {
+		if id == community.Default {
+			continue
+		}
+		seeded, err := q.SeedCommunityAutoUnlocks(ctx, id)
+		if err != nil {
+			return fmt.Errorf("%s %s: %w", ErrMsgFailedSeedCommunity, id, err)
+		}
+		if seeded > 0 {
+			slog.Info(LogMsgCommunitySeeded, "community", id, "unlocks", seeded)
+		}
+	}
+	return nil
+}
+
 // SyncItems loads, validates, and syncs the items configuration to database.
 // It handles the complete lifecycle: load JSON → validate → sync to DB → log results.
 // Uses intelligent hash-based change detection to skip sync if file is unchanged.
@@ -115,3 +140,77 @@ func SyncRecipes(ctx context.Context, craftingRepo repository.Crafting, itemRepo
 
 	return nil
 }
+
+// SyncSeasons loads the season packs and syncs their items and recipes to
+// the database. It runs after SyncItems and SyncRecipes, since pack loot and
+// recipes may use any item. Pack items and recipes are synced every startup
+// and stay in the database all year; the season limits when they drop and
+// when the recipes can be crafted.
+func SyncSeasons(ctx context.Context, craftingRepo repository.Crafting, itemRepo repository.Item) ([]season.Pack, error) {
+	packs, err := season.LoadPacks(config.ConfigPathSeasonsDir)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", ErrMsgFailedLoadSeasons, err)
+	}
+	if len(packs) == 0 {
+		return nil, nil
+	}
+
+	var items []item.Def
+	var recipes []crafting.RecipeDef
+	for _, pack := range packs {
+		items = append(items, pack.Items...)
+		recipes = append(recipes, pack.Recipes...)
+	}
+
+	itemNames, recipeKeys, err := knownContent(ctx, itemRepo)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", ErrMsgFailedLoadSeasons, err)
+	}
+	for _, def := range items {
+		itemNames[def.InternalName] = true
+	}
+	if err := season.ValidateReferences(packs, itemNames, recipeKeys); err != nil {
+		return nil, fmt.Errorf("%s: %w", ErrMsgInvalidSeasons, err)
+	}
+
+	itemResult, err := item.NewLoader().SyncItems(ctx, items, itemRepo)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", ErrMsgFailedSyncSeasons, err)
+	}
+
+	recipeResult, err := crafting.NewRecipeLoader().SyncCraftingRecipes(ctx, recipes, craftingRepo, itemRepo)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", ErrMsgFailedSyncSeasons, err)
+	}
+
+	slog.Info(LogMsgSeasonsSynced,
+		"packs", len(packs),
+		"items_inserted", itemResult.ItemsInserted,
+		"items_updated", itemResult.ItemsUpdated,
+		"recipes_inserted", recipeResult.CraftingInserted,
+		"recipes_updated", recipeResult.CraftingUpdated)
+	return packs, nil
+}
+
+// knownContent returns the item names in the database and the recipe keys
+// of the recipe configs, which season packs are checked against
+func knownContent(ctx context.Context, itemRepo repository.Item) (map[string]bool, map[string]bool, error) {
+	allItems, err := itemRepo.GetAllItems(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	itemNames := make(map[string]bool, len(allItems))
+	for _, it := range allItems {
+		itemNames[it.InternalName] = true
+	}
+
+	recipeConfig, err := crafting.NewRecipeLoader().Load(config.ConfigPathRecipesCrafting, config.ConfigPathRecipesDisassemble)
+	if err != nil {
+		return nil, nil, err
+	}
+	recipeKeys := make(map[string]bool, len(recipeConfig.UpgradeConfig.Recipes))
+	for _, recipe := range recipeConfig.UpgradeConfig.Recipes {
+		recipeKeys[recipe.RecipeKey] = true
+	}
+	return itemNames, recipeKeys, nil
+}
@@ -66,6 +66,12 @@ const (
 
 	// EventDefaultDeadLetterPath is the default file path for dead-letter event logging
 	EventDefaultDeadLetterPath = "logs/event_deadletter.jsonl"
+
+	// EventDefaultHandlerMaxRetries is the default number of attempts for a failing event handler
+	EventDefaultHandlerMaxRetries = 3
+
+	// EventDefaultHandlerRetryDelay is the default base delay between handler retries
+	EventDefaultHandlerRetryDelay = time.Second
 )
 
 // Log messages for event system initialization
@@ -73,6 +79,11 @@ const (
 	LogMsgEventSystemInitialized         = "Event system initialized"
 	LogMsgFailedCreateDeadLetterDir      = "failed to create dead-letter directory"
 	LogMsgFailedCreateResilientPublisher = "failed to create resilient publisher"
+	LogMsgFailedCreateEventTransport     = "failed to create distributed event transport"
+	LogMsgDistributedBusConnected        = "Distributed event bus connected"
+	LogMsgEventBridgeForwarding          = "Forwarding events over Postgres NOTIFY"
+	LogMsgEventBusCloseFailed            = "Failed to close event bus"
+	LogMsgEventBusNotRetrying            = "event bus does not support handler retry"
 )
 
 // =============================================================================
@@ -88,6 +99,8 @@ const (
 	LogMsgProgressionTreeUnchanged = "Progression tree config unchanged, sync skipped"
 	LogMsgItemsSynced              = "Items synced successfully"
 	LogMsgRecipesSynced            = "Recipes synced successfully"
+	LogMsgCommunitySeeded          = "Seeded starting unlocks for community"
+	LogMsgSeasonsSynced            = "Season packs synced"
 
 	// Config sync error messages
 	ErrMsgFailedLoadProgressionTree = "failed to load progression tree config"
@@ -99,6 +112,10 @@ const (
 	ErrMsgFailedLoadRecipes         = "failed to load recipe config"
 	ErrMsgInvalidRecipes            = "invalid recipe configuration"
 	ErrMsgFailedSyncRecipes         = "failed to sync recipes to database"
+	ErrMsgFailedSeedCommunity       = "failed to seed community"
+	ErrMsgFailedLoadSeasons         = "failed to load season packs"
+	ErrMsgInvalidSeasons            = "invalid season packs"
+	ErrMsgFailedSyncSeasons         = "failed to sync season packs to database"
 )
 
 // =============================================================================
@@ -122,6 +139,7 @@ const (
 	LogMsgShuttingDownEventPublisher = "Shutting down event publisher..."
 	LogMsgServerStopped              = "Server stopped"
 	LogMsgServerForcedShutdown       = "Server forced to shutdown"
+	LogMsgGRPCServerForcedShutdown   = "gRPC server forced to shutdown"
 	LogMsgResilientPublisherFailed   = "Resilient publisher shutdown failed"
 
 	// Service names for shutdown logging
@@ -140,6 +158,7 @@ const (
 	// Worker names for shutdown logging
 	WorkerNameGamble       = "Gamble"
 	WorkerNameExpedition   = "Expedition"
+	WorkerNameBoss         = "Boss"
 	WorkerNameDailyReset   = "Daily reset"
 	WorkerNameWeeklyReset  = "Weekly reset"
 	WorkerNameSubscription = "Subscription"
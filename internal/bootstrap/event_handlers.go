@@ -9,6 +9,7 @@ import (
 	"github.com/osse101/BrandishBot_Go/internal/eventlog"
 	"github.com/osse101/BrandishBot_Go/internal/job"
 	"github.com/osse101/BrandishBot_Go/internal/metrics"
+	"github.com/osse101/BrandishBot_Go/internal/personaltrack"
 	"github.com/osse101/BrandishBot_Go/internal/progression"
 	"github.com/osse101/BrandishBot_Go/internal/quest"
 	"github.com/osse101/BrandishBot_Go/internal/stats"
@@ -22,6 +23,7 @@ type EventHandlerDependencies struct {
 	JobService         job.Service
 	QuestService       quest.Service
 	StatsService       stats.Service
+	PersonalTracks     personaltrack.Service
 	Config             *config.Config
 }
 
@@ -33,6 +35,7 @@ type EventHandlerDependencies struct {
 // - Job event handler (for XP awards from crafting)
 // - Quest event handler (for quest progress from crafting)
 // - Stats event handler (for stats recording from crafting)
+// - Personal track event handler (for milestones from engagement)
 func RegisterEventHandlers(deps EventHandlerDependencies) error {
 	// Register Metrics Collector
 	metricsCollector := metrics.NewEventMetricsCollector()
@@ -68,5 +71,12 @@ func RegisterEventHandlers(deps EventHandlerDependencies) error {
 		slog.Info("Stats event handler registered")
 	}
 
+	// Register Personal Track Handler (milestones from engagement)
+	if deps.PersonalTracks != nil {
+		personalTrackHandler := personaltrack.NewEventHandler(deps.PersonalTracks)
+		personalTrackHandler.Register(deps.EventBus)
+		slog.Info("Personal track event handler registered")
+	}
+
 	return nil
 }
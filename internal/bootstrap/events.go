@@ -6,8 +6,11 @@ import (
 	"os"
 	"path/filepath"
 
+	"github.com/jackc/pgx/v5/pgxpool"
+
 	"github.com/osse101/BrandishBot_Go/internal/config"
 	"github.com/osse101/BrandishBot_Go/internal/event"
+	"github.com/osse101/BrandishBot_Go/internal/eventdlq"
 )
 
 // InitializeEventSystem creates and configures the event bus and resilient publisher.
@@ -16,8 +19,24 @@ import (
 // with exponential backoff retry logic.
 // Returns the event bus, resilient publisher, and any error encountered.
 func InitializeEventSystem(cfg *config.Config) (event.Bus, *event.ResilientPublisher, error) {
-	// Initialize Event Bus
-	eventBus := event.NewMemoryBus()
+	baseBus, err := newEventBus(cfg)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	handlerMaxRetries := cfg.EventHandlerMaxRetries
+	if handlerMaxRetries == 0 {
+		handlerMaxRetries = EventDefaultHandlerMaxRetries
+	}
+
+	handlerRetryDelay := cfg.EventHandlerRetryDelay
+	if handlerRetryDelay == 0 {
+		handlerRetryDelay = EventDefaultHandlerRetryDelay
+	}
+
+	// Each handler retries on its own so one failing subscriber does not
+	// re-run the others; exhausted failures land in the dead-letter queue.
+	eventBus := event.NewRetryingBus(baseBus, handlerMaxRetries, handlerRetryDelay)
 
 	// Apply config defaults for resilient publisher
 	maxRetries := cfg.EventMaxRetries
@@ -47,9 +66,69 @@ func InitializeEventSystem(cfg *config.Config) (event.Bus, *event.ResilientPubli
 	}
 
 	slog.Info(LogMsgEventSystemInitialized,
+		"backend", cfg.EventBusBackend,
 		"max_retries", maxRetries,
 		"retry_delay", retryDelay,
+		"handler_max_retries", handlerMaxRetries,
+		"handler_retry_delay", handlerRetryDelay,
 		"deadletter_path", deadLetterPath)
 
 	return eventBus, resilientPublisher, nil
 }
+
+// InitializeDeadLetterQueue creates the dead-letter queue service and connects
+// it to the event bus so exhausted handler failures are persisted.
+func InitializeDeadLetterQueue(eventBus event.Bus, repo eventdlq.Repository) (eventdlq.Service, error) {
+	retryingBus, ok := eventBus.(*event.RetryingBus)
+	if !ok {
+		return nil, fmt.Errorf("%s: %T", LogMsgEventBusNotRetrying, eventBus)
+	}
+	svc := eventdlq.NewService(repo, retryingBus)
+	retryingBus.SetFailureSink(svc)
+	return svc, nil
+}
+
+// InitializeEventBridge forwards the event types in EVENT_BRIDGE_TYPES from
+// the bus to other processes over Postgres NOTIFY. Returns nil when no types
+// are configured.
+func InitializeEventBridge(cfg *config.Config, pool *pgxpool.Pool, eventBus event.Bus) *event.Bridge {
+	if len(cfg.EventBridgeTypes) == 0 {
+		return nil
+	}
+
+	types := make([]event.Type, len(cfg.EventBridgeTypes))
+	for i, t := range cfg.EventBridgeTypes {
+		types[i] = event.Type(t)
+	}
+
+	bridge := event.NewBridge(eventBus, event.NewPGNotifyTransport(pool, cfg.EventBridgeChannel), types)
+	bridge.Forward()
+	slog.Info(LogMsgEventBridgeForwarding, "channel", cfg.EventBridgeChannel, "types", cfg.EventBridgeTypes)
+	return bridge
+}
+
+// newEventBus creates the event bus for the configured backend. The memory bus
+// is process-local; the NATS backend relays events so multiple API instances
+// share gamble, SSE, and other fan-out events.
+func newEventBus(cfg *config.Config) (event.Bus, error) {
+	switch cfg.EventBusBackend {
+	case event.BusBackendNATS:
+		subject := cfg.NATSSubject
+		if subject == "" {
+			subject = event.NATSDefaultSubject
+		}
+		transport, err := event.NewNATSTransport(cfg.NATSURL, subject)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", LogMsgFailedCreateEventTransport, err)
+		}
+		bus, err := event.NewDistributedBus(transport)
+		if err != nil {
+			_ = transport.Close()
+			return nil, fmt.Errorf("%s: %w", LogMsgFailedCreateEventTransport, err)
+		}
+		slog.Info(LogMsgDistributedBusConnected, "url", cfg.NATSURL, "subject", subject, "instance_id", bus.InstanceID())
+		return bus, nil
+	default:
+		return event.NewMemoryBus(), nil
+	}
+}
@@ -3,54 +3,177 @@ package bootstrap
 import (
 	"github.com/jackc/pgx/v5/pgxpool"
 
+	"github.com/osse101/BrandishBot_Go/internal/announce"
+	"github.com/osse101/BrandishBot_Go/internal/apitoken"
+	"github.com/osse101/BrandishBot_Go/internal/balance"
+	"github.com/osse101/BrandishBot_Go/internal/bank"
+	"github.com/osse101/BrandishBot_Go/internal/boss"
+	"github.com/osse101/BrandishBot_Go/internal/brigade"
+	"github.com/osse101/BrandishBot_Go/internal/celebration"
+	"github.com/osse101/BrandishBot_Go/internal/communitypool"
 	"github.com/osse101/BrandishBot_Go/internal/database/postgres"
+	"github.com/osse101/BrandishBot_Go/internal/digging"
+	"github.com/osse101/BrandishBot_Go/internal/effects"
 	"github.com/osse101/BrandishBot_Go/internal/event"
+	"github.com/osse101/BrandishBot_Go/internal/eventdlq"
 	"github.com/osse101/BrandishBot_Go/internal/eventlog"
+	"github.com/osse101/BrandishBot_Go/internal/featureflag"
+	"github.com/osse101/BrandishBot_Go/internal/gameevent"
+	"github.com/osse101/BrandishBot_Go/internal/gift"
+	"github.com/osse101/BrandishBot_Go/internal/giveguard"
+	"github.com/osse101/BrandishBot_Go/internal/inbox"
+	"github.com/osse101/BrandishBot_Go/internal/itemalias"
+	"github.com/osse101/BrandishBot_Go/internal/itemflags"
+	"github.com/osse101/BrandishBot_Go/internal/jackpot"
+	"github.com/osse101/BrandishBot_Go/internal/loan"
+	"github.com/osse101/BrandishBot_Go/internal/lootbox"
+	"github.com/osse101/BrandishBot_Go/internal/moderation"
+	"github.com/osse101/BrandishBot_Go/internal/monetization"
+	"github.com/osse101/BrandishBot_Go/internal/personaltrack"
+	"github.com/osse101/BrandishBot_Go/internal/playershop"
+	"github.com/osse101/BrandishBot_Go/internal/progressionbulk"
+	"github.com/osse101/BrandishBot_Go/internal/reconcile"
+	"github.com/osse101/BrandishBot_Go/internal/reminder"
 	"github.com/osse101/BrandishBot_Go/internal/repository"
+	"github.com/osse101/BrandishBot_Go/internal/scheduler"
+	"github.com/osse101/BrandishBot_Go/internal/search"
+	"github.com/osse101/BrandishBot_Go/internal/snapshot"
+	"github.com/osse101/BrandishBot_Go/internal/stats"
+	"github.com/osse101/BrandishBot_Go/internal/stream"
+	"github.com/osse101/BrandishBot_Go/internal/undo"
+	"github.com/osse101/BrandishBot_Go/internal/user"
+	"github.com/osse101/BrandishBot_Go/internal/usersettings"
+	"github.com/osse101/BrandishBot_Go/internal/webhook"
 )
 
 // Repositories holds all repository implementations used by the application.
 // This provides a centralized location for repository initialization and
 // makes dependency injection clearer.
 type Repositories struct {
-	User         repository.User
-	Crafting     repository.Crafting
-	Economy      repository.Economy
-	Stats        repository.Stats
-	Item         repository.Item
-	Job          repository.Job
-	EventLog     eventlog.Repository
-	Gamble       repository.Gamble
-	Linking      repository.Linking
-	Progression  repository.Progression
-	Harvest      repository.HarvestRepository
-	Trap         repository.TrapRepository
-	Expedition   repository.Expedition
-	Quest        repository.QuestRepository
-	Subscription repository.Subscription
-	Compost      repository.CompostRepository
+	User          repository.User
+	Crafting      repository.Crafting
+	Economy       repository.Economy
+	Market        repository.Market
+	Stats         repository.Stats
+	StatsRollups  stats.AggregateRepository
+	Item          repository.Item
+	Job           repository.Job
+	EventLog      eventlog.Repository
+	DeadLetter    eventdlq.Repository
+	Gamble        repository.Gamble
+	Linking       repository.Linking
+	Progression   repository.Progression
+	Harvest       repository.HarvestRepository
+	Trap          repository.TrapRepository
+	Expedition    repository.Expedition
+	Quest         repository.QuestRepository
+	Subscription  repository.Subscription
+	Compost       repository.CompostRepository
+	ScheduledJob  scheduler.Repository
+	Reconcile     reconcile.Repository
+	Monetization  monetization.Repository
+	Celebration   celebration.Repository
+	UserSettings  usersettings.Repository
+	LootboxPity   lootbox.PityRepository
+	VoteReview    brigade.Repository
+	BulkProgress  progressionbulk.Repository
+	Reminder      reminder.Repository
+	Search        search.ProgressRepository
+	Loan          loan.Repository
+	PlayerShop    playershop.Repository
+	Effects       effects.Repository
+	Balance       balance.Repository
+	APIToken      apitoken.Repository
+	FeatureFlags  featureflag.Repository
+	GameEvents    gameevent.Repository
+	ItemAliases   itemalias.Repository
+	PersonalTrack personaltrack.Repository
+	Webhook       webhook.Repository
+	CommunityPool communitypool.Repository
+	ItemFlags     itemflags.Repository
+	Undo          undo.Repository
+	GiveGuard     giveguard.Repository
+	Moderation    moderation.Repository
+	Timeouts      user.TimeoutStore
+	Snapshots     snapshot.Repository
+	Streams       stream.Repository
+	Announcements announce.Repository
+	Jackpot       jackpot.Repository
+	Digging       digging.Repository
+	Fishing       repository.FishingRepository
+	Boss          boss.Repository
+	Garden        repository.GardenRepository
+	Bank          bank.Repository
+	Gift          gift.Repository
+	Inbox         inbox.Repository
 }
 
 // InitializeRepositories creates all repository implementations.
 // Most repositories only need the database pool, but ProgressionRepository
-// also requires the event bus for publishing progression events.
-func InitializeRepositories(dbPool *pgxpool.Pool, eventBus event.Bus) *Repositories {
+// also requires the event bus for publishing progression events, and the
+// inventory-writing repositories publish inventory diffs on it. When
+// replicaPool is non-nil, the user, stats and progression repositories serve
+// inventory reads, leaderboards and tree queries from it.
+func InitializeRepositories(dbPool, replicaPool *pgxpool.Pool, eventBus event.Bus) *Repositories {
+	// Every inventory write publishes its diff as an inventory.changed event
+	inventoryEvents := postgres.WithInventoryEvents(eventBus)
+
 	return &Repositories{
-		User:         postgres.NewUserRepository(dbPool),
-		Crafting:     postgres.NewCraftingRepository(dbPool),
-		Economy:      postgres.NewEconomyRepository(dbPool),
-		Stats:        postgres.NewStatsRepository(dbPool),
-		Item:         postgres.NewItemRepository(dbPool),
-		Job:          postgres.NewJobRepository(dbPool),
-		EventLog:     postgres.NewEventLogRepository(dbPool),
-		Gamble:       postgres.NewGambleRepository(dbPool),
-		Linking:      postgres.NewLinkingRepository(dbPool),
-		Progression:  postgres.NewProgressionRepository(dbPool, eventBus),
-		Harvest:      postgres.NewHarvestRepository(dbPool),
-		Trap:         postgres.NewTrapRepository(dbPool),
-		Expedition:   postgres.NewExpeditionRepository(dbPool),
-		Quest:        postgres.NewQuestRepository(dbPool),
-		Subscription: postgres.NewSubscriptionRepository(dbPool),
-		Compost:      postgres.NewCompostRepository(dbPool),
+		User:          postgres.NewUserRepositoryWithReplica(dbPool, replicaPool, inventoryEvents),
+		Crafting:      postgres.NewCraftingRepository(dbPool, inventoryEvents),
+		Economy:       postgres.NewEconomyRepository(dbPool, inventoryEvents),
+		Market:        postgres.NewMarketRepository(dbPool),
+		Stats:         postgres.NewStatsRepositoryWithReplica(dbPool, replicaPool),
+		StatsRollups:  postgres.NewStatsAggregateRepository(dbPool, replicaPool),
+		Item:          postgres.NewItemRepository(dbPool),
+		Job:           postgres.NewJobRepository(dbPool),
+		EventLog:      postgres.NewEventLogRepository(dbPool),
+		DeadLetter:    postgres.NewEventDeadLetterRepository(dbPool),
+		Gamble:        postgres.NewGambleRepository(dbPool, inventoryEvents),
+		Linking:       postgres.NewLinkingRepository(dbPool),
+		Progression:   postgres.NewProgressionRepositoryWithReplica(dbPool, replicaPool, eventBus),
+		Harvest:       postgres.NewHarvestRepository(dbPool, inventoryEvents),
+		Trap:          postgres.NewTrapRepository(dbPool),
+		Expedition:    postgres.NewExpeditionRepository(dbPool, inventoryEvents),
+		Quest:         postgres.NewQuestRepository(dbPool),
+		Subscription:  postgres.NewSubscriptionRepository(dbPool),
+		Compost:       postgres.NewCompostRepository(dbPool, inventoryEvents),
+		ScheduledJob:  postgres.NewScheduledJobRepository(dbPool),
+		Reconcile:     postgres.NewReconcileRepository(dbPool),
+		Monetization:  postgres.NewMonetizationRepository(dbPool),
+		Celebration:   postgres.NewCelebrationRepository(dbPool),
+		UserSettings:  postgres.NewUserSettingsRepository(dbPool),
+		LootboxPity:   postgres.NewLootboxPityRepository(dbPool),
+		VoteReview:    postgres.NewVoteReviewRepository(dbPool),
+		BulkProgress:  postgres.NewProgressionBulkRepository(dbPool),
+		Reminder:      postgres.NewReminderRepository(dbPool),
+		Search:        postgres.NewSearchProgressRepository(dbPool),
+		Loan:          postgres.NewLoanRepository(dbPool, inventoryEvents),
+		PlayerShop:    postgres.NewPlayerShopRepository(dbPool, inventoryEvents),
+		Effects:       postgres.NewEffectRepository(dbPool),
+		Balance:       postgres.NewItemBalanceRepository(dbPool),
+		APIToken:      postgres.NewAPITokenRepository(dbPool),
+		FeatureFlags:  postgres.NewFeatureFlagRepository(dbPool),
+		GameEvents:    postgres.NewGameEventRepository(dbPool),
+		ItemAliases:   postgres.NewItemAliasRepository(dbPool),
+		PersonalTrack: postgres.NewPersonalTrackRepository(dbPool),
+		Webhook:       postgres.NewWebhookRepository(dbPool),
+		CommunityPool: postgres.NewCommunityPoolRepository(dbPool, inventoryEvents),
+		ItemFlags:     postgres.NewItemFlagsRepository(dbPool),
+		Undo:          postgres.NewUndoRepository(dbPool, inventoryEvents),
+		GiveGuard:     postgres.NewGiveGuardRepository(dbPool),
+		Moderation:    postgres.NewModerationRepository(dbPool, inventoryEvents),
+		Timeouts:      postgres.NewTimeoutRepository(dbPool),
+		Snapshots:     postgres.NewSnapshotRepository(dbPool),
+		Streams:       postgres.NewStreamRepository(dbPool),
+		Announcements: postgres.NewAnnouncementRepository(dbPool),
+		Jackpot:       postgres.NewJackpotRepository(dbPool, inventoryEvents),
+		Digging:       postgres.NewDiggingRepository(dbPool),
+		Fishing:       postgres.NewFishingRepository(dbPool),
+		Boss:          postgres.NewBossRepository(dbPool),
+		Garden:        postgres.NewGardenRepository(dbPool),
+		Bank:          postgres.NewBankRepository(dbPool, inventoryEvents),
+		Gift:          postgres.NewGiftRepository(dbPool, inventoryEvents),
+		Inbox:         postgres.NewInboxRepository(dbPool),
 	}
 }
@@ -9,6 +9,7 @@ import (
 	"github.com/osse101/BrandishBot_Go/internal/economy"
 	"github.com/osse101/BrandishBot_Go/internal/event"
 	"github.com/osse101/BrandishBot_Go/internal/gamble"
+	"github.com/osse101/BrandishBot_Go/internal/grpcserver"
 	"github.com/osse101/BrandishBot_Go/internal/job"
 	"github.com/osse101/BrandishBot_Go/internal/prediction"
 	"github.com/osse101/BrandishBot_Go/internal/progression"
@@ -23,6 +24,7 @@ import (
 // ShutdownComponents holds all components that need graceful shutdown.
 type ShutdownComponents struct {
 	Server              *server.Server
+	GRPCServer          *grpcserver.Server // Nil when the gRPC API is disabled
 	ProgressionService  progression.Service
 	UserService         user.Service
 	EconomyService      economy.Service
@@ -36,15 +38,17 @@ type ShutdownComponents struct {
 	CompostService      compost.Service
 	GambleWorker        *worker.GambleWorker
 	ExpeditionWorker    *worker.ExpeditionWorker
+	BossWorker          *worker.BossWorker
 	DailyResetWorker    *worker.DailyResetWorker
 	WeeklyResetWorker   *worker.WeeklyResetWorker
 	SubscriptionWorker  *worker.SubscriptionWorker
 	ResilientPublisher  *event.ResilientPublisher
+	EventBus            event.Bus
 }
 
 // GracefulShutdown performs graceful shutdown of all application components.
 // It shuts down services in the correct order:
-// 1. HTTP server (stop accepting new requests)
+// 1. HTTP and gRPC servers (stop accepting new requests)
 // 2. Application services (complete in-flight operations)
 // 3. Event publisher (flush pending events to ensure consistency)
 //
@@ -56,6 +60,11 @@ func GracefulShutdown(ctx context.Context, components ShutdownComponents) {
 	if err := components.Server.Stop(ctx); err != nil {
 		slog.Error(LogMsgServerForcedShutdown, "error", err)
 	}
+	if components.GRPCServer != nil {
+		if err := components.GRPCServer.Stop(ctx); err != nil {
+			slog.Error(LogMsgGRPCServerForcedShutdown, "error", err)
+		}
+	}
 
 	// Shutdown workers first to cancel pending timers
 	if components.GambleWorker != nil {
@@ -70,6 +79,12 @@ func GracefulShutdown(ctx context.Context, components ShutdownComponents) {
 		}
 	}
 
+	if components.BossWorker != nil {
+		if err := components.BossWorker.Shutdown(ctx); err != nil {
+			slog.Error(WorkerNameBoss+LogMsgWorkerShutdownFailed, "error", err)
+		}
+	}
+
 	if components.DailyResetWorker != nil {
 		if err := components.DailyResetWorker.Shutdown(ctx); err != nil {
 			slog.Error(WorkerNameDailyReset+LogMsgWorkerShutdownFailed, "error", err)
@@ -106,6 +121,14 @@ func GracefulShutdown(ctx context.Context, components ShutdownComponents) {
 		slog.Error(LogMsgResilientPublisherFailed, "error", err)
 	}
 
+	// Close the event bus after the final events are flushed: pending handler
+	// retries move to the dead-letter queue and any distributed transport closes
+	if closer, ok := components.EventBus.(interface{ Close() error }); ok {
+		if err := closer.Close(); err != nil {
+			slog.Error(LogMsgEventBusCloseFailed, "error", err)
+		}
+	}
+
 	slog.Info(LogMsgServerStopped)
 }
 
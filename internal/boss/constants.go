@@ -0,0 +1,44 @@
+package boss
+
+// JobType identifies scheduled boss spawns in the worker pool and scheduler
+const JobType = "boss_spawn"
+
+// SpawnedByScheduler is the spawned_by of bosses the scheduler brings out
+const SpawnedByScheduler = "scheduler"
+
+// Damage of an attack before any weapon bonus
+const (
+	MinAttackDamage = 5
+	MaxAttackDamage = 20
+)
+
+// TopAttackersLimit is how many attackers the boss status lists
+const TopAttackersLimit = 5
+
+// maxAttackers caps the attackers read when sharing out a defeated boss's
+// loot; more than this and the rest get nothing
+const maxAttackers = 1000
+
+// Error messages
+const (
+	ErrMsgGetBossFailed      = "failed to get boss: %w"
+	ErrMsgCreateBossFailed   = "failed to spawn boss: %w"
+	ErrMsgExpireBossFailed   = "failed to expire boss: %w"
+	ErrMsgAttackFailed       = "failed to attack boss: %w"
+	ErrMsgGetAttackersFailed = "failed to get boss attackers: %w"
+	ErrMsgShareLootFailed    = "failed to share out boss loot: %w"
+	ErrMsgGetWeaponFailed    = "failed to look up weapon: %w"
+)
+
+// Log messages
+const (
+	LogMsgSpawned             = "Boss spawned"
+	LogMsgAttacked            = "User attacked the boss"
+	LogMsgDefeated            = "Boss defeated"
+	LogMsgEscaped             = "Boss escaped"
+	LogWarnWeaponRefundFailed = "Failed to refund weapon after a failed attack"
+	LogWarnLootOpenFailed     = "Failed to open boss reward lootboxes"
+	LogWarnLootGrantFailed    = "Failed to grant boss loot"
+	LogWarnStatsRecordFailed  = "Failed to record boss attack stats"
+	LogMsgSpawnJobSkipped     = "Boss already active, skipping scheduled spawn"
+)
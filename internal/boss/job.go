@@ -0,0 +1,34 @@
+package boss
+
+import (
+	"context"
+	"errors"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/logger"
+)
+
+// Job spawns a random boss unless one is already being fought
+type Job struct {
+	service Service
+}
+
+// NewJob creates a boss spawn job
+func NewJob(service Service) *Job {
+	return &Job{service: service}
+}
+
+// JobType returns the worker pool job type
+func (j *Job) JobType() string {
+	return JobType
+}
+
+// Process spawns a random roster boss
+func (j *Job) Process(ctx context.Context) error {
+	_, err := j.service.Spawn(ctx, "", SpawnedByScheduler)
+	if errors.Is(err, domain.ErrBossAlreadyActive) {
+		logger.FromContext(ctx).Info(LogMsgSpawnJobSkipped)
+		return nil
+	}
+	return err
+}
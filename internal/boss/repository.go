@@ -0,0 +1,54 @@
+package boss
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/repository"
+)
+
+// Repository stores the context community's boss fights and each user's
+// damage against them
+type Repository interface {
+	BeginTx(ctx context.Context) (Tx, error)
+
+	// CreateBoss stores a new active boss. It returns false when the
+	// community already has one.
+	CreateBoss(ctx context.Context, boss *domain.Boss) (bool, error)
+
+	// GetActiveBoss returns the active boss, or nil if there is none
+	GetActiveBoss(ctx context.Context) (*domain.Boss, error)
+
+	// ExpireBoss marks the boss escaped if it is still active and past its
+	// expiry, returning it, or nil if it was not
+	ExpireBoss(ctx context.Context, id uuid.UUID) (*domain.Boss, error)
+
+	// GetAttackers returns up to limit of the boss's attackers, by damage
+	GetAttackers(ctx context.Context, bossID uuid.UUID, limit int) ([]domain.BossAttacker, error)
+}
+
+// Tx lands an attack and, on the killing blow, ends the fight and records
+// each attacker's share of the loot in one transaction. Locking the active
+// boss serialises attacks, so only one can land the killing blow.
+type Tx interface {
+	repository.Tx
+
+	// LockActiveBoss locks and returns the active boss, or nil if there is
+	// none
+	LockActiveBoss(ctx context.Context) (*domain.Boss, error)
+
+	// Damage takes damage off the boss's HP, credits it to the user and
+	// returns the boss after it
+	Damage(ctx context.Context, bossID uuid.UUID, userID string, damage int) (*domain.Boss, error)
+
+	// EndBoss moves the boss out of the active state
+	EndBoss(ctx context.Context, bossID uuid.UUID, state domain.BossState) error
+
+	// GetAttackers returns up to limit of the boss's attackers, by damage
+	GetAttackers(ctx context.Context, bossID uuid.UUID, limit int) ([]domain.BossAttacker, error)
+
+	// SetRewardBoxes records the lootboxes the user won
+	SetRewardBoxes(ctx context.Context, bossID uuid.UUID, userID string, boxes int) error
+}
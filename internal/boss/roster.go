@@ -0,0 +1,80 @@
+package boss
+
+import (
+	"sort"
+	"time"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+)
+
+// Template is a boss that can be spawned
+type Template struct {
+	Key           string
+	Name          string
+	MaxHP         int
+	RewardLootbox string        // Lootbox item shared out on defeat
+	RewardBoxes   int           // How many of them
+	Duration      time.Duration // How long the boss stays before escaping
+}
+
+// Roster lists every boss, weakest first
+var Roster = []Template{
+	{Key: "goblin_king", Name: "Goblin King", MaxHP: 500, RewardLootbox: "lootbox_tier1", RewardBoxes: 5, Duration: 30 * time.Minute},
+	{Key: "stone_golem", Name: "Stone Golem", MaxHP: 1500, RewardLootbox: "lootbox_tier1", RewardBoxes: 12, Duration: time.Hour},
+	{Key: "kraken", Name: "Kraken", MaxHP: 3000, RewardLootbox: "lootbox_tier2", RewardBoxes: 6, Duration: 90 * time.Minute},
+	{Key: "ancient_dragon", Name: "Ancient Dragon", MaxHP: 6000, RewardLootbox: "lootbox_tier2", RewardBoxes: 12, Duration: 2 * time.Hour},
+}
+
+// Weapons maps each item an attack can use up to its bonus damage
+var Weapons = map[string]int{
+	"item_grenade":       25,
+	"weapon_missile":     50,
+	"explosive_bomb":     100,
+	"explosive_tnt":      250,
+	"weapon_hugemissile": 500,
+}
+
+// findTemplate returns the roster entry with the key
+func findTemplate(key string) (Template, bool) {
+	for _, t := range Roster {
+		if t.Key == key {
+			return t, true
+		}
+	}
+	return Template{}, false
+}
+
+// shareBoxes splits boxes among the attackers in proportion to their damage.
+// Each gets the whole part of their share, and the boxes left over go to the
+// largest remainders, ties to the bigger hitter. attackers must be ordered
+// by damage, highest first.
+func shareBoxes(attackers []domain.BossAttacker, boxes int) []int {
+	shares := make([]int, len(attackers))
+	total := 0
+	for _, a := range attackers {
+		total += a.Damage
+	}
+	if total <= 0 || boxes <= 0 {
+		return shares
+	}
+
+	remainders := make([]int, len(attackers))
+	given := 0
+	for i, a := range attackers {
+		shares[i] = a.Damage * boxes / total
+		remainders[i] = a.Damage * boxes % total
+		given += shares[i]
+	}
+
+	order := make([]int, len(attackers))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(a, b int) bool {
+		return remainders[order[a]] > remainders[order[b]]
+	})
+	for _, i := range order[:boxes-given] {
+		shares[i]++
+	}
+	return shares
+}
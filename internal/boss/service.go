@@ -0,0 +1,418 @@
+// Package boss runs community boss raids. An admin or the scheduler spawns a
+// boss with a pool of HP; users attack it on a per-user cooldown, optionally
+// using up a weapon for bonus damage. The attack that brings it to 0 HP
+// shares its reward lootboxes out among every attacker in proportion to the
+// damage they dealt. A boss still standing when its time runs out escapes.
+package boss
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/osse101/BrandishBot_Go/internal/community"
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/event"
+	"github.com/osse101/BrandishBot_Go/internal/logger"
+	"github.com/osse101/BrandishBot_Go/internal/lootbox"
+	"github.com/osse101/BrandishBot_Go/internal/repository"
+	"github.com/osse101/BrandishBot_Go/internal/rng"
+)
+
+// Service runs the context community's boss fights
+type Service interface {
+	// Spawn brings out the roster boss with the key, or a random one when key
+	// is empty. It fails with domain.ErrBossAlreadyActive while another boss
+	// is still standing.
+	Spawn(ctx context.Context, key, spawnedBy string) (*domain.Boss, error)
+
+	// Attack hits the active boss for the user, on the boss attack cooldown.
+	// A weapon item, when given, is used up for bonus damage.
+	Attack(ctx context.Context, platform, platformID, username, weapon string) (*domain.BossAttackResult, error)
+
+	// GetStatus returns the active boss, if any, and its top attackers
+	GetStatus(ctx context.Context) (*domain.BossStatus, error)
+
+	// GetActiveBoss returns the active boss, or nil if there is none
+	GetActiveBoss(ctx context.Context) (*domain.Boss, error)
+
+	// Expire lets the boss escape if it is still standing past its expiry.
+	// It returns nil when there was nothing to expire.
+	Expire(ctx context.Context, id uuid.UUID) (*domain.Boss, error)
+}
+
+// UserService finds the attacker, moves weapons out of their inventory and
+// grants their loot
+type UserService interface {
+	GetUserOrRegister(ctx context.Context, platform, platformID, username string) (*domain.User, error)
+	GetItemByName(ctx context.Context, name string) (*domain.Item, error)
+	RemoveItemByUsername(ctx context.Context, platform, username, itemName string, quantity int) (int, error)
+	AddItemByUsername(ctx context.Context, platform, username, itemName string, quantity int) error
+	GrantItemReward(ctx context.Context, user *domain.User, item *domain.Item, quantity int, qualityLevel domain.QualityLevel) error
+}
+
+// CooldownService enforces the per-user attack cooldown
+type CooldownService interface {
+	EnforceCooldown(ctx context.Context, userID, action string, fn func() error) error
+}
+
+// LootboxOpener opens the reward lootboxes of a defeated boss
+type LootboxOpener interface {
+	OpenLootbox(ctx context.Context, userID, lootboxName string, quantity int, boxQuality domain.QualityLevel) ([]lootbox.DroppedItem, error)
+}
+
+// Publisher publishes boss fight events
+type Publisher interface {
+	PublishWithRetry(ctx context.Context, evt event.Event)
+}
+
+// StatsRecorder records a stats event per attack
+type StatsRecorder interface {
+	RecordUserEvent(ctx context.Context, userID string, eventType domain.EventType, metadata interface{}) error
+}
+
+// Option configures optional service dependencies
+type Option func(*service)
+
+// WithRNG draws attack damage and random spawns from the seeded RNG provider
+func WithRNG(provider *rng.Provider) Option {
+	return func(s *service) {
+		s.rng = provider
+	}
+}
+
+// WithStats records a boss_attack stats event for every attack
+func WithStats(recorder StatsRecorder) Option {
+	return func(s *service) {
+		s.stats = recorder
+	}
+}
+
+type service struct {
+	repo      Repository
+	users     UserService
+	cooldowns CooldownService
+	lootboxes LootboxOpener
+	publisher Publisher
+	rng       *rng.Provider
+	stats     StatsRecorder
+}
+
+// NewService creates a boss service. publisher may be nil.
+func NewService(repo Repository, users UserService, cooldowns CooldownService, lootboxes LootboxOpener, publisher Publisher, opts ...Option) Service {
+	s := &service{
+		repo:      repo,
+		users:     users,
+		cooldowns: cooldowns,
+		lootboxes: lootboxes,
+		publisher: publisher,
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+func (s *service) Spawn(ctx context.Context, key, spawnedBy string) (*domain.Boss, error) {
+	var template Template
+	if key == "" {
+		template = Roster[s.source(ctx).Intn(len(Roster))]
+	} else {
+		var ok bool
+		if template, ok = findTemplate(key); !ok {
+			return nil, fmt.Errorf("%w: %s", domain.ErrUnknownBoss, key)
+		}
+	}
+
+	// A boss past its expiry that the worker has not caught yet would block
+	// the spawn, so let it escape first
+	active, err := s.GetActiveBoss(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if active != nil {
+		if time.Now().Before(active.ExpiresAt) {
+			return nil, domain.ErrBossAlreadyActive
+		}
+		if _, err := s.Expire(ctx, active.ID); err != nil {
+			return nil, err
+		}
+	}
+
+	now := time.Now()
+	boss := &domain.Boss{
+		ID:            uuid.New(),
+		CommunityID:   community.FromContext(ctx),
+		Key:           template.Key,
+		Name:          template.Name,
+		MaxHP:         template.MaxHP,
+		HP:            template.MaxHP,
+		RewardLootbox: template.RewardLootbox,
+		RewardBoxes:   template.RewardBoxes,
+		State:         domain.BossStateActive,
+		SpawnedBy:     spawnedBy,
+		SpawnedAt:     now,
+		ExpiresAt:     now.Add(template.Duration),
+	}
+	created, err := s.repo.CreateBoss(ctx, boss)
+	if err != nil {
+		return nil, fmt.Errorf(ErrMsgCreateBossFailed, err)
+	}
+	if !created {
+		return nil, domain.ErrBossAlreadyActive
+	}
+
+	logger.FromContext(ctx).Info(LogMsgSpawned, "boss_id", boss.ID, "key", boss.Key, "spawned_by", spawnedBy, "expires_at", boss.ExpiresAt)
+	if s.publisher != nil {
+		s.publisher.PublishWithRetry(ctx, event.NewBossSpawnedEvent(boss))
+	}
+	return boss, nil
+}
+
+func (s *service) Attack(ctx context.Context, platform, platformID, username, weapon string) (*domain.BossAttackResult, error) {
+	bonus := 0
+	if weapon != "" {
+		var err error
+		if weapon, bonus, err = s.resolveWeapon(ctx, weapon); err != nil {
+			return nil, err
+		}
+	}
+
+	user, err := s.users.GetUserOrRegister(ctx, platform, platformID, username)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		result *domain.BossAttackResult
+		boss   *domain.Boss
+	)
+	err = s.cooldowns.EnforceCooldown(ctx, user.ID, domain.ActionBossAttack, func() error {
+		var attackErr error
+		result, boss, attackErr = s.attack(ctx, platform, user, weapon, bonus)
+		return attackErr
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.recordAttack(ctx, result)
+	if s.publisher != nil {
+		s.publisher.PublishWithRetry(ctx, event.NewBossDamagedEvent(result))
+	}
+	if result.Defeated {
+		s.shareLoot(ctx, boss, result)
+		if s.publisher != nil {
+			s.publisher.PublishWithRetry(ctx, event.NewBossDefeatedEvent(boss.RewardLootbox, result))
+		}
+	}
+	return result, nil
+}
+
+// attack uses up the weapon and lands the hit, giving the weapon back when
+// the hit can't land
+func (s *service) attack(ctx context.Context, platform string, user *domain.User, weapon string, bonus int) (*domain.BossAttackResult, *domain.Boss, error) {
+	if weapon != "" {
+		if _, err := s.users.RemoveItemByUsername(ctx, platform, user.Username, weapon, 1); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	damage := MinAttackDamage + s.source(ctx).Intn(MaxAttackDamage-MinAttackDamage+1) + bonus
+	result, boss, err := s.hit(ctx, user, damage)
+	if err != nil {
+		if weapon != "" {
+			if refundErr := s.users.AddItemByUsername(ctx, platform, user.Username, weapon, 1); refundErr != nil {
+				logger.FromContext(ctx).Warn(LogWarnWeaponRefundFailed, "user_id", user.ID, "weapon", weapon, "error", refundErr)
+			}
+		}
+		return nil, nil, err
+	}
+	result.Weapon = weapon
+
+	logger.FromContext(ctx).Info(LogMsgAttacked, "user_id", user.ID, "boss_id", boss.ID, "damage", result.Damage, "hp", result.HP)
+	return result, boss, nil
+}
+
+// hit takes the damage off the active boss. On the killing blow it also ends
+// the fight and records every attacker's share of the reward lootboxes.
+func (s *service) hit(ctx context.Context, user *domain.User, damage int) (*domain.BossAttackResult, *domain.Boss, error) {
+	tx, err := s.repo.BeginTx(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer repository.SafeRollback(ctx, tx)
+
+	boss, err := tx.LockActiveBoss(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf(ErrMsgGetBossFailed, err)
+	}
+	if boss == nil || !time.Now().Before(boss.ExpiresAt) {
+		return nil, nil, domain.ErrNoActiveBoss
+	}
+
+	damage = min(damage, boss.HP)
+	boss, err = tx.Damage(ctx, boss.ID, user.ID, damage)
+	if err != nil {
+		return nil, nil, fmt.Errorf(ErrMsgAttackFailed, err)
+	}
+
+	result := &domain.BossAttackResult{
+		UserID:   user.ID,
+		Username: user.Username,
+		BossID:   boss.ID.String(),
+		BossName: boss.Name,
+		Damage:   damage,
+		HP:       boss.HP,
+		MaxHP:    boss.MaxHP,
+		Defeated: boss.HP == 0,
+	}
+
+	if result.Defeated {
+		if err := tx.EndBoss(ctx, boss.ID, domain.BossStateDefeated); err != nil {
+			return nil, nil, fmt.Errorf(ErrMsgAttackFailed, err)
+		}
+		attackers, err := tx.GetAttackers(ctx, boss.ID, maxAttackers)
+		if err != nil {
+			return nil, nil, fmt.Errorf(ErrMsgGetAttackersFailed, err)
+		}
+		shares := shareBoxes(attackers, boss.RewardBoxes)
+		for i, attacker := range attackers {
+			if shares[i] > 0 {
+				if err := tx.SetRewardBoxes(ctx, boss.ID, attacker.UserID, shares[i]); err != nil {
+					return nil, nil, fmt.Errorf(ErrMsgShareLootFailed, err)
+				}
+			}
+			result.Loot = append(result.Loot, domain.BossLoot{
+				UserID:   attacker.UserID,
+				Username: attacker.Username,
+				Damage:   attacker.Damage,
+				Boxes:    shares[i],
+				Items:    []domain.BossLootItem{},
+			})
+		}
+		boss.State = domain.BossStateDefeated
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return result, boss, nil
+}
+
+// shareLoot opens each attacker's reward lootboxes and grants what drops.
+// The shares are already recorded, so a failed opening or grant is logged
+// rather than undoing the killing blow.
+func (s *service) shareLoot(ctx context.Context, boss *domain.Boss, result *domain.BossAttackResult) {
+	log := logger.FromContext(ctx)
+	log.Info(LogMsgDefeated, "boss_id", boss.ID, "killer_id", result.UserID, "attackers", len(result.Loot))
+
+	for i := range result.Loot {
+		loot := &result.Loot[i]
+		if loot.Boxes == 0 {
+			continue
+		}
+		drops, err := s.lootboxes.OpenLootbox(ctx, loot.UserID, boss.RewardLootbox, loot.Boxes, domain.QualityCommon)
+		if err != nil {
+			log.Warn(LogWarnLootOpenFailed, "boss_id", boss.ID, "user_id", loot.UserID, "boxes", loot.Boxes, "error", err)
+			continue
+		}
+		user := &domain.User{ID: loot.UserID, Username: loot.Username}
+		for _, drop := range drops {
+			item := &domain.Item{ID: drop.ItemID, InternalName: drop.ItemName}
+			if err := s.users.GrantItemReward(ctx, user, item, drop.Quantity, drop.QualityLevel); err != nil {
+				log.Warn(LogWarnLootGrantFailed, "boss_id", boss.ID, "user_id", loot.UserID, "item", drop.ItemName, "error", err)
+				continue
+			}
+			loot.Items = append(loot.Items, domain.BossLootItem{
+				ItemName: drop.ItemName,
+				Quantity: drop.Quantity,
+				Quality:  drop.QualityLevel,
+			})
+		}
+	}
+}
+
+func (s *service) GetStatus(ctx context.Context) (*domain.BossStatus, error) {
+	boss, err := s.GetActiveBoss(ctx)
+	if err != nil {
+		return nil, err
+	}
+	status := &domain.BossStatus{Boss: boss, TopAttackers: []domain.BossAttacker{}}
+	if boss == nil {
+		return status, nil
+	}
+
+	attackers, err := s.repo.GetAttackers(ctx, boss.ID, TopAttackersLimit)
+	if err != nil {
+		return nil, fmt.Errorf(ErrMsgGetAttackersFailed, err)
+	}
+	if attackers != nil {
+		status.TopAttackers = attackers
+	}
+	return status, nil
+}
+
+func (s *service) GetActiveBoss(ctx context.Context) (*domain.Boss, error) {
+	boss, err := s.repo.GetActiveBoss(ctx)
+	if err != nil {
+		return nil, fmt.Errorf(ErrMsgGetBossFailed, err)
+	}
+	return boss, nil
+}
+
+func (s *service) Expire(ctx context.Context, id uuid.UUID) (*domain.Boss, error) {
+	boss, err := s.repo.ExpireBoss(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf(ErrMsgExpireBossFailed, err)
+	}
+	if boss == nil {
+		return nil, nil
+	}
+
+	logger.FromContext(ctx).Info(LogMsgEscaped, "boss_id", boss.ID, "hp", boss.HP, "max_hp", boss.MaxHP)
+	if s.publisher != nil {
+		s.publisher.PublishWithRetry(ctx, event.NewBossEscapedEvent(boss))
+	}
+	return boss, nil
+}
+
+// resolveWeapon resolves a weapon name, internal or public, to its item and
+// bonus damage
+func (s *service) resolveWeapon(ctx context.Context, name string) (string, int, error) {
+	item, err := s.users.GetItemByName(ctx, name)
+	if err != nil {
+		return "", 0, fmt.Errorf(ErrMsgGetWeaponFailed, err)
+	}
+	if item != nil {
+		if bonus, ok := Weapons[item.InternalName]; ok {
+			return item.InternalName, bonus, nil
+		}
+	}
+	return "", 0, fmt.Errorf("%w: %s", domain.ErrUnknownWeapon, name)
+}
+
+func (s *service) recordAttack(ctx context.Context, result *domain.BossAttackResult) {
+	if s.stats == nil {
+		return
+	}
+	metadata := map[string]interface{}{
+		"boss_id":  result.BossID,
+		"damage":   result.Damage,
+		"weapon":   result.Weapon,
+		"defeated": result.Defeated,
+	}
+	if err := s.stats.RecordUserEvent(ctx, result.UserID, domain.StatsEventBossAttack, metadata); err != nil {
+		logger.FromContext(ctx).Warn(LogWarnStatsRecordFailed, "user_id", result.UserID, "error", err)
+	}
+}
+
+func (s *service) source(ctx context.Context) rng.Source {
+	if s.rng != nil {
+		return s.rng.ForOperation(ctx, rng.OpBoss)
+	}
+	return rand.New(rand.NewSource(time.Now().UnixNano())) //nolint:gosec // Game logic randomness, not security critical
+}
@@ -0,0 +1,303 @@
+package boss_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/osse101/BrandishBot_Go/internal/boss"
+	"github.com/osse101/BrandishBot_Go/internal/boss/mocks"
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/event"
+	"github.com/osse101/BrandishBot_Go/internal/lootbox"
+)
+
+const attackerID = "attacker-1"
+
+type bossFixture struct {
+	repo      *mocks.MockRepository
+	tx        *mocks.MockTx
+	users     *mocks.MockUserService
+	cooldowns *mocks.MockCooldownService
+	lootboxes *mocks.MockLootboxOpener
+	publisher *mocks.MockPublisher
+	stats     *mocks.MockStatsRecorder
+	svc       boss.Service
+}
+
+func newBossFixture(t *testing.T) *bossFixture {
+	f := &bossFixture{
+		repo:      mocks.NewMockRepository(t),
+		tx:        mocks.NewMockTx(t),
+		users:     mocks.NewMockUserService(t),
+		cooldowns: mocks.NewMockCooldownService(t),
+		lootboxes: mocks.NewMockLootboxOpener(t),
+		publisher: mocks.NewMockPublisher(t),
+		stats:     mocks.NewMockStatsRecorder(t),
+	}
+	f.svc = boss.NewService(f.repo, f.users, f.cooldowns, f.lootboxes, f.publisher, boss.WithStats(f.stats))
+	return f
+}
+
+func activeBoss(hp int) *domain.Boss {
+	return &domain.Boss{
+		ID:            uuid.New(),
+		Key:           "goblin_king",
+		Name:          "Goblin King",
+		MaxHP:         500,
+		HP:            hp,
+		RewardLootbox: "lootbox_tier1",
+		RewardBoxes:   5,
+		State:         domain.BossStateActive,
+		ExpiresAt:     time.Now().Add(time.Hour),
+	}
+}
+
+// expectAttack lets an attack by the user through the cooldown and into the
+// transaction on the boss
+func (f *bossFixture) expectAttack(ctx context.Context, target *domain.Boss) {
+	f.users.On("GetUserOrRegister", ctx, domain.PlatformDiscord, "d-1", "hero").Return(&domain.User{ID: attackerID, Username: "hero"}, nil)
+	f.cooldowns.On("EnforceCooldown", ctx, attackerID, domain.ActionBossAttack, mock.Anything).Return(
+		func(_ context.Context, _, _ string, fn func() error) error { return fn() })
+	f.repo.On("BeginTx", ctx).Return(f.tx, nil)
+	f.tx.On("LockActiveBoss", ctx).Return(target, nil)
+	f.tx.On("Rollback", ctx).Return(nil).Maybe()
+	if target == nil {
+		return
+	}
+	f.tx.On("Damage", ctx, target.ID, attackerID, mock.AnythingOfType("int")).Return(
+		func(_ context.Context, _ uuid.UUID, _ string, damage int) (*domain.Boss, error) {
+			after := *target
+			after.HP -= damage
+			return &after, nil
+		})
+	f.tx.On("Commit", ctx).Return(nil)
+	f.stats.On("RecordUserEvent", ctx, attackerID, domain.StatsEventBossAttack, mock.Anything).Return(nil)
+	f.publisher.On("PublishWithRetry", ctx, mock.MatchedBy(func(evt event.Event) bool {
+		return evt.Type == event.BossDamaged
+	})).Return()
+}
+
+func TestAttack(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("wears the boss down", func(t *testing.T) {
+		f := newBossFixture(t)
+		target := activeBoss(500)
+		f.expectAttack(ctx, target)
+
+		result, err := f.svc.Attack(ctx, domain.PlatformDiscord, "d-1", "hero", "")
+
+		require.NoError(t, err)
+		assert.GreaterOrEqual(t, result.Damage, boss.MinAttackDamage)
+		assert.LessOrEqual(t, result.Damage, boss.MaxAttackDamage)
+		assert.Equal(t, 500-result.Damage, result.HP)
+		assert.False(t, result.Defeated)
+		assert.Empty(t, result.Loot)
+	})
+
+	t.Run("a weapon is used up for bonus damage", func(t *testing.T) {
+		f := newBossFixture(t)
+		f.users.On("GetItemByName", ctx, "tnt").Return(&domain.Item{InternalName: "explosive_tnt", PublicName: "tnt"}, nil)
+		target := activeBoss(500)
+		f.expectAttack(ctx, target)
+		f.users.On("RemoveItemByUsername", ctx, domain.PlatformDiscord, "hero", "explosive_tnt", 1).Return(1, nil)
+
+		result, err := f.svc.Attack(ctx, domain.PlatformDiscord, "d-1", "hero", "tnt")
+
+		require.NoError(t, err)
+		assert.Equal(t, "explosive_tnt", result.Weapon)
+		assert.GreaterOrEqual(t, result.Damage, boss.MinAttackDamage+boss.Weapons["explosive_tnt"])
+	})
+
+	t.Run("the killing blow shares the loot by damage", func(t *testing.T) {
+		f := newBossFixture(t)
+		target := activeBoss(3)
+		f.expectAttack(ctx, target)
+		f.tx.On("EndBoss", ctx, target.ID, domain.BossStateDefeated).Return(nil)
+		f.tx.On("GetAttackers", ctx, target.ID, mock.AnythingOfType("int")).Return([]domain.BossAttacker{
+			{UserID: "other-1", Username: "other", Damage: 300},
+			{UserID: attackerID, Username: "hero", Damage: 100},
+		}, nil)
+		f.tx.On("SetRewardBoxes", ctx, target.ID, "other-1", 4).Return(nil)
+		f.tx.On("SetRewardBoxes", ctx, target.ID, attackerID, 1).Return(nil)
+		f.lootboxes.On("OpenLootbox", ctx, "other-1", "lootbox_tier1", 4, domain.QualityCommon).Return([]lootbox.DroppedItem{
+			{ItemID: 7, ItemName: "money", Quantity: 40, QualityLevel: domain.QualityCommon},
+		}, nil)
+		f.lootboxes.On("OpenLootbox", ctx, attackerID, "lootbox_tier1", 1, domain.QualityCommon).Return([]lootbox.DroppedItem{
+			{ItemID: 9, ItemName: "item_grenade", Quantity: 1, QualityLevel: domain.QualityRare},
+		}, nil)
+		f.users.On("GrantItemReward", ctx, mock.MatchedBy(func(u *domain.User) bool { return u.ID == "other-1" }),
+			mock.MatchedBy(func(i *domain.Item) bool { return i.ID == 7 }), 40, domain.QualityCommon).Return(nil)
+		f.users.On("GrantItemReward", ctx, mock.MatchedBy(func(u *domain.User) bool { return u.ID == attackerID }),
+			mock.MatchedBy(func(i *domain.Item) bool { return i.ID == 9 }), 1, domain.QualityRare).Return(nil)
+		f.publisher.On("PublishWithRetry", ctx, mock.MatchedBy(func(evt event.Event) bool {
+			payload, ok := evt.Payload.(event.BossDefeatedPayloadV1)
+			return evt.Type == event.BossDefeated && ok && payload.KillerID == attackerID && len(payload.Shares) == 2
+		})).Return()
+
+		result, err := f.svc.Attack(ctx, domain.PlatformDiscord, "d-1", "hero", "")
+
+		require.NoError(t, err)
+		assert.True(t, result.Defeated)
+		assert.Equal(t, 3, result.Damage, "damage is capped at the HP left")
+		assert.Equal(t, 0, result.HP)
+		require.Len(t, result.Loot, 2)
+		assert.Equal(t, 4, result.Loot[0].Boxes)
+		assert.Equal(t, 1, result.Loot[1].Boxes)
+		assert.Equal(t, []domain.BossLootItem{{ItemName: "item_grenade", Quantity: 1, Quality: domain.QualityRare}}, result.Loot[1].Items)
+	})
+
+	t.Run("no boss to fight refunds the weapon", func(t *testing.T) {
+		f := newBossFixture(t)
+		f.users.On("GetItemByName", ctx, "grenade").Return(&domain.Item{InternalName: "item_grenade"}, nil)
+		f.expectAttack(ctx, nil)
+		f.users.On("RemoveItemByUsername", ctx, domain.PlatformDiscord, "hero", "item_grenade", 1).Return(1, nil)
+		f.users.On("AddItemByUsername", ctx, domain.PlatformDiscord, "hero", "item_grenade", 1).Return(nil)
+
+		_, err := f.svc.Attack(ctx, domain.PlatformDiscord, "d-1", "hero", "grenade")
+
+		assert.ErrorIs(t, err, domain.ErrNoActiveBoss)
+	})
+
+	t.Run("only weapons can be used", func(t *testing.T) {
+		f := newBossFixture(t)
+		f.users.On("GetItemByName", ctx, "lootbox").Return(&domain.Item{InternalName: "lootbox_tier1"}, nil)
+
+		_, err := f.svc.Attack(ctx, domain.PlatformDiscord, "d-1", "hero", "lootbox")
+
+		assert.ErrorIs(t, err, domain.ErrUnknownWeapon)
+	})
+}
+
+func TestSpawn(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("brings out the roster boss", func(t *testing.T) {
+		f := newBossFixture(t)
+		f.repo.On("GetActiveBoss", ctx).Return(nil, nil)
+		f.repo.On("CreateBoss", ctx, mock.AnythingOfType("*domain.Boss")).Return(true, nil)
+		f.publisher.On("PublishWithRetry", ctx, mock.MatchedBy(func(evt event.Event) bool {
+			return evt.Type == event.BossSpawned
+		})).Return()
+
+		spawned, err := f.svc.Spawn(ctx, "stone_golem", "admin")
+
+		require.NoError(t, err)
+		assert.Equal(t, "Stone Golem", spawned.Name)
+		assert.Equal(t, spawned.MaxHP, spawned.HP)
+		assert.Equal(t, "admin", spawned.SpawnedBy)
+		assert.Equal(t, time.Hour, spawned.ExpiresAt.Sub(spawned.SpawnedAt))
+	})
+
+	t.Run("picks a random boss without a key", func(t *testing.T) {
+		f := newBossFixture(t)
+		f.repo.On("GetActiveBoss", ctx).Return(nil, nil)
+		f.repo.On("CreateBoss", ctx, mock.AnythingOfType("*domain.Boss")).Return(true, nil)
+		f.publisher.On("PublishWithRetry", ctx, mock.Anything).Return()
+
+		spawned, err := f.svc.Spawn(ctx, "", boss.SpawnedByScheduler)
+
+		require.NoError(t, err)
+		assert.NotEmpty(t, spawned.Key)
+	})
+
+	t.Run("one boss at a time", func(t *testing.T) {
+		f := newBossFixture(t)
+		f.repo.On("GetActiveBoss", ctx).Return(activeBoss(100), nil)
+
+		_, err := f.svc.Spawn(ctx, "kraken", "admin")
+
+		assert.ErrorIs(t, err, domain.ErrBossAlreadyActive)
+	})
+
+	t.Run("an overdue boss escapes first", func(t *testing.T) {
+		f := newBossFixture(t)
+		overdue := activeBoss(100)
+		overdue.ExpiresAt = time.Now().Add(-time.Minute)
+		f.repo.On("GetActiveBoss", ctx).Return(overdue, nil)
+		f.repo.On("ExpireBoss", ctx, overdue.ID).Return(overdue, nil)
+		f.repo.On("CreateBoss", ctx, mock.AnythingOfType("*domain.Boss")).Return(true, nil)
+		f.publisher.On("PublishWithRetry", ctx, mock.MatchedBy(func(evt event.Event) bool {
+			return evt.Type == event.BossEscaped
+		})).Return().Once()
+		f.publisher.On("PublishWithRetry", ctx, mock.MatchedBy(func(evt event.Event) bool {
+			return evt.Type == event.BossSpawned
+		})).Return().Once()
+
+		_, err := f.svc.Spawn(ctx, "kraken", "admin")
+
+		require.NoError(t, err)
+	})
+
+	t.Run("unknown boss", func(t *testing.T) {
+		f := newBossFixture(t)
+
+		_, err := f.svc.Spawn(ctx, "tax_collector", "admin")
+
+		assert.ErrorIs(t, err, domain.ErrUnknownBoss)
+	})
+}
+
+func TestExpire(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("a boss past its time escapes", func(t *testing.T) {
+		f := newBossFixture(t)
+		escaped := activeBoss(120)
+		f.repo.On("ExpireBoss", ctx, escaped.ID).Return(escaped, nil)
+		f.publisher.On("PublishWithRetry", ctx, mock.MatchedBy(func(evt event.Event) bool {
+			payload, ok := evt.Payload.(event.BossEscapedPayloadV1)
+			return evt.Type == event.BossEscaped && ok && payload.HP == 120
+		})).Return()
+
+		got, err := f.svc.Expire(ctx, escaped.ID)
+
+		require.NoError(t, err)
+		assert.Equal(t, escaped, got)
+	})
+
+	t.Run("a defeated boss is left alone", func(t *testing.T) {
+		f := newBossFixture(t)
+		id := uuid.New()
+		f.repo.On("ExpireBoss", ctx, id).Return(nil, nil)
+
+		got, err := f.svc.Expire(ctx, id)
+
+		require.NoError(t, err)
+		assert.Nil(t, got)
+	})
+}
+
+func TestGetStatus(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("no boss", func(t *testing.T) {
+		f := newBossFixture(t)
+		f.repo.On("GetActiveBoss", ctx).Return(nil, nil)
+
+		status, err := f.svc.GetStatus(ctx)
+
+		require.NoError(t, err)
+		assert.Nil(t, status.Boss)
+		assert.Empty(t, status.TopAttackers)
+	})
+
+	t.Run("lists the top attackers", func(t *testing.T) {
+		f := newBossFixture(t)
+		target := activeBoss(250)
+		f.repo.On("GetActiveBoss", ctx).Return(target, nil)
+		f.repo.On("GetAttackers", ctx, target.ID, boss.TopAttackersLimit).Return([]domain.BossAttacker{{UserID: attackerID, Damage: 250}}, nil)
+
+		status, err := f.svc.GetStatus(ctx)
+
+		require.NoError(t, err)
+		assert.Equal(t, target, status.Boss)
+		assert.Len(t, status.TopAttackers, 1)
+	})
+}
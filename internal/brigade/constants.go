@@ -0,0 +1,31 @@
+package brigade
+
+import "time"
+
+// JobType identifies brigading scans in the worker pool and scheduler
+const JobType = "vote_brigade_scan"
+
+// SystemActor is recorded in the audit trail for automatic exclusions
+const SystemActor = "system"
+
+// Detection defaults, used when the configured values are not positive
+const (
+	DefaultWindow   = 2 * time.Minute
+	DefaultMinVotes = 5
+)
+
+// ReasonNeverActiveBurst describes a flagged vote: burst size, then the span
+// the burst was cast in
+const ReasonNeverActiveBurst = "one of %d votes for the same option from accounts with no prior activity within %s"
+
+// AutoExcludeReason is recorded when detection excludes a vote by itself
+const AutoExcludeReason = "automatic exclusion of a brigading burst"
+
+// Log messages
+const (
+	LogMsgScanCompleted   = "Vote brigading scan completed"
+	LogMsgVoteFlagged     = "Flagged suspect vote"
+	LogMsgAutoExcludeFail = "Failed to auto-exclude suspect vote"
+	LogMsgVoteExcluded    = "Vote excluded from tally"
+	LogMsgVoteRestored    = "Vote restored to tally"
+)
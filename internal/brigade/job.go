@@ -0,0 +1,24 @@
+package brigade
+
+import "context"
+
+// Job scans the open voting session for brigading bursts
+type Job struct {
+	service Service
+}
+
+// NewJob creates a vote brigading scan job
+func NewJob(service Service) *Job {
+	return &Job{service: service}
+}
+
+// JobType returns the worker pool job type
+func (j *Job) JobType() string {
+	return JobType
+}
+
+// Process scans the voting or frozen session, if there is one
+func (j *Job) Process(ctx context.Context) error {
+	_, err := j.service.ScanOpenSession(ctx)
+	return err
+}
@@ -0,0 +1,32 @@
+package brigade
+
+import (
+	"context"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+)
+
+// Repository reads voting sessions and stores vote review state
+type Repository interface {
+	// GetOpenSessionID returns the voting or frozen session, or 0 if there is none
+	GetOpenSessionID(ctx context.Context) (int, error)
+
+	// GetSessionVotes returns every vote in a session in the order cast
+	GetSessionVotes(ctx context.Context, sessionID int) ([]domain.SessionVote, error)
+
+	// FlagSuspectVote marks a vote for review and returns false if it was
+	// already flagged
+	FlagSuspectVote(ctx context.Context, sessionID int, userID, reason string) (bool, error)
+
+	// GetSuspectVotes returns the flagged votes in a session
+	GetSuspectVotes(ctx context.Context, sessionID int) ([]domain.SuspectVote, error)
+
+	// SetVoteExcluded excludes or restores a vote, adjusts its option's vote
+	// count and records the change in the audit trail in one transaction. It
+	// returns false when the vote is not in an open session or is already in
+	// that state.
+	SetVoteExcluded(ctx context.Context, sessionID int, userID string, action domain.VoteReviewAction, actor, reason string) (bool, error)
+
+	// GetAuditTrail returns every exclusion and restoration in a session, oldest first
+	GetAuditTrail(ctx context.Context, sessionID int) ([]domain.VoteReviewAuditEntry, error)
+}
@@ -0,0 +1,267 @@
+package brigade
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/event"
+	"github.com/osse101/BrandishBot_Go/internal/logger"
+)
+
+// ErrVoteNotReviewable is returned when excluding or restoring a vote that is
+// missing, belongs to a closed session, or is already in the requested state
+var ErrVoteNotReviewable = errors.New("vote not found in an open session or already in that state")
+
+// Service detects vote brigading and lets admins exclude or restore suspect votes
+type Service interface {
+	// ScanSession flags bursts of votes from never-active accounts and
+	// returns the votes flagged by this scan. With auto-exclusion enabled
+	// they are also removed from the tally.
+	ScanSession(ctx context.Context, sessionID int) ([]domain.SuspectVote, error)
+
+	// ScanOpenSession scans the voting or frozen session, if there is one
+	ScanOpenSession(ctx context.Context) ([]domain.SuspectVote, error)
+
+	GetSuspectVotes(ctx context.Context, sessionID int) ([]domain.SuspectVote, error)
+
+	// ExcludeVote stops a vote counting towards its option. Only votes in
+	// open sessions can be changed.
+	ExcludeVote(ctx context.Context, sessionID int, userID, actor, reason string) error
+
+	// RestoreVote counts a previously excluded vote again
+	RestoreVote(ctx context.Context, sessionID int, userID, actor, reason string) error
+
+	GetAuditTrail(ctx context.Context, sessionID int) ([]domain.VoteReviewAuditEntry, error)
+}
+
+// Publisher publishes vote review events
+type Publisher interface {
+	PublishWithRetry(ctx context.Context, evt event.Event)
+}
+
+// Config tunes brigading detection
+type Config struct {
+	// Window is the longest span a burst of never-active votes may be cast in
+	Window time.Duration
+	// MinVotes is how many never-active votes for one option within Window
+	// count as a burst
+	MinVotes int
+	// AutoExclude removes flagged votes from the tally instead of leaving
+	// them for an admin to review
+	AutoExclude bool
+}
+
+type service struct {
+	repo      Repository
+	publisher Publisher
+	cfg       Config
+	now       func() time.Time
+}
+
+// NewService creates a vote brigading service. publisher may be nil.
+func NewService(repo Repository, publisher Publisher, cfg Config) Service {
+	if cfg.Window <= 0 {
+		cfg.Window = DefaultWindow
+	}
+	if cfg.MinVotes <= 0 {
+		cfg.MinVotes = DefaultMinVotes
+	}
+	return &service{
+		repo:      repo,
+		publisher: publisher,
+		cfg:       cfg,
+		now:       time.Now,
+	}
+}
+
+// ScanOpenSession scans the voting or frozen session, if there is one
+func (s *service) ScanOpenSession(ctx context.Context) ([]domain.SuspectVote, error) {
+	sessionID, err := s.repo.GetOpenSessionID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get open voting session: %w", err)
+	}
+	if sessionID == 0 {
+		return nil, nil
+	}
+	return s.ScanSession(ctx, sessionID)
+}
+
+// ScanSession flags bursts of votes from never-active accounts
+func (s *service) ScanSession(ctx context.Context, sessionID int) ([]domain.SuspectVote, error) {
+	log := logger.FromContext(ctx)
+
+	votes, err := s.repo.GetSessionVotes(ctx, sessionID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get session votes: %w", err)
+	}
+
+	var flagged []domain.SuspectVote
+	excluded := 0
+	for _, burst := range findBursts(votes, s.cfg.Window, s.cfg.MinVotes) {
+		for _, vote := range burst.votes {
+			if vote.Flagged {
+				continue
+			}
+			ok, err := s.repo.FlagSuspectVote(ctx, sessionID, vote.UserID, burst.reason)
+			if err != nil {
+				return flagged, fmt.Errorf("failed to flag vote: %w", err)
+			}
+			if !ok {
+				continue
+			}
+			log.Info(LogMsgVoteFlagged, "session_id", sessionID, "user_id", vote.UserID, "option_id", vote.OptionID)
+
+			suspect := domain.SuspectVote{
+				SessionID: sessionID,
+				UserID:    vote.UserID,
+				OptionID:  vote.OptionID,
+				VotedAt:   vote.VotedAt,
+				Reason:    burst.reason,
+				FlaggedAt: s.now(),
+			}
+			if s.cfg.AutoExclude && !vote.Excluded {
+				if s.autoExclude(ctx, sessionID, vote.UserID) {
+					excludedAt := s.now()
+					suspect.ExcludedAt = &excludedAt
+					excluded++
+				}
+			}
+			flagged = append(flagged, suspect)
+		}
+	}
+
+	log.Info(LogMsgScanCompleted, "session_id", sessionID, "votes", len(votes), "flagged", len(flagged), "excluded", excluded)
+	if len(flagged) > 0 {
+		s.publish(ctx, event.NewVotesFlaggedEvent(sessionID, len(flagged), excluded, flagged[0].Reason))
+	}
+	return flagged, nil
+}
+
+// autoExclude excludes a flagged vote on behalf of the system. Failures are
+// logged so the vote stays flagged for an admin instead of failing the scan.
+func (s *service) autoExclude(ctx context.Context, sessionID int, userID string) bool {
+	changed, err := s.repo.SetVoteExcluded(ctx, sessionID, userID, domain.VoteReviewExcluded, SystemActor, AutoExcludeReason)
+	if err != nil || !changed {
+		logger.FromContext(ctx).Warn(LogMsgAutoExcludeFail, "session_id", sessionID, "user_id", userID, "error", err)
+		return false
+	}
+	s.publish(ctx, event.NewVoteReviewedEvent(sessionID, userID, domain.VoteReviewExcluded, SystemActor))
+	return true
+}
+
+// GetSuspectVotes returns the flagged votes in a session
+func (s *service) GetSuspectVotes(ctx context.Context, sessionID int) ([]domain.SuspectVote, error) {
+	return s.repo.GetSuspectVotes(ctx, sessionID)
+}
+
+// ExcludeVote stops a vote counting towards its option
+func (s *service) ExcludeVote(ctx context.Context, sessionID int, userID, actor, reason string) error {
+	return s.review(ctx, sessionID, userID, domain.VoteReviewExcluded, actor, reason)
+}
+
+// RestoreVote counts a previously excluded vote again
+func (s *service) RestoreVote(ctx context.Context, sessionID int, userID, actor, reason string) error {
+	return s.review(ctx, sessionID, userID, domain.VoteReviewRestored, actor, reason)
+}
+
+func (s *service) review(ctx context.Context, sessionID int, userID string, action domain.VoteReviewAction, actor, reason string) error {
+	actor = strings.TrimSpace(actor)
+	if userID == "" || actor == "" {
+		return domain.ErrInvalidInput
+	}
+
+	changed, err := s.repo.SetVoteExcluded(ctx, sessionID, userID, action, actor, reason)
+	if err != nil {
+		return fmt.Errorf("failed to update vote: %w", err)
+	}
+	if !changed {
+		return ErrVoteNotReviewable
+	}
+
+	msg := LogMsgVoteExcluded
+	if action == domain.VoteReviewRestored {
+		msg = LogMsgVoteRestored
+	}
+	logger.FromContext(ctx).Info(msg, "session_id", sessionID, "user_id", userID, "actor", actor)
+
+	s.publish(ctx, event.NewVoteReviewedEvent(sessionID, userID, action, actor))
+	return nil
+}
+
+// GetAuditTrail returns every exclusion and restoration in a session
+func (s *service) GetAuditTrail(ctx context.Context, sessionID int) ([]domain.VoteReviewAuditEntry, error) {
+	return s.repo.GetAuditTrail(ctx, sessionID)
+}
+
+func (s *service) publish(ctx context.Context, evt event.Event) {
+	if s.publisher != nil {
+		s.publisher.PublishWithRetry(ctx, evt)
+	}
+}
+
+// burst is a run of never-active votes for one option cast close together
+type burst struct {
+	votes  []domain.SessionVote
+	reason string
+}
+
+// findBursts groups never-active votes by option and returns every run in
+// which at least minVotes were cast within window of each other. Overlapping
+// windows are merged into one burst.
+func findBursts(votes []domain.SessionVote, window time.Duration, minVotes int) []burst {
+	byOption := make(map[int][]domain.SessionVote)
+	var optionIDs []int
+	for _, vote := range votes {
+		if !vote.NeverActive {
+			continue
+		}
+		if _, seen := byOption[vote.OptionID]; !seen {
+			optionIDs = append(optionIDs, vote.OptionID)
+		}
+		byOption[vote.OptionID] = append(byOption[vote.OptionID], vote)
+	}
+	sort.Ints(optionIDs)
+
+	var bursts []burst
+	for _, optionID := range optionIDs {
+		group := byOption[optionID]
+		sort.SliceStable(group, func(i, j int) bool { return group[i].VotedAt.Before(group[j].VotedAt) })
+
+		inBurst := make([]bool, len(group))
+		left := 0
+		for right := range group {
+			for group[right].VotedAt.Sub(group[left].VotedAt) > window {
+				left++
+			}
+			if right-left+1 >= minVotes {
+				for i := left; i <= right; i++ {
+					inBurst[i] = true
+				}
+			}
+		}
+
+		for start := 0; start < len(group); {
+			if !inBurst[start] {
+				start++
+				continue
+			}
+			end := start
+			for end+1 < len(group) && inBurst[end+1] {
+				end++
+			}
+			run := group[start : end+1]
+			span := run[len(run)-1].VotedAt.Sub(run[0].VotedAt).Round(time.Second)
+			bursts = append(bursts, burst{
+				votes:  run,
+				reason: fmt.Sprintf(ReasonNeverActiveBurst, len(run), span),
+			})
+			start = end + 1
+		}
+	}
+	return bursts
+}
@@ -0,0 +1,174 @@
+package brigade_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/osse101/BrandishBot_Go/internal/brigade"
+	"github.com/osse101/BrandishBot_Go/internal/brigade/mocks"
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/event"
+)
+
+const sessionID = 7
+
+func setupServiceTest(t *testing.T, autoExclude bool) (brigade.Service, *mocks.MockRepository, *mocks.MockPublisher) {
+	mockRepo := mocks.NewMockRepository(t)
+	mockPublisher := mocks.NewMockPublisher(t)
+	svc := brigade.NewService(mockRepo, mockPublisher, brigade.Config{
+		Window:      time.Minute,
+		MinVotes:    3,
+		AutoExclude: autoExclude,
+	})
+	return svc, mockRepo, mockPublisher
+}
+
+var sessionStart = time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+
+func vote(userID string, optionID int, offset time.Duration, neverActive bool) domain.SessionVote {
+	return domain.SessionVote{
+		UserID:      userID,
+		OptionID:    optionID,
+		VotedAt:     sessionStart.Add(offset),
+		NeverActive: neverActive,
+	}
+}
+
+func TestScanSession(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("flags a burst of never-active voters on one option", func(t *testing.T) {
+		svc, mockRepo, mockPublisher := setupServiceTest(t, false)
+		mockRepo.On("GetSessionVotes", ctx, sessionID).Return([]domain.SessionVote{
+			vote("regular", 1, 0, false),
+			vote("new-1", 1, 10*time.Second, true),
+			vote("new-2", 1, 20*time.Second, true),
+			vote("new-3", 1, 40*time.Second, true),
+			vote("late", 1, 10*time.Minute, true),
+		}, nil)
+		for _, userID := range []string{"new-1", "new-2", "new-3"} {
+			mockRepo.On("FlagSuspectVote", ctx, sessionID, userID, mock.AnythingOfType("string")).Return(true, nil)
+		}
+		mockPublisher.On("PublishWithRetry", ctx, mock.MatchedBy(func(evt event.Event) bool {
+			payload, ok := evt.Payload.(event.VotesFlaggedPayloadV1)
+			return evt.Type == event.ProgressionVotesFlagged && ok && payload.Flagged == 3 && payload.Excluded == 0
+		})).Return()
+
+		flagged, err := svc.ScanSession(ctx, sessionID)
+
+		require.NoError(t, err)
+		require.Len(t, flagged, 3)
+		assert.Equal(t, "new-1", flagged[0].UserID)
+		assert.Contains(t, flagged[0].Reason, "one of 3 votes")
+		assert.Nil(t, flagged[0].ExcludedAt)
+	})
+
+	t.Run("ignores never-active voters spread across options or time", func(t *testing.T) {
+		svc, mockRepo, _ := setupServiceTest(t, false)
+		mockRepo.On("GetSessionVotes", ctx, sessionID).Return([]domain.SessionVote{
+			vote("new-1", 1, 0, true),
+			vote("new-2", 2, 5*time.Second, true),
+			vote("new-3", 1, 2*time.Minute, true),
+			vote("new-4", 2, 3*time.Minute, true),
+		}, nil)
+
+		flagged, err := svc.ScanSession(ctx, sessionID)
+
+		require.NoError(t, err)
+		assert.Empty(t, flagged)
+	})
+
+	t.Run("skips votes already flagged by an earlier scan", func(t *testing.T) {
+		svc, mockRepo, mockPublisher := setupServiceTest(t, false)
+		flaggedVote := vote("new-1", 1, 0, true)
+		flaggedVote.Flagged = true
+		mockRepo.On("GetSessionVotes", ctx, sessionID).Return([]domain.SessionVote{
+			flaggedVote,
+			vote("new-2", 1, 10*time.Second, true),
+			vote("new-3", 1, 20*time.Second, true),
+		}, nil)
+		mockRepo.On("FlagSuspectVote", ctx, sessionID, "new-2", mock.Anything).Return(true, nil)
+		mockRepo.On("FlagSuspectVote", ctx, sessionID, "new-3", mock.Anything).Return(false, nil)
+		mockPublisher.On("PublishWithRetry", ctx, mock.Anything).Return()
+
+		flagged, err := svc.ScanSession(ctx, sessionID)
+
+		require.NoError(t, err)
+		require.Len(t, flagged, 1)
+		assert.Equal(t, "new-2", flagged[0].UserID)
+	})
+
+	t.Run("auto-excludes flagged votes as the system", func(t *testing.T) {
+		svc, mockRepo, mockPublisher := setupServiceTest(t, true)
+		mockRepo.On("GetSessionVotes", ctx, sessionID).Return([]domain.SessionVote{
+			vote("new-1", 1, 0, true),
+			vote("new-2", 1, time.Second, true),
+			vote("new-3", 1, 2*time.Second, true),
+		}, nil)
+		mockRepo.On("FlagSuspectVote", ctx, sessionID, mock.Anything, mock.Anything).Return(true, nil).Times(3)
+		mockRepo.On("SetVoteExcluded", ctx, sessionID, "new-1", domain.VoteReviewExcluded, brigade.SystemActor, brigade.AutoExcludeReason).Return(true, nil)
+		mockRepo.On("SetVoteExcluded", ctx, sessionID, "new-2", domain.VoteReviewExcluded, brigade.SystemActor, brigade.AutoExcludeReason).Return(true, nil)
+		mockRepo.On("SetVoteExcluded", ctx, sessionID, "new-3", domain.VoteReviewExcluded, brigade.SystemActor, brigade.AutoExcludeReason).Return(false, nil)
+		mockPublisher.On("PublishWithRetry", ctx, mock.MatchedBy(func(evt event.Event) bool {
+			return evt.Type == event.ProgressionVoteReviewed
+		})).Return().Times(2)
+		mockPublisher.On("PublishWithRetry", ctx, mock.MatchedBy(func(evt event.Event) bool {
+			payload, ok := evt.Payload.(event.VotesFlaggedPayloadV1)
+			return ok && payload.Flagged == 3 && payload.Excluded == 2
+		})).Return()
+
+		flagged, err := svc.ScanSession(ctx, sessionID)
+
+		require.NoError(t, err)
+		require.Len(t, flagged, 3)
+		assert.NotNil(t, flagged[0].ExcludedAt)
+		assert.Nil(t, flagged[2].ExcludedAt, "a failed exclusion leaves the vote for admin review")
+	})
+}
+
+func TestScanOpenSession_NoSession(t *testing.T) {
+	svc, mockRepo, _ := setupServiceTest(t, false)
+	mockRepo.On("GetOpenSessionID", mock.Anything).Return(0, nil)
+
+	flagged, err := svc.ScanOpenSession(context.Background())
+
+	require.NoError(t, err)
+	assert.Empty(t, flagged)
+}
+
+func TestReviewVote(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("excludes a vote and publishes the review", func(t *testing.T) {
+		svc, mockRepo, mockPublisher := setupServiceTest(t, false)
+		mockRepo.On("SetVoteExcluded", ctx, sessionID, "user-1", domain.VoteReviewExcluded, "mod", "alt account").Return(true, nil)
+		mockPublisher.On("PublishWithRetry", ctx, mock.MatchedBy(func(evt event.Event) bool {
+			payload, ok := evt.Payload.(event.VoteReviewedPayloadV1)
+			return ok && payload.Action == string(domain.VoteReviewExcluded) && payload.Actor == "mod"
+		})).Return()
+
+		require.NoError(t, svc.ExcludeVote(ctx, sessionID, "user-1", "mod", "alt account"))
+	})
+
+	t.Run("restoring a vote that is not excluded is rejected", func(t *testing.T) {
+		svc, mockRepo, _ := setupServiceTest(t, false)
+		mockRepo.On("SetVoteExcluded", ctx, sessionID, "user-1", domain.VoteReviewRestored, "mod", "").Return(false, nil)
+
+		err := svc.RestoreVote(ctx, sessionID, "user-1", "mod", "")
+
+		assert.ErrorIs(t, err, brigade.ErrVoteNotReviewable)
+	})
+
+	t.Run("requires an actor for the audit trail", func(t *testing.T) {
+		svc, _, _ := setupServiceTest(t, false)
+
+		err := svc.ExcludeVote(ctx, sessionID, "user-1", "  ", "")
+
+		assert.ErrorIs(t, err, domain.ErrInvalidInput)
+	})
+}
@@ -0,0 +1,15 @@
+package celebration
+
+// JobType identifies celebration runs in the worker pool and scheduler
+const JobType = "celebrations"
+
+// Log messages
+const (
+	LogMsgRunStarting     = "Starting celebration run"
+	LogMsgRunCompleted    = "Celebration run completed"
+	LogMsgGranted         = "Granted celebration reward"
+	LogMsgGrantFailed     = "Failed to grant celebration reward"
+	LogMsgReleaseFailed   = "Failed to release celebration grant"
+	LogMsgBirthdaySet     = "Birthday registered"
+	LogMsgBirthdayCleared = "Birthday cleared"
+)
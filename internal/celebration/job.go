@@ -0,0 +1,28 @@
+package celebration
+
+import (
+	"context"
+	"time"
+)
+
+// Job grants the current day's birthday and anniversary rewards
+type Job struct {
+	service Service
+	now     func() time.Time
+}
+
+// NewJob creates a celebration job
+func NewJob(service Service) *Job {
+	return &Job{service: service, now: time.Now}
+}
+
+// JobType returns the worker pool job type
+func (j *Job) JobType() string {
+	return JobType
+}
+
+// Process grants rewards for today (UTC)
+func (j *Job) Process(ctx context.Context) error {
+	_, err := j.service.GrantForDate(ctx, j.now())
+	return err
+}
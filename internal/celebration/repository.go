@@ -0,0 +1,41 @@
+package celebration
+
+import (
+	"context"
+	"time"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+)
+
+// Repository stores birthdays, granted celebrations and guild settings
+type Repository interface {
+	// SetBirthday stores or replaces a user's birthday
+	SetBirthday(ctx context.Context, userID string, birthday domain.Birthday) error
+
+	// ClearBirthday removes a user's birthday and reports whether one was set
+	ClearBirthday(ctx context.Context, userID string) (bool, error)
+
+	// GetBirthday returns a user's birthday, or nil if none is registered
+	GetBirthday(ctx context.Context, userID string) (*domain.Birthday, error)
+
+	// ListBirthdays returns users whose birthday falls on the month and day
+	ListBirthdays(ctx context.Context, month, day int) ([]domain.Celebrant, error)
+
+	// ListAnniversaries returns users who registered on the month and day of
+	// a year before registeredBefore
+	ListAnniversaries(ctx context.Context, month, day int, registeredBefore time.Time) ([]domain.Celebrant, error)
+
+	// ClaimGrant records a celebration for the year and returns false if it
+	// was already granted
+	ClaimGrant(ctx context.Context, userID, kind string, year int) (bool, error)
+
+	// ReleaseGrant removes a claimed grant so a later run can retry it
+	ReleaseGrant(ctx context.Context, userID, kind string, year int) error
+
+	// IsGuildEnabled reports whether a Discord guild wants celebration
+	// announcements; unknown guilds are disabled
+	IsGuildEnabled(ctx context.Context, guildID string) (bool, error)
+
+	// SetGuildEnabled turns announcements on or off for a Discord guild
+	SetGuildEnabled(ctx context.Context, guildID string, enabled bool) error
+}
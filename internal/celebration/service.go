@@ -0,0 +1,233 @@
+package celebration
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/event"
+	"github.com/osse101/BrandishBot_Go/internal/logger"
+)
+
+// Service manages birthday registration and grants birthday and account
+// anniversary rewards
+type Service interface {
+	SetBirthday(ctx context.Context, platform, platformID, username string, birthday domain.Birthday) error
+	ClearBirthday(ctx context.Context, platform, platformID string) error
+	GetBirthday(ctx context.Context, platform, platformID string) (*domain.Birthday, error)
+
+	// GrantForDate rewards every birthday and anniversary falling on the
+	// date (UTC). Each user is rewarded at most once per kind and year, so
+	// reruns only pick up grants that failed.
+	GrantForDate(ctx context.Context, date time.Time) ([]domain.CelebrationGrant, error)
+
+	IsGuildEnabled(ctx context.Context, guildID string) (bool, error)
+	SetGuildEnabled(ctx context.Context, guildID string, enabled bool) error
+}
+
+// UserService defines the user operations needed by the celebration service
+type UserService interface {
+	GetUserOrRegister(ctx context.Context, platform, platformID, username string) (*domain.User, error)
+	GetUserIDByPlatformID(ctx context.Context, platform, platformID string) (string, error)
+	GetItemByName(ctx context.Context, name string) (*domain.Item, error)
+	GrantItemReward(ctx context.Context, user *domain.User, item *domain.Item, quantity int, qualityLevel domain.QualityLevel) error
+}
+
+// Publisher publishes celebration events
+type Publisher interface {
+	PublishWithRetry(ctx context.Context, evt event.Event)
+}
+
+type service struct {
+	repo       Repository
+	users      UserService
+	publisher  Publisher
+	rewardItem string
+}
+
+// NewService creates a celebration service that grants one rewardItem per
+// birthday or anniversary. publisher may be nil.
+func NewService(repo Repository, users UserService, publisher Publisher, rewardItem string) Service {
+	return &service{
+		repo:       repo,
+		users:      users,
+		publisher:  publisher,
+		rewardItem: rewardItem,
+	}
+}
+
+// SetBirthday registers the user's birthday, registering the user if needed
+func (s *service) SetBirthday(ctx context.Context, platform, platformID, username string, birthday domain.Birthday) error {
+	if err := birthday.Validate(); err != nil {
+		return err
+	}
+	user, err := s.users.GetUserOrRegister(ctx, platform, platformID, username)
+	if err != nil {
+		return err
+	}
+	if err := s.repo.SetBirthday(ctx, user.ID, birthday); err != nil {
+		return err
+	}
+	logger.FromContext(ctx).Info(LogMsgBirthdaySet, "user_id", user.ID)
+	return nil
+}
+
+// ClearBirthday removes the user's birthday. Clearing an unset birthday is not an error.
+func (s *service) ClearBirthday(ctx context.Context, platform, platformID string) error {
+	userID, err := s.resolveUserID(ctx, platform, platformID)
+	if err != nil {
+		return err
+	}
+	cleared, err := s.repo.ClearBirthday(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if cleared {
+		logger.FromContext(ctx).Info(LogMsgBirthdayCleared, "user_id", userID)
+	}
+	return nil
+}
+
+// GetBirthday returns the user's birthday, or nil if none is registered
+func (s *service) GetBirthday(ctx context.Context, platform, platformID string) (*domain.Birthday, error) {
+	userID, err := s.resolveUserID(ctx, platform, platformID)
+	if err != nil {
+		return nil, err
+	}
+	return s.repo.GetBirthday(ctx, userID)
+}
+
+func (s *service) resolveUserID(ctx context.Context, platform, platformID string) (string, error) {
+	userID, err := s.users.GetUserIDByPlatformID(ctx, platform, platformID)
+	if err != nil {
+		return "", err
+	}
+	if userID == "" {
+		return "", domain.ErrUserNotFound
+	}
+	return userID, nil
+}
+
+// GrantForDate rewards every birthday and anniversary falling on the date
+func (s *service) GrantForDate(ctx context.Context, date time.Time) ([]domain.CelebrationGrant, error) {
+	log := logger.FromContext(ctx)
+	date = date.UTC()
+	log.Info(LogMsgRunStarting, "date", date.Format(time.DateOnly))
+
+	item, err := s.users.GetItemByName(ctx, s.rewardItem)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get celebration reward item: %w", err)
+	}
+	if item == nil {
+		return nil, fmt.Errorf("celebration reward item %q: %w", s.rewardItem, domain.ErrItemNotFound)
+	}
+
+	birthdays, anniversaries, err := s.celebrants(ctx, date)
+	if err != nil {
+		return nil, err
+	}
+
+	pending := make([]domain.CelebrationGrant, 0, len(birthdays)+len(anniversaries))
+	for _, c := range birthdays {
+		pending = append(pending, domain.CelebrationGrant{
+			UserID:   c.UserID,
+			Username: c.Username,
+			Kind:     domain.CelebrationBirthday,
+		})
+	}
+	for _, c := range anniversaries {
+		pending = append(pending, domain.CelebrationGrant{
+			UserID:   c.UserID,
+			Username: c.Username,
+			Kind:     domain.CelebrationAnniversary,
+			Years:    date.Year() - c.RegisteredAt.Year(),
+		})
+	}
+
+	var grants []domain.CelebrationGrant
+	var errs []error
+	for _, grant := range pending {
+		grant.ItemName = item.InternalName
+		grant.Quantity = 1
+		if ok, err := s.grant(ctx, grant, item, date.Year()); err != nil {
+			errs = append(errs, err)
+		} else if ok {
+			grants = append(grants, grant)
+		}
+	}
+
+	log.Info(LogMsgRunCompleted, "date", date.Format(time.DateOnly), "granted", len(grants), "failed", len(errs))
+	return grants, errors.Join(errs...)
+}
+
+// celebrants lists the day's birthdays and anniversaries. Outside leap years,
+// 29 February is celebrated on the 28th.
+func (s *service) celebrants(ctx context.Context, date time.Time) (birthdays, anniversaries []domain.Celebrant, err error) {
+	month, day := int(date.Month()), date.Day()
+	startOfDay := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, time.UTC)
+
+	days := []int{day}
+	if month == 2 && day == 28 && !isLeapYear(date.Year()) {
+		days = append(days, 29)
+	}
+
+	for _, d := range days {
+		b, err := s.repo.ListBirthdays(ctx, month, d)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to list birthdays: %w", err)
+		}
+		birthdays = append(birthdays, b...)
+
+		a, err := s.repo.ListAnniversaries(ctx, month, d, startOfDay)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to list anniversaries: %w", err)
+		}
+		anniversaries = append(anniversaries, a...)
+	}
+	return birthdays, anniversaries, nil
+}
+
+// grant claims the celebration for the year, then grants the item. A failed
+// item grant releases the claim so the next run retries it.
+func (s *service) grant(ctx context.Context, grant domain.CelebrationGrant, item *domain.Item, year int) (bool, error) {
+	log := logger.FromContext(ctx)
+
+	claimed, err := s.repo.ClaimGrant(ctx, grant.UserID, grant.Kind, year)
+	if err != nil {
+		log.Error(LogMsgGrantFailed, "user_id", grant.UserID, "kind", grant.Kind, "error", err)
+		return false, err
+	}
+	if !claimed {
+		return false, nil
+	}
+
+	if err := s.users.GrantItemReward(ctx, &domain.User{ID: grant.UserID}, item, grant.Quantity, domain.QualityCommon); err != nil {
+		log.Error(LogMsgGrantFailed, "user_id", grant.UserID, "kind", grant.Kind, "error", err)
+		if relErr := s.repo.ReleaseGrant(ctx, grant.UserID, grant.Kind, year); relErr != nil {
+			log.Error(LogMsgReleaseFailed, "user_id", grant.UserID, "kind", grant.Kind, "error", relErr)
+		}
+		return false, err
+	}
+
+	log.Info(LogMsgGranted, "user_id", grant.UserID, "kind", grant.Kind, "years", grant.Years)
+	if s.publisher != nil {
+		s.publisher.PublishWithRetry(ctx, event.NewCelebrationGrantedEvent(grant))
+	}
+	return true, nil
+}
+
+// IsGuildEnabled reports whether a Discord guild wants celebration announcements
+func (s *service) IsGuildEnabled(ctx context.Context, guildID string) (bool, error) {
+	return s.repo.IsGuildEnabled(ctx, guildID)
+}
+
+// SetGuildEnabled turns celebration announcements on or off for a Discord guild
+func (s *service) SetGuildEnabled(ctx context.Context, guildID string, enabled bool) error {
+	return s.repo.SetGuildEnabled(ctx, guildID, enabled)
+}
+
+func isLeapYear(year int) bool {
+	return year%4 == 0 && (year%100 != 0 || year%400 == 0)
+}
@@ -0,0 +1,189 @@
+package celebration_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/osse101/BrandishBot_Go/internal/celebration"
+	"github.com/osse101/BrandishBot_Go/internal/celebration/mocks"
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/event"
+)
+
+const rewardItem = "lootbox_tier1"
+
+func TestSetBirthday(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("stores month and day for the registered user", func(t *testing.T) {
+		mockRepo := mocks.NewMockRepository(t)
+		mockUsers := mocks.NewMockUserService(t)
+		mockPublisher := mocks.NewMockPublisher(t)
+		svc := celebration.NewService(mockRepo, mockUsers, mockPublisher, rewardItem)
+
+		mockUsers.On("GetUserOrRegister", ctx, domain.PlatformDiscord, "d-1", "alice").Return(&domain.User{ID: "user-1"}, nil)
+		mockRepo.On("SetBirthday", ctx, "user-1", domain.Birthday{Month: 2, Day: 29}).Return(nil)
+
+		err := svc.SetBirthday(ctx, domain.PlatformDiscord, "d-1", "alice", domain.Birthday{Month: 2, Day: 29})
+
+		require.NoError(t, err)
+	})
+
+	invalid := []domain.Birthday{
+		{Month: 0, Day: 1},
+		{Month: 13, Day: 1},
+		{Month: 4, Day: 31},
+		{Month: 2, Day: 30},
+		{Month: 1, Day: 0},
+	}
+	for _, b := range invalid {
+		t.Run("rejects impossible dates", func(t *testing.T) {
+			mockRepo := mocks.NewMockRepository(t)
+			mockUsers := mocks.NewMockUserService(t)
+			mockPublisher := mocks.NewMockPublisher(t)
+			svc := celebration.NewService(mockRepo, mockUsers, mockPublisher, rewardItem)
+
+			err := svc.SetBirthday(ctx, domain.PlatformDiscord, "d-1", "alice", b)
+
+			assert.ErrorIs(t, err, domain.ErrInvalidBirthday)
+		})
+	}
+}
+
+func TestClearBirthday_UnknownUser(t *testing.T) {
+	ctx := context.Background()
+	mockRepo := mocks.NewMockRepository(t)
+	mockUsers := mocks.NewMockUserService(t)
+	mockPublisher := mocks.NewMockPublisher(t)
+	svc := celebration.NewService(mockRepo, mockUsers, mockPublisher, rewardItem)
+
+	mockUsers.On("GetUserIDByPlatformID", ctx, domain.PlatformDiscord, "d-1").Return("", nil)
+
+	err := svc.ClearBirthday(ctx, domain.PlatformDiscord, "d-1")
+
+	assert.ErrorIs(t, err, domain.ErrUserNotFound)
+}
+
+func TestGrantForDate(t *testing.T) {
+	ctx := context.Background()
+	lootbox := &domain.Item{ID: 7, InternalName: rewardItem}
+
+	t.Run("grants birthdays and anniversaries and publishes them", func(t *testing.T) {
+		mockRepo := mocks.NewMockRepository(t)
+		mockUsers := mocks.NewMockUserService(t)
+		mockPublisher := mocks.NewMockPublisher(t)
+		svc := celebration.NewService(mockRepo, mockUsers, mockPublisher, rewardItem)
+
+		date := time.Date(2026, time.June, 15, 15, 0, 0, 0, time.UTC)
+		startOfDay := time.Date(2026, time.June, 15, 0, 0, 0, 0, time.UTC)
+
+		mockUsers.On("GetItemByName", ctx, rewardItem).Return(lootbox, nil)
+		mockRepo.On("ListBirthdays", ctx, 6, 15).Return([]domain.Celebrant{{UserID: "user-1", Username: "alice"}}, nil)
+		mockRepo.On("ListAnniversaries", ctx, 6, 15, startOfDay).Return([]domain.Celebrant{
+			{UserID: "user-2", Username: "bob", RegisteredAt: time.Date(2023, time.June, 15, 20, 0, 0, 0, time.UTC)},
+		}, nil)
+		mockRepo.On("ClaimGrant", ctx, "user-1", domain.CelebrationBirthday, 2026).Return(true, nil)
+		mockRepo.On("ClaimGrant", ctx, "user-2", domain.CelebrationAnniversary, 2026).Return(true, nil)
+		mockUsers.On("GrantItemReward", ctx, &domain.User{ID: "user-1"}, lootbox, 1, domain.QualityCommon).Return(nil)
+		mockUsers.On("GrantItemReward", ctx, &domain.User{ID: "user-2"}, lootbox, 1, domain.QualityCommon).Return(nil)
+		mockPublisher.On("PublishWithRetry", ctx, mock.MatchedBy(func(evt event.Event) bool {
+			return evt.Type == event.CelebrationGranted
+		})).Times(2)
+
+		grants, err := svc.GrantForDate(ctx, date)
+
+		require.NoError(t, err)
+		require.Len(t, grants, 2)
+		assert.Equal(t, domain.CelebrationBirthday, grants[0].Kind)
+		assert.Equal(t, domain.CelebrationAnniversary, grants[1].Kind)
+		assert.Equal(t, 3, grants[1].Years)
+		assert.Equal(t, rewardItem, grants[1].ItemName)
+	})
+
+	t.Run("29 February is celebrated on the 28th outside leap years", func(t *testing.T) {
+		mockRepo := mocks.NewMockRepository(t)
+		mockUsers := mocks.NewMockUserService(t)
+		mockPublisher := mocks.NewMockPublisher(t)
+		svc := celebration.NewService(mockRepo, mockUsers, mockPublisher, rewardItem)
+
+		date := time.Date(2027, time.February, 28, 12, 0, 0, 0, time.UTC)
+
+		mockUsers.On("GetItemByName", ctx, rewardItem).Return(lootbox, nil)
+		mockRepo.On("ListBirthdays", ctx, 2, 28).Return(nil, nil)
+		mockRepo.On("ListBirthdays", ctx, 2, 29).Return([]domain.Celebrant{{UserID: "user-1", Username: "alice"}}, nil)
+		mockRepo.On("ListAnniversaries", ctx, 2, mock.Anything, mock.Anything).Return(nil, nil).Times(2)
+		mockRepo.On("ClaimGrant", ctx, "user-1", domain.CelebrationBirthday, 2027).Return(true, nil)
+		mockUsers.On("GrantItemReward", ctx, mock.Anything, lootbox, 1, domain.QualityCommon).Return(nil)
+		mockPublisher.On("PublishWithRetry", ctx, mock.Anything)
+
+		grants, err := svc.GrantForDate(ctx, date)
+
+		require.NoError(t, err)
+		assert.Len(t, grants, 1)
+	})
+
+	t.Run("already granted users are skipped", func(t *testing.T) {
+		mockRepo := mocks.NewMockRepository(t)
+		mockUsers := mocks.NewMockUserService(t)
+		mockPublisher := mocks.NewMockPublisher(t)
+		svc := celebration.NewService(mockRepo, mockUsers, mockPublisher, rewardItem)
+
+		date := time.Date(2026, time.June, 15, 0, 0, 0, 0, time.UTC)
+
+		mockUsers.On("GetItemByName", ctx, rewardItem).Return(lootbox, nil)
+		mockRepo.On("ListBirthdays", ctx, 6, 15).Return([]domain.Celebrant{{UserID: "user-1", Username: "alice"}}, nil)
+		mockRepo.On("ListAnniversaries", ctx, 6, 15, mock.Anything).Return(nil, nil)
+		mockRepo.On("ClaimGrant", ctx, "user-1", domain.CelebrationBirthday, 2026).Return(false, nil)
+
+		grants, err := svc.GrantForDate(ctx, date)
+
+		require.NoError(t, err)
+		assert.Empty(t, grants)
+	})
+
+	t.Run("a failed grant releases the claim and does not block others", func(t *testing.T) {
+		mockRepo := mocks.NewMockRepository(t)
+		mockUsers := mocks.NewMockUserService(t)
+		mockPublisher := mocks.NewMockPublisher(t)
+		svc := celebration.NewService(mockRepo, mockUsers, mockPublisher, rewardItem)
+
+		date := time.Date(2026, time.June, 15, 0, 0, 0, 0, time.UTC)
+
+		mockUsers.On("GetItemByName", ctx, rewardItem).Return(lootbox, nil)
+		mockRepo.On("ListBirthdays", ctx, 6, 15).Return([]domain.Celebrant{
+			{UserID: "user-1", Username: "alice"},
+			{UserID: "user-2", Username: "bob"},
+		}, nil)
+		mockRepo.On("ListAnniversaries", ctx, 6, 15, mock.Anything).Return(nil, nil)
+		mockRepo.On("ClaimGrant", ctx, mock.Anything, domain.CelebrationBirthday, 2026).Return(true, nil).Times(2)
+		mockUsers.On("GrantItemReward", ctx, &domain.User{ID: "user-1"}, lootbox, 1, domain.QualityCommon).Return(errors.New("db down"))
+		mockRepo.On("ReleaseGrant", ctx, "user-1", domain.CelebrationBirthday, 2026).Return(nil)
+		mockUsers.On("GrantItemReward", ctx, &domain.User{ID: "user-2"}, lootbox, 1, domain.QualityCommon).Return(nil)
+		mockPublisher.On("PublishWithRetry", ctx, mock.Anything)
+
+		grants, err := svc.GrantForDate(ctx, date)
+
+		assert.Error(t, err)
+		require.Len(t, grants, 1)
+		assert.Equal(t, "user-2", grants[0].UserID)
+	})
+
+	t.Run("missing reward item aborts the run", func(t *testing.T) {
+		mockRepo := mocks.NewMockRepository(t)
+		mockUsers := mocks.NewMockUserService(t)
+		mockPublisher := mocks.NewMockPublisher(t)
+		svc := celebration.NewService(mockRepo, mockUsers, mockPublisher, rewardItem)
+
+		mockUsers.On("GetItemByName", ctx, rewardItem).Return(nil, nil)
+
+		_, err := svc.GrantForDate(ctx, time.Now())
+
+		assert.ErrorIs(t, err, domain.ErrItemNotFound)
+	})
+}
@@ -0,0 +1,83 @@
+package chatcommand
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// ErrUsage is returned by a request builder when the arguments do not fit
+// the command, so the usage is sent back instead
+var ErrUsage = errors.New("usage")
+
+// Sender is the chat user a command runs as
+type Sender struct {
+	Platform   string
+	PlatformID string
+	Username   string
+}
+
+// Command maps a text command onto an API endpoint, so it runs with the
+// endpoint's validation, guards and side effects
+type Command struct {
+	Name    string
+	Aliases []string
+	// Usage is the argument synopsis shown after the name, e.g. "<item> [quantity]"
+	Usage string
+	// Feature is the progression feature that must be unlocked, empty for none
+	Feature string
+	MinArgs int
+
+	Method string
+	Path   string
+	// Body builds the JSON request body; Query builds the query string instead
+	Body  func(s Sender, args []string) (interface{}, error)
+	Query func(s Sender, args []string) (url.Values, error)
+	// Reply formats a successful response; nil uses its "message" field
+	Reply func(body []byte) string
+}
+
+// ItemAndQuantity reads "<item words...> [quantity]". A trailing number is
+// the quantity, so item names with spaces work without quotes.
+func ItemAndQuantity(args []string) (item string, quantity int, err error) {
+	quantity = 1
+	if len(args) > 1 {
+		if n, convErr := strconv.Atoi(args[len(args)-1]); convErr == nil {
+			quantity = n
+			args = args[:len(args)-1]
+		}
+	}
+	item = strings.Join(args, " ")
+	if item == "" {
+		return "", 0, ErrUsage
+	}
+	return item, quantity, nil
+}
+
+// Int reads a whole-number argument, naming it in the error
+func Int(name, arg string) (int, error) {
+	n, err := strconv.Atoi(arg)
+	if err != nil {
+		return 0, fmt.Errorf(ReplyNumberArgFormat, name)
+	}
+	return n, nil
+}
+
+// Username strips the @ chat users put in front of names
+func Username(arg string) string {
+	return strings.TrimPrefix(arg, "@")
+}
+
+// SplitMentions separates @mentions from the other arguments
+func SplitMentions(args []string) (rest, mentions []string) {
+	for _, arg := range args {
+		if len(arg) > 1 && strings.HasPrefix(arg, "@") {
+			mentions = append(mentions, Username(arg))
+			continue
+		}
+		rest = append(rest, arg)
+	}
+	return rest, mentions
+}
@@ -0,0 +1,23 @@
+package chatcommand
+
+// Built-in commands
+const (
+	// CommandHelp lists the commands a chat can use, or shows one's usage
+	CommandHelp = "help"
+)
+
+// Replies
+const (
+	ReplyUsageFormat       = "Usage: %s%s %s"
+	ReplyHelpFormat        = "Commands: %s"
+	ReplyHelpNone          = "No commands are available yet"
+	ReplyDone              = "Done"
+	ReplyNumberArgFormat   = "%s must be a whole number"
+	ReplyUnterminatedQuote = "Missing closing quote"
+)
+
+// Log messages
+const (
+	LogMsgCommandRun         = "Chat command run"
+	LogMsgFeatureCheckFailed = "Failed to check chat command feature"
+)
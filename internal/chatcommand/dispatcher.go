@@ -0,0 +1,244 @@
+package chatcommand
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/handler"
+	"github.com/osse101/BrandishBot_Go/internal/logger"
+)
+
+// FeatureChecker reports whether a progression feature is unlocked
+type FeatureChecker interface {
+	IsFeatureUnlocked(ctx context.Context, featureKey string) (bool, error)
+}
+
+// Dispatcher runs text commands from chat messages as requests to the API
+type Dispatcher struct {
+	prefix   string
+	api      http.Handler
+	features FeatureChecker
+	commands []Command
+	byName   map[string]int
+}
+
+// NewDispatcher creates a dispatcher for commands starting with prefix. api
+// serves the command requests, normally the server's own router, so a
+// command goes through the same middleware as a direct API call.
+func NewDispatcher(prefix string, api http.Handler, features FeatureChecker, commands []Command) *Dispatcher {
+	d := &Dispatcher{
+		prefix:   prefix,
+		api:      api,
+		features: features,
+		commands: commands,
+		byName:   make(map[string]int),
+	}
+	for i, cmd := range commands {
+		d.byName[cmd.Name] = i
+		for _, alias := range cmd.Aliases {
+			d.byName[alias] = i
+		}
+	}
+	return d
+}
+
+// Dispatch runs the command in a chat message on behalf of its sender. It
+// returns nil when the message is not one of its commands, so messages
+// meant for other chat bots are left alone.
+func (d *Dispatcher) Dispatch(r *http.Request, platform, platformID, username, message string) *domain.CommandResult {
+	inv, ok, parseErr := Parse(d.prefix, message)
+	if !ok {
+		return nil
+	}
+	if inv.Name == CommandHelp {
+		return d.help(r.Context(), inv)
+	}
+	i, known := d.byName[inv.Name]
+	if !known {
+		return nil
+	}
+	cmd := d.commands[i]
+
+	result := &domain.CommandResult{Name: cmd.Name, Args: inv.Args}
+	if parseErr != nil {
+		return fail(result, handler.ErrCodeBadRequest, parseErr.Error())
+	}
+
+	if cmd.Feature != "" {
+		unlocked, err := d.features.IsFeatureUnlocked(r.Context(), cmd.Feature)
+		if err != nil {
+			logger.FromContext(r.Context()).Error(LogMsgFeatureCheckFailed, "command", cmd.Name, "feature", cmd.Feature, "error", err)
+			return fail(result, handler.ErrCodeInternal, handler.ErrMsgGenericServerError)
+		}
+		if !unlocked {
+			return fail(result, handler.ErrCodeFeatureLocked, handler.ErrMsgFeatureLockedError)
+		}
+	}
+
+	sender := Sender{Platform: platform, PlatformID: platformID, Username: username}
+	req, err := d.buildRequest(r, cmd, sender, inv.Args)
+	if err != nil {
+		if errors.Is(err, ErrUsage) {
+			return fail(result, handler.ErrCodeBadRequest, d.usage(cmd))
+		}
+		return fail(result, handler.ErrCodeBadRequest, err.Error())
+	}
+
+	rec := &responseCapture{header: make(http.Header)}
+	d.api.ServeHTTP(rec, req)
+	status := rec.status
+	if status == 0 {
+		status = http.StatusOK
+	}
+	body := rec.body.Bytes()
+	logger.FromContext(r.Context()).Info(LogMsgCommandRun, "command", cmd.Name, "platform", platform, "username", username, "status", status)
+
+	if json.Valid(body) {
+		result.Data = append(json.RawMessage(nil), body...)
+	}
+	if status >= http.StatusBadRequest {
+		var errResp handler.ErrorResponse
+		_ = json.Unmarshal(body, &errResp)
+		if errResp.Code == "" {
+			errResp.Code = handler.ErrorCodeForStatus(status)
+		}
+		if errResp.Message == "" {
+			errResp.Message = http.StatusText(status)
+		}
+		return fail(result, errResp.Code, errResp.Message)
+	}
+
+	result.OK = true
+	result.Reply = replyFor(cmd, body)
+	return result
+}
+
+// Prefix returns the prefix commands start with
+func (d *Dispatcher) Prefix() string {
+	return d.prefix
+}
+
+func (d *Dispatcher) buildRequest(r *http.Request, cmd Command, sender Sender, args []string) (*http.Request, error) {
+	if len(args) < cmd.MinArgs {
+		return nil, ErrUsage
+	}
+
+	target := cmd.Path
+	var body []byte
+	switch {
+	case cmd.Query != nil:
+		query, err := cmd.Query(sender, args)
+		if err != nil {
+			return nil, err
+		}
+		target += "?" + query.Encode()
+	case cmd.Body != nil:
+		payload, err := cmd.Body(sender, args)
+		if err != nil {
+			return nil, err
+		}
+		if body, err = json.Marshal(payload); err != nil {
+			return nil, fmt.Errorf("failed to encode %s request: %w", cmd.Name, err)
+		}
+	}
+
+	req, err := http.NewRequestWithContext(r.Context(), cmd.Method, target, bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("failed to build %s request: %w", cmd.Name, err)
+	}
+	// The command runs with the caller's credentials and community
+	req.Header = r.Header.Clone()
+	req.Header.Del("Content-Length")
+	req.Header.Set("Content-Type", "application/json")
+	req.RemoteAddr = r.RemoteAddr
+	return req, nil
+}
+
+// help lists the commands whose features are unlocked, or shows the usage
+// of the command named in its argument
+func (d *Dispatcher) help(ctx context.Context, inv Invocation) *domain.CommandResult {
+	result := &domain.CommandResult{Name: CommandHelp, Args: inv.Args, OK: true}
+	if len(inv.Args) > 0 {
+		if i, ok := d.byName[strings.ToLower(Username(inv.Args[0]))]; ok {
+			result.Reply = d.usage(d.commands[i])
+			return result
+		}
+	}
+
+	names := make([]string, 0, len(d.commands))
+	for _, cmd := range d.commands {
+		if cmd.Feature != "" {
+			unlocked, err := d.features.IsFeatureUnlocked(ctx, cmd.Feature)
+			if err != nil {
+				logger.FromContext(ctx).Warn(LogMsgFeatureCheckFailed, "command", cmd.Name, "feature", cmd.Feature, "error", err)
+				continue
+			}
+			if !unlocked {
+				continue
+			}
+		}
+		names = append(names, d.prefix+cmd.Name)
+	}
+	if len(names) == 0 {
+		result.Reply = ReplyHelpNone
+		return result
+	}
+	result.Reply = fmt.Sprintf(ReplyHelpFormat, strings.Join(names, ", "))
+	return result
+}
+
+func (d *Dispatcher) usage(cmd Command) string {
+	return strings.TrimSpace(fmt.Sprintf(ReplyUsageFormat, d.prefix, cmd.Name, cmd.Usage))
+}
+
+func replyFor(cmd Command, body []byte) string {
+	if cmd.Reply != nil {
+		if reply := cmd.Reply(body); reply != "" {
+			return reply
+		}
+	}
+	var msg struct {
+		Message string `json:"message"`
+	}
+	if err := json.Unmarshal(body, &msg); err == nil && msg.Message != "" {
+		return msg.Message
+	}
+	return ReplyDone
+}
+
+func fail(result *domain.CommandResult, code handler.ErrorCode, reply string) *domain.CommandResult {
+	result.OK = false
+	result.Code = string(code)
+	result.Reply = reply
+	return result
+}
+
+// responseCapture keeps the response to a command request in memory
+type responseCapture struct {
+	header http.Header
+	status int
+	body   bytes.Buffer
+}
+
+func (c *responseCapture) Header() http.Header {
+	return c.header
+}
+
+func (c *responseCapture) WriteHeader(status int) {
+	if c.status == 0 {
+		c.status = status
+	}
+}
+
+func (c *responseCapture) Write(b []byte) (int, error) {
+	if c.status == 0 {
+		c.status = http.StatusOK
+	}
+	return c.body.Write(b)
+}
@@ -0,0 +1,184 @@
+package chatcommand
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/osse101/BrandishBot_Go/internal/handler"
+)
+
+type fakeFeatures struct {
+	unlocked map[string]bool
+	err      error
+}
+
+func (f fakeFeatures) IsFeatureUnlocked(_ context.Context, featureKey string) (bool, error) {
+	return f.unlocked[featureKey], f.err
+}
+
+type buyBody struct {
+	Username string `json:"username"`
+	ItemName string `json:"item_name"`
+	Quantity int    `json:"quantity"`
+}
+
+// recordingAPI answers every request with the given status and body and
+// keeps the last request it saw
+type recordingAPI struct {
+	status int
+	body   string
+	req    *http.Request
+	sent   []byte
+}
+
+func (a *recordingAPI) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	a.req = r
+	a.sent, _ = io.ReadAll(r.Body)
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(a.status)
+	_, _ = w.Write([]byte(a.body))
+}
+
+func testCommands() []Command {
+	return []Command{
+		{
+			Name: "buy", Aliases: []string{"b"}, Usage: "<item> [quantity]", Feature: "feature_economy", MinArgs: 1,
+			Method: http.MethodPost, Path: "/api/v1/user/item/buy",
+			Body: func(s Sender, args []string) (interface{}, error) {
+				item, quantity, err := ItemAndQuantity(args)
+				if err != nil {
+					return nil, err
+				}
+				return buyBody{Username: s.Username, ItemName: item, Quantity: quantity}, nil
+			},
+		},
+		{
+			Name:   "inventory",
+			Method: http.MethodGet, Path: "/api/v1/user/inventory",
+			Query: func(s Sender, _ []string) (url.Values, error) {
+				return url.Values{"username": {s.Username}}, nil
+			},
+			Reply: func([]byte) string { return "your stuff" },
+		},
+	}
+}
+
+func newTestRequest() *http.Request {
+	r := httptest.NewRequest(http.MethodPost, "/api/v1/message/handle", nil)
+	r.Header.Set("X-API-Key", "secret")
+	return r
+}
+
+func TestDispatch_IgnoresPlainAndUnknown(t *testing.T) {
+	api := &recordingAPI{status: http.StatusOK}
+	d := NewDispatcher("!", api, fakeFeatures{}, testCommands())
+
+	assert.Nil(t, d.Dispatch(newTestRequest(), "twitch", "1", "alice", "hello"))
+	assert.Nil(t, d.Dispatch(newTestRequest(), "twitch", "1", "alice", "!songrequest abc"))
+	assert.Nil(t, api.req)
+}
+
+func TestDispatch_RunsCommand(t *testing.T) {
+	api := &recordingAPI{status: http.StatusOK, body: `{"message":"Bought 2 missiles"}`}
+	d := NewDispatcher("!", api, fakeFeatures{unlocked: map[string]bool{"feature_economy": true}}, testCommands())
+
+	result := d.Dispatch(newTestRequest(), "twitch", "1", "alice", `!B "big missile" 2`)
+	require.NotNil(t, result)
+	assert.True(t, result.OK)
+	assert.Equal(t, "buy", result.Name)
+	assert.Equal(t, "Bought 2 missiles", result.Reply)
+	assert.JSONEq(t, api.body, string(result.Data))
+
+	require.NotNil(t, api.req)
+	assert.Equal(t, http.MethodPost, api.req.Method)
+	assert.Equal(t, "/api/v1/user/item/buy", api.req.URL.Path)
+	assert.Equal(t, "secret", api.req.Header.Get("X-API-Key"))
+	var sent buyBody
+	require.NoError(t, json.Unmarshal(api.sent, &sent))
+	assert.Equal(t, buyBody{Username: "alice", ItemName: "big missile", Quantity: 2}, sent)
+}
+
+func TestDispatch_QueryCommandAndCustomReply(t *testing.T) {
+	api := &recordingAPI{status: http.StatusOK, body: `{"items":[]}`}
+	d := NewDispatcher("!", api, fakeFeatures{}, testCommands())
+
+	result := d.Dispatch(newTestRequest(), "twitch", "1", "alice", "!inventory")
+	require.NotNil(t, result)
+	assert.True(t, result.OK)
+	assert.Equal(t, "your stuff", result.Reply)
+	assert.Equal(t, http.MethodGet, api.req.Method)
+	assert.Equal(t, "alice", api.req.URL.Query().Get("username"))
+}
+
+func TestDispatch_FeatureLocked(t *testing.T) {
+	api := &recordingAPI{status: http.StatusOK}
+	d := NewDispatcher("!", api, fakeFeatures{}, testCommands())
+
+	result := d.Dispatch(newTestRequest(), "twitch", "1", "alice", "!buy missile")
+	require.NotNil(t, result)
+	assert.False(t, result.OK)
+	assert.Equal(t, string(handler.ErrCodeFeatureLocked), result.Code)
+	assert.Nil(t, api.req)
+}
+
+func TestDispatch_FeatureCheckError(t *testing.T) {
+	api := &recordingAPI{status: http.StatusOK}
+	d := NewDispatcher("!", api, fakeFeatures{err: errors.New("db down")}, testCommands())
+
+	result := d.Dispatch(newTestRequest(), "twitch", "1", "alice", "!buy missile")
+	require.NotNil(t, result)
+	assert.False(t, result.OK)
+	assert.Equal(t, string(handler.ErrCodeInternal), result.Code)
+	assert.Nil(t, api.req)
+}
+
+func TestDispatch_Usage(t *testing.T) {
+	api := &recordingAPI{status: http.StatusOK}
+	d := NewDispatcher("!", api, fakeFeatures{unlocked: map[string]bool{"feature_economy": true}}, testCommands())
+
+	result := d.Dispatch(newTestRequest(), "twitch", "1", "alice", "!buy")
+	require.NotNil(t, result)
+	assert.False(t, result.OK)
+	assert.Equal(t, string(handler.ErrCodeBadRequest), result.Code)
+	assert.Equal(t, "Usage: !buy <item> [quantity]", result.Reply)
+
+	result = d.Dispatch(newTestRequest(), "twitch", "1", "alice", `!buy "missile`)
+	require.NotNil(t, result)
+	assert.False(t, result.OK)
+	assert.Equal(t, ReplyUnterminatedQuote, result.Reply)
+	assert.Nil(t, api.req)
+}
+
+func TestDispatch_APIError(t *testing.T) {
+	api := &recordingAPI{status: http.StatusBadRequest, body: `{"code":"NOT_FOUND","message":"Item not found"}`}
+	d := NewDispatcher("!", api, fakeFeatures{unlocked: map[string]bool{"feature_economy": true}}, testCommands())
+
+	result := d.Dispatch(newTestRequest(), "twitch", "1", "alice", "!buy nothing")
+	require.NotNil(t, result)
+	assert.False(t, result.OK)
+	assert.Equal(t, "NOT_FOUND", result.Code)
+	assert.Equal(t, "Item not found", result.Reply)
+}
+
+func TestDispatch_Help(t *testing.T) {
+	d := NewDispatcher("!", &recordingAPI{status: http.StatusOK}, fakeFeatures{}, testCommands())
+
+	result := d.Dispatch(newTestRequest(), "twitch", "1", "alice", "!help")
+	require.NotNil(t, result)
+	assert.True(t, result.OK)
+	assert.Contains(t, result.Reply, "!inventory")
+	assert.NotContains(t, result.Reply, "!buy", "locked commands are not listed")
+
+	result = d.Dispatch(newTestRequest(), "twitch", "1", "alice", "!help b")
+	require.NotNil(t, result)
+	assert.Equal(t, "Usage: !buy <item> [quantity]", result.Reply)
+}
@@ -0,0 +1,76 @@
+package chatcommand
+
+import (
+	"errors"
+	"strings"
+	"unicode"
+)
+
+// ErrUnterminatedQuote is returned for a message with an unclosed quote
+var ErrUnterminatedQuote = errors.New(ReplyUnterminatedQuote)
+
+// Invocation is a command parsed from a chat message
+type Invocation struct {
+	// Name is the lowercased command name without the prefix
+	Name string
+	Args []string
+}
+
+// Parse reads a command from a chat message. ok is false when the message
+// does not start with the prefix directly followed by a name. Arguments are
+// split on whitespace; double quotes group words into one argument and a
+// backslash escapes the next character inside them.
+func Parse(prefix, message string) (inv Invocation, ok bool, err error) {
+	message = strings.TrimSpace(message)
+	if prefix == "" || !strings.HasPrefix(message, prefix) {
+		return Invocation{}, false, nil
+	}
+	rest := message[len(prefix):]
+	if rest == "" || unicode.IsSpace([]rune(rest)[0]) {
+		return Invocation{}, false, nil
+	}
+
+	tokens, err := tokenize(rest)
+	if err != nil {
+		return Invocation{Name: strings.ToLower(strings.Fields(rest)[0])}, true, err
+	}
+	return Invocation{Name: strings.ToLower(tokens[0]), Args: tokens[1:]}, true, nil
+}
+
+func tokenize(s string) ([]string, error) {
+	var (
+		tokens  []string
+		current strings.Builder
+		inToken bool
+		quoted  bool
+		escaped bool
+	)
+	for _, r := range s {
+		switch {
+		case escaped:
+			current.WriteRune(r)
+			escaped = false
+		case quoted && r == '\\':
+			escaped = true
+		case r == '"':
+			quoted = !quoted
+			inToken = true
+		case !quoted && unicode.IsSpace(r):
+			if inToken {
+				tokens = append(tokens, current.String())
+				current.Reset()
+				inToken = false
+			}
+		default:
+			current.WriteRune(r)
+			inToken = true
+		}
+	}
+	if quoted || escaped {
+		return nil, ErrUnterminatedQuote
+	}
+	if inToken {
+		tokens = append(tokens, current.String())
+	}
+	return tokens, nil
+}
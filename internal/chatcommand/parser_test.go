@@ -0,0 +1,74 @@
+package chatcommand
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name     string
+		prefix   string
+		message  string
+		wantOK   bool
+		wantName string
+		wantArgs []string
+		wantErr  error
+	}{
+		{name: "plain chat", prefix: "!", message: "hello there", wantOK: false},
+		{name: "bare prefix", prefix: "!", message: "!", wantOK: false},
+		{name: "prefix then space", prefix: "!", message: "! buy", wantOK: false},
+		{name: "empty prefix disables", prefix: "", message: "buy missile", wantOK: false},
+		{name: "no args", prefix: "!", message: "!inventory", wantOK: true, wantName: "inventory", wantArgs: []string{}},
+		{name: "name lowercased", prefix: "!", message: "  !BUY missile 2 ", wantOK: true, wantName: "buy", wantArgs: []string{"missile", "2"}},
+		{name: "multi-char prefix", prefix: "bb!", message: "bb!use junkbox", wantOK: true, wantName: "use", wantArgs: []string{"junkbox"}},
+		{name: "quoted argument", prefix: "!", message: `!give @alice "rusty crate" 3`, wantOK: true, wantName: "give", wantArgs: []string{"@alice", "rusty crate", "3"}},
+		{name: "escaped quote", prefix: "!", message: `!search "the \"big\" one"`, wantOK: true, wantName: "search", wantArgs: []string{`the "big" one`}},
+		{name: "empty quotes", prefix: "!", message: `!search ""`, wantOK: true, wantName: "search", wantArgs: []string{""}},
+		{name: "unterminated quote", prefix: "!", message: `!buy "rusty crate`, wantOK: true, wantName: "buy", wantErr: ErrUnterminatedQuote},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			inv, ok, err := Parse(tt.prefix, tt.message)
+			assert.Equal(t, tt.wantOK, ok)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, tt.wantName, inv.Name)
+			if tt.wantErr == nil {
+				assert.Equal(t, tt.wantArgs, inv.Args)
+			}
+		})
+	}
+}
+
+func TestItemAndQuantity(t *testing.T) {
+	item, quantity, err := ItemAndQuantity([]string{"rusty", "crate", "3"})
+	require.NoError(t, err)
+	assert.Equal(t, "rusty crate", item)
+	assert.Equal(t, 3, quantity)
+
+	item, quantity, err = ItemAndQuantity([]string{"missile"})
+	require.NoError(t, err)
+	assert.Equal(t, "missile", item)
+	assert.Equal(t, 1, quantity)
+
+	// A lone number is the item, not a quantity
+	item, quantity, err = ItemAndQuantity([]string{"42"})
+	require.NoError(t, err)
+	assert.Equal(t, "42", item)
+	assert.Equal(t, 1, quantity)
+
+	_, _, err = ItemAndQuantity(nil)
+	assert.ErrorIs(t, err, ErrUsage)
+}
+
+func TestSplitMentions(t *testing.T) {
+	rest, mentions := SplitMentions([]string{"missile", "@bob", "@", "2"})
+	assert.Equal(t, []string{"missile", "@", "2"}, rest)
+	assert.Equal(t, []string{"bob"}, mentions)
+}
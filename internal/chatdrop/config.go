@@ -0,0 +1,204 @@
+package chatdrop
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DropItem is an entry of the drop table
+type DropItem struct {
+	ItemName string `json:"item_name"`
+	Quantity int    `json:"quantity"`
+	Weight   int    `json:"weight"`
+}
+
+// KeywordDrop is an item a chat message can drop by saying a keyword while
+// its event theme is running, such as an emote during an emote festival
+type KeywordDrop struct {
+	Keyword       string `json:"keyword"`
+	Theme         string `json:"theme"`
+	ItemName      string `json:"item_name"`
+	Quantity      int    `json:"quantity"`
+	ChancePercent int    `json:"chance_percent"`
+}
+
+// Config is the top-level JSON structure for chat_drops.json
+type Config struct {
+	Version string `json:"version,omitempty"`
+	Enabled bool   `json:"enabled"`
+
+	// MetricWeights is the activity each engagement metric adds. Metrics not
+	// listed add nothing.
+	MetricWeights map[string]float64 `json:"metric_weights"`
+
+	// ActivityPerDrop is the pooled activity each drop costs
+	ActivityPerDrop float64 `json:"activity_per_drop"`
+	// MaxDropsPerRound caps the drops made at once, however busy chat was
+	MaxDropsPerRound int `json:"max_drops_per_round"`
+	// MinChatters is how many different users must have been active for
+	// anything to drop, so one person cannot farm drops alone
+	MinChatters int `json:"min_chatters"`
+
+	// UserCooldownSeconds is how long after counted activity a user's next
+	// activity is ignored
+	UserCooldownSeconds int `json:"user_cooldown_seconds"`
+	// MaxUserActivity caps what one user adds to a round
+	MaxUserActivity float64 `json:"max_user_activity"`
+	// ActiveWindowSeconds is how recently a user must have chatted to stay in
+	// the round and be able to receive a drop
+	ActiveWindowSeconds int `json:"active_window_seconds"`
+
+	Drops []DropItem `json:"drops"`
+
+	// KeywordDrops are rolled for each chat message that says their keyword
+	// while their theme is active, outside of the activity rounds
+	KeywordDrops []KeywordDrop `json:"keyword_drops,omitempty"`
+	// KeywordCooldownSeconds is how long after saying a keyword a user's
+	// next keyword is ignored, whether or not it dropped anything
+	KeywordCooldownSeconds int `json:"keyword_cooldown_seconds"`
+}
+
+// UserCooldown returns the anti-spam cooldown between counted activity
+func (c Config) UserCooldown() time.Duration {
+	return time.Duration(c.UserCooldownSeconds) * time.Second
+}
+
+// ActiveWindow returns how long a chatter stays active after chatting
+func (c Config) ActiveWindow() time.Duration {
+	return time.Duration(c.ActiveWindowSeconds) * time.Second
+}
+
+// KeywordCooldown returns the cooldown between a user's keyword rolls
+func (c Config) KeywordCooldown() time.Duration {
+	return time.Duration(c.KeywordCooldownSeconds) * time.Second
+}
+
+// Validate checks the rates are usable and the drop table is non-empty
+func (c Config) Validate() error {
+	positive := false
+	for metric, weight := range c.MetricWeights {
+		if weight < 0 {
+			return fmt.Errorf("metric %q: weight must not be negative", metric)
+		}
+		positive = positive || weight > 0
+	}
+	if !positive {
+		return fmt.Errorf("at least one metric weight must be positive")
+	}
+	if c.ActivityPerDrop <= 0 {
+		return fmt.Errorf("activity_per_drop must be positive")
+	}
+	if c.MaxDropsPerRound < 1 {
+		return fmt.Errorf("max_drops_per_round must be at least 1")
+	}
+	if c.MinChatters < 1 {
+		return fmt.Errorf("min_chatters must be at least 1")
+	}
+	if c.UserCooldownSeconds < 0 {
+		return fmt.Errorf("user_cooldown_seconds must not be negative")
+	}
+	if c.MaxUserActivity <= 0 {
+		return fmt.Errorf("max_user_activity must be positive")
+	}
+	if c.ActiveWindowSeconds < 1 {
+		return fmt.Errorf("active_window_seconds must be at least 1")
+	}
+	if len(c.Drops) == 0 {
+		return fmt.Errorf("at least one drop is required")
+	}
+	for i, drop := range c.Drops {
+		if drop.ItemName == "" {
+			return fmt.Errorf("drop %d: item_name is required", i)
+		}
+		if drop.Quantity < 1 {
+			return fmt.Errorf("drop %q: quantity must be at least 1", drop.ItemName)
+		}
+		if drop.Weight < 1 {
+			return fmt.Errorf("drop %q: weight must be at least 1", drop.ItemName)
+		}
+	}
+	if c.KeywordCooldownSeconds < 0 {
+		return fmt.Errorf("keyword_cooldown_seconds must not be negative")
+	}
+	for i, drop := range c.KeywordDrops {
+		if drop.Keyword == "" || strings.ContainsAny(drop.Keyword, " \t\n") {
+			return fmt.Errorf("keyword drop %d: keyword must be a single word", i)
+		}
+		if drop.Theme == "" {
+			return fmt.Errorf("keyword drop %q: theme is required", drop.Keyword)
+		}
+		if drop.ItemName == "" {
+			return fmt.Errorf("keyword drop %q: item_name is required", drop.Keyword)
+		}
+		if drop.Quantity < 1 {
+			return fmt.Errorf("keyword drop %q: quantity must be at least 1", drop.Keyword)
+		}
+		if drop.ChancePercent < 1 || drop.ChancePercent > 100 {
+			return fmt.Errorf("keyword drop %q: chance_percent must be between 1 and 100", drop.Keyword)
+		}
+	}
+	return nil
+}
+
+// LoadConfig reads and validates the chat drop config file
+func LoadConfig(path string) (*Config, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read chat drop config: %w", err)
+	}
+
+	var config Config
+	if err := json.Unmarshal(data, &config); err != nil {
+		return nil, fmt.Errorf("failed to parse chat drop config: %w", err)
+	}
+
+	if err := config.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid chat drop config: %w", err)
+	}
+
+	return &config, nil
+}
+
+// Table holds the chat drop config and can be reloaded from its file
+type Table struct {
+	path string
+
+	mu     sync.RWMutex
+	config Config
+}
+
+// NewTable loads the chat drop config from path
+func NewTable(path string) (*Table, error) {
+	config, err := LoadConfig(path)
+	if err != nil {
+		return nil, err
+	}
+	return &Table{path: path, config: *config}, nil
+}
+
+// Reload re-reads the config from its file, keeping the current one if the file is invalid
+func (t *Table) Reload(ctx context.Context) error {
+	config, err := LoadConfig(t.path)
+	if err != nil {
+		return err
+	}
+	t.mu.Lock()
+	t.config = *config
+	t.mu.Unlock()
+	return nil
+}
+
+// Config returns the current config. A nil table is disabled.
+func (t *Table) Config() Config {
+	if t == nil {
+		return Config{}
+	}
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return t.config
+}
@@ -0,0 +1,13 @@
+package chatdrop
+
+// JobType identifies chat drop runs in the worker pool and scheduler
+const JobType = "chat_drops"
+
+// Log messages
+const (
+	LogMsgRoundDropped   = "Chat activity dropped items"
+	LogMsgDropFailed     = "Failed to grant chat drop"
+	LogMsgKeywordDropped = "Chat keyword dropped an item"
+
+	LogWarnRecordDropFail = "Failed to record chat drop stats event"
+)
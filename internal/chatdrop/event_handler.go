@@ -0,0 +1,48 @@
+package chatdrop
+
+import (
+	"context"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/event"
+)
+
+// EventHandler feeds engagement into the chat drop rounds
+type EventHandler struct {
+	service Service
+}
+
+// NewEventHandler creates a new chat drop event handler
+func NewEventHandler(service Service) *EventHandler {
+	return &EventHandler{service: service}
+}
+
+// Register subscribes the handler to engagement and chat drop events. Both
+// are shared so every instance keeps the same rounds: whichever instance runs
+// the drop job sees all chat, and its drops end the round everywhere.
+func (h *EventHandler) Register(bus event.Bus) {
+	event.SubscribeShared(bus, event.Type(domain.EventTypeEngagement), h.HandleEngagement)
+	event.SubscribeShared(bus, event.ChatDropped, h.HandleChatDropped)
+}
+
+// HandleEngagement adds recorded engagement to the user's community round
+func (h *EventHandler) HandleEngagement(ctx context.Context, evt event.Event) error {
+	metric, err := event.DecodePayload[domain.EngagementMetric](evt.Payload)
+	if err != nil || metric.UserID == "" {
+		return nil
+	}
+
+	// Only count engagement once the progression service has recorded it
+	if recorded, ok := evt.GetMetadataValue(domain.MetadataKeyRecorded).(bool); !ok || !recorded {
+		return nil
+	}
+
+	h.service.RecordActivity(ctx, metric.UserID, metric.Platform, metric.MetricType, metric.MetricValue)
+	return nil
+}
+
+// HandleChatDropped starts a new round in the community that dropped
+func (h *EventHandler) HandleChatDropped(ctx context.Context, _ event.Event) error {
+	h.service.EndRound(ctx)
+	return nil
+}
@@ -0,0 +1,24 @@
+package chatdrop
+
+import "context"
+
+// Job drops items for the chat activity pooled since the last drop
+type Job struct {
+	service Service
+}
+
+// NewJob creates a chat drop job
+func NewJob(service Service) *Job {
+	return &Job{service: service}
+}
+
+// JobType returns the worker pool job type
+func (j *Job) JobType() string {
+	return JobType
+}
+
+// Process grants the drops the current round has earned
+func (j *Job) Process(ctx context.Context) error {
+	_, err := j.service.Drop(ctx)
+	return err
+}
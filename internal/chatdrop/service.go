@@ -0,0 +1,312 @@
+package chatdrop
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"strings"
+	"time"
+
+	"github.com/osse101/BrandishBot_Go/internal/community"
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/event"
+	"github.com/osse101/BrandishBot_Go/internal/logger"
+	"github.com/osse101/BrandishBot_Go/internal/rng"
+)
+
+// Service turns chat activity into lootbox drops. Engagement from chat adds
+// weighted activity to a per-community round; each run drops items to random
+// active chatters once the round has pooled enough, favouring the most active.
+type Service interface {
+	// RecordActivity adds an engagement metric of a user to the current round
+	// of the context's community. Activity inside the user's cooldown or over
+	// their cap is ignored.
+	RecordActivity(ctx context.Context, userID, platform, metric string, value int)
+
+	// Drop grants the drops the current round has earned, publishes them and
+	// starts a new round. Nothing drops, and the round carries on, until
+	// enough chatters are active and enough activity is pooled.
+	Drop(ctx context.Context) ([]domain.ChatDrop, error)
+
+	// EndRound discards the current round of the context's community
+	EndRound(ctx context.Context)
+
+	// DropKeyword rolls the keyword drop of the first keyword in a chat
+	// message whose theme is active, granting and publishing it if it hits.
+	// It returns nil when nothing dropped.
+	DropKeyword(ctx context.Context, userID, platform, message string) (*domain.ChatDrop, error)
+}
+
+// UserService defines the user operations needed to grant drops
+type UserService interface {
+	GetItemByName(ctx context.Context, name string) (*domain.Item, error)
+	GrantItemReward(ctx context.Context, user *domain.User, item *domain.Item, quantity int, qualityLevel domain.QualityLevel) error
+}
+
+// UserLookup finds drop recipients by ID
+type UserLookup interface {
+	GetUserByID(ctx context.Context, userID string) (*domain.User, error)
+}
+
+// Publisher publishes chat drop events
+type Publisher interface {
+	PublishWithRetry(ctx context.Context, evt event.Event)
+}
+
+// StatsRecorder records a stats event per drop, which tags the drop with the
+// live stream session
+type StatsRecorder interface {
+	RecordUserEvent(ctx context.Context, userID string, eventType domain.EventType, metadata interface{}) error
+}
+
+// EventThemes reports whether an event theme is running, from the dated
+// naming themes or a live game event
+type EventThemes interface {
+	IsThemeActive(ctx context.Context, theme string) bool
+}
+
+// Option configures optional service dependencies
+type Option func(*service)
+
+// WithRNG draws drop recipients and items from the seeded RNG provider
+func WithRNG(provider *rng.Provider) Option {
+	return func(s *service) {
+		s.rng = provider
+	}
+}
+
+// WithStats records a chat_drop stats event for every granted drop
+func WithStats(recorder StatsRecorder) Option {
+	return func(s *service) {
+		s.stats = recorder
+	}
+}
+
+// WithEventThemes enables keyword drops, which only roll while their theme is active
+func WithEventThemes(themes EventThemes) Option {
+	return func(s *service) {
+		s.themes = themes
+	}
+}
+
+type service struct {
+	table     *Table
+	users     UserService
+	lookup    UserLookup
+	publisher Publisher
+	rng       *rng.Provider
+	stats     StatsRecorder
+	themes    EventThemes
+	tracker   *tracker
+	now       func() time.Time
+}
+
+// NewService creates a chat drop service. publisher may be nil.
+func NewService(table *Table, users UserService, lookup UserLookup, publisher Publisher, opts ...Option) Service {
+	s := &service{
+		table:     table,
+		users:     users,
+		lookup:    lookup,
+		publisher: publisher,
+		tracker:   newTracker(),
+		now:       time.Now,
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+func (s *service) RecordActivity(ctx context.Context, userID, platform, metric string, value int) {
+	cfg := s.table.Config()
+	if !cfg.Enabled || userID == "" || value <= 0 {
+		return
+	}
+	weight := cfg.MetricWeights[metric]
+	if weight <= 0 {
+		return
+	}
+	s.tracker.record(community.FromContext(ctx), userID, platform, weight*float64(value), cfg, s.now())
+}
+
+func (s *service) Drop(ctx context.Context) ([]domain.ChatDrop, error) {
+	cfg := s.table.Config()
+	if !cfg.Enabled {
+		return nil, nil
+	}
+	communityID := community.FromContext(ctx)
+	chatters, activity := s.tracker.active(communityID, cfg, s.now())
+	if len(chatters) < cfg.MinChatters {
+		return nil, nil
+	}
+	count := min(int(activity/cfg.ActivityPerDrop), cfg.MaxDropsPerRound, len(chatters))
+	if count == 0 {
+		return nil, nil
+	}
+
+	// The round is spent even if some grants fail, so a broken drop table
+	// cannot make every run retry the same drops
+	s.tracker.end(communityID)
+
+	src := s.source(ctx)
+	log := logger.FromContext(ctx)
+	var drops []domain.ChatDrop
+	var errs []error
+	for _, recipient := range pickRecipients(chatters, count, src) {
+		drop, err := s.grant(ctx, recipient, pickDrop(cfg.Drops, src))
+		if err != nil {
+			log.Error(LogMsgDropFailed, "user_id", recipient.userID, "error", err)
+			errs = append(errs, err)
+			continue
+		}
+		drops = append(drops, drop)
+		s.recordDrop(ctx, drop)
+	}
+
+	if len(drops) > 0 {
+		log.Info(LogMsgRoundDropped, "drops", len(drops), "chatters", len(chatters), "activity", activity)
+		if s.publisher != nil {
+			s.publisher.PublishWithRetry(ctx, event.NewChatDroppedEvent(drops, len(chatters)))
+		}
+	}
+	return drops, errors.Join(errs...)
+}
+
+func (s *service) EndRound(ctx context.Context) {
+	s.tracker.end(community.FromContext(ctx))
+}
+
+func (s *service) DropKeyword(ctx context.Context, userID, platform, message string) (*domain.ChatDrop, error) {
+	cfg := s.table.Config()
+	if !cfg.Enabled || s.themes == nil || userID == "" || len(cfg.KeywordDrops) == 0 {
+		return nil, nil
+	}
+	keywordDrop, ok := s.matchKeyword(ctx, cfg.KeywordDrops, message)
+	if !ok {
+		return nil, nil
+	}
+	if !s.tracker.takeKeyword(community.FromContext(ctx), userID, cfg.KeywordCooldown(), s.now()) {
+		return nil, nil
+	}
+	if s.source(ctx).Intn(100) >= keywordDrop.ChancePercent {
+		return nil, nil
+	}
+
+	recipient := chatter{userID: userID, platform: platform}
+	drop, err := s.grant(ctx, recipient, DropItem{ItemName: keywordDrop.ItemName, Quantity: keywordDrop.Quantity})
+	if err != nil {
+		return nil, err
+	}
+	s.recordDrop(ctx, drop)
+
+	logger.FromContext(ctx).Info(LogMsgKeywordDropped, "user_id", userID, "keyword", keywordDrop.Keyword, "item", drop.ItemName)
+	if s.publisher != nil {
+		s.publisher.PublishWithRetry(ctx, event.NewKeywordDroppedEvent(drop, keywordDrop.Keyword, keywordDrop.Theme))
+	}
+	return &drop, nil
+}
+
+// matchKeyword returns the keyword drop of the first word of the message that
+// is a keyword of an active theme. Keywords match whole words, case and all,
+// as emotes do.
+func (s *service) matchKeyword(ctx context.Context, drops []KeywordDrop, message string) (KeywordDrop, bool) {
+	for _, word := range strings.Fields(message) {
+		for _, drop := range drops {
+			if drop.Keyword == word && s.themes.IsThemeActive(ctx, drop.Theme) {
+				return drop, true
+			}
+		}
+	}
+	return KeywordDrop{}, false
+}
+
+func (s *service) grant(ctx context.Context, recipient chatter, drop DropItem) (domain.ChatDrop, error) {
+	user, err := s.lookup.GetUserByID(ctx, recipient.userID)
+	if err != nil {
+		return domain.ChatDrop{}, fmt.Errorf("failed to get drop recipient: %w", err)
+	}
+	if user == nil {
+		return domain.ChatDrop{}, fmt.Errorf("drop recipient %s: %w", recipient.userID, domain.ErrUserNotFound)
+	}
+	item, err := s.users.GetItemByName(ctx, drop.ItemName)
+	if err != nil {
+		return domain.ChatDrop{}, fmt.Errorf("failed to get drop item: %w", err)
+	}
+	if item == nil {
+		return domain.ChatDrop{}, fmt.Errorf("drop item %q: %w", drop.ItemName, domain.ErrItemNotFound)
+	}
+	if err := s.users.GrantItemReward(ctx, user, item, drop.Quantity, domain.QualityCommon); err != nil {
+		return domain.ChatDrop{}, fmt.Errorf("failed to grant drop: %w", err)
+	}
+	return domain.ChatDrop{
+		UserID:   user.ID,
+		Username: user.Username,
+		Platform: recipient.platform,
+		ItemName: item.InternalName,
+		Quantity: drop.Quantity,
+	}, nil
+}
+
+// recordDrop records the drop as a stats event. The item is already granted,
+// so a failure is only logged.
+func (s *service) recordDrop(ctx context.Context, drop domain.ChatDrop) {
+	if s.stats == nil {
+		return
+	}
+	metadata := map[string]interface{}{
+		"item_name": drop.ItemName,
+		"quantity":  drop.Quantity,
+		"platform":  drop.Platform,
+	}
+	if err := s.stats.RecordUserEvent(ctx, drop.UserID, domain.StatsEventChatDrop, metadata); err != nil {
+		logger.FromContext(ctx).Warn(LogWarnRecordDropFail, "user_id", drop.UserID, "error", err)
+	}
+}
+
+func (s *service) source(ctx context.Context) rng.Source {
+	if s.rng != nil {
+		return s.rng.ForOperation(ctx, rng.OpChatDrop)
+	}
+	return rand.New(rand.NewSource(s.now().UnixNano())) //nolint:gosec // Game logic randomness, not security critical
+}
+
+// pickRecipients draws count different chatters, each with a chance in
+// proportion to their activity
+func pickRecipients(chatters []chatter, count int, src rng.Source) []chatter {
+	pool := append([]chatter(nil), chatters...)
+	picked := make([]chatter, 0, count)
+	for len(picked) < count && len(pool) > 0 {
+		var total float64
+		for _, c := range pool {
+			total += c.activity
+		}
+		roll := src.Float64() * total
+		i := 0
+		for ; i < len(pool)-1; i++ {
+			roll -= pool[i].activity
+			if roll < 0 {
+				break
+			}
+		}
+		picked = append(picked, pool[i])
+		pool = append(pool[:i], pool[i+1:]...)
+	}
+	return picked
+}
+
+// pickDrop draws an entry of the drop table by weight
+func pickDrop(drops []DropItem, src rng.Source) DropItem {
+	total := 0
+	for _, drop := range drops {
+		total += drop.Weight
+	}
+	roll := src.Intn(total)
+	for _, drop := range drops {
+		roll -= drop.Weight
+		if roll < 0 {
+			return drop
+		}
+	}
+	return drops[len(drops)-1]
+}
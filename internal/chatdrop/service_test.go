@@ -0,0 +1,404 @@
+package chatdrop
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/osse101/BrandishBot_Go/internal/chatdrop/mocks"
+	"github.com/osse101/BrandishBot_Go/internal/community"
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/event"
+	"github.com/osse101/BrandishBot_Go/internal/rng"
+	"github.com/osse101/BrandishBot_Go/internal/scenario"
+)
+
+const testConfig = `{
+	"enabled": true,
+	"metric_weights": {"message": 1, "command": 2},
+	"activity_per_drop": 4,
+	"max_drops_per_round": 2,
+	"min_chatters": 2,
+	"user_cooldown_seconds": 10,
+	"max_user_activity": 5,
+	"active_window_seconds": 600,
+	"drops": [{"item_name": "lootbox_tier0", "quantity": 1, "weight": 1}],
+	"keyword_cooldown_seconds": 30,
+	"keyword_drops": [
+		{"keyword": "PogChamp", "theme": "emote_fest", "item_name": "item_emote_pog", "quantity": 1, "chance_percent": 100},
+		{"keyword": "Kappa", "theme": "spooky", "item_name": "item_emote_kappa", "quantity": 1, "chance_percent": 100}
+	]
+}`
+
+func writeConfig(t *testing.T, data string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "chat_drops.json")
+	require.NoError(t, os.WriteFile(path, []byte(data), 0o600))
+	return path
+}
+
+func setupServiceTest(t *testing.T) (*service, *scenario.SimulatedClock, *mocks.MockUserService, *mocks.MockUserLookup, *mocks.MockPublisher) {
+	table, err := NewTable(writeConfig(t, testConfig))
+	require.NoError(t, err)
+	clock := scenario.NewSimulatedClock(time.Date(2026, 10, 15, 12, 0, 0, 0, time.UTC))
+	mockUsers := mocks.NewMockUserService(t)
+	mockLookup := mocks.NewMockUserLookup(t)
+	mockPublisher := mocks.NewMockPublisher(t)
+	svc := NewService(table, mockUsers, mockLookup, mockPublisher, WithRNG(rng.New(1, false))).(*service)
+	svc.now = clock.Now
+	return svc, clock, mockUsers, mockLookup, mockPublisher
+}
+
+// chat records one message from each user, moving the clock past the cooldown first
+func chat(ctx context.Context, svc *service, clock *scenario.SimulatedClock, userIDs ...string) {
+	clock.Advance(11 * time.Second)
+	for _, id := range userIDs {
+		svc.RecordActivity(ctx, id, domain.PlatformTwitch, domain.MetricTypeMessage, 1)
+	}
+}
+
+func expectGrants(mockUsers *mocks.MockUserService, mockLookup *mocks.MockUserLookup, item *domain.Item) {
+	mockLookup.On("GetUserByID", mock.Anything, mock.Anything).Return(func(_ context.Context, id string) (*domain.User, error) {
+		return &domain.User{ID: id, Username: "name-" + id}, nil
+	})
+	mockUsers.On("GetItemByName", mock.Anything, item.InternalName).Return(item, nil)
+	mockUsers.On("GrantItemReward", mock.Anything, mock.Anything, item, 1, domain.QualityCommon).Return(nil)
+}
+
+func TestRecordActivity_AntiSpam(t *testing.T) {
+	ctx := context.Background()
+	svc, clock, _, _, _ := setupServiceTest(t)
+
+	// Messages inside the cooldown are ignored
+	for i := 0; i < 10; i++ {
+		svc.RecordActivity(ctx, "spammer", domain.PlatformTwitch, domain.MetricTypeMessage, 1)
+	}
+	chatters, activity := svc.tracker.active(community.Default, svc.table.Config(), clock.Now())
+	require.Len(t, chatters, 1)
+	assert.InDelta(t, 1.0, activity, 0.001)
+
+	// Past the cooldown, activity counts up to the per-user cap
+	for i := 0; i < 10; i++ {
+		chat(ctx, svc, clock, "spammer")
+	}
+	_, activity = svc.tracker.active(community.Default, svc.table.Config(), clock.Now())
+	assert.InDelta(t, 5.0, activity, 0.001)
+
+	// Unweighted metrics add nothing
+	svc.RecordActivity(ctx, "crafter", domain.PlatformTwitch, domain.MetricTypeItemCrafted, 1)
+	chatters, _ = svc.tracker.active(community.Default, svc.table.Config(), clock.Now())
+	assert.Len(t, chatters, 1)
+}
+
+func TestDrop(t *testing.T) {
+	ctx := context.Background()
+	item := &domain.Item{ID: 1, InternalName: "lootbox_tier0"}
+
+	t.Run("nothing drops with too few chatters", func(t *testing.T) {
+		svc, clock, _, _, _ := setupServiceTest(t)
+		for i := 0; i < 5; i++ {
+			chat(ctx, svc, clock, "alice")
+		}
+
+		drops, err := svc.Drop(ctx)
+
+		require.NoError(t, err)
+		assert.Empty(t, drops)
+	})
+
+	t.Run("nothing drops before enough activity pools", func(t *testing.T) {
+		svc, clock, mockUsers, mockLookup, mockPublisher := setupServiceTest(t)
+		chat(ctx, svc, clock, "alice", "bob")
+
+		drops, err := svc.Drop(ctx)
+
+		require.NoError(t, err)
+		assert.Empty(t, drops)
+
+		// The round carries on, so later activity adds to it
+		chat(ctx, svc, clock, "alice", "bob")
+		expectGrants(mockUsers, mockLookup, item)
+		mockPublisher.On("PublishWithRetry", mock.Anything, mock.Anything).Once()
+		drops, err = svc.Drop(ctx)
+		require.NoError(t, err)
+		assert.Len(t, drops, 1)
+	})
+
+	t.Run("drops to distinct chatters up to the cap and starts a new round", func(t *testing.T) {
+		svc, clock, mockUsers, mockLookup, mockPublisher := setupServiceTest(t)
+		for i := 0; i < 5; i++ {
+			chat(ctx, svc, clock, "alice", "bob", "carol")
+		}
+		expectGrants(mockUsers, mockLookup, item)
+		mockPublisher.On("PublishWithRetry", mock.Anything, mock.MatchedBy(func(evt event.Event) bool {
+			payload, ok := evt.Payload.(event.ChatDroppedPayloadV1)
+			return evt.Type == event.ChatDropped && ok && len(payload.Drops) == 2 && payload.Chatters == 3
+		})).Once()
+
+		drops, err := svc.Drop(ctx)
+
+		require.NoError(t, err)
+		require.Len(t, drops, 2)
+		assert.NotEqual(t, drops[0].UserID, drops[1].UserID)
+		for _, drop := range drops {
+			assert.Equal(t, "name-"+drop.UserID, drop.Username)
+			assert.Equal(t, domain.PlatformTwitch, drop.Platform)
+			assert.Equal(t, "lootbox_tier0", drop.ItemName)
+		}
+
+		drops, err = svc.Drop(ctx)
+		require.NoError(t, err)
+		assert.Empty(t, drops, "the round was spent")
+	})
+
+	t.Run("chatters who went quiet leave the round", func(t *testing.T) {
+		svc, clock, _, _, _ := setupServiceTest(t)
+		for i := 0; i < 5; i++ {
+			chat(ctx, svc, clock, "alice", "bob")
+		}
+		clock.Advance(11 * time.Minute)
+
+		drops, err := svc.Drop(ctx)
+
+		require.NoError(t, err)
+		assert.Empty(t, drops)
+	})
+
+	t.Run("communities pool separately", func(t *testing.T) {
+		svc, clock, _, _, _ := setupServiceTest(t)
+		alpha := community.WithID(ctx, "alpha")
+		for i := 0; i < 5; i++ {
+			chat(ctx, svc, clock, "alice")
+			chat(alpha, svc, clock, "bob")
+		}
+
+		drops, err := svc.Drop(ctx)
+
+		require.NoError(t, err)
+		assert.Empty(t, drops)
+	})
+
+	t.Run("failed grants are reported and the round is still spent", func(t *testing.T) {
+		svc, clock, _, mockLookup, _ := setupServiceTest(t)
+		for i := 0; i < 5; i++ {
+			chat(ctx, svc, clock, "alice", "bob")
+		}
+		mockLookup.On("GetUserByID", mock.Anything, mock.Anything).Return(nil, errors.New("db down"))
+
+		drops, err := svc.Drop(ctx)
+
+		require.Error(t, err)
+		assert.Empty(t, drops)
+		chatters, _ := svc.tracker.active(community.Default, svc.table.Config(), clock.Now())
+		assert.Empty(t, chatters)
+	})
+
+	t.Run("records a stats event per drop", func(t *testing.T) {
+		svc, clock, mockUsers, mockLookup, mockPublisher := setupServiceTest(t)
+		recorder := mocks.NewMockStatsRecorder(t)
+		svc.stats = recorder
+		for i := 0; i < 5; i++ {
+			chat(ctx, svc, clock, "alice", "bob")
+		}
+		expectGrants(mockUsers, mockLookup, item)
+		mockPublisher.On("PublishWithRetry", mock.Anything, mock.Anything).Once()
+		recorder.On("RecordUserEvent", mock.Anything, mock.Anything, domain.StatsEventChatDrop, mock.MatchedBy(func(meta map[string]interface{}) bool {
+			return meta["item_name"] == "lootbox_tier0" && meta["quantity"] == 1
+		})).Return(errors.New("stats down")).Twice()
+
+		drops, err := svc.Drop(ctx)
+
+		require.NoError(t, err, "a failed stats event does not fail the drop")
+		assert.Len(t, drops, 2)
+	})
+
+	t.Run("disabled config drops nothing", func(t *testing.T) {
+		svc, clock, _, _, _ := setupServiceTest(t)
+		svc.table = nil
+		chat(ctx, svc, clock, "alice", "bob")
+
+		drops, err := svc.Drop(ctx)
+
+		require.NoError(t, err)
+		assert.Empty(t, drops)
+	})
+}
+
+// activeThemes is a fixed set of running event themes
+type activeThemes map[string]bool
+
+func (a activeThemes) IsThemeActive(_ context.Context, theme string) bool {
+	return a[theme]
+}
+
+func TestDropKeyword(t *testing.T) {
+	ctx := context.Background()
+	pog := &domain.Item{ID: 7, InternalName: "item_emote_pog"}
+
+	t.Run("says an active theme's keyword and gets its item", func(t *testing.T) {
+		svc, _, mockUsers, mockLookup, mockPublisher := setupServiceTest(t)
+		svc.themes = activeThemes{"emote_fest": true}
+		recorder := mocks.NewMockStatsRecorder(t)
+		svc.stats = recorder
+		expectGrants(mockUsers, mockLookup, pog)
+		recorder.On("RecordUserEvent", mock.Anything, "alice", domain.StatsEventChatDrop, mock.Anything).Return(nil).Once()
+		mockPublisher.On("PublishWithRetry", mock.Anything, mock.MatchedBy(func(evt event.Event) bool {
+			payload, ok := evt.Payload.(event.KeywordDroppedPayloadV1)
+			return evt.Type == event.KeywordDropped && ok && payload.Keyword == "PogChamp" && payload.Theme == "emote_fest" && payload.Drop.ItemName == "item_emote_pog"
+		})).Once()
+
+		drop, err := svc.DropKeyword(ctx, "alice", domain.PlatformTwitch, "that was so PogChamp wow")
+
+		require.NoError(t, err)
+		require.NotNil(t, drop)
+		assert.Equal(t, "item_emote_pog", drop.ItemName)
+		assert.Equal(t, "name-alice", drop.Username)
+		assert.Equal(t, domain.PlatformTwitch, drop.Platform)
+	})
+
+	t.Run("keywords outside their theme, or inside other words, drop nothing", func(t *testing.T) {
+		svc, _, _, _, _ := setupServiceTest(t)
+		svc.themes = activeThemes{"emote_fest": true}
+
+		for _, message := range []string{"Kappa", "PogChampion", "pogchamp", ""} {
+			drop, err := svc.DropKeyword(ctx, "alice", domain.PlatformTwitch, message)
+			require.NoError(t, err)
+			assert.Nil(t, drop, message)
+		}
+	})
+
+	t.Run("one roll per cooldown", func(t *testing.T) {
+		svc, clock, mockUsers, mockLookup, mockPublisher := setupServiceTest(t)
+		svc.themes = activeThemes{"emote_fest": true}
+		expectGrants(mockUsers, mockLookup, pog)
+		mockPublisher.On("PublishWithRetry", mock.Anything, mock.Anything).Times(3)
+
+		drop, err := svc.DropKeyword(ctx, "alice", domain.PlatformTwitch, "PogChamp")
+		require.NoError(t, err)
+		require.NotNil(t, drop)
+
+		drop, err = svc.DropKeyword(ctx, "alice", domain.PlatformTwitch, "PogChamp")
+		require.NoError(t, err)
+		assert.Nil(t, drop, "still on cooldown")
+
+		// Another community keeps its own cooldowns
+		drop, err = svc.DropKeyword(community.WithID(ctx, "alpha"), "alice", domain.PlatformTwitch, "PogChamp")
+		require.NoError(t, err)
+		assert.NotNil(t, drop)
+
+		clock.Advance(31 * time.Second)
+		drop, err = svc.DropKeyword(ctx, "alice", domain.PlatformTwitch, "PogChamp")
+		require.NoError(t, err)
+		assert.NotNil(t, drop)
+	})
+
+	t.Run("a keyword drop does not end the activity round", func(t *testing.T) {
+		svc, clock, mockUsers, mockLookup, mockPublisher := setupServiceTest(t)
+		svc.themes = activeThemes{"emote_fest": true}
+		chat(ctx, svc, clock, "alice", "bob")
+		expectGrants(mockUsers, mockLookup, pog)
+		mockPublisher.On("PublishWithRetry", mock.Anything, mock.Anything).Once()
+
+		_, err := svc.DropKeyword(ctx, "alice", domain.PlatformTwitch, "PogChamp")
+
+		require.NoError(t, err)
+		chatters, _ := svc.tracker.active(community.Default, svc.table.Config(), clock.Now())
+		assert.Len(t, chatters, 2)
+	})
+
+	t.Run("failed grants are returned", func(t *testing.T) {
+		svc, _, _, mockLookup, _ := setupServiceTest(t)
+		svc.themes = activeThemes{"emote_fest": true}
+		mockLookup.On("GetUserByID", mock.Anything, "alice").Return(nil, errors.New("db down"))
+
+		drop, err := svc.DropKeyword(ctx, "alice", domain.PlatformTwitch, "PogChamp")
+
+		require.Error(t, err)
+		assert.Nil(t, drop)
+	})
+
+	t.Run("without event themes keyword drops are off", func(t *testing.T) {
+		svc, _, _, _, _ := setupServiceTest(t)
+
+		drop, err := svc.DropKeyword(ctx, "alice", domain.PlatformTwitch, "PogChamp")
+
+		require.NoError(t, err)
+		assert.Nil(t, drop)
+	})
+}
+
+func TestPickRecipients_FavoursActivity(t *testing.T) {
+	chatters := []chatter{{userID: "quiet", activity: 1}, {userID: "busy", activity: 99}}
+	src := rng.New(7, false).ForOperation(context.Background(), rng.OpChatDrop)
+
+	busy := 0
+	for i := 0; i < 200; i++ {
+		if pickRecipients(chatters, 1, src)[0].userID == "busy" {
+			busy++
+		}
+	}
+	assert.Greater(t, busy, 180)
+	assert.Len(t, pickRecipients(chatters, 5, src), 2, "each chatter gets at most one drop")
+}
+
+func TestEventHandler(t *testing.T) {
+	ctx := context.Background()
+	svc, clock, _, _, _ := setupServiceTest(t)
+	h := NewEventHandler(svc)
+	metric := domain.EngagementMetric{UserID: "alice", Platform: domain.PlatformTwitch, MetricType: domain.MetricTypeCommand, MetricValue: 1}
+
+	// Engagement is only counted once recorded
+	require.NoError(t, h.HandleEngagement(ctx, event.Event{Type: domain.EventTypeEngagement, Payload: &metric}))
+	chatters, _ := svc.tracker.active(community.Default, svc.table.Config(), clock.Now())
+	assert.Empty(t, chatters)
+
+	require.NoError(t, h.HandleEngagement(ctx, event.Event{
+		Type:     domain.EventTypeEngagement,
+		Payload:  &metric,
+		Metadata: map[string]interface{}{domain.MetadataKeyRecorded: true},
+	}))
+	_, activity := svc.tracker.active(community.Default, svc.table.Config(), clock.Now())
+	assert.InDelta(t, 2.0, activity, 0.001)
+
+	// A drop from any instance ends the round
+	require.NoError(t, h.HandleChatDropped(ctx, event.Event{Type: event.ChatDropped}))
+	chatters, _ = svc.tracker.active(community.Default, svc.table.Config(), clock.Now())
+	assert.Empty(t, chatters)
+}
+
+func TestConfigValidate(t *testing.T) {
+	_, err := LoadConfig(writeConfig(t, testConfig))
+	require.NoError(t, err)
+
+	_, err = LoadConfig(writeConfig(t, `{"metric_weights": {"message": 1}, "activity_per_drop": 4, "max_drops_per_round": 1,
+		"min_chatters": 1, "max_user_activity": 5, "active_window_seconds": 60, "drops": []}`))
+	assert.ErrorContains(t, err, "at least one drop")
+
+	_, err = LoadConfig(writeConfig(t, `{"metric_weights": {"message": 0}}`))
+	assert.ErrorContains(t, err, "metric weight")
+
+	_, err = LoadConfig(writeConfig(t, `{"metric_weights": {"message": 1}, "activity_per_drop": 4, "max_drops_per_round": 1,
+		"min_chatters": 1, "max_user_activity": 5, "active_window_seconds": 60, "drops": [{"item_name": "x", "quantity": 1, "weight": 1}],
+		"keyword_drops": [{"keyword": "two words", "theme": "t", "item_name": "x", "quantity": 1, "chance_percent": 10}]}`))
+	assert.ErrorContains(t, err, "single word")
+
+	_, err = LoadConfig(writeConfig(t, `{"metric_weights": {"message": 1}, "activity_per_drop": 4, "max_drops_per_round": 1,
+		"min_chatters": 1, "max_user_activity": 5, "active_window_seconds": 60, "drops": [{"item_name": "x", "quantity": 1, "weight": 1}],
+		"keyword_drops": [{"keyword": "Kappa", "theme": "t", "item_name": "x", "quantity": 1, "chance_percent": 101}]}`))
+	assert.ErrorContains(t, err, "chance_percent")
+
+	_, err = LoadConfig("configs/missing.json")
+	assert.Error(t, err)
+}
+
+func TestDefaultConfigLoads(t *testing.T) {
+	_, err := LoadConfig(filepath.Join("..", "..", "configs", "chat_drops.json"))
+	require.NoError(t, err)
+}
@@ -0,0 +1,126 @@
+package chatdrop
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+// chatter is one user's activity in the current round
+type chatter struct {
+	userID      string
+	platform    string
+	activity    float64
+	lastCounted time.Time
+	lastSeen    time.Time
+}
+
+// tracker keeps the current round of chat activity for each community. A
+// round lasts from one drop to the next.
+type tracker struct {
+	mu     sync.Mutex
+	rounds map[string]map[string]*chatter
+
+	// keywordReady is when each community's users may next roll a keyword
+	// drop. It outlives rounds, so ending a round does not reset it.
+	keywordReady map[string]map[string]time.Time
+}
+
+// keywordSweepSize is how many users a community's keyword cooldowns hold
+// before expired ones are swept
+const keywordSweepSize = 1024
+
+func newTracker() *tracker {
+	return &tracker{
+		rounds:       make(map[string]map[string]*chatter),
+		keywordReady: make(map[string]map[string]time.Time),
+	}
+}
+
+// record adds activity for a user, applying the config's cooldown and per-user
+// cap. It reports whether the activity was counted.
+func (t *tracker) record(communityID, userID, platform string, activity float64, cfg Config, now time.Time) bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	round, ok := t.rounds[communityID]
+	if !ok {
+		round = make(map[string]*chatter)
+		t.rounds[communityID] = round
+	}
+	c, ok := round[userID]
+	if !ok {
+		c = &chatter{userID: userID}
+		round[userID] = c
+	}
+	c.lastSeen = now
+	if platform != "" {
+		c.platform = platform
+	}
+
+	if !c.lastCounted.IsZero() && now.Sub(c.lastCounted) < cfg.UserCooldown() {
+		return false
+	}
+	if c.activity >= cfg.MaxUserActivity {
+		return false
+	}
+	c.activity = min(c.activity+activity, cfg.MaxUserActivity)
+	c.lastCounted = now
+	return true
+}
+
+// active drops chatters who left the active window from the round and
+// returns the rest with their pooled activity, ordered by user ID
+func (t *tracker) active(communityID string, cfg Config, now time.Time) ([]chatter, float64) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	round := t.rounds[communityID]
+	cutoff := now.Add(-cfg.ActiveWindow())
+	chatters := make([]chatter, 0, len(round))
+	var total float64
+	for userID, c := range round {
+		if c.lastSeen.Before(cutoff) {
+			delete(round, userID)
+			continue
+		}
+		if c.activity > 0 {
+			chatters = append(chatters, *c)
+			total += c.activity
+		}
+	}
+	sort.Slice(chatters, func(i, j int) bool { return chatters[i].userID < chatters[j].userID })
+	return chatters, total
+}
+
+// end starts a new round for the community
+func (t *tracker) end(communityID string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	delete(t.rounds, communityID)
+}
+
+// takeKeyword starts a user's keyword cooldown and reports whether they were
+// off cooldown, so only the first keyword in each cooldown is rolled
+func (t *tracker) takeKeyword(communityID, userID string, cooldown time.Duration, now time.Time) bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	ready, ok := t.keywordReady[communityID]
+	if !ok {
+		ready = make(map[string]time.Time)
+		t.keywordReady[communityID] = ready
+	}
+	if now.Before(ready[userID]) {
+		return false
+	}
+	if len(ready) >= keywordSweepSize {
+		for id, at := range ready {
+			if !now.Before(at) {
+				delete(ready, id)
+			}
+		}
+	}
+	ready[userID] = now.Add(cooldown)
+	return true
+}
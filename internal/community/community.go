@@ -0,0 +1,64 @@
+// Package community identifies which streamer community a request belongs to.
+//
+// One deployment can serve several communities, each with its own users,
+// progression tree state, market and gambles. The community is chosen when a
+// request is authenticated and travels on the context from there, so
+// repositories and services read it with FromContext instead of taking it as a
+// parameter. Code that runs outside a request (jobs, workers, startup) must
+// attach one with WithID before touching per-community state.
+//
+// Contexts without a community belong to Default, which is also where every
+// row created before communities existed lives.
+package community
+
+import (
+	"context"
+	"regexp"
+)
+
+// Default is the community used when none is selected
+const Default = "default"
+
+// idPattern matches valid community IDs
+var idPattern = regexp.MustCompile(`^[a-z0-9_-]{1,32}$`)
+
+// Valid reports whether id can be used as a community ID: 1-32 lowercase
+// letters, digits, '_' or '-'
+func Valid(id string) bool {
+	return idPattern.MatchString(id)
+}
+
+type contextKey struct{}
+
+// WithID returns a context scoped to the given community
+func WithID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, contextKey{}, id)
+}
+
+// FromContext returns the community the context is scoped to, or Default
+func FromContext(ctx context.Context) string {
+	if id, ok := Lookup(ctx); ok {
+		return id
+	}
+	return Default
+}
+
+// Lookup returns the community the context is scoped to. ok is false when no
+// community was attached.
+func Lookup(ctx context.Context) (id string, ok bool) {
+	id, ok = ctx.Value(contextKey{}).(string)
+	if !ok || id == "" {
+		return "", false
+	}
+	return id, true
+}
+
+// Each returns one context per community, derived from ctx. Used by jobs that
+// maintain per-community state.
+func Each(ctx context.Context, ids []string) []context.Context {
+	ctxs := make([]context.Context, 0, len(ids))
+	for _, id := range ids {
+		ctxs = append(ctxs, WithID(ctx, id))
+	}
+	return ctxs
+}
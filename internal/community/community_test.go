@@ -0,0 +1,42 @@
+package community
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFromContext(t *testing.T) {
+	ctx := context.Background()
+	assert.Equal(t, Default, FromContext(ctx))
+
+	_, ok := Lookup(ctx)
+	assert.False(t, ok)
+
+	scoped := WithID(ctx, "alpha")
+	assert.Equal(t, "alpha", FromContext(scoped))
+	id, ok := Lookup(scoped)
+	assert.True(t, ok)
+	assert.Equal(t, "alpha", id)
+
+	// An empty ID counts as unset
+	assert.Equal(t, Default, FromContext(WithID(ctx, "")))
+}
+
+func TestValid(t *testing.T) {
+	for _, id := range []string{"default", "alpha", "team_2", "a-b"} {
+		assert.True(t, Valid(id), id)
+	}
+	for _, id := range []string{"", "Alpha", "has space", "thirty-three-characters-long-name"} {
+		assert.False(t, Valid(id), id)
+	}
+}
+
+func TestEach(t *testing.T) {
+	ctxs := Each(context.Background(), []string{Default, "alpha"})
+
+	assert.Len(t, ctxs, 2)
+	assert.Equal(t, Default, FromContext(ctxs[0]))
+	assert.Equal(t, "alpha", FromContext(ctxs[1]))
+}
@@ -0,0 +1,28 @@
+package communitypool
+
+// DefaultMoneyPerPoint is how much pool money buys one progression
+// contribution point when the service is not told otherwise
+const DefaultMoneyPerPoint = 10
+
+// Donor leaderboard sizes
+const (
+	DefaultDonorLimit = 10
+	MaxDonorLimit     = 100
+)
+
+// Error messages
+const (
+	ErrMsgAmountTooSmallFmt       = "amount must be at least %d to fund one contribution point: %w"
+	ErrMsgWithdrawFailed          = "failed to withdraw from community pool: %w"
+	ErrMsgAddContributionFail     = "failed to add pool contribution: %w"
+	ErrMsgRecordDonationFailed    = "failed to record donation: %w"
+	ErrMsgGetDonationTotalsFailed = "failed to get donation totals: %w"
+)
+
+// Log messages
+const (
+	LogMsgProgressionFunded          = "Community pool funded progression"
+	LogMsgRefundFailed               = "Failed to return money to community pool after contribution failed"
+	LogMsgDonationReceived           = "Community pool donation received"
+	LogMsgDonationContributionFailed = "Donation contribution failed, keeping its value in the pool"
+)
@@ -0,0 +1,43 @@
+package communitypool
+
+import (
+	"context"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/repository"
+)
+
+// Repository stores the community pool's balance and the donations made to it
+type Repository interface {
+	BeginTx(ctx context.Context) (Tx, error)
+
+	// GetInventory reads a user's inventory without locking it
+	GetInventory(ctx context.Context, userID string) (*domain.Inventory, error)
+
+	// GetBalance returns the money the pool holds
+	GetBalance(ctx context.Context) (int64, error)
+
+	// Withdraw takes amount from the pool and returns what is left. It
+	// returns domain.ErrInsufficientFunds and leaves the pool unchanged when
+	// the pool holds less than amount.
+	Withdraw(ctx context.Context, amount int64) (int64, error)
+
+	// Deposit credits the pool
+	Deposit(ctx context.Context, amount int64) error
+
+	// GetDonationTotals returns the value and points of every donation and
+	// how many users have donated
+	GetDonationTotals(ctx context.Context) (value, points int64, donors int, err error)
+
+	// GetTopDonors returns up to limit donors, most value given first
+	GetTopDonors(ctx context.Context, limit int) ([]domain.CommunityDonor, error)
+}
+
+// Tx takes a donation from a user's inventory and records it in one transaction
+type Tx interface {
+	repository.Tx
+	repository.InventoryAdjuster
+	repository.CommunityPoolCreditor
+
+	RecordDonation(ctx context.Context, userID string, itemID, quantity, value, points int) error
+}
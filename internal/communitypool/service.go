@@ -0,0 +1,294 @@
+package communitypool
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/osse101/BrandishBot_Go/internal/database"
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/itemflags"
+	"github.com/osse101/BrandishBot_Go/internal/logger"
+	"github.com/osse101/BrandishBot_Go/internal/naming"
+	"github.com/osse101/BrandishBot_Go/internal/repository"
+	"github.com/osse101/BrandishBot_Go/internal/utils"
+)
+
+// Service runs the community pool, which collects the player shop's fees,
+// the economy's transaction taxes and users' donations, and spends them on
+// progression
+type Service interface {
+	// GetBalance returns the money the pool holds
+	GetBalance(ctx context.Context) (int64, error)
+
+	// FundProgression converts up to amount of the pool's money into
+	// contribution points toward the current unlock. Only whole points are
+	// bought, so any remainder stays in the pool. It returns
+	// domain.ErrInsufficientFunds when the pool holds less than is needed.
+	FundProgression(ctx context.Context, amount int) (*domain.CommunityPoolFunding, error)
+
+	// Donate takes money or items from a user. The donation's value buys
+	// whole contribution points straight away and the remainder stays in
+	// the pool.
+	Donate(ctx context.Context, req DonateRequest) (*domain.CommunityDonation, error)
+
+	// GetStatus returns the pool's balance and donation totals
+	GetStatus(ctx context.Context) (*domain.CommunityPoolStatus, error)
+
+	// GetTopDonors returns the users who have given the most
+	GetTopDonors(ctx context.Context, limit int) ([]domain.CommunityDonor, error)
+}
+
+// DonateRequest asks to give money or items to the community pool
+type DonateRequest struct {
+	Platform   string
+	PlatformID string
+	Username   string
+	ItemName   string
+	Quantity   int
+}
+
+// ContributionAdder adds points toward the current progression unlock
+type ContributionAdder interface {
+	AddContribution(ctx context.Context, amount int) error
+}
+
+// UserService defines the user operations needed by the community pool
+type UserService interface {
+	GetUserOrRegister(ctx context.Context, platform, platformID, username string) (*domain.User, error)
+}
+
+// ItemLookup finds items by name
+type ItemLookup interface {
+	GetItemByName(ctx context.Context, itemName string) (*domain.Item, error)
+}
+
+// LoanChecker reports how many of an item a user holds on loan
+type LoanChecker interface {
+	GetBorrowedQuantity(ctx context.Context, userID string, itemID int) (int, error)
+}
+
+// Option configures optional community pool dependencies
+type Option func(*service)
+
+// WithLoanChecker stops users donating items they have borrowed
+func WithLoanChecker(loans LoanChecker) Option {
+	return func(s *service) {
+		s.loans = loans
+	}
+}
+
+// WithItemLocks stops users donating items they have locked
+func WithItemLocks(locks itemflags.LockChecker) Option {
+	return func(s *service) {
+		s.locks = locks
+	}
+}
+
+type service struct {
+	repo           Repository
+	users          UserService
+	items          ItemLookup
+	namingResolver naming.Resolver
+	progression    ContributionAdder
+	loans          LoanChecker           // nil allows donating everything held
+	locks          itemflags.LockChecker // nil ignores item locks
+	moneyPerPoint  int
+	rnd            func() float64
+}
+
+// NewService creates a community pool service that buys one contribution
+// point for every moneyPerPoint spent or donated
+func NewService(repo Repository, users UserService, items ItemLookup, namingResolver naming.Resolver, progression ContributionAdder, moneyPerPoint int, opts ...Option) Service {
+	if moneyPerPoint <= 0 {
+		moneyPerPoint = DefaultMoneyPerPoint
+	}
+	s := &service{
+		repo:           repo,
+		users:          users,
+		items:          items,
+		namingResolver: namingResolver,
+		progression:    progression,
+		moneyPerPoint:  moneyPerPoint,
+		rnd:            utils.RandomFloat,
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+func (s *service) GetBalance(ctx context.Context) (int64, error) {
+	return s.repo.GetBalance(ctx)
+}
+
+func (s *service) FundProgression(ctx context.Context, amount int) (*domain.CommunityPoolFunding, error) {
+	if amount < s.moneyPerPoint {
+		return nil, fmt.Errorf(ErrMsgAmountTooSmallFmt, s.moneyPerPoint, domain.ErrInvalidInput)
+	}
+
+	points := amount / s.moneyPerPoint
+	spent := points * s.moneyPerPoint
+
+	balance, err := s.repo.Withdraw(ctx, int64(spent))
+	if err != nil {
+		if errors.Is(err, domain.ErrInsufficientFunds) {
+			return nil, err
+		}
+		return nil, fmt.Errorf(ErrMsgWithdrawFailed, err)
+	}
+
+	if err := s.progression.AddContribution(ctx, points); err != nil {
+		// The points never landed, so the money goes back
+		if refundErr := s.repo.Deposit(ctx, int64(spent)); refundErr != nil {
+			logger.FromContext(ctx).Error(LogMsgRefundFailed, "amount", spent, "error", refundErr)
+		}
+		return nil, fmt.Errorf(ErrMsgAddContributionFail, err)
+	}
+
+	logger.FromContext(ctx).Info(LogMsgProgressionFunded, "spent", spent, "points", points, "balance", balance)
+
+	return &domain.CommunityPoolFunding{
+		Spent:   spent,
+		Points:  points,
+		Balance: balance,
+	}, nil
+}
+
+// Donate values money at one per unit and items at their base value
+func (s *service) Donate(ctx context.Context, req DonateRequest) (*domain.CommunityDonation, error) {
+	if req.Quantity <= 0 || req.Quantity > domain.MaxTransactionQuantity {
+		return nil, fmt.Errorf("%w: quantity must be between 1 and %d", domain.ErrInvalidInput, domain.MaxTransactionQuantity)
+	}
+
+	donor, err := s.users.GetUserOrRegister(ctx, req.Platform, req.PlatformID, req.Username)
+	if err != nil {
+		return nil, err
+	}
+	item, err := naming.ResolveItem(ctx, s.namingResolver, s.items, req.ItemName)
+	if err != nil {
+		return nil, err
+	}
+	unitValue := item.BaseValue
+	if item.InternalName == domain.ItemMoney {
+		unitValue = 1
+	}
+	if unitValue <= 0 {
+		return nil, fmt.Errorf("%w: %s has no value to donate", domain.ErrInvalidInput, item.InternalName)
+	}
+	if err := itemflags.EnsureUnlocked(ctx, s.locks, donor.ID, item); err != nil {
+		return nil, err
+	}
+
+	quality, err := s.chooseDonationSlot(ctx, donor.ID, item, req.Quantity)
+	if err != nil {
+		return nil, err
+	}
+
+	value := unitValue * req.Quantity
+	points := value / s.moneyPerPoint
+	remainder := value - points*s.moneyPerPoint
+
+	tx, err := s.repo.BeginTx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer repository.SafeRollback(ctx, tx)
+
+	if _, err := tx.AdjustItemQuantity(ctx, donor.ID, item.ID, quality, -req.Quantity); err != nil {
+		if errors.Is(err, domain.ErrInsufficientQuantity) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to update inventory: %w", err)
+	}
+	if remainder > 0 {
+		if err := tx.AddToCommunityPool(ctx, remainder); err != nil {
+			return nil, fmt.Errorf("failed to credit community pool: %w", err)
+		}
+	}
+	if err := tx.RecordDonation(ctx, donor.ID, item.ID, req.Quantity, value, points); err != nil {
+		return nil, fmt.Errorf(ErrMsgRecordDonationFailed, err)
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	if points > 0 {
+		if err := s.progression.AddContribution(ctx, points); err != nil {
+			// The donation is already taken, so its points are kept in the
+			// pool as money for a later FundProgression
+			logger.FromContext(ctx).Warn(LogMsgDonationContributionFailed, "user_id", donor.ID, "points", points, "error", err)
+			if depositErr := s.repo.Deposit(ctx, int64(points*s.moneyPerPoint)); depositErr != nil {
+				logger.FromContext(ctx).Error(LogMsgRefundFailed, "amount", points*s.moneyPerPoint, "error", depositErr)
+			}
+			points = 0
+		}
+	}
+
+	logger.FromContext(ctx).Info(LogMsgDonationReceived, "user_id", donor.ID, "item", item.InternalName, "quantity", req.Quantity, "value", value, "points", points)
+
+	return &domain.CommunityDonation{
+		UserID:   donor.ID,
+		ItemName: item.InternalName,
+		Quantity: req.Quantity,
+		Value:    value,
+		Points:   points,
+	}, nil
+}
+
+// chooseDonationSlot picks the quality slot to donate from. Only items the
+// donor owns outright can be given, and a donation comes from a single slot.
+func (s *service) chooseDonationSlot(ctx context.Context, userID string, item *domain.Item, quantity int) (domain.QualityLevel, error) {
+	inventory, err := s.repo.GetInventory(database.WithPrimaryReads(ctx), userID)
+	if err != nil {
+		return "", fmt.Errorf("failed to get inventory: %w", err)
+	}
+	borrowed := 0
+	if s.loans != nil {
+		if borrowed, err = s.loans.GetBorrowedQuantity(ctx, userID, item.ID); err != nil {
+			return "", fmt.Errorf("failed to get borrowed quantity: %w", err)
+		}
+	}
+
+	owned := utils.GetTotalQuantity(inventory, item.ID) - borrowed
+	if owned < quantity {
+		if borrowed > 0 {
+			return "", fmt.Errorf("%w: %d of your %s are borrowed", domain.ErrItemBorrowed, borrowed, item.InternalName)
+		}
+		return "", fmt.Errorf("%w: have %d %s", domain.ErrInsufficientQuantity, owned, item.InternalName)
+	}
+
+	slotIndex, slotQuantity := utils.FindRandomSlot(inventory, item.ID, s.rnd)
+	if slotIndex == -1 {
+		return "", domain.ErrNotInInventory
+	}
+	if slotQuantity < quantity {
+		return "", fmt.Errorf("%w: a donation must come from items of one quality", domain.ErrInsufficientQuantity)
+	}
+	return inventory.Slots[slotIndex].QualityLevel, nil
+}
+
+func (s *service) GetStatus(ctx context.Context) (*domain.CommunityPoolStatus, error) {
+	balance, err := s.repo.GetBalance(ctx)
+	if err != nil {
+		return nil, err
+	}
+	value, points, donors, err := s.repo.GetDonationTotals(ctx)
+	if err != nil {
+		return nil, fmt.Errorf(ErrMsgGetDonationTotalsFailed, err)
+	}
+	return &domain.CommunityPoolStatus{
+		Balance:       balance,
+		TotalDonated:  value,
+		PointsFunded:  points,
+		Donors:        donors,
+		MoneyPerPoint: s.moneyPerPoint,
+	}, nil
+}
+
+func (s *service) GetTopDonors(ctx context.Context, limit int) ([]domain.CommunityDonor, error) {
+	if limit <= 0 {
+		limit = DefaultDonorLimit
+	}
+	return s.repo.GetTopDonors(ctx, min(limit, MaxDonorLimit))
+}
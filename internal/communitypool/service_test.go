@@ -0,0 +1,237 @@
+package communitypool_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/osse101/BrandishBot_Go/internal/communitypool"
+	"github.com/osse101/BrandishBot_Go/internal/communitypool/mocks"
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+)
+
+// recordingProgression records contributions and fails with err when set
+type recordingProgression struct {
+	added []int
+	err   error
+}
+
+func (p *recordingProgression) AddContribution(_ context.Context, amount int) error {
+	if p.err != nil {
+		return p.err
+	}
+	p.added = append(p.added, amount)
+	return nil
+}
+
+func TestFundProgression(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("buys whole points and leaves the remainder", func(t *testing.T) {
+		mockRepo := mocks.NewMockRepository(t)
+		progression := &recordingProgression{}
+		svc := communitypool.NewService(mockRepo, nil, nil, nil, progression, 10)
+		mockRepo.On("Withdraw", ctx, int64(250)).Return(int64(40), nil)
+
+		funding, err := svc.FundProgression(ctx, 257)
+
+		require.NoError(t, err)
+		assert.Equal(t, &domain.CommunityPoolFunding{Spent: 250, Points: 25, Balance: 40}, funding)
+		assert.Equal(t, []int{25}, progression.added)
+	})
+
+	t.Run("rejects less than one point", func(t *testing.T) {
+		svc := communitypool.NewService(mocks.NewMockRepository(t), nil, nil, nil, &recordingProgression{}, 10)
+
+		_, err := svc.FundProgression(ctx, 9)
+
+		assert.ErrorIs(t, err, domain.ErrInvalidInput)
+	})
+
+	t.Run("pool too small", func(t *testing.T) {
+		mockRepo := mocks.NewMockRepository(t)
+		progression := &recordingProgression{}
+		svc := communitypool.NewService(mockRepo, nil, nil, nil, progression, 10)
+		mockRepo.On("Withdraw", ctx, int64(100)).Return(int64(0), domain.ErrInsufficientFunds)
+
+		_, err := svc.FundProgression(ctx, 100)
+
+		assert.ErrorIs(t, err, domain.ErrInsufficientFunds)
+		assert.Empty(t, progression.added)
+	})
+
+	t.Run("returns the money when the contribution fails", func(t *testing.T) {
+		mockRepo := mocks.NewMockRepository(t)
+		svc := communitypool.NewService(mockRepo, nil, nil, nil, &recordingProgression{err: errors.New("db down")}, 10)
+		mockRepo.On("Withdraw", ctx, int64(100)).Return(int64(0), nil)
+		mockRepo.On("Deposit", mock.Anything, int64(100)).Return(nil)
+
+		_, err := svc.FundProgression(ctx, 100)
+
+		assert.Error(t, err)
+	})
+}
+
+const (
+	donorID = "donor-1"
+	swordID = 7
+	moneyID = 1
+)
+
+func donateRequest(itemName string, quantity int) communitypool.DonateRequest {
+	return communitypool.DonateRequest{
+		Platform:   domain.PlatformDiscord,
+		PlatformID: "d-1",
+		Username:   "alice",
+		ItemName:   itemName,
+		Quantity:   quantity,
+	}
+}
+
+func TestDonate(t *testing.T) {
+	ctx := context.Background()
+	money := &domain.Item{ID: moneyID, InternalName: domain.ItemMoney}
+	sword := &domain.Item{ID: swordID, InternalName: "sword", BaseValue: 45}
+
+	t.Run("money buys points and leaves the remainder in the pool", func(t *testing.T) {
+		mockRepo := mocks.NewMockRepository(t)
+		mockTx := mocks.NewMockTx(t)
+		mockUsers := mocks.NewMockUserService(t)
+		mockItems := mocks.NewMockItemLookup(t)
+		mockLoans := mocks.NewMockLoanChecker(t)
+		progression := &recordingProgression{}
+		svc := communitypool.NewService(mockRepo, mockUsers, mockItems, nil, progression, 10, communitypool.WithLoanChecker(mockLoans))
+
+		mockUsers.On("GetUserOrRegister", ctx, domain.PlatformDiscord, "d-1", "alice").Return(&domain.User{ID: donorID}, nil)
+		mockItems.On("GetItemByName", ctx, money.InternalName).Return(money, nil)
+		mockRepo.On("GetInventory", mock.Anything, donorID).Return(&domain.Inventory{Slots: []domain.InventorySlot{{ItemID: moneyID, Quantity: 500, QualityLevel: domain.QualityCommon}}}, nil)
+		mockLoans.On("GetBorrowedQuantity", ctx, donorID, money.ID).Return(0, nil)
+		mockRepo.On("BeginTx", mock.Anything).Return(mockTx, nil)
+		mockTx.On("Commit", mock.Anything).Return(nil)
+		mockTx.On("Rollback", mock.Anything).Return(nil).Maybe()
+		mockTx.On("AdjustItemQuantity", mock.Anything, donorID, moneyID, domain.QualityCommon, -123).Return(377, nil)
+		mockTx.On("AddToCommunityPool", mock.Anything, 3).Return(nil)
+		mockTx.On("RecordDonation", mock.Anything, donorID, moneyID, 123, 123, 12).Return(nil)
+
+		got, err := svc.Donate(ctx, donateRequest(domain.ItemMoney, 123))
+
+		require.NoError(t, err)
+		assert.Equal(t, &domain.CommunityDonation{UserID: donorID, ItemName: domain.ItemMoney, Quantity: 123, Value: 123, Points: 12}, got)
+		assert.Equal(t, []int{12}, progression.added)
+	})
+
+	t.Run("items are worth their base value", func(t *testing.T) {
+		mockRepo := mocks.NewMockRepository(t)
+		mockTx := mocks.NewMockTx(t)
+		mockUsers := mocks.NewMockUserService(t)
+		mockItems := mocks.NewMockItemLookup(t)
+		mockLoans := mocks.NewMockLoanChecker(t)
+		progression := &recordingProgression{}
+		svc := communitypool.NewService(mockRepo, mockUsers, mockItems, nil, progression, 10, communitypool.WithLoanChecker(mockLoans))
+
+		mockUsers.On("GetUserOrRegister", ctx, domain.PlatformDiscord, "d-1", "alice").Return(&domain.User{ID: donorID}, nil)
+		mockItems.On("GetItemByName", ctx, sword.InternalName).Return(sword, nil)
+		mockRepo.On("GetInventory", mock.Anything, donorID).Return(&domain.Inventory{Slots: []domain.InventorySlot{{ItemID: swordID, Quantity: 3, QualityLevel: domain.QualityRare}}}, nil)
+		mockLoans.On("GetBorrowedQuantity", ctx, donorID, sword.ID).Return(0, nil)
+		mockRepo.On("BeginTx", mock.Anything).Return(mockTx, nil)
+		mockTx.On("Commit", mock.Anything).Return(nil)
+		mockTx.On("Rollback", mock.Anything).Return(nil).Maybe()
+		mockTx.On("AdjustItemQuantity", mock.Anything, donorID, swordID, domain.QualityRare, -2).Return(1, nil)
+		mockTx.On("RecordDonation", mock.Anything, donorID, swordID, 2, 90, 9).Return(nil)
+
+		got, err := svc.Donate(ctx, donateRequest("sword", 2))
+
+		require.NoError(t, err)
+		assert.Equal(t, 90, got.Value)
+		assert.Equal(t, []int{9}, progression.added)
+	})
+
+	t.Run("borrowed items cannot be donated", func(t *testing.T) {
+		mockRepo := mocks.NewMockRepository(t)
+		mockUsers := mocks.NewMockUserService(t)
+		mockItems := mocks.NewMockItemLookup(t)
+		mockLoans := mocks.NewMockLoanChecker(t)
+		progression := &recordingProgression{}
+		svc := communitypool.NewService(mockRepo, mockUsers, mockItems, nil, progression, 10, communitypool.WithLoanChecker(mockLoans))
+
+		mockUsers.On("GetUserOrRegister", ctx, domain.PlatformDiscord, "d-1", "alice").Return(&domain.User{ID: donorID}, nil)
+		mockItems.On("GetItemByName", ctx, sword.InternalName).Return(sword, nil)
+		mockRepo.On("GetInventory", mock.Anything, donorID).Return(&domain.Inventory{Slots: []domain.InventorySlot{{ItemID: swordID, Quantity: 2, QualityLevel: domain.QualityRare}}}, nil)
+		mockLoans.On("GetBorrowedQuantity", ctx, donorID, sword.ID).Return(1, nil)
+
+		_, err := svc.Donate(ctx, donateRequest("sword", 2))
+
+		assert.ErrorIs(t, err, domain.ErrItemBorrowed)
+	})
+
+	t.Run("worthless items are rejected", func(t *testing.T) {
+		mockRepo := mocks.NewMockRepository(t)
+		mockUsers := mocks.NewMockUserService(t)
+		mockItems := mocks.NewMockItemLookup(t)
+		mockLoans := mocks.NewMockLoanChecker(t)
+		progression := &recordingProgression{}
+		svc := communitypool.NewService(mockRepo, mockUsers, mockItems, nil, progression, 10, communitypool.WithLoanChecker(mockLoans))
+
+		mockUsers.On("GetUserOrRegister", ctx, domain.PlatformDiscord, "d-1", "alice").Return(&domain.User{ID: donorID}, nil)
+		mockItems.On("GetItemByName", ctx, "pebble").Return(&domain.Item{ID: 9, InternalName: "pebble"}, nil)
+
+		_, err := svc.Donate(ctx, donateRequest("pebble", 1))
+
+		assert.ErrorIs(t, err, domain.ErrInvalidInput)
+	})
+
+	t.Run("keeps the value in the pool when the contribution fails", func(t *testing.T) {
+		mockRepo := mocks.NewMockRepository(t)
+		mockTx := mocks.NewMockTx(t)
+		mockUsers := mocks.NewMockUserService(t)
+		mockItems := mocks.NewMockItemLookup(t)
+		mockLoans := mocks.NewMockLoanChecker(t)
+		progression := &recordingProgression{}
+		svc := communitypool.NewService(mockRepo, mockUsers, mockItems, nil, progression, 10, communitypool.WithLoanChecker(mockLoans))
+
+		progression.err = errors.New("db down")
+		mockUsers.On("GetUserOrRegister", ctx, domain.PlatformDiscord, "d-1", "alice").Return(&domain.User{ID: donorID}, nil)
+		mockItems.On("GetItemByName", ctx, money.InternalName).Return(money, nil)
+		mockRepo.On("GetInventory", mock.Anything, donorID).Return(&domain.Inventory{Slots: []domain.InventorySlot{{ItemID: moneyID, Quantity: 500, QualityLevel: domain.QualityCommon}}}, nil)
+		mockLoans.On("GetBorrowedQuantity", ctx, donorID, money.ID).Return(0, nil)
+		mockRepo.On("BeginTx", mock.Anything).Return(mockTx, nil)
+		mockTx.On("Commit", mock.Anything).Return(nil)
+		mockTx.On("Rollback", mock.Anything).Return(nil).Maybe()
+		mockTx.On("AdjustItemQuantity", mock.Anything, donorID, moneyID, domain.QualityCommon, -100).Return(400, nil)
+		mockTx.On("RecordDonation", mock.Anything, donorID, moneyID, 100, 100, 10).Return(nil)
+		mockRepo.On("Deposit", mock.Anything, int64(100)).Return(nil)
+
+		got, err := svc.Donate(ctx, donateRequest(domain.ItemMoney, 100))
+
+		require.NoError(t, err)
+		assert.Equal(t, 0, got.Points)
+	})
+}
+
+func TestGetStatus(t *testing.T) {
+	ctx := context.Background()
+	mockRepo := mocks.NewMockRepository(t)
+	svc := communitypool.NewService(mockRepo, nil, nil, nil, &recordingProgression{}, 10)
+	mockRepo.On("GetBalance", ctx).Return(int64(42), nil)
+	mockRepo.On("GetDonationTotals", ctx).Return(int64(1500), int64(148), 3, nil)
+
+	status, err := svc.GetStatus(ctx)
+
+	require.NoError(t, err)
+	assert.Equal(t, &domain.CommunityPoolStatus{Balance: 42, TotalDonated: 1500, PointsFunded: 148, Donors: 3, MoneyPerPoint: 10}, status)
+}
+
+func TestGetTopDonors_CapsLimit(t *testing.T) {
+	ctx := context.Background()
+	mockRepo := mocks.NewMockRepository(t)
+	svc := communitypool.NewService(mockRepo, nil, nil, nil, &recordingProgression{}, 10)
+	mockRepo.On("GetTopDonors", ctx, communitypool.MaxDonorLimit).Return(nil, nil)
+
+	_, err := svc.GetTopDonors(ctx, 5000)
+
+	require.NoError(t, err)
+}
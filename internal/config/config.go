@@ -2,21 +2,61 @@ package config
 
 import (
 	"fmt"
+	"net/url"
 	"os"
+	"regexp"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
 
 	"github.com/joho/godotenv"
+
+	"github.com/osse101/BrandishBot_Go/internal/community"
 )
 
+// ExternalContributionKey is a scoped API key for one external contribution
+// source. HourlyCap limits the points the source may add per hour (0 = no cap).
+type ExternalContributionKey struct {
+	Source    string
+	Key       string
+	HourlyCap int
+}
+
+// CommunityKey is an API key pinned to one community. Requests made with it
+// only see and change that community's state.
+type CommunityKey struct {
+	Community string
+	Key       string
+}
+
+// VoteWeightTier gives votes from users whose contribution score is at least
+// MinScore the given Weight
+type VoteWeightTier struct {
+	MinScore float64
+	Weight   int
+}
+
+// externalSourcePattern matches the source names accepted by the progression
+// service for external contributions
+var externalSourcePattern = regexp.MustCompile(`^[a-z0-9_-]{1,32}$`)
+
 // Config holds the application configuration
 type Config struct {
 	// Server
 	Port           int
+	GRPCPort       int      // GRPC_PORT: port for the gRPC API; 0 disables it
 	APIKey         string   // API key for authentication
 	TrustedProxies []string // List of trusted proxy IPs
 
+	// ExternalContributionKeys are API keys limited to the external
+	// contribution endpoint, each tied to one contribution source
+	ExternalContributionKeys []ExternalContributionKey
+
+	// CommunityKeys are API keys pinned to one community. The communities
+	// they name, plus the default community, are the ones this deployment serves.
+	CommunityKeys []CommunityKey
+
 	// Logging
 	LogLevel    string
 	LogFormat   string // "json" or "text"
@@ -25,6 +65,11 @@ type Config struct {
 	Version     string
 	Environment string // "dev", "staging", "prod"
 
+	// HTTP access logging
+	AccessLogEnabled        bool    // ACCESS_LOG_ENABLED=true: log every request with latency, status, and user identity
+	AccessLogBodySampleRate float64 // Fraction of error responses whose request body is logged (0.0-1.0, default: 0.1)
+	AccessLogMaxBodyBytes   int     // Max request body bytes captured for sampling (default: 2048)
+
 	// Discord Configuration
 	DiscordToken                string `mapstructure:"DISCORD_TOKEN"`
 	DiscordAppID                string `mapstructure:"DISCORD_APP_ID"`
@@ -44,30 +89,215 @@ type Config struct {
 	DBName     string
 	DBURL      string // Database connection URL (optional, overrides individual components)
 
+	// DBReplicaURL is an optional read replica connection URL. Leaderboards,
+	// inventory reads and tree queries use it; writes stay on the primary.
+	DBReplicaURL string
+
 	// Database Pool
 	DBMaxConns        int
 	DBMaxConnIdleTime time.Duration
 	DBMaxConnLifetime time.Duration
 
+	// Item catalog cache
+	ItemCacheTTL time.Duration // ITEM_CACHE_TTL: how long item lookups are served from memory; 0 disables (default: 5m)
+
+	// Config hot-reload
+	ConfigWatchEnabled bool // CONFIG_WATCH_ENABLED: reload loot tables, aliases, themes and the progression tree when their files change (default: true)
+
 	// Gamble configuration
-	GambleJoinDuration time.Duration // Duration for users to join a gamble
+	GambleJoinDuration    time.Duration // Duration for users to join a gamble
+	GambleStaleTimeout    time.Duration // GAMBLE_STALE_TIMEOUT: how long past its join deadline a gamble may stay unresolved before the recovery job resumes or refunds it (default: 10m)
+	GambleMinParticipants int           // GAMBLE_MIN_PARTICIPANTS: fewest participants a gamble executes with; fewer by the deadline refunds it (default: 2)
+	GambleMaxParticipants int           // GAMBLE_MAX_PARTICIPANTS: most participants a gamble accepts, 0 for no limit (default: 0)
+	GambleMaxWagerValue   int           // GAMBLE_MAX_WAGER_VALUE: highest total base value of the lootboxes one participant wagers, 0 for no limit (default: 0)
+
+	// Game state projection
+	StateProjectionMaxAge time.Duration // STATE_PROJECTION_MAX_AGE: longest an in-memory status snapshot is served before reloading (default: 5s)
 
 	// Streamer.bot configuration
 	StreamerbotEnabled    bool   // Enable WebSocket connection to Streamer.bot
 	StreamerbotWebhookURL string // WebSocket URL for Streamer.bot (e.g., ws://127.0.0.1:8080/ or http://IP:PORT/streamerbot)
 
+	// Inventory sync webhook
+	InventoryWebhookURL    string // INVENTORY_WEBHOOK_URL: endpoint that receives every inventory.changed event (empty disables)
+	InventoryWebhookSecret string // INVENTORY_WEBHOOK_SECRET: when set, requests carry an HMAC-SHA256 signature of the body
+
 	// Development Settings
 	DevMode bool // When true, bypasses cooldowns and enables test features
 
+	// Randomness
+	RNGSeed     int64 // RNG_SEED: master seed for game randomness; 0 picks a random seed at startup
+	RNGLogSeeds bool  // RNG_LOG_SEEDS=true: log the seed of every lootbox, search, gamble and voting roll
+
 	// Feature Flags
 	DisableProgressionGains bool // DISABLE_PROGRESSION_GAINS=true: skip contribution score calculation
 	DisableJobXPGains       bool // DISABLE_JOB_XP_GAINS=true: all AwardXP calls return 0 XP
 
+	// JobXPSourceCaps limit the job XP a user can earn from each source between daily resets
+	// (JOB_XP_SOURCE_CAPS: comma-separated source:cap entries; empty means no per-source caps)
+	JobXPSourceCaps map[string]int
+
 	// Event Publishing
 	EventMaxRetries     int           // Max retries for event publishing (default: 5)
 	EventRetryDelay     time.Duration // Base delay for exponential backoff (default: 2s)
 	EventDeadLetterPath string        // Path to dead-letter log file (default: logs/event_deadletter.jsonl)
 
+	// Per-handler retry settings (failures that exhaust retries go to the dead-letter queue)
+	EventHandlerMaxRetries int           // Attempts per failing handler, including the first (default: 3)
+	EventHandlerRetryDelay time.Duration // Base delay for handler retry backoff (default: 1s)
+
+	// Event Bus backend
+	EventBusBackend string // "memory" (default) or "nats" for multi-instance deployments
+	NATSURL         string // NATS server URL when EventBusBackend is "nats"
+	NATSSubject     string // Subject used to relay events between instances
+
+	// Postgres LISTEN/NOTIFY event bridge
+	EventBridgeTypes   []string // EVENT_BRIDGE_TYPES: comma-separated event types sent to other processes over NOTIFY (empty disables)
+	EventBridgeChannel string   // EVENT_BRIDGE_CHANNEL: NOTIFY channel the bridged events are sent on (default: brandishbot_events)
+
+	// Worker pool
+	WorkerPoolSize  int // Number of background workers (default: 5)
+	WorkerQueueSize int // Jobs that may wait beyond the running ones before enqueue blocks or is rejected (default: 100)
+
+	// Reconciliation
+	ReconcileCron    string // Cron expression (UTC) for the reconciliation job (default: "30 4 * * *")
+	ReconcileHealMax int    // Largest drift the reconciliation job corrects automatically (default: 5)
+
+	// Vote brigading detection
+	VoteBrigadeWindow      time.Duration // VOTE_BRIGADE_WINDOW: span a burst of votes from never-active accounts must fall within; also how often the open session is scanned (default: 2m)
+	VoteBrigadeMinVotes    int           // VOTE_BRIGADE_MIN_VOTES: never-active votes for one option within the window that count as a burst (default: 5)
+	VoteBrigadeAutoExclude bool          // VOTE_BRIGADE_AUTO_EXCLUDE: exclude flagged votes from the tally instead of waiting for admin review (default: false)
+
+	// VoteWeightTiers scale a vote by the voter's contribution score
+	// (VOTE_WEIGHT_TIERS: comma-separated minScore:weight entries; empty means every vote counts once)
+	VoteWeightTiers []VoteWeightTier
+
+	// Contribution scores
+	ContributionDecayInterval    time.Duration // CONTRIBUTION_DECAY_INTERVAL: how often user contribution scores decay (default: 24h)
+	ContributionDecayRate        float64       // CONTRIBUTION_DECAY_RATE: share of every score removed per decay run, 0 disables decay (default: 0.05)
+	ContributionCatchUpThreshold float64       // CONTRIBUTION_CATCHUP_THRESHOLD: users below this fraction of the average score earn a catch-up bonus, 0 disables it (default: 0.5)
+	ContributionCatchUpMax       float64       // CONTRIBUTION_CATCHUP_MAX_MULTIPLIER: catch-up bonus for a user with no score, tapering to 1 at the threshold (default: 2.0)
+
+	// Reminders
+	ReminderDispatchInterval time.Duration // REMINDER_DISPATCH_INTERVAL: how often due reminders are sent (default: 30s)
+	ReminderVoteLead         time.Duration // REMINDER_VOTE_LEAD: how long before voting closes a vote reminder fires (default: 5m)
+	ReminderMaxPending       int           // REMINDER_MAX_PENDING: reminders a user can have waiting at once (default: 10)
+
+	// Chat drops
+	ChatDropInterval time.Duration // CHAT_DROP_INTERVAL: how often chat activity is turned into lootbox drops (default: 5m)
+
+	// Item loans
+	LoanReturnInterval  time.Duration // LOAN_RETURN_INTERVAL: how often due loans are returned to their lenders (default: 1m)
+	LoanDefaultDuration time.Duration // LOAN_DEFAULT_DURATION: how long a loan lasts when the lender does not say (default: 24h)
+	LoanMaxDuration     time.Duration // LOAN_MAX_DURATION: the longest a loan can last (default: 168h)
+
+	// Stats rollups
+	StatsRollupInterval time.Duration // STATS_ROLLUP_INTERVAL: how often closed stats buckets are rolled up (default: 5m)
+	StatsRollupBuckets  []string      // STATS_ROLLUP_BUCKETS: comma-separated bucket sizes to roll up, from hour, day and week (default: hour,day,week)
+
+	// Item balance
+	BalanceApplyInterval time.Duration // BALANCE_APPLY_INTERVAL: how often scheduled item balance changes are checked and applied (default: 1m)
+
+	// Guild API tokens
+	APITokenMaxPerGuild int // API_TOKEN_MAX_PER_GUILD: active read-only API tokens a guild can hold (default: 5)
+
+	// Chat commands
+	ChatCommandPrefix string // CHAT_COMMAND_PREFIX: prefix that marks a chat message sent to /message/handle as a command, empty to turn text commands off (default: !)
+
+	// Outgoing webhooks
+	WebhookDeliveryInterval time.Duration // WEBHOOK_DELIVERY_INTERVAL: how often due webhook deliveries are sent (default: 15s)
+	WebhookMaxAttempts      int           // WEBHOOK_MAX_ATTEMPTS: attempts before a webhook delivery is marked failed (default: 6)
+
+	// Player shop
+	PlayerShopFeePercent        int // PLAYER_SHOP_FEE_PERCENT: share of each player shop sale paid into the community pool (default: 5)
+	PlayerShopListingFeePercent int // PLAYER_SHOP_LISTING_FEE_PERCENT: share of a listing's asking price the seller pays to post it, burned as a money sink (default: 1)
+	PlayerShopMaxListings       int // PLAYER_SHOP_MAX_LISTINGS: active listings a seller can have at once (default: 10)
+
+	// Dynamic pricing
+	MarketPriceSensitivity float64       // MARKET_PRICE_SENSITIVITY: price change per unit of net trade pressure, 0 keeps static prices (default: 0.01)
+	MarketPriceHalfLife    time.Duration // MARKET_PRICE_HALF_LIFE: how long trade pressure takes to halve (default: 12h)
+	MarketPriceFloor       float64       // MARKET_PRICE_FLOOR: lowest price as a multiple of base value (default: 0.7)
+	MarketPriceCeiling     float64       // MARKET_PRICE_CEILING: highest price as a multiple of base value (default: 1.5)
+	MarketSnapshotInterval time.Duration // MARKET_SNAPSHOT_INTERVAL: how often moved prices are recorded to price history (default: 15m)
+	MarketHistoryRetention time.Duration // MARKET_HISTORY_RETENTION: how long price history is kept (default: 720h)
+
+	// Transaction taxes, paid into the community pool (0 turns a tax off)
+	SellTaxPercent             int // SELL_TAX_PERCENT: share of sale proceeds taken as tax (default: 5)
+	GiveTaxPercent             int // GIVE_TAX_PERCENT: share of money given above GIVE_TAX_THRESHOLD taken as tax (default: 5)
+	GiveTaxThreshold           int // GIVE_TAX_THRESHOLD: money a single give can move untaxed (default: 1000)
+	GambleRakePercent          int // GAMBLE_RAKE_PERCENT: share of the money in a gamble pot withheld from the winner (default: 5)
+	CommunityPoolMoneyPerPoint int // COMMUNITY_POOL_MONEY_PER_POINT: pool money spent per progression contribution point (default: 10)
+
+	// Progressive jackpot, fed by the house from lootbox openings and gambles
+	JackpotContributionPercent int     // JACKPOT_CONTRIBUTION_PERCENT: share of each opening's value added to the jackpot (default: 2)
+	JackpotTriggerChance       float64 // JACKPOT_TRIGGER_CHANCE: chance each opening wins the jackpot (default: 0.001)
+	JackpotMaxBalance          int     // JACKPOT_MAX_BALANCE: most the jackpot grows to, bounding the money a win mints, 0 for no maximum (default: 50000)
+
+	// Bank
+	BankInterestRate     float64 // BANK_INTEREST_RATE: share of a balance paid as interest per payout, 0 pays none (default: 0.01)
+	BankInterestCap      int     // BANK_INTEREST_CAP: most interest one account earns per payout, 0 for no cap (default: 500)
+	BankInterestCron     string  // BANK_INTEREST_CRON: cron expression (UTC) for interest payouts (default: "0 0 * * *")
+	BankWithdrawLimit    int     // BANK_WITHDRAW_DAILY_LIMIT: money a user can withdraw from the bank per UTC day, 0 for no limit (default: 5000)
+	BankGoalBonusPercent int     // BANK_GOAL_BONUS_PERCENT: share of what was saved toward a goal paid as a bonus when it is reached, capped at BANK_INTEREST_CAP (default: 5)
+
+	// Gifts
+	GiftDeliveryInterval time.Duration // GIFT_DELIVERY_INTERVAL: how often due gifts are delivered (default: 1m)
+	GiftMaxPending       int           // GIFT_MAX_PENDING: most undelivered gifts a user can have (default: 10)
+	GiftMaxDelay         time.Duration // GIFT_MAX_DELAY: furthest ahead a gift delivery can be scheduled (default: 720h)
+
+	// Inbox
+	InboxMaxMessages int // INBOX_MAX_MESSAGES: newest inbox messages kept per user (default: 100)
+
+	// Crafting quality tiers, rolled for each upgrade craft
+	CraftFineChance        float64 // CRAFT_FINE_CHANCE: chance a craft is fine, one quality level up (default: 0.2)
+	CraftMasterworkChance  float64 // CRAFT_MASTERWORK_CHANCE: chance a craft is a masterwork, two quality levels up and double output (default: 0.1)
+	CraftLegendaryChance   float64 // CRAFT_LEGENDARY_CHANCE: chance a craft is legendary, three quality levels up and triple output (default: 0.01)
+	CraftTierJobLevelBonus float64 // CRAFT_TIER_JOB_LEVEL_BONUS: relative boost to every tier chance per Blacksmith level (default: 0.02)
+	// CraftToolBonuses boost every tier chance while the crafter holds the tool
+	// (CRAFT_TOOL_BONUSES: comma-separated item:bonus entries, by item internal name; default: item_shovel:0.05)
+	CraftToolBonuses map[string]float64
+
+	// Recipe mastery
+	CraftMasteryThreshold       int     // CRAFT_MASTERY_THRESHOLD: crafts of a recipe needed to master it, 0 disables mastery (default: 100)
+	CraftMasteryMasterworkBonus float64 // CRAFT_MASTERY_MASTERWORK_BONUS: masterwork chance added to mastered recipes (default: 0.02)
+
+	// Effects
+	EffectExpiryInterval time.Duration // EFFECT_EXPIRY_INTERVAL: how often expired timed effects are removed (default: 1m)
+
+	// Inventory
+	InventorySlotLimit int // INVENTORY_SLOT_LIMIT: distinct item slots a user can hold before stash upgrades, 0 for no limit (default: 50)
+
+	// Undo
+	UndoWindow time.Duration // UNDO_WINDOW: how long a sell, disassemble or give can be undone, 0 to turn undo off (default: 5m)
+
+	// Give limits
+	GiveDailyLimit     int           // GIVE_DAILY_LIMIT: gives a user may make per UTC day, 0 for no limit (default: 50)
+	GiveMinAccountAge  time.Duration // GIVE_MIN_ACCOUNT_AGE: how long a user must have been registered before they can give, 0 to allow new accounts (default: 24h)
+	GiveCircularWindow time.Duration // GIVE_CIRCULAR_WINDOW: a give back to a user who gave to the giver this recently is flagged for admin review, 0 to turn flagging off (default: 10m)
+
+	// Cooldowns (0 turns an action's cooldown off)
+	CooldownSearch      time.Duration // COOLDOWN_SEARCH: wait between searches (default: 30m)
+	CooldownSlots       time.Duration // COOLDOWN_SLOTS: wait between slots spins (default: 10m)
+	CooldownGambleStart time.Duration // COOLDOWN_GAMBLE_START: wait before a user can start another gamble (default: 5m)
+	CooldownGive        time.Duration // COOLDOWN_GIVE: wait between item gifts (default: 0)
+	CooldownUseItem     time.Duration // COOLDOWN_USE_ITEM: wait between item uses (default: 0)
+	CooldownJobSwitch   time.Duration // COOLDOWN_JOB_SWITCH: wait between changes of active job (default: 24h)
+	CooldownUndo        time.Duration // COOLDOWN_UNDO: wait between undos (default: 10m)
+	CooldownDig         time.Duration // COOLDOWN_DIG: wait between digs at the community dig site (default: 15m)
+	CooldownFish        time.Duration // COOLDOWN_FISH: wait between fishing casts (default: 2m)
+	CooldownBossAttack  time.Duration // COOLDOWN_BOSS_ATTACK: wait between attacks on the community boss (default: 1m)
+
+	// Celebrations
+	CelebrationCron       string // Cron expression (UTC) for the birthday and anniversary job (default: "0 15 * * *")
+	CelebrationRewardItem string // Item granted for each birthday or anniversary (default: "lootbox_tier1")
+
+	// Game state snapshots
+	SnapshotCron      string // SNAPSHOT_CRON: cron expression (UTC) for automatic snapshots; empty disables them (default: "0 3 * * *")
+	SnapshotRetention int    // SNAPSHOT_RETENTION: scheduled snapshots kept; older ones are pruned (default: 7)
+
+	// Boss raids
+	BossSpawnCron string // BOSS_SPAWN_CRON: cron expression (UTC) for spawning a random boss; empty leaves spawning to admins (default: "")
+
 	// Subscription settings
 	SubscriptionCheckInterval   time.Duration // How often to check for expiring subscriptions (default: 6h)
 	SubscriptionDefaultDuration time.Duration // Default subscription length (default: 720h / 30 days)
@@ -96,6 +326,8 @@ func Load() (*Config, error) {
 		DBName:     getEnv("DB_NAME", "brandishbot"),
 		DBURL:      getEnv("DB_URL", ""),
 
+		DBReplicaURL: getEnv("DB_REPLICA_URL", ""),
+
 		// Database pool defaults
 		DBMaxConns:        getEnvAsInt("DB_MAX_CONNS", 20),
 		DBMaxConnIdleTime: getEnvAsDuration("DB_MAX_CONN_IDLE_TIME", 5*time.Minute),
@@ -118,10 +350,45 @@ func Load() (*Config, error) {
 		// Streamer.bot config
 		StreamerbotWebhookURL: getEnv("STREAMERBOT_WEBHOOK_URL", ""),
 
+		// Inventory sync webhook config
+		InventoryWebhookURL:    getEnv("INVENTORY_WEBHOOK_URL", ""),
+		InventoryWebhookSecret: getEnv("INVENTORY_WEBHOOK_SECRET", ""),
+
 		// Event publishing config
 		EventMaxRetries:     getEnvAsInt("EVENT_MAX_RETRIES", 5),
 		EventRetryDelay:     getEnvAsDuration("EVENT_RETRY_DELAY", 2*time.Second),
 		EventDeadLetterPath: getEnv("EVENT_DEADLETTER_PATH", "logs/event_deadletter.jsonl"),
+
+		// Per-handler retry config
+		EventHandlerMaxRetries: getEnvAsInt("EVENT_HANDLER_MAX_RETRIES", 3),
+		EventHandlerRetryDelay: getEnvAsDuration("EVENT_HANDLER_RETRY_DELAY", time.Second),
+
+		// Event bus backend config
+		EventBusBackend: strings.ToLower(getEnv("EVENT_BUS_BACKEND", "memory")),
+		NATSURL:         getEnv("NATS_URL", "nats://localhost:4222"),
+		NATSSubject:     getEnv("NATS_SUBJECT", "brandishbot.events"),
+
+		// Event bridge config
+		EventBridgeChannel: getEnv("EVENT_BRIDGE_CHANNEL", "brandishbot_events"),
+
+		// Worker pool config
+		WorkerPoolSize:  getEnvAsInt("WORKER_POOL_SIZE", 5),
+		WorkerQueueSize: getEnvAsInt("WORKER_QUEUE_SIZE", 100),
+
+		// Reconciliation config
+		ReconcileCron:    getEnv("RECONCILE_CRON", "30 4 * * *"),
+		ReconcileHealMax: getEnvAsInt("RECONCILE_HEAL_MAX", 5),
+
+		// Celebration config
+		CelebrationCron:       getEnv("CELEBRATION_CRON", "0 15 * * *"),
+		CelebrationRewardItem: getEnv("CELEBRATION_REWARD_ITEM", "lootbox_tier1"),
+
+		// Snapshot config
+		SnapshotCron:      getEnv("SNAPSHOT_CRON", "0 3 * * *"),
+		SnapshotRetention: getEnvAsInt("SNAPSHOT_RETENTION", 7),
+
+		// Boss raid config
+		BossSpawnCron: getEnv("BOSS_SPAWN_CRON", ""),
 	}
 
 	portStr := getEnv("PORT", "8080")
@@ -138,19 +405,407 @@ func Load() (*Config, error) {
 		return nil, fmt.Errorf("invalid GAMBLE_JOIN_DURATION_MINUTES value: %w", err)
 	}
 	cfg.GambleJoinDuration = time.Duration(gambleJoinMins) * time.Minute
+	cfg.GambleStaleTimeout = getEnvAsDuration("GAMBLE_STALE_TIMEOUT", 10*time.Minute)
+	if cfg.GambleStaleTimeout <= 0 {
+		return nil, fmt.Errorf("GAMBLE_STALE_TIMEOUT must be positive")
+	}
+	cfg.GambleMinParticipants = getEnvAsInt("GAMBLE_MIN_PARTICIPANTS", 2)
+	if cfg.GambleMinParticipants < 2 {
+		return nil, fmt.Errorf("invalid GAMBLE_MIN_PARTICIPANTS value %d: must be at least 2", cfg.GambleMinParticipants)
+	}
+	cfg.GambleMaxParticipants = getEnvAsInt("GAMBLE_MAX_PARTICIPANTS", 0)
+	if cfg.GambleMaxParticipants != 0 && cfg.GambleMaxParticipants < cfg.GambleMinParticipants {
+		return nil, fmt.Errorf("invalid GAMBLE_MAX_PARTICIPANTS value %d: must be 0 or at least GAMBLE_MIN_PARTICIPANTS", cfg.GambleMaxParticipants)
+	}
+	cfg.GambleMaxWagerValue = getEnvAsInt("GAMBLE_MAX_WAGER_VALUE", 0)
+	if cfg.GambleMaxWagerValue < 0 {
+		return nil, fmt.Errorf("invalid GAMBLE_MAX_WAGER_VALUE value %d: must not be negative", cfg.GambleMaxWagerValue)
+	}
+
+	cfg.ItemCacheTTL = getEnvAsDuration("ITEM_CACHE_TTL", 5*time.Minute)
+	if cfg.ItemCacheTTL < 0 {
+		return nil, fmt.Errorf("invalid ITEM_CACHE_TTL value %v: must not be negative", cfg.ItemCacheTTL)
+	}
+
+	cfg.StateProjectionMaxAge = getEnvAsDuration("STATE_PROJECTION_MAX_AGE", 5*time.Second)
+	if cfg.StateProjectionMaxAge <= 0 {
+		return nil, fmt.Errorf("invalid STATE_PROJECTION_MAX_AGE value %v: must be positive", cfg.StateProjectionMaxAge)
+	}
+
+	cfg.ConfigWatchEnabled = getEnv("CONFIG_WATCH_ENABLED", "true") == "true"
+
+	// Vote brigading detection
+	cfg.VoteBrigadeWindow = getEnvAsDuration("VOTE_BRIGADE_WINDOW", 2*time.Minute)
+	if cfg.VoteBrigadeWindow <= 0 {
+		return nil, fmt.Errorf("invalid VOTE_BRIGADE_WINDOW value %v: must be positive", cfg.VoteBrigadeWindow)
+	}
+	cfg.VoteBrigadeMinVotes = getEnvAsInt("VOTE_BRIGADE_MIN_VOTES", 5)
+	if cfg.VoteBrigadeMinVotes < 2 {
+		return nil, fmt.Errorf("invalid VOTE_BRIGADE_MIN_VOTES value %d: must be at least 2", cfg.VoteBrigadeMinVotes)
+	}
+	cfg.VoteBrigadeAutoExclude = getEnv("VOTE_BRIGADE_AUTO_EXCLUDE", "false") == "true"
+
+	voteWeightTiers, err := parseVoteWeightTiers(getEnv("VOTE_WEIGHT_TIERS", ""))
+	if err != nil {
+		return nil, err
+	}
+	cfg.VoteWeightTiers = voteWeightTiers
+
+	// Contribution scores
+	cfg.ContributionDecayInterval = getEnvAsDuration("CONTRIBUTION_DECAY_INTERVAL", 24*time.Hour)
+	if cfg.ContributionDecayInterval <= 0 {
+		return nil, fmt.Errorf("invalid CONTRIBUTION_DECAY_INTERVAL value %v: must be positive", cfg.ContributionDecayInterval)
+	}
+	cfg.ContributionDecayRate = getEnvAsFloat("CONTRIBUTION_DECAY_RATE", 0.05)
+	if cfg.ContributionDecayRate < 0 || cfg.ContributionDecayRate >= 1 {
+		return nil, fmt.Errorf("invalid CONTRIBUTION_DECAY_RATE value %v: must be at least 0 and below 1", cfg.ContributionDecayRate)
+	}
+	cfg.ContributionCatchUpThreshold = getEnvAsFloat("CONTRIBUTION_CATCHUP_THRESHOLD", 0.5)
+	if cfg.ContributionCatchUpThreshold < 0 || cfg.ContributionCatchUpThreshold > 1 {
+		return nil, fmt.Errorf("invalid CONTRIBUTION_CATCHUP_THRESHOLD value %v: must be between 0 and 1", cfg.ContributionCatchUpThreshold)
+	}
+	cfg.ContributionCatchUpMax = getEnvAsFloat("CONTRIBUTION_CATCHUP_MAX_MULTIPLIER", 2.0)
+	if cfg.ContributionCatchUpMax < 1 {
+		return nil, fmt.Errorf("invalid CONTRIBUTION_CATCHUP_MAX_MULTIPLIER value %v: must be at least 1", cfg.ContributionCatchUpMax)
+	}
+
+	// Reminders
+	cfg.ReminderDispatchInterval = getEnvAsDuration("REMINDER_DISPATCH_INTERVAL", 30*time.Second)
+	if cfg.ReminderDispatchInterval <= 0 {
+		return nil, fmt.Errorf("invalid REMINDER_DISPATCH_INTERVAL value %v: must be positive", cfg.ReminderDispatchInterval)
+	}
+	cfg.ReminderVoteLead = getEnvAsDuration("REMINDER_VOTE_LEAD", 5*time.Minute)
+	if cfg.ReminderVoteLead <= 0 {
+		return nil, fmt.Errorf("invalid REMINDER_VOTE_LEAD value %v: must be positive", cfg.ReminderVoteLead)
+	}
+	cfg.ReminderMaxPending = getEnvAsInt("REMINDER_MAX_PENDING", 10)
+	if cfg.ReminderMaxPending < 1 {
+		return nil, fmt.Errorf("invalid REMINDER_MAX_PENDING value %d: must be at least 1", cfg.ReminderMaxPending)
+	}
+
+	// Chat drops
+	cfg.ChatDropInterval = getEnvAsDuration("CHAT_DROP_INTERVAL", 5*time.Minute)
+	if cfg.ChatDropInterval <= 0 {
+		return nil, fmt.Errorf("invalid CHAT_DROP_INTERVAL value %v: must be positive", cfg.ChatDropInterval)
+	}
+
+	// Item loans
+	cfg.LoanReturnInterval = getEnvAsDuration("LOAN_RETURN_INTERVAL", time.Minute)
+	if cfg.LoanReturnInterval <= 0 {
+		return nil, fmt.Errorf("invalid LOAN_RETURN_INTERVAL value %v: must be positive", cfg.LoanReturnInterval)
+	}
+	cfg.LoanMaxDuration = getEnvAsDuration("LOAN_MAX_DURATION", 7*24*time.Hour)
+	if cfg.LoanMaxDuration <= 0 {
+		return nil, fmt.Errorf("invalid LOAN_MAX_DURATION value %v: must be positive", cfg.LoanMaxDuration)
+	}
+	cfg.LoanDefaultDuration = getEnvAsDuration("LOAN_DEFAULT_DURATION", 24*time.Hour)
+	if cfg.LoanDefaultDuration <= 0 || cfg.LoanDefaultDuration > cfg.LoanMaxDuration {
+		return nil, fmt.Errorf("invalid LOAN_DEFAULT_DURATION value %v: must be positive and at most LOAN_MAX_DURATION", cfg.LoanDefaultDuration)
+	}
+
+	// Stats rollups
+	cfg.StatsRollupInterval = getEnvAsDuration("STATS_ROLLUP_INTERVAL", 5*time.Minute)
+	if cfg.StatsRollupInterval <= 0 {
+		return nil, fmt.Errorf("invalid STATS_ROLLUP_INTERVAL value %v: must be positive", cfg.StatsRollupInterval)
+	}
+	for _, bucket := range strings.Split(getEnv("STATS_ROLLUP_BUCKETS", "hour,day,week"), ",") {
+		switch trimmed := strings.TrimSpace(bucket); trimmed {
+		case "":
+		case "hour", "day", "week":
+			cfg.StatsRollupBuckets = append(cfg.StatsRollupBuckets, trimmed)
+		default:
+			return nil, fmt.Errorf("invalid STATS_ROLLUP_BUCKETS entry %q: must be hour, day or week", trimmed)
+		}
+	}
+
+	// Item balance
+	cfg.BalanceApplyInterval = getEnvAsDuration("BALANCE_APPLY_INTERVAL", time.Minute)
+	if cfg.BalanceApplyInterval <= 0 {
+		return nil, fmt.Errorf("invalid BALANCE_APPLY_INTERVAL value %v: must be positive", cfg.BalanceApplyInterval)
+	}
+
+	// Guild API tokens
+	cfg.APITokenMaxPerGuild = getEnvAsInt("API_TOKEN_MAX_PER_GUILD", 5)
+	if cfg.APITokenMaxPerGuild <= 0 {
+		return nil, fmt.Errorf("invalid API_TOKEN_MAX_PER_GUILD value %d: must be positive", cfg.APITokenMaxPerGuild)
+	}
+
+	// Chat commands
+	cfg.ChatCommandPrefix = strings.TrimSpace(getEnv("CHAT_COMMAND_PREFIX", "!"))
+
+	// Outgoing webhooks
+	cfg.WebhookDeliveryInterval = getEnvAsDuration("WEBHOOK_DELIVERY_INTERVAL", 15*time.Second)
+	if cfg.WebhookDeliveryInterval <= 0 {
+		return nil, fmt.Errorf("invalid WEBHOOK_DELIVERY_INTERVAL value %v: must be positive", cfg.WebhookDeliveryInterval)
+	}
+	cfg.WebhookMaxAttempts = getEnvAsInt("WEBHOOK_MAX_ATTEMPTS", 6)
+	if cfg.WebhookMaxAttempts < 1 {
+		return nil, fmt.Errorf("invalid WEBHOOK_MAX_ATTEMPTS value %d: must be at least 1", cfg.WebhookMaxAttempts)
+	}
+
+	// Player shop
+	cfg.PlayerShopFeePercent = getEnvAsInt("PLAYER_SHOP_FEE_PERCENT", 5)
+	if cfg.PlayerShopFeePercent < 0 || cfg.PlayerShopFeePercent > 100 {
+		return nil, fmt.Errorf("invalid PLAYER_SHOP_FEE_PERCENT value %d: must be between 0 and 100", cfg.PlayerShopFeePercent)
+	}
+	cfg.PlayerShopListingFeePercent = getEnvAsInt("PLAYER_SHOP_LISTING_FEE_PERCENT", 1)
+	if cfg.PlayerShopListingFeePercent < 0 || cfg.PlayerShopListingFeePercent > 100 {
+		return nil, fmt.Errorf("invalid PLAYER_SHOP_LISTING_FEE_PERCENT value %d: must be between 0 and 100", cfg.PlayerShopListingFeePercent)
+	}
+	cfg.PlayerShopMaxListings = getEnvAsInt("PLAYER_SHOP_MAX_LISTINGS", 10)
+	if cfg.PlayerShopMaxListings < 1 {
+		return nil, fmt.Errorf("invalid PLAYER_SHOP_MAX_LISTINGS value %d: must be at least 1", cfg.PlayerShopMaxListings)
+	}
+
+	// Dynamic pricing
+	cfg.MarketPriceSensitivity = getEnvAsFloat("MARKET_PRICE_SENSITIVITY", 0.01)
+	if cfg.MarketPriceSensitivity < 0 {
+		return nil, fmt.Errorf("invalid MARKET_PRICE_SENSITIVITY value %v: must not be negative", cfg.MarketPriceSensitivity)
+	}
+	cfg.MarketPriceHalfLife = getEnvAsDuration("MARKET_PRICE_HALF_LIFE", 12*time.Hour)
+	if cfg.MarketPriceHalfLife <= 0 {
+		return nil, fmt.Errorf("invalid MARKET_PRICE_HALF_LIFE value %v: must be positive", cfg.MarketPriceHalfLife)
+	}
+	cfg.MarketPriceFloor = getEnvAsFloat("MARKET_PRICE_FLOOR", 0.7)
+	if cfg.MarketPriceFloor <= 0 || cfg.MarketPriceFloor > 1 {
+		return nil, fmt.Errorf("invalid MARKET_PRICE_FLOOR value %v: must be above 0 and at most 1", cfg.MarketPriceFloor)
+	}
+	cfg.MarketPriceCeiling = getEnvAsFloat("MARKET_PRICE_CEILING", 1.5)
+	if cfg.MarketPriceCeiling < 1 {
+		return nil, fmt.Errorf("invalid MARKET_PRICE_CEILING value %v: must be at least 1", cfg.MarketPriceCeiling)
+	}
+	cfg.MarketSnapshotInterval = getEnvAsDuration("MARKET_SNAPSHOT_INTERVAL", 15*time.Minute)
+	if cfg.MarketSnapshotInterval <= 0 {
+		return nil, fmt.Errorf("invalid MARKET_SNAPSHOT_INTERVAL value %v: must be positive", cfg.MarketSnapshotInterval)
+	}
+	cfg.MarketHistoryRetention = getEnvAsDuration("MARKET_HISTORY_RETENTION", 30*24*time.Hour)
+	if cfg.MarketHistoryRetention <= 0 {
+		return nil, fmt.Errorf("invalid MARKET_HISTORY_RETENTION value %v: must be positive", cfg.MarketHistoryRetention)
+	}
+
+	// Transaction taxes
+	for _, tax := range []struct {
+		name  string
+		value *int
+	}{
+		{"SELL_TAX_PERCENT", &cfg.SellTaxPercent},
+		{"GIVE_TAX_PERCENT", &cfg.GiveTaxPercent},
+		{"GAMBLE_RAKE_PERCENT", &cfg.GambleRakePercent},
+	} {
+		*tax.value = getEnvAsInt(tax.name, 5)
+		if *tax.value < 0 || *tax.value > 100 {
+			return nil, fmt.Errorf("invalid %s value %d: must be between 0 and 100", tax.name, *tax.value)
+		}
+	}
+	cfg.GiveTaxThreshold = getEnvAsInt("GIVE_TAX_THRESHOLD", 1000)
+	if cfg.GiveTaxThreshold < 0 {
+		return nil, fmt.Errorf("invalid GIVE_TAX_THRESHOLD value %d: must not be negative", cfg.GiveTaxThreshold)
+	}
+	cfg.CommunityPoolMoneyPerPoint = getEnvAsInt("COMMUNITY_POOL_MONEY_PER_POINT", 10)
+	if cfg.CommunityPoolMoneyPerPoint < 1 {
+		return nil, fmt.Errorf("invalid COMMUNITY_POOL_MONEY_PER_POINT value %d: must be at least 1", cfg.CommunityPoolMoneyPerPoint)
+	}
+
+	// Progressive jackpot
+	cfg.JackpotContributionPercent = getEnvAsInt("JACKPOT_CONTRIBUTION_PERCENT", 2)
+	if cfg.JackpotContributionPercent < 0 || cfg.JackpotContributionPercent > 100 {
+		return nil, fmt.Errorf("invalid JACKPOT_CONTRIBUTION_PERCENT value %d: must be between 0 and 100", cfg.JackpotContributionPercent)
+	}
+	cfg.JackpotTriggerChance = getEnvAsFloat("JACKPOT_TRIGGER_CHANCE", 0.001)
+	if cfg.JackpotTriggerChance < 0 || cfg.JackpotTriggerChance > 1 {
+		return nil, fmt.Errorf("invalid JACKPOT_TRIGGER_CHANCE value %v: must be between 0 and 1", cfg.JackpotTriggerChance)
+	}
+	cfg.JackpotMaxBalance = getEnvAsInt("JACKPOT_MAX_BALANCE", 50000)
+	if cfg.JackpotMaxBalance < 0 {
+		return nil, fmt.Errorf("invalid JACKPOT_MAX_BALANCE value %d: must not be negative", cfg.JackpotMaxBalance)
+	}
+
+	// Bank
+	cfg.BankInterestRate = getEnvAsFloat("BANK_INTEREST_RATE", 0.01)
+	if cfg.BankInterestRate < 0 || cfg.BankInterestRate > 1 {
+		return nil, fmt.Errorf("invalid BANK_INTEREST_RATE value %v: must be between 0 and 1", cfg.BankInterestRate)
+	}
+	cfg.BankInterestCap = getEnvAsInt("BANK_INTEREST_CAP", 500)
+	if cfg.BankInterestCap < 0 {
+		return nil, fmt.Errorf("invalid BANK_INTEREST_CAP value %d: must not be negative", cfg.BankInterestCap)
+	}
+	cfg.BankInterestCron = getEnv("BANK_INTEREST_CRON", "0 0 * * *")
+	cfg.BankWithdrawLimit = getEnvAsInt("BANK_WITHDRAW_DAILY_LIMIT", 5000)
+	if cfg.BankWithdrawLimit < 0 {
+		return nil, fmt.Errorf("invalid BANK_WITHDRAW_DAILY_LIMIT value %d: must not be negative", cfg.BankWithdrawLimit)
+	}
+	cfg.BankGoalBonusPercent = getEnvAsInt("BANK_GOAL_BONUS_PERCENT", 5)
+	if cfg.BankGoalBonusPercent < 0 || cfg.BankGoalBonusPercent > 100 {
+		return nil, fmt.Errorf("invalid BANK_GOAL_BONUS_PERCENT value %d: must be between 0 and 100", cfg.BankGoalBonusPercent)
+	}
+
+	// Gifts
+	cfg.GiftDeliveryInterval = getEnvAsDuration("GIFT_DELIVERY_INTERVAL", time.Minute)
+	if cfg.GiftDeliveryInterval <= 0 {
+		return nil, fmt.Errorf("invalid GIFT_DELIVERY_INTERVAL value %v: must be positive", cfg.GiftDeliveryInterval)
+	}
+	cfg.GiftMaxPending = getEnvAsInt("GIFT_MAX_PENDING", 10)
+	if cfg.GiftMaxPending <= 0 {
+		return nil, fmt.Errorf("invalid GIFT_MAX_PENDING value %d: must be positive", cfg.GiftMaxPending)
+	}
+	cfg.GiftMaxDelay = getEnvAsDuration("GIFT_MAX_DELAY", 30*24*time.Hour)
+	if cfg.GiftMaxDelay <= 0 {
+		return nil, fmt.Errorf("invalid GIFT_MAX_DELAY value %v: must be positive", cfg.GiftMaxDelay)
+	}
+
+	// Inbox
+	cfg.InboxMaxMessages = getEnvAsInt("INBOX_MAX_MESSAGES", 100)
+	if cfg.InboxMaxMessages <= 0 {
+		return nil, fmt.Errorf("invalid INBOX_MAX_MESSAGES value %d: must be positive", cfg.InboxMaxMessages)
+	}
+
+	// Crafting quality tiers
+	cfg.CraftFineChance = getEnvAsFloat("CRAFT_FINE_CHANCE", 0.2)
+	if cfg.CraftFineChance < 0 || cfg.CraftFineChance > 1 {
+		return nil, fmt.Errorf("invalid CRAFT_FINE_CHANCE value %v: must be between 0 and 1", cfg.CraftFineChance)
+	}
+	cfg.CraftMasterworkChance = getEnvAsFloat("CRAFT_MASTERWORK_CHANCE", 0.1)
+	if cfg.CraftMasterworkChance < 0 || cfg.CraftMasterworkChance > 1 {
+		return nil, fmt.Errorf("invalid CRAFT_MASTERWORK_CHANCE value %v: must be between 0 and 1", cfg.CraftMasterworkChance)
+	}
+	cfg.CraftLegendaryChance = getEnvAsFloat("CRAFT_LEGENDARY_CHANCE", 0.01)
+	if cfg.CraftLegendaryChance < 0 || cfg.CraftLegendaryChance > 1 {
+		return nil, fmt.Errorf("invalid CRAFT_LEGENDARY_CHANCE value %v: must be between 0 and 1", cfg.CraftLegendaryChance)
+	}
+	if total := cfg.CraftFineChance + cfg.CraftMasterworkChance + cfg.CraftLegendaryChance; total > 1 {
+		return nil, fmt.Errorf("invalid crafting tier chances: CRAFT_FINE_CHANCE, CRAFT_MASTERWORK_CHANCE and CRAFT_LEGENDARY_CHANCE add up to %v, must be at most 1", total)
+	}
+	cfg.CraftTierJobLevelBonus = getEnvAsFloat("CRAFT_TIER_JOB_LEVEL_BONUS", 0.02)
+	if cfg.CraftTierJobLevelBonus < 0 {
+		return nil, fmt.Errorf("invalid CRAFT_TIER_JOB_LEVEL_BONUS value %v: must not be negative", cfg.CraftTierJobLevelBonus)
+	}
+	craftToolBonuses, err := parseCraftToolBonuses(getEnv("CRAFT_TOOL_BONUSES", "item_shovel:0.05"))
+	if err != nil {
+		return nil, err
+	}
+	cfg.CraftToolBonuses = craftToolBonuses
+
+	// Recipe mastery
+	cfg.CraftMasteryThreshold = getEnvAsInt("CRAFT_MASTERY_THRESHOLD", 100)
+	if cfg.CraftMasteryThreshold < 0 {
+		return nil, fmt.Errorf("invalid CRAFT_MASTERY_THRESHOLD value %d: must not be negative", cfg.CraftMasteryThreshold)
+	}
+	cfg.CraftMasteryMasterworkBonus = getEnvAsFloat("CRAFT_MASTERY_MASTERWORK_BONUS", 0.02)
+	if cfg.CraftMasteryMasterworkBonus < 0 || cfg.CraftMasteryMasterworkBonus > 1 {
+		return nil, fmt.Errorf("invalid CRAFT_MASTERY_MASTERWORK_BONUS value %v: must be between 0 and 1", cfg.CraftMasteryMasterworkBonus)
+	}
+
+	// Effects
+	cfg.EffectExpiryInterval = getEnvAsDuration("EFFECT_EXPIRY_INTERVAL", time.Minute)
+	if cfg.EffectExpiryInterval <= 0 {
+		return nil, fmt.Errorf("invalid EFFECT_EXPIRY_INTERVAL value %v: must be positive", cfg.EffectExpiryInterval)
+	}
+
+	// Inventory
+	cfg.InventorySlotLimit = getEnvAsInt("INVENTORY_SLOT_LIMIT", 50)
+	if cfg.InventorySlotLimit < 0 {
+		return nil, fmt.Errorf("invalid INVENTORY_SLOT_LIMIT value %d: must not be negative", cfg.InventorySlotLimit)
+	}
+
+	// Undo
+	cfg.UndoWindow = getEnvAsDuration("UNDO_WINDOW", 5*time.Minute)
+	if cfg.UndoWindow < 0 {
+		return nil, fmt.Errorf("invalid UNDO_WINDOW value %v: must not be negative", cfg.UndoWindow)
+	}
+
+	// Give limits
+	cfg.GiveDailyLimit = getEnvAsInt("GIVE_DAILY_LIMIT", 50)
+	if cfg.GiveDailyLimit < 0 {
+		return nil, fmt.Errorf("invalid GIVE_DAILY_LIMIT value %d: must not be negative", cfg.GiveDailyLimit)
+	}
+	cfg.GiveMinAccountAge = getEnvAsDuration("GIVE_MIN_ACCOUNT_AGE", 24*time.Hour)
+	if cfg.GiveMinAccountAge < 0 {
+		return nil, fmt.Errorf("invalid GIVE_MIN_ACCOUNT_AGE value %v: must not be negative", cfg.GiveMinAccountAge)
+	}
+	cfg.GiveCircularWindow = getEnvAsDuration("GIVE_CIRCULAR_WINDOW", 10*time.Minute)
+	if cfg.GiveCircularWindow < 0 {
+		return nil, fmt.Errorf("invalid GIVE_CIRCULAR_WINDOW value %v: must not be negative", cfg.GiveCircularWindow)
+	}
+
+	// Cooldowns
+	cfg.CooldownSearch = getEnvAsDuration("COOLDOWN_SEARCH", 30*time.Minute)
+	cfg.CooldownSlots = getEnvAsDuration("COOLDOWN_SLOTS", 10*time.Minute)
+	cfg.CooldownGambleStart = getEnvAsDuration("COOLDOWN_GAMBLE_START", 5*time.Minute)
+	cfg.CooldownGive = getEnvAsDuration("COOLDOWN_GIVE", 0)
+	cfg.CooldownUseItem = getEnvAsDuration("COOLDOWN_USE_ITEM", 0)
+	cfg.CooldownJobSwitch = getEnvAsDuration("COOLDOWN_JOB_SWITCH", 24*time.Hour)
+	cfg.CooldownUndo = getEnvAsDuration("COOLDOWN_UNDO", 10*time.Minute)
+	cfg.CooldownDig = getEnvAsDuration("COOLDOWN_DIG", 15*time.Minute)
+	cfg.CooldownFish = getEnvAsDuration("COOLDOWN_FISH", 2*time.Minute)
+	cfg.CooldownBossAttack = getEnvAsDuration("COOLDOWN_BOSS_ATTACK", time.Minute)
+	for name, d := range map[string]time.Duration{
+		"COOLDOWN_SEARCH":       cfg.CooldownSearch,
+		"COOLDOWN_SLOTS":        cfg.CooldownSlots,
+		"COOLDOWN_GAMBLE_START": cfg.CooldownGambleStart,
+		"COOLDOWN_GIVE":         cfg.CooldownGive,
+		"COOLDOWN_USE_ITEM":     cfg.CooldownUseItem,
+		"COOLDOWN_JOB_SWITCH":   cfg.CooldownJobSwitch,
+		"COOLDOWN_UNDO":         cfg.CooldownUndo,
+		"COOLDOWN_DIG":          cfg.CooldownDig,
+		"COOLDOWN_FISH":         cfg.CooldownFish,
+		"COOLDOWN_BOSS_ATTACK":  cfg.CooldownBossAttack,
+	} {
+		if d < 0 {
+			return nil, fmt.Errorf("invalid %s value %v: must not be negative", name, d)
+		}
+	}
 
 	// Dev mode (bypasses cooldowns and enables test features)
 	devModeStr := getEnv("DEV_MODE", "false")
 	cfg.DevMode = devModeStr == "true" || devModeStr == "1"
 
+	rngSeed, err := strconv.ParseInt(getEnv("RNG_SEED", "0"), 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid RNG_SEED value: %w", err)
+	}
+	cfg.RNGSeed = rngSeed
+	cfg.RNGLogSeeds = getEnv("RNG_LOG_SEEDS", "false") == "true"
+
 	// Feature flags
 	cfg.DisableProgressionGains = getEnv("DISABLE_PROGRESSION_GAINS", "false") == "true"
 	cfg.DisableJobXPGains = getEnv("DISABLE_JOB_XP_GAINS", "false") == "true"
 
+	jobXPSourceCaps, err := parseJobXPSourceCaps(getEnv("JOB_XP_SOURCE_CAPS", ""))
+	if err != nil {
+		return nil, err
+	}
+	cfg.JobXPSourceCaps = jobXPSourceCaps
+
+	cfg.GRPCPort = getEnvAsInt("GRPC_PORT", 0)
+	if cfg.GRPCPort < 0 || cfg.GRPCPort > 65535 {
+		return nil, fmt.Errorf("invalid GRPC_PORT value %d: must be between 0 and 65535", cfg.GRPCPort)
+	}
+	if cfg.GRPCPort != 0 && cfg.GRPCPort == cfg.Port {
+		return nil, fmt.Errorf("GRPC_PORT must differ from PORT (%d)", cfg.Port)
+	}
+
+	// Parse access log settings
+	cfg.AccessLogEnabled = getEnv("ACCESS_LOG_ENABLED", "false") == "true"
+	cfg.AccessLogBodySampleRate = getEnvAsFloat("ACCESS_LOG_BODY_SAMPLE_RATE", 0.1)
+	cfg.AccessLogMaxBodyBytes = getEnvAsInt("ACCESS_LOG_MAX_BODY_BYTES", 2048)
+	if cfg.AccessLogBodySampleRate < 0 || cfg.AccessLogBodySampleRate > 1 {
+		return nil, fmt.Errorf("invalid ACCESS_LOG_BODY_SAMPLE_RATE value %v: must be between 0 and 1", cfg.AccessLogBodySampleRate)
+	}
+
 	// Streamer.bot WebSocket enabled
 	sbEnabledStr := getEnv("STREAMERBOT_ENABLED", "false")
 	cfg.StreamerbotEnabled = sbEnabledStr == "true" || sbEnabledStr == "1"
 
+	// Parse bridged event types
+	for _, eventType := range strings.Split(getEnv("EVENT_BRIDGE_TYPES", ""), ",") {
+		if trimmed := strings.TrimSpace(eventType); trimmed != "" {
+			cfg.EventBridgeTypes = append(cfg.EventBridgeTypes, trimmed)
+		}
+	}
+	if len(cfg.EventBridgeTypes) > 0 && cfg.EventBridgeChannel == "" {
+		return nil, fmt.Errorf("EVENT_BRIDGE_CHANNEL must not be empty when EVENT_BRIDGE_TYPES is set")
+	}
+
 	// Parse trusted proxies
 	trustedProxiesStr := getEnv("TRUSTED_PROXIES", "")
 	if trustedProxiesStr != "" {
@@ -163,11 +818,47 @@ func Load() (*Config, error) {
 		}
 	}
 
+	externalKeys, err := parseExternalContributionKeys(getEnv("EXTERNAL_CONTRIBUTION_KEYS", ""), cfg.APIKey)
+	if err != nil {
+		return nil, err
+	}
+	cfg.ExternalContributionKeys = externalKeys
+
+	communityKeys, err := parseCommunityKeys(getEnv("COMMUNITY_API_KEYS", ""), cfg.APIKey, externalKeys)
+	if err != nil {
+		return nil, err
+	}
+	cfg.CommunityKeys = communityKeys
+
 	// Subscription settings
 	cfg.SubscriptionCheckInterval = getEnvAsDuration("SUBSCRIPTION_CHECK_INTERVAL", 6*time.Hour)
 	cfg.SubscriptionDefaultDuration = getEnvAsDuration("SUBSCRIPTION_DEFAULT_DURATION", 720*time.Hour) // 30 days
 	cfg.SubscriptionGracePeriod = getEnvAsDuration("SUBSCRIPTION_GRACE_PERIOD", 24*time.Hour)
 
+	if cfg.WorkerPoolSize < 1 {
+		return nil, fmt.Errorf("invalid WORKER_POOL_SIZE value %d: must be at least 1", cfg.WorkerPoolSize)
+	}
+	if cfg.WorkerQueueSize < 0 {
+		return nil, fmt.Errorf("invalid WORKER_QUEUE_SIZE value %d: must not be negative", cfg.WorkerQueueSize)
+	}
+	if cfg.ReconcileHealMax < 0 {
+		return nil, fmt.Errorf("invalid RECONCILE_HEAL_MAX value %d: must not be negative", cfg.ReconcileHealMax)
+	}
+
+	if cfg.SnapshotRetention < 1 {
+		return nil, fmt.Errorf("invalid SNAPSHOT_RETENTION value %d: must be at least 1", cfg.SnapshotRetention)
+	}
+
+	if cfg.EventBusBackend != "memory" && cfg.EventBusBackend != "nats" {
+		return nil, fmt.Errorf("invalid EVENT_BUS_BACKEND value %q: must be memory or nats", cfg.EventBusBackend)
+	}
+
+	if cfg.InventoryWebhookURL != "" {
+		if u, err := url.Parse(cfg.InventoryWebhookURL); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return nil, fmt.Errorf("invalid INVENTORY_WEBHOOK_URL value %q: must be an http or https URL", cfg.InventoryWebhookURL)
+		}
+	}
+
 	// Validate API key is set
 	if cfg.APIKey == "" {
 		return nil, fmt.Errorf("API_KEY environment variable must be set for security")
@@ -176,6 +867,169 @@ func Load() (*Config, error) {
 	return cfg, nil
 }
 
+// parseExternalContributionKeys parses a comma-separated list of
+// source:key:cap entries, where the cap is optional
+func parseExternalContributionKeys(raw, apiKey string) ([]ExternalContributionKey, error) {
+	var keys []ExternalContributionKey
+	seen := make(map[string]bool)
+	for _, entry := range strings.Split(raw, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		parts := strings.Split(entry, ":")
+		if len(parts) < 2 || len(parts) > 3 {
+			return nil, fmt.Errorf("invalid EXTERNAL_CONTRIBUTION_KEYS entry %q: expected source:key[:cap]", entry)
+		}
+
+		key := ExternalContributionKey{Source: parts[0], Key: parts[1]}
+		if !externalSourcePattern.MatchString(key.Source) {
+			return nil, fmt.Errorf("invalid EXTERNAL_CONTRIBUTION_KEYS source %q: must be 1-32 lowercase letters, digits, '_' or '-'", key.Source)
+		}
+		if key.Key == "" || key.Key == apiKey {
+			return nil, fmt.Errorf("invalid EXTERNAL_CONTRIBUTION_KEYS key for source %q: must be set and differ from API_KEY", key.Source)
+		}
+		if seen[key.Key] {
+			return nil, fmt.Errorf("invalid EXTERNAL_CONTRIBUTION_KEYS key for source %q: keys must be unique", key.Source)
+		}
+		seen[key.Key] = true
+
+		if len(parts) == 3 {
+			hourlyCap, err := strconv.Atoi(parts[2])
+			if err != nil || hourlyCap < 0 {
+				return nil, fmt.Errorf("invalid EXTERNAL_CONTRIBUTION_KEYS cap %q for source %q: must be a non-negative integer", parts[2], key.Source)
+			}
+			key.HourlyCap = hourlyCap
+		}
+		keys = append(keys, key)
+	}
+	return keys, nil
+}
+
+// parseCommunityKeys parses a comma-separated list of community:key entries.
+// A community may have several keys; every key must be unique across the
+// main, external contribution and community keys.
+func parseCommunityKeys(raw, apiKey string, externalKeys []ExternalContributionKey) ([]CommunityKey, error) {
+	seen := make(map[string]bool)
+	for _, k := range externalKeys {
+		seen[k.Key] = true
+	}
+
+	var keys []CommunityKey
+	for _, entry := range strings.Split(raw, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		parts := strings.Split(entry, ":")
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("invalid COMMUNITY_API_KEYS entry %q: expected community:key", entry)
+		}
+
+		key := CommunityKey{Community: parts[0], Key: parts[1]}
+		if !community.Valid(key.Community) {
+			return nil, fmt.Errorf("invalid COMMUNITY_API_KEYS community %q: must be 1-32 lowercase letters, digits, '_' or '-'", key.Community)
+		}
+		if key.Key == "" || key.Key == apiKey {
+			return nil, fmt.Errorf("invalid COMMUNITY_API_KEYS key for community %q: must be set and differ from API_KEY", key.Community)
+		}
+		if seen[key.Key] {
+			return nil, fmt.Errorf("invalid COMMUNITY_API_KEYS key for community %q: keys must be unique", key.Community)
+		}
+		seen[key.Key] = true
+		keys = append(keys, key)
+	}
+	return keys, nil
+}
+
+// Communities returns the default community followed by every community
+// with a key, in the order first configured
+func (c *Config) Communities() []string {
+	ids := []string{community.Default}
+	seen := map[string]bool{community.Default: true}
+	for _, k := range c.CommunityKeys {
+		if !seen[k.Community] {
+			seen[k.Community] = true
+			ids = append(ids, k.Community)
+		}
+	}
+	return ids
+}
+
+// parseVoteWeightTiers parses a comma-separated list of minScore:weight
+// entries, returned in ascending score order
+func parseVoteWeightTiers(raw string) ([]VoteWeightTier, error) {
+	var tiers []VoteWeightTier
+	for _, entry := range strings.Split(raw, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		parts := strings.Split(entry, ":")
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("invalid VOTE_WEIGHT_TIERS entry %q: expected minScore:weight", entry)
+		}
+		minScore, err := strconv.ParseFloat(parts[0], 64)
+		if err != nil || minScore < 0 {
+			return nil, fmt.Errorf("invalid VOTE_WEIGHT_TIERS score %q: must be a non-negative number", parts[0])
+		}
+		weight, err := strconv.Atoi(parts[1])
+		if err != nil || weight < 1 {
+			return nil, fmt.Errorf("invalid VOTE_WEIGHT_TIERS weight %q: must be a positive integer", parts[1])
+		}
+		tiers = append(tiers, VoteWeightTier{MinScore: minScore, Weight: weight})
+	}
+	sort.Slice(tiers, func(i, j int) bool { return tiers[i].MinScore < tiers[j].MinScore })
+	return tiers, nil
+}
+
+// parseCraftToolBonuses parses a comma-separated list of item:bonus entries
+func parseCraftToolBonuses(raw string) (map[string]float64, error) {
+	bonuses := make(map[string]float64)
+	for _, entry := range strings.Split(raw, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		parts := strings.Split(entry, ":")
+		if len(parts) != 2 || strings.TrimSpace(parts[0]) == "" {
+			return nil, fmt.Errorf("invalid CRAFT_TOOL_BONUSES entry %q: expected item:bonus", entry)
+		}
+		bonus, err := strconv.ParseFloat(strings.TrimSpace(parts[1]), 64)
+		if err != nil || bonus < 0 {
+			return nil, fmt.Errorf("invalid CRAFT_TOOL_BONUSES bonus %q: must be a non-negative number", parts[1])
+		}
+		bonuses[strings.TrimSpace(parts[0])] = bonus
+	}
+	return bonuses, nil
+}
+
+// parseJobXPSourceCaps parses a comma-separated list of source:cap entries
+func parseJobXPSourceCaps(raw string) (map[string]int, error) {
+	caps := make(map[string]int)
+	for _, entry := range strings.Split(raw, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		parts := strings.Split(entry, ":")
+		if len(parts) != 2 || strings.TrimSpace(parts[0]) == "" {
+			return nil, fmt.Errorf("invalid JOB_XP_SOURCE_CAPS entry %q: expected source:cap", entry)
+		}
+		xpCap, err := strconv.Atoi(strings.TrimSpace(parts[1]))
+		if err != nil || xpCap < 1 {
+			return nil, fmt.Errorf("invalid JOB_XP_SOURCE_CAPS cap %q: must be a positive integer", parts[1])
+		}
+		caps[strings.TrimSpace(parts[0])] = xpCap
+	}
+	return caps, nil
+}
+
 // getEnv retrieves an environment variable or returns a default value
 func getEnv(key, defaultValue string) string {
 	if value, exists := os.LookupEnv(key); exists {
@@ -209,6 +1063,15 @@ func getEnvAsInt(key string, defaultValue int) int {
 	return defaultValue
 }
 
+// getEnvAsFloat retrieves an environment variable as a float or returns a default value
+func getEnvAsFloat(key string, defaultValue float64) float64 {
+	valueStr := getEnv(key, "")
+	if value, err := strconv.ParseFloat(valueStr, 64); err == nil {
+		return value
+	}
+	return defaultValue
+}
+
 // getEnvAsDuration retrieves an environment variable as a duration or returns a default value
 func getEnvAsDuration(key string, defaultValue time.Duration) time.Duration {
 	valueStr := getEnv(key, "")
@@ -184,3 +184,76 @@ func TestLoad_DatabasePoolConfig(t *testing.T) {
 		assert.Equal(t, 2*time.Hour, cfg.DBMaxConnLifetime)
 	})
 }
+
+// TestParseExternalContributionKeys tests parsing of EXTERNAL_CONTRIBUTION_KEYS
+func TestParseExternalContributionKeys(t *testing.T) {
+	t.Run("parses entries with and without caps", func(t *testing.T) {
+		keys, err := parseExternalContributionKeys("donations:abc:500, subs:def", "main")
+		require.NoError(t, err)
+		assert.Equal(t, []ExternalContributionKey{
+			{Source: "donations", Key: "abc", HourlyCap: 500},
+			{Source: "subs", Key: "def"},
+		}, keys)
+	})
+
+	t.Run("empty value yields no keys", func(t *testing.T) {
+		keys, err := parseExternalContributionKeys("", "main")
+		require.NoError(t, err)
+		assert.Empty(t, keys)
+	})
+
+	t.Run("rejects invalid entries", func(t *testing.T) {
+		for _, raw := range []string{
+			"donations",           // missing key
+			"Donations:abc",       // uppercase source
+			"donations::10",       // empty key
+			"donations:main",      // reuses API_KEY
+			"donations:abc:-1",    // negative cap
+			"a:abc,b:abc",         // duplicate key
+			"donations:abc:10:20", // too many parts
+		} {
+			_, err := parseExternalContributionKeys(raw, "main")
+			assert.Error(t, err, raw)
+		}
+	})
+}
+
+// TestParseCommunityKeys tests parsing of COMMUNITY_API_KEYS
+func TestParseCommunityKeys(t *testing.T) {
+	external := []ExternalContributionKey{{Source: "donations", Key: "ext"}}
+
+	t.Run("parses entries and lists communities", func(t *testing.T) {
+		keys, err := parseCommunityKeys("alpha:k1, beta:k2,alpha:k3", "main", external)
+		require.NoError(t, err)
+		assert.Equal(t, []CommunityKey{
+			{Community: "alpha", Key: "k1"},
+			{Community: "beta", Key: "k2"},
+			{Community: "alpha", Key: "k3"},
+		}, keys)
+
+		cfg := &Config{CommunityKeys: keys}
+		assert.Equal(t, []string{"default", "alpha", "beta"}, cfg.Communities())
+	})
+
+	t.Run("empty value serves only the default community", func(t *testing.T) {
+		keys, err := parseCommunityKeys("", "main", external)
+		require.NoError(t, err)
+		assert.Empty(t, keys)
+		assert.Equal(t, []string{"default"}, (&Config{}).Communities())
+	})
+
+	t.Run("rejects invalid entries", func(t *testing.T) {
+		for _, raw := range []string{
+			"alpha",          // missing key
+			"Alpha:k1",       // uppercase community
+			"alpha:",         // empty key
+			"alpha:main",     // reuses API_KEY
+			"alpha:ext",      // reuses an external contribution key
+			"a:k1,b:k1",      // duplicate key
+			"alpha:k1:extra", // too many parts
+		} {
+			_, err := parseCommunityKeys(raw, "main", external)
+			assert.Error(t, err, raw)
+		}
+	})
+}
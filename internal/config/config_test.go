@@ -83,6 +83,236 @@ func TestLoad(t *testing.T) {
 		assert.Contains(t, err.Error(), "invalid PORT")
 	})
 
+	t.Run("returns error for a non-http INVENTORY_WEBHOOK_URL", func(t *testing.T) {
+		clearEnvVars(t)
+		t.Setenv("API_KEY", "test-key")
+		t.Setenv("INVENTORY_WEBHOOK_URL", "ftp://sync.example.com/inventory")
+
+		cfg, err := Load()
+
+		assert.Error(t, err)
+		assert.Nil(t, cfg)
+		assert.Contains(t, err.Error(), "INVENTORY_WEBHOOK_URL")
+	})
+
+	t.Run("parses VOTE_WEIGHT_TIERS in score order", func(t *testing.T) {
+		clearEnvVars(t)
+		t.Setenv("API_KEY", "test-key")
+		t.Setenv("VOTE_WEIGHT_TIERS", "5000:3, 1000:2")
+
+		cfg, err := Load()
+
+		require.NoError(t, err)
+		assert.Equal(t, []VoteWeightTier{{MinScore: 1000, Weight: 2}, {MinScore: 5000, Weight: 3}}, cfg.VoteWeightTiers)
+	})
+
+	t.Run("returns error for a VOTE_WEIGHT_TIERS weight below 1", func(t *testing.T) {
+		clearEnvVars(t)
+		t.Setenv("API_KEY", "test-key")
+		t.Setenv("VOTE_WEIGHT_TIERS", "1000:0")
+
+		cfg, err := Load()
+
+		assert.Error(t, err)
+		assert.Nil(t, cfg)
+		assert.Contains(t, err.Error(), "VOTE_WEIGHT_TIERS")
+	})
+
+	t.Run("parses JOB_XP_SOURCE_CAPS", func(t *testing.T) {
+		clearEnvVars(t)
+		t.Setenv("API_KEY", "test-key")
+		t.Setenv("JOB_XP_SOURCE_CAPS", "search:200, engagement:100")
+
+		cfg, err := Load()
+
+		require.NoError(t, err)
+		assert.Equal(t, map[string]int{"search": 200, "engagement": 100}, cfg.JobXPSourceCaps)
+	})
+
+	t.Run("returns error for a malformed JOB_XP_SOURCE_CAPS entry", func(t *testing.T) {
+		clearEnvVars(t)
+		t.Setenv("API_KEY", "test-key")
+		t.Setenv("JOB_XP_SOURCE_CAPS", "search")
+
+		cfg, err := Load()
+
+		assert.Error(t, err)
+		assert.Nil(t, cfg)
+		assert.Contains(t, err.Error(), "JOB_XP_SOURCE_CAPS")
+	})
+
+	t.Run("parses RNG_SEED and RNG_LOG_SEEDS", func(t *testing.T) {
+		clearEnvVars(t)
+		t.Setenv("API_KEY", "test-key")
+		t.Setenv("RNG_SEED", "1234567890123")
+		t.Setenv("RNG_LOG_SEEDS", "true")
+
+		cfg, err := Load()
+
+		require.NoError(t, err)
+		assert.Equal(t, int64(1234567890123), cfg.RNGSeed)
+		assert.True(t, cfg.RNGLogSeeds)
+	})
+
+	t.Run("returns error for a non-numeric RNG_SEED", func(t *testing.T) {
+		clearEnvVars(t)
+		t.Setenv("API_KEY", "test-key")
+		t.Setenv("RNG_SEED", "lucky")
+
+		cfg, err := Load()
+
+		assert.Error(t, err)
+		assert.Nil(t, cfg)
+		assert.Contains(t, err.Error(), "RNG_SEED")
+	})
+
+	t.Run("allows an empty SNAPSHOT_CRON to disable automatic snapshots", func(t *testing.T) {
+		clearEnvVars(t)
+		t.Setenv("API_KEY", "test-key")
+		t.Setenv("SNAPSHOT_CRON", "")
+		t.Setenv("SNAPSHOT_RETENTION", "3")
+
+		cfg, err := Load()
+
+		require.NoError(t, err)
+		assert.Empty(t, cfg.SnapshotCron)
+		assert.Equal(t, 3, cfg.SnapshotRetention)
+	})
+
+	t.Run("returns error for a SNAPSHOT_RETENTION below 1", func(t *testing.T) {
+		clearEnvVars(t)
+		t.Setenv("API_KEY", "test-key")
+		t.Setenv("SNAPSHOT_RETENTION", "0")
+
+		cfg, err := Load()
+
+		assert.Error(t, err)
+		assert.Nil(t, cfg)
+		assert.Contains(t, err.Error(), "SNAPSHOT_RETENTION")
+	})
+
+	t.Run("parses gamble participant and wager limits", func(t *testing.T) {
+		clearEnvVars(t)
+		t.Setenv("API_KEY", "test-key")
+		t.Setenv("GAMBLE_MIN_PARTICIPANTS", "3")
+		t.Setenv("GAMBLE_MAX_PARTICIPANTS", "8")
+		t.Setenv("GAMBLE_MAX_WAGER_VALUE", "5000")
+
+		cfg, err := Load()
+
+		require.NoError(t, err)
+		assert.Equal(t, 3, cfg.GambleMinParticipants)
+		assert.Equal(t, 8, cfg.GambleMaxParticipants)
+		assert.Equal(t, 5000, cfg.GambleMaxWagerValue)
+	})
+
+	t.Run("returns error for GAMBLE_MAX_PARTICIPANTS below the minimum", func(t *testing.T) {
+		clearEnvVars(t)
+		t.Setenv("API_KEY", "test-key")
+		t.Setenv("GAMBLE_MIN_PARTICIPANTS", "4")
+		t.Setenv("GAMBLE_MAX_PARTICIPANTS", "3")
+
+		cfg, err := Load()
+
+		assert.Error(t, err)
+		assert.Nil(t, cfg)
+		assert.Contains(t, err.Error(), "GAMBLE_MAX_PARTICIPANTS")
+	})
+
+	t.Run("parses jackpot settings", func(t *testing.T) {
+		clearEnvVars(t)
+		t.Setenv("API_KEY", "test-key")
+		t.Setenv("JACKPOT_CONTRIBUTION_PERCENT", "5")
+		t.Setenv("JACKPOT_TRIGGER_CHANCE", "0.01")
+
+		cfg, err := Load()
+
+		require.NoError(t, err)
+		assert.Equal(t, 5, cfg.JackpotContributionPercent)
+		assert.Equal(t, 0.01, cfg.JackpotTriggerChance)
+	})
+
+	t.Run("returns error for a JACKPOT_TRIGGER_CHANCE above 1", func(t *testing.T) {
+		clearEnvVars(t)
+		t.Setenv("API_KEY", "test-key")
+		t.Setenv("JACKPOT_TRIGGER_CHANCE", "1.5")
+
+		cfg, err := Load()
+
+		assert.Error(t, err)
+		assert.Nil(t, cfg)
+		assert.Contains(t, err.Error(), "JACKPOT_TRIGGER_CHANCE")
+	})
+
+	t.Run("loads crafting tier settings", func(t *testing.T) {
+		clearEnvVars(t)
+		t.Setenv("API_KEY", "test-key")
+		t.Setenv("CRAFT_FINE_CHANCE", "0.3")
+		t.Setenv("CRAFT_MASTERWORK_CHANCE", "0.15")
+		t.Setenv("CRAFT_LEGENDARY_CHANCE", "0.05")
+		t.Setenv("CRAFT_TIER_JOB_LEVEL_BONUS", "0.01")
+		t.Setenv("CRAFT_TOOL_BONUSES", "item_shovel:0.1, item_scrap:0.02")
+
+		cfg, err := Load()
+
+		require.NoError(t, err)
+		assert.Equal(t, 0.3, cfg.CraftFineChance)
+		assert.Equal(t, 0.15, cfg.CraftMasterworkChance)
+		assert.Equal(t, 0.05, cfg.CraftLegendaryChance)
+		assert.Equal(t, 0.01, cfg.CraftTierJobLevelBonus)
+		assert.Equal(t, map[string]float64{"item_shovel": 0.1, "item_scrap": 0.02}, cfg.CraftToolBonuses)
+	})
+
+	t.Run("returns error when crafting tier chances add up to more than 1", func(t *testing.T) {
+		clearEnvVars(t)
+		t.Setenv("API_KEY", "test-key")
+		t.Setenv("CRAFT_FINE_CHANCE", "0.6")
+		t.Setenv("CRAFT_MASTERWORK_CHANCE", "0.5")
+
+		cfg, err := Load()
+
+		assert.Error(t, err)
+		assert.Nil(t, cfg)
+		assert.Contains(t, err.Error(), "crafting tier chances")
+	})
+
+	t.Run("returns error for a malformed CRAFT_TOOL_BONUSES entry", func(t *testing.T) {
+		clearEnvVars(t)
+		t.Setenv("API_KEY", "test-key")
+		t.Setenv("CRAFT_TOOL_BONUSES", "item_shovel")
+
+		cfg, err := Load()
+
+		assert.Error(t, err)
+		assert.Nil(t, cfg)
+		assert.Contains(t, err.Error(), "CRAFT_TOOL_BONUSES")
+	})
+
+	t.Run("loads recipe mastery settings", func(t *testing.T) {
+		clearEnvVars(t)
+		t.Setenv("API_KEY", "test-key")
+		t.Setenv("CRAFT_MASTERY_THRESHOLD", "25")
+		t.Setenv("CRAFT_MASTERY_MASTERWORK_BONUS", "0.05")
+
+		cfg, err := Load()
+
+		require.NoError(t, err)
+		assert.Equal(t, 25, cfg.CraftMasteryThreshold)
+		assert.Equal(t, 0.05, cfg.CraftMasteryMasterworkBonus)
+	})
+
+	t.Run("returns error for a negative CRAFT_MASTERY_THRESHOLD", func(t *testing.T) {
+		clearEnvVars(t)
+		t.Setenv("API_KEY", "test-key")
+		t.Setenv("CRAFT_MASTERY_THRESHOLD", "-1")
+
+		cfg, err := Load()
+
+		assert.Error(t, err)
+		assert.Nil(t, cfg)
+		assert.Contains(t, err.Error(), "CRAFT_MASTERY_THRESHOLD")
+	})
+
 	t.Run("handles negative port number", func(t *testing.T) {
 		clearEnvVars(t)
 		t.Setenv("API_KEY", "test-key")
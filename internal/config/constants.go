@@ -10,7 +10,15 @@ const (
 	ConfigPathLootTables           = "configs/loot_tables.json"
 	ConfigPathItemAliases          = "configs/items/aliases.json"
 	ConfigPathItemThemes           = "configs/items/themes.json"
+	ConfigPathItemEffects          = "configs/items/effects.json"
 	ConfigPathExpeditionEncounters = "configs/expedition/encounters.json"
 	ConfigPathQuestPool            = "configs/quests/weekly_quest_pool.json"
 	ConfigPathWeeklySales          = "configs/economy/weekly_sales.json"
+	ConfigPathMonetizationRewards  = "configs/monetization/rewards.json"
+	ConfigPathJobPerks             = "configs/jobs/perks.json"
+	ConfigPathJobXPEvents          = "configs/jobs/xp_events.json"
+	ConfigPathPersonalTracks       = "configs/personal_tracks.json"
+	ConfigPathChatDrops            = "configs/chat_drops.json"
+	ConfigPathRaidBonuses          = "configs/raid_bonuses.json"
+	ConfigPathSeasonsDir           = "configs/seasons/"
 )
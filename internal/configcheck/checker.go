@@ -0,0 +1,252 @@
+package configcheck
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/osse101/BrandishBot_Go/internal/config"
+	"github.com/osse101/BrandishBot_Go/internal/crafting"
+	"github.com/osse101/BrandishBot_Go/internal/item"
+	"github.com/osse101/BrandishBot_Go/internal/lootbox"
+	"github.com/osse101/BrandishBot_Go/internal/progression"
+	"github.com/osse101/BrandishBot_Go/internal/season"
+	"github.com/osse101/BrandishBot_Go/internal/validation"
+)
+
+// Paths locates the config files that are cross-checked
+type Paths struct {
+	Items              string
+	LootTables         string
+	ProgressionTree    string
+	RecipesCrafting    string
+	RecipesDisassemble string
+	// Seasons is the season packs directory; empty skips the packs
+	Seasons string
+}
+
+// DefaultPaths returns the paths the server loads at startup
+func DefaultPaths() Paths {
+	return Paths{
+		Items:              config.ConfigPathItems,
+		LootTables:         config.ConfigPathLootTables,
+		ProgressionTree:    config.ConfigPathProgressionTree,
+		RecipesCrafting:    config.ConfigPathRecipesCrafting,
+		RecipesDisassemble: config.ConfigPathRecipesDisassemble,
+		Seasons:            config.ConfigPathSeasonsDir,
+	}
+}
+
+// Issue is one problem found in a config file
+type Issue struct {
+	Source  string
+	Message string
+}
+
+// Report collects every issue found by Run
+type Report struct {
+	Issues []Issue
+}
+
+// OK reports whether no issues were found
+func (r *Report) OK() bool {
+	return len(r.Issues) == 0
+}
+
+// Err returns nil when the report is clean, otherwise an error listing every issue
+func (r *Report) Err() error {
+	if r.OK() {
+		return nil
+	}
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "config validation found %d issue(s):", len(r.Issues))
+	for _, issue := range r.Issues {
+		fmt.Fprintf(&sb, "\n  [%s] %s", issue.Source, issue.Message)
+	}
+	return errors.New(sb.String())
+}
+
+// add records err under source, splitting joined errors into one issue each
+func (r *Report) add(source string, err error) {
+	if err == nil {
+		return
+	}
+	if joined, ok := err.(interface{ Unwrap() []error }); ok {
+		for _, e := range joined.Unwrap() {
+			r.add(source, e)
+		}
+		return
+	}
+	r.Issues = append(r.Issues, Issue{Source: source, Message: err.Error()})
+}
+
+func (r *Report) addf(source, format string, args ...any) {
+	r.Issues = append(r.Issues, Issue{Source: source, Message: fmt.Sprintf(format, args...)})
+}
+
+// Run loads each config file and cross-checks the references between them.
+// It never stops at the first problem so one run reports everything that needs fixing.
+func Run(paths Paths) *Report {
+	report := &Report{}
+
+	items := checkItems(report, paths.Items)
+	checkProgressionTree(report, paths.ProgressionTree)
+
+	// References can only be checked once the item list itself is readable
+	if items == nil {
+		return report
+	}
+	checkLootTables(report, paths.LootTables, items)
+	checkRecipes(report, paths.RecipesCrafting, paths.RecipesDisassemble, items)
+	if paths.Seasons != "" {
+		checkSeasons(report, paths.Seasons, paths.RecipesCrafting, paths.RecipesDisassemble, items)
+	}
+
+	return report
+}
+
+// itemIndex holds the item internal names and content types defined in the items config
+type itemIndex struct {
+	names map[string]bool
+	types map[string]bool
+}
+
+func checkItems(report *Report, path string) *itemIndex {
+	loader := item.NewLoader()
+	cfg, err := loader.Load(path)
+	if err != nil {
+		report.add(SourceItems, err)
+		return nil
+	}
+	report.add(SourceItems, loader.Validate(cfg))
+
+	index := &itemIndex{
+		names: make(map[string]bool, len(cfg.Items)),
+		types: make(map[string]bool),
+	}
+	for _, def := range cfg.Items {
+		index.names[def.InternalName] = true
+		for _, t := range def.Type {
+			index.types[t] = true
+		}
+	}
+	return index
+}
+
+func checkProgressionTree(report *Report, path string) {
+	loader := progression.NewTreeLoader()
+	cfg, err := loader.Load(path)
+	if err != nil {
+		report.add(SourceProgressionTree, err)
+		return
+	}
+	report.add(SourceProgressionTree, loader.Validate(cfg))
+
+	inTree := make(map[string]bool, len(cfg.Nodes))
+	for _, node := range cfg.Nodes {
+		inTree[node.Key] = true
+	}
+	generated := make(map[string]bool, len(progression.GeneratedKeys))
+	for _, key := range progression.GeneratedKeys {
+		generated[key] = true
+		if !inTree[key] {
+			report.addf(SourceProgressionTree, "generated key '%s' no longer exists in the tree; run make generate", key)
+		}
+	}
+	for _, node := range cfg.Nodes {
+		if !generated[node.Key] {
+			report.addf(SourceProgressionTree, "node '%s' has no generated constant; run make generate", node.Key)
+		}
+	}
+}
+
+func checkLootTables(report *Report, path string, items *itemIndex) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		report.addf(SourceLootTables, "%s: %v", lootbox.ErrContextFailedToReadLootFile, err)
+		return
+	}
+	if err := validation.NewSchemaValidator().ValidateBytes(data, lootbox.LootTablesSchemaPath); err != nil {
+		report.addf(SourceLootTables, "schema validation failed for %s: %v", path, err)
+		return
+	}
+	var cfg lootbox.LootTableConfig
+	if err := json.Unmarshal(data, &cfg); err != nil {
+		report.addf(SourceLootTables, "%s: %v", lootbox.ErrContextFailedToParseLootFile, err)
+		return
+	}
+
+	for _, poolName := range sortedKeys(cfg.Pools) {
+		for i, entry := range cfg.Pools[poolName].Items {
+			if entry.ItemName != "" && !items.names[entry.ItemName] {
+				report.addf(SourceLootTables, "pool '%s' item[%d] references non-existent item '%s'", poolName, i, entry.ItemName)
+			}
+			if entry.ItemType != "" && !items.types[entry.ItemType] {
+				report.addf(SourceLootTables, "pool '%s' item[%d] references item type '%s' that no item has", poolName, i, entry.ItemType)
+			}
+		}
+	}
+
+	for _, boxName := range sortedKeys(cfg.Lootboxes) {
+		if !items.names[boxName] {
+			report.addf(SourceLootTables, "lootbox '%s' is not a defined item", boxName)
+		}
+		for _, ref := range cfg.Lootboxes[boxName].Pools {
+			if _, ok := cfg.Pools[ref.PoolName]; !ok {
+				report.addf(SourceLootTables, "lootbox '%s' references non-existent pool '%s'", boxName, ref.PoolName)
+			}
+		}
+	}
+}
+
+func checkRecipes(report *Report, craftingPath, disassemblePath string, items *itemIndex) {
+	cfg, err := crafting.NewRecipeLoader().Load(craftingPath, disassemblePath)
+	if err != nil {
+		report.add(SourceRecipes, err)
+		return
+	}
+	report.add(SourceRecipes, crafting.ValidateRecipes(cfg, items.names))
+}
+
+// checkSeasons checks the season packs against each other and against the
+// items and recipes they add to
+func checkSeasons(report *Report, dir, craftingPath, disassemblePath string, items *itemIndex) {
+	packs, err := season.LoadPacks(dir)
+	if err != nil {
+		report.add(SourceSeasons, err)
+		return
+	}
+
+	itemNames := make(map[string]bool, len(items.names))
+	for name := range items.names {
+		itemNames[name] = true
+	}
+	for _, pack := range packs {
+		for _, def := range pack.Items {
+			if items.names[def.InternalName] {
+				report.addf(SourceSeasons, "pack '%s' item '%s' is already in the items config", pack.Name, def.InternalName)
+			}
+			itemNames[def.InternalName] = true
+		}
+	}
+
+	recipeKeys := make(map[string]bool)
+	if cfg, err := crafting.NewRecipeLoader().Load(craftingPath, disassemblePath); err == nil {
+		for _, recipe := range cfg.UpgradeConfig.Recipes {
+			recipeKeys[recipe.RecipeKey] = true
+		}
+	}
+	report.add(SourceSeasons, season.ValidateReferences(packs, itemNames, recipeKeys))
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,114 @@
+package configcheck
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Schema paths are relative to the project root, as they are for the server
+func chdirRoot(t *testing.T) {
+	t.Helper()
+	t.Chdir(filepath.Join("..", ".."))
+}
+
+func writeFile(t *testing.T, dir, name, content string) string {
+	t.Helper()
+	path := filepath.Join(dir, name)
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	return path
+}
+
+func TestRun_ActualConfigs(t *testing.T) {
+	chdirRoot(t)
+
+	report := Run(DefaultPaths())
+
+	assert.True(t, report.OK(), "shipped configs should be valid: %v", report.Err())
+	assert.NoError(t, report.Err())
+}
+
+func TestRun_ReportsEveryBrokenReference(t *testing.T) {
+	chdirRoot(t)
+	dir := t.TempDir()
+
+	paths := DefaultPaths()
+	paths.LootTables = writeFile(t, dir, "loot_tables.json", `{
+		"version": "2.0",
+		"pools": {
+			"pool_a": {"items": [{"item_name": "no_such_item", "weight": 10}, {"item_type": "no_such_type", "weight": 5}]}
+		},
+		"lootboxes": {
+			"not_a_box": {"item_drop_rate": 0.5, "fixed_money": {"min": 1, "max": 2}, "pools": [{"pool_name": "pool_missing", "weight": 1}]}
+		}
+	}`)
+	paths.RecipesCrafting = writeFile(t, dir, "crafting.json", `{
+		"version": "1.0",
+		"recipes": [
+			{"recipe_key": "r1", "target_item": "ghost_item", "costs": [{"item": "ghost_cost", "quantity": 1}]}
+		]
+	}`)
+	paths.RecipesDisassemble = writeFile(t, dir, "disassemble.json", `{
+		"version": "1.0",
+		"recipes": [
+			{"recipe_key": "ghost_source", "quantity_consumed": 1, "outputs": [{"item": "ghost_output", "quantity": 1}], "associated_upgrade": "missing_upgrade"}
+		]
+	}`)
+
+	report := Run(paths)
+
+	require.False(t, report.OK())
+	bySource := make(map[string]int)
+	for _, issue := range report.Issues {
+		bySource[issue.Source]++
+	}
+	assert.Equal(t, 4, bySource[SourceLootTables], "unknown item, unknown type, non-item lootbox and unknown pool")
+	assert.Equal(t, 5, bySource[SourceRecipes], "target, cost, source, output and associated upgrade")
+	assert.Zero(t, bySource[SourceItems])
+	assert.Zero(t, bySource[SourceProgressionTree])
+
+	err := report.Err()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "no_such_item")
+	assert.Contains(t, err.Error(), "ghost_output")
+}
+
+func TestRun_UnreadableItemsSkipsReferenceChecks(t *testing.T) {
+	chdirRoot(t)
+
+	paths := DefaultPaths()
+	paths.Items = filepath.Join(t.TempDir(), "missing.json")
+
+	report := Run(paths)
+
+	require.Len(t, report.Issues, 1)
+	assert.Equal(t, SourceItems, report.Issues[0].Source)
+}
+
+func TestRun_ChecksSeasonPacks(t *testing.T) {
+	chdirRoot(t)
+	dir := t.TempDir()
+	writeFile(t, dir, "spring.json", `{
+		"version": "1.0",
+		"schema": "season-pack",
+		"name": "spring",
+		"start": "03-20",
+		"end": "04-10",
+		"items": [{"internal_name": "item_shovel", "public_name": "shovel", "max_stack": 1, "default_display": "A shovel"}],
+		"loot": [{"lootbox": "lootbox_tier0", "weight": 5, "items": [{"item_name": "no_such_item", "weight": 1}]}],
+		"recipes": [{"recipe_key": "lootbox_tier0", "target_item": "item_shovel", "costs": [{"item": "money", "quantity": 1}]}]
+	}`)
+
+	paths := DefaultPaths()
+	paths.Seasons = dir
+
+	report := Run(paths)
+
+	require.Len(t, report.Issues, 3, "duplicate item, unknown loot item and reused recipe key: %v", report.Err())
+	for _, issue := range report.Issues {
+		assert.Equal(t, SourceSeasons, issue.Source)
+	}
+}
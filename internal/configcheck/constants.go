@@ -0,0 +1,16 @@
+package configcheck
+
+// Source names used in the report
+const (
+	SourceItems           = "items"
+	SourceLootTables      = "loot_tables"
+	SourceProgressionTree = "progression_tree"
+	SourceRecipes         = "recipes"
+	SourceSeasons         = "seasons"
+)
+
+// Log messages
+const (
+	LogMsgIssue  = "Config validation issue"
+	LogMsgFailed = "Config validation failed"
+)
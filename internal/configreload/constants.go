@@ -0,0 +1,24 @@
+package configreload
+
+// Source names reported by the reloader
+const (
+	SourceLootTables       = "loot_tables"
+	SourceItemAliases      = "item_aliases"
+	SourceItemThemes       = "item_themes"
+	SourceItemEffects      = "item_effects"
+	SourceProgressionTree  = "progression_tree"
+	SourceSearchDifficulty = "search_difficulty"
+	SourceJobPerks         = "job_perks"
+	SourceJobXPEvents      = "job_xp_events"
+	SourcePersonalTracks   = "personal_tracks"
+	SourceChatDrops        = "chat_drops"
+	SourceRaidBonuses      = "raid_bonuses"
+)
+
+// Log messages
+const (
+	LogMsgReloaded       = "Config reloaded"
+	LogMsgReloadFailed   = "Config reload failed, keeping previous version"
+	LogMsgWatcherStarted = "Config watcher started"
+	LogMsgWatcherError   = "Config watcher error"
+)
@@ -0,0 +1,182 @@
+package configreload
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+)
+
+// DefaultDebounce is how long a file must stay quiet before it is reloaded.
+// Editors often write a file in several steps (truncate, write, rename).
+const DefaultDebounce = 500 * time.Millisecond
+
+// Source is a JSON config file that can be reloaded without a restart.
+// Reload must validate the file and only swap in the new data when it is valid.
+type Source struct {
+	Name   string
+	Path   string
+	Reload func(ctx context.Context) error
+}
+
+// Result reports the outcome of reloading one source
+type Result struct {
+	Name  string `json:"name"`
+	Path  string `json:"path"`
+	Error string `json:"error,omitempty"`
+}
+
+// Reloader reloads config sources on demand and, once started, whenever their files change
+type Reloader struct {
+	sources  []Source
+	debounce time.Duration
+
+	// reloadMu serialises reloads so a file event and an admin request never race
+	reloadMu sync.Mutex
+
+	mu       sync.Mutex
+	watcher  *fsnotify.Watcher
+	timers   map[string]*time.Timer
+	shutdown chan struct{}
+	wg       sync.WaitGroup
+}
+
+// New creates a Reloader for the given sources
+func New(debounce time.Duration, sources ...Source) *Reloader {
+	if debounce <= 0 {
+		debounce = DefaultDebounce
+	}
+	return &Reloader{
+		sources:  sources,
+		debounce: debounce,
+		timers:   make(map[string]*time.Timer),
+	}
+}
+
+// ReloadAll reloads every source and reports each outcome.
+// A failing source keeps its previous config and does not stop the others.
+func (r *Reloader) ReloadAll(ctx context.Context) []Result {
+	results := make([]Result, 0, len(r.sources))
+	for _, src := range r.sources {
+		results = append(results, r.reload(ctx, src))
+	}
+	return results
+}
+
+func (r *Reloader) reload(ctx context.Context, src Source) Result {
+	r.reloadMu.Lock()
+	defer r.reloadMu.Unlock()
+
+	result := Result{Name: src.Name, Path: src.Path}
+	if err := src.Reload(ctx); err != nil {
+		slog.Error(LogMsgReloadFailed, "source", src.Name, "path", src.Path, "error", err)
+		result.Error = err.Error()
+		return result
+	}
+	slog.Info(LogMsgReloaded, "source", src.Name, "path", src.Path)
+	return result
+}
+
+// Start watches the directories of all sources and reloads a source when its file changes.
+// Directories are watched rather than files so that atomic saves (write + rename) are seen.
+func (r *Reloader) Start() error {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return fmt.Errorf("failed to create config watcher: %w", err)
+	}
+
+	dirs := make(map[string]bool)
+	for _, src := range r.sources {
+		dir := filepath.Dir(src.Path)
+		if dirs[dir] {
+			continue
+		}
+		if err := watcher.Add(dir); err != nil {
+			_ = watcher.Close()
+			return fmt.Errorf("failed to watch %s: %w", dir, err)
+		}
+		dirs[dir] = true
+	}
+
+	r.mu.Lock()
+	r.watcher = watcher
+	r.shutdown = make(chan struct{})
+	r.mu.Unlock()
+
+	r.wg.Add(1)
+	go r.watch(watcher)
+
+	slog.Info(LogMsgWatcherStarted, "sources", len(r.sources), "directories", len(dirs))
+	return nil
+}
+
+func (r *Reloader) watch(watcher *fsnotify.Watcher) {
+	defer r.wg.Done()
+	for {
+		select {
+		case <-r.shutdown:
+			return
+		case ev, ok := <-watcher.Events:
+			if !ok {
+				return
+			}
+			if ev.Op&(fsnotify.Write|fsnotify.Create|fsnotify.Rename) == 0 {
+				continue
+			}
+			if src, ok := r.sourceFor(ev.Name); ok {
+				r.schedule(src)
+			}
+		case err, ok := <-watcher.Errors:
+			if !ok {
+				return
+			}
+			slog.Warn(LogMsgWatcherError, "error", err)
+		}
+	}
+}
+
+func (r *Reloader) sourceFor(path string) (Source, bool) {
+	changed := filepath.Clean(path)
+	for _, src := range r.sources {
+		if filepath.Clean(src.Path) == changed {
+			return src, true
+		}
+	}
+	return Source{}, false
+}
+
+// schedule debounces bursts of events for the same file into one reload
+func (r *Reloader) schedule(src Source) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if t, ok := r.timers[src.Path]; ok {
+		t.Stop()
+	}
+	r.timers[src.Path] = time.AfterFunc(r.debounce, func() {
+		r.reload(context.Background(), src)
+	})
+}
+
+// Stop stops watching for file changes. Manual reloads keep working.
+func (r *Reloader) Stop() {
+	r.mu.Lock()
+	if r.watcher == nil {
+		r.mu.Unlock()
+		return
+	}
+	close(r.shutdown)
+	_ = r.watcher.Close()
+	r.watcher = nil
+	for path, t := range r.timers {
+		t.Stop()
+		delete(r.timers, path)
+	}
+	r.mu.Unlock()
+
+	r.wg.Wait()
+}
@@ -0,0 +1,74 @@
+package configreload
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReloadAll_ReportsEachSource(t *testing.T) {
+	var calls int32
+	r := New(0,
+		Source{Name: "good", Path: "good.json", Reload: func(context.Context) error {
+			atomic.AddInt32(&calls, 1)
+			return nil
+		}},
+		Source{Name: "bad", Path: "bad.json", Reload: func(context.Context) error {
+			atomic.AddInt32(&calls, 1)
+			return errors.New("schema validation failed")
+		}},
+	)
+
+	results := r.ReloadAll(context.Background())
+
+	require.Len(t, results, 2)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&calls), "a failing source should not stop the others")
+	assert.Equal(t, Result{Name: "good", Path: "good.json"}, results[0])
+	assert.Equal(t, "bad", results[1].Name)
+	assert.Equal(t, "schema validation failed", results[1].Error)
+}
+
+func TestStart_ReloadsChangedFileOnce(t *testing.T) {
+	dir := t.TempDir()
+	watched := filepath.Join(dir, "loot_tables.json")
+	other := filepath.Join(dir, "unrelated.json")
+	require.NoError(t, os.WriteFile(watched, []byte("{}"), 0o600))
+
+	reloaded := make(chan struct{}, 10)
+	r := New(50*time.Millisecond, Source{Name: SourceLootTables, Path: watched, Reload: func(context.Context) error {
+		reloaded <- struct{}{}
+		return nil
+	}})
+	require.NoError(t, r.Start())
+	defer r.Stop()
+
+	// A burst of writes collapses into one reload; unrelated files are ignored
+	for i := 0; i < 3; i++ {
+		require.NoError(t, os.WriteFile(watched, []byte(`{"v":1}`), 0o600))
+	}
+	require.NoError(t, os.WriteFile(other, []byte("{}"), 0o600))
+
+	select {
+	case <-reloaded:
+	case <-time.After(2 * time.Second):
+		t.Fatal("expected a reload after the file changed")
+	}
+
+	select {
+	case <-reloaded:
+		t.Fatal("burst of writes should be debounced into a single reload")
+	case <-time.After(200 * time.Millisecond):
+	}
+}
+
+func TestStop_WithoutStartIsNoop(t *testing.T) {
+	r := New(0)
+	assert.NotPanics(t, r.Stop)
+}
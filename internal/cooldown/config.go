@@ -1,6 +1,7 @@
 package cooldown
 
 import (
+	"strings"
 	"time"
 
 	"github.com/osse101/BrandishBot_Go/internal/domain"
@@ -14,6 +15,9 @@ type Config struct {
 	// Cooldowns maps action names to their durations
 	// If not specified, defaults from domain package are used
 	Cooldowns map[string]time.Duration
+
+	// Modifiers adjust per-user cooldowns (e.g., search mastery)
+	Modifiers []Modifier
 }
 
 // GetCooldownDuration returns the cooldown duration for an action
@@ -31,8 +35,34 @@ func (c *Config) GetCooldownDuration(action string) time.Duration {
 		return domain.SearchCooldownDuration
 	case domain.ActionSlots:
 		return domain.SlotsCooldownDuration
+	case domain.ActionGambleStart:
+		return domain.GambleStartCooldownDuration
+	case domain.ActionGive:
+		return domain.GiveCooldownDuration
+	case domain.ActionUseItem:
+		return domain.UseItemCooldownDuration
+	case domain.ActionJobSwitch:
+		return domain.JobSwitchCooldownDuration
+	case domain.ActionUndo:
+		return domain.UndoCooldownDuration
+	case domain.ActionDig:
+		return domain.DigCooldownDuration
+	case domain.ActionFish:
+		return domain.FishCooldownDuration
+	case domain.ActionBossAttack:
+		return domain.BossAttackCooldownDuration
 	default:
 		// Unknown action - use default
 		return DefaultCooldownDuration
 	}
 }
+
+// FeatureKeyForAction returns the progression feature that reduces an action's
+// cooldown, or "" when progression does not affect it. Search locations share
+// the search reduction.
+func FeatureKeyForAction(action string) string {
+	if strings.HasPrefix(action, domain.SearchLocationActionPrefix) {
+		action = domain.ActionSearch
+	}
+	return reductionFeatureKeys[action]
+}
@@ -1,6 +1,10 @@
 package cooldown
 
-import "time"
+import (
+	"time"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+)
 
 // =============================================================================
 // Duration Constants
@@ -18,8 +22,37 @@ const (
 const (
 	// FeatureKeySearchCooldownReduction is the progression feature that reduces search cooldown
 	FeatureKeySearchCooldownReduction = "search_cooldown_reduction"
+
+	// FeatureKeySlotsCooldownReduction is the progression feature that reduces slots cooldown
+	FeatureKeySlotsCooldownReduction = "slots_cooldown_reduction"
+
+	// FeatureKeyGambleCooldownReduction is the progression feature that reduces the cooldown on starting gambles
+	FeatureKeyGambleCooldownReduction = "gamble_cooldown_reduction"
+
+	// FeatureKeyGiveCooldownReduction is the progression feature that reduces give cooldown
+	FeatureKeyGiveCooldownReduction = "give_cooldown_reduction"
+
+	// FeatureKeyUseItemCooldownReduction is the progression feature that reduces item use cooldown
+	FeatureKeyUseItemCooldownReduction = "use_item_cooldown_reduction"
+
+	// FeatureKeyCooldownReduction is the progression feature that reduces every
+	// action's cooldown by a fraction (0.05 = 5% shorter)
+	FeatureKeyCooldownReduction = "cooldown_reduction"
+
+	// MaxCooldownReduction caps the fraction FeatureKeyCooldownReduction can
+	// take off a cooldown
+	MaxCooldownReduction = 0.9
 )
 
+// reductionFeatureKeys maps actions to the progression feature that reduces their cooldown
+var reductionFeatureKeys = map[string]string{
+	domain.ActionSearch:      FeatureKeySearchCooldownReduction,
+	domain.ActionSlots:       FeatureKeySlotsCooldownReduction,
+	domain.ActionGambleStart: FeatureKeyGambleCooldownReduction,
+	domain.ActionGive:        FeatureKeyGiveCooldownReduction,
+	domain.ActionUseItem:     FeatureKeyUseItemCooldownReduction,
+}
+
 // =============================================================================
 // Hash Constants
 // =============================================================================
@@ -48,6 +81,13 @@ const (
 		WHERE user_id = $1 AND action_name = $2
 	`
 
+	// SQLSelectUserCooldowns retrieves every last used timestamp for a user
+	SQLSelectUserCooldowns = `
+		SELECT action_name, last_used_at
+		FROM user_cooldowns
+		WHERE user_id = $1
+	`
+
 	// SQLDeleteCooldown removes a cooldown record for a user action
 	SQLDeleteCooldown = `DELETE FROM user_cooldowns WHERE user_id = $1 AND action_name = $2`
 
@@ -88,6 +128,9 @@ const (
 
 	// ErrMsgGetLastUsedFailed is returned when retrieving last used timestamp fails
 	ErrMsgGetLastUsedFailed = "failed to get last used: %w"
+
+	// ErrMsgListCooldownsFailed is returned when listing a user's cooldowns fails
+	ErrMsgListCooldownsFailed = "failed to list cooldowns: %w"
 )
 
 // =============================================================================
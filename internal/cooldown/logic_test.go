@@ -44,6 +44,62 @@ func TestConfig_GetCooldownDuration(t *testing.T) {
 			action: "unknown_action",
 			want:   DefaultCooldownDuration,
 		},
+		{
+			name: "domain default - gamble start",
+			config: Config{
+				Cooldowns: nil,
+			},
+			action: domain.ActionGambleStart,
+			want:   domain.GambleStartCooldownDuration,
+		},
+		{
+			name: "domain default - give is off",
+			config: Config{
+				Cooldowns: nil,
+			},
+			action: domain.ActionGive,
+			want:   0,
+		},
+		{
+			name: "domain default - job switch",
+			config: Config{
+				Cooldowns: nil,
+			},
+			action: domain.ActionJobSwitch,
+			want:   domain.JobSwitchCooldownDuration,
+		},
+		{
+			name: "domain default - undo",
+			config: Config{
+				Cooldowns: nil,
+			},
+			action: domain.ActionUndo,
+			want:   domain.UndoCooldownDuration,
+		},
+		{
+			name: "domain default - dig",
+			config: Config{
+				Cooldowns: nil,
+			},
+			action: domain.ActionDig,
+			want:   domain.DigCooldownDuration,
+		},
+		{
+			name: "domain default - fish",
+			config: Config{
+				Cooldowns: nil,
+			},
+			action: domain.ActionFish,
+			want:   domain.FishCooldownDuration,
+		},
+		{
+			name: "domain default - boss attack",
+			config: Config{
+				Cooldowns: nil,
+			},
+			action: domain.ActionBossAttack,
+			want:   domain.BossAttackCooldownDuration,
+		},
 		{
 			name: "override search",
 			config: Config{
@@ -64,12 +120,17 @@ func TestConfig_GetCooldownDuration(t *testing.T) {
 	}
 }
 
-// Mock for testing
+// Mock for testing. The shared cooldown reduction is answered from reduction;
+// every other feature goes to mockGetModifiedValue.
 type mockProgressionService struct {
 	mockGetModifiedValue func(ctx context.Context, userID string, featureKey string, baseValue float64) (float64, error)
+	reduction            float64
 }
 
 func (m *mockProgressionService) GetModifiedValue(ctx context.Context, userID string, featureKey string, baseValue float64) (float64, error) {
+	if featureKey == FeatureKeyCooldownReduction {
+		return baseValue + m.reduction, nil
+	}
 	if m.mockGetModifiedValue != nil {
 		return m.mockGetModifiedValue(ctx, userID, featureKey, baseValue)
 	}
@@ -80,8 +141,10 @@ func TestGetEffectiveCooldown(t *testing.T) {
 	baseDuration := 5 * time.Minute
 	config := Config{
 		Cooldowns: map[string]time.Duration{
-			domain.ActionSearch: baseDuration,
-			"other":             baseDuration,
+			domain.ActionSearch:                         baseDuration,
+			domain.SearchLocationActionPrefix + "vault": 2 * baseDuration,
+			domain.ActionGambleStart:                    baseDuration,
+			"other":                                     baseDuration,
 		},
 	}
 
@@ -126,7 +189,34 @@ func TestGetEffectiveCooldown(t *testing.T) {
 			want: baseDuration,
 		},
 		{
-			name:   "not search action",
+			name:   "search location action",
+			action: domain.SearchLocationActionPrefix + "vault",
+			mockSetup: func() *mockProgressionService {
+				return &mockProgressionService{
+					mockGetModifiedValue: func(ctx context.Context, userID string, featureKey string, baseValue float64) (float64, error) {
+						assert.Equal(t, FeatureKeySearchCooldownReduction, featureKey)
+						assert.Equal(t, float64(2*baseDuration), baseValue)
+						return baseValue / 2, nil
+					},
+				}
+			},
+			want: baseDuration,
+		},
+		{
+			name:   "gamble start action",
+			action: domain.ActionGambleStart,
+			mockSetup: func() *mockProgressionService {
+				return &mockProgressionService{
+					mockGetModifiedValue: func(ctx context.Context, userID string, featureKey string, baseValue float64) (float64, error) {
+						assert.Equal(t, FeatureKeyGambleCooldownReduction, featureKey)
+						return baseValue / 5, nil
+					},
+				}
+			},
+			want: time.Minute,
+		},
+		{
+			name:   "action without a reduction feature",
 			action: "other",
 			mockSetup: func() *mockProgressionService {
 				return &mockProgressionService{
@@ -138,6 +228,35 @@ func TestGetEffectiveCooldown(t *testing.T) {
 			},
 			want: baseDuration,
 		},
+		{
+			name:   "shared reduction applies to every action",
+			action: "other",
+			mockSetup: func() *mockProgressionService {
+				return &mockProgressionService{reduction: 0.2}
+			},
+			want: 4 * time.Minute,
+		},
+		{
+			name:   "shared reduction stacks after the action reduction",
+			action: domain.ActionSearch,
+			mockSetup: func() *mockProgressionService {
+				return &mockProgressionService{
+					mockGetModifiedValue: func(ctx context.Context, userID string, featureKey string, baseValue float64) (float64, error) {
+						return baseValue / 2, nil
+					},
+					reduction: 0.2,
+				}
+			},
+			want: 2 * time.Minute,
+		},
+		{
+			name:   "shared reduction is capped",
+			action: "other",
+			mockSetup: func() *mockProgressionService {
+				return &mockProgressionService{reduction: 2}
+			},
+			want: 30 * time.Second,
+		},
 	}
 
 	for _, tt := range tests {
@@ -159,6 +278,29 @@ func TestGetEffectiveCooldown(t *testing.T) {
 	}
 }
 
+type halvingModifier struct{}
+
+func (halvingModifier) ModifyCooldown(ctx context.Context, userID, action string, duration time.Duration) time.Duration {
+	return duration / 2
+}
+
+func TestGetEffectiveCooldown_Modifiers(t *testing.T) {
+	b := &postgresBackend{
+		config: Config{
+			Cooldowns: map[string]time.Duration{domain.ActionSearch: 8 * time.Minute},
+			Modifiers: []Modifier{halvingModifier{}, halvingModifier{}},
+		},
+		progressionSvc: &mockProgressionService{
+			mockGetModifiedValue: func(ctx context.Context, userID string, featureKey string, baseValue float64) (float64, error) {
+				return baseValue / 2, nil
+			},
+		},
+	}
+
+	// Progression first, then each modifier in order
+	assert.Equal(t, time.Minute, b.getEffectiveCooldown(context.Background(), "testuser", domain.ActionSearch))
+}
+
 func TestHashUserAction(t *testing.T) {
 	tests := []struct {
 		name   string
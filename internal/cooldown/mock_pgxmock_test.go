@@ -375,3 +375,67 @@ func TestPostgresBackend_EnforceCooldown_FnError(t *testing.T) {
 
 	assert.NoError(t, mock.ExpectationsWereMet())
 }
+
+func TestPostgresBackend_EnforceCooldown_NoCooldownConfigured(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+	require.NoError(t, err)
+	defer mock.Close()
+
+	// A zero cooldown runs the action without touching the database
+	svc := NewPostgresService(mock, Config{Cooldowns: map[string]time.Duration{"action1": 0}}, nil)
+
+	called := false
+	err = svc.EnforceCooldown(context.Background(), "user1", "action1", func() error {
+		called = true
+		return nil
+	})
+
+	assert.NoError(t, err)
+	assert.True(t, called)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPostgresBackend_GetCooldowns(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+	require.NoError(t, err)
+	defer mock.Close()
+
+	now := time.Now()
+	rows := pgxmock.NewRows([]string{"action_name", "last_used_at"}).
+		AddRow("search", now.Add(-20*time.Minute)).
+		AddRow("slots", now.Add(-time.Minute)).
+		AddRow("give", now.Add(-time.Hour))
+	mock.ExpectQuery("SELECT action_name, last_used_at FROM user_cooldowns WHERE user_id = \\$1").
+		WithArgs("user1").
+		WillReturnRows(rows)
+
+	svc := NewPostgresService(mock, Config{Cooldowns: map[string]time.Duration{
+		"search": 30 * time.Minute,
+		"slots":  10 * time.Minute,
+		"give":   5 * time.Minute,
+	}}, nil)
+
+	timers, err := svc.GetCooldowns(context.Background(), "user1")
+
+	require.NoError(t, err)
+	require.Len(t, timers, 2, "actions that are ready are not listed")
+	assert.Equal(t, "slots", timers[0].Action, "soonest ready first")
+	assert.InDelta(t, 9*60, timers[0].RemainingSeconds, 2)
+	assert.Equal(t, "search", timers[1].Action)
+	assert.InDelta(t, 10*60, timers[1].RemainingSeconds, 2)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPostgresBackend_GetCooldowns_DevMode(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+	require.NoError(t, err)
+	defer mock.Close()
+
+	svc := NewPostgresService(mock, Config{DevMode: true}, nil)
+
+	timers, err := svc.GetCooldowns(context.Background(), "user1")
+
+	assert.NoError(t, err)
+	assert.Empty(t, timers)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
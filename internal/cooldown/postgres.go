@@ -6,6 +6,8 @@ import (
 	"encoding/binary"
 	"errors"
 	"fmt"
+	"math"
+	"sort"
 	"time"
 
 	"github.com/jackc/pgx/v5"
@@ -58,6 +60,11 @@ func (b *postgresBackend) CheckCooldown(ctx context.Context, userID, action stri
 func (b *postgresBackend) EnforceCooldown(ctx context.Context, userID, action string, fn func() error) error {
 	log := logger.FromContext(ctx)
 
+	// Actions configured without a cooldown are not tracked
+	if b.config.GetCooldownDuration(action) <= 0 {
+		return fn()
+	}
+
 	// PHASE 1: Cheap unlocked check - fast rejection for ~90% of requests
 	onCooldown, remaining, err := b.CheckCooldown(ctx, userID, action)
 	if err != nil {
@@ -147,6 +154,53 @@ func (b *postgresBackend) GetLastUsed(ctx context.Context, userID, action string
 	return b.getLastUsed(ctx, userID, action)
 }
 
+// GetCooldowns lists the user's actions still on cooldown
+func (b *postgresBackend) GetCooldowns(ctx context.Context, userID string) ([]domain.CooldownTimer, error) {
+	if b.config.DevMode {
+		return nil, nil
+	}
+
+	rows, err := b.db.Query(ctx, SQLSelectUserCooldowns, userID)
+	if err != nil {
+		return nil, fmt.Errorf(ErrMsgListCooldownsFailed, err)
+	}
+	defer rows.Close()
+
+	lastUsed := make(map[string]time.Time)
+	for rows.Next() {
+		var action string
+		var usedAt time.Time
+		if err := rows.Scan(&action, &usedAt); err != nil {
+			return nil, fmt.Errorf(ErrMsgListCooldownsFailed, err)
+		}
+		lastUsed[action] = usedAt
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf(ErrMsgListCooldownsFailed, err)
+	}
+
+	now := time.Now()
+	timers := make([]domain.CooldownTimer, 0, len(lastUsed))
+	for action, usedAt := range lastUsed {
+		onCooldown, remaining := b.checkCooldownInternal(now, &usedAt, b.getEffectiveCooldown(ctx, userID, action))
+		if !onCooldown {
+			continue
+		}
+		timers = append(timers, domain.CooldownTimer{
+			Action:           action,
+			RemainingSeconds: int(math.Ceil(remaining.Seconds())),
+			ReadyAt:          now.Add(remaining),
+		})
+	}
+	sort.Slice(timers, func(i, j int) bool {
+		if !timers[i].ReadyAt.Equal(timers[j].ReadyAt) {
+			return timers[i].ReadyAt.Before(timers[j].ReadyAt)
+		}
+		return timers[i].Action < timers[j].Action
+	})
+	return timers, nil
+}
+
 // getLastUsed retrieves last used time (unlocked read)
 func (b *postgresBackend) getLastUsed(ctx context.Context, userID, action string) (*time.Time, error) {
 	var lastUsed time.Time
@@ -197,14 +251,26 @@ func hashUserAction(userID, action string) int64 {
 func (b *postgresBackend) getEffectiveCooldown(ctx context.Context, userID, action string) time.Duration {
 	duration := b.config.GetCooldownDuration(action)
 
-	// Apply progression modifiers (e.g., cooldown reduction for search)
-	if b.progressionSvc != nil && action == domain.ActionSearch {
-		modifiedDuration, err := b.progressionSvc.GetModifiedValue(ctx, userID, FeatureKeySearchCooldownReduction, float64(duration))
+	// Apply progression modifiers for actions with a cooldown reduction feature
+	if featureKey := FeatureKeyForAction(action); b.progressionSvc != nil && featureKey != "" {
+		modifiedDuration, err := b.progressionSvc.GetModifiedValue(ctx, userID, featureKey, float64(duration))
 		if err == nil {
-			return time.Duration(modifiedDuration)
+			duration = time.Duration(modifiedDuration)
 		}
 	}
 
+	// Apply the progression reduction shared by every action
+	if b.progressionSvc != nil {
+		reduction, err := b.progressionSvc.GetModifiedValue(ctx, userID, FeatureKeyCooldownReduction, 0)
+		if err == nil && reduction > 0 {
+			duration = time.Duration(math.Round(float64(duration) * (1 - min(reduction, MaxCooldownReduction))))
+		}
+	}
+
+	for _, modifier := range b.config.Modifiers {
+		duration = modifier.ModifyCooldown(ctx, userID, action, duration)
+	}
+
 	return duration
 }
 
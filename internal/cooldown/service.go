@@ -13,6 +13,12 @@ type ProgressionService interface {
 	GetModifiedValue(ctx context.Context, userID string, featureKey string, baseValue float64) (float64, error)
 }
 
+// Modifier adjusts a user's cooldown for an action. Modifiers run after
+// progression modifiers, in order.
+type Modifier interface {
+	ModifyCooldown(ctx context.Context, userID, action string, duration time.Duration) time.Duration
+}
+
 // Service manages action cooldowns for users
 type Service interface {
 	// CheckCooldown checks if a user's action is on cooldown
@@ -28,6 +34,9 @@ type Service interface {
 
 	// GetLastUsed returns when action was last performed (for UI display)
 	GetLastUsed(ctx context.Context, userID, action string) (*time.Time, error)
+
+	// GetCooldowns lists the user's actions still on cooldown, soonest ready first
+	GetCooldowns(ctx context.Context, userID string) ([]domain.CooldownTimer, error)
 }
 
 // ErrOnCooldown is returned when action is still on cooldown
@@ -142,3 +142,113 @@ func TestDisassembleAutoUnlock(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, 1, result.QuantityProcessed)
 }
+
+func TestEventRecipeRequiresActiveTheme(t *testing.T) {
+	// ARRANGE
+	repo := NewMockRepository()
+	setupTestData(repo)
+
+	mockJobs := NewMockJobService()
+	mockNaming := &MockNamingResolver{
+		publicToInternal: map[string]string{"pumpkin crown": "emote_crown_halloween"},
+	}
+
+	svc := NewService(repo, &MockEventPublisher{}, mockNaming, &MockProgressionService{}, mockJobs).(*service)
+	svc.rnd = func() float64 { return 0.5 }
+	ctx := context.Background()
+
+	emoteID := 200
+	crownID := 201
+	repo.items["emote_pumpkin"] = &domain.Item{ID: emoteID, InternalName: "emote_pumpkin", PublicName: "pumpkin emote"}
+	repo.itemsByID[emoteID] = repo.items["emote_pumpkin"]
+	repo.items["emote_crown_halloween"] = &domain.Item{ID: crownID, InternalName: "emote_crown_halloween", PublicName: "pumpkin crown"}
+	repo.itemsByID[crownID] = repo.items["emote_crown_halloween"]
+
+	repo.recipes[200] = &domain.Recipe{
+		ID:           200,
+		RecipeKey:    "emote_crown_halloween",
+		TargetItemID: crownID,
+		IsAutoUnlock: true,
+		EventTheme:   "halloween",
+		BaseCost: []domain.RecipeCost{
+			{ItemID: emoteID, Quantity: 3},
+		},
+	}
+
+	userID := "user-alice"
+	repo.users["alice"] = &domain.User{ID: userID, Username: "alice", TwitchID: "twitch-alice"}
+	repo.inventories[userID] = &domain.Inventory{Slots: []domain.InventorySlot{
+		{ItemID: emoteID, Quantity: 3},
+	}}
+	mockJobs.SetFeatureUnlocked(userID, "feature_upgrade", true)
+
+	// ACT 1: No theme active
+	_, err := svc.UpgradeItem(ctx, domain.PlatformTwitch, "twitch-alice", "alice", "pumpkin crown", 1)
+
+	// ASSERT 1: Rejected outside the event
+	assert.ErrorIs(t, err, domain.ErrRecipeOffEvent)
+
+	// ACT 2: Event theme active
+	mockNaming.activeTheme = "halloween"
+	result, err := svc.UpgradeItem(ctx, domain.PlatformTwitch, "twitch-alice", "alice", "pumpkin crown", 1)
+
+	// ASSERT 2: Crafted during the event
+	require.NoError(t, err)
+	assert.Equal(t, 1, result.Quantity)
+}
+
+func TestEventRecipeOpensWithGameEventTheme(t *testing.T) {
+	// ARRANGE
+	repo := NewMockRepository()
+	setupTestData(repo)
+
+	mockJobs := NewMockJobService()
+	mockNaming := &MockNamingResolver{
+		publicToInternal: map[string]string{"emote crown": "item_emote_crown"},
+		activeTheme:      "emote_fest", // Ignored once a theme source is set
+	}
+	themes := &MockEventThemes{active: map[string]bool{}}
+
+	svc := NewService(repo, &MockEventPublisher{}, mockNaming, &MockProgressionService{}, mockJobs, WithEventThemes(themes)).(*service)
+	svc.rnd = func() float64 { return 0.5 }
+	ctx := context.Background()
+
+	emoteID := 200
+	crownID := 201
+	repo.items["item_emote_pog"] = &domain.Item{ID: emoteID, InternalName: "item_emote_pog", PublicName: "pog emote"}
+	repo.itemsByID[emoteID] = repo.items["item_emote_pog"]
+	repo.items["item_emote_crown"] = &domain.Item{ID: crownID, InternalName: "item_emote_crown", PublicName: "emote crown"}
+	repo.itemsByID[crownID] = repo.items["item_emote_crown"]
+
+	repo.recipes[200] = &domain.Recipe{
+		ID:           200,
+		RecipeKey:    "emote_crown",
+		TargetItemID: crownID,
+		IsAutoUnlock: true,
+		EventTheme:   "emote_fest",
+		BaseCost: []domain.RecipeCost{
+			{ItemID: emoteID, Quantity: 3},
+		},
+	}
+
+	userID := "user-alice"
+	repo.users["alice"] = &domain.User{ID: userID, Username: "alice", TwitchID: "twitch-alice"}
+	repo.inventories[userID] = &domain.Inventory{Slots: []domain.InventorySlot{
+		{ItemID: emoteID, Quantity: 3},
+	}}
+	mockJobs.SetFeatureUnlocked(userID, "feature_upgrade", true)
+
+	// ACT 1: No game event runs the theme
+	_, err := svc.UpgradeItem(ctx, domain.PlatformTwitch, "twitch-alice", "alice", "emote crown", 1)
+
+	// ASSERT 1: Rejected outside the event
+	assert.ErrorIs(t, err, domain.ErrRecipeOffEvent)
+
+	// ACT 2: A game event runs the theme
+	themes.active["emote_fest"] = true
+	result, err := svc.UpgradeItem(ctx, domain.PlatformTwitch, "twitch-alice", "alice", "emote crown", 1)
+
+	// ASSERT 2: Crafted during the event
+	require.NoError(t, err)
+	assert.Equal(t, 1, result.Quantity)
+}
@@ -1,5 +1,7 @@
 package crafting
 
+import "github.com/osse101/BrandishBot_Go/internal/domain"
+
 // ==================== Crafting Mechanics ====================
 
 // Masterwork constants control the probability and multiplier for critical crafts
@@ -9,8 +11,28 @@ const (
 
 	// MasterworkMultiplier is applied to output quantity when masterwork procs (2x output)
 	MasterworkMultiplier = 2
+
+	// LegendaryMultiplier is applied to output quantity when a legendary craft procs (3x output)
+	LegendaryMultiplier = 3
 )
 
+// tierQualitySteps is how many quality levels each craft tier raises its
+// output above the averaged quality of the materials
+var tierQualitySteps = map[domain.CraftTier]int{
+	domain.CraftTierStandard:   0,
+	domain.CraftTierFine:       1,
+	domain.CraftTierMasterwork: 2,
+	domain.CraftTierLegendary:  3,
+}
+
+// tierOutputMultipliers is how many items each craft tier produces
+var tierOutputMultipliers = map[domain.CraftTier]int{
+	domain.CraftTierStandard:   1,
+	domain.CraftTierFine:       1,
+	domain.CraftTierMasterwork: MasterworkMultiplier,
+	domain.CraftTierLegendary:  LegendaryMultiplier,
+}
+
 // Perfect Salvage constants control the probability and multiplier for disassembly bonuses
 const (
 	// PerfectSalvageChance is the probability of a "Perfect Salvage" occurring during disassembly
@@ -6,6 +6,7 @@ import (
 	"math"
 
 	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/itemflags"
 	"github.com/osse101/BrandishBot_Go/internal/logger"
 	"github.com/osse101/BrandishBot_Go/internal/repository"
 	"github.com/osse101/BrandishBot_Go/internal/utils"
@@ -52,9 +53,10 @@ func (s *service) DisassembleItem(ctx context.Context, platform, platformID, use
 	evt := NewItemDisassembledEvent(user.ID, itemName, actualQuantity, recipeKey, perfectSalvageTriggered, perfectSalvageCount, PerfectSalvageMultiplier, outputMap)
 	s.eventPublisher.PublishWithRetry(ctx, evt)
 
-	log.Info("Items disassembled", "username", username, "item", itemName, "quantity", actualQuantity, "outputs", outputMap, "perfect_salvage", perfectSalvageTriggered)
+	log.Info("Items disassembled", "username", username, "item", itemName, "quantity", actualQuantity, "requested", quantity, "outputs", outputMap, "perfect_salvage", perfectSalvageTriggered)
 	return &DisassembleResult{
 		Outputs:           outputMap,
+		QuantityRequested: quantity,
 		QuantityProcessed: actualQuantity,
 		IsPerfectSalvage:  perfectSalvageTriggered,
 		Multiplier:        PerfectSalvageMultiplier,
@@ -82,6 +84,10 @@ func (s *service) validateDisassembleInput(ctx context.Context, platform, platfo
 		return nil, nil, nil, err
 	}
 
+	if err := itemflags.EnsureUnlocked(ctx, s.locks, user.ID, item); err != nil {
+		return nil, nil, nil, err
+	}
+
 	recipe, err := s.getAndValidateDisassembleRecipe(ctx, item.ID, user.ID, resolvedName)
 	if err != nil {
 		return nil, nil, nil, err
@@ -129,7 +135,12 @@ func (s *service) executeDisassembleTx(ctx context.Context, userID string, itemI
 		return 0, 0, nil, fmt.Errorf("failed to get inventory: %w", err)
 	}
 
-	actualQuantity, err := s.calculateDisassembleQuantity(inventory, itemID, recipe.QuantityConsumed, requestedQuantity, itemName)
+	owned, err := s.ownedQuantity(ctx, userID, inventory, itemID, recipe.QuantityConsumed, itemName)
+	if err != nil {
+		return 0, 0, nil, err
+	}
+
+	actualQuantity, err := s.calculateDisassembleQuantity(owned, recipe.QuantityConsumed, requestedQuantity, itemName)
 	if err != nil {
 		return 0, 0, nil, err
 	}
@@ -148,10 +159,14 @@ func (s *service) executeDisassembleTx(ctx context.Context, userID string, itemI
 	perfectSalvageCount := s.calculatePerfectSalvage(ctx, actualQuantity)
 
 	// Process outputs with averaged quality from source materials
-	outputMap, err := s.processDisassembleOutputs(ctx, inventory, recipe.Outputs, actualQuantity, perfectSalvageCount, outputQuality)
+	before := utils.BuildSlotMap(inventory)
+	outputMap, produced, err := s.processDisassembleOutputs(ctx, inventory, recipe.Outputs, actualQuantity, perfectSalvageCount, outputQuality)
 	if err != nil {
 		return 0, 0, nil, err
 	}
+	if err := s.settleOverflow(ctx, before, inventory); err != nil {
+		return 0, 0, nil, err
+	}
 
 	if err := tx.UpdateInventory(ctx, userID, *inventory); err != nil {
 		return 0, 0, nil, fmt.Errorf("failed to update inventory: %w", err)
@@ -160,13 +175,48 @@ func (s *service) executeDisassembleTx(ctx context.Context, userID string, itemI
 	if err := tx.Commit(ctx); err != nil {
 		return 0, 0, nil, fmt.Errorf("failed to commit transaction: %w", err)
 	}
+	s.recordDisassembleUndo(ctx, userID, consumedItems, produced)
 
 	return actualQuantity, perfectSalvageCount, outputMap, nil
 }
 
-func (s *service) calculateDisassembleQuantity(inventory *domain.Inventory, itemID int, quantityConsumed int, quantity int, itemName string) (int, error) {
-	// Use total quantity across all slots
-	userQuantity := utils.GetTotalQuantity(inventory, itemID)
+// ownedQuantity is how many of the item the user holds outright, across all
+// slots. Borrowed items go back to their lender, so they cannot be
+// disassembled.
+func (s *service) ownedQuantity(ctx context.Context, userID string, inventory *domain.Inventory, itemID int, quantityConsumed int, itemName string) (int, error) {
+	total := utils.GetTotalQuantity(inventory, itemID)
+	if s.loans == nil {
+		return total, nil
+	}
+	borrowed, err := s.loans.GetBorrowedQuantity(ctx, userID, itemID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get borrowed quantity: %w", err)
+	}
+	if borrowed > 0 && total-borrowed < quantityConsumed {
+		return 0, fmt.Errorf("cannot disassemble borrowed %s (%d of %d borrowed) | %w", itemName, borrowed, total, domain.ErrItemBorrowed)
+	}
+	return total - borrowed, nil
+}
+
+// recordDisassembleUndo lets the user reassemble what they took apart while
+// they still hold its outputs
+func (s *service) recordDisassembleUndo(ctx context.Context, userID string, consumed, produced []domain.InventorySlot) {
+	if s.undo == nil {
+		return
+	}
+	changes := make([]domain.InventoryChange, 0, len(consumed)+len(produced))
+	for _, slot := range consumed {
+		changes = append(changes, domain.InventoryChange{UserID: userID, ItemID: slot.ItemID, QualityLevel: slot.QualityLevel, Delta: -slot.Quantity})
+	}
+	for _, slot := range produced {
+		if slot.Quantity > 0 {
+			changes = append(changes, domain.InventoryChange{UserID: userID, ItemID: slot.ItemID, QualityLevel: slot.QualityLevel, Delta: slot.Quantity})
+		}
+	}
+	s.undo.Record(ctx, userID, domain.UndoActionDisassemble, changes)
+}
+
+func (s *service) calculateDisassembleQuantity(userQuantity int, quantityConsumed int, quantity int, itemName string) (int, error) {
 	maxPossible := userQuantity / quantityConsumed
 	if maxPossible == 0 {
 		return 0, fmt.Errorf("insufficient items to disassemble %s (need %d, have %d) | %w", itemName, quantityConsumed, userQuantity, domain.ErrInsufficientQuantity)
@@ -181,7 +231,8 @@ func (s *service) calculateDisassembleQuantity(inventory *domain.Inventory, item
 
 // processDisassembleOutputs adds disassemble outputs to inventory and builds result map.
 // Outputs inherit the averaged quality level from the consumed source items.
-func (s *service) processDisassembleOutputs(ctx context.Context, inventory *domain.Inventory, outputs []domain.RecipeOutput, actualQuantity int, perfectSalvageCount int, outputQuality domain.QualityLevel) (map[string]int, error) {
+// It also returns the slots it added.
+func (s *service) processDisassembleOutputs(ctx context.Context, inventory *domain.Inventory, outputs []domain.RecipeOutput, actualQuantity int, perfectSalvageCount int, outputQuality domain.QualityLevel) (map[string]int, []domain.InventorySlot, error) {
 	outputMap := make(map[string]int)
 
 	// Collect IDs
@@ -193,7 +244,7 @@ func (s *service) processDisassembleOutputs(ctx context.Context, inventory *doma
 	// Batch fetch items
 	items, err := s.repo.GetItemsByIDs(ctx, itemIDs)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get output items: %w", err)
+		return nil, nil, fmt.Errorf("failed to get output items: %w", err)
 	}
 
 	// Map items by ID for easy lookup
@@ -205,15 +256,19 @@ func (s *service) processDisassembleOutputs(ctx context.Context, inventory *doma
 	// Prepare items to add to inventory
 	itemsToAdd := make([]domain.InventorySlot, 0, len(outputs))
 
+	// Finer sources (such as higher-tier crafts) return more materials;
+	// poorer ones still return the recipe's base outputs
+	qualityBonus := max(utils.GetQualityMultiplier(outputQuality), 1)
+
 	for _, output := range outputs {
 		// Calculate output for regular items
-		regularQuantity := (actualQuantity - perfectSalvageCount) * output.Quantity
+		basePerItem := int(math.Round(float64(output.Quantity) * qualityBonus))
+		regularQuantity := (actualQuantity - perfectSalvageCount) * basePerItem
 
 		// Calculate output for perfect salvage items (apply multiplier)
 		// Multiplier is applied per item, rounded up
 		perfectQuantity := 0
 		if perfectSalvageCount > 0 {
-			basePerItem := output.Quantity
 			perfectPerItem := int(math.Ceil(float64(basePerItem) * PerfectSalvageMultiplier))
 			perfectQuantity = perfectSalvageCount * perfectPerItem
 		}
@@ -223,7 +278,7 @@ func (s *service) processDisassembleOutputs(ctx context.Context, inventory *doma
 		// Get item name for the output
 		outputItem, ok := itemsByID[output.ItemID]
 		if !ok {
-			return nil, fmt.Errorf("output item not found: %d | %w", output.ItemID, domain.ErrItemNotFound)
+			return nil, nil, fmt.Errorf("output item not found: %d | %w", output.ItemID, domain.ErrItemNotFound)
 		}
 
 		// Resolve internal name to public name for result map
@@ -246,7 +301,7 @@ func (s *service) processDisassembleOutputs(ctx context.Context, inventory *doma
 	// Add all outputs to inventory using optimized helper
 	utils.AddItemsToInventory(inventory, itemsToAdd, nil)
 
-	return outputMap, nil
+	return outputMap, itemsToAdd, nil
 }
 
 func (s *service) calculatePerfectSalvage(ctx context.Context, quantity int) int {
@@ -15,7 +15,9 @@ type ItemUpgradedPayload struct {
 	RecipeKey     string `json:"recipe_key,omitempty"`
 	IsMasterwork  bool   `json:"is_masterwork"`
 	BonusQuantity int    `json:"bonus_quantity"`
-	Timestamp     int64  `json:"timestamp"`
+	// Tiers counts the crafts that rolled each tier above standard
+	Tiers     map[domain.CraftTier]int `json:"tiers,omitempty"`
+	Timestamp int64                    `json:"timestamp"`
 }
 
 // ItemDisassembledPayload represents the data for an item disassembled event
@@ -32,7 +34,7 @@ type ItemDisassembledPayload struct {
 }
 
 // NewItemUpgradedEvent creates a new event for an item upgrade
-func NewItemUpgradedEvent(userID, itemName string, quantity int, recipeKey string, isMasterwork bool, bonusQuantity int) event.Event {
+func NewItemUpgradedEvent(userID, itemName string, quantity int, recipeKey string, isMasterwork bool, bonusQuantity int, tiers map[domain.CraftTier]int) event.Event {
 	return event.Event{
 		Version: event.EventSchemaVersion,
 		Type:    domain.EventTypeItemUpgraded,
@@ -43,6 +45,7 @@ func NewItemUpgradedEvent(userID, itemName string, quantity int, recipeKey strin
 			RecipeKey:     recipeKey,
 			IsMasterwork:  isMasterwork,
 			BonusQuantity: bonusQuantity,
+			Tiers:         tiers,
 			Timestamp:     time.Now().Unix(),
 		},
 		Metadata: domain.CraftingMetadata{
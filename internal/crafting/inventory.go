@@ -1,6 +1,7 @@
 package crafting
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/osse101/BrandishBot_Go/internal/domain"
@@ -56,3 +57,14 @@ func addItemToInventory(inventory *domain.Inventory, itemID, quantity int, quali
 		QualityLevel: qualityLevel,
 	})
 }
+
+// settleOverflow sells the slots opened since before that do not fit
+func (s *service) settleOverflow(ctx context.Context, before map[utils.SlotKey]int, inventory *domain.Inventory) error {
+	if s.overflow == nil {
+		return nil
+	}
+	if _, _, err := s.overflow.SettleInventoryOverflow(ctx, before, inventory); err != nil {
+		return fmt.Errorf("failed to settle inventory overflow: %w", err)
+	}
+	return nil
+}
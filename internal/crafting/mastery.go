@@ -0,0 +1,101 @@
+package crafting
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/logger"
+)
+
+// GetRecipeMastery lists the user's progress towards mastering each recipe
+// they have crafted, most crafted first
+func (s *service) GetRecipeMastery(ctx context.Context, platform, platformID, username string) ([]RecipeMastery, error) {
+	log := logger.FromContext(ctx)
+	log.Info("GetRecipeMastery called", "platform", platform, "platformID", platformID, "username", username)
+
+	if err := s.validatePlatformInput(platform, platformID); err != nil {
+		return nil, err
+	}
+	user, err := s.validateUser(ctx, platform, platformID)
+	if err != nil {
+		return nil, err
+	}
+	if s.mastery == nil {
+		return []RecipeMastery{}, nil
+	}
+
+	counts, err := s.mastery.GetUserRecipeCraftCounts(ctx, user.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get recipe craft counts: %w", err)
+	}
+
+	recipes, err := s.repo.GetAllCraftingRecipes(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get crafting recipes: %w", err)
+	}
+	itemIDs := make([]int, 0, len(recipes))
+	for _, recipe := range recipes {
+		if counts[recipe.RecipeKey] > 0 {
+			itemIDs = append(itemIDs, recipe.TargetItemID)
+		}
+	}
+	if len(itemIDs) == 0 {
+		return []RecipeMastery{}, nil
+	}
+	items, err := s.repo.GetItemsByIDs(ctx, itemIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get recipe items: %w", err)
+	}
+	names := make(map[int]string, len(items))
+	for _, item := range items {
+		names[item.ID] = item.InternalName
+	}
+
+	mastery := make([]RecipeMastery, 0, len(itemIDs))
+	for _, recipe := range recipes {
+		crafts := counts[recipe.RecipeKey]
+		if crafts == 0 {
+			continue
+		}
+		entry := RecipeMastery{
+			RecipeKey: recipe.RecipeKey,
+			ItemName:  s.displayName(names[recipe.TargetItemID]),
+			Crafts:    crafts,
+			Threshold: s.masteryConfig.Threshold,
+			Mastered:  s.isMastered(crafts),
+		}
+		if entry.Mastered {
+			entry.MasterworkBonus = s.masteryConfig.MasterworkBonus
+		}
+		mastery = append(mastery, entry)
+	}
+	sort.SliceStable(mastery, func(i, j int) bool {
+		return mastery[i].Crafts > mastery[j].Crafts
+	})
+
+	return mastery, nil
+}
+
+// isMastered reports whether a craft count masters a recipe
+func (s *service) isMastered(crafts int) bool {
+	return s.masteryConfig.Threshold > 0 && crafts >= s.masteryConfig.Threshold
+}
+
+// masteryBonus is the masterwork chance the user has earned by mastering the
+// recipe. Failing to look up craft counts costs the user the bonus, not the craft.
+func (s *service) masteryBonus(ctx context.Context, userID string, recipe *domain.Recipe) float64 {
+	if s.mastery == nil || s.masteryConfig.MasterworkBonus == 0 || recipe == nil || recipe.RecipeKey == "" {
+		return 0
+	}
+	counts, err := s.mastery.GetUserRecipeCraftCounts(ctx, userID)
+	if err != nil {
+		logger.FromContext(ctx).Warn("Failed to get recipe craft counts, ignoring mastery bonus", "error", err, "user_id", userID)
+		return 0
+	}
+	if !s.isMastered(counts[recipe.RecipeKey]) {
+		return 0
+	}
+	return s.masteryConfig.MasterworkBonus
+}
@@ -0,0 +1,75 @@
+package crafting
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/osse101/BrandishBot_Go/internal/logger"
+	"github.com/osse101/BrandishBot_Go/internal/utils"
+)
+
+// PreviewUpgrade reports the material cost, masterwork chance, and expected
+// output of an upgrade without touching the user's inventory. It runs the same
+// checks as UpgradeItem, so a preview succeeds only when the upgrade could.
+func (s *service) PreviewUpgrade(ctx context.Context, platform, platformID, username, itemName string, quantity int) (*UpgradePreview, error) {
+	log := logger.FromContext(ctx)
+	log.Info("PreviewUpgrade called", "platform", platform, "platformID", platformID, "username", username, "item", itemName, "quantity", quantity)
+
+	user, _, recipe, resolvedName, err := s.validateUpgradeInput(ctx, platform, platformID, itemName, quantity)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkJobLevel(ctx, user.ID, recipe); err != nil {
+		return nil, err
+	}
+
+	inventory, err := s.repo.GetInventory(ctx, user.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get inventory: %w", err)
+	}
+
+	itemIDs := make([]int, 0, len(recipe.BaseCost))
+	for _, cost := range recipe.BaseCost {
+		itemIDs = append(itemIDs, cost.ItemID)
+	}
+	items, err := s.repo.GetItemsByIDs(ctx, itemIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get material items: %w", err)
+	}
+	names := make(map[int]string, len(items))
+	for _, item := range items {
+		names[item.ID] = item.InternalName
+	}
+
+	materials := make([]MaterialCost, 0, len(recipe.BaseCost))
+	for _, cost := range recipe.BaseCost {
+		required := cost.Quantity * quantity
+		owned := utils.GetTotalQuantity(inventory, cost.ItemID)
+		name, ok := names[cost.ItemID]
+		if !ok {
+			name = fmt.Sprintf("item #%d", cost.ItemID)
+		}
+		materials = append(materials, MaterialCost{
+			ItemName:  s.displayName(name),
+			Required:  required,
+			Owned:     owned,
+			Shortfall: max(required-owned, 0),
+		})
+	}
+
+	craftable := calculateMaxPossibleCrafts(inventory, recipe, quantity)
+	chances := s.tierChancesFor(ctx, user.ID, recipe, inventory)
+
+	return &UpgradePreview{
+		ItemName:          s.displayName(resolvedName),
+		QuantityRequested: quantity,
+		QuantityCraftable: craftable,
+		Materials:         materials,
+		MasterworkChance:  chances.masterwork,
+		FineChance:        chances.fine,
+		LegendaryChance:   chances.legendary,
+		ExpectedOutput:    float64(craftable) * chances.expectedOutput(),
+		MinOutput:         craftable,
+		MaxOutput:         craftable * chances.maxOutput(),
+	}, nil
+}
@@ -49,6 +49,9 @@ type RecipeDef struct {
 	Costs            []RecipeCost `json:"costs"`
 	RequiredJobLevel int          `json:"required_job_level,omitempty"`
 	IsAutoUnlock     bool         `json:"is_auto_unlock"`
+	// EventTheme limits crafting to while this event theme is active, from
+	// the dated naming themes or a live game event
+	EventTheme string `json:"event_theme,omitempty"`
 }
 
 // DisassembleRecipeDef represents a single disassemble recipe in the JSON
@@ -76,6 +79,10 @@ type RecipeLoader interface {
 	Load(craftingPath, disassemblePath string) (*Config, error)
 	Validate(config *Config, itemRepo repository.Item) error
 	SyncToDatabase(ctx context.Context, config *Config, craftingRepo repository.Crafting, itemRepo repository.Item, configDir string) (*SyncResult, error)
+	// SyncCraftingRecipes inserts or updates the given crafting recipes
+	// without checking whether a file changed, for recipes defined outside the
+	// recipe configs. Other recipes in the database are left alone.
+	SyncCraftingRecipes(ctx context.Context, recipes []RecipeDef, craftingRepo repository.Crafting, itemRepo repository.Item) (*SyncResult, error)
 }
 
 // SyncResult contains the result of syncing recipes to the database
@@ -145,12 +152,20 @@ func (l *recipeLoader) Validate(config *Config, itemRepo repository.Item) error
 		itemsByInternalName[item.InternalName] = true
 	}
 
-	craftingKeys := make(map[string]bool)
-	if err := l.validateUpgradeRecipes(config.UpgradeConfig, itemsByInternalName, craftingKeys); err != nil {
-		return err
+	return ValidateRecipes(config, itemsByInternalName)
+}
+
+// ValidateRecipes checks both recipe files against a set of known item internal names.
+// Every problem found is returned, joined into a single error.
+func ValidateRecipes(config *Config, itemsByInternalName map[string]bool) error {
+	if config == nil || config.UpgradeConfig == nil || config.DisassembleConfig == nil {
+		return fmt.Errorf("%w: config is nil", ErrInvalidConfig)
 	}
 
-	return l.validateDisassembleRecipes(config.DisassembleConfig, itemsByInternalName, craftingKeys)
+	craftingKeys := make(map[string]bool)
+	upgradeErr := validateUpgradeRecipes(config.UpgradeConfig, itemsByInternalName, craftingKeys)
+	disassembleErr := validateDisassembleRecipes(config.DisassembleConfig, itemsByInternalName, craftingKeys)
+	return errors.Join(upgradeErr, disassembleErr)
 }
 
 // SyncToDatabase syncs the recipe configuration to the database idempotently
@@ -226,6 +241,27 @@ func (l *recipeLoader) SyncToDatabase(ctx context.Context, config *Config, craft
 	return result, nil
 }
 
+// SyncCraftingRecipes syncs recipes that live outside the recipe configs
+func (l *recipeLoader) SyncCraftingRecipes(ctx context.Context, recipes []RecipeDef, craftingRepo repository.Crafting, itemRepo repository.Item) (*SyncResult, error) {
+	itemIDsByInternalName, err := l.getItemIDsByInternalName(ctx, itemRepo)
+	if err != nil {
+		return nil, err
+	}
+
+	// Every recipe not passed in would be reported as orphaned, so that
+	// part of the result is dropped
+	craftingResult, err := l.syncCraftingRecipes(ctx, &UpgradeConfig{Recipes: recipes}, craftingRepo, itemIDsByInternalName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to sync crafting recipes: %w", err)
+	}
+	return &SyncResult{
+		CraftingInserted: craftingResult.Inserted,
+		CraftingUpdated:  craftingResult.Updated,
+		CraftingSkipped:  craftingResult.Skipped,
+		OrphanedRecipes:  []string{},
+	}, nil
+}
+
 func (l *recipeLoader) checkFileChanged(ctx context.Context, itemRepo repository.Item, path string, metadataName string) (bool, *syncutil.FileState, error) {
 	state, err := syncutil.GetFileState(path)
 	if err != nil {
@@ -298,7 +334,8 @@ func (l *recipeLoader) syncCraftingRecipes(ctx context.Context, config *UpgradeC
 			needsUpdate := existingRecipe.TargetItemID != targetItemID ||
 				!costsEqual(existingRecipe.BaseCost, costs) ||
 				existingRecipe.RequiredJobLevel != recipeDef.RequiredJobLevel ||
-				existingRecipe.IsAutoUnlock != recipeDef.IsAutoUnlock
+				existingRecipe.IsAutoUnlock != recipeDef.IsAutoUnlock ||
+				existingRecipe.EventTheme != recipeDef.EventTheme
 
 			if needsUpdate {
 				// Update existing recipe
@@ -308,6 +345,7 @@ func (l *recipeLoader) syncCraftingRecipes(ctx context.Context, config *UpgradeC
 					BaseCost:         costs,
 					RequiredJobLevel: recipeDef.RequiredJobLevel,
 					IsAutoUnlock:     recipeDef.IsAutoUnlock,
+					EventTheme:       recipeDef.EventTheme,
 				}); err != nil {
 					return nil, fmt.Errorf("failed to update crafting recipe '%s': %w", recipeDef.RecipeKey, err)
 				}
@@ -324,6 +362,7 @@ func (l *recipeLoader) syncCraftingRecipes(ctx context.Context, config *UpgradeC
 				BaseCost:         costs,
 				RequiredJobLevel: recipeDef.RequiredJobLevel,
 				IsAutoUnlock:     recipeDef.IsAutoUnlock,
+				EventTheme:       recipeDef.EventTheme,
 			})
 			if err != nil {
 				return nil, fmt.Errorf("failed to insert crafting recipe '%s': %w", recipeDef.RecipeKey, err)
@@ -514,75 +553,81 @@ func costsEqual(a, b []domain.RecipeCost) bool {
 	return true
 }
 
-func (l *recipeLoader) validateUpgradeRecipes(config *UpgradeConfig, itemsByInternalName map[string]bool, seenKeys map[string]bool) error {
+func validateUpgradeRecipes(config *UpgradeConfig, itemsByInternalName map[string]bool, seenKeys map[string]bool) error {
+	var errs []error
 	for i, recipe := range config.Recipes {
 		if recipe.RecipeKey == "" {
-			return fmt.Errorf("%w: crafting recipe at index %d has empty recipe_key", ErrInvalidConfig, i)
+			errs = append(errs, fmt.Errorf("%w: crafting recipe at index %d has empty recipe_key", ErrInvalidConfig, i))
+			continue
 		}
 
 		if seenKeys[recipe.RecipeKey] {
-			return fmt.Errorf("%w: '%s' in crafting recipes", ErrDuplicateRecipeKey, recipe.RecipeKey)
+			errs = append(errs, fmt.Errorf("%w: '%s' in crafting recipes", ErrDuplicateRecipeKey, recipe.RecipeKey))
+			continue
 		}
 		seenKeys[recipe.RecipeKey] = true
 
 		if !itemsByInternalName[recipe.TargetItem] {
-			return fmt.Errorf("%w: crafting recipe '%s' references non-existent target_item '%s'", ErrInvalidItem, recipe.RecipeKey, recipe.TargetItem)
+			errs = append(errs, fmt.Errorf("%w: crafting recipe '%s' references non-existent target_item '%s'", ErrInvalidItem, recipe.RecipeKey, recipe.TargetItem))
 		}
 
 		if len(recipe.Costs) == 0 {
-			return fmt.Errorf("%w: crafting recipe '%s' has no costs", ErrInvalidConfig, recipe.RecipeKey)
+			errs = append(errs, fmt.Errorf("%w: crafting recipe '%s' has no costs", ErrInvalidConfig, recipe.RecipeKey))
 		}
 
 		for j, cost := range recipe.Costs {
 			if !itemsByInternalName[cost.Item] {
-				return fmt.Errorf("%w: crafting recipe '%s' cost[%d] references non-existent item '%s'", ErrInvalidItem, recipe.RecipeKey, j, cost.Item)
+				errs = append(errs, fmt.Errorf("%w: crafting recipe '%s' cost[%d] references non-existent item '%s'", ErrInvalidItem, recipe.RecipeKey, j, cost.Item))
 			}
 			if cost.Quantity <= 0 {
-				return fmt.Errorf("%w: crafting recipe '%s' cost[%d] has non-positive quantity", ErrInvalidConfig, recipe.RecipeKey, j)
+				errs = append(errs, fmt.Errorf("%w: crafting recipe '%s' cost[%d] has non-positive quantity", ErrInvalidConfig, recipe.RecipeKey, j))
 			}
 		}
 	}
-	return nil
+	return errors.Join(errs...)
 }
 
-func (l *recipeLoader) validateDisassembleRecipes(config *DisassembleConfig, itemsByInternalName map[string]bool, craftingKeys map[string]bool) error {
+func validateDisassembleRecipes(config *DisassembleConfig, itemsByInternalName map[string]bool, craftingKeys map[string]bool) error {
+	var errs []error
 	seenKeys := make(map[string]bool)
 	for i, recipe := range config.Recipes {
 		if recipe.RecipeKey == "" {
-			return fmt.Errorf("%w: disassemble recipe at index %d has empty recipe_key", ErrInvalidConfig, i)
+			errs = append(errs, fmt.Errorf("%w: disassemble recipe at index %d has empty recipe_key", ErrInvalidConfig, i))
+			continue
 		}
 
 		if seenKeys[recipe.RecipeKey] {
-			return fmt.Errorf("%w: '%s' in disassemble recipes", ErrDuplicateRecipeKey, recipe.RecipeKey)
+			errs = append(errs, fmt.Errorf("%w: '%s' in disassemble recipes", ErrDuplicateRecipeKey, recipe.RecipeKey))
+			continue
 		}
 		seenKeys[recipe.RecipeKey] = true
 
 		if !itemsByInternalName[recipe.RecipeKey] {
-			return fmt.Errorf("%w: disassemble recipe '%s' references non-existent source item", ErrInvalidItem, recipe.RecipeKey)
+			errs = append(errs, fmt.Errorf("%w: disassemble recipe '%s' references non-existent source item", ErrInvalidItem, recipe.RecipeKey))
 		}
 
 		if recipe.QuantityConsumed <= 0 {
-			return fmt.Errorf("%w: disassemble recipe '%s' has non-positive quantity_consumed", ErrInvalidConfig, recipe.RecipeKey)
+			errs = append(errs, fmt.Errorf("%w: disassemble recipe '%s' has non-positive quantity_consumed", ErrInvalidConfig, recipe.RecipeKey))
 		}
 
 		if len(recipe.Outputs) == 0 {
-			return fmt.Errorf("%w: disassemble recipe '%s' has no outputs", ErrInvalidConfig, recipe.RecipeKey)
+			errs = append(errs, fmt.Errorf("%w: disassemble recipe '%s' has no outputs", ErrInvalidConfig, recipe.RecipeKey))
 		}
 
 		for j, output := range recipe.Outputs {
 			if !itemsByInternalName[output.Item] {
-				return fmt.Errorf("%w: disassemble recipe '%s' output[%d] references non-existent item '%s'", ErrInvalidItem, recipe.RecipeKey, j, output.Item)
+				errs = append(errs, fmt.Errorf("%w: disassemble recipe '%s' output[%d] references non-existent item '%s'", ErrInvalidItem, recipe.RecipeKey, j, output.Item))
 			}
 			if output.Quantity <= 0 {
-				return fmt.Errorf("%w: disassemble recipe '%s' output[%d] has non-positive quantity", ErrInvalidConfig, recipe.RecipeKey, j)
+				errs = append(errs, fmt.Errorf("%w: disassemble recipe '%s' output[%d] has non-positive quantity", ErrInvalidConfig, recipe.RecipeKey, j))
 			}
 		}
 
 		if recipe.AssociatedUpgrade != "" && !craftingKeys[recipe.AssociatedUpgrade] {
-			return fmt.Errorf("%w: disassemble recipe '%s' references non-existent associated_upgrade '%s'", ErrInvalidItem, recipe.RecipeKey, recipe.AssociatedUpgrade)
+			errs = append(errs, fmt.Errorf("%w: disassemble recipe '%s' references non-existent associated_upgrade '%s'", ErrInvalidItem, recipe.RecipeKey, recipe.AssociatedUpgrade))
 		}
 	}
-	return nil
+	return errors.Join(errs...)
 }
 
 func outputsEqual(a, b []domain.RecipeOutput) bool {
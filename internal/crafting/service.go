@@ -19,20 +19,68 @@ type RecipeInfo struct {
 
 // Result contains the result of an upgrade operation
 type Result struct {
-	ItemName      string `json:"item_name"`
-	Quantity      int    `json:"quantity"`
-	IsMasterwork  bool   `json:"is_masterwork"`
-	BonusQuantity int    `json:"bonus_quantity"`
+	ItemName          string `json:"item_name"`
+	Quantity          int    `json:"quantity"`
+	IsMasterwork      bool   `json:"is_masterwork"`
+	BonusQuantity     int    `json:"bonus_quantity"`
+	QuantityRequested int    `json:"quantity_requested"`
+	QuantityProcessed int    `json:"quantity_processed"`
+	// Tiers counts the crafts that rolled each tier above standard
+	Tiers map[domain.CraftTier]int `json:"tiers,omitempty"`
+}
+
+// IsPartial reports whether fewer crafts ran than were requested
+func (r *Result) IsPartial() bool {
+	return r.QuantityProcessed < r.QuantityRequested
 }
 
 // DisassembleResult contains the result of a disassemble operation
 type DisassembleResult struct {
 	Outputs           map[string]int `json:"outputs"`
+	QuantityRequested int            `json:"quantity_requested"`
 	QuantityProcessed int            `json:"quantity_processed"`
 	IsPerfectSalvage  bool           `json:"is_perfect_salvage"`
 	Multiplier        float64        `json:"multiplier"`
 }
 
+// IsPartial reports whether fewer items were disassembled than were requested
+func (r *DisassembleResult) IsPartial() bool {
+	return r.QuantityProcessed < r.QuantityRequested
+}
+
+// MaterialCost is one recipe material's total cost against what the user holds
+type MaterialCost struct {
+	ItemName  string `json:"item_name"`
+	Required  int    `json:"required"`
+	Owned     int    `json:"owned"`
+	Shortfall int    `json:"shortfall"`
+}
+
+// UpgradePreview describes what an upgrade would cost and produce without
+// performing it
+type UpgradePreview struct {
+	ItemName          string         `json:"item_name"`
+	QuantityRequested int            `json:"quantity_requested"`
+	QuantityCraftable int            `json:"quantity_craftable"`
+	Materials         []MaterialCost `json:"materials"`
+	MasterworkChance  float64        `json:"masterwork_chance"`
+	FineChance        float64        `json:"fine_chance"`
+	LegendaryChance   float64        `json:"legendary_chance"`
+	ExpectedOutput    float64        `json:"expected_output"`
+	MinOutput         int            `json:"min_output"`
+	MaxOutput         int            `json:"max_output"`
+}
+
+// RecipeMastery is a user's progress towards mastering one recipe
+type RecipeMastery struct {
+	RecipeKey       string  `json:"recipe_key"`
+	ItemName        string  `json:"item_name"`
+	Crafts          int     `json:"crafts"`
+	Threshold       int     `json:"threshold"`
+	Mastered        bool    `json:"mastered"`
+	MasterworkBonus float64 `json:"masterwork_bonus"`
+}
+
 // EventPublisher defines the interface for publishing events
 type EventPublisher interface {
 	PublishWithRetry(ctx context.Context, event event.Event)
@@ -41,10 +89,14 @@ type EventPublisher interface {
 // Service defines the interface for crafting operations
 type Service interface {
 	UpgradeItem(ctx context.Context, platform, platformID, username, itemName string, quantity int) (*Result, error)
+	PreviewUpgrade(ctx context.Context, platform, platformID, username, itemName string, quantity int) (*UpgradePreview, error)
 	GetRecipe(ctx context.Context, itemName, platform, platformID, username string) (*RecipeInfo, error)
 	GetUnlockedRecipes(ctx context.Context, platform, platformID, username string) ([]repository.UnlockedRecipeInfo, error)
 	GetAllRecipes(ctx context.Context) ([]repository.RecipeListItem, error)
 	DisassembleItem(ctx context.Context, platform, platformID, username, itemName string, quantity int) (*DisassembleResult, error)
+	// GetRecipeMastery lists the user's progress towards mastering each
+	// recipe they have crafted
+	GetRecipeMastery(ctx context.Context, platform, platformID, username string) ([]RecipeMastery, error)
 	Shutdown(ctx context.Context) error
 }
 
@@ -59,6 +111,110 @@ type JobService interface {
 	IsJobFeatureUnlocked(ctx context.Context, userID string, featureKey string) (bool, error)
 }
 
+// LoanChecker reports how many of an item a user holds on loan
+type LoanChecker interface {
+	GetBorrowedQuantity(ctx context.Context, userID string, itemID int) (int, error)
+}
+
+// ItemLockChecker reports whether a user has locked an item
+type ItemLockChecker interface {
+	IsItemLocked(ctx context.Context, userID string, itemID int) (bool, error)
+}
+
+// UndoRecorder records operations the user can undo for a short while
+type UndoRecorder interface {
+	Record(ctx context.Context, userID string, action domain.UndoAction, changes []domain.InventoryChange)
+}
+
+// MasteryTracker reports how many items a user has crafted with each recipe
+type MasteryTracker interface {
+	GetUserRecipeCraftCounts(ctx context.Context, userID string) (map[string]int, error)
+}
+
+// EventThemes reports whether an event theme is running in the context's
+// community
+type EventThemes interface {
+	IsThemeActive(ctx context.Context, theme string) bool
+}
+
+// OverflowSettler sells the slots a write opened past the inventory slot limit
+type OverflowSettler interface {
+	SettleInventoryOverflow(ctx context.Context, before map[utils.SlotKey]int, inventory *domain.Inventory) (int, int, error)
+}
+
+// Option configures optional crafting service dependencies
+type Option func(*service)
+
+// WithLoanChecker stops users disassembling items they have borrowed
+func WithLoanChecker(loans LoanChecker) Option {
+	return func(s *service) {
+		s.loans = loans
+	}
+}
+
+// WithItemLocks stops users disassembling items they have locked
+func WithItemLocks(locks ItemLockChecker) Option {
+	return func(s *service) {
+		s.locks = locks
+	}
+}
+
+// WithUndo lets users undo their recent disassembles
+func WithUndo(undo UndoRecorder) Option {
+	return func(s *service) {
+		s.undo = undo
+	}
+}
+
+// WithEventThemes opens event recipes while their theme runs through a game
+// event as well as by date. Without it only the dated naming theme counts.
+func WithEventThemes(themes EventThemes) Option {
+	return func(s *service) {
+		s.themes = themes
+	}
+}
+
+// WithOverflow sells crafted and salvaged items that open slots past the
+// crafter's inventory slot limit, as item use does
+func WithOverflow(overflow OverflowSettler) Option {
+	return func(s *service) {
+		s.overflow = overflow
+	}
+}
+
+// TierConfig sets the chances of an upgrade crafting above standard quality.
+// Boosts are relative: a 0.05 boost turns a 10% chance into 10.5%.
+type TierConfig struct {
+	FineChance       float64
+	MasterworkChance float64
+	LegendaryChance  float64
+	JobLevelBonus    float64            // Boost per Blacksmith level
+	ToolBonuses      map[string]float64 // Boost while the crafter holds the tool, by item internal name
+}
+
+// WithTiers sets the crafting quality tier chances. Without it only
+// masterworks roll, at MasterworkChance.
+func WithTiers(tiers TierConfig) Option {
+	return func(s *service) {
+		s.tiers = tiers
+	}
+}
+
+// MasteryConfig sets when a recipe is mastered and what mastering it grants
+type MasteryConfig struct {
+	Threshold       int     // Crafts of a recipe needed to master it
+	MasterworkBonus float64 // Added to the masterwork chance of mastered recipes
+}
+
+// WithMastery rewards users who have crafted a recipe many times. Without it
+// no recipe is ever mastered.
+func WithMastery(tracker MasteryTracker, mastery MasteryConfig) Option {
+	return func(s *service) {
+		s.mastery = tracker
+		s.masteryConfig = mastery
+	}
+}
+
 // Crafting balance constants are defined in constants.go
 
 type service struct {
@@ -67,19 +223,32 @@ type service struct {
 	progressionSvc ProgressionService
 	jobService     JobService      // For checking job level requirements
 	namingResolver naming.Resolver // For resolving public names to internal names
-	rnd            func() float64  // For rolling RNG (does not need to be cryptographically secure)
+	loans          LoanChecker     // nil allows disassembling everything held
+	locks          ItemLockChecker // nil ignores item locks
+	undo           UndoRecorder    // nil records no disassembles to undo
+	themes         EventThemes     // nil opens event recipes by the dated naming theme only
+	overflow       OverflowSettler // nil ignores slot limits
+	tiers          TierConfig
+	mastery        MasteryTracker // nil disables recipe mastery
+	masteryConfig  MasteryConfig
+	rnd            func() float64 // For rolling RNG (does not need to be cryptographically secure)
 }
 
 // NewService creates a new crafting service
-func NewService(repo repository.Crafting, eventPublisher EventPublisher, namingResolver naming.Resolver, progressionSvc ProgressionService, jobService JobService) Service {
-	return &service{
+func NewService(repo repository.Crafting, eventPublisher EventPublisher, namingResolver naming.Resolver, progressionSvc ProgressionService, jobService JobService, opts ...Option) Service {
+	s := &service{
 		repo:           repo,
 		eventPublisher: eventPublisher,
 		progressionSvc: progressionSvc,
 		jobService:     jobService,
 		namingResolver: namingResolver,
+		tiers:          TierConfig{MasterworkChance: MasterworkChance},
 		rnd:            utils.RandomFloat,
 	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
 }
 
 // Shutdown gracefully shuts down the crafting service by waiting for all async operations to complete
@@ -7,8 +7,10 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/utils"
 )
 
 // ==================== Tests ====================
@@ -410,6 +412,9 @@ func TestUpgradeItem(t *testing.T) {
 		// Assert
 		assert.NoError(t, err)
 		assert.Equal(t, 1, result.Quantity, "Should process max available")
+		assert.Equal(t, 2, result.QuantityRequested)
+		assert.Equal(t, 1, result.QuantityProcessed)
+		assert.True(t, result.IsPartial())
 	})
 
 	t.Run("Error Case: Recipe Not Unlocked", func(t *testing.T) {
@@ -1584,6 +1589,49 @@ func TestDisassembleItem_WithNamingResolution(t *testing.T) {
 	assert.Equal(t, 1, result.Outputs["rusty_scrap"])
 }
 
+// fakeLoanChecker reports borrowed quantities by item ID
+type fakeLoanChecker map[int]int
+
+func (f fakeLoanChecker) GetBorrowedQuantity(ctx context.Context, userID string, itemID int) (int, error) {
+	return f[itemID], nil
+}
+
+func TestDisassembleItem_BorrowedItems(t *testing.T) {
+	t.Parallel()
+
+	setup := func(borrowed int) *service {
+		repo := NewMockRepository()
+		setupTestData(repo)
+		svc := NewService(repo, &MockEventPublisher{}, nil, nil, NewMockJobService(), WithLoanChecker(fakeLoanChecker{TestItemID2: borrowed})).(*service)
+		svc.rnd = func() float64 { return 1.0 } // No perfect salvage
+		ctx := context.Background()
+		repo.UpdateInventory(ctx, "user-alice", domain.Inventory{Slots: []domain.InventorySlot{
+			{ItemID: TestItemID2, Quantity: 3},
+		}})
+		repo.UnlockRecipe(ctx, "user-alice", 1)
+		return svc
+	}
+
+	t.Run("only owned items are disassembled", func(t *testing.T) {
+		t.Parallel()
+		svc := setup(2)
+
+		result, err := svc.DisassembleItem(context.Background(), domain.PlatformTwitch, "twitch-alice", "alice", domain.ItemLootbox1, 3)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1, result.QuantityProcessed)
+	})
+
+	t.Run("borrowed items cannot be disassembled", func(t *testing.T) {
+		t.Parallel()
+		svc := setup(3)
+
+		_, err := svc.DisassembleItem(context.Background(), domain.PlatformTwitch, "twitch-alice", "alice", domain.ItemLootbox1, 1)
+
+		assert.ErrorIs(t, err, domain.ErrItemBorrowed)
+	})
+}
+
 func TestShutdown_WaitsForAsync(t *testing.T) {
 	t.Parallel()
 	repo := NewMockRepository()
@@ -1663,3 +1711,419 @@ func TestDisassembleItem_WithProgression(t *testing.T) {
 		assert.Equal(t, "crafting_success_rate", mockProg.calls[0].featureKey)
 	})
 }
+
+func TestPreviewUpgrade(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Reports Costs And Shortfall", func(t *testing.T) {
+		t.Parallel()
+		repo := NewMockRepository()
+		setupTestData(repo)
+		svc := NewService(repo, &MockEventPublisher{}, nil, nil, NewMockJobService())
+		ctx := context.Background()
+
+		repo.UpdateInventory(ctx, "user-alice", domain.Inventory{Slots: []domain.InventorySlot{
+			{ItemID: TestItemID1, Quantity: 3},
+		}})
+		repo.UnlockRecipe(ctx, "user-alice", 1)
+
+		preview, err := svc.PreviewUpgrade(ctx, domain.PlatformTwitch, "twitch-alice", "alice", domain.ItemLootbox1, 5)
+		require.NoError(t, err)
+
+		assert.Equal(t, domain.ItemLootbox1, preview.ItemName)
+		assert.Equal(t, 5, preview.QuantityRequested)
+		assert.Equal(t, 3, preview.QuantityCraftable)
+		require.Len(t, preview.Materials, 1)
+		assert.Equal(t, MaterialCost{ItemName: domain.ItemLootbox0, Required: 5, Owned: 3, Shortfall: 2}, preview.Materials[0])
+		assert.Equal(t, MasterworkChance, preview.MasterworkChance)
+		assert.InDelta(t, 3.3, preview.ExpectedOutput, 0.0001)
+		assert.Equal(t, 3, preview.MinOutput)
+		assert.Equal(t, 6, preview.MaxOutput)
+	})
+
+	t.Run("Leaves Inventory Untouched", func(t *testing.T) {
+		t.Parallel()
+		repo := NewMockRepository()
+		setupTestData(repo)
+		mockEvent := &MockEventPublisher{}
+		svc := NewService(repo, mockEvent, nil, nil, NewMockJobService())
+		ctx := context.Background()
+
+		repo.UpdateInventory(ctx, "user-alice", domain.Inventory{Slots: []domain.InventorySlot{
+			{ItemID: TestItemID1, Quantity: 4},
+		}})
+		repo.UnlockRecipe(ctx, "user-alice", 1)
+
+		_, err := svc.PreviewUpgrade(ctx, domain.PlatformTwitch, "twitch-alice", "alice", domain.ItemLootbox1, 2)
+		require.NoError(t, err)
+
+		inv, _ := repo.GetInventory(ctx, "user-alice")
+		require.Len(t, inv.Slots, 1)
+		assert.Equal(t, 4, inv.Slots[0].Quantity)
+		assert.Empty(t, mockEvent.Published)
+	})
+
+	t.Run("Applies Masterwork Modifier", func(t *testing.T) {
+		t.Parallel()
+		repo := NewMockRepository()
+		setupTestData(repo)
+		mockProg := &MockProgressionService{returnValue: 0.5}
+		svc := NewService(repo, &MockEventPublisher{}, nil, mockProg, NewMockJobService())
+		ctx := context.Background()
+
+		repo.UpdateInventory(ctx, "user-alice", domain.Inventory{Slots: []domain.InventorySlot{
+			{ItemID: TestItemID1, Quantity: 10},
+		}})
+		repo.UnlockRecipe(ctx, "user-alice", 1)
+
+		preview, err := svc.PreviewUpgrade(ctx, domain.PlatformTwitch, "twitch-alice", "alice", domain.ItemLootbox1, 10)
+		require.NoError(t, err)
+		assert.Equal(t, 0.5, preview.MasterworkChance)
+		assert.InDelta(t, 15.0, preview.ExpectedOutput, 0.0001)
+	})
+
+	t.Run("Recipe Not Unlocked", func(t *testing.T) {
+		t.Parallel()
+		repo := NewMockRepository()
+		setupTestData(repo)
+		svc := NewService(repo, &MockEventPublisher{}, nil, nil, NewMockJobService())
+
+		_, err := svc.PreviewUpgrade(context.Background(), domain.PlatformTwitch, "twitch-alice", "alice", domain.ItemLootbox1, 1)
+		assert.ErrorIs(t, err, domain.ErrRecipeLocked)
+	})
+}
+
+func TestDisassembleItem_PartialCompletion(t *testing.T) {
+	t.Parallel()
+	repo := NewMockRepository()
+	setupTestData(repo)
+	svc := NewService(repo, &MockEventPublisher{}, nil, nil, NewMockJobService()).(*service)
+	svc.rnd = func() float64 { return 1.0 } // Prevent perfect salvage
+	ctx := context.Background()
+
+	repo.UpdateInventory(ctx, "user-alice", domain.Inventory{Slots: []domain.InventorySlot{
+		{ItemID: TestItemID2, Quantity: 3},
+	}})
+	repo.UnlockRecipe(ctx, "user-alice", 1)
+
+	result, err := svc.DisassembleItem(ctx, domain.PlatformTwitch, "twitch-alice", "alice", domain.ItemLootbox1, 500)
+	require.NoError(t, err)
+	assert.Equal(t, 500, result.QuantityRequested)
+	assert.Equal(t, 3, result.QuantityProcessed)
+	assert.True(t, result.IsPartial())
+}
+
+func TestUpgradeItem_QualityTiers(t *testing.T) {
+	t.Parallel()
+
+	tiers := TierConfig{FineChance: 0.2, MasterworkChance: 0.1, LegendaryChance: 0.05}
+
+	upgradeOnce := func(t *testing.T, roll float64, tiers TierConfig) (*Result, *domain.Inventory) {
+		t.Helper()
+		repo := NewMockRepository()
+		setupTestData(repo)
+		svc := NewService(repo, &MockEventPublisher{}, nil, nil, NewMockJobService(), WithTiers(tiers)).(*service)
+		svc.rnd = func() float64 { return roll }
+		ctx := context.Background()
+
+		repo.UpdateInventory(ctx, "user-alice", domain.Inventory{Slots: []domain.InventorySlot{
+			{ItemID: TestItemID1, Quantity: 1, QualityLevel: domain.QualityCommon},
+		}})
+		repo.UnlockRecipe(ctx, "user-alice", 1)
+
+		result, err := svc.UpgradeItem(ctx, domain.PlatformTwitch, "twitch-alice", "alice", domain.ItemLootbox1, 1)
+		require.NoError(t, err)
+		inv, _ := repo.GetInventory(ctx, "user-alice")
+		return result, inv
+	}
+
+	outputSlot := func(t *testing.T, inv *domain.Inventory) domain.InventorySlot {
+		t.Helper()
+		for _, slot := range inv.Slots {
+			if slot.ItemID == TestItemID2 {
+				return slot
+			}
+		}
+		t.Fatal("no crafted lootbox1 in inventory")
+		return domain.InventorySlot{}
+	}
+
+	tests := []struct {
+		name         string
+		roll         float64
+		wantTier     domain.CraftTier
+		wantQuantity int
+		wantQuality  domain.QualityLevel
+	}{
+		// Masterwork covers [0, 0.1), legendary [0.1, 0.15), fine [0.15, 0.35)
+		{"masterwork", 0.05, domain.CraftTierMasterwork, 2, domain.QualityRare},
+		{"legendary", 0.12, domain.CraftTierLegendary, 3, domain.QualityEpic},
+		{"fine", 0.2, domain.CraftTierFine, 1, domain.QualityUncommon},
+		{"standard", 0.5, domain.CraftTierStandard, 1, domain.QualityCommon},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			result, inv := upgradeOnce(t, tt.roll, tiers)
+
+			assert.Equal(t, tt.wantQuantity, result.Quantity)
+			if tt.wantTier == domain.CraftTierStandard {
+				assert.Empty(t, result.Tiers)
+			} else {
+				assert.Equal(t, map[domain.CraftTier]int{tt.wantTier: 1}, result.Tiers)
+			}
+			slot := outputSlot(t, inv)
+			assert.Equal(t, tt.wantQuantity, slot.Quantity)
+			assert.Equal(t, tt.wantQuality, slot.QualityLevel)
+		})
+	}
+
+	t.Run("Blacksmith Level Boosts Chances", func(t *testing.T) {
+		t.Parallel()
+		repo := NewMockRepository()
+		setupTestData(repo)
+		jobs := NewMockJobService()
+		jobs.levels["user-alice"] = map[string]int{domain.JobKeyBlacksmith: 10}
+		boosted := TierConfig{MasterworkChance: 0.1, JobLevelBonus: 0.1}
+		svc := NewService(repo, &MockEventPublisher{}, nil, nil, jobs, WithTiers(boosted)).(*service)
+		// 0.15 misses the base 10% but hits the boosted 20%
+		svc.rnd = func() float64 { return 0.15 }
+		ctx := context.Background()
+
+		repo.UpdateInventory(ctx, "user-alice", domain.Inventory{Slots: []domain.InventorySlot{
+			{ItemID: TestItemID1, Quantity: 1},
+		}})
+		repo.UnlockRecipe(ctx, "user-alice", 1)
+
+		result, err := svc.UpgradeItem(ctx, domain.PlatformTwitch, "twitch-alice", "alice", domain.ItemLootbox1, 1)
+		require.NoError(t, err)
+		assert.True(t, result.IsMasterwork)
+	})
+
+	t.Run("Held Tool Boosts Chances", func(t *testing.T) {
+		t.Parallel()
+		withTool := TierConfig{MasterworkChance: 0.1, ToolBonuses: map[string]float64{domain.ItemLootbox2: 1.0}}
+
+		repo := NewMockRepository()
+		setupTestData(repo)
+		svc := NewService(repo, &MockEventPublisher{}, nil, nil, NewMockJobService(), WithTiers(withTool)).(*service)
+		svc.rnd = func() float64 { return 0.15 }
+		ctx := context.Background()
+		repo.UnlockRecipe(ctx, "user-alice", 1)
+
+		// Without the tool held, 0.15 misses the 10% chance
+		repo.UpdateInventory(ctx, "user-alice", domain.Inventory{Slots: []domain.InventorySlot{
+			{ItemID: TestItemID1, Quantity: 1},
+		}})
+		result, err := svc.UpgradeItem(ctx, domain.PlatformTwitch, "twitch-alice", "alice", domain.ItemLootbox1, 1)
+		require.NoError(t, err)
+		assert.False(t, result.IsMasterwork)
+
+		// Holding it doubles the chance to 20%, and the tool is not consumed
+		repo.UpdateInventory(ctx, "user-alice", domain.Inventory{Slots: []domain.InventorySlot{
+			{ItemID: TestItemID1, Quantity: 1},
+			{ItemID: TestItemID3, Quantity: 1},
+		}})
+		result, err = svc.UpgradeItem(ctx, domain.PlatformTwitch, "twitch-alice", "alice", domain.ItemLootbox1, 1)
+		require.NoError(t, err)
+		assert.True(t, result.IsMasterwork)
+
+		inv, _ := repo.GetInventory(ctx, "user-alice")
+		toolHeld := false
+		for _, slot := range inv.Slots {
+			if slot.ItemID == TestItemID3 && slot.Quantity == 1 {
+				toolHeld = true
+			}
+		}
+		assert.True(t, toolHeld, "Tool should stay in the inventory")
+	})
+
+	t.Run("Preview Includes All Tiers", func(t *testing.T) {
+		t.Parallel()
+		repo := NewMockRepository()
+		setupTestData(repo)
+		svc := NewService(repo, &MockEventPublisher{}, nil, nil, NewMockJobService(), WithTiers(tiers))
+		ctx := context.Background()
+
+		repo.UpdateInventory(ctx, "user-alice", domain.Inventory{Slots: []domain.InventorySlot{
+			{ItemID: TestItemID1, Quantity: 10},
+		}})
+		repo.UnlockRecipe(ctx, "user-alice", 1)
+
+		preview, err := svc.PreviewUpgrade(ctx, domain.PlatformTwitch, "twitch-alice", "alice", domain.ItemLootbox1, 10)
+		require.NoError(t, err)
+		assert.Equal(t, 0.2, preview.FineChance)
+		assert.Equal(t, 0.05, preview.LegendaryChance)
+		// 10 * (1 + 0.1*1 + 0.05*2)
+		assert.InDelta(t, 12.0, preview.ExpectedOutput, 0.0001)
+		assert.Equal(t, 30, preview.MaxOutput)
+	})
+}
+
+func TestDisassembleItem_QualityBonus(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		quality    domain.QualityLevel
+		wantOutput int
+	}{
+		{"legendary doubles outputs", domain.QualityLegendary, 2},
+		{"common returns base outputs", domain.QualityCommon, 1},
+		{"junk still returns base outputs", domain.QualityJunk, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			repo := NewMockRepository()
+			setupTestData(repo)
+			svc := NewService(repo, &MockEventPublisher{}, nil, nil, NewMockJobService()).(*service)
+			svc.rnd = func() float64 { return 1.0 } // Prevent perfect salvage
+			ctx := context.Background()
+
+			repo.UpdateInventory(ctx, "user-alice", domain.Inventory{Slots: []domain.InventorySlot{
+				{ItemID: TestItemID2, Quantity: 1, QualityLevel: tt.quality},
+			}})
+			repo.UnlockRecipe(ctx, "user-alice", 1)
+
+			result, err := svc.DisassembleItem(ctx, domain.PlatformTwitch, "twitch-alice", "alice", domain.ItemLootbox1, 1)
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantOutput, result.Outputs[domain.ItemLootbox0])
+		})
+	}
+}
+
+func TestRecipeMastery(t *testing.T) {
+	t.Parallel()
+
+	mastery := MasteryConfig{Threshold: 50, MasterworkBonus: 0.1}
+
+	setup := func(crafts int) (*service, *MockRepository) {
+		repo := NewMockRepository()
+		setupTestData(repo)
+		repo.recipes[1].RecipeKey = "lootbox_tier0"
+		tracker := &MockMasteryTracker{counts: map[string]map[string]int{
+			"user-alice": {"lootbox_tier0": crafts},
+		}}
+		svc := NewService(repo, &MockEventPublisher{}, nil, nil, NewMockJobService(), WithMastery(tracker, mastery)).(*service)
+		return svc, repo
+	}
+
+	upgrade := func(t *testing.T, svc *service, repo *MockRepository) *Result {
+		t.Helper()
+		ctx := context.Background()
+		repo.UpdateInventory(ctx, "user-alice", domain.Inventory{Slots: []domain.InventorySlot{
+			{ItemID: TestItemID1, Quantity: 1},
+		}})
+		repo.UnlockRecipe(ctx, "user-alice", 1)
+		result, err := svc.UpgradeItem(ctx, domain.PlatformTwitch, "twitch-alice", "alice", domain.ItemLootbox1, 1)
+		require.NoError(t, err)
+		return result
+	}
+
+	t.Run("Mastered Recipe Boosts Masterwork Chance", func(t *testing.T) {
+		t.Parallel()
+		svc, repo := setup(50)
+		// 0.15 misses the base 10% but hits the mastered 20%
+		svc.rnd = func() float64 { return 0.15 }
+
+		result := upgrade(t, svc, repo)
+
+		assert.True(t, result.IsMasterwork)
+		assert.Equal(t, 2, result.Quantity)
+	})
+
+	t.Run("Unmastered Recipe Gets No Bonus", func(t *testing.T) {
+		t.Parallel()
+		svc, repo := setup(49)
+		svc.rnd = func() float64 { return 0.15 }
+
+		result := upgrade(t, svc, repo)
+
+		assert.False(t, result.IsMasterwork)
+		assert.Equal(t, 1, result.Quantity)
+	})
+
+	t.Run("Tracker Failure Ignores Bonus", func(t *testing.T) {
+		t.Parallel()
+		svc, repo := setup(50)
+		svc.mastery.(*MockMasteryTracker).returnError = fmt.Errorf("stats down")
+		svc.rnd = func() float64 { return 0.15 }
+
+		result := upgrade(t, svc, repo)
+
+		assert.False(t, result.IsMasterwork)
+	})
+
+	t.Run("Lists Crafted Recipes", func(t *testing.T) {
+		t.Parallel()
+		svc, _ := setup(60)
+
+		got, err := svc.GetRecipeMastery(context.Background(), domain.PlatformTwitch, "twitch-alice", "alice")
+
+		require.NoError(t, err)
+		assert.Equal(t, []RecipeMastery{{
+			RecipeKey:       "lootbox_tier0",
+			ItemName:        domain.ItemLootbox1,
+			Crafts:          60,
+			Threshold:       50,
+			Mastered:        true,
+			MasterworkBonus: 0.1,
+		}}, got)
+	})
+
+	t.Run("Lists Nothing Without Crafts", func(t *testing.T) {
+		t.Parallel()
+		svc, _ := setup(0)
+
+		got, err := svc.GetRecipeMastery(context.Background(), domain.PlatformTwitch, "twitch-alice", "alice")
+
+		require.NoError(t, err)
+		assert.Empty(t, got)
+	})
+}
+
+// sellNewSlots stands in for the user service's overflow, selling every slot
+// a write opened
+type sellNewSlots struct {
+	sold int
+}
+
+func (s *sellNewSlots) SettleInventoryOverflow(_ context.Context, before map[utils.SlotKey]int, inventory *domain.Inventory) (int, int, error) {
+	kept := inventory.Slots[:0]
+	for _, slot := range inventory.Slots {
+		if _, existed := before[utils.SlotKey{ItemID: slot.ItemID, QualityLevel: slot.QualityLevel}]; existed {
+			kept = append(kept, slot)
+			continue
+		}
+		s.sold++
+	}
+	inventory.Slots = kept
+	return s.sold, 0, nil
+}
+
+func TestUpgradeItem_SettlesOverflow(t *testing.T) {
+	t.Parallel()
+	repo := NewMockRepository()
+	setupTestData(repo)
+	overflow := &sellNewSlots{}
+	svc := NewService(repo, &MockEventPublisher{}, nil, nil, NewMockJobService(), WithOverflow(overflow)).(*service)
+	svc.rnd = func() float64 { return 1.0 }
+	ctx := context.Background()
+
+	repo.UpdateInventory(ctx, "user-alice", domain.Inventory{Slots: []domain.InventorySlot{
+		{ItemID: TestItemID1, Quantity: 2, QualityLevel: domain.QualityCommon},
+	}})
+	repo.UnlockRecipe(ctx, "user-alice", 1)
+
+	_, err := svc.UpgradeItem(ctx, domain.PlatformTwitch, "twitch-alice", "alice", domain.ItemLootbox1, 1)
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, overflow.sold, "the crafted lootbox opened a new slot")
+	inv, _ := repo.GetInventory(ctx, "user-alice")
+	for _, slot := range inv.Slots {
+		assert.NotEqual(t, TestItemID2, slot.ItemID, "the sold slot is not written")
+	}
+}
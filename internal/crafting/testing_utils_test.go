@@ -8,6 +8,7 @@ import (
 
 	"github.com/osse101/BrandishBot_Go/internal/domain"
 	"github.com/osse101/BrandishBot_Go/internal/event"
+	"github.com/osse101/BrandishBot_Go/internal/naming"
 	"github.com/osse101/BrandishBot_Go/internal/repository"
 )
 
@@ -598,6 +599,7 @@ func (m *MockProgressionService) GetModifiedValue(ctx context.Context, userID st
 type MockNamingResolver struct {
 	publicToInternal map[string]string
 	internalToPublic map[string]string
+	activeTheme      string
 }
 
 func (m *MockNamingResolver) ResolvePublicName(publicName string) (internalName string, ok bool) {
@@ -623,8 +625,13 @@ func (m *MockNamingResolver) ResolveInternalName(internalName string) (publicNam
 func (m *MockNamingResolver) GetDisplayName(internalName string, qualityLevel domain.QualityLevel) string {
 	return internalName
 }
-func (m *MockNamingResolver) GetActiveTheme() string { return "" }
-func (m *MockNamingResolver) Reload() error          { return nil }
+func (m *MockNamingResolver) GetDisplayNameForTheme(internalName string, qualityLevel domain.QualityLevel, theme string) string {
+	return internalName
+}
+func (m *MockNamingResolver) GetActiveTheme() string                   { return m.activeTheme }
+func (m *MockNamingResolver) GetThemes() map[string]naming.ThemePeriod { return nil }
+func (m *MockNamingResolver) SuggestNames(name string) []string        { return nil }
+func (m *MockNamingResolver) Reload() error                            { return nil }
 func (m *MockNamingResolver) RegisterItem(internalName, publicName string) {
 	if m.publicToInternal == nil {
 		m.publicToInternal = make(map[string]string)
@@ -636,6 +643,28 @@ func (m *MockNamingResolver) RegisterItem(internalName, publicName string) {
 	m.internalToPublic[internalName] = publicName
 }
 
+// MockMasteryTracker for testing recipe mastery
+type MockMasteryTracker struct {
+	counts      map[string]map[string]int // userID -> recipeKey -> crafts
+	returnError error
+}
+
+func (m *MockMasteryTracker) GetUserRecipeCraftCounts(ctx context.Context, userID string) (map[string]int, error) {
+	if m.returnError != nil {
+		return nil, m.returnError
+	}
+	return m.counts[userID], nil
+}
+
+// MockEventThemes for testing event recipes opened by game events
+type MockEventThemes struct {
+	active map[string]bool // theme -> running
+}
+
+func (m *MockEventThemes) IsThemeActive(ctx context.Context, theme string) bool {
+	return m.active[theme]
+}
+
 // MockJobService for testing job level requirements
 type MockJobService struct {
 	mu               sync.Mutex
@@ -0,0 +1,98 @@
+package crafting
+
+import (
+	"context"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/logger"
+	"github.com/osse101/BrandishBot_Go/internal/utils"
+)
+
+// craftTierOrder lists the craft tiers from lowest to highest
+var craftTierOrder = []domain.CraftTier{
+	domain.CraftTierStandard,
+	domain.CraftTierFine,
+	domain.CraftTierMasterwork,
+	domain.CraftTierLegendary,
+}
+
+// tierChances holds one craft's chance of rolling each tier above standard
+type tierChances struct {
+	fine       float64
+	masterwork float64
+	legendary  float64
+}
+
+// roll picks the tier for one craft. Masterwork takes the lowest rolls so
+// the crafting_success_rate modifier keeps meaning what it always has.
+func (c tierChances) roll(r float64) domain.CraftTier {
+	switch {
+	case r < c.masterwork:
+		return domain.CraftTierMasterwork
+	case r < c.masterwork+c.legendary:
+		return domain.CraftTierLegendary
+	case r < c.masterwork+c.legendary+c.fine:
+		return domain.CraftTierFine
+	default:
+		return domain.CraftTierStandard
+	}
+}
+
+// expectedOutput is the average number of items one craft produces
+func (c tierChances) expectedOutput() float64 {
+	return 1 + c.masterwork*(MasterworkMultiplier-1) + c.legendary*(LegendaryMultiplier-1)
+}
+
+// maxOutput is the most items one craft can produce
+func (c tierChances) maxOutput() int {
+	switch {
+	case c.legendary > 0:
+		return LegendaryMultiplier
+	case c.masterwork > 0:
+		return MasterworkMultiplier
+	default:
+		return 1
+	}
+}
+
+// tierChancesFor returns the tier chances for a user crafting a recipe after
+// the crafting_success_rate modifier, their Blacksmith level, the tools they
+// hold and their mastery of the recipe
+func (s *service) tierChancesFor(ctx context.Context, userID string, recipe *domain.Recipe, inventory *domain.Inventory) tierChances {
+	boost := 1 + s.jobLevelBoost(ctx, userID) + s.toolBoost(ctx, inventory)
+	return tierChances{
+		fine:       s.tiers.FineChance * boost,
+		masterwork: s.masterworkChance(ctx)*boost + s.masteryBonus(ctx, userID, recipe),
+		legendary:  s.tiers.LegendaryChance * boost,
+	}
+}
+
+// jobLevelBoost is the tier chance boost from the user's Blacksmith level
+func (s *service) jobLevelBoost(ctx context.Context, userID string) float64 {
+	if s.tiers.JobLevelBonus == 0 || s.jobService == nil {
+		return 0
+	}
+	level, err := s.jobService.GetJobLevel(ctx, userID, domain.JobKeyBlacksmith)
+	if err != nil {
+		logger.FromContext(ctx).Warn("Failed to get Blacksmith level for craft tiers, ignoring job bonus", "error", err, "user_id", userID)
+		return 0
+	}
+	return float64(level) * s.tiers.JobLevelBonus
+}
+
+// toolBoost is the tier chance boost from the tools in the user's inventory.
+// Tools are never consumed; holding one is enough.
+func (s *service) toolBoost(ctx context.Context, inventory *domain.Inventory) float64 {
+	boost := 0.0
+	for name, bonus := range s.tiers.ToolBonuses {
+		item, err := s.repo.GetItemByName(ctx, name)
+		if err != nil || item == nil {
+			logger.FromContext(ctx).Warn("Crafting tool not found, ignoring its bonus", "item", name, "error", err)
+			continue
+		}
+		if utils.GetTotalQuantity(inventory, item.ID) > 0 {
+			boost += bonus
+		}
+	}
+	return boost
+}
@@ -22,23 +22,8 @@ func (s *service) UpgradeItem(ctx context.Context, platform, platformID, usernam
 	}
 
 	// 1b. Check job level requirements (if any)
-	if recipe.RequiredJobLevel > 0 {
-		if s.jobService != nil {
-			// Get user's Blacksmith level
-			currentLevel, err := s.jobService.GetJobLevel(ctx, user.ID, domain.JobKeyBlacksmith)
-			if err != nil {
-				log.Error("Failed to check job level", "error", err, "userID", user.ID)
-				// Fail safe: if we can't check level, don't allow crafting high-tier items
-				return nil, fmt.Errorf("failed to verify job level requirements")
-			}
-
-			if currentLevel < recipe.RequiredJobLevel {
-				return nil, fmt.Errorf("requires Blacksmith Level %d (you are Level %d)", recipe.RequiredJobLevel, currentLevel)
-			}
-		} else {
-			// Should not happen in production if initialized correctly
-			log.Warn("Job service not initialized in crafting service, skipping level check")
-		}
+	if err := s.checkJobLevel(ctx, user.ID, recipe); err != nil {
+		return nil, err
 	}
 
 	// 2. Execute transaction
@@ -52,13 +37,39 @@ func (s *service) UpgradeItem(ctx context.Context, platform, platformID, usernam
 	if recipe != nil && recipe.RecipeKey != "" {
 		recipeKey = recipe.RecipeKey
 	}
-	evt := NewItemUpgradedEvent(user.ID, itemName, actualQuantity, recipeKey, result.IsMasterwork, result.BonusQuantity)
+	result.QuantityRequested = quantity
+	evt := NewItemUpgradedEvent(user.ID, itemName, actualQuantity, recipeKey, result.IsMasterwork, result.BonusQuantity, result.Tiers)
 	s.eventPublisher.PublishWithRetry(ctx, evt)
 
-	log.Info("Items upgraded", "username", username, "item", itemName, "quantity", result.Quantity, "masterwork", result.IsMasterwork)
+	log.Info("Items upgraded", "username", username, "item", itemName, "quantity", result.Quantity, "requested", quantity, "processed", actualQuantity, "masterwork", result.IsMasterwork)
 	return result, nil
 }
 
+// checkJobLevel rejects recipes above the user's Blacksmith level
+func (s *service) checkJobLevel(ctx context.Context, userID string, recipe *domain.Recipe) error {
+	if recipe.RequiredJobLevel <= 0 {
+		return nil
+	}
+	if s.jobService == nil {
+		// Should not happen in production if initialized correctly
+		logger.FromContext(ctx).Warn("Job service not initialized in crafting service, skipping level check")
+		return nil
+	}
+
+	// Get user's Blacksmith level
+	currentLevel, err := s.jobService.GetJobLevel(ctx, userID, domain.JobKeyBlacksmith)
+	if err != nil {
+		logger.FromContext(ctx).Error("Failed to check job level", "error", err, "userID", userID)
+		// Fail safe: if we can't check level, don't allow crafting high-tier items
+		return fmt.Errorf("failed to verify job level requirements")
+	}
+
+	if currentLevel < recipe.RequiredJobLevel {
+		return fmt.Errorf("requires Blacksmith Level %d (you are Level %d)", recipe.RequiredJobLevel, currentLevel)
+	}
+	return nil
+}
+
 func (s *service) validateUpgradeInput(ctx context.Context, platform, platformID, itemName string, quantity int) (*domain.User, *domain.Item, *domain.Recipe, string, error) {
 	if err := s.validateQuantity(quantity); err != nil {
 		return nil, nil, nil, "", err
@@ -92,6 +103,10 @@ func (s *service) validateUpgradeInput(ctx context.Context, platform, platformID
 		return nil, nil, nil, "", fmt.Errorf("no recipe found for '%s' | %w", itemName, domain.ErrRecipeNotFound)
 	}
 
+	if err := s.verifyRecipeEvent(ctx, recipe, itemName); err != nil {
+		return nil, nil, nil, "", err
+	}
+
 	if err := s.verifyRecipeUnlock(ctx, user.ID, recipe, itemName); err != nil {
 		return nil, nil, nil, "", err
 	}
@@ -148,6 +163,26 @@ func (s *service) verifyRecipeUnlock(ctx context.Context, userID string, recipe
 	return nil
 }
 
+// verifyRecipeEvent rejects event recipes while their theme is not running
+func (s *service) verifyRecipeEvent(ctx context.Context, recipe *domain.Recipe, itemName string) error {
+	if recipe.EventTheme == "" {
+		return nil
+	}
+	if !s.isThemeActive(ctx, recipe.EventTheme) {
+		return fmt.Errorf("recipe for %s is only craftable during the %s event | %w", itemName, recipe.EventTheme, domain.ErrRecipeOffEvent)
+	}
+	return nil
+}
+
+// isThemeActive reports whether an event theme is running, falling back to
+// the dated naming theme without an event theme source
+func (s *service) isThemeActive(ctx context.Context, theme string) bool {
+	if s.themes != nil {
+		return s.themes.IsThemeActive(ctx, theme)
+	}
+	return s.namingResolver != nil && s.namingResolver.GetActiveTheme() == theme
+}
+
 func (s *service) executeUpgradeTx(ctx context.Context, userID string, itemID int, recipe *domain.Recipe, requestedQuantity int, resolvedName string) (*Result, int, error) {
 	tx, err := s.repo.BeginTx(ctx)
 	if err != nil {
@@ -170,10 +205,20 @@ func (s *service) executeUpgradeTx(ctx context.Context, userID string, itemID in
 		return nil, 0, err
 	}
 
-	outputQuality := utils.CalculateAverageQuality(consumedMaterials)
-	result := s.calculateUpgradeOutput(ctx, userID, resolvedName, actualQuantity)
+	materialQuality := utils.CalculateAverageQuality(consumedMaterials)
+	chances := s.tierChancesFor(ctx, userID, recipe, inventory)
+	result, tierCounts := s.calculateUpgradeOutput(ctx, userID, resolvedName, actualQuantity, chances)
 
-	addItemToInventory(inventory, itemID, result.Quantity, outputQuality)
+	// Each tier lands in its own stack, raised above the materials' quality
+	before := utils.BuildSlotMap(inventory)
+	for _, tier := range craftTierOrder {
+		if count := tierCounts[tier]; count > 0 {
+			addItemToInventory(inventory, itemID, count*tierOutputMultipliers[tier], utils.ShiftQuality(materialQuality, tierQualitySteps[tier]))
+		}
+	}
+	if err := s.settleOverflow(ctx, before, inventory); err != nil {
+		return nil, 0, err
+	}
 
 	if err := tx.UpdateInventory(ctx, userID, *inventory); err != nil {
 		return nil, 0, fmt.Errorf("failed to update inventory: %w", err)
@@ -188,48 +233,67 @@ func (s *service) executeUpgradeTx(ctx context.Context, userID string, itemID in
 
 // getAndValidateRecipe is now integrated into validateUpgradeInput to avoid duplicate DB calls
 
-func (s *service) calculateUpgradeOutput(ctx context.Context, userID string, internalName string, actualQuantity int) *Result {
+// calculateUpgradeOutput rolls a tier for each craft. It returns the result
+// and how many crafts rolled each tier.
+func (s *service) calculateUpgradeOutput(ctx context.Context, userID string, internalName string, actualQuantity int, chances tierChances) (*Result, map[domain.CraftTier]int) {
 	log := logger.FromContext(ctx)
 
+	tierCounts := make(map[domain.CraftTier]int, len(craftTierOrder))
 	outputQuantity := 0
-	masterworkCount := 0
-
-	// Get modified masterwork chance (base 0.10 = 10%)
-	masterworkChance := MasterworkChance
-	if s.progressionSvc != nil {
-		if modifiedChance, err := s.progressionSvc.GetModifiedValue(ctx, "", "crafting_success_rate", MasterworkChance); err == nil {
-			masterworkChance = modifiedChance
-		} else {
-			log.Warn("Failed to apply crafting_success_rate modifier, using base chance", "error", err)
-		}
+	for i := 0; i < actualQuantity; i++ {
+		tier := chances.roll(s.rnd())
+		tierCounts[tier]++
+		outputQuantity += tierOutputMultipliers[tier]
 	}
 
-	for i := 0; i < actualQuantity; i++ {
-		if s.rnd() < masterworkChance {
-			masterworkCount++
-			outputQuantity += MasterworkMultiplier
-		} else {
-			outputQuantity += 1
+	var tiers map[domain.CraftTier]int
+	for _, tier := range craftTierOrder[1:] {
+		if tierCounts[tier] > 0 {
+			if tiers == nil {
+				tiers = make(map[domain.CraftTier]int)
+			}
+			tiers[tier] = tierCounts[tier]
 		}
 	}
 
+	masterworkCount := tierCounts[domain.CraftTierMasterwork] + tierCounts[domain.CraftTierLegendary]
 	masterworkTriggered := masterworkCount > 0
 	if masterworkTriggered {
-		log.Info("Masterwork craft triggered!", "user_id", userID, "item", internalName, "count", masterworkCount, "bonus", outputQuantity-actualQuantity)
+		log.Info("Masterwork craft triggered!", "user_id", userID, "item", internalName, "count", masterworkCount, "legendary", tierCounts[domain.CraftTierLegendary], "bonus", outputQuantity-actualQuantity)
+	}
+
+	return &Result{
+		ItemName:          s.displayName(internalName),
+		Quantity:          outputQuantity,
+		IsMasterwork:      masterworkTriggered,
+		BonusQuantity:     outputQuantity - actualQuantity,
+		QuantityRequested: actualQuantity,
+		QuantityProcessed: actualQuantity,
+		Tiers:             tiers,
+	}, tierCounts
+}
+
+// masterworkChance returns the masterwork chance after progression modifiers
+// (base 0.10 = 10%)
+func (s *service) masterworkChance(ctx context.Context) float64 {
+	base := s.tiers.MasterworkChance
+	if s.progressionSvc == nil {
+		return base
 	}
+	modifiedChance, err := s.progressionSvc.GetModifiedValue(ctx, "", "crafting_success_rate", base)
+	if err != nil {
+		logger.FromContext(ctx).Warn("Failed to apply crafting_success_rate modifier, using base chance", "error", err)
+		return base
+	}
+	return modifiedChance
+}
 
-	// Resolve internal name to public name for user feedback
-	displayName := internalName
+// displayName resolves an internal name to its public name for user feedback
+func (s *service) displayName(internalName string) string {
 	if s.namingResolver != nil {
 		if publicName, ok := s.namingResolver.ResolveInternalName(internalName); ok {
-			displayName = publicName
+			return publicName
 		}
 	}
-
-	return &Result{
-		ItemName:      displayName,
-		Quantity:      outputQuantity,
-		IsMasterwork:  masterworkTriggered,
-		BonusQuantity: outputQuantity - actualQuantity,
-	}
+	return internalName
 }
@@ -18,4 +18,6 @@ const (
 // Log Messages
 const (
 	LogMsgSuccessfullyConnectedToDatabase = "Successfully connected to the database"
+	LogMsgReplicaConnected                = "Read replica connected; read-only queries will use it"
+	LogMsgReplicaUnavailable              = "Read replica unavailable, serving reads from the primary"
 )
@@ -43,3 +43,34 @@ func NewPool(connString string, maxConns int, maxIdle, maxLife time.Duration) (*
 	slog.Default().Info(LogMsgSuccessfullyConnectedToDatabase)
 	return pool, nil
 }
+
+// NewReplicaPool connects to a read replica with the same pool settings as the
+// primary. It returns nil when no replica is configured or it cannot be
+// reached, in which case repositories serve reads from the primary.
+func NewReplicaPool(connString string, maxConns int, maxIdle, maxLife time.Duration) *pgxpool.Pool {
+	if connString == "" {
+		return nil
+	}
+
+	pool, err := NewPool(connString, maxConns, maxIdle, maxLife)
+	if err != nil {
+		slog.Default().Warn(LogMsgReplicaUnavailable, "error", err)
+		return nil
+	}
+	slog.Default().Info(LogMsgReplicaConnected)
+	return pool
+}
+
+type primaryReadsKey struct{}
+
+// WithPrimaryReads marks the context so that replica-aware repositories read
+// from the primary. Use it when a read feeds a write, since the replica may lag.
+func WithPrimaryReads(ctx context.Context) context.Context {
+	return context.WithValue(ctx, primaryReadsKey{}, true)
+}
+
+// PrimaryReadsRequired reports whether the context was marked by WithPrimaryReads
+func PrimaryReadsRequired(ctx context.Context) bool {
+	required, _ := ctx.Value(primaryReadsKey{}).(bool)
+	return required
+}
@@ -23,7 +23,7 @@ func (q *Queries) ClearDisassembleOutputs(ctx context.Context, recipeID int32) e
 
 const getAllCraftingRecipes = `-- name: GetAllCraftingRecipes :many
 
-SELECT recipe_id, recipe_key, target_item_id, base_cost, created_at, required_job_level, is_auto_unlock
+SELECT recipe_id, recipe_key, target_item_id, base_cost, created_at, required_job_level, is_auto_unlock, event_theme
 FROM crafting_recipes
 ORDER BY recipe_id
 `
@@ -36,6 +36,7 @@ type GetAllCraftingRecipesRow struct {
 	CreatedAt        pgtype.Timestamp `json:"created_at"`
 	RequiredJobLevel int32            `json:"required_job_level"`
 	IsAutoUnlock     bool             `json:"is_auto_unlock"`
+	EventTheme       string           `json:"event_theme"`
 }
 
 // Crafting Recipe Repository Queries
@@ -56,6 +57,7 @@ func (q *Queries) GetAllCraftingRecipes(ctx context.Context) ([]GetAllCraftingRe
 			&i.CreatedAt,
 			&i.RequiredJobLevel,
 			&i.IsAutoUnlock,
+			&i.EventTheme,
 		); err != nil {
 			return nil, err
 		}
@@ -108,7 +110,7 @@ func (q *Queries) GetAllDisassembleRecipes(ctx context.Context) ([]GetAllDisasse
 }
 
 const getCraftingRecipeByKey = `-- name: GetCraftingRecipeByKey :one
-SELECT recipe_id, recipe_key, target_item_id, base_cost, created_at, required_job_level, is_auto_unlock
+SELECT recipe_id, recipe_key, target_item_id, base_cost, created_at, required_job_level, is_auto_unlock, event_theme
 FROM crafting_recipes
 WHERE recipe_key = $1
 `
@@ -121,6 +123,7 @@ type GetCraftingRecipeByKeyRow struct {
 	CreatedAt        pgtype.Timestamp `json:"created_at"`
 	RequiredJobLevel int32            `json:"required_job_level"`
 	IsAutoUnlock     bool             `json:"is_auto_unlock"`
+	EventTheme       string           `json:"event_theme"`
 }
 
 func (q *Queries) GetCraftingRecipeByKey(ctx context.Context, recipeKey string) (GetCraftingRecipeByKeyRow, error) {
@@ -134,6 +137,7 @@ func (q *Queries) GetCraftingRecipeByKey(ctx context.Context, recipeKey string)
 		&i.CreatedAt,
 		&i.RequiredJobLevel,
 		&i.IsAutoUnlock,
+		&i.EventTheme,
 	)
 	return i, err
 }
@@ -166,8 +170,8 @@ func (q *Queries) GetDisassembleRecipeByKey(ctx context.Context, recipeKey strin
 }
 
 const insertCraftingRecipe = `-- name: InsertCraftingRecipe :one
-INSERT INTO crafting_recipes (recipe_key, target_item_id, base_cost, required_job_level, is_auto_unlock)
-VALUES ($1, $2, $3, $4, $5)
+INSERT INTO crafting_recipes (recipe_key, target_item_id, base_cost, required_job_level, is_auto_unlock, event_theme)
+VALUES ($1, $2, $3, $4, $5, $6)
 RETURNING recipe_id
 `
 
@@ -177,6 +181,7 @@ type InsertCraftingRecipeParams struct {
 	BaseCost         []byte `json:"base_cost"`
 	RequiredJobLevel int32  `json:"required_job_level"`
 	IsAutoUnlock     bool   `json:"is_auto_unlock"`
+	EventTheme       string `json:"event_theme"`
 }
 
 func (q *Queries) InsertCraftingRecipe(ctx context.Context, arg InsertCraftingRecipeParams) (int32, error) {
@@ -186,6 +191,7 @@ func (q *Queries) InsertCraftingRecipe(ctx context.Context, arg InsertCraftingRe
 		arg.BaseCost,
 		arg.RequiredJobLevel,
 		arg.IsAutoUnlock,
+		arg.EventTheme,
 	)
 	var recipe_id int32
 	err := row.Scan(&recipe_id)
@@ -229,8 +235,8 @@ func (q *Queries) InsertDisassembleRecipe(ctx context.Context, arg InsertDisasse
 
 const updateCraftingRecipe = `-- name: UpdateCraftingRecipe :exec
 UPDATE crafting_recipes
-SET recipe_key = $1, target_item_id = $2, base_cost = $3, required_job_level = $4, is_auto_unlock = $5
-WHERE recipe_id = $6
+SET recipe_key = $1, target_item_id = $2, base_cost = $3, required_job_level = $4, is_auto_unlock = $5, event_theme = $6
+WHERE recipe_id = $7
 `
 
 type UpdateCraftingRecipeParams struct {
@@ -239,6 +245,7 @@ type UpdateCraftingRecipeParams struct {
 	BaseCost         []byte `json:"base_cost"`
 	RequiredJobLevel int32  `json:"required_job_level"`
 	IsAutoUnlock     bool   `json:"is_auto_unlock"`
+	EventTheme       string `json:"event_theme"`
 	RecipeID         int32  `json:"recipe_id"`
 }
 
@@ -249,6 +256,7 @@ func (q *Queries) UpdateCraftingRecipe(ctx context.Context, arg UpdateCraftingRe
 		arg.BaseCost,
 		arg.RequiredJobLevel,
 		arg.IsAutoUnlock,
+		arg.EventTheme,
 		arg.RecipeID,
 	)
 	return err
@@ -14,8 +14,9 @@ import (
 )
 
 const createGamble = `-- name: CreateGamble :exec
-INSERT INTO gambles (id, initiator_id, state, created_at, join_deadline)
-VALUES ($1, $2, $3, $4, $5)
+INSERT INTO gambles (id, initiator_id, state, created_at, join_deadline, community_id, stream_session_id)
+VALUES ($1, $2, $3, $4, $5, $6,
+        (SELECT id FROM stream_sessions WHERE community_id = $6 AND ended_at IS NULL))
 `
 
 type CreateGambleParams struct {
@@ -24,6 +25,7 @@ type CreateGambleParams struct {
 	State        string             `json:"state"`
 	CreatedAt    pgtype.Timestamptz `json:"created_at"`
 	JoinDeadline pgtype.Timestamptz `json:"join_deadline"`
+	CommunityID  string             `json:"community_id"`
 }
 
 func (q *Queries) CreateGamble(ctx context.Context, arg CreateGambleParams) error {
@@ -33,19 +35,21 @@ func (q *Queries) CreateGamble(ctx context.Context, arg CreateGambleParams) erro
 		arg.State,
 		arg.CreatedAt,
 		arg.JoinDeadline,
+		arg.CommunityID,
 	)
 	return err
 }
 
 const getActiveGamble = `-- name: GetActiveGamble :one
-SELECT id, initiator_id, state, created_at, join_deadline
+SELECT id, initiator_id, state, created_at, join_deadline, community_id, stream_session_id
 FROM gambles
 WHERE state IN ('Joining', 'Opening')
+  AND community_id = $1
 LIMIT 1
 `
 
-func (q *Queries) GetActiveGamble(ctx context.Context) (Gamble, error) {
-	row := q.db.QueryRow(ctx, getActiveGamble)
+func (q *Queries) GetActiveGamble(ctx context.Context, communityID string) (Gamble, error) {
+	row := q.db.QueryRow(ctx, getActiveGamble, communityID)
 	var i Gamble
 	err := row.Scan(
 		&i.ID,
@@ -53,12 +57,14 @@ func (q *Queries) GetActiveGamble(ctx context.Context) (Gamble, error) {
 		&i.State,
 		&i.CreatedAt,
 		&i.JoinDeadline,
+		&i.CommunityID,
+		&i.StreamSessionID,
 	)
 	return i, err
 }
 
 const getGamble = `-- name: GetGamble :one
-SELECT id, initiator_id, state, created_at, join_deadline
+SELECT id, initiator_id, state, created_at, join_deadline, community_id, stream_session_id
 FROM gambles
 WHERE id = $1
 `
@@ -72,6 +78,8 @@ func (q *Queries) GetGamble(ctx context.Context, id uuid.UUID) (Gamble, error) {
 		&i.State,
 		&i.CreatedAt,
 		&i.JoinDeadline,
+		&i.CommunityID,
+		&i.StreamSessionID,
 	)
 	return i, err
 }
@@ -115,6 +123,92 @@ func (q *Queries) GetGambleParticipants(ctx context.Context, gambleID uuid.UUID)
 	return items, nil
 }
 
+const getGambleSideBets = `-- name: GetGambleSideBets :many
+SELECT b.gamble_id, b.user_id, u.username, b.backed_user_id, backed.username AS backed_username,
+       b.amount, b.payout, b.created_at
+FROM gamble_side_bets b
+JOIN users u ON b.user_id = u.user_id
+JOIN users backed ON b.backed_user_id = backed.user_id
+WHERE b.gamble_id = $1
+ORDER BY b.id
+`
+
+type GetGambleSideBetsRow struct {
+	GambleID       uuid.UUID          `json:"gamble_id"`
+	UserID         uuid.UUID          `json:"user_id"`
+	Username       string             `json:"username"`
+	BackedUserID   uuid.UUID          `json:"backed_user_id"`
+	BackedUsername string             `json:"backed_username"`
+	Amount         int32              `json:"amount"`
+	Payout         pgtype.Int4        `json:"payout"`
+	CreatedAt      pgtype.Timestamptz `json:"created_at"`
+}
+
+func (q *Queries) GetGambleSideBets(ctx context.Context, gambleID uuid.UUID) ([]GetGambleSideBetsRow, error) {
+	rows, err := q.db.Query(ctx, getGambleSideBets, gambleID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetGambleSideBetsRow
+	for rows.Next() {
+		var i GetGambleSideBetsRow
+		if err := rows.Scan(
+			&i.GambleID,
+			&i.UserID,
+			&i.Username,
+			&i.BackedUserID,
+			&i.BackedUsername,
+			&i.Amount,
+			&i.Payout,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getStaleGambles = `-- name: GetStaleGambles :many
+SELECT id, initiator_id, state, created_at, join_deadline, community_id, stream_session_id
+FROM gambles
+WHERE state IN ('Created', 'Joining', 'Opening')
+  AND join_deadline < $1
+ORDER BY join_deadline
+`
+
+func (q *Queries) GetStaleGambles(ctx context.Context, joinDeadline pgtype.Timestamptz) ([]Gamble, error) {
+	rows, err := q.db.Query(ctx, getStaleGambles, joinDeadline)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Gamble
+	for rows.Next() {
+		var i Gamble
+		if err := rows.Scan(
+			&i.ID,
+			&i.InitiatorID,
+			&i.State,
+			&i.CreatedAt,
+			&i.JoinDeadline,
+			&i.CommunityID,
+			&i.StreamSessionID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const joinGamble = `-- name: JoinGamble :exec
 INSERT INTO gamble_participants (gamble_id, user_id, lootbox_bets)
 VALUES ($1, $2, $3)
@@ -131,6 +225,36 @@ func (q *Queries) JoinGamble(ctx context.Context, arg JoinGambleParams) error {
 	return err
 }
 
+const placeGambleSideBet = `-- name: PlaceGambleSideBet :execrows
+INSERT INTO gamble_side_bets (gamble_id, user_id, backed_user_id, amount)
+SELECT id, $2, $3, $4
+FROM gambles
+WHERE id = $1 AND state = 'Joining'
+FOR UPDATE
+`
+
+type PlaceGambleSideBetParams struct {
+	GambleID     uuid.UUID `json:"gamble_id"`
+	UserID       uuid.UUID `json:"user_id"`
+	BackedUserID uuid.UUID `json:"backed_user_id"`
+	Amount       int32     `json:"amount"`
+}
+
+// Locks the gamble so the bet is either seen by the execution that claims it
+// or refused because the gamble stopped accepting bets
+func (q *Queries) PlaceGambleSideBet(ctx context.Context, arg PlaceGambleSideBetParams) (int64, error) {
+	result, err := q.db.Exec(ctx, placeGambleSideBet,
+		arg.GambleID,
+		arg.UserID,
+		arg.BackedUserID,
+		arg.Amount,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
 const saveOpenedItem = `-- name: SaveOpenedItem :exec
 INSERT INTO gamble_opened_items (gamble_id, user_id, item_id, quantity, value)
 VALUES ($1, $2, $3, $4, $5)
@@ -155,6 +279,23 @@ func (q *Queries) SaveOpenedItem(ctx context.Context, arg SaveOpenedItemParams)
 	return err
 }
 
+const settleGambleSideBet = `-- name: SettleGambleSideBet :exec
+UPDATE gamble_side_bets
+SET payout = $1
+WHERE gamble_id = $2 AND user_id = $3
+`
+
+type SettleGambleSideBetParams struct {
+	Payout   pgtype.Int4 `json:"payout"`
+	GambleID uuid.UUID   `json:"gamble_id"`
+	UserID   uuid.UUID   `json:"user_id"`
+}
+
+func (q *Queries) SettleGambleSideBet(ctx context.Context, arg SettleGambleSideBetParams) error {
+	_, err := q.db.Exec(ctx, settleGambleSideBet, arg.Payout, arg.GambleID, arg.UserID)
+	return err
+}
+
 const updateGambleState = `-- name: UpdateGambleState :exec
 UPDATE gambles 
 SET state = $1 
@@ -13,6 +13,30 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+const clearActiveJob = `-- name: ClearActiveJob :exec
+UPDATE user_jobs
+SET is_active = FALSE
+WHERE user_id = $1 AND is_active
+`
+
+func (q *Queries) ClearActiveJob(ctx context.Context, userID uuid.UUID) error {
+	_, err := q.db.Exec(ctx, clearActiveJob, userID)
+	return err
+}
+
+const getActiveXPBoost = `-- name: GetActiveXPBoost :one
+SELECT multiplier
+FROM job_xp_boosts
+WHERE user_id = $1 AND expires_at > NOW()
+`
+
+func (q *Queries) GetActiveXPBoost(ctx context.Context, userID uuid.UUID) (float64, error) {
+	row := q.db.QueryRow(ctx, getActiveXPBoost, userID)
+	var multiplier float64
+	err := row.Scan(&multiplier)
+	return multiplier, err
+}
+
 const getAllJobs = `-- name: GetAllJobs :many
 SELECT id, job_key, display_name, description, associated_features, created_at
 FROM jobs
@@ -84,7 +108,7 @@ func (q *Queries) GetLastDailyResetTime(ctx context.Context) (GetLastDailyResetT
 }
 
 const getUserJob = `-- name: GetUserJob :one
-SELECT user_id, job_id, current_xp, current_level, xp_gained_today, last_xp_gain
+SELECT user_id, job_id, current_xp, current_level, xp_gained_today, last_xp_gain, prestige, is_active
 FROM user_jobs
 WHERE user_id = $1 AND job_id = $2
 `
@@ -104,12 +128,14 @@ func (q *Queries) GetUserJob(ctx context.Context, arg GetUserJobParams) (UserJob
 		&i.CurrentLevel,
 		&i.XpGainedToday,
 		&i.LastXpGain,
+		&i.Prestige,
+		&i.IsActive,
 	)
 	return i, err
 }
 
 const getUserJobs = `-- name: GetUserJobs :many
-SELECT user_id, job_id, current_xp, current_level, xp_gained_today, last_xp_gain
+SELECT user_id, job_id, current_xp, current_level, xp_gained_today, last_xp_gain, prestige, is_active
 FROM user_jobs
 WHERE user_id = $1
 ORDER BY current_level DESC, current_xp DESC
@@ -131,6 +157,8 @@ func (q *Queries) GetUserJobs(ctx context.Context, userID uuid.UUID) ([]UserJob,
 			&i.CurrentLevel,
 			&i.XpGainedToday,
 			&i.LastXpGain,
+			&i.Prestige,
+			&i.IsActive,
 		); err != nil {
 			return nil, err
 		}
@@ -143,7 +171,7 @@ func (q *Queries) GetUserJobs(ctx context.Context, userID uuid.UUID) ([]UserJob,
 }
 
 const getUserJobsByPlatform = `-- name: GetUserJobsByPlatform :many
-SELECT uj.user_id, uj.job_id, uj.current_xp, uj.current_level, uj.xp_gained_today, uj.last_xp_gain
+SELECT uj.user_id, uj.job_id, uj.current_xp, uj.current_level, uj.xp_gained_today, uj.last_xp_gain, uj.prestige, uj.is_active
 FROM user_jobs uj
 JOIN user_platform_links upl ON uj.user_id = upl.user_id
 JOIN platforms p ON upl.platform_id = p.platform_id
@@ -172,6 +200,8 @@ func (q *Queries) GetUserJobsByPlatform(ctx context.Context, arg GetUserJobsByPl
 			&i.CurrentLevel,
 			&i.XpGainedToday,
 			&i.LastXpGain,
+			&i.Prestige,
+			&i.IsActive,
 		); err != nil {
 			return nil, err
 		}
@@ -183,6 +213,51 @@ func (q *Queries) GetUserJobsByPlatform(ctx context.Context, arg GetUserJobsByPl
 	return items, nil
 }
 
+const grantXPBoost = `-- name: GrantXPBoost :exec
+INSERT INTO job_xp_boosts (user_id, multiplier, expires_at)
+VALUES ($1, $2, NOW() + ($3::bigint * INTERVAL '1 millisecond'))
+ON CONFLICT (user_id) DO UPDATE
+SET multiplier = CASE WHEN job_xp_boosts.expires_at > NOW()
+                      THEN GREATEST(job_xp_boosts.multiplier, EXCLUDED.multiplier)
+                      ELSE EXCLUDED.multiplier END,
+    expires_at = GREATEST(job_xp_boosts.expires_at, NOW()) + ($3::bigint * INTERVAL '1 millisecond')
+`
+
+type GrantXPBoostParams struct {
+	UserID     uuid.UUID `json:"user_id"`
+	Multiplier float64   `json:"multiplier"`
+	DurationMs int64     `json:"duration_ms"`
+}
+
+// Grants a boost. While one is active the higher multiplier is kept and the
+// duration is added to the current expiry; an expired boost is replaced.
+func (q *Queries) GrantXPBoost(ctx context.Context, arg GrantXPBoostParams) error {
+	_, err := q.db.Exec(ctx, grantXPBoost, arg.UserID, arg.Multiplier, arg.DurationMs)
+	return err
+}
+
+const prestigeUserJob = `-- name: PrestigeUserJob :one
+UPDATE user_jobs
+SET current_xp = 0, current_level = 0, prestige = prestige + 1
+WHERE user_id = $1 AND job_id = $2 AND current_level >= $3
+RETURNING prestige
+`
+
+type PrestigeUserJobParams struct {
+	UserID   uuid.UUID `json:"user_id"`
+	JobID    int32     `json:"job_id"`
+	MinLevel int32     `json:"min_level"`
+}
+
+// Resets a job at or above the level cap and counts the prestige. No row is
+// returned when the job is below min_level.
+func (q *Queries) PrestigeUserJob(ctx context.Context, arg PrestigeUserJobParams) (int32, error) {
+	row := q.db.QueryRow(ctx, prestigeUserJob, arg.UserID, arg.JobID, arg.MinLevel)
+	var prestige int32
+	err := row.Scan(&prestige)
+	return prestige, err
+}
+
 const resetDailyJobXP = `-- name: ResetDailyJobXP :execresult
 UPDATE user_jobs
 SET xp_gained_today = 0
@@ -192,6 +267,23 @@ func (q *Queries) ResetDailyJobXP(ctx context.Context) (pgconn.CommandTag, error
 	return q.db.Exec(ctx, resetDailyJobXP)
 }
 
+const setActiveJob = `-- name: SetActiveJob :exec
+INSERT INTO user_jobs (user_id, job_id, is_active)
+VALUES ($1, $2, TRUE)
+ON CONFLICT (user_id, job_id)
+DO UPDATE SET is_active = TRUE
+`
+
+type SetActiveJobParams struct {
+	UserID uuid.UUID `json:"user_id"`
+	JobID  int32     `json:"job_id"`
+}
+
+func (q *Queries) SetActiveJob(ctx context.Context, arg SetActiveJobParams) error {
+	_, err := q.db.Exec(ctx, setActiveJob, arg.UserID, arg.JobID)
+	return err
+}
+
 const updateDailyResetTime = `-- name: UpdateDailyResetTime :exec
 UPDATE daily_reset_state
 SET last_reset_time = $1, records_affected = $2
@@ -9,6 +9,45 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+type AnnouncementRoute struct {
+	ID          int64              `json:"id"`
+	EventType   string             `json:"event_type"`
+	Destination string             `json:"destination"`
+	Target      string             `json:"target"`
+	Template    string             `json:"template"`
+	Enabled     bool               `json:"enabled"`
+	CreatedBy   string             `json:"created_by"`
+	CreatedAt   pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt   pgtype.Timestamptz `json:"updated_at"`
+}
+
+type ApiToken struct {
+	ID          int64              `json:"id"`
+	GuildID     string             `json:"guild_id"`
+	Name        string             `json:"name"`
+	TokenHash   string             `json:"token_hash"`
+	TokenPrefix string             `json:"token_prefix"`
+	CreatedBy   string             `json:"created_by"`
+	CreatedAt   pgtype.Timestamptz `json:"created_at"`
+	LastUsedAt  pgtype.Timestamptz `json:"last_used_at"`
+	RevokedAt   pgtype.Timestamptz `json:"revoked_at"`
+}
+
+type BankAccount struct {
+	UserID         uuid.UUID          `json:"user_id"`
+	Balance        int64              `json:"balance"`
+	Goal           pgtype.Int8        `json:"goal"`
+	GoalSetAt      pgtype.Timestamptz `json:"goal_set_at"`
+	GoalsReached   int32              `json:"goals_reached"`
+	InterestEarned int64              `json:"interest_earned"`
+	LastInterestAt pgtype.Timestamptz `json:"last_interest_at"`
+	WithdrawnToday int64              `json:"withdrawn_today"`
+	WithdrawnOn    pgtype.Date        `json:"withdrawn_on"`
+	CreatedAt      pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt      pgtype.Timestamptz `json:"updated_at"`
+	GoalStart      pgtype.Int8        `json:"goal_start"`
+}
+
 type BonusConfig struct {
 	ID            int32          `json:"id"`
 	NodeKey       string         `json:"node_key"`
@@ -21,6 +60,70 @@ type BonusConfig struct {
 	MinValue      pgtype.Numeric `json:"min_value"`
 }
 
+type BossAttacker struct {
+	BossID       uuid.UUID          `json:"boss_id"`
+	UserID       uuid.UUID          `json:"user_id"`
+	Damage       int32              `json:"damage"`
+	Attacks      int32              `json:"attacks"`
+	RewardBoxes  int32              `json:"reward_boxes"`
+	LastAttackAt pgtype.Timestamptz `json:"last_attack_at"`
+}
+
+type BossFight struct {
+	ID            uuid.UUID          `json:"id"`
+	CommunityID   string             `json:"community_id"`
+	BossKey       string             `json:"boss_key"`
+	Name          string             `json:"name"`
+	MaxHp         int32              `json:"max_hp"`
+	Hp            int32              `json:"hp"`
+	RewardLootbox string             `json:"reward_lootbox"`
+	RewardBoxes   int32              `json:"reward_boxes"`
+	State         string             `json:"state"`
+	SpawnedBy     string             `json:"spawned_by"`
+	SpawnedAt     pgtype.Timestamptz `json:"spawned_at"`
+	ExpiresAt     pgtype.Timestamptz `json:"expires_at"`
+	EndedAt       pgtype.Timestamptz `json:"ended_at"`
+}
+
+type CelebrationGrant struct {
+	UserID    uuid.UUID          `json:"user_id"`
+	Kind      string             `json:"kind"`
+	Year      int32              `json:"year"`
+	GrantedAt pgtype.Timestamptz `json:"granted_at"`
+}
+
+type CelebrationGuild struct {
+	GuildID   string             `json:"guild_id"`
+	Enabled   bool               `json:"enabled"`
+	UpdatedAt pgtype.Timestamptz `json:"updated_at"`
+}
+
+type CommunityDonation struct {
+	ID          int64              `json:"id"`
+	UserID      uuid.UUID          `json:"user_id"`
+	ItemID      int32              `json:"item_id"`
+	Quantity    int32              `json:"quantity"`
+	Value       int32              `json:"value"`
+	Points      int32              `json:"points"`
+	CreatedAt   pgtype.Timestamptz `json:"created_at"`
+	CommunityID string             `json:"community_id"`
+}
+
+type CommunityEffect struct {
+	CommunityID string             `json:"community_id"`
+	EffectType  string             `json:"effect_type"`
+	Magnitude   float64            `json:"magnitude"`
+	Source      string             `json:"source"`
+	ExpiresAt   pgtype.Timestamptz `json:"expires_at"`
+	CreatedAt   pgtype.Timestamptz `json:"created_at"`
+}
+
+type CommunityPool struct {
+	Balance     int64              `json:"balance"`
+	UpdatedAt   pgtype.Timestamptz `json:"updated_at"`
+	CommunityID string             `json:"community_id"`
+}
+
 type CompostBin struct {
 	ID           uuid.UUID          `json:"id"`
 	UserID       uuid.UUID          `json:"user_id"`
@@ -53,6 +156,7 @@ type CraftingRecipe struct {
 	RecipeKey        string           `json:"recipe_key"`
 	RequiredJobLevel int32            `json:"required_job_level"`
 	IsAutoUnlock     bool             `json:"is_auto_unlock"`
+	EventTheme       string           `json:"event_theme"`
 }
 
 type DailyResetState struct {
@@ -61,6 +165,21 @@ type DailyResetState struct {
 	RecordsAffected int32              `json:"records_affected"`
 }
 
+type DigSite struct {
+	Depth          int32              `json:"depth"`
+	TotalDigs      int32              `json:"total_digs"`
+	MilestoneLevel int32              `json:"milestone_level"`
+	UpdatedAt      pgtype.Timestamptz `json:"updated_at"`
+	CommunityID    string             `json:"community_id"`
+}
+
+type DigSiteDigger struct {
+	UserID    uuid.UUID          `json:"user_id"`
+	Meters    int32              `json:"meters"`
+	Digs      int32              `json:"digs"`
+	LastDugAt pgtype.Timestamptz `json:"last_dug_at"`
+}
+
 type DisassembleOutput struct {
 	OutputID int32 `json:"output_id"`
 	RecipeID int32 `json:"recipe_id"`
@@ -115,6 +234,18 @@ type Event struct {
 	CreatedAt pgtype.Timestamptz `json:"created_at"`
 }
 
+type EventDeadLetter struct {
+	ID          int64              `json:"id"`
+	EventType   string             `json:"event_type"`
+	HandlerName string             `json:"handler_name"`
+	Event       []byte             `json:"event"`
+	Attempts    int32              `json:"attempts"`
+	LastError   string             `json:"last_error"`
+	Status      string             `json:"status"`
+	CreatedAt   pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt   pgtype.Timestamptz `json:"updated_at"`
+}
+
 type Expedition struct {
 	ID                 uuid.UUID          `json:"id"`
 	InitiatorID        uuid.UUID          `json:"initiator_id"`
@@ -155,12 +286,33 @@ type ExpeditionParticipant struct {
 	FinalItems   []byte             `json:"final_items"`
 }
 
+type FeatureFlag struct {
+	Key               string             `json:"key"`
+	Description       string             `json:"description"`
+	Enabled           bool               `json:"enabled"`
+	RolloutPercent    int32              `json:"rollout_percent"`
+	PlatformOverrides []byte             `json:"platform_overrides"`
+	UpdatedBy         string             `json:"updated_by"`
+	CreatedAt         pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt         pgtype.Timestamptz `json:"updated_at"`
+}
+
+type FishingCast struct {
+	UserID uuid.UUID          `json:"user_id"`
+	Tackle string             `json:"tackle"`
+	CastAt pgtype.Timestamptz `json:"cast_at"`
+	BiteAt pgtype.Timestamptz `json:"bite_at"`
+	ReelBy pgtype.Timestamptz `json:"reel_by"`
+}
+
 type Gamble struct {
-	ID           uuid.UUID          `json:"id"`
-	InitiatorID  uuid.UUID          `json:"initiator_id"`
-	State        string             `json:"state"`
-	CreatedAt    pgtype.Timestamptz `json:"created_at"`
-	JoinDeadline pgtype.Timestamptz `json:"join_deadline"`
+	ID              uuid.UUID          `json:"id"`
+	InitiatorID     uuid.UUID          `json:"initiator_id"`
+	State           string             `json:"state"`
+	CreatedAt       pgtype.Timestamptz `json:"created_at"`
+	JoinDeadline    pgtype.Timestamptz `json:"join_deadline"`
+	CommunityID     string             `json:"community_id"`
+	StreamSessionID pgtype.Int8        `json:"stream_session_id"`
 }
 
 type GambleOpenedItem struct {
@@ -177,6 +329,92 @@ type GambleParticipant struct {
 	LootboxBets []byte    `json:"lootbox_bets"`
 }
 
+type GambleSideBet struct {
+	ID           int64              `json:"id"`
+	GambleID     uuid.UUID          `json:"gamble_id"`
+	UserID       uuid.UUID          `json:"user_id"`
+	BackedUserID uuid.UUID          `json:"backed_user_id"`
+	Amount       int32              `json:"amount"`
+	Payout       pgtype.Int4        `json:"payout"`
+	CreatedAt    pgtype.Timestamptz `json:"created_at"`
+}
+
+type GardenPlot struct {
+	UserID    uuid.UUID          `json:"user_id"`
+	Slot      int32              `json:"slot"`
+	Seed      string             `json:"seed"`
+	Crop      string             `json:"crop"`
+	Quantity  int32              `json:"quantity"`
+	PlantedAt pgtype.Timestamptz `json:"planted_at"`
+	ReadyAt   pgtype.Timestamptz `json:"ready_at"`
+}
+
+type GameEvent struct {
+	ID                  int64              `json:"id"`
+	Name                string             `json:"name"`
+	Description         string             `json:"description"`
+	XpMultiplier        float64            `json:"xp_multiplier"`
+	DropRateMultiplier  float64            `json:"drop_rate_multiplier"`
+	ShopDiscountPercent int32              `json:"shop_discount_percent"`
+	StartsAt            pgtype.Timestamptz `json:"starts_at"`
+	EndsAt              pgtype.Timestamptz `json:"ends_at"`
+	CreatedBy           string             `json:"created_by"`
+	CreatedAt           pgtype.Timestamptz `json:"created_at"`
+	CommunityID         string             `json:"community_id"`
+	Theme               string             `json:"theme"`
+}
+
+type GameSnapshot struct {
+	ID            int64              `json:"id"`
+	Trigger       string             `json:"trigger"`
+	Note          string             `json:"note"`
+	CreatedBy     string             `json:"created_by"`
+	SchemaVersion int64              `json:"schema_version"`
+	RowCounts     []byte             `json:"row_counts"`
+	SizeBytes     int64              `json:"size_bytes"`
+	Data          []byte             `json:"data"`
+	CreatedAt     pgtype.Timestamptz `json:"created_at"`
+}
+
+type Gift struct {
+	ID                  int64              `json:"id"`
+	SenderID            uuid.UUID          `json:"sender_id"`
+	RecipientID         pgtype.UUID        `json:"recipient_id"`
+	ItemID              int32              `json:"item_id"`
+	QualityLevel        string             `json:"quality_level"`
+	Quantity            int32              `json:"quantity"`
+	Message             string             `json:"message"`
+	Platform            string             `json:"platform"`
+	RecipientPlatformID string             `json:"recipient_platform_id"`
+	ChannelID           string             `json:"channel_id"`
+	Status              string             `json:"status"`
+	DeliverAt           pgtype.Timestamptz `json:"deliver_at"`
+	CreatedAt           pgtype.Timestamptz `json:"created_at"`
+	ClosedAt            pgtype.Timestamptz `json:"closed_at"`
+}
+
+type GiveFlag struct {
+	ID         int64              `json:"id"`
+	GiverID    uuid.UUID          `json:"giver_id"`
+	ReceiverID uuid.UUID          `json:"receiver_id"`
+	ItemID     int32              `json:"item_id"`
+	Quantity   int32              `json:"quantity"`
+	Reason     string             `json:"reason"`
+	Status     string             `json:"status"`
+	ReviewedBy pgtype.Text        `json:"reviewed_by"`
+	ReviewedAt pgtype.Timestamptz `json:"reviewed_at"`
+	CreatedAt  pgtype.Timestamptz `json:"created_at"`
+}
+
+type GiveLog struct {
+	ID         int64              `json:"id"`
+	GiverID    uuid.UUID          `json:"giver_id"`
+	ReceiverID uuid.UUID          `json:"receiver_id"`
+	ItemID     int32              `json:"item_id"`
+	Quantity   int32              `json:"quantity"`
+	CreatedAt  pgtype.Timestamptz `json:"created_at"`
+}
+
 type HarvestState struct {
 	UserID          uuid.UUID          `json:"user_id"`
 	LastHarvestedAt pgtype.Timestamptz `json:"last_harvested_at"`
@@ -184,6 +422,16 @@ type HarvestState struct {
 	UpdatedAt       pgtype.Timestamptz `json:"updated_at"`
 }
 
+type InboxMessage struct {
+	ID        int64              `json:"id"`
+	UserID    uuid.UUID          `json:"user_id"`
+	Kind      string             `json:"kind"`
+	Title     string             `json:"title"`
+	Body      string             `json:"body"`
+	ReadAt    pgtype.Timestamptz `json:"read_at"`
+	CreatedAt pgtype.Timestamptz `json:"created_at"`
+}
+
 type Item struct {
 	ItemID          int32       `json:"item_id"`
 	InternalName    string      `json:"internal_name"`
@@ -195,6 +443,58 @@ type Item struct {
 	ContentType     []string    `json:"content_type"`
 }
 
+type ItemAlias struct {
+	InternalName   string             `json:"internal_name"`
+	DefaultAliases []string           `json:"default_aliases"`
+	ThemeAliases   []byte             `json:"theme_aliases"`
+	UpdatedBy      string             `json:"updated_by"`
+	CreatedAt      pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt      pgtype.Timestamptz `json:"updated_at"`
+}
+
+type ItemBalanceChange struct {
+	ID          int64              `json:"id"`
+	ItemName    string             `json:"item_name"`
+	Field       string             `json:"field"`
+	PoolName    string             `json:"pool_name"`
+	Value       int32              `json:"value"`
+	EffectiveAt pgtype.Timestamptz `json:"effective_at"`
+	Reason      string             `json:"reason"`
+	CreatedBy   string             `json:"created_by"`
+	CreatedAt   pgtype.Timestamptz `json:"created_at"`
+}
+
+type ItemLoan struct {
+	ID               int64              `json:"id"`
+	LenderID         uuid.UUID          `json:"lender_id"`
+	BorrowerID       pgtype.UUID        `json:"borrower_id"`
+	ItemID           int32              `json:"item_id"`
+	QualityLevel     string             `json:"quality_level"`
+	Quantity         int32              `json:"quantity"`
+	ReturnedQuantity int32              `json:"returned_quantity"`
+	Status           string             `json:"status"`
+	DueAt            pgtype.Timestamptz `json:"due_at"`
+	CreatedAt        pgtype.Timestamptz `json:"created_at"`
+	ClosedAt         pgtype.Timestamptz `json:"closed_at"`
+}
+
+type ItemMarketPressure struct {
+	ItemID      int32              `json:"item_id"`
+	Pressure    float64            `json:"pressure"`
+	UpdatedAt   pgtype.Timestamptz `json:"updated_at"`
+	CommunityID string             `json:"community_id"`
+}
+
+type ItemPriceHistory struct {
+	ID          int64              `json:"id"`
+	ItemID      int32              `json:"item_id"`
+	Multiplier  float64            `json:"multiplier"`
+	BuyPrice    int32              `json:"buy_price"`
+	SellPrice   int32              `json:"sell_price"`
+	RecordedAt  pgtype.Timestamptz `json:"recorded_at"`
+	CommunityID string             `json:"community_id"`
+}
+
 type ItemType struct {
 	ItemTypeID int32  `json:"item_type_id"`
 	TypeName   string `json:"type_name"`
@@ -205,6 +505,22 @@ type ItemTypeAssignment struct {
 	ItemTypeID int32 `json:"item_type_id"`
 }
 
+type Jackpot struct {
+	Balance     int64              `json:"balance"`
+	UpdatedAt   pgtype.Timestamptz `json:"updated_at"`
+	CommunityID string             `json:"community_id"`
+}
+
+type JackpotWin struct {
+	ID     int64     `json:"id"`
+	UserID uuid.UUID `json:"user_id"`
+	Amount int64     `json:"amount"`
+	// What triggered the win: a lootbox opening or a gamble
+	Source      string             `json:"source"`
+	WonAt       pgtype.Timestamptz `json:"won_at"`
+	CommunityID string             `json:"community_id"`
+}
+
 type Job struct {
 	ID                 int32              `json:"id"`
 	JobKey             string             `json:"job_key"`
@@ -221,6 +537,12 @@ type JobUnlockConfig struct {
 	RequiredLevel int32  `json:"required_level"`
 }
 
+type JobXpBoost struct {
+	UserID     uuid.UUID          `json:"user_id"`
+	Multiplier float64            `json:"multiplier"`
+	ExpiresAt  pgtype.Timestamptz `json:"expires_at"`
+}
+
 type LinkToken struct {
 	Token            string             `json:"token"`
 	SourcePlatform   string             `json:"source_platform"`
@@ -232,17 +554,85 @@ type LinkToken struct {
 	ExpiresAt        pgtype.Timestamptz `json:"expires_at"`
 }
 
+type LootboxPity struct {
+	UserID            uuid.UUID          `json:"user_id"`
+	LootboxName       string             `json:"lootbox_name"`
+	OpeningsSinceRare int32              `json:"openings_since_rare"`
+	UpdatedAt         pgtype.Timestamptz `json:"updated_at"`
+}
+
+type ModerationAction struct {
+	ID        int64              `json:"id"`
+	UserID    uuid.UUID          `json:"user_id"`
+	Action    string             `json:"action"`
+	Actor     string             `json:"actor"`
+	Reason    string             `json:"reason"`
+	CreatedAt pgtype.Timestamptz `json:"created_at"`
+}
+
 type Moderator struct {
 	ModeratorID uuid.UUID        `json:"moderator_id"`
 	CreatedAt   pgtype.Timestamp `json:"created_at"`
 	UpdatedAt   pgtype.Timestamp `json:"updated_at"`
 }
 
+type MonetizationEvent struct {
+	Source     string             `json:"source"`
+	EventID    string             `json:"event_id"`
+	Kind       string             `json:"kind"`
+	UserID     pgtype.UUID        `json:"user_id"`
+	Payload    []byte             `json:"payload"`
+	Result     []byte             `json:"result"`
+	ReceivedAt pgtype.Timestamptz `json:"received_at"`
+}
+
 type Platform struct {
 	PlatformID int32  `json:"platform_id"`
 	Name       string `json:"name"`
 }
 
+type PlayerShopListing struct {
+	ID           int64              `json:"id"`
+	SellerID     uuid.UUID          `json:"seller_id"`
+	ItemID       int32              `json:"item_id"`
+	QualityLevel string             `json:"quality_level"`
+	Quantity     int32              `json:"quantity"`
+	UnitPrice    int32              `json:"unit_price"`
+	Status       string             `json:"status"`
+	CreatedAt    pgtype.Timestamptz `json:"created_at"`
+	ClosedAt     pgtype.Timestamptz `json:"closed_at"`
+	ListingFee   int32              `json:"listing_fee"`
+	CommunityID  string             `json:"community_id"`
+}
+
+type ProgressionBulkAudit struct {
+	ID            int64              `json:"id"`
+	OperationID   int64              `json:"operation_id"`
+	BatchNumber   int32              `json:"batch_number"`
+	UserIds       []string           `json:"user_ids"`
+	AffectedUsers int32              `json:"affected_users"`
+	CreatedAt     pgtype.Timestamptz `json:"created_at"`
+}
+
+type ProgressionBulkOperation struct {
+	ID               int64              `json:"id"`
+	Action           string             `json:"action"`
+	ProgressionType  string             `json:"progression_type"`
+	ProgressionKey   string             `json:"progression_key"`
+	Actor            string             `json:"actor"`
+	Reason           string             `json:"reason"`
+	Status           string             `json:"status"`
+	TotalUsers       int32              `json:"total_users"`
+	ProcessedUsers   int32              `json:"processed_users"`
+	AffectedUsers    int32              `json:"affected_users"`
+	TotalBatches     int32              `json:"total_batches"`
+	CompletedBatches int32              `json:"completed_batches"`
+	Error            string             `json:"error"`
+	CreatedAt        pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt        pgtype.Timestamptz `json:"updated_at"`
+	CompletedAt      pgtype.Timestamptz `json:"completed_at"`
+}
+
 type ProgressionNode struct {
 	ID          int32            `json:"id"`
 	NodeKey     string           `json:"node_key"`
@@ -258,11 +648,14 @@ type ProgressionNode struct {
 	Category    string           `json:"category"`
 	// Dynamic prerequisites stored as JSON array: [{"type":"nodes_unlocked_below_tier","tier":2,"count":5}]
 	DynamicPrerequisites []byte `json:"dynamic_prerequisites"`
+	// Effects of unlocking the node as JSON: {"features":["feature_gamble"],"items":["money"],"config_deltas":[{"key":"search_daily_limit","delta":5}]}
+	Effects []byte `json:"effects"`
 }
 
 type ProgressionPrerequisite struct {
 	NodeID             int32 `json:"node_id"`
 	PrerequisiteNodeID int32 `json:"prerequisite_node_id"`
+	PrerequisiteGroup  int32 `json:"prerequisite_group"`
 }
 
 type ProgressionReset struct {
@@ -281,6 +674,7 @@ type ProgressionUnlock struct {
 	UnlockedAt      pgtype.Timestamp `json:"unlocked_at"`
 	UnlockedBy      pgtype.Text      `json:"unlocked_by"`
 	EngagementScore pgtype.Int4      `json:"engagement_score"`
+	CommunityID     string           `json:"community_id"`
 }
 
 type ProgressionUnlockProgress struct {
@@ -291,6 +685,7 @@ type ProgressionUnlockProgress struct {
 	StartedAt                pgtype.Timestamp `json:"started_at"`
 	UnlockedAt               pgtype.Timestamp `json:"unlocked_at"`
 	VotingSessionID          pgtype.Int4      `json:"voting_session_id"`
+	CommunityID              string           `json:"community_id"`
 }
 
 type ProgressionVoting struct {
@@ -321,6 +716,7 @@ type ProgressionVotingSession struct {
 	WinningOptionID pgtype.Int4      `json:"winning_option_id"`
 	Status          string           `json:"status"`
 	CreatedAt       pgtype.Timestamp `json:"created_at"`
+	CommunityID     string           `json:"community_id"`
 }
 
 type Quest struct {
@@ -366,6 +762,32 @@ type RecipeUnlock struct {
 	UnlockedAt pgtype.Timestamp `json:"unlocked_at"`
 }
 
+type Reminder struct {
+	ID         int64              `json:"id"`
+	UserID     uuid.UUID          `json:"user_id"`
+	Platform   string             `json:"platform"`
+	PlatformID string             `json:"platform_id"`
+	Kind       string             `json:"kind"`
+	Target     string             `json:"target"`
+	Message    string             `json:"message"`
+	ChannelID  string             `json:"channel_id"`
+	RemindAt   pgtype.Timestamptz `json:"remind_at"`
+	CreatedAt  pgtype.Timestamptz `json:"created_at"`
+}
+
+type ScheduledJob struct {
+	Name      string             `json:"name"`
+	NextRunAt pgtype.Timestamptz `json:"next_run_at"`
+	LastRunAt pgtype.Timestamptz `json:"last_run_at"`
+	UpdatedAt pgtype.Timestamptz `json:"updated_at"`
+}
+
+type SchedulerLease struct {
+	Name      string             `json:"name"`
+	Holder    string             `json:"holder"`
+	ExpiresAt pgtype.Timestamptz `json:"expires_at"`
+}
+
 type StatsAggregate struct {
 	AggregateID int32            `json:"aggregate_id"`
 	Period      string           `json:"period"`
@@ -377,11 +799,34 @@ type StatsAggregate struct {
 }
 
 type StatsEvent struct {
-	EventID   int64            `json:"event_id"`
-	UserID    pgtype.UUID      `json:"user_id"`
-	EventType string           `json:"event_type"`
-	EventData []byte           `json:"event_data"`
-	CreatedAt pgtype.Timestamp `json:"created_at"`
+	EventID         int64            `json:"event_id"`
+	UserID          pgtype.UUID      `json:"user_id"`
+	EventType       string           `json:"event_type"`
+	EventData       []byte           `json:"event_data"`
+	CreatedAt       pgtype.Timestamp `json:"created_at"`
+	StreamSessionID pgtype.Int8      `json:"stream_session_id"`
+}
+
+type StatsRollup struct {
+	Bucket      string           `json:"bucket"`
+	BucketStart pgtype.Timestamp `json:"bucket_start"`
+	UserID      uuid.UUID        `json:"user_id"`
+	EventType   string           `json:"event_type"`
+	EventCount  int32            `json:"event_count"`
+}
+
+type StatsRollupProgress struct {
+	Bucket        string           `json:"bucket"`
+	RolledThrough pgtype.Timestamp `json:"rolled_through"`
+}
+
+type StreamSession struct {
+	ID          int64              `json:"id"`
+	CommunityID string             `json:"community_id"`
+	Platform    string             `json:"platform"`
+	Title       string             `json:"title"`
+	StartedAt   pgtype.Timestamptz `json:"started_at"`
+	EndedAt     pgtype.Timestamptz `json:"ended_at"`
 }
 
 type SubscriptionHistory struct {
@@ -405,11 +850,34 @@ type SubscriptionTier struct {
 	CreatedAt   pgtype.Timestamptz `json:"created_at"`
 }
 
+type UndoEntry struct {
+	ID        int64              `json:"id"`
+	UserID    uuid.UUID          `json:"user_id"`
+	Action    string             `json:"action"`
+	Changes   []byte             `json:"changes"`
+	CreatedAt pgtype.Timestamptz `json:"created_at"`
+	ExpiresAt pgtype.Timestamptz `json:"expires_at"`
+}
+
 type User struct {
-	UserID    uuid.UUID        `json:"user_id"`
-	Username  string           `json:"username"`
-	CreatedAt pgtype.Timestamp `json:"created_at"`
-	UpdatedAt pgtype.Timestamp `json:"updated_at"`
+	UserID      uuid.UUID        `json:"user_id"`
+	Username    string           `json:"username"`
+	CreatedAt   pgtype.Timestamp `json:"created_at"`
+	UpdatedAt   pgtype.Timestamp `json:"updated_at"`
+	CommunityID string           `json:"community_id"`
+}
+
+type UserBirthday struct {
+	UserID     uuid.UUID          `json:"user_id"`
+	BirthMonth int16              `json:"birth_month"`
+	BirthDay   int16              `json:"birth_day"`
+	UpdatedAt  pgtype.Timestamptz `json:"updated_at"`
+}
+
+type UserContributionScore struct {
+	UserID    string             `json:"user_id"`
+	Score     float64            `json:"score"`
+	UpdatedAt pgtype.Timestamptz `json:"updated_at"`
 }
 
 type UserCooldown struct {
@@ -418,11 +886,34 @@ type UserCooldown struct {
 	LastUsedAt pgtype.Timestamptz `json:"last_used_at"`
 }
 
+type UserEffect struct {
+	UserID     uuid.UUID          `json:"user_id"`
+	EffectType string             `json:"effect_type"`
+	Magnitude  float64            `json:"magnitude"`
+	ExpiresAt  pgtype.Timestamptz `json:"expires_at"`
+	CreatedAt  pgtype.Timestamptz `json:"created_at"`
+}
+
+type UserFreeze struct {
+	UserID   uuid.UUID          `json:"user_id"`
+	FrozenBy string             `json:"frozen_by"`
+	Reason   string             `json:"reason"`
+	FrozenAt pgtype.Timestamptz `json:"frozen_at"`
+}
+
 type UserInventory struct {
 	UserID        uuid.UUID `json:"user_id"`
 	InventoryData []byte    `json:"inventory_data"`
 }
 
+type UserItemFlag struct {
+	UserID    uuid.UUID          `json:"user_id"`
+	ItemID    int32              `json:"item_id"`
+	Favorite  bool               `json:"favorite"`
+	Locked    bool               `json:"locked"`
+	UpdatedAt pgtype.Timestamptz `json:"updated_at"`
+}
+
 type UserJob struct {
 	UserID        uuid.UUID          `json:"user_id"`
 	JobID         int32              `json:"job_id"`
@@ -430,6 +921,8 @@ type UserJob struct {
 	CurrentLevel  int32              `json:"current_level"`
 	XpGainedToday pgtype.Int8        `json:"xp_gained_today"`
 	LastXpGain    pgtype.Timestamptz `json:"last_xp_gain"`
+	Prestige      int32              `json:"prestige"`
+	IsActive      bool               `json:"is_active"`
 }
 
 type UserPlatformLink struct {
@@ -437,6 +930,7 @@ type UserPlatformLink struct {
 	PlatformID       int32       `json:"platform_id"`
 	PlatformUserID   string      `json:"platform_user_id"`
 	PlatformUsername pgtype.Text `json:"platform_username"`
+	CommunityID      string      `json:"community_id"`
 }
 
 type UserProgression struct {
@@ -447,6 +941,24 @@ type UserProgression struct {
 	Metadata        []byte           `json:"metadata"`
 }
 
+type UserSearchProgress struct {
+	UserID         uuid.UUID          `json:"user_id"`
+	CurrentStreak  int32              `json:"current_streak"`
+	BestStreak     int32              `json:"best_streak"`
+	LastSearchDate pgtype.Date        `json:"last_search_date"`
+	TotalSearches  int32              `json:"total_searches"`
+	MasteryLevel   int32              `json:"mastery_level"`
+	UpdatedAt      pgtype.Timestamptz `json:"updated_at"`
+}
+
+type UserSetting struct {
+	UserID             uuid.UUID          `json:"user_id"`
+	LeaderboardPrivate bool               `json:"leaderboard_private"`
+	UpdatedAt          pgtype.Timestamptz `json:"updated_at"`
+	TargetingOptOut    bool               `json:"targeting_opt_out"`
+	NameTheme          string             `json:"name_theme"`
+}
+
 type UserSubscription struct {
 	UserID         uuid.UUID          `json:"user_id"`
 	Platform       string             `json:"platform"`
@@ -460,6 +972,14 @@ type UserSubscription struct {
 }
 
 // Stores active and historical trap placements
+type UserTimeout struct {
+	Platform  string             `json:"platform"`
+	Username  string             `json:"username"`
+	ExpiresAt pgtype.Timestamptz `json:"expires_at"`
+	Reason    string             `json:"reason"`
+	CreatedAt pgtype.Timestamptz `json:"created_at"`
+}
+
 type UserTrap struct {
 	ID             uuid.UUID          `json:"id"`
 	SetterID       uuid.UUID          `json:"setter_id"`
@@ -471,12 +991,58 @@ type UserTrap struct {
 }
 
 type UserVote struct {
-	UserID      string           `json:"user_id"`
-	NodeID      int32            `json:"node_id"`
-	TargetLevel int32            `json:"target_level"`
-	VotedAt     pgtype.Timestamp `json:"voted_at"`
-	SessionID   int32            `json:"session_id"`
-	OptionID    pgtype.Int4      `json:"option_id"`
+	UserID        string             `json:"user_id"`
+	NodeID        int32              `json:"node_id"`
+	TargetLevel   int32              `json:"target_level"`
+	VotedAt       pgtype.Timestamp   `json:"voted_at"`
+	SessionID     int32              `json:"session_id"`
+	OptionID      pgtype.Int4        `json:"option_id"`
+	SuspectReason pgtype.Text        `json:"suspect_reason"`
+	FlaggedAt     pgtype.Timestamptz `json:"flagged_at"`
+	ExcludedAt    pgtype.Timestamptz `json:"excluded_at"`
+	Weight        int32              `json:"weight"`
+	CastBy        pgtype.Text        `json:"cast_by"`
+}
+
+type VoteDelegation struct {
+	DelegatorID string             `json:"delegator_id"`
+	DelegateID  string             `json:"delegate_id"`
+	CreatedAt   pgtype.Timestamptz `json:"created_at"`
+}
+
+type VoteReviewAudit struct {
+	ID        int64              `json:"id"`
+	SessionID int32              `json:"session_id"`
+	UserID    string             `json:"user_id"`
+	OptionID  pgtype.Int4        `json:"option_id"`
+	Action    string             `json:"action"`
+	Actor     string             `json:"actor"`
+	Reason    string             `json:"reason"`
+	CreatedAt pgtype.Timestamptz `json:"created_at"`
+}
+
+type Webhook struct {
+	ID          int64              `json:"id"`
+	Url         string             `json:"url"`
+	Secret      string             `json:"secret"`
+	EventTypes  []string           `json:"event_types"`
+	Description string             `json:"description"`
+	CreatedBy   string             `json:"created_by"`
+	CreatedAt   pgtype.Timestamptz `json:"created_at"`
+}
+
+type WebhookDelivery struct {
+	ID             int64              `json:"id"`
+	WebhookID      int64              `json:"webhook_id"`
+	EventType      string             `json:"event_type"`
+	Body           []byte             `json:"body"`
+	Status         string             `json:"status"`
+	Attempts       int32              `json:"attempts"`
+	NextAttemptAt  pgtype.Timestamptz `json:"next_attempt_at"`
+	LastStatusCode pgtype.Int4        `json:"last_status_code"`
+	LastError      pgtype.Text        `json:"last_error"`
+	CreatedAt      pgtype.Timestamptz `json:"created_at"`
+	DeliveredAt    pgtype.Timestamptz `json:"delivered_at"`
 }
 
 type WeeklyQuestResetState struct {
@@ -487,3 +1053,18 @@ type WeeklyQuestResetState struct {
 	QuestsGenerated int32              `json:"quests_generated"`
 	ProgressReset   int32              `json:"progress_reset"`
 }
+
+type XpAward struct {
+	ID         int64              `json:"id"`
+	UserID     uuid.UUID          `json:"user_id"`
+	JobKey     string             `json:"job_key"`
+	Source     string             `json:"source"`
+	BaseXp     int32              `json:"base_xp"`
+	XpAwarded  int32              `json:"xp_awarded"`
+	Multiplier float64            `json:"multiplier"`
+	CappedBy   pgtype.Text        `json:"capped_by"`
+	OldLevel   int32              `json:"old_level"`
+	NewLevel   int32              `json:"new_level"`
+	Metadata   []byte             `json:"metadata"`
+	AwardedAt  pgtype.Timestamptz `json:"awarded_at"`
+}
@@ -117,10 +117,15 @@ SELECT bc.node_key, bc.source_type, bc.feature_key, bc.modifier_type, bc.base_va
        COALESCE(u.current_level, 0)::int as progression_level
 FROM bonus_config bc
 LEFT JOIN progression_nodes n ON bc.node_key = n.node_key AND bc.source_type = 'progression'
-LEFT JOIN progression_unlocks u ON u.node_id = n.id
+LEFT JOIN progression_unlocks u ON u.node_id = n.id AND u.community_id = $2
 WHERE bc.feature_key = $1
 `
 
+type GetBonusModifiersWithLevelParams struct {
+	FeatureKey  string `json:"feature_key"`
+	CommunityID string `json:"community_id"`
+}
+
 type GetBonusModifiersWithLevelRow struct {
 	NodeKey          string         `json:"node_key"`
 	SourceType       string         `json:"source_type"`
@@ -133,8 +138,8 @@ type GetBonusModifiersWithLevelRow struct {
 	ProgressionLevel int32          `json:"progression_level"`
 }
 
-func (q *Queries) GetBonusModifiersWithLevel(ctx context.Context, featureKey string) ([]GetBonusModifiersWithLevelRow, error) {
-	rows, err := q.db.Query(ctx, getBonusModifiersWithLevel, featureKey)
+func (q *Queries) GetBonusModifiersWithLevel(ctx context.Context, arg GetBonusModifiersWithLevelParams) ([]GetBonusModifiersWithLevelRow, error) {
+	rows, err := q.db.Query(ctx, getBonusModifiersWithLevel, arg.FeatureKey, arg.CommunityID)
 	if err != nil {
 		return nil, err
 	}
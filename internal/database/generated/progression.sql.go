@@ -44,29 +44,31 @@ func (q *Queries) AddVotingOption(ctx context.Context, arg AddVotingOptionParams
 }
 
 const clearAllUnlockProgress = `-- name: ClearAllUnlockProgress :exec
-DELETE FROM progression_unlock_progress
+DELETE FROM progression_unlock_progress WHERE community_id = $1
 `
 
-func (q *Queries) ClearAllUnlockProgress(ctx context.Context) error {
-	_, err := q.db.Exec(ctx, clearAllUnlockProgress)
+func (q *Queries) ClearAllUnlockProgress(ctx context.Context, communityID string) error {
+	_, err := q.db.Exec(ctx, clearAllUnlockProgress, communityID)
 	return err
 }
 
 const clearAllUserProgression = `-- name: ClearAllUserProgression :exec
 DELETE FROM user_progression
+WHERE user_id IN (SELECT user_id::text FROM users WHERE community_id = $1)
 `
 
-func (q *Queries) ClearAllUserProgression(ctx context.Context) error {
-	_, err := q.db.Exec(ctx, clearAllUserProgression)
+func (q *Queries) ClearAllUserProgression(ctx context.Context, communityID string) error {
+	_, err := q.db.Exec(ctx, clearAllUserProgression, communityID)
 	return err
 }
 
 const clearAllUserVotes = `-- name: ClearAllUserVotes :exec
 DELETE FROM user_votes
+WHERE user_id IN (SELECT user_id::text FROM users WHERE community_id = $1)
 `
 
-func (q *Queries) ClearAllUserVotes(ctx context.Context) error {
-	_, err := q.db.Exec(ctx, clearAllUserVotes)
+func (q *Queries) ClearAllUserVotes(ctx context.Context, communityID string) error {
+	_, err := q.db.Exec(ctx, clearAllUserVotes, communityID)
 	return err
 }
 
@@ -80,20 +82,23 @@ func (q *Queries) ClearAllVoting(ctx context.Context) error {
 }
 
 const clearAllVotingOptions = `-- name: ClearAllVotingOptions :exec
-DELETE FROM progression_voting_options
+DELETE FROM progression_voting_options o
+WHERE NOT EXISTS (SELECT 1 FROM progression_voting_sessions s WHERE s.id = o.session_id)
 `
 
+// Options cascade with their session; this only removes leftovers whose
+// session is gone.
 func (q *Queries) ClearAllVotingOptions(ctx context.Context) error {
 	_, err := q.db.Exec(ctx, clearAllVotingOptions)
 	return err
 }
 
 const clearAllVotingSessions = `-- name: ClearAllVotingSessions :exec
-DELETE FROM progression_voting_sessions
+DELETE FROM progression_voting_sessions WHERE community_id = $1
 `
 
-func (q *Queries) ClearAllVotingSessions(ctx context.Context) error {
-	_, err := q.db.Exec(ctx, clearAllVotingSessions)
+func (q *Queries) ClearAllVotingSessions(ctx context.Context, communityID string) error {
+	_, err := q.db.Exec(ctx, clearAllVotingSessions, communityID)
 	return err
 }
 
@@ -107,21 +112,27 @@ func (q *Queries) ClearNodePrerequisites(ctx context.Context, nodeID int32) erro
 }
 
 const clearUnlockProgressForNode = `-- name: ClearUnlockProgressForNode :exec
-DELETE FROM progression_unlock_progress WHERE node_id = $1
+DELETE FROM progression_unlock_progress WHERE node_id = $1 AND community_id = $2
 `
 
-func (q *Queries) ClearUnlockProgressForNode(ctx context.Context, nodeID pgtype.Int4) error {
-	_, err := q.db.Exec(ctx, clearUnlockProgressForNode, nodeID)
+type ClearUnlockProgressForNodeParams struct {
+	NodeID      pgtype.Int4 `json:"node_id"`
+	CommunityID string      `json:"community_id"`
+}
+
+func (q *Queries) ClearUnlockProgressForNode(ctx context.Context, arg ClearUnlockProgressForNodeParams) error {
+	_, err := q.db.Exec(ctx, clearUnlockProgressForNode, arg.NodeID, arg.CommunityID)
 	return err
 }
 
 const clearUnlocksExceptRoot = `-- name: ClearUnlocksExceptRoot :exec
 DELETE FROM progression_unlocks
 WHERE node_id != (SELECT id FROM progression_nodes WHERE node_key = 'progression_system')
+  AND community_id = $1
 `
 
-func (q *Queries) ClearUnlocksExceptRoot(ctx context.Context) error {
-	_, err := q.db.Exec(ctx, clearUnlocksExceptRoot)
+func (q *Queries) ClearUnlocksExceptRoot(ctx context.Context, communityID string) error {
+	_, err := q.db.Exec(ctx, clearUnlocksExceptRoot, communityID)
 	return err
 }
 
@@ -136,13 +147,26 @@ func (q *Queries) CompleteUnlock(ctx context.Context, id int32) error {
 	return err
 }
 
+const countCompletedSessions = `-- name: CountCompletedSessions :one
+SELECT COUNT(*) FROM progression_voting_sessions
+WHERE status = 'completed' AND community_id = $1
+`
+
+func (q *Queries) CountCompletedSessions(ctx context.Context, communityID string) (int64, error) {
+	row := q.db.QueryRow(ctx, countCompletedSessions, communityID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
 const countTotalUnlockedNodes = `-- name: CountTotalUnlockedNodes :one
 SELECT COUNT(DISTINCT node_id)::int
 FROM progression_unlocks
+WHERE community_id = $1
 `
 
-func (q *Queries) CountTotalUnlockedNodes(ctx context.Context) (int32, error) {
-	row := q.db.QueryRow(ctx, countTotalUnlockedNodes)
+func (q *Queries) CountTotalUnlockedNodes(ctx context.Context, communityID string) (int32, error) {
+	row := q.db.QueryRow(ctx, countTotalUnlockedNodes, communityID)
 	var column_1 int32
 	err := row.Scan(&column_1)
 	return column_1, err
@@ -152,48 +176,53 @@ const countUnlockedNodesBelowTier = `-- name: CountUnlockedNodesBelowTier :one
 SELECT COUNT(DISTINCT pu.node_id)::int
 FROM progression_unlocks pu
 JOIN progression_nodes pn ON pu.node_id = pn.id
-WHERE pn.tier < $1
+WHERE pn.tier < $1 AND pu.community_id = $2
 `
 
-func (q *Queries) CountUnlockedNodesBelowTier(ctx context.Context, tier int32) (int32, error) {
-	row := q.db.QueryRow(ctx, countUnlockedNodesBelowTier, tier)
+type CountUnlockedNodesBelowTierParams struct {
+	Tier        int32  `json:"tier"`
+	CommunityID string `json:"community_id"`
+}
+
+func (q *Queries) CountUnlockedNodesBelowTier(ctx context.Context, arg CountUnlockedNodesBelowTierParams) (int32, error) {
+	row := q.db.QueryRow(ctx, countUnlockedNodesBelowTier, arg.Tier, arg.CommunityID)
 	var column_1 int32
 	err := row.Scan(&column_1)
 	return column_1, err
 }
 
 const countUnlocks = `-- name: CountUnlocks :one
-SELECT COUNT(*) FROM progression_unlocks
+SELECT COUNT(*) FROM progression_unlocks WHERE community_id = $1
 `
 
-func (q *Queries) CountUnlocks(ctx context.Context) (int64, error) {
-	row := q.db.QueryRow(ctx, countUnlocks)
+func (q *Queries) CountUnlocks(ctx context.Context, communityID string) (int64, error) {
+	row := q.db.QueryRow(ctx, countUnlocks, communityID)
 	var count int64
 	err := row.Scan(&count)
 	return count, err
 }
 
 const createUnlockProgress = `-- name: CreateUnlockProgress :one
-INSERT INTO progression_unlock_progress (contributions_accumulated)
-VALUES (0)
+INSERT INTO progression_unlock_progress (contributions_accumulated, community_id)
+VALUES (0, $1)
 RETURNING id
 `
 
-func (q *Queries) CreateUnlockProgress(ctx context.Context) (int32, error) {
-	row := q.db.QueryRow(ctx, createUnlockProgress)
+func (q *Queries) CreateUnlockProgress(ctx context.Context, communityID string) (int32, error) {
+	row := q.db.QueryRow(ctx, createUnlockProgress, communityID)
 	var id int32
 	err := row.Scan(&id)
 	return id, err
 }
 
 const createVotingSession = `-- name: CreateVotingSession :one
-INSERT INTO progression_voting_sessions (status)
-VALUES ('voting')
+INSERT INTO progression_voting_sessions (status, community_id)
+VALUES ('voting', $1)
 RETURNING id
 `
 
-func (q *Queries) CreateVotingSession(ctx context.Context) (int32, error) {
-	row := q.db.QueryRow(ctx, createVotingSession)
+func (q *Queries) CreateVotingSession(ctx context.Context, communityID string) (int32, error) {
+	row := q.db.QueryRow(ctx, createVotingSession, communityID)
 	var id int32
 	err := row.Scan(&id)
 	return id, err
@@ -248,6 +277,7 @@ const getActiveOrFrozenSession = `-- name: GetActiveOrFrozenSession :one
 SELECT id, started_at, ended_at, voting_deadline, winning_option_id, status
 FROM progression_voting_sessions
 WHERE status IN ('voting', 'frozen')
+  AND community_id = $1
   AND EXISTS (SELECT 1 FROM progression_voting_options WHERE session_id = progression_voting_sessions.id)
 ORDER BY started_at DESC
 LIMIT 1
@@ -262,8 +292,8 @@ type GetActiveOrFrozenSessionRow struct {
 	Status          string           `json:"status"`
 }
 
-func (q *Queries) GetActiveOrFrozenSession(ctx context.Context) (GetActiveOrFrozenSessionRow, error) {
-	row := q.db.QueryRow(ctx, getActiveOrFrozenSession)
+func (q *Queries) GetActiveOrFrozenSession(ctx context.Context, communityID string) (GetActiveOrFrozenSessionRow, error) {
+	row := q.db.QueryRow(ctx, getActiveOrFrozenSession, communityID)
 	var i GetActiveOrFrozenSessionRow
 	err := row.Scan(
 		&i.ID,
@@ -280,6 +310,7 @@ const getActiveSession = `-- name: GetActiveSession :one
 SELECT id, started_at, ended_at, voting_deadline, winning_option_id, status
 FROM progression_voting_sessions
 WHERE status = ('voting')::text
+  AND community_id = $1
   AND EXISTS (SELECT 1 FROM progression_voting_options WHERE session_id = progression_voting_sessions.id)
 ORDER BY started_at DESC
 LIMIT 1
@@ -294,8 +325,8 @@ type GetActiveSessionRow struct {
 	Status          string           `json:"status"`
 }
 
-func (q *Queries) GetActiveSession(ctx context.Context) (GetActiveSessionRow, error) {
-	row := q.db.QueryRow(ctx, getActiveSession)
+func (q *Queries) GetActiveSession(ctx context.Context, communityID string) (GetActiveSessionRow, error) {
+	row := q.db.QueryRow(ctx, getActiveSession, communityID)
 	var i GetActiveSessionRow
 	err := row.Scan(
 		&i.ID,
@@ -309,15 +340,15 @@ func (q *Queries) GetActiveSession(ctx context.Context) (GetActiveSessionRow, er
 }
 
 const getActiveUnlockProgress = `-- name: GetActiveUnlockProgress :one
-SELECT id, node_id, target_level, contributions_accumulated, started_at, unlocked_at, voting_session_id
+SELECT id, node_id, target_level, contributions_accumulated, started_at, unlocked_at, voting_session_id, community_id
 FROM progression_unlock_progress
-WHERE unlocked_at IS NULL
+WHERE unlocked_at IS NULL AND community_id = $1
 ORDER BY started_at DESC
 LIMIT 1
 `
 
-func (q *Queries) GetActiveUnlockProgress(ctx context.Context) (ProgressionUnlockProgress, error) {
-	row := q.db.QueryRow(ctx, getActiveUnlockProgress)
+func (q *Queries) GetActiveUnlockProgress(ctx context.Context, communityID string) (ProgressionUnlockProgress, error) {
+	row := q.db.QueryRow(ctx, getActiveUnlockProgress, communityID)
 	var i ProgressionUnlockProgress
 	err := row.Scan(
 		&i.ID,
@@ -327,6 +358,7 @@ func (q *Queries) GetActiveUnlockProgress(ctx context.Context) (ProgressionUnloc
 		&i.StartedAt,
 		&i.UnlockedAt,
 		&i.VotingSessionID,
+		&i.CommunityID,
 	)
 	return i, err
 }
@@ -354,6 +386,39 @@ func (q *Queries) GetActiveVoting(ctx context.Context) (ProgressionVoting, error
 	return i, err
 }
 
+const getAllNodeEffects = `-- name: GetAllNodeEffects :many
+SELECT id, effects
+FROM progression_nodes
+WHERE effects <> '{}'::jsonb
+ORDER BY id
+`
+
+type GetAllNodeEffectsRow struct {
+	ID      int32  `json:"id"`
+	Effects []byte `json:"effects"`
+}
+
+// Nodes that declare at least one effect
+func (q *Queries) GetAllNodeEffects(ctx context.Context) ([]GetAllNodeEffectsRow, error) {
+	rows, err := q.db.Query(ctx, getAllNodeEffects)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetAllNodeEffectsRow
+	for rows.Next() {
+		var i GetAllNodeEffectsRow
+		if err := rows.Scan(&i.ID, &i.Effects); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getAllNodes = `-- name: GetAllNodes :many
 SELECT id, node_key, node_type, display_name, description,
        max_level, unlock_cost, tier, size, category, sort_order, created_at
@@ -410,14 +475,19 @@ func (q *Queries) GetAllNodes(ctx context.Context) ([]GetAllNodesRow, error) {
 }
 
 const getAllNodesByFeatureKey = `-- name: GetAllNodesByFeatureKey :many
-SELECT n.id, n.node_key, n.node_type, n.display_name, n.description, n.max_level, n.unlock_cost, n.sort_order, n.created_at, n.tier, n.size, n.category, n.dynamic_prerequisites, COALESCE(u.current_level, 0)::int as unlock_level
+SELECT n.id, n.node_key, n.node_type, n.display_name, n.description, n.max_level, n.unlock_cost, n.sort_order, n.created_at, n.tier, n.size, n.category, n.dynamic_prerequisites, n.effects, COALESCE(u.current_level, 0)::int as unlock_level
 FROM progression_nodes n
-LEFT JOIN progression_unlocks u ON u.node_id = n.id
+LEFT JOIN progression_unlocks u ON u.node_id = n.id AND u.community_id = $2
 JOIN bonus_config bc ON n.node_key = bc.node_key
 WHERE bc.feature_key = $1 AND bc.source_type = 'progression'
 ORDER BY n.tier ASC, n.id ASC
 `
 
+type GetAllNodesByFeatureKeyParams struct {
+	FeatureKey  string `json:"feature_key"`
+	CommunityID string `json:"community_id"`
+}
+
 type GetAllNodesByFeatureKeyRow struct {
 	ID                   int32            `json:"id"`
 	NodeKey              string           `json:"node_key"`
@@ -432,11 +502,12 @@ type GetAllNodesByFeatureKeyRow struct {
 	Size                 string           `json:"size"`
 	Category             string           `json:"category"`
 	DynamicPrerequisites []byte           `json:"dynamic_prerequisites"`
+	Effects              []byte           `json:"effects"`
 	UnlockLevel          int32            `json:"unlock_level"`
 }
 
-func (q *Queries) GetAllNodesByFeatureKey(ctx context.Context, featureKey string) ([]GetAllNodesByFeatureKeyRow, error) {
-	rows, err := q.db.Query(ctx, getAllNodesByFeatureKey, featureKey)
+func (q *Queries) GetAllNodesByFeatureKey(ctx context.Context, arg GetAllNodesByFeatureKeyParams) ([]GetAllNodesByFeatureKeyRow, error) {
+	rows, err := q.db.Query(ctx, getAllNodesByFeatureKey, arg.FeatureKey, arg.CommunityID)
 	if err != nil {
 		return nil, err
 	}
@@ -458,6 +529,7 @@ func (q *Queries) GetAllNodesByFeatureKey(ctx context.Context, featureKey string
 			&i.Size,
 			&i.Category,
 			&i.DynamicPrerequisites,
+			&i.Effects,
 			&i.UnlockLevel,
 		); err != nil {
 			return nil, err
@@ -471,13 +543,14 @@ func (q *Queries) GetAllNodesByFeatureKey(ctx context.Context, featureKey string
 }
 
 const getAllUnlocks = `-- name: GetAllUnlocks :many
-SELECT id, node_id, current_level, unlocked_at, unlocked_by, engagement_score
+SELECT id, node_id, current_level, unlocked_at, unlocked_by, engagement_score, community_id
 FROM progression_unlocks
+WHERE community_id = $1
 ORDER BY unlocked_at
 `
 
-func (q *Queries) GetAllUnlocks(ctx context.Context) ([]ProgressionUnlock, error) {
-	rows, err := q.db.Query(ctx, getAllUnlocks)
+func (q *Queries) GetAllUnlocks(ctx context.Context, communityID string) ([]ProgressionUnlock, error) {
+	rows, err := q.db.Query(ctx, getAllUnlocks, communityID)
 	if err != nil {
 		return nil, err
 	}
@@ -492,6 +565,66 @@ func (q *Queries) GetAllUnlocks(ctx context.Context) ([]ProgressionUnlock, error
 			&i.UnlockedAt,
 			&i.UnlockedBy,
 			&i.EngagementScore,
+			&i.CommunityID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getCompletedSessions = `-- name: GetCompletedSessions :many
+SELECT s.id, s.started_at, s.ended_at, s.voting_deadline, s.winning_option_id, s.status,
+       up.node_id AS unlocked_node_id, up.target_level AS unlocked_level, up.unlocked_at
+FROM progression_voting_sessions s
+LEFT JOIN progression_unlock_progress up
+       ON up.voting_session_id = s.id AND up.unlocked_at IS NOT NULL
+WHERE s.status = 'completed' AND s.community_id = $3
+ORDER BY s.started_at DESC, s.id DESC
+LIMIT $1 OFFSET $2
+`
+
+type GetCompletedSessionsParams struct {
+	Limit       int32  `json:"limit"`
+	Offset      int32  `json:"offset"`
+	CommunityID string `json:"community_id"`
+}
+
+type GetCompletedSessionsRow struct {
+	ID              int32            `json:"id"`
+	StartedAt       pgtype.Timestamp `json:"started_at"`
+	EndedAt         pgtype.Timestamp `json:"ended_at"`
+	VotingDeadline  pgtype.Timestamp `json:"voting_deadline"`
+	WinningOptionID pgtype.Int4      `json:"winning_option_id"`
+	Status          string           `json:"status"`
+	UnlockedNodeID  pgtype.Int4      `json:"unlocked_node_id"`
+	UnlockedLevel   pgtype.Int4      `json:"unlocked_level"`
+	UnlockedAt      pgtype.Timestamp `json:"unlocked_at"`
+}
+
+func (q *Queries) GetCompletedSessions(ctx context.Context, arg GetCompletedSessionsParams) ([]GetCompletedSessionsRow, error) {
+	rows, err := q.db.Query(ctx, getCompletedSessions, arg.Limit, arg.Offset, arg.CommunityID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetCompletedSessionsRow
+	for rows.Next() {
+		var i GetCompletedSessionsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.StartedAt,
+			&i.EndedAt,
+			&i.VotingDeadline,
+			&i.WinningOptionID,
+			&i.Status,
+			&i.UnlockedNodeID,
+			&i.UnlockedLevel,
+			&i.UnlockedAt,
 		); err != nil {
 			return nil, err
 		}
@@ -504,30 +637,32 @@ func (q *Queries) GetAllUnlocks(ctx context.Context) ([]ProgressionUnlock, error
 }
 
 const getContributionLeaderboard = `-- name: GetContributionLeaderboard :many
-WITH user_contributions AS (
-    SELECT
-        user_id,
-        SUM(metric_value) as total_contribution
-    FROM engagement_metrics
-    GROUP BY user_id
-)
 SELECT
-    user_id,
-    total_contribution,
-    ROW_NUMBER() OVER (ORDER BY total_contribution DESC)::bigint as rank
-FROM user_contributions
-ORDER BY total_contribution DESC
+    ucs.user_id,
+    ROUND(ucs.score)::bigint as total_contribution,
+    ROW_NUMBER() OVER (ORDER BY ucs.score DESC)::bigint as rank,
+    COALESCE(us.leaderboard_private, FALSE)::boolean as is_private
+FROM user_contribution_scores ucs
+JOIN users u ON u.user_id::text = ucs.user_id AND u.community_id = $2
+LEFT JOIN user_settings us ON us.user_id::text = ucs.user_id
+ORDER BY ucs.score DESC
 LIMIT $1
 `
 
+type GetContributionLeaderboardParams struct {
+	Limit       int32  `json:"limit"`
+	CommunityID string `json:"community_id"`
+}
+
 type GetContributionLeaderboardRow struct {
 	UserID            string `json:"user_id"`
 	TotalContribution int64  `json:"total_contribution"`
 	Rank              int64  `json:"rank"`
+	IsPrivate         bool   `json:"is_private"`
 }
 
-func (q *Queries) GetContributionLeaderboard(ctx context.Context, limit int32) ([]GetContributionLeaderboardRow, error) {
-	rows, err := q.db.Query(ctx, getContributionLeaderboard, limit)
+func (q *Queries) GetContributionLeaderboard(ctx context.Context, arg GetContributionLeaderboardParams) ([]GetContributionLeaderboardRow, error) {
+	rows, err := q.db.Query(ctx, getContributionLeaderboard, arg.Limit, arg.CommunityID)
 	if err != nil {
 		return nil, err
 	}
@@ -535,7 +670,12 @@ func (q *Queries) GetContributionLeaderboard(ctx context.Context, limit int32) (
 	var items []GetContributionLeaderboardRow
 	for rows.Next() {
 		var i GetContributionLeaderboardRow
-		if err := rows.Scan(&i.UserID, &i.TotalContribution, &i.Rank); err != nil {
+		if err := rows.Scan(
+			&i.UserID,
+			&i.TotalContribution,
+			&i.Rank,
+			&i.IsPrivate,
+		); err != nil {
 			return nil, err
 		}
 		items = append(items, i)
@@ -672,9 +812,28 @@ func (q *Queries) GetEngagementWeights(ctx context.Context) ([]GetEngagementWeig
 	return items, nil
 }
 
+const getMetricTotalSince = `-- name: GetMetricTotalSince :one
+SELECT COALESCE(SUM(metric_value), 0)::bigint
+FROM engagement_metrics
+WHERE metric_type = $1 AND recorded_at >= $2
+`
+
+type GetMetricTotalSinceParams struct {
+	MetricType string           `json:"metric_type"`
+	RecordedAt pgtype.Timestamp `json:"recorded_at"`
+}
+
+func (q *Queries) GetMetricTotalSince(ctx context.Context, arg GetMetricTotalSinceParams) (int64, error) {
+	row := q.db.QueryRow(ctx, getMetricTotalSince, arg.MetricType, arg.RecordedAt)
+	var column_1 int64
+	err := row.Scan(&column_1)
+	return column_1, err
+}
+
 const getMostRecentSession = `-- name: GetMostRecentSession :one
 SELECT id, started_at, ended_at, voting_deadline, winning_option_id, status
 FROM progression_voting_sessions
+WHERE community_id = $1
 ORDER BY started_at DESC
 LIMIT 1
 `
@@ -688,8 +847,8 @@ type GetMostRecentSessionRow struct {
 	Status          string           `json:"status"`
 }
 
-func (q *Queries) GetMostRecentSession(ctx context.Context) (GetMostRecentSessionRow, error) {
-	row := q.db.QueryRow(ctx, getMostRecentSession)
+func (q *Queries) GetMostRecentSession(ctx context.Context, communityID string) (GetMostRecentSessionRow, error) {
+	row := q.db.QueryRow(ctx, getMostRecentSession, communityID)
 	var i GetMostRecentSessionRow
 	err := row.Scan(
 		&i.ID,
@@ -703,14 +862,19 @@ func (q *Queries) GetMostRecentSession(ctx context.Context) (GetMostRecentSessio
 }
 
 const getNodeByFeatureKey = `-- name: GetNodeByFeatureKey :one
-SELECT n.id, n.node_key, n.node_type, n.display_name, n.description, n.max_level, n.unlock_cost, n.sort_order, n.created_at, n.tier, n.size, n.category, n.dynamic_prerequisites, COALESCE(u.current_level, 0)::int as unlock_level
+SELECT n.id, n.node_key, n.node_type, n.display_name, n.description, n.max_level, n.unlock_cost, n.sort_order, n.created_at, n.tier, n.size, n.category, n.dynamic_prerequisites, n.effects, COALESCE(u.current_level, 0)::int as unlock_level
 FROM progression_nodes n
-LEFT JOIN progression_unlocks u ON u.node_id = n.id
+LEFT JOIN progression_unlocks u ON u.node_id = n.id AND u.community_id = $2
 JOIN bonus_config bc ON n.node_key = bc.node_key
 WHERE bc.feature_key = $1 AND bc.source_type = 'progression'
 LIMIT 1
 `
 
+type GetNodeByFeatureKeyParams struct {
+	FeatureKey  string `json:"feature_key"`
+	CommunityID string `json:"community_id"`
+}
+
 type GetNodeByFeatureKeyRow struct {
 	ID                   int32            `json:"id"`
 	NodeKey              string           `json:"node_key"`
@@ -725,11 +889,12 @@ type GetNodeByFeatureKeyRow struct {
 	Size                 string           `json:"size"`
 	Category             string           `json:"category"`
 	DynamicPrerequisites []byte           `json:"dynamic_prerequisites"`
+	Effects              []byte           `json:"effects"`
 	UnlockLevel          int32            `json:"unlock_level"`
 }
 
-func (q *Queries) GetNodeByFeatureKey(ctx context.Context, featureKey string) (GetNodeByFeatureKeyRow, error) {
-	row := q.db.QueryRow(ctx, getNodeByFeatureKey, featureKey)
+func (q *Queries) GetNodeByFeatureKey(ctx context.Context, arg GetNodeByFeatureKeyParams) (GetNodeByFeatureKeyRow, error) {
+	row := q.db.QueryRow(ctx, getNodeByFeatureKey, arg.FeatureKey, arg.CommunityID)
 	var i GetNodeByFeatureKeyRow
 	err := row.Scan(
 		&i.ID,
@@ -745,6 +910,7 @@ func (q *Queries) GetNodeByFeatureKey(ctx context.Context, featureKey string) (G
 		&i.Size,
 		&i.Category,
 		&i.DynamicPrerequisites,
+		&i.Effects,
 		&i.UnlockLevel,
 	)
 	return i, err
@@ -905,28 +1071,43 @@ func (q *Queries) GetNodeDynamicPrerequisites(ctx context.Context, id int32) ([]
 	return dynamic_prerequisites, err
 }
 
+const getNodeEffects = `-- name: GetNodeEffects :one
+SELECT effects
+FROM progression_nodes
+WHERE id = $1
+`
+
+func (q *Queries) GetNodeEffects(ctx context.Context, id int32) ([]byte, error) {
+	row := q.db.QueryRow(ctx, getNodeEffects, id)
+	var effects []byte
+	err := row.Scan(&effects)
+	return effects, err
+}
+
 const getNodePrerequisites = `-- name: GetNodePrerequisites :many
 SELECT n.id, n.node_key, n.node_type, n.display_name, n.description,
-       n.max_level, n.unlock_cost, n.tier, n.size, n.category, n.sort_order, n.created_at
+       n.max_level, n.unlock_cost, n.tier, n.size, n.category, n.sort_order, n.created_at,
+       p.prerequisite_group
 FROM progression_nodes n
 INNER JOIN progression_prerequisites p ON n.id = p.prerequisite_node_id
 WHERE p.node_id = $1
-ORDER BY n.sort_order, n.id
+ORDER BY p.prerequisite_group, n.sort_order, n.id
 `
 
 type GetNodePrerequisitesRow struct {
-	ID          int32            `json:"id"`
-	NodeKey     string           `json:"node_key"`
-	NodeType    string           `json:"node_type"`
-	DisplayName string           `json:"display_name"`
-	Description pgtype.Text      `json:"description"`
-	MaxLevel    pgtype.Int4      `json:"max_level"`
-	UnlockCost  pgtype.Int4      `json:"unlock_cost"`
-	Tier        int32            `json:"tier"`
-	Size        string           `json:"size"`
-	Category    string           `json:"category"`
-	SortOrder   pgtype.Int4      `json:"sort_order"`
-	CreatedAt   pgtype.Timestamp `json:"created_at"`
+	ID                int32            `json:"id"`
+	NodeKey           string           `json:"node_key"`
+	NodeType          string           `json:"node_type"`
+	DisplayName       string           `json:"display_name"`
+	Description       pgtype.Text      `json:"description"`
+	MaxLevel          pgtype.Int4      `json:"max_level"`
+	UnlockCost        pgtype.Int4      `json:"unlock_cost"`
+	Tier              int32            `json:"tier"`
+	Size              string           `json:"size"`
+	Category          string           `json:"category"`
+	SortOrder         pgtype.Int4      `json:"sort_order"`
+	CreatedAt         pgtype.Timestamp `json:"created_at"`
+	PrerequisiteGroup int32            `json:"prerequisite_group"`
 }
 
 func (q *Queries) GetNodePrerequisites(ctx context.Context, nodeID int32) ([]GetNodePrerequisitesRow, error) {
@@ -951,6 +1132,7 @@ func (q *Queries) GetNodePrerequisites(ctx context.Context, nodeID int32) ([]Get
 			&i.Category,
 			&i.SortOrder,
 			&i.CreatedAt,
+			&i.PrerequisiteGroup,
 		); err != nil {
 			return nil, err
 		}
@@ -992,7 +1174,8 @@ func (q *Queries) GetSessionByID(ctx context.Context, id int32) (GetSessionByIDR
 }
 
 const getSessionOptions = `-- name: GetSessionOptions :many
-SELECT o.id, o.session_id, o.node_id, o.target_level, o.vote_count, o.last_highest_vote_at
+SELECT o.id, o.session_id, o.node_id, o.target_level, o.vote_count, o.last_highest_vote_at,
+       (SELECT COUNT(*) FROM user_votes v WHERE v.option_id = o.id AND v.excluded_at IS NULL)::int AS voter_count
 FROM progression_voting_options o
 WHERE o.session_id = $1
 ORDER BY o.id
@@ -1005,6 +1188,7 @@ type GetSessionOptionsRow struct {
 	TargetLevel       int32            `json:"target_level"`
 	VoteCount         int32            `json:"vote_count"`
 	LastHighestVoteAt pgtype.Timestamp `json:"last_highest_vote_at"`
+	VoterCount        int32            `json:"voter_count"`
 }
 
 func (q *Queries) GetSessionOptions(ctx context.Context, sessionID int32) ([]GetSessionOptionsRow, error) {
@@ -1023,6 +1207,7 @@ func (q *Queries) GetSessionOptions(ctx context.Context, sessionID int32) ([]Get
 			&i.TargetLevel,
 			&i.VoteCount,
 			&i.LastHighestVoteAt,
+			&i.VoterCount,
 		); err != nil {
 			return nil, err
 		}
@@ -1072,18 +1257,19 @@ func (q *Queries) GetTotalEngagementScore(ctx context.Context) (int64, error) {
 }
 
 const getUnlock = `-- name: GetUnlock :one
-SELECT id, node_id, current_level, unlocked_at, unlocked_by, engagement_score
+SELECT id, node_id, current_level, unlocked_at, unlocked_by, engagement_score, community_id
 FROM progression_unlocks
-WHERE node_id = $1 AND current_level = $2
+WHERE node_id = $1 AND current_level = $2 AND community_id = $3
 `
 
 type GetUnlockParams struct {
 	NodeID       pgtype.Int4 `json:"node_id"`
 	CurrentLevel pgtype.Int4 `json:"current_level"`
+	CommunityID  string      `json:"community_id"`
 }
 
 func (q *Queries) GetUnlock(ctx context.Context, arg GetUnlockParams) (ProgressionUnlock, error) {
-	row := q.db.QueryRow(ctx, getUnlock, arg.NodeID, arg.CurrentLevel)
+	row := q.db.QueryRow(ctx, getUnlock, arg.NodeID, arg.CurrentLevel, arg.CommunityID)
 	var i ProgressionUnlock
 	err := row.Scan(
 		&i.ID,
@@ -1092,6 +1278,7 @@ func (q *Queries) GetUnlock(ctx context.Context, arg GetUnlockParams) (Progressi
 		&i.UnlockedAt,
 		&i.UnlockedBy,
 		&i.EngagementScore,
+		&i.CommunityID,
 	)
 	return i, err
 }
@@ -1286,13 +1473,18 @@ func (q *Queries) IncrementVote(ctx context.Context, arg IncrementVoteParams) er
 }
 
 const insertNextUnlockProgress = `-- name: InsertNextUnlockProgress :one
-INSERT INTO progression_unlock_progress (contributions_accumulated)
-VALUES ($1)
+INSERT INTO progression_unlock_progress (contributions_accumulated, community_id)
+VALUES ($1, $2)
 RETURNING id
 `
 
-func (q *Queries) InsertNextUnlockProgress(ctx context.Context, contributionsAccumulated int32) (int32, error) {
-	row := q.db.QueryRow(ctx, insertNextUnlockProgress, contributionsAccumulated)
+type InsertNextUnlockProgressParams struct {
+	ContributionsAccumulated int32  `json:"contributions_accumulated"`
+	CommunityID              string `json:"community_id"`
+}
+
+func (q *Queries) InsertNextUnlockProgress(ctx context.Context, arg InsertNextUnlockProgressParams) (int32, error) {
+	row := q.db.QueryRow(ctx, insertNextUnlockProgress, arg.ContributionsAccumulated, arg.CommunityID)
 	var id int32
 	err := row.Scan(&id)
 	return id, err
@@ -1336,18 +1528,19 @@ func (q *Queries) InsertNode(ctx context.Context, arg InsertNodeParams) (int32,
 }
 
 const insertNodePrerequisite = `-- name: InsertNodePrerequisite :exec
-INSERT INTO progression_prerequisites (node_id, prerequisite_node_id)
-VALUES ($1, $2)
+INSERT INTO progression_prerequisites (node_id, prerequisite_node_id, prerequisite_group)
+VALUES ($1, $2, $3)
 ON CONFLICT (node_id, prerequisite_node_id) DO NOTHING
 `
 
 type InsertNodePrerequisiteParams struct {
 	NodeID             int32 `json:"node_id"`
 	PrerequisiteNodeID int32 `json:"prerequisite_node_id"`
+	PrerequisiteGroup  int32 `json:"prerequisite_group"`
 }
 
 func (q *Queries) InsertNodePrerequisite(ctx context.Context, arg InsertNodePrerequisiteParams) error {
-	_, err := q.db.Exec(ctx, insertNodePrerequisite, arg.NodeID, arg.PrerequisiteNodeID)
+	_, err := q.db.Exec(ctx, insertNodePrerequisite, arg.NodeID, arg.PrerequisiteNodeID, arg.PrerequisiteGroup)
 	return err
 }
 
@@ -1355,17 +1548,18 @@ const isNodeUnlocked = `-- name: IsNodeUnlocked :one
 SELECT EXISTS(
     SELECT 1 FROM progression_unlocks pu
     JOIN progression_nodes pn ON pu.node_id = pn.id
-    WHERE pn.node_key = $1 AND pu.current_level >= $2
+    WHERE pn.node_key = $1 AND pu.current_level >= $2 AND pu.community_id = $3
 )
 `
 
 type IsNodeUnlockedParams struct {
 	NodeKey      string      `json:"node_key"`
 	CurrentLevel pgtype.Int4 `json:"current_level"`
+	CommunityID  string      `json:"community_id"`
 }
 
 func (q *Queries) IsNodeUnlocked(ctx context.Context, arg IsNodeUnlockedParams) (bool, error) {
-	row := q.db.QueryRow(ctx, isNodeUnlocked, arg.NodeKey, arg.CurrentLevel)
+	row := q.db.QueryRow(ctx, isNodeUnlocked, arg.NodeKey, arg.CurrentLevel, arg.CommunityID)
 	var exists bool
 	err := row.Scan(&exists)
 	return exists, err
@@ -1391,6 +1585,16 @@ func (q *Queries) IsUserProgressionUnlocked(ctx context.Context, arg IsUserProgr
 	return exists, err
 }
 
+const lockMetricType = `-- name: LockMetricType :exec
+SELECT pg_advisory_xact_lock(hashtext($1::text))
+`
+
+// Serialises capped inserts for one metric type until the transaction ends.
+func (q *Queries) LockMetricType(ctx context.Context, metricType string) error {
+	_, err := q.db.Exec(ctx, lockMetricType, metricType)
+	return err
+}
+
 const recordEngagement = `-- name: RecordEngagement :exec
 INSERT INTO engagement_metrics (user_id, metric_type, metric_value, metadata, recorded_at)
 VALUES ($1, $2, $3, $4, COALESCE($5::timestamp, CURRENT_TIMESTAMP))
@@ -1438,8 +1642,8 @@ func (q *Queries) RecordReset(ctx context.Context, arg RecordResetParams) error
 }
 
 const recordUserSessionVote = `-- name: RecordUserSessionVote :exec
-INSERT INTO user_votes (user_id, session_id, option_id, node_id, target_level)
-VALUES ($1, $2, $3, $4, $5)
+INSERT INTO user_votes (user_id, session_id, option_id, node_id, target_level, weight)
+VALUES ($1, $2, $3, $4, $5, $6)
 ON CONFLICT (user_id, session_id) DO NOTHING
 `
 
@@ -1449,6 +1653,7 @@ type RecordUserSessionVoteParams struct {
 	OptionID    pgtype.Int4 `json:"option_id"`
 	NodeID      int32       `json:"node_id"`
 	TargetLevel int32       `json:"target_level"`
+	Weight      int32       `json:"weight"`
 }
 
 func (q *Queries) RecordUserSessionVote(ctx context.Context, arg RecordUserSessionVoteParams) error {
@@ -1458,6 +1663,7 @@ func (q *Queries) RecordUserSessionVote(ctx context.Context, arg RecordUserSessi
 		arg.OptionID,
 		arg.NodeID,
 		arg.TargetLevel,
+		arg.Weight,
 	)
 	return err
 }
@@ -1480,16 +1686,17 @@ func (q *Queries) RecordUserVote(ctx context.Context, arg RecordUserVoteParams)
 }
 
 const relockNode = `-- name: RelockNode :exec
-DELETE FROM progression_unlocks WHERE node_id = $1 AND (current_level = $2 OR $2 = 0)
+DELETE FROM progression_unlocks WHERE node_id = $1 AND (current_level = $2 OR $2 = 0) AND community_id = $3
 `
 
 type RelockNodeParams struct {
 	NodeID       pgtype.Int4 `json:"node_id"`
 	CurrentLevel pgtype.Int4 `json:"current_level"`
+	CommunityID  string      `json:"community_id"`
 }
 
 func (q *Queries) RelockNode(ctx context.Context, arg RelockNodeParams) error {
-	_, err := q.db.Exec(ctx, relockNode, arg.NodeID, arg.CurrentLevel)
+	_, err := q.db.Exec(ctx, relockNode, arg.NodeID, arg.CurrentLevel, arg.CommunityID)
 	return err
 }
 
@@ -1504,6 +1711,24 @@ func (q *Queries) ResumeVotingSession(ctx context.Context, id int32) error {
 	return err
 }
 
+const seedCommunityAutoUnlocks = `-- name: SeedCommunityAutoUnlocks :execrows
+INSERT INTO progression_unlocks (node_id, current_level, unlocked_by, engagement_score, community_id)
+SELECT node_id, current_level, unlocked_by, 0, $1
+FROM progression_unlocks
+WHERE community_id = 'default' AND unlocked_by = 'auto'
+ON CONFLICT (community_id, node_id, current_level) DO NOTHING
+`
+
+// Gives a community the unlocks every tree starts with, copied from the
+// default community
+func (q *Queries) SeedCommunityAutoUnlocks(ctx context.Context, communityID string) (int64, error) {
+	result, err := q.db.Exec(ctx, seedCommunityAutoUnlocks, communityID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
 const setUnlockTarget = `-- name: SetUnlockTarget :exec
 UPDATE progression_unlock_progress
 SET node_id = $2, target_level = $3, voting_session_id = $4
@@ -1544,9 +1769,9 @@ func (q *Queries) StartVoting(ctx context.Context, arg StartVotingParams) error
 }
 
 const unlockNode = `-- name: UnlockNode :exec
-INSERT INTO progression_unlocks (node_id, current_level, unlocked_by, engagement_score)
-VALUES ($1, $2, $3, $4)
-ON CONFLICT (node_id, current_level) DO NOTHING
+INSERT INTO progression_unlocks (node_id, current_level, unlocked_by, engagement_score, community_id)
+VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT (community_id, node_id, current_level) DO NOTHING
 `
 
 type UnlockNodeParams struct {
@@ -1554,6 +1779,7 @@ type UnlockNodeParams struct {
 	CurrentLevel    pgtype.Int4 `json:"current_level"`
 	UnlockedBy      pgtype.Text `json:"unlocked_by"`
 	EngagementScore pgtype.Int4 `json:"engagement_score"`
+	CommunityID     string      `json:"community_id"`
 }
 
 func (q *Queries) UnlockNode(ctx context.Context, arg UnlockNodeParams) error {
@@ -1562,6 +1788,7 @@ func (q *Queries) UnlockNode(ctx context.Context, arg UnlockNodeParams) error {
 		arg.CurrentLevel,
 		arg.UnlockedBy,
 		arg.EngagementScore,
+		arg.CommunityID,
 	)
 	return err
 }
@@ -1657,6 +1884,22 @@ func (q *Queries) UpdateNodeDynamicPrerequisites(ctx context.Context, arg Update
 	return err
 }
 
+const updateNodeEffects = `-- name: UpdateNodeEffects :exec
+UPDATE progression_nodes
+SET effects = $2
+WHERE id = $1
+`
+
+type UpdateNodeEffectsParams struct {
+	ID      int32  `json:"id"`
+	Effects []byte `json:"effects"`
+}
+
+func (q *Queries) UpdateNodeEffects(ctx context.Context, arg UpdateNodeEffectsParams) error {
+	_, err := q.db.Exec(ctx, updateNodeEffects, arg.ID, arg.Effects)
+	return err
+}
+
 const updateOptionLastHighest = `-- name: UpdateOptionLastHighest :exec
 UPDATE progression_voting_options o
 SET last_highest_vote_at = NOW()
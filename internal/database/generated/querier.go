@@ -14,67 +14,171 @@ import (
 
 type Querier interface {
 	AcceptDuel(ctx context.Context, arg AcceptDuelParams) error
+	// Takes the lease if it is free, expired, or already held by the caller.
+	// Expiry is computed from the database clock so instance clock skew does not matter.
+	AcquireSchedulerLease(ctx context.Context, arg AcquireSchedulerLeaseParams) (int64, error)
 	AddContribution(ctx context.Context, arg AddContributionParams) error
 	AddExpeditionParticipant(ctx context.Context, arg AddExpeditionParticipantParams) error
+	AddToCommunityPool(ctx context.Context, arg AddToCommunityPoolParams) error
+	// Credits the community's jackpot, opening it on first use. The balance is
+	// not raised past max_balance, but a balance already above it is kept.
+	AddToJackpot(ctx context.Context, arg AddToJackpotParams) (int64, error)
+	AddUserContributionScore(ctx context.Context, arg AddUserContributionScoreParams) error
+	// Accumulates: the duration is added to whatever is left of an active timeout
+	AddUserTimeout(ctx context.Context, arg AddUserTimeoutParams) (pgtype.Timestamptz, error)
 	AddVotingOption(ctx context.Context, arg AddVotingOptionParams) error
+	// Applies a quantity delta to the first (item, quality) slot in a single
+	// statement, matching utils.AdjustSlotQuantity. The slot is created when
+	// missing and dropped when it reaches zero. No row is updated when the result
+	// would be negative. Slot order is preserved.
+	AdjustInventorySlot(ctx context.Context, arg AdjustInventorySlotParams) (int32, error)
+	AdjustOptionVoteCount(ctx context.Context, arg AdjustOptionVoteCountParams) error
+	ApplyBankInterest(ctx context.Context, arg ApplyBankInterestParams) ([]ApplyBankInterestRow, error)
 	AssignItemTag(ctx context.Context, arg AssignItemTagParams) error
+	// Only existing users are granted the progression.
+	BulkGrantUserProgression(ctx context.Context, arg BulkGrantUserProgressionParams) (int64, error)
+	BulkRevokeUserProgression(ctx context.Context, arg BulkRevokeUserProgressionParams) (int64, error)
+	// Affects no rows when the user already received this celebration this year
+	ClaimCelebrationGrant(ctx context.Context, arg ClaimCelebrationGrantParams) (int64, error)
+	// Removes and returns due reminders. SKIP LOCKED lets several instances
+	// claim batches concurrently without delivering a reminder twice.
+	ClaimDueReminders(ctx context.Context, arg ClaimDueRemindersParams) ([]Reminder, error)
+	// Leases due deliveries by pushing their next attempt past lease_until, so
+	// other instances skip them while they are being sent. SKIP LOCKED lets
+	// several instances claim batches concurrently.
+	ClaimDueWebhookDeliveries(ctx context.Context, arg ClaimDueWebhookDeliveriesParams) ([]ClaimDueWebhookDeliveriesRow, error)
+	ClaimJackpot(ctx context.Context, communityID string) (int64, error)
+	// Affects no rows when the event was already claimed
+	ClaimMonetizationEvent(ctx context.Context, arg ClaimMonetizationEventParams) (int64, error)
 	ClaimQuestReward(ctx context.Context, arg ClaimQuestRewardParams) error
+	// Affects no rows when the user already unlocked this progression
+	ClaimUserProgression(ctx context.Context, arg ClaimUserProgressionParams) (int64, error)
 	CleanupExpiredTokens(ctx context.Context) error
 	CleanupOldEvents(ctx context.Context, days int32) (int64, error)
 	CleanupStaleTraps(ctx context.Context, dollar_1 interface{}) error
-	ClearAllUnlockProgress(ctx context.Context) error
-	ClearAllUserProgression(ctx context.Context) error
-	ClearAllUserVotes(ctx context.Context) error
+	ClearActiveJob(ctx context.Context, userID uuid.UUID) error
+	ClearAllUnlockProgress(ctx context.Context, communityID string) error
+	ClearAllUserProgression(ctx context.Context, communityID string) error
+	ClearAllUserVotes(ctx context.Context, communityID string) error
 	ClearAllVoting(ctx context.Context) error
 	ClearAllVotingOptions(ctx context.Context) error
-	ClearAllVotingSessions(ctx context.Context) error
+	ClearAllVotingSessions(ctx context.Context, communityID string) error
 	ClearBonusModifiersForNode(ctx context.Context, nodeKey string) error
 	ClearDisassembleOutputs(ctx context.Context, recipeID int32) error
 	ClearItemTags(ctx context.Context, itemID int32) error
 	ClearNodePrerequisites(ctx context.Context, nodeID int32) error
-	ClearUnlockProgressForNode(ctx context.Context, nodeID pgtype.Int4) error
-	ClearUnlocksExceptRoot(ctx context.Context) error
+	ClearUnlockProgressForNode(ctx context.Context, arg ClearUnlockProgressForNodeParams) error
+	ClearUnlocksExceptRoot(ctx context.Context, communityID string) error
+	CloseGift(ctx context.Context, arg CloseGiftParams) error
+	CloseItemLoan(ctx context.Context, arg CloseItemLoanParams) error
+	CompleteBankGoal(ctx context.Context, arg CompleteBankGoalParams) (BankAccount, error)
 	CompleteExpedition(ctx context.Context, id uuid.UUID) error
+	CompleteMonetizationEvent(ctx context.Context, arg CompleteMonetizationEventParams) error
 	CompleteQuest(ctx context.Context, arg CompleteQuestParams) error
 	CompleteUnlock(ctx context.Context, id int32) error
-	CountTotalUnlockedNodes(ctx context.Context) (int32, error)
-	CountUnlockedNodesBelowTier(ctx context.Context, tier int32) (int32, error)
-	CountUnlocks(ctx context.Context) (int64, error)
+	CountActiveAPITokens(ctx context.Context, guildID string) (int32, error)
+	CountActivePlayerShopListings(ctx context.Context, sellerID uuid.UUID) (int32, error)
+	CountCompletedSessions(ctx context.Context, communityID string) (int64, error)
+	CountGivesSince(ctx context.Context, arg CountGivesSinceParams) (int32, error)
+	// Counts users whose last search was at or after since.
+	CountRecentSearchers(ctx context.Context, since pgtype.Timestamptz) (int32, error)
+	CountSenderPendingGifts(ctx context.Context, senderID uuid.UUID) (int32, error)
+	CountTotalUnlockedNodes(ctx context.Context, communityID string) (int32, error)
+	CountUnlockedNodesBelowTier(ctx context.Context, arg CountUnlockedNodesBelowTierParams) (int32, error)
+	CountUnlocks(ctx context.Context, communityID string) (int64, error)
+	CountUnreadInboxMessages(ctx context.Context, userID uuid.UUID) (int32, error)
+	CreateAPIToken(ctx context.Context, arg CreateAPITokenParams) (ApiToken, error)
+	CreateAnnouncementRoute(ctx context.Context, arg CreateAnnouncementRouteParams) (AnnouncementRoute, error)
+	CreateBossFight(ctx context.Context, arg CreateBossFightParams) (int64, error)
 	CreateCompostBin(ctx context.Context, userID uuid.UUID) (CompostBin, error)
 	CreateDuel(ctx context.Context, arg CreateDuelParams) error
 	CreateExpedition(ctx context.Context, arg CreateExpeditionParams) error
+	CreateFishingCast(ctx context.Context, arg CreateFishingCastParams) (int64, error)
 	CreateGamble(ctx context.Context, arg CreateGambleParams) error
+	CreateGameEvent(ctx context.Context, arg CreateGameEventParams) (GameEvent, error)
+	CreateGameSnapshot(ctx context.Context, arg CreateGameSnapshotParams) (CreateGameSnapshotRow, error)
+	CreateGift(ctx context.Context, arg CreateGiftParams) (Gift, error)
 	CreateHarvestState(ctx context.Context, dollar_1 uuid.UUID) (HarvestState, error)
+	CreateInboxMessage(ctx context.Context, arg CreateInboxMessageParams) (InboxMessage, error)
+	CreateItemBalanceChange(ctx context.Context, arg CreateItemBalanceChangeParams) (ItemBalanceChange, error)
+	CreateItemLoan(ctx context.Context, arg CreateItemLoanParams) (ItemLoan, error)
+	CreatePlayerShopListing(ctx context.Context, arg CreatePlayerShopListingParams) (PlayerShopListing, error)
+	CreateProgressionBulkOperation(ctx context.Context, arg CreateProgressionBulkOperationParams) (ProgressionBulkOperation, error)
 	CreateQuest(ctx context.Context, arg CreateQuestParams) (Quest, error)
 	CreateQuestProgress(ctx context.Context, arg CreateQuestProgressParams) (QuestProgress, error)
 	CreateQuestProgressForUser(ctx context.Context, arg CreateQuestProgressForUserParams) (QuestProgress, error)
 	CreateToken(ctx context.Context, arg CreateTokenParams) error
 	CreateTrap(ctx context.Context, arg CreateTrapParams) (UserTrap, error)
-	CreateUnlockProgress(ctx context.Context) (int32, error)
-	CreateUser(ctx context.Context, username string) (uuid.UUID, error)
+	CreateUnlockProgress(ctx context.Context, communityID string) (int32, error)
+	CreateUser(ctx context.Context, arg CreateUserParams) (uuid.UUID, error)
 	CreateUserWithID(ctx context.Context, arg CreateUserWithIDParams) (uuid.UUID, error)
-	CreateVotingSession(ctx context.Context) (int32, error)
+	CreateVotingSession(ctx context.Context, communityID string) (int32, error)
+	CreateWebhook(ctx context.Context, arg CreateWebhookParams) (Webhook, error)
+	DamageBossFight(ctx context.Context, arg DamageBossFightParams) (BossFight, error)
+	// Keeps the given share of every score.
+	DecayContributionScores(ctx context.Context, retained float64) (int64, error)
 	DeclineDuel(ctx context.Context, id uuid.UUID) error
+	// Deepens the community's site, starting it on the first dig.
+	DeepenDigSite(ctx context.Context, arg DeepenDigSiteParams) (DeepenDigSiteRow, error)
 	DeleteAllQuests(ctx context.Context) error
+	DeleteAnnouncementRoute(ctx context.Context, id int64) (int64, error)
+	DeleteContributionScoresBelow(ctx context.Context, score float64) (int64, error)
+	DeleteExpiredCommunityEffects(ctx context.Context, now pgtype.Timestamptz) (int64, error)
+	DeleteExpiredUserEffects(ctx context.Context, now pgtype.Timestamptz) (int64, error)
+	DeleteExpiredUserTimeout(ctx context.Context, arg DeleteExpiredUserTimeoutParams) error
+	DeleteExpiredUserTimeouts(ctx context.Context) error
+	DeleteExpiredUserUndoEntries(ctx context.Context, arg DeleteExpiredUserUndoEntriesParams) error
+	DeleteFeatureFlag(ctx context.Context, key string) (int64, error)
+	DeleteGameSnapshot(ctx context.Context, id int64) (int64, error)
 	DeleteInventory(ctx context.Context, userID uuid.UUID) error
+	DeleteItemAlias(ctx context.Context, internalName string) (int64, error)
+	DeleteItemPriceHistoryBefore(ctx context.Context, recordedAt pgtype.Timestamptz) (int64, error)
+	DeleteOldGiveLog(ctx context.Context, arg DeleteOldGiveLogParams) error
 	DeleteSubscription(ctx context.Context, arg DeleteSubscriptionParams) error
+	DeleteUndoEntry(ctx context.Context, id int64) error
+	// Only changes that have not taken effect can be cancelled
+	DeleteUpcomingItemBalanceChange(ctx context.Context, arg DeleteUpcomingItemBalanceChangeParams) (int64, error)
 	DeleteUser(ctx context.Context, userID uuid.UUID) error
+	DeleteUserBirthday(ctx context.Context, userID uuid.UUID) (int64, error)
 	DeleteUserPlatformLink(ctx context.Context, arg DeleteUserPlatformLinkParams) error
+	DeleteUserReminder(ctx context.Context, arg DeleteUserReminderParams) (int64, error)
+	// Counts only a timeout that was still active
+	DeleteUserTimeout(ctx context.Context, arg DeleteUserTimeoutParams) (int64, error)
+	DeleteVoteDelegation(ctx context.Context, delegatorID string) (int64, error)
+	DeleteWebhook(ctx context.Context, id int64) (int64, error)
+	DepositToBank(ctx context.Context, arg DepositToBankParams) (BankAccount, error)
+	EndBossFight(ctx context.Context, arg EndBossFightParams) error
+	EndGameEvent(ctx context.Context, arg EndGameEventParams) (GameEvent, error)
+	EndStreamSession(ctx context.Context, communityID string) (StreamSession, error)
 	EndVoting(ctx context.Context, arg EndVotingParams) error
 	EndVotingSession(ctx context.Context, arg EndVotingSessionParams) error
+	// Queues a delivery for every webhook subscribed to the event type
+	EnqueueWebhookDeliveries(ctx context.Context, arg EnqueueWebhookDeliveriesParams) (int64, error)
+	EnsureBankAccount(ctx context.Context, userID uuid.UUID) error
 	EnsureInventoryRow(ctx context.Context, arg EnsureInventoryRowParams) error
+	// Only votes in open sessions can be excluded, so a closed tally never changes.
+	ExcludeUserVote(ctx context.Context, arg ExcludeUserVoteParams) (ExcludeUserVoteRow, error)
+	ExpireBossFight(ctx context.Context, id uuid.UUID) (BossFight, error)
 	ExpireDuels(ctx context.Context) error
+	FlagSuspectVote(ctx context.Context, arg FlagSuspectVoteParams) (int64, error)
+	FreezeUser(ctx context.Context, arg FreezeUserParams) (int64, error)
 	FreezeVotingSession(ctx context.Context, id int32) error
+	GetActiveAPITokenByHash(ctx context.Context, tokenHash string) (ApiToken, error)
+	GetActiveBossFight(ctx context.Context, communityID string) (BossFight, error)
 	GetActiveExpedition(ctx context.Context) (Expedition, error)
-	GetActiveGamble(ctx context.Context) (Gamble, error)
-	GetActiveOrFrozenSession(ctx context.Context) (GetActiveOrFrozenSessionRow, error)
+	GetActiveGamble(ctx context.Context, communityID string) (Gamble, error)
+	GetActiveItemLoanForUpdate(ctx context.Context, id int64) (ItemLoan, error)
+	GetActiveOrFrozenSession(ctx context.Context, communityID string) (GetActiveOrFrozenSessionRow, error)
+	GetActivePlayerShopListingForUpdate(ctx context.Context, arg GetActivePlayerShopListingForUpdateParams) (PlayerShopListing, error)
 	GetActiveQuests(ctx context.Context) ([]Quest, error)
 	GetActiveQuestsForWeek(ctx context.Context, arg GetActiveQuestsForWeekParams) ([]Quest, error)
-	GetActiveSession(ctx context.Context) (GetActiveSessionRow, error)
+	GetActiveSession(ctx context.Context, communityID string) (GetActiveSessionRow, error)
 	GetActiveTrap(ctx context.Context, targetID uuid.UUID) (UserTrap, error)
 	GetActiveTrapForUpdate(ctx context.Context, targetID uuid.UUID) (UserTrap, error)
-	GetActiveUnlockProgress(ctx context.Context) (ProgressionUnlockProgress, error)
+	GetActiveUnlockProgress(ctx context.Context, communityID string) (ProgressionUnlockProgress, error)
+	GetActiveUserTimeouts(ctx context.Context) ([]GetActiveUserTimeoutsRow, error)
 	GetActiveVoting(ctx context.Context) (ProgressionVoting, error)
+	GetActiveXPBoost(ctx context.Context, userID uuid.UUID) (float64, error)
 	GetAllBonusModifiers(ctx context.Context) ([]GetAllBonusModifiersRow, error)
 	// Crafting Recipe Repository Queries
 	GetAllCraftingRecipes(ctx context.Context) ([]GetAllCraftingRecipesRow, error)
@@ -82,31 +186,46 @@ type Querier interface {
 	GetAllItemTypes(ctx context.Context) ([]ItemType, error)
 	GetAllItems(ctx context.Context) ([]GetAllItemsRow, error)
 	GetAllJobs(ctx context.Context) ([]Job, error)
+	// Nodes that declare at least one effect
+	GetAllNodeEffects(ctx context.Context) ([]GetAllNodeEffectsRow, error)
 	GetAllNodes(ctx context.Context) ([]GetAllNodesRow, error)
-	GetAllNodesByFeatureKey(ctx context.Context, featureKey string) ([]GetAllNodesByFeatureKeyRow, error)
+	GetAllNodesByFeatureKey(ctx context.Context, arg GetAllNodesByFeatureKeyParams) ([]GetAllNodesByFeatureKeyRow, error)
 	GetAllRecipes(ctx context.Context) ([]GetAllRecipesRow, error)
 	GetAllTiers(ctx context.Context) ([]SubscriptionTier, error)
-	GetAllUnlocks(ctx context.Context) ([]ProgressionUnlock, error)
+	GetAllUnlocks(ctx context.Context, communityID string) ([]ProgressionUnlock, error)
 	GetAssociatedUpgradeRecipeID(ctx context.Context, disassembleRecipeID int32) (int32, error)
+	GetAverageContributionScore(ctx context.Context) (float64, error)
+	GetBankAccount(ctx context.Context, userID uuid.UUID) (BankAccount, error)
+	GetBankAccountForUpdate(ctx context.Context, userID uuid.UUID) (BankAccount, error)
 	GetBonusModifiers(ctx context.Context, featureKey string) ([]GetBonusModifiersRow, error)
-	GetBonusModifiersWithLevel(ctx context.Context, featureKey string) ([]GetBonusModifiersWithLevelRow, error)
+	GetBonusModifiersWithLevel(ctx context.Context, arg GetBonusModifiersWithLevelParams) ([]GetBonusModifiersWithLevelRow, error)
+	GetBorrowedItemQuantity(ctx context.Context, arg GetBorrowedItemQuantityParams) (int32, error)
 	GetBuyablePrices(ctx context.Context) ([]GetBuyablePricesRow, error)
+	GetCelebrationGuild(ctx context.Context, guildID string) (bool, error)
 	GetClaimedTokenForSource(ctx context.Context, arg GetClaimedTokenForSourceParams) (GetClaimedTokenForSourceRow, error)
+	GetCommunityDonationTotals(ctx context.Context, communityID string) (GetCommunityDonationTotalsRow, error)
+	GetCommunityPoolBalance(ctx context.Context, communityID string) (int64, error)
+	GetCompletedSessions(ctx context.Context, arg GetCompletedSessionsParams) ([]GetCompletedSessionsRow, error)
 	// Compost Bin Queries
 	GetCompostBin(ctx context.Context, userID uuid.UUID) (CompostBin, error)
 	GetCompostBinForUpdate(ctx context.Context, userID uuid.UUID) (CompostBin, error)
-	GetContributionLeaderboard(ctx context.Context, limit int32) ([]GetContributionLeaderboardRow, error)
+	GetContributionLeaderboard(ctx context.Context, arg GetContributionLeaderboardParams) ([]GetContributionLeaderboardRow, error)
 	GetCraftingRecipeByKey(ctx context.Context, recipeKey string) (GetCraftingRecipeByKeyRow, error)
 	GetDailyEngagementTotals(ctx context.Context, recordedAt pgtype.Timestamp) ([]GetDailyEngagementTotalsRow, error)
+	GetDigSite(ctx context.Context, communityID string) (GetDigSiteRow, error)
 	GetDisassembleOutputs(ctx context.Context, recipeID int32) ([]GetDisassembleOutputsRow, error)
 	GetDisassembleRecipeByKey(ctx context.Context, recipeKey string) (GetDisassembleRecipeByKeyRow, error)
 	GetDisassembleRecipeBySourceItemID(ctx context.Context, sourceItemID int32) (GetDisassembleRecipeBySourceItemIDRow, error)
 	GetDuel(ctx context.Context, id uuid.UUID) (Duel, error)
 	GetDuelForUpdate(ctx context.Context, id uuid.UUID) (Duel, error)
+	GetEarliestStatsEventTime(ctx context.Context) (pgtype.Timestamp, error)
+	// The change in force for each item and pool: the latest at or before the given time
+	GetEffectiveItemBalanceChanges(ctx context.Context, arg GetEffectiveItemBalanceChangesParams) ([]ItemBalanceChange, error)
 	GetEngagementMetricsAggregated(ctx context.Context) ([]GetEngagementMetricsAggregatedRow, error)
 	GetEngagementMetricsAggregatedSince(ctx context.Context, recordedAt pgtype.Timestamp) ([]GetEngagementMetricsAggregatedSinceRow, error)
 	GetEngagementWeights(ctx context.Context) ([]GetEngagementWeightsRow, error)
 	GetEventCounts(ctx context.Context, arg GetEventCountsParams) ([]GetEventCountsRow, error)
+	GetEventDeadLetter(ctx context.Context, id int64) (EventDeadLetter, error)
 	GetEvents(ctx context.Context, arg GetEventsParams) ([]Event, error)
 	GetEventsByType(ctx context.Context, arg GetEventsByTypeParams) ([]StatsEvent, error)
 	GetEventsByUser(ctx context.Context, arg GetEventsByUserParams) ([]StatsEvent, error)
@@ -114,42 +233,70 @@ type Querier interface {
 	GetExpeditionJournalEntries(ctx context.Context, expeditionID uuid.UUID) ([]ExpeditionJournalEntry, error)
 	GetExpeditionParticipants(ctx context.Context, expeditionID uuid.UUID) ([]GetExpeditionParticipantsRow, error)
 	GetExpiringSubscriptions(ctx context.Context, expiresAt pgtype.Timestamptz) ([]GetExpiringSubscriptionsRow, error)
+	GetFeatureFlag(ctx context.Context, key string) (FeatureFlag, error)
 	GetGamble(ctx context.Context, id uuid.UUID) (Gamble, error)
 	GetGambleParticipants(ctx context.Context, gambleID uuid.UUID) ([]GetGambleParticipantsRow, error)
+	GetGambleSideBets(ctx context.Context, gambleID uuid.UUID) ([]GetGambleSideBetsRow, error)
+	GetGameEvent(ctx context.Context, arg GetGameEventParams) (GameEvent, error)
+	GetGameSnapshotData(ctx context.Context, id int64) ([]byte, error)
 	GetHarvestState(ctx context.Context, dollar_1 uuid.UUID) (HarvestState, error)
 	GetHarvestStateWithLock(ctx context.Context, dollar_1 uuid.UUID) (HarvestState, error)
 	GetInventory(ctx context.Context, userID uuid.UUID) ([]byte, error)
 	GetInventoryForUpdate(ctx context.Context, userID uuid.UUID) ([]byte, error)
+	GetItemAlias(ctx context.Context, internalName string) (ItemAlias, error)
+	// Newest first; an empty item name lists every item
+	GetItemBalanceChanges(ctx context.Context, arg GetItemBalanceChangesParams) ([]ItemBalanceChange, error)
+	// Changes that took effect in (from, to]
+	GetItemBalanceChangesEffectiveBetween(ctx context.Context, arg GetItemBalanceChangesEffectiveBetweenParams) ([]ItemBalanceChange, error)
 	GetItemByID(ctx context.Context, itemID int32) (GetItemByIDRow, error)
 	// Item Repository Queries
 	GetItemByInternalName(ctx context.Context, internalName string) (GetItemByInternalNameRow, error)
 	GetItemByName(ctx context.Context, internalName string) (GetItemByNameRow, error)
 	GetItemByPublicName(ctx context.Context, publicName pgtype.Text) (GetItemByPublicNameRow, error)
+	GetItemMarketPressure(ctx context.Context, arg GetItemMarketPressureParams) (ItemMarketPressure, error)
+	// Newest first; callers reverse for charts
+	GetItemPriceHistory(ctx context.Context, arg GetItemPriceHistoryParams) ([]ItemPriceHistory, error)
 	GetItemsByIDs(ctx context.Context, dollar_1 []int32) ([]GetItemsByIDsRow, error)
 	GetItemsByNames(ctx context.Context, dollar_1 []string) ([]GetItemsByNamesRow, error)
+	GetJackpotBalance(ctx context.Context, communityID string) (int64, error)
 	GetJobByKey(ctx context.Context, jobKey string) (Job, error)
 	GetJobFeatureUnlockConfigs(ctx context.Context) ([]GetJobFeatureUnlockConfigsRow, error)
 	GetJobUnlockConfig(ctx context.Context, featureKey string) (GetJobUnlockConfigRow, error)
 	GetLastCompletedExpedition(ctx context.Context) (Expedition, error)
-	GetLastCooldown(ctx context.Context, arg GetLastCooldownParams) (pgtype.Timestamptz, error)
-	GetLastCooldownForUpdate(ctx context.Context, arg GetLastCooldownForUpdateParams) (pgtype.Timestamptz, error)
 	GetLastDailyResetTime(ctx context.Context) (GetLastDailyResetTimeRow, error)
+	// Locks the user's newest entry that can still be undone
+	GetLatestUndoEntryForUpdate(ctx context.Context, arg GetLatestUndoEntryForUpdateParams) (UndoEntry, error)
+	GetLiveStreamSession(ctx context.Context, communityID string) (StreamSession, error)
 	GetLogEventsByType(ctx context.Context, arg GetLogEventsByTypeParams) ([]Event, error)
 	GetLogEventsByUser(ctx context.Context, arg GetLogEventsByUserParams) ([]Event, error)
-	GetMostRecentSession(ctx context.Context) (GetMostRecentSessionRow, error)
-	GetNodeByFeatureKey(ctx context.Context, featureKey string) (GetNodeByFeatureKeyRow, error)
+	GetLootboxPityCount(ctx context.Context, arg GetLootboxPityCountParams) (int32, error)
+	GetMetricTotalSince(ctx context.Context, arg GetMetricTotalSinceParams) (int64, error)
+	GetModerationActions(ctx context.Context, arg GetModerationActionsParams) ([]ModerationAction, error)
+	GetMostRecentSession(ctx context.Context, communityID string) (GetMostRecentSessionRow, error)
+	GetNodeByFeatureKey(ctx context.Context, arg GetNodeByFeatureKeyParams) (GetNodeByFeatureKeyRow, error)
 	GetNodeByID(ctx context.Context, id int32) (GetNodeByIDRow, error)
 	GetNodeByKey(ctx context.Context, nodeKey string) (GetNodeByKeyRow, error)
 	GetNodeDependents(ctx context.Context, prerequisiteNodeID int32) ([]GetNodeDependentsRow, error)
 	GetNodeDynamicPrerequisites(ctx context.Context, id int32) ([]byte, error)
+	GetNodeEffects(ctx context.Context, id int32) ([]byte, error)
 	GetNodePrerequisites(ctx context.Context, nodeID int32) ([]GetNodePrerequisitesRow, error)
 	GetPendingDuelsForUser(ctx context.Context, opponentID pgtype.UUID) ([]Duel, error)
+	GetPendingGiftForUpdate(ctx context.Context, id int64) (GetPendingGiftForUpdateRow, error)
 	GetPlatformID(ctx context.Context, name string) (int32, error)
+	GetProgressionBulkAudit(ctx context.Context, operationID int64) ([]ProgressionBulkAudit, error)
+	GetProgressionBulkOperation(ctx context.Context, id int64) (ProgressionBulkOperation, error)
+	GetRecentJackpotWins(ctx context.Context, arg GetRecentJackpotWinsParams) ([]GetRecentJackpotWinsRow, error)
+	GetRecentXPAwards(ctx context.Context, arg GetRecentXPAwardsParams) ([]XpAward, error)
 	GetRecentlyActiveUsers(ctx context.Context, limit int32) ([]GetRecentlyActiveUsersRow, error)
 	GetRecipeByTargetItemID(ctx context.Context, targetItemID int32) (GetRecipeByTargetItemIDRow, error)
+	GetScheduledJob(ctx context.Context, name string) (ScheduledJob, error)
+	GetSchemaVersion(ctx context.Context) (int64, error)
 	GetSellablePrices(ctx context.Context) ([]GetSellablePricesRow, error)
 	GetSessionByID(ctx context.Context, id int32) (GetSessionByIDRow, error)
 	GetSessionOptions(ctx context.Context, sessionID int32) ([]GetSessionOptionsRow, error)
+	// Votes in a session, with whether the voter had any recorded activity
+	// before the session started.
+	GetSessionVoteActivity(ctx context.Context, sessionID int32) ([]GetSessionVoteActivityRow, error)
 	GetSessionVoters(ctx context.Context, sessionID int32) ([]string, error)
 	// Get top users by mega jackpots hit for a time period
 	GetSlotsLeaderboardByMegaJackpots(ctx context.Context, arg GetSlotsLeaderboardByMegaJackpotsParams) ([]GetSlotsLeaderboardByMegaJackpotsRow, error)
@@ -157,9 +304,34 @@ type Querier interface {
 	GetSlotsLeaderboardByProfit(ctx context.Context, arg GetSlotsLeaderboardByProfitParams) ([]GetSlotsLeaderboardByProfitRow, error)
 	// Get top users by win rate for a time period (minimum spins required)
 	GetSlotsLeaderboardByWinRate(ctx context.Context, arg GetSlotsLeaderboardByWinRateParams) ([]GetSlotsLeaderboardByWinRateRow, error)
+	// Sums the XP a user earned from a source across all jobs since a point in time
+	GetSourceXPSince(ctx context.Context, arg GetSourceXPSinceParams) (int64, error)
+	GetStaleGambles(ctx context.Context, joinDeadline pgtype.Timestamptz) ([]Gamble, error)
+	// Users who recorded a stats event of the type within the window.
+	GetStatsEventParticipants(ctx context.Context, arg GetStatsEventParticipantsParams) ([]string, error)
+	GetStatsRollupProgress(ctx context.Context, bucket string) (pgtype.Timestamp, error)
+	GetStreamSession(ctx context.Context, arg GetStreamSessionParams) (StreamSession, error)
+	// Totals over the stats events tagged with a session. Chat drops record one
+	// chat_drop event per drop with the quantity in event_data.
+	GetStreamSessionActivity(ctx context.Context, streamSessionID pgtype.Int8) (GetStreamSessionActivityRow, error)
+	// The largest pot won in a session, from the gamble_won stats events
+	// recorded when gambles complete.
+	GetStreamSessionBiggestGambleWin(ctx context.Context, streamSessionID pgtype.Int8) (GetStreamSessionBiggestGambleWinRow, error)
+	GetStreamSessionEventCounts(ctx context.Context, streamSessionID pgtype.Int8) ([]GetStreamSessionEventCountsRow, error)
+	GetStreamSessionGambleStats(ctx context.Context, streamSessionID pgtype.Int8) (GetStreamSessionGambleStatsRow, error)
+	// The user with the most weighted engagement between started_at and ended_at,
+	// leaving out users who hid themselves from leaderboards.
+	GetStreamSessionTopContributor(ctx context.Context, arg GetStreamSessionTopContributorParams) (GetStreamSessionTopContributorRow, error)
+	// Most active users of a session, leaving out users who hid themselves from
+	// leaderboards.
+	GetStreamSessionTopUsers(ctx context.Context, arg GetStreamSessionTopUsersParams) ([]GetStreamSessionTopUsersRow, error)
+	GetStreamSessionUnlocks(ctx context.Context, arg GetStreamSessionUnlocksParams) ([]GetStreamSessionUnlocksRow, error)
+	GetSuspectVotes(ctx context.Context, sessionID int32) ([]GetSuspectVotesRow, error)
 	GetSyncMetadata(ctx context.Context, configName string) (GetSyncMetadataRow, error)
 	GetTierByPlatformAndName(ctx context.Context, arg GetTierByPlatformAndNameParams) (SubscriptionTier, error)
 	GetToken(ctx context.Context, token string) (GetTokenRow, error)
+	GetTopCommunityDonors(ctx context.Context, arg GetTopCommunityDonorsParams) ([]GetTopCommunityDonorsRow, error)
+	GetTopDigSiteDiggers(ctx context.Context, arg GetTopDigSiteDiggersParams) ([]GetTopDigSiteDiggersRow, error)
 	GetTopUsers(ctx context.Context, arg GetTopUsersParams) ([]GetTopUsersRow, error)
 	GetTotalEngagementScore(ctx context.Context) (int64, error)
 	GetTotalEventCount(ctx context.Context, arg GetTotalEventCountParams) (int64, error)
@@ -169,25 +341,55 @@ type Querier interface {
 	GetUnlock(ctx context.Context, arg GetUnlockParams) (ProgressionUnlock, error)
 	GetUnlockedRecipesForUser(ctx context.Context, userID uuid.UUID) ([]GetUnlockedRecipesForUserRow, error)
 	GetUserActiveQuestProgress(ctx context.Context, userID uuid.UUID) ([]GetUserActiveQuestProgressRow, error)
+	GetUserBirthday(ctx context.Context, userID uuid.UUID) (GetUserBirthdayRow, error)
 	GetUserByID(ctx context.Context, userID uuid.UUID) (User, error)
 	GetUserByPlatformID(ctx context.Context, arg GetUserByPlatformIDParams) (GetUserByPlatformIDRow, error)
 	GetUserByPlatformUsername(ctx context.Context, arg GetUserByPlatformUsernameParams) (GetUserByPlatformUsernameRow, error)
+	GetUserContributionScore(ctx context.Context, userID string) (float64, error)
 	GetUserEngagementAggregated(ctx context.Context, userID string) ([]GetUserEngagementAggregatedRow, error)
 	GetUserEventCounts(ctx context.Context, arg GetUserEventCountsParams) ([]GetUserEventCountsRow, error)
 	GetUserEventsByType(ctx context.Context, arg GetUserEventsByTypeParams) ([]StatsEvent, error)
+	GetUserFreeze(ctx context.Context, userID uuid.UUID) (UserFreeze, error)
+	GetUserItemFlags(ctx context.Context, userID uuid.UUID) ([]GetUserItemFlagsRow, error)
 	GetUserJob(ctx context.Context, arg GetUserJobParams) (UserJob, error)
 	GetUserJobs(ctx context.Context, userID uuid.UUID) ([]UserJob, error)
 	GetUserJobsByPlatform(ctx context.Context, arg GetUserJobsByPlatformParams) ([]UserJob, error)
+	GetUserLeaderboardRank(ctx context.Context, arg GetUserLeaderboardRankParams) (int64, error)
 	GetUserPlatformLinks(ctx context.Context, userID uuid.UUID) ([]GetUserPlatformLinksRow, error)
 	GetUserProgressions(ctx context.Context, arg GetUserProgressionsParams) ([]UserProgression, error)
 	GetUserQuestProgress(ctx context.Context, userID uuid.UUID) ([]GetUserQuestProgressRow, error)
+	// Total items crafted by a user per recipe, all time
+	GetUserRecipeCraftCounts(ctx context.Context, userID pgtype.UUID) ([]GetUserRecipeCraftCountsRow, error)
+	GetUserSearchProgress(ctx context.Context, userID uuid.UUID) (UserSearchProgress, error)
+	// Locks the user's vote in the session, if any. Must be used within a transaction.
+	GetUserSessionVoteForUpdate(ctx context.Context, arg GetUserSessionVoteForUpdateParams) (GetUserSessionVoteForUpdateRow, error)
+	GetUserSettings(ctx context.Context, userID uuid.UUID) (GetUserSettingsRow, error)
 	// Calculate aggregate slots statistics for a user within a time period
 	GetUserSlotsStats(ctx context.Context, arg GetUserSlotsStatsParams) (GetUserSlotsStatsRow, error)
 	GetUserSubscription(ctx context.Context, arg GetUserSubscriptionParams) (GetUserSubscriptionRow, error)
 	GetUserSubscriptionHistory(ctx context.Context, arg GetUserSubscriptionHistoryParams) ([]SubscriptionHistory, error)
 	GetUserSubscriptions(ctx context.Context, userID uuid.UUID) ([]GetUserSubscriptionsRow, error)
+	GetUserTimeout(ctx context.Context, arg GetUserTimeoutParams) (pgtype.Timestamptz, error)
+	// Options in open voting sessions whose stored vote_count disagrees with the
+	// number of recorded, non-excluded user votes.
+	GetVoteCountDrift(ctx context.Context) ([]GetVoteCountDriftRow, error)
+	GetVoteDelegation(ctx context.Context, delegatorID string) (VoteDelegation, error)
+	GetVoteDelegators(ctx context.Context, delegateID string) ([]string, error)
+	GetVoteReviewAudit(ctx context.Context, sessionID int32) ([]VoteReviewAudit, error)
 	GetVoting(ctx context.Context, arg GetVotingParams) (ProgressionVoting, error)
 	GetWeeklyQuestResetState(ctx context.Context) (WeeklyQuestResetState, error)
+	// Grants a community-wide effect. While one of the same type is active the
+	// stronger magnitude is kept and the duration is added to the current
+	// expiry; an expired effect is replaced.
+	GrantCommunityEffect(ctx context.Context, arg GrantCommunityEffectParams) (CommunityEffect, error)
+	// Grants an effect. While one of the same type is active the stronger
+	// magnitude is kept and the duration is added to the current expiry; an
+	// expired effect is replaced.
+	GrantUserEffect(ctx context.Context, arg GrantUserEffectParams) (UserEffect, error)
+	// Grants a boost. While one is active the higher multiplier is kept and the
+	// duration is added to the current expiry; an expired boost is replaced.
+	GrantXPBoost(ctx context.Context, arg GrantXPBoostParams) error
+	HasGivenSince(ctx context.Context, arg HasGivenSinceParams) (bool, error)
 	HasUserVoted(ctx context.Context, arg HasUserVotedParams) (bool, error)
 	// Read-only check for whether a user has voted in a session.
 	// Does NOT prevent concurrent votes - use HasUserVotedInSessionForUpdate for that.
@@ -202,47 +404,156 @@ type Querier interface {
 	IncrementQuestProgress(ctx context.Context, arg IncrementQuestProgressParams) error
 	IncrementVote(ctx context.Context, arg IncrementVoteParams) error
 	InsertBonusModifier(ctx context.Context, arg InsertBonusModifierParams) error
+	InsertCommunityDonation(ctx context.Context, arg InsertCommunityDonationParams) error
 	InsertCraftingRecipe(ctx context.Context, arg InsertCraftingRecipeParams) (int32, error)
 	InsertDisassembleOutput(ctx context.Context, arg InsertDisassembleOutputParams) error
 	InsertDisassembleRecipe(ctx context.Context, arg InsertDisassembleRecipeParams) (int32, error)
+	InsertEventDeadLetter(ctx context.Context, arg InsertEventDeadLetterParams) (int64, error)
+	InsertGiveFlag(ctx context.Context, arg InsertGiveFlagParams) (int64, error)
+	InsertGiveLog(ctx context.Context, arg InsertGiveLogParams) error
 	InsertItem(ctx context.Context, arg InsertItemParams) (int32, error)
+	InsertItemPriceHistory(ctx context.Context, arg InsertItemPriceHistoryParams) error
 	InsertItemType(ctx context.Context, typeName string) (int32, error)
-	InsertNextUnlockProgress(ctx context.Context, contributionsAccumulated int32) (int32, error)
+	InsertJackpotWin(ctx context.Context, arg InsertJackpotWinParams) error
+	InsertModerationAction(ctx context.Context, arg InsertModerationActionParams) error
+	InsertNextUnlockProgress(ctx context.Context, arg InsertNextUnlockProgressParams) (int32, error)
 	InsertNode(ctx context.Context, arg InsertNodeParams) (int32, error)
 	InsertNodePrerequisite(ctx context.Context, arg InsertNodePrerequisiteParams) error
+	InsertProgressionBulkAudit(ctx context.Context, arg InsertProgressionBulkAuditParams) error
+	InsertUndoEntry(ctx context.Context, arg InsertUndoEntryParams) error
+	InsertVoteReviewAudit(ctx context.Context, arg InsertVoteReviewAuditParams) error
 	InvalidateTokensForSource(ctx context.Context, arg InvalidateTokensForSourceParams) error
 	IsItemBuyable(ctx context.Context, internalName string) (bool, error)
 	IsNodeUnlocked(ctx context.Context, arg IsNodeUnlockedParams) (bool, error)
+	IsPlatformUserFrozen(ctx context.Context, arg IsPlatformUserFrozenParams) (bool, error)
 	IsRecipeUnlocked(ctx context.Context, arg IsRecipeUnlockedParams) (pgtype.Bool, error)
+	IsUserItemLocked(ctx context.Context, arg IsUserItemLockedParams) (bool, error)
 	IsUserProgressionUnlocked(ctx context.Context, arg IsUserProgressionUnlockedParams) (bool, error)
 	JoinGamble(ctx context.Context, arg JoinGambleParams) error
+	// Newest first; an empty guild ID lists every guild's tokens
+	ListAPITokens(ctx context.Context, guildID string) ([]ApiToken, error)
+	ListActiveCommunityEffects(ctx context.Context, communityID string) ([]CommunityEffect, error)
+	ListActivePlayerShopListings(ctx context.Context, arg ListActivePlayerShopListingsParams) ([]ListActivePlayerShopListingsRow, error)
+	ListActiveUserEffects(ctx context.Context, userID uuid.UUID) ([]UserEffect, error)
+	// Users registered on this month and day of an earlier year
+	ListAnniversaryUsers(ctx context.Context, arg ListAnniversaryUsersParams) ([]ListAnniversaryUsersRow, error)
+	ListAnnouncementRoutes(ctx context.Context) ([]AnnouncementRoute, error)
+	ListBirthdayUsers(ctx context.Context, arg ListBirthdayUsersParams) ([]ListBirthdayUsersRow, error)
+	ListBossAttackers(ctx context.Context, arg ListBossAttackersParams) ([]ListBossAttackersRow, error)
+	// Gifts whose recipient has been deleted are due straight away.
+	ListDueGiftIDs(ctx context.Context, arg ListDueGiftIDsParams) ([]int64, error)
+	// Loans whose borrower has been deleted are due straight away.
+	ListDueItemLoanIDs(ctx context.Context, arg ListDueItemLoanIDsParams) ([]int64, error)
+	ListEnabledAnnouncementRoutesForEvent(ctx context.Context, eventType string) ([]AnnouncementRoute, error)
+	ListEventDeadLetters(ctx context.Context, arg ListEventDeadLettersParams) ([]EventDeadLetter, error)
+	ListFeatureFlags(ctx context.Context) ([]FeatureFlag, error)
+	ListGameEvents(ctx context.Context, arg ListGameEventsParams) ([]GameEvent, error)
+	ListGameSnapshots(ctx context.Context) ([]ListGameSnapshotsRow, error)
+	ListGardenPlots(ctx context.Context, userID uuid.UUID) ([]GardenPlot, error)
+	// Newest first, with the names admins need to judge the flag
+	ListGiveFlags(ctx context.Context, arg ListGiveFlagsParams) ([]ListGiveFlagsRow, error)
+	ListInboxMessages(ctx context.Context, arg ListInboxMessagesParams) ([]InboxMessage, error)
+	ListItemAliases(ctx context.Context) ([]ItemAlias, error)
+	// Every traded item with its base value and most recently recorded multiplier
+	ListItemMarketStates(ctx context.Context, communityID string) ([]ListItemMarketStatesRow, error)
+	ListSenderPendingGifts(ctx context.Context, senderID uuid.UUID) ([]ListSenderPendingGiftsRow, error)
+	ListUserActiveItemLoans(ctx context.Context, lenderID uuid.UUID) ([]ListUserActiveItemLoansRow, error)
+	ListUserReminders(ctx context.Context, userID uuid.UUID) ([]Reminder, error)
+	ListWebhookDeliveries(ctx context.Context, arg ListWebhookDeliveriesParams) ([]WebhookDelivery, error)
+	ListWebhooks(ctx context.Context) ([]Webhook, error)
+	LockActiveBossFight(ctx context.Context, communityID string) (BossFight, error)
+	// Serialises capped inserts for one metric type until the transaction ends.
+	LockMetricType(ctx context.Context, metricType string) error
 	LogEvent(ctx context.Context, arg LogEventParams) error
+	MarkAllInboxMessagesRead(ctx context.Context, arg MarkAllInboxMessagesReadParams) (int64, error)
+	MarkInboxMessagesRead(ctx context.Context, arg MarkInboxMessagesReadParams) (int64, error)
 	MarkSubscriptionExpired(ctx context.Context, arg MarkSubscriptionExpiredParams) error
+	// Locks the gamble so the bet is either seen by the execution that claims it
+	// or refused because the gamble stopped accepting bets
+	PlaceGambleSideBet(ctx context.Context, arg PlaceGambleSideBetParams) (int64, error)
+	PlantGardenPlot(ctx context.Context, arg PlantGardenPlotParams) (int32, error)
+	// Resets a job at or above the level cap and counts the prestige. No row is
+	// returned when the job is below min_level.
+	PrestigeUserJob(ctx context.Context, arg PrestigeUserJobParams) (int32, error)
+	PruneGameSnapshots(ctx context.Context, arg PruneGameSnapshotsParams) (int64, error)
+	// Same as QueryStatsRollups but counts raw events, for the part of a range
+	// that has not been rolled up yet
+	QueryStatsEvents(ctx context.Context, arg QueryStatsEventsParams) ([]QueryStatsEventsRow, error)
+	// Sums rolled-up counts grouped by whichever of bucket start, user and event
+	// type are asked for; the others come back NULL
+	QueryStatsRollups(ctx context.Context, arg QueryStatsRollupsParams) ([]QueryStatsRollupsRow, error)
+	// Delegated votes never replace a vote already recorded for the delegator.
+	RecordDelegatedVote(ctx context.Context, arg RecordDelegatedVoteParams) (int64, error)
 	RecordEngagement(ctx context.Context, arg RecordEngagementParams) error
 	RecordEvent(ctx context.Context, arg RecordEventParams) (RecordEventRow, error)
+	RecordEventDeadLetterAttempt(ctx context.Context, arg RecordEventDeadLetterAttemptParams) error
+	// Decays the stored pressure to now before adding the trade
+	RecordItemTrade(ctx context.Context, arg RecordItemTradeParams) error
+	RecordProgressionBulkBatch(ctx context.Context, arg RecordProgressionBulkBatchParams) error
 	RecordReset(ctx context.Context, arg RecordResetParams) error
 	RecordSubscriptionHistory(ctx context.Context, arg RecordSubscriptionHistoryParams) error
+	// Counts a search on search_date, extending the streak when the previous
+	// search was the day before and restarting it after a missed day. Returns
+	// the date of the search before this one (NULL for a first search).
+	RecordUserSearch(ctx context.Context, arg RecordUserSearchParams) (RecordUserSearchRow, error)
 	RecordUserSessionVote(ctx context.Context, arg RecordUserSessionVoteParams) error
 	RecordUserVote(ctx context.Context, arg RecordUserVoteParams) error
+	RecordWebhookDeliveryAttempt(ctx context.Context, arg RecordWebhookDeliveryAttemptParams) error
+	RecordXPAward(ctx context.Context, arg RecordXPAwardParams) error
+	ReduceUserTimeout(ctx context.Context, arg ReduceUserTimeoutParams) (pgtype.Timestamptz, error)
+	ReleaseCelebrationGrant(ctx context.Context, arg ReleaseCelebrationGrantParams) error
+	ReleaseMonetizationEvent(ctx context.Context, arg ReleaseMonetizationEventParams) error
 	RelockNode(ctx context.Context, arg RelockNodeParams) error
+	// A user's own vote replaces the one a delegate cast for them.
+	ReplaceDelegatedVote(ctx context.Context, arg ReplaceDelegatedVoteParams) error
 	ResetCompostBin(ctx context.Context, userID uuid.UUID) error
 	ResetDailyJobXP(ctx context.Context) (pgconn.CommandTag, error)
+	RestoreUserVote(ctx context.Context, arg RestoreUserVoteParams) (RestoreUserVoteRow, error)
 	ResumeVotingSession(ctx context.Context, id int32) error
+	// Only pending flags can be reviewed, so a decision is never overwritten
+	ReviewGiveFlag(ctx context.Context, arg ReviewGiveFlagParams) (int64, error)
+	RevokeAPIToken(ctx context.Context, id int64) (int64, error)
+	// Recounts every bucket between from_time and to_time, which must fall on
+	// bucket boundaries, so rolling up the same range twice is safe
+	RollUpStatsEvents(ctx context.Context, arg RollUpStatsEventsParams) (int64, error)
 	SaveExpeditionJournalEntry(ctx context.Context, arg SaveExpeditionJournalEntryParams) error
 	SaveExpeditionParticipantRewards(ctx context.Context, arg SaveExpeditionParticipantRewardsParams) error
 	SaveOpenedItem(ctx context.Context, arg SaveOpenedItemParams) error
+	// Gives a community the unlocks every tree starts with, copied from the
+	// default community
+	SeedCommunityAutoUnlocks(ctx context.Context, communityID string) (int64, error)
+	SetActiveJob(ctx context.Context, arg SetActiveJobParams) error
+	SetBankGoal(ctx context.Context, arg SetBankGoalParams) (BankAccount, error)
+	SetBossAttackerRewards(ctx context.Context, arg SetBossAttackerRewardsParams) error
+	SetDigSiteMilestoneLevel(ctx context.Context, arg SetDigSiteMilestoneLevelParams) error
+	SetItemBaseValue(ctx context.Context, arg SetItemBaseValueParams) (int64, error)
+	SetOptionVoteCount(ctx context.Context, arg SetOptionVoteCountParams) error
+	SetProgressionBulkStatus(ctx context.Context, arg SetProgressionBulkStatusParams) error
+	SetStatsRollupProgress(ctx context.Context, arg SetStatsRollupProgressParams) error
 	SetUnlockTarget(ctx context.Context, arg SetUnlockTargetParams) error
+	SettleGambleSideBet(ctx context.Context, arg SettleGambleSideBetParams) error
+	// Opens a session unless the community already has a live one, in which
+	// case no row is returned.
+	StartStreamSession(ctx context.Context, arg StartStreamSessionParams) (StreamSession, error)
 	StartVoting(ctx context.Context, arg StartVotingParams) error
+	TakeFishingCast(ctx context.Context, userID uuid.UUID) (FishingCast, error)
+	TakeReadyGardenPlots(ctx context.Context, arg TakeReadyGardenPlotsParams) ([]GardenPlot, error)
+	TouchAPIToken(ctx context.Context, id int64) error
 	TriggerTrap(ctx context.Context, id uuid.UUID) error
+	// Drops a user's messages beyond the newest keep
+	TrimInboxMessages(ctx context.Context, arg TrimInboxMessagesParams) error
+	UnfreezeUser(ctx context.Context, userID uuid.UUID) (int64, error)
 	UnlockNode(ctx context.Context, arg UnlockNodeParams) error
 	UnlockRecipe(ctx context.Context, arg UnlockRecipeParams) error
 	UnlockUserProgression(ctx context.Context, arg UnlockUserProgressionParams) error
+	UpdateAnnouncementRoute(ctx context.Context, arg UpdateAnnouncementRouteParams) (AnnouncementRoute, error)
 	UpdateCompostBin(ctx context.Context, arg UpdateCompostBinParams) error
 	UpdateCooldown(ctx context.Context, arg UpdateCooldownParams) error
 	UpdateCraftingRecipe(ctx context.Context, arg UpdateCraftingRecipeParams) error
 	UpdateDailyResetTime(ctx context.Context, arg UpdateDailyResetTimeParams) error
 	UpdateDisassembleRecipe(ctx context.Context, arg UpdateDisassembleRecipeParams) error
 	UpdateDuelState(ctx context.Context, arg UpdateDuelStateParams) error
+	UpdateEventDeadLetterStatus(ctx context.Context, arg UpdateEventDeadLetterStatusParams) error
 	UpdateExpeditionParticipantResults(ctx context.Context, arg UpdateExpeditionParticipantResultsParams) error
 	UpdateExpeditionState(ctx context.Context, arg UpdateExpeditionStateParams) error
 	UpdateExpeditionStateIfMatches(ctx context.Context, arg UpdateExpeditionStateIfMatchesParams) (pgconn.CommandTag, error)
@@ -254,16 +565,37 @@ type Querier interface {
 	UpdateNode(ctx context.Context, arg UpdateNodeParams) error
 	UpdateNodeCost(ctx context.Context, arg UpdateNodeCostParams) error
 	UpdateNodeDynamicPrerequisites(ctx context.Context, arg UpdateNodeDynamicPrerequisitesParams) error
+	UpdateNodeEffects(ctx context.Context, arg UpdateNodeEffectsParams) error
 	UpdateOptionLastHighest(ctx context.Context, id int32) error
+	UpdatePlayerShopListing(ctx context.Context, arg UpdatePlayerShopListingParams) error
 	UpdateToken(ctx context.Context, arg UpdateTokenParams) error
 	UpdateUser(ctx context.Context, arg UpdateUserParams) error
+	UpdateUserSearchMasteryLevel(ctx context.Context, arg UpdateUserSearchMasteryLevelParams) error
 	UpdateUserTimestamp(ctx context.Context, userID uuid.UUID) error
 	UpdateWeeklyQuestResetState(ctx context.Context, arg UpdateWeeklyQuestResetStateParams) error
+	UpsertBossAttacker(ctx context.Context, arg UpsertBossAttackerParams) error
+	UpsertCelebrationGuild(ctx context.Context, arg UpsertCelebrationGuildParams) error
+	UpsertDigSiteDigger(ctx context.Context, arg UpsertDigSiteDiggerParams) error
+	UpsertFeatureFlag(ctx context.Context, arg UpsertFeatureFlagParams) (FeatureFlag, error)
+	UpsertItemAlias(ctx context.Context, arg UpsertItemAliasParams) (ItemAlias, error)
+	UpsertLootboxPityCount(ctx context.Context, arg UpsertLootboxPityCountParams) error
 	UpsertRecipeAssociation(ctx context.Context, arg UpsertRecipeAssociationParams) error
+	UpsertReminder(ctx context.Context, arg UpsertReminderParams) (Reminder, error)
+	UpsertScheduledJob(ctx context.Context, arg UpsertScheduledJobParams) error
 	UpsertSubscription(ctx context.Context, arg UpsertSubscriptionParams) error
 	UpsertSyncMetadata(ctx context.Context, arg UpsertSyncMetadataParams) error
+	UpsertUserBirthday(ctx context.Context, arg UpsertUserBirthdayParams) error
+	UpsertUserItemFavorite(ctx context.Context, arg UpsertUserItemFavoriteParams) error
+	UpsertUserItemLocked(ctx context.Context, arg UpsertUserItemLockedParams) error
 	UpsertUserJob(ctx context.Context, arg UpsertUserJobParams) error
+	UpsertUserLeaderboardPrivate(ctx context.Context, arg UpsertUserLeaderboardPrivateParams) error
+	UpsertUserNameTheme(ctx context.Context, arg UpsertUserNameThemeParams) error
 	UpsertUserPlatformLink(ctx context.Context, arg UpsertUserPlatformLinkParams) error
+	UpsertUserTargetingOptOut(ctx context.Context, arg UpsertUserTargetingOptOutParams) error
+	UpsertVoteDelegation(ctx context.Context, arg UpsertVoteDelegationParams) error
+	WebhookExists(ctx context.Context, id int64) (bool, error)
+	WithdrawFromBank(ctx context.Context, arg WithdrawFromBankParams) (BankAccount, error)
+	WithdrawFromCommunityPool(ctx context.Context, arg WithdrawFromCommunityPoolParams) (int64, error)
 }
 
 var _ Querier = (*Queries)(nil)
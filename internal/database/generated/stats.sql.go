@@ -12,15 +12,17 @@ import (
 )
 
 const getEventCounts = `-- name: GetEventCounts :many
-SELECT event_type, COUNT(*) as count
-FROM stats_events
-WHERE created_at >= $1 AND created_at <= $2
-GROUP BY event_type
+SELECT se.event_type, COUNT(*) as count
+FROM stats_events se
+JOIN users u ON se.user_id = u.user_id
+WHERE se.created_at >= $1 AND se.created_at <= $2 AND u.community_id = $3
+GROUP BY se.event_type
 `
 
 type GetEventCountsParams struct {
 	CreatedAt   pgtype.Timestamp `json:"created_at"`
 	CreatedAt_2 pgtype.Timestamp `json:"created_at_2"`
+	CommunityID string           `json:"community_id"`
 }
 
 type GetEventCountsRow struct {
@@ -29,7 +31,7 @@ type GetEventCountsRow struct {
 }
 
 func (q *Queries) GetEventCounts(ctx context.Context, arg GetEventCountsParams) ([]GetEventCountsRow, error) {
-	rows, err := q.db.Query(ctx, getEventCounts, arg.CreatedAt, arg.CreatedAt_2)
+	rows, err := q.db.Query(ctx, getEventCounts, arg.CreatedAt, arg.CreatedAt_2, arg.CommunityID)
 	if err != nil {
 		return nil, err
 	}
@@ -49,7 +51,7 @@ func (q *Queries) GetEventCounts(ctx context.Context, arg GetEventCountsParams)
 }
 
 const getEventsByType = `-- name: GetEventsByType :many
-SELECT event_id, user_id, event_type, event_data, created_at
+SELECT event_id, user_id, event_type, event_data, created_at, stream_session_id
 FROM stats_events
 WHERE event_type = $1 AND created_at >= $2 AND created_at <= $3
 ORDER BY created_at DESC
@@ -76,6 +78,7 @@ func (q *Queries) GetEventsByType(ctx context.Context, arg GetEventsByTypeParams
 			&i.EventType,
 			&i.EventData,
 			&i.CreatedAt,
+			&i.StreamSessionID,
 		); err != nil {
 			return nil, err
 		}
@@ -88,7 +91,7 @@ func (q *Queries) GetEventsByType(ctx context.Context, arg GetEventsByTypeParams
 }
 
 const getEventsByUser = `-- name: GetEventsByUser :many
-SELECT event_id, user_id, event_type, event_data, created_at
+SELECT event_id, user_id, event_type, event_data, created_at, stream_session_id
 FROM stats_events
 WHERE user_id = $1 AND created_at >= $2 AND created_at <= $3
 ORDER BY created_at DESC
@@ -115,6 +118,7 @@ func (q *Queries) GetEventsByUser(ctx context.Context, arg GetEventsByUserParams
 			&i.EventType,
 			&i.EventData,
 			&i.CreatedAt,
+			&i.StreamSessionID,
 		); err != nil {
 			return nil, err
 		}
@@ -127,13 +131,14 @@ func (q *Queries) GetEventsByUser(ctx context.Context, arg GetEventsByUserParams
 }
 
 const getTopUsers = `-- name: GetTopUsers :many
-SELECT se.user_id, u.username, COUNT(*) as event_count
+SELECT se.user_id, u.username, COUNT(*) as event_count, COALESCE(us.leaderboard_private, FALSE)::boolean as is_private
 FROM stats_events se
 JOIN users u ON se.user_id = u.user_id
-WHERE se.event_type = $1 AND se.created_at >= $2 AND se.created_at <= $3
-GROUP BY se.user_id, u.username
-ORDER BY event_count DESC
-LIMIT $4
+LEFT JOIN user_settings us ON us.user_id = se.user_id
+WHERE se.event_type = $1 AND se.created_at >= $2 AND se.created_at <= $3 AND u.community_id = $6
+GROUP BY se.user_id, u.username, us.leaderboard_private
+ORDER BY event_count DESC, se.user_id
+LIMIT $4 OFFSET $5
 `
 
 type GetTopUsersParams struct {
@@ -141,12 +146,15 @@ type GetTopUsersParams struct {
 	CreatedAt   pgtype.Timestamp `json:"created_at"`
 	CreatedAt_2 pgtype.Timestamp `json:"created_at_2"`
 	Limit       int32            `json:"limit"`
+	Offset      int32            `json:"offset"`
+	CommunityID string           `json:"community_id"`
 }
 
 type GetTopUsersRow struct {
 	UserID     pgtype.UUID `json:"user_id"`
 	Username   string      `json:"username"`
 	EventCount int64       `json:"event_count"`
+	IsPrivate  bool        `json:"is_private"`
 }
 
 func (q *Queries) GetTopUsers(ctx context.Context, arg GetTopUsersParams) ([]GetTopUsersRow, error) {
@@ -155,6 +163,8 @@ func (q *Queries) GetTopUsers(ctx context.Context, arg GetTopUsersParams) ([]Get
 		arg.CreatedAt,
 		arg.CreatedAt_2,
 		arg.Limit,
+		arg.Offset,
+		arg.CommunityID,
 	)
 	if err != nil {
 		return nil, err
@@ -163,7 +173,12 @@ func (q *Queries) GetTopUsers(ctx context.Context, arg GetTopUsersParams) ([]Get
 	var items []GetTopUsersRow
 	for rows.Next() {
 		var i GetTopUsersRow
-		if err := rows.Scan(&i.UserID, &i.Username, &i.EventCount); err != nil {
+		if err := rows.Scan(
+			&i.UserID,
+			&i.Username,
+			&i.EventCount,
+			&i.IsPrivate,
+		); err != nil {
 			return nil, err
 		}
 		items = append(items, i)
@@ -176,17 +191,19 @@ func (q *Queries) GetTopUsers(ctx context.Context, arg GetTopUsersParams) ([]Get
 
 const getTotalEventCount = `-- name: GetTotalEventCount :one
 SELECT COUNT(*)
-FROM stats_events
-WHERE created_at >= $1 AND created_at <= $2
+FROM stats_events se
+JOIN users u ON se.user_id = u.user_id
+WHERE se.created_at >= $1 AND se.created_at <= $2 AND u.community_id = $3
 `
 
 type GetTotalEventCountParams struct {
 	CreatedAt   pgtype.Timestamp `json:"created_at"`
 	CreatedAt_2 pgtype.Timestamp `json:"created_at_2"`
+	CommunityID string           `json:"community_id"`
 }
 
 func (q *Queries) GetTotalEventCount(ctx context.Context, arg GetTotalEventCountParams) (int64, error) {
-	row := q.db.QueryRow(ctx, getTotalEventCount, arg.CreatedAt, arg.CreatedAt_2)
+	row := q.db.QueryRow(ctx, getTotalEventCount, arg.CreatedAt, arg.CreatedAt_2, arg.CommunityID)
 	var count int64
 	err := row.Scan(&count)
 	return count, err
@@ -231,7 +248,7 @@ func (q *Queries) GetUserEventCounts(ctx context.Context, arg GetUserEventCounts
 }
 
 const getUserEventsByType = `-- name: GetUserEventsByType :many
-SELECT event_id, user_id, event_type, event_data, created_at
+SELECT event_id, user_id, event_type, event_data, created_at, stream_session_id
 FROM stats_events
 WHERE user_id = $1 AND event_type = $2
 ORDER BY created_at DESC
@@ -259,6 +276,7 @@ func (q *Queries) GetUserEventsByType(ctx context.Context, arg GetUserEventsByTy
 			&i.EventType,
 			&i.EventData,
 			&i.CreatedAt,
+			&i.StreamSessionID,
 		); err != nil {
 			return nil, err
 		}
@@ -270,9 +288,86 @@ func (q *Queries) GetUserEventsByType(ctx context.Context, arg GetUserEventsByTy
 	return items, nil
 }
 
+const getUserLeaderboardRank = `-- name: GetUserLeaderboardRank :one
+WITH counts AS (
+    SELECT se.user_id, COUNT(*) AS event_count
+    FROM stats_events se
+    JOIN users u ON se.user_id = u.user_id
+    WHERE se.event_type = $1 AND se.created_at >= $2 AND se.created_at <= $3 AND u.community_id = $5
+    GROUP BY se.user_id
+)
+SELECT (
+    SELECT COUNT(*) + 1
+    FROM counts c
+    WHERE c.event_count > t.event_count
+       OR (c.event_count = t.event_count AND c.user_id < t.user_id)
+)::bigint AS rank
+FROM counts t
+WHERE t.user_id = $4
+`
+
+type GetUserLeaderboardRankParams struct {
+	EventType   string           `json:"event_type"`
+	CreatedAt   pgtype.Timestamp `json:"created_at"`
+	CreatedAt_2 pgtype.Timestamp `json:"created_at_2"`
+	UserID      pgtype.UUID      `json:"user_id"`
+	CommunityID string           `json:"community_id"`
+}
+
+func (q *Queries) GetUserLeaderboardRank(ctx context.Context, arg GetUserLeaderboardRankParams) (int64, error) {
+	row := q.db.QueryRow(ctx, getUserLeaderboardRank,
+		arg.EventType,
+		arg.CreatedAt,
+		arg.CreatedAt_2,
+		arg.UserID,
+		arg.CommunityID,
+	)
+	var rank int64
+	err := row.Scan(&rank)
+	return rank, err
+}
+
+const getUserRecipeCraftCounts = `-- name: GetUserRecipeCraftCounts :many
+SELECT
+    (event_data->>'recipe_key')::text AS recipe_key,
+    COALESCE(SUM((event_data->>'quantity')::int), 0)::bigint AS crafts
+FROM stats_events
+WHERE user_id = $1 AND event_type = 'recipe_crafted'
+GROUP BY event_data->>'recipe_key'
+`
+
+type GetUserRecipeCraftCountsRow struct {
+	RecipeKey string `json:"recipe_key"`
+	Crafts    int64  `json:"crafts"`
+}
+
+// Total items crafted by a user per recipe, all time
+func (q *Queries) GetUserRecipeCraftCounts(ctx context.Context, userID pgtype.UUID) ([]GetUserRecipeCraftCountsRow, error) {
+	rows, err := q.db.Query(ctx, getUserRecipeCraftCounts, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetUserRecipeCraftCountsRow
+	for rows.Next() {
+		var i GetUserRecipeCraftCountsRow
+		if err := rows.Scan(&i.RecipeKey, &i.Crafts); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const recordEvent = `-- name: RecordEvent :one
-INSERT INTO stats_events (user_id, event_type, event_data, created_at)
-VALUES ($1, $2, $3, $4)
+INSERT INTO stats_events (user_id, event_type, event_data, created_at, stream_session_id)
+VALUES ($1, $2, $3, $4,
+        (SELECT s.id FROM stream_sessions s
+         JOIN users u ON u.community_id = s.community_id
+         WHERE u.user_id = $1 AND s.ended_at IS NULL))
 RETURNING event_id, created_at
 `
 
@@ -15,20 +15,24 @@ const getSlotsLeaderboardByMegaJackpots = `-- name: GetSlotsLeaderboardByMegaJac
 SELECT
     se.user_id,
     u.username,
-    COUNT(*) as mega_jackpots_hit
+    COUNT(*) as mega_jackpots_hit,
+    COALESCE(us.leaderboard_private, FALSE)::boolean as is_private
 FROM stats_events se
 JOIN users u ON se.user_id = u.user_id
+LEFT JOIN user_settings us ON us.user_id = se.user_id
 WHERE se.event_type = 'slots_mega_jackpot'
   AND se.created_at >= $1
   AND se.created_at <= $2
-GROUP BY se.user_id, u.username
+  AND u.community_id = $3
+GROUP BY se.user_id, u.username, us.leaderboard_private
 ORDER BY mega_jackpots_hit DESC
-LIMIT $3
+LIMIT $4
 `
 
 type GetSlotsLeaderboardByMegaJackpotsParams struct {
 	StartTime   pgtype.Timestamp `json:"start_time"`
 	EndTime     pgtype.Timestamp `json:"end_time"`
+	CommunityID string           `json:"community_id"`
 	ResultLimit int32            `json:"result_limit"`
 }
 
@@ -36,11 +40,12 @@ type GetSlotsLeaderboardByMegaJackpotsRow struct {
 	UserID          pgtype.UUID `json:"user_id"`
 	Username        string      `json:"username"`
 	MegaJackpotsHit int64       `json:"mega_jackpots_hit"`
+	IsPrivate       bool        `json:"is_private"`
 }
 
 // Get top users by mega jackpots hit for a time period
 func (q *Queries) GetSlotsLeaderboardByMegaJackpots(ctx context.Context, arg GetSlotsLeaderboardByMegaJackpotsParams) ([]GetSlotsLeaderboardByMegaJackpotsRow, error) {
-	rows, err := q.db.Query(ctx, getSlotsLeaderboardByMegaJackpots, arg.StartTime, arg.EndTime, arg.ResultLimit)
+	rows, err := q.db.Query(ctx, getSlotsLeaderboardByMegaJackpots, arg.StartTime, arg.EndTime, arg.CommunityID, arg.ResultLimit)
 	if err != nil {
 		return nil, err
 	}
@@ -48,7 +53,12 @@ func (q *Queries) GetSlotsLeaderboardByMegaJackpots(ctx context.Context, arg Get
 	var items []GetSlotsLeaderboardByMegaJackpotsRow
 	for rows.Next() {
 		var i GetSlotsLeaderboardByMegaJackpotsRow
-		if err := rows.Scan(&i.UserID, &i.Username, &i.MegaJackpotsHit); err != nil {
+		if err := rows.Scan(
+			&i.UserID,
+			&i.Username,
+			&i.MegaJackpotsHit,
+			&i.IsPrivate,
+		); err != nil {
 			return nil, err
 		}
 		items = append(items, i)
@@ -64,20 +74,24 @@ SELECT
     se.user_id,
     u.username,
     COALESCE(SUM((se.event_data->>'payout_amount')::int) - SUM((se.event_data->>'bet_amount')::int), 0) as net_profit,
-    COUNT(*) FILTER (WHERE se.event_type = 'slots_spin') as total_spins
+    COUNT(*) FILTER (WHERE se.event_type = 'slots_spin') as total_spins,
+    COALESCE(us.leaderboard_private, FALSE)::boolean as is_private
 FROM stats_events se
 JOIN users u ON se.user_id = u.user_id
+LEFT JOIN user_settings us ON us.user_id = se.user_id
 WHERE se.event_type = 'slots_spin'
   AND se.created_at >= $1
   AND se.created_at <= $2
-GROUP BY se.user_id, u.username
+  AND u.community_id = $3
+GROUP BY se.user_id, u.username, us.leaderboard_private
 ORDER BY net_profit DESC
-LIMIT $3
+LIMIT $4
 `
 
 type GetSlotsLeaderboardByProfitParams struct {
 	StartTime   pgtype.Timestamp `json:"start_time"`
 	EndTime     pgtype.Timestamp `json:"end_time"`
+	CommunityID string           `json:"community_id"`
 	ResultLimit int32            `json:"result_limit"`
 }
 
@@ -86,11 +100,12 @@ type GetSlotsLeaderboardByProfitRow struct {
 	Username   string      `json:"username"`
 	NetProfit  interface{} `json:"net_profit"`
 	TotalSpins int64       `json:"total_spins"`
+	IsPrivate  bool        `json:"is_private"`
 }
 
 // Get top users by net profit (total payout - total bet) for a time period
 func (q *Queries) GetSlotsLeaderboardByProfit(ctx context.Context, arg GetSlotsLeaderboardByProfitParams) ([]GetSlotsLeaderboardByProfitRow, error) {
-	rows, err := q.db.Query(ctx, getSlotsLeaderboardByProfit, arg.StartTime, arg.EndTime, arg.ResultLimit)
+	rows, err := q.db.Query(ctx, getSlotsLeaderboardByProfit, arg.StartTime, arg.EndTime, arg.CommunityID, arg.ResultLimit)
 	if err != nil {
 		return nil, err
 	}
@@ -103,6 +118,7 @@ func (q *Queries) GetSlotsLeaderboardByProfit(ctx context.Context, arg GetSlotsL
 			&i.Username,
 			&i.NetProfit,
 			&i.TotalSpins,
+			&i.IsPrivate,
 		); err != nil {
 			return nil, err
 		}
@@ -124,21 +140,25 @@ SELECT
         WHEN COUNT(*) FILTER (WHERE se.event_type = 'slots_spin') > 0
         THEN (COUNT(*) FILTER (WHERE se.event_type = 'slots_win')::float / COUNT(*) FILTER (WHERE se.event_type = 'slots_spin')::float * 100)
         ELSE 0
-    END as win_rate
+    END as win_rate,
+    COALESCE(us.leaderboard_private, FALSE)::boolean as is_private
 FROM stats_events se
 JOIN users u ON se.user_id = u.user_id
+LEFT JOIN user_settings us ON us.user_id = se.user_id
 WHERE se.event_type LIKE 'slots_%'
   AND se.created_at >= $1
   AND se.created_at <= $2
-GROUP BY se.user_id, u.username
-HAVING COUNT(*) FILTER (WHERE se.event_type = 'slots_spin') >= $3::int8
+  AND u.community_id = $3
+GROUP BY se.user_id, u.username, us.leaderboard_private
+HAVING COUNT(*) FILTER (WHERE se.event_type = 'slots_spin') >= $4::int8
 ORDER BY win_rate DESC
-LIMIT $4
+LIMIT $5
 `
 
 type GetSlotsLeaderboardByWinRateParams struct {
 	StartTime   pgtype.Timestamp `json:"start_time"`
 	EndTime     pgtype.Timestamp `json:"end_time"`
+	CommunityID string           `json:"community_id"`
 	MinSpins    int64            `json:"min_spins"`
 	ResultLimit int32            `json:"result_limit"`
 }
@@ -149,6 +169,7 @@ type GetSlotsLeaderboardByWinRateRow struct {
 	TotalSpins int64       `json:"total_spins"`
 	TotalWins  int64       `json:"total_wins"`
 	WinRate    int32       `json:"win_rate"`
+	IsPrivate  bool        `json:"is_private"`
 }
 
 // Get top users by win rate for a time period (minimum spins required)
@@ -156,6 +177,7 @@ func (q *Queries) GetSlotsLeaderboardByWinRate(ctx context.Context, arg GetSlots
 	rows, err := q.db.Query(ctx, getSlotsLeaderboardByWinRate,
 		arg.StartTime,
 		arg.EndTime,
+		arg.CommunityID,
 		arg.MinSpins,
 		arg.ResultLimit,
 	)
@@ -172,6 +194,7 @@ func (q *Queries) GetSlotsLeaderboardByWinRate(ctx context.Context, arg GetSlots
 			&i.TotalSpins,
 			&i.TotalWins,
 			&i.WinRate,
+			&i.IsPrivate,
 		); err != nil {
 			return nil, err
 		}
@@ -12,32 +12,108 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+const adjustInventorySlot = `-- name: AdjustInventorySlot :one
+WITH cur AS (
+    SELECT ui.user_id,
+           ui.inventory_data,
+           target.ord,
+           COALESCE(target.quantity, 0) AS quantity
+    FROM user_inventory ui
+    LEFT JOIN LATERAL (
+        SELECT e.ord, (e.s->>'quantity')::int AS quantity
+        FROM jsonb_array_elements(COALESCE(ui.inventory_data->'slots', '[]'::jsonb)) WITH ORDINALITY AS e(s, ord)
+        WHERE (e.s->>'item_id')::int = $1::int
+          AND COALESCE(e.s->>'quality', '') = $2::text
+        ORDER BY e.ord
+        LIMIT 1
+    ) AS target ON true
+    WHERE ui.user_id = $3
+    FOR UPDATE OF ui
+)
+UPDATE user_inventory
+SET inventory_data = jsonb_set(cur.inventory_data, '{slots}', (
+    SELECT COALESCE(jsonb_agg(adjusted.slot ORDER BY adjusted.ord), '[]'::jsonb)
+    FROM (
+        SELECT CASE
+                   WHEN e.ord = cur.ord
+                   THEN jsonb_set(e.s, '{quantity}', to_jsonb(cur.quantity + $4::int))
+                   ELSE e.s
+               END AS slot,
+               e.ord
+        FROM jsonb_array_elements(COALESCE(cur.inventory_data->'slots', '[]'::jsonb)) WITH ORDINALITY AS e(s, ord)
+        UNION ALL
+        SELECT CASE
+                   WHEN $2::text = ''
+                   THEN jsonb_build_object('item_id', $1::int, 'quantity', $4::int)
+                   ELSE jsonb_build_object('item_id', $1::int, 'quantity', $4::int, 'quality', $2::text)
+               END,
+               9223372036854775807
+        WHERE $4::int > 0
+          AND cur.ord IS NULL
+    ) AS adjusted
+    WHERE (adjusted.slot->>'quantity')::int > 0
+))
+FROM cur
+WHERE user_inventory.user_id = cur.user_id
+  AND cur.quantity + $4::int >= 0
+RETURNING (cur.quantity + $4::int)::int AS quantity
+`
+
+type AdjustInventorySlotParams struct {
+	ItemID  int32     `json:"item_id"`
+	Quality string    `json:"quality"`
+	UserID  uuid.UUID `json:"user_id"`
+	Delta   int32     `json:"delta"`
+}
+
+// Applies a quantity delta to the first (item, quality) slot in a single
+// statement, matching utils.AdjustSlotQuantity. The slot is created when
+// missing and dropped when it reaches zero. No row is updated when the result
+// would be negative. Slot order is preserved.
+func (q *Queries) AdjustInventorySlot(ctx context.Context, arg AdjustInventorySlotParams) (int32, error) {
+	row := q.db.QueryRow(ctx, adjustInventorySlot,
+		arg.ItemID,
+		arg.Quality,
+		arg.UserID,
+		arg.Delta,
+	)
+	var quantity int32
+	err := row.Scan(&quantity)
+	return quantity, err
+}
+
 const createUser = `-- name: CreateUser :one
-INSERT INTO users (username, created_at, updated_at)
-VALUES ($1, NOW(), NOW())
+INSERT INTO users (username, community_id, created_at, updated_at)
+VALUES ($1, $2, NOW(), NOW())
 RETURNING user_id
 `
 
-func (q *Queries) CreateUser(ctx context.Context, username string) (uuid.UUID, error) {
-	row := q.db.QueryRow(ctx, createUser, username)
+type CreateUserParams struct {
+	Username    string `json:"username"`
+	CommunityID string `json:"community_id"`
+}
+
+func (q *Queries) CreateUser(ctx context.Context, arg CreateUserParams) (uuid.UUID, error) {
+	row := q.db.QueryRow(ctx, createUser, arg.Username, arg.CommunityID)
 	var user_id uuid.UUID
 	err := row.Scan(&user_id)
 	return user_id, err
 }
 
 const createUserWithID = `-- name: CreateUserWithID :one
-INSERT INTO users (user_id, username, created_at, updated_at)
-VALUES ($1, $2, NOW(), NOW())
+INSERT INTO users (user_id, username, community_id, created_at, updated_at)
+VALUES ($1, $2, $3, NOW(), NOW())
 RETURNING user_id
 `
 
 type CreateUserWithIDParams struct {
-	UserID   uuid.UUID `json:"user_id"`
-	Username string    `json:"username"`
+	UserID      uuid.UUID `json:"user_id"`
+	Username    string    `json:"username"`
+	CommunityID string    `json:"community_id"`
 }
 
 func (q *Queries) CreateUserWithID(ctx context.Context, arg CreateUserWithIDParams) (uuid.UUID, error) {
-	row := q.db.QueryRow(ctx, createUserWithID, arg.UserID, arg.Username)
+	row := q.db.QueryRow(ctx, createUserWithID, arg.UserID, arg.Username, arg.CommunityID)
 	var user_id uuid.UUID
 	err := row.Scan(&user_id)
 	return user_id, err
@@ -556,43 +632,6 @@ func (q *Queries) GetItemsByNames(ctx context.Context, dollar_1 []string) ([]Get
 	return items, nil
 }
 
-const getLastCooldown = `-- name: GetLastCooldown :one
-SELECT last_used_at
-FROM user_cooldowns
-WHERE user_id = $1 AND action_name = $2
-`
-
-type GetLastCooldownParams struct {
-	UserID     uuid.UUID `json:"user_id"`
-	ActionName string    `json:"action_name"`
-}
-
-func (q *Queries) GetLastCooldown(ctx context.Context, arg GetLastCooldownParams) (pgtype.Timestamptz, error) {
-	row := q.db.QueryRow(ctx, getLastCooldown, arg.UserID, arg.ActionName)
-	var last_used_at pgtype.Timestamptz
-	err := row.Scan(&last_used_at)
-	return last_used_at, err
-}
-
-const getLastCooldownForUpdate = `-- name: GetLastCooldownForUpdate :one
-SELECT last_used_at
-FROM user_cooldowns
-WHERE user_id = $1 AND action_name = $2
-FOR UPDATE
-`
-
-type GetLastCooldownForUpdateParams struct {
-	UserID     uuid.UUID `json:"user_id"`
-	ActionName string    `json:"action_name"`
-}
-
-func (q *Queries) GetLastCooldownForUpdate(ctx context.Context, arg GetLastCooldownForUpdateParams) (pgtype.Timestamptz, error) {
-	row := q.db.QueryRow(ctx, getLastCooldownForUpdate, arg.UserID, arg.ActionName)
-	var last_used_at pgtype.Timestamptz
-	err := row.Scan(&last_used_at)
-	return last_used_at, err
-}
-
 const getPlatformID = `-- name: GetPlatformID :one
 SELECT platform_id FROM platforms WHERE name = $1
 `
@@ -605,7 +644,7 @@ func (q *Queries) GetPlatformID(ctx context.Context, name string) (int32, error)
 }
 
 const getRecipeByTargetItemID = `-- name: GetRecipeByTargetItemID :one
-SELECT recipe_id, recipe_key, target_item_id, base_cost, created_at, required_job_level, is_auto_unlock 
+SELECT recipe_id, recipe_key, target_item_id, base_cost, created_at, required_job_level, is_auto_unlock, event_theme
 FROM crafting_recipes 
 WHERE target_item_id = $1
 `
@@ -618,6 +657,7 @@ type GetRecipeByTargetItemIDRow struct {
 	CreatedAt        pgtype.Timestamp `json:"created_at"`
 	RequiredJobLevel int32            `json:"required_job_level"`
 	IsAutoUnlock     bool             `json:"is_auto_unlock"`
+	EventTheme       string           `json:"event_theme"`
 }
 
 func (q *Queries) GetRecipeByTargetItemID(ctx context.Context, targetItemID int32) (GetRecipeByTargetItemIDRow, error) {
@@ -631,6 +671,7 @@ func (q *Queries) GetRecipeByTargetItemID(ctx context.Context, targetItemID int3
 		&i.CreatedAt,
 		&i.RequiredJobLevel,
 		&i.IsAutoUnlock,
+		&i.EventTheme,
 	)
 	return i, err
 }
@@ -715,7 +756,7 @@ func (q *Queries) GetUnlockedRecipesForUser(ctx context.Context, userID uuid.UUI
 }
 
 const getUserByID = `-- name: GetUserByID :one
-SELECT user_id, username, created_at, updated_at FROM users WHERE user_id = $1
+SELECT user_id, username, created_at, updated_at, community_id FROM users WHERE user_id = $1
 `
 
 func (q *Queries) GetUserByID(ctx context.Context, userID uuid.UUID) (User, error) {
@@ -726,6 +767,7 @@ func (q *Queries) GetUserByID(ctx context.Context, userID uuid.UUID) (User, erro
 		&i.Username,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.CommunityID,
 	)
 	return i, err
 }
@@ -735,12 +777,13 @@ SELECT u.user_id, u.username
 FROM users u
 JOIN user_platform_links upl ON u.user_id = upl.user_id
 JOIN platforms p ON upl.platform_id = p.platform_id
-WHERE p.name = $1 AND upl.platform_user_id = $2
+WHERE p.name = $1 AND upl.platform_user_id = $2 AND upl.community_id = $3
 `
 
 type GetUserByPlatformIDParams struct {
 	Name           string `json:"name"`
 	PlatformUserID string `json:"platform_user_id"`
+	CommunityID    string `json:"community_id"`
 }
 
 type GetUserByPlatformIDRow struct {
@@ -749,7 +792,7 @@ type GetUserByPlatformIDRow struct {
 }
 
 func (q *Queries) GetUserByPlatformID(ctx context.Context, arg GetUserByPlatformIDParams) (GetUserByPlatformIDRow, error) {
-	row := q.db.QueryRow(ctx, getUserByPlatformID, arg.Name, arg.PlatformUserID)
+	row := q.db.QueryRow(ctx, getUserByPlatformID, arg.Name, arg.PlatformUserID, arg.CommunityID)
 	var i GetUserByPlatformIDRow
 	err := row.Scan(&i.UserID, &i.Username)
 	return i, err
@@ -762,11 +805,13 @@ JOIN user_platform_links upl ON u.user_id = upl.user_id
 JOIN platforms p ON upl.platform_id = p.platform_id
 WHERE LOWER(u.username) = LOWER($1)
 AND p.name = $2
+AND u.community_id = $3
 `
 
 type GetUserByPlatformUsernameParams struct {
-	Lower string `json:"lower"`
-	Name  string `json:"name"`
+	Lower       string `json:"lower"`
+	Name        string `json:"name"`
+	CommunityID string `json:"community_id"`
 }
 
 type GetUserByPlatformUsernameRow struct {
@@ -775,7 +820,7 @@ type GetUserByPlatformUsernameRow struct {
 }
 
 func (q *Queries) GetUserByPlatformUsername(ctx context.Context, arg GetUserByPlatformUsernameParams) (GetUserByPlatformUsernameRow, error) {
-	row := q.db.QueryRow(ctx, getUserByPlatformUsername, arg.Lower, arg.Name)
+	row := q.db.QueryRow(ctx, getUserByPlatformUsername, arg.Lower, arg.Name, arg.CommunityID)
 	var i GetUserByPlatformUsernameRow
 	err := row.Scan(&i.UserID, &i.Username)
 	return i, err
@@ -928,8 +973,8 @@ func (q *Queries) UpdateUserTimestamp(ctx context.Context, userID uuid.UUID) err
 }
 
 const upsertUserPlatformLink = `-- name: UpsertUserPlatformLink :exec
-INSERT INTO user_platform_links (user_id, platform_id, platform_user_id, platform_username)
-VALUES ($1, $2, $3, $4)
+INSERT INTO user_platform_links (user_id, platform_id, platform_user_id, platform_username, community_id)
+VALUES ($1, $2, $3, $4, $5)
 ON CONFLICT (user_id, platform_id) DO UPDATE
 SET platform_user_id = EXCLUDED.platform_user_id, 
     platform_username = COALESCE(EXCLUDED.platform_username, user_platform_links.platform_username)
@@ -940,6 +985,7 @@ type UpsertUserPlatformLinkParams struct {
 	PlatformID       int32       `json:"platform_id"`
 	PlatformUserID   string      `json:"platform_user_id"`
 	PlatformUsername pgtype.Text `json:"platform_username"`
+	CommunityID      string      `json:"community_id"`
 }
 
 func (q *Queries) UpsertUserPlatformLink(ctx context.Context, arg UpsertUserPlatformLinkParams) error {
@@ -948,6 +994,7 @@ func (q *Queries) UpsertUserPlatformLink(ctx context.Context, arg UpsertUserPlat
 		arg.PlatformID,
 		arg.PlatformUserID,
 		arg.PlatformUsername,
+		arg.CommunityID,
 	)
 	return err
 }
@@ -0,0 +1,109 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/osse101/BrandishBot_Go/internal/announce"
+	"github.com/osse101/BrandishBot_Go/internal/database/generated"
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+)
+
+type announcementRepository struct {
+	q *generated.Queries
+}
+
+// NewAnnouncementRepository creates a new PostgreSQL announcement route repository
+func NewAnnouncementRepository(pool *pgxpool.Pool) announce.Repository {
+	return &announcementRepository{q: generated.New(pool)}
+}
+
+// CreateRoute stores a route
+func (r *announcementRepository) CreateRoute(ctx context.Context, route domain.AnnouncementRoute) (*domain.AnnouncementRoute, error) {
+	row, err := r.q.CreateAnnouncementRoute(ctx, generated.CreateAnnouncementRouteParams{
+		EventType:   route.EventType,
+		Destination: string(route.Destination),
+		Target:      route.Target,
+		Template:    route.Template,
+		Enabled:     route.Enabled,
+		CreatedBy:   route.CreatedBy,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create announcement route: %w", err)
+	}
+	created := mapAnnouncementRoute(row)
+	return &created, nil
+}
+
+// UpdateRoute replaces a route's rule, or returns nil for unknown IDs
+func (r *announcementRepository) UpdateRoute(ctx context.Context, route domain.AnnouncementRoute) (*domain.AnnouncementRoute, error) {
+	row, err := r.q.UpdateAnnouncementRoute(ctx, generated.UpdateAnnouncementRouteParams{
+		ID:          route.ID,
+		EventType:   route.EventType,
+		Destination: string(route.Destination),
+		Target:      route.Target,
+		Template:    route.Template,
+		Enabled:     route.Enabled,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to update announcement route: %w", err)
+	}
+	updated := mapAnnouncementRoute(row)
+	return &updated, nil
+}
+
+// DeleteRoute removes a route
+func (r *announcementRepository) DeleteRoute(ctx context.Context, id int64) (bool, error) {
+	deleted, err := r.q.DeleteAnnouncementRoute(ctx, id)
+	if err != nil {
+		return false, fmt.Errorf("failed to delete announcement route: %w", err)
+	}
+	return deleted > 0, nil
+}
+
+// ListRoutes returns every route, oldest first
+func (r *announcementRepository) ListRoutes(ctx context.Context) ([]domain.AnnouncementRoute, error) {
+	rows, err := r.q.ListAnnouncementRoutes(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list announcement routes: %w", err)
+	}
+	return mapAnnouncementRoutes(rows), nil
+}
+
+// ListEnabledRoutes returns the enabled routes for an event type
+func (r *announcementRepository) ListEnabledRoutes(ctx context.Context, eventType string) ([]domain.AnnouncementRoute, error) {
+	rows, err := r.q.ListEnabledAnnouncementRoutesForEvent(ctx, eventType)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list announcement routes for %s: %w", eventType, err)
+	}
+	return mapAnnouncementRoutes(rows), nil
+}
+
+func mapAnnouncementRoutes(rows []generated.AnnouncementRoute) []domain.AnnouncementRoute {
+	routes := make([]domain.AnnouncementRoute, 0, len(rows))
+	for _, row := range rows {
+		routes = append(routes, mapAnnouncementRoute(row))
+	}
+	return routes
+}
+
+func mapAnnouncementRoute(row generated.AnnouncementRoute) domain.AnnouncementRoute {
+	return domain.AnnouncementRoute{
+		ID:          row.ID,
+		EventType:   row.EventType,
+		Destination: domain.AnnouncementDestination(row.Destination),
+		Target:      row.Target,
+		Template:    row.Template,
+		Enabled:     row.Enabled,
+		CreatedBy:   row.CreatedBy,
+		CreatedAt:   row.CreatedAt.Time,
+		UpdatedAt:   row.UpdatedAt.Time,
+	}
+}
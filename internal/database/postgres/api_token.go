@@ -0,0 +1,111 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/osse101/BrandishBot_Go/internal/apitoken"
+	"github.com/osse101/BrandishBot_Go/internal/database/generated"
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+)
+
+type apiTokenRepository struct {
+	q *generated.Queries
+}
+
+// NewAPITokenRepository creates a new PostgreSQL guild API token repository
+func NewAPITokenRepository(pool *pgxpool.Pool) apitoken.Repository {
+	return &apiTokenRepository{q: generated.New(pool)}
+}
+
+// CreateToken stores a token under its hash
+func (r *apiTokenRepository) CreateToken(ctx context.Context, token domain.APIToken, hash string) (*domain.APIToken, error) {
+	row, err := r.q.CreateAPIToken(ctx, generated.CreateAPITokenParams{
+		GuildID:     token.GuildID,
+		Name:        token.Name,
+		TokenHash:   hash,
+		TokenPrefix: token.Prefix,
+		CreatedBy:   token.CreatedBy,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create API token: %w", err)
+	}
+	created := mapAPIToken(row)
+	return &created, nil
+}
+
+// GetActiveTokenByHash returns the unrevoked token with the hash, or nil if there is none
+func (r *apiTokenRepository) GetActiveTokenByHash(ctx context.Context, hash string) (*domain.APIToken, error) {
+	row, err := r.q.GetActiveAPITokenByHash(ctx, hash)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get API token: %w", err)
+	}
+	token := mapAPIToken(row)
+	return &token, nil
+}
+
+// ListTokens returns a guild's tokens, or every guild's, newest first
+func (r *apiTokenRepository) ListTokens(ctx context.Context, guildID string) ([]domain.APIToken, error) {
+	rows, err := r.q.ListAPITokens(ctx, guildID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list API tokens: %w", err)
+	}
+	tokens := make([]domain.APIToken, 0, len(rows))
+	for _, row := range rows {
+		tokens = append(tokens, mapAPIToken(row))
+	}
+	return tokens, nil
+}
+
+// CountActiveTokens returns how many unrevoked tokens a guild has
+func (r *apiTokenRepository) CountActiveTokens(ctx context.Context, guildID string) (int, error) {
+	count, err := r.q.CountActiveAPITokens(ctx, guildID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count API tokens: %w", err)
+	}
+	return int(count), nil
+}
+
+// RevokeToken revokes an unrevoked token
+func (r *apiTokenRepository) RevokeToken(ctx context.Context, id int64) (bool, error) {
+	revoked, err := r.q.RevokeAPIToken(ctx, id)
+	if err != nil {
+		return false, fmt.Errorf("failed to revoke API token: %w", err)
+	}
+	return revoked > 0, nil
+}
+
+// TouchToken records that a token was used
+func (r *apiTokenRepository) TouchToken(ctx context.Context, id int64) error {
+	if err := r.q.TouchAPIToken(ctx, id); err != nil {
+		return fmt.Errorf("failed to touch API token: %w", err)
+	}
+	return nil
+}
+
+func mapAPIToken(row generated.ApiToken) domain.APIToken {
+	token := domain.APIToken{
+		ID:        row.ID,
+		GuildID:   row.GuildID,
+		Name:      row.Name,
+		Prefix:    row.TokenPrefix,
+		CreatedBy: row.CreatedBy,
+		CreatedAt: row.CreatedAt.Time,
+	}
+	if row.LastUsedAt.Valid {
+		lastUsed := row.LastUsedAt.Time
+		token.LastUsedAt = &lastUsed
+	}
+	if row.RevokedAt.Valid {
+		revoked := row.RevokedAt.Time
+		token.RevokedAt = &revoked
+	}
+	return token
+}
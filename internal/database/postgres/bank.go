@@ -0,0 +1,207 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/osse101/BrandishBot_Go/internal/bank"
+	"github.com/osse101/BrandishBot_Go/internal/database/generated"
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/repository"
+)
+
+type bankRepository struct {
+	db        *pgxpool.Pool
+	q         *generated.Queries
+	inventory *inventoryEvents
+}
+
+// NewBankRepository creates a PostgreSQL repository for users' bank accounts
+func NewBankRepository(pool *pgxpool.Pool, opts ...RepositoryOption) bank.Repository {
+	return &bankRepository{db: pool, q: generated.New(pool), inventory: newInventoryEvents(InventorySourceBank, opts)}
+}
+
+// BeginTx starts a transaction and returns a bank.Tx
+func (r *bankRepository) BeginTx(ctx context.Context) (bank.Tx, error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin bank transaction: %w", err)
+	}
+	return &bankTx{tx: tx, q: r.q.WithTx(tx), inventory: r.inventory.begin()}, nil
+}
+
+// GetInventory reads a user's inventory without locking it
+func (r *bankRepository) GetInventory(ctx context.Context, userID string) (*domain.Inventory, error) {
+	return getInventory(ctx, r.q, userID)
+}
+
+func (r *bankRepository) GetAccount(ctx context.Context, userID string) (*domain.BankAccount, error) {
+	userUUID, err := parseUserUUID(userID)
+	if err != nil {
+		return nil, err
+	}
+	row, err := r.q.GetBankAccount(ctx, userUUID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get bank account: %w", err)
+	}
+	return mapBankAccount(row), nil
+}
+
+func (r *bankRepository) ApplyInterest(ctx context.Context, rate float64, maxInterest int64, paidBefore, paidAt time.Time) ([]domain.BankInterest, error) {
+	rows, err := r.q.ApplyBankInterest(ctx, generated.ApplyBankInterestParams{
+		Rate:        rate,
+		MaxInterest: maxInterest,
+		PaidBefore:  pgtype.Timestamptz{Time: paidBefore, Valid: true},
+		PaidAt:      pgtype.Timestamptz{Time: paidAt, Valid: true},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to apply bank interest: %w", err)
+	}
+	paid := make([]domain.BankInterest, 0, len(rows))
+	for _, row := range rows {
+		paid = append(paid, domain.BankInterest{
+			UserID:   row.UserID.String(),
+			Interest: row.Interest,
+			Balance:  row.Balance,
+			Goal:     row.Goal.Int64,
+		})
+	}
+	return paid, nil
+}
+
+// bankTx implements bank.Tx
+type bankTx struct {
+	tx        pgx.Tx
+	q         *generated.Queries
+	inventory *inventoryJournal
+}
+
+func (t *bankTx) Commit(ctx context.Context) error {
+	if err := t.tx.Commit(ctx); err != nil {
+		return err
+	}
+	t.inventory.flush(ctx)
+	return nil
+}
+
+func (t *bankTx) Rollback(ctx context.Context) error {
+	err := t.tx.Rollback(ctx)
+	if errors.Is(err, pgx.ErrTxClosed) {
+		return fmt.Errorf("%w: %w", repository.ErrTxClosed, err)
+	}
+	return err
+}
+
+func (t *bankTx) AdjustItemQuantity(ctx context.Context, userID string, itemID int, quality domain.QualityLevel, delta int) (int, error) {
+	return adjustItemQuantity(ctx, t.q, userID, itemID, quality, delta, t.inventory)
+}
+
+func (t *bankTx) LockAccount(ctx context.Context, userID string) (*domain.BankAccount, error) {
+	userUUID, err := parseUserUUID(userID)
+	if err != nil {
+		return nil, err
+	}
+	if err := t.q.EnsureBankAccount(ctx, userUUID); err != nil {
+		return nil, fmt.Errorf("failed to open bank account: %w", err)
+	}
+	row, err := t.q.GetBankAccountForUpdate(ctx, userUUID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to lock bank account: %w", err)
+	}
+	return mapBankAccount(row), nil
+}
+
+func (t *bankTx) Deposit(ctx context.Context, userID string, amount int64) (*domain.BankAccount, error) {
+	userUUID, err := parseUserUUID(userID)
+	if err != nil {
+		return nil, err
+	}
+	row, err := t.q.DepositToBank(ctx, generated.DepositToBankParams{
+		Amount: amount,
+		UserID: userUUID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to credit bank account: %w", err)
+	}
+	return mapBankAccount(row), nil
+}
+
+func (t *bankTx) Withdraw(ctx context.Context, userID string, amount int64, today time.Time) (*domain.BankAccount, error) {
+	userUUID, err := parseUserUUID(userID)
+	if err != nil {
+		return nil, err
+	}
+	row, err := t.q.WithdrawFromBank(ctx, generated.WithdrawFromBankParams{
+		Amount: amount,
+		Today:  pgtype.Date{Time: today, Valid: true},
+		UserID: userUUID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to debit bank account: %w", err)
+	}
+	return mapBankAccount(row), nil
+}
+
+func (t *bankTx) SetGoal(ctx context.Context, userID string, goal int64, setAt time.Time) (*domain.BankAccount, error) {
+	userUUID, err := parseUserUUID(userID)
+	if err != nil {
+		return nil, err
+	}
+	row, err := t.q.SetBankGoal(ctx, generated.SetBankGoalParams{
+		Goal:      pgtype.Int8{Int64: goal, Valid: goal > 0},
+		GoalSetAt: pgtype.Timestamptz{Time: setAt, Valid: goal > 0},
+		UserID:    userUUID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to set savings goal: %w", err)
+	}
+	return mapBankAccount(row), nil
+}
+
+func (t *bankTx) CompleteGoal(ctx context.Context, userID string, bonus int64) (*domain.BankAccount, error) {
+	userUUID, err := parseUserUUID(userID)
+	if err != nil {
+		return nil, err
+	}
+	row, err := t.q.CompleteBankGoal(ctx, generated.CompleteBankGoalParams{
+		Bonus:  bonus,
+		UserID: userUUID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to complete savings goal: %w", err)
+	}
+	return mapBankAccount(row), nil
+}
+
+func mapBankAccount(row generated.BankAccount) *domain.BankAccount {
+	a := &domain.BankAccount{
+		UserID:         row.UserID.String(),
+		Balance:        row.Balance,
+		Goal:           row.Goal.Int64,
+		GoalStart:      row.GoalStart.Int64,
+		GoalsReached:   int(row.GoalsReached),
+		InterestEarned: row.InterestEarned,
+		WithdrawnToday: row.WithdrawnToday,
+	}
+	if row.GoalSetAt.Valid {
+		setAt := row.GoalSetAt.Time
+		a.GoalSetAt = &setAt
+	}
+	if row.LastInterestAt.Valid {
+		paidAt := row.LastInterestAt.Time
+		a.LastInterestAt = &paidAt
+	}
+	if row.WithdrawnOn.Valid {
+		a.WithdrawnOn = row.WithdrawnOn.Time
+	}
+	return a
+}
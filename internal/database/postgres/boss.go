@@ -0,0 +1,206 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/osse101/BrandishBot_Go/internal/boss"
+	"github.com/osse101/BrandishBot_Go/internal/community"
+	"github.com/osse101/BrandishBot_Go/internal/database/generated"
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/repository"
+)
+
+type bossRepository struct {
+	db *pgxpool.Pool
+	q  *generated.Queries
+}
+
+// NewBossRepository creates a PostgreSQL repository for community boss
+// fights and their attackers
+func NewBossRepository(pool *pgxpool.Pool) boss.Repository {
+	return &bossRepository{db: pool, q: generated.New(pool)}
+}
+
+// BeginTx starts a transaction and returns a boss.Tx
+func (r *bossRepository) BeginTx(ctx context.Context) (boss.Tx, error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin boss transaction: %w", err)
+	}
+	return &bossTx{tx: tx, q: r.q.WithTx(tx)}, nil
+}
+
+func (r *bossRepository) CreateBoss(ctx context.Context, b *domain.Boss) (bool, error) {
+	rows, err := r.q.CreateBossFight(ctx, generated.CreateBossFightParams{
+		ID:            b.ID,
+		CommunityID:   community.FromContext(ctx),
+		BossKey:       b.Key,
+		Name:          b.Name,
+		MaxHp:         int32(b.MaxHP),
+		RewardLootbox: b.RewardLootbox,
+		RewardBoxes:   int32(b.RewardBoxes),
+		SpawnedBy:     b.SpawnedBy,
+		SpawnedAt:     pgtype.Timestamptz{Time: b.SpawnedAt, Valid: true},
+		ExpiresAt:     pgtype.Timestamptz{Time: b.ExpiresAt, Valid: true},
+	})
+	if err != nil {
+		return false, fmt.Errorf("failed to create boss fight: %w", err)
+	}
+	return rows > 0, nil
+}
+
+func (r *bossRepository) GetActiveBoss(ctx context.Context) (*domain.Boss, error) {
+	row, err := r.q.GetActiveBossFight(ctx, community.FromContext(ctx))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get active boss fight: %w", err)
+	}
+	return mapBossFight(row), nil
+}
+
+func (r *bossRepository) ExpireBoss(ctx context.Context, id uuid.UUID) (*domain.Boss, error) {
+	row, err := r.q.ExpireBossFight(ctx, id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to expire boss fight: %w", err)
+	}
+	return mapBossFight(row), nil
+}
+
+func (r *bossRepository) GetAttackers(ctx context.Context, bossID uuid.UUID, limit int) ([]domain.BossAttacker, error) {
+	return listBossAttackers(ctx, r.q, bossID, limit)
+}
+
+// bossTx implements boss.Tx
+type bossTx struct {
+	tx pgx.Tx
+	q  *generated.Queries
+}
+
+func (t *bossTx) Commit(ctx context.Context) error {
+	return t.tx.Commit(ctx)
+}
+
+func (t *bossTx) Rollback(ctx context.Context) error {
+	err := t.tx.Rollback(ctx)
+	if errors.Is(err, pgx.ErrTxClosed) {
+		return fmt.Errorf("%w: %w", repository.ErrTxClosed, err)
+	}
+	return err
+}
+
+func (t *bossTx) LockActiveBoss(ctx context.Context) (*domain.Boss, error) {
+	row, err := t.q.LockActiveBossFight(ctx, community.FromContext(ctx))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to lock active boss fight: %w", err)
+	}
+	return mapBossFight(row), nil
+}
+
+func (t *bossTx) Damage(ctx context.Context, bossID uuid.UUID, userID string, damage int) (*domain.Boss, error) {
+	userUUID, err := parseUserUUID(userID)
+	if err != nil {
+		return nil, err
+	}
+	row, err := t.q.DamageBossFight(ctx, generated.DamageBossFightParams{
+		Damage: int32(damage),
+		ID:     bossID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to damage boss: %w", err)
+	}
+	if err := t.q.UpsertBossAttacker(ctx, generated.UpsertBossAttackerParams{
+		BossID: bossID,
+		UserID: userUUID,
+		Damage: int32(damage),
+	}); err != nil {
+		return nil, fmt.Errorf("failed to record boss attacker: %w", err)
+	}
+	return mapBossFight(row), nil
+}
+
+func (t *bossTx) EndBoss(ctx context.Context, bossID uuid.UUID, state domain.BossState) error {
+	if err := t.q.EndBossFight(ctx, generated.EndBossFightParams{
+		ID:    bossID,
+		State: string(state),
+	}); err != nil {
+		return fmt.Errorf("failed to end boss fight: %w", err)
+	}
+	return nil
+}
+
+func (t *bossTx) GetAttackers(ctx context.Context, bossID uuid.UUID, limit int) ([]domain.BossAttacker, error) {
+	return listBossAttackers(ctx, t.q, bossID, limit)
+}
+
+func (t *bossTx) SetRewardBoxes(ctx context.Context, bossID uuid.UUID, userID string, boxes int) error {
+	userUUID, err := parseUserUUID(userID)
+	if err != nil {
+		return err
+	}
+	if err := t.q.SetBossAttackerRewards(ctx, generated.SetBossAttackerRewardsParams{
+		BossID:      bossID,
+		UserID:      userUUID,
+		RewardBoxes: int32(boxes),
+	}); err != nil {
+		return fmt.Errorf("failed to set boss rewards: %w", err)
+	}
+	return nil
+}
+
+func listBossAttackers(ctx context.Context, q *generated.Queries, bossID uuid.UUID, limit int) ([]domain.BossAttacker, error) {
+	rows, err := q.ListBossAttackers(ctx, generated.ListBossAttackersParams{
+		BossID: bossID,
+		Limit:  int32(limit),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list boss attackers: %w", err)
+	}
+	attackers := make([]domain.BossAttacker, 0, len(rows))
+	for _, row := range rows {
+		attackers = append(attackers, domain.BossAttacker{
+			UserID:      row.UserID.String(),
+			Username:    row.Username,
+			Damage:      int(row.Damage),
+			Attacks:     int(row.Attacks),
+			RewardBoxes: int(row.RewardBoxes),
+		})
+	}
+	return attackers, nil
+}
+
+func mapBossFight(row generated.BossFight) *domain.Boss {
+	b := &domain.Boss{
+		ID:            row.ID,
+		CommunityID:   row.CommunityID,
+		Key:           row.BossKey,
+		Name:          row.Name,
+		MaxHP:         int(row.MaxHp),
+		HP:            int(row.Hp),
+		RewardLootbox: row.RewardLootbox,
+		RewardBoxes:   int(row.RewardBoxes),
+		State:         domain.BossState(row.State),
+		SpawnedBy:     row.SpawnedBy,
+		SpawnedAt:     row.SpawnedAt.Time,
+		ExpiresAt:     row.ExpiresAt.Time,
+	}
+	if row.EndedAt.Valid {
+		endedAt := row.EndedAt.Time
+		b.EndedAt = &endedAt
+	}
+	return b
+}
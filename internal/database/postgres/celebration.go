@@ -0,0 +1,163 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/osse101/BrandishBot_Go/internal/celebration"
+	"github.com/osse101/BrandishBot_Go/internal/database/generated"
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+)
+
+type celebrationRepository struct {
+	q *generated.Queries
+}
+
+// NewCelebrationRepository creates a new PostgreSQL celebration repository
+func NewCelebrationRepository(pool *pgxpool.Pool) celebration.Repository {
+	return &celebrationRepository{q: generated.New(pool)}
+}
+
+// SetBirthday stores or replaces a user's birthday
+func (r *celebrationRepository) SetBirthday(ctx context.Context, userID string, birthday domain.Birthday) error {
+	userUUID, err := parseUserUUID(userID)
+	if err != nil {
+		return err
+	}
+	if err := r.q.UpsertUserBirthday(ctx, generated.UpsertUserBirthdayParams{
+		UserID:     userUUID,
+		BirthMonth: int16(birthday.Month),
+		BirthDay:   int16(birthday.Day),
+	}); err != nil {
+		return fmt.Errorf("failed to set birthday: %w", err)
+	}
+	return nil
+}
+
+// ClearBirthday removes a user's birthday
+func (r *celebrationRepository) ClearBirthday(ctx context.Context, userID string) (bool, error) {
+	userUUID, err := parseUserUUID(userID)
+	if err != nil {
+		return false, err
+	}
+	rows, err := r.q.DeleteUserBirthday(ctx, userUUID)
+	if err != nil {
+		return false, fmt.Errorf("failed to clear birthday: %w", err)
+	}
+	return rows > 0, nil
+}
+
+// GetBirthday returns a user's birthday (returns nil, nil if none is registered)
+func (r *celebrationRepository) GetBirthday(ctx context.Context, userID string) (*domain.Birthday, error) {
+	userUUID, err := parseUserUUID(userID)
+	if err != nil {
+		return nil, err
+	}
+	row, err := r.q.GetUserBirthday(ctx, userUUID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get birthday: %w", err)
+	}
+	return &domain.Birthday{Month: int(row.BirthMonth), Day: int(row.BirthDay)}, nil
+}
+
+// ListBirthdays returns users whose birthday falls on the month and day
+func (r *celebrationRepository) ListBirthdays(ctx context.Context, month, day int) ([]domain.Celebrant, error) {
+	rows, err := r.q.ListBirthdayUsers(ctx, generated.ListBirthdayUsersParams{
+		BirthMonth: int16(month),
+		BirthDay:   int16(day),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list birthdays: %w", err)
+	}
+	celebrants := make([]domain.Celebrant, 0, len(rows))
+	for _, row := range rows {
+		celebrants = append(celebrants, domain.Celebrant{UserID: row.UserID.String(), Username: row.Username})
+	}
+	return celebrants, nil
+}
+
+// ListAnniversaries returns users who registered on the month and day of an earlier year
+func (r *celebrationRepository) ListAnniversaries(ctx context.Context, month, day int, registeredBefore time.Time) ([]domain.Celebrant, error) {
+	rows, err := r.q.ListAnniversaryUsers(ctx, generated.ListAnniversaryUsersParams{
+		Month:            int32(month),
+		Day:              int32(day),
+		RegisteredBefore: pgtype.Timestamp{Time: registeredBefore, Valid: true},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list anniversaries: %w", err)
+	}
+	celebrants := make([]domain.Celebrant, 0, len(rows))
+	for _, row := range rows {
+		celebrants = append(celebrants, domain.Celebrant{
+			UserID:       row.UserID.String(),
+			Username:     row.Username,
+			RegisteredAt: row.CreatedAt.Time,
+		})
+	}
+	return celebrants, nil
+}
+
+// ClaimGrant records a celebration for the year unless it was already granted
+func (r *celebrationRepository) ClaimGrant(ctx context.Context, userID, kind string, year int) (bool, error) {
+	userUUID, err := parseUserUUID(userID)
+	if err != nil {
+		return false, err
+	}
+	rows, err := r.q.ClaimCelebrationGrant(ctx, generated.ClaimCelebrationGrantParams{
+		UserID: userUUID,
+		Kind:   kind,
+		Year:   int32(year),
+	})
+	if err != nil {
+		return false, fmt.Errorf("failed to claim celebration grant: %w", err)
+	}
+	return rows > 0, nil
+}
+
+// ReleaseGrant removes a claimed grant
+func (r *celebrationRepository) ReleaseGrant(ctx context.Context, userID, kind string, year int) error {
+	userUUID, err := parseUserUUID(userID)
+	if err != nil {
+		return err
+	}
+	if err := r.q.ReleaseCelebrationGrant(ctx, generated.ReleaseCelebrationGrantParams{
+		UserID: userUUID,
+		Kind:   kind,
+		Year:   int32(year),
+	}); err != nil {
+		return fmt.Errorf("failed to release celebration grant: %w", err)
+	}
+	return nil
+}
+
+// IsGuildEnabled reports whether a guild wants celebration announcements
+func (r *celebrationRepository) IsGuildEnabled(ctx context.Context, guildID string) (bool, error) {
+	enabled, err := r.q.GetCelebrationGuild(ctx, guildID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to get celebration guild: %w", err)
+	}
+	return enabled, nil
+}
+
+// SetGuildEnabled turns announcements on or off for a guild
+func (r *celebrationRepository) SetGuildEnabled(ctx context.Context, guildID string, enabled bool) error {
+	if err := r.q.UpsertCelebrationGuild(ctx, generated.UpsertCelebrationGuildParams{
+		GuildID: guildID,
+		Enabled: enabled,
+	}); err != nil {
+		return fmt.Errorf("failed to set celebration guild: %w", err)
+	}
+	return nil
+}
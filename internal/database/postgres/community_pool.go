@@ -0,0 +1,174 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/osse101/BrandishBot_Go/internal/community"
+	"github.com/osse101/BrandishBot_Go/internal/communitypool"
+	"github.com/osse101/BrandishBot_Go/internal/database/generated"
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/repository"
+)
+
+type communityPoolRepository struct {
+	db        *pgxpool.Pool
+	q         *generated.Queries
+	inventory *inventoryEvents
+}
+
+// NewCommunityPoolRepository creates a PostgreSQL repository for the
+// community pool and its donations
+func NewCommunityPoolRepository(pool *pgxpool.Pool, opts ...RepositoryOption) communitypool.Repository {
+	return &communityPoolRepository{db: pool, q: generated.New(pool), inventory: newInventoryEvents(InventorySourceCommunity, opts)}
+}
+
+// BeginTx starts a transaction and returns a communitypool.Tx
+func (r *communityPoolRepository) BeginTx(ctx context.Context) (communitypool.Tx, error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin community pool transaction: %w", err)
+	}
+	return &communityPoolTx{tx: tx, q: r.q.WithTx(tx), inventory: r.inventory.begin()}, nil
+}
+
+// GetInventory reads a user's inventory without locking it
+func (r *communityPoolRepository) GetInventory(ctx context.Context, userID string) (*domain.Inventory, error) {
+	return getInventory(ctx, r.q, userID)
+}
+
+func (r *communityPoolRepository) GetBalance(ctx context.Context) (int64, error) {
+	return getCommunityPoolBalance(ctx, r.q)
+}
+
+func (r *communityPoolRepository) Withdraw(ctx context.Context, amount int64) (int64, error) {
+	balance, err := r.q.WithdrawFromCommunityPool(ctx, generated.WithdrawFromCommunityPoolParams{
+		CommunityID: community.FromContext(ctx),
+		Balance:     amount,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return 0, domain.ErrInsufficientFunds
+		}
+		return 0, fmt.Errorf("failed to withdraw from community pool: %w", err)
+	}
+	return balance, nil
+}
+
+func (r *communityPoolRepository) Deposit(ctx context.Context, amount int64) error {
+	if err := r.q.AddToCommunityPool(ctx, generated.AddToCommunityPoolParams{
+		CommunityID: community.FromContext(ctx),
+		Balance:     amount,
+	}); err != nil {
+		return fmt.Errorf("failed to credit community pool: %w", err)
+	}
+	return nil
+}
+
+func (r *communityPoolRepository) GetDonationTotals(ctx context.Context) (int64, int64, int, error) {
+	row, err := r.q.GetCommunityDonationTotals(ctx, community.FromContext(ctx))
+	if err != nil {
+		return 0, 0, 0, fmt.Errorf("failed to get donation totals: %w", err)
+	}
+	return row.TotalValue, row.TotalPoints, int(row.DonorCount), nil
+}
+
+func (r *communityPoolRepository) GetTopDonors(ctx context.Context, limit int) ([]domain.CommunityDonor, error) {
+	rows, err := r.q.GetTopCommunityDonors(ctx, generated.GetTopCommunityDonorsParams{
+		CommunityID: community.FromContext(ctx),
+		Limit:       int32(limit),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get top donors: %w", err)
+	}
+	donors := make([]domain.CommunityDonor, 0, len(rows))
+	for _, row := range rows {
+		userID, username := leaderboardIdentity(row.UserID.String(), row.Username, row.IsPrivate)
+		donors = append(donors, domain.CommunityDonor{
+			UserID:     userID,
+			Username:   username,
+			TotalValue: row.TotalValue,
+			Points:     row.TotalPoints,
+			Donations:  int(row.Donations),
+		})
+	}
+	return donors, nil
+}
+
+// communityPoolTx implements communitypool.Tx
+type communityPoolTx struct {
+	tx        pgx.Tx
+	q         *generated.Queries
+	inventory *inventoryJournal
+}
+
+func (t *communityPoolTx) Commit(ctx context.Context) error {
+	if err := t.tx.Commit(ctx); err != nil {
+		return err
+	}
+	t.inventory.flush(ctx)
+	return nil
+}
+
+func (t *communityPoolTx) Rollback(ctx context.Context) error {
+	err := t.tx.Rollback(ctx)
+	if errors.Is(err, pgx.ErrTxClosed) {
+		return fmt.Errorf("%w: %w", repository.ErrTxClosed, err)
+	}
+	return err
+}
+
+func (t *communityPoolTx) AdjustItemQuantity(ctx context.Context, userID string, itemID int, quality domain.QualityLevel, delta int) (int, error) {
+	return adjustItemQuantity(ctx, t.q, userID, itemID, quality, delta, t.inventory)
+}
+
+func (t *communityPoolTx) AddToCommunityPool(ctx context.Context, amount int) error {
+	return addToCommunityPool(ctx, t.q, amount)
+}
+
+func (t *communityPoolTx) RecordDonation(ctx context.Context, userID string, itemID, quantity, value, points int) error {
+	userUUID, err := parseUserUUID(userID)
+	if err != nil {
+		return err
+	}
+	if err := t.q.InsertCommunityDonation(ctx, generated.InsertCommunityDonationParams{
+		UserID:      userUUID,
+		ItemID:      int32(itemID),
+		Quantity:    int32(quantity),
+		Value:       int32(value),
+		Points:      int32(points),
+		CommunityID: community.FromContext(ctx),
+	}); err != nil {
+		return fmt.Errorf("failed to insert community donation: %w", err)
+	}
+	return nil
+}
+
+// getCommunityPoolBalance reads the pool of the context's community. A
+// community that has never been credited has an empty pool.
+func getCommunityPoolBalance(ctx context.Context, q *generated.Queries) (int64, error) {
+	balance, err := q.GetCommunityPoolBalance(ctx, community.FromContext(ctx))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return 0, nil
+		}
+		return 0, fmt.Errorf("failed to get community pool: %w", err)
+	}
+	return balance, nil
+}
+
+// addToCommunityPool credits the pool of the context's community with the
+// given queries, which may be bound to a transaction
+func addToCommunityPool(ctx context.Context, q *generated.Queries, amount int) error {
+	if err := q.AddToCommunityPool(ctx, generated.AddToCommunityPoolParams{
+		CommunityID: community.FromContext(ctx),
+		Balance:     int64(amount),
+	}); err != nil {
+		return fmt.Errorf("failed to credit community pool: %w", err)
+	}
+	return nil
+}
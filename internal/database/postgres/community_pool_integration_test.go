@@ -0,0 +1,69 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+
+	"github.com/osse101/BrandishBot_Go/internal/community"
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+)
+
+func TestCommunityPoolRepository_TopDonors_Integration(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test in short mode")
+	}
+	if testDBConnString == "" {
+		t.Skip("Skipping integration test: database not available")
+	}
+
+	ctx := community.WithID(context.Background(), "donor_privacy")
+	ensureMigrations(t)
+
+	userRepo := NewUserRepository(testPool)
+	public := &domain.User{Username: "public_donor"}
+	private := &domain.User{Username: "private_donor"}
+	for _, u := range []*domain.User{public, private} {
+		if err := userRepo.UpsertUser(ctx, u); err != nil {
+			t.Fatalf("failed to create user: %v", err)
+		}
+	}
+	if err := NewUserSettingsRepository(testPool).SetLeaderboardPrivate(ctx, private.ID, true); err != nil {
+		t.Fatalf("SetLeaderboardPrivate failed: %v", err)
+	}
+
+	money, err := NewCraftingRepository(testPool).GetItemByName(ctx, domain.ItemMoney)
+	if err != nil || money == nil {
+		t.Fatalf("failed to get money item: %v", err)
+	}
+
+	repo := NewCommunityPoolRepository(testPool)
+	tx, err := repo.BeginTx(ctx)
+	if err != nil {
+		t.Fatalf("BeginTx failed: %v", err)
+	}
+	if err := tx.RecordDonation(ctx, private.ID, money.ID, 1, 200, 2); err != nil {
+		t.Fatalf("RecordDonation failed: %v", err)
+	}
+	if err := tx.RecordDonation(ctx, public.ID, money.ID, 1, 100, 1); err != nil {
+		t.Fatalf("RecordDonation failed: %v", err)
+	}
+	if err := tx.Commit(ctx); err != nil {
+		t.Fatalf("Commit failed: %v", err)
+	}
+
+	donors, err := repo.GetTopDonors(ctx, 10)
+	if err != nil {
+		t.Fatalf("GetTopDonors failed: %v", err)
+	}
+	if len(donors) != 2 {
+		t.Fatalf("expected 2 donors, got %d", len(donors))
+	}
+
+	// The private donor keeps their place but not their name
+	if donors[0].UserID != "" || donors[0].Username != domain.AnonymousDisplayName || donors[0].TotalValue != 200 {
+		t.Errorf("expected an anonymous top donor with 200 given, got %+v", donors[0])
+	}
+	if donors[1].UserID != public.ID || donors[1].Username != "public_donor" {
+		t.Errorf("expected public_donor second, got %+v", donors[1])
+	}
+}
@@ -24,9 +24,9 @@ type CompostRepository struct {
 }
 
 // NewCompostRepository creates a new CompostRepository
-func NewCompostRepository(db *pgxpool.Pool) *CompostRepository {
+func NewCompostRepository(db *pgxpool.Pool, opts ...RepositoryOption) *CompostRepository {
 	return &CompostRepository{
-		UserRepository: NewUserRepository(db),
+		UserRepository: newUserRepository(db, nil, InventorySourceCompost, opts),
 		db:             db,
 		q:              generated.New(db),
 	}
@@ -73,19 +73,25 @@ func (r *CompostRepository) BeginTx(ctx context.Context) (repository.CompostTx,
 		return nil, fmt.Errorf("failed to begin compost transaction: %w", err)
 	}
 	return &compostTx{
-		tx: tx,
-		q:  r.q.WithTx(tx),
+		tx:        tx,
+		q:         r.q.WithTx(tx),
+		inventory: r.inventory.begin(),
 	}, nil
 }
 
 // compostTx implements repository.CompostTx
 type compostTx struct {
-	tx pgx.Tx
-	q  *generated.Queries
+	tx        pgx.Tx
+	q         *generated.Queries
+	inventory *inventoryJournal
 }
 
 func (t *compostTx) Commit(ctx context.Context) error {
-	return t.tx.Commit(ctx)
+	if err := t.tx.Commit(ctx); err != nil {
+		return err
+	}
+	t.inventory.flush(ctx)
+	return nil
 }
 
 func (t *compostTx) Rollback(ctx context.Context) error {
@@ -146,7 +152,7 @@ func (t *compostTx) GetInventory(ctx context.Context, userID string) (*domain.In
 }
 
 func (t *compostTx) UpdateInventory(ctx context.Context, userID string, inv domain.Inventory) error {
-	return updateInventory(ctx, t.q, userID, inv)
+	return updateInventory(ctx, t.q, userID, inv, t.inventory)
 }
 
 // mapCompostBin converts a generated.CompostBin to domain.CompostBin
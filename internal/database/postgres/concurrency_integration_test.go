@@ -2,11 +2,14 @@ package postgres
 
 import (
 	"context"
+	"errors"
 	"sync"
 	"testing"
 	"time"
 
 	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/naming"
+	"github.com/osse101/BrandishBot_Go/internal/repository"
 	"github.com/osse101/BrandishBot_Go/internal/user"
 )
 
@@ -111,6 +114,235 @@ func TestConcurrentAddItem_Integration(t *testing.T) {
 	}
 }
 
+// TestConcurrentInventoryTx_Integration runs read-modify-write cycles through
+// the economy and crafting transactions concurrently against a user with no
+// inventory row yet. Each cycle must see the previous one's write.
+func TestConcurrentInventoryTx_Integration(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test in short mode")
+	}
+	if testDBConnString == "" {
+		t.Skip("Skipping integration test: database not available")
+	}
+
+	ctx := context.Background()
+	ensureMigrations(t)
+
+	userRepo := NewUserRepository(testPool)
+	testUser := &domain.User{
+		Username: "inventory_tx_test_user",
+		TwitchID: "twitch_inventory_tx_123",
+	}
+	if err := userRepo.UpsertUser(ctx, testUser); err != nil {
+		t.Fatalf("failed to create test user: %v", err)
+	}
+	if err := userRepo.DeleteInventory(ctx, testUser.ID); err != nil {
+		t.Fatalf("failed to reset inventory: %v", err)
+	}
+	item, err := userRepo.GetItemByName(ctx, domain.ItemLootbox1)
+	if err != nil || item == nil {
+		t.Fatalf("failed to get item: %v", err)
+	}
+
+	economyRepo := NewEconomyRepository(testPool)
+	craftingRepo := NewCraftingRepository(testPool)
+	increment := func(tx repository.InventoryTx) error {
+		defer repository.SafeRollback(ctx, tx)
+		inv, err := tx.GetInventory(ctx, testUser.ID)
+		if err != nil {
+			return err
+		}
+		if len(inv.Slots) == 0 {
+			inv.Slots = append(inv.Slots, domain.InventorySlot{ItemID: item.ID, QualityLevel: domain.QualityCommon})
+		}
+		inv.Slots[0].Quantity++
+		if err := tx.UpdateInventory(ctx, testUser.ID, *inv); err != nil {
+			return err
+		}
+		return tx.Commit(ctx)
+	}
+
+	const concurrentOps = 20
+	var wg sync.WaitGroup
+	errChan := make(chan error, concurrentOps)
+	for i := 0; i < concurrentOps; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			var tx repository.InventoryTx
+			var err error
+			if i%2 == 0 {
+				tx, err = economyRepo.BeginTx(ctx)
+			} else {
+				tx, err = craftingRepo.BeginTx(ctx)
+			}
+			if err == nil {
+				err = increment(tx)
+			}
+			if err != nil {
+				errChan <- err
+			}
+		}(i)
+	}
+	wg.Wait()
+	close(errChan)
+
+	for err := range errChan {
+		t.Fatalf("concurrent inventory transaction failed: %v", err)
+	}
+
+	inv, err := userRepo.GetInventory(ctx, testUser.ID)
+	if err != nil {
+		t.Fatalf("failed to get inventory: %v", err)
+	}
+	if len(inv.Slots) != 1 || inv.Slots[0].Quantity != concurrentOps {
+		t.Errorf("expected one slot with quantity %d, got %+v", concurrentOps, inv.Slots)
+	}
+}
+
+// TestAdjustItemQuantity_Integration verifies the single-statement slot
+// adjustment: concurrent deltas all land, other slots are untouched, and a
+// debit past zero is rejected without changing the inventory.
+func TestAdjustItemQuantity_Integration(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test in short mode")
+	}
+	if testDBConnString == "" {
+		t.Skip("Skipping integration test: database not available")
+	}
+
+	ctx := context.Background()
+	ensureMigrations(t)
+
+	userRepo := NewUserRepository(testPool)
+	testUser := &domain.User{
+		Username: "adjust_quantity_test_user",
+		TwitchID: "twitch_adjust_quantity_123",
+	}
+	if err := userRepo.UpsertUser(ctx, testUser); err != nil {
+		t.Fatalf("failed to create test user: %v", err)
+	}
+	item, err := userRepo.GetItemByName(ctx, domain.ItemLootbox1)
+	if err != nil || item == nil {
+		t.Fatalf("failed to get item: %v", err)
+	}
+	other := domain.InventorySlot{ItemID: item.ID, Quantity: 7, QualityLevel: domain.QualityRare}
+	if err := userRepo.UpdateInventory(ctx, testUser.ID, domain.Inventory{Slots: []domain.InventorySlot{other}}); err != nil {
+		t.Fatalf("failed to reset inventory: %v", err)
+	}
+
+	adjust := func(delta int) (int, error) {
+		tx, err := userRepo.BeginTx(ctx)
+		if err != nil {
+			return 0, err
+		}
+		defer repository.SafeRollback(ctx, tx)
+		qty, err := tx.AdjustItemQuantity(ctx, testUser.ID, item.ID, domain.QualityCommon, delta)
+		if err != nil {
+			return 0, err
+		}
+		return qty, tx.Commit(ctx)
+	}
+
+	const concurrentOps = 20
+	var wg sync.WaitGroup
+	errChan := make(chan error, concurrentOps)
+	for i := 0; i < concurrentOps; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := adjust(2); err != nil {
+				errChan <- err
+			}
+		}()
+	}
+	wg.Wait()
+	close(errChan)
+	for err := range errChan {
+		t.Fatalf("concurrent adjustment failed: %v", err)
+	}
+
+	if _, err := adjust(-(2*concurrentOps + 1)); !errors.Is(err, domain.ErrInsufficientQuantity) {
+		t.Fatalf("expected ErrInsufficientQuantity, got %v", err)
+	}
+	if qty, err := adjust(-2 * concurrentOps); err != nil || qty != 0 {
+		t.Fatalf("expected slot to drain to 0, got %d, %v", qty, err)
+	}
+
+	inv, err := userRepo.GetInventory(ctx, testUser.ID)
+	if err != nil {
+		t.Fatalf("failed to get inventory: %v", err)
+	}
+	if len(inv.Slots) != 1 || inv.Slots[0] != other {
+		t.Errorf("expected only the rare slot to remain, got %+v", inv.Slots)
+	}
+}
+
+// TestAdjustItemQuantity_DuplicateSlots verifies that only the first slot of
+// a duplicated (item, quality) pair is adjusted, matching
+// utils.AdjustSlotQuantity: debits are bounded by that slot, and draining it
+// leaves the later duplicate untouched.
+func TestAdjustItemQuantity_DuplicateSlots(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test in short mode")
+	}
+	if testDBConnString == "" {
+		t.Skip("Skipping integration test: database not available")
+	}
+
+	ctx := context.Background()
+	ensureMigrations(t)
+
+	userRepo := NewUserRepository(testPool)
+	testUser := &domain.User{
+		Username: "adjust_duplicate_test_user",
+		TwitchID: "twitch_adjust_duplicate_123",
+	}
+	if err := userRepo.UpsertUser(ctx, testUser); err != nil {
+		t.Fatalf("failed to create test user: %v", err)
+	}
+	item, err := userRepo.GetItemByName(ctx, domain.ItemLootbox1)
+	if err != nil || item == nil {
+		t.Fatalf("failed to get item: %v", err)
+	}
+	first := domain.InventorySlot{ItemID: item.ID, Quantity: 3, QualityLevel: domain.QualityCommon}
+	second := domain.InventorySlot{ItemID: item.ID, Quantity: 5, QualityLevel: domain.QualityCommon}
+	if err := userRepo.UpdateInventory(ctx, testUser.ID, domain.Inventory{Slots: []domain.InventorySlot{first, second}}); err != nil {
+		t.Fatalf("failed to reset inventory: %v", err)
+	}
+
+	adjust := func(delta int) (int, error) {
+		tx, err := userRepo.BeginTx(ctx)
+		if err != nil {
+			return 0, err
+		}
+		defer repository.SafeRollback(ctx, tx)
+		qty, err := tx.AdjustItemQuantity(ctx, testUser.ID, item.ID, domain.QualityCommon, delta)
+		if err != nil {
+			return 0, err
+		}
+		return qty, tx.Commit(ctx)
+	}
+
+	if qty, err := adjust(2); err != nil || qty != 5 {
+		t.Fatalf("expected first slot to grow to 5, got %d, %v", qty, err)
+	}
+	if _, err := adjust(-6); !errors.Is(err, domain.ErrInsufficientQuantity) {
+		t.Fatalf("expected ErrInsufficientQuantity beyond the first slot, got %v", err)
+	}
+	if qty, err := adjust(-5); err != nil || qty != 0 {
+		t.Fatalf("expected first slot to drain to 0, got %d, %v", qty, err)
+	}
+
+	inv, err := userRepo.GetInventory(ctx, testUser.ID)
+	if err != nil {
+		t.Fatalf("failed to get inventory: %v", err)
+	}
+	if len(inv.Slots) != 1 || inv.Slots[0] != second {
+		t.Errorf("expected only the second duplicate to remain, got %+v", inv.Slots)
+	}
+}
+
 // mockNamingResolver is a minimal implementation for testing
 type mockNamingResolver struct{}
 
@@ -126,10 +358,22 @@ func (m *mockNamingResolver) ResolveInternalName(internalName string) (string, b
 	return internalName, true
 }
 
+func (m *mockNamingResolver) GetDisplayNameForTheme(internalName string, qualityLevel domain.QualityLevel, theme string) string {
+	return internalName
+}
+
 func (m *mockNamingResolver) GetActiveTheme() string {
 	return ""
 }
 
+func (m *mockNamingResolver) GetThemes() map[string]naming.ThemePeriod {
+	return nil
+}
+
+func (m *mockNamingResolver) SuggestNames(name string) []string {
+	return nil
+}
+
 func (m *mockNamingResolver) Reload() error {
 	return nil
 }
@@ -27,6 +27,29 @@ const (
 	EmptyInventoryJSON = `{"slots": []}`
 )
 
+// Inventory change reasons - used when the context names no reason
+const (
+	InventorySourceUser       = "user"
+	InventorySourceCrafting   = "crafting"
+	InventorySourceEconomy    = "economy"
+	InventorySourceHarvest    = "harvest"
+	InventorySourceCompost    = "compost"
+	InventorySourceGamble     = "gamble"
+	InventorySourceExpedition = "expedition"
+	InventorySourceLoan       = "loan"
+	InventorySourcePlayerShop = "player_shop"
+	InventorySourceCommunity  = "community_pool"
+	InventorySourceMerge      = "account_merge"
+	InventorySourceUndo       = "undo"
+	InventorySourceModeration = "moderation"
+	InventorySourceJackpot    = "jackpot"
+	InventorySourceBank       = "bank"
+	InventorySourceGift       = "gift"
+
+	// LogMsgInventoryEventPublishFailed is logged when an inventory diff cannot be published
+	LogMsgInventoryEventPublishFailed = "failed to publish inventory changed event"
+)
+
 // Error Messages - Transaction Operations
 const (
 	ErrMsgFailedToBeginTransaction       = "failed to begin transaction"
@@ -10,6 +10,7 @@ import (
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 
+	"github.com/osse101/BrandishBot_Go/internal/community"
 	"github.com/osse101/BrandishBot_Go/internal/database/generated"
 	"github.com/osse101/BrandishBot_Go/internal/domain"
 	"github.com/osse101/BrandishBot_Go/internal/repository"
@@ -17,23 +18,25 @@ import (
 
 // CraftingRepository implements the crafting repository for PostgreSQL
 type CraftingRepository struct {
-	db *pgxpool.Pool
-	q  *generated.Queries
-	// Helpers for shared logic could be injected or just duplicated if simple
+	db        *pgxpool.Pool
+	q         *generated.Queries
+	inventory *inventoryEvents
 }
 
 // NewCraftingRepository creates a new CraftingRepository
-func NewCraftingRepository(db *pgxpool.Pool) *CraftingRepository {
+func NewCraftingRepository(db *pgxpool.Pool, opts ...RepositoryOption) *CraftingRepository {
 	return &CraftingRepository{
-		db: db,
-		q:  generated.New(db),
+		db:        db,
+		q:         generated.New(db),
+		inventory: newInventoryEvents(InventorySourceCrafting, opts),
 	}
 }
 
 // CraftingTx implements repository.CraftingTx
 type CraftingTx struct {
-	tx pgx.Tx
-	q  *generated.Queries
+	tx        pgx.Tx
+	q         *generated.Queries
+	inventory *inventoryJournal
 }
 
 // BeginTx starts a new transaction
@@ -43,14 +46,19 @@ func (r *CraftingRepository) BeginTx(ctx context.Context) (repository.CraftingTx
 		return nil, fmt.Errorf("failed to begin transaction: %w", err)
 	}
 	return &CraftingTx{
-		tx: tx,
-		q:  r.q.WithTx(tx),
+		tx:        tx,
+		q:         r.q.WithTx(tx),
+		inventory: r.inventory.begin(),
 	}, nil
 }
 
-// Commit commits the transaction
+// Commit commits the transaction and publishes its inventory changes
 func (t *CraftingTx) Commit(ctx context.Context) error {
-	return t.tx.Commit(ctx)
+	if err := t.tx.Commit(ctx); err != nil {
+		return err
+	}
+	t.inventory.flush(ctx)
+	return nil
 }
 
 // Rollback rolls back the transaction
@@ -67,6 +75,7 @@ func (r *CraftingRepository) GetUserByPlatformID(ctx context.Context, platform,
 	row, err := r.q.GetUserByPlatformID(ctx, generated.GetUserByPlatformIDParams{
 		Name:           platform,
 		PlatformUserID: platformID,
+		CommunityID:    community.FromContext(ctx),
 	})
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
@@ -79,17 +88,17 @@ func (r *CraftingRepository) GetUserByPlatformID(ctx context.Context, platform,
 
 // GetItemByName retrieves an item by its internal name
 func (r *CraftingRepository) GetItemByName(ctx context.Context, itemName string) (*domain.Item, error) {
-	return getItemByName(ctx, r.q, itemName)
+	return itemCatalog.itemByName(ctx, r.q, itemName)
 }
 
 // GetItemByID retrieves an item by its ID
 func (r *CraftingRepository) GetItemByID(ctx context.Context, id int) (*domain.Item, error) {
-	return getItemByID(ctx, r.q, id)
+	return itemCatalog.itemByID(ctx, r.q, id)
 }
 
 // GetItemsByIDs retrieves multiple items by their IDs
 func (r *CraftingRepository) GetItemsByIDs(ctx context.Context, itemIDs []int) ([]domain.Item, error) {
-	return getItemsByIDs(ctx, r.q, itemIDs)
+	return itemCatalog.itemsByIDs(ctx, r.q, itemIDs)
 }
 
 // GetInventory retrieves the user's inventory
@@ -99,17 +108,17 @@ func (r *CraftingRepository) GetInventory(ctx context.Context, userID string) (*
 
 // UpdateInventory updates the user's inventory
 func (r *CraftingRepository) UpdateInventory(ctx context.Context, userID string, inventory domain.Inventory) error {
-	return updateInventory(ctx, r.q, userID, inventory)
+	return updateInventory(ctx, r.q, userID, inventory, r.inventory.direct())
 }
 
-// GetInventory for Tx
+// GetInventory for Tx, locking the inventory row until the transaction ends
 func (t *CraftingTx) GetInventory(ctx context.Context, userID string) (*domain.Inventory, error) {
-	return getInventory(ctx, t.q, userID)
+	return getInventoryForUpdate(ctx, t.q, userID)
 }
 
 // UpdateInventory for Tx
 func (t *CraftingTx) UpdateInventory(ctx context.Context, userID string, inventory domain.Inventory) error {
-	return updateInventory(ctx, t.q, userID, inventory)
+	return updateInventory(ctx, t.q, userID, inventory, t.inventory)
 }
 
 // GetRecipeByTargetItemID retrieves a recipe by its target item ID
@@ -128,6 +137,7 @@ func (r *CraftingRepository) GetRecipeByTargetItemID(ctx context.Context, itemID
 		TargetItemID:     int(row.TargetItemID),
 		RequiredJobLevel: int(row.RequiredJobLevel),
 		IsAutoUnlock:     row.IsAutoUnlock,
+		EventTheme:       row.EventTheme,
 		CreatedAt:        row.CreatedAt.Time,
 	}
 
@@ -274,6 +284,7 @@ func (r *CraftingRepository) GetAllCraftingRecipes(ctx context.Context) ([]domai
 			TargetItemID:     int(row.TargetItemID),
 			RequiredJobLevel: int(row.RequiredJobLevel),
 			IsAutoUnlock:     row.IsAutoUnlock,
+			EventTheme:       row.EventTheme,
 			CreatedAt:        row.CreatedAt.Time,
 		}
 
@@ -343,6 +354,7 @@ func (r *CraftingRepository) GetCraftingRecipeByKey(ctx context.Context, recipeK
 		TargetItemID:     int(row.TargetItemID),
 		RequiredJobLevel: int(row.RequiredJobLevel),
 		IsAutoUnlock:     row.IsAutoUnlock,
+		EventTheme:       row.EventTheme,
 		CreatedAt:        row.CreatedAt.Time,
 	}
 
@@ -404,6 +416,7 @@ func (r *CraftingRepository) InsertCraftingRecipe(ctx context.Context, recipe *d
 		BaseCost:         baseCostJSON,
 		RequiredJobLevel: int32(recipe.RequiredJobLevel),
 		IsAutoUnlock:     recipe.IsAutoUnlock,
+		EventTheme:       recipe.EventTheme,
 	})
 	if err != nil {
 		return 0, fmt.Errorf("failed to insert crafting recipe: %w", err)
@@ -439,6 +452,7 @@ func (r *CraftingRepository) UpdateCraftingRecipe(ctx context.Context, recipeID
 		BaseCost:         baseCostJSON,
 		RequiredJobLevel: int32(recipe.RequiredJobLevel),
 		IsAutoUnlock:     recipe.IsAutoUnlock,
+		EventTheme:       recipe.EventTheme,
 		RecipeID:         int32(recipeID),
 	})
 	if err != nil {
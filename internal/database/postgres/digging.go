@@ -0,0 +1,132 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/osse101/BrandishBot_Go/internal/community"
+	"github.com/osse101/BrandishBot_Go/internal/database/generated"
+	"github.com/osse101/BrandishBot_Go/internal/digging"
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/repository"
+)
+
+type diggingRepository struct {
+	db *pgxpool.Pool
+	q  *generated.Queries
+}
+
+// NewDiggingRepository creates a PostgreSQL repository for each community's
+// dig site and its diggers
+func NewDiggingRepository(pool *pgxpool.Pool) digging.Repository {
+	return &diggingRepository{db: pool, q: generated.New(pool)}
+}
+
+// BeginTx starts a transaction and returns a digging.Tx
+func (r *diggingRepository) BeginTx(ctx context.Context) (digging.Tx, error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin digging transaction: %w", err)
+	}
+	return &diggingTx{tx: tx, q: r.q.WithTx(tx)}, nil
+}
+
+func (r *diggingRepository) GetSite(ctx context.Context) (*domain.DigSite, error) {
+	row, err := r.q.GetDigSite(ctx, community.FromContext(ctx))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			// Nobody in the community has dug yet
+			return &domain.DigSite{}, nil
+		}
+		return nil, fmt.Errorf("failed to get dig site: %w", err)
+	}
+	return &domain.DigSite{
+		Depth:          int(row.Depth),
+		TotalDigs:      int(row.TotalDigs),
+		MilestoneLevel: int(row.MilestoneLevel),
+		UpdatedAt:      row.UpdatedAt.Time,
+	}, nil
+}
+
+func (r *diggingRepository) GetTopDiggers(ctx context.Context, limit int) ([]domain.Digger, error) {
+	rows, err := r.q.GetTopDigSiteDiggers(ctx, generated.GetTopDigSiteDiggersParams{
+		CommunityID: community.FromContext(ctx),
+		Limit:       int32(limit),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get top diggers: %w", err)
+	}
+	diggers := make([]domain.Digger, 0, len(rows))
+	for _, row := range rows {
+		diggers = append(diggers, domain.Digger{
+			UserID:    row.UserID.String(),
+			Username:  row.Username,
+			Meters:    int(row.Meters),
+			Digs:      int(row.Digs),
+			LastDugAt: row.LastDugAt.Time,
+		})
+	}
+	return diggers, nil
+}
+
+// diggingTx implements digging.Tx
+type diggingTx struct {
+	tx pgx.Tx
+	q  *generated.Queries
+}
+
+func (t *diggingTx) Commit(ctx context.Context) error {
+	return t.tx.Commit(ctx)
+}
+
+func (t *diggingTx) Rollback(ctx context.Context) error {
+	err := t.tx.Rollback(ctx)
+	if errors.Is(err, pgx.ErrTxClosed) {
+		return fmt.Errorf("%w: %w", repository.ErrTxClosed, err)
+	}
+	return err
+}
+
+func (t *diggingTx) Deepen(ctx context.Context, meters int) (*domain.DigSite, error) {
+	row, err := t.q.DeepenDigSite(ctx, generated.DeepenDigSiteParams{
+		CommunityID: community.FromContext(ctx),
+		Depth:       int32(meters),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to deepen dig site: %w", err)
+	}
+	return &domain.DigSite{
+		Depth:          int(row.Depth),
+		TotalDigs:      int(row.TotalDigs),
+		MilestoneLevel: int(row.MilestoneLevel),
+		UpdatedAt:      row.UpdatedAt.Time,
+	}, nil
+}
+
+func (t *diggingTx) RecordDigger(ctx context.Context, userID string, meters int) error {
+	userUUID, err := parseUserUUID(userID)
+	if err != nil {
+		return err
+	}
+	if err := t.q.UpsertDigSiteDigger(ctx, generated.UpsertDigSiteDiggerParams{
+		UserID: userUUID,
+		Meters: int32(meters),
+	}); err != nil {
+		return fmt.Errorf("failed to record digger: %w", err)
+	}
+	return nil
+}
+
+func (t *diggingTx) SetMilestoneLevel(ctx context.Context, level int) error {
+	if err := t.q.SetDigSiteMilestoneLevel(ctx, generated.SetDigSiteMilestoneLevelParams{
+		MilestoneLevel: int32(level),
+		CommunityID:    community.FromContext(ctx),
+	}); err != nil {
+		return fmt.Errorf("failed to set dig milestone level: %w", err)
+	}
+	return nil
+}
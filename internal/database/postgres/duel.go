@@ -10,6 +10,7 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/jackc/pgx/v5/pgxpool"
 
+	"github.com/osse101/BrandishBot_Go/internal/community"
 	"github.com/osse101/BrandishBot_Go/internal/database/generated"
 	"github.com/osse101/BrandishBot_Go/internal/domain"
 	"github.com/osse101/BrandishBot_Go/internal/repository"
@@ -243,6 +244,7 @@ func (r *duelRepository) GetUserByPlatformID(ctx context.Context, platform, plat
 	user, err := r.q.GetUserByPlatformID(ctx, generated.GetUserByPlatformIDParams{
 		Name:           platform,
 		PlatformUserID: platformID,
+		CommunityID:    community.FromContext(ctx),
 	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to get user by platform id: %w", err)
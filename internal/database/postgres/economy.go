@@ -8,6 +8,7 @@ import (
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 
+	"github.com/osse101/BrandishBot_Go/internal/community"
 	"github.com/osse101/BrandishBot_Go/internal/database/generated"
 	"github.com/osse101/BrandishBot_Go/internal/domain"
 	"github.com/osse101/BrandishBot_Go/internal/repository"
@@ -15,22 +16,25 @@ import (
 
 // EconomyRepository implements the economy repository for PostgreSQL
 type EconomyRepository struct {
-	db *pgxpool.Pool
-	q  *generated.Queries
+	db        *pgxpool.Pool
+	q         *generated.Queries
+	inventory *inventoryEvents
 }
 
 // NewEconomyRepository creates a new EconomyRepository
-func NewEconomyRepository(db *pgxpool.Pool) *EconomyRepository {
+func NewEconomyRepository(db *pgxpool.Pool, opts ...RepositoryOption) *EconomyRepository {
 	return &EconomyRepository{
-		db: db,
-		q:  generated.New(db),
+		db:        db,
+		q:         generated.New(db),
+		inventory: newInventoryEvents(InventorySourceEconomy, opts),
 	}
 }
 
 // EconomyTx implements repository.EconomyTx
 type EconomyTx struct {
-	tx pgx.Tx
-	q  *generated.Queries
+	tx        pgx.Tx
+	q         *generated.Queries
+	inventory *inventoryJournal
 }
 
 // BeginTx starts a new transaction
@@ -40,14 +44,19 @@ func (r *EconomyRepository) BeginTx(ctx context.Context) (repository.EconomyTx,
 		return nil, fmt.Errorf("failed to begin transaction: %w", err)
 	}
 	return &EconomyTx{
-		tx: tx,
-		q:  r.q.WithTx(tx),
+		tx:        tx,
+		q:         r.q.WithTx(tx),
+		inventory: r.inventory.begin(),
 	}, nil
 }
 
-// Commit commits the transaction
+// Commit commits the transaction and publishes its inventory changes
 func (t *EconomyTx) Commit(ctx context.Context) error {
-	return t.tx.Commit(ctx)
+	if err := t.tx.Commit(ctx); err != nil {
+		return err
+	}
+	t.inventory.flush(ctx)
+	return nil
 }
 
 // Rollback rolls back the transaction
@@ -64,6 +73,7 @@ func (r *EconomyRepository) GetUserByPlatformID(ctx context.Context, platform, p
 	row, err := r.q.GetUserByPlatformID(ctx, generated.GetUserByPlatformIDParams{
 		Name:           platform,
 		PlatformUserID: platformID,
+		CommunityID:    community.FromContext(ctx),
 	})
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
@@ -76,7 +86,7 @@ func (r *EconomyRepository) GetUserByPlatformID(ctx context.Context, platform, p
 
 // GetItemByName retrieves an item by its internal name
 func (r *EconomyRepository) GetItemByName(ctx context.Context, itemName string) (*domain.Item, error) {
-	return getItemByName(ctx, r.q, itemName)
+	return itemCatalog.itemByName(ctx, r.q, itemName)
 }
 
 // GetInventory retrieves the user's inventory
@@ -86,17 +96,27 @@ func (r *EconomyRepository) GetInventory(ctx context.Context, userID string) (*d
 
 // UpdateInventory updates the user's inventory
 func (r *EconomyRepository) UpdateInventory(ctx context.Context, userID string, inventory domain.Inventory) error {
-	return updateInventory(ctx, r.q, userID, inventory)
+	return updateInventory(ctx, r.q, userID, inventory, r.inventory.direct())
 }
 
-// GetInventory for Tx
+// GetInventory for Tx, locking the inventory row until the transaction ends
 func (t *EconomyTx) GetInventory(ctx context.Context, userID string) (*domain.Inventory, error) {
-	return getInventory(ctx, t.q, userID)
+	return getInventoryForUpdate(ctx, t.q, userID)
 }
 
 // UpdateInventory for Tx
 func (t *EconomyTx) UpdateInventory(ctx context.Context, userID string, inventory domain.Inventory) error {
-	return updateInventory(ctx, t.q, userID, inventory)
+	return updateInventory(ctx, t.q, userID, inventory, t.inventory)
+}
+
+// AdjustItemQuantity for Tx
+func (t *EconomyTx) AdjustItemQuantity(ctx context.Context, userID string, itemID int, quality domain.QualityLevel, delta int) (int, error) {
+	return adjustItemQuantity(ctx, t.q, userID, itemID, quality, delta, t.inventory)
+}
+
+// AddToCommunityPool credits the community pool within the transaction
+func (t *EconomyTx) AddToCommunityPool(ctx context.Context, amount int) error {
+	return addToCommunityPool(ctx, t.q, amount)
 }
 
 // GetSellablePrices retrieves all sellable items with their prices
@@ -0,0 +1,127 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/osse101/BrandishBot_Go/internal/community"
+	"github.com/osse101/BrandishBot_Go/internal/database/generated"
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/effects"
+)
+
+type effectRepository struct {
+	q *generated.Queries
+}
+
+// NewEffectRepository creates a new PostgreSQL user effect repository
+func NewEffectRepository(pool *pgxpool.Pool) effects.Repository {
+	return &effectRepository{q: generated.New(pool)}
+}
+
+// GrantEffect adds or strengthens a user's effect
+func (r *effectRepository) GrantEffect(ctx context.Context, userID string, effectType domain.EffectType, magnitude float64, duration time.Duration) (*domain.Effect, error) {
+	userUUID, err := parseUserUUID(userID)
+	if err != nil {
+		return nil, err
+	}
+	row, err := r.q.GrantUserEffect(ctx, generated.GrantUserEffectParams{
+		UserID:     userUUID,
+		EffectType: string(effectType),
+		Magnitude:  magnitude,
+		DurationMs: duration.Milliseconds(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to grant effect: %w", err)
+	}
+	effect := mapUserEffect(row)
+	return &effect, nil
+}
+
+// GetActiveEffects returns the user's unexpired effects
+func (r *effectRepository) GetActiveEffects(ctx context.Context, userID string) ([]domain.Effect, error) {
+	userUUID, err := parseUserUUID(userID)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := r.q.ListActiveUserEffects(ctx, userUUID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get effects: %w", err)
+	}
+	result := make([]domain.Effect, 0, len(rows))
+	for _, row := range rows {
+		result = append(result, mapUserEffect(row))
+	}
+	return result, nil
+}
+
+// DeleteExpiredEffects removes effects that expired at or before now
+func (r *effectRepository) DeleteExpiredEffects(ctx context.Context, now time.Time) (int, error) {
+	removed, err := r.q.DeleteExpiredUserEffects(ctx, pgtype.Timestamptz{Time: now, Valid: true})
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete expired effects: %w", err)
+	}
+	return int(removed), nil
+}
+
+// GrantCommunityEffect adds or strengthens an effect on the current community
+func (r *effectRepository) GrantCommunityEffect(ctx context.Context, effectType domain.EffectType, magnitude float64, duration time.Duration, source string) (*domain.CommunityEffect, error) {
+	row, err := r.q.GrantCommunityEffect(ctx, generated.GrantCommunityEffectParams{
+		CommunityID: community.FromContext(ctx),
+		EffectType:  string(effectType),
+		Magnitude:   magnitude,
+		Source:      source,
+		DurationMs:  duration.Milliseconds(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to grant community effect: %w", err)
+	}
+	effect := mapCommunityEffect(row)
+	return &effect, nil
+}
+
+// GetActiveCommunityEffects returns the current community's unexpired effects
+func (r *effectRepository) GetActiveCommunityEffects(ctx context.Context) ([]domain.CommunityEffect, error) {
+	rows, err := r.q.ListActiveCommunityEffects(ctx, community.FromContext(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get community effects: %w", err)
+	}
+	result := make([]domain.CommunityEffect, 0, len(rows))
+	for _, row := range rows {
+		result = append(result, mapCommunityEffect(row))
+	}
+	return result, nil
+}
+
+// DeleteExpiredCommunityEffects removes community effects that expired at or before now
+func (r *effectRepository) DeleteExpiredCommunityEffects(ctx context.Context, now time.Time) (int, error) {
+	removed, err := r.q.DeleteExpiredCommunityEffects(ctx, pgtype.Timestamptz{Time: now, Valid: true})
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete expired community effects: %w", err)
+	}
+	return int(removed), nil
+}
+
+func mapUserEffect(row generated.UserEffect) domain.Effect {
+	return domain.Effect{
+		UserID:    row.UserID.String(),
+		Type:      domain.EffectType(row.EffectType),
+		Magnitude: row.Magnitude,
+		ExpiresAt: row.ExpiresAt.Time,
+		CreatedAt: row.CreatedAt.Time,
+	}
+}
+
+func mapCommunityEffect(row generated.CommunityEffect) domain.CommunityEffect {
+	return domain.CommunityEffect{
+		Type:      domain.EffectType(row.EffectType),
+		Magnitude: row.Magnitude,
+		Source:    row.Source,
+		ExpiresAt: row.ExpiresAt.Time,
+		CreatedAt: row.CreatedAt.Time,
+	}
+}
@@ -0,0 +1,112 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/osse101/BrandishBot_Go/internal/database/generated"
+	"github.com/osse101/BrandishBot_Go/internal/eventdlq"
+)
+
+type eventDeadLetterRepository struct {
+	q *generated.Queries
+}
+
+// NewEventDeadLetterRepository creates a new PostgreSQL dead-letter repository
+func NewEventDeadLetterRepository(pool *pgxpool.Pool) eventdlq.Repository {
+	return &eventDeadLetterRepository{q: generated.New(pool)}
+}
+
+// Insert stores a new pending entry and returns its ID
+func (r *eventDeadLetterRepository) Insert(ctx context.Context, entry eventdlq.Entry) (int64, error) {
+	eventJSON, err := json.Marshal(entry.Event)
+	if err != nil {
+		return 0, fmt.Errorf("failed to marshal dead-letter event: %w", err)
+	}
+
+	return r.q.InsertEventDeadLetter(ctx, generated.InsertEventDeadLetterParams{
+		EventType:   entry.EventType,
+		HandlerName: entry.HandlerName,
+		Event:       eventJSON,
+		Attempts:    int32(entry.Attempts),
+		LastError:   entry.LastError,
+	})
+}
+
+// Get retrieves an entry by ID (returns nil, nil if not found)
+func (r *eventDeadLetterRepository) Get(ctx context.Context, id int64) (*eventdlq.Entry, error) {
+	row, err := r.q.GetEventDeadLetter(ctx, id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get dead-letter entry: %w", err)
+	}
+	entry, err := mapEventDeadLetter(row)
+	if err != nil {
+		return nil, err
+	}
+	return &entry, nil
+}
+
+// List retrieves entries matching the filter, newest first
+func (r *eventDeadLetterRepository) List(ctx context.Context, filter eventdlq.Filter) ([]eventdlq.Entry, error) {
+	params := generated.ListEventDeadLettersParams{Limit: int32(filter.Limit)}
+	if filter.Status != nil {
+		params.Status = pgtype.Text{String: *filter.Status, Valid: true}
+	}
+
+	rows, err := r.q.ListEventDeadLetters(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list dead-letter entries: %w", err)
+	}
+
+	entries := make([]eventdlq.Entry, 0, len(rows))
+	for _, row := range rows {
+		entry, err := mapEventDeadLetter(row)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
+
+// UpdateStatus changes the status of an entry
+func (r *eventDeadLetterRepository) UpdateStatus(ctx context.Context, id int64, status string) error {
+	return r.q.UpdateEventDeadLetterStatus(ctx, generated.UpdateEventDeadLetterStatusParams{
+		ID:     id,
+		Status: status,
+	})
+}
+
+// RecordAttempt increments the attempt count after a failed re-drive
+func (r *eventDeadLetterRepository) RecordAttempt(ctx context.Context, id int64, lastError string) error {
+	return r.q.RecordEventDeadLetterAttempt(ctx, generated.RecordEventDeadLetterAttemptParams{
+		ID:        id,
+		LastError: lastError,
+	})
+}
+
+func mapEventDeadLetter(row generated.EventDeadLetter) (eventdlq.Entry, error) {
+	entry := eventdlq.Entry{
+		ID:          row.ID,
+		EventType:   row.EventType,
+		HandlerName: row.HandlerName,
+		Attempts:    int(row.Attempts),
+		LastError:   row.LastError,
+		Status:      row.Status,
+		CreatedAt:   row.CreatedAt.Time,
+		UpdatedAt:   row.UpdatedAt.Time,
+	}
+	if err := json.Unmarshal(row.Event, &entry.Event); err != nil {
+		return entry, fmt.Errorf("failed to unmarshal dead-letter event: %w", err)
+	}
+	return entry, nil
+}
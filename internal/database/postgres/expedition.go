@@ -24,9 +24,9 @@ type ExpeditionRepository struct {
 }
 
 // NewExpeditionRepository creates a new ExpeditionRepository
-func NewExpeditionRepository(db *pgxpool.Pool) *ExpeditionRepository {
+func NewExpeditionRepository(db *pgxpool.Pool, opts ...RepositoryOption) *ExpeditionRepository {
 	return &ExpeditionRepository{
-		UserRepository: NewUserRepository(db),
+		UserRepository: newUserRepository(db, nil, InventorySourceExpedition, opts),
 		db:             db,
 		q:              generated.New(db),
 	}
@@ -296,21 +296,27 @@ func (r *ExpeditionRepository) BeginExpeditionTx(ctx context.Context) (repositor
 	}
 
 	return &expeditionTx{
-		tx:       tx,
-		q:        r.q.WithTx(tx),
-		userRepo: r.UserRepository,
+		tx:        tx,
+		q:         r.q.WithTx(tx),
+		userRepo:  r.UserRepository,
+		inventory: r.inventory.begin(),
 	}, nil
 }
 
 // expeditionTx implements the ExpeditionTx interface
 type expeditionTx struct {
-	tx       pgx.Tx
-	q        *generated.Queries
-	userRepo *UserRepository
+	tx        pgx.Tx
+	q         *generated.Queries
+	userRepo  *UserRepository
+	inventory *inventoryJournal
 }
 
 func (t *expeditionTx) Commit(ctx context.Context) error {
-	return t.tx.Commit(ctx)
+	if err := t.tx.Commit(ctx); err != nil {
+		return err
+	}
+	t.inventory.flush(ctx)
+	return nil
 }
 
 func (t *expeditionTx) Rollback(ctx context.Context) error {
@@ -399,7 +405,7 @@ func (t *expeditionTx) GetInventory(ctx context.Context, userID string) (*domain
 }
 
 func (t *expeditionTx) UpdateInventory(ctx context.Context, userID string, inventory domain.Inventory) error {
-	userTx := &userTx{tx: t.tx, q: t.q}
+	userTx := &userTx{tx: t.tx, q: t.q, inventory: t.inventory}
 	return userTx.UpdateInventory(ctx, userID, inventory)
 }
 
@@ -432,46 +438,15 @@ func mapExpedition(exp generated.Expedition) *domain.Expedition {
 
 // userTx is a helper to reuse the user repository transaction methods
 type userTx struct {
-	tx pgx.Tx
-	q  *generated.Queries
+	tx        pgx.Tx
+	q         *generated.Queries
+	inventory *inventoryJournal
 }
 
 func (t *userTx) GetInventory(ctx context.Context, userID string) (*domain.Inventory, error) {
-	id, err := uuid.Parse(userID)
-	if err != nil {
-		return nil, fmt.Errorf("invalid user ID: %w", err)
-	}
-
-	row, err := t.q.GetInventoryForUpdate(ctx, id)
-	if err != nil {
-		if errors.Is(err, pgx.ErrNoRows) {
-			return nil, nil
-		}
-		return nil, fmt.Errorf("failed to get inventory: %w", err)
-	}
-
-	var inv domain.Inventory
-	if row != nil {
-		if err := json.Unmarshal(row, &inv); err != nil {
-			return nil, fmt.Errorf("failed to unmarshal inventory: %w", err)
-		}
-	}
-	return &inv, nil
+	return getInventoryForUpdate(ctx, t.q, userID)
 }
 
 func (t *userTx) UpdateInventory(ctx context.Context, userID string, inventory domain.Inventory) error {
-	id, err := uuid.Parse(userID)
-	if err != nil {
-		return fmt.Errorf("invalid user ID: %w", err)
-	}
-
-	invBytes, err := json.Marshal(inventory)
-	if err != nil {
-		return fmt.Errorf("failed to marshal inventory: %w", err)
-	}
-
-	return t.q.UpdateInventory(ctx, generated.UpdateInventoryParams{
-		InventoryData: invBytes,
-		UserID:        id,
-	})
+	return updateInventory(ctx, t.q, userID, inventory, t.inventory)
 }
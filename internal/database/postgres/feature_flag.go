@@ -0,0 +1,109 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/osse101/BrandishBot_Go/internal/database/generated"
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/featureflag"
+)
+
+type featureFlagRepository struct {
+	q *generated.Queries
+}
+
+// NewFeatureFlagRepository creates a new PostgreSQL feature flag repository
+func NewFeatureFlagRepository(pool *pgxpool.Pool) featureflag.Repository {
+	return &featureFlagRepository{q: generated.New(pool)}
+}
+
+// ListFlags returns every flag ordered by key
+func (r *featureFlagRepository) ListFlags(ctx context.Context) ([]domain.FeatureFlag, error) {
+	rows, err := r.q.ListFeatureFlags(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list feature flags: %w", err)
+	}
+	flags := make([]domain.FeatureFlag, 0, len(rows))
+	for _, row := range rows {
+		flag, err := mapFeatureFlag(row)
+		if err != nil {
+			return nil, err
+		}
+		flags = append(flags, flag)
+	}
+	return flags, nil
+}
+
+// GetFlag returns the flag with the key, or nil if there is none
+func (r *featureFlagRepository) GetFlag(ctx context.Context, key string) (*domain.FeatureFlag, error) {
+	row, err := r.q.GetFeatureFlag(ctx, key)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get feature flag: %w", err)
+	}
+	flag, err := mapFeatureFlag(row)
+	if err != nil {
+		return nil, err
+	}
+	return &flag, nil
+}
+
+// UpsertFlag creates or replaces a flag
+func (r *featureFlagRepository) UpsertFlag(ctx context.Context, flag domain.FeatureFlag) (*domain.FeatureFlag, error) {
+	overrides, err := json.Marshal(flag.PlatformOverrides)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode platform overrides: %w", err)
+	}
+	row, err := r.q.UpsertFeatureFlag(ctx, generated.UpsertFeatureFlagParams{
+		Key:               flag.Key,
+		Description:       flag.Description,
+		Enabled:           flag.Enabled,
+		RolloutPercent:    int32(flag.RolloutPercent),
+		PlatformOverrides: overrides,
+		UpdatedBy:         flag.UpdatedBy,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to upsert feature flag: %w", err)
+	}
+	stored, err := mapFeatureFlag(row)
+	if err != nil {
+		return nil, err
+	}
+	return &stored, nil
+}
+
+// DeleteFlag removes a flag
+func (r *featureFlagRepository) DeleteFlag(ctx context.Context, key string) (bool, error) {
+	deleted, err := r.q.DeleteFeatureFlag(ctx, key)
+	if err != nil {
+		return false, fmt.Errorf("failed to delete feature flag: %w", err)
+	}
+	return deleted > 0, nil
+}
+
+func mapFeatureFlag(row generated.FeatureFlag) (domain.FeatureFlag, error) {
+	overrides := map[string]bool{}
+	if len(row.PlatformOverrides) > 0 {
+		if err := json.Unmarshal(row.PlatformOverrides, &overrides); err != nil {
+			return domain.FeatureFlag{}, fmt.Errorf("failed to decode platform overrides for feature flag %s: %w", row.Key, err)
+		}
+	}
+	return domain.FeatureFlag{
+		Key:               row.Key,
+		Description:       row.Description,
+		Enabled:           row.Enabled,
+		RolloutPercent:    int(row.RolloutPercent),
+		PlatformOverrides: overrides,
+		UpdatedBy:         row.UpdatedBy,
+		CreatedAt:         row.CreatedAt.Time,
+		UpdatedAt:         row.UpdatedAt.Time,
+	}, nil
+}
@@ -0,0 +1,63 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/osse101/BrandishBot_Go/internal/database/generated"
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/repository"
+)
+
+type fishingRepository struct {
+	q *generated.Queries
+}
+
+// NewFishingRepository creates a PostgreSQL repository for fishing casts
+func NewFishingRepository(pool *pgxpool.Pool) repository.FishingRepository {
+	return &fishingRepository{q: generated.New(pool)}
+}
+
+func (r *fishingRepository) CreateCast(ctx context.Context, cast *domain.FishingCast) (bool, error) {
+	userUUID, err := parseUserUUID(cast.UserID)
+	if err != nil {
+		return false, err
+	}
+	rows, err := r.q.CreateFishingCast(ctx, generated.CreateFishingCastParams{
+		UserID: userUUID,
+		Tackle: cast.Tackle,
+		CastAt: pgtype.Timestamptz{Time: cast.CastAt, Valid: true},
+		BiteAt: pgtype.Timestamptz{Time: cast.BiteAt, Valid: true},
+		ReelBy: pgtype.Timestamptz{Time: cast.ReelBy, Valid: true},
+	})
+	if err != nil {
+		return false, fmt.Errorf("failed to create fishing cast: %w", err)
+	}
+	return rows > 0, nil
+}
+
+func (r *fishingRepository) TakeCast(ctx context.Context, userID string) (*domain.FishingCast, error) {
+	userUUID, err := parseUserUUID(userID)
+	if err != nil {
+		return nil, err
+	}
+	row, err := r.q.TakeFishingCast(ctx, userUUID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to take fishing cast: %w", err)
+	}
+	return &domain.FishingCast{
+		UserID: row.UserID.String(),
+		Tackle: row.Tackle,
+		CastAt: row.CastAt.Time,
+		BiteAt: row.BiteAt.Time,
+		ReelBy: row.ReelBy.Time,
+	}, nil
+}
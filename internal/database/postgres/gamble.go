@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
@@ -12,6 +13,7 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/jackc/pgx/v5/pgxpool"
 
+	"github.com/osse101/BrandishBot_Go/internal/community"
 	"github.com/osse101/BrandishBot_Go/internal/database/generated"
 	"github.com/osse101/BrandishBot_Go/internal/domain"
 	"github.com/osse101/BrandishBot_Go/internal/logger"
@@ -26,9 +28,9 @@ type GambleRepository struct {
 }
 
 // NewGambleRepository creates a new GambleRepository
-func NewGambleRepository(db *pgxpool.Pool) *GambleRepository {
+func NewGambleRepository(db *pgxpool.Pool, opts ...RepositoryOption) *GambleRepository {
 	return &GambleRepository{
-		UserRepository: NewUserRepository(db),
+		UserRepository: newUserRepository(db, nil, InventorySourceGamble, opts),
 		db:             db,
 		q:              generated.New(db),
 	}
@@ -47,6 +49,7 @@ func (r *GambleRepository) CreateGamble(ctx context.Context, gamble *domain.Gamb
 		State:        string(gamble.State),
 		CreatedAt:    pgtype.Timestamptz{Time: gamble.CreatedAt, Valid: true},
 		JoinDeadline: pgtype.Timestamptz{Time: gamble.JoinDeadline, Valid: true},
+		CommunityID:  community.FromContext(ctx),
 	}
 
 	err = r.q.CreateGamble(ctx, params)
@@ -77,6 +80,7 @@ func (r *GambleRepository) GetGamble(ctx context.Context, id uuid.UUID) (*domain
 		State:        domain.GambleState(g.State),
 		CreatedAt:    g.CreatedAt.Time,
 		JoinDeadline: g.JoinDeadline.Time,
+		CommunityID:  g.CommunityID,
 	}
 
 	// Get Participants
@@ -99,9 +103,40 @@ func (r *GambleRepository) GetGamble(ctx context.Context, id uuid.UUID) (*domain
 		})
 	}
 
+	gamble.SideBets, err = getSideBets(ctx, r.q, id)
+	if err != nil {
+		return nil, err
+	}
+
 	return gamble, nil
 }
 
+func getSideBets(ctx context.Context, q *generated.Queries, gambleID uuid.UUID) ([]domain.SideBet, error) {
+	rows, err := q.GetGambleSideBets(ctx, gambleID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get side bets: %w", err)
+	}
+
+	var sideBets []domain.SideBet
+	for _, b := range rows {
+		bet := domain.SideBet{
+			GambleID:       b.GambleID,
+			UserID:         b.UserID.String(),
+			Username:       b.Username,
+			BackedUserID:   b.BackedUserID.String(),
+			BackedUsername: b.BackedUsername,
+			Amount:         int(b.Amount),
+			CreatedAt:      b.CreatedAt.Time,
+		}
+		if b.Payout.Valid {
+			payout := int(b.Payout.Int32)
+			bet.Payout = &payout
+		}
+		sideBets = append(sideBets, bet)
+	}
+	return sideBets, nil
+}
+
 // JoinGamble adds a participant to a gamble
 func (r *GambleRepository) JoinGamble(ctx context.Context, participant *domain.Participant) error {
 	userID, err := uuid.Parse(participant.UserID)
@@ -221,9 +256,9 @@ func (r *GambleRepository) RefundGamble(ctx context.Context, id uuid.UUID) error
 	return r.UpdateGambleState(ctx, id, domain.GambleStateRefunded)
 }
 
-// GetActiveGamble retrieves the current active gamble (Joining or Opening)
+// GetActiveGamble retrieves the context community's active gamble (Joining or Opening)
 func (r *GambleRepository) GetActiveGamble(ctx context.Context) (*domain.Gamble, error) {
-	g, err := r.q.GetActiveGamble(ctx)
+	g, err := r.q.GetActiveGamble(ctx, community.FromContext(ctx))
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return nil, nil
@@ -237,9 +272,32 @@ func (r *GambleRepository) GetActiveGamble(ctx context.Context) (*domain.Gamble,
 		State:        domain.GambleState(g.State),
 		CreatedAt:    g.CreatedAt.Time,
 		JoinDeadline: g.JoinDeadline.Time,
+		CommunityID:  g.CommunityID,
 	}, nil
 }
 
+// GetStaleGambles returns unfinished gambles (Created, Joining or Opening) whose
+// join deadline passed before the cutoff, oldest first. Participants are not loaded.
+func (r *GambleRepository) GetStaleGambles(ctx context.Context, deadlineBefore time.Time) ([]domain.Gamble, error) {
+	rows, err := r.q.GetStaleGambles(ctx, pgtype.Timestamptz{Time: deadlineBefore, Valid: true})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get stale gambles: %w", err)
+	}
+
+	gambles := make([]domain.Gamble, 0, len(rows))
+	for _, g := range rows {
+		gambles = append(gambles, domain.Gamble{
+			ID:           g.ID,
+			InitiatorID:  g.InitiatorID.String(),
+			State:        domain.GambleState(g.State),
+			CreatedAt:    g.CreatedAt.Time,
+			JoinDeadline: g.JoinDeadline.Time,
+			CommunityID:  g.CommunityID,
+		})
+	}
+	return gambles, nil
+}
+
 // BeginGambleTx starts a transaction and returns a GambleTx for gamble operations
 func (r *GambleRepository) BeginGambleTx(ctx context.Context) (repository.GambleTx, error) {
 	tx, err := r.db.Begin(ctx)
@@ -247,22 +305,28 @@ func (r *GambleRepository) BeginGambleTx(ctx context.Context) (repository.Gamble
 		return nil, fmt.Errorf("failed to begin gamble transaction: %w", err)
 	}
 	return &gambleTx{
-		tx:       tx,
-		userRepo: r.UserRepository,
-		q:        r.q.WithTx(tx),
+		tx:        tx,
+		userRepo:  r.UserRepository,
+		q:         r.q.WithTx(tx),
+		inventory: r.inventory.begin(),
 	}, nil
 }
 
 // gambleTx implements repository.GambleTx interface
 type gambleTx struct {
-	tx       pgx.Tx
-	userRepo *UserRepository
-	q        *generated.Queries
+	tx        pgx.Tx
+	userRepo  *UserRepository
+	q         *generated.Queries
+	inventory *inventoryJournal
 }
 
-// Commit commits the transaction
+// Commit commits the transaction and publishes its inventory changes
 func (t *gambleTx) Commit(ctx context.Context) error {
-	return t.tx.Commit(ctx)
+	if err := t.tx.Commit(ctx); err != nil {
+		return err
+	}
+	t.inventory.flush(ctx)
+	return nil
 }
 
 // Rollback rolls back the transaction
@@ -346,6 +410,60 @@ func (t *gambleTx) RefundGamble(ctx context.Context, id uuid.UUID) error {
 	return nil
 }
 
+// PlaceSideBet records a spectator's side bet within transaction. It fails
+// with domain.ErrNotInJoiningState once the gamble has stopped taking bets.
+func (t *gambleTx) PlaceSideBet(ctx context.Context, bet *domain.SideBet) error {
+	userID, err := uuid.Parse(bet.UserID)
+	if err != nil {
+		return fmt.Errorf("invalid user id: %w", err)
+	}
+	backedUserID, err := uuid.Parse(bet.BackedUserID)
+	if err != nil {
+		return fmt.Errorf("invalid backed user id: %w", err)
+	}
+
+	rows, err := t.q.PlaceGambleSideBet(ctx, generated.PlaceGambleSideBetParams{
+		GambleID:     bet.GambleID,
+		UserID:       userID,
+		BackedUserID: backedUserID,
+		Amount:       int32(bet.Amount),
+	})
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			return domain.ErrSideBetAlreadyPlaced
+		}
+		return fmt.Errorf("failed to place side bet: %w", err)
+	}
+	if rows == 0 {
+		return domain.ErrNotInJoiningState
+	}
+	return nil
+}
+
+// GetSideBets reads a gamble's side bets within transaction
+func (t *gambleTx) GetSideBets(ctx context.Context, gambleID uuid.UUID) ([]domain.SideBet, error) {
+	return getSideBets(ctx, t.q, gambleID)
+}
+
+// SettleSideBet records what a side bet paid out within transaction
+func (t *gambleTx) SettleSideBet(ctx context.Context, gambleID uuid.UUID, userID string, payout int) error {
+	uid, err := uuid.Parse(userID)
+	if err != nil {
+		return fmt.Errorf("invalid user id: %w", err)
+	}
+
+	err = t.q.SettleGambleSideBet(ctx, generated.SettleGambleSideBetParams{
+		Payout:   pgtype.Int4{Int32: int32(payout), Valid: true},
+		GambleID: gambleID,
+		UserID:   uid,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to settle side bet: %w", err)
+	}
+	return nil
+}
+
 // GetInventory retrieves inventory within transaction
 func (t *gambleTx) GetInventory(ctx context.Context, userID string) (*domain.Inventory, error) {
 	// Use UserTx wrapper for transactional inventory access with row locking
@@ -356,6 +474,11 @@ func (t *gambleTx) GetInventory(ctx context.Context, userID string) (*domain.Inv
 // UpdateInventory updates inventory within transaction
 func (t *gambleTx) UpdateInventory(ctx context.Context, userID string, inventory domain.Inventory) error {
 	// Use UserTx wrapper for transactional inventory update
-	userTx := &UserTx{tx: t.tx, q: t.q}
+	userTx := &UserTx{tx: t.tx, q: t.q, inventory: t.inventory}
 	return userTx.UpdateInventory(ctx, userID, inventory)
 }
+
+// AddToCommunityPool credits the gamble's rake to the community pool
+func (t *gambleTx) AddToCommunityPool(ctx context.Context, amount int) error {
+	return addToCommunityPool(ctx, t.q, amount)
+}
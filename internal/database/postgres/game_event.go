@@ -0,0 +1,112 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/osse101/BrandishBot_Go/internal/community"
+	"github.com/osse101/BrandishBot_Go/internal/database/generated"
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/gameevent"
+)
+
+type gameEventRepository struct {
+	q *generated.Queries
+}
+
+// NewGameEventRepository creates a new PostgreSQL game event repository
+func NewGameEventRepository(pool *pgxpool.Pool) gameevent.Repository {
+	return &gameEventRepository{q: generated.New(pool)}
+}
+
+// ListEvents returns the events that end after endsAfter, ordered by start
+func (r *gameEventRepository) ListEvents(ctx context.Context, endsAfter time.Time) ([]domain.GameEvent, error) {
+	rows, err := r.q.ListGameEvents(ctx, generated.ListGameEventsParams{
+		CommunityID: community.FromContext(ctx),
+		EndsAt:      pgtype.Timestamptz{Time: endsAfter, Valid: true},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list game events: %w", err)
+	}
+	events := make([]domain.GameEvent, 0, len(rows))
+	for _, row := range rows {
+		events = append(events, mapGameEvent(row))
+	}
+	return events, nil
+}
+
+// GetEvent returns the event with the ID, or nil if there is none
+func (r *gameEventRepository) GetEvent(ctx context.Context, id int64) (*domain.GameEvent, error) {
+	row, err := r.q.GetGameEvent(ctx, generated.GetGameEventParams{
+		ID:          id,
+		CommunityID: community.FromContext(ctx),
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get game event: %w", err)
+	}
+	evt := mapGameEvent(row)
+	return &evt, nil
+}
+
+// CreateEvent stores a new event
+func (r *gameEventRepository) CreateEvent(ctx context.Context, evt domain.GameEvent) (*domain.GameEvent, error) {
+	row, err := r.q.CreateGameEvent(ctx, generated.CreateGameEventParams{
+		Name:                evt.Name,
+		Description:         evt.Description,
+		XpMultiplier:        evt.XPMultiplier,
+		DropRateMultiplier:  evt.DropRateMultiplier,
+		ShopDiscountPercent: int32(evt.ShopDiscountPercent),
+		StartsAt:            pgtype.Timestamptz{Time: evt.StartsAt, Valid: true},
+		EndsAt:              pgtype.Timestamptz{Time: evt.EndsAt, Valid: true},
+		CreatedBy:           evt.CreatedBy,
+		CommunityID:         community.FromContext(ctx),
+		Theme:               evt.Theme,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create game event: %w", err)
+	}
+	stored := mapGameEvent(row)
+	return &stored, nil
+}
+
+// EndEvent moves an event's end to at, or returns nil if it had already ended
+func (r *gameEventRepository) EndEvent(ctx context.Context, id int64, at time.Time) (*domain.GameEvent, error) {
+	row, err := r.q.EndGameEvent(ctx, generated.EndGameEventParams{
+		EndedAt:     pgtype.Timestamptz{Time: at, Valid: true},
+		ID:          id,
+		CommunityID: community.FromContext(ctx),
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to end game event: %w", err)
+	}
+	evt := mapGameEvent(row)
+	return &evt, nil
+}
+
+func mapGameEvent(row generated.GameEvent) domain.GameEvent {
+	return domain.GameEvent{
+		ID:                  row.ID,
+		Name:                row.Name,
+		Description:         row.Description,
+		XPMultiplier:        row.XpMultiplier,
+		DropRateMultiplier:  row.DropRateMultiplier,
+		ShopDiscountPercent: int(row.ShopDiscountPercent),
+		Theme:               row.Theme,
+		StartsAt:            row.StartsAt.Time,
+		EndsAt:              row.EndsAt.Time,
+		CreatedBy:           row.CreatedBy,
+		CreatedAt:           row.CreatedAt.Time,
+	}
+}
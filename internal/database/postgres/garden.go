@@ -0,0 +1,91 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/osse101/BrandishBot_Go/internal/database/generated"
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/repository"
+)
+
+type gardenRepository struct {
+	q *generated.Queries
+}
+
+// NewGardenRepository creates a PostgreSQL repository for garden plots
+func NewGardenRepository(pool *pgxpool.Pool) repository.GardenRepository {
+	return &gardenRepository{q: generated.New(pool)}
+}
+
+func (r *gardenRepository) GetPlots(ctx context.Context, userID string) ([]domain.GardenPlot, error) {
+	userUUID, err := parseUserUUID(userID)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := r.q.ListGardenPlots(ctx, userUUID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list garden plots: %w", err)
+	}
+	return mapGardenPlots(rows), nil
+}
+
+func (r *gardenRepository) PlantPlot(ctx context.Context, userID string, capacity int, plot *domain.GardenPlot) (bool, error) {
+	userUUID, err := parseUserUUID(userID)
+	if err != nil {
+		return false, err
+	}
+	slot, err := r.q.PlantGardenPlot(ctx, generated.PlantGardenPlotParams{
+		UserID:    userUUID,
+		Seed:      plot.Seed,
+		Crop:      plot.Crop,
+		Quantity:  int32(plot.Quantity),
+		PlantedAt: pgtype.Timestamptz{Time: plot.PlantedAt, Valid: true},
+		ReadyAt:   pgtype.Timestamptz{Time: plot.ReadyAt, Valid: true},
+		Capacity:  int32(capacity),
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to plant garden plot: %w", err)
+	}
+	plot.Slot = int(slot)
+	return true, nil
+}
+
+func (r *gardenRepository) TakeReadyPlots(ctx context.Context, userID string, now time.Time) ([]domain.GardenPlot, error) {
+	userUUID, err := parseUserUUID(userID)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := r.q.TakeReadyGardenPlots(ctx, generated.TakeReadyGardenPlotsParams{
+		UserID:  userUUID,
+		ReadyAt: pgtype.Timestamptz{Time: now, Valid: true},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to take ready garden plots: %w", err)
+	}
+	return mapGardenPlots(rows), nil
+}
+
+func mapGardenPlots(rows []generated.GardenPlot) []domain.GardenPlot {
+	plots := make([]domain.GardenPlot, 0, len(rows))
+	for _, row := range rows {
+		plots = append(plots, domain.GardenPlot{
+			Slot:      int(row.Slot),
+			Seed:      row.Seed,
+			Crop:      row.Crop,
+			Quantity:  int(row.Quantity),
+			PlantedAt: row.PlantedAt.Time,
+			ReadyAt:   row.ReadyAt.Time,
+		})
+	}
+	return plots
+}
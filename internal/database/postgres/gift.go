@@ -0,0 +1,222 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/osse101/BrandishBot_Go/internal/database/generated"
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/gift"
+	"github.com/osse101/BrandishBot_Go/internal/repository"
+)
+
+type giftRepository struct {
+	db        *pgxpool.Pool
+	q         *generated.Queries
+	inventory *inventoryEvents
+}
+
+// NewGiftRepository creates a new PostgreSQL gift repository
+func NewGiftRepository(pool *pgxpool.Pool, opts ...RepositoryOption) gift.Repository {
+	return &giftRepository{db: pool, q: generated.New(pool), inventory: newInventoryEvents(InventorySourceGift, opts)}
+}
+
+// BeginTx starts a transaction and returns a gift.Tx
+func (r *giftRepository) BeginTx(ctx context.Context) (gift.Tx, error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin gift transaction: %w", err)
+	}
+	return &giftTx{tx: tx, q: r.q.WithTx(tx), inventory: r.inventory.begin()}, nil
+}
+
+// GetInventory reads a user's inventory without locking it
+func (r *giftRepository) GetInventory(ctx context.Context, userID string) (*domain.Inventory, error) {
+	return getInventory(ctx, r.q, userID)
+}
+
+// GetPendingGifts returns the gifts a user has wrapped that are not yet delivered
+func (r *giftRepository) GetPendingGifts(ctx context.Context, senderID string) ([]domain.Gift, error) {
+	senderUUID, err := parseUserUUID(senderID)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := r.q.ListSenderPendingGifts(ctx, senderUUID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get gifts: %w", err)
+	}
+	gifts := make([]domain.Gift, 0, len(rows))
+	for _, row := range rows {
+		g := mapGift(generated.Gift{
+			ID:                  row.ID,
+			SenderID:            row.SenderID,
+			RecipientID:         row.RecipientID,
+			ItemID:              row.ItemID,
+			QualityLevel:        row.QualityLevel,
+			Quantity:            row.Quantity,
+			Message:             row.Message,
+			Platform:            row.Platform,
+			RecipientPlatformID: row.RecipientPlatformID,
+			ChannelID:           row.ChannelID,
+			Status:              row.Status,
+			DeliverAt:           row.DeliverAt,
+			CreatedAt:           row.CreatedAt,
+			ClosedAt:            row.ClosedAt,
+		})
+		g.RecipientName = row.RecipientName
+		g.ItemName = row.ItemName
+		gifts = append(gifts, g)
+	}
+	return gifts, nil
+}
+
+// CountPendingGifts returns how many gifts a user has waiting to be delivered
+func (r *giftRepository) CountPendingGifts(ctx context.Context, senderID string) (int, error) {
+	senderUUID, err := parseUserUUID(senderID)
+	if err != nil {
+		return 0, err
+	}
+	count, err := r.q.CountSenderPendingGifts(ctx, senderUUID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count gifts: %w", err)
+	}
+	return int(count), nil
+}
+
+// GetDueGiftIDs returns pending gifts due at or before now
+func (r *giftRepository) GetDueGiftIDs(ctx context.Context, now time.Time, limit int) ([]int64, error) {
+	ids, err := r.q.ListDueGiftIDs(ctx, generated.ListDueGiftIDsParams{
+		Now:       pgtype.Timestamptz{Time: now, Valid: true},
+		BatchSize: int32(limit),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get due gifts: %w", err)
+	}
+	return ids, nil
+}
+
+// giftTx implements gift.Tx
+type giftTx struct {
+	tx        pgx.Tx
+	q         *generated.Queries
+	inventory *inventoryJournal
+}
+
+func (t *giftTx) Commit(ctx context.Context) error {
+	if err := t.tx.Commit(ctx); err != nil {
+		return err
+	}
+	t.inventory.flush(ctx)
+	return nil
+}
+
+func (t *giftTx) Rollback(ctx context.Context) error {
+	err := t.tx.Rollback(ctx)
+	if errors.Is(err, pgx.ErrTxClosed) {
+		return fmt.Errorf("%w: %w", repository.ErrTxClosed, err)
+	}
+	return err
+}
+
+func (t *giftTx) AdjustItemQuantity(ctx context.Context, userID string, itemID int, quality domain.QualityLevel, delta int) (int, error) {
+	return adjustItemQuantity(ctx, t.q, userID, itemID, quality, delta, t.inventory)
+}
+
+func (t *giftTx) CreateGift(ctx context.Context, g domain.Gift) (*domain.Gift, error) {
+	senderUUID, err := parseUserUUID(g.SenderID)
+	if err != nil {
+		return nil, err
+	}
+	recipientUUID, err := parseUserUUID(g.RecipientID)
+	if err != nil {
+		return nil, err
+	}
+	row, err := t.q.CreateGift(ctx, generated.CreateGiftParams{
+		SenderID:            senderUUID,
+		RecipientID:         pgtype.UUID{Bytes: recipientUUID, Valid: true},
+		ItemID:              int32(g.ItemID),
+		QualityLevel:        string(g.QualityLevel),
+		Quantity:            int32(g.Quantity),
+		Message:             g.Message,
+		Platform:            g.Platform,
+		RecipientPlatformID: g.RecipientPlatformID,
+		ChannelID:           g.ChannelID,
+		DeliverAt:           pgtype.Timestamptz{Time: g.DeliverAt, Valid: true},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create gift: %w", err)
+	}
+	created := mapGift(row)
+	return &created, nil
+}
+
+func (t *giftTx) GetPendingGiftForUpdate(ctx context.Context, id int64) (*domain.Gift, error) {
+	row, err := t.q.GetPendingGiftForUpdate(ctx, id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get gift: %w", err)
+	}
+	g := mapGift(generated.Gift{
+		ID:                  row.ID,
+		SenderID:            row.SenderID,
+		RecipientID:         row.RecipientID,
+		ItemID:              row.ItemID,
+		QualityLevel:        row.QualityLevel,
+		Quantity:            row.Quantity,
+		Message:             row.Message,
+		Platform:            row.Platform,
+		RecipientPlatformID: row.RecipientPlatformID,
+		ChannelID:           row.ChannelID,
+		Status:              row.Status,
+		DeliverAt:           row.DeliverAt,
+		CreatedAt:           row.CreatedAt,
+		ClosedAt:            row.ClosedAt,
+	})
+	g.SenderName = row.SenderName
+	g.RecipientName = row.RecipientName
+	g.ItemName = row.ItemName
+	return &g, nil
+}
+
+func (t *giftTx) CloseGift(ctx context.Context, id int64, status domain.GiftStatus, closedAt time.Time) error {
+	err := t.q.CloseGift(ctx, generated.CloseGiftParams{
+		ID:       id,
+		Status:   string(status),
+		ClosedAt: pgtype.Timestamptz{Time: closedAt, Valid: true},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to close gift: %w", err)
+	}
+	return nil
+}
+
+func mapGift(row generated.Gift) domain.Gift {
+	g := domain.Gift{
+		ID:                  row.ID,
+		SenderID:            row.SenderID.String(),
+		ItemID:              int(row.ItemID),
+		QualityLevel:        domain.QualityLevel(row.QualityLevel),
+		Quantity:            int(row.Quantity),
+		Message:             row.Message,
+		Platform:            row.Platform,
+		RecipientPlatformID: row.RecipientPlatformID,
+		ChannelID:           row.ChannelID,
+		Status:              domain.GiftStatus(row.Status),
+		DeliverAt:           row.DeliverAt.Time,
+		CreatedAt:           row.CreatedAt.Time,
+		ClosedAt:            ptrTimestamptz(row.ClosedAt),
+	}
+	if row.RecipientID.Valid {
+		g.RecipientID = uuid.UUID(row.RecipientID.Bytes).String()
+	}
+	return g
+}
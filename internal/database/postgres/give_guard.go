@@ -0,0 +1,175 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/osse101/BrandishBot_Go/internal/database/generated"
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/giveguard"
+)
+
+type giveGuardRepository struct {
+	q *generated.Queries
+}
+
+// NewGiveGuardRepository creates a new PostgreSQL give guard repository
+func NewGiveGuardRepository(pool *pgxpool.Pool) giveguard.Repository {
+	return &giveGuardRepository{q: generated.New(pool)}
+}
+
+// GetAccountCreatedAt returns when a user registered
+func (r *giveGuardRepository) GetAccountCreatedAt(ctx context.Context, userID string) (time.Time, error) {
+	userUUID, err := parseUserUUID(userID)
+	if err != nil {
+		return time.Time{}, err
+	}
+	user, err := r.q.GetUserByID(ctx, userUUID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return time.Time{}, domain.ErrUserNotFound
+		}
+		return time.Time{}, fmt.Errorf("failed to get user: %w", err)
+	}
+	return user.CreatedAt.Time, nil
+}
+
+// CountGivesSince counts the gives a user has made since the given time
+func (r *giveGuardRepository) CountGivesSince(ctx context.Context, giverID string, since time.Time) (int, error) {
+	giverUUID, err := parseUserUUID(giverID)
+	if err != nil {
+		return 0, err
+	}
+	count, err := r.q.CountGivesSince(ctx, generated.CountGivesSinceParams{
+		GiverID:   giverUUID,
+		CreatedAt: pgtype.Timestamptz{Time: since, Valid: true},
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to count gives: %w", err)
+	}
+	return int(count), nil
+}
+
+// HasGivenSince reports whether giverID has given receiverID anything since the given time
+func (r *giveGuardRepository) HasGivenSince(ctx context.Context, giverID, receiverID string, since time.Time) (bool, error) {
+	giverUUID, err := parseUserUUID(giverID)
+	if err != nil {
+		return false, err
+	}
+	receiverUUID, err := parseUserUUID(receiverID)
+	if err != nil {
+		return false, err
+	}
+	given, err := r.q.HasGivenSince(ctx, generated.HasGivenSinceParams{
+		GiverID:    giverUUID,
+		ReceiverID: receiverUUID,
+		CreatedAt:  pgtype.Timestamptz{Time: since, Valid: true},
+	})
+	if err != nil {
+		return false, fmt.Errorf("failed to check recent gives: %w", err)
+	}
+	return given, nil
+}
+
+// RecordGive logs a give and clears the giver's entries older than pruneBefore
+func (r *giveGuardRepository) RecordGive(ctx context.Context, giverID, receiverID string, itemID, quantity int, pruneBefore time.Time) error {
+	giverUUID, err := parseUserUUID(giverID)
+	if err != nil {
+		return err
+	}
+	receiverUUID, err := parseUserUUID(receiverID)
+	if err != nil {
+		return err
+	}
+	if err := r.q.DeleteOldGiveLog(ctx, generated.DeleteOldGiveLogParams{
+		GiverID:   giverUUID,
+		CreatedAt: pgtype.Timestamptz{Time: pruneBefore, Valid: true},
+	}); err != nil {
+		return fmt.Errorf("failed to prune give log: %w", err)
+	}
+	if err := r.q.InsertGiveLog(ctx, generated.InsertGiveLogParams{
+		GiverID:    giverUUID,
+		ReceiverID: receiverUUID,
+		ItemID:     int32(itemID),
+		Quantity:   int32(quantity),
+	}); err != nil {
+		return fmt.Errorf("failed to insert give log: %w", err)
+	}
+	return nil
+}
+
+// FlagGive queues a give for admin review
+func (r *giveGuardRepository) FlagGive(ctx context.Context, flag domain.GiveFlag) (int64, error) {
+	giverUUID, err := parseUserUUID(flag.GiverID)
+	if err != nil {
+		return 0, err
+	}
+	receiverUUID, err := parseUserUUID(flag.ReceiverID)
+	if err != nil {
+		return 0, err
+	}
+	id, err := r.q.InsertGiveFlag(ctx, generated.InsertGiveFlagParams{
+		GiverID:    giverUUID,
+		ReceiverID: receiverUUID,
+		ItemID:     int32(flag.ItemID),
+		Quantity:   int32(flag.Quantity),
+		Reason:     flag.Reason,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to insert give flag: %w", err)
+	}
+	return id, nil
+}
+
+// ListFlags returns the flags in a status, newest first
+func (r *giveGuardRepository) ListFlags(ctx context.Context, status domain.GiveFlagStatus, limit int) ([]domain.GiveFlag, error) {
+	rows, err := r.q.ListGiveFlags(ctx, generated.ListGiveFlagsParams{
+		Status: string(status),
+		Limit:  int32(limit),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list give flags: %w", err)
+	}
+	flags := make([]domain.GiveFlag, 0, len(rows))
+	for _, row := range rows {
+		flag := domain.GiveFlag{
+			ID:           row.ID,
+			GiverID:      row.GiverID.String(),
+			GiverName:    row.GiverName,
+			ReceiverID:   row.ReceiverID.String(),
+			ReceiverName: row.ReceiverName,
+			ItemID:       int(row.ItemID),
+			ItemName:     row.ItemName,
+			Quantity:     int(row.Quantity),
+			Reason:       row.Reason,
+			Status:       domain.GiveFlagStatus(row.Status),
+			ReviewedBy:   row.ReviewedBy.String,
+			CreatedAt:    row.CreatedAt.Time,
+		}
+		if row.ReviewedAt.Valid {
+			reviewedAt := row.ReviewedAt.Time
+			flag.ReviewedAt = &reviewedAt
+		}
+		flags = append(flags, flag)
+	}
+	return flags, nil
+}
+
+// ReviewFlag settles a pending flag
+func (r *giveGuardRepository) ReviewFlag(ctx context.Context, id int64, status domain.GiveFlagStatus, actor string) (bool, error) {
+	rows, err := r.q.ReviewGiveFlag(ctx, generated.ReviewGiveFlagParams{
+		ID:         id,
+		Status:     string(status),
+		ReviewedBy: pgtype.Text{String: actor, Valid: true},
+	})
+	if err != nil {
+		return false, fmt.Errorf("failed to review give flag: %w", err)
+	}
+	return rows > 0, nil
+}
@@ -24,9 +24,9 @@ type HarvestRepository struct {
 }
 
 // NewHarvestRepository creates a new harvest repository
-func NewHarvestRepository(db *pgxpool.Pool) *HarvestRepository {
+func NewHarvestRepository(db *pgxpool.Pool, opts ...RepositoryOption) *HarvestRepository {
 	return &HarvestRepository{
-		UserRepository: NewUserRepository(db),
+		UserRepository: newUserRepository(db, nil, InventorySourceHarvest, opts),
 		db:             db,
 		q:              generated.New(db),
 	}
@@ -71,22 +71,28 @@ func (r *HarvestRepository) BeginTx(ctx context.Context) (repository.HarvestTx,
 		return nil, fmt.Errorf("failed to begin harvest transaction: %w", err)
 	}
 	return &harvestTx{
-		tx:       tx,
-		userRepo: r.UserRepository,
-		q:        r.q.WithTx(tx),
+		tx:        tx,
+		userRepo:  r.UserRepository,
+		q:         r.q.WithTx(tx),
+		inventory: r.inventory.begin(),
 	}, nil
 }
 
 // harvestTx implements repository.HarvestTx
 type harvestTx struct {
-	tx       pgx.Tx
-	userRepo *UserRepository
-	q        *generated.Queries
+	tx        pgx.Tx
+	userRepo  *UserRepository
+	q         *generated.Queries
+	inventory *inventoryJournal
 }
 
-// Commit commits the transaction
+// Commit commits the transaction and publishes its inventory changes
 func (t *harvestTx) Commit(ctx context.Context) error {
-	return t.tx.Commit(ctx)
+	if err := t.tx.Commit(ctx); err != nil {
+		return err
+	}
+	t.inventory.flush(ctx)
+	return nil
 }
 
 // Rollback rolls back the transaction
@@ -130,7 +136,7 @@ func (t *harvestTx) GetInventory(ctx context.Context, userID string) (*domain.In
 
 // UpdateInventory updates a user's inventory within transaction
 func (t *harvestTx) UpdateInventory(ctx context.Context, userID string, inventory domain.Inventory) error {
-	return updateInventory(ctx, t.q, userID, inventory)
+	return updateInventory(ctx, t.q, userID, inventory, t.inventory)
 }
 
 // fetchHarvestState is a helper to fetch and map harvest state with common logic
@@ -0,0 +1,110 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/osse101/BrandishBot_Go/internal/database/generated"
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/inbox"
+)
+
+type inboxRepository struct {
+	q *generated.Queries
+}
+
+// NewInboxRepository creates a new PostgreSQL inbox repository
+func NewInboxRepository(pool *pgxpool.Pool) inbox.Repository {
+	return &inboxRepository{q: generated.New(pool)}
+}
+
+// AddMessage stores a message and trims the user's inbox to the newest keep
+func (r *inboxRepository) AddMessage(ctx context.Context, msg domain.InboxMessage, keep int) (*domain.InboxMessage, error) {
+	userUUID, err := parseUserUUID(msg.UserID)
+	if err != nil {
+		return nil, err
+	}
+	row, err := r.q.CreateInboxMessage(ctx, generated.CreateInboxMessageParams{
+		UserID: userUUID,
+		Kind:   string(msg.Kind),
+		Title:  msg.Title,
+		Body:   msg.Body,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create inbox message: %w", err)
+	}
+	if err := r.q.TrimInboxMessages(ctx, generated.TrimInboxMessagesParams{UserID: userUUID, Keep: int32(keep)}); err != nil {
+		return nil, fmt.Errorf("failed to trim inbox: %w", err)
+	}
+	saved := mapInboxMessage(row)
+	return &saved, nil
+}
+
+// GetMessages returns a user's messages, newest first
+func (r *inboxRepository) GetMessages(ctx context.Context, userID string, unreadOnly bool, limit int) ([]domain.InboxMessage, error) {
+	userUUID, err := parseUserUUID(userID)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := r.q.ListInboxMessages(ctx, generated.ListInboxMessagesParams{
+		UserID:     userUUID,
+		UnreadOnly: unreadOnly,
+		RowLimit:   int32(limit),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list inbox messages: %w", err)
+	}
+	messages := make([]domain.InboxMessage, 0, len(rows))
+	for _, row := range rows {
+		messages = append(messages, mapInboxMessage(row))
+	}
+	return messages, nil
+}
+
+// CountUnread returns how many of a user's messages are unread
+func (r *inboxRepository) CountUnread(ctx context.Context, userID string) (int, error) {
+	userUUID, err := parseUserUUID(userID)
+	if err != nil {
+		return 0, err
+	}
+	count, err := r.q.CountUnreadInboxMessages(ctx, userUUID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count unread messages: %w", err)
+	}
+	return int(count), nil
+}
+
+// MarkRead marks a user's unread messages read, all of them when ids is empty
+func (r *inboxRepository) MarkRead(ctx context.Context, userID string, ids []int64, at time.Time) (int, error) {
+	userUUID, err := parseUserUUID(userID)
+	if err != nil {
+		return 0, err
+	}
+	readAt := pgtype.Timestamptz{Time: at, Valid: true}
+	var marked int64
+	if len(ids) == 0 {
+		marked, err = r.q.MarkAllInboxMessagesRead(ctx, generated.MarkAllInboxMessagesReadParams{ReadAt: readAt, UserID: userUUID})
+	} else {
+		marked, err = r.q.MarkInboxMessagesRead(ctx, generated.MarkInboxMessagesReadParams{ReadAt: readAt, UserID: userUUID, Ids: ids})
+	}
+	if err != nil {
+		return 0, fmt.Errorf("failed to mark messages read: %w", err)
+	}
+	return int(marked), nil
+}
+
+func mapInboxMessage(row generated.InboxMessage) domain.InboxMessage {
+	return domain.InboxMessage{
+		ID:        row.ID,
+		UserID:    row.UserID.String(),
+		Kind:      domain.InboxKind(row.Kind),
+		Title:     row.Title,
+		Body:      row.Body,
+		ReadAt:    ptrTimestamptz(row.ReadAt),
+		CreatedAt: row.CreatedAt.Time,
+	}
+}
@@ -0,0 +1,197 @@
+package postgres
+
+import (
+	"context"
+	"sort"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/event"
+	"github.com/osse101/BrandishBot_Go/internal/logger"
+)
+
+// RepositoryOption configures optional behaviour of the inventory-writing repositories
+type RepositoryOption func(*repositoryOptions)
+
+type repositoryOptions struct {
+	bus event.Bus
+}
+
+// WithInventoryEvents publishes an inventory.changed event on bus for every
+// inventory write, once the write is committed
+func WithInventoryEvents(bus event.Bus) RepositoryOption {
+	return func(o *repositoryOptions) {
+		o.bus = bus
+	}
+}
+
+// inventoryEvents publishes the inventory diffs written by one repository.
+// A nil *inventoryEvents publishes nothing.
+type inventoryEvents struct {
+	bus    event.Bus
+	source string // Reason used when the context carries none
+}
+
+func newInventoryEvents(source string, opts []RepositoryOption) *inventoryEvents {
+	var o repositoryOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+	if o.bus == nil {
+		return nil
+	}
+	return &inventoryEvents{bus: o.bus, source: source}
+}
+
+// direct returns a journal that publishes each write as it is made
+func (e *inventoryEvents) direct() *inventoryJournal {
+	if e == nil {
+		return nil
+	}
+	return &inventoryJournal{events: e}
+}
+
+// begin returns a journal that holds writes until the transaction commits
+func (e *inventoryEvents) begin() *inventoryJournal {
+	if e == nil {
+		return nil
+	}
+	return &inventoryJournal{events: e, deferred: true}
+}
+
+// inventoryJournal collects the inventory diffs written through one set of
+// queries. A nil *inventoryJournal records nothing, so writes skip the diff.
+type inventoryJournal struct {
+	events   *inventoryEvents
+	deferred bool
+	pending  []event.InventoryChangedPayloadV1
+}
+
+func (j *inventoryJournal) enabled() bool {
+	return j != nil
+}
+
+// record adds a user's diff, merging it into an earlier diff of the same
+// transaction with the same reason
+func (j *inventoryJournal) record(ctx context.Context, userID string, changes []event.InventoryChangeV1) {
+	if j == nil || len(changes) == 0 {
+		return
+	}
+	reason := event.InventoryReason(ctx)
+	if reason == "" {
+		reason = j.events.source
+	}
+
+	if !j.deferred {
+		j.events.publish(ctx, userID, reason, changes)
+		return
+	}
+	for i := range j.pending {
+		if j.pending[i].UserID == userID && j.pending[i].Reason == reason {
+			j.pending[i].Changes = mergeInventoryChanges(j.pending[i].Changes, changes)
+			return
+		}
+	}
+	j.pending = append(j.pending, event.InventoryChangedPayloadV1{UserID: userID, Reason: reason, Changes: changes})
+}
+
+// flush publishes the held diffs; call it once the transaction has committed
+func (j *inventoryJournal) flush(ctx context.Context) {
+	if j == nil {
+		return
+	}
+	for _, p := range j.pending {
+		if len(p.Changes) > 0 {
+			j.events.publish(ctx, p.UserID, p.Reason, p.Changes)
+		}
+	}
+	j.pending = nil
+}
+
+func (e *inventoryEvents) publish(ctx context.Context, userID, reason string, changes []event.InventoryChangeV1) {
+	// The write is already committed, so a failed publish is only logged
+	if err := e.bus.Publish(ctx, event.NewInventoryChangedEvent(userID, reason, changes)); err != nil {
+		logger.FromContext(ctx).Error(LogMsgInventoryEventPublishFailed, "error", err, "user_id", userID, "reason", reason)
+	}
+}
+
+type inventoryKey struct {
+	itemID  int
+	quality domain.QualityLevel
+}
+
+// diffInventories lists the quantity change of every (item, quality) pair
+// that differs between before and after, ordered by item and quality
+func diffInventories(before, after *domain.Inventory) []event.InventoryChangeV1 {
+	totals := func(inv *domain.Inventory) map[inventoryKey]int {
+		m := make(map[inventoryKey]int)
+		if inv == nil {
+			return m
+		}
+		for _, slot := range inv.Slots {
+			m[inventoryKey{slot.ItemID, slot.QualityLevel}] += slot.Quantity
+		}
+		return m
+	}
+	old, current := totals(before), totals(after)
+
+	var changes []event.InventoryChangeV1
+	for key, quantity := range current {
+		if delta := quantity - old[key]; delta != 0 {
+			changes = append(changes, inventoryChange(key, delta, quantity))
+		}
+	}
+	for key, quantity := range old {
+		if _, ok := current[key]; !ok && quantity != 0 {
+			changes = append(changes, inventoryChange(key, -quantity, 0))
+		}
+	}
+	sortInventoryChanges(changes)
+	return changes
+}
+
+// mergeInventoryChanges folds next into prev, summing deltas and keeping the
+// latest quantity. Entries that cancel out are dropped.
+func mergeInventoryChanges(prev, next []event.InventoryChangeV1) []event.InventoryChangeV1 {
+	merged := append([]event.InventoryChangeV1(nil), prev...)
+	for _, change := range next {
+		found := false
+		for i := range merged {
+			if merged[i].ItemID == change.ItemID && merged[i].Quality == change.Quality {
+				merged[i].Delta += change.Delta
+				merged[i].Quantity = change.Quantity
+				found = true
+				break
+			}
+		}
+		if !found {
+			merged = append(merged, change)
+		}
+	}
+
+	result := merged[:0]
+	for _, change := range merged {
+		if change.Delta != 0 {
+			result = append(result, change)
+		}
+	}
+	sortInventoryChanges(result)
+	return result
+}
+
+func inventoryChange(key inventoryKey, delta, quantity int) event.InventoryChangeV1 {
+	return event.InventoryChangeV1{
+		ItemID:   key.itemID,
+		Quality:  string(key.quality),
+		Delta:    delta,
+		Quantity: quantity,
+	}
+}
+
+func sortInventoryChanges(changes []event.InventoryChangeV1) {
+	sort.Slice(changes, func(i, j int) bool {
+		if changes[i].ItemID != changes[j].ItemID {
+			return changes[i].ItemID < changes[j].ItemID
+		}
+		return changes[i].Quality < changes[j].Quality
+	})
+}
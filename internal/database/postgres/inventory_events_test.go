@@ -0,0 +1,110 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/event"
+)
+
+func TestDiffInventories(t *testing.T) {
+	before := &domain.Inventory{Slots: []domain.InventorySlot{
+		{ItemID: 1, Quantity: 100, QualityLevel: domain.QualityCommon},
+		{ItemID: 2, Quantity: 3, QualityLevel: domain.QualityRare},
+		{ItemID: 3, Quantity: 5, QualityLevel: domain.QualityCommon},
+	}}
+	after := &domain.Inventory{Slots: []domain.InventorySlot{
+		{ItemID: 1, Quantity: 80, QualityLevel: domain.QualityCommon},
+		{ItemID: 2, Quantity: 3, QualityLevel: domain.QualityRare},
+		{ItemID: 2, Quantity: 1, QualityLevel: domain.QualityEpic},
+	}}
+
+	changes := diffInventories(before, after)
+
+	assert.Equal(t, []event.InventoryChangeV1{
+		{ItemID: 1, Quality: string(domain.QualityCommon), Delta: -20, Quantity: 80},
+		{ItemID: 2, Quality: string(domain.QualityEpic), Delta: 1, Quantity: 1},
+		{ItemID: 3, Quality: string(domain.QualityCommon), Delta: -5, Quantity: 0},
+	}, changes)
+}
+
+func TestDiffInventories_Unchanged(t *testing.T) {
+	inv := &domain.Inventory{Slots: []domain.InventorySlot{{ItemID: 1, Quantity: 10}}}
+
+	assert.Empty(t, diffInventories(inv, inv))
+}
+
+// recordingBus keeps the events published on it
+type recordingBus struct {
+	events []event.Event
+}
+
+func (b *recordingBus) Publish(ctx context.Context, evt event.Event) error {
+	b.events = append(b.events, evt)
+	return nil
+}
+
+func (b *recordingBus) Subscribe(eventType event.Type, handler event.Handler) {}
+
+func TestInventoryJournal(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("no bus records nothing", func(t *testing.T) {
+		events := newInventoryEvents(InventorySourceUser, nil)
+
+		assert.False(t, events.begin().enabled())
+		events.begin().record(ctx, "user1", []event.InventoryChangeV1{{ItemID: 1, Delta: 1, Quantity: 1}})
+	})
+
+	t.Run("direct writes publish at once", func(t *testing.T) {
+		bus := &recordingBus{}
+		events := newInventoryEvents(InventorySourceEconomy, []RepositoryOption{WithInventoryEvents(bus)})
+
+		events.direct().record(ctx, "user1", []event.InventoryChangeV1{{ItemID: 1, Delta: 5, Quantity: 5}})
+
+		require.Len(t, bus.events, 1)
+		payload := bus.events[0].Payload.(event.InventoryChangedPayloadV1)
+		assert.Equal(t, "user1", payload.UserID)
+		assert.Equal(t, InventorySourceEconomy, payload.Reason)
+	})
+
+	t.Run("transaction writes wait for commit and merge per user and reason", func(t *testing.T) {
+		bus := &recordingBus{}
+		journal := newInventoryEvents(InventorySourceUser, []RepositoryOption{WithInventoryEvents(bus)}).begin()
+		giveCtx := event.WithInventoryReason(ctx, domain.ActionGive)
+
+		journal.record(giveCtx, "user1", []event.InventoryChangeV1{{ItemID: 1, Quality: "COMMON", Delta: -3, Quantity: 7}})
+		journal.record(giveCtx, "user2", []event.InventoryChangeV1{{ItemID: 1, Quality: "COMMON", Delta: 3, Quantity: 3}})
+		journal.record(giveCtx, "user1", []event.InventoryChangeV1{
+			{ItemID: 1, Quality: "COMMON", Delta: -2, Quantity: 5},
+			{ItemID: 4, Quality: "COMMON", Delta: 1, Quantity: 1},
+		})
+		assert.Empty(t, bus.events)
+
+		journal.flush(ctx)
+
+		require.Len(t, bus.events, 2)
+		first := bus.events[0].Payload.(event.InventoryChangedPayloadV1)
+		assert.Equal(t, domain.ActionGive, first.Reason)
+		assert.Equal(t, []event.InventoryChangeV1{
+			{ItemID: 1, Quality: "COMMON", Delta: -5, Quantity: 5},
+			{ItemID: 4, Quality: "COMMON", Delta: 1, Quantity: 1},
+		}, first.Changes)
+		assert.Equal(t, "user2", bus.events[1].Payload.(event.InventoryChangedPayloadV1).UserID)
+	})
+
+	t.Run("changes that cancel out publish nothing", func(t *testing.T) {
+		bus := &recordingBus{}
+		journal := newInventoryEvents(InventorySourceLoan, []RepositoryOption{WithInventoryEvents(bus)}).begin()
+
+		journal.record(ctx, "user1", []event.InventoryChangeV1{{ItemID: 1, Delta: -2, Quantity: 3}})
+		journal.record(ctx, "user1", []event.InventoryChangeV1{{ItemID: 1, Delta: 2, Quantity: 5}})
+		journal.flush(ctx)
+
+		assert.Empty(t, bus.events)
+	})
+}
@@ -55,25 +55,7 @@ func (r *ItemRepository) GetAllItems(ctx context.Context) ([]domain.Item, error)
 
 // GetItemByID retrieves an item by ID
 func (r *ItemRepository) GetItemByID(ctx context.Context, id int) (*domain.Item, error) {
-	row, err := r.q.GetItemByID(ctx, int32(id))
-	if err != nil {
-		if errors.Is(err, pgx.ErrNoRows) {
-			return nil, domain.ErrItemNotFound
-		}
-		return nil, fmt.Errorf("failed to get item: %w", err)
-	}
-
-	return &domain.Item{
-		ID:             int(row.ItemID),
-		InternalName:   row.InternalName,
-		PublicName:     row.PublicName.String,
-		DefaultDisplay: row.DefaultDisplay.String,
-		Description:    row.ItemDescription.String,
-		BaseValue:      int(row.BaseValue.Int32),
-		Handler:        textToPtr(row.Handler),
-		Types:          row.Types,
-		ContentType:    row.ContentType,
-	}, nil
+	return itemCatalog.itemByID(ctx, r.q, id)
 }
 
 // GetItemByInternalName retrieves an item by internal name
@@ -116,6 +98,7 @@ func (r *ItemRepository) InsertItem(ctx context.Context, item *domain.Item) (int
 		return 0, fmt.Errorf("failed to insert item: %w", err)
 	}
 
+	InvalidateItemCache()
 	return int(itemID), nil
 }
 
@@ -135,6 +118,7 @@ func (r *ItemRepository) UpdateItem(ctx context.Context, itemID int, item *domai
 		return fmt.Errorf("failed to update item: %w", err)
 	}
 
+	InvalidateItemCache()
 	return nil
 }
 
@@ -172,6 +156,7 @@ func (r *ItemRepository) ClearItemTags(ctx context.Context, itemID int) error {
 		return fmt.Errorf("failed to clear item tags: %w", err)
 	}
 
+	InvalidateItemCache()
 	return nil
 }
 
@@ -186,6 +171,7 @@ func (r *ItemRepository) AssignItemTag(ctx context.Context, itemID, typeID int)
 		return fmt.Errorf("failed to assign item tag: %w", err)
 	}
 
+	InvalidateItemCache()
 	return nil
 }
 
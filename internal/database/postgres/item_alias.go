@@ -0,0 +1,113 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/osse101/BrandishBot_Go/internal/database/generated"
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/itemalias"
+)
+
+type itemAliasRepository struct {
+	q *generated.Queries
+}
+
+// NewItemAliasRepository creates a new PostgreSQL item alias repository
+func NewItemAliasRepository(pool *pgxpool.Pool) itemalias.Repository {
+	return &itemAliasRepository{q: generated.New(pool)}
+}
+
+// ListAliases returns every alias pool ordered by internal name
+func (r *itemAliasRepository) ListAliases(ctx context.Context) ([]domain.ItemAlias, error) {
+	rows, err := r.q.ListItemAliases(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list item aliases: %w", err)
+	}
+	aliases := make([]domain.ItemAlias, 0, len(rows))
+	for _, row := range rows {
+		alias, err := mapItemAlias(row)
+		if err != nil {
+			return nil, err
+		}
+		aliases = append(aliases, alias)
+	}
+	return aliases, nil
+}
+
+// GetAlias returns the pool for an item, or nil if there is none
+func (r *itemAliasRepository) GetAlias(ctx context.Context, internalName string) (*domain.ItemAlias, error) {
+	row, err := r.q.GetItemAlias(ctx, internalName)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get item alias: %w", err)
+	}
+	alias, err := mapItemAlias(row)
+	if err != nil {
+		return nil, err
+	}
+	return &alias, nil
+}
+
+// UpsertAlias creates or replaces a pool
+func (r *itemAliasRepository) UpsertAlias(ctx context.Context, alias domain.ItemAlias) (*domain.ItemAlias, error) {
+	themes := alias.Themes
+	if themes == nil {
+		themes = map[string][]string{}
+	}
+	encoded, err := json.Marshal(themes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode theme aliases: %w", err)
+	}
+	row, err := r.q.UpsertItemAlias(ctx, generated.UpsertItemAliasParams{
+		InternalName:   alias.InternalName,
+		DefaultAliases: alias.Default,
+		ThemeAliases:   encoded,
+		UpdatedBy:      alias.UpdatedBy,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to upsert item alias: %w", err)
+	}
+	stored, err := mapItemAlias(row)
+	if err != nil {
+		return nil, err
+	}
+	return &stored, nil
+}
+
+// DeleteAlias removes a pool
+func (r *itemAliasRepository) DeleteAlias(ctx context.Context, internalName string) (bool, error) {
+	deleted, err := r.q.DeleteItemAlias(ctx, internalName)
+	if err != nil {
+		return false, fmt.Errorf("failed to delete item alias: %w", err)
+	}
+	return deleted > 0, nil
+}
+
+func mapItemAlias(row generated.ItemAlias) (domain.ItemAlias, error) {
+	themes := map[string][]string{}
+	if len(row.ThemeAliases) > 0 {
+		if err := json.Unmarshal(row.ThemeAliases, &themes); err != nil {
+			return domain.ItemAlias{}, fmt.Errorf("failed to decode theme aliases for item %s: %w", row.InternalName, err)
+		}
+	}
+	defaults := row.DefaultAliases
+	if defaults == nil {
+		defaults = []string{}
+	}
+	return domain.ItemAlias{
+		InternalName: row.InternalName,
+		Default:      defaults,
+		Themes:       themes,
+		UpdatedBy:    row.UpdatedBy,
+		CreatedAt:    row.CreatedAt.Time,
+		UpdatedAt:    row.UpdatedAt.Time,
+	}, nil
+}
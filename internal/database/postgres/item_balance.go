@@ -0,0 +1,126 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/osse101/BrandishBot_Go/internal/balance"
+	"github.com/osse101/BrandishBot_Go/internal/database/generated"
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+)
+
+type itemBalanceRepository struct {
+	q *generated.Queries
+}
+
+// NewItemBalanceRepository creates a new PostgreSQL item balance change repository
+func NewItemBalanceRepository(pool *pgxpool.Pool) balance.Repository {
+	return &itemBalanceRepository{q: generated.New(pool)}
+}
+
+// CreateChange stores a scheduled balance change
+func (r *itemBalanceRepository) CreateChange(ctx context.Context, change domain.ItemBalanceChange) (*domain.ItemBalanceChange, error) {
+	row, err := r.q.CreateItemBalanceChange(ctx, generated.CreateItemBalanceChangeParams{
+		ItemName:    change.ItemName,
+		Field:       string(change.Field),
+		PoolName:    change.Pool,
+		Value:       int32(change.Value),
+		EffectiveAt: pgtype.Timestamptz{Time: change.EffectiveAt, Valid: true},
+		Reason:      change.Reason,
+		CreatedBy:   change.CreatedBy,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create balance change: %w", err)
+	}
+	created := mapItemBalanceChange(row)
+	return &created, nil
+}
+
+// DeleteUpcomingChange removes a change that has not taken effect by now
+func (r *itemBalanceRepository) DeleteUpcomingChange(ctx context.Context, id int64, now time.Time) (bool, error) {
+	deleted, err := r.q.DeleteUpcomingItemBalanceChange(ctx, generated.DeleteUpcomingItemBalanceChangeParams{
+		ID:          id,
+		EffectiveAt: pgtype.Timestamptz{Time: now, Valid: true},
+	})
+	if err != nil {
+		return false, fmt.Errorf("failed to delete balance change: %w", err)
+	}
+	return deleted > 0, nil
+}
+
+// GetChanges returns up to limit changes, newest effective time first
+func (r *itemBalanceRepository) GetChanges(ctx context.Context, itemName string, limit int) ([]domain.ItemBalanceChange, error) {
+	rows, err := r.q.GetItemBalanceChanges(ctx, generated.GetItemBalanceChangesParams{
+		ItemName: itemName,
+		RowLimit: int32(limit),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get balance changes: %w", err)
+	}
+	return mapItemBalanceChanges(rows), nil
+}
+
+// GetEffectiveChanges returns the change in force at the given time for each item and pool
+func (r *itemBalanceRepository) GetEffectiveChanges(ctx context.Context, field domain.BalanceField, at time.Time) ([]domain.ItemBalanceChange, error) {
+	rows, err := r.q.GetEffectiveItemBalanceChanges(ctx, generated.GetEffectiveItemBalanceChangesParams{
+		Field:       string(field),
+		EffectiveAt: pgtype.Timestamptz{Time: at, Valid: true},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get effective balance changes: %w", err)
+	}
+	return mapItemBalanceChanges(rows), nil
+}
+
+// GetChangesEffectiveBetween returns changes that took effect after from and at or before to
+func (r *itemBalanceRepository) GetChangesEffectiveBetween(ctx context.Context, from, to time.Time) ([]domain.ItemBalanceChange, error) {
+	rows, err := r.q.GetItemBalanceChangesEffectiveBetween(ctx, generated.GetItemBalanceChangesEffectiveBetweenParams{
+		FromTime: pgtype.Timestamptz{Time: from, Valid: true},
+		ToTime:   pgtype.Timestamptz{Time: to, Valid: true},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get balance changes: %w", err)
+	}
+	return mapItemBalanceChanges(rows), nil
+}
+
+// SetItemBaseValue writes an item's base value, reporting whether it changed
+func (r *itemBalanceRepository) SetItemBaseValue(ctx context.Context, itemName string, value int) (bool, error) {
+	updated, err := r.q.SetItemBaseValue(ctx, generated.SetItemBaseValueParams{
+		InternalName: itemName,
+		BaseValue:    pgtype.Int4{Int32: int32(value), Valid: true},
+	})
+	if err != nil {
+		return false, fmt.Errorf("failed to set item base value: %w", err)
+	}
+	if updated > 0 {
+		InvalidateItemCache()
+	}
+	return updated > 0, nil
+}
+
+func mapItemBalanceChange(row generated.ItemBalanceChange) domain.ItemBalanceChange {
+	return domain.ItemBalanceChange{
+		ID:          row.ID,
+		ItemName:    row.ItemName,
+		Field:       domain.BalanceField(row.Field),
+		Pool:        row.PoolName,
+		Value:       int(row.Value),
+		EffectiveAt: row.EffectiveAt.Time,
+		Reason:      row.Reason,
+		CreatedBy:   row.CreatedBy,
+		CreatedAt:   row.CreatedAt.Time,
+	}
+}
+
+func mapItemBalanceChanges(rows []generated.ItemBalanceChange) []domain.ItemBalanceChange {
+	changes := make([]domain.ItemBalanceChange, 0, len(rows))
+	for _, row := range rows {
+		changes = append(changes, mapItemBalanceChange(row))
+	}
+	return changes
+}
@@ -0,0 +1,156 @@
+package postgres
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/osse101/BrandishBot_Go/internal/database/generated"
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+)
+
+// itemCache holds item catalog rows in memory. Items are static apart from
+// config syncs, so every repository in this package reads through one shared
+// instance and any item write clears it.
+type itemCache struct {
+	mu     sync.RWMutex
+	ttl    time.Duration
+	byID   map[int]cachedItem
+	byName map[string]int
+}
+
+type cachedItem struct {
+	item      domain.Item
+	expiresAt time.Time
+}
+
+// itemCatalog is disabled (zero TTL) until ConfigureItemCache is called
+var itemCatalog = newItemCache(0)
+
+func newItemCache(ttl time.Duration) *itemCache {
+	return &itemCache{
+		ttl:    ttl,
+		byID:   make(map[int]cachedItem),
+		byName: make(map[string]int),
+	}
+}
+
+// ConfigureItemCache enables the item catalog cache for GetItemByName,
+// GetItemByID and GetItemsByIDs with the given TTL. A TTL of zero disables it.
+// Other instances' item writes are only picked up when entries expire.
+func ConfigureItemCache(ttl time.Duration) {
+	itemCatalog.mu.Lock()
+	defer itemCatalog.mu.Unlock()
+	itemCatalog.ttl = ttl
+	itemCatalog.clearLocked()
+}
+
+// InvalidateItemCache drops every cached item
+func InvalidateItemCache() {
+	itemCatalog.mu.Lock()
+	defer itemCatalog.mu.Unlock()
+	itemCatalog.clearLocked()
+}
+
+func (c *itemCache) clearLocked() {
+	c.byID = make(map[int]cachedItem)
+	c.byName = make(map[string]int)
+}
+
+func (c *itemCache) enabled() bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.ttl > 0
+}
+
+// get returns a copy of the cached item so callers cannot modify the cache
+func (c *itemCache) get(id int) (*domain.Item, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	entry, ok := c.byID[id]
+	if !ok || time.Now().After(entry.expiresAt) {
+		return nil, false
+	}
+	item := entry.item
+	return &item, true
+}
+
+func (c *itemCache) getByName(name string) (*domain.Item, bool) {
+	c.mu.RLock()
+	id, ok := c.byName[name]
+	c.mu.RUnlock()
+	if !ok {
+		return nil, false
+	}
+	return c.get(id)
+}
+
+func (c *itemCache) put(items ...domain.Item) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.ttl <= 0 {
+		return
+	}
+	expiresAt := time.Now().Add(c.ttl)
+	for _, item := range items {
+		c.byID[item.ID] = cachedItem{item: item, expiresAt: expiresAt}
+		c.byName[item.InternalName] = item.ID
+	}
+}
+
+func (c *itemCache) itemByName(ctx context.Context, q *generated.Queries, itemName string) (*domain.Item, error) {
+	if item, ok := c.getByName(itemName); ok {
+		return item, nil
+	}
+	item, err := getItemByName(ctx, q, itemName)
+	if err != nil {
+		return nil, err
+	}
+	c.put(*item)
+	return item, nil
+}
+
+func (c *itemCache) itemByID(ctx context.Context, q *generated.Queries, id int) (*domain.Item, error) {
+	if item, ok := c.get(id); ok {
+		return item, nil
+	}
+	item, err := getItemByID(ctx, q, id)
+	if err != nil {
+		return nil, err
+	}
+	c.put(*item)
+	return item, nil
+}
+
+// itemsByIDs serves cached items and fetches only the misses. As with the
+// query, IDs that do not exist are left out of the result.
+func (c *itemCache) itemsByIDs(ctx context.Context, q *generated.Queries, itemIDs []int) ([]domain.Item, error) {
+	if !c.enabled() {
+		return getItemsByIDs(ctx, q, itemIDs)
+	}
+
+	items := make([]domain.Item, 0, len(itemIDs))
+	seen := make(map[int]bool, len(itemIDs))
+	var missing []int
+	for _, id := range itemIDs {
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+		if item, ok := c.get(id); ok {
+			items = append(items, *item)
+		} else {
+			missing = append(missing, id)
+		}
+	}
+	if len(missing) == 0 {
+		return items, nil
+	}
+
+	fetched, err := getItemsByIDs(ctx, q, missing)
+	if err != nil {
+		return nil, err
+	}
+	c.put(fetched...)
+	return append(items, fetched...), nil
+}
@@ -0,0 +1,79 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+)
+
+func TestItemCache(t *testing.T) {
+	sword := domain.Item{ID: 1, InternalName: "weapon_sword", BaseValue: 100}
+	shield := domain.Item{ID: 2, InternalName: "armor_shield", BaseValue: 50}
+
+	t.Run("serves cached items by ID and name", func(t *testing.T) {
+		c := newItemCache(time.Minute)
+		c.put(sword)
+
+		byID, ok := c.get(1)
+		require.True(t, ok)
+		assert.Equal(t, sword, *byID)
+
+		byName, ok := c.getByName("weapon_sword")
+		require.True(t, ok)
+		assert.Equal(t, sword, *byName)
+	})
+
+	t.Run("returned items are copies", func(t *testing.T) {
+		c := newItemCache(time.Minute)
+		c.put(sword)
+
+		item, _ := c.get(1)
+		item.BaseValue = 1
+
+		again, _ := c.get(1)
+		assert.Equal(t, 100, again.BaseValue)
+	})
+
+	t.Run("expired entries miss", func(t *testing.T) {
+		c := newItemCache(time.Nanosecond)
+		c.put(sword)
+		time.Sleep(time.Millisecond)
+
+		_, ok := c.get(1)
+		assert.False(t, ok)
+	})
+
+	t.Run("zero TTL stores nothing", func(t *testing.T) {
+		c := newItemCache(0)
+		c.put(sword)
+
+		_, ok := c.get(1)
+		assert.False(t, ok)
+	})
+
+	t.Run("itemsByIDs serves hits without a query and drops duplicates", func(t *testing.T) {
+		c := newItemCache(time.Minute)
+		c.put(sword, shield)
+
+		// A nil Queries would panic if the cache went to the database
+		items, err := c.itemsByIDs(context.Background(), nil, []int{2, 1, 2})
+		require.NoError(t, err)
+		assert.Equal(t, []domain.Item{shield, sword}, items)
+	})
+
+	t.Run("invalidation clears the shared catalog", func(t *testing.T) {
+		ConfigureItemCache(time.Minute)
+		t.Cleanup(func() { ConfigureItemCache(0) })
+		itemCatalog.put(sword)
+
+		InvalidateItemCache()
+
+		_, ok := itemCatalog.get(1)
+		assert.False(t, ok)
+	})
+}
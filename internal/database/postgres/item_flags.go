@@ -0,0 +1,91 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/osse101/BrandishBot_Go/internal/database/generated"
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/itemflags"
+)
+
+type itemFlagsRepository struct {
+	q *generated.Queries
+}
+
+// NewItemFlagsRepository creates a new PostgreSQL item flags repository
+func NewItemFlagsRepository(pool *pgxpool.Pool) itemflags.Repository {
+	return &itemFlagsRepository{q: generated.New(pool)}
+}
+
+// GetItemFlags returns every item the user has flagged, by item name
+func (r *itemFlagsRepository) GetItemFlags(ctx context.Context, userID string) ([]domain.ItemFlags, error) {
+	userUUID, err := parseUserUUID(userID)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := r.q.GetUserItemFlags(ctx, userUUID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get item flags: %w", err)
+	}
+	flags := make([]domain.ItemFlags, 0, len(rows))
+	for _, row := range rows {
+		flags = append(flags, domain.ItemFlags{
+			ItemID:   int(row.ItemID),
+			ItemName: row.InternalName,
+			Favorite: row.Favorite,
+			Locked:   row.Locked,
+		})
+	}
+	return flags, nil
+}
+
+// IsItemLocked reports whether the user has locked the item
+func (r *itemFlagsRepository) IsItemLocked(ctx context.Context, userID string, itemID int) (bool, error) {
+	userUUID, err := parseUserUUID(userID)
+	if err != nil {
+		return false, err
+	}
+	locked, err := r.q.IsUserItemLocked(ctx, generated.IsUserItemLockedParams{
+		UserID: userUUID,
+		ItemID: int32(itemID),
+	})
+	if err != nil {
+		return false, fmt.Errorf("failed to check item lock: %w", err)
+	}
+	return locked, nil
+}
+
+// SetItemLocked locks the item, or unlocks it
+func (r *itemFlagsRepository) SetItemLocked(ctx context.Context, userID string, itemID int, locked bool) error {
+	userUUID, err := parseUserUUID(userID)
+	if err != nil {
+		return err
+	}
+	if err := r.q.UpsertUserItemLocked(ctx, generated.UpsertUserItemLockedParams{
+		UserID: userUUID,
+		ItemID: int32(itemID),
+		Locked: locked,
+	}); err != nil {
+		return fmt.Errorf("failed to set item lock: %w", err)
+	}
+	return nil
+}
+
+// SetItemFavorite marks the item as a favorite, or clears the mark
+func (r *itemFlagsRepository) SetItemFavorite(ctx context.Context, userID string, itemID int, favorite bool) error {
+	userUUID, err := parseUserUUID(userID)
+	if err != nil {
+		return err
+	}
+	if err := r.q.UpsertUserItemFavorite(ctx, generated.UpsertUserItemFavoriteParams{
+		UserID:   userUUID,
+		ItemID:   int32(itemID),
+		Favorite: favorite,
+	}); err != nil {
+		return fmt.Errorf("failed to set item favorite: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,137 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/osse101/BrandishBot_Go/internal/community"
+	"github.com/osse101/BrandishBot_Go/internal/database/generated"
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/jackpot"
+	"github.com/osse101/BrandishBot_Go/internal/repository"
+)
+
+type jackpotRepository struct {
+	db        *pgxpool.Pool
+	q         *generated.Queries
+	inventory *inventoryEvents
+}
+
+// NewJackpotRepository creates a PostgreSQL repository for the progressive
+// jackpot and its wins
+func NewJackpotRepository(pool *pgxpool.Pool, opts ...RepositoryOption) jackpot.Repository {
+	return &jackpotRepository{db: pool, q: generated.New(pool), inventory: newInventoryEvents(InventorySourceJackpot, opts)}
+}
+
+// BeginTx starts a transaction and returns a jackpot.Tx
+func (r *jackpotRepository) BeginTx(ctx context.Context) (jackpot.Tx, error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin jackpot transaction: %w", err)
+	}
+	return &jackpotTx{tx: tx, q: r.q.WithTx(tx), inventory: r.inventory.begin()}, nil
+}
+
+// GetBalance reads the jackpot of the context's community. A community with
+// no openings yet has an empty jackpot.
+func (r *jackpotRepository) GetBalance(ctx context.Context) (int64, error) {
+	balance, err := r.q.GetJackpotBalance(ctx, community.FromContext(ctx))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return 0, nil
+		}
+		return 0, fmt.Errorf("failed to get jackpot: %w", err)
+	}
+	return balance, nil
+}
+
+func (r *jackpotRepository) Deposit(ctx context.Context, amount, maxBalance int64) (int64, error) {
+	balance, err := r.q.AddToJackpot(ctx, generated.AddToJackpotParams{
+		CommunityID: community.FromContext(ctx),
+		Amount:      amount,
+		MaxBalance:  maxBalance,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to credit jackpot: %w", err)
+	}
+	return balance, nil
+}
+
+func (r *jackpotRepository) GetRecentWins(ctx context.Context, limit int) ([]domain.JackpotWin, error) {
+	rows, err := r.q.GetRecentJackpotWins(ctx, generated.GetRecentJackpotWinsParams{
+		CommunityID: community.FromContext(ctx),
+		Limit:       int32(limit),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get jackpot wins: %w", err)
+	}
+	wins := make([]domain.JackpotWin, 0, len(rows))
+	for _, row := range rows {
+		wins = append(wins, domain.JackpotWin{
+			UserID:   row.UserID.String(),
+			Username: row.Username,
+			Amount:   row.Amount,
+			Source:   row.Source,
+			WonAt:    row.WonAt.Time,
+		})
+	}
+	return wins, nil
+}
+
+// jackpotTx implements jackpot.Tx
+type jackpotTx struct {
+	tx        pgx.Tx
+	q         *generated.Queries
+	inventory *inventoryJournal
+}
+
+func (t *jackpotTx) Commit(ctx context.Context) error {
+	if err := t.tx.Commit(ctx); err != nil {
+		return err
+	}
+	t.inventory.flush(ctx)
+	return nil
+}
+
+func (t *jackpotTx) Rollback(ctx context.Context) error {
+	err := t.tx.Rollback(ctx)
+	if errors.Is(err, pgx.ErrTxClosed) {
+		return fmt.Errorf("%w: %w", repository.ErrTxClosed, err)
+	}
+	return err
+}
+
+func (t *jackpotTx) AdjustItemQuantity(ctx context.Context, userID string, itemID int, quality domain.QualityLevel, delta int) (int, error) {
+	return adjustItemQuantity(ctx, t.q, userID, itemID, quality, delta, t.inventory)
+}
+
+func (t *jackpotTx) Claim(ctx context.Context) (int64, error) {
+	amount, err := t.q.ClaimJackpot(ctx, community.FromContext(ctx))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return 0, nil
+		}
+		return 0, fmt.Errorf("failed to claim jackpot: %w", err)
+	}
+	return amount, nil
+}
+
+func (t *jackpotTx) RecordWin(ctx context.Context, userID string, amount int64, source string) error {
+	userUUID, err := parseUserUUID(userID)
+	if err != nil {
+		return err
+	}
+	if err := t.q.InsertJackpotWin(ctx, generated.InsertJackpotWinParams{
+		UserID:      userUUID,
+		Amount:      amount,
+		Source:      source,
+		CommunityID: community.FromContext(ctx),
+	}); err != nil {
+		return fmt.Errorf("failed to insert jackpot win: %w", err)
+	}
+	return nil
+}
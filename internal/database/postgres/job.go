@@ -10,6 +10,7 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/jackc/pgx/v5/pgxpool"
 
+	"github.com/osse101/BrandishBot_Go/internal/community"
 	"github.com/osse101/BrandishBot_Go/internal/database/generated"
 	"github.com/osse101/BrandishBot_Go/internal/domain"
 	"github.com/osse101/BrandishBot_Go/internal/logger"
@@ -33,6 +34,7 @@ func (r *JobRepository) GetUserByPlatformID(ctx context.Context, platform, platf
 	row, err := r.q.GetUserByPlatformID(ctx, generated.GetUserByPlatformIDParams{
 		Name:           platform,
 		PlatformUserID: platformID,
+		CommunityID:    community.FromContext(ctx),
 	})
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
@@ -124,6 +126,8 @@ func (r *JobRepository) GetUserJobs(ctx context.Context, userID string) ([]domai
 			CurrentLevel:  int(row.CurrentLevel),
 			XPGainedToday: row.XpGainedToday.Int64,
 			LastXPGain:    &lastXPGain,
+			Prestige:      int(row.Prestige),
+			IsActive:      row.IsActive,
 		})
 	}
 
@@ -150,6 +154,8 @@ func (r *JobRepository) GetUserJobsByPlatform(ctx context.Context, platform, pla
 			CurrentLevel:  int(row.CurrentLevel),
 			XPGainedToday: row.XpGainedToday.Int64,
 			LastXPGain:    &lastXPGain,
+			Prestige:      int(row.Prestige),
+			IsActive:      row.IsActive,
 		})
 	}
 
@@ -182,6 +188,8 @@ func (r *JobRepository) GetUserJob(ctx context.Context, userID string, jobID int
 		CurrentLevel:  int(row.CurrentLevel),
 		XPGainedToday: row.XpGainedToday.Int64,
 		LastXPGain:    &lastXPGain,
+		Prestige:      int(row.Prestige),
+		IsActive:      row.IsActive,
 	}, nil
 }
 
@@ -214,6 +222,59 @@ func (r *JobRepository) UpsertUserJob(ctx context.Context, userJob *domain.UserJ
 	return nil
 }
 
+// SetActiveJob makes a job the user's only active job, creating its progress
+// row if the user has not earned XP in it yet
+func (r *JobRepository) SetActiveJob(ctx context.Context, userID string, jobID int) error {
+	userUUID, err := parseUserUUID(userID)
+	if err != nil {
+		return err
+	}
+
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer SafeRollback(ctx, tx)
+
+	q := r.q.WithTx(tx)
+	if err := q.ClearActiveJob(ctx, userUUID); err != nil {
+		return fmt.Errorf("failed to clear active job: %w", err)
+	}
+	if err := q.SetActiveJob(ctx, generated.SetActiveJobParams{
+		UserID: userUUID,
+		JobID:  int32(jobID),
+	}); err != nil {
+		return fmt.Errorf("failed to set active job: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
+
+// PrestigeUserJob resets a job's level and XP and returns its new prestige.
+// It returns domain.ErrJobBelowLevelCap when the job is below minLevel.
+func (r *JobRepository) PrestigeUserJob(ctx context.Context, userID string, jobID, minLevel int) (int, error) {
+	userUUID, err := parseUserUUID(userID)
+	if err != nil {
+		return 0, err
+	}
+
+	prestige, err := r.q.PrestigeUserJob(ctx, generated.PrestigeUserJobParams{
+		UserID:   userUUID,
+		JobID:    int32(jobID),
+		MinLevel: int32(minLevel),
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return 0, domain.ErrJobBelowLevelCap
+		}
+		return 0, fmt.Errorf("failed to prestige job: %w", err)
+	}
+	return int(prestige), nil
+}
+
 // ResetDailyJobXP resets the xp_gained_today counter for all users
 // Returns the number of records affected
 func (r *JobRepository) ResetDailyJobXP(ctx context.Context) (int64, error) {
@@ -253,3 +314,37 @@ func (r *JobRepository) UpdateDailyResetTime(ctx context.Context, resetTime time
 
 	return nil
 }
+
+// GetActiveXPBoost returns the user's active XP multiplier, or 1 when none is active
+func (r *JobRepository) GetActiveXPBoost(ctx context.Context, userID string) (float64, error) {
+	userUUID, err := parseUserUUID(userID)
+	if err != nil {
+		return 0, err
+	}
+
+	multiplier, err := r.q.GetActiveXPBoost(ctx, userUUID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return 1, nil
+		}
+		return 0, fmt.Errorf("failed to get XP boost: %w", err)
+	}
+	return multiplier, nil
+}
+
+// GrantXPBoost grants or extends a user's timed XP multiplier
+func (r *JobRepository) GrantXPBoost(ctx context.Context, userID string, multiplier float64, duration time.Duration) error {
+	userUUID, err := parseUserUUID(userID)
+	if err != nil {
+		return err
+	}
+
+	if err := r.q.GrantXPBoost(ctx, generated.GrantXPBoostParams{
+		UserID:     userUUID,
+		Multiplier: multiplier,
+		DurationMs: duration.Milliseconds(),
+	}); err != nil {
+		return fmt.Errorf("failed to grant XP boost: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,100 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/osse101/BrandishBot_Go/internal/database/generated"
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+)
+
+// RecordXPAward writes an XP award to the audit trail
+func (r *JobRepository) RecordXPAward(ctx context.Context, award *domain.XPAward) error {
+	userUUID, err := parseUserUUID(award.UserID)
+	if err != nil {
+		return err
+	}
+
+	metadataJSON, err := json.Marshal(award.Metadata)
+	if err != nil {
+		return fmt.Errorf("failed to marshal XP award metadata: %w", err)
+	}
+
+	if err := r.q.RecordXPAward(ctx, generated.RecordXPAwardParams{
+		UserID:     userUUID,
+		JobKey:     award.JobKey,
+		Source:     award.Source,
+		BaseXp:     int32(award.BaseXP),
+		XpAwarded:  int32(award.XPAwarded),
+		Multiplier: award.Multiplier,
+		CappedBy:   pgtype.Text{String: award.CappedBy, Valid: award.CappedBy != ""},
+		OldLevel:   int32(award.OldLevel),
+		NewLevel:   int32(award.NewLevel),
+		Metadata:   metadataJSON,
+	}); err != nil {
+		return fmt.Errorf("failed to record XP award: %w", err)
+	}
+	return nil
+}
+
+// GetSourceXPSince returns the XP a user earned from a source across all jobs since the given time
+func (r *JobRepository) GetSourceXPSince(ctx context.Context, userID, source string, since time.Time) (int64, error) {
+	userUUID, err := parseUserUUID(userID)
+	if err != nil {
+		return 0, err
+	}
+
+	total, err := r.q.GetSourceXPSince(ctx, generated.GetSourceXPSinceParams{
+		UserID:    userUUID,
+		Source:    source,
+		AwardedAt: pgtype.Timestamptz{Time: since, Valid: true},
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to get source XP: %w", err)
+	}
+	return total, nil
+}
+
+// GetRecentXPAwards returns a user's most recent XP awards, newest first
+func (r *JobRepository) GetRecentXPAwards(ctx context.Context, userID string, limit int) ([]domain.XPAward, error) {
+	userUUID, err := parseUserUUID(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.q.GetRecentXPAwards(ctx, generated.GetRecentXPAwardsParams{
+		UserID: userUUID,
+		Limit:  int32(limit),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get XP awards: %w", err)
+	}
+
+	awards := make([]domain.XPAward, 0, len(rows))
+	for _, row := range rows {
+		award := domain.XPAward{
+			ID:         row.ID,
+			UserID:     row.UserID.String(),
+			JobKey:     row.JobKey,
+			Source:     row.Source,
+			BaseXP:     int(row.BaseXp),
+			XPAwarded:  int(row.XpAwarded),
+			Multiplier: row.Multiplier,
+			CappedBy:   row.CappedBy.String,
+			OldLevel:   int(row.OldLevel),
+			NewLevel:   int(row.NewLevel),
+			AwardedAt:  row.AwardedAt.Time,
+		}
+		if len(row.Metadata) > 0 {
+			if err := json.Unmarshal(row.Metadata, &award.Metadata); err != nil {
+				return nil, fmt.Errorf("failed to unmarshal XP award metadata: %w", err)
+			}
+		}
+		awards = append(awards, award)
+	}
+	return awards, nil
+}
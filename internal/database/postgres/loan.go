@@ -0,0 +1,197 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/osse101/BrandishBot_Go/internal/database/generated"
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/loan"
+	"github.com/osse101/BrandishBot_Go/internal/repository"
+)
+
+type loanRepository struct {
+	db        *pgxpool.Pool
+	q         *generated.Queries
+	inventory *inventoryEvents
+}
+
+// NewLoanRepository creates a new PostgreSQL item loan repository
+func NewLoanRepository(pool *pgxpool.Pool, opts ...RepositoryOption) loan.Repository {
+	return &loanRepository{db: pool, q: generated.New(pool), inventory: newInventoryEvents(InventorySourceLoan, opts)}
+}
+
+// BeginTx starts a transaction and returns a loan.Tx
+func (r *loanRepository) BeginTx(ctx context.Context) (loan.Tx, error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin loan transaction: %w", err)
+	}
+	return &loanTx{tx: tx, q: r.q.WithTx(tx), inventory: r.inventory.begin()}, nil
+}
+
+// GetInventory reads a user's inventory without locking it
+func (r *loanRepository) GetInventory(ctx context.Context, userID string) (*domain.Inventory, error) {
+	return getInventory(ctx, r.q, userID)
+}
+
+// GetActiveLoans returns the active loans a user has made or taken
+func (r *loanRepository) GetActiveLoans(ctx context.Context, userID string) ([]domain.ItemLoan, error) {
+	userUUID, err := parseUserUUID(userID)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := r.q.ListUserActiveItemLoans(ctx, userUUID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get loans: %w", err)
+	}
+	loans := make([]domain.ItemLoan, 0, len(rows))
+	for _, row := range rows {
+		l := mapItemLoan(generated.ItemLoan{
+			ID:               row.ID,
+			LenderID:         row.LenderID,
+			BorrowerID:       row.BorrowerID,
+			ItemID:           row.ItemID,
+			QualityLevel:     row.QualityLevel,
+			Quantity:         row.Quantity,
+			ReturnedQuantity: row.ReturnedQuantity,
+			Status:           row.Status,
+			DueAt:            row.DueAt,
+			CreatedAt:        row.CreatedAt,
+			ClosedAt:         row.ClosedAt,
+		})
+		l.ItemName = row.ItemName
+		loans = append(loans, l)
+	}
+	return loans, nil
+}
+
+// GetBorrowedQuantity returns how many of an item the user holds on active loans
+func (r *loanRepository) GetBorrowedQuantity(ctx context.Context, userID string, itemID int) (int, error) {
+	userUUID, err := parseUserUUID(userID)
+	if err != nil {
+		return 0, err
+	}
+	quantity, err := r.q.GetBorrowedItemQuantity(ctx, generated.GetBorrowedItemQuantityParams{
+		BorrowerID: pgtype.UUID{Bytes: userUUID, Valid: true},
+		ItemID:     int32(itemID),
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to get borrowed quantity: %w", err)
+	}
+	return int(quantity), nil
+}
+
+// GetDueLoanIDs returns active loans due at or before now
+func (r *loanRepository) GetDueLoanIDs(ctx context.Context, now time.Time, limit int) ([]int64, error) {
+	ids, err := r.q.ListDueItemLoanIDs(ctx, generated.ListDueItemLoanIDsParams{
+		Now:       pgtype.Timestamptz{Time: now, Valid: true},
+		BatchSize: int32(limit),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get due loans: %w", err)
+	}
+	return ids, nil
+}
+
+// loanTx implements loan.Tx
+type loanTx struct {
+	tx        pgx.Tx
+	q         *generated.Queries
+	inventory *inventoryJournal
+}
+
+func (t *loanTx) Commit(ctx context.Context) error {
+	if err := t.tx.Commit(ctx); err != nil {
+		return err
+	}
+	t.inventory.flush(ctx)
+	return nil
+}
+
+func (t *loanTx) Rollback(ctx context.Context) error {
+	err := t.tx.Rollback(ctx)
+	if errors.Is(err, pgx.ErrTxClosed) {
+		return fmt.Errorf("%w: %w", repository.ErrTxClosed, err)
+	}
+	return err
+}
+
+func (t *loanTx) AdjustItemQuantity(ctx context.Context, userID string, itemID int, quality domain.QualityLevel, delta int) (int, error) {
+	return adjustItemQuantity(ctx, t.q, userID, itemID, quality, delta, t.inventory)
+}
+
+func (t *loanTx) CreateLoan(ctx context.Context, l domain.ItemLoan) (*domain.ItemLoan, error) {
+	lenderUUID, err := parseUserUUID(l.LenderID)
+	if err != nil {
+		return nil, err
+	}
+	borrowerUUID, err := parseUserUUID(l.BorrowerID)
+	if err != nil {
+		return nil, err
+	}
+	row, err := t.q.CreateItemLoan(ctx, generated.CreateItemLoanParams{
+		LenderID:     lenderUUID,
+		BorrowerID:   pgtype.UUID{Bytes: borrowerUUID, Valid: true},
+		ItemID:       int32(l.ItemID),
+		QualityLevel: string(l.QualityLevel),
+		Quantity:     int32(l.Quantity),
+		DueAt:        pgtype.Timestamptz{Time: l.DueAt, Valid: true},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create loan: %w", err)
+	}
+	created := mapItemLoan(row)
+	return &created, nil
+}
+
+func (t *loanTx) GetActiveLoanForUpdate(ctx context.Context, id int64) (*domain.ItemLoan, error) {
+	row, err := t.q.GetActiveItemLoanForUpdate(ctx, id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get loan: %w", err)
+	}
+	l := mapItemLoan(row)
+	return &l, nil
+}
+
+func (t *loanTx) CloseLoan(ctx context.Context, id int64, status domain.LoanStatus, returnedQuantity int, closedAt time.Time) error {
+	err := t.q.CloseItemLoan(ctx, generated.CloseItemLoanParams{
+		ID:               id,
+		Status:           string(status),
+		ReturnedQuantity: int32(returnedQuantity),
+		ClosedAt:         pgtype.Timestamptz{Time: closedAt, Valid: true},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to close loan: %w", err)
+	}
+	return nil
+}
+
+func mapItemLoan(row generated.ItemLoan) domain.ItemLoan {
+	l := domain.ItemLoan{
+		ID:               row.ID,
+		LenderID:         row.LenderID.String(),
+		ItemID:           int(row.ItemID),
+		QualityLevel:     domain.QualityLevel(row.QualityLevel),
+		Quantity:         int(row.Quantity),
+		ReturnedQuantity: int(row.ReturnedQuantity),
+		Status:           domain.LoanStatus(row.Status),
+		DueAt:            row.DueAt.Time,
+		CreatedAt:        row.CreatedAt.Time,
+		ClosedAt:         ptrTimestamptz(row.ClosedAt),
+	}
+	if row.BorrowerID.Valid {
+		l.BorrowerID = uuid.UUID(row.BorrowerID.Bytes).String()
+	}
+	return l
+}
@@ -0,0 +1,57 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/osse101/BrandishBot_Go/internal/database/generated"
+	"github.com/osse101/BrandishBot_Go/internal/lootbox"
+)
+
+type lootboxPityRepository struct {
+	q *generated.Queries
+}
+
+// NewLootboxPityRepository creates a new PostgreSQL lootbox pity repository
+func NewLootboxPityRepository(pool *pgxpool.Pool) lootbox.PityRepository {
+	return &lootboxPityRepository{q: generated.New(pool)}
+}
+
+// GetPityCount returns the user's openings since their last rare drop (0 if none are stored)
+func (r *lootboxPityRepository) GetPityCount(ctx context.Context, userID, lootboxName string) (int, error) {
+	userUUID, err := parseUserUUID(userID)
+	if err != nil {
+		return 0, err
+	}
+	count, err := r.q.GetLootboxPityCount(ctx, generated.GetLootboxPityCountParams{
+		UserID:      userUUID,
+		LootboxName: lootboxName,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return 0, nil
+		}
+		return 0, fmt.Errorf("failed to get lootbox pity count: %w", err)
+	}
+	return int(count), nil
+}
+
+// SetPityCount stores the user's openings since their last rare drop
+func (r *lootboxPityRepository) SetPityCount(ctx context.Context, userID, lootboxName string, count int) error {
+	userUUID, err := parseUserUUID(userID)
+	if err != nil {
+		return err
+	}
+	if err := r.q.UpsertLootboxPityCount(ctx, generated.UpsertLootboxPityCountParams{
+		UserID:            userUUID,
+		LootboxName:       lootboxName,
+		OpeningsSinceRare: int32(count),
+	}); err != nil {
+		return fmt.Errorf("failed to set lootbox pity count: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,133 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/osse101/BrandishBot_Go/internal/community"
+	"github.com/osse101/BrandishBot_Go/internal/database/generated"
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/repository"
+)
+
+type marketRepository struct {
+	q *generated.Queries
+}
+
+// NewMarketRepository creates a PostgreSQL repository for item trade
+// pressure and price history
+func NewMarketRepository(pool *pgxpool.Pool) repository.Market {
+	return &marketRepository{q: generated.New(pool)}
+}
+
+// RecordTrade decays the item's pressure to now and adds delta
+func (r *marketRepository) RecordTrade(ctx context.Context, itemID int, delta float64, halfLife time.Duration) error {
+	if err := r.q.RecordItemTrade(ctx, generated.RecordItemTradeParams{
+		CommunityID:     community.FromContext(ctx),
+		ItemID:          int32(itemID),
+		Delta:           delta,
+		HalfLifeSeconds: halfLife.Seconds(),
+	}); err != nil {
+		return fmt.Errorf("failed to record item trade: %w", err)
+	}
+	return nil
+}
+
+// GetMarketState returns the item's trade pressure, or nil if it has never traded
+func (r *marketRepository) GetMarketState(ctx context.Context, itemID int) (*domain.ItemMarketState, error) {
+	row, err := r.q.GetItemMarketPressure(ctx, generated.GetItemMarketPressureParams{
+		CommunityID: community.FromContext(ctx),
+		ItemID:      int32(itemID),
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get item market pressure: %w", err)
+	}
+	return &domain.ItemMarketState{
+		ItemID:    int(row.ItemID),
+		Pressure:  row.Pressure,
+		UpdatedAt: row.UpdatedAt.Time,
+	}, nil
+}
+
+// ListMarketStates returns every item that has traded
+func (r *marketRepository) ListMarketStates(ctx context.Context) ([]domain.ItemMarketState, error) {
+	rows, err := r.q.ListItemMarketStates(ctx, community.FromContext(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list item market states: %w", err)
+	}
+	states := make([]domain.ItemMarketState, 0, len(rows))
+	for _, row := range rows {
+		state := domain.ItemMarketState{
+			ItemID:    int(row.ItemID),
+			BaseValue: int(row.BaseValue.Int32),
+			Pressure:  row.Pressure,
+			UpdatedAt: row.UpdatedAt.Time,
+		}
+		if row.LastMultiplier.Valid {
+			last := row.LastMultiplier.Float64
+			state.LastMultiplier = &last
+		}
+		states = append(states, state)
+	}
+	return states, nil
+}
+
+// RecordPrice appends a point to the item's price history
+func (r *marketRepository) RecordPrice(ctx context.Context, itemID int, point domain.PricePoint) error {
+	if err := r.q.InsertItemPriceHistory(ctx, generated.InsertItemPriceHistoryParams{
+		ItemID:      int32(itemID),
+		Multiplier:  point.Multiplier,
+		BuyPrice:    int32(point.BuyPrice),
+		SellPrice:   int32(point.SellPrice),
+		RecordedAt:  pgtype.Timestamptz{Time: point.RecordedAt, Valid: true},
+		CommunityID: community.FromContext(ctx),
+	}); err != nil {
+		return fmt.Errorf("failed to insert item price history: %w", err)
+	}
+	return nil
+}
+
+// GetPriceHistory returns up to limit of the item's most recent points since
+// the given time, oldest first
+func (r *marketRepository) GetPriceHistory(ctx context.Context, itemID int, since time.Time, limit int) ([]domain.PricePoint, error) {
+	rows, err := r.q.GetItemPriceHistory(ctx, generated.GetItemPriceHistoryParams{
+		ItemID:      int32(itemID),
+		RecordedAt:  pgtype.Timestamptz{Time: since, Valid: true},
+		Limit:       int32(limit),
+		CommunityID: community.FromContext(ctx),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get item price history: %w", err)
+	}
+	points := make([]domain.PricePoint, 0, len(rows))
+	for _, row := range rows {
+		points = append(points, domain.PricePoint{
+			Multiplier: row.Multiplier,
+			BuyPrice:   int(row.BuyPrice),
+			SellPrice:  int(row.SellPrice),
+			RecordedAt: row.RecordedAt.Time,
+		})
+	}
+	slices.Reverse(points)
+	return points, nil
+}
+
+// PrunePriceHistory deletes points recorded before the given time in every
+// community
+func (r *marketRepository) PrunePriceHistory(ctx context.Context, before time.Time) (int64, error) {
+	deleted, err := r.q.DeleteItemPriceHistoryBefore(ctx, pgtype.Timestamptz{Time: before, Valid: true})
+	if err != nil {
+		return 0, fmt.Errorf("failed to prune item price history: %w", err)
+	}
+	return deleted, nil
+}
@@ -0,0 +1,194 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/osse101/BrandishBot_Go/internal/database/generated"
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/moderation"
+)
+
+type moderationRepository struct {
+	db        *pgxpool.Pool
+	q         *generated.Queries
+	inventory *inventoryEvents
+}
+
+// NewModerationRepository creates a new PostgreSQL moderation repository
+func NewModerationRepository(pool *pgxpool.Pool, opts ...RepositoryOption) moderation.Repository {
+	return &moderationRepository{db: pool, q: generated.New(pool), inventory: newInventoryEvents(InventorySourceModeration, opts)}
+}
+
+// Freeze freezes an account and records the action
+func (r *moderationRepository) Freeze(ctx context.Context, userID, actor, reason string) (bool, error) {
+	userUUID, err := parseUserUUID(userID)
+	if err != nil {
+		return false, err
+	}
+
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer SafeRollback(ctx, tx)
+	q := r.q.WithTx(tx)
+
+	rows, err := q.FreezeUser(ctx, generated.FreezeUserParams{
+		UserID:   userUUID,
+		FrozenBy: actor,
+		Reason:   reason,
+	})
+	if err != nil {
+		return false, fmt.Errorf("failed to freeze user: %w", err)
+	}
+	if rows == 0 {
+		return false, nil
+	}
+	if err := recordModerationAction(ctx, q, userID, domain.ModerationFreeze, actor, reason); err != nil {
+		return false, err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return false, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return true, nil
+}
+
+// Unfreeze lifts a freeze and records the action
+func (r *moderationRepository) Unfreeze(ctx context.Context, userID, actor, reason string) (bool, error) {
+	userUUID, err := parseUserUUID(userID)
+	if err != nil {
+		return false, err
+	}
+
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer SafeRollback(ctx, tx)
+	q := r.q.WithTx(tx)
+
+	rows, err := q.UnfreezeUser(ctx, userUUID)
+	if err != nil {
+		return false, fmt.Errorf("failed to unfreeze user: %w", err)
+	}
+	if rows == 0 {
+		return false, nil
+	}
+	if err := recordModerationAction(ctx, q, userID, domain.ModerationUnfreeze, actor, reason); err != nil {
+		return false, err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return false, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return true, nil
+}
+
+// GetFreeze returns the account's freeze, or nil when it is not frozen
+func (r *moderationRepository) GetFreeze(ctx context.Context, userID string) (*domain.AccountFreeze, error) {
+	userUUID, err := parseUserUUID(userID)
+	if err != nil {
+		return nil, err
+	}
+	row, err := r.q.GetUserFreeze(ctx, userUUID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get user freeze: %w", err)
+	}
+	return &domain.AccountFreeze{
+		UserID:   row.UserID.String(),
+		FrozenBy: row.FrozenBy,
+		Reason:   row.Reason,
+		FrozenAt: row.FrozenAt.Time,
+	}, nil
+}
+
+// IsFrozenByPlatform reports whether the account linked to a platform identity is frozen
+func (r *moderationRepository) IsFrozenByPlatform(ctx context.Context, platform, platformID string) (bool, error) {
+	frozen, err := r.q.IsPlatformUserFrozen(ctx, generated.IsPlatformUserFrozenParams{
+		Name:           platform,
+		PlatformUserID: platformID,
+	})
+	if err != nil {
+		return false, fmt.Errorf("failed to check user freeze: %w", err)
+	}
+	return frozen, nil
+}
+
+// WipeInventory empties an account's inventory, records the action and
+// publishes the removed slots once committed
+func (r *moderationRepository) WipeInventory(ctx context.Context, userID, actor, reason string) ([]domain.InventorySlot, error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer SafeRollback(ctx, tx)
+	q := r.q.WithTx(tx)
+	journal := r.inventory.begin()
+
+	inventory, err := getInventoryForUpdate(ctx, q, userID)
+	if err != nil {
+		return nil, err
+	}
+	if err := updateInventory(ctx, q, userID, domain.Inventory{Slots: []domain.InventorySlot{}}, journal); err != nil {
+		return nil, err
+	}
+	if err := recordModerationAction(ctx, q, userID, domain.ModerationWipeInventory, actor, reason); err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	journal.flush(ctx)
+	return inventory.Slots, nil
+}
+
+// GetActions returns an account's moderation history, newest first
+func (r *moderationRepository) GetActions(ctx context.Context, userID string, limit int) ([]domain.ModerationAction, error) {
+	userUUID, err := parseUserUUID(userID)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := r.q.GetModerationActions(ctx, generated.GetModerationActionsParams{
+		UserID: userUUID,
+		Limit:  int32(limit),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get moderation actions: %w", err)
+	}
+	actions := make([]domain.ModerationAction, 0, len(rows))
+	for _, row := range rows {
+		actions = append(actions, domain.ModerationAction{
+			ID:        row.ID,
+			UserID:    row.UserID.String(),
+			Action:    domain.ModerationActionType(row.Action),
+			Actor:     row.Actor,
+			Reason:    row.Reason,
+			CreatedAt: row.CreatedAt.Time,
+		})
+	}
+	return actions, nil
+}
+
+// recordModerationAction adds an entry to an account's moderation history
+func recordModerationAction(ctx context.Context, q *generated.Queries, userID string, action domain.ModerationActionType, actor, reason string) error {
+	userUUID, err := parseUserUUID(userID)
+	if err != nil {
+		return err
+	}
+	if err := q.InsertModerationAction(ctx, generated.InsertModerationActionParams{
+		UserID: userUUID,
+		Action: string(action),
+		Actor:  actor,
+		Reason: reason,
+	}); err != nil {
+		return fmt.Errorf("failed to record moderation action: %w", err)
+	}
+	return nil
+}
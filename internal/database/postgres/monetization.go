@@ -0,0 +1,80 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/osse101/BrandishBot_Go/internal/database/generated"
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/monetization"
+)
+
+type monetizationRepository struct {
+	q *generated.Queries
+}
+
+// NewMonetizationRepository creates a new PostgreSQL monetization event repository
+func NewMonetizationRepository(pool *pgxpool.Pool) monetization.Repository {
+	return &monetizationRepository{q: generated.New(pool)}
+}
+
+// ClaimEvent stores the event unless its source and event ID were already claimed
+func (r *monetizationRepository) ClaimEvent(ctx context.Context, evt domain.MonetizationEvent) (bool, error) {
+	payload, err := json.Marshal(evt)
+	if err != nil {
+		return false, fmt.Errorf("failed to marshal monetization event: %w", err)
+	}
+
+	rows, err := r.q.ClaimMonetizationEvent(ctx, generated.ClaimMonetizationEventParams{
+		Source:  evt.Source,
+		EventID: evt.EventID,
+		Kind:    evt.Kind,
+		Payload: payload,
+	})
+	if err != nil {
+		return false, fmt.Errorf("failed to claim monetization event: %w", err)
+	}
+	return rows > 0, nil
+}
+
+// CompleteEvent records the linked user and granted rewards of a claimed event
+func (r *monetizationRepository) CompleteEvent(ctx context.Context, result domain.MonetizationResult) error {
+	resultJSON, err := json.Marshal(result)
+	if err != nil {
+		return fmt.Errorf("failed to marshal monetization result: %w", err)
+	}
+
+	var userID pgtype.UUID
+	if result.UserID != "" {
+		userUUID, err := parseUserUUID(result.UserID)
+		if err != nil {
+			return err
+		}
+		userID = pgtype.UUID{Bytes: userUUID, Valid: true}
+	}
+
+	if err := r.q.CompleteMonetizationEvent(ctx, generated.CompleteMonetizationEventParams{
+		UserID:  userID,
+		Result:  resultJSON,
+		Source:  result.Source,
+		EventID: result.EventID,
+	}); err != nil {
+		return fmt.Errorf("failed to complete monetization event: %w", err)
+	}
+	return nil
+}
+
+// ReleaseEvent removes an uncompleted claim
+func (r *monetizationRepository) ReleaseEvent(ctx context.Context, source, eventID string) error {
+	if err := r.q.ReleaseMonetizationEvent(ctx, generated.ReleaseMonetizationEventParams{
+		Source:  source,
+		EventID: eventID,
+	}); err != nil {
+		return fmt.Errorf("failed to release monetization event: %w", err)
+	}
+	return nil
+}
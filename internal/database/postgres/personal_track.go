@@ -0,0 +1,75 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/osse101/BrandishBot_Go/internal/database/generated"
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/personaltrack"
+)
+
+type personalTrackRepository struct {
+	q *generated.Queries
+}
+
+// NewPersonalTrackRepository creates a new PostgreSQL personal track repository
+func NewPersonalTrackRepository(pool *pgxpool.Pool) personaltrack.Repository {
+	return &personalTrackRepository{q: generated.New(pool)}
+}
+
+// GetMetricTotals sums the user's engagement by metric
+func (r *personalTrackRepository) GetMetricTotals(ctx context.Context, userID string) (map[string]int64, error) {
+	rows, err := r.q.GetUserEngagementAggregated(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query user engagement: %w", err)
+	}
+	totals := make(map[string]int64, len(rows))
+	for _, row := range rows {
+		totals[row.MetricType] = row.Total
+	}
+	return totals, nil
+}
+
+// GetMilestones returns the user's milestone unlocks, oldest first
+func (r *personalTrackRepository) GetMilestones(ctx context.Context, userID string) ([]*domain.UserProgression, error) {
+	rows, err := r.q.GetUserProgressions(ctx, generated.GetUserProgressionsParams{
+		UserID:          userID,
+		ProgressionType: domain.ProgressionTypeMilestone,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to query user milestones: %w", err)
+	}
+
+	milestones := make([]*domain.UserProgression, 0, len(rows))
+	for _, row := range rows {
+		milestone, err := mapUserProgression(row)
+		if err != nil {
+			return nil, err
+		}
+		milestones = append(milestones, milestone)
+	}
+	return milestones, nil
+}
+
+// ClaimMilestone records a milestone unlock unless the user already has it
+func (r *personalTrackRepository) ClaimMilestone(ctx context.Context, userID, key string, metadata map[string]interface{}) (bool, error) {
+	metadataJSON, err := json.Marshal(metadata)
+	if err != nil {
+		return false, fmt.Errorf("failed to marshal metadata: %w", err)
+	}
+
+	affected, err := r.q.ClaimUserProgression(ctx, generated.ClaimUserProgressionParams{
+		UserID:          userID,
+		ProgressionType: domain.ProgressionTypeMilestone,
+		ProgressionKey:  key,
+		Metadata:        metadataJSON,
+	})
+	if err != nil {
+		return false, fmt.Errorf("failed to claim milestone: %w", err)
+	}
+	return affected > 0, nil
+}
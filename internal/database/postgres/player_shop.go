@@ -0,0 +1,192 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/osse101/BrandishBot_Go/internal/community"
+	"github.com/osse101/BrandishBot_Go/internal/database/generated"
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/playershop"
+	"github.com/osse101/BrandishBot_Go/internal/repository"
+)
+
+type playerShopRepository struct {
+	db        *pgxpool.Pool
+	q         *generated.Queries
+	inventory *inventoryEvents
+}
+
+// NewPlayerShopRepository creates a new PostgreSQL player shop repository
+func NewPlayerShopRepository(pool *pgxpool.Pool, opts ...RepositoryOption) playershop.Repository {
+	return &playerShopRepository{db: pool, q: generated.New(pool), inventory: newInventoryEvents(InventorySourcePlayerShop, opts)}
+}
+
+// BeginTx starts a transaction and returns a playershop.Tx
+func (r *playerShopRepository) BeginTx(ctx context.Context) (playershop.Tx, error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin player shop transaction: %w", err)
+	}
+	return &playerShopTx{tx: tx, q: r.q.WithTx(tx), inventory: r.inventory.begin()}, nil
+}
+
+// GetInventory reads a user's inventory without locking it
+func (r *playerShopRepository) GetInventory(ctx context.Context, userID string) (*domain.Inventory, error) {
+	return getInventory(ctx, r.q, userID)
+}
+
+// GetActiveListings returns the active listings matching the filter
+func (r *playerShopRepository) GetActiveListings(ctx context.Context, filter domain.ListingFilter, limit int) ([]domain.PlayerListing, error) {
+	rows, err := r.q.ListActivePlayerShopListings(ctx, generated.ListActivePlayerShopListingsParams{
+		CommunityID:  community.FromContext(ctx),
+		ItemID:       pgtype.Int4{Int32: int32(filter.ItemID), Valid: filter.ItemID != 0},
+		SellerName:   pgtype.Text{String: filter.SellerName, Valid: filter.SellerName != ""},
+		QualityLevel: pgtype.Text{String: string(filter.Quality), Valid: filter.Quality != ""},
+		MinPrice:     pgtype.Int4{Int32: int32(filter.MinPrice), Valid: filter.MinPrice != 0},
+		MaxPrice:     pgtype.Int4{Int32: int32(filter.MaxPrice), Valid: filter.MaxPrice != 0},
+		NewestFirst:  filter.Sort == domain.ListingSortNewest,
+		MaxRows:      int32(limit),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get listings: %w", err)
+	}
+	listings := make([]domain.PlayerListing, 0, len(rows))
+	for _, row := range rows {
+		l := mapPlayerShopListing(generated.PlayerShopListing{
+			ID:           row.ID,
+			SellerID:     row.SellerID,
+			ItemID:       row.ItemID,
+			QualityLevel: row.QualityLevel,
+			Quantity:     row.Quantity,
+			UnitPrice:    row.UnitPrice,
+			Status:       row.Status,
+			CreatedAt:    row.CreatedAt,
+			ClosedAt:     row.ClosedAt,
+			ListingFee:   row.ListingFee,
+		})
+		l.ItemName = row.ItemName
+		l.SellerName = row.SellerName
+		listings = append(listings, l)
+	}
+	return listings, nil
+}
+
+// CountActiveListings returns how many active listings the seller has
+func (r *playerShopRepository) CountActiveListings(ctx context.Context, sellerID string) (int, error) {
+	sellerUUID, err := parseUserUUID(sellerID)
+	if err != nil {
+		return 0, err
+	}
+	count, err := r.q.CountActivePlayerShopListings(ctx, sellerUUID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count listings: %w", err)
+	}
+	return int(count), nil
+}
+
+// GetCommunityPoolBalance returns the money held by the community pool
+func (r *playerShopRepository) GetCommunityPoolBalance(ctx context.Context) (int64, error) {
+	return getCommunityPoolBalance(ctx, r.q)
+}
+
+// playerShopTx implements playershop.Tx
+type playerShopTx struct {
+	tx        pgx.Tx
+	q         *generated.Queries
+	inventory *inventoryJournal
+}
+
+func (t *playerShopTx) Commit(ctx context.Context) error {
+	if err := t.tx.Commit(ctx); err != nil {
+		return err
+	}
+	t.inventory.flush(ctx)
+	return nil
+}
+
+func (t *playerShopTx) Rollback(ctx context.Context) error {
+	err := t.tx.Rollback(ctx)
+	if errors.Is(err, pgx.ErrTxClosed) {
+		return fmt.Errorf("%w: %w", repository.ErrTxClosed, err)
+	}
+	return err
+}
+
+func (t *playerShopTx) AdjustItemQuantity(ctx context.Context, userID string, itemID int, quality domain.QualityLevel, delta int) (int, error) {
+	return adjustItemQuantity(ctx, t.q, userID, itemID, quality, delta, t.inventory)
+}
+
+func (t *playerShopTx) CreateListing(ctx context.Context, l domain.PlayerListing) (*domain.PlayerListing, error) {
+	sellerUUID, err := parseUserUUID(l.SellerID)
+	if err != nil {
+		return nil, err
+	}
+	row, err := t.q.CreatePlayerShopListing(ctx, generated.CreatePlayerShopListingParams{
+		SellerID:     sellerUUID,
+		ItemID:       int32(l.ItemID),
+		QualityLevel: string(l.QualityLevel),
+		Quantity:     int32(l.Quantity),
+		UnitPrice:    int32(l.UnitPrice),
+		ListingFee:   int32(l.ListingFee),
+		CommunityID:  community.FromContext(ctx),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create listing: %w", err)
+	}
+	created := mapPlayerShopListing(row)
+	return &created, nil
+}
+
+func (t *playerShopTx) GetActiveListingForUpdate(ctx context.Context, id int64) (*domain.PlayerListing, error) {
+	row, err := t.q.GetActivePlayerShopListingForUpdate(ctx, generated.GetActivePlayerShopListingForUpdateParams{
+		ID:          id,
+		CommunityID: community.FromContext(ctx),
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get listing: %w", err)
+	}
+	l := mapPlayerShopListing(row)
+	return &l, nil
+}
+
+func (t *playerShopTx) UpdateListing(ctx context.Context, id int64, quantity int, status domain.ListingStatus, closedAt *time.Time) error {
+	err := t.q.UpdatePlayerShopListing(ctx, generated.UpdatePlayerShopListingParams{
+		ID:       id,
+		Quantity: int32(quantity),
+		Status:   string(status),
+		ClosedAt: timePtrToPgTimestamptz(closedAt),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update listing: %w", err)
+	}
+	return nil
+}
+
+func (t *playerShopTx) AddToCommunityPool(ctx context.Context, amount int) error {
+	return addToCommunityPool(ctx, t.q, amount)
+}
+
+func mapPlayerShopListing(row generated.PlayerShopListing) domain.PlayerListing {
+	return domain.PlayerListing{
+		ID:           row.ID,
+		SellerID:     row.SellerID.String(),
+		ItemID:       int(row.ItemID),
+		QualityLevel: domain.QualityLevel(row.QualityLevel),
+		Quantity:     int(row.Quantity),
+		UnitPrice:    int(row.UnitPrice),
+		Status:       domain.ListingStatus(row.Status),
+		ListingFee:   int(row.ListingFee),
+		CreatedAt:    row.CreatedAt.Time,
+		ClosedAt:     ptrTimestamptz(row.ClosedAt),
+	}
+}
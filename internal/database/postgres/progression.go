@@ -5,12 +5,14 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/jackc/pgx/v5/pgxpool"
 
+	"github.com/osse101/BrandishBot_Go/internal/community"
 	"github.com/osse101/BrandishBot_Go/internal/database/generated"
 	"github.com/osse101/BrandishBot_Go/internal/domain"
 	"github.com/osse101/BrandishBot_Go/internal/event"
@@ -21,14 +23,24 @@ import (
 type progressionRepository struct {
 	pool *pgxpool.Pool
 	q    *generated.Queries
+	rq   readQueries
 	bus  event.Bus
 }
 
 // NewProgressionRepository creates a new Postgres-backed progression repository
 func NewProgressionRepository(pool *pgxpool.Pool, bus event.Bus) repository.Progression {
+	return NewProgressionRepositoryWithReplica(pool, nil, bus)
+}
+
+// NewProgressionRepositoryWithReplica creates a progression repository that
+// reads the tree structure and contribution leaderboards from the replica pool
+// when it is non-nil. Unlock and voting state always come from the primary.
+func NewProgressionRepositoryWithReplica(pool, replica *pgxpool.Pool, bus event.Bus) repository.Progression {
+	q := generated.New(pool)
 	return &progressionRepository{
 		pool: pool,
-		q:    generated.New(pool),
+		q:    q,
+		rq:   newReadQueries(q, replica),
 		bus:  bus,
 	}
 }
@@ -60,7 +72,7 @@ func (r *progressionRepository) GetNodeByID(ctx context.Context, id int) (*domai
 }
 
 func (r *progressionRepository) GetAllNodes(ctx context.Context) ([]*domain.ProgressionNode, error) {
-	rows, err := r.q.GetAllNodes(ctx)
+	rows, err := r.rq.pick(ctx).GetAllNodes(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to query nodes: %w", err)
 	}
@@ -145,6 +157,7 @@ func (r *progressionRepository) GetUnlock(ctx context.Context, nodeID int, level
 	row, err := r.q.GetUnlock(ctx, generated.GetUnlockParams{
 		NodeID:       pgtype.Int4{Int32: int32(nodeID), Valid: true},
 		CurrentLevel: pgtype.Int4{Int32: int32(level), Valid: true},
+		CommunityID:  community.FromContext(ctx),
 	})
 
 	if err != nil {
@@ -165,7 +178,7 @@ func (r *progressionRepository) GetUnlock(ctx context.Context, nodeID int, level
 }
 
 func (r *progressionRepository) GetAllUnlocks(ctx context.Context) ([]*domain.ProgressionUnlock, error) {
-	rows, err := r.q.GetAllUnlocks(ctx)
+	rows, err := r.q.GetAllUnlocks(ctx, community.FromContext(ctx))
 	if err != nil {
 		return nil, fmt.Errorf("failed to query unlocks: %w", err)
 	}
@@ -189,6 +202,7 @@ func (r *progressionRepository) IsNodeUnlocked(ctx context.Context, nodeKey stri
 	return r.q.IsNodeUnlocked(ctx, generated.IsNodeUnlockedParams{
 		NodeKey:      nodeKey,
 		CurrentLevel: pgtype.Int4{Int32: int32(level), Valid: true},
+		CommunityID:  community.FromContext(ctx),
 	})
 }
 
@@ -198,6 +212,7 @@ func (r *progressionRepository) UnlockNode(ctx context.Context, nodeID int, leve
 		CurrentLevel:    pgtype.Int4{Int32: int32(level), Valid: true},
 		UnlockedBy:      pgtype.Text{String: unlockedBy, Valid: unlockedBy != ""},
 		EngagementScore: pgtype.Int4{Int32: int32(engagementScore), Valid: true},
+		CommunityID:     community.FromContext(ctx),
 	})
 
 	if err != nil {
@@ -235,6 +250,7 @@ func (r *progressionRepository) RelockNode(ctx context.Context, nodeID int, leve
 	err := r.q.RelockNode(ctx, generated.RelockNodeParams{
 		NodeID:       pgtype.Int4{Int32: int32(nodeID), Valid: true},
 		CurrentLevel: pgtype.Int4{Int32: int32(level), Valid: true},
+		CommunityID:  community.FromContext(ctx),
 	})
 
 	if err != nil {
@@ -242,7 +258,10 @@ func (r *progressionRepository) RelockNode(ctx context.Context, nodeID int, leve
 	}
 
 	// Clear any unlock progress records targeting this node to prevent stale state
-	if err := r.q.ClearUnlockProgressForNode(ctx, pgtype.Int4{Int32: int32(nodeID), Valid: true}); err != nil {
+	if err := r.q.ClearUnlockProgressForNode(ctx, generated.ClearUnlockProgressForNodeParams{
+		NodeID:      pgtype.Int4{Int32: int32(nodeID), Valid: true},
+		CommunityID: community.FromContext(ctx),
+	}); err != nil {
 		logger.FromContext(ctx).Warn("failed to clear unlock progress for relocked node", "error", err, "node_id", nodeID)
 		// Don't fail the relock operation, just log
 	}
@@ -439,28 +458,40 @@ func (r *progressionRepository) GetUserProgressions(ctx context.Context, userID
 
 	progressions := make([]*domain.UserProgression, 0, len(rows))
 	for _, row := range rows {
-		prog := &domain.UserProgression{
-			UserID:          row.UserID,
-			ProgressionType: row.ProgressionType,
-			ProgressionKey:  row.ProgressionKey,
-			UnlockedAt:      row.UnlockedAt.Time,
-		}
-
-		if len(row.Metadata) > 0 {
-			if err := json.Unmarshal(row.Metadata, &prog.Metadata); err != nil {
-				return nil, fmt.Errorf("failed to unmarshal metadata: %w", err)
-			}
+		prog, err := mapUserProgression(row)
+		if err != nil {
+			return nil, err
 		}
-
 		progressions = append(progressions, prog)
 	}
 
 	return progressions, nil
 }
 
+func mapUserProgression(row generated.UserProgression) (*domain.UserProgression, error) {
+	prog := &domain.UserProgression{
+		UserID:          row.UserID,
+		ProgressionType: row.ProgressionType,
+		ProgressionKey:  row.ProgressionKey,
+		UnlockedAt:      row.UnlockedAt.Time,
+	}
+
+	if len(row.Metadata) > 0 {
+		if err := json.Unmarshal(row.Metadata, &prog.Metadata); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal metadata: %w", err)
+		}
+	}
+
+	return prog, nil
+}
+
 // Engagement tracking
 
 func (r *progressionRepository) RecordEngagement(ctx context.Context, metric *domain.EngagementMetric) error {
+	return recordEngagement(ctx, r.q, metric)
+}
+
+func recordEngagement(ctx context.Context, q *generated.Queries, metric *domain.EngagementMetric) error {
 	var metadataJSON []byte
 	var err error
 	if metric.Metadata != nil {
@@ -470,7 +501,7 @@ func (r *progressionRepository) RecordEngagement(ctx context.Context, metric *do
 		}
 	}
 
-	err = r.q.RecordEngagement(ctx, generated.RecordEngagementParams{
+	err = q.RecordEngagement(ctx, generated.RecordEngagementParams{
 		UserID:      metric.UserID,
 		MetricType:  metric.MetricType,
 		MetricValue: pgtype.Int4{Int32: int32(metric.MetricValue), Valid: true},
@@ -547,6 +578,16 @@ func (r *progressionRepository) GetUserEngagement(ctx context.Context, userID st
 			breakdown.ItemsUsed = total
 		}
 
+		// External contributions are already in contribution points
+		if source, ok := strings.CutPrefix(row.MetricType, domain.MetricTypeExternalPrefix); ok {
+			if breakdown.External == nil {
+				breakdown.External = make(map[string]int)
+			}
+			breakdown.External[source] = total
+			breakdown.TotalScore += total
+			continue
+		}
+
 		weight := weights[row.MetricType]
 		breakdown.TotalScore += int(float64(total) * weight)
 	}
@@ -593,9 +634,10 @@ func (r *progressionRepository) ResetTree(ctx context.Context, resetBy string, r
 	defer SafeRollback(ctx, h.Tx())
 
 	q := h.Queries()
+	communityID := community.FromContext(ctx)
 
 	// Count unlocks
-	nodeCount, err := q.CountUnlocks(ctx)
+	nodeCount, err := q.CountUnlocks(ctx, communityID)
 	if err != nil {
 		return fmt.Errorf("failed to count unlocks: %w", err)
 	}
@@ -618,17 +660,17 @@ func (r *progressionRepository) ResetTree(ctx context.Context, resetBy string, r
 	}
 
 	// Clear user votes first (has FK to voting sessions)
-	if err := q.ClearAllUserVotes(ctx); err != nil {
+	if err := q.ClearAllUserVotes(ctx, communityID); err != nil {
 		return fmt.Errorf("failed to clear user votes: %w", err)
 	}
 
 	// Clear unlock progress (has FK to voting sessions)
-	if err := q.ClearAllUnlockProgress(ctx); err != nil {
+	if err := q.ClearAllUnlockProgress(ctx, communityID); err != nil {
 		return fmt.Errorf("failed to clear unlock progress: %w", err)
 	}
 
 	// Clear voting sessions (has FK to voting options via winning_option_id)
-	if err := q.ClearAllVotingSessions(ctx); err != nil {
+	if err := q.ClearAllVotingSessions(ctx, communityID); err != nil {
 		return fmt.Errorf("failed to clear voting sessions: %w", err)
 	}
 
@@ -638,7 +680,7 @@ func (r *progressionRepository) ResetTree(ctx context.Context, resetBy string, r
 	}
 
 	// Clear unlocks (except root)
-	if err := q.ClearUnlocksExceptRoot(ctx); err != nil {
+	if err := q.ClearUnlocksExceptRoot(ctx, communityID); err != nil {
 		return fmt.Errorf("failed to clear unlocks: %w", err)
 	}
 
@@ -649,7 +691,7 @@ func (r *progressionRepository) ResetTree(ctx context.Context, resetBy string, r
 
 	// Optionally preserve user progression
 	if !preserveUserData {
-		if err := q.ClearAllUserProgression(ctx); err != nil {
+		if err := q.ClearAllUserProgression(ctx, communityID); err != nil {
 			return fmt.Errorf("failed to clear user progression: %w", err)
 		}
 	}
@@ -706,7 +748,10 @@ func (t *progressionTx) Rollback(ctx context.Context) error {
 
 // GetNodeByFeatureKey retrieves a node by its modifier feature_key and returns the current unlock level
 func (r *progressionRepository) GetNodeByFeatureKey(ctx context.Context, featureKey string) (*domain.ProgressionNode, int, error) {
-	row, err := r.q.GetNodeByFeatureKey(ctx, featureKey)
+	row, err := r.q.GetNodeByFeatureKey(ctx, generated.GetNodeByFeatureKeyParams{
+		FeatureKey:  featureKey,
+		CommunityID: community.FromContext(ctx),
+	})
 	if err != nil {
 		return nil, 0, fmt.Errorf("failed to get node by feature key: %w", err)
 	}
@@ -731,7 +776,10 @@ func (r *progressionRepository) GetNodeByFeatureKey(ctx context.Context, feature
 
 // GetAllNodesByFeatureKey retrieves all nodes with the same feature_key and their unlock levels
 func (r *progressionRepository) GetAllNodesByFeatureKey(ctx context.Context, featureKey string) ([]*domain.ProgressionNode, []int, error) {
-	rows, err := r.q.GetAllNodesByFeatureKey(ctx, featureKey)
+	rows, err := r.q.GetAllNodesByFeatureKey(ctx, generated.GetAllNodesByFeatureKeyParams{
+		FeatureKey:  featureKey,
+		CommunityID: community.FromContext(ctx),
+	})
 	if err != nil {
 		return nil, nil, fmt.Errorf("failed to get nodes by feature key: %w", err)
 	}
@@ -764,7 +812,10 @@ func (r *progressionRepository) GetAllNodesByFeatureKey(ctx context.Context, fea
 
 // GetBonusModifiers gets all active modifiers for a specific feature key across all sources (jobs, progression)
 func (r *progressionRepository) GetBonusModifiers(ctx context.Context, featureKey string) ([]domain.ModifierConfig, error) {
-	rows, err := r.q.GetBonusModifiersWithLevel(ctx, featureKey)
+	rows, err := r.q.GetBonusModifiersWithLevel(ctx, generated.GetBonusModifiersWithLevelParams{
+		FeatureKey:  featureKey,
+		CommunityID: community.FromContext(ctx),
+	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to get bonus modifiers: %w", err)
 	}
@@ -822,6 +873,8 @@ func (r *progressionRepository) GetAllBonusModifiers(ctx context.Context) ([]dom
 		perLevelVal, _ := row.PerLevelValue.Float64Value()
 
 		configs = append(configs, domain.ModifierConfig{
+			NodeKey:       row.NodeKey,
+			SourceType:    row.SourceType,
 			FeatureKey:    row.FeatureKey,
 			ModifierType:  row.ModifierType,
 			BaseValue:     baseVal.Float64,
@@ -902,6 +955,45 @@ func (r *progressionRepository) GetDailyEngagementTotals(ctx context.Context, si
 	return totals, nil
 }
 
+// RecordCappedEngagement takes an advisory lock on the metric type so that
+// instances sharing the database cannot both spend the last of a cap
+func (r *progressionRepository) RecordCappedEngagement(ctx context.Context, metric *domain.EngagementMetric, limit int, since time.Time) (int, error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer SafeRollback(ctx, tx)
+
+	q := r.q.WithTx(tx)
+	if err := q.LockMetricType(ctx, metric.MetricType); err != nil {
+		return 0, fmt.Errorf("failed to lock metric type: %w", err)
+	}
+
+	used, err := q.GetMetricTotalSince(ctx, generated.GetMetricTotalSinceParams{
+		MetricType: metric.MetricType,
+		RecordedAt: pgtype.Timestamp{Time: since, Valid: true},
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to query metric total: %w", err)
+	}
+
+	accepted := min(metric.MetricValue, limit-int(used))
+	if accepted <= 0 {
+		return 0, nil
+	}
+
+	capped := *metric
+	capped.MetricValue = accepted
+	if err := recordEngagement(ctx, q, &capped); err != nil {
+		return 0, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return 0, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return accepted, nil
+}
+
 func (r *progressionRepository) GetSyncMetadata(ctx context.Context, configName string) (*domain.SyncMetadata, error) {
 	row, err := r.q.GetSyncMetadata(ctx, configName)
 	if errors.Is(err, pgx.ErrNoRows) {
@@ -937,7 +1029,10 @@ func (r *progressionRepository) UpsertSyncMetadata(ctx context.Context, metadata
 // Dynamic prerequisite operations
 
 func (r *progressionRepository) CountUnlockedNodesBelowTier(ctx context.Context, tier int) (int, error) {
-	count, err := r.q.CountUnlockedNodesBelowTier(ctx, int32(tier))
+	count, err := r.q.CountUnlockedNodesBelowTier(ctx, generated.CountUnlockedNodesBelowTierParams{
+		Tier:        int32(tier),
+		CommunityID: community.FromContext(ctx),
+	})
 	if err != nil {
 		return 0, fmt.Errorf("failed to count unlocked nodes below tier: %w", err)
 	}
@@ -945,7 +1040,7 @@ func (r *progressionRepository) CountUnlockedNodesBelowTier(ctx context.Context,
 }
 
 func (r *progressionRepository) CountTotalUnlockedNodes(ctx context.Context) (int, error) {
-	count, err := r.q.CountTotalUnlockedNodes(ctx)
+	count, err := r.q.CountTotalUnlockedNodes(ctx, community.FromContext(ctx))
 	if err != nil {
 		return 0, fmt.Errorf("failed to count total unlocked nodes: %w", err)
 	}
@@ -969,3 +1064,33 @@ func (r *progressionRepository) UpdateNodeDynamicPrerequisites(ctx context.Conte
 	}
 	return nil
 }
+
+func (r *progressionRepository) GetNodeEffects(ctx context.Context, nodeID int) ([]byte, error) {
+	data, err := r.q.GetNodeEffects(ctx, int32(nodeID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get node effects: %w", err)
+	}
+	return data, nil
+}
+
+func (r *progressionRepository) GetAllNodeEffects(ctx context.Context) (map[int][]byte, error) {
+	rows, err := r.q.GetAllNodeEffects(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get node effects: %w", err)
+	}
+	effects := make(map[int][]byte, len(rows))
+	for _, row := range rows {
+		effects[int(row.ID)] = row.Effects
+	}
+	return effects, nil
+}
+
+func (r *progressionRepository) UpdateNodeEffects(ctx context.Context, nodeID int, jsonData []byte) error {
+	if err := r.q.UpdateNodeEffects(ctx, generated.UpdateNodeEffectsParams{
+		ID:      int32(nodeID),
+		Effects: jsonData,
+	}); err != nil {
+		return fmt.Errorf("failed to update node effects: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,188 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/osse101/BrandishBot_Go/internal/database/generated"
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/progressionbulk"
+)
+
+type progressionBulkRepository struct {
+	pool *pgxpool.Pool
+	q    *generated.Queries
+}
+
+// NewProgressionBulkRepository creates a new PostgreSQL bulk progression repository
+func NewProgressionBulkRepository(pool *pgxpool.Pool) progressionbulk.Repository {
+	return &progressionBulkRepository{pool: pool, q: generated.New(pool)}
+}
+
+// GetStatsEventParticipants returns the users who recorded a stats event of
+// eventType in [since, until)
+func (r *progressionBulkRepository) GetStatsEventParticipants(ctx context.Context, eventType string, since, until time.Time) ([]string, error) {
+	userIDs, err := r.q.GetStatsEventParticipants(ctx, generated.GetStatsEventParticipantsParams{
+		EventType: eventType,
+		Since:     pgtype.Timestamp{Time: since.UTC(), Valid: true},
+		Until:     pgtype.Timestamp{Time: until.UTC(), Valid: true},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get stats event participants: %w", err)
+	}
+	return userIDs, nil
+}
+
+// CreateOperation records a new queued operation
+func (r *progressionBulkRepository) CreateOperation(ctx context.Context, op domain.BulkProgressionOperation) (*domain.BulkProgressionOperation, error) {
+	row, err := r.q.CreateProgressionBulkOperation(ctx, generated.CreateProgressionBulkOperationParams{
+		Action:          string(op.Action),
+		ProgressionType: op.ProgressionType,
+		ProgressionKey:  op.ProgressionKey,
+		Actor:           op.Actor,
+		Reason:          op.Reason,
+		TotalUsers:      int32(op.TotalUsers),
+		TotalBatches:    int32(op.TotalBatches),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create bulk progression operation: %w", err)
+	}
+	return mapBulkProgressionOperation(row), nil
+}
+
+// GetOperation returns an operation, or nil if there is none with that ID
+func (r *progressionBulkRepository) GetOperation(ctx context.Context, id int64) (*domain.BulkProgressionOperation, error) {
+	row, err := r.q.GetProgressionBulkOperation(ctx, id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get bulk progression operation: %w", err)
+	}
+	return mapBulkProgressionOperation(row), nil
+}
+
+// SetStatus moves an operation to status, recording errMsg for failures
+func (r *progressionBulkRepository) SetStatus(ctx context.Context, id int64, status domain.BulkProgressionStatus, errMsg string) error {
+	if err := r.q.SetProgressionBulkStatus(ctx, generated.SetProgressionBulkStatusParams{
+		ID:     id,
+		Status: string(status),
+		Error:  errMsg,
+	}); err != nil {
+		return fmt.Errorf("failed to set bulk progression status: %w", err)
+	}
+	return nil
+}
+
+// ApplyBatch grants or revokes the progression for the batch's users, writing
+// the audit entry and advancing the operation's progress in the same transaction
+func (r *progressionBulkRepository) ApplyBatch(ctx context.Context, batch progressionbulk.Batch) (int, error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer SafeRollback(ctx, tx)
+
+	q := r.q.WithTx(tx)
+	var affected int64
+	switch batch.Action {
+	case domain.BulkProgressionGrant:
+		var metadataJSON []byte
+		if batch.Metadata != nil {
+			if metadataJSON, err = json.Marshal(batch.Metadata); err != nil {
+				return 0, fmt.Errorf("failed to marshal metadata: %w", err)
+			}
+		}
+		affected, err = q.BulkGrantUserProgression(ctx, generated.BulkGrantUserProgressionParams{
+			ProgressionType: batch.ProgressionType,
+			ProgressionKey:  batch.ProgressionKey,
+			Metadata:        metadataJSON,
+			UserIds:         batch.UserIDs,
+		})
+	case domain.BulkProgressionRevoke:
+		affected, err = q.BulkRevokeUserProgression(ctx, generated.BulkRevokeUserProgressionParams{
+			UserIds:         batch.UserIDs,
+			ProgressionType: batch.ProgressionType,
+			ProgressionKey:  batch.ProgressionKey,
+		})
+	default:
+		return 0, fmt.Errorf("%w: unknown bulk progression action %q", domain.ErrInvalidInput, batch.Action)
+	}
+	if err != nil {
+		return 0, fmt.Errorf("failed to %s user progression: %w", batch.Action, err)
+	}
+
+	if err := q.InsertProgressionBulkAudit(ctx, generated.InsertProgressionBulkAuditParams{
+		OperationID:   batch.OperationID,
+		BatchNumber:   int32(batch.Number),
+		UserIds:       batch.UserIDs,
+		AffectedUsers: int32(affected),
+	}); err != nil {
+		return 0, fmt.Errorf("failed to record bulk progression batch: %w", err)
+	}
+
+	if err := q.RecordProgressionBulkBatch(ctx, generated.RecordProgressionBulkBatchParams{
+		Processed: int32(len(batch.UserIDs)),
+		Affected:  int32(affected),
+		ID:        batch.OperationID,
+	}); err != nil {
+		return 0, fmt.Errorf("failed to update bulk progression progress: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return 0, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return int(affected), nil
+}
+
+// GetAuditTrail returns an operation's committed batches in order
+func (r *progressionBulkRepository) GetAuditTrail(ctx context.Context, operationID int64) ([]domain.BulkProgressionAuditEntry, error) {
+	rows, err := r.q.GetProgressionBulkAudit(ctx, operationID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get bulk progression audit: %w", err)
+	}
+	entries := make([]domain.BulkProgressionAuditEntry, 0, len(rows))
+	for _, row := range rows {
+		entries = append(entries, domain.BulkProgressionAuditEntry{
+			ID:            row.ID,
+			OperationID:   row.OperationID,
+			BatchNumber:   int(row.BatchNumber),
+			UserIDs:       row.UserIds,
+			AffectedUsers: int(row.AffectedUsers),
+			CreatedAt:     row.CreatedAt.Time,
+		})
+	}
+	return entries, nil
+}
+
+func mapBulkProgressionOperation(row generated.ProgressionBulkOperation) *domain.BulkProgressionOperation {
+	op := &domain.BulkProgressionOperation{
+		ID:               row.ID,
+		Action:           domain.BulkProgressionAction(row.Action),
+		ProgressionType:  row.ProgressionType,
+		ProgressionKey:   row.ProgressionKey,
+		Actor:            row.Actor,
+		Reason:           row.Reason,
+		Status:           domain.BulkProgressionStatus(row.Status),
+		TotalUsers:       int(row.TotalUsers),
+		ProcessedUsers:   int(row.ProcessedUsers),
+		AffectedUsers:    int(row.AffectedUsers),
+		TotalBatches:     int(row.TotalBatches),
+		CompletedBatches: int(row.CompletedBatches),
+		Error:            row.Error,
+		CreatedAt:        row.CreatedAt.Time,
+		UpdatedAt:        row.UpdatedAt.Time,
+	}
+	if row.CompletedAt.Valid {
+		completedAt := row.CompletedAt.Time
+		op.CompletedAt = &completedAt
+	}
+	return op
+}
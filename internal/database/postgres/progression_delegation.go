@@ -0,0 +1,108 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/osse101/BrandishBot_Go/internal/database/generated"
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+)
+
+// SetVoteDelegation delegates a user's votes, replacing any earlier delegation
+func (r *progressionRepository) SetVoteDelegation(ctx context.Context, delegatorID, delegateID string) error {
+	err := r.q.UpsertVoteDelegation(ctx, generated.UpsertVoteDelegationParams{
+		DelegatorID: delegatorID,
+		DelegateID:  delegateID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to set vote delegation: %w", err)
+	}
+	return nil
+}
+
+// DeleteVoteDelegation removes a user's delegation, reporting whether one existed
+func (r *progressionRepository) DeleteVoteDelegation(ctx context.Context, delegatorID string) (bool, error) {
+	rows, err := r.q.DeleteVoteDelegation(ctx, delegatorID)
+	if err != nil {
+		return false, fmt.Errorf("failed to delete vote delegation: %w", err)
+	}
+	return rows > 0, nil
+}
+
+// GetVoteDelegation returns a user's delegation, or nil if they have none
+func (r *progressionRepository) GetVoteDelegation(ctx context.Context, delegatorID string) (*domain.VoteDelegation, error) {
+	row, err := r.q.GetVoteDelegation(ctx, delegatorID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get vote delegation: %w", err)
+	}
+	return &domain.VoteDelegation{
+		DelegatorID: row.DelegatorID,
+		DelegateID:  row.DelegateID,
+		CreatedAt:   row.CreatedAt.Time,
+	}, nil
+}
+
+// GetVoteDelegators returns the users who delegated their votes to delegateID
+func (r *progressionRepository) GetVoteDelegators(ctx context.Context, delegateID string) ([]string, error) {
+	delegators, err := r.q.GetVoteDelegators(ctx, delegateID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get vote delegators: %w", err)
+	}
+	return delegators, nil
+}
+
+// RecordDelegatedVotes casts a vote for each delegator in weights who has not
+// voted in the session yet, adding their weights to the option in the same
+// transaction. It returns the number of votes cast.
+func (r *progressionRepository) RecordDelegatedVotes(ctx context.Context, delegateID string, sessionID, optionID, nodeID, targetLevel int, weights map[string]int) (int, error) {
+	txHelper, err := beginTx(ctx, r.pool, r.q)
+	if err != nil {
+		return 0, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer SafeRollback(ctx, txHelper.Tx())
+	q := txHelper.Queries()
+
+	cast, total := 0, 0
+	for delegatorID, weight := range weights {
+		rows, err := q.RecordDelegatedVote(ctx, generated.RecordDelegatedVoteParams{
+			UserID:      delegatorID,
+			SessionID:   int32(sessionID),
+			OptionID:    pgtype.Int4{Int32: int32(optionID), Valid: true},
+			NodeID:      int32(nodeID),
+			TargetLevel: int32(targetLevel),
+			Weight:      int32(weight),
+			CastBy:      pgtype.Text{String: delegateID, Valid: true},
+		})
+		if err != nil {
+			return 0, fmt.Errorf("failed to record delegated vote: %w", err)
+		}
+		if rows > 0 {
+			cast++
+			total += weight
+		}
+	}
+
+	if total > 0 {
+		if err := q.AdjustOptionVoteCount(ctx, generated.AdjustOptionVoteCountParams{
+			Delta: int32(total),
+			ID:    int32(optionID),
+		}); err != nil {
+			return 0, fmt.Errorf("failed to increment vote: %w", err)
+		}
+		if err := q.UpdateOptionLastHighest(ctx, int32(optionID)); err != nil {
+			return 0, fmt.Errorf("failed to update option last highest: %w", err)
+		}
+	}
+
+	if err := txHelper.Commit(ctx); err != nil {
+		return 0, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return cast, nil
+}
@@ -239,10 +239,10 @@ func TestProgressionRepository_Integration(t *testing.T) {
 	})
 
 	t.Run("ContributionLeaderboard", func(t *testing.T) {
-		// Clear any existing metrics for clean test
-		_, err := testPool.Exec(ctx, "DELETE FROM engagement_metrics")
+		// Clear any existing scores for clean test
+		_, err := testPool.Exec(ctx, "DELETE FROM user_contribution_scores")
 		if err != nil {
-			t.Logf("Warning: Could not clear engagement metrics: %v", err)
+			t.Logf("Warning: Could not clear contribution scores: %v", err)
 		}
 
 		// Test empty leaderboard
@@ -254,7 +254,7 @@ func TestProgressionRepository_Integration(t *testing.T) {
 			t.Errorf("Expected empty leaderboard, got %d entries", len(leaderboard))
 		}
 
-		// Insert test engagement metrics for multiple users
+		// Add test contribution scores for multiple users
 		testData := []struct {
 			userID      string
 			metricType  string
@@ -270,14 +270,8 @@ func TestProgressionRepository_Integration(t *testing.T) {
 		}
 
 		for _, td := range testData {
-			metric := &domain.EngagementMetric{
-				UserID:      td.userID,
-				MetricType:  td.metricType,
-				MetricValue: td.metricValue,
-				RecordedAt:  time.Now(),
-			}
-			if err := repo.RecordEngagement(ctx, metric); err != nil {
-				t.Fatalf("RecordEngagement failed: %v", err)
+			if err := repo.AddUserContributionScore(ctx, td.userID, float64(td.metricValue)); err != nil {
+				t.Fatalf("AddUserContributionScore failed: %v", err)
 			}
 		}
 
@@ -351,6 +345,27 @@ func TestProgressionRepository_Integration(t *testing.T) {
 				t.Errorf("Expected exactly 2 entries with limit=2, got %d", len(leaderboard))
 			}
 		})
+
+		t.Run("Decay", func(t *testing.T) {
+			result, err := repo.DecayContributionScores(ctx, 0.5, 10)
+			if err != nil {
+				t.Fatalf("DecayContributionScores failed: %v", err)
+			}
+			if result.Decayed != 5 || result.Removed != 1 {
+				t.Errorf("Expected 5 decayed and 1 removed, got %+v", result)
+			}
+
+			leaderboard, err := repo.GetContributionLeaderboard(ctx, 10)
+			if err != nil {
+				t.Fatalf("GetContributionLeaderboard failed: %v", err)
+			}
+			if len(leaderboard) != 4 {
+				t.Errorf("Expected 4 entries after decay, got %d", len(leaderboard))
+			}
+			if len(leaderboard) > 0 && leaderboard[0].Contribution != 150 {
+				t.Errorf("Expected top contribution 150 after decay, got %d", leaderboard[0].Contribution)
+			}
+		})
 	})
 
 	t.Run("DailyEngagementTotals", func(t *testing.T) {
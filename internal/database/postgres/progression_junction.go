@@ -10,16 +10,36 @@ import (
 
 // GetPrerequisites returns all prerequisite nodes for a given node
 func (r *progressionRepository) GetPrerequisites(ctx context.Context, nodeID int) ([]*domain.ProgressionNode, error) {
-	rows, err := r.q.GetNodePrerequisites(ctx, int32(nodeID))
+	rows, err := r.rq.pick(ctx).GetNodePrerequisites(ctx, int32(nodeID))
 	if err != nil {
 		return nil, fmt.Errorf("failed to query prerequisites: %w", err)
 	}
 	return r.mapNodes(rows), nil
 }
 
+// GetPrerequisiteGroups returns a node's prerequisites grouped by alternative:
+// the node needs at least one unlocked node from every group
+func (r *progressionRepository) GetPrerequisiteGroups(ctx context.Context, nodeID int) ([][]*domain.ProgressionNode, error) {
+	rows, err := r.rq.pick(ctx).GetNodePrerequisites(ctx, int32(nodeID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to query prerequisites: %w", err)
+	}
+
+	var groups [][]*domain.ProgressionNode
+	nodes := r.mapNodes(rows)
+	for i, row := range rows {
+		// Rows are ordered by group
+		if i == 0 || row.PrerequisiteGroup != rows[i-1].PrerequisiteGroup {
+			groups = append(groups, nil)
+		}
+		groups[len(groups)-1] = append(groups[len(groups)-1], nodes[i])
+	}
+	return groups, nil
+}
+
 // GetDependents returns all nodes that have this node as a prerequisite
 func (r *progressionRepository) GetDependents(ctx context.Context, nodeID int) ([]*domain.ProgressionNode, error) {
-	rows, err := r.q.GetNodeDependents(ctx, int32(nodeID))
+	rows, err := r.rq.pick(ctx).GetNodeDependents(ctx, int32(nodeID))
 	if err != nil {
 		return nil, fmt.Errorf("failed to query dependents: %w", err)
 	}
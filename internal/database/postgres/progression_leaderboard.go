@@ -2,14 +2,22 @@ package postgres
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
+	"github.com/jackc/pgx/v5"
+
+	"github.com/osse101/BrandishBot_Go/internal/community"
+	"github.com/osse101/BrandishBot_Go/internal/database/generated"
 	"github.com/osse101/BrandishBot_Go/internal/domain"
 )
 
 // GetContributionLeaderboard returns top contributors
 func (r *progressionRepository) GetContributionLeaderboard(ctx context.Context, limit int) ([]domain.ContributionLeaderboardEntry, error) {
-	rows, err := r.q.GetContributionLeaderboard(ctx, int32(limit))
+	rows, err := r.rq.pick(ctx).GetContributionLeaderboard(ctx, generated.GetContributionLeaderboardParams{
+		Limit:       int32(limit),
+		CommunityID: community.FromContext(ctx),
+	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to get contribution leaderboard: %w", err)
 	}
@@ -21,8 +29,69 @@ func (r *progressionRepository) GetContributionLeaderboard(ctx context.Context,
 			Contribution: int(row.TotalContribution),
 			Rank:         int(row.Rank),
 		}
+		if row.IsPrivate {
+			entry.UserID, entry.Username = leaderboardIdentity(row.UserID, "", true)
+		}
 		leaderboard = append(leaderboard, entry)
 	}
 
 	return leaderboard, nil
 }
+
+// AddUserContributionScore adds points to a user's running contribution score
+func (r *progressionRepository) AddUserContributionScore(ctx context.Context, userID string, points float64) error {
+	err := r.q.AddUserContributionScore(ctx, generated.AddUserContributionScoreParams{
+		UserID: userID,
+		Score:  points,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to add user contribution score: %w", err)
+	}
+	return nil
+}
+
+// GetUserContributionScore returns a user's running contribution score, 0 if they have none
+func (r *progressionRepository) GetUserContributionScore(ctx context.Context, userID string) (float64, error) {
+	score, err := r.rq.pick(ctx).GetUserContributionScore(ctx, userID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return 0, nil
+		}
+		return 0, fmt.Errorf("failed to get user contribution score: %w", err)
+	}
+	return score, nil
+}
+
+// GetAverageContributionScore returns the mean of all running contribution scores
+func (r *progressionRepository) GetAverageContributionScore(ctx context.Context) (float64, error) {
+	avg, err := r.rq.pick(ctx).GetAverageContributionScore(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get average contribution score: %w", err)
+	}
+	return avg, nil
+}
+
+// DecayContributionScores keeps the retained share of every contribution
+// score and drops the scores that fall below floor, in one transaction
+func (r *progressionRepository) DecayContributionScores(ctx context.Context, retained, floor float64) (*domain.ContributionDecayResult, error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer SafeRollback(ctx, tx)
+
+	q := r.q.WithTx(tx)
+	decayed, err := q.DecayContributionScores(ctx, retained)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decay contribution scores: %w", err)
+	}
+	removed, err := q.DeleteContributionScoresBelow(ctx, floor)
+	if err != nil {
+		return nil, fmt.Errorf("failed to remove negligible contribution scores: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit contribution decay: %w", err)
+	}
+	return &domain.ContributionDecayResult{Decayed: int(decayed), Removed: int(removed)}, nil
+}
@@ -7,9 +7,9 @@ import (
 	"github.com/osse101/BrandishBot_Go/internal/database/generated"
 )
 
-// SyncPrerequisites synchronizes a node's prerequisites in the junction table
+// SyncPrerequisites synchronizes a node's prerequisite groups in the junction table
 // Implements progression.PrerequisiteSyncer interface
-func (r *progressionRepository) SyncPrerequisites(ctx context.Context, nodeID int, prerequisiteIDs []int) error {
+func (r *progressionRepository) SyncPrerequisites(ctx context.Context, nodeID int, groups [][]int) error {
 	tx, err := r.pool.Begin(ctx)
 	if err != nil {
 		return fmt.Errorf("failed to begin transaction: %w", err)
@@ -24,14 +24,17 @@ func (r *progressionRepository) SyncPrerequisites(ctx context.Context, nodeID in
 		return fmt.Errorf("failed to clear prerequisites: %w", err)
 	}
 
-	// Insert new prerequisites
-	for _, prereqID := range prerequisiteIDs {
-		err = q.InsertNodePrerequisite(ctx, generated.InsertNodePrerequisiteParams{
-			NodeID:             int32(nodeID),
-			PrerequisiteNodeID: int32(prereqID),
-		})
-		if err != nil {
-			return fmt.Errorf("failed to insert prerequisite: %w", err)
+	// Insert new prerequisites, keeping alternatives in the same group
+	for group, prereqIDs := range groups {
+		for _, prereqID := range prereqIDs {
+			err = q.InsertNodePrerequisite(ctx, generated.InsertNodePrerequisiteParams{
+				NodeID:             int32(nodeID),
+				PrerequisiteNodeID: int32(prereqID),
+				PrerequisiteGroup:  int32(group),
+			})
+			if err != nil {
+				return fmt.Errorf("failed to insert prerequisite: %w", err)
+			}
 		}
 	}
 
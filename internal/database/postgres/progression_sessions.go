@@ -8,6 +8,7 @@ import (
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgtype"
 
+	"github.com/osse101/BrandishBot_Go/internal/community"
 	"github.com/osse101/BrandishBot_Go/internal/database/generated"
 	"github.com/osse101/BrandishBot_Go/internal/domain"
 )
@@ -15,7 +16,7 @@ import (
 // Voting Session operations (multi-option voting)
 
 func (r *progressionRepository) CreateVotingSession(ctx context.Context) (int, error) {
-	sessionID, err := r.q.CreateVotingSession(ctx)
+	sessionID, err := r.q.CreateVotingSession(ctx, community.FromContext(ctx))
 	if err != nil {
 		return 0, fmt.Errorf("failed to create voting session: %w", err)
 	}
@@ -30,7 +31,7 @@ func (r *progressionRepository) CreateVotingSessionWithOptions(ctx context.Conte
 	}
 	defer SafeRollback(ctx, txHelper.Tx())
 
-	sessionID, err := txHelper.Queries().CreateVotingSession(ctx)
+	sessionID, err := txHelper.Queries().CreateVotingSession(ctx, community.FromContext(ctx))
 	if err != nil {
 		return 0, fmt.Errorf("failed to create voting session: %w", err)
 	}
@@ -68,7 +69,7 @@ func (r *progressionRepository) AddVotingOption(ctx context.Context, sessionID,
 }
 
 func (r *progressionRepository) GetActiveSession(ctx context.Context) (*domain.ProgressionVotingSession, error) {
-	row, err := r.q.GetActiveSession(ctx)
+	row, err := r.q.GetActiveSession(ctx, community.FromContext(ctx))
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return nil, nil
@@ -81,7 +82,7 @@ func (r *progressionRepository) GetActiveSession(ctx context.Context) (*domain.P
 
 // GetMostRecentSession returns the most recent voting session regardless of status
 func (r *progressionRepository) GetMostRecentSession(ctx context.Context) (*domain.ProgressionVotingSession, error) {
-	row, err := r.q.GetMostRecentSession(ctx)
+	row, err := r.q.GetMostRecentSession(ctx, community.FromContext(ctx))
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return nil, nil
@@ -137,6 +138,7 @@ func (r *progressionRepository) getSessionOptions(ctx context.Context, sessionID
 			NodeID:            int(row.NodeID),
 			TargetLevel:       int(row.TargetLevel),
 			VoteCount:         int(row.VoteCount),
+			VoterCount:        int(row.VoterCount),
 			LastHighestVoteAt: ptrTime(row.LastHighestVoteAt),
 		}
 
@@ -198,7 +200,7 @@ func (r *progressionRepository) ResumeVotingSession(ctx context.Context, session
 }
 
 func (r *progressionRepository) GetActiveOrFrozenSession(ctx context.Context) (*domain.ProgressionVotingSession, error) {
-	row, err := r.q.GetActiveOrFrozenSession(ctx)
+	row, err := r.q.GetActiveOrFrozenSession(ctx, community.FromContext(ctx))
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return nil, nil
@@ -209,6 +211,46 @@ func (r *progressionRepository) GetActiveOrFrozenSession(ctx context.Context) (*
 	return r.mapSessionHelper(ctx, row.ID, row.StartedAt, row.Status, row.EndedAt, row.VotingDeadline, row.WinningOptionID)
 }
 
+// GetCompletedSessions returns a page of completed voting sessions, newest first,
+// along with the total number of completed sessions
+func (r *progressionRepository) GetCompletedSessions(ctx context.Context, limit, offset int) ([]domain.VotingSessionRecap, int, error) {
+	total, err := r.q.CountCompletedSessions(ctx, community.FromContext(ctx))
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to count completed sessions: %w", err)
+	}
+
+	rows, err := r.q.GetCompletedSessions(ctx, generated.GetCompletedSessionsParams{
+		Limit:       int32(limit),
+		Offset:      int32(offset),
+		CommunityID: community.FromContext(ctx),
+	})
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to get completed sessions: %w", err)
+	}
+
+	recaps := make([]domain.VotingSessionRecap, 0, len(rows))
+	for _, row := range rows {
+		session, err := r.mapSessionHelper(ctx, row.ID, row.StartedAt, row.Status, row.EndedAt, row.VotingDeadline, row.WinningOptionID)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		recap := domain.VotingSessionRecap{
+			ProgressionVotingSession: *session,
+			UnlockedLevel:            ptrInt(row.UnlockedLevel),
+			UnlockedAt:               ptrTime(row.UnlockedAt),
+		}
+		if row.UnlockedNodeID.Valid {
+			node, _ := r.GetNodeByID(ctx, int(row.UnlockedNodeID.Int32))
+			recap.UnlockedNode = node
+		}
+
+		recaps = append(recaps, recap)
+	}
+
+	return recaps, int(total), nil
+}
+
 func (r *progressionRepository) GetSessionVoters(ctx context.Context, sessionID int) ([]string, error) {
 	rows, err := r.q.GetSessionVoters(ctx, int32(sessionID))
 	if err != nil {
@@ -235,6 +277,7 @@ func (r *progressionRepository) RecordUserSessionVote(ctx context.Context, userI
 		OptionID:    pgtype.Int4{Int32: int32(optionID), Valid: true},
 		NodeID:      int32(nodeID),
 		TargetLevel: int32(targetLevel),
+		Weight:      1,
 	})
 
 	if err != nil {
@@ -245,47 +288,74 @@ func (r *progressionRepository) RecordUserSessionVote(ctx context.Context, userI
 }
 
 // CheckAndRecordVoteAtomic atomically checks if a user has voted and records their vote if they haven't.
-// This method uses SELECT FOR UPDATE to prevent race conditions when multiple concurrent vote requests arrive.
-// Returns domain.ErrUserAlreadyVoted if the user has already voted in this session.
-func (r *progressionRepository) CheckAndRecordVoteAtomic(ctx context.Context, userID string, sessionID, optionID, nodeID, targetLevel int) error {
+// A vote a delegate cast on the user's behalf is replaced by the user's own vote.
+// The vote adds weight to the option's vote count.
+func (r *progressionRepository) CheckAndRecordVoteAtomic(ctx context.Context, userID string, sessionID, optionID, nodeID, targetLevel, weight int) error {
 	// Begin transaction
 	txHelper, err := beginTx(ctx, r.pool, r.q)
 	if err != nil {
 		return fmt.Errorf("failed to begin transaction: %w", err)
 	}
 	defer SafeRollback(ctx, txHelper.Tx())
+	q := txHelper.Queries()
 
-	// Check if user has already voted with row lock
-	hasVoted, err := txHelper.Queries().HasUserVotedInSessionForUpdate(ctx, generated.HasUserVotedInSessionForUpdateParams{
+	// Check for an existing vote with row lock
+	existing, err := q.GetUserSessionVoteForUpdate(ctx, generated.GetUserSessionVoteForUpdateParams{
 		UserID:    userID,
 		SessionID: int32(sessionID),
 	})
-	if err != nil {
+	hasVoted := err == nil
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
 		return fmt.Errorf("failed to check vote status: %w", err)
 	}
 
-	if hasVoted {
+	// Only a counted vote cast by a delegate can be replaced
+	if hasVoted && (!existing.CastBy.Valid || existing.ExcludedAt.Valid) {
 		return domain.ErrUserAlreadyVoted
 	}
 
-	// Increment vote count for the option
-	err = txHelper.Queries().IncrementOptionVote(ctx, int32(optionID))
-	if err != nil {
-		return fmt.Errorf("failed to increment vote: %w", err)
+	if hasVoted {
+		if existing.OptionID.Valid {
+			if err := q.AdjustOptionVoteCount(ctx, generated.AdjustOptionVoteCountParams{
+				Delta: -existing.Weight,
+				ID:    existing.OptionID.Int32,
+			}); err != nil {
+				return fmt.Errorf("failed to remove delegated vote: %w", err)
+			}
+		}
+		err = q.ReplaceDelegatedVote(ctx, generated.ReplaceDelegatedVoteParams{
+			UserID:      userID,
+			SessionID:   int32(sessionID),
+			OptionID:    pgtype.Int4{Int32: int32(optionID), Valid: true},
+			NodeID:      int32(nodeID),
+			TargetLevel: int32(targetLevel),
+			Weight:      int32(weight),
+		})
+	} else {
+		err = q.RecordUserSessionVote(ctx, generated.RecordUserSessionVoteParams{
+			UserID:      userID,
+			SessionID:   int32(sessionID),
+			OptionID:    pgtype.Int4{Int32: int32(optionID), Valid: true},
+			NodeID:      int32(nodeID),
+			TargetLevel: int32(targetLevel),
+			Weight:      int32(weight),
+		})
 	}
-
-	// Record the user's vote
-	err = txHelper.Queries().RecordUserSessionVote(ctx, generated.RecordUserSessionVoteParams{
-		UserID:      userID,
-		SessionID:   int32(sessionID),
-		OptionID:    pgtype.Int4{Int32: int32(optionID), Valid: true},
-		NodeID:      int32(nodeID),
-		TargetLevel: int32(targetLevel),
-	})
 	if err != nil {
 		return fmt.Errorf("failed to record user vote: %w", err)
 	}
 
+	// Add the vote's weight to the option
+	if err := q.AdjustOptionVoteCount(ctx, generated.AdjustOptionVoteCountParams{
+		Delta: int32(weight),
+		ID:    int32(optionID),
+	}); err != nil {
+		return fmt.Errorf("failed to increment vote: %w", err)
+	}
+	if err := q.UpdateOptionLastHighest(ctx, int32(optionID)); err != nil {
+		return fmt.Errorf("failed to update option last highest: %w", err)
+	}
+
 	// Commit transaction
 	if err := txHelper.Commit(ctx); err != nil {
 		return fmt.Errorf("failed to commit transaction: %w", err)
@@ -297,7 +367,7 @@ func (r *progressionRepository) CheckAndRecordVoteAtomic(ctx context.Context, us
 // Unlock Progress tracking
 
 func (r *progressionRepository) CreateUnlockProgress(ctx context.Context) (int, error) {
-	id, err := r.q.CreateUnlockProgress(ctx)
+	id, err := r.q.CreateUnlockProgress(ctx, community.FromContext(ctx))
 	if err != nil {
 		return 0, fmt.Errorf("failed to create unlock progress: %w", err)
 	}
@@ -306,7 +376,7 @@ func (r *progressionRepository) CreateUnlockProgress(ctx context.Context) (int,
 }
 
 func (r *progressionRepository) GetActiveUnlockProgress(ctx context.Context) (*domain.UnlockProgress, error) {
-	row, err := r.q.GetActiveUnlockProgress(ctx)
+	row, err := r.q.GetActiveUnlockProgress(ctx, community.FromContext(ctx))
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return nil, nil
@@ -363,7 +433,10 @@ func (r *progressionRepository) CompleteUnlock(ctx context.Context, progressID i
 	}
 
 	// Create new progress entry with rollover points
-	newID, err := r.q.InsertNextUnlockProgress(ctx, int32(rolloverPoints))
+	newID, err := r.q.InsertNextUnlockProgress(ctx, generated.InsertNextUnlockProgressParams{
+		ContributionsAccumulated: int32(rolloverPoints),
+		CommunityID:              community.FromContext(ctx),
+	})
 	if err != nil {
 		return 0, fmt.Errorf("failed to create next unlock progress: %w", err)
 	}
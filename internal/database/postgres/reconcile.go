@@ -0,0 +1,49 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/osse101/BrandishBot_Go/internal/database/generated"
+	"github.com/osse101/BrandishBot_Go/internal/reconcile"
+)
+
+type reconcileRepository struct {
+	q *generated.Queries
+}
+
+// NewReconcileRepository creates a new PostgreSQL reconciliation repository
+func NewReconcileRepository(pool *pgxpool.Pool) reconcile.Repository {
+	return &reconcileRepository{q: generated.New(pool)}
+}
+
+// GetVoteCountDrift lists open voting options whose vote_count disagrees with user_votes
+func (r *reconcileRepository) GetVoteCountDrift(ctx context.Context) ([]reconcile.VoteCountDrift, error) {
+	rows, err := r.q.GetVoteCountDrift(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query vote count drift: %w", err)
+	}
+	drifts := make([]reconcile.VoteCountDrift, 0, len(rows))
+	for _, row := range rows {
+		drifts = append(drifts, reconcile.VoteCountDrift{
+			OptionID:      int(row.ID),
+			SessionID:     int(row.SessionID),
+			StoredVotes:   int(row.VoteCount),
+			RecordedVotes: int(row.RecordedVotes),
+		})
+	}
+	return drifts, nil
+}
+
+// SetOptionVoteCount overwrites an option's vote_count
+func (r *reconcileRepository) SetOptionVoteCount(ctx context.Context, optionID, count int) error {
+	if err := r.q.SetOptionVoteCount(ctx, generated.SetOptionVoteCountParams{
+		ID:        int32(optionID),
+		VoteCount: int32(count),
+	}); err != nil {
+		return fmt.Errorf("failed to set option vote count: %w", err)
+	}
+	return nil
+}
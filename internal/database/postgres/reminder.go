@@ -0,0 +1,107 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/osse101/BrandishBot_Go/internal/database/generated"
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/reminder"
+)
+
+type reminderRepository struct {
+	q *generated.Queries
+}
+
+// NewReminderRepository creates a new PostgreSQL reminder repository
+func NewReminderRepository(pool *pgxpool.Pool) reminder.Repository {
+	return &reminderRepository{q: generated.New(pool)}
+}
+
+// SaveReminder stores a reminder, replacing the user's pending one with the same kind and target
+func (r *reminderRepository) SaveReminder(ctx context.Context, rem domain.Reminder) (*domain.Reminder, error) {
+	userUUID, err := parseUserUUID(rem.UserID)
+	if err != nil {
+		return nil, err
+	}
+	row, err := r.q.UpsertReminder(ctx, generated.UpsertReminderParams{
+		UserID:     userUUID,
+		Platform:   rem.Platform,
+		PlatformID: rem.PlatformID,
+		Kind:       string(rem.Kind),
+		Target:     rem.Target,
+		Message:    rem.Message,
+		ChannelID:  rem.ChannelID,
+		RemindAt:   pgtype.Timestamptz{Time: rem.RemindAt, Valid: true},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to save reminder: %w", err)
+	}
+	saved := mapReminder(row)
+	return &saved, nil
+}
+
+// GetReminders returns a user's pending reminders, soonest first
+func (r *reminderRepository) GetReminders(ctx context.Context, userID string) ([]domain.Reminder, error) {
+	userUUID, err := parseUserUUID(userID)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := r.q.ListUserReminders(ctx, userUUID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get reminders: %w", err)
+	}
+	return mapReminders(rows), nil
+}
+
+// DeleteReminder removes one of a user's reminders
+func (r *reminderRepository) DeleteReminder(ctx context.Context, userID string, id int64) (bool, error) {
+	userUUID, err := parseUserUUID(userID)
+	if err != nil {
+		return false, err
+	}
+	deleted, err := r.q.DeleteUserReminder(ctx, generated.DeleteUserReminderParams{ID: id, UserID: userUUID})
+	if err != nil {
+		return false, fmt.Errorf("failed to delete reminder: %w", err)
+	}
+	return deleted > 0, nil
+}
+
+// ClaimDueReminders removes and returns reminders due at or before now
+func (r *reminderRepository) ClaimDueReminders(ctx context.Context, now time.Time, limit int) ([]domain.Reminder, error) {
+	rows, err := r.q.ClaimDueReminders(ctx, generated.ClaimDueRemindersParams{
+		Now:       pgtype.Timestamptz{Time: now, Valid: true},
+		BatchSize: int32(limit),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to claim due reminders: %w", err)
+	}
+	return mapReminders(rows), nil
+}
+
+func mapReminders(rows []generated.Reminder) []domain.Reminder {
+	reminders := make([]domain.Reminder, 0, len(rows))
+	for _, row := range rows {
+		reminders = append(reminders, mapReminder(row))
+	}
+	return reminders
+}
+
+func mapReminder(row generated.Reminder) domain.Reminder {
+	return domain.Reminder{
+		ID:         row.ID,
+		UserID:     row.UserID.String(),
+		Platform:   row.Platform,
+		PlatformID: row.PlatformID,
+		Kind:       domain.ReminderKind(row.Kind),
+		Target:     row.Target,
+		Message:    row.Message,
+		ChannelID:  row.ChannelID,
+		RemindAt:   row.RemindAt.Time,
+		CreatedAt:  row.CreatedAt.Time,
+	}
+}
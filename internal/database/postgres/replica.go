@@ -0,0 +1,33 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/osse101/BrandishBot_Go/internal/database"
+	"github.com/osse101/BrandishBot_Go/internal/database/generated"
+)
+
+// readQueries routes read-only queries to the read replica when one is
+// configured. Results may lag the primary by the replication delay.
+type readQueries struct {
+	primary *generated.Queries
+	replica *generated.Queries
+}
+
+func newReadQueries(primary *generated.Queries, replica *pgxpool.Pool) readQueries {
+	r := readQueries{primary: primary, replica: primary}
+	if replica != nil {
+		r.replica = generated.New(replica)
+	}
+	return r
+}
+
+// pick returns the queries to use for a read with the given context
+func (r readQueries) pick(ctx context.Context) *generated.Queries {
+	if database.PrimaryReadsRequired(ctx) {
+		return r.primary
+	}
+	return r.replica
+}
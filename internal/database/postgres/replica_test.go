@@ -0,0 +1,34 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/osse101/BrandishBot_Go/internal/database"
+	"github.com/osse101/BrandishBot_Go/internal/database/generated"
+)
+
+func TestReadQueries_Pick(t *testing.T) {
+	ctx := context.Background()
+	primary := generated.New(nil)
+
+	t.Run("without a replica reads use the primary", func(t *testing.T) {
+		rq := newReadQueries(primary, nil)
+		assert.Same(t, primary, rq.pick(ctx))
+	})
+
+	t.Run("with a replica reads use it unless primary reads are required", func(t *testing.T) {
+		// pgxpool connects lazily, so no server is needed
+		replica, err := pgxpool.New(ctx, "postgres://replica.invalid:5432/app")
+		require.NoError(t, err)
+		defer replica.Close()
+
+		rq := newReadQueries(primary, replica)
+		assert.NotSame(t, primary, rq.pick(ctx))
+		assert.Same(t, primary, rq.pick(database.WithPrimaryReads(ctx)))
+	})
+}
@@ -0,0 +1,64 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/osse101/BrandishBot_Go/internal/database/generated"
+	"github.com/osse101/BrandishBot_Go/internal/scheduler"
+)
+
+type scheduledJobRepository struct {
+	q *generated.Queries
+}
+
+// NewScheduledJobRepository creates a new PostgreSQL scheduler repository
+func NewScheduledJobRepository(pool *pgxpool.Pool) scheduler.Repository {
+	return &scheduledJobRepository{q: generated.New(pool)}
+}
+
+// GetJobState retrieves a job's run times (returns nil, nil if not found)
+func (r *scheduledJobRepository) GetJobState(ctx context.Context, name string) (*scheduler.JobState, error) {
+	row, err := r.q.GetScheduledJob(ctx, name)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get scheduled job: %w", err)
+	}
+	return &scheduler.JobState{
+		Name:      row.Name,
+		NextRunAt: pgtimetzToPtr(row.NextRunAt),
+		LastRunAt: pgtimetzToPtr(row.LastRunAt),
+	}, nil
+}
+
+// SaveJobState inserts or replaces a job's run times
+func (r *scheduledJobRepository) SaveJobState(ctx context.Context, state scheduler.JobState) error {
+	if err := r.q.UpsertScheduledJob(ctx, generated.UpsertScheduledJobParams{
+		Name:      state.Name,
+		NextRunAt: timeToPgtimetz(state.NextRunAt),
+		LastRunAt: timeToPgtimetz(state.LastRunAt),
+	}); err != nil {
+		return fmt.Errorf("failed to save scheduled job: %w", err)
+	}
+	return nil
+}
+
+// TryAcquireLease takes or renews a job lease; false means another instance holds it
+func (r *scheduledJobRepository) TryAcquireLease(ctx context.Context, name, holder string, ttl time.Duration) (bool, error) {
+	rows, err := r.q.AcquireSchedulerLease(ctx, generated.AcquireSchedulerLeaseParams{
+		Name:   name,
+		Holder: holder,
+		TtlMs:  ttl.Milliseconds(),
+	})
+	if err != nil {
+		return false, fmt.Errorf("failed to acquire scheduler lease: %w", err)
+	}
+	return rows == 1, nil
+}
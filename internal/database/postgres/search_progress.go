@@ -0,0 +1,97 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/osse101/BrandishBot_Go/internal/database/generated"
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/search"
+)
+
+type searchProgressRepository struct {
+	q *generated.Queries
+}
+
+// NewSearchProgressRepository creates a new PostgreSQL search progress repository
+func NewSearchProgressRepository(pool *pgxpool.Pool) search.ProgressRepository {
+	return &searchProgressRepository{q: generated.New(pool)}
+}
+
+// RecordSearch counts a search made on day and reports whether it was the user's first that day
+func (r *searchProgressRepository) RecordSearch(ctx context.Context, userID string, day time.Time) (*domain.SearchProgress, bool, error) {
+	userUUID, err := parseUserUUID(userID)
+	if err != nil {
+		return nil, false, err
+	}
+	row, err := r.q.RecordUserSearch(ctx, generated.RecordUserSearchParams{
+		UserID:     userUUID,
+		SearchDate: pgtype.Date{Time: day, Valid: true},
+	})
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to record search: %w", err)
+	}
+
+	firstToday := !row.PreviousSearchDate.Valid || row.PreviousSearchDate.Time.Before(row.LastSearchDate.Time)
+	return &domain.SearchProgress{
+		UserID:         userID,
+		CurrentStreak:  int(row.CurrentStreak),
+		BestStreak:     int(row.BestStreak),
+		LastSearchDate: row.LastSearchDate.Time,
+		TotalSearches:  int(row.TotalSearches),
+		MasteryLevel:   int(row.MasteryLevel),
+	}, firstToday, nil
+}
+
+// GetProgress returns the user's search progress, or nil if they have never searched
+func (r *searchProgressRepository) GetProgress(ctx context.Context, userID string) (*domain.SearchProgress, error) {
+	userUUID, err := parseUserUUID(userID)
+	if err != nil {
+		return nil, err
+	}
+	row, err := r.q.GetUserSearchProgress(ctx, userUUID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get search progress: %w", err)
+	}
+	return &domain.SearchProgress{
+		UserID:         userID,
+		CurrentStreak:  int(row.CurrentStreak),
+		BestStreak:     int(row.BestStreak),
+		LastSearchDate: row.LastSearchDate.Time,
+		TotalSearches:  int(row.TotalSearches),
+		MasteryLevel:   int(row.MasteryLevel),
+	}, nil
+}
+
+// SetMasteryLevel raises the user's stored mastery level
+func (r *searchProgressRepository) SetMasteryLevel(ctx context.Context, userID string, level int) error {
+	userUUID, err := parseUserUUID(userID)
+	if err != nil {
+		return err
+	}
+	if err := r.q.UpdateUserSearchMasteryLevel(ctx, generated.UpdateUserSearchMasteryLevelParams{
+		UserID:       userUUID,
+		MasteryLevel: int32(level),
+	}); err != nil {
+		return fmt.Errorf("failed to set search mastery level: %w", err)
+	}
+	return nil
+}
+
+// CountRecentSearchers returns how many users have searched since the given time
+func (r *searchProgressRepository) CountRecentSearchers(ctx context.Context, since time.Time) (int, error) {
+	count, err := r.q.CountRecentSearchers(ctx, pgtype.Timestamptz{Time: since, Valid: true})
+	if err != nil {
+		return 0, fmt.Errorf("failed to count recent searchers: %w", err)
+	}
+	return int(count), nil
+}
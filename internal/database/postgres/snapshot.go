@@ -0,0 +1,341 @@
+package postgres
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/osse101/BrandishBot_Go/internal/database/generated"
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/snapshot"
+)
+
+// snapshotTable is a table captured in game snapshots. Tables are listed
+// parents first so restored rows always find the users they reference.
+type snapshotTable struct {
+	name string
+	// upsertKey merges snapshot rows over existing ones instead of replacing
+	// the table. Used for users, which most other tables reference.
+	upsertKey []string
+	// matchKeys replaces only the existing rows that clash with a snapshot
+	// row on any of these unique keys
+	matchKeys [][]string
+	// serial is a sequence-backed column moved past the restored rows
+	serial string
+}
+
+var snapshotTables = []snapshotTable{
+	{name: "users", upsertKey: []string{"user_id"}},
+	{name: "user_platform_links", matchKeys: [][]string{{"user_id", "platform_id"}, {"community_id", "platform_id", "platform_user_id"}}},
+	{name: "user_inventory"},
+	{name: "user_jobs"},
+	{name: "recipe_unlocks"},
+	{name: "user_progression"},
+	{name: "user_contribution_scores"},
+	{name: "progression_unlocks", serial: "id"},
+	{name: "progression_unlock_progress", serial: "id"},
+	{name: "stats_aggregates", serial: "aggregate_id"},
+}
+
+type snapshotRepository struct {
+	pool *pgxpool.Pool
+	q    *generated.Queries
+}
+
+// NewSnapshotRepository creates a new PostgreSQL game snapshot repository
+func NewSnapshotRepository(pool *pgxpool.Pool) snapshot.Repository {
+	return &snapshotRepository{pool: pool, q: generated.New(pool)}
+}
+
+// SchemaVersion returns the latest applied goose migration
+func (r *snapshotRepository) SchemaVersion(ctx context.Context) (int64, error) {
+	version, err := r.q.GetSchemaVersion(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get schema version: %w", err)
+	}
+	return version, nil
+}
+
+// ExportState reads every table in one repeatable-read transaction, so writes
+// committed while the export runs are not half included
+func (r *snapshotRepository) ExportState(ctx context.Context) (*domain.SnapshotData, map[string]int, error) {
+	tx, err := r.pool.BeginTx(ctx, pgx.TxOptions{IsoLevel: pgx.RepeatableRead, AccessMode: pgx.ReadOnly})
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to begin snapshot export: %w", err)
+	}
+	defer SafeRollback(ctx, tx)
+
+	version, err := r.q.WithTx(tx).GetSchemaVersion(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get schema version: %w", err)
+	}
+
+	data := &domain.SnapshotData{
+		SchemaVersion: version,
+		TakenAt:       time.Now().UTC(),
+		Tables:        make(map[string]json.RawMessage, len(snapshotTables)),
+	}
+	counts := make(map[string]int, len(snapshotTables))
+	for _, table := range snapshotTables {
+		query := fmt.Sprintf(`SELECT COALESCE(jsonb_agg(to_jsonb(t)), '[]'::jsonb), COUNT(*) FROM %s t`, quoteIdent(table.name))
+		var rows []byte
+		var count int
+		if err := tx.QueryRow(ctx, query).Scan(&rows, &count); err != nil {
+			return nil, nil, fmt.Errorf("failed to export %s: %w", table.name, err)
+		}
+		data.Tables[table.name] = rows
+		counts[table.name] = count
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, nil, fmt.Errorf("failed to finish snapshot export: %w", err)
+	}
+	return data, counts, nil
+}
+
+// ImportState locks the snapshot tables against writes and rewrites them in
+// one transaction, so players see either the old state or the restored one
+func (r *snapshotRepository) ImportState(ctx context.Context, data *domain.SnapshotData) (map[string]int, error) {
+	if err := validateSnapshotTables(data); err != nil {
+		return nil, err
+	}
+
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin snapshot restore: %w", err)
+	}
+	defer SafeRollback(ctx, tx)
+
+	names := make([]string, len(snapshotTables))
+	for i, table := range snapshotTables {
+		names[i] = quoteIdent(table.name)
+	}
+	if _, err := tx.Exec(ctx, fmt.Sprintf("LOCK TABLE %s IN EXCLUSIVE MODE", strings.Join(names, ", "))); err != nil {
+		return nil, fmt.Errorf("failed to lock snapshot tables: %w", err)
+	}
+
+	counts := make(map[string]int, len(snapshotTables))
+	for _, table := range snapshotTables {
+		count, err := restoreTable(ctx, tx, table, data.Tables[table.name])
+		if err != nil {
+			return nil, fmt.Errorf("failed to restore %s: %w", table.name, err)
+		}
+		counts[table.name] = count
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit snapshot restore: %w", err)
+	}
+	return counts, nil
+}
+
+// SaveSnapshot stores a compressed snapshot document
+func (r *snapshotRepository) SaveSnapshot(ctx context.Context, snap domain.GameSnapshot, payload []byte) (*domain.GameSnapshot, error) {
+	counts, err := json.Marshal(snap.RowCounts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode snapshot row counts: %w", err)
+	}
+	row, err := r.q.CreateGameSnapshot(ctx, generated.CreateGameSnapshotParams{
+		Trigger:       string(snap.Trigger),
+		Note:          snap.Note,
+		CreatedBy:     snap.CreatedBy,
+		SchemaVersion: snap.SchemaVersion,
+		RowCounts:     counts,
+		SizeBytes:     snap.SizeBytes,
+		Data:          payload,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to save snapshot: %w", err)
+	}
+	snap.ID = row.ID
+	snap.CreatedAt = row.CreatedAt.Time
+	return &snap, nil
+}
+
+// ListSnapshots returns snapshot metadata, newest first
+func (r *snapshotRepository) ListSnapshots(ctx context.Context) ([]domain.GameSnapshot, error) {
+	rows, err := r.q.ListGameSnapshots(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list snapshots: %w", err)
+	}
+	snaps := make([]domain.GameSnapshot, 0, len(rows))
+	for _, row := range rows {
+		counts := map[string]int{}
+		if len(row.RowCounts) > 0 {
+			if err := json.Unmarshal(row.RowCounts, &counts); err != nil {
+				return nil, fmt.Errorf("failed to decode row counts for snapshot %d: %w", row.ID, err)
+			}
+		}
+		snaps = append(snaps, domain.GameSnapshot{
+			ID:            row.ID,
+			Trigger:       domain.SnapshotTrigger(row.Trigger),
+			Note:          row.Note,
+			CreatedBy:     row.CreatedBy,
+			SchemaVersion: row.SchemaVersion,
+			RowCounts:     counts,
+			SizeBytes:     row.SizeBytes,
+			CreatedAt:     row.CreatedAt.Time,
+		})
+	}
+	return snaps, nil
+}
+
+// GetSnapshotPayload returns the compressed document, or nil if there is none
+func (r *snapshotRepository) GetSnapshotPayload(ctx context.Context, id int64) ([]byte, error) {
+	payload, err := r.q.GetGameSnapshotData(ctx, id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get snapshot: %w", err)
+	}
+	return payload, nil
+}
+
+// DeleteSnapshot removes a snapshot
+func (r *snapshotRepository) DeleteSnapshot(ctx context.Context, id int64) (bool, error) {
+	deleted, err := r.q.DeleteGameSnapshot(ctx, id)
+	if err != nil {
+		return false, fmt.Errorf("failed to delete snapshot: %w", err)
+	}
+	return deleted > 0, nil
+}
+
+// PruneSnapshots keeps the newest snapshots with the trigger
+func (r *snapshotRepository) PruneSnapshots(ctx context.Context, trigger domain.SnapshotTrigger, keep int) (int64, error) {
+	pruned, err := r.q.PruneGameSnapshots(ctx, generated.PruneGameSnapshotsParams{
+		Trigger: string(trigger),
+		Limit:   int32(keep),
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to prune snapshots: %w", err)
+	}
+	return pruned, nil
+}
+
+// validateSnapshotTables requires the document to hold exactly the snapshot
+// tables, each as a JSON array
+func validateSnapshotTables(data *domain.SnapshotData) error {
+	known := make(map[string]bool, len(snapshotTables))
+	for _, table := range snapshotTables {
+		known[table.name] = true
+		rows, ok := data.Tables[table.name]
+		if !ok {
+			return fmt.Errorf("%w: snapshot is missing table %s", domain.ErrInvalidInput, table.name)
+		}
+		if !bytes.HasPrefix(bytes.TrimSpace(rows), []byte("[")) || !json.Valid(rows) {
+			return fmt.Errorf("%w: table %s must be a JSON array of rows", domain.ErrInvalidInput, table.name)
+		}
+	}
+	for name := range data.Tables {
+		if !known[name] {
+			return fmt.Errorf("%w: snapshot has unknown table %s", domain.ErrInvalidInput, name)
+		}
+	}
+	return nil
+}
+
+// restoreTable writes one table's rows. Rows are expanded with
+// jsonb_populate_recordset, so they go back exactly as to_jsonb rendered them.
+func restoreTable(ctx context.Context, tx pgx.Tx, table snapshotTable, rows []byte) (int, error) {
+	columns, err := tableColumns(ctx, tx, table.name)
+	if err != nil {
+		return 0, err
+	}
+	name := quoteIdent(table.name)
+	source := fmt.Sprintf("jsonb_populate_recordset(NULL::%s, $1::jsonb)", name)
+
+	switch {
+	case len(table.upsertKey) > 0:
+		// Existing rows are updated by the insert below
+	case len(table.matchKeys) > 0:
+		clauses := make([]string, len(table.matchKeys))
+		for i, key := range table.matchKeys {
+			parts := make([]string, len(key))
+			for j, col := range key {
+				parts[j] = fmt.Sprintf("t.%[1]s = s.%[1]s", quoteIdent(col))
+			}
+			clauses[i] = "(" + strings.Join(parts, " AND ") + ")"
+		}
+		query := fmt.Sprintf("DELETE FROM %s t USING %s s WHERE %s", name, source, strings.Join(clauses, " OR "))
+		if _, err := tx.Exec(ctx, query, rows); err != nil {
+			return 0, fmt.Errorf("failed to clear clashing rows: %w", err)
+		}
+	default:
+		if _, err := tx.Exec(ctx, fmt.Sprintf("DELETE FROM %s", name)); err != nil {
+			return 0, fmt.Errorf("failed to clear table: %w", err)
+		}
+	}
+
+	cols := strings.Join(columns, ", ")
+	query := fmt.Sprintf("INSERT INTO %s (%s) OVERRIDING SYSTEM VALUE SELECT %s FROM %s", name, cols, cols, source)
+	if len(table.upsertKey) > 0 {
+		query += upsertClause(table.upsertKey, columns)
+	}
+	tag, err := tx.Exec(ctx, query, rows)
+	if err != nil {
+		return 0, fmt.Errorf("failed to insert rows: %w", err)
+	}
+
+	if table.serial != "" {
+		serial := quoteIdent(table.serial)
+		query := fmt.Sprintf("SELECT setval(pg_get_serial_sequence($1, $2), COALESCE(MAX(%s), 0) + 1, false) FROM %s", serial, name)
+		if _, err := tx.Exec(ctx, query, table.name, table.serial); err != nil {
+			return 0, fmt.Errorf("failed to reset sequence: %w", err)
+		}
+	}
+	return int(tag.RowsAffected()), nil
+}
+
+// tableColumns lists the writable columns of a table, quoted
+func tableColumns(ctx context.Context, tx pgx.Tx, table string) ([]string, error) {
+	rows, err := tx.Query(ctx, `
+		SELECT column_name FROM information_schema.columns
+		WHERE table_schema = current_schema() AND table_name = $1 AND is_generated = 'NEVER'
+		ORDER BY ordinal_position`, table)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list columns: %w", err)
+	}
+	names, err := pgx.CollectRows(rows, pgx.RowTo[string])
+	if err != nil {
+		return nil, fmt.Errorf("failed to list columns: %w", err)
+	}
+	if len(names) == 0 {
+		return nil, fmt.Errorf("table %s has no columns", table)
+	}
+	columns := make([]string, len(names))
+	for i, n := range names {
+		columns[i] = quoteIdent(n)
+	}
+	return columns, nil
+}
+
+func upsertClause(key, columns []string) string {
+	keyCols := make([]string, len(key))
+	isKey := make(map[string]bool, len(key))
+	for i, col := range key {
+		keyCols[i] = quoteIdent(col)
+		isKey[keyCols[i]] = true
+	}
+	var sets []string
+	for _, col := range columns {
+		if !isKey[col] {
+			sets = append(sets, fmt.Sprintf("%[1]s = EXCLUDED.%[1]s", col))
+		}
+	}
+	if len(sets) == 0 {
+		return fmt.Sprintf(" ON CONFLICT (%s) DO NOTHING", strings.Join(keyCols, ", "))
+	}
+	return fmt.Sprintf(" ON CONFLICT (%s) DO UPDATE SET %s", strings.Join(keyCols, ", "), strings.Join(sets, ", "))
+}
+
+func quoteIdent(name string) string {
+	return pgx.Identifier{name}.Sanitize()
+}
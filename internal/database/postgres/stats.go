@@ -3,13 +3,16 @@ package postgres
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/jackc/pgx/v5/pgxpool"
 
+	"github.com/osse101/BrandishBot_Go/internal/community"
 	"github.com/osse101/BrandishBot_Go/internal/database/generated"
 	"github.com/osse101/BrandishBot_Go/internal/domain"
 	"github.com/osse101/BrandishBot_Go/internal/repository"
@@ -19,13 +22,22 @@ import (
 type StatsRepository struct {
 	pool *pgxpool.Pool
 	q    *generated.Queries
+	rq   readQueries
 }
 
 // NewStatsRepository creates a new StatsRepository
 func NewStatsRepository(pool *pgxpool.Pool) repository.Stats {
+	return NewStatsRepositoryWithReplica(pool, nil)
+}
+
+// NewStatsRepositoryWithReplica creates a StatsRepository that serves its
+// aggregate and leaderboard queries from the replica pool when it is non-nil
+func NewStatsRepositoryWithReplica(pool, replica *pgxpool.Pool) repository.Stats {
+	q := generated.New(pool)
 	return &StatsRepository{
 		pool: pool,
-		q:    generated.New(pool),
+		q:    q,
+		rq:   newReadQueries(q, replica),
 	}
 }
 
@@ -167,12 +179,14 @@ func mapStatsEvent(eventID int64, userID pgtype.UUID, eventType string, eventDat
 }
 
 // GetTopUsers retrieves the most active users for a specific event type
-func (r *StatsRepository) GetTopUsers(ctx context.Context, eventType domain.EventType, startTime, endTime time.Time, limit int) ([]domain.LeaderboardEntry, error) {
-	rows, err := r.q.GetTopUsers(ctx, generated.GetTopUsersParams{
+func (r *StatsRepository) GetTopUsers(ctx context.Context, eventType domain.EventType, startTime, endTime time.Time, limit, offset int) ([]domain.LeaderboardEntry, error) {
+	rows, err := r.rq.pick(ctx).GetTopUsers(ctx, generated.GetTopUsersParams{
 		EventType:   string(eventType),
 		CreatedAt:   pgtype.Timestamp{Time: startTime, Valid: true},
 		CreatedAt_2: pgtype.Timestamp{Time: endTime, Valid: true},
 		Limit:       int32(limit),
+		Offset:      int32(offset),
+		CommunityID: community.FromContext(ctx),
 	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to query top users: %w", err)
@@ -185,9 +199,10 @@ func (r *StatsRepository) GetTopUsers(ctx context.Context, eventType domain.Even
 			uid = row.UserID.Bytes
 		}
 
+		userID, username := leaderboardIdentity(uid.String(), row.Username, row.IsPrivate)
 		entries = append(entries, domain.LeaderboardEntry{
-			UserID:    uid.String(),
-			Username:  row.Username,
+			UserID:    userID,
+			Username:  username,
 			Count:     int(row.EventCount),
 			EventType: string(eventType),
 		})
@@ -196,11 +211,36 @@ func (r *StatsRepository) GetTopUsers(ctx context.Context, eventType domain.Even
 	return entries, nil
 }
 
+// GetUserLeaderboardRank returns the user's position in GetTopUsers order, or 0 if they have no events
+func (r *StatsRepository) GetUserLeaderboardRank(ctx context.Context, eventType domain.EventType, userID string, startTime, endTime time.Time) (int, error) {
+	userUUID, err := parseUserUUID(userID)
+	if err != nil {
+		return 0, err
+	}
+
+	rank, err := r.rq.pick(ctx).GetUserLeaderboardRank(ctx, generated.GetUserLeaderboardRankParams{
+		EventType:   string(eventType),
+		CreatedAt:   pgtype.Timestamp{Time: startTime, Valid: true},
+		CreatedAt_2: pgtype.Timestamp{Time: endTime, Valid: true},
+		UserID:      pgtype.UUID{Bytes: userUUID, Valid: true},
+		CommunityID: community.FromContext(ctx),
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, fmt.Errorf("failed to query user leaderboard rank: %w", err)
+	}
+
+	return int(rank), nil
+}
+
 // GetEventCounts retrieves event counts grouped by event type within a time range
 func (r *StatsRepository) GetEventCounts(ctx context.Context, startTime, endTime time.Time) (map[domain.EventType]int, error) {
-	rows, err := r.q.GetEventCounts(ctx, generated.GetEventCountsParams{
+	rows, err := r.rq.pick(ctx).GetEventCounts(ctx, generated.GetEventCountsParams{
 		CreatedAt:   pgtype.Timestamp{Time: startTime, Valid: true},
 		CreatedAt_2: pgtype.Timestamp{Time: endTime, Valid: true},
+		CommunityID: community.FromContext(ctx),
 	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to query event counts: %w", err)
@@ -221,7 +261,7 @@ func (r *StatsRepository) GetUserEventCounts(ctx context.Context, userID string,
 		return nil, err
 	}
 
-	rows, err := r.q.GetUserEventCounts(ctx, generated.GetUserEventCountsParams{
+	rows, err := r.rq.pick(ctx).GetUserEventCounts(ctx, generated.GetUserEventCountsParams{
 		UserID:      pgtype.UUID{Bytes: userUUID, Valid: true},
 		CreatedAt:   pgtype.Timestamp{Time: startTime, Valid: true},
 		CreatedAt_2: pgtype.Timestamp{Time: endTime, Valid: true},
@@ -240,9 +280,10 @@ func (r *StatsRepository) GetUserEventCounts(ctx context.Context, userID string,
 
 // GetTotalEventCount retrieves the total number of events within a time range
 func (r *StatsRepository) GetTotalEventCount(ctx context.Context, startTime, endTime time.Time) (int, error) {
-	count, err := r.q.GetTotalEventCount(ctx, generated.GetTotalEventCountParams{
+	count, err := r.rq.pick(ctx).GetTotalEventCount(ctx, generated.GetTotalEventCountParams{
 		CreatedAt:   pgtype.Timestamp{Time: startTime, Valid: true},
 		CreatedAt_2: pgtype.Timestamp{Time: endTime, Valid: true},
+		CommunityID: community.FromContext(ctx),
 	})
 	if err != nil {
 		return 0, fmt.Errorf("failed to get total event count: %w", err)
@@ -251,6 +292,26 @@ func (r *StatsRepository) GetTotalEventCount(ctx context.Context, startTime, end
 	return int(count), nil
 }
 
+// GetUserRecipeCraftCounts retrieves how many items a user has crafted with each recipe
+func (r *StatsRepository) GetUserRecipeCraftCounts(ctx context.Context, userID string) (map[string]int, error) {
+	userUUID, err := parseUserUUID(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.rq.pick(ctx).GetUserRecipeCraftCounts(ctx, pgtype.UUID{Bytes: userUUID, Valid: true})
+	if err != nil {
+		return nil, fmt.Errorf("failed to query user recipe craft counts: %w", err)
+	}
+
+	counts := make(map[string]int, len(rows))
+	for _, row := range rows {
+		counts[row.RecipeKey] = int(row.Crafts)
+	}
+
+	return counts, nil
+}
+
 // GetUserSlotsStats retrieves aggregated slots statistics for a user
 func (r *StatsRepository) GetUserSlotsStats(ctx context.Context, userID string, startTime, endTime time.Time) (*domain.SlotsStats, error) {
 	userUUID, err := parseUserUUID(userID)
@@ -258,7 +319,7 @@ func (r *StatsRepository) GetUserSlotsStats(ctx context.Context, userID string,
 		return nil, err
 	}
 
-	row, err := r.q.GetUserSlotsStats(ctx, generated.GetUserSlotsStatsParams{
+	row, err := r.rq.pick(ctx).GetUserSlotsStats(ctx, generated.GetUserSlotsStatsParams{
 		UserID:    pgtype.UUID{Bytes: userUUID, Valid: true},
 		StartTime: pgtype.Timestamp{Time: startTime, Valid: true},
 		EndTime:   pgtype.Timestamp{Time: endTime, Valid: true},
@@ -295,9 +356,10 @@ func (r *StatsRepository) GetUserSlotsStats(ctx context.Context, userID string,
 
 // GetSlotsLeaderboardByProfit retrieves top users by net profit
 func (r *StatsRepository) GetSlotsLeaderboardByProfit(ctx context.Context, startTime, endTime time.Time, limit int) ([]domain.SlotsStats, error) {
-	rows, err := r.q.GetSlotsLeaderboardByProfit(ctx, generated.GetSlotsLeaderboardByProfitParams{
+	rows, err := r.rq.pick(ctx).GetSlotsLeaderboardByProfit(ctx, generated.GetSlotsLeaderboardByProfitParams{
 		StartTime:   pgtype.Timestamp{Time: startTime, Valid: true},
 		EndTime:     pgtype.Timestamp{Time: endTime, Valid: true},
+		CommunityID: community.FromContext(ctx),
 		ResultLimit: int32(limit),
 	})
 	if err != nil {
@@ -314,9 +376,10 @@ func (r *StatsRepository) GetSlotsLeaderboardByProfit(ctx context.Context, start
 		netProfit := convertToInt(row.NetProfit)
 		totalSpins := int(row.TotalSpins)
 
+		userID, username := leaderboardIdentity(uid.String(), row.Username, row.IsPrivate)
 		stats = append(stats, domain.SlotsStats{
-			UserID:     uid.String(),
-			Username:   row.Username,
+			UserID:     userID,
+			Username:   username,
 			TotalSpins: totalSpins,
 			NetProfit:  netProfit,
 		})
@@ -327,9 +390,10 @@ func (r *StatsRepository) GetSlotsLeaderboardByProfit(ctx context.Context, start
 
 // GetSlotsLeaderboardByWinRate retrieves top users by win rate (minimum spins required)
 func (r *StatsRepository) GetSlotsLeaderboardByWinRate(ctx context.Context, startTime, endTime time.Time, minSpins, limit int) ([]domain.SlotsStats, error) {
-	rows, err := r.q.GetSlotsLeaderboardByWinRate(ctx, generated.GetSlotsLeaderboardByWinRateParams{
+	rows, err := r.rq.pick(ctx).GetSlotsLeaderboardByWinRate(ctx, generated.GetSlotsLeaderboardByWinRateParams{
 		StartTime:   pgtype.Timestamp{Time: startTime, Valid: true},
 		EndTime:     pgtype.Timestamp{Time: endTime, Valid: true},
+		CommunityID: community.FromContext(ctx),
 		MinSpins:    int64(minSpins),
 		ResultLimit: int32(limit),
 	})
@@ -348,9 +412,10 @@ func (r *StatsRepository) GetSlotsLeaderboardByWinRate(ctx context.Context, star
 		totalWins := int(row.TotalWins)
 		winRate := convertToFloat(row.WinRate)
 
+		userID, username := leaderboardIdentity(uid.String(), row.Username, row.IsPrivate)
 		stats = append(stats, domain.SlotsStats{
-			UserID:     uid.String(),
-			Username:   row.Username,
+			UserID:     userID,
+			Username:   username,
 			TotalSpins: totalSpins,
 			TotalWins:  totalWins,
 			WinRate:    winRate,
@@ -362,9 +427,10 @@ func (r *StatsRepository) GetSlotsLeaderboardByWinRate(ctx context.Context, star
 
 // GetSlotsLeaderboardByMegaJackpots retrieves top users by mega jackpots hit
 func (r *StatsRepository) GetSlotsLeaderboardByMegaJackpots(ctx context.Context, startTime, endTime time.Time, limit int) ([]domain.SlotsStats, error) {
-	rows, err := r.q.GetSlotsLeaderboardByMegaJackpots(ctx, generated.GetSlotsLeaderboardByMegaJackpotsParams{
+	rows, err := r.rq.pick(ctx).GetSlotsLeaderboardByMegaJackpots(ctx, generated.GetSlotsLeaderboardByMegaJackpotsParams{
 		StartTime:   pgtype.Timestamp{Time: startTime, Valid: true},
 		EndTime:     pgtype.Timestamp{Time: endTime, Valid: true},
+		CommunityID: community.FromContext(ctx),
 		ResultLimit: int32(limit),
 	})
 	if err != nil {
@@ -380,9 +446,10 @@ func (r *StatsRepository) GetSlotsLeaderboardByMegaJackpots(ctx context.Context,
 
 		megaJackpotsHit := int(row.MegaJackpotsHit)
 
+		userID, username := leaderboardIdentity(uid.String(), row.Username, row.IsPrivate)
 		stats = append(stats, domain.SlotsStats{
-			UserID:          uid.String(),
-			Username:        row.Username,
+			UserID:          userID,
+			Username:        username,
 			MegaJackpotsHit: megaJackpotsHit,
 		})
 	}
@@ -390,6 +457,15 @@ func (r *StatsRepository) GetSlotsLeaderboardByMegaJackpots(ctx context.Context,
 	return stats, nil
 }
 
+// leaderboardIdentity returns the user ID and name to publish on a leaderboard.
+// Users who opted out of public rankings keep their place but are listed anonymously.
+func leaderboardIdentity(userID, username string, private bool) (string, string) {
+	if private {
+		return "", domain.AnonymousDisplayName
+	}
+	return userID, username
+}
+
 // convertToInt converts interface{} to int, handling various numeric types
 func convertToInt(v interface{}) int {
 	switch val := v.(type) {
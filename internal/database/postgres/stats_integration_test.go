@@ -5,6 +5,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/osse101/BrandishBot_Go/internal/community"
 	"github.com/osse101/BrandishBot_Go/internal/domain"
 )
 
@@ -179,7 +180,7 @@ func TestStatsRepository_Integration(t *testing.T) {
 		// Get top users
 		startTime := now.Add(-1 * time.Hour)
 		endTime := now.Add(1 * time.Hour)
-		topUsers, err := statsRepo.GetTopUsers(ctx, domain.StatsEventMessageReceived, startTime, endTime, 10)
+		topUsers, err := statsRepo.GetTopUsers(ctx, domain.StatsEventMessageReceived, startTime, endTime, 10, 0)
 		if err != nil {
 			t.Fatalf("GetTopUsers failed: %v", err)
 		}
@@ -196,6 +197,23 @@ func TestStatsRepository_Integration(t *testing.T) {
 		if topUsers[0].Count < 3 {
 			t.Errorf("expected top user to have at least 3 events, got %d", topUsers[0].Count)
 		}
+
+		// The second page starts where the first one ended
+		secondPage, err := statsRepo.GetTopUsers(ctx, domain.StatsEventMessageReceived, startTime, endTime, 1, 1)
+		if err != nil {
+			t.Fatalf("GetTopUsers with offset failed: %v", err)
+		}
+		if len(secondPage) != 1 || secondPage[0].UserID != topUsers[1].UserID {
+			t.Errorf("expected offset page to hold %s, got %+v", topUsers[1].UserID, secondPage)
+		}
+
+		rank, err := statsRepo.GetUserLeaderboardRank(ctx, domain.StatsEventMessageReceived, anotherUser.ID, startTime, endTime)
+		if err != nil {
+			t.Fatalf("GetUserLeaderboardRank failed: %v", err)
+		}
+		if rank != 2 {
+			t.Errorf("expected another user to rank 2nd, got %d", rank)
+		}
 	})
 
 	t.Run("GetEventCounts", func(t *testing.T) {
@@ -348,3 +366,93 @@ func TestStatsRepository_Integration(t *testing.T) {
 		}
 	})
 }
+
+func TestStatsRepository_CommunityScope_Integration(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test in short mode")
+	}
+	if testDBConnString == "" {
+		t.Skip("Skipping integration test: database not available")
+	}
+
+	ensureMigrations(t)
+
+	userRepo := NewUserRepository(testPool)
+	statsRepo := NewStatsRepository(testPool)
+
+	// Each community gets one user with a chat message and a slots spin
+	now := time.Now()
+	users := map[string]*domain.User{}
+	for _, id := range []string{"stats_scope_a", "stats_scope_b"} {
+		ctx := community.WithID(context.Background(), id)
+		user := &domain.User{Username: id + "_user"}
+		if err := userRepo.UpsertUser(ctx, user); err != nil {
+			t.Fatalf("failed to create user: %v", err)
+		}
+		users[id] = user
+
+		for _, event := range []*domain.StatsEvent{
+			{UserID: user.ID, EventType: domain.StatsEventMessageReceived, CreatedAt: now},
+			{
+				UserID:    user.ID,
+				EventType: domain.EventTypeSlotsSpin,
+				EventData: map[string]interface{}{"bet_amount": 10, "payout_amount": 50},
+				CreatedAt: now,
+			},
+		} {
+			if err := statsRepo.RecordEvent(ctx, event); err != nil {
+				t.Fatalf("failed to record event: %v", err)
+			}
+		}
+	}
+
+	ctx := community.WithID(context.Background(), "stats_scope_a")
+	startTime := now.Add(-1 * time.Hour)
+	endTime := now.Add(1 * time.Hour)
+
+	topUsers, err := statsRepo.GetTopUsers(ctx, domain.StatsEventMessageReceived, startTime, endTime, 10, 0)
+	if err != nil {
+		t.Fatalf("GetTopUsers failed: %v", err)
+	}
+	if len(topUsers) != 1 || topUsers[0].UserID != users["stats_scope_a"].ID {
+		t.Errorf("expected only the stats_scope_a user, got %+v", topUsers)
+	}
+
+	rank, err := statsRepo.GetUserLeaderboardRank(ctx, domain.StatsEventMessageReceived, users["stats_scope_b"].ID, startTime, endTime)
+	if err != nil {
+		t.Fatalf("GetUserLeaderboardRank failed: %v", err)
+	}
+	if rank != 0 {
+		t.Errorf("expected no rank for a user from another community, got %d", rank)
+	}
+
+	counts, err := statsRepo.GetEventCounts(ctx, startTime, endTime)
+	if err != nil {
+		t.Fatalf("GetEventCounts failed: %v", err)
+	}
+	if counts[domain.StatsEventMessageReceived] != 1 || counts[domain.EventTypeSlotsSpin] != 1 {
+		t.Errorf("expected one event of each type, got %v", counts)
+	}
+
+	total, err := statsRepo.GetTotalEventCount(ctx, startTime, endTime)
+	if err != nil {
+		t.Fatalf("GetTotalEventCount failed: %v", err)
+	}
+	if total != 2 {
+		t.Errorf("expected 2 events in the community, got %d", total)
+	}
+
+	byProfit, err := statsRepo.GetSlotsLeaderboardByProfit(ctx, startTime, endTime, 10)
+	if err != nil {
+		t.Fatalf("GetSlotsLeaderboardByProfit failed: %v", err)
+	}
+	byWinRate, err := statsRepo.GetSlotsLeaderboardByWinRate(ctx, startTime, endTime, 1, 10)
+	if err != nil {
+		t.Fatalf("GetSlotsLeaderboardByWinRate failed: %v", err)
+	}
+	for name, board := range map[string][]domain.SlotsStats{"profit": byProfit, "win rate": byWinRate} {
+		if len(board) != 1 || board[0].UserID != users["stats_scope_a"].ID {
+			t.Errorf("expected only the stats_scope_a user on the %s board, got %+v", name, board)
+		}
+	}
+}
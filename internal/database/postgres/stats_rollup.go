@@ -0,0 +1,163 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/osse101/BrandishBot_Go/internal/database/generated"
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/stats"
+)
+
+type statsAggregateRepository struct {
+	pool *pgxpool.Pool
+	q    *generated.Queries
+	rq   readQueries
+}
+
+// NewStatsAggregateRepository creates a PostgreSQL repository for stats
+// rollups. Queries are served from the replica pool when it is non-nil.
+func NewStatsAggregateRepository(pool, replica *pgxpool.Pool) stats.AggregateRepository {
+	q := generated.New(pool)
+	return &statsAggregateRepository{
+		pool: pool,
+		q:    q,
+		rq:   newReadQueries(q, replica),
+	}
+}
+
+// GetRollupProgress returns how far a bucket size has been rolled up
+func (r *statsAggregateRepository) GetRollupProgress(ctx context.Context, bucket domain.StatsBucket) (time.Time, bool, error) {
+	rolledThrough, err := r.q.GetStatsRollupProgress(ctx, string(bucket))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return time.Time{}, false, nil
+		}
+		return time.Time{}, false, fmt.Errorf("failed to get stats rollup progress: %w", err)
+	}
+	return rolledThrough.Time, true, nil
+}
+
+// GetEarliestEventTime returns when the first stats event was recorded
+func (r *statsAggregateRepository) GetEarliestEventTime(ctx context.Context) (time.Time, bool, error) {
+	earliest, err := r.q.GetEarliestStatsEventTime(ctx)
+	if err != nil {
+		return time.Time{}, false, fmt.Errorf("failed to get earliest stats event: %w", err)
+	}
+	return earliest.Time, earliest.Valid, nil
+}
+
+// RollUp recounts [from, to) and advances the progress in one transaction
+func (r *statsAggregateRepository) RollUp(ctx context.Context, bucket domain.StatsBucket, from, to time.Time) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer SafeRollback(ctx, tx)
+
+	q := r.q.WithTx(tx)
+	if _, err := q.RollUpStatsEvents(ctx, generated.RollUpStatsEventsParams{
+		Bucket:   string(bucket),
+		FromTime: pgtype.Timestamp{Time: from, Valid: true},
+		ToTime:   pgtype.Timestamp{Time: to, Valid: true},
+	}); err != nil {
+		return fmt.Errorf("failed to roll up stats events: %w", err)
+	}
+	if err := q.SetStatsRollupProgress(ctx, generated.SetStatsRollupProgressParams{
+		Bucket:        string(bucket),
+		RolledThrough: pgtype.Timestamp{Time: to, Valid: true},
+	}); err != nil {
+		return fmt.Errorf("failed to record stats rollup progress: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit stats rollup: %w", err)
+	}
+	return nil
+}
+
+// QueryRollups sums rolled-up counts for the query
+func (r *statsAggregateRepository) QueryRollups(ctx context.Context, query domain.StatsQuery) ([]domain.StatsQueryRow, error) {
+	userID, err := statsQueryUserID(query.UserID)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := r.rq.pick(ctx).QueryStatsRollups(ctx, generated.QueryStatsRollupsParams{
+		ByTime:      query.GroupByTime,
+		ByUser:      query.GroupByUser,
+		ByEventType: query.GroupByEventType,
+		Bucket:      string(query.Bucket),
+		FromTime:    pgtype.Timestamp{Time: query.Start, Valid: true},
+		ToTime:      pgtype.Timestamp{Time: query.End, Valid: true},
+		UserID:      userID,
+		EventType:   pgtype.Text{String: string(query.EventType), Valid: query.EventType != ""},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to query stats rollups: %w", err)
+	}
+	result := make([]domain.StatsQueryRow, 0, len(rows))
+	for _, row := range rows {
+		result = append(result, mapStatsQueryRow(row.BucketStart, row.UserID, row.EventType, row.EventCount))
+	}
+	return result, nil
+}
+
+// QueryEvents counts raw events for the query
+func (r *statsAggregateRepository) QueryEvents(ctx context.Context, query domain.StatsQuery) ([]domain.StatsQueryRow, error) {
+	userID, err := statsQueryUserID(query.UserID)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := r.rq.pick(ctx).QueryStatsEvents(ctx, generated.QueryStatsEventsParams{
+		ByTime:      query.GroupByTime,
+		Bucket:      string(query.Bucket),
+		ByUser:      query.GroupByUser,
+		ByEventType: query.GroupByEventType,
+		FromTime:    pgtype.Timestamp{Time: query.Start, Valid: true},
+		ToTime:      pgtype.Timestamp{Time: query.End, Valid: true},
+		UserID:      userID,
+		EventType:   pgtype.Text{String: string(query.EventType), Valid: query.EventType != ""},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to query stats events: %w", err)
+	}
+	result := make([]domain.StatsQueryRow, 0, len(rows))
+	for _, row := range rows {
+		result = append(result, mapStatsQueryRow(row.BucketStart, row.UserID, row.EventType, row.EventCount))
+	}
+	return result, nil
+}
+
+// statsQueryUserID converts an optional user filter
+func statsQueryUserID(userID string) (pgtype.UUID, error) {
+	if userID == "" {
+		return pgtype.UUID{}, nil
+	}
+	parsed, err := parseUserUUID(userID)
+	if err != nil {
+		return pgtype.UUID{}, err
+	}
+	return pgtype.UUID{Bytes: parsed, Valid: true}, nil
+}
+
+func mapStatsQueryRow(bucketStart pgtype.Timestamp, userID pgtype.UUID, eventType pgtype.Text, count int64) domain.StatsQueryRow {
+	row := domain.StatsQueryRow{
+		EventType: domain.EventType(eventType.String),
+		Count:     int(count),
+	}
+	if bucketStart.Valid {
+		start := bucketStart.Time
+		row.BucketStart = &start
+	}
+	if userID.Valid {
+		row.UserID = uuid.UUID(userID.Bytes).String()
+	}
+	return row
+}
@@ -0,0 +1,187 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/osse101/BrandishBot_Go/internal/community"
+	"github.com/osse101/BrandishBot_Go/internal/database/generated"
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/stream"
+)
+
+type streamRepository struct {
+	q *generated.Queries
+}
+
+// NewStreamRepository creates a new PostgreSQL stream session repository
+func NewStreamRepository(pool *pgxpool.Pool) stream.Repository {
+	return &streamRepository{q: generated.New(pool)}
+}
+
+// StartSession opens a session unless the community is already live
+func (r *streamRepository) StartSession(ctx context.Context, platform, title string) (*domain.StreamSession, error) {
+	row, err := r.q.StartStreamSession(ctx, generated.StartStreamSessionParams{
+		CommunityID: community.FromContext(ctx),
+		Platform:    platform,
+		Title:       title,
+	})
+	return mapStreamSessionRow(row, err, "failed to start stream session")
+}
+
+// GetLiveSession returns the community's live session
+func (r *streamRepository) GetLiveSession(ctx context.Context) (*domain.StreamSession, error) {
+	row, err := r.q.GetLiveStreamSession(ctx, community.FromContext(ctx))
+	return mapStreamSessionRow(row, err, "failed to get live stream session")
+}
+
+// EndSession closes the community's live session
+func (r *streamRepository) EndSession(ctx context.Context) (*domain.StreamSession, error) {
+	row, err := r.q.EndStreamSession(ctx, community.FromContext(ctx))
+	return mapStreamSessionRow(row, err, "failed to end stream session")
+}
+
+// GetSession returns one of the community's sessions
+func (r *streamRepository) GetSession(ctx context.Context, id int64) (*domain.StreamSession, error) {
+	row, err := r.q.GetStreamSession(ctx, generated.GetStreamSessionParams{
+		ID:          id,
+		CommunityID: community.FromContext(ctx),
+	})
+	return mapStreamSessionRow(row, err, "failed to get stream session")
+}
+
+// GetSessionSummary totals the activity tagged with a session
+func (r *streamRepository) GetSessionSummary(ctx context.Context, id int64, topUsers int) (*domain.StreamSummary, error) {
+	sessionID := pgtype.Int8{Int64: id, Valid: true}
+
+	activity, err := r.q.GetStreamSessionActivity(ctx, sessionID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get stream activity: %w", err)
+	}
+	counts, err := r.q.GetStreamSessionEventCounts(ctx, sessionID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get stream event counts: %w", err)
+	}
+	users, err := r.q.GetStreamSessionTopUsers(ctx, generated.GetStreamSessionTopUsersParams{
+		StreamSessionID: sessionID,
+		Limit:           int32(topUsers),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get stream top users: %w", err)
+	}
+	gambles, err := r.q.GetStreamSessionGambleStats(ctx, sessionID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get stream gamble stats: %w", err)
+	}
+
+	summary := &domain.StreamSummary{
+		TotalEvents:   int(activity.Events),
+		ActiveUsers:   int(activity.ActiveUsers),
+		EventCounts:   make(map[string]int, len(counts)),
+		TopUsers:      make([]domain.StreamTopUser, 0, len(users)),
+		Drops:         int(activity.Drops),
+		DroppedItems:  int(activity.DroppedItems),
+		Gambles:       int(gambles.Gambles),
+		GambleEntries: int(gambles.Participants),
+		GambleValue:   gambles.TotalValue,
+	}
+	for _, c := range counts {
+		summary.EventCounts[c.EventType] = int(c.Count)
+	}
+	for _, u := range users {
+		summary.TopUsers = append(summary.TopUsers, domain.StreamTopUser{
+			UserID:   uuid.UUID(u.UserID.Bytes).String(),
+			Username: u.Username,
+			Events:   int(u.Events),
+		})
+	}
+	return summary, nil
+}
+
+// GetSessionHighlights finds the biggest gamble win, top contributor and
+// unlocks of a session. The gamble win comes from tagged stats events; the
+// contributor and unlocks come from the session's time window, since
+// engagement and unlocks are not tagged.
+func (r *streamRepository) GetSessionHighlights(ctx context.Context, session *domain.StreamSession, until time.Time) (*domain.StreamRecap, error) {
+	communityID := community.FromContext(ctx)
+	startedAt := pgtype.Timestamptz{Time: session.StartedAt, Valid: true}
+	endedAt := pgtype.Timestamptz{Time: until, Valid: true}
+	recap := &domain.StreamRecap{Unlocks: []domain.StreamUnlock{}}
+
+	win, err := r.q.GetStreamSessionBiggestGambleWin(ctx, pgtype.Int8{Int64: session.ID, Valid: true})
+	switch {
+	case err == nil:
+		recap.BiggestGambleWin = &domain.StreamGambleWin{
+			GambleID:     win.GambleID,
+			WinnerID:     uuid.UUID(win.UserID.Bytes).String(),
+			Username:     win.Username,
+			TotalValue:   win.TotalValue,
+			Participants: int(win.Participants),
+		}
+	case !errors.Is(err, pgx.ErrNoRows):
+		return nil, fmt.Errorf("failed to get stream gamble win: %w", err)
+	}
+
+	contributor, err := r.q.GetStreamSessionTopContributor(ctx, generated.GetStreamSessionTopContributorParams{
+		CommunityID: communityID,
+		StartedAt:   startedAt,
+		EndedAt:     endedAt,
+	})
+	switch {
+	case err == nil:
+		recap.TopContributor = &domain.StreamContributor{
+			UserID:   uuid.UUID(contributor.UserID.Bytes).String(),
+			Username: contributor.Username,
+			Score:    contributor.Score,
+		}
+	case !errors.Is(err, pgx.ErrNoRows):
+		return nil, fmt.Errorf("failed to get stream top contributor: %w", err)
+	}
+
+	unlocks, err := r.q.GetStreamSessionUnlocks(ctx, generated.GetStreamSessionUnlocksParams{
+		CommunityID: communityID,
+		StartedAt:   startedAt,
+		EndedAt:     endedAt,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get stream unlocks: %w", err)
+	}
+	for _, u := range unlocks {
+		recap.Unlocks = append(recap.Unlocks, domain.StreamUnlock{
+			NodeKey:     u.NodeKey,
+			DisplayName: u.DisplayName,
+			Level:       int(u.CurrentLevel.Int32),
+			UnlockedAt:  u.UnlockedAt.Time,
+		})
+	}
+	return recap, nil
+}
+
+// mapStreamSessionRow turns a single-row query result into a session, with
+// no rows meaning no session
+func mapStreamSessionRow(row generated.StreamSession, err error, msg string) (*domain.StreamSession, error) {
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("%s: %w", msg, err)
+	}
+	session := &domain.StreamSession{
+		ID:        row.ID,
+		Platform:  row.Platform,
+		Title:     row.Title,
+		StartedAt: row.StartedAt.Time,
+	}
+	if row.EndedAt.Valid {
+		endedAt := row.EndedAt.Time
+		session.EndedAt = &endedAt
+	}
+	return session, nil
+}
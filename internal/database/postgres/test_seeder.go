@@ -9,6 +9,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5/pgtype"
 
+	"github.com/osse101/BrandishBot_Go/internal/community"
 	"github.com/osse101/BrandishBot_Go/internal/database/generated"
 	"github.com/osse101/BrandishBot_Go/internal/domain"
 )
@@ -19,8 +20,9 @@ func SeedFullyLoadedUser(ctx context.Context, q *generated.Queries, userID uuid.
 	// 1. users
 	// We use the provided userID directly
 	_, err := q.CreateUserWithID(ctx, generated.CreateUserWithIDParams{
-		UserID:   userID,
-		Username: username,
+		UserID:      userID,
+		Username:    username,
+		CommunityID: community.FromContext(ctx),
 	})
 	if err != nil {
 		return fmt.Errorf("failed to create user with id: %w", err)
@@ -40,6 +42,7 @@ func SeedFullyLoadedUser(ctx context.Context, q *generated.Queries, userID uuid.
 					String: username,
 					Valid:  true,
 				},
+				CommunityID: community.FromContext(ctx),
 			})
 			if err != nil {
 				return fmt.Errorf("failed to upsert platform link for %s: %w", pName, err)
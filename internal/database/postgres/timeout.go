@@ -0,0 +1,114 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/osse101/BrandishBot_Go/internal/database/generated"
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/user"
+)
+
+type timeoutRepository struct {
+	q *generated.Queries
+}
+
+// NewTimeoutRepository creates a PostgreSQL store for user timeouts, so they
+// survive a restart
+func NewTimeoutRepository(pool *pgxpool.Pool) user.TimeoutStore {
+	return &timeoutRepository{q: generated.New(pool)}
+}
+
+// AddTimeout extends a timeout by duration and returns the new expiry
+func (r *timeoutRepository) AddTimeout(ctx context.Context, platform, username string, duration time.Duration, reason string) (time.Time, error) {
+	expiresAt, err := r.q.AddUserTimeout(ctx, generated.AddUserTimeoutParams{
+		Platform: platform,
+		Username: username,
+		Seconds:  duration.Seconds(),
+		Reason:   reason,
+	})
+	if err != nil {
+		return time.Time{}, fmt.Errorf("failed to add timeout: %w", err)
+	}
+	return expiresAt.Time, nil
+}
+
+// GetTimeout returns the expiry of an active timeout, or the zero time
+func (r *timeoutRepository) GetTimeout(ctx context.Context, platform, username string) (time.Time, error) {
+	expiresAt, err := r.q.GetUserTimeout(ctx, generated.GetUserTimeoutParams{
+		Platform: platform,
+		Username: username,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return time.Time{}, nil
+		}
+		return time.Time{}, fmt.Errorf("failed to get timeout: %w", err)
+	}
+	return expiresAt.Time, nil
+}
+
+// ReduceTimeout moves an active timeout's expiry earlier and returns the new expiry
+func (r *timeoutRepository) ReduceTimeout(ctx context.Context, platform, username string, reduction time.Duration) (time.Time, error) {
+	expiresAt, err := r.q.ReduceUserTimeout(ctx, generated.ReduceUserTimeoutParams{
+		Seconds:  reduction.Seconds(),
+		Platform: platform,
+		Username: username,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return time.Time{}, nil
+		}
+		return time.Time{}, fmt.Errorf("failed to reduce timeout: %w", err)
+	}
+	return expiresAt.Time, nil
+}
+
+// ClearTimeout removes a timeout and reports whether it was still active
+func (r *timeoutRepository) ClearTimeout(ctx context.Context, platform, username string) (bool, error) {
+	rows, err := r.q.DeleteUserTimeout(ctx, generated.DeleteUserTimeoutParams{
+		Platform: platform,
+		Username: username,
+	})
+	if err != nil {
+		return false, fmt.Errorf("failed to clear timeout: %w", err)
+	}
+	return rows > 0, nil
+}
+
+// DeleteExpiredTimeout removes a timeout once it has expired
+func (r *timeoutRepository) DeleteExpiredTimeout(ctx context.Context, platform, username string) error {
+	if err := r.q.DeleteExpiredUserTimeout(ctx, generated.DeleteExpiredUserTimeoutParams{
+		Platform: platform,
+		Username: username,
+	}); err != nil {
+		return fmt.Errorf("failed to delete expired timeout: %w", err)
+	}
+	return nil
+}
+
+// GetActiveTimeouts removes expired timeouts and returns the active ones
+func (r *timeoutRepository) GetActiveTimeouts(ctx context.Context) ([]domain.UserTimeout, error) {
+	if err := r.q.DeleteExpiredUserTimeouts(ctx); err != nil {
+		return nil, fmt.Errorf("failed to delete expired timeouts: %w", err)
+	}
+	rows, err := r.q.GetActiveUserTimeouts(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get active timeouts: %w", err)
+	}
+	timeouts := make([]domain.UserTimeout, 0, len(rows))
+	for _, row := range rows {
+		timeouts = append(timeouts, domain.UserTimeout{
+			Platform:  row.Platform,
+			Username:  row.Username,
+			Reason:    row.Reason,
+			ExpiresAt: row.ExpiresAt.Time,
+		})
+	}
+	return timeouts, nil
+}
@@ -0,0 +1,128 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/osse101/BrandishBot_Go/internal/database/generated"
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/repository"
+	"github.com/osse101/BrandishBot_Go/internal/undo"
+)
+
+type undoRepository struct {
+	db        *pgxpool.Pool
+	q         *generated.Queries
+	inventory *inventoryEvents
+}
+
+// NewUndoRepository creates a new PostgreSQL undo ledger repository
+func NewUndoRepository(pool *pgxpool.Pool, opts ...RepositoryOption) undo.Repository {
+	return &undoRepository{db: pool, q: generated.New(pool), inventory: newInventoryEvents(InventorySourceUndo, opts)}
+}
+
+// BeginTx starts a transaction and returns an undo.Tx
+func (r *undoRepository) BeginTx(ctx context.Context) (undo.Tx, error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin undo transaction: %w", err)
+	}
+	return &undoTx{tx: tx, q: r.q.WithTx(tx), inventory: r.inventory.begin()}, nil
+}
+
+// RecordUndo stores an entry and clears the user's expired entries
+func (r *undoRepository) RecordUndo(ctx context.Context, entry domain.UndoEntry) error {
+	userUUID, err := parseUserUUID(entry.UserID)
+	if err != nil {
+		return err
+	}
+	changes, err := json.Marshal(entry.Changes)
+	if err != nil {
+		return fmt.Errorf("failed to marshal undo changes: %w", err)
+	}
+	if err := r.q.DeleteExpiredUserUndoEntries(ctx, generated.DeleteExpiredUserUndoEntriesParams{
+		UserID:    userUUID,
+		ExpiresAt: pgtype.Timestamptz{Time: entry.CreatedAt, Valid: true},
+	}); err != nil {
+		return fmt.Errorf("failed to delete expired undo entries: %w", err)
+	}
+	if err := r.q.InsertUndoEntry(ctx, generated.InsertUndoEntryParams{
+		UserID:    userUUID,
+		Action:    string(entry.Action),
+		Changes:   changes,
+		ExpiresAt: pgtype.Timestamptz{Time: entry.ExpiresAt, Valid: true},
+	}); err != nil {
+		return fmt.Errorf("failed to insert undo entry: %w", err)
+	}
+	return nil
+}
+
+// undoTx implements undo.Tx
+type undoTx struct {
+	tx        pgx.Tx
+	q         *generated.Queries
+	inventory *inventoryJournal
+}
+
+func (t *undoTx) Commit(ctx context.Context) error {
+	if err := t.tx.Commit(ctx); err != nil {
+		return err
+	}
+	t.inventory.flush(ctx)
+	return nil
+}
+
+func (t *undoTx) Rollback(ctx context.Context) error {
+	err := t.tx.Rollback(ctx)
+	if errors.Is(err, pgx.ErrTxClosed) {
+		return fmt.Errorf("%w: %w", repository.ErrTxClosed, err)
+	}
+	return err
+}
+
+func (t *undoTx) AdjustItemQuantity(ctx context.Context, userID string, itemID int, quality domain.QualityLevel, delta int) (int, error) {
+	return adjustItemQuantity(ctx, t.q, userID, itemID, quality, delta, t.inventory)
+}
+
+func (t *undoTx) GetLatestUndoForUpdate(ctx context.Context, userID string, now time.Time) (*domain.UndoEntry, error) {
+	userUUID, err := parseUserUUID(userID)
+	if err != nil {
+		return nil, err
+	}
+	row, err := t.q.GetLatestUndoEntryForUpdate(ctx, generated.GetLatestUndoEntryForUpdateParams{
+		UserID:    userUUID,
+		ExpiresAt: pgtype.Timestamptz{Time: now, Valid: true},
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get undo entry: %w", err)
+	}
+	var changes []domain.InventoryChange
+	if err := json.Unmarshal(row.Changes, &changes); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal undo changes: %w", err)
+	}
+	return &domain.UndoEntry{
+		ID:        row.ID,
+		UserID:    row.UserID.String(),
+		Action:    domain.UndoAction(row.Action),
+		Changes:   changes,
+		CreatedAt: row.CreatedAt.Time,
+		ExpiresAt: row.ExpiresAt.Time,
+	}, nil
+}
+
+func (t *undoTx) DeleteUndo(ctx context.Context, id int64) error {
+	if err := t.q.DeleteUndoEntry(ctx, id); err != nil {
+		return fmt.Errorf("failed to delete undo entry: %w", err)
+	}
+	return nil
+}
@@ -5,13 +5,13 @@ import (
 	"errors"
 	"fmt"
 	"strings"
-	"time"
 
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/jackc/pgx/v5/pgxpool"
 
+	"github.com/osse101/BrandishBot_Go/internal/community"
 	"github.com/osse101/BrandishBot_Go/internal/database/generated"
 	"github.com/osse101/BrandishBot_Go/internal/domain"
 	"github.com/osse101/BrandishBot_Go/internal/repository"
@@ -19,22 +19,41 @@ import (
 
 // UserRepository implements the user repository for PostgreSQL
 type UserRepository struct {
-	db *pgxpool.Pool
-	q  *generated.Queries
+	db        *pgxpool.Pool
+	q         *generated.Queries
+	rq        readQueries
+	inventory *inventoryEvents
 }
 
 // NewUserRepository creates a new UserRepository
-func NewUserRepository(db *pgxpool.Pool) *UserRepository {
+func NewUserRepository(db *pgxpool.Pool, opts ...RepositoryOption) *UserRepository {
+	return NewUserRepositoryWithReplica(db, nil, opts...)
+}
+
+// NewUserRepositoryWithReplica creates a UserRepository that serves plain
+// inventory reads from the replica pool when it is non-nil. Reads inside a
+// transaction always use the primary.
+func NewUserRepositoryWithReplica(db, replica *pgxpool.Pool, opts ...RepositoryOption) *UserRepository {
+	return newUserRepository(db, replica, InventorySourceUser, opts)
+}
+
+// newUserRepository builds a UserRepository whose inventory writes are
+// reported under source. Repositories embedding it pass their own source.
+func newUserRepository(db, replica *pgxpool.Pool, source string, opts []RepositoryOption) *UserRepository {
+	q := generated.New(db)
 	return &UserRepository{
-		db: db,
-		q:  generated.New(db),
+		db:        db,
+		q:         q,
+		rq:        newReadQueries(q, replica),
+		inventory: newInventoryEvents(source, opts),
 	}
 }
 
 // UserTx implements transactional operations
 type UserTx struct {
-	tx pgx.Tx
-	q  *generated.Queries
+	tx        pgx.Tx
+	q         *generated.Queries
+	inventory *inventoryJournal
 }
 
 // BeginTx starts a new transaction
@@ -44,8 +63,9 @@ func (r *UserRepository) BeginTx(ctx context.Context) (repository.UserTx, error)
 		return nil, fmt.Errorf("failed to begin transaction: %w", err)
 	}
 	return &UserTx{
-		tx: tx,
-		q:  r.q.WithTx(tx),
+		tx:        tx,
+		q:         r.q.WithTx(tx),
+		inventory: r.inventory.begin(),
 	}, nil
 }
 
@@ -56,12 +76,26 @@ func (t *UserTx) GetInventory(ctx context.Context, userID string) (*domain.Inven
 
 // UpdateInventory updates inventory within a transaction
 func (t *UserTx) UpdateInventory(ctx context.Context, userID string, inventory domain.Inventory) error {
-	return updateInventory(ctx, t.q, userID, inventory)
+	return updateInventory(ctx, t.q, userID, inventory, t.inventory)
+}
+
+// AdjustItemQuantity applies a quantity delta to one inventory slot within a transaction
+func (t *UserTx) AdjustItemQuantity(ctx context.Context, userID string, itemID int, quality domain.QualityLevel, delta int) (int, error) {
+	return adjustItemQuantity(ctx, t.q, userID, itemID, quality, delta, t.inventory)
+}
+
+// AddToCommunityPool credits the community pool within a transaction
+func (t *UserTx) AddToCommunityPool(ctx context.Context, amount int) error {
+	return addToCommunityPool(ctx, t.q, amount)
 }
 
-// Commit commits the transaction
+// Commit commits the transaction and publishes its inventory changes
 func (t *UserTx) Commit(ctx context.Context) error {
-	return t.tx.Commit(ctx)
+	if err := t.tx.Commit(ctx); err != nil {
+		return err
+	}
+	t.inventory.flush(ctx)
+	return nil
 }
 
 // Rollback rolls back the transaction
@@ -85,7 +119,10 @@ func (r *UserRepository) UpsertUser(ctx context.Context, user *domain.User) erro
 
 	var userUUID uuid.UUID
 	if user.ID == "" {
-		userUUID, err = q.CreateUser(ctx, user.Username)
+		userUUID, err = q.CreateUser(ctx, generated.CreateUserParams{
+			Username:    user.Username,
+			CommunityID: community.FromContext(ctx),
+		})
 		if err != nil {
 			return fmt.Errorf("failed to insert user: %w", err)
 		}
@@ -125,6 +162,7 @@ func (r *UserRepository) GetUserByPlatformID(ctx context.Context, platform, plat
 	row, err := r.q.GetUserByPlatformID(ctx, generated.GetUserByPlatformIDParams{
 		Name:           platform,
 		PlatformUserID: platformID,
+		CommunityID:    community.FromContext(ctx),
 	})
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
@@ -139,8 +177,9 @@ func (r *UserRepository) GetUserByPlatformID(ctx context.Context, platform, plat
 // GetUserByPlatformUsername finds a user by platform and username (case-insensitive)
 func (r *UserRepository) GetUserByPlatformUsername(ctx context.Context, platform, username string) (*domain.User, error) {
 	row, err := r.q.GetUserByPlatformUsername(ctx, generated.GetUserByPlatformUsernameParams{
-		Lower: strings.ToLower(username),
-		Name:  platform,
+		Lower:       strings.ToLower(username),
+		Name:        platform,
+		CommunityID: community.FromContext(ctx),
 	})
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
@@ -154,17 +193,17 @@ func (r *UserRepository) GetUserByPlatformUsername(ctx context.Context, platform
 
 // GetInventory retrieves the user's inventory
 func (r *UserRepository) GetInventory(ctx context.Context, userID string) (*domain.Inventory, error) {
-	return getInventory(ctx, r.q, userID)
+	return getInventory(ctx, r.rq.pick(ctx), userID)
 }
 
 // UpdateInventory updates the user's inventory
 func (r *UserRepository) UpdateInventory(ctx context.Context, userID string, inventory domain.Inventory) error {
-	return updateInventory(ctx, r.q, userID, inventory)
+	return updateInventory(ctx, r.q, userID, inventory, r.inventory.direct())
 }
 
 // GetItemByName retrieves an item by its internal name
 func (r *UserRepository) GetItemByName(ctx context.Context, itemName string) (*domain.Item, error) {
-	return getItemByName(ctx, r.q, itemName)
+	return itemCatalog.itemByName(ctx, r.q, itemName)
 }
 
 // GetItemByPublicName retrieves an item by its public name
@@ -182,7 +221,7 @@ func (r *UserRepository) GetItemByPublicName(ctx context.Context, publicName str
 
 // GetItemsByIDs retrieves multiple items by their IDs
 func (r *UserRepository) GetItemsByIDs(ctx context.Context, itemIDs []int) ([]domain.Item, error) {
-	return getItemsByIDs(ctx, r.q, itemIDs)
+	return itemCatalog.itemsByIDs(ctx, r.q, itemIDs)
 }
 
 // GetItemsByNames retrieves multiple items by their internal names
@@ -246,89 +285,7 @@ func renderPlatformID(platform, platformUserID, targetPlatform string) string {
 
 // GetItemByID retrieves an item by its ID
 func (r *UserRepository) GetItemByID(ctx context.Context, id int) (*domain.Item, error) {
-	return getItemByID(ctx, r.q, id)
-}
-
-// GetLastCooldown retrieves the last time a user performed an action
-func (r *UserRepository) GetLastCooldown(ctx context.Context, userID, action string) (*time.Time, error) {
-	userUUID, err := uuid.Parse(userID)
-	if err != nil {
-		return nil, fmt.Errorf("invalid user id: %w", err)
-	}
-
-	lastUsed, err := r.q.GetLastCooldown(ctx, generated.GetLastCooldownParams{
-		UserID:     userUUID,
-		ActionName: action,
-	})
-	if err != nil {
-		if errors.Is(err, pgx.ErrNoRows) {
-			return nil, nil
-		}
-		return nil, fmt.Errorf("failed to get cooldown: %w", err)
-	}
-
-	t := lastUsed.Time
-	return &t, nil
-}
-
-// GetLastCooldownForUpdate retrieves the last time a user performed an action with row-level lock
-func (r *UserRepository) GetLastCooldownForUpdate(ctx context.Context, tx pgx.Tx, userID, action string) (*time.Time, error) {
-	userUUID, err := uuid.Parse(userID)
-	if err != nil {
-		return nil, fmt.Errorf("invalid user id: %w", err)
-	}
-
-	q := r.q.WithTx(tx)
-	lastUsed, err := q.GetLastCooldownForUpdate(ctx, generated.GetLastCooldownForUpdateParams{
-		UserID:     userUUID,
-		ActionName: action,
-	})
-	if err != nil {
-		if errors.Is(err, pgx.ErrNoRows) {
-			return nil, nil
-		}
-		return nil, fmt.Errorf("failed to get cooldown with lock: %w", err)
-	}
-
-	t := lastUsed.Time
-	return &t, nil
-}
-
-// UpdateCooldown updates or creates a cooldown record for a user action
-func (r *UserRepository) UpdateCooldown(ctx context.Context, userID, action string, timestamp time.Time) error {
-	userUUID, err := uuid.Parse(userID)
-	if err != nil {
-		return fmt.Errorf("invalid user id: %w", err)
-	}
-
-	err = r.q.UpdateCooldown(ctx, generated.UpdateCooldownParams{
-		UserID:     userUUID,
-		ActionName: action,
-		LastUsedAt: pgtype.Timestamptz{Time: timestamp, Valid: true},
-	})
-	if err != nil {
-		return fmt.Errorf("failed to update cooldown: %w", err)
-	}
-	return nil
-}
-
-// UpdateCooldownTx updates or creates a cooldown record within a transaction
-func (r *UserRepository) UpdateCooldownTx(ctx context.Context, tx pgx.Tx, userID, action string, timestamp time.Time) error {
-	userUUID, err := uuid.Parse(userID)
-	if err != nil {
-		return fmt.Errorf("invalid user id: %w", err)
-	}
-
-	q := r.q.WithTx(tx)
-	err = q.UpdateCooldown(ctx, generated.UpdateCooldownParams{
-		UserID:     userUUID,
-		ActionName: action,
-		LastUsedAt: pgtype.Timestamptz{Time: timestamp, Valid: true},
-	})
-	if err != nil {
-		return fmt.Errorf("failed to update cooldown: %w", err)
-	}
-	return nil
+	return itemCatalog.itemByID(ctx, r.q, id)
 }
 
 func upsertUserPlatformLinks(ctx context.Context, q *generated.Queries, user *domain.User, userUUID uuid.UUID) error {
@@ -361,6 +318,7 @@ func upsertUserPlatformLinks(ctx context.Context, q *generated.Queries, user *do
 				String: platformUsername,
 				Valid:  platformUsername != "",
 			},
+			CommunityID: community.FromContext(ctx),
 		})
 		if err != nil {
 			return fmt.Errorf("failed to upsert link for %s: %w", platformName, err)
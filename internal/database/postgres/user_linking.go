@@ -2,14 +2,17 @@ package postgres
 
 import (
 	"context"
-	"encoding/json"
+	"errors"
 	"fmt"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgtype"
 
+	"github.com/osse101/BrandishBot_Go/internal/community"
 	"github.com/osse101/BrandishBot_Go/internal/database/generated"
 	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/event"
 )
 
 // GetUserByID retrieves a user by internal ID with all linked platform IDs
@@ -21,7 +24,10 @@ func (r *UserRepository) GetUserByID(ctx context.Context, userID string) (*domai
 
 	row, err := r.q.GetUserByID(ctx, userUUID)
 	if err != nil {
-		return nil, fmt.Errorf("user not found: %w", err)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("%w: %w", domain.ErrUserNotFound, err)
+		}
+		return nil, fmt.Errorf("failed to get user: %w", err)
 	}
 
 	user := domain.User{
@@ -97,6 +103,7 @@ func (r *UserRepository) UpdateUser(ctx context.Context, user domain.User) error
 				String: platformUsername,
 				Valid:  platformUsername != "",
 			},
+			CommunityID: community.FromContext(ctx),
 		})
 	}
 
@@ -164,6 +171,7 @@ func (r *UserRepository) MergeUsersInTransaction(ctx context.Context, primaryUse
 				String: platformUsername,
 				Valid:  platformUsername != "",
 			},
+			CommunityID: community.FromContext(ctx),
 		})
 	}
 
@@ -178,20 +186,16 @@ func (r *UserRepository) MergeUsersInTransaction(ctx context.Context, primaryUse
 	}
 
 	// 5. Update primary user's inventory with merged data
-	inventoryJSON, err := json.Marshal(mergedInventory)
-	if err != nil {
-		return fmt.Errorf("failed to marshal inventory: %w", err)
-	}
-
-	err = q.UpdateInventory(ctx, generated.UpdateInventoryParams{
-		UserID:        primUUID,
-		InventoryData: inventoryJSON,
-	})
-	if err != nil {
+	journal := r.inventory.begin()
+	if err := updateInventory(event.WithInventoryReason(ctx, InventorySourceMerge), q, primaryUserID, mergedInventory, journal); err != nil {
 		return fmt.Errorf("failed to update primary inventory: %w", err)
 	}
 
-	return tx.Commit(ctx)
+	if err := tx.Commit(ctx); err != nil {
+		return err
+	}
+	journal.flush(ctx)
+	return nil
 }
 
 // DeleteUser deletes a user by ID, including related data in a transaction
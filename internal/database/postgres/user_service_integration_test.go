@@ -15,6 +15,7 @@ import (
 	"github.com/osse101/BrandishBot_Go/internal/domain"
 	"github.com/osse101/BrandishBot_Go/internal/event"
 	"github.com/osse101/BrandishBot_Go/internal/lootbox"
+	"github.com/osse101/BrandishBot_Go/internal/naming"
 	"github.com/osse101/BrandishBot_Go/internal/search"
 	"github.com/osse101/BrandishBot_Go/internal/user"
 )
@@ -50,6 +51,26 @@ func (m *MockJobService) GetJobLevel(ctx context.Context, userID, jobKey string)
 	return 1, nil
 }
 
+func (m *MockJobService) GrantXPBoost(ctx context.Context, userID string, multiplier float64, duration time.Duration) error {
+	return nil
+}
+
+func (m *MockJobService) GetRecentXPAwards(ctx context.Context, userID string, limit int) ([]domain.XPAward, error) {
+	return nil, nil
+}
+
+func (m *MockJobService) SwitchActiveJob(ctx context.Context, platform, platformID, jobKey string) (*domain.UserJobInfo, error) {
+	return nil, nil
+}
+
+func (m *MockJobService) PrestigeJob(ctx context.Context, platform, platformID, jobKey string) (*domain.JobPrestigeResult, error) {
+	return nil, nil
+}
+
+func (m *MockJobService) GetJobPerks(ctx context.Context, jobKey, platform, platformID string) (*domain.JobPerks, error) {
+	return nil, nil
+}
+
 func (m *MockJobService) ResetDailyJobXP(ctx context.Context) (int64, error) {
 	return 0, nil
 }
@@ -84,10 +105,14 @@ func (m *MockJobService) Shutdown(ctx context.Context) error {
 
 type MockLootboxService struct{}
 
-func (m *MockLootboxService) OpenLootbox(ctx context.Context, lootboxName string, quantity int, boxQuality domain.QualityLevel) ([]lootbox.DroppedItem, error) {
+func (m *MockLootboxService) OpenLootbox(ctx context.Context, userID, lootboxName string, quantity int, boxQuality domain.QualityLevel) ([]lootbox.DroppedItem, error) {
 	return []lootbox.DroppedItem{}, nil
 }
 
+func (m *MockLootboxService) Reload(ctx context.Context) error {
+	return nil
+}
+
 type MockStatsService struct{}
 
 func (m *MockStatsService) RecordUserEvent(ctx context.Context, userID string, eventType domain.EventType, metadata interface{}) error {
@@ -102,12 +127,16 @@ func (m *MockStatsService) GetUserCurrentStreak(ctx context.Context, userID stri
 	return 0, nil
 }
 
+func (m *MockStatsService) GetUserRecipeCraftCounts(ctx context.Context, userID string) (map[string]int, error) {
+	return map[string]int{}, nil
+}
+
 func (m *MockStatsService) GetSystemStats(ctx context.Context, period string) (*domain.StatsSummary, error) {
 	return &domain.StatsSummary{EventCounts: make(map[domain.EventType]int)}, nil
 }
 
-func (m *MockStatsService) GetLeaderboard(ctx context.Context, eventType domain.EventType, period string, limit int) ([]domain.LeaderboardEntry, error) {
-	return []domain.LeaderboardEntry{}, nil
+func (m *MockStatsService) GetLeaderboard(ctx context.Context, query domain.LeaderboardQuery) (*domain.LeaderboardPage, error) {
+	return &domain.LeaderboardPage{}, nil
 }
 
 func (m *MockStatsService) GetUserSlotsStats(ctx context.Context, userID, period string) (*domain.SlotsStats, error) {
@@ -126,6 +155,14 @@ func (m *MockStatsService) GetSlotsLeaderboardByMegaJackpots(ctx context.Context
 	return nil, nil
 }
 
+func (m *MockStatsService) QueryStats(ctx context.Context, query domain.StatsQuery) (*domain.StatsQueryResult, error) {
+	return nil, nil
+}
+
+func (m *MockStatsService) RollUpDue(ctx context.Context) (int, error) {
+	return 0, nil
+}
+
 type MockNamingResolver struct{}
 
 func (m *MockNamingResolver) GetDisplayName(internalName string, qualityLevel domain.QualityLevel) string {
@@ -144,10 +181,22 @@ func (m *MockNamingResolver) ResolveInternalName(internalName string) (string, b
 	return internalName, true
 }
 
+func (m *MockNamingResolver) GetDisplayNameForTheme(internalName string, qualityLevel domain.QualityLevel, theme string) string {
+	return internalName
+}
+
 func (m *MockNamingResolver) GetActiveTheme() string {
 	return ""
 }
 
+func (m *MockNamingResolver) GetThemes() map[string]naming.ThemePeriod {
+	return nil
+}
+
+func (m *MockNamingResolver) SuggestNames(name string) []string {
+	return nil
+}
+
 func (m *MockNamingResolver) Reload() error {
 	return nil
 }
@@ -0,0 +1,88 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/osse101/BrandishBot_Go/internal/database/generated"
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/usersettings"
+)
+
+type userSettingsRepository struct {
+	q *generated.Queries
+}
+
+// NewUserSettingsRepository creates a new PostgreSQL user settings repository
+func NewUserSettingsRepository(pool *pgxpool.Pool) usersettings.Repository {
+	return &userSettingsRepository{q: generated.New(pool)}
+}
+
+// GetSettings returns a user's settings (the defaults if none are stored)
+func (r *userSettingsRepository) GetSettings(ctx context.Context, userID string) (*domain.UserSettings, error) {
+	userUUID, err := parseUserUUID(userID)
+	if err != nil {
+		return nil, err
+	}
+	row, err := r.q.GetUserSettings(ctx, userUUID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return &domain.UserSettings{}, nil
+		}
+		return nil, fmt.Errorf("failed to get user settings: %w", err)
+	}
+	return &domain.UserSettings{
+		LeaderboardPrivate: row.LeaderboardPrivate,
+		TargetingOptOut:    row.TargetingOptOut,
+		NameTheme:          row.NameTheme,
+	}, nil
+}
+
+// SetLeaderboardPrivate hides or shows the user on public rankings
+func (r *userSettingsRepository) SetLeaderboardPrivate(ctx context.Context, userID string, private bool) error {
+	userUUID, err := parseUserUUID(userID)
+	if err != nil {
+		return err
+	}
+	if err := r.q.UpsertUserLeaderboardPrivate(ctx, generated.UpsertUserLeaderboardPrivateParams{
+		UserID:             userUUID,
+		LeaderboardPrivate: private,
+	}); err != nil {
+		return fmt.Errorf("failed to set leaderboard privacy: %w", err)
+	}
+	return nil
+}
+
+// SetTargetingOptOut opts the user out of targeted items, or back in
+func (r *userSettingsRepository) SetTargetingOptOut(ctx context.Context, userID string, optOut bool) error {
+	userUUID, err := parseUserUUID(userID)
+	if err != nil {
+		return err
+	}
+	if err := r.q.UpsertUserTargetingOptOut(ctx, generated.UpsertUserTargetingOptOutParams{
+		UserID:          userUUID,
+		TargetingOptOut: optOut,
+	}); err != nil {
+		return fmt.Errorf("failed to set targeting opt-out: %w", err)
+	}
+	return nil
+}
+
+// SetNameTheme stores the item name theme the user picked
+func (r *userSettingsRepository) SetNameTheme(ctx context.Context, userID, theme string) error {
+	userUUID, err := parseUserUUID(userID)
+	if err != nil {
+		return err
+	}
+	if err := r.q.UpsertUserNameTheme(ctx, generated.UpsertUserNameThemeParams{
+		UserID:    userUUID,
+		NameTheme: theme,
+	}); err != nil {
+		return fmt.Errorf("failed to set name theme: %w", err)
+	}
+	return nil
+}
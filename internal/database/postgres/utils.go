@@ -14,6 +14,7 @@ import (
 
 	"github.com/osse101/BrandishBot_Go/internal/database/generated"
 	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/event"
 	"github.com/osse101/BrandishBot_Go/internal/logger"
 )
 
@@ -152,7 +153,9 @@ func getInventory(ctx context.Context, q *generated.Queries, userID string) (*do
 	return getInventoryInternal(ctx, q, userID, false)
 }
 
-// getInventoryForUpdate retrieves inventory with row locking (shared helper)
+// getInventoryForUpdate retrieves inventory with row locking (shared helper).
+// It must run inside a transaction: the row stays locked until commit or
+// rollback, so concurrent read-modify-write cycles on one inventory serialize.
 func getInventoryForUpdate(ctx context.Context, q *generated.Queries, userID string) (*domain.Inventory, error) {
 	return getInventoryInternal(ctx, q, userID, true)
 }
@@ -166,6 +169,15 @@ func getInventoryInternal(ctx context.Context, q *generated.Queries, userID stri
 	var inventoryData []byte
 	var fetchErr error
 	if forUpdate {
+		// FOR UPDATE locks nothing when the row is missing, so two first-time
+		// writers could both start from an empty inventory. Create the row
+		// first; a concurrent insert makes this wait for the other transaction.
+		if err := q.EnsureInventoryRow(ctx, generated.EnsureInventoryRowParams{
+			UserID:        userUUID,
+			InventoryData: []byte(EmptyInventoryJSON),
+		}); err != nil {
+			return nil, fmt.Errorf("%s: %w", ErrMsgFailedToEnsureInventoryRow, err)
+		}
 		inventoryData, fetchErr = q.GetInventoryForUpdate(ctx, userUUID)
 	} else {
 		inventoryData, fetchErr = q.GetInventory(ctx, userUUID)
@@ -194,13 +206,21 @@ func getInventoryInternal(ctx context.Context, q *generated.Queries, userID stri
 	return &inventory, nil
 }
 
-// updateInventory updates inventory (shared helper)
-func updateInventory(ctx context.Context, q *generated.Queries, userID string, inventory domain.Inventory) error {
+// updateInventory updates inventory (shared helper). When journal is set the
+// stored inventory is read first so the write can be recorded as a diff.
+func updateInventory(ctx context.Context, q *generated.Queries, userID string, inventory domain.Inventory, journal *inventoryJournal) error {
 	userUUID, err := uuid.Parse(userID)
 	if err != nil {
 		return fmt.Errorf("invalid user id: %w", err)
 	}
 
+	var before *domain.Inventory
+	if journal.enabled() {
+		if before, err = getInventory(ctx, q, userID); err != nil {
+			return err
+		}
+	}
+
 	inventoryJSON, err := json.Marshal(inventory)
 	if err != nil {
 		return fmt.Errorf("failed to marshal inventory: %w", err)
@@ -213,9 +233,49 @@ func updateInventory(ctx context.Context, q *generated.Queries, userID string, i
 	if err != nil {
 		return fmt.Errorf("failed to update inventory: %w", err)
 	}
+	journal.record(ctx, userID, diffInventories(before, &inventory))
 	return nil
 }
 
+// adjustItemQuantity applies delta to one (item, quality) slot without reading
+// the inventory into Go, and returns the slot's new quantity. A delta that would
+// take the slot below zero changes nothing and returns domain.ErrInsufficientQuantity.
+func adjustItemQuantity(ctx context.Context, q *generated.Queries, userID string, itemID int, quality domain.QualityLevel, delta int, journal *inventoryJournal) (int, error) {
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		return 0, fmt.Errorf("invalid user id: %w", err)
+	}
+
+	if err := q.EnsureInventoryRow(ctx, generated.EnsureInventoryRowParams{
+		UserID:        userUUID,
+		InventoryData: []byte(EmptyInventoryJSON),
+	}); err != nil {
+		return 0, fmt.Errorf("%s: %w", ErrMsgFailedToEnsureInventoryRow, err)
+	}
+
+	quantity, err := q.AdjustInventorySlot(ctx, generated.AdjustInventorySlotParams{
+		ItemID:  int32(itemID),
+		Quality: string(quality),
+		Delta:   int32(delta),
+		UserID:  userUUID,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return 0, domain.ErrInsufficientQuantity
+		}
+		return 0, fmt.Errorf("failed to adjust inventory: %w", err)
+	}
+	if delta != 0 {
+		journal.record(ctx, userID, []event.InventoryChangeV1{{
+			ItemID:   itemID,
+			Quality:  string(quality),
+			Delta:    delta,
+			Quantity: int(quantity),
+		}})
+	}
+	return int(quantity), nil
+}
+
 // strToText converts a string to pgtype.Text
 func strToText(s string) pgtype.Text {
 	if s == "" {
@@ -0,0 +1,179 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/osse101/BrandishBot_Go/internal/brigade"
+	"github.com/osse101/BrandishBot_Go/internal/community"
+	"github.com/osse101/BrandishBot_Go/internal/database/generated"
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+)
+
+type voteReviewRepository struct {
+	pool *pgxpool.Pool
+	q    *generated.Queries
+}
+
+// NewVoteReviewRepository creates a new PostgreSQL vote brigading review repository
+func NewVoteReviewRepository(pool *pgxpool.Pool) brigade.Repository {
+	return &voteReviewRepository{pool: pool, q: generated.New(pool)}
+}
+
+// GetOpenSessionID returns the voting or frozen session, or 0 if there is none
+func (r *voteReviewRepository) GetOpenSessionID(ctx context.Context) (int, error) {
+	row, err := r.q.GetActiveOrFrozenSession(ctx, community.FromContext(ctx))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return 0, nil
+		}
+		return 0, fmt.Errorf("failed to get open session: %w", err)
+	}
+	return int(row.ID), nil
+}
+
+// GetSessionVotes returns every vote in a session in the order cast
+func (r *voteReviewRepository) GetSessionVotes(ctx context.Context, sessionID int) ([]domain.SessionVote, error) {
+	rows, err := r.q.GetSessionVoteActivity(ctx, int32(sessionID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get session votes: %w", err)
+	}
+	votes := make([]domain.SessionVote, 0, len(rows))
+	for _, row := range rows {
+		votes = append(votes, domain.SessionVote{
+			UserID:        row.UserID,
+			OptionID:      int(row.OptionID.Int32),
+			VotedAt:       row.VotedAt.Time,
+			NeverActive:   row.NeverActive,
+			Flagged:       row.SuspectReason.Valid,
+			Excluded:      row.ExcludedAt.Valid,
+			SuspectReason: row.SuspectReason.String,
+		})
+	}
+	return votes, nil
+}
+
+// FlagSuspectVote marks a vote for review unless it is already flagged
+func (r *voteReviewRepository) FlagSuspectVote(ctx context.Context, sessionID int, userID, reason string) (bool, error) {
+	rows, err := r.q.FlagSuspectVote(ctx, generated.FlagSuspectVoteParams{
+		SessionID:     int32(sessionID),
+		UserID:        userID,
+		SuspectReason: pgtype.Text{String: reason, Valid: true},
+	})
+	if err != nil {
+		return false, fmt.Errorf("failed to flag vote: %w", err)
+	}
+	return rows > 0, nil
+}
+
+// GetSuspectVotes returns the flagged votes in a session
+func (r *voteReviewRepository) GetSuspectVotes(ctx context.Context, sessionID int) ([]domain.SuspectVote, error) {
+	rows, err := r.q.GetSuspectVotes(ctx, int32(sessionID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get suspect votes: %w", err)
+	}
+	votes := make([]domain.SuspectVote, 0, len(rows))
+	for _, row := range rows {
+		vote := domain.SuspectVote{
+			SessionID: sessionID,
+			UserID:    row.UserID,
+			Username:  row.Username.String,
+			OptionID:  int(row.OptionID.Int32),
+			NodeKey:   row.NodeKey.String,
+			VotedAt:   row.VotedAt.Time,
+			Reason:    row.SuspectReason.String,
+			FlaggedAt: row.FlaggedAt.Time,
+		}
+		if row.ExcludedAt.Valid {
+			excludedAt := row.ExcludedAt.Time
+			vote.ExcludedAt = &excludedAt
+		}
+		votes = append(votes, vote)
+	}
+	return votes, nil
+}
+
+// SetVoteExcluded excludes or restores a vote, adjusting the option's vote
+// count and writing the audit entry in the same transaction
+func (r *voteReviewRepository) SetVoteExcluded(ctx context.Context, sessionID int, userID string, action domain.VoteReviewAction, actor, reason string) (bool, error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer SafeRollback(ctx, tx)
+
+	q := r.q.WithTx(tx)
+	params := generated.ExcludeUserVoteParams{SessionID: int32(sessionID), UserID: userID}
+	var optionID pgtype.Int4
+	var delta int32
+	switch action {
+	case domain.VoteReviewExcluded:
+		var row generated.ExcludeUserVoteRow
+		row, err = q.ExcludeUserVote(ctx, params)
+		optionID, delta = row.OptionID, -row.Weight
+	case domain.VoteReviewRestored:
+		var row generated.RestoreUserVoteRow
+		row, err = q.RestoreUserVote(ctx, generated.RestoreUserVoteParams(params))
+		optionID, delta = row.OptionID, row.Weight
+	default:
+		return false, fmt.Errorf("%w: unknown vote review action %q", domain.ErrInvalidInput, action)
+	}
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to update vote: %w", err)
+	}
+
+	if optionID.Valid {
+		if err := q.AdjustOptionVoteCount(ctx, generated.AdjustOptionVoteCountParams{
+			Delta: delta,
+			ID:    optionID.Int32,
+		}); err != nil {
+			return false, fmt.Errorf("failed to adjust vote count: %w", err)
+		}
+	}
+
+	if err := q.InsertVoteReviewAudit(ctx, generated.InsertVoteReviewAuditParams{
+		SessionID: int32(sessionID),
+		UserID:    userID,
+		OptionID:  optionID,
+		Action:    string(action),
+		Actor:     actor,
+		Reason:    reason,
+	}); err != nil {
+		return false, fmt.Errorf("failed to record vote review: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return false, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return true, nil
+}
+
+// GetAuditTrail returns every exclusion and restoration in a session, oldest first
+func (r *voteReviewRepository) GetAuditTrail(ctx context.Context, sessionID int) ([]domain.VoteReviewAuditEntry, error) {
+	rows, err := r.q.GetVoteReviewAudit(ctx, int32(sessionID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get vote review audit: %w", err)
+	}
+	entries := make([]domain.VoteReviewAuditEntry, 0, len(rows))
+	for _, row := range rows {
+		entries = append(entries, domain.VoteReviewAuditEntry{
+			ID:        row.ID,
+			SessionID: int(row.SessionID),
+			UserID:    row.UserID,
+			OptionID:  int(row.OptionID.Int32),
+			Action:    domain.VoteReviewAction(row.Action),
+			Actor:     row.Actor,
+			Reason:    row.Reason,
+			CreatedAt: row.CreatedAt.Time,
+		})
+	}
+	return entries, nil
+}
@@ -0,0 +1,171 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/osse101/BrandishBot_Go/internal/database/generated"
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/webhook"
+)
+
+type webhookRepository struct {
+	q *generated.Queries
+}
+
+// NewWebhookRepository creates a new PostgreSQL webhook repository
+func NewWebhookRepository(pool *pgxpool.Pool) webhook.Repository {
+	return &webhookRepository{q: generated.New(pool)}
+}
+
+// CreateWebhook stores a webhook with its signing secret
+func (r *webhookRepository) CreateWebhook(ctx context.Context, hook domain.Webhook, secret string) (*domain.Webhook, error) {
+	row, err := r.q.CreateWebhook(ctx, generated.CreateWebhookParams{
+		Url:         hook.URL,
+		Secret:      secret,
+		EventTypes:  hook.EventTypes,
+		Description: hook.Description,
+		CreatedBy:   hook.CreatedBy,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create webhook: %w", err)
+	}
+	created := mapWebhook(row)
+	return &created, nil
+}
+
+// ListWebhooks returns every webhook, oldest first
+func (r *webhookRepository) ListWebhooks(ctx context.Context) ([]domain.Webhook, error) {
+	rows, err := r.q.ListWebhooks(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list webhooks: %w", err)
+	}
+	hooks := make([]domain.Webhook, 0, len(rows))
+	for _, row := range rows {
+		hooks = append(hooks, mapWebhook(row))
+	}
+	return hooks, nil
+}
+
+// DeleteWebhook deletes a webhook; its deliveries cascade
+func (r *webhookRepository) DeleteWebhook(ctx context.Context, id int64) (bool, error) {
+	deleted, err := r.q.DeleteWebhook(ctx, id)
+	if err != nil {
+		return false, fmt.Errorf("failed to delete webhook: %w", err)
+	}
+	return deleted > 0, nil
+}
+
+// WebhookExists reports whether a webhook with the ID exists
+func (r *webhookRepository) WebhookExists(ctx context.Context, id int64) (bool, error) {
+	exists, err := r.q.WebhookExists(ctx, id)
+	if err != nil {
+		return false, fmt.Errorf("failed to check webhook: %w", err)
+	}
+	return exists, nil
+}
+
+// EnqueueDeliveries queues body for every webhook subscribed to eventType
+func (r *webhookRepository) EnqueueDeliveries(ctx context.Context, eventType string, body []byte) (int, error) {
+	queued, err := r.q.EnqueueWebhookDeliveries(ctx, generated.EnqueueWebhookDeliveriesParams{
+		EventType: eventType,
+		Body:      body,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to enqueue webhook deliveries: %w", err)
+	}
+	return int(queued), nil
+}
+
+// ClaimDueDeliveries leases due deliveries until leaseUntil
+func (r *webhookRepository) ClaimDueDeliveries(ctx context.Context, now, leaseUntil time.Time, limit int) ([]webhook.PendingDelivery, error) {
+	rows, err := r.q.ClaimDueWebhookDeliveries(ctx, generated.ClaimDueWebhookDeliveriesParams{
+		LeaseUntil: pgtype.Timestamptz{Time: leaseUntil, Valid: true},
+		Now:        pgtype.Timestamptz{Time: now, Valid: true},
+		BatchSize:  int32(limit),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to claim webhook deliveries: %w", err)
+	}
+	deliveries := make([]webhook.PendingDelivery, 0, len(rows))
+	for _, row := range rows {
+		deliveries = append(deliveries, webhook.PendingDelivery{
+			ID:        row.ID,
+			WebhookID: row.WebhookID,
+			EventType: row.EventType,
+			Body:      row.Body,
+			Attempts:  int(row.Attempts),
+			URL:       row.Url,
+			Secret:    row.Secret,
+		})
+	}
+	return deliveries, nil
+}
+
+// RecordAttempt stores the outcome of a delivery attempt
+func (r *webhookRepository) RecordAttempt(ctx context.Context, id int64, result webhook.AttemptResult) error {
+	err := r.q.RecordWebhookDeliveryAttempt(ctx, generated.RecordWebhookDeliveryAttemptParams{
+		ID:             id,
+		Status:         string(result.Status),
+		NextAttemptAt:  pgtype.Timestamptz{Time: result.NextAttemptAt, Valid: true},
+		LastStatusCode: pgtype.Int4{Int32: int32(result.StatusCode), Valid: result.StatusCode != 0},
+		LastError:      pgtype.Text{String: result.Error, Valid: result.Error != ""},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to record webhook delivery attempt: %w", err)
+	}
+	return nil
+}
+
+// ListDeliveries returns a webhook's most recent deliveries
+func (r *webhookRepository) ListDeliveries(ctx context.Context, webhookID int64, limit int) ([]domain.WebhookDelivery, error) {
+	rows, err := r.q.ListWebhookDeliveries(ctx, generated.ListWebhookDeliveriesParams{
+		WebhookID:  webhookID,
+		MaxResults: int32(limit),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list webhook deliveries: %w", err)
+	}
+	deliveries := make([]domain.WebhookDelivery, 0, len(rows))
+	for _, row := range rows {
+		deliveries = append(deliveries, mapWebhookDelivery(row))
+	}
+	return deliveries, nil
+}
+
+func mapWebhook(row generated.Webhook) domain.Webhook {
+	return domain.Webhook{
+		ID:          row.ID,
+		URL:         row.Url,
+		EventTypes:  row.EventTypes,
+		Description: row.Description,
+		CreatedBy:   row.CreatedBy,
+		CreatedAt:   row.CreatedAt.Time,
+	}
+}
+
+func mapWebhookDelivery(row generated.WebhookDelivery) domain.WebhookDelivery {
+	delivery := domain.WebhookDelivery{
+		ID:             row.ID,
+		WebhookID:      row.WebhookID,
+		EventType:      row.EventType,
+		Status:         domain.WebhookDeliveryStatus(row.Status),
+		Attempts:       int(row.Attempts),
+		LastStatusCode: int(row.LastStatusCode.Int32),
+		LastError:      row.LastError.String,
+		CreatedAt:      row.CreatedAt.Time,
+	}
+	if delivery.Status == domain.WebhookDeliveryPending && row.NextAttemptAt.Valid {
+		next := row.NextAttemptAt.Time
+		delivery.NextAttemptAt = &next
+	}
+	if row.DeliveredAt.Valid {
+		delivered := row.DeliveredAt.Time
+		delivery.DeliveredAt = &delivered
+	}
+	return delivery
+}
@@ -0,0 +1,29 @@
+-- name: CreateAnnouncementRoute :one
+INSERT INTO announcement_routes (event_type, destination, target, template, enabled, created_by)
+VALUES ($1, $2, $3, $4, $5, $6)
+RETURNING id, event_type, destination, target, template, enabled, created_by, created_at, updated_at;
+
+-- name: ListAnnouncementRoutes :many
+SELECT id, event_type, destination, target, template, enabled, created_by, created_at, updated_at
+FROM announcement_routes
+ORDER BY id;
+
+-- name: ListEnabledAnnouncementRoutesForEvent :many
+SELECT id, event_type, destination, target, template, enabled, created_by, created_at, updated_at
+FROM announcement_routes
+WHERE event_type = $1 AND enabled
+ORDER BY id;
+
+-- name: UpdateAnnouncementRoute :one
+UPDATE announcement_routes
+SET event_type = $2,
+    destination = $3,
+    target = $4,
+    template = $5,
+    enabled = $6,
+    updated_at = NOW()
+WHERE id = $1
+RETURNING id, event_type, destination, target, template, enabled, created_by, created_at, updated_at;
+
+-- name: DeleteAnnouncementRoute :execrows
+DELETE FROM announcement_routes WHERE id = $1;
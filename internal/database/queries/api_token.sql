@@ -0,0 +1,30 @@
+-- name: CreateAPIToken :one
+INSERT INTO api_tokens (guild_id, name, token_hash, token_prefix, created_by)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING id, guild_id, name, token_hash, token_prefix, created_by, created_at, last_used_at, revoked_at;
+
+-- name: GetActiveAPITokenByHash :one
+SELECT id, guild_id, name, token_hash, token_prefix, created_by, created_at, last_used_at, revoked_at
+FROM api_tokens
+WHERE token_hash = $1 AND revoked_at IS NULL;
+
+-- name: ListAPITokens :many
+-- Newest first; an empty guild ID lists every guild's tokens
+SELECT id, guild_id, name, token_hash, token_prefix, created_by, created_at, last_used_at, revoked_at
+FROM api_tokens
+WHERE (sqlc.arg(guild_id)::text = '' OR guild_id = sqlc.arg(guild_id)::text)
+ORDER BY created_at DESC, id DESC;
+
+-- name: CountActiveAPITokens :one
+SELECT COUNT(*)::int FROM api_tokens
+WHERE guild_id = $1 AND revoked_at IS NULL;
+
+-- name: RevokeAPIToken :execrows
+UPDATE api_tokens
+SET revoked_at = NOW()
+WHERE id = $1 AND revoked_at IS NULL;
+
+-- name: TouchAPIToken :exec
+UPDATE api_tokens
+SET last_used_at = NOW()
+WHERE id = $1;
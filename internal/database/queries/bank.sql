@@ -0,0 +1,62 @@
+-- name: GetBankAccount :one
+SELECT user_id, balance, goal, goal_set_at, goals_reached, interest_earned, last_interest_at, withdrawn_today, withdrawn_on, created_at, updated_at, goal_start
+FROM bank_accounts
+WHERE user_id = $1;
+
+-- name: EnsureBankAccount :exec
+INSERT INTO bank_accounts (user_id)
+VALUES ($1)
+ON CONFLICT (user_id) DO NOTHING;
+
+-- name: GetBankAccountForUpdate :one
+SELECT user_id, balance, goal, goal_set_at, goals_reached, interest_earned, last_interest_at, withdrawn_today, withdrawn_on, created_at, updated_at, goal_start
+FROM bank_accounts
+WHERE user_id = $1
+FOR UPDATE;
+
+-- name: DepositToBank :one
+UPDATE bank_accounts
+SET balance = balance + sqlc.arg(amount), updated_at = NOW()
+WHERE user_id = sqlc.arg(user_id)
+RETURNING user_id, balance, goal, goal_set_at, goals_reached, interest_earned, last_interest_at, withdrawn_today, withdrawn_on, created_at, updated_at, goal_start;
+
+-- name: WithdrawFromBank :one
+UPDATE bank_accounts
+SET balance = balance - sqlc.arg(amount),
+    withdrawn_today = CASE WHEN withdrawn_on = sqlc.arg(today)::date THEN withdrawn_today ELSE 0 END + sqlc.arg(amount),
+    withdrawn_on = sqlc.arg(today)::date,
+    updated_at = NOW()
+WHERE user_id = sqlc.arg(user_id)
+RETURNING user_id, balance, goal, goal_set_at, goals_reached, interest_earned, last_interest_at, withdrawn_today, withdrawn_on, created_at, updated_at, goal_start;
+
+-- name: SetBankGoal :one
+UPDATE bank_accounts
+SET goal = sqlc.narg(goal),
+    goal_set_at = COALESCE(sqlc.narg(goal_set_at), goal_set_at),
+    goal_start = CASE WHEN sqlc.narg(goal)::bigint IS NULL THEN NULL ELSE balance END,
+    updated_at = NOW()
+WHERE user_id = sqlc.arg(user_id)
+RETURNING user_id, balance, goal, goal_set_at, goals_reached, interest_earned, last_interest_at, withdrawn_today, withdrawn_on, created_at, updated_at, goal_start;
+
+-- name: CompleteBankGoal :one
+UPDATE bank_accounts
+SET balance = balance + sqlc.arg(bonus), goal = NULL, goal_start = NULL, goals_reached = goals_reached + 1, updated_at = NOW()
+WHERE user_id = sqlc.arg(user_id)
+RETURNING user_id, balance, goal, goal_set_at, goals_reached, interest_earned, last_interest_at, withdrawn_today, withdrawn_on, created_at, updated_at, goal_start;
+
+-- name: ApplyBankInterest :many
+WITH due AS (
+    SELECT user_id, LEAST(FLOOR(balance * sqlc.arg(rate)::float8)::bigint, sqlc.arg(max_interest)::bigint) AS interest
+    FROM bank_accounts
+    WHERE balance > 0
+      AND (last_interest_at IS NULL OR last_interest_at < sqlc.arg(paid_before))
+    FOR UPDATE
+)
+UPDATE bank_accounts b
+SET balance = b.balance + due.interest,
+    interest_earned = b.interest_earned + due.interest,
+    last_interest_at = sqlc.arg(paid_at),
+    updated_at = NOW()
+FROM due
+WHERE b.user_id = due.user_id AND due.interest > 0
+RETURNING b.user_id, due.interest, b.balance, b.goal;
@@ -0,0 +1,49 @@
+-- name: CreateBossFight :execrows
+INSERT INTO boss_fights (id, community_id, boss_key, name, max_hp, hp, reward_lootbox, reward_boxes, spawned_by, spawned_at, expires_at)
+VALUES ($1, $2, $3, $4, $5, $5, $6, $7, $8, $9, $10)
+ON CONFLICT (community_id) WHERE state = 'active' DO NOTHING;
+
+-- name: GetActiveBossFight :one
+SELECT id, community_id, boss_key, name, max_hp, hp, reward_lootbox, reward_boxes, state, spawned_by, spawned_at, expires_at, ended_at
+FROM boss_fights
+WHERE community_id = $1 AND state = 'active';
+
+-- name: LockActiveBossFight :one
+SELECT id, community_id, boss_key, name, max_hp, hp, reward_lootbox, reward_boxes, state, spawned_by, spawned_at, expires_at, ended_at
+FROM boss_fights
+WHERE community_id = $1 AND state = 'active'
+FOR UPDATE;
+
+-- name: DamageBossFight :one
+UPDATE boss_fights
+SET hp = GREATEST(hp - sqlc.arg(damage), 0)
+WHERE id = sqlc.arg(id)
+RETURNING id, community_id, boss_key, name, max_hp, hp, reward_lootbox, reward_boxes, state, spawned_by, spawned_at, expires_at, ended_at;
+
+-- name: EndBossFight :exec
+UPDATE boss_fights SET state = $2, ended_at = NOW() WHERE id = $1;
+
+-- name: ExpireBossFight :one
+UPDATE boss_fights
+SET state = 'escaped', ended_at = NOW()
+WHERE id = $1 AND state = 'active' AND expires_at <= NOW()
+RETURNING id, community_id, boss_key, name, max_hp, hp, reward_lootbox, reward_boxes, state, spawned_by, spawned_at, expires_at, ended_at;
+
+-- name: UpsertBossAttacker :exec
+INSERT INTO boss_attackers (boss_id, user_id, damage, attacks, last_attack_at)
+VALUES ($1, $2, $3, 1, NOW())
+ON CONFLICT (boss_id, user_id) DO UPDATE
+SET damage = boss_attackers.damage + EXCLUDED.damage,
+    attacks = boss_attackers.attacks + 1,
+    last_attack_at = NOW();
+
+-- name: ListBossAttackers :many
+SELECT a.user_id, u.username, a.damage, a.attacks, a.reward_boxes
+FROM boss_attackers a
+JOIN users u ON u.user_id = a.user_id
+WHERE a.boss_id = $1
+ORDER BY a.damage DESC, a.last_attack_at ASC
+LIMIT $2;
+
+-- name: SetBossAttackerRewards :exec
+UPDATE boss_attackers SET reward_boxes = $3 WHERE boss_id = $1 AND user_id = $2;
@@ -0,0 +1,58 @@
+-- Votes in a session, with whether the voter had any recorded activity
+-- before the session started.
+-- name: GetSessionVoteActivity :many
+SELECT v.user_id, v.option_id, v.voted_at, v.suspect_reason, v.excluded_at,
+       NOT EXISTS (
+           SELECT 1 FROM stats_events e
+           WHERE e.user_id::text = v.user_id AND e.created_at < s.started_at
+       ) AS never_active
+FROM user_votes v
+JOIN progression_voting_sessions s ON s.id = v.session_id
+WHERE v.session_id = $1
+ORDER BY v.voted_at, v.user_id;
+
+-- name: FlagSuspectVote :execrows
+UPDATE user_votes
+SET suspect_reason = $3, flagged_at = NOW()
+WHERE session_id = $1 AND user_id = $2 AND suspect_reason IS NULL;
+
+-- name: GetSuspectVotes :many
+SELECT v.user_id, u.username, v.option_id, n.node_key, v.voted_at,
+       v.suspect_reason, v.flagged_at, v.excluded_at
+FROM user_votes v
+LEFT JOIN users u ON u.user_id::text = v.user_id
+LEFT JOIN progression_nodes n ON n.id = v.node_id
+WHERE v.session_id = $1 AND v.suspect_reason IS NOT NULL
+ORDER BY v.voted_at, v.user_id;
+
+-- Only votes in open sessions can be excluded, so a closed tally never changes.
+-- name: ExcludeUserVote :one
+UPDATE user_votes v
+SET excluded_at = NOW()
+FROM progression_voting_sessions s
+WHERE s.id = v.session_id AND s.status IN ('voting', 'frozen')
+  AND v.session_id = $1 AND v.user_id = $2 AND v.excluded_at IS NULL
+RETURNING v.option_id, v.weight;
+
+-- name: RestoreUserVote :one
+UPDATE user_votes v
+SET excluded_at = NULL
+FROM progression_voting_sessions s
+WHERE s.id = v.session_id AND s.status IN ('voting', 'frozen')
+  AND v.session_id = $1 AND v.user_id = $2 AND v.excluded_at IS NOT NULL
+RETURNING v.option_id, v.weight;
+
+-- name: AdjustOptionVoteCount :exec
+UPDATE progression_voting_options
+SET vote_count = GREATEST(vote_count + sqlc.arg(delta)::int, 0)
+WHERE id = sqlc.arg(id);
+
+-- name: InsertVoteReviewAudit :exec
+INSERT INTO vote_review_audit (session_id, user_id, option_id, action, actor, reason)
+VALUES ($1, $2, $3, $4, $5, $6);
+
+-- name: GetVoteReviewAudit :many
+SELECT id, session_id, user_id, option_id, action, actor, reason, created_at
+FROM vote_review_audit
+WHERE session_id = $1
+ORDER BY created_at, id;
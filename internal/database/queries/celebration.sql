@@ -0,0 +1,49 @@
+-- name: UpsertUserBirthday :exec
+INSERT INTO user_birthdays (user_id, birth_month, birth_day, updated_at)
+VALUES ($1, $2, $3, NOW())
+ON CONFLICT (user_id) DO UPDATE
+SET birth_month = EXCLUDED.birth_month,
+    birth_day = EXCLUDED.birth_day,
+    updated_at = NOW();
+
+-- name: DeleteUserBirthday :execrows
+DELETE FROM user_birthdays WHERE user_id = $1;
+
+-- name: GetUserBirthday :one
+SELECT birth_month, birth_day FROM user_birthdays WHERE user_id = $1;
+
+-- name: ListBirthdayUsers :many
+SELECT u.user_id, u.username
+FROM user_birthdays b
+JOIN users u ON u.user_id = b.user_id
+WHERE b.birth_month = $1 AND b.birth_day = $2
+ORDER BY u.user_id;
+
+-- Users registered on this month and day of an earlier year
+-- name: ListAnniversaryUsers :many
+SELECT user_id, username, created_at
+FROM users
+WHERE EXTRACT(MONTH FROM created_at) = sqlc.arg(month)::int
+  AND EXTRACT(DAY FROM created_at) = sqlc.arg(day)::int
+  AND created_at < sqlc.arg(registered_before)::timestamp
+ORDER BY user_id;
+
+-- Affects no rows when the user already received this celebration this year
+-- name: ClaimCelebrationGrant :execrows
+INSERT INTO celebration_grants (user_id, kind, year)
+VALUES ($1, $2, $3)
+ON CONFLICT (user_id, kind, year) DO NOTHING;
+
+-- name: ReleaseCelebrationGrant :exec
+DELETE FROM celebration_grants
+WHERE user_id = $1 AND kind = $2 AND year = $3;
+
+-- name: GetCelebrationGuild :one
+SELECT enabled FROM celebration_guilds WHERE guild_id = $1;
+
+-- name: UpsertCelebrationGuild :exec
+INSERT INTO celebration_guilds (guild_id, enabled, updated_at)
+VALUES ($1, $2, NOW())
+ON CONFLICT (guild_id) DO UPDATE
+SET enabled = EXCLUDED.enabled,
+    updated_at = NOW();
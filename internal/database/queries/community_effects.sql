@@ -0,0 +1,26 @@
+-- Grants a community-wide effect. While one of the same type is active the
+-- stronger magnitude is kept and the duration is added to the current
+-- expiry; an expired effect is replaced.
+-- name: GrantCommunityEffect :one
+INSERT INTO community_effects (community_id, effect_type, magnitude, source, expires_at)
+VALUES (sqlc.arg(community_id), sqlc.arg(effect_type), sqlc.arg(magnitude), sqlc.arg(source), NOW() + (sqlc.arg(duration_ms)::bigint * INTERVAL '1 millisecond'))
+ON CONFLICT (community_id, effect_type) DO UPDATE
+SET magnitude = CASE WHEN community_effects.expires_at > NOW()
+                     THEN GREATEST(community_effects.magnitude, EXCLUDED.magnitude)
+                     ELSE EXCLUDED.magnitude END,
+    source = EXCLUDED.source,
+    expires_at = GREATEST(community_effects.expires_at, NOW()) + (sqlc.arg(duration_ms)::bigint * INTERVAL '1 millisecond'),
+    created_at = CASE WHEN community_effects.expires_at > NOW()
+                      THEN community_effects.created_at
+                      ELSE NOW() END
+RETURNING community_id, effect_type, magnitude, source, expires_at, created_at;
+
+-- name: ListActiveCommunityEffects :many
+SELECT community_id, effect_type, magnitude, source, expires_at, created_at
+FROM community_effects
+WHERE community_id = $1 AND expires_at > NOW()
+ORDER BY expires_at;
+
+-- name: DeleteExpiredCommunityEffects :execrows
+DELETE FROM community_effects
+WHERE expires_at <= sqlc.arg(now);
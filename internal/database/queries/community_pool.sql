@@ -0,0 +1,27 @@
+-- name: InsertCommunityDonation :exec
+INSERT INTO community_donations (user_id, item_id, quantity, value, points, community_id)
+VALUES ($1, $2, $3, $4, $5, $6);
+
+-- name: GetCommunityDonationTotals :one
+SELECT
+    COALESCE(SUM(value), 0)::bigint AS total_value,
+    COALESCE(SUM(points), 0)::bigint AS total_points,
+    COUNT(DISTINCT user_id)::int AS donor_count
+FROM community_donations
+WHERE community_id = $1;
+
+-- name: GetTopCommunityDonors :many
+SELECT
+    d.user_id,
+    u.username,
+    SUM(d.value)::bigint AS total_value,
+    SUM(d.points)::bigint AS total_points,
+    COUNT(*)::int AS donations,
+    COALESCE(us.leaderboard_private, FALSE)::boolean AS is_private
+FROM community_donations d
+JOIN users u ON u.user_id = d.user_id
+LEFT JOIN user_settings us ON us.user_id = d.user_id
+WHERE d.community_id = $1
+GROUP BY d.user_id, u.username, us.leaderboard_private
+ORDER BY total_value DESC, d.user_id
+LIMIT $2;
@@ -0,0 +1,20 @@
+-- name: AddUserContributionScore :exec
+INSERT INTO user_contribution_scores (user_id, score, updated_at)
+VALUES ($1, $2, NOW())
+ON CONFLICT (user_id) DO UPDATE
+SET score = user_contribution_scores.score + EXCLUDED.score,
+    updated_at = NOW();
+
+-- name: GetUserContributionScore :one
+SELECT score FROM user_contribution_scores WHERE user_id = $1;
+
+-- name: GetAverageContributionScore :one
+SELECT COALESCE(AVG(score), 0)::float8 FROM user_contribution_scores;
+
+-- name: DecayContributionScores :execrows
+-- Keeps the given share of every score.
+UPDATE user_contribution_scores
+SET score = score * sqlc.arg(retained)::float8;
+
+-- name: DeleteContributionScoresBelow :execrows
+DELETE FROM user_contribution_scores WHERE score < $1;
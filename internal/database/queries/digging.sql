@@ -0,0 +1,31 @@
+-- name: GetDigSite :one
+SELECT depth, total_digs, milestone_level, updated_at FROM dig_site WHERE community_id = $1;
+
+-- Deepens the community's site, starting it on the first dig.
+-- name: DeepenDigSite :one
+INSERT INTO dig_site (community_id, depth, total_digs)
+VALUES (sqlc.arg(community_id), sqlc.arg(depth), 1)
+ON CONFLICT (community_id) DO UPDATE
+SET depth = dig_site.depth + EXCLUDED.depth,
+    total_digs = dig_site.total_digs + 1,
+    updated_at = NOW()
+RETURNING depth, total_digs, milestone_level, updated_at;
+
+-- name: SetDigSiteMilestoneLevel :exec
+UPDATE dig_site SET milestone_level = $1 WHERE community_id = $2;
+
+-- name: UpsertDigSiteDigger :exec
+INSERT INTO dig_site_diggers (user_id, meters, digs, last_dug_at)
+VALUES ($1, $2, 1, NOW())
+ON CONFLICT (user_id) DO UPDATE
+SET meters = dig_site_diggers.meters + EXCLUDED.meters,
+    digs = dig_site_diggers.digs + 1,
+    last_dug_at = NOW();
+
+-- name: GetTopDigSiteDiggers :many
+SELECT d.user_id, u.username, d.meters, d.digs, d.last_dug_at
+FROM dig_site_diggers d
+JOIN users u ON u.user_id = d.user_id
+WHERE u.community_id = $1
+ORDER BY d.meters DESC, d.last_dug_at ASC
+LIMIT $2;
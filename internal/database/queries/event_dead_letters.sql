@@ -0,0 +1,26 @@
+-- name: InsertEventDeadLetter :one
+INSERT INTO event_dead_letters (event_type, handler_name, event, attempts, last_error)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING id;
+
+-- name: GetEventDeadLetter :one
+SELECT id, event_type, handler_name, event, attempts, last_error, status, created_at, updated_at
+FROM event_dead_letters
+WHERE id = $1;
+
+-- name: ListEventDeadLetters :many
+SELECT id, event_type, handler_name, event, attempts, last_error, status, created_at, updated_at
+FROM event_dead_letters
+WHERE (sqlc.narg('status')::text IS NULL OR status = sqlc.narg('status'))
+ORDER BY created_at DESC
+LIMIT $1;
+
+-- name: UpdateEventDeadLetterStatus :exec
+UPDATE event_dead_letters
+SET status = $2, updated_at = NOW()
+WHERE id = $1;
+
+-- name: RecordEventDeadLetterAttempt :exec
+UPDATE event_dead_letters
+SET attempts = attempts + 1, last_error = $2, updated_at = NOW()
+WHERE id = $1;
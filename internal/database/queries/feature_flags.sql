@@ -0,0 +1,25 @@
+-- name: ListFeatureFlags :many
+SELECT key, description, enabled, rollout_percent, platform_overrides, updated_by, created_at, updated_at
+FROM feature_flags
+ORDER BY key;
+
+-- name: GetFeatureFlag :one
+SELECT key, description, enabled, rollout_percent, platform_overrides, updated_by, created_at, updated_at
+FROM feature_flags
+WHERE key = $1;
+
+-- name: UpsertFeatureFlag :one
+INSERT INTO feature_flags (key, description, enabled, rollout_percent, platform_overrides, updated_by)
+VALUES ($1, $2, $3, $4, $5, $6)
+ON CONFLICT (key) DO UPDATE
+SET description = EXCLUDED.description,
+    enabled = EXCLUDED.enabled,
+    rollout_percent = EXCLUDED.rollout_percent,
+    platform_overrides = EXCLUDED.platform_overrides,
+    updated_by = EXCLUDED.updated_by,
+    updated_at = NOW()
+RETURNING key, description, enabled, rollout_percent, platform_overrides, updated_by, created_at, updated_at;
+
+-- name: DeleteFeatureFlag :execrows
+DELETE FROM feature_flags
+WHERE key = $1;
@@ -0,0 +1,13 @@
+-- name: CreateFishingCast :execrows
+INSERT INTO fishing_casts (user_id, tackle, cast_at, bite_at, reel_by)
+VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT (user_id) DO UPDATE
+SET tackle = EXCLUDED.tackle,
+    cast_at = EXCLUDED.cast_at,
+    bite_at = EXCLUDED.bite_at,
+    reel_by = EXCLUDED.reel_by
+WHERE fishing_casts.reel_by < EXCLUDED.cast_at;
+
+-- name: TakeFishingCast :one
+DELETE FROM fishing_casts WHERE user_id = $1
+RETURNING user_id, tackle, cast_at, bite_at, reel_by;
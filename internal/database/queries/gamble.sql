@@ -1,9 +1,10 @@
 -- name: CreateGamble :exec
-INSERT INTO gambles (id, initiator_id, state, created_at, join_deadline)
-VALUES ($1, $2, $3, $4, $5);
+INSERT INTO gambles (id, initiator_id, state, created_at, join_deadline, community_id, stream_session_id)
+VALUES ($1, $2, $3, $4, $5, $6,
+        (SELECT id FROM stream_sessions WHERE community_id = $6 AND ended_at IS NULL));
 
 -- name: GetGamble :one
-SELECT id, initiator_id, state, created_at, join_deadline
+SELECT id, initiator_id, state, created_at, join_deadline, community_id, stream_session_id
 FROM gambles
 WHERE id = $1;
 
@@ -32,7 +33,38 @@ INSERT INTO gamble_opened_items (gamble_id, user_id, item_id, quantity, value)
 VALUES ($1, $2, $3, $4, $5);
 
 -- name: GetActiveGamble :one
-SELECT id, initiator_id, state, created_at, join_deadline
+SELECT id, initiator_id, state, created_at, join_deadline, community_id, stream_session_id
 FROM gambles
 WHERE state IN ('Joining', 'Opening')
+  AND community_id = $1
 LIMIT 1;
+
+-- name: GetStaleGambles :many
+SELECT id, initiator_id, state, created_at, join_deadline, community_id, stream_session_id
+FROM gambles
+WHERE state IN ('Created', 'Joining', 'Opening')
+  AND join_deadline < $1
+ORDER BY join_deadline;
+
+-- name: PlaceGambleSideBet :execrows
+-- Locks the gamble so the bet is either seen by the execution that claims it
+-- or refused because the gamble stopped accepting bets
+INSERT INTO gamble_side_bets (gamble_id, user_id, backed_user_id, amount)
+SELECT id, $2, $3, $4
+FROM gambles
+WHERE id = $1 AND state = 'Joining'
+FOR UPDATE;
+
+-- name: GetGambleSideBets :many
+SELECT b.gamble_id, b.user_id, u.username, b.backed_user_id, backed.username AS backed_username,
+       b.amount, b.payout, b.created_at
+FROM gamble_side_bets b
+JOIN users u ON b.user_id = u.user_id
+JOIN users backed ON b.backed_user_id = backed.user_id
+WHERE b.gamble_id = $1
+ORDER BY b.id;
+
+-- name: SettleGambleSideBet :exec
+UPDATE gamble_side_bets
+SET payout = $1
+WHERE gamble_id = $2 AND user_id = $3;
@@ -0,0 +1,22 @@
+-- name: ListGameEvents :many
+SELECT id, name, description, xp_multiplier, drop_rate_multiplier, shop_discount_percent, starts_at, ends_at, created_by, created_at, community_id, theme
+FROM game_events
+WHERE community_id = $1 AND ends_at > $2
+ORDER BY starts_at, id;
+
+-- name: GetGameEvent :one
+SELECT id, name, description, xp_multiplier, drop_rate_multiplier, shop_discount_percent, starts_at, ends_at, created_by, created_at, community_id, theme
+FROM game_events
+WHERE id = $1 AND community_id = $2;
+
+-- name: CreateGameEvent :one
+INSERT INTO game_events (name, description, xp_multiplier, drop_rate_multiplier, shop_discount_percent, starts_at, ends_at, created_by, community_id, theme)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+RETURNING id, name, description, xp_multiplier, drop_rate_multiplier, shop_discount_percent, starts_at, ends_at, created_by, created_at, community_id, theme;
+
+-- name: EndGameEvent :one
+UPDATE game_events
+SET ends_at = sqlc.arg(ended_at),
+    starts_at = LEAST(starts_at, sqlc.arg(ended_at))
+WHERE id = sqlc.arg(id) AND community_id = sqlc.arg(community_id) AND ends_at > sqlc.arg(ended_at)
+RETURNING id, name, description, xp_multiplier, drop_rate_multiplier, shop_discount_percent, starts_at, ends_at, created_by, created_at, community_id, theme;
@@ -0,0 +1,33 @@
+-- name: CreateGameSnapshot :one
+INSERT INTO game_snapshots (trigger, note, created_by, schema_version, row_counts, size_bytes, data)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+RETURNING id, created_at;
+
+-- name: ListGameSnapshots :many
+SELECT id, trigger, note, created_by, schema_version, row_counts, size_bytes, created_at
+FROM game_snapshots
+ORDER BY created_at DESC, id DESC;
+
+-- name: GetGameSnapshotData :one
+SELECT data
+FROM game_snapshots
+WHERE id = $1;
+
+-- name: DeleteGameSnapshot :execrows
+DELETE FROM game_snapshots
+WHERE id = $1;
+
+-- name: PruneGameSnapshots :execrows
+DELETE FROM game_snapshots
+WHERE trigger = $1
+  AND id NOT IN (
+    SELECT id FROM game_snapshots
+    WHERE trigger = $1
+    ORDER BY created_at DESC, id DESC
+    LIMIT $2
+  );
+
+-- name: GetSchemaVersion :one
+SELECT COALESCE(MAX(version_id), 0)::BIGINT AS version
+FROM goose_db_version
+WHERE is_applied;
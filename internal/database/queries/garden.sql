@@ -0,0 +1,22 @@
+-- name: ListGardenPlots :many
+SELECT user_id, slot, seed, crop, quantity, planted_at, ready_at
+FROM garden_plots
+WHERE user_id = $1
+ORDER BY slot;
+
+-- name: PlantGardenPlot :one
+INSERT INTO garden_plots (user_id, slot, seed, crop, quantity, planted_at, ready_at)
+SELECT sqlc.arg(user_id), s.slot, sqlc.arg(seed), sqlc.arg(crop), sqlc.arg(quantity), sqlc.arg(planted_at), sqlc.arg(ready_at)
+FROM generate_series(1, sqlc.arg(capacity)::int) AS s(slot)
+WHERE NOT EXISTS (
+    SELECT 1 FROM garden_plots p WHERE p.user_id = sqlc.arg(user_id) AND p.slot = s.slot
+)
+ORDER BY s.slot
+LIMIT 1
+ON CONFLICT (user_id, slot) DO NOTHING
+RETURNING slot;
+
+-- name: TakeReadyGardenPlots :many
+DELETE FROM garden_plots
+WHERE user_id = $1 AND ready_at <= $2
+RETURNING user_id, slot, seed, crop, quantity, planted_at, ready_at;
@@ -0,0 +1,41 @@
+-- name: CreateGift :one
+INSERT INTO gifts (sender_id, recipient_id, item_id, quality_level, quantity, message, platform, recipient_platform_id, channel_id, deliver_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+RETURNING id, sender_id, recipient_id, item_id, quality_level, quantity, message, platform, recipient_platform_id, channel_id, status, deliver_at, created_at, closed_at;
+
+-- name: GetPendingGiftForUpdate :one
+SELECT g.id, g.sender_id, g.recipient_id, g.item_id, g.quality_level, g.quantity, g.message, g.platform, g.recipient_platform_id, g.channel_id, g.status, g.deliver_at, g.created_at, g.closed_at,
+       s.username AS sender_name, COALESCE(r.username, '')::text AS recipient_name, i.internal_name AS item_name
+FROM gifts g
+JOIN users s ON s.user_id = g.sender_id
+LEFT JOIN users r ON r.user_id = g.recipient_id
+JOIN items i ON i.item_id = g.item_id
+WHERE g.id = $1 AND g.status = 'pending'
+FOR UPDATE OF g;
+
+-- name: CloseGift :exec
+UPDATE gifts
+SET status = $2, closed_at = $3
+WHERE id = $1;
+
+-- name: ListSenderPendingGifts :many
+SELECT g.id, g.sender_id, g.recipient_id, g.item_id, g.quality_level, g.quantity, g.message, g.platform, g.recipient_platform_id, g.channel_id, g.status, g.deliver_at, g.created_at, g.closed_at,
+       COALESCE(r.username, '')::text AS recipient_name, i.internal_name AS item_name
+FROM gifts g
+LEFT JOIN users r ON r.user_id = g.recipient_id
+JOIN items i ON i.item_id = g.item_id
+WHERE g.sender_id = $1 AND g.status = 'pending'
+ORDER BY g.deliver_at, g.id;
+
+-- name: CountSenderPendingGifts :one
+SELECT COUNT(*)::int
+FROM gifts
+WHERE sender_id = $1 AND status = 'pending';
+
+-- Gifts whose recipient has been deleted are due straight away.
+-- name: ListDueGiftIDs :many
+SELECT id
+FROM gifts
+WHERE status = 'pending' AND (deliver_at <= sqlc.arg(now)::timestamptz OR recipient_id IS NULL)
+ORDER BY deliver_at, id
+LIMIT sqlc.arg(batch_size)::int;
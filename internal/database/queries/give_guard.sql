@@ -0,0 +1,42 @@
+-- name: InsertGiveLog :exec
+INSERT INTO give_log (giver_id, receiver_id, item_id, quantity)
+VALUES ($1, $2, $3, $4);
+
+-- name: DeleteOldGiveLog :exec
+DELETE FROM give_log
+WHERE giver_id = $1 AND created_at < $2;
+
+-- name: CountGivesSince :one
+SELECT COUNT(*)::int
+FROM give_log
+WHERE giver_id = $1 AND created_at >= $2;
+
+-- name: HasGivenSince :one
+SELECT EXISTS (
+    SELECT 1 FROM give_log
+    WHERE giver_id = $1 AND receiver_id = $2 AND created_at >= $3
+);
+
+-- name: InsertGiveFlag :one
+INSERT INTO give_flags (giver_id, receiver_id, item_id, quantity, reason)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING id;
+
+-- Newest first, with the names admins need to judge the flag
+-- name: ListGiveFlags :many
+SELECT f.id, f.giver_id, g.username AS giver_name, f.receiver_id,
+       r.username AS receiver_name, f.item_id, i.internal_name AS item_name,
+       f.quantity, f.reason, f.status, f.reviewed_by, f.reviewed_at, f.created_at
+FROM give_flags f
+JOIN users g ON g.user_id = f.giver_id
+JOIN users r ON r.user_id = f.receiver_id
+JOIN items i ON i.item_id = f.item_id
+WHERE f.status = $1
+ORDER BY f.id DESC
+LIMIT $2;
+
+-- Only pending flags can be reviewed, so a decision is never overwritten
+-- name: ReviewGiveFlag :execrows
+UPDATE give_flags
+SET status = $2, reviewed_by = $3, reviewed_at = NOW()
+WHERE id = $1 AND status = 'pending';
@@ -0,0 +1,42 @@
+-- name: CreateInboxMessage :one
+INSERT INTO inbox_messages (user_id, kind, title, body)
+VALUES ($1, $2, $3, $4)
+RETURNING id, user_id, kind, title, body, read_at, created_at;
+
+-- Drops a user's messages beyond the newest keep
+-- name: TrimInboxMessages :exec
+DELETE FROM inbox_messages
+WHERE user_id = sqlc.arg(user_id)
+  AND id < (
+    SELECT MIN(k.id) FROM (
+        SELECT m.id FROM inbox_messages m
+        WHERE m.user_id = sqlc.arg(user_id)
+        ORDER BY m.id DESC
+        LIMIT sqlc.arg(keep)::int
+    ) k
+);
+
+-- name: ListInboxMessages :many
+SELECT id, user_id, kind, title, body, read_at, created_at
+FROM inbox_messages
+WHERE user_id = sqlc.arg(user_id)
+  AND (NOT sqlc.arg(unread_only)::boolean OR read_at IS NULL)
+ORDER BY id DESC
+LIMIT sqlc.arg(row_limit)::int;
+
+-- name: CountUnreadInboxMessages :one
+SELECT COUNT(*)::int FROM inbox_messages
+WHERE user_id = $1 AND read_at IS NULL;
+
+-- name: MarkInboxMessagesRead :execrows
+UPDATE inbox_messages
+SET read_at = sqlc.arg(read_at)
+WHERE user_id = sqlc.arg(user_id)
+  AND id = ANY(sqlc.arg(ids)::bigint[])
+  AND read_at IS NULL;
+
+-- name: MarkAllInboxMessagesRead :execrows
+UPDATE inbox_messages
+SET read_at = sqlc.arg(read_at)
+WHERE user_id = sqlc.arg(user_id)
+  AND read_at IS NULL;
@@ -0,0 +1,23 @@
+-- name: ListItemAliases :many
+SELECT internal_name, default_aliases, theme_aliases, updated_by, created_at, updated_at
+FROM item_aliases
+ORDER BY internal_name;
+
+-- name: GetItemAlias :one
+SELECT internal_name, default_aliases, theme_aliases, updated_by, created_at, updated_at
+FROM item_aliases
+WHERE internal_name = $1;
+
+-- name: UpsertItemAlias :one
+INSERT INTO item_aliases (internal_name, default_aliases, theme_aliases, updated_by)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (internal_name) DO UPDATE
+SET default_aliases = EXCLUDED.default_aliases,
+    theme_aliases = EXCLUDED.theme_aliases,
+    updated_by = EXCLUDED.updated_by,
+    updated_at = NOW()
+RETURNING internal_name, default_aliases, theme_aliases, updated_by, created_at, updated_at;
+
+-- name: DeleteItemAlias :execrows
+DELETE FROM item_aliases
+WHERE internal_name = $1;
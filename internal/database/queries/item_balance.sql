@@ -0,0 +1,36 @@
+-- name: CreateItemBalanceChange :one
+INSERT INTO item_balance_changes (item_name, field, pool_name, value, effective_at, reason, created_by)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+RETURNING id, item_name, field, pool_name, value, effective_at, reason, created_by, created_at;
+
+-- name: DeleteUpcomingItemBalanceChange :execrows
+-- Only changes that have not taken effect can be cancelled
+DELETE FROM item_balance_changes
+WHERE id = $1 AND effective_at > $2;
+
+-- name: GetItemBalanceChanges :many
+-- Newest first; an empty item name lists every item
+SELECT id, item_name, field, pool_name, value, effective_at, reason, created_by, created_at
+FROM item_balance_changes
+WHERE (sqlc.arg(item_name)::text = '' OR item_name = sqlc.arg(item_name)::text)
+ORDER BY effective_at DESC, id DESC
+LIMIT sqlc.arg(row_limit)::int;
+
+-- name: GetEffectiveItemBalanceChanges :many
+-- The change in force for each item and pool: the latest at or before the given time
+SELECT DISTINCT ON (item_name, pool_name) id, item_name, field, pool_name, value, effective_at, reason, created_by, created_at
+FROM item_balance_changes
+WHERE field = $1 AND effective_at <= $2
+ORDER BY item_name, pool_name, effective_at DESC, id DESC;
+
+-- name: GetItemBalanceChangesEffectiveBetween :many
+-- Changes that took effect in (from, to]
+SELECT id, item_name, field, pool_name, value, effective_at, reason, created_by, created_at
+FROM item_balance_changes
+WHERE effective_at > sqlc.arg(from_time)::timestamptz AND effective_at <= sqlc.arg(to_time)::timestamptz
+ORDER BY effective_at, id;
+
+-- name: SetItemBaseValue :execrows
+UPDATE items
+SET base_value = $2
+WHERE internal_name = $1 AND base_value IS DISTINCT FROM $2;
@@ -0,0 +1,26 @@
+-- name: GetUserItemFlags :many
+SELECT f.item_id, i.internal_name, f.favorite, f.locked
+FROM user_item_flags f
+JOIN items i ON i.item_id = f.item_id
+WHERE f.user_id = $1 AND (f.favorite OR f.locked)
+ORDER BY i.internal_name;
+
+-- name: IsUserItemLocked :one
+SELECT EXISTS (
+    SELECT 1 FROM user_item_flags
+    WHERE user_id = $1 AND item_id = $2 AND locked
+);
+
+-- name: UpsertUserItemLocked :exec
+INSERT INTO user_item_flags (user_id, item_id, locked, updated_at)
+VALUES ($1, $2, $3, NOW())
+ON CONFLICT (user_id, item_id) DO UPDATE
+SET locked = EXCLUDED.locked,
+    updated_at = NOW();
+
+-- name: UpsertUserItemFavorite :exec
+INSERT INTO user_item_flags (user_id, item_id, favorite, updated_at)
+VALUES ($1, $2, $3, NOW())
+ON CONFLICT (user_id, item_id) DO UPDATE
+SET favorite = EXCLUDED.favorite,
+    updated_at = NOW();
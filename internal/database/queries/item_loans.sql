@@ -0,0 +1,36 @@
+-- name: CreateItemLoan :one
+INSERT INTO item_loans (lender_id, borrower_id, item_id, quality_level, quantity, due_at)
+VALUES ($1, $2, $3, $4, $5, $6)
+RETURNING id, lender_id, borrower_id, item_id, quality_level, quantity, returned_quantity, status, due_at, created_at, closed_at;
+
+-- name: GetActiveItemLoanForUpdate :one
+SELECT id, lender_id, borrower_id, item_id, quality_level, quantity, returned_quantity, status, due_at, created_at, closed_at
+FROM item_loans
+WHERE id = $1 AND status = 'active'
+FOR UPDATE;
+
+-- name: CloseItemLoan :exec
+UPDATE item_loans
+SET status = $2, returned_quantity = $3, closed_at = $4
+WHERE id = $1;
+
+-- name: ListUserActiveItemLoans :many
+SELECT l.id, l.lender_id, l.borrower_id, l.item_id, l.quality_level, l.quantity, l.returned_quantity, l.status, l.due_at, l.created_at, l.closed_at,
+       i.internal_name AS item_name
+FROM item_loans l
+JOIN items i ON i.item_id = l.item_id
+WHERE l.status = 'active' AND (l.lender_id = $1 OR l.borrower_id = $1)
+ORDER BY l.due_at, l.id;
+
+-- name: GetBorrowedItemQuantity :one
+SELECT COALESCE(SUM(quantity), 0)::int
+FROM item_loans
+WHERE borrower_id = $1 AND item_id = $2 AND status = 'active';
+
+-- Loans whose borrower has been deleted are due straight away.
+-- name: ListDueItemLoanIDs :many
+SELECT id
+FROM item_loans
+WHERE status = 'active' AND (due_at <= sqlc.arg(now)::timestamptz OR borrower_id IS NULL)
+ORDER BY due_at, id
+LIMIT sqlc.arg(batch_size)::int;
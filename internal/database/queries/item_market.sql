@@ -0,0 +1,44 @@
+-- Decays the stored pressure to now before adding the trade
+-- name: RecordItemTrade :exec
+INSERT INTO item_market_pressure (community_id, item_id, pressure, updated_at)
+VALUES (@community_id, @item_id, @delta, NOW())
+ON CONFLICT (community_id, item_id) DO UPDATE
+SET pressure = item_market_pressure.pressure
+        * power(0.5, EXTRACT(EPOCH FROM (NOW() - item_market_pressure.updated_at)) / @half_life_seconds::float8)
+        + EXCLUDED.pressure,
+    updated_at = NOW();
+
+-- name: GetItemMarketPressure :one
+SELECT item_id, pressure, updated_at, community_id
+FROM item_market_pressure
+WHERE community_id = $1 AND item_id = $2;
+
+-- Every traded item with its base value and most recently recorded multiplier
+-- name: ListItemMarketStates :many
+SELECT p.item_id, p.pressure, p.updated_at, i.base_value, h.multiplier AS last_multiplier
+FROM item_market_pressure p
+JOIN items i ON i.item_id = p.item_id
+LEFT JOIN LATERAL (
+    SELECT ph.multiplier
+    FROM item_price_history ph
+    WHERE ph.community_id = p.community_id AND ph.item_id = p.item_id
+    ORDER BY ph.recorded_at DESC
+    LIMIT 1
+) h ON TRUE
+WHERE p.community_id = $1;
+
+-- name: InsertItemPriceHistory :exec
+INSERT INTO item_price_history (item_id, multiplier, buy_price, sell_price, recorded_at, community_id)
+VALUES ($1, $2, $3, $4, $5, $6);
+
+-- Newest first; callers reverse for charts
+-- name: GetItemPriceHistory :many
+SELECT id, item_id, multiplier, buy_price, sell_price, recorded_at, community_id
+FROM item_price_history
+WHERE item_id = $1 AND recorded_at >= $2 AND community_id = $4
+ORDER BY recorded_at DESC
+LIMIT $3;
+
+-- name: DeleteItemPriceHistoryBefore :execrows
+DELETE FROM item_price_history
+WHERE recorded_at < $1;
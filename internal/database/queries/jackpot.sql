@@ -0,0 +1,31 @@
+-- Credits the community's jackpot, opening it on first use. The balance is
+-- not raised past max_balance, but a balance already above it is kept.
+-- name: AddToJackpot :one
+INSERT INTO jackpot (community_id, balance)
+VALUES (sqlc.arg(community_id), LEAST(sqlc.arg(amount)::bigint, sqlc.arg(max_balance)::bigint))
+ON CONFLICT (community_id) DO UPDATE
+SET balance = GREATEST(jackpot.balance, LEAST(jackpot.balance + sqlc.arg(amount)::bigint, sqlc.arg(max_balance)::bigint)),
+    updated_at = NOW()
+RETURNING balance;
+
+-- name: GetJackpotBalance :one
+SELECT balance FROM jackpot WHERE community_id = $1;
+
+-- name: ClaimJackpot :one
+UPDATE jackpot j
+SET balance = 0, updated_at = NOW()
+FROM (SELECT balance FROM jackpot WHERE community_id = $1 FOR UPDATE) old
+WHERE j.community_id = $1 AND old.balance > 0
+RETURNING old.balance AS amount;
+
+-- name: InsertJackpotWin :exec
+INSERT INTO jackpot_wins (user_id, amount, source, community_id)
+VALUES ($1, $2, $3, $4);
+
+-- name: GetRecentJackpotWins :many
+SELECT w.user_id, u.username, w.amount, w.source, w.won_at
+FROM jackpot_wins w
+JOIN users u ON u.user_id = w.user_id
+WHERE w.community_id = $1
+ORDER BY w.won_at DESC, w.id DESC
+LIMIT $2;
@@ -9,13 +9,13 @@ FROM jobs
 WHERE job_key = $1;
 
 -- name: GetUserJobs :many
-SELECT user_id, job_id, current_xp, current_level, xp_gained_today, last_xp_gain
+SELECT user_id, job_id, current_xp, current_level, xp_gained_today, last_xp_gain, prestige, is_active
 FROM user_jobs
 WHERE user_id = $1
 ORDER BY current_level DESC, current_xp DESC;
 
 -- name: GetUserJob :one
-SELECT user_id, job_id, current_xp, current_level, xp_gained_today, last_xp_gain
+SELECT user_id, job_id, current_xp, current_level, xp_gained_today, last_xp_gain, prestige, is_active
 FROM user_jobs
 WHERE user_id = $1 AND job_id = $2;
 
@@ -29,13 +29,31 @@ DO UPDATE SET
     xp_gained_today = EXCLUDED.xp_gained_today,
     last_xp_gain = EXCLUDED.last_xp_gain;
 
+-- Resets a job at or above the level cap and counts the prestige. No row is
+-- returned when the job is below min_level.
+-- name: PrestigeUserJob :one
+UPDATE user_jobs
+SET current_xp = 0, current_level = 0, prestige = prestige + 1
+WHERE user_id = sqlc.arg(user_id) AND job_id = sqlc.arg(job_id) AND current_level >= sqlc.arg(min_level)
+RETURNING prestige;
+
+-- name: ClearActiveJob :exec
+UPDATE user_jobs
+SET is_active = FALSE
+WHERE user_id = $1 AND is_active;
+
+-- name: SetActiveJob :exec
+INSERT INTO user_jobs (user_id, job_id, is_active)
+VALUES ($1, $2, TRUE)
+ON CONFLICT (user_id, job_id)
+DO UPDATE SET is_active = TRUE;
 
 -- name: ResetDailyJobXP :execresult
 UPDATE user_jobs
 SET xp_gained_today = 0;
 
 -- name: GetUserJobsByPlatform :many
-SELECT uj.user_id, uj.job_id, uj.current_xp, uj.current_level, uj.xp_gained_today, uj.last_xp_gain
+SELECT uj.user_id, uj.job_id, uj.current_xp, uj.current_level, uj.xp_gained_today, uj.last_xp_gain, uj.prestige, uj.is_active
 FROM user_jobs uj
 JOIN user_platform_links upl ON uj.user_id = upl.user_id
 JOIN platforms p ON upl.platform_id = p.platform_id
@@ -50,3 +68,19 @@ FROM daily_reset_state WHERE id = 1;
 UPDATE daily_reset_state
 SET last_reset_time = $1, records_affected = $2
 WHERE id = 1;
+
+-- name: GetActiveXPBoost :one
+SELECT multiplier
+FROM job_xp_boosts
+WHERE user_id = $1 AND expires_at > NOW();
+
+-- Grants a boost. While one is active the higher multiplier is kept and the
+-- duration is added to the current expiry; an expired boost is replaced.
+-- name: GrantXPBoost :exec
+INSERT INTO job_xp_boosts (user_id, multiplier, expires_at)
+VALUES (sqlc.arg(user_id), sqlc.arg(multiplier), NOW() + (sqlc.arg(duration_ms)::bigint * INTERVAL '1 millisecond'))
+ON CONFLICT (user_id) DO UPDATE
+SET multiplier = CASE WHEN job_xp_boosts.expires_at > NOW()
+                      THEN GREATEST(job_xp_boosts.multiplier, EXCLUDED.multiplier)
+                      ELSE EXCLUDED.multiplier END,
+    expires_at = GREATEST(job_xp_boosts.expires_at, NOW()) + (sqlc.arg(duration_ms)::bigint * INTERVAL '1 millisecond');
@@ -0,0 +1,10 @@
+-- name: GetLootboxPityCount :one
+SELECT openings_since_rare FROM lootbox_pity
+WHERE user_id = $1 AND lootbox_name = $2;
+
+-- name: UpsertLootboxPityCount :exec
+INSERT INTO lootbox_pity (user_id, lootbox_name, openings_since_rare, updated_at)
+VALUES ($1, $2, $3, NOW())
+ON CONFLICT (user_id, lootbox_name) DO UPDATE
+SET openings_since_rare = EXCLUDED.openings_since_rare,
+    updated_at = NOW();
@@ -0,0 +1,33 @@
+-- name: FreezeUser :execrows
+INSERT INTO user_freezes (user_id, frozen_by, reason)
+VALUES ($1, $2, $3)
+ON CONFLICT (user_id) DO NOTHING;
+
+-- name: UnfreezeUser :execrows
+DELETE FROM user_freezes
+WHERE user_id = $1;
+
+-- name: GetUserFreeze :one
+SELECT user_id, frozen_by, reason, frozen_at
+FROM user_freezes
+WHERE user_id = $1;
+
+-- name: IsPlatformUserFrozen :one
+SELECT EXISTS (
+    SELECT 1
+    FROM user_freezes f
+    JOIN user_platform_links upl ON upl.user_id = f.user_id
+    JOIN platforms p ON p.platform_id = upl.platform_id
+    WHERE p.name = $1 AND upl.platform_user_id = $2
+);
+
+-- name: InsertModerationAction :exec
+INSERT INTO moderation_actions (user_id, action, actor, reason)
+VALUES ($1, $2, $3, $4);
+
+-- name: GetModerationActions :many
+SELECT id, user_id, action, actor, reason, created_at
+FROM moderation_actions
+WHERE user_id = $1
+ORDER BY id DESC
+LIMIT $2;
@@ -21,7 +21,7 @@ SELECT bc.node_key, bc.source_type, bc.feature_key, bc.modifier_type, bc.base_va
        COALESCE(u.current_level, 0)::int as progression_level
 FROM bonus_config bc
 LEFT JOIN progression_nodes n ON bc.node_key = n.node_key AND bc.source_type = 'progression'
-LEFT JOIN progression_unlocks u ON u.node_id = n.id
+LEFT JOIN progression_unlocks u ON u.node_id = n.id AND u.community_id = $2
 WHERE bc.feature_key = $1;
 
 -- name: ClearBonusModifiersForNode :exec
@@ -0,0 +1,14 @@
+-- Affects no rows when the event was already claimed
+-- name: ClaimMonetizationEvent :execrows
+INSERT INTO monetization_events (source, event_id, kind, payload)
+VALUES (sqlc.arg(source), sqlc.arg(event_id), sqlc.arg(kind), sqlc.arg(payload))
+ON CONFLICT (source, event_id) DO NOTHING;
+
+-- name: CompleteMonetizationEvent :exec
+UPDATE monetization_events
+SET user_id = sqlc.narg(user_id), result = sqlc.arg(result)
+WHERE source = sqlc.arg(source) AND event_id = sqlc.arg(event_id);
+
+-- name: ReleaseMonetizationEvent :exec
+DELETE FROM monetization_events
+WHERE source = $1 AND event_id = $2 AND result IS NULL;
@@ -0,0 +1,5 @@
+-- Affects no rows when the user already unlocked this progression
+-- name: ClaimUserProgression :execrows
+INSERT INTO user_progression (user_id, progression_type, progression_key, metadata)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (user_id, progression_type, progression_key) DO NOTHING;
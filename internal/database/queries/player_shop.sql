@@ -0,0 +1,50 @@
+-- name: CreatePlayerShopListing :one
+INSERT INTO player_shop_listings (seller_id, item_id, quality_level, quantity, unit_price, listing_fee, community_id)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+RETURNING *;
+
+-- name: GetActivePlayerShopListingForUpdate :one
+SELECT * FROM player_shop_listings
+WHERE id = $1 AND community_id = $2 AND status = 'active'
+FOR UPDATE;
+
+-- name: UpdatePlayerShopListing :exec
+UPDATE player_shop_listings
+SET quantity = $2, status = $3, closed_at = $4
+WHERE id = $1;
+
+-- name: ListActivePlayerShopListings :many
+SELECT l.id, l.seller_id, l.item_id, l.quality_level, l.quantity, l.unit_price, l.status, l.created_at, l.closed_at, l.listing_fee,
+       i.internal_name AS item_name, u.username AS seller_name
+FROM player_shop_listings l
+JOIN items i ON i.item_id = l.item_id
+JOIN users u ON u.user_id = l.seller_id
+WHERE l.community_id = sqlc.arg('community_id')
+  AND l.status = 'active'
+  AND (sqlc.narg('item_id')::int IS NULL OR l.item_id = sqlc.narg('item_id'))
+  AND (sqlc.narg('seller_name')::text IS NULL OR LOWER(u.username) = LOWER(sqlc.narg('seller_name')))
+  AND (sqlc.narg('quality_level')::text IS NULL OR l.quality_level = sqlc.narg('quality_level'))
+  AND (sqlc.narg('min_price')::int IS NULL OR l.unit_price >= sqlc.narg('min_price'))
+  AND (sqlc.narg('max_price')::int IS NULL OR l.unit_price <= sqlc.narg('max_price'))
+ORDER BY CASE WHEN sqlc.arg('newest_first')::bool THEN l.created_at END DESC,
+         l.unit_price, l.created_at, l.id
+LIMIT sqlc.arg('max_rows');
+
+-- name: CountActivePlayerShopListings :one
+SELECT COUNT(*)::int FROM player_shop_listings
+WHERE seller_id = $1 AND status = 'active';
+
+-- name: AddToCommunityPool :exec
+INSERT INTO community_pool (community_id, balance)
+VALUES ($1, $2)
+ON CONFLICT (community_id) DO UPDATE
+SET balance = community_pool.balance + EXCLUDED.balance, updated_at = NOW();
+
+-- name: GetCommunityPoolBalance :one
+SELECT balance FROM community_pool WHERE community_id = $1;
+
+-- name: WithdrawFromCommunityPool :one
+UPDATE community_pool
+SET balance = balance - $2, updated_at = NOW()
+WHERE community_id = $1 AND balance >= $2
+RETURNING balance;
@@ -34,29 +34,30 @@ SET unlock_cost = $2
 WHERE id = $1;
 
 -- name: GetUnlock :one
-SELECT id, node_id, current_level, unlocked_at, unlocked_by, engagement_score
+SELECT id, node_id, current_level, unlocked_at, unlocked_by, engagement_score, community_id
 FROM progression_unlocks
-WHERE node_id = $1 AND current_level = $2;
+WHERE node_id = $1 AND current_level = $2 AND community_id = $3;
 
 -- name: GetAllUnlocks :many
-SELECT id, node_id, current_level, unlocked_at, unlocked_by, engagement_score
+SELECT id, node_id, current_level, unlocked_at, unlocked_by, engagement_score, community_id
 FROM progression_unlocks
+WHERE community_id = $1
 ORDER BY unlocked_at;
 
 -- name: IsNodeUnlocked :one
 SELECT EXISTS(
     SELECT 1 FROM progression_unlocks pu
     JOIN progression_nodes pn ON pu.node_id = pn.id
-    WHERE pn.node_key = $1 AND pu.current_level >= $2
+    WHERE pn.node_key = $1 AND pu.current_level >= $2 AND pu.community_id = $3
 );
 
 -- name: UnlockNode :exec
-INSERT INTO progression_unlocks (node_id, current_level, unlocked_by, engagement_score)
-VALUES ($1, $2, $3, $4)
-ON CONFLICT (node_id, current_level) DO NOTHING;
+INSERT INTO progression_unlocks (node_id, current_level, unlocked_by, engagement_score, community_id)
+VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT (community_id, node_id, current_level) DO NOTHING;
 
 -- name: RelockNode :exec
-DELETE FROM progression_unlocks WHERE node_id = $1 AND (current_level = $2 OR $2 = 0);
+DELETE FROM progression_unlocks WHERE node_id = $1 AND (current_level = $2 OR $2 = 0) AND community_id = $3;
 
 -- name: GetActiveVoting :one
 SELECT id, node_id, target_level, vote_count, voting_started_at, voting_ends_at, is_active
@@ -133,11 +134,20 @@ FROM engagement_metrics
 WHERE user_id = $1
 GROUP BY metric_type;
 
+-- name: LockMetricType :exec
+-- Serialises capped inserts for one metric type until the transaction ends.
+SELECT pg_advisory_xact_lock(hashtext(sqlc.arg(metric_type)::text));
+
+-- name: GetMetricTotalSince :one
+SELECT COALESCE(SUM(metric_value), 0)::bigint
+FROM engagement_metrics
+WHERE metric_type = $1 AND recorded_at >= $2;
+
 -- name: GetEngagementWeights :many
 SELECT metric_type, weight FROM engagement_weights;
 
 -- name: CountUnlocks :one
-SELECT COUNT(*) FROM progression_unlocks;
+SELECT COUNT(*) FROM progression_unlocks WHERE community_id = $1;
 
 -- name: GetTotalEngagementScore :one
 SELECT COALESCE(SUM(metric_value), 0)::bigint FROM engagement_metrics;
@@ -148,20 +158,23 @@ VALUES ($1, $2, $3, $4);
 
 -- name: ClearUnlocksExceptRoot :exec
 DELETE FROM progression_unlocks
-WHERE node_id != (SELECT id FROM progression_nodes WHERE node_key = 'progression_system');
+WHERE node_id != (SELECT id FROM progression_nodes WHERE node_key = 'progression_system')
+  AND community_id = $1;
 
 -- name: ClearAllVoting :exec
 DELETE FROM progression_voting;
 
 -- name: ClearAllUserVotes :exec
-DELETE FROM user_votes;
+DELETE FROM user_votes
+WHERE user_id IN (SELECT user_id::text FROM users WHERE community_id = $1);
 
 -- name: ClearAllUserProgression :exec
-DELETE FROM user_progression;
+DELETE FROM user_progression
+WHERE user_id IN (SELECT user_id::text FROM users WHERE community_id = $1);
 
 -- name: CreateVotingSession :one
-INSERT INTO progression_voting_sessions (status)
-VALUES ('voting')
+INSERT INTO progression_voting_sessions (status, community_id)
+VALUES ('voting', $1)
 RETURNING id;
 
 -- name: AddVotingOption :exec
@@ -172,6 +185,7 @@ VALUES ($1, $2, $3, 0);
 SELECT id, started_at, ended_at, voting_deadline, winning_option_id, status
 FROM progression_voting_sessions
 WHERE status = ('voting')::text
+  AND community_id = $1
   AND EXISTS (SELECT 1 FROM progression_voting_options WHERE session_id = progression_voting_sessions.id)
 ORDER BY started_at DESC
 LIMIT 1;
@@ -179,6 +193,7 @@ LIMIT 1;
 -- name: GetMostRecentSession :one
 SELECT id, started_at, ended_at, voting_deadline, winning_option_id, status
 FROM progression_voting_sessions
+WHERE community_id = $1
 ORDER BY started_at DESC
 LIMIT 1;
 
@@ -188,7 +203,8 @@ FROM progression_voting_sessions
 WHERE id = $1;
 
 -- name: GetSessionOptions :many
-SELECT o.id, o.session_id, o.node_id, o.target_level, o.vote_count, o.last_highest_vote_at
+SELECT o.id, o.session_id, o.node_id, o.target_level, o.vote_count, o.last_highest_vote_at,
+       (SELECT COUNT(*) FROM user_votes v WHERE v.option_id = o.id AND v.excluded_at IS NULL)::int AS voter_count
 FROM progression_voting_options o
 WHERE o.session_id = $1
 ORDER BY o.id;
@@ -234,10 +250,25 @@ WHERE id = $1 AND status = 'frozen';
 SELECT id, started_at, ended_at, voting_deadline, winning_option_id, status
 FROM progression_voting_sessions
 WHERE status IN ('voting', 'frozen')
+  AND community_id = $1
   AND EXISTS (SELECT 1 FROM progression_voting_options WHERE session_id = progression_voting_sessions.id)
 ORDER BY started_at DESC
 LIMIT 1;
 
+-- name: GetCompletedSessions :many
+SELECT s.id, s.started_at, s.ended_at, s.voting_deadline, s.winning_option_id, s.status,
+       up.node_id AS unlocked_node_id, up.target_level AS unlocked_level, up.unlocked_at
+FROM progression_voting_sessions s
+LEFT JOIN progression_unlock_progress up
+       ON up.voting_session_id = s.id AND up.unlocked_at IS NOT NULL
+WHERE s.status = 'completed' AND s.community_id = $3
+ORDER BY s.started_at DESC, s.id DESC
+LIMIT $1 OFFSET $2;
+
+-- name: CountCompletedSessions :one
+SELECT COUNT(*) FROM progression_voting_sessions
+WHERE status = 'completed' AND community_id = $1;
+
 -- name: GetSessionVoters :many
 SELECT DISTINCT user_id
 FROM user_votes
@@ -264,19 +295,19 @@ SELECT EXISTS(
 );
 
 -- name: RecordUserSessionVote :exec
-INSERT INTO user_votes (user_id, session_id, option_id, node_id, target_level)
-VALUES ($1, $2, $3, $4, $5)
+INSERT INTO user_votes (user_id, session_id, option_id, node_id, target_level, weight)
+VALUES ($1, $2, $3, $4, $5, $6)
 ON CONFLICT (user_id, session_id) DO NOTHING;
 
 -- name: CreateUnlockProgress :one
-INSERT INTO progression_unlock_progress (contributions_accumulated)
-VALUES (0)
+INSERT INTO progression_unlock_progress (contributions_accumulated, community_id)
+VALUES (0, $1)
 RETURNING id;
 
 -- name: GetActiveUnlockProgress :one
-SELECT id, node_id, target_level, contributions_accumulated, started_at, unlocked_at, voting_session_id
+SELECT id, node_id, target_level, contributions_accumulated, started_at, unlocked_at, voting_session_id, community_id
 FROM progression_unlock_progress
-WHERE unlocked_at IS NULL
+WHERE unlocked_at IS NULL AND community_id = $1
 ORDER BY started_at DESC
 LIMIT 1;
 
@@ -296,17 +327,18 @@ SET unlocked_at = NOW()
 WHERE id = $1;
 
 -- name: InsertNextUnlockProgress :one
-INSERT INTO progression_unlock_progress (contributions_accumulated)
-VALUES ($1)
+INSERT INTO progression_unlock_progress (contributions_accumulated, community_id)
+VALUES ($1, $2)
 RETURNING id;
 
 -- name: GetNodePrerequisites :many
 SELECT n.id, n.node_key, n.node_type, n.display_name, n.description,
-       n.max_level, n.unlock_cost, n.tier, n.size, n.category, n.sort_order, n.created_at
+       n.max_level, n.unlock_cost, n.tier, n.size, n.category, n.sort_order, n.created_at,
+       p.prerequisite_group
 FROM progression_nodes n
 INNER JOIN progression_prerequisites p ON n.id = p.prerequisite_node_id
 WHERE p.node_id = $1
-ORDER BY n.sort_order, n.id;
+ORDER BY p.prerequisite_group, n.sort_order, n.id;
 
 -- name: GetNodeDependents :many
 SELECT n.id, n.node_key, n.node_type, n.display_name, n.description,
@@ -317,33 +349,29 @@ WHERE p.prerequisite_node_id = $1
 ORDER BY n.sort_order, n.id;
 
 -- name: GetContributionLeaderboard :many
-WITH user_contributions AS (
-    SELECT
-        user_id,
-        SUM(metric_value) as total_contribution
-    FROM engagement_metrics
-    GROUP BY user_id
-)
 SELECT
-    user_id,
-    total_contribution,
-    ROW_NUMBER() OVER (ORDER BY total_contribution DESC)::bigint as rank
-FROM user_contributions
-ORDER BY total_contribution DESC
+    ucs.user_id,
+    ROUND(ucs.score)::bigint as total_contribution,
+    ROW_NUMBER() OVER (ORDER BY ucs.score DESC)::bigint as rank,
+    COALESCE(us.leaderboard_private, FALSE)::boolean as is_private
+FROM user_contribution_scores ucs
+JOIN users u ON u.user_id::text = ucs.user_id AND u.community_id = $2
+LEFT JOIN user_settings us ON us.user_id::text = ucs.user_id
+ORDER BY ucs.score DESC
 LIMIT $1;
 
 -- name: ClearNodePrerequisites :exec
 DELETE FROM progression_prerequisites WHERE node_id = $1;
 
 -- name: InsertNodePrerequisite :exec
-INSERT INTO progression_prerequisites (node_id, prerequisite_node_id)
-VALUES ($1, $2)
+INSERT INTO progression_prerequisites (node_id, prerequisite_node_id, prerequisite_group)
+VALUES ($1, $2, $3)
 ON CONFLICT (node_id, prerequisite_node_id) DO NOTHING;
 
 -- name: GetNodeByFeatureKey :one
 SELECT n.*, COALESCE(u.current_level, 0)::int as unlock_level
 FROM progression_nodes n
-LEFT JOIN progression_unlocks u ON u.node_id = n.id
+LEFT JOIN progression_unlocks u ON u.node_id = n.id AND u.community_id = $2
 JOIN bonus_config bc ON n.node_key = bc.node_key
 WHERE bc.feature_key = $1 AND bc.source_type = 'progression'
 LIMIT 1;
@@ -351,7 +379,7 @@ LIMIT 1;
 -- name: GetAllNodesByFeatureKey :many
 SELECT n.*, COALESCE(u.current_level, 0)::int as unlock_level
 FROM progression_nodes n
-LEFT JOIN progression_unlocks u ON u.node_id = n.id
+LEFT JOIN progression_unlocks u ON u.node_id = n.id AND u.community_id = $2
 JOIN bonus_config bc ON n.node_key = bc.node_key
 WHERE bc.feature_key = $1 AND bc.source_type = 'progression'
 ORDER BY n.tier ASC, n.id ASC;
@@ -364,27 +392,31 @@ WHERE recorded_at >= $1
 GROUP BY DATE(recorded_at)
 ORDER BY day ASC;
 
+-- Options cascade with their session; this only removes leftovers whose
+-- session is gone.
 -- name: ClearAllVotingOptions :exec
-DELETE FROM progression_voting_options;
+DELETE FROM progression_voting_options o
+WHERE NOT EXISTS (SELECT 1 FROM progression_voting_sessions s WHERE s.id = o.session_id);
 
 -- name: ClearAllVotingSessions :exec
-DELETE FROM progression_voting_sessions;
+DELETE FROM progression_voting_sessions WHERE community_id = $1;
 
 -- name: ClearAllUnlockProgress :exec
-DELETE FROM progression_unlock_progress;
+DELETE FROM progression_unlock_progress WHERE community_id = $1;
 
 -- name: ClearUnlockProgressForNode :exec
-DELETE FROM progression_unlock_progress WHERE node_id = $1;
+DELETE FROM progression_unlock_progress WHERE node_id = $1 AND community_id = $2;
 
 -- name: CountUnlockedNodesBelowTier :one
 SELECT COUNT(DISTINCT pu.node_id)::int
 FROM progression_unlocks pu
 JOIN progression_nodes pn ON pu.node_id = pn.id
-WHERE pn.tier < $1;
+WHERE pn.tier < $1 AND pu.community_id = $2;
 
 -- name: CountTotalUnlockedNodes :one
 SELECT COUNT(DISTINCT node_id)::int
-FROM progression_unlocks;
+FROM progression_unlocks
+WHERE community_id = $1;
 
 -- name: GetNodeDynamicPrerequisites :one
 SELECT COALESCE(dynamic_prerequisites, '[]'::jsonb)
@@ -395,3 +427,29 @@ WHERE id = $1;
 UPDATE progression_nodes
 SET dynamic_prerequisites = $2
 WHERE id = $1;
+
+-- name: GetNodeEffects :one
+SELECT effects
+FROM progression_nodes
+WHERE id = $1;
+
+-- name: GetAllNodeEffects :many
+-- Nodes that declare at least one effect
+SELECT id, effects
+FROM progression_nodes
+WHERE effects <> '{}'::jsonb
+ORDER BY id;
+
+-- name: UpdateNodeEffects :exec
+UPDATE progression_nodes
+SET effects = $2
+WHERE id = $1;
+
+-- name: SeedCommunityAutoUnlocks :execrows
+-- Gives a community the unlocks every tree starts with, copied from the
+-- default community
+INSERT INTO progression_unlocks (node_id, current_level, unlocked_by, engagement_score, community_id)
+SELECT node_id, current_level, unlocked_by, 0, $1
+FROM progression_unlocks
+WHERE community_id = 'default' AND unlocked_by = 'auto'
+ON CONFLICT (community_id, node_id, current_level) DO NOTHING;
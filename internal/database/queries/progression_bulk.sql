@@ -0,0 +1,59 @@
+-- name: CreateProgressionBulkOperation :one
+INSERT INTO progression_bulk_operations (action, progression_type, progression_key, actor, reason, total_users, total_batches)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+RETURNING id, action, progression_type, progression_key, actor, reason, status, total_users, processed_users,
+          affected_users, total_batches, completed_batches, error, created_at, updated_at, completed_at;
+
+-- name: GetProgressionBulkOperation :one
+SELECT id, action, progression_type, progression_key, actor, reason, status, total_users, processed_users,
+       affected_users, total_batches, completed_batches, error, created_at, updated_at, completed_at
+FROM progression_bulk_operations
+WHERE id = $1;
+
+-- name: SetProgressionBulkStatus :exec
+UPDATE progression_bulk_operations
+SET status = $2,
+    error = $3,
+    updated_at = NOW(),
+    completed_at = CASE WHEN $2 IN ('completed', 'failed') THEN NOW() ELSE completed_at END
+WHERE id = $1;
+
+-- name: RecordProgressionBulkBatch :exec
+UPDATE progression_bulk_operations
+SET processed_users = processed_users + sqlc.arg(processed)::int,
+    affected_users = affected_users + sqlc.arg(affected)::int,
+    completed_batches = completed_batches + 1,
+    updated_at = NOW()
+WHERE id = sqlc.arg(id);
+
+-- name: InsertProgressionBulkAudit :exec
+INSERT INTO progression_bulk_audit (operation_id, batch_number, user_ids, affected_users)
+VALUES ($1, $2, $3, $4);
+
+-- name: GetProgressionBulkAudit :many
+SELECT id, operation_id, batch_number, user_ids, affected_users, created_at
+FROM progression_bulk_audit
+WHERE operation_id = $1
+ORDER BY batch_number;
+
+-- Only existing users are granted the progression.
+-- name: BulkGrantUserProgression :execrows
+INSERT INTO user_progression (user_id, progression_type, progression_key, metadata)
+SELECT u.user_id::text, sqlc.arg(progression_type)::varchar, sqlc.arg(progression_key)::varchar, sqlc.arg(metadata)::jsonb
+FROM users u
+WHERE u.user_id::text = ANY(sqlc.arg(user_ids)::text[])
+ON CONFLICT (user_id, progression_type, progression_key) DO NOTHING;
+
+-- name: BulkRevokeUserProgression :execrows
+DELETE FROM user_progression
+WHERE user_id = ANY(sqlc.arg(user_ids)::text[])
+  AND progression_type = sqlc.arg(progression_type)
+  AND progression_key = sqlc.arg(progression_key);
+
+-- Users who recorded a stats event of the type within the window.
+-- name: GetStatsEventParticipants :many
+SELECT DISTINCT user_id::text AS user_id
+FROM stats_events
+WHERE event_type = sqlc.arg(event_type) AND user_id IS NOT NULL
+  AND created_at >= sqlc.arg(since)::timestamp AND created_at < sqlc.arg(until)::timestamp
+ORDER BY user_id;
@@ -0,0 +1,16 @@
+-- Options in open voting sessions whose stored vote_count disagrees with the
+-- number of recorded, non-excluded user votes.
+-- name: GetVoteCountDrift :many
+SELECT o.id, o.session_id, o.vote_count, COALESCE(SUM(v.weight), 0)::int AS recorded_votes
+FROM progression_voting_options o
+JOIN progression_voting_sessions s ON s.id = o.session_id
+LEFT JOIN user_votes v ON v.option_id = o.id AND v.excluded_at IS NULL
+WHERE s.status IN ('voting', 'frozen')
+GROUP BY o.id, o.session_id, o.vote_count
+HAVING o.vote_count <> COALESCE(SUM(v.weight), 0)
+ORDER BY o.id;
+
+-- name: SetOptionVoteCount :exec
+UPDATE progression_voting_options
+SET vote_count = $2
+WHERE id = $1;
@@ -0,0 +1,33 @@
+-- name: UpsertReminder :one
+INSERT INTO reminders (user_id, platform, platform_id, kind, target, message, channel_id, remind_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+ON CONFLICT (user_id, kind, target) DO UPDATE
+SET platform = EXCLUDED.platform,
+    platform_id = EXCLUDED.platform_id,
+    message = EXCLUDED.message,
+    channel_id = EXCLUDED.channel_id,
+    remind_at = EXCLUDED.remind_at,
+    created_at = NOW()
+RETURNING id, user_id, platform, platform_id, kind, target, message, channel_id, remind_at, created_at;
+
+-- name: ListUserReminders :many
+SELECT id, user_id, platform, platform_id, kind, target, message, channel_id, remind_at, created_at
+FROM reminders
+WHERE user_id = $1
+ORDER BY remind_at, id;
+
+-- name: DeleteUserReminder :execrows
+DELETE FROM reminders WHERE id = $1 AND user_id = $2;
+
+-- Removes and returns due reminders. SKIP LOCKED lets several instances
+-- claim batches concurrently without delivering a reminder twice.
+-- name: ClaimDueReminders :many
+DELETE FROM reminders
+WHERE id IN (
+    SELECT r.id FROM reminders r
+    WHERE r.remind_at <= sqlc.arg(now)::timestamptz
+    ORDER BY r.remind_at, r.id
+    LIMIT sqlc.arg(batch_size)::int
+    FOR UPDATE SKIP LOCKED
+)
+RETURNING id, user_id, platform, platform_id, kind, target, message, channel_id, remind_at, created_at;
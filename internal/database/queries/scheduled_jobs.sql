@@ -0,0 +1,23 @@
+-- name: GetScheduledJob :one
+SELECT name, next_run_at, last_run_at, updated_at
+FROM scheduled_jobs
+WHERE name = $1;
+
+-- name: UpsertScheduledJob :exec
+INSERT INTO scheduled_jobs (name, next_run_at, last_run_at, updated_at)
+VALUES ($1, $2, $3, NOW())
+ON CONFLICT (name) DO UPDATE
+SET next_run_at = EXCLUDED.next_run_at,
+    last_run_at = EXCLUDED.last_run_at,
+    updated_at = NOW();
+
+-- Takes the lease if it is free, expired, or already held by the caller.
+-- Expiry is computed from the database clock so instance clock skew does not matter.
+-- name: AcquireSchedulerLease :execrows
+INSERT INTO scheduler_leases (name, holder, expires_at)
+VALUES (sqlc.arg(name), sqlc.arg(holder), NOW() + (sqlc.arg(ttl_ms)::bigint * INTERVAL '1 millisecond'))
+ON CONFLICT (name) DO UPDATE
+SET holder = EXCLUDED.holder,
+    expires_at = EXCLUDED.expires_at
+WHERE scheduler_leases.expires_at < NOW()
+   OR scheduler_leases.holder = EXCLUDED.holder;
@@ -0,0 +1,40 @@
+-- name: GetUserSearchProgress :one
+SELECT user_id, current_streak, best_streak, last_search_date, total_searches, mastery_level, updated_at
+FROM user_search_progress
+WHERE user_id = $1;
+
+-- name: RecordUserSearch :one
+-- Counts a search on search_date, extending the streak when the previous
+-- search was the day before and restarting it after a missed day. Returns
+-- the date of the search before this one (NULL for a first search).
+WITH previous AS (
+    SELECT last_search_date FROM user_search_progress WHERE user_id = @user_id
+)
+INSERT INTO user_search_progress (user_id, current_streak, best_streak, last_search_date, total_searches, updated_at)
+VALUES (@user_id, 1, 1, @search_date::date, 1, NOW())
+ON CONFLICT (user_id) DO UPDATE
+SET current_streak = CASE
+        WHEN user_search_progress.last_search_date >= EXCLUDED.last_search_date THEN user_search_progress.current_streak
+        WHEN user_search_progress.last_search_date = EXCLUDED.last_search_date - 1 THEN user_search_progress.current_streak + 1
+        ELSE 1
+    END,
+    best_streak = GREATEST(user_search_progress.best_streak, CASE
+        WHEN user_search_progress.last_search_date >= EXCLUDED.last_search_date THEN user_search_progress.current_streak
+        WHEN user_search_progress.last_search_date = EXCLUDED.last_search_date - 1 THEN user_search_progress.current_streak + 1
+        ELSE 1
+    END),
+    last_search_date = GREATEST(user_search_progress.last_search_date, EXCLUDED.last_search_date),
+    total_searches = user_search_progress.total_searches + 1,
+    updated_at = NOW()
+RETURNING user_id, current_streak, best_streak, last_search_date, total_searches, mastery_level, updated_at,
+    (SELECT last_search_date FROM previous)::date AS previous_search_date;
+
+-- name: UpdateUserSearchMasteryLevel :exec
+UPDATE user_search_progress
+SET mastery_level = $2, updated_at = NOW()
+WHERE user_id = $1 AND mastery_level < $2;
+
+-- name: CountRecentSearchers :one
+-- Counts users whose last search was at or after since.
+SELECT COUNT(*)::int FROM user_search_progress
+WHERE updated_at >= @since;
@@ -1,41 +1,63 @@
 -- name: RecordEvent :one
-INSERT INTO stats_events (user_id, event_type, event_data, created_at)
-VALUES ($1, $2, $3, $4)
+INSERT INTO stats_events (user_id, event_type, event_data, created_at, stream_session_id)
+VALUES ($1, $2, $3, $4,
+        (SELECT s.id FROM stream_sessions s
+         JOIN users u ON u.community_id = s.community_id
+         WHERE u.user_id = $1 AND s.ended_at IS NULL))
 RETURNING event_id, created_at;
 
 -- name: GetEventsByUser :many
-SELECT event_id, user_id, event_type, event_data, created_at
+SELECT event_id, user_id, event_type, event_data, created_at, stream_session_id
 FROM stats_events
 WHERE user_id = $1 AND created_at >= $2 AND created_at <= $3
 ORDER BY created_at DESC;
 
 -- name: GetUserEventsByType :many
-SELECT event_id, user_id, event_type, event_data, created_at
+SELECT event_id, user_id, event_type, event_data, created_at, stream_session_id
 FROM stats_events
 WHERE user_id = $1 AND event_type = $2
 ORDER BY created_at DESC
 LIMIT $3;
 
 -- name: GetEventsByType :many
-SELECT event_id, user_id, event_type, event_data, created_at
+SELECT event_id, user_id, event_type, event_data, created_at, stream_session_id
 FROM stats_events
 WHERE event_type = $1 AND created_at >= $2 AND created_at <= $3
 ORDER BY created_at DESC;
 
 -- name: GetTopUsers :many
-SELECT se.user_id, u.username, COUNT(*) as event_count
+SELECT se.user_id, u.username, COUNT(*) as event_count, COALESCE(us.leaderboard_private, FALSE)::boolean as is_private
 FROM stats_events se
 JOIN users u ON se.user_id = u.user_id
-WHERE se.event_type = $1 AND se.created_at >= $2 AND se.created_at <= $3
-GROUP BY se.user_id, u.username
-ORDER BY event_count DESC
-LIMIT $4;
+LEFT JOIN user_settings us ON us.user_id = se.user_id
+WHERE se.event_type = $1 AND se.created_at >= $2 AND se.created_at <= $3 AND u.community_id = $6
+GROUP BY se.user_id, u.username, us.leaderboard_private
+ORDER BY event_count DESC, se.user_id
+LIMIT $4 OFFSET $5;
+
+-- name: GetUserLeaderboardRank :one
+WITH counts AS (
+    SELECT se.user_id, COUNT(*) AS event_count
+    FROM stats_events se
+    JOIN users u ON se.user_id = u.user_id
+    WHERE se.event_type = $1 AND se.created_at >= $2 AND se.created_at <= $3 AND u.community_id = $5
+    GROUP BY se.user_id
+)
+SELECT (
+    SELECT COUNT(*) + 1
+    FROM counts c
+    WHERE c.event_count > t.event_count
+       OR (c.event_count = t.event_count AND c.user_id < t.user_id)
+)::bigint AS rank
+FROM counts t
+WHERE t.user_id = $4;
 
 -- name: GetEventCounts :many
-SELECT event_type, COUNT(*) as count
-FROM stats_events
-WHERE created_at >= $1 AND created_at <= $2
-GROUP BY event_type;
+SELECT se.event_type, COUNT(*) as count
+FROM stats_events se
+JOIN users u ON se.user_id = u.user_id
+WHERE se.created_at >= $1 AND se.created_at <= $2 AND u.community_id = $3
+GROUP BY se.event_type;
 
 -- name: GetUserEventCounts :many
 SELECT event_type, COUNT(*) as count
@@ -45,5 +67,15 @@ GROUP BY event_type;
 
 -- name: GetTotalEventCount :one
 SELECT COUNT(*)
+FROM stats_events se
+JOIN users u ON se.user_id = u.user_id
+WHERE se.created_at >= $1 AND se.created_at <= $2 AND u.community_id = $3;
+
+-- name: GetUserRecipeCraftCounts :many
+-- Total items crafted by a user per recipe, all time
+SELECT
+    (event_data->>'recipe_key')::text AS recipe_key,
+    COALESCE(SUM((event_data->>'quantity')::int), 0)::bigint AS crafts
 FROM stats_events
-WHERE created_at >= $1 AND created_at <= $2;
+WHERE user_id = $1 AND event_type = 'recipe_crafted'
+GROUP BY event_data->>'recipe_key';
@@ -0,0 +1,57 @@
+-- name: GetEarliestStatsEventTime :one
+SELECT MIN(created_at)::timestamp
+FROM stats_events;
+
+-- name: GetStatsRollupProgress :one
+SELECT rolled_through
+FROM stats_rollup_progress
+WHERE bucket = $1;
+
+-- name: SetStatsRollupProgress :exec
+INSERT INTO stats_rollup_progress (bucket, rolled_through)
+VALUES ($1, $2)
+ON CONFLICT (bucket) DO UPDATE SET rolled_through = EXCLUDED.rolled_through;
+
+-- Recounts every bucket between from_time and to_time, which must fall on
+-- bucket boundaries, so rolling up the same range twice is safe
+-- name: RollUpStatsEvents :execrows
+INSERT INTO stats_rollups (bucket, bucket_start, user_id, event_type, event_count)
+SELECT sqlc.arg(bucket)::text, date_trunc(sqlc.arg(bucket)::text, se.created_at), se.user_id, se.event_type, COUNT(*)::int
+FROM stats_events se
+WHERE se.user_id IS NOT NULL
+  AND se.created_at >= sqlc.arg(from_time)::timestamp
+  AND se.created_at < sqlc.arg(to_time)::timestamp
+GROUP BY 2, se.user_id, se.event_type
+ON CONFLICT (bucket, bucket_start, user_id, event_type) DO UPDATE SET event_count = EXCLUDED.event_count;
+
+-- Sums rolled-up counts grouped by whichever of bucket start, user and event
+-- type are asked for; the others come back NULL
+-- name: QueryStatsRollups :many
+SELECT
+    CASE WHEN sqlc.arg(by_time)::bool THEN r.bucket_start END::timestamp AS bucket_start,
+    CASE WHEN sqlc.arg(by_user)::bool THEN r.user_id END::uuid AS user_id,
+    CASE WHEN sqlc.arg(by_event_type)::bool THEN r.event_type END::text AS event_type,
+    SUM(r.event_count)::bigint AS event_count
+FROM stats_rollups r
+WHERE r.bucket = sqlc.arg(bucket)::text
+  AND r.bucket_start >= sqlc.arg(from_time)::timestamp
+  AND r.bucket_start < sqlc.arg(to_time)::timestamp
+  AND (sqlc.narg(user_id)::uuid IS NULL OR r.user_id = sqlc.narg(user_id)::uuid)
+  AND (sqlc.narg(event_type)::text IS NULL OR r.event_type = sqlc.narg(event_type)::text)
+GROUP BY 1, 2, 3;
+
+-- Same as QueryStatsRollups but counts raw events, for the part of a range
+-- that has not been rolled up yet
+-- name: QueryStatsEvents :many
+SELECT
+    CASE WHEN sqlc.arg(by_time)::bool THEN date_trunc(sqlc.arg(bucket)::text, se.created_at) END::timestamp AS bucket_start,
+    CASE WHEN sqlc.arg(by_user)::bool THEN se.user_id END::uuid AS user_id,
+    CASE WHEN sqlc.arg(by_event_type)::bool THEN se.event_type END::text AS event_type,
+    COUNT(*)::bigint AS event_count
+FROM stats_events se
+WHERE se.user_id IS NOT NULL
+  AND se.created_at >= sqlc.arg(from_time)::timestamp
+  AND se.created_at < sqlc.arg(to_time)::timestamp
+  AND (sqlc.narg(user_id)::uuid IS NULL OR se.user_id = sqlc.narg(user_id)::uuid)
+  AND (sqlc.narg(event_type)::text IS NULL OR se.event_type = sqlc.narg(event_type)::text)
+GROUP BY 1, 2, 3;
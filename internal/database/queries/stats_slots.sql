@@ -19,13 +19,16 @@ SELECT
     se.user_id,
     u.username,
     COALESCE(SUM((se.event_data->>'payout_amount')::int) - SUM((se.event_data->>'bet_amount')::int), 0) as net_profit,
-    COUNT(*) FILTER (WHERE se.event_type = 'slots_spin') as total_spins
+    COUNT(*) FILTER (WHERE se.event_type = 'slots_spin') as total_spins,
+    COALESCE(us.leaderboard_private, FALSE)::boolean as is_private
 FROM stats_events se
 JOIN users u ON se.user_id = u.user_id
+LEFT JOIN user_settings us ON us.user_id = se.user_id
 WHERE se.event_type = 'slots_spin'
   AND se.created_at >= sqlc.arg(start_time)
   AND se.created_at <= sqlc.arg(end_time)
-GROUP BY se.user_id, u.username
+  AND u.community_id = sqlc.arg(community_id)
+GROUP BY se.user_id, u.username, us.leaderboard_private
 ORDER BY net_profit DESC
 LIMIT sqlc.arg(result_limit);
 
@@ -40,13 +43,16 @@ SELECT
         WHEN COUNT(*) FILTER (WHERE se.event_type = 'slots_spin') > 0
         THEN (COUNT(*) FILTER (WHERE se.event_type = 'slots_win')::float / COUNT(*) FILTER (WHERE se.event_type = 'slots_spin')::float * 100)
         ELSE 0
-    END as win_rate
+    END as win_rate,
+    COALESCE(us.leaderboard_private, FALSE)::boolean as is_private
 FROM stats_events se
 JOIN users u ON se.user_id = u.user_id
+LEFT JOIN user_settings us ON us.user_id = se.user_id
 WHERE se.event_type LIKE 'slots_%'
   AND se.created_at >= sqlc.arg(start_time)
   AND se.created_at <= sqlc.arg(end_time)
-GROUP BY se.user_id, u.username
+  AND u.community_id = sqlc.arg(community_id)
+GROUP BY se.user_id, u.username, us.leaderboard_private
 HAVING COUNT(*) FILTER (WHERE se.event_type = 'slots_spin') >= sqlc.arg(min_spins)::int8
 ORDER BY win_rate DESC
 LIMIT sqlc.arg(result_limit);
@@ -56,12 +62,15 @@ LIMIT sqlc.arg(result_limit);
 SELECT
     se.user_id,
     u.username,
-    COUNT(*) as mega_jackpots_hit
+    COUNT(*) as mega_jackpots_hit,
+    COALESCE(us.leaderboard_private, FALSE)::boolean as is_private
 FROM stats_events se
 JOIN users u ON se.user_id = u.user_id
+LEFT JOIN user_settings us ON us.user_id = se.user_id
 WHERE se.event_type = 'slots_mega_jackpot'
   AND se.created_at >= sqlc.arg(start_time)
   AND se.created_at <= sqlc.arg(end_time)
-GROUP BY se.user_id, u.username
+  AND u.community_id = sqlc.arg(community_id)
+GROUP BY se.user_id, u.username, us.leaderboard_private
 ORDER BY mega_jackpots_hit DESC
 LIMIT sqlc.arg(result_limit);
@@ -0,0 +1,99 @@
+-- Opens a session unless the community already has a live one, in which
+-- case no row is returned.
+-- name: StartStreamSession :one
+INSERT INTO stream_sessions (community_id, platform, title)
+VALUES ($1, $2, $3)
+ON CONFLICT (community_id) WHERE ended_at IS NULL DO NOTHING
+RETURNING id, community_id, platform, title, started_at, ended_at;
+
+-- name: GetLiveStreamSession :one
+SELECT id, community_id, platform, title, started_at, ended_at
+FROM stream_sessions
+WHERE community_id = $1 AND ended_at IS NULL;
+
+-- name: EndStreamSession :one
+UPDATE stream_sessions
+SET ended_at = NOW()
+WHERE community_id = $1 AND ended_at IS NULL
+RETURNING id, community_id, platform, title, started_at, ended_at;
+
+-- name: GetStreamSession :one
+SELECT id, community_id, platform, title, started_at, ended_at
+FROM stream_sessions
+WHERE id = $1 AND community_id = $2;
+
+-- Totals over the stats events tagged with a session. Chat drops record one
+-- chat_drop event per drop with the quantity in event_data.
+-- name: GetStreamSessionActivity :one
+SELECT COUNT(*)::bigint AS events,
+       COUNT(DISTINCT user_id)::bigint AS active_users,
+       (COUNT(*) FILTER (WHERE event_type = 'chat_drop'))::bigint AS drops,
+       COALESCE(SUM((event_data->>'quantity')::int) FILTER (WHERE event_type = 'chat_drop'), 0)::bigint AS dropped_items
+FROM stats_events
+WHERE stream_session_id = $1;
+
+-- name: GetStreamSessionEventCounts :many
+SELECT event_type, COUNT(*)::bigint AS count
+FROM stats_events
+WHERE stream_session_id = $1
+GROUP BY event_type
+ORDER BY count DESC, event_type;
+
+-- Most active users of a session, leaving out users who hid themselves from
+-- leaderboards.
+-- name: GetStreamSessionTopUsers :many
+SELECT se.user_id, u.username, COUNT(*)::bigint AS events
+FROM stats_events se
+JOIN users u ON se.user_id = u.user_id
+LEFT JOIN user_settings us ON us.user_id = se.user_id
+WHERE se.stream_session_id = $1
+  AND NOT COALESCE(us.leaderboard_private, FALSE)
+GROUP BY se.user_id, u.username
+ORDER BY events DESC, se.user_id
+LIMIT $2;
+
+-- name: GetStreamSessionGambleStats :one
+SELECT COUNT(*)::bigint AS gambles,
+       COALESCE(SUM((SELECT COUNT(*) FROM gamble_participants p WHERE p.gamble_id = g.id)), 0)::bigint AS participants,
+       COALESCE(SUM((SELECT SUM(o.value) FROM gamble_opened_items o WHERE o.gamble_id = g.id)), 0)::bigint AS total_value
+FROM gambles g
+WHERE g.stream_session_id = $1;
+
+-- The largest pot won in a session, from the gamble_won stats events
+-- recorded when gambles complete.
+-- name: GetStreamSessionBiggestGambleWin :one
+SELECT se.user_id, u.username,
+       (se.event_data->>'gamble_id')::text AS gamble_id,
+       (se.event_data->>'total_value')::bigint AS total_value,
+       COALESCE((se.event_data->>'participants')::int, 0)::int AS participants
+FROM stats_events se
+JOIN users u ON se.user_id = u.user_id
+WHERE se.stream_session_id = $1 AND se.event_type = 'gamble_won'
+ORDER BY total_value DESC, se.event_id
+LIMIT 1;
+
+-- The user with the most weighted engagement between started_at and ended_at,
+-- leaving out users who hid themselves from leaderboards.
+-- name: GetStreamSessionTopContributor :one
+SELECT u.user_id, u.username,
+       SUM(em.metric_value * COALESCE(ew.weight, 1.0))::double precision AS score
+FROM engagement_metrics em
+JOIN users u ON u.user_id::text = em.user_id
+LEFT JOIN engagement_weights ew ON ew.metric_type = em.metric_type
+LEFT JOIN user_settings us ON us.user_id = u.user_id
+WHERE u.community_id = sqlc.arg(community_id)
+  AND em.recorded_at >= sqlc.arg(started_at)::timestamptz
+  AND em.recorded_at < sqlc.arg(ended_at)::timestamptz
+  AND NOT COALESCE(us.leaderboard_private, FALSE)
+GROUP BY u.user_id, u.username
+ORDER BY score DESC, u.user_id
+LIMIT 1;
+
+-- name: GetStreamSessionUnlocks :many
+SELECT n.node_key, n.display_name, pu.current_level, pu.unlocked_at
+FROM progression_unlocks pu
+JOIN progression_nodes n ON n.id = pu.node_id
+WHERE pu.community_id = sqlc.arg(community_id)
+  AND pu.unlocked_at >= sqlc.arg(started_at)::timestamptz
+  AND pu.unlocked_at < sqlc.arg(ended_at)::timestamptz
+ORDER BY pu.unlocked_at, pu.id;
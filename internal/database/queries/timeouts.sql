@@ -0,0 +1,38 @@
+-- Accumulates: the duration is added to whatever is left of an active timeout
+-- name: AddUserTimeout :one
+INSERT INTO user_timeouts (platform, username, expires_at, reason)
+VALUES (sqlc.arg(platform), sqlc.arg(username), NOW() + make_interval(secs => sqlc.arg(seconds)::float8), sqlc.arg(reason))
+ON CONFLICT (platform, username) DO UPDATE
+SET expires_at = GREATEST(user_timeouts.expires_at, NOW()) + make_interval(secs => sqlc.arg(seconds)::float8),
+    reason = EXCLUDED.reason
+RETURNING expires_at;
+
+-- name: GetUserTimeout :one
+SELECT expires_at
+FROM user_timeouts
+WHERE platform = $1 AND username = $2 AND expires_at > NOW();
+
+-- name: ReduceUserTimeout :one
+UPDATE user_timeouts
+SET expires_at = expires_at - make_interval(secs => sqlc.arg(seconds)::float8)
+WHERE platform = sqlc.arg(platform) AND username = sqlc.arg(username) AND expires_at > NOW()
+RETURNING expires_at;
+
+-- Counts only a timeout that was still active
+-- name: DeleteUserTimeout :execrows
+DELETE FROM user_timeouts
+WHERE platform = $1 AND username = $2 AND expires_at > NOW();
+
+-- name: DeleteExpiredUserTimeout :exec
+DELETE FROM user_timeouts
+WHERE platform = $1 AND username = $2 AND expires_at <= NOW();
+
+-- name: DeleteExpiredUserTimeouts :exec
+DELETE FROM user_timeouts
+WHERE expires_at <= NOW();
+
+-- name: GetActiveUserTimeouts :many
+SELECT platform, username, expires_at, reason
+FROM user_timeouts
+WHERE expires_at > NOW()
+ORDER BY expires_at;
@@ -0,0 +1,20 @@
+-- name: InsertUndoEntry :exec
+INSERT INTO undo_entries (user_id, action, changes, expires_at)
+VALUES ($1, $2, $3, $4);
+
+-- name: DeleteExpiredUserUndoEntries :exec
+DELETE FROM undo_entries
+WHERE user_id = $1 AND expires_at <= $2;
+
+-- Locks the user's newest entry that can still be undone
+-- name: GetLatestUndoEntryForUpdate :one
+SELECT id, user_id, action, changes, created_at, expires_at
+FROM undo_entries
+WHERE user_id = $1 AND expires_at > $2
+ORDER BY id DESC
+LIMIT 1
+FOR UPDATE;
+
+-- name: DeleteUndoEntry :exec
+DELETE FROM undo_entries
+WHERE id = $1;
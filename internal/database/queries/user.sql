@@ -15,14 +15,64 @@ VALUES ($1, $2)
 ON CONFLICT (user_id) DO UPDATE
 SET inventory_data = EXCLUDED.inventory_data;
 
+-- Applies a quantity delta to the first (item, quality) slot in a single
+-- statement, matching utils.AdjustSlotQuantity. The slot is created when
+-- missing and dropped when it reaches zero. No row is updated when the result
+-- would be negative. Slot order is preserved.
+-- name: AdjustInventorySlot :one
+WITH cur AS (
+    SELECT ui.user_id,
+           ui.inventory_data,
+           target.ord,
+           COALESCE(target.quantity, 0) AS quantity
+    FROM user_inventory ui
+    LEFT JOIN LATERAL (
+        SELECT e.ord, (e.s->>'quantity')::int AS quantity
+        FROM jsonb_array_elements(COALESCE(ui.inventory_data->'slots', '[]'::jsonb)) WITH ORDINALITY AS e(s, ord)
+        WHERE (e.s->>'item_id')::int = sqlc.arg(item_id)::int
+          AND COALESCE(e.s->>'quality', '') = sqlc.arg(quality)::text
+        ORDER BY e.ord
+        LIMIT 1
+    ) AS target ON true
+    WHERE ui.user_id = sqlc.arg(user_id)
+    FOR UPDATE OF ui
+)
+UPDATE user_inventory
+SET inventory_data = jsonb_set(cur.inventory_data, '{slots}', (
+    SELECT COALESCE(jsonb_agg(adjusted.slot ORDER BY adjusted.ord), '[]'::jsonb)
+    FROM (
+        SELECT CASE
+                   WHEN e.ord = cur.ord
+                   THEN jsonb_set(e.s, '{quantity}', to_jsonb(cur.quantity + sqlc.arg(delta)::int))
+                   ELSE e.s
+               END AS slot,
+               e.ord
+        FROM jsonb_array_elements(COALESCE(cur.inventory_data->'slots', '[]'::jsonb)) WITH ORDINALITY AS e(s, ord)
+        UNION ALL
+        SELECT CASE
+                   WHEN sqlc.arg(quality)::text = ''
+                   THEN jsonb_build_object('item_id', sqlc.arg(item_id)::int, 'quantity', sqlc.arg(delta)::int)
+                   ELSE jsonb_build_object('item_id', sqlc.arg(item_id)::int, 'quantity', sqlc.arg(delta)::int, 'quality', sqlc.arg(quality)::text)
+               END,
+               9223372036854775807
+        WHERE sqlc.arg(delta)::int > 0
+          AND cur.ord IS NULL
+    ) AS adjusted
+    WHERE (adjusted.slot->>'quantity')::int > 0
+))
+FROM cur
+WHERE user_inventory.user_id = cur.user_id
+  AND cur.quantity + sqlc.arg(delta)::int >= 0
+RETURNING (cur.quantity + sqlc.arg(delta)::int)::int AS quantity;
+
 -- name: CreateUser :one
-INSERT INTO users (username, created_at, updated_at)
-VALUES ($1, NOW(), NOW())
+INSERT INTO users (username, community_id, created_at, updated_at)
+VALUES ($1, $2, NOW(), NOW())
 RETURNING user_id;
 
 -- name: CreateUserWithID :one
-INSERT INTO users (user_id, username, created_at, updated_at)
-VALUES ($1, $2, NOW(), NOW())
+INSERT INTO users (user_id, username, community_id, created_at, updated_at)
+VALUES ($1, $2, $3, NOW(), NOW())
 RETURNING user_id;
 
 -- name: UpdateUser :exec
@@ -34,8 +84,8 @@ WHERE user_id = $2;
 SELECT platform_id FROM platforms WHERE name = $1;
 
 -- name: UpsertUserPlatformLink :exec
-INSERT INTO user_platform_links (user_id, platform_id, platform_user_id, platform_username)
-VALUES ($1, $2, $3, $4)
+INSERT INTO user_platform_links (user_id, platform_id, platform_user_id, platform_username, community_id)
+VALUES ($1, $2, $3, $4, $5)
 ON CONFLICT (user_id, platform_id) DO UPDATE
 SET platform_user_id = EXCLUDED.platform_user_id, 
     platform_username = COALESCE(EXCLUDED.platform_username, user_platform_links.platform_username);
@@ -45,7 +95,7 @@ SELECT u.user_id, u.username
 FROM users u
 JOIN user_platform_links upl ON u.user_id = upl.user_id
 JOIN platforms p ON upl.platform_id = p.platform_id
-WHERE p.name = $1 AND upl.platform_user_id = $2;
+WHERE p.name = $1 AND upl.platform_user_id = $2 AND upl.community_id = $3;
 
 -- name: GetUserPlatformLinks :many
 SELECT p.name, upl.platform_user_id, upl.platform_username
@@ -59,7 +109,8 @@ FROM users u
 JOIN user_platform_links upl ON u.user_id = upl.user_id
 JOIN platforms p ON upl.platform_id = p.platform_id
 WHERE LOWER(u.username) = LOWER($1)
-AND p.name = $2;
+AND p.name = $2
+AND u.community_id = $3;
 
 -- name: GetItemByName :one
 SELECT 
@@ -145,7 +196,7 @@ SELECT EXISTS (
 );
 
 -- name: GetRecipeByTargetItemID :one
-SELECT recipe_id, recipe_key, target_item_id, base_cost, created_at, required_job_level, is_auto_unlock 
+SELECT recipe_id, recipe_key, target_item_id, base_cost, created_at, required_job_level, is_auto_unlock, event_theme
 FROM crafting_recipes 
 WHERE target_item_id = $1;
 
@@ -191,17 +242,6 @@ SELECT upgrade_recipe_id
 FROM recipe_associations
 WHERE disassemble_recipe_id = $1;
 
--- name: GetLastCooldown :one
-SELECT last_used_at
-FROM user_cooldowns
-WHERE user_id = $1 AND action_name = $2;
-
--- name: GetLastCooldownForUpdate :one
-SELECT last_used_at
-FROM user_cooldowns
-WHERE user_id = $1 AND action_name = $2
-FOR UPDATE;
-
 -- name: UpdateCooldown :exec
 INSERT INTO user_cooldowns (user_id, action_name, last_used_at)
 VALUES ($1, $2, $3)
@@ -217,7 +257,7 @@ WHERE it.type_name = 'buyable' AND i.public_name IS NOT NULL
 ORDER BY i.public_name;
 
 -- name: GetUserByID :one
-SELECT user_id, username, created_at, updated_at FROM users WHERE user_id = $1;
+SELECT user_id, username, created_at, updated_at, community_id FROM users WHERE user_id = $1;
 
 -- name: DeleteUser :exec
 DELETE FROM users WHERE user_id = $1;
@@ -0,0 +1,25 @@
+-- Grants an effect. While one of the same type is active the stronger
+-- magnitude is kept and the duration is added to the current expiry; an
+-- expired effect is replaced.
+-- name: GrantUserEffect :one
+INSERT INTO user_effects (user_id, effect_type, magnitude, expires_at)
+VALUES (sqlc.arg(user_id), sqlc.arg(effect_type), sqlc.arg(magnitude), NOW() + (sqlc.arg(duration_ms)::bigint * INTERVAL '1 millisecond'))
+ON CONFLICT (user_id, effect_type) DO UPDATE
+SET magnitude = CASE WHEN user_effects.expires_at > NOW()
+                     THEN GREATEST(user_effects.magnitude, EXCLUDED.magnitude)
+                     ELSE EXCLUDED.magnitude END,
+    expires_at = GREATEST(user_effects.expires_at, NOW()) + (sqlc.arg(duration_ms)::bigint * INTERVAL '1 millisecond'),
+    created_at = CASE WHEN user_effects.expires_at > NOW()
+                      THEN user_effects.created_at
+                      ELSE NOW() END
+RETURNING user_id, effect_type, magnitude, expires_at, created_at;
+
+-- name: ListActiveUserEffects :many
+SELECT user_id, effect_type, magnitude, expires_at, created_at
+FROM user_effects
+WHERE user_id = $1 AND expires_at > NOW()
+ORDER BY expires_at;
+
+-- name: DeleteExpiredUserEffects :execrows
+DELETE FROM user_effects
+WHERE expires_at <= sqlc.arg(now);
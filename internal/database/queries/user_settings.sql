@@ -0,0 +1,23 @@
+-- name: GetUserSettings :one
+SELECT leaderboard_private, targeting_opt_out, name_theme FROM user_settings WHERE user_id = $1;
+
+-- name: UpsertUserLeaderboardPrivate :exec
+INSERT INTO user_settings (user_id, leaderboard_private, updated_at)
+VALUES ($1, $2, NOW())
+ON CONFLICT (user_id) DO UPDATE
+SET leaderboard_private = EXCLUDED.leaderboard_private,
+    updated_at = NOW();
+
+-- name: UpsertUserNameTheme :exec
+INSERT INTO user_settings (user_id, name_theme, updated_at)
+VALUES ($1, $2, NOW())
+ON CONFLICT (user_id) DO UPDATE
+SET name_theme = EXCLUDED.name_theme,
+    updated_at = NOW();
+
+-- name: UpsertUserTargetingOptOut :exec
+INSERT INTO user_settings (user_id, targeting_opt_out, updated_at)
+VALUES ($1, $2, NOW())
+ON CONFLICT (user_id) DO UPDATE
+SET targeting_opt_out = EXCLUDED.targeting_opt_out,
+    updated_at = NOW();
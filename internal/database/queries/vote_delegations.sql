@@ -0,0 +1,41 @@
+-- name: UpsertVoteDelegation :exec
+INSERT INTO vote_delegations (delegator_id, delegate_id)
+VALUES ($1, $2)
+ON CONFLICT (delegator_id) DO UPDATE
+SET delegate_id = EXCLUDED.delegate_id,
+    created_at = NOW();
+
+-- name: DeleteVoteDelegation :execrows
+DELETE FROM vote_delegations
+WHERE delegator_id = $1;
+
+-- name: GetVoteDelegation :one
+SELECT delegator_id, delegate_id, created_at
+FROM vote_delegations
+WHERE delegator_id = $1;
+
+-- name: GetVoteDelegators :many
+SELECT delegator_id
+FROM vote_delegations
+WHERE delegate_id = $1
+ORDER BY delegator_id;
+
+-- Locks the user's vote in the session, if any. Must be used within a transaction.
+-- name: GetUserSessionVoteForUpdate :one
+SELECT option_id, weight, cast_by, excluded_at
+FROM user_votes
+WHERE user_id = $1 AND session_id = $2
+FOR UPDATE;
+
+-- Delegated votes never replace a vote already recorded for the delegator.
+-- name: RecordDelegatedVote :execrows
+INSERT INTO user_votes (user_id, session_id, option_id, node_id, target_level, weight, cast_by)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+ON CONFLICT (user_id, session_id) DO NOTHING;
+
+-- A user's own vote replaces the one a delegate cast for them.
+-- name: ReplaceDelegatedVote :exec
+UPDATE user_votes
+SET option_id = $3, node_id = $4, target_level = $5, weight = $6,
+    cast_by = NULL, voted_at = NOW()
+WHERE user_id = $1 AND session_id = $2 AND cast_by IS NOT NULL;
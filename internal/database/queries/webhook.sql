@@ -0,0 +1,56 @@
+-- name: CreateWebhook :one
+INSERT INTO webhooks (url, secret, event_types, description, created_by)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING id, url, secret, event_types, description, created_by, created_at;
+
+-- name: ListWebhooks :many
+SELECT id, url, secret, event_types, description, created_by, created_at
+FROM webhooks
+ORDER BY id;
+
+-- name: WebhookExists :one
+SELECT EXISTS(SELECT 1 FROM webhooks WHERE id = $1);
+
+-- name: DeleteWebhook :execrows
+DELETE FROM webhooks WHERE id = $1;
+
+-- Queues a delivery for every webhook subscribed to the event type
+-- name: EnqueueWebhookDeliveries :execrows
+INSERT INTO webhook_deliveries (webhook_id, event_type, body)
+SELECT w.id, sqlc.arg(event_type)::text, sqlc.arg(body)::jsonb
+FROM webhooks w
+WHERE sqlc.arg(event_type)::text = ANY(w.event_types);
+
+-- Leases due deliveries by pushing their next attempt past lease_until, so
+-- other instances skip them while they are being sent. SKIP LOCKED lets
+-- several instances claim batches concurrently.
+-- name: ClaimDueWebhookDeliveries :many
+UPDATE webhook_deliveries d
+SET next_attempt_at = sqlc.arg(lease_until)::timestamptz
+FROM webhooks w
+WHERE w.id = d.webhook_id
+  AND d.id IN (
+    SELECT due.id FROM webhook_deliveries due
+    WHERE due.status = 'pending' AND due.next_attempt_at <= sqlc.arg(now)::timestamptz
+    ORDER BY due.next_attempt_at, due.id
+    LIMIT sqlc.arg(batch_size)::int
+    FOR UPDATE SKIP LOCKED
+  )
+RETURNING d.id, d.webhook_id, d.event_type, d.body, d.attempts, w.url, w.secret;
+
+-- name: RecordWebhookDeliveryAttempt :exec
+UPDATE webhook_deliveries
+SET status = $2,
+    attempts = attempts + 1,
+    next_attempt_at = $3,
+    last_status_code = $4,
+    last_error = $5,
+    delivered_at = CASE WHEN $2 = 'delivered' THEN NOW() ELSE NULL END
+WHERE id = $1;
+
+-- name: ListWebhookDeliveries :many
+SELECT id, webhook_id, event_type, body, status, attempts, next_attempt_at, last_status_code, last_error, created_at, delivered_at
+FROM webhook_deliveries
+WHERE webhook_id = $1
+ORDER BY created_at DESC, id DESC
+LIMIT sqlc.arg(max_results)::int;
@@ -0,0 +1,16 @@
+-- name: RecordXPAward :exec
+INSERT INTO xp_awards (user_id, job_key, source, base_xp, xp_awarded, multiplier, capped_by, old_level, new_level, metadata)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10);
+
+-- Sums the XP a user earned from a source across all jobs since a point in time
+-- name: GetSourceXPSince :one
+SELECT COALESCE(SUM(xp_awarded), 0)::bigint AS total
+FROM xp_awards
+WHERE user_id = $1 AND source = $2 AND awarded_at >= $3;
+
+-- name: GetRecentXPAwards :many
+SELECT id, user_id, job_key, source, base_xp, xp_awarded, multiplier, capped_by, old_level, new_level, metadata, awarded_at
+FROM xp_awards
+WHERE user_id = $1
+ORDER BY awarded_at DESC, id DESC
+LIMIT $2;
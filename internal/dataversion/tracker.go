@@ -0,0 +1,78 @@
+package dataversion
+
+import (
+	"context"
+	"sync"
+
+	"github.com/osse101/BrandishBot_Go/internal/event"
+)
+
+// Resource identifies a semi-static data set that clients may cache
+type Resource string
+
+// Cacheable resources tracked by the Tracker
+const (
+	ResourcePrices  Resource = "prices"
+	ResourceRecipes Resource = "recipes"
+	ResourceTree    Resource = "progression_tree"
+)
+
+// AllResources lists every tracked resource
+var AllResources = []Resource{ResourcePrices, ResourceRecipes, ResourceTree}
+
+// Tracker holds monotonically increasing version counters for cacheable data.
+// Clients compare the counters exposed on /version against their cached copy
+// and refetch only the resources whose counter moved.
+type Tracker struct {
+	mu       sync.RWMutex
+	versions map[Resource]int64
+}
+
+// NewTracker creates a Tracker with every resource at version 1
+func NewTracker() *Tracker {
+	versions := make(map[Resource]int64, len(AllResources))
+	for _, r := range AllResources {
+		versions[r] = 1
+	}
+	return &Tracker{versions: versions}
+}
+
+// Subscribe bumps counters when progression changes alter what is unlocked.
+// Node unlocks change the tree, which items are buyable, and which recipes
+// are available, so all three resources move together.
+func (t *Tracker) Subscribe(bus event.Bus) {
+	bumpAll := func(_ context.Context, _ event.Event) error {
+		t.Bump(AllResources...)
+		return nil
+	}
+	event.SubscribeShared(bus, event.ProgressionNodeUnlocked, bumpAll)
+	event.SubscribeShared(bus, event.ProgressionNodeRelocked, bumpAll)
+	event.SubscribeShared(bus, event.ProgressionAllUnlocked, bumpAll)
+}
+
+// Bump increments the counters of the given resources
+func (t *Tracker) Bump(resources ...Resource) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	for _, r := range resources {
+		t.versions[r]++
+	}
+}
+
+// Version returns the current counter for a resource
+func (t *Tracker) Version(resource Resource) int64 {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return t.versions[resource]
+}
+
+// Snapshot returns a copy of all counters keyed by resource name
+func (t *Tracker) Snapshot() map[string]int64 {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	out := make(map[string]int64, len(t.versions))
+	for r, v := range t.versions {
+		out[string(r)] = v
+	}
+	return out
+}
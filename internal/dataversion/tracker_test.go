@@ -0,0 +1,49 @@
+package dataversion
+
+import (
+	"context"
+	"testing"
+
+	"github.com/osse101/BrandishBot_Go/internal/event"
+)
+
+func TestTracker_Bump(t *testing.T) {
+	tracker := NewTracker()
+	tracker.Bump(ResourcePrices, ResourceRecipes)
+	tracker.Bump(ResourcePrices)
+
+	snapshot := tracker.Snapshot()
+	if snapshot[string(ResourcePrices)] != 3 {
+		t.Errorf("prices = %d, want 3", snapshot[string(ResourcePrices)])
+	}
+	if snapshot[string(ResourceRecipes)] != 2 {
+		t.Errorf("recipes = %d, want 2", snapshot[string(ResourceRecipes)])
+	}
+	if snapshot[string(ResourceTree)] != 1 {
+		t.Errorf("tree = %d, want 1", snapshot[string(ResourceTree)])
+	}
+}
+
+func TestTracker_BumpsOnProgressionEvents(t *testing.T) {
+	for _, eventType := range []event.Type{
+		event.ProgressionNodeUnlocked,
+		event.ProgressionNodeRelocked,
+		event.ProgressionAllUnlocked,
+	} {
+		t.Run(string(eventType), func(t *testing.T) {
+			bus := event.NewMemoryBus()
+			tracker := NewTracker()
+			tracker.Subscribe(bus)
+
+			if err := bus.Publish(context.Background(), event.Event{Type: eventType}); err != nil {
+				t.Fatalf("Publish returned error: %v", err)
+			}
+
+			for _, r := range AllResources {
+				if got := tracker.Version(r); got != 2 {
+					t.Errorf("%s = %d, want 2", r, got)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,26 @@
+package digging
+
+// Metres a single dig moves the shaft down
+const (
+	MinDigMeters = 1
+	MaxDigMeters = 5
+)
+
+// TopDiggersLimit is how many diggers the site status lists
+const TopDiggersLimit = 5
+
+// Error messages
+const (
+	ErrMsgDigFailed           = "failed to dig: %w"
+	ErrMsgRecordDiggerFailed  = "failed to record digger: %w"
+	ErrMsgSetMilestoneFailed  = "failed to record dig milestone: %w"
+	ErrMsgGetSiteFailed       = "failed to get dig site: %w"
+	ErrMsgGetTopDiggersFailed = "failed to get top diggers: %w"
+)
+
+// Log messages
+const (
+	LogMsgDug                    = "User dug at the dig site"
+	LogMsgMilestoneReached       = "Dig site reached a new level"
+	LogWarnMilestoneContribution = "Failed to grant dig milestone contribution"
+)
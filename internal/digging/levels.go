@@ -0,0 +1,43 @@
+package digging
+
+import "github.com/osse101/BrandishBot_Go/internal/domain"
+
+// Levels are the dig site's layers, shallowest first. Reaching a level for
+// the first time grants its contribution toward the current unlock.
+var Levels = []domain.DigLevel{
+	{Level: 0, Name: "Topsoil", MinDepth: 0},
+	{Level: 1, Name: "Clay", MinDepth: 50, Contribution: 10},
+	{Level: 2, Name: "Gravel", MinDepth: 150, Contribution: 20},
+	{Level: 3, Name: "Bedrock", MinDepth: 300, Contribution: 35},
+	{Level: 4, Name: "Caverns", MinDepth: 500, Contribution: 50},
+	{Level: 5, Name: "Crystal Veins", MinDepth: 800, Contribution: 75},
+	{Level: 6, Name: "Magma", MinDepth: 1200, Contribution: 100},
+	{Level: 7, Name: "Core", MinDepth: 2000, Contribution: 200},
+}
+
+// LevelAt returns the level a shaft depth metres deep has reached and the
+// next one down, or nil past the last level
+func LevelAt(depth int) (domain.DigLevel, *domain.DigLevel) {
+	current := 0
+	for i, level := range Levels {
+		if depth >= level.MinDepth {
+			current = i
+		}
+	}
+	if current+1 < len(Levels) {
+		next := Levels[current+1]
+		return Levels[current], &next
+	}
+	return Levels[current], nil
+}
+
+// levelsBetween returns the levels deeper than from, down to and including to
+func levelsBetween(from, to int) []domain.DigLevel {
+	var levels []domain.DigLevel
+	for _, level := range Levels {
+		if level.Level > from && level.Level <= to {
+			levels = append(levels, level)
+		}
+	}
+	return levels
+}
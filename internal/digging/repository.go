@@ -0,0 +1,36 @@
+package digging
+
+import (
+	"context"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/repository"
+)
+
+// Repository stores the dig site and each user's share of the digging
+type Repository interface {
+	BeginTx(ctx context.Context) (Tx, error)
+
+	// GetSite returns the dig site
+	GetSite(ctx context.Context) (*domain.DigSite, error)
+
+	// GetTopDiggers returns up to limit users who have dug the most metres
+	GetTopDiggers(ctx context.Context, limit int) ([]domain.Digger, error)
+}
+
+// Tx deepens the site, credits the digger and claims any milestones in one
+// transaction. Deepening locks the site row, so concurrent digs cannot claim
+// the same milestone twice.
+type Tx interface {
+	repository.Tx
+
+	// Deepen moves the site meters deeper, counts the dig and returns the
+	// site after it
+	Deepen(ctx context.Context, meters int) (*domain.DigSite, error)
+
+	// RecordDigger adds meters and a dig to the user's share
+	RecordDigger(ctx context.Context, userID string, meters int) error
+
+	// SetMilestoneLevel records level as the deepest milestone granted
+	SetMilestoneLevel(ctx context.Context, level int) error
+}
@@ -0,0 +1,199 @@
+// Package digging runs the community dig site, a shaft every user digs
+// deeper together. Each dig is on a per-user cooldown; the first dig to
+// reach a new level grants that level's contribution toward the current
+// progression unlock.
+package digging
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"time"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/event"
+	"github.com/osse101/BrandishBot_Go/internal/logger"
+	"github.com/osse101/BrandishBot_Go/internal/repository"
+	"github.com/osse101/BrandishBot_Go/internal/rng"
+)
+
+// Service runs the community dig site
+type Service interface {
+	// Dig moves the site down for the user, on the dig cooldown. It returns
+	// the metres dug, the site's new depth and any milestones the dig
+	// reached first.
+	Dig(ctx context.Context, platform, platformID, username string) (*domain.DigResult, error)
+
+	// GetStatus returns the site's depth, levels and top diggers
+	GetStatus(ctx context.Context) (*domain.DigSiteStatus, error)
+}
+
+// UserService finds or registers the digger
+type UserService interface {
+	GetUserOrRegister(ctx context.Context, platform, platformID, username string) (*domain.User, error)
+}
+
+// CooldownService enforces the per-user dig cooldown
+type CooldownService interface {
+	EnforceCooldown(ctx context.Context, userID, action string, fn func() error) error
+}
+
+// ContributionAdder adds points toward the current progression unlock
+type ContributionAdder interface {
+	AddContribution(ctx context.Context, amount int) error
+}
+
+// Publisher publishes dig milestone events
+type Publisher interface {
+	PublishWithRetry(ctx context.Context, evt event.Event)
+}
+
+// Option configures optional service dependencies
+type Option func(*service)
+
+// WithRNG draws dig depths from the seeded RNG provider
+func WithRNG(provider *rng.Provider) Option {
+	return func(s *service) {
+		s.rng = provider
+	}
+}
+
+type service struct {
+	repo        Repository
+	users       UserService
+	cooldowns   CooldownService
+	progression ContributionAdder
+	publisher   Publisher
+	rng         *rng.Provider
+}
+
+// NewService creates a dig site service. publisher may be nil.
+func NewService(repo Repository, users UserService, cooldowns CooldownService, progression ContributionAdder, publisher Publisher, opts ...Option) Service {
+	s := &service{
+		repo:        repo,
+		users:       users,
+		cooldowns:   cooldowns,
+		progression: progression,
+		publisher:   publisher,
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+func (s *service) Dig(ctx context.Context, platform, platformID, username string) (*domain.DigResult, error) {
+	user, err := s.users.GetUserOrRegister(ctx, platform, platformID, username)
+	if err != nil {
+		return nil, err
+	}
+
+	var result *domain.DigResult
+	err = s.cooldowns.EnforceCooldown(ctx, user.ID, domain.ActionDig, func() error {
+		var digErr error
+		result, digErr = s.dig(ctx, user)
+		return digErr
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.grantMilestones(ctx, result)
+	return result, nil
+}
+
+// dig moves the site down and claims the levels it reached first
+func (s *service) dig(ctx context.Context, user *domain.User) (*domain.DigResult, error) {
+	meters := MinDigMeters + s.source(ctx).Intn(MaxDigMeters-MinDigMeters+1)
+
+	tx, err := s.repo.BeginTx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer repository.SafeRollback(ctx, tx)
+
+	site, err := tx.Deepen(ctx, meters)
+	if err != nil {
+		return nil, fmt.Errorf(ErrMsgDigFailed, err)
+	}
+	if err := tx.RecordDigger(ctx, user.ID, meters); err != nil {
+		return nil, fmt.Errorf(ErrMsgRecordDiggerFailed, err)
+	}
+
+	level, next := LevelAt(site.Depth)
+	milestones := levelsBetween(site.MilestoneLevel, level.Level)
+	if len(milestones) > 0 {
+		if err := tx.SetMilestoneLevel(ctx, level.Level); err != nil {
+			return nil, fmt.Errorf(ErrMsgSetMilestoneFailed, err)
+		}
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	logger.FromContext(ctx).Info(LogMsgDug, "user_id", user.ID, "meters", meters, "depth", site.Depth)
+
+	return &domain.DigResult{
+		UserID:     user.ID,
+		Username:   user.Username,
+		Meters:     meters,
+		Depth:      site.Depth,
+		Level:      level,
+		NextLevel:  next,
+		Milestones: milestones,
+	}, nil
+}
+
+// grantMilestones adds the contribution of each level the dig reached first
+// and announces it. The milestone is already claimed, so a failed
+// contribution is logged rather than undoing the dig.
+func (s *service) grantMilestones(ctx context.Context, result *domain.DigResult) {
+	log := logger.FromContext(ctx)
+	for _, level := range result.Milestones {
+		granted := 0
+		if level.Contribution > 0 {
+			if err := s.progression.AddContribution(ctx, level.Contribution); err != nil {
+				log.Warn(LogWarnMilestoneContribution, "level", level.Level, "points", level.Contribution, "error", err)
+			} else {
+				granted = level.Contribution
+			}
+		}
+		result.Contribution += granted
+
+		log.Info(LogMsgMilestoneReached, "level", level.Level, "name", level.Name, "depth", result.Depth, "user_id", result.UserID)
+		if s.publisher != nil {
+			s.publisher.PublishWithRetry(ctx, event.NewDigMilestoneEvent(level, granted, result))
+		}
+	}
+}
+
+func (s *service) GetStatus(ctx context.Context) (*domain.DigSiteStatus, error) {
+	site, err := s.repo.GetSite(ctx)
+	if err != nil {
+		return nil, fmt.Errorf(ErrMsgGetSiteFailed, err)
+	}
+	diggers, err := s.repo.GetTopDiggers(ctx, TopDiggersLimit)
+	if err != nil {
+		return nil, fmt.Errorf(ErrMsgGetTopDiggersFailed, err)
+	}
+	if diggers == nil {
+		diggers = []domain.Digger{}
+	}
+
+	level, next := LevelAt(site.Depth)
+	return &domain.DigSiteStatus{
+		Depth:      site.Depth,
+		TotalDigs:  site.TotalDigs,
+		Level:      level,
+		NextLevel:  next,
+		Levels:     Levels,
+		TopDiggers: diggers,
+	}, nil
+}
+
+func (s *service) source(ctx context.Context) rng.Source {
+	if s.rng != nil {
+		return s.rng.ForOperation(ctx, rng.OpDig)
+	}
+	return rand.New(rand.NewSource(time.Now().UnixNano())) //nolint:gosec // Game logic randomness, not security critical
+}
@@ -0,0 +1,162 @@
+package digging_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/osse101/BrandishBot_Go/internal/digging"
+	"github.com/osse101/BrandishBot_Go/internal/digging/mocks"
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/event"
+)
+
+const diggerID = "digger-1"
+
+type digFixture struct {
+	repo        *mocks.MockRepository
+	tx          *mocks.MockTx
+	users       *mocks.MockUserService
+	cooldowns   *mocks.MockCooldownService
+	progression *mocks.MockContributionAdder
+	publisher   *mocks.MockPublisher
+	svc         digging.Service
+}
+
+func newDigFixture(t *testing.T) *digFixture {
+	f := &digFixture{
+		repo:        mocks.NewMockRepository(t),
+		tx:          mocks.NewMockTx(t),
+		users:       mocks.NewMockUserService(t),
+		cooldowns:   mocks.NewMockCooldownService(t),
+		progression: mocks.NewMockContributionAdder(t),
+		publisher:   mocks.NewMockPublisher(t),
+	}
+	f.svc = digging.NewService(f.repo, f.users, f.cooldowns, f.progression, f.publisher)
+	return f
+}
+
+// expectDig runs a dig on a site that was depth metres deep with milestone
+// level claimed
+func (f *digFixture) expectDig(ctx context.Context, depth, milestone int) {
+	f.users.On("GetUserOrRegister", ctx, domain.PlatformDiscord, "d-1", "digger").Return(&domain.User{ID: diggerID, Username: "digger"}, nil)
+	f.cooldowns.On("EnforceCooldown", ctx, diggerID, domain.ActionDig, mock.Anything).Return(
+		func(_ context.Context, _, _ string, fn func() error) error { return fn() })
+	f.repo.On("BeginTx", ctx).Return(f.tx, nil)
+	f.tx.On("Deepen", ctx, mock.AnythingOfType("int")).Return(func(_ context.Context, meters int) (*domain.DigSite, error) {
+		return &domain.DigSite{Depth: depth + meters, TotalDigs: 12, MilestoneLevel: milestone}, nil
+	})
+	f.tx.On("RecordDigger", ctx, diggerID, mock.AnythingOfType("int")).Return(nil)
+	f.tx.On("Commit", ctx).Return(nil)
+	f.tx.On("Rollback", ctx).Return(nil).Maybe()
+}
+
+func TestDig(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("moves the site down", func(t *testing.T) {
+		f := newDigFixture(t)
+		f.expectDig(ctx, 10, 0)
+
+		result, err := f.svc.Dig(ctx, domain.PlatformDiscord, "d-1", "digger")
+
+		require.NoError(t, err)
+		assert.GreaterOrEqual(t, result.Meters, digging.MinDigMeters)
+		assert.LessOrEqual(t, result.Meters, digging.MaxDigMeters)
+		assert.Equal(t, 10+result.Meters, result.Depth)
+		assert.Equal(t, "Topsoil", result.Level.Name)
+		require.NotNil(t, result.NextLevel)
+		assert.Equal(t, "Clay", result.NextLevel.Name)
+		assert.Empty(t, result.Milestones)
+	})
+
+	t.Run("the first dig into a level grants its contribution", func(t *testing.T) {
+		f := newDigFixture(t)
+		f.expectDig(ctx, 49, 0)
+		f.tx.On("SetMilestoneLevel", ctx, 1).Return(nil)
+		f.progression.On("AddContribution", ctx, 10).Return(nil)
+		f.publisher.On("PublishWithRetry", ctx, mock.MatchedBy(func(evt event.Event) bool {
+			payload, ok := evt.Payload.(event.DigMilestonePayloadV1)
+			return evt.Type == event.DigMilestoneReached && ok && payload.Name == "Clay" && payload.Contribution == 10 && payload.Username == "digger"
+		})).Return()
+
+		result, err := f.svc.Dig(ctx, domain.PlatformDiscord, "d-1", "digger")
+
+		require.NoError(t, err)
+		require.Len(t, result.Milestones, 1)
+		assert.Equal(t, "Clay", result.Level.Name)
+		assert.Equal(t, 10, result.Contribution)
+	})
+
+	t.Run("a claimed level pays nothing again", func(t *testing.T) {
+		f := newDigFixture(t)
+		f.expectDig(ctx, 60, 1)
+
+		result, err := f.svc.Dig(ctx, domain.PlatformDiscord, "d-1", "digger")
+
+		require.NoError(t, err)
+		assert.Equal(t, "Clay", result.Level.Name)
+		assert.Empty(t, result.Milestones)
+		assert.Zero(t, result.Contribution)
+	})
+
+	t.Run("a failed contribution keeps the dig", func(t *testing.T) {
+		f := newDigFixture(t)
+		f.expectDig(ctx, 149, 1)
+		f.tx.On("SetMilestoneLevel", ctx, 2).Return(nil)
+		f.progression.On("AddContribution", ctx, 20).Return(errors.New("no unlock in progress"))
+		f.publisher.On("PublishWithRetry", ctx, mock.MatchedBy(func(evt event.Event) bool {
+			payload, ok := evt.Payload.(event.DigMilestonePayloadV1)
+			return ok && payload.Name == "Gravel" && payload.Contribution == 0
+		})).Return()
+
+		result, err := f.svc.Dig(ctx, domain.PlatformDiscord, "d-1", "digger")
+
+		require.NoError(t, err)
+		assert.Equal(t, "Gravel", result.Level.Name)
+		assert.Zero(t, result.Contribution)
+	})
+
+	t.Run("returns the cooldown", func(t *testing.T) {
+		f := newDigFixture(t)
+		cooldownErr := fmt.Errorf("%w: dig", domain.ErrOnCooldown)
+		f.users.On("GetUserOrRegister", ctx, domain.PlatformDiscord, "d-1", "digger").Return(&domain.User{ID: diggerID}, nil)
+		f.cooldowns.On("EnforceCooldown", ctx, diggerID, domain.ActionDig, mock.Anything).Return(cooldownErr)
+
+		_, err := f.svc.Dig(ctx, domain.PlatformDiscord, "d-1", "digger")
+
+		assert.ErrorIs(t, err, domain.ErrOnCooldown)
+	})
+}
+
+func TestGetStatus(t *testing.T) {
+	ctx := context.Background()
+	f := newDigFixture(t)
+	f.repo.On("GetSite", ctx).Return(&domain.DigSite{Depth: 320, TotalDigs: 100, MilestoneLevel: 3}, nil)
+	f.repo.On("GetTopDiggers", ctx, digging.TopDiggersLimit).Return([]domain.Digger{{Username: "digger", Meters: 120}}, nil)
+
+	status, err := f.svc.GetStatus(ctx)
+
+	require.NoError(t, err)
+	assert.Equal(t, "Bedrock", status.Level.Name)
+	require.NotNil(t, status.NextLevel)
+	assert.Equal(t, "Caverns", status.NextLevel.Name)
+	assert.Len(t, status.Levels, len(digging.Levels))
+	assert.Len(t, status.TopDiggers, 1)
+}
+
+func TestLevelAt(t *testing.T) {
+	level, next := digging.LevelAt(0)
+	assert.Equal(t, 0, level.Level)
+	require.NotNil(t, next)
+	assert.Equal(t, 1, next.Level)
+
+	level, next = digging.LevelAt(5000)
+	assert.Equal(t, len(digging.Levels)-1, level.Level)
+	assert.Nil(t, next)
+}
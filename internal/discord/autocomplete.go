@@ -22,9 +22,9 @@ func HandleAutocomplete(s *discordgo.Session, i *discordgo.InteractionCreate, cl
 		handleJobAutocomplete(s, i)
 	case "use":
 		handleItemAutocomplete(s, i, client, true, nil)
-	case "buy":
+	case "buy", "market-search":
 		handleItemAutocomplete(s, i, client, false, nil)
-	case "sell", "give":
+	case "sell", "give", "market-list", "gift-wrap":
 		handleItemAutocomplete(s, i, client, true, nil)
 	case "disassemble":
 		handleItemAutocomplete(s, i, client, true, nil)
@@ -32,6 +32,8 @@ func HandleAutocomplete(s *discordgo.Session, i *discordgo.InteractionCreate, cl
 		handleGambleItemAutocomplete(s, i, client)
 	case "maprando":
 		handleMapRandoAutocomplete(s, i, randoClient)
+	case domain.ActionSearch:
+		handleSearchLocationAutocomplete(s, i, client)
 	default:
 		slog.Warn("Unhandled autocomplete command", "command", data.Name)
 	}
@@ -69,6 +71,41 @@ func handleMapRandoAutocomplete(s *discordgo.Session, i *discordgo.InteractionCr
 	respondAutocomplete(s, i, choices)
 }
 
+// handleSearchLocationAutocomplete suggests the search locations the user has unlocked,
+// noting any that are still on cooldown
+func handleSearchLocationAutocomplete(s *discordgo.Session, i *discordgo.InteractionCreate, client *APIClient) {
+	user := getInteractionUser(i)
+	focusedValue := getFocusedOptionValue(i.ApplicationCommandData().Options)
+
+	locations, err := client.GetSearchLocations(domain.PlatformDiscord, user.ID, user.Username)
+	if err != nil {
+		slog.Error("Failed to get search locations for autocomplete", "error", err, "user", user.Username)
+	}
+
+	var choices []*discordgo.ApplicationCommandOptionChoice
+	for _, loc := range locations {
+		if !loc.Unlocked {
+			continue
+		}
+		if focusedValue != "" && !strings.Contains(strings.ToLower(loc.Name), focusedValue) && !strings.Contains(loc.Key, focusedValue) {
+			continue
+		}
+		label := loc.Name
+		if loc.ReadyInSeconds > 0 {
+			label = fmt.Sprintf("%s (ready in %dm)", loc.Name, (loc.ReadyInSeconds+59)/60)
+		}
+		choices = append(choices, &discordgo.ApplicationCommandOptionChoice{
+			Name:  label,
+			Value: loc.Key,
+		})
+		if len(choices) >= 25 {
+			break
+		}
+	}
+
+	respondAutocomplete(s, i, choices)
+}
+
 // handleRecipeAutocomplete provides autocomplete for crafting recipes
 func handleRecipeAutocomplete(s *discordgo.Session, i *discordgo.InteractionCreate, client *APIClient) {
 	user := i.Member.User
@@ -30,6 +30,7 @@ type Bot struct {
 	MapRandoClient        *MapRandoClient
 	sseClient             *SSEClient
 	sseNotifier           *SSENotifier
+	reminders             *cooldownReminders
 	ctx                   context.Context
 	cancel                context.CancelFunc
 	wg                    sync.WaitGroup
@@ -67,6 +68,7 @@ func New(cfg Config) (*Bot, error) {
 		NotificationChannelID: cfg.NotificationChannelID,
 		GithubToken:           cfg.GithubToken,
 		GithubOwnerRepo:       cfg.GithubOwnerRepo,
+		reminders:             newCooldownReminders(),
 	}
 
 	// Initialize SSE client if notification channel is configured
@@ -80,6 +82,20 @@ func New(cfg Config) (*Bot, error) {
 			SSEEventTypeExpeditionStarted,
 			SSEEventTypeExpeditionTurn,
 			SSEEventTypeExpeditionCompleted,
+			SSEEventTypeCelebration,
+			SSEEventTypeGambleRecovered,
+			SSEEventTypeVotesFlagged,
+			SSEEventTypeGiveFlagged,
+			SSEEventTypeReminderDue,
+			SSEEventTypeGiftDelivered,
+			SSEEventTypeStreamRecap,
+			SSEEventTypeDigMilestone,
+			SSEEventTypeFishingBite,
+			SSEEventTypeBossSpawned,
+			SSEEventTypeBossDefeated,
+			SSEEventTypeBossEscaped,
+			SSEEventTypeAnnouncement,
+			SSEEventTypeAnnouncementRoutesChanged,
 		})
 	}
 
@@ -105,8 +121,9 @@ func (b *Bot) Start() error {
 
 	// Start SSE client for real-time notifications
 	if b.sseClient != nil && b.NotificationChannelID != "" {
-		b.sseNotifier = NewSSENotifier(b.Session, b.NotificationChannelID, b.DevChannelID)
+		b.sseNotifier = NewSSENotifier(b.Session, b.Client, b.NotificationChannelID, b.DevChannelID, b.DiggingGameChannelID)
 		b.sseNotifier.RegisterHandlers(b.sseClient)
+		b.sseNotifier.LoadAnnouncementRoutes()
 
 		b.ctx, b.cancel = context.WithCancel(context.Background())
 		b.sseClient.Start(b.ctx)
@@ -132,6 +149,9 @@ func (b *Bot) Stop() {
 		b.cancel()
 	}
 
+	// Pending cooldown reminders are in-memory only and are dropped on shutdown
+	b.reminders.stopAll()
+
 	// Stop SSE client
 	if b.sseClient != nil {
 		b.sseClient.Stop()
@@ -176,6 +196,12 @@ func (b *Bot) interactionCreate(s *discordgo.Session, i *discordgo.InteractionCr
 		if strings.HasPrefix(data.CustomID, "maprando_unlock_") {
 			seedName := strings.TrimPrefix(data.CustomID, "maprando_unlock_")
 			HandleButtonUnlock(s, i, b.MapRandoClient, seedName)
+		} else if strings.HasPrefix(data.CustomID, cooldownRemindPrefix) {
+			b.handleCooldownRemind(s, i, data.CustomID)
+		} else if data.CustomID == digAgainCustomID {
+			runDig(s, i, b.Client, b.DiggingGameChannelID)
+		} else if strings.HasPrefix(data.CustomID, fishReelPrefix) {
+			handleFishReel(s, i, b.Client, data.CustomID)
 		}
 	}
 }
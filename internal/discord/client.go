@@ -1,290 +1,261 @@
 package discord
 
 import (
-	"bytes"
-	"encoding/json"
+	"context"
 	"fmt"
-	"io"
-	"log/slog"
-	"net/http"
-	"net/url"
+	"sort"
 	"strings"
-	"time"
 
-	"github.com/osse101/BrandishBot_Go/internal/activechatter"
 	"github.com/osse101/BrandishBot_Go/internal/domain"
-	"github.com/osse101/BrandishBot_Go/internal/user"
+	"github.com/osse101/BrandishBot_Go/pkg/apiclient"
 )
 
-// APIClient handles communication with the BrandishBot Core API
+// APIClient handles communication with the BrandishBot Core API. Requests go
+// through the shared typed client in pkg/apiclient; the methods here adapt
+// them to the shapes the bot commands use.
 type APIClient struct {
-	BaseURL string
-	Client  *http.Client
-	APIKey  string
+	API *apiclient.Client
 }
 
 // NewAPIClient creates a new API client
 func NewAPIClient(baseURL, apiKey string) *APIClient {
-	return &APIClient{
-		BaseURL: baseURL,
-		Client: &http.Client{
-			Timeout: 10 * time.Second,
-		},
-		APIKey: apiKey,
-	}
+	return &APIClient{API: apiclient.New(baseURL, apiKey)}
 }
 
-// doRequest performs an HTTP request with retry logic
-func (c *APIClient) doRequest(method, path string, body interface{}) (*http.Response, error) {
-	var reqBody []byte
-	var err error
+// APIError is an error response returned by the Core API
+type APIError = apiclient.Error
 
-	if body != nil {
-		reqBody, err = json.Marshal(body)
-		if err != nil {
-			return nil, fmt.Errorf("failed to marshal body: %w", err)
-		}
-	}
+// CooldownInfo describes when a cooldown-blocked action can be retried
+type CooldownInfo = apiclient.CooldownInfo
 
-	url := fmt.Sprintf("%s%s", c.BaseURL, path)
+// User is a registered user as the Core API reports it
+type User = apiclient.User
 
-	// Retry configuration
-	maxRetries := 3
-	retryDelay := 500 * time.Millisecond
+// InventoryItem is one stack in a user's inventory
+type InventoryItem = apiclient.InventoryItem
 
-	var lastErr error
-	for attempt := 0; attempt <= maxRetries; attempt++ {
-		if attempt > 0 {
-			// Exponential backoff with jitter
-			jitter := time.Duration(time.Now().UnixNano()%100) * time.Millisecond
-			delay := retryDelay*time.Duration(1<<uint(attempt-1)) + jitter
-			time.Sleep(delay)
-			slog.Info("Retrying API request", "attempt", attempt, "path", path, "delay", delay)
-		}
+// SearchLocation is a search region with the user's access and cooldown state
+type SearchLocation = apiclient.LocationStatus
 
-		req, err := http.NewRequest(method, url, bytes.NewBuffer(reqBody))
-		if err != nil {
-			return nil, fmt.Errorf("failed to create request: %w", err)
-		}
+// Recipe is a crafting recipe listed by the API
+type Recipe = apiclient.RecipeListItem
 
-		req.Header.Set("Content-Type", "application/json")
-		if c.APIKey != "" {
-			req.Header.Set("X-API-Key", c.APIKey)
-		}
+// CacheStats reports the user cache's hit and eviction counters
+type CacheStats = apiclient.CacheStats
 
-		resp, err := c.Client.Do(req)
-		if err != nil {
-			lastErr = err
-			slog.Warn("API request failed", "error", err, "attempt", attempt)
-			continue
-		}
+// ProgressionTreeNode is a node of the progression tree with its unlock state
+type ProgressionTreeNode = apiclient.ProgressionTreeNode
 
-		// Success or non-retryable error
-		if resp.StatusCode < 500 {
-			return resp, nil
-		}
+// Chatter is a user seen in chat recently
+type Chatter = apiclient.Chatter
 
-		// Server error - retry
-		resp.Body.Close()
-		lastErr = fmt.Errorf("server error: %d", resp.StatusCode)
-		slog.Warn("Server error, will retry", "status", resp.StatusCode, "attempt", attempt)
-	}
+// LeaderboardPage is one page of an event leaderboard
+type LeaderboardPage = apiclient.LeaderboardPage
 
-	return nil, fmt.Errorf("max retries exceeded: %w", lastErr)
-}
+// XPAwardResult is the outcome of awarding job XP
+type XPAwardResult = apiclient.XPAwardResult
 
-// doRequestAndParse performs a request and parses the JSON response into the target struct
-func (c *APIClient) doRequestAndParse(method, path string, body interface{}, target interface{}) error {
-	resp, err := c.doRequest(method, path, body)
-	if err != nil {
-		return err
-	}
-	defer resp.Body.Close()
+// UnlockProgress is the contribution progress towards the next unlock
+type UnlockProgress = apiclient.UnlockProgressResponse
 
-	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		var errResp struct {
-			Error string `json:"error"`
-		}
-		if err := json.NewDecoder(resp.Body).Decode(&errResp); err == nil && errResp.Error != "" {
-			return fmt.Errorf("API error: %s", errResp.Error)
-		}
-		return fmt.Errorf("API returned status: %d", resp.StatusCode)
-	}
+// ContributionBreakdown is a user's engagement split by contribution type
+type ContributionBreakdown = apiclient.ContributionBreakdown
 
-	if target != nil {
-		if err := json.NewDecoder(resp.Body).Decode(target); err != nil {
-			return fmt.Errorf("failed to decode response: %w", err)
-		}
-	}
+// VotingSession is a progression voting session with its options
+type VotingSession = apiclient.ProgressionVotingSession
 
-	return nil
-}
+// VotingOption is a node on the ballot of a voting session
+type VotingOption = apiclient.ProgressionVotingOption
 
-// doAction performs a request and expects a standard response with a "message" field
-func (c *APIClient) doAction(method, path string, body interface{}) (string, error) {
-	var resp struct {
-		Message string `json:"message"`
-	}
-	if err := c.doRequestAndParse(method, path, body, &resp); err != nil {
+// VotingSessionHistory is a page of completed voting sessions
+type VotingSessionHistory = apiclient.VotingSessionHistory
+
+// MessageResult is what the server did with a chat message
+type MessageResult = apiclient.MessageResult
+
+// Job is a job users can level up
+type Job = apiclient.Job
+
+// UserJobsResponse is a user's progress in every job
+type UserJobsResponse = apiclient.GetUserJobsResponse
+
+// HarvestResponse is the outcome of harvesting accumulated rewards
+type HarvestResponse = apiclient.HarvestResponse
+
+// ExpeditionStatus reports the active expedition and the start cooldown
+type ExpeditionStatus = apiclient.ExpeditionStatus
+
+// ExpeditionJournalEntry is one turn of a finished expedition
+type ExpeditionJournalEntry = apiclient.ExpeditionJournalEntry
+
+// PredictionWinner is the user who won a prediction
+type PredictionWinner = apiclient.PredictionWinner
+
+// PredictionParticipant is a user who spent points on a prediction
+type PredictionParticipant = apiclient.PredictionParticipant
+
+// PredictionResult is the outcome of processing a prediction
+type PredictionResult = apiclient.PredictionResult
+
+// Quest is one of the week's quests
+type Quest = apiclient.Quest
+
+// QuestProgress is a user's progress on a quest
+type QuestProgress = apiclient.QuestProgress
+
+// QuestClaim is the reward paid for a completed quest
+type QuestClaim = apiclient.ClaimQuestResponse
+
+// CompostDepositItem is an item and quantity to put in the compost bin
+type CompostDepositItem = apiclient.DepositItem
+
+// CompostDepositResult is the compost bin after a deposit
+type CompostDepositResult = apiclient.CompostDepositResponse
+
+// CompostHarvestResult is the outcome of harvesting the compost bin
+type CompostHarvestResult = apiclient.CompostHarvestResponse
+
+// CompostStatusResult is the compost bin state, with its output when ready
+type CompostStatusResult = apiclient.HarvestResult
+
+// SlotsResult is the outcome of a slots spin
+type SlotsResult = apiclient.SlotsResult
+
+// AdminUserLookupResult is a user found by platform and username
+type AdminUserLookupResult = apiclient.UserLookupResponse
+
+// EventLogEntry is one entry of the event log
+type EventLogEntry = apiclient.EventLogEntry
+
+// DailyResetStatus reports the last and next daily XP reset
+type DailyResetStatus = apiclient.DailyResetStatus
+
+// Metrics is the server's HTTP, event, SSE and business metrics
+type Metrics = apiclient.MetricsResponse
+
+// ScenarioCapability is a simulation capability and its actions
+type ScenarioCapability = apiclient.CapabilityInfo
+
+// ScenarioSummary describes a predefined simulation scenario
+type ScenarioSummary = apiclient.Summary
+
+// Scenario is a full simulation scenario definition
+type Scenario = apiclient.Scenario
+
+// ScenarioRunResult is the outcome of running a simulation scenario
+type ScenarioRunResult = apiclient.ExecutionResult
+
+// messageOf returns the message from a generated call that answers with a SuccessResponse
+func messageOf(resp *apiclient.SuccessResponse, err error) (string, error) {
+	if err != nil {
 		return "", err
 	}
 	return resp.Message, nil
 }
 
 // RegisterUser registers or retrieves a user
-func (c *APIClient) RegisterUser(username, discordID string) (*domain.User, error) {
-	req := map[string]string{
-		"username":          username,
-		"known_platform":    domain.PlatformDiscord,
-		"known_platform_id": discordID,
-		"new_platform":      domain.PlatformDiscord,
-		"new_platform_id":   discordID,
-	}
-
-	resp, err := c.doRequest(http.MethodPost, "/api/v1/user/register", req)
+func (c *APIClient) RegisterUser(username, discordID string) (*User, error) {
+	return c.API.PostUserRegister(context.Background(), &apiclient.RegisterUserRequest{
+		Username:        username,
+		KnownPlatform:   domain.PlatformDiscord,
+		KnownPlatformID: discordID,
+		NewPlatform:     domain.PlatformDiscord,
+		NewPlatformID:   discordID,
+	})
+}
+
+// Search performs a search action, in the given location if one is set
+func (c *APIClient) Search(platform, platformID, username, location string) (string, error) {
+	resp, err := c.API.PostUserSearch(context.Background(),
+		apiclient.PostUserSearchParams{Location: location},
+		&apiclient.SearchRequest{Platform: platform, PlatformID: platformID, Username: username})
 	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusCreated {
-		return nil, fmt.Errorf("API returned status: %d", resp.StatusCode)
-	}
-
-	var user domain.User
-	if err := json.NewDecoder(resp.Body).Decode(&user); err != nil {
-		return nil, fmt.Errorf("failed to decode user: %w", err)
+		return "", err
 	}
-
-	return &user, nil
+	return resp.Message, nil
 }
 
-// Search performs a search action
-func (c *APIClient) Search(platform, platformID, username string) (string, error) {
-	req := map[string]string{
-		"platform":    platform,
-		"platform_id": platformID,
-		"username":    username,
+// GetSearchLocations lists the search locations with the user's access and cooldown state
+func (c *APIClient) GetSearchLocations(platform, platformID, username string) ([]SearchLocation, error) {
+	resp, err := c.API.GetUserSearchLocations(context.Background(), apiclient.GetUserSearchLocationsParams{
+		Platform:   platform,
+		PlatformID: platformID,
+		Username:   username,
+	})
+	if err != nil {
+		return nil, err
 	}
-	return c.doAction(http.MethodPost, "/api/v1/user/search", req)
+	return resp.Locations, nil
 }
 
 // GetInventory retrieves user inventory
-func (c *APIClient) GetInventory(platform, platformID, username, filter string) ([]user.InventoryItem, error) {
-	return c.getInventoryInternal("/api/v1/user/inventory", platform, platformID, username, filter)
-}
-
-func (c *APIClient) getInventoryInternal(path, platform, platformID, username, filter string) ([]user.InventoryItem, error) {
-	params := url.Values{}
-	params.Set("platform", platform)
-	params.Set("username", username)
-	if platformID != "" {
-		params.Set("platform_id", platformID)
-	}
-	if filter != "" {
-		params.Set("filter", filter)
-	}
-
-	fullPath := fmt.Sprintf("%s?%s", path, params.Encode())
-	resp, err := c.doRequest(http.MethodGet, fullPath, nil)
+func (c *APIClient) GetInventory(platform, platformID, username, filter string) ([]InventoryItem, error) {
+	resp, err := c.API.GetUserInventory(context.Background(), apiclient.GetUserInventoryParams{
+		Platform:   platform,
+		PlatformID: platformID,
+		Username:   username,
+		Filter:     filter,
+	})
 	if err != nil {
 		return nil, err
 	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK {
-		var errResp struct {
-			Error string `json:"error"`
-		}
-		if err := json.NewDecoder(resp.Body).Decode(&errResp); err == nil && errResp.Error != "" {
-			return nil, fmt.Errorf("API error: %s", errResp.Error)
-		}
-		return nil, fmt.Errorf("API returned status: %d", resp.StatusCode)
-	}
-
-	var invResp struct {
-		Items []user.InventoryItem `json:"items"`
-	}
-	if err := json.NewDecoder(resp.Body).Decode(&invResp); err != nil {
-		return nil, fmt.Errorf("failed to decode inventory: %w", err)
-	}
-
-	return invResp.Items, nil
+	return resp.Items, nil
 }
 
 // UseItem uses an item from inventory
 func (c *APIClient) UseItem(platform, platformID, username, itemName string, quantity int, target string) (string, error) {
-	req := map[string]interface{}{
-		"platform":    platform,
-		"platform_id": platformID,
-		"username":    username,
-		"item_name":   itemName,
-		"quantity":    quantity,
-		"target_user": target, // Optional, can be username or job name
+	resp, err := c.API.PostUserItemUse(context.Background(), &apiclient.UseItemRequest{
+		Platform:   platform,
+		PlatformID: platformID,
+		Username:   username,
+		ItemName:   itemName,
+		Quantity:   quantity,
+		TargetUser: target, // Optional, can be username or job name
+	})
+	if err != nil {
+		return "", err
 	}
-	return c.doAction(http.MethodPost, "/api/v1/user/item/use", req)
+	return resp.Message, nil
 }
 
-// StartGamble starts a new gamble
+// StartGamble starts a new gamble and returns its chat reference (the short
+// code, or the full ID from servers that do not send one)
 func (c *APIClient) StartGamble(platform, platformID, username, itemName string, quantity int) (string, error) {
-	req := map[string]interface{}{
-		"platform":    platform,
-		"platform_id": platformID,
-		"username":    username,
-		"bets":        []map[string]interface{}{{"item_name": itemName, "quantity": quantity}},
-	}
-
-	resp, err := c.doRequest(http.MethodPost, "/api/v1/gamble/start", req)
+	resp, err := c.API.PostGambleStart(context.Background(), &apiclient.StartGambleRequest{
+		Platform:   platform,
+		PlatformID: platformID,
+		Username:   username,
+		Bets:       []apiclient.LootboxBet{{ItemName: itemName, Quantity: quantity}},
+	})
 	if err != nil {
 		return "", err
 	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusCreated {
-		var errResp struct {
-			Error string `json:"error"`
-		}
-		if err := json.NewDecoder(resp.Body).Decode(&errResp); err == nil && errResp.Error != "" {
-			return "", fmt.Errorf("API error: %s", errResp.Error)
-		}
-		return "", fmt.Errorf("API returned status: %d", resp.StatusCode)
-	}
 
-	var gambleResp struct {
-		Message  string `json:"message"`
-		GambleID string `json:"gamble_id"`
+	// Prefer the short code for display; older servers only return the UUID
+	if resp.ShortCode != "" {
+		return resp.ShortCode, nil
 	}
-	if err := json.NewDecoder(resp.Body).Decode(&gambleResp); err != nil {
-		return "", fmt.Errorf("failed to decode response: %w", err)
-	}
-
-	return gambleResp.GambleID, nil
+	return resp.GambleID, nil
 }
 
 // JoinGamble joins an active gamble
 func (c *APIClient) JoinGamble(platform, platformID, username string) (string, error) {
-	req := map[string]interface{}{
-		"platform":    platform,
-		"platform_id": platformID,
-		"username":    username,
-	}
-
-	return c.doAction(http.MethodPost, "/api/v1/gamble/join", req)
+	return messageOf(c.API.PostGambleJoin(context.Background(), &apiclient.JoinGambleRequest{
+		Platform:   platform,
+		PlatformID: platformID,
+		Username:   username,
+	}))
 }
 
 // VoteForNode votes for a progression node unlock using an option index
 func (c *APIClient) VoteForNode(platform, platformID, username string, optionIndex int) (string, error) {
-	req := map[string]interface{}{
-		"platform":     platform,
-		"platform_id":  platformID,
-		"username":     username,
-		"option_index": optionIndex,
-	}
-
-	var resp struct {
-		Message string `json:"message"`
-	}
-	if err := c.doRequestAndParse(http.MethodPost, "/api/v1/progression/vote", req, &resp); err != nil {
+	resp, err := c.API.PostProgressionVote(context.Background(), &apiclient.VoteRequest{
+		Platform:    platform,
+		PlatformID:  platformID,
+		Username:    username,
+		OptionIndex: optionIndex,
+	})
+	if err != nil {
 		return "", err
 	}
 	return resp.Message, nil
@@ -292,33 +263,29 @@ func (c *APIClient) VoteForNode(platform, platformID, username string, optionInd
 
 // AdminUnlockNode force-unlocks a progression node (admin only)
 func (c *APIClient) AdminUnlockNode(nodeKey string, level int) (string, error) {
-	req := map[string]interface{}{
-		"node_key": nodeKey,
-		"level":    level,
-	}
-	return c.doAction(http.MethodPost, "/api/v1/progression/admin/unlock", req)
+	return messageOf(c.API.PostProgressionAdminUnlock(context.Background(), &apiclient.AdminNodeRequest{
+		NodeKey: nodeKey,
+		Level:   level,
+	}))
 }
 
 // AdminUnlockAllNodes force-unlocks ALL progression nodes at max level (admin only, DEBUG)
 func (c *APIClient) AdminUnlockAllNodes() (string, error) {
-	return c.doAction(http.MethodPost, "/api/v1/progression/admin/unlock-all", nil)
+	return messageOf(c.API.PostProgressionAdminUnlockAll(context.Background()))
 }
 
 // AdminRelockNode relocks a progression node (admin only)
 func (c *APIClient) AdminRelockNode(nodeKey string, level int) (string, error) {
-	req := map[string]interface{}{
-		"node_key": nodeKey,
-		"level":    level,
-	}
-	return c.doAction(http.MethodPost, "/api/v1/progression/admin/relock", req)
+	return messageOf(c.API.PostProgressionAdminRelock(context.Background(), &apiclient.AdminNodeRequest{
+		NodeKey: nodeKey,
+		Level:   level,
+	}))
 }
 
 // AdminInstantUnlock force-unlocks the current vote leader (admin only)
 func (c *APIClient) AdminInstantUnlock() (string, error) {
-	var resp struct {
-		Message string `json:"message"`
-	}
-	if err := c.doRequestAndParse(http.MethodPost, "/api/v1/progression/admin/instant-unlock", nil, &resp); err != nil {
+	resp, err := c.API.PostProgressionAdminInstantUnlock(context.Background())
+	if err != nil {
 		return "", err
 	}
 	return resp.Message, nil
@@ -326,142 +293,93 @@ func (c *APIClient) AdminInstantUnlock() (string, error) {
 
 // AdminResetProgression resets the entire progression tree (admin only)
 func (c *APIClient) AdminResetProgression(resetBy, reason string, preserveUser bool) (string, error) {
-	req := map[string]interface{}{
-		"reset_by":                  resetBy,
-		"reason":                    reason,
-		"preserve_user_progression": preserveUser,
-	}
-	return c.doAction(http.MethodPost, "/api/v1/progression/admin/reset", req)
+	return messageOf(c.API.PostProgressionAdminReset(context.Background(), &apiclient.AdminResetRequest{
+		ResetBy:                 resetBy,
+		Reason:                  reason,
+		PreserveUserProgression: preserveUser,
+	}))
 }
 
 // AdminReloadWeights invalidates the engagement weight cache (admin only)
 func (c *APIClient) AdminReloadWeights() (string, error) {
-	var resp struct {
-		Message string `json:"message"`
-	}
-	if err := c.doRequestAndParse(http.MethodPost, "/api/admin/progression/reload-weights", nil, &resp); err != nil {
-		return "", err
-	}
-	return resp.Message, nil
+	return messageOf(c.API.PostAdminProgressionReloadWeights(context.Background()))
 }
 
 // AdminGetCacheStats retrieves user cache statistics (admin only)
-func (c *APIClient) AdminGetCacheStats() (*user.CacheStats, error) {
-	var stats user.CacheStats
-	if err := c.doRequestAndParse(http.MethodGet, "/api/v1/admin/cache/stats", nil, &stats); err != nil {
-		return nil, err
-	}
-	return &stats, nil
+func (c *APIClient) AdminGetCacheStats() (*CacheStats, error) {
+	return c.API.GetAdminCacheStats(context.Background())
 }
 
 // AdminStartVoting starts a new voting session (admin only)
 func (c *APIClient) AdminStartVoting() (string, error) {
-	var resp struct {
-		Message string `json:"message"`
-	}
-	if err := c.doRequestAndParse(http.MethodPost, "/api/v1/progression/admin/start-voting", nil, &resp); err != nil {
-		return "", err
-	}
-	return resp.Message, nil
+	return messageOf(c.API.PostProgressionAdminStartVoting(context.Background()))
 }
 
 // AdminEndVoting forces the current voting session to end (admin only)
 func (c *APIClient) AdminEndVoting() (string, error) {
-	var resp struct {
-		Message string `json:"message"`
-	}
-	if err := c.doRequestAndParse(http.MethodPost, "/api/v1/progression/admin/end-voting", nil, &resp); err != nil {
-		return "", err
-	}
-	return resp.Message, nil
+	return messageOf(c.API.PostProgressionAdminEndVoting(context.Background()))
 }
 
 // GetProgressionTree retrieves the full progression tree
-func (c *APIClient) GetProgressionTree() ([]*domain.ProgressionTreeNode, error) {
-	resp, err := c.doRequest(http.MethodGet, "/api/v1/progression/tree", nil)
+func (c *APIClient) GetProgressionTree() ([]ProgressionTreeNode, error) {
+	resp, err := c.API.GetProgressionTree(context.Background())
 	if err != nil {
 		return nil, err
 	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("API returned status: %d", resp.StatusCode)
-	}
-
-	var treeResp struct {
-		Nodes []*domain.ProgressionTreeNode `json:"nodes"`
-	}
-	if err := json.NewDecoder(resp.Body).Decode(&treeResp); err != nil {
-		return nil, fmt.Errorf("failed to decode response: %w", err)
-	}
-
-	return treeResp.Nodes, nil
+	return resp.Nodes, nil
 }
 
 // AdminGetActiveChatters retrieves active chatters (admin only)
-func (c *APIClient) AdminGetActiveChatters(minutes int) ([]activechatter.Chatter, error) {
-	var chatters []activechatter.Chatter
-	path := fmt.Sprintf("/api/v1/admin/users/active?minutes=%d", minutes)
-	if err := c.doRequestAndParse(http.MethodGet, path, nil, &chatters); err != nil {
-		return nil, err
-	}
-	return chatters, nil
+func (c *APIClient) AdminGetActiveChatters(minutes int) ([]Chatter, error) {
+	return c.API.GetAdminUsersActive(context.Background(), apiclient.GetAdminUsersActiveParams{Minutes: minutes})
 }
 
 // BuyItem purchases an item from the shop
 func (c *APIClient) BuyItem(platform, platformID, username, itemName string, quantity int) (string, error) {
-	req := map[string]interface{}{
-		"platform":    platform,
-		"platform_id": platformID,
-		"username":    username,
-		"item_name":   itemName,
-		"quantity":    quantity,
+	resp, err := c.API.PostUserItemBuy(context.Background(), &apiclient.BuyItemRequest{
+		Platform:   platform,
+		PlatformID: platformID,
+		Username:   username,
+		ItemName:   itemName,
+		Quantity:   quantity,
+	})
+	if err != nil {
+		return "", err
 	}
-	return c.doAction(http.MethodPost, "/api/v1/user/item/buy", req)
+	return resp.Message, nil
 }
 
 // SellItem sells an item from inventory
 func (c *APIClient) SellItem(platform, platformID, username, itemName string, quantity int) (string, error) {
-	req := map[string]interface{}{
-		"platform":    platform,
-		"platform_id": platformID,
-		"username":    username,
-		"item_name":   itemName,
-		"quantity":    quantity,
+	resp, err := c.API.PostUserItemSell(context.Background(), &apiclient.SellItemRequest{
+		Platform:   platform,
+		PlatformID: platformID,
+		Username:   username,
+		ItemName:   itemName,
+		Quantity:   quantity,
+	})
+	if err != nil {
+		return "", err
 	}
-	return c.doAction(http.MethodPost, "/api/v1/user/item/sell", req)
+	return resp.Message, nil
 }
 
 // GetSellPrices retrieves current sell prices
 func (c *APIClient) GetSellPrices() (string, error) {
-	return c.getPricesInternal("/api/v1/prices")
+	return formatPrices(c.API.GetPrices(context.Background()))
 }
 
-func (c *APIClient) getPricesInternal(endpoint string) (string, error) {
-	resp, err := c.doRequest(http.MethodGet, endpoint, nil)
+func formatPrices(items []apiclient.Item, err error) (string, error) {
 	if err != nil {
 		return "", err
 	}
-	defer resp.Body.Close()
 
-	if resp.StatusCode != http.StatusOK {
-		return "", fmt.Errorf("API returned status: %d", resp.StatusCode)
-	}
-
-	var pricesResp struct {
-		Message string        `json:"message"`
-		Items   []domain.Item `json:"items"`
-	}
-	if err := json.NewDecoder(resp.Body).Decode(&pricesResp); err != nil {
-		return "", fmt.Errorf("failed to decode response: %w", err)
-	}
-
-	if len(pricesResp.Items) == 0 {
+	if len(items) == 0 {
 		return "No items available.", nil
 	}
 
 	var sb strings.Builder
-	for _, item := range pricesResp.Items {
+	for _, item := range items {
 		fmt.Fprintf(&sb, "**%s**: %d coins\n", item.InternalName, item.BaseValue)
 	}
 	return sb.String(), nil
@@ -469,395 +387,212 @@ func (c *APIClient) getPricesInternal(endpoint string) (string, error) {
 
 // GetBuyPrices retrieves current buy prices
 func (c *APIClient) GetBuyPrices() (string, error) {
-	return c.getPricesInternal("/api/v1/prices/buy")
+	return formatPrices(c.API.GetPricesBuy(context.Background()))
 }
 
 // AddItemByUsername adds an item by username (no platformID required)
 func (c *APIClient) AddItemByUsername(platform, username, itemName string, quantity int) (string, error) {
-	req := map[string]interface{}{
-		"platform":  platform,
-		"username":  username,
-		"item_name": itemName,
-		"quantity":  quantity,
-	}
-
-	var resp struct {
-		Message string `json:"message"`
-	}
-	if err := c.doRequestAndParse(http.MethodPost, "/api/v1/user/item/add", req, &resp); err != nil {
-		return "", err
-	}
-	return resp.Message, nil
+	return messageOf(c.API.PostUserItemAdd(context.Background(), &apiclient.AddItemByUsernameRequest{
+		Platform: platform,
+		Username: username,
+		ItemName: itemName,
+		Quantity: quantity,
+	}))
 }
 
 // RemoveItemByUsername removes an item by username (no platformID required)
 func (c *APIClient) RemoveItemByUsername(platform, username, itemName string, quantity int) (int, error) {
-	req := map[string]interface{}{
-		"platform":  platform,
-		"username":  username,
-		"item_name": itemName,
-		"quantity":  quantity,
-	}
-
-	var resp struct {
-		Removed int `json:"removed"`
-	}
-	if err := c.doRequestAndParse(http.MethodPost, "/api/v1/user/item/remove", req, &resp); err != nil {
+	resp, err := c.API.PostUserItemRemove(context.Background(), &apiclient.RemoveItemByUsernameRequest{
+		Platform: platform,
+		Username: username,
+		ItemName: itemName,
+		Quantity: quantity,
+	})
+	if err != nil {
 		return 0, err
 	}
 	return resp.Removed, nil
 }
 
-// GiveItemByUsername transfers an item by usernames (no platformIDs required)
-func (c *APIClient) GiveItemByUsername(fromPlatform, fromUsername, toPlatform, toUsername, itemName string, quantity int) (string, error) {
-	req := map[string]interface{}{
-		"from_platform": fromPlatform,
-		"from_username": fromUsername,
-		"to_platform":   toPlatform,
-		"to_username":   toUsername,
-		"item_name":     itemName,
-		"quantity":      quantity,
-	}
-	return c.doAction(http.MethodPost, "/api/v1/user/item/give", req)
-}
-
-// GiveItem transfers an item between users user
-func (c *APIClient) GiveItem(fromPlatform, fromPlatformID, toPlatform, toPlatformID, toUsername, itemName string, quantity int) (string, error) {
-	req := map[string]interface{}{
-		"from_platform":    fromPlatform,
-		"from_platform_id": fromPlatformID,
-		"to_platform":      toPlatform,
-		"to_platform_id":   toPlatformID,
-		"to_username":      toUsername,
-		"item_name":        itemName,
-		"quantity":         quantity,
-	}
-	return c.doAction(http.MethodPost, "/api/v1/user/item/give", req)
+// GiveItem transfers an item from one user to another
+func (c *APIClient) GiveItem(fromPlatform, fromPlatformID, fromUsername, toPlatform, toUsername, itemName string, quantity int) (string, error) {
+	return messageOf(c.API.PostUserItemGive(context.Background(), &apiclient.GiveItemRequest{
+		OwnerPlatform:    fromPlatform,
+		OwnerPlatformID:  fromPlatformID,
+		Owner:            fromUsername,
+		ReceiverPlatform: toPlatform,
+		Receiver:         toUsername,
+		ItemName:         itemName,
+		Quantity:         quantity,
+	}))
 }
 
 // UpgradeItem crafts an item upgrade
 func (c *APIClient) UpgradeItem(platform, platformID, username, itemName string, quantity int) (string, error) {
-	req := map[string]interface{}{
-		"platform":    platform,
-		"platform_id": platformID,
-		"username":    username,
-		"item":        itemName,
-		"quantity":    quantity,
+	resp, err := c.API.PostUserItemUpgrade(context.Background(), &apiclient.CraftingActionRequest{
+		Platform:   platform,
+		PlatformID: platformID,
+		Username:   username,
+		Item:       itemName,
+		Quantity:   quantity,
+	})
+	if err != nil {
+		return "", err
 	}
-	return c.doAction(http.MethodPost, "/api/v1/user/item/upgrade", req)
+	return resp.Message, nil
 }
 
 // DisassembleItem breaks down an item for materials
 func (c *APIClient) DisassembleItem(platform, platformID, username, itemName string, quantity int) (string, error) {
-	req := map[string]interface{}{
-		"platform":    platform,
-		"platform_id": platformID,
-		"username":    username,
-		"item":        itemName,
-		"quantity":    quantity,
+	resp, err := c.API.PostUserItemDisassemble(context.Background(), &apiclient.CraftingActionRequest{
+		Platform:   platform,
+		PlatformID: platformID,
+		Username:   username,
+		Item:       itemName,
+		Quantity:   quantity,
+	})
+	if err != nil {
+		return "", err
 	}
-	return c.doAction(http.MethodPost, "/api/v1/user/item/disassemble", req)
-}
-
-// Recipe represents a recipe returned by the API
-type Recipe struct {
-	ItemName         string `json:"item_name"`
-	ItemID           int    `json:"item_id"`
-	RequiredJobLevel int    `json:"required_job_level,omitempty"`
+	return resp.Message, nil
 }
 
 // GetRecipes retrieves all crafting recipes
 func (c *APIClient) GetRecipes() ([]Recipe, error) {
-	resp, err := c.doRequest(http.MethodGet, "/api/v1/recipes", nil)
+	resp, err := c.API.GetRecipes(context.Background(), apiclient.GetRecipesParams{})
 	if err != nil {
 		return nil, err
 	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("API returned status: %d", resp.StatusCode)
-	}
-
-	var recipesResp struct {
-		Recipes []Recipe `json:"recipes"`
-	}
-	if err := json.NewDecoder(resp.Body).Decode(&recipesResp); err != nil {
-		return nil, fmt.Errorf("failed to decode response: %w", err)
-	}
-
-	return recipesResp.Recipes, nil
+	return resp.Recipes, nil
 }
 
 // GetUnlockedRecipes retrieves unlocked recipes for a user
 func (c *APIClient) GetUnlockedRecipes(platform, platformID, username string) ([]Recipe, error) {
-	params := url.Values{}
-	params.Set("platform", platform)
-	params.Set("platform_id", platformID)
-	params.Set("user", username)
-
-	path := fmt.Sprintf("/api/v1/recipes?%s", params.Encode())
-	resp, err := c.doRequest(http.MethodGet, path, nil)
+	resp, err := c.API.GetRecipes(context.Background(), apiclient.GetRecipesParams{
+		Platform:   platform,
+		PlatformID: platformID,
+		User:       username,
+	})
 	if err != nil {
 		return nil, err
 	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("API returned status: %d", resp.StatusCode)
-	}
-
-	var recipesResp struct {
-		Recipes []Recipe `json:"recipes"`
-	}
-	if err := json.NewDecoder(resp.Body).Decode(&recipesResp); err != nil {
-		return nil, fmt.Errorf("failed to decode response: %w", err)
-	}
-
-	return recipesResp.Recipes, nil
+	return resp.Recipes, nil
 }
 
 // AdminAddContribution adds contribution points (admin only)
 func (c *APIClient) AdminAddContribution(amount int) (string, error) {
-	req := map[string]interface{}{
-		"amount": amount,
-	}
-	return c.doAction(http.MethodPost, "/api/v1/progression/admin/contribution", req)
+	return messageOf(c.API.PostProgressionAdminContribution(context.Background(), &apiclient.AdminAddContributionRequest{
+		Amount: amount,
+	}))
 }
 
 // GetUserTimeout retrieves timeout status for a user
 func (c *APIClient) GetUserTimeout(username string) (bool, float64, error) {
-	params := url.Values{}
-	params.Set("username", username)
-
-	path := fmt.Sprintf("/api/v1/user/timeout?%s", params.Encode())
-	resp, err := c.doRequest(http.MethodGet, path, nil)
+	resp, err := c.API.GetUserTimeout(context.Background(), apiclient.GetUserTimeoutParams{Username: username})
 	if err != nil {
 		return false, 0, err
 	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK {
-		var errResp struct {
-			Error string `json:"error"`
-		}
-		if err := json.NewDecoder(resp.Body).Decode(&errResp); err == nil && errResp.Error != "" {
-			return false, 0, fmt.Errorf("API error: %s", errResp.Error)
-		}
-		return false, 0, fmt.Errorf("API returned status: %d", resp.StatusCode)
-	}
-
-	var timeoutResp struct {
-		IsTimedOut       bool    `json:"is_timed_out"`
-		RemainingSeconds float64 `json:"remaining_seconds"`
-	}
-	if err := json.NewDecoder(resp.Body).Decode(&timeoutResp); err != nil {
-		return false, 0, fmt.Errorf("failed to decode response: %w", err)
-	}
-
-	return timeoutResp.IsTimedOut, timeoutResp.RemainingSeconds, nil
+	return resp.IsTimedOut, resp.RemainingSeconds, nil
 }
 
-// GetLeaderboard retrieves leaderboard rankings
-func (c *APIClient) GetLeaderboard(metric string, limit int) (string, error) {
-	params := url.Values{}
-	params.Set("metric", metric)
-	params.Set("limit", fmt.Sprintf("%d", limit))
-
-	path := fmt.Sprintf("/api/v1/stats/leaderboard?%s", params.Encode())
-	resp, err := c.doRequest(http.MethodGet, path, nil)
-	if err != nil {
-		return "", err
-	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK {
-		return "", fmt.Errorf("API returned status: %d", resp.StatusCode)
-	}
-
-	var leaderboardResp struct {
-		Message string `json:"message"`
-		Data    string `json:"data"`
+// GetLeaderboard retrieves a page of the leaderboard for an event type.
+// A non-empty aroundPlatformID centres the page on that Discord user instead of offset.
+func (c *APIClient) GetLeaderboard(eventType string, limit, offset int, aroundPlatformID string) (*LeaderboardPage, error) {
+	params := apiclient.GetStatsLeaderboardParams{
+		EventType: eventType,
+		Limit:     limit,
+		Offset:    offset,
 	}
-	if err := json.NewDecoder(resp.Body).Decode(&leaderboardResp); err != nil {
-		return "", fmt.Errorf("failed to decode response: %w", err)
+	if aroundPlatformID != "" {
+		params.Platform = domain.PlatformDiscord
+		params.PlatformID = aroundPlatformID
 	}
-
-	if leaderboardResp.Data != "" {
-		return leaderboardResp.Data, nil
-	}
-	return leaderboardResp.Message, nil
+	return c.API.GetStatsLeaderboard(context.Background(), params)
 }
 
 // GetUserStats retrieves stats for a specific user
 func (c *APIClient) GetUserStats(platform, platformID string) (string, error) {
-	params := url.Values{}
-	params.Set("platform", platform)
-	params.Set("platform_id", platformID)
+	return formatStatsSummary(c.API.GetStatsUser(context.Background(), apiclient.GetStatsUserParams{
+		Platform:   platform,
+		PlatformID: platformID,
+	}))
+}
 
-	path := fmt.Sprintf("/api/v1/stats/user?%s", params.Encode())
-	resp, err := c.doRequest(http.MethodGet, path, nil)
+// formatStatsSummary renders event counts busiest first, ties by event type
+func formatStatsSummary(summary *apiclient.StatsSummary, err error) (string, error) {
 	if err != nil {
 		return "", err
 	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK {
-		return "", fmt.Errorf("API returned status: %d", resp.StatusCode)
+	if summary.TotalEvents == 0 {
+		return "No events recorded yet.", nil
 	}
 
-	var statsResp struct {
-		Message string `json:"message"`
-		Stats   string `json:"stats"`
-	}
-	if err := json.NewDecoder(resp.Body).Decode(&statsResp); err != nil {
-		return "", fmt.Errorf("failed to decode response: %w", err)
+	eventTypes := make([]string, 0, len(summary.EventCounts))
+	for eventType := range summary.EventCounts {
+		eventTypes = append(eventTypes, eventType)
 	}
+	sort.Slice(eventTypes, func(a, b int) bool {
+		ca, cb := summary.EventCounts[eventTypes[a]], summary.EventCounts[eventTypes[b]]
+		if ca != cb {
+			return ca > cb
+		}
+		return eventTypes[a] < eventTypes[b]
+	})
 
-	if statsResp.Stats != "" {
-		return statsResp.Stats, nil
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "**Total events**: %d\n", summary.TotalEvents)
+	for _, eventType := range eventTypes {
+		fmt.Fprintf(&sb, "**%s**: %d\n", eventType, summary.EventCounts[eventType])
 	}
-	return statsResp.Message, nil
+	return sb.String(), nil
 }
 
 // GetInventoryByUsername retrieves user inventory by username
-func (c *APIClient) GetInventoryByUsername(platform, username, filter string) ([]user.InventoryItem, error) {
-	return c.getInventoryInternal("/api/v1/user/inventory-by-username", platform, "", username, filter)
-}
-
-// XPAwardResult represents the result of awarding XP
-type XPAwardResult struct {
-	LeveledUp bool `json:"leveled_up"`
-	NewLevel  int  `json:"new_level"`
-	NewXP     int  `json:"new_xp"`
+func (c *APIClient) GetInventoryByUsername(platform, username, filter string) ([]InventoryItem, error) {
+	resp, err := c.API.GetUserInventoryByUsername(context.Background(), apiclient.GetUserInventoryByUsernameParams{
+		Platform: platform,
+		Username: username,
+		Filter:   filter,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Items, nil
 }
 
 // AdminAwardXP awards job XP to a user via platform and username (admin only)
 func (c *APIClient) AdminAwardXP(platform, username, jobKey string, amount int) (*XPAwardResult, error) {
-	req := map[string]interface{}{
-		"platform": platform,
-		"username": username,
-		"job_key":  jobKey,
-		"amount":   amount,
-	}
-
-	resp, err := c.doRequest(http.MethodPost, "/api/v1/admin/job/award-xp", req)
+	resp, err := c.API.PostAdminJobsAwardXP(context.Background(), &apiclient.AdminAwardXPRequest{
+		Platform: platform,
+		Username: username,
+		JobKey:   jobKey,
+		Amount:   amount,
+	})
 	if err != nil {
 		return nil, err
 	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK {
-		var errResp struct {
-			Error string `json:"error"`
-		}
-		if err := json.NewDecoder(resp.Body).Decode(&errResp); err == nil && errResp.Error != "" {
-			return nil, fmt.Errorf("API error: %s", errResp.Error)
-		}
-		return nil, fmt.Errorf("API returned status: %d", resp.StatusCode)
-	}
-
-	var awardResp struct {
-		Success bool `json:"success"`
-		Result  struct {
-			LeveledUp bool `json:"leveled_up"`
-			NewLevel  int  `json:"new_level"`
-			NewXP     int  `json:"new_xp"`
-		} `json:"result"`
-	}
-	if err := json.NewDecoder(resp.Body).Decode(&awardResp); err != nil {
-		return nil, fmt.Errorf("failed to decode response: %w", err)
-	}
-
-	return &XPAwardResult{
-		LeveledUp: awardResp.Result.LeveledUp,
-		NewLevel:  awardResp.Result.NewLevel,
-		NewXP:     awardResp.Result.NewXP,
-	}, nil
+	return resp.Result, nil
 }
 
 // GetUnlockProgress returns current unlock progress
-func (c *APIClient) GetUnlockProgress() (*map[string]interface{}, error) {
-	resp, err := c.doRequest(http.MethodGet, "/api/v1/progression/unlock-progress", nil)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK {
-		var errResp struct {
-			Error string `json:"error"`
-		}
-		if err := json.NewDecoder(resp.Body).Decode(&errResp); err == nil && errResp.Error != "" {
-			return nil, fmt.Errorf("API error: %s", errResp.Error)
-		}
-		return nil, fmt.Errorf("API returned status: %d", resp.StatusCode)
-	}
-
-	var progress map[string]interface{}
-	if err := json.NewDecoder(resp.Body).Decode(&progress); err != nil {
-		return nil, fmt.Errorf("failed to decode response: %w", err)
-	}
-
-	return &progress, nil
+func (c *APIClient) GetUnlockProgress() (*UnlockProgress, error) {
+	return c.API.GetProgressionUnlockProgress(context.Background())
 }
 
 // GetUserEngagement returns user's engagement breakdown
-func (c *APIClient) GetUserEngagement(platform, platformID string) (*domain.ContributionBreakdown, error) {
-	params := url.Values{}
-	params.Set("platform", platform)
-	params.Set("platform_id", platformID)
-
-	path := fmt.Sprintf("/api/v1/progression/engagement?%s", params.Encode())
-	resp, err := c.doRequest(http.MethodGet, path, nil)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK {
-		var errResp struct {
-			Error string `json:"error"`
-		}
-		if err := json.NewDecoder(resp.Body).Decode(&errResp); err == nil && errResp.Error != "" {
-			return nil, fmt.Errorf("API error: %s", errResp.Error)
-		}
-		return nil, fmt.Errorf("API returned status: %d", resp.StatusCode)
-	}
-
-	var breakdown domain.ContributionBreakdown
-	if err := json.NewDecoder(resp.Body).Decode(&breakdown); err != nil {
-		return nil, fmt.Errorf("failed to decode response: %w", err)
-	}
-
-	return &breakdown, nil
+func (c *APIClient) GetUserEngagement(platform, platformID string) (*ContributionBreakdown, error) {
+	return c.API.GetProgressionEngagement(context.Background(), apiclient.GetProgressionEngagementParams{
+		Platform:   platform,
+		PlatformID: platformID,
+	})
 }
 
 // GetContributionLeaderboard returns top contributors
 func (c *APIClient) GetContributionLeaderboard(limit int) (string, error) {
-	params := url.Values{}
-	params.Set("limit", fmt.Sprintf("%d", limit))
-
-	path := fmt.Sprintf("/api/v1/progression/leaderboard?%s", params.Encode())
-	resp, err := c.doRequest(http.MethodGet, path, nil)
+	entries, err := c.API.GetProgressionLeaderboard(context.Background(), apiclient.GetProgressionLeaderboardParams{Limit: limit})
 	if err != nil {
 		return "", err
 	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK {
-		var errResp struct {
-			Error string `json:"error"`
-		}
-		if err := json.NewDecoder(resp.Body).Decode(&errResp); err == nil && errResp.Error != "" {
-			return "", fmt.Errorf("API error: %s", errResp.Error)
-		}
-		return "", fmt.Errorf("API returned status: %d", resp.StatusCode)
-	}
-
-	var entries []domain.ContributionLeaderboardEntry
-	if err := json.NewDecoder(resp.Body).Decode(&entries); err != nil {
-		return "", fmt.Errorf("failed to decode response: %w", err)
-	}
 
 	if len(entries) == 0 {
 		return "No contributions yet.", nil
@@ -865,673 +600,317 @@ func (c *APIClient) GetContributionLeaderboard(limit int) (string, error) {
 
 	var sb strings.Builder
 	for _, entry := range entries {
+		if entry.Username != "" {
+			fmt.Fprintf(&sb, "**%d.** %s: %d points\n", entry.Rank, entry.Username, entry.Contribution)
+			continue
+		}
 		fmt.Fprintf(&sb, "**%d.** <@%s>: %d points\n", entry.Rank, entry.UserID, entry.Contribution)
 	}
 	return sb.String(), nil
 }
 
+// GetVotingHistory returns a page of completed voting sessions, newest first
+func (c *APIClient) GetVotingHistory(limit, offset int) (*VotingSessionHistory, error) {
+	return c.API.GetProgressionSessionsHistory(context.Background(), apiclient.GetProgressionSessionsHistoryParams{
+		Limit:  limit,
+		Offset: offset,
+	})
+}
+
 // GetVotingSession returns current voting session
-func (c *APIClient) GetVotingSession() (*domain.ProgressionVotingSession, error) {
-	resp, err := c.doRequest(http.MethodGet, "/api/v1/progression/session", nil)
+func (c *APIClient) GetVotingSession() (*VotingSession, error) {
+	resp, err := c.API.GetProgressionSession(context.Background())
 	if err != nil {
 		return nil, err
 	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK {
-		var errResp struct {
-			Error string `json:"error"`
-		}
-		if err := json.NewDecoder(resp.Body).Decode(&errResp); err == nil && errResp.Error != "" {
-			return nil, fmt.Errorf("API error: %s", errResp.Error)
-		}
-		return nil, fmt.Errorf("API returned status: %d", resp.StatusCode)
-	}
-
-	// Handles "no active session" message wrapper if needed, but endpoint returns direct object or "session": null
-	// Checking for the map wrapper first just in case
-	bodyBytes, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, fmt.Errorf("failed to read response body: %w", err)
-	}
-
-	// Correctly decode the nested response
-	var sessionResp struct {
-		Session *domain.ProgressionVotingSession `json:"session"`
-		Message string                           `json:"message"`
-	}
-	if err := json.NewDecoder(io.NopCloser(bytes.NewBuffer(bodyBytes))).Decode(&sessionResp); err != nil {
-		return nil, fmt.Errorf("failed to decode session: %w", err)
-	}
-
-	return sessionResp.Session, nil
+	// The session is null with a message when no vote is running
+	return resp.Session, nil
 }
 
 // HandleMessage sends a chat message to the server for processing
-func (c *APIClient) HandleMessage(platform, platformID, username, message string) (*domain.MessageResult, error) {
-	req := map[string]string{
-		"platform":    platform,
-		"platform_id": platformID,
-		"username":    username,
-		"message":     message,
-	}
-
-	var result domain.MessageResult
-	if err := c.doRequestAndParse(http.MethodPost, "/api/v1/message/handle", req, &result); err != nil {
-		return nil, err
-	}
-	return &result, nil
+func (c *APIClient) HandleMessage(platform, platformID, username, message string) (*MessageResult, error) {
+	return c.API.PostMessageHandle(context.Background(), &apiclient.HandleMessageRequest{
+		Platform:   platform,
+		PlatformID: platformID,
+		Username:   username,
+		Message:    message,
+	})
 }
 
 // GetAllJobs retrieves all available jobs
-func (c *APIClient) GetAllJobs() ([]domain.Job, error) {
-	resp, err := c.doRequest(http.MethodGet, "/api/v1/jobs", nil)
+func (c *APIClient) GetAllJobs() ([]Job, error) {
+	resp, err := c.API.GetJobs(context.Background())
 	if err != nil {
 		return nil, err
 	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("API returned status: %d", resp.StatusCode)
-	}
-
-	var jobsResp struct {
-		Jobs []domain.Job `json:"jobs"`
-	}
-	if err := json.NewDecoder(resp.Body).Decode(&jobsResp); err != nil {
-		return nil, fmt.Errorf("failed to decode response: %w", err)
-	}
-
-	return jobsResp.Jobs, nil
+	return resp.Jobs, nil
 }
 
 // GetUserJobs retrieves job progress for a user
 func (c *APIClient) GetUserJobs(platform, platformID string) (*UserJobsResponse, error) {
-	params := url.Values{}
-	params.Set("platform", platform)
-	params.Set("platform_id", platformID)
-
-	path := fmt.Sprintf("/api/v1/jobs/user?%s", params.Encode())
-	resp, err := c.doRequest(http.MethodGet, path, nil)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK {
-		var errResp struct {
-			Error string `json:"error"`
-		}
-		if err := json.NewDecoder(resp.Body).Decode(&errResp); err == nil && errResp.Error != "" {
-			return nil, fmt.Errorf("API error: %s", errResp.Error)
-		}
-		return nil, fmt.Errorf("API returned status: %d", resp.StatusCode)
-	}
-
-	var jobsResp UserJobsResponse
-	if err := json.NewDecoder(resp.Body).Decode(&jobsResp); err != nil {
-		return nil, fmt.Errorf("failed to decode response: %w", err)
-	}
-
-	return &jobsResp, nil
+	return c.API.GetJobsUser(context.Background(), apiclient.GetJobsUserParams{
+		Platform:   platform,
+		PlatformID: platformID,
+	})
 }
 
 // AwardJobXP awards XP (Standard/Bot method)
-func (c *APIClient) AwardJobXP(userID, jobKey string, amount int, source string) (*domain.XPAwardResult, error) {
-	req := map[string]interface{}{
-		"user_id":   userID,
-		"job_key":   jobKey,
-		"xp_amount": amount,
-		"source":    source,
-	}
-
-	var result domain.XPAwardResult
-	if err := c.doRequestAndParse(http.MethodPost, "/api/v1/jobs/award-xp", req, &result); err != nil {
-		return nil, err
-	}
-	return &result, nil
+func (c *APIClient) AwardJobXP(platform, platformID, jobKey string, amount int, source string) (*XPAwardResult, error) {
+	return c.API.PostJobsAwardXP(context.Background(), &apiclient.AwardXPRequest{
+		Platform:   platform,
+		PlatformID: platformID,
+		JobKey:     jobKey,
+		XPAmount:   amount,
+		Source:     source,
+	})
 }
 
 // GetSystemStats retrieves system-wide statistics
 func (c *APIClient) GetSystemStats() (string, error) {
-	resp, err := c.doRequest(http.MethodGet, "/api/v1/stats/system", nil)
-	if err != nil {
-		return "", err
-	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK {
-		return "", fmt.Errorf("API returned status: %d", resp.StatusCode)
-	}
-
-	var statsResp struct {
-		Message string `json:"message"`
-		Stats   string `json:"stats"`
-	}
-	if err := json.NewDecoder(resp.Body).Decode(&statsResp); err != nil {
-		return "", fmt.Errorf("failed to decode response: %w", err)
-	}
-
-	if statsResp.Stats != "" {
-		return statsResp.Stats, nil
-	}
-	return statsResp.Message, nil
+	return formatStatsSummary(c.API.GetStatsSystem(context.Background(), apiclient.GetStatsSystemParams{}))
 }
 
 // RecordEvent records a generic user event
-func (c *APIClient) RecordEvent(platform, platformID, eventType string, metadata map[string]interface{}) (string, error) {
-	req := map[string]interface{}{
-		"platform":    platform,
-		"platform_id": platformID,
-		"event_type":  eventType,
-		"metadata":    metadata,
-	}
-
-	resp, err := c.doRequest(http.MethodPost, "/api/v1/stats/event", req)
-	if err != nil {
-		return "", err
-	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK {
-		return "", fmt.Errorf("API returned status: %d", resp.StatusCode)
-	}
-
-	var result struct {
-		Message string `json:"message"`
-	}
-	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
-		return "", fmt.Errorf("failed to decode response: %w", err)
-	}
-
-	return result.Message, nil
+func (c *APIClient) RecordEvent(userID, eventType string, eventData map[string]interface{}) (string, error) {
+	return messageOf(c.API.PostStatsEvent(context.Background(), &apiclient.RecordEventRequest{
+		UserID:    userID,
+		EventType: eventType,
+		EventData: eventData,
+	}))
 }
 
 // ReloadAliases reloads item aliases (admin only)
 func (c *APIClient) ReloadAliases() error {
-	resp, err := c.doRequest(http.MethodPost, "/api/v1/admin/reload-aliases", nil)
-	if err != nil {
-		return err
-	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK {
-		return fmt.Errorf("API returned status: %d", resp.StatusCode)
-	}
-	return nil
+	_, err := c.API.PostAdminReloadAliases(context.Background())
+	return err
 }
 
 // Test endpoint
 func (c *APIClient) Test(platform, platformID, username string) (string, error) {
-	req := map[string]string{
-		"platform":    platform,
-		"platform_id": platformID,
-		"username":    username,
-	}
-
-	resp, err := c.doRequest(http.MethodPost, "/api/v1/test", req)
+	resp, err := c.API.PostTest(context.Background(), &apiclient.TestRequest{
+		Platform:   platform,
+		PlatformID: platformID,
+		Username:   username,
+	})
 	if err != nil {
 		return "", err
 	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK {
-		return "", fmt.Errorf("API returned status: %d", resp.StatusCode)
-	}
-
-	var result struct {
-		Message string `json:"message"`
-	}
-	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
-		return "", fmt.Errorf("failed to decode response: %w", err)
-	}
-
-	return result.Message, nil
+	return resp.Message, nil
 }
 
 // Harvest collects accumulated rewards for a user
-func (c *APIClient) Harvest(platform, platformID, username string) (*domain.HarvestResponse, error) {
-	req := map[string]string{
-		"platform":    platform,
-		"platform_id": platformID,
-		"username":    username,
-	}
-
-	resp, err := c.doRequest(http.MethodPost, "/api/v1/harvest", req)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK {
-		// Try to read error message
-		var errResp struct {
-			Error string `json:"error"`
-		}
-		if err := json.NewDecoder(resp.Body).Decode(&errResp); err == nil && errResp.Error != "" {
-			return nil, fmt.Errorf("%s", errResp.Error)
-		}
-		return nil, fmt.Errorf("API returned status: %d", resp.StatusCode)
-	}
-
-	var harvestResp domain.HarvestResponse
-	if err := json.NewDecoder(resp.Body).Decode(&harvestResp); err != nil {
-		return nil, fmt.Errorf("failed to decode response: %w", err)
-	}
-
-	return &harvestResp, nil
+func (c *APIClient) Harvest(platform, platformID, username string) (*HarvestResponse, error) {
+	return c.API.PostHarvest(context.Background(), &apiclient.HarvestRewardsRequest{
+		Platform:   platform,
+		PlatformID: platformID,
+		Username:   username,
+	})
 }
 
 // AdminClearTimeout clears a user's timeout (admin only)
 func (c *APIClient) AdminClearTimeout(platform, username string) (string, error) {
-	req := map[string]string{
-		"platform": platform,
-		"username": username,
+	resp, err := c.API.PostAdminTimeoutClear(context.Background(), &apiclient.ClearTimeoutRequest{
+		Platform: platform,
+		Username: username,
+	})
+	if err != nil {
+		return "", err
 	}
-	return c.doAction(http.MethodPost, "/api/v1/admin/timeout/clear", req)
+	return resp.Message, nil
 }
 
 // SetUserTimeout applies or extends a timeout for a user
 func (c *APIClient) SetUserTimeout(platform, username string, durationSeconds int, reason string) (string, error) {
-	req := map[string]interface{}{
-		"platform":         platform,
-		"username":         username,
-		"duration_seconds": durationSeconds,
-		"reason":           reason,
+	resp, err := c.API.PutUserTimeout(context.Background(), &apiclient.SetTimeoutRequest{
+		Platform:        platform,
+		Username:        username,
+		DurationSeconds: durationSeconds,
+		Reason:          reason,
+	})
+	if err != nil {
+		return "", err
 	}
-	return c.doAction(http.MethodPut, "/api/v1/user/timeout", req)
+	return resp.Message, nil
 }
 
 // StartExpedition starts a new expedition
 func (c *APIClient) StartExpedition(platform, platformID, username string, expeditionType domain.ExpeditionType) (string, string, error) {
-	req := map[string]interface{}{
-		"platform":        platform,
-		"platform_id":     platformID,
-		"username":        username,
-		"expedition_type": expeditionType,
-	}
-
-	resp, err := c.doRequest(http.MethodPost, "/api/v1/expedition/start", req)
+	resp, err := c.API.PostExpeditionStart(context.Background(), &apiclient.StartExpeditionRequest{
+		Platform:       platform,
+		PlatformID:     platformID,
+		Username:       username,
+		ExpeditionType: string(expeditionType),
+	})
 	if err != nil {
 		return "", "", err
 	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusCreated {
-		var errResp struct {
-			Error string `json:"error"`
-		}
-		if err := json.NewDecoder(resp.Body).Decode(&errResp); err == nil && errResp.Error != "" {
-			return "", "", fmt.Errorf("API error: %s", errResp.Error)
-		}
-		return "", "", fmt.Errorf("API returned status: %d", resp.StatusCode)
-	}
-
-	var startResp struct {
-		Message      string `json:"message"`
-		ExpeditionID string `json:"expedition_id"`
-		JoinDeadline string `json:"join_deadline"`
-	}
-	if err := json.NewDecoder(resp.Body).Decode(&startResp); err != nil {
-		return "", "", fmt.Errorf("failed to decode response: %w", err)
-	}
-
-	return startResp.ExpeditionID, startResp.JoinDeadline, nil
+	return resp.ExpeditionID, resp.JoinDeadline, nil
 }
 
 // JoinExpedition joins an active expedition
 func (c *APIClient) JoinExpedition(platform, platformID, username, expeditionID string) (string, error) {
-	req := map[string]interface{}{
-		"platform":    platform,
-		"platform_id": platformID,
-		"username":    username,
-	}
-
-	path := fmt.Sprintf("/api/v1/expedition/join?id=%s", expeditionID)
-	return c.doAction(http.MethodPost, path, req)
-}
-
-// ExpeditionStatusResponse represents the expedition status from the API
-type ExpeditionStatusResponse struct {
-	HasActive       bool               `json:"has_active"`
-	ActiveDetails   *ExpeditionDetails `json:"active_details,omitempty"`
-	CooldownExpires *string            `json:"cooldown_expires,omitempty"`
-	OnCooldown      bool               `json:"on_cooldown"`
-}
-
-// ExpeditionDetails represents expedition details from the API
-type ExpeditionDetails struct {
-	ID           string `json:"id"`
-	State        string `json:"state"`
-	JoinDeadline string `json:"join_deadline"`
+	return messageOf(c.API.PostExpeditionJoin(context.Background(),
+		apiclient.PostExpeditionJoinParams{ID: expeditionID},
+		&apiclient.JoinExpeditionRequest{Platform: platform, PlatformID: platformID, Username: username}))
 }
 
 // GetExpeditionStatus retrieves the current expedition status
-func (c *APIClient) GetExpeditionStatus() (*ExpeditionStatusResponse, error) {
-	var status ExpeditionStatusResponse
-	if err := c.doRequestAndParse(http.MethodGet, "/api/v1/expedition/status", nil, &status); err != nil {
-		return nil, err
-	}
-	return &status, nil
-}
-
-// ExpeditionJournalEntry represents a journal entry from the API
-type ExpeditionJournalEntry struct {
-	TurnNumber    int    `json:"turn_number"`
-	EncounterType string `json:"encounter_type"`
-	Outcome       string `json:"outcome"`
-	Narrative     string `json:"narrative"`
-	Fatigue       int    `json:"fatigue"`
-	Purse         int    `json:"purse"`
+func (c *APIClient) GetExpeditionStatus() (*ExpeditionStatus, error) {
+	return c.API.GetExpeditionStatus(context.Background())
 }
 
 // GetExpeditionJournal retrieves the journal for a completed expedition
 func (c *APIClient) GetExpeditionJournal(expeditionID string) ([]ExpeditionJournalEntry, error) {
-	path := fmt.Sprintf("/api/v1/expedition/journal?id=%s", expeditionID)
-	resp, err := c.doRequest(http.MethodGet, path, nil)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK {
-		var errResp struct {
-			Error string `json:"error"`
-		}
-		if err := json.NewDecoder(resp.Body).Decode(&errResp); err == nil && errResp.Error != "" {
-			return nil, fmt.Errorf("API error: %s", errResp.Error)
-		}
-		return nil, fmt.Errorf("API returned status: %d", resp.StatusCode)
-	}
-
-	var entries []ExpeditionJournalEntry
-	if err := json.NewDecoder(resp.Body).Decode(&entries); err != nil {
-		return nil, fmt.Errorf("failed to decode response: %w", err)
-	}
-
-	return entries, nil
+	return c.API.GetExpeditionJournal(context.Background(), apiclient.GetExpeditionJournalParams{ID: expeditionID})
 }
 
 // ProcessPredictionOutcome processes a prediction outcome from Twitch/YouTube
-func (c *APIClient) ProcessPredictionOutcome(platform string, winner domain.PredictionWinner, totalPointsSpent int, participants []domain.PredictionParticipant) (*domain.PredictionResult, error) {
-	req := domain.PredictionOutcomeRequest{
+func (c *APIClient) ProcessPredictionOutcome(platform string, winner PredictionWinner, totalPointsSpent int, participants []PredictionParticipant) (*PredictionResult, error) {
+	return c.API.PostPrediction(context.Background(), &apiclient.PredictionOutcomeRequest{
 		Platform:         platform,
-		Winner:           winner,
+		Winner:           &winner,
 		TotalPointsSpent: totalPointsSpent,
 		Participants:     participants,
-	}
-
-	var result domain.PredictionResult
-	if err := c.doRequestAndParse(http.MethodPost, "/api/v1/prediction", req, &result); err != nil {
-		return nil, err
-	}
-	return &result, nil
+	})
 }
 
 // GetActiveQuests retrieves the current week's active quests
-func (c *APIClient) GetActiveQuests() ([]domain.Quest, error) {
-	var quests []domain.Quest
-	if err := c.doRequestAndParse(http.MethodGet, "/api/v1/quests/active", nil, &quests); err != nil {
-		return nil, err
-	}
-	return quests, nil
+func (c *APIClient) GetActiveQuests() ([]Quest, error) {
+	return c.API.GetQuestsActive(context.Background())
 }
 
 // GetUserQuestProgress retrieves a user's quest progress
-func (c *APIClient) GetUserQuestProgress(userID string) ([]domain.QuestProgress, error) {
-	params := url.Values{}
-	params.Set("user_id", userID)
-
-	path := fmt.Sprintf("/api/v1/quests/progress?%s", params.Encode())
-	var progress []domain.QuestProgress
-	if err := c.doRequestAndParse(http.MethodGet, path, nil, &progress); err != nil {
-		return nil, err
-	}
-	return progress, nil
+func (c *APIClient) GetUserQuestProgress(userID string) ([]QuestProgress, error) {
+	return c.API.GetQuestsProgress(context.Background(), apiclient.GetQuestsProgressParams{UserID: userID})
 }
 
 // ClaimQuestReward claims a completed quest's reward
-func (c *APIClient) ClaimQuestReward(userID string, questID int) (map[string]interface{}, error) {
-	req := map[string]interface{}{
-		"user_id":  userID,
-		"quest_id": questID,
-	}
-
-	var result map[string]interface{}
-	if err := c.doRequestAndParse(http.MethodPost, "/api/v1/quests/claim", req, &result); err != nil {
-		return nil, err
-	}
-	return result, nil
+func (c *APIClient) ClaimQuestReward(userID string, questID int) (*QuestClaim, error) {
+	return c.API.PostQuestsClaim(context.Background(), &apiclient.ClaimQuestRequest{
+		UserID:  userID,
+		QuestID: questID,
+	})
 }
 
 // CompostDeposit deposits items into the user's compost bin
-func (c *APIClient) CompostDeposit(platform, platformID string, items []map[string]interface{}) (*CompostDepositResult, error) {
-	req := map[string]interface{}{
-		"platform":    platform,
-		"platform_id": platformID,
-		"items":       items,
-	}
-
-	var result CompostDepositResult
-	if err := c.doRequestAndParse(http.MethodPost, "/api/v1/compost/deposit", req, &result); err != nil {
-		return nil, err
-	}
-	return &result, nil
-}
-
-// CompostDepositResult represents the deposit response from the API
-type CompostDepositResult struct {
-	Message   string `json:"message"`
-	Status    string `json:"status"`
-	ItemCount int    `json:"item_count"`
-	Capacity  int    `json:"capacity"`
-	ReadyAt   string `json:"ready_at,omitempty"`
+func (c *APIClient) CompostDeposit(platform, platformID string, items []CompostDepositItem) (*CompostDepositResult, error) {
+	return c.API.PostCompostDeposit(context.Background(), &apiclient.CompostDepositRequest{
+		Platform:   platform,
+		PlatformID: platformID,
+		Items:      items,
+	})
 }
 
 // CompostHarvest harvests from the user's compost bin
 func (c *APIClient) CompostHarvest(platform, platformID, username string) (*CompostHarvestResult, error) {
-	req := map[string]string{
-		"platform":    platform,
-		"platform_id": platformID,
-		"username":    username,
-	}
-
-	var result CompostHarvestResult
-	if err := c.doRequestAndParse(http.MethodPost, "/api/v1/compost/harvest", req, &result); err != nil {
-		return nil, err
-	}
-	return &result, nil
-}
-
-// CompostHarvestResult represents the harvest response from the API
-type CompostHarvestResult struct {
-	Message   string         `json:"message"`
-	Harvested bool           `json:"harvested"`
-	Items     map[string]int `json:"items,omitempty"`
-	TimeLeft  string         `json:"time_left,omitempty"`
-	Status    string         `json:"status,omitempty"`
+	return c.API.PostCompostHarvest(context.Background(), &apiclient.CompostHarvestRequest{
+		Platform:   platform,
+		PlatformID: platformID,
+		Username:   username,
+	})
 }
 
 // CompostStatus checks the compost bin status
-func (c *APIClient) CompostStatus(platform, platformID string) (*domain.HarvestResult, error) {
-	params := url.Values{}
-	params.Set("platform", platform)
-	params.Set("platform_id", platformID)
-
-	path := fmt.Sprintf("/api/v1/compost/status?%s", params.Encode())
-	var result domain.HarvestResult
-	if err := c.doRequestAndParse(http.MethodGet, path, nil, &result); err != nil {
-		return nil, err
-	}
-	return &result, nil
+func (c *APIClient) CompostStatus(platform, platformID string) (*CompostStatusResult, error) {
+	return c.API.GetCompostStatus(context.Background(), apiclient.GetCompostStatusParams{
+		Platform:   platform,
+		PlatformID: platformID,
+	})
 }
 
 // SpinSlots spins the slots machine with the specified bet
-func (c *APIClient) SpinSlots(platform, platformID, username string, betAmount int) (*domain.SlotsResult, error) {
-	req := map[string]interface{}{
-		"platform":    platform,
-		"platform_id": platformID,
-		"username":    username,
-		"bet_amount":  betAmount,
-	}
-
-	var result domain.SlotsResult
-	if err := c.doRequestAndParse(http.MethodPost, "/api/v1/slots/spin", req, &result); err != nil {
-		return nil, err
-	}
-	return &result, nil
-}
-
-// UserJobsResponse represents the response from GetUserJobs
-type UserJobsResponse struct {
-	Platform   string               `json:"platform"`
-	PlatformID string               `json:"platform_id"`
-	PrimaryJob *domain.UserJobInfo  `json:"primary_job"`
-	Jobs       []domain.UserJobInfo `json:"jobs"`
-}
-
-// AdminUserLookupResult represents the result of a user lookup
-type AdminUserLookupResult struct {
-	ID         string `json:"id"`
-	Platform   string `json:"platform"`
-	PlatformID string `json:"platform_id"`
-	Username   string `json:"username"`
-	CreatedAt  string `json:"created_at"`
+func (c *APIClient) SpinSlots(platform, platformID, username string, betAmount int) (*SlotsResult, error) {
+	return c.API.PostSlotsSpin(context.Background(), &apiclient.SpinSlotsRequest{
+		Platform:   platform,
+		PlatformID: platformID,
+		Username:   username,
+		BetAmount:  betAmount,
+	})
 }
 
 // AdminUserLookup retrieves user details (admin only)
 func (c *APIClient) AdminUserLookup(platform, username string) (*AdminUserLookupResult, error) {
-	params := url.Values{}
-	params.Set("platform", platform)
-	params.Set("username", username)
-
-	path := fmt.Sprintf("/api/v1/admin/users/lookup?%s", params.Encode())
-	var result AdminUserLookupResult
-	if err := c.doRequestAndParse(http.MethodGet, path, nil, &result); err != nil {
-		return nil, err
-	}
-	return &result, nil
+	return c.API.GetAdminUsersLookup(context.Background(), apiclient.GetAdminUsersLookupParams{
+		Platform: platform,
+		Username: username,
+	})
 }
 
 // AdminGetRecentUsers retrieves recently active users (admin only)
-func (c *APIClient) AdminGetRecentUsers(limit int) ([]domain.User, error) {
-	params := url.Values{}
-	params.Set("limit", fmt.Sprintf("%d", limit))
-
-	path := fmt.Sprintf("/api/v1/admin/users/recent?%s", params.Encode())
-	var result []domain.User
-	if err := c.doRequestAndParse(http.MethodGet, path, nil, &result); err != nil {
-		return nil, err
-	}
-	return result, nil
+func (c *APIClient) AdminGetRecentUsers(limit int) ([]User, error) {
+	return c.API.GetAdminUsersRecent(context.Background(), apiclient.GetAdminUsersRecentParams{Limit: limit})
 }
 
 // AdminGetEvents retrieves recent system events (admin only)
-func (c *APIClient) AdminGetEvents(limit int) ([]string, error) {
-	params := url.Values{}
-	params.Set("limit", fmt.Sprintf("%d", limit))
-
-	path := fmt.Sprintf("/api/v1/admin/events?%s", params.Encode())
-	var result []string
-	if err := c.doRequestAndParse(http.MethodGet, path, nil, &result); err != nil {
+func (c *APIClient) AdminGetEvents(limit int) ([]EventLogEntry, error) {
+	resp, err := c.API.GetAdminEvents(context.Background(), apiclient.GetAdminEventsParams{Limit: limit})
+	if err != nil {
 		return nil, err
 	}
-	return result, nil
+	return resp.Events, nil
 }
 
 // AdminManualDailyReset triggers the daily reset manually (admin only)
 func (c *APIClient) AdminManualDailyReset() (string, error) {
-	return c.doAction(http.MethodPost, "/api/v1/admin/jobs/reset-daily-xp", nil)
+	resp, err := c.API.PostAdminJobsResetDailyXP(context.Background())
+	if err != nil {
+		return "", err
+	}
+	return resp.Message, nil
 }
 
 // AdminGetResetStatus retrieves the daily reset status (admin only)
-func (c *APIClient) AdminGetResetStatus() (*domain.DailyResetStatus, error) {
-	var result domain.DailyResetStatus
-	if err := c.doRequestAndParse(http.MethodGet, "/api/v1/admin/jobs/reset-status", nil, &result); err != nil {
-		return nil, err
-	}
-	return &result, nil
+func (c *APIClient) AdminGetResetStatus() (*DailyResetStatus, error) {
+	return c.API.GetAdminJobsResetStatus(context.Background())
 }
 
 // AdminGetMetrics retrieves system metrics (admin only)
-func (c *APIClient) AdminGetMetrics() (map[string]interface{}, error) {
-	var result map[string]interface{}
-	if err := c.doRequestAndParse(http.MethodGet, "/api/v1/admin/metrics", nil, &result); err != nil {
-		return nil, err
-	}
-	return result, nil
+func (c *APIClient) AdminGetMetrics() (*Metrics, error) {
+	return c.API.GetAdminMetrics(context.Background())
 }
 
 // AdminSignalSSE sends a signal to connected SSE clients (admin only)
 func (c *APIClient) AdminSignalSSE(eventType string, payload map[string]interface{}) (string, error) {
-	req := map[string]interface{}{
-		"event_type": eventType,
-		"payload":    payload,
+	resp, err := c.API.PostAdminSSEBroadcast(context.Background(), &apiclient.SSEBroadcastRequest{
+		Type:    eventType,
+		Payload: payload,
+	})
+	if err != nil {
+		return "", err
 	}
-	return c.doAction(http.MethodPost, "/api/v1/admin/sse/broadcast", req)
-}
-
-// ScenarioCapability represents a simulation capability
-type ScenarioCapability struct {
-	ID          string   `json:"id"`
-	Description string   `json:"description"`
-	Params      []string `json:"params"`
-}
-
-// ScenarioDefinition represents a predefined scenario
-type ScenarioDefinition struct {
-	ID          string   `json:"id"`
-	Description string   `json:"description"`
-	Steps       []string `json:"steps"`
-}
-
-// ScenarioRunResult represents the result of a scenario run
-type ScenarioRunResult struct {
-	ScenarioID string `json:"scenario_id"`
-	Status     string `json:"status"`
-	Logs       string `json:"logs"`
+	return resp.Message, nil
 }
 
 // AdminGetScenarioCapabilities retrieves available simulation capabilities (admin only)
 func (c *APIClient) AdminGetScenarioCapabilities() ([]ScenarioCapability, error) {
-	var result []ScenarioCapability
-	if err := c.doRequestAndParse(http.MethodGet, "/api/v1/admin/simulate/capabilities", nil, &result); err != nil {
+	resp, err := c.API.GetAdminSimulateCapabilities(context.Background())
+	if err != nil {
 		return nil, err
 	}
-	return result, nil
+	return resp.Capabilities, nil
 }
 
 // AdminGetScenarios retrieves available scenarios (admin only)
-func (c *APIClient) AdminGetScenarios() ([]ScenarioDefinition, error) {
-	var result []ScenarioDefinition
-	if err := c.doRequestAndParse(http.MethodGet, "/api/v1/admin/simulate/scenarios", nil, &result); err != nil {
+func (c *APIClient) AdminGetScenarios() ([]ScenarioSummary, error) {
+	resp, err := c.API.GetAdminSimulateScenarios(context.Background())
+	if err != nil {
 		return nil, err
 	}
-	return result, nil
+	return resp.Scenarios, nil
 }
 
 // AdminRunScenario runs a simulation scenario (admin only)
-func (c *APIClient) AdminRunScenario(scenarioID string, params map[string]string) (*ScenarioRunResult, error) {
-	req := map[string]interface{}{
-		"scenario_id": scenarioID,
-		"parameters":  params,
-	}
-	var result ScenarioRunResult
-	if err := c.doRequestAndParse(http.MethodPost, "/api/v1/admin/simulate/run", req, &result); err != nil {
-		return nil, err
-	}
-	return &result, nil
+func (c *APIClient) AdminRunScenario(scenarioID string, params map[string]interface{}) (*ScenarioRunResult, error) {
+	return c.API.PostAdminSimulateRun(context.Background(), &apiclient.RunScenarioRequest{
+		ScenarioID: scenarioID,
+		Parameters: params,
+	})
 }
 
 // AdminRunCustomScenario runs a custom simulation scenario (admin only)
-func (c *APIClient) AdminRunCustomScenario(name, steps string) (*ScenarioRunResult, error) {
-	req := map[string]interface{}{
-		"name":  name,
-		"steps": steps,
-	}
-	var result ScenarioRunResult
-	if err := c.doRequestAndParse(http.MethodPost, "/api/v1/admin/simulate/run-custom", req, &result); err != nil {
-		return nil, err
-	}
-	return &result, nil
+func (c *APIClient) AdminRunCustomScenario(scenario *Scenario, params map[string]interface{}) (*ScenarioRunResult, error) {
+	return c.API.PostAdminSimulateRunCustom(context.Background(), &apiclient.RunCustomScenarioRequest{
+		Scenario:   scenario,
+		Parameters: params,
+	})
 }
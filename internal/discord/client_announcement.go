@@ -0,0 +1,13 @@
+package discord
+
+import "context"
+
+// GetDiscordAnnouncementEvents lists the event types announcement routes post
+// to Discord channels
+func (c *APIClient) GetDiscordAnnouncementEvents() ([]string, error) {
+	resp, err := c.API.GetAnnouncementsDiscordEvents(context.Background())
+	if err != nil {
+		return nil, err
+	}
+	return resp.EventTypes, nil
+}
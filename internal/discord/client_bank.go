@@ -0,0 +1,53 @@
+package discord
+
+import (
+	"context"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/pkg/apiclient"
+)
+
+// BankAccount is a user's savings in the bank
+type BankAccount = apiclient.BankAccount
+
+// BankTransaction is the outcome of a bank deposit or withdrawal
+type BankTransaction = apiclient.BankTransaction
+
+// GetBankAccount returns a Discord user's bank account
+func (c *APIClient) GetBankAccount(discordID string) (*BankAccount, error) {
+	return c.API.GetUserBank(context.Background(), apiclient.GetUserBankParams{
+		Platform:   domain.PlatformDiscord,
+		PlatformID: discordID,
+	})
+}
+
+// BankDeposit moves money from a Discord user's inventory into the bank
+func (c *APIClient) BankDeposit(discordID, username string, amount int) (*BankTransaction, error) {
+	return c.API.PostUserBankDeposit(context.Background(), &apiclient.BankTransferRequest{
+		Platform:   domain.PlatformDiscord,
+		PlatformID: discordID,
+		Username:   username,
+		Amount:     amount,
+	})
+}
+
+// BankWithdraw moves money from a Discord user's bank account back into
+// their inventory
+func (c *APIClient) BankWithdraw(discordID, username string, amount int) (*BankTransaction, error) {
+	return c.API.PostUserBankWithdraw(context.Background(), &apiclient.BankTransferRequest{
+		Platform:   domain.PlatformDiscord,
+		PlatformID: discordID,
+		Username:   username,
+		Amount:     amount,
+	})
+}
+
+// SetBankGoal sets or, with a goal of 0, clears a Discord user's savings goal
+func (c *APIClient) SetBankGoal(discordID, username string, goal int) (*BankAccount, error) {
+	return c.API.PutUserBankGoal(context.Background(), &apiclient.BankGoalRequest{
+		Platform:   domain.PlatformDiscord,
+		PlatformID: discordID,
+		Username:   username,
+		Goal:       goal,
+	})
+}
@@ -0,0 +1,30 @@
+package discord
+
+import (
+	"context"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/pkg/apiclient"
+)
+
+// BossAttackResult is the outcome of an attack on the community boss
+type BossAttackResult = apiclient.BossAttackResult
+
+// BossStatus is the community's current boss and its top attackers
+type BossStatus = apiclient.BossStatus
+
+// AttackBoss attacks the community boss for a Discord user, using up weapon
+// when one is given
+func (c *APIClient) AttackBoss(discordID, username, weapon string) (*BossAttackResult, error) {
+	return c.API.PostBossAttack(context.Background(), &apiclient.BossAttackRequest{
+		Platform:   domain.PlatformDiscord,
+		PlatformID: discordID,
+		Username:   username,
+		Weapon:     weapon,
+	})
+}
+
+// GetBoss reports the community boss
+func (c *APIClient) GetBoss() (*BossStatus, error) {
+	return c.API.GetBoss(context.Background())
+}
@@ -0,0 +1,40 @@
+package discord
+
+import (
+	"context"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/pkg/apiclient"
+)
+
+// CelebrationGuildSetting is a guild's celebration announcement setting
+type CelebrationGuildSetting = apiclient.CelebrationGuildResponse
+
+// SetBirthday registers a Discord user's birthday (month and day only)
+func (c *APIClient) SetBirthday(discordID, username string, month, day int) (string, error) {
+	return messageOf(c.API.PostCelebrationsBirthday(context.Background(), &apiclient.SetBirthdayRequest{
+		Platform:   domain.PlatformDiscord,
+		PlatformID: discordID,
+		Username:   username,
+		Month:      month,
+		Day:        day,
+	}))
+}
+
+// ClearBirthday removes a Discord user's birthday
+func (c *APIClient) ClearBirthday(discordID string) (string, error) {
+	return messageOf(c.API.DeleteCelebrationsBirthday(context.Background(), apiclient.DeleteCelebrationsBirthdayParams{
+		Platform:   domain.PlatformDiscord,
+		PlatformID: discordID,
+	}))
+}
+
+// GetCelebrationGuild reports whether a guild has celebration announcements enabled
+func (c *APIClient) GetCelebrationGuild(guildID string) (*CelebrationGuildSetting, error) {
+	return c.API.GetCelebrationsGuildsByGuildID(context.Background(), guildID)
+}
+
+// SetCelebrationGuild turns celebration announcements on or off for a guild
+func (c *APIClient) SetCelebrationGuild(guildID string, enabled bool) (*CelebrationGuildSetting, error) {
+	return c.API.PutCelebrationsGuildsByGuildID(context.Background(), guildID, &apiclient.SetCelebrationGuildRequest{Enabled: enabled})
+}
@@ -0,0 +1,28 @@
+package discord
+
+import (
+	"context"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/pkg/apiclient"
+)
+
+// DigResult is the outcome of a dig at the community dig site
+type DigResult = apiclient.DigResult
+
+// DigSiteStatus is the community dig site's depth, levels and top diggers
+type DigSiteStatus = apiclient.DigSiteStatus
+
+// Dig digs the community dig site deeper for a Discord user
+func (c *APIClient) Dig(discordID, username string) (*DigResult, error) {
+	return c.API.PostDiggingDig(context.Background(), &apiclient.DigRequest{
+		Platform:   domain.PlatformDiscord,
+		PlatformID: discordID,
+		Username:   username,
+	})
+}
+
+// GetDigSite reports the community dig site
+func (c *APIClient) GetDigSite() (*DigSiteStatus, error) {
+	return c.API.GetDiggingSite(context.Background())
+}
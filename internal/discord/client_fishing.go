@@ -0,0 +1,35 @@
+package discord
+
+import (
+	"context"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/pkg/apiclient"
+)
+
+// FishingCast is a user's line in the water
+type FishingCast = apiclient.FishingCast
+
+// ReelResult is the outcome of reeling in a fishing line
+type ReelResult = apiclient.ReelResult
+
+// Cast casts a fishing line for a Discord user. The bite is announced in
+// channelID.
+func (c *APIClient) Cast(discordID, username, tackle, channelID string) (*FishingCast, error) {
+	return c.API.PostFishingCast(context.Background(), &apiclient.CastRequest{
+		Platform:   domain.PlatformDiscord,
+		PlatformID: discordID,
+		Username:   username,
+		Tackle:     tackle,
+		ChannelID:  channelID,
+	})
+}
+
+// Reel reels in a Discord user's fishing line
+func (c *APIClient) Reel(discordID, username string) (*ReelResult, error) {
+	return c.API.PostFishingReel(context.Background(), &apiclient.ReelRequest{
+		Platform:   domain.PlatformDiscord,
+		PlatformID: discordID,
+		Username:   username,
+	})
+}
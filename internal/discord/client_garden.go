@@ -0,0 +1,44 @@
+package discord
+
+import (
+	"context"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/pkg/apiclient"
+)
+
+// Garden is a user's garden plots and how many they can plant
+type Garden = apiclient.Garden
+
+// GardenPlot is a seed growing in a garden plot
+type GardenPlot = apiclient.GardenPlot
+
+// GardenHarvest is the outcome of harvesting the ready garden plots
+type GardenHarvest = apiclient.GardenHarvest
+
+// GetGarden returns a Discord user's garden
+func (c *APIClient) GetGarden(discordID string) (*Garden, error) {
+	return c.API.GetUserGarden(context.Background(), apiclient.GetUserGardenParams{
+		Platform:   domain.PlatformDiscord,
+		PlatformID: discordID,
+	})
+}
+
+// Plant plants a seed in a Discord user's garden
+func (c *APIClient) Plant(discordID, username, seed string) (*GardenPlot, error) {
+	return c.API.PostUserGardenPlant(context.Background(), &apiclient.PlantRequest{
+		Platform:   domain.PlatformDiscord,
+		PlatformID: discordID,
+		Username:   username,
+		Seed:       seed,
+	})
+}
+
+// HarvestGarden harvests a Discord user's ready garden plots
+func (c *APIClient) HarvestGarden(discordID, username string) (*GardenHarvest, error) {
+	return c.API.PostUserGardenHarvest(context.Background(), &apiclient.GardenHarvestRequest{
+		Platform:   domain.PlatformDiscord,
+		PlatformID: discordID,
+		Username:   username,
+	})
+}
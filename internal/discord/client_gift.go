@@ -0,0 +1,52 @@
+package discord
+
+import (
+	"context"
+	"time"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/pkg/apiclient"
+)
+
+// Gift is a wrapped package of items waiting to be delivered
+type Gift = apiclient.Gift
+
+// WrapGift wraps a Discord user's items as a gift for another user. A nil
+// deliverAt delivers the gift straight away; an empty channelID announces the
+// delivery to the recipient by DM.
+func (c *APIClient) WrapGift(discordID, username, recipient, itemName string, quantity int, message string, deliverAt *time.Time, channelID string) (*Gift, error) {
+	req := &apiclient.WrapGiftRequest{
+		Platform:   domain.PlatformDiscord,
+		PlatformID: discordID,
+		Username:   username,
+		Recipient:  recipient,
+		ItemName:   itemName,
+		Quantity:   quantity,
+		Message:    message,
+		ChannelID:  channelID,
+	}
+	if deliverAt != nil {
+		req.DeliverAt = deliverAt.UTC().Format(time.RFC3339)
+	}
+	return c.API.PostUserGifts(context.Background(), req)
+}
+
+// GetSentGifts returns a Discord user's gifts that are waiting to be delivered
+func (c *APIClient) GetSentGifts(discordID string) ([]Gift, error) {
+	resp, err := c.API.GetUserGifts(context.Background(), apiclient.GetUserGiftsParams{
+		Platform:   domain.PlatformDiscord,
+		PlatformID: discordID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Gifts, nil
+}
+
+// CancelGift unwraps a Discord user's undelivered gift and returns the items
+func (c *APIClient) CancelGift(discordID string, giftID int) (*Gift, error) {
+	return c.API.DeleteUserGiftsByID(context.Background(), giftID, apiclient.DeleteUserGiftsByIDParams{
+		Platform:   domain.PlatformDiscord,
+		PlatformID: discordID,
+	})
+}
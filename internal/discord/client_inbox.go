@@ -0,0 +1,39 @@
+package discord
+
+import (
+	"context"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/pkg/apiclient"
+)
+
+// Inbox is a page of a user's notifications with their unread count
+type Inbox = apiclient.Inbox
+
+// InboxMessage is one notification in a user's inbox
+type InboxMessage = apiclient.InboxMessage
+
+// GetInbox returns a Discord user's newest inbox messages, or only the
+// unread ones
+func (c *APIClient) GetInbox(discordID string, unreadOnly bool, limit int) (*Inbox, error) {
+	return c.API.GetUserInbox(context.Background(), apiclient.GetUserInboxParams{
+		Platform:   domain.PlatformDiscord,
+		PlatformID: discordID,
+		Unread:     unreadOnly,
+		Limit:      limit,
+	})
+}
+
+// MarkInboxRead marks a Discord user's inbox messages read, or all of them
+// when ids is empty, and returns how many were marked
+func (c *APIClient) MarkInboxRead(discordID string, ids []int) (int, error) {
+	resp, err := c.API.PostUserInboxRead(context.Background(), &apiclient.MarkInboxReadRequest{
+		Platform:   domain.PlatformDiscord,
+		PlatformID: discordID,
+		Ids:        ids,
+	})
+	if err != nil {
+		return 0, err
+	}
+	return resp.Marked, nil
+}
@@ -0,0 +1,59 @@
+package discord
+
+import (
+	"context"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/pkg/apiclient"
+)
+
+// MarketListing is a user's fixed-price offer in the player shop
+type MarketListing = apiclient.PlayerListing
+
+// MarketPurchase is the outcome of buying from a player shop listing
+type MarketPurchase = apiclient.PlayerShopPurchase
+
+// MarketSearch narrows a search of active player shop listings
+type MarketSearch = apiclient.GetShopPlayerParams
+
+// SearchMarket returns the active player shop listings matching the search
+func (c *APIClient) SearchMarket(search MarketSearch) ([]MarketListing, error) {
+	resp, err := c.API.GetShopPlayer(context.Background(), search)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Listings, nil
+}
+
+// ListOnMarket puts a Discord user's items up for sale in the player shop
+func (c *APIClient) ListOnMarket(discordID, username, itemName string, quantity, unitPrice int) (*MarketListing, error) {
+	return c.API.PostMarketList(context.Background(), &apiclient.ListItemRequest{
+		Platform:   domain.PlatformDiscord,
+		PlatformID: discordID,
+		Username:   username,
+		ItemName:   itemName,
+		Quantity:   quantity,
+		UnitPrice:  unitPrice,
+	})
+}
+
+// BuyFromMarket buys items from a player shop listing for a Discord user
+func (c *APIClient) BuyFromMarket(discordID, username string, listingID, quantity int) (*MarketPurchase, error) {
+	return c.API.PostMarketBuy(context.Background(), &apiclient.MarketBuyRequest{
+		Platform:   domain.PlatformDiscord,
+		PlatformID: discordID,
+		Username:   username,
+		ListingID:  listingID,
+		Quantity:   quantity,
+	})
+}
+
+// CancelMarketListing withdraws a Discord user's listing and returns the
+// unsold items
+func (c *APIClient) CancelMarketListing(discordID string, listingID int) (*MarketListing, error) {
+	return c.API.PostMarketCancel(context.Background(), &apiclient.MarketCancelRequest{
+		Platform:   domain.PlatformDiscord,
+		PlatformID: discordID,
+		ListingID:  listingID,
+	})
+}
@@ -0,0 +1,50 @@
+package discord
+
+import (
+	"context"
+	"time"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/pkg/apiclient"
+)
+
+// Reminder is a pending reminder for a user
+type Reminder = apiclient.Reminder
+
+// CreateReminder asks the API to remind a Discord user. remindAt is only
+// used for cooldowns the API cannot look up itself and may be nil. An empty
+// channelID means the reminder is sent as a DM.
+func (c *APIClient) CreateReminder(discordID, username string, kind domain.ReminderKind, target string, remindAt *time.Time, channelID string) (*Reminder, error) {
+	req := &apiclient.CreateReminderRequest{
+		Platform:   domain.PlatformDiscord,
+		PlatformID: discordID,
+		Username:   username,
+		Kind:       string(kind),
+		Target:     target,
+		ChannelID:  channelID,
+	}
+	if remindAt != nil {
+		req.RemindAt = remindAt.UTC().Format(time.RFC3339)
+	}
+	return c.API.PostUserReminders(context.Background(), req)
+}
+
+// GetReminders lists a Discord user's pending reminders
+func (c *APIClient) GetReminders(discordID string) ([]Reminder, error) {
+	resp, err := c.API.GetUserReminders(context.Background(), apiclient.GetUserRemindersParams{
+		Platform:   domain.PlatformDiscord,
+		PlatformID: discordID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Reminders, nil
+}
+
+// CancelReminder removes one of a Discord user's pending reminders
+func (c *APIClient) CancelReminder(discordID string, id int64) (string, error) {
+	return messageOf(c.API.DeleteUserRemindersByID(context.Background(), int(id), apiclient.DeleteUserRemindersByIDParams{
+		Platform:   domain.PlatformDiscord,
+		PlatformID: discordID,
+	}))
+}
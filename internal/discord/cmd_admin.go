@@ -53,7 +53,7 @@ func AddItemCommand() (*discordgo.ApplicationCommand, CommandHandler) {
 		_, err := client.RegisterUser(targetUser.Username, targetUser.ID)
 		if err != nil {
 			slog.Error("Failed to register user", "error", err)
-			respondFriendlyError(s, i, err.Error())
+			respondAPIError(s, i, err)
 			return
 		}
 
@@ -113,7 +113,7 @@ func RemoveItemCommand() (*discordgo.ApplicationCommand, CommandHandler) {
 		_, err := client.RegisterUser(targetUser.Username, targetUser.ID)
 		if err != nil {
 			slog.Error("Failed to register user", "error", err)
-			respondFriendlyError(s, i, err.Error())
+			respondAPIError(s, i, err)
 			return
 		}
 
@@ -357,7 +357,7 @@ func RecentUsersCommand() (*discordgo.ApplicationCommand, CommandHandler) {
 
 		var sb strings.Builder
 		for _, u := range users {
-			fmt.Fprintf(&sb, "• **%s** (%s) - %s\n", u.Username, u.ID, u.UpdatedAt.Format("15:04:05"))
+			fmt.Fprintf(&sb, "• **%s** (%s) - %s\n", u.Username, u.InternalID, formatAPITime(u.UpdatedAt, "15:04:05"))
 		}
 
 		if sb.Len() == 0 {
@@ -457,11 +457,7 @@ func EventsCommand() (*discordgo.ApplicationCommand, CommandHandler) {
 
 		var sb strings.Builder
 		for _, e := range events {
-			// formatting log line roughly
-			if len(e) > 100 {
-				e = e[:97] + "..."
-			}
-			fmt.Fprintf(&sb, "`%s`\n", e)
+			fmt.Fprintf(&sb, "`%s` **%s** %s\n", formatAPITime(e.CreatedAt, "15:04:05"), e.EventType, e.UserID)
 		}
 
 		if sb.Len() == 0 {
@@ -523,8 +519,8 @@ func ResetStatusCommand() (*discordgo.ApplicationCommand, CommandHandler) {
 		}
 
 		description := fmt.Sprintf("**Last Reset:** %s\n**Next Reset:** %s\n**Records Affected:** %d",
-			status.LastResetTime.Format(time.RFC1123),
-			status.NextResetTime.Format(time.RFC1123),
+			formatAPITime(status.LastResetTime, time.RFC1123),
+			formatAPITime(status.NextResetTime, time.RFC1123),
 			status.RecordsAffected)
 
 		embed := createEmbed("⏱️ Reset Status", description, 0x3498db, FooterAdminAction)
@@ -555,8 +551,21 @@ func MetricsCommand() (*discordgo.ApplicationCommand, CommandHandler) {
 		}
 
 		var sb strings.Builder
-		for k, v := range metrics {
-			fmt.Fprintf(&sb, "**%s**: %v\n", k, v)
+		if m := metrics.HTTP; m != nil {
+			fmt.Fprintf(&sb, "**HTTP**: %.0f in flight, %.1fms avg, %.1fms p95\n", m.InFlight, m.AvgLatencyMs, m.P95LatencyMs)
+		}
+		if m := metrics.Events; m != nil {
+			fmt.Fprintf(&sb, "**Events**: %.0f published, %.0f handler errors\n",
+				sumMetric(m.PublishedTotalByType), sumMetric(m.HandlerErrorsByType))
+		}
+		if m := metrics.SSE; m != nil {
+			fmt.Fprintf(&sb, "**SSE clients**: %d\n", m.ClientCount)
+		}
+		if m := metrics.Business; m != nil {
+			fmt.Fprintf(&sb, "**Items**: %.0f bought, %.0f sold\n", sumMetric(m.ItemsBought), sumMetric(m.ItemsSold))
+		}
+		if sb.Len() == 0 {
+			sb.WriteString("No metrics recorded yet.")
 		}
 
 		embed := createEmbed("📊 System Metrics", sb.String(), 0x3498db, FooterAdminAction)
@@ -615,7 +624,7 @@ func SimulationCommand() (*discordgo.ApplicationCommand, CommandHandler) {
 			}
 			var sb strings.Builder
 			for _, c := range caps {
-				fmt.Fprintf(&sb, "**%s**: %s (Params: %v)\n", c.ID, c.Description, c.Params)
+				fmt.Fprintf(&sb, "**%s** (%s): %s, %d actions\n", c.Name, c.Type, c.Description, len(c.Actions))
 			}
 			sendEmbed(s, i, createEmbed("🧠 Capabilities", sb.String(), 0x9b59b6, FooterAdminAction))
 
@@ -639,7 +648,7 @@ func SimulationCommand() (*discordgo.ApplicationCommand, CommandHandler) {
 				return
 			}
 
-			desc := fmt.Sprintf("**Status**: %s\n\n**Logs**:\n```\n%s\n```", result.Status, result.Logs)
+			desc := formatScenarioRun(result)
 			if len(desc) > 2000 {
 				desc = desc[:1997] + "..."
 			}
@@ -650,3 +659,41 @@ func SimulationCommand() (*discordgo.ApplicationCommand, CommandHandler) {
 
 	return cmd, handler
 }
+
+// sumMetric totals a metric that is broken down by label
+func sumMetric(byLabel map[string]float64) float64 {
+	var total float64
+	for _, v := range byLabel {
+		total += v
+	}
+	return total
+}
+
+// formatScenarioRun summarises a simulation run with a line per step
+func formatScenarioRun(result *ScenarioRunResult) string {
+	status := "✅ Passed"
+	if !result.Success {
+		status = "❌ Failed"
+	}
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "**Status**: %s in %dms\n", status, result.DurationMs)
+	if result.Error != "" {
+		fmt.Fprintf(&sb, "**Error**: %s\n", result.Error)
+	}
+	if len(result.Steps) > 0 {
+		sb.WriteString("\n**Steps**:\n")
+	}
+	for _, step := range result.Steps {
+		mark := "✅"
+		if !step.Success {
+			mark = "❌"
+		}
+		fmt.Fprintf(&sb, "%s %d. %s", mark, step.StepIndex+1, step.StepName)
+		if step.Error != "" {
+			fmt.Fprintf(&sb, ": %s", step.Error)
+		}
+		sb.WriteString("\n")
+	}
+	return sb.String()
+}
@@ -0,0 +1,202 @@
+package discord
+
+import (
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// bankColor is the embed color for the bank
+const bankColor = 0xDAA520
+
+// BankDepositCommand returns the bank deposit command definition and handler
+func BankDepositCommand() (*discordgo.ApplicationCommand, CommandHandler) {
+	cmd := &discordgo.ApplicationCommand{
+		Name:        "bank-deposit",
+		Description: "Put money in the bank to earn interest",
+		Options:     []*discordgo.ApplicationCommandOption{bankAmountOption("Money to deposit")},
+	}
+
+	handler := func(s *discordgo.Session, i *discordgo.InteractionCreate, client *APIClient) {
+		if !deferResponse(s, i) {
+			return
+		}
+
+		user := getInteractionUser(i)
+		if !ensureUserRegistered(s, i, client, user, false) {
+			return
+		}
+
+		result, err := client.BankDeposit(user.ID, user.Username, bankAmount(i))
+		if err != nil {
+			slog.Error("Failed to deposit", "error", err, "user", user.Username)
+			respondAPIError(s, i, err)
+			return
+		}
+
+		description := fmt.Sprintf("**%s** deposited **%d** money.", user.Username, result.Amount)
+		if result.GoalBonus > 0 {
+			description += fmt.Sprintf("\n\n🎉 Savings goal reached! **+%d** bonus", result.GoalBonus)
+		}
+		if result.Account != nil {
+			description += fmt.Sprintf("\n\nBalance: **%d**", result.Account.Balance)
+		}
+		sendEmbed(s, i, createEmbed("🏦 Bank", description, bankColor, ""))
+	}
+
+	return cmd, handler
+}
+
+// BankWithdrawCommand returns the bank withdraw command definition and handler
+func BankWithdrawCommand() (*discordgo.ApplicationCommand, CommandHandler) {
+	cmd := &discordgo.ApplicationCommand{
+		Name:        "bank-withdraw",
+		Description: "Take money out of the bank",
+		Options:     []*discordgo.ApplicationCommandOption{bankAmountOption("Money to withdraw")},
+	}
+
+	handler := func(s *discordgo.Session, i *discordgo.InteractionCreate, client *APIClient) {
+		if !deferResponse(s, i) {
+			return
+		}
+
+		user := getInteractionUser(i)
+		if !ensureUserRegistered(s, i, client, user, false) {
+			return
+		}
+
+		result, err := client.BankWithdraw(user.ID, user.Username, bankAmount(i))
+		if err != nil {
+			slog.Error("Failed to withdraw", "error", err, "user", user.Username)
+			respondAPIError(s, i, err)
+			return
+		}
+
+		description := fmt.Sprintf("**%s** withdrew **%d** money.", user.Username, result.Amount)
+		if result.Account != nil {
+			description += fmt.Sprintf("\n\nBalance: **%d**", result.Account.Balance)
+		}
+		sendEmbed(s, i, createEmbed("🏦 Bank", description, bankColor, ""))
+	}
+
+	return cmd, handler
+}
+
+// BankGoalCommand returns the savings goal command definition and handler
+func BankGoalCommand() (*discordgo.ApplicationCommand, CommandHandler) {
+	cmd := &discordgo.ApplicationCommand{
+		Name:        "bank-goal",
+		Description: "Set a savings goal to earn a bonus when you reach it",
+		Options: []*discordgo.ApplicationCommandOption{
+			{
+				Type:        discordgo.ApplicationCommandOptionInteger,
+				Name:        "goal",
+				Description: "Balance to save toward, or 0 to clear your goal",
+				Required:    true,
+				MinValue:    floatPtr(0),
+			},
+		},
+	}
+
+	handler := func(s *discordgo.Session, i *discordgo.InteractionCreate, client *APIClient) {
+		if !deferResponse(s, i) {
+			return
+		}
+
+		user := getInteractionUser(i)
+		if !ensureUserRegistered(s, i, client, user, false) {
+			return
+		}
+
+		goal := 0
+		for _, opt := range getOptions(i) {
+			if opt.Name == "goal" {
+				goal = int(opt.IntValue())
+			}
+		}
+
+		account, err := client.SetBankGoal(user.ID, user.Username, goal)
+		if err != nil {
+			slog.Error("Failed to set savings goal", "error", err, "user", user.Username)
+			respondAPIError(s, i, err)
+			return
+		}
+
+		description := fmt.Sprintf("**%s** cleared their savings goal.", user.Username)
+		if account.Goal > 0 {
+			description = fmt.Sprintf("**%s** is saving toward **%d**. %d to go!", user.Username, account.Goal, account.Goal-account.Balance)
+		}
+		sendEmbed(s, i, createEmbed("🏦 Savings Goal", description, bankColor, ""))
+	}
+
+	return cmd, handler
+}
+
+// BankStatusCommand returns the bank status command definition and handler
+func BankStatusCommand() (*discordgo.ApplicationCommand, CommandHandler) {
+	cmd := &discordgo.ApplicationCommand{
+		Name:        "bank-status",
+		Description: "See your bank balance and savings goal",
+	}
+
+	handler := func(s *discordgo.Session, i *discordgo.InteractionCreate, client *APIClient) {
+		if !deferResponse(s, i) {
+			return
+		}
+
+		user := getInteractionUser(i)
+		if !ensureUserRegistered(s, i, client, user, false) {
+			return
+		}
+
+		account, err := client.GetBankAccount(user.ID)
+		if err != nil {
+			slog.Error("Failed to get bank account", "error", err, "user", user.Username)
+			respondAPIError(s, i, err)
+			return
+		}
+
+		sendEmbed(s, i, bankEmbed(user.Username, account))
+	}
+
+	return cmd, handler
+}
+
+// bankEmbed renders the balance, the savings goal and what can still be
+// withdrawn today
+func bankEmbed(username string, account *BankAccount) *discordgo.MessageEmbed {
+	lines := []string{
+		fmt.Sprintf("Balance: **%d**", account.Balance),
+		fmt.Sprintf("Interest: %g%% per payout, %d earned so far", account.InterestRate*100, account.InterestEarned),
+	}
+	if account.Goal > 0 {
+		lines = append(lines, fmt.Sprintf("Goal: **%d** (%d%%)", account.Goal, account.Balance*100/account.Goal))
+	}
+	if account.WithdrawLimit > 0 {
+		lines = append(lines, fmt.Sprintf("Can withdraw today: %d of %d", max(account.WithdrawLimit-account.WithdrawnToday, 0), account.WithdrawLimit))
+	}
+	return createEmbed(fmt.Sprintf("🏦 %s's Bank", username), strings.Join(lines, "\n"), bankColor, "")
+}
+
+// bankAmountOption is the required amount of money to move
+func bankAmountOption(description string) *discordgo.ApplicationCommandOption {
+	return &discordgo.ApplicationCommandOption{
+		Type:        discordgo.ApplicationCommandOptionInteger,
+		Name:        "amount",
+		Description: description,
+		Required:    true,
+		MinValue:    floatPtr(1),
+		MaxValue:    10000,
+	}
+}
+
+func bankAmount(i *discordgo.InteractionCreate) int {
+	for _, opt := range getOptions(i) {
+		if opt.Name == "amount" {
+			return int(opt.IntValue())
+		}
+	}
+	return 0
+}
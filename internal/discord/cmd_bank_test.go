@@ -0,0 +1,27 @@
+package discord
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBankEmbed(t *testing.T) {
+	embed := bankEmbed("alice", &BankAccount{
+		Balance:        750,
+		Goal:           1000,
+		InterestRate:   0.01,
+		InterestEarned: 42,
+		WithdrawLimit:  5000,
+		WithdrawnToday: 1000,
+	})
+
+	assert.Equal(t, "🏦 alice's Bank", embed.Title)
+	assert.Equal(t, "Balance: **750**\nInterest: 1% per payout, 42 earned so far\nGoal: **1000** (75%)\nCan withdraw today: 4000 of 5000", embed.Description)
+}
+
+func TestBankEmbed_NoGoalOrLimit(t *testing.T) {
+	embed := bankEmbed("alice", &BankAccount{Balance: 10})
+
+	assert.Equal(t, "Balance: **10**\nInterest: 0% per payout, 0 earned so far", embed.Description)
+}
@@ -0,0 +1,145 @@
+package discord
+
+import (
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// bossColor is the embed color for boss raids
+const bossColor = 0xB22222
+
+// BossCommand returns the boss status command definition and handler
+func BossCommand() (*discordgo.ApplicationCommand, CommandHandler) {
+	cmd := &discordgo.ApplicationCommand{
+		Name:        "boss",
+		Description: "See the community boss and who is hurting it most",
+	}
+
+	handler := func(s *discordgo.Session, i *discordgo.InteractionCreate, client *APIClient) {
+		if !deferResponse(s, i) {
+			return
+		}
+
+		status, err := client.GetBoss()
+		if err != nil {
+			slog.Error("Failed to get boss", "error", err)
+			respondAPIError(s, i, err)
+			return
+		}
+
+		sendEmbed(s, i, bossStatusEmbed(status))
+	}
+
+	return cmd, handler
+}
+
+// AttackCommand returns the boss attack command definition and handler
+func AttackCommand() (*discordgo.ApplicationCommand, CommandHandler) {
+	cmd := &discordgo.ApplicationCommand{
+		Name:        "attack",
+		Description: "Attack the community boss",
+		Options: []*discordgo.ApplicationCommandOption{
+			{
+				Type:        discordgo.ApplicationCommandOptionString,
+				Name:        "weapon",
+				Description: "Weapon to use up for bonus damage",
+				Required:    false,
+				Choices: []*discordgo.ApplicationCommandOptionChoice{
+					{Name: "Grenade (+25)", Value: "item_grenade"},
+					{Name: "Missile (+50)", Value: "weapon_missile"},
+					{Name: "Bomb (+100)", Value: "explosive_bomb"},
+					{Name: "TNT (+250)", Value: "explosive_tnt"},
+					{Name: "Huge Missile (+500)", Value: "weapon_hugemissile"},
+				},
+			},
+		},
+	}
+
+	handler := func(s *discordgo.Session, i *discordgo.InteractionCreate, client *APIClient) {
+		if !deferResponse(s, i) {
+			return
+		}
+
+		user := getInteractionUser(i)
+		if !ensureUserRegistered(s, i, client, user, false) {
+			return
+		}
+
+		weapon := ""
+		for _, opt := range getOptions(i) {
+			if opt.Name == "weapon" {
+				weapon = opt.StringValue()
+			}
+		}
+
+		result, err := client.AttackBoss(user.ID, user.Username, weapon)
+		if err != nil {
+			slog.Error("Failed to attack boss", "error", err, "user", user.Username)
+			respondAPIError(s, i, err)
+			return
+		}
+
+		sendEmbed(s, i, bossAttackEmbed(user.Username, result))
+	}
+
+	return cmd, handler
+}
+
+// bossAttackEmbed renders one attack, and the loot handed out when it was
+// the killing blow
+func bossAttackEmbed(username string, result *BossAttackResult) *discordgo.MessageEmbed {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "**%s** hit **%s** for **%d** damage", username, result.BossName, result.Damage)
+	if result.Weapon != "" {
+		fmt.Fprintf(&sb, " with a **%s**", result.Weapon)
+	}
+	sb.WriteString(".")
+	if !result.Defeated {
+		fmt.Fprintf(&sb, "\n**HP:** %d / %d", result.Hp, result.MaxHp)
+		return createEmbed("⚔️ Boss Raid", sb.String(), bossColor, "")
+	}
+
+	fmt.Fprintf(&sb, "\n\n🏆 **%s** has been defeated!", result.BossName)
+	embed := createEmbed("⚔️ Boss Raid", sb.String(), bossColor, "")
+	if len(result.Loot) > 0 {
+		lines := make([]string, 0, len(result.Loot))
+		for _, share := range result.Loot {
+			lines = append(lines, fmt.Sprintf("**%s**: %d damage, %d lootboxes", share.Username, share.Damage, share.Boxes))
+		}
+		embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{
+			Name:  "Loot",
+			Value: strings.Join(lines, "\n"),
+		})
+	}
+	return embed
+}
+
+// bossStatusEmbed renders the current boss's HP, time left and top attackers
+func bossStatusEmbed(status *BossStatus) *discordgo.MessageEmbed {
+	boss := status.Boss
+	if boss == nil {
+		return createEmbed("⚔️ Boss Raid", "There is no boss to fight right now.", bossColor, "")
+	}
+
+	description := fmt.Sprintf("**%s**\n**HP:** %d / %d\n**Reward:** %d lootboxes", boss.Name, boss.Hp, boss.MaxHp, boss.RewardBoxes)
+	if expiresAt, err := time.Parse(time.RFC3339, boss.ExpiresAt); err == nil {
+		description += fmt.Sprintf("\n**Escapes:** <t:%d:R>", expiresAt.Unix())
+	}
+	embed := createEmbed("⚔️ Boss Raid", description, bossColor, "")
+
+	if len(status.TopAttackers) > 0 {
+		lines := make([]string, 0, len(status.TopAttackers))
+		for rank, attacker := range status.TopAttackers {
+			lines = append(lines, fmt.Sprintf("%d. **%s**: %d damage in %d attacks", rank+1, attacker.Username, attacker.Damage, attacker.Attacks))
+		}
+		embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{
+			Name:  "Top Attackers",
+			Value: strings.Join(lines, "\n"),
+		})
+	}
+	return embed
+}
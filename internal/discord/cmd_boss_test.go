@@ -0,0 +1,62 @@
+package discord
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/osse101/BrandishBot_Go/pkg/apiclient"
+)
+
+func TestBossAttackEmbed(t *testing.T) {
+	t.Run("shows the boss's remaining HP", func(t *testing.T) {
+		embed := bossAttackEmbed("alice", &BossAttackResult{
+			BossName: "Goblin King",
+			Damage:   62,
+			Weapon:   "weapon_missile",
+			Hp:       438,
+			MaxHp:    500,
+		})
+
+		assert.Contains(t, embed.Description, "**alice** hit **Goblin King** for **62** damage with a **weapon_missile**.")
+		assert.Contains(t, embed.Description, "**HP:** 438 / 500")
+		assert.Empty(t, embed.Fields)
+	})
+
+	t.Run("lists the loot on the killing blow", func(t *testing.T) {
+		embed := bossAttackEmbed("alice", &BossAttackResult{
+			BossName: "Goblin King",
+			Damage:   12,
+			Defeated: true,
+			Loot: []apiclient.BossLoot{
+				{Username: "alice", Damage: 300, Boxes: 2},
+				{Username: "bob", Damage: 200, Boxes: 1},
+			},
+		})
+
+		assert.Contains(t, embed.Description, "**Goblin King** has been defeated!")
+		require.Len(t, embed.Fields, 1)
+		assert.Equal(t, "**alice**: 300 damage, 2 lootboxes\n**bob**: 200 damage, 1 lootboxes", embed.Fields[0].Value)
+	})
+}
+
+func TestBossStatusEmbed(t *testing.T) {
+	t.Run("no boss", func(t *testing.T) {
+		embed := bossStatusEmbed(&BossStatus{})
+
+		assert.Equal(t, "There is no boss to fight right now.", embed.Description)
+	})
+
+	t.Run("active boss", func(t *testing.T) {
+		embed := bossStatusEmbed(&BossStatus{
+			Boss:         &apiclient.Boss{Name: "Stone Golem", Hp: 900, MaxHp: 1500, RewardBoxes: 8, ExpiresAt: "2026-01-01T12:00:00Z"},
+			TopAttackers: []apiclient.BossAttacker{{Username: "alice", Damage: 600, Attacks: 7}},
+		})
+
+		assert.Contains(t, embed.Description, "**HP:** 900 / 1500")
+		assert.Contains(t, embed.Description, "**Escapes:** <t:1767268800:R>")
+		require.Len(t, embed.Fields, 1)
+		assert.Equal(t, "1. **alice**: 600 damage in 7 attacks", embed.Fields[0].Value)
+	})
+}
@@ -0,0 +1,138 @@
+package discord
+
+import (
+	"fmt"
+	"log/slog"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// BirthdaySetCommand returns the birthday-set command definition and handler
+func BirthdaySetCommand() (*discordgo.ApplicationCommand, CommandHandler) {
+	cmd := &discordgo.ApplicationCommand{
+		Name:        "birthday-set",
+		Description: "Register your birthday for a yearly reward (month and day only)",
+		Options: []*discordgo.ApplicationCommandOption{
+			{
+				Type:        discordgo.ApplicationCommandOptionInteger,
+				Name:        "month",
+				Description: "Birth month (1-12)",
+				Required:    true,
+				MinValue:    &[]float64{1}[0],
+				MaxValue:    12,
+			},
+			{
+				Type:        discordgo.ApplicationCommandOptionInteger,
+				Name:        "day",
+				Description: "Day of the month (1-31)",
+				Required:    true,
+				MinValue:    &[]float64{1}[0],
+				MaxValue:    31,
+			},
+		},
+	}
+
+	handler := func(s *discordgo.Session, i *discordgo.InteractionCreate, client *APIClient) {
+		if !deferResponse(s, i) {
+			return
+		}
+
+		user := getInteractionUser(i)
+		options := getOptions(i)
+		month := int(options[0].IntValue())
+		day := int(options[1].IntValue())
+
+		if _, err := client.SetBirthday(user.ID, user.Username, month, day); err != nil {
+			slog.Error("Failed to set birthday", "error", err, "user", user.Username)
+			respondAPIError(s, i, err)
+			return
+		}
+
+		embed := createEmbed(
+			"Birthday Saved",
+			fmt.Sprintf("Your birthday is set to **%02d/%02d** (month/day). Only the month and day are stored; use `/birthday-clear` to remove it.", month, day),
+			0xFF69B4,
+			"",
+		)
+		sendEmbed(s, i, embed)
+	}
+
+	return cmd, handler
+}
+
+// BirthdayClearCommand returns the birthday-clear command definition and handler
+func BirthdayClearCommand() (*discordgo.ApplicationCommand, CommandHandler) {
+	cmd := &discordgo.ApplicationCommand{
+		Name:        "birthday-clear",
+		Description: "Remove your registered birthday",
+	}
+
+	handler := func(s *discordgo.Session, i *discordgo.InteractionCreate, client *APIClient) {
+		if !deferResponse(s, i) {
+			return
+		}
+
+		user := getInteractionUser(i)
+
+		if _, err := client.ClearBirthday(user.ID); err != nil {
+			slog.Error("Failed to clear birthday", "error", err, "user", user.Username)
+			respondAPIError(s, i, err)
+			return
+		}
+
+		embed := createEmbed("Birthday Removed", "Your birthday has been removed.", 0x95a5a6, "")
+		sendEmbed(s, i, embed)
+	}
+
+	return cmd, handler
+}
+
+// AdminCelebrationsCommand returns the celebrations command definition and handler (admin only)
+func AdminCelebrationsCommand() (*discordgo.ApplicationCommand, CommandHandler) {
+	cmd := &discordgo.ApplicationCommand{
+		Name:        "celebrations",
+		Description: "[ADMIN] Turn birthday and anniversary announcements on or off for this server",
+		Options: []*discordgo.ApplicationCommandOption{
+			{
+				Type:        discordgo.ApplicationCommandOptionBoolean,
+				Name:        "enabled",
+				Description: "Whether to announce celebrations in this server",
+				Required:    true,
+			},
+		},
+		DefaultMemberPermissions: &[]int64{discordgo.PermissionAdministrator}[0],
+	}
+
+	handler := func(s *discordgo.Session, i *discordgo.InteractionCreate, client *APIClient) {
+		if !deferResponse(s, i) {
+			return
+		}
+
+		if i.GuildID == "" {
+			respondError(s, i, "This command can only be used in a server")
+			return
+		}
+
+		enabled := getOptions(i)[0].BoolValue()
+
+		if _, err := client.SetCelebrationGuild(i.GuildID, enabled); err != nil {
+			slog.Error("Failed to set celebration guild", "error", err, "guild_id", i.GuildID)
+			respondError(s, i, fmt.Sprintf("Failed to update celebrations: %v", err))
+			return
+		}
+
+		state := "disabled"
+		if enabled {
+			state = "enabled"
+		}
+		embed := createEmbed(
+			"Celebrations Updated",
+			fmt.Sprintf("Birthday and anniversary announcements are now **%s** for this server.", state),
+			0x2ecc71,
+			FooterAdminAction,
+		)
+		sendEmbed(s, i, embed)
+	}
+
+	return cmd, handler
+}
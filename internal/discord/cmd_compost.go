@@ -55,17 +55,12 @@ func CompostDepositCommand() (*discordgo.ApplicationCommand, CommandHandler) {
 			quantity = int(options[1].IntValue())
 		}
 
-		items := []map[string]interface{}{
-			{
-				"item_name": itemName,
-				"quantity":  quantity,
-			},
-		}
+		items := []CompostDepositItem{{ItemName: itemName, Quantity: quantity}}
 
 		result, err := client.CompostDeposit(domain.PlatformDiscord, user.ID, items)
 		if err != nil {
 			slog.Error("Failed to deposit into compost", "error", err, "user", user.Username)
-			respondFriendlyError(s, i, err.Error())
+			respondAPIError(s, i, err)
 			return
 		}
 
@@ -104,7 +99,7 @@ func CompostHarvestCommand() (*discordgo.ApplicationCommand, CommandHandler) {
 		result, err := client.CompostHarvest(domain.PlatformDiscord, user.ID, user.Username)
 		if err != nil {
 			slog.Error("Failed to harvest compost", "error", err, "user", user.Username)
-			respondFriendlyError(s, i, err.Error())
+			respondAPIError(s, i, err)
 			return
 		}
 
@@ -159,7 +154,7 @@ func CompostStatusCommand() (*discordgo.ApplicationCommand, CommandHandler) {
 		result, err := client.CompostStatus(domain.PlatformDiscord, user.ID)
 		if err != nil {
 			slog.Error("Failed to check compost status", "error", err, "user", user.Username)
-			respondFriendlyError(s, i, err.Error())
+			respondAPIError(s, i, err)
 			return
 		}
 
@@ -178,9 +173,9 @@ func CompostStatusCommand() (*discordgo.ApplicationCommand, CommandHandler) {
 				description += fmt.Sprintf("**Time Remaining:** %s", status.TimeLeft)
 			}
 
-			if status.Status == domain.CompostBinStatusComposting {
+			if domain.CompostBinStatus(status.Status) == domain.CompostBinStatusComposting {
 				color = 0x3498db
-			} else if status.Status == domain.CompostBinStatusReady {
+			} else if domain.CompostBinStatus(status.Status) == domain.CompostBinStatusReady {
 				color = 0x2ecc71
 				description += "\n\n**Ready to Harvest!**"
 			}
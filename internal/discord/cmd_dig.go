@@ -0,0 +1,148 @@
+package discord
+
+import (
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+const (
+	// digAgainCustomID is the custom ID of the "Dig again" button under a dig
+	digAgainCustomID = "dig_again"
+
+	// digColor is the embed color for the dig site
+	digColor = 0x8B5A2B
+)
+
+// DigCommand returns the dig command definition and handler. When
+// channelID is set, digging only happens in that channel.
+func DigCommand(channelID string) (*discordgo.ApplicationCommand, CommandHandler) {
+	cmd := &discordgo.ApplicationCommand{
+		Name:        "dig",
+		Description: "Dig the community dig site deeper",
+	}
+
+	handler := func(s *discordgo.Session, i *discordgo.InteractionCreate, client *APIClient) {
+		runDig(s, i, client, channelID)
+	}
+
+	return cmd, handler
+}
+
+// DigSiteCommand returns the dig site status command definition and handler
+func DigSiteCommand() (*discordgo.ApplicationCommand, CommandHandler) {
+	cmd := &discordgo.ApplicationCommand{
+		Name:        "digsite",
+		Description: "See how deep the community dig site is and who dug it",
+	}
+
+	handler := func(s *discordgo.Session, i *discordgo.InteractionCreate, client *APIClient) {
+		if !deferResponse(s, i) {
+			return
+		}
+
+		status, err := client.GetDigSite()
+		if err != nil {
+			slog.Error("Failed to get dig site", "error", err)
+			respondAPIError(s, i, err)
+			return
+		}
+
+		sendEmbed(s, i, digSiteEmbed(status))
+	}
+
+	return cmd, handler
+}
+
+// runDig digs for the interaction's user and replies with the result and a
+// "Dig again" button. It serves both /dig and the button.
+func runDig(s *discordgo.Session, i *discordgo.InteractionCreate, client *APIClient, channelID string) {
+	if channelID != "" && i.ChannelID != channelID {
+		respondEphemeral(s, i, fmt.Sprintf("⛏️ Digging happens in <#%s>.", channelID))
+		return
+	}
+	if !deferResponse(s, i) {
+		return
+	}
+
+	user := getInteractionUser(i)
+	if !ensureUserRegistered(s, i, client, user, false) {
+		return
+	}
+
+	result, err := client.Dig(user.ID, user.Username)
+	if err != nil {
+		slog.Error("Failed to dig", "error", err, "user", user.Username)
+		respondAPIError(s, i, err)
+		return
+	}
+
+	if _, err := s.InteractionResponseEdit(i.Interaction, &discordgo.WebhookEdit{
+		Embeds: &[]*discordgo.MessageEmbed{digEmbed(user.Username, result)},
+		Components: &[]discordgo.MessageComponent{
+			discordgo.ActionsRow{
+				Components: []discordgo.MessageComponent{
+					discordgo.Button{
+						Label:    "Dig again",
+						Style:    discordgo.PrimaryButton,
+						CustomID: digAgainCustomID,
+						Emoji:    &discordgo.ComponentEmoji{Name: "⛏️"},
+					},
+				},
+			},
+		},
+	}); err != nil {
+		slog.Error("Failed to send dig response", "error", err)
+	}
+}
+
+// digEmbed renders one dig: how far it went, where the site is now and any
+// levels it reached first
+func digEmbed(username string, result *DigResult) *discordgo.MessageEmbed {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "**%s** dug **%dm**. The site is now **%dm** deep", username, result.Meters, result.Depth)
+	if result.Level != nil {
+		fmt.Fprintf(&sb, " in the **%s** layer", result.Level.Name)
+	}
+	sb.WriteString(".")
+	if result.NextLevel != nil {
+		fmt.Fprintf(&sb, "\n**%s** starts at %dm (%dm to go).", result.NextLevel.Name, result.NextLevel.MinDepth, result.NextLevel.MinDepth-result.Depth)
+	}
+	for _, level := range result.Milestones {
+		fmt.Fprintf(&sb, "\n\n🎉 Broke through into **%s**!", level.Name)
+	}
+	if result.Contribution > 0 {
+		fmt.Fprintf(&sb, " The community earned **%d** contribution toward the current unlock.", result.Contribution)
+	}
+
+	return createEmbed("⛏️ Dig Site", sb.String(), digColor, "")
+}
+
+// digSiteEmbed renders the dig site's depth, next level and top diggers
+func digSiteEmbed(status *DigSiteStatus) *discordgo.MessageEmbed {
+	description := fmt.Sprintf("**Depth:** %dm\n**Digs:** %d", status.Depth, status.TotalDigs)
+	if status.Level != nil {
+		description += fmt.Sprintf("\n**Layer:** %s", status.Level.Name)
+	}
+	embed := createEmbed("⛏️ Community Dig Site", description, digColor, "")
+
+	if status.NextLevel != nil {
+		embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{
+			Name:  "Next Layer",
+			Value: fmt.Sprintf("**%s** at %dm (%dm to go, +%d contribution)", status.NextLevel.Name, status.NextLevel.MinDepth, status.NextLevel.MinDepth-status.Depth, status.NextLevel.Contribution),
+		})
+	}
+	if len(status.TopDiggers) > 0 {
+		var lines []string
+		for rank, digger := range status.TopDiggers {
+			lines = append(lines, fmt.Sprintf("%d. **%s**: %dm in %d digs", rank+1, digger.Username, digger.Meters, digger.Digs))
+		}
+		embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{
+			Name:  "Top Diggers",
+			Value: strings.Join(lines, "\n"),
+		})
+	}
+	return embed
+}
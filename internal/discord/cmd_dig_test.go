@@ -0,0 +1,53 @@
+package discord
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/osse101/BrandishBot_Go/pkg/apiclient"
+)
+
+func TestDigEmbed(t *testing.T) {
+	t.Run("shows the way to the next layer", func(t *testing.T) {
+		embed := digEmbed("alice", &DigResult{
+			Meters:    3,
+			Depth:     40,
+			Level:     &apiclient.DigLevel{Name: "Topsoil"},
+			NextLevel: &apiclient.DigLevel{Name: "Clay", MinDepth: 50},
+		})
+
+		assert.Contains(t, embed.Description, "**alice** dug **3m**. The site is now **40m** deep in the **Topsoil** layer.")
+		assert.Contains(t, embed.Description, "**Clay** starts at 50m (10m to go).")
+		assert.NotContains(t, embed.Description, "Broke through")
+	})
+
+	t.Run("celebrates a milestone", func(t *testing.T) {
+		embed := digEmbed("alice", &DigResult{
+			Meters:       4,
+			Depth:        52,
+			Level:        &apiclient.DigLevel{Name: "Clay"},
+			Milestones:   []apiclient.DigLevel{{Name: "Clay", Contribution: 10}},
+			Contribution: 10,
+		})
+
+		assert.Contains(t, embed.Description, "Broke through into **Clay**!")
+		assert.Contains(t, embed.Description, "earned **10** contribution")
+	})
+}
+
+func TestDigSiteEmbed(t *testing.T) {
+	embed := digSiteEmbed(&DigSiteStatus{
+		Depth:      320,
+		TotalDigs:  90,
+		Level:      &apiclient.DigLevel{Name: "Bedrock"},
+		NextLevel:  &apiclient.DigLevel{Name: "Caverns", MinDepth: 500, Contribution: 50},
+		TopDiggers: []apiclient.Digger{{Username: "alice", Meters: 120, Digs: 40}},
+	})
+
+	assert.Contains(t, embed.Description, "**Layer:** Bedrock")
+	require.Len(t, embed.Fields, 2)
+	assert.Equal(t, "**Caverns** at 500m (180m to go, +50 contribution)", embed.Fields[0].Value)
+	assert.Equal(t, "1. **alice**: 120m in 40 digs", embed.Fields[1].Value)
+}
@@ -130,13 +130,13 @@ func GiveCommand() (*discordgo.ApplicationCommand, CommandHandler) {
 		}
 
 		msg, err := client.GiveItem(
-			domain.PlatformDiscord, fromUser.ID,
-			domain.PlatformDiscord, toUser.ID, toUser.Username,
+			domain.PlatformDiscord, fromUser.ID, fromUser.Username,
+			domain.PlatformDiscord, toUser.Username,
 			itemName, quantity,
 		)
 		if err != nil {
 			slog.Error("Failed to give item", "error", err)
-			respondFriendlyError(s, i, err.Error())
+			respondAPIError(s, i, err)
 			return
 		}
 
@@ -20,11 +20,9 @@ func TestPricesCommand_Buy(t *testing.T) {
 	// Mock Backend Response
 	ctx.Mux.HandleFunc("/api/v1/prices/buy", func(w http.ResponseWriter, r *http.Request) {
 		assert.Equal(t, http.MethodGet, r.Method)
-		WriteJSON(w, map[string]interface{}{
-			"items": []domain.Item{
-				{InternalName: "health_potion", BaseValue: 50},
-				{InternalName: "iron_sword", BaseValue: 200},
-			},
+		WriteJSON(w, []domain.Item{
+			{InternalName: "health_potion", BaseValue: 50},
+			{InternalName: "iron_sword", BaseValue: 200},
 		})
 	})
 
@@ -79,10 +77,8 @@ func TestPricesCommand_Sell(t *testing.T) {
 	// Mock Backend Response
 	ctx.Mux.HandleFunc("/api/v1/prices", func(w http.ResponseWriter, r *http.Request) {
 		assert.Equal(t, http.MethodGet, r.Method)
-		WriteJSON(w, map[string]interface{}{
-			"items": []domain.Item{
-				{InternalName: "gold_nugget", BaseValue: 100},
-			},
+		WriteJSON(w, []domain.Item{
+			{InternalName: "gold_nugget", BaseValue: 100},
 		})
 	})
 
@@ -44,8 +44,8 @@ func ExploreCommand() (*discordgo.ApplicationCommand, CommandHandler) {
 		// If on cooldown, show time remaining
 		if status.OnCooldown && !status.HasActive {
 			description := "An expedition was completed recently. Please wait before starting a new one."
-			if status.CooldownExpires != nil {
-				description += fmt.Sprintf("\n\nCooldown expires: `%s`", *status.CooldownExpires)
+			if status.CooldownExpires != "" {
+				description += fmt.Sprintf("\n\nCooldown expires: `%s`", status.CooldownExpires)
 			}
 			embed := createEmbed("Expedition Cooldown", description, 0x95A5A6, "")
 			sendEmbed(s, i, embed)
@@ -53,16 +53,16 @@ func ExploreCommand() (*discordgo.ApplicationCommand, CommandHandler) {
 		}
 
 		// If there's an active expedition
-		if status.HasActive && status.ActiveDetails != nil {
-			details := status.ActiveDetails
+		if status.HasActive && status.ActiveDetails != nil && status.ActiveDetails.Expedition != nil {
+			details := status.ActiveDetails.Expedition
 
-			switch details.State {
-			case string(domain.ExpeditionStateRecruiting):
+			switch domain.ExpeditionState(details.State) {
+			case domain.ExpeditionStateRecruiting:
 				// Try to join the expedition
 				msg, err := client.JoinExpedition(domain.PlatformDiscord, user.ID, user.Username, details.ID)
 				if err != nil {
 					// If join fails (already joined, etc.), show status
-					respondFriendlyError(s, i, err.Error())
+					respondAPIError(s, i, err)
 					return
 				}
 
@@ -71,7 +71,7 @@ func ExploreCommand() (*discordgo.ApplicationCommand, CommandHandler) {
 				sendEmbed(s, i, embed)
 				return
 
-			case string(domain.ExpeditionStateInProgress):
+			case domain.ExpeditionStateInProgress:
 				embed := createEmbed("Expedition In Progress", "An expedition is currently underway! Watch the updates in the notification channel.", 0xf39c12, "")
 				sendEmbed(s, i, embed)
 				return
@@ -87,7 +87,7 @@ func ExploreCommand() (*discordgo.ApplicationCommand, CommandHandler) {
 		expeditionID, joinDeadline, err := client.StartExpedition(domain.PlatformDiscord, user.ID, user.Username, domain.ExpeditionTypeStandard)
 		if err != nil {
 			slog.Error("Failed to start expedition", "error", err)
-			respondFriendlyError(s, i, err.Error())
+			respondAPIError(s, i, err)
 			return
 		}
 
@@ -134,7 +134,7 @@ func ExpeditionJournalCommand() (*discordgo.ApplicationCommand, CommandHandler)
 		entries, err := client.GetExpeditionJournal(expeditionID)
 		if err != nil {
 			slog.Error("Failed to get expedition journal", "error", err)
-			respondFriendlyError(s, i, err.Error())
+			respondAPIError(s, i, err)
 			return
 		}
 
@@ -0,0 +1,135 @@
+package discord
+
+import (
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/bwmarrin/discordgo"
+
+	"github.com/osse101/BrandishBot_Go/pkg/apiclient"
+)
+
+const (
+	// fishReelPrefix prefixes the custom ID of the "Reel in" button:
+	// fish_reel:<discord user ID>
+	fishReelPrefix = "fish_reel:"
+
+	// fishColor is the embed color for fishing
+	fishColor = 0x1E90FF
+)
+
+// FishCommand returns the fish command definition and handler
+func FishCommand() (*discordgo.ApplicationCommand, CommandHandler) {
+	cmd := &discordgo.ApplicationCommand{
+		Name:        "fish",
+		Description: "Cast a fishing line and reel in when a fish bites",
+		Options: []*discordgo.ApplicationCommandOption{
+			{
+				Type:        discordgo.ApplicationCommandOptionString,
+				Name:        "tackle",
+				Description: "Tackle to use up for a better catch",
+				Required:    false,
+				Choices: []*discordgo.ApplicationCommandOptionChoice{
+					{Name: "Worm (rarer fish)", Value: "tackle_worm"},
+					{Name: "Lure (much rarer fish)", Value: "tackle_lure"},
+					{Name: "Bobber (longer bite)", Value: "tackle_bobber"},
+				},
+			},
+		},
+	}
+
+	handler := func(s *discordgo.Session, i *discordgo.InteractionCreate, client *APIClient) {
+		if !deferResponse(s, i) {
+			return
+		}
+
+		user := getInteractionUser(i)
+		if !ensureUserRegistered(s, i, client, user, false) {
+			return
+		}
+
+		tackle := ""
+		for _, opt := range getOptions(i) {
+			if opt.Name == "tackle" {
+				tackle = opt.StringValue()
+			}
+		}
+
+		cast, err := client.Cast(user.ID, user.Username, tackle, i.ChannelID)
+		if err != nil {
+			slog.Error("Failed to cast", "error", err, "user", user.Username)
+			respondAPIError(s, i, err)
+			return
+		}
+
+		description := fmt.Sprintf("**%s** cast a line. Wait for the bite, then reel in!", user.Username)
+		if cast.Tackle != "" {
+			description += fmt.Sprintf("\nUsing a **%s**.", strings.TrimPrefix(cast.Tackle, "tackle_"))
+		}
+		if _, err := s.InteractionResponseEdit(i.Interaction, &discordgo.WebhookEdit{
+			Embeds:     &[]*discordgo.MessageEmbed{createEmbed("🎣 Fishing", description, fishColor, "")},
+			Components: &[]discordgo.MessageComponent{fishReelButton(user.ID)},
+		}); err != nil {
+			slog.Error("Failed to send cast response", "error", err)
+		}
+	}
+
+	return cmd, handler
+}
+
+// fishReelButton is the "Reel in" button for the Discord user's line
+func fishReelButton(discordID string) discordgo.ActionsRow {
+	return discordgo.ActionsRow{
+		Components: []discordgo.MessageComponent{
+			discordgo.Button{
+				Label:    "Reel in!",
+				Style:    discordgo.SuccessButton,
+				CustomID: fishReelPrefix + discordID,
+				Emoji:    &discordgo.ComponentEmoji{Name: "🎣"},
+			},
+		},
+	}
+}
+
+// handleFishReel reels in the line of the user a "Reel in" button belongs
+// to. Only that user can press it.
+func handleFishReel(s *discordgo.Session, i *discordgo.InteractionCreate, client *APIClient, customID string) {
+	user := getInteractionUser(i)
+	if strings.TrimPrefix(customID, fishReelPrefix) != user.ID {
+		respondEphemeral(s, i, "❌ That's not your line!")
+		return
+	}
+	if !deferResponse(s, i) {
+		return
+	}
+
+	result, err := client.Reel(user.ID, user.Username)
+	if err != nil {
+		slog.Error("Failed to reel in", "error", err, "user", user.Username)
+		respondAPIError(s, i, err)
+		return
+	}
+
+	sendEmbed(s, i, reelEmbed(user.Username, result))
+}
+
+// reelEmbed renders the outcome of a reel
+func reelEmbed(username string, result *ReelResult) *discordgo.MessageEmbed {
+	var description string
+	switch result.Outcome {
+	case apiclient.FishingOutcomeCaught:
+		catch := result.Catch
+		name := catch.PublicName
+		if name == "" {
+			name = catch.ItemName
+		}
+		description = fmt.Sprintf("**%s** reeled in a **%s** (%s) in %.2fs!\n+%d Fisher XP", username, name, catch.Quality, float64(result.ReactionMs)/1000, catch.XP)
+	case apiclient.FishingOutcomeTooEarly:
+		description = fmt.Sprintf("**%s** reeled in too early and scared the fish away.", username)
+	default:
+		description = fmt.Sprintf("**%s** was too slow. The fish got away!", username)
+	}
+
+	return createEmbed("🎣 Fishing", description, fishColor, "")
+}
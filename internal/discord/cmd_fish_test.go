@@ -0,0 +1,42 @@
+package discord
+
+import (
+	"testing"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/osse101/BrandishBot_Go/pkg/apiclient"
+)
+
+func TestReelEmbed(t *testing.T) {
+	t.Run("shows the catch", func(t *testing.T) {
+		embed := reelEmbed("alice", &ReelResult{
+			Outcome:    apiclient.FishingOutcomeCaught,
+			ReactionMs: 1250,
+			Catch:      &apiclient.FishCatch{ItemName: "fish_salmon", PublicName: "salmon", Quality: "RARE", XP: 40},
+		})
+
+		assert.Equal(t, "**alice** reeled in a **salmon** (RARE) in 1.25s!\n+40 Fisher XP", embed.Description)
+	})
+
+	t.Run("too early", func(t *testing.T) {
+		embed := reelEmbed("alice", &ReelResult{Outcome: apiclient.FishingOutcomeTooEarly})
+
+		assert.Contains(t, embed.Description, "too early")
+	})
+
+	t.Run("got away", func(t *testing.T) {
+		embed := reelEmbed("alice", &ReelResult{Outcome: apiclient.FishingOutcomeGotAway})
+
+		assert.Contains(t, embed.Description, "got away")
+	})
+}
+
+func TestFishReelButton(t *testing.T) {
+	row := fishReelButton("12345")
+
+	button, ok := row.Components[0].(discordgo.Button)
+	assert.True(t, ok)
+	assert.Equal(t, "fish_reel:12345", button.CustomID)
+}
@@ -65,14 +65,14 @@ func GambleStartCommand() (*discordgo.ApplicationCommand, CommandHandler) {
 			return
 		}
 
-		gambleID, err := client.StartGamble(domain.PlatformDiscord, user.ID, user.Username, itemName, quantity)
+		gambleRef, err := client.StartGamble(domain.PlatformDiscord, user.ID, user.Username, itemName, quantity)
 		if err != nil {
 			slog.Error("Failed to start gamble", "error", err)
-			respondFriendlyError(s, i, err.Error())
+			respondAPIError(s, i, err)
 			return
 		}
 
-		description := fmt.Sprintf("**Gamble ID:** `%s`\n\nOthers can join using `/gamble-join %s`\n\nThe gamble will execute shortly after the join deadline.", gambleID, gambleID)
+		description := fmt.Sprintf("**Gamble:** `%s`\n\nOthers can join using `/gamble-join`\n\nThe gamble will execute shortly after the join deadline.", gambleRef)
 		embed := createEmbed("🎲 Gamble Started!", description, 0xe74c3c, "")
 		sendEmbed(s, i, embed)
 	}
@@ -105,7 +105,7 @@ func GambleJoinCommand() (*discordgo.ApplicationCommand, CommandHandler) {
 		msg, err := client.JoinGamble(domain.PlatformDiscord, user.ID, user.Username)
 		if err != nil {
 			slog.Error("Failed to join gamble", "error", err)
-			respondFriendlyError(s, i, err.Error())
+			respondAPIError(s, i, err)
 			return
 		}
 
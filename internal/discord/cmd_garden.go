@@ -0,0 +1,173 @@
+package discord
+
+import (
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// gardenColor is the embed color for the garden
+const gardenColor = 0x2E8B57
+
+// GardenPlantCommand returns the garden plant command definition and handler
+func GardenPlantCommand() (*discordgo.ApplicationCommand, CommandHandler) {
+	cmd := &discordgo.ApplicationCommand{
+		Name:        "garden-plant",
+		Description: "Plant a seed in your garden",
+		Options: []*discordgo.ApplicationCommandOption{
+			{
+				Type:        discordgo.ApplicationCommandOptionString,
+				Name:        "seed",
+				Description: "Seed to plant",
+				Required:    true,
+				Choices: []*discordgo.ApplicationCommandOptionChoice{
+					{Name: "Wheat (30 minutes)", Value: "seed_wheat"},
+					{Name: "Carrot (2 hours)", Value: "seed_carrot"},
+					{Name: "Pumpkin (8 hours)", Value: "seed_pumpkin"},
+					{Name: "Moonflower (24 hours)", Value: "seed_moonflower"},
+				},
+			},
+		},
+	}
+
+	handler := func(s *discordgo.Session, i *discordgo.InteractionCreate, client *APIClient) {
+		if !deferResponse(s, i) {
+			return
+		}
+
+		user := getInteractionUser(i)
+		if !ensureUserRegistered(s, i, client, user, false) {
+			return
+		}
+
+		seed := ""
+		for _, opt := range getOptions(i) {
+			if opt.Name == "seed" {
+				seed = opt.StringValue()
+			}
+		}
+
+		plot, err := client.Plant(user.ID, user.Username, seed)
+		if err != nil {
+			slog.Error("Failed to plant", "error", err, "user", user.Username)
+			respondAPIError(s, i, err)
+			return
+		}
+
+		description := fmt.Sprintf("**%s** planted a seed in plot **%d**. It will grow into **%d %s**", user.Username, plot.Slot, plot.Quantity, cropName(plot.Crop))
+		if readyAt, err := time.Parse(time.RFC3339, plot.ReadyAt); err == nil {
+			description += fmt.Sprintf(" <t:%d:R>", readyAt.Unix())
+		}
+		sendEmbed(s, i, createEmbed("🌱 Garden", description+".", gardenColor, ""))
+	}
+
+	return cmd, handler
+}
+
+// GardenHarvestCommand returns the garden harvest command definition and handler
+func GardenHarvestCommand() (*discordgo.ApplicationCommand, CommandHandler) {
+	cmd := &discordgo.ApplicationCommand{
+		Name:        "garden-harvest",
+		Description: "Harvest every crop in your garden that has grown",
+	}
+
+	handler := func(s *discordgo.Session, i *discordgo.InteractionCreate, client *APIClient) {
+		if !deferResponse(s, i) {
+			return
+		}
+
+		user := getInteractionUser(i)
+		if !ensureUserRegistered(s, i, client, user, false) {
+			return
+		}
+
+		result, err := client.HarvestGarden(user.ID, user.Username)
+		if err != nil {
+			slog.Error("Failed to harvest garden", "error", err, "user", user.Username)
+			respondAPIError(s, i, err)
+			return
+		}
+
+		sendEmbed(s, i, gardenHarvestEmbed(user.Username, result))
+	}
+
+	return cmd, handler
+}
+
+// GardenStatusCommand returns the garden status command definition and handler
+func GardenStatusCommand() (*discordgo.ApplicationCommand, CommandHandler) {
+	cmd := &discordgo.ApplicationCommand{
+		Name:        "garden-status",
+		Description: "See what is growing in your garden",
+	}
+
+	handler := func(s *discordgo.Session, i *discordgo.InteractionCreate, client *APIClient) {
+		if !deferResponse(s, i) {
+			return
+		}
+
+		user := getInteractionUser(i)
+		if !ensureUserRegistered(s, i, client, user, false) {
+			return
+		}
+
+		garden, err := client.GetGarden(user.ID)
+		if err != nil {
+			slog.Error("Failed to get garden", "error", err, "user", user.Username)
+			respondAPIError(s, i, err)
+			return
+		}
+
+		sendEmbed(s, i, gardenEmbed(user.Username, garden))
+	}
+
+	return cmd, handler
+}
+
+// gardenHarvestEmbed renders the crops a harvest put in the inventory
+func gardenHarvestEmbed(username string, result *GardenHarvest) *discordgo.MessageEmbed {
+	lines := make([]string, 0, len(result.Crops))
+	for _, crop := range result.Crops {
+		lines = append(lines, fmt.Sprintf("**%d** %s", crop.Quantity, cropName(crop.ItemName)))
+	}
+	description := fmt.Sprintf("**%s** harvested %d plots:\n%s", username, result.Plots, strings.Join(lines, "\n"))
+	if result.XP > 0 {
+		description += fmt.Sprintf("\n\n+%d Farmer XP", result.XP)
+	}
+	return createEmbed("🌾 Garden Harvest", description, gardenColor, "")
+}
+
+// gardenEmbed renders every plot, planted or free, and when each crop is
+// ready
+func gardenEmbed(username string, garden *Garden) *discordgo.MessageEmbed {
+	plots := make(map[int]GardenPlot, len(garden.Plots))
+	for _, plot := range garden.Plots {
+		plots[plot.Slot] = plot
+	}
+
+	lines := make([]string, 0, garden.Capacity)
+	for slot := 1; slot <= garden.Capacity; slot++ {
+		plot, ok := plots[slot]
+		switch {
+		case !ok:
+			lines = append(lines, fmt.Sprintf("%d. *empty*", slot))
+		case plot.Ready:
+			lines = append(lines, fmt.Sprintf("%d. **%d %s**, ready to harvest", slot, plot.Quantity, cropName(plot.Crop)))
+		default:
+			line := fmt.Sprintf("%d. %d %s", slot, plot.Quantity, cropName(plot.Crop))
+			if readyAt, err := time.Parse(time.RFC3339, plot.ReadyAt); err == nil {
+				line += fmt.Sprintf(", ready <t:%d:R>", readyAt.Unix())
+			}
+			lines = append(lines, line)
+		}
+	}
+	return createEmbed(fmt.Sprintf("🌱 %s's Garden", username), strings.Join(lines, "\n"), gardenColor, "")
+}
+
+// cropName is a crop item's name without its prefix
+func cropName(item string) string {
+	return strings.TrimPrefix(item, "crop_")
+}
@@ -0,0 +1,33 @@
+package discord
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/osse101/BrandishBot_Go/pkg/apiclient"
+)
+
+func TestGardenEmbed(t *testing.T) {
+	embed := gardenEmbed("alice", &Garden{
+		Capacity: 3,
+		Plots: []apiclient.GardenPlot{
+			{Slot: 1, Crop: "crop_wheat", Quantity: 3, Ready: true},
+			{Slot: 3, Crop: "crop_pumpkin", Quantity: 2, ReadyAt: "2026-01-01T12:00:00Z"},
+		},
+	})
+
+	assert.Equal(t, "🌱 alice's Garden", embed.Title)
+	assert.Equal(t, "1. **3 wheat**, ready to harvest\n2. *empty*\n3. 2 pumpkin, ready <t:1767268800:R>", embed.Description)
+}
+
+func TestGardenHarvestEmbed(t *testing.T) {
+	embed := gardenHarvestEmbed("alice", &GardenHarvest{
+		Plots: 2,
+		Crops: []apiclient.GardenCrop{{ItemName: "crop_wheat", Quantity: 6}},
+		XP:    10,
+	})
+
+	assert.Contains(t, embed.Description, "**alice** harvested 2 plots:\n**6** wheat")
+	assert.Contains(t, embed.Description, "+10 Farmer XP")
+}
@@ -0,0 +1,239 @@
+package discord
+
+import (
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+)
+
+// giftColor is the embed color for gifts
+const giftColor = 0xE91E63
+
+// giftMaxDelayHours caps the deliver-in option; the server enforces its own
+// limit as well
+const giftMaxDelayHours = 720
+
+// GiftWrapCommand returns the gift wrap command definition and handler
+func GiftWrapCommand() (*discordgo.ApplicationCommand, CommandHandler) {
+	cmd := &discordgo.ApplicationCommand{
+		Name:        "gift-wrap",
+		Description: "Wrap items as a gift with a note, delivered now or later",
+		Options: []*discordgo.ApplicationCommandOption{
+			{
+				Type:        discordgo.ApplicationCommandOptionUser,
+				Name:        "user",
+				Description: "Who the gift is for",
+				Required:    true,
+			},
+			{
+				Type:         discordgo.ApplicationCommandOptionString,
+				Name:         "item",
+				Description:  "Item to wrap",
+				Required:     true,
+				Autocomplete: true,
+			},
+			{
+				Type:        discordgo.ApplicationCommandOptionInteger,
+				Name:        "quantity",
+				Description: "How many to wrap (default: 1)",
+				Required:    false,
+				MinValue:    floatPtr(1),
+				MaxValue:    domain.MaxTransactionQuantity,
+			},
+			{
+				Type:        discordgo.ApplicationCommandOptionString,
+				Name:        "message",
+				Description: "A note to go with the gift",
+				Required:    false,
+				MaxLength:   200,
+			},
+			{
+				Type:        discordgo.ApplicationCommandOptionInteger,
+				Name:        "deliver-in",
+				Description: "Hours until the gift is delivered (default: now)",
+				Required:    false,
+				MinValue:    floatPtr(0),
+				MaxValue:    giftMaxDelayHours,
+			},
+			{
+				Type:        discordgo.ApplicationCommandOptionBoolean,
+				Name:        "dm",
+				Description: "Deliver the gift by DM instead of in this channel",
+				Required:    false,
+			},
+		},
+	}
+
+	handler := func(s *discordgo.Session, i *discordgo.InteractionCreate, client *APIClient) {
+		if !deferResponse(s, i) {
+			return
+		}
+
+		user := getInteractionUser(i)
+		if !ensureUserRegistered(s, i, client, user, false) {
+			return
+		}
+
+		var recipient *discordgo.User
+		itemName, message, quantity, hours := "", "", 1, 0
+		channelID := i.ChannelID
+		for _, opt := range getOptions(i) {
+			switch opt.Name {
+			case "user":
+				recipient = opt.UserValue(s)
+			case "item":
+				itemName = opt.StringValue()
+			case "quantity":
+				quantity = int(opt.IntValue())
+			case "message":
+				message = opt.StringValue()
+			case "deliver-in":
+				hours = int(opt.IntValue())
+			case "dm":
+				if opt.BoolValue() {
+					channelID = ""
+				}
+			}
+		}
+		if recipient == nil {
+			respondFriendlyError(s, i, "Pick who the gift is for.")
+			return
+		}
+
+		// The recipient needs an account for the gift to be addressed to
+		if _, err := client.RegisterUser(recipient.Username, recipient.ID); err != nil {
+			slog.Error("Failed to register gift recipient", "error", err)
+			respondError(s, i, "Error connecting to game server.")
+			return
+		}
+
+		var deliverAt *time.Time
+		if hours > 0 {
+			at := time.Now().Add(time.Duration(hours) * time.Hour)
+			deliverAt = &at
+		}
+
+		gift, err := client.WrapGift(user.ID, user.Username, recipient.Username, itemName, quantity, message, deliverAt, channelID)
+		if err != nil {
+			slog.Error("Failed to wrap gift", "error", err, "user", user.Username, "item", itemName)
+			respondAPIError(s, i, err)
+			return
+		}
+
+		when := "on its way now"
+		if deliverAt != nil {
+			when = fmt.Sprintf("arriving <t:%d:R>", deliverAt.Unix())
+		}
+		description := fmt.Sprintf("**%s** wrapped **%d %s** for **%s**, %s.\nGift ID: **%d**", user.Username, gift.Quantity, gift.ItemName, recipient.Username, when, gift.ID)
+		sendEmbed(s, i, createEmbed("🎁 Gift Wrapped", description, giftColor, ""))
+	}
+
+	return cmd, handler
+}
+
+// GiftListCommand returns the gift list command definition and handler
+func GiftListCommand() (*discordgo.ApplicationCommand, CommandHandler) {
+	cmd := &discordgo.ApplicationCommand{
+		Name:        "gift-list",
+		Description: "See the gifts you have wrapped that are not yet delivered",
+	}
+
+	handler := func(s *discordgo.Session, i *discordgo.InteractionCreate, client *APIClient) {
+		if !deferResponse(s, i) {
+			return
+		}
+
+		user := getInteractionUser(i)
+		if !ensureUserRegistered(s, i, client, user, false) {
+			return
+		}
+
+		gifts, err := client.GetSentGifts(user.ID)
+		if err != nil {
+			slog.Error("Failed to get gifts", "error", err, "user", user.Username)
+			respondAPIError(s, i, err)
+			return
+		}
+
+		sendEmbed(s, i, giftListEmbed(gifts))
+	}
+
+	return cmd, handler
+}
+
+// GiftCancelCommand returns the gift cancel command definition and handler
+func GiftCancelCommand() (*discordgo.ApplicationCommand, CommandHandler) {
+	cmd := &discordgo.ApplicationCommand{
+		Name:        "gift-cancel",
+		Description: "Unwrap a gift before it is delivered and get the items back",
+		Options: []*discordgo.ApplicationCommandOption{
+			{
+				Type:        discordgo.ApplicationCommandOptionInteger,
+				Name:        "gift",
+				Description: "Gift to cancel",
+				Required:    true,
+				MinValue:    floatPtr(1),
+			},
+		},
+	}
+
+	handler := func(s *discordgo.Session, i *discordgo.InteractionCreate, client *APIClient) {
+		if !deferResponse(s, i) {
+			return
+		}
+
+		user := getInteractionUser(i)
+		if !ensureUserRegistered(s, i, client, user, false) {
+			return
+		}
+
+		giftID := 0
+		for _, opt := range getOptions(i) {
+			if opt.Name == "gift" {
+				giftID = int(opt.IntValue())
+			}
+		}
+
+		gift, err := client.CancelGift(user.ID, giftID)
+		if err != nil {
+			slog.Error("Failed to cancel gift", "error", err, "user", user.Username, "gift", giftID)
+			respondAPIError(s, i, err)
+			return
+		}
+
+		description := fmt.Sprintf("**%s** unwrapped gift **%d**. **%d %s** returned to their inventory.", user.Username, gift.ID, gift.Quantity, gift.ItemName)
+		sendEmbed(s, i, createEmbed("🎁 Gift Cancelled", description, giftColor, ""))
+	}
+
+	return cmd, handler
+}
+
+// giftListEmbed lists undelivered gifts, soonest first
+func giftListEmbed(gifts []Gift) *discordgo.MessageEmbed {
+	if len(gifts) == 0 {
+		return createEmbed("🎁 Your Gifts", "You have no gifts waiting to be delivered.", giftColor, "")
+	}
+	lines := make([]string, 0, len(gifts))
+	for _, g := range gifts {
+		line := fmt.Sprintf("`#%d` **%d %s** for %s", g.ID, g.Quantity, g.ItemName, g.RecipientName)
+		if at, err := time.Parse(time.RFC3339, g.DeliverAt); err == nil {
+			line += fmt.Sprintf(", <t:%d:R>", at.Unix())
+		}
+		lines = append(lines, line)
+	}
+	return createEmbed("🎁 Your Gifts", strings.Join(lines, "\n"), giftColor, "")
+}
+
+// giftDeliveredEmbed unwraps a delivered gift for its recipient
+func giftDeliveredEmbed(payload GiftDeliveredPayload) *discordgo.MessageEmbed {
+	description := fmt.Sprintf("**%s** sent you **%d %s**!", payload.SenderName, payload.Quantity, payload.ItemName)
+	if payload.Message != "" {
+		description += fmt.Sprintf("\n\n> %s", payload.Message)
+	}
+	return createEmbed("🎁 A Gift Has Arrived", description, giftColor, "")
+}
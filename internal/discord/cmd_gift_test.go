@@ -0,0 +1,36 @@
+package discord
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGiftListEmbed(t *testing.T) {
+	embed := giftListEmbed([]Gift{
+		{ID: 3, Quantity: 2, ItemName: "lootbox1", RecipientName: "bob", DeliverAt: "2026-10-15T12:00:00Z"},
+		{ID: 5, Quantity: 1, ItemName: "missile", RecipientName: "carol", DeliverAt: "not a time"},
+	})
+
+	assert.Equal(t, "🎁 Your Gifts", embed.Title)
+	assert.Equal(t, "`#3` **2 lootbox1** for bob, <t:1792065600:R>\n`#5` **1 missile** for carol", embed.Description)
+}
+
+func TestGiftListEmbed_Empty(t *testing.T) {
+	embed := giftListEmbed(nil)
+
+	assert.Equal(t, "You have no gifts waiting to be delivered.", embed.Description)
+}
+
+func TestGiftDeliveredEmbed(t *testing.T) {
+	embed := giftDeliveredEmbed(GiftDeliveredPayload{SenderName: "alice", Quantity: 2, ItemName: "lootbox1", Message: "happy birthday"})
+
+	assert.Equal(t, "🎁 A Gift Has Arrived", embed.Title)
+	assert.Equal(t, "**alice** sent you **2 lootbox1**!\n\n> happy birthday", embed.Description)
+}
+
+func TestGiftDeliveredEmbed_NoMessage(t *testing.T) {
+	embed := giftDeliveredEmbed(GiftDeliveredPayload{SenderName: "alice", Quantity: 1, ItemName: "missile"})
+
+	assert.Equal(t, "**alice** sent you **1 missile**!", embed.Description)
+}
@@ -33,7 +33,7 @@ func HarvestCommand() (*discordgo.ApplicationCommand, CommandHandler) {
 		resp, err := client.Harvest(domain.PlatformDiscord, user.ID, user.Username)
 		if err != nil {
 			slog.Error("Failed to harvest", "error", err, "user", user.Username)
-			respondFriendlyError(s, i, err.Error())
+			respondAPIError(s, i, err)
 			return
 		}
 
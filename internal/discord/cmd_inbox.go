@@ -0,0 +1,102 @@
+package discord
+
+import (
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// inboxColor is the embed color for the inbox
+const inboxColor = 0x5865F2
+
+// inboxPageSize is how many messages /inbox shows
+const inboxPageSize = 10
+
+// InboxCommand returns the inbox command definition and handler
+func InboxCommand() (*discordgo.ApplicationCommand, CommandHandler) {
+	cmd := &discordgo.ApplicationCommand{
+		Name:        "inbox",
+		Description: "Read what happened while you were away",
+		Options: []*discordgo.ApplicationCommandOption{
+			{
+				Type:        discordgo.ApplicationCommandOptionBoolean,
+				Name:        "unread",
+				Description: "Only show unread messages",
+				Required:    false,
+			},
+		},
+	}
+
+	handler := func(s *discordgo.Session, i *discordgo.InteractionCreate, client *APIClient) {
+		if !deferResponse(s, i) {
+			return
+		}
+
+		user := getInteractionUser(i)
+		if !ensureUserRegistered(s, i, client, user, false) {
+			return
+		}
+
+		unreadOnly := false
+		for _, opt := range getOptions(i) {
+			if opt.Name == "unread" {
+				unreadOnly = opt.BoolValue()
+			}
+		}
+
+		inbox, err := client.GetInbox(user.ID, unreadOnly, inboxPageSize)
+		if err != nil {
+			slog.Error("Failed to get inbox", "error", err, "user", user.Username)
+			respondAPIError(s, i, err)
+			return
+		}
+
+		sendEmbed(s, i, inboxEmbed(user.Username, inbox))
+
+		// Opening the inbox reads the messages shown
+		var unread []int
+		for _, msg := range inbox.Messages {
+			if msg.ReadAt == "" {
+				unread = append(unread, msg.ID)
+			}
+		}
+		if len(unread) > 0 {
+			if _, err := client.MarkInboxRead(user.ID, unread); err != nil {
+				slog.Warn("Failed to mark inbox read", "error", err, "user", user.Username)
+			}
+		}
+	}
+
+	return cmd, handler
+}
+
+// inboxEmbed lists inbox messages newest first, with unread ones marked
+func inboxEmbed(username string, inbox *Inbox) *discordgo.MessageEmbed {
+	title := fmt.Sprintf("📬 %s's Inbox", username)
+	if len(inbox.Messages) == 0 {
+		return createEmbed(title, "Nothing new.", inboxColor, "")
+	}
+	lines := make([]string, 0, len(inbox.Messages))
+	for _, msg := range inbox.Messages {
+		marker := "▫️"
+		if msg.ReadAt == "" {
+			marker = "🔹"
+		}
+		line := fmt.Sprintf("%s **%s**", marker, msg.Title)
+		if at, err := time.Parse(time.RFC3339, msg.CreatedAt); err == nil {
+			line += fmt.Sprintf(" <t:%d:R>", at.Unix())
+		}
+		if msg.Body != "" {
+			line += "\n" + msg.Body
+		}
+		lines = append(lines, line)
+	}
+	footer := ""
+	if inbox.Unread > 0 {
+		footer = fmt.Sprintf("%d unread", inbox.Unread)
+	}
+	return createEmbed(title, strings.Join(lines, "\n\n"), inboxColor, footer)
+}
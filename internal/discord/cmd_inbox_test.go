@@ -0,0 +1,28 @@
+package discord
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInboxEmbed(t *testing.T) {
+	embed := inboxEmbed("alice", &Inbox{
+		Messages: []InboxMessage{
+			{ID: 2, Title: "Gift from bob", Body: "bob sent you 1 lootbox1.", CreatedAt: "2026-10-15T12:00:00Z"},
+			{ID: 1, Title: "Milestone unlocked", ReadAt: "2026-10-14T09:00:00Z", CreatedAt: "not a time"},
+		},
+		Unread: 3,
+	})
+
+	assert.Equal(t, "📬 alice's Inbox", embed.Title)
+	assert.Equal(t, "🔹 **Gift from bob** <t:1792065600:R>\nbob sent you 1 lootbox1.\n\n▫️ **Milestone unlocked**", embed.Description)
+	assert.Equal(t, "3 unread", embed.Footer.Text)
+}
+
+func TestInboxEmbed_Empty(t *testing.T) {
+	embed := inboxEmbed("alice", &Inbox{})
+
+	assert.Equal(t, "Nothing new.", embed.Description)
+	assert.Equal(t, FooterBrandishBot, embed.Footer.Text)
+}
@@ -85,7 +85,7 @@ func InventoryCommand() (*discordgo.ApplicationCommand, CommandHandler) {
 			inventoryItems, err := client.GetInventory(domain.PlatformDiscord, targetUser.ID, targetUser.Username, filter)
 			if err != nil {
 				slog.Error("Failed to get inventory", "error", err)
-				respondFriendlyError(s, i, err.Error())
+				respondAPIError(s, i, err)
 				return
 			}
 			items = ConvertToSimpleInventory(inventoryItems)
@@ -94,7 +94,7 @@ func InventoryCommand() (*discordgo.ApplicationCommand, CommandHandler) {
 			inventoryItems, err := client.GetInventoryByUsername(domain.PlatformDiscord, targetUser.Username, filter)
 			if err != nil {
 				slog.Error("Failed to get inventory", "error", err)
-				respondFriendlyError(s, i, err.Error())
+				respondAPIError(s, i, err)
 				return
 			}
 			items = ConvertToSimpleInventory(inventoryItems)
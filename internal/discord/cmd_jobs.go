@@ -49,7 +49,7 @@ func JobProgressCommand() (*discordgo.ApplicationCommand, CommandHandler) {
 		jobsData, err := client.GetUserJobs(domain.PlatformDiscord, targetUser.ID)
 		if err != nil {
 			slog.Error("Failed to get user jobs", "error", err)
-			respondFriendlyError(s, i, err.Error())
+			respondAPIError(s, i, err)
 			return
 		}
 
@@ -0,0 +1,298 @@
+package discord
+
+import (
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/bwmarrin/discordgo"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+)
+
+// marketColor is the embed color for the player market
+const marketColor = 0x1ABC9C
+
+// MarketListCommand returns the market list command definition and handler
+func MarketListCommand() (*discordgo.ApplicationCommand, CommandHandler) {
+	cmd := &discordgo.ApplicationCommand{
+		Name:        "market-list",
+		Description: "Put items up for sale to other players at your price",
+		Options: []*discordgo.ApplicationCommandOption{
+			{
+				Type:         discordgo.ApplicationCommandOptionString,
+				Name:         "item",
+				Description:  "Item to sell",
+				Required:     true,
+				Autocomplete: true,
+			},
+			{
+				Type:        discordgo.ApplicationCommandOptionInteger,
+				Name:        "price",
+				Description: "Price per item",
+				Required:    true,
+				MinValue:    floatPtr(1),
+			},
+			marketQuantityOption("How many to sell (default: 1)"),
+		},
+	}
+
+	handler := func(s *discordgo.Session, i *discordgo.InteractionCreate, client *APIClient) {
+		if !deferResponse(s, i) {
+			return
+		}
+
+		user := getInteractionUser(i)
+		if !ensureUserRegistered(s, i, client, user, false) {
+			return
+		}
+
+		itemName, price, quantity := "", 0, 1
+		for _, opt := range getOptions(i) {
+			switch opt.Name {
+			case "item":
+				itemName = opt.StringValue()
+			case "price":
+				price = int(opt.IntValue())
+			case "quantity":
+				quantity = int(opt.IntValue())
+			}
+		}
+
+		listing, err := client.ListOnMarket(user.ID, user.Username, itemName, quantity, price)
+		if err != nil {
+			slog.Error("Failed to list on market", "error", err, "user", user.Username, "item", itemName)
+			respondAPIError(s, i, err)
+			return
+		}
+
+		description := fmt.Sprintf("**%s** listed **%d %s** at **%d** each.\nListing ID: **%d**", user.Username, listing.Quantity, listing.ItemName, listing.UnitPrice, listing.ID)
+		if listing.ListingFee > 0 {
+			description += fmt.Sprintf("\nListing fee paid: %d", listing.ListingFee)
+		}
+		sendEmbed(s, i, createEmbed("🏷️ Market Listing", description, marketColor, ""))
+	}
+
+	return cmd, handler
+}
+
+// MarketBuyCommand returns the market buy command definition and handler
+func MarketBuyCommand() (*discordgo.ApplicationCommand, CommandHandler) {
+	cmd := &discordgo.ApplicationCommand{
+		Name:        "market-buy",
+		Description: "Buy items from another player's market listing",
+		Options: []*discordgo.ApplicationCommandOption{
+			marketListingOption("Listing to buy from"),
+			marketQuantityOption("How many to buy (default: 1)"),
+		},
+	}
+
+	handler := func(s *discordgo.Session, i *discordgo.InteractionCreate, client *APIClient) {
+		if !deferResponse(s, i) {
+			return
+		}
+
+		user := getInteractionUser(i)
+		if !ensureUserRegistered(s, i, client, user, false) {
+			return
+		}
+
+		listingID, quantity := 0, 1
+		for _, opt := range getOptions(i) {
+			switch opt.Name {
+			case "listing":
+				listingID = int(opt.IntValue())
+			case "quantity":
+				quantity = int(opt.IntValue())
+			}
+		}
+
+		purchase, err := client.BuyFromMarket(user.ID, user.Username, listingID, quantity)
+		if err != nil {
+			slog.Error("Failed to buy from market", "error", err, "user", user.Username, "listing", listingID)
+			respondAPIError(s, i, err)
+			return
+		}
+
+		itemName := ""
+		if purchase.Listing != nil {
+			itemName = purchase.Listing.ItemName
+		}
+		description := fmt.Sprintf("**%s** bought **%d %s** for **%d**.", user.Username, purchase.Quantity, itemName, purchase.TotalPrice)
+		sendEmbed(s, i, createEmbed("🛒 Market Purchase", description, marketColor, ""))
+	}
+
+	return cmd, handler
+}
+
+// MarketCancelCommand returns the market cancel command definition and handler
+func MarketCancelCommand() (*discordgo.ApplicationCommand, CommandHandler) {
+	cmd := &discordgo.ApplicationCommand{
+		Name:        "market-cancel",
+		Description: "Take down your market listing and get the unsold items back",
+		Options: []*discordgo.ApplicationCommandOption{
+			marketListingOption("Listing to cancel"),
+		},
+	}
+
+	handler := func(s *discordgo.Session, i *discordgo.InteractionCreate, client *APIClient) {
+		if !deferResponse(s, i) {
+			return
+		}
+
+		user := getInteractionUser(i)
+		if !ensureUserRegistered(s, i, client, user, false) {
+			return
+		}
+
+		listingID := 0
+		for _, opt := range getOptions(i) {
+			if opt.Name == "listing" {
+				listingID = int(opt.IntValue())
+			}
+		}
+
+		listing, err := client.CancelMarketListing(user.ID, listingID)
+		if err != nil {
+			slog.Error("Failed to cancel market listing", "error", err, "user", user.Username, "listing", listingID)
+			respondAPIError(s, i, err)
+			return
+		}
+
+		description := fmt.Sprintf("**%s** cancelled listing **%d**. **%d %s** returned to their inventory.", user.Username, listing.ID, listing.Quantity, listing.ItemName)
+		sendEmbed(s, i, createEmbed("🏷️ Listing Cancelled", description, marketColor, ""))
+	}
+
+	return cmd, handler
+}
+
+// MarketSearchCommand returns the market search command definition and handler
+func MarketSearchCommand() (*discordgo.ApplicationCommand, CommandHandler) {
+	cmd := &discordgo.ApplicationCommand{
+		Name:        "market-search",
+		Description: "Browse items other players are selling",
+		Options: []*discordgo.ApplicationCommandOption{
+			{
+				Type:         discordgo.ApplicationCommandOptionString,
+				Name:         "item",
+				Description:  "Only show this item",
+				Required:     false,
+				Autocomplete: true,
+			},
+			{
+				Type:        discordgo.ApplicationCommandOptionString,
+				Name:        "seller",
+				Description: "Only show this seller's listings",
+				Required:    false,
+			},
+			{
+				Type:        discordgo.ApplicationCommandOptionString,
+				Name:        "quality",
+				Description: "Only show this quality",
+				Required:    false,
+				Choices: []*discordgo.ApplicationCommandOptionChoice{
+					{Name: "Legendary", Value: string(domain.QualityLegendary)},
+					{Name: "Epic", Value: string(domain.QualityEpic)},
+					{Name: "Rare", Value: string(domain.QualityRare)},
+					{Name: "Uncommon", Value: string(domain.QualityUncommon)},
+					{Name: "Common", Value: string(domain.QualityCommon)},
+					{Name: "Poor", Value: string(domain.QualityPoor)},
+					{Name: "Junk", Value: string(domain.QualityJunk)},
+					{Name: "Cursed", Value: string(domain.QualityCursed)},
+				},
+			},
+			{
+				Type:        discordgo.ApplicationCommandOptionInteger,
+				Name:        "max-price",
+				Description: "Highest price per item",
+				Required:    false,
+				MinValue:    floatPtr(1),
+			},
+			{
+				Type:        discordgo.ApplicationCommandOptionString,
+				Name:        "sort",
+				Description: "Order of the listings (default: cheapest)",
+				Required:    false,
+				Choices: []*discordgo.ApplicationCommandOptionChoice{
+					{Name: "Cheapest", Value: string(domain.ListingSortPrice)},
+					{Name: "Newest", Value: string(domain.ListingSortNewest)},
+				},
+			},
+		},
+	}
+
+	handler := func(s *discordgo.Session, i *discordgo.InteractionCreate, client *APIClient) {
+		if !deferResponse(s, i) {
+			return
+		}
+
+		var search MarketSearch
+		for _, opt := range getOptions(i) {
+			switch opt.Name {
+			case "item":
+				search.Item = opt.StringValue()
+			case "seller":
+				search.Seller = opt.StringValue()
+			case "quality":
+				search.Quality = opt.StringValue()
+			case "max-price":
+				search.MaxPrice = int(opt.IntValue())
+			case "sort":
+				search.Sort = opt.StringValue()
+			}
+		}
+
+		listings, err := client.SearchMarket(search)
+		if err != nil {
+			slog.Error("Failed to search market", "error", err)
+			respondAPIError(s, i, err)
+			return
+		}
+
+		sendEmbed(s, i, marketEmbed(listings))
+	}
+
+	return cmd, handler
+}
+
+// marketMaxLines caps how many listings one search embed shows
+const marketMaxLines = 20
+
+// marketEmbed lists the listings a search found, one per line
+func marketEmbed(listings []MarketListing) *discordgo.MessageEmbed {
+	if len(listings) == 0 {
+		return createEmbed("🏪 Market", "No listings match your search.", marketColor, "")
+	}
+	lines := make([]string, 0, min(len(listings), marketMaxLines))
+	for _, l := range listings[:min(len(listings), marketMaxLines)] {
+		lines = append(lines, fmt.Sprintf("`#%d` **%d %s** (%s) at **%d** each from %s", l.ID, l.Quantity, l.ItemName, strings.ToLower(string(l.QualityLevel)), l.UnitPrice, l.SellerName))
+	}
+	footer := ""
+	if len(listings) > marketMaxLines {
+		footer = fmt.Sprintf("Showing %d of %d listings", marketMaxLines, len(listings))
+	}
+	return createEmbed("🏪 Market", strings.Join(lines, "\n"), marketColor, footer)
+}
+
+// marketListingOption is the required ID of a market listing
+func marketListingOption(description string) *discordgo.ApplicationCommandOption {
+	return &discordgo.ApplicationCommandOption{
+		Type:        discordgo.ApplicationCommandOptionInteger,
+		Name:        "listing",
+		Description: description,
+		Required:    true,
+		MinValue:    floatPtr(1),
+	}
+}
+
+// marketQuantityOption is the optional number of items to list or buy
+func marketQuantityOption(description string) *discordgo.ApplicationCommandOption {
+	return &discordgo.ApplicationCommandOption{
+		Type:        discordgo.ApplicationCommandOptionInteger,
+		Name:        "quantity",
+		Description: description,
+		Required:    false,
+		MinValue:    floatPtr(1),
+		MaxValue:    domain.MaxTransactionQuantity,
+	}
+}
@@ -0,0 +1,30 @@
+package discord
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMarketEmbed(t *testing.T) {
+	embed := marketEmbed([]MarketListing{
+		{ID: 4, Quantity: 2, ItemName: "sword", QualityLevel: "RARE", UnitPrice: 50, SellerName: "alice"},
+	})
+
+	assert.Equal(t, "`#4` **2 sword** (rare) at **50** each from alice", embed.Description)
+	assert.Equal(t, FooterBrandishBot, embed.Footer.Text)
+}
+
+func TestMarketEmbed_Empty(t *testing.T) {
+	embed := marketEmbed(nil)
+
+	assert.Equal(t, "No listings match your search.", embed.Description)
+}
+
+func TestMarketEmbed_Truncates(t *testing.T) {
+	listings := make([]MarketListing, marketMaxLines+5)
+
+	embed := marketEmbed(listings)
+
+	assert.Contains(t, embed.Footer.Text, "Showing 20 of 25 listings")
+}
@@ -47,7 +47,7 @@ func ProfileCommand() (*discordgo.ApplicationCommand, CommandHandler) {
 			Fields: []*discordgo.MessageEmbedField{
 				{
 					Name:   "User ID",
-					Value:  domainUser.ID,
+					Value:  domainUser.InternalID,
 					Inline: true,
 				},
 				{
@@ -110,7 +110,7 @@ func CheckTimeoutCommand() (*discordgo.ApplicationCommand, CommandHandler) {
 		isTimedOut, remainingSeconds, err := client.GetUserTimeout(targetUser.Username)
 		if err != nil {
 			slog.Error("Failed to check timeout", "error", err)
-			respondFriendlyError(s, i, err.Error())
+			respondAPIError(s, i, err)
 			return
 		}
 
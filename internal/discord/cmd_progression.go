@@ -3,6 +3,8 @@ package discord
 import (
 	"fmt"
 	"log/slog"
+	"strings"
+	"time"
 
 	"github.com/bwmarrin/discordgo"
 
@@ -123,11 +125,12 @@ func UnlockProgressCommand() (*discordgo.ApplicationCommand, CommandHandler) {
 		progress, err := client.GetUnlockProgress()
 		if err != nil {
 			slog.Error("Failed to get unlock progress", "error", err)
-			respondFriendlyError(s, i, err.Error())
+			respondAPIError(s, i, err)
 			return
 		}
 
-		if progress == nil {
+		// The server answers with only a message when no unlock is in progress
+		if progress.ID == 0 {
 			slog.Info("No active unlock progress")
 			if _, err := s.InteractionResponseEdit(i.Interaction, &discordgo.WebhookEdit{
 				Content: &[]string{"No active unlock progress."}[0],
@@ -137,17 +140,11 @@ func UnlockProgressCommand() (*discordgo.ApplicationCommand, CommandHandler) {
 			return
 		}
 
-		p := *progress
-		nodeName, _ := p["target_node_name"].(string)
-		targetLevel, _ := p["target_level"].(float64) // JSON numbers are float64
-		contribAcc, _ := p["contributions_accumulated"].(float64)
-		targetCost, _ := p["target_unlock_cost"].(float64)
-		percent, _ := p["completion_percentage"].(float64)
-		estimatedUnlock, _ := p["estimated_unlock_date"].(string)
+		percent := progress.CompletionPercentage
 
 		description := "Current community contribution progress:"
-		if nodeName != "" {
-			description = fmt.Sprintf("Working towards unlocking **%s Level %.0f**", nodeName, targetLevel)
+		if progress.TargetNodeName != "" {
+			description = fmt.Sprintf("Working towards unlocking **%s Level %d**", progress.TargetNodeName, progress.TargetLevel)
 		}
 
 		progressBar := createProgressBar(percent)
@@ -160,16 +157,16 @@ func UnlockProgressCommand() (*discordgo.ApplicationCommand, CommandHandler) {
 			},
 			{
 				Name:   "Contributions",
-				Value:  fmt.Sprintf("%.0f / %.0f", contribAcc, targetCost),
+				Value:  fmt.Sprintf("%d / %d", progress.ContributionsAccumulated, progress.TargetUnlockCost),
 				Inline: true,
 			},
 		}
 
 		// Add estimated completion date if available
-		if estimatedUnlock != "" {
+		if estimatedUnlock, err := time.Parse(time.RFC3339, progress.EstimatedUnlockDate); err == nil {
 			fields = append(fields, &discordgo.MessageEmbedField{
 				Name:   "Estimated Completion",
-				Value:  fmt.Sprintf("<t:%s:R>", estimatedUnlock), // Discord relative timestamp
+				Value:  fmt.Sprintf("<t:%d:R>", estimatedUnlock.Unix()), // Discord relative timestamp
 				Inline: true,
 			})
 		}
@@ -223,7 +220,7 @@ func EngagementCommand() (*discordgo.ApplicationCommand, CommandHandler) {
 		engagement, err := client.GetUserEngagement("discord", targetUser.ID)
 		if err != nil {
 			slog.Error("Failed to get engagement", "error", err)
-			respondFriendlyError(s, i, err.Error())
+			respondAPIError(s, i, err)
 			return
 		}
 
@@ -262,7 +259,7 @@ func VotingSessionCommand() (*discordgo.ApplicationCommand, CommandHandler) {
 		session, err := client.GetVotingSession()
 		if err != nil {
 			slog.Error("Failed to get voting session", "error", err)
-			respondFriendlyError(s, i, err.Error())
+			respondAPIError(s, i, err)
 			return
 		}
 
@@ -301,6 +298,87 @@ func VotingSessionCommand() (*discordgo.ApplicationCommand, CommandHandler) {
 	return cmd, handler
 }
 
+// votingHistoryPageSize is the number of past sessions shown per /voting-history page
+const votingHistoryPageSize = 5
+
+// VotingHistoryCommand returns the voting history command handler
+func VotingHistoryCommand() (*discordgo.ApplicationCommand, CommandHandler) {
+	cmd := &discordgo.ApplicationCommand{
+		Name:        "voting-history",
+		Description: "Recap of past voting sessions and what the community chose",
+		Options: []*discordgo.ApplicationCommandOption{
+			{
+				Type:        discordgo.ApplicationCommandOptionInteger,
+				Name:        "page",
+				Description: "Page number (default 1)",
+				Required:    false,
+				MinValue:    &[]float64{1}[0],
+			},
+		},
+	}
+
+	handler := func(s *discordgo.Session, i *discordgo.InteractionCreate, client *APIClient) {
+		if !deferResponse(s, i) {
+			return
+		}
+
+		page := 1
+		if options := getOptions(i); len(options) > 0 {
+			page = int(options[0].IntValue())
+		}
+
+		history, err := client.GetVotingHistory(votingHistoryPageSize, (page-1)*votingHistoryPageSize)
+		if err != nil {
+			slog.Error("Failed to get voting history", "error", err)
+			respondAPIError(s, i, err)
+			return
+		}
+
+		if len(history.Sessions) == 0 {
+			respondError(s, i, "No past voting sessions on this page yet.")
+			return
+		}
+
+		var sb strings.Builder
+		for _, session := range history.Sessions {
+			fmt.Fprintf(&sb, "**Session #%d** (%s) - %d votes\n", session.ID, formatAPITime(session.StartedAt, "2006-01-02"), session.TotalVotes)
+			for _, opt := range session.Options {
+				marker := "▫️"
+				if session.Winner != nil && opt.ID == session.Winner.ID {
+					marker = "🏆"
+				}
+				fmt.Fprintf(&sb, "%s %s (Level %d) - %d votes\n", marker, votingOptionName(opt), opt.TargetLevel, opt.VoteCount)
+			}
+			if session.UnlockedNode != nil && session.UnlockedAt != "" {
+				fmt.Fprintf(&sb, "  └ Unlocked **%s** on %s\n", session.UnlockedNode.DisplayName, formatAPITime(session.UnlockedAt, "2006-01-02"))
+			} else if session.Winner != nil {
+				sb.WriteString("  └ Unlock in progress\n")
+			}
+			sb.WriteString("\n")
+		}
+
+		totalPages := (history.Total + votingHistoryPageSize - 1) / votingHistoryPageSize
+		embed := &discordgo.MessageEmbed{
+			Title:       "📜 Community Choices So Far",
+			Description: sb.String(),
+			Color:       0x9b59b6, // Purple
+			Footer: &discordgo.MessageEmbedFooter{
+				Text: fmt.Sprintf("Page %d of %d • %d sessions", page, totalPages, history.Total),
+			},
+		}
+		sendEmbed(s, i, embed)
+	}
+
+	return cmd, handler
+}
+
+func votingOptionName(opt VotingOption) string {
+	if opt.NodeDetails != nil {
+		return opt.NodeDetails.DisplayName
+	}
+	return "Unknown Node"
+}
+
 func createProgressBar(percent float64) string {
 	totalBars := 10
 	filledBars := int((percent / 100) * float64(totalBars))
@@ -7,8 +7,6 @@ import (
 	"time"
 
 	"github.com/bwmarrin/discordgo"
-
-	"github.com/osse101/BrandishBot_Go/internal/domain"
 )
 
 // QuestsCommand returns the quests command definition and handler
@@ -53,7 +51,7 @@ func QuestsCommand() (*discordgo.ApplicationCommand, CommandHandler) {
 		}
 
 		// Create progress map for quick lookup
-		progressMap := make(map[int]*domain.QuestProgress)
+		progressMap := make(map[int]*QuestProgress)
 		for i := range progress {
 			if progress[i].QuestID > 0 {
 				progressMap[progress[i].QuestID] = &progress[i]
@@ -78,9 +76,9 @@ func QuestsCommand() (*discordgo.ApplicationCommand, CommandHandler) {
 
 			// Build status indicator
 			status := "🔄 In Progress"
-			if qp.CompletedAt != nil && qp.ClaimedAt == nil {
+			if qp.CompletedAt != "" && qp.ClaimedAt == "" {
 				status = "✅ Ready to Claim!"
-			} else if qp.ClaimedAt != nil {
+			} else if qp.ClaimedAt != "" {
 				status = "🎁 Claimed"
 			}
 
@@ -95,7 +93,7 @@ func QuestsCommand() (*discordgo.ApplicationCommand, CommandHandler) {
 				qp.ProgressCurrent,
 				qp.ProgressRequired,
 				qp.RewardMoney,
-				qp.RewardXp,
+				qp.RewardXP,
 			)
 
 			embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{
@@ -155,15 +153,6 @@ func ClaimQuestCommand() (*discordgo.ApplicationCommand, CommandHandler) {
 			return
 		}
 
-		// Extract money from result
-		moneyEarned := int64(0)
-
-		if money, ok := result["money_earned"]; ok {
-			if m, okm := money.(float64); okm {
-				moneyEarned = int64(m)
-			}
-		}
-
 		// Build response embed
 		embed := &discordgo.MessageEmbed{
 			Title:       "🎉 Quest Reward Claimed!",
@@ -172,7 +161,7 @@ func ClaimQuestCommand() (*discordgo.ApplicationCommand, CommandHandler) {
 			Fields: []*discordgo.MessageEmbedField{
 				{
 					Name:   "💰 Money Earned",
-					Value:  fmt.Sprintf("%d", moneyEarned),
+					Value:  fmt.Sprintf("%d", result.MoneyEarned),
 					Inline: true,
 				},
 			},
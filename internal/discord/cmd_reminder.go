@@ -0,0 +1,138 @@
+package discord
+
+import (
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/bwmarrin/discordgo"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+)
+
+// RemindCommand returns the remind command definition and handler
+func RemindCommand() (*discordgo.ApplicationCommand, CommandHandler) {
+	cmd := &discordgo.ApplicationCommand{
+		Name:        "remind",
+		Description: "Get pinged before voting closes or when your compost is ready",
+		Options: []*discordgo.ApplicationCommandOption{
+			{
+				Type:        discordgo.ApplicationCommandOptionString,
+				Name:        "what",
+				Description: "What to be reminded about",
+				Required:    true,
+				Choices: []*discordgo.ApplicationCommandOptionChoice{
+					{Name: "Voting closing", Value: string(domain.ReminderKindVote)},
+					{Name: "Compost ready", Value: string(domain.ReminderKindCompost)},
+				},
+			},
+			{
+				Type:        discordgo.ApplicationCommandOptionBoolean,
+				Name:        "dm",
+				Description: "Send the reminder as a DM instead of in this channel",
+				Required:    false,
+			},
+		},
+	}
+
+	handler := func(s *discordgo.Session, i *discordgo.InteractionCreate, client *APIClient) {
+		if !deferResponse(s, i) {
+			return
+		}
+
+		user := getInteractionUser(i)
+		var kind string
+		channelID := i.ChannelID
+		for _, opt := range getOptions(i) {
+			switch opt.Name {
+			case "what":
+				kind = opt.StringValue()
+			case "dm":
+				if opt.BoolValue() {
+					channelID = ""
+				}
+			}
+		}
+
+		reminder, err := client.CreateReminder(user.ID, user.Username, domain.ReminderKind(kind), "", nil, channelID)
+		if err != nil {
+			slog.Error("Failed to create reminder", "error", err, "user", user.Username, "kind", kind)
+			respondAPIError(s, i, err)
+			return
+		}
+
+		where := "here"
+		if channelID == "" {
+			where = "by DM"
+		}
+		embed := createEmbed(
+			"Reminder Set",
+			fmt.Sprintf("🔔 I'll ping you %s %s.", where, relativeAPITime(reminder.RemindAt)),
+			0x3498db,
+			"",
+		)
+		sendEmbed(s, i, embed)
+	}
+
+	return cmd, handler
+}
+
+// RemindersCommand returns the reminders command definition and handler
+func RemindersCommand() (*discordgo.ApplicationCommand, CommandHandler) {
+	cmd := &discordgo.ApplicationCommand{
+		Name:        "reminders",
+		Description: "List your pending reminders, or cancel one",
+		Options: []*discordgo.ApplicationCommandOption{
+			{
+				Type:        discordgo.ApplicationCommandOptionInteger,
+				Name:        "cancel",
+				Description: "ID of the reminder to cancel",
+				Required:    false,
+				MinValue:    &[]float64{1}[0],
+			},
+		},
+	}
+
+	handler := func(s *discordgo.Session, i *discordgo.InteractionCreate, client *APIClient) {
+		if !deferResponse(s, i) {
+			return
+		}
+
+		user := getInteractionUser(i)
+		var cancelID int64
+		for _, opt := range getOptions(i) {
+			if opt.Name == "cancel" {
+				cancelID = opt.IntValue()
+			}
+		}
+
+		if cancelID > 0 {
+			if _, err := client.CancelReminder(user.ID, cancelID); err != nil {
+				slog.Error("Failed to cancel reminder", "error", err, "user", user.Username, "reminder_id", cancelID)
+				respondAPIError(s, i, err)
+				return
+			}
+			sendEmbed(s, i, createEmbed("Reminder Cancelled", fmt.Sprintf("Reminder #%d has been cancelled.", cancelID), 0x95a5a6, ""))
+			return
+		}
+
+		reminders, err := client.GetReminders(user.ID)
+		if err != nil {
+			slog.Error("Failed to get reminders", "error", err, "user", user.Username)
+			respondAPIError(s, i, err)
+			return
+		}
+		if len(reminders) == 0 {
+			sendEmbed(s, i, createEmbed("Reminders", "You have no pending reminders.", 0x95a5a6, ""))
+			return
+		}
+
+		var sb strings.Builder
+		for _, r := range reminders {
+			fmt.Fprintf(&sb, "**#%d** %s — %s\n", r.ID, relativeAPITime(r.RemindAt), r.Message)
+		}
+		sendEmbed(s, i, createEmbed("Reminders", sb.String(), 0x3498db, "Use /reminders cancel:<id> to cancel one"))
+	}
+
+	return cmd, handler
+}
@@ -13,6 +13,15 @@ func SearchCommand() (*discordgo.ApplicationCommand, CommandHandler) {
 	cmd := &discordgo.ApplicationCommand{
 		Name:        domain.ActionSearch,
 		Description: "Search for items",
+		Options: []*discordgo.ApplicationCommandOption{
+			{
+				Type:         discordgo.ApplicationCommandOptionString,
+				Name:         "location",
+				Description:  "Where to search (leave empty to explore nearby)",
+				Required:     false,
+				Autocomplete: true,
+			},
+		},
 	}
 
 	handler := func(s *discordgo.Session, i *discordgo.InteractionCreate, client *APIClient) {
@@ -27,10 +36,15 @@ func SearchCommand() (*discordgo.ApplicationCommand, CommandHandler) {
 			return
 		}
 
-		msg, err := client.Search(domain.PlatformDiscord, user.ID, user.Username)
+		location := ""
+		if options := getOptions(i); len(options) > 0 {
+			location = options[0].StringValue()
+		}
+
+		msg, err := client.Search(domain.PlatformDiscord, user.ID, user.Username, location)
 		if err != nil {
 			slog.Error("Failed to search", "error", err)
-			respondFriendlyError(s, i, err.Error())
+			respondAPIError(s, i, err)
 			return
 		}
 
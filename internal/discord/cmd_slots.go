@@ -5,8 +5,6 @@ import (
 	"log/slog"
 
 	"github.com/bwmarrin/discordgo"
-
-	"github.com/osse101/BrandishBot_Go/internal/domain"
 )
 
 // SlotsCommand returns the slots minigame command definition and handler
@@ -51,7 +49,7 @@ func SlotsCommand() (*discordgo.ApplicationCommand, CommandHandler) {
 		result, err := client.SpinSlots("discord", user.ID, user.Username, betAmount)
 		if err != nil {
 			slog.Error("Failed to spin slots", "error", err, "username", user.Username)
-			respondFriendlyError(s, i, err.Error())
+			respondAPIError(s, i, err)
 			return
 		}
 
@@ -66,7 +64,7 @@ func SlotsCommand() (*discordgo.ApplicationCommand, CommandHandler) {
 }
 
 // buildSlotsEmbed creates an embed for slots results
-func buildSlotsEmbed(result *domain.SlotsResult) *discordgo.MessageEmbed {
+func buildSlotsEmbed(result *SlotsResult) *discordgo.MessageEmbed {
 	// Map symbols to emojis
 	emojiMap := map[string]string{
 		"LEMON":   "🍋",
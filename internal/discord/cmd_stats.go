@@ -3,12 +3,16 @@ package discord
 import (
 	"fmt"
 	"log/slog"
+	"strings"
 
 	"github.com/bwmarrin/discordgo"
 
 	"github.com/osse101/BrandishBot_Go/internal/domain"
 )
 
+// leaderboardMetricContribution ranks by progression contribution instead of a stats event
+const leaderboardMetricContribution = "contribution"
+
 // LeaderboardCommand returns the leaderboard command definition and handler
 func LeaderboardCommand() (*discordgo.ApplicationCommand, CommandHandler) {
 	cmd := &discordgo.ApplicationCommand{
@@ -18,20 +22,35 @@ func LeaderboardCommand() (*discordgo.ApplicationCommand, CommandHandler) {
 			{
 				Type:        discordgo.ApplicationCommandOptionString,
 				Name:        "metric",
-				Description: "Metric to rank by (default: engagement_score)",
+				Description: "Metric to rank by (default: messages sent)",
 				Required:    false,
 				Choices: []*discordgo.ApplicationCommandOptionChoice{
-					{Name: "Engagement Score", Value: "engagement_score"},
-					{Name: "Total Money", Value: "money"},
-					{Name: "Items Found", Value: "items"},
-					{Name: "Messages Sent", Value: "messages"},
-					{Name: "Contribution Points", Value: "contribution"},
+					{Name: "Messages Sent", Value: string(domain.StatsEventMessageReceived)},
+					{Name: "Searches", Value: string(domain.StatsEventSearch)},
+					{Name: "Items Used", Value: string(domain.StatsEventItemUsed)},
+					{Name: "Items Sold", Value: string(domain.StatsEventItemSold)},
+					{Name: "Contribution Points", Value: leaderboardMetricContribution},
 				},
 			},
 			{
 				Type:        discordgo.ApplicationCommandOptionInteger,
 				Name:        "limit",
-				Description: "Number of top players to show (default: 10)",
+				Description: "Number of players per page (default: 10)",
+				Required:    false,
+				MinValue:    &[]float64{1}[0],
+				MaxValue:    25,
+			},
+			{
+				Type:        discordgo.ApplicationCommandOptionInteger,
+				Name:        "page",
+				Description: "Page number (default 1)",
+				Required:    false,
+				MinValue:    &[]float64{1}[0],
+			},
+			{
+				Type:        discordgo.ApplicationCommandOptionBoolean,
+				Name:        "around_me",
+				Description: "Show the players ranked around you instead of a page",
 				Required:    false,
 			},
 		},
@@ -43,8 +62,10 @@ func LeaderboardCommand() (*discordgo.ApplicationCommand, CommandHandler) {
 		}
 
 		options := getOptions(i)
-		metric := "engagement_score"
+		metric := string(domain.StatsEventMessageReceived)
 		limit := 10
+		page := 1
+		aroundMe := false
 
 		for _, opt := range options {
 			switch opt.Name {
@@ -52,21 +73,34 @@ func LeaderboardCommand() (*discordgo.ApplicationCommand, CommandHandler) {
 				metric = opt.StringValue()
 			case "limit":
 				limit = int(opt.IntValue())
+			case "page":
+				page = int(opt.IntValue())
+			case "around_me":
+				aroundMe = opt.BoolValue()
 			}
 		}
 
-		var msg string
+		var msg, footer string
 		var err error
 
-		if metric == "contribution" {
+		if metric == leaderboardMetricContribution {
 			msg, err = client.GetContributionLeaderboard(limit)
+			footer = "BrandishBot"
 		} else {
-			msg, err = client.GetLeaderboard(metric, limit)
+			aroundPlatformID := ""
+			if aroundMe {
+				aroundPlatformID = getInteractionUser(i).ID
+			}
+			var board *LeaderboardPage
+			board, err = client.GetLeaderboard(metric, limit, (page-1)*limit, aroundPlatformID)
+			if err == nil {
+				msg, footer = formatLeaderboardPage(board, aroundMe)
+			}
 		}
 
 		if err != nil {
 			slog.Error("Failed to get leaderboard", "error", err)
-			respondFriendlyError(s, i, err.Error())
+			respondAPIError(s, i, err)
 			return
 		}
 
@@ -75,7 +109,7 @@ func LeaderboardCommand() (*discordgo.ApplicationCommand, CommandHandler) {
 			Description: msg,
 			Color:       0x1abc9c, // Teal
 			Footer: &discordgo.MessageEmbedFooter{
-				Text: "BrandishBot",
+				Text: footer,
 			},
 		}
 
@@ -89,6 +123,31 @@ func LeaderboardCommand() (*discordgo.ApplicationCommand, CommandHandler) {
 	return cmd, handler
 }
 
+// formatLeaderboardPage renders a leaderboard page and its footer, marking
+// the caller's row when the page was centred on them
+func formatLeaderboardPage(board *LeaderboardPage, aroundMe bool) (string, string) {
+	var sb strings.Builder
+	if aroundMe && board.UserRank == 0 {
+		sb.WriteString("You are not ranked yet, here are the leaders.\n\n")
+	}
+	if len(board.Entries) == 0 {
+		sb.WriteString("No one is on this page yet.")
+	}
+	for _, entry := range board.Entries {
+		marker := ""
+		if aroundMe && entry.Rank == board.UserRank {
+			marker = " ⬅️"
+		}
+		fmt.Fprintf(&sb, "**%d.** %s: %d%s\n", entry.Rank, entry.Username, entry.Count, marker)
+	}
+
+	footer := fmt.Sprintf("%s • %s • Page %d", board.EventType, board.Period, board.Offset/max(board.Limit, 1)+1)
+	if board.HasMore {
+		footer += " • more below"
+	}
+	return sb.String(), footer
+}
+
 // StatsCommand returns the stats command definition and handler
 func StatsCommand() (*discordgo.ApplicationCommand, CommandHandler) {
 	cmd := &discordgo.ApplicationCommand{
@@ -123,7 +182,7 @@ func StatsCommand() (*discordgo.ApplicationCommand, CommandHandler) {
 		msg, err := client.GetUserStats(domain.PlatformDiscord, user.ID)
 		if err != nil {
 			slog.Error("Failed to get stats", "error", err)
-			respondFriendlyError(s, i, err.Error())
+			respondAPIError(s, i, err)
 			return
 		}
 
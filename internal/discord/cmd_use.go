@@ -63,7 +63,7 @@ func UseItemCommand() (*discordgo.ApplicationCommand, CommandHandler) {
 		msg, err := client.UseItem(domain.PlatformDiscord, user.ID, user.Username, itemName, quantity, target)
 		if err != nil {
 			slog.Error("Failed to use item", "error", err)
-			respondFriendlyError(s, i, err.Error())
+			respondAPIError(s, i, err)
 			return
 		}
 
@@ -204,7 +204,7 @@ func handleEmbedResponse(
 	msg, err := action()
 	if err != nil {
 		slog.Error("Action failed", "title", config.Title, "error", err)
-		respondFriendlyError(s, i, err.Error())
+		respondAPIError(s, i, err)
 		return
 	}
 
@@ -353,14 +353,13 @@ func getOptions(i *discordgo.InteractionCreate) []*discordgo.ApplicationCommandI
 
 // respondFriendlyError formats the error message to be more user-friendly before responding.
 // Transforms technical errors (insufficient funds, item not found, cooldowns, etc.) into
-// readable messages. Use for API/business logic errors users can understand and act on.
+// readable messages. Use for business logic errors users can understand and act on;
+// errors returned by APIClient should go through respondAPIError instead.
 //
 // Usage:
 //
-//	msg, err := client.BuyItem(...)
-//	if err != nil {
-//	    slog.Error("Failed to buy item", "error", err)
-//	    respondFriendlyError(s, i, err.Error())
+//	if len(options) == 0 {
+//	    respondFriendlyError(s, i, "Missing required item argument")
 //	    return
 //	}
 func respondFriendlyError(s *discordgo.Session, i *discordgo.InteractionCreate, message string) {
@@ -492,7 +491,7 @@ func ensureUserRegistered(s *discordgo.Session, i *discordgo.InteractionCreate,
 	if err != nil {
 		slog.Error("Failed to register user", "error", err)
 		if friendlyError {
-			respondFriendlyError(s, i, err.Error())
+			respondAPIError(s, i, err)
 		} else {
 			respondError(s, i, "Error connecting to game server.")
 		}
@@ -24,7 +24,7 @@ type MockAPIClient struct {
 	UpgradeItemFunc     func(string, string, string, int) (string, error)
 	DisassembleItemFunc func(string, string, string, string, int) (string, error)
 	GetRecipesFunc      func() (string, error)
-	GetLeaderboardFunc  func(string, int) (string, error)
+	GetLeaderboardFunc  func(string, int, int, string) (*domain.LeaderboardPage, error)
 	GetUserStatsFunc    func(string, string) (string, error)
 	AddItemFunc         func(string, string, string, int) (string, error)
 	RemoveItemFunc      func(string, string, string, int) (string, error)
@@ -117,11 +117,16 @@ func (m *MockAPIClient) GetRecipes() (string, error) {
 	return "Recipe 1: Basic Sword", nil
 }
 
-func (m *MockAPIClient) GetLeaderboard(metric string, limit int) (string, error) {
+func (m *MockAPIClient) GetLeaderboard(eventType string, limit, offset int, aroundPlatformID string) (*domain.LeaderboardPage, error) {
 	if m.GetLeaderboardFunc != nil {
-		return m.GetLeaderboardFunc(metric, limit)
-	}
-	return "1. Player1 - 1000 points", nil
+		return m.GetLeaderboardFunc(eventType, limit, offset, aroundPlatformID)
+	}
+	return &domain.LeaderboardPage{
+		EventType: eventType,
+		Limit:     limit,
+		Offset:    offset,
+		Entries:   []domain.LeaderboardEntry{{Rank: offset + 1, Username: "Player1", Count: 1000}},
+	}, nil
 }
 
 func (m *MockAPIClient) GetUserStats(platform, platformID string) (string, error) {
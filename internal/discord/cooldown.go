@@ -0,0 +1,210 @@
+package discord
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+)
+
+const (
+	// cooldownRemindPrefix prefixes the custom ID of the "Remind me" button:
+	// cooldown_remind:<discord user ID>:<unix time available>:<action>
+	cooldownRemindPrefix = "cooldown_remind:"
+
+	// maxCooldownReminderDelay caps how far ahead a reminder can be scheduled.
+	// Reminders fall back to living in memory when the API can't store them,
+	// and those are lost when the bot restarts.
+	maxCooldownReminderDelay = 24 * time.Hour
+
+	// maxCustomIDLength is Discord's limit on a component custom ID
+	maxCustomIDLength = 100
+)
+
+// respondAPIError responds to a failed API call. Cooldown errors get a live
+// countdown and a "Remind me" button; everything else goes through
+// respondFriendlyError.
+//
+// Usage:
+//
+//	msg, err := client.Search(...)
+//	if err != nil {
+//	    slog.Error("Failed to search", "error", err)
+//	    respondAPIError(s, i, err)
+//	    return
+//	}
+func respondAPIError(s *discordgo.Session, i *discordgo.InteractionCreate, err error) {
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.Cooldown == nil {
+		respondFriendlyError(s, i, err.Error())
+		return
+	}
+
+	content := formatCooldownMessage(apiErr.Cooldown)
+	edit := &discordgo.WebhookEdit{Content: &content}
+	if customID := cooldownRemindCustomID(getInteractionUser(i).ID, apiErr.Cooldown); customID != "" {
+		edit.Components = &[]discordgo.MessageComponent{
+			discordgo.ActionsRow{
+				Components: []discordgo.MessageComponent{
+					discordgo.Button{
+						Label:    "Remind me",
+						Style:    discordgo.SecondaryButton,
+						CustomID: customID,
+						Emoji:    &discordgo.ComponentEmoji{Name: "🔔"},
+					},
+				},
+			},
+		}
+	}
+
+	if _, err := s.InteractionResponseEdit(i.Interaction, edit); err != nil {
+		slog.Error("Failed to edit interaction response", "error", err)
+	}
+}
+
+// formatCooldownMessage renders a cooldown as a Discord relative timestamp,
+// which each client counts down in the reader's own time zone
+func formatCooldownMessage(info *CooldownInfo) string {
+	action := "do that"
+	if info.Action != "" {
+		action = "**" + info.Action + "**"
+	}
+	return fmt.Sprintf(MsgCooldownUntil, action, fmt.Sprintf("<t:%d:R>", info.NextAvailableAt.Unix()))
+}
+
+// cooldownRemindCustomID builds the "Remind me" button ID, or returns "" if
+// the cooldown is too long to remind about
+func cooldownRemindCustomID(userID string, info *CooldownInfo) string {
+	if time.Duration(info.RetryAfterSeconds)*time.Second > maxCooldownReminderDelay {
+		return ""
+	}
+	id := fmt.Sprintf("%s%s:%d:%s", cooldownRemindPrefix, userID, info.NextAvailableAt.Unix(), info.Action)
+	if len(id) > maxCustomIDLength {
+		id = id[:maxCustomIDLength]
+	}
+	return id
+}
+
+// parseCooldownRemindCustomID is the inverse of cooldownRemindCustomID
+func parseCooldownRemindCustomID(customID string) (userID string, availableAt time.Time, action string, ok bool) {
+	parts := strings.SplitN(strings.TrimPrefix(customID, cooldownRemindPrefix), ":", 3)
+	if len(parts) != 3 || parts[0] == "" {
+		return "", time.Time{}, "", false
+	}
+	unix, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		return "", time.Time{}, "", false
+	}
+	return parts[0], time.Unix(unix, 0), parts[2], true
+}
+
+// cooldownReminders holds pending in-memory reminders, one per user and
+// action, for when the API can't deliver them
+type cooldownReminders struct {
+	mu     sync.Mutex
+	timers map[string]*time.Timer
+}
+
+func newCooldownReminders() *cooldownReminders {
+	return &cooldownReminders{timers: make(map[string]*time.Timer)}
+}
+
+// schedule runs fn after delay. A pending reminder with the same key is
+// replaced so repeated clicks don't pile up duplicate pings.
+func (r *cooldownReminders) schedule(key string, delay time.Duration, fn func()) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if existing, ok := r.timers[key]; ok {
+		existing.Stop()
+	}
+
+	var timer *time.Timer
+	timer = time.AfterFunc(delay, func() {
+		r.mu.Lock()
+		if r.timers[key] == timer {
+			delete(r.timers, key)
+		}
+		r.mu.Unlock()
+		fn()
+	})
+	r.timers[key] = timer
+}
+
+// stopAll cancels every pending reminder
+func (r *cooldownReminders) stopAll() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for key, timer := range r.timers {
+		timer.Stop()
+		delete(r.timers, key)
+	}
+}
+
+// handleCooldownRemind schedules a channel ping for when a cooldown ends
+func (b *Bot) handleCooldownRemind(s *discordgo.Session, i *discordgo.InteractionCreate, customID string) {
+	userID, availableAt, action, ok := parseCooldownRemindCustomID(customID)
+	if !ok {
+		respondEphemeral(s, i, "❌ This reminder button is no longer valid.")
+		return
+	}
+	if getInteractionUser(i).ID != userID {
+		respondEphemeral(s, i, "❌ Only the person who hit the cooldown can set this reminder.")
+		return
+	}
+
+	delay := time.Until(availableAt)
+	if delay <= 0 {
+		respondEphemeral(s, i, "✅ Your cooldown is already over!")
+		return
+	}
+	if delay > maxCooldownReminderDelay {
+		respondEphemeral(s, i, "❌ That cooldown is too long to set a reminder for.")
+		return
+	}
+
+	// Stored reminders survive restarts but are delivered over SSE, so they
+	// only work when the bot is listening for events
+	if b.sseClient != nil {
+		_, err := b.Client.CreateReminder(userID, getInteractionUser(i).Username, domain.ReminderKindCooldown, action, &availableAt, i.ChannelID)
+		if err == nil {
+			respondEphemeral(s, i, fmt.Sprintf("🔔 Got it! I'll ping you here <t:%d:R>.", availableAt.Unix()))
+			return
+		}
+		slog.Warn("Failed to store cooldown reminder, keeping it in memory", "error", err, "user_id", userID, "action", action)
+	}
+
+	what := "use that command"
+	if action != "" {
+		what = "**" + action + "**"
+	}
+	channelID := i.ChannelID
+	b.reminders.schedule(userID+":"+action, delay, func() {
+		if _, err := s.ChannelMessageSend(channelID, fmt.Sprintf("🔔 <@%s> you can %s again!", userID, what)); err != nil {
+			slog.Error("Failed to send cooldown reminder", "error", err, "user_id", userID, "action", action)
+		}
+	})
+
+	respondEphemeral(s, i, fmt.Sprintf("🔔 Got it! I'll ping you here <t:%d:R>.", availableAt.Unix()))
+}
+
+// respondEphemeral replies to a component interaction with a message only the clicker can see
+func respondEphemeral(s *discordgo.Session, i *discordgo.InteractionCreate, message string) {
+	if err := s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseChannelMessageWithSource,
+		Data: &discordgo.InteractionResponseData{
+			Content: message,
+			Flags:   discordgo.MessageFlagsEphemeral,
+		},
+	}); err != nil {
+		slog.Error("Failed to send ephemeral response", "error", err)
+	}
+}
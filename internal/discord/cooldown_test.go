@@ -0,0 +1,68 @@
+package discord
+
+import (
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCooldownRemindCustomID(t *testing.T) {
+	available := time.Unix(1772366490, 0)
+	info := &CooldownInfo{Action: "start an expedition", RetryAfterSeconds: 90, NextAvailableAt: available}
+
+	customID := cooldownRemindCustomID("1234", info)
+	assert.Equal(t, "cooldown_remind:1234:1772366490:start an expedition", customID)
+
+	userID, availableAt, action, ok := parseCooldownRemindCustomID(customID)
+	require.True(t, ok)
+	assert.Equal(t, "1234", userID)
+	assert.True(t, available.Equal(availableAt))
+	assert.Equal(t, "start an expedition", action)
+
+	t.Run("no button for cooldowns beyond the reminder cap", func(t *testing.T) {
+		long := &CooldownInfo{RetryAfterSeconds: int((48 * time.Hour).Seconds()), NextAvailableAt: available}
+		assert.Empty(t, cooldownRemindCustomID("1234", long))
+	})
+
+	t.Run("malformed IDs are rejected", func(t *testing.T) {
+		_, _, _, ok := parseCooldownRemindCustomID("cooldown_remind:1234:soon:search")
+		assert.False(t, ok)
+		_, _, _, ok = parseCooldownRemindCustomID("cooldown_remind:1234")
+		assert.False(t, ok)
+	})
+}
+
+func TestFormatCooldownMessage(t *testing.T) {
+	info := &CooldownInfo{Action: "search", NextAvailableAt: time.Unix(1772366490, 0)}
+	assert.Contains(t, formatCooldownMessage(info), "You can **search** again <t:1772366490:R>.")
+
+	info.Action = ""
+	assert.Contains(t, formatCooldownMessage(info), "You can do that again <t:1772366490:R>.")
+}
+
+func TestCooldownReminders_ReplacesPendingReminder(t *testing.T) {
+	r := newCooldownReminders()
+	var first, second atomic.Int32
+	done := make(chan struct{})
+
+	r.schedule("user:search", time.Hour, func() { first.Add(1) })
+	r.schedule("user:search", 10*time.Millisecond, func() {
+		second.Add(1)
+		close(done)
+	})
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("reminder did not fire")
+	}
+	assert.Equal(t, int32(0), first.Load())
+	assert.Equal(t, int32(1), second.Load())
+
+	r.schedule("user:search", time.Hour, func() { first.Add(1) })
+	r.stopAll()
+	assert.Empty(t, r.timers)
+}
@@ -173,16 +173,16 @@ func TestStatsCommands(t *testing.T) {
 	mock := &MockAPIClient{}
 
 	// Test leaderboard
-	result, err := mock.GetLeaderboard("money", 10)
+	board, err := mock.GetLeaderboard("message_received", 10, 0, "")
 	if err != nil {
 		t.Errorf("Unexpected error: %v", err)
 	}
-	if result == "" {
+	if len(board.Entries) == 0 {
 		t.Error("Expected non-empty leaderboard")
 	}
 
 	// Test user stats
-	result, err = mock.GetUserStats("discord", "123")
+	result, err := mock.GetUserStats("discord", "123")
 	if err != nil {
 		t.Errorf("Unexpected error: %v", err)
 	}
@@ -1,6 +1,9 @@
 package discord
 
-import "github.com/osse101/BrandishBot_Go/internal/user"
+import (
+	"fmt"
+	"time"
+)
 
 // SimpleInventoryItem represents a stripped-down inventory item for display purposes
 type SimpleInventoryItem struct {
@@ -8,10 +11,10 @@ type SimpleInventoryItem struct {
 	Quantity int
 }
 
-// ConvertToSimpleInventory converts a slice of user.InventoryItem (with full metadata)
+// ConvertToSimpleInventory converts a slice of InventoryItem (with full metadata)
 // to a simplified format containing only name and quantity for Discord display.
 // This helper eliminates duplication when converting inventory API response types.
-func ConvertToSimpleInventory(inventoryItems []user.InventoryItem) []SimpleInventoryItem {
+func ConvertToSimpleInventory(inventoryItems []InventoryItem) []SimpleInventoryItem {
 	itemsMap := make(map[string]int)
 	itemOrder := make([]string, 0)
 
@@ -31,3 +34,23 @@ func ConvertToSimpleInventory(inventoryItems []user.InventoryItem) []SimpleInven
 	}
 	return items
 }
+
+// formatAPITime re-renders an RFC 3339 timestamp from the API with layout,
+// falling back to the raw value when it does not parse
+func formatAPITime(value, layout string) string {
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return value
+	}
+	return t.Format(layout)
+}
+
+// relativeAPITime renders an RFC 3339 timestamp from the API as a Discord
+// relative timestamp, falling back to the raw value when it does not parse
+func relativeAPITime(value string) string {
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return value
+	}
+	return fmt.Sprintf("<t:%d:R>", t.Unix())
+}
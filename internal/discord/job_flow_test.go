@@ -9,6 +9,7 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/pkg/apiclient"
 )
 
 func TestJobFlow_Integration(t *testing.T) {
@@ -28,21 +29,17 @@ func TestJobFlow_Integration(t *testing.T) {
 	})
 
 	// Mock AdminAwardXP
-	mux.HandleFunc("/api/v1/admin/job/award-xp", func(w http.ResponseWriter, r *http.Request) {
+	mux.HandleFunc("/api/v1/admin/jobs/award-xp", func(w http.ResponseWriter, r *http.Request) {
 		assert.Equal(t, http.MethodPost, r.Method)
 
-		var resp struct {
-			Success bool `json:"success"`
-			Result  struct {
-				LeveledUp bool `json:"leveled_up"`
-				NewLevel  int  `json:"new_level"`
-				NewXP     int  `json:"new_xp"`
-			} `json:"result"`
+		resp := apiclient.AdminAwardXPResponse{
+			Success: true,
+			Result: &apiclient.XPAwardResult{
+				LeveledUp: true,
+				NewLevel:  2,
+				NewXP:     100,
+			},
 		}
-		resp.Success = true
-		resp.Result.LeveledUp = true
-		resp.Result.NewLevel = 2
-		resp.Result.NewXP = 100
 
 		w.WriteHeader(http.StatusOK)
 		json.NewEncoder(w).Encode(resp)
@@ -57,13 +54,13 @@ func TestJobFlow_Integration(t *testing.T) {
 		resp := UserJobsResponse{
 			Platform:   domain.PlatformDiscord,
 			PlatformID: "12345",
-			PrimaryJob: &domain.UserJobInfo{
+			PrimaryJob: &apiclient.UserJobInfo{
 				JobKey:      domain.JobKeyBlacksmith,
 				DisplayName: "Blacksmith",
 				Level:       2,
 				CurrentXP:   100,
 			},
-			Jobs: []domain.UserJobInfo{
+			Jobs: []apiclient.UserJobInfo{
 				{
 					JobKey:      domain.JobKeyBlacksmith,
 					DisplayName: "Blacksmith",
@@ -88,7 +85,7 @@ func TestJobFlow_Integration(t *testing.T) {
 	// A. Create/Register user
 	user, err := client.RegisterUser("Tester", "12345")
 	assert.NoError(t, err)
-	assert.Equal(t, "user-123", user.ID)
+	assert.Equal(t, "user-123", user.InternalID)
 
 	// B. Award XP
 	awardResult, err := client.AdminAwardXP(domain.PlatformDiscord, "Tester", domain.JobKeyBlacksmith, 100)
@@ -17,6 +17,7 @@ const (
 
 	// Cooldowns
 	MsgCooldownActive = "⏳ **Whoa there!**\nYou need to wait a bit before doing that again."
+	MsgCooldownUntil  = "⏳ **Whoa there!**\nYou can %s again %s." // action, Discord relative timestamp
 	MsgFeatureLocked  = "🔒 **Feature Locked**"
 
 	MsgGenericError = "❌ Something went wrong."
@@ -0,0 +1,85 @@
+package discord
+
+import (
+	"encoding/json"
+	"log/slog"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+)
+
+// AnnouncementPayload is the payload for routed announcement events
+type AnnouncementPayload struct {
+	RouteID     int64                          `json:"route_id"`
+	EventType   string                         `json:"event_type"`
+	Destination domain.AnnouncementDestination `json:"destination"`
+	Target      string                         `json:"target"`
+	Message     string                         `json:"message"`
+}
+
+// LoadAnnouncementRoutes fetches which events announcement routes post to
+// Discord. On failure the previous list is kept, so a brief API outage does
+// not bring back announcements a route replaced.
+func (n *SSENotifier) LoadAnnouncementRoutes() {
+	eventTypes, err := n.client.GetDiscordAnnouncementEvents()
+	if err != nil {
+		slog.Warn(sseLogMsgRoutesLoadError, "error", err)
+		return
+	}
+	n.setRoutedEvents(eventTypes)
+	slog.Info(sseLogMsgRoutesLoaded, "event_types", eventTypes)
+}
+
+func (n *SSENotifier) setRoutedEvents(eventTypes []string) {
+	routed := make(map[string]bool, len(eventTypes))
+	for _, eventType := range eventTypes {
+		routed[eventType] = true
+	}
+
+	n.routedMu.Lock()
+	defer n.routedMu.Unlock()
+	n.routed = routed
+}
+
+func (n *SSENotifier) isRouted(eventType string) bool {
+	n.routedMu.RLock()
+	defer n.routedMu.RUnlock()
+	return n.routed[eventType]
+}
+
+// unlessRouted wraps a built-in announcement so it is skipped while an
+// announcement route posts the event to Discord instead
+func (n *SSENotifier) unlessRouted(handler SSEEventHandler) SSEEventHandler {
+	return func(event SSEEvent) error {
+		if n.isRouted(event.Type) {
+			return nil
+		}
+		return handler(event)
+	}
+}
+
+// handleAnnouncement posts a routed message to the channel its route names
+func (n *SSENotifier) handleAnnouncement(event SSEEvent) error {
+	var payload AnnouncementPayload
+	if err := json.Unmarshal(event.Payload, &payload); err != nil {
+		slog.Warn(sseLogMsgParseError, "error", err, "event_type", event.Type)
+		return nil
+	}
+	if payload.Destination != domain.AnnouncementDestinationDiscord || payload.Target == "" || payload.Message == "" {
+		return nil
+	}
+
+	if _, err := n.session.ChannelMessageSend(payload.Target, payload.Message); err != nil {
+		slog.Error(sseLogMsgNotificationError, "error", err, "event_type", payload.EventType, "route_id", payload.RouteID)
+		return err
+	}
+
+	slog.Info(sseLogMsgNotificationSent, "event_type", payload.EventType, "route_id", payload.RouteID, "channel_id", payload.Target)
+	return nil
+}
+
+// handleAnnouncementRoutesChanged reloads the routed events after an admin
+// edits the routes
+func (n *SSENotifier) handleAnnouncementRoutesChanged(_ SSEEvent) error {
+	n.LoadAnnouncementRoutes()
+	return nil
+}
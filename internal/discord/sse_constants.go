@@ -42,6 +42,48 @@ const (
 
 	// SSEEventTypeExpeditionCompleted is the event type for expedition completion
 	SSEEventTypeExpeditionCompleted = "expedition.completed"
+
+	// SSEEventTypeCelebration is the event type for birthday and anniversary rewards
+	SSEEventTypeCelebration = "celebration"
+
+	// SSEEventTypeGambleRecovered is the event type for stale gambles resolved by the recovery job
+	SSEEventTypeGambleRecovered = "gamble.recovered"
+
+	// SSEEventTypeVotesFlagged is the event type for votes flagged by brigading detection
+	SSEEventTypeVotesFlagged = "progression.votes_flagged"
+
+	// SSEEventTypeGiveFlagged is the event type for gives flagged as likely funneling
+	SSEEventTypeGiveFlagged = "give.flagged"
+
+	// SSEEventTypeReminderDue is the event type for a user reminder falling due
+	SSEEventTypeReminderDue = "reminder.due"
+
+	// SSEEventTypeStreamRecap is the event type for the recap of a stream that just ended
+	SSEEventTypeStreamRecap = "stream.recap"
+
+	// SSEEventTypeDigMilestone is the event type for the dig site reaching a new level
+	SSEEventTypeDigMilestone = "digging.milestone"
+
+	// SSEEventTypeFishingBite is the event type for a fish biting a user's line
+	SSEEventTypeFishingBite = "fishing.bite"
+
+	// SSEEventTypeBossSpawned is the event type for a community boss appearing
+	SSEEventTypeBossSpawned = "boss.spawned"
+
+	// SSEEventTypeBossDefeated is the event type for the community boss falling
+	SSEEventTypeBossDefeated = "boss.defeated"
+
+	// SSEEventTypeBossEscaped is the event type for the community boss escaping before it fell
+	SSEEventTypeBossEscaped = "boss.escaped"
+
+	// SSEEventTypeGiftDelivered is the event type for a wrapped gift reaching its recipient
+	SSEEventTypeGiftDelivered = "gift.delivered"
+
+	// SSEEventTypeAnnouncement is the event type for a message an announcement route rendered for a Discord channel
+	SSEEventTypeAnnouncement = "announcement"
+
+	// SSEEventTypeAnnouncementRoutesChanged is the event type for a change to the announcement routes
+	SSEEventTypeAnnouncementRoutesChanged = "announcement.routes_changed"
 )
 
 // SSE log messages
@@ -54,4 +96,6 @@ const (
 	sseLogMsgEventReceived     = "SSE event received"
 	sseLogMsgNotificationSent  = "Discord notification sent"
 	sseLogMsgNotificationError = "Failed to send Discord notification"
+	sseLogMsgRoutesLoadError   = "Failed to load announcement routes"
+	sseLogMsgRoutesLoaded      = "Announcement routes loaded"
 )
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"log/slog"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/bwmarrin/discordgo"
@@ -15,29 +16,54 @@ import (
 // SSENotifier handles sending Discord notifications for SSE events
 type SSENotifier struct {
 	session            *discordgo.Session
+	client             *APIClient
 	notificationChanID string
 	devChannelID       string
+	diggingChanID      string // dig site milestones; the notification channel when empty
+
+	// routedMu guards routed, the event types an announcement route posts
+	// to Discord in place of the built-in announcement
+	routedMu sync.RWMutex
+	routed   map[string]bool
 }
 
 // NewSSENotifier creates a new SSE notifier
-func NewSSENotifier(session *discordgo.Session, notificationChanID, devChannelID string) *SSENotifier {
+func NewSSENotifier(session *discordgo.Session, client *APIClient, notificationChanID, devChannelID, diggingChanID string) *SSENotifier {
 	return &SSENotifier{
 		session:            session,
+		client:             client,
 		notificationChanID: notificationChanID,
 		devChannelID:       devChannelID,
+		diggingChanID:      diggingChanID,
 	}
 }
 
-// RegisterHandlers registers all SSE event handlers with the client
+// RegisterHandlers registers all SSE event handlers with the client.
+// Built-in announcements are skipped for events an announcement route posts
+// to Discord instead.
 func (n *SSENotifier) RegisterHandlers(client *SSEClient) {
-	client.OnEvent(SSEEventTypeJobLevelUp, n.handleJobLevelUp)
-	client.OnEvent(SSEEventTypeVotingStarted, n.handleVotingStarted)
-	client.OnEvent(SSEEventTypeCycleCompleted, n.handleCycleCompleted)
-	client.OnEvent(SSEEventTypeAllUnlocked, n.handleAllUnlocked)
-	client.OnEvent(SSEEventTypeGambleCompleted, n.handleGambleCompleted)
-	client.OnEvent(SSEEventTypeExpeditionStarted, n.handleExpeditionStarted)
+	client.OnEvent(SSEEventTypeJobLevelUp, n.unlessRouted(n.handleJobLevelUp))
+	client.OnEvent(SSEEventTypeVotingStarted, n.unlessRouted(n.handleVotingStarted))
+	client.OnEvent(SSEEventTypeCycleCompleted, n.unlessRouted(n.handleCycleCompleted))
+	client.OnEvent(SSEEventTypeAllUnlocked, n.unlessRouted(n.handleAllUnlocked))
+	client.OnEvent(SSEEventTypeGambleCompleted, n.unlessRouted(n.handleGambleCompleted))
+	client.OnEvent(SSEEventTypeExpeditionStarted, n.unlessRouted(n.handleExpeditionStarted))
 	client.OnEvent(SSEEventTypeExpeditionTurn, n.handleExpeditionTurn)
-	client.OnEvent(SSEEventTypeExpeditionCompleted, n.handleExpeditionCompleted)
+	client.OnEvent(SSEEventTypeExpeditionCompleted, n.unlessRouted(n.handleExpeditionCompleted))
+	client.OnEvent(SSEEventTypeCelebration, n.unlessRouted(n.handleCelebration))
+	client.OnEvent(SSEEventTypeGambleRecovered, n.unlessRouted(n.handleGambleRecovered))
+	client.OnEvent(SSEEventTypeVotesFlagged, n.unlessRouted(n.handleVotesFlagged))
+	client.OnEvent(SSEEventTypeGiveFlagged, n.unlessRouted(n.handleGiveFlagged))
+	client.OnEvent(SSEEventTypeReminderDue, n.handleReminderDue)
+	client.OnEvent(SSEEventTypeGiftDelivered, n.handleGiftDelivered)
+	client.OnEvent(SSEEventTypeStreamRecap, n.unlessRouted(n.handleStreamRecap))
+	client.OnEvent(SSEEventTypeDigMilestone, n.unlessRouted(n.handleDigMilestone))
+	client.OnEvent(SSEEventTypeFishingBite, n.handleFishingBite)
+	client.OnEvent(SSEEventTypeBossSpawned, n.unlessRouted(n.handleBossSpawned))
+	client.OnEvent(SSEEventTypeBossDefeated, n.unlessRouted(n.handleBossDefeated))
+	client.OnEvent(SSEEventTypeBossEscaped, n.unlessRouted(n.handleBossEscaped))
+	client.OnEvent(SSEEventTypeAnnouncement, n.handleAnnouncement)
+	client.OnEvent(SSEEventTypeAnnouncementRoutesChanged, n.handleAnnouncementRoutesChanged)
 }
 
 // JobLevelUpPayload is the payload for job level up events
@@ -97,6 +123,7 @@ type AllUnlockedPayload struct {
 // GambleCompletedPayload is the payload for gamble completed events
 type GambleCompletedPayload struct {
 	GambleID         string `json:"gamble_id"`
+	ShortCode        string `json:"short_code,omitempty"`
 	WinnerID         string `json:"winner_id"`
 	WinnerUsername   string `json:"winner_username,omitempty"`
 	TotalValue       int64  `json:"total_value"`
@@ -372,13 +399,18 @@ func (n *SSENotifier) handleGambleCompleted(event SSEEvent) error {
 		color = 0x95A5A6 // Grey
 	}
 
+	footer := "Gamble System"
+	if payload.ShortCode != "" {
+		footer += " • " + payload.ShortCode
+	}
+
 	embed := &discordgo.MessageEmbed{
 		Title:       title,
 		Description: description,
 		Color:       color,
 		Timestamp:   time.Now().Format(time.RFC3339),
 		Footer: &discordgo.MessageEmbedFooter{
-			Text: "Gamble System",
+			Text: footer,
 		},
 	}
 
@@ -578,6 +610,584 @@ func (n *SSENotifier) handleExpeditionCompleted(event SSEEvent) error {
 	return nil
 }
 
+// CelebrationPayload is the payload for birthday and anniversary rewards
+type CelebrationPayload struct {
+	UserID   string `json:"user_id"`
+	Username string `json:"username"`
+	Kind     string `json:"kind"`
+	Years    int    `json:"years,omitempty"`
+	ItemName string `json:"item_name"`
+	Quantity int    `json:"quantity"`
+}
+
+func (n *SSENotifier) handleCelebration(event SSEEvent) error {
+	if n.notificationChanID == "" {
+		return nil
+	}
+
+	var payload CelebrationPayload
+	if err := json.Unmarshal(event.Payload, &payload); err != nil {
+		slog.Warn(sseLogMsgParseError, "error", err, "event_type", event.Type)
+		return nil
+	}
+
+	// Announcements are opt-in per guild
+	if !n.celebrationsEnabled() {
+		return nil
+	}
+
+	title := fmt.Sprintf("Happy Birthday, %s!", payload.Username)
+	description := fmt.Sprintf("**%s** is celebrating today and received **%s x%d**!", payload.Username, payload.ItemName, payload.Quantity)
+	if payload.Kind == domain.CelebrationAnniversary {
+		years := "year"
+		if payload.Years != 1 {
+			years = "years"
+		}
+		title = fmt.Sprintf("Happy Anniversary, %s!", payload.Username)
+		description = fmt.Sprintf("**%s** joined **%d %s** ago today and received **%s x%d**!", payload.Username, payload.Years, years, payload.ItemName, payload.Quantity)
+	}
+
+	embed := &discordgo.MessageEmbed{
+		Title:       title,
+		Description: description,
+		Color:       0xFF69B4, // Pink
+		Timestamp:   time.Now().Format(time.RFC3339),
+		Footer: &discordgo.MessageEmbedFooter{
+			Text: "Celebrations",
+		},
+	}
+
+	_, err := n.session.ChannelMessageSendEmbed(n.notificationChanID, embed)
+	if err != nil {
+		slog.Error(sseLogMsgNotificationError, "error", err, "event_type", event.Type)
+		return err
+	}
+
+	slog.Info(sseLogMsgNotificationSent, "event_type", event.Type, "kind", payload.Kind, "user_id", payload.UserID)
+	return nil
+}
+
+// GambleRecoveredPayload is the payload for stale gamble recoveries
+type GambleRecoveredPayload struct {
+	GambleID      string `json:"gamble_id"`
+	ShortCode     string `json:"short_code"`
+	PreviousState string `json:"previous_state"`
+	Action        string `json:"action"`
+	Participants  int    `json:"participants"`
+	Reason        string `json:"reason,omitempty"`
+}
+
+// handleGambleRecovered alerts the dev channel that a gamble was stuck and had to be recovered
+func (n *SSENotifier) handleGambleRecovered(event SSEEvent) error {
+	if n.devChannelID == "" {
+		return nil
+	}
+
+	var payload GambleRecoveredPayload
+	if err := json.Unmarshal(event.Payload, &payload); err != nil {
+		slog.Warn(sseLogMsgParseError, "error", err, "event_type", event.Type)
+		return nil
+	}
+
+	fields := []*discordgo.MessageEmbedField{
+		{Name: "Gamble", Value: payload.ShortCode, Inline: true},
+		{Name: "Stuck In", Value: payload.PreviousState, Inline: true},
+		{Name: "Participants", Value: fmt.Sprintf("%d", payload.Participants), Inline: true},
+	}
+	if payload.Reason != "" {
+		fields = append(fields, &discordgo.MessageEmbedField{Name: "Resume Failed", Value: payload.Reason})
+	}
+
+	embed := &discordgo.MessageEmbed{
+		Title:       "Stale Gamble Recovered",
+		Description: fmt.Sprintf("A gamble was left unresolved and has been **%s**.", payload.Action),
+		Color:       0xFFA500, // Orange
+		Fields:      fields,
+		Timestamp:   time.Now().Format(time.RFC3339),
+		Footer: &discordgo.MessageEmbedFooter{
+			Text: "Gamble Recovery",
+		},
+	}
+
+	_, err := n.session.ChannelMessageSendEmbed(n.devChannelID, embed)
+	if err != nil {
+		slog.Error(sseLogMsgNotificationError, "error", err, "event_type", event.Type)
+		return err
+	}
+
+	slog.Info(sseLogMsgNotificationSent, "event_type", event.Type, "gamble_id", payload.GambleID, "action", payload.Action)
+	return nil
+}
+
+// VotesFlaggedPayload is the payload for votes flagged by brigading detection
+type VotesFlaggedPayload struct {
+	SessionID int    `json:"session_id"`
+	Flagged   int    `json:"flagged"`
+	Excluded  int    `json:"excluded"`
+	Reason    string `json:"reason"`
+}
+
+// handleVotesFlagged asks admins in the dev channel to review suspect votes
+func (n *SSENotifier) handleVotesFlagged(event SSEEvent) error {
+	if n.devChannelID == "" {
+		return nil
+	}
+
+	var payload VotesFlaggedPayload
+	if err := json.Unmarshal(event.Payload, &payload); err != nil {
+		slog.Warn(sseLogMsgParseError, "error", err, "event_type", event.Type)
+		return nil
+	}
+
+	description := fmt.Sprintf("%d vote(s) in session #%d look like brigading and need review.", payload.Flagged, payload.SessionID)
+	if payload.Excluded > 0 {
+		description = fmt.Sprintf("%d vote(s) in session #%d look like brigading; %d were excluded from the tally automatically.",
+			payload.Flagged, payload.SessionID, payload.Excluded)
+	}
+
+	embed := &discordgo.MessageEmbed{
+		Title:       "Suspect Votes Flagged",
+		Description: description,
+		Color:       0xFFA500, // Orange
+		Fields: []*discordgo.MessageEmbedField{
+			{Name: "Reason", Value: payload.Reason},
+		},
+		Timestamp: time.Now().Format(time.RFC3339),
+		Footer: &discordgo.MessageEmbedFooter{
+			Text: "Vote Brigading Detection",
+		},
+	}
+
+	_, err := n.session.ChannelMessageSendEmbed(n.devChannelID, embed)
+	if err != nil {
+		slog.Error(sseLogMsgNotificationError, "error", err, "event_type", event.Type)
+		return err
+	}
+
+	slog.Info(sseLogMsgNotificationSent, "event_type", event.Type, "session_id", payload.SessionID, "flagged", payload.Flagged)
+	return nil
+}
+
+// GiveFlaggedPayload is the payload for a give flagged as likely funneling
+type GiveFlaggedPayload struct {
+	FlagID     int64  `json:"flag_id"`
+	GiverID    string `json:"giver_id"`
+	ReceiverID string `json:"receiver_id"`
+	ItemName   string `json:"item_name"`
+	Quantity   int    `json:"quantity"`
+	Reason     string `json:"reason"`
+}
+
+// handleGiveFlagged asks admins in the dev channel to review a suspect give
+func (n *SSENotifier) handleGiveFlagged(event SSEEvent) error {
+	if n.devChannelID == "" {
+		return nil
+	}
+
+	var payload GiveFlaggedPayload
+	if err := json.Unmarshal(event.Payload, &payload); err != nil {
+		slog.Warn(sseLogMsgParseError, "error", err, "event_type", event.Type)
+		return nil
+	}
+
+	embed := &discordgo.MessageEmbed{
+		Title:       "Suspect Give Flagged",
+		Description: fmt.Sprintf("Give #%d of %dx %s looks like items being passed between accounts and needs review.", payload.FlagID, payload.Quantity, payload.ItemName),
+		Color:       0xFFA500, // Orange
+		Fields: []*discordgo.MessageEmbedField{
+			{Name: "Giver", Value: payload.GiverID, Inline: true},
+			{Name: "Receiver", Value: payload.ReceiverID, Inline: true},
+			{Name: "Reason", Value: payload.Reason},
+		},
+		Timestamp: time.Now().Format(time.RFC3339),
+		Footer: &discordgo.MessageEmbedFooter{
+			Text: "Give Funneling Detection",
+		},
+	}
+
+	_, err := n.session.ChannelMessageSendEmbed(n.devChannelID, embed)
+	if err != nil {
+		slog.Error(sseLogMsgNotificationError, "error", err, "event_type", event.Type)
+		return err
+	}
+
+	slog.Info(sseLogMsgNotificationSent, "event_type", event.Type, "flag_id", payload.FlagID)
+	return nil
+}
+
+// ReminderDuePayload is the payload for a reminder that has fallen due
+type ReminderDuePayload struct {
+	ReminderID int64  `json:"reminder_id"`
+	Platform   string `json:"platform"`
+	PlatformID string `json:"platform_id"`
+	Kind       string `json:"kind"`
+	Message    string `json:"message"`
+	ChannelID  string `json:"channel_id,omitempty"`
+}
+
+// handleReminderDue pings a Discord user in the channel they asked from, or
+// by DM when the reminder has no channel
+func (n *SSENotifier) handleReminderDue(event SSEEvent) error {
+	var payload ReminderDuePayload
+	if err := json.Unmarshal(event.Payload, &payload); err != nil {
+		slog.Warn(sseLogMsgParseError, "error", err, "event_type", event.Type)
+		return nil
+	}
+	if payload.Platform != domain.PlatformDiscord {
+		return nil
+	}
+
+	channelID := payload.ChannelID
+	if channelID == "" {
+		dm, err := n.session.UserChannelCreate(payload.PlatformID)
+		if err != nil {
+			slog.Error(sseLogMsgNotificationError, "error", err, "event_type", event.Type, "reminder_id", payload.ReminderID)
+			return err
+		}
+		channelID = dm.ID
+	}
+
+	_, err := n.session.ChannelMessageSend(channelID, fmt.Sprintf("🔔 <@%s> %s", payload.PlatformID, payload.Message))
+	if err != nil {
+		slog.Error(sseLogMsgNotificationError, "error", err, "event_type", event.Type, "reminder_id", payload.ReminderID)
+		return err
+	}
+
+	slog.Info(sseLogMsgNotificationSent, "event_type", event.Type, "reminder_id", payload.ReminderID, "kind", payload.Kind)
+	return nil
+}
+
+// GiftDeliveredPayload is the payload for a gift reaching its recipient
+type GiftDeliveredPayload struct {
+	GiftID              int64  `json:"gift_id"`
+	SenderName          string `json:"sender_name"`
+	Platform            string `json:"platform"`
+	RecipientPlatformID string `json:"recipient_platform_id"`
+	ChannelID           string `json:"channel_id,omitempty"`
+	ItemName            string `json:"item_name"`
+	Quantity            int    `json:"quantity"`
+	Message             string `json:"message,omitempty"`
+}
+
+// handleGiftDelivered tells a Discord user their gift has arrived, in the
+// channel it was wrapped in or by DM when the gift has no channel
+func (n *SSENotifier) handleGiftDelivered(event SSEEvent) error {
+	var payload GiftDeliveredPayload
+	if err := json.Unmarshal(event.Payload, &payload); err != nil {
+		slog.Warn(sseLogMsgParseError, "error", err, "event_type", event.Type)
+		return nil
+	}
+	if payload.Platform != domain.PlatformDiscord || payload.RecipientPlatformID == "" {
+		return nil
+	}
+
+	channelID := payload.ChannelID
+	if channelID == "" {
+		dm, err := n.session.UserChannelCreate(payload.RecipientPlatformID)
+		if err != nil {
+			slog.Error(sseLogMsgNotificationError, "error", err, "event_type", event.Type, "gift_id", payload.GiftID)
+			return err
+		}
+		channelID = dm.ID
+	}
+
+	_, err := n.session.ChannelMessageSendComplex(channelID, &discordgo.MessageSend{
+		Content: fmt.Sprintf("<@%s>", payload.RecipientPlatformID),
+		Embeds:  []*discordgo.MessageEmbed{giftDeliveredEmbed(payload)},
+	})
+	if err != nil {
+		slog.Error(sseLogMsgNotificationError, "error", err, "event_type", event.Type, "gift_id", payload.GiftID)
+		return err
+	}
+
+	slog.Info(sseLogMsgNotificationSent, "event_type", event.Type, "gift_id", payload.GiftID)
+	return nil
+}
+
+// StreamRecapPayload is the payload for the recap of a stream that just
+// ended
+type StreamRecapPayload struct {
+	Recap domain.StreamRecap `json:"recap"`
+}
+
+// handleStreamRecap posts the recap of the stream that just ended to the
+// notification channel
+func (n *SSENotifier) handleStreamRecap(event SSEEvent) error {
+	var payload StreamRecapPayload
+	if err := json.Unmarshal(event.Payload, &payload); err != nil {
+		slog.Warn(sseLogMsgParseError, "error", err, "event_type", event.Type)
+		return nil
+	}
+
+	embed := streamRecapEmbed(&payload.Recap)
+	_, err := n.session.ChannelMessageSendEmbed(n.notificationChanID, embed)
+	if err != nil {
+		slog.Error(sseLogMsgNotificationError, "error", err, "event_type", event.Type)
+		return err
+	}
+
+	slog.Info(sseLogMsgNotificationSent, "event_type", event.Type, "session_id", payload.Recap.Summary.Session.ID)
+	return nil
+}
+
+// DigMilestonePayload is the payload for the dig site reaching a new level
+type DigMilestonePayload struct {
+	Level        int    `json:"level"`
+	Name         string `json:"name"`
+	Depth        int    `json:"depth"`
+	Contribution int    `json:"contribution"`
+	Username     string `json:"username"`
+}
+
+// handleDigMilestone announces the dig site reaching a new level in the
+// digging game channel
+func (n *SSENotifier) handleDigMilestone(event SSEEvent) error {
+	var payload DigMilestonePayload
+	if err := json.Unmarshal(event.Payload, &payload); err != nil {
+		slog.Warn(sseLogMsgParseError, "error", err, "event_type", event.Type)
+		return nil
+	}
+
+	channelID := n.diggingChanID
+	if channelID == "" {
+		channelID = n.notificationChanID
+	}
+
+	description := fmt.Sprintf("**%s** broke through into **%s** at **%dm**!", payload.Username, payload.Name, payload.Depth)
+	if payload.Contribution > 0 {
+		description += fmt.Sprintf("\nThe community earned **%d** contribution toward the current unlock.", payload.Contribution)
+	}
+	embed := createEmbed("🎉 Dig Site Milestone", description, digColor, "")
+	if _, err := n.session.ChannelMessageSendEmbed(channelID, embed); err != nil {
+		slog.Error(sseLogMsgNotificationError, "error", err, "event_type", event.Type)
+		return err
+	}
+
+	slog.Info(sseLogMsgNotificationSent, "event_type", event.Type, "level", payload.Level)
+	return nil
+}
+
+// FishingBitePayload is the payload for a fish biting a user's line
+type FishingBitePayload struct {
+	Platform   string `json:"platform"`
+	PlatformID string `json:"platform_id"`
+	ChannelID  string `json:"channel_id,omitempty"`
+	ReelBy     int64  `json:"reel_by"`
+}
+
+// handleFishingBite pings a Discord user with a "Reel in" button in the
+// channel they cast from, or by DM when the cast has no channel
+func (n *SSENotifier) handleFishingBite(event SSEEvent) error {
+	var payload FishingBitePayload
+	if err := json.Unmarshal(event.Payload, &payload); err != nil {
+		slog.Warn(sseLogMsgParseError, "error", err, "event_type", event.Type)
+		return nil
+	}
+	if payload.Platform != domain.PlatformDiscord {
+		return nil
+	}
+
+	channelID := payload.ChannelID
+	if channelID == "" {
+		dm, err := n.session.UserChannelCreate(payload.PlatformID)
+		if err != nil {
+			slog.Error(sseLogMsgNotificationError, "error", err, "event_type", event.Type)
+			return err
+		}
+		channelID = dm.ID
+	}
+
+	_, err := n.session.ChannelMessageSendComplex(channelID, &discordgo.MessageSend{
+		Content:    fmt.Sprintf("🎣 <@%s> something's biting! Reel in <t:%d:R>!", payload.PlatformID, payload.ReelBy),
+		Components: []discordgo.MessageComponent{fishReelButton(payload.PlatformID)},
+	})
+	if err != nil {
+		slog.Error(sseLogMsgNotificationError, "error", err, "event_type", event.Type)
+		return err
+	}
+
+	slog.Info(sseLogMsgNotificationSent, "event_type", event.Type, "platform_id", payload.PlatformID)
+	return nil
+}
+
+// BossSpawnedPayload is the payload for a community boss appearing
+type BossSpawnedPayload struct {
+	Name        string `json:"name"`
+	MaxHP       int    `json:"max_hp"`
+	RewardBoxes int    `json:"reward_boxes"`
+	ExpiresAt   int64  `json:"expires_at"`
+}
+
+// handleBossSpawned calls the community to arms against a new boss
+func (n *SSENotifier) handleBossSpawned(event SSEEvent) error {
+	var payload BossSpawnedPayload
+	if err := json.Unmarshal(event.Payload, &payload); err != nil {
+		slog.Warn(sseLogMsgParseError, "error", err, "event_type", event.Type)
+		return nil
+	}
+
+	description := fmt.Sprintf("**%s** has appeared with **%d HP**!\nUse `/attack` to bring it down before it escapes <t:%d:R>. **%d** lootboxes go to the attackers by damage dealt.",
+		payload.Name, payload.MaxHP, payload.ExpiresAt, payload.RewardBoxes)
+	embed := createEmbed("⚔️ A Boss Appears", description, bossColor, "")
+	if _, err := n.session.ChannelMessageSendEmbed(n.notificationChanID, embed); err != nil {
+		slog.Error(sseLogMsgNotificationError, "error", err, "event_type", event.Type)
+		return err
+	}
+
+	slog.Info(sseLogMsgNotificationSent, "event_type", event.Type, "boss", payload.Name)
+	return nil
+}
+
+// BossSharePayload is an attacker's part in a defeated boss
+type BossSharePayload struct {
+	Username string `json:"username"`
+	Damage   int    `json:"damage"`
+	Boxes    int    `json:"boxes"`
+}
+
+// BossDefeatedPayload is the payload for the community boss falling
+type BossDefeatedPayload struct {
+	Name       string             `json:"name"`
+	KillerName string             `json:"killer_name"`
+	Shares     []BossSharePayload `json:"shares"`
+}
+
+// handleBossDefeated announces the boss falling and how its loot was shared
+func (n *SSENotifier) handleBossDefeated(event SSEEvent) error {
+	var payload BossDefeatedPayload
+	if err := json.Unmarshal(event.Payload, &payload); err != nil {
+		slog.Warn(sseLogMsgParseError, "error", err, "event_type", event.Type)
+		return nil
+	}
+
+	description := fmt.Sprintf("**%s** landed the killing blow on **%s**!", payload.KillerName, payload.Name)
+	embed := createEmbed("🏆 Boss Defeated", description, bossColor, "")
+	if len(payload.Shares) > 0 {
+		lines := make([]string, 0, len(payload.Shares))
+		for _, share := range payload.Shares {
+			lines = append(lines, fmt.Sprintf("**%s**: %d damage, %d lootboxes", share.Username, share.Damage, share.Boxes))
+		}
+		embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{
+			Name:  "Loot",
+			Value: strings.Join(lines, "\n"),
+		})
+	}
+	if _, err := n.session.ChannelMessageSendEmbed(n.notificationChanID, embed); err != nil {
+		slog.Error(sseLogMsgNotificationError, "error", err, "event_type", event.Type)
+		return err
+	}
+
+	slog.Info(sseLogMsgNotificationSent, "event_type", event.Type, "boss", payload.Name)
+	return nil
+}
+
+// BossEscapedPayload is the payload for the community boss escaping
+type BossEscapedPayload struct {
+	Name  string `json:"name"`
+	HP    int    `json:"hp"`
+	MaxHP int    `json:"max_hp"`
+}
+
+// handleBossEscaped announces a boss getting away before it fell
+func (n *SSENotifier) handleBossEscaped(event SSEEvent) error {
+	var payload BossEscapedPayload
+	if err := json.Unmarshal(event.Payload, &payload); err != nil {
+		slog.Warn(sseLogMsgParseError, "error", err, "event_type", event.Type)
+		return nil
+	}
+
+	description := fmt.Sprintf("**%s** escaped with **%d / %d HP** left. Better luck next time!", payload.Name, payload.HP, payload.MaxHP)
+	embed := createEmbed("💨 Boss Escaped", description, bossColor, "")
+	if _, err := n.session.ChannelMessageSendEmbed(n.notificationChanID, embed); err != nil {
+		slog.Error(sseLogMsgNotificationError, "error", err, "event_type", event.Type)
+		return err
+	}
+
+	slog.Info(sseLogMsgNotificationSent, "event_type", event.Type, "boss", payload.Name)
+	return nil
+}
+
+// streamRecapEmbed renders a stream recap: the totals first, then the
+// highlights that happened
+func streamRecapEmbed(recap *domain.StreamRecap) *discordgo.MessageEmbed {
+	summary := recap.Summary
+	title := "Stream Recap"
+	if summary.Session.Title != "" {
+		title = fmt.Sprintf("Stream Recap: %s", summary.Session.Title)
+	}
+	duration := time.Duration(summary.DurationSeconds) * time.Second
+
+	fields := []*discordgo.MessageEmbedField{
+		{Name: "Duration", Value: duration.Truncate(time.Minute).String(), Inline: true},
+		{Name: "Active Chatters", Value: fmt.Sprintf("%d", summary.ActiveUsers), Inline: true},
+		{Name: "Chat Drops", Value: fmt.Sprintf("%d drops, %d items", summary.Drops, summary.DroppedItems), Inline: true},
+		{Name: "Gambles", Value: fmt.Sprintf("%d gambles, %d entries", summary.Gambles, summary.GambleEntries), Inline: true},
+	}
+	if win := recap.BiggestGambleWin; win != nil {
+		fields = append(fields, &discordgo.MessageEmbedField{
+			Name:  "🎰 Biggest Gamble Win",
+			Value: fmt.Sprintf("**%s** won a %d value pot against %d players", win.Username, win.TotalValue, win.Participants),
+		})
+	}
+	if top := recap.TopContributor; top != nil {
+		fields = append(fields, &discordgo.MessageEmbedField{
+			Name:  "⭐ Top Contributor",
+			Value: fmt.Sprintf("**%s** (%.0f points)", top.Username, top.Score),
+		})
+	}
+	if len(recap.Unlocks) > 0 {
+		lines := make([]string, len(recap.Unlocks))
+		for i, unlock := range recap.Unlocks {
+			lines[i] = fmt.Sprintf("🔓 %s (level %d)", unlock.DisplayName, unlock.Level)
+		}
+		fields = append(fields, &discordgo.MessageEmbedField{Name: "Unlocked", Value: strings.Join(lines, "\n")})
+	}
+	if len(summary.TopUsers) > 0 {
+		lines := make([]string, len(summary.TopUsers))
+		for i, user := range summary.TopUsers {
+			lines[i] = fmt.Sprintf("%d. **%s** (%d events)", i+1, user.Username, user.Events)
+		}
+		fields = append(fields, &discordgo.MessageEmbedField{Name: "Most Active", Value: strings.Join(lines, "\n")})
+	}
+
+	return &discordgo.MessageEmbed{
+		Title:       title,
+		Description: fmt.Sprintf("Thanks for watching on %s! Here's how the stream went.", formatSource(summary.Session.Platform)),
+		Color:       0x9146FF, // Purple
+		Fields:      fields,
+		Timestamp:   time.Now().Format(time.RFC3339),
+		Footer: &discordgo.MessageEmbedFooter{
+			Text: fmt.Sprintf("Stream #%d", summary.Session.ID),
+		},
+	}
+}
+
+// celebrationsEnabled reports whether the notification channel's guild has
+// opted in to celebration announcements
+func (n *SSENotifier) celebrationsEnabled() bool {
+	if n.client == nil {
+		return false
+	}
+
+	channel, err := n.session.State.Channel(n.notificationChanID)
+	if err != nil {
+		if channel, err = n.session.Channel(n.notificationChanID); err != nil {
+			slog.Warn("Failed to resolve notification channel guild", "error", err)
+			return false
+		}
+	}
+	if channel.GuildID == "" {
+		return false
+	}
+
+	setting, err := n.client.GetCelebrationGuild(channel.GuildID)
+	if err != nil {
+		slog.Warn("Failed to get celebration guild setting", "error", err, "guild_id", channel.GuildID)
+		return false
+	}
+	return setting.Enabled
+}
+
 // Helper functions
 
 func formatJobName(jobKey string) string {
@@ -0,0 +1,75 @@
+package discord
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+)
+
+func TestStreamRecapEmbed(t *testing.T) {
+	t.Run("lists the highlights", func(t *testing.T) {
+		recap := &domain.StreamRecap{
+			Summary: domain.StreamSummary{
+				Session:         domain.StreamSession{ID: 7, Platform: domain.PlatformTwitch, Title: "Friday crafting"},
+				DurationSeconds: 2*3600 + 15*60 + 30,
+				ActiveUsers:     12,
+			},
+			BiggestGambleWin: &domain.StreamGambleWin{Username: "bob", TotalValue: 5000, Participants: 4},
+			TopContributor:   &domain.StreamContributor{Username: "alice", Score: 120},
+			Unlocks:          []domain.StreamUnlock{{DisplayName: "Slots", Level: 1}},
+		}
+
+		embed := streamRecapEmbed(recap)
+
+		assert.Equal(t, "Stream Recap: Friday crafting", embed.Title)
+		assert.Equal(t, "Stream #7", embed.Footer.Text)
+		values := map[string]string{}
+		for _, field := range embed.Fields {
+			values[field.Name] = field.Value
+		}
+		assert.Equal(t, "2h15m0s", values["Duration"])
+		assert.Contains(t, values["🎰 Biggest Gamble Win"], "**bob** won a 5000 value pot")
+		assert.Contains(t, values["⭐ Top Contributor"], "**alice**")
+		assert.Contains(t, values["Unlocked"], "Slots (level 1)")
+	})
+
+	t.Run("a quiet stream has only the totals", func(t *testing.T) {
+		embed := streamRecapEmbed(&domain.StreamRecap{Unlocks: []domain.StreamUnlock{}})
+
+		assert.Equal(t, "Stream Recap", embed.Title)
+		require.Len(t, embed.Fields, 4)
+	})
+}
+
+func TestUnlessRouted(t *testing.T) {
+	n := &SSENotifier{}
+	calls := 0
+	handler := n.unlessRouted(func(SSEEvent) error {
+		calls++
+		return nil
+	})
+
+	require.NoError(t, handler(SSEEvent{Type: SSEEventTypeStreamRecap}))
+	assert.Equal(t, 1, calls, "built-in announcement runs without a route")
+
+	n.setRoutedEvents([]string{SSEEventTypeStreamRecap})
+	require.NoError(t, handler(SSEEvent{Type: SSEEventTypeStreamRecap}))
+	assert.Equal(t, 1, calls, "routed events skip the built-in announcement")
+
+	require.NoError(t, handler(SSEEvent{Type: SSEEventTypeGambleCompleted}))
+	assert.Equal(t, 2, calls, "other events are still announced")
+}
+
+func TestHandleAnnouncementIgnoresOtherDestinations(t *testing.T) {
+	n := &SSENotifier{}
+
+	err := n.handleAnnouncement(SSEEvent{
+		Type:    SSEEventTypeAnnouncement,
+		Payload: []byte(`{"destination":"sse","target":"overlay","message":"hi"}`),
+	})
+
+	assert.NoError(t, err)
+}
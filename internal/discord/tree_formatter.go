@@ -3,12 +3,10 @@ package discord
 import (
 	"fmt"
 	"strings"
-
-	"github.com/osse101/BrandishBot_Go/internal/domain"
 )
 
 // formatTreeStatus formats the progression tree into a readable string for Discord
-func formatTreeStatus(nodes []*domain.ProgressionTreeNode) string {
+func formatTreeStatus(nodes []ProgressionTreeNode) string {
 	var sb strings.Builder
 
 	// Group by locked status
@@ -0,0 +1,33 @@
+package domain
+
+import "time"
+
+// AnnouncementDestination is where an announcement route sends its message
+type AnnouncementDestination string
+
+// Announcement destinations
+const (
+	// AnnouncementDestinationDiscord posts the message to the Discord
+	// channel named by the route's target
+	AnnouncementDestinationDiscord AnnouncementDestination = "discord"
+	// AnnouncementDestinationWebhook POSTs the message to the route's target URL
+	AnnouncementDestinationWebhook AnnouncementDestination = "webhook"
+	// AnnouncementDestinationSSE broadcasts the message to SSE clients under
+	// the route's target as the event type
+	AnnouncementDestinationSSE AnnouncementDestination = "sse"
+)
+
+// AnnouncementRoute turns one type of event into an announcement. The
+// template is a Go text/template rendered with the event's type and payload.
+type AnnouncementRoute struct {
+	ID          int64                   `json:"id"`
+	EventType   string                  `json:"event_type"`
+	Destination AnnouncementDestination `json:"destination"`
+	// Target is a Discord channel ID, webhook URL or SSE topic
+	Target    string    `json:"target"`
+	Template  string    `json:"template"`
+	Enabled   bool      `json:"enabled"`
+	CreatedBy string    `json:"created_by"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
@@ -0,0 +1,18 @@
+package domain
+
+import "time"
+
+// APIToken is a read-only API token issued to a guild's streamer, so
+// community developers can build tools without the main API key. The token
+// itself is only shown when it is issued.
+type APIToken struct {
+	ID      int64  `json:"id"`
+	GuildID string `json:"guild_id"`
+	Name    string `json:"name"`
+	// Prefix is the start of the token, for telling tokens apart
+	Prefix     string     `json:"prefix"`
+	CreatedBy  string     `json:"created_by"`
+	CreatedAt  time.Time  `json:"created_at"`
+	LastUsedAt *time.Time `json:"last_used_at,omitempty"`
+	RevokedAt  *time.Time `json:"revoked_at,omitempty"`
+}
@@ -0,0 +1,38 @@
+package domain
+
+import "time"
+
+// BankAccount is a user's savings in the bank. The balance earns interest on
+// a schedule, and reaching the savings goal pays a bonus onto it.
+type BankAccount struct {
+	UserID         string     `json:"user_id"`
+	Balance        int64      `json:"balance"`
+	Goal           int64      `json:"goal,omitempty"` // Savings goal, 0 when none is set
+	GoalSetAt      *time.Time `json:"goal_set_at,omitempty"`
+	GoalStart      int64      `json:"goal_start,omitempty"` // Balance when the goal was set
+	GoalsReached   int        `json:"goals_reached"`
+	InterestEarned int64      `json:"interest_earned"` // Total interest ever paid to the account
+	LastInterestAt *time.Time `json:"last_interest_at,omitempty"`
+	// WithdrawnToday counts what was withdrawn on WithdrawnOn, a UTC day
+	WithdrawnToday int64     `json:"withdrawn_today"`
+	WithdrawnOn    time.Time `json:"-"`
+	// WithdrawLimit is the most that can be withdrawn per UTC day, 0 for no limit
+	WithdrawLimit int64   `json:"withdraw_limit"`
+	InterestRate  float64 `json:"interest_rate"` // Share of the balance paid per interest payout
+}
+
+// BankTransaction is the outcome of a deposit or withdrawal
+type BankTransaction struct {
+	Account BankAccount `json:"account"`
+	Amount  int64       `json:"amount"`
+	// GoalBonus is paid onto the balance when a deposit reaches the savings goal
+	GoalBonus int64 `json:"goal_bonus,omitempty"`
+}
+
+// BankInterest is one account's interest payout
+type BankInterest struct {
+	UserID   string `json:"user_id"`
+	Interest int64  `json:"interest"`
+	Balance  int64  `json:"balance"`
+	Goal     int64  `json:"goal,omitempty"`
+}
@@ -0,0 +1,80 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// BossState is where a boss fight stands
+type BossState string
+
+const (
+	BossStateActive   BossState = "active"   // Spawned and taking attacks
+	BossStateDefeated BossState = "defeated" // Brought to 0 HP before it escaped
+	BossStateEscaped  BossState = "escaped"  // Still standing when its time ran out
+)
+
+// Boss is a community boss fight. Attackers wear its HP down together, and
+// when it falls its reward lootboxes are shared out by damage dealt.
+type Boss struct {
+	ID            uuid.UUID  `json:"id"`
+	CommunityID   string     `json:"community_id,omitempty"`
+	Key           string     `json:"key"`
+	Name          string     `json:"name"`
+	MaxHP         int        `json:"max_hp"`
+	HP            int        `json:"hp"`
+	RewardLootbox string     `json:"reward_lootbox"`
+	RewardBoxes   int        `json:"reward_boxes"` // Lootboxes shared out among attackers on defeat
+	State         BossState  `json:"state"`
+	SpawnedBy     string     `json:"spawned_by"`
+	SpawnedAt     time.Time  `json:"spawned_at"`
+	ExpiresAt     time.Time  `json:"expires_at"`
+	EndedAt       *time.Time `json:"ended_at,omitempty"`
+}
+
+// BossAttacker is a user's share of the fight against a boss
+type BossAttacker struct {
+	UserID      string `json:"user_id"`
+	Username    string `json:"username"`
+	Damage      int    `json:"damage"`
+	Attacks     int    `json:"attacks"`
+	RewardBoxes int    `json:"reward_boxes,omitempty"` // Lootboxes won, set once the boss is defeated
+}
+
+// BossLoot is what an attacker got out of a defeated boss's lootboxes
+type BossLoot struct {
+	UserID   string         `json:"user_id"`
+	Username string         `json:"username"`
+	Damage   int            `json:"damage"`
+	Boxes    int            `json:"boxes"`
+	Items    []BossLootItem `json:"items"`
+}
+
+// BossLootItem is an item dropped by a reward lootbox
+type BossLootItem struct {
+	ItemName string       `json:"item_name"`
+	Quantity int          `json:"quantity"`
+	Quality  QualityLevel `json:"quality"`
+}
+
+// BossAttackResult is the outcome of an attack on the boss
+type BossAttackResult struct {
+	UserID   string `json:"user_id"`
+	Username string `json:"username"`
+	BossID   string `json:"boss_id"`
+	BossName string `json:"boss_name"`
+	Damage   int    `json:"damage"`
+	Weapon   string `json:"weapon,omitempty"` // Weapon item used up by the attack
+	HP       int    `json:"hp"`
+	MaxHP    int    `json:"max_hp"`
+	Defeated bool   `json:"defeated"` // This attack landed the killing blow
+	// Loot lists every attacker's share, only on the killing blow
+	Loot []BossLoot `json:"loot,omitempty"`
+}
+
+// BossStatus is the community's current boss, if any, and its top attackers
+type BossStatus struct {
+	Boss         *Boss          `json:"boss,omitempty"`
+	TopAttackers []BossAttacker `json:"top_attackers"`
+}
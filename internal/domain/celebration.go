@@ -0,0 +1,50 @@
+package domain
+
+import (
+	"errors"
+	"time"
+)
+
+// Celebration kinds
+const (
+	CelebrationBirthday    = "birthday"
+	CelebrationAnniversary = "anniversary"
+)
+
+// ErrInvalidBirthday is returned for a month/day pair that is not a calendar date
+var ErrInvalidBirthday = errors.New("invalid birthday")
+
+// Birthday is a user's birthday. The year is deliberately not stored.
+type Birthday struct {
+	Month int `json:"month"`
+	Day   int `json:"day"`
+}
+
+// Validate checks the month and day form a real date, allowing 29 February
+func (b Birthday) Validate() error {
+	if b.Month < 1 || b.Month > 12 || b.Day < 1 {
+		return ErrInvalidBirthday
+	}
+	// 2024 is a leap year, so 29 February is accepted
+	if b.Day > time.Date(2024, time.Month(b.Month)+1, 0, 0, 0, 0, 0, time.UTC).Day() {
+		return ErrInvalidBirthday
+	}
+	return nil
+}
+
+// Celebrant is a user with a celebration on a given day
+type Celebrant struct {
+	UserID       string
+	Username     string
+	RegisteredAt time.Time // Set for anniversaries
+}
+
+// CelebrationGrant is a reward granted for a birthday or account anniversary
+type CelebrationGrant struct {
+	UserID   string `json:"user_id"`
+	Username string `json:"username"`
+	Kind     string `json:"kind"`
+	Years    int    `json:"years,omitempty"` // Account age, for anniversaries
+	ItemName string `json:"item_name"`
+	Quantity int    `json:"quantity"`
+}
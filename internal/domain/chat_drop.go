@@ -0,0 +1,10 @@
+package domain
+
+// ChatDrop is an item dropped to an active chatter by the chat activity engine
+type ChatDrop struct {
+	UserID   string `json:"user_id"`
+	Username string `json:"username"`
+	Platform string `json:"platform"`
+	ItemName string `json:"item_name"`
+	Quantity int    `json:"quantity"`
+}
@@ -0,0 +1,36 @@
+package domain
+
+// CommunityPoolFunding reports community pool money spent on progression
+// contribution points
+type CommunityPoolFunding struct {
+	Spent   int   `json:"spent"`
+	Points  int   `json:"points"`
+	Balance int64 `json:"balance"` // What the pool holds afterwards
+}
+
+// CommunityDonation is money or items a user gave to the community pool
+type CommunityDonation struct {
+	UserID   string `json:"user_id"`
+	ItemName string `json:"item_name"`
+	Quantity int    `json:"quantity"`
+	Value    int    `json:"value"`  // What the donation was worth in money
+	Points   int    `json:"points"` // Progression contribution it bought
+}
+
+// CommunityPoolStatus summarises the community pool and its donations
+type CommunityPoolStatus struct {
+	Balance       int64 `json:"balance"`
+	TotalDonated  int64 `json:"total_donated"`
+	PointsFunded  int64 `json:"points_funded"` // Contribution points bought by donations
+	Donors        int   `json:"donors"`
+	MoneyPerPoint int   `json:"money_per_point"`
+}
+
+// CommunityDonor is one user's donations to the community pool
+type CommunityDonor struct {
+	UserID     string `json:"user_id"`
+	Username   string `json:"username"`
+	TotalValue int64  `json:"total_value"`
+	Points     int64  `json:"points"`
+	Donations  int    `json:"donations"`
+}
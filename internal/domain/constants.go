@@ -41,6 +41,13 @@ const (
 	// Progression items
 	ItemRareCandy = "xp_rarecandy" // instant job XP
 
+	// Effect items - grant a timed buff when used
+	ItemLuckyClover   = "effect_lucky_clover"   // 2x search luck for 1h
+	ItemMerchantCharm = "effect_merchant_charm" // 1.25x sell prices for 1h
+	ItemRabbitFoot    = "effect_rabbit_foot"    // 1.25x gamble drop value for 1h
+	ItemAegis         = "effect_aegis"          // timeout immunity for 30 min
+	ItemXPTonic       = "effect_xp_tonic"       // 1.5x job XP for 1h
+
 	// Junk items
 	ItemSludge = "compost_sludge" // compost byproduct
 )
@@ -81,12 +88,27 @@ const (
 	// Progression public names
 	PublicNameRareCandy = "rarecandy" // XP item
 	PublicNameBomb      = "bomb"      // Large timed explosive
+
+	// Effect public names
+	PublicNameLuckyClover   = "clover"     // Search luck
+	PublicNameMerchantCharm = "charm"      // Sell bonus
+	PublicNameRabbitFoot    = "rabbitfoot" // Gamble luck
+	PublicNameAegis         = "aegis"      // Timeout immunity
+	PublicNameXPTonic       = "tonic"      // Job XP boost
 )
 
 // Action name constants for cooldown tracking
 const (
-	ActionSearch = "search"
-	ActionSlots  = "slots"
+	ActionSearch      = "search"
+	ActionSlots       = "slots"
+	ActionGambleStart = "gamble_start"
+	ActionGive        = "give"
+	ActionUseItem     = "use_item"
+	ActionJobSwitch   = "job_switch"
+	ActionUndo        = "undo"
+	ActionDig         = "dig"
+	ActionFish        = "fish"
+	ActionBossAttack  = "boss_attack"
 	// Future actions can be added here
 	// ActionDaily  = "daily"
 	// ActionQuest  = "quest"
@@ -94,8 +116,25 @@ const (
 
 // Duration constants for cooldowns and timing
 const (
-	SearchCooldownDuration = 30 * time.Minute
-	SlotsCooldownDuration  = 10 * time.Minute
+	SearchCooldownDuration      = 30 * time.Minute
+	SlotsCooldownDuration       = 10 * time.Minute
+	GambleStartCooldownDuration = 5 * time.Minute
+	// Giving and using items have no cooldown unless one is configured
+	GiveCooldownDuration    = 0
+	UseItemCooldownDuration = 0
+	// JobSwitchCooldownDuration is the wait between changes of active job
+	JobSwitchCooldownDuration = 24 * time.Hour
+	// UndoCooldownDuration stops sell and undo being repeated for their side
+	// effects, such as job XP
+	UndoCooldownDuration = 10 * time.Minute
+	// DigCooldownDuration is the wait between a user's digs at the community
+	// dig site
+	DigCooldownDuration = 15 * time.Minute
+	// FishCooldownDuration is the wait between a user's casts
+	FishCooldownDuration = 2 * time.Minute
+	// BossAttackCooldownDuration is the wait between a user's attacks on the
+	// community boss
+	BossAttackCooldownDuration = time.Minute
 	// Future durations can be added here
 	// DailyCooldownDuration  = 24 * time.Hour
 )
@@ -123,6 +162,8 @@ const (
 	MsgSearchCriticalFail    = "You tried to search, but disaster struck!"
 	MsgFirstSearchBonus      = " (First Search of the Day!)"
 	MsgStreakBonus           = " (🔥 %d Day Streak!)"
+	MsgSearchStreakReward    = "🔥 %d-day search streak! (+%d %s)"
+	MsgSearchMasteryLevelUp  = "⭐ Search Mastery level %d! Your search cooldown is now %d%% shorter."
 )
 
 // SearchCriticalFailMessages is a list of funny messages for critical failures
@@ -171,11 +212,12 @@ const (
 // Shared metadata keys used across multiple modules for event payloads
 // These keys ensure consistency when publishing and consuming events
 const (
-	MetadataKeyItemName   = "item_name"
-	MetadataKeyQuantity   = "quantity"
-	MetadataKeyMultiplier = "multiplier"
-	MetadataKeySource     = "source"
-	MetadataKeyRecorded   = "recorded"
+	MetadataKeyItemName     = "item_name"
+	MetadataKeyQuantity     = "quantity"
+	MetadataKeyMultiplier   = "multiplier"
+	MetadataKeySource       = "source"
+	MetadataKeyRecorded     = "recorded"
+	MetadataKeyLootboxValue = "lootbox_value" // Total value of the drops of a lootbox opening
 )
 
 // ============================================================================
@@ -376,6 +418,7 @@ const (
 	ContentTypeContainer = "container"
 	ContentTypeUtility   = "utility"
 	ContentTypeMagical   = "magical"
+	ContentTypeCosmetic  = "cosmetic"
 )
 
 // ============================================================================
@@ -496,6 +539,7 @@ const (
 	StatsEventGambleNearMiss     EventType = "gamble_near_miss"
 	StatsEventGambleTieBreakLost EventType = "gamble_tie_break_lost"
 	StatsEventGambleCriticalFail EventType = "gamble_critical_fail"
+	StatsEventGambleWon          EventType = "gamble_won"
 	StatsEventDailyStreak        EventType = "daily_streak"
 
 	// Search events
@@ -507,6 +551,7 @@ const (
 	// Crafting events
 	EventTypeCraftingCriticalSuccess EventType = "crafting_critical_success"
 	EventTypeCraftingPerfectSalvage  EventType = "crafting_perfect_salvage"
+	StatsEventRecipeCrafted          EventType = "recipe_crafted"
 
 	// Job events
 	EventTypeJobLevelUp EventType = "job_level_up"
@@ -519,6 +564,12 @@ const (
 	EventTypeSlotsSpin        EventType = "slots_spin"
 	EventTypeSlotsWin         EventType = "slots_win"
 	EventTypeSlotsMegaJackpot EventType = "slots_mega_jackpot"
+
+	// Chat drop events
+	StatsEventChatDrop EventType = "chat_drop"
+
+	// Boss events
+	StatsEventBossAttack EventType = "boss_attack"
 )
 
 // ============================================================================
@@ -563,6 +614,7 @@ const (
 	JobKeyGambler    = "job_gambler"
 	JobKeyFarmer     = "job_farmer"
 	JobKeyScholar    = "job_scholar"
+	JobKeyFisher     = "job_fisher"
 )
 
 // ============================================================================
@@ -579,6 +631,9 @@ const (
 	SearchNearMissRate = 0.05
 	// SearchCriticalFailRate defines the probability of a critical failure when searching (5%)
 	SearchCriticalFailRate = 0.05
+	// SearchMaxSuccessRate caps the success chance after luck effects, so a
+	// boosted search can still fail (95%)
+	SearchMaxSuccessRate = 0.95
 )
 
 // Search Mechanic - Diminishing Returns
@@ -597,6 +652,16 @@ const (
 	SearchRegionItemDropChance = 0.5
 	// SearchRegionConfigPath is the default path to the search regions config file
 	SearchRegionConfigPath = "configs/search_regions.json"
+	// SearchDifficultyConfigPath is the default path to the search difficulty curve
+	SearchDifficultyConfigPath = "configs/search_difficulty.json"
+	// SearchLocationActionPrefix prefixes the cooldown action of a location with its own cooldown
+	SearchLocationActionPrefix = ActionSearch + ":"
+)
+
+// Search Mechanic - Streaks and Mastery
+const (
+	// SearchMasteryCooldownReductionPerLevel is how much each mastery level shortens the search cooldown (2%)
+	SearchMasteryCooldownReductionPerLevel = 0.02
 )
 
 // ============================================================================
@@ -1041,4 +1106,9 @@ const (
 	MetricTypeSlotsWin     = "slots_win"
 	MetricTypeSlotsBigWin  = "slots_big_win"
 	MetricTypeSlotsJackpot = "slots_jackpot"
+
+	// External contributions are recorded as "external:<source>". Contributions
+	// not credited to a user are recorded under the user ID "external:<source>".
+	MetricTypeExternalPrefix  = "external:"
+	ExternalContributorPrefix = "external:"
 )
@@ -0,0 +1,10 @@
+package domain
+
+import "time"
+
+// CooldownTimer is an action a user has to wait for before doing it again
+type CooldownTimer struct {
+	Action           string    `json:"action"`
+	RemainingSeconds int       `json:"remaining_seconds"`
+	ReadyAt          time.Time `json:"ready_at"`
+}
@@ -0,0 +1,51 @@
+package domain
+
+import "time"
+
+// DigLevel is a layer of the community dig site, reached once the shaft is
+// MinDepth metres deep
+type DigLevel struct {
+	Level        int    `json:"level"`
+	Name         string `json:"name"`
+	MinDepth     int    `json:"min_depth"`
+	Contribution int    `json:"contribution"` // Progression points granted the first time the site reaches the level
+}
+
+// DigSite is the shaft every user digs deeper together
+type DigSite struct {
+	Depth          int       `json:"depth"`
+	TotalDigs      int       `json:"total_digs"`
+	MilestoneLevel int       `json:"milestone_level"` // Deepest level whose contribution has been granted
+	UpdatedAt      time.Time `json:"updated_at"`
+}
+
+// DigResult is the outcome of one user's dig
+type DigResult struct {
+	UserID       string     `json:"user_id"`
+	Username     string     `json:"username"`
+	Meters       int        `json:"meters"`
+	Depth        int        `json:"depth"`
+	Level        DigLevel   `json:"level"`
+	NextLevel    *DigLevel  `json:"next_level,omitempty"`
+	Milestones   []DigLevel `json:"milestones,omitempty"` // Levels this dig reached first
+	Contribution int        `json:"contribution"`         // Progression points the milestones granted
+}
+
+// Digger is one user's share of the digging
+type Digger struct {
+	UserID    string    `json:"user_id"`
+	Username  string    `json:"username"`
+	Meters    int       `json:"meters"`
+	Digs      int       `json:"digs"`
+	LastDugAt time.Time `json:"last_dug_at"`
+}
+
+// DigSiteStatus summarises the dig site, its levels and its top diggers
+type DigSiteStatus struct {
+	Depth      int        `json:"depth"`
+	TotalDigs  int        `json:"total_digs"`
+	Level      DigLevel   `json:"level"`
+	NextLevel  *DigLevel  `json:"next_level,omitempty"`
+	Levels     []DigLevel `json:"levels"`
+	TopDiggers []Digger   `json:"top_diggers"`
+}
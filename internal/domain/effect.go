@@ -0,0 +1,66 @@
+package domain
+
+import "time"
+
+// EffectType identifies a timed buff or debuff on a user
+type EffectType string
+
+// Effect types. The magnitude of a multiplier effect scales the value it
+// names; flag effects only need to be active.
+const (
+	// EffectSearchLuck multiplies the chance of a successful search
+	EffectSearchLuck EffectType = "search_luck"
+	// EffectSellBonus multiplies the money received when selling items
+	EffectSellBonus EffectType = "sell_bonus"
+	// EffectGambleLuck multiplies the value of the user's gamble drops
+	EffectGambleLuck EffectType = "gamble_luck"
+	// EffectTimeoutImmunity stops weapons and explosives timing the user out
+	EffectTimeoutImmunity EffectType = "timeout_immunity"
+	// EffectJobXPBoost multiplies the job XP the user earns
+	EffectJobXPBoost EffectType = "job_xp_boost"
+	// EffectContributionBoost multiplies the progression contributions a
+	// community makes. It only applies community-wide.
+	EffectContributionBoost EffectType = "contribution_boost"
+)
+
+// IsValid reports whether the effect type is known
+func (t EffectType) IsValid() bool {
+	switch t {
+	case EffectSearchLuck, EffectSellBonus, EffectGambleLuck, EffectTimeoutImmunity, EffectJobXPBoost:
+		return true
+	}
+	return false
+}
+
+// IsCommunityEffect reports whether the effect type can be granted to a
+// whole community
+func (t EffectType) IsCommunityEffect() bool {
+	switch t {
+	case EffectJobXPBoost, EffectContributionBoost:
+		return true
+	}
+	return false
+}
+
+// Effect is a timed buff or debuff on a user. A user has at most one effect
+// of each type; granting it again keeps the stronger magnitude and extends
+// the expiry.
+type Effect struct {
+	UserID    string     `json:"user_id"`
+	Type      EffectType `json:"type"`
+	Magnitude float64    `json:"magnitude"`
+	ExpiresAt time.Time  `json:"expires_at"`
+	CreatedAt time.Time  `json:"created_at"`
+}
+
+// CommunityEffect is a timed bonus that applies to everyone in a community,
+// such as the XP boost after a raid. Like user effects, a community has at
+// most one of each type and granting it again keeps the stronger magnitude
+// and extends the expiry.
+type CommunityEffect struct {
+	Type      EffectType `json:"type"`
+	Magnitude float64    `json:"magnitude"`
+	Source    string     `json:"source"`
+	ExpiresAt time.Time  `json:"expires_at"`
+	CreatedAt time.Time  `json:"created_at"`
+}
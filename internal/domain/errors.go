@@ -43,6 +43,12 @@ const (
 	ErrMsgFailedToSaveOpenedItems   = "failed to save opened items"
 	ErrMsgNotALootbox               = "not a lootbox"
 	ErrMsgUserAlreadyJoined         = "user has already joined this gamble"
+	ErrMsgParticipantCannotSideBet  = "participants cannot side-bet on their own gamble"
+	ErrMsgSideBetNotParticipant     = "side bets must back a participant of the gamble"
+	ErrMsgSideBetAlreadyPlaced      = "user has already placed a side bet on this gamble"
+	ErrMsgSideBettorCannotJoin      = "side bettors cannot join the gamble they bet on"
+	ErrMsgGambleFull                = "gamble is full"
+	ErrMsgWagerTooHigh              = "wager exceeds the per-participant limit"
 
 	// User service errors
 	ErrMsgNotEnoughItems       = "not enough items"
@@ -52,6 +58,111 @@ const (
 	ErrMsgDailyCapReached       = "daily XP cap reached"
 	ErrMsgInsufficientLevel     = "insufficient level"
 	ErrMsgInvalidExpeditionType = "invalid expedition type"
+	ErrMsgUnknownJob            = "unknown job"
+	ErrMsgJobAlreadyActive      = "job is already active"
+	ErrMsgJobBelowLevelCap      = "job has not reached the level cap"
+	ErrMsgMaxPrestigeReached    = "job is at maximum prestige"
+
+	// Search errors
+	ErrMsgUnknownSearchLocation = "unknown search location"
+	ErrMsgSearchLocationLocked  = "search location is locked"
+
+	// Naming errors
+	ErrMsgUnknownNameTheme  = "unknown name theme"
+	ErrMsgItemAliasNotFound = "item alias not found"
+
+	// Reminder errors
+	ErrMsgInvalidReminderKind = "invalid reminder kind"
+	ErrMsgNothingToRemind     = "nothing to remind about"
+	ErrMsgTooManyReminders    = "too many pending reminders"
+	ErrMsgReminderNotFound    = "reminder not found"
+
+	// Loan errors
+	ErrMsgItemBorrowed        = "borrowed items cannot be sold, disassembled or lent"
+	ErrMsgCannotLendToSelf    = "cannot lend items to yourself"
+	ErrMsgInvalidLoanDuration = "invalid loan duration"
+	ErrMsgLoanNotFound        = "loan not found"
+
+	// Item flag errors
+	ErrMsgItemLockedByUser = "you have locked this item; unlock it first"
+
+	// Undo errors
+	ErrMsgNothingToUndo     = "nothing to undo"
+	ErrMsgUndoNoLongerValid = "that can no longer be undone"
+
+	// Give guard errors
+	ErrMsgDailyGiveLimitReached = "daily give limit reached"
+	ErrMsgAccountTooNewToGive   = "account is too new to give items"
+	ErrMsgGiveFlagNotFound      = "give flag not found or already reviewed"
+
+	// Moderation errors
+	ErrMsgAccountFrozen        = "account is frozen"
+	ErrMsgAccountAlreadyFrozen = "account is already frozen"
+	ErrMsgAccountNotFrozen     = "account is not frozen"
+
+	// API token errors
+	ErrMsgAPITokenNotFound = "API token not found"
+	ErrMsgTooManyAPITokens = "too many active API tokens for this guild"
+
+	// Feature flag errors
+	ErrMsgFeatureFlagNotFound = "feature flag not found"
+
+	// Game event errors
+	ErrMsgGameEventNotFound = "game event not found"
+	ErrMsgGameEventEnded    = "game event has already ended"
+
+	// Snapshot errors
+	ErrMsgSnapshotNotFound       = "snapshot not found"
+	ErrMsgSnapshotSchemaMismatch = "snapshot was taken at a different schema version"
+
+	// Webhook errors
+	ErrMsgWebhookNotFound = "webhook not found"
+
+	// Announcement route errors
+	ErrMsgAnnouncementRouteNotFound = "announcement route not found"
+
+	// Balance change errors
+	ErrMsgBalanceChangeNotFound = "balance change not found or already in effect"
+
+	// Stream session errors
+	ErrMsgStreamSessionNotFound = "stream session not found"
+	ErrMsgNoLiveStream          = "no stream is live"
+
+	// Player shop errors
+	ErrMsgListingNotFound     = "listing not found"
+	ErrMsgCannotBuyOwnListing = "cannot buy your own listing"
+	ErrMsgTooManyListings     = "too many active listings"
+	ErrMsgInvalidListingPrice = "invalid listing price"
+
+	// Targeting errors
+	ErrMsgTargetOptedOut    = "target has opted out of targeted items"
+	ErrMsgTargetingDisabled = "you have opted out of targeted items"
+
+	// Fishing errors
+	ErrMsgAlreadyFishing = "you already have a line in the water"
+	ErrMsgNoFishingCast  = "you have no line in the water"
+	ErrMsgUnknownTackle  = "unknown tackle"
+
+	// Boss errors
+	ErrMsgNoActiveBoss      = "there is no boss to fight"
+	ErrMsgBossAlreadyActive = "a boss is already here"
+	ErrMsgUnknownBoss       = "unknown boss"
+	ErrMsgUnknownWeapon     = "unknown weapon"
+
+	// Garden errors
+	ErrMsgGardenFull       = "every garden plot is planted"
+	ErrMsgUnknownSeed      = "unknown seed"
+	ErrMsgNothingToHarvest = "nothing in the garden is ready to harvest"
+
+	// Bank errors
+	ErrMsgBankWithdrawLimit = "daily bank withdrawal limit reached"
+	ErrMsgBankGoalCooldown  = "savings goal was set too recently to change"
+
+	// Gift errors
+	ErrMsgCannotGiftSelf      = "cannot send a gift to yourself"
+	ErrMsgTooManyGifts        = "too many gifts waiting to be delivered"
+	ErrMsgInvalidGiftDelivery = "invalid gift delivery time"
+	ErrMsgGiftNotFound        = "gift not found"
 
 	// Database/System errors
 	ErrMsgConnectionTimeout       = "connection timeout"
@@ -82,11 +193,17 @@ const (
 	ErrMsgAccumulationInProgress = "cannot start voting while accumulation is in progress"
 	ErrMsgSessionAlreadyFrozen   = "voting session is already frozen"
 	ErrMsgNoNodesAvailable       = "no nodes available for voting"
+	ErrMsgExternalCapReached     = "external contribution cap reached for this hour"
+	ErrMsgInvalidSource          = "invalid contribution source"
+	ErrMsgSelfDelegation         = "cannot delegate your vote to yourself"
+	ErrMsgVoteDelegationChain    = "votes cannot be delegated through another delegation"
+	ErrMsgVoteDelegationNotFound = "no vote delegation found"
 
 	// Recipe/Crafting errors
 	ErrMsgRecipeNotFound = "recipe not found"
 	ErrMsgRecipeLocked   = "recipe is locked"
 	ErrMsgInvalidRecipe  = "invalid recipe"
+	ErrMsgRecipeOffEvent = "recipe is only available during its event"
 
 	// Harvest errors
 	ErrMsgHarvestStateNotFound = "harvest state not found"
@@ -142,6 +259,12 @@ var (
 	ErrBetQuantityMustBePositive = errors.New(ErrMsgBetQuantityMustBePositive)
 	ErrNotALootbox               = errors.New(ErrMsgNotALootbox)
 	ErrUserAlreadyJoined         = errors.New(ErrMsgUserAlreadyJoined)
+	ErrParticipantCannotSideBet  = errors.New(ErrMsgParticipantCannotSideBet)
+	ErrSideBetNotParticipant     = errors.New(ErrMsgSideBetNotParticipant)
+	ErrSideBetAlreadyPlaced      = errors.New(ErrMsgSideBetAlreadyPlaced)
+	ErrSideBettorCannotJoin      = errors.New(ErrMsgSideBettorCannotJoin)
+	ErrGambleFull                = errors.New(ErrMsgGambleFull)
+	ErrWagerTooHigh              = errors.New(ErrMsgWagerTooHigh)
 
 	// User service errors
 	ErrNotEnoughItems       = errors.New(ErrMsgNotEnoughItems)
@@ -151,6 +274,111 @@ var (
 	ErrDailyCapReached       = errors.New(ErrMsgDailyCapReached)
 	ErrInsufficientLevel     = errors.New(ErrMsgInsufficientLevel)
 	ErrInvalidExpeditionType = errors.New(ErrMsgInvalidExpeditionType)
+	ErrUnknownJob            = errors.New(ErrMsgUnknownJob)
+	ErrJobAlreadyActive      = errors.New(ErrMsgJobAlreadyActive)
+	ErrJobBelowLevelCap      = errors.New(ErrMsgJobBelowLevelCap)
+	ErrMaxPrestigeReached    = errors.New(ErrMsgMaxPrestigeReached)
+
+	// Search errors
+	ErrUnknownSearchLocation = errors.New(ErrMsgUnknownSearchLocation)
+	ErrSearchLocationLocked  = errors.New(ErrMsgSearchLocationLocked)
+
+	// Naming errors
+	ErrUnknownNameTheme  = errors.New(ErrMsgUnknownNameTheme)
+	ErrItemAliasNotFound = errors.New(ErrMsgItemAliasNotFound)
+
+	// Reminder errors
+	ErrInvalidReminderKind = errors.New(ErrMsgInvalidReminderKind)
+	ErrNothingToRemind     = errors.New(ErrMsgNothingToRemind)
+	ErrTooManyReminders    = errors.New(ErrMsgTooManyReminders)
+	ErrReminderNotFound    = errors.New(ErrMsgReminderNotFound)
+
+	// Loan errors
+	ErrItemBorrowed        = errors.New(ErrMsgItemBorrowed)
+	ErrCannotLendToSelf    = errors.New(ErrMsgCannotLendToSelf)
+	ErrInvalidLoanDuration = errors.New(ErrMsgInvalidLoanDuration)
+	ErrLoanNotFound        = errors.New(ErrMsgLoanNotFound)
+
+	// Item flag errors
+	ErrItemLockedByUser = errors.New(ErrMsgItemLockedByUser)
+
+	// Undo errors
+	ErrNothingToUndo     = errors.New(ErrMsgNothingToUndo)
+	ErrUndoNoLongerValid = errors.New(ErrMsgUndoNoLongerValid)
+
+	// Give guard errors
+	ErrDailyGiveLimitReached = errors.New(ErrMsgDailyGiveLimitReached)
+	ErrAccountTooNewToGive   = errors.New(ErrMsgAccountTooNewToGive)
+	ErrGiveFlagNotFound      = errors.New(ErrMsgGiveFlagNotFound)
+
+	// Moderation errors
+	ErrAccountFrozen        = errors.New(ErrMsgAccountFrozen)
+	ErrAccountAlreadyFrozen = errors.New(ErrMsgAccountAlreadyFrozen)
+	ErrAccountNotFrozen     = errors.New(ErrMsgAccountNotFrozen)
+
+	// API token errors
+	ErrAPITokenNotFound = errors.New(ErrMsgAPITokenNotFound)
+	ErrTooManyAPITokens = errors.New(ErrMsgTooManyAPITokens)
+
+	// Feature flag errors
+	ErrFeatureFlagNotFound = errors.New(ErrMsgFeatureFlagNotFound)
+
+	// Game event errors
+	ErrGameEventNotFound = errors.New(ErrMsgGameEventNotFound)
+	ErrGameEventEnded    = errors.New(ErrMsgGameEventEnded)
+
+	// Snapshot errors
+	ErrSnapshotNotFound       = errors.New(ErrMsgSnapshotNotFound)
+	ErrSnapshotSchemaMismatch = errors.New(ErrMsgSnapshotSchemaMismatch)
+
+	// Webhook errors
+	ErrWebhookNotFound = errors.New(ErrMsgWebhookNotFound)
+
+	// Announcement route errors
+	ErrAnnouncementRouteNotFound = errors.New(ErrMsgAnnouncementRouteNotFound)
+
+	// Balance change errors
+	ErrBalanceChangeNotFound = errors.New(ErrMsgBalanceChangeNotFound)
+
+	// Stream session errors
+	ErrStreamSessionNotFound = errors.New(ErrMsgStreamSessionNotFound)
+	ErrNoLiveStream          = errors.New(ErrMsgNoLiveStream)
+
+	// Player shop errors
+	ErrListingNotFound     = errors.New(ErrMsgListingNotFound)
+	ErrCannotBuyOwnListing = errors.New(ErrMsgCannotBuyOwnListing)
+	ErrTooManyListings     = errors.New(ErrMsgTooManyListings)
+	ErrInvalidListingPrice = errors.New(ErrMsgInvalidListingPrice)
+
+	// Targeting errors
+	ErrTargetOptedOut    = errors.New(ErrMsgTargetOptedOut)
+	ErrTargetingDisabled = errors.New(ErrMsgTargetingDisabled)
+
+	// Fishing errors
+	ErrAlreadyFishing = errors.New(ErrMsgAlreadyFishing)
+	ErrNoFishingCast  = errors.New(ErrMsgNoFishingCast)
+	ErrUnknownTackle  = errors.New(ErrMsgUnknownTackle)
+
+	// Boss errors
+	ErrNoActiveBoss      = errors.New(ErrMsgNoActiveBoss)
+	ErrBossAlreadyActive = errors.New(ErrMsgBossAlreadyActive)
+	ErrUnknownBoss       = errors.New(ErrMsgUnknownBoss)
+	ErrUnknownWeapon     = errors.New(ErrMsgUnknownWeapon)
+
+	// Garden errors
+	ErrGardenFull       = errors.New(ErrMsgGardenFull)
+	ErrUnknownSeed      = errors.New(ErrMsgUnknownSeed)
+	ErrNothingToHarvest = errors.New(ErrMsgNothingToHarvest)
+
+	// Bank errors
+	ErrBankWithdrawLimit = errors.New(ErrMsgBankWithdrawLimit)
+	ErrBankGoalCooldown  = errors.New(ErrMsgBankGoalCooldown)
+
+	// Gift errors
+	ErrCannotGiftSelf      = errors.New(ErrMsgCannotGiftSelf)
+	ErrTooManyGifts        = errors.New(ErrMsgTooManyGifts)
+	ErrInvalidGiftDelivery = errors.New(ErrMsgInvalidGiftDelivery)
+	ErrGiftNotFound        = errors.New(ErrMsgGiftNotFound)
 
 	// Database/System errors
 	ErrConnectionTimeout       = errors.New(ErrMsgConnectionTimeout)
@@ -176,6 +404,7 @@ var (
 	ErrRecipeNotFound = errors.New(ErrMsgRecipeNotFound)
 	ErrRecipeLocked   = errors.New(ErrMsgRecipeLocked)
 	ErrInvalidRecipe  = errors.New(ErrMsgInvalidRecipe)
+	ErrRecipeOffEvent = errors.New(ErrMsgRecipeOffEvent)
 
 	// Validation errors
 	ErrInvalidInput    = errors.New(ErrMsgInvalidInput)
@@ -192,6 +421,11 @@ var (
 	ErrAccumulationInProgress = errors.New(ErrMsgAccumulationInProgress)
 	ErrSessionAlreadyFrozen   = errors.New(ErrMsgSessionAlreadyFrozen)
 	ErrNoNodesAvailable       = errors.New(ErrMsgNoNodesAvailable)
+	ErrExternalCapReached     = errors.New(ErrMsgExternalCapReached)
+	ErrInvalidSource          = errors.New(ErrMsgInvalidSource)
+	ErrSelfDelegation         = errors.New(ErrMsgSelfDelegation)
+	ErrVoteDelegationChain    = errors.New(ErrMsgVoteDelegationChain)
+	ErrVoteDelegationNotFound = errors.New(ErrMsgVoteDelegationNotFound)
 
 	// Harvest errors
 	ErrHarvestStateNotFound = errors.New(ErrMsgHarvestStateNotFound)
@@ -212,3 +446,19 @@ var (
 	ErrDuelExpired      = errors.New(ErrMsgDuelExpired)
 	ErrDuelUnauthorized = errors.New(ErrMsgDuelUnauthorized)
 )
+
+// ItemNotFoundError is ErrItemNotFound for a name typed by a user, with the
+// item names it was probably meant to be
+type ItemNotFoundError struct {
+	Name        string
+	Suggestions []string
+}
+
+func (e ItemNotFoundError) Error() string {
+	return ErrMsgItemNotFound
+}
+
+// Is allows errors.Is() to match ErrItemNotFound
+func (e ItemNotFoundError) Is(target error) bool {
+	return target == ErrItemNotFound
+}
@@ -71,6 +71,8 @@ type SearchPerformedPayload struct {
 	XPAmount       int    `json:"xp_amount"`
 	ItemName       string `json:"item_name,omitempty"`
 	Quantity       int    `json:"quantity,omitempty"`
+	Location       string `json:"location,omitempty"`   // Region key the search happened in
+	RareEvent      string `json:"rare_event,omitempty"` // Key of the rare event triggered, if any
 	Timestamp      int64  `json:"timestamp"`
 }
 
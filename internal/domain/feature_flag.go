@@ -0,0 +1,18 @@
+package domain
+
+import "time"
+
+// FeatureFlag is an admin-managed switch for dark-launching a feature,
+// independent of the community progression tree
+type FeatureFlag struct {
+	Key         string `json:"key"`
+	Description string `json:"description"`
+	Enabled     bool   `json:"enabled"`
+	// RolloutPercent limits an enabled flag to a stable share of users (0-100)
+	RolloutPercent int `json:"rollout_percent"`
+	// PlatformOverrides replaces Enabled for the listed platforms
+	PlatformOverrides map[string]bool `json:"platform_overrides"`
+	UpdatedBy         string          `json:"updated_by"`
+	CreatedAt         time.Time       `json:"created_at"`
+	UpdatedAt         time.Time       `json:"updated_at"`
+}
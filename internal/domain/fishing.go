@@ -0,0 +1,41 @@
+package domain
+
+import "time"
+
+// FishingOutcome is how reeling in a cast ended
+type FishingOutcome string
+
+const (
+	FishingOutcomeCaught   FishingOutcome = "caught"    // Reeled in while the fish was biting
+	FishingOutcomeTooEarly FishingOutcome = "too_early" // Reeled in before the bite, scaring the fish off
+	FishingOutcomeGotAway  FishingOutcome = "got_away"  // Reeled in after the bite window closed
+)
+
+// FishingCast is a user's line in the water. The bite and reel deadline are
+// not sent to the caster, so the bite can't be timed from the response.
+type FishingCast struct {
+	UserID   string    `json:"user_id"`
+	Username string    `json:"username"`
+	Tackle   string    `json:"tackle,omitempty"` // Tackle item used up by the cast
+	CastAt   time.Time `json:"cast_at"`
+	BiteAt   time.Time `json:"-"`
+	ReelBy   time.Time `json:"-"`
+}
+
+// FishCatch is a fish landed by a reel
+type FishCatch struct {
+	ItemName   string       `json:"item_name"`
+	PublicName string       `json:"public_name"`
+	Quality    QualityLevel `json:"quality"`
+	XP         int          `json:"xp"` // Fisher XP for the catch
+}
+
+// ReelResult is the outcome of reeling in a cast
+type ReelResult struct {
+	UserID     string         `json:"user_id"`
+	Username   string         `json:"username"`
+	Outcome    FishingOutcome `json:"outcome"`
+	ReactionMs int64          `json:"reaction_ms"` // Time from the bite to the reel, negative when too early
+	Tackle     string         `json:"tackle,omitempty"`
+	Catch      *FishCatch     `json:"catch,omitempty"`
+}
@@ -4,6 +4,8 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+
+	"github.com/osse101/BrandishBot_Go/internal/ids"
 )
 
 // Gamble represents a multiplayer lootbox gamble session
@@ -13,11 +15,18 @@ type Gamble struct {
 	State        GambleState   `json:"state"`
 	CreatedAt    time.Time     `json:"created_at"`
 	JoinDeadline time.Time     `json:"join_deadline"`
+	CommunityID  string        `json:"community_id,omitempty"`
 	Participants []Participant `json:"participants,omitempty"`
+	SideBets     []SideBet     `json:"side_bets,omitempty"`
 	WinnerID     *string       `json:"winner_id,omitempty"`
 	TotalValue   int64         `json:"total_value,omitempty"`
 }
 
+// ShortCode returns the chat-friendly reference for the gamble, e.g. "G-7F3K"
+func (g *Gamble) ShortCode() string {
+	return ids.ShortCode(ids.PrefixGamble, g.ID)
+}
+
 // LootboxBet represents a wager of a specific lootbox item
 type LootboxBet struct {
 	ItemName     string       `json:"item_name" validate:"required"`
@@ -33,6 +42,38 @@ type Participant struct {
 	Username    string       `json:"username,omitempty"` // Populated for display
 }
 
+// SideBet is a spectator's money stake on which participant wins a gamble
+type SideBet struct {
+	GambleID       uuid.UUID `json:"gamble_id"`
+	UserID         string    `json:"user_id"`
+	Username       string    `json:"username,omitempty"`
+	BackedUserID   string    `json:"backed_user_id"`
+	BackedUsername string    `json:"backed_username,omitempty"`
+	Amount         int       `json:"amount"`
+	Payout         *int      `json:"payout,omitempty"` // Set once the gamble settles; refunds pay back the amount
+	CreatedAt      time.Time `json:"created_at"`
+}
+
+// GambleOdds is the side-bet pool of a gamble and what backing each
+// participant would pay
+type GambleOdds struct {
+	GambleID     uuid.UUID         `json:"gamble_id"`
+	State        GambleState       `json:"state"`
+	Pool         int               `json:"pool"`
+	Bettors      int               `json:"bettors"`
+	Participants []ParticipantOdds `json:"participants"`
+}
+
+// ParticipantOdds is the side-bet action on one participant. Multiplier is
+// the pool paid out per unit staked if they win, or 0 when nobody backs them.
+type ParticipantOdds struct {
+	UserID     string  `json:"user_id"`
+	Username   string  `json:"username,omitempty"`
+	Staked     int     `json:"staked"`
+	Bettors    int     `json:"bettors"`
+	Multiplier float64 `json:"multiplier"`
+}
+
 // GambleOpenedItem represents an item opened during the gamble
 type GambleOpenedItem struct {
 	GambleID     uuid.UUID    `json:"gamble_id"`
@@ -46,8 +87,27 @@ type GambleOpenedItem struct {
 
 // GambleResult contains the outcome of a completed gamble
 type GambleResult struct {
-	GambleID   uuid.UUID          `json:"gamble_id"`
-	WinnerID   string             `json:"winner_id"`
-	TotalValue int64              `json:"total_value"`
-	Items      []GambleOpenedItem `json:"items"`
+	GambleID    uuid.UUID          `json:"gamble_id"`
+	WinnerID    string             `json:"winner_id"`
+	TotalValue  int64              `json:"total_value"`
+	Rake        int                `json:"rake,omitempty"`          // Money withheld from the winner for the community pool
+	SideBetPool int                `json:"side_bet_pool,omitempty"` // Money staked by spectators on the outcome
+	Items       []GambleOpenedItem `json:"items"`
+}
+
+// GambleRecoveryAction describes how a stale gamble was resolved
+type GambleRecoveryAction string
+
+const (
+	GambleRecoveryResumed  GambleRecoveryAction = "resumed"  // Executed as if the worker had run it on time
+	GambleRecoveryRefunded GambleRecoveryAction = "refunded" // Bets returned to every participant
+)
+
+// GambleRecovery records a gamble that was stuck in a non-terminal state and has been resolved
+type GambleRecovery struct {
+	GambleID      uuid.UUID            `json:"gamble_id"`
+	PreviousState GambleState          `json:"previous_state"`
+	Action        GambleRecoveryAction `json:"action"`
+	Participants  int                  `json:"participants"`
+	Reason        string               `json:"reason,omitempty"` // Why a resume fell back to a refund
 }
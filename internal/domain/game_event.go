@@ -0,0 +1,57 @@
+package domain
+
+import "time"
+
+// GameEvent is a time-boxed global event, such as a double XP weekend, that
+// changes rewards or prices for everyone while it runs
+type GameEvent struct {
+	ID          int64  `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	// XPMultiplier scales job XP; 1 leaves it alone
+	XPMultiplier float64 `json:"xp_multiplier"`
+	// DropRateMultiplier scales the chance of a search or lootbox finding an item; 1 leaves it alone
+	DropRateMultiplier float64 `json:"drop_rate_multiplier"`
+	// ShopDiscountPercent comes off shop prices
+	ShopDiscountPercent int `json:"shop_discount_percent"`
+	// Theme opens the crafting recipes and chat keyword drops of an event
+	// theme, such as emote_fest, while the event runs; empty runs none
+	Theme     string    `json:"theme,omitempty"`
+	StartsAt  time.Time `json:"starts_at"`
+	EndsAt    time.Time `json:"ends_at"`
+	CreatedBy string    `json:"created_by"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// IsActive reports whether the event is running at now
+func (e GameEvent) IsActive(now time.Time) bool {
+	return !now.Before(e.StartsAt) && now.Before(e.EndsAt)
+}
+
+// GameModifiers are the combined modifiers of the game events running now.
+// Multipliers from several events multiply together and discounts add up.
+type GameModifiers struct {
+	XPMultiplier        float64 `json:"xp_multiplier"`
+	DropRateMultiplier  float64 `json:"drop_rate_multiplier"`
+	ShopDiscountPercent int     `json:"shop_discount_percent"`
+	// Events names the running events, in start order
+	Events []string `json:"events"`
+	// Themes lists the event themes the running events open
+	Themes []string `json:"themes"`
+}
+
+// HasTheme reports whether a running event opens theme
+func (m GameModifiers) HasTheme(theme string) bool {
+	for _, t := range m.Themes {
+		if t == theme {
+			return true
+		}
+	}
+	return false
+}
+
+// GameEventStatus lists the running game events and what they add up to
+type GameEventStatus struct {
+	Events    []GameEvent   `json:"events"`
+	Modifiers GameModifiers `json:"modifiers"`
+}
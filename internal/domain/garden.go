@@ -0,0 +1,36 @@
+package domain
+
+import "time"
+
+// GardenPlot is a seed growing in one of a user's garden plots
+type GardenPlot struct {
+	Slot      int       `json:"slot"`
+	Seed      string    `json:"seed"`
+	Crop      string    `json:"crop"`     // Item the seed grows into
+	Quantity  int       `json:"quantity"` // How many of the crop it yields
+	PlantedAt time.Time `json:"planted_at"`
+	ReadyAt   time.Time `json:"ready_at"`
+	Ready     bool      `json:"ready"` // Worked out on access from ready_at
+}
+
+// Garden is a user's garden plots and how many they can plant at once
+type Garden struct {
+	UserID   string       `json:"user_id"`
+	Capacity int          `json:"capacity"` // Plots unlocked by progression
+	Plots    []GardenPlot `json:"plots"`
+}
+
+// GardenCrop is a crop harvested from the garden
+type GardenCrop struct {
+	ItemName string `json:"item_name"`
+	Quantity int    `json:"quantity"`
+}
+
+// GardenHarvest is the outcome of harvesting every ready plot
+type GardenHarvest struct {
+	UserID   string       `json:"user_id"`
+	Username string       `json:"username"`
+	Crops    []GardenCrop `json:"crops"`
+	Plots    int          `json:"plots"` // Plots cleared by the harvest
+	XP       int          `json:"xp"`    // Farmer XP for the harvest
+}
@@ -0,0 +1,44 @@
+package domain
+
+import "time"
+
+// GiftStatus is where a gift is in its lifecycle
+type GiftStatus string
+
+// Gift statuses
+const (
+	// GiftStatusPending gifts are wrapped and waiting to be delivered
+	GiftStatusPending GiftStatus = "pending"
+	// GiftStatusDelivered gifts reached their recipient
+	GiftStatusDelivered GiftStatus = "delivered"
+	// GiftStatusCancelled gifts went back to the sender, either because the
+	// sender unwrapped them or the recipient's account was deleted
+	GiftStatusCancelled GiftStatus = "cancelled"
+)
+
+// Gift is a wrapped package of items addressed to another user. The items
+// leave the sender's inventory when the gift is wrapped and reach the
+// recipient once the gift falls due, along with the sender's message.
+type Gift struct {
+	ID         int64  `json:"id"`
+	SenderID   string `json:"sender_id"`
+	SenderName string `json:"sender_name,omitempty"`
+	// RecipientID is empty once the recipient's account has been deleted
+	RecipientID   string       `json:"recipient_id,omitempty"`
+	RecipientName string       `json:"recipient_name,omitempty"`
+	ItemID        int          `json:"item_id"`
+	ItemName      string       `json:"item_name,omitempty"`
+	QualityLevel  QualityLevel `json:"quality_level"`
+	Quantity      int          `json:"quantity"`
+	Message       string       `json:"message,omitempty"`
+	// Platform and ChannelID say where the delivery is announced. An empty
+	// channel announces the delivery to the recipient directly, by their
+	// RecipientPlatformID.
+	Platform            string     `json:"platform"`
+	RecipientPlatformID string     `json:"recipient_platform_id,omitempty"`
+	ChannelID           string     `json:"channel_id,omitempty"`
+	Status              GiftStatus `json:"status"`
+	DeliverAt           time.Time  `json:"deliver_at"`
+	CreatedAt           time.Time  `json:"created_at"`
+	ClosedAt            *time.Time `json:"closed_at,omitempty"`
+}
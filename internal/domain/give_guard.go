@@ -0,0 +1,29 @@
+package domain
+
+import "time"
+
+// GiveFlagStatus is where a flagged give is in admin review
+type GiveFlagStatus string
+
+const (
+	GiveFlagPending   GiveFlagStatus = "pending"
+	GiveFlagDismissed GiveFlagStatus = "dismissed"
+	GiveFlagConfirmed GiveFlagStatus = "confirmed"
+)
+
+// GiveFlag is a completed give that anomaly detection queued for admin review
+type GiveFlag struct {
+	ID           int64          `json:"id"`
+	GiverID      string         `json:"giver_id"`
+	GiverName    string         `json:"giver_name,omitempty"`
+	ReceiverID   string         `json:"receiver_id"`
+	ReceiverName string         `json:"receiver_name,omitempty"`
+	ItemID       int            `json:"item_id"`
+	ItemName     string         `json:"item_name,omitempty"`
+	Quantity     int            `json:"quantity"`
+	Reason       string         `json:"reason"`
+	Status       GiveFlagStatus `json:"status"`
+	ReviewedBy   string         `json:"reviewed_by,omitempty"`
+	ReviewedAt   *time.Time     `json:"reviewed_at,omitempty"`
+	CreatedAt    time.Time      `json:"created_at"`
+}
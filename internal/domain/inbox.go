@@ -0,0 +1,36 @@
+package domain
+
+import "time"
+
+// InboxKind is what an inbox message is about
+type InboxKind string
+
+// Inbox message kinds
+const (
+	// InboxKindReward is for items or money the user received while away,
+	// such as market sales, returned loans and boss loot
+	InboxKindReward InboxKind = "reward"
+	// InboxKindGift is for gifts delivered to the user
+	InboxKindGift InboxKind = "gift"
+	// InboxKindAchievement is for milestones the user unlocked
+	InboxKindAchievement InboxKind = "achievement"
+)
+
+// InboxMessage is a notification kept for a user, so they learn what
+// happened while they were offline
+type InboxMessage struct {
+	ID        int64      `json:"id"`
+	UserID    string     `json:"user_id"`
+	Kind      InboxKind  `json:"kind"`
+	Title     string     `json:"title"`
+	Body      string     `json:"body"`
+	ReadAt    *time.Time `json:"read_at,omitempty"` // Nil while unread
+	CreatedAt time.Time  `json:"created_at"`
+}
+
+// Inbox is a page of a user's messages, newest first
+type Inbox struct {
+	Messages []InboxMessage `json:"messages"`
+	// Unread counts every unread message, not only those on this page
+	Unread int `json:"unread"`
+}
@@ -12,3 +12,10 @@ type Inventory struct {
 	Slots      []InventorySlot `json:"slots"`
 	LastUpdate int64           `json:"last_update,omitempty"`
 }
+
+// InventoryCapacity reports how many of a user's inventory slots are filled.
+// Money is held without taking a slot.
+type InventoryCapacity struct {
+	UsedSlots int `json:"used_slots"`
+	MaxSlots  int `json:"max_slots"` // 0 means no limit
+}
@@ -10,7 +10,8 @@ type Item struct {
 	PublicName     string   `json:"public_name" db:"public_name"`
 	DefaultDisplay string   `json:"default_display" db:"default_display"`
 	Description    string   `json:"description" db:"item_description"`
-	BaseValue      int      `json:"base_value" db:"base_value"`     // Buy price
+	BaseValue      int      `json:"base_value" db:"base_value"`     // Buy price before market adjustment
+	BuyPrice       *int     `json:"buy_price,omitempty"`            // Market-adjusted buy price (only set for buyable items)
 	SellPrice      *int     `json:"sell_price,omitempty"`           // Calculated sell price (only set for sellable items)
 	Types          []string `json:"types" db:"types"`               // Populated from join/separate query
 	ContentType    []string `json:"content_type" db:"content_type"` // Content type categorization (weapon, material, etc.)
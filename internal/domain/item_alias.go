@@ -0,0 +1,15 @@
+package domain
+
+import "time"
+
+// ItemAlias is an admin-managed pool of display names for an item. It
+// replaces the item's pool from the aliases config file.
+type ItemAlias struct {
+	InternalName string   `json:"internal_name"`
+	Default      []string `json:"default"`
+	// Themes maps a theme name to the aliases shown while it is active
+	Themes    map[string][]string `json:"themes"`
+	UpdatedBy string              `json:"updated_by"`
+	CreatedAt time.Time           `json:"created_at"`
+	UpdatedAt time.Time           `json:"updated_at"`
+}
@@ -0,0 +1,53 @@
+package domain
+
+import "time"
+
+// BalanceField is the item property a balance change adjusts
+type BalanceField string
+
+// Balance fields
+const (
+	// BalanceFieldBaseValue is the item's base value in money
+	BalanceFieldBaseValue BalanceField = "base_value"
+	// BalanceFieldLootWeight is the item's drop weight in one loot pool
+	BalanceFieldLootWeight BalanceField = "loot_weight"
+)
+
+// IsValid reports whether f is a known balance field
+func (f BalanceField) IsValid() bool {
+	switch f {
+	case BalanceFieldBaseValue, BalanceFieldLootWeight:
+		return true
+	}
+	return false
+}
+
+// BalanceChangeStatus is where a balance change stands relative to now
+type BalanceChangeStatus string
+
+// Balance change statuses
+const (
+	// BalanceChangeUpcoming changes have not taken effect yet
+	BalanceChangeUpcoming BalanceChangeStatus = "upcoming"
+	// BalanceChangeActive changes are the ones in force
+	BalanceChangeActive BalanceChangeStatus = "active"
+	// BalanceChangeSuperseded changes were replaced by a later change
+	BalanceChangeSuperseded BalanceChangeStatus = "superseded"
+)
+
+// ItemBalanceChange sets an item's base value or loot weight from a point in
+// time. Changes are kept once they take effect, giving a changelog of every
+// balance pass.
+type ItemBalanceChange struct {
+	ID       int64        `json:"id"`
+	ItemName string       `json:"item_name"`
+	Field    BalanceField `json:"field"`
+	// Pool is the loot pool a loot weight applies to; empty for base values
+	Pool        string              `json:"pool,omitempty"`
+	Value       int                 `json:"value"`
+	EffectiveAt time.Time           `json:"effective_at"`
+	Reason      string              `json:"reason,omitempty"`
+	CreatedBy   string              `json:"created_by"`
+	CreatedAt   time.Time           `json:"created_at"`
+	Status      BalanceChangeStatus `json:"status,omitempty"`
+}
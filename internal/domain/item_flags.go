@@ -0,0 +1,10 @@
+package domain
+
+// ItemFlags are the flags a user has put on one of their items. A locked
+// item cannot be used, sold, given or disassembled until it is unlocked.
+type ItemFlags struct {
+	ItemID   int    `json:"-"`
+	ItemName string `json:"item_name"`
+	Favorite bool   `json:"favorite"`
+	Locked   bool   `json:"locked"`
+}
@@ -0,0 +1,27 @@
+package domain
+
+import "time"
+
+// What triggered a jackpot win
+const (
+	JackpotSourceLootbox = "lootbox"
+	JackpotSourceGamble  = "gamble"
+)
+
+// JackpotWin is one payout of the progressive jackpot
+type JackpotWin struct {
+	UserID   string    `json:"user_id"`
+	Username string    `json:"username"`
+	Amount   int64     `json:"amount"`
+	Source   string    `json:"source"`
+	WonAt    time.Time `json:"won_at"`
+}
+
+// JackpotStatus summarises the progressive jackpot and its recent winners
+type JackpotStatus struct {
+	Balance             int64        `json:"balance"`
+	ContributionPercent int          `json:"contribution_percent"` // Share of each opening's value added to the pool
+	TriggerChance       float64      `json:"trigger_chance"`       // Chance each opening wins the pool
+	MaxBalance          int64        `json:"max_balance"`          // Most the pool grows to, 0 for no maximum
+	RecentWins          []JackpotWin `json:"recent_wins"`
+}
@@ -22,6 +22,8 @@ type UserJob struct {
 	CurrentLevel  int        `json:"current_level"`
 	XPGainedToday int64      `json:"xp_gained_today"`
 	LastXPGain    *time.Time `json:"last_xp_gain,omitempty"`
+	Prestige      int        `json:"prestige"`  // Times the job was reset at the level cap
+	IsActive      bool       `json:"is_active"` // The user's chosen job, which earns bonus XP
 }
 
 // JobXPMetadata represents structured metadata for XP gain events
@@ -76,15 +78,69 @@ type UserJobInfo struct {
 	LevelRequirement int64  `json:"level_requirement"` // Total XP needed for current level
 	XPToNextLevel    int64  `json:"xp_to_next_level"`
 	MaxLevel         int    `json:"max_level"` // From progression system
+	Prestige         int    `json:"prestige"`
+	IsActive         bool   `json:"is_active"`
 }
 
-// XPAwardResult contains the outcome of awarding XP
+// JobPerk is a perk a job grants from a level onwards
+type JobPerk struct {
+	Level       int    `json:"level"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Unlocked    bool   `json:"unlocked"` // Whether the user's level has reached it
+}
+
+// JobPerks lists a job's perk table along with the user's standing in it
+type JobPerks struct {
+	JobKey   string    `json:"job_key"`
+	Level    int       `json:"level"`
+	Prestige int       `json:"prestige"`
+	XPBonus  float64   `json:"xp_bonus"` // Permanent XP bonus from prestige (0.04 = +4%)
+	Perks    []JobPerk `json:"perks"`
+}
+
+// JobPrestigeResult contains the outcome of prestiging a job
+type JobPrestigeResult struct {
+	JobKey   string  `json:"job_key"`
+	Prestige int     `json:"prestige"`
+	XPBonus  float64 `json:"xp_bonus"`
+}
+
+// XPMultiplier is one factor of the multiplier applied to awarded job XP
+type XPMultiplier struct {
+	Source     string  `json:"source"`
+	Name       string  `json:"name,omitempty"` // Set for global XP events
+	Multiplier float64 `json:"multiplier"`
+}
+
+// XPAwardResult contains the outcome of awarding XP. XPGained is BaseXP times
+// Multiplier, limited by the daily cap; Breakdown lists the factors of Multiplier.
 type XPAwardResult struct {
-	JobKey    string `json:"job_key"`
-	XPGained  int    `json:"xp_gained"`
-	NewXP     int64  `json:"new_xp"`
-	NewLevel  int    `json:"new_level"`
-	LeveledUp bool   `json:"leveled_up"`
+	JobKey     string         `json:"job_key"`
+	BaseXP     int            `json:"base_xp"`
+	Multiplier float64        `json:"multiplier"`
+	Breakdown  []XPMultiplier `json:"breakdown,omitempty"`
+	XPGained   int            `json:"xp_gained"`
+	NewXP      int64          `json:"new_xp"`
+	NewLevel   int            `json:"new_level"`
+	LeveledUp  bool           `json:"leveled_up"`
+}
+
+// XPAward is an audit record of one job XP award. CappedBy names the cap
+// ("daily" or "source") that reduced the award, if any.
+type XPAward struct {
+	ID         int64         `json:"id"`
+	UserID     string        `json:"user_id"`
+	JobKey     string        `json:"job_key"`
+	Source     string        `json:"source"`
+	BaseXP     int           `json:"base_xp"`
+	XPAwarded  int           `json:"xp_awarded"`
+	Multiplier float64       `json:"multiplier"`
+	CappedBy   string        `json:"capped_by,omitempty"`
+	OldLevel   int           `json:"old_level"`
+	NewLevel   int           `json:"new_level"`
+	Metadata   JobXPMetadata `json:"metadata"`
+	AwardedAt  time.Time     `json:"awarded_at"`
 }
 
 // DailyResetStatus shows the state of daily job XP resets
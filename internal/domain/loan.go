@@ -0,0 +1,37 @@
+package domain
+
+import "time"
+
+// LoanStatus is where an item loan is in its lifecycle
+type LoanStatus string
+
+// Loan statuses
+const (
+	// LoanStatusActive loans are with the borrower
+	LoanStatusActive LoanStatus = "active"
+	// LoanStatusReturned loans came back to the lender in full
+	LoanStatusReturned LoanStatus = "returned"
+	// LoanStatusDefaulted loans could not be returned by the borrower in
+	// full. A borrower short of the items returns what they still have; a
+	// deleted borrower's loan is restored to the lender in full.
+	LoanStatusDefaulted LoanStatus = "defaulted"
+)
+
+// ItemLoan is a time-limited transfer of items from a lender to a borrower.
+// Borrowed items cannot be sold, disassembled or lent on, and go back to the
+// lender when the borrower returns them or the loan falls due.
+type ItemLoan struct {
+	ID       int64  `json:"id"`
+	LenderID string `json:"lender_id"`
+	// BorrowerID is empty once the borrower's account has been deleted
+	BorrowerID       string       `json:"borrower_id,omitempty"`
+	ItemID           int          `json:"item_id"`
+	ItemName         string       `json:"item_name,omitempty"`
+	QualityLevel     QualityLevel `json:"quality_level"`
+	Quantity         int          `json:"quantity"`
+	ReturnedQuantity int          `json:"returned_quantity"`
+	Status           LoanStatus   `json:"status"`
+	DueAt            time.Time    `json:"due_at"`
+	CreatedAt        time.Time    `json:"created_at"`
+	ClosedAt         *time.Time   `json:"closed_at,omitempty"`
+}
@@ -0,0 +1,31 @@
+package domain
+
+import "time"
+
+// ItemMarketState is an item's net trade pressure: units bought minus units
+// sold, decaying toward zero from UpdatedAt
+type ItemMarketState struct {
+	ItemID    int
+	BaseValue int
+	Pressure  float64
+	UpdatedAt time.Time
+	// LastMultiplier is the most recently recorded price multiplier, nil if
+	// the item has no price history yet
+	LastMultiplier *float64
+}
+
+// PricePoint is an item's dynamic price at one moment
+type PricePoint struct {
+	Multiplier float64   `json:"multiplier"`
+	BuyPrice   int       `json:"buy_price"`
+	SellPrice  int       `json:"sell_price"`
+	RecordedAt time.Time `json:"recorded_at"`
+}
+
+// PriceHistory is an item's recorded prices, oldest first, with its price now
+type PriceHistory struct {
+	ItemName  string       `json:"item_name"`
+	BaseValue int          `json:"base_value"`
+	Current   PricePoint   `json:"current"`
+	Points    []PricePoint `json:"points"`
+}
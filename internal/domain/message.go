@@ -1,5 +1,7 @@
 package domain
 
+import "encoding/json"
+
 // FoundString represents a string pattern that was found in a user message
 type FoundString struct {
 	Code  string `json:"code"`
@@ -10,4 +12,21 @@ type FoundString struct {
 type MessageResult struct {
 	User    User          `json:"user"`
 	Matches []FoundString `json:"matches"`
+	// Command is set when the message was a text command
+	Command *CommandResult `json:"command,omitempty"`
+	// KeywordDrop is set when the message said an event theme's keyword and
+	// it dropped an item to the sender
+	KeywordDrop *ChatDrop `json:"keyword_drop,omitempty"`
+}
+
+// CommandResult is the outcome of a text command sent as a chat message.
+// Reply is ready to post back into chat; Data is the JSON the command's API
+// endpoint returned, for clients that want more than the reply.
+type CommandResult struct {
+	Name  string          `json:"name"`
+	Args  []string        `json:"args"`
+	OK    bool            `json:"ok"`
+	Code  string          `json:"code,omitempty"`
+	Reply string          `json:"reply"`
+	Data  json.RawMessage `json:"data,omitempty" swaggertype:"object"`
 }
@@ -0,0 +1,30 @@
+package domain
+
+import "time"
+
+// ModerationActionType is an action a moderator took against an account
+type ModerationActionType string
+
+const (
+	ModerationFreeze        ModerationActionType = "freeze"
+	ModerationUnfreeze      ModerationActionType = "unfreeze"
+	ModerationWipeInventory ModerationActionType = "wipe_inventory"
+)
+
+// AccountFreeze blocks an account from economy actions until it is lifted
+type AccountFreeze struct {
+	UserID   string    `json:"user_id"`
+	FrozenBy string    `json:"frozen_by"`
+	Reason   string    `json:"reason,omitempty"`
+	FrozenAt time.Time `json:"frozen_at"`
+}
+
+// ModerationAction is one entry in an account's moderation history
+type ModerationAction struct {
+	ID        int64                `json:"id"`
+	UserID    string               `json:"user_id"`
+	Action    ModerationActionType `json:"action"`
+	Actor     string               `json:"actor"`
+	Reason    string               `json:"reason,omitempty"`
+	CreatedAt time.Time            `json:"created_at"`
+}
@@ -0,0 +1,77 @@
+package domain
+
+import "errors"
+
+// Monetization event sources
+const (
+	MonetizationSourceTwitch     = "twitch"
+	MonetizationSourceStreamlabs = "streamlabs"
+)
+
+// Monetization event kinds
+const (
+	MonetizationKindSub      = "sub"
+	MonetizationKindResub    = "resub"
+	MonetizationKindGiftSub  = "gift_sub"
+	MonetizationKindDonation = "donation"
+	MonetizationKindCheer    = "cheer"
+)
+
+// ErrDuplicateMonetizationEvent is returned when an event ID has already been processed
+var ErrDuplicateMonetizationEvent = errors.New("monetization event already processed")
+
+// MonetizationEvent is a subscription or donation forwarded from Twitch or
+// Streamlabs (usually relayed by Streamer.bot)
+type MonetizationEvent struct {
+	Source     string  `json:"source" validate:"required,oneof=twitch streamlabs"`
+	EventID    string  `json:"event_id" validate:"required,max=128"`
+	Kind       string  `json:"kind" validate:"required,oneof=sub resub gift_sub donation cheer"`
+	Platform   string  `json:"platform" validate:"omitempty,platform"`
+	PlatformID string  `json:"platform_id" validate:"max=100"`
+	Username   string  `json:"username" validate:"max=100"`
+	Tier       string  `json:"tier,omitempty" validate:"max=20"`     // Subscription tier, e.g. "1000"
+	Quantity   int     `json:"quantity,omitempty" validate:"min=0"`  // Gifted subs, months or bits
+	Amount     float64 `json:"amount,omitempty" validate:"min=0"`    // Donation amount
+	Currency   string  `json:"currency,omitempty" validate:"max=10"` // Donation currency
+}
+
+// MonetizationConfig maps monetization events to rewards
+type MonetizationConfig struct {
+	Version string             `json:"version"`
+	Rules   []MonetizationRule `json:"rules"`
+}
+
+// MonetizationRule grants Rewards for events of Kind. Tier and MinAmount
+// narrow the match; the first matching rule in the config wins.
+type MonetizationRule struct {
+	Kind      string              `json:"kind"`
+	Tier      string              `json:"tier,omitempty"`
+	MinAmount float64             `json:"min_amount,omitempty"`
+	Rewards   MonetizationRewards `json:"rewards"`
+}
+
+// MonetizationRewards are granted to the user behind an event
+type MonetizationRewards struct {
+	Items               map[string]int `json:"items,omitempty"`
+	Contribution        int            `json:"contribution,omitempty"`
+	ContributionPerUnit int            `json:"contribution_per_unit,omitempty"` // Per gifted sub, bit or whole currency unit
+	XPBoost             *XPBoostReward `json:"xp_boost,omitempty"`
+}
+
+// XPBoostReward is a timed job XP multiplier
+type XPBoostReward struct {
+	Multiplier float64 `json:"multiplier"`
+	Duration   string  `json:"duration"` // Go duration string, e.g. "24h"
+}
+
+// MonetizationResult describes what an event granted
+type MonetizationResult struct {
+	Source          string         `json:"source"`
+	EventID         string         `json:"event_id"`
+	UserID          string         `json:"user_id,omitempty"`
+	Items           map[string]int `json:"items,omitempty"`
+	Contribution    int            `json:"contribution,omitempty"`
+	XPMultiplier    float64        `json:"xp_multiplier,omitempty"`
+	XPBoostDuration string         `json:"xp_boost_duration,omitempty"`
+	Matched         bool           `json:"matched"` // False when no rule matched the event
+}
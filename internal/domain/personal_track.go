@@ -0,0 +1,30 @@
+package domain
+
+import "time"
+
+// ProgressionTypeMilestone marks personal track milestones in user_progression
+const ProgressionTypeMilestone = "milestone"
+
+// PersonalTrack is one user's progress along a personal progression track.
+// Unlike the community tree, a track advances only with the user's own
+// activity.
+type PersonalTrack struct {
+	Key         string              `json:"key"`
+	Name        string              `json:"name"`
+	Description string              `json:"description,omitempty"`
+	Milestones  []PersonalMilestone `json:"milestones"`
+}
+
+// PersonalMilestone is a step on a personal track, unlocked once the user's
+// total for an engagement metric reaches its threshold
+type PersonalMilestone struct {
+	Key         string `json:"key"`
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	Metric      string `json:"metric"`
+	Threshold   int64  `json:"threshold"`
+	// Progress is the user's total for the metric, capped at the threshold
+	Progress   int64      `json:"progress"`
+	Unlocked   bool       `json:"unlocked"`
+	UnlockedAt *time.Time `json:"unlocked_at,omitempty"`
+}
@@ -0,0 +1,69 @@
+package domain
+
+import "time"
+
+// ListingStatus is where a player shop listing is in its lifecycle
+type ListingStatus string
+
+// Listing statuses
+const (
+	// ListingStatusActive listings still have items for sale
+	ListingStatusActive ListingStatus = "active"
+	// ListingStatusSold listings sold every item
+	ListingStatusSold ListingStatus = "sold"
+	// ListingStatusCancelled listings were withdrawn by the seller, who got
+	// the unsold items back
+	ListingStatusCancelled ListingStatus = "cancelled"
+)
+
+// PlayerListing is a user's offer to sell items to other users at a fixed
+// unit price. The items are held by the listing until bought or withdrawn.
+type PlayerListing struct {
+	ID           int64        `json:"id"`
+	SellerID     string       `json:"seller_id"`
+	SellerName   string       `json:"seller_name,omitempty"`
+	ItemID       int          `json:"item_id"`
+	ItemName     string       `json:"item_name,omitempty"`
+	QualityLevel QualityLevel `json:"quality_level"`
+	// Quantity is how many items remain for sale
+	Quantity  int           `json:"quantity"`
+	UnitPrice int           `json:"unit_price"`
+	Status    ListingStatus `json:"status"`
+	// ListingFee is what the seller paid to post the listing. It is not
+	// refunded if the listing is cancelled.
+	ListingFee int        `json:"listing_fee"`
+	CreatedAt  time.Time  `json:"created_at"`
+	ClosedAt   *time.Time `json:"closed_at,omitempty"`
+}
+
+// ListingSort orders a search of active listings
+type ListingSort string
+
+// Listing sort orders
+const (
+	// ListingSortPrice lists the cheapest unit price first
+	ListingSortPrice ListingSort = "price"
+	// ListingSortNewest lists the most recently posted first
+	ListingSortNewest ListingSort = "newest"
+)
+
+// ListingFilter narrows a search of active listings. Zero values match
+// every listing.
+type ListingFilter struct {
+	ItemID     int
+	SellerName string
+	Quality    QualityLevel
+	MinPrice   int
+	MaxPrice   int
+	Sort       ListingSort
+}
+
+// PlayerShopPurchase is the outcome of buying from a player listing. The
+// seller receives the total less the fee, which goes to the community pool.
+type PlayerShopPurchase struct {
+	Listing    PlayerListing `json:"listing"`
+	Quantity   int           `json:"quantity"`
+	TotalPrice int           `json:"total_price"`
+	Fee        int           `json:"fee"`
+	SellerGets int           `json:"seller_gets"`
+}
@@ -108,12 +108,40 @@ type DynamicPrerequisite struct {
 	Count int    `json:"count"`          // Required count
 }
 
+// NodeEffects declares what unlocking a node does. Features and items are
+// enabled while the node is unlocked; config deltas are added to a feature
+// value once per unlocked level.
+type NodeEffects struct {
+	Features     []string      `json:"features,omitempty"`      // Feature flags turned on
+	Items        []string      `json:"items,omitempty"`         // Item internal names enabled
+	ConfigDeltas []ConfigDelta `json:"config_deltas,omitempty"` // Feature value changes
+}
+
+// ConfigDelta adds Delta to the feature value named by Key
+type ConfigDelta struct {
+	Key   string  `json:"key"`
+	Delta float64 `json:"delta"`
+}
+
+// IsEmpty reports whether the node declares no effects
+func (e NodeEffects) IsEmpty() bool {
+	return len(e.Features) == 0 && len(e.Items) == 0 && len(e.ConfigDeltas) == 0
+}
+
 // ProgressionTreeNode combines node info with unlock status for display
 type ProgressionTreeNode struct {
 	ProgressionNode
 	IsUnlocked    bool  `json:"is_unlocked"`
 	UnlockedLevel int   `json:"unlocked_level"` // 0 if not unlocked
 	Children      []int `json:"children"`       // Child node IDs
+
+	// PrerequisiteGroups lists prerequisite node IDs by group. Every group
+	// must have at least one unlocked node, so a group with several IDs is
+	// an OR and the groups together are an AND.
+	PrerequisiteGroups [][]int `json:"prerequisite_groups"`
+
+	// Effects previews what unlocking the node does; nil if it declares none
+	Effects *NodeEffects `json:"effects,omitempty"`
 }
 
 // ProgressionStatus represents current community status
@@ -135,6 +163,32 @@ type ContributionBreakdown struct {
 	ItemsUsed    int            `json:"items_used"`
 	TotalScore   int            `json:"total_score"`
 	ByType       map[string]int `json:"by_type,omitempty"`
+	External     map[string]int `json:"external,omitempty"` // Contribution points credited by external source
+}
+
+// ExternalContribution is a contribution pushed by another system, such as
+// donation alerts or subscription events
+type ExternalContribution struct {
+	Source     string // Tag identifying the sending system, e.g. "donations"
+	Amount     int
+	HourlyCap  int    // Most Source may add per rolling hour; 0 means uncapped
+	Platform   string // Optional: credit this user in their contribution breakdown
+	PlatformID string
+	Username   string
+}
+
+// ExternalContributionResult reports how much of an external contribution was applied
+type ExternalContributionResult struct {
+	Source    string `json:"source"`
+	Requested int    `json:"requested"`
+	Accepted  int    `json:"accepted"`
+	Capped    bool   `json:"capped"` // True when the hourly cap reduced the amount
+}
+
+// ExternalMetricType returns the engagement metric type that contributions
+// from an external source are recorded under
+func ExternalMetricType(source string) string {
+	return MetricTypeExternalPrefix + source
 }
 
 // ProgressionVotingSession represents a voting session for selecting next unlock
@@ -154,12 +208,126 @@ type ProgressionVotingOption struct {
 	SessionID           int              `json:"session_id"`
 	NodeID              int              `json:"node_id"`
 	TargetLevel         int              `json:"target_level"`
-	VoteCount           int              `json:"vote_count"`
+	VoteCount           int              `json:"vote_count"`           // Weighted total of counted votes
+	VoterCount          int              `json:"voter_count"`          // Counted votes, regardless of weight
 	LastHighestVoteAt   *time.Time       `json:"last_highest_vote_at"` // When first reached current highest
 	NodeDetails         *ProgressionNode `json:"node_details,omitempty"`
 	EstimatedUnlockDate *time.Time       `json:"estimated_unlock_date,omitempty"`
 }
 
+// VotingSessionRecap is a completed voting session with its outcome
+type VotingSessionRecap struct {
+	ProgressionVotingSession
+	TotalVotes    int                      `json:"total_votes"`
+	Winner        *ProgressionVotingOption `json:"winner,omitempty"`
+	UnlockedNode  *ProgressionNode         `json:"unlocked_node,omitempty"` // Nil until the winner's unlock completes
+	UnlockedLevel *int                     `json:"unlocked_level,omitempty"`
+	UnlockedAt    *time.Time               `json:"unlocked_at,omitempty"`
+}
+
+// VotingSessionHistory is one page of completed voting sessions, newest first
+type VotingSessionHistory struct {
+	Sessions []VotingSessionRecap `json:"sessions"`
+	Total    int                  `json:"total"`
+	Limit    int                  `json:"limit"`
+	Offset   int                  `json:"offset"`
+}
+
+// SessionVote is one vote in a voting session along with the voter's activity
+// before the session started
+type SessionVote struct {
+	UserID        string
+	OptionID      int
+	VotedAt       time.Time
+	NeverActive   bool // Voter had no recorded stats events before the session started
+	Flagged       bool
+	Excluded      bool
+	SuspectReason string
+}
+
+// SuspectVote is a vote flagged by brigading detection for admin review
+type SuspectVote struct {
+	SessionID  int        `json:"session_id"`
+	UserID     string     `json:"user_id"`
+	Username   string     `json:"username,omitempty"`
+	OptionID   int        `json:"option_id"`
+	NodeKey    string     `json:"node_key,omitempty"`
+	VotedAt    time.Time  `json:"voted_at"`
+	Reason     string     `json:"reason"`
+	FlaggedAt  time.Time  `json:"flagged_at"`
+	ExcludedAt *time.Time `json:"excluded_at,omitempty"` // Nil while the vote still counts
+}
+
+// VoteReviewAction is a change made to whether a vote counts towards the tally
+type VoteReviewAction string
+
+const (
+	VoteReviewExcluded VoteReviewAction = "excluded"
+	VoteReviewRestored VoteReviewAction = "restored"
+)
+
+// VoteReviewAuditEntry records one exclusion or restoration of a vote
+type VoteReviewAuditEntry struct {
+	ID        int64            `json:"id"`
+	SessionID int              `json:"session_id"`
+	UserID    string           `json:"user_id"`
+	OptionID  int              `json:"option_id"`
+	Action    VoteReviewAction `json:"action"`
+	Actor     string           `json:"actor"` // Admin who made the change, or "system" for auto-exclusion
+	Reason    string           `json:"reason,omitempty"`
+	CreatedAt time.Time        `json:"created_at"`
+}
+
+// BulkProgressionAction is what a bulk operation does to each user's progression
+type BulkProgressionAction string
+
+const (
+	BulkProgressionGrant  BulkProgressionAction = "grant"
+	BulkProgressionRevoke BulkProgressionAction = "revoke"
+)
+
+// BulkProgressionStatus is the state of a bulk progression operation
+type BulkProgressionStatus string
+
+const (
+	BulkProgressionQueued    BulkProgressionStatus = "queued"
+	BulkProgressionRunning   BulkProgressionStatus = "running"
+	BulkProgressionCompleted BulkProgressionStatus = "completed"
+	BulkProgressionFailed    BulkProgressionStatus = "failed"
+)
+
+// BulkProgressionOperation grants or revokes one user progression for many
+// users, batch by batch. Processed counts the users in committed batches;
+// Affected counts those whose progression actually changed.
+type BulkProgressionOperation struct {
+	ID               int64                 `json:"id"`
+	Action           BulkProgressionAction `json:"action"`
+	ProgressionType  string                `json:"progression_type"`
+	ProgressionKey   string                `json:"progression_key"`
+	Actor            string                `json:"actor"`
+	Reason           string                `json:"reason,omitempty"`
+	Status           BulkProgressionStatus `json:"status"`
+	TotalUsers       int                   `json:"total_users"`
+	ProcessedUsers   int                   `json:"processed_users"`
+	AffectedUsers    int                   `json:"affected_users"`
+	TotalBatches     int                   `json:"total_batches"`
+	CompletedBatches int                   `json:"completed_batches"`
+	Error            string                `json:"error,omitempty"`
+	CreatedAt        time.Time             `json:"created_at"`
+	UpdatedAt        time.Time             `json:"updated_at"`
+	CompletedAt      *time.Time            `json:"completed_at,omitempty"`
+}
+
+// BulkProgressionAuditEntry records one committed batch of a bulk operation
+type BulkProgressionAuditEntry struct {
+	ID            int64     `json:"id"`
+	OperationID   int64     `json:"operation_id"`
+	BatchNumber   int       `json:"batch_number"`
+	UserIDs       []string  `json:"user_ids"`
+	AffectedUsers int       `json:"affected_users"`
+	CreatedAt     time.Time `json:"created_at"`
+}
+
 // UnlockProgress tracks contribution points accumulated toward next unlock
 type UnlockProgress struct {
 	ID                       int        `json:"id"`
@@ -175,10 +343,27 @@ type UnlockProgress struct {
 // ContributionLeaderboardEntry represents a user's rank and contribution total
 type ContributionLeaderboardEntry struct {
 	UserID       string `json:"user_id"`
+	Username     string `json:"username,omitempty"` // Only set to AnonymousDisplayName for private users
 	Contribution int    `json:"contribution"`
 	Rank         int    `json:"rank"`
 }
 
+// VoteDelegation hands a user's session votes to another user. When the
+// delegate votes, the delegator's vote is cast for the same option unless
+// the delegator has already voted.
+type VoteDelegation struct {
+	DelegatorID      string    `json:"delegator_id"`
+	DelegateID       string    `json:"delegate_id"`
+	DelegateUsername string    `json:"delegate_username,omitempty"`
+	CreatedAt        time.Time `json:"created_at"`
+}
+
+// ContributionDecayResult reports one run of contribution score decay
+type ContributionDecayResult struct {
+	Decayed int `json:"decayed"` // Scores reduced
+	Removed int `json:"removed"` // Scores dropped once they fell below the floor
+}
+
 // VelocityMetrics holds engagement velocity data
 type VelocityMetrics struct {
 	PointsPerDay float64 `json:"points_per_day"`
@@ -0,0 +1,37 @@
+package domain
+
+// RaidKind tells raids and hosts apart
+type RaidKind string
+
+// Raid kinds
+const (
+	// RaidKindRaid is another channel sending its viewers to this one
+	RaidKindRaid RaidKind = "raid"
+	// RaidKindHost is another channel showing this one's stream
+	RaidKindHost RaidKind = "host"
+)
+
+// RaidEvent is reported by a platform adapter when another channel raids or
+// hosts the stream
+type RaidEvent struct {
+	Platform    string `json:"platform" validate:"required,platform"`
+	FromChannel string `json:"from_channel" validate:"required,max=100"`
+	Viewers     int    `json:"viewers" validate:"min=0"`
+}
+
+// RaidBonus is what a raid or host granted the community. It has no effects
+// and no contribution when the raid was too small for a bonus tier or the
+// bonuses are disabled.
+type RaidBonus struct {
+	Kind              RaidKind          `json:"kind"`
+	Platform          string            `json:"platform"`
+	FromChannel       string            `json:"from_channel"`
+	Viewers           int               `json:"viewers"`
+	Effects           []CommunityEffect `json:"effects"`
+	BonusContribution int               `json:"bonus_contribution"`
+}
+
+// Granted reports whether the raid or host granted anything
+func (b *RaidBonus) Granted() bool {
+	return len(b.Effects) > 0 || b.BonusContribution > 0
+}
@@ -16,6 +16,7 @@ type Recipe struct {
 	BaseCost         []RecipeCost `json:"base_cost"`
 	RequiredJobLevel int          `json:"required_job_level,omitempty"` // Required Blacksmith level (0 = no requirement)
 	IsAutoUnlock     bool         `json:"is_auto_unlock"`               // Whether this recipe is automatically unlocked for all users
+	EventTheme       string       `json:"event_theme,omitempty"`        // Naming theme the recipe is limited to ("" = always craftable)
 	CreatedAt        time.Time    `json:"created_at,omitempty"`
 }
 
@@ -47,3 +48,15 @@ type RecipeAssociation struct {
 	UpgradeRecipeID     int `json:"upgrade_recipe_id"`
 	DisassembleRecipeID int `json:"disassemble_recipe_id"`
 }
+
+// CraftTier is the quality tier an upgrade rolled. Each tier above standard
+// raises the crafted item's quality level, which is stored with its
+// inventory slot.
+type CraftTier string
+
+const (
+	CraftTierStandard   CraftTier = "standard"
+	CraftTierFine       CraftTier = "fine"
+	CraftTierMasterwork CraftTier = "masterwork"
+	CraftTierLegendary  CraftTier = "legendary"
+)
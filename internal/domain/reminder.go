@@ -0,0 +1,43 @@
+package domain
+
+import "time"
+
+// ReminderKind is what a reminder waits for
+type ReminderKind string
+
+// Reminder kinds
+const (
+	// ReminderKindCooldown fires when one of the user's cooldowns expires.
+	// The target is the cooldown action.
+	ReminderKindCooldown ReminderKind = "cooldown"
+	// ReminderKindVote fires shortly before the open voting session closes
+	ReminderKindVote ReminderKind = "vote"
+	// ReminderKindCompost fires when the user's compost bin finishes
+	ReminderKindCompost ReminderKind = "compost"
+)
+
+// IsValid reports whether k is a known reminder kind
+func (k ReminderKind) IsValid() bool {
+	switch k {
+	case ReminderKindCooldown, ReminderKindVote, ReminderKindCompost:
+		return true
+	}
+	return false
+}
+
+// Reminder is a pending ping for a user. It is delivered once, on the
+// platform it was requested from, and then removed.
+type Reminder struct {
+	ID         int64        `json:"id"`
+	UserID     string       `json:"user_id"`
+	Platform   string       `json:"platform"`
+	PlatformID string       `json:"platform_id"`
+	Kind       ReminderKind `json:"kind"`
+	Target     string       `json:"target,omitempty"` // Cooldown action; empty for other kinds
+	Message    string       `json:"message"`
+	// ChannelID is where the platform should post the reminder. When empty
+	// the user is messaged directly.
+	ChannelID string    `json:"channel_id,omitempty"`
+	RemindAt  time.Time `json:"remind_at"`
+	CreatedAt time.Time `json:"created_at"`
+}
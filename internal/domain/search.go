@@ -0,0 +1,76 @@
+package domain
+
+import "time"
+
+// SearchProgress tracks a user's search streak and mastery
+type SearchProgress struct {
+	UserID string `json:"-"`
+	// CurrentStreak counts consecutive UTC days with at least one search
+	CurrentStreak  int       `json:"current_streak"`
+	BestStreak     int       `json:"best_streak"`
+	LastSearchDate time.Time `json:"last_search_date"`
+	TotalSearches  int       `json:"total_searches"`
+	MasteryLevel   int       `json:"mastery_level"`
+}
+
+// SearchStreakRewardTier is the daily bonus for keeping a search streak of at least Days
+type SearchStreakRewardTier struct {
+	Days      int
+	Lootboxes int
+}
+
+// SearchStreakRewardTiers escalate the first-search-of-the-day bonus with the streak length
+var SearchStreakRewardTiers = []SearchStreakRewardTier{
+	{Days: 3, Lootboxes: 1},
+	{Days: 7, Lootboxes: 2},
+	{Days: 14, Lootboxes: 3},
+	{Days: 30, Lootboxes: 5},
+}
+
+// SearchMasteryThresholds are the lifetime searches needed for each mastery
+// level; index i holds the requirement for level i+1
+var SearchMasteryThresholds = []int{10, 30, 60, 100, 150, 225, 325, 450, 600, 800}
+
+// SearchStreakBonus returns how many bonus lootboxes a streak earns, or 0
+func SearchStreakBonus(streak int) int {
+	bonus := 0
+	for _, tier := range SearchStreakRewardTiers {
+		if streak >= tier.Days {
+			bonus = tier.Lootboxes
+		}
+	}
+	return bonus
+}
+
+// SearchMasteryLevel returns the mastery level reached after totalSearches searches
+func SearchMasteryLevel(totalSearches int) int {
+	level := 0
+	for _, threshold := range SearchMasteryThresholds {
+		if totalSearches < threshold {
+			break
+		}
+		level++
+	}
+	return level
+}
+
+// SearchesToNextMasteryLevel returns how many more searches the next level
+// needs, or 0 at the maximum level
+func SearchesToNextMasteryLevel(totalSearches int) int {
+	level := SearchMasteryLevel(totalSearches)
+	if level >= len(SearchMasteryThresholds) {
+		return 0
+	}
+	return SearchMasteryThresholds[level] - totalSearches
+}
+
+// SearchMasteryCooldownMultiplier scales the search cooldown for a mastery level
+func SearchMasteryCooldownMultiplier(level int) float64 {
+	if level <= 0 {
+		return 1.0
+	}
+	if level > len(SearchMasteryThresholds) {
+		level = len(SearchMasteryThresholds)
+	}
+	return 1.0 - float64(level)*SearchMasteryCooldownReductionPerLevel
+}
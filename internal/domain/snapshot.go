@@ -0,0 +1,38 @@
+package domain
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// SnapshotTrigger records why a snapshot was taken
+type SnapshotTrigger string
+
+const (
+	SnapshotTriggerManual    SnapshotTrigger = "manual"
+	SnapshotTriggerScheduled SnapshotTrigger = "scheduled"
+	// SnapshotTriggerPreRestore is the safety copy taken right before a restore
+	SnapshotTriggerPreRestore SnapshotTrigger = "pre_restore"
+)
+
+// GameSnapshot describes a stored snapshot without its data
+type GameSnapshot struct {
+	ID            int64           `json:"id"`
+	Trigger       SnapshotTrigger `json:"trigger"`
+	Note          string          `json:"note"`
+	CreatedBy     string          `json:"created_by"`
+	SchemaVersion int64           `json:"schema_version"`
+	// RowCounts is the number of rows captured per table
+	RowCounts map[string]int `json:"row_counts"`
+	// SizeBytes is the compressed size of the stored document
+	SizeBytes int64     `json:"size_bytes"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// SnapshotData is the exported snapshot document. Tables maps a table name to
+// a JSON array of its rows, as Postgres renders them with to_jsonb.
+type SnapshotData struct {
+	SchemaVersion int64                      `json:"schema_version"`
+	TakenAt       time.Time                  `json:"taken_at"`
+	Tables        map[string]json.RawMessage `json:"tables"`
+}
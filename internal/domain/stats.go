@@ -20,6 +20,7 @@ type CraftingMetadata struct {
 	BonusQuantity    int     `json:"bonus_quantity,omitempty"`
 	PerfectCount     int     `json:"perfect_count,omitempty"`
 	Multiplier       float64 `json:"multiplier,omitempty"`
+	RecipeKey        string  `json:"recipe_key,omitempty"`
 }
 
 // SlotsMetadata represents metadata for slots events
@@ -43,6 +44,13 @@ type GambleMetadata struct {
 	WinnerScore int64  `json:"winner_score,omitempty"`
 }
 
+// GambleWinMetadata represents metadata for gamble won events
+type GambleWinMetadata struct {
+	GambleID     string `json:"gamble_id"`
+	TotalValue   int64  `json:"total_value"`
+	Participants int    `json:"participants"`
+}
+
 // SearchMetadata represents metadata for search events
 type SearchMetadata struct {
 	IsCritical   bool `json:"is_critical"`
@@ -104,12 +112,34 @@ type StatsSummary struct {
 
 // LeaderboardEntry represents a user's position in a leaderboard
 type LeaderboardEntry struct {
+	Rank      int    `json:"rank"`
 	UserID    string `json:"user_id"`
 	Username  string `json:"username,omitempty"`
 	Count     int    `json:"count"`
 	EventType string `json:"event_type"`
 }
 
+// LeaderboardQuery selects one page of a leaderboard
+type LeaderboardQuery struct {
+	EventType EventType
+	Period    string
+	Limit     int
+	Offset    int
+	// AroundUserID centres the page on this user's rank instead of using Offset
+	AroundUserID string
+}
+
+// LeaderboardPage is one page of a leaderboard, ranked from Offset+1
+type LeaderboardPage struct {
+	EventType string             `json:"event_type"`
+	Period    string             `json:"period"`
+	Limit     int                `json:"limit"`
+	Offset    int                `json:"offset"`
+	HasMore   bool               `json:"has_more"`
+	UserRank  int                `json:"user_rank,omitempty"` // Around-user's rank; 0 when they are unranked
+	Entries   []LeaderboardEntry `json:"entries"`
+}
+
 // SlotsStats represents aggregated slots statistics for a user
 type SlotsStats struct {
 	UserID          string  `json:"user_id"`
@@ -124,3 +154,53 @@ type SlotsStats struct {
 	BiggestWin      int     `json:"biggest_win"`
 	Period          string  `json:"period,omitempty"`
 }
+
+// StatsBucket is the width of the time buckets stats are rolled up into and
+// queried by. Buckets start where Postgres date_trunc puts them; weeks start
+// on Monday.
+type StatsBucket string
+
+// Stats buckets
+const (
+	StatsBucketHour StatsBucket = "hour"
+	StatsBucketDay  StatsBucket = "day"
+	StatsBucketWeek StatsBucket = "week"
+)
+
+// StatsQuery asks for event counts over a range, grouped by any of time
+// bucket, user and event type
+type StatsQuery struct {
+	Bucket StatsBucket
+	// Start is widened to the start of its bucket; End is exclusive
+	Start time.Time
+	End   time.Time
+
+	GroupByTime      bool
+	GroupByUser      bool
+	GroupByEventType bool
+
+	// UserID and EventType filter the counted events when set
+	UserID    string
+	EventType EventType
+
+	Limit int
+}
+
+// StatsQueryRow is one group of a stats query. Fields not grouped by are empty.
+type StatsQueryRow struct {
+	BucketStart *time.Time `json:"bucket_start,omitempty"`
+	UserID      string     `json:"user_id,omitempty"`
+	EventType   EventType  `json:"event_type,omitempty"`
+	Count       int        `json:"count"`
+}
+
+// StatsQueryResult holds the groups of a stats query, largest first unless
+// grouped by time, in which case they are in time order
+type StatsQueryResult struct {
+	Bucket    StatsBucket     `json:"bucket"`
+	StartTime time.Time       `json:"start_time"`
+	EndTime   time.Time       `json:"end_time"`
+	Rows      []StatsQueryRow `json:"rows"`
+	// Truncated is set when there were more groups than the limit
+	Truncated bool `json:"truncated"`
+}
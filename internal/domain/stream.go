@@ -0,0 +1,81 @@
+package domain
+
+import "time"
+
+// StreamSession is one live stream of the community. Stats events and
+// gambles that happen while a session is live are tagged with its ID.
+type StreamSession struct {
+	ID        int64      `json:"id"`
+	Platform  string     `json:"platform"`
+	Title     string     `json:"title"`
+	StartedAt time.Time  `json:"started_at"`
+	EndedAt   *time.Time `json:"ended_at,omitempty"`
+}
+
+// Live reports whether the session has not ended yet
+func (s *StreamSession) Live() bool {
+	return s.EndedAt == nil
+}
+
+// StartStreamRequest is sent by a platform adapter when the stream goes live
+type StartStreamRequest struct {
+	Platform string `json:"platform" validate:"required,platform"`
+	Title    string `json:"title" validate:"max=200"`
+}
+
+// StreamTopUser is one of the most active users of a stream
+type StreamTopUser struct {
+	UserID   string `json:"user_id"`
+	Username string `json:"username"`
+	Events   int    `json:"events"`
+}
+
+// StreamSummary totals what happened during a stream session
+type StreamSummary struct {
+	Session         StreamSession   `json:"session"`
+	DurationSeconds int64           `json:"duration_seconds"`
+	TotalEvents     int             `json:"total_events"`
+	ActiveUsers     int             `json:"active_users"`
+	EventCounts     map[string]int  `json:"event_counts"`
+	TopUsers        []StreamTopUser `json:"top_users"`
+	Drops           int             `json:"drops"`
+	DroppedItems    int             `json:"dropped_items"`
+	Gambles         int             `json:"gambles"`
+	GambleEntries   int             `json:"gamble_entries"`
+	GambleValue     int64           `json:"gamble_value"`
+}
+
+// StreamGambleWin is the largest gamble pot won during a stream
+type StreamGambleWin struct {
+	GambleID     string `json:"gamble_id"`
+	WinnerID     string `json:"winner_id"`
+	Username     string `json:"username"`
+	TotalValue   int64  `json:"total_value"`
+	Participants int    `json:"participants"`
+}
+
+// StreamContributor is the user who contributed the most engagement during a
+// stream
+type StreamContributor struct {
+	UserID   string  `json:"user_id"`
+	Username string  `json:"username"`
+	Score    float64 `json:"score"`
+}
+
+// StreamUnlock is a progression node unlocked during a stream
+type StreamUnlock struct {
+	NodeKey     string    `json:"node_key"`
+	DisplayName string    `json:"display_name"`
+	Level       int       `json:"level"`
+	UnlockedAt  time.Time `json:"unlocked_at"`
+}
+
+// StreamRecap is the end-of-stream recap: the session's totals plus its
+// notable moments. BiggestGambleWin and TopContributor are nil when nothing
+// qualified.
+type StreamRecap struct {
+	Summary          StreamSummary      `json:"summary"`
+	BiggestGambleWin *StreamGambleWin   `json:"biggest_gamble_win,omitempty"`
+	TopContributor   *StreamContributor `json:"top_contributor,omitempty"`
+	Unlocks          []StreamUnlock     `json:"unlocks"`
+}
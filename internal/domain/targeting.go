@@ -0,0 +1,30 @@
+package domain
+
+import "time"
+
+// TargetOutcome is how an item used on another user resolved
+type TargetOutcome string
+
+const (
+	// TargetOutcomeHit means the effect landed on the target
+	TargetOutcomeHit TargetOutcome = "hit"
+	// TargetOutcomeBlocked means a shield on the target stopped the effect
+	TargetOutcomeBlocked TargetOutcome = "blocked"
+	// TargetOutcomeReflected means a mirror shield turned the effect back on the attacker
+	TargetOutcomeReflected TargetOutcome = "reflected"
+	// TargetOutcomeImmune means a timeout immunity effect on the target stopped the effect
+	TargetOutcomeImmune TargetOutcome = "immune"
+)
+
+// TargetedEffect records one use of an item against another user
+type TargetedEffect struct {
+	Platform         string
+	AttackerID       string
+	AttackerUsername string
+	DefenderID       string // Empty when the target has no account
+	DefenderUsername string
+	ItemName         string
+	Outcome          TargetOutcome
+	CounterItem      string // The counter-item that stopped the effect, if any
+	Timeout          time.Duration
+}
@@ -0,0 +1,11 @@
+package domain
+
+import "time"
+
+// UserTimeout is an active timeout on a platform user
+type UserTimeout struct {
+	Platform  string    `json:"platform"`
+	Username  string    `json:"username"`
+	Reason    string    `json:"reason,omitempty"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
@@ -0,0 +1,32 @@
+package domain
+
+import "time"
+
+// UndoAction names the operation an undo entry reverses
+type UndoAction string
+
+const (
+	UndoActionSell        UndoAction = "sell"
+	UndoActionDisassemble UndoAction = "disassemble"
+	UndoActionGive        UndoAction = "give"
+)
+
+// InventoryChange is one slot adjustment made by an undoable operation
+type InventoryChange struct {
+	UserID       string       `json:"user_id"`
+	ItemID       int          `json:"item_id"`
+	ItemName     string       `json:"item_name,omitempty"`
+	QualityLevel QualityLevel `json:"quality_level"`
+	Delta        int          `json:"delta"`
+}
+
+// UndoEntry records the inventory changes of a recent operation so the user
+// can reverse it until it expires
+type UndoEntry struct {
+	ID        int64             `json:"id"`
+	UserID    string            `json:"user_id"`
+	Action    UndoAction        `json:"action"`
+	Changes   []InventoryChange `json:"changes"`
+	CreatedAt time.Time         `json:"created_at"`
+	ExpiresAt time.Time         `json:"expires_at"`
+}
@@ -0,0 +1,37 @@
+package domain
+
+// AnonymousDisplayName is shown in place of users who hide themselves from
+// public leaderboards and contribution rankings
+const AnonymousDisplayName = "Anonymous"
+
+// UserSettings holds a user's preferences. The zero value is the default.
+type UserSettings struct {
+	// LeaderboardPrivate hides the user's name and ID from public rankings.
+	// Their activity still counts; it is listed as AnonymousDisplayName.
+	LeaderboardPrivate bool `json:"leaderboard_private"`
+
+	// TargetingOptOut keeps other users from using weapons and traps on the
+	// user, and stops the user from using them on others.
+	TargetingOptOut bool `json:"targeting_opt_out"`
+
+	// NameTheme is the item name theme the user picked. Empty follows the
+	// calendar like everyone else.
+	NameTheme string `json:"name_theme"`
+}
+
+// NameTheme is an item name theme a user can pick
+type NameTheme struct {
+	Name     string `json:"name"`
+	Start    string `json:"start"` // MM-DD the theme starts for everyone
+	End      string `json:"end"`   // MM-DD the theme ends for everyone
+	Unlocked bool   `json:"unlocked"`
+}
+
+// NameThemes lists the themes a user can pick and which one they use
+type NameThemes struct {
+	// Selected is the user's pick; empty follows the calendar
+	Selected string `json:"selected"`
+	// Active is the theme the calendar shows today; empty outside every period
+	Active string      `json:"active"`
+	Themes []NameTheme `json:"themes"`
+}
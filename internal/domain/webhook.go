@@ -0,0 +1,42 @@
+package domain
+
+import "time"
+
+// Webhook is an admin-registered endpoint that receives selected events as
+// signed JSON callbacks
+type Webhook struct {
+	ID          int64     `json:"id"`
+	URL         string    `json:"url"`
+	EventTypes  []string  `json:"event_types"`
+	Description string    `json:"description,omitempty"`
+	CreatedBy   string    `json:"created_by"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+// WebhookDeliveryStatus is where a webhook delivery is in its lifecycle
+type WebhookDeliveryStatus string
+
+// Webhook delivery statuses
+const (
+	// WebhookDeliveryPending deliveries are waiting for their next attempt
+	WebhookDeliveryPending WebhookDeliveryStatus = "pending"
+	// WebhookDeliveryDelivered deliveries got a 2xx response
+	WebhookDeliveryDelivered WebhookDeliveryStatus = "delivered"
+	// WebhookDeliveryFailed deliveries ran out of attempts
+	WebhookDeliveryFailed WebhookDeliveryStatus = "failed"
+)
+
+// WebhookDelivery is one event sent, or waiting to be sent, to a webhook
+type WebhookDelivery struct {
+	ID        int64                 `json:"id"`
+	WebhookID int64                 `json:"webhook_id"`
+	EventType string                `json:"event_type"`
+	Status    WebhookDeliveryStatus `json:"status"`
+	Attempts  int                   `json:"attempts"`
+	// NextAttemptAt is set while the delivery is pending
+	NextAttemptAt  *time.Time `json:"next_attempt_at,omitempty"`
+	LastStatusCode int        `json:"last_status_code,omitempty"`
+	LastError      string     `json:"last_error,omitempty"`
+	CreatedAt      time.Time  `json:"created_at"`
+	DeliveredAt    *time.Time `json:"delivered_at,omitempty"`
+}
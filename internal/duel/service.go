@@ -11,6 +11,7 @@ import (
 
 	"github.com/osse101/BrandishBot_Go/internal/domain"
 	"github.com/osse101/BrandishBot_Go/internal/event"
+	"github.com/osse101/BrandishBot_Go/internal/ids"
 	"github.com/osse101/BrandishBot_Go/internal/repository"
 )
 
@@ -97,7 +98,7 @@ func (s *service) Challenge(ctx context.Context, platform, platformID, opponentU
 
 	// Create duel
 	duel := &domain.Duel{
-		ID:           uuid.New(),
+		ID:           ids.New(),
 		ChallengerID: challengerID,
 		OpponentID:   &opponentID,
 		State:        domain.DuelStatePending,
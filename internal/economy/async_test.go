@@ -66,9 +66,9 @@ func TestSellItem_PublishesEvent(t *testing.T) {
 	mockRepo.On("GetItemByName", ctx, domain.ItemMoney).Return(moneyItem, nil, nil)
 
 	mockTx := &MockTx{}
+	mockRepo.On("GetInventory", mock.Anything, user.ID).Return(inventory, nil)
 	mockRepo.On("BeginTx", ctx).Return(mockTx, nil, nil)
-	mockTx.On("GetInventory", ctx, user.ID).Return(inventory, nil, nil)
-	mockTx.On("UpdateInventory", ctx, user.ID, mock.Anything).Return(nil)
+	mockTx.On("AdjustItemQuantity", ctx, user.ID, mock.Anything, mock.Anything, mock.Anything).Return(0, nil)
 	mockTx.On("Commit", ctx).Return(nil)
 	mockTx.On("Rollback", ctx).Return(nil)
 
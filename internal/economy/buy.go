@@ -23,6 +23,13 @@ func (s *service) BuyItem(ctx context.Context, platform, platformID, username, i
 		return 0, err
 	}
 
+	// Bought items are common quality
+	if s.capacity != nil {
+		if err := s.capacity.EnsureInventoryRoom(ctx, user.ID, item.ID, domain.QualityCommon); err != nil {
+			return 0, err
+		}
+	}
+
 	tx, err := s.repo.BeginTx(ctx)
 	if err != nil {
 		return 0, fmt.Errorf(ErrMsgBeginTransactionFailed, err)
@@ -63,10 +70,15 @@ func (s *service) BuyItem(ctx context.Context, platform, platformID, username, i
 func (s *service) calculatePurchaseDetails(ctx context.Context, item *domain.Item, requestedQuantity, availableMoney int) (int, int) {
 	log := logger.FromContext(ctx)
 	itemCategory := getItemCategory(item)
-	discountedPrice := s.applyWeeklySaleDiscount(ctx, item.BaseValue, itemCategory)
+	marketValue := s.marketBaseValue(ctx, item)
+	discountedPrice := s.applyWeeklySaleDiscount(ctx, marketValue, itemCategory)
 
-	if discountedPrice < item.BaseValue {
-		log.Info("Weekly sale discount applied", "item", item.InternalName, "category", itemCategory, "original_price", item.BaseValue, "discounted_price", discountedPrice)
+	if discountedPrice < marketValue {
+		log.Info("Weekly sale discount applied", "item", item.InternalName, "category", itemCategory, "original_price", marketValue, "discounted_price", discountedPrice)
+	}
+	if eventPrice := s.applyGameEventDiscount(ctx, discountedPrice); eventPrice < discountedPrice {
+		log.Info("Game event discount applied", "item", item.InternalName, "original_price", discountedPrice, "discounted_price", eventPrice)
+		discountedPrice = eventPrice
 	}
 
 	actualQuantity, totalCost := calculateAffordableQuantity(requestedQuantity, discountedPrice, availableMoney)
@@ -79,6 +91,8 @@ func (s *service) calculatePurchaseDetails(ctx context.Context, item *domain.Ite
 }
 
 func (s *service) finalizePurchase(ctx context.Context, userID string, item *domain.Item, quantity, totalCost int) {
+	s.recordTrade(ctx, item.ID, quantity)
+
 	if s.publisher != nil {
 		s.publisher.PublishWithRetry(ctx, event.Event{
 			Version: "1.0",
@@ -1,5 +1,7 @@
 package economy
 
+import "time"
+
 // ==================== Error Messages ====================
 
 // General error messages
@@ -16,6 +18,7 @@ const (
 	ErrMsgItemNotFoundFmt              = "item not found: %s: %w"
 	ErrMsgItemNotInInventoryFmt        = "item %s not in inventory: %w"
 	ErrMsgItemNotBuyableFmt            = "item %s is not buyable: %w"
+	ErrMsgItemBorrowedFmt              = "all your %s are borrowed: %w"
 	ErrMsgInsufficientFundsToBuyOneFmt = "insufficient funds to buy even one %s (cost: %d, balance: %d): %w"
 )
 
@@ -35,6 +38,17 @@ const (
 	ErrMsgUpdateInventoryFailed   = "failed to update inventory: %w"
 	ErrMsgCommitTransactionFailed = "failed to commit transaction: %w"
 	ErrMsgCheckBuyableFailed      = "failed to check if item is buyable: %w"
+	ErrMsgGetBorrowedFailed       = "failed to get borrowed quantity: %w"
+	ErrMsgListMarketStatesFailed  = "failed to list market states: %w"
+	ErrMsgRecordPriceFailed       = "failed to record price for item %d: %w"
+	ErrMsgGetPriceHistoryFailed   = "failed to get price history: %w"
+	ErrMsgPrunePriceHistoryFailed = "failed to prune price history: %w"
+	ErrMsgCollectTaxFailed        = "failed to credit sell tax to community pool: %w"
+)
+
+// Market error messages
+const (
+	ErrMsgMarketUnavailable = "dynamic pricing is not enabled"
 )
 
 // Shutdown error messages
@@ -60,6 +74,9 @@ const (
 	LogMsgEconomyShuttingDown   = "Economy service shutting down, waiting for background tasks..."
 	ErrMsgAwardMerchantXPFailed = "Failed to award Merchant XP: UserID %d: %w"
 	LogMsgMerchantLeveledUp     = "Merchant leveled up!"
+	LogMsgMarketStateFailed     = "Failed to read market state, using static price"
+	LogMsgRecordTradeFailed     = "Failed to record trade in market pressure"
+	LogMsgPricesSnapshotted     = "Recorded market price snapshot"
 )
 
 // ==================== Metadata Keys ====================
@@ -86,3 +103,27 @@ const (
 // - 0.60 (60%) is standard for most RPGs - prevents buy/sell loops while being fair
 // - 0.40 (40%) is to start low and leave room for modifiers
 const SellPriceRatio = 0.40
+
+// ==================== Dynamic Pricing ====================
+
+// JobType is the worker pool job type for market price snapshots
+const JobType = "economy_price_snapshot"
+
+// Market defaults, used for any MarketConfig field left at zero
+const (
+	DefaultMarketHalfLife         = 12 * time.Hour
+	DefaultMarketFloor            = 0.7
+	DefaultMarketCeiling          = 1.5
+	DefaultMarketHistoryRetention = 30 * 24 * time.Hour
+)
+
+// MinRecordedPriceChange is how far an item's multiplier must move from its
+// last recorded value before a snapshot records it again
+const MinRecordedPriceChange = 0.01
+
+// Price history query limits
+const (
+	DefaultPriceHistoryWindow = 7 * 24 * time.Hour
+	DefaultPriceHistoryLimit  = 200
+	MaxPriceHistoryLimit      = 1000
+)
@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/naming"
 )
 
 func getItemCategory(item *domain.Item) string {
@@ -84,7 +85,7 @@ func (s *service) resolveItemName(ctx context.Context, itemName string) (string,
 		return "", fmt.Errorf(ErrMsgResolveItemFailedFmt, itemName, err)
 	}
 	if item == nil {
-		return "", fmt.Errorf(ErrMsgItemNotFoundPublicFmt, itemName, domain.ErrItemNotFound)
+		return "", fmt.Errorf(ErrMsgItemNotFoundPublicFmt, itemName, naming.ItemNotFound(s.namingResolver, itemName))
 	}
 
 	return itemName, nil
@@ -0,0 +1,24 @@
+package economy
+
+import "context"
+
+// Job records market price snapshots and prunes old price history
+type Job struct {
+	service Service
+}
+
+// NewJob creates a market price snapshot job
+func NewJob(service Service) *Job {
+	return &Job{service: service}
+}
+
+// JobType returns the worker pool job type
+func (j *Job) JobType() string {
+	return JobType
+}
+
+// Process records the prices that have moved since the last snapshot
+func (j *Job) Process(ctx context.Context) error {
+	_, err := j.service.RecordPriceSnapshot(ctx)
+	return err
+}
@@ -0,0 +1,207 @@
+package economy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"time"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/logger"
+	"github.com/osse101/BrandishBot_Go/internal/repository"
+)
+
+// MarketConfig tunes dynamic pricing. Each item's trade pressure (units
+// bought minus units sold, halving every HalfLife) moves its price by
+// Sensitivity per unit, kept between Floor and Ceiling times its base value.
+type MarketConfig struct {
+	Sensitivity      float64
+	HalfLife         time.Duration
+	Floor            float64
+	Ceiling          float64
+	HistoryRetention time.Duration
+}
+
+// WithMarket turns on dynamic buy and sell prices driven by recent trade
+// volume, with price history kept in the given repository
+func WithMarket(repo repository.Market, cfg MarketConfig) Option {
+	if cfg.HalfLife <= 0 {
+		cfg.HalfLife = DefaultMarketHalfLife
+	}
+	if cfg.Floor <= 0 {
+		cfg.Floor = DefaultMarketFloor
+	}
+	if cfg.Ceiling <= 0 {
+		cfg.Ceiling = DefaultMarketCeiling
+	}
+	if cfg.HistoryRetention <= 0 {
+		cfg.HistoryRetention = DefaultMarketHistoryRetention
+	}
+	return func(s *service) {
+		s.market = repo
+		s.marketCfg = cfg
+	}
+}
+
+// decayPressure halves pressure for every half-life that has elapsed
+func decayPressure(pressure float64, elapsed, halfLife time.Duration) float64 {
+	if elapsed <= 0 || halfLife <= 0 {
+		return pressure
+	}
+	return pressure * math.Pow(0.5, elapsed.Seconds()/halfLife.Seconds())
+}
+
+// marketPrice applies a multiplier to a base value
+func marketPrice(baseValue int, multiplier float64) int {
+	return int(math.Round(float64(baseValue) * multiplier))
+}
+
+// multiplierFor converts an item's trade pressure into its price multiplier now
+func (s *service) multiplierFor(state domain.ItemMarketState) float64 {
+	pressure := decayPressure(state.Pressure, s.now().Sub(state.UpdatedAt), s.marketCfg.HalfLife)
+	multiplier := 1 + s.marketCfg.Sensitivity*pressure
+	return math.Min(math.Max(multiplier, s.marketCfg.Floor), s.marketCfg.Ceiling)
+}
+
+// marketMultiplier returns the item's price multiplier, 1 when dynamic
+// pricing is off, the item has never traded or its state cannot be read
+func (s *service) marketMultiplier(ctx context.Context, itemID int) float64 {
+	if s.market == nil {
+		return 1
+	}
+	state, err := s.market.GetMarketState(ctx, itemID)
+	if err != nil {
+		logger.FromContext(ctx).Warn(LogMsgMarketStateFailed, "item_id", itemID, "error", err)
+		return 1
+	}
+	if state == nil {
+		return 1
+	}
+	return s.multiplierFor(*state)
+}
+
+// marketMultipliers returns the multiplier of every item that has traded
+func (s *service) marketMultipliers(ctx context.Context) map[int]float64 {
+	if s.market == nil {
+		return nil
+	}
+	states, err := s.market.ListMarketStates(ctx)
+	if err != nil {
+		logger.FromContext(ctx).Warn(LogMsgMarketStateFailed, "error", err)
+		return nil
+	}
+	multipliers := make(map[int]float64, len(states))
+	for _, state := range states {
+		multipliers[state.ItemID] = s.multiplierFor(state)
+	}
+	return multipliers
+}
+
+// marketBaseValue is the item's base value adjusted by the market
+func (s *service) marketBaseValue(ctx context.Context, item *domain.Item) int {
+	return marketPrice(item.BaseValue, s.marketMultiplier(ctx, item.ID))
+}
+
+// recordTrade adds a completed trade to the item's pressure. The trade has
+// already committed, so a failure only costs price accuracy and is logged.
+func (s *service) recordTrade(ctx context.Context, itemID, delta int) {
+	if s.market == nil || delta == 0 {
+		return
+	}
+	if err := s.market.RecordTrade(ctx, itemID, float64(delta), s.marketCfg.HalfLife); err != nil {
+		logger.FromContext(ctx).Warn(LogMsgRecordTradeFailed, "item_id", itemID, "delta", delta, "error", err)
+	}
+}
+
+// pricePoint is the item's list price now at the given multiplier
+func (s *service) pricePoint(ctx context.Context, baseValue int, multiplier float64) domain.PricePoint {
+	buyPrice := marketPrice(baseValue, multiplier)
+	return domain.PricePoint{
+		Multiplier: multiplier,
+		BuyPrice:   buyPrice,
+		SellPrice:  s.calculateSellPriceWithModifier(ctx, "", buyPrice),
+		RecordedAt: s.now(),
+	}
+}
+
+// GetPriceHistory returns an item's recorded prices since the given time,
+// oldest first, along with its price now
+func (s *service) GetPriceHistory(ctx context.Context, itemName string, since time.Time, limit int) (*domain.PriceHistory, error) {
+	if s.market == nil {
+		return nil, errors.New(ErrMsgMarketUnavailable)
+	}
+	if limit <= 0 {
+		limit = DefaultPriceHistoryLimit
+	}
+	limit = min(limit, MaxPriceHistoryLimit)
+	if since.IsZero() {
+		since = s.now().Add(-DefaultPriceHistoryWindow)
+	}
+
+	resolvedName, err := s.resolveItemName(ctx, itemName)
+	if err != nil {
+		return nil, err
+	}
+	item, err := s.repo.GetItemByName(ctx, resolvedName)
+	if err != nil {
+		return nil, fmt.Errorf(ErrMsgGetItemFailed, err)
+	}
+	if item == nil {
+		return nil, fmt.Errorf(ErrMsgItemNotFoundFmt, resolvedName, domain.ErrItemNotFound)
+	}
+
+	points, err := s.market.GetPriceHistory(ctx, item.ID, since, limit)
+	if err != nil {
+		return nil, fmt.Errorf(ErrMsgGetPriceHistoryFailed, err)
+	}
+
+	return &domain.PriceHistory{
+		ItemName:  item.InternalName,
+		BaseValue: item.BaseValue,
+		Current:   s.pricePoint(ctx, item.BaseValue, s.marketMultiplier(ctx, item.ID)),
+		Points:    points,
+	}, nil
+}
+
+// RecordPriceSnapshot records the price of every traded item whose
+// multiplier has moved since it was last recorded, prunes expired history,
+// and reports how many prices were recorded
+func (s *service) RecordPriceSnapshot(ctx context.Context) (int, error) {
+	if s.market == nil {
+		return 0, nil
+	}
+
+	states, err := s.market.ListMarketStates(ctx)
+	if err != nil {
+		return 0, fmt.Errorf(ErrMsgListMarketStatesFailed, err)
+	}
+	slices.SortFunc(states, func(a, b domain.ItemMarketState) int { return a.ItemID - b.ItemID })
+
+	recorded := 0
+	for _, state := range states {
+		multiplier := s.multiplierFor(state)
+		last := 1.0
+		if state.LastMultiplier != nil {
+			last = *state.LastMultiplier
+		}
+		if math.Abs(multiplier-last) < MinRecordedPriceChange {
+			continue
+		}
+		if err := s.market.RecordPrice(ctx, state.ItemID, s.pricePoint(ctx, state.BaseValue, multiplier)); err != nil {
+			return recorded, fmt.Errorf(ErrMsgRecordPriceFailed, state.ItemID, err)
+		}
+		recorded++
+	}
+
+	pruned, err := s.market.PrunePriceHistory(ctx, s.now().Add(-s.marketCfg.HistoryRetention))
+	if err != nil {
+		return recorded, fmt.Errorf(ErrMsgPrunePriceHistoryFailed, err)
+	}
+
+	if recorded > 0 || pruned > 0 {
+		logger.FromContext(ctx).Info(LogMsgPricesSnapshotted, "recorded", recorded, "pruned", pruned)
+	}
+	return recorded, nil
+}
@@ -0,0 +1,255 @@
+package economy
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+)
+
+// fakeMarket is an in-memory repository.Market
+type fakeMarket struct {
+	states   map[int]*domain.ItemMarketState
+	trades   map[int]float64
+	recorded map[int][]domain.PricePoint
+	prunedTo time.Time
+}
+
+func newFakeMarket(states ...domain.ItemMarketState) *fakeMarket {
+	m := &fakeMarket{
+		states:   make(map[int]*domain.ItemMarketState),
+		trades:   make(map[int]float64),
+		recorded: make(map[int][]domain.PricePoint),
+	}
+	for i := range states {
+		m.states[states[i].ItemID] = &states[i]
+	}
+	return m
+}
+
+func (m *fakeMarket) RecordTrade(_ context.Context, itemID int, delta float64, _ time.Duration) error {
+	m.trades[itemID] += delta
+	return nil
+}
+
+func (m *fakeMarket) GetMarketState(_ context.Context, itemID int) (*domain.ItemMarketState, error) {
+	return m.states[itemID], nil
+}
+
+func (m *fakeMarket) ListMarketStates(context.Context) ([]domain.ItemMarketState, error) {
+	states := make([]domain.ItemMarketState, 0, len(m.states))
+	for _, state := range m.states {
+		states = append(states, *state)
+	}
+	return states, nil
+}
+
+func (m *fakeMarket) RecordPrice(_ context.Context, itemID int, point domain.PricePoint) error {
+	m.recorded[itemID] = append(m.recorded[itemID], point)
+	return nil
+}
+
+func (m *fakeMarket) GetPriceHistory(_ context.Context, itemID int, since time.Time, limit int) ([]domain.PricePoint, error) {
+	return m.recorded[itemID], nil
+}
+
+func (m *fakeMarket) PrunePriceHistory(_ context.Context, before time.Time) (int64, error) {
+	m.prunedTo = before
+	return 0, nil
+}
+
+var marketNow = time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+
+func newMarketService(repo *MockRepository, market *fakeMarket) *service {
+	svc := NewService(repo, nil, nil, nil, WithMarket(market, MarketConfig{
+		Sensitivity: 0.01,
+		HalfLife:    time.Hour,
+		Floor:       0.7,
+		Ceiling:     1.5,
+	})).(*service)
+	svc.now = func() time.Time { return marketNow }
+	return svc
+}
+
+func TestMarketMultiplier(t *testing.T) {
+	t.Parallel()
+	svc := newMarketService(&MockRepository{}, newFakeMarket())
+
+	tests := []struct {
+		name     string
+		pressure float64
+		age      time.Duration
+		want     float64
+	}{
+		{"no pressure", 0, 0, 1},
+		{"net buying raises price", 20, 0, 1.2},
+		{"net selling lowers price", -20, 0, 0.8},
+		{"pressure halves each half-life", 20, time.Hour, 1.1},
+		{"clamped to ceiling", 500, 0, 1.5},
+		{"clamped to floor", -500, 0, 0.7},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := svc.multiplierFor(domain.ItemMarketState{Pressure: tt.pressure, UpdatedAt: marketNow.Add(-tt.age)})
+			assert.InDelta(t, tt.want, got, 1e-9)
+		})
+	}
+}
+
+func TestBuyItem_MarketPrice(t *testing.T) {
+	t.Parallel()
+	mockRepo := &MockRepository{}
+	market := newFakeMarket(domain.ItemMarketState{ItemID: 10, Pressure: 30, UpdatedAt: marketNow})
+	svc := newMarketService(mockRepo, market)
+	ctx := context.Background()
+
+	user := createTestUser()
+	item := createTestItem(10, domain.PublicNameLootbox, 100)
+	moneyItem := createMoneyItem()
+	inventory := createInventoryWithMoney(500)
+
+	mockRepo.On("GetUserByPlatformID", ctx, domain.PlatformTwitch, "").Return(user, nil)
+	mockRepo.On("GetItemByName", ctx, domain.PublicNameLootbox).Return(item, nil)
+	mockRepo.On("IsItemBuyable", ctx, domain.PublicNameLootbox).Return(true, nil)
+	mockRepo.On("GetItemByName", ctx, domain.ItemMoney).Return(moneyItem, nil)
+	mockTx := &MockTx{}
+	mockRepo.On("BeginTx", ctx).Return(mockTx, nil)
+	mockTx.On("GetInventory", ctx, user.ID).Return(inventory, nil)
+	mockTx.On("UpdateInventory", ctx, user.ID, mock.Anything).Return(nil)
+	mockTx.On("Commit", ctx).Return(nil)
+	mockTx.On("Rollback", ctx).Return(nil)
+
+	purchased, err := svc.BuyItem(ctx, domain.PlatformTwitch, "", "testuser", domain.PublicNameLootbox, 5)
+
+	require.NoError(t, err)
+	// 130 each at 1.3x, so 500 buys 3
+	assert.Equal(t, 3, purchased)
+	assert.Equal(t, 110, inventory.Slots[0].Quantity)
+	assert.InDelta(t, 3, market.trades[10], 1e-9)
+}
+
+func TestSellItem_MarketPrice(t *testing.T) {
+	t.Parallel()
+	mockRepo := &MockRepository{}
+	mockTx := &MockTx{}
+	market := newFakeMarket(domain.ItemMarketState{ItemID: 10, Pressure: -20, UpdatedAt: marketNow})
+	svc := newMarketService(mockRepo, market)
+	ctx := context.Background()
+
+	user := createTestUser()
+	item := createTestItem(10, domain.PublicNameLootbox, 100)
+	moneyItem := createMoneyItem()
+	inventory := createInventoryWithItem(10, 5)
+
+	mockRepo.On("GetUserByPlatformID", ctx, domain.PlatformTwitch, "").Return(user, nil)
+	mockRepo.On("GetItemByName", ctx, domain.PublicNameLootbox).Return(item, nil)
+	mockRepo.On("GetItemByName", ctx, domain.ItemMoney).Return(moneyItem, nil)
+	mockRepo.On("BeginTx", ctx).Return(mockTx, nil)
+	mockRepo.On("GetInventory", mock.Anything, user.ID).Return(inventory, nil)
+	mockTx.On("AdjustItemQuantity", ctx, user.ID, 10, domain.QualityLevel(""), -3).Return(2, nil)
+	// 80 at 0.8x, selling for 40% = 32 each
+	mockTx.On("AdjustItemQuantity", ctx, user.ID, moneyItem.ID, domain.QualityCommon, 96).Return(96, nil)
+	mockTx.On("Commit", ctx).Return(nil)
+	mockTx.On("Rollback", ctx).Return(nil)
+
+	moneyGained, sold, err := svc.SellItem(ctx, domain.PlatformTwitch, "", "testuser", domain.PublicNameLootbox, 3)
+
+	require.NoError(t, err)
+	assert.Equal(t, 96, moneyGained)
+	assert.Equal(t, 3, sold)
+	assert.InDelta(t, -3, market.trades[10], 1e-9)
+	mockTx.AssertExpectations(t)
+}
+
+func TestGetBuyablePrices_MarketPrice(t *testing.T) {
+	t.Parallel()
+	mockRepo := &MockRepository{}
+	market := newFakeMarket(domain.ItemMarketState{ItemID: 10, Pressure: 20, UpdatedAt: marketNow})
+	svc := newMarketService(mockRepo, market)
+	ctx := context.Background()
+
+	mockRepo.On("GetBuyablePrices", ctx).Return([]domain.Item{
+		*createTestItem(10, "traded", 100),
+		*createTestItem(11, "untraded", 50),
+	}, nil)
+
+	items, err := svc.GetBuyablePrices(ctx)
+
+	require.NoError(t, err)
+	require.Len(t, items, 2)
+	require.NotNil(t, items[0].BuyPrice)
+	assert.Equal(t, 120, *items[0].BuyPrice)
+	assert.Equal(t, 100, items[0].BaseValue)
+	require.NotNil(t, items[1].BuyPrice)
+	assert.Equal(t, 50, *items[1].BuyPrice)
+}
+
+func TestRecordPriceSnapshot(t *testing.T) {
+	t.Parallel()
+	unchanged := 1.2
+	settled := 1.3
+	market := newFakeMarket(
+		domain.ItemMarketState{ItemID: 1, BaseValue: 100, Pressure: 20, UpdatedAt: marketNow, LastMultiplier: &unchanged},
+		domain.ItemMarketState{ItemID: 2, BaseValue: 100, Pressure: -10, UpdatedAt: marketNow},
+		domain.ItemMarketState{ItemID: 3, BaseValue: 100, Pressure: 0.2, UpdatedAt: marketNow},
+		domain.ItemMarketState{ItemID: 4, BaseValue: 100, Pressure: 0, UpdatedAt: marketNow, LastMultiplier: &settled},
+	)
+	svc := newMarketService(&MockRepository{}, market)
+
+	recorded, err := svc.RecordPriceSnapshot(context.Background())
+
+	require.NoError(t, err)
+	// Item 1 has not moved and item 3 is within rounding of base price
+	assert.Equal(t, 2, recorded)
+	assert.Empty(t, market.recorded[1])
+	require.Len(t, market.recorded[2], 1)
+	assert.Equal(t, domain.PricePoint{Multiplier: 0.9, BuyPrice: 90, SellPrice: 36, RecordedAt: marketNow}, market.recorded[2][0])
+	assert.Empty(t, market.recorded[3])
+	require.Len(t, market.recorded[4], 1)
+	assert.Equal(t, 100, market.recorded[4][0].BuyPrice)
+	assert.Equal(t, marketNow.Add(-DefaultMarketHistoryRetention), market.prunedTo)
+}
+
+func TestGetPriceHistory(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	t.Run("market disabled", func(t *testing.T) {
+		svc := NewService(&MockRepository{}, nil, nil, nil)
+		_, err := svc.GetPriceHistory(ctx, "lootbox", time.Time{}, 0)
+		require.Error(t, err)
+		assert.Equal(t, ErrMsgMarketUnavailable, err.Error())
+	})
+
+	t.Run("unknown item", func(t *testing.T) {
+		mockRepo := &MockRepository{}
+		mockRepo.On("GetItemByName", ctx, "nope").Return(nil, nil)
+		svc := newMarketService(mockRepo, newFakeMarket())
+		_, err := svc.GetPriceHistory(ctx, "nope", time.Time{}, 0)
+		assert.True(t, errors.Is(err, domain.ErrItemNotFound))
+	})
+
+	t.Run("returns points and current price", func(t *testing.T) {
+		mockRepo := &MockRepository{}
+		item := createTestItem(10, domain.PublicNameLootbox, 100)
+		mockRepo.On("GetItemByName", ctx, domain.PublicNameLootbox).Return(item, nil)
+		market := newFakeMarket(domain.ItemMarketState{ItemID: 10, Pressure: 10, UpdatedAt: marketNow})
+		market.recorded[10] = []domain.PricePoint{{Multiplier: 1.05, BuyPrice: 105, SellPrice: 42}}
+		svc := newMarketService(mockRepo, market)
+
+		history, err := svc.GetPriceHistory(ctx, domain.PublicNameLootbox, time.Time{}, 0)
+
+		require.NoError(t, err)
+		assert.Equal(t, domain.PublicNameLootbox, history.ItemName)
+		assert.Equal(t, 100, history.BaseValue)
+		assert.Equal(t, 110, history.Current.BuyPrice)
+		assert.Equal(t, 44, history.Current.SellPrice)
+		assert.Len(t, history.Points, 1)
+	})
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/stretchr/testify/mock"
 
 	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/naming"
 	"github.com/osse101/BrandishBot_Go/internal/repository"
 )
 
@@ -96,6 +97,16 @@ func (m *MockTx) UpdateInventory(ctx context.Context, userID string, inventory d
 	return args.Error(0)
 }
 
+func (m *MockTx) AdjustItemQuantity(ctx context.Context, userID string, itemID int, quality domain.QualityLevel, delta int) (int, error) {
+	args := m.Called(ctx, userID, itemID, quality, delta)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *MockTx) AddToCommunityPool(ctx context.Context, amount int) error {
+	args := m.Called(ctx, amount)
+	return args.Error(0)
+}
+
 func (m *MockTx) Commit(ctx context.Context) error {
 	args := m.Called(ctx)
 	return args.Error(0)
@@ -209,11 +220,26 @@ func (m *MockNamingResolver) GetDisplayName(internalName string, qualityLevel do
 	return args.String(0)
 }
 
+func (m *MockNamingResolver) GetDisplayNameForTheme(internalName string, qualityLevel domain.QualityLevel, theme string) string {
+	args := m.Called(internalName, qualityLevel, theme)
+	return args.String(0)
+}
+
 func (m *MockNamingResolver) GetActiveTheme() string {
 	args := m.Called()
 	return args.String(0)
 }
 
+func (m *MockNamingResolver) GetThemes() map[string]naming.ThemePeriod {
+	args := m.Called()
+	themes, _ := args.Get(0).(map[string]naming.ThemePeriod)
+	return themes
+}
+
+func (m *MockNamingResolver) SuggestNames(name string) []string {
+	return nil
+}
+
 func (m *MockNamingResolver) Reload() error {
 	args := m.Called()
 	return args.Error(0)
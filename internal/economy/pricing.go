@@ -8,6 +8,7 @@ import (
 	"github.com/osse101/BrandishBot_Go/internal/domain"
 	"github.com/osse101/BrandishBot_Go/internal/logger"
 	"github.com/osse101/BrandishBot_Go/internal/progression"
+	"github.com/osse101/BrandishBot_Go/internal/utils"
 )
 
 func (s *service) GetBuyablePrices(ctx context.Context) ([]domain.Item, error) {
@@ -43,34 +44,38 @@ func (s *service) GetSellablePrices(ctx context.Context) ([]domain.Item, error)
 }
 
 func (s *service) filterUnlockedItems(ctx context.Context, items []domain.Item, calculateSellPrice bool) ([]domain.Item, error) {
-	if s.progressionService == nil {
-		if calculateSellPrice {
-			for i := range items {
-				sellPrice := s.calculateSellPriceWithModifier(ctx, "", items[i].BaseValue)
-				items[i].SellPrice = &sellPrice
-			}
+	unlockedItems := items
+	if s.progressionService != nil {
+		itemNames := make([]string, len(items))
+		for i, item := range items {
+			itemNames[i] = item.InternalName
 		}
-		return items, nil
-	}
 
-	itemNames := make([]string, len(items))
-	for i, item := range items {
-		itemNames[i] = item.InternalName
-	}
+		unlockStatus, err := s.progressionService.AreItemsUnlocked(ctx, itemNames)
+		if err != nil {
+			return nil, fmt.Errorf("failed to check item unlock status: %w", err)
+		}
 
-	unlockStatus, err := s.progressionService.AreItemsUnlocked(ctx, itemNames)
-	if err != nil {
-		return nil, fmt.Errorf("failed to check item unlock status: %w", err)
+		unlockedItems = make([]domain.Item, 0, len(items))
+		for _, item := range items {
+			if unlockStatus[item.InternalName] {
+				unlockedItems = append(unlockedItems, item)
+			}
+		}
 	}
 
-	unlockedItems := make([]domain.Item, 0, len(items))
-	for _, item := range items {
-		if unlockStatus[item.InternalName] {
-			if calculateSellPrice {
-				sellPrice := s.calculateSellPriceWithModifier(ctx, "", item.BaseValue)
-				item.SellPrice = &sellPrice
-			}
-			unlockedItems = append(unlockedItems, item)
+	multipliers := s.marketMultipliers(ctx)
+	for i := range unlockedItems {
+		multiplier, ok := multipliers[unlockedItems[i].ID]
+		if !ok {
+			multiplier = 1
+		}
+		price := marketPrice(unlockedItems[i].BaseValue, multiplier)
+		if calculateSellPrice {
+			sellPrice := s.calculateSellPriceWithModifier(ctx, "", price)
+			unlockedItems[i].SellPrice = &sellPrice
+		} else {
+			unlockedItems[i].BuyPrice = &price
 		}
 	}
 
@@ -97,6 +102,21 @@ func (s *service) calculateSellPriceWithModifier(ctx context.Context, userID str
 	return int(modifiedPrice)
 }
 
+// applySellBonusEffect scales a sell price by the user's active sell bonus effect
+// applyQualityValue scales a sale price by the quality of the items sold, so
+// finer crafts and rarer finds sell for more. Prices listed without a quality
+// are for COMMON items.
+func applyQualityValue(price int, quality domain.QualityLevel) int {
+	return int(float64(price) * utils.GetQualityMultiplier(quality))
+}
+
+func (s *service) applySellBonusEffect(ctx context.Context, userID string, price int) int {
+	if s.effects == nil || userID == "" {
+		return price
+	}
+	return int(float64(price) * s.effects.Multiplier(ctx, userID, domain.EffectSellBonus))
+}
+
 func (s *service) applyWeeklySaleDiscount(ctx context.Context, basePrice int, itemCategory string) int {
 	if s.progressionService != nil {
 		unlocked, err := s.progressionService.IsFeatureUnlocked(ctx, progression.FeatureWeeklyDiscount)
@@ -122,6 +142,18 @@ func (s *service) applyWeeklySaleDiscount(ctx context.Context, basePrice int, it
 	return basePrice - int(discount)
 }
 
+// applyGameEventDiscount takes the shop discount of running game events off a price
+func (s *service) applyGameEventDiscount(ctx context.Context, price int) int {
+	if s.gameEvents == nil {
+		return price
+	}
+	mods := s.gameEvents.Modifiers(ctx)
+	if mods.ShopDiscountPercent <= 0 {
+		return price
+	}
+	return price - price*mods.ShopDiscountPercent/100
+}
+
 func calculateAffordableQuantity(desired, unitPrice, balance int) (quantity, cost int) {
 	if unitPrice == 0 {
 		return desired, 0
@@ -4,8 +4,10 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/osse101/BrandishBot_Go/internal/database"
 	"github.com/osse101/BrandishBot_Go/internal/domain"
 	"github.com/osse101/BrandishBot_Go/internal/event"
+	"github.com/osse101/BrandishBot_Go/internal/itemflags"
 	"github.com/osse101/BrandishBot_Go/internal/logger"
 	"github.com/osse101/BrandishBot_Go/internal/repository"
 	"github.com/osse101/BrandishBot_Go/internal/utils"
@@ -23,14 +25,13 @@ func (s *service) SellItem(ctx context.Context, platform, platformID, username,
 	if err != nil {
 		return 0, 0, err
 	}
-
-	tx, err := s.repo.BeginTx(ctx)
-	if err != nil {
-		return 0, 0, fmt.Errorf(ErrMsgBeginTransactionFailed, err)
+	if err := itemflags.EnsureUnlocked(ctx, s.locks, user.ID, item); err != nil {
+		return 0, 0, err
 	}
-	defer repository.SafeRollback(ctx, tx)
 
-	inventory, err := tx.GetInventory(ctx, user.ID)
+	// Only the slot choice comes from this read; the sale itself is applied
+	// as atomic adjustments, and the debit fails if the slot has since shrunk
+	inventory, err := s.repo.GetInventory(database.WithPrimaryReads(ctx), user.ID)
 	if err != nil {
 		return 0, 0, fmt.Errorf(ErrMsgGetInventoryFailed, err)
 	}
@@ -39,32 +40,82 @@ func (s *service) SellItem(ctx context.Context, platform, platformID, username,
 	if itemSlotIndex == -1 {
 		return 0, 0, fmt.Errorf(ErrMsgItemNotInInventoryFmt, itemName, domain.ErrNotInInventory)
 	}
+	soldQuality := inventory.Slots[itemSlotIndex].QualityLevel
 
-	actualQuantity := quantity
-	if slotQuantity < quantity {
-		actualQuantity = slotQuantity
+	owned, err := s.ownedQuantity(ctx, user.ID, inventory, item)
+	if err != nil {
+		return 0, 0, err
 	}
 
-	sellPrice := s.calculateSellPriceWithModifier(ctx, user.ID, item.BaseValue)
+	actualQuantity := min(quantity, slotQuantity, owned)
+
+	sellPrice := s.applySellBonusEffect(ctx, user.ID, applyQualityValue(s.calculateSellPriceWithModifier(ctx, user.ID, s.marketBaseValue(ctx, item)), soldQuality))
 	totalMoneyGained := actualQuantity * sellPrice
+	tax := totalMoneyGained * s.sellTaxPercent / 100
+	totalMoneyGained -= tax
 
-	processSellTransaction(inventory, moneyItem.ID, itemSlotIndex, actualQuantity, totalMoneyGained)
+	tx, err := s.repo.BeginTx(ctx)
+	if err != nil {
+		return 0, 0, fmt.Errorf(ErrMsgBeginTransactionFailed, err)
+	}
+	defer repository.SafeRollback(ctx, tx)
 
-	if err := tx.UpdateInventory(ctx, user.ID, *inventory); err != nil {
+	if _, err := tx.AdjustItemQuantity(ctx, user.ID, item.ID, soldQuality, -actualQuantity); err != nil {
 		return 0, 0, fmt.Errorf(ErrMsgUpdateInventoryFailed, err)
 	}
+	if _, err := tx.AdjustItemQuantity(ctx, user.ID, moneyItem.ID, domain.QualityCommon, totalMoneyGained); err != nil {
+		return 0, 0, fmt.Errorf(ErrMsgUpdateInventoryFailed, err)
+	}
+	if tax > 0 {
+		if err := tx.AddToCommunityPool(ctx, tax); err != nil {
+			return 0, 0, fmt.Errorf(ErrMsgCollectTaxFailed, err)
+		}
+	}
 
 	if err := tx.Commit(ctx); err != nil {
 		return 0, 0, fmt.Errorf(ErrMsgCommitTransactionFailed, err)
 	}
 
 	s.finalizeSale(ctx, user.ID, item, actualQuantity, totalMoneyGained)
+	s.recordSaleUndo(ctx, user.ID, item.ID, soldQuality, actualQuantity, moneyItem.ID, totalMoneyGained)
 
-	log.Info(LogMsgItemSold, "username", username, "item", itemName, "quantity", actualQuantity, "totalMoneyGained", totalMoneyGained)
+	log.Info(LogMsgItemSold, "username", username, "item", itemName, "quantity", actualQuantity, "totalMoneyGained", totalMoneyGained, "tax", tax)
 	return totalMoneyGained, actualQuantity, nil
 }
 
+// ownedQuantity is how many of the item the user holds outright. Borrowed
+// items go back to their lender, so they cannot be sold.
+func (s *service) ownedQuantity(ctx context.Context, userID string, inventory *domain.Inventory, item *domain.Item) (int, error) {
+	total := utils.GetTotalQuantity(inventory, item.ID)
+	if s.loans == nil {
+		return total, nil
+	}
+	borrowed, err := s.loans.GetBorrowedQuantity(ctx, userID, item.ID)
+	if err != nil {
+		return 0, fmt.Errorf(ErrMsgGetBorrowedFailed, err)
+	}
+	if total-borrowed <= 0 {
+		return 0, fmt.Errorf(ErrMsgItemBorrowedFmt, item.InternalName, domain.ErrItemBorrowed)
+	}
+	return total - borrowed, nil
+}
+
+// recordSaleUndo lets the user buy back what they sold for what they were
+// paid. The tax stays in the community pool.
+func (s *service) recordSaleUndo(ctx context.Context, userID string, itemID int, quality domain.QualityLevel, quantity, moneyID, money int) {
+	if s.undo == nil {
+		return
+	}
+	changes := []domain.InventoryChange{{UserID: userID, ItemID: itemID, QualityLevel: quality, Delta: -quantity}}
+	if money > 0 {
+		changes = append(changes, domain.InventoryChange{UserID: userID, ItemID: moneyID, QualityLevel: domain.QualityCommon, Delta: money})
+	}
+	s.undo.Record(ctx, userID, domain.UndoActionSell, changes)
+}
+
 func (s *service) finalizeSale(ctx context.Context, userID string, item *domain.Item, quantity, totalMoneyGained int) {
+	s.recordTrade(ctx, item.ID, -quantity)
+
 	if s.publisher != nil {
 		s.publisher.PublishWithRetry(ctx, event.Event{
 			Version: "1.0",
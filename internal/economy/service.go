@@ -8,6 +8,7 @@ import (
 
 	"github.com/osse101/BrandishBot_Go/internal/domain"
 	"github.com/osse101/BrandishBot_Go/internal/event"
+	"github.com/osse101/BrandishBot_Go/internal/gameevent"
 	"github.com/osse101/BrandishBot_Go/internal/logger"
 	"github.com/osse101/BrandishBot_Go/internal/naming"
 	"github.com/osse101/BrandishBot_Go/internal/repository"
@@ -18,8 +19,15 @@ import (
 type Service interface {
 	GetSellablePrices(ctx context.Context) ([]domain.Item, error)
 	GetBuyablePrices(ctx context.Context) ([]domain.Item, error)
+	GetCurrentWeeklySale(ctx context.Context) (*domain.WeeklySale, error)
 	SellItem(ctx context.Context, platform, platformID, username, itemName string, quantity int) (int, int, error)
 	BuyItem(ctx context.Context, platform, platformID, username, itemName string, quantity int) (int, error)
+	// GetPriceHistory returns an item's recorded dynamic prices since the
+	// given time (zero for the last week), oldest first
+	GetPriceHistory(ctx context.Context, itemName string, since time.Time, limit int) (*domain.PriceHistory, error)
+	// RecordPriceSnapshot records prices that have moved since the last
+	// snapshot and prunes expired history
+	RecordPriceSnapshot(ctx context.Context) (int, error)
 	Shutdown(ctx context.Context) error
 }
 
@@ -31,19 +39,107 @@ type ProgressionService interface {
 	GetModifiedValue(ctx context.Context, userID string, featureKey string, baseValue float64) (float64, error)
 }
 
+// LoanChecker reports how many of an item a user holds on loan
+type LoanChecker interface {
+	GetBorrowedQuantity(ctx context.Context, userID string, itemID int) (int, error)
+}
+
+// ItemLockChecker reports whether a user has locked an item
+type ItemLockChecker interface {
+	IsItemLocked(ctx context.Context, userID string, itemID int) (bool, error)
+}
+
+// UndoRecorder records operations the user can undo for a short while
+type UndoRecorder interface {
+	Record(ctx context.Context, userID string, action domain.UndoAction, changes []domain.InventoryChange)
+}
+
+// EffectChecker reads timed effects such as a sell bonus
+type EffectChecker interface {
+	Multiplier(ctx context.Context, userID string, effectType domain.EffectType) float64
+}
+
+// CapacityChecker refuses credits that would overfill an inventory
+type CapacityChecker interface {
+	EnsureInventoryRoom(ctx context.Context, userID string, itemID int, qualityLevel domain.QualityLevel) error
+}
+
+// Option configures optional economy service dependencies
+type Option func(*service)
+
+// WithLoanChecker stops users selling items they have borrowed
+func WithLoanChecker(loans LoanChecker) Option {
+	return func(s *service) {
+		s.loans = loans
+	}
+}
+
+// WithItemLocks stops users selling items they have locked
+func WithItemLocks(locks ItemLockChecker) Option {
+	return func(s *service) {
+		s.locks = locks
+	}
+}
+
+// WithUndo lets users undo their recent sales
+func WithUndo(undo UndoRecorder) Option {
+	return func(s *service) {
+		s.undo = undo
+	}
+}
+
+// WithEffects applies users' sell bonus effects to sale proceeds
+func WithEffects(effects EffectChecker) Option {
+	return func(s *service) {
+		s.effects = effects
+	}
+}
+
+// WithSellTax takes percent of every sale's proceeds into the community pool
+func WithSellTax(percent int) Option {
+	return func(s *service) {
+		s.sellTaxPercent = percent
+	}
+}
+
+// WithCapacity refuses purchases that would open a slot past the buyer's
+// inventory slot limit
+func WithCapacity(capacity CapacityChecker) Option {
+	return func(s *service) {
+		s.capacity = capacity
+	}
+}
+
+// WithGameEvents takes the shop discount of running admin game events off
+// purchases, after any weekly sale
+func WithGameEvents(events gameevent.ModifierSource) Option {
+	return func(s *service) {
+		s.gameEvents = events
+	}
+}
+
 type service struct {
 	repo               repository.Economy
 	publisher          *event.ResilientPublisher
 	namingResolver     naming.Resolver
 	progressionService ProgressionService
-	rnd                func() float64 // For RNG - allows deterministic testing
+	loans              LoanChecker       // nil allows selling everything held
+	locks              ItemLockChecker   // nil ignores item locks
+	undo               UndoRecorder      // nil records no sales to undo
+	effects            EffectChecker     // nil ignores sell bonus effects
+	capacity           CapacityChecker   // nil ignores slot limits
+	market             repository.Market // nil keeps prices at base value
+	marketCfg          MarketConfig
+	sellTaxPercent     int                      // 0 leaves sale proceeds untaxed
+	gameEvents         gameevent.ModifierSource // nil ignores admin game events
+	rnd                func() float64           // For RNG - allows deterministic testing
 	now                func() time.Time
 	weeklySales        []domain.WeeklySale
 	weeklySalesMu      sync.RWMutex
 }
 
 // NewService creates a new economy service
-func NewService(repo repository.Economy, publisher *event.ResilientPublisher, namingResolver naming.Resolver, progressionService ProgressionService) Service {
+func NewService(repo repository.Economy, publisher *event.ResilientPublisher, namingResolver naming.Resolver, progressionService ProgressionService, opts ...Option) Service {
 	s := &service{
 		repo:               repo,
 		publisher:          publisher,
@@ -52,6 +148,9 @@ func NewService(repo repository.Economy, publisher *event.ResilientPublisher, na
 		rnd:                utils.RandomFloat,
 		now:                time.Now,
 	}
+	for _, opt := range opts {
+		opt(s)
+	}
 
 	// Load weekly sales configuration (log errors but don't fail startup)
 	if err := s.loadWeeklySales(); err != nil {
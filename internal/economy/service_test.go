@@ -88,8 +88,9 @@ func TestSellItem_Success(t *testing.T) {
 	mockRepo.On("GetItemByName", ctx, domain.PublicNameLootbox).Return(item, nil)
 	mockRepo.On("GetItemByName", ctx, domain.ItemMoney).Return(moneyItem, nil)
 	mockRepo.On("BeginTx", ctx).Return(mockTx, nil)
-	mockTx.On("GetInventory", ctx, user.ID).Return(inventory, nil)
-	mockTx.On("UpdateInventory", ctx, user.ID, mock.Anything).Return(nil)
+	mockRepo.On("GetInventory", mock.Anything, user.ID).Return(inventory, nil)
+	mockTx.On("AdjustItemQuantity", ctx, user.ID, 10, domain.QualityLevel(""), -3).Return(2, nil)
+	mockTx.On("AdjustItemQuantity", ctx, user.ID, moneyItem.ID, domain.QualityCommon, 120).Return(170, nil)
 	mockTx.On("Commit", ctx).Return(nil)
 	mockTx.On("Rollback", ctx).Return(nil)
 
@@ -104,6 +105,118 @@ func TestSellItem_Success(t *testing.T) {
 	mockTx.AssertExpectations(t)
 }
 
+func TestSellItem_SellTax(t *testing.T) {
+	t.Parallel()
+	// ARRANGE
+	mockRepo := &MockRepository{}
+	mockTx := &MockTx{}
+	service := NewService(mockRepo, nil, nil, nil, WithSellTax(10))
+	ctx := context.Background()
+
+	user := createTestUser()
+	item := createTestItem(10, domain.PublicNameLootbox, 100)
+	moneyItem := createMoneyItem()
+	inventory := createInventoryWithItem(10, 5)
+
+	mockRepo.On("GetUserByPlatformID", ctx, domain.PlatformTwitch, "").Return(user, nil)
+	mockRepo.On("GetItemByName", ctx, domain.PublicNameLootbox).Return(item, nil)
+	mockRepo.On("GetItemByName", ctx, domain.ItemMoney).Return(moneyItem, nil)
+	mockRepo.On("BeginTx", ctx).Return(mockTx, nil)
+	mockRepo.On("GetInventory", mock.Anything, user.ID).Return(inventory, nil)
+	mockTx.On("AdjustItemQuantity", ctx, user.ID, 10, domain.QualityLevel(""), -3).Return(2, nil)
+	// 120 proceeds less 10% tax
+	mockTx.On("AdjustItemQuantity", ctx, user.ID, moneyItem.ID, domain.QualityCommon, 108).Return(108, nil)
+	mockTx.On("AddToCommunityPool", ctx, 12).Return(nil)
+	mockTx.On("Commit", ctx).Return(nil)
+	mockTx.On("Rollback", ctx).Return(nil)
+
+	// ACT
+	moneyGained, quantitySold, err := service.SellItem(ctx, domain.PlatformTwitch, "", "testuser", domain.PublicNameLootbox, 3)
+
+	// ASSERT
+	require.NoError(t, err)
+	assert.Equal(t, 108, moneyGained)
+	assert.Equal(t, 3, quantitySold)
+	mockTx.AssertExpectations(t)
+}
+
+func TestSellItem_QualityValue(t *testing.T) {
+	t.Parallel()
+	// ARRANGE
+	mockRepo := &MockRepository{}
+	mockTx := &MockTx{}
+	service := NewService(mockRepo, nil, nil, nil)
+	ctx := context.Background()
+
+	user := createTestUser()
+	item := createTestItem(10, domain.PublicNameLootbox, 100)
+	moneyItem := createMoneyItem()
+	inventory := &domain.Inventory{
+		Slots: []domain.InventorySlot{
+			{ItemID: 10, Quantity: 2, QualityLevel: domain.QualityEpic},
+		},
+	}
+
+	mockRepo.On("GetUserByPlatformID", ctx, domain.PlatformTwitch, "").Return(user, nil)
+	mockRepo.On("GetItemByName", ctx, domain.PublicNameLootbox).Return(item, nil)
+	mockRepo.On("GetItemByName", ctx, domain.ItemMoney).Return(moneyItem, nil)
+	mockRepo.On("BeginTx", ctx).Return(mockTx, nil)
+	mockRepo.On("GetInventory", mock.Anything, user.ID).Return(inventory, nil)
+	mockTx.On("AdjustItemQuantity", ctx, user.ID, 10, domain.QualityEpic, -2).Return(0, nil)
+	// 40 per item at common, 1.5x for EPIC
+	mockTx.On("AdjustItemQuantity", ctx, user.ID, moneyItem.ID, domain.QualityCommon, 120).Return(120, nil)
+	mockTx.On("Commit", ctx).Return(nil)
+	mockTx.On("Rollback", ctx).Return(nil)
+
+	// ACT
+	moneyGained, quantitySold, err := service.SellItem(ctx, domain.PlatformTwitch, "", "testuser", domain.PublicNameLootbox, 2)
+
+	// ASSERT
+	require.NoError(t, err)
+	assert.Equal(t, 120, moneyGained)
+	assert.Equal(t, 2, quantitySold)
+	mockTx.AssertExpectations(t)
+}
+
+// fixedEffects reports the same multiplier for every effect
+type fixedEffects float64
+
+func (f fixedEffects) Multiplier(context.Context, string, domain.EffectType) float64 {
+	return float64(f)
+}
+
+func TestSellItem_SellBonusEffect(t *testing.T) {
+	t.Parallel()
+	// ARRANGE
+	mockRepo := &MockRepository{}
+	mockTx := &MockTx{}
+	service := NewService(mockRepo, nil, nil, nil, WithEffects(fixedEffects(1.5)))
+	ctx := context.Background()
+
+	user := createTestUser()
+	item := createTestItem(10, domain.PublicNameLootbox, 100)
+	moneyItem := createMoneyItem()
+	inventory := createInventoryWithItem(10, 5)
+
+	mockRepo.On("GetUserByPlatformID", ctx, domain.PlatformTwitch, "").Return(user, nil)
+	mockRepo.On("GetItemByName", ctx, domain.PublicNameLootbox).Return(item, nil)
+	mockRepo.On("GetItemByName", ctx, domain.ItemMoney).Return(moneyItem, nil)
+	mockRepo.On("BeginTx", ctx).Return(mockTx, nil)
+	mockRepo.On("GetInventory", mock.Anything, user.ID).Return(inventory, nil)
+	mockTx.On("AdjustItemQuantity", ctx, user.ID, 10, domain.QualityLevel(""), -3).Return(2, nil)
+	mockTx.On("AdjustItemQuantity", ctx, user.ID, moneyItem.ID, domain.QualityCommon, 180).Return(180, nil)
+	mockTx.On("Commit", ctx).Return(nil)
+	mockTx.On("Rollback", ctx).Return(nil)
+
+	// ACT
+	moneyGained, _, err := service.SellItem(ctx, domain.PlatformTwitch, "", "testuser", domain.PublicNameLootbox, 3)
+
+	// ASSERT
+	require.NoError(t, err)
+	assert.Equal(t, 180, moneyGained, "Should receive 1.5x the usual 40 per item")
+	mockTx.AssertExpectations(t)
+}
+
 // CASE 2: WORST CASE - Boundary conditions
 func TestSellItem_SellAllItems(t *testing.T) {
 	t.Parallel()
@@ -128,8 +241,9 @@ func TestSellItem_SellAllItems(t *testing.T) {
 	// Add mock transaction expectations
 	mockTx := &MockTx{}
 	mockRepo.On("BeginTx", ctx).Return(mockTx, nil)
-	mockTx.On("GetInventory", ctx, user.ID).Return(inventory, nil)
-	mockTx.On("UpdateInventory", ctx, user.ID, mock.Anything).Return(nil)
+	mockRepo.On("GetInventory", mock.Anything, user.ID).Return(inventory, nil)
+	mockTx.On("AdjustItemQuantity", ctx, user.ID, 10, domain.QualityLevel(""), -100).Return(0, nil)
+	mockTx.On("AdjustItemQuantity", ctx, user.ID, moneyItem.ID, domain.QualityCommon, 200).Return(200, nil)
 	mockTx.On("Commit", ctx).Return(nil)
 	mockTx.On("Rollback", ctx).Return(nil)
 
@@ -166,8 +280,9 @@ func TestSellItem_PartialQuantity(t *testing.T) {
 
 	mockTx := &MockTx{}
 	mockRepo.On("BeginTx", ctx).Return(mockTx, nil)
-	mockTx.On("GetInventory", ctx, user.ID).Return(inventory, nil)
-	mockTx.On("UpdateInventory", ctx, user.ID, mock.Anything).Return(nil)
+	mockRepo.On("GetInventory", mock.Anything, user.ID).Return(inventory, nil)
+	mockTx.On("AdjustItemQuantity", ctx, user.ID, 10, domain.QualityLevel(""), -30).Return(0, nil)
+	mockTx.On("AdjustItemQuantity", ctx, user.ID, moneyItem.ID, domain.QualityCommon, 240).Return(240, nil)
 	mockTx.On("Commit", ctx).Return(nil)
 	mockTx.On("Rollback", ctx).Return(nil)
 
@@ -180,6 +295,81 @@ func TestSellItem_PartialQuantity(t *testing.T) {
 	assert.Equal(t, 30, quantitySold, "Should return actual quantity sold")
 }
 
+// fakeLoanChecker reports borrowed quantities by item ID
+type fakeLoanChecker map[int]int
+
+func (f fakeLoanChecker) GetBorrowedQuantity(ctx context.Context, userID string, itemID int) (int, error) {
+	return f[itemID], nil
+}
+
+func TestSellItem_BorrowedItems(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	user := createTestUser()
+	item := createTestItem(10, domain.PublicNameMissile, 20)
+	moneyItem := createMoneyItem()
+	inventory := &domain.Inventory{
+		Slots: []domain.InventorySlot{
+			{ItemID: 10, Quantity: 30},
+		},
+	}
+
+	t.Run("only owned items are sold", func(t *testing.T) {
+		mockRepo := &MockRepository{}
+		mockTx := &MockTx{}
+		service := NewService(mockRepo, nil, nil, nil, WithLoanChecker(fakeLoanChecker{10: 25}))
+		mockRepo.On("GetUserByPlatformID", ctx, domain.PlatformTwitch, "").Return(user, nil)
+		mockRepo.On("GetItemByName", ctx, domain.PublicNameMissile).Return(item, nil)
+		mockRepo.On("GetItemByName", ctx, domain.ItemMoney).Return(moneyItem, nil)
+		mockRepo.On("GetInventory", mock.Anything, user.ID).Return(inventory, nil)
+		mockRepo.On("BeginTx", ctx).Return(mockTx, nil)
+		mockTx.On("AdjustItemQuantity", ctx, user.ID, 10, domain.QualityLevel(""), -5).Return(25, nil)
+		mockTx.On("AdjustItemQuantity", ctx, user.ID, moneyItem.ID, domain.QualityCommon, 40).Return(40, nil)
+		mockTx.On("Commit", ctx).Return(nil)
+		mockTx.On("Rollback", ctx).Return(nil)
+
+		_, quantitySold, err := service.SellItem(ctx, domain.PlatformTwitch, "", "testuser", domain.PublicNameMissile, 10)
+
+		require.NoError(t, err)
+		assert.Equal(t, 5, quantitySold, "The 25 borrowed missiles stay with the user")
+	})
+
+	t.Run("nothing to sell when everything is borrowed", func(t *testing.T) {
+		mockRepo := &MockRepository{}
+		service := NewService(mockRepo, nil, nil, nil, WithLoanChecker(fakeLoanChecker{10: 30}))
+		mockRepo.On("GetUserByPlatformID", ctx, domain.PlatformTwitch, "").Return(user, nil)
+		mockRepo.On("GetItemByName", ctx, domain.PublicNameMissile).Return(item, nil)
+		mockRepo.On("GetItemByName", ctx, domain.ItemMoney).Return(moneyItem, nil)
+		mockRepo.On("GetInventory", mock.Anything, user.ID).Return(inventory, nil)
+
+		_, _, err := service.SellItem(ctx, domain.PlatformTwitch, "", "testuser", domain.PublicNameMissile, 10)
+
+		assert.ErrorIs(t, err, domain.ErrItemBorrowed)
+	})
+}
+
+// fakeItemLocks reports which item IDs the user has locked
+type fakeItemLocks map[int]bool
+
+func (f fakeItemLocks) IsItemLocked(ctx context.Context, userID string, itemID int) (bool, error) {
+	return f[itemID], nil
+}
+
+func TestSellItem_LockedItem(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	mockRepo := &MockRepository{}
+	service := NewService(mockRepo, nil, nil, nil, WithItemLocks(fakeItemLocks{10: true}))
+	mockRepo.On("GetUserByPlatformID", ctx, domain.PlatformTwitch, "").Return(createTestUser(), nil)
+	mockRepo.On("GetItemByName", ctx, domain.PublicNameMissile).Return(createTestItem(10, domain.PublicNameMissile, 20), nil)
+	mockRepo.On("GetItemByName", ctx, domain.ItemMoney).Return(createMoneyItem(), nil)
+
+	_, _, err := service.SellItem(ctx, domain.PlatformTwitch, "", "testuser", domain.PublicNameMissile, 10)
+
+	assert.ErrorIs(t, err, domain.ErrItemLockedByUser)
+	mockRepo.AssertNotCalled(t, "BeginTx", ctx)
+}
+
 // CASE 4: INVALID CASE - Bad inputs
 func TestSellItem_InvalidInputs(t *testing.T) {
 	t.Parallel()
@@ -229,10 +419,7 @@ func TestSellItem_InvalidInputs(t *testing.T) {
 				m.On("GetItemByName", mock.Anything, domain.PublicNameLootbox).Return(item, nil)
 				m.On("GetItemByName", mock.Anything, domain.ItemMoney).Return(moneyItem, nil)
 
-				mockTx := &MockTx{}
-				m.On("BeginTx", mock.Anything).Return(mockTx, nil)
-				mockTx.On("GetInventory", mock.Anything, user.ID).Return(emptyInventory, nil)
-				mockTx.On("Rollback", mock.Anything).Return(nil)
+				m.On("GetInventory", mock.Anything, user.ID).Return(emptyInventory, nil)
 			},
 			username:      "testuser",
 			itemName:      domain.PublicNameLootbox,
@@ -381,9 +568,9 @@ func TestSellItem_QuantityBoundaries(t *testing.T) {
 			// Only expect Tx if validation passes
 			if !tt.expectErr || (tt.name != "negative quantity" && tt.name != "zero quantity" && tt.name != "over max boundary") {
 				if !tt.expectErr {
+					mockRepo.On("GetInventory", mock.Anything, user.ID).Return(inventory, nil)
 					mockRepo.On("BeginTx", ctx).Return(mockTx, nil)
-					mockTx.On("GetInventory", ctx, user.ID).Return(inventory, nil)
-					mockTx.On("UpdateInventory", ctx, user.ID, mock.Anything).Return(nil)
+					mockTx.On("AdjustItemQuantity", ctx, user.ID, mock.Anything, mock.Anything, mock.Anything).Return(0, nil)
 					mockTx.On("Commit", ctx).Return(nil)
 					mockTx.On("Rollback", ctx).Return(nil)
 				}
@@ -428,7 +615,7 @@ func TestSellItem_DatabaseErrors(t *testing.T) {
 			description:   "Should fail when database connection is lost during user fetch",
 		},
 		{
-			name: "database error on UpdateInventory",
+			name: "database error on AdjustItemQuantity",
 			setup: func(m *MockRepository, ctx context.Context) {
 				user := createTestUser()
 				item := createTestItem(10, domain.PublicNameLootbox, 100)
@@ -439,10 +626,10 @@ func TestSellItem_DatabaseErrors(t *testing.T) {
 				m.On("GetItemByName", ctx, domain.PublicNameLootbox).Return(item, nil)
 				m.On("GetItemByName", ctx, domain.ItemMoney).Return(moneyItem, nil)
 				mockTx := &MockTx{}
+				m.On("GetInventory", mock.Anything, user.ID).Return(inventory, nil)
 				m.On("BeginTx", ctx).Return(mockTx, nil)
-				mockTx.On("GetInventory", ctx, user.ID).Return(inventory, nil)
-				mockTx.On("UpdateInventory", ctx, user.ID, mock.Anything).
-					Return(domain.ErrDeadlockDetected)
+				mockTx.On("AdjustItemQuantity", ctx, user.ID, item.ID, domain.QualityLevel(""), -1).
+					Return(0, domain.ErrDeadlockDetected)
 				mockTx.On("Rollback", ctx).Return(nil).Maybe()
 			},
 			expectErr:     true,
@@ -571,6 +758,77 @@ func TestBuyItem_Success(t *testing.T) {
 	mockTx.AssertExpectations(t)
 }
 
+// fullInventory refuses every credit
+type fullInventory struct{}
+
+func (fullInventory) EnsureInventoryRoom(context.Context, string, int, domain.QualityLevel) error {
+	return domain.ErrInventoryFull
+}
+
+func TestBuyItem_InventoryFull(t *testing.T) {
+	t.Parallel()
+	// ARRANGE
+	mockRepo := &MockRepository{}
+	service := NewService(mockRepo, nil, nil, nil, WithCapacity(fullInventory{}))
+	ctx := context.Background()
+
+	user := createTestUser()
+	item := createTestItem(10, domain.PublicNameLootbox, 100)
+	mockRepo.On("GetUserByPlatformID", ctx, domain.PlatformTwitch, "").Return(user, nil)
+	mockRepo.On("GetItemByName", ctx, domain.PublicNameLootbox).Return(item, nil)
+
+	// ACT
+	purchased, err := service.BuyItem(ctx, domain.PlatformTwitch, "", "testuser", domain.PublicNameLootbox, 3)
+
+	// ASSERT
+	require.ErrorIs(t, err, domain.ErrInventoryFull)
+	assert.Zero(t, purchased)
+	mockRepo.AssertNotCalled(t, "BeginTx", mock.Anything)
+}
+
+// fixedGameEvents reports the same modifiers whenever asked
+type fixedGameEvents domain.GameModifiers
+
+func (f fixedGameEvents) Modifiers(context.Context) domain.GameModifiers {
+	return domain.GameModifiers(f)
+}
+
+func TestBuyItem_GameEventDiscount(t *testing.T) {
+	t.Parallel()
+	// ARRANGE
+	mockRepo := &MockRepository{}
+	service := NewService(mockRepo, nil, nil, nil, WithGameEvents(fixedGameEvents{XPMultiplier: 1, DropRateMultiplier: 1, ShopDiscountPercent: 50}))
+	ctx := context.Background()
+
+	user := createTestUser()
+	item := createTestItem(10, domain.PublicNameLootbox, 100)
+	moneyItem := createMoneyItem()
+	inventory := &domain.Inventory{
+		Slots: []domain.InventorySlot{
+			{ItemID: moneyItem.ID, Quantity: 500}, // 5 items at full price, 10 at half
+		},
+	}
+
+	mockRepo.On("GetUserByPlatformID", ctx, domain.PlatformTwitch, "").Return(user, nil)
+	mockRepo.On("GetItemByName", ctx, domain.PublicNameLootbox).Return(item, nil)
+	mockRepo.On("IsItemBuyable", ctx, domain.PublicNameLootbox).Return(true, nil)
+	mockRepo.On("GetItemByName", ctx, domain.ItemMoney).Return(moneyItem, nil)
+
+	mockTx := &MockTx{}
+	mockRepo.On("BeginTx", ctx).Return(mockTx, nil)
+	mockTx.On("GetInventory", ctx, user.ID).Return(inventory, nil)
+	mockTx.On("UpdateInventory", ctx, user.ID, mock.Anything).Return(nil)
+	mockTx.On("Commit", ctx).Return(nil)
+	mockTx.On("Rollback", ctx).Return(nil)
+
+	// ACT
+	purchased, err := service.BuyItem(ctx, domain.PlatformTwitch, "", "testuser", domain.PublicNameLootbox, 10)
+
+	// ASSERT
+	require.NoError(t, err)
+	assert.Equal(t, 10, purchased)
+}
+
 // CASE 2: BOUNDARY CASE - Money boundaries
 func TestBuyItem_MoneyBoundaries(t *testing.T) {
 	t.Parallel()
@@ -1063,8 +1321,8 @@ func TestSellItem_NamingResolution(t *testing.T) {
 
 	mockTx := &MockTx{}
 	mockRepo.On("BeginTx", ctx).Return(mockTx, nil)
-	mockTx.On("GetInventory", ctx, user.ID).Return(inventory, nil)
-	mockTx.On("UpdateInventory", ctx, user.ID, mock.Anything).Return(nil)
+	mockRepo.On("GetInventory", mock.Anything, user.ID).Return(inventory, nil)
+	mockTx.On("AdjustItemQuantity", ctx, user.ID, mock.Anything, mock.Anything, mock.Anything).Return(0, nil)
 	mockTx.On("Commit", ctx).Return(nil)
 	mockTx.On("Rollback", ctx).Return(nil)
 
@@ -37,10 +37,6 @@ func processBuyTransaction(inventory *domain.Inventory, itemID, moneySlotIndex,
 	processExchangeTransaction(inventory, moneySlotIndex, cost, actualQuantity, itemID)
 }
 
-func processSellTransaction(inventory *domain.Inventory, moneyItemID, itemSlotIndex, actualSellQuantity, moneyGained int) {
-	processExchangeTransaction(inventory, itemSlotIndex, actualSellQuantity, moneyGained, moneyItemID)
-}
-
 func (s *service) getMoneyBalance(ctx context.Context, tx repository.EconomyTx, userID string) (int, int, error) {
 	moneyItem, err := s.repo.GetItemByName(ctx, domain.ItemMoney)
 	if err != nil {
@@ -129,118 +129,6 @@ func TestProcessBuyTransaction(t *testing.T) {
 	}
 }
 
-func TestProcessSellTransaction(t *testing.T) {
-	t.Parallel()
-
-	moneyItemID := 1
-
-	tests := []struct {
-		name         string
-		initialInv   []domain.InventorySlot
-		itemSlotIdx  int
-		sellQuantity int
-		moneyGained  int
-		expectedInv  []domain.InventorySlot
-		desc         string
-	}{
-		{
-			name: "Sell entire stack",
-			initialInv: []domain.InventorySlot{
-				createSlot(10, 5), // Item to sell
-			},
-			itemSlotIdx:  0,
-			sellQuantity: 5,
-			moneyGained:  100,
-			expectedInv: []domain.InventorySlot{
-				createSlot(moneyItemID, 100), // Money gained
-			},
-			desc: "Should remove item slot and add money slot",
-		},
-		{
-			name: "Sell partial stack",
-			initialInv: []domain.InventorySlot{
-				createSlot(10, 10), // Item to sell
-			},
-			itemSlotIdx:  0,
-			sellQuantity: 5,
-			moneyGained:  100,
-			expectedInv: []domain.InventorySlot{
-				createSlot(10, 5),            // Remaining item
-				createSlot(moneyItemID, 100), // Money gained
-			},
-			desc: "Should reduce item quantity and add money slot",
-		},
-		{
-			name: "Sell with existing money",
-			initialInv: []domain.InventorySlot{
-				createSlot(10, 5),           // Item to sell
-				createSlot(moneyItemID, 50), // Existing money
-			},
-			itemSlotIdx:  0,
-			sellQuantity: 5,
-			moneyGained:  100,
-			expectedInv: []domain.InventorySlot{
-				createSlot(moneyItemID, 150), // Stacked money (50 + 100)
-			},
-			desc: "Should remove item and stack money",
-		},
-		{
-			name: "Sell specific quality slot",
-			initialInv: []domain.InventorySlot{
-				createSlot(10, 5, domain.QualityCommon), // Common
-				createSlot(10, 1, domain.QualityRare),   // Rare (index 1)
-			},
-			itemSlotIdx:  1, // Selling Rare item
-			sellQuantity: 1,
-			moneyGained:  500,
-			expectedInv: []domain.InventorySlot{
-				createSlot(10, 5, domain.QualityCommon), // Common remains
-				createSlot(moneyItemID, 500),            // Money added
-			},
-			desc: "Should remove correct slot based on index",
-		},
-	}
-
-	for _, tt := range tests {
-		tt := tt
-		t.Run(tt.name, func(t *testing.T) {
-			t.Parallel()
-			inv := &domain.Inventory{Slots: append([]domain.InventorySlot{}, tt.initialInv...)}
-
-			processSellTransaction(inv, moneyItemID, tt.itemSlotIdx, tt.sellQuantity, tt.moneyGained)
-
-			assert.ElementsMatch(t, tt.expectedInv, inv.Slots, tt.desc)
-		})
-	}
-}
-
-// Edge case: Selling into an inventory that has money in a weird state (e.g. split stacks)
-// Our logic just finds *first* money slot.
-func TestProcessSellTransaction_SplitMoneyStacks(t *testing.T) {
-	t.Parallel()
-	moneyItemID := 1
-
-	// Setup: Inventory has two money slots (shouldn't happen normally, but robust code handles it)
-	inv := &domain.Inventory{
-		Slots: []domain.InventorySlot{
-			createSlot(10, 5),
-			createSlot(moneyItemID, 100),
-			createSlot(moneyItemID, 50),
-		},
-	}
-
-	// Act: Sell item
-	processSellTransaction(inv, moneyItemID, 0, 5, 200)
-
-	// Assert: Logic searches for first matching money slot to add to.
-	expected := []domain.InventorySlot{
-		createSlot(moneyItemID, 300), // 100 + 200
-		createSlot(moneyItemID, 50),
-	}
-
-	assert.ElementsMatch(t, expected, inv.Slots)
-}
-
 // Edge case: Buying when money is the last slot and gets removed
 func TestProcessBuyTransaction_RemoveLastSlot(t *testing.T) {
 	t.Parallel()
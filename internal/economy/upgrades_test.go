@@ -192,8 +192,9 @@ func TestUpgradeEconomy1_IntegrationWithSellItem(t *testing.T) {
 	mockRepo.On("GetItemByName", ctx, "test_item").Return(item, nil)
 	mockRepo.On("GetItemByName", ctx, domain.ItemMoney).Return(moneyItem, nil)
 	mockRepo.On("BeginTx", ctx).Return(mockTx, nil)
-	mockTx.On("GetInventory", ctx, user.ID).Return(inventory, nil)
-	mockTx.On("UpdateInventory", ctx, user.ID, mock.Anything).Return(nil)
+	mockRepo.On("GetInventory", mock.Anything, user.ID).Return(inventory, nil)
+	mockTx.On("AdjustItemQuantity", ctx, user.ID, 10, domain.QualityLevel(""), -2).Return(3, nil)
+	mockTx.On("AdjustItemQuantity", ctx, user.ID, moneyItem.ID, domain.QualityCommon, 92).Return(92, nil)
 	mockTx.On("Commit", ctx).Return(nil)
 	mockTx.On("Rollback", ctx).Return(nil)
 
@@ -1,12 +1,14 @@
 package economy
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"os"
 
 	"github.com/osse101/BrandishBot_Go/internal/config"
 	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/progression"
 )
 
 // loadWeeklySales loads the weekly sales configuration from file
@@ -50,3 +52,18 @@ func (s *service) getCurrentWeeklySale() *domain.WeeklySale {
 
 	return nil
 }
+
+// GetCurrentWeeklySale returns the active weekly sale, or nil when the weekly
+// discount feature is locked or no sale is scheduled for this week
+func (s *service) GetCurrentWeeklySale(ctx context.Context) (*domain.WeeklySale, error) {
+	if s.progressionService != nil {
+		unlocked, err := s.progressionService.IsFeatureUnlocked(ctx, progression.FeatureWeeklyDiscount)
+		if err != nil {
+			return nil, fmt.Errorf("failed to check weekly discount feature: %w", err)
+		}
+		if !unlocked {
+			return nil, nil
+		}
+	}
+	return s.getCurrentWeeklySale(), nil
+}
@@ -0,0 +1,23 @@
+package effects
+
+import "time"
+
+// JobType identifies expired effect sweeps in the worker pool and scheduler
+const JobType = "effect_expiry"
+
+// Grant limits
+const (
+	// MaxDuration caps how long a single grant can last
+	MaxDuration = 7 * 24 * time.Hour
+	// MaxMagnitude caps how strong an effect can be
+	MaxMagnitude = 10.0
+)
+
+// Log messages
+const (
+	LogMsgEffectGranted          = "Effect granted"
+	LogMsgCommunityEffectGranted = "Community effect granted"
+	LogMsgEffectsExpired         = "Removed expired effects"
+	LogWarnEffectLookup          = "Failed to look up active effects"
+	LogWarnCommunityEffectLookup = "Failed to look up active community effects"
+)
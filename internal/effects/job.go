@@ -0,0 +1,24 @@
+package effects
+
+import "context"
+
+// Job removes expired effects
+type Job struct {
+	service Service
+}
+
+// NewJob creates an effect expiry job
+func NewJob(service Service) *Job {
+	return &Job{service: service}
+}
+
+// JobType returns the worker pool job type
+func (j *Job) JobType() string {
+	return JobType
+}
+
+// Process deletes every expired effect
+func (j *Job) Process(ctx context.Context) error {
+	_, err := j.service.ExpireDue(ctx)
+	return err
+}
@@ -0,0 +1,34 @@
+package effects
+
+import (
+	"context"
+	"time"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+)
+
+// Repository stores timed effects on users and communities
+type Repository interface {
+	// GrantEffect adds an effect or, while one of the same type is active,
+	// keeps the stronger magnitude and adds the duration to its expiry
+	GrantEffect(ctx context.Context, userID string, effectType domain.EffectType, magnitude float64, duration time.Duration) (*domain.Effect, error)
+
+	// GetActiveEffects returns the user's unexpired effects, soonest to expire first
+	GetActiveEffects(ctx context.Context, userID string) ([]domain.Effect, error)
+
+	// DeleteExpiredEffects removes effects that expired at or before now
+	DeleteExpiredEffects(ctx context.Context, now time.Time) (int, error)
+
+	// GrantCommunityEffect adds an effect to the current community or, while
+	// one of the same type is active, keeps the stronger magnitude and adds
+	// the duration to its expiry
+	GrantCommunityEffect(ctx context.Context, effectType domain.EffectType, magnitude float64, duration time.Duration, source string) (*domain.CommunityEffect, error)
+
+	// GetActiveCommunityEffects returns the current community's unexpired
+	// effects, soonest to expire first
+	GetActiveCommunityEffects(ctx context.Context) ([]domain.CommunityEffect, error)
+
+	// DeleteExpiredCommunityEffects removes community effects that expired
+	// at or before now, across all communities
+	DeleteExpiredCommunityEffects(ctx context.Context, now time.Time) (int, error)
+}
@@ -0,0 +1,197 @@
+// Package effects stores timed buffs and debuffs on users, such as better
+// search luck or timeout immunity. Items grant them, the search, economy,
+// gamble and user services check them, and a scheduled job removes them once
+// they expire. Community effects work the same way but apply to everyone in
+// a community, such as the bonuses granted after a raid.
+package effects
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/logger"
+)
+
+// Service grants and checks timed effects
+type Service interface {
+	// Grant gives a user an effect for a duration. Granting an effect the
+	// user already has keeps the stronger magnitude and extends the expiry.
+	Grant(ctx context.Context, userID string, effectType domain.EffectType, magnitude float64, duration time.Duration) (*domain.Effect, error)
+
+	// GetActive returns a user's unexpired effects, soonest to expire first
+	GetActive(ctx context.Context, platform, platformID string) ([]domain.Effect, error)
+
+	// Multiplier returns the magnitude of the user's active effect of the
+	// type, or 1 when there is none. A failed lookup counts as no effect.
+	Multiplier(ctx context.Context, userID string, effectType domain.EffectType) float64
+
+	// IsActive reports whether the user has an unexpired effect of the type.
+	// A failed lookup counts as no effect.
+	IsActive(ctx context.Context, userID string, effectType domain.EffectType) bool
+
+	// GrantCommunity gives the current community an effect for a duration,
+	// recording what granted it. Granting an active effect again keeps the
+	// stronger magnitude and extends the expiry.
+	GrantCommunity(ctx context.Context, effectType domain.EffectType, magnitude float64, duration time.Duration, source string) (*domain.CommunityEffect, error)
+
+	// GetCommunityActive returns the current community's unexpired effects,
+	// soonest to expire first
+	GetCommunityActive(ctx context.Context) ([]domain.CommunityEffect, error)
+
+	// CommunityMultiplier returns the magnitude of the current community's
+	// active effect of the type, or 1 when there is none. A failed lookup
+	// counts as no effect.
+	CommunityMultiplier(ctx context.Context, effectType domain.EffectType) float64
+
+	// ExpireDue removes expired user and community effects and returns how
+	// many were removed
+	ExpireDue(ctx context.Context) (int, error)
+}
+
+// UserRepository defines the user lookups needed by the effects service. It
+// is the repository rather than the user service because the user service
+// itself checks effects.
+type UserRepository interface {
+	GetUserByPlatformID(ctx context.Context, platform, platformID string) (*domain.User, error)
+}
+
+type service struct {
+	repo  Repository
+	users UserRepository
+	now   func() time.Time
+}
+
+// NewService creates an effects service
+func NewService(repo Repository, users UserRepository) Service {
+	return &service{
+		repo:  repo,
+		users: users,
+		now:   time.Now,
+	}
+}
+
+// Grant validates and stores an effect
+func (s *service) Grant(ctx context.Context, userID string, effectType domain.EffectType, magnitude float64, duration time.Duration) (*domain.Effect, error) {
+	if !effectType.IsValid() {
+		return nil, fmt.Errorf("%w: unknown effect %q", domain.ErrInvalidInput, effectType)
+	}
+	if magnitude <= 0 || magnitude > MaxMagnitude {
+		return nil, fmt.Errorf("%w: effect magnitude must be above 0 and at most %g", domain.ErrInvalidInput, MaxMagnitude)
+	}
+	if duration <= 0 || duration > MaxDuration {
+		return nil, fmt.Errorf("%w: effect duration must be positive and at most %s", domain.ErrInvalidInput, MaxDuration)
+	}
+
+	effect, err := s.repo.GrantEffect(ctx, userID, effectType, magnitude, duration)
+	if err != nil {
+		return nil, fmt.Errorf("failed to grant effect: %w", err)
+	}
+
+	logger.FromContext(ctx).Info(LogMsgEffectGranted, "user_id", userID, "effect", effectType, "magnitude", effect.Magnitude, "expires_at", effect.ExpiresAt)
+	return effect, nil
+}
+
+// GetActive returns a user's unexpired effects
+func (s *service) GetActive(ctx context.Context, platform, platformID string) ([]domain.Effect, error) {
+	user, err := s.users.GetUserByPlatformID(ctx, platform, platformID)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, domain.ErrUserNotFound
+	}
+	return s.repo.GetActiveEffects(ctx, user.ID)
+}
+
+// Multiplier returns the magnitude of an active effect, or 1
+func (s *service) Multiplier(ctx context.Context, userID string, effectType domain.EffectType) float64 {
+	if effect := s.find(ctx, userID, effectType); effect != nil {
+		return effect.Magnitude
+	}
+	return 1
+}
+
+// IsActive reports whether the user has an active effect of the type
+func (s *service) IsActive(ctx context.Context, userID string, effectType domain.EffectType) bool {
+	return s.find(ctx, userID, effectType) != nil
+}
+
+func (s *service) find(ctx context.Context, userID string, effectType domain.EffectType) *domain.Effect {
+	if userID == "" {
+		return nil
+	}
+	active, err := s.repo.GetActiveEffects(ctx, userID)
+	if err != nil {
+		logger.FromContext(ctx).Warn(LogWarnEffectLookup, "error", err, "user_id", userID, "effect", effectType)
+		return nil
+	}
+	now := s.now()
+	for i := range active {
+		if active[i].Type == effectType && active[i].ExpiresAt.After(now) {
+			return &active[i]
+		}
+	}
+	return nil
+}
+
+// GrantCommunity validates and stores a community effect
+func (s *service) GrantCommunity(ctx context.Context, effectType domain.EffectType, magnitude float64, duration time.Duration, source string) (*domain.CommunityEffect, error) {
+	if !effectType.IsCommunityEffect() {
+		return nil, fmt.Errorf("%w: %q is not a community effect", domain.ErrInvalidInput, effectType)
+	}
+	if magnitude <= 0 || magnitude > MaxMagnitude {
+		return nil, fmt.Errorf("%w: effect magnitude must be above 0 and at most %g", domain.ErrInvalidInput, MaxMagnitude)
+	}
+	if duration <= 0 || duration > MaxDuration {
+		return nil, fmt.Errorf("%w: effect duration must be positive and at most %s", domain.ErrInvalidInput, MaxDuration)
+	}
+
+	effect, err := s.repo.GrantCommunityEffect(ctx, effectType, magnitude, duration, source)
+	if err != nil {
+		return nil, fmt.Errorf("failed to grant community effect: %w", err)
+	}
+
+	logger.FromContext(ctx).Info(LogMsgCommunityEffectGranted, "effect", effectType, "magnitude", effect.Magnitude, "source", source, "expires_at", effect.ExpiresAt)
+	return effect, nil
+}
+
+// GetCommunityActive returns the current community's unexpired effects
+func (s *service) GetCommunityActive(ctx context.Context) ([]domain.CommunityEffect, error) {
+	return s.repo.GetActiveCommunityEffects(ctx)
+}
+
+// CommunityMultiplier returns the magnitude of an active community effect, or 1
+func (s *service) CommunityMultiplier(ctx context.Context, effectType domain.EffectType) float64 {
+	active, err := s.repo.GetActiveCommunityEffects(ctx)
+	if err != nil {
+		logger.FromContext(ctx).Warn(LogWarnCommunityEffectLookup, "error", err, "effect", effectType)
+		return 1
+	}
+	now := s.now()
+	for _, effect := range active {
+		if effect.Type == effectType && effect.ExpiresAt.After(now) {
+			return effect.Magnitude
+		}
+	}
+	return 1
+}
+
+// ExpireDue removes user and community effects that have expired
+func (s *service) ExpireDue(ctx context.Context) (int, error) {
+	now := s.now()
+	removed, err := s.repo.DeleteExpiredEffects(ctx, now)
+	if err != nil {
+		return 0, fmt.Errorf("failed to remove expired effects: %w", err)
+	}
+	communityRemoved, err := s.repo.DeleteExpiredCommunityEffects(ctx, now)
+	if err != nil {
+		return removed, fmt.Errorf("failed to remove expired community effects: %w", err)
+	}
+	removed += communityRemoved
+	if removed > 0 {
+		logger.FromContext(ctx).Info(LogMsgEffectsExpired, "count", removed)
+	}
+	return removed, nil
+}
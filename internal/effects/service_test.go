@@ -0,0 +1,169 @@
+package effects_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/effects"
+	"github.com/osse101/BrandishBot_Go/internal/effects/mocks"
+)
+
+const userID = "user-1"
+
+func setupServiceTest(t *testing.T) (effects.Service, *mocks.MockRepository, *mocks.MockUserRepository) {
+	mockRepo := mocks.NewMockRepository(t)
+	mockUserRepo := mocks.NewMockUserRepository(t)
+	return effects.NewService(mockRepo, mockUserRepo), mockRepo, mockUserRepo
+}
+
+func TestGrant(t *testing.T) {
+	t.Run("stores a valid effect", func(t *testing.T) {
+		svc, mockRepo, _ := setupServiceTest(t)
+		expires := time.Now().Add(time.Hour)
+		mockRepo.On("GrantEffect", mock.Anything, userID, domain.EffectSearchLuck, 2.0, time.Hour).
+			Return(&domain.Effect{UserID: userID, Type: domain.EffectSearchLuck, Magnitude: 2.0, ExpiresAt: expires}, nil)
+
+		effect, err := svc.Grant(context.Background(), userID, domain.EffectSearchLuck, 2.0, time.Hour)
+
+		require.NoError(t, err)
+		assert.Equal(t, expires, effect.ExpiresAt)
+	})
+
+	invalid := []struct {
+		name      string
+		effect    domain.EffectType
+		magnitude float64
+		duration  time.Duration
+	}{
+		{"unknown type", "flying", 2.0, time.Hour},
+		{"zero magnitude", domain.EffectSellBonus, 0, time.Hour},
+		{"magnitude above the cap", domain.EffectSellBonus, effects.MaxMagnitude + 1, time.Hour},
+		{"zero duration", domain.EffectSellBonus, 1.5, 0},
+		{"duration above the cap", domain.EffectSellBonus, 1.5, effects.MaxDuration + time.Minute},
+	}
+	for _, tc := range invalid {
+		t.Run("rejects "+tc.name, func(t *testing.T) {
+			svc, _, _ := setupServiceTest(t)
+
+			_, err := svc.Grant(context.Background(), userID, tc.effect, tc.magnitude, tc.duration)
+
+			assert.ErrorIs(t, err, domain.ErrInvalidInput)
+		})
+	}
+}
+
+func TestMultiplier(t *testing.T) {
+	active := []domain.Effect{
+		{UserID: userID, Type: domain.EffectSellBonus, Magnitude: 1.25, ExpiresAt: time.Now().Add(time.Hour)},
+		{UserID: userID, Type: domain.EffectSearchLuck, Magnitude: 2.0, ExpiresAt: time.Now().Add(-time.Minute)},
+	}
+
+	t.Run("returns the active magnitude", func(t *testing.T) {
+		svc, mockRepo, _ := setupServiceTest(t)
+		mockRepo.On("GetActiveEffects", mock.Anything, userID).Return(active, nil)
+
+		assert.Equal(t, 1.25, svc.Multiplier(context.Background(), userID, domain.EffectSellBonus))
+	})
+
+	t.Run("ignores an effect that has just expired", func(t *testing.T) {
+		svc, mockRepo, _ := setupServiceTest(t)
+		mockRepo.On("GetActiveEffects", mock.Anything, userID).Return(active, nil)
+
+		assert.Equal(t, 1.0, svc.Multiplier(context.Background(), userID, domain.EffectSearchLuck))
+	})
+
+	t.Run("a failed lookup counts as no effect", func(t *testing.T) {
+		svc, mockRepo, _ := setupServiceTest(t)
+		mockRepo.On("GetActiveEffects", mock.Anything, userID).Return(nil, errors.New("db down"))
+
+		assert.Equal(t, 1.0, svc.Multiplier(context.Background(), userID, domain.EffectSellBonus))
+		assert.False(t, svc.IsActive(context.Background(), userID, domain.EffectSellBonus))
+	})
+}
+
+func TestGetActive(t *testing.T) {
+	t.Run("looks up the user first", func(t *testing.T) {
+		svc, mockRepo, mockUserRepo := setupServiceTest(t)
+		mockUserRepo.On("GetUserByPlatformID", mock.Anything, domain.PlatformDiscord, "d-1").Return(&domain.User{ID: userID}, nil)
+		mockRepo.On("GetActiveEffects", mock.Anything, userID).Return([]domain.Effect{{Type: domain.EffectTimeoutImmunity}}, nil)
+
+		got, err := svc.GetActive(context.Background(), domain.PlatformDiscord, "d-1")
+
+		require.NoError(t, err)
+		assert.Len(t, got, 1)
+	})
+
+	t.Run("unknown user", func(t *testing.T) {
+		svc, _, mockUserRepo := setupServiceTest(t)
+		mockUserRepo.On("GetUserByPlatformID", mock.Anything, domain.PlatformDiscord, "d-1").Return(nil, nil)
+
+		_, err := svc.GetActive(context.Background(), domain.PlatformDiscord, "d-1")
+
+		assert.ErrorIs(t, err, domain.ErrUserNotFound)
+	})
+}
+
+func TestExpireDue(t *testing.T) {
+	svc, mockRepo, _ := setupServiceTest(t)
+	mockRepo.On("DeleteExpiredEffects", mock.Anything, mock.AnythingOfType("time.Time")).Return(3, nil)
+	mockRepo.On("DeleteExpiredCommunityEffects", mock.Anything, mock.AnythingOfType("time.Time")).Return(2, nil)
+
+	removed, err := svc.ExpireDue(context.Background())
+
+	require.NoError(t, err)
+	assert.Equal(t, 5, removed)
+}
+
+func TestGrantCommunity(t *testing.T) {
+	t.Run("stores a community effect", func(t *testing.T) {
+		svc, mockRepo, _ := setupServiceTest(t)
+		mockRepo.On("GrantCommunityEffect", mock.Anything, domain.EffectContributionBoost, 1.5, time.Hour, "raid").
+			Return(&domain.CommunityEffect{Type: domain.EffectContributionBoost, Magnitude: 1.5, Source: "raid"}, nil)
+
+		effect, err := svc.GrantCommunity(context.Background(), domain.EffectContributionBoost, 1.5, time.Hour, "raid")
+
+		require.NoError(t, err)
+		assert.Equal(t, "raid", effect.Source)
+	})
+
+	t.Run("rejects a user-only effect", func(t *testing.T) {
+		svc, _, _ := setupServiceTest(t)
+
+		_, err := svc.GrantCommunity(context.Background(), domain.EffectTimeoutImmunity, 1.5, time.Hour, "raid")
+
+		assert.ErrorIs(t, err, domain.ErrInvalidInput)
+	})
+
+	t.Run("rejects a duration above the cap", func(t *testing.T) {
+		svc, _, _ := setupServiceTest(t)
+
+		_, err := svc.GrantCommunity(context.Background(), domain.EffectJobXPBoost, 1.5, effects.MaxDuration+time.Minute, "raid")
+
+		assert.ErrorIs(t, err, domain.ErrInvalidInput)
+	})
+}
+
+func TestCommunityMultiplier(t *testing.T) {
+	t.Run("returns the active magnitude", func(t *testing.T) {
+		svc, mockRepo, _ := setupServiceTest(t)
+		mockRepo.On("GetActiveCommunityEffects", mock.Anything).Return([]domain.CommunityEffect{
+			{Type: domain.EffectJobXPBoost, Magnitude: 1.25, ExpiresAt: time.Now().Add(time.Hour)},
+		}, nil)
+
+		assert.Equal(t, 1.25, svc.CommunityMultiplier(context.Background(), domain.EffectJobXPBoost))
+	})
+
+	t.Run("a failed lookup counts as no effect", func(t *testing.T) {
+		svc, mockRepo, _ := setupServiceTest(t)
+		mockRepo.On("GetActiveCommunityEffects", mock.Anything).Return(nil, errors.New("db down"))
+
+		assert.Equal(t, 1.0, svc.CommunityMultiplier(context.Background(), domain.EffectJobXPBoost))
+	})
+}
@@ -0,0 +1,93 @@
+package event
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+
+	"github.com/google/uuid"
+)
+
+type bridgedKey struct{}
+
+// Bridge relays selected event types between separate processes over a
+// Transport. Unlike DistributedBus it does not replace the bus: a process
+// forwards the events it publishes, and other processes (the Discord bot, a
+// standalone worker) listen and publish them on their own bus.
+type Bridge struct {
+	bus       Bus
+	transport Transport
+	types     map[Type]bool
+	origin    string
+}
+
+// NewBridge creates a bridge for the given event types between bus and transport
+func NewBridge(bus Bus, transport Transport, types []Type) *Bridge {
+	selected := make(map[Type]bool, len(types))
+	for _, t := range types {
+		selected[t] = true
+	}
+	return &Bridge{
+		bus:       bus,
+		transport: transport,
+		types:     selected,
+		origin:    uuid.NewString(),
+	}
+}
+
+// Forward sends every selected event published on the bus to the transport.
+// A relay failure is logged but not returned, since the event has already
+// been handled locally.
+func (b *Bridge) Forward() {
+	for eventType := range b.types {
+		b.bus.Subscribe(eventType, b.forward)
+	}
+}
+
+// Listen publishes the selected events received from other processes on the
+// bus. Events this bridge forwarded itself are ignored.
+func (b *Bridge) Listen() error {
+	if err := b.transport.Listen(b.receive); err != nil {
+		return fmt.Errorf("failed to listen on event bridge: %w", err)
+	}
+	return nil
+}
+
+// Close closes the underlying transport
+func (b *Bridge) Close() error {
+	return b.transport.Close()
+}
+
+func (b *Bridge) forward(ctx context.Context, evt Event) error {
+	// Events that arrived over the bridge are not sent back
+	if bridged, _ := ctx.Value(bridgedKey{}).(bool); bridged {
+		return nil
+	}
+
+	data, err := json.Marshal(envelope{Origin: b.origin, Event: evt})
+	if err != nil {
+		slog.Warn(LogMsgBridgeEncodeFailed, "type", evt.Type, "error", err)
+		return nil
+	}
+	if err := b.transport.Publish(ctx, data); err != nil {
+		slog.Warn(LogMsgBridgePublishFailed, "type", evt.Type, "error", err)
+	}
+	return nil
+}
+
+func (b *Bridge) receive(data []byte) {
+	var env envelope
+	if err := json.Unmarshal(data, &env); err != nil {
+		slog.Warn(LogMsgRemoteDecodeFailed, "error", err)
+		return
+	}
+	if env.Origin == b.origin || !b.types[env.Event.Type] {
+		return
+	}
+
+	ctx := context.WithValue(context.Background(), bridgedKey{}, true)
+	if err := b.bus.Publish(ctx, env.Event); err != nil {
+		slog.Warn(LogMsgBridgeHandlerFailed, "type", env.Event.Type, "origin", env.Origin, "error", err)
+	}
+}
@@ -0,0 +1,87 @@
+package event
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestBridge_ForwardsSelectedTypesToListeners(t *testing.T) {
+	hub := &loopbackHub{}
+	apiBus, botBus := NewMemoryBus(), NewMemoryBus()
+
+	NewBridge(apiBus, &loopbackTransport{hub: hub}, []Type{"job.level_up"}).Forward()
+	if err := NewBridge(botBus, &loopbackTransport{hub: hub}, []Type{"job.level_up"}).Listen(); err != nil {
+		t.Fatalf("Listen returned error: %v", err)
+	}
+
+	var received []Event
+	botBus.Subscribe("job.level_up", func(_ context.Context, evt Event) error {
+		received = append(received, evt)
+		return nil
+	})
+	botBus.Subscribe("gamble.completed", func(_ context.Context, evt Event) error {
+		received = append(received, evt)
+		return nil
+	})
+
+	_ = apiBus.Publish(context.Background(), Event{Version: "1.0", Type: "job.level_up", Payload: map[string]interface{}{"level": float64(3)}})
+	_ = apiBus.Publish(context.Background(), Event{Version: "1.0", Type: "gamble.completed"})
+
+	if len(received) != 1 {
+		t.Fatalf("expected 1 bridged event, got %d", len(received))
+	}
+	if received[0].Type != "job.level_up" || received[0].Payload.(map[string]interface{})["level"] != float64(3) {
+		t.Errorf("unexpected bridged event: %+v", received[0])
+	}
+}
+
+func TestBridge_DoesNotEchoEvents(t *testing.T) {
+	hub := &loopbackHub{}
+	notifies := 0
+	hub.listeners = append(hub.listeners, func([]byte) { notifies++ })
+
+	busA, busB := NewMemoryBus(), NewMemoryBus()
+	types := []Type{"job.level_up"}
+	bridgeA := NewBridge(busA, &loopbackTransport{hub: hub}, types)
+	bridgeB := NewBridge(busB, &loopbackTransport{hub: hub}, types)
+	for _, b := range []*Bridge{bridgeA, bridgeB} {
+		b.Forward()
+		if err := b.Listen(); err != nil {
+			t.Fatalf("Listen returned error: %v", err)
+		}
+	}
+
+	countA, countB := 0, 0
+	busA.Subscribe("job.level_up", func(context.Context, Event) error { countA++; return nil })
+	busB.Subscribe("job.level_up", func(context.Context, Event) error { countB++; return nil })
+
+	_ = busA.Publish(context.Background(), Event{Type: "job.level_up"})
+
+	if countA != 1 || countB != 1 {
+		t.Errorf("expected each bus to handle the event once, got A=%d B=%d", countA, countB)
+	}
+	if notifies != 1 {
+		t.Errorf("expected the event to be sent once, got %d", notifies)
+	}
+}
+
+func TestBridge_RelayFailureIsNotReturned(t *testing.T) {
+	bus := NewMemoryBus()
+	NewBridge(bus, &loopbackTransport{hub: &loopbackHub{}, publishErr: errors.New("database down")}, []Type{"job.level_up"}).Forward()
+
+	if err := bus.Publish(context.Background(), Event{Type: "job.level_up"}); err != nil {
+		t.Errorf("expected relay failure to be swallowed, got %v", err)
+	}
+}
+
+func TestPGNotifyTransport_RejectsOversizedPayload(t *testing.T) {
+	transport := NewPGNotifyTransport(nil, PGNotifyDefaultChannel)
+
+	err := transport.Publish(context.Background(), []byte(strings.Repeat("x", PGNotifyMaxPayload)))
+
+	if !errors.Is(err, ErrNotifyPayloadTooLarge) {
+		t.Errorf("expected ErrNotifyPayloadTooLarge, got %v", err)
+	}
+}
@@ -42,6 +42,57 @@ const (
 
 	// Log message for handler errors
 	LogMsgHandlerErrorFormat = "encountered %d errors while handling event %s: %v"
+
+	// Log messages for the distributed bus
+	LogMsgRemoteEncodeFailed  = "Failed to encode event for remote relay"
+	LogMsgRemotePublishFailed = "Failed to relay event to other instances"
+	LogMsgRemoteDecodeFailed  = "Failed to decode remote event"
+	LogMsgRemoteHandlerFailed = "Shared handler failed for remote event"
+	LogMsgNATSDisconnected    = "NATS connection lost"
+	LogMsgNATSReconnected     = "NATS connection restored"
+
+	// Log messages for the Postgres event bridge
+	LogMsgBridgeEncodeFailed      = "Failed to encode event for bridge"
+	LogMsgBridgePublishFailed     = "Failed to send event over bridge"
+	LogMsgBridgeHandlerFailed     = "Handler failed for bridged event"
+	LogMsgPGNotifyDisconnected    = "Event bridge listen connection lost"
+	LogMsgPGNotifyReconnected     = "Event bridge listen connection restored"
+	LogMsgPGNotifyReconnectFailed = "Event bridge failed to reconnect"
+
+	// Log messages for per-handler retry
+	LogMsgHandlerFailedRetrying      = "Event handler failed, scheduling retry"
+	LogMsgHandlerRetrySucceeded      = "Event handler retry succeeded"
+	LogMsgHandlerRetryExhausted      = "Event handler retries exhausted, moving to dead-letter queue"
+	LogMsgHandlerFailureRecordFailed = "Failed to record event handler failure"
+)
+
+// Bus backend identifiers (EVENT_BUS_BACKEND)
+const (
+	BusBackendMemory = "memory"
+	BusBackendNATS   = "nats"
+)
+
+// NATS transport defaults
+const (
+	// NATSClientName identifies API instances in NATS monitoring
+	NATSClientName = "brandish-bot"
+
+	// NATSDefaultSubject is the subject events are relayed on
+	NATSDefaultSubject = "brandishbot.events"
+)
+
+// Postgres LISTEN/NOTIFY bridge defaults
+const (
+	// PGNotifyDefaultChannel is the channel bridged events are sent on
+	PGNotifyDefaultChannel = "brandishbot_events"
+
+	// PGNotifyMaxPayload is the size Postgres NOTIFY payloads must stay below
+	PGNotifyMaxPayload = 8000
+
+	// PGNotifyInitialBackoff and PGNotifyMaxBackoff bound the wait between
+	// attempts to re-establish a lost listen connection
+	PGNotifyInitialBackoff = time.Second
+	PGNotifyMaxBackoff     = 30 * time.Second
 )
 
 // CalculateRetryDelay calculates the exponential backoff delay for retry attempts.
@@ -0,0 +1,147 @@
+package event
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"sync"
+
+	"github.com/google/uuid"
+)
+
+// Transport moves serialized events between API instances.
+// Implementations wrap an external broker (e.g. NATS) and must be safe for concurrent use.
+type Transport interface {
+	// Publish sends an encoded envelope to all instances
+	Publish(ctx context.Context, data []byte) error
+	// Listen registers the callback invoked for every envelope received from the broker
+	Listen(handler func(data []byte)) error
+	// Close releases the broker connection
+	Close() error
+}
+
+// SharedSubscriber is implemented by buses that can deliver events published on
+// other instances. Handlers registered through Subscribe remain local-only so that
+// persistence side effects (XP, contributions, event log) run exactly once; fan-out
+// consumers such as SSE should register with SubscribeShared instead.
+type SharedSubscriber interface {
+	SubscribeShared(eventType Type, handler Handler)
+}
+
+// SubscribeShared registers a fan-out handler. On a distributed bus the handler
+// receives events from every instance; on a local bus it behaves like Subscribe.
+func SubscribeShared(bus Bus, eventType Type, handler Handler) {
+	if shared, ok := bus.(SharedSubscriber); ok {
+		shared.SubscribeShared(eventType, handler)
+		return
+	}
+	bus.Subscribe(eventType, handler)
+}
+
+// envelope is the wire format exchanged between instances
+type envelope struct {
+	Origin string `json:"origin"`
+	Event  Event  `json:"event"`
+}
+
+// DistributedBus is a Bus that dispatches events locally and relays them to other
+// instances through a Transport.
+type DistributedBus struct {
+	local      *MemoryBus
+	shared     map[Type][]Handler
+	mu         sync.RWMutex
+	transport  Transport
+	instanceID string
+}
+
+// NewDistributedBus creates a DistributedBus on top of the given transport and starts
+// listening for remote events
+func NewDistributedBus(transport Transport) (*DistributedBus, error) {
+	b := &DistributedBus{
+		local:      NewMemoryBus(),
+		shared:     make(map[Type][]Handler),
+		transport:  transport,
+		instanceID: uuid.NewString(),
+	}
+	if err := transport.Listen(b.receive); err != nil {
+		return nil, fmt.Errorf("failed to listen on event transport: %w", err)
+	}
+	return b, nil
+}
+
+// InstanceID returns the identifier used to suppress this instance's own echoes
+func (b *DistributedBus) InstanceID() string {
+	return b.instanceID
+}
+
+// Publish dispatches the event to local and shared handlers, then relays it to
+// other instances. A relay failure is logged but not returned, since local
+// handlers have already run.
+func (b *DistributedBus) Publish(ctx context.Context, evt Event) error {
+	ctx, evt = inCommunity(ctx, evt)
+	localErr := b.local.Publish(ctx, evt)
+	sharedErr := b.dispatchShared(ctx, evt)
+
+	data, err := json.Marshal(envelope{Origin: b.instanceID, Event: evt})
+	if err != nil {
+		slog.Warn(LogMsgRemoteEncodeFailed, "type", evt.Type, "error", err)
+	} else if err := b.transport.Publish(ctx, data); err != nil {
+		slog.Warn(LogMsgRemotePublishFailed, "type", evt.Type, "error", err)
+	}
+
+	if localErr != nil {
+		return localErr
+	}
+	return sharedErr
+}
+
+// Subscribe registers a local-only handler
+func (b *DistributedBus) Subscribe(eventType Type, handler Handler) {
+	b.local.Subscribe(eventType, handler)
+}
+
+// SubscribeShared registers a handler that receives events from every instance
+func (b *DistributedBus) SubscribeShared(eventType Type, handler Handler) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.shared[eventType] = append(b.shared[eventType], handler)
+}
+
+// Close closes the underlying transport
+func (b *DistributedBus) Close() error {
+	return b.transport.Close()
+}
+
+func (b *DistributedBus) dispatchShared(ctx context.Context, evt Event) error {
+	b.mu.RLock()
+	handlers := b.shared[evt.Type]
+	b.mu.RUnlock()
+
+	var errs []error
+	for _, handler := range handlers {
+		if err := handler(ctx, evt); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf(LogMsgHandlerErrorFormat, len(errs), evt.Type, errs)
+	}
+	return nil
+}
+
+// receive handles an envelope delivered by the transport
+func (b *DistributedBus) receive(data []byte) {
+	var env envelope
+	if err := json.Unmarshal(data, &env); err != nil {
+		slog.Warn(LogMsgRemoteDecodeFailed, "error", err)
+		return
+	}
+	if env.Origin == b.instanceID {
+		return
+	}
+	ctx, evt := inCommunity(context.Background(), env.Event)
+	if err := b.dispatchShared(ctx, evt); err != nil {
+		slog.Warn(LogMsgRemoteHandlerFailed, "type", env.Event.Type, "origin", env.Origin, "error", err)
+	}
+}
@@ -0,0 +1,182 @@
+package event
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/osse101/BrandishBot_Go/internal/community"
+)
+
+// loopbackTransport delivers every published message to all transports
+// attached to the same hub, mimicking a broker subject
+type loopbackHub struct {
+	mu        sync.Mutex
+	listeners []func([]byte)
+}
+
+type loopbackTransport struct {
+	hub        *loopbackHub
+	publishErr error
+	closed     bool
+}
+
+func (t *loopbackTransport) Publish(_ context.Context, data []byte) error {
+	if t.publishErr != nil {
+		return t.publishErr
+	}
+	t.hub.mu.Lock()
+	listeners := append([]func([]byte){}, t.hub.listeners...)
+	t.hub.mu.Unlock()
+	for _, l := range listeners {
+		l(data)
+	}
+	return nil
+}
+
+func (t *loopbackTransport) Listen(handler func([]byte)) error {
+	t.hub.mu.Lock()
+	defer t.hub.mu.Unlock()
+	t.hub.listeners = append(t.hub.listeners, handler)
+	return nil
+}
+
+func (t *loopbackTransport) Close() error {
+	t.closed = true
+	return nil
+}
+
+func newLoopbackPair(t *testing.T) (*DistributedBus, *DistributedBus) {
+	t.Helper()
+	hub := &loopbackHub{}
+	a, err := NewDistributedBus(&loopbackTransport{hub: hub})
+	if err != nil {
+		t.Fatalf("NewDistributedBus returned error: %v", err)
+	}
+	b, err := NewDistributedBus(&loopbackTransport{hub: hub})
+	if err != nil {
+		t.Fatalf("NewDistributedBus returned error: %v", err)
+	}
+	return a, b
+}
+
+func TestDistributedBus_LocalSubscribersOnlySeeLocalEvents(t *testing.T) {
+	a, b := newLoopbackPair(t)
+	eventType := Type("test_event")
+
+	var aCalls, bCalls int
+	a.Subscribe(eventType, func(ctx context.Context, e Event) error { aCalls++; return nil })
+	b.Subscribe(eventType, func(ctx context.Context, e Event) error { bCalls++; return nil })
+
+	if err := a.Publish(context.Background(), Event{Type: eventType}); err != nil {
+		t.Fatalf("Publish returned error: %v", err)
+	}
+
+	if aCalls != 1 {
+		t.Errorf("Expected local handler on origin to run once, got %d", aCalls)
+	}
+	if bCalls != 0 {
+		t.Errorf("Expected local handler on remote instance not to run, got %d", bCalls)
+	}
+}
+
+func TestDistributedBus_SharedSubscribersSeeAllInstances(t *testing.T) {
+	a, b := newLoopbackPair(t)
+	eventType := Type("test_event")
+
+	var aCalls, bCalls int
+	var received Event
+	a.SubscribeShared(eventType, func(ctx context.Context, e Event) error { aCalls++; return nil })
+	b.SubscribeShared(eventType, func(ctx context.Context, e Event) error {
+		bCalls++
+		received = e
+		return nil
+	})
+
+	if err := a.Publish(context.Background(), Event{Version: "1.0", Type: eventType, Payload: "payload"}); err != nil {
+		t.Fatalf("Publish returned error: %v", err)
+	}
+
+	if aCalls != 1 {
+		t.Errorf("Expected origin shared handler to run once (no echo), got %d", aCalls)
+	}
+	if bCalls != 1 {
+		t.Errorf("Expected remote shared handler to run once, got %d", bCalls)
+	}
+	if received.Payload != "payload" {
+		t.Errorf("Expected payload 'payload', got %v", received.Payload)
+	}
+}
+
+func TestDistributedBus_RemoteHandlersSeeCommunity(t *testing.T) {
+	a, b := newLoopbackPair(t)
+	eventType := Type("test_event")
+
+	var gotCommunity string
+	b.SubscribeShared(eventType, func(ctx context.Context, e Event) error {
+		gotCommunity = community.FromContext(ctx)
+		return nil
+	})
+
+	if err := a.Publish(community.WithID(context.Background(), "alpha"), Event{Version: "1.0", Type: eventType}); err != nil {
+		t.Fatalf("Publish returned error: %v", err)
+	}
+
+	if gotCommunity != "alpha" {
+		t.Errorf("Expected remote handler to run in community alpha, got %q", gotCommunity)
+	}
+}
+
+func TestDistributedBus_RelayFailureDoesNotFailPublish(t *testing.T) {
+	bus, err := NewDistributedBus(&loopbackTransport{hub: &loopbackHub{}, publishErr: errors.New("broker down")})
+	if err != nil {
+		t.Fatalf("NewDistributedBus returned error: %v", err)
+	}
+
+	handled := false
+	bus.Subscribe("test_event", func(ctx context.Context, e Event) error { handled = true; return nil })
+
+	if err := bus.Publish(context.Background(), Event{Type: "test_event"}); err != nil {
+		t.Errorf("Expected no error on relay failure, got %v", err)
+	}
+	if !handled {
+		t.Error("Local handler was not called")
+	}
+}
+
+func TestDistributedBus_HandlerErrorReturned(t *testing.T) {
+	a, _ := newLoopbackPair(t)
+	a.SubscribeShared("test_event", func(ctx context.Context, e Event) error { return errors.New("boom") })
+
+	if err := a.Publish(context.Background(), Event{Type: "test_event"}); err == nil {
+		t.Error("Expected shared handler error to be returned")
+	}
+}
+
+func TestDistributedBus_Close(t *testing.T) {
+	transport := &loopbackTransport{hub: &loopbackHub{}}
+	bus, err := NewDistributedBus(transport)
+	if err != nil {
+		t.Fatalf("NewDistributedBus returned error: %v", err)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatalf("Close returned error: %v", err)
+	}
+	if !transport.closed {
+		t.Error("Expected transport to be closed")
+	}
+}
+
+func TestSubscribeShared_FallsBackToSubscribe(t *testing.T) {
+	bus := NewMemoryBus()
+	handled := false
+	SubscribeShared(bus, "test_event", func(ctx context.Context, e Event) error { handled = true; return nil })
+
+	if err := bus.Publish(context.Background(), Event{Type: "test_event"}); err != nil {
+		t.Fatalf("Publish returned error: %v", err)
+	}
+	if !handled {
+		t.Error("Expected SubscribeShared to register on a MemoryBus")
+	}
+}
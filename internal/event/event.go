@@ -6,7 +6,9 @@ import (
 	"sync"
 	"time"
 
+	"github.com/osse101/BrandishBot_Go/internal/community"
 	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/ids"
 )
 
 // Type represents the type of an event
@@ -21,6 +23,24 @@ type Event struct {
 	Type     Type        `json:"type"`
 	Payload  interface{} `json:"payload"`
 	Metadata Metadata    `json:"metadata"`
+
+	// Community the event happened in. Empty means the default community.
+	// Set from the publisher's context when the event is published.
+	Community string `json:"community,omitempty"`
+}
+
+// inCommunity stamps the event with the community its publisher's context is
+// scoped to, or scopes ctx to the community an already-stamped event names.
+// Either way handlers see the same community on the event and on ctx, even
+// when the event is retried or relayed without the original context.
+func inCommunity(ctx context.Context, evt Event) (context.Context, Event) {
+	if evt.Community != "" {
+		return community.WithID(ctx, evt.Community), evt
+	}
+	if id, ok := community.Lookup(ctx); ok && id != community.Default {
+		evt.Community = id
+	}
+	return ctx, evt
 }
 
 // GetMetadataValue extracts a value from the event metadata safely
@@ -52,6 +72,7 @@ const (
 	ProgressionAllUnlocked    Type = "progression.all_unlocked"
 	ProgressionNodeUnlocked   Type = "progression.node_unlocked"
 	ProgressionNodeRelocked   Type = "progression.node_relocked"
+	PersonalMilestoneUnlocked Type = "progression.milestone_unlocked"
 	EventTypeEngagement       Type = "engagement"
 
 	// Timeout event types
@@ -65,6 +86,113 @@ const (
 	SubscriptionDowngraded Type = "subscription.downgraded"
 	SubscriptionExpired    Type = "subscription.expired"
 	SubscriptionCancelled  Type = "subscription.cancelled"
+
+	// Celebration event types
+	CelebrationGranted Type = "celebration.granted"
+
+	// GambleRecovered is published when a gamble stuck in a non-terminal state is resumed or refunded
+	GambleRecovered Type = "gamble.recovered"
+
+	// Vote review event types
+	ProgressionVotesFlagged Type = "progression.votes_flagged"
+	ProgressionVoteReviewed Type = "progression.vote_reviewed"
+
+	// ReminderDue is published when a user's reminder is dispatched
+	ReminderDue Type = "reminder.due"
+
+	// Item loan event types
+	ItemLoaned       Type = "item.loaned"
+	ItemLoanReturned Type = "item.loan_returned"
+
+	// ItemBalanceChanged is published when scheduled item balance changes take effect
+	ItemBalanceChanged Type = "item.balance_changed"
+
+	// SnapshotRestored is published after game state is restored from a snapshot
+	SnapshotRestored Type = "snapshot.restored"
+
+	// PlayerShopSold is published when a user buys from another user's listing
+	PlayerShopSold Type = "player_shop.sold"
+
+	// Targeted item event types: one for each side whenever an item is used on another user
+	ItemTargetAttacked Type = "item.target.attacked"
+	ItemTargetDefended Type = "item.target.defended"
+
+	// InventoryChanged is published after every committed inventory write with the per-item diff
+	InventoryChanged Type = "inventory.changed"
+
+	// GiveFlagged is published when a give is queued for admin review as a likely funnel
+	GiveFlagged Type = "give.flagged"
+
+	// ChatDropped is published when chat activity drops items to active chatters
+	ChatDropped Type = "chat.drop"
+
+	// KeywordDropped is published when a chat message saying an event
+	// theme's keyword drops an item to its sender. Unlike ChatDropped, it
+	// does not end the activity round.
+	KeywordDropped Type = "chat.keyword_drop"
+
+	// CommunityBonusGranted is published when a raid or host grants the
+	// community timed bonuses
+	CommunityBonusGranted Type = "community.bonus_granted"
+
+	// StreamStarted is published when a stream session opens
+	StreamStarted Type = "stream.started"
+
+	// StreamEnded is published when a stream session closes, so the Discord
+	// bot can post the stream's summary
+	StreamEnded Type = "stream.ended"
+
+	// StreamRecapGenerated is published with the recap of a stream that just
+	// ended, which the Discord bot renders as an embed
+	StreamRecapGenerated Type = "stream.recap"
+
+	// AnnouncementRouted is published with the message an announcement route
+	// rendered for a Discord channel or SSE topic
+	AnnouncementRouted Type = "announcement.routed"
+
+	// AnnouncementRoutesChanged is published when an admin creates, updates
+	// or deletes an announcement route, so the Discord bot can reload which
+	// events it no longer announces itself
+	AnnouncementRoutesChanged Type = "announcement.routes_changed"
+
+	// JackpotWon is published when a lootbox opening or gamble wins the
+	// progressive jackpot
+	JackpotWon Type = "jackpot.won"
+
+	// SeasonChanged is published when a seasonal content pack starts or ends
+	SeasonChanged Type = "season.changed"
+
+	// DigMilestoneReached is published when the community dig site reaches
+	// a level for the first time
+	DigMilestoneReached Type = "digging.milestone_reached"
+
+	// FishingBite is published when a fish bites a user's line, opening the
+	// window to reel it in
+	FishingBite Type = "fishing.bite"
+
+	// FishCaught is published when a user lands a fish
+	FishCaught Type = "fishing.caught"
+
+	// BossSpawned is published when a boss appears for the community to fight
+	BossSpawned Type = "boss.spawned"
+
+	// BossDamaged is published after every attack on the boss, with its
+	// remaining HP
+	BossDamaged Type = "boss.damaged"
+
+	// BossDefeated is published when an attack brings the boss to 0 HP, with
+	// each attacker's share of the loot
+	BossDefeated Type = "boss.defeated"
+
+	// BossEscaped is published when a boss's time runs out before it falls
+	BossEscaped Type = "boss.escaped"
+
+	// GardenHarvested is published when a user harvests their ready garden
+	// plots
+	GardenHarvested Type = "garden.harvested"
+
+	// GiftDelivered is published when a wrapped gift reaches its recipient
+	GiftDelivered Type = "gift.delivered"
 )
 
 // Typed event payloads for type safety
@@ -387,6 +515,171 @@ type SubscriptionPayloadV1 struct {
 	Timestamp int64  `json:"timestamp"`
 }
 
+// CelebrationPayloadV1 is the typed payload for birthday and anniversary rewards
+type CelebrationPayloadV1 struct {
+	UserID    string `json:"user_id"`
+	Username  string `json:"username"`
+	Kind      string `json:"kind"`
+	Years     int    `json:"years,omitempty"`
+	ItemName  string `json:"item_name"`
+	Quantity  int    `json:"quantity"`
+	Timestamp int64  `json:"timestamp"`
+}
+
+// NewCelebrationGrantedEvent creates a new celebration granted event
+func NewCelebrationGrantedEvent(grant domain.CelebrationGrant) Event {
+	return Event{
+		Version: EventSchemaVersion,
+		Type:    CelebrationGranted,
+		Payload: CelebrationPayloadV1{
+			UserID:    grant.UserID,
+			Username:  grant.Username,
+			Kind:      grant.Kind,
+			Years:     grant.Years,
+			ItemName:  grant.ItemName,
+			Quantity:  grant.Quantity,
+			Timestamp: time.Now().Unix(),
+		},
+	}
+}
+
+// GambleRecoveredPayloadV1 is the typed payload for stale gamble recoveries
+type GambleRecoveredPayloadV1 struct {
+	GambleID      string `json:"gamble_id"`
+	ShortCode     string `json:"short_code"`
+	PreviousState string `json:"previous_state"`
+	Action        string `json:"action"`
+	Participants  int    `json:"participants"`
+	Reason        string `json:"reason,omitempty"`
+	Timestamp     int64  `json:"timestamp"`
+}
+
+// NewGambleRecoveredEvent creates a new gamble recovered event
+func NewGambleRecoveredEvent(recovery domain.GambleRecovery) Event {
+	return Event{
+		Version: EventSchemaVersion,
+		Type:    GambleRecovered,
+		Payload: GambleRecoveredPayloadV1{
+			GambleID:      recovery.GambleID.String(),
+			ShortCode:     ids.ShortCode(ids.PrefixGamble, recovery.GambleID),
+			PreviousState: string(recovery.PreviousState),
+			Action:        string(recovery.Action),
+			Participants:  recovery.Participants,
+			Reason:        recovery.Reason,
+			Timestamp:     time.Now().Unix(),
+		},
+	}
+}
+
+// VotesFlaggedPayloadV1 is the typed payload for votes flagged by brigading detection
+type VotesFlaggedPayloadV1 struct {
+	SessionID int    `json:"session_id"`
+	Flagged   int    `json:"flagged"`
+	Excluded  int    `json:"excluded"`
+	Reason    string `json:"reason"`
+	Timestamp int64  `json:"timestamp"`
+}
+
+// NewVotesFlaggedEvent creates a new votes flagged event. reason is the
+// reason given for the first flagged vote.
+func NewVotesFlaggedEvent(sessionID, flagged, excluded int, reason string) Event {
+	return Event{
+		Version: EventSchemaVersion,
+		Type:    ProgressionVotesFlagged,
+		Payload: VotesFlaggedPayloadV1{
+			SessionID: sessionID,
+			Flagged:   flagged,
+			Excluded:  excluded,
+			Reason:    reason,
+			Timestamp: time.Now().Unix(),
+		},
+	}
+}
+
+// VoteReviewedPayloadV1 is the typed payload for a vote excluded from or restored to the tally
+type VoteReviewedPayloadV1 struct {
+	SessionID int    `json:"session_id"`
+	UserID    string `json:"user_id"`
+	Action    string `json:"action"`
+	Actor     string `json:"actor"`
+	Timestamp int64  `json:"timestamp"`
+}
+
+// NewVoteReviewedEvent creates a new vote reviewed event
+func NewVoteReviewedEvent(sessionID int, userID string, action domain.VoteReviewAction, actor string) Event {
+	return Event{
+		Version: EventSchemaVersion,
+		Type:    ProgressionVoteReviewed,
+		Payload: VoteReviewedPayloadV1{
+			SessionID: sessionID,
+			UserID:    userID,
+			Action:    string(action),
+			Actor:     actor,
+			Timestamp: time.Now().Unix(),
+		},
+	}
+}
+
+// GiveFlaggedPayloadV1 is the typed payload for a give queued for admin review
+type GiveFlaggedPayloadV1 struct {
+	FlagID     int64  `json:"flag_id"`
+	GiverID    string `json:"giver_id"`
+	ReceiverID string `json:"receiver_id"`
+	ItemName   string `json:"item_name"`
+	Quantity   int    `json:"quantity"`
+	Reason     string `json:"reason"`
+	Timestamp  int64  `json:"timestamp"`
+}
+
+// NewGiveFlaggedEvent creates a new give flagged event
+func NewGiveFlaggedEvent(flag domain.GiveFlag) Event {
+	return Event{
+		Version: EventSchemaVersion,
+		Type:    GiveFlagged,
+		Payload: GiveFlaggedPayloadV1{
+			FlagID:     flag.ID,
+			GiverID:    flag.GiverID,
+			ReceiverID: flag.ReceiverID,
+			ItemName:   flag.ItemName,
+			Quantity:   flag.Quantity,
+			Reason:     flag.Reason,
+			Timestamp:  time.Now().Unix(),
+		},
+	}
+}
+
+// ReminderDuePayloadV1 is the typed payload for a dispatched reminder
+type ReminderDuePayloadV1 struct {
+	ReminderID int64  `json:"reminder_id"`
+	UserID     string `json:"user_id"`
+	Platform   string `json:"platform"`
+	PlatformID string `json:"platform_id"`
+	Kind       string `json:"kind"`
+	Target     string `json:"target,omitempty"`
+	Message    string `json:"message"`
+	ChannelID  string `json:"channel_id,omitempty"`
+	Timestamp  int64  `json:"timestamp"`
+}
+
+// NewReminderDueEvent creates a new reminder due event
+func NewReminderDueEvent(reminder domain.Reminder) Event {
+	return Event{
+		Version: EventSchemaVersion,
+		Type:    ReminderDue,
+		Payload: ReminderDuePayloadV1{
+			ReminderID: reminder.ID,
+			UserID:     reminder.UserID,
+			Platform:   reminder.Platform,
+			PlatformID: reminder.PlatformID,
+			Kind:       string(reminder.Kind),
+			Target:     reminder.Target,
+			Message:    reminder.Message,
+			ChannelID:  reminder.ChannelID,
+			Timestamp:  time.Now().Unix(),
+		},
+	}
+}
+
 // NewTimeoutAppliedEvent creates a new timeout applied event
 func NewTimeoutAppliedEvent(platform, username string, durationSeconds int, reason string) Event {
 	return Event{
@@ -483,6 +776,8 @@ func NewMemoryBus() *MemoryBus {
 // Publish publishes an event to all subscribers.
 // Note: Currently executes handlers synchronously. Future versions may use a worker pool.
 func (b *MemoryBus) Publish(ctx context.Context, event Event) error {
+	ctx, event = inCommunity(ctx, event)
+
 	b.mu.RLock()
 	handlers, ok := b.handlers[event.Type]
 	b.mu.RUnlock()
@@ -512,3 +807,756 @@ func (b *MemoryBus) Subscribe(eventType Type, handler Handler) {
 
 	b.handlers[eventType] = append(b.handlers[eventType], handler)
 }
+
+// ItemLoanPayloadV1 is the typed payload for item loan events
+type ItemLoanPayloadV1 struct {
+	LoanID           int64  `json:"loan_id"`
+	LenderID         string `json:"lender_id"`
+	BorrowerID       string `json:"borrower_id,omitempty"` // Empty when the borrower's account was deleted
+	ItemName         string `json:"item_name"`
+	Quantity         int    `json:"quantity"`
+	ReturnedQuantity int    `json:"returned_quantity"`
+	Status           string `json:"status"`
+	DueAt            int64  `json:"due_at"`
+	Timestamp        int64  `json:"timestamp"`
+}
+
+// NewItemLoanedEvent creates a new item loaned event
+func NewItemLoanedEvent(loan domain.ItemLoan) Event {
+	return newItemLoanEvent(ItemLoaned, loan)
+}
+
+// NewItemLoanReturnedEvent creates a new event for a loan that was returned,
+// whether by the borrower or because it fell due
+func NewItemLoanReturnedEvent(loan domain.ItemLoan) Event {
+	return newItemLoanEvent(ItemLoanReturned, loan)
+}
+
+func newItemLoanEvent(eventType Type, loan domain.ItemLoan) Event {
+	return Event{
+		Version: EventSchemaVersion,
+		Type:    eventType,
+		Payload: ItemLoanPayloadV1{
+			LoanID:           loan.ID,
+			LenderID:         loan.LenderID,
+			BorrowerID:       loan.BorrowerID,
+			ItemName:         loan.ItemName,
+			Quantity:         loan.Quantity,
+			ReturnedQuantity: loan.ReturnedQuantity,
+			Status:           string(loan.Status),
+			DueAt:            loan.DueAt.Unix(),
+			Timestamp:        time.Now().Unix(),
+		},
+	}
+}
+
+// ItemBalanceChangeV1 is one change in a balance changed event
+type ItemBalanceChangeV1 struct {
+	ItemName    string `json:"item_name"`
+	Field       string `json:"field"`
+	Pool        string `json:"pool,omitempty"`
+	Value       int    `json:"value"`
+	EffectiveAt int64  `json:"effective_at"`
+}
+
+// ItemBalanceChangedPayloadV1 is the typed payload for balance changed events
+type ItemBalanceChangedPayloadV1 struct {
+	Changes   []ItemBalanceChangeV1 `json:"changes"`
+	Timestamp int64                 `json:"timestamp"`
+}
+
+// NewItemBalanceChangedEvent creates a new event for balance changes that have taken effect
+func NewItemBalanceChangedEvent(changes []domain.ItemBalanceChange) Event {
+	payload := ItemBalanceChangedPayloadV1{
+		Changes:   make([]ItemBalanceChangeV1, 0, len(changes)),
+		Timestamp: time.Now().Unix(),
+	}
+	for _, change := range changes {
+		payload.Changes = append(payload.Changes, ItemBalanceChangeV1{
+			ItemName:    change.ItemName,
+			Field:       string(change.Field),
+			Pool:        change.Pool,
+			Value:       change.Value,
+			EffectiveAt: change.EffectiveAt.Unix(),
+		})
+	}
+	return Event{
+		Version: EventSchemaVersion,
+		Type:    ItemBalanceChanged,
+		Payload: payload,
+	}
+}
+
+// SnapshotRestoredPayloadV1 is the typed payload for snapshot restores
+type SnapshotRestoredPayloadV1 struct {
+	// SnapshotID is the stored snapshot restored, or 0 for an uploaded one
+	SnapshotID int64  `json:"snapshot_id"`
+	RestoredBy string `json:"restored_by"`
+	Timestamp  int64  `json:"timestamp"`
+}
+
+// NewSnapshotRestoredEvent creates a new event for a completed restore
+func NewSnapshotRestoredEvent(snapshotID int64, restoredBy string) Event {
+	return Event{
+		Version: EventSchemaVersion,
+		Type:    SnapshotRestored,
+		Payload: SnapshotRestoredPayloadV1{
+			SnapshotID: snapshotID,
+			RestoredBy: restoredBy,
+			Timestamp:  time.Now().Unix(),
+		},
+	}
+}
+
+// PlayerShopSoldPayloadV1 is the typed payload for player shop sales
+type PlayerShopSoldPayloadV1 struct {
+	ListingID  int64  `json:"listing_id"`
+	SellerID   string `json:"seller_id"`
+	BuyerID    string `json:"buyer_id"`
+	ItemName   string `json:"item_name"`
+	Quantity   int    `json:"quantity"`
+	TotalPrice int    `json:"total_price"`
+	Fee        int    `json:"fee"`
+	Remaining  int    `json:"remaining"`
+	Timestamp  int64  `json:"timestamp"`
+}
+
+// NewPlayerShopSoldEvent creates a new player shop sale event
+func NewPlayerShopSoldEvent(buyerID string, purchase domain.PlayerShopPurchase) Event {
+	return Event{
+		Version: EventSchemaVersion,
+		Type:    PlayerShopSold,
+		Payload: PlayerShopSoldPayloadV1{
+			ListingID:  purchase.Listing.ID,
+			SellerID:   purchase.Listing.SellerID,
+			BuyerID:    buyerID,
+			ItemName:   purchase.Listing.ItemName,
+			Quantity:   purchase.Quantity,
+			TotalPrice: purchase.TotalPrice,
+			Fee:        purchase.Fee,
+			Remaining:  purchase.Listing.Quantity,
+			Timestamp:  time.Now().Unix(),
+		},
+	}
+}
+
+// InventoryChangeV1 is one item's entry in an inventory diff
+type InventoryChangeV1 struct {
+	ItemID   int    `json:"item_id"`
+	Quality  string `json:"quality"`
+	Delta    int    `json:"delta"`
+	Quantity int    `json:"quantity"` // Quantity held after the change
+}
+
+// InventoryChangedPayloadV1 is the typed payload for inventory diff events
+type InventoryChangedPayloadV1 struct {
+	UserID    string              `json:"user_id"`
+	Reason    string              `json:"reason"`
+	Changes   []InventoryChangeV1 `json:"changes"`
+	Timestamp int64               `json:"timestamp"`
+}
+
+// NewInventoryChangedEvent creates a new inventory diff event
+func NewInventoryChangedEvent(userID, reason string, changes []InventoryChangeV1) Event {
+	return Event{
+		Version: EventSchemaVersion,
+		Type:    InventoryChanged,
+		Payload: InventoryChangedPayloadV1{
+			UserID:    userID,
+			Reason:    reason,
+			Changes:   changes,
+			Timestamp: time.Now().Unix(),
+		},
+	}
+}
+
+// TargetedItemPayloadV1 is the typed payload for targeted item events. Both
+// sides of an encounter get the same payload under their own event type.
+type TargetedItemPayloadV1 struct {
+	Platform         string `json:"platform"`
+	AttackerID       string `json:"attacker_id"`
+	AttackerUsername string `json:"attacker_username"`
+	DefenderID       string `json:"defender_id,omitempty"` // Empty when the target has no account
+	DefenderUsername string `json:"defender_username"`
+	ItemName         string `json:"item_name"`
+	Outcome          string `json:"outcome"`
+	CounterItem      string `json:"counter_item,omitempty"`
+	TimeoutSeconds   int    `json:"timeout_seconds"`
+	Timestamp        int64  `json:"timestamp"`
+}
+
+// NewTargetedItemEvents creates the attacker and defender events for an item
+// used on another user
+func NewTargetedItemEvents(effect domain.TargetedEffect) (attacked, defended Event) {
+	payload := TargetedItemPayloadV1{
+		Platform:         effect.Platform,
+		AttackerID:       effect.AttackerID,
+		AttackerUsername: effect.AttackerUsername,
+		DefenderID:       effect.DefenderID,
+		DefenderUsername: effect.DefenderUsername,
+		ItemName:         effect.ItemName,
+		Outcome:          string(effect.Outcome),
+		CounterItem:      effect.CounterItem,
+		TimeoutSeconds:   int(effect.Timeout.Seconds()),
+		Timestamp:        time.Now().Unix(),
+	}
+	attacked = Event{Version: EventSchemaVersion, Type: ItemTargetAttacked, Payload: payload}
+	defended = Event{Version: EventSchemaVersion, Type: ItemTargetDefended, Payload: payload}
+	return attacked, defended
+}
+
+// PersonalMilestoneUnlockedPayloadV1 is the typed payload for personal milestone unlocks
+type PersonalMilestoneUnlockedPayloadV1 struct {
+	UserID        string `json:"user_id"`
+	TrackKey      string `json:"track_key"`
+	TrackName     string `json:"track_name"`
+	MilestoneKey  string `json:"milestone_key"`
+	MilestoneName string `json:"milestone_name"`
+	Metric        string `json:"metric"`
+	Threshold     int64  `json:"threshold"`
+	Timestamp     int64  `json:"timestamp"`
+}
+
+// NewPersonalMilestoneUnlockedEvent creates a new event for a milestone a
+// user's own activity unlocked
+func NewPersonalMilestoneUnlockedEvent(userID string, track domain.PersonalTrack, milestone domain.PersonalMilestone) Event {
+	return Event{
+		Version: EventSchemaVersion,
+		Type:    PersonalMilestoneUnlocked,
+		Payload: PersonalMilestoneUnlockedPayloadV1{
+			UserID:        userID,
+			TrackKey:      track.Key,
+			TrackName:     track.Name,
+			MilestoneKey:  milestone.Key,
+			MilestoneName: milestone.Name,
+			Metric:        milestone.Metric,
+			Threshold:     milestone.Threshold,
+			Timestamp:     time.Now().Unix(),
+		},
+	}
+}
+
+// ChatDropV1 is one item dropped to a chatter
+type ChatDropV1 struct {
+	UserID   string `json:"user_id"`
+	Username string `json:"username"`
+	Platform string `json:"platform"`
+	ItemName string `json:"item_name"`
+	Quantity int    `json:"quantity"`
+}
+
+// ChatDroppedPayloadV1 is the typed payload for chat drop events
+type ChatDroppedPayloadV1 struct {
+	Drops     []ChatDropV1 `json:"drops"`
+	Chatters  int          `json:"chatters"`
+	Timestamp int64        `json:"timestamp"`
+}
+
+// NewChatDroppedEvent creates a new event for the items one round of chat
+// activity dropped, out of the given number of active chatters
+func NewChatDroppedEvent(drops []domain.ChatDrop, chatters int) Event {
+	payload := ChatDroppedPayloadV1{
+		Drops:     make([]ChatDropV1, len(drops)),
+		Chatters:  chatters,
+		Timestamp: time.Now().Unix(),
+	}
+	for i, drop := range drops {
+		payload.Drops[i] = ChatDropV1{
+			UserID:   drop.UserID,
+			Username: drop.Username,
+			Platform: drop.Platform,
+			ItemName: drop.ItemName,
+			Quantity: drop.Quantity,
+		}
+	}
+	return Event{Version: EventSchemaVersion, Type: ChatDropped, Payload: payload}
+}
+
+// KeywordDroppedPayloadV1 is the typed payload for keyword drop events
+type KeywordDroppedPayloadV1 struct {
+	Drop      ChatDropV1 `json:"drop"`
+	Keyword   string     `json:"keyword"`
+	Theme     string     `json:"theme"`
+	Timestamp int64      `json:"timestamp"`
+}
+
+// NewKeywordDroppedEvent creates a new event for an item a chat keyword
+// dropped while its theme was active
+func NewKeywordDroppedEvent(drop domain.ChatDrop, keyword, theme string) Event {
+	payload := KeywordDroppedPayloadV1{
+		Drop: ChatDropV1{
+			UserID:   drop.UserID,
+			Username: drop.Username,
+			Platform: drop.Platform,
+			ItemName: drop.ItemName,
+			Quantity: drop.Quantity,
+		},
+		Keyword:   keyword,
+		Theme:     theme,
+		Timestamp: time.Now().Unix(),
+	}
+	return Event{Version: EventSchemaVersion, Type: KeywordDropped, Payload: payload}
+}
+
+// CommunityEffectV1 is one timed community bonus
+type CommunityEffectV1 struct {
+	Type      string  `json:"type"`
+	Magnitude float64 `json:"magnitude"`
+	ExpiresAt int64   `json:"expires_at"`
+}
+
+// CommunityBonusGrantedPayloadV1 is the typed payload for community bonus events
+type CommunityBonusGrantedPayloadV1 struct {
+	Kind              string              `json:"kind"`
+	Platform          string              `json:"platform"`
+	FromChannel       string              `json:"from_channel"`
+	Viewers           int                 `json:"viewers"`
+	Effects           []CommunityEffectV1 `json:"effects"`
+	BonusContribution int                 `json:"bonus_contribution"`
+	Timestamp         int64               `json:"timestamp"`
+}
+
+// NewCommunityBonusGrantedEvent creates a new event for the bonuses a raid or
+// host granted the community
+func NewCommunityBonusGrantedEvent(bonus *domain.RaidBonus) Event {
+	payload := CommunityBonusGrantedPayloadV1{
+		Kind:              string(bonus.Kind),
+		Platform:          bonus.Platform,
+		FromChannel:       bonus.FromChannel,
+		Viewers:           bonus.Viewers,
+		Effects:           make([]CommunityEffectV1, len(bonus.Effects)),
+		BonusContribution: bonus.BonusContribution,
+		Timestamp:         time.Now().Unix(),
+	}
+	for i, effect := range bonus.Effects {
+		payload.Effects[i] = CommunityEffectV1{
+			Type:      string(effect.Type),
+			Magnitude: effect.Magnitude,
+			ExpiresAt: effect.ExpiresAt.Unix(),
+		}
+	}
+	return Event{Version: EventSchemaVersion, Type: CommunityBonusGranted, Payload: payload}
+}
+
+// StreamRecapPayloadV1 is the typed payload for stream recap events
+type StreamRecapPayloadV1 struct {
+	Recap     domain.StreamRecap `json:"recap"`
+	Timestamp int64              `json:"timestamp"`
+}
+
+// NewStreamRecapEvent creates a new event for a stream's recap
+func NewStreamRecapEvent(recap *domain.StreamRecap) Event {
+	payload := StreamRecapPayloadV1{
+		Recap:     *recap,
+		Timestamp: time.Now().Unix(),
+	}
+	return Event{Version: EventSchemaVersion, Type: StreamRecapGenerated, Payload: payload}
+}
+
+// StreamSessionPayloadV1 is the typed payload for stream session events.
+// EndedAt is zero while the session is live.
+type StreamSessionPayloadV1 struct {
+	SessionID int64  `json:"session_id"`
+	Platform  string `json:"platform"`
+	Title     string `json:"title"`
+	StartedAt int64  `json:"started_at"`
+	EndedAt   int64  `json:"ended_at,omitempty"`
+	Timestamp int64  `json:"timestamp"`
+}
+
+// NewStreamStartedEvent creates a new event for a stream session opening
+func NewStreamStartedEvent(session *domain.StreamSession) Event {
+	return Event{Version: EventSchemaVersion, Type: StreamStarted, Payload: newStreamSessionPayload(session)}
+}
+
+// NewStreamEndedEvent creates a new event for a stream session closing
+func NewStreamEndedEvent(session *domain.StreamSession) Event {
+	return Event{Version: EventSchemaVersion, Type: StreamEnded, Payload: newStreamSessionPayload(session)}
+}
+
+func newStreamSessionPayload(session *domain.StreamSession) StreamSessionPayloadV1 {
+	payload := StreamSessionPayloadV1{
+		SessionID: session.ID,
+		Platform:  session.Platform,
+		Title:     session.Title,
+		StartedAt: session.StartedAt.Unix(),
+		Timestamp: time.Now().Unix(),
+	}
+	if session.EndedAt != nil {
+		payload.EndedAt = session.EndedAt.Unix()
+	}
+	return payload
+}
+
+// AnnouncementPayloadV1 is the typed payload for routed announcements
+type AnnouncementPayloadV1 struct {
+	RouteID     int64                          `json:"route_id"`
+	EventType   string                         `json:"event_type"`
+	Destination domain.AnnouncementDestination `json:"destination"`
+	Target      string                         `json:"target"`
+	Message     string                         `json:"message"`
+	Timestamp   int64                          `json:"timestamp"`
+}
+
+// NewAnnouncementEvent creates a new event for a message rendered by an
+// announcement route
+func NewAnnouncementEvent(route domain.AnnouncementRoute, message string) Event {
+	payload := AnnouncementPayloadV1{
+		RouteID:     route.ID,
+		EventType:   route.EventType,
+		Destination: route.Destination,
+		Target:      route.Target,
+		Message:     message,
+		Timestamp:   time.Now().Unix(),
+	}
+	return Event{Version: EventSchemaVersion, Type: AnnouncementRouted, Payload: payload}
+}
+
+// AnnouncementRoutesChangedPayloadV1 is the typed payload for announcement
+// route changes
+type AnnouncementRoutesChangedPayloadV1 struct {
+	Timestamp int64 `json:"timestamp"`
+}
+
+// NewAnnouncementRoutesChangedEvent creates a new event for a change to the
+// announcement routes
+func NewAnnouncementRoutesChangedEvent() Event {
+	payload := AnnouncementRoutesChangedPayloadV1{Timestamp: time.Now().Unix()}
+	return Event{Version: EventSchemaVersion, Type: AnnouncementRoutesChanged, Payload: payload}
+}
+
+// JackpotWonPayloadV1 is the typed payload for jackpot win events
+type JackpotWonPayloadV1 struct {
+	UserID    string `json:"user_id"`
+	Username  string `json:"username"`
+	Amount    int64  `json:"amount"`
+	Source    string `json:"source"`
+	Timestamp int64  `json:"timestamp"`
+}
+
+// NewJackpotWonEvent creates a new event for a payout of the progressive
+// jackpot
+func NewJackpotWonEvent(win *domain.JackpotWin) Event {
+	payload := JackpotWonPayloadV1{
+		UserID:    win.UserID,
+		Username:  win.Username,
+		Amount:    win.Amount,
+		Source:    win.Source,
+		Timestamp: win.WonAt.Unix(),
+	}
+	return Event{Version: EventSchemaVersion, Type: JackpotWon, Payload: payload}
+}
+
+// SeasonChangedPayloadV1 is the typed payload for season changes
+type SeasonChangedPayloadV1 struct {
+	Started   []string `json:"started"`
+	Ended     []string `json:"ended"`
+	Active    []string `json:"active"`
+	Timestamp int64    `json:"timestamp"`
+}
+
+// NewSeasonChangedEvent creates a new event for seasons that started or ended
+func NewSeasonChangedEvent(started, ended, active []string) Event {
+	return Event{
+		Version: EventSchemaVersion,
+		Type:    SeasonChanged,
+		Payload: SeasonChangedPayloadV1{
+			Started:   started,
+			Ended:     ended,
+			Active:    active,
+			Timestamp: time.Now().Unix(),
+		},
+	}
+}
+
+// DigMilestonePayloadV1 is the typed payload for dig site milestones
+type DigMilestonePayloadV1 struct {
+	Level        int    `json:"level"`
+	Name         string `json:"name"`
+	Depth        int    `json:"depth"`
+	Contribution int    `json:"contribution"` // Points granted, 0 when the grant failed
+	UserID       string `json:"user_id"`
+	Username     string `json:"username"`
+	Timestamp    int64  `json:"timestamp"`
+}
+
+// NewDigMilestoneEvent creates a new event for the dig that took the site
+// into level, which granted contribution points
+func NewDigMilestoneEvent(level domain.DigLevel, contribution int, dig *domain.DigResult) Event {
+	return Event{
+		Version: EventSchemaVersion,
+		Type:    DigMilestoneReached,
+		Payload: DigMilestonePayloadV1{
+			Level:        level.Level,
+			Name:         level.Name,
+			Depth:        dig.Depth,
+			Contribution: contribution,
+			UserID:       dig.UserID,
+			Username:     dig.Username,
+			Timestamp:    time.Now().Unix(),
+		},
+	}
+}
+
+// FishingBitePayloadV1 is the typed payload for fishing bites
+type FishingBitePayloadV1 struct {
+	UserID     string `json:"user_id"`
+	Username   string `json:"username"`
+	Platform   string `json:"platform"`
+	PlatformID string `json:"platform_id"`
+	ChannelID  string `json:"channel_id,omitempty"` // Where the cast was made, when the platform sent it
+	ReelBy     int64  `json:"reel_by"`              // Unix time the bite window closes
+	Timestamp  int64  `json:"timestamp"`
+}
+
+// NewFishingBiteEvent creates a new event for a fish biting the cast made on
+// platform by platformID
+func NewFishingBiteEvent(cast *domain.FishingCast, platform, platformID, channelID string) Event {
+	return Event{
+		Version: EventSchemaVersion,
+		Type:    FishingBite,
+		Payload: FishingBitePayloadV1{
+			UserID:     cast.UserID,
+			Username:   cast.Username,
+			Platform:   platform,
+			PlatformID: platformID,
+			ChannelID:  channelID,
+			ReelBy:     cast.ReelBy.Unix(),
+			Timestamp:  cast.BiteAt.Unix(),
+		},
+	}
+}
+
+// FishCaughtPayloadV1 is the typed payload for landed fish
+type FishCaughtPayloadV1 struct {
+	UserID     string `json:"user_id"`
+	Username   string `json:"username"`
+	ItemName   string `json:"item_name"`
+	Quality    string `json:"quality"`
+	XP         int    `json:"xp"` // Fisher XP to award
+	ReactionMs int64  `json:"reaction_ms"`
+	Tackle     string `json:"tackle,omitempty"`
+	Timestamp  int64  `json:"timestamp"`
+}
+
+// NewFishCaughtEvent creates a new event for a reel that landed a fish
+func NewFishCaughtEvent(result *domain.ReelResult) Event {
+	return Event{
+		Version: EventSchemaVersion,
+		Type:    FishCaught,
+		Payload: FishCaughtPayloadV1{
+			UserID:     result.UserID,
+			Username:   result.Username,
+			ItemName:   result.Catch.ItemName,
+			Quality:    string(result.Catch.Quality),
+			XP:         result.Catch.XP,
+			ReactionMs: result.ReactionMs,
+			Tackle:     result.Tackle,
+			Timestamp:  time.Now().Unix(),
+		},
+	}
+}
+
+// BossSpawnedPayloadV1 is the typed payload for a spawned boss
+type BossSpawnedPayloadV1 struct {
+	BossID        string `json:"boss_id"`
+	Key           string `json:"key"`
+	Name          string `json:"name"`
+	MaxHP         int    `json:"max_hp"`
+	RewardLootbox string `json:"reward_lootbox"`
+	RewardBoxes   int    `json:"reward_boxes"`
+	SpawnedBy     string `json:"spawned_by"`
+	ExpiresAt     int64  `json:"expires_at"` // Unix time the boss escapes
+	Timestamp     int64  `json:"timestamp"`
+}
+
+// NewBossSpawnedEvent creates a new event for a boss that just spawned
+func NewBossSpawnedEvent(boss *domain.Boss) Event {
+	return Event{
+		Version: EventSchemaVersion,
+		Type:    BossSpawned,
+		Payload: BossSpawnedPayloadV1{
+			BossID:        boss.ID.String(),
+			Key:           boss.Key,
+			Name:          boss.Name,
+			MaxHP:         boss.MaxHP,
+			RewardLootbox: boss.RewardLootbox,
+			RewardBoxes:   boss.RewardBoxes,
+			SpawnedBy:     boss.SpawnedBy,
+			ExpiresAt:     boss.ExpiresAt.Unix(),
+			Timestamp:     boss.SpawnedAt.Unix(),
+		},
+	}
+}
+
+// BossDamagedPayloadV1 is the typed payload for an attack on the boss
+type BossDamagedPayloadV1 struct {
+	BossID    string `json:"boss_id"`
+	Name      string `json:"name"`
+	UserID    string `json:"user_id"`
+	Username  string `json:"username"`
+	Damage    int    `json:"damage"`
+	Weapon    string `json:"weapon,omitempty"`
+	HP        int    `json:"hp"`
+	MaxHP     int    `json:"max_hp"`
+	Timestamp int64  `json:"timestamp"`
+}
+
+// NewBossDamagedEvent creates a new event for an attack on the boss
+func NewBossDamagedEvent(result *domain.BossAttackResult) Event {
+	return Event{
+		Version: EventSchemaVersion,
+		Type:    BossDamaged,
+		Payload: BossDamagedPayloadV1{
+			BossID:    result.BossID,
+			Name:      result.BossName,
+			UserID:    result.UserID,
+			Username:  result.Username,
+			Damage:    result.Damage,
+			Weapon:    result.Weapon,
+			HP:        result.HP,
+			MaxHP:     result.MaxHP,
+			Timestamp: time.Now().Unix(),
+		},
+	}
+}
+
+// BossShareV1 is an attacker's part in a defeated boss
+type BossShareV1 struct {
+	UserID   string `json:"user_id"`
+	Username string `json:"username"`
+	Damage   int    `json:"damage"`
+	Boxes    int    `json:"boxes"` // Reward lootboxes won
+}
+
+// BossDefeatedPayloadV1 is the typed payload for a defeated boss
+type BossDefeatedPayloadV1 struct {
+	BossID        string        `json:"boss_id"`
+	Name          string        `json:"name"`
+	MaxHP         int           `json:"max_hp"`
+	RewardLootbox string        `json:"reward_lootbox"`
+	KillerID      string        `json:"killer_id"`
+	KillerName    string        `json:"killer_name"`
+	Shares        []BossShareV1 `json:"shares"` // By damage, highest first
+	Timestamp     int64         `json:"timestamp"`
+}
+
+// NewBossDefeatedEvent creates a new event for the killing blow on the boss
+func NewBossDefeatedEvent(rewardLootbox string, result *domain.BossAttackResult) Event {
+	shares := make([]BossShareV1, 0, len(result.Loot))
+	for _, loot := range result.Loot {
+		shares = append(shares, BossShareV1{
+			UserID:   loot.UserID,
+			Username: loot.Username,
+			Damage:   loot.Damage,
+			Boxes:    loot.Boxes,
+		})
+	}
+	return Event{
+		Version: EventSchemaVersion,
+		Type:    BossDefeated,
+		Payload: BossDefeatedPayloadV1{
+			BossID:        result.BossID,
+			Name:          result.BossName,
+			MaxHP:         result.MaxHP,
+			RewardLootbox: rewardLootbox,
+			KillerID:      result.UserID,
+			KillerName:    result.Username,
+			Shares:        shares,
+			Timestamp:     time.Now().Unix(),
+		},
+	}
+}
+
+// BossEscapedPayloadV1 is the typed payload for a boss that escaped
+type BossEscapedPayloadV1 struct {
+	BossID    string `json:"boss_id"`
+	Name      string `json:"name"`
+	HP        int    `json:"hp"`
+	MaxHP     int    `json:"max_hp"`
+	Timestamp int64  `json:"timestamp"`
+}
+
+// NewBossEscapedEvent creates a new event for a boss whose time ran out
+func NewBossEscapedEvent(boss *domain.Boss) Event {
+	return Event{
+		Version: EventSchemaVersion,
+		Type:    BossEscaped,
+		Payload: BossEscapedPayloadV1{
+			BossID:    boss.ID.String(),
+			Name:      boss.Name,
+			HP:        boss.HP,
+			MaxHP:     boss.MaxHP,
+			Timestamp: time.Now().Unix(),
+		},
+	}
+}
+
+// GardenHarvestedPayloadV1 is the typed payload for garden harvests
+type GardenHarvestedPayloadV1 struct {
+	UserID    string `json:"user_id"`
+	Username  string `json:"username"`
+	Plots     int    `json:"plots"`
+	Crops     int    `json:"crops"` // Crop items harvested across every plot
+	XP        int    `json:"xp"`    // Farmer XP to award
+	Timestamp int64  `json:"timestamp"`
+}
+
+// NewGardenHarvestedEvent creates a new event for a garden harvest
+func NewGardenHarvestedEvent(harvest *domain.GardenHarvest) Event {
+	crops := 0
+	for _, crop := range harvest.Crops {
+		crops += crop.Quantity
+	}
+	return Event{
+		Version: EventSchemaVersion,
+		Type:    GardenHarvested,
+		Payload: GardenHarvestedPayloadV1{
+			UserID:    harvest.UserID,
+			Username:  harvest.Username,
+			Plots:     harvest.Plots,
+			Crops:     crops,
+			XP:        harvest.XP,
+			Timestamp: time.Now().Unix(),
+		},
+	}
+}
+
+// GiftDeliveredPayloadV1 is the typed payload for a delivered gift
+type GiftDeliveredPayloadV1 struct {
+	GiftID              int64  `json:"gift_id"`
+	SenderID            string `json:"sender_id"`
+	SenderName          string `json:"sender_name"`
+	RecipientID         string `json:"recipient_id"`
+	RecipientName       string `json:"recipient_name"`
+	Platform            string `json:"platform"`
+	RecipientPlatformID string `json:"recipient_platform_id"`
+	ChannelID           string `json:"channel_id,omitempty"`
+	ItemName            string `json:"item_name"`
+	Quantity            int    `json:"quantity"`
+	Message             string `json:"message,omitempty"`
+	Timestamp           int64  `json:"timestamp"`
+}
+
+// NewGiftDeliveredEvent creates a new event for a gift that reached its
+// recipient
+func NewGiftDeliveredEvent(gift domain.Gift) Event {
+	return Event{
+		Version: EventSchemaVersion,
+		Type:    GiftDelivered,
+		Payload: GiftDeliveredPayloadV1{
+			GiftID:              gift.ID,
+			SenderID:            gift.SenderID,
+			SenderName:          gift.SenderName,
+			RecipientID:         gift.RecipientID,
+			RecipientName:       gift.RecipientName,
+			Platform:            gift.Platform,
+			RecipientPlatformID: gift.RecipientPlatformID,
+			ChannelID:           gift.ChannelID,
+			ItemName:            gift.ItemName,
+			Quantity:            gift.Quantity,
+			Message:             gift.Message,
+			Timestamp:           time.Now().Unix(),
+		},
+	}
+}
@@ -4,6 +4,8 @@ import (
 	"context"
 	"errors"
 	"testing"
+
+	"github.com/osse101/BrandishBot_Go/internal/community"
 )
 
 func TestMemoryBus_PublishSubscribe(t *testing.T) {
@@ -73,3 +75,34 @@ func TestMemoryBus_PublishError(t *testing.T) {
 		t.Error("Expected error from Publish, got nil")
 	}
 }
+
+func TestMemoryBus_CarriesCommunity(t *testing.T) {
+	bus := NewMemoryBus()
+	eventType := Type("test_event")
+
+	var gotEvent Event
+	var gotCommunity string
+	bus.Subscribe(eventType, func(ctx context.Context, event Event) error {
+		gotEvent = event
+		gotCommunity = community.FromContext(ctx)
+		return nil
+	})
+
+	// The publisher's community is stamped on the event
+	_ = bus.Publish(community.WithID(context.Background(), "alpha"), Event{Version: "1.0", Type: eventType})
+	if gotEvent.Community != "alpha" || gotCommunity != "alpha" {
+		t.Errorf("Expected community alpha on event and context, got %q and %q", gotEvent.Community, gotCommunity)
+	}
+
+	// A stamped event republished without context, as retries are, keeps it
+	_ = bus.Publish(context.Background(), gotEvent)
+	if gotCommunity != "alpha" {
+		t.Errorf("Expected retried event to stay in alpha, got %q", gotCommunity)
+	}
+
+	// Default community events stay unstamped
+	_ = bus.Publish(context.Background(), Event{Version: "1.0", Type: eventType})
+	if gotEvent.Community != "" || gotCommunity != community.Default {
+		t.Errorf("Expected unstamped default event, got %q and %q", gotEvent.Community, gotCommunity)
+	}
+}
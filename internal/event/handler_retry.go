@@ -0,0 +1,226 @@
+package event
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"runtime"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/osse101/BrandishBot_Go/internal/logger"
+)
+
+// ErrHandlerNotFound is returned when re-driving a handler that is not subscribed
+var ErrHandlerNotFound = errors.New("event handler not found")
+
+// HandlerFailure describes a handler that kept failing after all retries
+type HandlerFailure struct {
+	Handler   string
+	Event     Event
+	Attempts  int
+	LastError string
+}
+
+// HandlerFailureSink stores handler failures for later inspection and re-drive
+type HandlerFailureSink interface {
+	RecordHandlerFailure(ctx context.Context, failure HandlerFailure) error
+}
+
+// HandlerRedriver re-runs a single named handler for an event
+type HandlerRedriver interface {
+	Redrive(ctx context.Context, handlerName string, evt Event) error
+}
+
+// RetryingBus wraps a Bus so that each subscribed handler is retried on its own
+// with exponential backoff. Handlers that exhaust their retries are handed to a
+// HandlerFailureSink instead of being lost.
+//
+// A failing handler does not fail Publish: the retry owns the failure from that
+// point, and re-running the whole event would repeat side effects of the
+// handlers that already succeeded.
+type RetryingBus struct {
+	inner      Bus
+	maxRetries int
+	retryDelay time.Duration
+
+	mu       sync.RWMutex
+	handlers map[string]Handler
+	sink     HandlerFailureSink
+
+	wg       sync.WaitGroup
+	shutdown chan struct{}
+	once     sync.Once
+}
+
+// NewRetryingBus wraps bus with per-handler retry
+func NewRetryingBus(bus Bus, maxRetries int, retryDelay time.Duration) *RetryingBus {
+	return &RetryingBus{
+		inner:      bus,
+		maxRetries: maxRetries,
+		retryDelay: retryDelay,
+		handlers:   make(map[string]Handler),
+		shutdown:   make(chan struct{}),
+	}
+}
+
+// SetFailureSink sets where exhausted handler failures are stored. Until a sink
+// is set they are only logged.
+func (b *RetryingBus) SetFailureSink(sink HandlerFailureSink) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.sink = sink
+}
+
+// Publish publishes through the wrapped bus
+func (b *RetryingBus) Publish(ctx context.Context, evt Event) error {
+	return b.inner.Publish(ctx, evt)
+}
+
+// Subscribe registers a handler wrapped with retry
+func (b *RetryingBus) Subscribe(eventType Type, handler Handler) {
+	b.inner.Subscribe(eventType, b.wrap(eventType, handler))
+}
+
+// SubscribeShared registers a fan-out handler wrapped with retry
+func (b *RetryingBus) SubscribeShared(eventType Type, handler Handler) {
+	SubscribeShared(b.inner, eventType, b.wrap(eventType, handler))
+}
+
+// Redrive runs the named handler once for the event, bypassing retry
+func (b *RetryingBus) Redrive(ctx context.Context, handlerName string, evt Event) error {
+	b.mu.RLock()
+	handler, ok := b.handlers[handlerName]
+	b.mu.RUnlock()
+	if !ok {
+		return fmt.Errorf("%w: %s", ErrHandlerNotFound, handlerName)
+	}
+	return handler(ctx, evt)
+}
+
+// HandlerNames returns the registered handler names
+func (b *RetryingBus) HandlerNames() []string {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	names := make([]string, 0, len(b.handlers))
+	for name := range b.handlers {
+		names = append(names, name)
+	}
+	return names
+}
+
+// Close stops pending retries, recording them as failures, and closes the
+// wrapped bus if it holds resources
+func (b *RetryingBus) Close() error {
+	b.once.Do(func() { close(b.shutdown) })
+	b.wg.Wait()
+	if closer, ok := b.inner.(interface{ Close() error }); ok {
+		return closer.Close()
+	}
+	return nil
+}
+
+// wrap registers the handler under a stable name and returns the retrying wrapper
+func (b *RetryingBus) wrap(eventType Type, handler Handler) Handler {
+	name := b.register(eventType, handler)
+
+	return func(ctx context.Context, evt Event) error {
+		err := handler(ctx, evt)
+		if err == nil {
+			return nil
+		}
+
+		logger.FromContext(ctx).Warn(LogMsgHandlerFailedRetrying,
+			"handler", name,
+			"event_type", evt.Type,
+			"error", err)
+
+		b.wg.Add(1)
+		go b.retry(context.WithoutCancel(ctx), name, handler, evt, err)
+		return nil
+	}
+}
+
+// register stores the handler under "<event type>:<function name>", adding a
+// numeric suffix when the same function is subscribed more than once
+func (b *RetryingBus) register(eventType Type, handler Handler) string {
+	base := string(eventType) + ":" + handlerFuncName(handler)
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	name := base
+	for i := 2; ; i++ {
+		if _, exists := b.handlers[name]; !exists {
+			break
+		}
+		name = fmt.Sprintf("%s#%d", base, i)
+	}
+	b.handlers[name] = handler
+	return name
+}
+
+// retry re-runs a failed handler with exponential backoff. The first call
+// counts as attempt 1.
+func (b *RetryingBus) retry(ctx context.Context, name string, handler Handler, evt Event, lastErr error) {
+	defer b.wg.Done()
+	log := logger.FromContext(ctx)
+
+	attempt := 1
+	for attempt < b.maxRetries {
+		select {
+		case <-time.After(CalculateRetryDelay(b.retryDelay, attempt)):
+		case <-b.shutdown:
+			b.recordFailure(ctx, name, evt, attempt, lastErr)
+			return
+		}
+
+		attempt++
+		if lastErr = handler(ctx, evt); lastErr == nil {
+			log.Info(LogMsgHandlerRetrySucceeded, "handler", name, "event_type", evt.Type, "attempt", attempt)
+			return
+		}
+	}
+
+	b.recordFailure(ctx, name, evt, attempt, lastErr)
+}
+
+func (b *RetryingBus) recordFailure(ctx context.Context, name string, evt Event, attempts int, lastErr error) {
+	log := logger.FromContext(ctx)
+	log.Error(LogMsgHandlerRetryExhausted,
+		"handler", name,
+		"event_type", evt.Type,
+		"attempts", attempts,
+		"error", lastErr)
+
+	b.mu.RLock()
+	sink := b.sink
+	b.mu.RUnlock()
+	if sink == nil {
+		return
+	}
+
+	failure := HandlerFailure{Handler: name, Event: evt, Attempts: attempts}
+	if lastErr != nil {
+		failure.LastError = lastErr.Error()
+	}
+	if err := sink.RecordHandlerFailure(ctx, failure); err != nil {
+		log.Error(LogMsgHandlerFailureRecordFailed, "handler", name, "error", err)
+	}
+}
+
+// handlerFuncName returns a short name for a handler function, e.g.
+// "progression.(*service).handleNodeUnlocked"
+func handlerFuncName(handler Handler) string {
+	fn := runtime.FuncForPC(reflect.ValueOf(handler).Pointer())
+	if fn == nil {
+		return "unknown"
+	}
+	name := fn.Name()
+	if i := strings.LastIndex(name, "/"); i >= 0 {
+		name = name[i+1:]
+	}
+	return strings.TrimSuffix(name, "-fm")
+}
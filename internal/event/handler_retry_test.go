@@ -0,0 +1,178 @@
+package event
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+type recordingSink struct {
+	mu       sync.Mutex
+	failures []HandlerFailure
+}
+
+func (s *recordingSink) RecordHandlerFailure(_ context.Context, failure HandlerFailure) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.failures = append(s.failures, failure)
+	return nil
+}
+
+func (s *recordingSink) all() []HandlerFailure {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]HandlerFailure(nil), s.failures...)
+}
+
+func waitFor(t *testing.T, cond func() bool) {
+	t.Helper()
+	deadline := time.Now().Add(2 * time.Second)
+	for !cond() {
+		if time.Now().After(deadline) {
+			t.Fatal("condition not met before timeout")
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func TestRetryingBus_RetriesOnlyFailingHandler(t *testing.T) {
+	bus := NewRetryingBus(NewMemoryBus(), 3, time.Millisecond)
+
+	var okCalls, flakyCalls atomic.Int32
+	bus.Subscribe("test_event", func(ctx context.Context, e Event) error {
+		okCalls.Add(1)
+		return nil
+	})
+	bus.Subscribe("test_event", func(ctx context.Context, e Event) error {
+		if flakyCalls.Add(1) < 2 {
+			return errors.New("transient")
+		}
+		return nil
+	})
+
+	if err := bus.Publish(context.Background(), Event{Type: "test_event"}); err != nil {
+		t.Fatalf("Publish returned error: %v", err)
+	}
+
+	waitFor(t, func() bool { return flakyCalls.Load() == 2 })
+	if err := bus.Close(); err != nil {
+		t.Fatalf("Close returned error: %v", err)
+	}
+
+	if okCalls.Load() != 1 {
+		t.Errorf("Expected succeeding handler to run once, got %d", okCalls.Load())
+	}
+}
+
+func TestRetryingBus_ExhaustedFailureGoesToSink(t *testing.T) {
+	bus := NewRetryingBus(NewMemoryBus(), 3, time.Millisecond)
+	sink := &recordingSink{}
+	bus.SetFailureSink(sink)
+
+	var calls atomic.Int32
+	bus.Subscribe("test_event", func(ctx context.Context, e Event) error {
+		calls.Add(1)
+		return errors.New("permanent")
+	})
+
+	if err := bus.Publish(context.Background(), Event{Type: "test_event", Payload: "payload"}); err != nil {
+		t.Fatalf("Publish returned error: %v", err)
+	}
+
+	waitFor(t, func() bool { return len(sink.all()) == 1 })
+
+	failure := sink.all()[0]
+	if calls.Load() != 3 {
+		t.Errorf("Expected 3 attempts, got %d", calls.Load())
+	}
+	if failure.Attempts != 3 {
+		t.Errorf("Expected failure attempts 3, got %d", failure.Attempts)
+	}
+	if failure.LastError != "permanent" {
+		t.Errorf("Expected last error 'permanent', got %q", failure.LastError)
+	}
+	if failure.Event.Payload != "payload" {
+		t.Errorf("Expected event payload to be kept, got %v", failure.Event.Payload)
+	}
+}
+
+func TestRetryingBus_CloseRecordsPendingRetries(t *testing.T) {
+	bus := NewRetryingBus(NewMemoryBus(), 5, time.Hour)
+	sink := &recordingSink{}
+	bus.SetFailureSink(sink)
+
+	bus.Subscribe("test_event", func(ctx context.Context, e Event) error {
+		return errors.New("fail")
+	})
+
+	if err := bus.Publish(context.Background(), Event{Type: "test_event"}); err != nil {
+		t.Fatalf("Publish returned error: %v", err)
+	}
+	if err := bus.Close(); err != nil {
+		t.Fatalf("Close returned error: %v", err)
+	}
+
+	if got := len(sink.all()); got != 1 {
+		t.Fatalf("Expected pending retry to be recorded on close, got %d", got)
+	}
+	if sink.all()[0].Attempts != 1 {
+		t.Errorf("Expected 1 attempt, got %d", sink.all()[0].Attempts)
+	}
+}
+
+func TestRetryingBus_Redrive(t *testing.T) {
+	bus := NewRetryingBus(NewMemoryBus(), 1, time.Millisecond)
+
+	var received Event
+	bus.Subscribe("test_event", func(ctx context.Context, e Event) error {
+		received = e
+		return nil
+	})
+
+	names := bus.HandlerNames()
+	if len(names) != 1 {
+		t.Fatalf("Expected 1 registered handler, got %v", names)
+	}
+
+	if err := bus.Redrive(context.Background(), names[0], Event{Type: "test_event", Payload: "again"}); err != nil {
+		t.Fatalf("Redrive returned error: %v", err)
+	}
+	if received.Payload != "again" {
+		t.Errorf("Expected handler to receive redriven event, got %v", received.Payload)
+	}
+
+	if err := bus.Redrive(context.Background(), "missing", Event{}); !errors.Is(err, ErrHandlerNotFound) {
+		t.Errorf("Expected ErrHandlerNotFound, got %v", err)
+	}
+}
+
+type namedHandlers struct{}
+
+func (h *namedHandlers) handle(ctx context.Context, e Event) error { return nil }
+
+func TestRetryingBus_HandlerNamesAreStable(t *testing.T) {
+	bus := NewRetryingBus(NewMemoryBus(), 1, time.Millisecond)
+	h := &namedHandlers{}
+
+	bus.Subscribe("a", h.handle)
+	bus.Subscribe("a", h.handle)
+	bus.Subscribe("b", h.handle)
+
+	want := map[string]bool{
+		"a:event.(*namedHandlers).handle":   true,
+		"a:event.(*namedHandlers).handle#2": true,
+		"b:event.(*namedHandlers).handle":   true,
+	}
+	for _, name := range bus.HandlerNames() {
+		if !want[name] {
+			t.Errorf("Unexpected handler name %q", name)
+		}
+		delete(want, name)
+	}
+	if len(want) != 0 {
+		t.Errorf("Missing handler names: %v", want)
+	}
+}
@@ -0,0 +1,18 @@
+package event
+
+import "context"
+
+type inventoryReasonKey struct{}
+
+// WithInventoryReason labels the inventory writes made with ctx, so their
+// inventory.changed events say what changed the inventory. Without a label the
+// repository that wrote the inventory names the reason.
+func WithInventoryReason(ctx context.Context, reason string) context.Context {
+	return context.WithValue(ctx, inventoryReasonKey{}, reason)
+}
+
+// InventoryReason returns the label set by WithInventoryReason, or "" when none was set
+func InventoryReason(ctx context.Context) string {
+	reason, _ := ctx.Value(inventoryReasonKey{}).(string)
+	return reason
+}
@@ -0,0 +1,59 @@
+package event
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/nats-io/nats.go"
+)
+
+// NATSTransport relays events between instances over a NATS subject
+type NATSTransport struct {
+	conn    *nats.Conn
+	subject string
+	sub     *nats.Subscription
+}
+
+// NewNATSTransport connects to the NATS server at url and publishes on subject
+func NewNATSTransport(url, subject string) (*NATSTransport, error) {
+	conn, err := nats.Connect(url,
+		nats.Name(NATSClientName),
+		nats.MaxReconnects(-1),
+		nats.DisconnectErrHandler(func(_ *nats.Conn, err error) {
+			slog.Warn(LogMsgNATSDisconnected, "error", err)
+		}),
+		nats.ReconnectHandler(func(c *nats.Conn) {
+			slog.Info(LogMsgNATSReconnected, "url", c.ConnectedUrl())
+		}),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to NATS at %s: %w", url, err)
+	}
+	return &NATSTransport{conn: conn, subject: subject}, nil
+}
+
+// Publish sends data on the configured subject
+func (t *NATSTransport) Publish(_ context.Context, data []byte) error {
+	return t.conn.Publish(t.subject, data)
+}
+
+// Listen subscribes to the configured subject
+func (t *NATSTransport) Listen(handler func(data []byte)) error {
+	sub, err := t.conn.Subscribe(t.subject, func(msg *nats.Msg) {
+		handler(msg.Data)
+	})
+	if err != nil {
+		return fmt.Errorf("failed to subscribe to %s: %w", t.subject, err)
+	}
+	t.sub = sub
+	return nil
+}
+
+// Close drains the subscription and closes the connection
+func (t *NATSTransport) Close() error {
+	if t.sub != nil {
+		_ = t.sub.Unsubscribe()
+	}
+	return t.conn.Drain()
+}
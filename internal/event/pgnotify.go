@@ -0,0 +1,128 @@
+package event
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// ErrNotifyPayloadTooLarge is returned when an encoded event does not fit in
+// a Postgres NOTIFY payload
+var ErrNotifyPayloadTooLarge = errors.New("event too large for NOTIFY payload")
+
+// PGNotifyTransport relays events between processes with Postgres
+// LISTEN/NOTIFY, so any process with database access can take part without
+// extra infrastructure. Notifications are not stored: a process that is not
+// listening when an event is sent never sees it.
+type PGNotifyTransport struct {
+	pool    *pgxpool.Pool
+	channel string
+
+	mu     sync.Mutex
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// NewPGNotifyTransport creates a transport that sends on channel through pool
+func NewPGNotifyTransport(pool *pgxpool.Pool, channel string) *PGNotifyTransport {
+	return &PGNotifyTransport{pool: pool, channel: channel}
+}
+
+// Publish sends data as a notification on the channel
+func (t *PGNotifyTransport) Publish(ctx context.Context, data []byte) error {
+	if len(data) >= PGNotifyMaxPayload {
+		return fmt.Errorf("%w: %d bytes", ErrNotifyPayloadTooLarge, len(data))
+	}
+	if _, err := t.pool.Exec(ctx, "SELECT pg_notify($1, $2)", t.channel, string(data)); err != nil {
+		return fmt.Errorf("failed to notify %s: %w", t.channel, err)
+	}
+	return nil
+}
+
+// Listen opens a dedicated connection that LISTENs on the channel and passes
+// every notification to handler. The first connection must succeed; after
+// that a lost connection is re-established in the background.
+func (t *PGNotifyTransport) Listen(handler func(data []byte)) error {
+	ctx, cancel := context.WithCancel(context.Background())
+	conn, err := t.connect(ctx)
+	if err != nil {
+		cancel()
+		return err
+	}
+
+	t.mu.Lock()
+	t.cancel = cancel
+	t.mu.Unlock()
+
+	t.wg.Add(1)
+	go func() {
+		defer t.wg.Done()
+		t.listenLoop(ctx, conn, handler)
+	}()
+	return nil
+}
+
+// Close stops listening. The pool belongs to the caller and stays open.
+func (t *PGNotifyTransport) Close() error {
+	t.mu.Lock()
+	cancel := t.cancel
+	t.mu.Unlock()
+	if cancel != nil {
+		cancel()
+	}
+	t.wg.Wait()
+	return nil
+}
+
+// connect opens a connection outside the pool, since a LISTEN lasts as long
+// as the connection
+func (t *PGNotifyTransport) connect(ctx context.Context) (*pgx.Conn, error) {
+	conn, err := pgx.ConnectConfig(ctx, t.pool.Config().ConnConfig.Copy())
+	if err != nil {
+		return nil, fmt.Errorf("failed to open listen connection: %w", err)
+	}
+	if _, err := conn.Exec(ctx, "LISTEN "+pgx.Identifier{t.channel}.Sanitize()); err != nil {
+		_ = conn.Close(ctx)
+		return nil, fmt.Errorf("failed to listen on %s: %w", t.channel, err)
+	}
+	return conn, nil
+}
+
+func (t *PGNotifyTransport) listenLoop(ctx context.Context, conn *pgx.Conn, handler func(data []byte)) {
+	backoff := PGNotifyInitialBackoff
+	for {
+		for conn == nil {
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(backoff):
+			}
+			var err error
+			if conn, err = t.connect(ctx); err != nil {
+				slog.Warn(LogMsgPGNotifyReconnectFailed, "channel", t.channel, "error", err)
+				backoff = min(backoff*2, PGNotifyMaxBackoff)
+				continue
+			}
+			slog.Info(LogMsgPGNotifyReconnected, "channel", t.channel)
+			backoff = PGNotifyInitialBackoff
+		}
+
+		notification, err := conn.WaitForNotification(ctx)
+		if err != nil {
+			_ = conn.Close(context.Background())
+			conn = nil
+			if ctx.Err() != nil {
+				return
+			}
+			slog.Warn(LogMsgPGNotifyDisconnected, "channel", t.channel, "error", err)
+			continue
+		}
+		handler([]byte(notification.Payload))
+	}
+}
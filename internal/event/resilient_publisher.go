@@ -52,6 +52,8 @@ func NewResilientPublisher(bus Bus, maxRetries int, retryDelay time.Duration, de
 // PublishWithRetry attempts to publish an event, queues for retry on failure
 // This method never blocks or returns errors to ensure XP awards always succeed
 func (rp *ResilientPublisher) PublishWithRetry(ctx context.Context, event Event) {
+	// Stamp the community now so retries, which run without ctx, stay in it
+	ctx, event = inCommunity(ctx, event)
 	if err := rp.bus.Publish(ctx, event); err != nil {
 		log := logger.FromContext(ctx)
 		log.Warn(LogMsgEventPublishFailed,
@@ -0,0 +1,15 @@
+package eventdlq
+
+// Query defaults
+const (
+	DefaultListLimit = 50
+	MaxListLimit     = 500
+)
+
+// Log messages
+const (
+	LogMsgEntryRecorded = "Event handler failure stored in dead-letter queue"
+	LogMsgRedriveFailed = "Dead-letter re-drive failed"
+	LogMsgRedriven      = "Dead-letter entry re-driven"
+	LogMsgDiscarded     = "Dead-letter entry discarded"
+)
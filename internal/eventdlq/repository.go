@@ -0,0 +1,52 @@
+package eventdlq
+
+import (
+	"context"
+	"time"
+
+	"github.com/osse101/BrandishBot_Go/internal/event"
+)
+
+// Entry statuses
+const (
+	StatusPending   = "pending"
+	StatusRedriven  = "redriven"
+	StatusDiscarded = "discarded"
+)
+
+// Entry is a handler failure stored in the dead-letter queue
+type Entry struct {
+	ID          int64       `json:"id"`
+	EventType   string      `json:"event_type"`
+	HandlerName string      `json:"handler_name"`
+	Event       event.Event `json:"event"`
+	Attempts    int         `json:"attempts"`
+	LastError   string      `json:"last_error,omitempty"`
+	Status      string      `json:"status"`
+	CreatedAt   time.Time   `json:"created_at"`
+	UpdatedAt   time.Time   `json:"updated_at"`
+}
+
+// Filter filters dead-letter entries for queries
+type Filter struct {
+	Status *string
+	Limit  int
+}
+
+// Repository defines the interface for dead-letter storage
+type Repository interface {
+	// Insert stores a new pending entry and returns its ID
+	Insert(ctx context.Context, entry Entry) (int64, error)
+
+	// Get retrieves an entry by ID
+	Get(ctx context.Context, id int64) (*Entry, error)
+
+	// List retrieves entries matching the filter, newest first
+	List(ctx context.Context, filter Filter) ([]Entry, error)
+
+	// UpdateStatus changes the status of an entry
+	UpdateStatus(ctx context.Context, id int64, status string) error
+
+	// RecordAttempt increments the attempt count after a failed re-drive
+	RecordAttempt(ctx context.Context, id int64, lastError string) error
+}
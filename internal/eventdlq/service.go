@@ -0,0 +1,123 @@
+package eventdlq
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/osse101/BrandishBot_Go/internal/event"
+	"github.com/osse101/BrandishBot_Go/internal/logger"
+)
+
+var (
+	// ErrEntryNotFound is returned when a dead-letter entry does not exist
+	ErrEntryNotFound = errors.New("dead-letter entry not found")
+	// ErrEntryNotPending is returned when re-driving or discarding a resolved entry
+	ErrEntryNotPending = errors.New("dead-letter entry is not pending")
+)
+
+// Service manages the dead-letter queue for failing event handlers
+type Service interface {
+	// RecordHandlerFailure stores a handler that exhausted its retries
+	RecordHandlerFailure(ctx context.Context, failure event.HandlerFailure) error
+
+	// List retrieves dead-letter entries
+	List(ctx context.Context, filter Filter) ([]Entry, error)
+
+	// Redrive re-runs the failed handler for a pending entry. On success the
+	// entry is marked redriven; on failure the attempt is recorded and the
+	// handler error is returned.
+	Redrive(ctx context.Context, id int64) (*Entry, error)
+
+	// Discard marks a pending entry as discarded without re-running it
+	Discard(ctx context.Context, id int64) error
+}
+
+type service struct {
+	repo     Repository
+	redriver event.HandlerRedriver
+}
+
+// NewService creates a new dead-letter queue service
+func NewService(repo Repository, redriver event.HandlerRedriver) Service {
+	return &service{repo: repo, redriver: redriver}
+}
+
+// RecordHandlerFailure stores a handler that exhausted its retries
+func (s *service) RecordHandlerFailure(ctx context.Context, failure event.HandlerFailure) error {
+	id, err := s.repo.Insert(ctx, Entry{
+		EventType:   string(failure.Event.Type),
+		HandlerName: failure.Handler,
+		Event:       failure.Event,
+		Attempts:    failure.Attempts,
+		LastError:   failure.LastError,
+		Status:      StatusPending,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to store dead-letter entry: %w", err)
+	}
+	logger.FromContext(ctx).Warn(LogMsgEntryRecorded, "id", id, "handler", failure.Handler, "event_type", failure.Event.Type)
+	return nil
+}
+
+// List retrieves dead-letter entries
+func (s *service) List(ctx context.Context, filter Filter) ([]Entry, error) {
+	if filter.Limit <= 0 {
+		filter.Limit = DefaultListLimit
+	}
+	if filter.Limit > MaxListLimit {
+		filter.Limit = MaxListLimit
+	}
+	return s.repo.List(ctx, filter)
+}
+
+// Redrive re-runs the failed handler for a pending entry
+func (s *service) Redrive(ctx context.Context, id int64) (*Entry, error) {
+	log := logger.FromContext(ctx)
+
+	entry, err := s.getPending(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if redriveErr := s.redriver.Redrive(ctx, entry.HandlerName, entry.Event); redriveErr != nil {
+		log.Warn(LogMsgRedriveFailed, "id", id, "handler", entry.HandlerName, "error", redriveErr)
+		if err := s.repo.RecordAttempt(ctx, id, redriveErr.Error()); err != nil {
+			return nil, fmt.Errorf("failed to record re-drive attempt: %w", err)
+		}
+		return nil, redriveErr
+	}
+
+	if err := s.repo.UpdateStatus(ctx, id, StatusRedriven); err != nil {
+		return nil, fmt.Errorf("failed to mark entry redriven: %w", err)
+	}
+	entry.Status = StatusRedriven
+	log.Info(LogMsgRedriven, "id", id, "handler", entry.HandlerName)
+	return entry, nil
+}
+
+// Discard marks a pending entry as discarded
+func (s *service) Discard(ctx context.Context, id int64) error {
+	if _, err := s.getPending(ctx, id); err != nil {
+		return err
+	}
+	if err := s.repo.UpdateStatus(ctx, id, StatusDiscarded); err != nil {
+		return fmt.Errorf("failed to discard entry: %w", err)
+	}
+	logger.FromContext(ctx).Info(LogMsgDiscarded, "id", id)
+	return nil
+}
+
+func (s *service) getPending(ctx context.Context, id int64) (*Entry, error) {
+	entry, err := s.repo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if entry == nil {
+		return nil, ErrEntryNotFound
+	}
+	if entry.Status != StatusPending {
+		return nil, ErrEntryNotPending
+	}
+	return entry, nil
+}
@@ -0,0 +1,141 @@
+package eventdlq_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/osse101/BrandishBot_Go/internal/event"
+	"github.com/osse101/BrandishBot_Go/internal/eventdlq"
+	"github.com/osse101/BrandishBot_Go/internal/eventdlq/mocks"
+)
+
+// fakeRedriver records re-drive calls and returns a preset error
+type fakeRedriver struct {
+	err     error
+	handler string
+	event   event.Event
+}
+
+func (f *fakeRedriver) Redrive(_ context.Context, handlerName string, evt event.Event) error {
+	f.handler = handlerName
+	f.event = evt
+	return f.err
+}
+
+func pendingEntry() *eventdlq.Entry {
+	return &eventdlq.Entry{
+		ID:          7,
+		EventType:   "item.sold",
+		HandlerName: "item.sold:progression.(*service).handleItemSold",
+		Event:       event.Event{Type: "item.sold", Payload: map[string]interface{}{"user_id": "u1"}},
+		Attempts:    3,
+		Status:      eventdlq.StatusPending,
+	}
+}
+
+func TestService_RecordHandlerFailure(t *testing.T) {
+	t.Parallel()
+
+	repo := mocks.NewMockRepository(t)
+	svc := eventdlq.NewService(repo, &fakeRedriver{})
+
+	repo.On("Insert", mock.Anything, mock.MatchedBy(func(e eventdlq.Entry) bool {
+		return e.EventType == "item.sold" && e.HandlerName == "h" && e.Attempts == 3 && e.LastError == "boom" && e.Status == eventdlq.StatusPending
+	})).Return(int64(1), nil)
+
+	err := svc.RecordHandlerFailure(context.Background(), event.HandlerFailure{
+		Handler:   "h",
+		Event:     event.Event{Type: "item.sold"},
+		Attempts:  3,
+		LastError: "boom",
+	})
+	assert.NoError(t, err)
+}
+
+func TestService_Redrive(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success marks entry redriven", func(t *testing.T) {
+		t.Parallel()
+		repo := mocks.NewMockRepository(t)
+		redriver := &fakeRedriver{}
+		svc := eventdlq.NewService(repo, redriver)
+
+		repo.On("Get", mock.Anything, int64(7)).Return(pendingEntry(), nil)
+		repo.On("UpdateStatus", mock.Anything, int64(7), eventdlq.StatusRedriven).Return(nil)
+
+		entry, err := svc.Redrive(context.Background(), 7)
+		require.NoError(t, err)
+		assert.Equal(t, eventdlq.StatusRedriven, entry.Status)
+		assert.Equal(t, pendingEntry().HandlerName, redriver.handler)
+		assert.Equal(t, event.Type("item.sold"), redriver.event.Type)
+	})
+
+	t.Run("handler failure records attempt", func(t *testing.T) {
+		t.Parallel()
+		repo := mocks.NewMockRepository(t)
+		svc := eventdlq.NewService(repo, &fakeRedriver{err: errors.New("still broken")})
+
+		repo.On("Get", mock.Anything, int64(7)).Return(pendingEntry(), nil)
+		repo.On("RecordAttempt", mock.Anything, int64(7), "still broken").Return(nil)
+
+		_, err := svc.Redrive(context.Background(), 7)
+		assert.EqualError(t, err, "still broken")
+	})
+
+	t.Run("missing entry", func(t *testing.T) {
+		t.Parallel()
+		repo := mocks.NewMockRepository(t)
+		svc := eventdlq.NewService(repo, &fakeRedriver{})
+
+		repo.On("Get", mock.Anything, int64(7)).Return(nil, nil)
+
+		_, err := svc.Redrive(context.Background(), 7)
+		assert.ErrorIs(t, err, eventdlq.ErrEntryNotFound)
+	})
+
+	t.Run("already resolved entry", func(t *testing.T) {
+		t.Parallel()
+		repo := mocks.NewMockRepository(t)
+		svc := eventdlq.NewService(repo, &fakeRedriver{})
+
+		entry := pendingEntry()
+		entry.Status = eventdlq.StatusRedriven
+		repo.On("Get", mock.Anything, int64(7)).Return(entry, nil)
+
+		_, err := svc.Redrive(context.Background(), 7)
+		assert.ErrorIs(t, err, eventdlq.ErrEntryNotPending)
+	})
+}
+
+func TestService_Discard(t *testing.T) {
+	t.Parallel()
+
+	repo := mocks.NewMockRepository(t)
+	svc := eventdlq.NewService(repo, &fakeRedriver{})
+
+	repo.On("Get", mock.Anything, int64(7)).Return(pendingEntry(), nil)
+	repo.On("UpdateStatus", mock.Anything, int64(7), eventdlq.StatusDiscarded).Return(nil)
+
+	assert.NoError(t, svc.Discard(context.Background(), 7))
+}
+
+func TestService_ListClampsLimit(t *testing.T) {
+	t.Parallel()
+
+	repo := mocks.NewMockRepository(t)
+	svc := eventdlq.NewService(repo, &fakeRedriver{})
+
+	repo.On("List", mock.Anything, eventdlq.Filter{Limit: eventdlq.DefaultListLimit}).Return([]eventdlq.Entry{}, nil)
+	repo.On("List", mock.Anything, eventdlq.Filter{Limit: eventdlq.MaxListLimit}).Return([]eventdlq.Entry{}, nil)
+
+	_, err := svc.List(context.Background(), eventdlq.Filter{})
+	require.NoError(t, err)
+	_, err = svc.List(context.Background(), eventdlq.Filter{Limit: 10000})
+	require.NoError(t, err)
+}
@@ -22,6 +22,9 @@ const (
 	LogMsgEventLogged        = "Event logged to database"
 )
 
+// CleanupJobType identifies cleanup jobs in the worker pool
+const CleanupJobType = "eventlog_cleanup"
+
 // Log messages - cleanup job
 const (
 	LogMsgCleanupJobStarting  = "Starting event log cleanup job"
@@ -21,6 +21,11 @@ func NewCleanupJob(service Service, retentionDays int) *CleanupJob {
 	}
 }
 
+// JobType returns the worker pool job type
+func (j *CleanupJob) JobType() string {
+	return CleanupJobType
+}
+
 // Process executes the cleanup job
 func (j *CleanupJob) Process(ctx context.Context) error {
 	log := logger.FromContext(ctx)
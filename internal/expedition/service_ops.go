@@ -7,8 +7,10 @@ import (
 
 	"github.com/google/uuid"
 
+	"github.com/osse101/BrandishBot_Go/internal/cooldown"
 	"github.com/osse101/BrandishBot_Go/internal/domain"
 	"github.com/osse101/BrandishBot_Go/internal/event"
+	"github.com/osse101/BrandishBot_Go/internal/ids"
 )
 
 // StartExpedition creates a new expedition
@@ -37,7 +39,7 @@ func (s *service) StartExpedition(ctx context.Context, platform, platformID, use
 	initiatorID, _ := uuid.Parse(initiator.ID)
 	now := time.Now()
 	expedition := &domain.Expedition{
-		ID:                 uuid.New(),
+		ID:                 ids.New(),
 		InitiatorID:        initiatorID,
 		ExpeditionType:     expeditionType,
 		State:              domain.ExpeditionStateRecruiting,
@@ -96,8 +98,7 @@ func (s *service) checkConstraints(ctx context.Context, initiator *domain.User,
 	if last != nil && last.CompletedAt != nil {
 		cooldownEnd := last.CompletedAt.Add(10 * time.Minute)
 		if time.Now().Before(cooldownEnd) {
-			remaining := time.Until(cooldownEnd).Truncate(time.Second)
-			return fmt.Errorf("%w: expedition on global cooldown for %s", domain.ErrOnCooldown, remaining)
+			return cooldown.ErrOnCooldown{Action: "start an expedition", Remaining: time.Until(cooldownEnd)}
 		}
 	}
 
@@ -108,7 +109,7 @@ func (s *service) checkConstraints(ctx context.Context, initiator *domain.User,
 			return fmt.Errorf("failed to check cooldown: %w", err)
 		}
 		if onCooldown {
-			return fmt.Errorf("%w: %s", domain.ErrOnCooldown, remaining.Truncate(time.Second))
+			return cooldown.ErrOnCooldown{Action: "start an expedition", Remaining: remaining}
 		}
 	}
 
@@ -0,0 +1,22 @@
+package featureflag
+
+import "time"
+
+// Defaults
+const (
+	// DefaultCacheTTL is how long the flag snapshot is reused before the
+	// database is asked again. A change made on another instance takes up to
+	// this long to reach this one.
+	DefaultCacheTTL = 30 * time.Second
+	// FullRollout enables a flag for every user it applies to
+	FullRollout = 100
+	// MaxKeyLength caps flag keys
+	MaxKeyLength = 64
+)
+
+// Log messages
+const (
+	LogMsgFlagSet       = "Feature flag set"
+	LogMsgFlagDeleted   = "Feature flag deleted"
+	LogMsgRefreshFailed = "Failed to refresh feature flags, using previous snapshot"
+)
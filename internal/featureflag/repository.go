@@ -0,0 +1,22 @@
+package featureflag
+
+import (
+	"context"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+)
+
+// Repository stores feature flags by key
+type Repository interface {
+	// ListFlags returns every flag ordered by key
+	ListFlags(ctx context.Context) ([]domain.FeatureFlag, error)
+
+	// GetFlag returns the flag with the key, or nil if there is none
+	GetFlag(ctx context.Context, key string) (*domain.FeatureFlag, error)
+
+	// UpsertFlag creates or replaces a flag and returns it as stored
+	UpsertFlag(ctx context.Context, flag domain.FeatureFlag) (*domain.FeatureFlag, error)
+
+	// DeleteFlag removes a flag and returns false if there was none
+	DeleteFlag(ctx context.Context, key string) (bool, error)
+}
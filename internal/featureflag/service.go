@@ -0,0 +1,225 @@
+package featureflag
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"regexp"
+	"sync"
+	"time"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/logger"
+)
+
+// Service manages admin feature flags and decides whether one is on for a user
+type Service interface {
+	// ListFlags returns every flag ordered by key
+	ListFlags(ctx context.Context) ([]domain.FeatureFlag, error)
+
+	// GetFlag returns a flag. It returns domain.ErrFeatureFlagNotFound for
+	// unknown keys.
+	GetFlag(ctx context.Context, key string) (*domain.FeatureFlag, error)
+
+	// SetFlag creates or replaces a flag
+	SetFlag(ctx context.Context, req SetRequest) (*domain.FeatureFlag, error)
+
+	// DeleteFlag removes a flag, which turns it off everywhere. It returns
+	// domain.ErrFeatureFlagNotFound for unknown keys.
+	DeleteFlag(ctx context.Context, key string) error
+
+	// IsEnabled reports whether a flag is on for a platform user. Unknown
+	// flags are off, so code can check a flag before it has been created.
+	IsEnabled(ctx context.Context, key, platform, platformID string) bool
+}
+
+// SetRequest describes the whole state of a flag
+type SetRequest struct {
+	Key               string
+	Description       string
+	Enabled           bool
+	RolloutPercent    int
+	PlatformOverrides map[string]bool
+	UpdatedBy         string
+}
+
+// Config tunes feature flags
+type Config struct {
+	// CacheTTL is how long the flag snapshot is reused
+	CacheTTL time.Duration
+}
+
+var keyPattern = regexp.MustCompile(`^[a-z0-9_]+$`)
+
+var validPlatforms = map[string]bool{
+	domain.PlatformTwitch:  true,
+	domain.PlatformYoutube: true,
+	domain.PlatformDiscord: true,
+}
+
+type service struct {
+	repo Repository
+	cfg  Config
+	now  func() time.Time
+
+	mu       sync.Mutex
+	flags    map[string]domain.FeatureFlag
+	loadedAt time.Time
+}
+
+// NewService creates a feature flag service
+func NewService(repo Repository, cfg Config) Service {
+	if cfg.CacheTTL <= 0 {
+		cfg.CacheTTL = DefaultCacheTTL
+	}
+	return &service{
+		repo: repo,
+		cfg:  cfg,
+		now:  time.Now,
+	}
+}
+
+// ListFlags returns every flag straight from the repository
+func (s *service) ListFlags(ctx context.Context) ([]domain.FeatureFlag, error) {
+	return s.repo.ListFlags(ctx)
+}
+
+// GetFlag returns a flag straight from the repository
+func (s *service) GetFlag(ctx context.Context, key string) (*domain.FeatureFlag, error) {
+	flag, err := s.repo.GetFlag(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	if flag == nil {
+		return nil, domain.ErrFeatureFlagNotFound
+	}
+	return flag, nil
+}
+
+// SetFlag validates and stores a flag, then drops the snapshot so the change
+// applies on this instance straight away
+func (s *service) SetFlag(ctx context.Context, req SetRequest) (*domain.FeatureFlag, error) {
+	if err := validateSetRequest(req); err != nil {
+		return nil, err
+	}
+
+	overrides := req.PlatformOverrides
+	if overrides == nil {
+		overrides = map[string]bool{}
+	}
+	flag, err := s.repo.UpsertFlag(ctx, domain.FeatureFlag{
+		Key:               req.Key,
+		Description:       req.Description,
+		Enabled:           req.Enabled,
+		RolloutPercent:    req.RolloutPercent,
+		PlatformOverrides: overrides,
+		UpdatedBy:         req.UpdatedBy,
+	})
+	if err != nil {
+		return nil, err
+	}
+	s.invalidate()
+
+	logger.FromContext(ctx).Info(LogMsgFlagSet, "key", flag.Key, "enabled", flag.Enabled, "rollout_percent", flag.RolloutPercent, "platform_overrides", flag.PlatformOverrides, "updated_by", flag.UpdatedBy)
+	return flag, nil
+}
+
+// DeleteFlag removes a flag and drops the snapshot
+func (s *service) DeleteFlag(ctx context.Context, key string) error {
+	deleted, err := s.repo.DeleteFlag(ctx, key)
+	if err != nil {
+		return err
+	}
+	if !deleted {
+		return domain.ErrFeatureFlagNotFound
+	}
+	s.invalidate()
+
+	logger.FromContext(ctx).Info(LogMsgFlagDeleted, "key", key)
+	return nil
+}
+
+// IsEnabled checks the flag against the cached snapshot. If the snapshot
+// cannot be refreshed the previous one keeps being used; with no snapshot at
+// all every flag is off.
+func (s *service) IsEnabled(ctx context.Context, key, platform, platformID string) bool {
+	flag, ok := s.lookup(ctx, key)
+	if !ok {
+		return false
+	}
+	return evaluate(flag, platform, platformID)
+}
+
+func (s *service) lookup(ctx context.Context, key string) (domain.FeatureFlag, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	if s.flags == nil || now.Sub(s.loadedAt) >= s.cfg.CacheTTL {
+		flags, err := s.repo.ListFlags(ctx)
+		if err != nil {
+			logger.FromContext(ctx).Warn(LogMsgRefreshFailed, "error", err)
+		} else {
+			s.flags = make(map[string]domain.FeatureFlag, len(flags))
+			for _, flag := range flags {
+				s.flags[flag.Key] = flag
+			}
+			s.loadedAt = now
+		}
+	}
+
+	flag, ok := s.flags[key]
+	return flag, ok
+}
+
+func (s *service) invalidate() {
+	s.mu.Lock()
+	s.loadedAt = time.Time{}
+	s.mu.Unlock()
+}
+
+// evaluate applies the platform override, then the rollout. A user always
+// lands in the same bucket for a flag, so raising the percentage only adds
+// users. Requests without a user are only let through at full rollout.
+func evaluate(flag domain.FeatureFlag, platform, platformID string) bool {
+	enabled := flag.Enabled
+	if override, ok := flag.PlatformOverrides[platform]; ok {
+		enabled = override
+	}
+	if !enabled {
+		return false
+	}
+	if flag.RolloutPercent >= FullRollout {
+		return true
+	}
+	if platformID == "" {
+		return false
+	}
+	return rolloutBucket(flag.Key, platform, platformID) < flag.RolloutPercent
+}
+
+// rolloutBucket places a user in one of 100 buckets. The flag key is part of
+// the hash so each flag rolls out to a different set of users.
+func rolloutBucket(key, platform, platformID string) int {
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(key + ":" + platform + ":" + platformID))
+	return int(h.Sum32() % FullRollout)
+}
+
+func validateSetRequest(req SetRequest) error {
+	if req.Key == "" || len(req.Key) > MaxKeyLength || !keyPattern.MatchString(req.Key) {
+		return fmt.Errorf("%w: key must be 1-%d lowercase letters, digits or underscores", domain.ErrInvalidInput, MaxKeyLength)
+	}
+	if req.UpdatedBy == "" {
+		return fmt.Errorf("%w: updated_by is required", domain.ErrInvalidInput)
+	}
+	if req.RolloutPercent < 0 || req.RolloutPercent > FullRollout {
+		return fmt.Errorf("%w: rollout_percent must be between 0 and %d", domain.ErrInvalidInput, FullRollout)
+	}
+	for platform := range req.PlatformOverrides {
+		if !validPlatforms[platform] {
+			return fmt.Errorf("%w: unknown platform '%s' in platform_overrides", domain.ErrInvalidInput, platform)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,138 @@
+package featureflag_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/featureflag"
+	"github.com/osse101/BrandishBot_Go/internal/featureflag/mocks"
+)
+
+func TestSetFlag(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("stores a valid flag", func(t *testing.T) {
+		repo := mocks.NewMockRepository(t)
+		svc := featureflag.NewService(repo, featureflag.Config{})
+		repo.On("UpsertFlag", ctx, mock.Anything).Return(func(_ context.Context, flag domain.FeatureFlag) (*domain.FeatureFlag, error) {
+			return &flag, nil
+		})
+
+		flag, err := svc.SetFlag(ctx, featureflag.SetRequest{Key: "gamble_roulette", Enabled: true, RolloutPercent: 25, UpdatedBy: "admin"})
+
+		require.NoError(t, err)
+		assert.Equal(t, 25, flag.RolloutPercent)
+		assert.NotNil(t, flag.PlatformOverrides)
+	})
+
+	tests := []struct {
+		name string
+		req  featureflag.SetRequest
+	}{
+		{"bad key", featureflag.SetRequest{Key: "Gamble Roulette", UpdatedBy: "admin"}},
+		{"missing updater", featureflag.SetRequest{Key: "gamble_roulette"}},
+		{"rollout above 100", featureflag.SetRequest{Key: "gamble_roulette", RolloutPercent: 101, UpdatedBy: "admin"}},
+		{"unknown platform", featureflag.SetRequest{Key: "gamble_roulette", UpdatedBy: "admin", PlatformOverrides: map[string]bool{"myspace": true}}},
+	}
+	for _, tt := range tests {
+		t.Run("rejects "+tt.name, func(t *testing.T) {
+			svc := featureflag.NewService(mocks.NewMockRepository(t), featureflag.Config{})
+
+			_, err := svc.SetFlag(ctx, tt.req)
+
+			assert.ErrorIs(t, err, domain.ErrInvalidInput)
+		})
+	}
+}
+
+func TestDeleteFlag_Unknown(t *testing.T) {
+	ctx := context.Background()
+	repo := mocks.NewMockRepository(t)
+	svc := featureflag.NewService(repo, featureflag.Config{})
+	repo.On("DeleteFlag", ctx, "missing").Return(false, nil)
+
+	err := svc.DeleteFlag(ctx, "missing")
+
+	assert.ErrorIs(t, err, domain.ErrFeatureFlagNotFound)
+}
+
+func TestIsEnabled(t *testing.T) {
+	ctx := context.Background()
+
+	newService := func(t *testing.T, flags ...domain.FeatureFlag) featureflag.Service {
+		repo := mocks.NewMockRepository(t)
+		repo.On("ListFlags", mock.Anything).Return(flags, nil).Maybe()
+		return featureflag.NewService(repo, featureflag.Config{})
+	}
+
+	t.Run("unknown flags are off", func(t *testing.T) {
+		svc := newService(t)
+
+		assert.False(t, svc.IsEnabled(ctx, "missing", domain.PlatformTwitch, "u1"))
+	})
+
+	t.Run("platform override replaces the global switch", func(t *testing.T) {
+		svc := newService(t, domain.FeatureFlag{
+			Key:               "new_mode",
+			Enabled:           false,
+			RolloutPercent:    featureflag.FullRollout,
+			PlatformOverrides: map[string]bool{domain.PlatformDiscord: true},
+		}, domain.FeatureFlag{
+			Key:               "old_mode",
+			Enabled:           true,
+			RolloutPercent:    featureflag.FullRollout,
+			PlatformOverrides: map[string]bool{domain.PlatformTwitch: false},
+		})
+
+		assert.True(t, svc.IsEnabled(ctx, "new_mode", domain.PlatformDiscord, "u1"))
+		assert.False(t, svc.IsEnabled(ctx, "new_mode", domain.PlatformTwitch, "u1"))
+		assert.False(t, svc.IsEnabled(ctx, "old_mode", domain.PlatformTwitch, "u1"))
+		assert.True(t, svc.IsEnabled(ctx, "old_mode", domain.PlatformYoutube, "u1"))
+	})
+
+	t.Run("rollout is stable and roughly proportional", func(t *testing.T) {
+		svc := newService(t, domain.FeatureFlag{Key: "new_mode", Enabled: true, RolloutPercent: 30})
+
+		enabled := 0
+		for i := 0; i < 1000; i++ {
+			id := fmt.Sprintf("user-%d", i)
+			first := svc.IsEnabled(ctx, "new_mode", domain.PlatformTwitch, id)
+			assert.Equal(t, first, svc.IsEnabled(ctx, "new_mode", domain.PlatformTwitch, id))
+			if first {
+				enabled++
+			}
+		}
+		assert.InDelta(t, 300, enabled, 60)
+		assert.False(t, svc.IsEnabled(ctx, "new_mode", domain.PlatformTwitch, ""), "anonymous requests need full rollout")
+	})
+
+	t.Run("no snapshot means everything is off", func(t *testing.T) {
+		repo := mocks.NewMockRepository(t)
+		repo.On("ListFlags", mock.Anything).Return(nil, errors.New("db down"))
+		svc := featureflag.NewService(repo, featureflag.Config{})
+
+		assert.False(t, svc.IsEnabled(ctx, "new_mode", domain.PlatformTwitch, "u1"))
+	})
+
+	t.Run("setting a flag refreshes the snapshot", func(t *testing.T) {
+		repo := mocks.NewMockRepository(t)
+		svc := featureflag.NewService(repo, featureflag.Config{})
+		repo.On("ListFlags", mock.Anything).Return(nil, nil).Once()
+		assert.False(t, svc.IsEnabled(ctx, "new_mode", domain.PlatformTwitch, "u1"))
+
+		flag := domain.FeatureFlag{Key: "new_mode", Enabled: true, RolloutPercent: featureflag.FullRollout}
+		repo.On("UpsertFlag", ctx, mock.Anything).Return(&flag, nil)
+		repo.On("ListFlags", mock.Anything).Return([]domain.FeatureFlag{flag}, nil).Once()
+		_, err := svc.SetFlag(ctx, featureflag.SetRequest{Key: "new_mode", Enabled: true, RolloutPercent: featureflag.FullRollout, UpdatedBy: "admin"})
+		require.NoError(t, err)
+
+		assert.True(t, svc.IsEnabled(ctx, "new_mode", domain.PlatformTwitch, "u1"))
+	})
+}
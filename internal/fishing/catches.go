@@ -0,0 +1,66 @@
+package fishing
+
+import (
+	"time"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/rng"
+)
+
+// Fish is a catch the pond holds
+type Fish struct {
+	ItemName string
+	Quality  domain.QualityLevel
+	Weight   int // Relative chance without tackle
+	XP       int // Fisher XP for landing it
+}
+
+// Catches lists every fish, most common first
+var Catches = []Fish{
+	{ItemName: "fish_minnow", Quality: domain.QualityCommon, Weight: 50, XP: 10},
+	{ItemName: "fish_trout", Quality: domain.QualityUncommon, Weight: 28, XP: 20},
+	{ItemName: "fish_salmon", Quality: domain.QualityRare, Weight: 14, XP: 40},
+	{ItemName: "fish_swordfish", Quality: domain.QualityEpic, Weight: 6, XP: 80},
+	{ItemName: "fish_golden_koi", Quality: domain.QualityLegendary, Weight: 2, XP: 200},
+}
+
+// Tackle is an item a cast uses up to improve its chances
+type Tackle struct {
+	RareBoost   float64       // Multiplies the weight of every fish above common
+	ExtraWindow time.Duration // Added to the reel window
+}
+
+// TackleItems maps each tackle item to its effect
+var TackleItems = map[string]Tackle{
+	"tackle_worm":   {RareBoost: 1.5},
+	"tackle_lure":   {RareBoost: 2.5},
+	"tackle_bobber": {ExtraWindow: 5 * time.Second},
+}
+
+// rollCatch picks a fish by weight, with the tackle's boost applied to every
+// fish above common
+func rollCatch(src rng.Source, tackle Tackle) Fish {
+	boost := tackle.RareBoost
+	if boost <= 0 {
+		boost = 1
+	}
+
+	weights := make([]float64, len(Catches))
+	total := 0.0
+	for i, fish := range Catches {
+		weights[i] = float64(fish.Weight)
+		if fish.Quality != domain.QualityCommon {
+			weights[i] *= boost
+		}
+		total += weights[i]
+	}
+
+	roll := src.Float64() * total
+	for i, weight := range weights {
+		if roll < weight {
+			return Catches[i]
+		}
+		roll -= weight
+	}
+	return Catches[len(Catches)-1]
+}
@@ -0,0 +1,28 @@
+package fishing
+
+import "time"
+
+// Bite timing. The fish bites a random delay after the cast, and the line
+// must be reeled in within the window after the bite.
+const (
+	MinBiteDelay = 5 * time.Second
+	MaxBiteDelay = 30 * time.Second
+	ReelWindow   = 8 * time.Second
+)
+
+// Error messages
+const (
+	ErrMsgCheckFeatureFailed = "failed to check fishing feature: %w"
+	ErrMsgGetTackleFailed    = "failed to look up tackle: %w"
+	ErrMsgCreateCastFailed   = "failed to cast: %w"
+	ErrMsgTakeCastFailed     = "failed to reel in: %w"
+	ErrMsgGrantCatchFailed   = "failed to grant catch: %w"
+	ErrMsgCatchItemMissing   = "catch item %s does not exist"
+)
+
+// Log messages
+const (
+	LogMsgCast                = "User cast a fishing line"
+	LogMsgReeled              = "User reeled in a fishing line"
+	LogWarnTackleRefundFailed = "Failed to refund tackle after a failed cast"
+)
@@ -0,0 +1,314 @@
+// Package fishing runs the fishing minigame. A cast puts the user's line in
+// the water; a few random seconds later a fish bites and a fishing.bite
+// event goes out, and the user has a short window to reel it in for a fish
+// of random rarity. Reeling in too early or too late loses the fish.
+package fishing
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/event"
+	"github.com/osse101/BrandishBot_Go/internal/logger"
+	"github.com/osse101/BrandishBot_Go/internal/progression"
+	"github.com/osse101/BrandishBot_Go/internal/repository"
+	"github.com/osse101/BrandishBot_Go/internal/rng"
+)
+
+// Service runs the fishing minigame
+type Service interface {
+	// Cast puts the user's line in the water, on the fish cooldown. A tackle
+	// item, when given, is used up by the cast. channelID is passed on with
+	// the bite so the platform can announce it where the cast was made.
+	Cast(ctx context.Context, platform, platformID, username, tackle, channelID string) (*domain.FishingCast, error)
+
+	// Reel reels in the user's line. Reeling in while the fish is biting
+	// lands it; too early or too late and it gets away.
+	Reel(ctx context.Context, platform, platformID, username string) (*domain.ReelResult, error)
+}
+
+// UserService finds the angler and moves tackle and catches in and out of
+// their inventory
+type UserService interface {
+	GetUserOrRegister(ctx context.Context, platform, platformID, username string) (*domain.User, error)
+	GetItemByName(ctx context.Context, name string) (*domain.Item, error)
+	RemoveItemByUsername(ctx context.Context, platform, username, itemName string, quantity int) (int, error)
+	AddItemByUsername(ctx context.Context, platform, username, itemName string, quantity int) error
+	GrantItemReward(ctx context.Context, user *domain.User, item *domain.Item, quantity int, qualityLevel domain.QualityLevel) error
+}
+
+// CooldownService enforces the per-user cast cooldown
+type CooldownService interface {
+	EnforceCooldown(ctx context.Context, userID, action string, fn func() error) error
+}
+
+// FeatureChecker reports whether fishing has been unlocked
+type FeatureChecker interface {
+	IsFeatureUnlocked(ctx context.Context, featureKey string) (bool, error)
+}
+
+// Publisher publishes bite and catch events
+type Publisher interface {
+	PublishWithRetry(ctx context.Context, evt event.Event)
+}
+
+// Option configures optional service dependencies
+type Option func(*service)
+
+// WithRNG draws bite delays and catches from the seeded RNG provider
+func WithRNG(provider *rng.Provider) Option {
+	return func(s *service) {
+		s.rng = provider
+	}
+}
+
+type service struct {
+	repo      repository.FishingRepository
+	users     UserService
+	cooldowns CooldownService
+	features  FeatureChecker
+	publisher Publisher
+	rng       *rng.Provider
+
+	mu    sync.Mutex
+	bites map[string]*time.Timer // Pending bite per user ID
+}
+
+// NewService creates a fishing service. Bites are timed in memory, so a cast
+// pending across a restart is never announced, though it can still be
+// reeled in on time.
+func NewService(repo repository.FishingRepository, users UserService, cooldowns CooldownService, features FeatureChecker, publisher Publisher, opts ...Option) Service {
+	s := &service{
+		repo:      repo,
+		users:     users,
+		cooldowns: cooldowns,
+		features:  features,
+		publisher: publisher,
+		bites:     make(map[string]*time.Timer),
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+func (s *service) Cast(ctx context.Context, platform, platformID, username, tackle, channelID string) (*domain.FishingCast, error) {
+	if err := s.checkFeature(ctx); err != nil {
+		return nil, err
+	}
+
+	var gear Tackle
+	if tackle != "" {
+		var err error
+		if tackle, gear, err = s.resolveTackle(ctx, tackle); err != nil {
+			return nil, err
+		}
+	}
+
+	user, err := s.users.GetUserOrRegister(ctx, platform, platformID, username)
+	if err != nil {
+		return nil, err
+	}
+
+	var cast *domain.FishingCast
+	err = s.cooldowns.EnforceCooldown(ctx, user.ID, domain.ActionFish, func() error {
+		var castErr error
+		cast, castErr = s.cast(ctx, platform, user, tackle, gear)
+		return castErr
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.scheduleBite(ctx, cast, platform, platformID, channelID)
+	return cast, nil
+}
+
+// cast uses up the tackle and stores the cast, giving the tackle back when
+// the cast can't be stored
+func (s *service) cast(ctx context.Context, platform string, user *domain.User, tackle string, gear Tackle) (*domain.FishingCast, error) {
+	src := s.source(ctx)
+	spread := int((MaxBiteDelay - MinBiteDelay) / time.Millisecond)
+	delay := MinBiteDelay + time.Duration(src.Intn(spread+1))*time.Millisecond
+
+	now := time.Now()
+	cast := &domain.FishingCast{
+		UserID:   user.ID,
+		Username: user.Username,
+		Tackle:   tackle,
+		CastAt:   now,
+		BiteAt:   now.Add(delay),
+		ReelBy:   now.Add(delay + ReelWindow + gear.ExtraWindow),
+	}
+
+	if tackle != "" {
+		if _, err := s.users.RemoveItemByUsername(ctx, platform, user.Username, tackle, 1); err != nil {
+			return nil, err
+		}
+	}
+
+	created, err := s.repo.CreateCast(ctx, cast)
+	if err == nil && !created {
+		err = domain.ErrAlreadyFishing
+	}
+	if err != nil {
+		if tackle != "" {
+			if refundErr := s.users.AddItemByUsername(ctx, platform, user.Username, tackle, 1); refundErr != nil {
+				logger.FromContext(ctx).Warn(LogWarnTackleRefundFailed, "user_id", user.ID, "tackle", tackle, "error", refundErr)
+			}
+		}
+		if errors.Is(err, domain.ErrAlreadyFishing) {
+			return nil, err
+		}
+		return nil, fmt.Errorf(ErrMsgCreateCastFailed, err)
+	}
+
+	logger.FromContext(ctx).Info(LogMsgCast, "user_id", user.ID, "tackle", tackle, "bite_in", delay)
+	return cast, nil
+}
+
+func (s *service) Reel(ctx context.Context, platform, platformID, username string) (*domain.ReelResult, error) {
+	user, err := s.users.GetUserOrRegister(ctx, platform, platformID, username)
+	if err != nil {
+		return nil, err
+	}
+
+	cast, err := s.repo.TakeCast(ctx, user.ID)
+	if err != nil {
+		return nil, fmt.Errorf(ErrMsgTakeCastFailed, err)
+	}
+	if cast == nil {
+		return nil, domain.ErrNoFishingCast
+	}
+	s.cancelBite(user.ID)
+
+	now := time.Now()
+	result := &domain.ReelResult{
+		UserID:     user.ID,
+		Username:   user.Username,
+		ReactionMs: now.Sub(cast.BiteAt).Milliseconds(),
+		Tackle:     cast.Tackle,
+	}
+
+	switch {
+	case now.Before(cast.BiteAt):
+		result.Outcome = domain.FishingOutcomeTooEarly
+	case now.After(cast.ReelBy):
+		result.Outcome = domain.FishingOutcomeGotAway
+	default:
+		catch, err := s.land(ctx, user, TackleItems[cast.Tackle])
+		if err != nil {
+			return nil, err
+		}
+		result.Outcome = domain.FishingOutcomeCaught
+		result.Catch = catch
+		if s.publisher != nil {
+			s.publisher.PublishWithRetry(ctx, event.NewFishCaughtEvent(result))
+		}
+	}
+
+	logger.FromContext(ctx).Info(LogMsgReeled, "user_id", user.ID, "outcome", result.Outcome, "reaction_ms", result.ReactionMs)
+	return result, nil
+}
+
+// land rolls a fish and puts it in the user's inventory at its rarity
+func (s *service) land(ctx context.Context, user *domain.User, gear Tackle) (*domain.FishCatch, error) {
+	fish := rollCatch(s.source(ctx), gear)
+
+	item, err := s.users.GetItemByName(ctx, fish.ItemName)
+	if err != nil {
+		return nil, fmt.Errorf(ErrMsgGrantCatchFailed, err)
+	}
+	if item == nil {
+		return nil, fmt.Errorf(ErrMsgGrantCatchFailed, fmt.Errorf(ErrMsgCatchItemMissing, fish.ItemName))
+	}
+	if err := s.users.GrantItemReward(ctx, user, item, 1, fish.Quality); err != nil {
+		return nil, fmt.Errorf(ErrMsgGrantCatchFailed, err)
+	}
+
+	return &domain.FishCatch{
+		ItemName:   item.InternalName,
+		PublicName: item.PublicName,
+		Quality:    fish.Quality,
+		XP:         fish.XP,
+	}, nil
+}
+
+func (s *service) checkFeature(ctx context.Context) error {
+	unlocked, err := s.features.IsFeatureUnlocked(ctx, progression.FeatureFishing)
+	if err != nil {
+		return fmt.Errorf(ErrMsgCheckFeatureFailed, err)
+	}
+	if !unlocked {
+		return fmt.Errorf("fishing requires feature unlock: %w", domain.ErrFeatureLocked)
+	}
+	return nil
+}
+
+// resolveTackle resolves a tackle name, internal or public, to its item and
+// effect
+func (s *service) resolveTackle(ctx context.Context, name string) (string, Tackle, error) {
+	item, err := s.users.GetItemByName(ctx, name)
+	if err != nil {
+		return "", Tackle{}, fmt.Errorf(ErrMsgGetTackleFailed, err)
+	}
+	if item != nil {
+		if gear, ok := TackleItems[item.InternalName]; ok {
+			return item.InternalName, gear, nil
+		}
+	}
+	return "", Tackle{}, fmt.Errorf("%w: %s", domain.ErrUnknownTackle, name)
+}
+
+// scheduleBite arms the timer that announces the bite. A newer cast by the
+// same user replaces the pending timer.
+func (s *service) scheduleBite(ctx context.Context, cast *domain.FishingCast, platform, platformID, channelID string) {
+	if s.publisher == nil {
+		return
+	}
+	evt := event.NewFishingBiteEvent(cast, platform, platformID, channelID)
+	asyncCtx := context.WithoutCancel(ctx) // Outlives the request, keeps its community
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if existing, ok := s.bites[cast.UserID]; ok {
+		existing.Stop()
+	}
+	var timer *time.Timer
+	timer = time.AfterFunc(cast.BiteAt.Sub(time.Now()), func() {
+		s.mu.Lock()
+		if s.bites[cast.UserID] != timer {
+			s.mu.Unlock()
+			return
+		}
+		delete(s.bites, cast.UserID)
+		s.mu.Unlock()
+
+		s.publisher.PublishWithRetry(asyncCtx, evt)
+	})
+	s.bites[cast.UserID] = timer
+}
+
+// cancelBite stops a bite that hasn't been announced yet
+func (s *service) cancelBite(userID string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if timer, ok := s.bites[userID]; ok {
+		timer.Stop()
+		delete(s.bites, userID)
+	}
+}
+
+func (s *service) source(ctx context.Context) rng.Source {
+	if s.rng != nil {
+		return s.rng.ForOperation(ctx, rng.OpFish)
+	}
+	return rand.New(rand.NewSource(time.Now().UnixNano())) //nolint:gosec // Game logic randomness, not security critical
+}
@@ -0,0 +1,225 @@
+package fishing_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/event"
+	"github.com/osse101/BrandishBot_Go/internal/fishing"
+	fishingmocks "github.com/osse101/BrandishBot_Go/internal/fishing/mocks"
+	"github.com/osse101/BrandishBot_Go/internal/progression"
+	"github.com/osse101/BrandishBot_Go/mocks"
+)
+
+const anglerID = "angler-1"
+
+type fishFixture struct {
+	repo      *mocks.MockRepositoryFishingRepository
+	users     *fishingmocks.MockUserService
+	cooldowns *fishingmocks.MockCooldownService
+	features  *fishingmocks.MockFeatureChecker
+	publisher *fishingmocks.MockPublisher
+	svc       fishing.Service
+}
+
+func newFishFixture(t *testing.T) *fishFixture {
+	f := &fishFixture{
+		repo:      mocks.NewMockRepositoryFishingRepository(t),
+		users:     fishingmocks.NewMockUserService(t),
+		cooldowns: fishingmocks.NewMockCooldownService(t),
+		features:  fishingmocks.NewMockFeatureChecker(t),
+		publisher: fishingmocks.NewMockPublisher(t),
+	}
+	f.svc = fishing.NewService(f.repo, f.users, f.cooldowns, f.features, f.publisher)
+	return f
+}
+
+func (f *fishFixture) expectAngler(ctx context.Context) {
+	f.users.On("GetUserOrRegister", ctx, domain.PlatformDiscord, "d-1", "angler").Return(&domain.User{ID: anglerID, Username: "angler"}, nil)
+}
+
+// expectCast lets a cast through the feature gate and cooldown. The bite
+// itself fires long after the test is done, if at all.
+func (f *fishFixture) expectCast(ctx context.Context) {
+	f.features.On("IsFeatureUnlocked", ctx, progression.FeatureFishing).Return(true, nil)
+	f.expectAngler(ctx)
+	f.cooldowns.On("EnforceCooldown", ctx, anglerID, domain.ActionFish, mock.Anything).Return(
+		func(_ context.Context, _, _ string, fn func() error) error { return fn() })
+	f.publisher.On("PublishWithRetry", mock.Anything, mock.Anything).Return().Maybe()
+}
+
+func TestCast(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("puts the line in the water", func(t *testing.T) {
+		f := newFishFixture(t)
+		f.expectCast(ctx)
+		f.repo.On("CreateCast", ctx, mock.AnythingOfType("*domain.FishingCast")).Return(true, nil)
+
+		before := time.Now()
+		cast, err := f.svc.Cast(ctx, domain.PlatformDiscord, "d-1", "angler", "", "chan-1")
+
+		require.NoError(t, err)
+		assert.Equal(t, anglerID, cast.UserID)
+		assert.Empty(t, cast.Tackle)
+		assert.False(t, cast.BiteAt.Before(before.Add(fishing.MinBiteDelay)))
+		assert.False(t, cast.BiteAt.After(time.Now().Add(fishing.MaxBiteDelay)))
+		assert.Equal(t, fishing.ReelWindow, cast.ReelBy.Sub(cast.BiteAt))
+	})
+
+	t.Run("tackle is used up and can widen the window", func(t *testing.T) {
+		f := newFishFixture(t)
+		f.users.On("GetItemByName", ctx, "bobber").Return(&domain.Item{InternalName: "tackle_bobber", PublicName: "bobber"}, nil)
+		f.expectCast(ctx)
+		f.users.On("RemoveItemByUsername", ctx, domain.PlatformDiscord, "angler", "tackle_bobber", 1).Return(1, nil)
+		f.repo.On("CreateCast", ctx, mock.AnythingOfType("*domain.FishingCast")).Return(true, nil)
+
+		cast, err := f.svc.Cast(ctx, domain.PlatformDiscord, "d-1", "angler", "bobber", "")
+
+		require.NoError(t, err)
+		assert.Equal(t, "tackle_bobber", cast.Tackle)
+		assert.Equal(t, fishing.ReelWindow+5*time.Second, cast.ReelBy.Sub(cast.BiteAt))
+	})
+
+	t.Run("a second line refunds the tackle", func(t *testing.T) {
+		f := newFishFixture(t)
+		f.users.On("GetItemByName", ctx, "tackle_worm").Return(&domain.Item{InternalName: "tackle_worm"}, nil)
+		f.expectCast(ctx)
+		f.users.On("RemoveItemByUsername", ctx, domain.PlatformDiscord, "angler", "tackle_worm", 1).Return(1, nil)
+		f.repo.On("CreateCast", ctx, mock.AnythingOfType("*domain.FishingCast")).Return(false, nil)
+		f.users.On("AddItemByUsername", ctx, domain.PlatformDiscord, "angler", "tackle_worm", 1).Return(nil)
+
+		_, err := f.svc.Cast(ctx, domain.PlatformDiscord, "d-1", "angler", "tackle_worm", "")
+
+		assert.ErrorIs(t, err, domain.ErrAlreadyFishing)
+	})
+
+	t.Run("missing tackle stops the cast", func(t *testing.T) {
+		f := newFishFixture(t)
+		f.users.On("GetItemByName", ctx, "tackle_lure").Return(&domain.Item{InternalName: "tackle_lure"}, nil)
+		f.expectCast(ctx)
+		f.users.On("RemoveItemByUsername", ctx, domain.PlatformDiscord, "angler", "tackle_lure", 1).Return(0, domain.ErrNotInInventory)
+
+		_, err := f.svc.Cast(ctx, domain.PlatformDiscord, "d-1", "angler", "tackle_lure", "")
+
+		assert.ErrorIs(t, err, domain.ErrNotInInventory)
+	})
+
+	t.Run("only tackle items can be used", func(t *testing.T) {
+		f := newFishFixture(t)
+		f.features.On("IsFeatureUnlocked", ctx, progression.FeatureFishing).Return(true, nil)
+		f.users.On("GetItemByName", ctx, "money").Return(&domain.Item{InternalName: "money"}, nil)
+
+		_, err := f.svc.Cast(ctx, domain.PlatformDiscord, "d-1", "angler", "money", "")
+
+		assert.ErrorIs(t, err, domain.ErrUnknownTackle)
+	})
+
+	t.Run("locked until fishing is unlocked", func(t *testing.T) {
+		f := newFishFixture(t)
+		f.features.On("IsFeatureUnlocked", ctx, progression.FeatureFishing).Return(false, nil)
+
+		_, err := f.svc.Cast(ctx, domain.PlatformDiscord, "d-1", "angler", "", "")
+
+		assert.ErrorIs(t, err, domain.ErrFeatureLocked)
+	})
+}
+
+func TestReel(t *testing.T) {
+	ctx := context.Background()
+
+	castBitingAt := func(biteAt time.Time, tackle string) *domain.FishingCast {
+		return &domain.FishingCast{
+			UserID:   anglerID,
+			Username: "angler",
+			Tackle:   tackle,
+			CastAt:   biteAt.Add(-10 * time.Second),
+			BiteAt:   biteAt,
+			ReelBy:   biteAt.Add(fishing.ReelWindow),
+		}
+	}
+
+	t.Run("lands the fish while it bites", func(t *testing.T) {
+		f := newFishFixture(t)
+		f.expectAngler(ctx)
+		f.repo.On("TakeCast", ctx, anglerID).Return(castBitingAt(time.Now().Add(-2*time.Second), "tackle_lure"), nil)
+		f.users.On("GetItemByName", ctx, mock.AnythingOfType("string")).Return(func(_ context.Context, name string) (*domain.Item, error) {
+			return &domain.Item{InternalName: name, PublicName: name}, nil
+		})
+		f.users.On("GrantItemReward", ctx, mock.Anything, mock.Anything, 1, mock.AnythingOfType("domain.QualityLevel")).Return(nil)
+		f.publisher.On("PublishWithRetry", ctx, mock.MatchedBy(func(evt event.Event) bool {
+			payload, ok := evt.Payload.(event.FishCaughtPayloadV1)
+			return evt.Type == event.FishCaught && ok && payload.UserID == anglerID && payload.XP > 0 && payload.Tackle == "tackle_lure"
+		})).Return()
+
+		result, err := f.svc.Reel(ctx, domain.PlatformDiscord, "d-1", "angler")
+
+		require.NoError(t, err)
+		assert.Equal(t, domain.FishingOutcomeCaught, result.Outcome)
+		require.NotNil(t, result.Catch)
+		assert.Contains(t, fishNames(), result.Catch.ItemName)
+		assert.GreaterOrEqual(t, result.ReactionMs, int64(2000))
+	})
+
+	t.Run("too early scares the fish", func(t *testing.T) {
+		f := newFishFixture(t)
+		f.expectAngler(ctx)
+		f.repo.On("TakeCast", ctx, anglerID).Return(castBitingAt(time.Now().Add(5*time.Second), ""), nil)
+
+		result, err := f.svc.Reel(ctx, domain.PlatformDiscord, "d-1", "angler")
+
+		require.NoError(t, err)
+		assert.Equal(t, domain.FishingOutcomeTooEarly, result.Outcome)
+		assert.Nil(t, result.Catch)
+		assert.Negative(t, result.ReactionMs)
+	})
+
+	t.Run("too late and it got away", func(t *testing.T) {
+		f := newFishFixture(t)
+		f.expectAngler(ctx)
+		f.repo.On("TakeCast", ctx, anglerID).Return(castBitingAt(time.Now().Add(-time.Minute), ""), nil)
+
+		result, err := f.svc.Reel(ctx, domain.PlatformDiscord, "d-1", "angler")
+
+		require.NoError(t, err)
+		assert.Equal(t, domain.FishingOutcomeGotAway, result.Outcome)
+		assert.Nil(t, result.Catch)
+	})
+
+	t.Run("nothing to reel in", func(t *testing.T) {
+		f := newFishFixture(t)
+		f.expectAngler(ctx)
+		f.repo.On("TakeCast", ctx, anglerID).Return(nil, nil)
+
+		_, err := f.svc.Reel(ctx, domain.PlatformDiscord, "d-1", "angler")
+
+		assert.ErrorIs(t, err, domain.ErrNoFishingCast)
+	})
+
+	t.Run("a failed grant fails the reel", func(t *testing.T) {
+		f := newFishFixture(t)
+		f.expectAngler(ctx)
+		f.repo.On("TakeCast", ctx, anglerID).Return(castBitingAt(time.Now(), ""), nil)
+		f.users.On("GetItemByName", ctx, mock.AnythingOfType("string")).Return(&domain.Item{InternalName: "fish_minnow"}, nil)
+		f.users.On("GrantItemReward", ctx, mock.Anything, mock.Anything, 1, mock.Anything).Return(errors.New("db down"))
+
+		_, err := f.svc.Reel(ctx, domain.PlatformDiscord, "d-1", "angler")
+
+		assert.Error(t, err)
+	})
+}
+
+func fishNames() []string {
+	names := make([]string, 0, len(fishing.Catches))
+	for _, fish := range fishing.Catches {
+		names = append(names, fish.ItemName)
+	}
+	return names
+}
@@ -31,8 +31,9 @@ func (s *service) determineCriticalFailures(userValues map[string]int64, totalGa
 	return critFails
 }
 
-// determineGambleWinners returns the winner ID, highest score, and set of users who lost a tie-break
-func (s *service) determineGambleWinners(userValues map[string]int64) (string, int64, map[string]bool) {
+// determineGambleWinners returns the winner ID, highest score, and set of users who lost a tie-break.
+// Ties are broken with intn.
+func (s *service) determineGambleWinners(userValues map[string]int64, intn func(int) int) (string, int64, map[string]bool) {
 	var highestValue int64 = InitialHighestValue
 	var winners []string
 
@@ -53,7 +54,7 @@ func (s *service) determineGambleWinners(userValues map[string]int64) (string, i
 
 	if len(winners) > 1 {
 		sort.Strings(winners)
-		idx := s.rng(len(winners))
+		idx := intn(len(winners))
 		winnerID := winners[idx]
 		for _, uid := range winners {
 			if uid != winnerID {
@@ -19,6 +19,10 @@ const CriticalFailThreshold = 0.2
 // positive value will be higher.
 const InitialHighestValue = -1
 
+// DefaultMinParticipants is the fewest participants a gamble executes with;
+// a winner-takes-all pot needs someone to lose
+const DefaultMinParticipants = 2
+
 // ============================================================================
 // Lootbox Validation
 // ============================================================================
@@ -29,6 +33,13 @@ const LootboxPrefix = "lootbox"
 // LootboxPrefixLength is the length of the lootbox prefix for validation
 const LootboxPrefixLength = 7
 
+// ============================================================================
+// Side Bets
+// ============================================================================
+
+// MaxSideBetAmount caps a spectator's side bet, in money, to keep side bets small
+const MaxSideBetAmount = 500
+
 // ============================================================================
 // Progression Feature Keys
 // ============================================================================
@@ -57,6 +68,13 @@ const MetadataKeyIsWin = "is_win"
 // EventSchemaVersion is the version of the event schema used for gamble events
 const EventSchemaVersion = "1.0"
 
+// ============================================================================
+// Stale Gamble Recovery
+// ============================================================================
+
+// RecoveryJobType is the worker pool job type for stale gamble recovery
+const RecoveryJobType = "gamble_recovery"
+
 // ============================================================================
 // Log Messages
 // ============================================================================
@@ -66,6 +84,7 @@ const (
 	LogMsgStartGambleCalled   = "StartGamble called"
 	LogMsgJoinGambleCalled    = "JoinGamble called"
 	LogMsgExecuteGambleCalled = "ExecuteGamble called"
+	LogMsgPlaceSideBetCalled  = "PlaceSideBet called"
 )
 
 // Log context for gamble events
@@ -86,6 +105,9 @@ const (
 	LogMsgShuttingDownGambleService   = "Shutting down gamble service, waiting for async operations..."
 	LogMsgGambleServiceShutdownDone   = "Gamble service shutdown complete"
 	LogMsgGambleServiceShutdownForced = "Gamble service shutdown forced by context cancellation"
+	LogMsgInvalidWatcherPayload       = "Gamble watcher received invalid event payload"
+	LogMsgStaleGambleRecovered        = "Recovered stale gamble"
+	LogMsgStaleGambleRecoveryFailed   = "Failed to recover stale gamble"
 )
 
 // ============================================================================
@@ -109,6 +131,11 @@ const (
 	ErrContextFailedToCheckActive     = "failed to check active gamble"
 	ErrContextFailedToCreateGamble    = "failed to create gamble"
 	ErrContextFailedToAddInitiator    = "failed to add initiator as participant"
+	ErrContextFailedToGetStaleGambles = "failed to get stale gambles"
+	ErrContextFailedToCollectRake     = "failed to credit gamble rake"
+	ErrContextFailedToPaySideBet      = "failed to settle side bet"
+	ErrContextFailedToGetSideBets     = "failed to get side bets"
+	ErrContextFailedToSettleOverflow  = "failed to settle inventory overflow"
 )
 
 // Validation and state error messages
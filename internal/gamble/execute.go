@@ -10,6 +10,8 @@ import (
 	"github.com/osse101/BrandishBot_Go/internal/domain"
 	"github.com/osse101/BrandishBot_Go/internal/logger"
 	"github.com/osse101/BrandishBot_Go/internal/repository"
+	"github.com/osse101/BrandishBot_Go/internal/rng"
+	"github.com/osse101/BrandishBot_Go/internal/utils"
 )
 
 // ExecuteGamble runs the gamble logic
@@ -44,9 +46,9 @@ func (s *service) ExecuteGamble(ctx context.Context, id uuid.UUID) (*domain.Gamb
 		return nil, err
 	}
 
-	// Minimum participant check (2+ required to gamble)
-	if len(gamble.Participants) < 2 {
-		log.Info("Gamble cancelled: not enough participants", "gambleID", id, "count", len(gamble.Participants))
+	// Minimum participant check, refunding gambles that fell short by the deadline
+	if len(gamble.Participants) < s.limits.MinParticipants {
+		log.Info("Gamble cancelled: not enough participants", "gambleID", id, "count", len(gamble.Participants), "min", s.limits.MinParticipants)
 		if err := s.refundGamble(ctx, tx, gamble); err != nil {
 			return nil, err
 		}
@@ -65,20 +67,37 @@ func (s *service) ExecuteGamble(ctx context.Context, id uuid.UUID) (*domain.Gamb
 		return nil, fmt.Errorf("failed to save opened items: %w", err)
 	}
 
-	winnerID, highestValue, tieBreakLostUsers := s.determineGambleWinners(userValues)
+	intn := s.rng
+	if s.rngProvider != nil {
+		intn = s.rngProvider.ForOperation(ctx, rng.OpGambleWinner).Intn
+	}
+	winnerID, highestValue, tieBreakLostUsers := s.determineGambleWinners(userValues, intn)
 	nearMissUsers := s.determineNearMisses(winnerID, highestValue, userValues)
 
+	sideBetPool, err := s.settleSideBets(ctx, tx, gamble, winnerID)
+	if err != nil {
+		return nil, err
+	}
+
+	var rake int
 	if winnerID != "" {
-		if err := s.awardItemsToWinner(ctx, tx, winnerID, allOpenedItems); err != nil {
+		var withheld map[int]int
+		rake, withheld, err = s.collectRake(ctx, tx, allOpenedItems)
+		if err != nil {
+			return nil, err
+		}
+		if err := s.awardItemsToWinner(ctx, tx, winnerID, allOpenedItems, withheld); err != nil {
 			return nil, err
 		}
 	}
 
 	result := &domain.GambleResult{
-		GambleID:   id,
-		WinnerID:   winnerID,
-		TotalValue: totalGambleValue,
-		Items:      allOpenedItems,
+		GambleID:    id,
+		WinnerID:    winnerID,
+		TotalValue:  totalGambleValue,
+		Rake:        rake,
+		SideBetPool: sideBetPool,
+		Items:       allOpenedItems,
 	}
 
 	if err := tx.CompleteGamble(ctx, result); err != nil {
@@ -118,6 +137,7 @@ func (s *service) refundGamble(ctx context.Context, tx repository.GambleTx, gamb
 			return fmt.Errorf("failed to get inventory for refund (user:%s): %w", p.UserID, err)
 		}
 
+		before := utils.BuildSlotMap(inv)
 		for _, bet := range p.LootboxBets {
 			// Resolve bet item name to ID
 			itemID, err := s.resolveLootboxBet(ctx, bet)
@@ -142,11 +162,19 @@ func (s *service) refundGamble(ctx context.Context, tx repository.GambleTx, gamb
 			}
 		}
 
+		if err := s.settleOverflow(ctx, before, inv); err != nil {
+			return err
+		}
+
 		if err := tx.UpdateInventory(ctx, p.UserID, *inv); err != nil {
 			return fmt.Errorf("failed to update inventory for refund (user:%s): %w", p.UserID, err)
 		}
 	}
 
+	if _, err := s.settleSideBets(ctx, tx, gamble, ""); err != nil {
+		return err
+	}
+
 	if err := tx.RefundGamble(ctx, gamble.ID); err != nil {
 		return fmt.Errorf("failed to mark gamble as refunded: %w", err)
 	}
@@ -164,6 +192,10 @@ func (s *service) openParticipantsLootboxes(ctx context.Context, gamble *domain.
 	itemNameCache := make(map[int]string)
 
 	for _, p := range gamble.Participants {
+		luck := 1.0
+		if s.effects != nil {
+			luck = s.effects.Multiplier(ctx, p.UserID, domain.EffectGambleLuck)
+		}
 		for _, bet := range p.LootboxBets {
 			// Resolve bet item name to ID to get lootbox item
 			itemID, err := s.resolveLootboxBet(ctx, bet)
@@ -176,7 +208,7 @@ func (s *service) openParticipantsLootboxes(ctx context.Context, gamble *domain.
 				continue
 			}
 
-			drops, err := s.lootboxSvc.OpenLootbox(ctx, lootboxItem.InternalName, bet.Quantity, bet.QualityLevel)
+			drops, err := s.lootboxSvc.OpenLootbox(ctx, p.UserID, lootboxItem.InternalName, bet.Quantity, bet.QualityLevel)
 			if err != nil {
 				continue
 			}
@@ -189,6 +221,9 @@ func (s *service) openParticipantsLootboxes(ctx context.Context, gamble *domain.
 						totalValue = int64(modifiedValue)
 					}
 				}
+				if luck != 1 {
+					totalValue = int64(float64(totalValue) * luck)
+				}
 
 				itemName := itemNameCache[drop.ItemID]
 				if itemName == "" {
@@ -10,6 +10,7 @@ import (
 
 	"github.com/osse101/BrandishBot_Go/internal/domain"
 	"github.com/osse101/BrandishBot_Go/internal/repository"
+	"github.com/osse101/BrandishBot_Go/internal/utils"
 )
 
 // consumeItem consumes an item from the inventory and returns its quality level
@@ -33,7 +34,7 @@ func consumeItem(inventory *domain.Inventory, itemID, quantity int) (domain.Qual
 	return domain.QualityLevel(""), domain.ErrItemNotFound
 }
 
-func (s *service) awardItemsToWinner(ctx context.Context, tx repository.GambleTx, winnerID string, allOpenedItems []domain.GambleOpenedItem) error {
+func (s *service) awardItemsToWinner(ctx context.Context, tx repository.GambleTx, winnerID string, allOpenedItems []domain.GambleOpenedItem, withheld map[int]int) error {
 	inv, err := tx.GetInventory(ctx, winnerID)
 	if err != nil {
 		return fmt.Errorf("%s: %w", ErrContextFailedToGetWinnerInv, err)
@@ -43,7 +44,14 @@ func (s *service) awardItemsToWinner(ctx context.Context, tx repository.GambleTx
 	for _, item := range allOpenedItems {
 		itemsToAdd[item.ItemID] += item.Quantity
 	}
+	for itemID, qty := range withheld {
+		itemsToAdd[itemID] -= qty
+		if itemsToAdd[itemID] <= 0 {
+			delete(itemsToAdd, itemID)
+		}
+	}
 
+	before := utils.BuildSlotMap(inv)
 	for i, slot := range inv.Slots {
 		if qty, ok := itemsToAdd[slot.ItemID]; ok {
 			inv.Slots[i].Quantity += qty
@@ -64,12 +72,59 @@ func (s *service) awardItemsToWinner(ctx context.Context, tx repository.GambleTx
 		inv.Slots = append(inv.Slots, domain.InventorySlot{ItemID: itemID, Quantity: itemsToAdd[itemID]})
 	}
 
+	if err := s.settleOverflow(ctx, before, inv); err != nil {
+		return err
+	}
+
 	if err := tx.UpdateInventory(ctx, winnerID, *inv); err != nil {
 		return fmt.Errorf("%s: %w", ErrContextFailedToUpdateWinnerInv, err)
 	}
 	return nil
 }
 
+// settleOverflow sells the slots opened since before that do not fit
+func (s *service) settleOverflow(ctx context.Context, before map[utils.SlotKey]int, inventory *domain.Inventory) error {
+	if s.overflow == nil {
+		return nil
+	}
+	if _, _, err := s.overflow.SettleInventoryOverflow(ctx, before, inventory); err != nil {
+		return fmt.Errorf("%s: %w", ErrContextFailedToSettleOverflow, err)
+	}
+	return nil
+}
+
+// collectRake credits the rake on the pot's money to the community pool and
+// returns it along with the quantities to withhold from the winner, keyed by
+// item ID. Pots without money are not raked.
+func (s *service) collectRake(ctx context.Context, tx repository.GambleTx, allOpenedItems []domain.GambleOpenedItem) (int, map[int]int, error) {
+	if s.rakePercent <= 0 {
+		return 0, nil, nil
+	}
+	moneyItem, err := s.repo.GetItemByName(ctx, domain.ItemMoney)
+	if err != nil {
+		return 0, nil, fmt.Errorf("%s: %w", ErrContextFailedToGetItem, err)
+	}
+	if moneyItem == nil {
+		return 0, nil, nil
+	}
+
+	money := 0
+	for _, item := range allOpenedItems {
+		if item.ItemID == moneyItem.ID {
+			money += item.Quantity
+		}
+	}
+	rake := money * s.rakePercent / 100
+	if rake <= 0 {
+		return 0, nil, nil
+	}
+
+	if err := tx.AddToCommunityPool(ctx, rake); err != nil {
+		return 0, nil, fmt.Errorf("%s: %w", ErrContextFailedToCollectRake, err)
+	}
+	return rake, map[int]int{moneyItem.ID: rake}, nil
+}
+
 // resolveItemName attempts to resolve a user-provided item name to its internal name.
 // It first tries the naming resolver, then falls back to using the input as-is.
 // This allows users to use either public names ("junkbox") or internal names ("lootbox_tier0").
@@ -97,42 +152,56 @@ func (s *service) resolveItemName(ctx context.Context, itemName string) (string,
 // resolveLootboxBet resolves a bet's item name to its item ID
 // Returns the resolved item ID or an error
 func (s *service) resolveLootboxBet(ctx context.Context, bet domain.LootboxBet) (int, error) {
+	item, err := s.resolveLootboxItem(ctx, bet)
+	if err != nil {
+		return 0, err
+	}
+	return item.ID, nil
+}
+
+// resolveLootboxItem resolves a bet's item name to its lootbox item
+func (s *service) resolveLootboxItem(ctx context.Context, bet domain.LootboxBet) (*domain.Item, error) {
 	// Resolve name to internal name
 	internalName, err := s.resolveItemName(ctx, bet.ItemName)
 	if err != nil {
-		return 0, fmt.Errorf("%s '%s': %w", ErrContextFailedToResolveItemName, bet.ItemName, err)
+		return nil, fmt.Errorf("%s '%s': %w", ErrContextFailedToResolveItemName, bet.ItemName, err)
 	}
 
 	// Get item by internal name to get ID
 	item, err := s.repo.GetItemByName(ctx, internalName)
 	if err != nil {
-		return 0, fmt.Errorf("%s: %w", ErrContextFailedToGetItem, err)
+		return nil, fmt.Errorf("%s: %w", ErrContextFailedToGetItem, err)
 	}
 	if item == nil {
-		return 0, fmt.Errorf("%w: %s", domain.ErrItemNotFound, internalName)
+		return nil, fmt.Errorf("%w: %s", domain.ErrItemNotFound, internalName)
 	}
 
 	// Validate it's a lootbox
 	if len(item.InternalName) < LootboxPrefixLength || item.InternalName[:LootboxPrefixLength] != LootboxPrefix {
-		return 0, fmt.Errorf("%w: %s (id:%d)", domain.ErrNotALootbox, item.InternalName, item.ID)
+		return nil, fmt.Errorf("%w: %s (id:%d)", domain.ErrNotALootbox, item.InternalName, item.ID)
 	}
 
-	return item.ID, nil
+	return item, nil
 }
 
 // validateGambleBets validates bets and resolves item names to IDs
 // Returns a slice of resolved item IDs corresponding to each bet
 func (s *service) validateGambleBets(ctx context.Context, bets []domain.LootboxBet) ([]int, error) {
 	resolvedItemIDs := make([]int, len(bets))
+	wager := 0
 	for i, bet := range bets {
 		if bet.Quantity > domain.MaxTransactionQuantity {
 			return nil, fmt.Errorf("%w: max is %d", domain.ErrQuantityTooHigh, domain.MaxTransactionQuantity)
 		}
-		itemID, err := s.resolveLootboxBet(ctx, bet)
+		item, err := s.resolveLootboxItem(ctx, bet)
 		if err != nil {
 			return nil, err
 		}
-		resolvedItemIDs[i] = itemID
+		resolvedItemIDs[i] = item.ID
+		wager += item.BaseValue * bet.Quantity
+	}
+	if s.limits.MaxWagerValue > 0 && wager > s.limits.MaxWagerValue {
+		return nil, fmt.Errorf("%w: wager worth %d, max is %d", domain.ErrWagerTooHigh, wager, s.limits.MaxWagerValue)
 	}
 	return resolvedItemIDs, nil
 }
@@ -41,6 +41,17 @@ func (s *service) JoinGamble(ctx context.Context, gambleID uuid.UUID, platform,
 		return err
 	}
 
+	if s.limits.MaxParticipants > 0 && len(gamble.Participants) >= s.limits.MaxParticipants {
+		return fmt.Errorf("%w: max is %d", domain.ErrGambleFull, s.limits.MaxParticipants)
+	}
+
+	// Spectators who side-bet cannot also play
+	for _, bet := range gamble.SideBets {
+		if bet.UserID == user.ID {
+			return domain.ErrSideBettorCannotJoin
+		}
+	}
+
 	// Get initiator's bets to use for this joiner
 	var initiatorBets []domain.LootboxBet
 	for _, p := range gamble.Participants {
@@ -87,12 +87,13 @@ func TestExecuteGamble_NoGoroutineLeak(t *testing.T) {
 	repo.On("GetGamble", mock.Anything, gambleID).Return(gamble, nil)
 	repo.On("BeginGambleTx", mock.Anything).Return(tx, nil)
 	tx.On("UpdateGambleStateIfMatches", mock.Anything, gambleID, domain.GambleStateJoining, domain.GambleStateOpening).Return(int64(1), nil)
+	tx.On("GetSideBets", mock.Anything, gambleID).Return(nil, nil)
 	tx.On("SaveOpenedItems", mock.Anything, mock.Anything).Return(nil)
 	tx.On("CompleteGamble", mock.Anything, mock.Anything).Return(nil)
 	tx.On("Commit", mock.Anything).Return(nil)
 	tx.On("Rollback", mock.Anything).Return(nil)
 
-	lootboxSvc.On("OpenLootbox", mock.Anything, mock.Anything, mock.Anything).Return([]lootbox.DroppedItem{}, nil).Maybe()
+	lootboxSvc.On("OpenLootbox", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return([]lootbox.DroppedItem{}, nil).Maybe()
 
 	// Expect completion event
 	publisher.On("PublishWithRetry", mock.Anything, mock.Anything).Return()
@@ -11,6 +11,8 @@ import (
 
 	repository "github.com/osse101/BrandishBot_Go/internal/repository"
 
+	time "time"
+
 	uuid "github.com/google/uuid"
 )
 
@@ -473,6 +475,65 @@ func (_c *MockRepository_GetItemByName_Call) RunAndReturn(run func(context.Conte
 	return _c
 }
 
+// GetStaleGambles provides a mock function with given fields: ctx, deadlineBefore
+func (_m *MockRepository) GetStaleGambles(ctx context.Context, deadlineBefore time.Time) ([]domain.Gamble, error) {
+	ret := _m.Called(ctx, deadlineBefore)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetStaleGambles")
+	}
+
+	var r0 []domain.Gamble
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) ([]domain.Gamble, error)); ok {
+		return rf(ctx, deadlineBefore)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) []domain.Gamble); ok {
+		r0 = rf(ctx, deadlineBefore)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Gamble)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = rf(ctx, deadlineBefore)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRepository_GetStaleGambles_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetStaleGambles'
+type MockRepository_GetStaleGambles_Call struct {
+	*mock.Call
+}
+
+// GetStaleGambles is a helper method to define mock.On call
+//   - ctx context.Context
+//   - deadlineBefore time.Time
+func (_e *MockRepository_Expecter) GetStaleGambles(ctx interface{}, deadlineBefore interface{}) *MockRepository_GetStaleGambles_Call {
+	return &MockRepository_GetStaleGambles_Call{Call: _e.mock.On("GetStaleGambles", ctx, deadlineBefore)}
+}
+
+func (_c *MockRepository_GetStaleGambles_Call) Run(run func(ctx context.Context, deadlineBefore time.Time)) *MockRepository_GetStaleGambles_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockRepository_GetStaleGambles_Call) Return(_a0 []domain.Gamble, _a1 error) *MockRepository_GetStaleGambles_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRepository_GetStaleGambles_Call) RunAndReturn(run func(context.Context, time.Time) ([]domain.Gamble, error)) *MockRepository_GetStaleGambles_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetUserByPlatformID provides a mock function with given fields: ctx, platform, platformID
 func (_m *MockRepository) GetUserByPlatformID(ctx context.Context, platform string, platformID string) (*domain.User, error) {
 	ret := _m.Called(ctx, platform, platformID)
@@ -2,6 +2,7 @@ package gamble
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/mock"
@@ -9,6 +10,7 @@ import (
 	"github.com/osse101/BrandishBot_Go/internal/domain"
 	"github.com/osse101/BrandishBot_Go/internal/event"
 	"github.com/osse101/BrandishBot_Go/internal/lootbox"
+	"github.com/osse101/BrandishBot_Go/internal/naming"
 	"github.com/osse101/BrandishBot_Go/internal/repository"
 )
 
@@ -77,6 +79,14 @@ func (m *MockRepository) GetActiveGamble(ctx context.Context) (*domain.Gamble, e
 	return args.Get(0).(*domain.Gamble), args.Error(1)
 }
 
+func (m *MockRepository) GetStaleGambles(ctx context.Context, deadlineBefore time.Time) ([]domain.Gamble, error) {
+	args := m.Called(ctx, deadlineBefore)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]domain.Gamble), args.Error(1)
+}
+
 func (m *MockRepository) BeginGambleTx(ctx context.Context) (repository.GambleTx, error) {
 	args := m.Called(ctx)
 	if args.Get(0) == nil {
@@ -119,14 +129,19 @@ type MockLootboxService struct {
 	mock.Mock
 }
 
-func (m *MockLootboxService) OpenLootbox(ctx context.Context, lootboxName string, quantity int, boxQuality domain.QualityLevel) ([]lootbox.DroppedItem, error) {
-	args := m.Called(ctx, lootboxName, quantity, boxQuality)
+func (m *MockLootboxService) OpenLootbox(ctx context.Context, userID, lootboxName string, quantity int, boxQuality domain.QualityLevel) ([]lootbox.DroppedItem, error) {
+	args := m.Called(ctx, userID, lootboxName, quantity, boxQuality)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
 	}
 	return args.Get(0).([]lootbox.DroppedItem), args.Error(1)
 }
 
+func (m *MockLootboxService) Reload(ctx context.Context) error {
+	args := m.Called(ctx)
+	return args.Error(0)
+}
+
 // MockTx
 type MockTx struct {
 	mock.Mock
@@ -175,6 +190,29 @@ func (m *MockTx) RefundGamble(ctx context.Context, id uuid.UUID) error {
 	return args.Error(0)
 }
 
+func (m *MockTx) PlaceSideBet(ctx context.Context, bet *domain.SideBet) error {
+	args := m.Called(ctx, bet)
+	return args.Error(0)
+}
+
+func (m *MockTx) GetSideBets(ctx context.Context, gambleID uuid.UUID) ([]domain.SideBet, error) {
+	args := m.Called(ctx, gambleID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]domain.SideBet), args.Error(1)
+}
+
+func (m *MockTx) SettleSideBet(ctx context.Context, gambleID uuid.UUID, userID string, payout int) error {
+	args := m.Called(ctx, gambleID, userID, payout)
+	return args.Error(0)
+}
+
+func (m *MockTx) AddToCommunityPool(ctx context.Context, amount int) error {
+	args := m.Called(ctx, amount)
+	return args.Error(0)
+}
+
 // MockStatsService
 type MockStatsService struct {
 	mock.Mock
@@ -198,6 +236,14 @@ func (m *MockStatsService) GetUserCurrentStreak(ctx context.Context, userID stri
 	return args.Int(0), args.Error(1)
 }
 
+func (m *MockStatsService) GetUserRecipeCraftCounts(ctx context.Context, userID string) (map[string]int, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(map[string]int), args.Error(1)
+}
+
 func (m *MockStatsService) GetSystemStats(ctx context.Context, period string) (*domain.StatsSummary, error) {
 	args := m.Called(ctx, period)
 	if args.Get(0) == nil {
@@ -206,12 +252,12 @@ func (m *MockStatsService) GetSystemStats(ctx context.Context, period string) (*
 	return args.Get(0).(*domain.StatsSummary), args.Error(1)
 }
 
-func (m *MockStatsService) GetLeaderboard(ctx context.Context, eventType domain.EventType, period string, limit int) ([]domain.LeaderboardEntry, error) {
-	args := m.Called(ctx, eventType, period, limit)
+func (m *MockStatsService) GetLeaderboard(ctx context.Context, query domain.LeaderboardQuery) (*domain.LeaderboardPage, error) {
+	args := m.Called(ctx, query)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
 	}
-	return args.Get(0).([]domain.LeaderboardEntry), args.Error(1)
+	return args.Get(0).(*domain.LeaderboardPage), args.Error(1)
 }
 
 func (m *MockStatsService) GetUserSlotsStats(ctx context.Context, userID, period string) (*domain.SlotsStats, error) {
@@ -246,6 +292,19 @@ func (m *MockStatsService) GetSlotsLeaderboardByMegaJackpots(ctx context.Context
 	return args.Get(0).([]domain.SlotsStats), args.Error(1)
 }
 
+func (m *MockStatsService) QueryStats(ctx context.Context, query domain.StatsQuery) (*domain.StatsQueryResult, error) {
+	args := m.Called(ctx, query)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.StatsQueryResult), args.Error(1)
+}
+
+func (m *MockStatsService) RollUpDue(ctx context.Context) (int, error) {
+	args := m.Called(ctx)
+	return args.Int(0), args.Error(1)
+}
+
 // MockJobService
 type MockJobService struct {
 	mock.Mock
@@ -340,11 +399,26 @@ func (m *MockNamingResolver) GetDisplayName(internalName string, qualityLevel do
 	return args.String(0)
 }
 
+func (m *MockNamingResolver) GetDisplayNameForTheme(internalName string, qualityLevel domain.QualityLevel, theme string) string {
+	args := m.Called(internalName, qualityLevel, theme)
+	return args.String(0)
+}
+
 func (m *MockNamingResolver) GetActiveTheme() string {
 	args := m.Called()
 	return args.String(0)
 }
 
+func (m *MockNamingResolver) GetThemes() map[string]naming.ThemePeriod {
+	args := m.Called()
+	themes, _ := args.Get(0).(map[string]naming.ThemePeriod)
+	return themes
+}
+
+func (m *MockNamingResolver) SuggestNames(name string) []string {
+	return nil
+}
+
 func (m *MockNamingResolver) Reload() error {
 	args := m.Called()
 	return args.Error(0)
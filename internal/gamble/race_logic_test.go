@@ -155,6 +155,7 @@ func TestExecuteGamble_Concurrent_Idempotent(t *testing.T) {
 
 	// One succeeds, one fails
 	tx1.On("UpdateGambleStateIfMatches", ctx, gambleID, domain.GambleStateJoining, domain.GambleStateOpening).Return(int64(1), nil)
+	tx1.On("GetSideBets", ctx, gambleID).Return(nil, nil)
 	tx2.On("UpdateGambleStateIfMatches", ctx, gambleID, domain.GambleStateJoining, domain.GambleStateOpening).Return(int64(0), nil)
 
 	// Failed one rolls back
@@ -164,7 +165,7 @@ func TestExecuteGamble_Concurrent_Idempotent(t *testing.T) {
 	drops := []lootbox.DroppedItem{{ItemID: 10, Quantity: 5, Value: 100}}
 	ts.repo.On("GetItemByName", ctx, domain.ItemLootbox1).Return(lootboxItem, nil)
 	ts.repo.On("GetItemByID", ctx, 1).Return(lootboxItem, nil)
-	ts.lootboxSvc.On("OpenLootbox", ctx, domain.ItemLootbox1, 1, mock.Anything).Return(drops, nil)
+	ts.lootboxSvc.On("OpenLootbox", ctx, mock.Anything, domain.ItemLootbox1, 1, mock.Anything).Return(drops, nil)
 	tx1.On("SaveOpenedItems", ctx, mock.Anything).Return(nil)
 
 	tx1.On("GetInventory", ctx, mock.Anything).Return(&domain.Inventory{}, nil)
@@ -0,0 +1,121 @@
+package gamble
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/osse101/BrandishBot_Go/internal/community"
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/event"
+	"github.com/osse101/BrandishBot_Go/internal/logger"
+	"github.com/osse101/BrandishBot_Go/internal/repository"
+)
+
+// RecoverStaleGambles resolves gambles left in Created, Joining or Opening more than
+// staleAfter past their join deadline, which happens when the process dies mid-gamble.
+// Joining gambles are resumed by executing them; any other state, or a resume that
+// fails, is refunded. Each recovery is published as a GambleRecovered event.
+func (s *service) RecoverStaleGambles(ctx context.Context, staleAfter time.Duration) ([]domain.GambleRecovery, error) {
+	log := logger.FromContext(ctx)
+
+	stale, err := s.repo.GetStaleGambles(ctx, time.Now().Add(-staleAfter))
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", ErrContextFailedToGetStaleGambles, err)
+	}
+
+	recoveries := make([]domain.GambleRecovery, 0, len(stale))
+	for _, g := range stale {
+		// Stale gambles are found across communities; each is resolved in its own
+		ctx := ctx
+		if g.CommunityID != "" {
+			ctx = community.WithID(ctx, g.CommunityID)
+		}
+		recovery, err := s.recoverGamble(ctx, g.ID)
+		if err != nil {
+			log.Error(LogMsgStaleGambleRecoveryFailed, "gambleID", g.ID, "state", g.State, "error", err)
+			continue
+		}
+		if recovery == nil {
+			// Resolved by someone else between the scan and the recovery
+			continue
+		}
+
+		log.Warn(LogMsgStaleGambleRecovered, "gambleID", recovery.GambleID, "previous_state", recovery.PreviousState,
+			"action", recovery.Action, "participants", recovery.Participants, "reason", recovery.Reason)
+		if s.resilientPublisher != nil {
+			s.resilientPublisher.PublishWithRetry(ctx, event.NewGambleRecoveredEvent(*recovery))
+		}
+		recoveries = append(recoveries, *recovery)
+	}
+
+	return recoveries, nil
+}
+
+func (s *service) recoverGamble(ctx context.Context, id uuid.UUID) (*domain.GambleRecovery, error) {
+	gamble, err := s.repo.GetGamble(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", ErrContextFailedToGetGamble, err)
+	}
+	if gamble == nil {
+		return nil, nil
+	}
+
+	recovery := &domain.GambleRecovery{
+		GambleID:      gamble.ID,
+		PreviousState: gamble.State,
+		Participants:  len(gamble.Participants),
+	}
+
+	if gamble.State == domain.GambleStateJoining {
+		if _, err := s.ExecuteGamble(ctx, gamble.ID); err == nil {
+			recovery.Action = domain.GambleRecoveryResumed
+			if len(gamble.Participants) < s.limits.MinParticipants {
+				// ExecuteGamble refunds gambles too few joined
+				recovery.Action = domain.GambleRecoveryRefunded
+			}
+			return recovery, nil
+		} else {
+			recovery.Reason = err.Error()
+		}
+	}
+
+	refunded, err := s.refundStaleGamble(ctx, gamble)
+	if err != nil {
+		return nil, err
+	}
+	if !refunded {
+		return nil, nil
+	}
+	recovery.Action = domain.GambleRecoveryRefunded
+	return recovery, nil
+}
+
+// refundStaleGamble returns every bet and marks the gamble refunded. It reports
+// false when the gamble left its stale state before the refund could claim it.
+func (s *service) refundStaleGamble(ctx context.Context, gamble *domain.Gamble) (bool, error) {
+	tx, err := s.repo.BeginGambleTx(ctx)
+	if err != nil {
+		return false, fmt.Errorf("%s: %w", ErrContextFailedToBeginTx, err)
+	}
+	defer repository.SafeRollback(ctx, tx)
+
+	// Claim the gamble so a late execution or another instance cannot resolve it too
+	rowsAffected, err := tx.UpdateGambleStateIfMatches(ctx, gamble.ID, gamble.State, domain.GambleStateRefunded)
+	if err != nil {
+		return false, fmt.Errorf("failed to claim stale gamble: %w", err)
+	}
+	if rowsAffected == 0 {
+		return false, nil
+	}
+
+	if err := s.refundGamble(ctx, tx, gamble); err != nil {
+		return false, err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return false, fmt.Errorf("%s: %w", ErrContextFailedToCommitTx, err)
+	}
+	return true, nil
+}
@@ -0,0 +1,29 @@
+package gamble
+
+import (
+	"context"
+	"time"
+)
+
+// RecoveryJob periodically resolves gambles stuck in a non-terminal state
+type RecoveryJob struct {
+	service    Service
+	staleAfter time.Duration
+}
+
+// NewRecoveryJob creates a stale gamble recovery job. Gambles are recovered
+// once they are staleAfter past their join deadline.
+func NewRecoveryJob(service Service, staleAfter time.Duration) *RecoveryJob {
+	return &RecoveryJob{service: service, staleAfter: staleAfter}
+}
+
+// JobType returns the worker pool job type
+func (j *RecoveryJob) JobType() string {
+	return RecoveryJobType
+}
+
+// Process recovers any stale gambles
+func (j *RecoveryJob) Process(ctx context.Context) error {
+	_, err := j.service.RecoverStaleGambles(ctx, j.staleAfter)
+	return err
+}
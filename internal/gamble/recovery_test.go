@@ -0,0 +1,148 @@
+package gamble
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/event"
+)
+
+func isRecoveredEvent(action domain.GambleRecoveryAction) interface{} {
+	return mock.MatchedBy(func(e event.Event) bool {
+		p, ok := e.Payload.(event.GambleRecoveredPayloadV1)
+		return ok && e.Type == event.GambleRecovered && p.Action == string(action)
+	})
+}
+
+func TestRecoverStaleGambles_RefundsOpeningGamble(t *testing.T) {
+	ts := setupService(nil, false)
+	ctx := context.Background()
+	gambleID := uuid.New()
+	gamble := &domain.Gamble{
+		ID:    gambleID,
+		State: domain.GambleStateOpening,
+		Participants: []domain.Participant{
+			{UserID: "user1", LootboxBets: []domain.LootboxBet{{ItemName: domain.ItemLootbox1, Quantity: 2}}},
+			{UserID: "user2", LootboxBets: []domain.LootboxBet{{ItemName: domain.ItemLootbox1, Quantity: 2}}},
+		},
+	}
+	lootboxItem := &domain.Item{ID: 1, InternalName: domain.ItemLootbox1}
+	tx := new(MockTx)
+
+	ts.repo.On("GetStaleGambles", ctx, mock.AnythingOfType("time.Time")).Return([]domain.Gamble{{ID: gambleID, State: domain.GambleStateOpening}}, nil)
+	ts.repo.On("GetGamble", ctx, gambleID).Return(gamble, nil)
+	ts.repo.On("BeginGambleTx", ctx).Return(tx, nil)
+	tx.On("UpdateGambleStateIfMatches", ctx, gambleID, domain.GambleStateOpening, domain.GambleStateRefunded).Return(int64(1), nil)
+	tx.On("GetSideBets", ctx, gambleID).Return(nil, nil)
+
+	ts.namingResolver.On("ResolvePublicName", domain.ItemLootbox1).Return("", false)
+	ts.repo.On("GetItemByName", ctx, domain.ItemLootbox1).Return(lootboxItem, nil)
+	tx.On("GetInventory", ctx, "user1").Return(&domain.Inventory{}, nil)
+	tx.On("GetInventory", ctx, "user2").Return(&domain.Inventory{}, nil)
+	tx.On("UpdateInventory", ctx, mock.Anything, mock.MatchedBy(func(inv domain.Inventory) bool {
+		return len(inv.Slots) == 1 && inv.Slots[0].Quantity == 2
+	})).Return(nil).Times(2)
+	tx.On("RefundGamble", ctx, gambleID).Return(nil)
+	tx.On("Commit", ctx).Return(nil)
+	tx.On("Rollback", ctx).Return(nil).Maybe()
+
+	ts.resilientPub.On("PublishWithRetry", ctx, mock.MatchedBy(func(e event.Event) bool {
+		return e.Type != event.GambleRecovered
+	})).Return()
+	ts.resilientPub.On("PublishWithRetry", ctx, isRecoveredEvent(domain.GambleRecoveryRefunded)).Return().Once()
+
+	recoveries, err := ts.svc.RecoverStaleGambles(ctx, 10*time.Minute)
+
+	assert.NoError(t, err)
+	assert.Len(t, recoveries, 1)
+	assert.Equal(t, domain.GambleRecoveryRefunded, recoveries[0].Action)
+	assert.Equal(t, domain.GambleStateOpening, recoveries[0].PreviousState)
+	assert.Equal(t, 2, recoveries[0].Participants)
+	ts.repo.AssertExpectations(t)
+	ts.resilientPub.AssertExpectations(t)
+	tx.AssertExpectations(t)
+}
+
+func TestRecoverStaleGambles_SkipsGambleResolvedConcurrently(t *testing.T) {
+	ts := setupService(nil, false)
+	ctx := context.Background()
+	gambleID := uuid.New()
+	gamble := &domain.Gamble{ID: gambleID, State: domain.GambleStateCreated}
+	tx := new(MockTx)
+
+	ts.repo.On("GetStaleGambles", ctx, mock.AnythingOfType("time.Time")).Return([]domain.Gamble{*gamble}, nil)
+	ts.repo.On("GetGamble", ctx, gambleID).Return(gamble, nil)
+	ts.repo.On("BeginGambleTx", ctx).Return(tx, nil)
+	tx.On("UpdateGambleStateIfMatches", ctx, gambleID, domain.GambleStateCreated, domain.GambleStateRefunded).Return(int64(0), nil)
+	tx.On("Rollback", ctx).Return(nil)
+
+	recoveries, err := ts.svc.RecoverStaleGambles(ctx, 10*time.Minute)
+
+	assert.NoError(t, err)
+	assert.Empty(t, recoveries)
+	tx.AssertNotCalled(t, "RefundGamble", mock.Anything, mock.Anything)
+	ts.resilientPub.AssertNotCalled(t, "PublishWithRetry", mock.Anything, mock.Anything)
+}
+
+func TestRecoverStaleGambles_ResumeFailureFallsBackToRefund(t *testing.T) {
+	ts := setupService(nil, false)
+	ctx := context.Background()
+	gambleID := uuid.New()
+	gamble := &domain.Gamble{
+		ID:    gambleID,
+		State: domain.GambleStateJoining,
+		Participants: []domain.Participant{
+			{UserID: "user1"},
+			{UserID: "user2"},
+		},
+	}
+	tx := new(MockTx)
+
+	ts.repo.On("GetStaleGambles", ctx, mock.AnythingOfType("time.Time")).Return([]domain.Gamble{{ID: gambleID, State: domain.GambleStateJoining}}, nil)
+	ts.repo.On("GetGamble", ctx, gambleID).Return(gamble, nil)
+	ts.repo.On("BeginGambleTx", ctx).Return(nil, errors.New("connection reset")).Once()
+	ts.repo.On("BeginGambleTx", ctx).Return(tx, nil).Once()
+	tx.On("UpdateGambleStateIfMatches", ctx, gambleID, domain.GambleStateJoining, domain.GambleStateRefunded).Return(int64(1), nil)
+	tx.On("GetSideBets", ctx, gambleID).Return(nil, nil)
+	tx.On("GetInventory", ctx, mock.Anything).Return(&domain.Inventory{}, nil)
+	tx.On("UpdateInventory", ctx, mock.Anything, mock.Anything).Return(nil)
+	tx.On("RefundGamble", ctx, gambleID).Return(nil)
+	tx.On("Commit", ctx).Return(nil)
+	tx.On("Rollback", ctx).Return(nil).Maybe()
+
+	ts.resilientPub.On("PublishWithRetry", ctx, mock.MatchedBy(func(e event.Event) bool {
+		return e.Type != event.GambleRecovered
+	})).Return()
+	ts.resilientPub.On("PublishWithRetry", ctx, mock.MatchedBy(func(e event.Event) bool {
+		p, ok := e.Payload.(event.GambleRecoveredPayloadV1)
+		return ok && p.Action == string(domain.GambleRecoveryRefunded) && p.Reason != ""
+	})).Return().Once()
+
+	recoveries, err := ts.svc.RecoverStaleGambles(ctx, 10*time.Minute)
+
+	assert.NoError(t, err)
+	assert.Len(t, recoveries, 1)
+	assert.Equal(t, domain.GambleRecoveryRefunded, recoveries[0].Action)
+	assert.Contains(t, recoveries[0].Reason, "connection reset")
+	ts.resilientPub.AssertExpectations(t)
+	tx.AssertExpectations(t)
+}
+
+func TestRecoverStaleGambles_RepositoryError(t *testing.T) {
+	ts := setupService(nil, false)
+	ctx := context.Background()
+
+	ts.repo.On("GetStaleGambles", ctx, mock.AnythingOfType("time.Time")).Return(nil, errors.New("db down"))
+
+	recoveries, err := ts.svc.RecoverStaleGambles(ctx, 10*time.Minute)
+
+	assert.Error(t, err)
+	assert.Nil(t, recoveries)
+}
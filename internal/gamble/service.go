@@ -11,6 +11,7 @@ import (
 	"github.com/osse101/BrandishBot_Go/internal/lootbox"
 	"github.com/osse101/BrandishBot_Go/internal/naming"
 	"github.com/osse101/BrandishBot_Go/internal/repository"
+	"github.com/osse101/BrandishBot_Go/internal/rng"
 	"github.com/osse101/BrandishBot_Go/internal/utils"
 )
 
@@ -22,6 +23,9 @@ type Service interface {
 	GetGamble(ctx context.Context, id uuid.UUID) (*domain.Gamble, error)
 	ExecuteGamble(ctx context.Context, id uuid.UUID) (*domain.GambleResult, error)
 	GetActiveGamble(ctx context.Context) (*domain.Gamble, error)
+	RecoverStaleGambles(ctx context.Context, staleAfter time.Duration) ([]domain.GambleRecovery, error)
+	PlaceSideBet(ctx context.Context, gambleID uuid.UUID, platform, platformID, username, backedUsername string, amount int) (*domain.SideBet, error)
+	GetOdds(ctx context.Context, id uuid.UUID) (*domain.GambleOdds, error)
 }
 
 // ProgressionService defines the interface for progression system
@@ -34,6 +38,79 @@ type ResilientPublisher interface {
 	PublishWithRetry(ctx context.Context, evt event.Event)
 }
 
+// EffectChecker reads timed effects such as gamble luck
+type EffectChecker interface {
+	Multiplier(ctx context.Context, userID string, effectType domain.EffectType) float64
+}
+
+// CooldownService enforces the cooldown on starting gambles
+type CooldownService interface {
+	EnforceCooldown(ctx context.Context, userID, action string, fn func() error) error
+}
+
+// OverflowSettler sells the slots a write opened past the inventory slot limit
+type OverflowSettler interface {
+	SettleInventoryOverflow(ctx context.Context, before map[utils.SlotKey]int, inventory *domain.Inventory) (int, int, error)
+}
+
+// Option configures optional gamble service dependencies
+type Option func(*service)
+
+// WithEffects scales participants' drop values by their gamble luck effects
+func WithEffects(effects EffectChecker) Option {
+	return func(s *service) {
+		s.effects = effects
+	}
+}
+
+// WithCooldowns puts starting a gamble on a per-user cooldown
+func WithCooldowns(cooldowns CooldownService) Option {
+	return func(s *service) {
+		s.cooldowns = cooldowns
+	}
+}
+
+// WithRake withholds percent of the money in each pot from the winner and
+// credits it to the community pool
+func WithRake(percent int) Option {
+	return func(s *service) {
+		s.rakePercent = percent
+	}
+}
+
+// WithOverflow sells winnings and refunds that open slots past the
+// receiver's inventory slot limit, so a full inventory never holds up a gamble
+func WithOverflow(overflow OverflowSettler) Option {
+	return func(s *service) {
+		s.overflow = overflow
+	}
+}
+
+// Limits bound who can take part in a gamble. Zero maximums mean no limit.
+type Limits struct {
+	MinParticipants int // Fewer by the join deadline refunds the gamble; at least DefaultMinParticipants
+	MaxParticipants int // Joins beyond this are rejected
+	MaxWagerValue   int // Highest total base value of the lootboxes one participant wagers
+}
+
+// WithLimits sets the participant and wager limits
+func WithLimits(limits Limits) Option {
+	return func(s *service) {
+		if limits.MinParticipants < DefaultMinParticipants {
+			limits.MinParticipants = DefaultMinParticipants
+		}
+		s.limits = limits
+	}
+}
+
+// WithRNG breaks winner ties with a seeded source per gamble, overriding the
+// rng passed to NewService
+func WithRNG(provider *rng.Provider) Option {
+	return func(s *service) {
+		s.rngProvider = provider
+	}
+}
+
 type service struct {
 	repo               repository.Gamble
 	eventBus           event.Bus
@@ -41,16 +118,22 @@ type service struct {
 	lootboxSvc         lootbox.Service
 	progressionSvc     ProgressionService
 	namingResolver     naming.Resolver
+	effects            EffectChecker   // nil ignores gamble luck effects
+	cooldowns          CooldownService // nil starts gambles without a cooldown
+	overflow           OverflowSettler // nil ignores slot limits
+	rakePercent        int             // 0 pays the whole pot to the winner
+	limits             Limits
 	joinDuration       time.Duration
 	rng                func(int) int
+	rngProvider        *rng.Provider // nil uses rng for tie-breaks
 }
 
 // NewService creates a new gamble service
-func NewService(repo repository.Gamble, eventBus event.Bus, resilientPublisher ResilientPublisher, lootboxSvc lootbox.Service, joinDuration time.Duration, progressionSvc ProgressionService, namingResolver naming.Resolver, rng func(int) int) Service {
+func NewService(repo repository.Gamble, eventBus event.Bus, resilientPublisher ResilientPublisher, lootboxSvc lootbox.Service, joinDuration time.Duration, progressionSvc ProgressionService, namingResolver naming.Resolver, rng func(int) int, opts ...Option) Service {
 	if rng == nil {
 		rng = utils.SecureRandomInt
 	}
-	return &service{
+	s := &service{
 		repo:               repo,
 		eventBus:           eventBus,
 		resilientPublisher: resilientPublisher,
@@ -59,7 +142,12 @@ func NewService(repo repository.Gamble, eventBus event.Bus, resilientPublisher R
 		namingResolver:     namingResolver,
 		joinDuration:       joinDuration,
 		rng:                rng,
+		limits:             Limits{MinParticipants: DefaultMinParticipants},
+	}
+	for _, opt := range opts {
+		opt(s)
 	}
+	return s
 }
 
 // GetGamble retrieves a gamble by ID
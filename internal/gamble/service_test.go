@@ -9,10 +9,14 @@ import (
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 
+	"github.com/osse101/BrandishBot_Go/internal/cooldown"
 	"github.com/osse101/BrandishBot_Go/internal/domain"
 	"github.com/osse101/BrandishBot_Go/internal/event"
 	"github.com/osse101/BrandishBot_Go/internal/lootbox"
+	"github.com/osse101/BrandishBot_Go/internal/rng"
+	"github.com/osse101/BrandishBot_Go/internal/utils"
 )
 
 // testService holds the service and its mocks
@@ -263,6 +267,39 @@ func TestStartGamble_BeginTxError(t *testing.T) {
 	ts.repo.AssertExpectations(t)
 }
 
+// fakeCooldowns rejects every action with err, or runs it when err is nil
+type fakeCooldowns struct {
+	err     error
+	actions []string
+}
+
+func (f *fakeCooldowns) EnforceCooldown(ctx context.Context, userID, action string, fn func() error) error {
+	f.actions = append(f.actions, action)
+	if f.err != nil {
+		return f.err
+	}
+	return fn()
+}
+
+func TestStartGamble_OnCooldown(t *testing.T) {
+	repo := new(MockRepository)
+	cooldowns := &fakeCooldowns{err: cooldown.ErrOnCooldown{Action: domain.ActionGambleStart, Remaining: time.Minute}}
+	svc := NewService(repo, new(MockEventBus), new(MockResilientPublisher), new(MockLootboxService), time.Minute, nil, new(MockNamingResolver), nil, WithCooldowns(cooldowns))
+	ctx := context.Background()
+
+	repo.On("GetUserByPlatformID", ctx, domain.PlatformTwitch, "123").Return(&domain.User{ID: "user1"}, nil)
+	repo.On("GetActiveGamble", ctx).Return(nil, nil)
+
+	gamble, err := svc.StartGamble(ctx, domain.PlatformTwitch, "123", "testuser", []domain.LootboxBet{{ItemName: "lootbox_tier1", Quantity: 1}})
+
+	assert.ErrorIs(t, err, domain.ErrOnCooldown)
+	assert.Nil(t, gamble)
+	assert.Equal(t, []string{domain.ActionGambleStart}, cooldowns.actions)
+	// The bets are not checked or taken while on cooldown
+	repo.AssertNotCalled(t, "GetItemByName", mock.Anything, mock.Anything)
+	repo.AssertNotCalled(t, "BeginGambleTx", mock.Anything)
+}
+
 // ========================================
 // JoinGamble Tests
 // ========================================
@@ -384,6 +421,44 @@ func TestJoinGamble_Errors(t *testing.T) {
 			},
 			expectedError: domain.ErrInsufficientQuantity,
 		},
+		{
+			name: "Gamble Full",
+			setupMocks: func(ts *testService, ctx context.Context, gambleID uuid.UUID, tx *MockTx) {
+				ts.svc.(*service).limits.MaxParticipants = 2
+				user := &domain.User{ID: "user3"}
+				gamble := &domain.Gamble{
+					ID:           gambleID,
+					InitiatorID:  "initiator_user",
+					State:        domain.GambleStateJoining,
+					JoinDeadline: time.Now().Add(time.Minute),
+					Participants: []domain.Participant{{UserID: "initiator_user"}, {UserID: "user2"}},
+				}
+				ts.repo.On("GetUserByPlatformID", ctx, domain.PlatformTwitch, "456").Return(user, nil)
+				ts.repo.On("GetGamble", ctx, gambleID).Return(gamble, nil)
+			},
+			expectedError: domain.ErrGambleFull,
+		},
+		{
+			name: "Wager Too High",
+			setupMocks: func(ts *testService, ctx context.Context, gambleID uuid.UUID, tx *MockTx) {
+				ts.svc.(*service).limits.MaxWagerValue = 100
+				user := &domain.User{ID: "user2"}
+				gamble := &domain.Gamble{
+					ID:           gambleID,
+					InitiatorID:  "initiator_user",
+					State:        domain.GambleStateJoining,
+					JoinDeadline: time.Now().Add(time.Minute),
+					Participants: []domain.Participant{
+						{UserID: "initiator_user", GambleID: gambleID, LootboxBets: []domain.LootboxBet{{ItemName: domain.ItemLootbox1, Quantity: 3}}},
+					},
+				}
+				ts.repo.On("GetUserByPlatformID", ctx, domain.PlatformTwitch, "456").Return(user, nil)
+				ts.repo.On("GetGamble", ctx, gambleID).Return(gamble, nil)
+				ts.namingResolver.On("ResolvePublicName", domain.ItemLootbox1).Return("", false)
+				ts.repo.On("GetItemByName", ctx, domain.ItemLootbox1).Return(&domain.Item{ID: 1, InternalName: domain.ItemLootbox1, BaseValue: 40}, nil)
+			},
+			expectedError: domain.ErrWagerTooHigh,
+		},
 	}
 
 	for _, tt := range tests {
@@ -429,11 +504,12 @@ func TestExecuteGamble_Success(t *testing.T) {
 	ts.repo.On("GetGamble", ctx, gambleID).Return(gamble, nil)
 	ts.repo.On("BeginGambleTx", ctx).Return(tx, nil)
 	tx.On("UpdateGambleStateIfMatches", ctx, gambleID, domain.GambleStateJoining, domain.GambleStateOpening).Return(int64(1), nil)
+	tx.On("GetSideBets", ctx, gambleID).Return(nil, nil)
 
 	ts.namingResolver.On("ResolvePublicName", domain.ItemLootbox1).Return("", false)
 	ts.repo.On("GetItemByName", ctx, domain.ItemLootbox1).Return(lootboxItem, nil)
 	ts.repo.On("GetItemByID", ctx, 1).Return(lootboxItem, nil)
-	ts.lootboxSvc.On("OpenLootbox", ctx, mock.Anything, mock.Anything, mock.Anything).Return(droppedItems, nil)
+	ts.lootboxSvc.On("OpenLootbox", ctx, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(droppedItems, nil)
 	tx.On("SaveOpenedItems", ctx, mock.Anything).Return(nil)
 	tx.On("GetInventory", ctx, mock.Anything).Return(winnerInventory, nil)
 	tx.On("UpdateInventory", ctx, mock.Anything, mock.Anything).Return(nil)
@@ -459,6 +535,68 @@ func TestExecuteGamble_Success(t *testing.T) {
 	ts.resilientPub.AssertExpectations(t)
 }
 
+// sellNewSlots stands in for the user service's overflow, selling every slot
+// a write opened.
+type sellNewSlots struct {
+	sold int
+}
+
+func (o *sellNewSlots) SettleInventoryOverflow(_ context.Context, before map[utils.SlotKey]int, inventory *domain.Inventory) (int, int, error) {
+	kept := inventory.Slots[:0]
+	for _, slot := range inventory.Slots {
+		if _, existed := before[utils.SlotKey{ItemID: slot.ItemID, QualityLevel: slot.QualityLevel}]; existed {
+			kept = append(kept, slot)
+			continue
+		}
+		o.sold++
+	}
+	inventory.Slots = kept
+	return o.sold, 0, nil
+}
+
+func TestExecuteGamble_SettlesWinnerOverflow(t *testing.T) {
+	ts := setupService(nil, false)
+	overflow := &sellNewSlots{}
+	ts.svc.(*service).overflow = overflow
+	ctx := context.Background()
+	gambleID := uuid.New()
+	gamble := &domain.Gamble{
+		ID:    gambleID,
+		State: domain.GambleStateJoining,
+		Participants: []domain.Participant{
+			{UserID: "user1", LootboxBets: []domain.LootboxBet{{ItemName: domain.ItemLootbox1, Quantity: 1}}},
+			{UserID: "user2", LootboxBets: []domain.LootboxBet{{ItemName: domain.ItemLootbox1, Quantity: 1}}},
+		},
+	}
+	tx := new(MockTx)
+	lootboxItem := &domain.Item{ID: 1, InternalName: domain.PublicNameLootbox}
+	droppedItems := []lootbox.DroppedItem{{ItemID: 11, ItemName: "item_stick", Quantity: 5, Value: 10}}
+
+	ts.repo.On("GetGamble", ctx, gambleID).Return(gamble, nil)
+	ts.repo.On("BeginGambleTx", ctx).Return(tx, nil)
+	tx.On("UpdateGambleStateIfMatches", ctx, gambleID, domain.GambleStateJoining, domain.GambleStateOpening).Return(int64(1), nil)
+	tx.On("GetSideBets", ctx, gambleID).Return(nil, nil)
+	ts.namingResolver.On("ResolvePublicName", domain.ItemLootbox1).Return("", false)
+	ts.repo.On("GetItemByName", ctx, domain.ItemLootbox1).Return(lootboxItem, nil)
+	ts.repo.On("GetItemByID", ctx, 1).Return(lootboxItem, nil)
+	ts.lootboxSvc.On("OpenLootbox", ctx, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(droppedItems, nil)
+	tx.On("SaveOpenedItems", ctx, mock.Anything).Return(nil)
+	tx.On("GetInventory", ctx, mock.Anything).Return(&domain.Inventory{Slots: []domain.InventorySlot{}}, nil)
+	tx.On("UpdateInventory", ctx, mock.Anything, mock.MatchedBy(func(inv domain.Inventory) bool {
+		return len(inv.Slots) == 0
+	})).Return(nil)
+	tx.On("CompleteGamble", ctx, mock.Anything).Return(nil)
+	tx.On("Commit", ctx).Return(nil)
+	tx.On("Rollback", ctx).Return(nil).Maybe()
+	ts.resilientPub.On("PublishWithRetry", ctx, mock.Anything).Return()
+
+	_, err := ts.svc.ExecuteGamble(ctx, gambleID)
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, overflow.sold, "the winnings opened a slot past the limit")
+	tx.AssertExpectations(t)
+}
+
 func TestExecuteGamble_Refund_OnlyOneParticipant(t *testing.T) {
 	ts := setupService(nil, false)
 	ctx := context.Background()
@@ -477,6 +615,7 @@ func TestExecuteGamble_Refund_OnlyOneParticipant(t *testing.T) {
 	ts.repo.On("GetGamble", ctx, gambleID).Return(gamble, nil)
 	ts.repo.On("BeginGambleTx", ctx).Return(tx, nil)
 	tx.On("UpdateGambleStateIfMatches", ctx, gambleID, domain.GambleStateJoining, domain.GambleStateOpening).Return(int64(1), nil)
+	tx.On("GetSideBets", ctx, gambleID).Return(nil, nil)
 
 	// Item resolution
 	ts.namingResolver.On("ResolvePublicName", domain.ItemLootbox1).Return("", false)
@@ -508,6 +647,48 @@ func TestExecuteGamble_Refund_OnlyOneParticipant(t *testing.T) {
 	tx.AssertExpectations(t)
 }
 
+func TestExecuteGamble_Refund_BelowMinParticipants(t *testing.T) {
+	ts := setupService(nil, false)
+	ts.svc.(*service).limits.MinParticipants = 3
+	ctx := context.Background()
+	gambleID := uuid.New()
+	gamble := &domain.Gamble{
+		ID:    gambleID,
+		State: domain.GambleStateJoining,
+		Participants: []domain.Participant{
+			{UserID: "user1", LootboxBets: []domain.LootboxBet{{ItemName: domain.ItemLootbox1, Quantity: 1}}},
+			{UserID: "user2", LootboxBets: []domain.LootboxBet{{ItemName: domain.ItemLootbox1, Quantity: 1}}},
+		},
+	}
+	tx := new(MockTx)
+
+	ts.repo.On("GetGamble", ctx, gambleID).Return(gamble, nil)
+	ts.repo.On("BeginGambleTx", ctx).Return(tx, nil)
+	tx.On("UpdateGambleStateIfMatches", ctx, gambleID, domain.GambleStateJoining, domain.GambleStateOpening).Return(int64(1), nil)
+	tx.On("GetSideBets", ctx, gambleID).Return(nil, nil)
+	ts.namingResolver.On("ResolvePublicName", domain.ItemLootbox1).Return("", false)
+	ts.repo.On("GetItemByName", ctx, domain.ItemLootbox1).Return(&domain.Item{ID: 1, InternalName: domain.ItemLootbox1}, nil)
+	tx.On("GetInventory", ctx, mock.Anything).Return(&domain.Inventory{}, nil)
+	tx.On("UpdateInventory", ctx, mock.Anything, mock.Anything).Return(nil).Times(2)
+	tx.On("RefundGamble", ctx, gambleID).Return(nil)
+	tx.On("Commit", ctx).Return(nil)
+	tx.On("Rollback", ctx).Return(nil).Maybe()
+	ts.resilientPub.On("PublishWithRetry", ctx, mock.Anything).Return()
+
+	result, err := ts.svc.ExecuteGamble(ctx, gambleID)
+
+	require.NoError(t, err)
+	assert.Empty(t, result.WinnerID)
+	ts.lootboxSvc.AssertNotCalled(t, "OpenLootbox", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	tx.AssertExpectations(t)
+}
+
+func TestWithLimits_KeepsMinimumOfTwo(t *testing.T) {
+	svc := NewService(new(MockRepository), nil, nil, nil, time.Minute, nil, nil, nil, WithLimits(Limits{MinParticipants: 1, MaxParticipants: 6})).(*service)
+
+	assert.Equal(t, Limits{MinParticipants: DefaultMinParticipants, MaxParticipants: 6}, svc.limits)
+}
+
 func TestExecuteGamble_MultipleParticipants(t *testing.T) {
 	ts := setupService(nil, false)
 	ctx := context.Background()
@@ -529,12 +710,13 @@ func TestExecuteGamble_MultipleParticipants(t *testing.T) {
 	ts.repo.On("GetGamble", ctx, gambleID).Return(gamble, nil)
 	ts.repo.On("BeginGambleTx", ctx).Return(tx, nil)
 	tx.On("UpdateGambleStateIfMatches", ctx, gambleID, domain.GambleStateJoining, domain.GambleStateOpening).Return(int64(1), nil)
+	tx.On("GetSideBets", ctx, gambleID).Return(nil, nil)
 
 	ts.namingResolver.On("ResolvePublicName", domain.ItemLootbox1).Return("", false)
 	ts.repo.On("GetItemByName", ctx, domain.ItemLootbox1).Return(lootboxItem, nil)
 	ts.repo.On("GetItemByID", ctx, 1).Return(lootboxItem, nil)
-	ts.lootboxSvc.On("OpenLootbox", ctx, domain.ItemLootbox1, 2, mock.Anything).Return(droppedItems1, nil)
-	ts.lootboxSvc.On("OpenLootbox", ctx, domain.ItemLootbox1, 1, mock.Anything).Return(droppedItems2, nil)
+	ts.lootboxSvc.On("OpenLootbox", ctx, mock.Anything, domain.ItemLootbox1, 2, mock.Anything).Return(droppedItems1, nil)
+	ts.lootboxSvc.On("OpenLootbox", ctx, mock.Anything, domain.ItemLootbox1, 1, mock.Anything).Return(droppedItems2, nil)
 	tx.On("SaveOpenedItems", ctx, mock.Anything).Return(nil)
 	tx.On("GetInventory", ctx, mock.Anything).Return(inventory, nil)
 	tx.On("UpdateInventory", ctx, mock.Anything, mock.Anything).Return(nil)
@@ -556,6 +738,65 @@ func TestExecuteGamble_MultipleParticipants(t *testing.T) {
 	ts.lootboxSvc.AssertExpectations(t)
 }
 
+func TestExecuteGamble_Rake(t *testing.T) {
+	ts := setupService(nil, false)
+	ts.svc.(*service).rakePercent = 10
+	ctx := context.Background()
+	gambleID := uuid.New()
+	gamble := &domain.Gamble{
+		ID:    gambleID,
+		State: domain.GambleStateJoining,
+		Participants: []domain.Participant{
+			{UserID: "user1", LootboxBets: []domain.LootboxBet{{ItemName: domain.ItemLootbox1, Quantity: 2}}},
+			{UserID: "user2", LootboxBets: []domain.LootboxBet{{ItemName: domain.ItemLootbox1, Quantity: 1}}},
+		},
+	}
+	tx := new(MockTx)
+	inventory := &domain.Inventory{Slots: []domain.InventorySlot{}}
+	lootboxItem := &domain.Item{ID: 1, InternalName: domain.ItemLootbox1}
+	moneyItem := &domain.Item{ID: 10, InternalName: domain.ItemMoney}
+	droppedItems1 := []lootbox.DroppedItem{{ItemID: 10, ItemName: domain.ItemMoney, Quantity: 50, Value: 1}}
+	droppedItems2 := []lootbox.DroppedItem{
+		{ItemID: 10, ItemName: domain.ItemMoney, Quantity: 40, Value: 1},
+		{ItemID: 11, ItemName: "stick", Quantity: 2, Value: 1},
+	}
+
+	ts.repo.On("GetGamble", ctx, gambleID).Return(gamble, nil)
+	ts.repo.On("BeginGambleTx", ctx).Return(tx, nil)
+	tx.On("UpdateGambleStateIfMatches", ctx, gambleID, domain.GambleStateJoining, domain.GambleStateOpening).Return(int64(1), nil)
+	tx.On("GetSideBets", ctx, gambleID).Return(nil, nil)
+
+	ts.namingResolver.On("ResolvePublicName", domain.ItemLootbox1).Return("", false)
+	ts.repo.On("GetItemByName", ctx, domain.ItemLootbox1).Return(lootboxItem, nil)
+	ts.repo.On("GetItemByName", ctx, domain.ItemMoney).Return(moneyItem, nil)
+	ts.repo.On("GetItemByID", ctx, 1).Return(lootboxItem, nil)
+	ts.lootboxSvc.On("OpenLootbox", ctx, mock.Anything, domain.ItemLootbox1, 2, mock.Anything).Return(droppedItems1, nil)
+	ts.lootboxSvc.On("OpenLootbox", ctx, mock.Anything, domain.ItemLootbox1, 1, mock.Anything).Return(droppedItems2, nil)
+	tx.On("SaveOpenedItems", ctx, mock.Anything).Return(nil)
+	// 10% of the 90 money in the pot
+	tx.On("AddToCommunityPool", ctx, 9).Return(nil)
+	tx.On("GetInventory", ctx, "user1").Return(inventory, nil)
+	tx.On("UpdateInventory", ctx, "user1", mock.MatchedBy(func(inv domain.Inventory) bool {
+		quantities := make(map[int]int)
+		for _, slot := range inv.Slots {
+			quantities[slot.ItemID] += slot.Quantity
+		}
+		return quantities[10] == 81 && quantities[11] == 2
+	})).Return(nil)
+	tx.On("CompleteGamble", ctx, mock.Anything).Return(nil)
+	tx.On("Commit", ctx).Return(nil)
+	tx.On("Rollback", ctx).Return(nil).Maybe()
+
+	ts.resilientPub.On("PublishWithRetry", ctx, mock.Anything).Return()
+
+	result, err := ts.svc.ExecuteGamble(ctx, gambleID)
+
+	require.NoError(t, err)
+	assert.Equal(t, "user1", result.WinnerID)
+	assert.Equal(t, 9, result.Rake)
+	tx.AssertExpectations(t)
+}
+
 func TestExecuteGamble_GambleNotFound(t *testing.T) {
 	ts := setupService(nil, false)
 	ctx := context.Background()
@@ -659,7 +900,7 @@ func TestExecuteGamble_SaveOpenedItemsFails(t *testing.T) {
 	ts.namingResolver.On("ResolvePublicName", domain.ItemLootbox1).Return("", false)
 	ts.repo.On("GetItemByName", ctx, domain.ItemLootbox1).Return(lootboxItem, nil)
 	ts.repo.On("GetItemByID", ctx, 1).Return(lootboxItem, nil)
-	ts.lootboxSvc.On("OpenLootbox", ctx, domain.ItemLootbox1, mock.Anything, mock.Anything).Return(droppedItems, nil)
+	ts.lootboxSvc.On("OpenLootbox", ctx, mock.Anything, domain.ItemLootbox1, mock.Anything, mock.Anything).Return(droppedItems, nil)
 	tx.On("SaveOpenedItems", ctx, mock.Anything).Return(domain.ErrDatabaseError)
 	tx.On("Rollback", ctx).Return(nil).Maybe()
 
@@ -762,6 +1003,7 @@ func TestExecuteGamble_NearMiss(t *testing.T) {
 	tx := new(MockTx)
 	ts.repo.On("BeginGambleTx", ctx).Return(tx, nil)
 	tx.On("UpdateGambleStateIfMatches", ctx, gambleID, domain.GambleStateJoining, domain.GambleStateOpening).Return(int64(1), nil)
+	tx.On("GetSideBets", ctx, gambleID).Return(nil, nil)
 
 	ts.namingResolver.On("ResolvePublicName", domain.ItemLootbox0).Return("", false)
 	ts.namingResolver.On("ResolvePublicName", domain.ItemLootbox1).Return("", false)
@@ -774,9 +1016,9 @@ func TestExecuteGamble_NearMiss(t *testing.T) {
 	ts.repo.On("GetItemByID", ctx, 2).Return(lootboxItem2, nil)
 	ts.repo.On("GetItemByID", ctx, 3).Return(lootboxItem3, nil)
 
-	ts.lootboxSvc.On("OpenLootbox", ctx, domain.ItemLootbox0, 1, mock.Anything).Return(drops1, nil)
-	ts.lootboxSvc.On("OpenLootbox", ctx, domain.ItemLootbox1, 1, mock.Anything).Return(drops2, nil)
-	ts.lootboxSvc.On("OpenLootbox", ctx, domain.ItemLootbox2, 1, mock.Anything).Return(drops3, nil)
+	ts.lootboxSvc.On("OpenLootbox", ctx, mock.Anything, domain.ItemLootbox0, 1, mock.Anything).Return(drops1, nil)
+	ts.lootboxSvc.On("OpenLootbox", ctx, mock.Anything, domain.ItemLootbox1, 1, mock.Anything).Return(drops2, nil)
+	ts.lootboxSvc.On("OpenLootbox", ctx, mock.Anything, domain.ItemLootbox2, 1, mock.Anything).Return(drops3, nil)
 
 	tx.On("SaveOpenedItems", ctx, mock.Anything).Return(nil)
 	tx.On("GetInventory", ctx, "user1").Return(&domain.Inventory{}, nil)
@@ -832,6 +1074,7 @@ func TestExecuteGamble_CriticalFailure(t *testing.T) {
 	tx := new(MockTx)
 	ts.repo.On("BeginGambleTx", ctx).Return(tx, nil)
 	tx.On("UpdateGambleStateIfMatches", ctx, gambleID, domain.GambleStateJoining, domain.GambleStateOpening).Return(int64(1), nil)
+	tx.On("GetSideBets", ctx, gambleID).Return(nil, nil)
 
 	ts.namingResolver.On("ResolvePublicName", domain.ItemLootbox0).Return("", false)
 	ts.namingResolver.On("ResolvePublicName", domain.ItemLootbox1).Return("", false)
@@ -844,9 +1087,9 @@ func TestExecuteGamble_CriticalFailure(t *testing.T) {
 	ts.repo.On("GetItemByID", ctx, 2).Return(lootboxItem2, nil)
 	ts.repo.On("GetItemByID", ctx, 3).Return(lootboxItem3, nil)
 
-	ts.lootboxSvc.On("OpenLootbox", ctx, domain.ItemLootbox0, 1, mock.Anything).Return(drops1, nil)
-	ts.lootboxSvc.On("OpenLootbox", ctx, domain.ItemLootbox1, 1, mock.Anything).Return(drops2, nil)
-	ts.lootboxSvc.On("OpenLootbox", ctx, domain.ItemLootbox2, 1, mock.Anything).Return(drops3, nil)
+	ts.lootboxSvc.On("OpenLootbox", ctx, mock.Anything, domain.ItemLootbox0, 1, mock.Anything).Return(drops1, nil)
+	ts.lootboxSvc.On("OpenLootbox", ctx, mock.Anything, domain.ItemLootbox1, 1, mock.Anything).Return(drops2, nil)
+	ts.lootboxSvc.On("OpenLootbox", ctx, mock.Anything, domain.ItemLootbox2, 1, mock.Anything).Return(drops3, nil)
 
 	tx.On("SaveOpenedItems", ctx, mock.Anything).Return(nil)
 	tx.On("GetInventory", ctx, mock.Anything).Return(&domain.Inventory{}, nil)
@@ -896,11 +1139,12 @@ func TestExecuteGamble_TieBreak(t *testing.T) {
 	tx := new(MockTx)
 	ts.repo.On("BeginGambleTx", ctx).Return(tx, nil)
 	tx.On("UpdateGambleStateIfMatches", ctx, gambleID, domain.GambleStateJoining, domain.GambleStateOpening).Return(int64(1), nil)
+	tx.On("GetSideBets", ctx, gambleID).Return(nil, nil)
 
 	ts.namingResolver.On("ResolvePublicName", domain.ItemLootbox1).Return("", false)
 	ts.repo.On("GetItemByName", ctx, domain.ItemLootbox1).Return(lootboxItem, nil)
 	ts.repo.On("GetItemByID", ctx, 1).Return(lootboxItem, nil)
-	ts.lootboxSvc.On("OpenLootbox", ctx, domain.ItemLootbox1, 1, mock.Anything).Return(drops, nil)
+	ts.lootboxSvc.On("OpenLootbox", ctx, mock.Anything, domain.ItemLootbox1, 1, mock.Anything).Return(drops, nil)
 
 	tx.On("SaveOpenedItems", ctx, mock.Anything).Return(nil)
 	tx.On("GetInventory", ctx, mock.Anything).Return(&domain.Inventory{}, nil)
@@ -922,6 +1166,19 @@ func TestExecuteGamble_TieBreak(t *testing.T) {
 	ts.resilientPub.AssertExpectations(t)
 }
 
+func TestDetermineGambleWinners_SeededTieBreakIsRepeatable(t *testing.T) {
+	s := &service{}
+	values := map[string]int64{"userA": 50, "userB": 50, "userC": 50, "userD": 10}
+	provider := rng.NewReplay(2024)
+
+	winner, _, lost := s.determineGambleWinners(values, provider.ForOperation(context.Background(), rng.OpGambleWinner).Intn)
+	assert.Len(t, lost, 2)
+	for i := 0; i < 10; i++ {
+		again, _, _ := s.determineGambleWinners(values, provider.ForOperation(context.Background(), rng.OpGambleWinner).Intn)
+		assert.Equal(t, winner, again)
+	}
+}
+
 func TestResolveItemName(t *testing.T) {
 	ts := setupService(nil, false)
 	ctx := context.Background()
@@ -0,0 +1,234 @@
+package gamble
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/logger"
+	"github.com/osse101/BrandishBot_Go/internal/repository"
+)
+
+// PlaceSideBet stakes a spectator's money on a participant winning a gamble
+// that is still accepting participants. Participants cannot side-bet, and each
+// spectator places one side bet per gamble.
+func (s *service) PlaceSideBet(ctx context.Context, gambleID uuid.UUID, platform, platformID, username, backedUsername string, amount int) (*domain.SideBet, error) {
+	log := logger.FromContext(ctx)
+	log.Info(LogMsgPlaceSideBetCalled, "gambleID", gambleID, "username", username, "backed", backedUsername, "amount", amount)
+
+	if amount <= 0 {
+		return nil, domain.ErrBetQuantityMustBePositive
+	}
+	if amount > MaxSideBetAmount {
+		return nil, fmt.Errorf("%w: max side bet is %d", domain.ErrQuantityTooHigh, MaxSideBetAmount)
+	}
+
+	user, err := s.getAndValidateGambleUser(ctx, platform, platformID)
+	if err != nil {
+		return nil, err
+	}
+
+	gamble, err := s.getAndValidateActiveGamble(ctx, gambleID)
+	if err != nil {
+		return nil, err
+	}
+
+	var backed *domain.Participant
+	for i, p := range gamble.Participants {
+		if p.UserID == user.ID {
+			return nil, domain.ErrParticipantCannotSideBet
+		}
+		if strings.EqualFold(p.Username, strings.TrimPrefix(backedUsername, "@")) {
+			backed = &gamble.Participants[i]
+		}
+	}
+	if backed == nil {
+		return nil, fmt.Errorf("%w: %s", domain.ErrSideBetNotParticipant, backedUsername)
+	}
+	for _, bet := range gamble.SideBets {
+		if bet.UserID == user.ID {
+			return nil, domain.ErrSideBetAlreadyPlaced
+		}
+	}
+
+	moneyItem, err := repository.MoneyItem(ctx, s.repo)
+	if err != nil {
+		return nil, err
+	}
+
+	bet := &domain.SideBet{
+		GambleID:       gamble.ID,
+		UserID:         user.ID,
+		Username:       username,
+		BackedUserID:   backed.UserID,
+		BackedUsername: backed.Username,
+		Amount:         amount,
+	}
+	if err := s.executeSideBetTx(ctx, bet, moneyItem.ID); err != nil {
+		return nil, err
+	}
+
+	return bet, nil
+}
+
+// executeSideBetTx takes the stake from the bettor and records the side bet
+func (s *service) executeSideBetTx(ctx context.Context, bet *domain.SideBet, moneyID int) error {
+	tx, err := s.repo.BeginGambleTx(ctx)
+	if err != nil {
+		return fmt.Errorf("%s: %w", ErrContextFailedToBeginTx, err)
+	}
+	defer repository.SafeRollback(ctx, tx)
+
+	inventory, err := tx.GetInventory(ctx, bet.UserID)
+	if err != nil {
+		return fmt.Errorf("%s: %w", ErrContextFailedToGetInventory, err)
+	}
+	if _, err := consumeItem(inventory, moneyID, bet.Amount); err != nil {
+		return domain.ErrInsufficientFunds
+	}
+	if err := tx.UpdateInventory(ctx, bet.UserID, *inventory); err != nil {
+		return fmt.Errorf("%s: %w", ErrContextFailedToUpdateInventory, err)
+	}
+
+	if err := tx.PlaceSideBet(ctx, bet); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("%s: %w", ErrContextFailedToCommitTx, err)
+	}
+	return nil
+}
+
+// GetOdds returns the side-bet pool of a gamble and the multiplier backing
+// each participant would pay if they won
+func (s *service) GetOdds(ctx context.Context, id uuid.UUID) (*domain.GambleOdds, error) {
+	gamble, err := s.repo.GetGamble(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", ErrContextFailedToGetGamble, err)
+	}
+	if gamble == nil {
+		return nil, domain.ErrGambleNotFound
+	}
+	return calculateOdds(gamble), nil
+}
+
+// calculateOdds computes pari-mutuel odds: backers of the winner split the
+// whole pool in proportion to their stakes
+func calculateOdds(gamble *domain.Gamble) *domain.GambleOdds {
+	odds := &domain.GambleOdds{
+		GambleID:     gamble.ID,
+		State:        gamble.State,
+		Bettors:      len(gamble.SideBets),
+		Participants: make([]domain.ParticipantOdds, 0, len(gamble.Participants)),
+	}
+
+	staked := make(map[string]int)
+	bettors := make(map[string]int)
+	for _, bet := range gamble.SideBets {
+		odds.Pool += bet.Amount
+		staked[bet.BackedUserID] += bet.Amount
+		bettors[bet.BackedUserID]++
+	}
+
+	for _, p := range gamble.Participants {
+		po := domain.ParticipantOdds{
+			UserID:   p.UserID,
+			Username: p.Username,
+			Staked:   staked[p.UserID],
+			Bettors:  bettors[p.UserID],
+		}
+		if po.Staked > 0 {
+			po.Multiplier = float64(odds.Pool) / float64(po.Staked)
+		}
+		odds.Participants = append(odds.Participants, po)
+	}
+	return odds
+}
+
+// settleSideBets pays out a gamble's side bets and returns the pool. Backers
+// of the winner split the pool in proportion to their stakes, with rounding
+// leftovers credited to the community pool. Every stake is refunded when
+// there is no winner or nobody backed them.
+//
+// The bets are read again inside tx, after the gamble has been claimed, so a
+// bet placed after the gamble was loaded is settled too.
+func (s *service) settleSideBets(ctx context.Context, tx repository.GambleTx, gamble *domain.Gamble, winnerID string) (int, error) {
+	sideBets, err := tx.GetSideBets(ctx, gamble.ID)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", ErrContextFailedToGetSideBets, err)
+	}
+	gamble.SideBets = sideBets
+	if len(gamble.SideBets) == 0 {
+		return 0, nil
+	}
+
+	moneyItem, err := repository.MoneyItem(ctx, s.repo)
+	if err != nil {
+		return 0, err
+	}
+
+	pool, winningStake := 0, 0
+	for _, bet := range gamble.SideBets {
+		pool += bet.Amount
+		if winnerID != "" && bet.BackedUserID == winnerID {
+			winningStake += bet.Amount
+		}
+	}
+
+	paid := 0
+	for _, bet := range gamble.SideBets {
+		payout := bet.Amount
+		if winningStake > 0 {
+			payout = 0
+			if bet.BackedUserID == winnerID {
+				payout = bet.Amount * pool / winningStake
+			}
+		}
+
+		if payout > 0 {
+			if err := creditItem(ctx, tx, bet.UserID, moneyItem.ID, payout); err != nil {
+				return 0, fmt.Errorf("%s (user:%s): %w", ErrContextFailedToPaySideBet, bet.UserID, err)
+			}
+		}
+		if err := tx.SettleSideBet(ctx, gamble.ID, bet.UserID, payout); err != nil {
+			return 0, fmt.Errorf("%s (user:%s): %w", ErrContextFailedToPaySideBet, bet.UserID, err)
+		}
+		paid += payout
+	}
+
+	if leftover := pool - paid; leftover > 0 {
+		if err := tx.AddToCommunityPool(ctx, leftover); err != nil {
+			return 0, fmt.Errorf("%s: %w", ErrContextFailedToCollectRake, err)
+		}
+	}
+	return pool, nil
+}
+
+// creditItem adds quantity of an item to a user's inventory within tx
+func creditItem(ctx context.Context, tx repository.GambleTx, userID string, itemID, quantity int) error {
+	inv, err := tx.GetInventory(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("%s: %w", ErrContextFailedToGetInventory, err)
+	}
+
+	found := false
+	for i, slot := range inv.Slots {
+		if slot.ItemID == itemID {
+			inv.Slots[i].Quantity += quantity
+			found = true
+			break
+		}
+	}
+	if !found {
+		inv.Slots = append(inv.Slots, domain.InventorySlot{ItemID: itemID, Quantity: quantity})
+	}
+
+	if err := tx.UpdateInventory(ctx, userID, *inv); err != nil {
+		return fmt.Errorf("%s: %w", ErrContextFailedToUpdateInventory, err)
+	}
+	return nil
+}
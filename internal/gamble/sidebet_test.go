@@ -0,0 +1,247 @@
+package gamble
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+)
+
+const testMoneyID = 5
+
+func sideBetGamble(sideBets ...domain.SideBet) *domain.Gamble {
+	return &domain.Gamble{
+		ID:           uuid.New(),
+		State:        domain.GambleStateJoining,
+		JoinDeadline: time.Now().Add(time.Minute),
+		Participants: []domain.Participant{
+			{UserID: "alice-id", Username: "Alice"},
+			{UserID: "bob-id", Username: "Bob"},
+		},
+		SideBets: sideBets,
+	}
+}
+
+func moneyInventory(qty int) *domain.Inventory {
+	return &domain.Inventory{Slots: []domain.InventorySlot{{ItemID: testMoneyID, Quantity: qty}}}
+}
+
+func TestPlaceSideBet(t *testing.T) {
+	ctx := context.Background()
+	spectator := &domain.User{ID: "spectator-id"}
+
+	t.Run("stakes money on a participant", func(t *testing.T) {
+		ts := setupService(nil, false)
+		g := sideBetGamble()
+		tx := new(MockTx)
+		ts.repo.On("GetUserByPlatformID", ctx, domain.PlatformTwitch, "s1").Return(spectator, nil)
+		ts.repo.On("GetGamble", ctx, g.ID).Return(g, nil)
+		ts.repo.On("GetItemByName", ctx, domain.ItemMoney).Return(&domain.Item{ID: testMoneyID}, nil)
+		ts.repo.On("BeginGambleTx", ctx).Return(tx, nil)
+		tx.On("GetInventory", ctx, spectator.ID).Return(moneyInventory(80), nil)
+		tx.On("UpdateInventory", ctx, spectator.ID, *moneyInventory(30)).Return(nil)
+		tx.On("PlaceSideBet", ctx, mock.MatchedBy(func(bet *domain.SideBet) bool {
+			return bet.BackedUserID == "alice-id" && bet.Amount == 50
+		})).Return(nil)
+		tx.On("Commit", ctx).Return(nil)
+		tx.On("Rollback", ctx).Return(nil).Maybe()
+
+		bet, err := ts.svc.PlaceSideBet(ctx, g.ID, domain.PlatformTwitch, "s1", "spec", "@alice", 50)
+
+		require.NoError(t, err)
+		assert.Equal(t, "Alice", bet.BackedUsername)
+		tx.AssertExpectations(t)
+	})
+
+	t.Run("rejects participants", func(t *testing.T) {
+		ts := setupService(nil, false)
+		g := sideBetGamble()
+		ts.repo.On("GetUserByPlatformID", ctx, domain.PlatformTwitch, "a1").Return(&domain.User{ID: "alice-id"}, nil)
+		ts.repo.On("GetGamble", ctx, g.ID).Return(g, nil)
+
+		_, err := ts.svc.PlaceSideBet(ctx, g.ID, domain.PlatformTwitch, "a1", "alice", "bob", 50)
+
+		assert.ErrorIs(t, err, domain.ErrParticipantCannotSideBet)
+	})
+
+	t.Run("rejects backing a non-participant", func(t *testing.T) {
+		ts := setupService(nil, false)
+		g := sideBetGamble()
+		ts.repo.On("GetUserByPlatformID", ctx, domain.PlatformTwitch, "s1").Return(spectator, nil)
+		ts.repo.On("GetGamble", ctx, g.ID).Return(g, nil)
+
+		_, err := ts.svc.PlaceSideBet(ctx, g.ID, domain.PlatformTwitch, "s1", "spec", "carol", 50)
+
+		assert.ErrorIs(t, err, domain.ErrSideBetNotParticipant)
+	})
+
+	t.Run("rejects a second side bet", func(t *testing.T) {
+		ts := setupService(nil, false)
+		g := sideBetGamble(domain.SideBet{UserID: spectator.ID, BackedUserID: "bob-id", Amount: 10})
+		ts.repo.On("GetUserByPlatformID", ctx, domain.PlatformTwitch, "s1").Return(spectator, nil)
+		ts.repo.On("GetGamble", ctx, g.ID).Return(g, nil)
+
+		_, err := ts.svc.PlaceSideBet(ctx, g.ID, domain.PlatformTwitch, "s1", "spec", "alice", 50)
+
+		assert.ErrorIs(t, err, domain.ErrSideBetAlreadyPlaced)
+	})
+
+	t.Run("rejects amounts over the cap", func(t *testing.T) {
+		ts := setupService(nil, false)
+
+		_, err := ts.svc.PlaceSideBet(ctx, uuid.New(), domain.PlatformTwitch, "s1", "spec", "alice", MaxSideBetAmount+1)
+
+		assert.ErrorIs(t, err, domain.ErrQuantityTooHigh)
+	})
+
+	t.Run("refuses bets once the gamble stops accepting them", func(t *testing.T) {
+		ts := setupService(nil, false)
+		g := sideBetGamble()
+		tx := new(MockTx)
+		ts.repo.On("GetUserByPlatformID", ctx, domain.PlatformTwitch, "s1").Return(spectator, nil)
+		ts.repo.On("GetGamble", ctx, g.ID).Return(g, nil)
+		ts.repo.On("GetItemByName", ctx, domain.ItemMoney).Return(&domain.Item{ID: testMoneyID}, nil)
+		ts.repo.On("BeginGambleTx", ctx).Return(tx, nil)
+		tx.On("GetInventory", ctx, spectator.ID).Return(moneyInventory(80), nil)
+		tx.On("UpdateInventory", ctx, spectator.ID, *moneyInventory(30)).Return(nil)
+		tx.On("PlaceSideBet", ctx, mock.Anything).Return(domain.ErrNotInJoiningState)
+		tx.On("Rollback", ctx).Return(nil)
+
+		_, err := ts.svc.PlaceSideBet(ctx, g.ID, domain.PlatformTwitch, "s1", "spec", "alice", 50)
+
+		assert.ErrorIs(t, err, domain.ErrNotInJoiningState)
+		tx.AssertNotCalled(t, "Commit", mock.Anything)
+	})
+
+	t.Run("insufficient money", func(t *testing.T) {
+		ts := setupService(nil, false)
+		g := sideBetGamble()
+		tx := new(MockTx)
+		ts.repo.On("GetUserByPlatformID", ctx, domain.PlatformTwitch, "s1").Return(spectator, nil)
+		ts.repo.On("GetGamble", ctx, g.ID).Return(g, nil)
+		ts.repo.On("GetItemByName", ctx, domain.ItemMoney).Return(&domain.Item{ID: testMoneyID}, nil)
+		ts.repo.On("BeginGambleTx", ctx).Return(tx, nil)
+		tx.On("GetInventory", ctx, spectator.ID).Return(moneyInventory(20), nil)
+		tx.On("Rollback", ctx).Return(nil)
+
+		_, err := ts.svc.PlaceSideBet(ctx, g.ID, domain.PlatformTwitch, "s1", "spec", "alice", 50)
+
+		assert.ErrorIs(t, err, domain.ErrInsufficientFunds)
+		tx.AssertNotCalled(t, "PlaceSideBet", mock.Anything, mock.Anything)
+	})
+}
+
+func TestJoinGamble_RejectsSideBettor(t *testing.T) {
+	ts := setupService(nil, false)
+	ctx := context.Background()
+	g := sideBetGamble(domain.SideBet{UserID: "spectator-id", BackedUserID: "alice-id", Amount: 10})
+	ts.repo.On("GetUserByPlatformID", ctx, domain.PlatformTwitch, "s1").Return(&domain.User{ID: "spectator-id"}, nil)
+	ts.repo.On("GetGamble", ctx, g.ID).Return(g, nil)
+
+	err := ts.svc.JoinGamble(ctx, g.ID, domain.PlatformTwitch, "s1", "spec")
+
+	assert.ErrorIs(t, err, domain.ErrSideBettorCannotJoin)
+}
+
+func TestCalculateOdds(t *testing.T) {
+	g := sideBetGamble(
+		domain.SideBet{UserID: "s1", BackedUserID: "alice-id", Amount: 100},
+		domain.SideBet{UserID: "s2", BackedUserID: "alice-id", Amount: 50},
+		domain.SideBet{UserID: "s3", BackedUserID: "bob-id", Amount: 50},
+	)
+	g.Participants = append(g.Participants, domain.Participant{UserID: "carol-id", Username: "Carol"})
+
+	odds := calculateOdds(g)
+
+	assert.Equal(t, 200, odds.Pool)
+	assert.Equal(t, 3, odds.Bettors)
+	require.Len(t, odds.Participants, 3)
+	assert.Equal(t, domain.ParticipantOdds{UserID: "alice-id", Username: "Alice", Staked: 150, Bettors: 2, Multiplier: 200.0 / 150}, odds.Participants[0])
+	assert.Equal(t, 4.0, odds.Participants[1].Multiplier)
+	assert.Zero(t, odds.Participants[2].Multiplier)
+}
+
+func TestSettleSideBets(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("splits the pool among backers of the winner", func(t *testing.T) {
+		ts := setupService(nil, false)
+		g := sideBetGamble(
+			domain.SideBet{UserID: "s1", BackedUserID: "alice-id", Amount: 70},
+			domain.SideBet{UserID: "s2", BackedUserID: "alice-id", Amount: 40},
+			domain.SideBet{UserID: "s3", BackedUserID: "bob-id", Amount: 100},
+		)
+		tx := new(MockTx)
+		tx.On("GetSideBets", ctx, g.ID).Return(g.SideBets, nil)
+		ts.repo.On("GetItemByName", ctx, domain.ItemMoney).Return(&domain.Item{ID: testMoneyID}, nil)
+		tx.On("GetInventory", ctx, "s1").Return(&domain.Inventory{}, nil)
+		tx.On("GetInventory", ctx, "s2").Return(moneyInventory(5), nil)
+		// 210 pool over a 110 winning stake, rounded down
+		tx.On("UpdateInventory", ctx, "s1", *moneyInventory(133)).Return(nil)
+		tx.On("UpdateInventory", ctx, "s2", *moneyInventory(81)).Return(nil)
+		tx.On("SettleSideBet", ctx, g.ID, "s1", 133).Return(nil)
+		tx.On("SettleSideBet", ctx, g.ID, "s2", 76).Return(nil)
+		tx.On("SettleSideBet", ctx, g.ID, "s3", 0).Return(nil)
+		tx.On("AddToCommunityPool", ctx, 1).Return(nil)
+
+		pool, err := ts.svc.(*service).settleSideBets(ctx, tx, g, "alice-id")
+
+		require.NoError(t, err)
+		assert.Equal(t, 210, pool)
+		tx.AssertExpectations(t)
+	})
+
+	t.Run("refunds every stake when nobody backed the winner", func(t *testing.T) {
+		ts := setupService(nil, false)
+		g := sideBetGamble(domain.SideBet{UserID: "s1", BackedUserID: "bob-id", Amount: 40})
+		tx := new(MockTx)
+		tx.On("GetSideBets", ctx, g.ID).Return(g.SideBets, nil)
+		ts.repo.On("GetItemByName", ctx, domain.ItemMoney).Return(&domain.Item{ID: testMoneyID}, nil)
+		tx.On("GetInventory", ctx, "s1").Return(&domain.Inventory{}, nil)
+		tx.On("UpdateInventory", ctx, "s1", *moneyInventory(40)).Return(nil)
+		tx.On("SettleSideBet", ctx, g.ID, "s1", 40).Return(nil)
+
+		pool, err := ts.svc.(*service).settleSideBets(ctx, tx, g, "alice-id")
+
+		require.NoError(t, err)
+		assert.Equal(t, 40, pool)
+		tx.AssertExpectations(t)
+		tx.AssertNotCalled(t, "AddToCommunityPool", mock.Anything, mock.Anything)
+	})
+
+	t.Run("settles bets placed after the gamble was loaded", func(t *testing.T) {
+		ts := setupService(nil, false)
+		g := sideBetGamble()
+		tx := new(MockTx)
+		tx.On("GetSideBets", ctx, g.ID).Return([]domain.SideBet{{UserID: "s1", BackedUserID: "bob-id", Amount: 40}}, nil)
+		ts.repo.On("GetItemByName", ctx, domain.ItemMoney).Return(&domain.Item{ID: testMoneyID}, nil)
+		tx.On("GetInventory", ctx, "s1").Return(&domain.Inventory{}, nil)
+		tx.On("UpdateInventory", ctx, "s1", *moneyInventory(40)).Return(nil)
+		tx.On("SettleSideBet", ctx, g.ID, "s1", 40).Return(nil)
+
+		pool, err := ts.svc.(*service).settleSideBets(ctx, tx, g, "")
+
+		require.NoError(t, err)
+		assert.Equal(t, 40, pool)
+		tx.AssertExpectations(t)
+	})
+
+	t.Run("does nothing without side bets", func(t *testing.T) {
+		ts := setupService(nil, false)
+		g := sideBetGamble()
+		tx := new(MockTx)
+		tx.On("GetSideBets", ctx, g.ID).Return(nil, nil)
+
+		pool, err := ts.svc.(*service).settleSideBets(ctx, tx, g, "alice-id")
+
+		require.NoError(t, err)
+		assert.Zero(t, pool)
+		ts.repo.AssertNotCalled(t, "GetItemByName", mock.Anything, mock.Anything)
+	})
+}
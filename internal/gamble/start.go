@@ -6,9 +6,9 @@ import (
 	"fmt"
 	"time"
 
-	"github.com/google/uuid"
-
+	"github.com/osse101/BrandishBot_Go/internal/community"
 	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/ids"
 	"github.com/osse101/BrandishBot_Go/internal/logger"
 	"github.com/osse101/BrandishBot_Go/internal/naming"
 	"github.com/osse101/BrandishBot_Go/internal/repository"
@@ -35,15 +35,16 @@ func (s *service) StartGamble(ctx context.Context, platform, platformID, usernam
 		return nil, err
 	}
 
-	gamble := s.createGambleRecord(user.ID)
-
-	// Validate bets and resolve item names to IDs
-	resolvedItemIDs, err := s.validateGambleBets(ctx, bets)
-	if err != nil {
-		return nil, err
-	}
+	gamble := s.createGambleRecord(community.FromContext(ctx), user.ID)
 
-	if err := s.executeGambleStartTx(ctx, user.ID, username, bets, resolvedItemIDs, gamble); err != nil {
+	if err := s.withStartCooldown(ctx, user.ID, func() error {
+		// Validate bets and resolve item names to IDs
+		resolvedItemIDs, err := s.validateGambleBets(ctx, bets)
+		if err != nil {
+			return err
+		}
+		return s.executeGambleStartTx(ctx, user.ID, username, bets, resolvedItemIDs, gamble)
+	}); err != nil {
 		return nil, err
 	}
 
@@ -53,13 +54,23 @@ func (s *service) StartGamble(ctx context.Context, platform, platformID, usernam
 	return gamble, nil
 }
 
-func (s *service) createGambleRecord(initiatorID string) *domain.Gamble {
+// withStartCooldown runs fn under the user's gamble start cooldown. A failed
+// start does not use up the cooldown.
+func (s *service) withStartCooldown(ctx context.Context, userID string, fn func() error) error {
+	if s.cooldowns == nil {
+		return fn()
+	}
+	return s.cooldowns.EnforceCooldown(ctx, userID, domain.ActionGambleStart, fn)
+}
+
+func (s *service) createGambleRecord(communityID, initiatorID string) *domain.Gamble {
 	return &domain.Gamble{
-		ID:           uuid.New(),
+		ID:           ids.New(),
 		InitiatorID:  initiatorID,
 		State:        domain.GambleStateJoining,
 		CreatedAt:    time.Now(),
 		JoinDeadline: time.Now().Add(s.joinDuration),
+		CommunityID:  communityID,
 	}
 }
 
@@ -37,6 +37,7 @@ func TestUpgradeGambleWinBonus_ExistingImplementation(t *testing.T) {
 	tx := new(MockTx)
 	ts.repo.On("BeginGambleTx", ctx).Return(tx, nil)
 	tx.On("UpdateGambleStateIfMatches", ctx, gambleID, domain.GambleStateJoining, domain.GambleStateOpening).Return(int64(1), nil)
+	tx.On("GetSideBets", ctx, gambleID).Return(nil, nil)
 
 	// Mock item resolution
 	lootboxItem := &domain.Item{ID: 1, InternalName: domain.ItemLootbox1}
@@ -46,7 +47,7 @@ func TestUpgradeGambleWinBonus_ExistingImplementation(t *testing.T) {
 
 	// Mock lootbox drop (value 100)
 	drops := []lootbox.DroppedItem{{ItemID: 10, Quantity: 1, Value: 100}}
-	ts.lootboxSvc.On("OpenLootbox", ctx, domain.ItemLootbox1, 1, mock.Anything).Return(drops, nil)
+	ts.lootboxSvc.On("OpenLootbox", ctx, mock.Anything, domain.ItemLootbox1, 1, mock.Anything).Return(drops, nil)
 
 	// Mock Progression Service: 1.25x bonus (100 -> 125)
 	ts.progressionSvc.On("GetModifiedValue", ctx, ProgressionFeatureGambleWinBonus, float64(100)).Return(float64(125), nil)
@@ -88,6 +89,7 @@ func TestUpgradeGambleWinBonus_AllGambleTypes(t *testing.T) {
 	tx := new(MockTx)
 	ts.repo.On("BeginGambleTx", ctx).Return(tx, nil)
 	tx.On("UpdateGambleStateIfMatches", ctx, gambleID, domain.GambleStateJoining, domain.GambleStateOpening).Return(int64(1), nil)
+	tx.On("GetSideBets", ctx, gambleID).Return(nil, nil)
 
 	lootboxItem := &domain.Item{ID: 1, InternalName: domain.ItemLootbox1}
 	ts.namingResolver.On("ResolvePublicName", domain.ItemLootbox1).Return("", false)
@@ -102,8 +104,8 @@ func TestUpgradeGambleWinBonus_AllGambleTypes(t *testing.T) {
 	drops2 := []lootbox.DroppedItem{
 		{ItemID: 10, Quantity: 1, Value: 100},
 	}
-	ts.lootboxSvc.On("OpenLootbox", ctx, domain.ItemLootbox1, 2, mock.Anything).Return(drops1, nil)
-	ts.lootboxSvc.On("OpenLootbox", ctx, domain.ItemLootbox1, 1, mock.Anything).Return(drops2, nil)
+	ts.lootboxSvc.On("OpenLootbox", ctx, mock.Anything, domain.ItemLootbox1, 2, mock.Anything).Return(drops1, nil)
+	ts.lootboxSvc.On("OpenLootbox", ctx, mock.Anything, domain.ItemLootbox1, 1, mock.Anything).Return(drops2, nil)
 
 	// Mock Progression Service
 	ts.progressionSvc.On("GetModifiedValue", ctx, ProgressionFeatureGambleWinBonus, float64(100)).Return(float64(110), nil) // 1.1x
@@ -144,6 +146,7 @@ func TestUpgradeGambleWinBonus_MultipleParticipants(t *testing.T) {
 	tx := new(MockTx)
 	ts.repo.On("BeginGambleTx", ctx).Return(tx, nil)
 	tx.On("UpdateGambleStateIfMatches", ctx, gambleID, domain.GambleStateJoining, domain.GambleStateOpening).Return(int64(1), nil)
+	tx.On("GetSideBets", ctx, gambleID).Return(nil, nil)
 
 	lootboxItem := &domain.Item{ID: 1, InternalName: domain.ItemLootbox1}
 	ts.namingResolver.On("ResolvePublicName", domain.ItemLootbox1).Return("", false)
@@ -152,7 +155,7 @@ func TestUpgradeGambleWinBonus_MultipleParticipants(t *testing.T) {
 
 	// User1 gets 100, User2 gets 200. Winner is User2.
 	drops := []lootbox.DroppedItem{{ItemID: 10, Quantity: 1, Value: 100}}
-	ts.lootboxSvc.On("OpenLootbox", ctx, domain.ItemLootbox1, 1, mock.Anything).Return(drops, nil).Twice()
+	ts.lootboxSvc.On("OpenLootbox", ctx, mock.Anything, domain.ItemLootbox1, 1, mock.Anything).Return(drops, nil).Twice()
 
 	// Mock Progression Service - called for BOTH participants during calculation
 	ts.progressionSvc.On("GetModifiedValue", ctx, ProgressionFeatureGambleWinBonus, float64(100)).Return(float64(150), nil).Twice()
@@ -191,6 +194,7 @@ func TestUpgradeGambleWinBonus_ModifierFailureFallback(t *testing.T) {
 	tx := new(MockTx)
 	ts.repo.On("BeginGambleTx", ctx).Return(tx, nil)
 	tx.On("UpdateGambleStateIfMatches", ctx, gambleID, domain.GambleStateJoining, domain.GambleStateOpening).Return(int64(1), nil)
+	tx.On("GetSideBets", ctx, gambleID).Return(nil, nil)
 
 	lootboxItem := &domain.Item{ID: 1, InternalName: domain.ItemLootbox1}
 	ts.namingResolver.On("ResolvePublicName", domain.ItemLootbox1).Return("", false)
@@ -198,7 +202,7 @@ func TestUpgradeGambleWinBonus_ModifierFailureFallback(t *testing.T) {
 	ts.repo.On("GetItemByID", ctx, 1).Return(lootboxItem, nil)
 
 	drops := []lootbox.DroppedItem{{ItemID: 10, Quantity: 1, Value: 100}}
-	ts.lootboxSvc.On("OpenLootbox", ctx, domain.ItemLootbox1, 1, mock.Anything).Return(drops, nil).Twice()
+	ts.lootboxSvc.On("OpenLootbox", ctx, mock.Anything, domain.ItemLootbox1, 1, mock.Anything).Return(drops, nil).Twice()
 
 	// Mock Progression Service Failure
 	ts.progressionSvc.On("GetModifiedValue", ctx, ProgressionFeatureGambleWinBonus, float64(100)).Return(float64(0), assert.AnError).Twice()
@@ -241,6 +245,7 @@ func TestUpgradeGambleWinBonus_NearMissInteraction(t *testing.T) {
 	tx := new(MockTx)
 	ts.repo.On("BeginGambleTx", ctx).Return(tx, nil)
 	tx.On("UpdateGambleStateIfMatches", ctx, gambleID, domain.GambleStateJoining, domain.GambleStateOpening).Return(int64(1), nil)
+	tx.On("GetSideBets", ctx, gambleID).Return(nil, nil)
 
 	lootboxItem := &domain.Item{ID: 1, InternalName: domain.ItemLootbox1}
 	ts.namingResolver.On("ResolvePublicName", domain.ItemLootbox1).Return("", false)
@@ -250,8 +255,8 @@ func TestUpgradeGambleWinBonus_NearMissInteraction(t *testing.T) {
 	dropsWinner := []lootbox.DroppedItem{{ItemID: 10, Quantity: 1, Value: 100}}
 	dropsLoser := []lootbox.DroppedItem{{ItemID: 10, Quantity: 1, Value: 95}}
 
-	ts.lootboxSvc.On("OpenLootbox", ctx, domain.ItemLootbox1, 1, mock.Anything).Return(dropsWinner, nil).Once()
-	ts.lootboxSvc.On("OpenLootbox", ctx, domain.ItemLootbox1, 1, mock.Anything).Return(dropsLoser, nil).Once()
+	ts.lootboxSvc.On("OpenLootbox", ctx, mock.Anything, domain.ItemLootbox1, 1, mock.Anything).Return(dropsWinner, nil).Once()
+	ts.lootboxSvc.On("OpenLootbox", ctx, mock.Anything, domain.ItemLootbox1, 1, mock.Anything).Return(dropsLoser, nil).Once()
 
 	ts.progressionSvc.On("GetModifiedValue", ctx, ProgressionFeatureGambleWinBonus, float64(100)).Return(float64(125), nil)
 	ts.progressionSvc.On("GetModifiedValue", ctx, ProgressionFeatureGambleWinBonus, float64(95)).Return(float64(118), nil)
@@ -0,0 +1,121 @@
+package gamble
+
+import (
+	"context"
+	"sync"
+
+	"github.com/google/uuid"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/event"
+	"github.com/osse101/BrandishBot_Go/internal/logger"
+)
+
+// Watcher tracks in-process waiters for gamble state changes.
+// It subscribes to gamble lifecycle events on the event bus and wakes any
+// long-poll requests waiting on the affected gamble.
+type Watcher struct {
+	mu      sync.Mutex
+	waiters map[uuid.UUID]map[chan struct{}]struct{}
+}
+
+// NewWatcher creates a new gamble Watcher
+func NewWatcher() *Watcher {
+	return &Watcher{
+		waiters: make(map[uuid.UUID]map[chan struct{}]struct{}),
+	}
+}
+
+// Subscribe registers the watcher for gamble lifecycle events. Shared
+// subscriptions are used so long-polls on any instance wake up.
+func (w *Watcher) Subscribe(bus event.Bus) {
+	event.SubscribeShared(bus, event.Type(domain.EventGambleStarted), w.handleGambleStarted)
+	event.SubscribeShared(bus, event.Type(domain.EventTypeGambleParticipated), w.handleGambleParticipated)
+	event.SubscribeShared(bus, event.Type(domain.EventGambleCompleted), w.handleGambleCompleted)
+}
+
+// Watch registers interest in changes to the given gamble. The returned channel
+// is closed on the next state change. The cancel function must be called once
+// the caller stops waiting so the registration is released.
+func (w *Watcher) Watch(gambleID uuid.UUID) (<-chan struct{}, func()) {
+	ch := make(chan struct{})
+
+	w.mu.Lock()
+	set, ok := w.waiters[gambleID]
+	if !ok {
+		set = make(map[chan struct{}]struct{})
+		w.waiters[gambleID] = set
+	}
+	set[ch] = struct{}{}
+	w.mu.Unlock()
+
+	cancel := func() {
+		w.mu.Lock()
+		defer w.mu.Unlock()
+		if set, ok := w.waiters[gambleID]; ok {
+			delete(set, ch)
+			if len(set) == 0 {
+				delete(w.waiters, gambleID)
+			}
+		}
+	}
+	return ch, cancel
+}
+
+// Notify wakes every waiter registered for the given gamble
+func (w *Watcher) Notify(gambleID uuid.UUID) {
+	w.mu.Lock()
+	set := w.waiters[gambleID]
+	delete(w.waiters, gambleID)
+	w.mu.Unlock()
+
+	for ch := range set {
+		close(ch)
+	}
+}
+
+// WaiterCount returns the number of active waiters for a gamble
+func (w *Watcher) WaiterCount(gambleID uuid.UUID) int {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return len(w.waiters[gambleID])
+}
+
+func (w *Watcher) handleGambleStarted(ctx context.Context, evt event.Event) error {
+	g, err := event.DecodePayload[*domain.Gamble](evt.Payload)
+	if err != nil || g == nil {
+		logger.FromContext(ctx).Warn(LogMsgInvalidWatcherPayload, "type", evt.Type, "error", err)
+		return nil
+	}
+	w.Notify(g.ID)
+	return nil
+}
+
+func (w *Watcher) handleGambleParticipated(ctx context.Context, evt event.Event) error {
+	payload, err := event.DecodePayload[domain.GambleParticipatedPayload](evt.Payload)
+	if err != nil {
+		logger.FromContext(ctx).Warn(LogMsgInvalidWatcherPayload, "type", evt.Type, "error", err)
+		return nil
+	}
+	w.notifyString(ctx, payload.GambleID)
+	return nil
+}
+
+func (w *Watcher) handleGambleCompleted(ctx context.Context, evt event.Event) error {
+	payload, err := event.DecodePayload[domain.GambleCompletedPayloadV2](evt.Payload)
+	if err != nil {
+		logger.FromContext(ctx).Warn(LogMsgInvalidWatcherPayload, "type", evt.Type, "error", err)
+		return nil
+	}
+	w.notifyString(ctx, payload.GambleID)
+	return nil
+}
+
+func (w *Watcher) notifyString(ctx context.Context, gambleID string) {
+	id, err := uuid.Parse(gambleID)
+	if err != nil {
+		logger.FromContext(ctx).Warn(LogMsgInvalidWatcherPayload, "gamble_id", gambleID, "error", err)
+		return
+	}
+	w.Notify(id)
+}
@@ -0,0 +1,89 @@
+package gamble
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/event"
+)
+
+func TestWatcher_NotifiesOnGambleEvents(t *testing.T) {
+	bus := event.NewMemoryBus()
+	w := NewWatcher()
+	w.Subscribe(bus)
+
+	gambleID := uuid.New()
+
+	tests := []struct {
+		name string
+		evt  event.Event
+	}{
+		{
+			name: "started",
+			evt:  event.Event{Type: event.Type(domain.EventGambleStarted), Payload: &domain.Gamble{ID: gambleID}},
+		},
+		{
+			name: "participated",
+			evt: event.Event{
+				Type:    event.Type(domain.EventTypeGambleParticipated),
+				Payload: domain.GambleParticipatedPayload{GambleID: gambleID.String()},
+			},
+		},
+		{
+			name: "completed",
+			evt:  event.NewGambleCompletedEvent(gambleID.String(), "", "", 0, 0, nil, nil),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ch, cancel := w.Watch(gambleID)
+			defer cancel()
+
+			assert.NoError(t, bus.Publish(context.Background(), tt.evt))
+
+			select {
+			case <-ch:
+			case <-time.After(time.Second):
+				t.Fatal("watcher was not notified")
+			}
+			assert.Equal(t, 0, w.WaiterCount(gambleID))
+		})
+	}
+}
+
+func TestWatcher_IgnoresOtherGambles(t *testing.T) {
+	w := NewWatcher()
+	watched := uuid.New()
+
+	ch, cancel := w.Watch(watched)
+	defer cancel()
+
+	w.Notify(uuid.New())
+
+	select {
+	case <-ch:
+		t.Fatal("watcher notified for unrelated gamble")
+	default:
+	}
+	assert.Equal(t, 1, w.WaiterCount(watched))
+}
+
+func TestWatcher_CancelReleasesWaiter(t *testing.T) {
+	w := NewWatcher()
+	id := uuid.New()
+
+	_, cancel := w.Watch(id)
+	assert.Equal(t, 1, w.WaiterCount(id))
+
+	cancel()
+	assert.Equal(t, 0, w.WaiterCount(id))
+
+	// Notify after cancel must not panic
+	w.Notify(id)
+}
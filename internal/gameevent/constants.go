@@ -0,0 +1,36 @@
+package gameevent
+
+import (
+	"regexp"
+	"time"
+)
+
+// Defaults and limits
+const (
+	// DefaultCacheTTL is how long the snapshot of running and upcoming events
+	// is reused before the database is asked again. An event created on
+	// another instance takes up to this long to apply on this one.
+	DefaultCacheTTL = 30 * time.Second
+	// NoMultiplier leaves XP or drop rates unchanged
+	NoMultiplier = 1.0
+	// MaxMultiplier caps each event's XP and drop rate multipliers
+	MaxMultiplier = 5.0
+	// MaxShopDiscountPercent caps the shop discount of one event and of all
+	// running events together
+	MaxShopDiscountPercent = 90
+	// MaxNameLength caps event names
+	MaxNameLength = 100
+	// MaxThemeLength caps event theme names
+	MaxThemeLength = 32
+)
+
+// themePattern matches event theme names, which are written like naming
+// theme and season pack names
+var themePattern = regexp.MustCompile(`^[a-z0-9_]{1,32}$`)
+
+// Log messages
+const (
+	LogMsgEventCreated  = "Game event created"
+	LogMsgEventEnded    = "Game event ended"
+	LogMsgRefreshFailed = "Failed to refresh game events, using previous snapshot"
+)
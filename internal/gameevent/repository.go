@@ -0,0 +1,24 @@
+package gameevent
+
+import (
+	"context"
+	"time"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+)
+
+// Repository stores game events
+type Repository interface {
+	// ListEvents returns the events that end after endsAfter, ordered by start
+	ListEvents(ctx context.Context, endsAfter time.Time) ([]domain.GameEvent, error)
+
+	// GetEvent returns the event with the ID, or nil if there is none
+	GetEvent(ctx context.Context, id int64) (*domain.GameEvent, error)
+
+	// CreateEvent stores a new event and returns it with its ID
+	CreateEvent(ctx context.Context, evt domain.GameEvent) (*domain.GameEvent, error)
+
+	// EndEvent moves an event's end to at, and its start too if it had not
+	// begun. It returns nil if the event does not exist or had already ended.
+	EndEvent(ctx context.Context, id int64, at time.Time) (*domain.GameEvent, error)
+}
@@ -0,0 +1,286 @@
+// Package gameevent runs time-boxed events, such as a double XP weekend or a
+// shop sale, and reports the modifiers of the ones running now. Each
+// community schedules its own events.
+package gameevent
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/osse101/BrandishBot_Go/internal/community"
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/logger"
+)
+
+// ModifierSource reports the combined modifiers of the game events running
+// now. The economy, lootbox, job and search services read it through their
+// WithGameEvents options.
+type ModifierSource interface {
+	// Modifiers never fails: with no events running, or none that could be
+	// loaded, it returns multipliers of 1 and no discount.
+	Modifiers(ctx context.Context) domain.GameModifiers
+}
+
+// Service schedules game events and reports what they change
+type Service interface {
+	ModifierSource
+
+	// ListEvents returns events ordered by start. Events that have ended are
+	// left out unless includeEnded is set.
+	ListEvents(ctx context.Context, includeEnded bool) ([]domain.GameEvent, error)
+
+	// GetStatus returns the events running now and their combined modifiers
+	GetStatus(ctx context.Context) (*domain.GameEventStatus, error)
+
+	// CreateEvent starts an event now or schedules it for later
+	CreateEvent(ctx context.Context, req CreateRequest) (*domain.GameEvent, error)
+
+	// EndEvent stops a running event, or cancels one that has not started.
+	// It returns domain.ErrGameEventNotFound for unknown IDs and
+	// domain.ErrGameEventEnded for events that are already over.
+	EndEvent(ctx context.Context, id int64) (*domain.GameEvent, error)
+}
+
+// CreateRequest describes a new event. A zero StartsAt starts it now. A
+// multiplier of 0 is treated as 1.
+type CreateRequest struct {
+	Name                string
+	Description         string
+	XPMultiplier        float64
+	DropRateMultiplier  float64
+	ShopDiscountPercent int
+	Theme               string
+	StartsAt            time.Time
+	EndsAt              time.Time
+	CreatedBy           string
+}
+
+// Config tunes game events
+type Config struct {
+	// CacheTTL is how long the event snapshot is reused
+	CacheTTL time.Duration
+}
+
+type service struct {
+	repo Repository
+	cfg  Config
+	now  func() time.Time
+
+	mu        sync.Mutex
+	snapshots map[string]*snapshot // by community
+}
+
+// snapshot holds the events of one community that had not ended when loaded,
+// by start
+type snapshot struct {
+	upcoming []domain.GameEvent
+	loaded   bool
+	loadedAt time.Time
+}
+
+// NewService creates a game event service
+func NewService(repo Repository, cfg Config) Service {
+	if cfg.CacheTTL <= 0 {
+		cfg.CacheTTL = DefaultCacheTTL
+	}
+	return &service{
+		repo:      repo,
+		cfg:       cfg,
+		now:       time.Now,
+		snapshots: make(map[string]*snapshot),
+	}
+}
+
+// ListEvents returns events straight from the repository
+func (s *service) ListEvents(ctx context.Context, includeEnded bool) ([]domain.GameEvent, error) {
+	var endsAfter time.Time
+	if !includeEnded {
+		endsAfter = s.now()
+	}
+	return s.repo.ListEvents(ctx, endsAfter)
+}
+
+// GetStatus reads the running events from the repository rather than the
+// snapshot, so admins see a change as soon as it is made
+func (s *service) GetStatus(ctx context.Context) (*domain.GameEventStatus, error) {
+	now := s.now()
+	events, err := s.repo.ListEvents(ctx, now)
+	if err != nil {
+		return nil, err
+	}
+	running := activeEvents(events, now)
+	if running == nil {
+		running = []domain.GameEvent{}
+	}
+	return &domain.GameEventStatus{Events: running, Modifiers: combine(running)}, nil
+}
+
+// CreateEvent validates and stores an event, then drops the snapshot so it
+// applies on this instance straight away
+func (s *service) CreateEvent(ctx context.Context, req CreateRequest) (*domain.GameEvent, error) {
+	now := s.now()
+	if req.StartsAt.IsZero() {
+		req.StartsAt = now
+	}
+	if req.XPMultiplier == 0 {
+		req.XPMultiplier = NoMultiplier
+	}
+	if req.DropRateMultiplier == 0 {
+		req.DropRateMultiplier = NoMultiplier
+	}
+	if err := validateCreateRequest(req, now); err != nil {
+		return nil, err
+	}
+
+	evt, err := s.repo.CreateEvent(ctx, domain.GameEvent{
+		Name:                req.Name,
+		Description:         req.Description,
+		XPMultiplier:        req.XPMultiplier,
+		DropRateMultiplier:  req.DropRateMultiplier,
+		ShopDiscountPercent: req.ShopDiscountPercent,
+		Theme:               req.Theme,
+		StartsAt:            req.StartsAt,
+		EndsAt:              req.EndsAt,
+		CreatedBy:           req.CreatedBy,
+	})
+	if err != nil {
+		return nil, err
+	}
+	s.invalidate(ctx)
+
+	logger.FromContext(ctx).Info(LogMsgEventCreated, "id", evt.ID, "name", evt.Name, "xp_multiplier", evt.XPMultiplier, "drop_rate_multiplier", evt.DropRateMultiplier, "shop_discount_percent", evt.ShopDiscountPercent, "theme", evt.Theme, "starts_at", evt.StartsAt, "ends_at", evt.EndsAt, "created_by", evt.CreatedBy)
+	return evt, nil
+}
+
+// EndEvent ends an event now and drops the snapshot
+func (s *service) EndEvent(ctx context.Context, id int64) (*domain.GameEvent, error) {
+	existing, err := s.repo.GetEvent(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if existing == nil {
+		return nil, domain.ErrGameEventNotFound
+	}
+
+	evt, err := s.repo.EndEvent(ctx, id, s.now())
+	if err != nil {
+		return nil, err
+	}
+	if evt == nil {
+		return nil, domain.ErrGameEventEnded
+	}
+	s.invalidate(ctx)
+
+	logger.FromContext(ctx).Info(LogMsgEventEnded, "id", evt.ID, "name", evt.Name)
+	return evt, nil
+}
+
+// Modifiers combines the running events of the context's community from the
+// cached snapshot. If the snapshot cannot be refreshed the previous one keeps
+// being used; with no snapshot at all nothing is modified.
+func (s *service) Modifiers(ctx context.Context) domain.GameModifiers {
+	now := s.now()
+	return combine(activeEvents(s.snapshot(ctx, now), now))
+}
+
+// snapshot returns the events of the context's community that had not ended
+// at the last refresh. The caller filters them by time, so scheduled events
+// start on the second rather than at the next refresh.
+func (s *service) snapshot(ctx context.Context, now time.Time) []domain.GameEvent {
+	id := community.FromContext(ctx)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	snap, ok := s.snapshots[id]
+	if !ok {
+		snap = &snapshot{}
+		s.snapshots[id] = snap
+	}
+	if !snap.loaded || now.Sub(snap.loadedAt) >= s.cfg.CacheTTL {
+		events, err := s.repo.ListEvents(ctx, now)
+		if err != nil {
+			logger.FromContext(ctx).Warn(LogMsgRefreshFailed, "error", err)
+		} else {
+			snap.upcoming = events
+			snap.loaded = true
+			snap.loadedAt = now
+		}
+	}
+	return snap.upcoming
+}
+
+// invalidate drops the snapshot of the context's community
+func (s *service) invalidate(ctx context.Context) {
+	s.mu.Lock()
+	if snap, ok := s.snapshots[community.FromContext(ctx)]; ok {
+		snap.loadedAt = time.Time{}
+	}
+	s.mu.Unlock()
+}
+
+// activeEvents keeps the events running at now
+func activeEvents(events []domain.GameEvent, now time.Time) []domain.GameEvent {
+	var active []domain.GameEvent
+	for _, evt := range events {
+		if evt.IsActive(now) {
+			active = append(active, evt)
+		}
+	}
+	return active
+}
+
+// combine multiplies the events' multipliers together, adds up their
+// discounts, up to MaxShopDiscountPercent, and collects their themes
+func combine(events []domain.GameEvent) domain.GameModifiers {
+	mods := domain.GameModifiers{
+		XPMultiplier:       NoMultiplier,
+		DropRateMultiplier: NoMultiplier,
+		Events:             []string{},
+		Themes:             []string{},
+	}
+	for _, evt := range events {
+		mods.XPMultiplier *= evt.XPMultiplier
+		mods.DropRateMultiplier *= evt.DropRateMultiplier
+		mods.ShopDiscountPercent += evt.ShopDiscountPercent
+		mods.Events = append(mods.Events, evt.Name)
+		if evt.Theme != "" && !mods.HasTheme(evt.Theme) {
+			mods.Themes = append(mods.Themes, evt.Theme)
+		}
+	}
+	mods.ShopDiscountPercent = min(mods.ShopDiscountPercent, MaxShopDiscountPercent)
+	return mods
+}
+
+func validateCreateRequest(req CreateRequest, now time.Time) error {
+	if req.Name == "" || len(req.Name) > MaxNameLength {
+		return fmt.Errorf("%w: name must be 1-%d characters", domain.ErrInvalidInput, MaxNameLength)
+	}
+	if req.CreatedBy == "" {
+		return fmt.Errorf("%w: created_by is required", domain.ErrInvalidInput)
+	}
+	if req.XPMultiplier < NoMultiplier || req.XPMultiplier > MaxMultiplier {
+		return fmt.Errorf("%w: xp_multiplier must be between %.0f and %.0f", domain.ErrInvalidInput, NoMultiplier, MaxMultiplier)
+	}
+	if req.DropRateMultiplier < NoMultiplier || req.DropRateMultiplier > MaxMultiplier {
+		return fmt.Errorf("%w: drop_rate_multiplier must be between %.0f and %.0f", domain.ErrInvalidInput, NoMultiplier, MaxMultiplier)
+	}
+	if req.ShopDiscountPercent < 0 || req.ShopDiscountPercent > MaxShopDiscountPercent {
+		return fmt.Errorf("%w: shop_discount_percent must be between 0 and %d", domain.ErrInvalidInput, MaxShopDiscountPercent)
+	}
+	if req.Theme != "" && !themePattern.MatchString(req.Theme) {
+		return fmt.Errorf("%w: theme must be 1-%d lowercase letters, digits or '_'", domain.ErrInvalidInput, MaxThemeLength)
+	}
+	if req.XPMultiplier == NoMultiplier && req.DropRateMultiplier == NoMultiplier && req.ShopDiscountPercent == 0 && req.Theme == "" {
+		return fmt.Errorf("%w: an event must change XP, drop rates or shop prices, or run a theme", domain.ErrInvalidInput)
+	}
+	if !req.EndsAt.After(req.StartsAt) {
+		return fmt.Errorf("%w: ends_at must be after starts_at", domain.ErrInvalidInput)
+	}
+	if !req.EndsAt.After(now) {
+		return fmt.Errorf("%w: ends_at must be in the future", domain.ErrInvalidInput)
+	}
+	return nil
+}
@@ -0,0 +1,168 @@
+package gameevent_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/osse101/BrandishBot_Go/internal/community"
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/gameevent"
+	"github.com/osse101/BrandishBot_Go/internal/gameevent/mocks"
+)
+
+func TestCreateEvent(t *testing.T) {
+	ctx := context.Background()
+	tomorrow := time.Now().Add(24 * time.Hour)
+
+	t.Run("starts now and leaves unset multipliers at 1", func(t *testing.T) {
+		repo := mocks.NewMockRepository(t)
+		svc := gameevent.NewService(repo, gameevent.Config{})
+		repo.On("CreateEvent", ctx, mock.Anything).Return(func(_ context.Context, evt domain.GameEvent) (*domain.GameEvent, error) {
+			evt.ID = 1
+			return &evt, nil
+		})
+
+		evt, err := svc.CreateEvent(ctx, gameevent.CreateRequest{Name: "Double XP Weekend", XPMultiplier: 2, EndsAt: tomorrow, CreatedBy: "admin"})
+
+		require.NoError(t, err)
+		assert.Equal(t, 2.0, evt.XPMultiplier)
+		assert.Equal(t, 1.0, evt.DropRateMultiplier)
+		assert.False(t, evt.StartsAt.IsZero())
+	})
+
+	t.Run("an event may only run a theme", func(t *testing.T) {
+		repo := mocks.NewMockRepository(t)
+		repo.On("CreateEvent", ctx, mock.MatchedBy(func(evt domain.GameEvent) bool {
+			return evt.Theme == "emote_fest"
+		})).Return(&domain.GameEvent{ID: 1, Theme: "emote_fest"}, nil)
+
+		evt, err := gameevent.NewService(repo, gameevent.Config{}).CreateEvent(ctx, gameevent.CreateRequest{Name: "Emote Fest", Theme: "emote_fest", EndsAt: tomorrow, CreatedBy: "admin"})
+
+		require.NoError(t, err)
+		assert.Equal(t, "emote_fest", evt.Theme)
+	})
+
+	tests := []struct {
+		name string
+		req  gameevent.CreateRequest
+	}{
+		{"missing name", gameevent.CreateRequest{XPMultiplier: 2, EndsAt: tomorrow, CreatedBy: "admin"}},
+		{"missing creator", gameevent.CreateRequest{Name: "Double XP", XPMultiplier: 2, EndsAt: tomorrow}},
+		{"no modifiers", gameevent.CreateRequest{Name: "Nothing", EndsAt: tomorrow, CreatedBy: "admin"}},
+		{"bad theme", gameevent.CreateRequest{Name: "Emotes", Theme: "Emote Fest", EndsAt: tomorrow, CreatedBy: "admin"}},
+		{"multiplier below 1", gameevent.CreateRequest{Name: "Half XP", XPMultiplier: 0.5, EndsAt: tomorrow, CreatedBy: "admin"}},
+		{"multiplier too high", gameevent.CreateRequest{Name: "Huge drops", DropRateMultiplier: 10, EndsAt: tomorrow, CreatedBy: "admin"}},
+		{"discount too high", gameevent.CreateRequest{Name: "Free shop", ShopDiscountPercent: 100, EndsAt: tomorrow, CreatedBy: "admin"}},
+		{"ends before it starts", gameevent.CreateRequest{Name: "Sale", ShopDiscountPercent: 20, StartsAt: tomorrow, EndsAt: tomorrow.Add(-time.Hour), CreatedBy: "admin"}},
+		{"already over", gameevent.CreateRequest{Name: "Sale", ShopDiscountPercent: 20, StartsAt: time.Now().Add(-2 * time.Hour), EndsAt: time.Now().Add(-time.Hour), CreatedBy: "admin"}},
+	}
+	for _, tt := range tests {
+		t.Run("rejects "+tt.name, func(t *testing.T) {
+			svc := gameevent.NewService(mocks.NewMockRepository(t), gameevent.Config{})
+
+			_, err := svc.CreateEvent(ctx, tt.req)
+
+			assert.ErrorIs(t, err, domain.ErrInvalidInput)
+		})
+	}
+}
+
+func TestEndEvent(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("unknown event", func(t *testing.T) {
+		repo := mocks.NewMockRepository(t)
+		repo.On("GetEvent", ctx, int64(7)).Return(nil, nil)
+
+		_, err := gameevent.NewService(repo, gameevent.Config{}).EndEvent(ctx, 7)
+
+		assert.ErrorIs(t, err, domain.ErrGameEventNotFound)
+	})
+
+	t.Run("already ended", func(t *testing.T) {
+		repo := mocks.NewMockRepository(t)
+		repo.On("GetEvent", ctx, int64(7)).Return(&domain.GameEvent{ID: 7}, nil)
+		repo.On("EndEvent", ctx, int64(7), mock.Anything).Return(nil, nil)
+
+		_, err := gameevent.NewService(repo, gameevent.Config{}).EndEvent(ctx, 7)
+
+		assert.ErrorIs(t, err, domain.ErrGameEventEnded)
+	})
+}
+
+func TestModifiers(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+
+	t.Run("combines running events and skips scheduled ones", func(t *testing.T) {
+		repo := mocks.NewMockRepository(t)
+		repo.On("ListEvents", ctx, mock.Anything).Return([]domain.GameEvent{
+			{Name: "Double XP", XPMultiplier: 2, DropRateMultiplier: 1, ShopDiscountPercent: 60, StartsAt: now.Add(-time.Hour), EndsAt: now.Add(time.Hour)},
+			{Name: "Lucky Hour", XPMultiplier: 1.5, DropRateMultiplier: 1.5, ShopDiscountPercent: 50, StartsAt: now.Add(-time.Minute), EndsAt: now.Add(time.Hour)},
+			{Name: "Next Week", XPMultiplier: 3, DropRateMultiplier: 1, StartsAt: now.Add(7 * 24 * time.Hour), EndsAt: now.Add(8 * 24 * time.Hour)},
+		}, nil).Once()
+		svc := gameevent.NewService(repo, gameevent.Config{})
+
+		mods := svc.Modifiers(ctx)
+
+		assert.Equal(t, 3.0, mods.XPMultiplier)
+		assert.Equal(t, 1.5, mods.DropRateMultiplier)
+		assert.Equal(t, gameevent.MaxShopDiscountPercent, mods.ShopDiscountPercent)
+		assert.Equal(t, []string{"Double XP", "Lucky Hour"}, mods.Events)
+		assert.Empty(t, mods.Themes)
+
+		// The snapshot is reused until it expires
+		svc.Modifiers(ctx)
+	})
+
+	t.Run("each community has its own snapshot", func(t *testing.T) {
+		alpha := community.WithID(ctx, "alpha")
+		repo := mocks.NewMockRepository(t)
+		repo.On("ListEvents", ctx, mock.Anything).Return([]domain.GameEvent{
+			{Name: "Double XP", XPMultiplier: 2, DropRateMultiplier: 1, StartsAt: now.Add(-time.Hour), EndsAt: now.Add(time.Hour)},
+		}, nil).Once()
+		repo.On("ListEvents", alpha, mock.Anything).Return([]domain.GameEvent{}, nil).Once()
+		svc := gameevent.NewService(repo, gameevent.Config{})
+
+		assert.Equal(t, 2.0, svc.Modifiers(ctx).XPMultiplier)
+		assert.Equal(t, 1.0, svc.Modifiers(alpha).XPMultiplier)
+	})
+
+	t.Run("nothing changes when events cannot be loaded", func(t *testing.T) {
+		repo := mocks.NewMockRepository(t)
+		repo.On("ListEvents", ctx, mock.Anything).Return(nil, errors.New("db down"))
+
+		mods := gameevent.NewService(repo, gameevent.Config{}).Modifiers(ctx)
+
+		assert.Equal(t, 1.0, mods.XPMultiplier)
+		assert.Equal(t, 1.0, mods.DropRateMultiplier)
+		assert.Zero(t, mods.ShopDiscountPercent)
+	})
+}
+
+type datedTheme string
+
+func (d datedTheme) GetActiveTheme() string { return string(d) }
+
+func TestThemeResolver(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	repo := mocks.NewMockRepository(t)
+	repo.On("ListEvents", ctx, mock.Anything).Return([]domain.GameEvent{
+		{Name: "Emote Fest", XPMultiplier: 1, DropRateMultiplier: 1, Theme: "emote_fest", StartsAt: now.Add(-time.Hour), EndsAt: now.Add(time.Hour)},
+		{Name: "Next Fest", XPMultiplier: 1, DropRateMultiplier: 1, Theme: "next_fest", StartsAt: now.Add(time.Hour), EndsAt: now.Add(2 * time.Hour)},
+	}, nil).Maybe()
+	resolver := gameevent.NewThemeResolver(datedTheme("spring_festival"), gameevent.NewService(repo, gameevent.Config{}))
+
+	assert.True(t, resolver.IsThemeActive(ctx, "spring_festival"), "the dated theme runs")
+	assert.True(t, resolver.IsThemeActive(ctx, "emote_fest"), "a running game event runs its theme")
+	assert.False(t, resolver.IsThemeActive(ctx, "next_fest"), "a scheduled event does not run its theme yet")
+	assert.False(t, resolver.IsThemeActive(ctx, ""))
+	assert.False(t, gameevent.NewThemeResolver(nil, nil).IsThemeActive(ctx, "emote_fest"))
+}
@@ -0,0 +1,34 @@
+package gameevent
+
+import "context"
+
+// DatedThemes reports the naming theme that is active by date, such as a
+// holiday theme or a running season pack
+type DatedThemes interface {
+	GetActiveTheme() string
+}
+
+// ThemeResolver reports whether an event theme is running. A theme runs
+// while its dated naming theme is active, which covers season packs, or
+// while a game event of the context's community runs it. Crafting uses it to
+// open event recipes and chat drops to open keyword drops.
+type ThemeResolver struct {
+	dated  DatedThemes
+	events ModifierSource
+}
+
+// NewThemeResolver creates a theme resolver. Either source may be nil.
+func NewThemeResolver(dated DatedThemes, events ModifierSource) *ThemeResolver {
+	return &ThemeResolver{dated: dated, events: events}
+}
+
+// IsThemeActive reports whether theme is running in the context's community
+func (r *ThemeResolver) IsThemeActive(ctx context.Context, theme string) bool {
+	if theme == "" {
+		return false
+	}
+	if r.dated != nil && r.dated.GetActiveTheme() == theme {
+		return true
+	}
+	return r.events != nil && r.events.Modifiers(ctx).HasTheme(theme)
+}
@@ -0,0 +1,14 @@
+package gamestate
+
+// Slot names used in logs
+const (
+	SlotActiveGamble   = "active_gamble"
+	SlotVotingSession  = "voting_session"
+	SlotUnlockProgress = "unlock_progress"
+)
+
+// Log messages
+const (
+	LogMsgReloadFailed             = "Failed to load game state"
+	LogMsgReloadFailedServingStale = "Failed to reload game state, serving last known value"
+)
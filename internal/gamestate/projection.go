@@ -0,0 +1,255 @@
+package gamestate
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/osse101/BrandishBot_Go/internal/community"
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/event"
+	"github.com/osse101/BrandishBot_Go/internal/logger"
+)
+
+// GambleSource loads the active gamble from the source of truth
+type GambleSource interface {
+	GetActiveGamble(ctx context.Context) (*domain.Gamble, error)
+}
+
+// ProgressionSource loads voting and unlock state from the source of truth
+type ProgressionSource interface {
+	GetActiveVotingSession(ctx context.Context) (*domain.ProgressionVotingSession, error)
+	GetUnlockProgress(ctx context.Context) (*domain.UnlockProgress, error)
+}
+
+// Projection keeps hot game state (active gamble, current voting session,
+// unlock progress) in memory for status polling.
+//
+// Each value is loaded from its source on first use, then served from memory
+// until an event or a local write marks it stale, or it is older than maxAge.
+// maxAge bounds staleness for changes that publish no event. If a reload fails
+// the last known value is served and the reload is retried on the next read.
+//
+// Values are kept per community, chosen by the community on the context.
+//
+// Returned values are shared between callers and must not be modified.
+type Projection struct {
+	gambles     GambleSource
+	progression ProgressionSource
+	maxAge      time.Duration
+
+	mu          sync.Mutex
+	communities map[string]*communityState
+}
+
+// communityState holds the projected values of one community
+type communityState struct {
+	gamble   *slot[*domain.Gamble]
+	session  *slot[*domain.ProgressionVotingSession]
+	progress *slot[*domain.UnlockProgress]
+}
+
+// NewProjection creates a projection over the given sources
+func NewProjection(gambles GambleSource, progression ProgressionSource, maxAge time.Duration) *Projection {
+	return &Projection{
+		gambles:     gambles,
+		progression: progression,
+		maxAge:      maxAge,
+		communities: make(map[string]*communityState),
+	}
+}
+
+// state returns the values of the context's community, creating empty slots
+// on first use
+func (p *Projection) state(ctx context.Context) *communityState {
+	id := community.FromContext(ctx)
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if st, ok := p.communities[id]; ok {
+		return st
+	}
+	st := &communityState{
+		gamble:   newSlot(SlotActiveGamble, p.maxAge, p.gambles.GetActiveGamble),
+		session:  newSlot(SlotVotingSession, p.maxAge, p.progression.GetActiveVotingSession),
+		progress: newSlot(SlotUnlockProgress, p.maxAge, p.progression.GetUnlockProgress),
+	}
+	p.communities[id] = st
+	return st
+}
+
+// Rebuild reloads every value of the context's community from the database.
+// Called on startup so the first polls are served from memory. Failures are
+// logged and left for the next read to retry.
+func (p *Projection) Rebuild(ctx context.Context) {
+	st := p.state(ctx)
+	st.gamble.reload(ctx)
+	st.session.reload(ctx)
+	st.progress.reload(ctx)
+}
+
+// Subscribe marks values stale when events change them. Shared subscriptions
+// are used so writes on other instances are seen too.
+func (p *Projection) Subscribe(bus event.Bus) {
+	for _, t := range []event.Type{
+		event.Type(domain.EventGambleStarted),
+		event.Type(domain.EventGambleCompleted),
+		event.Type(domain.EventTypeGambleParticipated),
+	} {
+		event.SubscribeShared(bus, t, p.handleGambleChanged)
+	}
+
+	for _, t := range []event.Type{
+		event.ProgressionVotingStarted,
+		event.ProgressionTargetSet,
+		event.ProgressionCycleCompleted,
+		event.ProgressionNodeUnlocked,
+		event.ProgressionNodeRelocked,
+		event.ProgressionAllUnlocked,
+		event.ProgressionVoteReviewed,
+	} {
+		event.SubscribeShared(bus, t, p.handleProgressionChanged)
+	}
+
+	// Every engagement adds contribution towards the current unlock
+	event.SubscribeShared(bus, event.Type(domain.EventTypeEngagement), p.handleContribution)
+}
+
+// GetActiveGamble returns the active gamble, or nil if there is none
+func (p *Projection) GetActiveGamble(ctx context.Context) (*domain.Gamble, error) {
+	return p.state(ctx).gamble.get(ctx)
+}
+
+// GetActiveVotingSession returns the current voting session, or nil if there is none
+func (p *Projection) GetActiveVotingSession(ctx context.Context) (*domain.ProgressionVotingSession, error) {
+	return p.state(ctx).session.get(ctx)
+}
+
+// GetUnlockProgress returns progress towards the current unlock, or nil if there is none
+func (p *Projection) GetUnlockProgress(ctx context.Context) (*domain.UnlockProgress, error) {
+	return p.state(ctx).progress.get(ctx)
+}
+
+// PeekActiveGamble returns the cached active gamble without reloading. ok is
+// false when nothing fresh is cached.
+func (p *Projection) PeekActiveGamble(ctx context.Context) (value *domain.Gamble, ok bool) {
+	return p.state(ctx).gamble.peek()
+}
+
+// PeekVotingSession returns the cached voting session without reloading
+func (p *Projection) PeekVotingSession(ctx context.Context) (value *domain.ProgressionVotingSession, ok bool) {
+	return p.state(ctx).session.peek()
+}
+
+// PeekUnlockProgress returns the cached unlock progress without reloading
+func (p *Projection) PeekUnlockProgress(ctx context.Context) (value *domain.UnlockProgress, ok bool) {
+	return p.state(ctx).progress.peek()
+}
+
+// InvalidateGamble marks the active gamble stale
+func (p *Projection) InvalidateGamble(ctx context.Context) {
+	p.state(ctx).gamble.invalidate()
+}
+
+// InvalidateProgression marks the voting session and unlock progress stale
+func (p *Projection) InvalidateProgression(ctx context.Context) {
+	st := p.state(ctx)
+	st.session.invalidate()
+	st.progress.invalidate()
+}
+
+// invalidateAllGambles marks the active gamble of every community stale
+func (p *Projection) invalidateAllGambles() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for _, st := range p.communities {
+		st.gamble.invalidate()
+	}
+}
+
+func (p *Projection) handleGambleChanged(ctx context.Context, _ event.Event) error {
+	p.InvalidateGamble(ctx)
+	return nil
+}
+
+func (p *Projection) handleProgressionChanged(ctx context.Context, _ event.Event) error {
+	p.InvalidateProgression(ctx)
+	return nil
+}
+
+func (p *Projection) handleContribution(ctx context.Context, _ event.Event) error {
+	p.state(ctx).progress.invalidate()
+	return nil
+}
+
+// slot holds one projected value and reloads it when stale
+type slot[T any] struct {
+	name   string
+	maxAge time.Duration
+	load   func(context.Context) (T, error)
+
+	mu       sync.Mutex
+	value    T
+	loaded   bool
+	stale    bool
+	loadedAt time.Time
+}
+
+func newSlot[T any](name string, maxAge time.Duration, load func(context.Context) (T, error)) *slot[T] {
+	return &slot[T]{name: name, maxAge: maxAge, load: load}
+}
+
+func (s *slot[T]) get(ctx context.Context) (T, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.loaded && !s.stale && time.Since(s.loadedAt) < s.maxAge {
+		return s.value, nil
+	}
+	return s.reloadLocked(ctx)
+}
+
+func (s *slot[T]) reload(ctx context.Context) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	_, _ = s.reloadLocked(ctx)
+}
+
+// reloadLocked loads from the source. Holding the lock while loading means
+// concurrent pollers wait for one query instead of each issuing their own, and
+// an invalidation arriving mid-load is applied after it rather than lost.
+func (s *slot[T]) reloadLocked(ctx context.Context) (T, error) {
+	value, err := s.load(ctx)
+	if err != nil {
+		if s.loaded {
+			logger.FromContext(ctx).Warn(LogMsgReloadFailedServingStale, "slot", s.name, "error", err)
+			return s.value, nil
+		}
+		logger.FromContext(ctx).Error(LogMsgReloadFailed, "slot", s.name, "error", err)
+		var zero T
+		return zero, err
+	}
+
+	s.value = value
+	s.loaded = true
+	s.stale = false
+	s.loadedAt = time.Now()
+	return value, nil
+}
+
+func (s *slot[T]) peek() (T, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !s.loaded || s.stale || time.Since(s.loadedAt) >= s.maxAge {
+		var zero T
+		return zero, false
+	}
+	return s.value, true
+}
+
+func (s *slot[T]) invalidate() {
+	s.mu.Lock()
+	s.stale = true
+	s.mu.Unlock()
+}
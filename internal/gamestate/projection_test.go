@@ -0,0 +1,247 @@
+package gamestate
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/osse101/BrandishBot_Go/internal/community"
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/event"
+	"github.com/osse101/BrandishBot_Go/mocks"
+)
+
+func newTestProjection(t *testing.T, maxAge time.Duration) (*Projection, *mocks.MockGambleService, *mocks.MockProgressionService) {
+	t.Helper()
+	gambles := mocks.NewMockGambleService(t)
+	progression := mocks.NewMockProgressionService(t)
+	return NewProjection(gambles, progression, maxAge), gambles, progression
+}
+
+func TestProjection_ServesFromMemory(t *testing.T) {
+	p, gambles, _ := newTestProjection(t, time.Minute)
+	ctx := context.Background()
+	active := &domain.Gamble{ID: uuid.New(), State: domain.GambleStateJoining}
+
+	gambles.On("GetActiveGamble", mock.Anything).Return(active, nil).Once()
+
+	for i := 0; i < 3; i++ {
+		got, err := p.GetActiveGamble(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, active, got)
+	}
+}
+
+func TestProjection_CachesNoActiveGamble(t *testing.T) {
+	p, gambles, _ := newTestProjection(t, time.Minute)
+	ctx := context.Background()
+
+	gambles.On("GetActiveGamble", mock.Anything).Return(nil, nil).Once()
+
+	for i := 0; i < 2; i++ {
+		got, err := p.GetActiveGamble(ctx)
+		require.NoError(t, err)
+		assert.Nil(t, got)
+	}
+}
+
+func TestProjection_ReloadsAfterMaxAge(t *testing.T) {
+	p, _, progression := newTestProjection(t, 10*time.Millisecond)
+	ctx := context.Background()
+
+	progression.On("GetUnlockProgress", mock.Anything).Return(&domain.UnlockProgress{ContributionsAccumulated: 10}, nil).Once()
+	progression.On("GetUnlockProgress", mock.Anything).Return(&domain.UnlockProgress{ContributionsAccumulated: 25}, nil).Once()
+
+	got, err := p.GetUnlockProgress(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 10, got.ContributionsAccumulated)
+
+	time.Sleep(20 * time.Millisecond)
+
+	got, err = p.GetUnlockProgress(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 25, got.ContributionsAccumulated)
+}
+
+func TestProjection_EventsInvalidate(t *testing.T) {
+	p, gambles, progression := newTestProjection(t, time.Hour)
+	ctx := context.Background()
+	bus := event.NewMemoryBus()
+	p.Subscribe(bus)
+
+	gambles.On("GetActiveGamble", mock.Anything).Return(nil, nil).Once()
+	progression.On("GetActiveVotingSession", mock.Anything).Return(nil, nil).Once()
+	progression.On("GetUnlockProgress", mock.Anything).Return(nil, nil).Once()
+	p.Rebuild(ctx)
+
+	// Served from memory until an event arrives
+	_, err := p.GetActiveGamble(ctx)
+	require.NoError(t, err)
+
+	started := &domain.Gamble{ID: uuid.New(), State: domain.GambleStateJoining}
+	gambles.On("GetActiveGamble", mock.Anything).Return(started, nil).Once()
+	require.NoError(t, bus.Publish(ctx, event.Event{Type: event.Type(domain.EventGambleStarted)}))
+
+	got, err := p.GetActiveGamble(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, started, got)
+
+	// An engagement only touches unlock progress
+	progression.On("GetUnlockProgress", mock.Anything).Return(&domain.UnlockProgress{ContributionsAccumulated: 5}, nil).Once()
+	require.NoError(t, bus.Publish(ctx, event.Event{Type: event.Type(domain.EventTypeEngagement)}))
+
+	progress, err := p.GetUnlockProgress(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 5, progress.ContributionsAccumulated)
+	_, err = p.GetActiveVotingSession(ctx)
+	require.NoError(t, err)
+
+	// Progression events refresh both the session and progress
+	session := &domain.ProgressionVotingSession{ID: 7}
+	progression.On("GetActiveVotingSession", mock.Anything).Return(session, nil).Once()
+	progression.On("GetUnlockProgress", mock.Anything).Return(nil, nil).Once()
+	require.NoError(t, bus.Publish(ctx, event.Event{Type: event.ProgressionVotingStarted}))
+
+	gotSession, err := p.GetActiveVotingSession(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, session, gotSession)
+	progress, err = p.GetUnlockProgress(ctx)
+	require.NoError(t, err)
+	assert.Nil(t, progress)
+}
+
+func TestProjection_ServesStaleOnReloadError(t *testing.T) {
+	p, gambles, _ := newTestProjection(t, time.Hour)
+	ctx := context.Background()
+	active := &domain.Gamble{ID: uuid.New()}
+
+	gambles.On("GetActiveGamble", mock.Anything).Return(active, nil).Once()
+	gambles.On("GetActiveGamble", mock.Anything).Return(nil, errors.New("db down")).Once()
+	gambles.On("GetActiveGamble", mock.Anything).Return(nil, nil).Once()
+
+	_, err := p.GetActiveGamble(ctx)
+	require.NoError(t, err)
+
+	p.InvalidateGamble(ctx)
+	got, err := p.GetActiveGamble(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, active, got, "last known value should be served while the database is unavailable")
+
+	// The failed reload is retried on the next read
+	got, err = p.GetActiveGamble(ctx)
+	require.NoError(t, err)
+	assert.Nil(t, got)
+}
+
+func TestProjection_ErrorWithoutPriorValue(t *testing.T) {
+	p, gambles, _ := newTestProjection(t, time.Hour)
+	ctx := context.Background()
+
+	gambles.On("GetActiveGamble", mock.Anything).Return(nil, errors.New("db down")).Once()
+
+	// A failed rebuild leaves nothing cached, so the read surfaces the error
+	p.state(ctx).gamble.reload(ctx)
+	gambles.On("GetActiveGamble", mock.Anything).Return(nil, errors.New("db down")).Once()
+	_, err := p.GetActiveGamble(ctx)
+	assert.Error(t, err)
+}
+
+func TestProjection_PerCommunity(t *testing.T) {
+	p, gambles, _ := newTestProjection(t, time.Hour)
+	ctx := context.Background()
+	alpha := community.WithID(ctx, "alpha")
+	active := &domain.Gamble{ID: uuid.New()}
+
+	gambles.On("GetActiveGamble", mock.MatchedBy(func(c context.Context) bool {
+		return community.FromContext(c) == community.Default
+	})).Return(active, nil).Once()
+	gambles.On("GetActiveGamble", mock.MatchedBy(func(c context.Context) bool {
+		return community.FromContext(c) == "alpha"
+	})).Return(nil, nil).Once()
+
+	got, err := p.GetActiveGamble(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, active, got)
+
+	got, err = p.GetActiveGamble(alpha)
+	require.NoError(t, err)
+	assert.Nil(t, got, "each community has its own active gamble")
+
+	// Invalidating one community leaves the other cached
+	p.InvalidateGamble(alpha)
+	_, ok := p.PeekActiveGamble(ctx)
+	assert.True(t, ok)
+	_, ok = p.PeekActiveGamble(alpha)
+	assert.False(t, ok)
+}
+
+func TestGambleService_WritesInvalidate(t *testing.T) {
+	p, gambles, _ := newTestProjection(t, time.Hour)
+	ctx := context.Background()
+	svc := p.GambleService(gambles)
+
+	gambles.On("GetActiveGamble", mock.Anything).Return(nil, nil).Once()
+	got, err := svc.GetActiveGamble(ctx)
+	require.NoError(t, err)
+	assert.Nil(t, got)
+
+	started := &domain.Gamble{ID: uuid.New()}
+	gambles.On("StartGamble", mock.Anything, domain.PlatformTwitch, "123", "alice", mock.Anything).Return(started, nil).Once()
+	gambles.On("GetActiveGamble", mock.Anything).Return(started, nil).Once()
+
+	_, err = svc.StartGamble(ctx, domain.PlatformTwitch, "123", "alice", nil)
+	require.NoError(t, err)
+
+	got, err = svc.GetActiveGamble(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, started, got)
+}
+
+func TestProgressionService_VoteInvalidates(t *testing.T) {
+	p, _, progression := newTestProjection(t, time.Hour)
+	ctx := context.Background()
+	svc := p.ProgressionService(progression)
+
+	before := &domain.ProgressionVotingSession{ID: 1}
+	after := &domain.ProgressionVotingSession{ID: 1, Options: []domain.ProgressionVotingOption{{VoteCount: 1}}}
+	progression.On("GetActiveVotingSession", mock.Anything).Return(before, nil).Once()
+	progression.On("VoteForUnlock", mock.Anything, domain.PlatformTwitch, "123", "alice", 1).Return(nil).Once()
+	progression.On("GetActiveVotingSession", mock.Anything).Return(after, nil).Once()
+
+	got, err := svc.GetActiveVotingSession(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, before, got)
+
+	require.NoError(t, svc.VoteForUnlock(ctx, domain.PlatformTwitch, "123", "alice", 1))
+
+	got, err = svc.GetActiveVotingSession(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, after, got)
+}
+
+func TestProjection_Peek(t *testing.T) {
+	p, gambles, _ := newTestProjection(t, time.Hour)
+	ctx := context.Background()
+	active := &domain.Gamble{ID: uuid.New()}
+
+	_, ok := p.PeekActiveGamble(ctx)
+	assert.False(t, ok, "nothing loaded yet")
+
+	gambles.On("GetActiveGamble", mock.Anything).Return(active, nil).Once()
+	_, err := p.GetActiveGamble(ctx)
+	require.NoError(t, err)
+
+	got, ok := p.PeekActiveGamble(ctx)
+	assert.True(t, ok)
+	assert.Equal(t, active, got)
+
+	p.InvalidateGamble(ctx)
+	_, ok = p.PeekActiveGamble(ctx)
+	assert.False(t, ok)
+}
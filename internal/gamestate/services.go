@@ -0,0 +1,135 @@
+package gamestate
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/gamble"
+	"github.com/osse101/BrandishBot_Go/internal/progression"
+)
+
+// GambleService wraps svc so active gamble reads come from the projection and
+// writes made through it invalidate the projection immediately, without
+// waiting for the event round trip.
+func (p *Projection) GambleService(svc gamble.Service) gamble.Service {
+	return &gambleService{Service: svc, projection: p}
+}
+
+// ProgressionService wraps svc so voting session and unlock progress reads
+// come from the projection and writes made through it invalidate it.
+func (p *Projection) ProgressionService(svc progression.Service) progression.Service {
+	return &progressionService{Service: svc, projection: p}
+}
+
+type gambleService struct {
+	gamble.Service
+	projection *Projection
+}
+
+func (s *gambleService) GetActiveGamble(ctx context.Context) (*domain.Gamble, error) {
+	return s.projection.GetActiveGamble(ctx)
+}
+
+func (s *gambleService) StartGamble(ctx context.Context, platform, platformID, username string, bets []domain.LootboxBet) (*domain.Gamble, error) {
+	defer s.projection.InvalidateGamble(ctx)
+	return s.Service.StartGamble(ctx, platform, platformID, username, bets)
+}
+
+func (s *gambleService) JoinGamble(ctx context.Context, gambleID uuid.UUID, platform, platformID, username string) error {
+	defer s.projection.InvalidateGamble(ctx)
+	return s.Service.JoinGamble(ctx, gambleID, platform, platformID, username)
+}
+
+func (s *gambleService) JoinActiveGamble(ctx context.Context, platform, platformID, username string) error {
+	defer s.projection.InvalidateGamble(ctx)
+	return s.Service.JoinActiveGamble(ctx, platform, platformID, username)
+}
+
+func (s *gambleService) ExecuteGamble(ctx context.Context, id uuid.UUID) (*domain.GambleResult, error) {
+	defer s.projection.InvalidateGamble(ctx)
+	return s.Service.ExecuteGamble(ctx, id)
+}
+
+func (s *gambleService) RecoverStaleGambles(ctx context.Context, staleAfter time.Duration) ([]domain.GambleRecovery, error) {
+	// Recovery resolves gambles in every community
+	defer s.projection.invalidateAllGambles()
+	return s.Service.RecoverStaleGambles(ctx, staleAfter)
+}
+
+type progressionService struct {
+	progression.Service
+	projection *Projection
+}
+
+func (s *progressionService) GetActiveVotingSession(ctx context.Context) (*domain.ProgressionVotingSession, error) {
+	return s.projection.GetActiveVotingSession(ctx)
+}
+
+func (s *progressionService) GetUnlockProgress(ctx context.Context) (*domain.UnlockProgress, error) {
+	return s.projection.GetUnlockProgress(ctx)
+}
+
+// Individual votes and admin contributions publish no event, so invalidating
+// here is what keeps vote counts fresh on this instance
+func (s *progressionService) VoteForUnlock(ctx context.Context, platform, platformID, username string, optionIndex int) error {
+	defer s.projection.InvalidateProgression(ctx)
+	return s.Service.VoteForUnlock(ctx, platform, platformID, username, optionIndex)
+}
+
+func (s *progressionService) AddContribution(ctx context.Context, amount int) error {
+	defer s.projection.InvalidateProgression(ctx)
+	return s.Service.AddContribution(ctx, amount)
+}
+
+func (s *progressionService) AddExternalContribution(ctx context.Context, contribution domain.ExternalContribution) (*domain.ExternalContributionResult, error) {
+	defer s.projection.InvalidateProgression(ctx)
+	return s.Service.AddExternalContribution(ctx, contribution)
+}
+
+func (s *progressionService) StartVotingSession(ctx context.Context, unlockedNodeID *int) error {
+	defer s.projection.InvalidateProgression(ctx)
+	return s.Service.StartVotingSession(ctx, unlockedNodeID)
+}
+
+func (s *progressionService) EndVoting(ctx context.Context) (*domain.ProgressionVotingOption, error) {
+	defer s.projection.InvalidateProgression(ctx)
+	return s.Service.EndVoting(ctx)
+}
+
+func (s *progressionService) ForceInstantUnlock(ctx context.Context) (*domain.ProgressionUnlock, error) {
+	defer s.projection.InvalidateProgression(ctx)
+	return s.Service.ForceInstantUnlock(ctx)
+}
+
+func (s *progressionService) AdminUnlock(ctx context.Context, nodeKey string, level int) error {
+	defer s.projection.InvalidateProgression(ctx)
+	return s.Service.AdminUnlock(ctx, nodeKey, level)
+}
+
+func (s *progressionService) AdminUnlockAll(ctx context.Context) error {
+	defer s.projection.InvalidateProgression(ctx)
+	return s.Service.AdminUnlockAll(ctx)
+}
+
+func (s *progressionService) AdminRelock(ctx context.Context, nodeKey string, level int) error {
+	defer s.projection.InvalidateProgression(ctx)
+	return s.Service.AdminRelock(ctx, nodeKey, level)
+}
+
+func (s *progressionService) AdminFreezeVoting(ctx context.Context) error {
+	defer s.projection.InvalidateProgression(ctx)
+	return s.Service.AdminFreezeVoting(ctx)
+}
+
+func (s *progressionService) AdminStartVoting(ctx context.Context) error {
+	defer s.projection.InvalidateProgression(ctx)
+	return s.Service.AdminStartVoting(ctx)
+}
+
+func (s *progressionService) ResetProgressionTree(ctx context.Context, resetBy string, reason string, preserveUserData bool) error {
+	defer s.projection.InvalidateProgression(ctx)
+	return s.Service.ResetProgressionTree(ctx, resetBy, reason, preserveUserData)
+}
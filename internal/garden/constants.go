@@ -0,0 +1,27 @@
+package garden
+
+// BasePlots is how many plots every user can plant before any upgrades
+const BasePlots = 2
+
+// featureGardenPlots is the progression modifier adding plots on top of
+// BasePlots
+const featureGardenPlots = "garden_plots"
+
+// Error messages
+const (
+	ErrMsgCheckFeatureFailed = "failed to check garden feature: %w"
+	ErrMsgGetSeedFailed      = "failed to look up seed: %w"
+	ErrMsgGetPlotsFailed     = "failed to get garden: %w"
+	ErrMsgPlantFailed        = "failed to plant: %w"
+	ErrMsgHarvestFailed      = "failed to harvest garden: %w"
+	ErrMsgGrantCropFailed    = "failed to grant crop: %w"
+	ErrMsgCropItemMissing    = "crop item %s does not exist"
+)
+
+// Log messages
+const (
+	LogMsgPlanted           = "User planted a seed"
+	LogMsgHarvested         = "User harvested their garden"
+	LogWarnSeedRefundFailed = "Failed to refund seed after a failed planting"
+	LogWarnCapacityFailed   = "Failed to get garden plot modifier, using base plots"
+)
@@ -0,0 +1,19 @@
+package garden
+
+import "time"
+
+// Seed is what a seed item grows into
+type Seed struct {
+	Crop     string        // Item harvested from the plot
+	Yield    int           // How many of the crop one seed gives
+	GrowTime time.Duration // Real time from planting to harvest
+	XP       int           // Farmer XP for harvesting it
+}
+
+// Seeds maps each plantable item to its crop, quickest first
+var Seeds = map[string]Seed{
+	"seed_wheat":      {Crop: "crop_wheat", Yield: 3, GrowTime: 30 * time.Minute, XP: 5},
+	"seed_carrot":     {Crop: "crop_carrot", Yield: 3, GrowTime: 2 * time.Hour, XP: 10},
+	"seed_pumpkin":    {Crop: "crop_pumpkin", Yield: 2, GrowTime: 8 * time.Hour, XP: 25},
+	"seed_moonflower": {Crop: "crop_moonflower", Yield: 1, GrowTime: 24 * time.Hour, XP: 60},
+}
@@ -0,0 +1,235 @@
+// Package garden runs each user's idle garden. A seed planted in one of the
+// user's plots grows in real time into a crop. Nothing runs while it grows:
+// a plot is ready once its ready_at has passed, which is worked out whenever
+// the garden is looked at or harvested.
+package garden
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/event"
+	"github.com/osse101/BrandishBot_Go/internal/logger"
+	"github.com/osse101/BrandishBot_Go/internal/progression"
+	"github.com/osse101/BrandishBot_Go/internal/repository"
+)
+
+// Service runs the garden
+type Service interface {
+	// GetGarden returns the user's plots, marking the ready ones, and how
+	// many plots they can plant
+	GetGarden(ctx context.Context, platform, platformID string) (*domain.Garden, error)
+
+	// Plant uses up one seed and plants it in the user's lowest free plot
+	Plant(ctx context.Context, platform, platformID, username, seed string) (*domain.GardenPlot, error)
+
+	// Harvest clears every ready plot and puts its crops in the user's
+	// inventory
+	Harvest(ctx context.Context, platform, platformID, username string) (*domain.GardenHarvest, error)
+}
+
+// UserService finds the gardener and moves seeds and crops in and out of
+// their inventory
+type UserService interface {
+	GetUserOrRegister(ctx context.Context, platform, platformID, username string) (*domain.User, error)
+	GetUserIDByPlatformID(ctx context.Context, platform, platformID string) (string, error)
+	GetItemByName(ctx context.Context, name string) (*domain.Item, error)
+	RemoveItemByUsername(ctx context.Context, platform, username, itemName string, quantity int) (int, error)
+	AddItemByUsername(ctx context.Context, platform, username, itemName string, quantity int) error
+	GrantItemReward(ctx context.Context, user *domain.User, item *domain.Item, quantity int, qualityLevel domain.QualityLevel) error
+}
+
+// Progression reports whether the garden has been unlocked and how many
+// plots upgrades have added
+type Progression interface {
+	IsFeatureUnlocked(ctx context.Context, featureKey string) (bool, error)
+	GetModifiedValue(ctx context.Context, userID string, featureKey string, baseValue float64) (float64, error)
+}
+
+// Publisher publishes harvest events
+type Publisher interface {
+	PublishWithRetry(ctx context.Context, evt event.Event)
+}
+
+type service struct {
+	repo        repository.GardenRepository
+	users       UserService
+	progression Progression
+	publisher   Publisher
+}
+
+// NewService creates a garden service
+func NewService(repo repository.GardenRepository, users UserService, progression Progression, publisher Publisher) Service {
+	return &service{
+		repo:        repo,
+		users:       users,
+		progression: progression,
+		publisher:   publisher,
+	}
+}
+
+func (s *service) GetGarden(ctx context.Context, platform, platformID string) (*domain.Garden, error) {
+	userID, err := s.users.GetUserIDByPlatformID(ctx, platform, platformID)
+	if err != nil {
+		return nil, err
+	}
+	if userID == "" {
+		return nil, domain.ErrUserNotFound
+	}
+
+	plots, err := s.repo.GetPlots(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf(ErrMsgGetPlotsFailed, err)
+	}
+	now := time.Now()
+	for i := range plots {
+		plots[i].Ready = !now.Before(plots[i].ReadyAt)
+	}
+
+	return &domain.Garden{
+		UserID:   userID,
+		Capacity: s.capacity(ctx, userID),
+		Plots:    plots,
+	}, nil
+}
+
+func (s *service) Plant(ctx context.Context, platform, platformID, username, seed string) (*domain.GardenPlot, error) {
+	if err := s.checkFeature(ctx); err != nil {
+		return nil, err
+	}
+
+	seed, growth, err := s.resolveSeed(ctx, seed)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := s.users.GetUserOrRegister(ctx, platform, platformID, username)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := s.users.RemoveItemByUsername(ctx, platform, user.Username, seed, 1); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	plot := &domain.GardenPlot{
+		Seed:      seed,
+		Crop:      growth.Crop,
+		Quantity:  growth.Yield,
+		PlantedAt: now,
+		ReadyAt:   now.Add(growth.GrowTime),
+	}
+	planted, err := s.repo.PlantPlot(ctx, user.ID, s.capacity(ctx, user.ID), plot)
+	if err == nil && !planted {
+		err = domain.ErrGardenFull
+	}
+	if err != nil {
+		if refundErr := s.users.AddItemByUsername(ctx, platform, user.Username, seed, 1); refundErr != nil {
+			logger.FromContext(ctx).Warn(LogWarnSeedRefundFailed, "user_id", user.ID, "seed", seed, "error", refundErr)
+		}
+		if errors.Is(err, domain.ErrGardenFull) {
+			return nil, err
+		}
+		return nil, fmt.Errorf(ErrMsgPlantFailed, err)
+	}
+
+	logger.FromContext(ctx).Info(LogMsgPlanted, "user_id", user.ID, "seed", seed, "slot", plot.Slot, "ready_at", plot.ReadyAt)
+	return plot, nil
+}
+
+func (s *service) Harvest(ctx context.Context, platform, platformID, username string) (*domain.GardenHarvest, error) {
+	user, err := s.users.GetUserOrRegister(ctx, platform, platformID, username)
+	if err != nil {
+		return nil, err
+	}
+
+	plots, err := s.repo.TakeReadyPlots(ctx, user.ID, time.Now())
+	if err != nil {
+		return nil, fmt.Errorf(ErrMsgHarvestFailed, err)
+	}
+	if len(plots) == 0 {
+		return nil, domain.ErrNothingToHarvest
+	}
+
+	result := &domain.GardenHarvest{
+		UserID:   user.ID,
+		Username: user.Username,
+		Plots:    len(plots),
+	}
+	crops := make(map[string]int)
+	for _, plot := range plots {
+		if crops[plot.Crop] == 0 {
+			result.Crops = append(result.Crops, domain.GardenCrop{ItemName: plot.Crop})
+		}
+		crops[plot.Crop] += plot.Quantity
+		result.XP += Seeds[plot.Seed].XP
+	}
+	for i := range result.Crops {
+		crop := &result.Crops[i]
+		crop.Quantity = crops[crop.ItemName]
+		if err := s.grantCrop(ctx, user, crop.ItemName, crop.Quantity); err != nil {
+			return nil, err
+		}
+	}
+
+	logger.FromContext(ctx).Info(LogMsgHarvested, "user_id", user.ID, "plots", result.Plots, "xp", result.XP)
+	if s.publisher != nil {
+		s.publisher.PublishWithRetry(ctx, event.NewGardenHarvestedEvent(result))
+	}
+	return result, nil
+}
+
+func (s *service) grantCrop(ctx context.Context, user *domain.User, crop string, quantity int) error {
+	item, err := s.users.GetItemByName(ctx, crop)
+	if err != nil {
+		return fmt.Errorf(ErrMsgGrantCropFailed, err)
+	}
+	if item == nil {
+		return fmt.Errorf(ErrMsgGrantCropFailed, fmt.Errorf(ErrMsgCropItemMissing, crop))
+	}
+	if err := s.users.GrantItemReward(ctx, user, item, quantity, domain.QualityCommon); err != nil {
+		return fmt.Errorf(ErrMsgGrantCropFailed, err)
+	}
+	return nil
+}
+
+// capacity is how many plots the user can plant: BasePlots plus any the
+// garden plot upgrades add
+func (s *service) capacity(ctx context.Context, userID string) int {
+	plots, err := s.progression.GetModifiedValue(ctx, userID, featureGardenPlots, BasePlots)
+	if err != nil {
+		logger.FromContext(ctx).Warn(LogWarnCapacityFailed, "user_id", userID, "error", err)
+		return BasePlots
+	}
+	return int(plots)
+}
+
+func (s *service) checkFeature(ctx context.Context) error {
+	unlocked, err := s.progression.IsFeatureUnlocked(ctx, progression.FeatureGarden)
+	if err != nil {
+		return fmt.Errorf(ErrMsgCheckFeatureFailed, err)
+	}
+	if !unlocked {
+		return fmt.Errorf("garden requires feature unlock: %w", domain.ErrFeatureLocked)
+	}
+	return nil
+}
+
+// resolveSeed resolves a seed name, internal or public, to its item and
+// what it grows into
+func (s *service) resolveSeed(ctx context.Context, name string) (string, Seed, error) {
+	item, err := s.users.GetItemByName(ctx, name)
+	if err != nil {
+		return "", Seed{}, fmt.Errorf(ErrMsgGetSeedFailed, err)
+	}
+	if item != nil {
+		if seed, ok := Seeds[item.InternalName]; ok {
+			return item.InternalName, seed, nil
+		}
+	}
+	return "", Seed{}, fmt.Errorf("%w: %s", domain.ErrUnknownSeed, name)
+}
@@ -0,0 +1,220 @@
+package garden_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/event"
+	"github.com/osse101/BrandishBot_Go/internal/garden"
+	gardenmocks "github.com/osse101/BrandishBot_Go/internal/garden/mocks"
+	"github.com/osse101/BrandishBot_Go/internal/progression"
+	"github.com/osse101/BrandishBot_Go/mocks"
+)
+
+const gardenerID = "gardener-1"
+
+type gardenFixture struct {
+	repo        *mocks.MockRepositoryGardenRepository
+	users       *gardenmocks.MockUserService
+	progression *gardenmocks.MockProgression
+	publisher   *gardenmocks.MockPublisher
+	svc         garden.Service
+}
+
+func newGardenFixture(t *testing.T) *gardenFixture {
+	f := &gardenFixture{
+		repo:        mocks.NewMockRepositoryGardenRepository(t),
+		users:       gardenmocks.NewMockUserService(t),
+		progression: gardenmocks.NewMockProgression(t),
+		publisher:   gardenmocks.NewMockPublisher(t),
+	}
+	f.svc = garden.NewService(f.repo, f.users, f.progression, f.publisher)
+	return f
+}
+
+func (f *gardenFixture) expectGardener(ctx context.Context) {
+	f.users.On("GetUserOrRegister", ctx, domain.PlatformDiscord, "d-1", "gardener").Return(&domain.User{ID: gardenerID, Username: "gardener"}, nil)
+}
+
+func (f *gardenFixture) expectPlots(ctx context.Context, plots float64) {
+	f.progression.On("GetModifiedValue", ctx, gardenerID, "garden_plots", float64(garden.BasePlots)).Return(plots, nil)
+}
+
+func TestGetGarden(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("marks the plots that are ready", func(t *testing.T) {
+		f := newGardenFixture(t)
+		f.users.On("GetUserIDByPlatformID", ctx, domain.PlatformDiscord, "d-1").Return(gardenerID, nil)
+		f.repo.On("GetPlots", ctx, gardenerID).Return([]domain.GardenPlot{
+			{Slot: 1, Seed: "seed_wheat", ReadyAt: time.Now().Add(-time.Minute)},
+			{Slot: 2, Seed: "seed_pumpkin", ReadyAt: time.Now().Add(time.Hour)},
+		}, nil)
+		f.expectPlots(ctx, 4)
+
+		g, err := f.svc.GetGarden(ctx, domain.PlatformDiscord, "d-1")
+
+		require.NoError(t, err)
+		assert.Equal(t, 4, g.Capacity)
+		require.Len(t, g.Plots, 2)
+		assert.True(t, g.Plots[0].Ready)
+		assert.False(t, g.Plots[1].Ready)
+	})
+
+	t.Run("unknown user", func(t *testing.T) {
+		f := newGardenFixture(t)
+		f.users.On("GetUserIDByPlatformID", ctx, domain.PlatformDiscord, "d-1").Return("", nil)
+
+		_, err := f.svc.GetGarden(ctx, domain.PlatformDiscord, "d-1")
+
+		assert.ErrorIs(t, err, domain.ErrUserNotFound)
+	})
+}
+
+func TestPlant(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("plants the seed in a free plot", func(t *testing.T) {
+		f := newGardenFixture(t)
+		f.progression.On("IsFeatureUnlocked", ctx, progression.FeatureGarden).Return(true, nil)
+		f.users.On("GetItemByName", ctx, "carrot seed").Return(&domain.Item{InternalName: "seed_carrot"}, nil)
+		f.expectGardener(ctx)
+		f.users.On("RemoveItemByUsername", ctx, domain.PlatformDiscord, "gardener", "seed_carrot", 1).Return(1, nil)
+		f.expectPlots(ctx, 2)
+		f.repo.On("PlantPlot", ctx, gardenerID, 2, mock.AnythingOfType("*domain.GardenPlot")).Return(
+			func(_ context.Context, _ string, _ int, plot *domain.GardenPlot) (bool, error) {
+				plot.Slot = 2
+				return true, nil
+			})
+
+		plot, err := f.svc.Plant(ctx, domain.PlatformDiscord, "d-1", "gardener", "carrot seed")
+
+		require.NoError(t, err)
+		assert.Equal(t, 2, plot.Slot)
+		assert.Equal(t, "crop_carrot", plot.Crop)
+		assert.Equal(t, garden.Seeds["seed_carrot"].Yield, plot.Quantity)
+		assert.Equal(t, garden.Seeds["seed_carrot"].GrowTime, plot.ReadyAt.Sub(plot.PlantedAt))
+	})
+
+	t.Run("a full garden refunds the seed", func(t *testing.T) {
+		f := newGardenFixture(t)
+		f.progression.On("IsFeatureUnlocked", ctx, progression.FeatureGarden).Return(true, nil)
+		f.users.On("GetItemByName", ctx, "seed_wheat").Return(&domain.Item{InternalName: "seed_wheat"}, nil)
+		f.expectGardener(ctx)
+		f.users.On("RemoveItemByUsername", ctx, domain.PlatformDiscord, "gardener", "seed_wheat", 1).Return(1, nil)
+		f.expectPlots(ctx, 2)
+		f.repo.On("PlantPlot", ctx, gardenerID, 2, mock.Anything).Return(false, nil)
+		f.users.On("AddItemByUsername", ctx, domain.PlatformDiscord, "gardener", "seed_wheat", 1).Return(nil)
+
+		_, err := f.svc.Plant(ctx, domain.PlatformDiscord, "d-1", "gardener", "seed_wheat")
+
+		assert.ErrorIs(t, err, domain.ErrGardenFull)
+	})
+
+	t.Run("only seeds can be planted", func(t *testing.T) {
+		f := newGardenFixture(t)
+		f.progression.On("IsFeatureUnlocked", ctx, progression.FeatureGarden).Return(true, nil)
+		f.users.On("GetItemByName", ctx, "money").Return(&domain.Item{InternalName: "money"}, nil)
+
+		_, err := f.svc.Plant(ctx, domain.PlatformDiscord, "d-1", "gardener", "money")
+
+		assert.ErrorIs(t, err, domain.ErrUnknownSeed)
+	})
+
+	t.Run("missing seed stops the planting", func(t *testing.T) {
+		f := newGardenFixture(t)
+		f.progression.On("IsFeatureUnlocked", ctx, progression.FeatureGarden).Return(true, nil)
+		f.users.On("GetItemByName", ctx, "seed_wheat").Return(&domain.Item{InternalName: "seed_wheat"}, nil)
+		f.expectGardener(ctx)
+		f.users.On("RemoveItemByUsername", ctx, domain.PlatformDiscord, "gardener", "seed_wheat", 1).Return(0, domain.ErrNotInInventory)
+
+		_, err := f.svc.Plant(ctx, domain.PlatformDiscord, "d-1", "gardener", "seed_wheat")
+
+		assert.ErrorIs(t, err, domain.ErrNotInInventory)
+	})
+
+	t.Run("locked until the garden is unlocked", func(t *testing.T) {
+		f := newGardenFixture(t)
+		f.progression.On("IsFeatureUnlocked", ctx, progression.FeatureGarden).Return(false, nil)
+
+		_, err := f.svc.Plant(ctx, domain.PlatformDiscord, "d-1", "gardener", "seed_wheat")
+
+		assert.ErrorIs(t, err, domain.ErrFeatureLocked)
+	})
+
+	t.Run("falls back to the base plots", func(t *testing.T) {
+		f := newGardenFixture(t)
+		f.progression.On("IsFeatureUnlocked", ctx, progression.FeatureGarden).Return(true, nil)
+		f.users.On("GetItemByName", ctx, "seed_wheat").Return(&domain.Item{InternalName: "seed_wheat"}, nil)
+		f.expectGardener(ctx)
+		f.users.On("RemoveItemByUsername", ctx, domain.PlatformDiscord, "gardener", "seed_wheat", 1).Return(1, nil)
+		f.progression.On("GetModifiedValue", ctx, gardenerID, "garden_plots", mock.Anything).Return(float64(0), errors.New("cache down"))
+		f.repo.On("PlantPlot", ctx, gardenerID, garden.BasePlots, mock.Anything).Return(true, nil)
+
+		_, err := f.svc.Plant(ctx, domain.PlatformDiscord, "d-1", "gardener", "seed_wheat")
+
+		assert.NoError(t, err)
+	})
+}
+
+func TestHarvest(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("grants every ready crop", func(t *testing.T) {
+		f := newGardenFixture(t)
+		f.expectGardener(ctx)
+		f.repo.On("TakeReadyPlots", ctx, gardenerID, mock.AnythingOfType("time.Time")).Return([]domain.GardenPlot{
+			{Slot: 1, Seed: "seed_wheat", Crop: "crop_wheat", Quantity: 3},
+			{Slot: 2, Seed: "seed_pumpkin", Crop: "crop_pumpkin", Quantity: 2},
+			{Slot: 3, Seed: "seed_wheat", Crop: "crop_wheat", Quantity: 3},
+		}, nil)
+		f.users.On("GetItemByName", ctx, mock.AnythingOfType("string")).Return(func(_ context.Context, name string) (*domain.Item, error) {
+			return &domain.Item{InternalName: name}, nil
+		})
+		f.users.On("GrantItemReward", ctx, mock.Anything, mock.MatchedBy(func(item *domain.Item) bool { return item.InternalName == "crop_wheat" }), 6, domain.QualityCommon).Return(nil)
+		f.users.On("GrantItemReward", ctx, mock.Anything, mock.MatchedBy(func(item *domain.Item) bool { return item.InternalName == "crop_pumpkin" }), 2, domain.QualityCommon).Return(nil)
+		wantXP := 2*garden.Seeds["seed_wheat"].XP + garden.Seeds["seed_pumpkin"].XP
+		f.publisher.On("PublishWithRetry", ctx, mock.MatchedBy(func(evt event.Event) bool {
+			payload, ok := evt.Payload.(event.GardenHarvestedPayloadV1)
+			return evt.Type == event.GardenHarvested && ok && payload.XP == wantXP && payload.Crops == 8 && payload.Plots == 3
+		})).Return()
+
+		harvest, err := f.svc.Harvest(ctx, domain.PlatformDiscord, "d-1", "gardener")
+
+		require.NoError(t, err)
+		assert.Equal(t, []domain.GardenCrop{{ItemName: "crop_wheat", Quantity: 6}, {ItemName: "crop_pumpkin", Quantity: 2}}, harvest.Crops)
+		assert.Equal(t, 3, harvest.Plots)
+		assert.Equal(t, wantXP, harvest.XP)
+	})
+
+	t.Run("nothing ready", func(t *testing.T) {
+		f := newGardenFixture(t)
+		f.expectGardener(ctx)
+		f.repo.On("TakeReadyPlots", ctx, gardenerID, mock.Anything).Return(nil, nil)
+
+		_, err := f.svc.Harvest(ctx, domain.PlatformDiscord, "d-1", "gardener")
+
+		assert.ErrorIs(t, err, domain.ErrNothingToHarvest)
+	})
+
+	t.Run("a failed grant fails the harvest", func(t *testing.T) {
+		f := newGardenFixture(t)
+		f.expectGardener(ctx)
+		f.repo.On("TakeReadyPlots", ctx, gardenerID, mock.Anything).Return([]domain.GardenPlot{
+			{Slot: 1, Seed: "seed_wheat", Crop: "crop_wheat", Quantity: 3},
+		}, nil)
+		f.users.On("GetItemByName", ctx, "crop_wheat").Return(&domain.Item{InternalName: "crop_wheat"}, nil)
+		f.users.On("GrantItemReward", ctx, mock.Anything, mock.Anything, 3, domain.QualityCommon).Return(errors.New("db down"))
+
+		_, err := f.svc.Harvest(ctx, domain.PlatformDiscord, "d-1", "gardener")
+
+		assert.Error(t, err)
+	})
+}
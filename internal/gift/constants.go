@@ -0,0 +1,28 @@
+package gift
+
+import "time"
+
+// JobType identifies gift delivery sweeps in the worker pool and scheduler
+const JobType = "gift_delivery"
+
+// Defaults, used when the configured values are not positive
+const (
+	// DefaultMaxPending caps how many undelivered gifts a sender can have
+	DefaultMaxPending = 10
+	// DefaultMaxDelay is the furthest ahead a delivery can be scheduled
+	DefaultMaxDelay = 30 * 24 * time.Hour
+	// DefaultBatchSize caps how many gifts one sweep looks up at a time
+	DefaultBatchSize = 100
+)
+
+// MaxMessageLength caps the note attached to a gift
+const MaxMessageLength = 200
+
+// Log messages
+const (
+	LogMsgGiftWrapped    = "Gift wrapped"
+	LogMsgGiftDelivered  = "Gift delivered"
+	LogMsgGiftCancelled  = "Gift cancelled"
+	LogMsgGiftsSwept     = "Delivered due gifts"
+	LogMsgGiftSweepError = "Failed to deliver due gift"
+)
@@ -0,0 +1,24 @@
+package gift
+
+import "context"
+
+// Job delivers gifts that have fallen due
+type Job struct {
+	service Service
+}
+
+// NewJob creates a gift delivery job
+func NewJob(service Service) *Job {
+	return &Job{service: service}
+}
+
+// JobType returns the worker pool job type
+func (j *Job) JobType() string {
+	return JobType
+}
+
+// Process delivers every due gift to its recipient
+func (j *Job) Process(ctx context.Context) error {
+	_, err := j.service.DeliverDue(ctx)
+	return err
+}
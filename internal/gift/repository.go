@@ -0,0 +1,44 @@
+package gift
+
+import (
+	"context"
+	"time"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/repository"
+)
+
+// Repository stores wrapped gifts
+type Repository interface {
+	BeginTx(ctx context.Context) (Tx, error)
+
+	// GetInventory reads a user's inventory without locking it
+	GetInventory(ctx context.Context, userID string) (*domain.Inventory, error)
+
+	// GetPendingGifts returns the gifts a user has wrapped that are not yet
+	// delivered, soonest first
+	GetPendingGifts(ctx context.Context, senderID string) ([]domain.Gift, error)
+
+	// CountPendingGifts returns how many gifts a user has waiting to be
+	// delivered
+	CountPendingGifts(ctx context.Context, senderID string) (int, error)
+
+	// GetDueGiftIDs returns up to limit pending gifts due at or before now,
+	// including gifts whose recipient has been deleted
+	GetDueGiftIDs(ctx context.Context, now time.Time, limit int) ([]int64, error)
+}
+
+// Tx moves gifted items and records the gift in one transaction
+type Tx interface {
+	repository.Tx
+	repository.InventoryAdjuster
+
+	CreateGift(ctx context.Context, gift domain.Gift) (*domain.Gift, error)
+
+	// GetPendingGiftForUpdate locks and returns a pending gift with the
+	// sender, recipient and item names, or nil if there is no pending gift
+	// with that ID
+	GetPendingGiftForUpdate(ctx context.Context, id int64) (*domain.Gift, error)
+
+	CloseGift(ctx context.Context, id int64, status domain.GiftStatus, closedAt time.Time) error
+}
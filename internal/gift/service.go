@@ -0,0 +1,426 @@
+package gift
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/osse101/BrandishBot_Go/internal/database"
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/event"
+	"github.com/osse101/BrandishBot_Go/internal/itemflags"
+	"github.com/osse101/BrandishBot_Go/internal/logger"
+	"github.com/osse101/BrandishBot_Go/internal/naming"
+	"github.com/osse101/BrandishBot_Go/internal/repository"
+	"github.com/osse101/BrandishBot_Go/internal/utils"
+)
+
+// Service wraps items as gifts for other users and delivers them when they
+// fall due
+type Service interface {
+	// WrapGift takes items from the sender and holds them until the gift is
+	// delivered. Gifts count toward the sender's daily give limit, and money
+	// cannot be gifted.
+	WrapGift(ctx context.Context, req WrapRequest) (*domain.Gift, error)
+
+	// CancelGift unwraps an undelivered gift and returns the items to the
+	// sender. Only the sender can cancel a gift.
+	CancelGift(ctx context.Context, platform, platformID string, id int64) (*domain.Gift, error)
+
+	// GetSentGifts returns the user's gifts that are waiting to be delivered
+	GetSentGifts(ctx context.Context, platform, platformID string) ([]domain.Gift, error)
+
+	// DeliverDue hands due gifts to their recipients and reports how many
+	// were closed. A gift whose recipient has been deleted goes back to the
+	// sender.
+	DeliverDue(ctx context.Context) (int, error)
+}
+
+// WrapRequest asks to wrap items as a gift for another user
+type WrapRequest struct {
+	Platform   string
+	PlatformID string
+	Username   string
+	// Recipient is the recipient's username on the same platform
+	Recipient string
+	ItemName  string
+	Quantity  int
+	Message   string
+	// DeliverAt schedules the delivery; nil or a past time delivers on the
+	// next sweep
+	DeliverAt *time.Time
+	// ChannelID is where the delivery is announced; empty announces it to
+	// the recipient directly
+	ChannelID string
+}
+
+// UserService defines the user operations needed by the gift service
+type UserService interface {
+	GetUserOrRegister(ctx context.Context, platform, platformID, username string) (*domain.User, error)
+	GetUserByPlatformUsername(ctx context.Context, platform, username string) (*domain.User, error)
+	GetUserIDByPlatformID(ctx context.Context, platform, platformID string) (string, error)
+}
+
+// ItemLookup finds items by name
+type ItemLookup interface {
+	GetItemByName(ctx context.Context, itemName string) (*domain.Item, error)
+}
+
+// GiveGuard limits who may give items and watches gives for funneling
+type GiveGuard interface {
+	CheckGive(ctx context.Context, giverID string) error
+	RecordGive(ctx context.Context, giverID, receiverID string, item *domain.Item, quantity int)
+}
+
+// LoanChecker reports how many of an item a user holds on loan
+type LoanChecker interface {
+	GetBorrowedQuantity(ctx context.Context, userID string, itemID int) (int, error)
+}
+
+// CapacityChecker refuses credits that would overfill an inventory
+type CapacityChecker interface {
+	EnsureInventoryRoom(ctx context.Context, userID string, itemID int, qualityLevel domain.QualityLevel) error
+}
+
+// Publisher publishes gift events
+type Publisher interface {
+	PublishWithRetry(ctx context.Context, evt event.Event)
+}
+
+// Config tunes gifts
+type Config struct {
+	// MaxPending caps how many undelivered gifts a sender can have
+	MaxPending int
+	// MaxDelay is the furthest ahead a delivery can be scheduled
+	MaxDelay time.Duration
+	// BatchSize caps how many due gifts one sweep delivers
+	BatchSize int
+}
+
+// Option configures optional gift service dependencies
+type Option func(*service)
+
+// WithGiveGuard applies daily give limits and the minimum account age to
+// wrapping gifts
+func WithGiveGuard(guard GiveGuard) Option {
+	return func(s *service) {
+		s.giveGuard = guard
+	}
+}
+
+// WithLoanChecker stops users gifting items they have borrowed
+func WithLoanChecker(loans LoanChecker) Option {
+	return func(s *service) {
+		s.loans = loans
+	}
+}
+
+// WithItemLocks stops users gifting items they have locked
+func WithItemLocks(locks itemflags.LockChecker) Option {
+	return func(s *service) {
+		s.locks = locks
+	}
+}
+
+// WithCapacity holds deliveries and cancellations that would take the
+// receiver past their inventory slot limit
+func WithCapacity(capacity CapacityChecker) Option {
+	return func(s *service) {
+		s.capacity = capacity
+	}
+}
+
+type service struct {
+	repo           Repository
+	users          UserService
+	items          ItemLookup
+	namingResolver naming.Resolver
+	publisher      Publisher
+	giveGuard      GiveGuard             // nil leaves gifts unlimited
+	loans          LoanChecker           // nil allows gifting everything held
+	locks          itemflags.LockChecker // nil ignores item locks
+	capacity       CapacityChecker       // nil ignores slot limits
+	cfg            Config
+	now            func() time.Time
+	rnd            func() float64
+}
+
+// NewService creates a gift service
+func NewService(repo Repository, users UserService, items ItemLookup, namingResolver naming.Resolver, publisher Publisher, cfg Config, opts ...Option) Service {
+	if cfg.MaxPending <= 0 {
+		cfg.MaxPending = DefaultMaxPending
+	}
+	if cfg.MaxDelay <= 0 {
+		cfg.MaxDelay = DefaultMaxDelay
+	}
+	if cfg.BatchSize <= 0 {
+		cfg.BatchSize = DefaultBatchSize
+	}
+	s := &service{
+		repo:           repo,
+		users:          users,
+		items:          items,
+		namingResolver: namingResolver,
+		publisher:      publisher,
+		cfg:            cfg,
+		now:            time.Now,
+		rnd:            utils.RandomFloat,
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+// WrapGift wraps items from one quality slot of the sender's inventory
+func (s *service) WrapGift(ctx context.Context, req WrapRequest) (*domain.Gift, error) {
+	if req.Quantity <= 0 || req.Quantity > domain.MaxTransactionQuantity {
+		return nil, fmt.Errorf("%w: quantity must be between 1 and %d", domain.ErrInvalidInput, domain.MaxTransactionQuantity)
+	}
+	if len([]rune(req.Message)) > MaxMessageLength {
+		return nil, fmt.Errorf("%w: message must be at most %d characters", domain.ErrInvalidInput, MaxMessageLength)
+	}
+	now := s.now()
+	deliverAt := now
+	if req.DeliverAt != nil && req.DeliverAt.After(now) {
+		deliverAt = *req.DeliverAt
+	}
+	if deliverAt.Sub(now) > s.cfg.MaxDelay {
+		return nil, fmt.Errorf("%w: gifts can be scheduled at most %s ahead", domain.ErrInvalidGiftDelivery, s.cfg.MaxDelay)
+	}
+
+	sender, err := s.users.GetUserOrRegister(ctx, req.Platform, req.PlatformID, req.Username)
+	if err != nil {
+		return nil, err
+	}
+	recipient, err := s.users.GetUserByPlatformUsername(ctx, req.Platform, req.Recipient)
+	if err != nil {
+		return nil, err
+	}
+	if recipient == nil {
+		return nil, fmt.Errorf("%w: %s", domain.ErrUserNotFound, req.Recipient)
+	}
+	if recipient.ID == sender.ID {
+		return nil, domain.ErrCannotGiftSelf
+	}
+
+	item, err := naming.ResolveItem(ctx, s.namingResolver, s.items, req.ItemName)
+	if err != nil {
+		return nil, err
+	}
+	if item.InternalName == domain.ItemMoney {
+		// Money goes through give, where large sums are taxed
+		return nil, fmt.Errorf("%w: money cannot be gifted", domain.ErrInvalidInput)
+	}
+	if err := itemflags.EnsureUnlocked(ctx, s.locks, sender.ID, item); err != nil {
+		return nil, err
+	}
+
+	pending, err := s.repo.CountPendingGifts(ctx, sender.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count gifts: %w", err)
+	}
+	if pending >= s.cfg.MaxPending {
+		return nil, fmt.Errorf("%w: you can have %d at a time", domain.ErrTooManyGifts, s.cfg.MaxPending)
+	}
+	if s.giveGuard != nil {
+		if err := s.giveGuard.CheckGive(ctx, sender.ID); err != nil {
+			return nil, err
+		}
+	}
+
+	quality, err := s.chooseGiftSlot(ctx, sender.ID, item, req.Quantity)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := s.repo.BeginTx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer repository.SafeRollback(ctx, tx)
+
+	if _, err := tx.AdjustItemQuantity(ctx, sender.ID, item.ID, quality, -req.Quantity); err != nil {
+		if errors.Is(err, domain.ErrInsufficientQuantity) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to update inventory: %w", err)
+	}
+	gift, err := tx.CreateGift(ctx, domain.Gift{
+		SenderID:            sender.ID,
+		RecipientID:         recipient.ID,
+		ItemID:              item.ID,
+		QualityLevel:        quality,
+		Quantity:            req.Quantity,
+		Message:             req.Message,
+		Platform:            req.Platform,
+		RecipientPlatformID: platformID(recipient, req.Platform),
+		ChannelID:           req.ChannelID,
+		Status:              domain.GiftStatusPending,
+		DeliverAt:           deliverAt,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create gift: %w", err)
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	if s.giveGuard != nil {
+		s.giveGuard.RecordGive(ctx, sender.ID, recipient.ID, item, req.Quantity)
+	}
+	gift.SenderName = sender.Username
+	gift.RecipientName = recipient.Username
+	gift.ItemName = item.InternalName
+	logger.FromContext(ctx).Info(LogMsgGiftWrapped, "gift_id", gift.ID, "sender", sender.ID, "recipient", recipient.ID, "item", item.InternalName, "quantity", gift.Quantity, "deliver_at", gift.DeliverAt)
+	return gift, nil
+}
+
+// chooseGiftSlot picks the quality slot to wrap from. Only items the sender
+// owns outright can be gifted, and a gift must fit in a single slot.
+func (s *service) chooseGiftSlot(ctx context.Context, senderID string, item *domain.Item, quantity int) (domain.QualityLevel, error) {
+	inventory, err := s.repo.GetInventory(database.WithPrimaryReads(ctx), senderID)
+	if err != nil {
+		return "", fmt.Errorf("failed to get inventory: %w", err)
+	}
+	borrowed := 0
+	if s.loans != nil {
+		if borrowed, err = s.loans.GetBorrowedQuantity(ctx, senderID, item.ID); err != nil {
+			return "", fmt.Errorf("failed to get borrowed quantity: %w", err)
+		}
+	}
+
+	owned := utils.GetTotalQuantity(inventory, item.ID) - borrowed
+	if owned < quantity {
+		if borrowed > 0 {
+			return "", fmt.Errorf("%w: %d of your %s are borrowed", domain.ErrItemBorrowed, borrowed, item.InternalName)
+		}
+		return "", fmt.Errorf("%w: have %d %s", domain.ErrInsufficientQuantity, owned, item.InternalName)
+	}
+
+	slotIndex, slotQuantity := utils.FindRandomSlot(inventory, item.ID, s.rnd)
+	if slotIndex == -1 {
+		return "", domain.ErrNotInInventory
+	}
+	if slotQuantity < quantity {
+		return "", fmt.Errorf("%w: a gift must come from items of one quality", domain.ErrInsufficientQuantity)
+	}
+	return inventory.Slots[slotIndex].QualityLevel, nil
+}
+
+// CancelGift returns an undelivered gift to its sender
+func (s *service) CancelGift(ctx context.Context, platform, platformID string, id int64) (*domain.Gift, error) {
+	senderID, err := repository.RegisteredUserID(ctx, s.users, platform, platformID)
+	if err != nil {
+		return nil, err
+	}
+	return s.close(ctx, id, senderID)
+}
+
+// GetSentGifts returns the user's undelivered gifts
+func (s *service) GetSentGifts(ctx context.Context, platform, platformID string) ([]domain.Gift, error) {
+	senderID, err := repository.RegisteredUserID(ctx, s.users, platform, platformID)
+	if err != nil {
+		return nil, err
+	}
+	return s.repo.GetPendingGifts(ctx, senderID)
+}
+
+// DeliverDue closes one batch of due gifts. A gift that fails to deliver,
+// including to a full inventory, is logged and left pending, so the next
+// sweep tries it again.
+func (s *service) DeliverDue(ctx context.Context) (int, error) {
+	log := logger.FromContext(ctx)
+	ids, err := s.repo.GetDueGiftIDs(ctx, s.now(), s.cfg.BatchSize)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get due gifts: %w", err)
+	}
+
+	delivered := 0
+	for _, id := range ids {
+		if _, err := s.close(ctx, id, ""); err != nil {
+			if errors.Is(err, domain.ErrGiftNotFound) {
+				continue // Cancelled by the sender or delivered by another instance in the meantime
+			}
+			log.Error(LogMsgGiftSweepError, "gift_id", id, "error", err)
+			continue
+		}
+		delivered++
+	}
+
+	if delivered > 0 {
+		log.Info(LogMsgGiftsSwept, "count", delivered)
+	}
+	return delivered, nil
+}
+
+// close settles a pending gift. A cancellation names the sender and returns
+// the items to them; a delivery hands the items to the recipient, or back to
+// the sender when the recipient's account is gone.
+func (s *service) close(ctx context.Context, id int64, senderID string) (*domain.Gift, error) {
+	cancel := senderID != ""
+
+	tx, err := s.repo.BeginTx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer repository.SafeRollback(ctx, tx)
+
+	gift, err := tx.GetPendingGiftForUpdate(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get gift: %w", err)
+	}
+	if gift == nil || (cancel && gift.SenderID != senderID) {
+		return nil, domain.ErrGiftNotFound
+	}
+
+	status, ownerID := domain.GiftStatusDelivered, gift.RecipientID
+	if cancel || gift.RecipientID == "" {
+		status, ownerID = domain.GiftStatusCancelled, gift.SenderID
+	}
+	if s.capacity != nil {
+		if err := s.capacity.EnsureInventoryRoom(ctx, ownerID, gift.ItemID, gift.QualityLevel); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := tx.AdjustItemQuantity(ctx, ownerID, gift.ItemID, gift.QualityLevel, gift.Quantity); err != nil {
+		return nil, fmt.Errorf("failed to update inventory: %w", err)
+	}
+	closedAt := s.now()
+	if err := tx.CloseGift(ctx, gift.ID, status, closedAt); err != nil {
+		return nil, fmt.Errorf("failed to close gift: %w", err)
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	gift.Status = status
+	gift.ClosedAt = &closedAt
+	msg := LogMsgGiftCancelled
+	if status == domain.GiftStatusDelivered {
+		msg = LogMsgGiftDelivered
+		s.publish(ctx, event.NewGiftDeliveredEvent(*gift))
+	}
+	logger.FromContext(ctx).Info(msg, "gift_id", gift.ID, "sender", gift.SenderID, "recipient", gift.RecipientID, "quantity", gift.Quantity)
+	return gift, nil
+}
+
+func (s *service) publish(ctx context.Context, evt event.Event) {
+	if s.publisher != nil {
+		s.publisher.PublishWithRetry(ctx, evt)
+	}
+}
+
+// platformID is the user's ID on the platform the gift was wrapped on
+func platformID(user *domain.User, platform string) string {
+	switch platform {
+	case domain.PlatformDiscord:
+		return user.DiscordID
+	case domain.PlatformTwitch:
+		return user.TwitchID
+	case domain.PlatformYoutube:
+		return user.YoutubeID
+	}
+	return ""
+}
@@ -0,0 +1,361 @@
+package gift_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/event"
+	"github.com/osse101/BrandishBot_Go/internal/gift"
+	"github.com/osse101/BrandishBot_Go/internal/gift/mocks"
+	itemflagsmocks "github.com/osse101/BrandishBot_Go/internal/itemflags/mocks"
+)
+
+const (
+	senderID    = "sender-1"
+	recipientID = "recipient-1"
+	swordID     = 7
+)
+
+func wrapRequest(quantity int) gift.WrapRequest {
+	return gift.WrapRequest{
+		Platform:   domain.PlatformDiscord,
+		PlatformID: "d-1",
+		Username:   "alice",
+		Recipient:  "bob",
+		ItemName:   "sword",
+		Quantity:   quantity,
+		Message:    "happy birthday",
+	}
+}
+
+func TestWrapGift(t *testing.T) {
+	ctx := context.Background()
+	sword := &domain.Item{ID: swordID, InternalName: "sword"}
+
+	t.Run("takes the items and schedules the delivery", func(t *testing.T) {
+		mockRepo := mocks.NewMockRepository(t)
+		mockTx := mocks.NewMockTx(t)
+		mockUsers := mocks.NewMockUserService(t)
+		mockItems := mocks.NewMockItemLookup(t)
+		mockGuard := mocks.NewMockGiveGuard(t)
+		mockPublisher := mocks.NewMockPublisher(t)
+		svc := gift.NewService(mockRepo, mockUsers, mockItems, nil, mockPublisher, gift.Config{}, gift.WithGiveGuard(mockGuard))
+
+		mockUsers.On("GetUserOrRegister", ctx, domain.PlatformDiscord, "d-1", "alice").Return(&domain.User{ID: senderID, Username: "alice"}, nil)
+		mockUsers.On("GetUserByPlatformUsername", ctx, domain.PlatformDiscord, "bob").Return(&domain.User{ID: recipientID, Username: "bob", DiscordID: "d-2"}, nil)
+		mockItems.On("GetItemByName", ctx, "sword").Return(sword, nil)
+		mockRepo.On("CountPendingGifts", ctx, senderID).Return(0, nil)
+		mockGuard.On("CheckGive", ctx, senderID).Return(nil)
+		mockRepo.On("GetInventory", mock.Anything, senderID).Return(&domain.Inventory{Slots: []domain.InventorySlot{
+			{ItemID: swordID, Quantity: 5, QualityLevel: domain.QualityRare},
+		}}, nil)
+		mockRepo.On("BeginTx", mock.Anything).Return(mockTx, nil)
+		mockTx.On("Commit", mock.Anything).Return(nil)
+		mockTx.On("Rollback", mock.Anything).Return(nil).Maybe()
+		mockTx.On("AdjustItemQuantity", mock.Anything, senderID, swordID, domain.QualityRare, -2).Return(0, nil)
+		mockTx.On("CreateGift", mock.Anything, mock.Anything).Return(func(_ context.Context, g domain.Gift) (*domain.Gift, error) {
+			g.ID = 4
+			return &g, nil
+		})
+		mockGuard.On("RecordGive", ctx, senderID, recipientID, sword, 2).Return()
+
+		req := wrapRequest(2)
+		deliverAt := time.Now().Add(2 * time.Hour)
+		req.DeliverAt = &deliverAt
+		got, err := svc.WrapGift(ctx, req)
+
+		require.NoError(t, err)
+		assert.Equal(t, domain.GiftStatusPending, got.Status)
+		assert.Equal(t, "d-2", got.RecipientPlatformID)
+		assert.Equal(t, "happy birthday", got.Message)
+		assert.Equal(t, deliverAt, got.DeliverAt)
+	})
+
+	t.Run("refused by the give guard", func(t *testing.T) {
+		mockRepo := mocks.NewMockRepository(t)
+		mockUsers := mocks.NewMockUserService(t)
+		mockItems := mocks.NewMockItemLookup(t)
+		mockGuard := mocks.NewMockGiveGuard(t)
+		mockPublisher := mocks.NewMockPublisher(t)
+		svc := gift.NewService(mockRepo, mockUsers, mockItems, nil, mockPublisher, gift.Config{}, gift.WithGiveGuard(mockGuard))
+
+		mockUsers.On("GetUserOrRegister", ctx, domain.PlatformDiscord, "d-1", "alice").Return(&domain.User{ID: senderID, Username: "alice"}, nil)
+		mockUsers.On("GetUserByPlatformUsername", ctx, domain.PlatformDiscord, "bob").Return(&domain.User{ID: recipientID, Username: "bob", DiscordID: "d-2"}, nil)
+		mockItems.On("GetItemByName", ctx, "sword").Return(sword, nil)
+		mockRepo.On("CountPendingGifts", ctx, senderID).Return(0, nil)
+		mockGuard.On("CheckGive", ctx, senderID).Return(domain.ErrDailyGiveLimitReached)
+
+		_, err := svc.WrapGift(ctx, wrapRequest(1))
+
+		assert.ErrorIs(t, err, domain.ErrDailyGiveLimitReached)
+	})
+
+	t.Run("caps pending gifts", func(t *testing.T) {
+		mockRepo := mocks.NewMockRepository(t)
+		mockUsers := mocks.NewMockUserService(t)
+		mockItems := mocks.NewMockItemLookup(t)
+		mockGuard := mocks.NewMockGiveGuard(t)
+		mockPublisher := mocks.NewMockPublisher(t)
+		svc := gift.NewService(mockRepo, mockUsers, mockItems, nil, mockPublisher, gift.Config{}, gift.WithGiveGuard(mockGuard))
+
+		mockUsers.On("GetUserOrRegister", ctx, domain.PlatformDiscord, "d-1", "alice").Return(&domain.User{ID: senderID, Username: "alice"}, nil)
+		mockUsers.On("GetUserByPlatformUsername", ctx, domain.PlatformDiscord, "bob").Return(&domain.User{ID: recipientID, Username: "bob", DiscordID: "d-2"}, nil)
+		mockItems.On("GetItemByName", ctx, "sword").Return(sword, nil)
+		mockRepo.On("CountPendingGifts", ctx, senderID).Return(gift.DefaultMaxPending, nil)
+
+		_, err := svc.WrapGift(ctx, wrapRequest(1))
+
+		assert.ErrorIs(t, err, domain.ErrTooManyGifts)
+	})
+
+	t.Run("money cannot be gifted", func(t *testing.T) {
+		mockRepo := mocks.NewMockRepository(t)
+		mockUsers := mocks.NewMockUserService(t)
+		mockItems := mocks.NewMockItemLookup(t)
+		mockGuard := mocks.NewMockGiveGuard(t)
+		mockPublisher := mocks.NewMockPublisher(t)
+		svc := gift.NewService(mockRepo, mockUsers, mockItems, nil, mockPublisher, gift.Config{}, gift.WithGiveGuard(mockGuard))
+
+		mockUsers.On("GetUserOrRegister", ctx, domain.PlatformDiscord, "d-1", "alice").Return(&domain.User{ID: senderID, Username: "alice"}, nil)
+		mockUsers.On("GetUserByPlatformUsername", ctx, domain.PlatformDiscord, "bob").Return(&domain.User{ID: recipientID, Username: "bob", DiscordID: "d-2"}, nil)
+		req := wrapRequest(100)
+		req.ItemName = domain.ItemMoney
+		mockItems.On("GetItemByName", ctx, domain.ItemMoney).Return(&domain.Item{ID: 1, InternalName: domain.ItemMoney}, nil)
+
+		_, err := svc.WrapGift(ctx, req)
+
+		assert.ErrorIs(t, err, domain.ErrInvalidInput)
+	})
+
+	t.Run("locked items cannot be gifted", func(t *testing.T) {
+		mockRepo := mocks.NewMockRepository(t)
+		mockUsers := mocks.NewMockUserService(t)
+		mockItems := mocks.NewMockItemLookup(t)
+		mockPublisher := mocks.NewMockPublisher(t)
+		mockLocks := itemflagsmocks.NewMockRepository(t)
+		svc := gift.NewService(mockRepo, mockUsers, mockItems, nil, mockPublisher, gift.Config{}, gift.WithItemLocks(mockLocks))
+
+		mockUsers.On("GetUserOrRegister", ctx, domain.PlatformDiscord, "d-1", "alice").Return(&domain.User{ID: senderID, Username: "alice"}, nil)
+		mockUsers.On("GetUserByPlatformUsername", ctx, domain.PlatformDiscord, "bob").Return(&domain.User{ID: recipientID, Username: "bob", DiscordID: "d-2"}, nil)
+		mockItems.On("GetItemByName", ctx, "sword").Return(sword, nil)
+		mockLocks.On("IsItemLocked", ctx, senderID, swordID).Return(true, nil)
+
+		_, err := svc.WrapGift(ctx, wrapRequest(1))
+
+		assert.ErrorIs(t, err, domain.ErrItemLockedByUser)
+	})
+
+	t.Run("cannot gift yourself", func(t *testing.T) {
+		mockRepo := mocks.NewMockRepository(t)
+		mockUsers := mocks.NewMockUserService(t)
+		mockItems := mocks.NewMockItemLookup(t)
+		mockGuard := mocks.NewMockGiveGuard(t)
+		mockPublisher := mocks.NewMockPublisher(t)
+		svc := gift.NewService(mockRepo, mockUsers, mockItems, nil, mockPublisher, gift.Config{}, gift.WithGiveGuard(mockGuard))
+
+		mockUsers.On("GetUserOrRegister", ctx, domain.PlatformDiscord, "d-1", "alice").Return(&domain.User{ID: senderID}, nil)
+		mockUsers.On("GetUserByPlatformUsername", ctx, domain.PlatformDiscord, "bob").Return(&domain.User{ID: senderID}, nil)
+
+		_, err := svc.WrapGift(ctx, wrapRequest(1))
+
+		assert.ErrorIs(t, err, domain.ErrCannotGiftSelf)
+	})
+
+	t.Run("rejects deliveries past the maximum delay", func(t *testing.T) {
+		mockRepo := mocks.NewMockRepository(t)
+		mockUsers := mocks.NewMockUserService(t)
+		mockItems := mocks.NewMockItemLookup(t)
+		mockGuard := mocks.NewMockGiveGuard(t)
+		mockPublisher := mocks.NewMockPublisher(t)
+		svc := gift.NewService(mockRepo, mockUsers, mockItems, nil, mockPublisher, gift.Config{}, gift.WithGiveGuard(mockGuard))
+
+		req := wrapRequest(1)
+		deliverAt := time.Now().Add(gift.DefaultMaxDelay + time.Hour)
+		req.DeliverAt = &deliverAt
+
+		_, err := svc.WrapGift(ctx, req)
+
+		assert.ErrorIs(t, err, domain.ErrInvalidGiftDelivery)
+	})
+
+	t.Run("rejects long messages", func(t *testing.T) {
+		mockRepo := mocks.NewMockRepository(t)
+		mockUsers := mocks.NewMockUserService(t)
+		mockItems := mocks.NewMockItemLookup(t)
+		mockGuard := mocks.NewMockGiveGuard(t)
+		mockPublisher := mocks.NewMockPublisher(t)
+		svc := gift.NewService(mockRepo, mockUsers, mockItems, nil, mockPublisher, gift.Config{}, gift.WithGiveGuard(mockGuard))
+
+		req := wrapRequest(1)
+		req.Message = strings.Repeat("x", gift.MaxMessageLength+1)
+
+		_, err := svc.WrapGift(ctx, req)
+
+		assert.ErrorIs(t, err, domain.ErrInvalidInput)
+	})
+}
+
+func pendingGift(recipient string) *domain.Gift {
+	return &domain.Gift{
+		ID:            4,
+		SenderID:      senderID,
+		SenderName:    "alice",
+		RecipientID:   recipient,
+		RecipientName: "bob",
+		ItemID:        swordID,
+		ItemName:      "sword",
+		QualityLevel:  domain.QualityRare,
+		Quantity:      2,
+		Message:       "enjoy",
+		Status:        domain.GiftStatusPending,
+	}
+}
+
+func TestDeliverDue(t *testing.T) {
+	ctx := context.Background()
+
+	expectDue := func(mockRepo *mocks.MockRepository, mockTx *mocks.MockTx, g *domain.Gift) {
+		mockRepo.On("GetDueGiftIDs", ctx, mock.Anything, gift.DefaultBatchSize).Return([]int64{4}, nil)
+		mockTx.On("GetPendingGiftForUpdate", mock.Anything, int64(4)).Return(g, nil)
+	}
+
+	t.Run("hands the items to the recipient and announces it", func(t *testing.T) {
+		mockRepo := mocks.NewMockRepository(t)
+		mockTx := mocks.NewMockTx(t)
+		mockUsers := mocks.NewMockUserService(t)
+		mockItems := mocks.NewMockItemLookup(t)
+		mockGuard := mocks.NewMockGiveGuard(t)
+		mockPublisher := mocks.NewMockPublisher(t)
+		svc := gift.NewService(mockRepo, mockUsers, mockItems, nil, mockPublisher, gift.Config{}, gift.WithGiveGuard(mockGuard))
+
+		expectDue(mockRepo, mockTx, pendingGift(recipientID))
+		mockRepo.On("BeginTx", mock.Anything).Return(mockTx, nil)
+		mockTx.On("Commit", mock.Anything).Return(nil)
+		mockTx.On("Rollback", mock.Anything).Return(nil).Maybe()
+		mockTx.On("AdjustItemQuantity", mock.Anything, recipientID, swordID, domain.QualityRare, 2).Return(0, nil)
+		mockTx.On("CloseGift", mock.Anything, int64(4), domain.GiftStatusDelivered, mock.Anything).Return(nil)
+		mockPublisher.On("PublishWithRetry", mock.Anything, mock.MatchedBy(func(evt event.Event) bool {
+			p, ok := evt.Payload.(event.GiftDeliveredPayloadV1)
+			return evt.Type == event.GiftDelivered && ok && p.SenderName == "alice" && p.Message == "enjoy" && p.Quantity == 2
+		}))
+
+		n, err := svc.DeliverDue(ctx)
+
+		require.NoError(t, err)
+		assert.Equal(t, 1, n)
+	})
+
+	t.Run("a deleted recipient's gift goes back to the sender", func(t *testing.T) {
+		mockRepo := mocks.NewMockRepository(t)
+		mockTx := mocks.NewMockTx(t)
+		mockUsers := mocks.NewMockUserService(t)
+		mockItems := mocks.NewMockItemLookup(t)
+		mockGuard := mocks.NewMockGiveGuard(t)
+		mockPublisher := mocks.NewMockPublisher(t)
+		svc := gift.NewService(mockRepo, mockUsers, mockItems, nil, mockPublisher, gift.Config{}, gift.WithGiveGuard(mockGuard))
+
+		expectDue(mockRepo, mockTx, pendingGift(""))
+		mockRepo.On("BeginTx", mock.Anything).Return(mockTx, nil)
+		mockTx.On("Commit", mock.Anything).Return(nil)
+		mockTx.On("Rollback", mock.Anything).Return(nil).Maybe()
+		mockTx.On("AdjustItemQuantity", mock.Anything, senderID, swordID, domain.QualityRare, 2).Return(0, nil)
+		mockTx.On("CloseGift", mock.Anything, int64(4), domain.GiftStatusCancelled, mock.Anything).Return(nil)
+
+		n, err := svc.DeliverDue(ctx)
+
+		require.NoError(t, err)
+		assert.Equal(t, 1, n)
+	})
+
+	t.Run("a gift to a full inventory stays pending", func(t *testing.T) {
+		mockRepo := mocks.NewMockRepository(t)
+		mockTx := mocks.NewMockTx(t)
+		mockUsers := mocks.NewMockUserService(t)
+		mockItems := mocks.NewMockItemLookup(t)
+		mockPublisher := mocks.NewMockPublisher(t)
+		mockCapacity := mocks.NewMockCapacityChecker(t)
+		svc := gift.NewService(mockRepo, mockUsers, mockItems, nil, mockPublisher, gift.Config{}, gift.WithCapacity(mockCapacity))
+
+		expectDue(mockRepo, mockTx, pendingGift(recipientID))
+		mockRepo.On("BeginTx", mock.Anything).Return(mockTx, nil)
+		mockTx.On("Rollback", mock.Anything).Return(nil)
+		mockCapacity.On("EnsureInventoryRoom", mock.Anything, recipientID, swordID, domain.QualityRare).Return(domain.ErrInventoryFull)
+
+		n, err := svc.DeliverDue(ctx)
+
+		require.NoError(t, err)
+		assert.Zero(t, n)
+	})
+
+	t.Run("skips gifts closed in the meantime", func(t *testing.T) {
+		mockRepo := mocks.NewMockRepository(t)
+		mockTx := mocks.NewMockTx(t)
+		mockUsers := mocks.NewMockUserService(t)
+		mockItems := mocks.NewMockItemLookup(t)
+		mockGuard := mocks.NewMockGiveGuard(t)
+		mockPublisher := mocks.NewMockPublisher(t)
+		svc := gift.NewService(mockRepo, mockUsers, mockItems, nil, mockPublisher, gift.Config{}, gift.WithGiveGuard(mockGuard))
+
+		expectDue(mockRepo, mockTx, nil)
+		mockRepo.On("BeginTx", mock.Anything).Return(mockTx, nil)
+		mockTx.On("Rollback", mock.Anything).Return(nil)
+
+		n, err := svc.DeliverDue(ctx)
+
+		require.NoError(t, err)
+		assert.Zero(t, n)
+	})
+}
+
+func TestCancelGift(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("returns the items to the sender", func(t *testing.T) {
+		mockRepo := mocks.NewMockRepository(t)
+		mockTx := mocks.NewMockTx(t)
+		mockUsers := mocks.NewMockUserService(t)
+		mockItems := mocks.NewMockItemLookup(t)
+		mockGuard := mocks.NewMockGiveGuard(t)
+		mockPublisher := mocks.NewMockPublisher(t)
+		svc := gift.NewService(mockRepo, mockUsers, mockItems, nil, mockPublisher, gift.Config{}, gift.WithGiveGuard(mockGuard))
+
+		mockUsers.On("GetUserIDByPlatformID", ctx, domain.PlatformDiscord, "d-1").Return(senderID, nil)
+		mockTx.On("GetPendingGiftForUpdate", mock.Anything, int64(4)).Return(pendingGift(recipientID), nil)
+		mockRepo.On("BeginTx", mock.Anything).Return(mockTx, nil)
+		mockTx.On("Commit", mock.Anything).Return(nil)
+		mockTx.On("Rollback", mock.Anything).Return(nil).Maybe()
+		mockTx.On("AdjustItemQuantity", mock.Anything, senderID, swordID, domain.QualityRare, 2).Return(0, nil)
+		mockTx.On("CloseGift", mock.Anything, int64(4), domain.GiftStatusCancelled, mock.Anything).Return(nil)
+
+		got, err := svc.CancelGift(ctx, domain.PlatformDiscord, "d-1", 4)
+
+		require.NoError(t, err)
+		assert.Equal(t, domain.GiftStatusCancelled, got.Status)
+	})
+
+	t.Run("only the sender can cancel a gift", func(t *testing.T) {
+		mockRepo := mocks.NewMockRepository(t)
+		mockTx := mocks.NewMockTx(t)
+		mockUsers := mocks.NewMockUserService(t)
+		mockItems := mocks.NewMockItemLookup(t)
+		mockGuard := mocks.NewMockGiveGuard(t)
+		mockPublisher := mocks.NewMockPublisher(t)
+		svc := gift.NewService(mockRepo, mockUsers, mockItems, nil, mockPublisher, gift.Config{}, gift.WithGiveGuard(mockGuard))
+
+		mockUsers.On("GetUserIDByPlatformID", ctx, domain.PlatformDiscord, "d-2").Return(recipientID, nil)
+		mockRepo.On("BeginTx", mock.Anything).Return(mockTx, nil)
+		mockTx.On("Rollback", mock.Anything).Return(nil)
+		mockTx.On("GetPendingGiftForUpdate", mock.Anything, int64(4)).Return(pendingGift(recipientID), nil)
+
+		_, err := svc.CancelGift(ctx, domain.PlatformDiscord, "d-2", 4)
+
+		assert.ErrorIs(t, err, domain.ErrGiftNotFound)
+	})
+}
@@ -0,0 +1,19 @@
+package giveguard
+
+// Flag list limits
+const (
+	DefaultListLimit = 50
+	MaxListLimit     = 200
+)
+
+// ReasonCircularTransfer describes a give back to a user who gave to the
+// giver within the circular transfer window
+const ReasonCircularTransfer = "receiver gave to the giver within the last %s"
+
+// Log messages
+const (
+	LogMsgGiveBlocked      = "Give blocked by give guard"
+	LogMsgGiveRecordFailed = "Failed to record give"
+	LogMsgGiveFlagged      = "Flagged give for review"
+	LogMsgFlagReviewed     = "Give flag reviewed"
+)
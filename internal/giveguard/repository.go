@@ -0,0 +1,34 @@
+package giveguard
+
+import (
+	"context"
+	"time"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+)
+
+// Repository stores recent gives and the flags raised on them
+type Repository interface {
+	// GetAccountCreatedAt returns when a user registered
+	GetAccountCreatedAt(ctx context.Context, userID string) (time.Time, error)
+
+	// CountGivesSince counts the gives a user has made since the given time
+	CountGivesSince(ctx context.Context, giverID string, since time.Time) (int, error)
+
+	// HasGivenSince reports whether giverID has given receiverID anything
+	// since the given time
+	HasGivenSince(ctx context.Context, giverID, receiverID string, since time.Time) (bool, error)
+
+	// RecordGive logs a give and clears the giver's entries older than pruneBefore
+	RecordGive(ctx context.Context, giverID, receiverID string, itemID, quantity int, pruneBefore time.Time) error
+
+	// FlagGive queues a give for admin review and returns the flag's ID
+	FlagGive(ctx context.Context, flag domain.GiveFlag) (int64, error)
+
+	// ListFlags returns the flags in a status, newest first
+	ListFlags(ctx context.Context, status domain.GiveFlagStatus, limit int) ([]domain.GiveFlag, error)
+
+	// ReviewFlag settles a pending flag. It returns false when the flag does
+	// not exist or has already been reviewed.
+	ReviewFlag(ctx context.Context, id int64, status domain.GiveFlagStatus, actor string) (bool, error)
+}
@@ -0,0 +1,196 @@
+package giveguard
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/event"
+	"github.com/osse101/BrandishBot_Go/internal/logger"
+)
+
+// Service limits who may give items and how often, and queues gives that
+// look like alt accounts funneling items for admin review
+type Service interface {
+	// CheckGive returns domain.ErrAccountTooNewToGive or
+	// domain.ErrDailyGiveLimitReached when the user may not give right now
+	CheckGive(ctx context.Context, giverID string) error
+
+	// RecordGive logs a completed give. A give back to a user who gave to
+	// the giver within the circular transfer window is flagged for review.
+	RecordGive(ctx context.Context, giverID, receiverID string, item *domain.Item, quantity int)
+
+	// ListFlags returns flagged gives in a status, newest first. An empty
+	// status lists pending flags.
+	ListFlags(ctx context.Context, status domain.GiveFlagStatus, limit int) ([]domain.GiveFlag, error)
+
+	// ReviewFlag dismisses or confirms a pending flag
+	ReviewFlag(ctx context.Context, id int64, status domain.GiveFlagStatus, actor string) error
+}
+
+// Publisher publishes give flag events
+type Publisher interface {
+	PublishWithRetry(ctx context.Context, evt event.Event)
+}
+
+// Config tunes the give limits. A zero value turns that check off.
+type Config struct {
+	// DailyLimit is how many gives a user may make per UTC day
+	DailyLimit int
+	// MinAccountAge is how long a user must have been registered to give
+	MinAccountAge time.Duration
+	// CircularWindow is how soon a give back to the previous giver is flagged
+	CircularWindow time.Duration
+}
+
+type service struct {
+	repo      Repository
+	publisher Publisher
+	cfg       Config
+	now       func() time.Time
+}
+
+// NewService creates a give guard service. publisher may be nil.
+func NewService(repo Repository, publisher Publisher, cfg Config) Service {
+	return &service{
+		repo:      repo,
+		publisher: publisher,
+		cfg:       cfg,
+		now:       time.Now,
+	}
+}
+
+// CheckGive enforces the minimum account age and the daily give limit
+func (s *service) CheckGive(ctx context.Context, giverID string) error {
+	log := logger.FromContext(ctx)
+	now := s.now()
+
+	if s.cfg.MinAccountAge > 0 {
+		createdAt, err := s.repo.GetAccountCreatedAt(ctx, giverID)
+		if err != nil {
+			return fmt.Errorf("failed to get account age: %w", err)
+		}
+		if now.Sub(createdAt) < s.cfg.MinAccountAge {
+			log.Info(LogMsgGiveBlocked, "user_id", giverID, "reason", domain.ErrAccountTooNewToGive)
+			return domain.ErrAccountTooNewToGive
+		}
+	}
+
+	if s.cfg.DailyLimit > 0 {
+		count, err := s.repo.CountGivesSince(ctx, giverID, startOfDay(now))
+		if err != nil {
+			return fmt.Errorf("failed to count gives: %w", err)
+		}
+		if count >= s.cfg.DailyLimit {
+			log.Info(LogMsgGiveBlocked, "user_id", giverID, "reason", domain.ErrDailyGiveLimitReached, "gives", count)
+			return domain.ErrDailyGiveLimitReached
+		}
+	}
+
+	return nil
+}
+
+// RecordGive logs the give and flags circular transfers. The give has
+// already happened, so failures are logged rather than returned.
+func (s *service) RecordGive(ctx context.Context, giverID, receiverID string, item *domain.Item, quantity int) {
+	if s.cfg.DailyLimit <= 0 && s.cfg.CircularWindow <= 0 {
+		return
+	}
+	log := logger.FromContext(ctx)
+	now := s.now()
+
+	if s.cfg.CircularWindow > 0 {
+		s.flagCircular(ctx, giverID, receiverID, item, quantity, now)
+	}
+
+	pruneBefore := startOfDay(now)
+	if windowStart := now.Add(-s.cfg.CircularWindow); windowStart.Before(pruneBefore) {
+		pruneBefore = windowStart
+	}
+	if err := s.repo.RecordGive(ctx, giverID, receiverID, item.ID, quantity, pruneBefore); err != nil {
+		log.Error(LogMsgGiveRecordFailed, "error", err, "giver_id", giverID, "receiver_id", receiverID)
+	}
+}
+
+// flagCircular queues the give for review when the receiver recently gave to the giver
+func (s *service) flagCircular(ctx context.Context, giverID, receiverID string, item *domain.Item, quantity int, now time.Time) {
+	log := logger.FromContext(ctx)
+
+	circular, err := s.repo.HasGivenSince(ctx, receiverID, giverID, now.Add(-s.cfg.CircularWindow))
+	if err != nil {
+		log.Error(LogMsgGiveRecordFailed, "error", err, "giver_id", giverID, "receiver_id", receiverID)
+		return
+	}
+	if !circular {
+		return
+	}
+
+	flag := domain.GiveFlag{
+		GiverID:    giverID,
+		ReceiverID: receiverID,
+		ItemID:     item.ID,
+		ItemName:   item.InternalName,
+		Quantity:   quantity,
+		Reason:     fmt.Sprintf(ReasonCircularTransfer, s.cfg.CircularWindow),
+		Status:     domain.GiveFlagPending,
+		CreatedAt:  now,
+	}
+	flag.ID, err = s.repo.FlagGive(ctx, flag)
+	if err != nil {
+		log.Error(LogMsgGiveRecordFailed, "error", err, "giver_id", giverID, "receiver_id", receiverID)
+		return
+	}
+
+	log.Info(LogMsgGiveFlagged, "flag_id", flag.ID, "giver_id", giverID, "receiver_id", receiverID, "item", item.InternalName)
+	if s.publisher != nil {
+		s.publisher.PublishWithRetry(ctx, event.NewGiveFlaggedEvent(flag))
+	}
+}
+
+// ListFlags returns flagged gives in a status, newest first
+func (s *service) ListFlags(ctx context.Context, status domain.GiveFlagStatus, limit int) ([]domain.GiveFlag, error) {
+	if status == "" {
+		status = domain.GiveFlagPending
+	}
+	switch status {
+	case domain.GiveFlagPending, domain.GiveFlagDismissed, domain.GiveFlagConfirmed:
+	default:
+		return nil, fmt.Errorf("%w: unknown give flag status %q", domain.ErrInvalidInput, status)
+	}
+	if limit <= 0 {
+		limit = DefaultListLimit
+	}
+	if limit > MaxListLimit {
+		limit = MaxListLimit
+	}
+
+	flags, err := s.repo.ListFlags(ctx, status, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list give flags: %w", err)
+	}
+	return flags, nil
+}
+
+// ReviewFlag dismisses or confirms a pending flag
+func (s *service) ReviewFlag(ctx context.Context, id int64, status domain.GiveFlagStatus, actor string) error {
+	if actor == "" || (status != domain.GiveFlagDismissed && status != domain.GiveFlagConfirmed) {
+		return domain.ErrInvalidInput
+	}
+
+	ok, err := s.repo.ReviewFlag(ctx, id, status, actor)
+	if err != nil {
+		return fmt.Errorf("failed to review give flag: %w", err)
+	}
+	if !ok {
+		return domain.ErrGiveFlagNotFound
+	}
+
+	logger.FromContext(ctx).Info(LogMsgFlagReviewed, "flag_id", id, "status", status, "actor", actor)
+	return nil
+}
+
+// startOfDay is midnight UTC on the day of t
+func startOfDay(t time.Time) time.Time {
+	return t.UTC().Truncate(24 * time.Hour)
+}
@@ -0,0 +1,131 @@
+package giveguard_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/event"
+	"github.com/osse101/BrandishBot_Go/internal/giveguard"
+	"github.com/osse101/BrandishBot_Go/internal/giveguard/mocks"
+)
+
+var testConfig = giveguard.Config{
+	DailyLimit:     3,
+	MinAccountAge:  24 * time.Hour,
+	CircularWindow: 10 * time.Minute,
+}
+
+func TestCheckGive(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("allows an established account under the limit", func(t *testing.T) {
+		repo := mocks.NewMockRepository(t)
+		repo.On("GetAccountCreatedAt", ctx, "user-a").Return(time.Now().Add(-48*time.Hour), nil)
+		repo.On("CountGivesSince", ctx, "user-a", mock.Anything).Return(2, nil)
+
+		assert.NoError(t, giveguard.NewService(repo, nil, testConfig).CheckGive(ctx, "user-a"))
+	})
+
+	t.Run("refuses a new account", func(t *testing.T) {
+		repo := mocks.NewMockRepository(t)
+		repo.On("GetAccountCreatedAt", ctx, "user-a").Return(time.Now().Add(-time.Hour), nil)
+
+		err := giveguard.NewService(repo, nil, testConfig).CheckGive(ctx, "user-a")
+
+		assert.ErrorIs(t, err, domain.ErrAccountTooNewToGive)
+	})
+
+	t.Run("refuses once the daily limit is used", func(t *testing.T) {
+		repo := mocks.NewMockRepository(t)
+		repo.On("GetAccountCreatedAt", ctx, "user-a").Return(time.Now().Add(-48*time.Hour), nil)
+		repo.On("CountGivesSince", ctx, "user-a", mock.MatchedBy(func(since time.Time) bool {
+			return since.Equal(since.Truncate(24*time.Hour)) && time.Since(since) <= 24*time.Hour
+		})).Return(3, nil)
+
+		err := giveguard.NewService(repo, nil, testConfig).CheckGive(ctx, "user-a")
+
+		assert.ErrorIs(t, err, domain.ErrDailyGiveLimitReached)
+	})
+
+	t.Run("checks nothing when turned off", func(t *testing.T) {
+		repo := mocks.NewMockRepository(t)
+
+		assert.NoError(t, giveguard.NewService(repo, nil, giveguard.Config{}).CheckGive(ctx, "user-a"))
+	})
+}
+
+func TestRecordGive(t *testing.T) {
+	ctx := context.Background()
+	item := &domain.Item{ID: 7, InternalName: domain.ItemMoney}
+
+	t.Run("flags a give back to a recent giver", func(t *testing.T) {
+		repo := mocks.NewMockRepository(t)
+		publisher := mocks.NewMockPublisher(t)
+		repo.On("HasGivenSince", ctx, "user-b", "user-a", mock.Anything).Return(true, nil)
+		repo.On("FlagGive", ctx, mock.MatchedBy(func(f domain.GiveFlag) bool {
+			return f.GiverID == "user-a" && f.ReceiverID == "user-b" && f.ItemID == 7 &&
+				f.Quantity == 500 && f.Status == domain.GiveFlagPending
+		})).Return(int64(12), nil)
+		publisher.On("PublishWithRetry", ctx, mock.MatchedBy(func(evt event.Event) bool {
+			payload, ok := evt.Payload.(event.GiveFlaggedPayloadV1)
+			return evt.Type == event.GiveFlagged && ok && payload.FlagID == 12 && payload.ItemName == domain.ItemMoney
+		})).Return()
+		repo.On("RecordGive", ctx, "user-a", "user-b", 7, 500, mock.Anything).Return(nil)
+
+		giveguard.NewService(repo, publisher, testConfig).RecordGive(ctx, "user-a", "user-b", item, 500)
+	})
+
+	t.Run("only logs a one-way give", func(t *testing.T) {
+		repo := mocks.NewMockRepository(t)
+		publisher := mocks.NewMockPublisher(t)
+		repo.On("HasGivenSince", ctx, "user-b", "user-a", mock.Anything).Return(false, nil)
+		repo.On("RecordGive", ctx, "user-a", "user-b", 7, 500, mock.Anything).Return(nil)
+
+		giveguard.NewService(repo, publisher, testConfig).RecordGive(ctx, "user-a", "user-b", item, 500)
+	})
+}
+
+func TestListFlags(t *testing.T) {
+	ctx := context.Background()
+	repo := mocks.NewMockRepository(t)
+	repo.On("ListFlags", ctx, domain.GiveFlagPending, giveguard.DefaultListLimit).Return([]domain.GiveFlag{{ID: 1}}, nil)
+
+	flags, err := giveguard.NewService(repo, nil, testConfig).ListFlags(ctx, "", 0)
+
+	require.NoError(t, err)
+	assert.Len(t, flags, 1)
+}
+
+func TestReviewFlag(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("settles a pending flag", func(t *testing.T) {
+		repo := mocks.NewMockRepository(t)
+		repo.On("ReviewFlag", ctx, int64(4), domain.GiveFlagConfirmed, "mod").Return(true, nil)
+
+		assert.NoError(t, giveguard.NewService(repo, nil, testConfig).ReviewFlag(ctx, 4, domain.GiveFlagConfirmed, "mod"))
+	})
+
+	t.Run("already reviewed", func(t *testing.T) {
+		repo := mocks.NewMockRepository(t)
+		repo.On("ReviewFlag", ctx, int64(4), domain.GiveFlagDismissed, "mod").Return(false, nil)
+
+		err := giveguard.NewService(repo, nil, testConfig).ReviewFlag(ctx, 4, domain.GiveFlagDismissed, "mod")
+
+		assert.ErrorIs(t, err, domain.ErrGiveFlagNotFound)
+	})
+
+	t.Run("cannot move a flag back to pending", func(t *testing.T) {
+		repo := mocks.NewMockRepository(t)
+
+		err := giveguard.NewService(repo, nil, testConfig).ReviewFlag(ctx, 4, domain.GiveFlagPending, "mod")
+
+		assert.ErrorIs(t, err, domain.ErrInvalidInput)
+	})
+}
@@ -0,0 +1,20 @@
+package grpcserver
+
+// Log messages
+const (
+	LogMsgServerStarting = "Starting gRPC server"
+	LogMsgCallFinished   = "gRPC call finished"
+	LogMsgPanic          = "gRPC handler panicked"
+
+	LogMsgFrozenCheckFailed  = "Failed to check account freeze"
+	LogMsgFrozenCallRefused  = "Refused gRPC call from frozen account"
+	LogMsgTimeoutCheckFailed = "Failed to check user timeout"
+	LogMsgTimedOutCall       = "Refused gRPC call from timed out user"
+)
+
+// Error messages
+const (
+	ErrMsgUnauthorized     = "Unauthorized"
+	ErrMsgUnknownCommunity = "Unknown community"
+	ErrMsgSelfGive         = "You can't give items to yourself! Nice try though."
+)
@@ -0,0 +1,133 @@
+package grpcserver
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/economy"
+	"github.com/osse101/BrandishBot_Go/internal/event"
+	"github.com/osse101/BrandishBot_Go/internal/handler"
+	"github.com/osse101/BrandishBot_Go/internal/logger"
+	"github.com/osse101/BrandishBot_Go/internal/middleware"
+	"github.com/osse101/BrandishBot_Go/internal/progression"
+	"github.com/osse101/BrandishBot_Go/internal/user"
+	pb "github.com/osse101/BrandishBot_Go/pkg/pb/brandishbot/v1"
+)
+
+type economyServer struct {
+	pb.UnimplementedEconomyServiceServer
+	svc         economy.Service
+	users       user.ManagementService
+	progression progression.Service
+	bus         event.Bus
+}
+
+func (s *economyServer) GetSellPrices(ctx context.Context, _ *pb.GetSellPricesRequest) (*pb.GetSellPricesResponse, error) {
+	items, err := s.svc.GetSellablePrices(ctx)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &pb.GetSellPricesResponse{Items: toItemPrices(items)}, nil
+}
+
+func (s *economyServer) GetBuyPrices(ctx context.Context, _ *pb.GetBuyPricesRequest) (*pb.GetBuyPricesResponse, error) {
+	items, err := s.svc.GetBuyablePrices(ctx)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &pb.GetBuyPricesResponse{Items: toItemPrices(items)}, nil
+}
+
+func (s *economyServer) BuyItem(ctx context.Context, req *pb.BuyItemRequest) (*pb.BuyItemResponse, error) {
+	if err := checkFeatureLocked(ctx, s.progression, progression.FeatureEconomy); err != nil {
+		return nil, err
+	}
+	c := req.GetCaller()
+	r := handler.BuyItemRequest{Platform: c.GetPlatform(), PlatformID: c.GetPlatformId(), Username: c.GetUsername(), ItemName: req.GetItemName(), Quantity: int(req.GetQuantity())}
+	if err := validate(r); err != nil {
+		return nil, err
+	}
+
+	bought, err := s.svc.BuyItem(ctx, r.Platform, r.PlatformID, r.Username, r.ItemName, r.Quantity)
+	if err != nil {
+		logger.FromContext(ctx).Error("Failed to buy item", "error", err, "username", r.Username, "item", r.ItemName)
+		return nil, toStatus(err)
+	}
+
+	eventUserID := s.trackEngagement(ctx, r.Platform, r.PlatformID, r.Username, domain.MetricTypeItemBought, bought)
+	_ = handler.PublishEvent(ctx, s.bus, domain.EventTypeItemBought, map[string]interface{}{
+		"user_id":   eventUserID,
+		"item_name": r.ItemName,
+		"quantity":  bought,
+	})
+
+	return &pb.BuyItemResponse{
+		Message:     fmt.Sprintf("Purchased %dx %s", bought, r.ItemName),
+		ItemsBought: int32(bought),
+	}, nil
+}
+
+func (s *economyServer) SellItem(ctx context.Context, req *pb.SellItemRequest) (*pb.SellItemResponse, error) {
+	if err := checkFeatureLocked(ctx, s.progression, progression.FeatureEconomy); err != nil {
+		return nil, err
+	}
+	c := req.GetCaller()
+	r := handler.SellItemRequest{Platform: c.GetPlatform(), PlatformID: c.GetPlatformId(), Username: c.GetUsername(), ItemName: req.GetItemName(), Quantity: int(req.GetQuantity())}
+	if err := validate(r); err != nil {
+		return nil, err
+	}
+
+	moneyGained, itemsSold, err := s.svc.SellItem(ctx, r.Platform, r.PlatformID, r.Username, r.ItemName, r.Quantity)
+	if err != nil {
+		logger.FromContext(ctx).Error("Failed to sell item", "error", err, "username", r.Username, "item", r.ItemName)
+		return nil, toStatus(err)
+	}
+
+	eventUserID := s.trackEngagement(ctx, r.Platform, r.PlatformID, r.Username, domain.MetricTypeItemSold, itemsSold)
+	_ = handler.PublishEvent(ctx, s.bus, domain.EventTypeItemSold, map[string]interface{}{
+		"user_id":      eventUserID,
+		"item_name":    r.ItemName,
+		"quantity":     itemsSold,
+		"money_gained": moneyGained,
+	})
+
+	return &pb.SellItemResponse{
+		Message:     fmt.Sprintf("Sold %dx %s for %d money", itemsSold, r.ItemName, moneyGained),
+		MoneyGained: int32(moneyGained),
+		ItemsSold:   int32(itemsSold),
+	}, nil
+}
+
+// trackEngagement records engagement for the caller and returns the ID to
+// publish events under: the user's UUID, or the username if it can't be resolved
+func (s *economyServer) trackEngagement(ctx context.Context, platform, platformID, username, metricType string, value int) string {
+	userID, err := s.users.GetUserIDByPlatformID(ctx, platform, platformID)
+	if err != nil || userID == "" {
+		logger.FromContext(ctx).Warn("Could not resolve UUID for metrics, using username", "username", username, "error", err)
+		return username
+	}
+	middleware.TrackEngagementFromContext(middleware.WithUserID(ctx, userID), s.bus, metricType, value)
+	return userID
+}
+
+func toItemPrices(items []domain.Item) []*pb.ItemPrice {
+	prices := make([]*pb.ItemPrice, 0, len(items))
+	for _, it := range items {
+		p := &pb.ItemPrice{
+			InternalName: it.InternalName,
+			PublicName:   it.PublicName,
+			BaseValue:    int32(it.BaseValue),
+		}
+		if it.BuyPrice != nil {
+			v := int32(*it.BuyPrice)
+			p.BuyPrice = &v
+		}
+		if it.SellPrice != nil {
+			v := int32(*it.SellPrice)
+			p.SellPrice = &v
+		}
+		prices = append(prices, p)
+	}
+	return prices
+}
@@ -0,0 +1,91 @@
+package grpcserver
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/osse101/BrandishBot_Go/internal/handler"
+)
+
+// ErrorDomain is the domain set on the ErrorInfo detail of every error
+const ErrorDomain = "brandishbot"
+
+// statusError builds a gRPC status carrying the same machine-readable code as
+// the HTTP error envelope in an ErrorInfo detail, so clients branch on
+// ErrorInfo.Reason exactly as HTTP clients branch on the code field
+func statusError(c codes.Code, code handler.ErrorCode, message string, metadata map[string]string) error {
+	st := status.New(c, message)
+	withDetails, err := st.WithDetails(&errdetails.ErrorInfo{
+		Reason:   string(code),
+		Domain:   ErrorDomain,
+		Metadata: metadata,
+	})
+	if err != nil {
+		return st.Err()
+	}
+	return withDetails.Err()
+}
+
+// toStatus maps a service error to a gRPC status with the same message and
+// code the HTTP API would send
+func toStatus(err error) error {
+	if err == nil {
+		return nil
+	}
+	if errors.Is(err, context.Canceled) {
+		return status.Error(codes.Canceled, err.Error())
+	}
+	if errors.Is(err, context.DeadlineExceeded) {
+		return status.Error(codes.DeadlineExceeded, err.Error())
+	}
+	httpStatus, msg := handler.MapServiceErrorToUserMessage(err)
+	code := handler.ErrorCodeForError(err, httpStatus)
+	return statusError(grpcCodeFor(httpStatus, code), code, msg, nil)
+}
+
+// grpcCodeFor picks the gRPC code for an HTTP status, refined by the domain
+// code where the HTTP status is too coarse
+func grpcCodeFor(httpStatus int, code handler.ErrorCode) codes.Code {
+	switch code {
+	case handler.ErrCodeUserNotFound, handler.ErrCodeItemNotFound, handler.ErrCodeRecipeNotFound, handler.ErrCodeGambleNotFound:
+		return codes.NotFound
+	case handler.ErrCodeInsufficientFunds, handler.ErrCodeInsufficientItems, handler.ErrCodeNotInInventory,
+		handler.ErrCodeInventoryFull, handler.ErrCodeFeatureLocked, handler.ErrCodeFeatureDisabled, handler.ErrCodeGambleClosed:
+		return codes.FailedPrecondition
+	case handler.ErrCodeOnCooldown, handler.ErrCodeLimitReached:
+		return codes.ResourceExhausted
+	case handler.ErrCodeAlreadyVoted, handler.ErrCodeAlreadyJoined:
+		return codes.AlreadyExists
+	}
+
+	switch httpStatus {
+	case http.StatusBadRequest:
+		return codes.InvalidArgument
+	case http.StatusUnauthorized:
+		return codes.Unauthenticated
+	case http.StatusForbidden:
+		return codes.PermissionDenied
+	case http.StatusNotFound:
+		return codes.NotFound
+	case http.StatusConflict:
+		return codes.AlreadyExists
+	case http.StatusTooManyRequests:
+		return codes.ResourceExhausted
+	case http.StatusServiceUnavailable:
+		return codes.Unavailable
+	}
+	if httpStatus >= http.StatusInternalServerError {
+		return codes.Internal
+	}
+	return codes.Unknown
+}
+
+// invalidArgument reports a request that failed validation
+func invalidArgument(message string) error {
+	return statusError(codes.InvalidArgument, handler.ErrCodeValidation, message, nil)
+}
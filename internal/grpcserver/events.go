@@ -0,0 +1,55 @@
+package grpcserver
+
+import (
+	"encoding/json"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/osse101/BrandishBot_Go/internal/logger"
+	"github.com/osse101/BrandishBot_Go/internal/sse"
+	pb "github.com/osse101/BrandishBot_Go/pkg/pb/brandishbot/v1"
+)
+
+type eventServer struct {
+	pb.UnimplementedEventServiceServer
+	hub *sse.Hub
+}
+
+// Subscribe registers with the SSE hub and forwards its events until the
+// client disconnects or the hub shuts down. Only events from the community
+// the call selects are forwarded. Like SSE clients, slow subscribers miss
+// events rather than holding up the hub.
+func (s *eventServer) Subscribe(req *pb.SubscribeRequest, stream pb.EventService_SubscribeServer) error {
+	if s.hub == nil {
+		return status.Error(codes.Unavailable, "event stream is not available")
+	}
+
+	ctx := stream.Context()
+	client := s.hub.Register(ctx, req.GetTypes())
+	defer s.hub.Unregister(client.ID)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case evt, ok := <-client.EventChannel:
+			if !ok {
+				return status.Error(codes.Unavailable, "event stream closed")
+			}
+			payload, err := json.Marshal(evt.Payload)
+			if err != nil {
+				logger.FromContext(ctx).Error("Failed to encode event payload", "error", err, "type", evt.Type)
+				continue
+			}
+			if err := stream.Send(&pb.SubscribeResponse{Event: &pb.Event{
+				Id:          evt.ID,
+				Type:        evt.Type,
+				Timestamp:   evt.Timestamp,
+				PayloadJson: payload,
+			}}); err != nil {
+				return err
+			}
+		}
+	}
+}
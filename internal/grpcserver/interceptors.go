@@ -0,0 +1,124 @@
+package grpcserver
+
+import (
+	"context"
+	"crypto/subtle"
+	"log/slog"
+	"runtime/debug"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/osse101/BrandishBot_Go/internal/community"
+	"github.com/osse101/BrandishBot_Go/internal/handler"
+	"github.com/osse101/BrandishBot_Go/internal/logger"
+)
+
+// MetadataAPIKey is the metadata key the API key is sent in, matching the
+// X-API-Key header of the HTTP API
+const MetadataAPIKey = "x-api-key"
+
+// MetadataCommunityID selects the community a call runs in, matching the
+// X-Community-ID header of the HTTP API
+const MetadataCommunityID = "x-community-id"
+
+// metadataRequestID is the response header the request ID is returned in
+const metadataRequestID = "x-request-id"
+
+// authorize checks the API key in the incoming metadata and scopes the
+// context to the community the call selects, if any
+func authorize(ctx context.Context, apiKey string, communities map[string]bool) (context.Context, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	keys := md.Get(MetadataAPIKey)
+	if len(keys) == 0 || subtle.ConstantTimeCompare([]byte(keys[0]), []byte(apiKey)) != 1 {
+		return ctx, statusError(codes.Unauthenticated, handler.ErrCodeUnauthorized, ErrMsgUnauthorized, nil)
+	}
+	ids := md.Get(MetadataCommunityID)
+	if len(ids) == 0 || ids[0] == "" {
+		return ctx, nil
+	}
+	if !communities[ids[0]] {
+		return ctx, statusError(codes.InvalidArgument, handler.ErrCodeBadRequest, ErrMsgUnknownCommunity, nil)
+	}
+	return community.WithID(ctx, ids[0]), nil
+}
+
+// withRequestID tags the context with a new request ID and sends it back in
+// the response header, like the HTTP API's X-Request-ID
+func withRequestID(ctx context.Context) context.Context {
+	requestID := logger.GenerateRequestID()
+	_ = grpc.SetHeader(ctx, metadata.Pairs(metadataRequestID, requestID))
+	return logger.WithRequestID(ctx, requestID)
+}
+
+// recoverPanic turns a handler panic into an Internal error
+func recoverPanic(ctx context.Context, method string, err *error) {
+	if r := recover(); r != nil {
+		logger.FromContext(ctx).Error(LogMsgPanic, "method", method, "panic", r, "stack", string(debug.Stack()))
+		*err = status.Error(codes.Internal, handler.ErrMsgGenericServerError)
+	}
+}
+
+// logCall logs a finished call at a level matching its outcome
+func logCall(ctx context.Context, method string, start time.Time, err error) {
+	code := status.Code(err)
+	level := slog.LevelInfo
+	switch code {
+	case codes.OK:
+	case codes.Internal, codes.Unknown, codes.DataLoss, codes.Unavailable:
+		level = slog.LevelError
+	default:
+		level = slog.LevelWarn
+	}
+	logger.FromContext(ctx).Log(ctx, level, LogMsgCallFinished,
+		"method", method,
+		"code", code.String(),
+		"duration_ms", time.Since(start).Milliseconds())
+}
+
+// unaryInterceptor authenticates, tags and logs unary calls
+func unaryInterceptor(apiKey string, communities map[string]bool) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, next grpc.UnaryHandler) (resp interface{}, err error) {
+		start := time.Now()
+		ctx = withRequestID(ctx)
+		defer func() { logCall(ctx, info.FullMethod, start, err) }()
+		defer recoverPanic(ctx, info.FullMethod, &err)
+
+		ctx, err = authorize(ctx, apiKey, communities)
+		if err != nil {
+			return nil, err
+		}
+		return next(ctx, req)
+	}
+}
+
+// wrappedStream overrides the stream context so handlers see the request ID
+type wrappedStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *wrappedStream) Context() context.Context {
+	return s.ctx
+}
+
+// streamInterceptor authenticates, tags and logs streaming calls
+func streamInterceptor(apiKey string, communities map[string]bool) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, next grpc.StreamHandler) (err error) {
+		start := time.Now()
+		requestID := logger.GenerateRequestID()
+		_ = ss.SetHeader(metadata.Pairs(metadataRequestID, requestID))
+		ctx := logger.WithRequestID(ss.Context(), requestID)
+		defer func() { logCall(ctx, info.FullMethod, start, err) }()
+		defer recoverPanic(ctx, info.FullMethod, &err)
+
+		ctx, err = authorize(ctx, apiKey, communities)
+		if err != nil {
+			return err
+		}
+		return next(srv, &wrappedStream{ServerStream: ss, ctx: ctx})
+	}
+}
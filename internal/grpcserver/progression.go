@@ -0,0 +1,108 @@
+package grpcserver
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/handler"
+	"github.com/osse101/BrandishBot_Go/internal/logger"
+	"github.com/osse101/BrandishBot_Go/internal/progression"
+	pb "github.com/osse101/BrandishBot_Go/pkg/pb/brandishbot/v1"
+)
+
+type progressionServer struct {
+	pb.UnimplementedProgressionServiceServer
+	svc progression.Service
+}
+
+func (s *progressionServer) GetStatus(ctx context.Context, _ *pb.GetStatusRequest) (*pb.GetStatusResponse, error) {
+	status, err := s.svc.GetProgressionStatus(ctx)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	resp := &pb.GetStatusResponse{
+		TotalUnlocked:     int32(status.TotalUnlocked),
+		TotalNodes:        int32(status.TotalNodes),
+		AllNodesUnlocked:  status.AllNodesUnlocked,
+		ContributionScore: int32(status.ContributionScore),
+		ActiveSession:     toVotingSession(status.ActiveSession),
+		IsTransitioning:   status.IsTransitioning,
+	}
+	if p := status.ActiveUnlockProgress; p != nil {
+		resp.ActiveUnlockProgress = &pb.UnlockProgress{
+			NodeId:                   optionalInt32(p.NodeID),
+			TargetLevel:              optionalInt32(p.TargetLevel),
+			ContributionsAccumulated: int32(p.ContributionsAccumulated),
+			StartedAt:                timestamppb.New(p.StartedAt),
+		}
+		if p.EstimatedUnlockDate != nil {
+			resp.ActiveUnlockProgress.EstimatedUnlockDate = timestamppb.New(*p.EstimatedUnlockDate)
+		}
+	}
+	return resp, nil
+}
+
+func (s *progressionServer) GetVotingSession(ctx context.Context, _ *pb.GetVotingSessionRequest) (*pb.GetVotingSessionResponse, error) {
+	session, err := s.svc.GetActiveVotingSession(ctx)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &pb.GetVotingSessionResponse{Session: toVotingSession(session)}, nil
+}
+
+func (s *progressionServer) Vote(ctx context.Context, req *pb.VoteRequest) (*pb.VoteResponse, error) {
+	c := req.GetCaller()
+	r := handler.VoteRequest{Platform: c.GetPlatform(), PlatformID: c.GetPlatformId(), Username: c.GetUsername(), OptionIndex: int(req.GetOptionIndex())}
+	if err := validate(r); err != nil {
+		return nil, err
+	}
+
+	if err := s.svc.VoteForUnlock(ctx, r.Platform, r.PlatformID, r.Username, r.OptionIndex); err != nil {
+		if errors.Is(err, domain.ErrUserAlreadyVoted) {
+			return &pb.VoteResponse{Message: handler.MsgAlreadyVoted, AlreadyVoted: true}, nil
+		}
+		logger.FromContext(ctx).Warn("Vote failed", "error", err, "platform", r.Platform, "username", r.Username, "option_index", r.OptionIndex)
+		return nil, toStatus(err)
+	}
+	return &pb.VoteResponse{Message: handler.MsgVoteRecordedSuccess}, nil
+}
+
+func toVotingSession(session *domain.ProgressionVotingSession) *pb.VotingSession {
+	if session == nil {
+		return nil
+	}
+	out := &pb.VotingSession{
+		Id:             int32(session.ID),
+		Status:         session.Status,
+		StartedAt:      timestamppb.New(session.StartedAt),
+		VotingDeadline: timestamppb.New(session.VotingDeadline),
+		Options:        make([]*pb.VotingOption, 0, len(session.Options)),
+	}
+	for _, o := range session.Options {
+		opt := &pb.VotingOption{
+			Id:          int32(o.ID),
+			NodeId:      int32(o.NodeID),
+			TargetLevel: int32(o.TargetLevel),
+			VoteCount:   int32(o.VoteCount),
+			VoterCount:  int32(o.VoterCount),
+		}
+		if o.NodeDetails != nil {
+			opt.NodeKey = o.NodeDetails.NodeKey
+			opt.DisplayName = o.NodeDetails.DisplayName
+		}
+		out.Options = append(out.Options, opt)
+	}
+	return out
+}
+
+func optionalInt32(v *int) *int32 {
+	if v == nil {
+		return nil
+	}
+	n := int32(*v)
+	return &n
+}
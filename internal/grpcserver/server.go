@@ -0,0 +1,140 @@
+// Package grpcserver exposes the user, economy and progression services and
+// the live event stream over gRPC, alongside the HTTP API. It is meant for
+// latency-sensitive internal adapters such as Twitch chat. Calls go through
+// the same services, feature locks, engagement tracking and event publishing
+// as the matching HTTP handlers, and errors carry the same machine-readable
+// codes as the HTTP error envelope.
+package grpcserver
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/economy"
+	"github.com/osse101/BrandishBot_Go/internal/event"
+	"github.com/osse101/BrandishBot_Go/internal/handler"
+	"github.com/osse101/BrandishBot_Go/internal/logger"
+	"github.com/osse101/BrandishBot_Go/internal/progression"
+	"github.com/osse101/BrandishBot_Go/internal/sse"
+	"github.com/osse101/BrandishBot_Go/internal/user"
+	pb "github.com/osse101/BrandishBot_Go/pkg/pb/brandishbot/v1"
+)
+
+// Services holds the application services the gRPC API calls into
+type Services struct {
+	User        user.Service
+	Economy     economy.Service
+	Progression progression.Service
+	Moderation  FrozenChecker // Refuses item and money calls from frozen accounts; nil skips the check
+	EventBus    event.Bus
+	SSEHub      *sse.Hub
+	ChatDrops   handler.KeywordDropper // Rolls keyword drops for chat messages; nil disables them
+}
+
+// Server is the gRPC API server
+type Server struct {
+	grpcServer *grpc.Server
+	addr       string
+}
+
+// NewServer creates a gRPC server listening on port. Every call must carry the
+// API key in the x-api-key metadata and may pick one of communities with
+// x-community-id; calls without it run in the default community.
+func NewServer(port int, apiKey string, communities []string, svcs Services) *Server {
+	served := make(map[string]bool, len(communities))
+	for _, id := range communities {
+		served[id] = true
+	}
+	gs := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(unaryInterceptor(apiKey, served), guardInterceptor(svcs.Moderation, svcs.User)),
+		grpc.ChainStreamInterceptor(streamInterceptor(apiKey, served)),
+	)
+
+	pb.RegisterUserServiceServer(gs, &userServer{svc: svcs.User, progression: svcs.Progression, bus: svcs.EventBus, drops: svcs.ChatDrops})
+	pb.RegisterEconomyServiceServer(gs, &economyServer{svc: svcs.Economy, users: svcs.User, progression: svcs.Progression, bus: svcs.EventBus})
+	pb.RegisterProgressionServiceServer(gs, &progressionServer{svc: svcs.Progression})
+	pb.RegisterEventServiceServer(gs, &eventServer{hub: svcs.SSEHub})
+
+	return &Server{grpcServer: gs, addr: fmt.Sprintf(":%d", port)}
+}
+
+// Start listens on the configured port and serves until Stop is called
+func (s *Server) Start() error {
+	lis, err := net.Listen("tcp", s.addr)
+	if err != nil {
+		return fmt.Errorf("failed to listen on %s: %w", s.addr, err)
+	}
+	slog.Default().Info(LogMsgServerStarting, "addr", s.addr)
+	return s.grpcServer.Serve(lis)
+}
+
+// Serve serves on an existing listener, for tests
+func (s *Server) Serve(lis net.Listener) error {
+	return s.grpcServer.Serve(lis)
+}
+
+// Stop waits for in-flight calls to finish, cancelling them when ctx expires.
+// Open event streams are cancelled straight away.
+func (s *Server) Stop(ctx context.Context) error {
+	done := make(chan struct{})
+	go func() {
+		s.grpcServer.GracefulStop()
+		close(done)
+	}()
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		s.grpcServer.Stop()
+		return ctx.Err()
+	}
+}
+
+// callerRequest carries the validation rules the HTTP API applies to the
+// calling user, for calls whose HTTP endpoint takes them as query parameters
+type callerRequest struct {
+	Platform   string `json:"platform" validate:"required,platform"`
+	PlatformID string `json:"platform_id" validate:"required"`
+	Username   string `json:"username" validate:"required,max=100,excludesall=\x00\n\r\t"`
+}
+
+// validate checks a request against the validation rules of the matching HTTP
+// request struct, reporting failed fields in the ErrorInfo metadata
+func validate(req interface{}) error {
+	if err := handler.GetValidator().ValidateStruct(req); err != nil {
+		return statusError(codes.InvalidArgument, handler.ErrCodeValidation, handler.ErrMsgInvalidRequestSummary, handler.FormatValidationError(err))
+	}
+	return nil
+}
+
+// checkFeatureLocked returns a FailedPrecondition error when a progression
+// feature is locked, like handler.CheckFeatureLocked does for HTTP
+func checkFeatureLocked(ctx context.Context, svc progression.Service, key string) error {
+	unlocked, err := svc.IsFeatureUnlocked(ctx, key)
+	if err != nil {
+		logger.FromContext(ctx).Error("Failed to check feature unlock status", "error", err, "feature", key)
+		return toStatus(err)
+	}
+	if unlocked {
+		return nil
+	}
+
+	msg := domain.ErrMsgFeatureLocked
+	metadata := map[string]string{"feature": key}
+	if nodes, err := svc.GetRequiredNodes(ctx, key); err == nil && len(nodes) > 0 {
+		names := make([]string, 0, len(nodes))
+		for _, n := range nodes {
+			names = append(names, n.DisplayName)
+		}
+		msg = fmt.Sprintf(handler.MsgLockedNodesFormat, strings.Join(names, ", "))
+		metadata["required_nodes"] = strings.Join(names, ",")
+	}
+	return statusError(codes.FailedPrecondition, handler.ErrCodeFeatureLocked, msg, metadata)
+}
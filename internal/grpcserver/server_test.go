@@ -0,0 +1,255 @@
+package grpcserver_test
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/osse101/BrandishBot_Go/internal/community"
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/grpcserver"
+	"github.com/osse101/BrandishBot_Go/internal/progression"
+	"github.com/osse101/BrandishBot_Go/internal/sse"
+	"github.com/osse101/BrandishBot_Go/mocks"
+	pb "github.com/osse101/BrandishBot_Go/pkg/pb/brandishbot/v1"
+)
+
+const testAPIKey = "test-key"
+
+type testEnv struct {
+	conn        *grpc.ClientConn
+	users       *mocks.MockUserService
+	economy     *mocks.MockEconomyService
+	progression *mocks.MockProgressionService
+	moderation  *mocks.MockModerationService
+	bus         *mocks.MockEventBus
+	hub         *sse.Hub
+}
+
+func newTestEnv(t *testing.T) *testEnv {
+	t.Helper()
+	env := &testEnv{
+		users:       mocks.NewMockUserService(t),
+		economy:     mocks.NewMockEconomyService(t),
+		progression: mocks.NewMockProgressionService(t),
+		moderation:  mocks.NewMockModerationService(t),
+		bus:         mocks.NewMockEventBus(t),
+		hub:         sse.NewHub(),
+	}
+	env.hub.Start()
+	t.Cleanup(env.hub.Stop)
+	env.moderation.On("IsFrozen", mock.Anything, domain.PlatformTwitch, "123").Return(false, nil).Maybe()
+	env.users.On("GetTimeoutPlatform", mock.Anything, domain.PlatformTwitch, "tester").Return(time.Duration(0), nil).Maybe()
+
+	srv := grpcserver.NewServer(0, testAPIKey, []string{"default", "alpha"}, grpcserver.Services{
+		User:        env.users,
+		Economy:     env.economy,
+		Progression: env.progression,
+		Moderation:  env.moderation,
+		EventBus:    env.bus,
+		SSEHub:      env.hub,
+	})
+	lis := bufconn.Listen(1 << 20)
+	go func() { _ = srv.Serve(lis) }()
+	t.Cleanup(func() { _ = srv.Stop(context.Background()) })
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+	env.conn = conn
+	return env
+}
+
+func authed() context.Context {
+	return metadata.AppendToOutgoingContext(context.Background(), grpcserver.MetadataAPIKey, testAPIKey)
+}
+
+func caller() *pb.Caller {
+	return &pb.Caller{Platform: domain.PlatformTwitch, PlatformId: "123", Username: "tester"}
+}
+
+// errorInfo returns the ErrorInfo detail of a gRPC error
+func errorInfo(t *testing.T, err error) *errdetails.ErrorInfo {
+	t.Helper()
+	st, ok := status.FromError(err)
+	require.True(t, ok)
+	for _, d := range st.Details() {
+		if info, ok := d.(*errdetails.ErrorInfo); ok {
+			return info
+		}
+	}
+	t.Fatalf("no ErrorInfo detail in %v", err)
+	return nil
+}
+
+func TestAuth_RejectsMissingKey(t *testing.T) {
+	env := newTestEnv(t)
+
+	_, err := pb.NewProgressionServiceClient(env.conn).GetStatus(context.Background(), &pb.GetStatusRequest{})
+
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	assert.Equal(t, "UNAUTHORIZED", errorInfo(t, err).Reason)
+}
+
+func TestAuth_CommunitySelection(t *testing.T) {
+	env := newTestEnv(t)
+	env.progression.On("GetProgressionStatus", mock.MatchedBy(func(ctx context.Context) bool {
+		return community.FromContext(ctx) == "alpha"
+	})).Return(&domain.ProgressionStatus{}, nil)
+
+	client := pb.NewProgressionServiceClient(env.conn)
+	_, err := client.GetStatus(metadata.AppendToOutgoingContext(authed(), grpcserver.MetadataCommunityID, "alpha"), &pb.GetStatusRequest{})
+	require.NoError(t, err)
+
+	_, err = client.GetStatus(metadata.AppendToOutgoingContext(authed(), grpcserver.MetadataCommunityID, "other"), &pb.GetStatusRequest{})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	assert.Equal(t, "BAD_REQUEST", errorInfo(t, err).Reason)
+}
+
+func TestBuyItem_Success(t *testing.T) {
+	env := newTestEnv(t)
+	env.progression.On("IsFeatureUnlocked", mock.Anything, progression.FeatureEconomy).Return(true, nil)
+	env.economy.On("BuyItem", mock.Anything, domain.PlatformTwitch, "123", "tester", "stick", 3).Return(3, nil)
+	env.users.On("GetUserIDByPlatformID", mock.Anything, domain.PlatformTwitch, "123").Return("user-1", nil)
+	env.bus.On("Publish", mock.Anything, mock.Anything).Return(nil)
+
+	var header metadata.MD
+	resp, err := pb.NewEconomyServiceClient(env.conn).BuyItem(authed(),
+		&pb.BuyItemRequest{Caller: caller(), ItemName: "stick", Quantity: 3}, grpc.Header(&header))
+
+	require.NoError(t, err)
+	assert.Equal(t, int32(3), resp.ItemsBought)
+	assert.Equal(t, "Purchased 3x stick", resp.Message)
+	assert.NotEmpty(t, header.Get("x-request-id"))
+}
+
+func TestBuyItem_DomainErrorCode(t *testing.T) {
+	env := newTestEnv(t)
+	env.progression.On("IsFeatureUnlocked", mock.Anything, progression.FeatureEconomy).Return(true, nil)
+	env.economy.On("BuyItem", mock.Anything, domain.PlatformTwitch, "123", "tester", "stick", 1).
+		Return(0, fmt.Errorf("buy stick: %w", domain.ErrInsufficientFunds))
+
+	_, err := pb.NewEconomyServiceClient(env.conn).BuyItem(authed(), &pb.BuyItemRequest{Caller: caller(), ItemName: "stick", Quantity: 1})
+
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	assert.Equal(t, "INSUFFICIENT_FUNDS", errorInfo(t, err).Reason)
+}
+
+func TestBuyItem_FeatureLocked(t *testing.T) {
+	env := newTestEnv(t)
+	env.progression.On("IsFeatureUnlocked", mock.Anything, progression.FeatureEconomy).Return(false, nil)
+	env.progression.On("GetRequiredNodes", mock.Anything, progression.FeatureEconomy).
+		Return([]*domain.ProgressionNode{{DisplayName: "Economy"}}, nil)
+
+	_, err := pb.NewEconomyServiceClient(env.conn).BuyItem(authed(), &pb.BuyItemRequest{Caller: caller(), ItemName: "stick", Quantity: 1})
+
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	info := errorInfo(t, err)
+	assert.Equal(t, "FEATURE_LOCKED", info.Reason)
+	assert.Equal(t, progression.FeatureEconomy, info.Metadata["feature"])
+	assert.Equal(t, "Economy", info.Metadata["required_nodes"])
+}
+
+func TestBuyItem_FrozenAccount(t *testing.T) {
+	env := newTestEnv(t)
+	env.moderation.On("IsFrozen", mock.Anything, domain.PlatformTwitch, "frozen-1").Return(true, nil)
+
+	_, err := pb.NewEconomyServiceClient(env.conn).BuyItem(authed(), &pb.BuyItemRequest{
+		Caller:   &pb.Caller{Platform: domain.PlatformTwitch, PlatformId: "frozen-1", Username: "frosty"},
+		ItemName: "stick",
+		Quantity: 1,
+	})
+
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	assert.Equal(t, "ACCOUNT_FROZEN", errorInfo(t, err).Reason)
+	env.economy.AssertNotCalled(t, "BuyItem", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestGiveItem_TimedOut(t *testing.T) {
+	env := newTestEnv(t)
+	env.moderation.On("IsFrozen", mock.Anything, domain.PlatformTwitch, "456").Return(false, nil)
+	env.users.On("GetTimeoutPlatform", mock.Anything, domain.PlatformTwitch, "muted").Return(90*time.Second, nil)
+
+	_, err := pb.NewUserServiceClient(env.conn).GiveItem(authed(), &pb.GiveItemRequest{
+		Caller:           &pb.Caller{Platform: domain.PlatformTwitch, PlatformId: "456", Username: "muted"},
+		ReceiverPlatform: domain.PlatformTwitch,
+		ReceiverUsername: "friend",
+		ItemName:         "stick",
+		Quantity:         1,
+	})
+
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	assert.Equal(t, "FORBIDDEN", errorInfo(t, err).Reason)
+	env.users.AssertNotCalled(t, "GiveItem", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestVote_ValidationError(t *testing.T) {
+	env := newTestEnv(t)
+
+	_, err := pb.NewProgressionServiceClient(env.conn).Vote(authed(), &pb.VoteRequest{Caller: caller(), OptionIndex: 0})
+
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	info := errorInfo(t, err)
+	assert.Equal(t, "VALIDATION_FAILED", info.Reason)
+	assert.NotEmpty(t, info.Metadata, "failed fields are reported in metadata")
+}
+
+func TestVote_AlreadyVoted(t *testing.T) {
+	env := newTestEnv(t)
+	env.progression.On("VoteForUnlock", mock.Anything, domain.PlatformTwitch, "123", "tester", 2).Return(domain.ErrUserAlreadyVoted)
+
+	resp, err := pb.NewProgressionServiceClient(env.conn).Vote(authed(), &pb.VoteRequest{Caller: caller(), OptionIndex: 2})
+
+	require.NoError(t, err)
+	assert.True(t, resp.AlreadyVoted)
+}
+
+func TestSubscribe_StreamsHubEvents(t *testing.T) {
+	env := newTestEnv(t)
+	ctx, cancel := context.WithTimeout(authed(), 5*time.Second)
+	defer cancel()
+
+	stream, err := pb.NewEventServiceClient(env.conn).Subscribe(ctx, &pb.SubscribeRequest{Types: []string{sse.EventTypeVotingStarted}})
+	require.NoError(t, err)
+
+	require.Eventually(t, func() bool { return env.hub.ClientCount() == 1 }, time.Second, 10*time.Millisecond)
+	env.hub.Broadcast(context.Background(), sse.EventTypeJobLevelUp, map[string]int{"level": 2})
+	env.hub.Broadcast(context.Background(), sse.EventTypeVotingStarted, map[string]string{"node": "economy"})
+
+	resp, err := stream.Recv()
+	require.NoError(t, err)
+	assert.Equal(t, sse.EventTypeVotingStarted, resp.Event.Type)
+	assert.JSONEq(t, `{"node":"economy"}`, string(resp.Event.PayloadJson))
+}
+
+func TestSubscribe_OnlyStreamsOwnCommunity(t *testing.T) {
+	env := newTestEnv(t)
+	ctx, cancel := context.WithTimeout(metadata.AppendToOutgoingContext(authed(), grpcserver.MetadataCommunityID, "alpha"), 5*time.Second)
+	defer cancel()
+
+	stream, err := pb.NewEventServiceClient(env.conn).Subscribe(ctx, &pb.SubscribeRequest{})
+	require.NoError(t, err)
+
+	require.Eventually(t, func() bool { return env.hub.ClientCount() == 1 }, time.Second, 10*time.Millisecond)
+	env.hub.Broadcast(context.Background(), sse.EventTypeJobLevelUp, map[string]string{"community": "default"})
+	env.hub.Broadcast(community.WithID(context.Background(), "alpha"), sse.EventTypeJobLevelUp, map[string]string{"community": "alpha"})
+
+	resp, err := stream.Recv()
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"community":"alpha"}`, string(resp.Event.PayloadJson))
+}
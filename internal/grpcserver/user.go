@@ -0,0 +1,178 @@
+package grpcserver
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/grpc/codes"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/event"
+	"github.com/osse101/BrandishBot_Go/internal/handler"
+	"github.com/osse101/BrandishBot_Go/internal/logger"
+	"github.com/osse101/BrandishBot_Go/internal/middleware"
+	"github.com/osse101/BrandishBot_Go/internal/progression"
+	"github.com/osse101/BrandishBot_Go/internal/user"
+	pb "github.com/osse101/BrandishBot_Go/pkg/pb/brandishbot/v1"
+)
+
+type userServer struct {
+	pb.UnimplementedUserServiceServer
+	svc         user.Service
+	progression progression.Service
+	bus         event.Bus
+	drops       handler.KeywordDropper
+}
+
+func (s *userServer) HandleMessage(ctx context.Context, req *pb.HandleMessageRequest) (*pb.HandleMessageResponse, error) {
+	c := req.GetCaller()
+	if err := validate(handler.HandleMessageRequest{Platform: c.GetPlatform(), PlatformID: c.GetPlatformId(), Username: c.GetUsername(), Message: req.GetMessage()}); err != nil {
+		return nil, err
+	}
+
+	result, err := s.svc.HandleIncomingMessage(ctx, c.GetPlatform(), c.GetPlatformId(), c.GetUsername(), req.GetMessage())
+	if err != nil {
+		logger.FromContext(ctx).Error("Failed to handle message", "error", err, "platform", c.GetPlatform(), "username", c.GetUsername())
+		return nil, toStatus(err)
+	}
+
+	middleware.TrackEngagementFromContext(
+		middleware.WithPlatform(middleware.WithUserID(ctx, result.User.ID), c.GetPlatform()),
+		s.bus,
+		domain.MetricTypeMessage,
+		1,
+	)
+
+	// Keyword drops are announced through the event bus, so a failure only logs
+	if s.drops != nil {
+		if _, err := s.drops.DropKeyword(ctx, result.User.ID, c.GetPlatform(), req.GetMessage()); err != nil {
+			logger.FromContext(ctx).Warn("Failed to roll keyword drop", "error", err, "user_id", result.User.ID)
+		}
+	}
+
+	matches := make([]*pb.FoundString, 0, len(result.Matches))
+	for _, m := range result.Matches {
+		matches = append(matches, &pb.FoundString{Code: m.Code, Value: m.Value})
+	}
+	return &pb.HandleMessageResponse{UserId: result.User.ID, Username: result.User.Username, Matches: matches}, nil
+}
+
+func (s *userServer) GetInventory(ctx context.Context, req *pb.GetInventoryRequest) (*pb.GetInventoryResponse, error) {
+	c := req.GetCaller()
+	if err := validate(callerRequest{Platform: c.GetPlatform(), PlatformID: c.GetPlatformId(), Username: c.GetUsername()}); err != nil {
+		return nil, err
+	}
+
+	filter := req.GetFilter()
+	if filter != "" {
+		if !domain.IsValidFilterType(filter) {
+			return nil, statusError(codes.InvalidArgument, handler.ErrCodeBadRequest, fmt.Sprintf(handler.ErrMsgInvalidFilterType, filter), nil)
+		}
+		unlocked, err := s.progression.IsFeatureUnlocked(ctx, fmt.Sprintf("feature_filter_%s", filter))
+		if err != nil {
+			return nil, toStatus(err)
+		}
+		if !unlocked {
+			return nil, statusError(codes.FailedPrecondition, handler.ErrCodeFeatureLocked, fmt.Sprintf(handler.ErrMsgFilterLocked, filter),
+				map[string]string{"feature": "feature_filter_" + filter})
+		}
+	}
+
+	items, err := s.svc.GetInventory(ctx, c.GetPlatform(), c.GetPlatformId(), c.GetUsername(), filter)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	capacity, err := s.svc.GetInventoryCapacity(ctx, c.GetPlatform(), c.GetPlatformId(), c.GetUsername())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	resp := &pb.GetInventoryResponse{
+		Items:     make([]*pb.InventoryItem, 0, len(items)),
+		UsedSlots: int32(capacity.UsedSlots),
+		MaxSlots:  int32(capacity.MaxSlots),
+	}
+	for _, it := range items {
+		resp.Items = append(resp.Items, &pb.InventoryItem{
+			ItemName:     it.InternalName,
+			PublicName:   it.PublicName,
+			Quantity:     int32(it.Quantity),
+			QualityLevel: it.QualityLevel,
+			Favorite:     it.Favorite,
+			Locked:       it.Locked,
+		})
+	}
+	return resp, nil
+}
+
+func (s *userServer) UseItem(ctx context.Context, req *pb.UseItemRequest) (*pb.UseItemResponse, error) {
+	c := req.GetCaller()
+	r := handler.UseItemRequest{
+		Platform:   c.GetPlatform(),
+		PlatformID: c.GetPlatformId(),
+		Username:   c.GetUsername(),
+		ItemName:   req.GetItemName(),
+		Quantity:   int(req.GetQuantity()),
+		TargetUser: req.GetTargetUsername(),
+	}
+	if err := validate(r); err != nil {
+		return nil, err
+	}
+	if r.Quantity <= 0 {
+		r.Quantity = 1
+	}
+
+	internalName := r.ItemName
+	if item, err := s.svc.GetItemByName(ctx, r.ItemName); err == nil && item != nil {
+		internalName = item.InternalName
+	}
+	if nodeKey := handler.ProgressionNodeForItem(internalName); nodeKey != "" {
+		if err := checkFeatureLocked(ctx, s.progression, nodeKey); err != nil {
+			return nil, err
+		}
+	}
+
+	result, err := s.svc.UseItemDetailed(ctx, r.Platform, r.PlatformID, r.Username, r.ItemName, r.Quantity, r.TargetUser)
+	if err != nil {
+		logger.FromContext(ctx).Error("Failed to use item", "error", err, "username", r.Username, "item", r.ItemName)
+		return nil, toStatus(err)
+	}
+
+	engagementPoints := r.Quantity
+	switch r.ItemName {
+	case domain.ItemLootbox0, domain.ItemLootbox1, domain.ItemLootbox2, domain.ItemLootbox3:
+		engagementPoints = 0
+	}
+	if engagementPoints > 0 {
+		if userID, err := s.svc.GetUserIDByPlatformID(ctx, r.Platform, r.PlatformID); err == nil && userID != "" {
+			middleware.TrackEngagementFromContext(middleware.WithUserID(ctx, userID), s.bus, domain.MetricTypeItemUsed, engagementPoints)
+		}
+	}
+
+	return &pb.UseItemResponse{Message: result.Message}, nil
+}
+
+func (s *userServer) GiveItem(ctx context.Context, req *pb.GiveItemRequest) (*pb.GiveItemResponse, error) {
+	c := req.GetCaller()
+	r := handler.GiveItemRequest{
+		OwnerPlatform:    c.GetPlatform(),
+		OwnerPlatformID:  c.GetPlatformId(),
+		Owner:            c.GetUsername(),
+		ReceiverPlatform: req.GetReceiverPlatform(),
+		Receiver:         req.GetReceiverUsername(),
+		ItemName:         req.GetItemName(),
+		Quantity:         int(req.GetQuantity()),
+	}
+	if err := validate(r); err != nil {
+		return nil, err
+	}
+	if r.OwnerPlatform == r.ReceiverPlatform && (r.Owner == r.Receiver || r.OwnerPlatformID == r.Receiver) {
+		return nil, statusError(codes.InvalidArgument, handler.ErrCodeBadRequest, ErrMsgSelfGive, nil)
+	}
+
+	if err := s.svc.GiveItem(ctx, r.OwnerPlatform, r.OwnerPlatformID, r.Owner, r.ReceiverPlatform, r.Receiver, r.ItemName, r.Quantity); err != nil {
+		logger.FromContext(ctx).Error("Failed to give item", "error", err, "owner", r.Owner, "receiver", r.Receiver, "item", r.ItemName)
+		return nil, toStatus(err)
+	}
+	return &pb.GiveItemResponse{Message: handler.MsgItemTransferredSuccess}, nil
+}
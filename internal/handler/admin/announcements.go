@@ -0,0 +1,154 @@
+package admin
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+
+	"github.com/osse101/BrandishBot_Go/internal/announce"
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/handler"
+	"github.com/osse101/BrandishBot_Go/internal/logger"
+)
+
+// CreateAnnouncementRouteRequest asks for an announcement route
+type CreateAnnouncementRouteRequest struct {
+	EventType   string `json:"event_type" validate:"required,max=100"`
+	Destination string `json:"destination" validate:"required,oneof=discord webhook sse"`
+	Target      string `json:"target" validate:"required,max=2048"`
+	Template    string `json:"template" validate:"required,max=4000"`
+	// Enabled defaults to true
+	Enabled   *bool  `json:"enabled"`
+	CreatedBy string `json:"created_by" validate:"required,max=100"`
+}
+
+// UpdateAnnouncementRouteRequest replaces an announcement route's rule
+type UpdateAnnouncementRouteRequest struct {
+	EventType   string `json:"event_type" validate:"required,max=100"`
+	Destination string `json:"destination" validate:"required,oneof=discord webhook sse"`
+	Target      string `json:"target" validate:"required,max=2048"`
+	Template    string `json:"template" validate:"required,max=4000"`
+	Enabled     bool   `json:"enabled"`
+}
+
+// AnnouncementHandler creates, lists, updates and deletes announcement routes
+type AnnouncementHandler struct {
+	svc announce.Service
+}
+
+// NewAnnouncementHandler creates a new admin announcement route handler
+func NewAnnouncementHandler(svc announce.Service) *AnnouncementHandler {
+	return &AnnouncementHandler{svc: svc}
+}
+
+// HandleCreate adds an announcement route
+// POST /api/v1/admin/announcements/routes
+func (h *AnnouncementHandler) HandleCreate(w http.ResponseWriter, r *http.Request) {
+	var req CreateAnnouncementRouteRequest
+	if err := handler.DecodeAndValidateRequest(r, w, &req, "Admin create announcement route"); err != nil {
+		return
+	}
+
+	enabled := true
+	if req.Enabled != nil {
+		enabled = *req.Enabled
+	}
+
+	route, err := h.svc.CreateRoute(r.Context(), announce.RouteRequest{
+		EventType:   req.EventType,
+		Destination: req.Destination,
+		Target:      req.Target,
+		Template:    req.Template,
+		Enabled:     enabled,
+		CreatedBy:   req.CreatedBy,
+	})
+	if err != nil {
+		respondAnnouncementError(w, r, err, "Failed to create announcement route")
+		return
+	}
+
+	handler.RespondJSON(w, http.StatusCreated, route)
+}
+
+// HandleList returns every announcement route along with the event types
+// routes can be set up for
+// GET /api/v1/admin/announcements/routes
+func (h *AnnouncementHandler) HandleList(w http.ResponseWriter, r *http.Request) {
+	routes, err := h.svc.ListRoutes(r.Context())
+	if err != nil {
+		respondAnnouncementError(w, r, err, "Failed to list announcement routes")
+		return
+	}
+
+	handler.RespondJSON(w, http.StatusOK, map[string]interface{}{
+		"routes":      routes,
+		"event_types": announce.SupportedEventTypes(),
+	})
+}
+
+// HandleUpdate replaces an announcement route's rule
+// PUT /api/v1/admin/announcements/routes/{id}
+func (h *AnnouncementHandler) HandleUpdate(w http.ResponseWriter, r *http.Request) {
+	id, ok := parseAnnouncementRouteID(w, r)
+	if !ok {
+		return
+	}
+
+	var req UpdateAnnouncementRouteRequest
+	if err := handler.DecodeAndValidateRequest(r, w, &req, "Admin update announcement route"); err != nil {
+		return
+	}
+
+	route, err := h.svc.UpdateRoute(r.Context(), id, announce.RouteRequest{
+		EventType:   req.EventType,
+		Destination: req.Destination,
+		Target:      req.Target,
+		Template:    req.Template,
+		Enabled:     req.Enabled,
+	})
+	if err != nil {
+		respondAnnouncementError(w, r, err, "Failed to update announcement route")
+		return
+	}
+
+	handler.RespondJSON(w, http.StatusOK, route)
+}
+
+// HandleDelete removes an announcement route
+// DELETE /api/v1/admin/announcements/routes/{id}
+func (h *AnnouncementHandler) HandleDelete(w http.ResponseWriter, r *http.Request) {
+	id, ok := parseAnnouncementRouteID(w, r)
+	if !ok {
+		return
+	}
+
+	if err := h.svc.DeleteRoute(r.Context(), id); err != nil {
+		respondAnnouncementError(w, r, err, "Failed to delete announcement route")
+		return
+	}
+
+	handler.RespondJSON(w, http.StatusOK, handler.SuccessResponse{Message: "Announcement route deleted"})
+}
+
+func parseAnnouncementRouteID(w http.ResponseWriter, r *http.Request) (int64, bool) {
+	id, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil || id < 1 {
+		handler.RespondError(w, http.StatusBadRequest, "Invalid announcement route ID")
+		return 0, false
+	}
+	return id, true
+}
+
+func respondAnnouncementError(w http.ResponseWriter, r *http.Request, err error, msg string) {
+	switch {
+	case errors.Is(err, domain.ErrAnnouncementRouteNotFound):
+		handler.RespondError(w, http.StatusNotFound, "Announcement route not found")
+	case errors.Is(err, domain.ErrInvalidInput):
+		handler.RespondError(w, http.StatusBadRequest, err.Error())
+	default:
+		logger.FromContext(r.Context()).Error(msg, "error", err)
+		handler.RespondError(w, http.StatusInternalServerError, msg)
+	}
+}
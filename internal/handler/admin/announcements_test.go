@@ -0,0 +1,135 @@
+package admin
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/osse101/BrandishBot_Go/internal/announce"
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/mocks"
+)
+
+func TestAnnouncementHandler_HandleCreate(t *testing.T) {
+	tests := []struct {
+		name           string
+		body           string
+		setup          func(*mocks.MockAnnounceService)
+		expectedStatus int
+	}{
+		{
+			name: "creates an enabled route by default",
+			body: `{"event_type":"gamble.completed","destination":"discord","target":"42","template":"{{.Payload.winner_username}} won","created_by":"admin"}`,
+			setup: func(m *mocks.MockAnnounceService) {
+				m.On("CreateRoute", mock.Anything, announce.RouteRequest{
+					EventType:   "gamble.completed",
+					Destination: "discord",
+					Target:      "42",
+					Template:    "{{.Payload.winner_username}} won",
+					Enabled:     true,
+					CreatedBy:   "admin",
+				}).Return(&domain.AnnouncementRoute{ID: 1}, nil)
+			},
+			expectedStatus: http.StatusCreated,
+		},
+		{
+			name:           "unknown destination",
+			body:           `{"event_type":"gamble.completed","destination":"email","target":"42","template":"hi","created_by":"admin"}`,
+			setup:          func(m *mocks.MockAnnounceService) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name: "invalid route",
+			body: `{"event_type":"nope","destination":"sse","target":"overlay","template":"hi","created_by":"admin"}`,
+			setup: func(m *mocks.MockAnnounceService) {
+				m.On("CreateRoute", mock.Anything, mock.Anything).Return(nil, domain.ErrInvalidInput)
+			},
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := mocks.NewMockAnnounceService(t)
+			tt.setup(svc)
+
+			req := httptest.NewRequest(http.MethodPost, "/api/v1/admin/announcements/routes", bytes.NewBufferString(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			rec := httptest.NewRecorder()
+			NewAnnouncementHandler(svc).HandleCreate(rec, req)
+
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+		})
+	}
+}
+
+func announcementRouteRequest(method, target, id, body string) *http.Request {
+	rctx := chi.NewRouteContext()
+	rctx.URLParams.Add("id", id)
+	req := httptest.NewRequest(method, target, bytes.NewBufferString(body))
+	req.Header.Set("Content-Type", "application/json")
+	return req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, rctx))
+}
+
+func TestAnnouncementHandler_HandleUpdate(t *testing.T) {
+	body := `{"event_type":"stream.recap","destination":"sse","target":"overlay.recap","template":"Thanks!","enabled":false}`
+
+	t.Run("replaces the route", func(t *testing.T) {
+		svc := mocks.NewMockAnnounceService(t)
+		svc.On("UpdateRoute", mock.Anything, int64(5), mock.MatchedBy(func(req announce.RouteRequest) bool {
+			return req.Target == "overlay.recap" && !req.Enabled
+		})).Return(&domain.AnnouncementRoute{ID: 5}, nil)
+
+		rec := httptest.NewRecorder()
+		NewAnnouncementHandler(svc).HandleUpdate(rec, announcementRouteRequest(http.MethodPut, "/api/v1/admin/announcements/routes/5", "5", body))
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+	})
+
+	t.Run("unknown route", func(t *testing.T) {
+		svc := mocks.NewMockAnnounceService(t)
+		svc.On("UpdateRoute", mock.Anything, int64(5), mock.Anything).Return(nil, domain.ErrAnnouncementRouteNotFound)
+
+		rec := httptest.NewRecorder()
+		NewAnnouncementHandler(svc).HandleUpdate(rec, announcementRouteRequest(http.MethodPut, "/api/v1/admin/announcements/routes/5", "5", body))
+
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+	})
+
+	t.Run("invalid ID", func(t *testing.T) {
+		svc := mocks.NewMockAnnounceService(t)
+
+		rec := httptest.NewRecorder()
+		NewAnnouncementHandler(svc).HandleUpdate(rec, announcementRouteRequest(http.MethodPut, "/api/v1/admin/announcements/routes/x", "x", body))
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+}
+
+func TestAnnouncementHandler_HandleList(t *testing.T) {
+	svc := mocks.NewMockAnnounceService(t)
+	svc.On("ListRoutes", mock.Anything).Return([]domain.AnnouncementRoute{{ID: 1, EventType: "stream.recap"}}, nil)
+
+	rec := httptest.NewRecorder()
+	NewAnnouncementHandler(svc).HandleList(rec, httptest.NewRequest(http.MethodGet, "/api/v1/admin/announcements/routes", nil))
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Body.String(), `"event_type":"stream.recap"`)
+	assert.Contains(t, rec.Body.String(), `"event_types":[`)
+}
+
+func TestAnnouncementHandler_HandleDelete(t *testing.T) {
+	svc := mocks.NewMockAnnounceService(t)
+	svc.On("DeleteRoute", mock.Anything, int64(2)).Return(domain.ErrAnnouncementRouteNotFound)
+
+	rec := httptest.NewRecorder()
+	NewAnnouncementHandler(svc).HandleDelete(rec, announcementRouteRequest(http.MethodDelete, "/api/v1/admin/announcements/routes/2", "2", ""))
+
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
@@ -0,0 +1,98 @@
+package admin
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+
+	"github.com/osse101/BrandishBot_Go/internal/apitoken"
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/handler"
+	"github.com/osse101/BrandishBot_Go/internal/logger"
+)
+
+// IssueAPITokenRequest asks for a read-only API token for a guild
+type IssueAPITokenRequest struct {
+	GuildID   string `json:"guild_id" validate:"required,max=32"`
+	Name      string `json:"name" validate:"required,max=100"`
+	CreatedBy string `json:"created_by" validate:"required,max=100"`
+}
+
+// APITokenHandler issues, lists and revokes guild API tokens
+type APITokenHandler struct {
+	svc apitoken.Service
+}
+
+// NewAPITokenHandler creates a new admin API token handler
+func NewAPITokenHandler(svc apitoken.Service) *APITokenHandler {
+	return &APITokenHandler{svc: svc}
+}
+
+// HandleIssue creates a token. The response is the only time the token itself
+// is shown.
+// POST /api/v1/admin/api-tokens
+func (h *APITokenHandler) HandleIssue(w http.ResponseWriter, r *http.Request) {
+	var req IssueAPITokenRequest
+	if err := handler.DecodeAndValidateRequest(r, w, &req, "Admin issue API token"); err != nil {
+		return
+	}
+
+	issued, err := h.svc.IssueToken(r.Context(), apitoken.IssueRequest{
+		GuildID:   req.GuildID,
+		Name:      req.Name,
+		CreatedBy: req.CreatedBy,
+	})
+	if err != nil {
+		respondAPITokenError(w, r, err, "Failed to issue API token")
+		return
+	}
+
+	handler.RespondJSON(w, http.StatusCreated, issued)
+}
+
+// HandleList returns tokens, optionally for one guild
+// GET /api/v1/admin/api-tokens?guild_id=
+func (h *APITokenHandler) HandleList(w http.ResponseWriter, r *http.Request) {
+	tokens, err := h.svc.ListTokens(r.Context(), r.URL.Query().Get("guild_id"))
+	if err != nil {
+		respondAPITokenError(w, r, err, "Failed to list API tokens")
+		return
+	}
+
+	handler.RespondJSON(w, http.StatusOK, map[string]interface{}{
+		"tokens": tokens,
+	})
+}
+
+// HandleRevoke stops a token working
+// DELETE /api/v1/admin/api-tokens/{id}
+func (h *APITokenHandler) HandleRevoke(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil || id < 1 {
+		handler.RespondError(w, http.StatusBadRequest, "Invalid API token ID")
+		return
+	}
+
+	if err := h.svc.RevokeToken(r.Context(), id); err != nil {
+		respondAPITokenError(w, r, err, "Failed to revoke API token")
+		return
+	}
+
+	handler.RespondJSON(w, http.StatusOK, handler.SuccessResponse{Message: "API token revoked"})
+}
+
+func respondAPITokenError(w http.ResponseWriter, r *http.Request, err error, msg string) {
+	switch {
+	case errors.Is(err, domain.ErrAPITokenNotFound):
+		handler.RespondError(w, http.StatusNotFound, "API token not found or already revoked")
+	case errors.Is(err, domain.ErrTooManyAPITokens):
+		handler.RespondError(w, http.StatusConflict, err.Error())
+	case errors.Is(err, domain.ErrInvalidInput):
+		handler.RespondError(w, http.StatusBadRequest, err.Error())
+	default:
+		logger.FromContext(r.Context()).Error(msg, "error", err)
+		handler.RespondError(w, http.StatusInternalServerError, msg)
+	}
+}
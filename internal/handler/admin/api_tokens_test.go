@@ -0,0 +1,109 @@
+package admin
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/osse101/BrandishBot_Go/internal/apitoken"
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/mocks"
+)
+
+func TestAPITokenHandler_HandleIssue(t *testing.T) {
+	tests := []struct {
+		name           string
+		body           string
+		setup          func(*mocks.MockAPITokenService)
+		expectedStatus int
+	}{
+		{
+			name: "issues a token",
+			body: `{"guild_id":"g1","name":"overlay","created_by":"admin"}`,
+			setup: func(m *mocks.MockAPITokenService) {
+				m.On("IssueToken", mock.Anything, apitoken.IssueRequest{GuildID: "g1", Name: "overlay", CreatedBy: "admin"}).
+					Return(&apitoken.IssuedToken{APIToken: domain.APIToken{ID: 1}, Token: "bbt_x"}, nil)
+			},
+			expectedStatus: http.StatusCreated,
+		},
+		{
+			name:           "missing guild",
+			body:           `{"name":"overlay","created_by":"admin"}`,
+			setup:          func(m *mocks.MockAPITokenService) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name: "guild at its limit",
+			body: `{"guild_id":"g1","name":"overlay","created_by":"admin"}`,
+			setup: func(m *mocks.MockAPITokenService) {
+				m.On("IssueToken", mock.Anything, mock.Anything).Return(nil, domain.ErrTooManyAPITokens)
+			},
+			expectedStatus: http.StatusConflict,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := mocks.NewMockAPITokenService(t)
+			tt.setup(svc)
+			h := NewAPITokenHandler(svc)
+
+			req := httptest.NewRequest(http.MethodPost, "/api/v1/admin/api-tokens", bytes.NewBufferString(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			rec := httptest.NewRecorder()
+			h.HandleIssue(rec, req)
+
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+		})
+	}
+}
+
+func TestAPITokenHandler_HandleList(t *testing.T) {
+	svc := mocks.NewMockAPITokenService(t)
+	svc.On("ListTokens", mock.Anything, "g1").Return([]domain.APIToken{{ID: 1, GuildID: "g1"}}, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/admin/api-tokens?guild_id=g1", nil)
+	rec := httptest.NewRecorder()
+	NewAPITokenHandler(svc).HandleList(rec, req)
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Body.String(), `"guild_id":"g1"`)
+}
+
+func TestAPITokenHandler_HandleRevoke(t *testing.T) {
+	revoke := func(h *APITokenHandler, id string) *httptest.ResponseRecorder {
+		rctx := chi.NewRouteContext()
+		rctx.URLParams.Add("id", id)
+		req := httptest.NewRequest(http.MethodDelete, "/api/v1/admin/api-tokens/"+id, nil)
+		req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, rctx))
+		rec := httptest.NewRecorder()
+		h.HandleRevoke(rec, req)
+		return rec
+	}
+
+	t.Run("revokes the token", func(t *testing.T) {
+		svc := mocks.NewMockAPITokenService(t)
+		svc.On("RevokeToken", mock.Anything, int64(2)).Return(nil)
+
+		assert.Equal(t, http.StatusOK, revoke(NewAPITokenHandler(svc), "2").Code)
+	})
+
+	t.Run("token already revoked", func(t *testing.T) {
+		svc := mocks.NewMockAPITokenService(t)
+		svc.On("RevokeToken", mock.Anything, int64(2)).Return(domain.ErrAPITokenNotFound)
+
+		assert.Equal(t, http.StatusNotFound, revoke(NewAPITokenHandler(svc), "2").Code)
+	})
+
+	t.Run("rejects invalid IDs", func(t *testing.T) {
+		svc := mocks.NewMockAPITokenService(t)
+
+		assert.Equal(t, http.StatusBadRequest, revoke(NewAPITokenHandler(svc), "abc").Code)
+	})
+}
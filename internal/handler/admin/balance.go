@@ -0,0 +1,99 @@
+package admin
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+
+	"github.com/osse101/BrandishBot_Go/internal/balance"
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/handler"
+	"github.com/osse101/BrandishBot_Go/internal/logger"
+)
+
+// ScheduleBalanceChangeRequest sets an item's base value or its weight in one
+// loot pool from a point in time
+type ScheduleBalanceChangeRequest struct {
+	ItemName string `json:"item_name" validate:"required,max=100"`
+	Field    string `json:"field" validate:"required,oneof=base_value loot_weight"`
+	Pool     string `json:"pool" validate:"max=100"`
+	Value    int    `json:"value" validate:"min=0"`
+	// EffectiveAt is when the change takes effect; omitted means now
+	EffectiveAt *time.Time `json:"effective_at"`
+	Reason      string     `json:"reason" validate:"max=500"`
+	CreatedBy   string     `json:"created_by" validate:"required,max=100"`
+}
+
+// BalanceHandler schedules and cancels item balance changes
+type BalanceHandler struct {
+	svc balance.Service
+}
+
+// NewBalanceHandler creates a new admin balance handler
+func NewBalanceHandler(svc balance.Service) *BalanceHandler {
+	return &BalanceHandler{svc: svc}
+}
+
+// HandleSchedule stores a balance change, applying it straight away when it
+// has no effective time
+// POST /api/v1/admin/items/balance-changes
+func (h *BalanceHandler) HandleSchedule(w http.ResponseWriter, r *http.Request) {
+	var req ScheduleBalanceChangeRequest
+	if err := handler.DecodeAndValidateRequest(r, w, &req, "Admin schedule balance change"); err != nil {
+		return
+	}
+
+	scheduleReq := balance.ScheduleRequest{
+		ItemName:  req.ItemName,
+		Field:     domain.BalanceField(req.Field),
+		Pool:      req.Pool,
+		Value:     req.Value,
+		Reason:    req.Reason,
+		CreatedBy: req.CreatedBy,
+	}
+	if req.EffectiveAt != nil {
+		scheduleReq.EffectiveAt = *req.EffectiveAt
+	}
+
+	change, err := h.svc.ScheduleChange(r.Context(), scheduleReq)
+	if err != nil {
+		respondBalanceError(w, r, err, "Failed to schedule balance change")
+		return
+	}
+
+	handler.RespondJSON(w, http.StatusCreated, change)
+}
+
+// HandleCancel removes a balance change that has not taken effect
+// DELETE /api/v1/admin/items/balance-changes/{id}
+func (h *BalanceHandler) HandleCancel(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil || id < 1 {
+		handler.RespondError(w, http.StatusBadRequest, "Invalid balance change ID")
+		return
+	}
+
+	if err := h.svc.CancelChange(r.Context(), id); err != nil {
+		respondBalanceError(w, r, err, "Failed to cancel balance change")
+		return
+	}
+
+	handler.RespondJSON(w, http.StatusOK, handler.SuccessResponse{Message: "Balance change cancelled"})
+}
+
+func respondBalanceError(w http.ResponseWriter, r *http.Request, err error, msg string) {
+	switch {
+	case errors.Is(err, domain.ErrBalanceChangeNotFound):
+		handler.RespondError(w, http.StatusNotFound, "Balance change not found or already in effect")
+	case errors.Is(err, domain.ErrItemNotFound):
+		handler.RespondError(w, http.StatusNotFound, "Item not found")
+	case errors.Is(err, domain.ErrInvalidInput):
+		handler.RespondError(w, http.StatusBadRequest, err.Error())
+	default:
+		logger.FromContext(r.Context()).Error(msg, "error", err)
+		handler.RespondError(w, http.StatusInternalServerError, msg)
+	}
+}
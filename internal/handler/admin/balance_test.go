@@ -0,0 +1,98 @@
+package admin
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/osse101/BrandishBot_Go/internal/balance"
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/mocks"
+)
+
+func TestBalanceHandler_HandleSchedule(t *testing.T) {
+	tests := []struct {
+		name           string
+		body           string
+		setup          func(*mocks.MockBalanceService)
+		expectedStatus int
+	}{
+		{
+			name: "schedules a loot weight change",
+			body: `{"item_name":"sword","field":"loot_weight","pool":"common","value":5,"effective_at":"2026-11-01T00:00:00Z","created_by":"admin"}`,
+			setup: func(m *mocks.MockBalanceService) {
+				m.On("ScheduleChange", mock.Anything, mock.MatchedBy(func(req balance.ScheduleRequest) bool {
+					return req.Field == domain.BalanceFieldLootWeight && req.Pool == "common" && !req.EffectiveAt.IsZero()
+				})).Return(&domain.ItemBalanceChange{ID: 4, Status: domain.BalanceChangeUpcoming}, nil)
+			},
+			expectedStatus: http.StatusCreated,
+		},
+		{
+			name:           "unknown field",
+			body:           `{"item_name":"sword","field":"price","value":5,"created_by":"admin"}`,
+			setup:          func(m *mocks.MockBalanceService) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name: "unknown item",
+			body: `{"item_name":"nope","field":"base_value","value":5,"created_by":"admin"}`,
+			setup: func(m *mocks.MockBalanceService) {
+				m.On("ScheduleChange", mock.Anything, mock.Anything).Return(nil, domain.ErrItemNotFound)
+			},
+			expectedStatus: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := mocks.NewMockBalanceService(t)
+			tt.setup(svc)
+			h := NewBalanceHandler(svc)
+
+			req := httptest.NewRequest(http.MethodPost, "/api/v1/admin/items/balance-changes", bytes.NewBufferString(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			rec := httptest.NewRecorder()
+			h.HandleSchedule(rec, req)
+
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+		})
+	}
+}
+
+func TestBalanceHandler_HandleCancel(t *testing.T) {
+	cancel := func(h *BalanceHandler, id string) *httptest.ResponseRecorder {
+		rctx := chi.NewRouteContext()
+		rctx.URLParams.Add("id", id)
+		req := httptest.NewRequest(http.MethodDelete, "/api/v1/admin/items/balance-changes/"+id, nil)
+		req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, rctx))
+		rec := httptest.NewRecorder()
+		h.HandleCancel(rec, req)
+		return rec
+	}
+
+	t.Run("cancels the change", func(t *testing.T) {
+		svc := mocks.NewMockBalanceService(t)
+		svc.On("CancelChange", mock.Anything, int64(4)).Return(nil)
+
+		assert.Equal(t, http.StatusOK, cancel(NewBalanceHandler(svc), "4").Code)
+	})
+
+	t.Run("change already in effect", func(t *testing.T) {
+		svc := mocks.NewMockBalanceService(t)
+		svc.On("CancelChange", mock.Anything, int64(4)).Return(domain.ErrBalanceChangeNotFound)
+
+		assert.Equal(t, http.StatusNotFound, cancel(NewBalanceHandler(svc), "4").Code)
+	})
+
+	t.Run("rejects invalid IDs", func(t *testing.T) {
+		svc := mocks.NewMockBalanceService(t)
+
+		assert.Equal(t, http.StatusBadRequest, cancel(NewBalanceHandler(svc), "abc").Code)
+	})
+}
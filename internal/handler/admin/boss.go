@@ -0,0 +1,53 @@
+package admin
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/osse101/BrandishBot_Go/internal/boss"
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/handler"
+	"github.com/osse101/BrandishBot_Go/internal/logger"
+)
+
+// SpawnBossRequest spawns a boss from the roster. An empty key picks one at
+// random.
+type SpawnBossRequest struct {
+	Key       string `json:"key" validate:"max=100"`
+	SpawnedBy string `json:"spawned_by" validate:"required,max=100"`
+}
+
+// BossHandler spawns community bosses
+type BossHandler struct {
+	svc boss.Service
+}
+
+// NewBossHandler creates a new admin boss handler
+func NewBossHandler(svc boss.Service) *BossHandler {
+	return &BossHandler{svc: svc}
+}
+
+// HandleSpawn spawns a boss for the community to fight
+// POST /api/v1/admin/boss
+func (h *BossHandler) HandleSpawn(w http.ResponseWriter, r *http.Request) {
+	var req SpawnBossRequest
+	if err := handler.DecodeAndValidateRequest(r, w, &req, "Admin spawn boss"); err != nil {
+		return
+	}
+
+	spawned, err := h.svc.Spawn(r.Context(), req.Key, req.SpawnedBy)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrUnknownBoss):
+			handler.RespondError(w, http.StatusBadRequest, err.Error())
+		case errors.Is(err, domain.ErrBossAlreadyActive):
+			handler.RespondError(w, http.StatusConflict, "A boss is already active")
+		default:
+			logger.FromContext(r.Context()).Error("Failed to spawn boss", "error", err)
+			handler.RespondError(w, http.StatusInternalServerError, "Failed to spawn boss")
+		}
+		return
+	}
+
+	handler.RespondJSON(w, http.StatusCreated, spawned)
+}
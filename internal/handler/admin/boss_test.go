@@ -0,0 +1,69 @@
+package admin
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/mocks"
+)
+
+func TestBossHandler_HandleSpawn(t *testing.T) {
+	tests := []struct {
+		name           string
+		body           string
+		setup          func(*mocks.MockBossService)
+		expectedStatus int
+	}{
+		{
+			name: "spawns the boss",
+			body: `{"key":"kraken","spawned_by":"admin"}`,
+			setup: func(m *mocks.MockBossService) {
+				m.On("Spawn", mock.Anything, "kraken", "admin").Return(&domain.Boss{Key: "kraken", Name: "Kraken"}, nil)
+			},
+			expectedStatus: http.StatusCreated,
+		},
+		{
+			name:           "needs a spawner",
+			body:           `{"key":"kraken"}`,
+			setup:          func(m *mocks.MockBossService) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name: "unknown boss",
+			body: `{"key":"tax_collector","spawned_by":"admin"}`,
+			setup: func(m *mocks.MockBossService) {
+				m.On("Spawn", mock.Anything, "tax_collector", "admin").Return(nil, fmt.Errorf("%w: tax_collector", domain.ErrUnknownBoss))
+			},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name: "one boss at a time",
+			body: `{"spawned_by":"admin"}`,
+			setup: func(m *mocks.MockBossService) {
+				m.On("Spawn", mock.Anything, "", "admin").Return(nil, domain.ErrBossAlreadyActive)
+			},
+			expectedStatus: http.StatusConflict,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := mocks.NewMockBossService(t)
+			tt.setup(svc)
+
+			req := httptest.NewRequest(http.MethodPost, "/api/v1/admin/boss", bytes.NewBufferString(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			rec := httptest.NewRecorder()
+			NewBossHandler(svc).HandleSpawn(rec, req)
+
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+		})
+	}
+}
@@ -0,0 +1,71 @@
+package admin
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/osse101/BrandishBot_Go/internal/communitypool"
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/handler"
+	"github.com/osse101/BrandishBot_Go/internal/logger"
+)
+
+// FundProgressionRequest spends community pool money on progression
+type FundProgressionRequest struct {
+	Amount int `json:"amount" validate:"required,min=1"`
+}
+
+// CommunityPoolBalanceResponse is the money the community pool holds
+type CommunityPoolBalanceResponse struct {
+	Balance int64 `json:"balance"`
+}
+
+// CommunityPoolHandler reads and spends the community pool
+type CommunityPoolHandler struct {
+	svc communitypool.Service
+}
+
+// NewCommunityPoolHandler creates a new admin community pool handler
+func NewCommunityPoolHandler(svc communitypool.Service) *CommunityPoolHandler {
+	return &CommunityPoolHandler{svc: svc}
+}
+
+// HandleGetBalance returns the community pool's balance
+// GET /api/v1/admin/community-pool
+func (h *CommunityPoolHandler) HandleGetBalance(w http.ResponseWriter, r *http.Request) {
+	balance, err := h.svc.GetBalance(r.Context())
+	if err != nil {
+		logger.FromContext(r.Context()).Error("Failed to get community pool", "error", err)
+		handler.RespondError(w, http.StatusInternalServerError, "Failed to get community pool")
+		return
+	}
+
+	handler.RespondJSON(w, http.StatusOK, CommunityPoolBalanceResponse{Balance: balance})
+}
+
+// HandleFundProgression converts pool money into contribution points toward
+// the current unlock
+// POST /api/v1/admin/community-pool/fund-progression
+func (h *CommunityPoolHandler) HandleFundProgression(w http.ResponseWriter, r *http.Request) {
+	var req FundProgressionRequest
+	if err := handler.DecodeAndValidateRequest(r, w, &req, "Admin fund progression from community pool"); err != nil {
+		return
+	}
+
+	funding, err := h.svc.FundProgression(r.Context(), req.Amount)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrInvalidInput):
+			handler.RespondError(w, http.StatusBadRequest, err.Error())
+		case errors.Is(err, domain.ErrInsufficientFunds):
+			handler.RespondError(w, http.StatusBadRequest, "Community pool does not hold enough money")
+		default:
+			logger.FromContext(r.Context()).Error("Failed to fund progression from community pool", "error", err)
+			handler.RespondError(w, http.StatusInternalServerError, "Failed to fund progression")
+		}
+		return
+	}
+
+	logger.FromContext(r.Context()).Info("Admin funded progression from community pool", "spent", funding.Spent, "points", funding.Points)
+	handler.RespondJSON(w, http.StatusOK, funding)
+}
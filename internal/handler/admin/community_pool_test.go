@@ -0,0 +1,71 @@
+package admin
+
+import (
+	"bytes"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/mocks"
+)
+
+func TestCommunityPoolHandler_HandleFundProgression(t *testing.T) {
+	tests := []struct {
+		name           string
+		body           string
+		setup          func(*mocks.MockCommunitypoolService)
+		expectedStatus int
+	}{
+		{
+			name: "funds progression",
+			body: `{"amount":100}`,
+			setup: func(m *mocks.MockCommunitypoolService) {
+				m.On("FundProgression", mock.Anything, 100).Return(&domain.CommunityPoolFunding{Spent: 100, Points: 10}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "missing amount",
+			body:           `{}`,
+			setup:          func(m *mocks.MockCommunitypoolService) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name: "pool too small",
+			body: `{"amount":100}`,
+			setup: func(m *mocks.MockCommunitypoolService) {
+				m.On("FundProgression", mock.Anything, 100).Return(nil, domain.ErrInsufficientFunds)
+			},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name: "contribution fails",
+			body: `{"amount":100}`,
+			setup: func(m *mocks.MockCommunitypoolService) {
+				m.On("FundProgression", mock.Anything, 100).Return(nil, errors.New("db down"))
+			},
+			expectedStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := mocks.NewMockCommunitypoolService(t)
+			tt.setup(svc)
+			h := NewCommunityPoolHandler(svc)
+
+			req := httptest.NewRequest(http.MethodPost, "/api/v1/admin/community-pool/fund-progression", bytes.NewBufferString(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			rec := httptest.NewRecorder()
+
+			h.HandleFundProgression(rec, req)
+
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+		})
+	}
+}
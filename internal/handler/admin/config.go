@@ -1,8 +1,10 @@
 package admin
 
 import (
+	"context"
 	"net/http"
 
+	"github.com/osse101/BrandishBot_Go/internal/configreload"
 	"github.com/osse101/BrandishBot_Go/internal/handler"
 	"github.com/osse101/BrandishBot_Go/internal/logger"
 	"github.com/osse101/BrandishBot_Go/internal/naming"
@@ -16,7 +18,7 @@ import (
 // @Produce json
 // @Success 200 {object} map[string]interface{}
 // @Failure 500 {object} map[string]string
-// @Router /admin/reload-aliases [post]
+// @Router /api/v1/admin/reload-aliases [post]
 // @Security ApiKeyAuth
 func HandleReloadAliases(resolver naming.Resolver) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
@@ -44,3 +46,53 @@ func HandleReloadAliases(resolver naming.Resolver) http.HandlerFunc {
 		handler.RespondJSON(w, http.StatusOK, response)
 	}
 }
+
+// ConfigReloader reloads the hot-reloadable JSON game data
+type ConfigReloader interface {
+	ReloadAll(ctx context.Context) []configreload.Result
+}
+
+// ConfigReloadResponse lists the outcome for each reloaded config file
+type ConfigReloadResponse struct {
+	Message string                `json:"message"`
+	Results []configreload.Result `json:"results"`
+}
+
+// HandleReloadConfig reloads loot tables, item aliases, item themes, the progression tree and the search difficulty curve (admin only)
+// @Summary Reload game data configuration
+// @Description Re-reads the hot-reloadable JSON configs. Each file is validated before it replaces the running version; invalid files keep the previous version.
+// @Tags admin
+// @Produce json
+// @Success 200 {object} ConfigReloadResponse
+// @Failure 422 {object} ConfigReloadResponse
+// @Router /api/v1/admin/config/reload [post]
+// @Security ApiKeyAuth
+func HandleReloadConfig(reloader ConfigReloader) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		log := logger.FromContext(r.Context())
+
+		results := reloader.ReloadAll(r.Context())
+
+		failed := 0
+		for _, res := range results {
+			if res.Error != "" {
+				failed++
+			}
+		}
+
+		if failed > 0 {
+			log.Warn("Config reload finished with errors", "failed", failed, "total", len(results))
+			handler.RespondJSON(w, http.StatusUnprocessableEntity, ConfigReloadResponse{
+				Message: handler.ErrMsgReloadConfigFailed,
+				Results: results,
+			})
+			return
+		}
+
+		log.Info("Config reloaded", "total", len(results))
+		handler.RespondJSON(w, http.StatusOK, ConfigReloadResponse{
+			Message: handler.MsgGameConfigReloadedSuccess,
+			Results: results,
+		})
+	}
+}
@@ -0,0 +1,66 @@
+package admin
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/osse101/BrandishBot_Go/internal/configreload"
+	"github.com/osse101/BrandishBot_Go/internal/handler"
+)
+
+type fakeConfigReloader struct {
+	results []configreload.Result
+}
+
+func (f *fakeConfigReloader) ReloadAll(ctx context.Context) []configreload.Result {
+	return f.results
+}
+
+func TestHandleReloadConfig(t *testing.T) {
+	tests := []struct {
+		name           string
+		results        []configreload.Result
+		expectedStatus int
+		expectedMsg    string
+	}{
+		{
+			name: "all sources reloaded",
+			results: []configreload.Result{
+				{Name: configreload.SourceLootTables, Path: "configs/loot_tables.json"},
+				{Name: configreload.SourceItemThemes, Path: "configs/items/themes.json"},
+			},
+			expectedStatus: http.StatusOK,
+			expectedMsg:    handler.MsgGameConfigReloadedSuccess,
+		},
+		{
+			name: "invalid file keeps previous version",
+			results: []configreload.Result{
+				{Name: configreload.SourceLootTables, Path: "configs/loot_tables.json", Error: "schema validation failed"},
+				{Name: configreload.SourceItemThemes, Path: "configs/items/themes.json"},
+			},
+			expectedStatus: http.StatusUnprocessableEntity,
+			expectedMsg:    handler.ErrMsgReloadConfigFailed,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/admin/config/reload", nil)
+			w := httptest.NewRecorder()
+
+			HandleReloadConfig(&fakeConfigReloader{results: tt.results})(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			var resp ConfigReloadResponse
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+			assert.Equal(t, tt.expectedMsg, resp.Message)
+			assert.Equal(t, tt.results, resp.Results)
+		})
+	}
+}
@@ -20,15 +20,22 @@ func NewDailyResetHandler(jobService job.Service) *DailyResetHandler {
 	}
 }
 
+// ManualResetResponse reports a manual daily XP reset
+type ManualResetResponse struct {
+	Success         bool   `json:"success"`
+	Message         string `json:"message"`
+	RecordsAffected int64  `json:"records_affected"`
+}
+
 // HandleManualReset manually triggers a daily job XP reset
 // POST /api/v1/admin/jobs/reset-daily-xp
 // @Summary Manually trigger daily job XP reset
 // @Description Triggers an immediate reset of all users' daily XP counters
 // @Tags admin
 // @Produce json
-// @Success 200 {object} map[string]interface{}
-// @Failure 500 {object} map[string]interface{}
-// @Router /admin/jobs/reset-daily-xp [post]
+// @Success 200 {object} ManualResetResponse
+// @Failure 500 {object} handler.ErrorResponse
+// @Router /api/v1/admin/jobs/reset-daily-xp [post]
 func (h *DailyResetHandler) HandleManualReset(w http.ResponseWriter, r *http.Request) {
 	log := logger.FromContext(r.Context())
 	log.Info("Manual daily reset triggered")
@@ -42,10 +49,10 @@ func (h *DailyResetHandler) HandleManualReset(w http.ResponseWriter, r *http.Req
 
 	log.Info("Manual daily reset completed", "records_affected", recordsAffected)
 
-	handler.RespondJSON(w, http.StatusOK, map[string]interface{}{
-		"success":          true,
-		"message":          "Daily XP reset completed",
-		"records_affected": recordsAffected,
+	handler.RespondJSON(w, http.StatusOK, ManualResetResponse{
+		Success:         true,
+		Message:         "Daily XP reset completed",
+		RecordsAffected: recordsAffected,
 	})
 }
 
@@ -56,8 +63,8 @@ func (h *DailyResetHandler) HandleManualReset(w http.ResponseWriter, r *http.Req
 // @Tags admin
 // @Produce json
 // @Success 200 {object} domain.DailyResetStatus
-// @Failure 500 {object} map[string]interface{}
-// @Router /admin/jobs/reset-status [get]
+// @Failure 500 {object} handler.ErrorResponse
+// @Router /api/v1/admin/jobs/reset-status [get]
 func (h *DailyResetHandler) HandleGetResetStatus(w http.ResponseWriter, r *http.Request) {
 	log := logger.FromContext(r.Context())
 
@@ -0,0 +1,120 @@
+package admin
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+
+	"github.com/osse101/BrandishBot_Go/internal/eventdlq"
+	"github.com/osse101/BrandishBot_Go/internal/handler"
+	"github.com/osse101/BrandishBot_Go/internal/logger"
+)
+
+// DeadLetterHandler handles admin inspection and re-drive of failed event handlers
+type DeadLetterHandler struct {
+	svc eventdlq.Service
+}
+
+// NewDeadLetterHandler creates a new admin dead-letter handler
+func NewDeadLetterHandler(svc eventdlq.Service) *DeadLetterHandler {
+	return &DeadLetterHandler{svc: svc}
+}
+
+// DeadLetterListResponse contains dead-letter query results
+type DeadLetterListResponse struct {
+	Entries []eventdlq.Entry `json:"entries"`
+}
+
+// HandleList lists dead-letter entries
+// GET /api/v1/admin/events/dlq?status=pending&limit=N
+func (h *DeadLetterHandler) HandleList(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	filter := eventdlq.Filter{Limit: eventdlq.DefaultListLimit}
+
+	if status := query.Get("status"); status != "" {
+		switch status {
+		case eventdlq.StatusPending, eventdlq.StatusRedriven, eventdlq.StatusDiscarded:
+			filter.Status = &status
+		default:
+			handler.RespondError(w, http.StatusBadRequest, "Invalid 'status' (must be pending, redriven, or discarded)")
+			return
+		}
+	}
+
+	if limitStr := query.Get("limit"); limitStr != "" {
+		limit, err := strconv.Atoi(limitStr)
+		if err != nil || limit < 1 || limit > eventdlq.MaxListLimit {
+			handler.RespondError(w, http.StatusBadRequest, "Invalid 'limit' (must be 1-500)")
+			return
+		}
+		filter.Limit = limit
+	}
+
+	entries, err := h.svc.List(r.Context(), filter)
+	if err != nil {
+		logger.FromContext(r.Context()).Error("Failed to list dead-letter entries", "error", err)
+		handler.RespondError(w, http.StatusInternalServerError, "Failed to retrieve dead-letter entries")
+		return
+	}
+
+	handler.RespondJSON(w, http.StatusOK, DeadLetterListResponse{Entries: entries})
+}
+
+// HandleRedrive re-runs the failed handler for a dead-letter entry
+// POST /api/v1/admin/events/dlq/{id}/redrive
+func (h *DeadLetterHandler) HandleRedrive(w http.ResponseWriter, r *http.Request) {
+	id, ok := parseDeadLetterID(w, r)
+	if !ok {
+		return
+	}
+
+	entry, err := h.svc.Redrive(r.Context(), id)
+	if err != nil {
+		respondDeadLetterError(w, r, err, "Re-drive failed")
+		return
+	}
+
+	handler.RespondJSON(w, http.StatusOK, entry)
+}
+
+// HandleDiscard marks a dead-letter entry as discarded
+// POST /api/v1/admin/events/dlq/{id}/discard
+func (h *DeadLetterHandler) HandleDiscard(w http.ResponseWriter, r *http.Request) {
+	id, ok := parseDeadLetterID(w, r)
+	if !ok {
+		return
+	}
+
+	if err := h.svc.Discard(r.Context(), id); err != nil {
+		respondDeadLetterError(w, r, err, "Failed to discard dead-letter entry")
+		return
+	}
+
+	handler.RespondJSON(w, http.StatusOK, map[string]interface{}{
+		"id":     id,
+		"status": eventdlq.StatusDiscarded,
+	})
+}
+
+func parseDeadLetterID(w http.ResponseWriter, r *http.Request) (int64, bool) {
+	id, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil || id < 1 {
+		handler.RespondError(w, http.StatusBadRequest, "Invalid dead-letter entry ID")
+		return 0, false
+	}
+	return id, true
+}
+
+func respondDeadLetterError(w http.ResponseWriter, r *http.Request, err error, msg string) {
+	switch {
+	case errors.Is(err, eventdlq.ErrEntryNotFound):
+		handler.RespondError(w, http.StatusNotFound, "Dead-letter entry not found")
+	case errors.Is(err, eventdlq.ErrEntryNotPending):
+		handler.RespondError(w, http.StatusConflict, "Dead-letter entry is not pending")
+	default:
+		logger.FromContext(r.Context()).Error(msg, "error", err)
+		handler.RespondError(w, http.StatusInternalServerError, msg+": "+err.Error())
+	}
+}
@@ -0,0 +1,165 @@
+package admin
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/osse101/BrandishBot_Go/internal/eventdlq"
+	"github.com/osse101/BrandishBot_Go/mocks"
+)
+
+func withURLParam(r *http.Request, key, value string) *http.Request {
+	rctx := chi.NewRouteContext()
+	rctx.URLParams.Add(key, value)
+	return r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, rctx))
+}
+
+func TestDeadLetterHandler_HandleList(t *testing.T) {
+	tests := []struct {
+		name           string
+		query          string
+		setup          func(*mocks.MockEventdlqService)
+		expectedStatus int
+	}{
+		{
+			name:  "default filter",
+			query: "",
+			setup: func(m *mocks.MockEventdlqService) {
+				m.On("List", mock.Anything, eventdlq.Filter{Limit: eventdlq.DefaultListLimit}).
+					Return([]eventdlq.Entry{{ID: 1, Status: eventdlq.StatusPending}}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:  "status filter",
+			query: "?status=pending&limit=5",
+			setup: func(m *mocks.MockEventdlqService) {
+				m.On("List", mock.Anything, mock.MatchedBy(func(f eventdlq.Filter) bool {
+					return f.Limit == 5 && f.Status != nil && *f.Status == eventdlq.StatusPending
+				})).Return([]eventdlq.Entry{}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "invalid status",
+			query:          "?status=bogus",
+			setup:          func(m *mocks.MockEventdlqService) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "invalid limit",
+			query:          "?limit=0",
+			setup:          func(m *mocks.MockEventdlqService) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:  "service error",
+			query: "",
+			setup: func(m *mocks.MockEventdlqService) {
+				m.On("List", mock.Anything, mock.Anything).Return(nil, errors.New("db down"))
+			},
+			expectedStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := mocks.NewMockEventdlqService(t)
+			tt.setup(svc)
+			h := NewDeadLetterHandler(svc)
+
+			rec := httptest.NewRecorder()
+			h.HandleList(rec, httptest.NewRequest(http.MethodGet, "/api/v1/admin/events/dlq"+tt.query, nil))
+
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+		})
+	}
+}
+
+func TestDeadLetterHandler_HandleRedrive(t *testing.T) {
+	tests := []struct {
+		name           string
+		id             string
+		setup          func(*mocks.MockEventdlqService)
+		expectedStatus int
+	}{
+		{
+			name: "success",
+			id:   "3",
+			setup: func(m *mocks.MockEventdlqService) {
+				m.On("Redrive", mock.Anything, int64(3)).Return(&eventdlq.Entry{ID: 3, Status: eventdlq.StatusRedriven}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "invalid id",
+			id:             "abc",
+			setup:          func(m *mocks.MockEventdlqService) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name: "not found",
+			id:   "3",
+			setup: func(m *mocks.MockEventdlqService) {
+				m.On("Redrive", mock.Anything, int64(3)).Return(nil, eventdlq.ErrEntryNotFound)
+			},
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			name: "not pending",
+			id:   "3",
+			setup: func(m *mocks.MockEventdlqService) {
+				m.On("Redrive", mock.Anything, int64(3)).Return(nil, eventdlq.ErrEntryNotPending)
+			},
+			expectedStatus: http.StatusConflict,
+		},
+		{
+			name: "handler still failing",
+			id:   "3",
+			setup: func(m *mocks.MockEventdlqService) {
+				m.On("Redrive", mock.Anything, int64(3)).Return(nil, errors.New("still broken"))
+			},
+			expectedStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := mocks.NewMockEventdlqService(t)
+			tt.setup(svc)
+			h := NewDeadLetterHandler(svc)
+
+			req := withURLParam(httptest.NewRequest(http.MethodPost, "/api/v1/admin/events/dlq/"+tt.id+"/redrive", nil), "id", tt.id)
+			rec := httptest.NewRecorder()
+			h.HandleRedrive(rec, req)
+
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+			if tt.expectedStatus == http.StatusOK {
+				var entry eventdlq.Entry
+				require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &entry))
+				assert.Equal(t, eventdlq.StatusRedriven, entry.Status)
+			}
+		})
+	}
+}
+
+func TestDeadLetterHandler_HandleDiscard(t *testing.T) {
+	svc := mocks.NewMockEventdlqService(t)
+	svc.On("Discard", mock.Anything, int64(4)).Return(nil)
+	h := NewDeadLetterHandler(svc)
+
+	req := withURLParam(httptest.NewRequest(http.MethodPost, "/api/v1/admin/events/dlq/4/discard", nil), "id", "4")
+	rec := httptest.NewRecorder()
+	h.HandleDiscard(rec, req)
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+}
@@ -35,7 +35,19 @@ type EventLogEntry struct {
 }
 
 // HandleGetEvents retrieves events based on query parameters
-// GET /api/v1/admin/events?user_id=X&event_type=Y&since=Z&limit=N
+// @Summary List event log entries
+// @Description Query the event log, newest first
+// @Tags admin
+// @Produce json
+// @Param user_id query string false "Only events for this user"
+// @Param event_type query string false "Only events of this type"
+// @Param since query string false "Only events at or after this time (RFC3339)"
+// @Param until query string false "Only events before this time (RFC3339)"
+// @Param limit query int false "Number of events (default 50, max 1000)"
+// @Success 200 {object} EventsResponse
+// @Failure 400 {object} handler.ErrorResponse
+// @Failure 500 {object} handler.ErrorResponse
+// @Router /api/v1/admin/events [get]
 func (h *EventsHandler) HandleGetEvents(w http.ResponseWriter, r *http.Request) {
 	query := r.URL.Query()
 
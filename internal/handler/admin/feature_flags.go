@@ -0,0 +1,111 @@
+package admin
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/featureflag"
+	"github.com/osse101/BrandishBot_Go/internal/handler"
+	"github.com/osse101/BrandishBot_Go/internal/logger"
+)
+
+// SetFeatureFlagRequest is the whole state of a flag. RolloutPercent
+// defaults to 100 when left out.
+type SetFeatureFlagRequest struct {
+	Description       string          `json:"description" validate:"max=500"`
+	Enabled           bool            `json:"enabled"`
+	RolloutPercent    *int            `json:"rollout_percent,omitempty" validate:"omitempty,min=0,max=100"`
+	PlatformOverrides map[string]bool `json:"platform_overrides,omitempty"`
+	UpdatedBy         string          `json:"updated_by" validate:"required,max=100"`
+}
+
+// FeatureFlagHandler lists, sets and deletes admin feature flags
+type FeatureFlagHandler struct {
+	svc featureflag.Service
+}
+
+// NewFeatureFlagHandler creates a new admin feature flag handler
+func NewFeatureFlagHandler(svc featureflag.Service) *FeatureFlagHandler {
+	return &FeatureFlagHandler{svc: svc}
+}
+
+// HandleList returns every flag
+// GET /api/v1/admin/feature-flags
+func (h *FeatureFlagHandler) HandleList(w http.ResponseWriter, r *http.Request) {
+	flags, err := h.svc.ListFlags(r.Context())
+	if err != nil {
+		respondFeatureFlagError(w, r, err, "Failed to list feature flags")
+		return
+	}
+
+	handler.RespondJSON(w, http.StatusOK, map[string]interface{}{
+		"flags": flags,
+	})
+}
+
+// HandleGet returns one flag
+// GET /api/v1/admin/feature-flags/{key}
+func (h *FeatureFlagHandler) HandleGet(w http.ResponseWriter, r *http.Request) {
+	flag, err := h.svc.GetFlag(r.Context(), chi.URLParam(r, "key"))
+	if err != nil {
+		respondFeatureFlagError(w, r, err, "Failed to get feature flag")
+		return
+	}
+
+	handler.RespondJSON(w, http.StatusOK, flag)
+}
+
+// HandleSet creates or replaces a flag
+// PUT /api/v1/admin/feature-flags/{key}
+func (h *FeatureFlagHandler) HandleSet(w http.ResponseWriter, r *http.Request) {
+	var req SetFeatureFlagRequest
+	if err := handler.DecodeAndValidateRequest(r, w, &req, "Admin set feature flag"); err != nil {
+		return
+	}
+
+	rollout := featureflag.FullRollout
+	if req.RolloutPercent != nil {
+		rollout = *req.RolloutPercent
+	}
+
+	flag, err := h.svc.SetFlag(r.Context(), featureflag.SetRequest{
+		Key:               chi.URLParam(r, "key"),
+		Description:       req.Description,
+		Enabled:           req.Enabled,
+		RolloutPercent:    rollout,
+		PlatformOverrides: req.PlatformOverrides,
+		UpdatedBy:         req.UpdatedBy,
+	})
+	if err != nil {
+		respondFeatureFlagError(w, r, err, "Failed to set feature flag")
+		return
+	}
+
+	handler.RespondJSON(w, http.StatusOK, flag)
+}
+
+// HandleDelete removes a flag, turning it off everywhere
+// DELETE /api/v1/admin/feature-flags/{key}
+func (h *FeatureFlagHandler) HandleDelete(w http.ResponseWriter, r *http.Request) {
+	if err := h.svc.DeleteFlag(r.Context(), chi.URLParam(r, "key")); err != nil {
+		respondFeatureFlagError(w, r, err, "Failed to delete feature flag")
+		return
+	}
+
+	handler.RespondJSON(w, http.StatusOK, handler.SuccessResponse{Message: "Feature flag deleted"})
+}
+
+func respondFeatureFlagError(w http.ResponseWriter, r *http.Request, err error, msg string) {
+	switch {
+	case errors.Is(err, domain.ErrFeatureFlagNotFound):
+		handler.RespondError(w, http.StatusNotFound, "Feature flag not found")
+	case errors.Is(err, domain.ErrInvalidInput):
+		handler.RespondError(w, http.StatusBadRequest, err.Error())
+	default:
+		logger.FromContext(r.Context()).Error(msg, "error", err)
+		handler.RespondError(w, http.StatusInternalServerError, msg)
+	}
+}
@@ -0,0 +1,104 @@
+package admin
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/featureflag"
+	"github.com/osse101/BrandishBot_Go/mocks"
+)
+
+func TestFeatureFlagHandler_HandleSet(t *testing.T) {
+	tests := []struct {
+		name           string
+		body           string
+		setup          func(*mocks.MockFeatureFlagService)
+		expectedStatus int
+	}{
+		{
+			name: "defaults to full rollout",
+			body: `{"enabled":true,"updated_by":"admin"}`,
+			setup: func(m *mocks.MockFeatureFlagService) {
+				m.On("SetFlag", mock.Anything, featureflag.SetRequest{Key: "new_mode", Enabled: true, RolloutPercent: 100, UpdatedBy: "admin"}).
+					Return(&domain.FeatureFlag{Key: "new_mode", Enabled: true, RolloutPercent: 100}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name: "passes rollout and platform overrides",
+			body: `{"enabled":false,"rollout_percent":10,"platform_overrides":{"discord":true},"updated_by":"admin"}`,
+			setup: func(m *mocks.MockFeatureFlagService) {
+				m.On("SetFlag", mock.Anything, featureflag.SetRequest{Key: "new_mode", RolloutPercent: 10, PlatformOverrides: map[string]bool{"discord": true}, UpdatedBy: "admin"}).
+					Return(&domain.FeatureFlag{Key: "new_mode"}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "rollout out of range",
+			body:           `{"rollout_percent":150,"updated_by":"admin"}`,
+			setup:          func(m *mocks.MockFeatureFlagService) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name: "invalid key",
+			body: `{"updated_by":"admin"}`,
+			setup: func(m *mocks.MockFeatureFlagService) {
+				m.On("SetFlag", mock.Anything, mock.Anything).Return(nil, domain.ErrInvalidInput)
+			},
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := mocks.NewMockFeatureFlagService(t)
+			tt.setup(svc)
+
+			req := httptest.NewRequest(http.MethodPut, "/api/v1/admin/feature-flags/new_mode", bytes.NewBufferString(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			rec := httptest.NewRecorder()
+			NewFeatureFlagHandler(svc).HandleSet(rec, withURLParam(req, "key", "new_mode"))
+
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+		})
+	}
+}
+
+func TestFeatureFlagHandler_HandleList(t *testing.T) {
+	svc := mocks.NewMockFeatureFlagService(t)
+	svc.On("ListFlags", mock.Anything).Return([]domain.FeatureFlag{{Key: "new_mode"}}, nil)
+
+	rec := httptest.NewRecorder()
+	NewFeatureFlagHandler(svc).HandleList(rec, httptest.NewRequest(http.MethodGet, "/api/v1/admin/feature-flags", nil))
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Body.String(), `"key":"new_mode"`)
+}
+
+func TestFeatureFlagHandler_HandleDelete(t *testing.T) {
+	t.Run("deletes the flag", func(t *testing.T) {
+		svc := mocks.NewMockFeatureFlagService(t)
+		svc.On("DeleteFlag", mock.Anything, "new_mode").Return(nil)
+
+		rec := httptest.NewRecorder()
+		NewFeatureFlagHandler(svc).HandleDelete(rec, withURLParam(httptest.NewRequest(http.MethodDelete, "/api/v1/admin/feature-flags/new_mode", nil), "key", "new_mode"))
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+	})
+
+	t.Run("unknown flag", func(t *testing.T) {
+		svc := mocks.NewMockFeatureFlagService(t)
+		svc.On("DeleteFlag", mock.Anything, "new_mode").Return(domain.ErrFeatureFlagNotFound)
+
+		rec := httptest.NewRecorder()
+		NewFeatureFlagHandler(svc).HandleDelete(rec, withURLParam(httptest.NewRequest(http.MethodDelete, "/api/v1/admin/feature-flags/new_mode", nil), "key", "new_mode"))
+
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+	})
+}
@@ -0,0 +1,135 @@
+package admin
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/gameevent"
+	"github.com/osse101/BrandishBot_Go/internal/handler"
+	"github.com/osse101/BrandishBot_Go/internal/logger"
+)
+
+// CreateGameEventRequest starts a game event now or schedules it. The end is
+// given either as ends_at or as duration_minutes from the start. Multipliers
+// left out stay at 1.
+type CreateGameEventRequest struct {
+	Name                string  `json:"name" validate:"required,max=100"`
+	Description         string  `json:"description" validate:"max=500"`
+	XPMultiplier        float64 `json:"xp_multiplier" validate:"omitempty,min=1,max=5"`
+	DropRateMultiplier  float64 `json:"drop_rate_multiplier" validate:"omitempty,min=1,max=5"`
+	ShopDiscountPercent int     `json:"shop_discount_percent" validate:"min=0,max=90"`
+	// Theme opens the event recipes and chat keyword drops of an event theme
+	Theme string `json:"theme" validate:"max=32"`
+	// StartsAt is when the event begins; omitted means now
+	StartsAt        *time.Time `json:"starts_at"`
+	EndsAt          *time.Time `json:"ends_at"`
+	DurationMinutes int        `json:"duration_minutes" validate:"min=0"`
+	CreatedBy       string     `json:"created_by" validate:"required,max=100"`
+}
+
+// GameEventHandler lists, creates and ends game events
+type GameEventHandler struct {
+	svc gameevent.Service
+}
+
+// NewGameEventHandler creates a new admin game event handler
+func NewGameEventHandler(svc gameevent.Service) *GameEventHandler {
+	return &GameEventHandler{svc: svc}
+}
+
+// HandleList returns running and scheduled events, and ended ones too with
+// include_ended=true
+// GET /api/v1/admin/game-events
+func (h *GameEventHandler) HandleList(w http.ResponseWriter, r *http.Request) {
+	includeEnded := r.URL.Query().Get("include_ended") == "true"
+	events, err := h.svc.ListEvents(r.Context(), includeEnded)
+	if err != nil {
+		respondGameEventError(w, r, err, "Failed to list game events")
+		return
+	}
+
+	handler.RespondJSON(w, http.StatusOK, map[string]interface{}{
+		"events": events,
+	})
+}
+
+// HandleCreate starts or schedules an event
+// POST /api/v1/admin/game-events
+func (h *GameEventHandler) HandleCreate(w http.ResponseWriter, r *http.Request) {
+	var req CreateGameEventRequest
+	if err := handler.DecodeAndValidateRequest(r, w, &req, "Admin create game event"); err != nil {
+		return
+	}
+	if (req.EndsAt == nil) == (req.DurationMinutes == 0) {
+		handler.RespondError(w, http.StatusBadRequest, "Give either ends_at or duration_minutes")
+		return
+	}
+
+	createReq := gameevent.CreateRequest{
+		Name:                req.Name,
+		Description:         req.Description,
+		XPMultiplier:        req.XPMultiplier,
+		DropRateMultiplier:  req.DropRateMultiplier,
+		ShopDiscountPercent: req.ShopDiscountPercent,
+		Theme:               req.Theme,
+		CreatedBy:           req.CreatedBy,
+	}
+	if req.StartsAt != nil {
+		createReq.StartsAt = *req.StartsAt
+	}
+	if req.EndsAt != nil {
+		createReq.EndsAt = *req.EndsAt
+	} else {
+		start := createReq.StartsAt
+		if start.IsZero() {
+			start = time.Now()
+			createReq.StartsAt = start
+		}
+		createReq.EndsAt = start.Add(time.Duration(req.DurationMinutes) * time.Minute)
+	}
+
+	evt, err := h.svc.CreateEvent(r.Context(), createReq)
+	if err != nil {
+		respondGameEventError(w, r, err, "Failed to create game event")
+		return
+	}
+
+	handler.RespondJSON(w, http.StatusCreated, evt)
+}
+
+// HandleEnd stops a running event, or cancels one that has not started
+// DELETE /api/v1/admin/game-events/{id}
+func (h *GameEventHandler) HandleEnd(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil || id < 1 {
+		handler.RespondError(w, http.StatusBadRequest, "Invalid game event ID")
+		return
+	}
+
+	evt, err := h.svc.EndEvent(r.Context(), id)
+	if err != nil {
+		respondGameEventError(w, r, err, "Failed to end game event")
+		return
+	}
+
+	handler.RespondJSON(w, http.StatusOK, evt)
+}
+
+func respondGameEventError(w http.ResponseWriter, r *http.Request, err error, msg string) {
+	switch {
+	case errors.Is(err, domain.ErrGameEventNotFound):
+		handler.RespondError(w, http.StatusNotFound, "Game event not found")
+	case errors.Is(err, domain.ErrGameEventEnded):
+		handler.RespondError(w, http.StatusConflict, "Game event has already ended")
+	case errors.Is(err, domain.ErrInvalidInput):
+		handler.RespondError(w, http.StatusBadRequest, err.Error())
+	default:
+		logger.FromContext(r.Context()).Error(msg, "error", err)
+		handler.RespondError(w, http.StatusInternalServerError, msg)
+	}
+}
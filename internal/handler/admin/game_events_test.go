@@ -0,0 +1,110 @@
+package admin
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/gameevent"
+	"github.com/osse101/BrandishBot_Go/mocks"
+)
+
+func TestGameEventHandler_HandleCreate(t *testing.T) {
+	tests := []struct {
+		name           string
+		body           string
+		setup          func(*mocks.MockGameEventService)
+		expectedStatus int
+	}{
+		{
+			name: "duration from a scheduled start",
+			body: `{"name":"Double XP Weekend","xp_multiplier":2,"starts_at":"2026-10-17T00:00:00Z","duration_minutes":2880,"created_by":"admin"}`,
+			setup: func(m *mocks.MockGameEventService) {
+				start := time.Date(2026, 10, 17, 0, 0, 0, 0, time.UTC)
+				m.On("CreateEvent", mock.Anything, gameevent.CreateRequest{
+					Name: "Double XP Weekend", XPMultiplier: 2, StartsAt: start, EndsAt: start.Add(48 * time.Hour), CreatedBy: "admin",
+				}).Return(&domain.GameEvent{ID: 1, Name: "Double XP Weekend"}, nil)
+			},
+			expectedStatus: http.StatusCreated,
+		},
+		{
+			name:           "needs an end",
+			body:           `{"name":"Sale","shop_discount_percent":20,"created_by":"admin"}`,
+			setup:          func(m *mocks.MockGameEventService) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "discount out of range",
+			body:           `{"name":"Sale","shop_discount_percent":95,"duration_minutes":60,"created_by":"admin"}`,
+			setup:          func(m *mocks.MockGameEventService) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name: "invalid event",
+			body: `{"name":"Nothing","duration_minutes":60,"created_by":"admin"}`,
+			setup: func(m *mocks.MockGameEventService) {
+				m.On("CreateEvent", mock.Anything, mock.Anything).Return(nil, domain.ErrInvalidInput)
+			},
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := mocks.NewMockGameEventService(t)
+			tt.setup(svc)
+
+			req := httptest.NewRequest(http.MethodPost, "/api/v1/admin/game-events", bytes.NewBufferString(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			rec := httptest.NewRecorder()
+			NewGameEventHandler(svc).HandleCreate(rec, req)
+
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+		})
+	}
+}
+
+func TestGameEventHandler_HandleList(t *testing.T) {
+	svc := mocks.NewMockGameEventService(t)
+	svc.On("ListEvents", mock.Anything, true).Return([]domain.GameEvent{{ID: 1, Name: "Double XP Weekend"}}, nil)
+
+	rec := httptest.NewRecorder()
+	NewGameEventHandler(svc).HandleList(rec, httptest.NewRequest(http.MethodGet, "/api/v1/admin/game-events?include_ended=true", nil))
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Body.String(), `"name":"Double XP Weekend"`)
+}
+
+func TestGameEventHandler_HandleEnd(t *testing.T) {
+	tests := []struct {
+		name           string
+		err            error
+		expectedStatus int
+	}{
+		{"ends the event", nil, http.StatusOK},
+		{"unknown event", domain.ErrGameEventNotFound, http.StatusNotFound},
+		{"already over", domain.ErrGameEventEnded, http.StatusConflict},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := mocks.NewMockGameEventService(t)
+			var evt *domain.GameEvent
+			if tt.err == nil {
+				evt = &domain.GameEvent{ID: 3}
+			}
+			svc.On("EndEvent", mock.Anything, int64(3)).Return(evt, tt.err)
+
+			rec := httptest.NewRecorder()
+			NewGameEventHandler(svc).HandleEnd(rec, withURLParam(httptest.NewRequest(http.MethodDelete, "/api/v1/admin/game-events/3", nil), "id", "3"))
+
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+		})
+	}
+}
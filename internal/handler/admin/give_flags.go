@@ -0,0 +1,101 @@
+package admin
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/giveguard"
+	"github.com/osse101/BrandishBot_Go/internal/handler"
+	"github.com/osse101/BrandishBot_Go/internal/logger"
+)
+
+// GiveFlagReviewRequest settles a flagged give
+type GiveFlagReviewRequest struct {
+	Status domain.GiveFlagStatus `json:"status" validate:"required,oneof=dismissed confirmed"`
+	Actor  string                `json:"actor" validate:"required,max=100"` // Admin making the decision, recorded on the flag
+}
+
+// GiveFlagsResponse lists flagged gives
+type GiveFlagsResponse struct {
+	Flags []domain.GiveFlag `json:"flags"`
+}
+
+// GiveFlagsHandler handles admin review of gives flagged as likely funneling
+type GiveFlagsHandler struct {
+	svc giveguard.Service
+}
+
+// NewGiveFlagsHandler creates a new admin give flag handler
+func NewGiveFlagsHandler(svc giveguard.Service) *GiveFlagsHandler {
+	return &GiveFlagsHandler{svc: svc}
+}
+
+// HandleList lists flagged gives, pending ones unless a status is given
+// GET /api/v1/admin/gives/flags?status=pending&limit=N
+func (h *GiveFlagsHandler) HandleList(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	status := domain.GiveFlagStatus(query.Get("status"))
+	switch status {
+	case "", domain.GiveFlagPending, domain.GiveFlagDismissed, domain.GiveFlagConfirmed:
+	default:
+		handler.RespondError(w, http.StatusBadRequest, "Invalid 'status' (must be pending, dismissed, or confirmed)")
+		return
+	}
+
+	limit := giveguard.DefaultListLimit
+	if limitStr := query.Get("limit"); limitStr != "" {
+		var err error
+		limit, err = strconv.Atoi(limitStr)
+		if err != nil || limit < 1 || limit > giveguard.MaxListLimit {
+			handler.RespondError(w, http.StatusBadRequest, "Invalid 'limit' (must be 1-200)")
+			return
+		}
+	}
+
+	flags, err := h.svc.ListFlags(r.Context(), status, limit)
+	if err != nil {
+		logger.FromContext(r.Context()).Error("Failed to list give flags", "error", err)
+		handler.RespondError(w, http.StatusInternalServerError, "Failed to retrieve give flags")
+		return
+	}
+
+	handler.RespondJSON(w, http.StatusOK, GiveFlagsResponse{Flags: flags})
+}
+
+// HandleReview dismisses or confirms a pending flag
+// POST /api/v1/admin/gives/flags/{id}/review
+func (h *GiveFlagsHandler) HandleReview(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil || id < 1 {
+		handler.RespondError(w, http.StatusBadRequest, "Invalid give flag ID")
+		return
+	}
+
+	var req GiveFlagReviewRequest
+	if err := handler.DecodeAndValidateRequest(r, w, &req, "Admin review give flag"); err != nil {
+		return
+	}
+
+	if err := h.svc.ReviewFlag(r.Context(), id, req.Status, req.Actor); err != nil {
+		switch {
+		case errors.Is(err, domain.ErrGiveFlagNotFound):
+			handler.RespondError(w, http.StatusNotFound, "Give flag not found or already reviewed")
+		case errors.Is(err, domain.ErrInvalidInput):
+			handler.RespondError(w, http.StatusBadRequest, "status must be dismissed or confirmed and actor is required")
+		default:
+			logger.FromContext(r.Context()).Error("Failed to review give flag", "error", err, "flag_id", id)
+			handler.RespondError(w, http.StatusInternalServerError, "Failed to review give flag")
+		}
+		return
+	}
+
+	handler.RespondJSON(w, http.StatusOK, map[string]interface{}{
+		"id":     id,
+		"status": req.Status,
+	})
+}
@@ -0,0 +1,126 @@
+package admin
+
+import (
+	"bytes"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/giveguard"
+	"github.com/osse101/BrandishBot_Go/mocks"
+)
+
+func TestGiveFlagsHandler_HandleList(t *testing.T) {
+	tests := []struct {
+		name           string
+		query          string
+		setup          func(*mocks.MockGiveguardService)
+		expectedStatus int
+	}{
+		{
+			name:  "lists pending flags by default",
+			query: "",
+			setup: func(m *mocks.MockGiveguardService) {
+				m.On("ListFlags", mock.Anything, domain.GiveFlagStatus(""), giveguard.DefaultListLimit).
+					Return([]domain.GiveFlag{{ID: 1, Status: domain.GiveFlagPending}}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:  "filters by status",
+			query: "?status=confirmed&limit=5",
+			setup: func(m *mocks.MockGiveguardService) {
+				m.On("ListFlags", mock.Anything, domain.GiveFlagConfirmed, 5).Return(nil, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "unknown status",
+			query:          "?status=maybe",
+			setup:          func(m *mocks.MockGiveguardService) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:  "service error",
+			query: "",
+			setup: func(m *mocks.MockGiveguardService) {
+				m.On("ListFlags", mock.Anything, domain.GiveFlagStatus(""), giveguard.DefaultListLimit).Return(nil, errors.New("db down"))
+			},
+			expectedStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := mocks.NewMockGiveguardService(t)
+			tt.setup(svc)
+
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/admin/gives/flags"+tt.query, nil)
+			rec := httptest.NewRecorder()
+			NewGiveFlagsHandler(svc).HandleList(rec, req)
+
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+		})
+	}
+}
+
+func TestGiveFlagsHandler_HandleReview(t *testing.T) {
+	tests := []struct {
+		name           string
+		id             string
+		body           string
+		setup          func(*mocks.MockGiveguardService)
+		expectedStatus int
+	}{
+		{
+			name: "confirms the flag",
+			id:   "4",
+			body: `{"status":"confirmed","actor":"mod"}`,
+			setup: func(m *mocks.MockGiveguardService) {
+				m.On("ReviewFlag", mock.Anything, int64(4), domain.GiveFlagConfirmed, "mod").Return(nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "pending is not a decision",
+			id:             "4",
+			body:           `{"status":"pending","actor":"mod"}`,
+			setup:          func(m *mocks.MockGiveguardService) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "invalid ID",
+			id:             "abc",
+			body:           `{"status":"confirmed","actor":"mod"}`,
+			setup:          func(m *mocks.MockGiveguardService) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name: "already reviewed",
+			id:   "4",
+			body: `{"status":"dismissed","actor":"mod"}`,
+			setup: func(m *mocks.MockGiveguardService) {
+				m.On("ReviewFlag", mock.Anything, int64(4), domain.GiveFlagDismissed, "mod").Return(domain.ErrGiveFlagNotFound)
+			},
+			expectedStatus: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := mocks.NewMockGiveguardService(t)
+			tt.setup(svc)
+
+			req := httptest.NewRequest(http.MethodPost, "/api/v1/admin/gives/flags/"+tt.id+"/review", bytes.NewBufferString(tt.body))
+			rec := httptest.NewRecorder()
+			NewGiveFlagsHandler(svc).HandleReview(rec, withURLParam(req, "id", tt.id))
+
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+		})
+	}
+}
@@ -0,0 +1,103 @@
+package admin
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/handler"
+	"github.com/osse101/BrandishBot_Go/internal/itemalias"
+	"github.com/osse101/BrandishBot_Go/internal/logger"
+)
+
+// SetItemAliasRequest is the whole alias pool of an item. Themes maps a
+// configured theme to the aliases shown while it is active.
+type SetItemAliasRequest struct {
+	Default   []string            `json:"default" validate:"required,min=1"`
+	Themes    map[string][]string `json:"themes,omitempty"`
+	UpdatedBy string              `json:"updated_by" validate:"required,max=100"`
+}
+
+// ItemAliasHandler lists, sets and deletes admin item aliases
+type ItemAliasHandler struct {
+	svc itemalias.Service
+}
+
+// NewItemAliasHandler creates a new admin item alias handler
+func NewItemAliasHandler(svc itemalias.Service) *ItemAliasHandler {
+	return &ItemAliasHandler{svc: svc}
+}
+
+// HandleList returns every stored alias pool
+// GET /api/v1/admin/naming/aliases
+func (h *ItemAliasHandler) HandleList(w http.ResponseWriter, r *http.Request) {
+	aliases, err := h.svc.ListAliases(r.Context())
+	if err != nil {
+		respondItemAliasError(w, r, err, "Failed to list item aliases")
+		return
+	}
+
+	handler.RespondJSON(w, http.StatusOK, map[string]interface{}{
+		"aliases": aliases,
+	})
+}
+
+// HandleGet returns the stored alias pool of an item
+// GET /api/v1/admin/naming/aliases/{item}
+func (h *ItemAliasHandler) HandleGet(w http.ResponseWriter, r *http.Request) {
+	alias, err := h.svc.GetAlias(r.Context(), chi.URLParam(r, "item"))
+	if err != nil {
+		respondItemAliasError(w, r, err, "Failed to get item alias")
+		return
+	}
+
+	handler.RespondJSON(w, http.StatusOK, alias)
+}
+
+// HandleSet creates or replaces the alias pool of an item
+// PUT /api/v1/admin/naming/aliases/{item}
+func (h *ItemAliasHandler) HandleSet(w http.ResponseWriter, r *http.Request) {
+	var req SetItemAliasRequest
+	if err := handler.DecodeAndValidateRequest(r, w, &req, "Admin set item alias"); err != nil {
+		return
+	}
+
+	alias, err := h.svc.SetAlias(r.Context(), itemalias.SetRequest{
+		InternalName: chi.URLParam(r, "item"),
+		Default:      req.Default,
+		Themes:       req.Themes,
+		UpdatedBy:    req.UpdatedBy,
+	})
+	if err != nil {
+		respondItemAliasError(w, r, err, "Failed to set item alias")
+		return
+	}
+
+	handler.RespondJSON(w, http.StatusOK, alias)
+}
+
+// HandleDelete removes the stored alias pool of an item, so the config
+// file's pool applies again
+// DELETE /api/v1/admin/naming/aliases/{item}
+func (h *ItemAliasHandler) HandleDelete(w http.ResponseWriter, r *http.Request) {
+	if err := h.svc.DeleteAlias(r.Context(), chi.URLParam(r, "item")); err != nil {
+		respondItemAliasError(w, r, err, "Failed to delete item alias")
+		return
+	}
+
+	handler.RespondJSON(w, http.StatusOK, handler.SuccessResponse{Message: "Item alias deleted"})
+}
+
+func respondItemAliasError(w http.ResponseWriter, r *http.Request, err error, msg string) {
+	switch {
+	case errors.Is(err, domain.ErrItemAliasNotFound):
+		handler.RespondError(w, http.StatusNotFound, "Item alias not found")
+	case errors.Is(err, domain.ErrInvalidInput):
+		handler.RespondError(w, http.StatusBadRequest, err.Error())
+	default:
+		logger.FromContext(r.Context()).Error(msg, "error", err)
+		handler.RespondError(w, http.StatusInternalServerError, msg)
+	}
+}
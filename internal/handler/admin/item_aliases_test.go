@@ -0,0 +1,99 @@
+package admin
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/itemalias"
+	"github.com/osse101/BrandishBot_Go/mocks"
+)
+
+func TestItemAliasHandler_HandleSet(t *testing.T) {
+	tests := []struct {
+		name           string
+		body           string
+		setup          func(*mocks.MockItemAliasService)
+		expectedStatus int
+	}{
+		{
+			name: "stores the pool",
+			body: `{"default":["pew pew"],"themes":{"halloween":["spooky blaster"]},"updated_by":"admin"}`,
+			setup: func(m *mocks.MockItemAliasService) {
+				m.On("SetAlias", mock.Anything, itemalias.SetRequest{
+					InternalName: "weapon_blaster",
+					Default:      []string{"pew pew"},
+					Themes:       map[string][]string{"halloween": {"spooky blaster"}},
+					UpdatedBy:    "admin",
+				}).Return(&domain.ItemAlias{InternalName: "weapon_blaster", Default: []string{"pew pew"}}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "missing default aliases",
+			body:           `{"updated_by":"admin"}`,
+			setup:          func(m *mocks.MockItemAliasService) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name: "collides with an internal name",
+			body: `{"default":["lootbox_tier1"],"updated_by":"admin"}`,
+			setup: func(m *mocks.MockItemAliasService) {
+				m.On("SetAlias", mock.Anything, mock.Anything).Return(nil, domain.ErrInvalidInput)
+			},
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := mocks.NewMockItemAliasService(t)
+			tt.setup(svc)
+
+			req := httptest.NewRequest(http.MethodPut, "/api/v1/admin/naming/aliases/weapon_blaster", bytes.NewBufferString(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			rec := httptest.NewRecorder()
+			NewItemAliasHandler(svc).HandleSet(rec, withURLParam(req, "item", "weapon_blaster"))
+
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+		})
+	}
+}
+
+func TestItemAliasHandler_HandleList(t *testing.T) {
+	svc := mocks.NewMockItemAliasService(t)
+	svc.On("ListAliases", mock.Anything).Return([]domain.ItemAlias{{InternalName: "weapon_blaster"}}, nil)
+
+	rec := httptest.NewRecorder()
+	NewItemAliasHandler(svc).HandleList(rec, httptest.NewRequest(http.MethodGet, "/api/v1/admin/naming/aliases", nil))
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Body.String(), `"internal_name":"weapon_blaster"`)
+}
+
+func TestItemAliasHandler_HandleDelete(t *testing.T) {
+	t.Run("deletes the pool", func(t *testing.T) {
+		svc := mocks.NewMockItemAliasService(t)
+		svc.On("DeleteAlias", mock.Anything, "weapon_blaster").Return(nil)
+
+		rec := httptest.NewRecorder()
+		NewItemAliasHandler(svc).HandleDelete(rec, withURLParam(httptest.NewRequest(http.MethodDelete, "/api/v1/admin/naming/aliases/weapon_blaster", nil), "item", "weapon_blaster"))
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+	})
+
+	t.Run("unknown item", func(t *testing.T) {
+		svc := mocks.NewMockItemAliasService(t)
+		svc.On("DeleteAlias", mock.Anything, "weapon_blaster").Return(domain.ErrItemAliasNotFound)
+
+		rec := httptest.NewRecorder()
+		NewItemAliasHandler(svc).HandleDelete(rec, withURLParam(httptest.NewRequest(http.MethodDelete, "/api/v1/admin/naming/aliases/weapon_blaster", nil), "item", "weapon_blaster"))
+
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+	})
+}
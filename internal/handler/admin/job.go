@@ -2,6 +2,7 @@ package admin
 
 import (
 	"net/http"
+	"strconv"
 
 	"github.com/osse101/BrandishBot_Go/internal/domain"
 	"github.com/osse101/BrandishBot_Go/internal/handler"
@@ -10,14 +11,24 @@ import (
 	"github.com/osse101/BrandishBot_Go/internal/user"
 )
 
-// AwardXPRequest is the request body for admin XP awards
-type AwardXPRequest struct {
+// AdminAwardXPRequest is the request body for admin XP awards
+type AdminAwardXPRequest struct {
 	Platform string `json:"platform"` // discord, twitch, youtube
 	Username string `json:"username"` // Platform username
 	JobKey   string `json:"job_key"`  // explorer, blacksmith, etc.
 	Amount   int    `json:"amount"`   //  XP amount to award
 }
 
+// AdminAwardXPResponse is the response to an admin XP award
+type AdminAwardXPResponse struct {
+	Success   bool                  `json:"success"`
+	UserID    string                `json:"user_id"`
+	Username  string                `json:"username"`
+	JobKey    string                `json:"job_key"`
+	XPAwarded int                   `json:"xp_awarded"`
+	Result    *domain.XPAwardResult `json:"result"`
+}
+
 // JobHandler handles admin job operations
 type JobHandler struct {
 	jobService  job.Service
@@ -33,9 +44,19 @@ func NewJobHandler(jobService job.Service, userService user.Service) *JobHandler
 }
 
 // HandleAwardXP awards XP to a user identified by platform and username
-// POST /admin/job/award-xp
+// @Summary Award job XP
+// @Description Award job XP to a user identified by platform and username (admin only)
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Param request body AdminAwardXPRequest true "XP award"
+// @Success 200 {object} AdminAwardXPResponse
+// @Failure 400 {object} handler.ErrorResponse
+// @Failure 404 {object} handler.ErrorResponse "User not found"
+// @Failure 500 {object} handler.ErrorResponse
+// @Router /api/v1/admin/jobs/award-xp [post]
 func (h *JobHandler) HandleAwardXP(w http.ResponseWriter, r *http.Request) {
-	var req AwardXPRequest
+	var req AdminAwardXPRequest
 	if err := handler.DecodeAndValidateRequest(r, w, &req, "Admin award XP"); err != nil {
 		return
 	}
@@ -93,8 +114,7 @@ func (h *JobHandler) HandleAwardXP(w http.ResponseWriter, r *http.Request) {
 			"error", err,
 			"user_id", user.ID,
 			"job_key", req.JobKey)
-		statusCode, userMsg := handler.MapServiceErrorToUserMessage(err)
-		handler.RespondError(w, statusCode, userMsg)
+		handler.RespondMappedError(w, err)
 		return
 	}
 
@@ -106,15 +126,54 @@ func (h *JobHandler) HandleAwardXP(w http.ResponseWriter, r *http.Request) {
 		"leveled_up", result.LeveledUp,
 		"new_level", result.NewLevel)
 
-	// Return success response
-	response := map[string]interface{}{
-		"success":    true,
-		"user_id":    user.ID,
-		"username":   user.Username,
-		"job_key":    req.JobKey,
-		"xp_awarded": req.Amount,
-		"result":     result,
+	handler.RespondJSON(w, http.StatusOK, AdminAwardXPResponse{
+		Success:   true,
+		UserID:    user.ID,
+		Username:  user.Username,
+		JobKey:    req.JobKey,
+		XPAwarded: req.Amount,
+		Result:    result,
+	})
+}
+
+// HandleGetXPAwards lists a user's most recent job XP awards from the audit
+// trail, for investigating suspicious level gains
+// GET /api/v1/admin/jobs/xp-awards?platform=twitch&username=foo&limit=N
+func (h *JobHandler) HandleGetXPAwards(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	platform := query.Get("platform")
+	username := query.Get("username")
+	if platform == "" || username == "" {
+		handler.RespondError(w, http.StatusBadRequest, handler.ErrMsgPlatformUsernameRequired)
+		return
+	}
+
+	limit := job.DefaultXPAwardHistoryLimit
+	if limitStr := query.Get("limit"); limitStr != "" {
+		parsed, err := strconv.Atoi(limitStr)
+		if err != nil || parsed < 1 || parsed > job.MaxXPAwardHistoryLimit {
+			handler.RespondError(w, http.StatusBadRequest, handler.ErrMsgInvalidLimit)
+			return
+		}
+		limit = parsed
+	}
+
+	user, err := h.userService.GetUserByPlatformUsername(r.Context(), platform, username)
+	if err != nil {
+		handler.RespondError(w, http.StatusNotFound, handler.ErrMsgUserNotFoundHTTP)
+		return
+	}
+
+	awards, err := h.jobService.GetRecentXPAwards(r.Context(), user.ID, limit)
+	if err != nil {
+		logger.FromContext(r.Context()).Error("Failed to get XP awards", "error", err, "user_id", user.ID)
+		handler.RespondMappedError(w, err)
+		return
 	}
 
-	handler.RespondJSON(w, http.StatusOK, response)
+	handler.RespondJSON(w, http.StatusOK, map[string]interface{}{
+		"user_id":  user.ID,
+		"username": user.Username,
+		"awards":   awards,
+	})
 }
@@ -22,14 +22,14 @@ import (
 func TestJobHandler_HandleAwardXP(t *testing.T) {
 	tests := []struct {
 		name           string
-		reqBody        admin.AwardXPRequest
+		reqBody        admin.AdminAwardXPRequest
 		setupMocks     func(userSvc *mocks.MockUserService, jobSvc *mocks.MockJobService)
 		expectedStatus int
 		expectedError  string
 	}{
 		{
 			name: "Success_AwardXP",
-			reqBody: admin.AwardXPRequest{
+			reqBody: admin.AdminAwardXPRequest{
 				Platform: "discord",
 				Username: "testuser",
 				JobKey:   "explorer",
@@ -49,7 +49,7 @@ func TestJobHandler_HandleAwardXP(t *testing.T) {
 		},
 		{
 			name: "Error_MissingPlatform",
-			reqBody: admin.AwardXPRequest{
+			reqBody: admin.AdminAwardXPRequest{
 				Username: "testuser",
 				JobKey:   "explorer",
 				Amount:   100,
@@ -60,7 +60,7 @@ func TestJobHandler_HandleAwardXP(t *testing.T) {
 		},
 		{
 			name: "Error_MissingUsername",
-			reqBody: admin.AwardXPRequest{
+			reqBody: admin.AdminAwardXPRequest{
 				Platform: "discord",
 				JobKey:   "explorer",
 				Amount:   100,
@@ -71,7 +71,7 @@ func TestJobHandler_HandleAwardXP(t *testing.T) {
 		},
 		{
 			name: "Error_MissingJobKey",
-			reqBody: admin.AwardXPRequest{
+			reqBody: admin.AdminAwardXPRequest{
 				Platform: "discord",
 				Username: "testuser",
 				Amount:   100,
@@ -82,7 +82,7 @@ func TestJobHandler_HandleAwardXP(t *testing.T) {
 		},
 		{
 			name: "Error_AmountZero",
-			reqBody: admin.AwardXPRequest{
+			reqBody: admin.AdminAwardXPRequest{
 				Platform: "discord",
 				Username: "testuser",
 				JobKey:   "explorer",
@@ -94,7 +94,7 @@ func TestJobHandler_HandleAwardXP(t *testing.T) {
 		},
 		{
 			name: "Error_AmountNegative",
-			reqBody: admin.AwardXPRequest{
+			reqBody: admin.AdminAwardXPRequest{
 				Platform: "discord",
 				Username: "testuser",
 				JobKey:   "explorer",
@@ -106,7 +106,7 @@ func TestJobHandler_HandleAwardXP(t *testing.T) {
 		},
 		{
 			name: "Error_AmountExceedsMax",
-			reqBody: admin.AwardXPRequest{
+			reqBody: admin.AdminAwardXPRequest{
 				Platform: "discord",
 				Username: "testuser",
 				JobKey:   "explorer",
@@ -118,7 +118,7 @@ func TestJobHandler_HandleAwardXP(t *testing.T) {
 		},
 		{
 			name: "Error_UserNotFound",
-			reqBody: admin.AwardXPRequest{
+			reqBody: admin.AdminAwardXPRequest{
 				Platform: "discord",
 				Username: "testuser",
 				JobKey:   "explorer",
@@ -133,7 +133,7 @@ func TestJobHandler_HandleAwardXP(t *testing.T) {
 		},
 		{
 			name: "Error_JobServiceFails",
-			reqBody: admin.AwardXPRequest{
+			reqBody: admin.AdminAwardXPRequest{
 				Platform: "discord",
 				Username: "testuser",
 				JobKey:   "explorer",
@@ -151,7 +151,7 @@ func TestJobHandler_HandleAwardXP(t *testing.T) {
 		},
 		{
 			name:           "Error_InvalidJSON",
-			reqBody:        admin.AwardXPRequest{}, // This will be manually bypassed in the loop
+			reqBody:        admin.AdminAwardXPRequest{}, // This will be manually bypassed in the loop
 			setupMocks:     func(userSvc *mocks.MockUserService, jobSvc *mocks.MockJobService) {},
 			expectedStatus: http.StatusBadRequest,
 			expectedError:  handler.ErrMsgInvalidRequest,
@@ -207,3 +207,81 @@ func TestJobHandler_HandleAwardXP(t *testing.T) {
 		})
 	}
 }
+
+func TestJobHandler_HandleGetXPAwards(t *testing.T) {
+	tests := []struct {
+		name           string
+		query          string
+		setupMocks     func(userSvc *mocks.MockUserService, jobSvc *mocks.MockJobService)
+		expectedStatus int
+		expectedError  string
+	}{
+		{
+			name:  "Success_DefaultLimit",
+			query: "?platform=discord&username=testuser",
+			setupMocks: func(userSvc *mocks.MockUserService, jobSvc *mocks.MockJobService) {
+				userSvc.On("GetUserByPlatformUsername", mock.Anything, "discord", "testuser").
+					Return(&domain.User{ID: "user123", Username: "testuser"}, nil)
+				jobSvc.On("GetRecentXPAwards", mock.Anything, "user123", 50).
+					Return([]domain.XPAward{{ID: 1, JobKey: "explorer", Source: "search", XPAwarded: 30, CappedBy: "source"}}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "Error_MissingUsername",
+			query:          "?platform=discord",
+			setupMocks:     func(userSvc *mocks.MockUserService, jobSvc *mocks.MockJobService) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  handler.ErrMsgPlatformUsernameRequired,
+		},
+		{
+			name:           "Error_InvalidLimit",
+			query:          "?platform=discord&username=testuser&limit=1000",
+			setupMocks:     func(userSvc *mocks.MockUserService, jobSvc *mocks.MockJobService) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  handler.ErrMsgInvalidLimit,
+		},
+		{
+			name:  "Error_UserNotFound",
+			query: "?platform=discord&username=testuser",
+			setupMocks: func(userSvc *mocks.MockUserService, jobSvc *mocks.MockJobService) {
+				userSvc.On("GetUserByPlatformUsername", mock.Anything, "discord", "testuser").
+					Return((*domain.User)(nil), errors.New("not found"))
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedError:  handler.ErrMsgUserNotFoundHTTP,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUserSvc := mocks.NewMockUserService(t)
+			mockJobSvc := mocks.NewMockJobService(t)
+			tt.setupMocks(mockUserSvc, mockJobSvc)
+
+			jobHandler := admin.NewJobHandler(mockJobSvc, mockUserSvc)
+
+			req := httptest.NewRequest(http.MethodGet, "/admin/jobs/xp-awards"+tt.query, nil)
+			rec := httptest.NewRecorder()
+
+			jobHandler.HandleGetXPAwards(rec, req)
+
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+
+			if tt.expectedStatus == http.StatusOK {
+				var response struct {
+					UserID string           `json:"user_id"`
+					Awards []domain.XPAward `json:"awards"`
+				}
+				require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+				assert.Equal(t, "user123", response.UserID)
+				require.Len(t, response.Awards, 1)
+				assert.Equal(t, "source", response.Awards[0].CappedBy)
+			} else {
+				var errResp handler.ErrorResponse
+				require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &errResp))
+				assert.Equal(t, tt.expectedError, errResp.Error)
+			}
+		})
+	}
+}
@@ -50,7 +50,13 @@ func NewMetricsHandler(sseHub *sse.Hub) *MetricsHandler {
 }
 
 // HandleGetMetrics returns JSON-formatted metrics from Prometheus
-// GET /api/v1/admin/metrics
+// @Summary Get dashboard metrics
+// @Description Summarises the HTTP, event, business and SSE metrics for the admin dashboard
+// @Tags admin
+// @Produce json
+// @Success 200 {object} MetricsResponse
+// @Failure 500 {object} handler.ErrorResponse
+// @Router /api/v1/admin/metrics [get]
 func (h *MetricsHandler) HandleGetMetrics(w http.ResponseWriter, r *http.Request) {
 	metrics, err := gatherMetrics()
 	if err != nil {
@@ -0,0 +1,147 @@
+package admin
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/handler"
+	"github.com/osse101/BrandishBot_Go/internal/logger"
+	"github.com/osse101/BrandishBot_Go/internal/moderation"
+)
+
+// ModerationRequest is a moderator action against an account
+type ModerationRequest struct {
+	Actor  string `json:"actor" validate:"required,max=100"` // Moderator taking the action, recorded in the history
+	Reason string `json:"reason" validate:"max=500"`
+}
+
+// WipeInventoryResponse lists the slots removed by an inventory wipe
+type WipeInventoryResponse struct {
+	UserID  string                 `json:"user_id"`
+	Removed []domain.InventorySlot `json:"removed"`
+}
+
+// ModerationHandler handles freezing, wiping and inspecting accounts
+type ModerationHandler struct {
+	svc moderation.Service
+}
+
+// NewModerationHandler creates a new admin moderation handler
+func NewModerationHandler(svc moderation.Service) *ModerationHandler {
+	return &ModerationHandler{svc: svc}
+}
+
+// HandleFreeze blocks an account from economy actions
+// POST /api/v1/admin/users/{id}/freeze
+func (h *ModerationHandler) HandleFreeze(w http.ResponseWriter, r *http.Request) {
+	userID, req, ok := h.decode(w, r, "Admin freeze account")
+	if !ok {
+		return
+	}
+
+	if err := h.svc.Freeze(r.Context(), userID, req.Actor, req.Reason); err != nil {
+		h.respondError(w, r, err, userID, "Failed to freeze account")
+		return
+	}
+
+	handler.RespondJSON(w, http.StatusOK, map[string]interface{}{
+		"user_id": userID,
+		"frozen":  true,
+	})
+}
+
+// HandleUnfreeze lifts a freeze
+// POST /api/v1/admin/users/{id}/unfreeze
+func (h *ModerationHandler) HandleUnfreeze(w http.ResponseWriter, r *http.Request) {
+	userID, req, ok := h.decode(w, r, "Admin unfreeze account")
+	if !ok {
+		return
+	}
+
+	if err := h.svc.Unfreeze(r.Context(), userID, req.Actor, req.Reason); err != nil {
+		h.respondError(w, r, err, userID, "Failed to unfreeze account")
+		return
+	}
+
+	handler.RespondJSON(w, http.StatusOK, map[string]interface{}{
+		"user_id": userID,
+		"frozen":  false,
+	})
+}
+
+// HandleWipeInventory empties an account's inventory
+// POST /api/v1/admin/users/{id}/wipe-inventory
+func (h *ModerationHandler) HandleWipeInventory(w http.ResponseWriter, r *http.Request) {
+	userID, req, ok := h.decode(w, r, "Admin wipe inventory")
+	if !ok {
+		return
+	}
+
+	removed, err := h.svc.WipeInventory(r.Context(), userID, req.Actor, req.Reason)
+	if err != nil {
+		h.respondError(w, r, err, userID, "Failed to wipe inventory")
+		return
+	}
+
+	handler.RespondJSON(w, http.StatusOK, WipeInventoryResponse{UserID: userID, Removed: removed})
+}
+
+// HandleInspect returns the account dossier
+// GET /api/v1/admin/users/{id}/inspect
+func (h *ModerationHandler) HandleInspect(w http.ResponseWriter, r *http.Request) {
+	userID, ok := parseUserIDParam(w, r)
+	if !ok {
+		return
+	}
+
+	dossier, err := h.svc.Inspect(r.Context(), userID)
+	if err != nil {
+		h.respondError(w, r, err, userID, "Failed to inspect account")
+		return
+	}
+
+	handler.RespondJSON(w, http.StatusOK, dossier)
+}
+
+// decode reads the user ID path parameter and the moderation request body
+func (h *ModerationHandler) decode(w http.ResponseWriter, r *http.Request, action string) (string, ModerationRequest, bool) {
+	var req ModerationRequest
+	userID, ok := parseUserIDParam(w, r)
+	if !ok {
+		return "", req, false
+	}
+	if err := handler.DecodeAndValidateRequest(r, w, &req, action); err != nil {
+		return "", req, false
+	}
+	return userID, req, true
+}
+
+func (h *ModerationHandler) respondError(w http.ResponseWriter, r *http.Request, err error, userID, msg string) {
+	switch {
+	case errors.Is(err, domain.ErrUserNotFound):
+		handler.RespondError(w, http.StatusNotFound, "User not found")
+	case errors.Is(err, domain.ErrAccountAlreadyFrozen):
+		handler.RespondError(w, http.StatusConflict, "Account is already frozen")
+	case errors.Is(err, domain.ErrAccountNotFrozen):
+		handler.RespondError(w, http.StatusConflict, "Account is not frozen")
+	case errors.Is(err, domain.ErrInvalidInput):
+		handler.RespondError(w, http.StatusBadRequest, "actor is required")
+	default:
+		logger.FromContext(r.Context()).Error(msg, "error", err, "user_id", userID)
+		handler.RespondError(w, http.StatusInternalServerError, msg)
+	}
+}
+
+// parseUserIDParam reads and validates the {id} path parameter as an internal user ID
+func parseUserIDParam(w http.ResponseWriter, r *http.Request) (string, bool) {
+	userID := chi.URLParam(r, "id")
+	if _, err := uuid.Parse(userID); err != nil {
+		handler.RespondError(w, http.StatusBadRequest, "Invalid user ID")
+		return "", false
+	}
+	return userID, true
+}
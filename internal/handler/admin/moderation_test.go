@@ -0,0 +1,142 @@
+package admin
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/moderation"
+	"github.com/osse101/BrandishBot_Go/mocks"
+)
+
+const testModeratedUserID = "11111111-2222-3333-4444-555555555555"
+
+func TestModerationHandler_HandleFreeze(t *testing.T) {
+	tests := []struct {
+		name           string
+		id             string
+		body           string
+		setup          func(*mocks.MockModerationService)
+		expectedStatus int
+	}{
+		{
+			name: "freezes the account",
+			id:   testModeratedUserID,
+			body: `{"actor":"mod","reason":"duping"}`,
+			setup: func(m *mocks.MockModerationService) {
+				m.On("Freeze", mock.Anything, testModeratedUserID, "mod", "duping").Return(nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "invalid user ID",
+			id:             "not-a-uuid",
+			body:           `{"actor":"mod"}`,
+			setup:          func(m *mocks.MockModerationService) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "missing actor",
+			id:             testModeratedUserID,
+			body:           `{"reason":"duping"}`,
+			setup:          func(m *mocks.MockModerationService) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name: "unknown user",
+			id:   testModeratedUserID,
+			body: `{"actor":"mod"}`,
+			setup: func(m *mocks.MockModerationService) {
+				m.On("Freeze", mock.Anything, testModeratedUserID, "mod", "").Return(domain.ErrUserNotFound)
+			},
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			name: "already frozen",
+			id:   testModeratedUserID,
+			body: `{"actor":"mod"}`,
+			setup: func(m *mocks.MockModerationService) {
+				m.On("Freeze", mock.Anything, testModeratedUserID, "mod", "").Return(domain.ErrAccountAlreadyFrozen)
+			},
+			expectedStatus: http.StatusConflict,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := mocks.NewMockModerationService(t)
+			tt.setup(svc)
+
+			req := httptest.NewRequest(http.MethodPost, "/api/v1/admin/users/"+tt.id+"/freeze", bytes.NewBufferString(tt.body))
+			rec := httptest.NewRecorder()
+			NewModerationHandler(svc).HandleFreeze(rec, withURLParam(req, "id", tt.id))
+
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+		})
+	}
+}
+
+func TestModerationHandler_HandleUnfreeze(t *testing.T) {
+	svc := mocks.NewMockModerationService(t)
+	svc.On("Unfreeze", mock.Anything, testModeratedUserID, "mod", "").Return(domain.ErrAccountNotFrozen)
+
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/admin/users/"+testModeratedUserID+"/unfreeze", bytes.NewBufferString(`{"actor":"mod"}`))
+	rec := httptest.NewRecorder()
+	NewModerationHandler(svc).HandleUnfreeze(rec, withURLParam(req, "id", testModeratedUserID))
+
+	assert.Equal(t, http.StatusConflict, rec.Code)
+}
+
+func TestModerationHandler_HandleWipeInventory(t *testing.T) {
+	svc := mocks.NewMockModerationService(t)
+	svc.On("WipeInventory", mock.Anything, testModeratedUserID, "mod", "alt account").
+		Return([]domain.InventorySlot{{ItemID: 1, Quantity: 500}}, nil)
+
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/admin/users/"+testModeratedUserID+"/wipe-inventory",
+		bytes.NewBufferString(`{"actor":"mod","reason":"alt account"}`))
+	rec := httptest.NewRecorder()
+	NewModerationHandler(svc).HandleWipeInventory(rec, withURLParam(req, "id", testModeratedUserID))
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	var resp WipeInventoryResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+	assert.Len(t, resp.Removed, 1)
+}
+
+func TestModerationHandler_HandleInspect(t *testing.T) {
+	t.Run("returns the dossier", func(t *testing.T) {
+		svc := mocks.NewMockModerationService(t)
+		svc.On("Inspect", mock.Anything, testModeratedUserID).Return(&moderation.Dossier{
+			User:   &domain.User{ID: testModeratedUserID, Username: "alice"},
+			Frozen: true,
+		}, nil)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/admin/users/"+testModeratedUserID+"/inspect", nil)
+		rec := httptest.NewRecorder()
+		NewModerationHandler(svc).HandleInspect(rec, withURLParam(req, "id", testModeratedUserID))
+
+		require.Equal(t, http.StatusOK, rec.Code)
+		var dossier moderation.Dossier
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &dossier))
+		assert.True(t, dossier.Frozen)
+		assert.Equal(t, "alice", dossier.User.Username)
+	})
+
+	t.Run("unknown user", func(t *testing.T) {
+		svc := mocks.NewMockModerationService(t)
+		svc.On("Inspect", mock.Anything, testModeratedUserID).Return(nil, domain.ErrUserNotFound)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/admin/users/"+testModeratedUserID+"/inspect", nil)
+		rec := httptest.NewRecorder()
+		NewModerationHandler(svc).HandleInspect(rec, withURLParam(req, "id", testModeratedUserID))
+
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+	})
+}
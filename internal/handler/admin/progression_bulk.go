@@ -0,0 +1,140 @@
+package admin
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/handler"
+	"github.com/osse101/BrandishBot_Go/internal/logger"
+	"github.com/osse101/BrandishBot_Go/internal/progressionbulk"
+)
+
+// BulkParticipantsRequest selects the users who recorded a stats event of
+// the type within [since, until)
+type BulkParticipantsRequest struct {
+	EventType string    `json:"event_type" validate:"required,max=100"`
+	Since     time.Time `json:"since" validate:"required"`
+	Until     time.Time `json:"until" validate:"required"`
+}
+
+// BulkProgressionRequest grants or revokes one user progression for many users
+type BulkProgressionRequest struct {
+	Action          string                   `json:"action" validate:"required,oneof=grant revoke"`
+	ProgressionType string                   `json:"progression_type" validate:"required,max=50"`
+	ProgressionKey  string                   `json:"progression_key" validate:"required,max=100"`
+	UserIDs         []string                 `json:"user_ids" validate:"omitempty,max=10000,dive,required,max=255"`
+	Participants    *BulkParticipantsRequest `json:"participants"`
+	Metadata        map[string]interface{}   `json:"metadata"`
+	Actor           string                   `json:"actor" validate:"required,max=100"` // Admin running the operation
+	Reason          string                   `json:"reason" validate:"max=500"`
+}
+
+// BulkProgressionAuditResponse lists an operation's committed batches
+type BulkProgressionAuditResponse struct {
+	OperationID int64                              `json:"operation_id"`
+	Batches     []domain.BulkProgressionAuditEntry `json:"batches"`
+}
+
+// ProgressionBulkHandler handles bulk grants and revokes of user progressions
+type ProgressionBulkHandler struct {
+	svc progressionbulk.Service
+}
+
+// NewProgressionBulkHandler creates a new admin bulk progression handler
+func NewProgressionBulkHandler(svc progressionbulk.Service) *ProgressionBulkHandler {
+	return &ProgressionBulkHandler{svc: svc}
+}
+
+// HandleStart queues a bulk grant or revoke and returns the operation to poll
+// POST /api/v1/admin/progression/bulk
+func (h *ProgressionBulkHandler) HandleStart(w http.ResponseWriter, r *http.Request) {
+	var req BulkProgressionRequest
+	if err := handler.DecodeAndValidateRequest(r, w, &req, "Admin bulk progression"); err != nil {
+		return
+	}
+
+	bulkReq := progressionbulk.Request{
+		Action:          domain.BulkProgressionAction(req.Action),
+		ProgressionType: req.ProgressionType,
+		ProgressionKey:  req.ProgressionKey,
+		UserIDs:         req.UserIDs,
+		Metadata:        req.Metadata,
+		Actor:           req.Actor,
+		Reason:          req.Reason,
+	}
+	if p := req.Participants; p != nil {
+		bulkReq.Participants = &progressionbulk.Participants{EventType: p.EventType, Since: p.Since, Until: p.Until}
+	}
+
+	op, err := h.svc.Start(r.Context(), bulkReq)
+	if err != nil {
+		respondBulkProgressionError(w, r, err, "Failed to start bulk progression operation")
+		return
+	}
+
+	handler.RespondJSON(w, http.StatusAccepted, op)
+}
+
+// HandleGetOperation returns an operation and its progress
+// GET /api/v1/admin/progression/bulk/{operationID}
+func (h *ProgressionBulkHandler) HandleGetOperation(w http.ResponseWriter, r *http.Request) {
+	id, ok := parseBulkOperationID(w, r)
+	if !ok {
+		return
+	}
+
+	op, err := h.svc.GetOperation(r.Context(), id)
+	if err != nil {
+		respondBulkProgressionError(w, r, err, "Failed to retrieve bulk progression operation")
+		return
+	}
+
+	handler.RespondJSON(w, http.StatusOK, op)
+}
+
+// HandleGetAudit returns an operation's audit entries, one per committed batch
+// GET /api/v1/admin/progression/bulk/{operationID}/audit
+func (h *ProgressionBulkHandler) HandleGetAudit(w http.ResponseWriter, r *http.Request) {
+	id, ok := parseBulkOperationID(w, r)
+	if !ok {
+		return
+	}
+
+	batches, err := h.svc.GetAuditTrail(r.Context(), id)
+	if err != nil {
+		respondBulkProgressionError(w, r, err, "Failed to retrieve bulk progression audit")
+		return
+	}
+
+	handler.RespondJSON(w, http.StatusOK, BulkProgressionAuditResponse{OperationID: id, Batches: batches})
+}
+
+func parseBulkOperationID(w http.ResponseWriter, r *http.Request) (int64, bool) {
+	id, err := strconv.ParseInt(chi.URLParam(r, "operationID"), 10, 64)
+	if err != nil || id < 1 {
+		handler.RespondError(w, http.StatusBadRequest, "Invalid bulk operation ID")
+		return 0, false
+	}
+	return id, true
+}
+
+func respondBulkProgressionError(w http.ResponseWriter, r *http.Request, err error, msg string) {
+	switch {
+	case errors.Is(err, progressionbulk.ErrOperationNotFound):
+		handler.RespondError(w, http.StatusNotFound, "Bulk progression operation not found")
+	case errors.Is(err, progressionbulk.ErrNoUsers):
+		handler.RespondError(w, http.StatusUnprocessableEntity, "No users matched the selection")
+	case errors.Is(err, progressionbulk.ErrBusy):
+		handler.RespondError(w, http.StatusServiceUnavailable, "Worker pool is busy, try again later")
+	case errors.Is(err, domain.ErrInvalidInput):
+		handler.RespondError(w, http.StatusBadRequest, err.Error())
+	default:
+		logger.FromContext(r.Context()).Error(msg, "error", err)
+		handler.RespondError(w, http.StatusInternalServerError, msg)
+	}
+}
@@ -0,0 +1,119 @@
+package admin
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/progressionbulk"
+	"github.com/osse101/BrandishBot_Go/mocks"
+)
+
+func TestProgressionBulkHandler_HandleStart(t *testing.T) {
+	tests := []struct {
+		name           string
+		body           string
+		setup          func(*mocks.MockProgressionBulkService)
+		expectedStatus int
+	}{
+		{
+			name: "queues the operation",
+			body: `{"action":"grant","progression_type":"recipe","progression_key":"recipe_festive_hat","actor":"admin",
+				"participants":{"event_type":"stream_event","since":"2026-12-24T00:00:00Z","until":"2026-12-25T00:00:00Z"}}`,
+			setup: func(m *mocks.MockProgressionBulkService) {
+				m.On("Start", mock.Anything, mock.MatchedBy(func(req progressionbulk.Request) bool {
+					return req.Action == domain.BulkProgressionGrant && req.Participants != nil && req.Participants.EventType == "stream_event"
+				})).Return(&domain.BulkProgressionOperation{ID: 4, Status: domain.BulkProgressionQueued}, nil)
+			},
+			expectedStatus: http.StatusAccepted,
+		},
+		{
+			name:           "unknown action",
+			body:           `{"action":"toggle","progression_type":"recipe","progression_key":"k","actor":"admin","user_ids":["u1"]}`,
+			setup:          func(m *mocks.MockProgressionBulkService) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name: "no users matched",
+			body: `{"action":"revoke","progression_type":"recipe","progression_key":"k","actor":"admin","user_ids":["u1"]}`,
+			setup: func(m *mocks.MockProgressionBulkService) {
+				m.On("Start", mock.Anything, mock.Anything).Return(nil, progressionbulk.ErrNoUsers)
+			},
+			expectedStatus: http.StatusUnprocessableEntity,
+		},
+		{
+			name: "worker pool busy",
+			body: `{"action":"grant","progression_type":"recipe","progression_key":"k","actor":"admin","user_ids":["u1"]}`,
+			setup: func(m *mocks.MockProgressionBulkService) {
+				m.On("Start", mock.Anything, mock.Anything).Return(nil, progressionbulk.ErrBusy)
+			},
+			expectedStatus: http.StatusServiceUnavailable,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := mocks.NewMockProgressionBulkService(t)
+			tt.setup(svc)
+			h := NewProgressionBulkHandler(svc)
+
+			req := httptest.NewRequest(http.MethodPost, "/api/v1/admin/progression/bulk", bytes.NewBufferString(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			rec := httptest.NewRecorder()
+			h.HandleStart(rec, req)
+
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+		})
+	}
+}
+
+func TestProgressionBulkHandler_HandleGetOperation(t *testing.T) {
+	tests := []struct {
+		name           string
+		operationID    string
+		setup          func(*mocks.MockProgressionBulkService)
+		expectedStatus int
+	}{
+		{
+			name:        "returns progress",
+			operationID: "4",
+			setup: func(m *mocks.MockProgressionBulkService) {
+				m.On("GetOperation", mock.Anything, int64(4)).Return(&domain.BulkProgressionOperation{ID: 4, ProcessedUsers: 500}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:        "unknown operation",
+			operationID: "5",
+			setup: func(m *mocks.MockProgressionBulkService) {
+				m.On("GetOperation", mock.Anything, int64(5)).Return(nil, progressionbulk.ErrOperationNotFound)
+			},
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			name:           "invalid ID",
+			operationID:    "abc",
+			setup:          func(m *mocks.MockProgressionBulkService) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := mocks.NewMockProgressionBulkService(t)
+			tt.setup(svc)
+			h := NewProgressionBulkHandler(svc)
+
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/admin/progression/bulk/"+tt.operationID, nil)
+			rec := httptest.NewRecorder()
+			h.HandleGetOperation(rec, withURLParam(req, "operationID", tt.operationID))
+
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+		})
+	}
+}
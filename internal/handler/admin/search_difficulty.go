@@ -0,0 +1,62 @@
+package admin
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/handler"
+	"github.com/osse101/BrandishBot_Go/internal/logger"
+	"github.com/osse101/BrandishBot_Go/internal/search"
+)
+
+// SearchDifficultyHandler handles admin tuning of population-based search difficulty
+type SearchDifficultyHandler struct {
+	svc search.Service
+}
+
+// NewSearchDifficultyHandler creates a new admin search difficulty handler
+func NewSearchDifficultyHandler(svc search.Service) *SearchDifficultyHandler {
+	return &SearchDifficultyHandler{svc: svc}
+}
+
+// HandleGetDifficulty reports the recent searcher count, the curve and the tier in effect
+// GET /api/v1/admin/search/difficulty
+func (h *SearchDifficultyHandler) HandleGetDifficulty(w http.ResponseWriter, r *http.Request) {
+	status, err := h.svc.GetDifficulty(r.Context())
+	if err != nil {
+		h.respondError(w, r, err)
+		return
+	}
+
+	handler.RespondJSON(w, http.StatusOK, status)
+}
+
+// HandleSetDifficulty replaces the difficulty curve until the next restart or config reload
+// PUT /api/v1/admin/search/difficulty
+func (h *SearchDifficultyHandler) HandleSetDifficulty(w http.ResponseWriter, r *http.Request) {
+	var req search.DifficultyConfig
+	if err := handler.DecodeAndValidateRequest(r, w, &req, "Set search difficulty"); err != nil {
+		return
+	}
+
+	status, err := h.svc.SetDifficulty(r.Context(), req)
+	if err != nil {
+		h.respondError(w, r, err)
+		return
+	}
+
+	handler.RespondJSON(w, http.StatusOK, status)
+}
+
+func (h *SearchDifficultyHandler) respondError(w http.ResponseWriter, r *http.Request, err error) {
+	switch {
+	case errors.Is(err, search.ErrDifficultyNotConfigured):
+		handler.RespondError(w, http.StatusNotFound, err.Error())
+	case errors.Is(err, domain.ErrInvalidInput):
+		handler.RespondError(w, http.StatusBadRequest, err.Error())
+	default:
+		logger.FromContext(r.Context()).Error("Failed to get search difficulty", "error", err)
+		handler.RespondError(w, http.StatusInternalServerError, "Failed to retrieve search difficulty")
+	}
+}
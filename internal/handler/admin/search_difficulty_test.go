@@ -0,0 +1,111 @@
+package admin
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/search"
+	"github.com/osse101/BrandishBot_Go/mocks"
+)
+
+func TestSearchDifficultyHandler_HandleGetDifficulty(t *testing.T) {
+	tests := []struct {
+		name           string
+		setup          func(*mocks.MockSearchService)
+		expectedStatus int
+	}{
+		{
+			name: "reports the active tier",
+			setup: func(m *mocks.MockSearchService) {
+				m.On("GetDifficulty", mock.Anything).Return(&search.DifficultyStatus{RecentSearchers: 12}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name: "not configured",
+			setup: func(m *mocks.MockSearchService) {
+				m.On("GetDifficulty", mock.Anything).Return(nil, search.ErrDifficultyNotConfigured)
+			},
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			name: "count failure",
+			setup: func(m *mocks.MockSearchService) {
+				m.On("GetDifficulty", mock.Anything).Return(nil, errors.New("db down"))
+			},
+			expectedStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := mocks.NewMockSearchService(t)
+			tt.setup(svc)
+			h := NewSearchDifficultyHandler(svc)
+
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/admin/search/difficulty", nil)
+			rec := httptest.NewRecorder()
+			h.HandleGetDifficulty(rec, req)
+
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+		})
+	}
+}
+
+func TestSearchDifficultyHandler_HandleSetDifficulty(t *testing.T) {
+	body := `{"enabled":true,"window_minutes":30,"tiers":[{"min_searchers":0,"success_modifier":0.05,"quality_modifier":1}]}`
+	tests := []struct {
+		name           string
+		body           string
+		setup          func(*mocks.MockSearchService)
+		expectedStatus int
+	}{
+		{
+			name: "replaces the curve",
+			body: body,
+			setup: func(m *mocks.MockSearchService) {
+				m.On("SetDifficulty", mock.Anything, mock.MatchedBy(func(c search.DifficultyConfig) bool {
+					return c.WindowMinutes == 30 && len(c.Tiers) == 1 && c.Tiers[0].QualityModifier == 1
+				})).Return(&search.DifficultyStatus{}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name: "invalid curve",
+			body: body,
+			setup: func(m *mocks.MockSearchService) {
+				m.On("SetDifficulty", mock.Anything, mock.Anything).
+					Return(nil, fmt.Errorf("%w: at least one tier is required", domain.ErrInvalidInput))
+			},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "malformed body",
+			body:           `{"tiers":`,
+			setup:          func(m *mocks.MockSearchService) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := mocks.NewMockSearchService(t)
+			tt.setup(svc)
+			h := NewSearchDifficultyHandler(svc)
+
+			req := httptest.NewRequest(http.MethodPut, "/api/v1/admin/search/difficulty", bytes.NewBufferString(tt.body))
+			rec := httptest.NewRecorder()
+			h.HandleSetDifficulty(rec, req)
+
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+		})
+	}
+}
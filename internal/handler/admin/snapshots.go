@@ -0,0 +1,161 @@
+package admin
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/handler"
+	"github.com/osse101/BrandishBot_Go/internal/logger"
+	"github.com/osse101/BrandishBot_Go/internal/snapshot"
+)
+
+// CreateSnapshotRequest takes a manual snapshot
+type CreateSnapshotRequest struct {
+	Note      string `json:"note" validate:"max=200"`
+	CreatedBy string `json:"created_by" validate:"required,max=100"`
+}
+
+// RestoreSnapshotRequest restores either a stored snapshot or an uploaded
+// document, such as one downloaded from another environment. Uploads are
+// subject to the server's request size limit; larger snapshots have to be
+// restored by ID.
+type RestoreSnapshotRequest struct {
+	SnapshotID *int64               `json:"snapshot_id,omitempty"`
+	Snapshot   *domain.SnapshotData `json:"snapshot,omitempty"`
+	RestoredBy string               `json:"restored_by" validate:"required,max=100"`
+}
+
+// SnapshotHandler takes, lists and restores game state snapshots
+type SnapshotHandler struct {
+	svc snapshot.Service
+}
+
+// NewSnapshotHandler creates a new admin snapshot handler
+func NewSnapshotHandler(svc snapshot.Service) *SnapshotHandler {
+	return &SnapshotHandler{svc: svc}
+}
+
+// HandleCreate snapshots the current game state
+// POST /api/v1/admin/snapshot
+func (h *SnapshotHandler) HandleCreate(w http.ResponseWriter, r *http.Request) {
+	var req CreateSnapshotRequest
+	if err := handler.DecodeAndValidateRequest(r, w, &req, "Admin create snapshot"); err != nil {
+		return
+	}
+
+	snap, err := h.svc.Create(r.Context(), snapshot.CreateRequest{
+		Trigger:   domain.SnapshotTriggerManual,
+		Note:      req.Note,
+		CreatedBy: req.CreatedBy,
+	})
+	if err != nil {
+		respondSnapshotError(w, r, err, "Failed to create snapshot")
+		return
+	}
+
+	handler.RespondJSON(w, http.StatusCreated, snap)
+}
+
+// HandleList returns every stored snapshot without its data
+// GET /api/v1/admin/snapshots
+func (h *SnapshotHandler) HandleList(w http.ResponseWriter, r *http.Request) {
+	snaps, err := h.svc.List(r.Context())
+	if err != nil {
+		respondSnapshotError(w, r, err, "Failed to list snapshots")
+		return
+	}
+
+	handler.RespondJSON(w, http.StatusOK, map[string]interface{}{
+		"snapshots": snaps,
+	})
+}
+
+// HandleExport downloads a stored snapshot's document
+// GET /api/v1/admin/snapshots/{id}
+func (h *SnapshotHandler) HandleExport(w http.ResponseWriter, r *http.Request) {
+	id, ok := snapshotIDParam(w, r)
+	if !ok {
+		return
+	}
+
+	data, err := h.svc.Export(r.Context(), id)
+	if err != nil {
+		respondSnapshotError(w, r, err, "Failed to export snapshot")
+		return
+	}
+
+	w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="snapshot-%d.json"`, id))
+	handler.RespondJSON(w, http.StatusOK, data)
+}
+
+// HandleDelete removes a stored snapshot
+// DELETE /api/v1/admin/snapshots/{id}
+func (h *SnapshotHandler) HandleDelete(w http.ResponseWriter, r *http.Request) {
+	id, ok := snapshotIDParam(w, r)
+	if !ok {
+		return
+	}
+
+	if err := h.svc.Delete(r.Context(), id); err != nil {
+		respondSnapshotError(w, r, err, "Failed to delete snapshot")
+		return
+	}
+
+	handler.RespondJSON(w, http.StatusOK, handler.SuccessResponse{Message: "Snapshot deleted"})
+}
+
+// HandleRestore replaces game state with a snapshot. The replaced state is
+// snapshotted first and its ID returned.
+// POST /api/v1/admin/restore
+func (h *SnapshotHandler) HandleRestore(w http.ResponseWriter, r *http.Request) {
+	var req RestoreSnapshotRequest
+	if err := handler.DecodeAndValidateRequest(r, w, &req, "Admin restore snapshot"); err != nil {
+		return
+	}
+	if (req.SnapshotID == nil) == (req.Snapshot == nil) {
+		handler.RespondError(w, http.StatusBadRequest, "Provide exactly one of snapshot_id or snapshot")
+		return
+	}
+
+	var result *snapshot.RestoreResult
+	var err error
+	if req.SnapshotID != nil {
+		result, err = h.svc.Restore(r.Context(), *req.SnapshotID, req.RestoredBy)
+	} else {
+		result, err = h.svc.Import(r.Context(), req.Snapshot, req.RestoredBy)
+	}
+	if err != nil {
+		respondSnapshotError(w, r, err, "Failed to restore snapshot")
+		return
+	}
+
+	handler.RespondJSON(w, http.StatusOK, result)
+}
+
+func snapshotIDParam(w http.ResponseWriter, r *http.Request) (int64, bool) {
+	id, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil || id <= 0 {
+		handler.RespondError(w, http.StatusBadRequest, "Invalid snapshot ID")
+		return 0, false
+	}
+	return id, true
+}
+
+func respondSnapshotError(w http.ResponseWriter, r *http.Request, err error, msg string) {
+	switch {
+	case errors.Is(err, domain.ErrSnapshotNotFound):
+		handler.RespondError(w, http.StatusNotFound, "Snapshot not found")
+	case errors.Is(err, domain.ErrSnapshotSchemaMismatch):
+		handler.RespondError(w, http.StatusConflict, err.Error())
+	case errors.Is(err, domain.ErrInvalidInput):
+		handler.RespondError(w, http.StatusBadRequest, err.Error())
+	default:
+		logger.FromContext(r.Context()).Error(msg, "error", err)
+		handler.RespondError(w, http.StatusInternalServerError, msg)
+	}
+}
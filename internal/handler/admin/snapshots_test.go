@@ -0,0 +1,135 @@
+package admin
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/snapshot"
+	"github.com/osse101/BrandishBot_Go/mocks"
+)
+
+func TestSnapshotHandler_HandleCreate(t *testing.T) {
+	t.Run("takes a manual snapshot", func(t *testing.T) {
+		svc := mocks.NewMockSnapshotService(t)
+		svc.On("Create", mock.Anything, snapshot.CreateRequest{Trigger: domain.SnapshotTriggerManual, Note: "pre-patch", CreatedBy: "admin"}).
+			Return(&domain.GameSnapshot{ID: 3, Trigger: domain.SnapshotTriggerManual}, nil)
+
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/admin/snapshot", bytes.NewBufferString(`{"note":"pre-patch","created_by":"admin"}`))
+		rec := httptest.NewRecorder()
+		NewSnapshotHandler(svc).HandleCreate(rec, req)
+
+		assert.Equal(t, http.StatusCreated, rec.Code)
+		assert.Contains(t, rec.Body.String(), `"id":3`)
+	})
+
+	t.Run("requires created_by", func(t *testing.T) {
+		svc := mocks.NewMockSnapshotService(t)
+
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/admin/snapshot", bytes.NewBufferString(`{}`))
+		rec := httptest.NewRecorder()
+		NewSnapshotHandler(svc).HandleCreate(rec, req)
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+}
+
+func TestSnapshotHandler_HandleExport(t *testing.T) {
+	t.Run("downloads the document", func(t *testing.T) {
+		svc := mocks.NewMockSnapshotService(t)
+		svc.On("Export", mock.Anything, int64(3)).Return(&domain.SnapshotData{SchemaVersion: 69}, nil)
+
+		rec := httptest.NewRecorder()
+		NewSnapshotHandler(svc).HandleExport(rec, withURLParam(httptest.NewRequest(http.MethodGet, "/api/v1/admin/snapshots/3", nil), "id", "3"))
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Contains(t, rec.Header().Get("Content-Disposition"), "snapshot-3.json")
+		assert.Contains(t, rec.Body.String(), `"schema_version":69`)
+	})
+
+	t.Run("unknown snapshot", func(t *testing.T) {
+		svc := mocks.NewMockSnapshotService(t)
+		svc.On("Export", mock.Anything, int64(3)).Return(nil, domain.ErrSnapshotNotFound)
+
+		rec := httptest.NewRecorder()
+		NewSnapshotHandler(svc).HandleExport(rec, withURLParam(httptest.NewRequest(http.MethodGet, "/api/v1/admin/snapshots/3", nil), "id", "3"))
+
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+	})
+
+	t.Run("bad ID", func(t *testing.T) {
+		svc := mocks.NewMockSnapshotService(t)
+
+		rec := httptest.NewRecorder()
+		NewSnapshotHandler(svc).HandleExport(rec, withURLParam(httptest.NewRequest(http.MethodGet, "/api/v1/admin/snapshots/latest", nil), "id", "latest"))
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+}
+
+func TestSnapshotHandler_HandleRestore(t *testing.T) {
+	tests := []struct {
+		name           string
+		body           string
+		setup          func(*mocks.MockSnapshotService)
+		expectedStatus int
+	}{
+		{
+			name: "restores a stored snapshot",
+			body: `{"snapshot_id":3,"restored_by":"admin"}`,
+			setup: func(m *mocks.MockSnapshotService) {
+				m.On("Restore", mock.Anything, int64(3), "admin").Return(&snapshot.RestoreResult{SafetySnapshotID: 4}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name: "imports an uploaded snapshot",
+			body: `{"snapshot":{"schema_version":69,"tables":{"users":[]}},"restored_by":"admin"}`,
+			setup: func(m *mocks.MockSnapshotService) {
+				m.On("Import", mock.Anything, mock.MatchedBy(func(d *domain.SnapshotData) bool { return d.SchemaVersion == 69 }), "admin").
+					Return(&snapshot.RestoreResult{SafetySnapshotID: 4}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "neither source",
+			body:           `{"restored_by":"admin"}`,
+			setup:          func(m *mocks.MockSnapshotService) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "both sources",
+			body:           `{"snapshot_id":3,"snapshot":{"tables":{}},"restored_by":"admin"}`,
+			setup:          func(m *mocks.MockSnapshotService) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name: "schema mismatch",
+			body: `{"snapshot_id":3,"restored_by":"admin"}`,
+			setup: func(m *mocks.MockSnapshotService) {
+				m.On("Restore", mock.Anything, int64(3), "admin").Return(nil, fmt.Errorf("%w: version 68", domain.ErrSnapshotSchemaMismatch))
+			},
+			expectedStatus: http.StatusConflict,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := mocks.NewMockSnapshotService(t)
+			tt.setup(svc)
+
+			req := httptest.NewRequest(http.MethodPost, "/api/v1/admin/restore", bytes.NewBufferString(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			rec := httptest.NewRecorder()
+			NewSnapshotHandler(svc).HandleRestore(rec, req)
+
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+		})
+	}
+}
@@ -11,7 +11,13 @@ import (
 // SSEBroadcastRequest represents the request to broadcast an SSE event
 type SSEBroadcastRequest struct {
 	Type    string          `json:"type"`
-	Payload json.RawMessage `json:"payload"`
+	Payload json.RawMessage `json:"payload" swaggertype:"object"`
+}
+
+// SSEBroadcastResponse confirms a broadcast
+type SSEBroadcastResponse struct {
+	Message string `json:"message"`
+	Type    string `json:"type"`
 }
 
 // SSEHandler handles SSE-related admin tasks
@@ -24,8 +30,17 @@ func NewSSEHandler(sseHub *sse.Hub) *SSEHandler {
 	return &SSEHandler{sseHub: sseHub}
 }
 
-// HandleBroadcast broadcasts a manual event to all SSE clients
-// POST /api/v1/admin/sse/broadcast
+// HandleBroadcast broadcasts a manual event to the SSE clients of the
+// request's community
+// @Summary Broadcast an SSE event
+// @Description Send a manual event to the SSE clients of the request's community
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Param request body SSEBroadcastRequest true "Event type and payload"
+// @Success 200 {object} SSEBroadcastResponse
+// @Failure 400 {object} handler.ErrorResponse
+// @Router /api/v1/admin/sse/broadcast [post]
 func (h *SSEHandler) HandleBroadcast(w http.ResponseWriter, r *http.Request) {
 	var req SSEBroadcastRequest
 	if err := handler.DecodeAndValidateRequest(r, w, &req, "BroadcastSSE"); err != nil {
@@ -46,10 +61,10 @@ func (h *SSEHandler) HandleBroadcast(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
-	h.sseHub.Broadcast(req.Type, payload)
+	h.sseHub.Broadcast(r.Context(), req.Type, payload)
 
-	handler.RespondJSON(w, http.StatusOK, map[string]string{
-		"message": "Event broadcasted successfully",
-		"type":    req.Type,
+	handler.RespondJSON(w, http.StatusOK, SSEBroadcastResponse{
+		Message: "Event broadcasted successfully",
+		Type:    req.Type,
 	})
 }
@@ -14,6 +14,13 @@ type ClearTimeoutRequest struct {
 	Username string `json:"username" validate:"required,max=100"`
 }
 
+// ClearTimeoutResponse confirms a cleared timeout
+type ClearTimeoutResponse struct {
+	Message  string `json:"message"`
+	Platform string `json:"platform"`
+	Username string `json:"username"`
+}
+
 // HandleClearTimeout clears a user's timeout (admin action)
 // @Summary Clear user timeout
 // @Description Remove a user's timeout (admin only)
@@ -21,10 +28,10 @@ type ClearTimeoutRequest struct {
 // @Accept json
 // @Produce json
 // @Param request body ClearTimeoutRequest true "Clear timeout request"
-// @Success 200 {object} map[string]interface{}
+// @Success 200 {object} ClearTimeoutResponse
 // @Failure 400 {object} handler.ErrorResponse
 // @Failure 500 {object} handler.ErrorResponse
-// @Router /admin/timeout/clear [post]
+// @Router /api/v1/admin/timeout/clear [post]
 func HandleClearTimeout(svc user.Service) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		log := logger.FromContext(r.Context())
@@ -42,8 +49,7 @@ func HandleClearTimeout(svc user.Service) http.HandlerFunc {
 
 		if err := svc.ClearTimeout(r.Context(), req.Platform, req.Username); err != nil {
 			log.Error("Failed to clear timeout", "error", err, "platform", req.Platform, "username", req.Username)
-			statusCode, userMsg := handler.MapServiceErrorToUserMessage(err)
-			handler.RespondError(w, statusCode, userMsg)
+			handler.RespondMappedError(w, err)
 			return
 		}
 
@@ -51,12 +57,10 @@ func HandleClearTimeout(svc user.Service) http.HandlerFunc {
 			"platform", req.Platform,
 			"username", req.Username)
 
-		response := map[string]interface{}{
-			"message":  "Timeout cleared successfully",
-			"platform": req.Platform,
-			"username": req.Username,
-		}
-
-		handler.RespondJSON(w, http.StatusOK, response)
+		handler.RespondJSON(w, http.StatusOK, ClearTimeoutResponse{
+			Message:  "Timeout cleared successfully",
+			Platform: req.Platform,
+			Username: req.Username,
+		})
 	}
 }
@@ -2,6 +2,8 @@ package admin
 
 import (
 	"net/http"
+	"strconv"
+	"time"
 
 	"github.com/osse101/BrandishBot_Go/internal/domain"
 	"github.com/osse101/BrandishBot_Go/internal/handler"
@@ -33,8 +35,23 @@ type UserLookupResponse struct {
 	CreatedAt  string `json:"created_at"`
 }
 
+// Limits for HandleGetRecentUsers
+const (
+	DefaultRecentUsersLimit = 10
+	MaxRecentUsersLimit     = 100
+)
+
 // HandleUserLookup looks up a user by platform and username
-// GET /api/v1/admin/user/lookup?platform=twitch&username=foo
+// @Summary Look up a user
+// @Description Find a user by platform and username
+// @Tags admin
+// @Produce json
+// @Param platform query string true "Platform (twitch, youtube, discord)"
+// @Param username query string true "Username"
+// @Success 200 {object} UserLookupResponse
+// @Failure 400 {object} handler.ErrorResponse
+// @Failure 404 {object} handler.ErrorResponse
+// @Router /api/v1/admin/users/lookup [get]
 func (h *UserHandler) HandleUserLookup(w http.ResponseWriter, r *http.Request) {
 	platform := r.URL.Query().Get("platform")
 	username := r.URL.Query().Get("username")
@@ -46,8 +63,7 @@ func (h *UserHandler) HandleUserLookup(w http.ResponseWriter, r *http.Request) {
 
 	user, err := h.userRepo.GetUserByPlatformUsername(r.Context(), platform, username)
 	if err != nil {
-		status, msg := handler.MapServiceErrorToUserMessage(err)
-		handler.RespondError(w, status, msg)
+		handler.RespondMappedError(w, err)
 		return
 	}
 
@@ -76,9 +92,25 @@ func (h *UserHandler) HandleUserLookup(w http.ResponseWriter, r *http.Request) {
 }
 
 // HandleGetRecentUsers returns a list of recently active users
-// GET /api/v1/admin/users/recent
+// @Summary List recent users
+// @Description List the users who were active most recently
+// @Tags admin
+// @Produce json
+// @Param limit query int false "Number of users (default 10, max 100)"
+// @Success 200 {array} domain.User
+// @Failure 400 {object} handler.ErrorResponse
+// @Failure 500 {object} handler.ErrorResponse
+// @Router /api/v1/admin/users/recent [get]
 func (h *UserHandler) HandleGetRecentUsers(w http.ResponseWriter, r *http.Request) {
-	limit := 10 // Default limit
+	limit := DefaultRecentUsersLimit
+	if limitStr := r.URL.Query().Get("limit"); limitStr != "" {
+		parsed, err := strconv.Atoi(limitStr)
+		if err != nil || parsed < 1 || parsed > MaxRecentUsersLimit {
+			handler.RespondError(w, http.StatusBadRequest, handler.ErrMsgInvalidLimit)
+			return
+		}
+		limit = parsed
+	}
 
 	users, err := h.userRepo.GetRecentlyActiveUsers(r.Context(), limit)
 	if err != nil {
@@ -107,9 +139,33 @@ func (h *UserHandler) HandleGetJobs(w http.ResponseWriter, r *http.Request) {
 }
 
 // HandleGetActiveChatters returns a list of users who recently sent messages
-// GET /api/v1/admin/users/active
+// @Summary List active chatters
+// @Description List the users who recently sent chat messages
+// @Tags admin
+// @Produce json
+// @Param minutes query int false "Only chatters seen in the last N minutes (default all tracked chatters)"
+// @Success 200 {array} activechatter.Chatter
+// @Failure 400 {object} handler.ErrorResponse
+// @Router /api/v1/admin/users/active [get]
 func (h *UserHandler) HandleGetActiveChatters(w http.ResponseWriter, r *http.Request) {
-	chatters := h.userService.GetActiveChatters()
 	// chatters is []user.ActiveChatter, which is aliased to activechatter.Chatter
+	chatters := h.userService.GetActiveChatters()
+
+	if minutesStr := r.URL.Query().Get("minutes"); minutesStr != "" {
+		minutes, err := strconv.Atoi(minutesStr)
+		if err != nil || minutes < 1 {
+			handler.RespondError(w, http.StatusBadRequest, "Invalid 'minutes' (must be positive)")
+			return
+		}
+		since := time.Now().Add(-time.Duration(minutes) * time.Minute)
+		recent := chatters[:0]
+		for _, c := range chatters {
+			if c.LastMessageAt.After(since) {
+				recent = append(recent, c)
+			}
+		}
+		chatters = recent
+	}
+
 	handler.RespondJSON(w, http.StatusOK, chatters)
 }
@@ -188,10 +188,28 @@ type ErrorResponse struct {
 func TestHandleGetRecentUsers(t *testing.T) {
 	tests := []struct {
 		name           string
+		query          string
 		mockRepoSetup  func(*repomocks.MockRepository)
 		expectedStatus int
 		expectedBody   interface{}
 	}{
+		{
+			name:           "invalid limit",
+			query:          "?limit=0",
+			mockRepoSetup:  func(m *repomocks.MockRepository) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   ErrorResponse{Error: "Invalid limit parameter"},
+		},
+		{
+			name:  "custom limit",
+			query: "?limit=1",
+			mockRepoSetup: func(m *repomocks.MockRepository) {
+				m.On("GetRecentlyActiveUsers", mock.Anything, 1).
+					Return([]domain.User{{ID: "user-1", Username: "user1"}}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   []domain.User{{ID: "user-1", Username: "user1"}},
+		},
 		{
 			name: "error getting recent users",
 			mockRepoSetup: func(m *repomocks.MockRepository) {
@@ -238,7 +256,7 @@ func TestHandleGetRecentUsers(t *testing.T) {
 
 			handler := NewUserHandler(mockRepo, mockSvc)
 
-			req := httptest.NewRequest(http.MethodGet, "/api/v1/admin/users/recent", nil)
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/admin/users/recent"+tt.query, nil)
 			rr := httptest.NewRecorder()
 			handler.HandleGetRecentUsers(rr, req)
 
@@ -408,3 +426,25 @@ func TestHandleGetActiveChatters(t *testing.T) {
 	mockRepo.AssertExpectations(t)
 	mockSvc.AssertExpectations(t)
 }
+
+func TestHandleGetActiveChatters_MinutesWindow(t *testing.T) {
+	mockRepo := new(repomocks.MockRepository)
+	mockSvc := new(mocks.MockUserService)
+	handler := NewUserHandler(mockRepo, mockSvc)
+
+	mockSvc.On("GetActiveChatters").Return([]user.ActiveChatter{
+		{UserID: "user-1", Username: "recent", LastMessageAt: time.Now().Add(-2 * time.Minute)},
+		{UserID: "user-2", Username: "stale", LastMessageAt: time.Now().Add(-20 * time.Minute)},
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/admin/users/active?minutes=5", nil)
+	rr := httptest.NewRecorder()
+	handler.HandleGetActiveChatters(rr, req)
+
+	assert.Equal(t, http.StatusOK, rr.Code)
+
+	var resp []user.ActiveChatter
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &resp))
+	require.Len(t, resp, 1)
+	assert.Equal(t, "recent", resp[0].Username)
+}
@@ -0,0 +1,168 @@
+package admin
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+
+	"github.com/osse101/BrandishBot_Go/internal/brigade"
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/handler"
+	"github.com/osse101/BrandishBot_Go/internal/logger"
+)
+
+// VoteReviewRequest excludes or restores one user's vote in a session
+type VoteReviewRequest struct {
+	UserID string `json:"user_id" validate:"required,max=255"`
+	Actor  string `json:"actor" validate:"required,max=100"` // Admin making the change, recorded in the audit trail
+	Reason string `json:"reason" validate:"max=500"`
+}
+
+// SuspectVotesResponse lists the flagged votes in a session
+type SuspectVotesResponse struct {
+	SessionID int                  `json:"session_id"`
+	Votes     []domain.SuspectVote `json:"votes"`
+}
+
+// VoteAuditResponse lists every exclusion and restoration in a session
+type VoteAuditResponse struct {
+	SessionID int                           `json:"session_id"`
+	Entries   []domain.VoteReviewAuditEntry `json:"entries"`
+}
+
+// VoteReviewHandler handles admin review of votes flagged for brigading
+type VoteReviewHandler struct {
+	svc brigade.Service
+}
+
+// NewVoteReviewHandler creates a new admin vote review handler
+func NewVoteReviewHandler(svc brigade.Service) *VoteReviewHandler {
+	return &VoteReviewHandler{svc: svc}
+}
+
+// HandleListSuspects lists the flagged votes in a session
+// GET /api/v1/admin/votes/sessions/{sessionID}/suspects
+func (h *VoteReviewHandler) HandleListSuspects(w http.ResponseWriter, r *http.Request) {
+	sessionID, ok := parseVoteSessionID(w, r)
+	if !ok {
+		return
+	}
+
+	votes, err := h.svc.GetSuspectVotes(r.Context(), sessionID)
+	if err != nil {
+		logger.FromContext(r.Context()).Error("Failed to list suspect votes", "error", err, "session_id", sessionID)
+		handler.RespondError(w, http.StatusInternalServerError, "Failed to retrieve suspect votes")
+		return
+	}
+
+	handler.RespondJSON(w, http.StatusOK, SuspectVotesResponse{SessionID: sessionID, Votes: votes})
+}
+
+// HandleScan runs brigading detection on a session now and returns the newly flagged votes
+// POST /api/v1/admin/votes/sessions/{sessionID}/scan
+func (h *VoteReviewHandler) HandleScan(w http.ResponseWriter, r *http.Request) {
+	sessionID, ok := parseVoteSessionID(w, r)
+	if !ok {
+		return
+	}
+
+	votes, err := h.svc.ScanSession(r.Context(), sessionID)
+	if err != nil {
+		logger.FromContext(r.Context()).Error("Failed to scan session for brigading", "error", err, "session_id", sessionID)
+		handler.RespondError(w, http.StatusInternalServerError, "Failed to scan voting session")
+		return
+	}
+
+	handler.RespondJSON(w, http.StatusOK, SuspectVotesResponse{SessionID: sessionID, Votes: votes})
+}
+
+// HandleGetAudit returns the exclusion audit trail for a session
+// GET /api/v1/admin/votes/sessions/{sessionID}/audit
+func (h *VoteReviewHandler) HandleGetAudit(w http.ResponseWriter, r *http.Request) {
+	sessionID, ok := parseVoteSessionID(w, r)
+	if !ok {
+		return
+	}
+
+	entries, err := h.svc.GetAuditTrail(r.Context(), sessionID)
+	if err != nil {
+		logger.FromContext(r.Context()).Error("Failed to get vote audit trail", "error", err, "session_id", sessionID)
+		handler.RespondError(w, http.StatusInternalServerError, "Failed to retrieve vote audit trail")
+		return
+	}
+
+	handler.RespondJSON(w, http.StatusOK, VoteAuditResponse{SessionID: sessionID, Entries: entries})
+}
+
+// HandleExclude removes a vote from its option's tally
+// POST /api/v1/admin/votes/sessions/{sessionID}/exclude
+func (h *VoteReviewHandler) HandleExclude(w http.ResponseWriter, r *http.Request) {
+	sessionID, ok := parseVoteSessionID(w, r)
+	if !ok {
+		return
+	}
+
+	var req VoteReviewRequest
+	if err := handler.DecodeAndValidateRequest(r, w, &req, "Admin exclude vote"); err != nil {
+		return
+	}
+
+	if err := h.svc.ExcludeVote(r.Context(), sessionID, req.UserID, req.Actor, req.Reason); err != nil {
+		respondVoteReviewError(w, r, err, "Failed to exclude vote")
+		return
+	}
+
+	handler.RespondJSON(w, http.StatusOK, map[string]interface{}{
+		"session_id": sessionID,
+		"user_id":    req.UserID,
+		"action":     domain.VoteReviewExcluded,
+	})
+}
+
+// HandleRestore counts a previously excluded vote again
+// POST /api/v1/admin/votes/sessions/{sessionID}/restore
+func (h *VoteReviewHandler) HandleRestore(w http.ResponseWriter, r *http.Request) {
+	sessionID, ok := parseVoteSessionID(w, r)
+	if !ok {
+		return
+	}
+
+	var req VoteReviewRequest
+	if err := handler.DecodeAndValidateRequest(r, w, &req, "Admin restore vote"); err != nil {
+		return
+	}
+
+	if err := h.svc.RestoreVote(r.Context(), sessionID, req.UserID, req.Actor, req.Reason); err != nil {
+		respondVoteReviewError(w, r, err, "Failed to restore vote")
+		return
+	}
+
+	handler.RespondJSON(w, http.StatusOK, map[string]interface{}{
+		"session_id": sessionID,
+		"user_id":    req.UserID,
+		"action":     domain.VoteReviewRestored,
+	})
+}
+
+func parseVoteSessionID(w http.ResponseWriter, r *http.Request) (int, bool) {
+	id, err := strconv.Atoi(chi.URLParam(r, "sessionID"))
+	if err != nil || id < 1 {
+		handler.RespondError(w, http.StatusBadRequest, "Invalid voting session ID")
+		return 0, false
+	}
+	return id, true
+}
+
+func respondVoteReviewError(w http.ResponseWriter, r *http.Request, err error, msg string) {
+	switch {
+	case errors.Is(err, brigade.ErrVoteNotReviewable):
+		handler.RespondError(w, http.StatusConflict, "Vote is not in an open session or is already in that state")
+	case errors.Is(err, domain.ErrInvalidInput):
+		handler.RespondError(w, http.StatusBadRequest, "user_id and actor are required")
+	default:
+		logger.FromContext(r.Context()).Error(msg, "error", err)
+		handler.RespondError(w, http.StatusInternalServerError, msg)
+	}
+}
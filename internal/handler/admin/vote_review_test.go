@@ -0,0 +1,109 @@
+package admin
+
+import (
+	"bytes"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/osse101/BrandishBot_Go/internal/brigade"
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/mocks"
+)
+
+func TestVoteReviewHandler_HandleListSuspects(t *testing.T) {
+	tests := []struct {
+		name           string
+		sessionID      string
+		setup          func(*mocks.MockBrigadeService)
+		expectedStatus int
+	}{
+		{
+			name:      "lists flagged votes",
+			sessionID: "3",
+			setup: func(m *mocks.MockBrigadeService) {
+				m.On("GetSuspectVotes", mock.Anything, 3).Return([]domain.SuspectVote{{SessionID: 3, UserID: "u1"}}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "invalid session ID",
+			sessionID:      "abc",
+			setup:          func(m *mocks.MockBrigadeService) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:      "service error",
+			sessionID: "3",
+			setup: func(m *mocks.MockBrigadeService) {
+				m.On("GetSuspectVotes", mock.Anything, 3).Return(nil, errors.New("db down"))
+			},
+			expectedStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := mocks.NewMockBrigadeService(t)
+			tt.setup(svc)
+			h := NewVoteReviewHandler(svc)
+
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/admin/votes/sessions/"+tt.sessionID+"/suspects", nil)
+			rec := httptest.NewRecorder()
+			h.HandleListSuspects(rec, withURLParam(req, "sessionID", tt.sessionID))
+
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+		})
+	}
+}
+
+func TestVoteReviewHandler_HandleExclude(t *testing.T) {
+	tests := []struct {
+		name           string
+		body           string
+		setup          func(*mocks.MockBrigadeService)
+		expectedStatus int
+	}{
+		{
+			name: "excludes the vote",
+			body: `{"user_id":"u1","actor":"mod","reason":"alt account"}`,
+			setup: func(m *mocks.MockBrigadeService) {
+				m.On("ExcludeVote", mock.Anything, 3, "u1", "mod", "alt account").Return(nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "actor is required",
+			body:           `{"user_id":"u1"}`,
+			setup:          func(m *mocks.MockBrigadeService) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name: "already excluded or session closed",
+			body: `{"user_id":"u1","actor":"mod"}`,
+			setup: func(m *mocks.MockBrigadeService) {
+				m.On("ExcludeVote", mock.Anything, 3, "u1", "mod", "").Return(brigade.ErrVoteNotReviewable)
+			},
+			expectedStatus: http.StatusConflict,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := mocks.NewMockBrigadeService(t)
+			tt.setup(svc)
+			h := NewVoteReviewHandler(svc)
+
+			req := httptest.NewRequest(http.MethodPost, "/api/v1/admin/votes/sessions/3/exclude", bytes.NewBufferString(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			rec := httptest.NewRecorder()
+			h.HandleExclude(rec, withURLParam(req, "sessionID", "3"))
+
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+		})
+	}
+}
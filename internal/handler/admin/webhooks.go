@@ -0,0 +1,136 @@
+package admin
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/handler"
+	"github.com/osse101/BrandishBot_Go/internal/logger"
+	"github.com/osse101/BrandishBot_Go/internal/webhook"
+)
+
+// RegisterWebhookRequest asks for a webhook
+type RegisterWebhookRequest struct {
+	URL         string   `json:"url" validate:"required,max=2048"`
+	EventTypes  []string `json:"event_types" validate:"required,min=1,max=10,dive,required,max=100"`
+	Description string   `json:"description" validate:"max=200"`
+	CreatedBy   string   `json:"created_by" validate:"required,max=100"`
+}
+
+// WebhookHandler registers, lists and deletes outgoing webhooks and shows
+// their delivery history
+type WebhookHandler struct {
+	svc webhook.Service
+}
+
+// NewWebhookHandler creates a new admin webhook handler
+func NewWebhookHandler(svc webhook.Service) *WebhookHandler {
+	return &WebhookHandler{svc: svc}
+}
+
+// HandleRegister adds a webhook. The response is the only time its signing
+// secret is shown.
+// POST /api/v1/admin/webhooks
+func (h *WebhookHandler) HandleRegister(w http.ResponseWriter, r *http.Request) {
+	var req RegisterWebhookRequest
+	if err := handler.DecodeAndValidateRequest(r, w, &req, "Admin register webhook"); err != nil {
+		return
+	}
+
+	registered, err := h.svc.Register(r.Context(), webhook.RegisterRequest{
+		URL:         req.URL,
+		EventTypes:  req.EventTypes,
+		Description: req.Description,
+		CreatedBy:   req.CreatedBy,
+	})
+	if err != nil {
+		respondWebhookError(w, r, err, "Failed to register webhook")
+		return
+	}
+
+	handler.RespondJSON(w, http.StatusCreated, registered)
+}
+
+// HandleList returns every webhook
+// GET /api/v1/admin/webhooks
+func (h *WebhookHandler) HandleList(w http.ResponseWriter, r *http.Request) {
+	hooks, err := h.svc.ListWebhooks(r.Context())
+	if err != nil {
+		respondWebhookError(w, r, err, "Failed to list webhooks")
+		return
+	}
+
+	handler.RespondJSON(w, http.StatusOK, map[string]interface{}{
+		"webhooks": hooks,
+	})
+}
+
+// HandleDelete removes a webhook and its delivery history
+// DELETE /api/v1/admin/webhooks/{id}
+func (h *WebhookHandler) HandleDelete(w http.ResponseWriter, r *http.Request) {
+	id, ok := parseWebhookID(w, r)
+	if !ok {
+		return
+	}
+
+	if err := h.svc.DeleteWebhook(r.Context(), id); err != nil {
+		respondWebhookError(w, r, err, "Failed to delete webhook")
+		return
+	}
+
+	handler.RespondJSON(w, http.StatusOK, handler.SuccessResponse{Message: "Webhook deleted"})
+}
+
+// HandleGetDeliveries returns a webhook's most recent deliveries
+// GET /api/v1/admin/webhooks/{id}/deliveries?limit=
+func (h *WebhookHandler) HandleGetDeliveries(w http.ResponseWriter, r *http.Request) {
+	id, ok := parseWebhookID(w, r)
+	if !ok {
+		return
+	}
+
+	limit := webhook.DefaultHistoryLimit
+	if limitStr := r.URL.Query().Get("limit"); limitStr != "" {
+		parsed, err := strconv.Atoi(limitStr)
+		if err != nil || parsed < 1 || parsed > webhook.MaxHistoryLimit {
+			handler.RespondError(w, http.StatusBadRequest, "Invalid 'limit' (must be 1-500)")
+			return
+		}
+		limit = parsed
+	}
+
+	deliveries, err := h.svc.GetDeliveries(r.Context(), id, limit)
+	if err != nil {
+		respondWebhookError(w, r, err, "Failed to get webhook deliveries")
+		return
+	}
+
+	handler.RespondJSON(w, http.StatusOK, map[string]interface{}{
+		"deliveries": deliveries,
+	})
+}
+
+func parseWebhookID(w http.ResponseWriter, r *http.Request) (int64, bool) {
+	id, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil || id < 1 {
+		handler.RespondError(w, http.StatusBadRequest, "Invalid webhook ID")
+		return 0, false
+	}
+	return id, true
+}
+
+func respondWebhookError(w http.ResponseWriter, r *http.Request, err error, msg string) {
+	switch {
+	case errors.Is(err, domain.ErrWebhookNotFound):
+		handler.RespondError(w, http.StatusNotFound, "Webhook not found")
+	case errors.Is(err, domain.ErrInvalidInput):
+		handler.RespondError(w, http.StatusBadRequest, err.Error())
+	default:
+		logger.FromContext(r.Context()).Error(msg, "error", err)
+		handler.RespondError(w, http.StatusInternalServerError, msg)
+	}
+}
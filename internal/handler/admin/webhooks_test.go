@@ -0,0 +1,117 @@
+package admin
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/webhook"
+	"github.com/osse101/BrandishBot_Go/mocks"
+)
+
+func TestWebhookHandler_HandleRegister(t *testing.T) {
+	tests := []struct {
+		name           string
+		body           string
+		setup          func(*mocks.MockWebhookService)
+		expectedStatus int
+	}{
+		{
+			name: "registers a webhook",
+			body: `{"url":"https://example.com/hook","event_types":["job_level_up"],"created_by":"admin"}`,
+			setup: func(m *mocks.MockWebhookService) {
+				m.On("Register", mock.Anything, webhook.RegisterRequest{URL: "https://example.com/hook", EventTypes: []string{"job_level_up"}, CreatedBy: "admin"}).
+					Return(&webhook.RegisteredWebhook{Webhook: domain.Webhook{ID: 1}, Secret: "abc"}, nil)
+			},
+			expectedStatus: http.StatusCreated,
+		},
+		{
+			name:           "missing event types",
+			body:           `{"url":"https://example.com/hook","created_by":"admin"}`,
+			setup:          func(m *mocks.MockWebhookService) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name: "unsupported event type",
+			body: `{"url":"https://example.com/hook","event_types":["nope"],"created_by":"admin"}`,
+			setup: func(m *mocks.MockWebhookService) {
+				m.On("Register", mock.Anything, mock.Anything).Return(nil, domain.ErrInvalidInput)
+			},
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := mocks.NewMockWebhookService(t)
+			tt.setup(svc)
+			h := NewWebhookHandler(svc)
+
+			req := httptest.NewRequest(http.MethodPost, "/api/v1/admin/webhooks", bytes.NewBufferString(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			rec := httptest.NewRecorder()
+			h.HandleRegister(rec, req)
+
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+		})
+	}
+}
+
+func webhookRequest(method, target, id string) *http.Request {
+	rctx := chi.NewRouteContext()
+	rctx.URLParams.Add("id", id)
+	req := httptest.NewRequest(method, target, nil)
+	return req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, rctx))
+}
+
+func TestWebhookHandler_HandleDelete(t *testing.T) {
+	t.Run("deletes the webhook", func(t *testing.T) {
+		svc := mocks.NewMockWebhookService(t)
+		svc.On("DeleteWebhook", mock.Anything, int64(2)).Return(nil)
+
+		rec := httptest.NewRecorder()
+		NewWebhookHandler(svc).HandleDelete(rec, webhookRequest(http.MethodDelete, "/api/v1/admin/webhooks/2", "2"))
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+	})
+
+	t.Run("unknown webhook", func(t *testing.T) {
+		svc := mocks.NewMockWebhookService(t)
+		svc.On("DeleteWebhook", mock.Anything, int64(2)).Return(domain.ErrWebhookNotFound)
+
+		rec := httptest.NewRecorder()
+		NewWebhookHandler(svc).HandleDelete(rec, webhookRequest(http.MethodDelete, "/api/v1/admin/webhooks/2", "2"))
+
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+	})
+}
+
+func TestWebhookHandler_HandleGetDeliveries(t *testing.T) {
+	t.Run("returns the history", func(t *testing.T) {
+		svc := mocks.NewMockWebhookService(t)
+		svc.On("GetDeliveries", mock.Anything, int64(3), 10).
+			Return([]domain.WebhookDelivery{{ID: 8, Status: domain.WebhookDeliveryFailed}}, nil)
+
+		rec := httptest.NewRecorder()
+		NewWebhookHandler(svc).HandleGetDeliveries(rec, webhookRequest(http.MethodGet, "/api/v1/admin/webhooks/3/deliveries?limit=10", "3"))
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Contains(t, rec.Body.String(), `"status":"failed"`)
+	})
+
+	t.Run("rejects an invalid limit", func(t *testing.T) {
+		svc := mocks.NewMockWebhookService(t)
+
+		rec := httptest.NewRecorder()
+		NewWebhookHandler(svc).HandleGetDeliveries(rec, webhookRequest(http.MethodGet, "/api/v1/admin/webhooks/3/deliveries?limit=0", "3"))
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+}
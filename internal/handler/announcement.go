@@ -0,0 +1,43 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/osse101/BrandishBot_Go/internal/announce"
+	"github.com/osse101/BrandishBot_Go/internal/logger"
+)
+
+// DiscordAnnouncementEventsResponse lists the events announced by routes to
+// Discord channels
+type DiscordAnnouncementEventsResponse struct {
+	EventTypes []string `json:"event_types"`
+}
+
+// AnnouncementHandler serves announcement routing details to clients
+type AnnouncementHandler struct {
+	service announce.Service
+}
+
+// NewAnnouncementHandler creates a new announcement handler
+func NewAnnouncementHandler(service announce.Service) *AnnouncementHandler {
+	return &AnnouncementHandler{service: service}
+}
+
+// HandleGetDiscordEvents lists the event types with an enabled Discord route
+// @Summary List events routed to Discord
+// @Description The SSE event types that announcement routes post to Discord channels. The Discord bot skips its built-in announcement for these, and reloads the list on announcement.routes_changed events.
+// @Tags announcements
+// @Produce json
+// @Success 200 {object} DiscordAnnouncementEventsResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /api/v1/announcements/discord-events [get]
+func (h *AnnouncementHandler) HandleGetDiscordEvents(w http.ResponseWriter, r *http.Request) {
+	eventTypes, err := h.service.DiscordEventTypes(r.Context())
+	if err != nil {
+		logger.FromContext(r.Context()).Error(ErrMsgGetAnnouncementEventsFailed, "error", err)
+		RespondError(w, http.StatusInternalServerError, ErrMsgGetAnnouncementEventsFailed)
+		return
+	}
+
+	RespondJSON(w, http.StatusOK, DiscordAnnouncementEventsResponse{EventTypes: eventTypes})
+}
@@ -0,0 +1,40 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/osse101/BrandishBot_Go/mocks"
+)
+
+func TestAnnouncementHandler_HandleGetDiscordEvents(t *testing.T) {
+	get := func(h *AnnouncementHandler) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		h.HandleGetDiscordEvents(rec, httptest.NewRequest(http.MethodGet, "/announcements/discord-events", nil))
+		return rec
+	}
+
+	t.Run("lists routed events", func(t *testing.T) {
+		svc := mocks.NewMockAnnounceService(t)
+		svc.On("DiscordEventTypes", mock.Anything).Return([]string{"stream.recap"}, nil)
+
+		rec := get(NewAnnouncementHandler(svc))
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.JSONEq(t, `{"event_types":["stream.recap"]}`, rec.Body.String())
+	})
+
+	t.Run("service error", func(t *testing.T) {
+		svc := mocks.NewMockAnnounceService(t)
+		svc.On("DiscordEventTypes", mock.Anything).Return(nil, errors.New("db down"))
+
+		rec := get(NewAnnouncementHandler(svc))
+
+		assert.Equal(t, http.StatusInternalServerError, rec.Code)
+	})
+}
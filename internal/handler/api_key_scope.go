@@ -0,0 +1,46 @@
+package handler
+
+import "context"
+
+// APIKeyScope describes a caller authenticated with a scoped API key instead
+// of the main key. Scoped keys are issued to external systems and bound to
+// one contribution source.
+type APIKeyScope struct {
+	Source    string // Contribution source tag the key may write to
+	HourlyCap int    // Most the source may contribute per rolling hour; 0 means uncapped
+}
+
+type apiKeyScopeKey struct{}
+
+// WithAPIKeyScope marks the request context as authenticated by a scoped key
+func WithAPIKeyScope(ctx context.Context, scope APIKeyScope) context.Context {
+	return context.WithValue(ctx, apiKeyScopeKey{}, scope)
+}
+
+// APIKeyScopeFromContext returns the scope of a scoped-key caller. ok is false
+// for callers using the main API key.
+func APIKeyScopeFromContext(ctx context.Context) (scope APIKeyScope, ok bool) {
+	scope, ok = ctx.Value(apiKeyScopeKey{}).(APIKeyScope)
+	return scope, ok
+}
+
+// APITokenScope describes a caller authenticated with a guild API token.
+// Tokens are read-only and limited to community-wide endpoints.
+type APITokenScope struct {
+	TokenID int64
+	GuildID string
+}
+
+type apiTokenScopeKey struct{}
+
+// WithAPITokenScope marks the request context as authenticated by a guild API token
+func WithAPITokenScope(ctx context.Context, scope APITokenScope) context.Context {
+	return context.WithValue(ctx, apiTokenScopeKey{}, scope)
+}
+
+// APITokenScopeFromContext returns the scope of a guild API token caller. ok
+// is false for callers using an API key.
+func APITokenScopeFromContext(ctx context.Context) (scope APITokenScope, ok bool) {
+	scope, ok = ctx.Value(apiTokenScopeKey{}).(APITokenScope)
+	return scope, ok
+}
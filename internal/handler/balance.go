@@ -0,0 +1,51 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/osse101/BrandishBot_Go/internal/balance"
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/logger"
+)
+
+// BalanceChangelogResponse lists item balance changes, newest first
+type BalanceChangelogResponse struct {
+	Changes []domain.ItemBalanceChange `json:"changes"`
+}
+
+// BalanceHandler serves the public item balance changelog
+type BalanceHandler struct {
+	service balance.Service
+}
+
+// NewBalanceHandler creates a new balance changelog handler
+func NewBalanceHandler(service balance.Service) *BalanceHandler {
+	return &BalanceHandler{service: service}
+}
+
+// HandleGetChangelog lists past, current and upcoming balance changes to an
+// item, or to every item when none is named
+// GET /api/v1/items/balance-changes?item=sword&limit=N
+func (h *BalanceHandler) HandleGetChangelog(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	limit := balance.DefaultChangelogLimit
+	if limitStr := query.Get("limit"); limitStr != "" {
+		parsed, err := strconv.Atoi(limitStr)
+		if err != nil || parsed < 1 || parsed > balance.MaxChangelogLimit {
+			RespondError(w, http.StatusBadRequest, ErrMsgInvalidLimit)
+			return
+		}
+		limit = parsed
+	}
+
+	changes, err := h.service.GetChangelog(r.Context(), query.Get("item"), limit)
+	if err != nil {
+		logger.FromContext(r.Context()).Error("Failed to get balance changelog", "error", err)
+		RespondError(w, http.StatusInternalServerError, "Failed to retrieve balance changes")
+		return
+	}
+
+	RespondJSON(w, http.StatusOK, BalanceChangelogResponse{Changes: changes})
+}
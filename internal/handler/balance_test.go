@@ -0,0 +1,48 @@
+package handler
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/osse101/BrandishBot_Go/internal/balance"
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/mocks"
+)
+
+func TestBalanceHandler_HandleGetChangelog(t *testing.T) {
+	get := func(h *BalanceHandler, query string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, "/items/balance-changes"+query, nil)
+		rec := httptest.NewRecorder()
+		h.HandleGetChangelog(rec, req)
+		return rec
+	}
+
+	t.Run("lists an item's changes", func(t *testing.T) {
+		svc := mocks.NewMockBalanceService(t)
+		svc.On("GetChangelog", mock.Anything, "sword", 10).Return([]domain.ItemBalanceChange{
+			{ID: 4, ItemName: "sword", Field: domain.BalanceFieldBaseValue, Value: 120, Status: domain.BalanceChangeActive},
+		}, nil)
+
+		rec := get(NewBalanceHandler(svc), "?item=sword&limit=10")
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Contains(t, rec.Body.String(), `"status":"active"`)
+	})
+
+	t.Run("defaults the limit", func(t *testing.T) {
+		svc := mocks.NewMockBalanceService(t)
+		svc.On("GetChangelog", mock.Anything, "", balance.DefaultChangelogLimit).Return([]domain.ItemBalanceChange{}, nil)
+
+		assert.Equal(t, http.StatusOK, get(NewBalanceHandler(svc), "").Code)
+	})
+
+	t.Run("rejects limits over the maximum", func(t *testing.T) {
+		svc := mocks.NewMockBalanceService(t)
+
+		assert.Equal(t, http.StatusBadRequest, get(NewBalanceHandler(svc), "?limit=1000").Code)
+	})
+}
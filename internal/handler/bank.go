@@ -0,0 +1,184 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/osse101/BrandishBot_Go/internal/bank"
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/logger"
+)
+
+// BankTransferRequest asks to move money into or out of the user's bank
+// account
+type BankTransferRequest struct {
+	Platform   string `json:"platform" validate:"required,platform"`
+	PlatformID string `json:"platform_id" validate:"required"`
+	Username   string `json:"username" validate:"required,max=100,excludesall=\x00\n\r\t"`
+	Amount     int64  `json:"amount" validate:"required,min=1,max=10000"`
+}
+
+// BankGoalRequest sets or clears the user's savings goal
+type BankGoalRequest struct {
+	Platform   string `json:"platform" validate:"required,platform"`
+	PlatformID string `json:"platform_id" validate:"required"`
+	Username   string `json:"username" validate:"required,max=100,excludesall=\x00\n\r\t"`
+	// Goal is the balance to save toward; 0 clears the goal
+	Goal int64 `json:"goal" validate:"min=0"`
+}
+
+// BankHandler handles users' bank accounts
+type BankHandler struct {
+	service bank.Service
+}
+
+// NewBankHandler creates a new bank handler
+func NewBankHandler(service bank.Service) *BankHandler {
+	return &BankHandler{service: service}
+}
+
+// HandleGetBank returns the user's bank account
+// @Summary Get bank account
+// @Description The user's balance, savings goal, interest earned and what they have withdrawn today, with the bank's interest rate and daily withdrawal limit.
+// @Tags user
+// @Produce json
+// @Param platform query string true "Platform"
+// @Param platform_id query string true "Platform user ID"
+// @Success 200 {object} domain.BankAccount
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /api/v1/user/bank [get]
+func (h *BankHandler) HandleGetBank(w http.ResponseWriter, r *http.Request) {
+	platform, platformID, ok := platformUserParams(r, w)
+	if !ok {
+		return
+	}
+
+	account, err := h.service.GetAccount(r.Context(), platform, platformID)
+	if err != nil {
+		if errors.Is(err, domain.ErrUserNotFound) {
+			RespondError(w, http.StatusNotFound, ErrMsgUserNotFoundHTTP)
+			return
+		}
+		logger.FromContext(r.Context()).Error("Failed to get bank account", "error", err, "platform", platform)
+		RespondError(w, http.StatusInternalServerError, ErrMsgGetBankFailed)
+		return
+	}
+
+	RespondJSON(w, http.StatusOK, account)
+}
+
+// HandleDeposit moves money from the user's inventory into the bank
+// @Summary Deposit money
+// @Description Moves money from the user's inventory into their bank account, where it earns interest. A deposit that reaches the savings goal pays the goal bonus.
+// @Tags user
+// @Accept json
+// @Produce json
+// @Param request body BankTransferRequest true "Amount to deposit"
+// @Success 200 {object} domain.BankTransaction
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /api/v1/user/bank/deposit [post]
+func (h *BankHandler) HandleDeposit(w http.ResponseWriter, r *http.Request) {
+	var req BankTransferRequest
+	if err := DecodeAndValidateRequest(r, w, &req, "Deposit"); err != nil {
+		return
+	}
+
+	result, err := h.service.Deposit(r.Context(), req.Platform, req.PlatformID, req.Username, req.Amount)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrInsufficientFunds),
+			errors.Is(err, domain.ErrInvalidInput):
+			RespondError(w, http.StatusBadRequest, err.Error())
+		case errors.Is(err, domain.ErrFeatureLocked),
+			errors.Is(err, domain.ErrUserNotFound):
+			RespondMappedError(w, err)
+		default:
+			logger.FromContext(r.Context()).Error("Failed to deposit", "error", err, "platform", req.Platform)
+			RespondError(w, http.StatusInternalServerError, ErrMsgDepositFailed)
+		}
+		return
+	}
+
+	RespondJSON(w, http.StatusOK, result)
+}
+
+// HandleWithdraw moves money from the bank back into the user's inventory
+// @Summary Withdraw money
+// @Description Moves money from the user's bank account back into their inventory, up to the daily withdrawal limit.
+// @Tags user
+// @Accept json
+// @Produce json
+// @Param request body BankTransferRequest true "Amount to withdraw"
+// @Success 200 {object} domain.BankTransaction
+// @Failure 400 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse "Daily withdrawal limit reached"
+// @Failure 500 {object} ErrorResponse
+// @Router /api/v1/user/bank/withdraw [post]
+func (h *BankHandler) HandleWithdraw(w http.ResponseWriter, r *http.Request) {
+	var req BankTransferRequest
+	if err := DecodeAndValidateRequest(r, w, &req, "Withdraw"); err != nil {
+		return
+	}
+
+	result, err := h.service.Withdraw(r.Context(), req.Platform, req.PlatformID, req.Username, req.Amount)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrBankWithdrawLimit):
+			RespondError(w, http.StatusConflict, err.Error())
+		case errors.Is(err, domain.ErrInsufficientFunds),
+			errors.Is(err, domain.ErrInvalidInput):
+			RespondError(w, http.StatusBadRequest, err.Error())
+		case errors.Is(err, domain.ErrUserNotFound):
+			RespondMappedError(w, err)
+		default:
+			logger.FromContext(r.Context()).Error("Failed to withdraw", "error", err, "platform", req.Platform)
+			RespondError(w, http.StatusInternalServerError, ErrMsgWithdrawFailed)
+		}
+		return
+	}
+
+	RespondJSON(w, http.StatusOK, result)
+}
+
+// HandleSetBankGoal sets or clears the user's savings goal
+// @Summary Set savings goal
+// @Description Sets a savings goal above the user's balance, or clears it with a goal of 0. Reaching the goal pays a bonus into the account. A new goal can only be set a day after the last.
+// @Tags user
+// @Accept json
+// @Produce json
+// @Param request body BankGoalRequest true "Savings goal"
+// @Success 200 {object} domain.BankAccount
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse "A goal was set too recently"
+// @Failure 500 {object} ErrorResponse
+// @Router /api/v1/user/bank/goal [put]
+func (h *BankHandler) HandleSetBankGoal(w http.ResponseWriter, r *http.Request) {
+	var req BankGoalRequest
+	if err := DecodeAndValidateRequest(r, w, &req, "Set savings goal"); err != nil {
+		return
+	}
+
+	account, err := h.service.SetGoal(r.Context(), req.Platform, req.PlatformID, req.Username, req.Goal)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrBankGoalCooldown):
+			RespondError(w, http.StatusConflict, err.Error())
+		case errors.Is(err, domain.ErrInvalidInput):
+			RespondError(w, http.StatusBadRequest, err.Error())
+		case errors.Is(err, domain.ErrFeatureLocked),
+			errors.Is(err, domain.ErrUserNotFound):
+			RespondMappedError(w, err)
+		default:
+			logger.FromContext(r.Context()).Error("Failed to set savings goal", "error", err, "platform", req.Platform)
+			RespondError(w, http.StatusInternalServerError, ErrMsgSetBankGoalFailed)
+		}
+		return
+	}
+
+	RespondJSON(w, http.StatusOK, account)
+}
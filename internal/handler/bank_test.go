@@ -0,0 +1,148 @@
+package handler
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/mocks"
+)
+
+func TestBankHandler_HandleGetBank(t *testing.T) {
+	get := func(h *BankHandler, query string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, "/user/bank"+query, nil)
+		rec := httptest.NewRecorder()
+		h.HandleGetBank(rec, req)
+		return rec
+	}
+
+	t.Run("returns the account", func(t *testing.T) {
+		svc := mocks.NewMockBankService(t)
+		svc.On("GetAccount", mock.Anything, "discord", "d-1").Return(&domain.BankAccount{UserID: "u-1", Balance: 250, WithdrawLimit: 5000}, nil)
+
+		rec := get(NewBankHandler(svc), "?platform=discord&platform_id=d-1")
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Contains(t, rec.Body.String(), `"balance":250`)
+		assert.Contains(t, rec.Body.String(), `"withdraw_limit":5000`)
+	})
+
+	t.Run("unknown user is not found", func(t *testing.T) {
+		svc := mocks.NewMockBankService(t)
+		svc.On("GetAccount", mock.Anything, "discord", "d-1").Return(nil, domain.ErrUserNotFound)
+
+		rec := get(NewBankHandler(svc), "?platform=discord&platform_id=d-1")
+
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+	})
+}
+
+func TestBankHandler_HandleDeposit(t *testing.T) {
+	post := func(h *BankHandler, body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/user/bank/deposit", bytes.NewBufferString(body))
+		rec := httptest.NewRecorder()
+		h.HandleDeposit(rec, req)
+		return rec
+	}
+
+	t.Run("deposits", func(t *testing.T) {
+		svc := mocks.NewMockBankService(t)
+		svc.On("Deposit", mock.Anything, "discord", "d-1", "alice", int64(100)).Return(&domain.BankTransaction{
+			Account:   domain.BankAccount{Balance: 1050},
+			Amount:    100,
+			GoalBonus: 50,
+		}, nil)
+
+		rec := post(NewBankHandler(svc), `{"platform":"discord","platform_id":"d-1","username":"alice","amount":100}`)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Contains(t, rec.Body.String(), `"goal_bonus":50`)
+	})
+
+	t.Run("not enough money is a bad request", func(t *testing.T) {
+		svc := mocks.NewMockBankService(t)
+		svc.On("Deposit", mock.Anything, mock.Anything, mock.Anything, mock.Anything, int64(100)).Return(nil, fmt.Errorf("%w: have less than 100", domain.ErrInsufficientFunds))
+
+		rec := post(NewBankHandler(svc), `{"platform":"discord","platform_id":"d-1","username":"alice","amount":100}`)
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("locked bank is forbidden", func(t *testing.T) {
+		svc := mocks.NewMockBankService(t)
+		svc.On("Deposit", mock.Anything, mock.Anything, mock.Anything, mock.Anything, int64(100)).Return(nil, domain.ErrFeatureLocked)
+
+		rec := post(NewBankHandler(svc), `{"platform":"discord","platform_id":"d-1","username":"alice","amount":100}`)
+
+		assert.Equal(t, http.StatusForbidden, rec.Code)
+	})
+
+	t.Run("amount is required", func(t *testing.T) {
+		rec := post(NewBankHandler(mocks.NewMockBankService(t)), `{"platform":"discord","platform_id":"d-1","username":"alice"}`)
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+}
+
+func TestBankHandler_HandleWithdraw(t *testing.T) {
+	post := func(h *BankHandler, body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/user/bank/withdraw", bytes.NewBufferString(body))
+		rec := httptest.NewRecorder()
+		h.HandleWithdraw(rec, req)
+		return rec
+	}
+
+	t.Run("withdraws", func(t *testing.T) {
+		svc := mocks.NewMockBankService(t)
+		svc.On("Withdraw", mock.Anything, "discord", "d-1", "alice", int64(300)).Return(&domain.BankTransaction{Account: domain.BankAccount{Balance: 700}, Amount: 300}, nil)
+
+		rec := post(NewBankHandler(svc), `{"platform":"discord","platform_id":"d-1","username":"alice","amount":300}`)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Contains(t, rec.Body.String(), `"balance":700`)
+	})
+
+	t.Run("over the daily limit is a conflict", func(t *testing.T) {
+		svc := mocks.NewMockBankService(t)
+		svc.On("Withdraw", mock.Anything, mock.Anything, mock.Anything, mock.Anything, int64(300)).Return(nil, fmt.Errorf("%w: 100 more can be withdrawn today", domain.ErrBankWithdrawLimit))
+
+		rec := post(NewBankHandler(svc), `{"platform":"discord","platform_id":"d-1","username":"alice","amount":300}`)
+
+		assert.Equal(t, http.StatusConflict, rec.Code)
+		assert.Contains(t, rec.Body.String(), "100 more can be withdrawn today")
+	})
+}
+
+func TestBankHandler_HandleSetBankGoal(t *testing.T) {
+	put := func(h *BankHandler, body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPut, "/user/bank/goal", bytes.NewBufferString(body))
+		rec := httptest.NewRecorder()
+		h.HandleSetBankGoal(rec, req)
+		return rec
+	}
+
+	t.Run("sets the goal", func(t *testing.T) {
+		svc := mocks.NewMockBankService(t)
+		svc.On("SetGoal", mock.Anything, "discord", "d-1", "alice", int64(5000)).Return(&domain.BankAccount{Balance: 100, Goal: 5000}, nil)
+
+		rec := put(NewBankHandler(svc), `{"platform":"discord","platform_id":"d-1","username":"alice","goal":5000}`)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Contains(t, rec.Body.String(), `"goal":5000`)
+	})
+
+	t.Run("too soon after the last goal is a conflict", func(t *testing.T) {
+		svc := mocks.NewMockBankService(t)
+		svc.On("SetGoal", mock.Anything, mock.Anything, mock.Anything, mock.Anything, int64(5000)).Return(nil, domain.ErrBankGoalCooldown)
+
+		rec := put(NewBankHandler(svc), `{"platform":"discord","platform_id":"d-1","username":"alice","goal":5000}`)
+
+		assert.Equal(t, http.StatusConflict, rec.Code)
+	})
+}
@@ -0,0 +1,88 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/osse101/BrandishBot_Go/internal/boss"
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/logger"
+)
+
+// BossAttackRequest asks to attack the community boss
+type BossAttackRequest struct {
+	Platform   string `json:"platform" validate:"required,platform"`
+	PlatformID string `json:"platform_id" validate:"required"`
+	Username   string `json:"username" validate:"required,max=100,excludesall=\x00\n\r\t"`
+	Weapon     string `json:"weapon,omitempty" validate:"max=100"`
+}
+
+// BossHandler handles community boss raids
+type BossHandler struct {
+	service boss.Service
+}
+
+// NewBossHandler creates a new boss handler
+func NewBossHandler(service boss.Service) *BossHandler {
+	return &BossHandler{service: service}
+}
+
+// HandleAttack attacks the community boss
+// @Summary Attack the boss
+// @Description Hits the community's active boss, on the per-user COOLDOWN_BOSS_ATTACK cooldown. A weapon (grenade, missile, bomb, tnt or hugemissile) is used up for bonus damage. The attack that brings the boss to 0 HP shares its reward lootboxes out among every attacker by damage dealt, and the response then lists each share and what it dropped.
+// @Tags boss
+// @Accept json
+// @Produce json
+// @Param request body BossAttackRequest true "Attacker"
+// @Success 200 {object} domain.BossAttackResult
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse "No boss to fight"
+// @Failure 429 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /api/v1/boss/attack [post]
+func (h *BossHandler) HandleAttack(w http.ResponseWriter, r *http.Request) {
+	var req BossAttackRequest
+	if err := DecodeAndValidateRequest(r, w, &req, "Boss attack"); err != nil {
+		return
+	}
+
+	result, err := h.service.Attack(r.Context(), req.Platform, req.PlatformID, req.Username, req.Weapon)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrNoActiveBoss):
+			RespondError(w, http.StatusNotFound, domain.ErrMsgNoActiveBoss)
+		case errors.Is(err, domain.ErrUnknownWeapon):
+			RespondError(w, http.StatusBadRequest, err.Error())
+		case errors.Is(err, domain.ErrOnCooldown),
+			errors.Is(err, domain.ErrNotInInventory),
+			errors.Is(err, domain.ErrInsufficientQuantity),
+			errors.Is(err, domain.ErrUserNotFound):
+			RespondMappedError(w, err)
+		default:
+			logger.FromContext(r.Context()).Error("Failed to attack boss", "error", err, "platform", req.Platform)
+			RespondError(w, http.StatusInternalServerError, ErrMsgBossAttackFailed)
+		}
+		return
+	}
+
+	RespondJSON(w, http.StatusOK, result)
+}
+
+// HandleGetStatus reports the community boss and its top attackers
+// @Summary Get boss status
+// @Description The community's active boss with its remaining HP, reward and expiry, and the users who have dealt it the most damage. boss is left out when there is no boss to fight.
+// @Tags boss
+// @Produce json
+// @Success 200 {object} domain.BossStatus
+// @Failure 500 {object} ErrorResponse
+// @Router /api/v1/boss [get]
+func (h *BossHandler) HandleGetStatus(w http.ResponseWriter, r *http.Request) {
+	status, err := h.service.GetStatus(r.Context())
+	if err != nil {
+		logger.FromContext(r.Context()).Error("Failed to get boss status", "error", err)
+		RespondError(w, http.StatusInternalServerError, ErrMsgGetBossFailed)
+		return
+	}
+
+	RespondJSON(w, http.StatusOK, status)
+}
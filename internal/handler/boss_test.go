@@ -0,0 +1,88 @@
+package handler
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/mocks"
+)
+
+func TestBossHandler_HandleAttack(t *testing.T) {
+	post := func(h *BossHandler, body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/boss/attack", bytes.NewBufferString(body))
+		rec := httptest.NewRecorder()
+		h.HandleAttack(rec, req)
+		return rec
+	}
+
+	t.Run("attacks", func(t *testing.T) {
+		svc := mocks.NewMockBossService(t)
+		svc.On("Attack", mock.Anything, "discord", "d-1", "alice", "tnt").Return(&domain.BossAttackResult{Damage: 262, HP: 238, MaxHP: 500}, nil)
+
+		rec := post(NewBossHandler(svc), `{"platform":"discord","platform_id":"d-1","username":"alice","weapon":"tnt"}`)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Contains(t, rec.Body.String(), `"hp":238`)
+	})
+
+	t.Run("no boss is not found", func(t *testing.T) {
+		svc := mocks.NewMockBossService(t)
+		svc.On("Attack", mock.Anything, mock.Anything, mock.Anything, mock.Anything, "").Return(nil, domain.ErrNoActiveBoss)
+
+		rec := post(NewBossHandler(svc), `{"platform":"discord","platform_id":"d-1","username":"alice"}`)
+
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+	})
+
+	t.Run("unknown weapon is a bad request", func(t *testing.T) {
+		svc := mocks.NewMockBossService(t)
+		svc.On("Attack", mock.Anything, mock.Anything, mock.Anything, mock.Anything, "spoon").Return(nil, fmt.Errorf("%w: spoon", domain.ErrUnknownWeapon))
+
+		rec := post(NewBossHandler(svc), `{"platform":"discord","platform_id":"d-1","username":"alice","weapon":"spoon"}`)
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("cooldown is too many requests", func(t *testing.T) {
+		svc := mocks.NewMockBossService(t)
+		svc.On("Attack", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil, fmt.Errorf("%w: boss_attack", domain.ErrOnCooldown))
+
+		rec := post(NewBossHandler(svc), `{"platform":"discord","platform_id":"d-1","username":"alice"}`)
+
+		assert.Equal(t, http.StatusTooManyRequests, rec.Code)
+	})
+}
+
+func TestBossHandler_HandleGetStatus(t *testing.T) {
+	t.Run("reports the boss", func(t *testing.T) {
+		svc := mocks.NewMockBossService(t)
+		svc.On("GetStatus", mock.Anything).Return(&domain.BossStatus{
+			Boss:         &domain.Boss{Name: "Kraken", HP: 1200, MaxHP: 3000},
+			TopAttackers: []domain.BossAttacker{{Username: "alice", Damage: 1800}},
+		}, nil)
+
+		rec := httptest.NewRecorder()
+		NewBossHandler(svc).HandleGetStatus(rec, httptest.NewRequest(http.MethodGet, "/boss", nil))
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Contains(t, rec.Body.String(), `"name":"Kraken"`)
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		svc := mocks.NewMockBossService(t)
+		svc.On("GetStatus", mock.Anything).Return(nil, errors.New("db down"))
+
+		rec := httptest.NewRecorder()
+		NewBossHandler(svc).HandleGetStatus(rec, httptest.NewRequest(http.MethodGet, "/boss", nil))
+
+		assert.Equal(t, http.StatusInternalServerError, rec.Code)
+	})
+}
@@ -0,0 +1,139 @@
+package handler
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/economy"
+	"github.com/osse101/BrandishBot_Go/internal/gamble"
+	"github.com/osse101/BrandishBot_Go/internal/logger"
+	"github.com/osse101/BrandishBot_Go/internal/progression"
+)
+
+// BotBootstrapFeatures lists the progression feature keys reported in the
+// bootstrap flags so the bot can hide locked commands without per-feature calls
+var BotBootstrapFeatures = []string{
+	progression.FeatureCompost,
+	progression.FeatureDisassemble,
+	progression.FeatureDuel,
+	progression.FeatureEconomy,
+	progression.FeatureEvents,
+	progression.FeatureExpedition,
+	progression.FeatureFarming,
+	progression.FeatureGamble,
+	progression.FeatureSearch,
+	progression.FeatureSlots,
+	progression.FeatureUpgrade,
+	progression.FeatureWeeklyDiscount,
+	progression.FeatureWeeklyQuests,
+}
+
+// Bootstrap section names reported in BotBootstrapResponse.Errors
+const (
+	BotSectionActiveGamble   = "active_gamble"
+	BotSectionVotingSession  = "voting_session"
+	BotSectionUnlockProgress = "unlock_progress"
+	BotSectionFeaturedShop   = "featured_shop"
+	BotSectionWeeklySale     = "weekly_sale"
+	BotSectionFlags          = "flags"
+)
+
+// BotHandler serves aggregate endpoints used by the Discord bot
+type BotHandler struct {
+	gambleSvc      gamble.Service
+	progressionSvc progression.Service
+	economySvc     economy.Service
+}
+
+// NewBotHandler creates a new bot handler
+func NewBotHandler(gambleSvc gamble.Service, progressionSvc progression.Service, economySvc economy.Service) *BotHandler {
+	return &BotHandler{
+		gambleSvc:      gambleSvc,
+		progressionSvc: progressionSvc,
+		economySvc:     economySvc,
+	}
+}
+
+// BotFeaturedShop is the shop section of the bootstrap response
+type BotFeaturedShop struct {
+	Items      []domain.Item      `json:"items"`
+	WeeklySale *domain.WeeklySale `json:"weekly_sale,omitempty"`
+}
+
+// BotBootstrapResponse bundles the state the bot needs to warm its caches
+type BotBootstrapResponse struct {
+	ActiveGamble   *domain.Gamble                   `json:"active_gamble"`
+	VotingSession  *domain.ProgressionVotingSession `json:"voting_session"`
+	UnlockProgress *domain.UnlockProgress           `json:"unlock_progress"`
+	FeaturedShop   BotFeaturedShop                  `json:"featured_shop"`
+	Flags          map[string]bool                  `json:"flags"`
+	Errors         []string                         `json:"errors,omitempty"`
+	GeneratedAt    time.Time                        `json:"generated_at"`
+}
+
+// HandleBootstrap returns the combined bot cold-start state
+// @Summary Bot bootstrap state
+// @Description Returns active gamble, voting session, unlock progress, featured shop, and feature flags in one response. Sections that fail to load are left empty and named in errors.
+// @Tags bot
+// @Produce json
+// @Success 200 {object} BotBootstrapResponse
+// @Router /api/v1/bot/bootstrap [get]
+func (h *BotHandler) HandleBootstrap(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logger.FromContext(ctx)
+
+	resp := BotBootstrapResponse{
+		FeaturedShop: BotFeaturedShop{Items: []domain.Item{}},
+		Flags:        make(map[string]bool, len(BotBootstrapFeatures)),
+		GeneratedAt:  time.Now(),
+	}
+
+	// A failing section must not prevent the bot from warming the rest,
+	// so each lookup records its failure and moves on.
+	fail := func(section string, err error) {
+		log.Warn("Bot bootstrap section failed", "section", section, "error", err)
+		resp.Errors = append(resp.Errors, section)
+	}
+
+	if g, err := h.gambleSvc.GetActiveGamble(ctx); err != nil {
+		fail(BotSectionActiveGamble, err)
+	} else {
+		resp.ActiveGamble = g
+	}
+
+	if session, err := h.progressionSvc.GetActiveVotingSession(ctx); err != nil {
+		fail(BotSectionVotingSession, err)
+	} else {
+		resp.VotingSession = session
+	}
+
+	if progress, err := h.progressionSvc.GetUnlockProgress(ctx); err != nil {
+		fail(BotSectionUnlockProgress, err)
+	} else {
+		resp.UnlockProgress = progress
+	}
+
+	if items, err := h.economySvc.GetBuyablePrices(ctx); err != nil {
+		fail(BotSectionFeaturedShop, err)
+	} else if items != nil {
+		resp.FeaturedShop.Items = items
+	}
+
+	if sale, err := h.economySvc.GetCurrentWeeklySale(ctx); err != nil {
+		fail(BotSectionWeeklySale, err)
+	} else {
+		resp.FeaturedShop.WeeklySale = sale
+	}
+
+	for _, feature := range BotBootstrapFeatures {
+		unlocked, err := h.progressionSvc.IsFeatureUnlocked(ctx, feature)
+		if err != nil {
+			fail(BotSectionFlags, err)
+			break
+		}
+		resp.Flags[feature] = unlocked
+	}
+
+	RespondJSON(w, http.StatusOK, resp)
+}
@@ -0,0 +1,78 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/mocks"
+)
+
+func TestHandleBotBootstrap(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Success - All Sections", func(t *testing.T) {
+		t.Parallel()
+		mockGamble := mocks.NewMockGambleService(t)
+		mockProg := mocks.NewMockProgressionService(t)
+		mockEco := mocks.NewMockEconomyService(t)
+
+		gambleID := uuid.New()
+		mockGamble.On("GetActiveGamble", mock.Anything).Return(&domain.Gamble{ID: gambleID, State: domain.GambleStateJoining}, nil)
+		mockProg.On("GetActiveVotingSession", mock.Anything).Return(&domain.ProgressionVotingSession{ID: 7}, nil)
+		mockProg.On("GetUnlockProgress", mock.Anything).Return(&domain.UnlockProgress{ID: 3}, nil)
+		mockProg.On("IsFeatureUnlocked", mock.Anything, mock.Anything).Return(true, nil)
+		mockEco.On("GetBuyablePrices", mock.Anything).Return([]domain.Item{{InternalName: "lootbox_tier1"}}, nil)
+		mockEco.On("GetCurrentWeeklySale", mock.Anything).Return(&domain.WeeklySale{DiscountPercent: 10}, nil)
+
+		h := NewBotHandler(mockGamble, mockProg, mockEco)
+		rec := httptest.NewRecorder()
+		h.HandleBootstrap(rec, httptest.NewRequest(http.MethodGet, "/api/v1/bot/bootstrap", nil))
+
+		require.Equal(t, http.StatusOK, rec.Code)
+		var resp BotBootstrapResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+		require.NotNil(t, resp.ActiveGamble)
+		assert.Equal(t, gambleID, resp.ActiveGamble.ID)
+		require.NotNil(t, resp.VotingSession)
+		assert.Equal(t, 7, resp.VotingSession.ID)
+		require.NotNil(t, resp.UnlockProgress)
+		assert.Len(t, resp.FeaturedShop.Items, 1)
+		require.NotNil(t, resp.FeaturedShop.WeeklySale)
+		assert.Len(t, resp.Flags, len(BotBootstrapFeatures))
+		assert.Empty(t, resp.Errors)
+	})
+
+	t.Run("Partial Failure - Reports Failed Sections", func(t *testing.T) {
+		t.Parallel()
+		mockGamble := mocks.NewMockGambleService(t)
+		mockProg := mocks.NewMockProgressionService(t)
+		mockEco := mocks.NewMockEconomyService(t)
+
+		mockGamble.On("GetActiveGamble", mock.Anything).Return(nil, errors.New("db down"))
+		mockProg.On("GetActiveVotingSession", mock.Anything).Return(nil, nil)
+		mockProg.On("GetUnlockProgress", mock.Anything).Return(nil, nil)
+		mockProg.On("IsFeatureUnlocked", mock.Anything, mock.Anything).Return(false, errors.New("cache miss"))
+		mockEco.On("GetBuyablePrices", mock.Anything).Return(nil, errors.New("db down"))
+		mockEco.On("GetCurrentWeeklySale", mock.Anything).Return(nil, nil)
+
+		h := NewBotHandler(mockGamble, mockProg, mockEco)
+		rec := httptest.NewRecorder()
+		h.HandleBootstrap(rec, httptest.NewRequest(http.MethodGet, "/api/v1/bot/bootstrap", nil))
+
+		require.Equal(t, http.StatusOK, rec.Code)
+		var resp BotBootstrapResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+		assert.Nil(t, resp.ActiveGamble)
+		assert.NotNil(t, resp.FeaturedShop.Items)
+		assert.ElementsMatch(t, []string{BotSectionActiveGamble, BotSectionFeaturedShop, BotSectionFlags}, resp.Errors)
+	})
+}
@@ -55,8 +55,7 @@ func HandleBuyItem(svc economy.Service, userSvc user.ManagementService, progress
 		bought, err := svc.BuyItem(r.Context(), req.Platform, req.PlatformID, req.Username, req.ItemName, req.Quantity)
 		if err != nil {
 			log.Error("Failed to buy item", "error", err, "username", req.Username, "item", req.ItemName)
-			statusCode, userMsg := MapServiceErrorToUserMessage(err)
-			RespondError(w, statusCode, userMsg)
+			RespondMappedError(w, err)
 			return
 		}
 
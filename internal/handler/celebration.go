@@ -0,0 +1,200 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+
+	"github.com/osse101/BrandishBot_Go/internal/celebration"
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/logger"
+)
+
+// SetBirthdayRequest registers a user's birthday. Only the month and day are accepted.
+type SetBirthdayRequest struct {
+	Platform   string `json:"platform" validate:"required,platform"`
+	PlatformID string `json:"platform_id" validate:"required"`
+	Username   string `json:"username" validate:"required,max=100,excludesall=\x00\n\r\t"`
+	Month      int    `json:"month" validate:"min=1,max=12"`
+	Day        int    `json:"day" validate:"min=1,max=31"`
+}
+
+// BirthdayResponse reports a user's registered birthday
+type BirthdayResponse struct {
+	Registered bool             `json:"registered"`
+	Birthday   *domain.Birthday `json:"birthday,omitempty"`
+}
+
+// SetCelebrationGuildRequest turns celebration announcements on or off for a guild
+type SetCelebrationGuildRequest struct {
+	Enabled bool `json:"enabled"`
+}
+
+// CelebrationGuildResponse reports a guild's announcement setting
+type CelebrationGuildResponse struct {
+	GuildID string `json:"guild_id"`
+	Enabled bool   `json:"enabled"`
+}
+
+// CelebrationHandler handles birthday registration and guild announcement settings
+type CelebrationHandler struct {
+	service celebration.Service
+}
+
+// NewCelebrationHandler creates a new celebration handler
+func NewCelebrationHandler(service celebration.Service) *CelebrationHandler {
+	return &CelebrationHandler{service: service}
+}
+
+// HandleSetBirthday registers or replaces a user's birthday
+// @Summary Set birthday
+// @Description Registers the month and day of a user's birthday for the daily celebration reward. The year is never stored.
+// @Tags celebrations
+// @Accept json
+// @Produce json
+// @Param request body SetBirthdayRequest true "Birthday"
+// @Success 200 {object} SuccessResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /api/v1/celebrations/birthday [post]
+func (h *CelebrationHandler) HandleSetBirthday(w http.ResponseWriter, r *http.Request) {
+	var req SetBirthdayRequest
+	if err := DecodeAndValidateRequest(r, w, &req, "Set birthday"); err != nil {
+		return
+	}
+
+	birthday := domain.Birthday{Month: req.Month, Day: req.Day}
+	if err := h.service.SetBirthday(r.Context(), req.Platform, req.PlatformID, req.Username, birthday); err != nil {
+		if errors.Is(err, domain.ErrInvalidBirthday) {
+			RespondError(w, http.StatusBadRequest, ErrMsgInvalidBirthday)
+			return
+		}
+		logger.FromContext(r.Context()).Error("Failed to set birthday", "error", err, "platform", req.Platform)
+		RespondError(w, http.StatusInternalServerError, ErrMsgSetBirthdayFailed)
+		return
+	}
+
+	RespondJSON(w, http.StatusOK, SuccessResponse{Message: MsgBirthdaySet})
+}
+
+// HandleGetBirthday returns a user's registered birthday
+// @Summary Get birthday
+// @Tags celebrations
+// @Produce json
+// @Param platform query string true "Platform"
+// @Param platform_id query string true "Platform user ID"
+// @Success 200 {object} BirthdayResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /api/v1/celebrations/birthday [get]
+func (h *CelebrationHandler) HandleGetBirthday(w http.ResponseWriter, r *http.Request) {
+	platform, platformID, ok := platformUserParams(r, w)
+	if !ok {
+		return
+	}
+
+	birthday, err := h.service.GetBirthday(r.Context(), platform, platformID)
+	if err != nil {
+		if errors.Is(err, domain.ErrUserNotFound) {
+			RespondError(w, http.StatusNotFound, ErrMsgUserNotFoundHTTP)
+			return
+		}
+		logger.FromContext(r.Context()).Error("Failed to get birthday", "error", err, "platform", platform)
+		RespondError(w, http.StatusInternalServerError, ErrMsgGetBirthdayFailed)
+		return
+	}
+
+	RespondJSON(w, http.StatusOK, BirthdayResponse{Registered: birthday != nil, Birthday: birthday})
+}
+
+// HandleClearBirthday removes a user's birthday
+// @Summary Clear birthday
+// @Tags celebrations
+// @Produce json
+// @Param platform query string true "Platform"
+// @Param platform_id query string true "Platform user ID"
+// @Success 200 {object} SuccessResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /api/v1/celebrations/birthday [delete]
+func (h *CelebrationHandler) HandleClearBirthday(w http.ResponseWriter, r *http.Request) {
+	platform, platformID, ok := platformUserParams(r, w)
+	if !ok {
+		return
+	}
+
+	if err := h.service.ClearBirthday(r.Context(), platform, platformID); err != nil {
+		if errors.Is(err, domain.ErrUserNotFound) {
+			RespondError(w, http.StatusNotFound, ErrMsgUserNotFoundHTTP)
+			return
+		}
+		logger.FromContext(r.Context()).Error("Failed to clear birthday", "error", err, "platform", platform)
+		RespondError(w, http.StatusInternalServerError, ErrMsgClearBirthdayFailed)
+		return
+	}
+
+	RespondJSON(w, http.StatusOK, SuccessResponse{Message: MsgBirthdayCleared})
+}
+
+// HandleGetGuild reports whether a Discord guild has celebration announcements enabled
+// @Summary Get guild celebration setting
+// @Tags celebrations
+// @Produce json
+// @Param guildID path string true "Discord guild ID"
+// @Success 200 {object} CelebrationGuildResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /api/v1/celebrations/guilds/{guildID} [get]
+func (h *CelebrationHandler) HandleGetGuild(w http.ResponseWriter, r *http.Request) {
+	guildID := chi.URLParam(r, "guildID")
+
+	enabled, err := h.service.IsGuildEnabled(r.Context(), guildID)
+	if err != nil {
+		logger.FromContext(r.Context()).Error("Failed to get celebration guild", "error", err, "guild_id", guildID)
+		RespondError(w, http.StatusInternalServerError, ErrMsgCelebrationGuildFailed)
+		return
+	}
+
+	RespondJSON(w, http.StatusOK, CelebrationGuildResponse{GuildID: guildID, Enabled: enabled})
+}
+
+// HandleSetGuild turns celebration announcements on or off for a Discord guild
+// @Summary Set guild celebration setting
+// @Description Announcements are off until a guild opts in.
+// @Tags celebrations
+// @Accept json
+// @Produce json
+// @Param guildID path string true "Discord guild ID"
+// @Param request body SetCelebrationGuildRequest true "Setting"
+// @Success 200 {object} CelebrationGuildResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /api/v1/celebrations/guilds/{guildID} [put]
+func (h *CelebrationHandler) HandleSetGuild(w http.ResponseWriter, r *http.Request) {
+	guildID := chi.URLParam(r, "guildID")
+
+	var req SetCelebrationGuildRequest
+	if err := DecodeAndValidateRequest(r, w, &req, "Set celebration guild"); err != nil {
+		return
+	}
+
+	if err := h.service.SetGuildEnabled(r.Context(), guildID, req.Enabled); err != nil {
+		logger.FromContext(r.Context()).Error("Failed to set celebration guild", "error", err, "guild_id", guildID)
+		RespondError(w, http.StatusInternalServerError, ErrMsgCelebrationGuildFailed)
+		return
+	}
+
+	RespondJSON(w, http.StatusOK, CelebrationGuildResponse{GuildID: guildID, Enabled: req.Enabled})
+}
+
+func platformUserParams(r *http.Request, w http.ResponseWriter) (platform, platformID string, ok bool) {
+	if platform, ok = GetQueryParam(r, w, "platform"); !ok {
+		return "", "", false
+	}
+	if platformID, ok = GetQueryParam(r, w, "platform_id"); !ok {
+		return "", "", false
+	}
+	return platform, platformID, true
+}
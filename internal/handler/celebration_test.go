@@ -0,0 +1,68 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/mocks"
+)
+
+func TestCelebrationHandler_HandleSetBirthday(t *testing.T) {
+	post := func(h *CelebrationHandler, body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/celebrations/birthday", bytes.NewBufferString(body))
+		rec := httptest.NewRecorder()
+		h.HandleSetBirthday(rec, req)
+		return rec
+	}
+
+	t.Run("saves the birthday", func(t *testing.T) {
+		svc := mocks.NewMockCelebrationService(t)
+		svc.On("SetBirthday", mock.Anything, domain.PlatformDiscord, "d-1", "alice", domain.Birthday{Month: 3, Day: 14}).Return(nil)
+
+		rec := post(NewCelebrationHandler(svc), `{"platform":"discord","platform_id":"d-1","username":"alice","month":3,"day":14}`)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Contains(t, rec.Body.String(), MsgBirthdaySet)
+	})
+
+	t.Run("rejects dates that do not exist", func(t *testing.T) {
+		svc := mocks.NewMockCelebrationService(t)
+		svc.On("SetBirthday", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(domain.ErrInvalidBirthday)
+
+		rec := post(NewCelebrationHandler(svc), `{"platform":"discord","platform_id":"d-1","username":"alice","month":4,"day":31}`)
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("rejects out of range months", func(t *testing.T) {
+		svc := mocks.NewMockCelebrationService(t)
+
+		rec := post(NewCelebrationHandler(svc), `{"platform":"discord","platform_id":"d-1","username":"alice","month":13,"day":1}`)
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+}
+
+func TestCelebrationHandler_HandleSetGuild(t *testing.T) {
+	svc := mocks.NewMockCelebrationService(t)
+	svc.On("SetGuildEnabled", mock.Anything, "guild-1", true).Return(nil)
+
+	rctx := chi.NewRouteContext()
+	rctx.URLParams.Add("guildID", "guild-1")
+	req := httptest.NewRequest(http.MethodPut, "/celebrations/guilds/guild-1", bytes.NewBufferString(`{"enabled":true}`))
+	req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, rctx))
+	rec := httptest.NewRecorder()
+
+	NewCelebrationHandler(svc).HandleSetGuild(rec, req)
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Body.String(), `"enabled":true`)
+}
@@ -0,0 +1,135 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/osse101/BrandishBot_Go/internal/communitypool"
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/logger"
+)
+
+// DonateRequest asks to give money or items to the community pool
+type DonateRequest struct {
+	Platform   string `json:"platform" validate:"required,platform"`
+	PlatformID string `json:"platform_id" validate:"required"`
+	Username   string `json:"username" validate:"required,max=100,excludesall=\x00\n\r\t"`
+	ItemName   string `json:"item_name" validate:"required,max=100"`
+	Quantity   int    `json:"quantity" validate:"min=1,max=10000"`
+}
+
+// CommunityDonorsResponse lists the top community pool donors
+type CommunityDonorsResponse struct {
+	Donors []domain.CommunityDonor `json:"donors"`
+}
+
+// CommunityPoolHandler handles donations to the community pool
+type CommunityPoolHandler struct {
+	service communitypool.Service
+}
+
+// NewCommunityPoolHandler creates a new community pool handler
+func NewCommunityPoolHandler(service communitypool.Service) *CommunityPoolHandler {
+	return &CommunityPoolHandler{service: service}
+}
+
+// HandleDonate gives money or items to the community pool
+// @Summary Donate to community pool
+// @Description Money is worth 1 per unit and items their base value. Every COMMUNITY_POOL_MONEY_PER_POINT of value adds a contribution point to the current unlock; the remainder stays in the pool. Borrowed items cannot be donated.
+// @Tags progression
+// @Accept json
+// @Produce json
+// @Param request body DonateRequest true "Donation"
+// @Success 200 {object} domain.CommunityDonation
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /api/v1/community/donate [post]
+func (h *CommunityPoolHandler) HandleDonate(w http.ResponseWriter, r *http.Request) {
+	var req DonateRequest
+	if err := DecodeAndValidateRequest(r, w, &req, "Donate"); err != nil {
+		return
+	}
+
+	donation, err := h.service.Donate(r.Context(), communitypool.DonateRequest{
+		Platform:   req.Platform,
+		PlatformID: req.PlatformID,
+		Username:   req.Username,
+		ItemName:   req.ItemName,
+		Quantity:   req.Quantity,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrInvalidInput):
+			RespondError(w, http.StatusBadRequest, err.Error())
+			return
+		case errors.Is(err, domain.ErrUserNotFound),
+			errors.Is(err, domain.ErrItemNotFound),
+			errors.Is(err, domain.ErrItemBorrowed),
+			errors.Is(err, domain.ErrItemLockedByUser),
+			errors.Is(err, domain.ErrInsufficientQuantity),
+			errors.Is(err, domain.ErrNotInInventory):
+			RespondMappedError(w, err)
+			return
+		}
+		logger.FromContext(r.Context()).Error("Failed to donate", "error", err, "platform", req.Platform, "item", req.ItemName)
+		RespondError(w, http.StatusInternalServerError, ErrMsgDonateFailed)
+		return
+	}
+
+	RespondJSON(w, http.StatusOK, donation)
+}
+
+// HandleGetStatus reports the community pool's balance and donation totals
+// @Summary Get community pool status
+// @Description The money the pool holds, everything donated so far and the contribution points it bought.
+// @Tags progression
+// @Produce json
+// @Success 200 {object} domain.CommunityPoolStatus
+// @Failure 500 {object} ErrorResponse
+// @Router /api/v1/community/pool [get]
+func (h *CommunityPoolHandler) HandleGetStatus(w http.ResponseWriter, r *http.Request) {
+	status, err := h.service.GetStatus(r.Context())
+	if err != nil {
+		logger.FromContext(r.Context()).Error("Failed to get community pool status", "error", err)
+		RespondError(w, http.StatusInternalServerError, ErrMsgGetCommunityPoolFailed)
+		return
+	}
+
+	RespondJSON(w, http.StatusOK, status)
+}
+
+// HandleGetDonors lists the users who have donated the most
+// @Summary Get community pool donors
+// @Description Donors ranked by the total value they have given.
+// @Tags progression
+// @Produce json
+// @Param limit query int false "Number of donors (max 100)"
+// @Success 200 {object} CommunityDonorsResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /api/v1/community/donors [get]
+func (h *CommunityPoolHandler) HandleGetDonors(w http.ResponseWriter, r *http.Request) {
+	limit := communitypool.DefaultDonorLimit
+	if limitStr := r.URL.Query().Get("limit"); limitStr != "" {
+		parsed, err := strconv.Atoi(limitStr)
+		if err != nil || parsed < 1 || parsed > communitypool.MaxDonorLimit {
+			RespondError(w, http.StatusBadRequest, ErrMsgInvalidLimit)
+			return
+		}
+		limit = parsed
+	}
+
+	donors, err := h.service.GetTopDonors(r.Context(), limit)
+	if err != nil {
+		logger.FromContext(r.Context()).Error("Failed to get community pool donors", "error", err)
+		RespondError(w, http.StatusInternalServerError, ErrMsgGetDonorsFailed)
+		return
+	}
+
+	if donors == nil {
+		donors = []domain.CommunityDonor{}
+	}
+	RespondJSON(w, http.StatusOK, CommunityDonorsResponse{Donors: donors})
+}
@@ -0,0 +1,86 @@
+package handler
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/osse101/BrandishBot_Go/internal/communitypool"
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/mocks"
+)
+
+func TestCommunityPoolHandler_HandleDonate(t *testing.T) {
+	post := func(h *CommunityPoolHandler, body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/community/donate", bytes.NewBufferString(body))
+		rec := httptest.NewRecorder()
+		h.HandleDonate(rec, req)
+		return rec
+	}
+
+	t.Run("donates", func(t *testing.T) {
+		svc := mocks.NewMockCommunitypoolService(t)
+		svc.On("Donate", mock.Anything, mock.MatchedBy(func(req communitypool.DonateRequest) bool {
+			return req.ItemName == "money" && req.Quantity == 120
+		})).Return(&domain.CommunityDonation{ItemName: "money", Quantity: 120, Value: 120, Points: 12}, nil)
+
+		rec := post(NewCommunityPoolHandler(svc), `{"platform":"discord","platform_id":"d-1","username":"alice","item_name":"money","quantity":120}`)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Contains(t, rec.Body.String(), `"points":12`)
+	})
+
+	t.Run("worthless item is a bad request", func(t *testing.T) {
+		svc := mocks.NewMockCommunitypoolService(t)
+		svc.On("Donate", mock.Anything, mock.Anything).Return(nil, domain.ErrInvalidInput)
+
+		rec := post(NewCommunityPoolHandler(svc), `{"platform":"discord","platform_id":"d-1","username":"alice","item_name":"pebble","quantity":1}`)
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("rejects a missing item", func(t *testing.T) {
+		svc := mocks.NewMockCommunitypoolService(t)
+
+		rec := post(NewCommunityPoolHandler(svc), `{"platform":"discord","platform_id":"d-1","username":"alice","quantity":1}`)
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+}
+
+func TestCommunityPoolHandler_HandleGetStatus(t *testing.T) {
+	svc := mocks.NewMockCommunitypoolService(t)
+	svc.On("GetStatus", mock.Anything).Return(&domain.CommunityPoolStatus{Balance: 42, TotalDonated: 1500, MoneyPerPoint: 10}, nil)
+
+	rec := httptest.NewRecorder()
+	NewCommunityPoolHandler(svc).HandleGetStatus(rec, httptest.NewRequest(http.MethodGet, "/community/pool", nil))
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Body.String(), `"total_donated":1500`)
+}
+
+func TestCommunityPoolHandler_HandleGetDonors(t *testing.T) {
+	t.Run("lists donors", func(t *testing.T) {
+		svc := mocks.NewMockCommunitypoolService(t)
+		svc.On("GetTopDonors", mock.Anything, 5).Return([]domain.CommunityDonor{{Username: "alice", TotalValue: 900}}, nil)
+
+		rec := httptest.NewRecorder()
+		NewCommunityPoolHandler(svc).HandleGetDonors(rec, httptest.NewRequest(http.MethodGet, "/community/donors?limit=5", nil))
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Contains(t, rec.Body.String(), `"username":"alice"`)
+	})
+
+	t.Run("rejects a bad limit", func(t *testing.T) {
+		svc := mocks.NewMockCommunitypoolService(t)
+
+		rec := httptest.NewRecorder()
+		NewCommunityPoolHandler(svc).HandleGetDonors(rec, httptest.NewRequest(http.MethodGet, "/community/donors?limit=500", nil))
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+}
@@ -57,6 +57,17 @@ type CompostHarvestResponse struct {
 }
 
 // HandleDeposit handles compost deposit requests
+// @Summary Deposit into the compost bin
+// @Description Put items into the user's compost bin, starting it if it was idle
+// @Tags compost
+// @Accept json
+// @Produce json
+// @Param request body CompostDepositRequest true "Items to compost"
+// @Success 201 {object} CompostDepositResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse "Feature locked"
+// @Failure 500 {object} ErrorResponse
+// @Router /api/v1/compost/deposit [post]
 func (h *CompostHandler) HandleDeposit(w http.ResponseWriter, r *http.Request) {
 	handleFeatureAction(w, r, h.progressionSvc, progression.FeatureCompost, "Compost deposit",
 		func(ctx context.Context, req CompostDepositRequest) (*domain.CompostBin, error) {
@@ -82,6 +93,17 @@ func (h *CompostHandler) HandleDeposit(w http.ResponseWriter, r *http.Request) {
 }
 
 // HandleHarvest handles compost harvest requests
+// @Summary Harvest the compost bin
+// @Description Collect the bin's output when it is ready; otherwise reports how long is left
+// @Tags compost
+// @Accept json
+// @Produce json
+// @Param request body CompostHarvestRequest true "Bin owner"
+// @Success 201 {object} CompostHarvestResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse "Feature locked"
+// @Failure 500 {object} ErrorResponse
+// @Router /api/v1/compost/harvest [post]
 func (h *CompostHandler) HandleHarvest(w http.ResponseWriter, r *http.Request) {
 	handleFeatureAction(w, r, h.progressionSvc, progression.FeatureCompost, "Compost harvest",
 		func(ctx context.Context, req CompostHarvestRequest) (*domain.HarvestResult, error) {
@@ -115,6 +137,17 @@ func (h *CompostHandler) HandleHarvest(w http.ResponseWriter, r *http.Request) {
 }
 
 // HandleStatus is a GET convenience endpoint for checking bin status
+// @Summary Get compost bin status
+// @Description The bin's state, harvesting it first when it is ready
+// @Tags compost
+// @Produce json
+// @Param platform query string true "Platform (twitch, youtube, discord)"
+// @Param platform_id query string true "Platform user ID"
+// @Param username query string false "Username"
+// @Success 200 {object} domain.HarvestResult
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /api/v1/compost/status [get]
 func (h *CompostHandler) HandleStatus(w http.ResponseWriter, r *http.Request) {
 	platform, ok := GetQueryParam(r, w, "platform")
 	if !ok {
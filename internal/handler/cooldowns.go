@@ -0,0 +1,68 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/osse101/BrandishBot_Go/internal/cooldown"
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/logger"
+	"github.com/osse101/BrandishBot_Go/internal/user"
+)
+
+// CooldownsResponse lists a user's remaining cooldown timers
+type CooldownsResponse struct {
+	Cooldowns []domain.CooldownTimer `json:"cooldowns"`
+}
+
+// CooldownsHandler handles per-user action cooldowns
+type CooldownsHandler struct {
+	userService user.Service
+	cooldowns   cooldown.Service
+}
+
+// NewCooldownsHandler creates a new cooldowns handler
+func NewCooldownsHandler(userService user.Service, cooldowns cooldown.Service) *CooldownsHandler {
+	return &CooldownsHandler{userService: userService, cooldowns: cooldowns}
+}
+
+// HandleGetCooldowns lists the actions a user has to wait for
+// @Summary List cooldowns
+// @Description Actions such as search, slots, starting a gamble, giving and using items that are still on cooldown, soonest ready first. Actions that are ready are not listed.
+// @Tags user
+// @Produce json
+// @Param platform query string true "Platform"
+// @Param platform_id query string true "Platform user ID"
+// @Success 200 {object} CooldownsResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /api/v1/user/cooldowns [get]
+func (h *CooldownsHandler) HandleGetCooldowns(w http.ResponseWriter, r *http.Request) {
+	platform, platformID, ok := platformUserParams(r, w)
+	if !ok {
+		return
+	}
+
+	userID, err := h.userService.GetUserIDByPlatformID(r.Context(), platform, platformID)
+	if err != nil {
+		logger.FromContext(r.Context()).Error("Failed to resolve user for cooldowns", "error", err, "platform", platform)
+		RespondError(w, http.StatusInternalServerError, ErrMsgGetCooldownsFailed)
+		return
+	}
+	if userID == "" {
+		RespondError(w, http.StatusNotFound, ErrMsgUserNotFoundHTTP)
+		return
+	}
+
+	timers, err := h.cooldowns.GetCooldowns(r.Context(), userID)
+	if err != nil {
+		logger.FromContext(r.Context()).Error("Failed to get cooldowns", "error", err, "user_id", userID)
+		RespondError(w, http.StatusInternalServerError, ErrMsgGetCooldownsFailed)
+		return
+	}
+
+	if timers == nil {
+		timers = []domain.CooldownTimer{}
+	}
+	RespondJSON(w, http.StatusOK, CooldownsResponse{Cooldowns: timers})
+}
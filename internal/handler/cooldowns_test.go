@@ -0,0 +1,76 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/mocks"
+)
+
+func TestCooldownsHandler_HandleGetCooldowns(t *testing.T) {
+	get := func(h *CooldownsHandler, query string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, "/user/cooldowns"+query, nil)
+		rec := httptest.NewRecorder()
+		h.HandleGetCooldowns(rec, req)
+		return rec
+	}
+
+	t.Run("lists remaining cooldowns", func(t *testing.T) {
+		users := mocks.NewMockUserService(t)
+		cooldowns := mocks.NewMockCooldownService(t)
+		users.On("GetUserIDByPlatformID", mock.Anything, "discord", "d-1").Return("user-1", nil)
+		cooldowns.On("GetCooldowns", mock.Anything, "user-1").
+			Return([]domain.CooldownTimer{{Action: domain.ActionSearch, RemainingSeconds: 90}}, nil)
+
+		rec := get(NewCooldownsHandler(users, cooldowns), "?platform=discord&platform_id=d-1")
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Contains(t, rec.Body.String(), `"action":"search"`)
+		assert.Contains(t, rec.Body.String(), `"remaining_seconds":90`)
+	})
+
+	t.Run("no cooldowns is an empty list", func(t *testing.T) {
+		users := mocks.NewMockUserService(t)
+		cooldowns := mocks.NewMockCooldownService(t)
+		users.On("GetUserIDByPlatformID", mock.Anything, "discord", "d-1").Return("user-1", nil)
+		cooldowns.On("GetCooldowns", mock.Anything, "user-1").Return(nil, nil)
+
+		rec := get(NewCooldownsHandler(users, cooldowns), "?platform=discord&platform_id=d-1")
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Contains(t, rec.Body.String(), `"cooldowns":[]`)
+	})
+
+	t.Run("unknown user", func(t *testing.T) {
+		users := mocks.NewMockUserService(t)
+		users.On("GetUserIDByPlatformID", mock.Anything, "discord", "d-1").Return("", nil)
+
+		rec := get(NewCooldownsHandler(users, mocks.NewMockCooldownService(t)), "?platform=discord&platform_id=d-1")
+
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+	})
+
+	t.Run("lookup failure", func(t *testing.T) {
+		users := mocks.NewMockUserService(t)
+		cooldowns := mocks.NewMockCooldownService(t)
+		users.On("GetUserIDByPlatformID", mock.Anything, "discord", "d-1").Return("user-1", nil)
+		cooldowns.On("GetCooldowns", mock.Anything, "user-1").Return(nil, errors.New("db down"))
+
+		rec := get(NewCooldownsHandler(users, cooldowns), "?platform=discord&platform_id=d-1")
+
+		assert.Equal(t, http.StatusInternalServerError, rec.Code)
+		assert.Contains(t, rec.Body.String(), ErrMsgGetCooldownsFailed)
+	})
+
+	t.Run("requires platform_id", func(t *testing.T) {
+		rec := get(NewCooldownsHandler(mocks.NewMockUserService(t), mocks.NewMockCooldownService(t)), "?platform=discord")
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+}
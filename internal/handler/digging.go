@@ -0,0 +1,78 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/osse101/BrandishBot_Go/internal/digging"
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/logger"
+)
+
+// DigRequest asks to dig at the community dig site
+type DigRequest struct {
+	Platform   string `json:"platform" validate:"required,platform"`
+	PlatformID string `json:"platform_id" validate:"required"`
+	Username   string `json:"username" validate:"required,max=100,excludesall=\x00\n\r\t"`
+}
+
+// DiggingHandler handles the community dig site
+type DiggingHandler struct {
+	service digging.Service
+}
+
+// NewDiggingHandler creates a new digging handler
+func NewDiggingHandler(service digging.Service) *DiggingHandler {
+	return &DiggingHandler{service: service}
+}
+
+// HandleDig digs the community dig site deeper
+// @Summary Dig at the dig site
+// @Description Moves the community dig site a few metres deeper, on the per-user COOLDOWN_DIG cooldown. The first dig to reach a level grants that level's contribution toward the current unlock for everyone.
+// @Tags progression
+// @Accept json
+// @Produce json
+// @Param request body DigRequest true "Digger"
+// @Success 200 {object} domain.DigResult
+// @Failure 400 {object} ErrorResponse
+// @Failure 429 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /api/v1/digging/dig [post]
+func (h *DiggingHandler) HandleDig(w http.ResponseWriter, r *http.Request) {
+	var req DigRequest
+	if err := DecodeAndValidateRequest(r, w, &req, "Dig"); err != nil {
+		return
+	}
+
+	result, err := h.service.Dig(r.Context(), req.Platform, req.PlatformID, req.Username)
+	if err != nil {
+		if errors.Is(err, domain.ErrOnCooldown) || errors.Is(err, domain.ErrUserNotFound) {
+			RespondMappedError(w, err)
+			return
+		}
+		logger.FromContext(r.Context()).Error("Failed to dig", "error", err, "platform", req.Platform)
+		RespondError(w, http.StatusInternalServerError, ErrMsgDigFailed)
+		return
+	}
+
+	RespondJSON(w, http.StatusOK, result)
+}
+
+// HandleGetSite reports the dig site's depth, levels and top diggers
+// @Summary Get dig site status
+// @Description How deep the community dig site is, the level it has reached and the next one, every level with its milestone contribution, and the users who have dug the most.
+// @Tags progression
+// @Produce json
+// @Success 200 {object} domain.DigSiteStatus
+// @Failure 500 {object} ErrorResponse
+// @Router /api/v1/digging/site [get]
+func (h *DiggingHandler) HandleGetSite(w http.ResponseWriter, r *http.Request) {
+	status, err := h.service.GetStatus(r.Context())
+	if err != nil {
+		logger.FromContext(r.Context()).Error("Failed to get dig site status", "error", err)
+		RespondError(w, http.StatusInternalServerError, ErrMsgGetDigSiteFailed)
+		return
+	}
+
+	RespondJSON(w, http.StatusOK, status)
+}
@@ -0,0 +1,75 @@
+package handler
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/mocks"
+)
+
+func TestDiggingHandler_HandleDig(t *testing.T) {
+	post := func(h *DiggingHandler, body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/digging/dig", bytes.NewBufferString(body))
+		rec := httptest.NewRecorder()
+		h.HandleDig(rec, req)
+		return rec
+	}
+
+	t.Run("digs", func(t *testing.T) {
+		svc := mocks.NewMockDiggingService(t)
+		svc.On("Dig", mock.Anything, "discord", "d-1", "alice").Return(&domain.DigResult{Meters: 3, Depth: 53, Level: domain.DigLevel{Level: 1, Name: "Clay"}}, nil)
+
+		rec := post(NewDiggingHandler(svc), `{"platform":"discord","platform_id":"d-1","username":"alice"}`)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Contains(t, rec.Body.String(), `"depth":53`)
+	})
+
+	t.Run("cooldown is too many requests", func(t *testing.T) {
+		svc := mocks.NewMockDiggingService(t)
+		svc.On("Dig", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil, fmt.Errorf("%w: dig", domain.ErrOnCooldown))
+
+		rec := post(NewDiggingHandler(svc), `{"platform":"discord","platform_id":"d-1","username":"alice"}`)
+
+		assert.Equal(t, http.StatusTooManyRequests, rec.Code)
+	})
+
+	t.Run("rejects a missing username", func(t *testing.T) {
+		svc := mocks.NewMockDiggingService(t)
+
+		rec := post(NewDiggingHandler(svc), `{"platform":"discord","platform_id":"d-1"}`)
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+}
+
+func TestDiggingHandler_HandleGetSite(t *testing.T) {
+	t.Run("reports the site", func(t *testing.T) {
+		svc := mocks.NewMockDiggingService(t)
+		svc.On("GetStatus", mock.Anything).Return(&domain.DigSiteStatus{Depth: 320, Level: domain.DigLevel{Level: 3, Name: "Bedrock"}}, nil)
+
+		rec := httptest.NewRecorder()
+		NewDiggingHandler(svc).HandleGetSite(rec, httptest.NewRequest(http.MethodGet, "/digging/site", nil))
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Contains(t, rec.Body.String(), `"name":"Bedrock"`)
+	})
+
+	t.Run("fails", func(t *testing.T) {
+		svc := mocks.NewMockDiggingService(t)
+		svc.On("GetStatus", mock.Anything).Return(nil, errors.New("db down"))
+
+		rec := httptest.NewRecorder()
+		NewDiggingHandler(svc).HandleGetSite(rec, httptest.NewRequest(http.MethodGet, "/digging/site", nil))
+
+		assert.Equal(t, http.StatusInternalServerError, rec.Code)
+	})
+}
@@ -17,7 +17,9 @@ import (
 type DisassembleItemResponse struct {
 	Message           string         `json:"message"`
 	Outputs           map[string]int `json:"outputs"`
+	QuantityRequested int            `json:"quantity_requested"`
 	QuantityProcessed int            `json:"quantity_processed"`
+	Partial           bool           `json:"partial"`
 	IsPerfectSalvage  bool           `json:"is_perfect_salvage"`
 	Multiplier        float64        `json:"multiplier"`
 }
@@ -33,7 +35,7 @@ type DisassembleItemResponse struct {
 // @Failure 400 {object} ErrorResponse
 // @Failure 403 {object} ErrorResponse "Feature locked"
 // @Failure 500 {object} ErrorResponse
-// @Router /user/item/disassemble [post]
+// @Router /api/v1/user/item/disassemble [post]
 func HandleDisassembleItem(svc crafting.Service, userSvc user.ManagementService, progressionSvc progression.Service, eventBus event.Bus) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		log := logger.FromContext(r.Context())
@@ -51,8 +53,7 @@ func HandleDisassembleItem(svc crafting.Service, userSvc user.ManagementService,
 		result, err := svc.DisassembleItem(r.Context(), req.Platform, req.PlatformID, req.Username, req.Item, req.Quantity)
 		if err != nil {
 			log.Error("Failed to disassemble item", "error", err, "username", req.Username, "item", req.Item)
-			statusCode, userMsg := MapServiceErrorToUserMessage(err)
-			RespondError(w, statusCode, userMsg)
+			RespondMappedError(w, err)
 			return
 		}
 
@@ -93,11 +94,16 @@ func HandleDisassembleItem(svc crafting.Service, userSvc user.ManagementService,
 		if result.IsPerfectSalvage {
 			message = fmt.Sprintf("PERFECT SALVAGE! You efficiently recovered more materials! (+50%% Bonus): %s", outputStr)
 		}
+		if result.IsPartial() {
+			message += fmt.Sprintf(MsgPartialCraftFmt, result.QuantityProcessed, result.QuantityRequested)
+		}
 
 		RespondJSON(w, http.StatusOK, DisassembleItemResponse{
 			Message:           message,
 			Outputs:           result.Outputs,
+			QuantityRequested: result.QuantityRequested,
 			QuantityProcessed: result.QuantityProcessed,
+			Partial:           result.IsPartial(),
 			IsPerfectSalvage:  result.IsPerfectSalvage,
 			Multiplier:        result.Multiplier,
 		})
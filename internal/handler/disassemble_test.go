@@ -38,6 +38,7 @@ func TestHandleDisassembleItem(t *testing.T) {
 				c.On("DisassembleItem", mock.Anything, domain.PlatformTwitch, "test-id", "testuser", "lootbox_tier1", 2).
 					Return(&crafting.DisassembleResult{
 						Outputs:           map[string]int{"lootbox_tier0": 4},
+						QuantityRequested: 2,
 						QuantityProcessed: 2,
 						IsPerfectSalvage:  false,
 						Multiplier:        1.0,
@@ -49,7 +50,7 @@ func TestHandleDisassembleItem(t *testing.T) {
 				})).Return(nil).Maybe()
 			},
 			expectedStatus: http.StatusOK,
-			expectedBody:   `{"message":"Disassembled 2 items into: 4x lootbox_tier0","outputs":{"lootbox_tier0":4},"quantity_processed":2,"is_perfect_salvage":false,"multiplier":1}`,
+			expectedBody:   `{"message":"Disassembled 2 items into: 4x lootbox_tier0","outputs":{"lootbox_tier0":4},"quantity_requested":2,"quantity_processed":2,"partial":false,"is_perfect_salvage":false,"multiplier":1}`,
 		},
 		{
 			name: "Feature Locked",
@@ -67,7 +68,7 @@ func TestHandleDisassembleItem(t *testing.T) {
 				p.On("GetRequiredNodes", mock.Anything, "feature_disassemble").Return([]*domain.ProgressionNode{}, nil)
 			},
 			expectedStatus: http.StatusForbidden,
-			expectedBody:   `{"error":"` + domain.ErrMsgFeatureLocked + `"}`,
+			expectedBody:   `{"code":"` + string(ErrCodeFeatureLocked) + `","message":"` + domain.ErrMsgFeatureLocked + `","error":"` + domain.ErrMsgFeatureLocked + `","details":{"feature":"feature_disassemble"}}`,
 		},
 		{
 			name: "Service Error",
@@ -100,6 +101,7 @@ func TestHandleDisassembleItem(t *testing.T) {
 				c.On("DisassembleItem", mock.Anything, domain.PlatformTwitch, "test-id", "testuser", "lootbox_tier1", 10).
 					Return(&crafting.DisassembleResult{
 						Outputs:           map[string]int{"lootbox_tier0": 30}, // 20 * 1.5
+						QuantityRequested: 10,
 						QuantityProcessed: 10,
 						IsPerfectSalvage:  true,
 						Multiplier:        1.5,
@@ -109,7 +111,7 @@ func TestHandleDisassembleItem(t *testing.T) {
 				b.On("Publish", mock.Anything, mock.Anything).Return(nil).Maybe()
 			},
 			expectedStatus: http.StatusOK,
-			expectedBody:   `{"message":"PERFECT SALVAGE! You efficiently recovered more materials! (+50% Bonus): 30x lootbox_tier0","outputs":{"lootbox_tier0":30},"quantity_processed":10,"is_perfect_salvage":true,"multiplier":1.5}`,
+			expectedBody:   `{"message":"PERFECT SALVAGE! You efficiently recovered more materials! (+50% Bonus): 30x lootbox_tier0","outputs":{"lootbox_tier0":30},"quantity_requested":10,"quantity_processed":10,"partial":false,"is_perfect_salvage":true,"multiplier":1.5}`,
 		},
 	}
 
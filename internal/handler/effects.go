@@ -0,0 +1,60 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/effects"
+	"github.com/osse101/BrandishBot_Go/internal/logger"
+)
+
+// EffectsResponse lists a user's active timed effects
+type EffectsResponse struct {
+	Effects []domain.Effect `json:"effects"`
+}
+
+// EffectsHandler handles timed user effects
+type EffectsHandler struct {
+	service effects.Service
+}
+
+// NewEffectsHandler creates a new effects handler
+func NewEffectsHandler(service effects.Service) *EffectsHandler {
+	return &EffectsHandler{service: service}
+}
+
+// HandleGetEffects lists a user's active effects
+// @Summary List active effects
+// @Description Timed buffs from items such as the clover or the aegis, soonest to expire first.
+// @Tags user
+// @Produce json
+// @Param platform query string true "Platform"
+// @Param platform_id query string true "Platform user ID"
+// @Success 200 {object} EffectsResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /api/v1/user/effects [get]
+func (h *EffectsHandler) HandleGetEffects(w http.ResponseWriter, r *http.Request) {
+	platform, platformID, ok := platformUserParams(r, w)
+	if !ok {
+		return
+	}
+
+	active, err := h.service.GetActive(r.Context(), platform, platformID)
+	if err != nil {
+		if errors.Is(err, domain.ErrUserNotFound) {
+			RespondError(w, http.StatusNotFound, ErrMsgUserNotFoundHTTP)
+			return
+		}
+		logger.FromContext(r.Context()).Error("Failed to get effects", "error", err, "platform", platform)
+		RespondError(w, http.StatusInternalServerError, ErrMsgGetEffectsFailed)
+		return
+	}
+
+	if active == nil {
+		active = []domain.Effect{}
+	}
+	RespondJSON(w, http.StatusOK, EffectsResponse{Effects: active})
+}
@@ -0,0 +1,71 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/mocks"
+)
+
+func TestEffectsHandler_HandleGetEffects(t *testing.T) {
+	get := func(h *EffectsHandler, query string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, "/user/effects"+query, nil)
+		rec := httptest.NewRecorder()
+		h.HandleGetEffects(rec, req)
+		return rec
+	}
+
+	t.Run("lists active effects", func(t *testing.T) {
+		svc := mocks.NewMockEffectsService(t)
+		svc.On("GetActive", mock.Anything, "discord", "d-1").
+			Return([]domain.Effect{{Type: domain.EffectSearchLuck, Magnitude: 2}}, nil)
+
+		rec := get(NewEffectsHandler(svc), "?platform=discord&platform_id=d-1")
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Contains(t, rec.Body.String(), `"type":"search_luck"`)
+	})
+
+	t.Run("no effects is an empty list", func(t *testing.T) {
+		svc := mocks.NewMockEffectsService(t)
+		svc.On("GetActive", mock.Anything, "discord", "d-1").Return(nil, nil)
+
+		rec := get(NewEffectsHandler(svc), "?platform=discord&platform_id=d-1")
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Contains(t, rec.Body.String(), `"effects":[]`)
+	})
+
+	t.Run("unknown user", func(t *testing.T) {
+		svc := mocks.NewMockEffectsService(t)
+		svc.On("GetActive", mock.Anything, "discord", "d-1").Return(nil, domain.ErrUserNotFound)
+
+		rec := get(NewEffectsHandler(svc), "?platform=discord&platform_id=d-1")
+
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+	})
+
+	t.Run("service failure", func(t *testing.T) {
+		svc := mocks.NewMockEffectsService(t)
+		svc.On("GetActive", mock.Anything, "discord", "d-1").Return(nil, errors.New("db down"))
+
+		rec := get(NewEffectsHandler(svc), "?platform=discord&platform_id=d-1")
+
+		assert.Equal(t, http.StatusInternalServerError, rec.Code)
+		assert.Contains(t, rec.Body.String(), ErrMsgGetEffectsFailed)
+	})
+
+	t.Run("requires a platform", func(t *testing.T) {
+		svc := mocks.NewMockEffectsService(t)
+
+		rec := get(NewEffectsHandler(svc), "?platform_id=d-1")
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+}
@@ -34,8 +34,136 @@ const (
 	ErrMsgGetLeaderboardFailed       = "Failed to retrieve leaderboard"
 	ErrMsgGetVelocityMetricsFailed   = "Failed to retrieve velocity metrics"
 	ErrMsgGetVotingSessionFailed     = "Failed to retrieve voting session"
+	ErrMsgGetVotingHistoryFailed     = "Failed to retrieve voting history"
 	ErrMsgGetUnlockProgressFailed    = "Failed to retrieve unlock progress"
 	ErrMsgGetUnlockEstimateFailed    = "Failed to get unlock estimate"
+	ErrMsgAddContributionFailed      = "Failed to add contribution"
+	ErrMsgSourceRequired             = "source is required"
+	ErrMsgSourceNotInScope           = "API key is not allowed to contribute for this source"
+	ErrMsgExternalCapReached         = "Hourly contribution cap reached for this source"
+	ErrMsgDelegateVoteFailed         = "Failed to delegate vote"
+	ErrMsgRevokeDelegationFailed     = "Failed to revoke vote delegation"
+	ErrMsgGetDelegationFailed        = "Failed to retrieve vote delegation"
+	ErrMsgEditTreeFailed             = "Failed to edit progression tree"
+
+	// Monetization error messages
+	ErrMsgMonetizationEventFailed = "Failed to process monetization event"
+
+	// Celebration error messages
+	ErrMsgInvalidBirthday        = "Birthday is not a valid month and day"
+	ErrMsgSetBirthdayFailed      = "Failed to set birthday"
+	ErrMsgGetBirthdayFailed      = "Failed to retrieve birthday"
+	ErrMsgClearBirthdayFailed    = "Failed to clear birthday"
+	ErrMsgCelebrationGuildFailed = "Failed to access celebration settings"
+
+	// User settings error messages
+	ErrMsgGetUserSettingsFailed    = "Failed to retrieve user settings"
+	ErrMsgSetUserSettingsFailed    = "Failed to update user settings"
+	ErrMsgSetUserPreferencesFailed = "Failed to update user preferences"
+
+	// Item flag error messages
+	ErrMsgSetItemLockFailed     = "Failed to update item lock"
+	ErrMsgSetItemFavoriteFailed = "Failed to update item favorite"
+
+	// Undo error messages
+	ErrMsgUndoFailed        = "Failed to undo"
+	ErrMsgNothingToUndoHTTP = "Nothing to undo"
+
+	// Reminder error messages
+	ErrMsgGetRemindersFailed   = "Failed to retrieve reminders"
+	ErrMsgCreateReminderFailed = "Failed to create reminder"
+	ErrMsgCancelReminderFailed = "Failed to cancel reminder"
+	ErrMsgInvalidReminderID    = "Invalid reminder ID"
+	ErrMsgReminderNotFoundHTTP = "Reminder not found"
+
+	// Loan error messages
+	ErrMsgGetLoansFailed   = "Failed to retrieve loans"
+	ErrMsgLendItemFailed   = "Failed to lend item"
+	ErrMsgReturnLoanFailed = "Failed to return loan"
+	ErrMsgInvalidLoanID    = "Invalid loan ID"
+	ErrMsgLoanNotFoundHTTP = "Loan not found"
+
+	// Personal track error messages
+	ErrMsgGetPersonalProgressionFailed = "Failed to retrieve personal progression"
+
+	// Player shop error messages
+	ErrMsgGetListingsFailed      = "Failed to retrieve listings"
+	ErrMsgListItemFailed         = "Failed to list item"
+	ErrMsgBuyListingFailed       = "Failed to buy listing"
+	ErrMsgCancelListingFailed    = "Failed to cancel listing"
+	ErrMsgGetCommunityPoolFailed = "Failed to retrieve community pool"
+	ErrMsgInvalidListingID       = "Invalid listing ID"
+	ErrMsgInvalidPriceFilter     = "Invalid price filter"
+	ErrMsgListingNotFoundHTTP    = "Listing not found"
+
+	// Community pool error messages
+	ErrMsgDonateFailed    = "Failed to donate"
+	ErrMsgGetDonorsFailed = "Failed to retrieve donors"
+
+	// Jackpot error messages
+	ErrMsgGetJackpotFailed = "Failed to retrieve jackpot"
+
+	// Game event error messages
+	ErrMsgGetGameEventsFailed = "Failed to retrieve game events"
+
+	// Digging error messages
+	ErrMsgDigFailed        = "Failed to dig"
+	ErrMsgGetDigSiteFailed = "Failed to retrieve dig site"
+
+	// Fishing error messages
+	ErrMsgCastFailed = "Failed to cast"
+	ErrMsgReelFailed = "Failed to reel in"
+
+	// Garden error messages
+	ErrMsgGetGardenFailed     = "Failed to retrieve garden"
+	ErrMsgPlantFailed         = "Failed to plant"
+	ErrMsgHarvestGardenFailed = "Failed to harvest garden"
+
+	// Bank error messages
+	ErrMsgGetBankFailed     = "Failed to retrieve bank account"
+	ErrMsgDepositFailed     = "Failed to deposit"
+	ErrMsgWithdrawFailed    = "Failed to withdraw"
+	ErrMsgSetBankGoalFailed = "Failed to set savings goal"
+
+	// Gift error messages
+	ErrMsgGetGiftsFailed   = "Failed to retrieve gifts"
+	ErrMsgWrapGiftFailed   = "Failed to wrap gift"
+	ErrMsgCancelGiftFailed = "Failed to cancel gift"
+	ErrMsgInvalidGiftID    = "Invalid gift ID"
+	ErrMsgGiftNotFoundHTTP = "Gift not found"
+
+	// Inbox error messages
+	ErrMsgGetInboxFailed      = "Failed to retrieve inbox"
+	ErrMsgMarkInboxReadFailed = "Failed to mark inbox messages read"
+
+	// Boss error messages
+	ErrMsgBossAttackFailed = "Failed to attack boss"
+	ErrMsgGetBossFailed    = "Failed to retrieve boss"
+
+	// Effect error messages
+	ErrMsgGetEffectsFailed = "Failed to retrieve effects"
+	ErrMsgGetBonusesFailed = "Failed to retrieve community bonuses"
+
+	// Stream session error messages
+	ErrMsgStartStreamFailed      = "Failed to start stream session"
+	ErrMsgEndStreamFailed        = "Failed to end stream session"
+	ErrMsgGetStreamSummaryFailed = "Failed to retrieve stream summary"
+	ErrMsgGetStreamRecapFailed   = "Failed to retrieve stream recap"
+	ErrMsgInvalidStreamSessionID = "Invalid stream session ID"
+	ErrMsgStreamSessionNotFound  = "Stream session not found"
+	ErrMsgNoLiveStreamHTTP       = "No stream is live"
+
+	// Announcement error messages
+	ErrMsgGetAnnouncementEventsFailed = "Failed to retrieve announcement routes"
+
+	// Cooldown error messages
+	ErrMsgGetCooldownsFailed = "Failed to retrieve cooldowns"
+
+	// Job error messages
+	ErrMsgSwitchJobFailed   = "Failed to switch active job"
+	ErrMsgPrestigeJobFailed = "Failed to prestige job"
+	ErrMsgGetJobPerksFailed = "Failed to retrieve job perks"
+	ErrMsgJobNotFoundHTTP   = "Job not found"
 
 	// Crafting/upgrade error messages
 	ErrMsgDisassembleItemFailed = "Failed to disassemble item"
@@ -60,20 +188,24 @@ const (
 	ErrMsgAmountMustBePositive        = "amount must be positive"
 	ErrMsgAmountExceedsMax            = "amount exceeds maximum (10000)"
 	ErrMsgPlatformUsernameJobRequired = "platform, username, and job_key are required"
+	ErrMsgPlatformUsernameRequired    = "platform and username are required"
 
 	// Gamble error messages
 	ErrMsgInvalidGambleID    = "Invalid gamble ID"
 	ErrMsgGambleNotFoundHTTP = "Gamble not found"
+	ErrMsgInvalidWaitTimeout = "Invalid timeout parameter"
 
 	// Inventory filter error messages
 	ErrMsgInvalidFilterType = "Invalid filter type '%s'. Valid options: upgrade, sellable, consumable"
 	ErrMsgFilterLocked      = "Filter '%s' is locked. Unlock it in the progression tree."
 
 	// Parameter validation error messages
-	ErrMsgInvalidLimit = "Invalid limit parameter"
+	ErrMsgInvalidLimit  = "Invalid limit parameter"
+	ErrMsgInvalidOffset = "Invalid offset parameter"
 
 	// Feature lock reason constants
 	FeatureLockReasonProgression = "progression_locked"
+	FeatureLockReasonFlag        = "feature_flag_off"
 	MsgLockedNodesFormat         = "LOCKED_NODES: %s"
 
 	// Compost error messages
@@ -105,10 +237,15 @@ const (
 	MsgNodeRelockedSuccess       = "Node relocked successfully"
 	MsgInstantUnlockSuccess      = "Instant unlock successful"
 	MsgVotingEndedSuccess        = "Voting ended successfully"
+	MsgVoteDelegatedSuccess      = "Vote delegated successfully"
+	MsgVoteDelegationRevoked     = "Vote delegation revoked successfully"
 
 	// Gamble success messages
 	MsgJoinedGambleSuccess = "Successfully joined gamble"
 
+	// Crafting info messages
+	MsgPartialCraftFmt = " (processed %d of %d requested)"
+
 	// Linking success messages
 	MsgConfirmWithinSeconds = "Confirm within 60 seconds"
 	MsgPlatformUnlinked     = "Platform unlinked"
@@ -118,9 +255,20 @@ const (
 	MsgNoActiveUnlockProgress = "No active unlock progress"
 
 	// Admin success messages
-	MsgConfigReloadedSuccess = "Alias configuration reloaded successfully"
+	MsgConfigReloadedSuccess     = "Alias configuration reloaded successfully"
+	MsgGameConfigReloadedSuccess = "Game data configuration reloaded successfully"
 
 	// Compost success messages
 	MsgCompostDepositSuccess = "Items deposited into compost bin!"
 	MsgCompostBinEmpty       = "Bin is empty"
+
+	// Monetization success messages
+	MsgMonetizationEventDuplicate = "Event already processed"
+
+	// Celebration success messages
+	MsgBirthdaySet     = "Birthday saved"
+	MsgBirthdayCleared = "Birthday removed"
+
+	// Reminder success messages
+	MsgReminderCancelled = "Reminder cancelled"
 )
@@ -39,6 +39,17 @@ type StartExpeditionResponse struct {
 }
 
 // HandleStart handles expedition start requests
+// @Summary Start an expedition
+// @Description Start an expedition that other users can join before the deadline
+// @Tags expedition
+// @Accept json
+// @Produce json
+// @Param request body StartExpeditionRequest true "Expedition details"
+// @Success 201 {object} StartExpeditionResponse
+// @Failure 400 {object} ErrorResponse "Invalid request, expedition running or on cooldown"
+// @Failure 403 {object} ErrorResponse "Feature locked"
+// @Failure 500 {object} ErrorResponse
+// @Router /api/v1/expedition/start [post]
 func (h *ExpeditionHandler) HandleStart(w http.ResponseWriter, r *http.Request) {
 	handleFeatureAction(w, r, h.progressionSvc, progression.FeatureExpedition, "Start expedition",
 		func(ctx context.Context, req StartExpeditionRequest) (*domain.Expedition, error) {
@@ -68,6 +79,18 @@ type JoinExpeditionRequest struct {
 }
 
 // HandleJoin handles expedition join requests
+// @Summary Join an expedition
+// @Description Join the expedition with the given ID, or the active one when no ID is given
+// @Tags expedition
+// @Accept json
+// @Produce json
+// @Param id query string false "Expedition ID"
+// @Param request body JoinExpeditionRequest true "Participant details"
+// @Success 200 {object} SuccessResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse "Feature locked"
+// @Failure 500 {object} ErrorResponse
+// @Router /api/v1/expedition/join [post]
 func (h *ExpeditionHandler) HandleJoin(w http.ResponseWriter, r *http.Request) {
 	// Check if expedition feature is unlocked
 	if CheckFeatureLocked(w, r, h.progressionSvc, progression.FeatureExpedition) {
@@ -96,7 +119,7 @@ func (h *ExpeditionHandler) HandleJoin(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	RespondJSON(w, http.StatusOK, map[string]string{"message": "Joined expedition!"})
+	RespondJSON(w, http.StatusOK, SuccessResponse{Message: "Joined expedition!"})
 }
 
 // HandleGet handles expedition get requests
@@ -123,6 +146,16 @@ func (h *ExpeditionHandler) HandleGetActive(w http.ResponseWriter, r *http.Reque
 }
 
 // HandleGetJournal handles expedition journal requests
+// @Summary Get an expedition journal
+// @Description The turn-by-turn journal of an expedition
+// @Tags expedition
+// @Produce json
+// @Param id query string true "Expedition ID"
+// @Success 200 {array} domain.ExpeditionJournalEntry
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse "Feature locked"
+// @Failure 500 {object} ErrorResponse
+// @Router /api/v1/expedition/journal [get]
 func (h *ExpeditionHandler) HandleGetJournal(w http.ResponseWriter, r *http.Request) {
 	h.handleByID(w, r, "Failed to get expedition journal", func(ctx context.Context, id uuid.UUID) (interface{}, error) {
 		return h.service.GetJournal(ctx, id)
@@ -130,6 +163,14 @@ func (h *ExpeditionHandler) HandleGetJournal(w http.ResponseWriter, r *http.Requ
 }
 
 // HandleGetStatus handles expedition status requests
+// @Summary Get expedition status
+// @Description Whether an expedition is running, with its details, and when the cooldown ends
+// @Tags expedition
+// @Produce json
+// @Success 200 {object} domain.ExpeditionStatus
+// @Failure 403 {object} ErrorResponse "Feature locked"
+// @Failure 500 {object} ErrorResponse
+// @Router /api/v1/expedition/status [get]
 func (h *ExpeditionHandler) HandleGetStatus(w http.ResponseWriter, r *http.Request) {
 	// Check if expedition feature is unlocked
 	if CheckFeatureLocked(w, r, h.progressionSvc, progression.FeatureExpedition) {
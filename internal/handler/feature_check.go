@@ -1,6 +1,7 @@
 package handler
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"strings"
@@ -18,8 +19,7 @@ func CheckFeatureLocked(w http.ResponseWriter, r *http.Request, svc progression.
 	unlocked, err := svc.IsFeatureUnlocked(r.Context(), key)
 	if err != nil {
 		log.Error("Failed to check feature unlock status", "error", err, "feature", key)
-		statusCode, userMsg := MapServiceErrorToUserMessage(err)
-		RespondError(w, statusCode, userMsg)
+		RespondMappedError(w, err)
 		return true
 	}
 	if !unlocked {
@@ -40,7 +40,7 @@ func CheckFeatureLocked(w http.ResponseWriter, r *http.Request, svc progression.
 		nodes, err := svc.GetRequiredNodes(r.Context(), key)
 		if err != nil {
 			log.Error("Failed to get required nodes", "error", err, "feature", key)
-			RespondError(w, http.StatusForbidden, domain.ErrMsgFeatureLocked)
+			RespondErrorCode(w, http.StatusForbidden, ErrCodeFeatureLocked, domain.ErrMsgFeatureLocked, FeatureLockDetails{Feature: key})
 			return true
 		}
 
@@ -54,8 +54,35 @@ func CheckFeatureLocked(w http.ResponseWriter, r *http.Request, svc progression.
 			msg = fmt.Sprintf(MsgLockedNodesFormat, strings.Join(names, ", "))
 		}
 
-		RespondError(w, http.StatusForbidden, msg)
+		RespondErrorCode(w, http.StatusForbidden, ErrCodeFeatureLocked, msg, FeatureLockDetails{Feature: key, RequiredNodes: names})
 		return true
 	}
 	return false
 }
+
+// FeatureLockDetails is sent in the details of FEATURE_LOCKED and
+// FEATURE_DISABLED errors
+type FeatureLockDetails struct {
+	Feature       string   `json:"feature"`
+	RequiredNodes []string `json:"required_nodes,omitempty"`
+}
+
+// FeatureFlagChecker reports whether an admin feature flag is on for a platform user
+type FeatureFlagChecker interface {
+	IsEnabled(ctx context.Context, key, platform, platformID string) bool
+}
+
+// CheckFeatureFlagDisabled checks an admin feature flag for the caller. If the flag is off it writes
+// a 403 and returns true, like CheckFeatureLocked. Unlike progression locks, flags are per user.
+func CheckFeatureFlagDisabled(w http.ResponseWriter, r *http.Request, flags FeatureFlagChecker, key, platform, platformID string) bool {
+	if flags.IsEnabled(r.Context(), key, platform, platformID) {
+		return false
+	}
+	logger.FromContext(r.Context()).Info("Feature is disabled by flag",
+		"feature", key,
+		"platform", platform,
+		"platform_id", platformID,
+		"reason", FeatureLockReasonFlag)
+	RespondErrorCode(w, http.StatusForbidden, ErrCodeFeatureDisabled, ErrMsgFeatureUnavailable, FeatureLockDetails{Feature: key})
+	return true
+}
@@ -0,0 +1,114 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/fishing"
+	"github.com/osse101/BrandishBot_Go/internal/logger"
+)
+
+// CastRequest asks to cast a fishing line
+type CastRequest struct {
+	Platform   string `json:"platform" validate:"required,platform"`
+	PlatformID string `json:"platform_id" validate:"required"`
+	Username   string `json:"username" validate:"required,max=100,excludesall=\x00\n\r\t"`
+	Tackle     string `json:"tackle,omitempty" validate:"max=100"`
+	ChannelID  string `json:"channel_id,omitempty" validate:"max=100"`
+}
+
+// ReelRequest asks to reel in a fishing line
+type ReelRequest struct {
+	Platform   string `json:"platform" validate:"required,platform"`
+	PlatformID string `json:"platform_id" validate:"required"`
+	Username   string `json:"username" validate:"required,max=100,excludesall=\x00\n\r\t"`
+}
+
+// FishingHandler handles the fishing minigame
+type FishingHandler struct {
+	service fishing.Service
+}
+
+// NewFishingHandler creates a new fishing handler
+func NewFishingHandler(service fishing.Service) *FishingHandler {
+	return &FishingHandler{service: service}
+}
+
+// HandleCast casts the user's fishing line
+// @Summary Cast a fishing line
+// @Description Puts the user's line in the water, on the per-user COOLDOWN_FISH cooldown. A fish bites 5-30 seconds later and a fishing.bite event is sent; the user then has a few seconds to reel in. Tackle (worm, lure or bobber) is used up by the cast. channel_id is passed on with the bite so the platform can announce it where the cast was made.
+// @Tags fishing
+// @Accept json
+// @Produce json
+// @Param request body CastRequest true "Cast details"
+// @Success 200 {object} domain.FishingCast
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse "A line is already in the water"
+// @Failure 429 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /api/v1/fishing/cast [post]
+func (h *FishingHandler) HandleCast(w http.ResponseWriter, r *http.Request) {
+	var req CastRequest
+	if err := DecodeAndValidateRequest(r, w, &req, "Cast"); err != nil {
+		return
+	}
+
+	cast, err := h.service.Cast(r.Context(), req.Platform, req.PlatformID, req.Username, req.Tackle, req.ChannelID)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrAlreadyFishing):
+			RespondError(w, http.StatusConflict, domain.ErrMsgAlreadyFishing)
+		case errors.Is(err, domain.ErrUnknownTackle):
+			RespondError(w, http.StatusBadRequest, err.Error())
+		case errors.Is(err, domain.ErrOnCooldown),
+			errors.Is(err, domain.ErrFeatureLocked),
+			errors.Is(err, domain.ErrNotInInventory),
+			errors.Is(err, domain.ErrInsufficientQuantity),
+			errors.Is(err, domain.ErrUserNotFound):
+			RespondMappedError(w, err)
+		default:
+			logger.FromContext(r.Context()).Error("Failed to cast", "error", err, "platform", req.Platform)
+			RespondError(w, http.StatusInternalServerError, ErrMsgCastFailed)
+		}
+		return
+	}
+
+	RespondJSON(w, http.StatusOK, cast)
+}
+
+// HandleReel reels in the user's fishing line
+// @Summary Reel in a fishing line
+// @Description Reels in the user's line. While the fish is biting this lands a fish of random rarity into the user's inventory and awards Fisher XP; reeling in before the bite or after the window closes loses it.
+// @Tags fishing
+// @Accept json
+// @Produce json
+// @Param request body ReelRequest true "Angler"
+// @Success 200 {object} domain.ReelResult
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse "No line in the water"
+// @Failure 500 {object} ErrorResponse
+// @Router /api/v1/fishing/reel [post]
+func (h *FishingHandler) HandleReel(w http.ResponseWriter, r *http.Request) {
+	var req ReelRequest
+	if err := DecodeAndValidateRequest(r, w, &req, "Reel"); err != nil {
+		return
+	}
+
+	result, err := h.service.Reel(r.Context(), req.Platform, req.PlatformID, req.Username)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrNoFishingCast):
+			RespondError(w, http.StatusNotFound, err.Error())
+		case errors.Is(err, domain.ErrUserNotFound):
+			RespondMappedError(w, err)
+		default:
+			logger.FromContext(r.Context()).Error("Failed to reel in", "error", err, "platform", req.Platform)
+			RespondError(w, http.StatusInternalServerError, ErrMsgReelFailed)
+		}
+		return
+	}
+
+	RespondJSON(w, http.StatusOK, result)
+}
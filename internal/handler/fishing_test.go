@@ -0,0 +1,105 @@
+package handler
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/mocks"
+)
+
+func TestFishingHandler_HandleCast(t *testing.T) {
+	post := func(h *FishingHandler, body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/fishing/cast", bytes.NewBufferString(body))
+		rec := httptest.NewRecorder()
+		h.HandleCast(rec, req)
+		return rec
+	}
+
+	t.Run("casts", func(t *testing.T) {
+		svc := mocks.NewMockFishingService(t)
+		svc.On("Cast", mock.Anything, "discord", "d-1", "alice", "worm", "chan-1").Return(&domain.FishingCast{UserID: "u-1", Username: "alice", Tackle: "tackle_worm"}, nil)
+
+		rec := post(NewFishingHandler(svc), `{"platform":"discord","platform_id":"d-1","username":"alice","tackle":"worm","channel_id":"chan-1"}`)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Contains(t, rec.Body.String(), `"tackle":"tackle_worm"`)
+		assert.NotContains(t, rec.Body.String(), "bite_at")
+	})
+
+	t.Run("a line already in the water is a conflict", func(t *testing.T) {
+		svc := mocks.NewMockFishingService(t)
+		svc.On("Cast", mock.Anything, mock.Anything, mock.Anything, mock.Anything, "", "").Return(nil, domain.ErrAlreadyFishing)
+
+		rec := post(NewFishingHandler(svc), `{"platform":"discord","platform_id":"d-1","username":"alice"}`)
+
+		assert.Equal(t, http.StatusConflict, rec.Code)
+	})
+
+	t.Run("unknown tackle is a bad request", func(t *testing.T) {
+		svc := mocks.NewMockFishingService(t)
+		svc.On("Cast", mock.Anything, mock.Anything, mock.Anything, mock.Anything, "money", "").Return(nil, fmt.Errorf("%w: money", domain.ErrUnknownTackle))
+
+		rec := post(NewFishingHandler(svc), `{"platform":"discord","platform_id":"d-1","username":"alice","tackle":"money"}`)
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("cooldown is too many requests", func(t *testing.T) {
+		svc := mocks.NewMockFishingService(t)
+		svc.On("Cast", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil, fmt.Errorf("%w: fish", domain.ErrOnCooldown))
+
+		rec := post(NewFishingHandler(svc), `{"platform":"discord","platform_id":"d-1","username":"alice"}`)
+
+		assert.Equal(t, http.StatusTooManyRequests, rec.Code)
+	})
+}
+
+func TestFishingHandler_HandleReel(t *testing.T) {
+	post := func(h *FishingHandler, body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/fishing/reel", bytes.NewBufferString(body))
+		rec := httptest.NewRecorder()
+		h.HandleReel(rec, req)
+		return rec
+	}
+
+	t.Run("reels in a catch", func(t *testing.T) {
+		svc := mocks.NewMockFishingService(t)
+		svc.On("Reel", mock.Anything, "discord", "d-1", "alice").Return(&domain.ReelResult{
+			Outcome:    domain.FishingOutcomeCaught,
+			ReactionMs: 850,
+			Catch:      &domain.FishCatch{ItemName: "fish_trout", Quality: domain.QualityUncommon, XP: 20},
+		}, nil)
+
+		rec := post(NewFishingHandler(svc), `{"platform":"discord","platform_id":"d-1","username":"alice"}`)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Contains(t, rec.Body.String(), `"outcome":"caught"`)
+		assert.Contains(t, rec.Body.String(), `"fish_trout"`)
+	})
+
+	t.Run("no line is not found", func(t *testing.T) {
+		svc := mocks.NewMockFishingService(t)
+		svc.On("Reel", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil, domain.ErrNoFishingCast)
+
+		rec := post(NewFishingHandler(svc), `{"platform":"discord","platform_id":"d-1","username":"alice"}`)
+
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+	})
+
+	t.Run("fails", func(t *testing.T) {
+		svc := mocks.NewMockFishingService(t)
+		svc.On("Reel", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil, errors.New("db down"))
+
+		rec := post(NewFishingHandler(svc), `{"platform":"discord","platform_id":"d-1","username":"alice"}`)
+
+		assert.Equal(t, http.StatusInternalServerError, rec.Code)
+	})
+}
@@ -1,8 +1,12 @@
 package handler
 
 import (
+	"errors"
 	"net/http"
+	"strconv"
+	"time"
 
+	"github.com/go-chi/chi/v5"
 	"github.com/google/uuid"
 
 	"github.com/osse101/BrandishBot_Go/internal/domain"
@@ -14,19 +18,27 @@ import (
 	"github.com/osse101/BrandishBot_Go/internal/user"
 )
 
+// Long-poll bounds for HandleWaitGamble
+const (
+	GambleWaitDefaultTimeout = 25 * time.Second
+	GambleWaitMaxTimeout     = 55 * time.Second
+)
+
 type GambleHandler struct {
 	service        gamble.Service
 	userSvc        user.ManagementService
 	progressionSvc progression.Service
 	eventBus       event.Bus
+	watcher        *gamble.Watcher
 }
 
-func NewGambleHandler(service gamble.Service, userSvc user.ManagementService, progressionSvc progression.Service, eventBus event.Bus) *GambleHandler {
+func NewGambleHandler(service gamble.Service, userSvc user.ManagementService, progressionSvc progression.Service, eventBus event.Bus, watcher *gamble.Watcher) *GambleHandler {
 	return &GambleHandler{
 		service:        service,
 		userSvc:        userSvc,
 		progressionSvc: progressionSvc,
 		eventBus:       eventBus,
+		watcher:        watcher,
 	}
 }
 
@@ -38,10 +50,23 @@ type StartGambleRequest struct {
 }
 
 type StartGambleResponse struct {
-	Message  string `json:"message"`
-	GambleID string `json:"gamble_id"`
+	Message   string `json:"message"`
+	GambleID  string `json:"gamble_id"`
+	ShortCode string `json:"short_code"`
 }
 
+// HandleStartGamble starts a gamble with the caller's lootbox bets
+// @Summary Start a gamble
+// @Description Start a new gamble with lootbox bets that other users can join before the deadline
+// @Tags gamble
+// @Accept json
+// @Produce json
+// @Param request body StartGambleRequest true "Gamble details"
+// @Success 201 {object} StartGambleResponse
+// @Failure 400 {object} ErrorResponse "Invalid request or a gamble is already running"
+// @Failure 403 {object} ErrorResponse "Feature locked"
+// @Failure 500 {object} ErrorResponse "Internal server error"
+// @Router /api/v1/gamble/start [post]
 func (h *GambleHandler) HandleStartGamble(w http.ResponseWriter, r *http.Request) {
 	// Check if gamble feature is unlocked
 	if CheckFeatureLocked(w, r, h.progressionSvc, progression.FeatureGamble) {
@@ -56,8 +81,7 @@ func (h *GambleHandler) HandleStartGamble(w http.ResponseWriter, r *http.Request
 	gamble, err := h.service.StartGamble(r.Context(), req.Platform, req.PlatformID, req.Username, req.Bets)
 	if err != nil {
 		logger.FromContext(r.Context()).Error("Failed to start gamble", "error", err)
-		statusCode, userMsg := MapServiceErrorToUserMessage(err)
-		RespondError(w, statusCode, userMsg)
+		RespondMappedError(w, err)
 		return
 	}
 
@@ -72,8 +96,9 @@ func (h *GambleHandler) HandleStartGamble(w http.ResponseWriter, r *http.Request
 	}
 
 	response := StartGambleResponse{
-		Message:  "Gamble started!",
-		GambleID: gamble.ID.String(),
+		Message:   "Gamble started!",
+		GambleID:  gamble.ID.String(),
+		ShortCode: gamble.ShortCode(),
 	}
 	RespondJSON(w, http.StatusCreated, response)
 }
@@ -84,6 +109,17 @@ type JoinGambleRequest struct {
 	Username   string `json:"username" validate:"required"`
 }
 
+// HandleJoinGamble joins the active gamble, matching its bets
+// @Summary Join the active gamble
+// @Description Join the running gamble, paying the same lootbox bets as the starter
+// @Tags gamble
+// @Accept json
+// @Produce json
+// @Param request body JoinGambleRequest true "Participant details"
+// @Success 200 {object} SuccessResponse
+// @Failure 400 {object} ErrorResponse "Invalid request, no active gamble or missing bets"
+// @Failure 500 {object} ErrorResponse "Internal server error"
+// @Router /api/v1/gamble/join [post]
 func (h *GambleHandler) HandleJoinGamble(w http.ResponseWriter, r *http.Request) {
 	var req JoinGambleRequest
 	if err := DecodeAndValidateRequest(r, w, &req, "Join gamble"); err != nil {
@@ -92,8 +128,7 @@ func (h *GambleHandler) HandleJoinGamble(w http.ResponseWriter, r *http.Request)
 
 	if err := h.service.JoinActiveGamble(r.Context(), req.Platform, req.PlatformID, req.Username); err != nil {
 		logger.FromContext(r.Context()).Debug("Failed to join gamble", "error", err)
-		statusCode, userMsg := MapServiceErrorToUserMessage(err)
-		RespondError(w, statusCode, userMsg)
+		RespondMappedError(w, err)
 		return
 	}
 
@@ -107,7 +142,7 @@ func (h *GambleHandler) HandleJoinGamble(w http.ResponseWriter, r *http.Request)
 		)
 	}
 
-	RespondJSON(w, http.StatusOK, map[string]string{"message": MsgJoinedGambleSuccess})
+	RespondJSON(w, http.StatusOK, SuccessResponse{Message: MsgJoinedGambleSuccess})
 }
 
 func (h *GambleHandler) HandleGetGamble(w http.ResponseWriter, r *http.Request) {
@@ -124,8 +159,7 @@ func (h *GambleHandler) HandleGetGamble(w http.ResponseWriter, r *http.Request)
 	gamble, err := h.service.GetGamble(r.Context(), gambleID)
 	if err != nil {
 		logger.FromContext(r.Context()).Error("Failed to get gamble", "error", err)
-		statusCode, userMsg := MapServiceErrorToUserMessage(err)
-		RespondError(w, statusCode, userMsg)
+		RespondMappedError(w, err)
 		return
 	}
 	if gamble == nil {
@@ -140,8 +174,7 @@ func (h *GambleHandler) HandleGetActiveGamble(w http.ResponseWriter, r *http.Req
 	gamble, err := h.service.GetActiveGamble(r.Context())
 	if err != nil {
 		logger.FromContext(r.Context()).Error("Failed to get active gamble", "error", err)
-		statusCode, userMsg := MapServiceErrorToUserMessage(err)
-		RespondError(w, statusCode, userMsg)
+		RespondMappedError(w, err)
 		return
 	}
 
@@ -155,3 +188,151 @@ func (h *GambleHandler) HandleGetActiveGamble(w http.ResponseWriter, r *http.Req
 		"gamble": gamble,
 	})
 }
+
+// PlaceSideBetRequest stakes a spectator's money on a gamble participant
+type PlaceSideBetRequest struct {
+	Platform       string `json:"platform" validate:"required,platform"`
+	PlatformID     string `json:"platform_id" validate:"required"`
+	Username       string `json:"username" validate:"required"`
+	BackedUsername string `json:"backed_username" validate:"required"`
+	Amount         int    `json:"amount" validate:"min=1,max=500"` // gamble.MaxSideBetAmount
+}
+
+// HandlePlaceSideBet places a spectator's side bet on the gamble in the path
+func (h *GambleHandler) HandlePlaceSideBet(w http.ResponseWriter, r *http.Request) {
+	gambleID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		RespondError(w, http.StatusBadRequest, ErrMsgInvalidGambleID)
+		return
+	}
+
+	var req PlaceSideBetRequest
+	if err := DecodeAndValidateRequest(r, w, &req, "Place side bet"); err != nil {
+		return
+	}
+
+	bet, err := h.service.PlaceSideBet(r.Context(), gambleID, req.Platform, req.PlatformID, req.Username, req.BackedUsername, req.Amount)
+	if err != nil {
+		logger.FromContext(r.Context()).Debug("Failed to place side bet", "error", err)
+		RespondMappedError(w, err)
+		return
+	}
+
+	RespondJSON(w, http.StatusCreated, bet)
+}
+
+// HandleGetGambleOdds returns the side-bet pool and per-participant odds
+func (h *GambleHandler) HandleGetGambleOdds(w http.ResponseWriter, r *http.Request) {
+	gambleID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		RespondError(w, http.StatusBadRequest, ErrMsgInvalidGambleID)
+		return
+	}
+
+	odds, err := h.service.GetOdds(r.Context(), gambleID)
+	if err != nil {
+		if errors.Is(err, domain.ErrGambleNotFound) {
+			RespondError(w, http.StatusNotFound, ErrMsgGambleNotFoundHTTP)
+			return
+		}
+		RespondServiceError(w, r, "Failed to get gamble odds", err)
+		return
+	}
+
+	RespondJSON(w, http.StatusOK, odds)
+}
+
+// GambleWaitResponse is returned by the long-poll endpoint
+type GambleWaitResponse struct {
+	Changed bool           `json:"changed"`
+	Gamble  *domain.Gamble `json:"gamble"`
+}
+
+// HandleWaitGamble long-polls until the gamble changes state or the timeout elapses.
+// Clients pass their last known state and participant count; if the server-side
+// gamble already differs, the response is returned immediately.
+func (h *GambleHandler) HandleWaitGamble(w http.ResponseWriter, r *http.Request) {
+	gambleID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		RespondError(w, http.StatusBadRequest, ErrMsgInvalidGambleID)
+		return
+	}
+
+	timeout := GambleWaitDefaultTimeout
+	if raw := r.URL.Query().Get("timeout"); raw != "" {
+		secs, err := strconv.Atoi(raw)
+		if err != nil || secs <= 0 {
+			RespondError(w, http.StatusBadRequest, ErrMsgInvalidWaitTimeout)
+			return
+		}
+		timeout = time.Duration(secs) * time.Second
+		if timeout > GambleWaitMaxTimeout {
+			timeout = GambleWaitMaxTimeout
+		}
+	}
+
+	// Register before reading so a change between the read and the wait is not missed
+	var changed <-chan struct{}
+	if h.watcher != nil {
+		ch, cancel := h.watcher.Watch(gambleID)
+		defer cancel()
+		changed = ch
+	}
+
+	current, ok := h.fetchGamble(w, r, gambleID)
+	if !ok {
+		return
+	}
+
+	knownState := domain.GambleState(GetOptionalQueryParam(r, "state", string(current.State)))
+	knownCount := len(current.Participants)
+	if raw := r.URL.Query().Get("participants"); raw != "" {
+		if n, err := strconv.Atoi(raw); err == nil {
+			knownCount = n
+		}
+	}
+
+	if differs := gambleDiffers(current, knownState, knownCount); differs || isTerminalGambleState(current.State) {
+		RespondJSON(w, http.StatusOK, GambleWaitResponse{Changed: differs, Gamble: current})
+		return
+	}
+
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+
+	select {
+	case <-changed:
+	case <-timer.C:
+		RespondJSON(w, http.StatusOK, GambleWaitResponse{Changed: false, Gamble: current})
+		return
+	case <-r.Context().Done():
+		return
+	}
+
+	updated, ok := h.fetchGamble(w, r, gambleID)
+	if !ok {
+		return
+	}
+	RespondJSON(w, http.StatusOK, GambleWaitResponse{Changed: gambleDiffers(updated, knownState, knownCount), Gamble: updated})
+}
+
+func (h *GambleHandler) fetchGamble(w http.ResponseWriter, r *http.Request, gambleID uuid.UUID) (*domain.Gamble, bool) {
+	g, err := h.service.GetGamble(r.Context(), gambleID)
+	if err != nil {
+		RespondServiceError(w, r, "Failed to get gamble", err)
+		return nil, false
+	}
+	if g == nil {
+		RespondError(w, http.StatusNotFound, ErrMsgGambleNotFoundHTTP)
+		return nil, false
+	}
+	return g, true
+}
+
+func gambleDiffers(g *domain.Gamble, knownState domain.GambleState, knownCount int) bool {
+	return g.State != knownState || len(g.Participants) != knownCount
+}
+
+func isTerminalGambleState(state domain.GambleState) bool {
+	return state == domain.GambleStateCompleted || state == domain.GambleStateRefunded
+}
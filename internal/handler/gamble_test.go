@@ -2,17 +2,21 @@ package handler
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
+	"github.com/go-chi/chi/v5"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 
 	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/gamble"
 	"github.com/osse101/BrandishBot_Go/internal/progression"
 	"github.com/osse101/BrandishBot_Go/mocks"
 )
@@ -78,7 +82,7 @@ func TestHandleStartGamble(t *testing.T) {
 				mg.On("StartGamble", mock.Anything, "discord", "123", "testuser", mock.Anything).Return(&domain.Gamble{ID: uuid.MustParse("00000000-0000-0000-0000-000000000001")}, nil)
 			},
 			expectedStatus: http.StatusCreated,
-			expectedBody:   `"gamble_id":"00000000-0000-0000-0000-000000000001"`,
+			expectedBody:   `"gamble_id":"00000000-0000-0000-0000-000000000001","short_code":"G-0001"`,
 		},
 	}
 
@@ -89,7 +93,7 @@ func TestHandleStartGamble(t *testing.T) {
 			mockEventBus := mocks.NewMockEventBus(t)
 			mockEventBus.On("Publish", mock.Anything, mock.Anything).Return(nil).Maybe()
 			mockUser := mocks.NewMockUserService(t)
-			handler := NewGambleHandler(mockGamble, mockUser, mockProgression, mockEventBus)
+			handler := NewGambleHandler(mockGamble, mockUser, mockProgression, mockEventBus, nil)
 
 			mockProgression.On("RecordEngagement", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
 			if tt.setupMocks != nil {
@@ -171,7 +175,7 @@ func TestHandleJoinGamble(t *testing.T) {
 			// Progression service is not used in JoinGamble, so we can pass nil or a mock
 			mockUser := mocks.NewMockUserService(t)
 			mockUser.On("GetUserIDByPlatformID", mock.Anything, mock.Anything, mock.Anything).Return("", nil).Maybe()
-			handler := NewGambleHandler(mockGamble, mockUser, mockProg, mockEventBus)
+			handler := NewGambleHandler(mockGamble, mockUser, mockProg, mockEventBus, nil)
 
 			if tt.setupMocks != nil {
 				tt.setupMocks(mockGamble, mockUser)
@@ -256,7 +260,7 @@ func TestHandleGetGamble(t *testing.T) {
 			mockGamble := mocks.NewMockGambleService(t)
 			mockUser := mocks.NewMockUserService(t)
 			mockUser.On("GetUserIDByPlatformID", mock.Anything, mock.Anything, mock.Anything).Return("", nil).Maybe()
-			handler := NewGambleHandler(mockGamble, mockUser, mockProg, mockEventBus)
+			handler := NewGambleHandler(mockGamble, mockUser, mockProg, mockEventBus, nil)
 
 			if tt.setupMocks != nil {
 				tt.setupMocks(mockGamble, mockUser)
@@ -274,3 +278,174 @@ func TestHandleGetGamble(t *testing.T) {
 		})
 	}
 }
+
+func TestHandleWaitGamble(t *testing.T) {
+	gambleID := uuid.MustParse("00000000-0000-0000-0000-000000000002")
+	joining := &domain.Gamble{ID: gambleID, State: domain.GambleStateJoining, Participants: []domain.Participant{{UserID: "u1"}}}
+
+	newRequest := func(id, query string) *http.Request {
+		req := httptest.NewRequest("GET", "/gamble/"+id+"/wait?"+query, nil)
+		rctx := chi.NewRouteContext()
+		rctx.URLParams.Add("id", id)
+		return req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, rctx))
+	}
+
+	t.Run("Invalid ID", func(t *testing.T) {
+		h := NewGambleHandler(mocks.NewMockGambleService(t), nil, nil, nil, gamble.NewWatcher())
+		rec := httptest.NewRecorder()
+		h.HandleWaitGamble(rec, newRequest("not-a-uuid", ""))
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("Invalid Timeout", func(t *testing.T) {
+		h := NewGambleHandler(mocks.NewMockGambleService(t), nil, nil, nil, gamble.NewWatcher())
+		rec := httptest.NewRecorder()
+		h.HandleWaitGamble(rec, newRequest(gambleID.String(), "timeout=abc"))
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("Not Found", func(t *testing.T) {
+		mockGamble := mocks.NewMockGambleService(t)
+		mockGamble.On("GetGamble", mock.Anything, gambleID).Return(nil, nil)
+		h := NewGambleHandler(mockGamble, nil, nil, nil, gamble.NewWatcher())
+		rec := httptest.NewRecorder()
+		h.HandleWaitGamble(rec, newRequest(gambleID.String(), ""))
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+	})
+
+	t.Run("Already Changed Returns Immediately", func(t *testing.T) {
+		mockGamble := mocks.NewMockGambleService(t)
+		mockGamble.On("GetGamble", mock.Anything, gambleID).Return(joining, nil)
+		h := NewGambleHandler(mockGamble, nil, nil, nil, gamble.NewWatcher())
+		rec := httptest.NewRecorder()
+		h.HandleWaitGamble(rec, newRequest(gambleID.String(), "state=Joining&participants=0"))
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Contains(t, rec.Body.String(), `"changed":true`)
+	})
+
+	t.Run("Wakes On Notify", func(t *testing.T) {
+		completed := &domain.Gamble{ID: gambleID, State: domain.GambleStateCompleted, Participants: joining.Participants}
+		mockGamble := mocks.NewMockGambleService(t)
+		mockGamble.On("GetGamble", mock.Anything, gambleID).Return(joining, nil).Once()
+		mockGamble.On("GetGamble", mock.Anything, gambleID).Return(completed, nil).Once()
+
+		watcher := gamble.NewWatcher()
+		h := NewGambleHandler(mockGamble, nil, nil, nil, watcher)
+
+		go func() {
+			for watcher.WaiterCount(gambleID) == 0 {
+				time.Sleep(5 * time.Millisecond)
+			}
+			watcher.Notify(gambleID)
+		}()
+
+		rec := httptest.NewRecorder()
+		h.HandleWaitGamble(rec, newRequest(gambleID.String(), "timeout=5"))
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Contains(t, rec.Body.String(), `"changed":true`)
+		assert.Contains(t, rec.Body.String(), `"state":"Completed"`)
+	})
+
+	t.Run("Times Out Unchanged", func(t *testing.T) {
+		mockGamble := mocks.NewMockGambleService(t)
+		mockGamble.On("GetGamble", mock.Anything, gambleID).Return(joining, nil)
+		h := NewGambleHandler(mockGamble, nil, nil, nil, gamble.NewWatcher())
+		rec := httptest.NewRecorder()
+		h.HandleWaitGamble(rec, newRequest(gambleID.String(), "timeout=1"))
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Contains(t, rec.Body.String(), `"changed":false`)
+	})
+}
+
+func TestHandlePlaceSideBet(t *testing.T) {
+	gambleID := uuid.MustParse("00000000-0000-0000-0000-000000000003")
+	valid := PlaceSideBetRequest{
+		Platform:       "discord",
+		PlatformID:     "123",
+		Username:       "spectator",
+		BackedUsername: "alice",
+		Amount:         50,
+	}
+
+	newRequest := func(id string, body interface{}) *http.Request {
+		raw, _ := json.Marshal(body)
+		req := httptest.NewRequest("POST", "/gamble/"+id+"/side-bet", bytes.NewBuffer(raw))
+		rctx := chi.NewRouteContext()
+		rctx.URLParams.Add("id", id)
+		return req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, rctx))
+	}
+
+	t.Run("Invalid ID", func(t *testing.T) {
+		h := NewGambleHandler(mocks.NewMockGambleService(t), nil, nil, nil, nil)
+		rec := httptest.NewRecorder()
+		h.HandlePlaceSideBet(rec, newRequest("not-a-uuid", valid))
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("Amount Over Cap", func(t *testing.T) {
+		h := NewGambleHandler(mocks.NewMockGambleService(t), nil, nil, nil, nil)
+		req := valid
+		req.Amount = gamble.MaxSideBetAmount + 1
+		rec := httptest.NewRecorder()
+		h.HandlePlaceSideBet(rec, newRequest(gambleID.String(), req))
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("Participant Cannot Side-Bet", func(t *testing.T) {
+		mg := mocks.NewMockGambleService(t)
+		mg.On("PlaceSideBet", mock.Anything, gambleID, "discord", "123", "spectator", "alice", 50).Return(nil, domain.ErrParticipantCannotSideBet)
+		h := NewGambleHandler(mg, nil, nil, nil, nil)
+		rec := httptest.NewRecorder()
+		h.HandlePlaceSideBet(rec, newRequest(gambleID.String(), valid))
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		assert.Contains(t, rec.Body.String(), ErrMsgParticipantSideBetError)
+	})
+
+	t.Run("Success", func(t *testing.T) {
+		mg := mocks.NewMockGambleService(t)
+		mg.On("PlaceSideBet", mock.Anything, gambleID, "discord", "123", "spectator", "alice", 50).
+			Return(&domain.SideBet{GambleID: gambleID, BackedUsername: "alice", Amount: 50}, nil)
+		h := NewGambleHandler(mg, nil, nil, nil, nil)
+		rec := httptest.NewRecorder()
+		h.HandlePlaceSideBet(rec, newRequest(gambleID.String(), valid))
+		assert.Equal(t, http.StatusCreated, rec.Code)
+		assert.Contains(t, rec.Body.String(), `"backed_username":"alice"`)
+	})
+}
+
+func TestHandleGetGambleOdds(t *testing.T) {
+	gambleID := uuid.MustParse("00000000-0000-0000-0000-000000000004")
+
+	newRequest := func(id string) *http.Request {
+		req := httptest.NewRequest("GET", "/gamble/"+id+"/odds", nil)
+		rctx := chi.NewRouteContext()
+		rctx.URLParams.Add("id", id)
+		return req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, rctx))
+	}
+
+	t.Run("Not Found", func(t *testing.T) {
+		mg := mocks.NewMockGambleService(t)
+		mg.On("GetOdds", mock.Anything, gambleID).Return(nil, domain.ErrGambleNotFound)
+		h := NewGambleHandler(mg, nil, nil, nil, nil)
+		rec := httptest.NewRecorder()
+		h.HandleGetGambleOdds(rec, newRequest(gambleID.String()))
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+	})
+
+	t.Run("Success", func(t *testing.T) {
+		mg := mocks.NewMockGambleService(t)
+		mg.On("GetOdds", mock.Anything, gambleID).Return(&domain.GambleOdds{
+			GambleID: gambleID,
+			Pool:     300,
+			Participants: []domain.ParticipantOdds{
+				{UserID: "u1", Username: "alice", Staked: 100, Bettors: 1, Multiplier: 3},
+			},
+		}, nil)
+		h := NewGambleHandler(mg, nil, nil, nil, nil)
+		rec := httptest.NewRecorder()
+		h.HandleGetGambleOdds(rec, newRequest(gambleID.String()))
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Contains(t, rec.Body.String(), `"pool":300`)
+		assert.Contains(t, rec.Body.String(), `"multiplier":3`)
+	})
+}
@@ -0,0 +1,37 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/osse101/BrandishBot_Go/internal/gameevent"
+	"github.com/osse101/BrandishBot_Go/internal/logger"
+)
+
+// GameEventHandler reports the game events running now
+type GameEventHandler struct {
+	service gameevent.Service
+}
+
+// NewGameEventHandler creates a new game event handler
+func NewGameEventHandler(service gameevent.Service) *GameEventHandler {
+	return &GameEventHandler{service: service}
+}
+
+// HandleGetActive reports the running game events and their combined modifiers
+// @Summary Get active game events
+// @Description The global events running now, such as a double XP weekend, and what they add up to: the XP and drop rate multipliers and the shop discount.
+// @Tags events
+// @Produce json
+// @Success 200 {object} domain.GameEventStatus
+// @Failure 500 {object} ErrorResponse
+// @Router /api/v1/game-events [get]
+func (h *GameEventHandler) HandleGetActive(w http.ResponseWriter, r *http.Request) {
+	status, err := h.service.GetStatus(r.Context())
+	if err != nil {
+		logger.FromContext(r.Context()).Error("Failed to get game events", "error", err)
+		RespondError(w, http.StatusInternalServerError, ErrMsgGetGameEventsFailed)
+		return
+	}
+
+	RespondJSON(w, http.StatusOK, status)
+}
@@ -0,0 +1,41 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/mocks"
+)
+
+func TestGameEventHandler_HandleGetActive(t *testing.T) {
+	t.Run("reports the running events", func(t *testing.T) {
+		svc := mocks.NewMockGameEventService(t)
+		svc.On("GetStatus", mock.Anything).Return(&domain.GameEventStatus{
+			Events:    []domain.GameEvent{{ID: 1, Name: "Double XP Weekend", XPMultiplier: 2, DropRateMultiplier: 1}},
+			Modifiers: domain.GameModifiers{XPMultiplier: 2, DropRateMultiplier: 1, Events: []string{"Double XP Weekend"}},
+		}, nil)
+
+		rec := httptest.NewRecorder()
+		NewGameEventHandler(svc).HandleGetActive(rec, httptest.NewRequest(http.MethodGet, "/game-events", nil))
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Contains(t, rec.Body.String(), `"name":"Double XP Weekend"`)
+		assert.Contains(t, rec.Body.String(), `"xp_multiplier":2`)
+	})
+
+	t.Run("service failure", func(t *testing.T) {
+		svc := mocks.NewMockGameEventService(t)
+		svc.On("GetStatus", mock.Anything).Return(nil, errors.New("db down"))
+
+		rec := httptest.NewRecorder()
+		NewGameEventHandler(svc).HandleGetActive(rec, httptest.NewRequest(http.MethodGet, "/game-events", nil))
+
+		assert.Equal(t, http.StatusInternalServerError, rec.Code)
+	})
+}
@@ -0,0 +1,146 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/garden"
+	"github.com/osse101/BrandishBot_Go/internal/logger"
+)
+
+// PlantRequest asks to plant a seed in the user's garden
+type PlantRequest struct {
+	Platform   string `json:"platform" validate:"required,platform"`
+	PlatformID string `json:"platform_id" validate:"required"`
+	Username   string `json:"username" validate:"required,max=100,excludesall=\x00\n\r\t"`
+	Seed       string `json:"seed" validate:"required,max=100"`
+}
+
+// GardenHarvestRequest asks to harvest the user's ready garden plots
+type GardenHarvestRequest struct {
+	Platform   string `json:"platform" validate:"required,platform"`
+	PlatformID string `json:"platform_id" validate:"required"`
+	Username   string `json:"username" validate:"required,max=100,excludesall=\x00\n\r\t"`
+}
+
+// GardenHandler handles the users' gardens
+type GardenHandler struct {
+	service garden.Service
+}
+
+// NewGardenHandler creates a new garden handler
+func NewGardenHandler(service garden.Service) *GardenHandler {
+	return &GardenHandler{service: service}
+}
+
+// HandleGetGarden returns the user's garden
+// @Summary Get garden
+// @Description The user's planted plots, each marked ready once its crop has grown, and how many plots they can plant. Garden plot upgrades add plots on top of the base two.
+// @Tags user
+// @Produce json
+// @Param platform query string true "Platform"
+// @Param platform_id query string true "Platform user ID"
+// @Success 200 {object} domain.Garden
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /api/v1/user/garden [get]
+func (h *GardenHandler) HandleGetGarden(w http.ResponseWriter, r *http.Request) {
+	platform, platformID, ok := platformUserParams(r, w)
+	if !ok {
+		return
+	}
+
+	g, err := h.service.GetGarden(r.Context(), platform, platformID)
+	if err != nil {
+		if errors.Is(err, domain.ErrUserNotFound) {
+			RespondError(w, http.StatusNotFound, ErrMsgUserNotFoundHTTP)
+			return
+		}
+		logger.FromContext(r.Context()).Error("Failed to get garden", "error", err, "platform", platform)
+		RespondError(w, http.StatusInternalServerError, ErrMsgGetGardenFailed)
+		return
+	}
+
+	if g.Plots == nil {
+		g.Plots = []domain.GardenPlot{}
+	}
+	RespondJSON(w, http.StatusOK, g)
+}
+
+// HandlePlant plants a seed in the user's garden
+// @Summary Plant a seed
+// @Description Uses up one seed item and plants it in the user's lowest free plot. The crop is ready to harvest once the seed's grow time has passed.
+// @Tags user
+// @Accept json
+// @Produce json
+// @Param request body PlantRequest true "Seed to plant"
+// @Success 201 {object} domain.GardenPlot
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse "Every plot is planted"
+// @Failure 500 {object} ErrorResponse
+// @Router /api/v1/user/garden/plant [post]
+func (h *GardenHandler) HandlePlant(w http.ResponseWriter, r *http.Request) {
+	var req PlantRequest
+	if err := DecodeAndValidateRequest(r, w, &req, "Plant"); err != nil {
+		return
+	}
+
+	plot, err := h.service.Plant(r.Context(), req.Platform, req.PlatformID, req.Username, req.Seed)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrGardenFull):
+			RespondError(w, http.StatusConflict, domain.ErrMsgGardenFull)
+		case errors.Is(err, domain.ErrUnknownSeed):
+			RespondError(w, http.StatusBadRequest, err.Error())
+		case errors.Is(err, domain.ErrFeatureLocked),
+			errors.Is(err, domain.ErrNotInInventory),
+			errors.Is(err, domain.ErrInsufficientQuantity),
+			errors.Is(err, domain.ErrUserNotFound):
+			RespondMappedError(w, err)
+		default:
+			logger.FromContext(r.Context()).Error("Failed to plant", "error", err, "platform", req.Platform)
+			RespondError(w, http.StatusInternalServerError, ErrMsgPlantFailed)
+		}
+		return
+	}
+
+	RespondJSON(w, http.StatusCreated, plot)
+}
+
+// HandleHarvestGarden harvests the user's ready garden plots
+// @Summary Harvest garden
+// @Description Clears every plot whose crop has grown and puts the crops in the user's inventory. Awards Farmer XP.
+// @Tags user
+// @Accept json
+// @Produce json
+// @Param request body GardenHarvestRequest true "Gardener"
+// @Success 200 {object} domain.GardenHarvest
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse "Nothing is ready to harvest"
+// @Failure 500 {object} ErrorResponse
+// @Router /api/v1/user/garden/harvest [post]
+func (h *GardenHandler) HandleHarvestGarden(w http.ResponseWriter, r *http.Request) {
+	var req GardenHarvestRequest
+	if err := DecodeAndValidateRequest(r, w, &req, "Harvest garden"); err != nil {
+		return
+	}
+
+	result, err := h.service.Harvest(r.Context(), req.Platform, req.PlatformID, req.Username)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrNothingToHarvest):
+			RespondError(w, http.StatusNotFound, domain.ErrMsgNothingToHarvest)
+		case errors.Is(err, domain.ErrUserNotFound):
+			RespondMappedError(w, err)
+		default:
+			logger.FromContext(r.Context()).Error("Failed to harvest garden", "error", err, "platform", req.Platform)
+			RespondError(w, http.StatusInternalServerError, ErrMsgHarvestGardenFailed)
+		}
+		return
+	}
+
+	RespondJSON(w, http.StatusOK, result)
+}
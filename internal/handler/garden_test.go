@@ -0,0 +1,135 @@
+package handler
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/mocks"
+)
+
+func TestGardenHandler_HandleGetGarden(t *testing.T) {
+	get := func(h *GardenHandler, query string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, "/user/garden"+query, nil)
+		rec := httptest.NewRecorder()
+		h.HandleGetGarden(rec, req)
+		return rec
+	}
+
+	t.Run("returns the garden", func(t *testing.T) {
+		svc := mocks.NewMockGardenService(t)
+		svc.On("GetGarden", mock.Anything, "discord", "d-1").Return(&domain.Garden{UserID: "u-1", Capacity: 2}, nil)
+
+		rec := get(NewGardenHandler(svc), "?platform=discord&platform_id=d-1")
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Contains(t, rec.Body.String(), `"capacity":2`)
+		assert.Contains(t, rec.Body.String(), `"plots":[]`)
+	})
+
+	t.Run("unknown user is not found", func(t *testing.T) {
+		svc := mocks.NewMockGardenService(t)
+		svc.On("GetGarden", mock.Anything, "discord", "d-1").Return(nil, domain.ErrUserNotFound)
+
+		rec := get(NewGardenHandler(svc), "?platform=discord&platform_id=d-1")
+
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+	})
+
+	t.Run("needs the user", func(t *testing.T) {
+		rec := get(NewGardenHandler(mocks.NewMockGardenService(t)), "?platform=discord")
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+}
+
+func TestGardenHandler_HandlePlant(t *testing.T) {
+	post := func(h *GardenHandler, body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/user/garden/plant", bytes.NewBufferString(body))
+		rec := httptest.NewRecorder()
+		h.HandlePlant(rec, req)
+		return rec
+	}
+
+	t.Run("plants", func(t *testing.T) {
+		svc := mocks.NewMockGardenService(t)
+		svc.On("Plant", mock.Anything, "discord", "d-1", "alice", "wheat seed").Return(&domain.GardenPlot{Slot: 1, Seed: "seed_wheat", Crop: "crop_wheat", Quantity: 3}, nil)
+
+		rec := post(NewGardenHandler(svc), `{"platform":"discord","platform_id":"d-1","username":"alice","seed":"wheat seed"}`)
+
+		assert.Equal(t, http.StatusCreated, rec.Code)
+		assert.Contains(t, rec.Body.String(), `"crop":"crop_wheat"`)
+	})
+
+	t.Run("a full garden is a conflict", func(t *testing.T) {
+		svc := mocks.NewMockGardenService(t)
+		svc.On("Plant", mock.Anything, mock.Anything, mock.Anything, mock.Anything, "seed_wheat").Return(nil, domain.ErrGardenFull)
+
+		rec := post(NewGardenHandler(svc), `{"platform":"discord","platform_id":"d-1","username":"alice","seed":"seed_wheat"}`)
+
+		assert.Equal(t, http.StatusConflict, rec.Code)
+	})
+
+	t.Run("unknown seed is a bad request", func(t *testing.T) {
+		svc := mocks.NewMockGardenService(t)
+		svc.On("Plant", mock.Anything, mock.Anything, mock.Anything, mock.Anything, "money").Return(nil, fmt.Errorf("%w: money", domain.ErrUnknownSeed))
+
+		rec := post(NewGardenHandler(svc), `{"platform":"discord","platform_id":"d-1","username":"alice","seed":"money"}`)
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("needs a seed", func(t *testing.T) {
+		rec := post(NewGardenHandler(mocks.NewMockGardenService(t)), `{"platform":"discord","platform_id":"d-1","username":"alice"}`)
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+}
+
+func TestGardenHandler_HandleHarvestGarden(t *testing.T) {
+	post := func(h *GardenHandler, body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/user/garden/harvest", bytes.NewBufferString(body))
+		rec := httptest.NewRecorder()
+		h.HandleHarvestGarden(rec, req)
+		return rec
+	}
+
+	t.Run("harvests", func(t *testing.T) {
+		svc := mocks.NewMockGardenService(t)
+		svc.On("Harvest", mock.Anything, "discord", "d-1", "alice").Return(&domain.GardenHarvest{
+			Crops: []domain.GardenCrop{{ItemName: "crop_wheat", Quantity: 3}},
+			Plots: 1,
+			XP:    5,
+		}, nil)
+
+		rec := post(NewGardenHandler(svc), `{"platform":"discord","platform_id":"d-1","username":"alice"}`)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Contains(t, rec.Body.String(), `"crop_wheat"`)
+	})
+
+	t.Run("nothing ready is not found", func(t *testing.T) {
+		svc := mocks.NewMockGardenService(t)
+		svc.On("Harvest", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil, domain.ErrNothingToHarvest)
+
+		rec := post(NewGardenHandler(svc), `{"platform":"discord","platform_id":"d-1","username":"alice"}`)
+
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+	})
+
+	t.Run("fails", func(t *testing.T) {
+		svc := mocks.NewMockGardenService(t)
+		svc.On("Harvest", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil, errors.New("db down"))
+
+		rec := post(NewGardenHandler(svc), `{"platform":"discord","platform_id":"d-1","username":"alice"}`)
+
+		assert.Equal(t, http.StatusInternalServerError, rec.Code)
+	})
+}
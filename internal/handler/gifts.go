@@ -0,0 +1,185 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/gift"
+	"github.com/osse101/BrandishBot_Go/internal/logger"
+)
+
+// WrapGiftRequest asks to wrap items as a gift for another user on the same
+// platform
+type WrapGiftRequest struct {
+	Platform   string `json:"platform" validate:"required,platform"`
+	PlatformID string `json:"platform_id" validate:"required"`
+	Username   string `json:"username" validate:"required,max=100,excludesall=\x00\n\r\t"`
+	Recipient  string `json:"recipient" validate:"required,max=100,excludesall=\x00\n\r\t"`
+	ItemName   string `json:"item_name" validate:"required,max=100"`
+	Quantity   int    `json:"quantity" validate:"min=1,max=10000"`
+	Message    string `json:"message" validate:"max=200"`
+	// DeliverAt schedules the delivery; omitted delivers on the next sweep
+	DeliverAt *time.Time `json:"deliver_at,omitempty"`
+	// ChannelID is where the delivery is announced; omitted announces it to
+	// the recipient directly
+	ChannelID string `json:"channel_id" validate:"max=100"`
+}
+
+// GiftsResponse lists the gifts a user has wrapped that are not yet delivered
+type GiftsResponse struct {
+	Gifts []domain.Gift `json:"gifts"`
+}
+
+// GiftHandler handles wrapped gifts between users
+type GiftHandler struct {
+	service gift.Service
+}
+
+// NewGiftHandler creates a new gift handler
+func NewGiftHandler(service gift.Service) *GiftHandler {
+	return &GiftHandler{service: service}
+}
+
+// HandleGetGifts lists the user's undelivered gifts
+// @Summary List sent gifts
+// @Tags inventory
+// @Produce json
+// @Param platform query string true "Platform"
+// @Param platform_id query string true "Platform user ID"
+// @Success 200 {object} GiftsResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /api/v1/user/gifts [get]
+func (h *GiftHandler) HandleGetGifts(w http.ResponseWriter, r *http.Request) {
+	platform, platformID, ok := platformUserParams(r, w)
+	if !ok {
+		return
+	}
+
+	gifts, err := h.service.GetSentGifts(r.Context(), platform, platformID)
+	if err != nil {
+		if errors.Is(err, domain.ErrUserNotFound) {
+			RespondError(w, http.StatusNotFound, ErrMsgUserNotFoundHTTP)
+			return
+		}
+		logger.FromContext(r.Context()).Error("Failed to get gifts", "error", err, "platform", platform)
+		RespondError(w, http.StatusInternalServerError, ErrMsgGetGiftsFailed)
+		return
+	}
+
+	if gifts == nil {
+		gifts = []domain.Gift{}
+	}
+	RespondJSON(w, http.StatusOK, GiftsResponse{Gifts: gifts})
+}
+
+// HandleWrapGift wraps items as a gift for another user
+// @Summary Wrap gift
+// @Description Takes items from the sender and delivers them to the recipient at the chosen time, with an optional message. Gifts count toward the daily give limit; money, borrowed and locked items cannot be gifted. Publishes a "gift.delivered" event on delivery.
+// @Tags inventory
+// @Accept json
+// @Produce json
+// @Param request body WrapGiftRequest true "Gift"
+// @Success 201 {object} domain.Gift
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse "Too many gifts waiting to be delivered"
+// @Failure 429 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /api/v1/user/gifts [post]
+func (h *GiftHandler) HandleWrapGift(w http.ResponseWriter, r *http.Request) {
+	var req WrapGiftRequest
+	if err := DecodeAndValidateRequest(r, w, &req, "Wrap gift"); err != nil {
+		return
+	}
+
+	wrapped, err := h.service.WrapGift(r.Context(), gift.WrapRequest{
+		Platform:   req.Platform,
+		PlatformID: req.PlatformID,
+		Username:   req.Username,
+		Recipient:  req.Recipient,
+		ItemName:   req.ItemName,
+		Quantity:   req.Quantity,
+		Message:    req.Message,
+		DeliverAt:  req.DeliverAt,
+		ChannelID:  req.ChannelID,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrTooManyGifts):
+			RespondError(w, http.StatusConflict, err.Error())
+			return
+		case errors.Is(err, domain.ErrCannotGiftSelf),
+			errors.Is(err, domain.ErrInvalidGiftDelivery),
+			errors.Is(err, domain.ErrInvalidInput):
+			RespondError(w, http.StatusBadRequest, err.Error())
+			return
+		case errors.Is(err, domain.ErrUserNotFound),
+			errors.Is(err, domain.ErrItemNotFound),
+			errors.Is(err, domain.ErrItemBorrowed),
+			errors.Is(err, domain.ErrItemLockedByUser),
+			errors.Is(err, domain.ErrInsufficientQuantity),
+			errors.Is(err, domain.ErrNotInInventory),
+			errors.Is(err, domain.ErrDailyGiveLimitReached),
+			errors.Is(err, domain.ErrAccountTooNewToGive):
+			RespondMappedError(w, err)
+			return
+		}
+		logger.FromContext(r.Context()).Error("Failed to wrap gift", "error", err, "platform", req.Platform, "item", req.ItemName)
+		RespondError(w, http.StatusInternalServerError, ErrMsgWrapGiftFailed)
+		return
+	}
+
+	RespondJSON(w, http.StatusCreated, wrapped)
+}
+
+// HandleCancelGift unwraps an undelivered gift
+// @Summary Cancel gift
+// @Description Only the sender can cancel a gift, and only before it is delivered. The items go back to the sender.
+// @Tags inventory
+// @Produce json
+// @Param id path int true "Gift ID"
+// @Param platform query string true "Platform"
+// @Param platform_id query string true "Platform user ID"
+// @Success 200 {object} domain.Gift
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /api/v1/user/gifts/{id} [delete]
+func (h *GiftHandler) HandleCancelGift(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil || id <= 0 {
+		RespondError(w, http.StatusBadRequest, ErrMsgInvalidGiftID)
+		return
+	}
+	platform, platformID, ok := platformUserParams(r, w)
+	if !ok {
+		return
+	}
+
+	cancelled, err := h.service.CancelGift(r.Context(), platform, platformID, id)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrUserNotFound):
+			RespondError(w, http.StatusNotFound, ErrMsgUserNotFoundHTTP)
+			return
+		case errors.Is(err, domain.ErrGiftNotFound):
+			RespondError(w, http.StatusNotFound, ErrMsgGiftNotFoundHTTP)
+			return
+		case errors.Is(err, domain.ErrInventoryFull):
+			RespondMappedError(w, err)
+			return
+		}
+		logger.FromContext(r.Context()).Error("Failed to cancel gift", "error", err, "platform", platform, "gift_id", id)
+		RespondError(w, http.StatusInternalServerError, ErrMsgCancelGiftFailed)
+		return
+	}
+
+	RespondJSON(w, http.StatusOK, cancelled)
+}
@@ -0,0 +1,93 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/gift"
+	"github.com/osse101/BrandishBot_Go/mocks"
+)
+
+func TestGiftHandler_HandleWrapGift(t *testing.T) {
+	post := func(h *GiftHandler, body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/user/gifts", bytes.NewBufferString(body))
+		rec := httptest.NewRecorder()
+		h.HandleWrapGift(rec, req)
+		return rec
+	}
+
+	t.Run("wraps the gift", func(t *testing.T) {
+		svc := mocks.NewMockGiftService(t)
+		svc.On("WrapGift", mock.Anything, mock.MatchedBy(func(req gift.WrapRequest) bool {
+			return req.Recipient == "bob" && req.Message == "enjoy" && req.DeliverAt != nil && req.DeliverAt.Hour() == 18
+		})).Return(&domain.Gift{ID: 4, Status: domain.GiftStatusPending}, nil)
+
+		rec := post(NewGiftHandler(svc), `{"platform":"discord","platform_id":"d-1","username":"alice","recipient":"bob","item_name":"sword","quantity":1,"message":"enjoy","deliver_at":"2026-12-24T18:00:00Z"}`)
+
+		assert.Equal(t, http.StatusCreated, rec.Code)
+		assert.Contains(t, rec.Body.String(), `"id":4`)
+	})
+
+	t.Run("too many pending gifts is a conflict", func(t *testing.T) {
+		svc := mocks.NewMockGiftService(t)
+		svc.On("WrapGift", mock.Anything, mock.Anything).Return(nil, domain.ErrTooManyGifts)
+
+		rec := post(NewGiftHandler(svc), `{"platform":"discord","platform_id":"d-1","username":"alice","recipient":"bob","item_name":"sword","quantity":1}`)
+
+		assert.Equal(t, http.StatusConflict, rec.Code)
+	})
+
+	t.Run("the daily give limit applies", func(t *testing.T) {
+		svc := mocks.NewMockGiftService(t)
+		svc.On("WrapGift", mock.Anything, mock.Anything).Return(nil, domain.ErrDailyGiveLimitReached)
+
+		rec := post(NewGiftHandler(svc), `{"platform":"discord","platform_id":"d-1","username":"alice","recipient":"bob","item_name":"sword","quantity":1}`)
+
+		assert.Equal(t, http.StatusTooManyRequests, rec.Code)
+	})
+}
+
+func TestGiftHandler_HandleCancelGift(t *testing.T) {
+	cancel := func(h *GiftHandler, id string) *httptest.ResponseRecorder {
+		rctx := chi.NewRouteContext()
+		rctx.URLParams.Add("id", id)
+		req := httptest.NewRequest(http.MethodDelete, "/user/gifts/"+id+"?platform=discord&platform_id=d-1", nil)
+		req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, rctx))
+		rec := httptest.NewRecorder()
+		h.HandleCancelGift(rec, req)
+		return rec
+	}
+
+	t.Run("cancels the gift", func(t *testing.T) {
+		svc := mocks.NewMockGiftService(t)
+		svc.On("CancelGift", mock.Anything, domain.PlatformDiscord, "d-1", int64(4)).Return(&domain.Gift{ID: 4, Status: domain.GiftStatusCancelled}, nil)
+
+		rec := cancel(NewGiftHandler(svc), "4")
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Contains(t, rec.Body.String(), `"status":"cancelled"`)
+	})
+
+	t.Run("unknown gift", func(t *testing.T) {
+		svc := mocks.NewMockGiftService(t)
+		svc.On("CancelGift", mock.Anything, domain.PlatformDiscord, "d-1", int64(4)).Return(nil, domain.ErrGiftNotFound)
+
+		rec := cancel(NewGiftHandler(svc), "4")
+
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+	})
+
+	t.Run("rejects invalid IDs", func(t *testing.T) {
+		rec := cancel(NewGiftHandler(mocks.NewMockGiftService(t)), "abc")
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+}
@@ -71,8 +71,7 @@ func (h *HarvestHandler) Harvest(w http.ResponseWriter, r *http.Request) {
 		}
 
 		log.Error("Harvest failed", "error", err, "username", req.Username, "platform", req.Platform)
-		statusCode, userMsg := MapServiceErrorToUserMessage(err)
-		RespondError(w, statusCode, userMsg)
+		RespondMappedError(w, err)
 		return
 	}
 
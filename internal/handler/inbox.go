@@ -0,0 +1,114 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/inbox"
+	"github.com/osse101/BrandishBot_Go/internal/logger"
+)
+
+// MarkInboxReadRequest marks messages in the user's inbox read
+type MarkInboxReadRequest struct {
+	Platform   string `json:"platform" validate:"required,platform"`
+	PlatformID string `json:"platform_id" validate:"required"`
+	// IDs are the messages to mark; empty marks every message
+	IDs []int64 `json:"ids" validate:"max=100,dive,min=1"`
+}
+
+// MarkInboxReadResponse reports how many messages were newly marked read
+type MarkInboxReadResponse struct {
+	Marked int `json:"marked"`
+}
+
+// InboxHandler handles users' inboxes
+type InboxHandler struct {
+	service inbox.Service
+}
+
+// NewInboxHandler creates a new inbox handler
+func NewInboxHandler(service inbox.Service) *InboxHandler {
+	return &InboxHandler{service: service}
+}
+
+// HandleGetInbox returns the user's newest inbox messages
+// @Summary Get inbox
+// @Description The user's newest notifications, such as delivered gifts, market sales, returned loans, boss loot, celebrations and unlocked milestones, with how many are unread.
+// @Tags user
+// @Produce json
+// @Param platform query string true "Platform"
+// @Param platform_id query string true "Platform user ID"
+// @Param unread query bool false "Only unread messages"
+// @Param limit query int false "Number of messages (default 20, max 50)"
+// @Success 200 {object} domain.Inbox
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /api/v1/user/inbox [get]
+func (h *InboxHandler) HandleGetInbox(w http.ResponseWriter, r *http.Request) {
+	platform, platformID, ok := platformUserParams(r, w)
+	if !ok {
+		return
+	}
+
+	limit := inbox.DefaultPageSize
+	if limitStr := r.URL.Query().Get("limit"); limitStr != "" {
+		parsed, err := strconv.Atoi(limitStr)
+		if err != nil || parsed < 1 || parsed > inbox.MaxPageSize {
+			RespondError(w, http.StatusBadRequest, ErrMsgInvalidLimit)
+			return
+		}
+		limit = parsed
+	}
+	unreadOnly := r.URL.Query().Get("unread") == "true"
+
+	result, err := h.service.GetInbox(r.Context(), platform, platformID, unreadOnly, limit)
+	if err != nil {
+		if errors.Is(err, domain.ErrUserNotFound) {
+			RespondError(w, http.StatusNotFound, ErrMsgUserNotFoundHTTP)
+			return
+		}
+		logger.FromContext(r.Context()).Error("Failed to get inbox", "error", err, "platform", platform)
+		RespondError(w, http.StatusInternalServerError, ErrMsgGetInboxFailed)
+		return
+	}
+
+	if result.Messages == nil {
+		result.Messages = []domain.InboxMessage{}
+	}
+	RespondJSON(w, http.StatusOK, result)
+}
+
+// HandleMarkInboxRead marks inbox messages read
+// @Summary Mark inbox messages read
+// @Description Marks the given messages read, or every message when no IDs are given. Messages that are already read or belong to someone else are skipped.
+// @Tags user
+// @Accept json
+// @Produce json
+// @Param request body MarkInboxReadRequest true "Messages to mark"
+// @Success 200 {object} MarkInboxReadResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /api/v1/user/inbox/read [post]
+func (h *InboxHandler) HandleMarkInboxRead(w http.ResponseWriter, r *http.Request) {
+	var req MarkInboxReadRequest
+	if err := DecodeAndValidateRequest(r, w, &req, "Mark inbox read"); err != nil {
+		return
+	}
+
+	marked, err := h.service.MarkRead(r.Context(), req.Platform, req.PlatformID, req.IDs)
+	if err != nil {
+		if errors.Is(err, domain.ErrUserNotFound) {
+			RespondError(w, http.StatusNotFound, ErrMsgUserNotFoundHTTP)
+			return
+		}
+		logger.FromContext(r.Context()).Error("Failed to mark inbox read", "error", err, "platform", req.Platform)
+		RespondError(w, http.StatusInternalServerError, ErrMsgMarkInboxReadFailed)
+		return
+	}
+
+	RespondJSON(w, http.StatusOK, MarkInboxReadResponse{Marked: marked})
+}
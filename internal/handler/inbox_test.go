@@ -0,0 +1,94 @@
+package handler
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/inbox"
+	"github.com/osse101/BrandishBot_Go/mocks"
+)
+
+func TestInboxHandler_HandleGetInbox(t *testing.T) {
+	get := func(h *InboxHandler, query string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, "/user/inbox?platform=discord&platform_id=d-1"+query, nil)
+		rec := httptest.NewRecorder()
+		h.HandleGetInbox(rec, req)
+		return rec
+	}
+
+	t.Run("returns the default page", func(t *testing.T) {
+		svc := mocks.NewMockInboxService(t)
+		svc.On("GetInbox", mock.Anything, domain.PlatformDiscord, "d-1", false, inbox.DefaultPageSize).Return(&domain.Inbox{Unread: 0}, nil)
+
+		rec := get(NewInboxHandler(svc), "")
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.JSONEq(t, `{"messages":[],"unread":0}`, rec.Body.String())
+	})
+
+	t.Run("passes unread and limit through", func(t *testing.T) {
+		svc := mocks.NewMockInboxService(t)
+		svc.On("GetInbox", mock.Anything, domain.PlatformDiscord, "d-1", true, 5).
+			Return(&domain.Inbox{Messages: []domain.InboxMessage{{ID: 3, Kind: domain.InboxKindGift, Title: "Gift from bob"}}, Unread: 1}, nil)
+
+		rec := get(NewInboxHandler(svc), "&unread=true&limit=5")
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Contains(t, rec.Body.String(), `"title":"Gift from bob"`)
+	})
+
+	t.Run("rejects a limit above the page size", func(t *testing.T) {
+		rec := get(NewInboxHandler(mocks.NewMockInboxService(t)), "&limit=51")
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("unknown user", func(t *testing.T) {
+		svc := mocks.NewMockInboxService(t)
+		svc.On("GetInbox", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil, domain.ErrUserNotFound)
+
+		rec := get(NewInboxHandler(svc), "")
+
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+	})
+}
+
+func TestInboxHandler_HandleMarkInboxRead(t *testing.T) {
+	post := func(h *InboxHandler, body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/user/inbox/read", bytes.NewBufferString(body))
+		rec := httptest.NewRecorder()
+		h.HandleMarkInboxRead(rec, req)
+		return rec
+	}
+
+	t.Run("marks the given messages", func(t *testing.T) {
+		svc := mocks.NewMockInboxService(t)
+		svc.On("MarkRead", mock.Anything, domain.PlatformDiscord, "d-1", []int64{1, 2}).Return(2, nil)
+
+		rec := post(NewInboxHandler(svc), `{"platform":"discord","platform_id":"d-1","ids":[1,2]}`)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.JSONEq(t, `{"marked":2}`, rec.Body.String())
+	})
+
+	t.Run("marks everything without IDs", func(t *testing.T) {
+		svc := mocks.NewMockInboxService(t)
+		svc.On("MarkRead", mock.Anything, domain.PlatformDiscord, "d-1", []int64(nil)).Return(4, nil)
+
+		rec := post(NewInboxHandler(svc), `{"platform":"discord","platform_id":"d-1"}`)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+	})
+
+	t.Run("rejects invalid IDs", func(t *testing.T) {
+		rec := post(NewInboxHandler(mocks.NewMockInboxService(t)), `{"platform":"discord","platform_id":"d-1","ids":[0]}`)
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+}
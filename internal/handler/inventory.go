@@ -42,8 +42,7 @@ func HandleAddItemByUsername(svc user.Service) http.HandlerFunc {
 
 		if err := svc.AddItemByUsername(r.Context(), req.Platform, req.Username, req.ItemName, req.Quantity); err != nil {
 			log.Error("Failed to add item by username", "error", err, "username", req.Username, "item", req.ItemName)
-			statusCode, userMsg := MapServiceErrorToUserMessage(err)
-			RespondError(w, statusCode, userMsg)
+			RespondMappedError(w, err)
 			return
 		}
 
@@ -87,8 +86,7 @@ func HandleRemoveItemByUsername(svc user.Service) http.HandlerFunc {
 		removed, err := svc.RemoveItemByUsername(r.Context(), req.Platform, req.Username, req.ItemName, req.Quantity)
 		if err != nil {
 			log.Error("Failed to remove item by username", "error", err, "username", req.Username, "item", req.ItemName)
-			statusCode, userMsg := MapServiceErrorToUserMessage(err)
-			RespondError(w, statusCode, userMsg)
+			RespondMappedError(w, err)
 			return
 		}
 
@@ -102,15 +100,17 @@ func HandleRemoveItemByUsername(svc user.Service) http.HandlerFunc {
 }
 
 type GetInventoryResponse struct {
-	Items []user.InventoryItem `json:"items"`
+	Items    []user.InventoryItem      `json:"items"`
+	Capacity *domain.InventoryCapacity `json:"capacity,omitempty"`
 }
 
 // HandleGetInventory gets the user's inventory
 // @Summary Get inventory
-// @Description Get the user's inventory
+// @Description Get the user's inventory and how many of its slots are filled
 // @Tags inventory
 // @Accept json
 // @Produce json
+// @Param platform query string true "Platform"
 // @Param platform_id query string true "Platform ID"
 // @Param username query string true "Username"
 // @Param filter query string false "Filter by item type (upgrade, sellable, consumable)"
@@ -151,8 +151,7 @@ func HandleGetInventory(svc user.Service, progSvc progression.Service) http.Hand
 			unlocked, err := progSvc.IsFeatureUnlocked(r.Context(), featureKey)
 			if err != nil {
 				log.Error("Failed to check filter unlock", "error", err)
-				statusCode, userMsg := MapServiceErrorToUserMessage(err)
-				RespondError(w, statusCode, userMsg)
+				RespondMappedError(w, err)
 				return
 			}
 			if !unlocked {
@@ -167,15 +166,22 @@ func HandleGetInventory(svc user.Service, progSvc progression.Service) http.Hand
 		items, err := svc.GetInventory(r.Context(), platform, platformID, username, filter)
 		if err != nil {
 			log.Error("Failed to get inventory", "error", err, "username", username)
-			statusCode, userMsg := MapServiceErrorToUserMessage(err)
-			RespondError(w, statusCode, userMsg)
+			RespondMappedError(w, err)
+			return
+		}
+
+		capacity, err := svc.GetInventoryCapacity(r.Context(), platform, platformID, username)
+		if err != nil {
+			log.Error("Failed to get inventory capacity", "error", err, "username", username)
+			RespondMappedError(w, err)
 			return
 		}
 
-		log.Info("Inventory retrieved", "username", username, "item_count", len(items))
+		log.Info("Inventory retrieved", "username", username, "item_count", len(items), "used_slots", capacity.UsedSlots)
 
 		RespondJSON(w, http.StatusOK, GetInventoryResponse{
-			Items: items,
+			Items:    items,
+			Capacity: capacity,
 		})
 	}
 }
@@ -219,8 +225,7 @@ func HandleGetInventoryByUsername(svc user.Service, progSvc progression.Service)
 			unlocked, err := progSvc.IsFeatureUnlocked(r.Context(), featureKey)
 			if err != nil {
 				log.Error("Failed to check filter unlock", "error", err)
-				statusCode, userMsg := MapServiceErrorToUserMessage(err)
-				RespondError(w, statusCode, userMsg)
+				RespondMappedError(w, err)
 				return
 			}
 			if !unlocked {
@@ -235,8 +240,7 @@ func HandleGetInventoryByUsername(svc user.Service, progSvc progression.Service)
 		items, err := svc.GetInventoryByUsername(r.Context(), platform, username, filter)
 		if err != nil {
 			log.Error("Failed to get inventory by username", "error", err, "username", username)
-			statusCode, userMsg := MapServiceErrorToUserMessage(err)
-			RespondError(w, statusCode, userMsg)
+			RespondMappedError(w, err)
 			return
 		}
 
@@ -246,6 +246,8 @@ func TestHandleRemoveItem(t *testing.T) {
 func TestHandleGetInventory(t *testing.T) {
 	t.Parallel()
 
+	capacity := &domain.InventoryCapacity{UsedSlots: 1, MaxSlots: 50}
+
 	tests := []struct {
 		name             string
 		username         string
@@ -268,12 +270,14 @@ func TestHandleGetInventory(t *testing.T) {
 					{InternalName: domain.ItemMissile, PublicName: "missile", Quantity: 1, QualityLevel: "COMMON"},
 				}
 				m.On("GetInventory", mock.Anything, domain.PlatformDiscord, "test-platformid", "testuser", "").Return(items, nil)
+				m.On("GetInventoryCapacity", mock.Anything, domain.PlatformDiscord, "test-platformid", "testuser").Return(capacity, nil)
 			},
 			expectedStatus: http.StatusOK,
 			expectedResponse: &GetInventoryResponse{
 				Items: []user.InventoryItem{
 					{InternalName: domain.ItemMissile, PublicName: "missile", Quantity: 1, QualityLevel: "COMMON"},
 				},
+				Capacity: capacity,
 			},
 		},
 		{
@@ -288,12 +292,14 @@ func TestHandleGetInventory(t *testing.T) {
 				}
 				p.On("IsFeatureUnlocked", mock.Anything, "feature_filter_upgrade").Return(true, nil)
 				m.On("GetInventory", mock.Anything, domain.PlatformDiscord, "test-platformid", "testuser", domain.FilterTypeUpgrade).Return(items, nil)
+				m.On("GetInventoryCapacity", mock.Anything, domain.PlatformDiscord, "test-platformid", "testuser").Return(capacity, nil)
 			},
 			expectedStatus: http.StatusOK,
 			expectedResponse: &GetInventoryResponse{
 				Items: []user.InventoryItem{
 					{InternalName: domain.ItemLootbox0, PublicName: "junkbox", Quantity: 1, QualityLevel: "COMMON"},
 				},
+				Capacity: capacity,
 			},
 		},
 		{
@@ -362,12 +368,14 @@ func TestHandleGetInventory(t *testing.T) {
 				}
 				p.On("IsFeatureUnlocked", mock.Anything, "feature_filter_sellable").Return(true, nil)
 				m.On("GetInventory", mock.Anything, domain.PlatformDiscord, "test-platformid", "testuser", domain.FilterTypeSellable).Return(items, nil)
+				m.On("GetInventoryCapacity", mock.Anything, domain.PlatformDiscord, "test-platformid", "testuser").Return(capacity, nil)
 			},
 			expectedStatus: http.StatusOK,
 			expectedResponse: &GetInventoryResponse{
 				Items: []user.InventoryItem{
 					{InternalName: domain.ItemLootbox1, PublicName: "lootbox", Quantity: 5, QualityLevel: "COMMON"},
 				},
+				Capacity: capacity,
 			},
 		},
 		{
@@ -394,12 +402,14 @@ func TestHandleGetInventory(t *testing.T) {
 				}
 				p.On("IsFeatureUnlocked", mock.Anything, "feature_filter_consumable").Return(true, nil)
 				m.On("GetInventory", mock.Anything, domain.PlatformDiscord, "test-platformid", "testuser", domain.FilterTypeConsumable).Return(items, nil)
+				m.On("GetInventoryCapacity", mock.Anything, domain.PlatformDiscord, "test-platformid", "testuser").Return(capacity, nil)
 			},
 			expectedStatus: http.StatusOK,
 			expectedResponse: &GetInventoryResponse{
 				Items: []user.InventoryItem{
 					{InternalName: domain.ItemLootbox0, PublicName: "junkbox", Quantity: 3, QualityLevel: "COMMON"},
 				},
+				Capacity: capacity,
 			},
 		},
 		{
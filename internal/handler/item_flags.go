@@ -0,0 +1,100 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/itemflags"
+	"github.com/osse101/BrandishBot_Go/internal/logger"
+)
+
+// SetItemLockRequest locks or unlocks one of a user's items
+type SetItemLockRequest struct {
+	Platform   string `json:"platform" validate:"required,platform"`
+	PlatformID string `json:"platform_id" validate:"required"`
+	Username   string `json:"username" validate:"required,max=100,excludesall=\x00\n\r\t"`
+	ItemName   string `json:"item_name" validate:"required,max=100"`
+	Locked     *bool  `json:"locked" validate:"required"`
+}
+
+// SetItemFavoriteRequest marks or unmarks one of a user's items as a favorite
+type SetItemFavoriteRequest struct {
+	Platform   string `json:"platform" validate:"required,platform"`
+	PlatformID string `json:"platform_id" validate:"required"`
+	Username   string `json:"username" validate:"required,max=100,excludesall=\x00\n\r\t"`
+	ItemName   string `json:"item_name" validate:"required,max=100"`
+	Favorite   *bool  `json:"favorite" validate:"required"`
+}
+
+// ItemFlagsHandler handles the favorite and locked flags users put on items
+type ItemFlagsHandler struct {
+	service itemflags.Service
+}
+
+// NewItemFlagsHandler creates a new item flags handler
+func NewItemFlagsHandler(service itemflags.Service) *ItemFlagsHandler {
+	return &ItemFlagsHandler{service: service}
+}
+
+// HandleSetLock locks or unlocks an item
+// @Summary Lock or unlock an item
+// @Description A locked item cannot be used, sold, given or disassembled until it is unlocked. The lock covers every quality of the item.
+// @Tags inventory
+// @Accept json
+// @Produce json
+// @Param request body SetItemLockRequest true "Lock"
+// @Success 200 {object} domain.ItemFlags
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /api/v1/user/item/lock [post]
+func (h *ItemFlagsHandler) HandleSetLock(w http.ResponseWriter, r *http.Request) {
+	var req SetItemLockRequest
+	if err := DecodeAndValidateRequest(r, w, &req, "Set item lock"); err != nil {
+		return
+	}
+
+	flags, err := h.service.SetLocked(r.Context(), req.Platform, req.PlatformID, req.Username, req.ItemName, *req.Locked)
+	if err != nil {
+		if errors.Is(err, domain.ErrItemNotFound) {
+			RespondMappedError(w, err)
+			return
+		}
+		logger.FromContext(r.Context()).Error("Failed to set item lock", "error", err, "platform", req.Platform, "item", req.ItemName)
+		RespondError(w, http.StatusInternalServerError, ErrMsgSetItemLockFailed)
+		return
+	}
+
+	RespondJSON(w, http.StatusOK, flags)
+}
+
+// HandleSetFavorite marks or unmarks an item as a favorite
+// @Summary Favorite or unfavorite an item
+// @Description Favorites are marked in the inventory listing and have no other effect.
+// @Tags inventory
+// @Accept json
+// @Produce json
+// @Param request body SetItemFavoriteRequest true "Favorite"
+// @Success 200 {object} domain.ItemFlags
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /api/v1/user/item/favorite [post]
+func (h *ItemFlagsHandler) HandleSetFavorite(w http.ResponseWriter, r *http.Request) {
+	var req SetItemFavoriteRequest
+	if err := DecodeAndValidateRequest(r, w, &req, "Set item favorite"); err != nil {
+		return
+	}
+
+	flags, err := h.service.SetFavorite(r.Context(), req.Platform, req.PlatformID, req.Username, req.ItemName, *req.Favorite)
+	if err != nil {
+		if errors.Is(err, domain.ErrItemNotFound) {
+			RespondMappedError(w, err)
+			return
+		}
+		logger.FromContext(r.Context()).Error("Failed to set item favorite", "error", err, "platform", req.Platform, "item", req.ItemName)
+		RespondError(w, http.StatusInternalServerError, ErrMsgSetItemFavoriteFailed)
+		return
+	}
+
+	RespondJSON(w, http.StatusOK, flags)
+}
@@ -0,0 +1,67 @@
+package handler
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/mocks"
+)
+
+func TestItemFlagsHandler_HandleSetLock(t *testing.T) {
+	post := func(h *ItemFlagsHandler, body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/user/item/lock", bytes.NewBufferString(body))
+		rec := httptest.NewRecorder()
+		h.HandleSetLock(rec, req)
+		return rec
+	}
+
+	t.Run("locks the item", func(t *testing.T) {
+		svc := mocks.NewMockItemflagsService(t)
+		svc.On("SetLocked", mock.Anything, domain.PlatformDiscord, "d-1", "alice", "lootbox", true).
+			Return(&domain.ItemFlags{ItemName: domain.ItemLootbox1, Locked: true}, nil)
+
+		rec := post(NewItemFlagsHandler(svc), `{"platform":"discord","platform_id":"d-1","username":"alice","item_name":"lootbox","locked":true}`)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Contains(t, rec.Body.String(), `"locked":true`)
+	})
+
+	t.Run("requires the locked flag", func(t *testing.T) {
+		svc := mocks.NewMockItemflagsService(t)
+
+		rec := post(NewItemFlagsHandler(svc), `{"platform":"discord","platform_id":"d-1","username":"alice","item_name":"lootbox"}`)
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("unknown item", func(t *testing.T) {
+		svc := mocks.NewMockItemflagsService(t)
+		svc.On("SetLocked", mock.Anything, domain.PlatformDiscord, "d-1", "alice", "nope", false).
+			Return(nil, fmt.Errorf("%w: nope", domain.ErrItemNotFound))
+
+		rec := post(NewItemFlagsHandler(svc), `{"platform":"discord","platform_id":"d-1","username":"alice","item_name":"nope","locked":false}`)
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+}
+
+func TestItemFlagsHandler_HandleSetFavorite(t *testing.T) {
+	svc := mocks.NewMockItemflagsService(t)
+	svc.On("SetFavorite", mock.Anything, domain.PlatformDiscord, "d-1", "alice", "lootbox", true).
+		Return(&domain.ItemFlags{ItemName: domain.ItemLootbox1, Favorite: true}, nil)
+
+	req := httptest.NewRequest(http.MethodPost, "/user/item/favorite",
+		bytes.NewBufferString(`{"platform":"discord","platform_id":"d-1","username":"alice","item_name":"lootbox","favorite":true}`))
+	rec := httptest.NewRecorder()
+	NewItemFlagsHandler(svc).HandleSetFavorite(rec, req)
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Body.String(), `"favorite":true`)
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/osse101/BrandishBot_Go/internal/domain"
 	"github.com/osse101/BrandishBot_Go/internal/event"
 	"github.com/osse101/BrandishBot_Go/internal/logger"
+	"github.com/osse101/BrandishBot_Go/internal/lootbox"
 	"github.com/osse101/BrandishBot_Go/internal/middleware"
 	"github.com/osse101/BrandishBot_Go/internal/progression"
 	"github.com/osse101/BrandishBot_Go/internal/user"
@@ -21,7 +22,34 @@ type UseItemRequest struct {
 }
 
 type UseItemResponse struct {
-	Message string `json:"message"`
+	Message string               `json:"message"`
+	Reveal  []LootboxRevealEntry `json:"reveal,omitempty"` // lootbox drops in roll order, for reveal animations
+}
+
+// LootboxRevealEntry is one lootbox drop with the roll details behind it
+type LootboxRevealEntry struct {
+	ItemName     string `json:"item_name"`
+	Quantity     int    `json:"quantity"`
+	Value        int    `json:"value"`
+	QualityLevel string `json:"quality_level"`
+	lootbox.Reveal
+}
+
+func buildLootboxReveal(drops []lootbox.DroppedItem) []LootboxRevealEntry {
+	if len(drops) == 0 {
+		return nil
+	}
+	entries := make([]LootboxRevealEntry, 0, len(drops))
+	for _, d := range drops {
+		entries = append(entries, LootboxRevealEntry{
+			ItemName:     d.ItemName,
+			Quantity:     d.Quantity,
+			Value:        d.Value,
+			QualityLevel: string(d.QualityLevel),
+			Reveal:       d.Reveal,
+		})
+	}
+	return entries
 }
 
 var itemToProgressionNodeMap = map[string]string{
@@ -57,8 +85,9 @@ var itemToProgressionNodeMap = map[string]string{
 	domain.ItemScript: progression.ItemScript,
 }
 
-// mapItemToProgressionNode maps internal item names to progression node keys
-func mapItemToProgressionNode(itemName string) string {
+// ProgressionNodeForItem returns the progression node key that gates an item,
+// or "" when the item is not gated
+func ProgressionNodeForItem(itemName string) string {
 	return itemToProgressionNodeMap[itemName]
 }
 
@@ -97,7 +126,7 @@ func HandleUseItem(svc user.Service, progressionSvc progression.Service, eventBu
 			}
 
 			// Map item internal name to progression node key
-			nodeKey := mapItemToProgressionNode(internalName)
+			nodeKey := ProgressionNodeForItem(internalName)
 			if nodeKey != "" {
 				if CheckFeatureLocked(w, r, progressionSvc, nodeKey) {
 					return // CheckFeatureLocked already wrote 403 response
@@ -105,11 +134,10 @@ func HandleUseItem(svc user.Service, progressionSvc progression.Service, eventBu
 			}
 		}
 
-		message, err := svc.UseItem(r.Context(), req.Platform, req.PlatformID, req.Username, req.ItemName, req.Quantity, req.TargetUser)
+		result, err := svc.UseItemDetailed(r.Context(), req.Platform, req.PlatformID, req.Username, req.ItemName, req.Quantity, req.TargetUser)
 		if err != nil {
 			log.Error("Failed to use item", "error", err, "username", req.Username, "item", req.ItemName)
-			statusCode, userMsg := MapServiceErrorToUserMessage(err)
-			RespondError(w, statusCode, userMsg)
+			RespondMappedError(w, err)
 			return
 		}
 
@@ -142,10 +170,11 @@ func HandleUseItem(svc user.Service, progressionSvc progression.Service, eventBu
 			"username", req.Username,
 			"item", req.ItemName,
 			"quantity", req.Quantity,
-			"message", message)
+			"message", result.Message)
 
 		RespondJSON(w, http.StatusOK, UseItemResponse{
-			Message: message,
+			Message: result.Message,
+			Reveal:  buildLootboxReveal(result.Drops),
 		})
 	}
 }
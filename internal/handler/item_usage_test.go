@@ -13,6 +13,8 @@ import (
 
 	"github.com/osse101/BrandishBot_Go/internal/domain"
 	"github.com/osse101/BrandishBot_Go/internal/event"
+	"github.com/osse101/BrandishBot_Go/internal/itemhandler"
+	"github.com/osse101/BrandishBot_Go/internal/lootbox"
 	"github.com/osse101/BrandishBot_Go/mocks"
 )
 
@@ -39,8 +41,8 @@ func TestHandleUseItem(t *testing.T) {
 				// Unlock the feature
 				p.On("IsFeatureUnlocked", mock.Anything, "weapon_missile").Return(true, nil)
 				// Mock should return what the real blaster handler would return
-				u.On("UseItem", mock.Anything, domain.PlatformTwitch, "test-id", "testuser", domain.PublicNameMissile, 1, "").
-					Return("testuser has BLASTED target 1 times! They are timed out for 1m0s.", nil)
+				u.On("UseItemDetailed", mock.Anything, domain.PlatformTwitch, "test-id", "testuser", domain.PublicNameMissile, 1, "").
+					Return(&itemhandler.UseResult{Message: "testuser has BLASTED target 1 times! They are timed out for 1m0s."}, nil)
 				u.On("GetUserIDByPlatformID", mock.Anything, domain.PlatformTwitch, "test-id").Return("", nil)
 				// Expect ONLY engagement event (item.used is now service-side and not using this mock bus in this test setup)
 				// Wait, the handler STILL publishes engagement if points > 0
@@ -63,11 +65,38 @@ func TestHandleUseItem(t *testing.T) {
 			setupMock: func(u *mocks.MockUserService, p *mocks.MockProgressionService, e *mocks.MockEventBus) {
 				u.On("GetItemByName", mock.Anything, domain.PublicNameMissile).Return(&domain.Item{InternalName: domain.ItemMissile}, nil)
 				p.On("IsFeatureUnlocked", mock.Anything, "weapon_missile").Return(true, nil)
-				u.On("UseItem", mock.Anything, domain.PlatformTwitch, "test-id", "testuser", domain.PublicNameMissile, 1, "").Return("", errors.New(ErrMsgGenericServerError))
+				u.On("UseItemDetailed", mock.Anything, domain.PlatformTwitch, "test-id", "testuser", domain.PublicNameMissile, 1, "").Return(nil, errors.New(ErrMsgGenericServerError))
 			},
 			expectedStatus: http.StatusInternalServerError,
 			expectedBody:   ErrMsgGenericServerError,
 		},
+		{
+			name: "Lootbox Reveal",
+			requestBody: UseItemRequest{
+				Platform:   domain.PlatformTwitch,
+				PlatformID: "test-id",
+				Username:   "testuser",
+				ItemName:   domain.ItemLootbox1,
+				Quantity:   1,
+			},
+			setupMock: func(u *mocks.MockUserService, p *mocks.MockProgressionService, e *mocks.MockEventBus) {
+				u.On("GetItemByName", mock.Anything, domain.ItemLootbox1).Return(&domain.Item{InternalName: domain.ItemLootbox1}, nil)
+				p.On("IsFeatureUnlocked", mock.Anything, mock.Anything).Return(true, nil)
+				u.On("UseItemDetailed", mock.Anything, domain.PlatformTwitch, "test-id", "testuser", domain.ItemLootbox1, 1, "").
+					Return(&itemhandler.UseResult{
+						Message: "Opened a lootbox",
+						Drops: []lootbox.DroppedItem{{
+							ItemName:     "Sword",
+							Quantity:     1,
+							QualityLevel: domain.QualityCommon,
+							Reveal:       lootbox.Reveal{TierRank: 3, QualityRoll: 0.31, NearMiss: true, NearMissTier: domain.QualityUncommon},
+						}},
+					}, nil)
+				u.On("GetUserIDByPlatformID", mock.Anything, domain.PlatformTwitch, "test-id").Return("", nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   `"reveal":[{"item_name":"Sword","quantity":1,"value":0,"quality_level":"COMMON","tier_rank":3,"quality_roll":0.31,"near_miss":true,"near_miss_tier":"UNCOMMON"`,
+		},
 		{
 			name: "Progression Locked",
 			requestBody: UseItemRequest{
@@ -0,0 +1,37 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/osse101/BrandishBot_Go/internal/jackpot"
+	"github.com/osse101/BrandishBot_Go/internal/logger"
+)
+
+// JackpotHandler reports on the progressive jackpot
+type JackpotHandler struct {
+	service jackpot.Service
+}
+
+// NewJackpotHandler creates a new jackpot handler
+func NewJackpotHandler(service jackpot.Service) *JackpotHandler {
+	return &JackpotHandler{service: service}
+}
+
+// HandleGetStatus reports the jackpot's balance, settings and recent wins
+// @Summary Get jackpot status
+// @Description The money the progressive jackpot holds, the share of each lootbox opening and gamble added to it, the chance each opening wins it, and the most recent winners.
+// @Tags economy
+// @Produce json
+// @Success 200 {object} domain.JackpotStatus
+// @Failure 500 {object} ErrorResponse
+// @Router /api/v1/jackpot [get]
+func (h *JackpotHandler) HandleGetStatus(w http.ResponseWriter, r *http.Request) {
+	status, err := h.service.GetStatus(r.Context())
+	if err != nil {
+		logger.FromContext(r.Context()).Error("Failed to get jackpot status", "error", err)
+		RespondError(w, http.StatusInternalServerError, ErrMsgGetJackpotFailed)
+		return
+	}
+
+	RespondJSON(w, http.StatusOK, status)
+}
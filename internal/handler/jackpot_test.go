@@ -0,0 +1,41 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/mocks"
+)
+
+func TestJackpotHandler_HandleGetStatus(t *testing.T) {
+	t.Run("reports the jackpot", func(t *testing.T) {
+		svc := mocks.NewMockJackpotService(t)
+		svc.On("GetStatus", mock.Anything).Return(&domain.JackpotStatus{
+			Balance:    1200,
+			RecentWins: []domain.JackpotWin{{Username: "lucky", Amount: 5000}},
+		}, nil)
+
+		rec := httptest.NewRecorder()
+		NewJackpotHandler(svc).HandleGetStatus(rec, httptest.NewRequest(http.MethodGet, "/jackpot", nil))
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Contains(t, rec.Body.String(), `"balance":1200`)
+		assert.Contains(t, rec.Body.String(), `"username":"lucky"`)
+	})
+
+	t.Run("service failure", func(t *testing.T) {
+		svc := mocks.NewMockJackpotService(t)
+		svc.On("GetStatus", mock.Anything).Return(nil, errors.New("db down"))
+
+		rec := httptest.NewRecorder()
+		NewJackpotHandler(svc).HandleGetStatus(rec, httptest.NewRequest(http.MethodGet, "/jackpot", nil))
+
+		assert.Equal(t, http.StatusInternalServerError, rec.Code)
+	})
+}
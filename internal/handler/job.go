@@ -1,8 +1,11 @@
 package handler
 
 import (
+	"errors"
 	"net/http"
 
+	"github.com/go-chi/chi/v5"
+
 	"github.com/osse101/BrandishBot_Go/internal/domain"
 	"github.com/osse101/BrandishBot_Go/internal/job"
 	"github.com/osse101/BrandishBot_Go/internal/logger"
@@ -23,6 +26,18 @@ func NewJobHandler(service job.Service, userRepo repository.User) *JobHandler {
 
 // HandleGetUserJobs returns a user's job progress
 // Supports dual-mode: platform+platform_id (self-mode) or platform+username (target-mode)
+// @Summary Get user jobs
+// @Description A user's progress in every job and their primary job. Pass platform_id for the caller or username for another user.
+// @Tags jobs
+// @Produce json
+// @Param platform query string true "Platform (twitch, youtube, discord)"
+// @Param platform_id query string false "Platform user ID"
+// @Param username query string false "Username, used when platform_id is empty"
+// @Success 200 {object} GetUserJobsResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /api/v1/jobs/user [get]
 func (h *JobHandler) HandleGetUserJobs(w http.ResponseWriter, r *http.Request) {
 	log := logger.FromContext(r.Context())
 
@@ -61,8 +76,7 @@ func (h *JobHandler) HandleGetUserJobs(w http.ResponseWriter, r *http.Request) {
 	userJobs, err := h.service.GetUserJobsByPlatform(r.Context(), platform, platformID)
 	if err != nil {
 		log.Error("Failed to get user jobs", "error", err, "platform", platform, "platform_id", platformID)
-		statusCode, userMsg := MapServiceErrorToUserMessage(err)
-		RespondError(w, statusCode, userMsg)
+		RespondMappedError(w, err)
 		return
 	}
 
@@ -95,6 +109,16 @@ type AwardXPRequest struct {
 }
 
 // HandleAwardXP awards XP to a user's job (internal/bot use)
+// @Summary Award job XP
+// @Description Award XP to one of a user's jobs, applying the usual multipliers
+// @Tags jobs
+// @Accept json
+// @Produce json
+// @Param request body AwardXPRequest true "XP award"
+// @Success 200 {object} domain.XPAwardResult
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /api/v1/jobs/award-xp [post]
 func (h *JobHandler) HandleAwardXP(w http.ResponseWriter, r *http.Request) {
 	var req AwardXPRequest
 	if err := DecodeAndValidateRequest(r, w, &req, "Award XP"); err != nil {
@@ -114,10 +138,159 @@ func (h *JobHandler) HandleAwardXP(w http.ResponseWriter, r *http.Request) {
 			"platform_id", req.PlatformID,
 			"job_key", req.JobKey,
 		)
-		statusCode, userMsg := MapServiceErrorToUserMessage(err)
-		RespondError(w, statusCode, userMsg)
+		RespondMappedError(w, err)
+		return
+	}
+
+	RespondJSON(w, http.StatusOK, result)
+}
+
+// JobsResponse lists every job
+type JobsResponse struct {
+	Jobs []domain.Job `json:"jobs"`
+}
+
+// JobChoiceRequest names one of the user's jobs to switch to or prestige
+type JobChoiceRequest struct {
+	Platform   string `json:"platform" validate:"required,platform"`
+	PlatformID string `json:"platform_id" validate:"required"`
+	JobKey     string `json:"job_key" validate:"required,max=50"`
+}
+
+// HandleGetJobs lists every job
+// @Summary List jobs
+// @Description Every job in the game, whether or not it is unlocked yet.
+// @Tags jobs
+// @Produce json
+// @Success 200 {object} JobsResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /api/v1/jobs [get]
+func (h *JobHandler) HandleGetJobs(w http.ResponseWriter, r *http.Request) {
+	jobs, err := h.service.GetAllJobs(r.Context())
+	if err != nil {
+		logger.FromContext(r.Context()).Error("Failed to get jobs", "error", err)
+		RespondError(w, http.StatusInternalServerError, ErrMsgGetJobsFailed)
+		return
+	}
+
+	if jobs == nil {
+		jobs = []domain.Job{}
+	}
+	RespondJSON(w, http.StatusOK, JobsResponse{Jobs: jobs})
+}
+
+// HandleSwitchActiveJob makes a job the user's active job
+// @Summary Switch active job
+// @Description The active job earns bonus XP. Switching is on a cooldown set by COOLDOWN_JOB_SWITCH; choosing the current active job is rejected without using it up.
+// @Tags jobs
+// @Accept json
+// @Produce json
+// @Param request body JobChoiceRequest true "Job to switch to"
+// @Success 200 {object} domain.UserJobInfo
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 429 {object} CooldownErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /api/v1/jobs/active [post]
+func (h *JobHandler) HandleSwitchActiveJob(w http.ResponseWriter, r *http.Request) {
+	var req JobChoiceRequest
+	if err := DecodeAndValidateRequest(r, w, &req, "Switch active job"); err != nil {
+		return
+	}
+
+	info, err := h.service.SwitchActiveJob(r.Context(), req.Platform, req.PlatformID, req.JobKey)
+	if err != nil {
+		if respondJobChoiceError(w, err) {
+			return
+		}
+		logger.FromContext(r.Context()).Error("Failed to switch active job", "error", err, "platform", req.Platform, "job_key", req.JobKey)
+		RespondError(w, http.StatusInternalServerError, ErrMsgSwitchJobFailed)
+		return
+	}
+
+	RespondJSON(w, http.StatusOK, info)
+}
+
+// HandlePrestigeJob resets a job at the level cap for a permanent XP bonus
+// @Summary Prestige job
+// @Description Resets a job at the level cap to level 0. Each prestige permanently adds to the XP the job earns, up to a maximum number of prestiges.
+// @Tags jobs
+// @Accept json
+// @Produce json
+// @Param request body JobChoiceRequest true "Job to prestige"
+// @Success 200 {object} domain.JobPrestigeResult
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /api/v1/jobs/prestige [post]
+func (h *JobHandler) HandlePrestigeJob(w http.ResponseWriter, r *http.Request) {
+	var req JobChoiceRequest
+	if err := DecodeAndValidateRequest(r, w, &req, "Prestige job"); err != nil {
+		return
+	}
+
+	result, err := h.service.PrestigeJob(r.Context(), req.Platform, req.PlatformID, req.JobKey)
+	if err != nil {
+		if respondJobChoiceError(w, err) {
+			return
+		}
+		logger.FromContext(r.Context()).Error("Failed to prestige job", "error", err, "platform", req.Platform, "job_key", req.JobKey)
+		RespondError(w, http.StatusInternalServerError, ErrMsgPrestigeJobFailed)
 		return
 	}
 
 	RespondJSON(w, http.StatusOK, result)
 }
+
+// HandleGetJobPerks returns a job's per-level perk table
+// @Summary Get job perks
+// @Description The perks a job grants by level. With platform and platform_id, the perks the user has reached are marked and their prestige bonus is included.
+// @Tags jobs
+// @Produce json
+// @Param job_key path string true "Job key"
+// @Param platform query string false "Platform"
+// @Param platform_id query string false "Platform user ID"
+// @Success 200 {object} domain.JobPerks
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /api/v1/jobs/{job_key}/perks [get]
+func (h *JobHandler) HandleGetJobPerks(w http.ResponseWriter, r *http.Request) {
+	jobKey := chi.URLParam(r, "job_key")
+	platform := r.URL.Query().Get("platform")
+	platformID := r.URL.Query().Get("platform_id")
+
+	perks, err := h.service.GetJobPerks(r.Context(), jobKey, platform, platformID)
+	if err != nil {
+		if respondJobChoiceError(w, err) {
+			return
+		}
+		logger.FromContext(r.Context()).Error("Failed to get job perks", "error", err, "job_key", jobKey)
+		RespondError(w, http.StatusInternalServerError, ErrMsgGetJobPerksFailed)
+		return
+	}
+
+	RespondJSON(w, http.StatusOK, perks)
+}
+
+// respondJobChoiceError writes the response for errors a user can act on and
+// reports whether it did
+func respondJobChoiceError(w http.ResponseWriter, err error) bool {
+	switch {
+	case errors.Is(err, domain.ErrUnknownJob):
+		RespondError(w, http.StatusNotFound, ErrMsgJobNotFoundHTTP)
+	case errors.Is(err, domain.ErrJobAlreadyActive),
+		errors.Is(err, domain.ErrJobBelowLevelCap),
+		errors.Is(err, domain.ErrMaxPrestigeReached):
+		RespondError(w, http.StatusBadRequest, err.Error())
+	case errors.Is(err, domain.ErrUserNotFound),
+		errors.Is(err, domain.ErrFeatureLocked),
+		errors.Is(err, domain.ErrOnCooldown):
+		RespondMappedError(w, err)
+	default:
+		return false
+	}
+	return true
+}
@@ -2,15 +2,19 @@ package handler
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
+	"github.com/go-chi/chi/v5"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 
+	"github.com/osse101/BrandishBot_Go/internal/cooldown"
 	"github.com/osse101/BrandishBot_Go/internal/domain"
 	"github.com/osse101/BrandishBot_Go/internal/job"
 	"github.com/osse101/BrandishBot_Go/mocks"
@@ -352,3 +356,169 @@ func TestHandleAwardXP_Cases(t *testing.T) {
 		})
 	}
 }
+
+func TestJobHandler_HandleSwitchActiveJob(t *testing.T) {
+	post := func(h *JobHandler, body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/jobs/active", bytes.NewBufferString(body))
+		rec := httptest.NewRecorder()
+		h.HandleSwitchActiveJob(rec, req)
+		return rec
+	}
+	body := `{"platform":"twitch","platform_id":"u1","job_key":"job_explorer"}`
+
+	t.Run("switches the active job", func(t *testing.T) {
+		svc := mocks.NewMockJobService(t)
+		svc.On("SwitchActiveJob", mock.Anything, domain.PlatformTwitch, "u1", job.JobKeyExplorer).
+			Return(&domain.UserJobInfo{JobKey: job.JobKeyExplorer, IsActive: true}, nil)
+
+		rec := post(NewJobHandler(svc, nil), body)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Contains(t, rec.Body.String(), `"is_active":true`)
+	})
+
+	t.Run("on cooldown", func(t *testing.T) {
+		svc := mocks.NewMockJobService(t)
+		svc.On("SwitchActiveJob", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+			Return(nil, cooldown.ErrOnCooldown{Action: domain.ActionJobSwitch, Remaining: time.Hour})
+
+		rec := post(NewJobHandler(svc, nil), body)
+
+		assert.Equal(t, http.StatusTooManyRequests, rec.Code)
+		assert.NotEmpty(t, rec.Header().Get("Retry-After"))
+	})
+
+	t.Run("already active is a bad request", func(t *testing.T) {
+		svc := mocks.NewMockJobService(t)
+		svc.On("SwitchActiveJob", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+			Return(nil, domain.ErrJobAlreadyActive)
+
+		rec := post(NewJobHandler(svc, nil), body)
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("unknown job", func(t *testing.T) {
+		svc := mocks.NewMockJobService(t)
+		svc.On("SwitchActiveJob", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+			Return(nil, domain.ErrUnknownJob)
+
+		rec := post(NewJobHandler(svc, nil), body)
+
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+	})
+
+	t.Run("rejects a missing job key", func(t *testing.T) {
+		svc := mocks.NewMockJobService(t)
+
+		rec := post(NewJobHandler(svc, nil), `{"platform":"twitch","platform_id":"u1"}`)
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+}
+
+func TestJobHandler_HandlePrestigeJob(t *testing.T) {
+	post := func(h *JobHandler, body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/jobs/prestige", bytes.NewBufferString(body))
+		rec := httptest.NewRecorder()
+		h.HandlePrestigeJob(rec, req)
+		return rec
+	}
+	body := `{"platform":"twitch","platform_id":"u1","job_key":"job_explorer"}`
+
+	t.Run("prestiges the job", func(t *testing.T) {
+		svc := mocks.NewMockJobService(t)
+		svc.On("PrestigeJob", mock.Anything, domain.PlatformTwitch, "u1", job.JobKeyExplorer).
+			Return(&domain.JobPrestigeResult{JobKey: job.JobKeyExplorer, Prestige: 1, XPBonus: 0.02}, nil)
+
+		rec := post(NewJobHandler(svc, nil), body)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Contains(t, rec.Body.String(), `"prestige":1`)
+	})
+
+	t.Run("below the level cap is a bad request", func(t *testing.T) {
+		svc := mocks.NewMockJobService(t)
+		svc.On("PrestigeJob", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+			Return(nil, domain.ErrJobBelowLevelCap)
+
+		rec := post(NewJobHandler(svc, nil), body)
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("locked job", func(t *testing.T) {
+		svc := mocks.NewMockJobService(t)
+		svc.On("PrestigeJob", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+			Return(nil, domain.ErrFeatureLocked)
+
+		rec := post(NewJobHandler(svc, nil), body)
+
+		assert.Equal(t, http.StatusForbidden, rec.Code)
+	})
+
+	t.Run("unexpected errors are hidden", func(t *testing.T) {
+		svc := mocks.NewMockJobService(t)
+		svc.On("PrestigeJob", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+			Return(nil, errors.New("db down"))
+
+		rec := post(NewJobHandler(svc, nil), body)
+
+		assert.Equal(t, http.StatusInternalServerError, rec.Code)
+		assert.Contains(t, rec.Body.String(), ErrMsgPrestigeJobFailed)
+	})
+}
+
+func TestJobHandler_HandleGetJobPerks(t *testing.T) {
+	get := func(h *JobHandler, jobKey, query string) *httptest.ResponseRecorder {
+		rctx := chi.NewRouteContext()
+		rctx.URLParams.Add("job_key", jobKey)
+		req := httptest.NewRequest(http.MethodGet, "/jobs/"+jobKey+"/perks"+query, nil)
+		req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, rctx))
+		rec := httptest.NewRecorder()
+		h.HandleGetJobPerks(rec, req)
+		return rec
+	}
+
+	t.Run("returns the user's perks", func(t *testing.T) {
+		svc := mocks.NewMockJobService(t)
+		svc.On("GetJobPerks", mock.Anything, job.JobKeyExplorer, domain.PlatformTwitch, "u1").
+			Return(&domain.JobPerks{JobKey: job.JobKeyExplorer, Perks: []domain.JobPerk{{Level: 1, Name: "Scout", Unlocked: true}}}, nil)
+
+		rec := get(NewJobHandler(svc, nil), job.JobKeyExplorer, "?platform=twitch&platform_id=u1")
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Contains(t, rec.Body.String(), `"Scout"`)
+	})
+
+	t.Run("works without a user", func(t *testing.T) {
+		svc := mocks.NewMockJobService(t)
+		svc.On("GetJobPerks", mock.Anything, job.JobKeyExplorer, "", "").
+			Return(&domain.JobPerks{JobKey: job.JobKeyExplorer}, nil)
+
+		rec := get(NewJobHandler(svc, nil), job.JobKeyExplorer, "")
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+	})
+
+	t.Run("unknown job", func(t *testing.T) {
+		svc := mocks.NewMockJobService(t)
+		svc.On("GetJobPerks", mock.Anything, "job_astronaut", "", "").Return(nil, domain.ErrUnknownJob)
+
+		rec := get(NewJobHandler(svc, nil), "job_astronaut", "")
+
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+	})
+}
+
+func TestJobHandler_HandleGetJobs(t *testing.T) {
+	svc := mocks.NewMockJobService(t)
+	svc.On("GetAllJobs", mock.Anything).Return([]domain.Job{{ID: 2, JobKey: job.JobKeyExplorer}}, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/jobs", nil)
+	rec := httptest.NewRecorder()
+	NewJobHandler(svc, nil).HandleGetJobs(rec, req)
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Body.String(), job.JobKeyExplorer)
+}
@@ -45,6 +45,16 @@ type UnlinkRequest struct {
 }
 
 // HandleInitiate handles POST /link/initiate (Step 1)
+// @Summary Start an account link
+// @Description Issue a token to enter on the other platform to link the two accounts
+// @Tags linking
+// @Accept json
+// @Produce json
+// @Param request body InitiateRequest true "Account starting the link"
+// @Success 200 {object} InitiateResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /api/v1/link/initiate [post]
 func (h *LinkingHandlers) HandleInitiate() http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		log := logger.FromContext(r.Context())
@@ -62,8 +72,7 @@ func (h *LinkingHandlers) HandleInitiate() http.HandlerFunc {
 		token, err := h.svc.InitiateLink(r.Context(), req.Platform, req.PlatformID)
 		if err != nil {
 			log.Error("Failed to initiate link", "error", err)
-			statusCode, userMsg := MapServiceErrorToUserMessage(err)
-			RespondError(w, statusCode, userMsg)
+			RespondMappedError(w, err)
 			return
 		}
 
@@ -75,6 +84,15 @@ func (h *LinkingHandlers) HandleInitiate() http.HandlerFunc {
 }
 
 // HandleClaim handles POST /link/claim (Step 2)
+// @Summary Claim a link token
+// @Description Enter a link token from the other platform; the link then waits for confirmation there
+// @Tags linking
+// @Accept json
+// @Produce json
+// @Param request body ClaimRequest true "Token and claiming account"
+// @Success 200 {object} ClaimResponse
+// @Failure 400 {object} ErrorResponse
+// @Router /api/v1/link/claim [post]
 func (h *LinkingHandlers) HandleClaim() http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		log := logger.FromContext(r.Context())
@@ -104,6 +122,15 @@ func (h *LinkingHandlers) HandleClaim() http.HandlerFunc {
 }
 
 // HandleConfirm handles POST /link/confirm (Step 3)
+// @Summary Confirm an account link
+// @Description Confirm a claimed link from the account that started it, merging the two accounts
+// @Tags linking
+// @Accept json
+// @Produce json
+// @Param request body ConfirmRequest true "Account that started the link"
+// @Success 200 {object} linking.LinkResult
+// @Failure 400 {object} ErrorResponse
+// @Router /api/v1/link/confirm [post]
 func (h *LinkingHandlers) HandleConfirm() http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		log := logger.FromContext(r.Context())
@@ -130,6 +157,16 @@ func (h *LinkingHandlers) HandleConfirm() http.HandlerFunc {
 }
 
 // HandleUnlink handles POST /link/unlink
+// @Summary Unlink a platform
+// @Description Without confirm, starts an unlink that must be confirmed in time; with confirm, removes the link
+// @Tags linking
+// @Accept json
+// @Produce json
+// @Param request body UnlinkRequest true "Platform to unlink"
+// @Success 200 {object} UnlinkResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /api/v1/link/unlink [post]
 func (h *LinkingHandlers) HandleUnlink() http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		log := logger.FromContext(r.Context())
@@ -148,12 +185,11 @@ func (h *LinkingHandlers) HandleUnlink() http.HandlerFunc {
 			// Step 1: Initiate unlink
 			if err := h.svc.InitiateUnlink(r.Context(), req.Platform, req.PlatformID, req.TargetPlatform); err != nil {
 				log.Error("Failed to initiate unlink", "error", err)
-				statusCode, userMsg := MapServiceErrorToUserMessage(err)
-				RespondError(w, statusCode, userMsg)
+				RespondMappedError(w, err)
 				return
 			}
 
-			RespondJSON(w, http.StatusOK, UnlinkInitiateResponse{
+			RespondJSON(w, http.StatusOK, UnlinkResponse{
 				AwaitingConfirmation: true,
 				Message:              MsgConfirmWithinSeconds,
 			})
@@ -167,7 +203,7 @@ func (h *LinkingHandlers) HandleUnlink() http.HandlerFunc {
 			return
 		}
 
-		RespondJSON(w, http.StatusOK, UnlinkConfirmResponse{
+		RespondJSON(w, http.StatusOK, UnlinkResponse{
 			Success: true,
 			Message: MsgPlatformUnlinked,
 		})
@@ -188,19 +224,25 @@ type ClaimResponse struct {
 	AwaitingConfirmation bool   `json:"awaiting_confirmation"`
 }
 
-// UnlinkInitiateResponse is the response body for initiating an unlink
-type UnlinkInitiateResponse struct {
-	AwaitingConfirmation bool   `json:"awaiting_confirmation"`
+// UnlinkResponse is the response body for unlinking. Starting an unlink
+// sets AwaitingConfirmation; confirming it sets Success.
+type UnlinkResponse struct {
+	AwaitingConfirmation bool   `json:"awaiting_confirmation,omitempty"`
+	Success              bool   `json:"success,omitempty"`
 	Message              string `json:"message"`
 }
 
-// UnlinkConfirmResponse is the response body for confirming an unlink
-type UnlinkConfirmResponse struct {
-	Success bool   `json:"success"`
-	Message string `json:"message"`
-}
-
 // HandleStatus handles GET /link/status
+// @Summary Get link status
+// @Description The platforms linked to the account and any pending link token
+// @Tags linking
+// @Produce json
+// @Param platform query string true "Platform (twitch, youtube, discord)"
+// @Param platform_id query string true "Platform user ID"
+// @Success 200 {object} linking.LinkStatus
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /api/v1/link/status [get]
 func (h *LinkingHandlers) HandleStatus() http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		log := logger.FromContext(r.Context())
@@ -217,8 +259,7 @@ func (h *LinkingHandlers) HandleStatus() http.HandlerFunc {
 		status, err := h.svc.GetStatus(r.Context(), platform, platformID)
 		if err != nil {
 			log.Error("Failed to get link status", "error", err)
-			statusCode, userMsg := MapServiceErrorToUserMessage(err)
-			RespondError(w, statusCode, userMsg)
+			RespondMappedError(w, err)
 			return
 		}
 
@@ -0,0 +1,172 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/loan"
+	"github.com/osse101/BrandishBot_Go/internal/logger"
+)
+
+// LendItemRequest asks to lend items to another user on the same platform
+type LendItemRequest struct {
+	Platform   string `json:"platform" validate:"required,platform"`
+	PlatformID string `json:"platform_id" validate:"required"`
+	Username   string `json:"username" validate:"required,max=100,excludesall=\x00\n\r\t"`
+	Borrower   string `json:"borrower" validate:"required,max=100,excludesall=\x00\n\r\t"`
+	ItemName   string `json:"item_name" validate:"required,max=100"`
+	Quantity   int    `json:"quantity" validate:"min=1,max=10000"`
+	// DurationMinutes is how long the loan lasts; 0 uses the server default
+	DurationMinutes int `json:"duration_minutes" validate:"min=0"`
+}
+
+// LoansResponse lists a user's active loans, both made and taken
+type LoansResponse struct {
+	Loans []domain.ItemLoan `json:"loans"`
+}
+
+// LoanHandler handles item loans between users
+type LoanHandler struct {
+	service loan.Service
+}
+
+// NewLoanHandler creates a new loan handler
+func NewLoanHandler(service loan.Service) *LoanHandler {
+	return &LoanHandler{service: service}
+}
+
+// HandleGetLoans lists a user's active loans
+// @Summary List item loans
+// @Tags inventory
+// @Produce json
+// @Param platform query string true "Platform"
+// @Param platform_id query string true "Platform user ID"
+// @Success 200 {object} LoansResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /api/v1/user/loans [get]
+func (h *LoanHandler) HandleGetLoans(w http.ResponseWriter, r *http.Request) {
+	platform, platformID, ok := platformUserParams(r, w)
+	if !ok {
+		return
+	}
+
+	loans, err := h.service.GetLoans(r.Context(), platform, platformID)
+	if err != nil {
+		if errors.Is(err, domain.ErrUserNotFound) {
+			RespondError(w, http.StatusNotFound, ErrMsgUserNotFoundHTTP)
+			return
+		}
+		logger.FromContext(r.Context()).Error("Failed to get loans", "error", err, "platform", platform)
+		RespondError(w, http.StatusInternalServerError, ErrMsgGetLoansFailed)
+		return
+	}
+
+	if loans == nil {
+		loans = []domain.ItemLoan{}
+	}
+	RespondJSON(w, http.StatusOK, LoansResponse{Loans: loans})
+}
+
+// HandleLendItem lends items to another user
+// @Summary Lend item
+// @Description Moves items to the borrower until the loan falls due, when they go back to the lender automatically. Borrowed items cannot be sold, disassembled or lent on. A borrower who no longer has all the items returns what they have and the loan is marked defaulted. Publishes "item.loaned" and "item.loan_returned" events.
+// @Tags inventory
+// @Accept json
+// @Produce json
+// @Param request body LendItemRequest true "Loan"
+// @Success 201 {object} domain.ItemLoan
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /api/v1/user/loans [post]
+func (h *LoanHandler) HandleLendItem(w http.ResponseWriter, r *http.Request) {
+	var req LendItemRequest
+	if err := DecodeAndValidateRequest(r, w, &req, "Lend item"); err != nil {
+		return
+	}
+
+	created, err := h.service.LendItem(r.Context(), loan.LendRequest{
+		Platform:   req.Platform,
+		PlatformID: req.PlatformID,
+		Username:   req.Username,
+		Borrower:   req.Borrower,
+		ItemName:   req.ItemName,
+		Quantity:   req.Quantity,
+		Duration:   time.Duration(req.DurationMinutes) * time.Minute,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrCannotLendToSelf),
+			errors.Is(err, domain.ErrInvalidLoanDuration),
+			errors.Is(err, domain.ErrInvalidInput):
+			RespondError(w, http.StatusBadRequest, err.Error())
+			return
+		case errors.Is(err, domain.ErrUserNotFound),
+			errors.Is(err, domain.ErrItemNotFound),
+			errors.Is(err, domain.ErrItemBorrowed),
+			errors.Is(err, domain.ErrItemLockedByUser),
+			errors.Is(err, domain.ErrInventoryFull),
+			errors.Is(err, domain.ErrInsufficientQuantity),
+			errors.Is(err, domain.ErrNotInInventory):
+			RespondMappedError(w, err)
+			return
+		}
+		logger.FromContext(r.Context()).Error("Failed to lend item", "error", err, "platform", req.Platform, "item", req.ItemName)
+		RespondError(w, http.StatusInternalServerError, ErrMsgLendItemFailed)
+		return
+	}
+
+	RespondJSON(w, http.StatusCreated, created)
+}
+
+// HandleReturnLoan gives borrowed items back to their lender early
+// @Summary Return loan
+// @Description Only the borrower can return a loan early, and only with all of the borrowed items.
+// @Tags inventory
+// @Produce json
+// @Param id path int true "Loan ID"
+// @Param platform query string true "Platform"
+// @Param platform_id query string true "Platform user ID"
+// @Success 200 {object} domain.ItemLoan
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /api/v1/user/loans/{id}/return [post]
+func (h *LoanHandler) HandleReturnLoan(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil || id <= 0 {
+		RespondError(w, http.StatusBadRequest, ErrMsgInvalidLoanID)
+		return
+	}
+	platform, platformID, ok := platformUserParams(r, w)
+	if !ok {
+		return
+	}
+
+	returned, err := h.service.ReturnLoan(r.Context(), platform, platformID, id)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrUserNotFound):
+			RespondError(w, http.StatusNotFound, ErrMsgUserNotFoundHTTP)
+			return
+		case errors.Is(err, domain.ErrLoanNotFound):
+			RespondError(w, http.StatusNotFound, ErrMsgLoanNotFoundHTTP)
+			return
+		case errors.Is(err, domain.ErrInsufficientQuantity),
+			errors.Is(err, domain.ErrInventoryFull):
+			RespondError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		logger.FromContext(r.Context()).Error("Failed to return loan", "error", err, "platform", platform, "loan_id", id)
+		RespondError(w, http.StatusInternalServerError, ErrMsgReturnLoanFailed)
+		return
+	}
+
+	RespondJSON(w, http.StatusOK, returned)
+}
@@ -0,0 +1,97 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/loan"
+	"github.com/osse101/BrandishBot_Go/mocks"
+)
+
+func TestLoanHandler_HandleLendItem(t *testing.T) {
+	post := func(h *LoanHandler, body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/user/loans", bytes.NewBufferString(body))
+		rec := httptest.NewRecorder()
+		h.HandleLendItem(rec, req)
+		return rec
+	}
+
+	t.Run("lends the item", func(t *testing.T) {
+		svc := mocks.NewMockLoanService(t)
+		svc.On("LendItem", mock.Anything, mock.MatchedBy(func(req loan.LendRequest) bool {
+			return req.Borrower == "bob" && req.Quantity == 2 && req.Duration == 90*time.Minute
+		})).Return(&domain.ItemLoan{ID: 9, Status: domain.LoanStatusActive}, nil)
+
+		rec := post(NewLoanHandler(svc), `{"platform":"discord","platform_id":"d-1","username":"alice","borrower":"bob","item_name":"sword","quantity":2,"duration_minutes":90}`)
+
+		assert.Equal(t, http.StatusCreated, rec.Code)
+		assert.Contains(t, rec.Body.String(), `"id":9`)
+	})
+
+	t.Run("borrowed items are a bad request", func(t *testing.T) {
+		svc := mocks.NewMockLoanService(t)
+		svc.On("LendItem", mock.Anything, mock.Anything).Return(nil, domain.ErrItemBorrowed)
+
+		rec := post(NewLoanHandler(svc), `{"platform":"discord","platform_id":"d-1","username":"alice","borrower":"bob","item_name":"sword","quantity":1}`)
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		assert.Contains(t, rec.Body.String(), ErrMsgItemBorrowedError)
+	})
+
+	t.Run("lending to yourself is a bad request", func(t *testing.T) {
+		svc := mocks.NewMockLoanService(t)
+		svc.On("LendItem", mock.Anything, mock.Anything).Return(nil, domain.ErrCannotLendToSelf)
+
+		rec := post(NewLoanHandler(svc), `{"platform":"discord","platform_id":"d-1","username":"alice","borrower":"alice","item_name":"sword","quantity":1}`)
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+}
+
+func TestLoanHandler_HandleReturnLoan(t *testing.T) {
+	returnLoan := func(h *LoanHandler, id string) *httptest.ResponseRecorder {
+		rctx := chi.NewRouteContext()
+		rctx.URLParams.Add("id", id)
+		req := httptest.NewRequest(http.MethodPost, "/user/loans/"+id+"/return?platform=discord&platform_id=d-2", nil)
+		req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, rctx))
+		rec := httptest.NewRecorder()
+		h.HandleReturnLoan(rec, req)
+		return rec
+	}
+
+	t.Run("returns the loan", func(t *testing.T) {
+		svc := mocks.NewMockLoanService(t)
+		svc.On("ReturnLoan", mock.Anything, domain.PlatformDiscord, "d-2", int64(9)).Return(&domain.ItemLoan{ID: 9, Status: domain.LoanStatusReturned}, nil)
+
+		rec := returnLoan(NewLoanHandler(svc), "9")
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Contains(t, rec.Body.String(), `"status":"returned"`)
+	})
+
+	t.Run("unknown loan", func(t *testing.T) {
+		svc := mocks.NewMockLoanService(t)
+		svc.On("ReturnLoan", mock.Anything, domain.PlatformDiscord, "d-2", int64(9)).Return(nil, domain.ErrLoanNotFound)
+
+		rec := returnLoan(NewLoanHandler(svc), "9")
+
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+	})
+
+	t.Run("rejects invalid IDs", func(t *testing.T) {
+		svc := mocks.NewMockLoanService(t)
+
+		rec := returnLoan(NewLoanHandler(svc), "abc")
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+}
@@ -1,6 +1,7 @@
 package handler
 
 import (
+	"context"
 	"net/http"
 	"time"
 
@@ -20,9 +21,24 @@ type HandleMessageRequest struct {
 	Message    string `json:"message"`
 }
 
-// HandleMessageHandler handles the incoming message flow.
+// CommandDispatcher runs the text command in a chat message, returning nil
+// when the message is not a command
+type CommandDispatcher interface {
+	Dispatch(r *http.Request, platform, platformID, username, message string) *domain.CommandResult
+}
+
+// KeywordDropper rolls the keyword drops a chat message may earn during an
+// event theme
+type KeywordDropper interface {
+	DropKeyword(ctx context.Context, userID, platform, message string) (*domain.ChatDrop, error)
+}
+
+// HandleMessageHandler handles the incoming message flow. When commands is
+// set, a message that is a text command is also run and its outcome returned
+// in the command field. When drops is set, a message saying an active event
+// theme's keyword may drop an item, returned in the keyword_drop field.
 // @Summary Handle chat message
-// @Description Process a chat message for potential commands or triggers
+// @Description Process a chat message for string triggers, and run it as a text command (e.g. "!buy junkbox 2") when it starts with the command prefix. The command's reply is in command.reply.
 // @Tags message
 // @Accept json
 // @Produce json
@@ -31,7 +47,7 @@ type HandleMessageRequest struct {
 // @Failure 400 {object} ErrorResponse
 // @Failure 500 {object} ErrorResponse
 // @Router /api/v1/message/handle [post]
-func HandleMessageHandler(userService user.Service, progressionSvc progression.Service, eventBus event.Bus) http.HandlerFunc {
+func HandleMessageHandler(userService user.Service, progressionSvc progression.Service, eventBus event.Bus, commands CommandDispatcher, drops KeywordDropper) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		start := time.Now()
 		log := logger.FromContext(r.Context())
@@ -54,8 +70,7 @@ func HandleMessageHandler(userService user.Service, progressionSvc progression.S
 				"platform", req.Platform,
 				"platform_id", req.PlatformID,
 				"username", req.Username)
-			statusCode, userMsg := MapServiceErrorToUserMessage(err)
-			RespondError(w, statusCode, userMsg)
+			RespondMappedError(w, err)
 			return
 		}
 
@@ -70,13 +85,29 @@ func HandleMessageHandler(userService user.Service, progressionSvc progression.S
 			1,
 		)
 
+		// A failed keyword drop must not fail the message
+		if drops != nil {
+			drop, err := drops.DropKeyword(ctx, result.User.ID, req.Platform, req.Message)
+			if err != nil {
+				log.Warn("Failed to roll keyword drop", "error", err, "user_id", result.User.ID)
+			}
+			result.KeywordDrop = drop
+		}
+
+		// The user is registered by now, so the command can run as them
+		if commands != nil {
+			result.Command = commands.Dispatch(r.WithContext(ctx), req.Platform, req.PlatformID, req.Username, req.Message)
+		}
+
 		// Single consolidated summary log
 		duration := time.Since(start)
 		log.Info("Message processed",
 			"username", req.Username,
 			"platform", req.Platform,
 			"duration_ms", duration.Milliseconds(),
-			"matches_found", len(result.Matches))
+			"matches_found", len(result.Matches),
+			"command", result.Command != nil,
+			"keyword_drop", result.KeywordDrop != nil)
 
 		RespondJSON(w, http.StatusOK, result)
 	}
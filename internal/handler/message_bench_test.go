@@ -15,7 +15,9 @@ import (
 
 	"github.com/osse101/BrandishBot_Go/internal/domain"
 	"github.com/osse101/BrandishBot_Go/internal/event"
+	"github.com/osse101/BrandishBot_Go/internal/itemhandler"
 	"github.com/osse101/BrandishBot_Go/internal/user"
+	"github.com/osse101/BrandishBot_Go/internal/utils"
 	"github.com/osse101/BrandishBot_Go/mocks"
 )
 
@@ -79,9 +81,21 @@ func (m *benchMockUserService) ApplyShield(ctx context.Context, user *domain.Use
 func (m *benchMockUserService) UseItem(ctx context.Context, platform, platformID, username, itemName string, quantity int, targetUsername string) (string, error) {
 	return "", nil
 }
+func (m *benchMockUserService) UseItemDetailed(ctx context.Context, platform, platformID, username, itemName string, quantity int, targetUsername string) (*itemhandler.UseResult, error) {
+	return &itemhandler.UseResult{}, nil
+}
 func (m *benchMockUserService) GetInventory(ctx context.Context, platform, platformID, username, filter string) ([]user.InventoryItem, error) {
 	return nil, nil
 }
+func (m *benchMockUserService) GetInventoryCapacity(ctx context.Context, platform, platformID, username string) (*domain.InventoryCapacity, error) {
+	return nil, nil
+}
+func (m *benchMockUserService) EnsureInventoryRoom(ctx context.Context, userID string, itemID int, qualityLevel domain.QualityLevel) error {
+	return nil
+}
+func (m *benchMockUserService) SettleInventoryOverflow(ctx context.Context, before map[utils.SlotKey]int, inventory *domain.Inventory) (int, int, error) {
+	return 0, 0, nil
+}
 func (m *benchMockUserService) GiveItem(ctx context.Context, ownerPlatform, ownerPlatformID, ownerUsername, receiverPlatform, receiverUsername, itemName string, quantity int) error {
 	return nil
 }
@@ -124,6 +138,9 @@ func (m *benchMockUserService) GetTimeoutPlatform(ctx context.Context, platform,
 func (m *benchMockUserService) ReduceTimeoutPlatform(ctx context.Context, platform, username string, reduction time.Duration) error {
 	return nil
 }
+func (m *benchMockUserService) RestoreTimeouts(ctx context.Context) error {
+	return nil
+}
 func (m *benchMockUserService) TimeoutUser(ctx context.Context, username string, duration time.Duration, reason string) error {
 	return nil
 }
@@ -161,7 +178,7 @@ func BenchmarkHandler_HandleMessage(b *testing.B) {
 	mockProgressionService := mocks.NewMockProgressionService(b)
 	mockEventBus := &benchMockEventBus{}
 
-	handler := HandleMessageHandler(mockUserService, mockProgressionService, mockEventBus)
+	handler := HandleMessageHandler(mockUserService, mockProgressionService, mockEventBus, nil, nil)
 
 	reqBody := HandleMessageRequest{
 		Platform:   "twitch",
@@ -194,7 +211,7 @@ func BenchmarkHandler_HandleMessage_ExistingUser(b *testing.B) {
 	mockProgressionService := mocks.NewMockProgressionService(b)
 	mockEventBus := &benchMockEventBus{}
 
-	handler := HandleMessageHandler(mockUserService, mockProgressionService, mockEventBus)
+	handler := HandleMessageHandler(mockUserService, mockProgressionService, mockEventBus, nil, nil)
 
 	reqBody := HandleMessageRequest{
 		Platform:   "twitch",
@@ -223,7 +240,7 @@ func BenchmarkHandler_HandleMessage_WithMatches(b *testing.B) {
 	mockProgressionService := mocks.NewMockProgressionService(b)
 	mockEventBus := &benchMockEventBus{}
 
-	handler := HandleMessageHandler(mockUserService, mockProgressionService, mockEventBus)
+	handler := HandleMessageHandler(mockUserService, mockProgressionService, mockEventBus, nil, nil)
 
 	reqBody := HandleMessageRequest{
 		Platform:   "discord",
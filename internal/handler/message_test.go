@@ -2,6 +2,7 @@ package handler
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"net/http"
@@ -107,7 +108,7 @@ func TestHandleMessageHandler(t *testing.T) {
 
 			tt.setupMocks(mockUser, mockProgression, mockEvent)
 
-			handler := HandleMessageHandler(mockUser, mockProgression, mockEvent)
+			handler := HandleMessageHandler(mockUser, mockProgression, mockEvent, nil, nil)
 
 			var reqBody []byte
 			if str, ok := tt.body.(string); ok && str == "invalid-json" {
@@ -134,3 +135,87 @@ func TestHandleMessageHandler(t *testing.T) {
 		})
 	}
 }
+
+type stubDispatcher struct {
+	message string
+	result  *domain.CommandResult
+}
+
+func (s *stubDispatcher) Dispatch(_ *http.Request, _, _, _, message string) *domain.CommandResult {
+	s.message = message
+	return s.result
+}
+
+func TestHandleMessageHandler_Command(t *testing.T) {
+	mockUser := mocks.NewMockUserService(t)
+	mockProgression := mocks.NewMockProgressionService(t)
+	mockEvent := mocks.NewMockEventBus(t)
+
+	mockUser.On("HandleIncomingMessage", mock.Anything, domain.PlatformTwitch, "123", "testuser", "!buy junkbox").
+		Return(&domain.MessageResult{User: domain.User{ID: "user-123", Username: "testuser"}}, nil)
+	mockEvent.On("Publish", mock.Anything, mock.Anything).Return(nil)
+
+	commands := &stubDispatcher{result: &domain.CommandResult{Name: "buy", Args: []string{"junkbox"}, OK: true, Reply: "Bought 1 junkbox"}}
+	h := HandleMessageHandler(mockUser, mockProgression, mockEvent, commands, nil)
+
+	reqBody, err := json.Marshal(HandleMessageRequest{Platform: domain.PlatformTwitch, PlatformID: "123", Username: "testuser", Message: "!buy junkbox"})
+	require.NoError(t, err)
+	rec := httptest.NewRecorder()
+	h(rec, httptest.NewRequest(http.MethodPost, "/message/handle", bytes.NewReader(reqBody)))
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "!buy junkbox", commands.message)
+	var result domain.MessageResult
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &result))
+	require.NotNil(t, result.Command)
+	assert.True(t, result.Command.OK)
+	assert.Equal(t, "Bought 1 junkbox", result.Command.Reply)
+}
+
+type stubKeywordDropper struct {
+	drop *domain.ChatDrop
+	err  error
+}
+
+func (s stubKeywordDropper) DropKeyword(_ context.Context, _, _, _ string) (*domain.ChatDrop, error) {
+	return s.drop, s.err
+}
+
+func TestHandleMessageHandler_KeywordDrop(t *testing.T) {
+	tests := []struct {
+		name     string
+		dropper  stubKeywordDropper
+		wantDrop bool
+	}{
+		{name: "returns the dropped item", dropper: stubKeywordDropper{drop: &domain.ChatDrop{UserID: "user-123", ItemName: "item_emote_pog", Quantity: 1}}, wantDrop: true},
+		{name: "a failed drop does not fail the message", dropper: stubKeywordDropper{err: errors.New("db down")}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUser := mocks.NewMockUserService(t)
+			mockProgression := mocks.NewMockProgressionService(t)
+			mockEvent := mocks.NewMockEventBus(t)
+			mockUser.On("HandleIncomingMessage", mock.Anything, domain.PlatformTwitch, "123", "testuser", "PogChamp").
+				Return(&domain.MessageResult{User: domain.User{ID: "user-123", Username: "testuser"}}, nil)
+			mockEvent.On("Publish", mock.Anything, mock.Anything).Return(nil)
+
+			h := HandleMessageHandler(mockUser, mockProgression, mockEvent, nil, tt.dropper)
+
+			reqBody, err := json.Marshal(HandleMessageRequest{Platform: domain.PlatformTwitch, PlatformID: "123", Username: "testuser", Message: "PogChamp"})
+			require.NoError(t, err)
+			rec := httptest.NewRecorder()
+			h(rec, httptest.NewRequest(http.MethodPost, "/message/handle", bytes.NewReader(reqBody)))
+
+			require.Equal(t, http.StatusOK, rec.Code)
+			var result domain.MessageResult
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &result))
+			if tt.wantDrop {
+				require.NotNil(t, result.KeywordDrop)
+				assert.Equal(t, "item_emote_pog", result.KeywordDrop.ItemName)
+			} else {
+				assert.Nil(t, result.KeywordDrop)
+			}
+		})
+	}
+}
@@ -0,0 +1,54 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/logger"
+	"github.com/osse101/BrandishBot_Go/internal/monetization"
+)
+
+// MonetizationHandler handles subscription and donation webhooks
+type MonetizationHandler struct {
+	service monetization.Service
+}
+
+// NewMonetizationHandler creates a new monetization handler
+func NewMonetizationHandler(service monetization.Service) *MonetizationHandler {
+	return &MonetizationHandler{service: service}
+}
+
+// HandleEvent grants the configured rewards for a sub, resub, gift sub, cheer or donation
+// @Summary Receive monetization event
+// @Description Processes a Twitch subscription/cheer or Streamlabs donation event relayed by Streamer.bot and grants the rewards configured for it. Events are deduplicated by source and event ID, so redeliveries are acknowledged without granting rewards again.
+// @Tags monetization
+// @Accept json
+// @Produce json
+// @Param event body domain.MonetizationEvent true "Monetization event"
+// @Success 200 {object} domain.MonetizationResult
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /api/v1/monetization/event [post]
+func (h *MonetizationHandler) HandleEvent(w http.ResponseWriter, r *http.Request) {
+	var evt domain.MonetizationEvent
+	if err := DecodeAndValidateRequest(r, w, &evt, "Monetization event"); err != nil {
+		return
+	}
+
+	log := logger.FromContext(r.Context())
+
+	result, err := h.service.HandleEvent(r.Context(), evt)
+	if err != nil {
+		if errors.Is(err, domain.ErrDuplicateMonetizationEvent) {
+			// Acknowledge so the sender stops retrying
+			RespondJSON(w, http.StatusOK, SuccessResponse{Message: MsgMonetizationEventDuplicate})
+			return
+		}
+		log.Error("Failed to handle monetization event", "error", err, "source", evt.Source, "event_id", evt.EventID)
+		RespondError(w, http.StatusInternalServerError, ErrMsgMonetizationEventFailed)
+		return
+	}
+
+	RespondJSON(w, http.StatusOK, result)
+}
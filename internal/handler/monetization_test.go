@@ -0,0 +1,69 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/mocks"
+)
+
+func TestMonetizationHandler_HandleEvent(t *testing.T) {
+	validBody := `{"source":"twitch","event_id":"evt-1","kind":"sub","platform":"twitch","platform_id":"tw-1","username":"alice","tier":"1000"}`
+
+	post := func(h *MonetizationHandler, body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/monetization/event", bytes.NewBufferString(body))
+		rec := httptest.NewRecorder()
+		h.HandleEvent(rec, req)
+		return rec
+	}
+
+	t.Run("returns the granted rewards", func(t *testing.T) {
+		svc := mocks.NewMockMonetizationService(t)
+		svc.On("HandleEvent", mock.Anything, mock.MatchedBy(func(evt domain.MonetizationEvent) bool {
+			return evt.EventID == "evt-1" && evt.Kind == domain.MonetizationKindSub
+		})).Return(&domain.MonetizationResult{Source: "twitch", EventID: "evt-1", Matched: true, Contribution: 30}, nil)
+
+		rec := post(NewMonetizationHandler(svc), validBody)
+
+		require.Equal(t, http.StatusOK, rec.Code)
+		var result domain.MonetizationResult
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &result))
+		assert.Equal(t, 30, result.Contribution)
+	})
+
+	t.Run("acknowledges duplicates", func(t *testing.T) {
+		svc := mocks.NewMockMonetizationService(t)
+		svc.On("HandleEvent", mock.Anything, mock.Anything).Return(nil, domain.ErrDuplicateMonetizationEvent)
+
+		rec := post(NewMonetizationHandler(svc), validBody)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Contains(t, rec.Body.String(), MsgMonetizationEventDuplicate)
+	})
+
+	t.Run("rejects unknown kinds", func(t *testing.T) {
+		svc := mocks.NewMockMonetizationService(t)
+
+		rec := post(NewMonetizationHandler(svc), `{"source":"twitch","event_id":"evt-1","kind":"raid"}`)
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("service errors return 500 so the sender retries", func(t *testing.T) {
+		svc := mocks.NewMockMonetizationService(t)
+		svc.On("HandleEvent", mock.Anything, mock.Anything).Return(nil, errors.New("db down"))
+
+		rec := post(NewMonetizationHandler(svc), validBody)
+
+		assert.Equal(t, http.StatusInternalServerError, rec.Code)
+	})
+}
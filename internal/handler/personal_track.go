@@ -0,0 +1,60 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/logger"
+	"github.com/osse101/BrandishBot_Go/internal/personaltrack"
+)
+
+// PersonalProgressionResponse lists a user's progress along the personal tracks
+type PersonalProgressionResponse struct {
+	Tracks []domain.PersonalTrack `json:"tracks"`
+}
+
+// PersonalTrackHandler handles personal progression tracks
+type PersonalTrackHandler struct {
+	service personaltrack.Service
+}
+
+// NewPersonalTrackHandler creates a new personal track handler
+func NewPersonalTrackHandler(service personaltrack.Service) *PersonalTrackHandler {
+	return &PersonalTrackHandler{service: service}
+}
+
+// HandleGetProgression returns a user's personal track progress
+// @Summary Get personal progression
+// @Description Lists the personal tracks with the user's progress toward each milestone. Milestones unlock from the user's own engagement and publish a "progression.milestone_unlocked" event.
+// @Tags progression
+// @Produce json
+// @Param platform query string true "Platform"
+// @Param platform_id query string true "Platform user ID"
+// @Success 200 {object} PersonalProgressionResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /api/v1/user/progression [get]
+func (h *PersonalTrackHandler) HandleGetProgression(w http.ResponseWriter, r *http.Request) {
+	platform, platformID, ok := platformUserParams(r, w)
+	if !ok {
+		return
+	}
+
+	tracks, err := h.service.GetProgress(r.Context(), platform, platformID)
+	if err != nil {
+		if errors.Is(err, domain.ErrUserNotFound) {
+			RespondError(w, http.StatusNotFound, ErrMsgUserNotFoundHTTP)
+			return
+		}
+		logger.FromContext(r.Context()).Error("Failed to get personal progression", "error", err, "platform", platform)
+		RespondError(w, http.StatusInternalServerError, ErrMsgGetPersonalProgressionFailed)
+		return
+	}
+
+	if tracks == nil {
+		tracks = []domain.PersonalTrack{}
+	}
+	RespondJSON(w, http.StatusOK, PersonalProgressionResponse{Tracks: tracks})
+}
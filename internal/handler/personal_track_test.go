@@ -0,0 +1,67 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/mocks"
+)
+
+func TestPersonalTrackHandler_HandleGetProgression(t *testing.T) {
+	get := func(h *PersonalTrackHandler, query string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, "/user/progression"+query, nil)
+		rec := httptest.NewRecorder()
+		h.HandleGetProgression(rec, req)
+		return rec
+	}
+
+	t.Run("lists the user's tracks", func(t *testing.T) {
+		svc := mocks.NewMockPersonalTrackService(t)
+		svc.On("GetProgress", mock.Anything, "discord", "d-1").Return([]domain.PersonalTrack{{
+			Key:        "chatter",
+			Name:       "Chatter",
+			Milestones: []domain.PersonalMilestone{{Key: "chatter_regular", Metric: domain.MetricTypeMessage, Threshold: 100, Progress: 40}},
+		}}, nil)
+
+		rec := get(NewPersonalTrackHandler(svc), "?platform=discord&platform_id=d-1")
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Contains(t, rec.Body.String(), `"key":"chatter_regular"`)
+		assert.Contains(t, rec.Body.String(), `"progress":40`)
+	})
+
+	t.Run("no tracks is an empty list", func(t *testing.T) {
+		svc := mocks.NewMockPersonalTrackService(t)
+		svc.On("GetProgress", mock.Anything, "discord", "d-1").Return(nil, nil)
+
+		rec := get(NewPersonalTrackHandler(svc), "?platform=discord&platform_id=d-1")
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Contains(t, rec.Body.String(), `"tracks":[]`)
+	})
+
+	t.Run("unknown user", func(t *testing.T) {
+		svc := mocks.NewMockPersonalTrackService(t)
+		svc.On("GetProgress", mock.Anything, "discord", "d-1").Return(nil, domain.ErrUserNotFound)
+
+		rec := get(NewPersonalTrackHandler(svc), "?platform=discord&platform_id=d-1")
+
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+	})
+
+	t.Run("service failure", func(t *testing.T) {
+		svc := mocks.NewMockPersonalTrackService(t)
+		svc.On("GetProgress", mock.Anything, "discord", "d-1").Return(nil, errors.New("db down"))
+
+		rec := get(NewPersonalTrackHandler(svc), "?platform=discord&platform_id=d-1")
+
+		assert.Equal(t, http.StatusInternalServerError, rec.Code)
+		assert.Contains(t, rec.Body.String(), ErrMsgGetPersonalProgressionFailed)
+	})
+}
@@ -0,0 +1,355 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/logger"
+	"github.com/osse101/BrandishBot_Go/internal/playershop"
+)
+
+// ListItemRequest asks to put items up for sale in the player shop
+type ListItemRequest struct {
+	Platform   string `json:"platform" validate:"required,platform"`
+	PlatformID string `json:"platform_id" validate:"required"`
+	Username   string `json:"username" validate:"required,max=100,excludesall=\x00\n\r\t"`
+	ItemName   string `json:"item_name" validate:"required,max=100"`
+	Quantity   int    `json:"quantity" validate:"min=1,max=10000"`
+	UnitPrice  int    `json:"unit_price" validate:"min=1"`
+}
+
+// BuyListingRequest asks to buy items from a player shop listing
+type BuyListingRequest struct {
+	Platform   string `json:"platform" validate:"required,platform"`
+	PlatformID string `json:"platform_id" validate:"required"`
+	Username   string `json:"username" validate:"required,max=100,excludesall=\x00\n\r\t"`
+	Quantity   int    `json:"quantity" validate:"min=1,max=10000"`
+}
+
+// MarketBuyRequest asks to buy items from the market listing with the ID
+type MarketBuyRequest struct {
+	Platform   string `json:"platform" validate:"required,platform"`
+	PlatformID string `json:"platform_id" validate:"required"`
+	Username   string `json:"username" validate:"required,max=100,excludesall=\x00\n\r\t"`
+	ListingID  int64  `json:"listing_id" validate:"min=1"`
+	Quantity   int    `json:"quantity" validate:"min=1,max=10000"`
+}
+
+// MarketCancelRequest asks to withdraw the caller's market listing
+type MarketCancelRequest struct {
+	Platform   string `json:"platform" validate:"required,platform"`
+	PlatformID string `json:"platform_id" validate:"required"`
+	ListingID  int64  `json:"listing_id" validate:"min=1"`
+}
+
+// ListingsResponse lists active player shop listings
+type ListingsResponse struct {
+	Listings []domain.PlayerListing `json:"listings"`
+}
+
+// CommunityPoolResponse reports the community pool's balance
+type CommunityPoolResponse struct {
+	Balance int64 `json:"balance"`
+}
+
+// PlayerShopHandler handles the player-to-player shop
+type PlayerShopHandler struct {
+	service playershop.Service
+}
+
+// NewPlayerShopHandler creates a new player shop handler
+func NewPlayerShopHandler(service playershop.Service) *PlayerShopHandler {
+	return &PlayerShopHandler{service: service}
+}
+
+// HandleGetListings searches active player shop listings
+// @Summary List player shop listings
+// @Description Active listings, cheapest first. Filter by item, seller, quality and unit price range, or sort the newest first.
+// @Tags economy
+// @Produce json
+// @Param item query string false "Item name"
+// @Param seller query string false "Seller username"
+// @Param quality query string false "Quality level"
+// @Param min_price query int false "Lowest unit price"
+// @Param max_price query int false "Highest unit price"
+// @Param sort query string false "price (default) or newest"
+// @Success 200 {object} ListingsResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /api/v1/shop/player [get]
+func (h *PlayerShopHandler) HandleGetListings(w http.ResponseWriter, r *http.Request) {
+	params := r.URL.Query()
+	query := playershop.ListingQuery{
+		ItemName: params.Get("item"),
+		Seller:   params.Get("seller"),
+		Quality:  domain.QualityLevel(params.Get("quality")),
+		Sort:     domain.ListingSort(params.Get("sort")),
+	}
+	for key, price := range map[string]*int{"min_price": &query.MinPrice, "max_price": &query.MaxPrice} {
+		if value := params.Get(key); value != "" {
+			parsed, err := strconv.Atoi(value)
+			if err != nil || parsed < 1 {
+				RespondError(w, http.StatusBadRequest, ErrMsgInvalidPriceFilter)
+				return
+			}
+			*price = parsed
+		}
+	}
+
+	listings, err := h.service.GetListings(r.Context(), query)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrInvalidInput):
+			RespondError(w, http.StatusBadRequest, err.Error())
+			return
+		case errors.Is(err, domain.ErrItemNotFound):
+			RespondMappedError(w, err)
+			return
+		}
+		logger.FromContext(r.Context()).Error("Failed to get listings", "error", err, "item", query.ItemName)
+		RespondError(w, http.StatusInternalServerError, ErrMsgGetListingsFailed)
+		return
+	}
+
+	if listings == nil {
+		listings = []domain.PlayerListing{}
+	}
+	RespondJSON(w, http.StatusOK, ListingsResponse{Listings: listings})
+}
+
+// HandleListItem puts items up for sale
+// @Summary List item in player shop
+// @Description Takes the items from one quality slot of the seller's inventory and holds them until they are bought or the listing is cancelled. The seller pays a listing fee, set by PLAYER_SHOP_LISTING_FEE_PERCENT of the total asking price, which is not refunded on cancel. Money, borrowed and locked items cannot be listed.
+// @Tags economy
+// @Accept json
+// @Produce json
+// @Param request body ListItemRequest true "Listing"
+// @Success 201 {object} domain.PlayerListing
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /api/v1/shop/player [post]
+// @Router /api/v1/market/list [post]
+func (h *PlayerShopHandler) HandleListItem(w http.ResponseWriter, r *http.Request) {
+	var req ListItemRequest
+	if err := DecodeAndValidateRequest(r, w, &req, "List item"); err != nil {
+		return
+	}
+
+	listing, err := h.service.ListItem(r.Context(), playershop.ListRequest{
+		Platform:   req.Platform,
+		PlatformID: req.PlatformID,
+		Username:   req.Username,
+		ItemName:   req.ItemName,
+		Quantity:   req.Quantity,
+		UnitPrice:  req.UnitPrice,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrTooManyListings),
+			errors.Is(err, domain.ErrInvalidListingPrice),
+			errors.Is(err, domain.ErrInvalidInput):
+			RespondError(w, http.StatusBadRequest, err.Error())
+			return
+		case errors.Is(err, domain.ErrUserNotFound),
+			errors.Is(err, domain.ErrItemNotFound),
+			errors.Is(err, domain.ErrInsufficientFunds),
+			errors.Is(err, domain.ErrItemBorrowed),
+			errors.Is(err, domain.ErrItemLockedByUser),
+			errors.Is(err, domain.ErrInsufficientQuantity),
+			errors.Is(err, domain.ErrNotInInventory):
+			RespondMappedError(w, err)
+			return
+		}
+		logger.FromContext(r.Context()).Error("Failed to list item", "error", err, "platform", req.Platform, "item", req.ItemName)
+		RespondError(w, http.StatusInternalServerError, ErrMsgListItemFailed)
+		return
+	}
+
+	RespondJSON(w, http.StatusCreated, listing)
+}
+
+// HandleBuyListing buys items from a listing
+// @Summary Buy from player shop
+// @Description Pays the seller for some or all of the listed items. A share of the price, set by PLAYER_SHOP_FEE_PERCENT, goes to the community pool instead of the seller. Publishes a "player_shop.sold" event.
+// @Tags economy
+// @Accept json
+// @Produce json
+// @Param id path int true "Listing ID"
+// @Param request body BuyListingRequest true "Purchase"
+// @Success 200 {object} domain.PlayerShopPurchase
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /api/v1/shop/player/{id}/buy [post]
+func (h *PlayerShopHandler) HandleBuyListing(w http.ResponseWriter, r *http.Request) {
+	id, ok := listingIDParam(w, r)
+	if !ok {
+		return
+	}
+	var req BuyListingRequest
+	if err := DecodeAndValidateRequest(r, w, &req, "Buy listing"); err != nil {
+		return
+	}
+
+	h.buyListing(w, r, playershop.BuyRequest{
+		Platform:   req.Platform,
+		PlatformID: req.PlatformID,
+		Username:   req.Username,
+		ListingID:  id,
+		Quantity:   req.Quantity,
+	})
+}
+
+// HandleMarketBuy buys items from a listing named in the body
+// @Summary Buy from market
+// @Description Same as buying from the player shop, with the listing ID in the body. Publishes a "player_shop.sold" event.
+// @Tags economy
+// @Accept json
+// @Produce json
+// @Param request body MarketBuyRequest true "Purchase"
+// @Success 200 {object} domain.PlayerShopPurchase
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /api/v1/market/buy [post]
+func (h *PlayerShopHandler) HandleMarketBuy(w http.ResponseWriter, r *http.Request) {
+	var req MarketBuyRequest
+	if err := DecodeAndValidateRequest(r, w, &req, "Market buy"); err != nil {
+		return
+	}
+
+	h.buyListing(w, r, playershop.BuyRequest{
+		Platform:   req.Platform,
+		PlatformID: req.PlatformID,
+		Username:   req.Username,
+		ListingID:  req.ListingID,
+		Quantity:   req.Quantity,
+	})
+}
+
+func (h *PlayerShopHandler) buyListing(w http.ResponseWriter, r *http.Request, req playershop.BuyRequest) {
+	purchase, err := h.service.BuyListing(r.Context(), req)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrListingNotFound):
+			RespondError(w, http.StatusNotFound, ErrMsgListingNotFoundHTTP)
+			return
+		case errors.Is(err, domain.ErrCannotBuyOwnListing),
+			errors.Is(err, domain.ErrInsufficientQuantity),
+			errors.Is(err, domain.ErrInvalidInput):
+			RespondError(w, http.StatusBadRequest, err.Error())
+			return
+		case errors.Is(err, domain.ErrUserNotFound),
+			errors.Is(err, domain.ErrInsufficientFunds),
+			errors.Is(err, domain.ErrInventoryFull):
+			RespondMappedError(w, err)
+			return
+		}
+		logger.FromContext(r.Context()).Error("Failed to buy listing", "error", err, "platform", req.Platform, "listing_id", req.ListingID)
+		RespondError(w, http.StatusInternalServerError, ErrMsgBuyListingFailed)
+		return
+	}
+
+	RespondJSON(w, http.StatusOK, purchase)
+}
+
+// HandleCancelListing withdraws a listing
+// @Summary Cancel player shop listing
+// @Description Only the seller can cancel a listing. The unsold items go back to their inventory.
+// @Tags economy
+// @Produce json
+// @Param id path int true "Listing ID"
+// @Param platform query string true "Platform"
+// @Param platform_id query string true "Platform user ID"
+// @Success 200 {object} domain.PlayerListing
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /api/v1/shop/player/{id} [delete]
+func (h *PlayerShopHandler) HandleCancelListing(w http.ResponseWriter, r *http.Request) {
+	id, ok := listingIDParam(w, r)
+	if !ok {
+		return
+	}
+	platform, platformID, ok := platformUserParams(r, w)
+	if !ok {
+		return
+	}
+
+	h.cancelListing(w, r, platform, platformID, id)
+}
+
+// HandleMarketCancel withdraws a listing named in the body
+// @Summary Cancel market listing
+// @Description Same as cancelling a player shop listing, with the listing ID in the body. Only the seller can cancel, and the unsold items go back to their inventory.
+// @Tags economy
+// @Accept json
+// @Produce json
+// @Param request body MarketCancelRequest true "Listing to cancel"
+// @Success 200 {object} domain.PlayerListing
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /api/v1/market/cancel [post]
+func (h *PlayerShopHandler) HandleMarketCancel(w http.ResponseWriter, r *http.Request) {
+	var req MarketCancelRequest
+	if err := DecodeAndValidateRequest(r, w, &req, "Market cancel"); err != nil {
+		return
+	}
+
+	h.cancelListing(w, r, req.Platform, req.PlatformID, req.ListingID)
+}
+
+func (h *PlayerShopHandler) cancelListing(w http.ResponseWriter, r *http.Request, platform, platformID string, id int64) {
+	listing, err := h.service.CancelListing(r.Context(), platform, platformID, id)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrUserNotFound):
+			RespondError(w, http.StatusNotFound, ErrMsgUserNotFoundHTTP)
+			return
+		case errors.Is(err, domain.ErrListingNotFound):
+			RespondError(w, http.StatusNotFound, ErrMsgListingNotFoundHTTP)
+			return
+		case errors.Is(err, domain.ErrInventoryFull):
+			RespondMappedError(w, err)
+			return
+		}
+		logger.FromContext(r.Context()).Error("Failed to cancel listing", "error", err, "platform", platform, "listing_id", id)
+		RespondError(w, http.StatusInternalServerError, ErrMsgCancelListingFailed)
+		return
+	}
+
+	RespondJSON(w, http.StatusOK, listing)
+}
+
+// HandleGetCommunityPool reports the community pool's balance
+// @Summary Get community pool
+// @Description The money collected from player shop fees.
+// @Tags economy
+// @Produce json
+// @Success 200 {object} CommunityPoolResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /api/v1/shop/player/pool [get]
+func (h *PlayerShopHandler) HandleGetCommunityPool(w http.ResponseWriter, r *http.Request) {
+	balance, err := h.service.GetCommunityPool(r.Context())
+	if err != nil {
+		logger.FromContext(r.Context()).Error("Failed to get community pool", "error", err)
+		RespondError(w, http.StatusInternalServerError, ErrMsgGetCommunityPoolFailed)
+		return
+	}
+
+	RespondJSON(w, http.StatusOK, CommunityPoolResponse{Balance: balance})
+}
+
+func listingIDParam(w http.ResponseWriter, r *http.Request) (int64, bool) {
+	id, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil || id <= 0 {
+		RespondError(w, http.StatusBadRequest, ErrMsgInvalidListingID)
+		return 0, false
+	}
+	return id, true
+}
@@ -0,0 +1,206 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/playershop"
+	"github.com/osse101/BrandishBot_Go/mocks"
+)
+
+func TestPlayerShopHandler_HandleGetListings(t *testing.T) {
+	get := func(h *PlayerShopHandler, query string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, "/shop/player?"+query, nil)
+		rec := httptest.NewRecorder()
+		h.HandleGetListings(rec, req)
+		return rec
+	}
+
+	t.Run("passes the filters to the service", func(t *testing.T) {
+		svc := mocks.NewMockPlayershopService(t)
+		svc.On("GetListings", mock.Anything, playershop.ListingQuery{
+			ItemName: "sword",
+			Seller:   "alice",
+			Quality:  "rare",
+			MinPrice: 5,
+			MaxPrice: 50,
+			Sort:     domain.ListingSortNewest,
+		}).Return(nil, nil)
+
+		rec := get(NewPlayerShopHandler(svc), "item=sword&seller=alice&quality=rare&min_price=5&max_price=50&sort=newest")
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Contains(t, rec.Body.String(), `"listings":[]`)
+	})
+
+	t.Run("rejects an invalid price", func(t *testing.T) {
+		svc := mocks.NewMockPlayershopService(t)
+
+		rec := get(NewPlayerShopHandler(svc), "max_price=cheap")
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("invalid queries are a bad request", func(t *testing.T) {
+		svc := mocks.NewMockPlayershopService(t)
+		svc.On("GetListings", mock.Anything, mock.Anything).Return(nil, domain.ErrInvalidInput)
+
+		rec := get(NewPlayerShopHandler(svc), "sort=popular")
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+}
+
+func TestPlayerShopHandler_HandleListItem(t *testing.T) {
+	post := func(h *PlayerShopHandler, body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/shop/player", bytes.NewBufferString(body))
+		rec := httptest.NewRecorder()
+		h.HandleListItem(rec, req)
+		return rec
+	}
+
+	t.Run("lists the item", func(t *testing.T) {
+		svc := mocks.NewMockPlayershopService(t)
+		svc.On("ListItem", mock.Anything, mock.MatchedBy(func(req playershop.ListRequest) bool {
+			return req.ItemName == "sword" && req.Quantity == 2 && req.UnitPrice == 50
+		})).Return(&domain.PlayerListing{ID: 4, Status: domain.ListingStatusActive}, nil)
+
+		rec := post(NewPlayerShopHandler(svc), `{"platform":"discord","platform_id":"d-1","username":"alice","item_name":"sword","quantity":2,"unit_price":50}`)
+
+		assert.Equal(t, http.StatusCreated, rec.Code)
+		assert.Contains(t, rec.Body.String(), `"id":4`)
+	})
+
+	t.Run("too many listings is a bad request", func(t *testing.T) {
+		svc := mocks.NewMockPlayershopService(t)
+		svc.On("ListItem", mock.Anything, mock.Anything).Return(nil, domain.ErrTooManyListings)
+
+		rec := post(NewPlayerShopHandler(svc), `{"platform":"discord","platform_id":"d-1","username":"alice","item_name":"sword","quantity":1,"unit_price":50}`)
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("rejects a missing price", func(t *testing.T) {
+		svc := mocks.NewMockPlayershopService(t)
+
+		rec := post(NewPlayerShopHandler(svc), `{"platform":"discord","platform_id":"d-1","username":"alice","item_name":"sword","quantity":1}`)
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+}
+
+func TestPlayerShopHandler_HandleBuyListing(t *testing.T) {
+	buy := func(h *PlayerShopHandler, id, body string) *httptest.ResponseRecorder {
+		rctx := chi.NewRouteContext()
+		rctx.URLParams.Add("id", id)
+		req := httptest.NewRequest(http.MethodPost, "/shop/player/"+id+"/buy", bytes.NewBufferString(body))
+		req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, rctx))
+		rec := httptest.NewRecorder()
+		h.HandleBuyListing(rec, req)
+		return rec
+	}
+	body := `{"platform":"discord","platform_id":"d-2","username":"bob","quantity":1}`
+
+	t.Run("buys from the listing", func(t *testing.T) {
+		svc := mocks.NewMockPlayershopService(t)
+		svc.On("BuyListing", mock.Anything, mock.MatchedBy(func(req playershop.BuyRequest) bool {
+			return req.ListingID == 4 && req.Quantity == 1
+		})).Return(&domain.PlayerShopPurchase{Quantity: 1, TotalPrice: 50, Fee: 2, SellerGets: 48}, nil)
+
+		rec := buy(NewPlayerShopHandler(svc), "4", body)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Contains(t, rec.Body.String(), `"fee":2`)
+	})
+
+	t.Run("not enough money is a bad request", func(t *testing.T) {
+		svc := mocks.NewMockPlayershopService(t)
+		svc.On("BuyListing", mock.Anything, mock.Anything).Return(nil, domain.ErrInsufficientFunds)
+
+		rec := buy(NewPlayerShopHandler(svc), "4", body)
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		assert.Contains(t, rec.Body.String(), ErrMsgNotEnoughMoneyError)
+	})
+
+	t.Run("unknown listing", func(t *testing.T) {
+		svc := mocks.NewMockPlayershopService(t)
+		svc.On("BuyListing", mock.Anything, mock.Anything).Return(nil, domain.ErrListingNotFound)
+
+		rec := buy(NewPlayerShopHandler(svc), "4", body)
+
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+	})
+
+	t.Run("rejects invalid IDs", func(t *testing.T) {
+		svc := mocks.NewMockPlayershopService(t)
+
+		rec := buy(NewPlayerShopHandler(svc), "abc", body)
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+}
+
+func TestPlayerShopHandler_HandleMarketBuy(t *testing.T) {
+	buy := func(h *PlayerShopHandler, body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/market/buy", bytes.NewBufferString(body))
+		rec := httptest.NewRecorder()
+		h.HandleMarketBuy(rec, req)
+		return rec
+	}
+
+	t.Run("buys from the listing in the body", func(t *testing.T) {
+		svc := mocks.NewMockPlayershopService(t)
+		svc.On("BuyListing", mock.Anything, mock.MatchedBy(func(req playershop.BuyRequest) bool {
+			return req.ListingID == 4 && req.Quantity == 2
+		})).Return(&domain.PlayerShopPurchase{Quantity: 2, TotalPrice: 100}, nil)
+
+		rec := buy(NewPlayerShopHandler(svc), `{"platform":"discord","platform_id":"d-2","username":"bob","listing_id":4,"quantity":2}`)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+	})
+
+	t.Run("requires a listing ID", func(t *testing.T) {
+		svc := mocks.NewMockPlayershopService(t)
+
+		rec := buy(NewPlayerShopHandler(svc), `{"platform":"discord","platform_id":"d-2","username":"bob","quantity":1}`)
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+}
+
+func TestPlayerShopHandler_HandleMarketCancel(t *testing.T) {
+	cancel := func(h *PlayerShopHandler, body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/market/cancel", bytes.NewBufferString(body))
+		rec := httptest.NewRecorder()
+		h.HandleMarketCancel(rec, req)
+		return rec
+	}
+	body := `{"platform":"discord","platform_id":"d-1","listing_id":4}`
+
+	t.Run("cancels the listing in the body", func(t *testing.T) {
+		svc := mocks.NewMockPlayershopService(t)
+		svc.On("CancelListing", mock.Anything, "discord", "d-1", int64(4)).Return(&domain.PlayerListing{ID: 4}, nil)
+
+		rec := cancel(NewPlayerShopHandler(svc), body)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+	})
+
+	t.Run("unknown listing", func(t *testing.T) {
+		svc := mocks.NewMockPlayershopService(t)
+		svc.On("CancelListing", mock.Anything, "discord", "d-1", int64(4)).Return(nil, domain.ErrListingNotFound)
+
+		rec := cancel(NewPlayerShopHandler(svc), body)
+
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+	})
+}
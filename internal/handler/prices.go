@@ -3,6 +3,8 @@ package handler
 import (
 	"context"
 	"net/http"
+	"strconv"
+	"time"
 
 	"github.com/osse101/BrandishBot_Go/internal/domain"
 	"github.com/osse101/BrandishBot_Go/internal/economy"
@@ -37,14 +39,67 @@ func HandleGetBuyPrices(svc economy.Service) http.HandlerFunc {
 	}
 }
 
+// HandleGetPriceHistory handles getting an item's dynamic price history
+// @Summary Get item price history
+// @Description Get an item's recorded market prices, oldest first, along with its price now
+// @Tags economy
+// @Produce json
+// @Param item query string true "Item name (public or internal)"
+// @Param since query string false "Earliest point, RFC3339 (default 7 days ago)"
+// @Param limit query int false "Maximum points, most recent kept (default 200, max 1000)"
+// @Success 200 {object} domain.PriceHistory
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /api/v1/prices/history [get]
+func HandleGetPriceHistory(svc economy.Service) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		log := logger.FromContext(r.Context())
+
+		itemName, ok := GetQueryParam(r, w, "item")
+		if !ok {
+			return
+		}
+
+		var since time.Time
+		if raw := r.URL.Query().Get("since"); raw != "" {
+			parsed, err := time.Parse(time.RFC3339, raw)
+			if err != nil {
+				RespondError(w, http.StatusBadRequest, "Invalid 'since' timestamp format (use RFC3339)")
+				return
+			}
+			since = parsed
+		}
+
+		limit := 0
+		if limitStr := r.URL.Query().Get("limit"); limitStr != "" {
+			var err error
+			limit, err = strconv.Atoi(limitStr)
+			if err != nil || limit < 1 || limit > economy.MaxPriceHistoryLimit {
+				RespondError(w, http.StatusBadRequest, ErrMsgInvalidLimit)
+				return
+			}
+		}
+
+		history, err := svc.GetPriceHistory(r.Context(), itemName, since, limit)
+		if err != nil {
+			log.Error("Failed to get price history", "error", err, "item", itemName)
+			RespondMappedError(w, err)
+			return
+		}
+
+		log.Info("Price history retrieved", "item", history.ItemName, "points", len(history.Points))
+
+		RespondJSON(w, http.StatusOK, history)
+	}
+}
+
 func handleGetPricesInternal(w http.ResponseWriter, r *http.Request, fetcher func(context.Context) ([]domain.Item, error), label string) {
 	log := logger.FromContext(r.Context())
 
 	items, err := fetcher(r.Context())
 	if err != nil {
 		log.Error("Failed to get "+label+" prices", "error", err)
-		statusCode, userMsg := MapServiceErrorToUserMessage(err)
-		RespondError(w, statusCode, userMsg)
+		RespondMappedError(w, err)
 		return
 	}
 
@@ -5,6 +5,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -179,3 +180,89 @@ func TestHandleGetBuyPrices(t *testing.T) {
 		})
 	}
 }
+
+func TestHandleGetPriceHistory(t *testing.T) {
+	t.Parallel()
+	since := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name           string
+		query          string
+		setupMock      func(*mocks.MockEconomyService)
+		expectedStatus int
+	}{
+		{
+			name:  "Success",
+			query: "?item=lootbox&since=2026-03-01T00:00:00Z&limit=50",
+			setupMock: func(m *mocks.MockEconomyService) {
+				m.On("GetPriceHistory", mock.Anything, "lootbox", since, 50).Return(&domain.PriceHistory{
+					ItemName:  "lootbox0",
+					BaseValue: 100,
+					Current:   domain.PricePoint{Multiplier: 1.1, BuyPrice: 110, SellPrice: 44},
+					Points:    []domain.PricePoint{{Multiplier: 1.05, BuyPrice: 105, SellPrice: 42}},
+				}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:  "Defaults",
+			query: "?item=lootbox",
+			setupMock: func(m *mocks.MockEconomyService) {
+				m.On("GetPriceHistory", mock.Anything, "lootbox", time.Time{}, 0).Return(&domain.PriceHistory{ItemName: "lootbox0"}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "Missing Item",
+			query:          "",
+			setupMock:      func(m *mocks.MockEconomyService) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "Invalid Since",
+			query:          "?item=lootbox&since=yesterday",
+			setupMock:      func(m *mocks.MockEconomyService) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "Invalid Limit",
+			query:          "?item=lootbox&limit=0",
+			setupMock:      func(m *mocks.MockEconomyService) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:  "Unknown Item",
+			query: "?item=nope",
+			setupMock: func(m *mocks.MockEconomyService) {
+				m.On("GetPriceHistory", mock.Anything, "nope", time.Time{}, 0).Return(nil, domain.ErrItemNotFound)
+			},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:  "Service Error",
+			query: "?item=lootbox",
+			setupMock: func(m *mocks.MockEconomyService) {
+				m.On("GetPriceHistory", mock.Anything, "lootbox", time.Time{}, 0).Return(nil, domain.ErrDatabaseError)
+			},
+			expectedStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			mockSvc := mocks.NewMockEconomyService(t)
+			tt.setupMock(mockSvc)
+
+			handler := HandleGetPriceHistory(mockSvc)
+
+			req := httptest.NewRequest("GET", "/prices/history"+tt.query, nil)
+			rec := httptest.NewRecorder()
+
+			handler(rec, req)
+
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+			mockSvc.AssertExpectations(t)
+		})
+	}
+}
@@ -3,6 +3,7 @@ package handler
 import (
 	"context"
 	"errors"
+	"io"
 	"net/http"
 
 	"github.com/go-chi/chi/v5"
@@ -115,6 +116,124 @@ func (h *ProgressionHandlers) HandleVote() http.HandlerFunc {
 	}
 }
 
+// HandleDelegateVote hands the user's session votes to another user
+// @Summary Delegate votes
+// @Description Delegate future session votes to another user on the same platform. When the delegate votes, the same option is voted for on the user's behalf unless the user has already voted. Delegations do not chain.
+// @Tags progression
+// @Accept json
+// @Produce json
+// @Param request body DelegateVoteRequest true "Delegation request"
+// @Success 200 {object} SuccessResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /api/v1/progression/vote/delegate [post]
+func (h *ProgressionHandlers) HandleDelegateVote() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req DelegateVoteRequest
+		if err := DecodeAndValidateRequest(r, w, &req, "Delegate vote"); err != nil {
+			return
+		}
+
+		log := logger.FromContext(r.Context())
+
+		err := h.service.DelegateVote(r.Context(), req.Platform, req.PlatformID, req.Username, req.DelegateUsername)
+		if err != nil {
+			switch {
+			case errors.Is(err, domain.ErrUserNotFound):
+				RespondError(w, http.StatusNotFound, err.Error())
+			case errors.Is(err, domain.ErrSelfDelegation), errors.Is(err, domain.ErrVoteDelegationChain):
+				RespondError(w, http.StatusBadRequest, err.Error())
+			default:
+				log.Error("Delegate vote: service error", "error", err, "platform", req.Platform, "platformID", req.PlatformID)
+				RespondError(w, http.StatusInternalServerError, ErrMsgDelegateVoteFailed)
+			}
+			return
+		}
+
+		log.Info("Vote delegated", "platform", req.Platform, "platformID", req.PlatformID, "delegate", req.DelegateUsername)
+		RespondJSON(w, http.StatusOK, SuccessResponse{Message: MsgVoteDelegatedSuccess})
+	}
+}
+
+// HandleRevokeVoteDelegation removes the user's vote delegation
+// @Summary Revoke vote delegation
+// @Description Stop delegating votes. Votes the delegate already cast stand until the user votes themselves.
+// @Tags progression
+// @Produce json
+// @Param platform query string true "Platform (twitch, youtube, discord)"
+// @Param platform_id query string true "Platform-specific user ID"
+// @Success 200 {object} SuccessResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /api/v1/progression/vote/delegate [delete]
+func (h *ProgressionHandlers) HandleRevokeVoteDelegation() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		platform, ok := GetQueryParam(r, w, "platform")
+		if !ok {
+			return
+		}
+		platformID, ok := GetQueryParam(r, w, "platform_id")
+		if !ok {
+			return
+		}
+
+		log := logger.FromContext(r.Context())
+
+		if err := h.service.RevokeVoteDelegation(r.Context(), platform, platformID); err != nil {
+			if errors.Is(err, domain.ErrUserNotFound) || errors.Is(err, domain.ErrVoteDelegationNotFound) {
+				RespondError(w, http.StatusNotFound, err.Error())
+				return
+			}
+			log.Error("Revoke vote delegation: service error", "error", err, "platform", platform, "platformID", platformID)
+			RespondError(w, http.StatusInternalServerError, ErrMsgRevokeDelegationFailed)
+			return
+		}
+
+		log.Info("Vote delegation revoked", "platform", platform, "platformID", platformID)
+		RespondJSON(w, http.StatusOK, SuccessResponse{Message: MsgVoteDelegationRevoked})
+	}
+}
+
+// HandleGetVoteDelegation returns the user's vote delegation
+// @Summary Get vote delegation
+// @Description Returns who the user has delegated their votes to
+// @Tags progression
+// @Produce json
+// @Param platform query string true "Platform (twitch, youtube, discord)"
+// @Param platform_id query string true "Platform-specific user ID"
+// @Success 200 {object} domain.VoteDelegation
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /api/v1/progression/vote/delegate [get]
+func (h *ProgressionHandlers) HandleGetVoteDelegation() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		platform, ok := GetQueryParam(r, w, "platform")
+		if !ok {
+			return
+		}
+		platformID, ok := GetQueryParam(r, w, "platform_id")
+		if !ok {
+			return
+		}
+
+		delegation, err := h.service.GetVoteDelegation(r.Context(), platform, platformID)
+		if err != nil {
+			if errors.Is(err, domain.ErrUserNotFound) || errors.Is(err, domain.ErrVoteDelegationNotFound) {
+				RespondError(w, http.StatusNotFound, err.Error())
+				return
+			}
+			logger.FromContext(r.Context()).Error("Get vote delegation: service error", "error", err, "platform", platform, "platformID", platformID)
+			RespondError(w, http.StatusInternalServerError, ErrMsgGetDelegationFailed)
+			return
+		}
+
+		RespondJSON(w, http.StatusOK, delegation)
+	}
+}
+
 // HandleGetStatus returns current progression status
 // @Summary Get progression status
 // @Description Returns current community progression status including unlocks and engagement
@@ -198,7 +317,7 @@ func (h *ProgressionHandlers) HandleGetContributionLeaderboard() http.HandlerFun
 // @Param days query int false "Number of days (default 7)"
 // @Success 200 {object} domain.VelocityMetrics
 // @Failure 500 {object} ErrorResponse
-// @Router /progression/velocity [get]
+// @Router /api/v1/progression/velocity [get]
 func (h *ProgressionHandlers) HandleGetVelocity() http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		h.handleGetSimpleMetric(w, r, "days", 7, func(ctx context.Context, i int) (interface{}, error) {
@@ -216,7 +335,7 @@ func (h *ProgressionHandlers) HandleGetVelocity() http.HandlerFunc {
 // @Success 200 {object} domain.UnlockEstimate
 // @Failure 404 {object} ErrorResponse
 // @Failure 500 {object} ErrorResponse
-// @Router /progression/estimate/{nodeKey} [get]
+// @Router /api/v1/progression/estimate/{nodeKey} [get]
 func (h *ProgressionHandlers) HandleGetEstimate() http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		log := logger.FromContext(r.Context())
@@ -247,7 +366,16 @@ func (h *ProgressionHandlers) HandleGetEstimate() http.HandlerFunc {
 // Admin endpoints
 
 // HandleAdminUnlock admin force-unlocks a node
-// ... (swagger comments)
+// @Summary Admin unlock node
+// @Description Force-unlocks a progression node at the given level
+// @Tags progression,admin
+// @Accept json
+// @Produce json
+// @Param request body AdminNodeRequest true "Node and level"
+// @Success 200 {object} SuccessResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /api/v1/progression/admin/unlock [post]
 func (h *ProgressionHandlers) HandleAdminUnlock() http.HandlerFunc {
 	return h.handleAdminNodeAction(func(ctx context.Context, nodeKey string, level int) error {
 		return h.service.AdminUnlock(ctx, nodeKey, level)
@@ -255,7 +383,16 @@ func (h *ProgressionHandlers) HandleAdminUnlock() http.HandlerFunc {
 }
 
 // HandleAdminRelock admin relocks a node
-// ... (swagger comments)
+// @Summary Admin relock node
+// @Description Relocks a progression node level
+// @Tags progression,admin
+// @Accept json
+// @Produce json
+// @Param request body AdminNodeRequest true "Node and level"
+// @Success 200 {object} SuccessResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /api/v1/progression/admin/relock [post]
 func (h *ProgressionHandlers) HandleAdminRelock() http.HandlerFunc {
 	return h.handleAdminNodeAction(func(ctx context.Context, nodeKey string, level int) error {
 		return h.service.AdminRelock(ctx, nodeKey, level)
@@ -269,7 +406,7 @@ func (h *ProgressionHandlers) HandleAdminRelock() http.HandlerFunc {
 // @Produce json
 // @Success 200 {object} SuccessResponse
 // @Failure 500 {object} ErrorResponse
-// @Router /progression/admin/unlock-all [post]
+// @Router /api/v1/progression/admin/unlock-all [post]
 func (h *ProgressionHandlers) HandleAdminUnlockAll() http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		log := logger.FromContext(r.Context())
@@ -287,11 +424,7 @@ func (h *ProgressionHandlers) HandleAdminUnlockAll() http.HandlerFunc {
 
 func (h *ProgressionHandlers) handleAdminNodeAction(action func(context.Context, string, int) error, successMsg string) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
-		// We use a shared struct for validation since both use same fields
-		var req struct {
-			NodeKey string `json:"node_key" validate:"required,max=50"`
-			Level   int    `json:"level" validate:"min=0"`
-		}
+		var req AdminNodeRequest
 		if err := DecodeAndValidateRequest(r, w, &req, "Admin node action"); err != nil {
 			return
 		}
@@ -365,7 +498,7 @@ func (h *ProgressionHandlers) handleAdminAction(w http.ResponseWriter, r *http.R
 // @Produce json
 // @Success 200 {object} AdminInstantUnlockResponse
 // @Failure 500 {object} ErrorResponse
-// @Router /progression/admin/instant-unlock [post]
+// @Router /api/v1/progression/admin/instant-unlock [post]
 func (h *ProgressionHandlers) HandleAdminInstantUnlock() http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		h.handleAdminAction(w, r,
@@ -389,7 +522,7 @@ func (h *ProgressionHandlers) HandleAdminInstantUnlock() http.HandlerFunc {
 // @Success 200 {object} SuccessResponse
 // @Failure 400 {object} ErrorResponse
 // @Failure 500 {object} ErrorResponse
-// @Router /progression/admin/reset [post]
+// @Router /api/v1/progression/admin/reset [post]
 func (h *ProgressionHandlers) HandleAdminReset() http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		var req AdminResetRequest
@@ -420,9 +553,9 @@ func (h *ProgressionHandlers) HandleAdminReset() http.HandlerFunc {
 // @Description Returns the current voting session with all available options
 // @Tags progression
 // @Produce json
-// @Success 200 {object} domain.ProgressionVotingSession
+// @Success 200 {object} VotingSessionResponse
 // @Failure 500 {object} ErrorResponse
-// @Router /progression/session [get]
+// @Router /api/v1/progression/session [get]
 func (h *ProgressionHandlers) HandleGetVotingSession() http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		log := logger.FromContext(r.Context())
@@ -467,14 +600,43 @@ func (h *ProgressionHandlers) HandleGetVotingSession() http.HandlerFunc {
 	}
 }
 
+// HandleGetSessionHistory returns completed voting sessions, newest first
+// @Summary Get voting session history
+// @Description Returns past voting sessions with their options, vote counts, winner and the node the winner unlocked
+// @Tags progression
+// @Produce json
+// @Param limit query int false "Sessions per page (default 10, max 50)"
+// @Param offset query int false "Number of sessions to skip (default 0)"
+// @Success 200 {object} domain.VotingSessionHistory
+// @Failure 500 {object} ErrorResponse
+// @Router /api/v1/progression/sessions/history [get]
+func (h *ProgressionHandlers) HandleGetSessionHistory() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		log := logger.FromContext(r.Context())
+
+		limit := getQueryInt(r, "limit", 10)
+		offset := getQueryInt(r, "offset", 0)
+
+		history, err := h.service.GetVotingSessionHistory(r.Context(), limit, offset)
+		if err != nil {
+			log.Error("Get voting session history: service error", "error", err, "limit", limit, "offset", offset)
+			RespondError(w, http.StatusInternalServerError, ErrMsgGetVotingHistoryFailed)
+			return
+		}
+
+		log.Info("Get voting session history: success", "returned", len(history.Sessions), "total", history.Total)
+		RespondJSON(w, http.StatusOK, history)
+	}
+}
+
 // HandleGetUnlockProgress returns current unlock progress
 // @Summary Get unlock progress
 // @Description Returns the current unlock progress including accumulated contributions
 // @Tags progression
 // @Produce json
-// @Success 200 {object} domain.UnlockProgress
+// @Success 200 {object} UnlockProgressResponse
 // @Failure 500 {object} ErrorResponse
-// @Router /progression/unlock-progress [get]
+// @Router /api/v1/progression/unlock-progress [get]
 func (h *ProgressionHandlers) HandleGetUnlockProgress() http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		log := logger.FromContext(r.Context())
@@ -551,7 +713,7 @@ func (h *ProgressionHandlers) enrichUnlockProgress(ctx context.Context, progress
 // @Success 200 {object} SuccessResponse
 // @Failure 400 {object} ErrorResponse
 // @Failure 500 {object} ErrorResponse
-// @Router /progression/admin/end-voting [post]
+// @Router /api/v1/progression/admin/end-voting [post]
 func (h *ProgressionHandlers) HandleAdminEndVoting() http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		log := logger.FromContext(r.Context())
@@ -585,7 +747,7 @@ func (h *ProgressionHandlers) HandleAdminEndVoting() http.HandlerFunc {
 // @Produce json
 // @Success 200 {object} AdminEndVotingResponse
 // @Failure 500 {object} ErrorResponse
-// @Router /progression/admin/force-end-voting [post]
+// @Router /api/v1/progression/admin/force-end-voting [post]
 func (h *ProgressionHandlers) HandleAdminForceEndVoting() http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		h.handleAdminAction(w, r,
@@ -608,7 +770,7 @@ func (h *ProgressionHandlers) HandleAdminForceEndVoting() http.HandlerFunc {
 // @Failure 400 {object} ErrorResponse
 // @Failure 409 {object} ErrorResponse
 // @Failure 500 {object} ErrorResponse
-// @Router /progression/admin/start-voting [post]
+// @Router /api/v1/progression/admin/start-voting [post]
 func (h *ProgressionHandlers) HandleAdminStartVoting() http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		log := logger.FromContext(r.Context())
@@ -650,7 +812,7 @@ func (h *ProgressionHandlers) HandleAdminStartVoting() http.HandlerFunc {
 // @Success 200 {object} SuccessResponse
 // @Failure 400 {object} ErrorResponse
 // @Failure 500 {object} ErrorResponse
-// @Router /progression/admin/contribution [post]
+// @Router /api/v1/progression/admin/contribution [post]
 func (h *ProgressionHandlers) HandleAdminAddContribution() http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		var req AdminAddContributionRequest
@@ -677,13 +839,74 @@ func (h *ProgressionHandlers) HandleAdminAddContribution() http.HandlerFunc {
 	}
 }
 
+// HandleExternalContribution adds contribution points from an external system
+// @Summary Add external contribution
+// @Description Add contribution points from another system such as donation alerts or sub events. Callers with a scoped API key are bound to that key's source and hourly cap; callers with the main key must name the source and are uncapped. Naming a user credits the points to their contribution breakdown.
+// @Tags progression
+// @Accept json
+// @Produce json
+// @Param request body ExternalContributionRequest true "Contribution request"
+// @Success 200 {object} domain.ExternalContributionResult
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 429 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /api/v1/progression/contribution/external [post]
+func (h *ProgressionHandlers) HandleExternalContribution() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req ExternalContributionRequest
+		if err := DecodeAndValidateRequest(r, w, &req, "External contribution"); err != nil {
+			return
+		}
+
+		log := logger.FromContext(r.Context())
+
+		contribution := domain.ExternalContribution{
+			Source:     req.Source,
+			Amount:     req.Amount,
+			Platform:   req.Platform,
+			PlatformID: req.PlatformID,
+			Username:   req.Username,
+		}
+		if scope, ok := APIKeyScopeFromContext(r.Context()); ok {
+			if req.Source != "" && req.Source != scope.Source {
+				log.Warn("External contribution: source outside key scope", "source", req.Source, "keySource", scope.Source)
+				RespondError(w, http.StatusForbidden, ErrMsgSourceNotInScope)
+				return
+			}
+			contribution.Source = scope.Source
+			contribution.HourlyCap = scope.HourlyCap
+		} else if req.Source == "" {
+			RespondError(w, http.StatusBadRequest, ErrMsgSourceRequired)
+			return
+		}
+
+		result, err := h.service.AddExternalContribution(r.Context(), contribution)
+		if err != nil {
+			switch {
+			case errors.Is(err, domain.ErrExternalCapReached):
+				log.Warn("External contribution: hourly cap reached", "source", contribution.Source)
+				RespondError(w, http.StatusTooManyRequests, ErrMsgExternalCapReached)
+			case errors.Is(err, domain.ErrInvalidSource), errors.Is(err, domain.ErrInvalidInput):
+				RespondError(w, http.StatusBadRequest, err.Error())
+			default:
+				log.Error("External contribution: service error", "error", err, "source", contribution.Source)
+				RespondError(w, http.StatusInternalServerError, ErrMsgAddContributionFailed)
+			}
+			return
+		}
+
+		RespondJSON(w, http.StatusOK, result)
+	}
+}
+
 // HandleAdminReloadWeights invalidates the engagement weight cache
 // @Summary Admin reload weights
 // @Description Invalidate engagement weight cache to force reload from database (admin only)
 // @Tags progression,admin
 // @Produce json
 // @Success 200 {object} SuccessResponse
-// @Router /admin/progression/reload-weights [post]
+// @Router /api/v1/admin/progression/reload-weights [post]
 func (h *ProgressionHandlers) HandleAdminReloadWeights() http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		log := logger.FromContext(r.Context())
@@ -695,6 +918,62 @@ func (h *ProgressionHandlers) HandleAdminReloadWeights() http.HandlerFunc {
 	}
 }
 
+// HandleAdminReplaceTree validates tree JSON as the complete progression tree and applies the additions and changes
+// @Summary Admin replace progression tree
+// @Description Validate a full tree (schema, unique keys, existing prerequisites, no cycles, tiers not below prerequisites), diff it against the database and apply new and changed nodes without a reset. Nodes left out are reported as missing and kept. With dry_run=true nothing is applied.
+// @Tags progression,admin
+// @Accept json
+// @Produce json
+// @Param dry_run query bool false "Only validate and diff"
+// @Success 200 {object} progression.TreeDiff
+// @Failure 400 {object} ErrorResponse
+// @Router /api/v1/progression/admin/tree [put]
+func (h *ProgressionHandlers) HandleAdminReplaceTree() http.HandlerFunc {
+	return h.handleTreeEdit("replace", h.service.ReplaceTree)
+}
+
+// HandleAdminPatchTree merges the nodes in tree JSON over the current progression tree and applies them
+// @Summary Admin patch progression tree
+// @Description Add or replace the given nodes, validate the merged tree, diff it against the database and apply it without a reset. With dry_run=true nothing is applied.
+// @Tags progression,admin
+// @Accept json
+// @Produce json
+// @Param dry_run query bool false "Only validate and diff"
+// @Success 200 {object} progression.TreeDiff
+// @Failure 400 {object} ErrorResponse
+// @Router /api/v1/progression/admin/tree [patch]
+func (h *ProgressionHandlers) HandleAdminPatchTree() http.HandlerFunc {
+	return h.handleTreeEdit("patch", h.service.PatchTree)
+}
+
+func (h *ProgressionHandlers) handleTreeEdit(mode string, edit func(ctx context.Context, data []byte, dryRun bool) (*progression.TreeDiff, error)) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		log := logger.FromContext(r.Context())
+
+		data, err := io.ReadAll(r.Body)
+		if err != nil {
+			RespondError(w, http.StatusBadRequest, ErrMsgInvalidRequest)
+			return
+		}
+		dryRun := r.URL.Query().Get("dry_run") == "true"
+
+		diff, err := edit(r.Context(), data, dryRun)
+		if err != nil {
+			if errors.Is(err, domain.ErrInvalidInput) {
+				RespondError(w, http.StatusBadRequest, err.Error())
+				return
+			}
+			log.Error("Admin tree edit: service error", "error", err, "mode", mode)
+			RespondError(w, http.StatusInternalServerError, ErrMsgEditTreeFailed)
+			return
+		}
+
+		log.Info("Admin tree edit", "mode", mode, "dryRun", dryRun,
+			"added", len(diff.Added), "changed", len(diff.Changed), "applied", diff.Applied)
+		RespondJSON(w, http.StatusOK, diff)
+	}
+}
+
 // Request/Response types
 
 type ProgressionTreeResponse struct {
@@ -712,12 +991,14 @@ type VoteRequest struct {
 	OptionIndex int    `json:"option_index" validate:"required,min=1"`
 }
 
-type AdminUnlockRequest struct {
-	NodeKey string `json:"node_key" validate:"required,max=50"`
-	Level   int    `json:"level" validate:"min=0"`
+type DelegateVoteRequest struct {
+	Platform         string `json:"platform" validate:"required,max=20"`
+	PlatformID       string `json:"platform_id" validate:"required,max=100,excludesall=\x00\n\r\t"`
+	Username         string `json:"username" validate:"required,max=100"`
+	DelegateUsername string `json:"delegate_username" validate:"required,max=100"`
 }
 
-type AdminRelockRequest struct {
+type AdminNodeRequest struct {
 	NodeKey string `json:"node_key" validate:"required,max=50"`
 	Level   int    `json:"level" validate:"min=0"`
 }
@@ -742,6 +1023,14 @@ type AdminAddContributionRequest struct {
 	Amount int `json:"amount"`
 }
 
+type ExternalContributionRequest struct {
+	Source     string `json:"source" validate:"omitempty,max=32"`
+	Amount     int    `json:"amount" validate:"required,min=1,max=10000"`
+	Platform   string `json:"platform" validate:"omitempty,platform"`
+	PlatformID string `json:"platform_id" validate:"required_with=Platform,max=100,excludesall=\x00\n\r\t"`
+	Username   string `json:"username" validate:"omitempty,max=100"`
+}
+
 type VotingSessionResponse struct {
 	Session     *domain.ProgressionVotingSession `json:"session"`
 	Message     string                           `json:"message,omitempty"`
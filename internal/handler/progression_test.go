@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -15,6 +16,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/progression"
 	"github.com/osse101/BrandishBot_Go/mocks"
 )
 
@@ -322,3 +324,191 @@ func TestProgressionHandlers_HandleGetVotingSession_WithEstimates(t *testing.T)
 	assert.Len(t, resp.Session.Options, 1)
 	assert.NotNil(t, resp.Session.Options[0].EstimatedUnlockDate)
 }
+
+func TestProgressionHandlers_HandleGetSessionHistory(t *testing.T) {
+	mockSvc := mocks.NewMockProgressionService(t)
+	handler := NewProgressionHandlers(mockSvc)
+
+	winnerID := 7
+	history := &domain.VotingSessionHistory{
+		Sessions: []domain.VotingSessionRecap{
+			{
+				ProgressionVotingSession: domain.ProgressionVotingSession{ID: 3, Status: "completed", WinningOptionID: &winnerID},
+				TotalVotes:               5,
+				Winner:                   &domain.ProgressionVotingOption{ID: winnerID, NodeID: 2, VoteCount: 4},
+			},
+		},
+		Total:  12,
+		Limit:  5,
+		Offset: 10,
+	}
+	mockSvc.On("GetVotingSessionHistory", mock.Anything, 5, 10).Return(history, nil)
+
+	req := httptest.NewRequest("GET", "/progression/sessions/history?limit=5&offset=10", nil)
+	rec := httptest.NewRecorder()
+
+	handler.HandleGetSessionHistory()(rec, req)
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	var resp domain.VotingSessionHistory
+	err := json.Unmarshal(rec.Body.Bytes(), &resp)
+	require.NoError(t, err)
+	assert.Equal(t, 12, resp.Total)
+	require.Len(t, resp.Sessions, 1)
+	assert.Equal(t, 3, resp.Sessions[0].ID)
+	assert.Equal(t, 5, resp.Sessions[0].TotalVotes)
+	require.NotNil(t, resp.Sessions[0].Winner)
+	assert.Equal(t, winnerID, resp.Sessions[0].Winner.ID)
+}
+
+func TestProgressionHandlers_HandleExternalContribution(t *testing.T) {
+	donationsScope := &APIKeyScope{Source: "donations", HourlyCap: 100}
+
+	tests := []struct {
+		name           string
+		body           ExternalContributionRequest
+		scope          *APIKeyScope
+		setupMock      func(*mocks.MockProgressionService)
+		expectedStatus int
+		expectedMsg    string
+	}{
+		{
+			name:  "Scoped key uses key source and cap",
+			body:  ExternalContributionRequest{Amount: 50},
+			scope: donationsScope,
+			setupMock: func(m *mocks.MockProgressionService) {
+				m.On("AddExternalContribution", mock.Anything, domain.ExternalContribution{Source: "donations", Amount: 50, HourlyCap: 100}).
+					Return(&domain.ExternalContributionResult{Source: "donations", Requested: 50, Accepted: 50}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedMsg:    `"accepted":50`,
+		},
+		{
+			name:           "Scoped key cannot claim another source",
+			body:           ExternalContributionRequest{Source: "subs", Amount: 50},
+			scope:          donationsScope,
+			setupMock:      func(m *mocks.MockProgressionService) {},
+			expectedStatus: http.StatusForbidden,
+			expectedMsg:    ErrMsgSourceNotInScope,
+		},
+		{
+			name:           "Main key must name a source",
+			body:           ExternalContributionRequest{Amount: 50},
+			setupMock:      func(m *mocks.MockProgressionService) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedMsg:    ErrMsgSourceRequired,
+		},
+		{
+			name:  "Cap reached",
+			body:  ExternalContributionRequest{Amount: 50},
+			scope: donationsScope,
+			setupMock: func(m *mocks.MockProgressionService) {
+				m.On("AddExternalContribution", mock.Anything, mock.Anything).Return(nil, domain.ErrExternalCapReached)
+			},
+			expectedStatus: http.StatusTooManyRequests,
+			expectedMsg:    ErrMsgExternalCapReached,
+		},
+		{
+			name: "Service error",
+			body: ExternalContributionRequest{Source: "donations", Amount: 50},
+			setupMock: func(m *mocks.MockProgressionService) {
+				m.On("AddExternalContribution", mock.Anything, mock.Anything).Return(nil, errors.New("db down"))
+			},
+			expectedStatus: http.StatusInternalServerError,
+			expectedMsg:    ErrMsgAddContributionFailed,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockSvc := mocks.NewMockProgressionService(t)
+			tt.setupMock(mockSvc)
+
+			handler := NewProgressionHandlers(mockSvc)
+
+			bodyBytes, _ := json.Marshal(tt.body)
+			req := httptest.NewRequest("POST", "/progression/contribution/external", bytes.NewReader(bodyBytes))
+			if tt.scope != nil {
+				req = req.WithContext(WithAPIKeyScope(req.Context(), *tt.scope))
+			}
+			rec := httptest.NewRecorder()
+
+			handler.HandleExternalContribution()(rec, req)
+
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+			assert.Contains(t, rec.Body.String(), tt.expectedMsg)
+		})
+	}
+}
+
+func TestProgressionHandlers_HandleDelegateVote(t *testing.T) {
+	body := DelegateVoteRequest{Platform: "discord", PlatformID: "123", Username: "alice", DelegateUsername: "bob"}
+
+	tests := []struct {
+		name           string
+		serviceErr     error
+		expectedStatus int
+		expectedMsg    string
+	}{
+		{"Success", nil, http.StatusOK, MsgVoteDelegatedSuccess},
+		{"Unknown delegate", domain.ErrUserNotFound, http.StatusNotFound, domain.ErrMsgUserNotFound},
+		{"Chain", domain.ErrVoteDelegationChain, http.StatusBadRequest, domain.ErrMsgVoteDelegationChain},
+		{"Service error", errors.New("db down"), http.StatusInternalServerError, ErrMsgDelegateVoteFailed},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockSvc := mocks.NewMockProgressionService(t)
+			mockSvc.On("DelegateVote", mock.Anything, "discord", "123", "alice", "bob").Return(tt.serviceErr)
+			handler := NewProgressionHandlers(mockSvc)
+
+			bodyBytes, _ := json.Marshal(body)
+			req := httptest.NewRequest("POST", "/progression/vote/delegate", bytes.NewReader(bodyBytes))
+			rec := httptest.NewRecorder()
+
+			handler.HandleDelegateVote()(rec, req)
+
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+			assert.Contains(t, rec.Body.String(), tt.expectedMsg)
+		})
+	}
+}
+
+func TestProgressionHandlers_HandleAdminPatchTree(t *testing.T) {
+	body := []byte(`{"version": "1.0", "nodes": []}`)
+
+	tests := []struct {
+		name           string
+		query          string
+		dryRun         bool
+		diff           *progression.TreeDiff
+		serviceErr     error
+		expectedStatus int
+	}{
+		{"Dry run", "?dry_run=true", true, &progression.TreeDiff{Added: []string{"n1"}}, nil, http.StatusOK},
+		{"Apply", "", false, &progression.TreeDiff{Added: []string{"n1"}, Applied: true}, nil, http.StatusOK},
+		{"Invalid tree", "", false, nil, fmt.Errorf("%w: circular dependency", domain.ErrInvalidInput), http.StatusBadRequest},
+		{"Service error", "", false, nil, errors.New("db down"), http.StatusInternalServerError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockSvc := mocks.NewMockProgressionService(t)
+			mockSvc.On("PatchTree", mock.Anything, body, tt.dryRun).Return(tt.diff, tt.serviceErr)
+			handler := NewProgressionHandlers(mockSvc)
+
+			req := httptest.NewRequest("PATCH", "/progression/admin/tree"+tt.query, bytes.NewReader(body))
+			rec := httptest.NewRecorder()
+
+			handler.HandleAdminPatchTree()(rec, req)
+
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+			if tt.diff != nil {
+				var resp progression.TreeDiff
+				require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+				assert.Equal(t, tt.diff.Applied, resp.Applied)
+			}
+		})
+	}
+}
@@ -21,7 +21,27 @@ func NewQuestHandler(questService quest.Service, progressionService progression.
 	}
 }
 
+// ClaimQuestRequest names the completed quest to claim
+type ClaimQuestRequest struct {
+	UserID  string `json:"user_id"`
+	QuestID int    `json:"quest_id"`
+}
+
+// ClaimQuestResponse reports the money a claimed quest paid
+type ClaimQuestResponse struct {
+	MoneyEarned int    `json:"money_earned"`
+	Message     string `json:"message"`
+}
+
 // GetActiveQuests returns the current week's active quests
+// @Summary List active quests
+// @Description The current week's quests
+// @Tags quests
+// @Produce json
+// @Success 200 {array} domain.Quest
+// @Failure 403 {object} ErrorResponse "Feature locked"
+// @Failure 500 {object} ErrorResponse
+// @Router /api/v1/quests/active [get]
 func (h *QuestHandler) GetActiveQuests(w http.ResponseWriter, r *http.Request) {
 	if locked := CheckFeatureLocked(w, r, h.progressionService, "feature_weekly_quests"); locked {
 		return
@@ -39,6 +59,16 @@ func (h *QuestHandler) GetActiveQuests(w http.ResponseWriter, r *http.Request) {
 }
 
 // GetUserQuestProgress returns user's quest progress
+// @Summary Get quest progress
+// @Description A user's progress on this week's quests
+// @Tags quests
+// @Produce json
+// @Param user_id query string true "Internal user ID"
+// @Success 200 {array} domain.QuestProgress
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse "Feature locked"
+// @Failure 500 {object} ErrorResponse
+// @Router /api/v1/quests/progress [get]
 func (h *QuestHandler) GetUserQuestProgress(w http.ResponseWriter, r *http.Request) {
 	if locked := CheckFeatureLocked(w, r, h.progressionService, "feature_weekly_quests"); locked {
 		return
@@ -64,6 +94,17 @@ func (h *QuestHandler) GetUserQuestProgress(w http.ResponseWriter, r *http.Reque
 }
 
 // ClaimQuestReward claims a completed quest's reward
+// @Summary Claim a quest reward
+// @Description Pay out the money for a completed quest
+// @Tags quests
+// @Accept json
+// @Produce json
+// @Param request body ClaimQuestRequest true "Quest to claim"
+// @Success 200 {object} ClaimQuestResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse "Feature locked"
+// @Failure 500 {object} ErrorResponse
+// @Router /api/v1/quests/claim [post]
 func (h *QuestHandler) ClaimQuestReward(w http.ResponseWriter, r *http.Request) {
 	if locked := CheckFeatureLocked(w, r, h.progressionService, "feature_weekly_quests"); locked {
 		return
@@ -72,11 +113,7 @@ func (h *QuestHandler) ClaimQuestReward(w http.ResponseWriter, r *http.Request)
 	ctx := r.Context()
 	log := logger.FromContext(ctx)
 
-	var req struct {
-		UserID  string `json:"user_id"`
-		QuestID int    `json:"quest_id"`
-	}
-
+	var req ClaimQuestRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
 		RespondError(w, http.StatusBadRequest, "Invalid request body")
 		return
@@ -89,10 +126,8 @@ func (h *QuestHandler) ClaimQuestReward(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
-	resp := map[string]interface{}{
-		"money_earned": money,
-		"message":      "Quest reward claimed successfully",
-	}
-
-	RespondJSON(w, http.StatusOK, resp)
+	RespondJSON(w, http.StatusOK, ClaimQuestResponse{
+		MoneyEarned: money,
+		Message:     "Quest reward claimed successfully",
+	})
 }
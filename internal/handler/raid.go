@@ -0,0 +1,93 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/logger"
+	"github.com/osse101/BrandishBot_Go/internal/raid"
+)
+
+// CommunityBonusesResponse lists the community's active timed bonuses
+type CommunityBonusesResponse struct {
+	Bonuses []domain.CommunityEffect `json:"bonuses"`
+}
+
+// RaidHandler handles raid and host events from platform adapters
+type RaidHandler struct {
+	service raid.Service
+}
+
+// NewRaidHandler creates a new raid handler
+func NewRaidHandler(service raid.Service) *RaidHandler {
+	return &RaidHandler{service: service}
+}
+
+// HandleRaid grants the community a bonus for a raid
+// @Summary Report a raid
+// @Description Called by platform adapters when another channel raids the stream. Grants the community the timed XP and contribution bonuses of the tier the viewer count reaches; a raid below every tier grants nothing.
+// @Tags events
+// @Accept json
+// @Produce json
+// @Param request body domain.RaidEvent true "Raid details"
+// @Success 200 {object} domain.RaidBonus
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /api/v1/events/raid [post]
+func (h *RaidHandler) HandleRaid(w http.ResponseWriter, r *http.Request) {
+	h.handle(w, r, domain.RaidKindRaid, h.service.HandleRaid)
+}
+
+// HandleHost grants the community a bonus for a host
+// @Summary Report a host
+// @Description Called by platform adapters when another channel hosts the stream. Grants the community the timed bonuses of the host tier the viewer count reaches; a host below every tier grants nothing.
+// @Tags events
+// @Accept json
+// @Produce json
+// @Param request body domain.RaidEvent true "Host details"
+// @Success 200 {object} domain.RaidBonus
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /api/v1/events/host [post]
+func (h *RaidHandler) HandleHost(w http.ResponseWriter, r *http.Request) {
+	h.handle(w, r, domain.RaidKindHost, h.service.HandleHost)
+}
+
+func (h *RaidHandler) handle(w http.ResponseWriter, r *http.Request, kind domain.RaidKind, grant func(ctx context.Context, evt domain.RaidEvent) (*domain.RaidBonus, error)) {
+	var evt domain.RaidEvent
+	if err := DecodeAndValidateRequest(r, w, &evt, "Raid event"); err != nil {
+		return
+	}
+
+	bonus, err := grant(r.Context(), evt)
+	if err != nil {
+		logger.FromContext(r.Context()).Error("Failed to grant raid bonus", "error", err, "kind", kind, "platform", evt.Platform)
+		RespondMappedError(w, err)
+		return
+	}
+
+	RespondJSON(w, http.StatusOK, bonus)
+}
+
+// HandleGetBonuses lists the community's active bonuses
+// @Summary List active community bonuses
+// @Description Timed community-wide bonuses, such as those granted by raids and hosts, soonest to expire first.
+// @Tags events
+// @Produce json
+// @Success 200 {object} CommunityBonusesResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /api/v1/events/bonuses [get]
+func (h *RaidHandler) HandleGetBonuses(w http.ResponseWriter, r *http.Request) {
+	bonuses, err := h.service.GetActiveBonuses(r.Context())
+	if err != nil {
+		logger.FromContext(r.Context()).Error("Failed to get community bonuses", "error", err)
+		RespondError(w, http.StatusInternalServerError, ErrMsgGetBonusesFailed)
+		return
+	}
+
+	if bonuses == nil {
+		bonuses = []domain.CommunityEffect{}
+	}
+	RespondJSON(w, http.StatusOK, CommunityBonusesResponse{Bonuses: bonuses})
+}
@@ -0,0 +1,97 @@
+package handler
+
+import (
+	"bytes"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/mocks"
+)
+
+func TestRaidHandler_HandleRaid(t *testing.T) {
+	post := func(h *RaidHandler, body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/events/raid", bytes.NewBufferString(body))
+		rec := httptest.NewRecorder()
+		h.HandleRaid(rec, req)
+		return rec
+	}
+
+	t.Run("grants the bonus", func(t *testing.T) {
+		svc := mocks.NewMockRaidService(t)
+		evt := domain.RaidEvent{Platform: "twitch", FromChannel: "friend", Viewers: 40}
+		svc.On("HandleRaid", mock.Anything, evt).Return(&domain.RaidBonus{
+			Kind:    domain.RaidKindRaid,
+			Effects: []domain.CommunityEffect{{Type: domain.EffectJobXPBoost, Magnitude: 1.25}},
+		}, nil)
+
+		rec := post(NewRaidHandler(svc), `{"platform":"twitch","from_channel":"friend","viewers":40}`)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Contains(t, rec.Body.String(), `"type":"job_xp_boost"`)
+	})
+
+	t.Run("requires the raiding channel", func(t *testing.T) {
+		svc := mocks.NewMockRaidService(t)
+
+		rec := post(NewRaidHandler(svc), `{"platform":"twitch","viewers":40}`)
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("service failure", func(t *testing.T) {
+		svc := mocks.NewMockRaidService(t)
+		svc.On("HandleRaid", mock.Anything, mock.Anything).Return(nil, errors.New("db down"))
+
+		rec := post(NewRaidHandler(svc), `{"platform":"twitch","from_channel":"friend","viewers":40}`)
+
+		assert.Equal(t, http.StatusInternalServerError, rec.Code)
+	})
+}
+
+func TestRaidHandler_HandleHost(t *testing.T) {
+	svc := mocks.NewMockRaidService(t)
+	svc.On("HandleHost", mock.Anything, domain.RaidEvent{Platform: "twitch", FromChannel: "friend", Viewers: 12}).
+		Return(&domain.RaidBonus{Kind: domain.RaidKindHost, Effects: []domain.CommunityEffect{}}, nil)
+
+	req := httptest.NewRequest(http.MethodPost, "/events/host", bytes.NewBufferString(`{"platform":"twitch","from_channel":"friend","viewers":12}`))
+	rec := httptest.NewRecorder()
+	NewRaidHandler(svc).HandleHost(rec, req)
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Body.String(), `"kind":"host"`)
+}
+
+func TestRaidHandler_HandleGetBonuses(t *testing.T) {
+	get := func(h *RaidHandler) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, "/events/bonuses", nil)
+		rec := httptest.NewRecorder()
+		h.HandleGetBonuses(rec, req)
+		return rec
+	}
+
+	t.Run("no bonuses is an empty list", func(t *testing.T) {
+		svc := mocks.NewMockRaidService(t)
+		svc.On("GetActiveBonuses", mock.Anything).Return(nil, nil)
+
+		rec := get(NewRaidHandler(svc))
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Contains(t, rec.Body.String(), `"bonuses":[]`)
+	})
+
+	t.Run("service failure", func(t *testing.T) {
+		svc := mocks.NewMockRaidService(t)
+		svc.On("GetActiveBonuses", mock.Anything).Return(nil, errors.New("db down"))
+
+		rec := get(NewRaidHandler(svc))
+
+		assert.Equal(t, http.StatusInternalServerError, rec.Code)
+		assert.Contains(t, rec.Body.String(), ErrMsgGetBonusesFailed)
+	})
+}
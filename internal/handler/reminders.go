@@ -0,0 +1,160 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/logger"
+	"github.com/osse101/BrandishBot_Go/internal/reminder"
+)
+
+// CreateReminderRequest asks to be reminded when something finishes
+type CreateReminderRequest struct {
+	Platform   string `json:"platform" validate:"required,platform"`
+	PlatformID string `json:"platform_id" validate:"required"`
+	Username   string `json:"username" validate:"required,max=100,excludesall=\x00\n\r\t"`
+	Kind       string `json:"kind" validate:"required,oneof=cooldown vote compost"`
+	// Target is the cooldown action, as returned in a 429 response's "action"
+	Target string `json:"target" validate:"max=100"`
+	// RemindAt is used for cooldowns the server cannot look up itself; pass
+	// the 429 response's "next_available_at"
+	RemindAt  *time.Time `json:"remind_at,omitempty"`
+	ChannelID string     `json:"channel_id" validate:"max=100"`
+}
+
+// RemindersResponse lists a user's pending reminders
+type RemindersResponse struct {
+	Reminders []domain.Reminder `json:"reminders"`
+}
+
+// ReminderHandler handles user reminders
+type ReminderHandler struct {
+	service reminder.Service
+}
+
+// NewReminderHandler creates a new reminder handler
+func NewReminderHandler(service reminder.Service) *ReminderHandler {
+	return &ReminderHandler{service: service}
+}
+
+// HandleGetReminders lists a user's pending reminders
+// @Summary List reminders
+// @Tags user
+// @Produce json
+// @Param platform query string true "Platform"
+// @Param platform_id query string true "Platform user ID"
+// @Success 200 {object} RemindersResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /api/v1/user/reminders [get]
+func (h *ReminderHandler) HandleGetReminders(w http.ResponseWriter, r *http.Request) {
+	platform, platformID, ok := platformUserParams(r, w)
+	if !ok {
+		return
+	}
+
+	reminders, err := h.service.GetReminders(r.Context(), platform, platformID)
+	if err != nil {
+		if errors.Is(err, domain.ErrUserNotFound) {
+			RespondError(w, http.StatusNotFound, ErrMsgUserNotFoundHTTP)
+			return
+		}
+		logger.FromContext(r.Context()).Error("Failed to get reminders", "error", err, "platform", platform)
+		RespondError(w, http.StatusInternalServerError, ErrMsgGetRemindersFailed)
+		return
+	}
+
+	if reminders == nil {
+		reminders = []domain.Reminder{}
+	}
+	RespondJSON(w, http.StatusOK, RemindersResponse{Reminders: reminders})
+}
+
+// HandleCreateReminder schedules a reminder
+// @Summary Create reminder
+// @Description Pings the user when a cooldown expires (kind "cooldown", target is the action), shortly before the open vote closes ("vote") or when their compost bin is ready ("compost"). Asking again for the same thing moves the existing reminder. Due reminders are published as "reminder.due" SSE events.
+// @Tags user
+// @Accept json
+// @Produce json
+// @Param request body CreateReminderRequest true "Reminder"
+// @Success 201 {object} domain.Reminder
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /api/v1/user/reminders [post]
+func (h *ReminderHandler) HandleCreateReminder(w http.ResponseWriter, r *http.Request) {
+	var req CreateReminderRequest
+	if err := DecodeAndValidateRequest(r, w, &req, "Create reminder"); err != nil {
+		return
+	}
+
+	created, err := h.service.CreateReminder(r.Context(), reminder.Request{
+		Platform:   req.Platform,
+		PlatformID: req.PlatformID,
+		Username:   req.Username,
+		Kind:       domain.ReminderKind(req.Kind),
+		Target:     req.Target,
+		RemindAt:   req.RemindAt,
+		ChannelID:  req.ChannelID,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrNothingToRemind),
+			errors.Is(err, domain.ErrTooManyReminders),
+			errors.Is(err, domain.ErrInvalidReminderKind),
+			errors.Is(err, domain.ErrInvalidInput):
+			RespondError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		logger.FromContext(r.Context()).Error("Failed to create reminder", "error", err, "platform", req.Platform, "kind", req.Kind)
+		RespondError(w, http.StatusInternalServerError, ErrMsgCreateReminderFailed)
+		return
+	}
+
+	RespondJSON(w, http.StatusCreated, created)
+}
+
+// HandleCancelReminder removes a pending reminder
+// @Summary Cancel reminder
+// @Tags user
+// @Produce json
+// @Param id path int true "Reminder ID"
+// @Param platform query string true "Platform"
+// @Param platform_id query string true "Platform user ID"
+// @Success 200 {object} SuccessResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /api/v1/user/reminders/{id} [delete]
+func (h *ReminderHandler) HandleCancelReminder(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil || id <= 0 {
+		RespondError(w, http.StatusBadRequest, ErrMsgInvalidReminderID)
+		return
+	}
+	platform, platformID, ok := platformUserParams(r, w)
+	if !ok {
+		return
+	}
+
+	if err := h.service.CancelReminder(r.Context(), platform, platformID, id); err != nil {
+		switch {
+		case errors.Is(err, domain.ErrUserNotFound):
+			RespondError(w, http.StatusNotFound, ErrMsgUserNotFoundHTTP)
+			return
+		case errors.Is(err, domain.ErrReminderNotFound):
+			RespondError(w, http.StatusNotFound, ErrMsgReminderNotFoundHTTP)
+			return
+		}
+		logger.FromContext(r.Context()).Error("Failed to cancel reminder", "error", err, "platform", platform)
+		RespondError(w, http.StatusInternalServerError, ErrMsgCancelReminderFailed)
+		return
+	}
+
+	RespondJSON(w, http.StatusOK, SuccessResponse{Message: MsgReminderCancelled})
+}
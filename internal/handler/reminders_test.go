@@ -0,0 +1,96 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/reminder"
+	"github.com/osse101/BrandishBot_Go/mocks"
+)
+
+func TestReminderHandler_HandleCreateReminder(t *testing.T) {
+	post := func(h *ReminderHandler, body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/user/reminders", bytes.NewBufferString(body))
+		rec := httptest.NewRecorder()
+		h.HandleCreateReminder(rec, req)
+		return rec
+	}
+
+	t.Run("creates the reminder", func(t *testing.T) {
+		svc := mocks.NewMockReminderService(t)
+		svc.On("CreateReminder", mock.Anything, mock.MatchedBy(func(req reminder.Request) bool {
+			return req.Kind == domain.ReminderKindCooldown && req.Target == domain.ActionSearch && req.ChannelID == "chan-1"
+		})).Return(&domain.Reminder{ID: 5, Kind: domain.ReminderKindCooldown, RemindAt: time.Now().Add(time.Minute)}, nil)
+
+		rec := post(NewReminderHandler(svc), `{"platform":"discord","platform_id":"d-1","username":"alice","kind":"cooldown","target":"search","channel_id":"chan-1"}`)
+
+		assert.Equal(t, http.StatusCreated, rec.Code)
+		assert.Contains(t, rec.Body.String(), `"id":5`)
+	})
+
+	t.Run("nothing to remind about is a bad request", func(t *testing.T) {
+		svc := mocks.NewMockReminderService(t)
+		svc.On("CreateReminder", mock.Anything, mock.Anything).Return(nil, domain.ErrNothingToRemind)
+
+		rec := post(NewReminderHandler(svc), `{"platform":"discord","platform_id":"d-1","username":"alice","kind":"vote"}`)
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		assert.Contains(t, rec.Body.String(), domain.ErrMsgNothingToRemind)
+	})
+
+	t.Run("rejects unknown kinds", func(t *testing.T) {
+		svc := mocks.NewMockReminderService(t)
+
+		rec := post(NewReminderHandler(svc), `{"platform":"discord","platform_id":"d-1","username":"alice","kind":"birthday"}`)
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+}
+
+func TestReminderHandler_HandleCancelReminder(t *testing.T) {
+	cancel := func(h *ReminderHandler, id string) *httptest.ResponseRecorder {
+		rctx := chi.NewRouteContext()
+		rctx.URLParams.Add("id", id)
+		req := httptest.NewRequest(http.MethodDelete, "/user/reminders/"+id+"?platform=discord&platform_id=d-1", nil)
+		req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, rctx))
+		rec := httptest.NewRecorder()
+		h.HandleCancelReminder(rec, req)
+		return rec
+	}
+
+	t.Run("cancels the reminder", func(t *testing.T) {
+		svc := mocks.NewMockReminderService(t)
+		svc.On("CancelReminder", mock.Anything, domain.PlatformDiscord, "d-1", int64(5)).Return(nil)
+
+		rec := cancel(NewReminderHandler(svc), "5")
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Contains(t, rec.Body.String(), MsgReminderCancelled)
+	})
+
+	t.Run("unknown reminder", func(t *testing.T) {
+		svc := mocks.NewMockReminderService(t)
+		svc.On("CancelReminder", mock.Anything, domain.PlatformDiscord, "d-1", int64(5)).Return(domain.ErrReminderNotFound)
+
+		rec := cancel(NewReminderHandler(svc), "5")
+
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+	})
+
+	t.Run("rejects invalid IDs", func(t *testing.T) {
+		svc := mocks.NewMockReminderService(t)
+
+		rec := cancel(NewReminderHandler(svc), "abc")
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+}
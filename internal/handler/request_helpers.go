@@ -49,8 +49,8 @@ func DecodeAndValidateRequest(r *http.Request, w http.ResponseWriter, req interf
 	if err := GetValidator().ValidateStruct(req); err != nil {
 		validationErrs := FormatValidationError(err)
 		RespondJSON(w, http.StatusBadRequest, ValidationErrorResponse{
-			Error:  ErrMsgInvalidRequestSummary,
-			Fields: validationErrs,
+			ErrorResponse: NewErrorResponse(w, ErrCodeValidation, ErrMsgInvalidRequestSummary, validationErrs),
+			Fields:        validationErrs,
 		})
 		return err
 	}
@@ -58,9 +58,10 @@ func DecodeAndValidateRequest(r *http.Request, w http.ResponseWriter, req interf
 	return nil
 }
 
-// ValidationErrorResponse defines the response structure for validation errors
+// ValidationErrorResponse defines the response structure for validation errors.
+// Details holds the field messages; Fields repeats them for older clients.
 type ValidationErrorResponse struct {
-	Error  string            `json:"error"`
+	ErrorResponse
 	Fields map[string]string `json:"fields"`
 }
 
@@ -3,8 +3,13 @@ package handler
 import (
 	"encoding/json"
 	"errors"
+	"fmt"
 	"log/slog"
+	"math"
 	"net/http"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/osse101/BrandishBot_Go/internal/cooldown"
 	"github.com/osse101/BrandishBot_Go/internal/domain"
@@ -19,11 +24,170 @@ type SuccessResponse struct {
 	Message string `json:"message"`
 }
 
-// ErrorResponse represents an error response
+// HeaderRequestID is the response header carrying the request ID. Error
+// responses repeat it in the request_id field so it can be quoted in reports.
+const HeaderRequestID = "X-Request-ID"
+
+// ErrorCode is a stable, machine-readable error identifier. Clients should
+// branch on it instead of matching the message text.
+type ErrorCode string
+
+// ErrorResponse is the envelope sent with every error. Message is meant for
+// users; Code is stable and meant for clients to branch on. Details carries
+// optional structured context such as the fields that failed validation.
 type ErrorResponse struct {
+	Code      ErrorCode   `json:"code"`
+	Message   string      `json:"message"`
+	Details   interface{} `json:"details,omitempty"`
+	RequestID string      `json:"request_id,omitempty"`
+	// Error repeats Message for clients written before the envelope had one.
+	// Deprecated: read Message instead.
 	Error string `json:"error"`
 }
 
+// Generic error codes, picked from the HTTP status when no domain code applies
+const (
+	ErrCodeBadRequest       ErrorCode = "BAD_REQUEST"
+	ErrCodeValidation       ErrorCode = "VALIDATION_FAILED"
+	ErrCodeUnauthorized     ErrorCode = "UNAUTHORIZED"
+	ErrCodeForbidden        ErrorCode = "FORBIDDEN"
+	ErrCodeNotFound         ErrorCode = "NOT_FOUND"
+	ErrCodeMethodNotAllowed ErrorCode = "METHOD_NOT_ALLOWED"
+	ErrCodeConflict         ErrorCode = "CONFLICT"
+	ErrCodePayloadTooLarge  ErrorCode = "PAYLOAD_TOO_LARGE"
+	ErrCodeRateLimited      ErrorCode = "RATE_LIMITED"
+	ErrCodeInternal         ErrorCode = "INTERNAL_ERROR"
+	ErrCodeUnavailable      ErrorCode = "UNAVAILABLE"
+)
+
+// Domain error codes, sent when a service error maps to a known domain error
+const (
+	ErrCodeUserNotFound      ErrorCode = "USER_NOT_FOUND"
+	ErrCodeItemNotFound      ErrorCode = "ITEM_NOT_FOUND"
+	ErrCodeRecipeNotFound    ErrorCode = "RECIPE_NOT_FOUND"
+	ErrCodeGambleNotFound    ErrorCode = "GAMBLE_NOT_FOUND"
+	ErrCodeInvalidPlatform   ErrorCode = "INVALID_PLATFORM"
+	ErrCodeInsufficientFunds ErrorCode = "INSUFFICIENT_FUNDS"
+	ErrCodeInsufficientItems ErrorCode = "INSUFFICIENT_ITEMS"
+	ErrCodeNotInInventory    ErrorCode = "NOT_IN_INVENTORY"
+	ErrCodeInventoryFull     ErrorCode = "INVENTORY_FULL"
+	ErrCodeNotSellable       ErrorCode = "NOT_SELLABLE"
+	ErrCodeNotBuyable        ErrorCode = "NOT_BUYABLE"
+	ErrCodeItemBorrowed      ErrorCode = "ITEM_BORROWED"
+	ErrCodeItemLocked        ErrorCode = "ITEM_LOCKED"
+	ErrCodeFeatureLocked     ErrorCode = "FEATURE_LOCKED"
+	ErrCodeFeatureDisabled   ErrorCode = "FEATURE_DISABLED"
+	ErrCodeRecipeOffEvent    ErrorCode = "RECIPE_OFF_EVENT"
+	ErrCodeOnCooldown        ErrorCode = "ON_COOLDOWN"
+	ErrCodeLimitReached      ErrorCode = "LIMIT_REACHED"
+	ErrCodeAccountFrozen     ErrorCode = "ACCOUNT_FROZEN"
+	ErrCodeAccountTooNew     ErrorCode = "ACCOUNT_TOO_NEW"
+	ErrCodeAlreadyVoted      ErrorCode = "ALREADY_VOTED"
+	ErrCodeGambleClosed      ErrorCode = "GAMBLE_CLOSED"
+	ErrCodeAlreadyJoined     ErrorCode = "ALREADY_JOINED"
+	ErrCodeAlreadyBet        ErrorCode = "ALREADY_BET"
+	ErrCodeGambleFull        ErrorCode = "GAMBLE_FULL"
+	ErrCodeWagerTooHigh      ErrorCode = "WAGER_TOO_HIGH"
+)
+
+// domainErrorCodes maps domain errors to their codes. Entries are checked in
+// order with errors.Is, so a wrapped error gets the code of its domain error.
+var domainErrorCodes = []struct {
+	err  error
+	code ErrorCode
+}{
+	{domain.ErrUserNotFound, ErrCodeUserNotFound},
+	{domain.ErrItemNotFound, ErrCodeItemNotFound},
+	{domain.ErrRecipeNotFound, ErrCodeRecipeNotFound},
+	{domain.ErrGambleNotFound, ErrCodeGambleNotFound},
+	{domain.ErrInvalidPlatform, ErrCodeInvalidPlatform},
+	{domain.ErrInsufficientFunds, ErrCodeInsufficientFunds},
+	{domain.ErrInsufficientQuantity, ErrCodeInsufficientItems},
+	{domain.ErrNotInInventory, ErrCodeNotInInventory},
+	{domain.ErrInventoryFull, ErrCodeInventoryFull},
+	{domain.ErrNotSellable, ErrCodeNotSellable},
+	{domain.ErrNotBuyable, ErrCodeNotBuyable},
+	{domain.ErrItemBorrowed, ErrCodeItemBorrowed},
+	{domain.ErrItemLockedByUser, ErrCodeItemLocked},
+	{domain.ErrFeatureLocked, ErrCodeFeatureLocked},
+	{domain.ErrRecipeLocked, ErrCodeFeatureLocked},
+	{domain.ErrSearchLocationLocked, ErrCodeFeatureLocked},
+	{domain.ErrRecipeOffEvent, ErrCodeRecipeOffEvent},
+	{domain.ErrOnCooldown, ErrCodeOnCooldown},
+	{domain.ErrDailyCapReached, ErrCodeLimitReached},
+	{domain.ErrDailyGiveLimitReached, ErrCodeLimitReached},
+	{domain.ErrAccountFrozen, ErrCodeAccountFrozen},
+	{domain.ErrAccountTooNewToGive, ErrCodeAccountTooNew},
+	{domain.ErrUserAlreadyVoted, ErrCodeAlreadyVoted},
+	{domain.ErrNotInJoiningState, ErrCodeGambleClosed},
+	{domain.ErrJoinDeadlinePassed, ErrCodeGambleClosed},
+	{domain.ErrUserAlreadyJoined, ErrCodeAlreadyJoined},
+	{domain.ErrParticipantCannotSideBet, ErrCodeAlreadyJoined},
+	{domain.ErrSideBetAlreadyPlaced, ErrCodeAlreadyBet},
+	{domain.ErrSideBettorCannotJoin, ErrCodeAlreadyBet},
+	{domain.ErrGambleFull, ErrCodeGambleFull},
+	{domain.ErrWagerTooHigh, ErrCodeWagerTooHigh},
+}
+
+// ErrorCodeForError returns the code for a service error, falling back to
+// the generic code for status when err is not a known domain error
+func ErrorCodeForError(err error, status int) ErrorCode {
+	for _, m := range domainErrorCodes {
+		if errors.Is(err, m.err) {
+			return m.code
+		}
+	}
+	return ErrorCodeForStatus(status)
+}
+
+// ErrorCodeForStatus returns the generic error code for a status
+func ErrorCodeForStatus(status int) ErrorCode {
+	switch status {
+	case http.StatusUnauthorized:
+		return ErrCodeUnauthorized
+	case http.StatusForbidden:
+		return ErrCodeForbidden
+	case http.StatusNotFound, http.StatusGone:
+		return ErrCodeNotFound
+	case http.StatusMethodNotAllowed:
+		return ErrCodeMethodNotAllowed
+	case http.StatusConflict:
+		return ErrCodeConflict
+	case http.StatusRequestEntityTooLarge:
+		return ErrCodePayloadTooLarge
+	case http.StatusTooManyRequests:
+		return ErrCodeRateLimited
+	case http.StatusServiceUnavailable:
+		return ErrCodeUnavailable
+	}
+	if status >= http.StatusInternalServerError {
+		return ErrCodeInternal
+	}
+	return ErrCodeBadRequest
+}
+
+// CooldownDetails is sent in the details of an ON_COOLDOWN error
+type CooldownDetails struct {
+	Action            string    `json:"action,omitempty"`
+	RetryAfterSeconds int       `json:"retry_after_seconds"`
+	NextAvailableAt   time.Time `json:"next_available_at"`
+}
+
+// ItemNotFoundDetails is sent in the details of an ITEM_NOT_FOUND error when
+// the typed name is close to known items
+type ItemNotFoundDetails struct {
+	Name        string   `json:"name"`
+	Suggestions []string `json:"suggestions"`
+}
+
+// CooldownErrorResponse is sent with 429 when an action is on cooldown, so
+// clients can show a countdown without parsing the message. The retry timing
+// is repeated at the top level for clients written before details existed.
+type CooldownErrorResponse struct {
+	ErrorResponse
+	CooldownDetails
+}
+
 // DataResponse represents a response with data payload
 type DataResponse struct {
 	Message string      `json:"message,omitempty"`
@@ -54,17 +218,71 @@ func RespondJSON(w http.ResponseWriter, status int, payload interface{}) {
 	}
 }
 
-// RespondError sends a JSON error response
+// NewErrorResponse builds the error envelope, taking the request ID from the
+// response header set by the server's request ID middleware
+func NewErrorResponse(w http.ResponseWriter, code ErrorCode, message string, details interface{}) ErrorResponse {
+	return ErrorResponse{
+		Code:      code,
+		Message:   message,
+		Details:   details,
+		RequestID: w.Header().Get(HeaderRequestID),
+		Error:     message,
+	}
+}
+
+// RespondError sends a JSON error response with the generic code for status
 func RespondError(w http.ResponseWriter, status int, message string) {
-	RespondJSON(w, status, ErrorResponse{Error: message})
+	RespondErrorCode(w, status, ErrorCodeForStatus(status), message, nil)
+}
+
+// RespondErrorCode sends a JSON error response with an explicit code and
+// optional details
+func RespondErrorCode(w http.ResponseWriter, status int, code ErrorCode, message string, details interface{}) {
+	RespondJSON(w, status, NewErrorResponse(w, code, message, details))
+}
+
+// RespondMappedError maps a service error to a user-friendly response with
+// its domain error code. Cooldown errors also carry the retry timing and a
+// Retry-After header; unknown item names carry "did you mean" suggestions.
+func RespondMappedError(w http.ResponseWriter, err error) {
+	statusCode, userMsg := MapServiceErrorToUserMessage(err)
+	var cooldownErr cooldown.ErrOnCooldown
+	if statusCode == http.StatusTooManyRequests && errors.As(err, &cooldownErr) {
+		RespondCooldownError(w, userMsg, cooldownErr.Action, cooldownErr.Remaining)
+		return
+	}
+	var details interface{}
+	var notFoundErr domain.ItemNotFoundError
+	if errors.As(err, &notFoundErr) && len(notFoundErr.Suggestions) > 0 {
+		details = ItemNotFoundDetails{Name: notFoundErr.Name, Suggestions: notFoundErr.Suggestions}
+	}
+	RespondErrorCode(w, statusCode, ErrorCodeForError(err, statusCode), userMsg, details)
+}
+
+// RespondCooldownError sends a 429 with the time until the action is
+// available again, rounded up to whole seconds
+func RespondCooldownError(w http.ResponseWriter, message, action string, remaining time.Duration) {
+	retryAfter := int(math.Ceil(remaining.Seconds()))
+	if retryAfter < 1 {
+		retryAfter = 1
+	}
+	details := CooldownDetails{
+		Action:            action,
+		RetryAfterSeconds: retryAfter,
+		NextAvailableAt:   time.Now().Add(time.Duration(retryAfter) * time.Second).UTC().Truncate(time.Second),
+	}
+	w.Header().Set("Retry-After", strconv.Itoa(retryAfter))
+	RespondJSON(w, http.StatusTooManyRequests, CooldownErrorResponse{
+		ErrorResponse:   NewErrorResponse(w, ErrCodeOnCooldown, message, details),
+		CooldownDetails: details,
+	})
 }
 
 // RespondServiceError handles service-level errors by mapping them to user-friendly messages
 // and logging the internal error details.
 func RespondServiceError(w http.ResponseWriter, r *http.Request, opName string, err error) {
 	logger.FromContext(r.Context()).Error(opName, "error", err)
-	statusCode, userMsg := MapServiceErrorToUserMessage(err)
-	RespondError(w, statusCode, userMsg)
+	RespondMappedError(w, err)
 }
 
 // recordEngagement helper for consistently recording engagement and logging errors
@@ -83,6 +301,7 @@ const (
 	ErrMsgInvalidRequestError  = "Invalid request. Please check your inputs."
 	ErrMsgAuthFailedError      = "Authentication failed. Please check your API key."
 	ErrMsgFeatureLockedError   = "That feature is locked. Unlock it in the progression tree."
+	ErrMsgFeatureUnavailable   = "That feature is not available yet."
 	ErrMsgResourceNotFoundErr  = "Resource not found."
 	ErrMsgTooManyRequestsError = "Too many requests. Please try again later."
 	ErrMsgServerErrorError     = "Server error occurred. Please try again."
@@ -91,17 +310,25 @@ const (
 	// User and inventory messages
 	ErrMsgUserNotFoundError    = "User not found"
 	ErrMsgItemNotFoundError    = "Item not found"
+	ErrMsgItemSuggestionFormat = "Item not found. Did you mean %s?"
 	ErrMsgInsufficientItemsErr = "Not enough items"
 	ErrMsgNotInInventoryError  = "You don't have that item"
 	ErrMsgInventoryFullError   = "Inventory is full"
 	ErrMsgNotSellableError     = "Item is not sellable"
 	ErrMsgNotBuyableError      = "Item is not buyable"
+	ErrMsgItemBorrowedError    = "Borrowed items can't be sold, disassembled or lent"
+	ErrMsgItemLockedError      = "You locked that item. Unlock it first"
+	ErrMsgGiveLimitError       = "You've reached today's give limit"
+	ErrMsgAccountTooNewError   = "Your account is too new to give items"
+	ErrMsgAccountFrozenError   = "Your account is frozen by a moderator"
+	ErrMsgTimedOutFormat       = "You are timed out for another %ds"
 
 	// Economy messages
 	ErrMsgNotEnoughMoneyError = "Not enough money"
 
 	// Crafting messages
 	ErrMsgRecipeLockedError   = "Recipe is locked. Unlock it in the progression tree"
+	ErrMsgRecipeOffEventError = "This recipe can only be crafted during its event"
 	ErrMsgRecipeNotFoundError = "Recipe not found"
 
 	// Feature messages
@@ -122,6 +349,12 @@ const (
 	ErrMsgBetQuantityPositiveError    = "Bet quantity must be positive"
 	ErrMsgNotLootboxError             = "That item is not a lootbox"
 	ErrMsgAlreadyJoinedError          = "You have already joined this gamble"
+	ErrMsgParticipantSideBetError     = "Players cannot side-bet on their own gamble"
+	ErrMsgSideBetNotParticipantError  = "You can only back a player in this gamble"
+	ErrMsgSideBetAlreadyPlacedError   = "You have already placed a side bet on this gamble"
+	ErrMsgSideBettorCannotJoinError   = "You have a side bet on this gamble and cannot join it"
+	ErrMsgGambleFullError             = "This gamble is full"
+	ErrMsgWagerTooHighError           = "That wager is worth more than a gamble allows"
 
 	// Voting messages
 	ErrMsgAlreadyVotedError = "You have already voted"
@@ -200,6 +433,12 @@ func mapUserErrors(err error, errMsg string) (int, string, bool) {
 		return http.StatusBadRequest, ErrMsgUserNotFoundError, true
 	case errors.Is(err, domain.ErrInvalidPlatform):
 		return http.StatusBadRequest, ErrMsgInvalidPlatformError, true
+	case errors.Is(err, domain.ErrDailyGiveLimitReached):
+		return http.StatusTooManyRequests, ErrMsgGiveLimitError, true
+	case errors.Is(err, domain.ErrAccountTooNewToGive):
+		return http.StatusForbidden, ErrMsgAccountTooNewError, true
+	case errors.Is(err, domain.ErrAccountFrozen):
+		return http.StatusForbidden, ErrMsgAccountFrozenError, true
 	}
 	return 0, "", false
 }
@@ -207,6 +446,10 @@ func mapUserErrors(err error, errMsg string) (int, string, bool) {
 func mapItemErrors(err error) (int, string, bool) {
 	switch {
 	case errors.Is(err, domain.ErrItemNotFound):
+		var notFoundErr domain.ItemNotFoundError
+		if errors.As(err, &notFoundErr) && len(notFoundErr.Suggestions) > 0 {
+			return http.StatusBadRequest, fmt.Sprintf(ErrMsgItemSuggestionFormat, strings.Join(notFoundErr.Suggestions, ", ")), true
+		}
 		return http.StatusBadRequest, ErrMsgItemNotFoundError, true
 	case errors.Is(err, domain.ErrInsufficientFunds):
 		return http.StatusBadRequest, ErrMsgNotEnoughMoneyError, true
@@ -220,6 +463,10 @@ func mapItemErrors(err error) (int, string, bool) {
 		return http.StatusBadRequest, ErrMsgNotSellableError, true
 	case errors.Is(err, domain.ErrNotBuyable):
 		return http.StatusBadRequest, ErrMsgNotBuyableError, true
+	case errors.Is(err, domain.ErrItemBorrowed):
+		return http.StatusBadRequest, ErrMsgItemBorrowedError, true
+	case errors.Is(err, domain.ErrItemLockedByUser):
+		return http.StatusBadRequest, ErrMsgItemLockedError, true
 	}
 	return 0, "", false
 }
@@ -248,8 +495,16 @@ func mapEconomyAndFeatureErrors(err error, errMsg string) (int, string, bool) {
 			}
 		}
 		return http.StatusForbidden, ErrMsgRecipeLockedError, true
+	case errors.Is(err, domain.ErrRecipeOffEvent):
+		return http.StatusForbidden, ErrMsgRecipeOffEventError, true
 	case errors.Is(err, domain.ErrFeatureLocked):
 		return http.StatusForbidden, ErrMsgFeatureLockedProgressionError, true
+	case errors.Is(err, domain.ErrSearchLocationLocked):
+		return http.StatusForbidden, errMsg, true
+	case errors.Is(err, domain.ErrUnknownSearchLocation):
+		return http.StatusBadRequest, errMsg, true
+	case errors.Is(err, domain.ErrUnknownNameTheme):
+		return http.StatusBadRequest, errMsg, true
 	case errors.Is(err, domain.ErrDailyCapReached):
 		return http.StatusBadRequest, ErrMsgDailyCapReachedError, true
 	case errors.Is(err, domain.ErrOnCooldown):
@@ -290,6 +545,18 @@ func mapGambleErrors(err error) (int, string, bool) {
 		return http.StatusBadRequest, ErrMsgNotLootboxError, true
 	case errors.Is(err, domain.ErrUserAlreadyJoined):
 		return http.StatusBadRequest, ErrMsgAlreadyJoinedError, true
+	case errors.Is(err, domain.ErrParticipantCannotSideBet):
+		return http.StatusBadRequest, ErrMsgParticipantSideBetError, true
+	case errors.Is(err, domain.ErrSideBetNotParticipant):
+		return http.StatusBadRequest, ErrMsgSideBetNotParticipantError, true
+	case errors.Is(err, domain.ErrSideBetAlreadyPlaced):
+		return http.StatusBadRequest, ErrMsgSideBetAlreadyPlacedError, true
+	case errors.Is(err, domain.ErrSideBettorCannotJoin):
+		return http.StatusBadRequest, ErrMsgSideBettorCannotJoinError, true
+	case errors.Is(err, domain.ErrGambleFull):
+		return http.StatusBadRequest, ErrMsgGambleFullError, true
+	case errors.Is(err, domain.ErrWagerTooHigh):
+		return http.StatusBadRequest, ErrMsgWagerTooHighError, true
 	}
 	return 0, "", false
 }
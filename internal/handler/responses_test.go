@@ -0,0 +1,131 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+)
+
+func TestErrorCodeForStatus(t *testing.T) {
+	tests := []struct {
+		status int
+		want   ErrorCode
+	}{
+		{http.StatusBadRequest, ErrCodeBadRequest},
+		{http.StatusUnprocessableEntity, ErrCodeBadRequest},
+		{http.StatusUnauthorized, ErrCodeUnauthorized},
+		{http.StatusForbidden, ErrCodeForbidden},
+		{http.StatusNotFound, ErrCodeNotFound},
+		{http.StatusMethodNotAllowed, ErrCodeMethodNotAllowed},
+		{http.StatusConflict, ErrCodeConflict},
+		{http.StatusRequestEntityTooLarge, ErrCodePayloadTooLarge},
+		{http.StatusTooManyRequests, ErrCodeRateLimited},
+		{http.StatusInternalServerError, ErrCodeInternal},
+		{http.StatusBadGateway, ErrCodeInternal},
+		{http.StatusServiceUnavailable, ErrCodeUnavailable},
+	}
+
+	for _, tt := range tests {
+		t.Run(http.StatusText(tt.status), func(t *testing.T) {
+			assert.Equal(t, tt.want, ErrorCodeForStatus(tt.status))
+		})
+	}
+}
+
+func TestRespondError_Envelope(t *testing.T) {
+	w := httptest.NewRecorder()
+
+	RespondError(w, http.StatusNotFound, ErrMsgUserNotFoundError)
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+	assert.Equal(t, "application/json", w.Header().Get("Content-Type"))
+
+	var resp ErrorResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	assert.Equal(t, ErrCodeNotFound, resp.Code)
+	assert.Equal(t, ErrMsgUserNotFoundError, resp.Message)
+	assert.Equal(t, resp.Message, resp.Error)
+	assert.Empty(t, resp.RequestID)
+}
+
+func TestRespondError_RequestID(t *testing.T) {
+	w := httptest.NewRecorder()
+	w.Header().Set(HeaderRequestID, "req-123")
+
+	RespondError(w, http.StatusBadRequest, ErrMsgInvalidRequestError)
+
+	var resp ErrorResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	assert.Equal(t, "req-123", resp.RequestID)
+}
+
+func TestErrorCodeForError(t *testing.T) {
+	tests := []struct {
+		name   string
+		err    error
+		status int
+		want   ErrorCode
+	}{
+		{"item not found", domain.ErrItemNotFound, http.StatusBadRequest, ErrCodeItemNotFound},
+		{"wrapped insufficient funds", fmt.Errorf("buy: %w", domain.ErrInsufficientFunds), http.StatusBadRequest, ErrCodeInsufficientFunds},
+		{"recipe locked", domain.ErrRecipeLocked, http.StatusForbidden, ErrCodeFeatureLocked},
+		{"give limit", domain.ErrDailyGiveLimitReached, http.StatusTooManyRequests, ErrCodeLimitReached},
+		{"unknown error falls back to status", errors.New("boom"), http.StatusInternalServerError, ErrCodeInternal},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, ErrorCodeForError(tt.err, tt.status))
+		})
+	}
+}
+
+func TestRespondMappedError_DomainCode(t *testing.T) {
+	w := httptest.NewRecorder()
+
+	RespondMappedError(w, fmt.Errorf("sell: %w", domain.ErrNotInInventory))
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	var resp ErrorResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	assert.Equal(t, ErrCodeNotInInventory, resp.Code)
+	assert.Equal(t, ErrMsgNotInInventoryError, resp.Message)
+}
+
+func TestRespondMappedError_ItemSuggestions(t *testing.T) {
+	t.Run("suggestions go in the message and details", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		RespondMappedError(w, fmt.Errorf("buy: %w", domain.ItemNotFoundError{Name: "misile", Suggestions: []string{"missile", "mine"}}))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		var resp struct {
+			Code    ErrorCode           `json:"code"`
+			Message string              `json:"message"`
+			Details ItemNotFoundDetails `json:"details"`
+		}
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+		assert.Equal(t, ErrCodeItemNotFound, resp.Code)
+		assert.Equal(t, "Item not found. Did you mean missile, mine?", resp.Message)
+		assert.Equal(t, []string{"missile", "mine"}, resp.Details.Suggestions)
+	})
+
+	t.Run("no suggestions keeps the plain message", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		RespondMappedError(w, domain.ItemNotFoundError{Name: "zzz"})
+
+		var resp ErrorResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+		assert.Equal(t, ErrMsgItemNotFoundError, resp.Message)
+		assert.Nil(t, resp.Details)
+	})
+}
@@ -22,18 +22,29 @@ type SearchRequest struct {
 
 type SearchResponse struct {
 	Message string `json:"message"`
+	// Progress is the player's search streak and mastery after this search
+	Progress *search.ProgressStatus `json:"progress,omitempty"`
+}
+
+type SearchLocationsResponse struct {
+	Locations []search.LocationStatus `json:"locations"`
 }
 
 // HandleSearch handles player searching for items in the current environment.
 // @Summary Perform environment search
 // @Description Allows players to search for loot boxes. Results depend on daily usage and character progression.
+// @Description Pass a location to search a specific region instead of the one picked for the player.
+// @Description The response includes the player's search streak (bonus lootboxes on the first search of each day from 3 days on) and mastery level (each level shortens the search cooldown by 2%).
 // @Tags user
 // @Accept json
 // @Produce json
 // @Param request body SearchRequest true "User identification"
+// @Param location query string false "Region key to search (see /user/search/locations)"
 // @Success 200 {object} SearchResponse
+// @Failure 400 {object} ErrorResponse "Unknown location"
 // @Failure 401 {object} ErrorResponse "Invalid API Key"
-// @Failure 429 {object} ErrorResponse "Action on cooldown"
+// @Failure 403 {object} ErrorResponse "Location locked"
+// @Failure 429 {object} CooldownErrorResponse "Action on cooldown"
 // @Failure 500 {object} ErrorResponse "Internal server error"
 // @Router /api/v1/user/search [post]
 func HandleSearch(searchSvc search.Service, userService user.Service, progressionSvc progression.Service, eventBus event.Bus) http.HandlerFunc {
@@ -49,7 +60,13 @@ func HandleSearch(searchSvc search.Service, userService user.Service, progressio
 		}
 
 		// Perform search through search service directly
-		resultMessage, err := searchSvc.HandleSearch(r.Context(), req.Platform, req.PlatformID, req.Username, req.ItemHint)
+		var resultMessage string
+		var err error
+		if location := r.URL.Query().Get("location"); location != "" {
+			resultMessage, err = searchSvc.SearchLocation(r.Context(), req.Platform, req.PlatformID, req.Username, location)
+		} else {
+			resultMessage, err = searchSvc.HandleSearch(r.Context(), req.Platform, req.PlatformID, req.Username, req.ItemHint)
+		}
 		if err != nil {
 			log := logger.FromContext(r.Context())
 			if errors.Is(err, domain.ErrOnCooldown) {
@@ -57,8 +74,7 @@ func HandleSearch(searchSvc search.Service, userService user.Service, progressio
 			} else {
 				log.Error("Search failed", "error", err, "username", req.Username)
 			}
-			statusCode, userMsg := MapServiceErrorToUserMessage(err)
-			RespondError(w, statusCode, userMsg)
+			RespondMappedError(w, err)
 			return
 		}
 
@@ -72,8 +88,57 @@ func HandleSearch(searchSvc search.Service, userService user.Service, progressio
 			)
 		}
 
+		// Progress is extra detail; the search itself already succeeded
+		progress, err := searchSvc.GetProgress(r.Context(), req.Platform, req.PlatformID, req.Username)
+		if err != nil {
+			logger.FromContext(r.Context()).Warn("Failed to get search progress", "error", err, "username", req.Username)
+		}
+
 		RespondJSON(w, http.StatusOK, SearchResponse{
-			Message: resultMessage,
+			Message:  resultMessage,
+			Progress: progress,
 		})
 	}
 }
+
+// HandleGetSearchLocations lists the search locations and whether the player can search them.
+// @Summary List search locations
+// @Description Lists every search region with its unlock requirements, whether the player has unlocked it, and how long until it is off cooldown.
+// @Tags user
+// @Produce json
+// @Param platform query string true "Platform"
+// @Param platform_id query string true "Platform ID"
+// @Param username query string true "Username"
+// @Success 200 {object} SearchLocationsResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /api/v1/user/search/locations [get]
+func HandleGetSearchLocations(searchSvc search.Service, progressionSvc progression.Service) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if CheckFeatureLocked(w, r, progressionSvc, progression.FeatureSearch) {
+			return
+		}
+
+		platform, ok := GetQueryParam(r, w, "platform")
+		if !ok {
+			return
+		}
+		platformID, ok := GetQueryParam(r, w, "platform_id")
+		if !ok {
+			return
+		}
+		username, ok := GetQueryParam(r, w, "username")
+		if !ok {
+			return
+		}
+
+		locations, err := searchSvc.GetLocations(r.Context(), platform, platformID, username)
+		if err != nil {
+			logger.FromContext(r.Context()).Error("Failed to get search locations", "error", err, "username", username)
+			RespondMappedError(w, err)
+			return
+		}
+
+		RespondJSON(w, http.StatusOK, SearchLocationsResponse{Locations: locations})
+	}
+}
@@ -4,22 +4,27 @@ import (
 	"bytes"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 
+	"github.com/osse101/BrandishBot_Go/internal/cooldown"
 	"github.com/osse101/BrandishBot_Go/internal/domain"
 	"github.com/osse101/BrandishBot_Go/internal/event"
 	"github.com/osse101/BrandishBot_Go/internal/progression"
+	"github.com/osse101/BrandishBot_Go/internal/search"
 	"github.com/osse101/BrandishBot_Go/mocks"
 )
 
 func TestHandleSearch(t *testing.T) {
 	tests := []struct {
 		name           string
+		query          string
 		requestBody    interface{}
 		setupMock      func(*mocks.MockSearchService, *mocks.MockUserService, *mocks.MockProgressionService, *mocks.MockEventBus)
 		expectedStatus int
@@ -37,6 +42,7 @@ func TestHandleSearch(t *testing.T) {
 				u.On("GetUserIDByPlatformID", mock.Anything, domain.PlatformTwitch, "test-id").Return("", nil)
 
 				ms.On("HandleSearch", mock.Anything, domain.PlatformTwitch, "test-id", "testuser", "").Return("Found a sword!", nil)
+				ms.On("GetProgress", mock.Anything, domain.PlatformTwitch, "test-id", "testuser").Return(&search.ProgressStatus{CurrentStreak: 3, StreakBonus: 1}, nil)
 
 				// Expect both engagement and search.performed events
 				e.On("Publish", mock.Anything, mock.MatchedBy(func(evt event.Event) bool {
@@ -44,7 +50,7 @@ func TestHandleSearch(t *testing.T) {
 				})).Return(nil).Maybe()
 			},
 			expectedStatus: http.StatusOK,
-			expectedBody:   `"message":"Found a sword!"`,
+			expectedBody:   `"message":"Found a sword!","progress":{"current_streak":3`,
 		},
 		{
 			name: "Feature Locked",
@@ -62,6 +68,70 @@ func TestHandleSearch(t *testing.T) {
 			expectedStatus: http.StatusForbidden,
 			expectedBody:   "LOCKED_NODES: Progression System",
 		},
+		{
+			name: "On Cooldown",
+			requestBody: SearchRequest{
+				Platform:   domain.PlatformTwitch,
+				PlatformID: "test-id",
+				Username:   "testuser",
+			},
+			setupMock: func(ms *mocks.MockSearchService, u *mocks.MockUserService, p *mocks.MockProgressionService, e *mocks.MockEventBus) {
+				p.On("IsFeatureUnlocked", mock.Anything, progression.FeatureSearch).Return(true, nil)
+				ms.On("HandleSearch", mock.Anything, domain.PlatformTwitch, "test-id", "testuser", "").
+					Return("", cooldown.ErrOnCooldown{Action: domain.ActionSearch, Remaining: 89500 * time.Millisecond})
+			},
+			expectedStatus: http.StatusTooManyRequests,
+			expectedBody:   `"code":"ON_COOLDOWN","message":"You can search again in 1m 29s","details":{"action":"search","retry_after_seconds":90`,
+		},
+		{
+			name:  "Location",
+			query: "?location=sunken_vault",
+			requestBody: SearchRequest{
+				Platform:   domain.PlatformTwitch,
+				PlatformID: "test-id",
+				Username:   "testuser",
+			},
+			setupMock: func(ms *mocks.MockSearchService, u *mocks.MockUserService, p *mocks.MockProgressionService, e *mocks.MockEventBus) {
+				p.On("IsFeatureUnlocked", mock.Anything, progression.FeatureSearch).Return(true, nil)
+				u.On("GetUserIDByPlatformID", mock.Anything, domain.PlatformTwitch, "test-id").Return("", nil)
+				ms.On("SearchLocation", mock.Anything, domain.PlatformTwitch, "test-id", "testuser", "sunken_vault").Return("Found a shield! [Sunken Vault]", nil)
+				ms.On("GetProgress", mock.Anything, domain.PlatformTwitch, "test-id", "testuser").Return(nil, assert.AnError)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   `[Sunken Vault]`,
+		},
+		{
+			name:  "Location Locked",
+			query: "?location=sunken_vault",
+			requestBody: SearchRequest{
+				Platform:   domain.PlatformTwitch,
+				PlatformID: "test-id",
+				Username:   "testuser",
+			},
+			setupMock: func(ms *mocks.MockSearchService, u *mocks.MockUserService, p *mocks.MockProgressionService, e *mocks.MockEventBus) {
+				p.On("IsFeatureUnlocked", mock.Anything, progression.FeatureSearch).Return(true, nil)
+				ms.On("SearchLocation", mock.Anything, domain.PlatformTwitch, "test-id", "testuser", "sunken_vault").
+					Return("", fmt.Errorf("%w: Sunken Vault requires feature_expedition to be unlocked in the progression tree", domain.ErrSearchLocationLocked))
+			},
+			expectedStatus: http.StatusForbidden,
+			expectedBody:   "Sunken Vault requires feature_expedition",
+		},
+		{
+			name:  "Unknown Location",
+			query: "?location=moon",
+			requestBody: SearchRequest{
+				Platform:   domain.PlatformTwitch,
+				PlatformID: "test-id",
+				Username:   "testuser",
+			},
+			setupMock: func(ms *mocks.MockSearchService, u *mocks.MockUserService, p *mocks.MockProgressionService, e *mocks.MockEventBus) {
+				p.On("IsFeatureUnlocked", mock.Anything, progression.FeatureSearch).Return(true, nil)
+				ms.On("SearchLocation", mock.Anything, domain.PlatformTwitch, "test-id", "testuser", "moon").
+					Return("", fmt.Errorf("%w: moon", domain.ErrUnknownSearchLocation))
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   "unknown search location: moon",
+		},
 		{
 			name: "Service Error",
 			requestBody: SearchRequest{
@@ -89,12 +159,15 @@ func TestHandleSearch(t *testing.T) {
 			handler := HandleSearch(mockSearch, mockUser, mockProg, mockBus)
 
 			body, _ := json.Marshal(tt.requestBody)
-			req := httptest.NewRequest("POST", "/user/search", bytes.NewBuffer(body))
+			req := httptest.NewRequest("POST", "/user/search"+tt.query, bytes.NewBuffer(body))
 			w := httptest.NewRecorder()
 
 			handler.ServeHTTP(w, req)
 
 			assert.Equal(t, tt.expectedStatus, w.Code)
+			if tt.expectedStatus == http.StatusTooManyRequests {
+				assert.Equal(t, "90", w.Header().Get("Retry-After"))
+			}
 			if tt.expectedBody != "" {
 				assert.Contains(t, w.Body.String(), tt.expectedBody)
 			}
@@ -105,3 +178,33 @@ func TestHandleSearch(t *testing.T) {
 		})
 	}
 }
+
+func TestHandleGetSearchLocations(t *testing.T) {
+	t.Run("lists locations", func(t *testing.T) {
+		mockSearch := mocks.NewMockSearchService(t)
+		mockProg := mocks.NewMockProgressionService(t)
+		mockProg.On("IsFeatureUnlocked", mock.Anything, progression.FeatureSearch).Return(true, nil)
+		mockSearch.On("GetLocations", mock.Anything, domain.PlatformDiscord, "123", "testuser").Return([]search.LocationStatus{
+			{Key: "the_clearing", Name: "The Clearing", Unlocked: true},
+		}, nil)
+
+		req := httptest.NewRequest(http.MethodGet, "/user/search/locations?platform=discord&platform_id=123&username=testuser", nil)
+		w := httptest.NewRecorder()
+		HandleGetSearchLocations(mockSearch, mockProg).ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), `"key":"the_clearing"`)
+	})
+
+	t.Run("missing username", func(t *testing.T) {
+		mockSearch := mocks.NewMockSearchService(t)
+		mockProg := mocks.NewMockProgressionService(t)
+		mockProg.On("IsFeatureUnlocked", mock.Anything, progression.FeatureSearch).Return(true, nil)
+
+		req := httptest.NewRequest(http.MethodGet, "/user/search/locations?platform=discord&platform_id=123", nil)
+		w := httptest.NewRecorder()
+		HandleGetSearchLocations(mockSearch, mockProg).ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
@@ -33,6 +33,17 @@ type SpinSlotsRequest struct {
 }
 
 // HandleSpinSlots processes a slots spin request
+// @Summary Spin the slots
+// @Description Bet money on a spin of the slot machine
+// @Tags slots
+// @Accept json
+// @Produce json
+// @Param request body SpinSlotsRequest true "Bet"
+// @Success 200 {object} domain.SlotsResult
+// @Failure 400 {object} ErrorResponse "Invalid bet or insufficient funds"
+// @Failure 403 {object} ErrorResponse "Feature locked"
+// @Failure 429 {object} CooldownErrorResponse
+// @Router /api/v1/slots/spin [post]
 func (h *SlotsHandler) HandleSpinSlots(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 	log := logger.FromContext(ctx)
@@ -62,7 +73,7 @@ func (h *SlotsHandler) HandleSpinSlots(w http.ResponseWriter, r *http.Request) {
 		case errors.Is(err, domain.ErrInvalidQuantity):
 			RespondError(w, http.StatusBadRequest, err.Error())
 		case errors.Is(err, domain.ErrOnCooldown):
-			RespondError(w, http.StatusBadRequest, err.Error())
+			RespondMappedError(w, err)
 		case errors.Is(err, domain.ErrInvalidInput):
 			RespondError(w, http.StatusBadRequest, err.Error())
 		default:
@@ -1,8 +1,11 @@
 package handler
 
 import (
+	"errors"
 	"net/http"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/osse101/BrandishBot_Go/internal/domain"
 	"github.com/osse101/BrandishBot_Go/internal/logger"
@@ -53,8 +56,7 @@ func HandleRecordEvent(svc stats.Service) http.HandlerFunc {
 
 		if err := svc.RecordUserEvent(r.Context(), req.UserID, domain.EventType(req.EventType), req.EventData); err != nil {
 			log.Error("Failed to record event", "error", err, "user_id", req.UserID, "event_type", req.EventType)
-			statusCode, userMsg := MapServiceErrorToUserMessage(err)
-			RespondError(w, statusCode, userMsg)
+			RespondMappedError(w, err)
 			return
 		}
 
@@ -127,8 +129,7 @@ func (h *StatsHandler) HandleGetUserStats() http.HandlerFunc {
 		summary, err := h.service.GetUserStats(r.Context(), userID, period)
 		if err != nil {
 			log.Error("Failed to get user stats", "error", err, "user_id", userID)
-			statusCode, userMsg := MapServiceErrorToUserMessage(err)
-			RespondError(w, statusCode, userMsg)
+			RespondMappedError(w, err)
 			return
 		}
 
@@ -158,8 +159,7 @@ func HandleGetSystemStats(svc stats.Service) http.HandlerFunc {
 		summary, err := svc.GetSystemStats(r.Context(), period)
 		if err != nil {
 			log.Error("Failed to get system stats", "error", err)
-			statusCode, userMsg := MapServiceErrorToUserMessage(err)
-			RespondError(w, statusCode, userMsg)
+			RespondMappedError(w, err)
 			return
 		}
 
@@ -171,17 +171,22 @@ func HandleGetSystemStats(svc stats.Service) http.HandlerFunc {
 
 // HandleGetLeaderboard handles GET requests for leaderboards
 // @Summary Get leaderboard
-// @Description Get leaderboard for a specific event type
+// @Description Get a page of the leaderboard for a specific event type. Passing platform with platform_id or username centres the page on that user instead of using offset.
 // @Tags stats
 // @Produce json
 // @Param event_type query string true "Event Type"
 // @Param period query string false "Period (daily, weekly, all_time)"
-// @Param limit query int false "Limit (default 10)"
-// @Success 200 {object} map[string]interface{}
+// @Param limit query int false "Limit (default 10, max 100)"
+// @Param offset query int false "Number of ranks to skip (default 0)"
+// @Param platform query string false "Platform of the user to centre on"
+// @Param platform_id query string false "Platform ID of the user to centre on"
+// @Param username query string false "Username of the user to centre on"
+// @Success 200 {object} domain.LeaderboardPage
 // @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
 // @Failure 500 {object} ErrorResponse
 // @Router /api/v1/stats/leaderboard [get]
-func HandleGetLeaderboard(svc stats.Service) http.HandlerFunc {
+func (h *StatsHandler) HandleGetLeaderboard() http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		log := logger.FromContext(r.Context())
 
@@ -204,22 +209,136 @@ func HandleGetLeaderboard(svc stats.Service) http.HandlerFunc {
 			}
 		}
 
-		log.Debug("Get leaderboard request", "event_type", eventType, "period", period, "limit", limit)
+		offset := 0
+		if offsetStr := r.URL.Query().Get("offset"); offsetStr != "" {
+			var err error
+			offset, err = strconv.Atoi(offsetStr)
+			if err != nil || offset < 0 {
+				log.Warn("Invalid offset parameter", "offset", offsetStr)
+				RespondError(w, http.StatusBadRequest, ErrMsgInvalidOffset)
+				return
+			}
+		}
+
+		query := domain.LeaderboardQuery{
+			EventType: domain.EventType(eventType),
+			Period:    period,
+			Limit:     limit,
+			Offset:    offset,
+		}
+
+		// Around-mode: centre the page on a user given by platform_id or username
+		platformID := r.URL.Query().Get("platform_id")
+		username := r.URL.Query().Get("username")
+		if platformID != "" || username != "" {
+			platform, ok := GetQueryParam(r, w, "platform")
+			if !ok {
+				return
+			}
+
+			var user *domain.User
+			var err error
+			if platformID != "" {
+				user, err = h.userRepo.GetUserByPlatformID(r.Context(), platform, platformID)
+			} else {
+				user, err = h.userRepo.GetUserByPlatformUsername(r.Context(), platform, username)
+			}
+			if err != nil {
+				log.Error("Failed to find leaderboard user", "error", err, "platform", platform, "platform_id", platformID, "username", username)
+				RespondError(w, http.StatusNotFound, "User not found")
+				return
+			}
+			query.AroundUserID = user.ID
+		}
+
+		log.Debug("Get leaderboard request", "event_type", eventType, "period", period, "limit", limit, "offset", offset, "around_user_id", query.AroundUserID)
 
-		entries, err := svc.GetLeaderboard(r.Context(), domain.EventType(eventType), period, limit)
+		page, err := h.service.GetLeaderboard(r.Context(), query)
 		if err != nil {
 			log.Error("Failed to get leaderboard", "error", err, "event_type", eventType)
-			statusCode, userMsg := MapServiceErrorToUserMessage(err)
-			RespondError(w, statusCode, userMsg)
+			RespondMappedError(w, err)
 			return
 		}
 
-		log.Info("Leaderboard retrieved", "event_type", eventType, "period", period, "entries", len(entries))
+		log.Info("Leaderboard retrieved", "event_type", eventType, "period", period, "offset", page.Offset, "entries", len(page.Entries))
+
+		RespondJSON(w, http.StatusOK, page)
+	}
+}
+
+// HandleQueryStats handles GET requests for grouped event counts over a range
+// @Summary Query stats
+// @Description Count events over a range, grouped by time bucket, user and/or event type. Closed buckets are read from rollups.
+// @Tags stats
+// @Produce json
+// @Param bucket query string false "Bucket size (hour, day, week; default day)"
+// @Param start query string false "Range start, RFC3339 (default 7 days before end); widened to the start of its bucket"
+// @Param end query string false "Range end, RFC3339, exclusive (default now)"
+// @Param group_by query string false "Comma-separated grouping: time, user, event_type"
+// @Param user_id query string false "Only count this user's events"
+// @Param event_type query string false "Only count this event type"
+// @Param limit query int false "Maximum groups (default 100, max 1000)"
+// @Success 200 {object} domain.StatsQueryResult
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /api/v1/stats/query [get]
+func HandleQueryStats(svc stats.Service) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		log := logger.FromContext(r.Context())
+		params := r.URL.Query()
+
+		query := domain.StatsQuery{
+			Bucket:    domain.StatsBucket(params.Get("bucket")),
+			UserID:    params.Get("user_id"),
+			EventType: domain.EventType(params.Get("event_type")),
+		}
+
+		for name, target := range map[string]*time.Time{"start": &query.Start, "end": &query.End} {
+			if raw := params.Get(name); raw != "" {
+				parsed, err := time.Parse(time.RFC3339, raw)
+				if err != nil {
+					RespondError(w, http.StatusBadRequest, "Invalid '"+name+"' timestamp format (use RFC3339)")
+					return
+				}
+				*target = parsed
+			}
+		}
+
+		for _, group := range strings.Split(params.Get("group_by"), ",") {
+			switch strings.TrimSpace(group) {
+			case "":
+			case "time":
+				query.GroupByTime = true
+			case "user":
+				query.GroupByUser = true
+			case "event_type":
+				query.GroupByEventType = true
+			default:
+				RespondError(w, http.StatusBadRequest, "Invalid 'group_by' (use time, user and/or event_type)")
+				return
+			}
+		}
+
+		if limitStr := params.Get("limit"); limitStr != "" {
+			limit, err := strconv.Atoi(limitStr)
+			if err != nil || limit < 1 || limit > stats.MaxQueryLimit {
+				RespondError(w, http.StatusBadRequest, ErrMsgInvalidLimit)
+				return
+			}
+			query.Limit = limit
+		}
+
+		result, err := svc.QueryStats(r.Context(), query)
+		if err != nil {
+			if errors.Is(err, domain.ErrInvalidInput) {
+				RespondError(w, http.StatusBadRequest, err.Error())
+				return
+			}
+			log.Error("Failed to query stats", "error", err)
+			RespondMappedError(w, err)
+			return
+		}
 
-		RespondJSON(w, http.StatusOK, map[string]interface{}{
-			"event_type": eventType,
-			"period":     period,
-			"entries":    entries,
-		})
+		RespondJSON(w, http.StatusOK, result)
 	}
 }
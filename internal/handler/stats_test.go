@@ -4,9 +4,11 @@ import (
 	"bytes"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -264,43 +266,83 @@ func TestHandleGetSystemStats(t *testing.T) {
 func TestHandleGetLeaderboard(t *testing.T) {
 	tests := []struct {
 		name           string
-		eventType      string
-		limit          string
-		setupMock      func(*mocks.MockStatsService)
+		query          string
+		setupMock      func(*mocks.MockStatsService, *mocks.MockRepositoryUser)
 		expectedStatus int
 		expectedBody   string
 	}{
 		{
-			name:      "Success",
-			eventType: domain.EventTypeItemUsed,
-			limit:     "5",
-			setupMock: func(m *mocks.MockStatsService) {
-				entries := []domain.LeaderboardEntry{{UserID: "user1", Count: 10}}
-				m.On("GetLeaderboard", mock.Anything, domain.EventType(domain.EventTypeItemUsed), domain.PeriodDaily, 5).Return(entries, nil)
+			name:  "Success",
+			query: "?event_type=" + domain.EventTypeItemUsed + "&limit=5&offset=10",
+			setupMock: func(m *mocks.MockStatsService, repo *mocks.MockRepositoryUser) {
+				page := &domain.LeaderboardPage{Entries: []domain.LeaderboardEntry{{Rank: 11, UserID: "user1", Count: 10}}}
+				m.On("GetLeaderboard", mock.Anything, domain.LeaderboardQuery{
+					EventType: domain.EventType(domain.EventTypeItemUsed),
+					Period:    domain.PeriodDaily,
+					Limit:     5,
+					Offset:    10,
+				}).Return(page, nil)
 			},
 			expectedStatus: http.StatusOK,
-			expectedBody:   `"user_id":"user1"`,
+			expectedBody:   `"rank":11,"user_id":"user1"`,
+		},
+		{
+			name:  "Around user",
+			query: "?event_type=" + domain.EventTypeItemUsed + "&platform=" + domain.PlatformDiscord + "&platform_id=disc123",
+			setupMock: func(m *mocks.MockStatsService, repo *mocks.MockRepositoryUser) {
+				repo.On("GetUserByPlatformID", mock.Anything, domain.PlatformDiscord, "disc123").Return(&domain.User{ID: "user123"}, nil)
+				m.On("GetLeaderboard", mock.Anything, domain.LeaderboardQuery{
+					EventType:    domain.EventType(domain.EventTypeItemUsed),
+					Period:       domain.PeriodDaily,
+					Limit:        10,
+					AroundUserID: "user123",
+				}).Return(&domain.LeaderboardPage{UserRank: 42}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   `"user_rank":42`,
+		},
+		{
+			name:  "Around user not found",
+			query: "?event_type=" + domain.EventTypeItemUsed + "&platform=" + domain.PlatformTwitch + "&username=ghost",
+			setupMock: func(m *mocks.MockStatsService, repo *mocks.MockRepositoryUser) {
+				repo.On("GetUserByPlatformUsername", mock.Anything, domain.PlatformTwitch, "ghost").Return(nil, errors.New("not found"))
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedBody:   "User not found",
+		},
+		{
+			name:           "Around user missing platform",
+			query:          "?event_type=" + domain.EventTypeItemUsed + "&platform_id=disc123",
+			setupMock:      func(m *mocks.MockStatsService, repo *mocks.MockRepositoryUser) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   "Missing platform",
 		},
 		{
 			name:           "Missing EventType",
-			eventType:      "",
-			setupMock:      func(m *mocks.MockStatsService) {},
+			query:          "",
+			setupMock:      func(m *mocks.MockStatsService, repo *mocks.MockRepositoryUser) {},
 			expectedStatus: http.StatusBadRequest,
 			expectedBody:   "Missing event_type",
 		},
 		{
 			name:           "Invalid Limit",
-			eventType:      domain.EventTypeItemUsed,
-			limit:          "invalid",
-			setupMock:      func(m *mocks.MockStatsService) {},
+			query:          "?event_type=" + domain.EventTypeItemUsed + "&limit=invalid",
+			setupMock:      func(m *mocks.MockStatsService, repo *mocks.MockRepositoryUser) {},
 			expectedStatus: http.StatusBadRequest,
 			expectedBody:   "Invalid limit",
 		},
 		{
-			name:      "Service Error",
-			eventType: domain.EventTypeItemUsed,
-			setupMock: func(m *mocks.MockStatsService) {
-				m.On("GetLeaderboard", mock.Anything, domain.EventType(domain.EventTypeItemUsed), domain.PeriodDaily, 10).Return(nil, errors.New(ErrMsgGenericServerError))
+			name:           "Invalid Offset",
+			query:          "?event_type=" + domain.EventTypeItemUsed + "&offset=-1",
+			setupMock:      func(m *mocks.MockStatsService, repo *mocks.MockRepositoryUser) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   ErrMsgInvalidOffset,
+		},
+		{
+			name:  "Service Error",
+			query: "?event_type=" + domain.EventTypeItemUsed,
+			setupMock: func(m *mocks.MockStatsService, repo *mocks.MockRepositoryUser) {
+				m.On("GetLeaderboard", mock.Anything, mock.Anything).Return(nil, errors.New(ErrMsgGenericServerError))
 			},
 			expectedStatus: http.StatusInternalServerError,
 			expectedBody:   ErrMsgGenericServerError,
@@ -310,18 +352,12 @@ func TestHandleGetLeaderboard(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			mockSvc := mocks.NewMockStatsService(t)
-			tt.setupMock(mockSvc)
+			mockRepo := mocks.NewMockRepositoryUser(t)
+			tt.setupMock(mockSvc, mockRepo)
 
-			handler := HandleGetLeaderboard(mockSvc)
+			handler := NewStatsHandler(mockSvc, mockRepo).HandleGetLeaderboard()
 
-			url := "/stats/leaderboard"
-			if tt.eventType != "" {
-				url += "?event_type=" + tt.eventType
-			}
-			if tt.limit != "" {
-				url += "&limit=" + tt.limit
-			}
-			req := httptest.NewRequest("GET", url, nil)
+			req := httptest.NewRequest("GET", "/stats/leaderboard"+tt.query, nil)
 			w := httptest.NewRecorder()
 
 			handler.ServeHTTP(w, req)
@@ -330,7 +366,71 @@ func TestHandleGetLeaderboard(t *testing.T) {
 			if tt.expectedBody != "" {
 				assert.Contains(t, w.Body.String(), tt.expectedBody)
 			}
-			mockSvc.AssertExpectations(t)
+		})
+	}
+}
+
+func TestHandleQueryStats(t *testing.T) {
+	tests := []struct {
+		name           string
+		query          string
+		setupMock      func(*mocks.MockStatsService)
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name:  "Success",
+			query: "?bucket=hour&start=2026-03-01T00:00:00Z&group_by=user,event_type&event_type=message&limit=5",
+			setupMock: func(m *mocks.MockStatsService) {
+				m.On("QueryStats", mock.Anything, domain.StatsQuery{
+					Bucket:           domain.StatsBucketHour,
+					Start:            time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC),
+					GroupByUser:      true,
+					GroupByEventType: true,
+					EventType:        "message",
+					Limit:            5,
+				}).Return(&domain.StatsQueryResult{Rows: []domain.StatsQueryRow{{UserID: "u1", Count: 9}}}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   `"count":9`,
+		},
+		{
+			name:           "Invalid group_by",
+			query:          "?group_by=platform",
+			setupMock:      func(m *mocks.MockStatsService) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "Invalid start",
+			query:          "?start=yesterday",
+			setupMock:      func(m *mocks.MockStatsService) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:  "Invalid query",
+			query: "?bucket=minute",
+			setupMock: func(m *mocks.MockStatsService) {
+				m.On("QueryStats", mock.Anything, mock.Anything).Return(nil, fmt.Errorf("%w: bucket must be hour, day or week", domain.ErrInvalidInput))
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   "bucket must be hour, day or week",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockSvc := mocks.NewMockStatsService(t)
+			tt.setupMock(mockSvc)
+
+			req := httptest.NewRequest("GET", "/stats/query"+tt.query, nil)
+			w := httptest.NewRecorder()
+
+			HandleQueryStats(mockSvc).ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			if tt.expectedBody != "" {
+				assert.Contains(t, w.Body.String(), tt.expectedBody)
+			}
 		})
 	}
 }
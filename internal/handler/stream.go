@@ -0,0 +1,156 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/logger"
+	"github.com/osse101/BrandishBot_Go/internal/stream"
+)
+
+// StartStreamResponse is the session a stream start opened or joined
+type StartStreamResponse struct {
+	Session *domain.StreamSession `json:"session"`
+	// Created is false when the stream was already live
+	Created bool `json:"created"`
+}
+
+// StreamHandler handles stream sessions reported by platform adapters
+type StreamHandler struct {
+	service stream.Service
+}
+
+// NewStreamHandler creates a new stream session handler
+func NewStreamHandler(service stream.Service) *StreamHandler {
+	return &StreamHandler{service: service}
+}
+
+// HandleStart opens a stream session
+// @Summary Start a stream session
+// @Description Called by platform adapters when the stream goes live. Stats events, chat drops and gambles are tagged with the session until it ends. If a stream is already live its session is returned with created false.
+// @Tags stream
+// @Accept json
+// @Produce json
+// @Param request body domain.StartStreamRequest true "Stream details"
+// @Success 201 {object} StartStreamResponse
+// @Success 200 {object} StartStreamResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /api/v1/stream/start [post]
+func (h *StreamHandler) HandleStart(w http.ResponseWriter, r *http.Request) {
+	var req domain.StartStreamRequest
+	if err := DecodeAndValidateRequest(r, w, &req, "Start stream"); err != nil {
+		return
+	}
+
+	session, created, err := h.service.Start(r.Context(), req)
+	if err != nil {
+		logger.FromContext(r.Context()).Error("Failed to start stream session", "error", err, "platform", req.Platform)
+		RespondError(w, http.StatusInternalServerError, ErrMsgStartStreamFailed)
+		return
+	}
+
+	status := http.StatusOK
+	if created {
+		status = http.StatusCreated
+	}
+	RespondJSON(w, status, StartStreamResponse{Session: session, Created: created})
+}
+
+// HandleEnd closes the live stream session
+// @Summary End the stream session
+// @Description Called by platform adapters when the stream goes offline. Publishes a stream.ended event, on which the Discord bot posts the stream's summary.
+// @Tags stream
+// @Produce json
+// @Success 200 {object} domain.StreamSession
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /api/v1/stream/end [post]
+func (h *StreamHandler) HandleEnd(w http.ResponseWriter, r *http.Request) {
+	session, err := h.service.End(r.Context())
+	if err != nil {
+		if errors.Is(err, domain.ErrNoLiveStream) {
+			RespondError(w, http.StatusNotFound, ErrMsgNoLiveStreamHTTP)
+			return
+		}
+		logger.FromContext(r.Context()).Error("Failed to end stream session", "error", err)
+		RespondError(w, http.StatusInternalServerError, ErrMsgEndStreamFailed)
+		return
+	}
+
+	RespondJSON(w, http.StatusOK, session)
+}
+
+// HandleGetSummary totals a stream session's activity
+// @Summary Get a stream summary
+// @Description Totals the stats events, chat drops and gambles of a stream session, with its most active users. Live sessions are summarised up to now.
+// @Tags stream
+// @Produce json
+// @Param id path int true "Stream session ID"
+// @Success 200 {object} domain.StreamSummary
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /api/v1/stream/{id}/summary [get]
+func (h *StreamHandler) HandleGetSummary(w http.ResponseWriter, r *http.Request) {
+	id, ok := streamSessionIDParam(w, r)
+	if !ok {
+		return
+	}
+
+	summary, err := h.service.GetSummary(r.Context(), id)
+	if err != nil {
+		respondStreamSessionError(w, r, err, id, ErrMsgGetStreamSummaryFailed)
+		return
+	}
+
+	RespondJSON(w, http.StatusOK, summary)
+}
+
+// HandleGetRecap builds a stream session's recap
+// @Summary Get a stream recap
+// @Description The session's summary plus its notable moments: the biggest gamble win, the top contributor by weighted engagement and the progression nodes unlocked. The same recap is published as a stream.recap event when a stream ends.
+// @Tags stream
+// @Produce json
+// @Param id path int true "Stream session ID"
+// @Success 200 {object} domain.StreamRecap
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /api/v1/stream/{id}/recap [get]
+func (h *StreamHandler) HandleGetRecap(w http.ResponseWriter, r *http.Request) {
+	id, ok := streamSessionIDParam(w, r)
+	if !ok {
+		return
+	}
+
+	recap, err := h.service.GetRecap(r.Context(), id)
+	if err != nil {
+		respondStreamSessionError(w, r, err, id, ErrMsgGetStreamRecapFailed)
+		return
+	}
+
+	RespondJSON(w, http.StatusOK, recap)
+}
+
+func streamSessionIDParam(w http.ResponseWriter, r *http.Request) (int64, bool) {
+	id, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil || id <= 0 {
+		RespondError(w, http.StatusBadRequest, ErrMsgInvalidStreamSessionID)
+		return 0, false
+	}
+	return id, true
+}
+
+func respondStreamSessionError(w http.ResponseWriter, r *http.Request, err error, id int64, msg string) {
+	if errors.Is(err, domain.ErrStreamSessionNotFound) {
+		RespondError(w, http.StatusNotFound, ErrMsgStreamSessionNotFound)
+		return
+	}
+	logger.FromContext(r.Context()).Error(msg, "error", err, "session_id", id)
+	RespondError(w, http.StatusInternalServerError, msg)
+}
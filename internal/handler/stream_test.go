@@ -0,0 +1,171 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/mocks"
+)
+
+func TestStreamHandler_HandleStart(t *testing.T) {
+	post := func(h *StreamHandler, body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/stream/start", bytes.NewBufferString(body))
+		rec := httptest.NewRecorder()
+		h.HandleStart(rec, req)
+		return rec
+	}
+	req := domain.StartStreamRequest{Platform: "twitch", Title: "Friday crafting"}
+	session := &domain.StreamSession{ID: 7, Platform: "twitch", Title: "Friday crafting", StartedAt: time.Now()}
+
+	t.Run("opens a session", func(t *testing.T) {
+		svc := mocks.NewMockStreamService(t)
+		svc.On("Start", mock.Anything, req).Return(session, true, nil)
+
+		rec := post(NewStreamHandler(svc), `{"platform":"twitch","title":"Friday crafting"}`)
+
+		assert.Equal(t, http.StatusCreated, rec.Code)
+		assert.Contains(t, rec.Body.String(), `"created":true`)
+	})
+
+	t.Run("already live", func(t *testing.T) {
+		svc := mocks.NewMockStreamService(t)
+		svc.On("Start", mock.Anything, req).Return(session, false, nil)
+
+		rec := post(NewStreamHandler(svc), `{"platform":"twitch","title":"Friday crafting"}`)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Contains(t, rec.Body.String(), `"created":false`)
+	})
+
+	t.Run("requires a platform", func(t *testing.T) {
+		svc := mocks.NewMockStreamService(t)
+
+		rec := post(NewStreamHandler(svc), `{"title":"Friday crafting"}`)
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+}
+
+func TestStreamHandler_HandleEnd(t *testing.T) {
+	end := func(h *StreamHandler) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/stream/end", nil)
+		rec := httptest.NewRecorder()
+		h.HandleEnd(rec, req)
+		return rec
+	}
+
+	t.Run("closes the live session", func(t *testing.T) {
+		svc := mocks.NewMockStreamService(t)
+		svc.On("End", mock.Anything).Return(&domain.StreamSession{ID: 7}, nil)
+
+		rec := end(NewStreamHandler(svc))
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Contains(t, rec.Body.String(), `"id":7`)
+	})
+
+	t.Run("no live stream", func(t *testing.T) {
+		svc := mocks.NewMockStreamService(t)
+		svc.On("End", mock.Anything).Return(nil, domain.ErrNoLiveStream)
+
+		rec := end(NewStreamHandler(svc))
+
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+	})
+
+	t.Run("service failure", func(t *testing.T) {
+		svc := mocks.NewMockStreamService(t)
+		svc.On("End", mock.Anything).Return(nil, errors.New("db down"))
+
+		rec := end(NewStreamHandler(svc))
+
+		assert.Equal(t, http.StatusInternalServerError, rec.Code)
+	})
+}
+
+func TestStreamHandler_HandleGetSummary(t *testing.T) {
+	get := func(h *StreamHandler, id string) *httptest.ResponseRecorder {
+		rctx := chi.NewRouteContext()
+		rctx.URLParams.Add("id", id)
+		req := httptest.NewRequest(http.MethodGet, "/stream/"+id+"/summary", nil)
+		req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, rctx))
+		rec := httptest.NewRecorder()
+		h.HandleGetSummary(rec, req)
+		return rec
+	}
+
+	t.Run("returns the summary", func(t *testing.T) {
+		svc := mocks.NewMockStreamService(t)
+		svc.On("GetSummary", mock.Anything, int64(7)).Return(&domain.StreamSummary{
+			Session:     domain.StreamSession{ID: 7},
+			TotalEvents: 42,
+		}, nil)
+
+		rec := get(NewStreamHandler(svc), "7")
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Contains(t, rec.Body.String(), `"total_events":42`)
+	})
+
+	t.Run("invalid ID", func(t *testing.T) {
+		svc := mocks.NewMockStreamService(t)
+
+		rec := get(NewStreamHandler(svc), "abc")
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("unknown session", func(t *testing.T) {
+		svc := mocks.NewMockStreamService(t)
+		svc.On("GetSummary", mock.Anything, int64(9)).Return(nil, domain.ErrStreamSessionNotFound)
+
+		rec := get(NewStreamHandler(svc), "9")
+
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+	})
+}
+
+func TestStreamHandler_HandleGetRecap(t *testing.T) {
+	get := func(h *StreamHandler, id string) *httptest.ResponseRecorder {
+		rctx := chi.NewRouteContext()
+		rctx.URLParams.Add("id", id)
+		req := httptest.NewRequest(http.MethodGet, "/stream/"+id+"/recap", nil)
+		req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, rctx))
+		rec := httptest.NewRecorder()
+		h.HandleGetRecap(rec, req)
+		return rec
+	}
+
+	t.Run("returns the recap", func(t *testing.T) {
+		svc := mocks.NewMockStreamService(t)
+		svc.On("GetRecap", mock.Anything, int64(7)).Return(&domain.StreamRecap{
+			Summary:          domain.StreamSummary{Session: domain.StreamSession{ID: 7}},
+			BiggestGambleWin: &domain.StreamGambleWin{Username: "bob", TotalValue: 5000},
+			Unlocks:          []domain.StreamUnlock{},
+		}, nil)
+
+		rec := get(NewStreamHandler(svc), "7")
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Contains(t, rec.Body.String(), `"total_value":5000`)
+	})
+
+	t.Run("unknown session", func(t *testing.T) {
+		svc := mocks.NewMockStreamService(t)
+		svc.On("GetRecap", mock.Anything, int64(9)).Return(nil, domain.ErrStreamSessionNotFound)
+
+		rec := get(NewStreamHandler(svc), "9")
+
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+	})
+}
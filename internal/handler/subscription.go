@@ -41,8 +41,7 @@ func (h *SubscriptionHandler) HandleSubscriptionEvent(w http.ResponseWriter, r *
 
 	if err := h.service.HandleSubscriptionEvent(r.Context(), evt); err != nil {
 		log.Error("Failed to handle subscription event", "error", err, "platform", evt.Platform, "username", evt.Username)
-		statusCode, userMsg := MapServiceErrorToUserMessage(err)
-		RespondError(w, statusCode, userMsg)
+		RespondMappedError(w, err)
 		return
 	}
 
@@ -21,6 +21,16 @@ type TestResponse struct {
 }
 
 // TestHandler handles the /test endpoint
+// @Summary Greet a user
+// @Description Registers the user if needed and answers with a greeting, for checking a bot's connection
+// @Tags user
+// @Accept json
+// @Produce json
+// @Param request body TestRequest true "User to greet"
+// @Success 200 {object} TestResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /api/v1/test [post]
 func HandleTest(userService user.Service) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		log := logger.FromContext(r.Context())
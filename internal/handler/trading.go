@@ -26,6 +26,8 @@ type GiveItemRequest struct {
 // @Param request body GiveItemRequest true "Transfer details including owner and receiver info"
 // @Success 200 {object} SuccessResponse
 // @Failure 400 {object} ErrorResponse "Invalid request or self-gifting attempt"
+// @Failure 403 {object} ErrorResponse "Account too new to give items"
+// @Failure 429 {object} ErrorResponse "Daily give limit reached"
 // @Failure 500 {object} ErrorResponse "Internal server error"
 // @Router /api/v1/user/item/give [post]
 func HandleGiveItem(svc user.Service) http.HandlerFunc {
@@ -47,8 +49,7 @@ func HandleGiveItem(svc user.Service) http.HandlerFunc {
 
 		if err := svc.GiveItem(r.Context(), req.OwnerPlatform, req.OwnerPlatformID, req.Owner, req.ReceiverPlatform, req.Receiver, req.ItemName, req.Quantity); err != nil {
 			log.Error("Failed to give item", "error", err, "owner", req.Owner, "receiver", req.Receiver, "item", req.ItemName)
-			statusCode, userMsg := MapServiceErrorToUserMessage(err)
-			RespondError(w, statusCode, userMsg)
+			RespondMappedError(w, err)
 			return
 		}
 
@@ -0,0 +1,70 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/logger"
+	"github.com/osse101/BrandishBot_Go/internal/undo"
+)
+
+// UndoRequest asks to undo the user's latest sell, disassemble or give
+type UndoRequest struct {
+	Platform   string `json:"platform" validate:"required,platform"`
+	PlatformID string `json:"platform_id" validate:"required"`
+}
+
+// UndoHandler handles undoing recent operations
+type UndoHandler struct {
+	service undo.Service
+}
+
+// NewUndoHandler creates a new undo handler
+func NewUndoHandler(service undo.Service) *UndoHandler {
+	return &UndoHandler{service: service}
+}
+
+// HandleUndo reverses the user's latest sell, disassemble or give
+// @Summary Undo last operation
+// @Description Reverses the user's latest sell, disassemble or give within the undo window (UNDO_WINDOW), provided the items and money it takes back are still held. Taxes are not refunded. Undo is on a cooldown set by COOLDOWN_UNDO.
+// @Tags inventory
+// @Accept json
+// @Produce json
+// @Param request body UndoRequest true "User"
+// @Success 200 {object} domain.UndoEntry
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Failure 429 {object} CooldownErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /api/v1/user/undo [post]
+func (h *UndoHandler) HandleUndo(w http.ResponseWriter, r *http.Request) {
+	var req UndoRequest
+	if err := DecodeAndValidateRequest(r, w, &req, "Undo"); err != nil {
+		return
+	}
+
+	undone, err := h.service.Undo(r.Context(), req.Platform, req.PlatformID)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrUserNotFound):
+			RespondError(w, http.StatusNotFound, ErrMsgUserNotFoundHTTP)
+			return
+		case errors.Is(err, domain.ErrNothingToUndo):
+			RespondError(w, http.StatusNotFound, ErrMsgNothingToUndoHTTP)
+			return
+		case errors.Is(err, domain.ErrUndoNoLongerValid):
+			RespondError(w, http.StatusConflict, err.Error())
+			return
+		case errors.Is(err, domain.ErrOnCooldown):
+			RespondMappedError(w, err)
+			return
+		}
+		logger.FromContext(r.Context()).Error("Failed to undo", "error", err, "platform", req.Platform)
+		RespondError(w, http.StatusInternalServerError, ErrMsgUndoFailed)
+		return
+	}
+
+	RespondJSON(w, http.StatusOK, undone)
+}
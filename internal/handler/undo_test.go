@@ -0,0 +1,55 @@
+package handler
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/mocks"
+)
+
+func TestUndoHandler_HandleUndo(t *testing.T) {
+	post := func(h *UndoHandler) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/user/undo", bytes.NewBufferString(`{"platform":"discord","platform_id":"d-1"}`))
+		rec := httptest.NewRecorder()
+		h.HandleUndo(rec, req)
+		return rec
+	}
+
+	t.Run("returns the undone operation", func(t *testing.T) {
+		svc := mocks.NewMockUndoService(t)
+		svc.On("Undo", mock.Anything, domain.PlatformDiscord, "d-1").
+			Return(&domain.UndoEntry{ID: 3, Action: domain.UndoActionSell}, nil)
+
+		rec := post(NewUndoHandler(svc))
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Contains(t, rec.Body.String(), `"action":"sell"`)
+	})
+
+	t.Run("nothing to undo", func(t *testing.T) {
+		svc := mocks.NewMockUndoService(t)
+		svc.On("Undo", mock.Anything, domain.PlatformDiscord, "d-1").Return(nil, domain.ErrNothingToUndo)
+
+		rec := post(NewUndoHandler(svc))
+
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+	})
+
+	t.Run("no longer valid", func(t *testing.T) {
+		svc := mocks.NewMockUndoService(t)
+		svc.On("Undo", mock.Anything, domain.PlatformDiscord, "d-1").
+			Return(nil, fmt.Errorf("%w: the sell has been changed since", domain.ErrUndoNoLongerValid))
+
+		rec := post(NewUndoHandler(svc))
+
+		assert.Equal(t, http.StatusConflict, rec.Code)
+		assert.Contains(t, rec.Body.String(), domain.ErrMsgUndoNoLongerValid)
+	})
+}
@@ -5,6 +5,7 @@ import (
 	"net/http"
 
 	"github.com/osse101/BrandishBot_Go/internal/crafting"
+	"github.com/osse101/BrandishBot_Go/internal/domain"
 	"github.com/osse101/BrandishBot_Go/internal/event"
 	"github.com/osse101/BrandishBot_Go/internal/logger"
 	"github.com/osse101/BrandishBot_Go/internal/progression"
@@ -27,11 +28,16 @@ func NewCraftingHandler(service crafting.Service, userRepo repository.User) *Cra
 }
 
 type UpgradeItemResponse struct {
-	Message          string `json:"message"`
-	NewItem          string `json:"new_item"`
-	QuantityUpgraded int    `json:"quantity_upgraded"`
-	IsMasterwork     bool   `json:"is_masterwork"`
-	BonusQuantity    int    `json:"bonus_quantity"`
+	Message           string `json:"message"`
+	NewItem           string `json:"new_item"`
+	QuantityUpgraded  int    `json:"quantity_upgraded"`
+	IsMasterwork      bool   `json:"is_masterwork"`
+	BonusQuantity     int    `json:"bonus_quantity"`
+	QuantityRequested int    `json:"quantity_requested"`
+	QuantityProcessed int    `json:"quantity_processed"`
+	Partial           bool   `json:"partial"`
+	// Tiers counts the crafts that rolled each tier above standard
+	Tiers map[domain.CraftTier]int `json:"tiers,omitempty"`
 }
 
 // HandleUpgradeItem handles upgrading an item
@@ -45,7 +51,7 @@ type UpgradeItemResponse struct {
 // @Failure 400 {object} ErrorResponse
 // @Failure 403 {object} ErrorResponse "Feature locked"
 // @Failure 500 {object} ErrorResponse
-// @Router /user/item/upgrade [post]
+// @Router /api/v1/user/item/upgrade [post]
 func HandleUpgradeItem(svc crafting.Service, userSvc user.ManagementService, progressionSvc progression.Service, eventBus event.Bus) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		log := logger.FromContext(r.Context())
@@ -63,8 +69,7 @@ func HandleUpgradeItem(svc crafting.Service, userSvc user.ManagementService, pro
 		result, err := svc.UpgradeItem(r.Context(), req.Platform, req.PlatformID, req.Username, req.Item, req.Quantity)
 		if err != nil {
 			log.Error("Failed to upgrade item", "error", err, "username", req.Username, "item", req.Item)
-			statusCode, userMsg := MapServiceErrorToUserMessage(err)
-			RespondError(w, statusCode, userMsg)
+			RespondMappedError(w, err)
 			return
 		}
 
@@ -81,34 +86,81 @@ func HandleUpgradeItem(svc crafting.Service, userSvc user.ManagementService, pro
 
 		// Construct user message
 		message := fmt.Sprintf("Successfully upgraded to %dx %s", result.Quantity, result.ItemName)
-		if result.IsMasterwork {
+		switch {
+		case result.Tiers[domain.CraftTierLegendary] > 0:
+			message = fmt.Sprintf("LEGENDARY CRAFT! A flawless creation! You received %dx %s (Bonus: +%d)", result.Quantity, result.ItemName, result.BonusQuantity)
+		case result.IsMasterwork:
 			message = fmt.Sprintf("MASTERWORK! Critical success! You received %dx %s (Bonus: +%d)", result.Quantity, result.ItemName, result.BonusQuantity)
 		}
+		if result.IsPartial() {
+			message += fmt.Sprintf(MsgPartialCraftFmt, result.QuantityProcessed, result.QuantityRequested)
+		}
 
 		RespondJSON(w, http.StatusOK, UpgradeItemResponse{
-			Message:          message,
-			NewItem:          result.ItemName,
-			QuantityUpgraded: result.Quantity,
-			IsMasterwork:     result.IsMasterwork,
-			BonusQuantity:    result.BonusQuantity,
+			Message:           message,
+			NewItem:           result.ItemName,
+			QuantityUpgraded:  result.Quantity,
+			IsMasterwork:      result.IsMasterwork,
+			BonusQuantity:     result.BonusQuantity,
+			QuantityRequested: result.QuantityRequested,
+			QuantityProcessed: result.QuantityProcessed,
+			Partial:           result.IsPartial(),
+			Tiers:             result.Tiers,
 		})
 	}
 }
 
+// HandlePreviewUpgrade reports what an upgrade would cost and produce
+// @Summary Preview upgrade
+// @Description Show exact material costs, masterwork chance, and expected outputs for upgrading a quantity of an item, without crafting anything
+// @Tags crafting
+// @Accept json
+// @Produce json
+// @Param request body CraftingActionRequest true "Upgrade details"
+// @Success 200 {object} crafting.UpgradePreview
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse "Feature locked"
+// @Failure 500 {object} ErrorResponse
+// @Router /api/v1/user/item/upgrade/preview [post]
+func HandlePreviewUpgrade(svc crafting.Service, progressionSvc progression.Service) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		log := logger.FromContext(r.Context())
+
+		if CheckFeatureLocked(w, r, progressionSvc, progression.FeatureUpgrade) {
+			return
+		}
+
+		req, err := decodeCraftingRequest(r, w, "Preview upgrade")
+		if err != nil {
+			return
+		}
+
+		preview, err := svc.PreviewUpgrade(r.Context(), req.Platform, req.PlatformID, req.Username, req.Item, req.Quantity)
+		if err != nil {
+			log.Error("Failed to preview upgrade", "error", err, "username", req.Username, "item", req.Item)
+			RespondMappedError(w, err)
+			return
+		}
+
+		RespondJSON(w, http.StatusOK, preview)
+	}
+}
+
 // HandleGetRecipes returns recipe information based on query parameters
 // @Summary Get recipes
 // @Description Get recipe information. Can filter by item or get all unlocked recipes for a user.
+// @Description Without item the response is a recipe list (unlocked recipes carry only item_id and item_name); with item it is that item's recipe.
 // @Tags crafting
 // @Produce json
 // @Param item query string false "Item name to get recipe for"
 // @Param user query string false "Username to get unlocked recipes for"
 // @Param platform query string false "Platform (required if user provided)"
 // @Param platform_id query string false "Platform ID (self-mode, optional for target-mode)"
-// @Success 200 {object} map[string]interface{} "Recipes or single recipe"
+// @Success 200 {object} AllRecipesResponse "Recipes, or the single recipe when item is set"
 // @Failure 400 {object} ErrorResponse
 // @Failure 404 {object} ErrorResponse
 // @Failure 500 {object} ErrorResponse
-// @Router /recipes [get]
+// @Router /api/v1/recipes [get]
 func (h *CraftingHandler) HandleGetRecipes() http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		log := logger.FromContext(r.Context())
@@ -147,8 +199,7 @@ func (h *CraftingHandler) HandleGetRecipes() http.HandlerFunc {
 			recipes, err := h.service.GetUnlockedRecipes(r.Context(), platform, platformID, username)
 			if err != nil {
 				log.Error("Failed to get unlocked recipes", "error", err, "username", username)
-				statusCode, userMsg := MapServiceErrorToUserMessage(err)
-				RespondError(w, statusCode, userMsg)
+				RespondMappedError(w, err)
 				return
 			}
 
@@ -168,8 +219,7 @@ func (h *CraftingHandler) HandleGetRecipes() http.HandlerFunc {
 			recipe, err := h.service.GetRecipe(r.Context(), itemName, platform, platformID, username)
 			if err != nil {
 				log.Error("Failed to get recipe", "error", err, "item", itemName)
-				statusCode, userMsg := MapServiceErrorToUserMessage(err)
-				RespondError(w, statusCode, userMsg)
+				RespondMappedError(w, err)
 				return
 			}
 
@@ -182,8 +232,7 @@ func (h *CraftingHandler) HandleGetRecipes() http.HandlerFunc {
 		recipes, err := h.service.GetAllRecipes(r.Context())
 		if err != nil {
 			log.Error("Failed to get all recipes", "error", err)
-			statusCode, userMsg := MapServiceErrorToUserMessage(err)
-			RespondError(w, statusCode, userMsg)
+			RespondMappedError(w, err)
 			return
 		}
 
@@ -202,3 +251,65 @@ type UnlockedRecipesResponse struct {
 type AllRecipesResponse struct {
 	Recipes []repository.RecipeListItem `json:"recipes"`
 }
+
+// RecipeMasteryResponse defines response for a user's recipe mastery
+type RecipeMasteryResponse struct {
+	Recipes []crafting.RecipeMastery `json:"recipes"`
+}
+
+// HandleGetRecipeMastery returns a user's progress towards mastering recipes
+// @Summary Get recipe mastery
+// @Description Get how many times a user has crafted each recipe and which recipes they have mastered. Mastered recipes craft masterworks more often.
+// @Tags crafting
+// @Produce json
+// @Param platform query string true "Platform (twitch, youtube, discord)"
+// @Param platform_id query string false "Platform-specific user ID (self-mode)"
+// @Param username query string false "Username (target-mode)"
+// @Success 200 {object} RecipeMasteryResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /api/v1/user/crafting/mastery [get]
+func (h *CraftingHandler) HandleGetRecipeMastery() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		log := logger.FromContext(r.Context())
+
+		platform, ok := GetQueryParam(r, w, "platform")
+		if !ok {
+			return
+		}
+
+		platformID := r.URL.Query().Get("platform_id")
+		username := r.URL.Query().Get("username")
+		if platformID == "" && username == "" {
+			RespondError(w, http.StatusBadRequest, "Either platform_id or username is required")
+			return
+		}
+
+		// Target-mode: resolve user by username
+		if platformID == "" {
+			user, err := h.userRepo.GetUserByPlatformUsername(r.Context(), platform, username)
+			if err != nil {
+				log.Error("Failed to find user by username", "error", err, "platform", platform, "username", username)
+				RespondError(w, http.StatusNotFound, "User not found")
+				return
+			}
+			platformID = getPlatformID(user, platform)
+			if platformID == "" {
+				RespondError(w, http.StatusNotFound, "User not found on platform")
+				return
+			}
+		}
+
+		mastery, err := h.service.GetRecipeMastery(r.Context(), platform, platformID, username)
+		if err != nil {
+			log.Error("Failed to get recipe mastery", "error", err, "platform", platform, "platform_id", platformID)
+			RespondMappedError(w, err)
+			return
+		}
+
+		RespondJSON(w, http.StatusOK, RecipeMasteryResponse{
+			Recipes: mastery,
+		})
+	}
+}
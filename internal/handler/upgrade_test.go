@@ -40,10 +40,12 @@ func TestHandleUpgradeItem(t *testing.T) {
 				p.On("IsFeatureUnlocked", mock.Anything, progression.FeatureUpgrade).Return(true, nil)
 				c.On("UpgradeItem", mock.Anything, domain.PlatformTwitch, "test-id", "testuser", domain.PublicNameJunkbox, 2).
 					Return(&crafting.Result{
-						ItemName:      domain.PublicNameLootbox, // Result is Lootbox1
-						Quantity:      2,
-						IsMasterwork:  false,
-						BonusQuantity: 0,
+						ItemName:          domain.PublicNameLootbox, // Result is Lootbox1
+						Quantity:          2,
+						IsMasterwork:      false,
+						BonusQuantity:     0,
+						QuantityRequested: 2,
+						QuantityProcessed: 2,
 					}, nil)
 				u.On("GetUserIDByPlatformID", mock.Anything, domain.PlatformTwitch, "test-id").Return("", nil).Maybe()
 
@@ -54,7 +56,7 @@ func TestHandleUpgradeItem(t *testing.T) {
 				p.On("RecordEngagement", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
 			},
 			expectedStatus: http.StatusOK,
-			expectedBody:   `{"message":"Successfully upgraded to 2x lootbox","new_item":"lootbox","quantity_upgraded":2,"is_masterwork":false,"bonus_quantity":0}`,
+			expectedBody:   `{"message":"Successfully upgraded to 2x lootbox","new_item":"lootbox","quantity_upgraded":2,"is_masterwork":false,"bonus_quantity":0,"quantity_requested":2,"quantity_processed":2,"partial":false}`,
 		},
 		{
 			name: "Feature Locked",
@@ -72,7 +74,7 @@ func TestHandleUpgradeItem(t *testing.T) {
 				p.On("GetRequiredNodes", mock.Anything, progression.FeatureUpgrade).Return([]*domain.ProgressionNode{}, nil)
 			},
 			expectedStatus: http.StatusForbidden,
-			expectedBody:   `{"error":"` + domain.ErrMsgFeatureLocked + `"}`,
+			expectedBody:   `{"code":"` + string(ErrCodeFeatureLocked) + `","message":"` + domain.ErrMsgFeatureLocked + `","error":"` + domain.ErrMsgFeatureLocked + `","details":{"feature":"` + progression.FeatureUpgrade + `"}}`,
 		},
 		{
 			name: "Service Error",
@@ -115,10 +117,12 @@ func TestHandleUpgradeItem(t *testing.T) {
 				p.On("IsFeatureUnlocked", mock.Anything, progression.FeatureUpgrade).Return(true, nil)
 				c.On("UpgradeItem", mock.Anything, domain.PlatformTwitch, "test-id", "testuser", domain.PublicNameJunkbox, 10).
 					Return(&crafting.Result{
-						ItemName:      domain.PublicNameLootbox,
-						Quantity:      20, // Doubled
-						IsMasterwork:  true,
-						BonusQuantity: 10,
+						ItemName:          domain.PublicNameLootbox,
+						Quantity:          20, // Doubled
+						IsMasterwork:      true,
+						BonusQuantity:     10,
+						QuantityRequested: 10,
+						QuantityProcessed: 10,
 					}, nil)
 				u.On("GetUserIDByPlatformID", mock.Anything, domain.PlatformTwitch, "test-id").Return("", nil).Maybe()
 
@@ -126,7 +130,7 @@ func TestHandleUpgradeItem(t *testing.T) {
 				p.On("RecordEngagement", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
 			},
 			expectedStatus: http.StatusOK,
-			expectedBody:   `{"message":"MASTERWORK! Critical success! You received 20x lootbox (Bonus: +10)","new_item":"lootbox","quantity_upgraded":20,"is_masterwork":true,"bonus_quantity":10}`,
+			expectedBody:   `{"message":"MASTERWORK! Critical success! You received 20x lootbox (Bonus: +10)","new_item":"lootbox","quantity_upgraded":20,"is_masterwork":true,"bonus_quantity":10,"quantity_requested":10,"quantity_processed":10,"partial":false}`,
 		},
 		{
 			name: "Boundary Quantity Zero",
@@ -156,10 +160,12 @@ func TestHandleUpgradeItem(t *testing.T) {
 				p.On("IsFeatureUnlocked", mock.Anything, progression.FeatureUpgrade).Return(true, nil)
 				c.On("UpgradeItem", mock.Anything, domain.PlatformTwitch, "test-id", "testuser", domain.PublicNameJunkbox, 10000).
 					Return(&crafting.Result{
-						ItemName:      domain.PublicNameLootbox,
-						Quantity:      10000,
-						IsMasterwork:  false,
-						BonusQuantity: 0,
+						ItemName:          domain.PublicNameLootbox,
+						Quantity:          10000,
+						IsMasterwork:      false,
+						BonusQuantity:     0,
+						QuantityRequested: 10000,
+						QuantityProcessed: 10000,
 					}, nil)
 				u.On("GetUserIDByPlatformID", mock.Anything, domain.PlatformTwitch, "test-id").Return("", nil).Maybe()
 
@@ -167,7 +173,62 @@ func TestHandleUpgradeItem(t *testing.T) {
 				p.On("RecordEngagement", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
 			},
 			expectedStatus: http.StatusOK,
-			expectedBody:   `{"message":"Successfully upgraded to 10000x lootbox","new_item":"lootbox","quantity_upgraded":10000,"is_masterwork":false,"bonus_quantity":0}`,
+			expectedBody:   `{"message":"Successfully upgraded to 10000x lootbox","new_item":"lootbox","quantity_upgraded":10000,"is_masterwork":false,"bonus_quantity":0,"quantity_requested":10000,"quantity_processed":10000,"partial":false}`,
+		},
+		{
+			name: "Success Legendary",
+			requestBody: CraftingActionRequest{
+				Platform:   domain.PlatformTwitch,
+				PlatformID: "test-id",
+				Username:   "testuser",
+				Item:       domain.PublicNameJunkbox,
+				Quantity:   2,
+			},
+			mockSetup: func(c *mocks.MockCraftingService, p *mocks.MockProgressionService, b *mocks.MockEventBus, u *mocks.MockUserService) {
+				p.On("IsFeatureUnlocked", mock.Anything, progression.FeatureUpgrade).Return(true, nil)
+				c.On("UpgradeItem", mock.Anything, domain.PlatformTwitch, "test-id", "testuser", domain.PublicNameJunkbox, 2).
+					Return(&crafting.Result{
+						ItemName:          domain.PublicNameLootbox,
+						Quantity:          4,
+						IsMasterwork:      true,
+						BonusQuantity:     2,
+						QuantityRequested: 2,
+						QuantityProcessed: 2,
+						Tiers:             map[domain.CraftTier]int{domain.CraftTierLegendary: 1},
+					}, nil)
+				u.On("GetUserIDByPlatformID", mock.Anything, domain.PlatformTwitch, "test-id").Return("", nil).Maybe()
+
+				b.On("Publish", mock.Anything, mock.Anything).Return(nil).Maybe()
+				p.On("RecordEngagement", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   `{"message":"LEGENDARY CRAFT! A flawless creation! You received 4x lootbox (Bonus: +2)","new_item":"lootbox","quantity_upgraded":4,"is_masterwork":true,"bonus_quantity":2,"quantity_requested":2,"quantity_processed":2,"partial":false,"tiers":{"legendary":1}}`,
+		},
+		{
+			name: "Partial Completion",
+			requestBody: CraftingActionRequest{
+				Platform:   domain.PlatformTwitch,
+				PlatformID: "test-id",
+				Username:   "testuser",
+				Item:       domain.PublicNameJunkbox,
+				Quantity:   50,
+			},
+			mockSetup: func(c *mocks.MockCraftingService, p *mocks.MockProgressionService, b *mocks.MockEventBus, u *mocks.MockUserService) {
+				p.On("IsFeatureUnlocked", mock.Anything, progression.FeatureUpgrade).Return(true, nil)
+				c.On("UpgradeItem", mock.Anything, domain.PlatformTwitch, "test-id", "testuser", domain.PublicNameJunkbox, 50).
+					Return(&crafting.Result{
+						ItemName:          domain.PublicNameLootbox,
+						Quantity:          12,
+						QuantityRequested: 50,
+						QuantityProcessed: 12,
+					}, nil)
+				u.On("GetUserIDByPlatformID", mock.Anything, domain.PlatformTwitch, "test-id").Return("", nil).Maybe()
+
+				b.On("Publish", mock.Anything, mock.Anything).Return(nil).Maybe()
+				p.On("RecordEngagement", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   `{"message":"Successfully upgraded to 12x lootbox (processed 12 of 50 requested)","new_item":"lootbox","quantity_upgraded":12,"is_masterwork":false,"bonus_quantity":0,"quantity_requested":50,"quantity_processed":12,"partial":true}`,
 		},
 		{
 			name: "Boundary Quantity Over Max",
@@ -248,6 +309,91 @@ func TestHandleUpgradeItem(t *testing.T) {
 	}
 }
 
+func TestHandlePreviewUpgrade(t *testing.T) {
+	validRequest := CraftingActionRequest{
+		Platform:   domain.PlatformTwitch,
+		PlatformID: "test-id",
+		Username:   "testuser",
+		Item:       domain.PublicNameJunkbox,
+		Quantity:   5,
+	}
+
+	tests := []struct {
+		name           string
+		requestBody    CraftingActionRequest
+		mockSetup      func(*mocks.MockCraftingService, *mocks.MockProgressionService)
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name:        "Success",
+			requestBody: validRequest,
+			mockSetup: func(c *mocks.MockCraftingService, p *mocks.MockProgressionService) {
+				p.On("IsFeatureUnlocked", mock.Anything, progression.FeatureUpgrade).Return(true, nil)
+				c.On("PreviewUpgrade", mock.Anything, domain.PlatformTwitch, "test-id", "testuser", domain.PublicNameJunkbox, 5).
+					Return(&crafting.UpgradePreview{
+						ItemName:          domain.PublicNameLootbox,
+						QuantityRequested: 5,
+						QuantityCraftable: 3,
+						Materials: []crafting.MaterialCost{
+							{ItemName: domain.PublicNameJunkbox, Required: 5, Owned: 3, Shortfall: 2},
+						},
+						MasterworkChance: 0.1,
+						FineChance:       0.2,
+						LegendaryChance:  0.01,
+						ExpectedOutput:   3.36,
+						MinOutput:        3,
+						MaxOutput:        9,
+					}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   `{"item_name":"lootbox","quantity_requested":5,"quantity_craftable":3,"materials":[{"item_name":"junkbox","required":5,"owned":3,"shortfall":2}],"masterwork_chance":0.1,"fine_chance":0.2,"legendary_chance":0.01,"expected_output":3.36,"min_output":3,"max_output":9}`,
+		},
+		{
+			name:        "Feature Locked",
+			requestBody: validRequest,
+			mockSetup: func(c *mocks.MockCraftingService, p *mocks.MockProgressionService) {
+				p.On("IsFeatureUnlocked", mock.Anything, progression.FeatureUpgrade).Return(false, nil)
+				p.On("GetRequiredNodes", mock.Anything, progression.FeatureUpgrade).Return([]*domain.ProgressionNode{}, nil)
+			},
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:        "Recipe Locked",
+			requestBody: validRequest,
+			mockSetup: func(c *mocks.MockCraftingService, p *mocks.MockProgressionService) {
+				p.On("IsFeatureUnlocked", mock.Anything, progression.FeatureUpgrade).Return(true, nil)
+				c.On("PreviewUpgrade", mock.Anything, domain.PlatformTwitch, "test-id", "testuser", domain.PublicNameJunkbox, 5).
+					Return(nil, domain.ErrRecipeLocked)
+			},
+			expectedStatus: http.StatusForbidden,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			mockCrafting := new(mocks.MockCraftingService)
+			mockProgression := new(mocks.MockProgressionService)
+			tc.mockSetup(mockCrafting, mockProgression)
+			handler := HandlePreviewUpgrade(mockCrafting, mockProgression)
+
+			body, _ := json.Marshal(tc.requestBody)
+			req, _ := http.NewRequest("POST", "/user/item/upgrade/preview", bytes.NewBuffer(body))
+			rr := httptest.NewRecorder()
+
+			handler.ServeHTTP(rr, req)
+
+			assert.Equal(t, tc.expectedStatus, rr.Code)
+			if tc.expectedBody != "" {
+				assert.JSONEq(t, tc.expectedBody, rr.Body.String())
+			}
+
+			mockCrafting.AssertExpectations(t)
+			mockProgression.AssertExpectations(t)
+		})
+	}
+}
+
 func TestHandleGetRecipes(t *testing.T) {
 	tests := []struct {
 		name           string
@@ -393,3 +539,99 @@ func TestHandleGetRecipes(t *testing.T) {
 		})
 	}
 }
+
+func TestHandleGetRecipeMastery(t *testing.T) {
+	mastered := []crafting.RecipeMastery{{
+		RecipeKey:       "lootbox_tier0",
+		ItemName:        "lootbox1",
+		Crafts:          120,
+		Threshold:       100,
+		Mastered:        true,
+		MasterworkBonus: 0.02,
+	}}
+
+	tests := []struct {
+		name           string
+		queryParams    map[string]string
+		mockSetup      func(*mocks.MockCraftingService, *mocks.MockRepositoryUser)
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name: "Self Mode",
+			queryParams: map[string]string{
+				"platform":    domain.PlatformTwitch,
+				"platform_id": "twitch-alice",
+			},
+			mockSetup: func(c *mocks.MockCraftingService, u *mocks.MockRepositoryUser) {
+				c.On("GetRecipeMastery", mock.Anything, domain.PlatformTwitch, "twitch-alice", "").Return(mastered, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   `{"recipes":[{"recipe_key":"lootbox_tier0","item_name":"lootbox1","crafts":120,"threshold":100,"mastered":true,"masterwork_bonus":0.02}]}`,
+		},
+		{
+			name: "Target Mode",
+			queryParams: map[string]string{
+				"platform": domain.PlatformTwitch,
+				"username": "otheruser",
+			},
+			mockSetup: func(c *mocks.MockCraftingService, u *mocks.MockRepositoryUser) {
+				u.On("GetUserByPlatformUsername", mock.Anything, domain.PlatformTwitch, "otheruser").Return(&domain.User{ID: "other-uuid", TwitchID: "other-id"}, nil)
+				c.On("GetRecipeMastery", mock.Anything, domain.PlatformTwitch, "other-id", "otheruser").Return([]crafting.RecipeMastery{}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   `{"recipes":[]}`,
+		},
+		{
+			name:           "Missing User",
+			queryParams:    map[string]string{"platform": domain.PlatformTwitch},
+			mockSetup:      func(c *mocks.MockCraftingService, u *mocks.MockRepositoryUser) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   "Either platform_id or username is required",
+		},
+		{
+			name: "Service Error",
+			queryParams: map[string]string{
+				"platform":    domain.PlatformTwitch,
+				"platform_id": "twitch-alice",
+			},
+			mockSetup: func(c *mocks.MockCraftingService, u *mocks.MockRepositoryUser) {
+				c.On("GetRecipeMastery", mock.Anything, domain.PlatformTwitch, "twitch-alice", "").Return(nil, errors.New("db error"))
+			},
+			expectedStatus: http.StatusInternalServerError,
+			expectedBody:   "db error",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			mockCrafting := new(mocks.MockCraftingService)
+			mockUserRepo := new(mocks.MockRepositoryUser)
+
+			tc.mockSetup(mockCrafting, mockUserRepo)
+
+			handler := NewCraftingHandler(mockCrafting, mockUserRepo)
+
+			req, _ := http.NewRequest("GET", "/user/crafting/mastery", nil)
+			q := req.URL.Query()
+			for k, v := range tc.queryParams {
+				q.Add(k, v)
+			}
+			req.URL.RawQuery = q.Encode()
+
+			rr := httptest.NewRecorder()
+
+			handler.HandleGetRecipeMastery().ServeHTTP(rr, req)
+
+			assert.Equal(t, tc.expectedStatus, rr.Code)
+			if tc.expectedStatus == http.StatusOK {
+				assert.JSONEq(t, tc.expectedBody, rr.Body.String())
+			} else {
+				assert.Contains(t, rr.Body.String(), tc.expectedBody)
+			}
+
+			mockCrafting.AssertExpectations(t)
+			mockUserRepo.AssertExpectations(t)
+		})
+	}
+}
@@ -73,8 +73,7 @@ func HandleRegisterUser(userService user.Service) http.HandlerFunc {
 		updatedUser, err := userService.RegisterUser(r.Context(), *user)
 		if err != nil {
 			log.Error("Failed to register user", "error", err, "username", req.Username)
-			statusCode, userMsg := MapServiceErrorToUserMessage(err)
-			RespondError(w, statusCode, userMsg)
+			RespondMappedError(w, err)
 			return
 		}
 
@@ -130,7 +129,7 @@ func getPlatformID(user *domain.User, platform string) string {
 // @Produce json
 // @Param platform query string false "Platform (default: twitch)" Enums(twitch, youtube, discord)
 // @Param username query string true "Username to check"
-// @Success 200 {object} map[string]interface{}
+// @Success 200 {object} GetUserTimeoutResponse
 // @Failure 400 {object} ErrorResponse
 // @Failure 500 {object} ErrorResponse
 // @Router /api/v1/user/timeout [get]
@@ -152,8 +151,7 @@ func HandleGetTimeout(svc user.Service) http.HandlerFunc {
 		duration, err := svc.GetTimeoutPlatform(r.Context(), platform, username)
 		if err != nil {
 			log.Error("Failed to get timeout", "error", err, "platform", platform, "username", username)
-			statusCode, userMsg := MapServiceErrorToUserMessage(err)
-			RespondError(w, statusCode, userMsg)
+			RespondMappedError(w, err)
 			return
 		}
 
@@ -182,6 +180,15 @@ type SetTimeoutRequest struct {
 	Reason          string `json:"reason" validate:"max=255"`
 }
 
+// SetTimeoutResponse reports the timeout a user is left with
+type SetTimeoutResponse struct {
+	Message               string  `json:"message"`
+	Platform              string  `json:"platform"`
+	Username              string  `json:"username"`
+	AddedDurationSeconds  int     `json:"added_duration_seconds"`
+	TotalRemainingSeconds float64 `json:"total_remaining_seconds"`
+}
+
 // HandleSetTimeout applies or extends a timeout for a user
 // @Summary Set user timeout
 // @Description Apply or extend a timeout for a user (accumulates with existing timeout)
@@ -189,10 +196,10 @@ type SetTimeoutRequest struct {
 // @Accept json
 // @Produce json
 // @Param request body SetTimeoutRequest true "Timeout details"
-// @Success 200 {object} map[string]interface{}
+// @Success 200 {object} SetTimeoutResponse
 // @Failure 400 {object} ErrorResponse
 // @Failure 500 {object} ErrorResponse
-// @Router /user/timeout [put]
+// @Router /api/v1/user/timeout [put]
 func HandleSetTimeout(svc user.Service) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		log := logger.FromContext(r.Context())
@@ -212,8 +219,7 @@ func HandleSetTimeout(svc user.Service) http.HandlerFunc {
 
 		if err := svc.AddTimeout(r.Context(), req.Platform, req.Username, duration, req.Reason); err != nil {
 			log.Error("Failed to set timeout", "error", err, "platform", req.Platform, "username", req.Username)
-			statusCode, userMsg := MapServiceErrorToUserMessage(err)
-			RespondError(w, statusCode, userMsg)
+			RespondMappedError(w, err)
 			return
 		}
 
@@ -226,14 +232,12 @@ func HandleSetTimeout(svc user.Service) http.HandlerFunc {
 			"added_duration", req.DurationSeconds,
 			"total_remaining", remaining.Seconds())
 
-		response := map[string]interface{}{
-			"message":                 "Timeout applied successfully",
-			"platform":                req.Platform,
-			"username":                req.Username,
-			"added_duration_seconds":  req.DurationSeconds,
-			"total_remaining_seconds": remaining.Seconds(),
-		}
-
-		RespondJSON(w, http.StatusOK, response)
+		RespondJSON(w, http.StatusOK, SetTimeoutResponse{
+			Message:               "Timeout applied successfully",
+			Platform:              req.Platform,
+			Username:              req.Username,
+			AddedDurationSeconds:  req.DurationSeconds,
+			TotalRemainingSeconds: remaining.Seconds(),
+		})
 	}
 }
@@ -0,0 +1,209 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/logger"
+	"github.com/osse101/BrandishBot_Go/internal/usersettings"
+)
+
+// UpdateUserSettingsRequest changes a user's settings
+type UpdateUserSettingsRequest struct {
+	Platform           string `json:"platform" validate:"required,platform"`
+	PlatformID         string `json:"platform_id" validate:"required"`
+	Username           string `json:"username" validate:"required,max=100,excludesall=\x00\n\r\t"`
+	LeaderboardPrivate *bool  `json:"leaderboard_private" validate:"required"`
+}
+
+// UpdateUserPreferencesRequest changes a user's gameplay preferences
+type UpdateUserPreferencesRequest struct {
+	Platform        string `json:"platform" validate:"required,platform"`
+	PlatformID      string `json:"platform_id" validate:"required"`
+	Username        string `json:"username" validate:"required,max=100,excludesall=\x00\n\r\t"`
+	TargetingOptOut *bool  `json:"targeting_opt_out" validate:"required"`
+}
+
+// UpdateUserThemeRequest picks a user's item name theme
+type UpdateUserThemeRequest struct {
+	Platform   string `json:"platform" validate:"required,platform"`
+	PlatformID string `json:"platform_id" validate:"required"`
+	Username   string `json:"username" validate:"required,max=100,excludesall=\x00\n\r\t"`
+	Theme      string `json:"theme" validate:"max=50"` // Empty follows the calendar
+}
+
+// UserSettingsHandler handles per-user settings such as leaderboard privacy
+type UserSettingsHandler struct {
+	service usersettings.Service
+}
+
+// NewUserSettingsHandler creates a new user settings handler
+func NewUserSettingsHandler(service usersettings.Service) *UserSettingsHandler {
+	return &UserSettingsHandler{service: service}
+}
+
+// HandleGetSettings returns a user's settings
+// @Summary Get user settings
+// @Tags user
+// @Produce json
+// @Param platform query string true "Platform"
+// @Param platform_id query string true "Platform user ID"
+// @Success 200 {object} domain.UserSettings
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /api/v1/user/settings [get]
+func (h *UserSettingsHandler) HandleGetSettings(w http.ResponseWriter, r *http.Request) {
+	h.respondSettings(w, r)
+}
+
+// HandleGetPreferences returns a user's preferences, which share storage with their settings
+// @Summary Get user preferences
+// @Tags user
+// @Produce json
+// @Param platform query string true "Platform"
+// @Param platform_id query string true "Platform user ID"
+// @Success 200 {object} domain.UserSettings
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /api/v1/user/preferences [get]
+func (h *UserSettingsHandler) HandleGetPreferences(w http.ResponseWriter, r *http.Request) {
+	h.respondSettings(w, r)
+}
+
+func (h *UserSettingsHandler) respondSettings(w http.ResponseWriter, r *http.Request) {
+	platform, ok := GetQueryParam(r, w, "platform")
+	if !ok {
+		return
+	}
+	platformID, ok := GetQueryParam(r, w, "platform_id")
+	if !ok {
+		return
+	}
+
+	settings, err := h.service.GetSettings(r.Context(), platform, platformID)
+	if err != nil {
+		if errors.Is(err, domain.ErrUserNotFound) {
+			RespondError(w, http.StatusNotFound, ErrMsgUserNotFoundHTTP)
+			return
+		}
+		logger.FromContext(r.Context()).Error("Failed to get user settings", "error", err, "platform", platform)
+		RespondError(w, http.StatusInternalServerError, ErrMsgGetUserSettingsFailed)
+		return
+	}
+
+	RespondJSON(w, http.StatusOK, settings)
+}
+
+// HandleUpdateSettings changes a user's settings
+// @Summary Update user settings
+// @Description Sets leaderboard privacy. Private users still accrue stats and contribution but appear as "Anonymous" on leaderboards and contribution rankings.
+// @Tags user
+// @Accept json
+// @Produce json
+// @Param request body UpdateUserSettingsRequest true "Settings"
+// @Success 200 {object} domain.UserSettings
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /api/v1/user/settings [put]
+func (h *UserSettingsHandler) HandleUpdateSettings(w http.ResponseWriter, r *http.Request) {
+	var req UpdateUserSettingsRequest
+	if err := DecodeAndValidateRequest(r, w, &req, "Update user settings"); err != nil {
+		return
+	}
+
+	settings, err := h.service.SetLeaderboardPrivate(r.Context(), req.Platform, req.PlatformID, req.Username, *req.LeaderboardPrivate)
+	if err != nil {
+		logger.FromContext(r.Context()).Error("Failed to update user settings", "error", err, "platform", req.Platform)
+		RespondError(w, http.StatusInternalServerError, ErrMsgSetUserSettingsFailed)
+		return
+	}
+
+	RespondJSON(w, http.StatusOK, settings)
+}
+
+// HandleUpdatePreferences changes a user's gameplay preferences
+// @Summary Update user preferences
+// @Description Opts the user out of targeted items such as weapons and traps, or back in. Opted-out users cannot be targeted, are skipped by random-target items, and cannot target others.
+// @Tags user
+// @Accept json
+// @Produce json
+// @Param request body UpdateUserPreferencesRequest true "Preferences"
+// @Success 200 {object} domain.UserSettings
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /api/v1/user/preferences [put]
+func (h *UserSettingsHandler) HandleUpdatePreferences(w http.ResponseWriter, r *http.Request) {
+	var req UpdateUserPreferencesRequest
+	if err := DecodeAndValidateRequest(r, w, &req, "Update user preferences"); err != nil {
+		return
+	}
+
+	settings, err := h.service.SetTargetingOptOut(r.Context(), req.Platform, req.PlatformID, req.Username, *req.TargetingOptOut)
+	if err != nil {
+		logger.FromContext(r.Context()).Error("Failed to update user preferences", "error", err, "platform", req.Platform)
+		RespondError(w, http.StatusInternalServerError, ErrMsgSetUserPreferencesFailed)
+		return
+	}
+
+	RespondJSON(w, http.StatusOK, settings)
+}
+
+// HandleGetTheme lists the item name themes and the user's pick
+// @Summary Get item name themes
+// @Description Lists every item name theme with whether progression has unlocked it, the theme the calendar shows today, and the user's pick (empty follows the calendar).
+// @Tags user
+// @Produce json
+// @Param platform query string true "Platform"
+// @Param platform_id query string true "Platform user ID"
+// @Success 200 {object} domain.NameThemes
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /api/v1/user/theme [get]
+func (h *UserSettingsHandler) HandleGetTheme(w http.ResponseWriter, r *http.Request) {
+	platform, ok := GetQueryParam(r, w, "platform")
+	if !ok {
+		return
+	}
+	platformID, ok := GetQueryParam(r, w, "platform_id")
+	if !ok {
+		return
+	}
+
+	themes, err := h.service.GetNameThemes(r.Context(), platform, platformID)
+	if err != nil {
+		RespondServiceError(w, r, "Failed to get name themes", err)
+		return
+	}
+
+	RespondJSON(w, http.StatusOK, themes)
+}
+
+// HandleUpdateTheme picks the item name theme the user sees
+// @Summary Pick item name theme
+// @Description Item names in the user's messages use the picked theme all year. Themes tied to a progression feature can only be picked once it is unlocked. An empty theme follows the calendar again.
+// @Tags user
+// @Accept json
+// @Produce json
+// @Param request body UpdateUserThemeRequest true "Theme"
+// @Success 200 {object} domain.UserSettings
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /api/v1/user/theme [put]
+func (h *UserSettingsHandler) HandleUpdateTheme(w http.ResponseWriter, r *http.Request) {
+	var req UpdateUserThemeRequest
+	if err := DecodeAndValidateRequest(r, w, &req, "Update user theme"); err != nil {
+		return
+	}
+
+	settings, err := h.service.SetNameTheme(r.Context(), req.Platform, req.PlatformID, req.Username, req.Theme)
+	if err != nil {
+		RespondServiceError(w, r, "Failed to update name theme", err)
+		return
+	}
+
+	RespondJSON(w, http.StatusOK, settings)
+}
@@ -0,0 +1,123 @@
+package handler
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/mocks"
+)
+
+func TestUserSettingsHandler_HandleUpdateSettings(t *testing.T) {
+	put := func(h *UserSettingsHandler, body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPut, "/user/settings", bytes.NewBufferString(body))
+		rec := httptest.NewRecorder()
+		h.HandleUpdateSettings(rec, req)
+		return rec
+	}
+
+	t.Run("opts the user out of leaderboards", func(t *testing.T) {
+		svc := mocks.NewMockUsersettingsService(t)
+		svc.On("SetLeaderboardPrivate", mock.Anything, domain.PlatformDiscord, "d-1", "alice", true).
+			Return(&domain.UserSettings{LeaderboardPrivate: true}, nil)
+
+		rec := put(NewUserSettingsHandler(svc), `{"platform":"discord","platform_id":"d-1","username":"alice","leaderboard_private":true}`)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Contains(t, rec.Body.String(), `"leaderboard_private":true`)
+	})
+
+	t.Run("requires the privacy flag", func(t *testing.T) {
+		svc := mocks.NewMockUsersettingsService(t)
+
+		rec := put(NewUserSettingsHandler(svc), `{"platform":"discord","platform_id":"d-1","username":"alice"}`)
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+}
+
+func TestUserSettingsHandler_HandleUpdatePreferences(t *testing.T) {
+	put := func(h *UserSettingsHandler, body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPut, "/user/preferences", bytes.NewBufferString(body))
+		rec := httptest.NewRecorder()
+		h.HandleUpdatePreferences(rec, req)
+		return rec
+	}
+
+	t.Run("opts the user out of targeted items", func(t *testing.T) {
+		svc := mocks.NewMockUsersettingsService(t)
+		svc.On("SetTargetingOptOut", mock.Anything, domain.PlatformDiscord, "d-1", "alice", true).
+			Return(&domain.UserSettings{TargetingOptOut: true}, nil)
+
+		rec := put(NewUserSettingsHandler(svc), `{"platform":"discord","platform_id":"d-1","username":"alice","targeting_opt_out":true}`)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Contains(t, rec.Body.String(), `"targeting_opt_out":true`)
+	})
+
+	t.Run("requires the opt-out flag", func(t *testing.T) {
+		svc := mocks.NewMockUsersettingsService(t)
+
+		rec := put(NewUserSettingsHandler(svc), `{"platform":"discord","platform_id":"d-1","username":"alice"}`)
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+}
+
+func TestUserSettingsHandler_HandleGetSettings_UnknownUser(t *testing.T) {
+	svc := mocks.NewMockUsersettingsService(t)
+	svc.On("GetSettings", mock.Anything, domain.PlatformDiscord, "d-1").Return(nil, domain.ErrUserNotFound)
+
+	req := httptest.NewRequest(http.MethodGet, "/user/settings?platform=discord&platform_id=d-1", nil)
+	rec := httptest.NewRecorder()
+	NewUserSettingsHandler(svc).HandleGetSettings(rec, req)
+
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
+
+func TestUserSettingsHandler_HandleUpdateTheme(t *testing.T) {
+	put := func(h *UserSettingsHandler, body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPut, "/user/theme", bytes.NewBufferString(body))
+		rec := httptest.NewRecorder()
+		h.HandleUpdateTheme(rec, req)
+		return rec
+	}
+
+	t.Run("picks a theme", func(t *testing.T) {
+		svc := mocks.NewMockUsersettingsService(t)
+		svc.On("SetNameTheme", mock.Anything, domain.PlatformDiscord, "d-1", "alice", "halloween").
+			Return(&domain.UserSettings{NameTheme: "halloween"}, nil)
+
+		rec := put(NewUserSettingsHandler(svc), `{"platform":"discord","platform_id":"d-1","username":"alice","theme":"halloween"}`)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Contains(t, rec.Body.String(), `"name_theme":"halloween"`)
+	})
+
+	t.Run("unknown theme is a bad request", func(t *testing.T) {
+		svc := mocks.NewMockUsersettingsService(t)
+		svc.On("SetNameTheme", mock.Anything, domain.PlatformDiscord, "d-1", "alice", "easter").
+			Return(nil, fmt.Errorf("%w: easter", domain.ErrUnknownNameTheme))
+
+		rec := put(NewUserSettingsHandler(svc), `{"platform":"discord","platform_id":"d-1","username":"alice","theme":"easter"}`)
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("locked theme is forbidden", func(t *testing.T) {
+		svc := mocks.NewMockUsersettingsService(t)
+		svc.On("SetNameTheme", mock.Anything, domain.PlatformDiscord, "d-1", "alice", "halloween").
+			Return(nil, domain.ErrFeatureLocked)
+
+		rec := put(NewUserSettingsHandler(svc), `{"platform":"discord","platform_id":"d-1","username":"alice","theme":"halloween"}`)
+
+		assert.Equal(t, http.StatusForbidden, rec.Code)
+		assert.Contains(t, rec.Body.String(), `"code":"FEATURE_LOCKED"`)
+	})
+}
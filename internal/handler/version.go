@@ -4,6 +4,8 @@ import (
 	"net/http"
 	"os"
 	"runtime"
+
+	"github.com/osse101/BrandishBot_Go/internal/dataversion"
 )
 
 // VersionInfo contains version and build information
@@ -12,6 +14,10 @@ type VersionInfo struct {
 	GoVersion string `json:"go_version"`
 	BuildTime string `json:"build_time,omitempty"`
 	GitCommit string `json:"git_commit,omitempty"`
+
+	// DataVersions holds counters for cacheable data (prices, recipes, progression tree).
+	// A counter changes whenever the underlying data does, so clients can refetch only what moved.
+	DataVersions map[string]int64 `json:"data_versions,omitempty"`
 }
 
 // Build-time variables (injected via ldflags)
@@ -23,7 +29,7 @@ var (
 
 // HandleVersion returns version information about the application
 // This makes it easy to verify which version is deployed
-func HandleVersion() http.HandlerFunc {
+func HandleVersion(dataVersions *dataversion.Tracker) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		info := VersionInfo{
 			Version:   getVersionInfo(),
@@ -31,6 +37,9 @@ func HandleVersion() http.HandlerFunc {
 			BuildTime: BuildTime,
 			GitCommit: GitCommit,
 		}
+		if dataVersions != nil {
+			info.DataVersions = dataVersions.Snapshot()
+		}
 
 		RespondJSON(w, http.StatusOK, info)
 	}
@@ -10,6 +10,8 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	"github.com/osse101/BrandishBot_Go/internal/dataversion"
 )
 
 func TestHandleVersion(t *testing.T) {
@@ -84,7 +86,7 @@ func TestHandleVersion(t *testing.T) {
 			req := httptest.NewRequest("GET", "/version", nil)
 			w := httptest.NewRecorder()
 
-			handler := HandleVersion()
+			handler := HandleVersion(nil)
 			handler.ServeHTTP(w, req)
 
 			assert.Equal(t, http.StatusOK, w.Code)
@@ -93,6 +95,23 @@ func TestHandleVersion(t *testing.T) {
 	}
 }
 
+func TestHandleVersion_DataVersions(t *testing.T) {
+	tracker := dataversion.NewTracker()
+	tracker.Bump(dataversion.ResourcePrices)
+
+	req := httptest.NewRequest("GET", "/version", nil)
+	w := httptest.NewRecorder()
+	HandleVersion(tracker).ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusOK, w.Code)
+
+	var info VersionInfo
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &info))
+	assert.Equal(t, int64(2), info.DataVersions[string(dataversion.ResourcePrices)])
+	assert.Equal(t, int64(1), info.DataVersions[string(dataversion.ResourceRecipes)])
+	assert.Equal(t, int64(1), info.DataVersions[string(dataversion.ResourceTree)])
+}
+
 func TestGetVersionInfo(t *testing.T) {
 	tests := []struct {
 		name     string
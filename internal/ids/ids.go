@@ -0,0 +1,47 @@
+// Package ids generates identifiers that are safe to create on any instance
+// and merge later, plus short codes that players can type in chat.
+package ids
+
+import (
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// PrefixGamble is the short code prefix for gambles
+const PrefixGamble = "G"
+
+// ShortCodeLength is the number of characters after the prefix
+const ShortCodeLength = 4
+
+// crockfordAlphabet is Crockford's base32: no I, L, O or U, so codes read
+// aloud or typed from a screenshot are hard to get wrong
+const crockfordAlphabet = "0123456789ABCDEFGHJKMNPQRSTVWXYZ"
+
+// New returns a time-ordered UUID (version 7). It falls back to a random
+// UUID (version 4) if the clock-based generator fails.
+func New() uuid.UUID {
+	id, err := uuid.NewV7()
+	if err != nil {
+		return uuid.New()
+	}
+	return id
+}
+
+// ShortCode returns a human-friendly code such as "G-7F3K" for chat
+// references and announcements. The code is taken from the random tail of
+// the UUID. It is not unique across all time and must only be used to pick
+// out an entity among the few that are currently active.
+func ShortCode(prefix string, id uuid.UUID) string {
+	// Last 20 bits of the UUID, 5 bits per character
+	bits := uint32(id[13]&0x0F)<<16 | uint32(id[14])<<8 | uint32(id[15])
+
+	var b strings.Builder
+	b.Grow(len(prefix) + 1 + ShortCodeLength)
+	b.WriteString(prefix)
+	b.WriteByte('-')
+	for i := ShortCodeLength - 1; i >= 0; i-- {
+		b.WriteByte(crockfordAlphabet[(bits>>(uint(i)*5))&0x1F])
+	}
+	return b.String()
+}
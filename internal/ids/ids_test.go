@@ -0,0 +1,30 @@
+package ids
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNew_IsTimeOrdered(t *testing.T) {
+	t.Parallel()
+
+	first := New()
+	second := New()
+
+	assert.Equal(t, uuid.Version(7), first.Version())
+	assert.Less(t, first.String(), second.String())
+}
+
+func TestShortCode(t *testing.T) {
+	t.Parallel()
+
+	id := uuid.MustParse("01890a5d-ac96-774b-bcce-b302099a8057")
+	code := ShortCode(PrefixGamble, id)
+
+	assert.Equal(t, "G-N02Q", code)
+	assert.Equal(t, code, ShortCode(PrefixGamble, id), "code must be deterministic")
+	assert.Regexp(t, regexp.MustCompile(`^G-[0-9A-HJKMNP-TV-Z]{4}$`), ShortCode(PrefixGamble, New()))
+}
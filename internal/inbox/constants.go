@@ -0,0 +1,38 @@
+package inbox
+
+// Defaults, used when the configured values are not positive
+const (
+	// DefaultMaxMessages caps how many messages are kept per user
+	DefaultMaxMessages = 100
+	// DefaultPageSize is how many messages GetInbox returns without a limit
+	DefaultPageSize = 20
+)
+
+// MaxPageSize caps how many messages one GetInbox call returns
+const MaxPageSize = 50
+
+// Message titles and bodies
+const (
+	MsgGiftTitle           = "Gift from %s"
+	MsgGiftBody            = "%s sent you %d %s."
+	MsgGiftNote            = "\n\n\"%s\""
+	MsgMilestoneTitle      = "Milestone unlocked"
+	MsgMilestoneBody       = "You reached %s on the %s track."
+	MsgBirthdayTitle       = "Happy birthday!"
+	MsgAnniversaryTitle    = "Happy anniversary!"
+	MsgCelebrationBody     = "You received %d %s."
+	MsgMarketSaleTitle     = "Market sale"
+	MsgMarketSaleBody      = "%d %s sold for %d."
+	MsgMarketRemainingBody = " %d still listed."
+	MsgLoanReturnedTitle   = "Loan returned"
+	MsgLoanReturnedBody    = "Your %d %s came back."
+	MsgLoanDefaultedBody   = "Only %d of the %d %s you lent came back."
+	MsgBossLootTitle       = "%s defeated"
+	MsgBossLootBody        = "You dealt %d damage and won %d %s."
+)
+
+// Log messages
+const (
+	LogMsgMessagesRead  = "Inbox messages marked read"
+	LogWarnNotifyFailed = "Failed to add inbox message"
+)
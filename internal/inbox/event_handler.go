@@ -0,0 +1,132 @@
+package inbox
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/event"
+	"github.com/osse101/BrandishBot_Go/internal/logger"
+)
+
+// EventHandler writes inbox messages for events that reward a user who may
+// not be around to see them
+type EventHandler struct {
+	service Service
+}
+
+// NewEventHandler creates a new inbox event handler
+func NewEventHandler(service Service) *EventHandler {
+	return &EventHandler{service: service}
+}
+
+// Register subscribes the handler to gift, milestone, celebration, market,
+// loan and boss events. All are local so each message is written once.
+func (h *EventHandler) Register(bus event.Bus) {
+	bus.Subscribe(event.GiftDelivered, h.HandleGiftDelivered)
+	bus.Subscribe(event.PersonalMilestoneUnlocked, h.HandleMilestoneUnlocked)
+	bus.Subscribe(event.CelebrationGranted, h.HandleCelebrationGranted)
+	bus.Subscribe(event.PlayerShopSold, h.HandlePlayerShopSold)
+	bus.Subscribe(event.ItemLoanReturned, h.HandleLoanReturned)
+	bus.Subscribe(event.BossDefeated, h.HandleBossDefeated)
+}
+
+// HandleGiftDelivered tells the recipient who their gift is from
+func (h *EventHandler) HandleGiftDelivered(ctx context.Context, evt event.Event) error {
+	payload, err := event.DecodePayload[event.GiftDeliveredPayloadV1](evt.Payload)
+	if err != nil {
+		return fmt.Errorf("failed to decode gift delivered payload: %w", err)
+	}
+
+	body := fmt.Sprintf(MsgGiftBody, payload.SenderName, payload.Quantity, payload.ItemName)
+	if payload.Message != "" {
+		body += fmt.Sprintf(MsgGiftNote, payload.Message)
+	}
+	h.notify(ctx, payload.RecipientID, domain.InboxKindGift, fmt.Sprintf(MsgGiftTitle, payload.SenderName), body)
+	return nil
+}
+
+// HandleMilestoneUnlocked records a personal milestone the user reached
+func (h *EventHandler) HandleMilestoneUnlocked(ctx context.Context, evt event.Event) error {
+	payload, err := event.DecodePayload[event.PersonalMilestoneUnlockedPayloadV1](evt.Payload)
+	if err != nil {
+		return fmt.Errorf("failed to decode milestone unlocked payload: %w", err)
+	}
+
+	h.notify(ctx, payload.UserID, domain.InboxKindAchievement, MsgMilestoneTitle, fmt.Sprintf(MsgMilestoneBody, payload.MilestoneName, payload.TrackName))
+	return nil
+}
+
+// HandleCelebrationGranted records a birthday or anniversary reward
+func (h *EventHandler) HandleCelebrationGranted(ctx context.Context, evt event.Event) error {
+	payload, err := event.DecodePayload[event.CelebrationPayloadV1](evt.Payload)
+	if err != nil {
+		return fmt.Errorf("failed to decode celebration payload: %w", err)
+	}
+
+	title := MsgBirthdayTitle
+	if payload.Kind == domain.CelebrationAnniversary {
+		title = MsgAnniversaryTitle
+	}
+	h.notify(ctx, payload.UserID, domain.InboxKindReward, title, fmt.Sprintf(MsgCelebrationBody, payload.Quantity, payload.ItemName))
+	return nil
+}
+
+// HandlePlayerShopSold tells the seller what their listing earned after the
+// shop fee
+func (h *EventHandler) HandlePlayerShopSold(ctx context.Context, evt event.Event) error {
+	payload, err := event.DecodePayload[event.PlayerShopSoldPayloadV1](evt.Payload)
+	if err != nil {
+		return fmt.Errorf("failed to decode player shop sold payload: %w", err)
+	}
+
+	body := fmt.Sprintf(MsgMarketSaleBody, payload.Quantity, payload.ItemName, payload.TotalPrice-payload.Fee)
+	if payload.Remaining > 0 {
+		body += fmt.Sprintf(MsgMarketRemainingBody, payload.Remaining)
+	}
+	h.notify(ctx, payload.SellerID, domain.InboxKindReward, MsgMarketSaleTitle, body)
+	return nil
+}
+
+// HandleLoanReturned tells the lender what came back from their loan
+func (h *EventHandler) HandleLoanReturned(ctx context.Context, evt event.Event) error {
+	payload, err := event.DecodePayload[event.ItemLoanPayloadV1](evt.Payload)
+	if err != nil {
+		return fmt.Errorf("failed to decode item loan payload: %w", err)
+	}
+
+	body := fmt.Sprintf(MsgLoanReturnedBody, payload.Quantity, payload.ItemName)
+	if payload.ReturnedQuantity < payload.Quantity {
+		body = fmt.Sprintf(MsgLoanDefaultedBody, payload.ReturnedQuantity, payload.Quantity, payload.ItemName)
+	}
+	h.notify(ctx, payload.LenderID, domain.InboxKindReward, MsgLoanReturnedTitle, body)
+	return nil
+}
+
+// HandleBossDefeated tells every raider who won loot what they earned
+func (h *EventHandler) HandleBossDefeated(ctx context.Context, evt event.Event) error {
+	payload, err := event.DecodePayload[event.BossDefeatedPayloadV1](evt.Payload)
+	if err != nil {
+		return fmt.Errorf("failed to decode boss defeated payload: %w", err)
+	}
+
+	title := fmt.Sprintf(MsgBossLootTitle, payload.Name)
+	for _, share := range payload.Shares {
+		if share.Boxes <= 0 {
+			continue
+		}
+		h.notify(ctx, share.UserID, domain.InboxKindReward, title, fmt.Sprintf(MsgBossLootBody, share.Damage, share.Boxes, payload.RewardLootbox))
+	}
+	return nil
+}
+
+// notify writes one message. Failures are logged rather than returned, since
+// a retried event would repeat the messages already written.
+func (h *EventHandler) notify(ctx context.Context, userID string, kind domain.InboxKind, title, body string) {
+	if userID == "" {
+		return
+	}
+	if _, err := h.service.Notify(ctx, userID, kind, title, body); err != nil {
+		logger.FromContext(ctx).Warn(LogWarnNotifyFailed, "user_id", userID, "kind", kind, "error", err)
+	}
+}
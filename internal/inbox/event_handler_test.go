@@ -0,0 +1,167 @@
+package inbox_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/event"
+	"github.com/osse101/BrandishBot_Go/internal/inbox"
+	"github.com/osse101/BrandishBot_Go/internal/inbox/mocks"
+)
+
+// expectMessage expects one message for userID and returns it once written
+func expectMessage(mockRepo *mocks.MockRepository, userID string) *domain.InboxMessage {
+	written := &domain.InboxMessage{}
+	mockRepo.On("AddMessage", mock.Anything, mock.MatchedBy(func(msg domain.InboxMessage) bool { return msg.UserID == userID }), mock.Anything).
+		Return(func(_ context.Context, msg domain.InboxMessage, _ int) (*domain.InboxMessage, error) {
+			*written = msg
+			return &msg, nil
+		}).Once()
+	return written
+}
+
+func TestHandleGiftDelivered(t *testing.T) {
+	mockRepo := mocks.NewMockRepository(t)
+	h := inbox.NewEventHandler(inbox.NewService(mockRepo, mocks.NewMockUserService(t), inbox.Config{}))
+	msg := expectMessage(mockRepo, "user-2")
+
+	err := h.HandleGiftDelivered(context.Background(), event.Event{Payload: event.GiftDeliveredPayloadV1{
+		SenderName:  "alice",
+		RecipientID: "user-2",
+		ItemName:    "lootbox1",
+		Quantity:    2,
+		Message:     "happy birthday",
+	}})
+
+	require.NoError(t, err)
+	assert.Equal(t, domain.InboxKindGift, msg.Kind)
+	assert.Equal(t, "Gift from alice", msg.Title)
+	assert.Equal(t, "alice sent you 2 lootbox1.\n\n\"happy birthday\"", msg.Body)
+}
+
+func TestHandleMilestoneUnlocked(t *testing.T) {
+	mockRepo := mocks.NewMockRepository(t)
+	h := inbox.NewEventHandler(inbox.NewService(mockRepo, mocks.NewMockUserService(t), inbox.Config{}))
+	msg := expectMessage(mockRepo, "user-1")
+
+	err := h.HandleMilestoneUnlocked(context.Background(), event.Event{Payload: event.PersonalMilestoneUnlockedPayloadV1{
+		UserID:        "user-1",
+		TrackName:     "Explorer",
+		MilestoneName: "First Steps",
+	}})
+
+	require.NoError(t, err)
+	assert.Equal(t, domain.InboxKindAchievement, msg.Kind)
+	assert.Equal(t, "You reached First Steps on the Explorer track.", msg.Body)
+}
+
+func TestHandleCelebrationGranted(t *testing.T) {
+	mockRepo := mocks.NewMockRepository(t)
+	h := inbox.NewEventHandler(inbox.NewService(mockRepo, mocks.NewMockUserService(t), inbox.Config{}))
+	msg := expectMessage(mockRepo, "user-1")
+
+	err := h.HandleCelebrationGranted(context.Background(), event.Event{Payload: event.CelebrationPayloadV1{
+		UserID:   "user-1",
+		Kind:     domain.CelebrationAnniversary,
+		Years:    2,
+		ItemName: "lootbox2",
+		Quantity: 1,
+	}})
+
+	require.NoError(t, err)
+	assert.Equal(t, "Happy anniversary!", msg.Title)
+	assert.Equal(t, "You received 1 lootbox2.", msg.Body)
+}
+
+func TestHandlePlayerShopSold(t *testing.T) {
+	mockRepo := mocks.NewMockRepository(t)
+	h := inbox.NewEventHandler(inbox.NewService(mockRepo, mocks.NewMockUserService(t), inbox.Config{}))
+	msg := expectMessage(mockRepo, "seller")
+
+	err := h.HandlePlayerShopSold(context.Background(), event.Event{Payload: event.PlayerShopSoldPayloadV1{
+		SellerID:   "seller",
+		BuyerID:    "buyer",
+		ItemName:   "missile",
+		Quantity:   3,
+		TotalPrice: 300,
+		Fee:        15,
+		Remaining:  2,
+	}})
+
+	require.NoError(t, err)
+	assert.Equal(t, domain.InboxKindReward, msg.Kind)
+	assert.Equal(t, "3 missile sold for 285. 2 still listed.", msg.Body)
+}
+
+func TestHandleLoanReturned(t *testing.T) {
+	tests := []struct {
+		name     string
+		returned int
+		want     string
+	}{
+		{"in full", 4, "Your 4 lootbox1 came back."},
+		{"defaulted", 1, "Only 1 of the 4 lootbox1 you lent came back."},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := mocks.NewMockRepository(t)
+			h := inbox.NewEventHandler(inbox.NewService(mockRepo, mocks.NewMockUserService(t), inbox.Config{}))
+			msg := expectMessage(mockRepo, "lender")
+
+			err := h.HandleLoanReturned(context.Background(), event.Event{Payload: event.ItemLoanPayloadV1{
+				LenderID:         "lender",
+				ItemName:         "lootbox1",
+				Quantity:         4,
+				ReturnedQuantity: tt.returned,
+			}})
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, msg.Body)
+		})
+	}
+}
+
+func TestHandleBossDefeated(t *testing.T) {
+	mockRepo := mocks.NewMockRepository(t)
+	h := inbox.NewEventHandler(inbox.NewService(mockRepo, mocks.NewMockUserService(t), inbox.Config{}))
+	msg := expectMessage(mockRepo, "user-1")
+
+	// user-2 dealt too little damage to win a box and gets no message
+	err := h.HandleBossDefeated(context.Background(), event.Event{Payload: event.BossDefeatedPayloadV1{
+		Name:          "Goblin King",
+		RewardLootbox: "lootbox2",
+		Shares: []event.BossShareV1{
+			{UserID: "user-1", Damage: 120, Boxes: 2},
+			{UserID: "user-2", Damage: 3, Boxes: 0},
+		},
+	}})
+
+	require.NoError(t, err)
+	assert.Equal(t, "Goblin King defeated", msg.Title)
+	assert.Equal(t, "You dealt 120 damage and won 2 lootbox2.", msg.Body)
+}
+
+func TestHandler_NotifyFailureIsNotReturned(t *testing.T) {
+	mockRepo := mocks.NewMockRepository(t)
+	h := inbox.NewEventHandler(inbox.NewService(mockRepo, mocks.NewMockUserService(t), inbox.Config{}))
+	mockRepo.On("AddMessage", mock.Anything, mock.Anything, mock.Anything).Return(nil, errors.New("db down"))
+
+	err := h.HandleMilestoneUnlocked(context.Background(), event.Event{Payload: event.PersonalMilestoneUnlockedPayloadV1{UserID: "user-1"}})
+
+	assert.NoError(t, err)
+}
+
+func TestHandler_SkipsEventsWithoutUser(t *testing.T) {
+	mockRepo := mocks.NewMockRepository(t)
+	h := inbox.NewEventHandler(inbox.NewService(mockRepo, mocks.NewMockUserService(t), inbox.Config{}))
+
+	err := h.HandleGiftDelivered(context.Background(), event.Event{Payload: event.GiftDeliveredPayloadV1{SenderName: "alice"}})
+
+	assert.NoError(t, err)
+}
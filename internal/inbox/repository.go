@@ -0,0 +1,25 @@
+package inbox
+
+import (
+	"context"
+	"time"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+)
+
+// Repository stores users' inbox messages
+type Repository interface {
+	// AddMessage stores a message and drops the user's oldest messages
+	// beyond the newest keep
+	AddMessage(ctx context.Context, msg domain.InboxMessage, keep int) (*domain.InboxMessage, error)
+
+	// GetMessages returns up to limit of a user's messages, newest first
+	GetMessages(ctx context.Context, userID string, unreadOnly bool, limit int) ([]domain.InboxMessage, error)
+
+	// CountUnread returns how many of a user's messages are unread
+	CountUnread(ctx context.Context, userID string) (int, error)
+
+	// MarkRead marks a user's unread messages with the given IDs read, or all
+	// of them when ids is empty, and returns how many were marked
+	MarkRead(ctx context.Context, userID string, ids []int64, at time.Time) (int, error)
+}
@@ -0,0 +1,114 @@
+package inbox
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/logger"
+	"github.com/osse101/BrandishBot_Go/internal/repository"
+)
+
+// Service keeps a per-user inbox of notifications, so users learn what
+// happened while they were offline
+type Service interface {
+	// Notify adds a message to the user's inbox, dropping their oldest
+	// messages beyond the configured maximum
+	Notify(ctx context.Context, userID string, kind domain.InboxKind, title, body string) (*domain.InboxMessage, error)
+
+	// GetInbox returns the user's newest messages, or only the unread ones,
+	// with their unread count. A limit of 0 uses DefaultPageSize.
+	GetInbox(ctx context.Context, platform, platformID string, unreadOnly bool, limit int) (*domain.Inbox, error)
+
+	// MarkRead marks the user's messages with the given IDs read, or every
+	// message when ids is empty, and returns how many were newly marked
+	MarkRead(ctx context.Context, platform, platformID string, ids []int64) (int, error)
+}
+
+// UserService defines the user operations needed by the inbox service
+type UserService interface {
+	GetUserIDByPlatformID(ctx context.Context, platform, platformID string) (string, error)
+}
+
+// Config tunes the inbox
+type Config struct {
+	// MaxMessages caps how many messages are kept per user
+	MaxMessages int
+}
+
+type service struct {
+	repo  Repository
+	users UserService
+	cfg   Config
+	now   func() time.Time
+}
+
+// NewService creates an inbox service
+func NewService(repo Repository, users UserService, cfg Config) Service {
+	if cfg.MaxMessages <= 0 {
+		cfg.MaxMessages = DefaultMaxMessages
+	}
+	return &service{
+		repo:  repo,
+		users: users,
+		cfg:   cfg,
+		now:   time.Now,
+	}
+}
+
+// Notify stores a message for the user
+func (s *service) Notify(ctx context.Context, userID string, kind domain.InboxKind, title, body string) (*domain.InboxMessage, error) {
+	msg, err := s.repo.AddMessage(ctx, domain.InboxMessage{
+		UserID: userID,
+		Kind:   kind,
+		Title:  title,
+		Body:   body,
+	}, s.cfg.MaxMessages)
+	if err != nil {
+		return nil, fmt.Errorf("failed to add inbox message: %w", err)
+	}
+	return msg, nil
+}
+
+// GetInbox returns a page of the user's messages
+func (s *service) GetInbox(ctx context.Context, platform, platformID string, unreadOnly bool, limit int) (*domain.Inbox, error) {
+	if limit < 0 || limit > MaxPageSize {
+		return nil, fmt.Errorf("%w: limit must be between 1 and %d", domain.ErrInvalidInput, MaxPageSize)
+	}
+	if limit == 0 {
+		limit = DefaultPageSize
+	}
+
+	userID, err := repository.RegisteredUserID(ctx, s.users, platform, platformID)
+	if err != nil {
+		return nil, err
+	}
+
+	messages, err := s.repo.GetMessages(ctx, userID, unreadOnly, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get inbox messages: %w", err)
+	}
+	unread, err := s.repo.CountUnread(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count unread messages: %w", err)
+	}
+	return &domain.Inbox{Messages: messages, Unread: unread}, nil
+}
+
+// MarkRead marks the user's messages read
+func (s *service) MarkRead(ctx context.Context, platform, platformID string, ids []int64) (int, error) {
+	userID, err := repository.RegisteredUserID(ctx, s.users, platform, platformID)
+	if err != nil {
+		return 0, err
+	}
+
+	marked, err := s.repo.MarkRead(ctx, userID, ids, s.now())
+	if err != nil {
+		return 0, fmt.Errorf("failed to mark messages read: %w", err)
+	}
+	if marked > 0 {
+		logger.FromContext(ctx).Info(LogMsgMessagesRead, "user_id", userID, "count", marked)
+	}
+	return marked, nil
+}
@@ -0,0 +1,166 @@
+package inbox_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/inbox"
+	"github.com/osse101/BrandishBot_Go/internal/inbox/mocks"
+)
+
+func TestNotify(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("keeps the default number of messages", func(t *testing.T) {
+		mockRepo := mocks.NewMockRepository(t)
+		mockUsers := mocks.NewMockUserService(t)
+		svc := inbox.NewService(mockRepo, mockUsers, inbox.Config{})
+
+		mockRepo.On("AddMessage", ctx, domain.InboxMessage{UserID: "user-1", Kind: domain.InboxKindGift, Title: "Gift from bob", Body: "bob sent you 1 lootbox1."}, inbox.DefaultMaxMessages).
+			Return(func(_ context.Context, msg domain.InboxMessage, _ int) (*domain.InboxMessage, error) {
+				msg.ID = 7
+				return &msg, nil
+			})
+
+		got, err := svc.Notify(ctx, "user-1", domain.InboxKindGift, "Gift from bob", "bob sent you 1 lootbox1.")
+
+		require.NoError(t, err)
+		assert.Equal(t, int64(7), got.ID)
+	})
+
+	t.Run("keeps the configured number of messages", func(t *testing.T) {
+		mockRepo := mocks.NewMockRepository(t)
+		mockUsers := mocks.NewMockUserService(t)
+		svc := inbox.NewService(mockRepo, mockUsers, inbox.Config{MaxMessages: 5})
+
+		mockRepo.On("AddMessage", ctx, mock.Anything, 5).Return(&domain.InboxMessage{ID: 1}, nil)
+
+		_, err := svc.Notify(ctx, "user-1", domain.InboxKindReward, "Market sale", "")
+
+		require.NoError(t, err)
+	})
+
+	t.Run("wraps repository errors", func(t *testing.T) {
+		mockRepo := mocks.NewMockRepository(t)
+		mockUsers := mocks.NewMockUserService(t)
+		svc := inbox.NewService(mockRepo, mockUsers, inbox.Config{})
+
+		mockRepo.On("AddMessage", ctx, mock.Anything, mock.Anything).Return(nil, errors.New("db down"))
+
+		_, err := svc.Notify(ctx, "user-1", domain.InboxKindReward, "Market sale", "")
+
+		assert.ErrorContains(t, err, "db down")
+	})
+}
+
+func TestGetInbox(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("returns a page with the unread count", func(t *testing.T) {
+		mockRepo := mocks.NewMockRepository(t)
+		mockUsers := mocks.NewMockUserService(t)
+		svc := inbox.NewService(mockRepo, mockUsers, inbox.Config{})
+
+		messages := []domain.InboxMessage{{ID: 2, UserID: "user-1"}, {ID: 1, UserID: "user-1"}}
+		mockUsers.On("GetUserIDByPlatformID", ctx, domain.PlatformDiscord, "d-1").Return("user-1", nil)
+		mockRepo.On("GetMessages", ctx, "user-1", false, inbox.DefaultPageSize).Return(messages, nil)
+		mockRepo.On("CountUnread", ctx, "user-1").Return(3, nil)
+
+		got, err := svc.GetInbox(ctx, domain.PlatformDiscord, "d-1", false, 0)
+
+		require.NoError(t, err)
+		assert.Equal(t, messages, got.Messages)
+		assert.Equal(t, 3, got.Unread)
+	})
+
+	t.Run("passes unread only and the limit through", func(t *testing.T) {
+		mockRepo := mocks.NewMockRepository(t)
+		mockUsers := mocks.NewMockUserService(t)
+		svc := inbox.NewService(mockRepo, mockUsers, inbox.Config{})
+
+		mockUsers.On("GetUserIDByPlatformID", ctx, domain.PlatformDiscord, "d-1").Return("user-1", nil)
+		mockRepo.On("GetMessages", ctx, "user-1", true, 5).Return(nil, nil)
+		mockRepo.On("CountUnread", ctx, "user-1").Return(0, nil)
+
+		_, err := svc.GetInbox(ctx, domain.PlatformDiscord, "d-1", true, 5)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("rejects a limit above the page size", func(t *testing.T) {
+		mockRepo := mocks.NewMockRepository(t)
+		mockUsers := mocks.NewMockUserService(t)
+		svc := inbox.NewService(mockRepo, mockUsers, inbox.Config{})
+
+		_, err := svc.GetInbox(ctx, domain.PlatformDiscord, "d-1", false, inbox.MaxPageSize+1)
+
+		assert.ErrorIs(t, err, domain.ErrInvalidInput)
+	})
+
+	t.Run("unknown user", func(t *testing.T) {
+		mockRepo := mocks.NewMockRepository(t)
+		mockUsers := mocks.NewMockUserService(t)
+		svc := inbox.NewService(mockRepo, mockUsers, inbox.Config{})
+
+		mockUsers.On("GetUserIDByPlatformID", ctx, domain.PlatformDiscord, "d-1").Return("", nil)
+
+		_, err := svc.GetInbox(ctx, domain.PlatformDiscord, "d-1", false, 0)
+
+		assert.ErrorIs(t, err, domain.ErrUserNotFound)
+	})
+}
+
+func TestMarkRead(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("marks the given messages", func(t *testing.T) {
+		mockRepo := mocks.NewMockRepository(t)
+		mockUsers := mocks.NewMockUserService(t)
+		svc := inbox.NewService(mockRepo, mockUsers, inbox.Config{})
+
+		mockUsers.On("GetUserIDByPlatformID", ctx, domain.PlatformDiscord, "d-1").Return("user-1", nil)
+		mockRepo.On("MarkRead", ctx, "user-1", []int64{3, 4}, mock.AnythingOfType("time.Time")).
+			Return(func(_ context.Context, _ string, _ []int64, at time.Time) (int, error) {
+				assert.WithinDuration(t, time.Now(), at, time.Second)
+				return 2, nil
+			})
+
+		marked, err := svc.MarkRead(ctx, domain.PlatformDiscord, "d-1", []int64{3, 4})
+
+		require.NoError(t, err)
+		assert.Equal(t, 2, marked)
+	})
+
+	t.Run("marks everything without IDs", func(t *testing.T) {
+		mockRepo := mocks.NewMockRepository(t)
+		mockUsers := mocks.NewMockUserService(t)
+		svc := inbox.NewService(mockRepo, mockUsers, inbox.Config{})
+
+		mockUsers.On("GetUserIDByPlatformID", ctx, domain.PlatformDiscord, "d-1").Return("user-1", nil)
+		mockRepo.On("MarkRead", ctx, "user-1", []int64(nil), mock.Anything).Return(0, nil)
+
+		marked, err := svc.MarkRead(ctx, domain.PlatformDiscord, "d-1", nil)
+
+		require.NoError(t, err)
+		assert.Zero(t, marked)
+	})
+
+	t.Run("unknown user", func(t *testing.T) {
+		mockRepo := mocks.NewMockRepository(t)
+		mockUsers := mocks.NewMockUserService(t)
+		svc := inbox.NewService(mockRepo, mockUsers, inbox.Config{})
+
+		mockUsers.On("GetUserIDByPlatformID", ctx, domain.PlatformDiscord, "d-1").Return("", domain.ErrUserNotFound)
+
+		_, err := svc.MarkRead(ctx, domain.PlatformDiscord, "d-1", nil)
+
+		assert.ErrorIs(t, err, domain.ErrUserNotFound)
+	})
+}
@@ -0,0 +1,21 @@
+package inventorysync
+
+import "time"
+
+const (
+	// RequestTimeout bounds one delivery to the webhook endpoint
+	RequestTimeout = 5 * time.Second
+
+	// QueueSize is how many changes may wait for delivery before new ones are dropped
+	QueueSize = 1000
+
+	// SignatureHeader carries "sha256=" and the hex HMAC-SHA256 of the body
+	// when a secret is configured
+	SignatureHeader = "X-BrandishBot-Signature"
+
+	// EventTypeHeader names the event type of the delivered body
+	EventTypeHeader = "X-BrandishBot-Event"
+
+	// SignaturePrefix precedes the hex digest in SignatureHeader
+	SignaturePrefix = "sha256="
+)
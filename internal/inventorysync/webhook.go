@@ -0,0 +1,129 @@
+// Package inventorysync forwards inventory diffs to external systems so they
+// can mirror inventories without polling the API.
+package inventorysync
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"sync"
+
+	"github.com/osse101/BrandishBot_Go/internal/event"
+)
+
+// Webhook POSTs every inventory.changed event to an HTTP endpoint. Events are
+// queued and delivered in order by a background worker, so a slow endpoint
+// never holds up the inventory write that published them.
+type Webhook struct {
+	url    string
+	secret string
+	client *http.Client
+	bus    event.Bus
+
+	queue chan []byte
+	wg    sync.WaitGroup
+}
+
+// NewWebhook creates a forwarder to url. A non-empty secret signs each body.
+func NewWebhook(url, secret string, bus event.Bus) *Webhook {
+	return &Webhook{
+		url:    url,
+		secret: secret,
+		client: &http.Client{Timeout: RequestTimeout},
+		bus:    bus,
+		queue:  make(chan []byte, QueueSize),
+	}
+}
+
+// Subscribe registers the forwarder. It subscribes locally so that with
+// several instances each change is delivered once, by the instance that made it.
+func (w *Webhook) Subscribe() {
+	w.bus.Subscribe(event.InventoryChanged, w.handleInventoryChanged)
+	slog.Info("Inventory sync webhook registered", "url", w.url)
+}
+
+// Start launches the delivery worker
+func (w *Webhook) Start() {
+	w.wg.Add(1)
+	go func() {
+		defer w.wg.Done()
+		for body := range w.queue {
+			if err := w.deliver(body); err != nil {
+				slog.Warn("Failed to deliver inventory change", "error", err)
+			}
+		}
+	}()
+}
+
+// Stop delivers the queued changes and stops the worker. Call it after the
+// last inventory write, once nothing can publish any more.
+func (w *Webhook) Stop() {
+	close(w.queue)
+	w.wg.Wait()
+}
+
+// handleInventoryChanged queues the event for delivery, dropping it when the
+// queue is full
+func (w *Webhook) handleInventoryChanged(_ context.Context, evt event.Event) error {
+	payload, err := event.DecodePayload[event.InventoryChangedPayloadV1](evt.Payload)
+	if err != nil {
+		slog.Warn("Invalid inventory changed event payload type", "error", err)
+		return nil
+	}
+
+	body, err := json.Marshal(event.Event{
+		Version: evt.Version,
+		Type:    evt.Type,
+		Payload: payload,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to encode inventory change: %w", err)
+	}
+
+	select {
+	case w.queue <- body:
+		return nil
+	default:
+		return fmt.Errorf("inventory webhook queue full, dropped change for user %s", payload.UserID)
+	}
+}
+
+// deliver POSTs one encoded event
+func (w *Webhook) deliver(body []byte) error {
+	ctx, cancel := context.WithTimeout(context.Background(), RequestTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to build inventory webhook request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(EventTypeHeader, string(event.InventoryChanged))
+	if w.secret != "" {
+		req.Header.Set(SignatureHeader, SignaturePrefix+Sign(w.secret, body))
+	}
+
+	resp, err := w.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to post inventory change: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("inventory webhook returned status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// Sign returns the hex HMAC-SHA256 of body under secret, as sent in SignatureHeader
+func Sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
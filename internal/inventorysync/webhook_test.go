@@ -0,0 +1,88 @@
+package inventorysync
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/osse101/BrandishBot_Go/internal/event"
+)
+
+func TestWebhook_DeliversSignedChanges(t *testing.T) {
+	type delivery struct {
+		body      []byte
+		signature string
+		eventType string
+	}
+	received := make(chan delivery, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		received <- delivery{body: body, signature: r.Header.Get(SignatureHeader), eventType: r.Header.Get(EventTypeHeader)}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	bus := event.NewMemoryBus()
+	webhook := NewWebhook(server.URL, "s3cret", bus)
+	webhook.Subscribe()
+	webhook.Start()
+
+	changes := []event.InventoryChangeV1{{ItemID: 7, Quality: "COMMON", Delta: -2, Quantity: 3}}
+	require.NoError(t, bus.Publish(context.Background(), event.NewInventoryChangedEvent("user1", "sell", changes)))
+	webhook.Stop()
+
+	got := <-received
+	assert.Equal(t, SignaturePrefix+Sign("s3cret", got.body), got.signature)
+	assert.Equal(t, string(event.InventoryChanged), got.eventType)
+
+	var evt struct {
+		Type    string                          `json:"type"`
+		Payload event.InventoryChangedPayloadV1 `json:"payload"`
+	}
+	require.NoError(t, json.Unmarshal(got.body, &evt))
+	assert.Equal(t, string(event.InventoryChanged), evt.Type)
+	assert.Equal(t, "user1", evt.Payload.UserID)
+	assert.Equal(t, "sell", evt.Payload.Reason)
+	assert.Equal(t, changes, evt.Payload.Changes)
+}
+
+func TestWebhook_UnsignedWithoutSecret(t *testing.T) {
+	signatures := make(chan string, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		signatures <- r.Header.Get(SignatureHeader)
+	}))
+	defer server.Close()
+
+	webhook := NewWebhook(server.URL, "", event.NewMemoryBus())
+	webhook.Start()
+	require.NoError(t, webhook.handleInventoryChanged(context.Background(), event.NewInventoryChangedEvent("user1", "search", nil)))
+	webhook.Stop()
+
+	assert.Empty(t, <-signatures)
+}
+
+func TestWebhook_DropsWhenQueueFull(t *testing.T) {
+	webhook := NewWebhook("http://127.0.0.1:0", "", event.NewMemoryBus())
+	webhook.queue = make(chan []byte, 1)
+	evt := event.NewInventoryChangedEvent("user1", "search", nil)
+
+	require.NoError(t, webhook.handleInventoryChanged(context.Background(), evt))
+	assert.Error(t, webhook.handleInventoryChanged(context.Background(), evt))
+}
+
+func TestWebhook_DeliverReportsErrorStatus(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	webhook := NewWebhook(server.URL, "", event.NewMemoryBus())
+
+	assert.Error(t, webhook.deliver([]byte(`{}`)))
+}
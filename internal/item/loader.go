@@ -53,6 +53,9 @@ type Loader interface {
 	Load(path string) (*Config, error)
 	Validate(config *Config) error
 	SyncToDatabase(ctx context.Context, config *Config, repo repository.Item, configPath string) (*SyncResult, error)
+	// SyncItems inserts or updates the given definitions without checking
+	// whether a file changed, for items defined outside the items config
+	SyncItems(ctx context.Context, defs []Def, repo repository.Item) (*SyncResult, error)
 }
 
 // SyncResult contains the result of syncing items to the database
@@ -169,19 +172,11 @@ func (l *itemLoader) SyncToDatabase(ctx context.Context, config *Config, repo re
 		return &SyncResult{}, nil
 	}
 
-	existingByInternalName, typesByName, err := l.loadSyncData(ctx, repo)
+	result, err := l.SyncItems(ctx, config.Items, repo)
 	if err != nil {
 		return nil, err
 	}
 
-	result := &SyncResult{}
-	// Process each item
-	for _, itemDef := range config.Items {
-		if err := l.syncOneItem(ctx, repo, itemDef, existingByInternalName, typesByName, result); err != nil {
-			return nil, err
-		}
-	}
-
 	// Update sync metadata
 	if err := syncutil.UpdateMetadata(ctx, repo, ConfigFileName, fileState); err != nil {
 		log.Warn(LogMsgUpdateMetadataFailed, "error", err)
@@ -195,6 +190,22 @@ func (l *itemLoader) SyncToDatabase(ctx context.Context, config *Config, repo re
 	return result, nil
 }
 
+// SyncItems inserts new items and updates changed ones
+func (l *itemLoader) SyncItems(ctx context.Context, defs []Def, repo repository.Item) (*SyncResult, error) {
+	existingByInternalName, typesByName, err := l.loadSyncData(ctx, repo)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &SyncResult{}
+	for _, itemDef := range defs {
+		if err := l.syncOneItem(ctx, repo, itemDef, existingByInternalName, typesByName, result); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
 func (l *itemLoader) loadSyncData(ctx context.Context, repo repository.Item) (map[string]*domain.Item, map[string]int, error) {
 	// Get all existing items from DB
 	existingItems, err := repo.GetAllItems(ctx)
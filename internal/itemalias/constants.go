@@ -0,0 +1,14 @@
+package itemalias
+
+// Limits
+const (
+	// MaxAliasLength caps a single alias
+	MaxAliasLength = 100
+)
+
+// Log messages
+const (
+	LogMsgAliasSet     = "Item alias set"
+	LogMsgAliasDeleted = "Item alias deleted"
+	LogMsgReloadFailed = "Failed to reload naming resolver after alias change"
+)
@@ -0,0 +1,27 @@
+package itemalias
+
+import (
+	"context"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+)
+
+// Repository stores admin-managed alias pools by item internal name
+type Repository interface {
+	// ListAliases returns every alias pool ordered by internal name
+	ListAliases(ctx context.Context) ([]domain.ItemAlias, error)
+
+	// GetAlias returns the pool for an item, or nil if there is none
+	GetAlias(ctx context.Context, internalName string) (*domain.ItemAlias, error)
+
+	// UpsertAlias creates or replaces a pool and returns it as stored
+	UpsertAlias(ctx context.Context, alias domain.ItemAlias) (*domain.ItemAlias, error)
+
+	// DeleteAlias removes a pool and returns false if there was none
+	DeleteAlias(ctx context.Context, internalName string) (bool, error)
+}
+
+// ItemLookup lists the items aliases can be attached to
+type ItemLookup interface {
+	GetAllItems(ctx context.Context) ([]domain.Item, error)
+}
@@ -0,0 +1,196 @@
+package itemalias
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/logger"
+	"github.com/osse101/BrandishBot_Go/internal/naming"
+)
+
+// Service manages admin item aliases. A stored pool replaces the item's pool
+// from the aliases config file.
+type Service interface {
+	// ListAliases returns every stored pool ordered by internal name
+	ListAliases(ctx context.Context) ([]domain.ItemAlias, error)
+
+	// GetAlias returns an item's stored pool. It returns
+	// domain.ErrItemAliasNotFound if the item has none.
+	GetAlias(ctx context.Context, internalName string) (*domain.ItemAlias, error)
+
+	// SetAlias creates or replaces an item's pool
+	SetAlias(ctx context.Context, req SetRequest) (*domain.ItemAlias, error)
+
+	// DeleteAlias removes an item's pool, so the config file's pool applies
+	// again. It returns domain.ErrItemAliasNotFound if the item has none.
+	DeleteAlias(ctx context.Context, internalName string) error
+}
+
+// SetRequest describes the whole alias pool of an item
+type SetRequest struct {
+	InternalName string
+	Default      []string
+	Themes       map[string][]string
+	UpdatedBy    string
+}
+
+type service struct {
+	repo     Repository
+	items    ItemLookup
+	resolver naming.Resolver
+}
+
+// NewService creates an item alias service. Changes are reloaded into the
+// resolver straight away; other instances pick them up on their next reload.
+func NewService(repo Repository, items ItemLookup, resolver naming.Resolver) Service {
+	return &service{
+		repo:     repo,
+		items:    items,
+		resolver: resolver,
+	}
+}
+
+// OverrideLoader reads the stored pools for naming.WithAliasOverrides
+func OverrideLoader(repo Repository) naming.AliasOverrideLoader {
+	return func(ctx context.Context) (map[string]naming.AliasPool, error) {
+		aliases, err := repo.ListAliases(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pools := make(map[string]naming.AliasPool, len(aliases))
+		for _, alias := range aliases {
+			pools[alias.InternalName] = naming.AliasPool{Default: alias.Default, Themes: alias.Themes}
+		}
+		return pools, nil
+	}
+}
+
+// ListAliases returns every stored pool straight from the repository
+func (s *service) ListAliases(ctx context.Context) ([]domain.ItemAlias, error) {
+	return s.repo.ListAliases(ctx)
+}
+
+// GetAlias returns a stored pool straight from the repository
+func (s *service) GetAlias(ctx context.Context, internalName string) (*domain.ItemAlias, error) {
+	alias, err := s.repo.GetAlias(ctx, internalName)
+	if err != nil {
+		return nil, err
+	}
+	if alias == nil {
+		return nil, domain.ErrItemAliasNotFound
+	}
+	return alias, nil
+}
+
+// SetAlias validates and stores a pool, then reloads the resolver
+func (s *service) SetAlias(ctx context.Context, req SetRequest) (*domain.ItemAlias, error) {
+	if req.UpdatedBy == "" {
+		return nil, fmt.Errorf("%w: updated_by is required", domain.ErrInvalidInput)
+	}
+	items, err := s.items.GetAllItems(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load items: %w", err)
+	}
+	defaults, themes, err := s.normalize(req, items)
+	if err != nil {
+		return nil, err
+	}
+
+	alias, err := s.repo.UpsertAlias(ctx, domain.ItemAlias{
+		InternalName: req.InternalName,
+		Default:      defaults,
+		Themes:       themes,
+		UpdatedBy:    req.UpdatedBy,
+	})
+	if err != nil {
+		return nil, err
+	}
+	s.reload(ctx)
+
+	logger.FromContext(ctx).Info(LogMsgAliasSet, "item", alias.InternalName, "default", alias.Default, "themes", alias.Themes, "updated_by", alias.UpdatedBy)
+	return alias, nil
+}
+
+// DeleteAlias removes a stored pool, then reloads the resolver
+func (s *service) DeleteAlias(ctx context.Context, internalName string) error {
+	deleted, err := s.repo.DeleteAlias(ctx, internalName)
+	if err != nil {
+		return err
+	}
+	if !deleted {
+		return domain.ErrItemAliasNotFound
+	}
+	s.reload(ctx)
+
+	logger.FromContext(ctx).Info(LogMsgAliasDeleted, "item", internalName)
+	return nil
+}
+
+// reload applies the change on this instance. The change is already stored,
+// so a failed reload is only logged and the next reload picks it up.
+func (s *service) reload(ctx context.Context) {
+	if err := s.resolver.Reload(); err != nil {
+		logger.FromContext(ctx).Warn(LogMsgReloadFailed, "error", err)
+	}
+}
+
+// normalize trims the aliases and checks the pool against the items and
+// themes. An alias may not read as another item's internal name.
+func (s *service) normalize(req SetRequest, items []domain.Item) ([]string, map[string][]string, error) {
+	known := false
+	internalNames := make(map[string]string, len(items))
+	for _, item := range items {
+		internalNames[strings.ToLower(item.InternalName)] = item.InternalName
+		if item.InternalName == req.InternalName {
+			known = true
+		}
+	}
+	if !known {
+		return nil, nil, fmt.Errorf("%w: unknown item '%s'", domain.ErrInvalidInput, req.InternalName)
+	}
+
+	checkPool := func(field string, aliases []string) ([]string, error) {
+		seen := make(map[string]bool, len(aliases))
+		cleaned := make([]string, 0, len(aliases))
+		for _, alias := range aliases {
+			alias = strings.TrimSpace(alias)
+			if alias == "" || len(alias) > MaxAliasLength {
+				return nil, fmt.Errorf("%w: %s aliases must be 1-%d characters", domain.ErrInvalidInput, field, MaxAliasLength)
+			}
+			key := strings.ToLower(alias)
+			if seen[key] {
+				return nil, fmt.Errorf("%w: duplicate alias '%s' in %s", domain.ErrInvalidInput, alias, field)
+			}
+			if other, ok := internalNames[key]; ok && other != req.InternalName {
+				return nil, fmt.Errorf("%w: alias '%s' collides with the internal name of item '%s'", domain.ErrInvalidInput, alias, other)
+			}
+			seen[key] = true
+			cleaned = append(cleaned, alias)
+		}
+		return cleaned, nil
+	}
+
+	if len(req.Default) == 0 {
+		return nil, nil, fmt.Errorf("%w: at least one default alias is required", domain.ErrInvalidInput)
+	}
+	defaults, err := checkPool("default", req.Default)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	configured := s.resolver.GetThemes()
+	themes := make(map[string][]string, len(req.Themes))
+	for theme, aliases := range req.Themes {
+		if _, ok := configured[theme]; !ok {
+			return nil, nil, fmt.Errorf("%w: unknown theme '%s'", domain.ErrInvalidInput, theme)
+		}
+		cleaned, err := checkPool("theme "+theme, aliases)
+		if err != nil {
+			return nil, nil, err
+		}
+		themes[theme] = cleaned
+	}
+	return defaults, themes, nil
+}
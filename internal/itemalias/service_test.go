@@ -0,0 +1,154 @@
+package itemalias_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/itemalias"
+	itemaliasmocks "github.com/osse101/BrandishBot_Go/internal/itemalias/mocks"
+	"github.com/osse101/BrandishBot_Go/internal/naming"
+	"github.com/osse101/BrandishBot_Go/mocks"
+)
+
+var testItems = []domain.Item{
+	{InternalName: "weapon_blaster", PublicName: "missile"},
+	{InternalName: "lootbox_tier1", PublicName: "junkbox"},
+}
+
+func TestSetAlias(t *testing.T) {
+	ctx := context.Background()
+
+	newService := func(t *testing.T) (itemalias.Service, *itemaliasmocks.MockRepository, *mocks.MockNamingResolver) {
+		repo := itemaliasmocks.NewMockRepository(t)
+		items := itemaliasmocks.NewMockItemLookup(t)
+		items.On("GetAllItems", ctx).Return(testItems, nil).Maybe()
+		resolver := mocks.NewMockNamingResolver(t)
+		resolver.On("GetThemes").Return(map[string]naming.ThemePeriod{"halloween": {Start: "10-01", End: "10-31"}}).Maybe()
+		return itemalias.NewService(repo, items, resolver), repo, resolver
+	}
+
+	t.Run("stores trimmed aliases and reloads the resolver", func(t *testing.T) {
+		svc, repo, resolver := newService(t)
+		repo.On("UpsertAlias", ctx, domain.ItemAlias{
+			InternalName: "weapon_blaster",
+			Default:      []string{"pew pew", "boomstick"},
+			Themes:       map[string][]string{"halloween": {"spooky blaster"}},
+			UpdatedBy:    "admin",
+		}).Return(func(_ context.Context, alias domain.ItemAlias) (*domain.ItemAlias, error) {
+			return &alias, nil
+		})
+		resolver.On("Reload").Return(nil)
+
+		alias, err := svc.SetAlias(ctx, itemalias.SetRequest{
+			InternalName: "weapon_blaster",
+			Default:      []string{" pew pew ", "boomstick"},
+			Themes:       map[string][]string{"halloween": {"spooky blaster"}},
+			UpdatedBy:    "admin",
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"pew pew", "boomstick"}, alias.Default)
+	})
+
+	t.Run("a failed reload still stores the aliases", func(t *testing.T) {
+		svc, repo, resolver := newService(t)
+		repo.On("UpsertAlias", ctx, mock.Anything).Return(func(_ context.Context, alias domain.ItemAlias) (*domain.ItemAlias, error) {
+			return &alias, nil
+		})
+		resolver.On("Reload").Return(errors.New("bad aliases file"))
+
+		_, err := svc.SetAlias(ctx, itemalias.SetRequest{InternalName: "weapon_blaster", Default: []string{"pew pew"}, UpdatedBy: "admin"})
+
+		assert.NoError(t, err)
+	})
+
+	tests := []struct {
+		name string
+		req  itemalias.SetRequest
+	}{
+		{"unknown item", itemalias.SetRequest{InternalName: "weapon_laser", Default: []string{"zap"}, UpdatedBy: "admin"}},
+		{"missing updater", itemalias.SetRequest{InternalName: "weapon_blaster", Default: []string{"pew pew"}}},
+		{"no default aliases", itemalias.SetRequest{InternalName: "weapon_blaster", UpdatedBy: "admin"}},
+		{"blank alias", itemalias.SetRequest{InternalName: "weapon_blaster", Default: []string{"  "}, UpdatedBy: "admin"}},
+		{"duplicate alias", itemalias.SetRequest{InternalName: "weapon_blaster", Default: []string{"pew", "PEW"}, UpdatedBy: "admin"}},
+		{"unknown theme", itemalias.SetRequest{InternalName: "weapon_blaster", Default: []string{"pew"}, Themes: map[string][]string{"easter": {"egg"}}, UpdatedBy: "admin"}},
+		{"collides with another item", itemalias.SetRequest{InternalName: "weapon_blaster", Default: []string{"Lootbox_Tier1"}, UpdatedBy: "admin"}},
+		{"theme alias collides with another item", itemalias.SetRequest{InternalName: "weapon_blaster", Default: []string{"pew"}, Themes: map[string][]string{"halloween": {"lootbox_tier1"}}, UpdatedBy: "admin"}},
+	}
+	for _, tt := range tests {
+		t.Run("rejects "+tt.name, func(t *testing.T) {
+			svc, _, _ := newService(t)
+
+			_, err := svc.SetAlias(ctx, tt.req)
+
+			assert.ErrorIs(t, err, domain.ErrInvalidInput)
+		})
+	}
+
+	t.Run("an item may use its own internal name", func(t *testing.T) {
+		svc, repo, resolver := newService(t)
+		repo.On("UpsertAlias", ctx, mock.Anything).Return(func(_ context.Context, alias domain.ItemAlias) (*domain.ItemAlias, error) {
+			return &alias, nil
+		})
+		resolver.On("Reload").Return(nil)
+
+		_, err := svc.SetAlias(ctx, itemalias.SetRequest{InternalName: "weapon_blaster", Default: []string{"weapon_blaster"}, UpdatedBy: "admin"})
+
+		assert.NoError(t, err)
+	})
+}
+
+func TestDeleteAlias(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("reloads the resolver", func(t *testing.T) {
+		repo := itemaliasmocks.NewMockRepository(t)
+		resolver := mocks.NewMockNamingResolver(t)
+		repo.On("DeleteAlias", ctx, "weapon_blaster").Return(true, nil)
+		resolver.On("Reload").Return(nil)
+
+		err := itemalias.NewService(repo, itemaliasmocks.NewMockItemLookup(t), resolver).DeleteAlias(ctx, "weapon_blaster")
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("unknown item", func(t *testing.T) {
+		repo := itemaliasmocks.NewMockRepository(t)
+		repo.On("DeleteAlias", ctx, "weapon_blaster").Return(false, nil)
+
+		err := itemalias.NewService(repo, itemaliasmocks.NewMockItemLookup(t), mocks.NewMockNamingResolver(t)).DeleteAlias(ctx, "weapon_blaster")
+
+		assert.ErrorIs(t, err, domain.ErrItemAliasNotFound)
+	})
+}
+
+func TestGetAlias_Unknown(t *testing.T) {
+	ctx := context.Background()
+	repo := itemaliasmocks.NewMockRepository(t)
+	repo.On("GetAlias", ctx, "weapon_blaster").Return(nil, nil)
+
+	_, err := itemalias.NewService(repo, itemaliasmocks.NewMockItemLookup(t), mocks.NewMockNamingResolver(t)).GetAlias(ctx, "weapon_blaster")
+
+	assert.ErrorIs(t, err, domain.ErrItemAliasNotFound)
+}
+
+func TestOverrideLoader(t *testing.T) {
+	ctx := context.Background()
+	repo := itemaliasmocks.NewMockRepository(t)
+	repo.On("ListAliases", ctx).Return([]domain.ItemAlias{
+		{InternalName: "weapon_blaster", Default: []string{"pew pew"}, Themes: map[string][]string{"halloween": {"spooky blaster"}}},
+	}, nil)
+
+	pools, err := itemalias.OverrideLoader(repo)(ctx)
+
+	require.NoError(t, err)
+	assert.Equal(t, map[string]naming.AliasPool{
+		"weapon_blaster": {Default: []string{"pew pew"}, Themes: map[string][]string{"halloween": {"spooky blaster"}}},
+	}, pools)
+}
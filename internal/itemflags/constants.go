@@ -0,0 +1,13 @@
+package itemflags
+
+// Log messages
+const (
+	LogMsgItemLockSet     = "Item lock updated"
+	LogMsgItemFavoriteSet = "Item favorite updated"
+)
+
+// Error messages
+const (
+	ErrMsgCheckLockFailed = "failed to check item lock: %w"
+	ErrMsgItemLockedFmt   = "%s is locked: %w"
+)
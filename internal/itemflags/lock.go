@@ -0,0 +1,31 @@
+package itemflags
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+)
+
+// LockChecker reports whether a user has locked an item. Repository
+// satisfies it.
+type LockChecker interface {
+	IsItemLocked(ctx context.Context, userID string, itemID int) (bool, error)
+}
+
+// EnsureUnlocked returns domain.ErrItemLockedByUser when the user has locked
+// the item. Services that move items out of an inventory call it before
+// taking them; a nil checker allows everything.
+func EnsureUnlocked(ctx context.Context, locks LockChecker, userID string, item *domain.Item) error {
+	if locks == nil {
+		return nil
+	}
+	locked, err := locks.IsItemLocked(ctx, userID, item.ID)
+	if err != nil {
+		return fmt.Errorf(ErrMsgCheckLockFailed, err)
+	}
+	if locked {
+		return fmt.Errorf(ErrMsgItemLockedFmt, item.InternalName, domain.ErrItemLockedByUser)
+	}
+	return nil
+}
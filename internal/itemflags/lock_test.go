@@ -0,0 +1,49 @@
+package itemflags_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/itemflags"
+	"github.com/osse101/BrandishBot_Go/internal/itemflags/mocks"
+)
+
+func TestEnsureUnlocked(t *testing.T) {
+	ctx := context.Background()
+	sword := &domain.Item{ID: 7, InternalName: "sword"}
+
+	t.Run("allows unlocked items", func(t *testing.T) {
+		repo := mocks.NewMockRepository(t)
+		repo.On("IsItemLocked", ctx, "user-1", 7).Return(false, nil)
+
+		assert.NoError(t, itemflags.EnsureUnlocked(ctx, repo, "user-1", sword))
+	})
+
+	t.Run("refuses locked items", func(t *testing.T) {
+		repo := mocks.NewMockRepository(t)
+		repo.On("IsItemLocked", ctx, "user-1", 7).Return(true, nil)
+
+		err := itemflags.EnsureUnlocked(ctx, repo, "user-1", sword)
+
+		assert.ErrorIs(t, err, domain.ErrItemLockedByUser)
+		assert.Contains(t, err.Error(), "sword")
+	})
+
+	t.Run("reports a failed check", func(t *testing.T) {
+		repo := mocks.NewMockRepository(t)
+		repo.On("IsItemLocked", ctx, "user-1", 7).Return(false, errors.New("db down"))
+
+		err := itemflags.EnsureUnlocked(ctx, repo, "user-1", sword)
+
+		assert.Error(t, err)
+		assert.NotErrorIs(t, err, domain.ErrItemLockedByUser)
+	})
+
+	t.Run("a nil checker allows everything", func(t *testing.T) {
+		assert.NoError(t, itemflags.EnsureUnlocked(ctx, nil, "user-1", sword))
+	})
+}
@@ -0,0 +1,22 @@
+package itemflags
+
+import (
+	"context"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+)
+
+// Repository stores the flags users put on their items
+type Repository interface {
+	// GetItemFlags returns every item the user has flagged, by item name
+	GetItemFlags(ctx context.Context, userID string) ([]domain.ItemFlags, error)
+
+	// IsItemLocked reports whether the user has locked the item
+	IsItemLocked(ctx context.Context, userID string, itemID int) (bool, error)
+
+	// SetItemLocked locks the item, or unlocks it
+	SetItemLocked(ctx context.Context, userID string, itemID int, locked bool) error
+
+	// SetItemFavorite marks the item as a favorite, or clears the mark
+	SetItemFavorite(ctx context.Context, userID string, itemID int, favorite bool) error
+}
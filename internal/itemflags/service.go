@@ -0,0 +1,96 @@
+package itemflags
+
+import (
+	"context"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/logger"
+	"github.com/osse101/BrandishBot_Go/internal/naming"
+)
+
+// Service updates the favorite and locked flags users put on their items.
+// Locks are enforced with EnsureUnlocked by the services that take items
+// from an inventory, not here.
+type Service interface {
+	// SetLocked locks the item against use, sale, gifting and disassembly,
+	// or unlocks it
+	SetLocked(ctx context.Context, platform, platformID, username, itemName string, locked bool) (*domain.ItemFlags, error)
+
+	// SetFavorite marks the item as a favorite, or clears the mark
+	SetFavorite(ctx context.Context, platform, platformID, username, itemName string, favorite bool) (*domain.ItemFlags, error)
+}
+
+// UserService defines the user operations needed by the item flags service
+type UserService interface {
+	GetUserOrRegister(ctx context.Context, platform, platformID, username string) (*domain.User, error)
+}
+
+// ItemLookup finds items by name
+type ItemLookup interface {
+	GetItemByName(ctx context.Context, itemName string) (*domain.Item, error)
+}
+
+type service struct {
+	repo           Repository
+	users          UserService
+	items          ItemLookup
+	namingResolver naming.Resolver
+}
+
+// NewService creates an item flags service
+func NewService(repo Repository, users UserService, items ItemLookup, namingResolver naming.Resolver) Service {
+	return &service{repo: repo, users: users, items: items, namingResolver: namingResolver}
+}
+
+// SetLocked locks or unlocks the item, registering the user if needed
+func (s *service) SetLocked(ctx context.Context, platform, platformID, username, itemName string, locked bool) (*domain.ItemFlags, error) {
+	user, item, err := s.resolve(ctx, platform, platformID, username, itemName)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.repo.SetItemLocked(ctx, user.ID, item.ID, locked); err != nil {
+		return nil, err
+	}
+	logger.FromContext(ctx).Info(LogMsgItemLockSet, "user_id", user.ID, "item", item.InternalName, "locked", locked)
+	return s.flagsFor(ctx, user.ID, item)
+}
+
+// SetFavorite marks or unmarks the item as a favorite, registering the user if needed
+func (s *service) SetFavorite(ctx context.Context, platform, platformID, username, itemName string, favorite bool) (*domain.ItemFlags, error) {
+	user, item, err := s.resolve(ctx, platform, platformID, username, itemName)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.repo.SetItemFavorite(ctx, user.ID, item.ID, favorite); err != nil {
+		return nil, err
+	}
+	logger.FromContext(ctx).Info(LogMsgItemFavoriteSet, "user_id", user.ID, "item", item.InternalName, "favorite", favorite)
+	return s.flagsFor(ctx, user.ID, item)
+}
+
+// resolve finds the user and the item, by public or internal name
+func (s *service) resolve(ctx context.Context, platform, platformID, username, itemName string) (*domain.User, *domain.Item, error) {
+	user, err := s.users.GetUserOrRegister(ctx, platform, platformID, username)
+	if err != nil {
+		return nil, nil, err
+	}
+	item, err := naming.ResolveItem(ctx, s.namingResolver, s.items, itemName)
+	if err != nil {
+		return nil, nil, err
+	}
+	return user, item, nil
+}
+
+// flagsFor returns the user's current flags on the item
+func (s *service) flagsFor(ctx context.Context, userID string, item *domain.Item) (*domain.ItemFlags, error) {
+	flags, err := s.repo.GetItemFlags(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	for i := range flags {
+		if flags[i].ItemID == item.ID {
+			return &flags[i], nil
+		}
+	}
+	return &domain.ItemFlags{ItemID: item.ID, ItemName: item.InternalName}, nil
+}
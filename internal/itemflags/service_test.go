@@ -0,0 +1,61 @@
+package itemflags_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/itemflags"
+	"github.com/osse101/BrandishBot_Go/internal/itemflags/mocks"
+)
+
+func TestSetLocked(t *testing.T) {
+	ctx := context.Background()
+	repo := mocks.NewMockRepository(t)
+	users := mocks.NewMockUserService(t)
+	items := mocks.NewMockItemLookup(t)
+	users.On("GetUserOrRegister", ctx, domain.PlatformDiscord, "d-1", "alice").Return(&domain.User{ID: "user-1"}, nil)
+	items.On("GetItemByName", ctx, domain.ItemLootbox1).Return(&domain.Item{ID: 7, InternalName: domain.ItemLootbox1}, nil)
+	repo.On("SetItemLocked", ctx, "user-1", 7, true).Return(nil)
+	repo.On("GetItemFlags", ctx, "user-1").Return([]domain.ItemFlags{
+		{ItemID: 3, ItemName: "other", Favorite: true},
+		{ItemID: 7, ItemName: domain.ItemLootbox1, Locked: true},
+	}, nil)
+
+	flags, err := itemflags.NewService(repo, users, items, nil).SetLocked(ctx, domain.PlatformDiscord, "d-1", "alice", domain.ItemLootbox1, true)
+
+	require.NoError(t, err)
+	assert.Equal(t, &domain.ItemFlags{ItemID: 7, ItemName: domain.ItemLootbox1, Locked: true}, flags)
+}
+
+func TestSetFavorite_Cleared(t *testing.T) {
+	ctx := context.Background()
+	repo := mocks.NewMockRepository(t)
+	users := mocks.NewMockUserService(t)
+	items := mocks.NewMockItemLookup(t)
+	users.On("GetUserOrRegister", ctx, domain.PlatformDiscord, "d-1", "alice").Return(&domain.User{ID: "user-1"}, nil)
+	items.On("GetItemByName", ctx, domain.ItemLootbox1).Return(&domain.Item{ID: 7, InternalName: domain.ItemLootbox1}, nil)
+	repo.On("SetItemFavorite", ctx, "user-1", 7, false).Return(nil)
+	repo.On("GetItemFlags", ctx, "user-1").Return(nil, nil)
+
+	flags, err := itemflags.NewService(repo, users, items, nil).SetFavorite(ctx, domain.PlatformDiscord, "d-1", "alice", domain.ItemLootbox1, false)
+
+	require.NoError(t, err)
+	assert.Equal(t, &domain.ItemFlags{ItemID: 7, ItemName: domain.ItemLootbox1}, flags)
+}
+
+func TestSetLocked_UnknownItem(t *testing.T) {
+	ctx := context.Background()
+	repo := mocks.NewMockRepository(t)
+	users := mocks.NewMockUserService(t)
+	items := mocks.NewMockItemLookup(t)
+	users.On("GetUserOrRegister", ctx, domain.PlatformDiscord, "d-1", "alice").Return(&domain.User{ID: "user-1"}, nil)
+	items.On("GetItemByName", ctx, "nope").Return(nil, nil)
+
+	_, err := itemflags.NewService(repo, users, items, nil).SetLocked(ctx, domain.PlatformDiscord, "d-1", "alice", "nope", true)
+
+	assert.ErrorIs(t, err, domain.ErrItemNotFound)
+}
@@ -30,7 +30,7 @@ func handleBomb(ctx context.Context, ec EffectContext, _ *domain.User, inventory
 	totalQueued := 0
 	for _, slot := range consumedSlots {
 		baseTimeout := getWeaponTimeout(item.InternalName) + slot.QualityLevel.GetTimeoutAdjustment()
-		displayName := ec.GetDisplayName(item.InternalName, slot.QualityLevel)
+		displayName := ec.GetDisplayName(ctx, item.InternalName, slot.QualityLevel)
 		lastDisplayName = displayName
 
 		// A single slot might contain multiple items of the same quality
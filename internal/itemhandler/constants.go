@@ -22,11 +22,16 @@ const (
 	LogMsgHandleTrapCalled        = "handleTrap called"
 	LogMsgResourceGeneratorCalled = "ResourceGeneratorHandler called"
 	LogMsgUtilityCalled           = "UtilityHandler called"
+	LogMsgHandleEffectCalled      = "handleEffect called"
+	LogMsgHandleScriptCalled      = "ScriptedHandler called"
 
-	LogMsgWeaponUsed    = "weapon used"
-	LogMsgReviveUsed    = "revive used"
-	LogMsgShieldApplied = "shield applied"
-	LogMsgRareCandyUsed = "rare candy used"
+	LogMsgWeaponUsed      = "weapon used"
+	LogMsgReviveUsed      = "revive used"
+	LogMsgShieldApplied   = "shield applied"
+	LogMsgRareCandyUsed   = "rare candy used"
+	LogMsgTargetResolved  = "targeted item resolved"
+	LogMsgEffectActivated = "effect activated"
+	LogMsgScriptRun       = "scripted item effect run"
 
 	LogWarnWeaponNotInInventory         = "weapon not in inventory"
 	LogWarnNotEnoughWeapons             = "not enough weapons in inventory"
@@ -44,6 +49,9 @@ const (
 	LogWarnFailedToApplyShield          = "Failed to apply shield"
 	LogWarnFailedToRecordLootboxJackpot = "Failed to record lootbox jackpot event"
 	LogWarnFailedToRecordLootboxBigWin  = "Failed to record lootbox big-win event"
+	LogWarnFailedToCheckConsent         = "Failed to check targeting consent"
+	LogWarnEffectNotInInventory         = "effect item not in inventory"
+	LogWarnNotEnoughEffectItems         = "not enough effect items in inventory"
 )
 
 // ============================================================================
@@ -58,12 +66,16 @@ const (
 	MsgLootboxBigWin   = " BIG WIN! 💰"
 	MsgLootboxNiceHaul = " Nice haul! 📦"
 
-	MsgBlasterReasonBy = "Blasted by "
-	MsgTNTReasonBy     = "Blown up by "
-	MsgGrenadeReasonBy = "Blown up by "
-	MsgThisReason      = "Played yourself"
-	MsgShovelUsed      = " used a shovel and found "
-	MsgStickUsed       = " planted a stick as a monument to their achievement!"
+	MsgBlasterReasonBy   = "Blasted by "
+	MsgTNTReasonBy       = "Blown up by "
+	MsgGrenadeReasonBy   = "Blown up by "
+	MsgThisReason        = "Played yourself"
+	MsgReflectedReasonBy = "Reflected by "
+	MsgShovelUsed        = " used a shovel and found "
+	MsgStickUsed         = " planted a stick as a monument to their achievement!"
+
+	MsgScriptDefault          = "{user} used {quantity} {item}!"
+	MsgScriptTimeoutReasonFmt = "Used %s"
 
 	LootboxDropSeparator = ", "
 )
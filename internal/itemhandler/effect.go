@@ -0,0 +1,85 @@
+package itemhandler
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/logger"
+	"github.com/osse101/BrandishBot_Go/internal/utils"
+)
+
+// effectItem describes the timed effect an item grants. Using several at once
+// multiplies the duration, not the magnitude.
+type effectItem struct {
+	effect    domain.EffectType
+	magnitude float64
+	duration  time.Duration
+}
+
+var effectItems = map[string]effectItem{
+	domain.ItemLuckyClover:   {effect: domain.EffectSearchLuck, magnitude: 2.0, duration: time.Hour},
+	domain.ItemMerchantCharm: {effect: domain.EffectSellBonus, magnitude: 1.25, duration: time.Hour},
+	domain.ItemRabbitFoot:    {effect: domain.EffectGambleLuck, magnitude: 1.25, duration: time.Hour},
+	domain.ItemAegis:         {effect: domain.EffectTimeoutImmunity, magnitude: 1.0, duration: 30 * time.Minute},
+	domain.ItemXPTonic:       {effect: domain.EffectJobXPBoost, magnitude: 1.5, duration: time.Hour},
+}
+
+func handleEffect(ctx context.Context, ec EffectContext, user *domain.User, inventory *domain.Inventory, item *domain.Item, quantity int) (string, error) {
+	log := logger.FromContext(ctx)
+	log.Info(LogMsgHandleEffectCalled, "item", item.InternalName, "quantity", quantity)
+
+	cfg, ok := effectItems[item.InternalName]
+	if !ok {
+		return "", fmt.Errorf("%w: %s does not grant an effect", domain.ErrInvalidInput, item.InternalName)
+	}
+
+	totalAvailable := utils.GetTotalQuantity(inventory, item.ID)
+	if totalAvailable == 0 {
+		log.Warn(LogWarnEffectNotInInventory)
+		return "", domain.ErrNotInInventory
+	}
+	if totalAvailable < quantity {
+		log.Warn(LogWarnNotEnoughEffectItems)
+		return "", domain.ErrInsufficientQuantity
+	}
+
+	// Grant before consuming so a rejected grant (e.g. too long a duration)
+	// does not use up the items
+	effect, err := ec.GrantEffect(ctx, user.ID, cfg.effect, cfg.magnitude, cfg.duration*time.Duration(quantity))
+	if err != nil {
+		return "", err
+	}
+	if err := utils.ConsumeItems(inventory, item.ID, quantity, ec.RandomFloat); err != nil {
+		return "", err
+	}
+
+	displayName := ec.GetDisplayName(ctx, item.InternalName, "")
+	log.Info(LogMsgEffectActivated, "item", item.InternalName, "effect", cfg.effect, "expires_at", effect.ExpiresAt)
+
+	remaining := time.Until(effect.ExpiresAt).Round(time.Minute)
+	return fmt.Sprintf("Used %d %s! %s is active for %s.", quantity, displayName, effectDisplayNames[cfg.effect], remaining), nil
+}
+
+var effectDisplayNames = map[domain.EffectType]string{
+	domain.EffectSearchLuck:      "Search luck",
+	domain.EffectSellBonus:       "Sell bonus",
+	domain.EffectGambleLuck:      "Gamble luck",
+	domain.EffectTimeoutImmunity: "Timeout immunity",
+	domain.EffectJobXPBoost:      "Job XP boost",
+}
+
+// EffectHandler handles items that grant timed effects.
+type EffectHandler struct{}
+
+// CanHandle returns true for effect items.
+func (h *EffectHandler) CanHandle(itemName string) bool {
+	_, ok := effectItems[itemName]
+	return ok
+}
+
+// Handle processes effect activation.
+func (h *EffectHandler) Handle(ctx context.Context, ec EffectContext, user *domain.User, inventory *domain.Inventory, item *domain.Item, quantity int, args HandlerArgs) (string, error) {
+	return handleEffect(ctx, ec, user, inventory, item, quantity)
+}
@@ -18,6 +18,11 @@ type dropStats struct {
 }
 
 func HandleLootbox(ctx context.Context, ec EffectContext, user *domain.User, inventory *domain.Inventory, lootboxItem *domain.Item, quantity int) (string, error) {
+	return handleLootbox(ctx, ec, user, inventory, lootboxItem, quantity, nil)
+}
+
+// handleLootbox opens the lootboxes and, when result is non-nil, records the drops for reveal animations.
+func handleLootbox(ctx context.Context, ec EffectContext, user *domain.User, inventory *domain.Inventory, lootboxItem *domain.Item, quantity int, result *UseResult) (string, error) {
 	// 1. Consume lootboxes
 	consumedSlots, err := utils.ConsumeItemsWithTracking(inventory, lootboxItem.ID, quantity, ec.RandomFloat)
 	if err != nil {
@@ -30,7 +35,7 @@ func HandleLootbox(ctx context.Context, ec EffectContext, user *domain.User, inv
 	// 2. Open lootboxes
 	allDrops := make([]lootbox.DroppedItem, 0, quantity)
 	for _, slot := range consumedSlots {
-		drops, err := ec.OpenLootbox(ctx, lootboxItem.InternalName, slot.Quantity, slot.QualityLevel)
+		drops, err := ec.OpenLootbox(ctx, user.ID, lootboxItem.InternalName, slot.Quantity, slot.QualityLevel)
 		if err != nil {
 			logger.FromContext(ctx).Error("Failed to open lootbox", "error", err, "lootbox", lootboxItem.InternalName)
 			return "", fmt.Errorf("failed to open lootbox: %w", err)
@@ -38,6 +43,10 @@ func HandleLootbox(ctx context.Context, ec EffectContext, user *domain.User, inv
 		allDrops = append(allDrops, drops...)
 	}
 
+	if result != nil {
+		result.Drops = allDrops
+	}
+
 	if len(allDrops) == 0 {
 		return MsgLootboxEmpty, nil
 	}
@@ -72,7 +81,7 @@ func HandleLootboxDrops(ctx context.Context, ec EffectContext, user *domain.User
 	utils.AddItemsToInventory(inventory, itemsToAdd, nil)
 
 	// Build message
-	displayName := ec.GetDisplayName(lootboxItem.InternalName, "")
+	displayName := ec.GetDisplayName(ctx, lootboxItem.InternalName, "")
 	boxPart := fmt.Sprintf("%d %s", quantity, ec.Pluralize(displayName, quantity))
 	if quantity == 1 {
 		boxPart = fmt.Sprintf("%s %s", getIndefiniteArticle(displayName), displayName)
@@ -114,5 +123,5 @@ func (h *LootboxHandler) CanHandle(itemName string) bool {
 
 // Handle processes lootbox opening.
 func (h *LootboxHandler) Handle(ctx context.Context, ec EffectContext, user *domain.User, inventory *domain.Inventory, item *domain.Item, quantity int, args HandlerArgs) (string, error) {
-	return HandleLootbox(ctx, ec, user, inventory, item, quantity)
+	return handleLootbox(ctx, ec, user, inventory, item, quantity, args.Result)
 }
@@ -20,10 +20,11 @@ type Registry struct {
 	handlers []Handler
 }
 
-// NewRegistry creates a new handler registry with default handlers.
-func NewRegistry() *Registry {
+// NewRegistry creates a new handler registry with default handlers. Any
+// extra handlers are checked before the defaults.
+func NewRegistry(extra ...Handler) *Registry {
 	return &Registry{
-		handlers: []Handler{
+		handlers: append(extra,
 			&LootboxHandler{},
 			&TrapHandler{}, // Must come before WeaponHandler to avoid matching "explosive_" prefix
 			&WeaponHandler{},
@@ -34,7 +35,8 @@ func NewRegistry() *Registry {
 			&UtilityHandler{},
 			&VideoFilterHandler{},
 			&BombHandler{},
-		},
+			&EffectHandler{},
+		),
 	}
 }
 
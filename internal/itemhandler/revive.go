@@ -51,7 +51,7 @@ func handleRevive(ctx context.Context, ec EffectContext, inventory *domain.Inven
 		recovery := getReviveRecovery(item.InternalName) + slot.QualityLevel.GetTimeoutAdjustment()
 		totalRecovery += time.Duration(slot.Quantity) * recovery
 		if i == 0 {
-			displayName = ec.GetDisplayName(item.InternalName, slot.QualityLevel)
+			displayName = ec.GetDisplayName(ctx, item.InternalName, slot.QualityLevel)
 		}
 	}
 
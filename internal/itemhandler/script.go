@@ -0,0 +1,308 @@
+package itemhandler
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/job"
+	"github.com/osse101/BrandishBot_Go/internal/logger"
+	"github.com/osse101/BrandishBot_Go/internal/utils"
+)
+
+// Script action types
+const (
+	ActionGrantItem  = "grant_item"  // Adds Quantity of Item per use to the user's inventory
+	ActionGrantXP    = "grant_xp"    // Awards XP per use to Job
+	ActionTimeout    = "timeout"     // Times the user out for Seconds per use
+	ActionStartEvent = "start_event" // Starts the mini-event named by Event once per use
+)
+
+// Mini-events a script can start
+const (
+	// ScriptEventBomb queues a bomb that times out the crowd for Seconds
+	// once chat gathers and then slows down
+	ScriptEventBomb = "bomb"
+)
+
+var scriptQualities = map[domain.QualityLevel]bool{
+	domain.QualityCursed:    true,
+	domain.QualityJunk:      true,
+	domain.QualityPoor:      true,
+	domain.QualityCommon:    true,
+	domain.QualityUncommon:  true,
+	domain.QualityRare:      true,
+	domain.QualityEpic:      true,
+	domain.QualityLegendary: true,
+}
+
+// ScriptAction is one primitive step of a scripted item effect
+type ScriptAction struct {
+	Type     string              `json:"type"`
+	Item     string              `json:"item,omitempty"`
+	Quality  domain.QualityLevel `json:"quality,omitempty"`
+	Quantity int                 `json:"quantity,omitempty"`
+	Job      string              `json:"job,omitempty"`
+	XP       int                 `json:"xp,omitempty"`
+	Seconds  int                 `json:"seconds,omitempty"`
+	Event    string              `json:"event,omitempty"`
+}
+
+// ScriptedItem is a consumable whose effect is defined in config. Message
+// may use {user}, {quantity} and {item} placeholders.
+type ScriptedItem struct {
+	Item    string         `json:"item"`
+	Message string         `json:"message,omitempty"`
+	Actions []ScriptAction `json:"actions"`
+}
+
+// ScriptConfig is the top-level JSON structure for items/effects.json
+type ScriptConfig struct {
+	Version string         `json:"version,omitempty"`
+	Items   []ScriptedItem `json:"items"`
+}
+
+// Validate checks that each item is scripted once and every action has the
+// fields its type needs
+func (c ScriptConfig) Validate() error {
+	seen := make(map[string]bool, len(c.Items))
+	for i, item := range c.Items {
+		if item.Item == "" {
+			return fmt.Errorf("scripted item %d: item is required", i)
+		}
+		if seen[item.Item] {
+			return fmt.Errorf("item %q is scripted twice", item.Item)
+		}
+		seen[item.Item] = true
+		if len(item.Actions) == 0 {
+			return fmt.Errorf("item %q: at least one action is required", item.Item)
+		}
+		for j, action := range item.Actions {
+			if err := action.validate(); err != nil {
+				return fmt.Errorf("item %q action %d: %w", item.Item, j, err)
+			}
+		}
+	}
+	return nil
+}
+
+func (a ScriptAction) validate() error {
+	switch a.Type {
+	case ActionGrantItem:
+		if a.Item == "" || a.Quantity < 1 {
+			return fmt.Errorf("%s needs an item and a quantity of at least 1", a.Type)
+		}
+		if a.Quality != "" && !scriptQualities[a.Quality] {
+			return fmt.Errorf("%s: unknown quality %q", a.Type, a.Quality)
+		}
+	case ActionGrantXP:
+		if a.Job == "" || a.XP < 1 {
+			return fmt.Errorf("%s needs a job and xp of at least 1", a.Type)
+		}
+	case ActionTimeout:
+		if a.Seconds < 1 {
+			return fmt.Errorf("%s needs seconds of at least 1", a.Type)
+		}
+	case ActionStartEvent:
+		if a.Event != ScriptEventBomb {
+			return fmt.Errorf("%s: unknown event %q", a.Type, a.Event)
+		}
+		if a.Seconds < 1 {
+			return fmt.Errorf("%s needs seconds of at least 1", a.Type)
+		}
+	default:
+		return fmt.Errorf("unknown action type %q", a.Type)
+	}
+	return nil
+}
+
+// LoadScriptConfig reads and validates the scripted item effect config file
+func LoadScriptConfig(path string) (*ScriptConfig, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read item effect config: %w", err)
+	}
+
+	var config ScriptConfig
+	if err := json.Unmarshal(data, &config); err != nil {
+		return nil, fmt.Errorf("failed to parse item effect config: %w", err)
+	}
+
+	if err := config.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid item effect config: %w", err)
+	}
+
+	return &config, nil
+}
+
+// ScriptTable holds the scripted items and can be reloaded from its file
+type ScriptTable struct {
+	path string
+
+	mu    sync.RWMutex
+	items map[string]ScriptedItem
+}
+
+// NewScriptTable loads the scripted items from path
+func NewScriptTable(path string) (*ScriptTable, error) {
+	config, err := LoadScriptConfig(path)
+	if err != nil {
+		return nil, err
+	}
+	return &ScriptTable{path: path, items: indexScripts(config.Items)}, nil
+}
+
+// NewScriptTableFromConfig builds a table that cannot be reloaded, for tests
+// and callers that build the config themselves
+func NewScriptTableFromConfig(config ScriptConfig) (*ScriptTable, error) {
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
+	return &ScriptTable{items: indexScripts(config.Items)}, nil
+}
+
+func indexScripts(items []ScriptedItem) map[string]ScriptedItem {
+	index := make(map[string]ScriptedItem, len(items))
+	for _, item := range items {
+		index[item.Item] = item
+	}
+	return index
+}
+
+// Reload re-reads the scripts from their file, keeping the current ones if the file is invalid
+func (t *ScriptTable) Reload(ctx context.Context) error {
+	config, err := LoadScriptConfig(t.path)
+	if err != nil {
+		return err
+	}
+	t.mu.Lock()
+	t.items = indexScripts(config.Items)
+	t.mu.Unlock()
+	return nil
+}
+
+// Get returns the script for an item, if it has one
+func (t *ScriptTable) Get(itemName string) (ScriptedItem, bool) {
+	if t == nil {
+		return ScriptedItem{}, false
+	}
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	item, ok := t.items[itemName]
+	return item, ok
+}
+
+// ScriptedHandler runs the config-defined effects of scripted items. It is
+// checked before the built-in handlers, so a script replaces an item's
+// built-in effect.
+type ScriptedHandler struct {
+	table *ScriptTable
+}
+
+// NewScriptedHandler creates a handler for the items in table
+func NewScriptedHandler(table *ScriptTable) *ScriptedHandler {
+	return &ScriptedHandler{table: table}
+}
+
+// CanHandle returns true for items with a script.
+func (h *ScriptedHandler) CanHandle(itemName string) bool {
+	_, ok := h.table.Get(itemName)
+	return ok
+}
+
+// Handle consumes the items and runs each action of the item's script in order.
+func (h *ScriptedHandler) Handle(ctx context.Context, ec EffectContext, user *domain.User, inventory *domain.Inventory, item *domain.Item, quantity int, args HandlerArgs) (string, error) {
+	log := logger.FromContext(ctx)
+	log.Info(LogMsgHandleScriptCalled, "item", item.InternalName, "quantity", quantity)
+
+	script, ok := h.table.Get(item.InternalName)
+	if !ok {
+		return "", fmt.Errorf("%w: %s has no scripted effect", domain.ErrInvalidInput, item.InternalName)
+	}
+
+	totalAvailable := utils.GetTotalQuantity(inventory, item.ID)
+	if totalAvailable == 0 {
+		return "", domain.ErrNotInInventory
+	}
+	if totalAvailable < quantity {
+		return "", domain.ErrInsufficientQuantity
+	}
+
+	// Look up granted items before consuming anything, so a script naming a
+	// missing item fails without using up the user's items
+	grants := make(map[string]*domain.Item)
+	for _, action := range script.Actions {
+		if action.Type != ActionGrantItem || grants[action.Item] != nil {
+			continue
+		}
+		granted, err := ec.GetItemByName(ctx, action.Item)
+		if err != nil {
+			return "", fmt.Errorf("failed to get item %s: %w", action.Item, err)
+		}
+		if granted == nil {
+			return "", fmt.Errorf("%w: %s", domain.ErrItemNotFound, action.Item)
+		}
+		grants[action.Item] = granted
+	}
+
+	if err := utils.ConsumeItems(inventory, item.ID, quantity, ec.RandomFloat); err != nil {
+		return "", err
+	}
+
+	for _, action := range script.Actions {
+		if err := runScriptAction(ctx, ec, user, inventory, item, quantity, args, action, grants); err != nil {
+			return "", err
+		}
+	}
+
+	log.Info(LogMsgScriptRun, "item", item.InternalName, "quantity", quantity, "actions", len(script.Actions))
+
+	message := script.Message
+	if message == "" {
+		message = MsgScriptDefault
+	}
+	displayName := ec.Pluralize(ec.GetDisplayName(ctx, item.InternalName, ""), quantity)
+	return strings.NewReplacer(
+		"{user}", args.Username,
+		"{quantity}", strconv.Itoa(quantity),
+		"{item}", displayName,
+	).Replace(message), nil
+}
+
+// runScriptAction applies one action for every item used
+func runScriptAction(ctx context.Context, ec EffectContext, user *domain.User, inventory *domain.Inventory, item *domain.Item, quantity int, args HandlerArgs, action ScriptAction, grants map[string]*domain.Item) error {
+	switch action.Type {
+	case ActionGrantItem:
+		quality := action.Quality
+		if quality == "" {
+			quality = domain.QualityCommon
+		}
+		utils.AddItemsToInventory(inventory, []domain.InventorySlot{
+			{ItemID: grants[action.Item].ID, Quantity: action.Quantity * quantity, QualityLevel: quality},
+		}, nil)
+	case ActionGrantXP:
+		ec.PublishItemUsedEvent(ctx, user.ID, item.InternalName, quantity, map[string]interface{}{
+			"job_name":               action.Job,
+			"xp_total":               action.XP * quantity,
+			domain.MetadataKeySource: job.SourceItemScript,
+		})
+	case ActionTimeout:
+		duration := time.Duration(action.Seconds*quantity) * time.Second
+		if err := ec.TimeoutUser(ctx, args.Username, duration, fmt.Sprintf(MsgScriptTimeoutReasonFmt, item.InternalName)); err != nil {
+			return fmt.Errorf("failed to apply timeout: %w", err)
+		}
+	case ActionStartEvent:
+		for i := 0; i < quantity; i++ {
+			if err := ec.SetPendingBomb(ctx, args.Platform, args.Username, time.Duration(action.Seconds)*time.Second); err != nil {
+				return fmt.Errorf("failed to start %s: %w", action.Event, err)
+			}
+		}
+	}
+	return nil
+}
@@ -25,13 +25,21 @@ type HandlerArgs struct {
 	Platform       string
 	TargetUsername string
 	JobName        string
+	Result         *UseResult // optional; handlers record structured output here
+}
+
+// UseResult is the outcome of using an item: the chat message plus any
+// structured data clients need to present it.
+type UseResult struct {
+	Message string
+	Drops   []lootbox.DroppedItem // lootbox drops with reveal metadata, in roll order
 }
 
 // EffectContext provides the capabilities that item handlers need from the
 // broader service layer, without coupling to a concrete service implementation.
 type EffectContext interface {
 	// Naming
-	GetDisplayName(itemName string, quality domain.QualityLevel) string
+	GetDisplayName(ctx context.Context, itemName string, quality domain.QualityLevel) string
 	Pluralize(name string, quantity int) string
 
 	// Combat
@@ -40,10 +48,18 @@ type EffectContext interface {
 	ApplyShield(ctx context.Context, user *domain.User, quantity int, isMirror bool) error
 
 	// Targeting
-	GetRandomTarget(platform string) (username, userID string, err error)
 	GetRandomTargets(platform string, count int) ([]ActiveTarget, error)
 	RemoveActiveChatter(platform, userID string)
 
+	// Consent and counter-items
+	IsTargetingOptedOut(ctx context.Context, userID string) (bool, error)
+	ConsumeShield(ctx context.Context, userID string) (counterItem string, ok bool)
+	PublishTargetedEffect(ctx context.Context, effect domain.TargetedEffect)
+
+	// Timed effects
+	GrantEffect(ctx context.Context, userID string, effectType domain.EffectType, magnitude float64, duration time.Duration) (*domain.Effect, error)
+	HasEffect(ctx context.Context, userID string, effectType domain.EffectType) bool
+
 	// Items
 	GetItemByName(ctx context.Context, name string) (*domain.Item, error)
 
@@ -52,7 +68,7 @@ type EffectContext interface {
 	PublishItemUsedEvent(ctx context.Context, userID, itemName string, quantity int, metadata map[string]interface{})
 
 	// Lootbox
-	OpenLootbox(ctx context.Context, lootboxName string, quantity int, boxQuality domain.QualityLevel) ([]lootbox.DroppedItem, error)
+	OpenLootbox(ctx context.Context, userID, lootboxName string, quantity int, boxQuality domain.QualityLevel) ([]lootbox.DroppedItem, error)
 
 	// Traps
 	GetUserByPlatformUsername(ctx context.Context, platform, username string) (*domain.User, error)
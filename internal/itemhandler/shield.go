@@ -36,7 +36,7 @@ func handleShield(ctx context.Context, ec EffectContext, user *domain.User, inve
 		return "", fmt.Errorf("%w: failed to apply shield", domain.ErrInvalidInput)
 	}
 
-	displayName := ec.GetDisplayName(item.InternalName, "")
+	displayName := ec.GetDisplayName(ctx, item.InternalName, "")
 	log.Info(LogMsgShieldApplied, "item", item.InternalName, "quantity", quantity, "is_mirror", isMirror)
 
 	if isMirror {
@@ -0,0 +1,119 @@
+package itemhandler
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/logger"
+)
+
+// randomTargetPool is how many active chatters are drawn when an item needs a
+// single random target, so that opted-out users can be passed over
+const randomTargetPool = 10
+
+// counterOutcomes maps each counter-item to what it does to a targeted effect.
+// Counter-items are activated by using them and one charge is spent per attack.
+var counterOutcomes = map[string]domain.TargetOutcome{
+	domain.ItemShield:       domain.TargetOutcomeBlocked,
+	domain.ItemMirrorShield: domain.TargetOutcomeReflected,
+}
+
+// checkAttackerConsent stops users who opted out of targeted items from using them on others
+func checkAttackerConsent(ctx context.Context, ec EffectContext, attacker *domain.User) error {
+	optedOut, err := ec.IsTargetingOptedOut(ctx, attacker.ID)
+	if err != nil {
+		return err
+	}
+	if optedOut {
+		return domain.ErrTargetingDisabled
+	}
+	return nil
+}
+
+// resolveTarget looks up the user an item is aimed at and checks that they
+// accept targeted items. Targets without an account can still be hit; they
+// have no preferences or counter-items.
+func resolveTarget(ctx context.Context, ec EffectContext, platform, username string) (ActiveTarget, error) {
+	target := ActiveTarget{Username: username}
+	user, err := ec.GetUserByPlatformUsername(ctx, platform, username)
+	if err != nil && !errors.Is(err, domain.ErrUserNotFound) {
+		return target, err
+	}
+	if user == nil {
+		return target, nil
+	}
+	target.UserID = user.ID
+
+	optedOut, err := ec.IsTargetingOptedOut(ctx, user.ID)
+	if err != nil {
+		return target, err
+	}
+	if optedOut {
+		return target, domain.ErrTargetOptedOut
+	}
+	return target, nil
+}
+
+// consentingTargets drops randomly selected users who opted out of targeted
+// items. A failed lookup drops the user too, erring on the side of consent.
+func consentingTargets(ctx context.Context, ec EffectContext, targets []ActiveTarget) []ActiveTarget {
+	log := logger.FromContext(ctx)
+	result := make([]ActiveTarget, 0, len(targets))
+	for _, target := range targets {
+		optedOut, err := ec.IsTargetingOptedOut(ctx, target.UserID)
+		if err != nil {
+			log.Warn(LogWarnFailedToCheckConsent, "error", err, "target", target.Username)
+			continue
+		}
+		if !optedOut {
+			result = append(result, target)
+		}
+	}
+	return result
+}
+
+// strike lands a timeout on a target unless a timeout immunity effect or one
+// of their counter-items stops it, then publishes the encounter for both
+// sides. A reflected strike times out the attacker instead.
+func strike(ctx context.Context, ec EffectContext, attacker *domain.User, platform string, target ActiveTarget, item *domain.Item, timeout time.Duration, reason string) domain.TargetOutcome {
+	log := logger.FromContext(ctx)
+
+	outcome := domain.TargetOutcomeHit
+	var counterItem string
+	if target.UserID != "" {
+		// Immunity is checked first so it does not cost a shield charge
+		if ec.HasEffect(ctx, target.UserID, domain.EffectTimeoutImmunity) {
+			outcome = domain.TargetOutcomeImmune
+		} else if counter, ok := ec.ConsumeShield(ctx, target.UserID); ok {
+			counterItem = counter
+			outcome = counterOutcomes[counter]
+		}
+	}
+
+	switch outcome {
+	case domain.TargetOutcomeHit:
+		if err := ec.TimeoutUser(ctx, target.Username, timeout, reason); err != nil {
+			log.Error(LogWarnFailedToTimeoutUser, "error", err, "target", target.Username)
+		}
+	case domain.TargetOutcomeReflected:
+		if err := ec.TimeoutUser(ctx, attacker.Username, timeout, MsgReflectedReasonBy+target.Username); err != nil {
+			log.Error(LogWarnFailedToTimeoutUser, "error", err, "target", attacker.Username)
+		}
+	}
+
+	log.Info(LogMsgTargetResolved, "target", target.Username, "item", item.InternalName, "outcome", outcome)
+	ec.PublishTargetedEffect(ctx, domain.TargetedEffect{
+		Platform:         platform,
+		AttackerID:       attacker.ID,
+		AttackerUsername: attacker.Username,
+		DefenderID:       target.UserID,
+		DefenderUsername: target.Username,
+		ItemName:         item.InternalName,
+		Outcome:          outcome,
+		CounterItem:      counterItem,
+		Timeout:          timeout,
+	})
+	return outcome
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/google/uuid"
 
 	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/ids"
 	"github.com/osse101/BrandishBot_Go/internal/logger"
 	"github.com/osse101/BrandishBot_Go/internal/utils"
 )
@@ -47,6 +48,9 @@ func handleTrap(ctx context.Context, ec EffectContext, user *domain.User, invent
 
 func getTrapTargets(ctx context.Context, ec EffectContext, item *domain.Item, quantity int, user *domain.User, platform string, args HandlerArgs) ([]string, bool, error) {
 	log := logger.FromContext(ctx)
+	if err := checkAttackerConsent(ctx, ec, user); err != nil {
+		return nil, false, err
+	}
 	if item.InternalName == domain.ItemMine {
 		log.Info("Mine used, selecting random targets", "count", quantity)
 		targets, err := ec.GetRandomTargets(platform, quantity)
@@ -54,7 +58,7 @@ func getTrapTargets(ctx context.Context, ec EffectContext, item *domain.Item, qu
 			return []string{user.Username}, true, nil
 		}
 		var potentialTargets []string
-		for _, t := range targets {
+		for _, t := range consentingTargets(ctx, ec, targets) {
 			potentialTargets = append(potentialTargets, t.Username)
 		}
 		if len(potentialTargets) == 0 {
@@ -71,6 +75,9 @@ func getTrapTargets(ctx context.Context, ec EffectContext, item *domain.Item, qu
 	if targetUsername == "" {
 		return nil, false, fmt.Errorf("%w: target username is required for weapon", domain.ErrInvalidInput)
 	}
+	if _, err := resolveTarget(ctx, ec, platform, targetUsername); err != nil {
+		return nil, false, err
+	}
 	return []string{targetUsername}, false, nil
 }
 
@@ -187,7 +194,7 @@ func processTrapTargets(ctx context.Context, ec EffectContext, user *domain.User
 		trapsPlaced++
 		userID, _ := uuid.Parse(user.ID)
 		newTrap := &domain.Trap{
-			ID:             uuid.New(),
+			ID:             ids.New(),
 			SetterID:       userID,
 			TargetID:       targetUserID,
 			QualityLevel:   qualityLevel,
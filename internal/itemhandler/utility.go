@@ -77,7 +77,7 @@ func handleResourceGenerator(ctx context.Context, ec EffectContext, inventory *d
 		{ItemID: stickItem.ID, Quantity: sticksGenerated, QualityLevel: domain.QualityCommon},
 	}, nil)
 
-	displayName := ec.GetDisplayName(domain.ItemStick, "")
+	displayName := ec.GetDisplayName(ctx, domain.ItemStick, "")
 	return fmt.Sprintf("%s%d %s!", username+MsgShovelUsed, sticksGenerated, displayName), nil
 }
 
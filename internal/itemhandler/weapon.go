@@ -12,7 +12,7 @@ import (
 	"github.com/osse101/BrandishBot_Go/internal/utils"
 )
 
-func handleWeapon(ctx context.Context, ec EffectContext, _ *domain.User, inventory *domain.Inventory, item *domain.Item, quantity int, args HandlerArgs) (string, error) {
+func handleWeapon(ctx context.Context, ec EffectContext, user *domain.User, inventory *domain.Inventory, item *domain.Item, quantity int, args HandlerArgs) (string, error) {
 	log := logger.FromContext(ctx)
 	log.Info(LogMsgHandleWeaponCalled, "item", item.InternalName, "quantity", quantity)
 
@@ -31,6 +31,25 @@ func handleWeapon(ctx context.Context, ec EffectContext, _ *domain.User, invento
 		return "", domain.ErrInsufficientQuantity
 	}
 
+	// Consent is checked before anything is consumed; This only ever hits its user
+	var target ActiveTarget
+	if item.InternalName != domain.ItemThis {
+		if err := checkAttackerConsent(ctx, ec, user); err != nil {
+			return "", err
+		}
+		if item.InternalName != domain.ItemTNT && item.InternalName != domain.ItemGrenade {
+			// Standard weapons require a user-provided target
+			if targetUsername == "" {
+				log.Warn(LogWarnTargetUsernameMissingWeapon)
+				return "", fmt.Errorf("%w: target username is required for weapon", domain.ErrInvalidInput)
+			}
+			var err error
+			if target, err = resolveTarget(ctx, ec, platform, targetUsername); err != nil {
+				return "", err
+			}
+		}
+	}
+
 	consumedSlots, err := utils.ConsumeItemsWithTracking(inventory, item.ID, quantity, ec.RandomFloat)
 	if err != nil {
 		return "", err
@@ -42,90 +61,104 @@ func handleWeapon(ctx context.Context, ec EffectContext, _ *domain.User, invento
 		baseTimeout := getWeaponTimeout(item.InternalName) + slot.QualityLevel.GetTimeoutAdjustment()
 		timeout += baseTimeout * time.Duration(slot.Quantity)
 		if i == 0 {
-			displayName = ec.GetDisplayName(item.InternalName, slot.QualityLevel)
+			displayName = ec.GetDisplayName(ctx, item.InternalName, slot.QualityLevel)
 		}
 	}
 
 	// Route to special handlers if applicable
 	switch item.InternalName {
 	case domain.ItemTNT:
-		return handleTNT(ctx, ec, username, platform, timeout, displayName)
+		return handleTNT(ctx, ec, user, item, platform, timeout, displayName)
 	case domain.ItemGrenade:
-		return handleGrenade(ctx, ec, username, platform, timeout)
+		return handleGrenade(ctx, ec, user, item, platform, timeout)
 	case domain.ItemThis:
 		return handleThis(ctx, ec, username, timeout, displayName)
 	}
 
-	// Standard weapons require a user-provided target
-	if targetUsername == "" {
-		log.Warn(LogWarnTargetUsernameMissingWeapon)
-		return "", fmt.Errorf("%w: target username is required for weapon", domain.ErrInvalidInput)
-	}
+	outcome := strike(ctx, ec, user, platform, target, item, timeout, MsgBlasterReasonBy+username)
 
-	// Apply timeout
-	if err := ec.TimeoutUser(ctx, targetUsername, timeout, MsgBlasterReasonBy+username); err != nil {
-		log.Error(LogWarnFailedToTimeoutUser, "error", err, "target", targetUsername)
-		// Continue anyway, as the item was used
+	log.Info(LogMsgWeaponUsed, "target", targetUsername, "item", item.InternalName, "quantity", quantity, "outcome", outcome)
+	switch outcome {
+	case domain.TargetOutcomeBlocked:
+		return fmt.Sprintf("%s's shield blocks the %s!", targetUsername, displayName), nil
+	case domain.TargetOutcomeReflected:
+		return fmt.Sprintf("%s's mirror shield reflects the %s back at %s!", targetUsername, displayName, username), nil
+	case domain.TargetOutcomeImmune:
+		return fmt.Sprintf("The %s can't touch %s, who is immune to timeouts!", displayName, targetUsername), nil
 	}
-
-	log.Info(LogMsgWeaponUsed, "target", targetUsername, "item", item.InternalName, "quantity", quantity)
 	return fmt.Sprintf("A %s hits %s!", displayName, targetUsername), nil
 }
 
-func handleTNT(ctx context.Context, ec EffectContext, username, platform string, timeout time.Duration, displayName string) (string, error) {
+func handleTNT(ctx context.Context, ec EffectContext, user *domain.User, item *domain.Item, platform string, timeout time.Duration, displayName string) (string, error) {
 	log := logger.FromContext(ctx)
 	log.Info("TNT used, selecting 5-9 random targets")
 
-	// Select 5-9 random targets
+	// Select 5-9 random targets, passing over anyone who opted out
 	numTargets := 5 + rand.Intn(5) //nolint:gosec // weak random is fine for games
 	targets, err := ec.GetRandomTargets(platform, numTargets)
-	if err != nil {
+	if err == nil {
+		targets = consentingTargets(ctx, ec, targets)
+	}
+	if err != nil || len(targets) == 0 {
 		log.Warn("No active targets available for TNT", "error", err)
 		return "", fmt.Errorf("%w: no active users to target", domain.ErrInvalidInput)
 	}
 
-	// Apply timeout to all targets and collect names
+	// Strike all targets and collect the names of those hit
 	hitUsernames := make([]string, 0, len(targets))
+	countered := 0
 	for _, target := range targets {
-		if err := ec.TimeoutUser(ctx, target.Username, timeout, MsgTNTReasonBy+username); err != nil {
-			log.Error(LogWarnFailedToTimeoutUser, "error", err, "target", target.Username)
-			// Continue with other targets even if one fails
+		if strike(ctx, ec, user, platform, target, item, timeout, MsgTNTReasonBy+user.Username) == domain.TargetOutcomeHit {
+			hitUsernames = append(hitUsernames, target.Username)
+		} else {
+			countered++
 		}
 
 		// Remove from active chatters
 		ec.RemoveActiveChatter(platform, target.UserID)
-		hitUsernames = append(hitUsernames, target.Username)
 	}
 
-	log.Info("TNT hits multiple targets", "count", len(hitUsernames), "targets", hitUsernames)
+	log.Info("TNT hits multiple targets", "count", len(hitUsernames), "targets", hitUsernames, "countered", countered)
 
 	// Format message with all hit users
 	targetsStr := FormatTargetList(hitUsernames)
-	return fmt.Sprintf("%s used a %s! Hit %d targets: %s!",
-		username, displayName, len(hitUsernames), targetsStr), nil
+	msg := fmt.Sprintf("%s used a %s! Hit %d targets: %s!",
+		user.Username, displayName, len(hitUsernames), targetsStr)
+	if countered > 0 {
+		msg += fmt.Sprintf(" %d protected.", countered)
+	}
+	return msg, nil
 }
 
-func handleGrenade(ctx context.Context, ec EffectContext, username, platform string, timeout time.Duration) (string, error) {
+func handleGrenade(ctx context.Context, ec EffectContext, user *domain.User, item *domain.Item, platform string, timeout time.Duration) (string, error) {
 	log := logger.FromContext(ctx)
 	log.Info("Grenade used, selecting single random target")
 
-	randomUsername, randomUserID, err := ec.GetRandomTarget(platform)
-	if err != nil {
+	candidates, err := ec.GetRandomTargets(platform, randomTargetPool)
+	if err == nil {
+		candidates = consentingTargets(ctx, ec, candidates)
+	}
+	if err != nil || len(candidates) == 0 {
 		log.Warn("No active targets available for grenade", "error", err)
 		return "", fmt.Errorf("%w: no active users to target", domain.ErrInvalidInput)
 	}
+	target := candidates[0]
 
-	// Apply timeout
-	if err := ec.TimeoutUser(ctx, randomUsername, timeout, MsgGrenadeReasonBy+username); err != nil {
-		log.Error(LogWarnFailedToTimeoutUser, "error", err, "target", randomUsername)
-		// Continue anyway, as the item was used
-	}
+	outcome := strike(ctx, ec, user, platform, target, item, timeout, MsgGrenadeReasonBy+user.Username)
 
 	// Remove from active chatters
-	ec.RemoveActiveChatter(platform, randomUserID)
-	log.Info("Grenade hit target", "target", randomUsername)
-
-	return fmt.Sprintf("%s is blown up!", randomUsername), nil
+	ec.RemoveActiveChatter(platform, target.UserID)
+	log.Info("Grenade hit target", "target", target.Username, "outcome", outcome)
+
+	switch outcome {
+	case domain.TargetOutcomeBlocked:
+		return fmt.Sprintf("%s's shield absorbs the blast!", target.Username), nil
+	case domain.TargetOutcomeReflected:
+		return fmt.Sprintf("%s's mirror shield throws the grenade back at %s!", target.Username, user.Username), nil
+	case domain.TargetOutcomeImmune:
+		return fmt.Sprintf("%s shrugs off the blast, immune to timeouts!", target.Username), nil
+	}
+	return fmt.Sprintf("%s is blown up!", target.Username), nil
 }
 
 func handleThis(ctx context.Context, ec EffectContext, username string, timeout time.Duration, displayName string) (string, error) {
@@ -0,0 +1,25 @@
+package jackpot
+
+import "math"
+
+// RecentWinsLimit is how many past wins the jackpot status lists
+const RecentWinsLimit = 5
+
+// noMaxBalance stands in for a maximum of 0, which lets the jackpot grow
+// without limit
+const noMaxBalance = math.MaxInt64
+
+// Error messages
+const (
+	ErrMsgDepositFailed   = "failed to add to jackpot: %w"
+	ErrMsgClaimFailed     = "failed to claim jackpot: %w"
+	ErrMsgRecordWinFailed = "failed to record jackpot win: %w"
+	ErrMsgPayoutFailed    = "failed to pay out jackpot: %w"
+)
+
+// Log messages
+const (
+	LogMsgJackpotWon         = "Jackpot won"
+	LogWarnRecordOpeningFail = "Failed to record opening for jackpot"
+	LogWarnLookupWinnerFail  = "Failed to look up jackpot winner"
+)
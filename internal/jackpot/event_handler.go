@@ -0,0 +1,69 @@
+package jackpot
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/event"
+	"github.com/osse101/BrandishBot_Go/internal/logger"
+)
+
+// EventHandler feeds lootbox openings and gambles into the jackpot
+type EventHandler struct {
+	service Service
+}
+
+// NewEventHandler creates a new jackpot event handler
+func NewEventHandler(service Service) *EventHandler {
+	return &EventHandler{service: service}
+}
+
+// Register subscribes the handler to item use and gamble completion. Both
+// are local so each opening is counted and rolled exactly once.
+func (h *EventHandler) Register(bus event.Bus) {
+	bus.Subscribe(event.Type(domain.EventTypeItemUsed), h.HandleItemUsed)
+	bus.Subscribe(event.Type(domain.EventGambleCompleted), h.HandleGambleCompleted)
+}
+
+// HandleItemUsed records lootbox openings, which carry the value of their
+// drops in the event metadata
+func (h *EventHandler) HandleItemUsed(ctx context.Context, evt event.Event) error {
+	payload, err := event.DecodePayload[domain.ItemUsedPayload](evt.Payload)
+	if err != nil {
+		return fmt.Errorf("failed to decode item used payload: %w", err)
+	}
+
+	metadata, ok := payload.Metadata.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	var value int
+	switch v := metadata[domain.MetadataKeyLootboxValue].(type) {
+	case float64:
+		value = int(v)
+	case int:
+		value = v
+	default:
+		return nil
+	}
+
+	if _, err := h.service.RecordOpening(ctx, payload.UserID, domain.JackpotSourceLootbox, value); err != nil {
+		logger.FromContext(ctx).Warn(LogWarnRecordOpeningFail, "user_id", payload.UserID, "source", domain.JackpotSourceLootbox, "error", err)
+	}
+	return nil
+}
+
+// HandleGambleCompleted records a gamble's lootboxes as one opening by the
+// winner
+func (h *EventHandler) HandleGambleCompleted(ctx context.Context, evt event.Event) error {
+	payload, err := event.DecodePayload[domain.GambleCompletedPayloadV2](evt.Payload)
+	if err != nil {
+		return fmt.Errorf("failed to decode gamble completed payload: %w", err)
+	}
+
+	if _, err := h.service.RecordOpening(ctx, payload.WinnerID, domain.JackpotSourceGamble, int(payload.TotalValue)); err != nil {
+		logger.FromContext(ctx).Warn(LogWarnRecordOpeningFail, "user_id", payload.WinnerID, "source", domain.JackpotSourceGamble, "error", err)
+	}
+	return nil
+}
@@ -0,0 +1,37 @@
+package jackpot
+
+import (
+	"context"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/repository"
+)
+
+// Repository stores the jackpot's balance and its past wins. Each community
+// has its own jackpot, picked by the context.
+type Repository interface {
+	BeginTx(ctx context.Context) (Tx, error)
+
+	// GetBalance returns the money the jackpot holds
+	GetBalance(ctx context.Context) (int64, error)
+
+	// Deposit credits the jackpot, without raising it past maxBalance, and
+	// returns its new balance
+	Deposit(ctx context.Context, amount, maxBalance int64) (int64, error)
+
+	// GetRecentWins returns up to limit wins, most recent first
+	GetRecentWins(ctx context.Context, limit int) ([]domain.JackpotWin, error)
+}
+
+// Tx empties the jackpot into a winner's inventory and records the win in
+// one transaction
+type Tx interface {
+	repository.Tx
+	repository.InventoryAdjuster
+
+	// Claim empties the jackpot and returns what it held, or 0 when it was
+	// already empty
+	Claim(ctx context.Context) (int64, error)
+
+	RecordWin(ctx context.Context, userID string, amount int64, source string) error
+}
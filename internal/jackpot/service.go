@@ -0,0 +1,178 @@
+package jackpot
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"time"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/event"
+	"github.com/osse101/BrandishBot_Go/internal/logger"
+	"github.com/osse101/BrandishBot_Go/internal/repository"
+	"github.com/osse101/BrandishBot_Go/internal/rng"
+)
+
+// Service runs the progressive jackpot. Every lootbox opening and gamble adds
+// a cut of the value opened to the pool, paid by the house, and has a chance
+// of winning all of it. The house's cut is new money, so the pool stops
+// growing at its maximum balance to bound what one win can mint.
+type Service interface {
+	// RecordOpening adds the jackpot's cut of an opening worth value and
+	// rolls the trigger chance for the user who opened it. It returns the
+	// win, or nil when the roll missed, the jackpot was empty or there is no
+	// user to pay.
+	RecordOpening(ctx context.Context, userID, source string, value int) (*domain.JackpotWin, error)
+
+	// GetStatus returns the jackpot's balance, settings and recent wins
+	GetStatus(ctx context.Context) (*domain.JackpotStatus, error)
+}
+
+// UserLookup finds the winner so wins can be announced by name
+type UserLookup interface {
+	GetUserByID(ctx context.Context, userID string) (*domain.User, error)
+}
+
+// ItemLookup finds items by name
+type ItemLookup interface {
+	GetItemByName(ctx context.Context, itemName string) (*domain.Item, error)
+}
+
+// Publisher publishes jackpot win events
+type Publisher interface {
+	PublishWithRetry(ctx context.Context, evt event.Event)
+}
+
+// Option configures optional service dependencies
+type Option func(*service)
+
+// WithRNG draws trigger rolls from the seeded RNG provider
+func WithRNG(provider *rng.Provider) Option {
+	return func(s *service) {
+		s.rng = provider
+	}
+}
+
+type service struct {
+	repo                Repository
+	users               UserLookup
+	items               ItemLookup
+	publisher           Publisher
+	contributionPercent int
+	triggerChance       float64
+	maxBalance          int64
+	rng                 *rng.Provider
+}
+
+// NewService creates a jackpot service that adds contributionPercent of each
+// opening's value to the pool, up to maxBalance (0 for no maximum), and pays
+// it out with triggerChance per opening. publisher may be nil.
+func NewService(repo Repository, users UserLookup, items ItemLookup, publisher Publisher, contributionPercent int, triggerChance float64, maxBalance int64, opts ...Option) Service {
+	if maxBalance < 0 {
+		maxBalance = 0
+	}
+	s := &service{
+		repo:                repo,
+		users:               users,
+		items:               items,
+		publisher:           publisher,
+		contributionPercent: contributionPercent,
+		triggerChance:       triggerChance,
+		maxBalance:          maxBalance,
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+func (s *service) RecordOpening(ctx context.Context, userID, source string, value int) (*domain.JackpotWin, error) {
+	if cut := value * s.contributionPercent / 100; cut > 0 {
+		maxBalance := s.maxBalance
+		if maxBalance == 0 {
+			maxBalance = noMaxBalance
+		}
+		if _, err := s.repo.Deposit(ctx, int64(cut), maxBalance); err != nil {
+			return nil, fmt.Errorf(ErrMsgDepositFailed, err)
+		}
+	}
+
+	if userID == "" || s.triggerChance <= 0 || s.source(ctx).Float64() >= s.triggerChance {
+		return nil, nil
+	}
+	return s.payout(ctx, userID, source)
+}
+
+// payout empties the jackpot into the winner's inventory as money
+func (s *service) payout(ctx context.Context, userID, source string) (*domain.JackpotWin, error) {
+	money, err := repository.MoneyItem(ctx, s.items)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := s.repo.BeginTx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer repository.SafeRollback(ctx, tx)
+
+	amount, err := tx.Claim(ctx)
+	if err != nil {
+		return nil, fmt.Errorf(ErrMsgClaimFailed, err)
+	}
+	if amount == 0 {
+		return nil, nil
+	}
+	if _, err := tx.AdjustItemQuantity(ctx, userID, money.ID, domain.QualityCommon, int(amount)); err != nil {
+		return nil, fmt.Errorf(ErrMsgPayoutFailed, err)
+	}
+	if err := tx.RecordWin(ctx, userID, amount, source); err != nil {
+		return nil, fmt.Errorf(ErrMsgRecordWinFailed, err)
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	win := &domain.JackpotWin{
+		UserID: userID,
+		Amount: amount,
+		Source: source,
+		WonAt:  time.Now(),
+	}
+	if user, err := s.users.GetUserByID(ctx, userID); err != nil {
+		logger.FromContext(ctx).Warn(LogWarnLookupWinnerFail, "user_id", userID, "error", err)
+	} else if user != nil {
+		win.Username = user.Username
+	}
+
+	logger.FromContext(ctx).Info(LogMsgJackpotWon, "user_id", userID, "amount", amount, "source", source)
+	if s.publisher != nil {
+		s.publisher.PublishWithRetry(ctx, event.NewJackpotWonEvent(win))
+	}
+	return win, nil
+}
+
+func (s *service) GetStatus(ctx context.Context) (*domain.JackpotStatus, error) {
+	balance, err := s.repo.GetBalance(ctx)
+	if err != nil {
+		return nil, err
+	}
+	wins, err := s.repo.GetRecentWins(ctx, RecentWinsLimit)
+	if err != nil {
+		return nil, err
+	}
+	return &domain.JackpotStatus{
+		Balance:             balance,
+		ContributionPercent: s.contributionPercent,
+		TriggerChance:       s.triggerChance,
+		MaxBalance:          s.maxBalance,
+		RecentWins:          wins,
+	}, nil
+}
+
+func (s *service) source(ctx context.Context) rng.Source {
+	if s.rng != nil {
+		return s.rng.ForOperation(ctx, rng.OpJackpotTrigger)
+	}
+	return rand.New(rand.NewSource(time.Now().UnixNano())) //nolint:gosec // Game logic randomness, not security critical
+}
@@ -0,0 +1,154 @@
+package jackpot_test
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/event"
+	"github.com/osse101/BrandishBot_Go/internal/jackpot"
+	"github.com/osse101/BrandishBot_Go/internal/jackpot/mocks"
+)
+
+const (
+	winnerID = "winner-1"
+	moneyID  = 7
+)
+
+func TestRecordOpening(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("adds the cut without a win", func(t *testing.T) {
+		repo := mocks.NewMockRepository(t)
+		svc := jackpot.NewService(repo, nil, nil, nil, 2, 0, 0)
+		repo.On("Deposit", ctx, int64(10), int64(math.MaxInt64)).Return(int64(110), nil)
+
+		win, err := svc.RecordOpening(ctx, winnerID, domain.JackpotSourceLootbox, 500)
+
+		require.NoError(t, err)
+		assert.Nil(t, win)
+	})
+
+	t.Run("skips a cut that rounds to nothing", func(t *testing.T) {
+		repo := mocks.NewMockRepository(t)
+		svc := jackpot.NewService(repo, nil, nil, nil, 2, 0, 0)
+
+		win, err := svc.RecordOpening(ctx, winnerID, domain.JackpotSourceLootbox, 49)
+
+		require.NoError(t, err)
+		assert.Nil(t, win)
+	})
+
+	t.Run("pays the whole pool to the winner", func(t *testing.T) {
+		repo := mocks.NewMockRepository(t)
+		tx := mocks.NewMockTx(t)
+		users := mocks.NewMockUserLookup(t)
+		items := mocks.NewMockItemLookup(t)
+		publisher := mocks.NewMockPublisher(t)
+		svc := jackpot.NewService(repo, users, items, publisher, 2, 1, 0)
+
+		repo.On("Deposit", ctx, int64(20), int64(math.MaxInt64)).Return(int64(1020), nil)
+		items.On("GetItemByName", ctx, domain.ItemMoney).Return(&domain.Item{ID: moneyID}, nil)
+		repo.On("BeginTx", ctx).Return(tx, nil)
+		tx.On("Claim", ctx).Return(int64(1020), nil)
+		tx.On("AdjustItemQuantity", ctx, winnerID, moneyID, domain.QualityCommon, 1020).Return(1020, nil)
+		tx.On("RecordWin", ctx, winnerID, int64(1020), domain.JackpotSourceGamble).Return(nil)
+		tx.On("Commit", ctx).Return(nil)
+		tx.On("Rollback", ctx).Return(nil).Maybe()
+		users.On("GetUserByID", ctx, winnerID).Return(&domain.User{ID: winnerID, Username: "lucky"}, nil)
+		publisher.On("PublishWithRetry", ctx, mock.MatchedBy(func(evt event.Event) bool {
+			payload, ok := evt.Payload.(event.JackpotWonPayloadV1)
+			return evt.Type == event.JackpotWon && ok && payload.Amount == 1020 && payload.Username == "lucky"
+		})).Return()
+
+		win, err := svc.RecordOpening(ctx, winnerID, domain.JackpotSourceGamble, 1000)
+
+		require.NoError(t, err)
+		require.NotNil(t, win)
+		assert.Equal(t, int64(1020), win.Amount)
+		assert.Equal(t, "lucky", win.Username)
+	})
+
+	t.Run("an empty pool pays nothing", func(t *testing.T) {
+		repo := mocks.NewMockRepository(t)
+		tx := mocks.NewMockTx(t)
+		items := mocks.NewMockItemLookup(t)
+		svc := jackpot.NewService(repo, nil, items, nil, 0, 1, 0)
+
+		items.On("GetItemByName", ctx, domain.ItemMoney).Return(&domain.Item{ID: moneyID}, nil)
+		repo.On("BeginTx", ctx).Return(tx, nil)
+		tx.On("Claim", ctx).Return(int64(0), nil)
+		tx.On("Rollback", ctx).Return(nil)
+
+		win, err := svc.RecordOpening(ctx, winnerID, domain.JackpotSourceLootbox, 100)
+
+		require.NoError(t, err)
+		assert.Nil(t, win)
+	})
+
+	t.Run("the cut stops at the maximum balance", func(t *testing.T) {
+		repo := mocks.NewMockRepository(t)
+		svc := jackpot.NewService(repo, nil, nil, nil, 2, 0, 1000)
+		repo.On("Deposit", ctx, int64(10), int64(1000)).Return(int64(1000), nil)
+
+		win, err := svc.RecordOpening(ctx, winnerID, domain.JackpotSourceLootbox, 500)
+
+		require.NoError(t, err)
+		assert.Nil(t, win)
+	})
+
+	t.Run("contributes without rolling when nobody opened it", func(t *testing.T) {
+		repo := mocks.NewMockRepository(t)
+		svc := jackpot.NewService(repo, nil, nil, nil, 10, 1, 0)
+		repo.On("Deposit", ctx, int64(30), int64(math.MaxInt64)).Return(int64(30), nil)
+
+		win, err := svc.RecordOpening(ctx, "", domain.JackpotSourceGamble, 300)
+
+		require.NoError(t, err)
+		assert.Nil(t, win)
+	})
+}
+
+func TestGetStatus(t *testing.T) {
+	ctx := context.Background()
+	repo := mocks.NewMockRepository(t)
+	svc := jackpot.NewService(repo, nil, nil, nil, 2, 0.001, 5000)
+	wins := []domain.JackpotWin{{UserID: winnerID, Username: "lucky", Amount: 900, Source: domain.JackpotSourceLootbox}}
+	repo.On("GetBalance", ctx).Return(int64(450), nil)
+	repo.On("GetRecentWins", ctx, jackpot.RecentWinsLimit).Return(wins, nil)
+
+	status, err := svc.GetStatus(ctx)
+
+	require.NoError(t, err)
+	assert.Equal(t, &domain.JackpotStatus{Balance: 450, ContributionPercent: 2, TriggerChance: 0.001, MaxBalance: 5000, RecentWins: wins}, status)
+}
+
+func TestEventHandler(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("records lootbox openings from item use", func(t *testing.T) {
+		repo := mocks.NewMockRepository(t)
+		handler := jackpot.NewEventHandler(jackpot.NewService(repo, nil, nil, nil, 10, 0, 0))
+		repo.On("Deposit", ctx, int64(25), int64(math.MaxInt64)).Return(int64(25), nil)
+
+		err := handler.HandleItemUsed(ctx, event.NewItemUsedEvent(winnerID, "lootbox_tier1", 1, map[string]interface{}{
+			domain.MetadataKeyLootboxValue: 250,
+		}))
+
+		require.NoError(t, err)
+	})
+
+	t.Run("ignores other items", func(t *testing.T) {
+		repo := mocks.NewMockRepository(t)
+		handler := jackpot.NewEventHandler(jackpot.NewService(repo, nil, nil, nil, 10, 1, 0))
+
+		err := handler.HandleItemUsed(ctx, event.NewItemUsedEvent(winnerID, "blaster", 1, map[string]interface{}{"target": "bob"}))
+
+		require.NoError(t, err)
+	})
+}
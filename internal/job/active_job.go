@@ -0,0 +1,43 @@
+package job
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/logger"
+)
+
+// SwitchActiveJob makes a job the user's active job, which earns bonus XP.
+// Switching is on a per-user cooldown; choosing the job that is already
+// active does not use it up.
+func (s *service) SwitchActiveJob(ctx context.Context, platform, platformID, jobKey string) (*domain.UserJobInfo, error) {
+	user, job, progress, err := s.loadUserJob(ctx, platform, platformID, jobKey)
+	if err != nil {
+		return nil, err
+	}
+	if progress != nil && progress.IsActive {
+		return nil, fmt.Errorf("%s: %w", jobKey, domain.ErrJobAlreadyActive)
+	}
+
+	switchJob := func() error {
+		return s.repo.SetActiveJob(ctx, user.ID, job.ID)
+	}
+	if s.cooldowns != nil {
+		err = s.cooldowns.EnforceCooldown(ctx, user.ID, domain.ActionJobSwitch, switchJob)
+	} else {
+		err = switchJob()
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if progress == nil {
+		progress = &domain.UserJob{UserID: user.ID, JobID: job.ID}
+	}
+	progress.IsActive = true
+
+	logger.FromContext(ctx).Info("Switched active job", "user_id", user.ID, "job", jobKey)
+	info := s.buildJobInfo(*job, progress, s.getMaxJobLevel(ctx))
+	return &info, nil
+}
@@ -26,6 +26,38 @@ const (
 	DefaultDailyCap = 500
 )
 
+// Active job and prestige
+const (
+	// ActiveJobXPBonus is the extra XP share earned in the user's active job (10%)
+	ActiveJobXPBonus = 0.10
+
+	// PrestigeXPBonusPerLevel is the permanent extra XP share earned in a job
+	// for each time it was prestiged (2%)
+	PrestigeXPBonusPerLevel = 0.02
+
+	// MaxPrestige is how many times a single job can be prestiged
+	MaxPrestige = 5
+)
+
+// XP multiplier sources reported in an award's breakdown
+const (
+	XPSourceProgression = "progression" // Progression tree upgrades
+	XPSourceBoost       = "xp_boost"    // Timed boosts granted as rewards
+	XPSourceBooster     = "booster"     // Consumable XP booster items
+	XPSourceCommunity   = "community"   // Community-wide bonuses such as raids
+	XPSourceActiveJob   = "active_job"  // The user's active job
+	XPSourcePrestige    = "prestige"    // The job's prestige
+	XPSourceEvent       = "event"       // Active global XP events
+	XPSourceGameEvent   = "game_event"  // Running admin game events
+	XPSourceEpiphany    = "epiphany"    // Random critical XP
+)
+
+// Global XP event limits
+const (
+	// MaxXPEventMultiplier caps a single global XP event so a mistyped config can't flood XP
+	MaxXPEventMultiplier = 3.0
+)
+
 // Job keys for referencing specific jobs
 const (
 	JobKeyBlacksmith = domain.JobKeyBlacksmith
@@ -34,6 +66,7 @@ const (
 	JobKeyGambler    = domain.JobKeyGambler
 	JobKeyFarmer     = domain.JobKeyFarmer
 	JobKeyScholar    = domain.JobKeyScholar
+	JobKeyFisher     = domain.JobKeyFisher
 )
 
 // XP award amounts for different actions
@@ -68,10 +101,25 @@ const (
 	SourceDisassemble    = "disassemble"       // Item disassemble XP
 	SourceSlots          = "slots"             // Slots XP
 	SourceCompostHarvest = "compost_harvest"   // Compost harvest XP
+	SourceItemScript     = "item_script"       // Scripted item effect XP
 	SourceExpedition     = "expedition"        // Expedition XP
 	SourceGambleWin      = "win"               // Gamble win XP
 	SourceSell           = "sell"              // Item sell XP
 	SourceBuy            = "buy"               // Item buy XP
+	SourceFishing        = "fishing"           // Fish catch XP
+	SourceGarden         = "garden"            // Garden harvest XP
+)
+
+// Caps that can reduce an XP award, as recorded in the XP audit trail
+const (
+	XPCapDaily  = "daily"  // Per-job daily cap
+	XPCapSource = "source" // Per-source daily cap across all jobs
+)
+
+// XP audit trail query limits
+const (
+	DefaultXPAwardHistoryLimit = 50
+	MaxXPAwardHistoryLimit     = 200
 )
 
 // Log source constants for better tracking in logs
@@ -100,4 +148,5 @@ var AllJobs = []Info{
 	{Key: JobKeyGambler, DisplayName: "Gambler (Gambling)"},
 	{Key: JobKeyFarmer, DisplayName: "Farmer (Farming)"},
 	{Key: JobKeyScholar, DisplayName: "Scholar (Community)"},
+	{Key: JobKeyFisher, DisplayName: "Fisher (Fishing)"},
 }
@@ -60,6 +60,12 @@ func (h *EventHandler) Register(bus event.Bus) {
 
 	// Item usage events (Rare Candy)
 	bus.Subscribe(event.Type(domain.EventTypeItemUsed), h.HandleItemUsed)
+
+	// Fishing events
+	bus.Subscribe(event.FishCaught, h.HandleFishCaught)
+
+	// Garden events
+	bus.Subscribe(event.GardenHarvested, h.HandleGardenHarvested)
 }
 
 // HandleItemUpgraded handles item upgrade events to award Blacksmith XP
@@ -175,6 +181,45 @@ func (h *EventHandler) HandleCompostHarvested(ctx context.Context, evt event.Eve
 	return h.awardXPAndLog(ctx, payload.UserID, JobKeyFarmer, payload.XPAmount, SourceCompostHarvest, metadata, LogSourceCompost)
 }
 
+// HandleFishCaught handles landed fish to award Fisher XP
+func (h *EventHandler) HandleFishCaught(ctx context.Context, evt event.Event) error {
+	payload, err := event.DecodePayload[event.FishCaughtPayloadV1](evt.Payload)
+	if err != nil {
+		return fmt.Errorf("failed to decode fish caught payload: %w", err)
+	}
+
+	if payload.XP <= 0 {
+		return nil
+	}
+
+	metadata := domain.JobXPMetadata{
+		Source:   SourceFishing,
+		ItemName: payload.ItemName,
+		Username: payload.Username,
+	}
+
+	return h.awardXPAndLog(ctx, payload.UserID, JobKeyFisher, payload.XP, SourceFishing, metadata, SourceFishing)
+}
+
+// HandleGardenHarvested handles garden harvests to award Farmer XP
+func (h *EventHandler) HandleGardenHarvested(ctx context.Context, evt event.Event) error {
+	payload, err := event.DecodePayload[event.GardenHarvestedPayloadV1](evt.Payload)
+	if err != nil {
+		return fmt.Errorf("failed to decode garden harvested payload: %w", err)
+	}
+
+	if payload.XP <= 0 {
+		return nil
+	}
+
+	metadata := domain.JobXPMetadata{
+		Source:   SourceGarden,
+		Username: payload.Username,
+	}
+
+	return h.awardXPAndLog(ctx, payload.UserID, JobKeyFarmer, payload.XP, SourceGarden, metadata, SourceGarden)
+}
+
 // HandleExpeditionRewarded handles expedition reward events to award job XP
 func (h *EventHandler) HandleExpeditionRewarded(ctx context.Context, evt event.Event) error {
 	log := logger.FromContext(ctx)
@@ -448,7 +493,8 @@ func (h *EventHandler) HandlePredictionParticipated(ctx context.Context, evt eve
 	return nil
 }
 
-// HandleItemUsed handles item usage events, specifically awarding XP for Rare Candy
+// HandleItemUsed handles item usage events, awarding XP for Rare Candy and
+// for scripted items with a grant_xp action
 func (h *EventHandler) HandleItemUsed(ctx context.Context, evt event.Event) error {
 	log := logger.FromContext(ctx)
 
@@ -457,8 +503,7 @@ func (h *EventHandler) HandleItemUsed(ctx context.Context, evt event.Event) erro
 		return fmt.Errorf("failed to decode item used payload: %w", err)
 	}
 
-	// Currently handles Rare Candy XP awards
-	if payload.ItemName == domain.ItemRareCandy {
+	if payload.ItemName == domain.ItemRareCandy || isScriptedXPGrant(payload.Metadata) {
 		var jobName, source string
 		var xpTotal int
 
@@ -508,3 +553,14 @@ func (h *EventHandler) HandleItemUsed(ctx context.Context, evt event.Event) erro
 
 	return nil
 }
+
+// isScriptedXPGrant reports whether item-used metadata carries XP from a
+// scripted item effect
+func isScriptedXPGrant(metadata interface{}) bool {
+	metaMap, ok := metadata.(map[string]interface{})
+	if !ok {
+		return false
+	}
+	source, _ := metaMap[domain.MetadataKeySource].(string)
+	return source == SourceItemScript
+}
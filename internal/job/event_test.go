@@ -95,6 +95,34 @@ func (m *MockRepo) UpdateDailyResetTime(ctx context.Context, resetTime time.Time
 	return nil
 }
 
+func (m *MockRepo) GetActiveXPBoost(ctx context.Context, userID string) (float64, error) {
+	return 1.0, nil
+}
+
+func (m *MockRepo) GrantXPBoost(ctx context.Context, userID string, multiplier float64, duration time.Duration) error {
+	return nil
+}
+
+func (m *MockRepo) RecordXPAward(ctx context.Context, award *domain.XPAward) error {
+	return nil
+}
+
+func (m *MockRepo) GetSourceXPSince(ctx context.Context, userID, source string, since time.Time) (int64, error) {
+	return 0, nil
+}
+
+func (m *MockRepo) GetRecentXPAwards(ctx context.Context, userID string, limit int) ([]domain.XPAward, error) {
+	return nil, nil
+}
+
+func (m *MockRepo) SetActiveJob(ctx context.Context, userID string, jobID int) error {
+	return nil
+}
+
+func (m *MockRepo) PrestigeUserJob(ctx context.Context, userID string, jobID, minLevel int) (int, error) {
+	return 0, nil
+}
+
 // Mock Progression
 type MockProgression struct {
 	mock.Mock
@@ -143,10 +171,13 @@ func (m *MockStats) GetUserStats(ctx context.Context, userID string, period stri
 func (m *MockStats) GetUserCurrentStreak(ctx context.Context, userID string) (int, error) {
 	return 0, nil
 }
+func (m *MockStats) GetUserRecipeCraftCounts(ctx context.Context, userID string) (map[string]int, error) {
+	return nil, nil
+}
 func (m *MockStats) GetSystemStats(ctx context.Context, period string) (*domain.StatsSummary, error) {
 	return nil, nil
 }
-func (m *MockStats) GetLeaderboard(ctx context.Context, eventType domain.EventType, period string, limit int) ([]domain.LeaderboardEntry, error) {
+func (m *MockStats) GetLeaderboard(ctx context.Context, query domain.LeaderboardQuery) (*domain.LeaderboardPage, error) {
 	return nil, nil
 }
 func (m *MockStats) GetUserSlotsStats(ctx context.Context, userID, period string) (*domain.SlotsStats, error) {
@@ -162,6 +193,14 @@ func (m *MockStats) GetSlotsLeaderboardByMegaJackpots(ctx context.Context, perio
 	return nil, nil
 }
 
+func (m *MockStats) QueryStats(ctx context.Context, query domain.StatsQuery) (*domain.StatsQueryResult, error) {
+	return nil, nil
+}
+
+func (m *MockStats) RollUpDue(ctx context.Context) (int, error) {
+	return 0, nil
+}
+
 func TestAwardXP_PublishesEventOnLevelUp(t *testing.T) {
 	// Setup
 	mockRepo := new(MockRepo)
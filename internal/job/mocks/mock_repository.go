@@ -25,6 +25,63 @@ func (_m *MockRepository) EXPECT() *MockRepository_Expecter {
 	return &MockRepository_Expecter{mock: &_m.Mock}
 }
 
+// GetActiveXPBoost provides a mock function with given fields: ctx, userID
+func (_m *MockRepository) GetActiveXPBoost(ctx context.Context, userID string) (float64, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetActiveXPBoost")
+	}
+
+	var r0 float64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (float64, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) float64); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Get(0).(float64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRepository_GetActiveXPBoost_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetActiveXPBoost'
+type MockRepository_GetActiveXPBoost_Call struct {
+	*mock.Call
+}
+
+// GetActiveXPBoost is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+func (_e *MockRepository_Expecter) GetActiveXPBoost(ctx interface{}, userID interface{}) *MockRepository_GetActiveXPBoost_Call {
+	return &MockRepository_GetActiveXPBoost_Call{Call: _e.mock.On("GetActiveXPBoost", ctx, userID)}
+}
+
+func (_c *MockRepository_GetActiveXPBoost_Call) Run(run func(ctx context.Context, userID string)) *MockRepository_GetActiveXPBoost_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockRepository_GetActiveXPBoost_Call) Return(_a0 float64, _a1 error) *MockRepository_GetActiveXPBoost_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRepository_GetActiveXPBoost_Call) RunAndReturn(run func(context.Context, string) (float64, error)) *MockRepository_GetActiveXPBoost_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetAllJobs provides a mock function with given fields: ctx
 func (_m *MockRepository) GetAllJobs(ctx context.Context) ([]domain.Job, error) {
 	ret := _m.Called(ctx)
@@ -205,6 +262,125 @@ func (_c *MockRepository_GetLastDailyResetTime_Call) RunAndReturn(run func(conte
 	return _c
 }
 
+// GetRecentXPAwards provides a mock function with given fields: ctx, userID, limit
+func (_m *MockRepository) GetRecentXPAwards(ctx context.Context, userID string, limit int) ([]domain.XPAward, error) {
+	ret := _m.Called(ctx, userID, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRecentXPAwards")
+	}
+
+	var r0 []domain.XPAward
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int) ([]domain.XPAward, error)); ok {
+		return rf(ctx, userID, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int) []domain.XPAward); ok {
+		r0 = rf(ctx, userID, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.XPAward)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int) error); ok {
+		r1 = rf(ctx, userID, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRepository_GetRecentXPAwards_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRecentXPAwards'
+type MockRepository_GetRecentXPAwards_Call struct {
+	*mock.Call
+}
+
+// GetRecentXPAwards is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - limit int
+func (_e *MockRepository_Expecter) GetRecentXPAwards(ctx interface{}, userID interface{}, limit interface{}) *MockRepository_GetRecentXPAwards_Call {
+	return &MockRepository_GetRecentXPAwards_Call{Call: _e.mock.On("GetRecentXPAwards", ctx, userID, limit)}
+}
+
+func (_c *MockRepository_GetRecentXPAwards_Call) Run(run func(ctx context.Context, userID string, limit int)) *MockRepository_GetRecentXPAwards_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *MockRepository_GetRecentXPAwards_Call) Return(_a0 []domain.XPAward, _a1 error) *MockRepository_GetRecentXPAwards_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRepository_GetRecentXPAwards_Call) RunAndReturn(run func(context.Context, string, int) ([]domain.XPAward, error)) *MockRepository_GetRecentXPAwards_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSourceXPSince provides a mock function with given fields: ctx, userID, source, since
+func (_m *MockRepository) GetSourceXPSince(ctx context.Context, userID string, source string, since time.Time) (int64, error) {
+	ret := _m.Called(ctx, userID, source, since)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSourceXPSince")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, time.Time) (int64, error)); ok {
+		return rf(ctx, userID, source, since)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, time.Time) int64); ok {
+		r0 = rf(ctx, userID, source, since)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, time.Time) error); ok {
+		r1 = rf(ctx, userID, source, since)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRepository_GetSourceXPSince_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSourceXPSince'
+type MockRepository_GetSourceXPSince_Call struct {
+	*mock.Call
+}
+
+// GetSourceXPSince is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - source string
+//   - since time.Time
+func (_e *MockRepository_Expecter) GetSourceXPSince(ctx interface{}, userID interface{}, source interface{}, since interface{}) *MockRepository_GetSourceXPSince_Call {
+	return &MockRepository_GetSourceXPSince_Call{Call: _e.mock.On("GetSourceXPSince", ctx, userID, source, since)}
+}
+
+func (_c *MockRepository_GetSourceXPSince_Call) Run(run func(ctx context.Context, userID string, source string, since time.Time)) *MockRepository_GetSourceXPSince_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockRepository_GetSourceXPSince_Call) Return(_a0 int64, _a1 error) *MockRepository_GetSourceXPSince_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRepository_GetSourceXPSince_Call) RunAndReturn(run func(context.Context, string, string, time.Time) (int64, error)) *MockRepository_GetSourceXPSince_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetUserByID provides a mock function with given fields: ctx, userID
 func (_m *MockRepository) GetUserByID(ctx context.Context, userID string) (*domain.User, error) {
 	ret := _m.Called(ctx, userID)
@@ -503,6 +679,161 @@ func (_c *MockRepository_GetUserJobsByPlatform_Call) RunAndReturn(run func(conte
 	return _c
 }
 
+// GrantXPBoost provides a mock function with given fields: ctx, userID, multiplier, duration
+func (_m *MockRepository) GrantXPBoost(ctx context.Context, userID string, multiplier float64, duration time.Duration) error {
+	ret := _m.Called(ctx, userID, multiplier, duration)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GrantXPBoost")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, float64, time.Duration) error); ok {
+		r0 = rf(ctx, userID, multiplier, duration)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockRepository_GrantXPBoost_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GrantXPBoost'
+type MockRepository_GrantXPBoost_Call struct {
+	*mock.Call
+}
+
+// GrantXPBoost is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - multiplier float64
+//   - duration time.Duration
+func (_e *MockRepository_Expecter) GrantXPBoost(ctx interface{}, userID interface{}, multiplier interface{}, duration interface{}) *MockRepository_GrantXPBoost_Call {
+	return &MockRepository_GrantXPBoost_Call{Call: _e.mock.On("GrantXPBoost", ctx, userID, multiplier, duration)}
+}
+
+func (_c *MockRepository_GrantXPBoost_Call) Run(run func(ctx context.Context, userID string, multiplier float64, duration time.Duration)) *MockRepository_GrantXPBoost_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(float64), args[3].(time.Duration))
+	})
+	return _c
+}
+
+func (_c *MockRepository_GrantXPBoost_Call) Return(_a0 error) *MockRepository_GrantXPBoost_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockRepository_GrantXPBoost_Call) RunAndReturn(run func(context.Context, string, float64, time.Duration) error) *MockRepository_GrantXPBoost_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PrestigeUserJob provides a mock function with given fields: ctx, userID, jobID, minLevel
+func (_m *MockRepository) PrestigeUserJob(ctx context.Context, userID string, jobID int, minLevel int) (int, error) {
+	ret := _m.Called(ctx, userID, jobID, minLevel)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PrestigeUserJob")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int, int) (int, error)); ok {
+		return rf(ctx, userID, jobID, minLevel)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int, int) int); ok {
+		r0 = rf(ctx, userID, jobID, minLevel)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int, int) error); ok {
+		r1 = rf(ctx, userID, jobID, minLevel)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRepository_PrestigeUserJob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PrestigeUserJob'
+type MockRepository_PrestigeUserJob_Call struct {
+	*mock.Call
+}
+
+// PrestigeUserJob is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - jobID int
+//   - minLevel int
+func (_e *MockRepository_Expecter) PrestigeUserJob(ctx interface{}, userID interface{}, jobID interface{}, minLevel interface{}) *MockRepository_PrestigeUserJob_Call {
+	return &MockRepository_PrestigeUserJob_Call{Call: _e.mock.On("PrestigeUserJob", ctx, userID, jobID, minLevel)}
+}
+
+func (_c *MockRepository_PrestigeUserJob_Call) Run(run func(ctx context.Context, userID string, jobID int, minLevel int)) *MockRepository_PrestigeUserJob_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int), args[3].(int))
+	})
+	return _c
+}
+
+func (_c *MockRepository_PrestigeUserJob_Call) Return(_a0 int, _a1 error) *MockRepository_PrestigeUserJob_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRepository_PrestigeUserJob_Call) RunAndReturn(run func(context.Context, string, int, int) (int, error)) *MockRepository_PrestigeUserJob_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RecordXPAward provides a mock function with given fields: ctx, award
+func (_m *MockRepository) RecordXPAward(ctx context.Context, award *domain.XPAward) error {
+	ret := _m.Called(ctx, award)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecordXPAward")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.XPAward) error); ok {
+		r0 = rf(ctx, award)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockRepository_RecordXPAward_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecordXPAward'
+type MockRepository_RecordXPAward_Call struct {
+	*mock.Call
+}
+
+// RecordXPAward is a helper method to define mock.On call
+//   - ctx context.Context
+//   - award *domain.XPAward
+func (_e *MockRepository_Expecter) RecordXPAward(ctx interface{}, award interface{}) *MockRepository_RecordXPAward_Call {
+	return &MockRepository_RecordXPAward_Call{Call: _e.mock.On("RecordXPAward", ctx, award)}
+}
+
+func (_c *MockRepository_RecordXPAward_Call) Run(run func(ctx context.Context, award *domain.XPAward)) *MockRepository_RecordXPAward_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*domain.XPAward))
+	})
+	return _c
+}
+
+func (_c *MockRepository_RecordXPAward_Call) Return(_a0 error) *MockRepository_RecordXPAward_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockRepository_RecordXPAward_Call) RunAndReturn(run func(context.Context, *domain.XPAward) error) *MockRepository_RecordXPAward_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ResetDailyJobXP provides a mock function with given fields: ctx
 func (_m *MockRepository) ResetDailyJobXP(ctx context.Context) (int64, error) {
 	ret := _m.Called(ctx)
@@ -559,6 +890,54 @@ func (_c *MockRepository_ResetDailyJobXP_Call) RunAndReturn(run func(context.Con
 	return _c
 }
 
+// SetActiveJob provides a mock function with given fields: ctx, userID, jobID
+func (_m *MockRepository) SetActiveJob(ctx context.Context, userID string, jobID int) error {
+	ret := _m.Called(ctx, userID, jobID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetActiveJob")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int) error); ok {
+		r0 = rf(ctx, userID, jobID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockRepository_SetActiveJob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetActiveJob'
+type MockRepository_SetActiveJob_Call struct {
+	*mock.Call
+}
+
+// SetActiveJob is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - jobID int
+func (_e *MockRepository_Expecter) SetActiveJob(ctx interface{}, userID interface{}, jobID interface{}) *MockRepository_SetActiveJob_Call {
+	return &MockRepository_SetActiveJob_Call{Call: _e.mock.On("SetActiveJob", ctx, userID, jobID)}
+}
+
+func (_c *MockRepository_SetActiveJob_Call) Run(run func(ctx context.Context, userID string, jobID int)) *MockRepository_SetActiveJob_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *MockRepository_SetActiveJob_Call) Return(_a0 error) *MockRepository_SetActiveJob_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockRepository_SetActiveJob_Call) RunAndReturn(run func(context.Context, string, int) error) *MockRepository_SetActiveJob_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateDailyResetTime provides a mock function with given fields: ctx, resetTime, recordsAffected
 func (_m *MockRepository) UpdateDailyResetTime(ctx context.Context, resetTime time.Time, recordsAffected int64) error {
 	ret := _m.Called(ctx, resetTime, recordsAffected)
@@ -0,0 +1,152 @@
+package job
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+)
+
+// Perk is one row of a job's perk table
+type Perk struct {
+	Level       int    `json:"level"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+// PerkConfig is the top-level JSON structure for perks.json
+type PerkConfig struct {
+	Version string            `json:"version,omitempty"`
+	Jobs    map[string][]Perk `json:"jobs"`
+}
+
+// Validate checks that every table belongs to a known job and lists named
+// perks at strictly rising levels.
+func (c PerkConfig) Validate() error {
+	for jobKey, perks := range c.Jobs {
+		if !isKnownJob(jobKey) {
+			return fmt.Errorf("unknown job %q", jobKey)
+		}
+		for i, perk := range perks {
+			if perk.Level < 1 || perk.Level > MaxIterationLevel {
+				return fmt.Errorf("%s perk %d: level must be between 1 and %d", jobKey, i, MaxIterationLevel)
+			}
+			if i > 0 && perk.Level <= perks[i-1].Level {
+				return fmt.Errorf("%s perk %d: level must be greater than the previous perk's", jobKey, i)
+			}
+			if perk.Name == "" {
+				return fmt.Errorf("%s perk %d: name is required", jobKey, i)
+			}
+		}
+	}
+	return nil
+}
+
+// LoadPerkConfig reads and validates the job perk config file
+func LoadPerkConfig(path string) (*PerkConfig, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read job perk config: %w", err)
+	}
+
+	var config PerkConfig
+	if err := json.Unmarshal(data, &config); err != nil {
+		return nil, fmt.Errorf("failed to parse job perk config: %w", err)
+	}
+
+	if err := config.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid job perk config: %w", err)
+	}
+
+	return &config, nil
+}
+
+// PerkTable holds the per-level perks of each job and can be reloaded from its file
+type PerkTable struct {
+	path string
+
+	mu     sync.RWMutex
+	config PerkConfig
+}
+
+// NewPerkTable loads the perk tables from path
+func NewPerkTable(path string) (*PerkTable, error) {
+	config, err := LoadPerkConfig(path)
+	if err != nil {
+		return nil, err
+	}
+	return &PerkTable{path: path, config: *config}, nil
+}
+
+// Reload re-reads the tables from their file, keeping the current ones if the file is invalid
+func (t *PerkTable) Reload(ctx context.Context) error {
+	config, err := LoadPerkConfig(t.path)
+	if err != nil {
+		return err
+	}
+	t.mu.Lock()
+	t.config = *config
+	t.mu.Unlock()
+	return nil
+}
+
+// Perks returns a job's perks, lowest level first
+func (t *PerkTable) Perks(jobKey string) []Perk {
+	if t == nil {
+		return nil
+	}
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return t.config.Jobs[jobKey]
+}
+
+// GetJobPerks returns a job's perk table. When a platform user is given, the
+// perks their level has reached are marked and their prestige is included.
+func (s *service) GetJobPerks(ctx context.Context, jobKey, platform, platformID string) (*domain.JobPerks, error) {
+	if !isKnownJob(jobKey) {
+		return nil, fmt.Errorf("%q: %w", jobKey, domain.ErrUnknownJob)
+	}
+
+	result := &domain.JobPerks{JobKey: jobKey}
+	if platform != "" && platformID != "" {
+		_, _, progress, err := s.loadUserJob(ctx, platform, platformID, jobKey)
+		if err != nil {
+			return nil, err
+		}
+		if progress != nil {
+			result.Level = progress.CurrentLevel
+			result.Prestige = progress.Prestige
+			result.XPBonus = PrestigeXPBonus(progress.Prestige)
+		}
+	}
+
+	result.Perks = perksFor(s.perks.Perks(jobKey), result.Level)
+	return result, nil
+}
+
+// isKnownJob reports whether jobKey is one of AllJobs
+func isKnownJob(jobKey string) bool {
+	for _, job := range AllJobs {
+		if job.Key == jobKey {
+			return true
+		}
+	}
+	return false
+}
+
+// perksFor marks which of a job's perks the level has reached
+func perksFor(perks []Perk, level int) []domain.JobPerk {
+	result := make([]domain.JobPerk, 0, len(perks))
+	for _, perk := range perks {
+		result = append(result, domain.JobPerk{
+			Level:       perk.Level,
+			Name:        perk.Name,
+			Description: perk.Description,
+			Unlocked:    level >= perk.Level,
+		})
+	}
+	return result
+}
@@ -0,0 +1,43 @@
+package job
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/logger"
+)
+
+// PrestigeXPBonus returns the permanent extra XP share a job earns at a prestige
+func PrestigeXPBonus(prestige int) float64 {
+	return float64(prestige) * PrestigeXPBonusPerLevel
+}
+
+// PrestigeJob resets a job at the level cap to level 0 for a permanent XP
+// bonus in that job. A job can be prestiged up to MaxPrestige times.
+func (s *service) PrestigeJob(ctx context.Context, platform, platformID, jobKey string) (*domain.JobPrestigeResult, error) {
+	user, job, progress, err := s.loadUserJob(ctx, platform, platformID, jobKey)
+	if err != nil {
+		return nil, err
+	}
+
+	maxLevel := s.getMaxJobLevel(ctx)
+	if progress == nil || progress.CurrentLevel < maxLevel {
+		return nil, fmt.Errorf("%s must reach level %d: %w", jobKey, maxLevel, domain.ErrJobBelowLevelCap)
+	}
+	if progress.Prestige >= MaxPrestige {
+		return nil, fmt.Errorf("%s: %w", jobKey, domain.ErrMaxPrestigeReached)
+	}
+
+	prestige, err := s.repo.PrestigeUserJob(ctx, user.ID, job.ID, maxLevel)
+	if err != nil {
+		return nil, err
+	}
+
+	logger.FromContext(ctx).Info("Prestiged job", "user_id", user.ID, "job", jobKey, "prestige", prestige)
+	return &domain.JobPrestigeResult{
+		JobKey:   jobKey,
+		Prestige: prestige,
+		XPBonus:  PrestigeXPBonus(prestige),
+	}, nil
+}
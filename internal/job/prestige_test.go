@@ -0,0 +1,246 @@
+package job
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+)
+
+// fakeCooldowns records the enforced action and optionally refuses it
+type fakeCooldowns struct {
+	action string
+	err    error
+}
+
+func (f *fakeCooldowns) EnforceCooldown(ctx context.Context, userID, action string, fn func() error) error {
+	f.action = action
+	if f.err != nil {
+		return f.err
+	}
+	return fn()
+}
+
+func setupJobChoice(t *testing.T, progress *domain.UserJob) (*MockRepository, *MockProgressionService, context.Context) {
+	t.Helper()
+	repo := new(MockRepository)
+	prog := new(MockProgressionService)
+	ctx := context.Background()
+
+	repo.On("GetUserByPlatformID", ctx, domain.PlatformTwitch, "t1").Return(&domain.User{ID: "user1"}, nil)
+	prog.On("IsNodeUnlocked", ctx, JobKeyExplorer, 1).Return(true, nil)
+	repo.On("GetJobByKey", ctx, JobKeyExplorer).Return(&domain.Job{ID: 2, JobKey: JobKeyExplorer, DisplayName: "Explorer"}, nil)
+	repo.On("GetUserJob", ctx, "user1", 2).Return(progress, nil)
+	prog.On("GetModifiedValue", ctx, "job_level_cap", float64(DefaultMaxLevel)).Return(float64(DefaultMaxLevel), nil).Maybe()
+	return repo, prog, ctx
+}
+
+func TestSwitchActiveJob(t *testing.T) {
+	t.Run("switches under the cooldown", func(t *testing.T) {
+		repo, prog, ctx := setupJobChoice(t, &domain.UserJob{UserID: "user1", JobID: 2, CurrentLevel: 3})
+		repo.On("SetActiveJob", ctx, "user1", 2).Return(nil)
+		cooldowns := &fakeCooldowns{}
+		svc := NewService(repo, prog, nil, nil, false, WithCooldowns(cooldowns))
+
+		info, err := svc.SwitchActiveJob(ctx, domain.PlatformTwitch, "t1", JobKeyExplorer)
+
+		require.NoError(t, err)
+		assert.True(t, info.IsActive)
+		assert.Equal(t, 3, info.Level)
+		assert.Equal(t, domain.ActionJobSwitch, cooldowns.action)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("a job without progress can be chosen", func(t *testing.T) {
+		repo, prog, ctx := setupJobChoice(t, nil)
+		repo.On("SetActiveJob", ctx, "user1", 2).Return(nil)
+		svc := NewService(repo, prog, nil, nil, false)
+
+		info, err := svc.SwitchActiveJob(ctx, domain.PlatformTwitch, "t1", JobKeyExplorer)
+
+		require.NoError(t, err)
+		assert.True(t, info.IsActive)
+		assert.Equal(t, 0, info.Level)
+	})
+
+	t.Run("the active job is rejected without using the cooldown", func(t *testing.T) {
+		repo, prog, ctx := setupJobChoice(t, &domain.UserJob{UserID: "user1", JobID: 2, IsActive: true})
+		cooldowns := &fakeCooldowns{}
+		svc := NewService(repo, prog, nil, nil, false, WithCooldowns(cooldowns))
+
+		_, err := svc.SwitchActiveJob(ctx, domain.PlatformTwitch, "t1", JobKeyExplorer)
+
+		assert.ErrorIs(t, err, domain.ErrJobAlreadyActive)
+		assert.Empty(t, cooldowns.action)
+		repo.AssertNotCalled(t, "SetActiveJob", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("on cooldown", func(t *testing.T) {
+		repo, prog, ctx := setupJobChoice(t, nil)
+		svc := NewService(repo, prog, nil, nil, false, WithCooldowns(&fakeCooldowns{err: domain.ErrOnCooldown}))
+
+		_, err := svc.SwitchActiveJob(ctx, domain.PlatformTwitch, "t1", JobKeyExplorer)
+
+		assert.ErrorIs(t, err, domain.ErrOnCooldown)
+		repo.AssertNotCalled(t, "SetActiveJob", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("unknown job", func(t *testing.T) {
+		svc := NewService(new(MockRepository), new(MockProgressionService), nil, nil, false)
+
+		_, err := svc.SwitchActiveJob(context.Background(), domain.PlatformTwitch, "t1", "job_astronaut")
+
+		assert.ErrorIs(t, err, domain.ErrUnknownJob)
+	})
+}
+
+func TestPrestigeJob(t *testing.T) {
+	t.Run("resets a job at the level cap", func(t *testing.T) {
+		repo, prog, ctx := setupJobChoice(t, &domain.UserJob{UserID: "user1", JobID: 2, CurrentLevel: DefaultMaxLevel, Prestige: 1})
+		repo.On("PrestigeUserJob", ctx, "user1", 2, DefaultMaxLevel).Return(2, nil)
+		svc := NewService(repo, prog, nil, nil, false)
+
+		result, err := svc.PrestigeJob(ctx, domain.PlatformTwitch, "t1", JobKeyExplorer)
+
+		require.NoError(t, err)
+		assert.Equal(t, 2, result.Prestige)
+		assert.InDelta(t, 2*PrestigeXPBonusPerLevel, result.XPBonus, 1e-9)
+	})
+
+	t.Run("below the level cap", func(t *testing.T) {
+		repo, prog, ctx := setupJobChoice(t, &domain.UserJob{UserID: "user1", JobID: 2, CurrentLevel: DefaultMaxLevel - 1})
+		svc := NewService(repo, prog, nil, nil, false)
+
+		_, err := svc.PrestigeJob(ctx, domain.PlatformTwitch, "t1", JobKeyExplorer)
+
+		assert.ErrorIs(t, err, domain.ErrJobBelowLevelCap)
+		repo.AssertNotCalled(t, "PrestigeUserJob", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("at maximum prestige", func(t *testing.T) {
+		repo, prog, ctx := setupJobChoice(t, &domain.UserJob{UserID: "user1", JobID: 2, CurrentLevel: DefaultMaxLevel, Prestige: MaxPrestige})
+		svc := NewService(repo, prog, nil, nil, false)
+
+		_, err := svc.PrestigeJob(ctx, domain.PlatformTwitch, "t1", JobKeyExplorer)
+
+		assert.ErrorIs(t, err, domain.ErrMaxPrestigeReached)
+	})
+
+	t.Run("locked job", func(t *testing.T) {
+		repo := new(MockRepository)
+		prog := new(MockProgressionService)
+		ctx := context.Background()
+		repo.On("GetUserByPlatformID", ctx, domain.PlatformTwitch, "t1").Return(&domain.User{ID: "user1"}, nil)
+		prog.On("IsNodeUnlocked", ctx, JobKeyExplorer, 1).Return(false, nil)
+		svc := NewService(repo, prog, nil, nil, false)
+
+		_, err := svc.PrestigeJob(ctx, domain.PlatformTwitch, "t1", JobKeyExplorer)
+
+		assert.ErrorIs(t, err, domain.ErrFeatureLocked)
+	})
+}
+
+func TestAwardXP_ActiveAndPrestigeBonus(t *testing.T) {
+	repo := new(MockRepository)
+	prog := new(MockProgressionService)
+	svc := NewService(repo, prog, nil, nil, false).(*service)
+	svc.rnd = func() float64 { return 1.0 }
+	ctx := context.Background()
+
+	prog.On("IsNodeUnlocked", ctx, JobKeyExplorer, 1).Return(true, nil)
+	repo.On("GetJobByKey", ctx, JobKeyExplorer).Return(&domain.Job{ID: 2, JobKey: JobKeyExplorer}, nil)
+	repo.On("GetActiveXPBoost", ctx, "user1").Return(DefaultXPMultiplier, nil)
+	repo.On("GetUserJob", ctx, "user1", 2).Return(&domain.UserJob{UserID: "user1", JobID: 2, IsActive: true, Prestige: 2}, nil)
+	prog.On("GetModifiedValue", ctx, "job_xp_multiplier", 1.0).Return(1.0, nil)
+	prog.On("GetModifiedValue", ctx, "job_level_cap", mock.Anything).Return(float64(DefaultMaxLevel), nil)
+	prog.On("GetModifiedValue", ctx, "job_daily_cap", float64(DefaultDailyCap)).Return(float64(DefaultDailyCap), nil)
+	repo.On("GetUserByID", ctx, "user1").Return(&domain.User{ID: "user1", Username: "tester", TwitchID: "t1"}, nil)
+	repo.On("UpsertUserJob", ctx, mock.Anything).Return(nil)
+	repo.On("RecordXPAward", ctx, mock.Anything).Return(nil)
+
+	result, err := svc.AwardXP(ctx, "user1", JobKeyExplorer, 100, "test", domain.JobXPMetadata{})
+
+	require.NoError(t, err)
+	// +10% for the active job and +2% for each prestige
+	assert.Equal(t, 114, result.XPGained)
+}
+
+func TestGetJobPerks(t *testing.T) {
+	table, err := NewPerkTable(filepath.Join("..", "..", "configs", "jobs", "perks.json"))
+	require.NoError(t, err)
+
+	t.Run("table without a user", func(t *testing.T) {
+		svc := NewService(new(MockRepository), new(MockProgressionService), nil, nil, false, WithPerks(table))
+
+		perks, err := svc.GetJobPerks(context.Background(), JobKeyExplorer, "", "")
+
+		require.NoError(t, err)
+		require.NotEmpty(t, perks.Perks)
+		for _, perk := range perks.Perks {
+			assert.False(t, perk.Unlocked)
+		}
+	})
+
+	t.Run("marks the perks the user reached", func(t *testing.T) {
+		repo, prog, ctx := setupJobChoice(t, &domain.UserJob{UserID: "user1", JobID: 2, CurrentLevel: 5, Prestige: 1})
+		svc := NewService(repo, prog, nil, nil, false, WithPerks(table))
+
+		perks, err := svc.GetJobPerks(ctx, JobKeyExplorer, domain.PlatformTwitch, "t1")
+
+		require.NoError(t, err)
+		assert.Equal(t, 1, perks.Prestige)
+		for _, perk := range perks.Perks {
+			assert.Equal(t, perk.Level <= 5, perk.Unlocked, "perk at level %d", perk.Level)
+		}
+	})
+
+	t.Run("unknown job", func(t *testing.T) {
+		svc := NewService(new(MockRepository), new(MockProgressionService), nil, nil, false, WithPerks(table))
+
+		_, err := svc.GetJobPerks(context.Background(), "job_astronaut", "", "")
+
+		assert.ErrorIs(t, err, domain.ErrUnknownJob)
+	})
+}
+
+func TestLoadPerkConfig_Invalid(t *testing.T) {
+	tests := []struct {
+		name string
+		json string
+	}{
+		{"unknown job", `{"jobs": {"job_astronaut": [{"level": 1, "name": "Cadet"}]}}`},
+		{"levels out of order", `{"jobs": {"job_explorer": [{"level": 5, "name": "A"}, {"level": 5, "name": "B"}]}}`},
+		{"level below 1", `{"jobs": {"job_explorer": [{"level": 0, "name": "A"}]}}`},
+		{"missing name", `{"jobs": {"job_explorer": [{"level": 1}]}}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "perks.json")
+			require.NoError(t, os.WriteFile(path, []byte(tt.json), 0o600))
+
+			_, err := LoadPerkConfig(path)
+
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestPerkTable_ReloadKeepsTableOnError(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "perks.json")
+	require.NoError(t, os.WriteFile(path, []byte(`{"jobs": {"job_explorer": [{"level": 1, "name": "Scout"}]}}`), 0o600))
+	table, err := NewPerkTable(path)
+	require.NoError(t, err)
+
+	require.NoError(t, os.WriteFile(path, []byte(`{"jobs": `), 0o600))
+	err = table.Reload(context.Background())
+
+	assert.Error(t, err)
+	assert.Len(t, table.Perks(JobKeyExplorer), 1)
+}
@@ -67,32 +67,39 @@ func (s *service) GetUserJobs(ctx context.Context, userID string) ([]domain.User
 			continue
 		}
 
-		progress := progressMap[job.ID]
-		info := domain.UserJobInfo{
-			JobKey:      job.JobKey,
-			DisplayName: job.DisplayName,
-			Level:       0,
-			CurrentXP:   0,
-			MaxLevel:    maxLevel,
-		}
+		result = append(result, s.buildJobInfo(job, progressMap[job.ID], maxLevel))
+	}
 
-		if progress != nil {
-			info.Level = progress.CurrentLevel
-			info.CurrentXP = progress.CurrentXP
-			_, levelXP, levelReq, xpToNext := s.GetXPProgress(progress.CurrentXP)
-			info.LevelXP = levelXP
-			info.LevelRequirement = levelReq
-			info.XPToNextLevel = xpToNext
-		} else {
-			info.LevelXP = 0
-			info.LevelRequirement = s.GetXPForLevel(1)
-			info.XPToNextLevel = info.LevelRequirement
-		}
+	return result, nil
+}
 
-		result = append(result, info)
+// buildJobInfo combines a job with the user's progress in it, which is nil
+// when they have not earned XP in the job yet
+func (s *service) buildJobInfo(job domain.Job, progress *domain.UserJob, maxLevel int) domain.UserJobInfo {
+	info := domain.UserJobInfo{
+		JobKey:      job.JobKey,
+		DisplayName: job.DisplayName,
+		Level:       0,
+		CurrentXP:   0,
+		MaxLevel:    maxLevel,
 	}
 
-	return result, nil
+	if progress != nil {
+		info.Level = progress.CurrentLevel
+		info.CurrentXP = progress.CurrentXP
+		info.Prestige = progress.Prestige
+		info.IsActive = progress.IsActive
+		_, levelXP, levelReq, xpToNext := s.GetXPProgress(progress.CurrentXP)
+		info.LevelXP = levelXP
+		info.LevelRequirement = levelReq
+		info.XPToNextLevel = xpToNext
+	} else {
+		info.LevelXP = 0
+		info.LevelRequirement = s.GetXPForLevel(1)
+		info.XPToNextLevel = info.LevelRequirement
+	}
+
+	return info
 }
 
 // GetPrimaryJob returns the user's highest-level job
@@ -129,6 +136,31 @@ func (s *service) GetPrimaryJob(ctx context.Context, platform string, platformID
 	return primary, nil
 }
 
+// loadUserJob resolves a platform user and an unlocked job along with the
+// user's progress in it, which is nil before they earn any XP
+func (s *service) loadUserJob(ctx context.Context, platform, platformID, jobKey string) (*domain.User, *domain.Job, *domain.UserJob, error) {
+	if !isKnownJob(jobKey) {
+		return nil, nil, nil, fmt.Errorf("%q: %w", jobKey, domain.ErrUnknownJob)
+	}
+
+	user, err := s.repo.GetUserByPlatformID(ctx, platform, platformID)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if err := s.validateJobUnlocked(ctx, jobKey); err != nil {
+		return nil, nil, nil, err
+	}
+
+	job, err := s.repo.GetJobByKey(ctx, jobKey)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("failed to get job: %w", err)
+	}
+	progress, err := s.repo.GetUserJob(ctx, user.ID, job.ID)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("failed to get user job: %w", err)
+	}
+	return user, job, progress, nil
+}
 func (s *service) GetUserJobByPlatform(ctx context.Context, platform, platformID, jobKey string) (*domain.UserJob, error) {
 	user, err := s.repo.GetUserByPlatformID(ctx, platform, platformID)
 	if err != nil {
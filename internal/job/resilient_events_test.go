@@ -56,6 +56,7 @@ func TestResilientEvents_Integration(t *testing.T) {
 		prog.On("GetModifiedValue", mock.Anything, "job_daily_cap", mock.Anything).Return(float64(DefaultDailyCap), nil)
 		prog.On("GetModifiedValue", mock.Anything, "job_level_cap", mock.Anything).Return(float64(DefaultMaxLevel), nil)
 		repo.On("GetJobByKey", ctx, jobKey).Return(job, nil)
+		repo.On("GetActiveXPBoost", ctx, mock.Anything).Return(DefaultXPMultiplier, nil).Maybe()
 		repo.On("GetUserByID", ctx, userID).Return(&domain.User{ID: userID, Username: "testuser", TwitchID: "t1"}, nil)
 		repo.On("GetUserJob", ctx, userID, jobID).Return(&domain.UserJob{
 			UserID: userID, JobID: jobID, CurrentXP: 0, CurrentLevel: 0,
@@ -63,6 +64,7 @@ func TestResilientEvents_Integration(t *testing.T) {
 		repo.On("UpsertUserJob", ctx, mock.MatchedBy(func(uj *domain.UserJob) bool {
 			return uj.CurrentLevel == 1 // Leveled up
 		})).Return(nil)
+		repo.On("RecordXPAward", ctx, mock.Anything).Return(nil)
 		statsSvc.On("RecordUserEvent", ctx, userID, domain.EventTypeJobLevelUp, mock.Anything).Return(nil)
 
 		// Setup bus expectations: Fail once, then succeed
@@ -107,6 +109,7 @@ func TestResilientEvents_Integration(t *testing.T) {
 		prog.On("GetModifiedValue", mock.Anything, "job_daily_cap", mock.Anything).Return(float64(DefaultDailyCap), nil)
 		prog.On("GetModifiedValue", mock.Anything, "job_level_cap", mock.Anything).Return(float64(DefaultMaxLevel), nil)
 		repo.On("GetJobByKey", ctx, jobKey).Return(job, nil)
+		repo.On("GetActiveXPBoost", ctx, mock.Anything).Return(DefaultXPMultiplier, nil).Maybe()
 		repo.On("GetUserByID", ctx, userID).Return(&domain.User{ID: userID, Username: "testuser", TwitchID: "t1"}, nil)
 		repo.On("GetUserJob", ctx, userID, jobID).Return(&domain.UserJob{
 			UserID: userID, JobID: jobID, CurrentXP: 0, CurrentLevel: 0,
@@ -114,6 +117,7 @@ func TestResilientEvents_Integration(t *testing.T) {
 		repo.On("UpsertUserJob", ctx, mock.MatchedBy(func(uj *domain.UserJob) bool {
 			return uj.CurrentLevel == 1
 		})).Return(nil)
+		repo.On("RecordXPAward", ctx, mock.Anything).Return(nil)
 		statsSvc.On("RecordUserEvent", ctx, userID, domain.EventTypeJobLevelUp, mock.Anything).Return(nil)
 
 		// Setup bus expectations: Fail always (initial + 3 retries = 4 calls)
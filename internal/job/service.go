@@ -3,9 +3,11 @@ package job
 import (
 	"context"
 	"sync"
+	"time"
 
 	"github.com/osse101/BrandishBot_Go/internal/domain"
 	"github.com/osse101/BrandishBot_Go/internal/event"
+	"github.com/osse101/BrandishBot_Go/internal/gameevent"
 	"github.com/osse101/BrandishBot_Go/internal/logger"
 	"github.com/osse101/BrandishBot_Go/internal/repository"
 	"github.com/osse101/BrandishBot_Go/internal/utils"
@@ -19,6 +21,18 @@ type ProgressionService interface {
 	GetJobUnlockConfig(ctx context.Context, featureKey string) (*domain.JobUnlockConfig, error)
 }
 
+// EffectChecker reads timed effects such as consumable XP boosters and
+// community-wide raid bonuses
+type EffectChecker interface {
+	Multiplier(ctx context.Context, userID string, effectType domain.EffectType) float64
+	CommunityMultiplier(ctx context.Context, effectType domain.EffectType) float64
+}
+
+// CooldownService defines the cooldown check used when switching active job
+type CooldownService interface {
+	EnforceCooldown(ctx context.Context, userID, action string, fn func() error) error
+}
+
 // Service defines the job system business logic
 type Service interface {
 	// Core operations
@@ -32,6 +46,13 @@ type Service interface {
 	AwardXP(ctx context.Context, userID, jobKey string, baseAmount int, source string, metadata domain.JobXPMetadata) (*domain.XPAwardResult, error)
 	AwardXPByPlatform(ctx context.Context, platform, platformID, jobKey string, baseAmount int, source string, metadata domain.JobXPMetadata) (*domain.XPAwardResult, error)
 	GetJobLevel(ctx context.Context, userID, jobKey string) (int, error)
+	GrantXPBoost(ctx context.Context, userID string, multiplier float64, duration time.Duration) error
+	GetRecentXPAwards(ctx context.Context, userID string, limit int) ([]domain.XPAward, error)
+
+	// Player choices
+	SwitchActiveJob(ctx context.Context, platform, platformID, jobKey string) (*domain.UserJobInfo, error)
+	PrestigeJob(ctx context.Context, platform, platformID, jobKey string) (*domain.JobPrestigeResult, error)
+	GetJobPerks(ctx context.Context, jobKey, platform, platformID string) (*domain.JobPerks, error)
 
 	// Daily reset operations
 	ResetDailyJobXP(ctx context.Context) (int64, error)
@@ -53,22 +74,73 @@ type service struct {
 	publisher      *event.ResilientPublisher
 	rnd            func() float64 // For RNG
 	disableXPGains bool           // When true, AwardXP is a no-op returning 0 XP
+	cooldowns      CooldownService
+	perks          *PerkTable
+	effects        EffectChecker
+	xpEvents       *XPEventTable
+	gameEvents     gameevent.ModifierSource // nil ignores admin game events
+	sourceCaps     map[string]int
+	now            func() time.Time
 
 	// Cache for daily reset status
 	resetCache   *domain.DailyResetStatus
 	resetCacheMu sync.RWMutex
 }
 
+// Option configures optional job service dependencies
+type Option func(*service)
+
+// WithCooldowns limits how often users can switch active job
+func WithCooldowns(cooldowns CooldownService) Option {
+	return func(s *service) {
+		s.cooldowns = cooldowns
+	}
+}
+
+// WithPerks provides the per-level perk tables shown by GetJobPerks
+func WithPerks(perks *PerkTable) Option {
+	return func(s *service) {
+		s.perks = perks
+	}
+}
+
+// WithEffects applies consumable XP boosters and community XP bonuses to
+// awarded XP
+func WithEffects(effects EffectChecker) Option {
+	return func(s *service) {
+		s.effects = effects
+	}
+}
+
+// WithXPEvents applies scheduled global XP events to awarded XP
+func WithXPEvents(events *XPEventTable) Option {
+	return func(s *service) {
+		s.xpEvents = events
+	}
+}
+
+// WithGameEvents applies the XP multiplier of running admin game events to awarded XP
+func WithGameEvents(events gameevent.ModifierSource) Option {
+	return func(s *service) {
+		s.gameEvents = events
+	}
+}
+
 // NewService creates a new job service
-func NewService(repo repository.Job, progressionSvc ProgressionService, eventBus event.Bus, publisher *event.ResilientPublisher, disableXPGains bool) Service {
-	return &service{
+func NewService(repo repository.Job, progressionSvc ProgressionService, eventBus event.Bus, publisher *event.ResilientPublisher, disableXPGains bool, opts ...Option) Service {
+	s := &service{
 		repo:           repo,
 		progressionSvc: progressionSvc,
 		eventBus:       eventBus,
 		publisher:      publisher,
 		rnd:            utils.RandomFloat,
 		disableXPGains: disableXPGains,
+		now:            time.Now,
+	}
+	for _, opt := range opts {
+		opt(s)
 	}
+	return s
 }
 
 // Shutdown gracefully shuts down the job service
@@ -8,6 +8,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 
 	"github.com/osse101/BrandishBot_Go/internal/domain"
 )
@@ -93,7 +94,7 @@ func (m *MockRepository) ResetDailyJobXP(ctx context.Context) (int64, error) {
 
 func (m *MockRepository) GetLastDailyResetTime(ctx context.Context) (time.Time, int64, error) {
 	args := m.Called(ctx)
-	return time.Time{}, int64(args.Int(1)), args.Error(2)
+	return args.Get(0).(time.Time), args.Get(1).(int64), args.Error(2)
 }
 
 func (m *MockRepository) UpdateDailyResetTime(ctx context.Context, resetTime time.Time, recordsAffected int64) error {
@@ -101,6 +102,44 @@ func (m *MockRepository) UpdateDailyResetTime(ctx context.Context, resetTime tim
 	return args.Error(0)
 }
 
+func (m *MockRepository) GetActiveXPBoost(ctx context.Context, userID string) (float64, error) {
+	args := m.Called(ctx, userID)
+	return args.Get(0).(float64), args.Error(1)
+}
+
+func (m *MockRepository) GrantXPBoost(ctx context.Context, userID string, multiplier float64, duration time.Duration) error {
+	args := m.Called(ctx, userID, multiplier, duration)
+	return args.Error(0)
+}
+
+func (m *MockRepository) RecordXPAward(ctx context.Context, award *domain.XPAward) error {
+	args := m.Called(ctx, award)
+	return args.Error(0)
+}
+
+func (m *MockRepository) GetSourceXPSince(ctx context.Context, userID, source string, since time.Time) (int64, error) {
+	args := m.Called(ctx, userID, source, since)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockRepository) GetRecentXPAwards(ctx context.Context, userID string, limit int) ([]domain.XPAward, error) {
+	args := m.Called(ctx, userID, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]domain.XPAward), args.Error(1)
+}
+
+func (m *MockRepository) SetActiveJob(ctx context.Context, userID string, jobID int) error {
+	args := m.Called(ctx, userID, jobID)
+	return args.Error(0)
+}
+
+func (m *MockRepository) PrestigeUserJob(ctx context.Context, userID string, jobID, minLevel int) (int, error) {
+	args := m.Called(ctx, userID, jobID, minLevel)
+	return args.Int(0), args.Error(1)
+}
+
 // MockProgressionService
 type MockProgressionService struct {
 	mock.Mock
@@ -160,6 +199,14 @@ func (m *MockStatsService) GetUserCurrentStreak(ctx context.Context, userID stri
 	return args.Int(0), args.Error(1)
 }
 
+func (m *MockStatsService) GetUserRecipeCraftCounts(ctx context.Context, userID string) (map[string]int, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(map[string]int), args.Error(1)
+}
+
 func (m *MockStatsService) GetSystemStats(ctx context.Context, period string) (*domain.StatsSummary, error) {
 	args := m.Called(ctx, period)
 	if args.Get(0) == nil {
@@ -168,12 +215,12 @@ func (m *MockStatsService) GetSystemStats(ctx context.Context, period string) (*
 	return args.Get(0).(*domain.StatsSummary), args.Error(1)
 }
 
-func (m *MockStatsService) GetLeaderboard(ctx context.Context, eventType domain.EventType, period string, limit int) ([]domain.LeaderboardEntry, error) {
-	args := m.Called(ctx, eventType, period, limit)
+func (m *MockStatsService) GetLeaderboard(ctx context.Context, query domain.LeaderboardQuery) (*domain.LeaderboardPage, error) {
+	args := m.Called(ctx, query)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
 	}
-	return args.Get(0).([]domain.LeaderboardEntry), args.Error(1)
+	return args.Get(0).(*domain.LeaderboardPage), args.Error(1)
 }
 
 func (m *MockStatsService) GetUserSlotsStats(ctx context.Context, userID, period string) (*domain.SlotsStats, error) {
@@ -208,6 +255,19 @@ func (m *MockStatsService) GetSlotsLeaderboardByMegaJackpots(ctx context.Context
 	return args.Get(0).([]domain.SlotsStats), args.Error(1)
 }
 
+func (m *MockStatsService) QueryStats(ctx context.Context, query domain.StatsQuery) (*domain.StatsQueryResult, error) {
+	args := m.Called(ctx, query)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.StatsQueryResult), args.Error(1)
+}
+
+func (m *MockStatsService) RollUpDue(ctx context.Context) (int, error) {
+	args := m.Called(ctx)
+	return args.Int(0), args.Error(1)
+}
+
 // Tests
 
 func TestCalculateLevel(t *testing.T) {
@@ -281,10 +341,12 @@ func TestAwardXP_Success(t *testing.T) {
 
 	prog.On("IsNodeUnlocked", ctx, jobKey, 1).Return(true, nil)
 	repo.On("GetJobByKey", ctx, jobKey).Return(job, nil)
+	repo.On("GetActiveXPBoost", ctx, mock.Anything).Return(DefaultXPMultiplier, nil).Maybe()
 	repo.On("GetUserJob", ctx, userID, jobID).Return(nil, nil) // New user job
 	repo.On("UpsertUserJob", ctx, mock.MatchedBy(func(uj *domain.UserJob) bool {
 		return uj.UserID == userID && uj.CurrentXP == int64(BlacksmithXPPerItem) && uj.CurrentLevel == 0
 	})).Return(nil)
+	repo.On("RecordXPAward", ctx, mock.Anything).Return(nil)
 
 	prog.On("GetModifiedValue", ctx, "job_xp_multiplier", 1.0).Return(1.0, nil)
 	prog.On("GetModifiedValue", ctx, "job_level_cap", mock.Anything).Return(float64(DefaultMaxLevel), nil)
@@ -302,6 +364,56 @@ func TestAwardXP_Success(t *testing.T) {
 	prog.AssertExpectations(t)
 }
 
+func TestAwardXP_UserBoost(t *testing.T) {
+	repo := new(MockRepository)
+	prog := new(MockProgressionService)
+	svc := NewService(repo, prog, nil, nil, false).(*service)
+	svc.rnd = func() float64 { return 1.0 }
+
+	ctx := context.Background()
+	userID := "user1"
+	jobKey := JobKeyBlacksmith
+	job := &domain.Job{ID: 1, JobKey: jobKey}
+
+	prog.On("IsNodeUnlocked", ctx, jobKey, 1).Return(true, nil)
+	prog.On("GetModifiedValue", ctx, "job_xp_multiplier", 1.0).Return(1.5, nil)
+	prog.On("GetModifiedValue", ctx, "job_level_cap", mock.Anything).Return(float64(DefaultMaxLevel), nil)
+	prog.On("GetModifiedValue", ctx, "job_daily_cap", float64(DefaultDailyCap)).Return(float64(DefaultDailyCap), nil)
+	repo.On("GetJobByKey", ctx, jobKey).Return(job, nil)
+	repo.On("GetActiveXPBoost", ctx, userID).Return(2.0, nil)
+	repo.On("GetUserJob", ctx, userID, 1).Return(nil, nil)
+	repo.On("UpsertUserJob", ctx, mock.Anything).Return(nil)
+	repo.On("RecordXPAward", ctx, mock.Anything).Return(nil)
+	repo.On("GetUserByID", ctx, userID).Return(&domain.User{ID: userID, Username: "testuser", TwitchID: "t1"}, nil)
+
+	result, err := svc.AwardXP(ctx, userID, jobKey, 100, "test", domain.JobXPMetadata{})
+
+	require.NoError(t, err)
+	// Progression and personal boosts stack multiplicatively
+	assert.Equal(t, 300, result.XPGained)
+	repo.AssertExpectations(t)
+}
+
+func TestGrantXPBoost(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("stores a valid boost", func(t *testing.T) {
+		repo := new(MockRepository)
+		svc := NewService(repo, new(MockProgressionService), nil, nil, false)
+		repo.On("GrantXPBoost", ctx, "user1", 1.5, time.Hour).Return(nil)
+
+		require.NoError(t, svc.GrantXPBoost(ctx, "user1", 1.5, time.Hour))
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("rejects multipliers that do not boost", func(t *testing.T) {
+		svc := NewService(new(MockRepository), new(MockProgressionService), nil, nil, false)
+
+		assert.ErrorIs(t, svc.GrantXPBoost(ctx, "user1", 1.0, time.Hour), domain.ErrInvalidInput)
+		assert.ErrorIs(t, svc.GrantXPBoost(ctx, "user1", 2.0, 0), domain.ErrInvalidInput)
+	})
+}
+
 func TestAwardXP_Epiphany(t *testing.T) {
 	repo := new(MockRepository)
 	prog := new(MockProgressionService)
@@ -322,12 +434,14 @@ func TestAwardXP_Epiphany(t *testing.T) {
 
 	prog.On("IsNodeUnlocked", ctx, jobKey, 1).Return(true, nil)
 	repo.On("GetJobByKey", ctx, jobKey).Return(job, nil)
+	repo.On("GetActiveXPBoost", ctx, mock.Anything).Return(DefaultXPMultiplier, nil).Maybe()
 	repo.On("GetUserJob", ctx, userID, jobID).Return(nil, nil)
 
 	// Expect doubled XP
 	repo.On("UpsertUserJob", ctx, mock.MatchedBy(func(uj *domain.UserJob) bool {
 		return uj.CurrentXP == int64(expectedXP)
 	})).Return(nil)
+	repo.On("RecordXPAward", ctx, mock.Anything).Return(nil)
 
 	prog.On("GetModifiedValue", ctx, "job_xp_multiplier", 1.0).Return(1.0, nil)
 	prog.On("GetModifiedValue", ctx, "job_level_cap", mock.Anything).Return(float64(DefaultMaxLevel), nil)
@@ -362,6 +476,7 @@ func TestAwardXP_LevelUp(t *testing.T) {
 
 	prog.On("IsNodeUnlocked", ctx, jobKey, 1).Return(true, nil)
 	repo.On("GetJobByKey", ctx, jobKey).Return(job, nil)
+	repo.On("GetActiveXPBoost", ctx, mock.Anything).Return(DefaultXPMultiplier, nil).Maybe()
 	// Current XP 0
 	repo.On("GetUserJob", ctx, userID, jobID).Return(&domain.UserJob{
 		UserID: userID, JobID: jobID, CurrentXP: 0, CurrentLevel: 0,
@@ -371,6 +486,7 @@ func TestAwardXP_LevelUp(t *testing.T) {
 	repo.On("UpsertUserJob", ctx, mock.MatchedBy(func(uj *domain.UserJob) bool {
 		return uj.CurrentXP == 300 && uj.CurrentLevel == 1
 	})).Return(nil)
+	repo.On("RecordXPAward", ctx, mock.Anything).Return(nil)
 
 	prog.On("GetModifiedValue", ctx, "job_xp_multiplier", 1.0).Return(1.0, nil)
 	prog.On("GetModifiedValue", ctx, "job_level_cap", mock.Anything).Return(float64(DefaultMaxLevel), nil)
@@ -418,6 +534,7 @@ func TestAwardXP_DailyCap(t *testing.T) {
 
 	prog.On("IsNodeUnlocked", ctx, jobKey, 1).Return(true, nil)
 	repo.On("GetJobByKey", ctx, jobKey).Return(job, nil)
+	repo.On("GetActiveXPBoost", ctx, mock.Anything).Return(DefaultXPMultiplier, nil).Maybe()
 	// User has 0 XP gained today
 	repo.On("GetUserJob", ctx, userID, jobID).Return(&domain.UserJob{
 		UserID: userID, JobID: jobID, XPGainedToday: 0,
@@ -427,6 +544,7 @@ func TestAwardXP_DailyCap(t *testing.T) {
 	repo.On("UpsertUserJob", ctx, mock.MatchedBy(func(uj *domain.UserJob) bool {
 		return uj.XPGainedToday == int64(DefaultDailyCap) && uj.CurrentXP == int64(DefaultDailyCap)
 	})).Return(nil)
+	repo.On("RecordXPAward", ctx, mock.Anything).Return(nil)
 
 	prog.On("GetModifiedValue", ctx, "job_xp_multiplier", 1.0).Return(1.0, nil)
 	prog.On("GetModifiedValue", ctx, "job_level_cap", mock.Anything).Return(float64(DefaultMaxLevel), nil)
@@ -456,6 +574,7 @@ func TestAwardXP_DailyCap_Reached(t *testing.T) {
 
 	prog.On("IsNodeUnlocked", ctx, jobKey, 1).Return(true, nil)
 	repo.On("GetJobByKey", ctx, jobKey).Return(job, nil)
+	repo.On("GetActiveXPBoost", ctx, mock.Anything).Return(DefaultXPMultiplier, nil).Maybe()
 	// User has already reached the cap
 	repo.On("GetUserJob", ctx, userID, jobID).Return(&domain.UserJob{
 		UserID: userID, JobID: jobID, XPGainedToday: int64(DefaultDailyCap),
@@ -487,6 +606,7 @@ func TestAwardXP_RareCandy_BypassesDailyCap(t *testing.T) {
 
 	prog.On("IsNodeUnlocked", ctx, jobKey, 1).Return(true, nil)
 	repo.On("GetJobByKey", ctx, jobKey).Return(job, nil)
+	repo.On("GetActiveXPBoost", ctx, mock.Anything).Return(DefaultXPMultiplier, nil).Maybe()
 	// User has already reached the cap
 	repo.On("GetUserJob", ctx, userID, jobID).Return(&domain.UserJob{
 		UserID: userID, JobID: jobID, XPGainedToday: int64(DefaultDailyCap),
@@ -502,6 +622,7 @@ func TestAwardXP_RareCandy_BypassesDailyCap(t *testing.T) {
 		// Verify that XP was awarded and xp_gained_today includes rare candy XP
 		return uj.XPGainedToday == int64(DefaultDailyCap+rarecandyXP) && uj.CurrentXP == int64(rarecandyXP)
 	})).Return(nil)
+	repo.On("RecordXPAward", ctx, mock.Anything).Return(nil)
 
 	result, err := svc.AwardXP(ctx, userID, jobKey, rarecandyXP, SourceRareCandy, domain.JobXPMetadata{})
 
@@ -527,6 +648,7 @@ func TestAwardXP_RareCandy_ExceedsNormalCap(t *testing.T) {
 
 	prog.On("IsNodeUnlocked", ctx, jobKey, 1).Return(true, nil)
 	repo.On("GetJobByKey", ctx, jobKey).Return(job, nil)
+	repo.On("GetActiveXPBoost", ctx, mock.Anything).Return(DefaultXPMultiplier, nil).Maybe()
 	// User has 400 XP gained today
 	repo.On("GetUserJob", ctx, userID, jobID).Return(&domain.UserJob{
 		UserID: userID, JobID: jobID, XPGainedToday: int64(initialXP), CurrentXP: int64(initialXP),
@@ -542,6 +664,7 @@ func TestAwardXP_RareCandy_ExceedsNormalCap(t *testing.T) {
 		// Verify that XP was awarded and xp_gained_today exceeds normal cap
 		return uj.XPGainedToday == int64(initialXP+rarecandyXP) && uj.CurrentXP == int64(initialXP+rarecandyXP)
 	})).Return(nil)
+	repo.On("RecordXPAward", ctx, mock.Anything).Return(nil)
 
 	result, err := svc.AwardXP(ctx, userID, jobKey, rarecandyXP, SourceRareCandy, domain.JobXPMetadata{})
 
@@ -570,6 +693,7 @@ func TestAwardXP_MaxLevel(t *testing.T) {
 
 	prog.On("IsNodeUnlocked", ctx, jobKey, 1).Return(true, nil)
 	repo.On("GetJobByKey", ctx, jobKey).Return(job, nil)
+	repo.On("GetActiveXPBoost", ctx, mock.Anything).Return(DefaultXPMultiplier, nil).Maybe()
 	repo.On("GetUserJob", ctx, userID, jobID).Return(&domain.UserJob{
 		UserID: userID, JobID: jobID, CurrentXP: startXP, CurrentLevel: 10, XPGainedToday: 0,
 	}, nil)
@@ -579,6 +703,7 @@ func TestAwardXP_MaxLevel(t *testing.T) {
 	repo.On("UpsertUserJob", ctx, mock.MatchedBy(func(uj *domain.UserJob) bool {
 		return uj.CurrentLevel == DefaultMaxLevel
 	})).Return(nil)
+	repo.On("RecordXPAward", ctx, mock.Anything).Return(nil)
 
 	prog.On("GetModifiedValue", ctx, "job_xp_multiplier", 1.0).Return(1.0, nil)
 	prog.On("GetModifiedValue", ctx, "job_level_cap", mock.Anything).Return(float64(DefaultMaxLevel), nil)
@@ -811,6 +936,7 @@ func TestGetJobLevel_RepositoryError(t *testing.T) {
 
 	job := &domain.Job{ID: 1, JobKey: JobKeyBlacksmith}
 	repo.On("GetJobByKey", ctx, JobKeyBlacksmith).Return(job, nil)
+	repo.On("GetActiveXPBoost", ctx, mock.Anything).Return(DefaultXPMultiplier, nil).Maybe()
 	repo.On("GetUserJob", ctx, "u1", 1).Return(nil, assert.AnError)
 
 	level, err := svc.GetJobLevel(ctx, "u1", JobKeyBlacksmith)
@@ -826,6 +952,7 @@ func TestGetJobLevel_NoProgress(t *testing.T) {
 
 	job := &domain.Job{ID: 1, JobKey: JobKeyBlacksmith}
 	repo.On("GetJobByKey", ctx, JobKeyBlacksmith).Return(job, nil)
+	repo.On("GetActiveXPBoost", ctx, mock.Anything).Return(DefaultXPMultiplier, nil).Maybe()
 	repo.On("GetUserJob", ctx, "u1", 1).Return(nil, nil) // No progress yet
 
 	level, err := svc.GetJobLevel(ctx, "u1", JobKeyBlacksmith)
@@ -859,6 +986,7 @@ func TestAwardXP_RepositoryFailure_Upsert(t *testing.T) {
 
 	prog.On("IsNodeUnlocked", ctx, JobKeyBlacksmith, 1).Return(true, nil)
 	repo.On("GetJobByKey", ctx, JobKeyBlacksmith).Return(job, nil)
+	repo.On("GetActiveXPBoost", ctx, mock.Anything).Return(DefaultXPMultiplier, nil).Maybe()
 	repo.On("GetUserJob", ctx, "u1", 1).Return(nil, nil)
 	repo.On("UpsertUserJob", ctx, mock.Anything).Return(assert.AnError)
 
@@ -892,6 +1020,7 @@ func TestAwardXP_PartialDailyCapRemaining(t *testing.T) {
 
 	prog.On("IsNodeUnlocked", ctx, JobKeyBlacksmith, 1).Return(true, nil)
 	repo.On("GetJobByKey", ctx, JobKeyBlacksmith).Return(job, nil)
+	repo.On("GetActiveXPBoost", ctx, mock.Anything).Return(DefaultXPMultiplier, nil).Maybe()
 	repo.On("GetUserJob", ctx, "u1", 1).Return(userJob, nil)
 
 	prog.On("GetModifiedValue", ctx, "job_xp_multiplier", 1.0).Return(1.0, nil)
@@ -903,6 +1032,7 @@ func TestAwardXP_PartialDailyCapRemaining(t *testing.T) {
 	repo.On("UpsertUserJob", ctx, mock.MatchedBy(func(uj *domain.UserJob) bool {
 		return uj.XPGainedToday == int64(DefaultDailyCap) && uj.CurrentXP == 2050 // 2000 + 50
 	})).Return(nil)
+	repo.On("RecordXPAward", ctx, mock.Anything).Return(nil)
 
 	result, err := svc.AwardXP(ctx, "u1", JobKeyBlacksmith, 100, "test", domain.JobXPMetadata{})
 	assert.NoError(t, err)
@@ -32,11 +32,23 @@ func (s *service) AwardXP(ctx context.Context, userID string, jobKey string, bas
 		return nil, err
 	}
 
-	actualAmount := s.calculateActualXP(ctx, userID, jobKey, baseAmount, source)
+	actualAmount, breakdown := s.calculateActualXP(ctx, userID, jobKey, currentProgress, baseAmount, source)
 
+	cappedBy := ""
+	uncapped := actualAmount
+	if err := s.checkSourceCap(ctx, userID, jobKey, &actualAmount, source); err != nil {
+		return nil, err
+	}
+	if actualAmount < uncapped {
+		cappedBy = XPCapSource
+	}
+	uncapped = actualAmount
 	if err := s.checkDailyCap(ctx, userID, jobKey, currentProgress, &actualAmount, source); err != nil {
 		return nil, err
 	}
+	if actualAmount < uncapped {
+		cappedBy = XPCapDaily
+	}
 
 	oldLevel := currentProgress.CurrentLevel
 	newXP := currentProgress.CurrentXP + int64(actualAmount)
@@ -44,19 +56,34 @@ func (s *service) AwardXP(ctx context.Context, userID string, jobKey string, bas
 
 	s.enrichMetadata(ctx, userID, &metadata)
 
-	now := time.Now()
+	now := s.now()
 	if err := s.updateUserJobProgress(ctx, currentProgress, newXP, newLevel, actualAmount, &now); err != nil {
 		return nil, err
 	}
 
+	s.recordXPAward(ctx, &domain.XPAward{
+		UserID:     userID,
+		JobKey:     jobKey,
+		Source:     source,
+		BaseXP:     baseAmount,
+		XPAwarded:  actualAmount,
+		Multiplier: totalMultiplier(breakdown),
+		CappedBy:   cappedBy,
+		OldLevel:   oldLevel,
+		NewLevel:   newLevel,
+		Metadata:   metadata,
+	})
 	s.recordXPAndLevelUpEvents(ctx, userID, metadata.Username, metadata.Platform, jobKey, actualAmount, oldLevel, newLevel, source)
 
 	return &domain.XPAwardResult{
-		JobKey:    jobKey,
-		XPGained:  actualAmount,
-		NewXP:     newXP,
-		NewLevel:  newLevel,
-		LeveledUp: newLevel > oldLevel,
+		JobKey:     jobKey,
+		BaseXP:     baseAmount,
+		Multiplier: totalMultiplier(breakdown),
+		Breakdown:  breakdown,
+		XPGained:   actualAmount,
+		NewXP:      newXP,
+		NewLevel:   newLevel,
+		LeveledUp:  newLevel > oldLevel,
 	}, nil
 }
 
@@ -166,18 +193,61 @@ func (s *service) getOrCreateUserJob(ctx context.Context, userID string, jobID i
 	return currentProgress, nil
 }
 
-func (s *service) calculateActualXP(ctx context.Context, userID, jobKey string, baseAmount int, source string) int {
-	xpMultiplier := s.getXPMultiplier(ctx)
-	actualAmount := int(float64(baseAmount) * xpMultiplier)
+// calculateActualXP applies every XP multiplier to baseAmount, returning the
+// XP before the daily cap and the multipliers that applied
+func (s *service) calculateActualXP(ctx context.Context, userID, jobKey string, progress *domain.UserJob, baseAmount int, source string) (int, []domain.XPMultiplier) {
+	breakdown := s.xpMultipliers(ctx, userID, jobKey, progress)
+	actualAmount := int(float64(baseAmount) * totalMultiplier(breakdown))
 
 	if s.rnd() < EpiphanyChance {
 		actualAmount = int(float64(actualAmount) * EpiphanyMultiplier)
+		breakdown = append(breakdown, domain.XPMultiplier{Source: XPSourceEpiphany, Multiplier: EpiphanyMultiplier})
 		logger.FromContext(ctx).Info("Job Epiphany triggered!", "user_id", userID, "job", jobKey, "base_amount", baseAmount, "bonus_amount", actualAmount-baseAmount)
 		if s.publisher != nil {
 			s.publisher.PublishWithRetry(ctx, event.NewJobXPCriticalEvent(userID, jobKey, baseAmount, actualAmount-baseAmount, EpiphanyMultiplier, source))
 		}
 	}
-	return actualAmount
+	return actualAmount, breakdown
+}
+
+// xpMultipliers lists the multipliers that apply to the user's next award in
+// jobKey. Factors of exactly 1 are left out.
+func (s *service) xpMultipliers(ctx context.Context, userID, jobKey string, progress *domain.UserJob) []domain.XPMultiplier {
+	var breakdown []domain.XPMultiplier
+	add := func(source, name string, multiplier float64) {
+		if multiplier != DefaultXPMultiplier {
+			breakdown = append(breakdown, domain.XPMultiplier{Source: source, Name: name, Multiplier: multiplier})
+		}
+	}
+
+	add(XPSourceProgression, "", s.getXPMultiplier(ctx))
+	add(XPSourceBoost, "", s.getUserXPBoost(ctx, userID))
+	if s.effects != nil {
+		add(XPSourceBooster, "", s.effects.Multiplier(ctx, userID, domain.EffectJobXPBoost))
+		add(XPSourceCommunity, "", s.effects.CommunityMultiplier(ctx, domain.EffectJobXPBoost))
+	}
+	if progress.IsActive {
+		add(XPSourceActiveJob, "", DefaultXPMultiplier+ActiveJobXPBonus)
+	}
+	add(XPSourcePrestige, "", DefaultXPMultiplier+PrestigeXPBonus(progress.Prestige))
+	for _, evt := range s.xpEvents.Active(s.now(), jobKey) {
+		add(XPSourceEvent, evt.Name, evt.Multiplier)
+	}
+	if s.gameEvents != nil {
+		if mods := s.gameEvents.Modifiers(ctx); mods.XPMultiplier > DefaultXPMultiplier {
+			add(XPSourceGameEvent, "", mods.XPMultiplier)
+		}
+	}
+	return breakdown
+}
+
+// totalMultiplier is the product of the multipliers in a breakdown
+func totalMultiplier(breakdown []domain.XPMultiplier) float64 {
+	total := DefaultXPMultiplier
+	for _, m := range breakdown {
+		total *= m.Multiplier
+	}
+	return total
 }
 
 func (s *service) checkDailyCap(ctx context.Context, userID, jobKey string, currentProgress *domain.UserJob, actualAmount *int, source string) error {
@@ -258,6 +328,29 @@ func (s *service) getXPMultiplier(ctx context.Context) float64 {
 	return modified
 }
 
+// getUserXPBoost returns the user's timed XP boost (e.g. from a subscription reward)
+func (s *service) getUserXPBoost(ctx context.Context, userID string) float64 {
+	boost, err := s.repo.GetActiveXPBoost(ctx, userID)
+	if err != nil {
+		logger.FromContext(ctx).Warn("Failed to get user XP boost, using default", "user_id", userID, "error", err)
+		return DefaultXPMultiplier
+	}
+	return boost
+}
+
+// GrantXPBoost gives a user a timed XP multiplier. While a boost is active the
+// higher multiplier is kept and the durations stack.
+func (s *service) GrantXPBoost(ctx context.Context, userID string, multiplier float64, duration time.Duration) error {
+	if multiplier <= DefaultXPMultiplier || duration <= 0 {
+		return fmt.Errorf("invalid XP boost %.2fx for %s: %w", multiplier, duration, domain.ErrInvalidInput)
+	}
+	if err := s.repo.GrantXPBoost(ctx, userID, multiplier, duration); err != nil {
+		return err
+	}
+	logger.FromContext(ctx).Info("Granted XP boost", "user_id", userID, "multiplier", multiplier, "duration", duration)
+	return nil
+}
+
 func (s *service) getDailyCap(ctx context.Context) int {
 	// Apply progression modifier for daily job cap
 	modified, err := s.progressionSvc.GetModifiedValue(ctx, "", "job_daily_cap", float64(DefaultDailyCap))
@@ -0,0 +1,72 @@
+package job
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/logger"
+)
+
+// WithSourceCaps limits the XP a user can earn from each source, across all
+// jobs, between daily resets. Sources without a cap are only limited by the
+// per-job daily cap.
+func WithSourceCaps(caps map[string]int) Option {
+	return func(s *service) {
+		s.sourceCaps = caps
+	}
+}
+
+// checkSourceCap limits the award to what is left of the source's cap since
+// the last daily reset. A failed lookup lets the award through rather than
+// blocking XP on a database hiccup.
+func (s *service) checkSourceCap(ctx context.Context, userID, jobKey string, actualAmount *int, source string) error {
+	sourceCap, ok := s.sourceCaps[source]
+	if !ok {
+		return nil
+	}
+
+	earned, err := s.repo.GetSourceXPSince(ctx, userID, source, s.dailyWindowStart(ctx))
+	if err != nil {
+		logger.FromContext(ctx).Warn("Failed to get source XP, skipping source cap", "user_id", userID, "source", source, "error", err)
+		return nil
+	}
+
+	remaining := int64(sourceCap) - earned
+	if remaining <= 0 {
+		logger.FromContext(ctx).Info("Source XP cap reached", "user_id", userID, "job", jobKey, "source", source)
+		return fmt.Errorf("daily XP cap for source %s reached: %w", source, domain.ErrDailyCapReached)
+	}
+	if int64(*actualAmount) > remaining {
+		*actualAmount = int(remaining)
+	}
+	return nil
+}
+
+// dailyWindowStart is the start of the current daily cap window: the last
+// daily reset, or the past 24 hours if no reset has run yet
+func (s *service) dailyWindowStart(ctx context.Context) time.Time {
+	status, err := s.GetDailyResetStatus(ctx)
+	if err != nil || status.LastResetTime.IsZero() {
+		return s.now().Add(-24 * time.Hour)
+	}
+	return status.LastResetTime
+}
+
+// recordXPAward writes the award to the audit trail. The XP has already been
+// granted, so a failure is logged rather than returned.
+func (s *service) recordXPAward(ctx context.Context, award *domain.XPAward) {
+	if err := s.repo.RecordXPAward(ctx, award); err != nil {
+		logger.FromContext(ctx).Error("Failed to record XP award", "user_id", award.UserID, "job", award.JobKey, "source", award.Source, "xp", award.XPAwarded, "error", err)
+	}
+}
+
+// GetRecentXPAwards returns the user's most recent XP awards, newest first.
+// A limit outside 1..MaxXPAwardHistoryLimit uses the default.
+func (s *service) GetRecentXPAwards(ctx context.Context, userID string, limit int) ([]domain.XPAward, error) {
+	if limit <= 0 || limit > MaxXPAwardHistoryLimit {
+		limit = DefaultXPAwardHistoryLimit
+	}
+	return s.repo.GetRecentXPAwards(ctx, userID, limit)
+}
@@ -0,0 +1,118 @@
+package job
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+)
+
+func TestAwardXP_SourceCaps(t *testing.T) {
+	ctx := context.Background()
+	lastReset := time.Date(2026, 10, 14, 17, 0, 0, 0, time.UTC)
+
+	setup := func(t *testing.T, earned int64) (*MockRepository, *service) {
+		t.Helper()
+		repo := new(MockRepository)
+		prog := new(MockProgressionService)
+		svc := NewService(repo, prog, nil, nil, false,
+			WithSourceCaps(map[string]int{SourceSearch: 100})).(*service)
+		svc.rnd = func() float64 { return 1.0 }
+
+		prog.On("IsNodeUnlocked", ctx, JobKeyExplorer, 1).Return(true, nil)
+		repo.On("GetJobByKey", ctx, JobKeyExplorer).Return(&domain.Job{ID: 2, JobKey: JobKeyExplorer}, nil)
+		repo.On("GetActiveXPBoost", ctx, "user1").Return(DefaultXPMultiplier, nil)
+		repo.On("GetUserJob", ctx, "user1", 2).Return(&domain.UserJob{UserID: "user1", JobID: 2}, nil)
+		prog.On("GetModifiedValue", ctx, "job_xp_multiplier", 1.0).Return(1.0, nil)
+		prog.On("GetModifiedValue", ctx, "job_level_cap", mock.Anything).Return(float64(DefaultMaxLevel), nil)
+		prog.On("GetModifiedValue", ctx, "job_daily_cap", float64(DefaultDailyCap)).Return(float64(DefaultDailyCap), nil).Maybe()
+		repo.On("GetLastDailyResetTime", ctx).Return(lastReset, int64(0), nil)
+		repo.On("GetSourceXPSince", ctx, "user1", SourceSearch, lastReset).Return(earned, nil)
+		repo.On("GetUserByID", ctx, "user1").Return(&domain.User{ID: "user1", Username: "tester", TwitchID: "t1"}, nil).Maybe()
+		repo.On("UpsertUserJob", ctx, mock.Anything).Return(nil).Maybe()
+		return repo, svc
+	}
+
+	t.Run("limits the award to what is left of the source cap", func(t *testing.T) {
+		repo, svc := setup(t, 70)
+		repo.On("RecordXPAward", ctx, mock.MatchedBy(func(award *domain.XPAward) bool {
+			return award.XPAwarded == 30 && award.BaseXP == 50 && award.CappedBy == XPCapSource && award.Source == SourceSearch
+		})).Return(nil)
+
+		result, err := svc.AwardXP(ctx, "user1", JobKeyExplorer, 50, SourceSearch, domain.JobXPMetadata{})
+
+		require.NoError(t, err)
+		assert.Equal(t, 30, result.XPGained)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("rejects the award once the source cap is spent", func(t *testing.T) {
+		repo, svc := setup(t, 100)
+
+		_, err := svc.AwardXP(ctx, "user1", JobKeyExplorer, 50, SourceSearch, domain.JobXPMetadata{})
+
+		assert.ErrorIs(t, err, domain.ErrDailyCapReached)
+		repo.AssertNotCalled(t, "UpsertUserJob", mock.Anything, mock.Anything)
+		repo.AssertNotCalled(t, "RecordXPAward", mock.Anything, mock.Anything)
+	})
+
+	t.Run("uncapped sources skip the lookup", func(t *testing.T) {
+		repo, svc := setup(t, 0)
+		repo.On("RecordXPAward", ctx, mock.MatchedBy(func(award *domain.XPAward) bool {
+			return award.XPAwarded == 50 && award.CappedBy == ""
+		})).Return(nil)
+
+		result, err := svc.AwardXP(ctx, "user1", JobKeyExplorer, 50, SourceQuest, domain.JobXPMetadata{})
+
+		require.NoError(t, err)
+		assert.Equal(t, 50, result.XPGained)
+		repo.AssertNotCalled(t, "GetSourceXPSince", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestAwardXP_AuditFailureDoesNotFailAward(t *testing.T) {
+	repo := new(MockRepository)
+	prog := new(MockProgressionService)
+	svc := NewService(repo, prog, nil, nil, false).(*service)
+	svc.rnd = func() float64 { return 1.0 }
+	ctx := context.Background()
+
+	prog.On("IsNodeUnlocked", ctx, JobKeyExplorer, 1).Return(true, nil)
+	repo.On("GetJobByKey", ctx, JobKeyExplorer).Return(&domain.Job{ID: 2, JobKey: JobKeyExplorer}, nil)
+	repo.On("GetActiveXPBoost", ctx, "user1").Return(DefaultXPMultiplier, nil)
+	repo.On("GetUserJob", ctx, "user1", 2).Return(&domain.UserJob{UserID: "user1", JobID: 2, XPGainedToday: int64(DefaultDailyCap - 20)}, nil)
+	prog.On("GetModifiedValue", ctx, "job_xp_multiplier", 1.0).Return(1.0, nil)
+	prog.On("GetModifiedValue", ctx, "job_level_cap", mock.Anything).Return(float64(DefaultMaxLevel), nil)
+	prog.On("GetModifiedValue", ctx, "job_daily_cap", float64(DefaultDailyCap)).Return(float64(DefaultDailyCap), nil)
+	repo.On("GetUserByID", ctx, "user1").Return(&domain.User{ID: "user1", Username: "tester", TwitchID: "t1"}, nil)
+	repo.On("UpsertUserJob", ctx, mock.Anything).Return(nil)
+	repo.On("RecordXPAward", ctx, mock.MatchedBy(func(award *domain.XPAward) bool {
+		return award.XPAwarded == 20 && award.CappedBy == XPCapDaily
+	})).Return(assert.AnError)
+
+	result, err := svc.AwardXP(ctx, "user1", JobKeyExplorer, 50, "test", domain.JobXPMetadata{})
+
+	require.NoError(t, err)
+	assert.Equal(t, 20, result.XPGained)
+	repo.AssertExpectations(t)
+}
+
+func TestGetRecentXPAwards_ClampsLimit(t *testing.T) {
+	repo := new(MockRepository)
+	svc := NewService(repo, new(MockProgressionService), nil, nil, false)
+	ctx := context.Background()
+
+	repo.On("GetRecentXPAwards", ctx, "user1", DefaultXPAwardHistoryLimit).Return([]domain.XPAward{{ID: 1}}, nil).Twice()
+	repo.On("GetRecentXPAwards", ctx, "user1", 10).Return([]domain.XPAward{}, nil).Once()
+
+	for _, limit := range []int{0, MaxXPAwardHistoryLimit + 1, 10} {
+		_, err := svc.GetRecentXPAwards(ctx, "user1", limit)
+		require.NoError(t, err)
+	}
+	repo.AssertExpectations(t)
+}
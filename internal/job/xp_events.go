@@ -0,0 +1,135 @@
+package job
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+)
+
+// XPEvent is a scheduled global XP multiplier, such as a double XP weekend
+type XPEvent struct {
+	Key        string    `json:"key"`
+	Name       string    `json:"name"`
+	Multiplier float64   `json:"multiplier"`
+	StartsAt   time.Time `json:"starts_at"`
+	EndsAt     time.Time `json:"ends_at"`
+	Jobs       []string  `json:"jobs,omitempty"` // Empty applies the event to every job
+}
+
+// appliesTo reports whether the event boosts jobKey at now
+func (e XPEvent) appliesTo(now time.Time, jobKey string) bool {
+	if now.Before(e.StartsAt) || !now.Before(e.EndsAt) {
+		return false
+	}
+	if len(e.Jobs) == 0 {
+		return true
+	}
+	for _, key := range e.Jobs {
+		if key == jobKey {
+			return true
+		}
+	}
+	return false
+}
+
+// XPEventConfig is the top-level JSON structure for xp_events.json
+type XPEventConfig struct {
+	Version string    `json:"version,omitempty"`
+	Events  []XPEvent `json:"events"`
+}
+
+// Validate checks that every event has a unique key, a multiplier above 1 and
+// at most MaxXPEventMultiplier, a window that ends after it starts, and only
+// known jobs.
+func (c XPEventConfig) Validate() error {
+	seen := make(map[string]bool, len(c.Events))
+	for i, evt := range c.Events {
+		if evt.Key == "" {
+			return fmt.Errorf("event %d: key is required", i)
+		}
+		if seen[evt.Key] {
+			return fmt.Errorf("event %s: duplicate key", evt.Key)
+		}
+		seen[evt.Key] = true
+		if evt.Multiplier <= DefaultXPMultiplier || evt.Multiplier > MaxXPEventMultiplier {
+			return fmt.Errorf("event %s: multiplier must be above %.1f and at most %.1f", evt.Key, DefaultXPMultiplier, MaxXPEventMultiplier)
+		}
+		if !evt.EndsAt.After(evt.StartsAt) {
+			return fmt.Errorf("event %s: ends_at must be after starts_at", evt.Key)
+		}
+		for _, jobKey := range evt.Jobs {
+			if !isKnownJob(jobKey) {
+				return fmt.Errorf("event %s: unknown job %q", evt.Key, jobKey)
+			}
+		}
+	}
+	return nil
+}
+
+// LoadXPEventConfig reads and validates the global XP event config file
+func LoadXPEventConfig(path string) (*XPEventConfig, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read XP event config: %w", err)
+	}
+
+	var config XPEventConfig
+	if err := json.Unmarshal(data, &config); err != nil {
+		return nil, fmt.Errorf("failed to parse XP event config: %w", err)
+	}
+
+	if err := config.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid XP event config: %w", err)
+	}
+
+	return &config, nil
+}
+
+// XPEventTable holds the scheduled global XP events and can be reloaded from its file
+type XPEventTable struct {
+	path string
+
+	mu     sync.RWMutex
+	config XPEventConfig
+}
+
+// NewXPEventTable loads the XP events from path
+func NewXPEventTable(path string) (*XPEventTable, error) {
+	config, err := LoadXPEventConfig(path)
+	if err != nil {
+		return nil, err
+	}
+	return &XPEventTable{path: path, config: *config}, nil
+}
+
+// Reload re-reads the events from their file, keeping the current ones if the file is invalid
+func (t *XPEventTable) Reload(ctx context.Context) error {
+	config, err := LoadXPEventConfig(t.path)
+	if err != nil {
+		return err
+	}
+	t.mu.Lock()
+	t.config = *config
+	t.mu.Unlock()
+	return nil
+}
+
+// Active returns the events boosting jobKey at now, in config order
+func (t *XPEventTable) Active(now time.Time, jobKey string) []XPEvent {
+	if t == nil {
+		return nil
+	}
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	var active []XPEvent
+	for _, evt := range t.config.Events {
+		if evt.appliesTo(now, jobKey) {
+			active = append(active, evt)
+		}
+	}
+	return active
+}
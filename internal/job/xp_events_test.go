@@ -0,0 +1,192 @@
+package job
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+)
+
+// fakeEffects returns a fixed user and community multiplier for each effect type
+type fakeEffects struct {
+	user      map[domain.EffectType]float64
+	community map[domain.EffectType]float64
+}
+
+func (f fakeEffects) Multiplier(ctx context.Context, userID string, effectType domain.EffectType) float64 {
+	if m, ok := f.user[effectType]; ok {
+		return m
+	}
+	return 1
+}
+
+func (f fakeEffects) CommunityMultiplier(ctx context.Context, effectType domain.EffectType) float64 {
+	if m, ok := f.community[effectType]; ok {
+		return m
+	}
+	return 1
+}
+
+func writeXPEvents(t *testing.T, json string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "xp_events.json")
+	require.NoError(t, os.WriteFile(path, []byte(json), 0o600))
+	return path
+}
+
+func TestAwardXP_MultiplierBreakdown(t *testing.T) {
+	path := writeXPEvents(t, `{"events": [
+		{"key": "double_weekend", "name": "Double XP Weekend", "multiplier": 2.0, "starts_at": "2026-10-10T00:00:00Z", "ends_at": "2026-10-12T00:00:00Z"},
+		{"key": "farm_fest", "name": "Farm Fest", "multiplier": 1.5, "starts_at": "2026-10-10T00:00:00Z", "ends_at": "2026-10-12T00:00:00Z", "jobs": ["job_farmer"]},
+		{"key": "next_week", "name": "Next Week", "multiplier": 3.0, "starts_at": "2026-10-17T00:00:00Z", "ends_at": "2026-10-18T00:00:00Z"}
+	]}`)
+	events, err := NewXPEventTable(path)
+	require.NoError(t, err)
+
+	repo := new(MockRepository)
+	prog := new(MockProgressionService)
+	svc := NewService(repo, prog, nil, nil, false,
+		WithEffects(fakeEffects{
+			user:      map[domain.EffectType]float64{domain.EffectJobXPBoost: 1.5},
+			community: map[domain.EffectType]float64{domain.EffectJobXPBoost: 1.25},
+		}), WithXPEvents(events)).(*service)
+	svc.rnd = func() float64 { return 1.0 }
+	svc.now = func() time.Time { return time.Date(2026, 10, 11, 12, 0, 0, 0, time.UTC) }
+	ctx := context.Background()
+
+	prog.On("IsNodeUnlocked", ctx, JobKeyExplorer, 1).Return(true, nil)
+	repo.On("GetJobByKey", ctx, JobKeyExplorer).Return(&domain.Job{ID: 2, JobKey: JobKeyExplorer}, nil)
+	repo.On("GetActiveXPBoost", ctx, "user1").Return(DefaultXPMultiplier, nil)
+	repo.On("GetUserJob", ctx, "user1", 2).Return(&domain.UserJob{UserID: "user1", JobID: 2, IsActive: true}, nil)
+	prog.On("GetModifiedValue", ctx, "job_xp_multiplier", 1.0).Return(1.2, nil)
+	prog.On("GetModifiedValue", ctx, "job_level_cap", mock.Anything).Return(float64(DefaultMaxLevel), nil)
+	prog.On("GetModifiedValue", ctx, "job_daily_cap", float64(DefaultDailyCap)).Return(float64(DefaultDailyCap), nil)
+	repo.On("GetUserByID", ctx, "user1").Return(&domain.User{ID: "user1", Username: "tester", TwitchID: "t1"}, nil)
+	repo.On("UpsertUserJob", ctx, mock.Anything).Return(nil)
+	repo.On("RecordXPAward", ctx, mock.Anything).Return(nil)
+
+	result, err := svc.AwardXP(ctx, "user1", JobKeyExplorer, 50, "test", domain.JobXPMetadata{})
+
+	require.NoError(t, err)
+	assert.Equal(t, []domain.XPMultiplier{
+		{Source: XPSourceProgression, Multiplier: 1.2},
+		{Source: XPSourceBooster, Multiplier: 1.5},
+		{Source: XPSourceCommunity, Multiplier: 1.25},
+		{Source: XPSourceActiveJob, Multiplier: 1.1},
+		{Source: XPSourceEvent, Name: "Double XP Weekend", Multiplier: 2.0},
+	}, result.Breakdown)
+	assert.Equal(t, 50, result.BaseXP)
+	assert.InDelta(t, 4.95, result.Multiplier, 1e-9)
+	assert.Equal(t, 247, result.XPGained)
+}
+
+func TestAwardXP_NoMultipliers(t *testing.T) {
+	repo := new(MockRepository)
+	prog := new(MockProgressionService)
+	svc := NewService(repo, prog, nil, nil, false).(*service)
+	svc.rnd = func() float64 { return 1.0 }
+	ctx := context.Background()
+
+	prog.On("IsNodeUnlocked", ctx, JobKeyExplorer, 1).Return(true, nil)
+	repo.On("GetJobByKey", ctx, JobKeyExplorer).Return(&domain.Job{ID: 2, JobKey: JobKeyExplorer}, nil)
+	repo.On("GetActiveXPBoost", ctx, "user1").Return(DefaultXPMultiplier, nil)
+	repo.On("GetUserJob", ctx, "user1", 2).Return(&domain.UserJob{UserID: "user1", JobID: 2}, nil)
+	prog.On("GetModifiedValue", ctx, "job_xp_multiplier", 1.0).Return(1.0, nil)
+	prog.On("GetModifiedValue", ctx, "job_level_cap", mock.Anything).Return(float64(DefaultMaxLevel), nil)
+	prog.On("GetModifiedValue", ctx, "job_daily_cap", float64(DefaultDailyCap)).Return(float64(DefaultDailyCap), nil)
+	repo.On("GetUserByID", ctx, "user1").Return(&domain.User{ID: "user1", Username: "tester", TwitchID: "t1"}, nil)
+	repo.On("UpsertUserJob", ctx, mock.Anything).Return(nil)
+	repo.On("RecordXPAward", ctx, mock.Anything).Return(nil)
+
+	result, err := svc.AwardXP(ctx, "user1", JobKeyExplorer, 40, "test", domain.JobXPMetadata{})
+
+	require.NoError(t, err)
+	assert.Empty(t, result.Breakdown)
+	assert.Equal(t, 1.0, result.Multiplier)
+	assert.Equal(t, 40, result.XPGained)
+}
+
+func TestLoadXPEventConfig_Invalid(t *testing.T) {
+	const window = `"starts_at": "2026-10-10T00:00:00Z", "ends_at": "2026-10-12T00:00:00Z"`
+	tests := []struct {
+		name string
+		json string
+	}{
+		{"missing key", `{"events": [{"multiplier": 2, ` + window + `}]}`},
+		{"duplicate key", `{"events": [{"key": "a", "multiplier": 2, ` + window + `}, {"key": "a", "multiplier": 2, ` + window + `}]}`},
+		{"multiplier not above 1", `{"events": [{"key": "a", "multiplier": 1, ` + window + `}]}`},
+		{"multiplier above max", `{"events": [{"key": "a", "multiplier": 5, ` + window + `}]}`},
+		{"ends before start", `{"events": [{"key": "a", "multiplier": 2, "starts_at": "2026-10-12T00:00:00Z", "ends_at": "2026-10-10T00:00:00Z"}]}`},
+		{"unknown job", `{"events": [{"key": "a", "multiplier": 2, ` + window + `, "jobs": ["job_astronaut"]}]}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := LoadXPEventConfig(writeXPEvents(t, tt.json))
+
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestXPEventTable_Active(t *testing.T) {
+	table, err := NewXPEventTable(writeXPEvents(t, `{"events": [
+		{"key": "harvest", "name": "Harvest Moon", "multiplier": 1.5, "starts_at": "2026-10-10T00:00:00Z", "ends_at": "2026-10-12T00:00:00Z", "jobs": ["job_farmer"]}
+	]}`))
+	require.NoError(t, err)
+	during := time.Date(2026, 10, 11, 0, 0, 0, 0, time.UTC)
+
+	assert.Len(t, table.Active(during, JobKeyFarmer), 1)
+	assert.Empty(t, table.Active(during, JobKeyExplorer))
+	assert.Empty(t, table.Active(time.Date(2026, 10, 12, 0, 0, 0, 0, time.UTC), JobKeyFarmer), "ends_at is exclusive")
+
+	var none *XPEventTable
+	assert.Empty(t, none.Active(during, JobKeyFarmer))
+}
+
+func TestXPEventsConfigFileLoads(t *testing.T) {
+	_, err := NewXPEventTable(filepath.Join("..", "..", "configs", "jobs", "xp_events.json"))
+
+	require.NoError(t, err)
+}
+
+type fakeGameEvents struct {
+	mods domain.GameModifiers
+}
+
+func (f fakeGameEvents) Modifiers(context.Context) domain.GameModifiers {
+	return f.mods
+}
+
+func TestAwardXP_GameEvent(t *testing.T) {
+	repo := new(MockRepository)
+	prog := new(MockProgressionService)
+	svc := NewService(repo, prog, nil, nil, false,
+		WithGameEvents(fakeGameEvents{mods: domain.GameModifiers{XPMultiplier: 2, DropRateMultiplier: 1}})).(*service)
+	svc.rnd = func() float64 { return 1.0 }
+	ctx := context.Background()
+
+	prog.On("IsNodeUnlocked", ctx, JobKeyExplorer, 1).Return(true, nil)
+	repo.On("GetJobByKey", ctx, JobKeyExplorer).Return(&domain.Job{ID: 2, JobKey: JobKeyExplorer}, nil)
+	repo.On("GetActiveXPBoost", ctx, "user1").Return(DefaultXPMultiplier, nil)
+	repo.On("GetUserJob", ctx, "user1", 2).Return(&domain.UserJob{UserID: "user1", JobID: 2}, nil)
+	prog.On("GetModifiedValue", ctx, "job_xp_multiplier", 1.0).Return(1.0, nil)
+	prog.On("GetModifiedValue", ctx, "job_level_cap", mock.Anything).Return(float64(DefaultMaxLevel), nil)
+	prog.On("GetModifiedValue", ctx, "job_daily_cap", float64(DefaultDailyCap)).Return(float64(DefaultDailyCap), nil)
+	repo.On("GetUserByID", ctx, "user1").Return(&domain.User{ID: "user1", Username: "tester", TwitchID: "t1"}, nil)
+	repo.On("UpsertUserJob", ctx, mock.Anything).Return(nil)
+	repo.On("RecordXPAward", ctx, mock.Anything).Return(nil)
+
+	result, err := svc.AwardXP(ctx, "user1", JobKeyExplorer, 40, "test", domain.JobXPMetadata{})
+
+	require.NoError(t, err)
+	assert.Equal(t, []domain.XPMultiplier{{Source: XPSourceGameEvent, Multiplier: 2}}, result.Breakdown)
+	assert.Equal(t, 80, result.XPGained)
+}
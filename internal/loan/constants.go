@@ -0,0 +1,28 @@
+package loan
+
+import "time"
+
+// JobType identifies loan return sweeps in the worker pool and scheduler
+const JobType = "loan_return"
+
+// Defaults, used when the configured values are not positive
+const (
+	// DefaultDuration is how long a loan lasts when the lender does not say
+	DefaultDuration = 24 * time.Hour
+	// DefaultMaxDuration caps how long a loan can last
+	DefaultMaxDuration = 7 * 24 * time.Hour
+	// DefaultBatchSize caps how many loans one sweep looks up at a time
+	DefaultBatchSize = 100
+)
+
+// MinDuration is the shortest loan allowed
+const MinDuration = time.Minute
+
+// Log messages
+const (
+	LogMsgItemLent       = "Item lent"
+	LogMsgLoanReturned   = "Loan returned"
+	LogMsgLoanDefaulted  = "Loan defaulted"
+	LogMsgLoansSwept     = "Returned due loans"
+	LogMsgLoanSweepError = "Failed to return due loan"
+)
@@ -0,0 +1,24 @@
+package loan
+
+import "context"
+
+// Job returns loans that have fallen due
+type Job struct {
+	service Service
+}
+
+// NewJob creates a loan return job
+func NewJob(service Service) *Job {
+	return &Job{service: service}
+}
+
+// JobType returns the worker pool job type
+func (j *Job) JobType() string {
+	return JobType
+}
+
+// Process returns every due loan to its lender
+func (j *Job) Process(ctx context.Context) error {
+	_, err := j.service.ReturnDue(ctx)
+	return err
+}
@@ -0,0 +1,43 @@
+package loan
+
+import (
+	"context"
+	"time"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/repository"
+)
+
+// Repository stores item loans
+type Repository interface {
+	BeginTx(ctx context.Context) (Tx, error)
+
+	// GetInventory reads a user's inventory without locking it
+	GetInventory(ctx context.Context, userID string) (*domain.Inventory, error)
+
+	// GetActiveLoans returns the active loans a user has made or taken,
+	// soonest due first
+	GetActiveLoans(ctx context.Context, userID string) ([]domain.ItemLoan, error)
+
+	// GetBorrowedQuantity returns how many of an item the user holds on
+	// active loans
+	GetBorrowedQuantity(ctx context.Context, userID string, itemID int) (int, error)
+
+	// GetDueLoanIDs returns up to limit active loans due at or before now,
+	// including loans whose borrower has been deleted
+	GetDueLoanIDs(ctx context.Context, now time.Time, limit int) ([]int64, error)
+}
+
+// Tx moves loaned items and records the loan in one transaction
+type Tx interface {
+	repository.Tx
+	repository.InventoryAdjuster
+
+	CreateLoan(ctx context.Context, loan domain.ItemLoan) (*domain.ItemLoan, error)
+
+	// GetActiveLoanForUpdate locks and returns an active loan, or nil if
+	// there is no active loan with that ID
+	GetActiveLoanForUpdate(ctx context.Context, id int64) (*domain.ItemLoan, error)
+
+	CloseLoan(ctx context.Context, id int64, status domain.LoanStatus, returnedQuantity int, closedAt time.Time) error
+}
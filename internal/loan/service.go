@@ -0,0 +1,415 @@
+package loan
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/osse101/BrandishBot_Go/internal/database"
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/event"
+	"github.com/osse101/BrandishBot_Go/internal/itemflags"
+	"github.com/osse101/BrandishBot_Go/internal/logger"
+	"github.com/osse101/BrandishBot_Go/internal/naming"
+	"github.com/osse101/BrandishBot_Go/internal/repository"
+	"github.com/osse101/BrandishBot_Go/internal/utils"
+)
+
+// Service lends items between users and returns them when loans fall due
+type Service interface {
+	// LendItem moves items from the lender to the borrower until the loan
+	// falls due. Items the lender has borrowed themselves cannot be lent on.
+	LendItem(ctx context.Context, req LendRequest) (*domain.ItemLoan, error)
+
+	// ReturnLoan hands a loan back to its lender before it falls due. Only
+	// the borrower can return a loan, and only in full.
+	ReturnLoan(ctx context.Context, platform, platformID string, id int64) (*domain.ItemLoan, error)
+
+	// GetLoans returns the active loans the user has made or taken
+	GetLoans(ctx context.Context, platform, platformID string) ([]domain.ItemLoan, error)
+
+	// ReturnDue returns due loans to their lenders and reports how many were
+	// closed. A borrower short of the items returns what they still have and
+	// the loan is marked defaulted.
+	ReturnDue(ctx context.Context) (int, error)
+}
+
+// LendRequest asks to lend items to another user
+type LendRequest struct {
+	Platform   string
+	PlatformID string
+	Username   string
+	// Borrower is the borrower's username on the same platform
+	Borrower string
+	ItemName string
+	Quantity int
+	// Duration is how long the loan lasts; zero uses the configured default
+	Duration time.Duration
+}
+
+// UserService defines the user operations needed by the loan service
+type UserService interface {
+	GetUserOrRegister(ctx context.Context, platform, platformID, username string) (*domain.User, error)
+	GetUserByPlatformUsername(ctx context.Context, platform, username string) (*domain.User, error)
+	GetUserIDByPlatformID(ctx context.Context, platform, platformID string) (string, error)
+}
+
+// ItemLookup finds items by name or ID
+type ItemLookup interface {
+	GetItemByName(ctx context.Context, itemName string) (*domain.Item, error)
+	GetItemByID(ctx context.Context, id int) (*domain.Item, error)
+}
+
+// CapacityChecker refuses credits that would overfill an inventory
+type CapacityChecker interface {
+	EnsureInventoryRoom(ctx context.Context, userID string, itemID int, qualityLevel domain.QualityLevel) error
+}
+
+// Publisher publishes loan events
+type Publisher interface {
+	PublishWithRetry(ctx context.Context, evt event.Event)
+}
+
+// Config tunes loans
+type Config struct {
+	// DefaultDuration is how long a loan lasts when the lender does not say
+	DefaultDuration time.Duration
+	// MaxDuration caps how long a loan can last
+	MaxDuration time.Duration
+	// BatchSize caps how many due loans one sweep returns
+	BatchSize int
+}
+
+// Option configures optional loan service dependencies
+type Option func(*service)
+
+// WithItemLocks stops users lending items they have locked
+func WithItemLocks(locks itemflags.LockChecker) Option {
+	return func(s *service) {
+		s.locks = locks
+	}
+}
+
+// WithCapacity holds loans and returns that would take the receiver past
+// their inventory slot limit
+func WithCapacity(capacity CapacityChecker) Option {
+	return func(s *service) {
+		s.capacity = capacity
+	}
+}
+
+type service struct {
+	repo           Repository
+	users          UserService
+	items          ItemLookup
+	namingResolver naming.Resolver
+	publisher      Publisher
+	locks          itemflags.LockChecker // nil ignores item locks
+	capacity       CapacityChecker       // nil ignores slot limits
+	cfg            Config
+	now            func() time.Time
+	rnd            func() float64
+}
+
+// NewService creates a loan service
+func NewService(repo Repository, users UserService, items ItemLookup, namingResolver naming.Resolver, publisher Publisher, cfg Config, opts ...Option) Service {
+	if cfg.DefaultDuration <= 0 {
+		cfg.DefaultDuration = DefaultDuration
+	}
+	if cfg.MaxDuration <= 0 {
+		cfg.MaxDuration = DefaultMaxDuration
+	}
+	if cfg.BatchSize <= 0 {
+		cfg.BatchSize = DefaultBatchSize
+	}
+	s := &service{
+		repo:           repo,
+		users:          users,
+		items:          items,
+		namingResolver: namingResolver,
+		publisher:      publisher,
+		cfg:            cfg,
+		now:            time.Now,
+		rnd:            utils.RandomFloat,
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+// LendItem lends items from one quality slot of the lender's inventory
+func (s *service) LendItem(ctx context.Context, req LendRequest) (*domain.ItemLoan, error) {
+	if req.Quantity <= 0 || req.Quantity > domain.MaxTransactionQuantity {
+		return nil, fmt.Errorf("%w: quantity must be between 1 and %d", domain.ErrInvalidInput, domain.MaxTransactionQuantity)
+	}
+	duration := req.Duration
+	if duration == 0 {
+		duration = s.cfg.DefaultDuration
+	}
+	if duration < MinDuration || duration > s.cfg.MaxDuration {
+		return nil, fmt.Errorf("%w: loans last between %s and %s", domain.ErrInvalidLoanDuration, MinDuration, s.cfg.MaxDuration)
+	}
+
+	lender, err := s.users.GetUserOrRegister(ctx, req.Platform, req.PlatformID, req.Username)
+	if err != nil {
+		return nil, err
+	}
+	borrower, err := s.users.GetUserByPlatformUsername(ctx, req.Platform, req.Borrower)
+	if err != nil {
+		return nil, err
+	}
+	if borrower == nil {
+		return nil, fmt.Errorf("%w: %s", domain.ErrUserNotFound, req.Borrower)
+	}
+	if borrower.ID == lender.ID {
+		return nil, domain.ErrCannotLendToSelf
+	}
+
+	item, err := naming.ResolveItem(ctx, s.namingResolver, s.items, req.ItemName)
+	if err != nil {
+		return nil, err
+	}
+	if err := itemflags.EnsureUnlocked(ctx, s.locks, lender.ID, item); err != nil {
+		return nil, err
+	}
+	quality, err := s.chooseLendSlot(ctx, lender.ID, item, req.Quantity)
+	if err != nil {
+		return nil, err
+	}
+	if s.capacity != nil {
+		if err := s.capacity.EnsureInventoryRoom(ctx, borrower.ID, item.ID, quality); err != nil {
+			return nil, fmt.Errorf("%w: %s has no free slot", err, borrower.Username)
+		}
+	}
+
+	tx, err := s.repo.BeginTx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer repository.SafeRollback(ctx, tx)
+
+	if err := transfer(ctx, tx, item.ID, quality, lender.ID, borrower.ID, req.Quantity); err != nil {
+		return nil, err
+	}
+	loan, err := tx.CreateLoan(ctx, domain.ItemLoan{
+		LenderID:     lender.ID,
+		BorrowerID:   borrower.ID,
+		ItemID:       item.ID,
+		QualityLevel: quality,
+		Quantity:     req.Quantity,
+		Status:       domain.LoanStatusActive,
+		DueAt:        s.now().Add(duration),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create loan: %w", err)
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	loan.ItemName = item.InternalName
+	s.publish(ctx, event.NewItemLoanedEvent(*loan))
+	logger.FromContext(ctx).Info(LogMsgItemLent, "loan_id", loan.ID, "lender", lender.ID, "borrower", borrower.ID, "item", item.InternalName, "quantity", loan.Quantity, "due_at", loan.DueAt)
+	return loan, nil
+}
+
+// chooseLendSlot picks the quality slot to lend from. Only items the lender
+// owns outright can be lent, and the loan must fit in a single slot.
+func (s *service) chooseLendSlot(ctx context.Context, lenderID string, item *domain.Item, quantity int) (domain.QualityLevel, error) {
+	inventory, err := s.repo.GetInventory(database.WithPrimaryReads(ctx), lenderID)
+	if err != nil {
+		return "", fmt.Errorf("failed to get inventory: %w", err)
+	}
+	borrowed, err := s.repo.GetBorrowedQuantity(ctx, lenderID, item.ID)
+	if err != nil {
+		return "", fmt.Errorf("failed to get borrowed quantity: %w", err)
+	}
+
+	owned := utils.GetTotalQuantity(inventory, item.ID) - borrowed
+	if owned < quantity {
+		if borrowed > 0 {
+			return "", fmt.Errorf("%w: %d of your %s are borrowed", domain.ErrItemBorrowed, borrowed, item.InternalName)
+		}
+		return "", fmt.Errorf("%w: have %d %s", domain.ErrInsufficientQuantity, owned, item.InternalName)
+	}
+
+	slotIndex, slotQuantity := utils.FindRandomSlot(inventory, item.ID, s.rnd)
+	if slotIndex == -1 {
+		return "", domain.ErrNotInInventory
+	}
+	if slotQuantity < quantity {
+		return "", fmt.Errorf("%w: a loan must come from items of one quality", domain.ErrInsufficientQuantity)
+	}
+	return inventory.Slots[slotIndex].QualityLevel, nil
+}
+
+// ReturnLoan settles the borrower's loan early
+func (s *service) ReturnLoan(ctx context.Context, platform, platformID string, id int64) (*domain.ItemLoan, error) {
+	userID, err := repository.RegisteredUserID(ctx, s.users, platform, platformID)
+	if err != nil {
+		return nil, err
+	}
+	return s.settle(ctx, id, userID)
+}
+
+// GetLoans returns the user's active loans
+func (s *service) GetLoans(ctx context.Context, platform, platformID string) ([]domain.ItemLoan, error) {
+	userID, err := repository.RegisteredUserID(ctx, s.users, platform, platformID)
+	if err != nil {
+		return nil, err
+	}
+	return s.repo.GetActiveLoans(ctx, userID)
+}
+
+// ReturnDue settles one batch of due loans. A loan that fails to settle,
+// including to a lender with a full inventory, is logged and left active, so
+// the next sweep tries it again.
+func (s *service) ReturnDue(ctx context.Context) (int, error) {
+	log := logger.FromContext(ctx)
+	ids, err := s.repo.GetDueLoanIDs(ctx, s.now(), s.cfg.BatchSize)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get due loans: %w", err)
+	}
+
+	returned := 0
+	for _, id := range ids {
+		if _, err := s.settle(ctx, id, ""); err != nil {
+			if errors.Is(err, domain.ErrLoanNotFound) {
+				continue // Closed by the borrower or another instance in the meantime
+			}
+			log.Error(LogMsgLoanSweepError, "loan_id", id, "error", err)
+			continue
+		}
+		returned++
+	}
+
+	if returned > 0 {
+		log.Info(LogMsgLoansSwept, "count", returned)
+	}
+	return returned, nil
+}
+
+// settle closes an active loan, moving the items back to the lender. An
+// early return names the borrower and must be in full; a due loan takes back
+// whatever the borrower still holds.
+func (s *service) settle(ctx context.Context, id int64, borrowerID string) (*domain.ItemLoan, error) {
+	early := borrowerID != ""
+
+	tx, err := s.repo.BeginTx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer repository.SafeRollback(ctx, tx)
+
+	loan, err := tx.GetActiveLoanForUpdate(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get loan: %w", err)
+	}
+	if loan == nil || (early && loan.BorrowerID != borrowerID) {
+		return nil, domain.ErrLoanNotFound
+	}
+
+	returned := loan.Quantity
+	status := domain.LoanStatusReturned
+	if loan.BorrowerID == "" {
+		// The borrower's inventory went with their account, so the lender
+		// is made whole
+		status = domain.LoanStatusDefaulted
+	} else {
+		held, err := s.heldQuantity(ctx, loan)
+		if err != nil {
+			return nil, err
+		}
+		if held < loan.Quantity {
+			if early {
+				return nil, fmt.Errorf("%w: you have %d of the %d borrowed", domain.ErrInsufficientQuantity, held, loan.Quantity)
+			}
+			returned = held
+			status = domain.LoanStatusDefaulted
+		}
+	}
+
+	if returned > 0 && s.capacity != nil {
+		if err := s.capacity.EnsureInventoryRoom(ctx, loan.LenderID, loan.ItemID, loan.QualityLevel); err != nil {
+			return nil, fmt.Errorf("%w: the lender has no free slot", err)
+		}
+	}
+	if returned > 0 {
+		if err := transfer(ctx, tx, loan.ItemID, loan.QualityLevel, loan.BorrowerID, loan.LenderID, returned); err != nil {
+			return nil, err
+		}
+	}
+	closedAt := s.now()
+	if err := tx.CloseLoan(ctx, loan.ID, status, returned, closedAt); err != nil {
+		return nil, fmt.Errorf("failed to close loan: %w", err)
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	loan.Status = status
+	loan.ReturnedQuantity = returned
+	loan.ClosedAt = &closedAt
+	if item, err := s.items.GetItemByID(ctx, loan.ItemID); err == nil && item != nil {
+		loan.ItemName = item.InternalName
+	}
+	s.publish(ctx, event.NewItemLoanReturnedEvent(*loan))
+
+	msg := LogMsgLoanReturned
+	if status == domain.LoanStatusDefaulted {
+		msg = LogMsgLoanDefaulted
+	}
+	logger.FromContext(ctx).Info(msg, "loan_id", loan.ID, "lender", loan.LenderID, "borrower", loan.BorrowerID, "quantity", loan.Quantity, "returned", returned, "early", early)
+	return loan, nil
+}
+
+// heldQuantity is how many of the loaned item, at the loaned quality, the
+// borrower still has. The read is not locked; if the inventory changes
+// before the transfer, the debit fails and the loan is settled later.
+func (s *service) heldQuantity(ctx context.Context, loan *domain.ItemLoan) (int, error) {
+	inventory, err := s.repo.GetInventory(database.WithPrimaryReads(ctx), loan.BorrowerID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get inventory: %w", err)
+	}
+	for _, slot := range inventory.Slots {
+		if slot.ItemID == loan.ItemID && slot.QualityLevel == loan.QualityLevel {
+			return slot.Quantity, nil
+		}
+	}
+	return 0, nil
+}
+
+// transfer moves quantity items of one quality between users. An empty
+// fromID only credits the receiver. The two slots are adjusted in user ID
+// order so opposing transfers cannot deadlock.
+func transfer(ctx context.Context, tx Tx, itemID int, quality domain.QualityLevel, fromID, toID string, quantity int) error {
+	type adjustment struct {
+		userID string
+		delta  int
+	}
+	adjustments := []adjustment{{toID, quantity}}
+	if fromID != "" {
+		adjustments = append(adjustments, adjustment{fromID, -quantity})
+		if fromID < toID {
+			adjustments[0], adjustments[1] = adjustments[1], adjustments[0]
+		}
+	}
+
+	for _, adj := range adjustments {
+		if _, err := tx.AdjustItemQuantity(ctx, adj.userID, itemID, quality, adj.delta); err != nil {
+			if errors.Is(err, domain.ErrInsufficientQuantity) {
+				return err
+			}
+			return fmt.Errorf("failed to update inventory: %w", err)
+		}
+	}
+	return nil
+}
+
+func (s *service) publish(ctx context.Context, evt event.Event) {
+	if s.publisher != nil {
+		s.publisher.PublishWithRetry(ctx, evt)
+	}
+}
@@ -0,0 +1,315 @@
+package loan_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/event"
+	"github.com/osse101/BrandishBot_Go/internal/loan"
+	"github.com/osse101/BrandishBot_Go/internal/loan/mocks"
+)
+
+const (
+	lenderID   = "lender-1"
+	borrowerID = "borrower-1"
+	swordID    = 7
+)
+
+func expectPublished(mockPublisher *mocks.MockPublisher, eventType event.Type, check func(event.ItemLoanPayloadV1)) {
+	mockPublisher.On("PublishWithRetry", mock.Anything, mock.MatchedBy(func(evt event.Event) bool {
+		if evt.Type != eventType {
+			return false
+		}
+		check(evt.Payload.(event.ItemLoanPayloadV1))
+		return true
+	}))
+}
+
+func swordSlot(quantity int) domain.InventorySlot {
+	return domain.InventorySlot{ItemID: swordID, Quantity: quantity, QualityLevel: domain.QualityRare}
+}
+
+func lendRequest(quantity int) loan.LendRequest {
+	return loan.LendRequest{
+		Platform:   domain.PlatformDiscord,
+		PlatformID: "d-1",
+		Username:   "alice",
+		Borrower:   "bob",
+		ItemName:   "sword",
+		Quantity:   quantity,
+	}
+}
+
+func TestLendItem(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("moves the items and records the loan", func(t *testing.T) {
+		mockRepo := mocks.NewMockRepository(t)
+		mockTx := mocks.NewMockTx(t)
+		mockUsers := mocks.NewMockUserService(t)
+		mockItems := mocks.NewMockItemLookup(t)
+		mockPublisher := mocks.NewMockPublisher(t)
+		svc := loan.NewService(mockRepo, mockUsers, mockItems, nil, mockPublisher, loan.Config{})
+
+		mockUsers.On("GetUserOrRegister", ctx, domain.PlatformDiscord, "d-1", "alice").Return(&domain.User{ID: lenderID}, nil)
+		mockUsers.On("GetUserByPlatformUsername", ctx, domain.PlatformDiscord, "bob").Return(&domain.User{ID: borrowerID}, nil)
+		mockItems.On("GetItemByName", ctx, "sword").Return(&domain.Item{ID: swordID, InternalName: "sword"}, nil)
+		mockRepo.On("GetInventory", mock.Anything, lenderID).Return(&domain.Inventory{Slots: []domain.InventorySlot{swordSlot(5)}}, nil)
+		mockRepo.On("GetBorrowedQuantity", ctx, lenderID, swordID).Return(0, nil)
+		mockRepo.On("BeginTx", mock.Anything).Return(mockTx, nil)
+		mockTx.On("Commit", mock.Anything).Return(nil)
+		mockTx.On("Rollback", mock.Anything).Return(nil).Maybe()
+		mockTx.On("AdjustItemQuantity", mock.Anything, lenderID, swordID, domain.QualityRare, -2).Return(0, nil)
+		mockTx.On("AdjustItemQuantity", mock.Anything, borrowerID, swordID, domain.QualityRare, 2).Return(0, nil)
+		mockTx.On("CreateLoan", mock.Anything, mock.Anything).Return(func(_ context.Context, l domain.ItemLoan) (*domain.ItemLoan, error) {
+			l.ID = 9
+			return &l, nil
+		})
+		expectPublished(mockPublisher, event.ItemLoaned, func(p event.ItemLoanPayloadV1) {
+			assert.Equal(t, int64(9), p.LoanID)
+			assert.Equal(t, "sword", p.ItemName)
+		})
+
+		got, err := svc.LendItem(ctx, lendRequest(2))
+
+		require.NoError(t, err)
+		assert.Equal(t, domain.LoanStatusActive, got.Status)
+		assert.Equal(t, domain.QualityRare, got.QualityLevel)
+		assert.WithinDuration(t, time.Now().Add(loan.DefaultDuration), got.DueAt, time.Second)
+	})
+
+	t.Run("borrowed items cannot be lent on", func(t *testing.T) {
+		mockRepo := mocks.NewMockRepository(t)
+		mockUsers := mocks.NewMockUserService(t)
+		mockItems := mocks.NewMockItemLookup(t)
+		mockPublisher := mocks.NewMockPublisher(t)
+		svc := loan.NewService(mockRepo, mockUsers, mockItems, nil, mockPublisher, loan.Config{})
+
+		mockUsers.On("GetUserOrRegister", ctx, domain.PlatformDiscord, "d-1", "alice").Return(&domain.User{ID: lenderID}, nil)
+		mockUsers.On("GetUserByPlatformUsername", ctx, domain.PlatformDiscord, "bob").Return(&domain.User{ID: borrowerID}, nil)
+		mockItems.On("GetItemByName", ctx, "sword").Return(&domain.Item{ID: swordID, InternalName: "sword"}, nil)
+		mockRepo.On("GetInventory", mock.Anything, lenderID).Return(&domain.Inventory{Slots: []domain.InventorySlot{swordSlot(3)}}, nil)
+		mockRepo.On("GetBorrowedQuantity", ctx, lenderID, swordID).Return(2, nil)
+
+		_, err := svc.LendItem(ctx, lendRequest(2))
+
+		assert.ErrorIs(t, err, domain.ErrItemBorrowed)
+	})
+
+	t.Run("borrowers with a full inventory are refused", func(t *testing.T) {
+		mockRepo := mocks.NewMockRepository(t)
+		mockUsers := mocks.NewMockUserService(t)
+		mockItems := mocks.NewMockItemLookup(t)
+		mockPublisher := mocks.NewMockPublisher(t)
+		mockCapacity := mocks.NewMockCapacityChecker(t)
+		svc := loan.NewService(mockRepo, mockUsers, mockItems, nil, mockPublisher, loan.Config{}, loan.WithCapacity(mockCapacity))
+
+		mockUsers.On("GetUserOrRegister", ctx, domain.PlatformDiscord, "d-1", "alice").Return(&domain.User{ID: lenderID}, nil)
+		mockUsers.On("GetUserByPlatformUsername", ctx, domain.PlatformDiscord, "bob").Return(&domain.User{ID: borrowerID}, nil)
+		mockItems.On("GetItemByName", ctx, "sword").Return(&domain.Item{ID: swordID, InternalName: "sword"}, nil)
+		mockRepo.On("GetInventory", mock.Anything, lenderID).Return(&domain.Inventory{Slots: []domain.InventorySlot{swordSlot(3)}}, nil)
+		mockRepo.On("GetBorrowedQuantity", ctx, lenderID, swordID).Return(0, nil)
+		mockCapacity.On("EnsureInventoryRoom", ctx, borrowerID, swordID, domain.QualityRare).Return(domain.ErrInventoryFull)
+
+		_, err := svc.LendItem(ctx, lendRequest(1))
+
+		assert.ErrorIs(t, err, domain.ErrInventoryFull)
+	})
+
+	t.Run("cannot lend to yourself", func(t *testing.T) {
+		mockRepo := mocks.NewMockRepository(t)
+		mockUsers := mocks.NewMockUserService(t)
+		mockItems := mocks.NewMockItemLookup(t)
+		mockPublisher := mocks.NewMockPublisher(t)
+		svc := loan.NewService(mockRepo, mockUsers, mockItems, nil, mockPublisher, loan.Config{})
+
+		mockUsers.On("GetUserOrRegister", ctx, domain.PlatformDiscord, "d-1", "alice").Return(&domain.User{ID: lenderID}, nil)
+		mockUsers.On("GetUserByPlatformUsername", ctx, domain.PlatformDiscord, "bob").Return(&domain.User{ID: lenderID}, nil)
+
+		_, err := svc.LendItem(ctx, lendRequest(1))
+
+		assert.ErrorIs(t, err, domain.ErrCannotLendToSelf)
+	})
+
+	t.Run("rejects loans longer than the maximum", func(t *testing.T) {
+		mockRepo := mocks.NewMockRepository(t)
+		mockUsers := mocks.NewMockUserService(t)
+		mockItems := mocks.NewMockItemLookup(t)
+		mockPublisher := mocks.NewMockPublisher(t)
+		svc := loan.NewService(mockRepo, mockUsers, mockItems, nil, mockPublisher, loan.Config{})
+
+		req := lendRequest(1)
+		req.Duration = loan.DefaultMaxDuration + time.Hour
+
+		_, err := svc.LendItem(ctx, req)
+
+		assert.ErrorIs(t, err, domain.ErrInvalidLoanDuration)
+	})
+}
+
+func activeLoan(borrower string) *domain.ItemLoan {
+	return &domain.ItemLoan{
+		ID:           9,
+		LenderID:     lenderID,
+		BorrowerID:   borrower,
+		ItemID:       swordID,
+		QualityLevel: domain.QualityRare,
+		Quantity:     3,
+		Status:       domain.LoanStatusActive,
+	}
+}
+
+func TestReturnDue(t *testing.T) {
+	ctx := context.Background()
+
+	expectDue := func(mockRepo *mocks.MockRepository, mockTx *mocks.MockTx, l *domain.ItemLoan) {
+		mockRepo.On("GetDueLoanIDs", ctx, mock.Anything, loan.DefaultBatchSize).Return([]int64{9}, nil)
+		mockTx.On("GetActiveLoanForUpdate", mock.Anything, int64(9)).Return(l, nil)
+	}
+
+	t.Run("returns the items in full", func(t *testing.T) {
+		mockRepo := mocks.NewMockRepository(t)
+		mockTx := mocks.NewMockTx(t)
+		mockUsers := mocks.NewMockUserService(t)
+		mockItems := mocks.NewMockItemLookup(t)
+		mockPublisher := mocks.NewMockPublisher(t)
+		svc := loan.NewService(mockRepo, mockUsers, mockItems, nil, mockPublisher, loan.Config{})
+
+		expectDue(mockRepo, mockTx, activeLoan(borrowerID))
+		mockRepo.On("BeginTx", mock.Anything).Return(mockTx, nil)
+		mockTx.On("Commit", mock.Anything).Return(nil)
+		mockTx.On("Rollback", mock.Anything).Return(nil).Maybe()
+		mockRepo.On("GetInventory", mock.Anything, borrowerID).Return(&domain.Inventory{Slots: []domain.InventorySlot{swordSlot(4)}}, nil)
+		mockTx.On("AdjustItemQuantity", mock.Anything, borrowerID, swordID, domain.QualityRare, -3).Return(0, nil)
+		mockTx.On("AdjustItemQuantity", mock.Anything, lenderID, swordID, domain.QualityRare, 3).Return(0, nil)
+		mockTx.On("CloseLoan", mock.Anything, int64(9), domain.LoanStatusReturned, 3, mock.Anything).Return(nil)
+		mockItems.On("GetItemByID", ctx, swordID).Return(&domain.Item{ID: swordID, InternalName: "sword"}, nil)
+		expectPublished(mockPublisher, event.ItemLoanReturned, func(p event.ItemLoanPayloadV1) {
+			assert.Equal(t, string(domain.LoanStatusReturned), p.Status)
+			assert.Equal(t, 3, p.ReturnedQuantity)
+		})
+
+		n, err := svc.ReturnDue(ctx)
+
+		require.NoError(t, err)
+		assert.Equal(t, 1, n)
+	})
+
+	t.Run("a short borrower returns what they have and defaults", func(t *testing.T) {
+		mockRepo := mocks.NewMockRepository(t)
+		mockTx := mocks.NewMockTx(t)
+		mockUsers := mocks.NewMockUserService(t)
+		mockItems := mocks.NewMockItemLookup(t)
+		mockPublisher := mocks.NewMockPublisher(t)
+		svc := loan.NewService(mockRepo, mockUsers, mockItems, nil, mockPublisher, loan.Config{})
+
+		expectDue(mockRepo, mockTx, activeLoan(borrowerID))
+		mockRepo.On("BeginTx", mock.Anything).Return(mockTx, nil)
+		mockTx.On("Commit", mock.Anything).Return(nil)
+		mockTx.On("Rollback", mock.Anything).Return(nil).Maybe()
+		mockRepo.On("GetInventory", mock.Anything, borrowerID).Return(&domain.Inventory{Slots: []domain.InventorySlot{swordSlot(1)}}, nil)
+		mockTx.On("AdjustItemQuantity", mock.Anything, borrowerID, swordID, domain.QualityRare, -1).Return(0, nil)
+		mockTx.On("AdjustItemQuantity", mock.Anything, lenderID, swordID, domain.QualityRare, 1).Return(0, nil)
+		mockTx.On("CloseLoan", mock.Anything, int64(9), domain.LoanStatusDefaulted, 1, mock.Anything).Return(nil)
+		mockItems.On("GetItemByID", ctx, swordID).Return(&domain.Item{ID: swordID, InternalName: "sword"}, nil)
+		expectPublished(mockPublisher, event.ItemLoanReturned, func(p event.ItemLoanPayloadV1) {
+			assert.Equal(t, string(domain.LoanStatusDefaulted), p.Status)
+		})
+
+		n, err := svc.ReturnDue(ctx)
+
+		require.NoError(t, err)
+		assert.Equal(t, 1, n)
+	})
+
+	t.Run("a deleted borrower's loan is restored to the lender", func(t *testing.T) {
+		mockRepo := mocks.NewMockRepository(t)
+		mockTx := mocks.NewMockTx(t)
+		mockUsers := mocks.NewMockUserService(t)
+		mockItems := mocks.NewMockItemLookup(t)
+		mockPublisher := mocks.NewMockPublisher(t)
+		svc := loan.NewService(mockRepo, mockUsers, mockItems, nil, mockPublisher, loan.Config{})
+
+		expectDue(mockRepo, mockTx, activeLoan(""))
+		mockRepo.On("BeginTx", mock.Anything).Return(mockTx, nil)
+		mockTx.On("Commit", mock.Anything).Return(nil)
+		mockTx.On("Rollback", mock.Anything).Return(nil).Maybe()
+		mockTx.On("AdjustItemQuantity", mock.Anything, lenderID, swordID, domain.QualityRare, 3).Return(0, nil)
+		mockTx.On("CloseLoan", mock.Anything, int64(9), domain.LoanStatusDefaulted, 3, mock.Anything).Return(nil)
+		mockItems.On("GetItemByID", ctx, swordID).Return(&domain.Item{ID: swordID, InternalName: "sword"}, nil)
+		expectPublished(mockPublisher, event.ItemLoanReturned, func(p event.ItemLoanPayloadV1) {
+			assert.Empty(t, p.BorrowerID)
+		})
+
+		n, err := svc.ReturnDue(ctx)
+
+		require.NoError(t, err)
+		assert.Equal(t, 1, n)
+	})
+
+	t.Run("skips loans closed in the meantime", func(t *testing.T) {
+		mockRepo := mocks.NewMockRepository(t)
+		mockTx := mocks.NewMockTx(t)
+		mockUsers := mocks.NewMockUserService(t)
+		mockItems := mocks.NewMockItemLookup(t)
+		mockPublisher := mocks.NewMockPublisher(t)
+		svc := loan.NewService(mockRepo, mockUsers, mockItems, nil, mockPublisher, loan.Config{})
+
+		expectDue(mockRepo, mockTx, nil)
+		mockRepo.On("BeginTx", mock.Anything).Return(mockTx, nil)
+		mockTx.On("Rollback", mock.Anything).Return(nil)
+
+		n, err := svc.ReturnDue(ctx)
+
+		require.NoError(t, err)
+		assert.Zero(t, n)
+	})
+}
+
+func TestReturnLoan(t *testing.T) {
+	ctx := context.Background()
+
+	start := func(mockRepo *mocks.MockRepository, mockTx *mocks.MockTx, mockUsers *mocks.MockUserService, l *domain.ItemLoan) {
+		mockUsers.On("GetUserIDByPlatformID", ctx, domain.PlatformDiscord, "d-2").Return(borrowerID, nil)
+		mockRepo.On("BeginTx", mock.Anything).Return(mockTx, nil)
+		mockTx.On("Rollback", mock.Anything).Return(nil)
+		mockTx.On("GetActiveLoanForUpdate", mock.Anything, int64(9)).Return(l, nil)
+	}
+
+	t.Run("only the borrower can return a loan", func(t *testing.T) {
+		mockRepo := mocks.NewMockRepository(t)
+		mockTx := mocks.NewMockTx(t)
+		mockUsers := mocks.NewMockUserService(t)
+		mockItems := mocks.NewMockItemLookup(t)
+		mockPublisher := mocks.NewMockPublisher(t)
+		svc := loan.NewService(mockRepo, mockUsers, mockItems, nil, mockPublisher, loan.Config{})
+
+		start(mockRepo, mockTx, mockUsers, activeLoan("someone-else"))
+
+		_, err := svc.ReturnLoan(ctx, domain.PlatformDiscord, "d-2", 9)
+
+		assert.ErrorIs(t, err, domain.ErrLoanNotFound)
+	})
+
+	t.Run("early returns must be in full", func(t *testing.T) {
+		mockRepo := mocks.NewMockRepository(t)
+		mockTx := mocks.NewMockTx(t)
+		mockUsers := mocks.NewMockUserService(t)
+		mockItems := mocks.NewMockItemLookup(t)
+		mockPublisher := mocks.NewMockPublisher(t)
+		svc := loan.NewService(mockRepo, mockUsers, mockItems, nil, mockPublisher, loan.Config{})
+
+		start(mockRepo, mockTx, mockUsers, activeLoan(borrowerID))
+		mockRepo.On("GetInventory", mock.Anything, borrowerID).Return(&domain.Inventory{Slots: []domain.InventorySlot{swordSlot(2)}}, nil)
+
+		_, err := svc.ReturnLoan(ctx, domain.PlatformDiscord, "d-2", 9)
+
+		assert.ErrorIs(t, err, domain.ErrInsufficientQuantity)
+	})
+}
@@ -42,6 +42,7 @@ type FlattenedLootbox struct {
 	PoolRefs        []flatPoolRef
 	TotalPoolWeight int
 	Pools           map[string]*FlatPool
+	PityThreshold   int // 0 = no pity
 }
 
 // ============================================================================
@@ -90,15 +91,18 @@ func (s *service) buildCache(path string) error {
 
 	// Build flattened pools (filtering by progression unlock status).
 	ctx := context.Background()
+	weightOverrides := s.loadWeightOverrides(ctx)
 	flatPools := make(map[string]*FlatPool, len(config.Pools))
 	for poolName, poolDef := range config.Pools {
-		fp, err := buildFlatPool(ctx, poolDef, itemByName, itemsByType, s.progressionSvc)
+		fp, err := buildFlatPool(ctx, poolDef, itemByName, itemsByType, weightOverrides[poolName], s.progressionSvc)
 		if err != nil {
 			return fmt.Errorf("pool %q: %w", poolName, err)
 		}
 		flatPools[poolName] = fp
 	}
 
+	s.addSeasonalPools(ctx, &config, flatPools, itemByName, itemsByType)
+
 	// Build flattened lootboxes.
 	cache := make(map[string]*FlattenedLootbox, len(config.Lootboxes))
 	for lbName, lbDef := range config.Lootboxes {
@@ -109,10 +113,51 @@ func (s *service) buildCache(path string) error {
 		cache[lbName] = flb
 	}
 
+	s.mu.Lock()
 	s.cache = cache
+	s.mu.Unlock()
 	return nil
 }
 
+// addSeasonalPools links the running seasons' pools into their lootboxes.
+// Seasonal entries skip the progression check, since the season already
+// limits when they drop. A pool that cannot be built is left out rather than
+// failing the build.
+func (s *service) addSeasonalPools(ctx context.Context, config *LootTableConfig, flatPools map[string]*FlatPool, itemByName map[string]*domain.Item, itemsByType map[string][]*domain.Item) {
+	if s.seasons == nil {
+		return
+	}
+	for _, pool := range s.seasons.ActiveLootPools(ctx) {
+		def, ok := config.Lootboxes[pool.Lootbox]
+		if !ok {
+			logger.Warn(LogMsgSeasonalPoolSkipped, "pool", pool.PoolName, "lootbox", pool.Lootbox, "error", "unknown lootbox")
+			continue
+		}
+		fp, err := buildFlatPool(ctx, PoolDef{Items: pool.Items}, itemByName, itemsByType, nil, nil)
+		if err != nil {
+			logger.Warn(LogMsgSeasonalPoolSkipped, "pool", pool.PoolName, "lootbox", pool.Lootbox, "error", err)
+			continue
+		}
+		flatPools[pool.PoolName] = fp
+		def.Pools = append(append([]PoolRef(nil), def.Pools...), PoolRef{PoolName: pool.PoolName, Weight: pool.Weight})
+		config.Lootboxes[pool.Lootbox] = def
+	}
+}
+
+// loadWeightOverrides fetches scheduled loot weight changes. A failed lookup
+// falls back to the loot tables file weights rather than failing the build.
+func (s *service) loadWeightOverrides(ctx context.Context) map[string]map[string]int {
+	if s.weights == nil {
+		return nil
+	}
+	overrides, err := s.weights.EffectiveLootWeights(ctx)
+	if err != nil {
+		logger.Warn("Failed to load loot weight overrides, using loot table weights", "error", err)
+		return nil
+	}
+	return overrides
+}
+
 // checkOrphans logs a warning for every item that is not referenced by any pool entry.
 // Money (domain.ItemMoney) is excluded because it is handled via the consolation path.
 func (s *service) checkOrphans(allItems []domain.Item, pools map[string]PoolDef) {
@@ -155,7 +200,8 @@ func (s *service) checkOrphans(allItems []domain.Item, pools map[string]PoolDef)
 
 // buildFlatPool resolves a PoolDef into a FlatPool with cumulative weights.
 // Items that are locked via progression are excluded, and weights are adjusted accordingly.
-func buildFlatPool(ctx context.Context, def PoolDef, itemByName map[string]*domain.Item, itemsByType map[string][]*domain.Item, progressionSvc ProgressionService) (*FlatPool, error) {
+// An item's weight in overrides replaces its entry weight; a zero weight drops it from the pool.
+func buildFlatPool(ctx context.Context, def PoolDef, itemByName map[string]*domain.Item, itemsByType map[string][]*domain.Item, overrides map[string]int, progressionSvc ProgressionService) (*FlatPool, error) {
 	fp := &FlatPool{}
 
 	for _, entry := range def.Items {
@@ -172,7 +218,12 @@ func buildFlatPool(ctx context.Context, def PoolDef, itemByName map[string]*doma
 				continue
 			}
 
-			fp.TotalWeight += entry.Weight
+			weight := entryWeight(entry.Weight, item.InternalName, overrides)
+			if weight == 0 {
+				continue
+			}
+
+			fp.TotalWeight += weight
 			fp.Entries = append(fp.Entries, FlatPoolEntry{
 				ItemName:    entry.ItemName,
 				CumulWeight: fp.TotalWeight,
@@ -191,7 +242,12 @@ func buildFlatPool(ctx context.Context, def PoolDef, itemByName map[string]*doma
 					continue
 				}
 
-				fp.TotalWeight += entry.Weight
+				weight := entryWeight(entry.Weight, item.InternalName, overrides)
+				if weight == 0 {
+					continue
+				}
+
+				fp.TotalWeight += weight
 				fp.Entries = append(fp.Entries, FlatPoolEntry{
 					ItemName:    item.InternalName,
 					CumulWeight: fp.TotalWeight,
@@ -205,6 +261,15 @@ func buildFlatPool(ctx context.Context, def PoolDef, itemByName map[string]*doma
 	return fp, nil
 }
 
+// entryWeight returns the scheduled weight for an item if there is one, else
+// the weight from the loot tables file
+func entryWeight(weight int, itemName string, overrides map[string]int) int {
+	if override, ok := overrides[itemName]; ok {
+		return override
+	}
+	return weight
+}
+
 // isItemUnlocked checks if an item is unlocked via the progression system.
 // Returns true if progressionSvc is nil (no progression checks) or if the item is unlocked.
 func isItemUnlocked(ctx context.Context, itemInternalName string, progressionSvc ProgressionService) bool {
@@ -225,11 +290,12 @@ func isItemUnlocked(ctx context.Context, itemInternalName string, progressionSvc
 // buildFlattenedLootbox resolves a Def into a FlattenedLootbox.
 func buildFlattenedLootbox(def Def, flatPools map[string]*FlatPool, moneyItem *domain.Item) (*FlattenedLootbox, error) {
 	flb := &FlattenedLootbox{
-		ItemDropRate: def.ItemDropRate,
-		MoneyMin:     def.FixedMoney.Min,
-		MoneyMax:     def.FixedMoney.Max,
-		MoneyItem:    moneyItem,
-		Pools:        make(map[string]*FlatPool, len(def.Pools)),
+		ItemDropRate:  def.ItemDropRate,
+		MoneyMin:      def.FixedMoney.Min,
+		MoneyMax:      def.FixedMoney.Max,
+		MoneyItem:     moneyItem,
+		Pools:         make(map[string]*FlatPool, len(def.Pools)),
+		PityThreshold: def.PityThreshold,
 	}
 
 	// Ensure MoneyMax >= MoneyMin.
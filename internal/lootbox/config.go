@@ -33,6 +33,9 @@ type Def struct {
 	ItemDropRate float64    `json:"item_drop_rate"` // gatekeeper probability [0,1]
 	FixedMoney   MoneyRange `json:"fixed_money"`
 	Pools        []PoolRef  `json:"pools"`
+	// PityThreshold guarantees a RARE or better item once a user has opened this many
+	// boxes in a row without one. Zero disables pity for this lootbox.
+	PityThreshold int `json:"pity_threshold,omitempty"`
 }
 
 // LootTableConfig is the top-level v2 config structure.
@@ -1,5 +1,7 @@
 package lootbox
 
+import "github.com/osse101/BrandishBot_Go/internal/domain"
+
 // ============================================================================
 // Quality Rarity Thresholds
 // ============================================================================
@@ -34,6 +36,22 @@ const QualityJunkThreshold = 0.95
 // have its quality level upgraded by one tier.
 const CriticalQualityUpgradeChance = 0.01
 
+// RevealNearMissMargin is how far above the next better tier's threshold a
+// quality roll may land and still be reported as a near miss.
+const RevealNearMissMargin = 0.02
+
+// ============================================================================
+// Pity
+// ============================================================================
+
+// PityMinimumQuality is the quality a pity drop is lifted to if it rolls lower.
+// Any drop at or above it also resets the user's pity counter.
+const PityMinimumQuality = domain.QualityRare
+
+// PityMaxRerolls bounds how many weighted rolls are spent looking for a
+// non-currency item in an unlocked pool when pity triggers.
+const PityMaxRerolls = 10
+
 // ============================================================================
 // Configuration
 // ============================================================================
@@ -64,11 +82,19 @@ const (
 // Log Messages
 // ============================================================================
 
+// Info messages
+const (
+	LogMsgPityTriggered = "Lootbox pity triggered, guaranteeing a rare drop"
+)
+
 // Warning messages for missing or invalid data
 const (
-	LogMsgNoLootTableFound   = "No loot table found for lootbox"
-	LogMsgDroppedItemNotInDB = "Dropped item not found in DB"
-	LogMsgOrphanedItem       = "Item not referenced in any pool (orphaned)"
+	LogMsgNoLootTableFound    = "No loot table found for lootbox"
+	LogMsgDroppedItemNotInDB  = "Dropped item not found in DB"
+	LogMsgOrphanedItem        = "Item not referenced in any pool (orphaned)"
+	LogMsgPityLoadFailed      = "Failed to load lootbox pity counter, opening without pity"
+	LogMsgPitySaveFailed      = "Failed to save lootbox pity counter"
+	LogMsgSeasonalPoolSkipped = "Seasonal loot pool skipped"
 )
 
 // Log field keys for structured logging
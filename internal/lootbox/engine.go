@@ -18,16 +18,16 @@ type dropInfo struct {
 
 // processLootTable runs the 3-stage pipeline for `quantity` box opens.
 // Returns accumulated item drops and total consolation money.
-func (s *service) processLootTable(flat *FlattenedLootbox, quantity int) (map[string]*dropInfo, int) {
+func (s *service) processLootTable(flat *FlattenedLootbox, quantity int, rnd func() float64) (map[string]*dropInfo, int) {
 	dropCounts := make(map[string]*dropInfo)
 	consolationMoney := 0
 
 	for i := 0; i < quantity; i++ {
 		// Stage 1 — Gatekeeper roll.
-		if s.rnd() >= flat.ItemDropRate {
+		if rnd() >= flat.ItemDropRate {
 			// Gatekeeper failed: award consolation money.
-			base := s.rnd()*float64(flat.MoneyMax-flat.MoneyMin) + float64(flat.MoneyMin)
-			jitter := 1.0 + (s.rnd()-0.5)*(1.0-flat.ItemDropRate)
+			base := rnd()*float64(flat.MoneyMax-flat.MoneyMin) + float64(flat.MoneyMin)
+			jitter := 1.0 + (rnd()-0.5)*(1.0-flat.ItemDropRate)
 			amount := int(math.Round(base * jitter))
 			if amount < 1 {
 				amount = 1
@@ -37,13 +37,13 @@ func (s *service) processLootTable(flat *FlattenedLootbox, quantity int) (map[st
 		}
 
 		// Stage 2 — Pool selection (weighted).
-		poolName := selectPool(flat, s.rnd())
+		poolName := selectPool(flat, rnd())
 		pool := flat.Pools[poolName]
 
 		// If pool is empty (all items locked), treat as gatekeeper failure.
 		if len(pool.Entries) == 0 {
-			base := s.rnd()*float64(flat.MoneyMax-flat.MoneyMin) + float64(flat.MoneyMin)
-			jitter := 1.0 + (s.rnd()-0.5)*(1.0-flat.ItemDropRate)
+			base := rnd()*float64(flat.MoneyMax-flat.MoneyMin) + float64(flat.MoneyMin)
+			jitter := 1.0 + (rnd()-0.5)*(1.0-flat.ItemDropRate)
 			amount := int(math.Round(base * jitter))
 			if amount < 1 {
 				amount = 1
@@ -53,7 +53,7 @@ func (s *service) processLootTable(flat *FlattenedLootbox, quantity int) (map[st
 		}
 
 		// Stage 3 — Item selection (weighted).
-		entry := selectItem(pool, s.rnd())
+		entry := selectItem(pool, rnd())
 
 		if info, ok := dropCounts[entry.ItemName]; ok {
 			info.Qty++
@@ -13,4 +13,16 @@ type DroppedItem struct {
 	Quantity     int
 	Value        int
 	QualityLevel domain.QualityLevel
+	Reveal       Reveal
+}
+
+// Reveal describes how a drop was rolled so clients can animate the opening.
+type Reveal struct {
+	TierRank     int                 `json:"tier_rank"`    // 0 (CURSED) to 7 (LEGENDARY)
+	QualityRoll  float64             `json:"quality_roll"` // raw roll in [0,1); lower is rarer
+	NearMiss     bool                `json:"near_miss"`    // roll just missed the next better tier
+	NearMissTier domain.QualityLevel `json:"near_miss_tier,omitempty"`
+	Upgraded     bool                `json:"upgraded"`    // critical quality upgrade applied
+	Pity         bool                `json:"pity"`        // guaranteed by the pity counter
+	Consolation  bool                `json:"consolation"` // money awarded because no item dropped
 }
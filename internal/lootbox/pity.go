@@ -0,0 +1,82 @@
+package lootbox
+
+import (
+	"context"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/logger"
+	"github.com/osse101/BrandishBot_Go/internal/utils"
+)
+
+// loadPity returns the user's current pity counter for a lootbox and whether
+// pity applies at all. Pity is skipped for anonymous opens, lootboxes without a
+// threshold, or when the counter cannot be read.
+func (s *service) loadPity(ctx context.Context, userID, lootboxName string, flat *FlattenedLootbox) (int, bool) {
+	if s.pityRepo == nil || userID == "" || flat.PityThreshold <= 0 {
+		return 0, false
+	}
+
+	count, err := s.pityRepo.GetPityCount(ctx, userID, lootboxName)
+	if err != nil {
+		logger.FromContext(ctx).Warn(LogMsgPityLoadFailed, LogFieldLootbox, lootboxName, "user_id", userID, LogFieldError, err)
+		return 0, false
+	}
+	return count, true
+}
+
+// savePity stores the new counter. A failed write only delays the guarantee, so it is logged rather than returned.
+func (s *service) savePity(ctx context.Context, userID, lootboxName string, previous, next int) {
+	if next == previous {
+		return
+	}
+	if err := s.pityRepo.SetPityCount(ctx, userID, lootboxName, next); err != nil {
+		logger.FromContext(ctx).Warn(LogMsgPitySaveFailed, LogFieldLootbox, lootboxName, "user_id", userID, LogFieldError, err)
+	}
+}
+
+// nextPityCount resets the counter when the batch produced a RARE or better
+// drop, and otherwise adds every opened box to it. Currency is always COMMON,
+// so consolation money never resets it.
+func (s *service) nextPityCount(current, opened int, drops []DroppedItem) int {
+	for _, d := range drops {
+		if s.isRareOrBetter(d.QualityLevel) {
+			return 0
+		}
+	}
+	return current + opened
+}
+
+func (s *service) isRareOrBetter(q domain.QualityLevel) bool {
+	return s.getQualityDistance(q) >= s.getQualityDistance(PityMinimumQuality)
+}
+
+// selectPityEntry picks the guaranteed item using the lootbox's normal pool and
+// item weights, skipping empty pools and currency. Returns nil if no eligible
+// item was found within PityMaxRerolls attempts.
+func (s *service) selectPityEntry(flat *FlattenedLootbox, rnd func() float64) *FlatPoolEntry {
+	for i := 0; i < PityMaxRerolls; i++ {
+		pool := flat.Pools[selectPool(flat, rnd())]
+		if len(pool.Entries) == 0 {
+			continue
+		}
+		entry := selectItem(pool, rnd())
+		if entry.Item == nil || entry.Item.IsCurrency() {
+			continue
+		}
+		return entry
+	}
+	return nil
+}
+
+// pityDrop rolls quality for the guaranteed item as usual, then lifts it to RARE if it rolled lower.
+func (s *service) pityDrop(ctx context.Context, entry *FlatPoolEntry, boxQuality domain.QualityLevel, rnd func() float64) DroppedItem {
+	qr := s.calculateQuality(rnd, boxQuality, s.canUpgradeQuality(ctx))
+	if !s.isRareOrBetter(qr.quality) {
+		qr.quality = PityMinimumQuality
+		qr.multiplier = utils.GetQualityMultiplier(qr.quality)
+		qr.nearMiss = ""
+	}
+	drop := s.constructDroppedItem(entry.Item, 1, qr)
+	drop.Reveal.Pity = true
+	return drop
+}
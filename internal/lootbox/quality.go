@@ -23,28 +23,50 @@ var qualityThresholds = []qualityThreshold{
 	{QualityJunkThreshold, domain.QualityJunk},
 }
 
-// calculateQuality determines the visual rarity "quality" and value multiplier of a drop based on a roll.
+// qualityRoll is the outcome of a quality roll, kept so drops can carry reveal metadata.
+type qualityRoll struct {
+	quality    domain.QualityLevel
+	multiplier float64
+	roll       float64
+	upgraded   bool                // critical upgrade applied
+	nearMiss   domain.QualityLevel // better tier missed by at most RevealNearMissMargin, "" if none
+}
+
+// calculateQuality determines the visual rarity "quality" and value multiplier of a drop from a roll of rnd.
 // The boxQuality level shifts the constraints: a more rare box makes it easier to get rare item quality levels.
-func (s *service) calculateQuality(roll float64, boxQuality domain.QualityLevel, canUpgrade bool) (domain.QualityLevel, float64) {
+func (s *service) calculateQuality(rnd func() float64, boxQuality domain.QualityLevel, canUpgrade bool) qualityRoll {
+	roll := rnd()
 	dist := s.getQualityDistance(boxQuality)
 	bonus := 0.03 * float64(dist)
 
 	// Default to Cursed if no threshold is met (roll > QualityJunkThreshold + bonus)
 	quality := domain.QualityCursed
+	better := len(qualityThresholds) - 1 // tier just above the result
 
-	for _, qt := range qualityThresholds {
+	for i, qt := range qualityThresholds {
 		if roll <= qt.threshold+bonus {
 			quality = qt.quality
+			better = i - 1
 			break
 		}
 	}
 
+	result := qualityRoll{roll: roll}
+	if better >= 0 && roll-(qualityThresholds[better].threshold+bonus) <= RevealNearMissMargin {
+		result.nearMiss = qualityThresholds[better].quality
+	}
+
 	// Critical Quality Upgrade: 1% chance to upgrade the quality level (locked by progression)
-	if canUpgrade && s.rnd() < CriticalQualityUpgradeChance {
+	if canUpgrade && rnd() < CriticalQualityUpgradeChance {
 		quality = s.getNextQualityLevel(quality)
+		result.upgraded = true
+		// The upgrade already lands on the tier that was nearly hit
+		result.nearMiss = ""
 	}
 
-	return quality, utils.GetQualityMultiplier(quality)
+	result.quality = quality
+	result.multiplier = utils.GetQualityMultiplier(quality)
+	return result
 }
 
 func (s *service) getNextQualityLevel(q domain.QualityLevel) domain.QualityLevel {
@@ -2,6 +2,8 @@ package lootbox
 
 import (
 	"context"
+	"maps"
+	"slices"
 
 	"github.com/osse101/BrandishBot_Go/internal/domain"
 	"github.com/osse101/BrandishBot_Go/internal/logger"
@@ -9,27 +11,21 @@ import (
 
 // convertToDroppedItems applies quality rolls to pool drops and appends consolation money.
 // Items are pre-fetched in dropInfo.Item, so no database call is needed here.
-func (s *service) convertToDroppedItems(ctx context.Context, dropCounts map[string]*dropInfo, consolationMoney int, moneyItem *domain.Item, boxQuality domain.QualityLevel) ([]DroppedItem, error) {
+// Items are rolled in name order so a seeded rnd always gives the same result.
+func (s *service) convertToDroppedItems(ctx context.Context, dropCounts map[string]*dropInfo, consolationMoney int, moneyItem *domain.Item, boxQuality domain.QualityLevel, rnd func() float64) ([]DroppedItem, error) {
 	log := logger.FromContext(ctx)
-
-	// Check if lucky upgrade is unlocked via progression.
-	canUpgrade := false
-	if s.progressionSvc != nil {
-		unlocked, err := s.progressionSvc.IsNodeUnlocked(ctx, "feature_gamble", 1)
-		if err == nil {
-			canUpgrade = unlocked
-		}
-	}
+	canUpgrade := s.canUpgradeQuality(ctx)
 
 	drops := make([]DroppedItem, 0, len(dropCounts)+1)
 
-	for itemName, info := range dropCounts {
+	for _, itemName := range slices.Sorted(maps.Keys(dropCounts)) {
+		info := dropCounts[itemName]
 		if info.Item == nil {
 			log.Warn(LogMsgDroppedItemNotInDB, LogFieldItem, itemName)
 			continue
 		}
-		quality, mult := s.calculateQuality(s.rnd(), boxQuality, canUpgrade)
-		drops = append(drops, s.constructDroppedItem(info.Item, info.Qty, quality, mult))
+		qr := s.calculateQuality(rnd, boxQuality, canUpgrade)
+		drops = append(drops, s.constructDroppedItem(info.Item, info.Qty, qr))
 	}
 
 	// Consolation money bypasses the quality roll and is forced to COMMON.
@@ -41,6 +37,7 @@ func (s *service) convertToDroppedItems(ctx context.Context, dropCounts map[stri
 				Quantity:     consolationMoney,
 				Value:        moneyItem.BaseValue,
 				QualityLevel: domain.QualityCommon,
+				Reveal:       Reveal{TierRank: s.tierRank(domain.QualityCommon), Consolation: true},
 			})
 		} else {
 			log.Warn("Consolation money cannot be awarded: money item not found in database")
@@ -50,7 +47,17 @@ func (s *service) convertToDroppedItems(ctx context.Context, dropCounts map[stri
 	return drops, nil
 }
 
-func (s *service) constructDroppedItem(item *domain.Item, qty int, quality domain.QualityLevel, mult float64) DroppedItem {
+// canUpgradeQuality reports whether the lucky quality upgrade is unlocked via progression.
+func (s *service) canUpgradeQuality(ctx context.Context) bool {
+	if s.progressionSvc == nil {
+		return false
+	}
+	unlocked, err := s.progressionSvc.IsNodeUnlocked(ctx, "feature_gamble", 1)
+	return err == nil && unlocked
+}
+
+func (s *service) constructDroppedItem(item *domain.Item, qty int, qr qualityRoll) DroppedItem {
+	quality, mult := qr.quality, qr.multiplier
 	quantity := qty
 	boostedValue := int(float64(item.BaseValue) * mult)
 
@@ -75,5 +82,17 @@ func (s *service) constructDroppedItem(item *domain.Item, qty int, quality domai
 		Quantity:     quantity,
 		Value:        boostedValue,
 		QualityLevel: quality,
+		Reveal: Reveal{
+			TierRank:     s.tierRank(quality),
+			QualityRoll:  qr.roll,
+			NearMiss:     qr.nearMiss != "" && !item.IsCurrency(),
+			NearMissTier: qr.nearMiss,
+			Upgraded:     qr.upgraded && !item.IsCurrency(),
+		},
 	}
 }
+
+// tierRank orders quality levels from CURSED (0) to LEGENDARY (7).
+func (s *service) tierRank(q domain.QualityLevel) int {
+	return s.getQualityDistance(q) - s.getQualityDistance(domain.QualityCursed)
+}
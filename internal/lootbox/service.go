@@ -3,10 +3,13 @@ package lootbox
 import (
 	"context"
 	"fmt"
+	"sync"
 
 	"github.com/osse101/BrandishBot_Go/internal/domain"
 	"github.com/osse101/BrandishBot_Go/internal/event"
+	"github.com/osse101/BrandishBot_Go/internal/gameevent"
 	"github.com/osse101/BrandishBot_Go/internal/logger"
+	"github.com/osse101/BrandishBot_Go/internal/rng"
 	"github.com/osse101/BrandishBot_Go/internal/utils"
 	"github.com/osse101/BrandishBot_Go/internal/validation"
 )
@@ -22,9 +25,39 @@ type ItemRepository interface {
 	GetAllItems(ctx context.Context) ([]domain.Item, error)
 }
 
+// PityRepository persists per-user pity counters: the number of consecutive
+// openings of a lootbox type that produced no RARE or better drop.
+type PityRepository interface {
+	GetPityCount(ctx context.Context, userID, lootboxName string) (int, error)
+	SetPityCount(ctx context.Context, userID, lootboxName string, count int) error
+}
+
+// WeightProvider supplies scheduled loot weight changes that override the
+// weights in the loot tables file, keyed by pool name and then item name.
+type WeightProvider interface {
+	EffectiveLootWeights(ctx context.Context) (map[string]map[string]int, error)
+}
+
+// SeasonalLoot supplies the extra pools of the seasonal content packs
+// running now. It is read on every cache build.
+type SeasonalLoot interface {
+	ActiveLootPools(ctx context.Context) []SeasonalPool
+}
+
+// SeasonalPool adds a pool to one lootbox while its season runs
+type SeasonalPool struct {
+	Lootbox  string
+	PoolName string
+	Weight   int // selection weight against the lootbox's other pools
+	Items    []PoolItemDef
+}
+
 // Service defines the lootbox opening interface.
 type Service interface {
-	OpenLootbox(ctx context.Context, lootboxName string, quantity int, boxQuality domain.QualityLevel) ([]DroppedItem, error)
+	// OpenLootbox opens quantity boxes for userID. An empty userID opens them without pity tracking.
+	OpenLootbox(ctx context.Context, userID, lootboxName string, quantity int, boxQuality domain.QualityLevel) ([]DroppedItem, error)
+	// Reload re-reads the loot tables file and swaps the cache only if it builds cleanly
+	Reload(ctx context.Context) error
 }
 
 // ProgressionService defines the interface for checking feature unlocks.
@@ -47,11 +80,56 @@ func WithRnd(rnd func() float64) Option {
 	}
 }
 
+// WithRNG draws each opening from its own seeded source, overriding WithRnd.
+// Seeds are logged when the provider has seed logging on.
+func WithRNG(provider *rng.Provider) Option {
+	return func(s *service) {
+		s.rng = provider
+	}
+}
+
+// WithPityRepository enables pity counters for lootboxes that define a pity_threshold.
+func WithPityRepository(repo PityRepository) Option {
+	return func(s *service) {
+		s.pityRepo = repo
+	}
+}
+
+// WithWeightOverrides applies scheduled loot weight changes on every cache
+// build. The cache is rebuilt whenever a balance change takes effect.
+func WithWeightOverrides(provider WeightProvider) Option {
+	return func(s *service) {
+		s.weights = provider
+	}
+}
+
+// WithSeasonalLoot adds the pools of running seasons on every cache build.
+// The cache is rebuilt whenever a season starts or ends.
+func WithSeasonalLoot(seasons SeasonalLoot) Option {
+	return func(s *service) {
+		s.seasons = seasons
+	}
+}
+
+// WithGameEvents raises each box's item drop rate by the drop rate
+// multiplier of running admin game events
+func WithGameEvents(events gameevent.ModifierSource) Option {
+	return func(s *service) {
+		s.gameEvents = events
+	}
+}
+
 type service struct {
 	repo            ItemRepository
 	progressionSvc  ProgressionService
-	cache           map[string]*FlattenedLootbox // read-only after NewService
+	pityRepo        PityRepository           // nil disables pity
+	weights         WeightProvider           // nil uses the loot tables file weights as-is
+	gameEvents      gameevent.ModifierSource // nil ignores admin game events
+	seasons         SeasonalLoot             // nil adds no seasonal pools
+	mu              sync.RWMutex
+	cache           map[string]*FlattenedLootbox // replaced wholesale on rebuild
 	rnd             func() float64
+	rng             *rng.Provider // nil uses rnd for every opening
 	schemaValidator validation.SchemaValidator
 	bus             event.Bus
 	lootTablesPath  string // Stored for cache rebuilding
@@ -81,6 +159,9 @@ func NewService(repo ItemRepository, progressionSvc ProgressionService, bus even
 	// Subscribe to progression node unlocked events for cache invalidation
 	if bus != nil {
 		bus.Subscribe(event.ProgressionNodeUnlocked, svc.handleNodeUnlocked)
+		bus.Subscribe(event.ItemBalanceChanged, svc.handleBalanceChanged)
+		bus.Subscribe(event.SnapshotRestored, svc.handleBalanceChanged)
+		bus.Subscribe(event.SeasonChanged, svc.handleBalanceChanged)
 	}
 
 	return svc, nil
@@ -122,22 +203,95 @@ func (s *service) handleNodeUnlocked(ctx context.Context, e event.Event) error {
 	return nil
 }
 
+// handleBalanceChanged rebuilds the lootbox cache so new item values and loot
+// weights are used from the next opening. It also runs after a snapshot
+// restore, which can change which item nodes are unlocked, and when a season
+// starts or ends.
+func (s *service) handleBalanceChanged(ctx context.Context, e event.Event) error {
+	if err := s.buildCache(s.lootTablesPath); err != nil {
+		logger.FromContext(ctx).Error("Failed to rebuild lootbox cache", "event", e.Type, "error", err)
+		return fmt.Errorf("failed to rebuild lootbox cache: %w", err)
+	}
+	logger.FromContext(ctx).Info("Lootbox cache rebuilt", "event", e.Type)
+	return nil
+}
+
+// Reload rebuilds the lootbox cache from the loot tables file.
+// The previous cache stays in place if the file fails validation.
+func (s *service) Reload(ctx context.Context) error {
+	if err := s.buildCache(s.lootTablesPath); err != nil {
+		return fmt.Errorf("%s: %w", ErrContextFailedToLoadLootTables, err)
+	}
+	logger.FromContext(ctx).Info("Loot tables reloaded", "path", s.lootTablesPath)
+	return nil
+}
+
 // OpenLootbox simulates opening lootboxes and returns the dropped items.
-func (s *service) OpenLootbox(ctx context.Context, lootboxName string, quantity int, boxQuality domain.QualityLevel) ([]DroppedItem, error) {
+// When the lootbox has a pity threshold and this batch reaches it, one box is
+// guaranteed an item drop of RARE quality or better.
+func (s *service) OpenLootbox(ctx context.Context, userID, lootboxName string, quantity int, boxQuality domain.QualityLevel) ([]DroppedItem, error) {
 	if quantity <= 0 {
 		return nil, nil
 	}
 
+	s.mu.RLock()
 	flat, ok := s.cache[lootboxName]
+	s.mu.RUnlock()
 	if !ok {
 		logger.FromContext(ctx).Warn(LogMsgNoLootTableFound, LogFieldLootbox, lootboxName)
 		return nil, nil
 	}
 
-	dropCounts, consolationMoney := s.processLootTable(flat, quantity)
-	if len(dropCounts) == 0 && consolationMoney == 0 {
+	flat = s.applyGameEvents(ctx, flat)
+
+	rnd := s.rnd
+	if s.rng != nil {
+		rnd = s.rng.ForOperation(ctx, rng.OpLootboxOpen).Float64
+	}
+
+	pityCount, tracked := s.loadPity(ctx, userID, lootboxName, flat)
+
+	rolled := quantity
+	var pityEntry *FlatPoolEntry
+	if tracked && pityCount+quantity >= flat.PityThreshold {
+		if pityEntry = s.selectPityEntry(flat, rnd); pityEntry != nil {
+			rolled--
+		}
+	}
+
+	dropCounts, consolationMoney := s.processLootTable(flat, rolled, rnd)
+	drops, err := s.convertToDroppedItems(ctx, dropCounts, consolationMoney, flat.MoneyItem, boxQuality, rnd)
+	if err != nil {
+		return nil, err
+	}
+
+	if pityEntry != nil {
+		drops = append(drops, s.pityDrop(ctx, pityEntry, boxQuality, rnd))
+		logger.FromContext(ctx).Info(LogMsgPityTriggered, LogFieldLootbox, lootboxName, "user_id", userID, "openings", pityCount+quantity)
+	}
+
+	if tracked {
+		s.savePity(ctx, userID, lootboxName, pityCount, s.nextPityCount(pityCount, quantity, drops))
+	}
+
+	if len(drops) == 0 {
 		return nil, nil
 	}
+	return drops, nil
+}
 
-	return s.convertToDroppedItems(ctx, dropCounts, consolationMoney, flat.MoneyItem, boxQuality)
+// applyGameEvents returns a copy of flat with its item drop rate raised by
+// running game events, up to every box dropping an item. The cached table is
+// left alone so the boost ends with the event.
+func (s *service) applyGameEvents(ctx context.Context, flat *FlattenedLootbox) *FlattenedLootbox {
+	if s.gameEvents == nil {
+		return flat
+	}
+	mods := s.gameEvents.Modifiers(ctx)
+	if mods.DropRateMultiplier <= 1 || flat.ItemDropRate >= 1 {
+		return flat
+	}
+	boosted := *flat
+	boosted.ItemDropRate = min(flat.ItemDropRate*mods.DropRateMultiplier, 1)
+	return &boosted
 }
@@ -11,6 +11,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/rng"
 )
 
 // ============================================================================
@@ -61,6 +62,22 @@ func (m *mockProgression) IsItemUnlocked(_ context.Context, _ string) (bool, err
 	return m.unlocked, nil
 }
 
+// mockPityRepo is an in-memory pity counter store keyed by user and lootbox.
+type mockPityRepo struct {
+	counts map[string]int
+	sets   int
+}
+
+func (m *mockPityRepo) GetPityCount(_ context.Context, userID, lootboxName string) (int, error) {
+	return m.counts[userID+"/"+lootboxName], nil
+}
+
+func (m *mockPityRepo) SetPityCount(_ context.Context, userID, lootboxName string, count int) error {
+	m.counts[userID+"/"+lootboxName] = count
+	m.sets++
+	return nil
+}
+
 // ============================================================================
 // Helpers
 // ============================================================================
@@ -147,7 +164,7 @@ func TestGatekeeperPass_ItemDropped(t *testing.T) {
 	)
 	require.NoError(t, err)
 
-	drops, err := s.OpenLootbox(context.Background(), "box", 1, domain.QualityCommon)
+	drops, err := s.OpenLootbox(context.Background(), "", "box", 1, domain.QualityCommon)
 	require.NoError(t, err)
 	require.Len(t, drops, 1)
 	assert.Equal(t, "sword", drops[0].ItemName)
@@ -166,7 +183,7 @@ func TestGatekeeperFail_MoneyOnly(t *testing.T) {
 	)
 	require.NoError(t, err)
 
-	drops, err := s.OpenLootbox(context.Background(), "box", 1, domain.QualityCommon)
+	drops, err := s.OpenLootbox(context.Background(), "", "box", 1, domain.QualityCommon)
 	require.NoError(t, err)
 	require.Len(t, drops, 1)
 	assert.Equal(t, domain.ItemMoney, drops[0].ItemName)
@@ -174,6 +191,40 @@ func TestGatekeeperFail_MoneyOnly(t *testing.T) {
 	assert.Equal(t, 100, drops[0].Quantity)
 }
 
+type fakeGameEvents struct {
+	mods domain.GameModifiers
+}
+
+func (f fakeGameEvents) Modifiers(context.Context) domain.GameModifiers {
+	return f.mods
+}
+
+func TestGatekeeper_GameEventRaisesDropRate(t *testing.T) {
+	repo := &mockItemRepo{items: map[string]*domain.Item{
+		domain.ItemMoney: moneyItem(),
+		"sword":          swordItem(2, "sword", 10),
+	}}
+
+	// ItemDropRate=0.4 fails a 0.5 gate roll until a 2x event raises it to 0.8
+	s, err := buildSimpleService(t, repo, 0.4, 100, 100,
+		[]PoolItemDef{{ItemName: "sword", Weight: 1}},
+		[]float64{0.5, 0.5, 0.5, 0.5, 0.9},
+	)
+	require.NoError(t, err)
+
+	drops, err := s.OpenLootbox(context.Background(), "", "box", 1, domain.QualityCommon)
+	require.NoError(t, err)
+	require.Len(t, drops, 1)
+	assert.Equal(t, domain.ItemMoney, drops[0].ItemName)
+
+	s.gameEvents = fakeGameEvents{mods: domain.GameModifiers{XPMultiplier: 1, DropRateMultiplier: 2}}
+	drops, err = s.OpenLootbox(context.Background(), "", "box", 1, domain.QualityCommon)
+	require.NoError(t, err)
+	require.Len(t, drops, 1)
+	assert.Equal(t, "sword", drops[0].ItemName)
+	assert.Equal(t, 0.4, s.cache["box"].ItemDropRate, "the cached drop rate is left alone")
+}
+
 // ============================================================================
 // Money jitter tests
 // ============================================================================
@@ -193,7 +244,7 @@ func TestMoneyJitter_Proportional(t *testing.T) {
 	idx := 0
 	s.rnd = func() float64 { v := rolls[idx%len(rolls)]; idx++; return v }
 
-	drops, err := s.OpenLootbox(context.Background(), "box", 1, domain.QualityCommon)
+	drops, err := s.OpenLootbox(context.Background(), "", "box", 1, domain.QualityCommon)
 	require.NoError(t, err)
 	require.Len(t, drops, 1)
 	assert.Equal(t, domain.ItemMoney, drops[0].ItemName)
@@ -213,7 +264,7 @@ func TestMoneyJitter_FloorsAtOne(t *testing.T) {
 	)
 	require.NoError(t, err)
 
-	drops, err := s.OpenLootbox(context.Background(), "box", 1, domain.QualityCommon)
+	drops, err := s.OpenLootbox(context.Background(), "", "box", 1, domain.QualityCommon)
 	require.NoError(t, err)
 	require.Len(t, drops, 1)
 	assert.Equal(t, domain.ItemMoney, drops[0].ItemName)
@@ -238,7 +289,7 @@ func TestMultiQuantity_Aggregated(t *testing.T) {
 	)
 	require.NoError(t, err)
 
-	drops, err := s.OpenLootbox(context.Background(), "box", 5, domain.QualityCommon)
+	drops, err := s.OpenLootbox(context.Background(), "", "box", 5, domain.QualityCommon)
 	require.NoError(t, err)
 	require.Len(t, drops, 1) // one unique item, aggregated
 	assert.Equal(t, "sword", drops[0].ItemName)
@@ -269,7 +320,7 @@ func TestSameItem_QuantitySummed(t *testing.T) {
 	svc, err := NewService(repo, &mockProgression{unlocked: true}, nil, path)
 	require.NoError(t, err)
 
-	drops, err := svc.OpenLootbox(context.Background(), "box", 3, domain.QualityCommon)
+	drops, err := svc.OpenLootbox(context.Background(), "", "box", 3, domain.QualityCommon)
 	require.NoError(t, err)
 	require.Len(t, drops, 1)
 	assert.Equal(t, "sword", drops[0].ItemName)
@@ -313,7 +364,7 @@ func TestPoolSelection_WeightedRoll(t *testing.T) {
 	idx := 0
 	s.rnd = func() float64 { v := rolls[idx]; idx++; return v }
 
-	drops, err := s.OpenLootbox(context.Background(), "box", 1, domain.QualityCommon)
+	drops, err := s.OpenLootbox(context.Background(), "", "box", 1, domain.QualityCommon)
 	require.NoError(t, err)
 	require.Len(t, drops, 1)
 	assert.Equal(t, "sword_a", drops[0].ItemName)
@@ -323,7 +374,7 @@ func TestPoolSelection_WeightedRoll(t *testing.T) {
 	idx2 := 0
 	s.rnd = func() float64 { v := rolls2[idx2]; idx2++; return v }
 
-	drops2, err := s.OpenLootbox(context.Background(), "box", 1, domain.QualityCommon)
+	drops2, err := s.OpenLootbox(context.Background(), "", "box", 1, domain.QualityCommon)
 	require.NoError(t, err)
 	require.Len(t, drops2, 1)
 	assert.Equal(t, "sword_b", drops2[0].ItemName)
@@ -359,7 +410,7 @@ func TestItemSelection_WeightedRoll(t *testing.T) {
 	rollsCommon := []float64{0.0, 0.0, 0.1, 0.5, 0.9}
 	idx := 0
 	s.rnd = func() float64 { v := rollsCommon[idx]; idx++; return v }
-	drops, err := s.OpenLootbox(context.Background(), "box", 1, domain.QualityCommon)
+	drops, err := s.OpenLootbox(context.Background(), "", "box", 1, domain.QualityCommon)
 	require.NoError(t, err)
 	require.Len(t, drops, 1)
 	assert.Equal(t, "item_common", drops[0].ItemName)
@@ -368,7 +419,7 @@ func TestItemSelection_WeightedRoll(t *testing.T) {
 	rollsRare := []float64{0.0, 0.0, 0.8, 0.5, 0.9}
 	idx2 := 0
 	s.rnd = func() float64 { v := rollsRare[idx2]; idx2++; return v }
-	drops2, err := s.OpenLootbox(context.Background(), "box", 1, domain.QualityCommon)
+	drops2, err := s.OpenLootbox(context.Background(), "", "box", 1, domain.QualityCommon)
 	require.NoError(t, err)
 	require.Len(t, drops2, 1)
 	assert.Equal(t, "item_rare", drops2[0].ItemName)
@@ -414,6 +465,78 @@ func TestTypeExpansion_Explosive(t *testing.T) {
 	assert.Equal(t, 100, pool.TotalWeight)
 }
 
+// staticWeights is a WeightProvider with fixed overrides.
+type staticWeights map[string]map[string]int
+
+func (w staticWeights) EffectiveLootWeights(_ context.Context) (map[string]map[string]int, error) {
+	return w, nil
+}
+
+func TestWeightOverrides_ReplaceAndDropEntries(t *testing.T) {
+	repo := &mockItemRepo{items: map[string]*domain.Item{
+		domain.ItemMoney: moneyItem(),
+		"item_common":    swordItem(2, "item_common", 10),
+		"item_rare":      swordItem(3, "item_rare", 100),
+		"item_epic":      swordItem(4, "item_epic", 500),
+	}}
+
+	pools := map[string]PoolDef{
+		"pool_a": {Items: []PoolItemDef{
+			{ItemName: "item_common", Weight: 70},
+			{ItemName: "item_rare", Weight: 30},
+			{ItemName: "item_epic", Weight: 5},
+		}},
+	}
+	lootboxes := map[string]Def{
+		"box": {
+			ItemDropRate: 1.0,
+			FixedMoney:   MoneyRange{Min: 0, Max: 0},
+			Pools:        []PoolRef{{PoolName: "pool_a", Weight: 1}},
+		},
+	}
+	path := createTempConfigV2(t, pools, lootboxes)
+	overrides := staticWeights{"pool_a": {"item_rare": 10, "item_epic": 0}}
+	svc, err := NewService(repo, &mockProgression{unlocked: true}, nil, path, WithWeightOverrides(overrides))
+	require.NoError(t, err)
+
+	pool := svc.(*service).cache["box"].Pools["pool_a"]
+	require.Len(t, pool.Entries, 2)
+	assert.Equal(t, "item_rare", pool.Entries[1].ItemName)
+	assert.Equal(t, 80, pool.TotalWeight)
+}
+
+type staticSeasons []SeasonalPool
+
+func (s staticSeasons) ActiveLootPools(context.Context) []SeasonalPool {
+	return s
+}
+
+func TestSeasonalPools_LinkedIntoLootbox(t *testing.T) {
+	repo := &mockItemRepo{items: map[string]*domain.Item{
+		domain.ItemMoney: moneyItem(),
+		"sword":          swordItem(2, "sword", 10),
+		"item_egg":       swordItem(3, "item_egg", 40),
+	}}
+	path := createTempConfigV2(t,
+		map[string]PoolDef{"pool_a": {Items: []PoolItemDef{{ItemName: "sword", Weight: 1}}}},
+		map[string]Def{"box": {ItemDropRate: 1.0, Pools: []PoolRef{{PoolName: "pool_a", Weight: 3}}}},
+	)
+	seasons := staticSeasons{
+		{Lootbox: "box", PoolName: "season_spring_box", Weight: 1, Items: []PoolItemDef{{ItemName: "item_egg", Weight: 1}}},
+		{Lootbox: "missing_box", PoolName: "season_spring_missing_box", Weight: 1, Items: []PoolItemDef{{ItemName: "item_egg", Weight: 1}}},
+	}
+
+	// Locked items still drop from seasonal pools
+	svc, err := NewService(repo, &mockProgression{unlocked: false}, nil, path, WithSeasonalLoot(seasons))
+	require.NoError(t, err)
+
+	box := svc.(*service).cache["box"]
+	assert.Equal(t, 4, box.TotalPoolWeight)
+	require.Contains(t, box.Pools, "season_spring_box")
+	assert.Equal(t, "item_egg", box.Pools["season_spring_box"].Entries[0].ItemName)
+	assert.Empty(t, box.Pools["pool_a"].Entries)
+}
+
 func TestTypeExpansion_Unknown_Error(t *testing.T) {
 	repo := &mockItemRepo{items: map[string]*domain.Item{
 		domain.ItemMoney: moneyItem(),
@@ -491,7 +614,7 @@ func TestBoxNotFound_NilNoError(t *testing.T) {
 	)
 	require.NoError(t, err)
 
-	drops, err := s.OpenLootbox(context.Background(), "nonexistent_box", 1, domain.QualityCommon)
+	drops, err := s.OpenLootbox(context.Background(), "", "nonexistent_box", 1, domain.QualityCommon)
 	assert.NoError(t, err)
 	assert.Nil(t, drops)
 }
@@ -507,7 +630,7 @@ func TestZeroQuantity_NilNoError(t *testing.T) {
 	)
 	require.NoError(t, err)
 
-	drops, err := s.OpenLootbox(context.Background(), "box", 0, domain.QualityCommon)
+	drops, err := s.OpenLootbox(context.Background(), "", "box", 0, domain.QualityCommon)
 	assert.NoError(t, err)
 	assert.Nil(t, drops)
 }
@@ -539,12 +662,61 @@ func TestQualityApplied_PerItem(t *testing.T) {
 	idx := 0
 	s.rnd = func() float64 { v := rolls[idx]; idx++; return v }
 
-	drops, err := s.OpenLootbox(context.Background(), "box", 1, domain.QualityCommon)
+	drops, err := s.OpenLootbox(context.Background(), "", "box", 1, domain.QualityCommon)
 	require.NoError(t, err)
 	require.Len(t, drops, 1)
 	assert.Equal(t, domain.QualityLegendary, drops[0].QualityLevel)
 }
 
+func TestReveal_NearMissAndTier(t *testing.T) {
+	repo := &mockItemRepo{items: map[string]*domain.Item{
+		domain.ItemMoney: moneyItem(),
+		"sword":          swordItem(2, "sword", 100),
+	}}
+
+	s, err := buildSimpleService(t, repo, 1.0, 0, 10,
+		[]PoolItemDef{{ItemName: "sword", Weight: 1}},
+		nil,
+	)
+	require.NoError(t, err)
+
+	// rnd sequence: [gate, pool, item, quality=0.31 (COMMON, 0.01 past UNCOMMON), upgrade=0.9(no)]
+	rolls := []float64{0.0, 0.0, 0.0, 0.31, 0.9}
+	idx := 0
+	s.rnd = func() float64 { v := rolls[idx]; idx++; return v }
+
+	drops, err := s.OpenLootbox(context.Background(), "", "box", 1, domain.QualityCommon)
+	require.NoError(t, err)
+	require.Len(t, drops, 1)
+
+	reveal := drops[0].Reveal
+	assert.Equal(t, domain.QualityCommon, drops[0].QualityLevel)
+	assert.Equal(t, 3, reveal.TierRank)
+	assert.InDelta(t, 0.31, reveal.QualityRoll, 1e-9)
+	assert.True(t, reveal.NearMiss)
+	assert.Equal(t, domain.QualityUncommon, reveal.NearMissTier)
+	assert.False(t, reveal.Upgraded)
+}
+
+func TestReveal_ConsolationMoneyFlagged(t *testing.T) {
+	repo := &mockItemRepo{items: map[string]*domain.Item{
+		domain.ItemMoney: moneyItem(),
+		"sword":          swordItem(2, "sword", 10),
+	}}
+
+	s, err := buildSimpleService(t, repo, 0.0, 5, 5,
+		[]PoolItemDef{{ItemName: "sword", Weight: 1}},
+		[]float64{0.5},
+	)
+	require.NoError(t, err)
+
+	drops, err := s.OpenLootbox(context.Background(), "", "box", 1, domain.QualityCommon)
+	require.NoError(t, err)
+	require.Len(t, drops, 1)
+	assert.True(t, drops[0].Reveal.Consolation)
+	assert.False(t, drops[0].Reveal.NearMiss)
+}
+
 // ============================================================================
 // Concurrent access test
 // ============================================================================
@@ -568,7 +740,7 @@ func TestConcurrentOpen_NoRace(t *testing.T) {
 		wg.Add(1)
 		go func() {
 			defer wg.Done()
-			_, err := s.OpenLootbox(context.Background(), "box", 1, domain.QualityCommon)
+			_, err := s.OpenLootbox(context.Background(), "", "box", 1, domain.QualityCommon)
 			if err != nil {
 				errChan <- err
 			}
@@ -582,6 +754,36 @@ func TestConcurrentOpen_NoRace(t *testing.T) {
 	}
 }
 
+func TestWithRNG_ReplayReproducesOpening(t *testing.T) {
+	repo := &mockItemRepo{items: map[string]*domain.Item{
+		domain.ItemMoney: moneyItem(),
+		"sword":          swordItem(2, "sword", 10),
+		"shield":         swordItem(3, "shield", 20),
+		"bow":            swordItem(4, "bow", 30),
+	}}
+	pools := map[string]PoolDef{
+		"pool_a": {Items: []PoolItemDef{{ItemName: "sword", Weight: 5}, {ItemName: "shield", Weight: 3}, {ItemName: "bow", Weight: 1}}},
+	}
+	lootboxes := map[string]Def{
+		"box": {ItemDropRate: 0.7, FixedMoney: MoneyRange{Min: 1, Max: 50}, Pools: []PoolRef{{PoolName: "pool_a", Weight: 1}}},
+	}
+	path := createTempConfigV2(t, pools, lootboxes)
+
+	open := func() []DroppedItem {
+		svc, err := NewService(repo, &mockProgression{unlocked: true}, nil, path, WithRNG(rng.NewReplay(12345)))
+		require.NoError(t, err)
+		drops, err := svc.OpenLootbox(context.Background(), "", "box", 25, domain.QualityCommon)
+		require.NoError(t, err)
+		return drops
+	}
+
+	first := open()
+	require.NotEmpty(t, first)
+	for i := 0; i < 5; i++ {
+		assert.Equal(t, first, open())
+	}
+}
+
 // ============================================================================
 // Orphan tracking (no error, just a warning)
 // ============================================================================
@@ -601,3 +803,106 @@ func TestOrphanTracking_NoError(t *testing.T) {
 	// Orphaned items emit warnings, not errors.
 	assert.NoError(t, err)
 }
+
+// ============================================================================
+// Pity tests
+// ============================================================================
+
+// buildPityService creates a single-pool service whose "box" has the given pity threshold.
+func buildPityService(t *testing.T, itemDropRate float64, threshold int, pity *mockPityRepo, rnd float64) *service {
+	t.Helper()
+	repo := &mockItemRepo{items: map[string]*domain.Item{
+		domain.ItemMoney: moneyItem(),
+		"sword":          swordItem(2, "sword", 10),
+	}}
+	pools := map[string]PoolDef{
+		"pool_a": {Items: []PoolItemDef{{ItemName: "sword", Weight: 1}}},
+	}
+	lootboxes := map[string]Def{
+		"box": {
+			ItemDropRate:  itemDropRate,
+			FixedMoney:    MoneyRange{Min: 1, Max: 10},
+			Pools:         []PoolRef{{PoolName: "pool_a", Weight: 1}},
+			PityThreshold: threshold,
+		},
+	}
+	path := createTempConfigV2(t, pools, lootboxes)
+	svc, err := NewService(repo, &mockProgression{unlocked: true}, nil, path,
+		WithPityRepository(pity), WithRnd(func() float64 { return rnd }))
+	require.NoError(t, err)
+	return svc.(*service)
+}
+
+func TestPity_GuaranteesRareAtThreshold(t *testing.T) {
+	pity := &mockPityRepo{counts: map[string]int{"user1/box": 4}}
+	// Drop rate 0 means every normal open is consolation money; quality roll 0.9 would be JUNK
+	s := buildPityService(t, 0.0, 5, pity, 0.9)
+
+	drops, err := s.OpenLootbox(context.Background(), "user1", "box", 1, domain.QualityCommon)
+
+	require.NoError(t, err)
+	require.Len(t, drops, 1)
+	assert.Equal(t, "sword", drops[0].ItemName)
+	assert.Equal(t, domain.QualityRare, drops[0].QualityLevel)
+	assert.True(t, drops[0].Reveal.Pity)
+	assert.Equal(t, 0, pity.counts["user1/box"])
+}
+
+func TestPity_BatchReachingThresholdReplacesOneBox(t *testing.T) {
+	pity := &mockPityRepo{counts: map[string]int{"user1/box": 2}}
+	s := buildPityService(t, 0.0, 5, pity, 0.9)
+
+	drops, err := s.OpenLootbox(context.Background(), "user1", "box", 4, domain.QualityCommon)
+
+	require.NoError(t, err)
+	var swords, money int
+	for _, d := range drops {
+		if d.ItemName == "sword" {
+			swords += d.Quantity
+		} else {
+			money++
+		}
+	}
+	assert.Equal(t, 1, swords, "exactly one box should become the pity drop")
+	assert.Equal(t, 1, money, "the other three boxes pay out aggregated consolation money")
+	assert.Equal(t, 0, pity.counts["user1/box"])
+}
+
+func TestPity_CountsOpeningsWithoutRare(t *testing.T) {
+	pity := &mockPityRepo{counts: map[string]int{}}
+	s := buildPityService(t, 1.0, 10, pity, 0.9)
+
+	drops, err := s.OpenLootbox(context.Background(), "user1", "box", 3, domain.QualityCommon)
+
+	require.NoError(t, err)
+	require.Len(t, drops, 1)
+	assert.Equal(t, domain.QualityJunk, drops[0].QualityLevel)
+	assert.Equal(t, 3, pity.counts["user1/box"])
+}
+
+func TestPity_NaturalRareResetsCounter(t *testing.T) {
+	pity := &mockPityRepo{counts: map[string]int{"user1/box": 6}}
+	// Quality roll 0.1 is RARE without any help
+	s := buildPityService(t, 1.0, 10, pity, 0.1)
+
+	drops, err := s.OpenLootbox(context.Background(), "user1", "box", 1, domain.QualityCommon)
+
+	require.NoError(t, err)
+	require.Len(t, drops, 1)
+	assert.Equal(t, domain.QualityRare, drops[0].QualityLevel)
+	assert.Equal(t, 0, pity.counts["user1/box"])
+}
+
+func TestPity_SkippedWithoutUserOrThreshold(t *testing.T) {
+	pity := &mockPityRepo{counts: map[string]int{}}
+	s := buildPityService(t, 1.0, 0, pity, 0.9)
+
+	_, err := s.OpenLootbox(context.Background(), "user1", "box", 1, domain.QualityCommon)
+	require.NoError(t, err)
+
+	s = buildPityService(t, 1.0, 10, pity, 0.9)
+	_, err = s.OpenLootbox(context.Background(), "", "box", 1, domain.QualityCommon)
+	require.NoError(t, err)
+
+	assert.Zero(t, pity.sets)
+}
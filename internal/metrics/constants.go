@@ -9,6 +9,8 @@ const (
 	MetricNameHTTPRequestsTotal    = "http_requests_total"
 	MetricNameHTTPRequestDuration  = "http_request_duration_seconds"
 	MetricNameHTTPRequestsInFlight = "http_requests_in_flight"
+	MetricNameHTTPAccessRequests   = "http_access_requests_total"
+	MetricNameHTTPErrorBodySamples = "http_error_body_samples_total"
 )
 
 // Event metric names
@@ -17,6 +19,20 @@ const (
 	MetricNameEventHandlerErrors = "event_handler_errors_total"
 )
 
+// Worker pool metric names
+const (
+	MetricNameWorkerQueueDepth   = "worker_queue_depth"
+	MetricNameWorkerJobsRunning  = "worker_jobs_running"
+	MetricNameWorkerJobsRejected = "worker_jobs_rejected_total"
+	MetricNameWorkerJobWait      = "worker_job_wait_seconds"
+)
+
+// Reconciliation metric names
+const (
+	MetricNameReconcileDiscrepancies = "reconcile_discrepancies_total"
+	MetricNameReconcileHealed        = "reconcile_healed_total"
+)
+
 // Business metric names
 const (
 	MetricNameItemsSold         = "items_sold_total"
@@ -38,6 +54,8 @@ const (
 	HelpTextHTTPRequestsTotal    = "Total number of HTTP requests"
 	HelpTextHTTPRequestDuration  = "HTTP request latency in seconds"
 	HelpTextHTTPRequestsInFlight = "Current number of HTTP requests being served"
+	HelpTextHTTPAccessRequests   = "HTTP requests seen by the access log, by route, status, and caller platform"
+	HelpTextHTTPErrorBodySamples = "Error responses whose request body was sampled into the access log"
 )
 
 // Event metric help text
@@ -46,6 +64,20 @@ const (
 	HelpTextEventHandlerErrors = "Total number of event handler errors"
 )
 
+// Worker pool metric help text
+const (
+	HelpTextWorkerQueueDepth   = "Number of jobs waiting in the worker queue, by priority"
+	HelpTextWorkerJobsRunning  = "Number of jobs currently being processed, by job type"
+	HelpTextWorkerJobsRejected = "Jobs rejected because the worker queue was full"
+	HelpTextWorkerJobWait      = "Time jobs spent queued before a worker picked them up, in seconds"
+)
+
+// Reconciliation metric help text
+const (
+	HelpTextReconcileDiscrepancies = "Drifts found between derived state and source-of-truth tables, by check and severity"
+	HelpTextReconcileHealed        = "Drifts corrected automatically by the reconciliation job, by check"
+)
+
 // Business metric help text
 const (
 	HelpTextItemsSold         = "Total number of items sold"
@@ -67,7 +99,13 @@ const (
 	LabelMethod     = "method"
 	LabelPath       = "path"
 	LabelStatus     = "status"
+	LabelRoute      = "route"
+	LabelPlatform   = "platform"
 	LabelType       = "type"
+	LabelPriority   = "priority"
+	LabelJobType    = "job_type"
+	LabelCheck      = "check"
+	LabelSeverity   = "severity"
 	LabelItem       = "item"
 	LabelSourceItem = "source_item"
 	LabelResultItem = "result_item"
@@ -30,6 +30,77 @@ var (
 			Help: HelpTextHTTPRequestsInFlight,
 		},
 	)
+
+	HTTPAccessRequests = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: MetricNameHTTPAccessRequests,
+			Help: HelpTextHTTPAccessRequests,
+		},
+		[]string{LabelMethod, LabelRoute, LabelStatus, LabelPlatform},
+	)
+
+	HTTPErrorBodySamples = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: MetricNameHTTPErrorBodySamples,
+			Help: HelpTextHTTPErrorBodySamples,
+		},
+		[]string{LabelRoute, LabelStatus},
+	)
+)
+
+// Worker Pool Metrics
+var (
+	WorkerQueueDepth = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: MetricNameWorkerQueueDepth,
+			Help: HelpTextWorkerQueueDepth,
+		},
+		[]string{LabelPriority},
+	)
+
+	WorkerJobsRunning = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: MetricNameWorkerJobsRunning,
+			Help: HelpTextWorkerJobsRunning,
+		},
+		[]string{LabelJobType},
+	)
+
+	WorkerJobsRejected = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: MetricNameWorkerJobsRejected,
+			Help: HelpTextWorkerJobsRejected,
+		},
+		[]string{LabelJobType},
+	)
+
+	WorkerJobWait = promauto.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    MetricNameWorkerJobWait,
+			Help:    HelpTextWorkerJobWait,
+			Buckets: HTTPLatencyBuckets,
+		},
+		[]string{LabelPriority},
+	)
+)
+
+// Reconciliation Metrics
+var (
+	ReconcileDiscrepancies = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: MetricNameReconcileDiscrepancies,
+			Help: HelpTextReconcileDiscrepancies,
+		},
+		[]string{LabelCheck, LabelSeverity},
+	)
+
+	ReconcileHealed = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: MetricNameReconcileHealed,
+			Help: HelpTextReconcileHealed,
+		},
+		[]string{LabelCheck},
+	)
 )
 
 // Event Metrics
@@ -0,0 +1,15 @@
+package moderation
+
+// Dossier limits
+const (
+	DossierEventLimit  = 25
+	DossierActionLimit = 25
+)
+
+// Log messages
+const (
+	LogMsgAccountFrozen     = "Account frozen"
+	LogMsgAccountUnfrozen   = "Account unfrozen"
+	LogMsgInventoryWiped    = "Account inventory wiped"
+	LogMsgDossierPartFailed = "Failed to load part of account dossier"
+)
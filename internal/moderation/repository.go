@@ -0,0 +1,31 @@
+package moderation
+
+import (
+	"context"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+)
+
+// Repository stores account freezes and the moderation history. Each write
+// records its action in the history in the same transaction.
+type Repository interface {
+	// Freeze freezes an account. It returns false when the account is
+	// already frozen.
+	Freeze(ctx context.Context, userID, actor, reason string) (bool, error)
+
+	// Unfreeze lifts a freeze. It returns false when the account is not frozen.
+	Unfreeze(ctx context.Context, userID, actor, reason string) (bool, error)
+
+	// GetFreeze returns the account's freeze, or nil when it is not frozen
+	GetFreeze(ctx context.Context, userID string) (*domain.AccountFreeze, error)
+
+	// IsFrozenByPlatform reports whether the account linked to a platform
+	// identity is frozen
+	IsFrozenByPlatform(ctx context.Context, platform, platformID string) (bool, error)
+
+	// WipeInventory empties an account's inventory and returns the slots it held
+	WipeInventory(ctx context.Context, userID, actor, reason string) ([]domain.InventorySlot, error)
+
+	// GetActions returns an account's moderation history, newest first
+	GetActions(ctx context.Context, userID string, limit int) ([]domain.ModerationAction, error)
+}
@@ -0,0 +1,237 @@
+package moderation
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/eventlog"
+	"github.com/osse101/BrandishBot_Go/internal/logger"
+)
+
+// Service lets moderators freeze accounts out of the economy, wipe their
+// inventories and pull a full account dossier
+type Service interface {
+	// Freeze blocks an account from economy actions. It returns
+	// domain.ErrAccountAlreadyFrozen when the account is already frozen.
+	Freeze(ctx context.Context, userID, actor, reason string) error
+
+	// Unfreeze lifts a freeze. It returns domain.ErrAccountNotFrozen when
+	// the account is not frozen.
+	Unfreeze(ctx context.Context, userID, actor, reason string) error
+
+	// WipeInventory empties an account's inventory and returns the slots it held
+	WipeInventory(ctx context.Context, userID, actor, reason string) ([]domain.InventorySlot, error)
+
+	// Inspect gathers everything moderators need to judge an account
+	Inspect(ctx context.Context, userID string) (*Dossier, error)
+
+	// IsFrozen reports whether the account linked to a platform identity is frozen
+	IsFrozen(ctx context.Context, platform, platformID string) (bool, error)
+}
+
+// UserRepository reads the accounts being moderated
+type UserRepository interface {
+	GetUserByID(ctx context.Context, userID string) (*domain.User, error)
+	GetInventory(ctx context.Context, userID string) (*domain.Inventory, error)
+	GetItemsByIDs(ctx context.Context, itemIDs []int) ([]domain.Item, error)
+}
+
+// JobReader reads an account's job progress
+type JobReader interface {
+	GetUserJobs(ctx context.Context, userID string) ([]domain.UserJobInfo, error)
+}
+
+// EventReader reads an account's recent events
+type EventReader interface {
+	GetEvents(ctx context.Context, filter eventlog.EventFilter) ([]eventlog.Event, error)
+}
+
+// DossierItem is one inventory slot with its item name resolved
+type DossierItem struct {
+	ItemID   int                 `json:"item_id"`
+	ItemName string              `json:"item_name"`
+	Quantity int                 `json:"quantity"`
+	Quality  domain.QualityLevel `json:"quality,omitempty"`
+}
+
+// Dossier is the account view returned to moderators
+type Dossier struct {
+	User         *domain.User              `json:"user"`
+	Frozen       bool                      `json:"frozen"`
+	Freeze       *domain.AccountFreeze     `json:"freeze,omitempty"`
+	Inventory    []DossierItem             `json:"inventory"`
+	Jobs         []domain.UserJobInfo      `json:"jobs"`
+	RecentEvents []eventlog.Event          `json:"recent_events"`
+	History      []domain.ModerationAction `json:"moderation_history"`
+}
+
+type service struct {
+	repo   Repository
+	users  UserRepository
+	jobs   JobReader
+	events EventReader
+}
+
+// NewService creates a moderation service. jobs and events may be nil, in
+// which case the dossier leaves those sections empty.
+func NewService(repo Repository, users UserRepository, jobs JobReader, events EventReader) Service {
+	return &service{
+		repo:   repo,
+		users:  users,
+		jobs:   jobs,
+		events: events,
+	}
+}
+
+// Freeze blocks an account from economy actions
+func (s *service) Freeze(ctx context.Context, userID, actor, reason string) error {
+	if err := s.checkTarget(ctx, userID, actor); err != nil {
+		return err
+	}
+
+	ok, err := s.repo.Freeze(ctx, userID, actor, reason)
+	if err != nil {
+		return fmt.Errorf("failed to freeze account: %w", err)
+	}
+	if !ok {
+		return domain.ErrAccountAlreadyFrozen
+	}
+
+	logger.FromContext(ctx).Info(LogMsgAccountFrozen, "user_id", userID, "actor", actor, "reason", reason)
+	return nil
+}
+
+// Unfreeze lifts a freeze
+func (s *service) Unfreeze(ctx context.Context, userID, actor, reason string) error {
+	if err := s.checkTarget(ctx, userID, actor); err != nil {
+		return err
+	}
+
+	ok, err := s.repo.Unfreeze(ctx, userID, actor, reason)
+	if err != nil {
+		return fmt.Errorf("failed to unfreeze account: %w", err)
+	}
+	if !ok {
+		return domain.ErrAccountNotFrozen
+	}
+
+	logger.FromContext(ctx).Info(LogMsgAccountUnfrozen, "user_id", userID, "actor", actor, "reason", reason)
+	return nil
+}
+
+// WipeInventory empties an account's inventory
+func (s *service) WipeInventory(ctx context.Context, userID, actor, reason string) ([]domain.InventorySlot, error) {
+	if err := s.checkTarget(ctx, userID, actor); err != nil {
+		return nil, err
+	}
+
+	removed, err := s.repo.WipeInventory(ctx, userID, actor, reason)
+	if err != nil {
+		return nil, fmt.Errorf("failed to wipe inventory: %w", err)
+	}
+
+	logger.FromContext(ctx).Info(LogMsgInventoryWiped, "user_id", userID, "actor", actor, "reason", reason, "slots", len(removed))
+	return removed, nil
+}
+
+// Inspect gathers the account, its freeze, inventory, jobs, recent events
+// and moderation history. Jobs and events are best effort: a failure there
+// is logged and leaves the section empty rather than hiding the rest.
+func (s *service) Inspect(ctx context.Context, userID string) (*Dossier, error) {
+	log := logger.FromContext(ctx)
+
+	user, err := s.users.GetUserByID(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user: %w", err)
+	}
+
+	dossier := &Dossier{
+		User:         user,
+		Inventory:    []DossierItem{},
+		Jobs:         []domain.UserJobInfo{},
+		RecentEvents: []eventlog.Event{},
+	}
+
+	if dossier.Freeze, err = s.repo.GetFreeze(ctx, userID); err != nil {
+		return nil, fmt.Errorf("failed to get freeze: %w", err)
+	}
+	dossier.Frozen = dossier.Freeze != nil
+
+	if dossier.Inventory, err = s.inventory(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	if dossier.History, err = s.repo.GetActions(ctx, userID, DossierActionLimit); err != nil {
+		return nil, fmt.Errorf("failed to get moderation history: %w", err)
+	}
+
+	if s.jobs != nil {
+		if jobs, err := s.jobs.GetUserJobs(ctx, userID); err != nil {
+			log.Warn(LogMsgDossierPartFailed, "part", "jobs", "user_id", userID, "error", err)
+		} else {
+			dossier.Jobs = jobs
+		}
+	}
+
+	if s.events != nil {
+		if events, err := s.events.GetEvents(ctx, eventlog.EventFilter{UserID: &userID, Limit: DossierEventLimit}); err != nil {
+			log.Warn(LogMsgDossierPartFailed, "part", "events", "user_id", userID, "error", err)
+		} else {
+			dossier.RecentEvents = events
+		}
+	}
+
+	return dossier, nil
+}
+
+// IsFrozen reports whether the account linked to a platform identity is frozen
+func (s *service) IsFrozen(ctx context.Context, platform, platformID string) (bool, error) {
+	return s.repo.IsFrozenByPlatform(ctx, platform, platformID)
+}
+
+// checkTarget validates the actor and makes sure the account exists
+func (s *service) checkTarget(ctx context.Context, userID, actor string) error {
+	if actor == "" {
+		return fmt.Errorf("%w: actor is required", domain.ErrInvalidInput)
+	}
+	if _, err := s.users.GetUserByID(ctx, userID); err != nil {
+		return fmt.Errorf("failed to get user: %w", err)
+	}
+	return nil
+}
+
+// inventory returns the account's slots with item names resolved
+func (s *service) inventory(ctx context.Context, userID string) ([]DossierItem, error) {
+	inv, err := s.users.GetInventory(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get inventory: %w", err)
+	}
+	items := make([]DossierItem, 0, len(inv.Slots))
+	if len(inv.Slots) == 0 {
+		return items, nil
+	}
+
+	ids := make([]int, 0, len(inv.Slots))
+	for _, slot := range inv.Slots {
+		ids = append(ids, slot.ItemID)
+	}
+	defs, err := s.users.GetItemsByIDs(ctx, ids)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get items: %w", err)
+	}
+	names := make(map[int]string, len(defs))
+	for _, def := range defs {
+		names[def.ID] = def.InternalName
+	}
+
+	for _, slot := range inv.Slots {
+		items = append(items, DossierItem{
+			ItemID:   slot.ItemID,
+			ItemName: names[slot.ItemID],
+			Quantity: slot.Quantity,
+			Quality:  slot.QualityLevel,
+		})
+	}
+	return items, nil
+}
@@ -0,0 +1,160 @@
+package moderation_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/eventlog"
+	"github.com/osse101/BrandishBot_Go/internal/moderation"
+	"github.com/osse101/BrandishBot_Go/internal/moderation/mocks"
+)
+
+const testUserID = "user-a"
+
+type fakeJobs struct {
+	jobs []domain.UserJobInfo
+	err  error
+}
+
+func (f fakeJobs) GetUserJobs(context.Context, string) ([]domain.UserJobInfo, error) {
+	return f.jobs, f.err
+}
+
+type fakeEvents struct {
+	filter *eventlog.EventFilter
+}
+
+func (f *fakeEvents) GetEvents(_ context.Context, filter eventlog.EventFilter) ([]eventlog.Event, error) {
+	f.filter = &filter
+	return []eventlog.Event{{ID: 9, EventType: "item.sold"}}, nil
+}
+
+func TestFreeze(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("freezes an account", func(t *testing.T) {
+		repo := mocks.NewMockRepository(t)
+		users := mocks.NewMockUserRepository(t)
+		users.On("GetUserByID", ctx, testUserID).Return(&domain.User{ID: testUserID}, nil)
+		repo.On("Freeze", ctx, testUserID, "mod", "duping").Return(true, nil)
+
+		assert.NoError(t, moderation.NewService(repo, users, nil, nil).Freeze(ctx, testUserID, "mod", "duping"))
+	})
+
+	t.Run("already frozen", func(t *testing.T) {
+		repo := mocks.NewMockRepository(t)
+		users := mocks.NewMockUserRepository(t)
+		users.On("GetUserByID", ctx, testUserID).Return(&domain.User{ID: testUserID}, nil)
+		repo.On("Freeze", ctx, testUserID, "mod", "").Return(false, nil)
+
+		err := moderation.NewService(repo, users, nil, nil).Freeze(ctx, testUserID, "mod", "")
+
+		assert.ErrorIs(t, err, domain.ErrAccountAlreadyFrozen)
+	})
+
+	t.Run("unknown user", func(t *testing.T) {
+		repo := mocks.NewMockRepository(t)
+		users := mocks.NewMockUserRepository(t)
+		users.On("GetUserByID", ctx, testUserID).Return(nil, domain.ErrUserNotFound)
+
+		err := moderation.NewService(repo, users, nil, nil).Freeze(ctx, testUserID, "mod", "")
+
+		assert.ErrorIs(t, err, domain.ErrUserNotFound)
+	})
+
+	t.Run("requires an actor", func(t *testing.T) {
+		repo := mocks.NewMockRepository(t)
+		users := mocks.NewMockUserRepository(t)
+
+		err := moderation.NewService(repo, users, nil, nil).Freeze(ctx, testUserID, "", "")
+
+		assert.ErrorIs(t, err, domain.ErrInvalidInput)
+	})
+}
+
+func TestUnfreeze(t *testing.T) {
+	ctx := context.Background()
+	repo := mocks.NewMockRepository(t)
+	users := mocks.NewMockUserRepository(t)
+	users.On("GetUserByID", ctx, testUserID).Return(&domain.User{ID: testUserID}, nil)
+	repo.On("Unfreeze", ctx, testUserID, "mod", "").Return(false, nil)
+
+	err := moderation.NewService(repo, users, nil, nil).Unfreeze(ctx, testUserID, "mod", "")
+
+	assert.ErrorIs(t, err, domain.ErrAccountNotFrozen)
+}
+
+func TestWipeInventory(t *testing.T) {
+	ctx := context.Background()
+	repo := mocks.NewMockRepository(t)
+	users := mocks.NewMockUserRepository(t)
+	users.On("GetUserByID", ctx, testUserID).Return(&domain.User{ID: testUserID}, nil)
+	repo.On("WipeInventory", ctx, testUserID, "mod", "alt").Return([]domain.InventorySlot{{ItemID: 1, Quantity: 10}}, nil)
+
+	removed, err := moderation.NewService(repo, users, nil, nil).WipeInventory(ctx, testUserID, "mod", "alt")
+
+	require.NoError(t, err)
+	assert.Equal(t, []domain.InventorySlot{{ItemID: 1, Quantity: 10}}, removed)
+}
+
+func TestInspect(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("builds the full dossier", func(t *testing.T) {
+		repo := mocks.NewMockRepository(t)
+		users := mocks.NewMockUserRepository(t)
+		events := &fakeEvents{}
+		freeze := &domain.AccountFreeze{UserID: testUserID, FrozenBy: "mod"}
+		users.On("GetUserByID", ctx, testUserID).Return(&domain.User{ID: testUserID, Username: "alice"}, nil)
+		repo.On("GetFreeze", ctx, testUserID).Return(freeze, nil)
+		users.On("GetInventory", ctx, testUserID).Return(&domain.Inventory{Slots: []domain.InventorySlot{
+			{ItemID: 1, Quantity: 500},
+			{ItemID: 4, Quantity: 2, QualityLevel: domain.QualityRare},
+		}}, nil)
+		users.On("GetItemsByIDs", ctx, []int{1, 4}).Return([]domain.Item{
+			{ID: 1, InternalName: domain.ItemMoney},
+			{ID: 4, InternalName: "lootbox_tier1"},
+		}, nil)
+		repo.On("GetActions", ctx, testUserID, moderation.DossierActionLimit).
+			Return([]domain.ModerationAction{{ID: 3, Action: domain.ModerationFreeze}}, nil)
+		jobs := fakeJobs{jobs: []domain.UserJobInfo{{JobKey: "merchant", Level: 4}}}
+
+		dossier, err := moderation.NewService(repo, users, jobs, events).Inspect(ctx, testUserID)
+
+		require.NoError(t, err)
+		assert.True(t, dossier.Frozen)
+		assert.Equal(t, freeze, dossier.Freeze)
+		assert.Equal(t, []moderation.DossierItem{
+			{ItemID: 1, ItemName: domain.ItemMoney, Quantity: 500},
+			{ItemID: 4, ItemName: "lootbox_tier1", Quantity: 2, Quality: domain.QualityRare},
+		}, dossier.Inventory)
+		assert.Len(t, dossier.Jobs, 1)
+		assert.Len(t, dossier.RecentEvents, 1)
+		assert.Len(t, dossier.History, 1)
+		require.NotNil(t, events.filter)
+		assert.Equal(t, testUserID, *events.filter.UserID)
+		assert.Equal(t, moderation.DossierEventLimit, events.filter.Limit)
+	})
+
+	t.Run("a failed job lookup leaves jobs empty", func(t *testing.T) {
+		repo := mocks.NewMockRepository(t)
+		users := mocks.NewMockUserRepository(t)
+		users.On("GetUserByID", ctx, testUserID).Return(&domain.User{ID: testUserID}, nil)
+		repo.On("GetFreeze", ctx, testUserID).Return(nil, nil)
+		users.On("GetInventory", ctx, testUserID).Return(&domain.Inventory{}, nil)
+		repo.On("GetActions", ctx, testUserID, mock.Anything).Return(nil, nil)
+
+		dossier, err := moderation.NewService(repo, users, fakeJobs{err: errors.New("db down")}, nil).Inspect(ctx, testUserID)
+
+		require.NoError(t, err)
+		assert.False(t, dossier.Frozen)
+		assert.Empty(t, dossier.Jobs)
+		assert.Empty(t, dossier.Inventory)
+	})
+}
@@ -0,0 +1,106 @@
+package monetization
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+)
+
+// rule is a validated config rule with its XP boost duration parsed
+type rule struct {
+	domain.MonetizationRule
+	boostDuration time.Duration
+}
+
+// LoadConfig reads and validates the reward mapping config
+func LoadConfig(path string) (*domain.MonetizationConfig, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read monetization config: %w", err)
+	}
+
+	var cfg domain.MonetizationConfig
+	if err := json.Unmarshal(data, &cfg); err != nil {
+		return nil, fmt.Errorf("failed to parse monetization config: %w", err)
+	}
+
+	if _, err := compileRules(cfg.Rules); err != nil {
+		return nil, fmt.Errorf("invalid monetization config: %w", err)
+	}
+	return &cfg, nil
+}
+
+func compileRules(rules []domain.MonetizationRule) ([]rule, error) {
+	compiled := make([]rule, 0, len(rules))
+	for i, r := range rules {
+		switch r.Kind {
+		case domain.MonetizationKindSub, domain.MonetizationKindResub, domain.MonetizationKindGiftSub,
+			domain.MonetizationKindDonation, domain.MonetizationKindCheer:
+		default:
+			return nil, fmt.Errorf("rule %d: unknown kind %q", i, r.Kind)
+		}
+		if r.MinAmount < 0 {
+			return nil, fmt.Errorf("rule %d: min_amount must not be negative", i)
+		}
+
+		rewards := r.Rewards
+		for item, qty := range rewards.Items {
+			if qty <= 0 {
+				return nil, fmt.Errorf("rule %d: item %q quantity must be positive", i, item)
+			}
+		}
+		if rewards.Contribution < 0 || rewards.ContributionPerUnit < 0 {
+			return nil, fmt.Errorf("rule %d: contribution must not be negative", i)
+		}
+
+		c := rule{MonetizationRule: r}
+		if rewards.XPBoost != nil {
+			if rewards.XPBoost.Multiplier <= 1 {
+				return nil, fmt.Errorf("rule %d: xp_boost multiplier must be greater than 1", i)
+			}
+			d, err := time.ParseDuration(rewards.XPBoost.Duration)
+			if err != nil || d <= 0 {
+				return nil, fmt.Errorf("rule %d: xp_boost duration %q must be a positive duration", i, rewards.XPBoost.Duration)
+			}
+			c.boostDuration = d
+		}
+		compiled = append(compiled, c)
+	}
+	return compiled, nil
+}
+
+// matches reports whether the rule applies to the event
+func (r rule) matches(evt domain.MonetizationEvent) bool {
+	if r.Kind != evt.Kind {
+		return false
+	}
+	if r.Tier != "" && r.Tier != evt.Tier {
+		return false
+	}
+	return size(evt) >= r.MinAmount
+}
+
+// size is what MinAmount is compared against: the donation amount, or the
+// number of gifted subs or bits
+func size(evt domain.MonetizationEvent) float64 {
+	if evt.Kind == domain.MonetizationKindDonation {
+		return evt.Amount
+	}
+	return float64(evt.Quantity)
+}
+
+// units is what ContributionPerUnit is multiplied by: gifted subs, bits, or
+// whole currency units of a donation
+func units(evt domain.MonetizationEvent) int {
+	switch evt.Kind {
+	case domain.MonetizationKindGiftSub, domain.MonetizationKindCheer:
+		return evt.Quantity
+	case domain.MonetizationKindDonation:
+		return int(evt.Amount)
+	default:
+		return 1
+	}
+}
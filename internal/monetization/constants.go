@@ -0,0 +1,19 @@
+package monetization
+
+// Log messages
+const (
+	LogMsgEventDuplicate = "Ignoring duplicate monetization event"
+	LogMsgEventUnmatched = "No monetization reward rule matched event"
+	LogMsgEventRewarded  = "Granted monetization rewards"
+	LogMsgRewardFailed   = "Failed to grant monetization reward"
+	LogMsgCompleteFailed = "Failed to record monetization event result"
+	LogMsgReleaseFailed  = "Failed to release monetization event claim"
+	LogMsgUserUnresolved = "Monetization event has no linked user; granting community rewards only"
+)
+
+// Reward names used in logs
+const (
+	RewardItems        = "items"
+	RewardContribution = "contribution"
+	RewardXPBoost      = "xp_boost"
+)
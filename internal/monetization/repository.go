@@ -0,0 +1,21 @@
+package monetization
+
+import (
+	"context"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+)
+
+// Repository records processed monetization events for deduplication
+type Repository interface {
+	// ClaimEvent stores the event and returns false if its source and event ID
+	// were already claimed
+	ClaimEvent(ctx context.Context, evt domain.MonetizationEvent) (bool, error)
+
+	// CompleteEvent records the linked user and granted rewards of a claimed event
+	CompleteEvent(ctx context.Context, result domain.MonetizationResult) error
+
+	// ReleaseEvent removes a claim that has not been completed so a
+	// redelivery of the event can be processed
+	ReleaseEvent(ctx context.Context, source, eventID string) error
+}
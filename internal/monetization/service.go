@@ -0,0 +1,195 @@
+package monetization
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/logger"
+)
+
+// Service converts subscription and donation events into rewards
+type Service interface {
+	// HandleEvent grants the rewards configured for the event. Events are
+	// deduplicated by source and event ID; a redelivered event returns
+	// domain.ErrDuplicateMonetizationEvent.
+	HandleEvent(ctx context.Context, evt domain.MonetizationEvent) (*domain.MonetizationResult, error)
+}
+
+// UserService defines the user operations needed to grant rewards
+type UserService interface {
+	GetUserOrRegister(ctx context.Context, platform, platformID, username string) (*domain.User, error)
+	GetItemByName(ctx context.Context, name string) (*domain.Item, error)
+	GrantItemReward(ctx context.Context, user *domain.User, item *domain.Item, quantity int, qualityLevel domain.QualityLevel) error
+}
+
+// ProgressionService defines the progression operations needed to grant rewards
+type ProgressionService interface {
+	AddExternalContribution(ctx context.Context, contribution domain.ExternalContribution) (*domain.ExternalContributionResult, error)
+}
+
+// JobService defines the job operations needed to grant rewards
+type JobService interface {
+	GrantXPBoost(ctx context.Context, userID string, multiplier float64, duration time.Duration) error
+}
+
+type service struct {
+	repo        Repository
+	users       UserService
+	progression ProgressionService
+	jobs        JobService
+	rules       []rule
+}
+
+// NewService creates a monetization service from a loaded reward config
+func NewService(cfg *domain.MonetizationConfig, repo Repository, users UserService, progression ProgressionService, jobs JobService) (Service, error) {
+	rules, err := compileRules(cfg.Rules)
+	if err != nil {
+		return nil, fmt.Errorf("invalid monetization config: %w", err)
+	}
+	return &service{
+		repo:        repo,
+		users:       users,
+		progression: progression,
+		jobs:        jobs,
+		rules:       rules,
+	}, nil
+}
+
+// HandleEvent grants the rewards configured for the event
+func (s *service) HandleEvent(ctx context.Context, evt domain.MonetizationEvent) (*domain.MonetizationResult, error) {
+	log := logger.FromContext(ctx)
+
+	claimed, err := s.repo.ClaimEvent(ctx, evt)
+	if err != nil {
+		return nil, fmt.Errorf("failed to claim monetization event: %w", err)
+	}
+	if !claimed {
+		log.Info(LogMsgEventDuplicate, "source", evt.Source, "event_id", evt.EventID)
+		return nil, domain.ErrDuplicateMonetizationEvent
+	}
+
+	result := &domain.MonetizationResult{Source: evt.Source, EventID: evt.EventID}
+
+	r := s.matchRule(evt)
+	if r == nil {
+		log.Info(LogMsgEventUnmatched, "source", evt.Source, "event_id", evt.EventID, "kind", evt.Kind, "tier", evt.Tier)
+		s.complete(ctx, result)
+		return result, nil
+	}
+	result.Matched = true
+
+	// Nothing has been granted until the user and items are resolved, so a
+	// failure up to that point releases the claim and lets the sender retry
+	var user *domain.User
+	var items []*domain.Item
+	if evt.Platform != "" && evt.PlatformID != "" {
+		user, err = s.users.GetUserOrRegister(ctx, evt.Platform, evt.PlatformID, evt.Username)
+		if err != nil {
+			s.release(ctx, evt)
+			return nil, fmt.Errorf("failed to resolve monetization user: %w", err)
+		}
+		result.UserID = user.ID
+
+		items, err = s.resolveItems(ctx, r.Rewards.Items)
+		if err != nil {
+			s.release(ctx, evt)
+			return nil, err
+		}
+	} else {
+		log.Info(LogMsgUserUnresolved, "source", evt.Source, "event_id", evt.EventID)
+	}
+
+	// Grants are best effort from here on: releasing the claim after any
+	// reward was granted would let a retry grant it twice
+	for _, item := range items {
+		qty := r.Rewards.Items[item.InternalName]
+		if err := s.users.GrantItemReward(ctx, user, item, qty, domain.QualityCommon); err != nil {
+			log.Warn(LogMsgRewardFailed, "reward", RewardItems, "item", item.InternalName, "event_id", evt.EventID, "error", err)
+			continue
+		}
+		if result.Items == nil {
+			result.Items = make(map[string]int, len(items))
+		}
+		result.Items[item.InternalName] = qty
+	}
+
+	if amount := r.Rewards.Contribution + r.Rewards.ContributionPerUnit*units(evt); amount > 0 {
+		contribution, err := s.progression.AddExternalContribution(ctx, domain.ExternalContribution{
+			Source:     evt.Source,
+			Amount:     amount,
+			Platform:   evt.Platform,
+			PlatformID: evt.PlatformID,
+			Username:   evt.Username,
+		})
+		if err != nil {
+			log.Warn(LogMsgRewardFailed, "reward", RewardContribution, "event_id", evt.EventID, "error", err)
+		} else {
+			result.Contribution = contribution.Accepted
+		}
+	}
+
+	if user != nil && r.Rewards.XPBoost != nil {
+		if err := s.jobs.GrantXPBoost(ctx, user.ID, r.Rewards.XPBoost.Multiplier, r.boostDuration); err != nil {
+			log.Warn(LogMsgRewardFailed, "reward", RewardXPBoost, "event_id", evt.EventID, "error", err)
+		} else {
+			result.XPMultiplier = r.Rewards.XPBoost.Multiplier
+			result.XPBoostDuration = r.boostDuration.String()
+		}
+	}
+
+	s.complete(ctx, result)
+	log.Info(LogMsgEventRewarded,
+		"source", evt.Source,
+		"event_id", evt.EventID,
+		"kind", evt.Kind,
+		"user_id", result.UserID,
+		"contribution", result.Contribution)
+	return result, nil
+}
+
+// resolveItems looks up the rule's reward items in name order
+func (s *service) resolveItems(ctx context.Context, rewards map[string]int) ([]*domain.Item, error) {
+	names := make([]string, 0, len(rewards))
+	for name := range rewards {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	items := make([]*domain.Item, 0, len(names))
+	for _, name := range names {
+		item, err := s.users.GetItemByName(ctx, name)
+		if err != nil {
+			return nil, fmt.Errorf("failed to resolve monetization reward item %q: %w", name, err)
+		}
+		if item == nil {
+			return nil, fmt.Errorf("monetization reward item %q: %w", name, domain.ErrItemNotFound)
+		}
+		items = append(items, item)
+	}
+	return items, nil
+}
+
+// matchRule returns the first rule that applies to the event
+func (s *service) matchRule(evt domain.MonetizationEvent) *rule {
+	for i := range s.rules {
+		if s.rules[i].matches(evt) {
+			return &s.rules[i]
+		}
+	}
+	return nil
+}
+
+func (s *service) complete(ctx context.Context, result *domain.MonetizationResult) {
+	if err := s.repo.CompleteEvent(ctx, *result); err != nil {
+		logger.FromContext(ctx).Warn(LogMsgCompleteFailed, "event_id", result.EventID, "error", err)
+	}
+}
+
+func (s *service) release(ctx context.Context, evt domain.MonetizationEvent) {
+	if err := s.repo.ReleaseEvent(ctx, evt.Source, evt.EventID); err != nil {
+		logger.FromContext(ctx).Warn(LogMsgReleaseFailed, "event_id", evt.EventID, "error", err)
+	}
+}
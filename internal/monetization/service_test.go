@@ -0,0 +1,214 @@
+package monetization_test
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/monetization"
+	"github.com/osse101/BrandishBot_Go/internal/monetization/mocks"
+)
+
+func setupServiceTest(t *testing.T, rules ...domain.MonetizationRule) (monetization.Service, *mocks.MockRepository, *mocks.MockUserService, *mocks.MockProgressionService, *mocks.MockJobService) {
+	mockRepo := mocks.NewMockRepository(t)
+	mockUsers := mocks.NewMockUserService(t)
+	mockProgression := mocks.NewMockProgressionService(t)
+	mockJobs := mocks.NewMockJobService(t)
+	svc, err := monetization.NewService(&domain.MonetizationConfig{Rules: rules}, mockRepo, mockUsers, mockProgression, mockJobs)
+	require.NoError(t, err)
+	return svc, mockRepo, mockUsers, mockProgression, mockJobs
+}
+
+func subEvent() domain.MonetizationEvent {
+	return domain.MonetizationEvent{
+		Source:     domain.MonetizationSourceTwitch,
+		EventID:    "evt-1",
+		Kind:       domain.MonetizationKindSub,
+		Platform:   domain.PlatformTwitch,
+		PlatformID: "tw-1",
+		Username:   "alice",
+		Tier:       "1000",
+	}
+}
+
+func TestHandleEvent(t *testing.T) {
+	ctx := context.Background()
+	alice := &domain.User{ID: "user-1", Username: "alice"}
+	lootbox := &domain.Item{ID: 5, InternalName: "lootbox_tier1"}
+
+	subRule := domain.MonetizationRule{
+		Kind: domain.MonetizationKindSub,
+		Rewards: domain.MonetizationRewards{
+			Items:        map[string]int{"lootbox_tier1": 2},
+			Contribution: 30,
+			XPBoost:      &domain.XPBoostReward{Multiplier: 1.5, Duration: "24h"},
+		},
+	}
+
+	t.Run("grants items, contribution and XP boost to the linked user", func(t *testing.T) {
+		svc, mockRepo, mockUsers, mockProgression, mockJobs := setupServiceTest(t, subRule)
+		evt := subEvent()
+
+		mockRepo.On("ClaimEvent", ctx, evt).Return(true, nil)
+		mockUsers.On("GetUserOrRegister", ctx, domain.PlatformTwitch, "tw-1", "alice").Return(alice, nil)
+		mockUsers.On("GetItemByName", ctx, "lootbox_tier1").Return(lootbox, nil)
+		mockUsers.On("GrantItemReward", ctx, alice, lootbox, 2, domain.QualityCommon).Return(nil)
+		mockProgression.On("AddExternalContribution", ctx, domain.ExternalContribution{
+			Source: domain.MonetizationSourceTwitch, Amount: 30,
+			Platform: domain.PlatformTwitch, PlatformID: "tw-1", Username: "alice",
+		}).Return(&domain.ExternalContributionResult{Accepted: 30}, nil)
+		mockJobs.On("GrantXPBoost", ctx, "user-1", 1.5, 24*time.Hour).Return(nil)
+		mockRepo.On("CompleteEvent", ctx, mock.MatchedBy(func(r domain.MonetizationResult) bool {
+			return r.UserID == "user-1" && r.Matched
+		})).Return(nil)
+
+		result, err := svc.HandleEvent(ctx, evt)
+
+		require.NoError(t, err)
+		assert.True(t, result.Matched)
+		assert.Equal(t, map[string]int{"lootbox_tier1": 2}, result.Items)
+		assert.Equal(t, 30, result.Contribution)
+		assert.Equal(t, 1.5, result.XPMultiplier)
+	})
+
+	t.Run("redelivered events are rejected without granting", func(t *testing.T) {
+		svc, mockRepo, _, _, _ := setupServiceTest(t, subRule)
+		evt := subEvent()
+		mockRepo.On("ClaimEvent", ctx, evt).Return(false, nil)
+
+		_, err := svc.HandleEvent(ctx, evt)
+
+		assert.ErrorIs(t, err, domain.ErrDuplicateMonetizationEvent)
+	})
+
+	t.Run("first matching rule wins", func(t *testing.T) {
+		tier3 := domain.MonetizationRule{
+			Kind:    domain.MonetizationKindSub,
+			Tier:    "3000",
+			Rewards: domain.MonetizationRewards{Contribution: 150},
+		}
+		svc, mockRepo, _, mockProgression, _ := setupServiceTest(t, tier3, domain.MonetizationRule{
+			Kind:    domain.MonetizationKindSub,
+			Rewards: domain.MonetizationRewards{Contribution: 30},
+		})
+		evt := subEvent()
+		evt.Platform, evt.PlatformID = "", ""
+
+		mockRepo.On("ClaimEvent", ctx, evt).Return(true, nil)
+		mockProgression.On("AddExternalContribution", ctx, mock.MatchedBy(func(c domain.ExternalContribution) bool {
+			return c.Amount == 30
+		})).Return(&domain.ExternalContributionResult{Accepted: 30}, nil)
+		mockRepo.On("CompleteEvent", ctx, mock.Anything).Return(nil)
+
+		result, err := svc.HandleEvent(ctx, evt)
+
+		require.NoError(t, err)
+		assert.Equal(t, 30, result.Contribution)
+	})
+
+	t.Run("donations scale contribution by amount and respect min_amount", func(t *testing.T) {
+		svc, mockRepo, _, mockProgression, _ := setupServiceTest(t, domain.MonetizationRule{
+			Kind:      domain.MonetizationKindDonation,
+			MinAmount: 5,
+			Rewards:   domain.MonetizationRewards{ContributionPerUnit: 10},
+		})
+		evt := domain.MonetizationEvent{
+			Source: domain.MonetizationSourceStreamlabs, EventID: "don-1",
+			Kind: domain.MonetizationKindDonation, Amount: 12.5, Currency: "USD",
+		}
+
+		mockRepo.On("ClaimEvent", ctx, evt).Return(true, nil)
+		mockProgression.On("AddExternalContribution", ctx, mock.MatchedBy(func(c domain.ExternalContribution) bool {
+			return c.Source == domain.MonetizationSourceStreamlabs && c.Amount == 120
+		})).Return(&domain.ExternalContributionResult{Accepted: 120}, nil)
+		mockRepo.On("CompleteEvent", ctx, mock.Anything).Return(nil)
+
+		result, err := svc.HandleEvent(ctx, evt)
+		require.NoError(t, err)
+		assert.Equal(t, 120, result.Contribution)
+
+		small := evt
+		small.EventID, small.Amount = "don-2", 2
+		mockRepo.On("ClaimEvent", ctx, small).Return(true, nil)
+		mockRepo.On("CompleteEvent", ctx, mock.MatchedBy(func(r domain.MonetizationResult) bool {
+			return !r.Matched
+		})).Return(nil)
+
+		result, err = svc.HandleEvent(ctx, small)
+		require.NoError(t, err)
+		assert.False(t, result.Matched)
+	})
+
+	t.Run("releases the claim when the user cannot be resolved", func(t *testing.T) {
+		svc, mockRepo, mockUsers, _, _ := setupServiceTest(t, subRule)
+		evt := subEvent()
+
+		mockRepo.On("ClaimEvent", ctx, evt).Return(true, nil)
+		mockUsers.On("GetUserOrRegister", ctx, domain.PlatformTwitch, "tw-1", "alice").Return(nil, errors.New("db down"))
+		mockRepo.On("ReleaseEvent", ctx, domain.MonetizationSourceTwitch, "evt-1").Return(nil)
+
+		_, err := svc.HandleEvent(ctx, evt)
+
+		assert.Error(t, err)
+	})
+
+	t.Run("a failed reward does not block the others", func(t *testing.T) {
+		svc, mockRepo, mockUsers, mockProgression, mockJobs := setupServiceTest(t, subRule)
+		evt := subEvent()
+
+		mockRepo.On("ClaimEvent", ctx, evt).Return(true, nil)
+		mockUsers.On("GetUserOrRegister", ctx, domain.PlatformTwitch, "tw-1", "alice").Return(alice, nil)
+		mockUsers.On("GetItemByName", ctx, "lootbox_tier1").Return(lootbox, nil)
+		mockUsers.On("GrantItemReward", ctx, alice, lootbox, 2, domain.QualityCommon).Return(errors.New("inventory full"))
+		mockProgression.On("AddExternalContribution", ctx, mock.Anything).Return(nil, domain.ErrExternalCapReached)
+		mockJobs.On("GrantXPBoost", ctx, "user-1", 1.5, 24*time.Hour).Return(nil)
+		mockRepo.On("CompleteEvent", ctx, mock.Anything).Return(nil)
+
+		result, err := svc.HandleEvent(ctx, evt)
+
+		require.NoError(t, err)
+		assert.Empty(t, result.Items)
+		assert.Zero(t, result.Contribution)
+		assert.Equal(t, 1.5, result.XPMultiplier)
+	})
+}
+
+func TestLoadConfig(t *testing.T) {
+	write := func(t *testing.T, body string) string {
+		t.Helper()
+		path := filepath.Join(t.TempDir(), "rewards.json")
+		require.NoError(t, os.WriteFile(path, []byte(body), 0o600))
+		return path
+	}
+
+	t.Run("shipped config is valid", func(t *testing.T) {
+		cfg, err := monetization.LoadConfig(filepath.Join("..", "..", "configs", "monetization", "rewards.json"))
+		require.NoError(t, err)
+		assert.NotEmpty(t, cfg.Rules)
+	})
+
+	tests := []struct {
+		name string
+		body string
+	}{
+		{"unknown kind", `{"rules":[{"kind":"raid"}]}`},
+		{"non-positive item quantity", `{"rules":[{"kind":"sub","rewards":{"items":{"money":0}}}]}`},
+		{"boost that does not boost", `{"rules":[{"kind":"sub","rewards":{"xp_boost":{"multiplier":1,"duration":"1h"}}}]}`},
+		{"bad boost duration", `{"rules":[{"kind":"sub","rewards":{"xp_boost":{"multiplier":2,"duration":"soon"}}}]}`},
+		{"negative contribution", `{"rules":[{"kind":"cheer","rewards":{"contribution_per_unit":-1}}]}`},
+	}
+	for _, tt := range tests {
+		t.Run("rejects "+tt.name, func(t *testing.T) {
+			_, err := monetization.LoadConfig(write(t, tt.body))
+			assert.Error(t, err)
+		})
+	}
+}
@@ -1,5 +1,7 @@
 package naming
 
+import "time"
+
 // ============================================================================
 // Quality Level Constants
 // ============================================================================
@@ -24,6 +26,10 @@ const DatePartsCount = 2
 // a theme period (handles year-wrap scenarios like 12-15 to 01-05).
 const DateComparisonMultiplier = 100
 
+// LeapYear is a year with every month-day in it, used to walk a calendar
+// year when comparing theme periods
+const LeapYear = 2024
+
 // ============================================================================
 // Configuration Schema Constants
 // ============================================================================
@@ -50,20 +56,44 @@ const JSONKeyAliases = "aliases"
 // JSONKeyThemes is the top-level JSON key for theme period definitions
 const JSONKeyThemes = "themes"
 
+// ============================================================================
+// Alias Overrides
+// ============================================================================
+
+// AliasOverrideLoadTimeout bounds how long Reload waits for alias overrides
+const AliasOverrideLoadTimeout = 5 * time.Second
+
+// ============================================================================
+// Fuzzy Matching
+// ============================================================================
+
+// FuzzyCharsPerEdit allows one edit per this many characters of the typed
+// name, so short names have to be close to match
+const FuzzyCharsPerEdit = 4
+
+// FuzzyMaxDistance is the most edits a suggestion may be from the typed name
+const FuzzyMaxDistance = 3
+
+// MaxSuggestions caps how many names SuggestNames returns
+const MaxSuggestions = 3
+
 // ============================================================================
 // Error Messages
 // ============================================================================
 
 // Error context messages for wrapped errors during configuration loading
 const (
-	ErrContextFailedToLoadAliases = "failed to load aliases"
-	ErrContextFailedToLoadThemes  = "failed to load themes"
-	ErrContextFailedToParseConfig = "failed to parse config %s"
-	ErrContextFailedToDecodeData  = "failed to decode data for %s"
+	ErrContextFailedToLoadAliases   = "failed to load aliases"
+	ErrContextFailedToLoadThemes    = "failed to load themes"
+	ErrContextFailedToLoadOverrides = "failed to load alias overrides"
+	ErrContextFailedToParseConfig   = "failed to parse config %s"
+	ErrContextFailedToDecodeData    = "failed to decode data for %s"
 )
 
 // Configuration validation error messages
 const (
 	ErrMsgMissingVersionField = "%s missing version field"
 	ErrMsgInvalidSchema       = "invalid schema in %s: expected '%s', got '%s'"
+	ErrMsgPackThemeExists     = "content pack theme '%s' is already defined in the themes config"
+	ErrMsgPackThemeOverlaps   = "content pack theme '%s' overlaps theme '%s'"
 )
@@ -0,0 +1,107 @@
+package naming
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+)
+
+// SuggestNames returns the public names of the items a mistyped name was
+// most likely meant to be, closest first. Public names, internal names and
+// every alias of an item are compared, so a near-miss of a seasonal alias
+// still suggests the item.
+func (r *resolver) SuggestNames(name string) []string {
+	query := strings.ToLower(strings.TrimSpace(name))
+	if query == "" {
+		return nil
+	}
+	maxDistance := len([]rune(query)) / FuzzyCharsPerEdit
+	if maxDistance < 1 {
+		maxDistance = 1
+	}
+	if maxDistance > FuzzyMaxDistance {
+		maxDistance = FuzzyMaxDistance
+	}
+
+	r.mu.RLock()
+	best := make(map[string]int)
+	consider := func(internalName, candidate string) {
+		d := levenshtein(query, strings.ToLower(candidate))
+		if d > maxDistance {
+			return
+		}
+		suggestion := internalName
+		if public, ok := r.internalToPublic[internalName]; ok {
+			suggestion = public
+		}
+		if prev, ok := best[suggestion]; !ok || d < prev {
+			best[suggestion] = d
+		}
+	}
+	for internalName, public := range r.internalToPublic {
+		consider(internalName, public)
+		consider(internalName, internalName)
+	}
+	for internalName, pool := range r.aliases {
+		for _, alias := range pool.Default {
+			consider(internalName, alias)
+		}
+		for _, aliases := range pool.Themes {
+			for _, alias := range aliases {
+				consider(internalName, alias)
+			}
+		}
+	}
+	r.mu.RUnlock()
+
+	suggestions := make([]string, 0, len(best))
+	for suggestion := range best {
+		suggestions = append(suggestions, suggestion)
+	}
+	sort.Slice(suggestions, func(i, j int) bool {
+		di, dj := best[suggestions[i]], best[suggestions[j]]
+		if di != dj {
+			return di < dj
+		}
+		return suggestions[i] < suggestions[j]
+	})
+	if len(suggestions) > MaxSuggestions {
+		suggestions = suggestions[:MaxSuggestions]
+	}
+	return suggestions
+}
+
+// ItemNotFound returns domain.ErrItemNotFound for a name that resolved to no
+// item, carrying the resolver's suggestions for it. A nil resolver gives an
+// error without suggestions.
+func ItemNotFound(r Resolver, name string) error {
+	var suggestions []string
+	if r != nil {
+		suggestions = r.SuggestNames(name)
+	}
+	return domain.ItemNotFoundError{Name: name, Suggestions: suggestions}
+}
+
+// levenshtein counts the single-rune inserts, deletes and substitutions
+// that turn a into b
+func levenshtein(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	prev := make([]int, len(rb)+1)
+	curr := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		curr[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(rb)]
+}
@@ -0,0 +1,77 @@
+package naming
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+)
+
+func TestLevenshtein(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{"", "", 0},
+		{"abc", "", 3},
+		{"missile", "missile", 0},
+		{"misile", "missile", 1},
+		{"kitten", "sitting", 3},
+		{"bömb", "bomb", 1},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, levenshtein(tt.a, tt.b), "%q -> %q", tt.a, tt.b)
+	}
+}
+
+func newSuggestResolver() *resolver {
+	r := &resolver{
+		publicToInternal: make(map[string]string),
+		internalToPublic: make(map[string]string),
+		aliases: map[string]AliasPool{
+			"lootbox_tier0": {Default: []string{"Rusty Crate"}, Themes: map[string][]string{"halloween": {"Spooky Crate"}}},
+		},
+	}
+	r.RegisterItem("weapon_missile", "missile")
+	r.RegisterItem("weapon_mine", "mine")
+	r.RegisterItem("lootbox_tier0", "junkbox")
+	return r
+}
+
+func TestSuggestNames(t *testing.T) {
+	r := newSuggestResolver()
+
+	t.Run("near miss of a public name", func(t *testing.T) {
+		assert.Equal(t, []string{"missile"}, r.SuggestNames("misile"))
+	})
+
+	t.Run("near miss of an internal name", func(t *testing.T) {
+		assert.Equal(t, []string{"missile", "mine"}, r.SuggestNames("weapon_misile"))
+	})
+
+	t.Run("near miss of an alias suggests the public name", func(t *testing.T) {
+		assert.Equal(t, []string{"junkbox"}, r.SuggestNames("spooky crat"))
+	})
+
+	t.Run("closest first", func(t *testing.T) {
+		assert.Equal(t, []string{"mine", "missile"}, r.SuggestNames("weapon_minle"))
+	})
+
+	t.Run("nothing close", func(t *testing.T) {
+		assert.Empty(t, r.SuggestNames("dragon"))
+		assert.Empty(t, r.SuggestNames(""))
+	})
+}
+
+func TestItemNotFound(t *testing.T) {
+	err := ItemNotFound(newSuggestResolver(), "misile")
+
+	assert.ErrorIs(t, err, domain.ErrItemNotFound)
+	var notFound domain.ItemNotFoundError
+	assert.True(t, errors.As(err, &notFound))
+	assert.Equal(t, []string{"missile"}, notFound.Suggestions)
+
+	assert.ErrorIs(t, ItemNotFound(nil, "misile"), domain.ErrItemNotFound)
+}
@@ -87,7 +87,7 @@ func TestIntegration_AllItemsHaveValidStructure(t *testing.T) {
 		aliases:     make(map[string]AliasPool),
 	}
 
-	err := r.loadAliases()
+	err := r.Reload()
 	require.NoError(t, err, "Production aliases should be valid JSON")
 
 	// Verify all items have at least default or theme aliases
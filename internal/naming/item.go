@@ -0,0 +1,31 @@
+package naming
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+)
+
+// ItemGetter looks items up by internal name
+type ItemGetter interface {
+	GetItemByName(ctx context.Context, itemName string) (*domain.Item, error)
+}
+
+// ResolveItem looks an item up by a user-typed public or internal name. A
+// nil resolver only matches internal names.
+func ResolveItem(ctx context.Context, r Resolver, items ItemGetter, itemName string) (*domain.Item, error) {
+	if r != nil {
+		if internalName, ok := r.ResolvePublicName(itemName); ok {
+			itemName = internalName
+		}
+	}
+	item, err := items.GetItemByName(ctx, itemName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get item: %w", err)
+	}
+	if item == nil {
+		return nil, ItemNotFound(r, itemName)
+	}
+	return item, nil
+}
@@ -0,0 +1,40 @@
+package naming
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+)
+
+// itemsByName is an ItemGetter over a fixed set of items
+type itemsByName map[string]*domain.Item
+
+func (m itemsByName) GetItemByName(_ context.Context, itemName string) (*domain.Item, error) {
+	return m[itemName], nil
+}
+
+func TestResolveItem(t *testing.T) {
+	ctx := context.Background()
+	items := itemsByName{"weapon_missile": {ID: 3, InternalName: "weapon_missile"}}
+
+	t.Run("public name", func(t *testing.T) {
+		item, err := ResolveItem(ctx, newSuggestResolver(), items, "missile")
+		require.NoError(t, err)
+		assert.Equal(t, 3, item.ID)
+	})
+
+	t.Run("internal name without a resolver", func(t *testing.T) {
+		item, err := ResolveItem(ctx, nil, items, "weapon_missile")
+		require.NoError(t, err)
+		assert.Equal(t, 3, item.ID)
+	})
+
+	t.Run("unknown name", func(t *testing.T) {
+		_, err := ResolveItem(ctx, newSuggestResolver(), items, "misile")
+		assert.ErrorIs(t, err, domain.ErrItemNotFound)
+	})
+}
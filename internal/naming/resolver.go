@@ -1,12 +1,14 @@
 package naming
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"os"
 	"strconv"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/osse101/BrandishBot_Go/internal/domain"
@@ -23,6 +25,38 @@ type AliasPool struct {
 type ThemePeriod struct {
 	Start string `json:"start"` // MM-DD format
 	End   string `json:"end"`   // MM-DD format
+
+	// FeatureKey is the progression feature that lets users pick the theme
+	// outside its period. Empty means any user can pick it.
+	FeatureKey string `json:"feature_key,omitempty"`
+}
+
+// Contains reports whether now falls within the period (handles year wrap)
+func (p ThemePeriod) Contains(now time.Time) bool {
+	return isInPeriod(now, p.Start, p.End)
+}
+
+// Overlaps reports whether the two periods share at least one day
+func (p ThemePeriod) Overlaps(other ThemePeriod) bool {
+	// A leap year covers every month-day either period can name
+	day := time.Date(LeapYear, time.January, 1, 0, 0, 0, 0, time.UTC)
+	for day.Year() == LeapYear {
+		if p.Contains(day) && other.Contains(day) {
+			return true
+		}
+		day = day.AddDate(0, 0, 1)
+	}
+	return false
+}
+
+// ThemePack is a theme defined outside the themes config, such as by a
+// seasonal content pack, together with the aliases it gives items
+type ThemePack struct {
+	Period ThemePeriod
+
+	// Aliases are shown while the theme is active, keyed by internal name.
+	// Items with no alias pool of their own show them all year.
+	Aliases map[string][]string
 }
 
 // Resolver handles item name resolution and display name generation
@@ -33,9 +67,16 @@ type Resolver interface {
 	// GetDisplayName generates a display name with optional quality prefix
 	GetDisplayName(internalName string, qualityLevel domain.QualityLevel) string
 
+	// GetDisplayNameForTheme is GetDisplayName in the given theme instead of
+	// the dated one. An empty or unknown theme falls back to GetDisplayName.
+	GetDisplayNameForTheme(internalName string, qualityLevel domain.QualityLevel, theme string) string
+
 	// GetActiveTheme returns the currently active theme based on date
 	GetActiveTheme() string
 
+	// GetThemes returns every configured theme by name
+	GetThemes() map[string]ThemePeriod
+
 	// Reload reloads the alias and theme configurations
 	Reload() error
 
@@ -44,6 +85,10 @@ type Resolver interface {
 
 	// ResolveInternalName converts an internal name to its primary public name
 	ResolveInternalName(internalName string) (publicName string, ok bool)
+
+	// SuggestNames returns the public names closest to a name that did not
+	// resolve, for "did you mean" hints
+	SuggestNames(name string) []string
 }
 
 type resolver struct {
@@ -55,19 +100,91 @@ type resolver struct {
 	// Mapping: internal_name -> public_name (reverse lookup)
 	internalToPublic map[string]string
 
-	// Alias pools keyed by internal_name
+	// Alias pools keyed by internal_name: the config file's pools with the
+	// overrides laid over them
 	aliases map[string]AliasPool
 
+	// Pools from the aliases config file, kept to re-merge overrides
+	fileAliases map[string]AliasPool
+
+	// loadOverrides returns pools that replace the file's, nil for none
+	loadOverrides AliasOverrideLoader
+
 	// Theme periods
 	themes map[string]ThemePeriod
 
+	// Themes from content packs, merged into themes and aliases on Reload
+	packs map[string]ThemePack
+
 	// Config paths
 	aliasesPath string
 	themesPath  string
+
+	// Precomputed display names for the active theme, rebuilt on reload and
+	// when the date moves into or out of a theme period
+	table   atomic.Pointer[displayTable]
+	tableMu sync.Mutex
+
+	// Display names for themes users picked themselves, built on first use
+	themeNames map[string]map[string]displayNames
+
+	now func() time.Time // nil uses time.Now
+}
+
+// displayTable holds every item's display name candidates for one theme.
+// It is checked against the date so a theme switch takes effect on the
+// first lookup of the new day.
+type displayTable struct {
+	theme string
+	day   int // month*100+day the active theme was computed for
+	names map[string]displayNames
+}
+
+// displayNames are an item's aliases for the active theme, already formatted
+// for each quality that changes how a name is shown
+type displayNames struct {
+	plain     []string
+	cursed    []string
+	legendary []string
+}
+
+func (n displayNames) forQuality(qualityLevel domain.QualityLevel) []string {
+	switch qualityLevel {
+	case domain.QualityCursed:
+		return n.cursed
+	case domain.QualityLegendary:
+		return n.legendary
+	default:
+		return n.plain
+	}
+}
+
+// AliasOverrideLoader returns alias pools that replace the config file's
+// pools for their items
+type AliasOverrideLoader func(ctx context.Context) (map[string]AliasPool, error)
+
+// Option configures optional resolver behaviour
+type Option func(*resolver)
+
+// WithAliasOverrides lays the loaded pools over the aliases config file on
+// every Reload, so pools managed elsewhere apply without editing the file
+func WithAliasOverrides(load AliasOverrideLoader) Option {
+	return func(r *resolver) {
+		r.loadOverrides = load
+	}
+}
+
+// WithThemePacks adds the packs' themes and aliases on every Reload. A pack
+// may not reuse the name or overlap the period of a theme in the themes
+// config, since only one theme is active at a time.
+func WithThemePacks(packs map[string]ThemePack) Option {
+	return func(r *resolver) {
+		r.packs = packs
+	}
 }
 
 // NewResolver creates a new naming resolver
-func NewResolver(aliasesPath, themesPath string) (Resolver, error) {
+func NewResolver(aliasesPath, themesPath string, opts ...Option) (Resolver, error) {
 	r := &resolver{
 		publicToInternal: make(map[string]string),
 		internalToPublic: make(map[string]string),
@@ -76,6 +193,9 @@ func NewResolver(aliasesPath, themesPath string) (Resolver, error) {
 		aliasesPath:      aliasesPath,
 		themesPath:       themesPath,
 	}
+	for _, opt := range opts {
+		opt(r)
+	}
 
 	if err := r.Reload(); err != nil {
 		return nil, err
@@ -115,38 +235,88 @@ func (r *resolver) ResolveInternalName(internalName string) (string, bool) {
 
 // GetDisplayName generates a display name with optional quality prefix
 func (r *resolver) GetDisplayName(internalName string, qualityLevel domain.QualityLevel) string {
-	r.mu.RLock()
-	defer r.mu.RUnlock()
-
-	// Get alias pool for this item
-	pool, ok := r.aliases[internalName]
-	if !ok || (len(pool.Default) == 0 && len(pool.Themes) == 0) {
+	names, ok := r.currentTable().names[internalName]
+	if !ok {
 		// No alias pool, return internal name with quality
 		return r.formatWithQuality(internalName, qualityLevel)
 	}
 
-	// Check for active theme
-	activeTheme := r.getActiveThemeUnlocked()
-	var aliases []string
+	// Random selection from alias pool
+	aliases := names.forQuality(qualityLevel)
+	return aliases[utils.RandomInt(0, len(aliases)-1)]
+}
 
-	if activeTheme != "" {
-		if themeAliases, exists := pool.Themes[activeTheme]; exists && len(themeAliases) > 0 {
-			aliases = themeAliases
-		}
+// currentTable returns the display table for today, rebuilding it if the
+// config was reloaded or the day changed
+func (r *resolver) currentTable() *displayTable {
+	today := dayKey(r.clock())
+	if t := r.table.Load(); t != nil && t.day == today {
+		return t
 	}
 
-	// Fall back to default aliases
-	if len(aliases) == 0 {
-		aliases = pool.Default
+	r.tableMu.Lock()
+	defer r.tableMu.Unlock()
+	old := r.table.Load()
+	if old != nil && old.day == today {
+		return old
 	}
 
-	if len(aliases) == 0 {
-		return r.formatWithQuality(internalName, qualityLevel)
+	r.mu.RLock()
+	theme := r.getActiveThemeUnlocked()
+	var t *displayTable
+	if old != nil && old.theme == theme {
+		// Same theme on a new day; the names are unchanged
+		t = &displayTable{theme: theme, day: today, names: old.names}
+	} else {
+		t = &displayTable{theme: theme, day: today, names: r.buildDisplayNames(theme)}
 	}
+	r.mu.RUnlock()
 
-	// Random selection from alias pool
-	alias := aliases[utils.RandomInt(0, len(aliases)-1)]
-	return r.formatWithQuality(alias, qualityLevel)
+	r.table.Store(t)
+	return t
+}
+
+// buildDisplayNames picks each item's aliases for the theme, falling back to
+// the defaults, and formats them once per quality (caller must hold lock)
+func (r *resolver) buildDisplayNames(theme string) map[string]displayNames {
+	names := make(map[string]displayNames, len(r.aliases))
+	for internalName, pool := range r.aliases {
+		var aliases []string
+		if theme != "" {
+			aliases = pool.Themes[theme]
+		}
+		if len(aliases) == 0 {
+			aliases = pool.Default
+		}
+		if len(aliases) == 0 {
+			continue
+		}
+
+		n := displayNames{
+			plain:     make([]string, len(aliases)),
+			cursed:    make([]string, len(aliases)),
+			legendary: make([]string, len(aliases)),
+		}
+		for i, alias := range aliases {
+			n.plain[i] = r.formatWithQuality(alias, domain.QualityCommon)
+			n.cursed[i] = r.formatWithQuality(alias, domain.QualityCursed)
+			n.legendary[i] = r.formatWithQuality(alias, domain.QualityLegendary)
+		}
+		names[internalName] = n
+	}
+	return names
+}
+
+func (r *resolver) clock() time.Time {
+	if r.now != nil {
+		return r.now()
+	}
+	return time.Now()
+}
+
+// dayKey returns a comparable month*100+day value for a date
+func dayKey(t time.Time) int {
+	return int(t.Month())*DateComparisonMultiplier + t.Day()
 }
 
 // formatWithQuality adds quality level prefix if not COMMON
@@ -164,14 +334,12 @@ func (r *resolver) formatWithQuality(name string, qualityLevel domain.QualityLev
 
 // GetActiveTheme returns the currently active theme
 func (r *resolver) GetActiveTheme() string {
-	r.mu.RLock()
-	defer r.mu.RUnlock()
-	return r.getActiveThemeUnlocked()
+	return r.currentTable().theme
 }
 
 // getActiveThemeUnlocked returns active theme (caller must hold lock)
 func (r *resolver) getActiveThemeUnlocked() string {
-	now := time.Now()
+	now := r.clock()
 	for theme, period := range r.themes {
 		if isInPeriod(now, period.Start, period.End) {
 			return theme
@@ -216,28 +384,126 @@ func parseMonthDay(s string) (month, day int) {
 	return
 }
 
-// Reload reloads the alias and theme configurations
+// Reload reloads the alias and theme configurations and the alias overrides.
+// Everything is loaded before anything is swapped in, so a bad edit leaves the previous config active.
 func (r *resolver) Reload() error {
-	r.mu.Lock()
-	defer r.mu.Unlock()
-
-	// Load aliases
+	var aliases map[string]AliasPool
 	if r.aliasesPath != "" {
-		if err := r.loadAliases(); err != nil {
+		loaded, err := r.loadAliases()
+		if err != nil {
 			return fmt.Errorf("%s: %w", ErrContextFailedToLoadAliases, err)
 		}
+		aliases = loaded
 	}
 
-	// Load themes
+	var themes map[string]ThemePeriod
 	if r.themesPath != "" {
-		if err := r.loadThemes(); err != nil {
+		loaded, err := r.loadThemes()
+		if err != nil {
 			return fmt.Errorf("%s: %w", ErrContextFailedToLoadThemes, err)
 		}
+		themes = loaded
+	}
+
+	themes, err := r.addPackThemes(themes)
+	if err != nil {
+		return err
+	}
+
+	var overrides map[string]AliasPool
+	if r.loadOverrides != nil {
+		ctx, cancel := context.WithTimeout(context.Background(), AliasOverrideLoadTimeout)
+		loaded, err := r.loadOverrides(ctx)
+		cancel()
+		if err != nil {
+			return fmt.Errorf("%s: %w", ErrContextFailedToLoadOverrides, err)
+		}
+		overrides = loaded
+		if overrides == nil {
+			overrides = map[string]AliasPool{}
+		}
+	}
+
+	r.mu.Lock()
+	if aliases != nil {
+		r.fileAliases = aliases
+	}
+	if aliases != nil || overrides != nil || len(r.packs) > 0 {
+		r.aliases = r.addPackAliases(mergeAliases(r.fileAliases, overrides))
 	}
+	if themes != nil {
+		r.themes = themes
+	}
+	r.mu.Unlock()
+
+	// Display names are rebuilt from the new config on the next lookup
+	r.tableMu.Lock()
+	r.table.Store(nil)
+	r.themeNames = nil
+	r.tableMu.Unlock()
 
 	return nil
 }
 
+// mergeAliases returns the file's pools with each override replacing the
+// pool of its item
+func mergeAliases(file, overrides map[string]AliasPool) map[string]AliasPool {
+	merged := make(map[string]AliasPool, len(file)+len(overrides))
+	for name, pool := range file {
+		merged[name] = pool
+	}
+	for name, pool := range overrides {
+		merged[name] = pool
+	}
+	return merged
+}
+
+// addPackThemes returns the loaded themes with the content pack themes added.
+// With no themes config the pack themes alone replace the previous ones.
+func (r *resolver) addPackThemes(themes map[string]ThemePeriod) (map[string]ThemePeriod, error) {
+	if len(r.packs) == 0 {
+		return themes, nil
+	}
+
+	merged := make(map[string]ThemePeriod, len(themes)+len(r.packs))
+	for name, period := range themes {
+		merged[name] = period
+	}
+	for name, pack := range r.packs {
+		if _, ok := themes[name]; ok {
+			return nil, fmt.Errorf(ErrMsgPackThemeExists, name)
+		}
+		for other, period := range themes {
+			if pack.Period.Overlaps(period) {
+				return nil, fmt.Errorf(ErrMsgPackThemeOverlaps, name, other)
+			}
+		}
+		merged[name] = pack.Period
+	}
+	return merged, nil
+}
+
+// addPackAliases adds each pack's aliases as its theme's variants, and as the
+// defaults of items that have no pool (caller must hold lock)
+func (r *resolver) addPackAliases(aliases map[string]AliasPool) map[string]AliasPool {
+	for theme, pack := range r.packs {
+		for internalName, names := range pack.Aliases {
+			pool := aliases[internalName]
+			themed := make(map[string][]string, len(pool.Themes)+1)
+			for t, n := range pool.Themes {
+				themed[t] = n
+			}
+			themed[theme] = names
+			pool.Themes = themed
+			if len(pool.Default) == 0 {
+				pool.Default = names
+			}
+			aliases[internalName] = pool
+		}
+	}
+	return aliases
+}
+
 func (r *resolver) loadVersionedConfig(path string, target interface{}, schema string) error {
 	data, err := os.ReadFile(path)
 	if err != nil {
@@ -271,28 +537,22 @@ func (r *resolver) loadVersionedConfig(path string, target interface{}, schema s
 	return nil
 }
 
-func (r *resolver) loadAliases() error {
+func (r *resolver) loadAliases() (map[string]AliasPool, error) {
 	var config struct {
 		Aliases map[string]AliasPool `json:"aliases"`
 	}
 	if err := r.loadVersionedConfig(r.aliasesPath, &config, SchemaItemAliases); err != nil {
-		return err
-	}
-	if config.Aliases != nil {
-		r.aliases = config.Aliases
+		return nil, err
 	}
-	return nil
+	return config.Aliases, nil
 }
 
-func (r *resolver) loadThemes() error {
+func (r *resolver) loadThemes() (map[string]ThemePeriod, error) {
 	var config struct {
 		Themes map[string]ThemePeriod `json:"themes"`
 	}
 	if err := r.loadVersionedConfig(r.themesPath, &config, SchemaItemThemes); err != nil {
-		return err
-	}
-	if config.Themes != nil {
-		r.themes = config.Themes
+		return nil, err
 	}
-	return nil
+	return config.Themes, nil
 }
@@ -0,0 +1,89 @@
+package naming
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/utils"
+)
+
+// benchInventorySize is a large inventory render: every slot needs a display name
+const benchInventorySize = 500
+
+// perCallDisplayName is GetDisplayName without the precomputed table: it
+// finds the active theme, picks the alias pool and formats the name on every
+// call. It is kept as the baseline the table is measured against.
+func perCallDisplayName(r *resolver, internalName string, qualityLevel domain.QualityLevel) string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	pool, ok := r.aliases[internalName]
+	if !ok || (len(pool.Default) == 0 && len(pool.Themes) == 0) {
+		return r.formatWithQuality(internalName, qualityLevel)
+	}
+
+	var aliases []string
+	if activeTheme := r.getActiveThemeUnlocked(); activeTheme != "" {
+		aliases = pool.Themes[activeTheme]
+	}
+	if len(aliases) == 0 {
+		aliases = pool.Default
+	}
+	if len(aliases) == 0 {
+		return r.formatWithQuality(internalName, qualityLevel)
+	}
+
+	return r.formatWithQuality(aliases[utils.RandomInt(0, len(aliases)-1)], qualityLevel)
+}
+
+func newBenchResolver(b *testing.B) (*resolver, []domain.InventorySlot, []string) {
+	b.Helper()
+	res, err := NewResolver("../../configs/items/aliases.json", "../../configs/items/themes.json")
+	if err != nil {
+		b.Fatalf("failed to load naming configs: %v", err)
+	}
+	r := res.(*resolver)
+
+	items := make([]string, 0, len(r.aliases))
+	for name := range r.aliases {
+		items = append(items, name)
+	}
+	sort.Strings(items)
+	if len(items) == 0 {
+		b.Skip("no aliases configured")
+	}
+
+	qualities := []domain.QualityLevel{domain.QualityCommon, domain.QualityRare, domain.QualityCursed, domain.QualityLegendary}
+	slots := make([]domain.InventorySlot, benchInventorySize)
+	for i := range slots {
+		slots[i] = domain.InventorySlot{ItemID: i, QualityLevel: qualities[i%len(qualities)]}
+	}
+	return r, slots, items
+}
+
+// BenchmarkGetDisplayName_LargeInventory renders display names for a large
+// inventory with and without the precomputed table.
+//
+// Run with: go test -bench=LargeInventory -benchmem ./internal/naming/
+func BenchmarkGetDisplayName_LargeInventory(b *testing.B) {
+	r, slots, items := newBenchResolver(b)
+
+	b.Run("Precomputed", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			for j, slot := range slots {
+				_ = r.GetDisplayName(items[j%len(items)], slot.QualityLevel)
+			}
+		}
+	})
+
+	b.Run("PerCall", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			for j, slot := range slots {
+				_ = perCallDisplayName(r, items[j%len(items)], slot.QualityLevel)
+			}
+		}
+	})
+}
@@ -1,6 +1,9 @@
 package naming
 
 import (
+	"context"
+	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
@@ -128,6 +131,94 @@ func TestGetActiveTheme(t *testing.T) {
 	_ = theme // Result depends on current date
 }
 
+func TestGetDisplayName_ThemeSwitch(t *testing.T) {
+	now := time.Date(2025, time.October, 1, 12, 0, 0, 0, time.UTC)
+	r := &resolver{
+		aliases: map[string]AliasPool{
+			"lootbox_tier0": {
+				Default: []string{"Default Box"},
+				Themes:  map[string][]string{"halloween": {"Spooky Box"}},
+			},
+		},
+		themes: map[string]ThemePeriod{
+			"halloween": {Start: "10-15", End: "11-02"},
+		},
+		now: func() time.Time { return now },
+	}
+
+	assert.Equal(t, "Default Box", r.GetDisplayName("lootbox_tier0", domain.QualityCommon))
+	assert.Equal(t, "", r.GetActiveTheme())
+
+	// The precomputed names follow the date into the theme period
+	now = time.Date(2025, time.October, 20, 12, 0, 0, 0, time.UTC)
+	assert.Equal(t, "Spooky Box", r.GetDisplayName("lootbox_tier0", domain.QualityCommon))
+	assert.Equal(t, "halloween", r.GetActiveTheme())
+
+	now = time.Date(2025, time.November, 5, 12, 0, 0, 0, time.UTC)
+	assert.Equal(t, "Default Box", r.GetDisplayName("lootbox_tier0", domain.QualityCommon))
+}
+
+func TestGetDisplayNameForTheme(t *testing.T) {
+	r := &resolver{
+		aliases: map[string]AliasPool{
+			"lootbox_tier0": {
+				Default: []string{"Default Box"},
+				Themes:  map[string][]string{"halloween": {"Spooky Box"}},
+			},
+			"item_stick": {Default: []string{"Plain Stick"}},
+		},
+		themes: map[string]ThemePeriod{
+			"halloween": {Start: "10-15", End: "11-02"},
+		},
+		now: func() time.Time { return time.Date(2025, time.March, 1, 12, 0, 0, 0, time.UTC) },
+	}
+
+	// A picked theme applies outside its period
+	assert.Equal(t, "Spooky Box", r.GetDisplayNameForTheme("lootbox_tier0", domain.QualityCommon, "halloween"))
+	assert.Equal(t, "Spooky Box👑", r.GetDisplayNameForTheme("lootbox_tier0", domain.QualityLegendary, "halloween"))
+	// Items the theme does not rename keep their defaults
+	assert.Equal(t, "Plain Stick", r.GetDisplayNameForTheme("item_stick", domain.QualityCommon, "halloween"))
+	// No pick or an unknown one follows the calendar
+	assert.Equal(t, "Default Box", r.GetDisplayNameForTheme("lootbox_tier0", domain.QualityCommon, ""))
+	assert.Equal(t, "Default Box", r.GetDisplayNameForTheme("lootbox_tier0", domain.QualityCommon, "easter"))
+}
+
+func TestWithTheme(t *testing.T) {
+	ctx := context.Background()
+	assert.Equal(t, "", ThemeFromContext(ctx))
+	assert.Equal(t, "halloween", ThemeFromContext(WithTheme(ctx, "halloween")))
+}
+
+func TestGetDisplayName_QualityFormatting(t *testing.T) {
+	r := &resolver{
+		aliases: map[string]AliasPool{
+			"lootbox_tier0": {Default: []string{"Default Box"}},
+		},
+	}
+
+	assert.Equal(t, "Default Box", r.GetDisplayName("lootbox_tier0", domain.QualityRare))
+	assert.Equal(t, "Default Box👻", r.GetDisplayName("lootbox_tier0", domain.QualityCursed))
+	assert.Equal(t, "Default Box👑", r.GetDisplayName("lootbox_tier0", domain.QualityLegendary))
+	assert.Equal(t, "unknown_item👑", r.GetDisplayName("unknown_item", domain.QualityLegendary))
+}
+
+func TestGetDisplayName_ReloadRebuildsNames(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "aliases.json")
+	write := func(alias string) {
+		data := `{"version":"1.0","schema":"item-aliases","aliases":{"lootbox_tier0":{"default":["` + alias + `"]}}}`
+		require.NoError(t, os.WriteFile(path, []byte(data), 0o600))
+	}
+
+	write("Old Box")
+	r := &resolver{aliasesPath: path}
+	require.NoError(t, r.Reload())
+	assert.Equal(t, "Old Box", r.GetDisplayName("lootbox_tier0", domain.QualityCommon))
+
+	write("New Box")
+	require.NoError(t, r.Reload())
+	assert.Equal(t, "New Box", r.GetDisplayName("lootbox_tier0", domain.QualityCommon))
+}
+
 func TestRegisterItem(t *testing.T) {
 	r := &resolver{
 		publicToInternal: make(map[string]string),
@@ -157,7 +248,7 @@ func TestLoadAliases_ValidJSON(t *testing.T) {
 		aliases:     make(map[string]AliasPool),
 	}
 
-	err := r.loadAliases()
+	err := r.Reload()
 	require.NoError(t, err)
 
 	// Verify loaded correctly
@@ -174,7 +265,7 @@ func TestLoadAliases_MalformedJSON(t *testing.T) {
 		aliases:     make(map[string]AliasPool),
 	}
 
-	err := r.loadAliases()
+	err := r.Reload()
 	assert.Error(t, err, "Should fail on malformed JSON")
 }
 
@@ -185,7 +276,7 @@ func TestLoadAliases_MissingDefault(t *testing.T) {
 		aliases:     make(map[string]AliasPool),
 	}
 
-	err := r.loadAliases()
+	err := r.Reload()
 	assert.NoError(t, err, "Should handle missing default gracefully")
 
 	pool, ok := r.aliases["lootbox_tier0"]
@@ -201,7 +292,7 @@ func TestLoadAliases_EmptyDefault(t *testing.T) {
 		aliases:     make(map[string]AliasPool),
 	}
 
-	err := r.loadAliases()
+	err := r.Reload()
 	assert.NoError(t, err, "Empty default is valid")
 
 	pool, ok := r.aliases["lootbox_tier0"]
@@ -216,7 +307,7 @@ func TestLoadAliases_FileNotExist(t *testing.T) {
 		aliases:     make(map[string]AliasPool),
 	}
 
-	err := r.loadAliases()
+	err := r.Reload()
 	assert.NoError(t, err, "Should handle missing file gracefully")
 	assert.Len(t, r.aliases, 0, "Aliases should be empty")
 }
@@ -227,7 +318,7 @@ func TestLoadThemes_ValidJSON(t *testing.T) {
 		themes:     make(map[string]ThemePeriod),
 	}
 
-	err := r.loadThemes()
+	err := r.Reload()
 	require.NoError(t, err)
 
 	period, ok := r.themes["test_theme"]
@@ -244,7 +335,7 @@ func TestLoadThemes_InvalidDates(t *testing.T) {
 		themes:     make(map[string]ThemePeriod),
 	}
 
-	err := r.loadThemes()
+	err := r.Reload()
 	assert.NoError(t, err, "Invalid dates still valid JSON")
 
 	period, ok := r.themes["bad_theme"]
@@ -253,6 +344,61 @@ func TestLoadThemes_InvalidDates(t *testing.T) {
 	assert.Equal(t, "October 15", period.Start)
 }
 
+func TestReload_InvalidThemesKeepsPreviousConfig(t *testing.T) {
+	previous := map[string]AliasPool{"keep_me": {Default: []string{"old name"}}}
+	r := &resolver{
+		aliasesPath: "testdata/valid_aliases.json",
+		themesPath:  "testdata/malformed.json",
+		aliases:     previous,
+		themes:      make(map[string]ThemePeriod),
+	}
+
+	err := r.Reload()
+	assert.Error(t, err, "Should fail on malformed themes")
+
+	_, ok := r.aliases["keep_me"]
+	assert.True(t, ok, "Aliases should not be swapped when themes fail to load")
+	_, ok = r.aliases["lootbox_tier0"]
+	assert.False(t, ok)
+}
+
+func TestReload_ThemePacks(t *testing.T) {
+	spring := ThemePack{
+		Period:  ThemePeriod{Start: "03-20", End: "04-10"},
+		Aliases: map[string][]string{"lootbox_tier0": {"flower basket"}, "item_spring_egg": {"painted egg"}},
+	}
+
+	t.Run("adds the pack theme and its aliases", func(t *testing.T) {
+		r, err := NewResolver("testdata/valid_aliases.json", "testdata/valid_themes.json",
+			WithThemePacks(map[string]ThemePack{"spring": spring}))
+		require.NoError(t, err)
+		res := r.(*resolver)
+		res.now = func() time.Time { return time.Date(2026, 3, 25, 12, 0, 0, 0, time.UTC) }
+
+		assert.Equal(t, "spring", r.GetActiveTheme())
+		assert.Contains(t, r.GetThemes(), "test_theme")
+		assert.Equal(t, "flower basket", r.GetDisplayName("lootbox_tier0", domain.QualityCommon))
+		assert.Equal(t, "painted egg", r.GetDisplayNameForTheme("item_spring_egg", domain.QualityCommon, "test_theme"),
+			"pack items keep their names outside the season")
+	})
+
+	t.Run("rejects a pack overlapping a configured theme", func(t *testing.T) {
+		halloween := ThemePack{Period: ThemePeriod{Start: "10-30", End: "11-05"}}
+
+		_, err := NewResolver("testdata/valid_aliases.json", "testdata/valid_themes.json",
+			WithThemePacks(map[string]ThemePack{"late_harvest": halloween}))
+
+		assert.ErrorContains(t, err, "overlaps theme 'test_theme'")
+	})
+}
+
+func TestThemePeriod_Overlaps(t *testing.T) {
+	winter := ThemePeriod{Start: "12-15", End: "01-05"}
+
+	assert.True(t, winter.Overlaps(ThemePeriod{Start: "01-05", End: "01-20"}))
+	assert.False(t, winter.Overlaps(ThemePeriod{Start: "01-06", End: "12-14"}))
+}
+
 func TestParseMonthDay_EdgeCases(t *testing.T) {
 	tests := []struct {
 		name      string
@@ -310,6 +456,35 @@ func TestReload_BothValid(t *testing.T) {
 	assert.NotEmpty(t, r.themes, "Themes should load")
 }
 
+func TestReload_AliasOverrides(t *testing.T) {
+	overrides := map[string]AliasPool{"lootbox_tier0": {Default: []string{"Community Box"}}}
+	r, err := NewResolver("testdata/valid_aliases.json", "", WithAliasOverrides(func(context.Context) (map[string]AliasPool, error) {
+		return overrides, nil
+	}))
+	require.NoError(t, err)
+	assert.Equal(t, "Community Box", r.GetDisplayName("lootbox_tier0", domain.QualityCommon))
+
+	// Dropping the override brings the file's pool back
+	overrides = nil
+	require.NoError(t, r.Reload())
+	assert.Contains(t, []string{"A test box", "Another test box"}, r.GetDisplayName("lootbox_tier0", domain.QualityCommon))
+}
+
+func TestReload_AliasOverridesFailureKeepsPreviousConfig(t *testing.T) {
+	fail := false
+	r, err := NewResolver("testdata/valid_aliases.json", "", WithAliasOverrides(func(context.Context) (map[string]AliasPool, error) {
+		if fail {
+			return nil, assert.AnError
+		}
+		return map[string]AliasPool{"lootbox_tier0": {Default: []string{"Community Box"}}}, nil
+	}))
+	require.NoError(t, err)
+
+	fail = true
+	assert.ErrorIs(t, r.Reload(), assert.AnError)
+	assert.Equal(t, "Community Box", r.GetDisplayName("lootbox_tier0", domain.QualityCommon))
+}
+
 func TestReload_EmptyPaths(t *testing.T) {
 	// Empty paths should be handled gracefully
 	r := &resolver{
@@ -0,0 +1,75 @@
+package naming
+
+import (
+	"context"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/utils"
+)
+
+type themeContextKey struct{}
+
+// WithTheme returns a context carrying the theme a user picked for item names
+func WithTheme(ctx context.Context, theme string) context.Context {
+	return context.WithValue(ctx, themeContextKey{}, theme)
+}
+
+// ThemeFromContext returns the theme set by WithTheme, or "" for the dated one
+func ThemeFromContext(ctx context.Context) string {
+	theme, _ := ctx.Value(themeContextKey{}).(string)
+	return theme
+}
+
+// GetDisplayNameForTheme generates a display name from the theme's aliases,
+// falling back to the defaults for items the theme does not rename
+func (r *resolver) GetDisplayNameForTheme(internalName string, qualityLevel domain.QualityLevel, theme string) string {
+	names := r.themeDisplayNames(theme)
+	if names == nil {
+		return r.GetDisplayName(internalName, qualityLevel)
+	}
+
+	n, ok := names[internalName]
+	if !ok {
+		return r.formatWithQuality(internalName, qualityLevel)
+	}
+	aliases := n.forQuality(qualityLevel)
+	return aliases[utils.RandomInt(0, len(aliases)-1)]
+}
+
+// themeDisplayNames returns the display names for a configured theme, or nil
+// when the theme is empty or unknown
+func (r *resolver) themeDisplayNames(theme string) map[string]displayNames {
+	if theme == "" {
+		return nil
+	}
+
+	r.tableMu.Lock()
+	defer r.tableMu.Unlock()
+	if names, ok := r.themeNames[theme]; ok {
+		return names
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	if _, ok := r.themes[theme]; !ok {
+		return nil
+	}
+	if r.themeNames == nil {
+		r.themeNames = make(map[string]map[string]displayNames)
+	}
+	names := r.buildDisplayNames(theme)
+	r.themeNames[theme] = names
+	return names
+}
+
+// GetThemes returns a copy of every configured theme
+func (r *resolver) GetThemes() map[string]ThemePeriod {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	themes := make(map[string]ThemePeriod, len(r.themes))
+	for name, period := range r.themes {
+		themes[name] = period
+	}
+	return themes
+}
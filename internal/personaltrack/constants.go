@@ -0,0 +1,36 @@
+package personaltrack
+
+import "github.com/osse101/BrandishBot_Go/internal/domain"
+
+// Metadata keys stored with a milestone unlock
+const (
+	MetadataKeyTrack  = "track"
+	MetadataKeyMetric = "metric"
+	MetadataKeyValue  = "value"
+)
+
+// trackableMetrics are the engagement metrics a milestone can count
+var trackableMetrics = map[string]bool{
+	domain.MetricTypeMessage:                true,
+	domain.MetricTypeCommand:                true,
+	domain.MetricTypeItemSold:               true,
+	domain.MetricTypeItemBought:             true,
+	domain.MetricTypeItemCrafted:            true,
+	domain.MetricTypeItemUsed:               true,
+	domain.MetricTypeGambleStarted:          true,
+	domain.MetricTypeGambleJoined:           true,
+	domain.MetricTypeExpeditionCompleted:    true,
+	domain.MetricTypeVoteCast:               true,
+	domain.MetricTypeSearch:                 true,
+	domain.MetricTypePredictionContribution: true,
+	domain.MetricTypeSlotsSpin:              true,
+	domain.MetricTypeSlotsWin:               true,
+	domain.MetricTypeSlotsBigWin:            true,
+	domain.MetricTypeSlotsJackpot:           true,
+}
+
+// Log messages
+const (
+	LogMsgMilestoneUnlocked    = "Personal milestone unlocked"
+	LogMsgMilestoneCheckFailed = "Failed to check personal milestones"
+)
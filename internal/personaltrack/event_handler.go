@@ -0,0 +1,44 @@
+package personaltrack
+
+import (
+	"context"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/event"
+	"github.com/osse101/BrandishBot_Go/internal/logger"
+)
+
+// EventHandler unlocks personal milestones as users engage
+type EventHandler struct {
+	service Service
+}
+
+// NewEventHandler creates a new personal track event handler
+func NewEventHandler(service Service) *EventHandler {
+	return &EventHandler{service: service}
+}
+
+// Register subscribes the handler to engagement events
+func (h *EventHandler) Register(bus event.Bus) {
+	bus.Subscribe(event.Type(domain.EventTypeEngagement), h.HandleEngagement)
+}
+
+// HandleEngagement checks the user's milestones for the recorded metric.
+// Failures are logged rather than retried, since the next engagement checks
+// the same milestones again.
+func (h *EventHandler) HandleEngagement(ctx context.Context, evt event.Event) error {
+	metric, err := event.DecodePayload[domain.EngagementMetric](evt.Payload)
+	if err != nil || metric.UserID == "" {
+		return nil
+	}
+
+	// Only count engagement once the progression service has recorded it
+	if recorded, ok := evt.GetMetadataValue(domain.MetadataKeyRecorded).(bool); !ok || !recorded {
+		return nil
+	}
+
+	if _, err := h.service.CheckMilestones(ctx, metric.UserID, metric.MetricType); err != nil {
+		logger.FromContext(ctx).Warn(LogMsgMilestoneCheckFailed, "error", err, "user_id", metric.UserID)
+	}
+	return nil
+}
@@ -0,0 +1,20 @@
+package personaltrack
+
+import (
+	"context"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+)
+
+// Repository stores personal milestone unlocks in user_progression
+type Repository interface {
+	// GetMetricTotals returns the user's all-time total for each engagement metric
+	GetMetricTotals(ctx context.Context, userID string) (map[string]int64, error)
+
+	// GetMilestones returns the milestones the user has unlocked
+	GetMilestones(ctx context.Context, userID string) ([]*domain.UserProgression, error)
+
+	// ClaimMilestone records a milestone unlock and reports false when the
+	// user had already unlocked it
+	ClaimMilestone(ctx context.Context, userID, key string, metadata map[string]interface{}) (bool, error)
+}
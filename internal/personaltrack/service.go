@@ -0,0 +1,179 @@
+package personaltrack
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/event"
+	"github.com/osse101/BrandishBot_Go/internal/logger"
+)
+
+// Service tracks each user's progress along the personal progression tracks.
+// Milestones unlock from the user's own engagement, alongside the community
+// tree.
+type Service interface {
+	// GetProgress returns the user's progress along every personal track
+	GetProgress(ctx context.Context, platform, platformID string) ([]domain.PersonalTrack, error)
+
+	// CheckMilestones unlocks the milestones counting metric that the user's
+	// totals have reached, publishes an event for each and returns them
+	CheckMilestones(ctx context.Context, userID, metric string) ([]domain.PersonalMilestone, error)
+}
+
+// UserLookup finds users by platform ID
+type UserLookup interface {
+	GetUserByPlatformID(ctx context.Context, platform, platformID string) (*domain.User, error)
+}
+
+// Publisher publishes milestone events
+type Publisher interface {
+	PublishWithRetry(ctx context.Context, evt event.Event)
+}
+
+type service struct {
+	repo      Repository
+	users     UserLookup
+	tracks    *TrackTable
+	publisher Publisher
+}
+
+// NewService creates a personal track service
+func NewService(repo Repository, users UserLookup, tracks *TrackTable, publisher Publisher) Service {
+	return &service{
+		repo:      repo,
+		users:     users,
+		tracks:    tracks,
+		publisher: publisher,
+	}
+}
+
+// GetProgress builds each track from the user's metric totals and unlocks.
+// Unlocks of milestones no longer in the config are left out.
+func (s *service) GetProgress(ctx context.Context, platform, platformID string) ([]domain.PersonalTrack, error) {
+	user, err := s.users.GetUserByPlatformID(ctx, platform, platformID)
+	if err != nil {
+		return nil, err
+	}
+
+	totals, err := s.repo.GetMetricTotals(ctx, user.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get metric totals: %w", err)
+	}
+	unlocked, err := s.unlockedMilestones(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	tracks := s.tracks.Tracks()
+	result := make([]domain.PersonalTrack, 0, len(tracks))
+	for _, track := range tracks {
+		progress := toDomainTrack(track)
+		for i := range progress.Milestones {
+			milestone := &progress.Milestones[i]
+			milestone.Progress = min(totals[milestone.Metric], milestone.Threshold)
+			if unlock, ok := unlocked[milestone.Key]; ok {
+				milestone.Unlocked = true
+				milestone.UnlockedAt = &unlock.UnlockedAt
+				milestone.Progress = milestone.Threshold
+			}
+		}
+		result = append(result, progress)
+	}
+	return result, nil
+}
+
+// CheckMilestones only reads the user's totals when a track counts metric, so
+// engagement no track uses costs nothing
+func (s *service) CheckMilestones(ctx context.Context, userID, metric string) ([]domain.PersonalMilestone, error) {
+	var candidates []int
+	tracks := s.tracks.Tracks()
+	for i, track := range tracks {
+		for _, milestone := range track.Milestones {
+			if milestone.Metric == metric {
+				candidates = append(candidates, i)
+				break
+			}
+		}
+	}
+	if len(candidates) == 0 {
+		return nil, nil
+	}
+
+	totals, err := s.repo.GetMetricTotals(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get metric totals: %w", err)
+	}
+	total := totals[metric]
+
+	var unlocked map[string]*domain.UserProgression
+	var newlyUnlocked []domain.PersonalMilestone
+	for _, i := range candidates {
+		track := toDomainTrack(tracks[i])
+		for _, milestone := range track.Milestones {
+			if milestone.Metric != metric || total < milestone.Threshold {
+				continue
+			}
+			// Only look up unlocks once a milestone is reached
+			if unlocked == nil {
+				if unlocked, err = s.unlockedMilestones(ctx, userID); err != nil {
+					return nil, err
+				}
+			}
+			if _, ok := unlocked[milestone.Key]; ok {
+				continue
+			}
+
+			claimed, err := s.repo.ClaimMilestone(ctx, userID, milestone.Key, map[string]interface{}{
+				MetadataKeyTrack:  track.Key,
+				MetadataKeyMetric: metric,
+				MetadataKeyValue:  total,
+			})
+			if err != nil {
+				return nil, fmt.Errorf("failed to unlock milestone %q: %w", milestone.Key, err)
+			}
+			if !claimed {
+				continue
+			}
+
+			milestone.Progress = milestone.Threshold
+			milestone.Unlocked = true
+			newlyUnlocked = append(newlyUnlocked, milestone)
+			logger.FromContext(ctx).Info(LogMsgMilestoneUnlocked, "user_id", userID, "track", track.Key, "milestone", milestone.Key)
+			s.publisher.PublishWithRetry(ctx, event.NewPersonalMilestoneUnlockedEvent(userID, track, milestone))
+		}
+	}
+	return newlyUnlocked, nil
+}
+
+// unlockedMilestones returns the user's milestone unlocks by key
+func (s *service) unlockedMilestones(ctx context.Context, userID string) (map[string]*domain.UserProgression, error) {
+	unlocks, err := s.repo.GetMilestones(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get unlocked milestones: %w", err)
+	}
+	byKey := make(map[string]*domain.UserProgression, len(unlocks))
+	for _, unlock := range unlocks {
+		byKey[unlock.ProgressionKey] = unlock
+	}
+	return byKey, nil
+}
+
+func toDomainTrack(track Track) domain.PersonalTrack {
+	result := domain.PersonalTrack{
+		Key:         track.Key,
+		Name:        track.Name,
+		Description: track.Description,
+		Milestones:  make([]domain.PersonalMilestone, 0, len(track.Milestones)),
+	}
+	for _, milestone := range track.Milestones {
+		result.Milestones = append(result.Milestones, domain.PersonalMilestone{
+			Key:         milestone.Key,
+			Name:        milestone.Name,
+			Description: milestone.Description,
+			Metric:      milestone.Metric,
+			Threshold:   milestone.Threshold,
+		})
+	}
+	return result
+}
@@ -0,0 +1,209 @@
+package personaltrack_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/event"
+	"github.com/osse101/BrandishBot_Go/internal/personaltrack"
+	"github.com/osse101/BrandishBot_Go/internal/personaltrack/mocks"
+)
+
+const (
+	userID = "user-1"
+
+	testTracks = `{"tracks": [{"key": "chatter", "name": "Chatter", "milestones": [
+		{"key": "chatter_regular", "name": "Regular", "metric": "message", "threshold": 10},
+		{"key": "chatter_voice", "name": "Voice", "metric": "message", "threshold": 100}
+	]}]}`
+)
+
+func writeTracks(t *testing.T, data string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "personal_tracks.json")
+	require.NoError(t, os.WriteFile(path, []byte(data), 0o600))
+	return path
+}
+
+func newTrackTable(t *testing.T) *personaltrack.TrackTable {
+	t.Helper()
+	tracks, err := personaltrack.NewTrackTable(writeTracks(t, testTracks))
+	require.NoError(t, err)
+	return tracks
+}
+
+func TestCheckMilestones(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("unlocks reached milestones and publishes each", func(t *testing.T) {
+		mockRepo := mocks.NewMockRepository(t)
+		mockUsers := mocks.NewMockUserLookup(t)
+		mockPublisher := mocks.NewMockPublisher(t)
+		svc := personaltrack.NewService(mockRepo, mockUsers, newTrackTable(t), mockPublisher)
+
+		mockRepo.On("GetMetricTotals", mock.Anything, userID).Return(map[string]int64{domain.MetricTypeMessage: 12}, nil)
+		mockRepo.On("GetMilestones", mock.Anything, userID).Return(nil, nil)
+		mockRepo.On("ClaimMilestone", mock.Anything, userID, "chatter_regular", mock.Anything).Return(true, nil)
+		mockPublisher.On("PublishWithRetry", mock.Anything, mock.MatchedBy(func(evt event.Event) bool {
+			payload, ok := evt.Payload.(event.PersonalMilestoneUnlockedPayloadV1)
+			return evt.Type == event.PersonalMilestoneUnlocked && ok &&
+				payload.UserID == userID && payload.TrackKey == "chatter" && payload.MilestoneKey == "chatter_regular"
+		})).Once()
+
+		unlocked, err := svc.CheckMilestones(ctx, userID, domain.MetricTypeMessage)
+
+		require.NoError(t, err)
+		require.Len(t, unlocked, 1)
+		assert.Equal(t, "chatter_regular", unlocked[0].Key)
+	})
+
+	t.Run("skips milestones already unlocked", func(t *testing.T) {
+		mockRepo := mocks.NewMockRepository(t)
+		mockUsers := mocks.NewMockUserLookup(t)
+		mockPublisher := mocks.NewMockPublisher(t)
+		svc := personaltrack.NewService(mockRepo, mockUsers, newTrackTable(t), mockPublisher)
+
+		mockRepo.On("GetMetricTotals", mock.Anything, userID).Return(map[string]int64{domain.MetricTypeMessage: 12}, nil)
+		mockRepo.On("GetMilestones", mock.Anything, userID).Return([]*domain.UserProgression{
+			{UserID: userID, ProgressionType: domain.ProgressionTypeMilestone, ProgressionKey: "chatter_regular"},
+		}, nil)
+
+		unlocked, err := svc.CheckMilestones(ctx, userID, domain.MetricTypeMessage)
+
+		require.NoError(t, err)
+		assert.Empty(t, unlocked)
+	})
+
+	t.Run("a concurrent unlock is not published twice", func(t *testing.T) {
+		mockRepo := mocks.NewMockRepository(t)
+		mockUsers := mocks.NewMockUserLookup(t)
+		mockPublisher := mocks.NewMockPublisher(t)
+		svc := personaltrack.NewService(mockRepo, mockUsers, newTrackTable(t), mockPublisher)
+
+		mockRepo.On("GetMetricTotals", mock.Anything, userID).Return(map[string]int64{domain.MetricTypeMessage: 10}, nil)
+		mockRepo.On("GetMilestones", mock.Anything, userID).Return(nil, nil)
+		mockRepo.On("ClaimMilestone", mock.Anything, userID, "chatter_regular", mock.Anything).Return(false, nil)
+
+		unlocked, err := svc.CheckMilestones(ctx, userID, domain.MetricTypeMessage)
+
+		require.NoError(t, err)
+		assert.Empty(t, unlocked)
+	})
+
+	t.Run("metrics no track counts skip the lookups", func(t *testing.T) {
+		mockRepo := mocks.NewMockRepository(t)
+		mockUsers := mocks.NewMockUserLookup(t)
+		mockPublisher := mocks.NewMockPublisher(t)
+		svc := personaltrack.NewService(mockRepo, mockUsers, newTrackTable(t), mockPublisher)
+
+		unlocked, err := svc.CheckMilestones(ctx, userID, domain.MetricTypeSearch)
+
+		require.NoError(t, err)
+		assert.Empty(t, unlocked)
+	})
+}
+
+func TestGetProgress(t *testing.T) {
+	ctx := context.Background()
+	unlockedAt := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+
+	t.Run("reports progress and unlocks", func(t *testing.T) {
+		mockRepo := mocks.NewMockRepository(t)
+		mockUsers := mocks.NewMockUserLookup(t)
+		mockPublisher := mocks.NewMockPublisher(t)
+		svc := personaltrack.NewService(mockRepo, mockUsers, newTrackTable(t), mockPublisher)
+
+		mockUsers.On("GetUserByPlatformID", mock.Anything, domain.PlatformDiscord, "d-1").Return(&domain.User{ID: userID}, nil)
+		mockRepo.On("GetMetricTotals", mock.Anything, userID).Return(map[string]int64{domain.MetricTypeMessage: 40}, nil)
+		mockRepo.On("GetMilestones", mock.Anything, userID).Return([]*domain.UserProgression{
+			{ProgressionKey: "chatter_regular", UnlockedAt: unlockedAt},
+			{ProgressionKey: "retired_milestone", UnlockedAt: unlockedAt},
+		}, nil)
+
+		tracks, err := svc.GetProgress(ctx, domain.PlatformDiscord, "d-1")
+
+		require.NoError(t, err)
+		require.Len(t, tracks, 1)
+		require.Len(t, tracks[0].Milestones, 2)
+		regular, voice := tracks[0].Milestones[0], tracks[0].Milestones[1]
+		assert.True(t, regular.Unlocked)
+		assert.Equal(t, int64(10), regular.Progress)
+		assert.Equal(t, unlockedAt, *regular.UnlockedAt)
+		assert.False(t, voice.Unlocked)
+		assert.Equal(t, int64(40), voice.Progress)
+	})
+
+	t.Run("unknown user", func(t *testing.T) {
+		mockRepo := mocks.NewMockRepository(t)
+		mockUsers := mocks.NewMockUserLookup(t)
+		mockPublisher := mocks.NewMockPublisher(t)
+		svc := personaltrack.NewService(mockRepo, mockUsers, newTrackTable(t), mockPublisher)
+
+		mockUsers.On("GetUserByPlatformID", mock.Anything, domain.PlatformDiscord, "d-1").Return(nil, domain.ErrUserNotFound)
+
+		_, err := svc.GetProgress(ctx, domain.PlatformDiscord, "d-1")
+
+		assert.ErrorIs(t, err, domain.ErrUserNotFound)
+	})
+}
+
+func TestEventHandler_OnlyCountsRecordedEngagement(t *testing.T) {
+	ctx := context.Background()
+	mockRepo := mocks.NewMockRepository(t)
+	mockUsers := mocks.NewMockUserLookup(t)
+	mockPublisher := mocks.NewMockPublisher(t)
+	svc := personaltrack.NewService(mockRepo, mockUsers, newTrackTable(t), mockPublisher)
+
+	handler := personaltrack.NewEventHandler(svc)
+	metric := &domain.EngagementMetric{UserID: userID, MetricType: domain.MetricTypeMessage, MetricValue: 1}
+
+	// Not yet recorded: no lookups
+	require.NoError(t, handler.HandleEngagement(ctx, event.Event{Type: domain.EventTypeEngagement, Payload: metric}))
+
+	mockRepo.On("GetMetricTotals", mock.Anything, userID).Return(map[string]int64{domain.MetricTypeMessage: 1}, nil).Once()
+	err := handler.HandleEngagement(ctx, event.Event{
+		Type:     domain.EventTypeEngagement,
+		Payload:  metric,
+		Metadata: map[string]interface{}{domain.MetadataKeyRecorded: true},
+	})
+
+	assert.NoError(t, err)
+}
+
+func TestLoadTrackConfig_Invalid(t *testing.T) {
+	tests := []struct {
+		name string
+		json string
+	}{
+		{"unknown metric", `{"tracks": [{"key": "a", "name": "A", "milestones": [{"key": "m", "name": "M", "metric": "sleeping", "threshold": 1}]}]}`},
+		{"threshold below 1", `{"tracks": [{"key": "a", "name": "A", "milestones": [{"key": "m", "name": "M", "metric": "message", "threshold": 0}]}]}`},
+		{"milestone key reused across tracks", `{"tracks": [
+			{"key": "a", "name": "A", "milestones": [{"key": "m", "name": "M", "metric": "message", "threshold": 1}]},
+			{"key": "b", "name": "B", "milestones": [{"key": "m", "name": "M", "metric": "search", "threshold": 1}]}]}`},
+		{"duplicate track", `{"tracks": [{"key": "a", "name": "A"}, {"key": "a", "name": "B"}]}`},
+		{"missing name", `{"tracks": [{"key": "a"}]}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := personaltrack.LoadTrackConfig(writeTracks(t, tt.json))
+
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestTrackConfig_ShippedTracksAreValid(t *testing.T) {
+	table, err := personaltrack.NewTrackTable(filepath.Join("..", "..", "configs", "personal_tracks.json"))
+
+	require.NoError(t, err)
+	assert.NotEmpty(t, table.Tracks())
+}
@@ -0,0 +1,124 @@
+package personaltrack
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+)
+
+// Milestone is one step of a personal track in the config file
+type Milestone struct {
+	Key         string `json:"key"`
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	Metric      string `json:"metric"`
+	Threshold   int64  `json:"threshold"`
+}
+
+// Track is a personal track in the config file
+type Track struct {
+	Key         string      `json:"key"`
+	Name        string      `json:"name"`
+	Description string      `json:"description,omitempty"`
+	Milestones  []Milestone `json:"milestones"`
+}
+
+// TrackConfig is the top-level JSON structure for personal_tracks.json
+type TrackConfig struct {
+	Version string  `json:"version,omitempty"`
+	Tracks  []Track `json:"tracks"`
+}
+
+// Validate checks that tracks and milestones have unique keys and names, and
+// that each milestone counts a known metric. Milestone keys are unique across
+// all tracks since they are stored without their track.
+func (c TrackConfig) Validate() error {
+	trackKeys := make(map[string]bool, len(c.Tracks))
+	milestoneKeys := make(map[string]bool)
+	for i, track := range c.Tracks {
+		if track.Key == "" || track.Name == "" {
+			return fmt.Errorf("track %d: key and name are required", i)
+		}
+		if trackKeys[track.Key] {
+			return fmt.Errorf("track %q is defined twice", track.Key)
+		}
+		trackKeys[track.Key] = true
+
+		for j, milestone := range track.Milestones {
+			if milestone.Key == "" || milestone.Name == "" {
+				return fmt.Errorf("%s milestone %d: key and name are required", track.Key, j)
+			}
+			if milestoneKeys[milestone.Key] {
+				return fmt.Errorf("milestone %q is defined twice", milestone.Key)
+			}
+			milestoneKeys[milestone.Key] = true
+			if !trackableMetrics[milestone.Metric] {
+				return fmt.Errorf("%s milestone %q: unknown metric %q", track.Key, milestone.Key, milestone.Metric)
+			}
+			if milestone.Threshold < 1 {
+				return fmt.Errorf("%s milestone %q: threshold must be at least 1", track.Key, milestone.Key)
+			}
+		}
+	}
+	return nil
+}
+
+// LoadTrackConfig reads and validates the personal track config file
+func LoadTrackConfig(path string) (*TrackConfig, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read personal track config: %w", err)
+	}
+
+	var config TrackConfig
+	if err := json.Unmarshal(data, &config); err != nil {
+		return nil, fmt.Errorf("failed to parse personal track config: %w", err)
+	}
+
+	if err := config.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid personal track config: %w", err)
+	}
+
+	return &config, nil
+}
+
+// TrackTable holds the personal tracks and can be reloaded from its file
+type TrackTable struct {
+	path string
+
+	mu     sync.RWMutex
+	tracks []Track
+}
+
+// NewTrackTable loads the personal tracks from path
+func NewTrackTable(path string) (*TrackTable, error) {
+	config, err := LoadTrackConfig(path)
+	if err != nil {
+		return nil, err
+	}
+	return &TrackTable{path: path, tracks: config.Tracks}, nil
+}
+
+// Reload re-reads the tracks from their file, keeping the current ones if the file is invalid
+func (t *TrackTable) Reload(ctx context.Context) error {
+	config, err := LoadTrackConfig(t.path)
+	if err != nil {
+		return err
+	}
+	t.mu.Lock()
+	t.tracks = config.Tracks
+	t.mu.Unlock()
+	return nil
+}
+
+// Tracks returns the personal tracks in config order
+func (t *TrackTable) Tracks() []Track {
+	if t == nil {
+		return nil
+	}
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return t.tracks
+}
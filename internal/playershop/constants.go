@@ -0,0 +1,19 @@
+package playershop
+
+// Defaults, used when the configured values are not positive
+const (
+	// DefaultMaxListingsPerUser caps how many active listings a seller can have
+	DefaultMaxListingsPerUser = 10
+	// DefaultBrowseLimit caps how many listings one browse returns
+	DefaultBrowseLimit = 50
+)
+
+// MaxUnitPrice is the highest price a listing can ask per item
+const MaxUnitPrice = 1_000_000
+
+// Log messages
+const (
+	LogMsgItemListed       = "Player shop listing created"
+	LogMsgListingSold      = "Player shop listing sold"
+	LogMsgListingCancelled = "Player shop listing cancelled"
+)
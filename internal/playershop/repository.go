@@ -0,0 +1,46 @@
+package playershop
+
+import (
+	"context"
+	"time"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/repository"
+)
+
+// Repository stores player shop listings and the community pool
+type Repository interface {
+	BeginTx(ctx context.Context) (Tx, error)
+
+	// GetInventory reads a user's inventory without locking it
+	GetInventory(ctx context.Context, userID string) (*domain.Inventory, error)
+
+	// GetActiveListings returns up to limit active listings matching the
+	// filter, cheapest first unless the filter sorts them otherwise
+	GetActiveListings(ctx context.Context, filter domain.ListingFilter, limit int) ([]domain.PlayerListing, error)
+
+	// CountActiveListings returns how many active listings the seller has
+	CountActiveListings(ctx context.Context, sellerID string) (int, error)
+
+	// GetCommunityPoolBalance returns the money held by the community pool
+	GetCommunityPoolBalance(ctx context.Context) (int64, error)
+}
+
+// Tx moves items and money and records listings in one transaction
+type Tx interface {
+	repository.Tx
+	repository.InventoryAdjuster
+
+	CreateListing(ctx context.Context, listing domain.PlayerListing) (*domain.PlayerListing, error)
+
+	// GetActiveListingForUpdate locks and returns an active listing, or nil
+	// if there is no active listing with that ID
+	GetActiveListingForUpdate(ctx context.Context, id int64) (*domain.PlayerListing, error)
+
+	// UpdateListing records the quantity left for sale; closedAt is set
+	// when the listing leaves the active status
+	UpdateListing(ctx context.Context, id int64, quantity int, status domain.ListingStatus, closedAt *time.Time) error
+
+	// AddToCommunityPool credits the community pool
+	AddToCommunityPool(ctx context.Context, amount int) error
+}
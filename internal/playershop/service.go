@@ -0,0 +1,530 @@
+package playershop
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/osse101/BrandishBot_Go/internal/database"
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/event"
+	"github.com/osse101/BrandishBot_Go/internal/itemflags"
+	"github.com/osse101/BrandishBot_Go/internal/logger"
+	"github.com/osse101/BrandishBot_Go/internal/naming"
+	"github.com/osse101/BrandishBot_Go/internal/repository"
+	"github.com/osse101/BrandishBot_Go/internal/utils"
+)
+
+// Service runs the player shop, where users sell items to each other at a
+// price they set. Unlike selling to the game, the buyer pays the seller
+// directly, less a fee that goes to the community pool. Sellers also pay a
+// listing fee up front, which leaves the economy entirely.
+type Service interface {
+	// ListItem puts items up for sale. The items leave the seller's
+	// inventory until they are bought or the listing is cancelled, and the
+	// listing fee is taken from their money.
+	ListItem(ctx context.Context, req ListRequest) (*domain.PlayerListing, error)
+
+	// BuyListing buys some or all of the items left on a listing
+	BuyListing(ctx context.Context, req BuyRequest) (*domain.PlayerShopPurchase, error)
+
+	// CancelListing withdraws the seller's listing and returns the unsold items
+	CancelListing(ctx context.Context, platform, platformID string, id int64) (*domain.PlayerListing, error)
+
+	// GetListings searches active listings, cheapest first unless the query
+	// sorts them otherwise
+	GetListings(ctx context.Context, query ListingQuery) ([]domain.PlayerListing, error)
+
+	// GetCommunityPool returns the money the community pool holds
+	GetCommunityPool(ctx context.Context) (int64, error)
+}
+
+// ListRequest asks to put items up for sale
+type ListRequest struct {
+	Platform   string
+	PlatformID string
+	Username   string
+	ItemName   string
+	Quantity   int
+	UnitPrice  int
+}
+
+// BuyRequest asks to buy from a listing
+type BuyRequest struct {
+	Platform   string
+	PlatformID string
+	Username   string
+	ListingID  int64
+	Quantity   int
+}
+
+// ListingQuery searches active listings. Zero values match every listing.
+type ListingQuery struct {
+	ItemName string
+	// Seller is the seller's username, matched without regard to case
+	Seller   string
+	Quality  domain.QualityLevel
+	MinPrice int
+	MaxPrice int
+	// Sort is domain.ListingSortPrice when empty
+	Sort domain.ListingSort
+}
+
+// UserService defines the user operations needed by the player shop
+type UserService interface {
+	GetUserOrRegister(ctx context.Context, platform, platformID, username string) (*domain.User, error)
+	GetUserIDByPlatformID(ctx context.Context, platform, platformID string) (string, error)
+}
+
+// ItemLookup finds items by name or ID
+type ItemLookup interface {
+	GetItemByName(ctx context.Context, itemName string) (*domain.Item, error)
+	GetItemByID(ctx context.Context, id int) (*domain.Item, error)
+}
+
+// LoanChecker reports how many of an item a user holds on loan
+type LoanChecker interface {
+	GetBorrowedQuantity(ctx context.Context, userID string, itemID int) (int, error)
+}
+
+// CapacityChecker refuses credits that would overfill an inventory
+type CapacityChecker interface {
+	EnsureInventoryRoom(ctx context.Context, userID string, itemID int, qualityLevel domain.QualityLevel) error
+}
+
+// Publisher publishes player shop events
+type Publisher interface {
+	PublishWithRetry(ctx context.Context, evt event.Event)
+}
+
+// Config tunes the player shop
+type Config struct {
+	// FeePercent is the share of each sale, rounded down, paid into the
+	// community pool instead of to the seller
+	FeePercent int
+	// ListingFeePercent is the share of a listing's total asking price the
+	// seller pays to post it, at least 1 when it is not zero. The fee is
+	// burned rather than paid to anyone.
+	ListingFeePercent int
+	// MaxListingsPerUser caps how many active listings a seller can have
+	MaxListingsPerUser int
+	// BrowseLimit caps how many listings one browse returns
+	BrowseLimit int
+}
+
+// Option configures optional player shop dependencies
+type Option func(*service)
+
+// WithLoanChecker stops users listing items they have borrowed
+func WithLoanChecker(loans LoanChecker) Option {
+	return func(s *service) {
+		s.loans = loans
+	}
+}
+
+// WithItemLocks stops users listing items they have locked
+func WithItemLocks(locks itemflags.LockChecker) Option {
+	return func(s *service) {
+		s.locks = locks
+	}
+}
+
+// WithCapacity refuses purchases and cancellations that would take the
+// receiver past their inventory slot limit
+func WithCapacity(capacity CapacityChecker) Option {
+	return func(s *service) {
+		s.capacity = capacity
+	}
+}
+
+type service struct {
+	repo           Repository
+	users          UserService
+	items          ItemLookup
+	namingResolver naming.Resolver
+	publisher      Publisher
+	loans          LoanChecker           // nil allows listing everything held
+	locks          itemflags.LockChecker // nil ignores item locks
+	capacity       CapacityChecker       // nil ignores slot limits
+	cfg            Config
+	now            func() time.Time
+	rnd            func() float64
+}
+
+// NewService creates a player shop service
+func NewService(repo Repository, users UserService, items ItemLookup, namingResolver naming.Resolver, publisher Publisher, cfg Config, opts ...Option) Service {
+	if cfg.FeePercent < 0 {
+		cfg.FeePercent = 0
+	}
+	if cfg.ListingFeePercent < 0 {
+		cfg.ListingFeePercent = 0
+	}
+	if cfg.MaxListingsPerUser <= 0 {
+		cfg.MaxListingsPerUser = DefaultMaxListingsPerUser
+	}
+	if cfg.BrowseLimit <= 0 {
+		cfg.BrowseLimit = DefaultBrowseLimit
+	}
+	s := &service{
+		repo:           repo,
+		users:          users,
+		items:          items,
+		namingResolver: namingResolver,
+		publisher:      publisher,
+		cfg:            cfg,
+		now:            time.Now,
+		rnd:            utils.RandomFloat,
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+// ListItem lists items from one quality slot of the seller's inventory
+func (s *service) ListItem(ctx context.Context, req ListRequest) (*domain.PlayerListing, error) {
+	if req.Quantity <= 0 || req.Quantity > domain.MaxTransactionQuantity {
+		return nil, fmt.Errorf("%w: quantity must be between 1 and %d", domain.ErrInvalidInput, domain.MaxTransactionQuantity)
+	}
+	if req.UnitPrice <= 0 || req.UnitPrice > MaxUnitPrice {
+		return nil, fmt.Errorf("%w: price must be between 1 and %d", domain.ErrInvalidListingPrice, MaxUnitPrice)
+	}
+
+	seller, err := s.users.GetUserOrRegister(ctx, req.Platform, req.PlatformID, req.Username)
+	if err != nil {
+		return nil, err
+	}
+	item, err := naming.ResolveItem(ctx, s.namingResolver, s.items, req.ItemName)
+	if err != nil {
+		return nil, err
+	}
+	if item.InternalName == domain.ItemMoney {
+		return nil, fmt.Errorf("%w: money cannot be listed", domain.ErrInvalidInput)
+	}
+	if err := itemflags.EnsureUnlocked(ctx, s.locks, seller.ID, item); err != nil {
+		return nil, err
+	}
+
+	active, err := s.repo.CountActiveListings(ctx, seller.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count listings: %w", err)
+	}
+	if active >= s.cfg.MaxListingsPerUser {
+		return nil, fmt.Errorf("%w: you can have %d at a time", domain.ErrTooManyListings, s.cfg.MaxListingsPerUser)
+	}
+
+	quality, err := s.chooseListSlot(ctx, seller.ID, item, req.Quantity)
+	if err != nil {
+		return nil, err
+	}
+
+	listingFee := s.listingFee(req.Quantity * req.UnitPrice)
+	var money *domain.Item
+	var moneyQuality domain.QualityLevel
+	if listingFee > 0 {
+		if money, err = repository.MoneyItem(ctx, s.items); err != nil {
+			return nil, err
+		}
+		if moneyQuality, err = repository.PaymentSlot(ctx, s.repo, seller.ID, money.ID, int64(listingFee)); err != nil {
+			return nil, err
+		}
+	}
+
+	tx, err := s.repo.BeginTx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer repository.SafeRollback(ctx, tx)
+
+	if listingFee > 0 {
+		if _, err := tx.AdjustItemQuantity(ctx, seller.ID, money.ID, moneyQuality, -listingFee); err != nil {
+			if errors.Is(err, domain.ErrInsufficientQuantity) {
+				return nil, fmt.Errorf("%w: the listing fee is %d", domain.ErrInsufficientFunds, listingFee)
+			}
+			return nil, fmt.Errorf("failed to update inventory: %w", err)
+		}
+	}
+	if _, err := tx.AdjustItemQuantity(ctx, seller.ID, item.ID, quality, -req.Quantity); err != nil {
+		if errors.Is(err, domain.ErrInsufficientQuantity) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to update inventory: %w", err)
+	}
+	listing, err := tx.CreateListing(ctx, domain.PlayerListing{
+		SellerID:     seller.ID,
+		ItemID:       item.ID,
+		QualityLevel: quality,
+		Quantity:     req.Quantity,
+		UnitPrice:    req.UnitPrice,
+		Status:       domain.ListingStatusActive,
+		ListingFee:   listingFee,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create listing: %w", err)
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	listing.ItemName = item.InternalName
+	logger.FromContext(ctx).Info(LogMsgItemListed, "listing_id", listing.ID, "seller", seller.ID, "item", item.InternalName, "quantity", listing.Quantity, "unit_price", listing.UnitPrice, "listing_fee", listingFee)
+	return listing, nil
+}
+
+// listingFee is what it costs to post a listing asking total for its items
+func (s *service) listingFee(total int) int {
+	if s.cfg.ListingFeePercent == 0 {
+		return 0
+	}
+	return max(total*s.cfg.ListingFeePercent/100, 1)
+}
+
+// chooseListSlot picks the quality slot to list from. Only items the seller
+// owns outright can be listed, and a listing must fit in a single slot.
+func (s *service) chooseListSlot(ctx context.Context, sellerID string, item *domain.Item, quantity int) (domain.QualityLevel, error) {
+	inventory, err := s.repo.GetInventory(database.WithPrimaryReads(ctx), sellerID)
+	if err != nil {
+		return "", fmt.Errorf("failed to get inventory: %w", err)
+	}
+	borrowed := 0
+	if s.loans != nil {
+		if borrowed, err = s.loans.GetBorrowedQuantity(ctx, sellerID, item.ID); err != nil {
+			return "", fmt.Errorf("failed to get borrowed quantity: %w", err)
+		}
+	}
+
+	owned := utils.GetTotalQuantity(inventory, item.ID) - borrowed
+	if owned < quantity {
+		if borrowed > 0 {
+			return "", fmt.Errorf("%w: %d of your %s are borrowed", domain.ErrItemBorrowed, borrowed, item.InternalName)
+		}
+		return "", fmt.Errorf("%w: have %d %s", domain.ErrInsufficientQuantity, owned, item.InternalName)
+	}
+
+	slotIndex, slotQuantity := utils.FindRandomSlot(inventory, item.ID, s.rnd)
+	if slotIndex == -1 {
+		return "", domain.ErrNotInInventory
+	}
+	if slotQuantity < quantity {
+		return "", fmt.Errorf("%w: a listing must come from items of one quality", domain.ErrInsufficientQuantity)
+	}
+	return inventory.Slots[slotIndex].QualityLevel, nil
+}
+
+// BuyListing pays the seller and the community pool and hands over the items
+func (s *service) BuyListing(ctx context.Context, req BuyRequest) (*domain.PlayerShopPurchase, error) {
+	if req.Quantity <= 0 || req.Quantity > domain.MaxTransactionQuantity {
+		return nil, fmt.Errorf("%w: quantity must be between 1 and %d", domain.ErrInvalidInput, domain.MaxTransactionQuantity)
+	}
+
+	buyer, err := s.users.GetUserOrRegister(ctx, req.Platform, req.PlatformID, req.Username)
+	if err != nil {
+		return nil, err
+	}
+	money, err := repository.MoneyItem(ctx, s.items)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := s.repo.BeginTx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer repository.SafeRollback(ctx, tx)
+
+	listing, err := tx.GetActiveListingForUpdate(ctx, req.ListingID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get listing: %w", err)
+	}
+	if listing == nil {
+		return nil, domain.ErrListingNotFound
+	}
+	if listing.SellerID == buyer.ID {
+		return nil, domain.ErrCannotBuyOwnListing
+	}
+	if req.Quantity > listing.Quantity {
+		return nil, fmt.Errorf("%w: only %d left on this listing", domain.ErrInsufficientQuantity, listing.Quantity)
+	}
+	if err := s.ensureRoom(ctx, buyer.ID, listing); err != nil {
+		return nil, err
+	}
+
+	total := req.Quantity * listing.UnitPrice
+	fee := total * s.cfg.FeePercent / 100
+	purchase := domain.PlayerShopPurchase{
+		Quantity:   req.Quantity,
+		TotalPrice: total,
+		Fee:        fee,
+		SellerGets: total - fee,
+	}
+
+	moneyQuality, err := repository.PaymentSlot(ctx, s.repo, buyer.ID, money.ID, int64(total))
+	if err != nil {
+		return nil, err
+	}
+	if err := settle(ctx, tx, buyer.ID, listing, money.ID, moneyQuality, purchase); err != nil {
+		return nil, err
+	}
+	if fee > 0 {
+		if err := tx.AddToCommunityPool(ctx, fee); err != nil {
+			return nil, fmt.Errorf("failed to credit community pool: %w", err)
+		}
+	}
+
+	listing.Quantity -= req.Quantity
+	var closedAt *time.Time
+	if listing.Quantity == 0 {
+		now := s.now()
+		closedAt = &now
+		listing.Status = domain.ListingStatusSold
+		listing.ClosedAt = closedAt
+	}
+	if err := tx.UpdateListing(ctx, listing.ID, listing.Quantity, listing.Status, closedAt); err != nil {
+		return nil, fmt.Errorf("failed to update listing: %w", err)
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	s.fillItemName(ctx, listing)
+	purchase.Listing = *listing
+	if s.publisher != nil {
+		s.publisher.PublishWithRetry(ctx, event.NewPlayerShopSoldEvent(buyer.ID, purchase))
+	}
+	logger.FromContext(ctx).Info(LogMsgListingSold, "listing_id", listing.ID, "seller", listing.SellerID, "buyer", buyer.ID, "quantity", req.Quantity, "total", total, "fee", fee)
+	return &purchase, nil
+}
+
+// settle moves the money and the items between buyer and seller. Each
+// user's slots are adjusted together, in user ID order, so opposing
+// purchases cannot deadlock.
+func settle(ctx context.Context, tx Tx, buyerID string, listing *domain.PlayerListing, moneyID int, moneyQuality domain.QualityLevel, purchase domain.PlayerShopPurchase) error {
+	buyer := func() error {
+		if _, err := tx.AdjustItemQuantity(ctx, buyerID, moneyID, moneyQuality, -purchase.TotalPrice); err != nil {
+			if errors.Is(err, domain.ErrInsufficientQuantity) {
+				return fmt.Errorf("%w: the price is %d", domain.ErrInsufficientFunds, purchase.TotalPrice)
+			}
+			return fmt.Errorf("failed to update inventory: %w", err)
+		}
+		if _, err := tx.AdjustItemQuantity(ctx, buyerID, listing.ItemID, listing.QualityLevel, purchase.Quantity); err != nil {
+			return fmt.Errorf("failed to update inventory: %w", err)
+		}
+		return nil
+	}
+	seller := func() error {
+		if purchase.SellerGets == 0 {
+			return nil
+		}
+		if _, err := tx.AdjustItemQuantity(ctx, listing.SellerID, moneyID, domain.QualityCommon, purchase.SellerGets); err != nil {
+			return fmt.Errorf("failed to update inventory: %w", err)
+		}
+		return nil
+	}
+
+	steps := []func() error{buyer, seller}
+	if listing.SellerID < buyerID {
+		steps[0], steps[1] = steps[1], steps[0]
+	}
+	for _, step := range steps {
+		if err := step(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ensureRoom refuses to hand the listed items to a user whose inventory has
+// no slot for them
+func (s *service) ensureRoom(ctx context.Context, userID string, listing *domain.PlayerListing) error {
+	if s.capacity == nil {
+		return nil
+	}
+	return s.capacity.EnsureInventoryRoom(ctx, userID, listing.ItemID, listing.QualityLevel)
+}
+
+// CancelListing returns the unsold items to the seller
+func (s *service) CancelListing(ctx context.Context, platform, platformID string, id int64) (*domain.PlayerListing, error) {
+	sellerID, err := repository.RegisteredUserID(ctx, s.users, platform, platformID)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := s.repo.BeginTx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer repository.SafeRollback(ctx, tx)
+
+	listing, err := tx.GetActiveListingForUpdate(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get listing: %w", err)
+	}
+	if listing == nil || listing.SellerID != sellerID {
+		return nil, domain.ErrListingNotFound
+	}
+	if err := s.ensureRoom(ctx, sellerID, listing); err != nil {
+		return nil, err
+	}
+
+	if _, err := tx.AdjustItemQuantity(ctx, sellerID, listing.ItemID, listing.QualityLevel, listing.Quantity); err != nil {
+		return nil, fmt.Errorf("failed to update inventory: %w", err)
+	}
+	closedAt := s.now()
+	if err := tx.UpdateListing(ctx, listing.ID, listing.Quantity, domain.ListingStatusCancelled, &closedAt); err != nil {
+		return nil, fmt.Errorf("failed to update listing: %w", err)
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	listing.Status = domain.ListingStatusCancelled
+	listing.ClosedAt = &closedAt
+	s.fillItemName(ctx, listing)
+	logger.FromContext(ctx).Info(LogMsgListingCancelled, "listing_id", listing.ID, "seller", sellerID, "returned", listing.Quantity)
+	return listing, nil
+}
+
+// GetListings searches active listings
+func (s *service) GetListings(ctx context.Context, query ListingQuery) ([]domain.PlayerListing, error) {
+	if query.MinPrice < 0 || query.MaxPrice < 0 {
+		return nil, fmt.Errorf("%w: prices cannot be negative", domain.ErrInvalidInput)
+	}
+	if query.MaxPrice > 0 && query.MinPrice > query.MaxPrice {
+		return nil, fmt.Errorf("%w: the minimum price is above the maximum", domain.ErrInvalidInput)
+	}
+	filter := domain.ListingFilter{
+		SellerName: strings.TrimSpace(query.Seller),
+		Quality:    domain.QualityLevel(strings.ToUpper(string(query.Quality))),
+		MinPrice:   query.MinPrice,
+		MaxPrice:   query.MaxPrice,
+		Sort:       query.Sort,
+	}
+	switch filter.Sort {
+	case "":
+		filter.Sort = domain.ListingSortPrice
+	case domain.ListingSortPrice, domain.ListingSortNewest:
+	default:
+		return nil, fmt.Errorf("%w: sort by %q or %q", domain.ErrInvalidInput, domain.ListingSortPrice, domain.ListingSortNewest)
+	}
+	if query.ItemName != "" {
+		item, err := naming.ResolveItem(ctx, s.namingResolver, s.items, query.ItemName)
+		if err != nil {
+			return nil, err
+		}
+		filter.ItemID = item.ID
+	}
+	return s.repo.GetActiveListings(ctx, filter, s.cfg.BrowseLimit)
+}
+
+// GetCommunityPool returns the community pool's balance
+func (s *service) GetCommunityPool(ctx context.Context) (int64, error) {
+	return s.repo.GetCommunityPoolBalance(ctx)
+}
+
+func (s *service) fillItemName(ctx context.Context, listing *domain.PlayerListing) {
+	if item, err := s.items.GetItemByID(ctx, listing.ItemID); err == nil && item != nil {
+		listing.ItemName = item.InternalName
+	}
+}
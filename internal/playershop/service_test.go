@@ -0,0 +1,495 @@
+package playershop_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/event"
+	"github.com/osse101/BrandishBot_Go/internal/playershop"
+	"github.com/osse101/BrandishBot_Go/internal/playershop/mocks"
+)
+
+const (
+	sellerID = "seller-1"
+	buyerID  = "buyer-1"
+	swordID  = 7
+	moneyID  = 1
+)
+
+func swordSlot(quantity int) domain.InventorySlot {
+	return domain.InventorySlot{ItemID: swordID, Quantity: quantity, QualityLevel: domain.QualityRare}
+}
+
+func moneySlot(quantity int) domain.InventorySlot {
+	return domain.InventorySlot{ItemID: moneyID, Quantity: quantity, QualityLevel: domain.QualityCommon}
+}
+
+func listRequest(quantity, price int) playershop.ListRequest {
+	return playershop.ListRequest{
+		Platform:   domain.PlatformDiscord,
+		PlatformID: "d-1",
+		Username:   "alice",
+		ItemName:   "sword",
+		Quantity:   quantity,
+		UnitPrice:  price,
+	}
+}
+
+func TestListItem(t *testing.T) {
+	ctx := context.Background()
+
+	start := func(mockUsers *mocks.MockUserService, mockItems *mocks.MockItemLookup) {
+		mockUsers.On("GetUserOrRegister", ctx, domain.PlatformDiscord, "d-1", "alice").Return(&domain.User{ID: sellerID}, nil)
+		mockItems.On("GetItemByName", ctx, "sword").Return(&domain.Item{ID: swordID, InternalName: "sword"}, nil)
+	}
+
+	t.Run("escrows the items and creates the listing", func(t *testing.T) {
+		mockRepo := mocks.NewMockRepository(t)
+		mockTx := mocks.NewMockTx(t)
+		mockUsers := mocks.NewMockUserService(t)
+		mockItems := mocks.NewMockItemLookup(t)
+		mockLoans := mocks.NewMockLoanChecker(t)
+		mockPublisher := mocks.NewMockPublisher(t)
+		svc := playershop.NewService(mockRepo, mockUsers, mockItems, nil, mockPublisher, playershop.Config{FeePercent: 10}, playershop.WithLoanChecker(mockLoans))
+
+		start(mockUsers, mockItems)
+		mockRepo.On("CountActiveListings", ctx, sellerID).Return(0, nil)
+		mockRepo.On("GetInventory", mock.Anything, sellerID).Return(&domain.Inventory{Slots: []domain.InventorySlot{swordSlot(5)}}, nil)
+		mockLoans.On("GetBorrowedQuantity", ctx, sellerID, swordID).Return(0, nil)
+		mockRepo.On("BeginTx", mock.Anything).Return(mockTx, nil)
+		mockTx.On("Commit", mock.Anything).Return(nil)
+		mockTx.On("Rollback", mock.Anything).Return(nil).Maybe()
+		mockTx.On("AdjustItemQuantity", mock.Anything, sellerID, swordID, domain.QualityRare, -2).Return(3, nil)
+		mockTx.On("CreateListing", mock.Anything, mock.Anything).Return(func(_ context.Context, l domain.PlayerListing) (*domain.PlayerListing, error) {
+			l.ID = 4
+			return &l, nil
+		})
+
+		got, err := svc.ListItem(ctx, listRequest(2, 50))
+
+		require.NoError(t, err)
+		assert.Equal(t, int64(4), got.ID)
+		assert.Equal(t, domain.ListingStatusActive, got.Status)
+		assert.Equal(t, domain.QualityRare, got.QualityLevel)
+		assert.Equal(t, "sword", got.ItemName)
+	})
+
+	t.Run("charges the listing fee", func(t *testing.T) {
+		mockRepo := mocks.NewMockRepository(t)
+		mockTx := mocks.NewMockTx(t)
+		mockUsers := mocks.NewMockUserService(t)
+		mockItems := mocks.NewMockItemLookup(t)
+		mockLoans := mocks.NewMockLoanChecker(t)
+		mockPublisher := mocks.NewMockPublisher(t)
+		svc := playershop.NewService(mockRepo, mockUsers, mockItems, nil, mockPublisher, playershop.Config{FeePercent: 10, ListingFeePercent: 2}, playershop.WithLoanChecker(mockLoans))
+
+		start(mockUsers, mockItems)
+		mockRepo.On("CountActiveListings", ctx, sellerID).Return(0, nil)
+		mockRepo.On("GetInventory", mock.Anything, sellerID).Return(&domain.Inventory{Slots: []domain.InventorySlot{swordSlot(5), moneySlot(10)}}, nil)
+		mockLoans.On("GetBorrowedQuantity", ctx, sellerID, swordID).Return(0, nil)
+		mockItems.On("GetItemByName", ctx, domain.ItemMoney).Return(&domain.Item{ID: moneyID, InternalName: domain.ItemMoney}, nil)
+		mockRepo.On("BeginTx", mock.Anything).Return(mockTx, nil)
+		mockTx.On("Commit", mock.Anything).Return(nil)
+		mockTx.On("Rollback", mock.Anything).Return(nil).Maybe()
+		mockTx.On("AdjustItemQuantity", mock.Anything, sellerID, moneyID, domain.QualityCommon, -2).Return(8, nil)
+		mockTx.On("AdjustItemQuantity", mock.Anything, sellerID, swordID, domain.QualityRare, -2).Return(3, nil)
+		mockTx.On("CreateListing", mock.Anything, mock.Anything).Return(func(_ context.Context, l domain.PlayerListing) (*domain.PlayerListing, error) {
+			return &l, nil
+		})
+
+		got, err := svc.ListItem(ctx, listRequest(2, 50))
+
+		require.NoError(t, err)
+		assert.Equal(t, 2, got.ListingFee)
+	})
+
+	t.Run("the listing fee is at least 1", func(t *testing.T) {
+		mockRepo := mocks.NewMockRepository(t)
+		mockUsers := mocks.NewMockUserService(t)
+		mockItems := mocks.NewMockItemLookup(t)
+		mockLoans := mocks.NewMockLoanChecker(t)
+		mockPublisher := mocks.NewMockPublisher(t)
+		svc := playershop.NewService(mockRepo, mockUsers, mockItems, nil, mockPublisher, playershop.Config{ListingFeePercent: 2}, playershop.WithLoanChecker(mockLoans))
+
+		start(mockUsers, mockItems)
+		mockRepo.On("CountActiveListings", ctx, sellerID).Return(0, nil)
+		mockRepo.On("GetInventory", mock.Anything, sellerID).Return(&domain.Inventory{Slots: []domain.InventorySlot{swordSlot(5)}}, nil)
+		mockLoans.On("GetBorrowedQuantity", ctx, sellerID, swordID).Return(0, nil)
+		mockItems.On("GetItemByName", ctx, domain.ItemMoney).Return(&domain.Item{ID: moneyID, InternalName: domain.ItemMoney}, nil)
+
+		_, err := svc.ListItem(ctx, listRequest(1, 10))
+
+		assert.ErrorIs(t, err, domain.ErrInsufficientFunds)
+	})
+
+	t.Run("borrowed items cannot be listed", func(t *testing.T) {
+		mockRepo := mocks.NewMockRepository(t)
+		mockUsers := mocks.NewMockUserService(t)
+		mockItems := mocks.NewMockItemLookup(t)
+		mockLoans := mocks.NewMockLoanChecker(t)
+		mockPublisher := mocks.NewMockPublisher(t)
+		svc := playershop.NewService(mockRepo, mockUsers, mockItems, nil, mockPublisher, playershop.Config{FeePercent: 10}, playershop.WithLoanChecker(mockLoans))
+
+		start(mockUsers, mockItems)
+		mockRepo.On("CountActiveListings", ctx, sellerID).Return(0, nil)
+		mockRepo.On("GetInventory", mock.Anything, sellerID).Return(&domain.Inventory{Slots: []domain.InventorySlot{swordSlot(2)}}, nil)
+		mockLoans.On("GetBorrowedQuantity", ctx, sellerID, swordID).Return(1, nil)
+
+		_, err := svc.ListItem(ctx, listRequest(2, 50))
+
+		assert.ErrorIs(t, err, domain.ErrItemBorrowed)
+	})
+
+	t.Run("sellers are capped on active listings", func(t *testing.T) {
+		mockRepo := mocks.NewMockRepository(t)
+		mockUsers := mocks.NewMockUserService(t)
+		mockItems := mocks.NewMockItemLookup(t)
+		mockLoans := mocks.NewMockLoanChecker(t)
+		mockPublisher := mocks.NewMockPublisher(t)
+		svc := playershop.NewService(mockRepo, mockUsers, mockItems, nil, mockPublisher, playershop.Config{FeePercent: 10}, playershop.WithLoanChecker(mockLoans))
+
+		start(mockUsers, mockItems)
+		mockRepo.On("CountActiveListings", ctx, sellerID).Return(playershop.DefaultMaxListingsPerUser, nil)
+
+		_, err := svc.ListItem(ctx, listRequest(1, 50))
+
+		assert.ErrorIs(t, err, domain.ErrTooManyListings)
+	})
+
+	t.Run("money cannot be listed", func(t *testing.T) {
+		mockRepo := mocks.NewMockRepository(t)
+		mockUsers := mocks.NewMockUserService(t)
+		mockItems := mocks.NewMockItemLookup(t)
+		mockLoans := mocks.NewMockLoanChecker(t)
+		mockPublisher := mocks.NewMockPublisher(t)
+		svc := playershop.NewService(mockRepo, mockUsers, mockItems, nil, mockPublisher, playershop.Config{FeePercent: 10}, playershop.WithLoanChecker(mockLoans))
+
+		mockUsers.On("GetUserOrRegister", ctx, domain.PlatformDiscord, "d-1", "alice").Return(&domain.User{ID: sellerID}, nil)
+		mockItems.On("GetItemByName", ctx, "money").Return(&domain.Item{ID: moneyID, InternalName: domain.ItemMoney}, nil)
+		req := listRequest(1, 50)
+		req.ItemName = "money"
+
+		_, err := svc.ListItem(ctx, req)
+
+		assert.ErrorIs(t, err, domain.ErrInvalidInput)
+	})
+
+	t.Run("rejects prices out of range", func(t *testing.T) {
+		mockRepo := mocks.NewMockRepository(t)
+		mockUsers := mocks.NewMockUserService(t)
+		mockItems := mocks.NewMockItemLookup(t)
+		mockLoans := mocks.NewMockLoanChecker(t)
+		mockPublisher := mocks.NewMockPublisher(t)
+		svc := playershop.NewService(mockRepo, mockUsers, mockItems, nil, mockPublisher, playershop.Config{FeePercent: 10}, playershop.WithLoanChecker(mockLoans))
+
+		_, err := svc.ListItem(ctx, listRequest(1, 0))
+		assert.ErrorIs(t, err, domain.ErrInvalidListingPrice)
+
+		_, err = svc.ListItem(ctx, listRequest(1, playershop.MaxUnitPrice+1))
+		assert.ErrorIs(t, err, domain.ErrInvalidListingPrice)
+	})
+}
+
+func TestGetListings(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("builds the filter from the query", func(t *testing.T) {
+		mockRepo := mocks.NewMockRepository(t)
+		mockUsers := mocks.NewMockUserService(t)
+		mockItems := mocks.NewMockItemLookup(t)
+		mockLoans := mocks.NewMockLoanChecker(t)
+		mockPublisher := mocks.NewMockPublisher(t)
+		svc := playershop.NewService(mockRepo, mockUsers, mockItems, nil, mockPublisher, playershop.Config{FeePercent: 10}, playershop.WithLoanChecker(mockLoans))
+
+		mockItems.On("GetItemByName", ctx, "sword").Return(&domain.Item{ID: swordID, InternalName: "sword"}, nil)
+		want := domain.ListingFilter{
+			ItemID:     swordID,
+			SellerName: "alice",
+			Quality:    domain.QualityRare,
+			MaxPrice:   100,
+			Sort:       domain.ListingSortNewest,
+		}
+		mockRepo.On("GetActiveListings", ctx, want, playershop.DefaultBrowseLimit).Return([]domain.PlayerListing{*activeListing(sellerID, 1)}, nil)
+
+		got, err := svc.GetListings(ctx, playershop.ListingQuery{
+			ItemName: "sword",
+			Seller:   " alice ",
+			Quality:  "rare",
+			MaxPrice: 100,
+			Sort:     domain.ListingSortNewest,
+		})
+
+		require.NoError(t, err)
+		assert.Len(t, got, 1)
+	})
+
+	t.Run("sorts by price by default", func(t *testing.T) {
+		mockRepo := mocks.NewMockRepository(t)
+		mockUsers := mocks.NewMockUserService(t)
+		mockItems := mocks.NewMockItemLookup(t)
+		mockLoans := mocks.NewMockLoanChecker(t)
+		mockPublisher := mocks.NewMockPublisher(t)
+		svc := playershop.NewService(mockRepo, mockUsers, mockItems, nil, mockPublisher, playershop.Config{FeePercent: 10}, playershop.WithLoanChecker(mockLoans))
+
+		mockRepo.On("GetActiveListings", ctx, domain.ListingFilter{Sort: domain.ListingSortPrice}, playershop.DefaultBrowseLimit).Return(nil, nil)
+
+		_, err := svc.GetListings(ctx, playershop.ListingQuery{})
+
+		require.NoError(t, err)
+	})
+
+	t.Run("rejects bad queries", func(t *testing.T) {
+		mockRepo := mocks.NewMockRepository(t)
+		mockUsers := mocks.NewMockUserService(t)
+		mockItems := mocks.NewMockItemLookup(t)
+		mockLoans := mocks.NewMockLoanChecker(t)
+		mockPublisher := mocks.NewMockPublisher(t)
+		svc := playershop.NewService(mockRepo, mockUsers, mockItems, nil, mockPublisher, playershop.Config{FeePercent: 10}, playershop.WithLoanChecker(mockLoans))
+
+		_, err := svc.GetListings(ctx, playershop.ListingQuery{MinPrice: 50, MaxPrice: 10})
+		assert.ErrorIs(t, err, domain.ErrInvalidInput)
+
+		_, err = svc.GetListings(ctx, playershop.ListingQuery{Sort: "popular"})
+		assert.ErrorIs(t, err, domain.ErrInvalidInput)
+	})
+}
+
+func activeListing(seller string, quantity int) *domain.PlayerListing {
+	return &domain.PlayerListing{
+		ID:           4,
+		SellerID:     seller,
+		ItemID:       swordID,
+		QualityLevel: domain.QualityRare,
+		Quantity:     quantity,
+		UnitPrice:    50,
+		Status:       domain.ListingStatusActive,
+	}
+}
+
+func buyRequest(quantity int) playershop.BuyRequest {
+	return playershop.BuyRequest{
+		Platform:   domain.PlatformDiscord,
+		PlatformID: "d-2",
+		Username:   "bob",
+		ListingID:  4,
+		Quantity:   quantity,
+	}
+}
+
+func TestBuyListing(t *testing.T) {
+	ctx := context.Background()
+
+	start := func(mockUsers *mocks.MockUserService, mockItems *mocks.MockItemLookup, mockTx *mocks.MockTx, l *domain.PlayerListing) {
+		mockUsers.On("GetUserOrRegister", ctx, domain.PlatformDiscord, "d-2", "bob").Return(&domain.User{ID: buyerID}, nil)
+		mockItems.On("GetItemByName", ctx, domain.ItemMoney).Return(&domain.Item{ID: moneyID, InternalName: domain.ItemMoney}, nil)
+		mockTx.On("GetActiveListingForUpdate", mock.Anything, int64(4)).Return(l, nil)
+	}
+
+	t.Run("pays the seller less the fee and pools the fee", func(t *testing.T) {
+		mockRepo := mocks.NewMockRepository(t)
+		mockTx := mocks.NewMockTx(t)
+		mockUsers := mocks.NewMockUserService(t)
+		mockItems := mocks.NewMockItemLookup(t)
+		mockLoans := mocks.NewMockLoanChecker(t)
+		mockPublisher := mocks.NewMockPublisher(t)
+		svc := playershop.NewService(mockRepo, mockUsers, mockItems, nil, mockPublisher, playershop.Config{FeePercent: 10}, playershop.WithLoanChecker(mockLoans))
+
+		mockRepo.On("BeginTx", mock.Anything).Return(mockTx, nil)
+		mockTx.On("Commit", mock.Anything).Return(nil)
+		mockTx.On("Rollback", mock.Anything).Return(nil).Maybe()
+		start(mockUsers, mockItems, mockTx, activeListing(sellerID, 3))
+		mockRepo.On("GetInventory", mock.Anything, buyerID).Return(&domain.Inventory{Slots: []domain.InventorySlot{moneySlot(500)}}, nil)
+		mockTx.On("AdjustItemQuantity", mock.Anything, buyerID, moneyID, domain.QualityCommon, -100).Return(400, nil)
+		mockTx.On("AdjustItemQuantity", mock.Anything, buyerID, swordID, domain.QualityRare, 2).Return(2, nil)
+		mockTx.On("AdjustItemQuantity", mock.Anything, sellerID, moneyID, domain.QualityCommon, 90).Return(90, nil)
+		mockTx.On("AddToCommunityPool", mock.Anything, 10).Return(nil)
+		mockTx.On("UpdateListing", mock.Anything, int64(4), 1, domain.ListingStatusActive, (*time.Time)(nil)).Return(nil)
+		mockItems.On("GetItemByID", ctx, swordID).Return(&domain.Item{ID: swordID, InternalName: "sword"}, nil)
+		mockPublisher.On("PublishWithRetry", mock.Anything, mock.MatchedBy(func(evt event.Event) bool {
+			return evt.Type == event.PlayerShopSold
+		}))
+
+		got, err := svc.BuyListing(ctx, buyRequest(2))
+
+		require.NoError(t, err)
+		assert.Equal(t, 100, got.TotalPrice)
+		assert.Equal(t, 10, got.Fee)
+		assert.Equal(t, 90, got.SellerGets)
+		assert.Equal(t, 1, got.Listing.Quantity)
+	})
+
+	t.Run("buying the last items marks the listing sold", func(t *testing.T) {
+		mockRepo := mocks.NewMockRepository(t)
+		mockTx := mocks.NewMockTx(t)
+		mockUsers := mocks.NewMockUserService(t)
+		mockItems := mocks.NewMockItemLookup(t)
+		mockLoans := mocks.NewMockLoanChecker(t)
+		mockPublisher := mocks.NewMockPublisher(t)
+		svc := playershop.NewService(mockRepo, mockUsers, mockItems, nil, mockPublisher, playershop.Config{FeePercent: 10}, playershop.WithLoanChecker(mockLoans))
+
+		mockRepo.On("BeginTx", mock.Anything).Return(mockTx, nil)
+		mockTx.On("Commit", mock.Anything).Return(nil)
+		mockTx.On("Rollback", mock.Anything).Return(nil).Maybe()
+		start(mockUsers, mockItems, mockTx, activeListing(sellerID, 1))
+		mockRepo.On("GetInventory", mock.Anything, buyerID).Return(&domain.Inventory{Slots: []domain.InventorySlot{moneySlot(50)}}, nil)
+		mockTx.On("AdjustItemQuantity", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(0, nil)
+		mockTx.On("AddToCommunityPool", mock.Anything, 5).Return(nil)
+		mockTx.On("UpdateListing", mock.Anything, int64(4), 0, domain.ListingStatusSold, mock.Anything).Return(nil)
+		mockItems.On("GetItemByID", ctx, swordID).Return(&domain.Item{ID: swordID, InternalName: "sword"}, nil)
+		mockPublisher.On("PublishWithRetry", mock.Anything, mock.Anything)
+
+		got, err := svc.BuyListing(ctx, buyRequest(1))
+
+		require.NoError(t, err)
+		assert.Equal(t, domain.ListingStatusSold, got.Listing.Status)
+		assert.NotNil(t, got.Listing.ClosedAt)
+	})
+
+	t.Run("buyers without enough money are refused", func(t *testing.T) {
+		mockRepo := mocks.NewMockRepository(t)
+		mockTx := mocks.NewMockTx(t)
+		mockUsers := mocks.NewMockUserService(t)
+		mockItems := mocks.NewMockItemLookup(t)
+		mockLoans := mocks.NewMockLoanChecker(t)
+		mockPublisher := mocks.NewMockPublisher(t)
+		svc := playershop.NewService(mockRepo, mockUsers, mockItems, nil, mockPublisher, playershop.Config{FeePercent: 10}, playershop.WithLoanChecker(mockLoans))
+
+		mockRepo.On("BeginTx", mock.Anything).Return(mockTx, nil)
+		mockTx.On("Rollback", mock.Anything).Return(nil)
+		start(mockUsers, mockItems, mockTx, activeListing(sellerID, 3))
+		mockRepo.On("GetInventory", mock.Anything, buyerID).Return(&domain.Inventory{Slots: []domain.InventorySlot{moneySlot(99)}}, nil)
+
+		_, err := svc.BuyListing(ctx, buyRequest(2))
+
+		assert.ErrorIs(t, err, domain.ErrInsufficientFunds)
+	})
+
+	t.Run("sellers cannot buy their own listing", func(t *testing.T) {
+		mockRepo := mocks.NewMockRepository(t)
+		mockTx := mocks.NewMockTx(t)
+		mockUsers := mocks.NewMockUserService(t)
+		mockItems := mocks.NewMockItemLookup(t)
+		mockLoans := mocks.NewMockLoanChecker(t)
+		mockPublisher := mocks.NewMockPublisher(t)
+		svc := playershop.NewService(mockRepo, mockUsers, mockItems, nil, mockPublisher, playershop.Config{FeePercent: 10}, playershop.WithLoanChecker(mockLoans))
+
+		mockRepo.On("BeginTx", mock.Anything).Return(mockTx, nil)
+		mockTx.On("Rollback", mock.Anything).Return(nil)
+		start(mockUsers, mockItems, mockTx, activeListing(buyerID, 3))
+
+		_, err := svc.BuyListing(ctx, buyRequest(1))
+
+		assert.ErrorIs(t, err, domain.ErrCannotBuyOwnListing)
+	})
+
+	t.Run("cannot buy more than is listed", func(t *testing.T) {
+		mockRepo := mocks.NewMockRepository(t)
+		mockTx := mocks.NewMockTx(t)
+		mockUsers := mocks.NewMockUserService(t)
+		mockItems := mocks.NewMockItemLookup(t)
+		mockLoans := mocks.NewMockLoanChecker(t)
+		mockPublisher := mocks.NewMockPublisher(t)
+		svc := playershop.NewService(mockRepo, mockUsers, mockItems, nil, mockPublisher, playershop.Config{FeePercent: 10}, playershop.WithLoanChecker(mockLoans))
+
+		mockRepo.On("BeginTx", mock.Anything).Return(mockTx, nil)
+		mockTx.On("Rollback", mock.Anything).Return(nil)
+		start(mockUsers, mockItems, mockTx, activeListing(sellerID, 1))
+
+		_, err := svc.BuyListing(ctx, buyRequest(2))
+
+		assert.ErrorIs(t, err, domain.ErrInsufficientQuantity)
+	})
+
+	t.Run("buyers with a full inventory are refused", func(t *testing.T) {
+		mockRepo := mocks.NewMockRepository(t)
+		mockTx := mocks.NewMockTx(t)
+		mockUsers := mocks.NewMockUserService(t)
+		mockItems := mocks.NewMockItemLookup(t)
+		mockPublisher := mocks.NewMockPublisher(t)
+		mockCapacity := mocks.NewMockCapacityChecker(t)
+		svc := playershop.NewService(mockRepo, mockUsers, mockItems, nil, mockPublisher, playershop.Config{FeePercent: 10}, playershop.WithCapacity(mockCapacity))
+
+		mockRepo.On("BeginTx", mock.Anything).Return(mockTx, nil)
+		mockTx.On("Rollback", mock.Anything).Return(nil)
+		start(mockUsers, mockItems, mockTx, activeListing(sellerID, 3))
+		mockCapacity.On("EnsureInventoryRoom", mock.Anything, buyerID, swordID, domain.QualityRare).Return(domain.ErrInventoryFull)
+
+		_, err := svc.BuyListing(ctx, buyRequest(1))
+
+		assert.ErrorIs(t, err, domain.ErrInventoryFull)
+	})
+
+	t.Run("closed listings are not found", func(t *testing.T) {
+		mockRepo := mocks.NewMockRepository(t)
+		mockTx := mocks.NewMockTx(t)
+		mockUsers := mocks.NewMockUserService(t)
+		mockItems := mocks.NewMockItemLookup(t)
+		mockLoans := mocks.NewMockLoanChecker(t)
+		mockPublisher := mocks.NewMockPublisher(t)
+		svc := playershop.NewService(mockRepo, mockUsers, mockItems, nil, mockPublisher, playershop.Config{FeePercent: 10}, playershop.WithLoanChecker(mockLoans))
+
+		mockRepo.On("BeginTx", mock.Anything).Return(mockTx, nil)
+		mockTx.On("Rollback", mock.Anything).Return(nil)
+		start(mockUsers, mockItems, mockTx, nil)
+
+		_, err := svc.BuyListing(ctx, buyRequest(1))
+
+		assert.ErrorIs(t, err, domain.ErrListingNotFound)
+	})
+}
+
+func TestCancelListing(t *testing.T) {
+	ctx := context.Background()
+
+	start := func(mockUsers *mocks.MockUserService, mockTx *mocks.MockTx, l *domain.PlayerListing) {
+		mockUsers.On("GetUserIDByPlatformID", ctx, domain.PlatformDiscord, "d-1").Return(sellerID, nil)
+		mockTx.On("GetActiveListingForUpdate", mock.Anything, int64(4)).Return(l, nil)
+	}
+
+	t.Run("returns the unsold items", func(t *testing.T) {
+		mockRepo := mocks.NewMockRepository(t)
+		mockTx := mocks.NewMockTx(t)
+		mockUsers := mocks.NewMockUserService(t)
+		mockItems := mocks.NewMockItemLookup(t)
+		mockLoans := mocks.NewMockLoanChecker(t)
+		mockPublisher := mocks.NewMockPublisher(t)
+		svc := playershop.NewService(mockRepo, mockUsers, mockItems, nil, mockPublisher, playershop.Config{FeePercent: 10}, playershop.WithLoanChecker(mockLoans))
+
+		mockRepo.On("BeginTx", mock.Anything).Return(mockTx, nil)
+		mockTx.On("Commit", mock.Anything).Return(nil)
+		mockTx.On("Rollback", mock.Anything).Return(nil).Maybe()
+		start(mockUsers, mockTx, activeListing(sellerID, 3))
+		mockTx.On("AdjustItemQuantity", mock.Anything, sellerID, swordID, domain.QualityRare, 3).Return(3, nil)
+		mockTx.On("UpdateListing", mock.Anything, int64(4), 3, domain.ListingStatusCancelled, mock.Anything).Return(nil)
+		mockItems.On("GetItemByID", ctx, swordID).Return(&domain.Item{ID: swordID, InternalName: "sword"}, nil)
+
+		got, err := svc.CancelListing(ctx, domain.PlatformDiscord, "d-1", 4)
+
+		require.NoError(t, err)
+		assert.Equal(t, domain.ListingStatusCancelled, got.Status)
+	})
+
+	t.Run("only the seller can cancel", func(t *testing.T) {
+		mockRepo := mocks.NewMockRepository(t)
+		mockTx := mocks.NewMockTx(t)
+		mockUsers := mocks.NewMockUserService(t)
+		mockItems := mocks.NewMockItemLookup(t)
+		mockLoans := mocks.NewMockLoanChecker(t)
+		mockPublisher := mocks.NewMockPublisher(t)
+		svc := playershop.NewService(mockRepo, mockUsers, mockItems, nil, mockPublisher, playershop.Config{FeePercent: 10}, playershop.WithLoanChecker(mockLoans))
+
+		mockRepo.On("BeginTx", mock.Anything).Return(mockTx, nil)
+		mockTx.On("Rollback", mock.Anything).Return(nil)
+		start(mockUsers, mockTx, activeListing("someone-else", 3))
+
+		_, err := svc.CancelListing(ctx, domain.PlatformDiscord, "d-1", 4)
+
+		assert.ErrorIs(t, err, domain.ErrListingNotFound)
+	})
+}
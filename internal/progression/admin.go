@@ -7,6 +7,7 @@ import (
 
 	"github.com/osse101/BrandishBot_Go/internal/domain"
 	"github.com/osse101/BrandishBot_Go/internal/logger"
+	"github.com/osse101/BrandishBot_Go/internal/rng"
 )
 
 // AdminUnlock forces a node to unlock (for testing)
@@ -140,7 +141,7 @@ func (s *service) ForceInstantUnlock(ctx context.Context) (*domain.ProgressionUn
 	}
 
 	// Find winning option
-	winner := findWinningOption(session.Options, nil)
+	winner := findWinningOption(session.Options, s.intn(ctx, rng.OpVotingWinner))
 	if winner == nil {
 		return nil, domain.ErrNoActiveSession
 	}
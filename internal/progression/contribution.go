@@ -0,0 +1,158 @@
+package progression
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/logger"
+)
+
+// Contribution score defaults
+const (
+	DefaultContributionDecayRate        = 0.05 // 5% of every score lost per decay run
+	DefaultContributionCatchUpThreshold = 0.5  // Below half the average score earns a catch-up bonus
+	DefaultContributionCatchUpMax       = 2.0  // A user with no score earns double
+
+	// contributionScoreFloor is the score below which decay drops a user's row
+	contributionScoreFloor = 1.0
+
+	// averageScoreTTL is how long the average contribution score is cached
+	averageScoreTTL = 5 * time.Minute
+)
+
+// ContributionConfig tunes the per-user contribution scores ranked on the
+// contribution leaderboard. It does not change the points added to the
+// community unlock progress.
+type ContributionConfig struct {
+	DecayRate            float64 // Share of every score removed per decay run; 0 disables decay
+	CatchUpThreshold     float64 // Users below this fraction of the average score earn a catch-up bonus; 0 disables it
+	CatchUpMaxMultiplier float64 // Bonus for a user with no score, tapering to 1 at the threshold
+}
+
+// DefaultContributionConfig returns the default contribution score tuning
+func DefaultContributionConfig() ContributionConfig {
+	return ContributionConfig{
+		DecayRate:            DefaultContributionDecayRate,
+		CatchUpThreshold:     DefaultContributionCatchUpThreshold,
+		CatchUpMaxMultiplier: DefaultContributionCatchUpMax,
+	}
+}
+
+// WithContributionConfig overrides the contribution score tuning
+func WithContributionConfig(cfg ContributionConfig) Option {
+	return func(s *service) {
+		s.contribution = cfg
+	}
+}
+
+// CommunityEffectChecker reads community-wide timed effects such as the
+// contribution boost after a raid
+type CommunityEffectChecker interface {
+	CommunityMultiplier(ctx context.Context, effectType domain.EffectType) float64
+}
+
+// WithCommunityEffects applies active community contribution boosts to the
+// points added to the unlock progress
+func WithCommunityEffects(effects CommunityEffectChecker) Option {
+	return func(s *service) {
+		s.communityEffects = effects
+	}
+}
+
+// DecayContributions shrinks every user's contribution score by the
+// configured decay rate and drops scores that become negligible
+func (s *service) DecayContributions(ctx context.Context) (*domain.ContributionDecayResult, error) {
+	if s.contribution.DecayRate <= 0 {
+		return &domain.ContributionDecayResult{}, nil
+	}
+
+	result, err := s.repo.DecayContributionScores(ctx, 1-s.contribution.DecayRate, contributionScoreFloor)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decay contribution scores: %w", err)
+	}
+	s.invalidateAverageScore()
+
+	logger.FromContext(ctx).Info("Contribution scores decayed",
+		"rate", s.contribution.DecayRate,
+		"decayed", result.Decayed,
+		"removed", result.Removed)
+	return result, nil
+}
+
+// creditUserContribution adds points to the user's contribution score. The
+// community progress has already been credited, so failures are only logged.
+func (s *service) creditUserContribution(ctx context.Context, userID string, points float64) {
+	if points <= 0 {
+		return
+	}
+	if err := s.repo.AddUserContributionScore(ctx, userID, points); err != nil {
+		logger.FromContext(ctx).Warn("Failed to add user contribution score", "user_id", userID, "error", err)
+	}
+}
+
+// contributionCatchUp returns the multiplier for the user's contribution
+// score, greater than 1 when they are behind the average contributor
+func (s *service) contributionCatchUp(ctx context.Context, userID string) float64 {
+	cfg := s.contribution
+	if cfg.CatchUpThreshold <= 0 || cfg.CatchUpMaxMultiplier <= 1 {
+		return 1.0
+	}
+
+	average := s.averageContributionScore(ctx)
+	if average <= 0 {
+		return 1.0
+	}
+
+	score, err := s.repo.GetUserContributionScore(ctx, userID)
+	if err != nil {
+		logger.FromContext(ctx).Warn("Failed to get user contribution score, skipping catch-up", "user_id", userID, "error", err)
+		return 1.0
+	}
+
+	return catchUpMultiplier(score/average, cfg.CatchUpThreshold, cfg.CatchUpMaxMultiplier)
+}
+
+// catchUpMultiplier scales linearly from maxMultiplier at a ratio of 0 down
+// to 1 at the threshold ratio of the user's score to the average score
+func catchUpMultiplier(ratio, threshold, maxMultiplier float64) float64 {
+	if ratio >= threshold {
+		return 1.0
+	}
+	if ratio < 0 {
+		ratio = 0
+	}
+	return 1 + (maxMultiplier-1)*(1-ratio/threshold)
+}
+
+// averageContributionScore returns the cached average contribution score,
+// reloading it once the cache expires. Returns 0 if it cannot be loaded.
+func (s *service) averageContributionScore(ctx context.Context) float64 {
+	s.averageMu.RLock()
+	if time.Now().Before(s.averageExpiry) {
+		avg := s.cachedAverage
+		s.averageMu.RUnlock()
+		return avg
+	}
+	s.averageMu.RUnlock()
+
+	avg, err := s.repo.GetAverageContributionScore(ctx)
+	if err != nil {
+		logger.FromContext(ctx).Warn("Failed to get average contribution score", "error", err)
+		return 0
+	}
+
+	s.averageMu.Lock()
+	s.cachedAverage = avg
+	s.averageExpiry = time.Now().Add(averageScoreTTL)
+	s.averageMu.Unlock()
+	return avg
+}
+
+// invalidateAverageScore forces the next catch-up check to reload the average
+func (s *service) invalidateAverageScore() {
+	s.averageMu.Lock()
+	defer s.averageMu.Unlock()
+	s.averageExpiry = time.Time{}
+}
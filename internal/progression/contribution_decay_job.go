@@ -0,0 +1,36 @@
+package progression
+
+import (
+	"context"
+
+	"github.com/osse101/BrandishBot_Go/internal/logger"
+)
+
+// ContributionDecayJobType identifies contribution decay jobs in the worker pool
+const ContributionDecayJobType = "progression_contribution_decay"
+
+// ContributionDecayJob periodically decays user contribution scores
+type ContributionDecayJob struct {
+	service Service
+}
+
+// NewContributionDecayJob creates a new contribution decay job
+func NewContributionDecayJob(service Service) *ContributionDecayJob {
+	return &ContributionDecayJob{
+		service: service,
+	}
+}
+
+// JobType returns the worker pool job type (implements worker.TypedJob interface)
+func (j *ContributionDecayJob) JobType() string {
+	return ContributionDecayJobType
+}
+
+// Process runs one decay pass (implements worker.Job interface)
+func (j *ContributionDecayJob) Process(ctx context.Context) error {
+	if _, err := j.service.DecayContributions(ctx); err != nil {
+		logger.FromContext(ctx).Error("Failed to decay contribution scores", "error", err)
+		return err
+	}
+	return nil
+}
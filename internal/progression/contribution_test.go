@@ -0,0 +1,113 @@
+package progression
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+)
+
+func TestCatchUpMultiplier(t *testing.T) {
+	tests := []struct {
+		name  string
+		ratio float64
+		want  float64
+	}{
+		{"no score earns the maximum", 0, 3.0},
+		{"halfway to the threshold", 0.25, 2.0},
+		{"at the threshold", 0.5, 1.0},
+		{"above average", 2.0, 1.0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.InDelta(t, tt.want, catchUpMultiplier(tt.ratio, 0.5, 3.0), 0.0001)
+		})
+	}
+}
+
+func TestRecordEngagement_ContributionScores(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("credits the weighted score with no catch-up before anyone has a score", func(t *testing.T) {
+		repo := NewMockRepository()
+		setupTestTree(repo)
+		svc := NewService(repo, NewMockUser(), nil, nil, nil, false)
+
+		require.NoError(t, svc.RecordEngagement(ctx, "user1", domain.MetricTypeCommand, 5))
+
+		assert.InDelta(t, 10.0, repo.contributionScores["user1"], 0.0001)
+	})
+
+	t.Run("users behind the average earn a catch-up bonus on their own score only", func(t *testing.T) {
+		repo := NewMockRepository()
+		setupTestTree(repo)
+		repo.contributionScores["grinder"] = 1000
+		svc := NewService(repo, NewMockUser(), nil, nil, nil, false,
+			WithContributionConfig(ContributionConfig{CatchUpThreshold: 0.5, CatchUpMaxMultiplier: 2.0}))
+
+		require.NoError(t, svc.RecordEngagement(ctx, "newcomer", domain.MetricTypeMessage, 10))
+
+		assert.InDelta(t, 20.0, repo.contributionScores["newcomer"], 0.0001)
+		progress, err := repo.GetActiveUnlockProgress(ctx)
+		require.NoError(t, err)
+		require.NotNil(t, progress)
+		assert.Equal(t, 10, progress.ContributionsAccumulated)
+	})
+
+	t.Run("catch-up disabled credits the plain score", func(t *testing.T) {
+		repo := NewMockRepository()
+		setupTestTree(repo)
+		repo.contributionScores["grinder"] = 1000
+		svc := NewService(repo, NewMockUser(), nil, nil, nil, false,
+			WithContributionConfig(ContributionConfig{}))
+
+		require.NoError(t, svc.RecordEngagement(ctx, "newcomer", domain.MetricTypeMessage, 10))
+
+		assert.InDelta(t, 10.0, repo.contributionScores["newcomer"], 0.0001)
+	})
+
+	t.Run("disabled gains credit nothing", func(t *testing.T) {
+		repo := NewMockRepository()
+		setupTestTree(repo)
+		svc := NewService(repo, NewMockUser(), nil, nil, nil, true)
+
+		require.NoError(t, svc.RecordEngagement(ctx, "user1", domain.MetricTypeMessage, 10))
+
+		assert.Empty(t, repo.contributionScores)
+	})
+}
+
+func TestDecayContributions(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("keeps the remaining share and drops negligible scores", func(t *testing.T) {
+		repo := NewMockRepository()
+		repo.contributionScores["grinder"] = 1000
+		repo.contributionScores["lurker"] = 1
+		svc := NewService(repo, NewMockUser(), nil, nil, nil, false,
+			WithContributionConfig(ContributionConfig{DecayRate: 0.1}))
+
+		result, err := svc.DecayContributions(ctx)
+
+		require.NoError(t, err)
+		assert.Equal(t, &domain.ContributionDecayResult{Decayed: 2, Removed: 1}, result)
+		assert.InDelta(t, 900.0, repo.contributionScores["grinder"], 0.0001)
+		assert.NotContains(t, repo.contributionScores, "lurker")
+	})
+
+	t.Run("zero rate leaves scores alone", func(t *testing.T) {
+		repo := NewMockRepository()
+		repo.contributionScores["grinder"] = 1000
+		svc := NewService(repo, NewMockUser(), nil, nil, nil, false,
+			WithContributionConfig(ContributionConfig{}))
+
+		result, err := svc.DecayContributions(ctx)
+
+		require.NoError(t, err)
+		assert.Zero(t, result.Decayed)
+		assert.InDelta(t, 1000.0, repo.contributionScores["grinder"], 0.0001)
+	})
+}
@@ -0,0 +1,170 @@
+package progression
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/logger"
+	"github.com/osse101/BrandishBot_Go/internal/repository"
+)
+
+// EffectsApplier holds the combined effects of the unlocked nodes. Feature
+// and item checks consult it before falling back to looking the name up as a
+// node key, so a node can enable any flag or item its effects list.
+type EffectsApplier struct {
+	mu     sync.RWMutex
+	loaded bool
+
+	// Declared by any node, unlocked or not
+	declaredFeatures map[string]bool
+	declaredItems    map[string]bool
+
+	// Enabled by unlocked nodes
+	features     map[string]bool
+	items        map[string]bool
+	configDeltas map[string]float64
+}
+
+// NewEffectsApplier creates an applier that loads effects on first use
+func NewEffectsApplier() *EffectsApplier {
+	return &EffectsApplier{}
+}
+
+// Apply rebuilds the active effects from the nodes' declared effects and
+// their unlock levels. It runs whenever a node is unlocked or relocked.
+func (a *EffectsApplier) Apply(ctx context.Context, repo repository.Progression) error {
+	effectsByNode, err := repo.GetAllNodeEffects(ctx)
+	if err != nil {
+		return err
+	}
+	unlocks, err := repo.GetAllUnlocks(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get unlocks: %w", err)
+	}
+	levels := make(map[int]int, len(unlocks))
+	for _, unlock := range unlocks {
+		levels[unlock.NodeID] = max(levels[unlock.NodeID], unlock.CurrentLevel)
+	}
+
+	declaredFeatures := make(map[string]bool)
+	declaredItems := make(map[string]bool)
+	features := make(map[string]bool)
+	items := make(map[string]bool)
+	configDeltas := make(map[string]float64)
+
+	for nodeID, data := range effectsByNode {
+		effects, err := decodeNodeEffects(data)
+		if err != nil {
+			return fmt.Errorf("node %d: %w", nodeID, err)
+		}
+		level := levels[nodeID]
+
+		for _, feature := range effects.Features {
+			declaredFeatures[feature] = true
+			if level > 0 {
+				features[feature] = true
+			}
+		}
+		for _, item := range effects.Items {
+			declaredItems[item] = true
+			if level > 0 {
+				items[item] = true
+			}
+		}
+		for _, delta := range effects.ConfigDeltas {
+			configDeltas[delta.Key] += delta.Delta * float64(level)
+		}
+	}
+
+	a.mu.Lock()
+	a.declaredFeatures = declaredFeatures
+	a.declaredItems = declaredItems
+	a.features = features
+	a.items = items
+	a.configDeltas = configDeltas
+	a.loaded = true
+	a.mu.Unlock()
+	return nil
+}
+
+// Invalidate makes the next check reload the effects
+func (a *EffectsApplier) Invalidate() {
+	a.mu.Lock()
+	a.loaded = false
+	a.mu.Unlock()
+}
+
+// Feature reports whether a feature flag is enabled, and whether any node
+// declares it at all
+func (a *EffectsApplier) Feature(flag string) (enabled, declared bool) {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	return a.features[flag], a.declaredFeatures[flag]
+}
+
+// Item reports whether an item is enabled, and whether any node declares it
+func (a *EffectsApplier) Item(itemName string) (enabled, declared bool) {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	return a.items[itemName], a.declaredItems[itemName]
+}
+
+// ConfigDelta returns the total delta unlocked nodes add to a feature value
+func (a *EffectsApplier) ConfigDelta(key string) float64 {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	return a.configDeltas[key]
+}
+
+func (a *EffectsApplier) isLoaded() bool {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	return a.loaded
+}
+
+// effects returns the applier, loading it first if an unlock invalidated it
+func (s *service) effects(ctx context.Context) (*EffectsApplier, error) {
+	if s.effectsApplier.isLoaded() {
+		return s.effectsApplier, nil
+	}
+	if err := s.effectsApplier.Apply(ctx, s.repo); err != nil {
+		return nil, fmt.Errorf("failed to apply node effects: %w", err)
+	}
+	return s.effectsApplier, nil
+}
+
+// applyEffects re-applies node effects after an unlock or relock. On failure
+// the applier is left to reload on the next check.
+func (s *service) applyEffects(ctx context.Context) {
+	if err := s.effectsApplier.Apply(ctx, s.repo); err != nil {
+		s.effectsApplier.Invalidate()
+		logger.FromContext(ctx).Warn("Failed to apply node effects", "error", err)
+	}
+}
+
+// nodeEffects returns a node's declared effects, or nil if it has none
+func (s *service) nodeEffects(ctx context.Context, nodeID int) (*domain.NodeEffects, error) {
+	data, err := s.repo.GetNodeEffects(ctx, nodeID)
+	if err != nil {
+		return nil, err
+	}
+	effects, err := decodeNodeEffects(data)
+	if err != nil || effects.IsEmpty() {
+		return nil, err
+	}
+	return &effects, nil
+}
+
+func decodeNodeEffects(data []byte) (domain.NodeEffects, error) {
+	var effects domain.NodeEffects
+	if len(data) == 0 {
+		return effects, nil
+	}
+	if err := json.Unmarshal(data, &effects); err != nil {
+		return effects, fmt.Errorf("failed to unmarshal node effects: %w", err)
+	}
+	return effects, nil
+}
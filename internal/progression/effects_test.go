@@ -0,0 +1,128 @@
+package progression
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+)
+
+// setupEffectsNode seeds a node whose effects enable a feature flag, an item
+// and a config delta under names unrelated to its key
+func setupEffectsNode(t *testing.T, repo *MockRepository) int {
+	t.Helper()
+	ctx := context.Background()
+	nodeID, err := repo.InsertNode(ctx, &domain.ProgressionNode{
+		NodeKey: "upgrade_bundle", NodeType: "upgrade", DisplayName: "Bundle", MaxLevel: 3,
+		Tier: 1, Size: "small", Category: "upgrades",
+	})
+	require.NoError(t, err)
+	require.NoError(t, repo.UpdateNodeEffects(ctx, nodeID,
+		[]byte(`{"features":["trading_post"],"items":["revive_medium"],"config_deltas":[{"key":"search_daily_limit","delta":5}]}`)))
+	return nodeID
+}
+
+func TestEffectsApplier(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("declared effects are off until the node unlocks", func(t *testing.T) {
+		repo := NewMockRepository()
+		setupEffectsNode(t, repo)
+		svc := NewService(repo, NewMockUser(), nil, nil, nil, false)
+
+		feature, err := svc.IsFeatureUnlocked(ctx, "trading_post")
+		require.NoError(t, err)
+		assert.False(t, feature)
+		item, err := svc.IsItemUnlocked(ctx, domain.ItemReviveMedium)
+		require.NoError(t, err)
+		assert.False(t, item)
+		value, err := svc.GetModifiedValue(ctx, "", "search_daily_limit", 10)
+		require.NoError(t, err)
+		assert.Equal(t, 10.0, value)
+	})
+
+	t.Run("unlocking the node applies its effects", func(t *testing.T) {
+		repo := NewMockRepository()
+		nodeID := setupEffectsNode(t, repo)
+		svc := NewService(repo, NewMockUser(), nil, nil, nil, false)
+		require.NoError(t, repo.UnlockNode(ctx, nodeID, 2, "test", 0))
+		svc.InvalidateUnlockCacheForTest()
+
+		feature, err := svc.IsFeatureUnlocked(ctx, "trading_post")
+		require.NoError(t, err)
+		assert.True(t, feature)
+		items, err := svc.AreItemsUnlocked(ctx, []string{domain.ItemReviveMedium})
+		require.NoError(t, err)
+		assert.True(t, items[domain.ItemReviveMedium])
+		value, err := svc.GetModifiedValue(ctx, "", "search_daily_limit", 10)
+		require.NoError(t, err)
+		assert.Equal(t, 20.0, value, "delta applies once per unlocked level")
+	})
+
+	t.Run("undeclared keys fall back to node keys", func(t *testing.T) {
+		repo := NewMockRepository()
+		setupEffectsNode(t, repo)
+		nodeID, err := repo.InsertNode(ctx, &domain.ProgressionNode{
+			NodeKey: FeatureGamble, NodeType: "feature", DisplayName: "Gamble", MaxLevel: 1,
+			Tier: 1, Size: "small", Category: "gambling",
+		})
+		require.NoError(t, err)
+		require.NoError(t, repo.UnlockNode(ctx, nodeID, 1, "test", 0))
+		svc := NewService(repo, NewMockUser(), nil, nil, nil, false)
+
+		unlocked, err := svc.IsFeatureUnlocked(ctx, FeatureGamble)
+
+		require.NoError(t, err)
+		assert.True(t, unlocked)
+	})
+}
+
+func TestGetProgressionTree_IncludesEffects(t *testing.T) {
+	ctx := context.Background()
+	repo := NewMockRepository()
+	nodeID := setupEffectsNode(t, repo)
+	svc := NewService(repo, NewMockUser(), nil, nil, nil, false)
+
+	tree, err := svc.GetProgressionTree(ctx)
+
+	require.NoError(t, err)
+	require.Len(t, tree, 1)
+	assert.Equal(t, nodeID, tree[0].ID)
+	require.NotNil(t, tree[0].Effects)
+	assert.Equal(t, []string{"trading_post"}, tree[0].Effects.Features)
+	assert.Equal(t, []domain.ConfigDelta{{Key: "search_daily_limit", Delta: 5}}, tree[0].Effects.ConfigDeltas)
+}
+
+func TestPatchTree_Effects(t *testing.T) {
+	ctx := context.Background()
+	repo := NewMockRepository()
+	setupEditorTree(repo)
+	svc := NewService(repo, NewMockUser(), nil, nil, nil, false)
+	withEffects := `{"key": "item_money", "name": "Money", "type": "item", "tier": 1, "size": "small", "category": "economy", "max_level": 1, "prerequisites": ["root"], "sort_order": 1, "effects": {"items": ["money"]}}`
+
+	diff, err := svc.PatchTree(ctx, editorTreeJSON(withEffects), false)
+
+	require.NoError(t, err)
+	require.Len(t, diff.Changed, 1)
+	assert.Equal(t, TreeNodeChange{Key: "item_money", Fields: []string{"effects"}}, diff.Changed[0])
+	node, err := repo.GetNodeByKey(ctx, "item_money")
+	require.NoError(t, err)
+	data, err := repo.GetNodeEffects(ctx, node.ID)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"items":["money"]}`, string(data))
+}
+
+func TestValidate_RejectsRepeatedEffects(t *testing.T) {
+	loader := NewTreeLoader()
+	config := &TreeConfig{Nodes: []NodeConfig{{
+		Key: "root", Name: "Root", Type: "feature", Tier: 0, Size: "small", Category: "core", MaxLevel: 1,
+		Effects: &domain.NodeEffects{Features: []string{"root", "root"}},
+	}}}
+
+	err := loader.Validate(config)
+
+	assert.ErrorIs(t, err, ErrInvalidConfig)
+}
@@ -3,6 +3,7 @@ package progression
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"time"
 
 	"github.com/osse101/BrandishBot_Go/internal/domain"
@@ -110,6 +111,15 @@ func (s *service) calculateAndAddScore(ctx context.Context, userID string, value
 		modifiedScore *= scholarMultiplier
 	}
 
+	// The catch-up bonus only lifts the user's own score, not the community progress
+	catchUp := s.contributionCatchUp(ctx, userID)
+	if catchUp > 1.0 {
+		logger.FromContext(ctx).Debug("Applying contribution catch-up",
+			"user_id", userID,
+			"multiplier", catchUp)
+	}
+	s.creditUserContribution(ctx, userID, modifiedScore*catchUp)
+
 	score := int(modifiedScore)
 	if score > 0 {
 		return s.AddContribution(ctx, score)
@@ -352,3 +362,75 @@ func (s *service) InvalidateWeightCache() {
 	s.cachedWeights = nil
 	s.weightsExpiry = time.Time{} // Zero time = always expired
 }
+
+// externalSourcePattern restricts source tags so they stay readable in
+// metric types and breakdowns
+var externalSourcePattern = regexp.MustCompile(`^[a-z0-9_-]{1,32}$`)
+
+// AddExternalContribution adds contribution points pushed by another system
+// (donation alerts, sub events). The points are recorded under the source's
+// metric type, credited to the given user if one is named, and added to the
+// current unlock. When HourlyCap is set, the amount is trimmed to what the
+// source has left for the rolling hour.
+func (s *service) AddExternalContribution(ctx context.Context, contribution domain.ExternalContribution) (*domain.ExternalContributionResult, error) {
+	log := logger.FromContext(ctx)
+
+	if !externalSourcePattern.MatchString(contribution.Source) {
+		return nil, fmt.Errorf("%w: %q", domain.ErrInvalidSource, contribution.Source)
+	}
+	if contribution.Amount <= 0 {
+		return nil, fmt.Errorf("%w: amount must be positive", domain.ErrInvalidInput)
+	}
+
+	userID := domain.ExternalContributorPrefix + contribution.Source
+	if contribution.Platform != "" {
+		user, err := s.resolveUserByPlatform(ctx, contribution.Platform, contribution.PlatformID, contribution.Username)
+		if err != nil {
+			// The contribution still counts; it just isn't credited to anyone
+			log.Warn("External contribution user not resolved, crediting source", "source", contribution.Source, "platform", contribution.Platform, "platformID", contribution.PlatformID, "error", err)
+		} else {
+			userID = user.ID
+		}
+	}
+
+	metricType := domain.ExternalMetricType(contribution.Source)
+	result := &domain.ExternalContributionResult{
+		Source:    contribution.Source,
+		Requested: contribution.Amount,
+		Accepted:  contribution.Amount,
+	}
+
+	metric := &domain.EngagementMetric{
+		UserID:      userID,
+		MetricType:  metricType,
+		MetricValue: contribution.Amount,
+		RecordedAt:  time.Now(),
+	}
+
+	if contribution.HourlyCap > 0 {
+		accepted, err := s.repo.RecordCappedEngagement(ctx, metric, contribution.HourlyCap, time.Now().Add(-time.Hour))
+		if err != nil {
+			return nil, fmt.Errorf("failed to record external contribution: %w", err)
+		}
+		if accepted == 0 {
+			return nil, domain.ErrExternalCapReached
+		}
+		result.Accepted = accepted
+		result.Capped = accepted < contribution.Amount
+	} else if err := s.repo.RecordEngagement(ctx, metric); err != nil {
+		return nil, err
+	}
+
+	if s.disableGains {
+		log.Debug("Progression gains disabled; external contribution recorded but not applied", "source", contribution.Source)
+		return result, nil
+	}
+
+	if err := s.AddContribution(ctx, result.Accepted); err != nil {
+		return nil, fmt.Errorf("failed to add external contribution: %w", err)
+	}
+	s.creditUserContribution(ctx, userID, float64(result.Accepted))
+
+	log.Info("External contribution added", "source", contribution.Source, "userID", userID, "requested", result.Requested, "accepted", result.Accepted)
+	return result, nil
+}
@@ -0,0 +1,116 @@
+package progression
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+)
+
+func TestAddExternalContribution(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("credits the resolved user and adds to unlock progress", func(t *testing.T) {
+		repo := NewMockRepository()
+		setupTestTree(repo)
+		svc := NewService(repo, NewMockUser(), nil, nil, nil, false)
+
+		result, err := svc.AddExternalContribution(ctx, domain.ExternalContribution{
+			Source:     "donations",
+			Amount:     40,
+			Platform:   domain.PlatformDiscord,
+			PlatformID: "user1",
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, 40, result.Accepted)
+		assert.False(t, result.Capped)
+
+		breakdown, err := repo.GetUserEngagement(ctx, "test-user-1")
+		require.NoError(t, err)
+		assert.Equal(t, 40, breakdown.External["donations"])
+
+		progress, err := repo.GetActiveUnlockProgress(ctx)
+		require.NoError(t, err)
+		require.NotNil(t, progress)
+		assert.Equal(t, 40, progress.ContributionsAccumulated)
+	})
+
+	t.Run("credits the source when the user cannot be resolved", func(t *testing.T) {
+		repo := NewMockRepository()
+		setupTestTree(repo)
+		svc := NewService(repo, NewMockUser(), nil, nil, nil, false)
+
+		_, err := svc.AddExternalContribution(ctx, domain.ExternalContribution{
+			Source:     "donations",
+			Amount:     10,
+			Platform:   domain.PlatformDiscord,
+			PlatformID: "unknown",
+		})
+
+		require.NoError(t, err)
+		breakdown, err := repo.GetUserEngagement(ctx, domain.ExternalContributorPrefix+"donations")
+		require.NoError(t, err)
+		assert.Equal(t, 10, breakdown.External["donations"])
+	})
+
+	t.Run("trims to the remaining hourly cap then rejects", func(t *testing.T) {
+		repo := NewMockRepository()
+		setupTestTree(repo)
+		svc := NewService(repo, NewMockUser(), nil, nil, nil, false)
+		contribution := domain.ExternalContribution{Source: "subs", Amount: 60, HourlyCap: 100}
+
+		first, err := svc.AddExternalContribution(ctx, contribution)
+		require.NoError(t, err)
+		assert.Equal(t, 60, first.Accepted)
+
+		second, err := svc.AddExternalContribution(ctx, contribution)
+		require.NoError(t, err)
+		assert.Equal(t, 40, second.Accepted)
+		assert.True(t, second.Capped)
+
+		_, err = svc.AddExternalContribution(ctx, contribution)
+		assert.ErrorIs(t, err, domain.ErrExternalCapReached)
+	})
+
+	t.Run("caps are tracked per source", func(t *testing.T) {
+		repo := NewMockRepository()
+		setupTestTree(repo)
+		svc := NewService(repo, NewMockUser(), nil, nil, nil, false)
+
+		_, err := svc.AddExternalContribution(ctx, domain.ExternalContribution{Source: "subs", Amount: 50, HourlyCap: 50})
+		require.NoError(t, err)
+
+		result, err := svc.AddExternalContribution(ctx, domain.ExternalContribution{Source: "bits", Amount: 50, HourlyCap: 50})
+		require.NoError(t, err)
+		assert.Equal(t, 50, result.Accepted)
+	})
+
+	t.Run("rejects invalid source and amount", func(t *testing.T) {
+		repo := NewMockRepository()
+		svc := NewService(repo, NewMockUser(), nil, nil, nil, false)
+
+		_, err := svc.AddExternalContribution(ctx, domain.ExternalContribution{Source: "Bad Source!", Amount: 5})
+		assert.ErrorIs(t, err, domain.ErrInvalidSource)
+
+		_, err = svc.AddExternalContribution(ctx, domain.ExternalContribution{Source: "donations", Amount: 0})
+		assert.ErrorIs(t, err, domain.ErrInvalidInput)
+	})
+
+	t.Run("records but does not apply when gains are disabled", func(t *testing.T) {
+		repo := NewMockRepository()
+		setupTestTree(repo)
+		svc := NewService(repo, NewMockUser(), nil, nil, nil, true)
+
+		result, err := svc.AddExternalContribution(ctx, domain.ExternalContribution{Source: "donations", Amount: 25})
+		require.NoError(t, err)
+		assert.Equal(t, 25, result.Accepted)
+
+		progress, err := repo.GetActiveUnlockProgress(ctx)
+		require.NoError(t, err)
+		assert.Nil(t, progress)
+	})
+}
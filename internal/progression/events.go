@@ -3,19 +3,33 @@ package progression
 import (
 	"context"
 
+	"github.com/osse101/BrandishBot_Go/internal/community"
 	"github.com/osse101/BrandishBot_Go/internal/domain"
 	"github.com/osse101/BrandishBot_Go/internal/event"
 	"github.com/osse101/BrandishBot_Go/internal/logger"
 )
 
+// ownsEvent reports whether an event handled in ctx belongs to this
+// instance's community
+func (s *service) ownsEvent(ctx context.Context) bool {
+	return s.community == "" || community.FromContext(ctx) == s.community
+}
+
 // handleNodeUnlocked invalidates caches when any node is unlocked
 func (s *service) handleNodeUnlocked(ctx context.Context, e event.Event) error {
+	if !s.ownsEvent(ctx) {
+		return nil
+	}
+
 	// Invalidate modifier cache - values may have changed
 	s.modifierCache.InvalidateAll()
 
 	// Invalidate unlock cache - new features may be available
 	s.unlockCache.InvalidateAll()
 
+	// Apply the effects the node declares
+	s.applyEffects(ctx)
+
 	log := logger.FromContext(ctx)
 	if payload, ok := e.Payload.(map[string]interface{}); ok {
 		log.Info("Invalidated caches due to node unlock",
@@ -27,12 +41,19 @@ func (s *service) handleNodeUnlocked(ctx context.Context, e event.Event) error {
 
 // handleNodeRelocked invalidates caches when any node is relocked
 func (s *service) handleNodeRelocked(ctx context.Context, e event.Event) error {
+	if !s.ownsEvent(ctx) {
+		return nil
+	}
+
 	// Invalidate modifier cache - values have changed
 	s.modifierCache.InvalidateAll()
 
 	// Invalidate unlock cache - features may no longer be available
 	s.unlockCache.InvalidateAll()
 
+	// Withdraw the effects the node declared
+	s.applyEffects(ctx)
+
 	log := logger.FromContext(ctx)
 	if payload, ok := e.Payload.(map[string]interface{}); ok {
 		log.Info("Invalidated caches due to node relock",
@@ -42,8 +63,27 @@ func (s *service) handleNodeRelocked(ctx context.Context, e event.Event) error {
 	return nil
 }
 
+// handleSnapshotRestored drops every cache after a restore, since the whole
+// unlock tree may have changed underneath them. A restore covers every
+// community, so each instance reloads its own.
+func (s *service) handleSnapshotRestored(ctx context.Context, _ event.Event) error {
+	if s.community != "" {
+		ctx = community.WithID(ctx, s.community)
+	}
+	s.modifierCache.InvalidateAll()
+	s.unlockCache.InvalidateAll()
+	s.applyEffects(ctx)
+
+	logger.FromContext(ctx).Info("Invalidated caches due to snapshot restore")
+	return nil
+}
+
 // handleEngagement records engagement metrics from events
 func (s *service) handleEngagement(ctx context.Context, e event.Event) error {
+	if !s.ownsEvent(ctx) {
+		return nil
+	}
+
 	// Skip if already recorded by the service to prevent infinite loop
 	if recorded, ok := e.GetMetadataValue(domain.MetadataKeyRecorded).(bool); ok && recorded {
 		return nil
@@ -5,6 +5,8 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
 )
 
 // Additional tests to reach 70% coverage target
@@ -131,3 +133,45 @@ func TestGetProgressionStatus_WithActiveSession(t *testing.T) {
 	assert.NotNil(t, status)
 	assert.NotNil(t, status.ActiveSession)
 }
+
+// GetVotingSessionHistory Tests
+func TestGetVotingSessionHistory(t *testing.T) {
+	repo := NewMockRepository()
+	setupTestTree(repo)
+	service := NewService(repo, NewMockUser(), nil, nil, nil, false)
+	ctx := context.Background()
+
+	service.StartVotingSession(ctx, nil)
+	service.VoteForUnlock(ctx, domain.PlatformDiscord, "user1", "user1", 1)
+	service.VoteForUnlock(ctx, domain.PlatformDiscord, "user2", "user2", 1)
+	winner, err := service.EndVoting(ctx)
+	assert.NoError(t, err)
+
+	progress, _ := repo.GetActiveUnlockProgress(ctx)
+	node, _ := repo.GetNodeByID(ctx, winner.NodeID)
+	service.AddContribution(ctx, node.UnlockCost-progress.ContributionsAccumulated)
+	_, err = service.CheckAndUnlockNode(ctx)
+	assert.NoError(t, err)
+
+	history, err := service.GetVotingSessionHistory(ctx, 0, -5)
+	assert.NoError(t, err)
+	assert.Equal(t, 10, history.Limit, "invalid limit falls back to the default")
+	assert.Equal(t, 0, history.Offset)
+	assert.Equal(t, 1, history.Total)
+	if assert.Len(t, history.Sessions, 1) {
+		recap := history.Sessions[0]
+		assert.Equal(t, 2, recap.TotalVotes)
+		if assert.NotNil(t, recap.Winner) {
+			assert.Equal(t, winner.NodeID, recap.Winner.NodeID)
+		}
+		if assert.NotNil(t, recap.UnlockedNode) {
+			assert.Equal(t, winner.NodeID, recap.UnlockedNode.ID)
+		}
+		assert.NotNil(t, recap.UnlockedAt)
+	}
+
+	page, err := service.GetVotingSessionHistory(ctx, 10, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, page.Total)
+	assert.Empty(t, page.Sessions)
+}
@@ -31,6 +31,7 @@ const (
 	ItemXpRarecandy   = "xp_rarecandy"
 
 	// Features
+	FeatureBank           = "feature_bank"
 	FeatureCompost        = "feature_compost"
 	FeatureDisassemble    = "feature_disassemble"
 	FeatureDuel           = "feature_duel"
@@ -38,7 +39,9 @@ const (
 	FeatureEvents         = "feature_events"
 	FeatureExpedition     = "feature_expedition"
 	FeatureFarming        = "feature_farming"
+	FeatureFishing        = "feature_fishing"
 	FeatureGamble         = "feature_gamble"
+	FeatureGarden         = "feature_garden"
 	FeatureSearch         = "feature_search"
 	FeatureSlots          = "feature_slots"
 	FeatureUpgrade        = "feature_upgrade"
@@ -49,22 +52,96 @@ const (
 	FeatureTier4          = "tier_4"
 
 	// Upgrades
-	UpgradeCrafting1        = "upgrade_crafting_1"
-	UpgradeEconomy1         = "upgrade_economy_1"
-	UpgradeExploration1     = "upgrade_exploration_1"
-	UpgradeFarming1         = "upgrade_farming_1"
-	UpgradeGambleWinBonus   = "upgrade_gamble_win_bonus"
-	UpgradeJobLevelCap      = "upgrade_job_level_cap"
-	UpgradeJobXpMultiplier  = "upgrade_job_xp_multiplier"
-	UpgradeProgressionBasic = "upgrade_progression_basic"
-	UpgradeProgressionThree = "upgrade_progression_three"
-	UpgradeProgressionTwo   = "upgrade_progression_two"
+	UpgradeCooldownReduction = "upgrade_cooldown_reduction"
+	UpgradeCrafting1         = "upgrade_crafting_1"
+	UpgradeEconomy1          = "upgrade_economy_1"
+	UpgradeExploration1      = "upgrade_exploration_1"
+	UpgradeFarming1          = "upgrade_farming_1"
+	UpgradeGambleWinBonus    = "upgrade_gamble_win_bonus"
+	UpgradeGardenPlots       = "upgrade_garden_plots"
+	UpgradeJobLevelCap       = "upgrade_job_level_cap"
+	UpgradeJobXpMultiplier   = "upgrade_job_xp_multiplier"
+	UpgradeProgressionBasic  = "upgrade_progression_basic"
+	UpgradeProgressionThree  = "upgrade_progression_three"
+	UpgradeProgressionTwo    = "upgrade_progression_two"
+	UpgradeStash1            = "upgrade_stash_1"
+	UpgradeStash2            = "upgrade_stash_2"
+	UpgradeStash3            = "upgrade_stash_3"
 
 	// Jobs
 	JobBlacksmith = "job_blacksmith"
 	JobExplorer   = "job_explorer"
 	JobFarmer     = "job_farmer"
+	JobFisher     = "job_fisher"
 	JobGambler    = "job_gambler"
 	JobMerchant   = "job_merchant"
 	JobScholar    = "job_scholar"
 )
+
+// GeneratedKeys lists every key above, sorted.
+var GeneratedKeys = []string{
+	"feature_bank",
+	"feature_compost",
+	"feature_disassemble",
+	"feature_duel",
+	"feature_economy",
+	"feature_events",
+	"feature_expedition",
+	"feature_farming",
+	"feature_fishing",
+	"feature_gamble",
+	"feature_garden",
+	"feature_search",
+	"feature_slots",
+	"feature_upgrade",
+	"feature_weekly_discount",
+	"feature_weekly_quests",
+	"item_bomb",
+	"item_grenade",
+	"item_hugemissile",
+	"item_lootbox0",
+	"item_lootbox1",
+	"item_lootbox2",
+	"item_lootbox3",
+	"item_mine",
+	"item_money",
+	"item_revives",
+	"item_scrap",
+	"item_script",
+	"item_shield",
+	"item_shovel",
+	"item_stick",
+	"item_this",
+	"item_tnt",
+	"item_trap",
+	"item_video_filter",
+	"job_blacksmith",
+	"job_explorer",
+	"job_farmer",
+	"job_fisher",
+	"job_gambler",
+	"job_merchant",
+	"job_scholar",
+	"progression_system",
+	"tier_2",
+	"tier_3",
+	"tier_4",
+	"upgrade_cooldown_reduction",
+	"upgrade_crafting_1",
+	"upgrade_economy_1",
+	"upgrade_exploration_1",
+	"upgrade_farming_1",
+	"upgrade_gamble_win_bonus",
+	"upgrade_garden_plots",
+	"upgrade_job_level_cap",
+	"upgrade_job_xp_multiplier",
+	"upgrade_progression_basic",
+	"upgrade_progression_three",
+	"upgrade_progression_two",
+	"upgrade_stash_1",
+	"upgrade_stash_2",
+	"upgrade_stash_3",
+	"weapon_mirror",
+	"weapon_missile",
+	"xp_rarecandy",
+}
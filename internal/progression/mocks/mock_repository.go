@@ -74,6 +74,54 @@ func (_c *MockRepository_AddContribution_Call) RunAndReturn(run func(context.Con
 	return _c
 }
 
+// AddUserContributionScore provides a mock function with given fields: ctx, userID, points
+func (_m *MockRepository) AddUserContributionScore(ctx context.Context, userID string, points float64) error {
+	ret := _m.Called(ctx, userID, points)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddUserContributionScore")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, float64) error); ok {
+		r0 = rf(ctx, userID, points)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockRepository_AddUserContributionScore_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddUserContributionScore'
+type MockRepository_AddUserContributionScore_Call struct {
+	*mock.Call
+}
+
+// AddUserContributionScore is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - points float64
+func (_e *MockRepository_Expecter) AddUserContributionScore(ctx interface{}, userID interface{}, points interface{}) *MockRepository_AddUserContributionScore_Call {
+	return &MockRepository_AddUserContributionScore_Call{Call: _e.mock.On("AddUserContributionScore", ctx, userID, points)}
+}
+
+func (_c *MockRepository_AddUserContributionScore_Call) Run(run func(ctx context.Context, userID string, points float64)) *MockRepository_AddUserContributionScore_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(float64))
+	})
+	return _c
+}
+
+func (_c *MockRepository_AddUserContributionScore_Call) Return(_a0 error) *MockRepository_AddUserContributionScore_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockRepository_AddUserContributionScore_Call) RunAndReturn(run func(context.Context, string, float64) error) *MockRepository_AddUserContributionScore_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // AddVotingOption provides a mock function with given fields: ctx, sessionID, nodeID, targetLevel
 func (_m *MockRepository) AddVotingOption(ctx context.Context, sessionID int, nodeID int, targetLevel int) error {
 	ret := _m.Called(ctx, sessionID, nodeID, targetLevel)
@@ -181,17 +229,17 @@ func (_c *MockRepository_BeginTx_Call) RunAndReturn(run func(context.Context) (r
 	return _c
 }
 
-// CheckAndRecordVoteAtomic provides a mock function with given fields: ctx, userID, sessionID, optionID, nodeID, targetLevel
-func (_m *MockRepository) CheckAndRecordVoteAtomic(ctx context.Context, userID string, sessionID int, optionID int, nodeID int, targetLevel int) error {
-	ret := _m.Called(ctx, userID, sessionID, optionID, nodeID, targetLevel)
+// CheckAndRecordVoteAtomic provides a mock function with given fields: ctx, userID, sessionID, optionID, nodeID, targetLevel, weight
+func (_m *MockRepository) CheckAndRecordVoteAtomic(ctx context.Context, userID string, sessionID int, optionID int, nodeID int, targetLevel int, weight int) error {
+	ret := _m.Called(ctx, userID, sessionID, optionID, nodeID, targetLevel, weight)
 
 	if len(ret) == 0 {
 		panic("no return value specified for CheckAndRecordVoteAtomic")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, int, int, int, int) error); ok {
-		r0 = rf(ctx, userID, sessionID, optionID, nodeID, targetLevel)
+	if rf, ok := ret.Get(0).(func(context.Context, string, int, int, int, int, int) error); ok {
+		r0 = rf(ctx, userID, sessionID, optionID, nodeID, targetLevel, weight)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -211,13 +259,14 @@ type MockRepository_CheckAndRecordVoteAtomic_Call struct {
 //   - optionID int
 //   - nodeID int
 //   - targetLevel int
-func (_e *MockRepository_Expecter) CheckAndRecordVoteAtomic(ctx interface{}, userID interface{}, sessionID interface{}, optionID interface{}, nodeID interface{}, targetLevel interface{}) *MockRepository_CheckAndRecordVoteAtomic_Call {
-	return &MockRepository_CheckAndRecordVoteAtomic_Call{Call: _e.mock.On("CheckAndRecordVoteAtomic", ctx, userID, sessionID, optionID, nodeID, targetLevel)}
+//   - weight int
+func (_e *MockRepository_Expecter) CheckAndRecordVoteAtomic(ctx interface{}, userID interface{}, sessionID interface{}, optionID interface{}, nodeID interface{}, targetLevel interface{}, weight interface{}) *MockRepository_CheckAndRecordVoteAtomic_Call {
+	return &MockRepository_CheckAndRecordVoteAtomic_Call{Call: _e.mock.On("CheckAndRecordVoteAtomic", ctx, userID, sessionID, optionID, nodeID, targetLevel, weight)}
 }
 
-func (_c *MockRepository_CheckAndRecordVoteAtomic_Call) Run(run func(ctx context.Context, userID string, sessionID int, optionID int, nodeID int, targetLevel int)) *MockRepository_CheckAndRecordVoteAtomic_Call {
+func (_c *MockRepository_CheckAndRecordVoteAtomic_Call) Run(run func(ctx context.Context, userID string, sessionID int, optionID int, nodeID int, targetLevel int, weight int)) *MockRepository_CheckAndRecordVoteAtomic_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string), args[2].(int), args[3].(int), args[4].(int), args[5].(int))
+		run(args[0].(context.Context), args[1].(string), args[2].(int), args[3].(int), args[4].(int), args[5].(int), args[6].(int))
 	})
 	return _c
 }
@@ -227,7 +276,7 @@ func (_c *MockRepository_CheckAndRecordVoteAtomic_Call) Return(_a0 error) *MockR
 	return _c
 }
 
-func (_c *MockRepository_CheckAndRecordVoteAtomic_Call) RunAndReturn(run func(context.Context, string, int, int, int, int) error) *MockRepository_CheckAndRecordVoteAtomic_Call {
+func (_c *MockRepository_CheckAndRecordVoteAtomic_Call) RunAndReturn(run func(context.Context, string, int, int, int, int, int) error) *MockRepository_CheckAndRecordVoteAtomic_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -572,6 +621,123 @@ func (_c *MockRepository_CreateVotingSessionWithOptions_Call) RunAndReturn(run f
 	return _c
 }
 
+// DecayContributionScores provides a mock function with given fields: ctx, retained, floor
+func (_m *MockRepository) DecayContributionScores(ctx context.Context, retained float64, floor float64) (*domain.ContributionDecayResult, error) {
+	ret := _m.Called(ctx, retained, floor)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DecayContributionScores")
+	}
+
+	var r0 *domain.ContributionDecayResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, float64, float64) (*domain.ContributionDecayResult, error)); ok {
+		return rf(ctx, retained, floor)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, float64, float64) *domain.ContributionDecayResult); ok {
+		r0 = rf(ctx, retained, floor)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.ContributionDecayResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, float64, float64) error); ok {
+		r1 = rf(ctx, retained, floor)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRepository_DecayContributionScores_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DecayContributionScores'
+type MockRepository_DecayContributionScores_Call struct {
+	*mock.Call
+}
+
+// DecayContributionScores is a helper method to define mock.On call
+//   - ctx context.Context
+//   - retained float64
+//   - floor float64
+func (_e *MockRepository_Expecter) DecayContributionScores(ctx interface{}, retained interface{}, floor interface{}) *MockRepository_DecayContributionScores_Call {
+	return &MockRepository_DecayContributionScores_Call{Call: _e.mock.On("DecayContributionScores", ctx, retained, floor)}
+}
+
+func (_c *MockRepository_DecayContributionScores_Call) Run(run func(ctx context.Context, retained float64, floor float64)) *MockRepository_DecayContributionScores_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(float64), args[2].(float64))
+	})
+	return _c
+}
+
+func (_c *MockRepository_DecayContributionScores_Call) Return(_a0 *domain.ContributionDecayResult, _a1 error) *MockRepository_DecayContributionScores_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRepository_DecayContributionScores_Call) RunAndReturn(run func(context.Context, float64, float64) (*domain.ContributionDecayResult, error)) *MockRepository_DecayContributionScores_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteVoteDelegation provides a mock function with given fields: ctx, delegatorID
+func (_m *MockRepository) DeleteVoteDelegation(ctx context.Context, delegatorID string) (bool, error) {
+	ret := _m.Called(ctx, delegatorID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteVoteDelegation")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (bool, error)); ok {
+		return rf(ctx, delegatorID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) bool); ok {
+		r0 = rf(ctx, delegatorID)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, delegatorID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRepository_DeleteVoteDelegation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteVoteDelegation'
+type MockRepository_DeleteVoteDelegation_Call struct {
+	*mock.Call
+}
+
+// DeleteVoteDelegation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - delegatorID string
+func (_e *MockRepository_Expecter) DeleteVoteDelegation(ctx interface{}, delegatorID interface{}) *MockRepository_DeleteVoteDelegation_Call {
+	return &MockRepository_DeleteVoteDelegation_Call{Call: _e.mock.On("DeleteVoteDelegation", ctx, delegatorID)}
+}
+
+func (_c *MockRepository_DeleteVoteDelegation_Call) Run(run func(ctx context.Context, delegatorID string)) *MockRepository_DeleteVoteDelegation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockRepository_DeleteVoteDelegation_Call) Return(_a0 bool, _a1 error) *MockRepository_DeleteVoteDelegation_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRepository_DeleteVoteDelegation_Call) RunAndReturn(run func(context.Context, string) (bool, error)) *MockRepository_DeleteVoteDelegation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // EndVotingSession provides a mock function with given fields: ctx, sessionID, winningOptionID
 func (_m *MockRepository) EndVotingSession(ctx context.Context, sessionID int, winningOptionID *int) error {
 	ret := _m.Called(ctx, sessionID, winningOptionID)
@@ -899,6 +1065,64 @@ func (_c *MockRepository_GetAllBonusModifiers_Call) RunAndReturn(run func(contex
 	return _c
 }
 
+// GetAllNodeEffects provides a mock function with given fields: ctx
+func (_m *MockRepository) GetAllNodeEffects(ctx context.Context) (map[int][]byte, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAllNodeEffects")
+	}
+
+	var r0 map[int][]byte
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (map[int][]byte, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) map[int][]byte); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[int][]byte)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRepository_GetAllNodeEffects_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAllNodeEffects'
+type MockRepository_GetAllNodeEffects_Call struct {
+	*mock.Call
+}
+
+// GetAllNodeEffects is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockRepository_Expecter) GetAllNodeEffects(ctx interface{}) *MockRepository_GetAllNodeEffects_Call {
+	return &MockRepository_GetAllNodeEffects_Call{Call: _e.mock.On("GetAllNodeEffects", ctx)}
+}
+
+func (_c *MockRepository_GetAllNodeEffects_Call) Run(run func(ctx context.Context)) *MockRepository_GetAllNodeEffects_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockRepository_GetAllNodeEffects_Call) Return(_a0 map[int][]byte, _a1 error) *MockRepository_GetAllNodeEffects_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRepository_GetAllNodeEffects_Call) RunAndReturn(run func(context.Context) (map[int][]byte, error)) *MockRepository_GetAllNodeEffects_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetAllNodes provides a mock function with given fields: ctx
 func (_m *MockRepository) GetAllNodes(ctx context.Context) ([]*domain.ProgressionNode, error) {
 	ret := _m.Called(ctx)
@@ -1083,6 +1307,62 @@ func (_c *MockRepository_GetAllUnlocks_Call) RunAndReturn(run func(context.Conte
 	return _c
 }
 
+// GetAverageContributionScore provides a mock function with given fields: ctx
+func (_m *MockRepository) GetAverageContributionScore(ctx context.Context) (float64, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAverageContributionScore")
+	}
+
+	var r0 float64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (float64, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) float64); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(float64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRepository_GetAverageContributionScore_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAverageContributionScore'
+type MockRepository_GetAverageContributionScore_Call struct {
+	*mock.Call
+}
+
+// GetAverageContributionScore is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockRepository_Expecter) GetAverageContributionScore(ctx interface{}) *MockRepository_GetAverageContributionScore_Call {
+	return &MockRepository_GetAverageContributionScore_Call{Call: _e.mock.On("GetAverageContributionScore", ctx)}
+}
+
+func (_c *MockRepository_GetAverageContributionScore_Call) Run(run func(ctx context.Context)) *MockRepository_GetAverageContributionScore_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockRepository_GetAverageContributionScore_Call) Return(_a0 float64, _a1 error) *MockRepository_GetAverageContributionScore_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRepository_GetAverageContributionScore_Call) RunAndReturn(run func(context.Context) (float64, error)) *MockRepository_GetAverageContributionScore_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetBonusModifiers provides a mock function with given fields: ctx, featureKey
 func (_m *MockRepository) GetBonusModifiers(ctx context.Context, featureKey string) ([]domain.ModifierConfig, error) {
 	ret := _m.Called(ctx, featureKey)
@@ -1142,6 +1422,73 @@ func (_c *MockRepository_GetBonusModifiers_Call) RunAndReturn(run func(context.C
 	return _c
 }
 
+// GetCompletedSessions provides a mock function with given fields: ctx, limit, offset
+func (_m *MockRepository) GetCompletedSessions(ctx context.Context, limit int, offset int) ([]domain.VotingSessionRecap, int, error) {
+	ret := _m.Called(ctx, limit, offset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCompletedSessions")
+	}
+
+	var r0 []domain.VotingSessionRecap
+	var r1 int
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, int) ([]domain.VotingSessionRecap, int, error)); ok {
+		return rf(ctx, limit, offset)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, int) []domain.VotingSessionRecap); ok {
+		r0 = rf(ctx, limit, offset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.VotingSessionRecap)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, int) int); ok {
+		r1 = rf(ctx, limit, offset)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, int, int) error); ok {
+		r2 = rf(ctx, limit, offset)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockRepository_GetCompletedSessions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCompletedSessions'
+type MockRepository_GetCompletedSessions_Call struct {
+	*mock.Call
+}
+
+// GetCompletedSessions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - limit int
+//   - offset int
+func (_e *MockRepository_Expecter) GetCompletedSessions(ctx interface{}, limit interface{}, offset interface{}) *MockRepository_GetCompletedSessions_Call {
+	return &MockRepository_GetCompletedSessions_Call{Call: _e.mock.On("GetCompletedSessions", ctx, limit, offset)}
+}
+
+func (_c *MockRepository_GetCompletedSessions_Call) Run(run func(ctx context.Context, limit int, offset int)) *MockRepository_GetCompletedSessions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *MockRepository_GetCompletedSessions_Call) Return(_a0 []domain.VotingSessionRecap, _a1 int, _a2 error) *MockRepository_GetCompletedSessions_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockRepository_GetCompletedSessions_Call) RunAndReturn(run func(context.Context, int, int) ([]domain.VotingSessionRecap, int, error)) *MockRepository_GetCompletedSessions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetContributionLeaderboard provides a mock function with given fields: ctx, limit
 func (_m *MockRepository) GetContributionLeaderboard(ctx context.Context, limit int) ([]domain.ContributionLeaderboardEntry, error) {
 	ret := _m.Called(ctx, limit)
@@ -1794,24 +2141,24 @@ func (_c *MockRepository_GetNodeDynamicPrerequisites_Call) RunAndReturn(run func
 	return _c
 }
 
-// GetPrerequisites provides a mock function with given fields: ctx, nodeID
-func (_m *MockRepository) GetPrerequisites(ctx context.Context, nodeID int) ([]*domain.ProgressionNode, error) {
+// GetNodeEffects provides a mock function with given fields: ctx, nodeID
+func (_m *MockRepository) GetNodeEffects(ctx context.Context, nodeID int) ([]byte, error) {
 	ret := _m.Called(ctx, nodeID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetPrerequisites")
+		panic("no return value specified for GetNodeEffects")
 	}
 
-	var r0 []*domain.ProgressionNode
+	var r0 []byte
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, int) ([]*domain.ProgressionNode, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, int) ([]byte, error)); ok {
 		return rf(ctx, nodeID)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, int) []*domain.ProgressionNode); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, int) []byte); ok {
 		r0 = rf(ctx, nodeID)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*domain.ProgressionNode)
+			r0 = ret.Get(0).([]byte)
 		}
 	}
 
@@ -1824,16 +2171,134 @@ func (_m *MockRepository) GetPrerequisites(ctx context.Context, nodeID int) ([]*
 	return r0, r1
 }
 
-// MockRepository_GetPrerequisites_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPrerequisites'
-type MockRepository_GetPrerequisites_Call struct {
+// MockRepository_GetNodeEffects_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetNodeEffects'
+type MockRepository_GetNodeEffects_Call struct {
 	*mock.Call
 }
 
-// GetPrerequisites is a helper method to define mock.On call
+// GetNodeEffects is a helper method to define mock.On call
 //   - ctx context.Context
 //   - nodeID int
-func (_e *MockRepository_Expecter) GetPrerequisites(ctx interface{}, nodeID interface{}) *MockRepository_GetPrerequisites_Call {
-	return &MockRepository_GetPrerequisites_Call{Call: _e.mock.On("GetPrerequisites", ctx, nodeID)}
+func (_e *MockRepository_Expecter) GetNodeEffects(ctx interface{}, nodeID interface{}) *MockRepository_GetNodeEffects_Call {
+	return &MockRepository_GetNodeEffects_Call{Call: _e.mock.On("GetNodeEffects", ctx, nodeID)}
+}
+
+func (_c *MockRepository_GetNodeEffects_Call) Run(run func(ctx context.Context, nodeID int)) *MockRepository_GetNodeEffects_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *MockRepository_GetNodeEffects_Call) Return(_a0 []byte, _a1 error) *MockRepository_GetNodeEffects_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRepository_GetNodeEffects_Call) RunAndReturn(run func(context.Context, int) ([]byte, error)) *MockRepository_GetNodeEffects_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPrerequisiteGroups provides a mock function with given fields: ctx, nodeID
+func (_m *MockRepository) GetPrerequisiteGroups(ctx context.Context, nodeID int) ([][]*domain.ProgressionNode, error) {
+	ret := _m.Called(ctx, nodeID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPrerequisiteGroups")
+	}
+
+	var r0 [][]*domain.ProgressionNode
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) ([][]*domain.ProgressionNode, error)); ok {
+		return rf(ctx, nodeID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) [][]*domain.ProgressionNode); ok {
+		r0 = rf(ctx, nodeID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([][]*domain.ProgressionNode)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, nodeID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRepository_GetPrerequisiteGroups_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPrerequisiteGroups'
+type MockRepository_GetPrerequisiteGroups_Call struct {
+	*mock.Call
+}
+
+// GetPrerequisiteGroups is a helper method to define mock.On call
+//   - ctx context.Context
+//   - nodeID int
+func (_e *MockRepository_Expecter) GetPrerequisiteGroups(ctx interface{}, nodeID interface{}) *MockRepository_GetPrerequisiteGroups_Call {
+	return &MockRepository_GetPrerequisiteGroups_Call{Call: _e.mock.On("GetPrerequisiteGroups", ctx, nodeID)}
+}
+
+func (_c *MockRepository_GetPrerequisiteGroups_Call) Run(run func(ctx context.Context, nodeID int)) *MockRepository_GetPrerequisiteGroups_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *MockRepository_GetPrerequisiteGroups_Call) Return(_a0 [][]*domain.ProgressionNode, _a1 error) *MockRepository_GetPrerequisiteGroups_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRepository_GetPrerequisiteGroups_Call) RunAndReturn(run func(context.Context, int) ([][]*domain.ProgressionNode, error)) *MockRepository_GetPrerequisiteGroups_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPrerequisites provides a mock function with given fields: ctx, nodeID
+func (_m *MockRepository) GetPrerequisites(ctx context.Context, nodeID int) ([]*domain.ProgressionNode, error) {
+	ret := _m.Called(ctx, nodeID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPrerequisites")
+	}
+
+	var r0 []*domain.ProgressionNode
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) ([]*domain.ProgressionNode, error)); ok {
+		return rf(ctx, nodeID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) []*domain.ProgressionNode); ok {
+		r0 = rf(ctx, nodeID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.ProgressionNode)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, nodeID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRepository_GetPrerequisites_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPrerequisites'
+type MockRepository_GetPrerequisites_Call struct {
+	*mock.Call
+}
+
+// GetPrerequisites is a helper method to define mock.On call
+//   - ctx context.Context
+//   - nodeID int
+func (_e *MockRepository_Expecter) GetPrerequisites(ctx interface{}, nodeID interface{}) *MockRepository_GetPrerequisites_Call {
+	return &MockRepository_GetPrerequisites_Call{Call: _e.mock.On("GetPrerequisites", ctx, nodeID)}
 }
 
 func (_c *MockRepository_GetPrerequisites_Call) Run(run func(ctx context.Context, nodeID int)) *MockRepository_GetPrerequisites_Call {
@@ -2090,6 +2555,63 @@ func (_c *MockRepository_GetUnlock_Call) RunAndReturn(run func(context.Context,
 	return _c
 }
 
+// GetUserContributionScore provides a mock function with given fields: ctx, userID
+func (_m *MockRepository) GetUserContributionScore(ctx context.Context, userID string) (float64, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUserContributionScore")
+	}
+
+	var r0 float64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (float64, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) float64); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Get(0).(float64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRepository_GetUserContributionScore_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserContributionScore'
+type MockRepository_GetUserContributionScore_Call struct {
+	*mock.Call
+}
+
+// GetUserContributionScore is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+func (_e *MockRepository_Expecter) GetUserContributionScore(ctx interface{}, userID interface{}) *MockRepository_GetUserContributionScore_Call {
+	return &MockRepository_GetUserContributionScore_Call{Call: _e.mock.On("GetUserContributionScore", ctx, userID)}
+}
+
+func (_c *MockRepository_GetUserContributionScore_Call) Run(run func(ctx context.Context, userID string)) *MockRepository_GetUserContributionScore_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockRepository_GetUserContributionScore_Call) Return(_a0 float64, _a1 error) *MockRepository_GetUserContributionScore_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRepository_GetUserContributionScore_Call) RunAndReturn(run func(context.Context, string) (float64, error)) *MockRepository_GetUserContributionScore_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetUserEngagement provides a mock function with given fields: ctx, userID
 func (_m *MockRepository) GetUserEngagement(ctx context.Context, userID string) (*domain.ContributionBreakdown, error) {
 	ret := _m.Called(ctx, userID)
@@ -2209,6 +2731,124 @@ func (_c *MockRepository_GetUserProgressions_Call) RunAndReturn(run func(context
 	return _c
 }
 
+// GetVoteDelegation provides a mock function with given fields: ctx, delegatorID
+func (_m *MockRepository) GetVoteDelegation(ctx context.Context, delegatorID string) (*domain.VoteDelegation, error) {
+	ret := _m.Called(ctx, delegatorID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetVoteDelegation")
+	}
+
+	var r0 *domain.VoteDelegation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*domain.VoteDelegation, error)); ok {
+		return rf(ctx, delegatorID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *domain.VoteDelegation); ok {
+		r0 = rf(ctx, delegatorID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.VoteDelegation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, delegatorID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRepository_GetVoteDelegation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetVoteDelegation'
+type MockRepository_GetVoteDelegation_Call struct {
+	*mock.Call
+}
+
+// GetVoteDelegation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - delegatorID string
+func (_e *MockRepository_Expecter) GetVoteDelegation(ctx interface{}, delegatorID interface{}) *MockRepository_GetVoteDelegation_Call {
+	return &MockRepository_GetVoteDelegation_Call{Call: _e.mock.On("GetVoteDelegation", ctx, delegatorID)}
+}
+
+func (_c *MockRepository_GetVoteDelegation_Call) Run(run func(ctx context.Context, delegatorID string)) *MockRepository_GetVoteDelegation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockRepository_GetVoteDelegation_Call) Return(_a0 *domain.VoteDelegation, _a1 error) *MockRepository_GetVoteDelegation_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRepository_GetVoteDelegation_Call) RunAndReturn(run func(context.Context, string) (*domain.VoteDelegation, error)) *MockRepository_GetVoteDelegation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetVoteDelegators provides a mock function with given fields: ctx, delegateID
+func (_m *MockRepository) GetVoteDelegators(ctx context.Context, delegateID string) ([]string, error) {
+	ret := _m.Called(ctx, delegateID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetVoteDelegators")
+	}
+
+	var r0 []string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]string, error)); ok {
+		return rf(ctx, delegateID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []string); ok {
+		r0 = rf(ctx, delegateID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, delegateID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRepository_GetVoteDelegators_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetVoteDelegators'
+type MockRepository_GetVoteDelegators_Call struct {
+	*mock.Call
+}
+
+// GetVoteDelegators is a helper method to define mock.On call
+//   - ctx context.Context
+//   - delegateID string
+func (_e *MockRepository_Expecter) GetVoteDelegators(ctx interface{}, delegateID interface{}) *MockRepository_GetVoteDelegators_Call {
+	return &MockRepository_GetVoteDelegators_Call{Call: _e.mock.On("GetVoteDelegators", ctx, delegateID)}
+}
+
+func (_c *MockRepository_GetVoteDelegators_Call) Run(run func(ctx context.Context, delegateID string)) *MockRepository_GetVoteDelegators_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockRepository_GetVoteDelegators_Call) Return(_a0 []string, _a1 error) *MockRepository_GetVoteDelegators_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRepository_GetVoteDelegators_Call) RunAndReturn(run func(context.Context, string) ([]string, error)) *MockRepository_GetVoteDelegators_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // HasUserVotedInSession provides a mock function with given fields: ctx, userID, sessionID
 func (_m *MockRepository) HasUserVotedInSession(ctx context.Context, userID string, sessionID int) (bool, error) {
 	ret := _m.Called(ctx, userID, sessionID)
@@ -2431,6 +3071,127 @@ func (_c *MockRepository_IsUserProgressionUnlocked_Call) RunAndReturn(run func(c
 	return _c
 }
 
+// RecordCappedEngagement provides a mock function with given fields: ctx, metric, limit, since
+func (_m *MockRepository) RecordCappedEngagement(ctx context.Context, metric *domain.EngagementMetric, limit int, since time.Time) (int, error) {
+	ret := _m.Called(ctx, metric, limit, since)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecordCappedEngagement")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.EngagementMetric, int, time.Time) (int, error)); ok {
+		return rf(ctx, metric, limit, since)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.EngagementMetric, int, time.Time) int); ok {
+		r0 = rf(ctx, metric, limit, since)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *domain.EngagementMetric, int, time.Time) error); ok {
+		r1 = rf(ctx, metric, limit, since)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRepository_RecordCappedEngagement_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecordCappedEngagement'
+type MockRepository_RecordCappedEngagement_Call struct {
+	*mock.Call
+}
+
+// RecordCappedEngagement is a helper method to define mock.On call
+//   - ctx context.Context
+//   - metric *domain.EngagementMetric
+//   - limit int
+//   - since time.Time
+func (_e *MockRepository_Expecter) RecordCappedEngagement(ctx interface{}, metric interface{}, limit interface{}, since interface{}) *MockRepository_RecordCappedEngagement_Call {
+	return &MockRepository_RecordCappedEngagement_Call{Call: _e.mock.On("RecordCappedEngagement", ctx, metric, limit, since)}
+}
+
+func (_c *MockRepository_RecordCappedEngagement_Call) Run(run func(ctx context.Context, metric *domain.EngagementMetric, limit int, since time.Time)) *MockRepository_RecordCappedEngagement_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*domain.EngagementMetric), args[2].(int), args[3].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockRepository_RecordCappedEngagement_Call) Return(_a0 int, _a1 error) *MockRepository_RecordCappedEngagement_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRepository_RecordCappedEngagement_Call) RunAndReturn(run func(context.Context, *domain.EngagementMetric, int, time.Time) (int, error)) *MockRepository_RecordCappedEngagement_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RecordDelegatedVotes provides a mock function with given fields: ctx, delegateID, sessionID, optionID, nodeID, targetLevel, weights
+func (_m *MockRepository) RecordDelegatedVotes(ctx context.Context, delegateID string, sessionID int, optionID int, nodeID int, targetLevel int, weights map[string]int) (int, error) {
+	ret := _m.Called(ctx, delegateID, sessionID, optionID, nodeID, targetLevel, weights)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecordDelegatedVotes")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int, int, int, int, map[string]int) (int, error)); ok {
+		return rf(ctx, delegateID, sessionID, optionID, nodeID, targetLevel, weights)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int, int, int, int, map[string]int) int); ok {
+		r0 = rf(ctx, delegateID, sessionID, optionID, nodeID, targetLevel, weights)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int, int, int, int, map[string]int) error); ok {
+		r1 = rf(ctx, delegateID, sessionID, optionID, nodeID, targetLevel, weights)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRepository_RecordDelegatedVotes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecordDelegatedVotes'
+type MockRepository_RecordDelegatedVotes_Call struct {
+	*mock.Call
+}
+
+// RecordDelegatedVotes is a helper method to define mock.On call
+//   - ctx context.Context
+//   - delegateID string
+//   - sessionID int
+//   - optionID int
+//   - nodeID int
+//   - targetLevel int
+//   - weights map[string]int
+func (_e *MockRepository_Expecter) RecordDelegatedVotes(ctx interface{}, delegateID interface{}, sessionID interface{}, optionID interface{}, nodeID interface{}, targetLevel interface{}, weights interface{}) *MockRepository_RecordDelegatedVotes_Call {
+	return &MockRepository_RecordDelegatedVotes_Call{Call: _e.mock.On("RecordDelegatedVotes", ctx, delegateID, sessionID, optionID, nodeID, targetLevel, weights)}
+}
+
+func (_c *MockRepository_RecordDelegatedVotes_Call) Run(run func(ctx context.Context, delegateID string, sessionID int, optionID int, nodeID int, targetLevel int, weights map[string]int)) *MockRepository_RecordDelegatedVotes_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int), args[3].(int), args[4].(int), args[5].(int), args[6].(map[string]int))
+	})
+	return _c
+}
+
+func (_c *MockRepository_RecordDelegatedVotes_Call) Return(_a0 int, _a1 error) *MockRepository_RecordDelegatedVotes_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRepository_RecordDelegatedVotes_Call) RunAndReturn(run func(context.Context, string, int, int, int, int, map[string]int) (int, error)) *MockRepository_RecordDelegatedVotes_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // RecordEngagement provides a mock function with given fields: ctx, metric
 func (_m *MockRepository) RecordEngagement(ctx context.Context, metric *domain.EngagementMetric) error {
 	ret := _m.Called(ctx, metric)
@@ -2770,6 +3531,54 @@ func (_c *MockRepository_SetUnlockTarget_Call) RunAndReturn(run func(context.Con
 	return _c
 }
 
+// SetVoteDelegation provides a mock function with given fields: ctx, delegatorID, delegateID
+func (_m *MockRepository) SetVoteDelegation(ctx context.Context, delegatorID string, delegateID string) error {
+	ret := _m.Called(ctx, delegatorID, delegateID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetVoteDelegation")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, delegatorID, delegateID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockRepository_SetVoteDelegation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetVoteDelegation'
+type MockRepository_SetVoteDelegation_Call struct {
+	*mock.Call
+}
+
+// SetVoteDelegation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - delegatorID string
+//   - delegateID string
+func (_e *MockRepository_Expecter) SetVoteDelegation(ctx interface{}, delegatorID interface{}, delegateID interface{}) *MockRepository_SetVoteDelegation_Call {
+	return &MockRepository_SetVoteDelegation_Call{Call: _e.mock.On("SetVoteDelegation", ctx, delegatorID, delegateID)}
+}
+
+func (_c *MockRepository_SetVoteDelegation_Call) Run(run func(ctx context.Context, delegatorID string, delegateID string)) *MockRepository_SetVoteDelegation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockRepository_SetVoteDelegation_Call) Return(_a0 error) *MockRepository_SetVoteDelegation_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockRepository_SetVoteDelegation_Call) RunAndReturn(run func(context.Context, string, string) error) *MockRepository_SetVoteDelegation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UnlockNode provides a mock function with given fields: ctx, nodeID, level, unlockedBy, engagementScore
 func (_m *MockRepository) UnlockNode(ctx context.Context, nodeID int, level int, unlockedBy string, engagementScore int) error {
 	ret := _m.Called(ctx, nodeID, level, unlockedBy, engagementScore)
@@ -2966,6 +3775,54 @@ func (_c *MockRepository_UpdateNodeDynamicPrerequisites_Call) RunAndReturn(run f
 	return _c
 }
 
+// UpdateNodeEffects provides a mock function with given fields: ctx, nodeID, jsonData
+func (_m *MockRepository) UpdateNodeEffects(ctx context.Context, nodeID int, jsonData []byte) error {
+	ret := _m.Called(ctx, nodeID, jsonData)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateNodeEffects")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, []byte) error); ok {
+		r0 = rf(ctx, nodeID, jsonData)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockRepository_UpdateNodeEffects_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateNodeEffects'
+type MockRepository_UpdateNodeEffects_Call struct {
+	*mock.Call
+}
+
+// UpdateNodeEffects is a helper method to define mock.On call
+//   - ctx context.Context
+//   - nodeID int
+//   - jsonData []byte
+func (_e *MockRepository_Expecter) UpdateNodeEffects(ctx interface{}, nodeID interface{}, jsonData interface{}) *MockRepository_UpdateNodeEffects_Call {
+	return &MockRepository_UpdateNodeEffects_Call{Call: _e.mock.On("UpdateNodeEffects", ctx, nodeID, jsonData)}
+}
+
+func (_c *MockRepository_UpdateNodeEffects_Call) Run(run func(ctx context.Context, nodeID int, jsonData []byte)) *MockRepository_UpdateNodeEffects_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].([]byte))
+	})
+	return _c
+}
+
+func (_c *MockRepository_UpdateNodeEffects_Call) Return(_a0 error) *MockRepository_UpdateNodeEffects_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockRepository_UpdateNodeEffects_Call) RunAndReturn(run func(context.Context, int, []byte) error) *MockRepository_UpdateNodeEffects_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpsertSyncMetadata provides a mock function with given fields: ctx, metadata
 func (_m *MockRepository) UpsertSyncMetadata(ctx context.Context, metadata *domain.SyncMetadata) error {
 	ret := _m.Called(ctx, metadata)
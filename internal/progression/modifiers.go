@@ -68,20 +68,29 @@ func (s *service) GetModifiedValue(ctx context.Context, userID string, featureKe
 		// Fallback to base value on error
 		return baseValue, err
 	}
+
+	// 3. Config deltas from unlocked nodes' effects are added last
+	effects, err := s.effects(ctx)
+	if err != nil {
+		return baseValue, err
+	}
+	delta := effects.ConfigDelta(featureKey)
+
 	if len(modifiers) == 0 {
 		// No modifiers configured for this feature
-		return baseValue, nil
+		return baseValue + delta, nil
 	}
 
-	// 3. Apply all modifiers (stacks multiplicatively)
+	// 4. Apply all modifiers (stacks multiplicatively)
 	value := baseValue
 	totalLevel := 0
 	for _, modifier := range modifiers {
 		value = ApplyModifier(modifier, value)
 		totalLevel += modifier.CurrentLevel
 	}
+	value += delta
 
-	// 4. Cache with total level across all modifiers
+	// 5. Cache with total level across all modifiers
 	s.modifierCache.Set(featureKey, value, totalLevel)
 
 	return value, nil
@@ -0,0 +1,95 @@
+package progression
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+)
+
+// setupAlternativeTree makes economy require money OR lootbox0
+func setupAlternativeTree(repo *MockRepository) {
+	setupTestTree(repo)
+	repo.prerequisites[3] = []int{2, 4}
+	repo.prerequisiteGroups[3] = [][]int{{2, 4}}
+}
+
+func nodeKeys(nodes []*domain.ProgressionNode) []string {
+	keys := make([]string, 0, len(nodes))
+	for _, node := range nodes {
+		keys = append(keys, node.NodeKey)
+	}
+	return keys
+}
+
+func TestGetAvailableUnlocks_AlternativePrerequisites(t *testing.T) {
+	repo := NewMockRepository()
+	setupAlternativeTree(repo)
+	service := NewService(repo, NewMockUser(), nil, nil, nil, false)
+	ctx := context.Background()
+
+	available, err := service.GetAvailableUnlocks(ctx)
+	require.NoError(t, err)
+	assert.NotContains(t, nodeKeys(available), "feature_economy", "no alternative is unlocked yet")
+
+	// Either alternative is enough
+	require.NoError(t, repo.UnlockNode(ctx, 4, 1, "test", 0))
+
+	available, err = service.GetAvailableUnlocks(ctx)
+	require.NoError(t, err)
+	assert.Contains(t, nodeKeys(available), "feature_economy")
+}
+
+func TestGetRequiredNodes_AlternativePrerequisites(t *testing.T) {
+	repo := NewMockRepository()
+	setupAlternativeTree(repo)
+	service := NewService(repo, NewMockUser(), nil, nil, nil, false)
+	ctx := context.Background()
+
+	// With neither unlocked, both alternatives are listed
+	required, err := service.GetRequiredNodes(ctx, "feature_economy")
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"item_money", "item_lootbox0"}, nodeKeys(required))
+
+	require.NoError(t, repo.UnlockNode(ctx, 2, 1, "test", 0))
+
+	required, err = service.GetRequiredNodes(ctx, "feature_economy")
+	require.NoError(t, err)
+	assert.Empty(t, required)
+}
+
+func TestGetProgressionTree_PrerequisiteGroups(t *testing.T) {
+	repo := NewMockRepository()
+	setupAlternativeTree(repo)
+	service := NewService(repo, NewMockUser(), nil, nil, nil, false)
+
+	tree, err := service.GetProgressionTree(context.Background())
+	require.NoError(t, err)
+
+	for _, node := range tree {
+		switch node.NodeKey {
+		case "feature_economy":
+			assert.Equal(t, [][]int{{2, 4}}, node.PrerequisiteGroups)
+		case "item_money":
+			assert.Equal(t, [][]int{{1}}, node.PrerequisiteGroups)
+		}
+	}
+}
+
+func TestSyncPrerequisites_GroupsAlternatives(t *testing.T) {
+	repo := NewMockRepository()
+	ctx := context.Background()
+	existing := map[string]*domain.ProgressionNode{
+		"a": {ID: 1, NodeKey: "a"},
+		"b": {ID: 2, NodeKey: "b"},
+		"c": {ID: 3, NodeKey: "c"},
+	}
+
+	err := syncPrerequisites(ctx, repo, 4, []string{"a|b", "c", "-total_nodes_unlocked:2"}, existing, map[string]int{})
+
+	require.NoError(t, err)
+	assert.Equal(t, [][]int{{1, 2}, {3}}, repo.prerequisiteGroups[4])
+}
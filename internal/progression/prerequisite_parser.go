@@ -11,12 +11,22 @@ import (
 const (
 	PrereqNodesUnlockedBelowTier = "nodes_unlocked_below_tier"
 	PrereqTotalNodesUnlocked     = "total_nodes_unlocked"
+
+	// PrereqAlternativeSeparator joins static node keys of which any one
+	// satisfies the prerequisite, e.g. "feature_crafting|feature_economy"
+	PrereqAlternativeSeparator = "|"
 )
 
 // ParsePrerequisite parses a prerequisite string (static or dynamic)
 // Returns: isDynamic, dynamicPrerequisite, staticKey, error
+// A static key may hold alternatives; see StaticAlternatives.
 func ParsePrerequisite(prereqStr string) (isDynamic bool, dynamic *domain.DynamicPrerequisite, staticKey string, err error) {
 	if !strings.HasPrefix(prereqStr, "-") {
+		for _, key := range StaticAlternatives(prereqStr) {
+			if key == "" {
+				return false, nil, "", fmt.Errorf("invalid syntax: empty node key in %s", prereqStr)
+			}
+		}
 		return false, nil, prereqStr, nil // Static prerequisite
 	}
 
@@ -71,3 +81,24 @@ func ValidateDynamicPrerequisite(prereq *domain.DynamicPrerequisite) error {
 
 	return nil
 }
+
+// FormatDynamicPrerequisite renders a dynamic prerequisite in the string form
+// accepted by ParsePrerequisite
+func FormatDynamicPrerequisite(prereq domain.DynamicPrerequisite) string {
+	if prereq.Type == PrereqNodesUnlockedBelowTier {
+		return fmt.Sprintf("-%s:%d:%d", prereq.Type, prereq.Tier, prereq.Count)
+	}
+	return fmt.Sprintf("-%s:%d", prereq.Type, prereq.Count)
+}
+
+// StaticAlternatives splits a static prerequisite into the node keys that can
+// each satisfy it. A plain node key yields itself.
+func StaticAlternatives(staticKey string) []string {
+	return strings.Split(staticKey, PrereqAlternativeSeparator)
+}
+
+// FormatStaticPrerequisite joins alternative node keys in the string form
+// accepted by ParsePrerequisite
+func FormatStaticPrerequisite(keys []string) string {
+	return strings.Join(keys, PrereqAlternativeSeparator)
+}
@@ -17,6 +17,17 @@ func TestParsePrerequisite_Static(t *testing.T) {
 	assert.Equal(t, "item_money", staticKey)
 }
 
+func TestParsePrerequisite_StaticAlternatives(t *testing.T) {
+	isDynamic, _, staticKey, err := ParsePrerequisite("feature_crafting|feature_economy")
+	assert.NoError(t, err)
+	assert.False(t, isDynamic)
+	assert.Equal(t, []string{"feature_crafting", "feature_economy"}, StaticAlternatives(staticKey))
+	assert.Equal(t, staticKey, FormatStaticPrerequisite(StaticAlternatives(staticKey)))
+
+	_, _, _, err = ParsePrerequisite("feature_crafting|")
+	assert.Error(t, err)
+}
+
 func TestParsePrerequisite_NodesUnlockedBelowTier(t *testing.T) {
 	isDynamic, dynamic, staticKey, err := ParsePrerequisite("-nodes_unlocked_below_tier:2:5")
 	assert.NoError(t, err)
@@ -201,3 +212,11 @@ func TestParsePrerequisite_MultipleFormats(t *testing.T) {
 		})
 	}
 }
+
+func TestFormatDynamicPrerequisite_RoundTrips(t *testing.T) {
+	for _, raw := range []string{"-nodes_unlocked_below_tier:2:5", "-total_nodes_unlocked:10"} {
+		_, dynamic, _, err := ParsePrerequisite(raw)
+		require.NoError(t, err)
+		assert.Equal(t, raw, FormatDynamicPrerequisite(*dynamic))
+	}
+}
@@ -0,0 +1,308 @@
+package progression
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/osse101/BrandishBot_Go/internal/community"
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+)
+
+// WithCommunity binds the service to one community. Its background work runs
+// in that community and its event handlers skip events from other
+// communities. Used by NewRouter; a service without one handles every event.
+func WithCommunity(id string) Option {
+	return func(s *service) {
+		s.community = id
+		s.shutdownCtx = community.WithID(s.shutdownCtx, id)
+	}
+}
+
+// treeCacheInvalidator is implemented by services that cache tree data
+type treeCacheInvalidator interface {
+	invalidateTreeCaches()
+}
+
+// router keeps one progression service per community and sends each call to
+// the instance of the community on the context. Every instance holds its own
+// unlock target, caches and node effects, so communities progress
+// independently while sharing the tree definition.
+type router struct {
+	factory func(communityID string) Service
+
+	mu        sync.RWMutex
+	instances map[string]Service
+	order     []string
+}
+
+// NewRouter returns a Service that routes by community. An instance is created
+// up front for each of the given communities so their event handlers are
+// subscribed from startup; other communities get one on first use. factory
+// should pass WithCommunity(communityID) to NewService.
+func NewRouter(communities []string, factory func(communityID string) Service) Service {
+	r := &router{
+		factory:   factory,
+		instances: make(map[string]Service, len(communities)),
+	}
+	for _, id := range communities {
+		r.instance(id)
+	}
+	return r
+}
+
+// instance returns the service for a community, creating it if needed
+func (r *router) instance(id string) Service {
+	r.mu.RLock()
+	svc, ok := r.instances[id]
+	r.mu.RUnlock()
+	if ok {
+		return svc
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if svc, ok := r.instances[id]; ok {
+		return svc
+	}
+	svc = r.factory(id)
+	r.instances[id] = svc
+	r.order = append(r.order, id)
+	return svc
+}
+
+// all returns every instance created so far, in creation order
+func (r *router) all() []Service {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	services := make([]Service, 0, len(r.order))
+	for _, id := range r.order {
+		services = append(services, r.instances[id])
+	}
+	return services
+}
+
+func (r *router) pick(ctx context.Context) Service {
+	return r.instance(community.FromContext(ctx))
+}
+
+func (r *router) GetProgressionTree(ctx context.Context) ([]*domain.ProgressionTreeNode, error) {
+	return r.pick(ctx).GetProgressionTree(ctx)
+}
+
+func (r *router) GetAvailableUnlocks(ctx context.Context) ([]*domain.ProgressionNode, error) {
+	return r.pick(ctx).GetAvailableUnlocks(ctx)
+}
+
+func (r *router) GetNode(ctx context.Context, id int) (*domain.ProgressionNode, error) {
+	return r.pick(ctx).GetNode(ctx, id)
+}
+
+func (r *router) IsFeatureUnlocked(ctx context.Context, featureKey string) (bool, error) {
+	return r.pick(ctx).IsFeatureUnlocked(ctx, featureKey)
+}
+
+func (r *router) IsItemUnlocked(ctx context.Context, itemName string) (bool, error) {
+	return r.pick(ctx).IsItemUnlocked(ctx, itemName)
+}
+
+func (r *router) AreItemsUnlocked(ctx context.Context, itemNames []string) (map[string]bool, error) {
+	return r.pick(ctx).AreItemsUnlocked(ctx, itemNames)
+}
+
+func (r *router) IsNodeUnlocked(ctx context.Context, nodeKey string, level int) (bool, error) {
+	return r.pick(ctx).IsNodeUnlocked(ctx, nodeKey, level)
+}
+
+func (r *router) VoteForUnlock(ctx context.Context, platform, platformID, username string, optionIndex int) error {
+	return r.pick(ctx).VoteForUnlock(ctx, platform, platformID, username, optionIndex)
+}
+
+func (r *router) GetActiveVotingSession(ctx context.Context) (*domain.ProgressionVotingSession, error) {
+	return r.pick(ctx).GetActiveVotingSession(ctx)
+}
+
+func (r *router) GetMostRecentVotingSession(ctx context.Context) (*domain.ProgressionVotingSession, error) {
+	return r.pick(ctx).GetMostRecentVotingSession(ctx)
+}
+
+func (r *router) StartVotingSession(ctx context.Context, unlockedNodeID *int) error {
+	return r.pick(ctx).StartVotingSession(ctx, unlockedNodeID)
+}
+
+func (r *router) EndVoting(ctx context.Context) (*domain.ProgressionVotingOption, error) {
+	return r.pick(ctx).EndVoting(ctx)
+}
+
+func (r *router) GetVotingSessionHistory(ctx context.Context, limit, offset int) (*domain.VotingSessionHistory, error) {
+	return r.pick(ctx).GetVotingSessionHistory(ctx, limit, offset)
+}
+
+func (r *router) DelegateVote(ctx context.Context, platform, platformID, username, delegateUsername string) error {
+	return r.pick(ctx).DelegateVote(ctx, platform, platformID, username, delegateUsername)
+}
+
+func (r *router) RevokeVoteDelegation(ctx context.Context, platform, platformID string) error {
+	return r.pick(ctx).RevokeVoteDelegation(ctx, platform, platformID)
+}
+
+func (r *router) GetVoteDelegation(ctx context.Context, platform, platformID string) (*domain.VoteDelegation, error) {
+	return r.pick(ctx).GetVoteDelegation(ctx, platform, platformID)
+}
+
+func (r *router) CheckAndUnlockCriteria(ctx context.Context) (*domain.ProgressionUnlock, error) {
+	return r.pick(ctx).CheckAndUnlockCriteria(ctx)
+}
+
+func (r *router) CheckAndUnlockNode(ctx context.Context) (*domain.ProgressionUnlock, error) {
+	return r.pick(ctx).CheckAndUnlockNode(ctx)
+}
+
+func (r *router) ForceInstantUnlock(ctx context.Context) (*domain.ProgressionUnlock, error) {
+	return r.pick(ctx).ForceInstantUnlock(ctx)
+}
+
+func (r *router) GetUnlockProgress(ctx context.Context) (*domain.UnlockProgress, error) {
+	return r.pick(ctx).GetUnlockProgress(ctx)
+}
+
+func (r *router) AddContribution(ctx context.Context, amount int) error {
+	return r.pick(ctx).AddContribution(ctx, amount)
+}
+
+func (r *router) AddExternalContribution(ctx context.Context, contribution domain.ExternalContribution) (*domain.ExternalContributionResult, error) {
+	return r.pick(ctx).AddExternalContribution(ctx, contribution)
+}
+
+func (r *router) RecordEngagement(ctx context.Context, userID string, metricType string, value int) error {
+	return r.pick(ctx).RecordEngagement(ctx, userID, metricType, value)
+}
+
+func (r *router) GetEngagementScore(ctx context.Context) (int, error) {
+	return r.pick(ctx).GetEngagementScore(ctx)
+}
+
+func (r *router) GetUserEngagement(ctx context.Context, platform, platformID string) (*domain.ContributionBreakdown, error) {
+	return r.pick(ctx).GetUserEngagement(ctx, platform, platformID)
+}
+
+func (r *router) GetUserEngagementByUsername(ctx context.Context, platform, username string) (*domain.ContributionBreakdown, error) {
+	return r.pick(ctx).GetUserEngagementByUsername(ctx, platform, username)
+}
+
+func (r *router) GetContributionLeaderboard(ctx context.Context, limit int) ([]domain.ContributionLeaderboardEntry, error) {
+	return r.pick(ctx).GetContributionLeaderboard(ctx, limit)
+}
+
+func (r *router) GetEngagementVelocity(ctx context.Context, days int) (*domain.VelocityMetrics, error) {
+	return r.pick(ctx).GetEngagementVelocity(ctx, days)
+}
+
+func (r *router) EstimateUnlockTime(ctx context.Context, nodeKey string) (*domain.UnlockEstimate, error) {
+	return r.pick(ctx).EstimateUnlockTime(ctx, nodeKey)
+}
+
+func (r *router) DecayContributions(ctx context.Context) (*domain.ContributionDecayResult, error) {
+	return r.pick(ctx).DecayContributions(ctx)
+}
+
+func (r *router) GetModifiedValue(ctx context.Context, userID string, featureKey string, baseValue float64) (float64, error) {
+	return r.pick(ctx).GetModifiedValue(ctx, userID, featureKey, baseValue)
+}
+
+func (r *router) GetProgressionStatus(ctx context.Context) (*domain.ProgressionStatus, error) {
+	return r.pick(ctx).GetProgressionStatus(ctx)
+}
+
+func (r *router) GetRequiredNodes(ctx context.Context, nodeKey string) ([]*domain.ProgressionNode, error) {
+	return r.pick(ctx).GetRequiredNodes(ctx, nodeKey)
+}
+
+func (r *router) GetJobUnlockConfig(ctx context.Context, featureKey string) (*domain.JobUnlockConfig, error) {
+	return r.pick(ctx).GetJobUnlockConfig(ctx, featureKey)
+}
+
+func (r *router) AdminUnlock(ctx context.Context, nodeKey string, level int) error {
+	return r.pick(ctx).AdminUnlock(ctx, nodeKey, level)
+}
+
+func (r *router) AdminUnlockAll(ctx context.Context) error {
+	return r.pick(ctx).AdminUnlockAll(ctx)
+}
+
+func (r *router) AdminRelock(ctx context.Context, nodeKey string, level int) error {
+	return r.pick(ctx).AdminRelock(ctx, nodeKey, level)
+}
+
+func (r *router) AdminFreezeVoting(ctx context.Context) error {
+	return r.pick(ctx).AdminFreezeVoting(ctx)
+}
+
+func (r *router) AdminStartVoting(ctx context.Context) error {
+	return r.pick(ctx).AdminStartVoting(ctx)
+}
+
+func (r *router) ResetProgressionTree(ctx context.Context, resetBy string, reason string, preserveUserData bool) error {
+	return r.pick(ctx).ResetProgressionTree(ctx, resetBy, reason, preserveUserData)
+}
+
+// InvalidateWeightCache clears the weight cache of every community
+func (r *router) InvalidateWeightCache() {
+	for _, svc := range r.all() {
+		svc.InvalidateWeightCache()
+	}
+}
+
+// ReplaceTree edits the shared tree through the context's community and then
+// drops the tree caches of the others
+func (r *router) ReplaceTree(ctx context.Context, data []byte, dryRun bool) (*TreeDiff, error) {
+	diff, err := r.pick(ctx).ReplaceTree(ctx, data, dryRun)
+	if err == nil && diff.Applied {
+		r.invalidateOthers(ctx)
+	}
+	return diff, err
+}
+
+// PatchTree is ReplaceTree for a partial tree
+func (r *router) PatchTree(ctx context.Context, data []byte, dryRun bool) (*TreeDiff, error) {
+	diff, err := r.pick(ctx).PatchTree(ctx, data, dryRun)
+	if err == nil && diff.Applied {
+		r.invalidateOthers(ctx)
+	}
+	return diff, err
+}
+
+func (r *router) invalidateOthers(ctx context.Context) {
+	editor := r.pick(ctx)
+	for _, svc := range r.all() {
+		if inv, ok := svc.(treeCacheInvalidator); ok && svc != editor {
+			inv.invalidateTreeCaches()
+		}
+	}
+}
+
+func (r *router) InitializeProgressionState(ctx context.Context) error {
+	return r.pick(ctx).InitializeProgressionState(ctx)
+}
+
+// InvalidateUnlockCacheForTest clears the unlock cache of every community
+func (r *router) InvalidateUnlockCacheForTest() {
+	for _, svc := range r.all() {
+		svc.InvalidateUnlockCacheForTest()
+	}
+}
+
+// Shutdown shuts down every community's service
+func (r *router) Shutdown(ctx context.Context) error {
+	var errs []error
+	r.mu.RLock()
+	order := append([]string(nil), r.order...)
+	r.mu.RUnlock()
+	for _, id := range order {
+		if err := r.instance(id).Shutdown(ctx); err != nil {
+			errs = append(errs, fmt.Errorf("community %s: %w", id, err))
+		}
+	}
+	return errors.Join(errs...)
+}
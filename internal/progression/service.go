@@ -9,6 +9,7 @@ import (
 	"github.com/osse101/BrandishBot_Go/internal/event"
 	"github.com/osse101/BrandishBot_Go/internal/logger"
 	"github.com/osse101/BrandishBot_Go/internal/repository"
+	"github.com/osse101/BrandishBot_Go/internal/rng"
 )
 
 // JobService defines the interface for the job system (read-only operations)
@@ -35,6 +36,10 @@ type Service interface {
 	GetMostRecentVotingSession(ctx context.Context) (*domain.ProgressionVotingSession, error) // Bug #1: Get most recent session (any status)
 	StartVotingSession(ctx context.Context, unlockedNodeID *int) error
 	EndVoting(ctx context.Context) (*domain.ProgressionVotingOption, error)
+	GetVotingSessionHistory(ctx context.Context, limit, offset int) (*domain.VotingSessionHistory, error) // Completed sessions, newest first
+	DelegateVote(ctx context.Context, platform, platformID, username, delegateUsername string) error
+	RevokeVoteDelegation(ctx context.Context, platform, platformID string) error
+	GetVoteDelegation(ctx context.Context, platform, platformID string) (*domain.VoteDelegation, error)
 
 	// Unlocking
 	CheckAndUnlockCriteria(ctx context.Context) (*domain.ProgressionUnlock, error) // Auto-check if criteria met
@@ -42,6 +47,7 @@ type Service interface {
 	ForceInstantUnlock(ctx context.Context) (*domain.ProgressionUnlock, error)     // Admin instant unlock
 	GetUnlockProgress(ctx context.Context) (*domain.UnlockProgress, error)
 	AddContribution(ctx context.Context, amount int) error
+	AddExternalContribution(ctx context.Context, contribution domain.ExternalContribution) (*domain.ExternalContributionResult, error)
 
 	// Contribution tracking
 	RecordEngagement(ctx context.Context, userID string, metricType string, value int) error
@@ -51,6 +57,7 @@ type Service interface {
 	GetContributionLeaderboard(ctx context.Context, limit int) ([]domain.ContributionLeaderboardEntry, error)
 	GetEngagementVelocity(ctx context.Context, days int) (*domain.VelocityMetrics, error)
 	EstimateUnlockTime(ctx context.Context, nodeKey string) (*domain.UnlockEstimate, error)
+	DecayContributions(ctx context.Context) (*domain.ContributionDecayResult, error) // Scheduled decay of user contribution scores
 
 	// Value modification
 	GetModifiedValue(ctx context.Context, userID string, featureKey string, baseValue float64) (float64, error)
@@ -69,6 +76,10 @@ type Service interface {
 	ResetProgressionTree(ctx context.Context, resetBy string, reason string, preserveUserData bool) error
 	InvalidateWeightCache() // Clears engagement weight cache (forces reload on next engagement)
 
+	// Tree editing (validate, diff and apply without a reset)
+	ReplaceTree(ctx context.Context, data []byte, dryRun bool) (*TreeDiff, error)
+	PatchTree(ctx context.Context, data []byte, dryRun bool) (*TreeDiff, error)
+
 	// Initialization
 	InitializeProgressionState(ctx context.Context) error // Called on startup to ensure valid state
 
@@ -97,12 +108,35 @@ type service struct {
 	cachedWeights map[string]float64
 	weightsExpiry time.Time
 
+	// Contribution score tuning and cached average score for catch-up
+	contribution  ContributionConfig
+	averageMu     sync.RWMutex
+	cachedAverage float64
+	averageExpiry time.Time
+
+	// Community-wide contribution boosts; nil applies none
+	communityEffects CommunityEffectChecker
+
+	// Vote weights by contribution score; empty counts every vote once
+	voteWeights []VoteWeightTier
+
 	// Cache for modifier values (reduces DB load for feature values)
 	modifierCache *ModifierCache
 
 	// Cache for node unlock status (reduces DB load for feature checks)
 	unlockCache *UnlockCache
 
+	// Features, items and config deltas enabled by unlocked nodes
+	effectsApplier *EffectsApplier
+
+	// Validates and applies admin edits of the tree
+	treeLoader *treeLoader
+
+	rng *rng.Provider // nil uses crypto/rand for random selections
+
+	// Community this instance serves; empty serves events from all of them
+	community string
+
 	// Semaphore to prevent concurrent unlock attempts
 	unlockSem chan struct{}
 
@@ -112,8 +146,11 @@ type service struct {
 	shutdownCancel context.CancelFunc
 }
 
+// Option configures optional service behaviour
+type Option func(*service)
+
 // NewService creates a new progression service
-func NewService(repo repository.Progression, userRepo repository.User, bus event.Bus, publisher *event.ResilientPublisher, jobService JobService, disableGains bool) Service {
+func NewService(repo repository.Progression, userRepo repository.User, bus event.Bus, publisher *event.ResilientPublisher, jobService JobService, disableGains bool, opts ...Option) Service {
 	shutdownCtx, shutdownCancel := context.WithCancel(context.Background())
 	svc := &service{
 		repo:           repo,
@@ -122,17 +159,24 @@ func NewService(repo repository.Progression, userRepo repository.User, bus event
 		jobService:     jobService,
 		publisher:      publisher,
 		disableGains:   disableGains,
+		contribution:   DefaultContributionConfig(),
 		modifierCache:  NewModifierCache(30 * time.Minute), // 30-min TTL
 		unlockCache:    NewUnlockCache(),                   // No TTL - invalidate on unlock/relock
+		effectsApplier: NewEffectsApplier(),                // Re-applied on unlock/relock
 		unlockSem:      make(chan struct{}, 1),             // Buffer of 1 = only one unlock check at a time
+		treeLoader:     NewTreeLoader().(*treeLoader),
 		shutdownCtx:    shutdownCtx,
 		shutdownCancel: shutdownCancel,
 	}
+	for _, opt := range opts {
+		opt(svc)
+	}
 
 	// Subscribe to node unlock/relock events to invalidate caches
 	if bus != nil {
 		bus.Subscribe(event.ProgressionNodeUnlocked, svc.handleNodeUnlocked)
 		bus.Subscribe(event.ProgressionNodeRelocked, svc.handleNodeRelocked)
+		bus.Subscribe(event.SnapshotRestored, svc.handleSnapshotRestored)
 		bus.Subscribe(domain.EventTypeEngagement, svc.handleEngagement)
 	}
 
@@ -143,6 +187,7 @@ func NewService(repo repository.Progression, userRepo repository.User, bus event
 // This should only be used in tests where there's no event bus to trigger automatic invalidation
 func (s *service) InvalidateUnlockCacheForTest() {
 	s.unlockCache.InvalidateAll()
+	s.effectsApplier.Invalidate()
 }
 
 // GetJobUnlockConfig retrieves job-specific unlocking rules for features like compost/disassemble.
@@ -145,6 +145,9 @@ func (m *ReliabilityMockRepository) GetDependents(ctx context.Context, nodeID in
 func (m *ReliabilityMockRepository) GetPrerequisites(ctx context.Context, nodeID int) ([]*domain.ProgressionNode, error) {
 	panic("not implemented")
 }
+func (m *ReliabilityMockRepository) GetPrerequisiteGroups(ctx context.Context, nodeID int) ([][]*domain.ProgressionNode, error) {
+	panic("not implemented")
+}
 func (m *ReliabilityMockRepository) GetAllUnlocks(ctx context.Context) ([]*domain.ProgressionUnlock, error) {
 	panic("not implemented")
 }
@@ -157,6 +160,9 @@ func (m *ReliabilityMockRepository) RelockNode(ctx context.Context, nodeID int,
 func (m *ReliabilityMockRepository) GetSessionByID(ctx context.Context, sessionID int) (*domain.ProgressionVotingSession, error) {
 	panic("not implemented")
 }
+func (m *ReliabilityMockRepository) GetCompletedSessions(ctx context.Context, limit, offset int) ([]domain.VotingSessionRecap, int, error) {
+	panic("not implemented")
+}
 func (m *ReliabilityMockRepository) IncrementOptionVote(ctx context.Context, optionID int) error {
 	panic("not implemented")
 }
@@ -169,7 +175,22 @@ func (m *ReliabilityMockRepository) HasUserVotedInSession(ctx context.Context, u
 func (m *ReliabilityMockRepository) RecordUserSessionVote(ctx context.Context, userID string, sessionID, optionID, nodeID, targetLevel int) error {
 	panic("not implemented")
 }
-func (m *ReliabilityMockRepository) CheckAndRecordVoteAtomic(ctx context.Context, userID string, sessionID, optionID, nodeID, targetLevel int) error {
+func (m *ReliabilityMockRepository) CheckAndRecordVoteAtomic(ctx context.Context, userID string, sessionID, optionID, nodeID, targetLevel, weight int) error {
+	panic("not implemented")
+}
+func (m *ReliabilityMockRepository) SetVoteDelegation(ctx context.Context, delegatorID, delegateID string) error {
+	panic("not implemented")
+}
+func (m *ReliabilityMockRepository) DeleteVoteDelegation(ctx context.Context, delegatorID string) (bool, error) {
+	panic("not implemented")
+}
+func (m *ReliabilityMockRepository) GetVoteDelegation(ctx context.Context, delegatorID string) (*domain.VoteDelegation, error) {
+	panic("not implemented")
+}
+func (m *ReliabilityMockRepository) GetVoteDelegators(ctx context.Context, delegateID string) ([]string, error) {
+	panic("not implemented")
+}
+func (m *ReliabilityMockRepository) RecordDelegatedVotes(ctx context.Context, delegateID string, sessionID, optionID, nodeID, targetLevel int, weights map[string]int) (int, error) {
 	panic("not implemented")
 }
 func (m *ReliabilityMockRepository) CreateUnlockProgress(ctx context.Context) (int, error) {
@@ -217,6 +238,26 @@ func (m *ReliabilityMockRepository) GetDailyEngagementTotals(ctx context.Context
 	return args.Get(0).(map[time.Time]int), args.Error(1)
 }
 
+func (m *ReliabilityMockRepository) RecordCappedEngagement(ctx context.Context, metric *domain.EngagementMetric, limit int, since time.Time) (int, error) {
+	panic("not implemented")
+}
+
+func (m *ReliabilityMockRepository) AddUserContributionScore(ctx context.Context, userID string, points float64) error {
+	panic("not implemented")
+}
+
+func (m *ReliabilityMockRepository) GetUserContributionScore(ctx context.Context, userID string) (float64, error) {
+	panic("not implemented")
+}
+
+func (m *ReliabilityMockRepository) GetAverageContributionScore(ctx context.Context) (float64, error) {
+	panic("not implemented")
+}
+
+func (m *ReliabilityMockRepository) DecayContributionScores(ctx context.Context, retained, floor float64) (*domain.ContributionDecayResult, error) {
+	panic("not implemented")
+}
+
 func (m *ReliabilityMockRepository) CountUnlockedNodesBelowTier(ctx context.Context, tier int) (int, error) {
 	panic("not implemented")
 }
@@ -233,6 +274,18 @@ func (m *ReliabilityMockRepository) UpdateNodeDynamicPrerequisites(ctx context.C
 	panic("not implemented")
 }
 
+func (m *ReliabilityMockRepository) GetNodeEffects(ctx context.Context, nodeID int) ([]byte, error) {
+	panic("not implemented")
+}
+
+func (m *ReliabilityMockRepository) GetAllNodeEffects(ctx context.Context) (map[int][]byte, error) {
+	panic("not implemented")
+}
+
+func (m *ReliabilityMockRepository) UpdateNodeEffects(ctx context.Context, nodeID int, jsonData []byte) error {
+	panic("not implemented")
+}
+
 // ReliabilityMockBus is a minimal mock for event bus
 type ReliabilityMockBus struct {
 	mock.Mock
@@ -3,6 +3,8 @@ package progression
 import (
 	"context"
 	"fmt"
+	"sort"
+	"strings"
 	"sync"
 	"testing"
 	"time"
@@ -35,7 +37,8 @@ type MockRepository struct {
 	engagementMetrics []*domain.EngagementMetric
 
 	// Prerequisites junction table (v2.0)
-	prerequisites map[int][]int // nodeID -> []prerequisiteNodeIDs
+	prerequisites      map[int][]int   // nodeID -> []prerequisiteNodeIDs
+	prerequisiteGroups map[int][][]int // nodeID -> alternatives per group; unset means one group per prerequisite
 
 	// Voting session state
 	sessions       map[int]*domain.ProgressionVotingSession
@@ -56,6 +59,22 @@ type MockRepository struct {
 
 	// Bonus configs
 	bonusConfigs []domain.ModifierConfig
+
+	// Running contribution scores
+	contributionScores map[string]float64
+
+	// Vote delegation
+	delegations    map[string]string                    // delegatorID -> delegateID
+	delegatedVotes map[int]map[string]mockDelegatedVote // sessionID -> delegatorID -> vote cast by a delegate
+
+	// Node effects JSON
+	effects map[int][]byte // nodeID -> effects
+}
+
+// mockDelegatedVote is a vote a delegate cast on a user's behalf
+type mockDelegatedVote struct {
+	optionID int
+	weight   int
 }
 
 func NewMockRepository() *MockRepository {
@@ -72,15 +91,21 @@ func NewMockRepository() *MockRepository {
 			"item_used":    1.5,
 			"vote_cast":    5.0,
 		},
-		engagementMetrics: make([]*domain.EngagementMetric, 0),
-		prerequisites:     make(map[int][]int),
-		sessions:          make(map[int]*domain.ProgressionVotingSession),
-		sessionOptions:    make(map[int][]domain.ProgressionVotingOption),
-		sessionVotes:      make(map[int]map[string]bool),
-		unlockProgress:    make(map[int]*domain.UnlockProgress),
-		dailyTotals:       make(map[time.Time]int),
-		syncMetadata:      make(map[string]*domain.SyncMetadata),
-		bonusConfigs:      make([]domain.ModifierConfig, 0),
+		engagementMetrics:  make([]*domain.EngagementMetric, 0),
+		prerequisites:      make(map[int][]int),
+		prerequisiteGroups: make(map[int][][]int),
+		sessions:           make(map[int]*domain.ProgressionVotingSession),
+		sessionOptions:     make(map[int][]domain.ProgressionVotingOption),
+		sessionVotes:       make(map[int]map[string]bool),
+		unlockProgress:     make(map[int]*domain.UnlockProgress),
+		dailyTotals:        make(map[time.Time]int),
+		syncMetadata:       make(map[string]*domain.SyncMetadata),
+		bonusConfigs:       make([]domain.ModifierConfig, 0),
+
+		contributionScores: make(map[string]float64),
+		delegations:        make(map[string]string),
+		delegatedVotes:     make(map[int]map[string]mockDelegatedVote),
+		effects:            make(map[int][]byte),
 	}
 }
 
@@ -128,6 +153,30 @@ func (m *MockRepository) GetAllNodes(ctx context.Context) ([]*domain.Progression
 	return nodes, nil
 }
 
+func (m *MockRepository) GetPrerequisiteGroups(ctx context.Context, nodeID int) ([][]*domain.ProgressionNode, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	groupIDs, ok := m.prerequisiteGroups[nodeID]
+	if !ok {
+		for _, prereqID := range m.prerequisites[nodeID] {
+			groupIDs = append(groupIDs, []int{prereqID})
+		}
+	}
+
+	groups := make([][]*domain.ProgressionNode, 0, len(groupIDs))
+	for _, ids := range groupIDs {
+		group := make([]*domain.ProgressionNode, 0, len(ids))
+		for _, prereqID := range ids {
+			if node, ok := m.nodes[prereqID]; ok {
+				group = append(group, node)
+			}
+		}
+		groups = append(groups, group)
+	}
+	return groups, nil
+}
+
 func (m *MockRepository) GetPrerequisites(ctx context.Context, nodeID int) ([]*domain.ProgressionNode, error) {
 	m.mu.RLock()
 	defer m.mu.RUnlock()
@@ -392,6 +441,15 @@ func (m *MockRepository) GetUserEngagement(ctx context.Context, userID string) (
 			continue
 		}
 
+		if source, ok := strings.CutPrefix(metric.MetricType, domain.MetricTypeExternalPrefix); ok {
+			if breakdown.External == nil {
+				breakdown.External = make(map[string]int)
+			}
+			breakdown.External[source] += metric.MetricValue
+			breakdown.TotalScore += metric.MetricValue
+			continue
+		}
+
 		weight := m.engagementWeights[metric.MetricType]
 		breakdown.TotalScore += int(float64(metric.MetricValue) * weight)
 
@@ -489,6 +547,18 @@ func (m *MockRepository) UpdateNode(ctx context.Context, nodeID int, node *domai
 	return nil
 }
 
+func (m *MockRepository) SyncPrerequisites(ctx context.Context, nodeID int, groups [][]int) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var flat []int
+	for _, group := range groups {
+		flat = append(flat, group...)
+	}
+	m.prerequisites[nodeID] = flat
+	m.prerequisiteGroups[nodeID] = groups
+	return nil
+}
+
 // Session-based voting mock methods
 func (m *MockRepository) CreateVotingSession(ctx context.Context) (int, error) {
 	m.mu.Lock()
@@ -638,6 +708,38 @@ func (m *MockRepository) GetSessionByID(ctx context.Context, sessionID int) (*do
 	return nil, nil
 }
 
+func (m *MockRepository) GetCompletedSessions(ctx context.Context, limit, offset int) ([]domain.VotingSessionRecap, int, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	ended := make([]*domain.ProgressionVotingSession, 0)
+	for _, session := range m.sessions {
+		if session.EndedAt != nil {
+			ended = append(ended, session)
+		}
+	}
+	sort.Slice(ended, func(i, j int) bool {
+		if !ended[i].StartedAt.Equal(ended[j].StartedAt) {
+			return ended[i].StartedAt.After(ended[j].StartedAt)
+		}
+		return ended[i].ID > ended[j].ID
+	})
+
+	recaps := make([]domain.VotingSessionRecap, 0)
+	for i := offset; i < len(ended) && len(recaps) < limit; i++ {
+		recap := domain.VotingSessionRecap{ProgressionVotingSession: *ended[i]}
+		recap.Options = append([]domain.ProgressionVotingOption(nil), m.sessionOptions[ended[i].ID]...)
+		for _, progress := range m.unlockProgress {
+			if progress.VotingSessionID != nil && *progress.VotingSessionID == ended[i].ID && progress.UnlockedAt != nil {
+				recap.UnlockedNode = m.nodes[*progress.NodeID]
+				recap.UnlockedLevel = progress.TargetLevel
+				recap.UnlockedAt = progress.UnlockedAt
+			}
+		}
+		recaps = append(recaps, recap)
+	}
+	return recaps, len(ended), nil
+}
+
 func (m *MockRepository) IncrementOptionVote(ctx context.Context, optionID int) error {
 	m.mu.Lock()
 	defer m.mu.Unlock()
@@ -703,25 +805,23 @@ func (m *MockRepository) RecordUserSessionVote(ctx context.Context, userID strin
 	return nil
 }
 
-func (m *MockRepository) CheckAndRecordVoteAtomic(ctx context.Context, userID string, sessionID, optionID, nodeID, targetLevel int) error {
+func (m *MockRepository) CheckAndRecordVoteAtomic(ctx context.Context, userID string, sessionID, optionID, nodeID, targetLevel, weight int) error {
 	m.mu.Lock()
 	defer m.mu.Unlock()
 
-	// Check if user has already voted
+	// Check if user has already voted; a delegated vote is replaced
 	if m.sessionVotes[sessionID] != nil && m.sessionVotes[sessionID][userID] {
-		return domain.ErrUserAlreadyVoted
-	}
-
-	// Increment vote count for the option
-	if options, ok := m.sessionOptions[sessionID]; ok {
-		for i, opt := range options {
-			if opt.ID == optionID {
-				m.sessionOptions[sessionID][i].VoteCount++
-				break
-			}
+		delegated, ok := m.delegatedVotes[sessionID][userID]
+		if !ok {
+			return domain.ErrUserAlreadyVoted
 		}
+		m.addOptionVotes(sessionID, delegated.optionID, -delegated.weight, -1)
+		delete(m.delegatedVotes[sessionID], userID)
 	}
 
+	// Add the vote's weight to the option
+	m.addOptionVotes(sessionID, optionID, weight, 1)
+
 	// Record the user's vote
 	if m.sessionVotes[sessionID] == nil {
 		m.sessionVotes[sessionID] = make(map[string]bool)
@@ -731,6 +831,79 @@ func (m *MockRepository) CheckAndRecordVoteAtomic(ctx context.Context, userID st
 	return nil
 }
 
+// addOptionVotes adjusts an option's vote and voter counts. Callers hold m.mu.
+func (m *MockRepository) addOptionVotes(sessionID, optionID, votes, voters int) {
+	for i, opt := range m.sessionOptions[sessionID] {
+		if opt.ID == optionID {
+			m.sessionOptions[sessionID][i].VoteCount += votes
+			m.sessionOptions[sessionID][i].VoterCount += voters
+			return
+		}
+	}
+}
+
+// Vote delegation mock methods
+func (m *MockRepository) SetVoteDelegation(ctx context.Context, delegatorID, delegateID string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.delegations[delegatorID] = delegateID
+	return nil
+}
+
+func (m *MockRepository) DeleteVoteDelegation(ctx context.Context, delegatorID string) (bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	_, ok := m.delegations[delegatorID]
+	delete(m.delegations, delegatorID)
+	return ok, nil
+}
+
+func (m *MockRepository) GetVoteDelegation(ctx context.Context, delegatorID string) (*domain.VoteDelegation, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	delegateID, ok := m.delegations[delegatorID]
+	if !ok {
+		return nil, nil
+	}
+	return &domain.VoteDelegation{DelegatorID: delegatorID, DelegateID: delegateID}, nil
+}
+
+func (m *MockRepository) GetVoteDelegators(ctx context.Context, delegateID string) ([]string, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	var delegators []string
+	for delegatorID, id := range m.delegations {
+		if id == delegateID {
+			delegators = append(delegators, delegatorID)
+		}
+	}
+	sort.Strings(delegators)
+	return delegators, nil
+}
+
+func (m *MockRepository) RecordDelegatedVotes(ctx context.Context, delegateID string, sessionID, optionID, nodeID, targetLevel int, weights map[string]int) (int, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.sessionVotes[sessionID] == nil {
+		m.sessionVotes[sessionID] = make(map[string]bool)
+	}
+	if m.delegatedVotes[sessionID] == nil {
+		m.delegatedVotes[sessionID] = make(map[string]mockDelegatedVote)
+	}
+
+	cast := 0
+	for delegatorID, weight := range weights {
+		if m.sessionVotes[sessionID][delegatorID] {
+			continue
+		}
+		m.sessionVotes[sessionID][delegatorID] = true
+		m.delegatedVotes[sessionID][delegatorID] = mockDelegatedVote{optionID: optionID, weight: weight}
+		m.addOptionVotes(sessionID, optionID, weight, 1)
+		cast++
+	}
+	return cast, nil
+}
+
 // Unlock progress mock methods
 func (m *MockRepository) CreateUnlockProgress(ctx context.Context) (int, error) {
 	m.mu.Lock()
@@ -829,6 +1002,69 @@ func (m *MockRepository) GetDailyEngagementTotals(ctx context.Context, since tim
 	return result, nil
 }
 
+func (m *MockRepository) RecordCappedEngagement(ctx context.Context, metric *domain.EngagementMetric, limit int, since time.Time) (int, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	used := 0
+	for _, existing := range m.engagementMetrics {
+		if existing.MetricType == metric.MetricType && !existing.RecordedAt.Before(since) {
+			used += existing.MetricValue
+		}
+	}
+
+	accepted := min(metric.MetricValue, limit-used)
+	if accepted <= 0 {
+		return 0, nil
+	}
+	capped := *metric
+	capped.MetricValue = accepted
+	m.engagementMetrics = append(m.engagementMetrics, &capped)
+	return accepted, nil
+}
+
+func (m *MockRepository) AddUserContributionScore(ctx context.Context, userID string, points float64) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.contributionScores[userID] += points
+	return nil
+}
+
+func (m *MockRepository) GetUserContributionScore(ctx context.Context, userID string) (float64, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.contributionScores[userID], nil
+}
+
+func (m *MockRepository) GetAverageContributionScore(ctx context.Context) (float64, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	if len(m.contributionScores) == 0 {
+		return 0, nil
+	}
+	total := 0.0
+	for _, score := range m.contributionScores {
+		total += score
+	}
+	return total / float64(len(m.contributionScores)), nil
+}
+
+func (m *MockRepository) DecayContributionScores(ctx context.Context, retained, floor float64) (*domain.ContributionDecayResult, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	result := &domain.ContributionDecayResult{}
+	for userID, score := range m.contributionScores {
+		result.Decayed++
+		if score*retained < floor {
+			delete(m.contributionScores, userID)
+			result.Removed++
+			continue
+		}
+		m.contributionScores[userID] = score * retained
+	}
+	return result, nil
+}
+
 // Dynamic prerequisite operations
 
 func (m *MockRepository) CountUnlockedNodesBelowTier(ctx context.Context, tier int) (int, error) {
@@ -873,6 +1109,34 @@ func (m *MockRepository) UpdateNodeDynamicPrerequisites(ctx context.Context, nod
 	return nil
 }
 
+func (m *MockRepository) GetNodeEffects(ctx context.Context, nodeID int) ([]byte, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	if data, ok := m.effects[nodeID]; ok {
+		return data, nil
+	}
+	return []byte("{}"), nil
+}
+
+func (m *MockRepository) GetAllNodeEffects(ctx context.Context) (map[int][]byte, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	effects := make(map[int][]byte, len(m.effects))
+	for nodeID, data := range m.effects {
+		if string(data) != "{}" {
+			effects[nodeID] = data
+		}
+	}
+	return effects, nil
+}
+
+func (m *MockRepository) UpdateNodeEffects(ctx context.Context, nodeID int, jsonData []byte) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.effects[nodeID] = jsonData
+	return nil
+}
+
 // Test helper functions
 
 func setupTestTree(repo *MockRepository) {
@@ -47,11 +47,33 @@ func (s *service) GetProgressionTree(ctx context.Context) ([]*domain.Progression
 			childIDs = append(childIDs, child.ID)
 		}
 
+		// Get prerequisite groups (any node in a group satisfies it)
+		groups, err := s.repo.GetPrerequisiteGroups(ctx, node.ID)
+		if err != nil {
+			log.Warn("Failed to get prerequisite groups", "nodeID", node.ID, "error", err)
+		}
+
+		groupIDs := make([][]int, 0, len(groups))
+		for _, group := range groups {
+			ids := make([]int, 0, len(group))
+			for _, prereq := range group {
+				ids = append(ids, prereq.ID)
+			}
+			groupIDs = append(groupIDs, ids)
+		}
+
+		effects, err := s.nodeEffects(ctx, node.ID)
+		if err != nil {
+			log.Warn("Failed to get node effects", "nodeID", node.ID, "error", err)
+		}
+
 		treeNode := &domain.ProgressionTreeNode{
-			ProgressionNode: *node,
-			IsUnlocked:      isUnlocked,
-			UnlockedLevel:   level,
-			Children:        childIDs,
+			ProgressionNode:    *node,
+			IsUnlocked:         isUnlocked,
+			UnlockedLevel:      level,
+			Children:           childIDs,
+			PrerequisiteGroups: groupIDs,
+			Effects:            effects,
 		}
 		treeNodes = append(treeNodes, treeNode)
 	}
@@ -102,22 +124,37 @@ func (s *service) isNodeAvailable(ctx context.Context, node *domain.ProgressionN
 	return true
 }
 
+// checkStaticPrereqs requires an unlocked node in every prerequisite group
 func (s *service) checkStaticPrereqs(ctx context.Context, node *domain.ProgressionNode) bool {
-	prerequisites, err := s.repo.GetPrerequisites(ctx, node.ID)
+	groups, err := s.repo.GetPrerequisiteGroups(ctx, node.ID)
 	if err != nil {
 		logger.FromContext(ctx).Warn("Failed to get prerequisites", "nodeKey", node.NodeKey, "error", err)
 		return false
 	}
 
-	for _, prereq := range prerequisites {
-		unlocked, err := s.repo.IsNodeUnlocked(ctx, prereq.NodeKey, 1)
-		if err != nil || !unlocked {
+	for _, group := range groups {
+		met, err := s.isGroupMet(ctx, group)
+		if err != nil || !met {
 			return false
 		}
 	}
 	return true
 }
 
+// isGroupMet reports whether any node in a prerequisite group is unlocked
+func (s *service) isGroupMet(ctx context.Context, group []*domain.ProgressionNode) (bool, error) {
+	for _, prereq := range group {
+		unlocked, err := s.repo.IsNodeUnlocked(ctx, prereq.NodeKey, 1)
+		if err != nil {
+			return false, fmt.Errorf("failed to check unlock status for %s: %w", prereq.NodeKey, err)
+		}
+		if unlocked {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
 func (s *service) checkDynamicPrereqs(ctx context.Context, node *domain.ProgressionNode) bool {
 	log := logger.FromContext(ctx)
 	dynamicPrereqsJSON, err := s.repo.GetNodeDynamicPrerequisites(ctx, node.ID)
@@ -297,20 +334,23 @@ func (s *service) GetRequiredNodes(ctx context.Context, nodeKey string) ([]*doma
 		}
 		visited[nodeID] = true
 
-		prerequisites, err := s.repo.GetPrerequisites(ctx, nodeID)
+		groups, err := s.repo.GetPrerequisiteGroups(ctx, nodeID)
 		if err != nil {
 			return fmt.Errorf("failed to get prerequisites for node %d: %w", nodeID, err)
 		}
 
-		for _, prereq := range prerequisites {
-			// Check if this prerequisite is unlocked
-			isUnlocked, err := s.repo.IsNodeUnlocked(ctx, prereq.NodeKey, 1)
+		for _, group := range groups {
+			// A group with an unlocked node is satisfied
+			met, err := s.isGroupMet(ctx, group)
 			if err != nil {
-				return fmt.Errorf("failed to check unlock status for %s: %w", prereq.NodeKey, err)
+				return err
+			}
+			if met {
+				continue
 			}
 
-			if !isUnlocked {
-				// Add to locked list
+			// Otherwise any of its nodes would do, so list them all
+			for _, prereq := range group {
 				lockedPrereqs = append(lockedPrereqs, prereq)
 				// Recursively check its prerequisites too
 				if err := checkPrereqs(prereq.ID); err != nil {
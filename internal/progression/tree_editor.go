@@ -0,0 +1,327 @@
+package progression
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"slices"
+	"sort"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/logger"
+)
+
+// TreeNodeChange names an existing node and the fields an edit changes
+type TreeNodeChange struct {
+	Key    string   `json:"key"`
+	Fields []string `json:"fields"`
+}
+
+// TreeDiff describes a progression tree edit against the database. Nodes left
+// out of a replacement tree are listed in Missing but kept, since removing
+// nodes needs a reset.
+type TreeDiff struct {
+	Added     []string         `json:"added"`
+	Changed   []TreeNodeChange `json:"changed"`
+	Unchanged int              `json:"unchanged"`
+	Missing   []string         `json:"missing,omitempty"`
+	Applied   bool             `json:"applied"`
+}
+
+// ReplaceTree takes tree JSON as the complete progression tree, validates it,
+// diffs it against the database and, unless dryRun, applies the additions and
+// changes without a reset
+func (s *service) ReplaceTree(ctx context.Context, data []byte, dryRun bool) (*TreeDiff, error) {
+	edit, err := s.treeLoader.Parse(data)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domain.ErrInvalidInput, err)
+	}
+
+	current, existingByKey, err := s.currentTree(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.editTree(ctx, current, edit.Nodes, existingByKey, dryRun)
+}
+
+// PatchTree merges the nodes in tree JSON over the current tree, adding new
+// keys and replacing existing ones, then validates, diffs and applies the
+// result like ReplaceTree
+func (s *service) PatchTree(ctx context.Context, data []byte, dryRun bool) (*TreeDiff, error) {
+	edit, err := s.treeLoader.Parse(data)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domain.ErrInvalidInput, err)
+	}
+
+	current, existingByKey, err := s.currentTree(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	merged := slices.Clone(current)
+	for _, node := range edit.Nodes {
+		i := slices.IndexFunc(merged, func(n NodeConfig) bool { return n.Key == node.Key })
+		if i >= 0 {
+			merged[i] = node
+		} else {
+			merged = append(merged, node)
+		}
+	}
+
+	return s.editTree(ctx, current, merged, existingByKey, dryRun)
+}
+
+func (s *service) editTree(ctx context.Context, current, tree []NodeConfig, existingByKey map[string]*domain.ProgressionNode, dryRun bool) (*TreeDiff, error) {
+	if err := s.treeLoader.Validate(&TreeConfig{Nodes: tree}); err != nil {
+		return nil, fmt.Errorf("%w: %w", domain.ErrInvalidInput, err)
+	}
+
+	diff := diffTree(current, tree)
+	if dryRun || (len(diff.Added) == 0 && len(diff.Changed) == 0) {
+		return diff, nil
+	}
+
+	edited := make(map[string]bool, len(diff.Added)+len(diff.Changed))
+	for _, key := range diff.Added {
+		edited[key] = true
+	}
+	for _, change := range diff.Changed {
+		edited[change.Key] = true
+	}
+	toApply := make([]NodeConfig, 0, len(edited))
+	for _, node := range tree {
+		if edited[node.Key] {
+			toApply = append(toApply, node)
+		}
+	}
+
+	result, err := s.treeLoader.syncNodes(ctx, s.repo, toApply, existingByKey, true)
+	if err != nil {
+		return nil, fmt.Errorf("failed to apply tree edit: %w", err)
+	}
+	diff.Applied = true
+
+	s.invalidateTreeCaches()
+
+	logger.FromContext(ctx).Info("Progression tree edited",
+		"inserted", result.NodesInserted,
+		"updated", result.NodesUpdated,
+		"auto_unlocked", result.AutoUnlocked)
+	return diff, nil
+}
+
+// invalidateTreeCaches drops everything derived from the tree after an edit:
+// modifiers, unlock checks and the target's cost may all have changed
+func (s *service) invalidateTreeCaches() {
+	s.modifierCache.InvalidateAll()
+	s.unlockCache.InvalidateAll()
+	s.effectsApplier.Invalidate()
+	s.mu.Lock()
+	s.cachedTargetCost = 0
+	s.mu.Unlock()
+}
+
+// currentTree rebuilds the tree configuration from the database. Whether a
+// node auto-unlocks is not stored, so it is always false.
+func (s *service) currentTree(ctx context.Context) ([]NodeConfig, map[string]*domain.ProgressionNode, error) {
+	nodes, err := s.repo.GetAllNodes(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get nodes: %w", err)
+	}
+	sort.Slice(nodes, func(i, j int) bool { return nodes[i].ID < nodes[j].ID })
+
+	modifiers, err := s.repo.GetAllBonusModifiers(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get bonus modifiers: %w", err)
+	}
+	modifiersByNode := make(map[string][]domain.ModifierConfig)
+	for _, mod := range modifiers {
+		modifiersByNode[mod.NodeKey] = append(modifiersByNode[mod.NodeKey], mod)
+	}
+
+	configs := make([]NodeConfig, 0, len(nodes))
+	existingByKey := make(map[string]*domain.ProgressionNode, len(nodes))
+	for _, node := range nodes {
+		existingByKey[node.NodeKey] = node
+
+		prereqs, err := s.nodePrerequisites(ctx, node.ID)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to get prerequisites for '%s': %w", node.NodeKey, err)
+		}
+
+		effects, err := s.nodeEffects(ctx, node.ID)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to get effects for '%s': %w", node.NodeKey, err)
+		}
+
+		configs = append(configs, NodeConfig{
+			Key:             node.NodeKey,
+			Name:            node.DisplayName,
+			Type:            node.NodeType,
+			Description:     node.Description,
+			Tier:            node.Tier,
+			Size:            node.Size,
+			MaxLevel:        node.MaxLevel,
+			Category:        node.Category,
+			Prerequisites:   prereqs,
+			SortOrder:       node.SortOrder,
+			ModifierConfigs: modifiersByNode[node.NodeKey],
+			Effects:         effects,
+		})
+	}
+	return configs, existingByKey, nil
+}
+
+// nodePrerequisites returns a node's static and dynamic prerequisites in
+// configuration form
+func (s *service) nodePrerequisites(ctx context.Context, nodeID int) ([]string, error) {
+	groups, err := s.repo.GetPrerequisiteGroups(ctx, nodeID)
+	if err != nil {
+		return nil, err
+	}
+	prereqs := make([]string, 0, len(groups))
+	for _, group := range groups {
+		keys := make([]string, 0, len(group))
+		for _, prereq := range group {
+			keys = append(keys, prereq.NodeKey)
+		}
+		prereqs = append(prereqs, FormatStaticPrerequisite(keys))
+	}
+
+	data, err := s.repo.GetNodeDynamicPrerequisites(ctx, nodeID)
+	if err != nil {
+		return nil, err
+	}
+	if len(data) > 0 {
+		var dynamic []domain.DynamicPrerequisite
+		if err := json.Unmarshal(data, &dynamic); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal dynamic prerequisites: %w", err)
+		}
+		for _, prereq := range dynamic {
+			prereqs = append(prereqs, FormatDynamicPrerequisite(prereq))
+		}
+	}
+	return prereqs, nil
+}
+
+// diffTree compares an edited tree with the current one
+func diffTree(current, tree []NodeConfig) *TreeDiff {
+	currentByKey := make(map[string]*NodeConfig, len(current))
+	for i := range current {
+		currentByKey[current[i].Key] = &current[i]
+	}
+
+	diff := &TreeDiff{Added: []string{}, Changed: []TreeNodeChange{}}
+	inTree := make(map[string]bool, len(tree))
+	for i := range tree {
+		node := &tree[i]
+		inTree[node.Key] = true
+
+		existing, ok := currentByKey[node.Key]
+		if !ok {
+			diff.Added = append(diff.Added, node.Key)
+			continue
+		}
+		if fields := changedNodeFields(existing, node); len(fields) > 0 {
+			diff.Changed = append(diff.Changed, TreeNodeChange{Key: node.Key, Fields: fields})
+		} else {
+			diff.Unchanged++
+		}
+	}
+
+	for _, node := range current {
+		if !inTree[node.Key] {
+			diff.Missing = append(diff.Missing, node.Key)
+		}
+	}
+	return diff
+}
+
+// changedNodeFields lists the JSON fields that differ between two versions of a node
+func changedNodeFields(existing, edited *NodeConfig) []string {
+	var fields []string
+	check := func(name string, changed bool) {
+		if changed {
+			fields = append(fields, name)
+		}
+	}
+
+	check("name", existing.Name != edited.Name)
+	check("type", existing.Type != edited.Type)
+	check("description", existing.Description != edited.Description)
+	check("tier", existing.Tier != edited.Tier)
+	check("size", existing.Size != edited.Size)
+	check("max_level", existing.MaxLevel != edited.MaxLevel)
+	check("category", existing.Category != edited.Category)
+	check("prerequisites", !samePrerequisites(existing.Prerequisites, edited.Prerequisites))
+	check("sort_order", existing.SortOrder != edited.SortOrder)
+	check("modifier_configs", !sameModifiers(existing.ModifierConfigs, edited.ModifierConfigs))
+	check("effects", !sameEffects(existing.Effects, edited.Effects))
+	return fields
+}
+
+// samePrerequisites compares prerequisite lists ignoring the order of the
+// entries and of the alternatives within each entry
+func samePrerequisites(a, b []string) bool {
+	normalize := func(prereqs []string) []string {
+		out := make([]string, 0, len(prereqs))
+		for _, prereq := range prereqs {
+			keys := StaticAlternatives(prereq)
+			slices.Sort(keys)
+			out = append(out, FormatStaticPrerequisite(keys))
+		}
+		slices.Sort(out)
+		return out
+	}
+	return slices.Equal(normalize(a), normalize(b))
+}
+
+// sameModifiers compares modifier configs by their values, ignoring the node
+// and source fields the database fills in
+func sameModifiers(a, b []domain.ModifierConfig) bool {
+	normalize := func(mods []domain.ModifierConfig) []domain.ModifierConfig {
+		out := make([]domain.ModifierConfig, 0, len(mods))
+		for _, mod := range mods {
+			out = append(out, domain.ModifierConfig{
+				FeatureKey:    mod.FeatureKey,
+				ModifierType:  mod.ModifierType,
+				PerLevelValue: mod.PerLevelValue,
+				BaseValue:     mod.BaseValue,
+				MaxValue:      mod.MaxValue,
+				MinValue:      mod.MinValue,
+			})
+		}
+		sort.Slice(out, func(i, j int) bool { return out[i].FeatureKey < out[j].FeatureKey })
+		return out
+	}
+	return reflect.DeepEqual(normalize(a), normalize(b))
+}
+
+// sameEffects compares node effects, treating no effects and empty effects as
+// the same and ignoring the order of each list
+func sameEffects(a, b *domain.NodeEffects) bool {
+	normalize := func(effects *domain.NodeEffects) domain.NodeEffects {
+		if effects == nil {
+			return domain.NodeEffects{}
+		}
+		out := domain.NodeEffects{
+			Features:     slices.Sorted(slices.Values(effects.Features)),
+			Items:        slices.Sorted(slices.Values(effects.Items)),
+			ConfigDeltas: slices.Clone(effects.ConfigDeltas),
+		}
+		sort.Slice(out.ConfigDeltas, func(i, j int) bool { return out.ConfigDeltas[i].Key < out.ConfigDeltas[j].Key })
+		if len(out.Features) == 0 {
+			out.Features = nil
+		}
+		if len(out.Items) == 0 {
+			out.Items = nil
+		}
+		if len(out.ConfigDeltas) == 0 {
+			out.ConfigDeltas = nil
+		}
+		return out
+	}
+	return reflect.DeepEqual(normalize(a), normalize(b))
+}
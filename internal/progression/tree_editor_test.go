@@ -0,0 +1,122 @@
+package progression
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+)
+
+// setupEditorTree seeds a two-node tree: a root and a child that requires it
+func setupEditorTree(repo *MockRepository) {
+	ctx := context.Background()
+	rootID, _ := repo.InsertNode(ctx, &domain.ProgressionNode{
+		NodeKey: "root", NodeType: "feature", DisplayName: "Root", MaxLevel: 1,
+		Tier: 0, Size: "small", Category: "core",
+	})
+	childID, _ := repo.InsertNode(ctx, &domain.ProgressionNode{
+		NodeKey: "item_money", NodeType: "item", DisplayName: "Money", MaxLevel: 1,
+		Tier: 1, Size: "small", Category: "economy", SortOrder: 1,
+	})
+	repo.prerequisites[childID] = []int{rootID}
+}
+
+func editorTreeJSON(nodes ...string) []byte {
+	body := `{"version": "1.0", "nodes": [`
+	for i, node := range nodes {
+		if i > 0 {
+			body += ","
+		}
+		body += node
+	}
+	return []byte(body + `]}`)
+}
+
+func TestPatchTree(t *testing.T) {
+	ctx := context.Background()
+	newNode := `{"key": "item_lootbox0", "name": "Lootbox", "type": "item", "tier": 1, "size": "small", "category": "economy", "max_level": 1, "prerequisites": ["root"], "sort_order": 2}`
+	renamed := `{"key": "item_money", "name": "Coins", "type": "item", "tier": 1, "size": "small", "category": "economy", "max_level": 1, "prerequisites": ["root"], "sort_order": 1}`
+
+	t.Run("dry run reports without applying", func(t *testing.T) {
+		repo := NewMockRepository()
+		setupEditorTree(repo)
+		svc := NewService(repo, NewMockUser(), nil, nil, nil, false)
+
+		diff, err := svc.PatchTree(ctx, editorTreeJSON(newNode), true)
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"item_lootbox0"}, diff.Added)
+		assert.Empty(t, diff.Changed)
+		assert.Equal(t, 2, diff.Unchanged)
+		assert.False(t, diff.Applied)
+		node, err := repo.GetNodeByKey(ctx, "item_lootbox0")
+		require.NoError(t, err)
+		assert.Nil(t, node)
+	})
+
+	t.Run("applies added and changed nodes", func(t *testing.T) {
+		repo := NewMockRepository()
+		setupEditorTree(repo)
+		svc := NewService(repo, NewMockUser(), nil, nil, nil, false)
+
+		diff, err := svc.PatchTree(ctx, editorTreeJSON(newNode, renamed), false)
+
+		require.NoError(t, err)
+		assert.True(t, diff.Applied)
+		assert.Equal(t, []string{"item_lootbox0"}, diff.Added)
+		require.Len(t, diff.Changed, 1)
+		assert.Equal(t, TreeNodeChange{Key: "item_money", Fields: []string{"name"}}, diff.Changed[0])
+
+		added, err := repo.GetNodeByKey(ctx, "item_lootbox0")
+		require.NoError(t, err)
+		prereqs, err := repo.GetPrerequisites(ctx, added.ID)
+		require.NoError(t, err)
+		require.Len(t, prereqs, 1)
+		assert.Equal(t, "root", prereqs[0].NodeKey)
+
+		money, err := repo.GetNodeByKey(ctx, "item_money")
+		require.NoError(t, err)
+		assert.Equal(t, "Coins", money.DisplayName)
+	})
+
+	t.Run("rejects an edit that breaks the tree", func(t *testing.T) {
+		repo := NewMockRepository()
+		setupEditorTree(repo)
+		svc := NewService(repo, NewMockUser(), nil, nil, nil, false)
+		unknownPrereq := `{"key": "item_lootbox0", "name": "Lootbox", "type": "item", "tier": 1, "size": "small", "category": "economy", "max_level": 1, "prerequisites": ["missing"], "sort_order": 2}`
+
+		_, err := svc.PatchTree(ctx, editorTreeJSON(unknownPrereq), false)
+
+		assert.ErrorIs(t, err, domain.ErrInvalidInput)
+	})
+
+	t.Run("rejects malformed JSON", func(t *testing.T) {
+		repo := NewMockRepository()
+		svc := NewService(repo, NewMockUser(), nil, nil, nil, false)
+
+		_, err := svc.PatchTree(ctx, []byte(`{"nodes": [`), true)
+
+		assert.ErrorIs(t, err, domain.ErrInvalidInput)
+	})
+}
+
+func TestReplaceTree_ReportsMissingNodes(t *testing.T) {
+	ctx := context.Background()
+	repo := NewMockRepository()
+	setupEditorTree(repo)
+	svc := NewService(repo, NewMockUser(), nil, nil, nil, false)
+	root := `{"key": "root", "name": "Root", "type": "feature", "tier": 0, "size": "small", "category": "core", "max_level": 1, "prerequisites": [], "sort_order": 0}`
+
+	diff, err := svc.ReplaceTree(ctx, editorTreeJSON(root), false)
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"item_money"}, diff.Missing)
+	assert.Equal(t, 1, diff.Unchanged)
+	assert.False(t, diff.Applied)
+	node, err := repo.GetNodeByKey(ctx, "item_money")
+	require.NoError(t, err)
+	assert.NotNil(t, node)
+}
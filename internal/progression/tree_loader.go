@@ -51,16 +51,18 @@ type NodeConfig struct {
 	Category string `json:"category"` // Grouping: economy, combat, progression, etc.
 
 	// Prerequisites (breaking: was single parent, now supports multiple)
-	Prerequisites []string `json:"prerequisites"` // List of node keys that must be unlocked first (AND logic)
+	Prerequisites []string `json:"prerequisites"` // All must be met (AND); "a|b" is met by either node (OR)
 
 	SortOrder       int                     `json:"sort_order"`
 	AutoUnlock      bool                    `json:"auto_unlock"` // If true, node is auto-unlocked (skips voting)
 	ModifierConfigs []domain.ModifierConfig `json:"modifier_configs,omitempty"`
+	Effects         *domain.NodeEffects     `json:"effects,omitempty"` // Applied while the node is unlocked
 }
 
 // TreeLoader handles loading and validating progression tree configuration
 type TreeLoader interface {
 	Load(path string) (*TreeConfig, error)
+	Parse(data []byte) (*TreeConfig, error)
 	Validate(config *TreeConfig) error
 	SyncToDatabase(ctx context.Context, config *TreeConfig, repo repository.Progression, path string) (*SyncResult, error)
 }
@@ -91,9 +93,18 @@ func (t *treeLoader) Load(path string) (*TreeConfig, error) {
 		return nil, fmt.Errorf("failed to read tree config file: %w", err)
 	}
 
+	config, err := t.Parse(data)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return config, nil
+}
+
+// Parse validates progression tree JSON against the schema and decodes it
+func (t *treeLoader) Parse(data []byte) (*TreeConfig, error) {
 	// Validate against schema first
 	if err := t.schemaValidator.ValidateBytes(data, ProgressionTreeSchemaPath); err != nil {
-		return nil, fmt.Errorf("schema validation failed for %s: %w", path, err)
+		return nil, fmt.Errorf("schema validation failed: %w", err)
 	}
 
 	var config TreeConfig
@@ -141,12 +152,8 @@ func (t *treeLoader) Validate(config *TreeConfig) error {
 					return fmt.Errorf("%w: node '%s' dynamic prerequisite invalid: %w",
 						ErrInvalidConfig, node.Key, err)
 				}
-			} else {
-				// Validate static prerequisite references valid node
-				if _, exists := nodesByKey[staticKey]; !exists {
-					return fmt.Errorf("%w: node '%s' references prerequisite '%s'",
-						ErrMissingParent, node.Key, staticKey)
-				}
+			} else if err := validateStaticPrerequisite(&node, staticKey, nodesByKey); err != nil {
+				return err
 			}
 		}
 	}
@@ -155,6 +162,24 @@ func (t *treeLoader) Validate(config *TreeConfig) error {
 	return detectCycles(config.Nodes, nodesByKey)
 }
 
+// validateStaticPrerequisite checks every alternative of a static prerequisite
+func validateStaticPrerequisite(node *NodeConfig, staticKey string, nodesByKey map[string]*NodeConfig) error {
+	for _, key := range StaticAlternatives(staticKey) {
+		// Validate static prerequisite references valid node
+		prereq, exists := nodesByKey[key]
+		if !exists {
+			return fmt.Errorf("%w: node '%s' references prerequisite '%s'",
+				ErrMissingParent, node.Key, key)
+		}
+		// A node must not cost less than what it depends on
+		if prereq.Tier > node.Tier {
+			return fmt.Errorf("%w: node '%s' (tier %d) is below its prerequisite '%s' (tier %d)",
+				ErrInvalidConfig, node.Key, node.Tier, key, prereq.Tier)
+		}
+	}
+	return nil
+}
+
 func (t *treeLoader) validateNodeConfig(index int, node *NodeConfig, nodesByKey map[string]*NodeConfig) error {
 	if node.Key == "" {
 		return fmt.Errorf("%w: node at index %d has empty key", ErrInvalidConfig, index)
@@ -189,7 +214,41 @@ func (t *treeLoader) validateNodeConfig(index int, node *NodeConfig, nodesByKey
 	if node.Category == "" {
 		return fmt.Errorf("%w: node '%s' has empty category", ErrInvalidConfig, node.Key)
 	}
-	return nil
+
+	return validateNodeEffects(node)
+}
+
+// validateNodeEffects rejects blank or repeated effect names
+func validateNodeEffects(node *NodeConfig) error {
+	if node.Effects == nil {
+		return nil
+	}
+
+	check := func(kind string, names []string) error {
+		seen := make(map[string]bool, len(names))
+		for _, name := range names {
+			if name == "" {
+				return fmt.Errorf("%w: node '%s' has an empty %s effect", ErrInvalidConfig, node.Key, kind)
+			}
+			if seen[name] {
+				return fmt.Errorf("%w: node '%s' lists %s effect '%s' twice", ErrInvalidConfig, node.Key, kind, name)
+			}
+			seen[name] = true
+		}
+		return nil
+	}
+
+	if err := check("feature", node.Effects.Features); err != nil {
+		return err
+	}
+	if err := check("item", node.Effects.Items); err != nil {
+		return err
+	}
+	deltaKeys := make([]string, 0, len(node.Effects.ConfigDeltas))
+	for _, delta := range node.Effects.ConfigDeltas {
+		deltaKeys = append(deltaKeys, delta.Key)
+	}
+	return check("config delta", deltaKeys)
 }
 
 // detectCycles uses DFS to find cycles in the tree
@@ -222,8 +281,11 @@ func detectCycles(nodes []NodeConfig, nodesByKey map[string]*NodeConfig) error {
 				continue
 			}
 
-			if err := dfs(staticKey); err != nil {
-				return err
+			// Any alternative could be the one unlocked, so all must be acyclic
+			for _, key := range StaticAlternatives(staticKey) {
+				if err := dfs(key); err != nil {
+					return err
+				}
 			}
 		}
 
@@ -266,18 +328,40 @@ func (t *treeLoader) SyncToDatabase(ctx context.Context, config *TreeConfig, rep
 		return nil, err
 	}
 
+	result, err := t.syncNodes(ctx, repo, config.Nodes, existingByKey, false)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Info("Progression tree sync completed",
+		"inserted", result.NodesInserted,
+		"updated", result.NodesUpdated,
+		"skipped", result.NodesSkipped,
+		"auto_unlocked", result.AutoUnlocked)
+
+	if err := syncutil.UpdateMetadata(ctx, repo, ConfigFileName, fileState); err != nil {
+		log.Warn("Failed to update sync metadata", "error", err)
+	}
+
+	return result, nil
+}
+
+// syncNodes inserts or updates the given nodes, each after its prerequisites.
+// Existing nodes whose columns match are skipped unless force is set, which
+// also rewrites their prerequisites and modifiers.
+func (t *treeLoader) syncNodes(ctx context.Context, repo repository.Progression, nodes []NodeConfig, existingByKey map[string]*domain.ProgressionNode, force bool) (*SyncResult, error) {
 	result := &SyncResult{}
 	processed := make(map[string]bool)
 	insertedNodeIDs := make(map[string]int)
 
-	for len(processed) < len(config.Nodes) {
+	for len(processed) < len(nodes) {
 		progressMade := false
-		for _, nodeConfig := range config.Nodes {
+		for _, nodeConfig := range nodes {
 			if processed[nodeConfig.Key] || !t.arePrerequisitesMet(nodeConfig, existingByKey, insertedNodeIDs) {
 				continue
 			}
 
-			if err := t.syncOneNode(ctx, repo, &nodeConfig, existingByKey, insertedNodeIDs, result); err != nil {
+			if err := t.syncOneNode(ctx, repo, &nodeConfig, existingByKey, insertedNodeIDs, force, result); err != nil {
 				return nil, err
 			}
 
@@ -289,17 +373,6 @@ func (t *treeLoader) SyncToDatabase(ctx context.Context, config *TreeConfig, rep
 			return nil, fmt.Errorf("unable to process all nodes - possible circular dependency")
 		}
 	}
-
-	log.Info("Progression tree sync completed",
-		"inserted", result.NodesInserted,
-		"updated", result.NodesUpdated,
-		"skipped", result.NodesSkipped,
-		"auto_unlocked", result.AutoUnlocked)
-
-	if err := syncutil.UpdateMetadata(ctx, repo, ConfigFileName, fileState); err != nil {
-		log.Warn("Failed to update sync metadata", "error", err)
-	}
-
 	return result, nil
 }
 
@@ -329,27 +402,33 @@ func (t *treeLoader) arePrerequisitesMet(node NodeConfig, existingByKey map[stri
 			continue
 		}
 
-		// Check if static prerequisite exists
-		if _, ok := existingByKey[staticKey]; !ok {
-			if _, ok := insertedNodeIDs[staticKey]; !ok {
-				return false
+		// Check if every alternative of the static prerequisite exists
+		for _, key := range StaticAlternatives(staticKey) {
+			if _, ok := existingByKey[key]; !ok {
+				if _, ok := insertedNodeIDs[key]; !ok {
+					return false
+				}
 			}
 		}
 	}
 	return true
 }
 
-func (t *treeLoader) syncOneNode(ctx context.Context, repo repository.Progression, nodeConfig *NodeConfig, existingByKey map[string]*domain.ProgressionNode, insertedNodeIDs map[string]int, result *SyncResult) error {
+func (t *treeLoader) syncOneNode(ctx context.Context, repo repository.Progression, nodeConfig *NodeConfig, existingByKey map[string]*domain.ProgressionNode, insertedNodeIDs map[string]int, force bool, result *SyncResult) error {
 	if existing, ok := existingByKey[nodeConfig.Key]; ok {
-		return t.syncExistingNode(ctx, repo, nodeConfig, existing, existingByKey, insertedNodeIDs, result)
+		return t.syncExistingNode(ctx, repo, nodeConfig, existing, existingByKey, insertedNodeIDs, force, result)
 	}
 	return t.syncNewNode(ctx, repo, nodeConfig, existingByKey, insertedNodeIDs, result)
 }
 
-func (t *treeLoader) syncExistingNode(ctx context.Context, repo repository.Progression, config *NodeConfig, existing *domain.ProgressionNode, existingByKey map[string]*domain.ProgressionNode, insertedNodeIDs map[string]int, result *SyncResult) error {
+func (t *treeLoader) syncExistingNode(ctx context.Context, repo repository.Progression, config *NodeConfig, existing *domain.ProgressionNode, existingByKey map[string]*domain.ProgressionNode, insertedNodeIDs map[string]int, force bool, result *SyncResult) error {
 	log := logger.FromContext(ctx)
 
-	if !t.needsUpdate(existing, config) {
+	if !force && !t.needsUpdate(existing, config) {
+		// Effects are not part of the compared columns, so they are always written
+		if err := syncNodeEffects(ctx, repo, existing.ID, config); err != nil {
+			return fmt.Errorf("failed to sync effects for '%s': %w", config.Key, err)
+		}
 		result.NodesSkipped++
 		return nil
 	}
@@ -415,6 +494,9 @@ func (t *treeLoader) syncPrerequisitesAndModifiers(ctx context.Context, repo rep
 	if err := syncBonusModifiers(ctx, repo, config); err != nil {
 		return fmt.Errorf("failed to sync bonus modifiers for '%s': %w", config.Key, err)
 	}
+	if err := syncNodeEffects(ctx, repo, nodeID, config); err != nil {
+		return fmt.Errorf("failed to sync effects for '%s': %w", config.Key, err)
+	}
 	return nil
 }
 
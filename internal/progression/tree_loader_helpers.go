@@ -11,7 +11,7 @@ import (
 
 // PrerequisiteSyncer is an optional interface for syncing prerequisites to junction table
 type PrerequisiteSyncer interface {
-	SyncPrerequisites(ctx context.Context, nodeID int, prerequisiteIDs []int) error
+	SyncPrerequisites(ctx context.Context, nodeID int, groups [][]int) error // One group per prerequisite, holding its alternatives
 }
 
 // DynamicPrerequisiteSyncer is an optional interface for syncing dynamic prerequisites
@@ -30,8 +30,8 @@ func syncPrerequisites(ctx context.Context, repo repository.Progression, nodeID
 		return nil
 	}
 
-	// Resolve only static prerequisite keys to IDs
-	prereqIDs := make([]int, 0, len(prerequisites))
+	// Resolve only static prerequisite keys to IDs, one group per prerequisite
+	groups := make([][]int, 0, len(prerequisites))
 	for _, prereqStr := range prerequisites {
 		isDynamic, _, staticKey, err := ParsePrerequisite(prereqStr)
 		if err != nil {
@@ -43,24 +43,29 @@ func syncPrerequisites(ctx context.Context, repo repository.Progression, nodeID
 			continue
 		}
 
-		var prereqID int
-
-		// Try existing nodes first
-		if existing, ok := existingByKey[staticKey]; ok {
-			prereqID = existing.ID
-		} else if id, ok := insertedNodeIDs[staticKey]; ok {
-			// Try newly inserted nodes
-			prereqID = id
-		} else {
-			// Prerequisites should have been validated earlier
-			return fmt.Errorf("prerequisite '%s' not found", staticKey)
+		alternatives := StaticAlternatives(staticKey)
+		group := make([]int, 0, len(alternatives))
+		for _, key := range alternatives {
+			var prereqID int
+
+			// Try existing nodes first
+			if existing, ok := existingByKey[key]; ok {
+				prereqID = existing.ID
+			} else if id, ok := insertedNodeIDs[key]; ok {
+				// Try newly inserted nodes
+				prereqID = id
+			} else {
+				// Prerequisites should have been validated earlier
+				return fmt.Errorf("prerequisite '%s' not found", key)
+			}
+
+			group = append(group, prereqID)
 		}
-
-		prereqIDs = append(prereqIDs, prereqID)
+		groups = append(groups, group)
 	}
 
 	// Sync to database (clear old, insert new)
-	return prereqSyncer.SyncPrerequisites(ctx, nodeID, prereqIDs)
+	return prereqSyncer.SyncPrerequisites(ctx, nodeID, groups)
 }
 
 // syncDynamicPrerequisites parses and stores dynamic prerequisites in JSONB column
@@ -95,6 +100,21 @@ func syncDynamicPrerequisites(ctx context.Context, repo repository.Progression,
 	return dynamicSyncer.UpdateNodeDynamicPrerequisites(ctx, nodeID, jsonData)
 }
 
+// syncNodeEffects stores a node's effects, clearing them if it declares none
+func syncNodeEffects(ctx context.Context, repo repository.Progression, nodeID int, config *NodeConfig) error {
+	effects := domain.NodeEffects{}
+	if config.Effects != nil {
+		effects = *config.Effects
+	}
+
+	jsonData, err := json.Marshal(effects)
+	if err != nil {
+		return fmt.Errorf("failed to marshal node effects: %w", err)
+	}
+
+	return repo.UpdateNodeEffects(ctx, nodeID, jsonData)
+}
+
 // BonusModifierSyncer is an optional interface for syncing bonus modifiers
 type BonusModifierSyncer interface {
 	SyncBonusModifiers(ctx context.Context, nodeKey string, sourceType string, modifiers []domain.ModifierConfig) error
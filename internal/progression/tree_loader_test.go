@@ -285,6 +285,37 @@ func TestTreeLoader_Validate(t *testing.T) {
 		err := loader.Validate(config)
 		assert.NoError(t, err)
 	})
+
+	t.Run("alternative prerequisites", func(t *testing.T) {
+		config := &TreeConfig{
+			Version: "1.0",
+			Nodes: []NodeConfig{
+				{Key: "root", Name: "Root", Type: "feature", Tier: 0, Size: "medium", Category: "core", MaxLevel: 1, Prerequisites: []string{}},
+				{Key: "crafting", Name: "Crafting", Type: "feature", Tier: 1, Size: "small", Category: "crafting", MaxLevel: 1, Prerequisites: []string{"root"}},
+				{Key: "economy", Name: "Economy", Type: "feature", Tier: 1, Size: "small", Category: "economy", MaxLevel: 1, Prerequisites: []string{"root"}},
+				{Key: "market", Name: "Market", Type: "feature", Tier: 2, Size: "small", Category: "economy", MaxLevel: 1, Prerequisites: []string{"crafting|economy"}},
+			},
+		}
+		assert.NoError(t, loader.Validate(config))
+
+		config.Nodes[3].Prerequisites = []string{"crafting|missing"}
+		err := loader.Validate(config)
+		assert.True(t, errors.Is(err, ErrMissingParent))
+		assert.Contains(t, err.Error(), "missing")
+	})
+
+	t.Run("tier below prerequisite", func(t *testing.T) {
+		config := &TreeConfig{
+			Version: "1.0",
+			Nodes: []NodeConfig{
+				{Key: "root", Name: "Root", Type: "feature", Tier: 2, Size: "medium", Category: "core", MaxLevel: 1, Prerequisites: []string{}},
+				{Key: "cheap", Name: "Cheap", Type: "item", Tier: 1, Size: "small", Category: "items", MaxLevel: 1, Prerequisites: []string{"root"}},
+			},
+		}
+		err := loader.Validate(config)
+		assert.True(t, errors.Is(err, ErrInvalidConfig))
+		assert.Contains(t, err.Error(), "cheap")
+	})
 }
 
 func TestTreeLoader_CycleDetection(t *testing.T) {
@@ -7,7 +7,8 @@ import (
 	"github.com/osse101/BrandishBot_Go/internal/domain"
 )
 
-// mapItemToProgressionKey correctly maps an item's internal_name to its progression node key.
+// mapItemToProgressionKey maps an item's internal_name to its progression node
+// key. It is the fallback for items no node's effects declare.
 func mapItemToProgressionKey(itemName string) string {
 	switch itemName {
 	case domain.ItemMoney:
@@ -39,8 +40,18 @@ func mapItemToProgressionKey(itemName string) string {
 	}
 }
 
-// IsFeatureUnlocked checks if a feature is available
+// IsFeatureUnlocked checks if a feature is available. A flag declared in node
+// effects is enabled by unlocking a node that declares it; any other key is
+// checked as a node key.
 func (s *service) IsFeatureUnlocked(ctx context.Context, featureKey string) (bool, error) {
+	effects, err := s.effects(ctx)
+	if err != nil {
+		return false, err
+	}
+	if enabled, declared := effects.Feature(featureKey); declared {
+		return enabled, nil
+	}
+
 	// Check cache first (hottest query in the system)
 	if unlocked, found := s.unlockCache.Get(featureKey, 1); found {
 		return unlocked, nil
@@ -58,8 +69,17 @@ func (s *service) IsFeatureUnlocked(ctx context.Context, featureKey string) (boo
 	return unlocked, nil
 }
 
-// IsItemUnlocked checks if an item is available
+// IsItemUnlocked checks if an item is available, from node effects when a
+// node declares the item
 func (s *service) IsItemUnlocked(ctx context.Context, itemName string) (bool, error) {
+	effects, err := s.effects(ctx)
+	if err != nil {
+		return false, err
+	}
+	if enabled, declared := effects.Item(itemName); declared {
+		return enabled, nil
+	}
+
 	nodeKey := mapItemToProgressionKey(itemName)
 
 	// Check cache first
@@ -105,12 +125,21 @@ func (s *service) AreItemsUnlocked(ctx context.Context, itemNames []string) (map
 		return make(map[string]bool), nil
 	}
 
+	effects, err := s.effects(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	result := make(map[string]bool, len(itemNames))
 	uncachedKeys := make([]string, 0)
 	uncachedNames := make([]string, 0)
 
-	// Check cache first for all items
+	// Check node effects, then the cache, for all items
 	for _, itemName := range itemNames {
+		if enabled, declared := effects.Item(itemName); declared {
+			result[itemName] = enabled
+			continue
+		}
 		nodeKey := mapItemToProgressionKey(itemName)
 
 		if unlocked, found := s.unlockCache.Get(nodeKey, 1); found {
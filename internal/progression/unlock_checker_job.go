@@ -18,6 +18,14 @@ func NewUnlockCheckerJob(service Service) *UnlockCheckerJob {
 	}
 }
 
+// UnlockCheckerJobType identifies unlock checker jobs in the worker pool
+const UnlockCheckerJobType = "progression_unlock_check"
+
+// JobType returns the worker pool job type (implements worker.TypedJob interface)
+func (j *UnlockCheckerJob) JobType() string {
+	return UnlockCheckerJobType
+}
+
 // Process runs the unlock check (implements worker.Job interface)
 func (j *UnlockCheckerJob) Process(ctx context.Context) error {
 	log := logger.FromContext(ctx)
@@ -189,6 +189,27 @@ func TestAddContribution_Success(t *testing.T) {
 	assert.Equal(t, 75, progress.ContributionsAccumulated)
 }
 
+// fixedCommunityEffects reports the same community multiplier for every effect
+type fixedCommunityEffects float64
+
+func (f fixedCommunityEffects) CommunityMultiplier(ctx context.Context, effectType domain.EffectType) float64 {
+	return float64(f)
+}
+
+func TestAddContribution_CommunityBoost(t *testing.T) {
+	repo := NewMockRepository()
+	service := NewService(repo, NewMockUser(), nil, nil, nil, false, WithCommunityEffects(fixedCommunityEffects(1.5)))
+	ctx := context.Background()
+
+	repo.CreateUnlockProgress(ctx)
+
+	err := service.AddContribution(ctx, 50)
+	assert.NoError(t, err)
+
+	progress, _ := repo.GetActiveUnlockProgress(ctx)
+	assert.Equal(t, 75, progress.ContributionsAccumulated)
+}
+
 func TestAddContribution_CreatesProgress(t *testing.T) {
 	repo := NewMockRepository()
 	service := NewService(repo, NewMockUser(), nil, nil, nil, false)
@@ -34,8 +34,9 @@ func TestUpgradeProgressionBasic_ModifierApplication(t *testing.T) {
 			// Setup mock repository
 			mockRepo := NewMockRepository()
 			svc := &service{
-				repo:          mockRepo,
-				modifierCache: NewModifierCache(30 * time.Minute),
+				repo:           mockRepo,
+				modifierCache:  NewModifierCache(30 * time.Minute),
+				effectsApplier: NewEffectsApplier(),
 			}
 
 			// Create node with progression_rate modifier
@@ -100,8 +101,9 @@ func TestUpgradeProgressionTwo_StackingWithBasic(t *testing.T) {
 			// Setup mock repository
 			mockRepo := NewMockRepository()
 			svc := &service{
-				repo:          mockRepo,
-				modifierCache: NewModifierCache(30 * time.Minute),
+				repo:           mockRepo,
+				modifierCache:  NewModifierCache(30 * time.Minute),
+				effectsApplier: NewEffectsApplier(),
 			}
 
 			// Create first node (tier 1)
@@ -190,8 +192,9 @@ func TestUpgradeProgressionThree_TripleStacking(t *testing.T) {
 			// Setup mock repository
 			mockRepo := NewMockRepository()
 			svc := &service{
-				repo:          mockRepo,
-				modifierCache: NewModifierCache(30 * time.Minute),
+				repo:           mockRepo,
+				modifierCache:  NewModifierCache(30 * time.Minute),
+				effectsApplier: NewEffectsApplier(),
 			}
 
 			// Create all three nodes
@@ -288,8 +291,9 @@ func TestProgressionUpgrades_CacheInvalidation(t *testing.T) {
 	// Setup mock repository
 	mockRepo := NewMockRepository()
 	svc := &service{
-		repo:          mockRepo,
-		modifierCache: NewModifierCache(30 * time.Minute),
+		repo:           mockRepo,
+		modifierCache:  NewModifierCache(30 * time.Minute),
+		effectsApplier: NewEffectsApplier(),
 	}
 
 	// Create node
@@ -357,8 +361,9 @@ func TestProgressionUpgrades_FallbackBehavior(t *testing.T) {
 	// Setup mock repository that returns empty results
 	mockRepo := NewMockRepository()
 	svc := &service{
-		repo:          mockRepo,
-		modifierCache: NewModifierCache(30 * time.Minute),
+		repo:           mockRepo,
+		modifierCache:  NewModifierCache(30 * time.Minute),
+		effectsApplier: NewEffectsApplier(),
 	}
 
 	// Test with no modifiers configured - should return base value
@@ -0,0 +1,168 @@
+package progression
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/logger"
+)
+
+// VoteWeightTier gives votes from users whose contribution score is at least
+// MinScore the given Weight
+type VoteWeightTier struct {
+	MinScore float64
+	Weight   int
+}
+
+// WithVoteWeights scales votes by the voter's contribution score. Tiers must
+// be in ascending score order; a user below every tier votes with weight 1.
+func WithVoteWeights(tiers []VoteWeightTier) Option {
+	return func(s *service) {
+		s.voteWeights = tiers
+	}
+}
+
+// voteWeight returns the weight of a vote from the user. A failed score
+// lookup counts the vote once rather than rejecting it.
+func (s *service) voteWeight(ctx context.Context, userID string) int {
+	if len(s.voteWeights) == 0 {
+		return 1
+	}
+
+	score, err := s.repo.GetUserContributionScore(ctx, userID)
+	if err != nil {
+		logger.FromContext(ctx).Warn("Failed to get contribution score for vote weight", "userID", userID, "error", err)
+		return 1
+	}
+
+	weight := 1
+	for _, tier := range s.voteWeights {
+		if score < tier.MinScore {
+			break
+		}
+		weight = tier.Weight
+	}
+	return weight
+}
+
+// castDelegatedVotes votes for the option on behalf of everyone who delegated
+// to the user. The delegate's own vote is already recorded, so failures are
+// only logged.
+func (s *service) castDelegatedVotes(ctx context.Context, delegateID string, session *domain.ProgressionVotingSession, option *domain.ProgressionVotingOption) {
+	log := logger.FromContext(ctx)
+
+	delegators, err := s.repo.GetVoteDelegators(ctx, delegateID)
+	if err != nil {
+		log.Warn("Failed to get vote delegators", "userID", delegateID, "error", err)
+		return
+	}
+	if len(delegators) == 0 {
+		return
+	}
+
+	weights := make(map[string]int, len(delegators))
+	for _, delegatorID := range delegators {
+		weights[delegatorID] = s.voteWeight(ctx, delegatorID)
+	}
+
+	cast, err := s.repo.RecordDelegatedVotes(ctx, delegateID, session.ID, option.ID, option.NodeID, option.TargetLevel, weights)
+	if err != nil {
+		log.Warn("Failed to cast delegated votes", "userID", delegateID, "sessionID", session.ID, "error", err)
+		return
+	}
+	if cast > 0 {
+		log.Info("Delegated votes cast", "userID", delegateID, "sessionID", session.ID, "votes", cast)
+	}
+}
+
+// DelegateVote hands the user's future session votes to delegateUsername on
+// the same platform, replacing any earlier delegation. Delegations do not
+// chain, so neither side may already be part of one in the other direction.
+func (s *service) DelegateVote(ctx context.Context, platform, platformID, username, delegateUsername string) error {
+	user, err := s.resolveUserByPlatform(ctx, platform, platformID, username)
+	if err != nil {
+		return err
+	}
+
+	delegate, err := s.user.GetUserByPlatformUsername(ctx, platform, delegateUsername)
+	if err != nil && !errors.Is(err, domain.ErrUserNotFound) {
+		return fmt.Errorf("failed to resolve delegate: %w", err)
+	}
+	if delegate == nil {
+		return domain.ErrUserNotFound
+	}
+	if delegate.ID == user.ID {
+		return domain.ErrSelfDelegation
+	}
+
+	delegateDelegation, err := s.repo.GetVoteDelegation(ctx, delegate.ID)
+	if err != nil {
+		return err
+	}
+	if delegateDelegation != nil {
+		return domain.ErrVoteDelegationChain
+	}
+	delegators, err := s.repo.GetVoteDelegators(ctx, user.ID)
+	if err != nil {
+		return err
+	}
+	if len(delegators) > 0 {
+		return domain.ErrVoteDelegationChain
+	}
+
+	if err := s.repo.SetVoteDelegation(ctx, user.ID, delegate.ID); err != nil {
+		return err
+	}
+
+	logger.FromContext(ctx).Info("Vote delegated", "userID", user.ID, "delegateID", delegate.ID)
+	return nil
+}
+
+// RevokeVoteDelegation removes the user's delegation. Votes already cast by
+// the delegate stand until the user votes themselves.
+func (s *service) RevokeVoteDelegation(ctx context.Context, platform, platformID string) error {
+	user, err := s.user.GetUserByPlatformID(ctx, platform, platformID)
+	if err != nil {
+		return err
+	}
+	if user == nil {
+		return domain.ErrUserNotFound
+	}
+
+	removed, err := s.repo.DeleteVoteDelegation(ctx, user.ID)
+	if err != nil {
+		return err
+	}
+	if !removed {
+		return domain.ErrVoteDelegationNotFound
+	}
+
+	logger.FromContext(ctx).Info("Vote delegation revoked", "userID", user.ID)
+	return nil
+}
+
+// GetVoteDelegation returns the user's delegation
+func (s *service) GetVoteDelegation(ctx context.Context, platform, platformID string) (*domain.VoteDelegation, error) {
+	user, err := s.user.GetUserByPlatformID(ctx, platform, platformID)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, domain.ErrUserNotFound
+	}
+
+	delegation, err := s.repo.GetVoteDelegation(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+	if delegation == nil {
+		return nil, domain.ErrVoteDelegationNotFound
+	}
+
+	if delegate, err := s.user.GetUserByID(ctx, delegation.DelegateID); err == nil && delegate != nil {
+		delegation.DelegateUsername = delegate.Username
+	}
+	return delegation, nil
+}
@@ -0,0 +1,120 @@
+package progression
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+)
+
+func TestVoteWeight(t *testing.T) {
+	ctx := context.Background()
+	repo := NewMockRepository()
+	repo.contributionScores["regular"] = 1500
+	repo.contributionScores["veteran"] = 9000
+	svc := NewService(repo, NewMockUser(), nil, nil, nil, false,
+		WithVoteWeights([]VoteWeightTier{{MinScore: 1000, Weight: 2}, {MinScore: 5000, Weight: 3}})).(*service)
+
+	assert.Equal(t, 1, svc.voteWeight(ctx, "newcomer"))
+	assert.Equal(t, 2, svc.voteWeight(ctx, "regular"))
+	assert.Equal(t, 3, svc.voteWeight(ctx, "veteran"))
+
+	unweighted := NewService(repo, NewMockUser(), nil, nil, nil, false).(*service)
+	assert.Equal(t, 1, unweighted.voteWeight(ctx, "veteran"))
+}
+
+func TestDelegateVote(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("rejects delegating to yourself", func(t *testing.T) {
+		svc := NewService(NewMockRepository(), NewMockUser(), nil, nil, nil, false)
+
+		err := svc.DelegateVote(ctx, domain.PlatformDiscord, "user1", "testuser", "testuser")
+
+		assert.ErrorIs(t, err, domain.ErrSelfDelegation)
+	})
+
+	t.Run("rejects an unknown delegate", func(t *testing.T) {
+		svc := NewService(NewMockRepository(), NewMockUser(), nil, nil, nil, false)
+
+		err := svc.DelegateVote(ctx, domain.PlatformDiscord, "user1", "testuser", "nobody")
+
+		assert.ErrorIs(t, err, domain.ErrUserNotFound)
+	})
+
+	t.Run("rejects chains in either direction", func(t *testing.T) {
+		repo := NewMockRepository()
+		svc := NewService(repo, NewMockUser(), nil, nil, nil, false)
+		require.NoError(t, svc.DelegateVote(ctx, domain.PlatformDiscord, "user1", "testuser", "testuser2"))
+
+		assert.ErrorIs(t, svc.DelegateVote(ctx, domain.PlatformDiscord, "user3", "testuser3", "testuser"), domain.ErrVoteDelegationChain)
+		assert.ErrorIs(t, svc.DelegateVote(ctx, domain.PlatformDiscord, "user2", "testuser2", "testuser3"), domain.ErrVoteDelegationChain)
+	})
+
+	t.Run("revoke removes the delegation", func(t *testing.T) {
+		svc := NewService(NewMockRepository(), NewMockUser(), nil, nil, nil, false)
+		require.NoError(t, svc.DelegateVote(ctx, domain.PlatformDiscord, "user1", "testuser", "testuser2"))
+
+		delegation, err := svc.GetVoteDelegation(ctx, domain.PlatformDiscord, "user1")
+		require.NoError(t, err)
+		assert.Equal(t, "testuser2", delegation.DelegateUsername)
+
+		require.NoError(t, svc.RevokeVoteDelegation(ctx, domain.PlatformDiscord, "user1"))
+		assert.ErrorIs(t, svc.RevokeVoteDelegation(ctx, domain.PlatformDiscord, "user1"), domain.ErrVoteDelegationNotFound)
+	})
+}
+
+func TestVoteForUnlock_Delegation(t *testing.T) {
+	ctx := context.Background()
+	setup := func(t *testing.T) (*MockRepository, Service, *domain.ProgressionVotingSession) {
+		t.Helper()
+		repo := NewMockRepository()
+		setupTestTree(repo)
+		repo.contributionScores["test-user-1"] = 2000
+		svc := NewService(repo, NewMockUser(), nil, nil, nil, false,
+			WithVoteWeights([]VoteWeightTier{{MinScore: 1000, Weight: 2}}))
+		require.NoError(t, svc.StartVotingSession(ctx, nil))
+		require.NoError(t, svc.DelegateVote(ctx, domain.PlatformDiscord, "user1", "testuser", "testuser2"))
+		session, err := repo.GetActiveSession(ctx)
+		require.NoError(t, err)
+		return repo, svc, session
+	}
+
+	t.Run("the delegate's vote carries the delegator's weighted vote", func(t *testing.T) {
+		repo, svc, session := setup(t)
+
+		require.NoError(t, svc.VoteForUnlock(ctx, domain.PlatformDiscord, "user2", "testuser2", 1))
+
+		updated, _ := repo.GetSessionByID(ctx, session.ID)
+		assert.Equal(t, 3, updated.Options[0].VoteCount)
+		assert.Equal(t, 2, updated.Options[0].VoterCount)
+		voted, _ := repo.HasUserVotedInSession(ctx, "test-user-1", session.ID)
+		assert.True(t, voted)
+	})
+
+	t.Run("the delegator's own vote replaces the delegated one", func(t *testing.T) {
+		repo, svc, session := setup(t)
+		require.NoError(t, svc.VoteForUnlock(ctx, domain.PlatformDiscord, "user2", "testuser2", 1))
+
+		require.NoError(t, svc.VoteForUnlock(ctx, domain.PlatformDiscord, "user1", "testuser", 2))
+
+		updated, _ := repo.GetSessionByID(ctx, session.ID)
+		assert.Equal(t, 1, updated.Options[0].VoteCount)
+		assert.Equal(t, 2, updated.Options[1].VoteCount)
+		assert.ErrorIs(t, svc.VoteForUnlock(ctx, domain.PlatformDiscord, "user1", "testuser", 1), domain.ErrUserAlreadyVoted)
+	})
+
+	t.Run("delegates cannot overwrite a vote the delegator already cast", func(t *testing.T) {
+		repo, svc, session := setup(t)
+		require.NoError(t, svc.VoteForUnlock(ctx, domain.PlatformDiscord, "user1", "testuser", 2))
+
+		require.NoError(t, svc.VoteForUnlock(ctx, domain.PlatformDiscord, "user2", "testuser2", 1))
+
+		updated, _ := repo.GetSessionByID(ctx, session.ID)
+		assert.Equal(t, 1, updated.Options[0].VoteCount)
+		assert.Equal(t, 2, updated.Options[1].VoteCount)
+	})
+}
@@ -24,17 +24,21 @@ func (s *service) VoteForUnlock(ctx context.Context, platform, platformID, usern
 		return err
 	}
 
-	// 3. Record vote atomically
-	if err := s.repo.CheckAndRecordVoteAtomic(ctx, user.ID, session.ID, selectedOption.ID, selectedOption.NodeID, selectedOption.TargetLevel); err != nil {
+	// 3. Record vote atomically, weighted by the user's contribution tier
+	weight := s.voteWeight(ctx, user.ID)
+	if err := s.repo.CheckAndRecordVoteAtomic(ctx, user.ID, session.ID, selectedOption.ID, selectedOption.NodeID, selectedOption.TargetLevel, weight); err != nil {
 		return err
 	}
 
-	// 4. Record engagement
+	// 4. Vote for everyone who delegated to the user
+	s.castDelegatedVotes(ctx, user.ID, session, selectedOption)
+
+	// 5. Record engagement
 	if err := s.RecordEngagement(ctx, user.ID, domain.MetricTypeVoteCast, 1); err != nil {
 		log.Warn("Failed to record vote engagement", "userID", user.ID, "error", err)
 	}
 
-	log.Info("Vote recorded", "userID", user.ID, "platform", platform, "platformID", platformID, "optionIndex", optionIndex, "nodeKey", selectedOption.NodeDetails.NodeKey, "sessionID", session.ID)
+	log.Info("Vote recorded", "userID", user.ID, "platform", platform, "platformID", platformID, "optionIndex", optionIndex, "weight", weight, "nodeKey", selectedOption.NodeDetails.NodeKey, "sessionID", session.ID)
 	return nil
 }
 
@@ -126,3 +130,37 @@ func (s *service) GetMostRecentVotingSession(ctx context.Context) (*domain.Progr
 	s.enrichSessionWithEstimates(ctx, session)
 	return session, nil
 }
+
+// GetVotingSessionHistory returns a page of completed voting sessions with their
+// vote totals, winning option and the node the winner unlocked
+func (s *service) GetVotingSessionHistory(ctx context.Context, limit, offset int) (*domain.VotingSessionHistory, error) {
+	if limit <= 0 || limit > 50 {
+		limit = 10
+	}
+	if offset < 0 {
+		offset = 0
+	}
+
+	recaps, total, err := s.repo.GetCompletedSessions(ctx, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range recaps {
+		recap := &recaps[i]
+		for j := range recap.Options {
+			opt := &recap.Options[j]
+			recap.TotalVotes += opt.VoteCount
+			if recap.WinningOptionID != nil && opt.ID == *recap.WinningOptionID {
+				recap.Winner = opt
+			}
+		}
+	}
+
+	return &domain.VotingSessionHistory{
+		Sessions: recaps,
+		Total:    total,
+		Limit:    limit,
+		Offset:   offset,
+	}, nil
+}
@@ -1,13 +1,32 @@
 package progression
 
 import (
+	"context"
+
 	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/rng"
 	"github.com/osse101/BrandishBot_Go/internal/utils"
 )
 
 // RandomIntFunc represents a function that returns a random integer in [0, max).
 type RandomIntFunc func(max int) int
 
+// WithRNG draws voting options, tie-breaks and random unlock targets from a
+// seeded source per operation instead of crypto/rand
+func WithRNG(provider *rng.Provider) Option {
+	return func(s *service) {
+		s.rng = provider
+	}
+}
+
+// intn returns the random source for one operation
+func (s *service) intn(ctx context.Context, op string) RandomIntFunc {
+	if s.rng == nil {
+		return utils.SecureRandomInt
+	}
+	return s.rng.ForOperation(ctx, op).Intn
+}
+
 // selectRandomNodes selects a random subset of nodes.
 // If rng is nil, it uses utils.SecureRandomInt.
 func selectRandomNodes(nodes []*domain.ProgressionNode, count int, rng RandomIntFunc) []*domain.ProgressionNode {
@@ -32,6 +51,8 @@ func selectRandomNodes(nodes []*domain.ProgressionNode, count int, rng RandomInt
 }
 
 // findWinningOption determines the winning option based on votes and tie-breaking rules.
+// Options are ranked by weighted vote count, then by number of voters, then by
+// which reached the top count first.
 // If rng is nil, it uses utils.SecureRandomInt for random tie-breaking.
 func findWinningOption(options []domain.ProgressionVotingOption, rng RandomIntFunc) *domain.ProgressionVotingOption {
 	if rng == nil {
@@ -62,21 +83,30 @@ func findWinningOption(options []domain.ProgressionVotingOption, rng RandomIntFu
 	for i := 1; i < len(options); i++ {
 		opt := &options[i]
 
-		// Higher vote count wins
+		// Higher (weighted) vote count wins
 		if opt.VoteCount > winner.VoteCount {
 			winner = opt
 			continue
 		}
+		if opt.VoteCount < winner.VoteCount {
+			continue
+		}
 
-		// Tie-breaker: first to reach highest vote (LastHighestVoteAt)
-		if opt.VoteCount == winner.VoteCount {
-			if opt.LastHighestVoteAt != nil && winner.LastHighestVoteAt != nil {
-				if opt.LastHighestVoteAt.Before(*winner.LastHighestVoteAt) {
-					winner = opt
-				}
-			} else if opt.LastHighestVoteAt != nil {
+		// First tie-breaker: more voters, so a few heavy votes don't beat a broad base
+		if opt.VoterCount != winner.VoterCount {
+			if opt.VoterCount > winner.VoterCount {
 				winner = opt
 			}
+			continue
+		}
+
+		// Second tie-breaker: first to reach highest vote (LastHighestVoteAt)
+		if opt.LastHighestVoteAt != nil && winner.LastHighestVoteAt != nil {
+			if opt.LastHighestVoteAt.Before(*winner.LastHighestVoteAt) {
+				winner = opt
+			}
+		} else if opt.LastHighestVoteAt != nil {
+			winner = opt
 		}
 	}
 
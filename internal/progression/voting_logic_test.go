@@ -1,6 +1,7 @@
 package progression
 
 import (
+	"context"
 	"testing"
 	"time"
 
@@ -8,6 +9,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/rng"
 )
 
 func TestFindWinningOption_WithVotes(t *testing.T) {
@@ -37,6 +39,14 @@ func TestFindWinningOption_WithVotes(t *testing.T) {
 			},
 			expected: 102,
 		},
+		{
+			name: "weighted tie broken by voter count before LastHighestVoteAt",
+			options: []domain.ProgressionVotingOption{
+				{ID: 1, NodeID: 101, VoteCount: 6, VoterCount: 2, LastHighestVoteAt: &earlier},
+				{ID: 2, NodeID: 102, VoteCount: 6, VoterCount: 5, LastHighestVoteAt: &now}, // wins (more voters)
+			},
+			expected: 102,
+		},
 		{
 			name: "tie broken by LastHighestVoteAt (nil vs non-nil)",
 			options: []domain.ProgressionVotingOption{
@@ -212,4 +222,14 @@ func TestSelectRandomNodes(t *testing.T) {
 		assert.Equal(t, 3, selected[1].ID)
 		assert.Equal(t, 4, selected[2].ID)
 	})
+
+	t.Run("replayed seed repeats selection", func(t *testing.T) {
+		svc := &service{rng: rng.NewReplay(99)}
+		ctx := context.Background()
+
+		first := selectRandomNodes(nodes, 3, svc.intn(ctx, rng.OpVotingOptions))
+		for i := 0; i < 5; i++ {
+			assert.Equal(t, first, selectRandomNodes(nodes, 3, svc.intn(ctx, rng.OpVotingOptions)))
+		}
+	})
 }
@@ -8,7 +8,7 @@ import (
 	"github.com/osse101/BrandishBot_Go/internal/domain"
 	"github.com/osse101/BrandishBot_Go/internal/event"
 	"github.com/osse101/BrandishBot_Go/internal/logger"
-	"github.com/osse101/BrandishBot_Go/internal/utils"
+	"github.com/osse101/BrandishBot_Go/internal/rng"
 )
 
 const (
@@ -78,7 +78,7 @@ func (s *service) getFilteredAvailableNodes(ctx context.Context, progress *domai
 
 func (s *service) startVotingWithMultipleOptions(ctx context.Context, available []*domain.ProgressionNode, unlockedNodeID *int) error {
 	log := logger.FromContext(ctx)
-	selected := selectRandomNodes(available, MaxVotingOptions, nil)
+	selected := selectRandomNodes(available, MaxVotingOptions, s.intn(ctx, rng.OpVotingOptions))
 
 	sort.Slice(selected, func(i, j int) bool {
 		return selected[i].NodeKey < selected[j].NodeKey
@@ -257,7 +257,7 @@ func (s *service) EndVoting(ctx context.Context) (*domain.ProgressionVotingOptio
 		return nil, fmt.Errorf("no active voting session")
 	}
 
-	winner := findWinningOption(session.Options, nil)
+	winner := findWinningOption(session.Options, s.intn(ctx, rng.OpVotingWinner))
 	if winner == nil {
 		return nil, fmt.Errorf("no voting options found")
 	}
@@ -328,7 +328,10 @@ func (s *service) AddContribution(ctx context.Context, amount int) error {
 func (s *service) applyContributionBoosts(ctx context.Context, amount int) int {
 	isBoosted, _ := s.IsFeatureUnlocked(ctx, "upgrade_contribution_boost")
 	if isBoosted {
-		return (amount * 3) / 2
+		amount = (amount * 3) / 2
+	}
+	if s.communityEffects != nil {
+		amount = int(float64(amount) * s.communityEffects.CommunityMultiplier(ctx, domain.EffectContributionBoost))
 	}
 	return amount
 }
@@ -564,7 +567,7 @@ func (s *service) resolveNextTarget(ctx context.Context) (*domain.ProgressionNod
 		return nil, 0, 0
 	}
 
-	newTargetNode := available[utils.SecureRandomInt(len(available))]
+	newTargetNode := available[s.intn(ctx, rng.OpProgressionTarget)(len(available))]
 	newTargetLevel := s.calculateNextTargetLevel(ctx, newTargetNode)
 	log.Info("No active vote, picked random next target", "nodeKey", newTargetNode.NodeKey)
 	return newTargetNode, newTargetLevel, 0
@@ -587,7 +590,7 @@ func (s *service) resolveSessionWinner(ctx context.Context, session *domain.Prog
 		_ = s.repo.ResumeVotingSession(ctx, session.ID)
 	}
 
-	winner := findWinningOption(session.Options, nil)
+	winner := findWinningOption(session.Options, s.intn(ctx, rng.OpVotingWinner))
 	if winner != nil {
 		winnerID := winner.ID
 		if err := s.repo.EndVotingSession(ctx, session.ID, &winnerID); err != nil {
@@ -736,7 +739,7 @@ func (s *service) resumeFrozenVotingSession(ctx context.Context, sessionID int)
 
 func (s *service) setInitialTarget(ctx context.Context, available []*domain.ProgressionNode) error {
 	log := logger.FromContext(ctx)
-	node := available[utils.SecureRandomInt(len(available))]
+	node := available[s.intn(ctx, rng.OpProgressionTarget)(len(available))]
 
 	progress, err := s.ensureActiveUnlockProgress(ctx)
 	if err != nil {
@@ -790,7 +793,7 @@ func (s *service) startVotingWithOptions(ctx context.Context, options []*domain.
 		return fmt.Errorf("no options provided for voting")
 	}
 
-	selected := selectRandomNodes(options, MaxVotingOptions, nil)
+	selected := selectRandomNodes(options, MaxVotingOptions, s.intn(ctx, rng.OpVotingOptions))
 
 	// Enforce consistent ordering of options (sort by NodeKey)
 	sort.Slice(selected, func(i, j int) bool {
@@ -0,0 +1,26 @@
+package progressionbulk
+
+// JobType identifies bulk progression operations in the worker pool
+const JobType = "progression_bulk"
+
+// Limits
+const (
+	// DefaultBatchSize is how many users one batch covers, and so one
+	// transaction and one audit entry, when the configured size is not positive
+	DefaultBatchSize = 500
+
+	// MaxUsers bounds the users one operation may select
+	MaxUsers = 100000
+)
+
+// MetadataOperationID is added to the metadata of every granted progression,
+// pointing back at the operation that granted it
+const MetadataOperationID = "bulk_operation_id"
+
+// Log messages
+const (
+	LogMsgOperationQueued    = "Bulk progression operation queued"
+	LogMsgOperationCompleted = "Bulk progression operation completed"
+	LogMsgOperationFailed    = "Bulk progression operation failed"
+	LogMsgStatusUpdateFailed = "Failed to update bulk progression operation status"
+)
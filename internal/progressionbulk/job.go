@@ -0,0 +1,25 @@
+package progressionbulk
+
+import (
+	"context"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+)
+
+// Job applies one bulk progression operation on the worker pool
+type Job struct {
+	service  *service
+	op       domain.BulkProgressionOperation
+	userIDs  []string
+	metadata map[string]interface{}
+}
+
+// JobType returns the worker pool job type
+func (j *Job) JobType() string {
+	return JobType
+}
+
+// Process applies the operation's batches in order
+func (j *Job) Process(ctx context.Context) error {
+	return j.service.run(ctx, j.op, j.userIDs, j.metadata)
+}
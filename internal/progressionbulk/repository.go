@@ -0,0 +1,43 @@
+package progressionbulk
+
+import (
+	"context"
+	"time"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+)
+
+// Batch is one slice of an operation's users, applied in a single transaction
+type Batch struct {
+	OperationID     int64
+	Number          int // 1-based
+	Action          domain.BulkProgressionAction
+	ProgressionType string
+	ProgressionKey  string
+	Metadata        map[string]interface{} // Stored with granted progressions
+	UserIDs         []string
+}
+
+// Repository stores bulk progression operations and applies their batches
+type Repository interface {
+	// GetStatsEventParticipants returns the users who recorded a stats event
+	// of eventType in [since, until)
+	GetStatsEventParticipants(ctx context.Context, eventType string, since, until time.Time) ([]string, error)
+
+	// CreateOperation records a new queued operation
+	CreateOperation(ctx context.Context, op domain.BulkProgressionOperation) (*domain.BulkProgressionOperation, error)
+
+	// GetOperation returns an operation, or nil if there is none with that ID
+	GetOperation(ctx context.Context, id int64) (*domain.BulkProgressionOperation, error)
+
+	// SetStatus moves an operation to status, recording errMsg for failures
+	SetStatus(ctx context.Context, id int64, status domain.BulkProgressionStatus, errMsg string) error
+
+	// ApplyBatch grants or revokes the progression for the batch's users,
+	// writes the batch's audit entry and advances the operation's progress in
+	// one transaction. It returns how many users' progression changed.
+	ApplyBatch(ctx context.Context, batch Batch) (int, error)
+
+	// GetAuditTrail returns an operation's committed batches in order
+	GetAuditTrail(ctx context.Context, operationID int64) ([]domain.BulkProgressionAuditEntry, error)
+}
@@ -0,0 +1,239 @@
+// Package progressionbulk grants or revokes a user progression, such as a
+// recipe, for many users at once. Operations run in batches on the worker
+// pool, report their progress as each batch commits and leave an audit entry
+// per batch.
+package progressionbulk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/logger"
+)
+
+// Errors returned by the service
+var (
+	// ErrOperationNotFound is returned for an unknown operation ID
+	ErrOperationNotFound = errors.New("bulk progression operation not found")
+	// ErrNoUsers is returned when the selection matches no users
+	ErrNoUsers = errors.New("no users selected")
+	// ErrBusy is returned when the worker pool cannot take the operation
+	ErrBusy = errors.New("worker pool is busy, try again later")
+)
+
+// Participants selects the users who recorded a stats event of EventType
+// in [Since, Until), e.g. everyone who took part in a stream event
+type Participants struct {
+	EventType string
+	Since     time.Time
+	Until     time.Time
+}
+
+// Request describes a bulk grant or revoke. The users are UserIDs together
+// with the Participants, if given.
+type Request struct {
+	Action          domain.BulkProgressionAction
+	ProgressionType string
+	ProgressionKey  string
+	UserIDs         []string
+	Participants    *Participants
+	Metadata        map[string]interface{} // Stored with granted progressions
+	Actor           string
+	Reason          string
+}
+
+// Service runs bulk progression operations
+type Service interface {
+	// Start validates the request, resolves its users and queues the
+	// operation on the worker pool. It returns the queued operation.
+	Start(ctx context.Context, req Request) (*domain.BulkProgressionOperation, error)
+
+	// GetOperation returns an operation and its progress
+	GetOperation(ctx context.Context, id int64) (*domain.BulkProgressionOperation, error)
+
+	// GetAuditTrail returns an operation's committed batches in order
+	GetAuditTrail(ctx context.Context, id int64) ([]domain.BulkProgressionAuditEntry, error)
+}
+
+// EnqueueFunc hands a job to the worker pool, failing instead of blocking
+// when the pool is full
+type EnqueueFunc func(job *Job) error
+
+// Config tunes bulk operations
+type Config struct {
+	// BatchSize is how many users each batch covers
+	BatchSize int
+}
+
+type service struct {
+	repo    Repository
+	enqueue EnqueueFunc
+	cfg     Config
+}
+
+// NewService creates a bulk progression service that runs operations through enqueue
+func NewService(repo Repository, enqueue EnqueueFunc, cfg Config) Service {
+	if cfg.BatchSize <= 0 {
+		cfg.BatchSize = DefaultBatchSize
+	}
+	return &service{repo: repo, enqueue: enqueue, cfg: cfg}
+}
+
+func (s *service) Start(ctx context.Context, req Request) (*domain.BulkProgressionOperation, error) {
+	if err := validateRequest(req); err != nil {
+		return nil, err
+	}
+
+	userIDs, err := s.resolveUsers(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if len(userIDs) == 0 {
+		return nil, ErrNoUsers
+	}
+	if len(userIDs) > MaxUsers {
+		return nil, fmt.Errorf("%w: %d users selected, at most %d allowed", domain.ErrInvalidInput, len(userIDs), MaxUsers)
+	}
+
+	op, err := s.repo.CreateOperation(ctx, domain.BulkProgressionOperation{
+		Action:          req.Action,
+		ProgressionType: req.ProgressionType,
+		ProgressionKey:  req.ProgressionKey,
+		Actor:           req.Actor,
+		Reason:          req.Reason,
+		Status:          domain.BulkProgressionQueued,
+		TotalUsers:      len(userIDs),
+		TotalBatches:    (len(userIDs) + s.cfg.BatchSize - 1) / s.cfg.BatchSize,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create bulk progression operation: %w", err)
+	}
+
+	job := &Job{service: s, op: *op, userIDs: userIDs, metadata: withOperationID(req.Metadata, op.ID)}
+	if err := s.enqueue(job); err != nil {
+		s.setStatus(ctx, op.ID, domain.BulkProgressionFailed, err.Error())
+		return nil, fmt.Errorf("%w: %v", ErrBusy, err)
+	}
+
+	logger.FromContext(ctx).Info(LogMsgOperationQueued,
+		"operation_id", op.ID, "action", op.Action, "progression_type", op.ProgressionType,
+		"progression_key", op.ProgressionKey, "users", op.TotalUsers, "actor", op.Actor)
+	return op, nil
+}
+
+func (s *service) GetOperation(ctx context.Context, id int64) (*domain.BulkProgressionOperation, error) {
+	op, err := s.repo.GetOperation(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if op == nil {
+		return nil, ErrOperationNotFound
+	}
+	return op, nil
+}
+
+func (s *service) GetAuditTrail(ctx context.Context, id int64) ([]domain.BulkProgressionAuditEntry, error) {
+	if _, err := s.GetOperation(ctx, id); err != nil {
+		return nil, err
+	}
+	return s.repo.GetAuditTrail(ctx, id)
+}
+
+// run applies an operation batch by batch, stopping at the first failure.
+// Batches committed before a failure stay applied and audited.
+func (s *service) run(ctx context.Context, op domain.BulkProgressionOperation, userIDs []string, metadata map[string]interface{}) error {
+	log := logger.FromContext(ctx)
+	s.setStatus(ctx, op.ID, domain.BulkProgressionRunning, "")
+
+	affected := 0
+	for number, start := 1, 0; start < len(userIDs); number, start = number+1, start+s.cfg.BatchSize {
+		end := min(start+s.cfg.BatchSize, len(userIDs))
+		n, err := s.repo.ApplyBatch(ctx, Batch{
+			OperationID:     op.ID,
+			Number:          number,
+			Action:          op.Action,
+			ProgressionType: op.ProgressionType,
+			ProgressionKey:  op.ProgressionKey,
+			Metadata:        metadata,
+			UserIDs:         userIDs[start:end],
+		})
+		if err != nil {
+			err = fmt.Errorf("batch %d: %w", number, err)
+			log.Error(LogMsgOperationFailed, "operation_id", op.ID, "error", err)
+			// The pool may be stopping, so record the failure even if ctx is done
+			s.setStatus(context.WithoutCancel(ctx), op.ID, domain.BulkProgressionFailed, err.Error())
+			return err
+		}
+		affected += n
+	}
+
+	s.setStatus(ctx, op.ID, domain.BulkProgressionCompleted, "")
+	log.Info(LogMsgOperationCompleted, "operation_id", op.ID, "users", len(userIDs), "affected", affected)
+	return nil
+}
+
+func (s *service) setStatus(ctx context.Context, id int64, status domain.BulkProgressionStatus, errMsg string) {
+	if err := s.repo.SetStatus(ctx, id, status, errMsg); err != nil {
+		logger.FromContext(ctx).Error(LogMsgStatusUpdateFailed, "operation_id", id, "status", status, "error", err)
+	}
+}
+
+// resolveUsers returns the requested user IDs followed by the participants,
+// without duplicates
+func (s *service) resolveUsers(ctx context.Context, req Request) ([]string, error) {
+	userIDs := make([]string, 0, len(req.UserIDs))
+	seen := make(map[string]bool, len(req.UserIDs))
+	add := func(ids []string) {
+		for _, id := range ids {
+			if id != "" && !seen[id] {
+				seen[id] = true
+				userIDs = append(userIDs, id)
+			}
+		}
+	}
+
+	add(req.UserIDs)
+	if p := req.Participants; p != nil {
+		participants, err := s.repo.GetStatsEventParticipants(ctx, p.EventType, p.Since, p.Until)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get event participants: %w", err)
+		}
+		add(participants)
+	}
+	return userIDs, nil
+}
+
+func validateRequest(req Request) error {
+	switch {
+	case req.Action != domain.BulkProgressionGrant && req.Action != domain.BulkProgressionRevoke:
+		return fmt.Errorf("%w: action must be %q or %q", domain.ErrInvalidInput, domain.BulkProgressionGrant, domain.BulkProgressionRevoke)
+	case req.ProgressionType == "" || req.ProgressionKey == "":
+		return fmt.Errorf("%w: progression type and key are required", domain.ErrInvalidInput)
+	case req.Actor == "":
+		return fmt.Errorf("%w: actor is required", domain.ErrInvalidInput)
+	case len(req.UserIDs) == 0 && req.Participants == nil:
+		return fmt.Errorf("%w: user IDs or participants are required", domain.ErrInvalidInput)
+	}
+	if p := req.Participants; p != nil {
+		if p.EventType == "" {
+			return fmt.Errorf("%w: participants event type is required", domain.ErrInvalidInput)
+		}
+		if !p.Until.After(p.Since) {
+			return fmt.Errorf("%w: participants window must end after it starts", domain.ErrInvalidInput)
+		}
+	}
+	return nil
+}
+
+// withOperationID copies metadata, adding the operation's ID
+func withOperationID(metadata map[string]interface{}, id int64) map[string]interface{} {
+	result := make(map[string]interface{}, len(metadata)+1)
+	for k, v := range metadata {
+		result[k] = v
+	}
+	result[MetadataOperationID] = id
+	return result
+}
@@ -0,0 +1,181 @@
+package progressionbulk_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/progressionbulk"
+	"github.com/osse101/BrandishBot_Go/internal/progressionbulk/mocks"
+)
+
+var errQueueFull = errors.New("worker queue is full")
+
+// fakeQueue keeps the last job queued instead of running it
+type fakeQueue struct {
+	job *progressionbulk.Job
+	err error // Returned when queueing
+}
+
+func (q *fakeQueue) enqueue(job *progressionbulk.Job) error {
+	if q.err != nil {
+		return q.err
+	}
+	q.job = job
+	return nil
+}
+
+func grantRequest(userIDs ...string) progressionbulk.Request {
+	return progressionbulk.Request{
+		Action:          domain.BulkProgressionGrant,
+		ProgressionType: "recipe",
+		ProgressionKey:  "recipe_festive_hat",
+		UserIDs:         userIDs,
+		Actor:           "admin",
+		Reason:          "winter event",
+	}
+}
+
+func createdOperation(total, batches int) func(context.Context, domain.BulkProgressionOperation) (*domain.BulkProgressionOperation, error) {
+	return func(_ context.Context, op domain.BulkProgressionOperation) (*domain.BulkProgressionOperation, error) {
+		op.ID = 9
+		if op.TotalUsers != total || op.TotalBatches != batches {
+			return nil, errors.New("unexpected totals")
+		}
+		return &op, nil
+	}
+}
+
+func TestStart_RunsBatchesInOrder(t *testing.T) {
+	ctx := context.Background()
+	mockRepo := mocks.NewMockRepository(t)
+	queue := &fakeQueue{}
+	svc := progressionbulk.NewService(mockRepo, queue.enqueue, progressionbulk.Config{BatchSize: 2})
+	since := time.Date(2026, 12, 24, 0, 0, 0, 0, time.UTC)
+	until := since.Add(24 * time.Hour)
+
+	req := grantRequest("u1", "u2", "u1")
+	req.Participants = &progressionbulk.Participants{EventType: "stream_event", Since: since, Until: until}
+	mockRepo.On("GetStatsEventParticipants", ctx, "stream_event", since, until).Return([]string{"u2", "u3"}, nil)
+	mockRepo.On("CreateOperation", ctx, mock.Anything).Return(createdOperation(3, 2))
+
+	op, err := svc.Start(ctx, req)
+
+	require.NoError(t, err)
+	assert.Equal(t, domain.BulkProgressionQueued, op.Status)
+	require.NotNil(t, queue.job)
+
+	var batches []progressionbulk.Batch
+	mockRepo.On("SetStatus", ctx, int64(9), domain.BulkProgressionRunning, "").Return(nil)
+	mockRepo.On("ApplyBatch", ctx, mock.Anything).Return(func(_ context.Context, b progressionbulk.Batch) (int, error) {
+		batches = append(batches, b)
+		return len(b.UserIDs), nil
+	})
+	mockRepo.On("SetStatus", ctx, int64(9), domain.BulkProgressionCompleted, "").Return(nil)
+
+	require.NoError(t, queue.job.Process(ctx))
+
+	require.Len(t, batches, 2)
+	assert.Equal(t, 1, batches[0].Number)
+	assert.Equal(t, []string{"u1", "u2"}, batches[0].UserIDs)
+	assert.Equal(t, 2, batches[1].Number)
+	assert.Equal(t, []string{"u3"}, batches[1].UserIDs)
+	assert.Equal(t, int64(9), batches[0].Metadata[progressionbulk.MetadataOperationID])
+}
+
+func TestStart_BatchFailureStopsOperation(t *testing.T) {
+	ctx := context.Background()
+	mockRepo := mocks.NewMockRepository(t)
+	queue := &fakeQueue{}
+	svc := progressionbulk.NewService(mockRepo, queue.enqueue, progressionbulk.Config{BatchSize: 2})
+	mockRepo.On("CreateOperation", ctx, mock.Anything).Return(createdOperation(3, 2))
+
+	_, err := svc.Start(ctx, grantRequest("u1", "u2", "u3"))
+	require.NoError(t, err)
+
+	mockRepo.On("SetStatus", ctx, int64(9), domain.BulkProgressionRunning, "").Return(nil)
+	mockRepo.On("ApplyBatch", ctx, mock.Anything).Return(0, errors.New("db down")).Once()
+	mockRepo.On("SetStatus", mock.Anything, int64(9), domain.BulkProgressionFailed, mock.MatchedBy(func(msg string) bool {
+		return msg != ""
+	})).Return(nil)
+
+	assert.Error(t, queue.job.Process(ctx))
+}
+
+func TestStart_QueueFull(t *testing.T) {
+	ctx := context.Background()
+	mockRepo := mocks.NewMockRepository(t)
+	queue := &fakeQueue{}
+	svc := progressionbulk.NewService(mockRepo, queue.enqueue, progressionbulk.Config{BatchSize: 2})
+	mockRepo.On("CreateOperation", ctx, mock.Anything).Return(createdOperation(1, 1))
+	queue.err = errQueueFull
+	mockRepo.On("SetStatus", ctx, int64(9), domain.BulkProgressionFailed, errQueueFull.Error()).Return(nil)
+
+	_, err := svc.Start(ctx, grantRequest("u1"))
+
+	assert.ErrorIs(t, err, progressionbulk.ErrBusy)
+}
+
+func TestStart_Invalid(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	tests := []struct {
+		name   string
+		modify func(*progressionbulk.Request)
+	}{
+		{"unknown action", func(r *progressionbulk.Request) { r.Action = "toggle" }},
+		{"missing key", func(r *progressionbulk.Request) { r.ProgressionKey = "" }},
+		{"missing actor", func(r *progressionbulk.Request) { r.Actor = "" }},
+		{"no selection", func(r *progressionbulk.Request) { r.UserIDs = nil }},
+		{"empty participants window", func(r *progressionbulk.Request) {
+			r.Participants = &progressionbulk.Participants{EventType: "stream_event", Since: now, Until: now}
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := mocks.NewMockRepository(t)
+			queue := &fakeQueue{}
+			svc := progressionbulk.NewService(mockRepo, queue.enqueue, progressionbulk.Config{BatchSize: 2})
+			req := grantRequest("u1")
+			tt.modify(&req)
+
+			_, err := svc.Start(ctx, req)
+
+			assert.ErrorIs(t, err, domain.ErrInvalidInput)
+		})
+	}
+}
+
+func TestStart_NoMatchingUsers(t *testing.T) {
+	ctx := context.Background()
+	mockRepo := mocks.NewMockRepository(t)
+	queue := &fakeQueue{}
+	svc := progressionbulk.NewService(mockRepo, queue.enqueue, progressionbulk.Config{BatchSize: 2})
+	since := time.Date(2026, 12, 24, 0, 0, 0, 0, time.UTC)
+	req := grantRequest()
+	req.Participants = &progressionbulk.Participants{EventType: "stream_event", Since: since, Until: since.Add(time.Hour)}
+	mockRepo.On("GetStatsEventParticipants", ctx, "stream_event", since, since.Add(time.Hour)).Return(nil, nil)
+
+	_, err := svc.Start(ctx, req)
+
+	assert.ErrorIs(t, err, progressionbulk.ErrNoUsers)
+}
+
+func TestGetAuditTrail_UnknownOperation(t *testing.T) {
+	ctx := context.Background()
+	mockRepo := mocks.NewMockRepository(t)
+	queue := &fakeQueue{}
+	svc := progressionbulk.NewService(mockRepo, queue.enqueue, progressionbulk.Config{BatchSize: 2})
+	mockRepo.On("GetOperation", ctx, int64(5)).Return(nil, nil)
+
+	_, err := svc.GetAuditTrail(ctx, 5)
+
+	assert.ErrorIs(t, err, progressionbulk.ErrOperationNotFound)
+}
@@ -0,0 +1,149 @@
+package raid
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/osse101/BrandishBot_Go/internal/effects"
+)
+
+// Tier is the bonus a raid or host of at least MinViewers viewers grants
+type Tier struct {
+	MinViewers int `json:"min_viewers"`
+
+	// XPMultiplier multiplies the job XP everyone in the community earns
+	// while the bonus lasts; 1 grants no XP bonus
+	XPMultiplier float64 `json:"xp_multiplier"`
+	// ContributionMultiplier multiplies the progression contributions the
+	// community makes while the bonus lasts; 1 grants no boost
+	ContributionMultiplier float64 `json:"contribution_multiplier"`
+	// DurationMinutes is how long the multipliers last. Raids while a bonus
+	// is active extend it.
+	DurationMinutes int `json:"duration_minutes"`
+
+	// BonusContribution is added to the current unlock progress at once
+	BonusContribution int `json:"bonus_contribution"`
+}
+
+// Duration returns how long the tier's multipliers last
+func (t Tier) Duration() time.Duration {
+	return time.Duration(t.DurationMinutes) * time.Minute
+}
+
+// Config is the top-level JSON structure for raid_bonuses.json
+type Config struct {
+	Version string `json:"version,omitempty"`
+	Enabled bool   `json:"enabled"`
+
+	// Raid and Host list the bonus tiers by ascending min_viewers. A raid
+	// smaller than the first tier grants nothing.
+	Raid []Tier `json:"raid"`
+	Host []Tier `json:"host"`
+}
+
+// TierFor returns the largest tier the viewer count reaches, or nil
+func (c Config) TierFor(tiers []Tier, viewers int) *Tier {
+	var match *Tier
+	for i := range tiers {
+		if viewers >= tiers[i].MinViewers {
+			match = &tiers[i]
+		}
+	}
+	return match
+}
+
+// Validate checks both tier lists are ascending and grant something usable
+func (c Config) Validate() error {
+	if err := validateTiers("raid", c.Raid); err != nil {
+		return err
+	}
+	return validateTiers("host", c.Host)
+}
+
+func validateTiers(kind string, tiers []Tier) error {
+	previous := 0
+	for i, tier := range tiers {
+		if tier.MinViewers < 1 {
+			return fmt.Errorf("%s tier %d: min_viewers must be at least 1", kind, i)
+		}
+		if i > 0 && tier.MinViewers <= previous {
+			return fmt.Errorf("%s tier %d: min_viewers must be higher than the previous tier", kind, i)
+		}
+		previous = tier.MinViewers
+		if tier.XPMultiplier < 1 || tier.XPMultiplier > effects.MaxMagnitude {
+			return fmt.Errorf("%s tier %d: xp_multiplier must be between 1 and %g", kind, i, effects.MaxMagnitude)
+		}
+		if tier.ContributionMultiplier < 1 || tier.ContributionMultiplier > effects.MaxMagnitude {
+			return fmt.Errorf("%s tier %d: contribution_multiplier must be between 1 and %g", kind, i, effects.MaxMagnitude)
+		}
+		if tier.DurationMinutes < 1 || tier.Duration() > effects.MaxDuration {
+			return fmt.Errorf("%s tier %d: duration_minutes must be positive and at most %s", kind, i, effects.MaxDuration)
+		}
+		if tier.BonusContribution < 0 {
+			return fmt.Errorf("%s tier %d: bonus_contribution must not be negative", kind, i)
+		}
+	}
+	return nil
+}
+
+// LoadConfig reads and validates the raid bonus config file
+func LoadConfig(path string) (*Config, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read raid bonus config: %w", err)
+	}
+
+	var config Config
+	if err := json.Unmarshal(data, &config); err != nil {
+		return nil, fmt.Errorf("failed to parse raid bonus config: %w", err)
+	}
+
+	if err := config.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid raid bonus config: %w", err)
+	}
+
+	return &config, nil
+}
+
+// Table holds the raid bonus config and can be reloaded from its file
+type Table struct {
+	path string
+
+	mu     sync.RWMutex
+	config Config
+}
+
+// NewTable loads the raid bonus config from path
+func NewTable(path string) (*Table, error) {
+	config, err := LoadConfig(path)
+	if err != nil {
+		return nil, err
+	}
+	return &Table{path: path, config: *config}, nil
+}
+
+// Reload re-reads the config from its file, keeping the current one if the file is invalid
+func (t *Table) Reload(ctx context.Context) error {
+	config, err := LoadConfig(t.path)
+	if err != nil {
+		return err
+	}
+	t.mu.Lock()
+	t.config = *config
+	t.mu.Unlock()
+	return nil
+}
+
+// Config returns the current config. A nil table is disabled.
+func (t *Table) Config() Config {
+	if t == nil {
+		return Config{}
+	}
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return t.config
+}
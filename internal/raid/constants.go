@@ -0,0 +1,8 @@
+package raid
+
+// Log messages
+const (
+	LogMsgBonusGranted      = "Raid bonus granted"
+	LogMsgNoBonusTier       = "Raid too small for a bonus"
+	LogWarnContributionFail = "Failed to add raid bonus contribution"
+)
@@ -0,0 +1,130 @@
+// Package raid turns raids and hosts reported by platform adapters into
+// timed community-wide bonuses. The bonus tiers come from a hot-reloaded
+// config; the XP and contribution multipliers are stored as community
+// effects, so the effects service applies and expires them.
+package raid
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/event"
+	"github.com/osse101/BrandishBot_Go/internal/logger"
+)
+
+// Service grants community bonuses for raids and hosts
+type Service interface {
+	// HandleRaid grants the bonus tier the raid's viewer count reaches
+	HandleRaid(ctx context.Context, evt domain.RaidEvent) (*domain.RaidBonus, error)
+
+	// HandleHost grants the host bonus tier the viewer count reaches
+	HandleHost(ctx context.Context, evt domain.RaidEvent) (*domain.RaidBonus, error)
+
+	// GetActiveBonuses returns the community's unexpired bonuses, soonest to
+	// expire first
+	GetActiveBonuses(ctx context.Context) ([]domain.CommunityEffect, error)
+}
+
+// EffectService stores the timed community multipliers
+type EffectService interface {
+	GrantCommunity(ctx context.Context, effectType domain.EffectType, magnitude float64, duration time.Duration, source string) (*domain.CommunityEffect, error)
+	GetCommunityActive(ctx context.Context) ([]domain.CommunityEffect, error)
+}
+
+// ProgressionService receives the one-off bonus contributions
+type ProgressionService interface {
+	AddContribution(ctx context.Context, amount int) error
+}
+
+// Publisher publishes community bonus events
+type Publisher interface {
+	PublishWithRetry(ctx context.Context, evt event.Event)
+}
+
+type service struct {
+	table       *Table
+	effects     EffectService
+	progression ProgressionService
+	publisher   Publisher
+}
+
+// NewService creates a raid bonus service. publisher may be nil.
+func NewService(table *Table, effects EffectService, progression ProgressionService, publisher Publisher) Service {
+	return &service{
+		table:       table,
+		effects:     effects,
+		progression: progression,
+		publisher:   publisher,
+	}
+}
+
+func (s *service) HandleRaid(ctx context.Context, evt domain.RaidEvent) (*domain.RaidBonus, error) {
+	return s.grant(ctx, domain.RaidKindRaid, evt, s.table.Config().Raid)
+}
+
+func (s *service) HandleHost(ctx context.Context, evt domain.RaidEvent) (*domain.RaidBonus, error) {
+	return s.grant(ctx, domain.RaidKindHost, evt, s.table.Config().Host)
+}
+
+func (s *service) GetActiveBonuses(ctx context.Context) ([]domain.CommunityEffect, error) {
+	return s.effects.GetCommunityActive(ctx)
+}
+
+func (s *service) grant(ctx context.Context, kind domain.RaidKind, evt domain.RaidEvent, tiers []Tier) (*domain.RaidBonus, error) {
+	bonus := &domain.RaidBonus{
+		Kind:        kind,
+		Platform:    evt.Platform,
+		FromChannel: evt.FromChannel,
+		Viewers:     evt.Viewers,
+		Effects:     []domain.CommunityEffect{},
+	}
+	cfg := s.table.Config()
+	if !cfg.Enabled {
+		return bonus, nil
+	}
+
+	log := logger.FromContext(ctx)
+	tier := cfg.TierFor(tiers, evt.Viewers)
+	if tier == nil {
+		log.Info(LogMsgNoBonusTier, "kind", kind, "from_channel", evt.FromChannel, "viewers", evt.Viewers)
+		return bonus, nil
+	}
+
+	multipliers := []struct {
+		effect    domain.EffectType
+		magnitude float64
+	}{
+		{domain.EffectJobXPBoost, tier.XPMultiplier},
+		{domain.EffectContributionBoost, tier.ContributionMultiplier},
+	}
+	for _, m := range multipliers {
+		if m.magnitude <= 1 {
+			continue
+		}
+		effect, err := s.effects.GrantCommunity(ctx, m.effect, m.magnitude, tier.Duration(), string(kind))
+		if err != nil {
+			return nil, fmt.Errorf("failed to grant %s bonus: %w", kind, err)
+		}
+		bonus.Effects = append(bonus.Effects, *effect)
+	}
+
+	// The multipliers are already granted, so a failed contribution is
+	// logged rather than failing the whole bonus
+	if tier.BonusContribution > 0 {
+		if err := s.progression.AddContribution(ctx, tier.BonusContribution); err != nil {
+			log.Warn(LogWarnContributionFail, "error", err, "kind", kind, "amount", tier.BonusContribution)
+		} else {
+			bonus.BonusContribution = tier.BonusContribution
+		}
+	}
+
+	if bonus.Granted() {
+		log.Info(LogMsgBonusGranted, "kind", kind, "from_channel", evt.FromChannel, "viewers", evt.Viewers, "effects", len(bonus.Effects), "contribution", bonus.BonusContribution)
+		if s.publisher != nil {
+			s.publisher.PublishWithRetry(ctx, event.NewCommunityBonusGrantedEvent(bonus))
+		}
+	}
+	return bonus, nil
+}
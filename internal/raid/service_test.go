@@ -0,0 +1,161 @@
+package raid_test
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/event"
+	"github.com/osse101/BrandishBot_Go/internal/raid"
+	"github.com/osse101/BrandishBot_Go/internal/raid/mocks"
+)
+
+const testConfig = `{
+	"enabled": true,
+	"raid": [
+		{"min_viewers": 5, "xp_multiplier": 1.1, "contribution_multiplier": 1.0, "duration_minutes": 10},
+		{"min_viewers": 50, "xp_multiplier": 1.5, "contribution_multiplier": 1.25, "duration_minutes": 30, "bonus_contribution": 100}
+	],
+	"host": [
+		{"min_viewers": 10, "xp_multiplier": 1.2, "contribution_multiplier": 1.0, "duration_minutes": 15}
+	]
+}`
+
+func writeConfig(t *testing.T, json string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "raid_bonuses.json")
+	require.NoError(t, os.WriteFile(path, []byte(json), 0o600))
+	return path
+}
+
+func setupServiceTest(t *testing.T, json string) (raid.Service, *mocks.MockEffectService, *mocks.MockProgressionService, *mocks.MockPublisher) {
+	table, err := raid.NewTable(writeConfig(t, json))
+	require.NoError(t, err)
+	mockEffects := mocks.NewMockEffectService(t)
+	mockProgression := mocks.NewMockProgressionService(t)
+	mockPublisher := mocks.NewMockPublisher(t)
+	return raid.NewService(table, mockEffects, mockProgression, mockPublisher), mockEffects, mockProgression, mockPublisher
+}
+
+func grantedEffect(effectType domain.EffectType, magnitude float64) *domain.CommunityEffect {
+	return &domain.CommunityEffect{Type: effectType, Magnitude: magnitude, ExpiresAt: time.Now().Add(time.Hour)}
+}
+
+func TestHandleRaid(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("grants the highest tier reached", func(t *testing.T) {
+		svc, mockEffects, mockProgression, mockPublisher := setupServiceTest(t, testConfig)
+		mockEffects.On("GrantCommunity", mock.Anything, domain.EffectJobXPBoost, 1.5, 30*time.Minute, "raid").
+			Return(grantedEffect(domain.EffectJobXPBoost, 1.5), nil)
+		mockEffects.On("GrantCommunity", mock.Anything, domain.EffectContributionBoost, 1.25, 30*time.Minute, "raid").
+			Return(grantedEffect(domain.EffectContributionBoost, 1.25), nil)
+		mockProgression.On("AddContribution", mock.Anything, 100).Return(nil)
+		mockPublisher.On("PublishWithRetry", mock.Anything, mock.MatchedBy(func(evt event.Event) bool {
+			return evt.Type == event.CommunityBonusGranted
+		})).Return()
+
+		bonus, err := svc.HandleRaid(ctx, domain.RaidEvent{Platform: domain.PlatformTwitch, FromChannel: "friend", Viewers: 80})
+
+		require.NoError(t, err)
+		assert.Equal(t, domain.RaidKindRaid, bonus.Kind)
+		assert.Len(t, bonus.Effects, 2)
+		assert.Equal(t, 100, bonus.BonusContribution)
+	})
+
+	t.Run("skips multipliers of 1", func(t *testing.T) {
+		svc, mockEffects, _, mockPublisher := setupServiceTest(t, testConfig)
+		mockEffects.On("GrantCommunity", mock.Anything, domain.EffectJobXPBoost, 1.1, 10*time.Minute, "raid").
+			Return(grantedEffect(domain.EffectJobXPBoost, 1.1), nil)
+		mockPublisher.On("PublishWithRetry", mock.Anything, mock.Anything).Return()
+
+		bonus, err := svc.HandleRaid(ctx, domain.RaidEvent{Platform: domain.PlatformTwitch, FromChannel: "friend", Viewers: 5})
+
+		require.NoError(t, err)
+		assert.Len(t, bonus.Effects, 1)
+		assert.Zero(t, bonus.BonusContribution)
+	})
+
+	t.Run("a raid below every tier grants nothing", func(t *testing.T) {
+		svc, _, _, _ := setupServiceTest(t, testConfig)
+
+		bonus, err := svc.HandleRaid(ctx, domain.RaidEvent{Platform: domain.PlatformTwitch, FromChannel: "friend", Viewers: 2})
+
+		require.NoError(t, err)
+		assert.False(t, bonus.Granted())
+		assert.Empty(t, bonus.Effects)
+	})
+
+	t.Run("disabled grants nothing", func(t *testing.T) {
+		svc, _, _, _ := setupServiceTest(t, `{"enabled": false}`)
+
+		bonus, err := svc.HandleRaid(ctx, domain.RaidEvent{Platform: domain.PlatformTwitch, FromChannel: "friend", Viewers: 500})
+
+		require.NoError(t, err)
+		assert.False(t, bonus.Granted())
+	})
+
+	t.Run("a failed contribution keeps the multipliers", func(t *testing.T) {
+		svc, mockEffects, mockProgression, mockPublisher := setupServiceTest(t, testConfig)
+		mockEffects.On("GrantCommunity", mock.Anything, mock.Anything, mock.Anything, mock.Anything, "raid").
+			Return(grantedEffect(domain.EffectJobXPBoost, 1.5), nil).Twice()
+		mockProgression.On("AddContribution", mock.Anything, 100).Return(errors.New("db down"))
+		mockPublisher.On("PublishWithRetry", mock.Anything, mock.Anything).Return()
+
+		bonus, err := svc.HandleRaid(ctx, domain.RaidEvent{Platform: domain.PlatformTwitch, FromChannel: "friend", Viewers: 80})
+
+		require.NoError(t, err)
+		assert.Len(t, bonus.Effects, 2)
+		assert.Zero(t, bonus.BonusContribution)
+	})
+
+	t.Run("a failed grant is an error", func(t *testing.T) {
+		svc, mockEffects, _, _ := setupServiceTest(t, testConfig)
+		mockEffects.On("GrantCommunity", mock.Anything, domain.EffectJobXPBoost, 1.1, 10*time.Minute, "raid").
+			Return(nil, errors.New("db down"))
+
+		_, err := svc.HandleRaid(ctx, domain.RaidEvent{Platform: domain.PlatformTwitch, FromChannel: "friend", Viewers: 5})
+
+		assert.Error(t, err)
+	})
+}
+
+func TestHandleHost(t *testing.T) {
+	svc, mockEffects, _, mockPublisher := setupServiceTest(t, testConfig)
+	mockEffects.On("GrantCommunity", mock.Anything, domain.EffectJobXPBoost, 1.2, 15*time.Minute, "host").
+		Return(grantedEffect(domain.EffectJobXPBoost, 1.2), nil)
+	mockPublisher.On("PublishWithRetry", mock.Anything, mock.Anything).Return()
+
+	bonus, err := svc.HandleHost(context.Background(), domain.RaidEvent{Platform: domain.PlatformTwitch, FromChannel: "friend", Viewers: 12})
+
+	require.NoError(t, err)
+	assert.Equal(t, domain.RaidKindHost, bonus.Kind)
+	assert.Len(t, bonus.Effects, 1)
+}
+
+func TestLoadConfig(t *testing.T) {
+	_, err := raid.LoadConfig(writeConfig(t, testConfig))
+	require.NoError(t, err)
+
+	invalid := map[string]string{
+		"tiers out of order":    `{"raid": [{"min_viewers": 50, "xp_multiplier": 1.1, "contribution_multiplier": 1, "duration_minutes": 10}, {"min_viewers": 5, "xp_multiplier": 1.1, "contribution_multiplier": 1, "duration_minutes": 10}]}`,
+		"multiplier below 1":    `{"raid": [{"min_viewers": 5, "xp_multiplier": 0.5, "contribution_multiplier": 1, "duration_minutes": 10}]}`,
+		"zero duration":         `{"host": [{"min_viewers": 5, "xp_multiplier": 1.1, "contribution_multiplier": 1, "duration_minutes": 0}]}`,
+		"negative contribution": `{"raid": [{"min_viewers": 5, "xp_multiplier": 1.1, "contribution_multiplier": 1, "duration_minutes": 10, "bonus_contribution": -1}]}`,
+		"zero minimum viewers":  `{"raid": [{"min_viewers": 0, "xp_multiplier": 1.1, "contribution_multiplier": 1, "duration_minutes": 10}]}`,
+	}
+	for name, json := range invalid {
+		t.Run("rejects "+name, func(t *testing.T) {
+			_, err := raid.LoadConfig(writeConfig(t, json))
+			assert.Error(t, err)
+		})
+	}
+}
@@ -0,0 +1,40 @@
+package reconcile
+
+// JobType identifies reconciliation runs in the worker pool and scheduler
+const JobType = "reconciliation"
+
+// Check names, used in logs and the check metric label
+const (
+	CheckVoteCounts = "vote_counts"
+	CheckGameState  = "game_state"
+)
+
+// Subjects reported by the game state check
+const (
+	SubjectActiveGamble   = "active_gamble"
+	SubjectVotingSession  = "voting_session"
+	SubjectUnlockProgress = "unlock_progress"
+)
+
+// Log messages
+const (
+	LogMsgReconcileStarting    = "Starting reconciliation"
+	LogMsgReconcileCompleted   = "Reconciliation completed"
+	LogMsgReconcileCheckFailed = "Reconciliation check failed"
+	LogMsgDiscrepancyFound     = "Reconciliation discrepancy"
+	LogMsgHealFailed           = "Failed to heal reconciliation discrepancy"
+)
+
+// Log field keys
+const (
+	LogFieldCheck         = "check"
+	LogFieldSubject       = "subject"
+	LogFieldExpected      = "expected"
+	LogFieldActual        = "actual"
+	LogFieldSeverity      = "severity"
+	LogFieldHealed        = "healed"
+	LogFieldError         = "error"
+	LogFieldDuration      = "duration"
+	LogFieldDiscrepancies = "discrepancies"
+	LogFieldHealedCount   = "healed_count"
+)
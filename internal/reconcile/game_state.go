@@ -0,0 +1,180 @@
+package reconcile
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/osse101/BrandishBot_Go/internal/community"
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+)
+
+// Projection is the in-memory game state being checked, implemented by
+// gamestate.Projection
+type Projection interface {
+	PeekActiveGamble(ctx context.Context) (*domain.Gamble, bool)
+	PeekVotingSession(ctx context.Context) (*domain.ProgressionVotingSession, bool)
+	PeekUnlockProgress(ctx context.Context) (*domain.UnlockProgress, bool)
+	InvalidateGamble(ctx context.Context)
+	InvalidateProgression(ctx context.Context)
+}
+
+// GambleSource reads the active gamble from the database
+type GambleSource interface {
+	GetActiveGamble(ctx context.Context) (*domain.Gamble, error)
+}
+
+// ProgressionSource reads voting and unlock state from the database
+type ProgressionSource interface {
+	GetActiveVotingSession(ctx context.Context) (*domain.ProgressionVotingSession, error)
+	GetUnlockProgress(ctx context.Context) (*domain.UnlockProgress, error)
+}
+
+// gameStateCheck compares the in-memory game state projection with fresh
+// database reads. Any difference is healed by invalidating the projection,
+// which is always safe since the next read reloads it.
+//
+// Projections are per instance, so this only checks the instance that runs
+// the job. Other instances are bounded by the projection's max age.
+type gameStateCheck struct {
+	projection  Projection
+	gambles     GambleSource
+	progression ProgressionSource
+	healMax     int
+	communities []string
+}
+
+// NewGameStateCheck creates a check of the projection against the given
+// sources. The sources must read the database directly, not through the
+// projection. Contribution drifts up to healMax are reported as info; they are
+// expected when contributions land between the projection's last reload and
+// the check. Each of the given communities is checked, or only the default
+// one if none are given.
+func NewGameStateCheck(projection Projection, gambles GambleSource, progression ProgressionSource, healMax int, communities ...string) Check {
+	if len(communities) == 0 {
+		communities = []string{community.Default}
+	}
+	return &gameStateCheck{
+		projection:  projection,
+		gambles:     gambles,
+		progression: progression,
+		healMax:     healMax,
+		communities: communities,
+	}
+}
+
+func (c *gameStateCheck) Name() string {
+	return CheckGameState
+}
+
+func (c *gameStateCheck) Run(ctx context.Context) ([]Discrepancy, error) {
+	var discrepancies []Discrepancy
+	for _, ctx := range community.Each(ctx, c.communities) {
+		found, err := c.runCommunity(ctx)
+		discrepancies = append(discrepancies, found...)
+		if err != nil {
+			return discrepancies, fmt.Errorf("community %s: %w", community.FromContext(ctx), err)
+		}
+	}
+	return discrepancies, nil
+}
+
+// runCommunity checks the projected state of the context's community
+func (c *gameStateCheck) runCommunity(ctx context.Context) ([]Discrepancy, error) {
+	var discrepancies []Discrepancy
+
+	if cached, ok := c.projection.PeekActiveGamble(ctx); ok {
+		actual, err := c.gambles.GetActiveGamble(ctx)
+		if err != nil {
+			return discrepancies, fmt.Errorf("failed to load active gamble: %w", err)
+		}
+		if d, drifted := c.compareGamble(actual, cached); drifted {
+			c.projection.InvalidateGamble(ctx)
+			discrepancies = append(discrepancies, d)
+		}
+	}
+
+	progressionDrifted := false
+
+	if cached, ok := c.projection.PeekVotingSession(ctx); ok {
+		actual, err := c.progression.GetActiveVotingSession(ctx)
+		if err != nil {
+			return discrepancies, fmt.Errorf("failed to load voting session: %w", err)
+		}
+		if d, drifted := c.compareSession(actual, cached); drifted {
+			progressionDrifted = true
+			discrepancies = append(discrepancies, d)
+		}
+	}
+
+	if cached, ok := c.projection.PeekUnlockProgress(ctx); ok {
+		actual, err := c.progression.GetUnlockProgress(ctx)
+		if err != nil {
+			return discrepancies, fmt.Errorf("failed to load unlock progress: %w", err)
+		}
+		if d, drifted := c.compareProgress(actual, cached); drifted {
+			progressionDrifted = true
+			discrepancies = append(discrepancies, d)
+		}
+	}
+
+	if progressionDrifted {
+		c.projection.InvalidateProgression(ctx)
+	}
+	return discrepancies, nil
+}
+
+// compareGamble checks the gamble identity, then its participant count
+func (c *gameStateCheck) compareGamble(actual, cached *domain.Gamble) (Discrepancy, bool) {
+	d := Discrepancy{Check: CheckGameState, Subject: SubjectActiveGamble, Healed: true}
+	if (actual == nil) != (cached == nil) || (actual != nil && (actual.ID != cached.ID || actual.State != cached.State)) {
+		d.Severity = SeverityCritical
+		return d, true
+	}
+	if actual == nil || len(actual.Participants) == len(cached.Participants) {
+		return d, false
+	}
+	d.Expected, d.Actual = int64(len(actual.Participants)), int64(len(cached.Participants))
+	d.Severity = classify(d.Expected, d.Actual, int64(c.healMax))
+	return d, true
+}
+
+// compareSession checks the session identity, then its total vote count
+func (c *gameStateCheck) compareSession(actual, cached *domain.ProgressionVotingSession) (Discrepancy, bool) {
+	d := Discrepancy{Check: CheckGameState, Subject: SubjectVotingSession, Healed: true}
+	if (actual == nil) != (cached == nil) || (actual != nil && (actual.ID != cached.ID || actual.Status != cached.Status)) {
+		d.Severity = SeverityCritical
+		return d, true
+	}
+	if actual == nil {
+		return d, false
+	}
+	d.Expected, d.Actual = totalVotes(actual), totalVotes(cached)
+	if d.Expected == d.Actual {
+		return d, false
+	}
+	d.Severity = classify(d.Expected, d.Actual, int64(c.healMax))
+	return d, true
+}
+
+// compareProgress checks which unlock is in progress, then its contributions
+func (c *gameStateCheck) compareProgress(actual, cached *domain.UnlockProgress) (Discrepancy, bool) {
+	d := Discrepancy{Check: CheckGameState, Subject: SubjectUnlockProgress, Healed: true}
+	if (actual == nil) != (cached == nil) || (actual != nil && actual.ID != cached.ID) {
+		d.Severity = SeverityCritical
+		return d, true
+	}
+	if actual == nil || actual.ContributionsAccumulated == cached.ContributionsAccumulated {
+		return d, false
+	}
+	d.Expected, d.Actual = int64(actual.ContributionsAccumulated), int64(cached.ContributionsAccumulated)
+	d.Severity = classify(d.Expected, d.Actual, int64(c.healMax))
+	return d, true
+}
+
+func totalVotes(session *domain.ProgressionVotingSession) int64 {
+	var total int64
+	for _, opt := range session.Options {
+		total += int64(opt.VoteCount)
+	}
+	return total
+}
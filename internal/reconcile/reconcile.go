@@ -0,0 +1,131 @@
+package reconcile
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/osse101/BrandishBot_Go/internal/logger"
+	"github.com/osse101/BrandishBot_Go/internal/metrics"
+)
+
+// Severity grades how far derived state has drifted from the source of truth
+type Severity string
+
+const (
+	// SeverityInfo is a drift within the heal threshold, usually a write that
+	// raced the check or a lost increment
+	SeverityInfo Severity = "info"
+	// SeverityWarning is a drift beyond the heal threshold but within 10% of the
+	// true value
+	SeverityWarning Severity = "warning"
+	// SeverityCritical is a drift of more than 10%, or a disagreement about
+	// which record is current
+	SeverityCritical Severity = "critical"
+)
+
+// Discrepancy is one difference found by a check
+type Discrepancy struct {
+	Check    string
+	Subject  string
+	Expected int64 // Value in the source-of-truth table
+	Actual   int64 // Value in the rollup or projection
+	Severity Severity
+	Healed   bool
+}
+
+// Check compares one kind of derived state against its source of truth,
+// healing what it safely can
+type Check interface {
+	Name() string
+	Run(ctx context.Context) ([]Discrepancy, error)
+}
+
+// Job runs every check, logs each discrepancy at a level matching its
+// severity and records metrics
+type Job struct {
+	checks []Check
+}
+
+// NewJob creates a reconciliation job over the given checks
+func NewJob(checks ...Check) *Job {
+	return &Job{checks: checks}
+}
+
+// JobType returns the worker pool job type
+func (j *Job) JobType() string {
+	return JobType
+}
+
+// Process runs all checks. A failing check does not stop the others; the
+// failures are returned together.
+func (j *Job) Process(ctx context.Context) error {
+	log := logger.FromContext(ctx)
+	log.Info(LogMsgReconcileStarting)
+	start := time.Now()
+
+	var errs []error
+	found, healed := 0, 0
+	for _, check := range j.checks {
+		discrepancies, err := check.Run(ctx)
+		if err != nil {
+			log.Error(LogMsgReconcileCheckFailed, LogFieldCheck, check.Name(), LogFieldError, err)
+			errs = append(errs, err)
+		}
+		for _, d := range discrepancies {
+			logDiscrepancy(log, d)
+			metrics.ReconcileDiscrepancies.WithLabelValues(d.Check, string(d.Severity)).Inc()
+			found++
+			if d.Healed {
+				metrics.ReconcileHealed.WithLabelValues(d.Check).Inc()
+				healed++
+			}
+		}
+	}
+
+	log.Info(LogMsgReconcileCompleted,
+		LogFieldDiscrepancies, found,
+		LogFieldHealedCount, healed,
+		LogFieldDuration, time.Since(start))
+	return errors.Join(errs...)
+}
+
+func logDiscrepancy(log *slog.Logger, d Discrepancy) {
+	args := []any{
+		LogFieldCheck, d.Check,
+		LogFieldSubject, d.Subject,
+		LogFieldExpected, d.Expected,
+		LogFieldActual, d.Actual,
+		LogFieldSeverity, d.Severity,
+		LogFieldHealed, d.Healed,
+	}
+	switch d.Severity {
+	case SeverityCritical:
+		log.Error(LogMsgDiscrepancyFound, args...)
+	case SeverityWarning:
+		log.Warn(LogMsgDiscrepancyFound, args...)
+	default:
+		log.Info(LogMsgDiscrepancyFound, args...)
+	}
+}
+
+// classify grades a numeric drift. Drifts up to healMax are SeverityInfo.
+func classify(expected, actual, healMax int64) Severity {
+	diff := abs(expected - actual)
+	switch {
+	case diff <= healMax:
+		return SeverityInfo
+	case diff*10 <= abs(expected):
+		return SeverityWarning
+	default:
+		return SeverityCritical
+	}
+}
+
+func abs(v int64) int64 {
+	if v < 0 {
+		return -v
+	}
+	return v
+}
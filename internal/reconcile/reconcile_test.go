@@ -0,0 +1,146 @@
+package reconcile_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/gamestate"
+	"github.com/osse101/BrandishBot_Go/internal/reconcile"
+	reconcilemocks "github.com/osse101/BrandishBot_Go/internal/reconcile/mocks"
+	"github.com/osse101/BrandishBot_Go/mocks"
+)
+
+func TestVoteCountCheck(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	repo := reconcilemocks.NewMockRepository(t)
+
+	repo.On("GetVoteCountDrift", ctx).Return([]reconcile.VoteCountDrift{
+		{OptionID: 1, SessionID: 9, StoredVotes: 41, RecordedVotes: 42},   // small: healed
+		{OptionID: 2, SessionID: 9, StoredVotes: 92, RecordedVotes: 100},  // within 10%: reported
+		{OptionID: 3, SessionID: 9, StoredVotes: 300, RecordedVotes: 100}, // large: reported
+	}, nil)
+	repo.On("SetOptionVoteCount", ctx, 1, 42).Return(nil).Once()
+
+	got, err := reconcile.NewVoteCountCheck(repo, 5).Run(ctx)
+	require.NoError(t, err)
+	require.Len(t, got, 3)
+
+	assert.Equal(t, reconcile.SeverityInfo, got[0].Severity)
+	assert.True(t, got[0].Healed)
+	assert.Equal(t, int64(42), got[0].Expected)
+	assert.Equal(t, int64(41), got[0].Actual)
+
+	assert.Equal(t, reconcile.SeverityWarning, got[1].Severity)
+	assert.False(t, got[1].Healed)
+
+	assert.Equal(t, reconcile.SeverityCritical, got[2].Severity)
+	assert.False(t, got[2].Healed)
+}
+
+func TestVoteCountCheck_HealFailureIsReported(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	repo := reconcilemocks.NewMockRepository(t)
+
+	repo.On("GetVoteCountDrift", ctx).Return([]reconcile.VoteCountDrift{
+		{OptionID: 1, SessionID: 9, StoredVotes: 0, RecordedVotes: 1},
+	}, nil)
+	repo.On("SetOptionVoteCount", ctx, 1, 1).Return(errors.New("db down"))
+
+	got, err := reconcile.NewVoteCountCheck(repo, 5).Run(ctx)
+	require.NoError(t, err)
+	require.Len(t, got, 1)
+	assert.False(t, got[0].Healed)
+}
+
+func TestGameStateCheck(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// The projection and the check read from different mocks so the test
+	// controls what each side sees
+	projGambles := mocks.NewMockGambleService(t)
+	projProgression := mocks.NewMockProgressionService(t)
+	dbGambles := mocks.NewMockGambleService(t)
+	dbProgression := mocks.NewMockProgressionService(t)
+
+	projection := gamestate.NewProjection(projGambles, projProgression, time.Hour)
+
+	gambleID := uuid.New()
+	projGambles.On("GetActiveGamble", mock.Anything).Return(&domain.Gamble{ID: gambleID, State: domain.GambleStateJoining}, nil).Once()
+	projProgression.On("GetActiveVotingSession", mock.Anything).Return(&domain.ProgressionVotingSession{ID: 3, Status: domain.VotingStatusVoting}, nil).Once()
+	projProgression.On("GetUnlockProgress", mock.Anything).Return(&domain.UnlockProgress{ID: 5, ContributionsAccumulated: 100}, nil).Once()
+	projection.Rebuild(ctx)
+
+	// Gamble matches, session was replaced, contributions drifted slightly
+	dbGambles.On("GetActiveGamble", mock.Anything).Return(&domain.Gamble{ID: gambleID, State: domain.GambleStateJoining}, nil)
+	dbProgression.On("GetActiveVotingSession", mock.Anything).Return(&domain.ProgressionVotingSession{ID: 4, Status: domain.VotingStatusVoting}, nil)
+	dbProgression.On("GetUnlockProgress", mock.Anything).Return(&domain.UnlockProgress{ID: 5, ContributionsAccumulated: 103}, nil)
+
+	got, err := reconcile.NewGameStateCheck(projection, dbGambles, dbProgression, 5).Run(ctx)
+	require.NoError(t, err)
+	require.Len(t, got, 2)
+
+	assert.Equal(t, reconcile.SubjectVotingSession, got[0].Subject)
+	assert.Equal(t, reconcile.SeverityCritical, got[0].Severity)
+	assert.Equal(t, reconcile.SubjectUnlockProgress, got[1].Subject)
+	assert.Equal(t, reconcile.SeverityInfo, got[1].Severity)
+	assert.Equal(t, int64(103), got[1].Expected)
+	assert.Equal(t, int64(100), got[1].Actual)
+
+	// Healing invalidated progression state but left the matching gamble cached
+	_, ok := projection.PeekActiveGamble(ctx)
+	assert.True(t, ok)
+	_, ok = projection.PeekVotingSession(ctx)
+	assert.False(t, ok)
+	_, ok = projection.PeekUnlockProgress(ctx)
+	assert.False(t, ok)
+}
+
+func TestGameStateCheck_SkipsUnloadedState(t *testing.T) {
+	t.Parallel()
+	projection := gamestate.NewProjection(mocks.NewMockGambleService(t), mocks.NewMockProgressionService(t), time.Hour)
+
+	// Nothing is cached, so the database sources must not be queried
+	got, err := reconcile.NewGameStateCheck(projection, mocks.NewMockGambleService(t), mocks.NewMockProgressionService(t), 5).Run(context.Background())
+	require.NoError(t, err)
+	assert.Empty(t, got)
+}
+
+type stubCheck struct {
+	name          string
+	discrepancies []reconcile.Discrepancy
+	err           error
+	ran           bool
+}
+
+func (c *stubCheck) Name() string { return c.name }
+
+func (c *stubCheck) Run(context.Context) ([]reconcile.Discrepancy, error) {
+	c.ran = true
+	return c.discrepancies, c.err
+}
+
+func TestJob_RunsAllChecks(t *testing.T) {
+	t.Parallel()
+	failing := &stubCheck{name: "failing", err: errors.New("boom")}
+	passing := &stubCheck{name: "passing", discrepancies: []reconcile.Discrepancy{
+		{Check: "passing", Subject: "x", Expected: 2, Actual: 1, Severity: reconcile.SeverityInfo, Healed: true},
+	}}
+
+	job := reconcile.NewJob(failing, passing)
+	err := job.Process(context.Background())
+
+	assert.ErrorContains(t, err, "boom")
+	assert.True(t, passing.ran, "a failing check must not stop later checks")
+	assert.Equal(t, reconcile.JobType, job.JobType())
+}
@@ -0,0 +1,20 @@
+package reconcile
+
+import "context"
+
+// VoteCountDrift is a voting option whose stored vote_count rollup differs
+// from the total weight of the counted votes in user_votes
+type VoteCountDrift struct {
+	OptionID      int
+	SessionID     int
+	StoredVotes   int
+	RecordedVotes int
+}
+
+// Repository reads rollup columns alongside the rows they summarise
+type Repository interface {
+	// GetVoteCountDrift returns options in open voting sessions whose stored
+	// count disagrees with the recorded votes
+	GetVoteCountDrift(ctx context.Context) ([]VoteCountDrift, error)
+	SetOptionVoteCount(ctx context.Context, optionID, count int) error
+}
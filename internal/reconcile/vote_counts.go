@@ -0,0 +1,54 @@
+package reconcile
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/osse101/BrandishBot_Go/internal/logger"
+)
+
+// voteCountCheck compares the vote_count rollup on each open voting option
+// with the user_votes rows behind it
+type voteCountCheck struct {
+	repo    Repository
+	healMax int
+}
+
+// NewVoteCountCheck creates a check that resets an option's vote_count to the
+// recorded votes when they differ by at most healMax. Larger drifts are only
+// reported, since they point at a bug worth investigating before the evidence
+// is overwritten.
+func NewVoteCountCheck(repo Repository, healMax int) Check {
+	return &voteCountCheck{repo: repo, healMax: healMax}
+}
+
+func (c *voteCountCheck) Name() string {
+	return CheckVoteCounts
+}
+
+func (c *voteCountCheck) Run(ctx context.Context) ([]Discrepancy, error) {
+	drifts, err := c.repo.GetVoteCountDrift(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get vote count drift: %w", err)
+	}
+
+	discrepancies := make([]Discrepancy, 0, len(drifts))
+	for _, drift := range drifts {
+		d := Discrepancy{
+			Check:    CheckVoteCounts,
+			Subject:  fmt.Sprintf("session %d option %d", drift.SessionID, drift.OptionID),
+			Expected: int64(drift.RecordedVotes),
+			Actual:   int64(drift.StoredVotes),
+			Severity: classify(int64(drift.RecordedVotes), int64(drift.StoredVotes), int64(c.healMax)),
+		}
+		if d.Severity == SeverityInfo {
+			if err := c.repo.SetOptionVoteCount(ctx, drift.OptionID, drift.RecordedVotes); err != nil {
+				logger.FromContext(ctx).Error(LogMsgHealFailed, LogFieldCheck, CheckVoteCounts, LogFieldSubject, d.Subject, LogFieldError, err)
+			} else {
+				d.Healed = true
+			}
+		}
+		discrepancies = append(discrepancies, d)
+	}
+	return discrepancies, nil
+}
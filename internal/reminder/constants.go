@@ -0,0 +1,34 @@
+package reminder
+
+import "time"
+
+// JobType identifies reminder dispatch runs in the worker pool and scheduler
+const JobType = "reminder_dispatch"
+
+// Defaults, used when the configured values are not positive
+const (
+	// DefaultVoteLead is how long before voting closes a vote reminder fires
+	DefaultVoteLead = 5 * time.Minute
+	// DefaultMaxPending caps how many reminders a user can have waiting
+	DefaultMaxPending = 10
+	// DefaultBatchSize caps how many reminders one dispatch run claims
+	DefaultBatchSize = 100
+)
+
+// MaxCooldownReminderDelay caps how far ahead a cooldown reminder given an
+// explicit remind_at can be set
+const MaxCooldownReminderDelay = 7 * 24 * time.Hour
+
+// Reminder messages
+const (
+	MsgCooldownReady = "You can %s again!"
+	MsgVoteClosing   = "Voting on the next unlock closes <t:%d:R>. Get your vote in!"
+	MsgCompostReady  = "Your compost bin is ready to harvest!"
+)
+
+// Log messages
+const (
+	LogMsgReminderCreated   = "Reminder created"
+	LogMsgReminderCancelled = "Reminder cancelled"
+	LogMsgRemindersSent     = "Dispatched due reminders"
+)
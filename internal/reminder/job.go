@@ -0,0 +1,24 @@
+package reminder
+
+import "context"
+
+// Job dispatches reminders that have fallen due
+type Job struct {
+	service Service
+}
+
+// NewJob creates a reminder dispatch job
+func NewJob(service Service) *Job {
+	return &Job{service: service}
+}
+
+// JobType returns the worker pool job type
+func (j *Job) JobType() string {
+	return JobType
+}
+
+// Process dispatches every due reminder
+func (j *Job) Process(ctx context.Context) error {
+	_, err := j.service.DispatchDue(ctx)
+	return err
+}
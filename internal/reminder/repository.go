@@ -0,0 +1,26 @@
+package reminder
+
+import (
+	"context"
+	"time"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+)
+
+// Repository stores pending reminders
+type Repository interface {
+	// SaveReminder stores a reminder, replacing the user's pending reminder
+	// with the same kind and target, and returns it with its ID
+	SaveReminder(ctx context.Context, reminder domain.Reminder) (*domain.Reminder, error)
+
+	// GetReminders returns a user's pending reminders, soonest first
+	GetReminders(ctx context.Context, userID string) ([]domain.Reminder, error)
+
+	// DeleteReminder removes one of a user's reminders and returns false if
+	// the user has no reminder with that ID
+	DeleteReminder(ctx context.Context, userID string, id int64) (bool, error)
+
+	// ClaimDueReminders removes and returns up to limit reminders due at or
+	// before now. Each reminder is returned to exactly one caller.
+	ClaimDueReminders(ctx context.Context, now time.Time, limit int) ([]domain.Reminder, error)
+}
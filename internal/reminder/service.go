@@ -0,0 +1,294 @@
+package reminder
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/event"
+	"github.com/osse101/BrandishBot_Go/internal/logger"
+	"github.com/osse101/BrandishBot_Go/internal/repository"
+)
+
+// Service schedules reminders for users and dispatches them when they fall due
+type Service interface {
+	// CreateReminder works out when the requested reminder should fire and
+	// stores it, replacing any pending reminder for the same thing. It
+	// returns domain.ErrNothingToRemind when there is nothing to wait for.
+	CreateReminder(ctx context.Context, req Request) (*domain.Reminder, error)
+
+	GetReminders(ctx context.Context, platform, platformID string) ([]domain.Reminder, error)
+	CancelReminder(ctx context.Context, platform, platformID string, id int64) error
+
+	// DispatchDue publishes a reminder.due event for every due reminder and
+	// returns how many were sent
+	DispatchDue(ctx context.Context) (int, error)
+}
+
+// Request asks for a reminder
+type Request struct {
+	Platform   string
+	PlatformID string
+	Username   string
+	Kind       domain.ReminderKind
+	// Target is the cooldown action for cooldown reminders
+	Target string
+	// RemindAt is used for cooldown reminders when the action's cooldown is
+	// not tracked by the cooldown service (for example expeditions)
+	RemindAt *time.Time
+	// ChannelID is where the platform should post the reminder
+	ChannelID string
+}
+
+// UserService defines the user operations needed by the reminder service
+type UserService interface {
+	GetUserOrRegister(ctx context.Context, platform, platformID, username string) (*domain.User, error)
+	GetUserIDByPlatformID(ctx context.Context, platform, platformID string) (string, error)
+}
+
+// CooldownChecker reports how long a user's action is on cooldown
+type CooldownChecker interface {
+	CheckCooldown(ctx context.Context, userID, action string) (bool, time.Duration, error)
+}
+
+// VotingSessions provides the open voting session
+type VotingSessions interface {
+	GetActiveVotingSession(ctx context.Context) (*domain.ProgressionVotingSession, error)
+}
+
+// CompostBins provides users' compost bins
+type CompostBins interface {
+	// GetBin returns the user's compost bin (nil, nil if they have none)
+	GetBin(ctx context.Context, userID string) (*domain.CompostBin, error)
+}
+
+// Publisher publishes reminder events
+type Publisher interface {
+	PublishWithRetry(ctx context.Context, evt event.Event)
+}
+
+// Config tunes reminders
+type Config struct {
+	// VoteLead is how long before voting closes a vote reminder fires
+	VoteLead time.Duration
+	// MaxPending caps how many reminders a user can have waiting
+	MaxPending int
+	// BatchSize caps how many reminders one dispatch run claims
+	BatchSize int
+}
+
+type service struct {
+	repo      Repository
+	users     UserService
+	cooldowns CooldownChecker
+	voting    VotingSessions
+	compost   CompostBins
+	publisher Publisher
+	cfg       Config
+	now       func() time.Time
+}
+
+// NewService creates a reminder service
+func NewService(repo Repository, users UserService, cooldowns CooldownChecker, voting VotingSessions, compost CompostBins, publisher Publisher, cfg Config) Service {
+	if cfg.VoteLead <= 0 {
+		cfg.VoteLead = DefaultVoteLead
+	}
+	if cfg.MaxPending <= 0 {
+		cfg.MaxPending = DefaultMaxPending
+	}
+	if cfg.BatchSize <= 0 {
+		cfg.BatchSize = DefaultBatchSize
+	}
+	return &service{
+		repo:      repo,
+		users:     users,
+		cooldowns: cooldowns,
+		voting:    voting,
+		compost:   compost,
+		publisher: publisher,
+		cfg:       cfg,
+		now:       time.Now,
+	}
+}
+
+// CreateReminder stores a reminder for the request, registering the user if needed
+func (s *service) CreateReminder(ctx context.Context, req Request) (*domain.Reminder, error) {
+	if !req.Kind.IsValid() {
+		return nil, fmt.Errorf("%w: %q", domain.ErrInvalidReminderKind, req.Kind)
+	}
+	req.Target = strings.TrimSpace(req.Target)
+	if req.Kind == domain.ReminderKindCooldown && req.Target == "" {
+		return nil, fmt.Errorf("%w: cooldown reminders need an action", domain.ErrInvalidInput)
+	}
+
+	user, err := s.users.GetUserOrRegister(ctx, req.Platform, req.PlatformID, req.Username)
+	if err != nil {
+		return nil, err
+	}
+
+	reminder := domain.Reminder{
+		UserID:     user.ID,
+		Platform:   req.Platform,
+		PlatformID: req.PlatformID,
+		Kind:       req.Kind,
+		ChannelID:  req.ChannelID,
+	}
+	switch req.Kind {
+	case domain.ReminderKindCooldown:
+		err = s.resolveCooldown(ctx, &reminder, req)
+	case domain.ReminderKindVote:
+		err = s.resolveVote(ctx, &reminder)
+	case domain.ReminderKindCompost:
+		err = s.resolveCompost(ctx, &reminder)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.checkPendingLimit(ctx, reminder); err != nil {
+		return nil, err
+	}
+
+	saved, err := s.repo.SaveReminder(ctx, reminder)
+	if err != nil {
+		return nil, fmt.Errorf("failed to save reminder: %w", err)
+	}
+	logger.FromContext(ctx).Info(LogMsgReminderCreated, "user_id", user.ID, "kind", saved.Kind, "target", saved.Target, "remind_at", saved.RemindAt)
+	return saved, nil
+}
+
+// resolveCooldown reminds when the action's cooldown ends, falling back to
+// the client's remind_at for cooldowns tracked outside the cooldown service
+func (s *service) resolveCooldown(ctx context.Context, reminder *domain.Reminder, req Request) error {
+	now := s.now()
+	onCooldown, remaining, err := s.cooldowns.CheckCooldown(ctx, reminder.UserID, req.Target)
+	if err != nil {
+		return fmt.Errorf("failed to check cooldown: %w", err)
+	}
+
+	switch {
+	case onCooldown:
+		reminder.RemindAt = now.Add(remaining)
+	case req.RemindAt != nil && req.RemindAt.After(now):
+		if req.RemindAt.Sub(now) > MaxCooldownReminderDelay {
+			return fmt.Errorf("%w: reminders can be set at most %s ahead", domain.ErrInvalidInput, MaxCooldownReminderDelay)
+		}
+		reminder.RemindAt = *req.RemindAt
+	default:
+		return fmt.Errorf("%w: %s is not on cooldown", domain.ErrNothingToRemind, req.Target)
+	}
+
+	reminder.Target = req.Target
+	reminder.Message = fmt.Sprintf(MsgCooldownReady, req.Target)
+	return nil
+}
+
+// resolveVote reminds VoteLead before the open voting session closes, or
+// straight away if it closes sooner than that
+func (s *service) resolveVote(ctx context.Context, reminder *domain.Reminder) error {
+	session, err := s.voting.GetActiveVotingSession(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get voting session: %w", err)
+	}
+	now := s.now()
+	if session == nil || !session.VotingDeadline.After(now) {
+		return fmt.Errorf("%w: no vote is open", domain.ErrNothingToRemind)
+	}
+
+	reminder.RemindAt = session.VotingDeadline.Add(-s.cfg.VoteLead)
+	if reminder.RemindAt.Before(now) {
+		reminder.RemindAt = now
+	}
+	reminder.Target = strconv.Itoa(session.ID)
+	reminder.Message = fmt.Sprintf(MsgVoteClosing, session.VotingDeadline.Unix())
+	return nil
+}
+
+// resolveCompost reminds when the user's compost bin is ready to harvest
+func (s *service) resolveCompost(ctx context.Context, reminder *domain.Reminder) error {
+	bin, err := s.compost.GetBin(ctx, reminder.UserID)
+	if err != nil {
+		return fmt.Errorf("failed to get compost bin: %w", err)
+	}
+	if bin == nil || bin.Status != domain.CompostBinStatusComposting || bin.ReadyAt == nil || !bin.ReadyAt.After(s.now()) {
+		return fmt.Errorf("%w: nothing is composting", domain.ErrNothingToRemind)
+	}
+
+	reminder.RemindAt = *bin.ReadyAt
+	reminder.Message = MsgCompostReady
+	return nil
+}
+
+// checkPendingLimit rejects a new reminder once the user has MaxPending
+// waiting. Replacing a pending reminder is always allowed.
+func (s *service) checkPendingLimit(ctx context.Context, reminder domain.Reminder) error {
+	pending, err := s.repo.GetReminders(ctx, reminder.UserID)
+	if err != nil {
+		return fmt.Errorf("failed to get reminders: %w", err)
+	}
+	if len(pending) < s.cfg.MaxPending {
+		return nil
+	}
+	for _, p := range pending {
+		if p.Kind == reminder.Kind && p.Target == reminder.Target {
+			return nil
+		}
+	}
+	return fmt.Errorf("%w: the limit is %d", domain.ErrTooManyReminders, s.cfg.MaxPending)
+}
+
+// GetReminders returns the user's pending reminders
+func (s *service) GetReminders(ctx context.Context, platform, platformID string) ([]domain.Reminder, error) {
+	userID, err := repository.RegisteredUserID(ctx, s.users, platform, platformID)
+	if err != nil {
+		return nil, err
+	}
+	return s.repo.GetReminders(ctx, userID)
+}
+
+// CancelReminder removes one of the user's pending reminders
+func (s *service) CancelReminder(ctx context.Context, platform, platformID string, id int64) error {
+	userID, err := repository.RegisteredUserID(ctx, s.users, platform, platformID)
+	if err != nil {
+		return err
+	}
+	deleted, err := s.repo.DeleteReminder(ctx, userID, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete reminder: %w", err)
+	}
+	if !deleted {
+		return domain.ErrReminderNotFound
+	}
+	logger.FromContext(ctx).Info(LogMsgReminderCancelled, "user_id", userID, "reminder_id", id)
+	return nil
+}
+
+// DispatchDue claims due reminders in batches and publishes each one.
+// Claimed reminders are removed, so each is delivered at most once even
+// when several instances dispatch at the same time.
+func (s *service) DispatchDue(ctx context.Context) (int, error) {
+	sent := 0
+	for {
+		due, err := s.repo.ClaimDueReminders(ctx, s.now(), s.cfg.BatchSize)
+		if err != nil {
+			return sent, fmt.Errorf("failed to claim due reminders: %w", err)
+		}
+		for _, reminder := range due {
+			if s.publisher != nil {
+				s.publisher.PublishWithRetry(ctx, event.NewReminderDueEvent(reminder))
+			}
+		}
+		sent += len(due)
+		if len(due) < s.cfg.BatchSize {
+			break
+		}
+	}
+
+	if sent > 0 {
+		logger.FromContext(ctx).Info(LogMsgRemindersSent, "count", sent)
+	}
+	return sent, nil
+}
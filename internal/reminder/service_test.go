@@ -0,0 +1,300 @@
+package reminder_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/event"
+	"github.com/osse101/BrandishBot_Go/internal/reminder"
+	"github.com/osse101/BrandishBot_Go/internal/reminder/mocks"
+)
+
+// testService holds the service and its mocks
+type testService struct {
+	svc       reminder.Service
+	repo      *mocks.MockRepository
+	users     *mocks.MockUserService
+	cooldowns *mocks.MockCooldownChecker
+	voting    *mocks.MockVotingSessions
+	compost   *mocks.MockCompostBins
+	publisher *mocks.MockPublisher
+}
+
+func setupService(t *testing.T, cfg reminder.Config) *testService {
+	repo := mocks.NewMockRepository(t)
+	users := mocks.NewMockUserService(t)
+	cooldowns := mocks.NewMockCooldownChecker(t)
+	voting := mocks.NewMockVotingSessions(t)
+	compost := mocks.NewMockCompostBins(t)
+	publisher := mocks.NewMockPublisher(t)
+
+	return &testService{
+		svc:       reminder.NewService(repo, users, cooldowns, voting, compost, publisher, cfg),
+		repo:      repo,
+		users:     users,
+		cooldowns: cooldowns,
+		voting:    voting,
+		compost:   compost,
+		publisher: publisher,
+	}
+}
+
+// expectSave accepts the pending-limit check and echoes the saved reminder back
+func expectSave(ctx context.Context, repo *mocks.MockRepository) {
+	repo.On("GetReminders", ctx, "user-1").Return(nil, nil)
+	repo.On("SaveReminder", ctx, mock.Anything).Return(func(_ context.Context, r domain.Reminder) (*domain.Reminder, error) {
+		r.ID = 1
+		return &r, nil
+	})
+}
+
+func cooldownRequest(action string) reminder.Request {
+	return reminder.Request{
+		Platform:   domain.PlatformDiscord,
+		PlatformID: "d-1",
+		Username:   "alice",
+		Kind:       domain.ReminderKindCooldown,
+		Target:     action,
+		ChannelID:  "chan-1",
+	}
+}
+
+func TestCreateReminder_Cooldown(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("fires when the tracked cooldown ends", func(t *testing.T) {
+		ts := setupService(t, reminder.Config{})
+		ts.users.On("GetUserOrRegister", ctx, domain.PlatformDiscord, "d-1", "alice").Return(&domain.User{ID: "user-1"}, nil)
+		ts.cooldowns.On("CheckCooldown", ctx, "user-1", domain.ActionSearch).Return(true, 10*time.Minute, nil)
+		expectSave(ctx, ts.repo)
+
+		got, err := ts.svc.CreateReminder(ctx, cooldownRequest(domain.ActionSearch))
+
+		require.NoError(t, err)
+		assert.WithinDuration(t, time.Now().Add(10*time.Minute), got.RemindAt, time.Second)
+		assert.Equal(t, domain.ActionSearch, got.Target)
+		assert.Equal(t, "chan-1", got.ChannelID)
+		assert.Contains(t, got.Message, domain.ActionSearch)
+	})
+
+	t.Run("falls back to remind_at for untracked cooldowns", func(t *testing.T) {
+		ts := setupService(t, reminder.Config{})
+		at := time.Now().Add(2 * time.Hour)
+		req := cooldownRequest("start an expedition")
+		req.RemindAt = &at
+		ts.users.On("GetUserOrRegister", ctx, domain.PlatformDiscord, "d-1", "alice").Return(&domain.User{ID: "user-1"}, nil)
+		ts.cooldowns.On("CheckCooldown", ctx, "user-1", "start an expedition").Return(false, time.Duration(0), nil)
+		expectSave(ctx, ts.repo)
+
+		got, err := ts.svc.CreateReminder(ctx, req)
+
+		require.NoError(t, err)
+		assert.Equal(t, at, got.RemindAt)
+	})
+
+	t.Run("rejects remind_at too far ahead", func(t *testing.T) {
+		ts := setupService(t, reminder.Config{})
+		at := time.Now().Add(reminder.MaxCooldownReminderDelay + time.Hour)
+		req := cooldownRequest("start an expedition")
+		req.RemindAt = &at
+		ts.users.On("GetUserOrRegister", ctx, domain.PlatformDiscord, "d-1", "alice").Return(&domain.User{ID: "user-1"}, nil)
+		ts.cooldowns.On("CheckCooldown", ctx, "user-1", "start an expedition").Return(false, time.Duration(0), nil)
+
+		_, err := ts.svc.CreateReminder(ctx, req)
+
+		assert.ErrorIs(t, err, domain.ErrInvalidInput)
+	})
+
+	t.Run("nothing to remind about when not on cooldown", func(t *testing.T) {
+		ts := setupService(t, reminder.Config{})
+		ts.users.On("GetUserOrRegister", ctx, domain.PlatformDiscord, "d-1", "alice").Return(&domain.User{ID: "user-1"}, nil)
+		ts.cooldowns.On("CheckCooldown", ctx, "user-1", domain.ActionSearch).Return(false, time.Duration(0), nil)
+
+		_, err := ts.svc.CreateReminder(ctx, cooldownRequest(domain.ActionSearch))
+
+		assert.ErrorIs(t, err, domain.ErrNothingToRemind)
+	})
+
+	t.Run("requires an action", func(t *testing.T) {
+		ts := setupService(t, reminder.Config{})
+
+		_, err := ts.svc.CreateReminder(ctx, cooldownRequest("  "))
+
+		assert.ErrorIs(t, err, domain.ErrInvalidInput)
+	})
+}
+
+func TestCreateReminder_InvalidKind(t *testing.T) {
+	ts := setupService(t, reminder.Config{})
+	req := cooldownRequest(domain.ActionSearch)
+	req.Kind = "birthday"
+
+	_, err := ts.svc.CreateReminder(context.Background(), req)
+
+	assert.ErrorIs(t, err, domain.ErrInvalidReminderKind)
+}
+
+func TestCreateReminder_Vote(t *testing.T) {
+	ctx := context.Background()
+	req := reminder.Request{Platform: domain.PlatformDiscord, PlatformID: "d-1", Username: "alice", Kind: domain.ReminderKindVote}
+
+	t.Run("fires the configured lead before voting closes", func(t *testing.T) {
+		ts := setupService(t, reminder.Config{VoteLead: 10 * time.Minute})
+		deadline := time.Now().Add(time.Hour)
+		ts.users.On("GetUserOrRegister", ctx, domain.PlatformDiscord, "d-1", "alice").Return(&domain.User{ID: "user-1"}, nil)
+		ts.voting.On("GetActiveVotingSession", ctx).Return(&domain.ProgressionVotingSession{ID: 42, VotingDeadline: deadline}, nil)
+		expectSave(ctx, ts.repo)
+
+		got, err := ts.svc.CreateReminder(ctx, req)
+
+		require.NoError(t, err)
+		assert.Equal(t, deadline.Add(-10*time.Minute), got.RemindAt)
+		assert.Equal(t, "42", got.Target)
+	})
+
+	t.Run("fires straight away when voting closes within the lead", func(t *testing.T) {
+		ts := setupService(t, reminder.Config{VoteLead: 10 * time.Minute})
+		ts.users.On("GetUserOrRegister", ctx, domain.PlatformDiscord, "d-1", "alice").Return(&domain.User{ID: "user-1"}, nil)
+		ts.voting.On("GetActiveVotingSession", ctx).Return(&domain.ProgressionVotingSession{ID: 42, VotingDeadline: time.Now().Add(time.Minute)}, nil)
+		expectSave(ctx, ts.repo)
+
+		got, err := ts.svc.CreateReminder(ctx, req)
+
+		require.NoError(t, err)
+		assert.WithinDuration(t, time.Now(), got.RemindAt, time.Second)
+	})
+
+	t.Run("nothing to remind about without an open vote", func(t *testing.T) {
+		ts := setupService(t, reminder.Config{})
+		ts.users.On("GetUserOrRegister", ctx, domain.PlatformDiscord, "d-1", "alice").Return(&domain.User{ID: "user-1"}, nil)
+		ts.voting.On("GetActiveVotingSession", ctx).Return(nil, nil)
+
+		_, err := ts.svc.CreateReminder(ctx, req)
+
+		assert.ErrorIs(t, err, domain.ErrNothingToRemind)
+	})
+}
+
+func TestCreateReminder_Compost(t *testing.T) {
+	ctx := context.Background()
+	req := reminder.Request{Platform: domain.PlatformDiscord, PlatformID: "d-1", Username: "alice", Kind: domain.ReminderKindCompost}
+
+	t.Run("fires when the bin is ready", func(t *testing.T) {
+		ts := setupService(t, reminder.Config{})
+		readyAt := time.Now().Add(3 * time.Hour)
+		ts.users.On("GetUserOrRegister", ctx, domain.PlatformDiscord, "d-1", "alice").Return(&domain.User{ID: "user-1"}, nil)
+		ts.compost.On("GetBin", ctx, "user-1").Return(&domain.CompostBin{Status: domain.CompostBinStatusComposting, ReadyAt: &readyAt}, nil)
+		expectSave(ctx, ts.repo)
+
+		got, err := ts.svc.CreateReminder(ctx, req)
+
+		require.NoError(t, err)
+		assert.Equal(t, readyAt, got.RemindAt)
+		assert.Equal(t, reminder.MsgCompostReady, got.Message)
+	})
+
+	t.Run("nothing to remind about when the bin is idle", func(t *testing.T) {
+		ts := setupService(t, reminder.Config{})
+		ts.users.On("GetUserOrRegister", ctx, domain.PlatformDiscord, "d-1", "alice").Return(&domain.User{ID: "user-1"}, nil)
+		ts.compost.On("GetBin", ctx, "user-1").Return(nil, nil)
+
+		_, err := ts.svc.CreateReminder(ctx, req)
+
+		assert.ErrorIs(t, err, domain.ErrNothingToRemind)
+	})
+}
+
+func TestCreateReminder_PendingLimit(t *testing.T) {
+	ctx := context.Background()
+	pending := []domain.Reminder{
+		{ID: 1, Kind: domain.ReminderKindCooldown, Target: domain.ActionSearch},
+		{ID: 2, Kind: domain.ReminderKindCompost},
+	}
+
+	t.Run("rejects a new reminder at the limit", func(t *testing.T) {
+		ts := setupService(t, reminder.Config{MaxPending: 2})
+		ts.users.On("GetUserOrRegister", ctx, domain.PlatformDiscord, "d-1", "alice").Return(&domain.User{ID: "user-1"}, nil)
+		ts.cooldowns.On("CheckCooldown", ctx, "user-1", domain.ActionSlots).Return(true, time.Minute, nil)
+		ts.repo.On("GetReminders", ctx, "user-1").Return(pending, nil)
+
+		_, err := ts.svc.CreateReminder(ctx, cooldownRequest(domain.ActionSlots))
+
+		assert.ErrorIs(t, err, domain.ErrTooManyReminders)
+	})
+
+	t.Run("allows replacing a pending reminder at the limit", func(t *testing.T) {
+		ts := setupService(t, reminder.Config{MaxPending: 2})
+		ts.users.On("GetUserOrRegister", ctx, domain.PlatformDiscord, "d-1", "alice").Return(&domain.User{ID: "user-1"}, nil)
+		ts.cooldowns.On("CheckCooldown", ctx, "user-1", domain.ActionSearch).Return(true, time.Minute, nil)
+		ts.repo.On("GetReminders", ctx, "user-1").Return(pending, nil)
+		ts.repo.On("SaveReminder", ctx, mock.Anything).Return(func(_ context.Context, r domain.Reminder) (*domain.Reminder, error) {
+			r.ID = 1
+			return &r, nil
+		})
+
+		_, err := ts.svc.CreateReminder(ctx, cooldownRequest(domain.ActionSearch))
+
+		require.NoError(t, err)
+	})
+}
+
+func TestCancelReminder(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("deletes the user's reminder", func(t *testing.T) {
+		ts := setupService(t, reminder.Config{})
+		ts.users.On("GetUserIDByPlatformID", ctx, domain.PlatformDiscord, "d-1").Return("user-1", nil)
+		ts.repo.On("DeleteReminder", ctx, "user-1", int64(7)).Return(true, nil)
+
+		require.NoError(t, ts.svc.CancelReminder(ctx, domain.PlatformDiscord, "d-1", 7))
+	})
+
+	t.Run("not found when nothing was deleted", func(t *testing.T) {
+		ts := setupService(t, reminder.Config{})
+		ts.users.On("GetUserIDByPlatformID", ctx, domain.PlatformDiscord, "d-1").Return("user-1", nil)
+		ts.repo.On("DeleteReminder", ctx, "user-1", int64(7)).Return(false, nil)
+
+		assert.ErrorIs(t, ts.svc.CancelReminder(ctx, domain.PlatformDiscord, "d-1", 7), domain.ErrReminderNotFound)
+	})
+
+	t.Run("unknown user", func(t *testing.T) {
+		ts := setupService(t, reminder.Config{})
+		ts.users.On("GetUserIDByPlatformID", ctx, domain.PlatformDiscord, "d-1").Return("", nil)
+
+		assert.ErrorIs(t, ts.svc.CancelReminder(ctx, domain.PlatformDiscord, "d-1", 7), domain.ErrUserNotFound)
+	})
+}
+
+func TestDispatchDue(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("claims batches until one comes back short", func(t *testing.T) {
+		ts := setupService(t, reminder.Config{BatchSize: 2})
+		ts.repo.On("ClaimDueReminders", ctx, mock.Anything, 2).Return([]domain.Reminder{{ID: 1}, {ID: 2}}, nil).Once()
+		ts.repo.On("ClaimDueReminders", ctx, mock.Anything, 2).Return([]domain.Reminder{{ID: 3}}, nil).Once()
+		ts.publisher.On("PublishWithRetry", ctx, mock.MatchedBy(func(evt event.Event) bool {
+			return evt.Type == event.ReminderDue
+		})).Times(3)
+
+		sent, err := ts.svc.DispatchDue(ctx)
+
+		require.NoError(t, err)
+		assert.Equal(t, 3, sent)
+	})
+
+	t.Run("nothing due", func(t *testing.T) {
+		ts := setupService(t, reminder.Config{})
+		ts.repo.On("ClaimDueReminders", ctx, mock.Anything, reminder.DefaultBatchSize).Return(nil, nil)
+
+		sent, err := ts.svc.DispatchDue(ctx)
+
+		require.NoError(t, err)
+		assert.Zero(t, sent)
+	})
+}
@@ -41,9 +41,7 @@ type Crafting interface {
 
 // CraftingTx defines the interface for crafting transactions
 type CraftingTx interface {
-	Tx
-	GetInventory(ctx context.Context, userID string) (*domain.Inventory, error)
-	UpdateInventory(ctx context.Context, userID string, inventory domain.Inventory) error
+	InventoryTx
 }
 
 // Structs needed for the interface (formerly in crafting package)
@@ -20,7 +20,7 @@ type Economy interface {
 
 // EconomyTx defines the interface for economy transactions
 type EconomyTx interface {
-	Tx
-	GetInventory(ctx context.Context, userID string) (*domain.Inventory, error)
-	UpdateInventory(ctx context.Context, userID string, inventory domain.Inventory) error
+	InventoryTx
+	InventoryAdjuster
+	CommunityPoolCreditor
 }
@@ -0,0 +1,18 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+)
+
+// FishingRepository stores each user's line in the water
+type FishingRepository interface {
+	// CreateCast stores the cast unless the user's previous cast can still
+	// be reeled in, in which case it returns false
+	CreateCast(ctx context.Context, cast *domain.FishingCast) (bool, error)
+
+	// TakeCast removes and returns the user's cast, or nil when there is
+	// none. Taking it is atomic, so a cast is only ever reeled in once.
+	TakeCast(ctx context.Context, userID string) (*domain.FishingCast, error)
+}
@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 
@@ -19,6 +20,7 @@ type Gamble interface {
 	CompleteGamble(ctx context.Context, result *domain.GambleResult) error
 	RefundGamble(ctx context.Context, id uuid.UUID) error
 	GetActiveGamble(ctx context.Context) (*domain.Gamble, error)
+	GetStaleGambles(ctx context.Context, deadlineBefore time.Time) ([]domain.Gamble, error) // Unfinished gambles whose join deadline passed before the cutoff
 
 	// Transaction support
 	BeginGambleTx(ctx context.Context) (GambleTx, error)
@@ -42,7 +44,15 @@ type GambleTx interface {
 	CompleteGamble(ctx context.Context, result *domain.GambleResult) error
 	RefundGamble(ctx context.Context, id uuid.UUID) error
 
+	// Side-bet operations within transaction
+	PlaceSideBet(ctx context.Context, bet *domain.SideBet) error
+	GetSideBets(ctx context.Context, gambleID uuid.UUID) ([]domain.SideBet, error)
+	SettleSideBet(ctx context.Context, gambleID uuid.UUID, userID string, payout int) error
+
 	// Inventory operations within transaction
 	GetInventory(ctx context.Context, userID string) (*domain.Inventory, error)
 	UpdateInventory(ctx context.Context, userID string, inventory domain.Inventory) error
+
+	// Gamble rake
+	CommunityPoolCreditor
 }
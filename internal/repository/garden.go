@@ -0,0 +1,22 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+)
+
+// GardenRepository stores the seeds growing in each user's garden plots
+type GardenRepository interface {
+	// GetPlots returns the user's planted plots, by slot
+	GetPlots(ctx context.Context, userID string) ([]domain.GardenPlot, error)
+
+	// PlantPlot plants into the user's lowest free slot up to capacity and
+	// sets plot.Slot. It returns false when every slot is taken.
+	PlantPlot(ctx context.Context, userID string, capacity int, plot *domain.GardenPlot) (bool, error)
+
+	// TakeReadyPlots removes and returns the user's plots ready by now.
+	// Taking them is atomic, so a plot is only ever harvested once.
+	TakeReadyPlots(ctx context.Context, userID string, now time.Time) ([]domain.GardenPlot, error)
+}
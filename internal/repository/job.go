@@ -17,7 +17,18 @@ type Job interface {
 	GetUserJob(ctx context.Context, userID string, jobID int) (*domain.UserJob, error)
 	GetUserJobsByPlatform(ctx context.Context, platform, platformID string) ([]domain.UserJob, error)
 	UpsertUserJob(ctx context.Context, userJob *domain.UserJob) error
+	SetActiveJob(ctx context.Context, userID string, jobID int) error
+	PrestigeUserJob(ctx context.Context, userID string, jobID, minLevel int) (int, error)
 	ResetDailyJobXP(ctx context.Context) (int64, error)
 	GetLastDailyResetTime(ctx context.Context) (time.Time, int64, error)
 	UpdateDailyResetTime(ctx context.Context, resetTime time.Time, recordsAffected int64) error
+
+	// Timed XP boosts
+	GetActiveXPBoost(ctx context.Context, userID string) (float64, error)
+	GrantXPBoost(ctx context.Context, userID string, multiplier float64, duration time.Duration) error
+
+	// XP award audit trail
+	RecordXPAward(ctx context.Context, award *domain.XPAward) error
+	GetSourceXPSince(ctx context.Context, userID, source string, since time.Time) (int64, error)
+	GetRecentXPAwards(ctx context.Context, userID string, limit int) ([]domain.XPAward, error)
 }
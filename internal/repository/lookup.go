@@ -0,0 +1,65 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/osse101/BrandishBot_Go/internal/database"
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+)
+
+// ItemGetter looks items up by internal name
+type ItemGetter interface {
+	GetItemByName(ctx context.Context, itemName string) (*domain.Item, error)
+}
+
+// InventoryReader reads a user's inventory outside a transaction
+type InventoryReader interface {
+	GetInventory(ctx context.Context, userID string) (*domain.Inventory, error)
+}
+
+// UserIDLookup finds a user's ID from their platform ID, "" when unknown
+type UserIDLookup interface {
+	GetUserIDByPlatformID(ctx context.Context, platform, platformID string) (string, error)
+}
+
+// MoneyItem looks up the money item that prices, fees and deposits are paid in
+func MoneyItem(ctx context.Context, items ItemGetter) (*domain.Item, error) {
+	money, err := items.GetItemByName(ctx, domain.ItemMoney)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get money item: %w", err)
+	}
+	if money == nil {
+		return nil, fmt.Errorf("%w: %s", domain.ErrItemNotFound, domain.ItemMoney)
+	}
+	return money, nil
+}
+
+// PaymentSlot picks the quality of the user's money slot that covers the
+// amount. The read is not locked; if the money is spent meanwhile, the
+// debit that follows fails.
+func PaymentSlot(ctx context.Context, inventories InventoryReader, userID string, moneyID int, amount int64) (domain.QualityLevel, error) {
+	inventory, err := inventories.GetInventory(database.WithPrimaryReads(ctx), userID)
+	if err != nil {
+		return "", fmt.Errorf("failed to get inventory: %w", err)
+	}
+	for _, slot := range inventory.Slots {
+		if slot.ItemID == moneyID && int64(slot.Quantity) >= amount {
+			return slot.QualityLevel, nil
+		}
+	}
+	return "", fmt.Errorf("%w: %d needed", domain.ErrInsufficientFunds, amount)
+}
+
+// RegisteredUserID returns the ID of a user who must already be registered,
+// domain.ErrUserNotFound otherwise
+func RegisteredUserID(ctx context.Context, users UserIDLookup, platform, platformID string) (string, error) {
+	userID, err := users.GetUserIDByPlatformID(ctx, platform, platformID)
+	if err != nil {
+		return "", err
+	}
+	if userID == "" {
+		return "", domain.ErrUserNotFound
+	}
+	return userID, nil
+}
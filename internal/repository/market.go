@@ -0,0 +1,32 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+)
+
+// Market persists per-item trade pressure and price history
+type Market interface {
+	// RecordTrade decays the item's pressure to now with the given half-life
+	// and adds delta, positive for units bought and negative for units sold
+	RecordTrade(ctx context.Context, itemID int, delta float64, halfLife time.Duration) error
+
+	// GetMarketState returns the item's trade pressure, or nil if it has
+	// never traded
+	GetMarketState(ctx context.Context, itemID int) (*domain.ItemMarketState, error)
+
+	// ListMarketStates returns every item that has traded
+	ListMarketStates(ctx context.Context) ([]domain.ItemMarketState, error)
+
+	// RecordPrice appends a point to the item's price history
+	RecordPrice(ctx context.Context, itemID int, point domain.PricePoint) error
+
+	// GetPriceHistory returns up to limit of the item's most recent points
+	// since the given time, oldest first
+	GetPriceHistory(ctx context.Context, itemID int, since time.Time, limit int) ([]domain.PricePoint, error)
+
+	// PrunePriceHistory deletes points recorded before the given time
+	PrunePriceHistory(ctx context.Context, before time.Time) (int64, error)
+}
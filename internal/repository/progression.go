@@ -18,8 +18,9 @@ type Progression interface {
 	GetAllNodesByFeatureKey(ctx context.Context, featureKey string) ([]*domain.ProgressionNode, []int, error) // Returns all nodes with same feature_key and their levels
 
 	// Prerequisites operations (v2.0 - junction table)
-	GetPrerequisites(ctx context.Context, nodeID int) ([]*domain.ProgressionNode, error) // Get prerequisites FOR this node
-	GetDependents(ctx context.Context, nodeID int) ([]*domain.ProgressionNode, error)    // Get nodes that depend ON this node
+	GetPrerequisites(ctx context.Context, nodeID int) ([]*domain.ProgressionNode, error)        // Get prerequisites FOR this node
+	GetPrerequisiteGroups(ctx context.Context, nodeID int) ([][]*domain.ProgressionNode, error) // Same, grouped: one unlocked node per group is required
+	GetDependents(ctx context.Context, nodeID int) ([]*domain.ProgressionNode, error)           // Get nodes that depend ON this node
 
 	// Modifier (Bonus configs)
 	GetBonusModifiers(ctx context.Context, featureKey string) ([]domain.ModifierConfig, error)
@@ -41,6 +42,7 @@ type Progression interface {
 	GetActiveOrFrozenSession(ctx context.Context) (*domain.ProgressionVotingSession, error) // Get session with status 'voting' or 'frozen'
 	GetMostRecentSession(ctx context.Context) (*domain.ProgressionVotingSession, error)     // Bug #1: Get most recent session (any status)
 	GetSessionByID(ctx context.Context, sessionID int) (*domain.ProgressionVotingSession, error)
+	GetCompletedSessions(ctx context.Context, limit, offset int) ([]domain.VotingSessionRecap, int, error) // Newest first, with the total count of completed sessions
 	IncrementOptionVote(ctx context.Context, optionID int) error
 	EndVotingSession(ctx context.Context, sessionID int, winningOptionID *int) error
 	FreezeVotingSession(ctx context.Context, sessionID int) error // Pause voting until unlock completes
@@ -48,7 +50,14 @@ type Progression interface {
 	GetSessionVoters(ctx context.Context, sessionID int) ([]string, error)
 	HasUserVotedInSession(ctx context.Context, userID string, sessionID int) (bool, error)
 	RecordUserSessionVote(ctx context.Context, userID string, sessionID, optionID, nodeID, targetLevel int) error
-	CheckAndRecordVoteAtomic(ctx context.Context, userID string, sessionID, optionID, nodeID, targetLevel int) error
+	CheckAndRecordVoteAtomic(ctx context.Context, userID string, sessionID, optionID, nodeID, targetLevel, weight int) error // Replaces a vote a delegate cast for the user
+
+	// Vote delegation
+	SetVoteDelegation(ctx context.Context, delegatorID, delegateID string) error
+	DeleteVoteDelegation(ctx context.Context, delegatorID string) (bool, error)
+	GetVoteDelegation(ctx context.Context, delegatorID string) (*domain.VoteDelegation, error) // nil when the user has not delegated
+	GetVoteDelegators(ctx context.Context, delegateID string) ([]string, error)
+	RecordDelegatedVotes(ctx context.Context, delegateID string, sessionID, optionID, nodeID, targetLevel int, weights map[string]int) (int, error) // weights by delegator; returns votes cast
 
 	// Unlock progress tracking
 	CreateUnlockProgress(ctx context.Context) (int, error)
@@ -69,6 +78,15 @@ type Progression interface {
 	GetContributionLeaderboard(ctx context.Context, limit int) ([]domain.ContributionLeaderboardEntry, error)
 	GetEngagementWeights(ctx context.Context) (map[string]float64, error)
 	GetDailyEngagementTotals(ctx context.Context, since time.Time) (map[time.Time]int, error)
+	// RecordCappedEngagement records up to limit minus the metric type's total
+	// since the given time and returns the value recorded (0 when the limit is used up)
+	RecordCappedEngagement(ctx context.Context, metric *domain.EngagementMetric, limit int, since time.Time) (int, error)
+
+	// Running contribution scores (decayed over time, ranked on the leaderboard)
+	AddUserContributionScore(ctx context.Context, userID string, points float64) error
+	GetUserContributionScore(ctx context.Context, userID string) (float64, error) // 0 when the user has no score
+	GetAverageContributionScore(ctx context.Context) (float64, error)
+	DecayContributionScores(ctx context.Context, retained, floor float64) (*domain.ContributionDecayResult, error)
 
 	// Reset operations
 	ResetTree(ctx context.Context, resetBy string, reason string, preserveUserData bool) error
@@ -84,6 +102,11 @@ type Progression interface {
 	GetNodeDynamicPrerequisites(ctx context.Context, nodeID int) ([]byte, error)
 	UpdateNodeDynamicPrerequisites(ctx context.Context, nodeID int, jsonData []byte) error
 
+	// Node effects, as JSON
+	GetNodeEffects(ctx context.Context, nodeID int) ([]byte, error)
+	GetAllNodeEffects(ctx context.Context) (map[int][]byte, error) // Node ID -> effects, for nodes that declare any
+	UpdateNodeEffects(ctx context.Context, nodeID int, jsonData []byte) error
+
 	// Transaction support
 	BeginTx(ctx context.Context) (Tx, error)
 }
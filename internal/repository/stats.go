@@ -13,10 +13,14 @@ type Stats interface {
 	GetEventsByUser(ctx context.Context, userID string, startTime, endTime time.Time) ([]domain.StatsEvent, error)
 	GetEventsByType(ctx context.Context, eventType domain.EventType, startTime, endTime time.Time) ([]domain.StatsEvent, error)
 	GetUserEventsByType(ctx context.Context, userID string, eventType domain.EventType, limit int) ([]domain.StatsEvent, error)
-	GetTopUsers(ctx context.Context, eventType domain.EventType, startTime, endTime time.Time, limit int) ([]domain.LeaderboardEntry, error)
+	GetTopUsers(ctx context.Context, eventType domain.EventType, startTime, endTime time.Time, limit, offset int) ([]domain.LeaderboardEntry, error)
+	// GetUserLeaderboardRank returns the user's 1-based position in GetTopUsers order, or 0 if they have no events
+	GetUserLeaderboardRank(ctx context.Context, eventType domain.EventType, userID string, startTime, endTime time.Time) (int, error)
 	GetEventCounts(ctx context.Context, startTime, endTime time.Time) (map[domain.EventType]int, error)
 	GetUserEventCounts(ctx context.Context, userID string, startTime, endTime time.Time) (map[domain.EventType]int, error)
 	GetTotalEventCount(ctx context.Context, startTime, endTime time.Time) (int, error)
+	// GetUserRecipeCraftCounts returns how many items the user has crafted with each recipe, by recipe key
+	GetUserRecipeCraftCounts(ctx context.Context, userID string) (map[string]int, error)
 	// Slots-specific stats
 	GetUserSlotsStats(ctx context.Context, userID string, startTime, endTime time.Time) (*domain.SlotsStats, error)
 	GetSlotsLeaderboardByProfit(ctx context.Context, startTime, endTime time.Time, limit int) ([]domain.SlotsStats, error)
@@ -2,6 +2,8 @@ package repository
 
 import (
 	"context"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
 )
 
 // Tx defines the interface for transaction lifecycle operations.
@@ -11,3 +13,30 @@ type Tx interface {
 	Commit(ctx context.Context) error
 	Rollback(ctx context.Context) error
 }
+
+// InventoryTx is a transaction that reads and writes inventories.
+// GetInventory locks the user's inventory row until Commit or Rollback, so
+// concurrent read-modify-write cycles on the same inventory are serialized
+// instead of overwriting each other.
+type InventoryTx interface {
+	Tx
+	GetInventory(ctx context.Context, userID string) (*domain.Inventory, error)
+	UpdateInventory(ctx context.Context, userID string, inventory domain.Inventory) error
+}
+
+// InventoryAdjuster changes a single inventory slot in place, without a
+// read-modify-write of the whole inventory. Slots are keyed by item and
+// quality, so callers name the quality of the slot they mean.
+type InventoryAdjuster interface {
+	// AdjustItemQuantity adds delta (which may be negative) to the slot and
+	// returns its new quantity. The slot is created when missing and removed
+	// at zero. A delta that would go below zero returns
+	// domain.ErrInsufficientQuantity and leaves the inventory unchanged.
+	AdjustItemQuantity(ctx context.Context, userID string, itemID int, quality domain.QualityLevel, delta int) (int, error)
+}
+
+// CommunityPoolCreditor credits the community pool within a transaction, so
+// a fee is only collected if the trade it was taken from commits
+type CommunityPoolCreditor interface {
+	AddToCommunityPool(ctx context.Context, amount int) error
+}
@@ -2,7 +2,6 @@ package repository
 
 import (
 	"context"
-	"time"
 
 	"github.com/osse101/BrandishBot_Go/internal/domain"
 )
@@ -27,16 +26,13 @@ type User interface {
 
 	BeginTx(ctx context.Context) (UserTx, error)
 
-	GetLastCooldown(ctx context.Context, userID, action string) (*time.Time, error)
-	UpdateCooldown(ctx context.Context, userID, action string, timestamp time.Time) error
-
 	// Account linking - atomic transaction for merge
 	MergeUsersInTransaction(ctx context.Context, primaryUserID, secondaryUserID string, mergedUser domain.User, mergedInventory domain.Inventory) error
 }
 
 // UserTx defines the interface for user transactions
 type UserTx interface {
-	Tx
-	GetInventory(ctx context.Context, userID string) (*domain.Inventory, error)
-	UpdateInventory(ctx context.Context, userID string, inventory domain.Inventory) error
+	InventoryTx
+	InventoryAdjuster
+	CommunityPoolCreditor
 }
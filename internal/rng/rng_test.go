@@ -0,0 +1,51 @@
+package rng_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/osse101/BrandishBot_Go/internal/rng"
+)
+
+func draw(src rng.Source, n int) []float64 {
+	out := make([]float64, n)
+	for i := range out {
+		out[i] = src.Float64()
+	}
+	return out
+}
+
+func TestNew_SameSeedSameSequence(t *testing.T) {
+	ctx := context.Background()
+	a := rng.New(42, false)
+	b := rng.New(42, false)
+
+	for i := 0; i < 3; i++ {
+		assert.Equal(t, draw(a.ForOperation(ctx, rng.OpLootboxOpen), 5), draw(b.ForOperation(ctx, rng.OpLootboxOpen), 5))
+	}
+}
+
+func TestNew_OperationsGetDifferentSources(t *testing.T) {
+	ctx := context.Background()
+	p := rng.New(42, false)
+
+	assert.NotEqual(t, draw(p.ForOperation(ctx, rng.OpSearch), 5), draw(p.ForOperation(ctx, rng.OpSearch), 5))
+}
+
+func TestNew_ZeroSeedIsRandomized(t *testing.T) {
+	p := rng.New(0, false)
+
+	assert.NotZero(t, p.Seed())
+	assert.Positive(t, p.Seed())
+}
+
+func TestNewReplay_RepeatsSeed(t *testing.T) {
+	ctx := context.Background()
+	p := rng.NewReplay(7)
+
+	first := draw(p.ForOperation(ctx, rng.OpGambleWinner), 5)
+	assert.Equal(t, first, draw(p.ForOperation(ctx, rng.OpGambleWinner), 5))
+	assert.Equal(t, int64(7), p.Seed())
+}
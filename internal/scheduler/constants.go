@@ -0,0 +1,29 @@
+package scheduler
+
+import (
+	"errors"
+	"time"
+)
+
+// ErrInvalidCron is returned when a cron expression cannot be parsed
+var ErrInvalidCron = errors.New("invalid cron expression")
+
+// Lease durations
+const (
+	// MinLeaseTTL is the shortest lease taken for a run
+	MinLeaseTTL = time.Second
+	// OneShotLeaseTTL covers one-shot jobs, which have no next run to bound the lease
+	OneShotLeaseTTL = time.Hour
+)
+
+// Log messages
+const (
+	LogMsgJobStateLoadFailed  = "Failed to load scheduled job state"
+	LogMsgJobStateSaveFailed  = "Failed to save scheduled job state"
+	LogMsgMissedRunCatchUp    = "Scheduled job missed a run while stopped, running now"
+	LogMsgOneShotAlreadyRan   = "One-shot job already ran, skipping"
+	LogMsgCronNeverMatches    = "Cron expression never matches, job will not run"
+	LogMsgScheduledJobEnqueue = "Failed to enqueue scheduled job"
+	LogMsgLeaseAcquireFailed  = "Failed to acquire scheduler lease, skipping run"
+	LogMsgLeaseHeldElsewhere  = "Scheduler lease held by another instance, skipping run"
+)
@@ -0,0 +1,83 @@
+package scheduler
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseCron_Next(t *testing.T) {
+	t.Parallel()
+
+	// Wednesday
+	base := time.Date(2025, 1, 15, 10, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		name string
+		expr string
+		want time.Time
+	}{
+		{"every minute", "* * * * *", time.Date(2025, 1, 15, 10, 31, 0, 0, time.UTC)},
+		{"daily later today", "0 12 * * *", time.Date(2025, 1, 15, 12, 0, 0, 0, time.UTC)},
+		{"daily tomorrow", "0 4 * * *", time.Date(2025, 1, 16, 4, 0, 0, 0, time.UTC)},
+		{"step minutes", "*/20 * * * *", time.Date(2025, 1, 15, 10, 40, 0, 0, time.UTC)},
+		{"list and range", "15 8-9,22 * * *", time.Date(2025, 1, 15, 22, 15, 0, 0, time.UTC)},
+		{"weekday", "0 0 * * 1", time.Date(2025, 1, 20, 0, 0, 0, 0, time.UTC)},
+		{"sunday as 7", "0 0 * * 7", time.Date(2025, 1, 19, 0, 0, 0, 0, time.UTC)},
+		{"day of month", "0 0 1 * *", time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC)},
+		{"dom or dow", "0 0 20 * 5", time.Date(2025, 1, 17, 0, 0, 0, 0, time.UTC)},
+		{"month rollover", "0 0 1 3 *", time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)},
+		{"leap day", "0 0 29 2 *", time.Date(2028, 2, 29, 0, 0, 0, 0, time.UTC)},
+		{"macro", "@hourly", time.Date(2025, 1, 15, 11, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			cron, err := ParseCron(tt.expr)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, cron.Next(base))
+		})
+	}
+}
+
+func TestParseCron_NextIsStrictlyAfter(t *testing.T) {
+	t.Parallel()
+
+	cron, err := ParseCron("0 4 * * *")
+	require.NoError(t, err)
+
+	at := time.Date(2025, 1, 15, 4, 0, 0, 0, time.UTC)
+	assert.Equal(t, at.AddDate(0, 0, 1), cron.Next(at))
+}
+
+func TestParseCron_NeverMatches(t *testing.T) {
+	t.Parallel()
+
+	cron, err := ParseCron("0 0 31 2 *")
+	require.NoError(t, err)
+	assert.True(t, cron.Next(time.Now()).IsZero())
+}
+
+func TestParseCron_Invalid(t *testing.T) {
+	t.Parallel()
+
+	for _, expr := range []string{
+		"",
+		"* * * *",
+		"60 * * * *",
+		"* 24 * * *",
+		"* * 0 * *",
+		"* * * 13 *",
+		"* * * * 8",
+		"*/0 * * * *",
+		"5-1 * * * *",
+		"a * * * *",
+		"@often",
+	} {
+		_, err := ParseCron(expr)
+		assert.ErrorIs(t, err, ErrInvalidCron, "expr %q", expr)
+	}
+}
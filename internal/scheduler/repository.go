@@ -0,0 +1,25 @@
+package scheduler
+
+import (
+	"context"
+	"time"
+)
+
+// JobState is the persisted run bookkeeping for a named job
+type JobState struct {
+	Name      string
+	NextRunAt *time.Time // nil once a one-shot job has run
+	LastRunAt *time.Time
+}
+
+// Repository persists job run times so named schedules survive restarts, and
+// hands out leases so that only one instance runs each execution of a job
+type Repository interface {
+	// GetJobState returns the stored state for a job (returns nil, nil if not found)
+	GetJobState(ctx context.Context, name string) (*JobState, error)
+	SaveJobState(ctx context.Context, state JobState) error
+
+	// TryAcquireLease takes the named lease for holder for ttl. It succeeds if
+	// the lease is free, expired, or already held by holder.
+	TryAcquireLease(ctx context.Context, name, holder string, ttl time.Duration) (bool, error)
+}
@@ -2,15 +2,22 @@ package scheduler
 
 import (
 	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"os"
 	"sync"
 	"time"
 
+	"github.com/osse101/BrandishBot_Go/internal/logger"
 	"github.com/osse101/BrandishBot_Go/internal/worker"
 )
 
 // Scheduler manages scheduled jobs
 type Scheduler struct {
 	workerPool *worker.Pool
+	repo       Repository
+	instanceID string
 	ctx        context.Context
 	cancel     context.CancelFunc
 	wg         sync.WaitGroup
@@ -18,9 +25,19 @@ type Scheduler struct {
 
 // New creates a new scheduler
 func New(pool *worker.Pool) *Scheduler {
+	return NewWithRepository(pool, nil)
+}
+
+// NewWithRepository creates a scheduler that persists the run times of named
+// cron and one-shot jobs and takes a lease before each run, so that when
+// several instances share a database each execution happens on only one of
+// them. A nil repository keeps state in memory and runs every job locally.
+func NewWithRepository(pool *worker.Pool, repo Repository) *Scheduler {
 	ctx, cancel := context.WithCancel(context.Background())
 	return &Scheduler{
 		workerPool: pool,
+		repo:       repo,
+		instanceID: newInstanceID(),
 		ctx:        ctx,
 		cancel:     cancel,
 	}
@@ -28,6 +45,7 @@ func New(pool *worker.Pool) *Scheduler {
 
 // Schedule registers a job to run at a fixed interval
 func (s *Scheduler) Schedule(interval time.Duration, job worker.Job) {
+	name := jobName(job)
 	s.wg.Add(1)
 	go func() {
 		defer s.wg.Done()
@@ -37,14 +55,101 @@ func (s *Scheduler) Schedule(interval time.Duration, job worker.Job) {
 		for {
 			select {
 			case <-ticker.C:
-				// Attempt to enqueue job with context cancellation support
-				// If the pool is full and we are stopping, this will return quickly.
-				_ = s.workerPool.EnqueueContext(s.ctx, job)
+				// Instances tick out of phase, so the lease is held for most of
+				// the interval to stop a second instance running it in between
+				s.run(name, job, leaseTTL(interval))
+			case <-s.ctx.Done():
+				return
+			}
+		}
+	}()
+}
+
+// ScheduleCron registers a named job to run whenever the cron expression
+// matches (in UTC). Run times are computed from the wall clock, so the
+// schedule does not drift. If a run was missed while the process was down,
+// the job runs once immediately on startup.
+func (s *Scheduler) ScheduleCron(name, expr string, job worker.Job) error {
+	cron, err := ParseCron(expr)
+	if err != nil {
+		return err
+	}
+
+	log := logger.FromContext(s.ctx)
+	now := time.Now()
+	next := cron.Next(now)
+
+	var lastRun *time.Time
+	if state := s.loadState(name); state != nil && state.NextRunAt != nil && !state.NextRunAt.After(now) {
+		log.Info(LogMsgMissedRunCatchUp, "job", name, "missed_run_at", *state.NextRunAt)
+		if s.run(name, job, leaseTTL(next.Sub(now))) {
+			lastRun = &now
+		}
+	}
+
+	if next.IsZero() {
+		log.Warn(LogMsgCronNeverMatches, "job", name, "cron", expr)
+		return nil
+	}
+	s.saveState(name, &next, lastRun)
+
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		for {
+			timer := time.NewTimer(time.Until(next))
+			select {
+			case <-timer.C:
 			case <-s.ctx.Done():
+				timer.Stop()
+				return
+			}
+
+			ranAt := time.Now()
+			next = cron.Next(ranAt)
+			// Only the instance that ran the job records it
+			if s.run(name, job, leaseTTL(next.Sub(ranAt))) {
+				if next.IsZero() {
+					s.saveState(name, nil, &ranAt)
+				} else {
+					s.saveState(name, &next, &ranAt)
+				}
+			}
+			if next.IsZero() {
 				return
 			}
 		}
 	}()
+	return nil
+}
+
+// ScheduleAt registers a named job to run once at the given time. A time in
+// the past runs immediately, unless the repository shows the job already ran
+// for that time, so re-registering on every startup is safe.
+func (s *Scheduler) ScheduleAt(name string, at time.Time, job worker.Job) {
+	if state := s.loadState(name); state != nil && state.LastRunAt != nil && !state.LastRunAt.Before(at) {
+		logger.FromContext(s.ctx).Info(LogMsgOneShotAlreadyRan, "job", name, "last_run_at", *state.LastRunAt)
+		return
+	}
+	s.saveState(name, &at, nil)
+
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		timer := time.NewTimer(time.Until(at))
+		defer timer.Stop()
+
+		select {
+		case <-timer.C:
+		case <-s.ctx.Done():
+			return
+		}
+
+		ranAt := time.Now()
+		if s.run(name, job, OneShotLeaseTTL) {
+			s.saveState(name, nil, &ranAt)
+		}
+	}()
 }
 
 // Start starts the scheduler (noop for now as Schedule starts goroutines immediately)
@@ -59,3 +164,92 @@ func (s *Scheduler) Stop() {
 	s.cancel()
 	s.wg.Wait()
 }
+
+// run enqueues the job if this instance wins the job's lease. It returns
+// whether the job was enqueued.
+func (s *Scheduler) run(name string, job worker.Job, ttl time.Duration) bool {
+	log := logger.FromContext(s.ctx)
+
+	if s.repo != nil {
+		acquired, err := s.repo.TryAcquireLease(s.ctx, name, s.instanceID, ttl)
+		if err != nil {
+			// Skipping is safer than risking a duplicate run
+			if s.ctx.Err() == nil {
+				log.Error(LogMsgLeaseAcquireFailed, "job", name, "error", err)
+			}
+			return false
+		}
+		if !acquired {
+			log.Debug(LogMsgLeaseHeldElsewhere, "job", name)
+			return false
+		}
+	}
+
+	if err := s.workerPool.EnqueueContext(s.ctx, job); err != nil {
+		if s.ctx.Err() == nil {
+			log.Error(LogMsgScheduledJobEnqueue, "job", name, "error", err)
+		}
+		return false
+	}
+	return true
+}
+
+func (s *Scheduler) loadState(name string) *JobState {
+	if s.repo == nil {
+		return nil
+	}
+	state, err := s.repo.GetJobState(context.WithoutCancel(s.ctx), name)
+	if err != nil {
+		logger.FromContext(s.ctx).Error(LogMsgJobStateLoadFailed, "job", name, "error", err)
+		return nil
+	}
+	return state
+}
+
+// saveState records the next run and, when given, the last run. A nil lastRun
+// keeps the previously stored value.
+func (s *Scheduler) saveState(name string, next, lastRun *time.Time) {
+	if s.repo == nil {
+		return
+	}
+	state := JobState{Name: name, NextRunAt: next, LastRunAt: lastRun}
+	if lastRun == nil {
+		if prev := s.loadState(name); prev != nil {
+			state.LastRunAt = prev.LastRunAt
+		}
+	}
+	// A run that fired just before Stop should still be recorded
+	if err := s.repo.SaveJobState(context.WithoutCancel(s.ctx), state); err != nil {
+		logger.FromContext(s.ctx).Error(LogMsgJobStateSaveFailed, "job", name, "error", err)
+	}
+}
+
+// leaseTTL holds a lease for most of the gap until the next run, leaving room
+// for clock and ticker jitter between instances
+func leaseTTL(untilNext time.Duration) time.Duration {
+	ttl := untilNext * 9 / 10
+	if ttl < MinLeaseTTL {
+		return MinLeaseTTL
+	}
+	return ttl
+}
+
+// jobName identifies an interval job for leasing by its job type, falling
+// back to its Go type
+func jobName(job worker.Job) string {
+	if typed, ok := job.(worker.TypedJob); ok {
+		return typed.JobType()
+	}
+	return fmt.Sprintf("%T", job)
+}
+
+// newInstanceID returns a lease holder ID unique to this process
+func newInstanceID() string {
+	host, err := os.Hostname()
+	if err != nil {
+		host = "unknown"
+	}
+	suffix := make([]byte, 4)
+	_, _ = rand.Read(suffix)
+	return fmt.Sprintf("%s:%d:%s", host, os.Getpid(), hex.EncodeToString(suffix))
+}
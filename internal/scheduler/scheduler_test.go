@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/osse101/BrandishBot_Go/internal/worker"
 )
@@ -123,3 +124,207 @@ func TestScheduler_StopWhileBlocked(t *testing.T) {
 	// Wait for stop to complete
 	<-done
 }
+
+// memoryRepository is an in-memory Repository for testing
+type memoryRepository struct {
+	mu     sync.Mutex
+	states map[string]JobState
+	leases map[string]memoryLease
+}
+
+type memoryLease struct {
+	holder    string
+	expiresAt time.Time
+}
+
+func newMemoryRepository() *memoryRepository {
+	return &memoryRepository{
+		states: make(map[string]JobState),
+		leases: make(map[string]memoryLease),
+	}
+}
+
+func (r *memoryRepository) GetJobState(ctx context.Context, name string) (*JobState, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	state, ok := r.states[name]
+	if !ok {
+		return nil, nil
+	}
+	return &state, nil
+}
+
+func (r *memoryRepository) SaveJobState(ctx context.Context, state JobState) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.states[state.Name] = state
+	return nil
+}
+
+func (r *memoryRepository) TryAcquireLease(ctx context.Context, name, holder string, ttl time.Duration) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	now := time.Now()
+	if lease, ok := r.leases[name]; ok && lease.holder != holder && lease.expiresAt.After(now) {
+		return false, nil
+	}
+	r.leases[name] = memoryLease{holder: holder, expiresAt: now.Add(ttl)}
+	return true, nil
+}
+
+func (r *memoryRepository) get(name string) JobState {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.states[name]
+}
+
+func waitForRun(t *testing.T, job *MockJob) {
+	t.Helper()
+	select {
+	case <-job.Done:
+	case <-time.After(time.Second):
+		t.Fatal("Timeout waiting for job execution")
+	}
+}
+
+func TestScheduler_ScheduleCron(t *testing.T) {
+	pool := worker.NewPool(1, 10)
+	pool.Start()
+	defer pool.Stop()
+
+	t.Run("Invalid expression", func(t *testing.T) {
+		sched := New(pool)
+		defer sched.Stop()
+
+		err := sched.ScheduleCron("bad", "not a cron", &MockJob{})
+		assert.ErrorIs(t, err, ErrInvalidCron)
+	})
+
+	t.Run("Persists next run", func(t *testing.T) {
+		repo := newMemoryRepository()
+		sched := NewWithRepository(pool, repo)
+		defer sched.Stop()
+
+		job := &MockJob{Done: make(chan struct{}, 1)}
+		require.NoError(t, sched.ScheduleCron("daily", "0 4 * * *", job))
+
+		state := repo.get("daily")
+		require.NotNil(t, state.NextRunAt)
+		assert.Equal(t, 4, state.NextRunAt.Hour())
+		assert.True(t, state.NextRunAt.After(time.Now()))
+		assert.Nil(t, state.LastRunAt)
+	})
+
+	t.Run("Catches up missed run", func(t *testing.T) {
+		repo := newMemoryRepository()
+		missed := time.Now().Add(-time.Hour)
+		repo.states["daily"] = JobState{Name: "daily", NextRunAt: &missed}
+
+		sched := NewWithRepository(pool, repo)
+		defer sched.Stop()
+
+		job := &MockJob{Done: make(chan struct{}, 1)}
+		require.NoError(t, sched.ScheduleCron("daily", "0 4 * * *", job))
+		waitForRun(t, job)
+
+		state := repo.get("daily")
+		require.NotNil(t, state.LastRunAt)
+		require.NotNil(t, state.NextRunAt)
+		assert.True(t, state.NextRunAt.After(time.Now()))
+	})
+}
+
+func TestScheduler_ScheduleAt(t *testing.T) {
+	pool := worker.NewPool(1, 10)
+	pool.Start()
+	defer pool.Stop()
+
+	t.Run("Runs once and records it", func(t *testing.T) {
+		repo := newMemoryRepository()
+		sched := NewWithRepository(pool, repo)
+		defer sched.Stop()
+
+		job := &MockJob{Done: make(chan struct{}, 1)}
+		sched.ScheduleAt("one-shot", time.Now().Add(10*time.Millisecond), job)
+		waitForRun(t, job)
+
+		assert.Eventually(t, func() bool {
+			state := repo.get("one-shot")
+			return state.LastRunAt != nil && state.NextRunAt == nil
+		}, time.Second, 5*time.Millisecond)
+	})
+
+	t.Run("Skips job that already ran", func(t *testing.T) {
+		repo := newMemoryRepository()
+		at := time.Now().Add(-time.Minute)
+		ranAt := at.Add(time.Second)
+		repo.states["one-shot"] = JobState{Name: "one-shot", LastRunAt: &ranAt}
+
+		sched := NewWithRepository(pool, repo)
+		job := &MockJob{Done: make(chan struct{}, 1)}
+		sched.ScheduleAt("one-shot", at, job)
+		sched.Stop()
+
+		job.mu.Lock()
+		defer job.mu.Unlock()
+		assert.Equal(t, 0, job.RunCount)
+	})
+}
+
+func TestScheduler_LeasesAcrossInstances(t *testing.T) {
+	pool := worker.NewPool(2, 10)
+	pool.Start()
+	defer pool.Stop()
+
+	t.Run("Interval job runs on one instance", func(t *testing.T) {
+		repo := newMemoryRepository()
+		job := &MockJob{Done: make(chan struct{}, 10)}
+
+		first := NewWithRepository(pool, repo)
+		second := NewWithRepository(pool, repo)
+		first.Schedule(50*time.Millisecond, job)
+		second.Schedule(50*time.Millisecond, job)
+
+		time.Sleep(120 * time.Millisecond)
+		first.Stop()
+		second.Stop()
+
+		job.mu.Lock()
+		defer job.mu.Unlock()
+		// Two ticks per instance; only the lease holder's ticks run
+		assert.Equal(t, 2, job.RunCount)
+	})
+
+	t.Run("Missed cron run is caught up once", func(t *testing.T) {
+		repo := newMemoryRepository()
+		missed := time.Now().Add(-time.Hour)
+		repo.states["daily"] = JobState{Name: "daily", NextRunAt: &missed}
+
+		job := &MockJob{Done: make(chan struct{}, 10)}
+		first := NewWithRepository(pool, repo)
+		second := NewWithRepository(pool, repo)
+		require.NoError(t, first.ScheduleCron("daily", "0 4 * * *", job))
+		require.NoError(t, second.ScheduleCron("daily", "0 4 * * *", job))
+		waitForRun(t, job)
+		first.Stop()
+		second.Stop()
+
+		job.mu.Lock()
+		defer job.mu.Unlock()
+		assert.Equal(t, 1, job.RunCount)
+	})
+
+	t.Run("Expired lease is taken over", func(t *testing.T) {
+		repo := newMemoryRepository()
+		ok, err := repo.TryAcquireLease(context.Background(), "job", "dead-instance", time.Millisecond)
+		require.NoError(t, err)
+		require.True(t, ok)
+		time.Sleep(5 * time.Millisecond)
+
+		sched := NewWithRepository(pool, repo)
+		defer sched.Stop()
+		job := &MockJob{Done: make(chan struct{}, 1)}
+		assert.True(t, sched.run("job", job, time.Minute))
+		waitForRun(t, job)
+	})
+}
@@ -0,0 +1,235 @@
+package search
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/logger"
+)
+
+// Difficulty limits keep a mistyped curve from making searches free or impossible
+const (
+	maxDifficultyWindowMinutes   = 24 * 60
+	maxDifficultySuccessModifier = 0.5
+	maxDifficultyQualityModifier = 3
+
+	// difficultyCountTTL is how long the recent searcher count is reused
+	// before it is read again
+	difficultyCountTTL = 30 * time.Second
+)
+
+// ErrDifficultyNotConfigured is returned when population-based difficulty is not set up
+var ErrDifficultyNotConfigured = errors.New("search difficulty is not configured")
+
+// DifficultyTier adjusts searches while at least MinSearchers users have
+// searched within the window.
+type DifficultyTier struct {
+	MinSearchers    int     `json:"min_searchers"`
+	SuccessModifier float64 `json:"success_modifier"` // Added to the search success chance
+	QualityModifier int     `json:"quality_modifier"` // Quality levels added to search rewards
+}
+
+// DifficultyConfig is the top-level JSON structure for search_difficulty.json.
+// Tiers form a step curve: the tier with the highest MinSearchers not above
+// the current count applies.
+type DifficultyConfig struct {
+	Version       string           `json:"version,omitempty"`
+	Enabled       bool             `json:"enabled"`
+	WindowMinutes int              `json:"window_minutes"`
+	Tiers         []DifficultyTier `json:"tiers"`
+}
+
+// Validate checks the window and that tiers start at 0 searchers, rise
+// strictly and stay within the modifier limits.
+func (c DifficultyConfig) Validate() error {
+	if c.WindowMinutes < 1 || c.WindowMinutes > maxDifficultyWindowMinutes {
+		return fmt.Errorf("window_minutes must be between 1 and %d", maxDifficultyWindowMinutes)
+	}
+	if len(c.Tiers) == 0 {
+		return fmt.Errorf("at least one tier is required")
+	}
+	if c.Tiers[0].MinSearchers != 0 {
+		return fmt.Errorf("the first tier must start at 0 searchers")
+	}
+	for i, tier := range c.Tiers {
+		if i > 0 && tier.MinSearchers <= c.Tiers[i-1].MinSearchers {
+			return fmt.Errorf("tier %d: min_searchers must be greater than the previous tier's", i)
+		}
+		if tier.SuccessModifier < -maxDifficultySuccessModifier || tier.SuccessModifier > maxDifficultySuccessModifier {
+			return fmt.Errorf("tier %d: success_modifier must be between -%g and %g", i, maxDifficultySuccessModifier, maxDifficultySuccessModifier)
+		}
+		if tier.QualityModifier < -maxDifficultyQualityModifier || tier.QualityModifier > maxDifficultyQualityModifier {
+			return fmt.Errorf("tier %d: quality_modifier must be between -%d and %d", i, maxDifficultyQualityModifier, maxDifficultyQualityModifier)
+		}
+	}
+	return nil
+}
+
+// TierFor returns the tier that applies with the given number of recent searchers
+func (c DifficultyConfig) TierFor(searchers int) DifficultyTier {
+	var tier DifficultyTier
+	for _, t := range c.Tiers {
+		if searchers < t.MinSearchers {
+			break
+		}
+		tier = t
+	}
+	return tier
+}
+
+// LoadDifficultyConfig reads and validates the search difficulty config file.
+func LoadDifficultyConfig(path string) (*DifficultyConfig, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read search difficulty config: %w", err)
+	}
+
+	var config DifficultyConfig
+	if err := json.Unmarshal(data, &config); err != nil {
+		return nil, fmt.Errorf("failed to parse search difficulty config: %w", err)
+	}
+
+	if err := config.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid search difficulty config: %w", err)
+	}
+
+	return &config, nil
+}
+
+// DifficultyStatus is the current difficulty as shown to admins
+type DifficultyStatus struct {
+	DifficultyConfig
+	RecentSearchers int            `json:"recent_searchers"`
+	ActiveTier      DifficultyTier `json:"active_tier"`
+}
+
+// SearcherCounter counts users who searched recently
+type SearcherCounter interface {
+	CountRecentSearchers(ctx context.Context, since time.Time) (int, error)
+}
+
+// Difficulty scales search success and reward quality with how many users
+// searched recently: harder during raids, easier during quiet hours.
+type Difficulty struct {
+	path    string
+	counter SearcherCounter
+	now     func() time.Time
+
+	mu       sync.Mutex
+	config   DifficultyConfig
+	count    int
+	countAt  time.Time
+	hasCount bool
+}
+
+// NewDifficulty loads the difficulty curve from path
+func NewDifficulty(path string, counter SearcherCounter) (*Difficulty, error) {
+	config, err := LoadDifficultyConfig(path)
+	if err != nil {
+		return nil, err
+	}
+	return &Difficulty{
+		path:    path,
+		counter: counter,
+		now:     time.Now,
+		config:  *config,
+	}, nil
+}
+
+// Reload re-reads the curve from its file, keeping the current one if the file is invalid
+func (d *Difficulty) Reload(ctx context.Context) error {
+	config, err := LoadDifficultyConfig(d.path)
+	if err != nil {
+		return err
+	}
+	d.setConfig(*config)
+	return nil
+}
+
+// SetConfig replaces the curve until the next restart or reload
+func (d *Difficulty) SetConfig(config DifficultyConfig) error {
+	if err := config.Validate(); err != nil {
+		return fmt.Errorf("%w: %w", domain.ErrInvalidInput, err)
+	}
+	d.setConfig(config)
+	return nil
+}
+
+func (d *Difficulty) setConfig(config DifficultyConfig) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if config.WindowMinutes != d.config.WindowMinutes {
+		d.hasCount = false // The cached count was taken over the old window
+	}
+	d.config = config
+}
+
+// Current returns the tier that applies now. A failed count applies no
+// adjustment, so searches are never blocked by it.
+func (d *Difficulty) Current(ctx context.Context) DifficultyTier {
+	status, err := d.status(ctx, false)
+	if err != nil {
+		logger.FromContext(ctx).Warn("Failed to count recent searchers for difficulty", "error", err)
+		return DifficultyTier{}
+	}
+	return status.ActiveTier
+}
+
+// Status reads a fresh searcher count and reports the curve and the tier it selects
+func (d *Difficulty) Status(ctx context.Context) (*DifficultyStatus, error) {
+	return d.status(ctx, true)
+}
+
+func (d *Difficulty) status(ctx context.Context, fresh bool) (*DifficultyStatus, error) {
+	d.mu.Lock()
+	config := d.config
+	count, cached := d.count, d.hasCount && !fresh && d.now().Sub(d.countAt) < difficultyCountTTL
+	d.mu.Unlock()
+
+	status := &DifficultyStatus{DifficultyConfig: config}
+	if !config.Enabled {
+		return status, nil
+	}
+
+	if !cached {
+		now := d.now()
+		var err error
+		count, err = d.counter.CountRecentSearchers(ctx, now.Add(-time.Duration(config.WindowMinutes)*time.Minute))
+		if err != nil {
+			return nil, err
+		}
+		d.mu.Lock()
+		d.count, d.countAt, d.hasCount = count, now, true
+		d.mu.Unlock()
+	}
+
+	status.RecentSearchers = count
+	status.ActiveTier = config.TierFor(count)
+	return status, nil
+}
+
+// GetDifficulty reports the recent searcher count and the difficulty tier it selects
+func (s *service) GetDifficulty(ctx context.Context) (*DifficultyStatus, error) {
+	if s.deps.Difficulty == nil {
+		return nil, ErrDifficultyNotConfigured
+	}
+	return s.deps.Difficulty.Status(ctx)
+}
+
+// SetDifficulty replaces the difficulty curve and reports the resulting difficulty
+func (s *service) SetDifficulty(ctx context.Context, config DifficultyConfig) (*DifficultyStatus, error) {
+	if s.deps.Difficulty == nil {
+		return nil, ErrDifficultyNotConfigured
+	}
+	if err := s.deps.Difficulty.SetConfig(config); err != nil {
+		return nil, err
+	}
+	logger.FromContext(ctx).Info("Search difficulty curve updated", "enabled", config.Enabled, "window_minutes", config.WindowMinutes, "tiers", len(config.Tiers))
+	return s.deps.Difficulty.Status(ctx)
+}
@@ -0,0 +1,175 @@
+package search
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+)
+
+// fakeSearcherCounter returns a fixed count and records how often it was asked
+type fakeSearcherCounter struct {
+	count int
+	err   error
+	calls int
+	since time.Time
+}
+
+func (f *fakeSearcherCounter) CountRecentSearchers(ctx context.Context, since time.Time) (int, error) {
+	f.calls++
+	f.since = since
+	return f.count, f.err
+}
+
+func testDifficultyConfig() DifficultyConfig {
+	return DifficultyConfig{
+		Enabled:       true,
+		WindowMinutes: 60,
+		Tiers: []DifficultyTier{
+			{MinSearchers: 0, SuccessModifier: 0.1, QualityModifier: 1},
+			{MinSearchers: 5},
+			{MinSearchers: 20, SuccessModifier: -0.4, QualityModifier: -1},
+		},
+	}
+}
+
+func newTestDifficulty(counter SearcherCounter, now time.Time) *Difficulty {
+	return &Difficulty{counter: counter, now: func() time.Time { return now }, config: testDifficultyConfig()}
+}
+
+func TestDifficultyConfig_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		modify  func(*DifficultyConfig)
+		wantErr bool
+	}{
+		{"valid", func(c *DifficultyConfig) {}, false},
+		{"zero window", func(c *DifficultyConfig) { c.WindowMinutes = 0 }, true},
+		{"window over a day", func(c *DifficultyConfig) { c.WindowMinutes = 24*60 + 1 }, true},
+		{"no tiers", func(c *DifficultyConfig) { c.Tiers = nil }, true},
+		{"first tier above 0", func(c *DifficultyConfig) { c.Tiers[0].MinSearchers = 1 }, true},
+		{"tiers out of order", func(c *DifficultyConfig) { c.Tiers[2].MinSearchers = 5 }, true},
+		{"success modifier too large", func(c *DifficultyConfig) { c.Tiers[1].SuccessModifier = 0.6 }, true},
+		{"quality modifier too small", func(c *DifficultyConfig) { c.Tiers[1].QualityModifier = -4 }, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := testDifficultyConfig()
+			tt.modify(&config)
+			if tt.wantErr {
+				assert.Error(t, config.Validate())
+			} else {
+				assert.NoError(t, config.Validate())
+			}
+		})
+	}
+}
+
+func TestDifficultyConfig_TierFor(t *testing.T) {
+	config := testDifficultyConfig()
+
+	assert.Equal(t, 1, config.TierFor(0).QualityModifier, "quiet hours")
+	assert.Equal(t, 1, config.TierFor(4).QualityModifier)
+	assert.Equal(t, 5, config.TierFor(5).MinSearchers)
+	assert.Equal(t, 20, config.TierFor(500).MinSearchers, "raid")
+}
+
+func TestLoadDifficultyConfig_Shipped(t *testing.T) {
+	config, err := LoadDifficultyConfig("../../configs/search_difficulty.json")
+	require.NoError(t, err)
+	assert.True(t, config.Enabled)
+}
+
+func TestDifficulty_Current(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	t.Run("counts over the window and reuses the count briefly", func(t *testing.T) {
+		counter := &fakeSearcherCounter{count: 25}
+		d := newTestDifficulty(counter, now)
+
+		assert.Equal(t, -1, d.Current(context.Background()).QualityModifier)
+		assert.Equal(t, now.Add(-time.Hour), counter.since)
+		d.Current(context.Background())
+		assert.Equal(t, 1, counter.calls, "second lookup should use the cached count")
+
+		d.now = func() time.Time { return now.Add(difficultyCountTTL) }
+		d.Current(context.Background())
+		assert.Equal(t, 2, counter.calls, "an expired count is read again")
+	})
+
+	t.Run("status always reads a fresh count", func(t *testing.T) {
+		counter := &fakeSearcherCounter{count: 2}
+		d := newTestDifficulty(counter, now)
+		d.Current(context.Background())
+
+		status, err := d.Status(context.Background())
+
+		require.NoError(t, err)
+		assert.Equal(t, 2, counter.calls)
+		assert.Equal(t, 2, status.RecentSearchers)
+		assert.Equal(t, 0, status.ActiveTier.MinSearchers)
+	})
+
+	t.Run("a failed count applies no adjustment", func(t *testing.T) {
+		d := newTestDifficulty(&fakeSearcherCounter{err: errors.New("db down")}, now)
+
+		assert.Equal(t, DifficultyTier{}, d.Current(context.Background()))
+	})
+
+	t.Run("disabled skips the count", func(t *testing.T) {
+		counter := &fakeSearcherCounter{count: 100}
+		d := newTestDifficulty(counter, now)
+		d.config.Enabled = false
+
+		assert.Equal(t, DifficultyTier{}, d.Current(context.Background()))
+		assert.Zero(t, counter.calls)
+	})
+
+	t.Run("rejects an invalid curve", func(t *testing.T) {
+		d := newTestDifficulty(&fakeSearcherCounter{}, now)
+		config := testDifficultyConfig()
+		config.Tiers = nil
+
+		assert.ErrorIs(t, d.SetConfig(config), domain.ErrInvalidInput)
+		assert.Len(t, d.config.Tiers, 3, "the previous curve is kept")
+	})
+}
+
+func TestHandleSearch_Difficulty(t *testing.T) {
+	t.Parallel()
+
+	t.Run("a raid lowers the success chance", func(t *testing.T) {
+		svc, repo := createSearchTestService()
+		user := createTestUser()
+		repo.users[TestUsername] = user
+		svc.deps.Difficulty = newTestDifficulty(&fakeSearcherCounter{count: 30}, time.Now())
+		svc.deps.Rnd = func() float64 { return 0.5 } // Succeeds at the base rate, fails at 0.4
+
+		_, err := svc.HandleSearch(context.Background(), domain.PlatformTwitch, "testuser123", TestUsername, "")
+
+		require.NoError(t, err)
+		inv, _ := repo.GetInventory(context.Background(), user.ID)
+		assert.Empty(t, inv.Slots)
+	})
+
+	t.Run("quiet hours raise the reward quality", func(t *testing.T) {
+		svc, repo := createSearchTestService()
+		user := createTestUser()
+		repo.users[TestUsername] = user
+		svc.deps.Difficulty = newTestDifficulty(&fakeSearcherCounter{count: 0}, time.Now())
+		svc.deps.Rnd = func() float64 { return 0.5 }
+
+		_, err := svc.HandleSearch(context.Background(), domain.PlatformTwitch, "testuser123", TestUsername, "")
+
+		require.NoError(t, err)
+		inv, _ := repo.GetInventory(context.Background(), user.ID)
+		require.Len(t, inv.Slots, 1)
+		assert.Equal(t, domain.QualityRare, inv.Slots[0].QualityLevel, "Base Uncommon + 1")
+	})
+}
@@ -9,7 +9,6 @@ import (
 
 	"github.com/osse101/BrandishBot_Go/internal/domain"
 	"github.com/osse101/BrandishBot_Go/internal/logger"
-	"github.com/osse101/BrandishBot_Go/internal/utils"
 )
 
 // searchFailureType categorizes the type of search failure
@@ -49,6 +48,7 @@ func (s *service) calculateSearchQuality(ctx context.Context, userID string, isC
 	if params.streak > 0 && params.streak%5 == 0 {
 		points += 1
 	}
+	points += params.qualityModifier
 
 	if s.deps.JobSvc != nil {
 		explorerLevel, err := s.deps.JobSvc.GetJobLevel(ctx, userID, "job_explorer")
@@ -118,21 +118,26 @@ func determineSearchFailureType(roll, successThreshold float64) searchFailureTyp
 	return searchFailureNormal
 }
 
+// rollIndex maps a roll of rnd onto [0, n)
+func rollIndex(rnd func() float64, n int) int {
+	return min(int(rnd()*float64(n)), n-1)
+}
+
 // formatSearchFailureMessage builds the failure message based on failure type.
-func formatSearchFailureMessage(failureType searchFailureType) string {
+func formatSearchFailureMessage(failureType searchFailureType, rnd func() float64) string {
 	switch failureType {
 	case searchFailureNearMiss:
 		return domain.MsgSearchNearMiss
 	case searchFailureCritical:
 		resultMessage := domain.MsgSearchCriticalFail
 		if len(domain.SearchCriticalFailMessages) > 0 {
-			idx := utils.SecureRandomIntRange(0, len(domain.SearchCriticalFailMessages)-1)
+			idx := rollIndex(rnd, len(domain.SearchCriticalFailMessages))
 			resultMessage = fmt.Sprintf("%s %s", domain.MsgSearchCriticalFail, domain.SearchCriticalFailMessages[idx])
 		}
 		return resultMessage
 	case searchFailureNormal:
 		if len(domain.SearchFailureMessages) > 0 {
-			idx := utils.SecureRandomIntRange(0, len(domain.SearchFailureMessages)-1)
+			idx := rollIndex(rnd, len(domain.SearchFailureMessages))
 			return domain.SearchFailureMessages[idx]
 		}
 		return domain.MsgSearchNothingFound
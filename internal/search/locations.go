@@ -0,0 +1,153 @@
+package search
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"golang.org/x/text/cases"
+	"golang.org/x/text/language"
+
+	"github.com/osse101/BrandishBot_Go/internal/cooldown"
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/logger"
+)
+
+// LocationStatus describes a search region from one user's point of view.
+type LocationStatus struct {
+	Key                   string `json:"key"`
+	Name                  string `json:"name"`
+	Description           string `json:"description,omitempty"`
+	RequiredExplorerLevel int    `json:"required_explorer_level"`
+	RequiredNode          string `json:"required_node,omitempty"`
+	Destination           bool   `json:"destination"` // Only searched when chosen explicitly
+	Unlocked              bool   `json:"unlocked"`
+	LockedReason          string `json:"locked_reason,omitempty"`
+	CooldownMinutes       int    `json:"cooldown_minutes,omitempty"` // Set when the region has its own cooldown
+	ReadyInSeconds        int    `json:"ready_in_seconds"`           // 0 when the region can be searched now
+	HasRareEvents         bool   `json:"has_rare_events"`
+}
+
+// SearchLocation searches a region chosen by key.
+func (s *service) SearchLocation(ctx context.Context, platform, platformID, username, location string) (string, error) {
+	log := logger.FromContext(ctx)
+	log.Info("SearchLocation called", "platform", platform, "platformID", platformID, "username", username, "location", location)
+
+	user, err := s.resolveUser(ctx, platform, platformID, username)
+	if err != nil {
+		return "", err
+	}
+
+	region := findRegion(s.deps.Regions, location)
+	if region == nil {
+		return "", fmt.Errorf("%w: %s", domain.ErrUnknownSearchLocation, location)
+	}
+
+	if reason, err := s.lockedReason(ctx, user.ID, region); err != nil {
+		return "", err
+	} else if reason != "" {
+		return "", fmt.Errorf("%w: %s", domain.ErrSearchLocationLocked, reason)
+	}
+
+	var resultMessage string
+	err = s.deps.CooldownSvc.EnforceCooldown(ctx, user.ID, region.CooldownAction(), func() error {
+		var err error
+		resultMessage, err = s.executeSearch(ctx, user, "", region)
+		return err
+	})
+	if err != nil {
+		// Name the region instead of the internal cooldown action
+		var cooldownErr cooldown.ErrOnCooldown
+		if region.CooldownMinutes > 0 && errors.As(err, &cooldownErr) {
+			cooldownErr.Action = domain.ActionSearch + " " + region.Name
+			return "", cooldownErr
+		}
+		return "", err
+	}
+
+	log.Info("Location search completed", "username", username, "location", region.Key, "result", resultMessage)
+	return resultMessage, nil
+}
+
+// GetLocations lists every region with the user's access and cooldown state.
+func (s *service) GetLocations(ctx context.Context, platform, platformID, username string) ([]LocationStatus, error) {
+	user, err := s.resolveUser(ctx, platform, platformID, username)
+	if err != nil {
+		return nil, err
+	}
+
+	locations := make([]LocationStatus, 0, len(s.deps.Regions))
+	for i := range s.deps.Regions {
+		region := &s.deps.Regions[i]
+		reason, err := s.lockedReason(ctx, user.ID, region)
+		if err != nil {
+			return nil, err
+		}
+
+		status := LocationStatus{
+			Key:                   region.Key,
+			Name:                  region.Name,
+			Description:           region.Description,
+			RequiredExplorerLevel: region.RequiredExplorerLevel,
+			RequiredNode:          region.RequiredNode,
+			Destination:           region.IsDestination(),
+			Unlocked:              reason == "",
+			LockedReason:          reason,
+			CooldownMinutes:       region.CooldownMinutes,
+			HasRareEvents:         len(region.RareEvents) > 0,
+		}
+
+		onCooldown, remaining, err := s.deps.CooldownSvc.CheckCooldown(ctx, user.ID, region.CooldownAction())
+		if err != nil {
+			return nil, fmt.Errorf("failed to check cooldown for %s: %w", region.Key, err)
+		}
+		if onCooldown {
+			status.ReadyInSeconds = int(remaining.Seconds()) + 1
+		}
+
+		locations = append(locations, status)
+	}
+	return locations, nil
+}
+
+// lockedReason explains why the user can't search a region yet, or returns "" if they can.
+func (s *service) lockedReason(ctx context.Context, userID string, region *Region) (string, error) {
+	if region.RequiredNode != "" {
+		if s.deps.ProgressionSvc == nil {
+			return fmt.Sprintf("%s is not available", region.Name), nil
+		}
+		unlocked, err := s.deps.ProgressionSvc.IsNodeUnlocked(ctx, region.RequiredNode, 1)
+		if err != nil {
+			return "", fmt.Errorf("failed to check unlock for %s: %w", region.Key, err)
+		}
+		if !unlocked {
+			return fmt.Sprintf("%s requires %s to be unlocked in the progression tree", region.Name, region.RequiredNode), nil
+		}
+	}
+
+	if region.RequiredExplorerLevel > 0 {
+		if level := s.explorerLevel(ctx, userID); level < region.RequiredExplorerLevel {
+			return fmt.Sprintf("%s requires Explorer level %d (currently %d)", region.Name, region.RequiredExplorerLevel, level), nil
+		}
+	}
+	return "", nil
+}
+
+// grantRareEvent gives the user a rare event's item and returns the message to append.
+func (s *service) grantRareEvent(ctx context.Context, user *domain.User, rare *RareEvent) (string, error) {
+	item, err := s.deps.ItemLookup.GetItemByName(ctx, rare.ItemName)
+	if err != nil {
+		return "", fmt.Errorf("failed to get rare event item: %w", err)
+	}
+	if item == nil {
+		return "", domain.ErrItemNotFound
+	}
+
+	if err := s.deps.RewardGranter.GrantItemReward(ctx, user, item, rare.Quantity, domain.QualityRare); err != nil {
+		return "", err
+	}
+
+	logger.FromContext(ctx).Info("Rare search event triggered", "event", rare.Key, "item", rare.ItemName, "quantity", rare.Quantity)
+	displayName := cases.Title(language.English).String(item.PublicName)
+	return fmt.Sprintf("✨ %s (+%d %s)", rare.Message, rare.Quantity, displayName), nil
+}
@@ -0,0 +1,198 @@
+package search
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/osse101/BrandishBot_Go/internal/cooldown"
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+)
+
+const (
+	testVaultNode = "feature_vault"
+	testVaultKey  = "vault"
+)
+
+var testRegions = []Region{
+	{Key: "clearing", Name: "The Clearing"},
+	{Key: "meadow", Name: "Meadow", RequiredExplorerLevel: 5},
+	{
+		Key:             testVaultKey,
+		Name:            "Sunken Vault",
+		RequiredNode:    testVaultNode,
+		CooldownMinutes: 90,
+		RareEvents: []RareEvent{
+			{Key: "hoard", Message: "You find the hoard!", Chance: 0.6, ItemName: "item_gem", Quantity: 2},
+		},
+	},
+}
+
+func createLocationTestService(t *testing.T, explorerLevel int) (*service, *mockSearchRepo, *MockProgressionService) {
+	t.Helper()
+	jobSvc := newMockJobService()
+	jobSvc.jobLevels[domain.JobKeyExplorer] = explorerLevel
+	svc, repo := createSearchTestService(func(opts *searchTestServiceOpts) {
+		opts.jobService = jobSvc
+	})
+
+	prog := new(MockProgressionService)
+	prog.On("GetModifiedValue", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(0.0, assert.AnError).Maybe()
+	svc.deps.ProgressionSvc = prog
+	svc.deps.Regions = append([]Region(nil), testRegions...)
+	svc.deps.Rnd = func() float64 { return 0.5 } // Successful search, and triggers the vault's rare event
+
+	repo.users[TestUsername] = createTestUser()
+	repo.items["item_gem"] = &domain.Item{ID: 7, InternalName: "item_gem", PublicName: "gem"}
+	return svc, repo, prog
+}
+
+func TestSearchLocation(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("searches a destination on its own cooldown and rolls rare events", func(t *testing.T) {
+		svc, repo, prog := createLocationTestService(t, 0)
+		prog.On("IsNodeUnlocked", ctx, testVaultNode, 1).Return(true, nil)
+
+		msg, err := svc.SearchLocation(ctx, domain.PlatformTwitch, "testuser123", TestUsername, "Vault")
+
+		require.NoError(t, err)
+		assert.Contains(t, msg, "[Sunken Vault]")
+		assert.Contains(t, msg, "✨ You find the hoard! (+2 Gem)")
+
+		own, _ := repo.GetLastCooldown(ctx, TestUserID, domain.SearchLocationActionPrefix+testVaultKey)
+		shared, _ := repo.GetLastCooldown(ctx, TestUserID, domain.ActionSearch)
+		assert.NotNil(t, own, "destination search should use its own cooldown")
+		assert.Nil(t, shared, "destination search should not consume the regular search cooldown")
+
+		inv, _ := repo.GetInventory(ctx, TestUserID)
+		var gems int
+		for _, slot := range inv.Slots {
+			if slot.ItemID == 7 {
+				gems += slot.Quantity
+			}
+		}
+		assert.Equal(t, 2, gems)
+	})
+
+	t.Run("cooldown error names the location", func(t *testing.T) {
+		svc, repo, prog := createLocationTestService(t, 0)
+		prog.On("IsNodeUnlocked", ctx, testVaultNode, 1).Return(true, nil)
+		_ = repo.UpdateCooldown(ctx, TestUserID, domain.SearchLocationActionPrefix+testVaultKey, time.Now())
+
+		_, err := svc.SearchLocation(ctx, domain.PlatformTwitch, "testuser123", TestUsername, testVaultKey)
+
+		var cooldownErr cooldown.ErrOnCooldown
+		require.ErrorAs(t, err, &cooldownErr)
+		assert.Equal(t, "search Sunken Vault", cooldownErr.Action)
+		assert.ErrorIs(t, err, domain.ErrOnCooldown)
+	})
+
+	t.Run("locked by progression node", func(t *testing.T) {
+		svc, _, prog := createLocationTestService(t, 0)
+		prog.On("IsNodeUnlocked", ctx, testVaultNode, 1).Return(false, nil)
+
+		_, err := svc.SearchLocation(ctx, domain.PlatformTwitch, "testuser123", TestUsername, testVaultKey)
+
+		assert.ErrorIs(t, err, domain.ErrSearchLocationLocked)
+		assert.Contains(t, err.Error(), testVaultNode)
+	})
+
+	t.Run("locked by explorer level", func(t *testing.T) {
+		svc, _, _ := createLocationTestService(t, 2)
+
+		_, err := svc.SearchLocation(ctx, domain.PlatformTwitch, "testuser123", TestUsername, "meadow")
+
+		assert.ErrorIs(t, err, domain.ErrSearchLocationLocked)
+		assert.Contains(t, err.Error(), "Explorer level 5 (currently 2)")
+	})
+
+	t.Run("unknown location", func(t *testing.T) {
+		svc, _, _ := createLocationTestService(t, 0)
+
+		_, err := svc.SearchLocation(ctx, domain.PlatformTwitch, "testuser123", TestUsername, "moon")
+
+		assert.ErrorIs(t, err, domain.ErrUnknownSearchLocation)
+	})
+}
+
+func TestHandleSearch_SkipsDestinations(t *testing.T) {
+	ctx := context.Background()
+	// Explorer level 50 qualifies for every region, but destinations need to be chosen
+	svc, repo, _ := createLocationTestService(t, 50)
+
+	msg, err := svc.HandleSearch(ctx, domain.PlatformTwitch, "testuser123", TestUsername, "")
+
+	require.NoError(t, err)
+	assert.Contains(t, msg, "[Meadow]")
+	assert.NotContains(t, msg, "hoard")
+	shared, _ := repo.GetLastCooldown(ctx, TestUserID, domain.ActionSearch)
+	assert.NotNil(t, shared)
+}
+
+func TestGetLocations(t *testing.T) {
+	ctx := context.Background()
+	svc, repo, prog := createLocationTestService(t, 5)
+	prog.On("IsNodeUnlocked", ctx, testVaultNode, 1).Return(false, nil)
+	_ = repo.UpdateCooldown(ctx, TestUserID, domain.ActionSearch, time.Now())
+
+	locations, err := svc.GetLocations(ctx, domain.PlatformTwitch, "testuser123", TestUsername)
+
+	require.NoError(t, err)
+	require.Len(t, locations, 3)
+	assert.True(t, locations[1].Unlocked)
+	assert.Positive(t, locations[1].ReadyInSeconds, "open regions share the regular search cooldown")
+	vault := locations[2]
+	assert.False(t, vault.Unlocked)
+	assert.True(t, vault.Destination)
+	assert.True(t, vault.HasRareEvents)
+	assert.Equal(t, 90, vault.CooldownMinutes)
+	assert.Zero(t, vault.ReadyInSeconds)
+}
+
+func TestLoadSearchRegions_ShippedConfig(t *testing.T) {
+	regions, err := LoadSearchRegions("../../configs/search_regions.json")
+	require.NoError(t, err)
+
+	cooldowns := LocationCooldowns(regions)
+	for _, r := range regions {
+		if r.CooldownMinutes > 0 {
+			assert.Equal(t, time.Duration(r.CooldownMinutes)*time.Minute, cooldowns[r.CooldownAction()], r.Key)
+		}
+	}
+	assert.NotEmpty(t, openRegions(regions), "players need somewhere to search without choosing")
+}
+
+func TestValidateRegions(t *testing.T) {
+	tests := []struct {
+		name    string
+		regions []Region
+		wantErr string
+	}{
+		{
+			name:    "duplicate key",
+			regions: []Region{{Key: "a"}, {Key: "a"}},
+			wantErr: "duplicate region key",
+		},
+		{
+			name:    "rare event chance out of range",
+			regions: []Region{{Key: "a", RareEvents: []RareEvent{{Key: "e", Chance: 1.5, ItemName: "x", Quantity: 1}}}},
+			wantErr: "outside (0, 1]",
+		},
+		{
+			name:    "rare event without item",
+			regions: []Region{{Key: "a", RareEvents: []RareEvent{{Key: "e", Chance: 0.1}}}},
+			wantErr: "needs an item",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.ErrorContains(t, validateRegions(tt.regions), tt.wantErr)
+		})
+	}
+}
@@ -0,0 +1,161 @@
+package search
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"golang.org/x/text/cases"
+	"golang.org/x/text/language"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/logger"
+)
+
+// ProgressRepository persists search streaks and mastery.
+type ProgressRepository interface {
+	// RecordSearch counts a search made on day (UTC) and reports whether it
+	// was the user's first search that day.
+	RecordSearch(ctx context.Context, userID string, day time.Time) (*domain.SearchProgress, bool, error)
+
+	// GetProgress returns the user's progress, or nil if they have never searched.
+	GetProgress(ctx context.Context, userID string) (*domain.SearchProgress, error)
+
+	// SetMasteryLevel raises the user's stored mastery level. Lower levels are ignored.
+	SetMasteryLevel(ctx context.Context, userID string, level int) error
+
+	// CountRecentSearchers returns how many users have searched since the given time.
+	CountRecentSearchers(ctx context.Context, since time.Time) (int, error)
+}
+
+// ProgressStatus is a user's search streak and mastery as shown to clients.
+type ProgressStatus struct {
+	CurrentStreak            int `json:"current_streak"`
+	BestStreak               int `json:"best_streak"`
+	StreakBonus              int `json:"streak_bonus"` // Bonus lootboxes on the first search of each day
+	MasteryLevel             int `json:"mastery_level"`
+	TotalSearches            int `json:"total_searches"`
+	SearchesToNextLevel      int `json:"searches_to_next_level"` // 0 at the maximum level
+	CooldownReductionPercent int `json:"cooldown_reduction_percent"`
+}
+
+// GetProgress returns the user's search streak and mastery.
+func (s *service) GetProgress(ctx context.Context, platform, platformID, username string) (*ProgressStatus, error) {
+	if s.deps.Progress == nil {
+		return nil, nil
+	}
+	user, err := s.resolveUser(ctx, platform, platformID, username)
+	if err != nil {
+		return nil, err
+	}
+
+	progress, err := s.deps.Progress.GetProgress(ctx, user.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get search progress: %w", err)
+	}
+	if progress == nil {
+		progress = &domain.SearchProgress{UserID: user.ID}
+	}
+	return newProgressStatus(progress, time.Now().UTC()), nil
+}
+
+// newProgressStatus reports a streak broken by a missed day as 0.
+func newProgressStatus(progress *domain.SearchProgress, now time.Time) *ProgressStatus {
+	streak := progress.CurrentStreak
+	if !progress.LastSearchDate.IsZero() && now.Sub(progress.LastSearchDate) >= 48*time.Hour {
+		streak = 0
+	}
+	return &ProgressStatus{
+		CurrentStreak:            streak,
+		BestStreak:               progress.BestStreak,
+		StreakBonus:              domain.SearchStreakBonus(streak),
+		MasteryLevel:             progress.MasteryLevel,
+		TotalSearches:            progress.TotalSearches,
+		SearchesToNextLevel:      domain.SearchesToNextMasteryLevel(progress.TotalSearches),
+		CooldownReductionPercent: cooldownReductionPercent(progress.MasteryLevel),
+	}
+}
+
+// recordProgress counts the search towards the user's streak and mastery,
+// granting any streak bonus, and returns the messages to append. Progress is
+// a bonus on top of the search, so failures are logged rather than returned.
+func (s *service) recordProgress(ctx context.Context, user *domain.User) string {
+	log := logger.FromContext(ctx)
+	progress, firstToday, err := s.deps.Progress.RecordSearch(ctx, user.ID, time.Now().UTC())
+	if err != nil {
+		log.Warn("Failed to record search progress", "error", err, "user_id", user.ID)
+		return ""
+	}
+
+	var messages []string
+	if firstToday {
+		if bonus := domain.SearchStreakBonus(progress.CurrentStreak); bonus > 0 {
+			if msg, err := s.grantStreakBonus(ctx, user, progress.CurrentStreak, bonus); err != nil {
+				log.Warn("Failed to grant search streak bonus", "error", err, "user_id", user.ID, "streak", progress.CurrentStreak)
+			} else {
+				messages = append(messages, msg)
+			}
+		}
+	}
+
+	if level := domain.SearchMasteryLevel(progress.TotalSearches); level > progress.MasteryLevel {
+		if err := s.deps.Progress.SetMasteryLevel(ctx, user.ID, level); err != nil {
+			log.Warn("Failed to save search mastery level", "error", err, "user_id", user.ID, "level", level)
+		} else {
+			log.Info("Search mastery level up", "user_id", user.ID, "level", level)
+			messages = append(messages, fmt.Sprintf(domain.MsgSearchMasteryLevelUp, level, cooldownReductionPercent(level)))
+		}
+	}
+
+	return strings.Join(messages, "\n")
+}
+
+// grantStreakBonus gives the user the streak's bonus lootboxes and returns the message to append.
+func (s *service) grantStreakBonus(ctx context.Context, user *domain.User, streak, bonus int) (string, error) {
+	if err := s.deps.RewardGranter.GrantSearchReward(ctx, user, bonus, domain.QualityCommon); err != nil {
+		return "", err
+	}
+	item, err := s.deps.ItemLookup.GetItemByName(ctx, domain.ItemLootbox0)
+	if err != nil {
+		return "", fmt.Errorf("failed to get reward item: %w", err)
+	}
+	if item == nil {
+		return "", domain.ErrItemNotFound
+	}
+
+	logger.FromContext(ctx).Info("Search streak bonus granted", "user_id", user.ID, "streak", streak, "quantity", bonus)
+	displayName := cases.Title(language.English).String(item.PublicName)
+	return fmt.Sprintf(domain.MsgSearchStreakReward, streak, bonus, displayName), nil
+}
+
+func cooldownReductionPercent(level int) int {
+	return int((1.0-domain.SearchMasteryCooldownMultiplier(level))*100 + 0.5)
+}
+
+// MasteryCooldownModifier shortens a user's search cooldowns, including
+// location cooldowns, by their search mastery level.
+type MasteryCooldownModifier struct {
+	repo ProgressRepository
+}
+
+// NewMasteryCooldownModifier creates a cooldown modifier backed by the progress repository.
+func NewMasteryCooldownModifier(repo ProgressRepository) *MasteryCooldownModifier {
+	return &MasteryCooldownModifier{repo: repo}
+}
+
+// ModifyCooldown implements cooldown.Modifier.
+func (m *MasteryCooldownModifier) ModifyCooldown(ctx context.Context, userID, action string, duration time.Duration) time.Duration {
+	if action != domain.ActionSearch && !strings.HasPrefix(action, domain.SearchLocationActionPrefix) {
+		return duration
+	}
+	progress, err := m.repo.GetProgress(ctx, userID)
+	if err != nil {
+		logger.FromContext(ctx).Warn("Failed to get search mastery for cooldown", "error", err, "user_id", userID)
+		return duration
+	}
+	if progress == nil {
+		return duration
+	}
+	return time.Duration(float64(duration) * domain.SearchMasteryCooldownMultiplier(progress.MasteryLevel))
+}
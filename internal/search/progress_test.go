@@ -0,0 +1,196 @@
+package search
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+)
+
+// fakeProgressRepo records searches in memory, treating every call as a new day
+// unless sameDay is set
+type fakeProgressRepo struct {
+	progress    map[string]*domain.SearchProgress
+	sameDay     bool
+	recordCalls int
+}
+
+func newFakeProgressRepo() *fakeProgressRepo {
+	return &fakeProgressRepo{progress: make(map[string]*domain.SearchProgress)}
+}
+
+func (f *fakeProgressRepo) RecordSearch(ctx context.Context, userID string, day time.Time) (*domain.SearchProgress, bool, error) {
+	f.recordCalls++
+	p, ok := f.progress[userID]
+	if !ok {
+		p = &domain.SearchProgress{UserID: userID}
+		f.progress[userID] = p
+	}
+	if !f.sameDay || !ok {
+		p.CurrentStreak++
+	}
+	if p.CurrentStreak > p.BestStreak {
+		p.BestStreak = p.CurrentStreak
+	}
+	p.TotalSearches++
+	p.LastSearchDate = day
+	copied := *p
+	return &copied, !f.sameDay || !ok, nil
+}
+
+func (f *fakeProgressRepo) GetProgress(ctx context.Context, userID string) (*domain.SearchProgress, error) {
+	p, ok := f.progress[userID]
+	if !ok {
+		return nil, nil
+	}
+	copied := *p
+	return &copied, nil
+}
+
+func (f *fakeProgressRepo) SetMasteryLevel(ctx context.Context, userID string, level int) error {
+	if p, ok := f.progress[userID]; ok && level > p.MasteryLevel {
+		p.MasteryLevel = level
+	}
+	return nil
+}
+
+func (f *fakeProgressRepo) CountRecentSearchers(ctx context.Context, since time.Time) (int, error) {
+	return len(f.progress), nil
+}
+
+func createProgressTestService(t *testing.T) (*service, *mockSearchRepo, *fakeProgressRepo) {
+	t.Helper()
+	svc, repo := createSearchTestService()
+	progress := newFakeProgressRepo()
+	svc.deps.Progress = progress
+	svc.deps.Rnd = func() float64 { return 0.99 } // Failed search, so only bonuses reach the inventory
+	repo.users[TestUsername] = createTestUser()
+	return svc, repo, progress
+}
+
+func inventoryLootboxes(t *testing.T, repo *mockSearchRepo) int {
+	t.Helper()
+	inv, err := repo.GetInventory(context.Background(), TestUserID)
+	require.NoError(t, err)
+	total := 0
+	for _, slot := range inv.Slots {
+		if slot.ItemID == 1 {
+			total += slot.Quantity
+		}
+	}
+	return total
+}
+
+func TestHandleSearch_SearchStreakBonus(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("grants the tier bonus on the first search of the day", func(t *testing.T) {
+		svc, repo, progress := createProgressTestService(t)
+		progress.progress[TestUserID] = &domain.SearchProgress{UserID: TestUserID, CurrentStreak: 6, TotalSearches: 3, MasteryLevel: 0}
+
+		msg, err := svc.HandleSearch(ctx, domain.PlatformTwitch, "testuser123", TestUsername, "")
+
+		require.NoError(t, err)
+		assert.Contains(t, msg, "7-day search streak! (+2")
+		assert.Equal(t, 2, inventoryLootboxes(t, repo))
+	})
+
+	t.Run("no bonus for later searches the same day", func(t *testing.T) {
+		svc, repo, progress := createProgressTestService(t)
+		progress.progress[TestUserID] = &domain.SearchProgress{UserID: TestUserID, CurrentStreak: 7, TotalSearches: 3}
+		progress.sameDay = true
+
+		msg, err := svc.HandleSearch(ctx, domain.PlatformTwitch, "testuser123", TestUsername, "")
+
+		require.NoError(t, err)
+		assert.NotContains(t, msg, "search streak")
+		assert.Zero(t, inventoryLootboxes(t, repo))
+	})
+
+	t.Run("no bonus below the first tier", func(t *testing.T) {
+		svc, repo, _ := createProgressTestService(t)
+
+		msg, err := svc.HandleSearch(ctx, domain.PlatformTwitch, "testuser123", TestUsername, "")
+
+		require.NoError(t, err)
+		assert.NotContains(t, msg, "search streak")
+		assert.Zero(t, inventoryLootboxes(t, repo))
+	})
+}
+
+func TestHandleSearch_MasteryLevelUp(t *testing.T) {
+	svc, _, progress := createProgressTestService(t)
+	progress.sameDay = true
+	progress.progress[TestUserID] = &domain.SearchProgress{UserID: TestUserID, CurrentStreak: 1, TotalSearches: domain.SearchMasteryThresholds[0] - 1}
+
+	msg, err := svc.HandleSearch(context.Background(), domain.PlatformTwitch, "testuser123", TestUsername, "")
+
+	require.NoError(t, err)
+	assert.Contains(t, msg, "Search Mastery level 1!")
+	assert.Equal(t, 1, progress.progress[TestUserID].MasteryLevel)
+}
+
+func TestGetProgress(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("reports streak, bonus and mastery", func(t *testing.T) {
+		svc, _, progress := createProgressTestService(t)
+		progress.progress[TestUserID] = &domain.SearchProgress{
+			UserID: TestUserID, CurrentStreak: 14, BestStreak: 20, LastSearchDate: time.Now().UTC(),
+			TotalSearches: 65, MasteryLevel: 3,
+		}
+
+		status, err := svc.GetProgress(ctx, domain.PlatformTwitch, "testuser123", TestUsername)
+
+		require.NoError(t, err)
+		assert.Equal(t, &ProgressStatus{
+			CurrentStreak:            14,
+			BestStreak:               20,
+			StreakBonus:              3,
+			MasteryLevel:             3,
+			TotalSearches:            65,
+			SearchesToNextLevel:      35,
+			CooldownReductionPercent: 6,
+		}, status)
+	})
+
+	t.Run("a missed day breaks the streak", func(t *testing.T) {
+		svc, _, progress := createProgressTestService(t)
+		progress.progress[TestUserID] = &domain.SearchProgress{
+			UserID: TestUserID, CurrentStreak: 14, BestStreak: 14, LastSearchDate: time.Now().UTC().Add(-72 * time.Hour),
+		}
+
+		status, err := svc.GetProgress(ctx, domain.PlatformTwitch, "testuser123", TestUsername)
+
+		require.NoError(t, err)
+		assert.Zero(t, status.CurrentStreak)
+		assert.Zero(t, status.StreakBonus)
+		assert.Equal(t, 14, status.BestStreak)
+	})
+
+	t.Run("never searched", func(t *testing.T) {
+		svc, _, _ := createProgressTestService(t)
+
+		status, err := svc.GetProgress(ctx, domain.PlatformTwitch, "testuser123", TestUsername)
+
+		require.NoError(t, err)
+		assert.Zero(t, status.CurrentStreak)
+		assert.Equal(t, domain.SearchMasteryThresholds[0], status.SearchesToNextLevel)
+	})
+}
+
+func TestMasteryCooldownModifier(t *testing.T) {
+	ctx := context.Background()
+	progress := newFakeProgressRepo()
+	progress.progress[TestUserID] = &domain.SearchProgress{UserID: TestUserID, MasteryLevel: 5}
+	modifier := NewMasteryCooldownModifier(progress)
+
+	assert.Equal(t, 27*time.Minute, modifier.ModifyCooldown(ctx, TestUserID, domain.ActionSearch, 30*time.Minute))
+	assert.Equal(t, 81*time.Minute, modifier.ModifyCooldown(ctx, TestUserID, domain.SearchLocationActionPrefix+"vault", 90*time.Minute))
+	assert.Equal(t, 10*time.Minute, modifier.ModifyCooldown(ctx, TestUserID, domain.ActionSlots, 10*time.Minute), "only search cooldowns shrink")
+	assert.Equal(t, 30*time.Minute, modifier.ModifyCooldown(ctx, "someone-else", domain.ActionSearch, 30*time.Minute), "no progress, no change")
+}
@@ -5,8 +5,9 @@ import (
 	"fmt"
 	"os"
 	"strings"
+	"time"
 
-	"github.com/osse101/BrandishBot_Go/internal/utils"
+	"github.com/osse101/BrandishBot_Go/internal/domain"
 )
 
 // RegionDrop represents a single item drop entry in a region's drop table.
@@ -15,13 +16,60 @@ type RegionDrop struct {
 	Weight   int    `json:"weight"`
 }
 
+// RareEvent is an uncommon find that can trigger on any search in a region,
+// granting its item on top of the normal result.
+type RareEvent struct {
+	Key      string  `json:"key"`
+	Message  string  `json:"message"`
+	Chance   float64 `json:"chance"` // Probability per search, 0-1
+	ItemName string  `json:"item_name"`
+	Quantity int     `json:"quantity"`
+}
+
 // Region defines a search region with level gating and thematic item drops.
+// Regions that require a progression node or set their own cooldown are
+// destinations: players only search them by choosing them explicitly.
 type Region struct {
 	Key                   string       `json:"key"`
 	Name                  string       `json:"name"`
+	Description           string       `json:"description,omitempty"`
 	RequiredExplorerLevel int          `json:"required_explorer_level"`
+	RequiredNode          string       `json:"required_node,omitempty"`    // Progression node that unlocks the region
+	CooldownMinutes       int          `json:"cooldown_minutes,omitempty"` // Own cooldown; 0 shares the regular search cooldown
 	LootboxChanceModifier float64      `json:"lootbox_chance_modifier"`
 	ItemDrops             []RegionDrop `json:"item_drops"`
+	RareEvents            []RareEvent  `json:"rare_events,omitempty"`
+}
+
+// IsDestination reports whether the region is only searched when chosen explicitly
+func (r Region) IsDestination() bool {
+	return r.RequiredNode != "" || r.CooldownMinutes > 0
+}
+
+// showsName reports whether search results should be tagged with the region
+// name. The starting region is left untagged.
+func (r *Region) showsName() bool {
+	return r != nil && (r.RequiredExplorerLevel > 0 || r.IsDestination())
+}
+
+// CooldownAction returns the cooldown action searches in this region count against
+func (r Region) CooldownAction() string {
+	if r.CooldownMinutes > 0 {
+		return domain.SearchLocationActionPrefix + r.Key
+	}
+	return domain.ActionSearch
+}
+
+// LocationCooldowns returns the cooldown durations of regions with their own
+// cooldown, keyed by cooldown action, for cooldown.Config.Cooldowns.
+func LocationCooldowns(regions []Region) map[string]time.Duration {
+	cooldowns := make(map[string]time.Duration)
+	for _, r := range regions {
+		if r.CooldownMinutes > 0 {
+			cooldowns[r.CooldownAction()] = time.Duration(r.CooldownMinutes) * time.Minute
+		}
+	}
+	return cooldowns
 }
 
 // RegionConfig is the top-level JSON structure for search_regions.json.
@@ -46,9 +94,62 @@ func LoadSearchRegions(path string) ([]Region, error) {
 		return nil, fmt.Errorf("search regions config has no regions")
 	}
 
+	if err := validateRegions(config.Regions); err != nil {
+		return nil, fmt.Errorf("invalid search regions config: %w", err)
+	}
+
 	return config.Regions, nil
 }
 
+// validateRegions checks region keys are unique and rare events are well formed.
+func validateRegions(regions []Region) error {
+	seen := make(map[string]bool, len(regions))
+	for _, r := range regions {
+		if r.Key == "" {
+			return fmt.Errorf("region %q has no key", r.Name)
+		}
+		if seen[r.Key] {
+			return fmt.Errorf("duplicate region key %q", r.Key)
+		}
+		seen[r.Key] = true
+
+		if r.CooldownMinutes < 0 {
+			return fmt.Errorf("region %q has a negative cooldown", r.Key)
+		}
+		for _, e := range r.RareEvents {
+			if e.Chance <= 0 || e.Chance > 1 {
+				return fmt.Errorf("rare event %q in region %q has chance %v outside (0, 1]", e.Key, r.Key, e.Chance)
+			}
+			if e.ItemName == "" || e.Quantity < 1 {
+				return fmt.Errorf("rare event %q in region %q needs an item and a positive quantity", e.Key, r.Key)
+			}
+		}
+	}
+	return nil
+}
+
+// findRegion returns the region with the given key, ignoring case.
+func findRegion(regions []Region, key string) *Region {
+	key = strings.ToLower(strings.TrimSpace(key))
+	for i := range regions {
+		if strings.ToLower(regions[i].Key) == key {
+			return &regions[i]
+		}
+	}
+	return nil
+}
+
+// openRegions returns the regions searched without choosing a location.
+func openRegions(regions []Region) []Region {
+	var open []Region
+	for _, r := range regions {
+		if !r.IsDestination() {
+			open = append(open, r)
+		}
+	}
+	return open
+}
+
 // resolveRegion picks the best region for the user based on explorer level and an optional item hint.
 func resolveRegion(regions []Region, explorerLevel int, itemHint string, publicNameIndex map[string]string) *Region {
 	if len(regions) == 0 {
@@ -100,8 +201,19 @@ func resolveRegion(regions []Region, explorerLevel int, itemHint string, publicN
 	return resolveRegion(regions, explorerLevel, "", publicNameIndex)
 }
 
+// rollRareEvent returns the first rare event whose roll succeeds, or nil.
+// Each event is rolled independently in config order.
+func rollRareEvent(events []RareEvent, rnd func() float64) *RareEvent {
+	for i := range events {
+		if rnd() < events[i].Chance {
+			return &events[i]
+		}
+	}
+	return nil
+}
+
 // rollRegionItemDrop performs a weighted random selection from a region's item drops.
-func rollRegionItemDrop(drops []RegionDrop) string {
+func rollRegionItemDrop(drops []RegionDrop, rnd func() float64) string {
 	if len(drops) == 0 {
 		return ""
 	}
@@ -114,7 +226,7 @@ func rollRegionItemDrop(drops []RegionDrop) string {
 		return ""
 	}
 
-	roll := utils.SecureRandomIntRange(0, totalWeight-1)
+	roll := rollIndex(rnd, totalWeight)
 	cumulative := 0
 	for _, d := range drops {
 		cumulative += d.Weight
@@ -17,6 +17,7 @@ import (
 	"github.com/osse101/BrandishBot_Go/internal/event"
 	"github.com/osse101/BrandishBot_Go/internal/job"
 	"github.com/osse101/BrandishBot_Go/internal/repository"
+	"github.com/osse101/BrandishBot_Go/internal/rng"
 	"github.com/osse101/BrandishBot_Go/internal/utils"
 )
 
@@ -68,6 +69,21 @@ func (m *mockJobService) GetUserJobsByPlatform(ctx context.Context, platform, pl
 func (m *mockJobService) GetPrimaryJob(ctx context.Context, platform, platformID string) (*domain.UserJobInfo, error) {
 	return nil, nil
 }
+func (m *mockJobService) GrantXPBoost(ctx context.Context, userID string, multiplier float64, duration time.Duration) error {
+	return nil
+}
+func (m *mockJobService) GetRecentXPAwards(ctx context.Context, userID string, limit int) ([]domain.XPAward, error) {
+	return nil, nil
+}
+func (m *mockJobService) SwitchActiveJob(ctx context.Context, platform, platformID, jobKey string) (*domain.UserJobInfo, error) {
+	return nil, nil
+}
+func (m *mockJobService) PrestigeJob(ctx context.Context, platform, platformID, jobKey string) (*domain.JobPrestigeResult, error) {
+	return nil, nil
+}
+func (m *mockJobService) GetJobPerks(ctx context.Context, jobKey, platform, platformID string) (*domain.JobPerks, error) {
+	return nil, nil
+}
 func (m *mockJobService) IsJobFeatureUnlocked(ctx context.Context, userID, featureKey string) (bool, error) {
 	return false, nil
 }
@@ -166,6 +182,20 @@ func (m *mockSearchRepo) UpdateInventory(ctx context.Context, userID string, inv
 	return nil
 }
 
+func (m *mockSearchRepo) AdjustItemQuantity(ctx context.Context, userID string, itemID int, quality domain.QualityLevel, delta int) (int, error) {
+	inv, _ := m.GetInventory(ctx, userID)
+	qty, err := utils.AdjustSlotQuantity(inv, itemID, quality, delta)
+	if err != nil {
+		return 0, err
+	}
+	m.inventories[userID] = inv
+	return qty, nil
+}
+
+func (m *mockSearchRepo) AddToCommunityPool(ctx context.Context, amount int) error {
+	return nil
+}
+
 func (m *mockSearchRepo) DeleteInventory(ctx context.Context, userID string) error {
 	delete(m.inventories, userID)
 	return nil
@@ -400,6 +430,10 @@ func (m *mockCooldownService) GetLastUsed(ctx context.Context, userID, action st
 	return m.repo.GetLastCooldown(ctx, userID, action)
 }
 
+func (m *mockCooldownService) GetCooldowns(ctx context.Context, userID string) ([]domain.CooldownTimer, error) {
+	return nil, nil
+}
+
 type searchTestServiceOpts struct {
 	jobService job.Service
 	publisher  *event.ResilientPublisher
@@ -797,10 +831,13 @@ func (m *mockStatsService) GetUserCurrentStreak(ctx context.Context, userID stri
 	}
 	return 1, nil
 }
+func (m *mockStatsService) GetUserRecipeCraftCounts(ctx context.Context, userID string) (map[string]int, error) {
+	return nil, nil
+}
 func (m *mockStatsService) GetSystemStats(ctx context.Context, period string) (*domain.StatsSummary, error) {
 	return nil, nil
 }
-func (m *mockStatsService) GetLeaderboard(ctx context.Context, eventType domain.EventType, period string, limit int) ([]domain.LeaderboardEntry, error) {
+func (m *mockStatsService) GetLeaderboard(ctx context.Context, query domain.LeaderboardQuery) (*domain.LeaderboardPage, error) {
 	return nil, nil
 }
 func (m *mockStatsService) GetUserSlotsStats(ctx context.Context, userID, period string) (*domain.SlotsStats, error) {
@@ -816,6 +853,14 @@ func (m *mockStatsService) GetSlotsLeaderboardByMegaJackpots(ctx context.Context
 	return nil, nil
 }
 
+func (m *mockStatsService) QueryStats(ctx context.Context, query domain.StatsQuery) (*domain.StatsQueryResult, error) {
+	return nil, nil
+}
+
+func (m *mockStatsService) RollUpDue(ctx context.Context) (int, error) {
+	return 0, nil
+}
+
 func TestHandleSearch_CriticalSuccess(t *testing.T) {
 	t.Parallel()
 	// ARRANGE
@@ -1007,6 +1052,32 @@ func TestHandleSearch_NormalFailure(t *testing.T) {
 	assert.True(t, isValid, "Expected valid failure message, got: %s", msg)
 }
 
+type fakeGameEvents struct {
+	mods domain.GameModifiers
+}
+
+func (f fakeGameEvents) Modifiers(context.Context) domain.GameModifiers {
+	return f.mods
+}
+
+func TestHandleSearch_GameEventRaisesSuccessRate(t *testing.T) {
+	t.Parallel()
+	// ARRANGE
+	svc, repo := createSearchTestService()
+	repo.users[TestUsername] = createTestUser()
+	svc.deps.GameEvents = fakeGameEvents{mods: domain.GameModifiers{XPMultiplier: 1, DropRateMultiplier: 1.5}}
+
+	// 0.9 fails at the base rate but passes once the event lifts it to the cap
+	svc.deps.Rnd = func() float64 { return 0.9 }
+
+	// ACT
+	msg, err := svc.HandleSearch(context.Background(), domain.PlatformTwitch, "testuser123", TestUsername, "")
+
+	// ASSERT
+	require.NoError(t, err)
+	assert.Contains(t, msg, "You found", "Should be a success message")
+}
+
 func TestHandleSearch_BoundaryConditions(t *testing.T) {
 	t.Parallel()
 	tests := []struct {
@@ -1182,3 +1253,19 @@ func TestHandleSearch_ExhaustedMessage_Logic(t *testing.T) {
 	require.NoError(t, err)
 	assert.NotContains(t, msg, "(Exhausted)", "Should only show exhausted message once")
 }
+
+func TestHandleSearch_ReplayedSeedRepeatsOutcome(t *testing.T) {
+	t.Parallel()
+	search := func(seed int64) string {
+		svc, repo := createSearchTestService()
+		repo.users[TestUsername] = createTestUser()
+		svc.deps.RNG = rng.NewReplay(seed)
+		msg, err := svc.HandleSearch(context.Background(), domain.PlatformTwitch, "testuser123", TestUsername, "")
+		require.NoError(t, err)
+		return msg
+	}
+
+	for seed := int64(1); seed <= 20; seed++ {
+		assert.Equal(t, search(seed), search(seed), "seed %d", seed)
+	}
+}
@@ -21,6 +21,11 @@ func (m *MockProgressionService) GetModifiedValue(ctx context.Context, userID st
 	return args.Get(0).(float64), args.Error(1)
 }
 
+func (m *MockProgressionService) IsNodeUnlocked(ctx context.Context, nodeKey string, level int) (bool, error) {
+	args := m.Called(ctx, nodeKey, level)
+	return args.Bool(0), args.Error(1)
+}
+
 func TestUpgradeSearchQuality_ModifierApplied(t *testing.T) {
 	// ARRANGE
 	mockProg := new(MockProgressionService)
@@ -11,10 +11,11 @@ import (
 	"github.com/osse101/BrandishBot_Go/internal/cooldown"
 	"github.com/osse101/BrandishBot_Go/internal/domain"
 	"github.com/osse101/BrandishBot_Go/internal/event"
+	"github.com/osse101/BrandishBot_Go/internal/gameevent"
 	"github.com/osse101/BrandishBot_Go/internal/job"
 	"github.com/osse101/BrandishBot_Go/internal/logger"
+	"github.com/osse101/BrandishBot_Go/internal/rng"
 	"github.com/osse101/BrandishBot_Go/internal/stats"
-	"github.com/osse101/BrandishBot_Go/internal/utils"
 )
 
 // UserResolver resolves user identity from platform credentials.
@@ -34,9 +35,15 @@ type RewardGranter interface {
 	GrantItemReward(ctx context.Context, user *domain.User, item *domain.Item, quantity int, quality domain.QualityLevel) error
 }
 
-// ProgressionService provides progression-based modifiers.
+// ProgressionService provides progression-based modifiers and node unlock checks.
 type ProgressionService interface {
 	GetModifiedValue(ctx context.Context, userID string, featureKey string, baseValue float64) (float64, error)
+	IsNodeUnlocked(ctx context.Context, nodeKey string, level int) (bool, error)
+}
+
+// EffectChecker reads timed effects such as search luck.
+type EffectChecker interface {
+	Multiplier(ctx context.Context, userID string, effectType domain.EffectType) float64
 }
 
 // Deps bundles all dependencies for the search service.
@@ -50,12 +57,35 @@ type Deps struct {
 	ProgressionSvc ProgressionService
 	Publisher      *event.ResilientPublisher
 	Rnd            func() float64
+	RNG            *rng.Provider // Optional: seeded source per search, overrides Rnd
 	Regions        []Region
+	Progress       ProgressRepository       // Optional: search streaks and mastery
+	Effects        EffectChecker            // Optional: search luck effects
+	Difficulty     *Difficulty              // Optional: difficulty scaled by recent search activity
+	GameEvents     gameevent.ModifierSource // Optional: drop rate boosts from admin game events
 }
 
 // Service defines the interface for the search gameplay feature.
 type Service interface {
 	HandleSearch(ctx context.Context, platform, platformID, username, itemHint string) (string, error)
+
+	// SearchLocation searches a region chosen by key. Destinations gated by a
+	// progression node or explorer level must be unlocked first.
+	SearchLocation(ctx context.Context, platform, platformID, username, location string) (string, error)
+
+	// GetLocations lists every region with the user's access and cooldown state
+	GetLocations(ctx context.Context, platform, platformID, username string) ([]LocationStatus, error)
+
+	// GetProgress returns the user's search streak and mastery, or nil when
+	// progress is not tracked
+	GetProgress(ctx context.Context, platform, platformID, username string) (*ProgressStatus, error)
+
+	// GetDifficulty reports the recent searcher count and the difficulty tier it selects
+	GetDifficulty(ctx context.Context) (*DifficultyStatus, error)
+
+	// SetDifficulty replaces the difficulty curve until the next restart or
+	// config reload, and reports the resulting difficulty
+	SetDifficulty(ctx context.Context, config DifficultyConfig) (*DifficultyStatus, error)
 }
 
 // service implements the search gameplay feature.
@@ -76,7 +106,9 @@ type searchParams struct {
 	successThreshold   float64
 	dailyCount         int
 	streak             int
+	qualityModifier    int
 	region             *Region
+	rnd                func() float64 // every roll in this search draws from rnd
 }
 
 // HandleSearch performs a search action for a user with cooldown tracking.
@@ -84,23 +116,16 @@ func (s *service) HandleSearch(ctx context.Context, platform, platformID, userna
 	log := logger.FromContext(ctx)
 	log.Info("HandleSearch called", "platform", platform, "platformID", platformID, "username", username, "itemHint", itemHint)
 
-	if username == "" || platform == "" {
-		return "", domain.ErrInvalidInput
-	}
-	if platform != domain.PlatformTwitch && platform != domain.PlatformDiscord {
-		return "", domain.ErrInvalidInput
-	}
-
-	user, err := s.deps.UserResolver.GetUserOrRegister(ctx, platform, platformID, username)
+	user, err := s.resolveUser(ctx, platform, platformID, username)
 	if err != nil {
-		log.Error("Failed to get user or register", "error", err)
 		return "", err
 	}
 
 	var resultMessage string
+	ctx = event.WithInventoryReason(ctx, domain.ActionSearch)
 	err = s.deps.CooldownSvc.EnforceCooldown(ctx, user.ID, domain.ActionSearch, func() error {
 		var err error
-		resultMessage, err = s.executeSearch(ctx, user, itemHint)
+		resultMessage, err = s.executeSearch(ctx, user, itemHint, nil)
 		return err
 	})
 
@@ -112,34 +137,84 @@ func (s *service) HandleSearch(ctx context.Context, platform, platformID, userna
 	return resultMessage, nil
 }
 
+// resolveUser validates the platform identity and returns the user, registering them if needed.
+func (s *service) resolveUser(ctx context.Context, platform, platformID, username string) (*domain.User, error) {
+	if username == "" || platform == "" {
+		return nil, domain.ErrInvalidInput
+	}
+	if platform != domain.PlatformTwitch && platform != domain.PlatformDiscord {
+		return nil, domain.ErrInvalidInput
+	}
+
+	user, err := s.deps.UserResolver.GetUserOrRegister(ctx, platform, platformID, username)
+	if err != nil {
+		logger.FromContext(ctx).Error("Failed to get user or register", "error", err)
+		return nil, err
+	}
+	return user, nil
+}
+
+// explorerLevel returns the user's Explorer job level, or 0 if it can't be read.
+func (s *service) explorerLevel(ctx context.Context, userID string) int {
+	if s.deps.JobSvc == nil {
+		return 0
+	}
+	level, err := s.deps.JobSvc.GetJobLevel(ctx, userID, domain.JobKeyExplorer)
+	if err != nil {
+		logger.FromContext(ctx).Warn("Failed to get explorer level for region resolution", "error", err)
+		return 0
+	}
+	return level
+}
+
 // executeSearch performs the actual search logic (called within cooldown enforcement).
-func (s *service) executeSearch(ctx context.Context, user *domain.User, itemHint string) (string, error) {
+// A nil region is resolved from the open regions by explorer level and item hint.
+func (s *service) executeSearch(ctx context.Context, user *domain.User, itemHint string, region *Region) (string, error) {
 	log := logger.FromContext(ctx)
 	params := s.calculateSearchParameters(ctx, user)
 
-	// Resolve search region based on explorer level and optional item hint
-	if len(s.deps.Regions) > 0 {
-		explorerLevel := 0
-		if s.deps.JobSvc != nil {
-			if level, err := s.deps.JobSvc.GetJobLevel(ctx, user.ID, domain.JobKeyExplorer); err == nil {
-				explorerLevel = level
-			} else {
-				log.Warn("Failed to get explorer level for region resolution", "error", err)
-			}
+	params.region = region
+	if params.region == nil {
+		if open := openRegions(s.deps.Regions); len(open) > 0 {
+			pubIndex := s.deps.ItemLookup.BuildPublicNameIndex()
+			params.region = resolveRegion(open, s.explorerLevel(ctx, user.ID), itemHint, pubIndex)
 		}
-		pubIndex := s.deps.ItemLookup.BuildPublicNameIndex()
-		params.region = resolveRegion(s.deps.Regions, explorerLevel, itemHint, pubIndex)
-		if params.region != nil {
-			params.successThreshold += params.region.LootboxChanceModifier
-			if params.successThreshold < 0.1 {
-				params.successThreshold = 0.1
-			}
-			log.Debug("Search region resolved", "region", params.region.Name, "modifier", params.region.LootboxChanceModifier, "threshold", params.successThreshold)
+	}
+	if params.region != nil {
+		params.successThreshold += params.region.LootboxChanceModifier
+		if params.successThreshold < 0.1 {
+			params.successThreshold = 0.1
+		}
+		log.Debug("Search region resolved", "region", params.region.Name, "modifier", params.region.LootboxChanceModifier, "threshold", params.successThreshold)
+	}
+	if s.deps.Difficulty != nil {
+		tier := s.deps.Difficulty.Current(ctx)
+		if tier.SuccessModifier != 0 {
+			params.successThreshold = min(max(params.successThreshold+tier.SuccessModifier, 0.1), domain.SearchMaxSuccessRate)
 		}
+		params.qualityModifier = tier.QualityModifier
+		log.Debug("Search difficulty applied", "min_searchers", tier.MinSearchers, "quality_modifier", tier.QualityModifier, "threshold", params.successThreshold)
+	}
+	if s.deps.Effects != nil {
+		if luck := s.deps.Effects.Multiplier(ctx, user.ID, domain.EffectSearchLuck); luck != 1 {
+			params.successThreshold = min(params.successThreshold*luck, domain.SearchMaxSuccessRate)
+			log.Debug("Search luck applied", "multiplier", luck, "threshold", params.successThreshold)
+		}
+	}
+	if s.deps.GameEvents != nil {
+		if mods := s.deps.GameEvents.Modifiers(ctx); mods.DropRateMultiplier > 1 {
+			params.successThreshold = min(params.successThreshold*mods.DropRateMultiplier, domain.SearchMaxSuccessRate)
+			log.Debug("Game event drop rate applied", "multiplier", mods.DropRateMultiplier, "events", mods.Events, "threshold", params.successThreshold)
+		}
+	}
+
+	params.rnd = s.deps.Rnd
+	if s.deps.RNG != nil {
+		params.rnd = s.deps.RNG.ForOperation(ctx, rng.OpSearch).Float64
 	}
 
 	// Perform search roll
-	roll := s.deps.Rnd()
+	roll := params.rnd()
 
 	var resultMessage string
 	isSuccess := roll <= params.successThreshold
@@ -166,6 +241,26 @@ func (s *service) executeSearch(ctx context.Context, user *domain.User, itemHint
 		resultMessage = s.processSearchFailure(roll, params.successThreshold, params)
 	}
 
+	var location, rareEventKey string
+	if params.region != nil {
+		location = params.region.Key
+		if rare := rollRareEvent(params.region.RareEvents, params.rnd); rare != nil {
+			// The main reward is already granted, so a failed bonus must not fail the search
+			if rareMessage, err := s.grantRareEvent(ctx, user, rare); err != nil {
+				log.Warn("Failed to grant rare event reward", "event", rare.Key, "region", location, "error", err)
+			} else {
+				resultMessage += "\n" + rareMessage
+				rareEventKey = rare.Key
+			}
+		}
+	}
+
+	if s.deps.Progress != nil {
+		if progressMessage := s.recordProgress(ctx, user); progressMessage != "" {
+			resultMessage += "\n" + progressMessage
+		}
+	}
+
 	xpAmount := int(float64(job.ExplorerXPPerItem) * params.xpMultiplier)
 	if xpAmount < 1 {
 		xpAmount = 1
@@ -184,6 +279,8 @@ func (s *service) executeSearch(ctx context.Context, user *domain.User, itemHint
 				XPAmount:       xpAmount,
 				ItemName:       itemName,
 				Quantity:       quantity,
+				Location:       location,
+				RareEvent:      rareEventKey,
 				Timestamp:      time.Now().Unix(),
 			},
 		})
@@ -238,7 +335,7 @@ func (s *service) processSearchSuccess(ctx context.Context, user *domain.User, r
 
 	// Determine if we grant a region item instead of a lootbox
 	if params.region != nil && len(params.region.ItemDrops) > 0 {
-		regionRoll := utils.RandomFloat()
+		regionRoll := params.rnd()
 		if regionRoll < domain.SearchRegionItemDropChance {
 			return s.processRegionItemDrop(ctx, user, isCritical, quantity, params)
 		}
@@ -259,7 +356,7 @@ func (s *service) processSearchSuccess(ctx context.Context, user *domain.User, r
 	}
 
 	msg := s.formatSearchSuccessMessage(ctx, user, item, quantity, isCritical, params)
-	if params.region != nil && params.region.RequiredExplorerLevel > 0 {
+	if params.region.showsName() {
 		msg += fmt.Sprintf(" [%s]", params.region.Name)
 	}
 	return msg, nil
@@ -267,7 +364,7 @@ func (s *service) processSearchSuccess(ctx context.Context, user *domain.User, r
 
 func (s *service) processRegionItemDrop(ctx context.Context, user *domain.User, isCritical bool, quantity int, params searchParams) (string, error) {
 	log := logger.FromContext(ctx)
-	droppedItemName := rollRegionItemDrop(params.region.ItemDrops)
+	droppedItemName := rollRegionItemDrop(params.region.ItemDrops, params.rnd)
 	if droppedItemName == "" {
 		log.Warn("Region item drop roll returned empty, falling back to lootbox")
 		qualityLevel := s.calculateSearchQuality(ctx, user.ID, isCritical, params)
@@ -316,9 +413,9 @@ func (s *service) processRegionItemDrop(ctx context.Context, user *domain.User,
 
 func (s *service) processSearchFailure(roll float64, successThreshold float64, params searchParams) string {
 	failureType := determineSearchFailureType(roll, successThreshold)
-	resultMessage := formatSearchFailureMessage(failureType)
+	resultMessage := formatSearchFailureMessage(failureType, params.rnd)
 
-	if params.region != nil && params.region.RequiredExplorerLevel > 0 {
+	if params.region.showsName() {
 		resultMessage += fmt.Sprintf(" [%s]", params.region.Name)
 	}
 
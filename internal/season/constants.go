@@ -0,0 +1,28 @@
+package season
+
+import "time"
+
+// JobType identifies season refreshes in the worker pool and scheduler
+const JobType = "season_refresh"
+
+// CheckInterval is how often the scheduler looks for seasons that started or
+// ended. Seasons change on day boundaries, so loot follows within a minute.
+const CheckInterval = time.Minute
+
+// SchemaSeasonPack is the schema identifier of a season pack file
+const SchemaSeasonPack = "season-pack"
+
+// PackFileExtension is the extension of the pack files loaded from the seasons directory
+const PackFileExtension = ".json"
+
+// MonthDayLayout parses the MM-DD start and end of a season
+const MonthDayLayout = "01-02"
+
+// PoolNameFormat names a season's pool in a lootbox: season, then lootbox
+const PoolNameFormat = "season_%s_%s"
+
+// Log messages
+const (
+	LogMsgSeasonStarted = "Season started"
+	LogMsgSeasonEnded   = "Season ended"
+)
@@ -0,0 +1,23 @@
+package season
+
+import "context"
+
+// Job starts and ends seasons as their windows open and close
+type Job struct {
+	service Service
+}
+
+// NewJob creates a season refresh job
+func NewJob(service Service) *Job {
+	return &Job{service: service}
+}
+
+// JobType returns the worker pool job type
+func (j *Job) JobType() string {
+	return JobType
+}
+
+// Process publishes the seasons that started or ended since the last run
+func (j *Job) Process(ctx context.Context) error {
+	return j.service.Refresh(ctx)
+}
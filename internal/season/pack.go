@@ -0,0 +1,265 @@
+// Package season loads seasonal content packs: extra items, loot, limited
+// recipes and themed names that are only in play during a yearly window.
+package season
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/osse101/BrandishBot_Go/internal/crafting"
+	"github.com/osse101/BrandishBot_Go/internal/item"
+	"github.com/osse101/BrandishBot_Go/internal/lootbox"
+	"github.com/osse101/BrandishBot_Go/internal/naming"
+)
+
+// ErrInvalidPack is returned for season packs that fail validation
+var ErrInvalidPack = errors.New("invalid season pack")
+
+// Pack is one seasonal content pack. Its items are added to the game for
+// good, so copies players hold stay valid after the season; the loot,
+// recipes and themed names only apply between Start and End each year.
+type Pack struct {
+	Version     string `json:"version"`
+	Schema      string `json:"schema"`
+	Name        string `json:"name"`
+	DisplayName string `json:"display_name"`
+	Description string `json:"description"`
+	Start       string `json:"start"` // MM-DD
+	End         string `json:"end"`   // MM-DD, may wrap into the next year
+
+	// FeatureKey is the progression feature that lets users pick the
+	// season's names outside the season. Empty means any user can pick them.
+	FeatureKey string `json:"feature_key,omitempty"`
+
+	Items []item.Def `json:"items"`
+	Loot  []LootPool `json:"loot"`
+
+	// Recipes are only craftable during the season. Their event_theme is
+	// set to the pack name.
+	Recipes []crafting.RecipeDef `json:"recipes"`
+
+	// Aliases name items while the season runs, keyed by internal name.
+	// The pack's own items use them all year.
+	Aliases map[string][]string `json:"aliases"`
+}
+
+// LootPool adds a pool of entries to a lootbox during the season
+type LootPool struct {
+	Lootbox string                `json:"lootbox"`
+	Weight  int                   `json:"weight"`
+	Items   []lootbox.PoolItemDef `json:"items"`
+}
+
+// Period returns the yearly window the pack is active in
+func (p Pack) Period() naming.ThemePeriod {
+	return naming.ThemePeriod{Start: p.Start, End: p.End, FeatureKey: p.FeatureKey}
+}
+
+// IsActive reports whether the season is running at now
+func (p Pack) IsActive(now time.Time) bool {
+	return p.Period().Contains(now)
+}
+
+// PoolName returns the name of the pack's pool in a lootbox
+func (p Pack) PoolName(lootboxName string) string {
+	return fmt.Sprintf(PoolNameFormat, p.Name, lootboxName)
+}
+
+// LoadPacks reads every pack in dir, ordered by file name, and validates
+// them together. A missing directory means there are no packs.
+func LoadPacks(dir string) ([]Pack, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to read season packs: %w", err)
+	}
+
+	var packs []Pack
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), PackFileExtension) {
+			continue
+		}
+		pack, err := loadPack(filepath.Join(dir, entry.Name()))
+		if err != nil {
+			return nil, err
+		}
+		packs = append(packs, pack)
+	}
+
+	if err := Validate(packs); err != nil {
+		return nil, err
+	}
+	return packs, nil
+}
+
+func loadPack(path string) (Pack, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return Pack{}, fmt.Errorf("failed to read season pack %s: %w", path, err)
+	}
+
+	var pack Pack
+	if err := json.Unmarshal(data, &pack); err != nil {
+		return Pack{}, fmt.Errorf("failed to parse season pack %s: %w", path, err)
+	}
+	if pack.Version == "" {
+		return Pack{}, fmt.Errorf("%w: %s missing version field", ErrInvalidPack, path)
+	}
+	if pack.Schema != SchemaSeasonPack {
+		return Pack{}, fmt.Errorf("%w: invalid schema in %s: expected '%s', got '%s'", ErrInvalidPack, path, SchemaSeasonPack, pack.Schema)
+	}
+
+	for i := range pack.Recipes {
+		if pack.Recipes[i].EventTheme == "" {
+			pack.Recipes[i].EventTheme = pack.Name
+		}
+	}
+	return pack, nil
+}
+
+// Validate checks each pack on its own and the packs against each other.
+// Item references are checked once the items are known, by ValidateReferences.
+// Every problem found is returned, joined into a single error.
+func Validate(packs []Pack) error {
+	var errs []error
+	names := make(map[string]bool, len(packs))
+	itemNames := make(map[string]string)
+	recipeKeys := make(map[string]string)
+
+	for i, pack := range packs {
+		if pack.Name == "" {
+			errs = append(errs, fmt.Errorf("%w: pack at index %d has no name", ErrInvalidPack, i))
+			continue
+		}
+		if names[pack.Name] {
+			errs = append(errs, fmt.Errorf("%w: duplicate pack name '%s'", ErrInvalidPack, pack.Name))
+			continue
+		}
+		names[pack.Name] = true
+
+		errs = append(errs, validatePack(pack))
+
+		for _, def := range pack.Items {
+			if other, ok := itemNames[def.InternalName]; ok {
+				errs = append(errs, fmt.Errorf("%w: item '%s' is defined by both '%s' and '%s'", ErrInvalidPack, def.InternalName, other, pack.Name))
+			}
+			itemNames[def.InternalName] = pack.Name
+		}
+		for _, recipe := range pack.Recipes {
+			if other, ok := recipeKeys[recipe.RecipeKey]; ok {
+				errs = append(errs, fmt.Errorf("%w: recipe '%s' is defined by both '%s' and '%s'", ErrInvalidPack, recipe.RecipeKey, other, pack.Name))
+			}
+			recipeKeys[recipe.RecipeKey] = pack.Name
+		}
+		// Only one theme is active at a time, so seasons may not overlap
+		for _, other := range packs[:i] {
+			if other.Name != "" && pack.Period().Overlaps(other.Period()) {
+				errs = append(errs, fmt.Errorf("%w: '%s' overlaps '%s'", ErrInvalidPack, pack.Name, other.Name))
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func validatePack(pack Pack) error {
+	var errs []error
+	for _, date := range []string{pack.Start, pack.End} {
+		if _, err := time.Parse(MonthDayLayout, date); err != nil {
+			errs = append(errs, fmt.Errorf("%w: '%s' has invalid date '%s', expected MM-DD", ErrInvalidPack, pack.Name, date))
+		}
+	}
+
+	if len(pack.Items) > 0 {
+		if err := item.NewLoader().Validate(&item.Config{Items: pack.Items}); err != nil {
+			errs = append(errs, fmt.Errorf("%w: '%s': %w", ErrInvalidPack, pack.Name, err))
+		}
+	}
+
+	lootboxes := make(map[string]bool, len(pack.Loot))
+	for i, pool := range pack.Loot {
+		if pool.Lootbox == "" || lootboxes[pool.Lootbox] {
+			errs = append(errs, fmt.Errorf("%w: '%s' loot[%d] needs a lootbox not used by another pool", ErrInvalidPack, pack.Name, i))
+		}
+		lootboxes[pool.Lootbox] = true
+		if pool.Weight <= 0 {
+			errs = append(errs, fmt.Errorf("%w: '%s' loot[%d] has non-positive weight", ErrInvalidPack, pack.Name, i))
+		}
+		if len(pool.Items) == 0 {
+			errs = append(errs, fmt.Errorf("%w: '%s' loot[%d] has no items", ErrInvalidPack, pack.Name, i))
+		}
+		for j, entry := range pool.Items {
+			if (entry.ItemName == "") == (entry.ItemType == "") {
+				errs = append(errs, fmt.Errorf("%w: '%s' loot[%d] item[%d] must set exactly one of item_name or item_type", ErrInvalidPack, pack.Name, i, j))
+			}
+			if entry.Weight <= 0 {
+				errs = append(errs, fmt.Errorf("%w: '%s' loot[%d] item[%d] has non-positive weight", ErrInvalidPack, pack.Name, i, j))
+			}
+		}
+	}
+
+	for _, recipe := range pack.Recipes {
+		if recipe.EventTheme != pack.Name {
+			errs = append(errs, fmt.Errorf("%w: '%s' recipe '%s' has event_theme '%s'; pack recipes belong to their season", ErrInvalidPack, pack.Name, recipe.RecipeKey, recipe.EventTheme))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// ValidateReferences checks the items, lootboxes and recipes the packs
+// refer to against the known item internal names, which must already
+// include the packs' own items. recipeKeys holds the keys of the recipe
+// configs, which pack recipes may not reuse.
+func ValidateReferences(packs []Pack, itemNames, recipeKeys map[string]bool) error {
+	var errs []error
+	for _, pack := range packs {
+		for i, pool := range pack.Loot {
+			if !itemNames[pool.Lootbox] {
+				errs = append(errs, fmt.Errorf("%w: '%s' loot[%d] lootbox '%s' is not a defined item", ErrInvalidPack, pack.Name, i, pool.Lootbox))
+			}
+			for j, entry := range pool.Items {
+				if entry.ItemName != "" && !itemNames[entry.ItemName] {
+					errs = append(errs, fmt.Errorf("%w: '%s' loot[%d] item[%d] references non-existent item '%s'", ErrInvalidPack, pack.Name, i, j, entry.ItemName))
+				}
+			}
+		}
+
+		for _, name := range sortedKeys(pack.Aliases) {
+			if !itemNames[name] {
+				errs = append(errs, fmt.Errorf("%w: '%s' has aliases for non-existent item '%s'", ErrInvalidPack, pack.Name, name))
+			}
+		}
+
+		for _, recipe := range pack.Recipes {
+			if recipeKeys[recipe.RecipeKey] {
+				errs = append(errs, fmt.Errorf("%w: '%s' recipe '%s' is already in the recipe configs", ErrInvalidPack, pack.Name, recipe.RecipeKey))
+			}
+		}
+		if len(pack.Recipes) > 0 {
+			cfg := &crafting.Config{
+				UpgradeConfig:     &crafting.UpgradeConfig{Recipes: pack.Recipes},
+				DisassembleConfig: &crafting.DisassembleConfig{},
+			}
+			if err := crafting.ValidateRecipes(cfg, itemNames); err != nil {
+				errs = append(errs, fmt.Errorf("%w: '%s': %w", ErrInvalidPack, pack.Name, err))
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
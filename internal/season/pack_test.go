@@ -0,0 +1,78 @@
+package season
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/osse101/BrandishBot_Go/internal/crafting"
+	"github.com/osse101/BrandishBot_Go/internal/lootbox"
+)
+
+func TestLoadPacks(t *testing.T) {
+	t.Run("loads every pack in the directory", func(t *testing.T) {
+		packs, err := LoadPacks("testdata/packs")
+
+		require.NoError(t, err)
+		require.Len(t, packs, 2)
+		assert.Equal(t, "harvest", packs[0].Name)
+		assert.Equal(t, "harvest", packs[0].Recipes[0].EventTheme, "pack recipes are limited to their season")
+		assert.Equal(t, "winter", packs[1].Name)
+	})
+
+	t.Run("a missing directory has no packs", func(t *testing.T) {
+		packs, err := LoadPacks("testdata/missing")
+
+		require.NoError(t, err)
+		assert.Empty(t, packs)
+	})
+
+	t.Run("rejects overlapping seasons", func(t *testing.T) {
+		_, err := LoadPacks("testdata/overlap")
+
+		assert.ErrorIs(t, err, ErrInvalidPack)
+		assert.ErrorContains(t, err, "'winter' overlaps 'late_winter'")
+	})
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name string
+		pack Pack
+		want string
+	}{
+		{"bad date", Pack{Name: "spring", Start: "March 20", End: "04-10"}, "invalid date 'March 20'"},
+		{"empty pool", Pack{Name: "spring", Start: "03-20", End: "04-10", Loot: []LootPool{{Lootbox: "lootbox_tier0", Weight: 1}}}, "has no items"},
+		{"ambiguous entry", Pack{Name: "spring", Start: "03-20", End: "04-10", Loot: []LootPool{{Lootbox: "lootbox_tier0", Weight: 1, Items: []lootbox.PoolItemDef{{Weight: 1}}}}}, "exactly one of item_name or item_type"},
+		{"recipe for another theme", Pack{Name: "spring", Start: "03-20", End: "04-10", Recipes: []crafting.RecipeDef{{RecipeKey: "egg", EventTheme: "halloween"}}}, "has event_theme 'halloween'"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.ErrorContains(t, Validate([]Pack{tt.pack}), tt.want)
+		})
+	}
+}
+
+func TestValidateReferences(t *testing.T) {
+	packs, err := LoadPacks("testdata/packs")
+	require.NoError(t, err)
+	items := map[string]bool{"item_pumpkin": true, "item_pie": true, "lootbox_tier0": true, "lootbox_tier1": true}
+
+	require.NoError(t, ValidateReferences(packs, items, map[string]bool{"lootbox_tier0": true}))
+
+	err = ValidateReferences(packs, items, map[string]bool{"harvest_pie": true})
+	assert.ErrorContains(t, err, "recipe 'harvest_pie' is already in the recipe configs")
+
+	delete(items, "item_pie")
+	err = ValidateReferences(packs, items, nil)
+	assert.ErrorContains(t, err, "non-existent target_item 'item_pie'")
+}
+
+func TestPack_IsActive(t *testing.T) {
+	winter := Pack{Name: "winter", Start: "12-20", End: "01-10"}
+
+	assert.True(t, winter.IsActive(time.Date(2026, 1, 3, 0, 0, 0, 0, time.UTC)))
+	assert.False(t, winter.IsActive(time.Date(2026, 1, 11, 0, 0, 0, 0, time.UTC)))
+}
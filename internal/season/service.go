@@ -0,0 +1,139 @@
+package season
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/osse101/BrandishBot_Go/internal/event"
+	"github.com/osse101/BrandishBot_Go/internal/logger"
+	"github.com/osse101/BrandishBot_Go/internal/lootbox"
+	"github.com/osse101/BrandishBot_Go/internal/naming"
+)
+
+// Publisher publishes season events
+type Publisher interface {
+	PublishWithRetry(ctx context.Context, evt event.Event)
+}
+
+// Service tracks which season packs are running. Naming and crafting follow
+// the date on their own through the packs' themes; the loot cache is rebuilt
+// from the SeasonChanged event that Refresh publishes.
+type Service interface {
+	// Packs returns every loaded pack
+	Packs() []Pack
+
+	// ActivePacks returns the packs running now
+	ActivePacks() []Pack
+
+	// ActiveLootPools returns the lootbox pools of the packs running now
+	ActiveLootPools(ctx context.Context) []lootbox.SeasonalPool
+
+	// ThemePacks returns each pack as a naming theme, keyed by pack name
+	ThemePacks() map[string]naming.ThemePack
+
+	// Refresh compares the running packs with the last refresh and
+	// publishes a SeasonChanged event when any started or ended
+	Refresh(ctx context.Context) error
+}
+
+type service struct {
+	packs     []Pack
+	publisher Publisher
+	now       func() time.Time
+
+	mu     sync.Mutex
+	active map[string]bool // packs running at the last refresh
+}
+
+// NewService creates a season service. The packs running now count as
+// already started, since everything built at startup includes them.
+func NewService(packs []Pack, publisher Publisher) Service {
+	return newService(packs, publisher, time.Now)
+}
+
+func newService(packs []Pack, publisher Publisher, now func() time.Time) *service {
+	s := &service{
+		packs:     packs,
+		publisher: publisher,
+		now:       now,
+		active:    make(map[string]bool),
+	}
+	for _, pack := range s.ActivePacks() {
+		s.active[pack.Name] = true
+	}
+	return s
+}
+
+// Packs returns every loaded pack
+func (s *service) Packs() []Pack {
+	return s.packs
+}
+
+// ActivePacks returns the packs whose window contains today
+func (s *service) ActivePacks() []Pack {
+	now := s.now()
+	var active []Pack
+	for _, pack := range s.packs {
+		if pack.IsActive(now) {
+			active = append(active, pack)
+		}
+	}
+	return active
+}
+
+// ActiveLootPools returns a pool per lootbox per running pack
+func (s *service) ActiveLootPools(_ context.Context) []lootbox.SeasonalPool {
+	var pools []lootbox.SeasonalPool
+	for _, pack := range s.ActivePacks() {
+		for _, pool := range pack.Loot {
+			pools = append(pools, lootbox.SeasonalPool{
+				Lootbox:  pool.Lootbox,
+				PoolName: pack.PoolName(pool.Lootbox),
+				Weight:   pool.Weight,
+				Items:    pool.Items,
+			})
+		}
+	}
+	return pools
+}
+
+// ThemePacks returns the packs' periods and aliases for the naming resolver
+func (s *service) ThemePacks() map[string]naming.ThemePack {
+	themes := make(map[string]naming.ThemePack, len(s.packs))
+	for _, pack := range s.packs {
+		themes[pack.Name] = naming.ThemePack{Period: pack.Period(), Aliases: pack.Aliases}
+	}
+	return themes
+}
+
+// Refresh logs and publishes the packs that started or ended since the last
+// refresh
+func (s *service) Refresh(ctx context.Context) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	log := logger.FromContext(ctx)
+	active := make(map[string]bool)
+	var started, ended, names []string
+	for _, pack := range s.ActivePacks() {
+		active[pack.Name] = true
+		names = append(names, pack.Name)
+		if !s.active[pack.Name] {
+			started = append(started, pack.Name)
+			log.Info(LogMsgSeasonStarted, "season", pack.Name)
+		}
+	}
+	for _, pack := range s.packs {
+		if s.active[pack.Name] && !active[pack.Name] {
+			ended = append(ended, pack.Name)
+			log.Info(LogMsgSeasonEnded, "season", pack.Name)
+		}
+	}
+	s.active = active
+
+	if (len(started) > 0 || len(ended) > 0) && s.publisher != nil {
+		s.publisher.PublishWithRetry(ctx, event.NewSeasonChangedEvent(started, ended, names))
+	}
+	return nil
+}
@@ -0,0 +1,63 @@
+package season
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/osse101/BrandishBot_Go/internal/event"
+)
+
+type recordingPublisher struct {
+	events []event.Event
+}
+
+func (p *recordingPublisher) PublishWithRetry(_ context.Context, evt event.Event) {
+	p.events = append(p.events, evt)
+}
+
+func TestRefresh(t *testing.T) {
+	ctx := context.Background()
+	packs, err := LoadPacks("testdata/packs")
+	require.NoError(t, err)
+
+	now := time.Date(2026, 9, 19, 23, 59, 0, 0, time.UTC)
+	pub := &recordingPublisher{}
+	svc := newService(packs, pub, func() time.Time { return now })
+
+	require.NoError(t, svc.Refresh(ctx))
+	assert.Empty(t, pub.events, "nothing changed since startup")
+	assert.Empty(t, svc.ActiveLootPools(ctx))
+
+	now = now.Add(time.Minute)
+	require.NoError(t, svc.Refresh(ctx))
+	require.Len(t, pub.events, 1)
+	assert.Equal(t, event.SeasonChanged, pub.events[0].Type)
+	payload := pub.events[0].Payload.(event.SeasonChangedPayloadV1)
+	assert.Equal(t, []string{"harvest"}, payload.Started)
+	assert.Empty(t, payload.Ended)
+
+	pools := svc.ActiveLootPools(ctx)
+	require.Len(t, pools, 1)
+	assert.Equal(t, "lootbox_tier0", pools[0].Lootbox)
+	assert.Equal(t, "season_harvest_lootbox_tier0", pools[0].PoolName)
+
+	now = time.Date(2026, 10, 6, 0, 0, 0, 0, time.UTC)
+	require.NoError(t, svc.Refresh(ctx))
+	require.Len(t, pub.events, 2)
+	assert.Equal(t, []string{"harvest"}, pub.events[1].Payload.(event.SeasonChangedPayloadV1).Ended)
+}
+
+func TestThemePacks(t *testing.T) {
+	packs, err := LoadPacks("testdata/packs")
+	require.NoError(t, err)
+
+	themes := NewService(packs, nil).ThemePacks()
+
+	require.Contains(t, themes, "harvest")
+	assert.Equal(t, "09-20", themes["harvest"].Period.Start)
+	assert.Equal(t, []string{"plump pumpkin"}, themes["harvest"].Aliases["item_pumpkin"])
+}
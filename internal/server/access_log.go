@@ -0,0 +1,235 @@
+package server
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+
+	"github.com/osse101/BrandishBot_Go/internal/logger"
+	"github.com/osse101/BrandishBot_Go/internal/metrics"
+)
+
+// AccessLogConfig controls the optional HTTP access log
+type AccessLogConfig struct {
+	Enabled bool
+	// BodySampleRate is the fraction (0.0-1.0) of error responses whose
+	// redacted request body is included in the log entry
+	BodySampleRate float64
+	// MaxBodyBytes caps how much of the request body is captured
+	MaxBodyBytes int
+}
+
+// accessLogWriter captures the status code and response size
+type accessLogWriter struct {
+	http.ResponseWriter
+	statusCode int
+	bytes      int
+	written    bool
+}
+
+func (w *accessLogWriter) WriteHeader(statusCode int) {
+	if !w.written {
+		w.statusCode = statusCode
+		w.written = true
+	}
+	w.ResponseWriter.WriteHeader(statusCode)
+}
+
+func (w *accessLogWriter) Write(b []byte) (int, error) {
+	if !w.written {
+		w.WriteHeader(http.StatusOK)
+	}
+	n, err := w.ResponseWriter.Write(b)
+	w.bytes += n
+	return n, err
+}
+
+func (w *accessLogWriter) Flush() {
+	if f, ok := w.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+// accessLogIdentityFields are the request fields that identify the caller
+var accessLogIdentityFields = []string{AccessLogFieldUsername, AccessLogFieldPlatform, AccessLogFieldPlatformID}
+
+// capturedBody restores a partially read body while keeping the original Close
+type capturedBody struct {
+	io.Reader
+	io.Closer
+}
+
+// AccessLogMiddleware logs every API request with latency, status, and caller
+// identity, and counts it in the access metrics. For a sampled fraction of
+// error responses the redacted request body is logged as well. Returns a
+// pass-through middleware when disabled.
+func AccessLogMiddleware(cfg AccessLogConfig) func(http.Handler) http.Handler {
+	if !cfg.Enabled {
+		return func(next http.Handler) http.Handler { return next }
+	}
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if isQuietPath(r.URL.Path) {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			start := time.Now()
+			body := captureRequestBody(r, cfg.MaxBodyBytes)
+
+			rw := &accessLogWriter{ResponseWriter: w, statusCode: http.StatusOK}
+			next.ServeHTTP(rw, r)
+			duration := time.Since(start)
+
+			route := routePattern(r)
+			identity := extractIdentity(r, body)
+			status := strconv.Itoa(rw.statusCode)
+
+			metrics.HTTPAccessRequests.WithLabelValues(r.Method, route, status, identity[AccessLogFieldPlatform]).Inc()
+
+			fields := []any{
+				"method", r.Method,
+				"route", route,
+				"path", r.URL.Path,
+				"status", rw.statusCode,
+				"duration_ms", duration.Milliseconds(),
+				"response_bytes", rw.bytes,
+			}
+			for _, key := range accessLogIdentityFields {
+				if v := identity[key]; v != "" {
+					fields = append(fields, key, v)
+				}
+			}
+
+			if rw.statusCode >= http.StatusBadRequest && len(body) > 0 && rand.Float64() < cfg.BodySampleRate {
+				fields = append(fields, "request_body", redactBody(body))
+				metrics.HTTPErrorBodySamples.WithLabelValues(route, status).Inc()
+			}
+
+			log := logger.FromContext(r.Context())
+			switch {
+			case rw.statusCode >= http.StatusInternalServerError:
+				log.Error(LogMsgHTTPAccess, fields...)
+			case rw.statusCode >= http.StatusBadRequest:
+				log.Warn(LogMsgHTTPAccess, fields...)
+			default:
+				log.Info(LogMsgHTTPAccess, fields...)
+			}
+		})
+	}
+}
+
+// isQuietPath reports whether the path is excluded from request logging
+func isQuietPath(path string) bool {
+	for _, prefix := range PublicPaths {
+		if strings.HasPrefix(path, prefix) {
+			return true
+		}
+	}
+	for _, prefix := range QuietPaths {
+		if strings.HasPrefix(path, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// captureRequestBody reads up to maxBytes of the body and puts it back so the
+// handler still sees the full stream
+func captureRequestBody(r *http.Request, maxBytes int) []byte {
+	if r.Body == nil || r.Body == http.NoBody || maxBytes <= 0 {
+		return nil
+	}
+	buf, err := io.ReadAll(io.LimitReader(r.Body, int64(maxBytes)))
+	r.Body = capturedBody{Reader: io.MultiReader(bytes.NewReader(buf), r.Body), Closer: r.Body}
+	if err != nil {
+		return nil
+	}
+	return buf
+}
+
+// routePattern returns the chi route pattern so metrics are not labelled with
+// raw IDs from the URL
+func routePattern(r *http.Request) string {
+	if rctx := chi.RouteContext(r.Context()); rctx != nil {
+		if pattern := rctx.RoutePattern(); pattern != "" {
+			return pattern
+		}
+	}
+	return AccessLogUnmatchedRoute
+}
+
+// extractIdentity pulls the caller's platform identity from query parameters
+// or, failing that, from a JSON request body
+func extractIdentity(r *http.Request, body []byte) map[string]string {
+	identity := make(map[string]string, len(accessLogIdentityFields))
+	query := r.URL.Query()
+	for _, key := range accessLogIdentityFields {
+		identity[key] = query.Get(key)
+	}
+
+	if len(body) > 0 {
+		var payload map[string]any
+		if err := json.Unmarshal(body, &payload); err == nil {
+			for _, key := range accessLogIdentityFields {
+				if identity[key] != "" {
+					continue
+				}
+				if v, ok := payload[key].(string); ok {
+					identity[key] = v
+				}
+			}
+		}
+	}
+	return identity
+}
+
+// redactBody masks sensitive values in a JSON body. Bodies that are not JSON
+// cannot be redacted reliably and are omitted.
+func redactBody(body []byte) string {
+	var payload any
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return AccessLogBodyOmitted
+	}
+	data, _ := json.Marshal(redactValue(payload))
+	return string(data)
+}
+
+func redactValue(v any) any {
+	switch val := v.(type) {
+	case map[string]any:
+		for k, child := range val {
+			if isSensitiveKey(k) {
+				val[k] = RedactedValue
+			} else {
+				val[k] = redactValue(child)
+			}
+		}
+		return val
+	case []any:
+		for i, child := range val {
+			val[i] = redactValue(child)
+		}
+		return val
+	default:
+		return v
+	}
+}
+
+func isSensitiveKey(key string) bool {
+	lower := strings.ToLower(key)
+	for _, marker := range AccessLogSensitiveKeys {
+		if strings.Contains(lower, marker) {
+			return true
+		}
+	}
+	return false
+}
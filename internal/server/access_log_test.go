@@ -0,0 +1,126 @@
+package server
+
+import (
+	"bytes"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+)
+
+func captureLogs(t *testing.T) *bytes.Buffer {
+	t.Helper()
+	var buf bytes.Buffer
+	prev := slog.Default()
+	slog.SetDefault(slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug})))
+	t.Cleanup(func() { slog.SetDefault(prev) })
+	return &buf
+}
+
+func newAccessLogRouter(cfg AccessLogConfig, status int, gotBody *string) http.Handler {
+	r := chi.NewRouter()
+	r.Use(AccessLogMiddleware(cfg))
+	r.Post("/api/v1/user/item/{action}", func(w http.ResponseWriter, req *http.Request) {
+		data, _ := io.ReadAll(req.Body)
+		if gotBody != nil {
+			*gotBody = string(data)
+		}
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(`{"error":"nope"}`))
+	})
+	return r
+}
+
+func TestAccessLogMiddleware_LogsIdentityAndRoute(t *testing.T) {
+	buf := captureLogs(t)
+	var gotBody string
+	router := newAccessLogRouter(AccessLogConfig{Enabled: true, MaxBodyBytes: 1024}, http.StatusOK, &gotBody)
+
+	body := `{"platform":"twitch","platform_id":"123","username":"alice","item_name":"sword"}`
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/user/item/sell", strings.NewReader(body))
+	router.ServeHTTP(httptest.NewRecorder(), req)
+
+	if gotBody != body {
+		t.Errorf("Handler saw body %q, want %q", gotBody, body)
+	}
+
+	out := buf.String()
+	for _, want := range []string{LogMsgHTTPAccess, "route=/api/v1/user/item/{action}", "status=200", "username=alice", "platform=twitch", "platform_id=123"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("Log output missing %q: %s", want, out)
+		}
+	}
+	if strings.Contains(out, "request_body") {
+		t.Errorf("Successful request should not log body: %s", out)
+	}
+}
+
+func TestAccessLogMiddleware_SamplesRedactedErrorBodies(t *testing.T) {
+	buf := captureLogs(t)
+	router := newAccessLogRouter(AccessLogConfig{Enabled: true, BodySampleRate: 1, MaxBodyBytes: 1024}, http.StatusBadRequest, nil)
+
+	body := `{"username":"alice","token":"hunter2","nested":{"link_code":"ABC123"}}`
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/user/item/give", strings.NewReader(body))
+	router.ServeHTTP(httptest.NewRecorder(), req)
+
+	out := buf.String()
+	if !strings.Contains(out, "request_body") {
+		t.Fatalf("Error response should include sampled body: %s", out)
+	}
+	if strings.Contains(out, "hunter2") || strings.Contains(out, "ABC123") {
+		t.Errorf("SECURITY FAIL: sensitive values leaked into access log: %s", out)
+	}
+	if !strings.Contains(out, "level=WARN") {
+		t.Errorf("4xx should log at WARN: %s", out)
+	}
+}
+
+func TestAccessLogMiddleware_ZeroSampleRateSkipsBody(t *testing.T) {
+	buf := captureLogs(t)
+	router := newAccessLogRouter(AccessLogConfig{Enabled: true, BodySampleRate: 0, MaxBodyBytes: 1024}, http.StatusInternalServerError, nil)
+
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/user/item/give", strings.NewReader(`{"username":"alice"}`))
+	router.ServeHTTP(httptest.NewRecorder(), req)
+
+	out := buf.String()
+	if strings.Contains(out, "request_body") {
+		t.Errorf("Body should not be sampled at rate 0: %s", out)
+	}
+	if !strings.Contains(out, "level=ERROR") {
+		t.Errorf("5xx should log at ERROR: %s", out)
+	}
+}
+
+func TestAccessLogMiddleware_TruncatedBodyStillReachesHandler(t *testing.T) {
+	captureLogs(t)
+	var gotBody string
+	router := newAccessLogRouter(AccessLogConfig{Enabled: true, BodySampleRate: 1, MaxBodyBytes: 8}, http.StatusBadRequest, &gotBody)
+
+	body := `{"username":"alice","platform":"twitch"}`
+	router.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodPost, "/api/v1/user/item/give", strings.NewReader(body)))
+
+	if gotBody != body {
+		t.Errorf("Handler saw body %q, want %q", gotBody, body)
+	}
+}
+
+func TestAccessLogMiddleware_Disabled(t *testing.T) {
+	buf := captureLogs(t)
+	router := newAccessLogRouter(AccessLogConfig{Enabled: false}, http.StatusOK, nil)
+
+	router.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodPost, "/api/v1/user/item/sell", strings.NewReader(`{}`)))
+
+	if strings.Contains(buf.String(), LogMsgHTTPAccess) {
+		t.Errorf("Disabled access log should not log: %s", buf.String())
+	}
+}
+
+func TestRedactBody_NonJSON(t *testing.T) {
+	if got := redactBody([]byte("password=hunter2")); got != AccessLogBodyOmitted {
+		t.Errorf("redactBody(non-JSON) = %q, want %q", got, AccessLogBodyOmitted)
+	}
+}
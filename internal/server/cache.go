@@ -0,0 +1,66 @@
+package server
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/osse101/BrandishBot_Go/internal/dataversion"
+)
+
+// cacheHeaderWriter injects cache headers only when the wrapped handler
+// responds with a 2xx status, so errors are never cached by clients
+type cacheHeaderWriter struct {
+	http.ResponseWriter
+	headers     map[string]string
+	wroteHeader bool
+}
+
+func (w *cacheHeaderWriter) WriteHeader(statusCode int) {
+	if !w.wroteHeader {
+		w.wroteHeader = true
+		if statusCode >= 200 && statusCode < 300 {
+			for k, v := range w.headers {
+				w.Header().Set(k, v)
+			}
+		}
+	}
+	w.ResponseWriter.WriteHeader(statusCode)
+}
+
+func (w *cacheHeaderWriter) Write(b []byte) (int, error) {
+	if !w.wroteHeader {
+		w.WriteHeader(http.StatusOK)
+	}
+	return w.ResponseWriter.Write(b)
+}
+
+// CacheControlMiddleware marks successful responses as cacheable for maxAge and
+// tags them with the current data version of the given resource
+func CacheControlMiddleware(maxAge time.Duration, tracker *dataversion.Tracker, resource dataversion.Resource) func(http.Handler) http.Handler {
+	cacheControl := fmt.Sprintf(HeaderValueCacheControlFormat, int(maxAge.Seconds()))
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			headers := map[string]string{HeaderCacheControl: cacheControl}
+			if tracker != nil {
+				headers[HeaderDataVersion] = strconv.FormatInt(tracker.Version(resource), 10)
+			}
+			next.ServeHTTP(&cacheHeaderWriter{ResponseWriter: w, headers: headers}, r)
+		})
+	}
+}
+
+// DataVersionBumpMiddleware bumps the given resource counters after a
+// successful admin mutation so clients know to refetch
+func DataVersionBumpMiddleware(tracker *dataversion.Tracker, resources ...dataversion.Resource) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			rw := newResponseWriter(w)
+			next.ServeHTTP(rw, r)
+			if rw.statusCode >= 200 && rw.statusCode < 300 {
+				tracker.Bump(resources...)
+			}
+		})
+	}
+}
@@ -0,0 +1,77 @@
+package server
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/osse101/BrandishBot_Go/internal/dataversion"
+)
+
+func TestCacheControlMiddleware(t *testing.T) {
+	tracker := dataversion.NewTracker()
+	tracker.Bump(dataversion.ResourcePrices)
+
+	tests := []struct {
+		name        string
+		status      int
+		wantCache   string
+		wantVersion string
+	}{
+		{name: "success is cacheable", status: http.StatusOK, wantCache: "private, max-age=60", wantVersion: "2"},
+		{name: "error is not cacheable", status: http.StatusInternalServerError, wantCache: "", wantVersion: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handler := CacheControlMiddleware(time.Minute, tracker, dataversion.ResourcePrices)(
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					w.WriteHeader(tt.status)
+				}))
+
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, httptest.NewRequest("GET", "/api/v1/prices", nil))
+
+			if got := rec.Header().Get(HeaderCacheControl); got != tt.wantCache {
+				t.Errorf("Cache-Control = %q, want %q", got, tt.wantCache)
+			}
+			if got := rec.Header().Get(HeaderDataVersion); got != tt.wantVersion {
+				t.Errorf("X-Data-Version = %q, want %q", got, tt.wantVersion)
+			}
+		})
+	}
+}
+
+func TestCacheControlMiddleware_ImplicitOK(t *testing.T) {
+	handler := CacheControlMiddleware(5*time.Minute, nil, dataversion.ResourceTree)(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte("{}"))
+		}))
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest("GET", "/api/v1/progression/tree", nil))
+
+	if got := rec.Header().Get(HeaderCacheControl); got != "private, max-age=300" {
+		t.Errorf("Cache-Control = %q, want private, max-age=300", got)
+	}
+}
+
+func TestDataVersionBumpMiddleware(t *testing.T) {
+	tracker := dataversion.NewTracker()
+
+	for _, status := range []int{http.StatusOK, http.StatusBadRequest} {
+		handler := DataVersionBumpMiddleware(tracker, dataversion.ResourceTree)(
+			http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(status)
+			}))
+		handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest("POST", "/api/v1/progression/admin/unlock", nil))
+	}
+
+	if got := tracker.Version(dataversion.ResourceTree); got != 2 {
+		t.Errorf("tree version = %d, want 2 (only the successful request bumps)", got)
+	}
+	if got := tracker.Version(dataversion.ResourcePrices); got != 1 {
+		t.Errorf("prices version = %d, want 1", got)
+	}
+}
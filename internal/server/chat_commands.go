@@ -0,0 +1,217 @@
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/osse101/BrandishBot_Go/internal/chatcommand"
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/handler"
+	"github.com/osse101/BrandishBot_Go/internal/progression"
+)
+
+// chatCommands are the text commands chat users on platforms without slash
+// commands can run through /message/handle. Each one calls the API endpoint
+// the Discord command of the same name uses.
+func chatCommands() []chatcommand.Command {
+	return []chatcommand.Command{
+		{
+			Name: "inventory", Aliases: []string{"inv", "bag"}, Usage: "[filter]",
+			Method: http.MethodGet, Path: "/api/v1/user/inventory",
+			Query: func(s chatcommand.Sender, args []string) (url.Values, error) {
+				query := url.Values{"platform": {s.Platform}, "platform_id": {s.PlatformID}, "username": {s.Username}}
+				if len(args) > 0 {
+					query.Set("filter", strings.ToLower(args[0]))
+				}
+				return query, nil
+			},
+			Reply: inventoryReply,
+		},
+		{
+			Name: "search", Usage: "[item hint]", Feature: progression.FeatureSearch,
+			Method: http.MethodPost, Path: "/api/v1/user/search",
+			Body: func(s chatcommand.Sender, args []string) (interface{}, error) {
+				return handler.SearchRequest{Platform: s.Platform, PlatformID: s.PlatformID, Username: s.Username, ItemHint: strings.Join(args, " ")}, nil
+			},
+		},
+		{
+			Name: "buy", Usage: "<item> [quantity]", Feature: progression.FeatureEconomy, MinArgs: 1,
+			Method: http.MethodPost, Path: "/api/v1/user/item/buy",
+			Body: func(s chatcommand.Sender, args []string) (interface{}, error) {
+				item, quantity, err := chatcommand.ItemAndQuantity(args)
+				if err != nil {
+					return nil, err
+				}
+				return handler.BuyItemRequest{Platform: s.Platform, PlatformID: s.PlatformID, Username: s.Username, ItemName: item, Quantity: quantity}, nil
+			},
+		},
+		{
+			Name: "sell", Usage: "<item> [quantity]", Feature: progression.FeatureEconomy, MinArgs: 1,
+			Method: http.MethodPost, Path: "/api/v1/user/item/sell",
+			Body: func(s chatcommand.Sender, args []string) (interface{}, error) {
+				item, quantity, err := chatcommand.ItemAndQuantity(args)
+				if err != nil {
+					return nil, err
+				}
+				return handler.SellItemRequest{Platform: s.Platform, PlatformID: s.PlatformID, Username: s.Username, ItemName: item, Quantity: quantity}, nil
+			},
+		},
+		{
+			Name: "use", Usage: "<item> [quantity] [@target]", MinArgs: 1,
+			Method: http.MethodPost, Path: "/api/v1/user/item/use",
+			Body: func(s chatcommand.Sender, args []string) (interface{}, error) {
+				rest, mentions := chatcommand.SplitMentions(args)
+				item, quantity, err := chatcommand.ItemAndQuantity(rest)
+				if err != nil {
+					return nil, err
+				}
+				req := handler.UseItemRequest{Platform: s.Platform, PlatformID: s.PlatformID, Username: s.Username, ItemName: item, Quantity: quantity}
+				if len(mentions) > 0 {
+					req.TargetUser = mentions[0]
+				}
+				return req, nil
+			},
+		},
+		{
+			Name: "give", Usage: "<@user> <item> [quantity]", MinArgs: 2,
+			Method: http.MethodPost, Path: "/api/v1/user/item/give",
+			Body: func(s chatcommand.Sender, args []string) (interface{}, error) {
+				item, quantity, err := chatcommand.ItemAndQuantity(args[1:])
+				if err != nil {
+					return nil, err
+				}
+				return handler.GiveItemRequest{
+					OwnerPlatform: s.Platform, OwnerPlatformID: s.PlatformID, Owner: s.Username,
+					ReceiverPlatform: s.Platform, Receiver: chatcommand.Username(args[0]),
+					ItemName: item, Quantity: quantity,
+				}, nil
+			},
+		},
+		{
+			Name: "upgrade", Aliases: []string{"craft"}, Usage: "<item> [quantity]", Feature: progression.FeatureUpgrade, MinArgs: 1,
+			Method: http.MethodPost, Path: "/api/v1/user/item/upgrade",
+			Body: craftingBody,
+		},
+		{
+			Name: "disassemble", Usage: "<item> [quantity]", Feature: progression.FeatureDisassemble, MinArgs: 1,
+			Method: http.MethodPost, Path: "/api/v1/user/item/disassemble",
+			Body: craftingBody,
+		},
+		{
+			Name: "donate", Usage: "<item> [quantity]", MinArgs: 1,
+			Method: http.MethodPost, Path: "/api/v1/community/donate",
+			Body: func(s chatcommand.Sender, args []string) (interface{}, error) {
+				item, quantity, err := chatcommand.ItemAndQuantity(args)
+				if err != nil {
+					return nil, err
+				}
+				return handler.DonateRequest{Platform: s.Platform, PlatformID: s.PlatformID, Username: s.Username, ItemName: item, Quantity: quantity}, nil
+			},
+			Reply: func(body []byte) string {
+				var donation domain.CommunityDonation
+				if err := json.Unmarshal(body, &donation); err != nil || donation.ItemName == "" {
+					return ""
+				}
+				return fmt.Sprintf(ChatReplyDonatedFormat, donation.Quantity, donation.ItemName, donation.Points)
+			},
+		},
+		{
+			Name: "gamble", Usage: "<lootbox> [quantity]", Feature: progression.FeatureGamble, MinArgs: 1,
+			Method: http.MethodPost, Path: "/api/v1/gamble/start",
+			Body: func(s chatcommand.Sender, args []string) (interface{}, error) {
+				item, quantity, err := chatcommand.ItemAndQuantity(args)
+				if err != nil {
+					return nil, err
+				}
+				return handler.StartGambleRequest{Platform: s.Platform, PlatformID: s.PlatformID, Username: s.Username, Bets: []domain.LootboxBet{{ItemName: item, Quantity: quantity}}}, nil
+			},
+		},
+		{
+			Name: "joingamble", Aliases: []string{"join"}, Feature: progression.FeatureGamble,
+			Method: http.MethodPost, Path: "/api/v1/gamble/join",
+			Body: func(s chatcommand.Sender, _ []string) (interface{}, error) {
+				return handler.JoinGambleRequest{Platform: s.Platform, PlatformID: s.PlatformID, Username: s.Username}, nil
+			},
+		},
+		{
+			Name: "slots", Usage: "<bet>", Feature: progression.FeatureSlots, MinArgs: 1,
+			Method: http.MethodPost, Path: "/api/v1/slots/spin",
+			Body: func(s chatcommand.Sender, args []string) (interface{}, error) {
+				bet, err := chatcommand.Int("bet", args[0])
+				if err != nil {
+					return nil, err
+				}
+				return handler.SpinSlotsRequest{Platform: s.Platform, PlatformID: s.PlatformID, Username: s.Username, BetAmount: bet}, nil
+			},
+		},
+		{
+			Name: "expedition", Aliases: []string{"explore"}, Feature: progression.FeatureExpedition,
+			Method: http.MethodPost, Path: "/api/v1/expedition/join",
+			Body: func(s chatcommand.Sender, _ []string) (interface{}, error) {
+				return handler.JoinExpeditionRequest{Platform: s.Platform, PlatformID: s.PlatformID, Username: s.Username}, nil
+			},
+		},
+		{
+			Name:   "harvest",
+			Method: http.MethodPost, Path: "/api/v1/harvest",
+			Body: func(s chatcommand.Sender, _ []string) (interface{}, error) {
+				return handler.HarvestRewardsRequest{Platform: s.Platform, PlatformID: s.PlatformID, Username: s.Username}, nil
+			},
+		},
+		{
+			Name: "vote", Usage: "<option>", MinArgs: 1,
+			Method: http.MethodPost, Path: "/api/v1/progression/vote",
+			Body: func(s chatcommand.Sender, args []string) (interface{}, error) {
+				option, err := chatcommand.Int("option", args[0])
+				if err != nil {
+					return nil, err
+				}
+				return handler.VoteRequest{Platform: s.Platform, PlatformID: s.PlatformID, Username: s.Username, OptionIndex: option}, nil
+			},
+		},
+		{
+			Name:   "undo",
+			Method: http.MethodPost, Path: "/api/v1/user/undo",
+			Body: func(s chatcommand.Sender, _ []string) (interface{}, error) {
+				return handler.UndoRequest{Platform: s.Platform, PlatformID: s.PlatformID}, nil
+			},
+			Reply: func([]byte) string { return ChatReplyUndone },
+		},
+	}
+}
+
+func craftingBody(s chatcommand.Sender, args []string) (interface{}, error) {
+	item, quantity, err := chatcommand.ItemAndQuantity(args)
+	if err != nil {
+		return nil, err
+	}
+	return handler.CraftingActionRequest{Platform: s.Platform, PlatformID: s.PlatformID, Username: s.Username, Item: item, Quantity: quantity}, nil
+}
+
+// inventoryReply lists the items by public name in the order returned, cut
+// short to fit in a chat message
+func inventoryReply(body []byte) string {
+	var inv handler.GetInventoryResponse
+	if err := json.Unmarshal(body, &inv); err != nil {
+		return ""
+	}
+	if len(inv.Items) == 0 {
+		return ChatReplyInventoryEmpty
+	}
+	parts := make([]string, 0, ChatInventoryReplyMaxItems+1)
+	for i, item := range inv.Items {
+		if i == ChatInventoryReplyMaxItems {
+			parts = append(parts, fmt.Sprintf(ChatReplyInventoryMore, len(inv.Items)-i))
+			break
+		}
+		name := item.PublicName
+		if name == "" {
+			name = item.InternalName
+		}
+		parts = append(parts, fmt.Sprintf("%s x%d", name, item.Quantity))
+	}
+	return strings.Join(parts, ", ")
+}
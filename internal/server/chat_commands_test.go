@@ -0,0 +1,49 @@
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/osse101/BrandishBot_Go/internal/chatcommand"
+	"github.com/osse101/BrandishBot_Go/internal/handler"
+	"github.com/osse101/BrandishBot_Go/internal/user"
+)
+
+func TestChatCommands_NamesUnique(t *testing.T) {
+	seen := map[string]string{chatcommand.CommandHelp: chatcommand.CommandHelp}
+	for _, cmd := range chatCommands() {
+		for _, name := range append([]string{cmd.Name}, cmd.Aliases...) {
+			owner, dup := seen[name]
+			assert.False(t, dup, "%q of %s already used by %s", name, cmd.Name, owner)
+			seen[name] = cmd.Name
+		}
+		assert.NotEmpty(t, cmd.Path, cmd.Name)
+		assert.True(t, cmd.Body != nil || cmd.Query != nil, "%s builds no request", cmd.Name)
+	}
+}
+
+func TestInventoryReply(t *testing.T) {
+	empty, err := json.Marshal(handler.GetInventoryResponse{})
+	require.NoError(t, err)
+	assert.Equal(t, ChatReplyInventoryEmpty, inventoryReply(empty))
+
+	inv := handler.GetInventoryResponse{Items: []user.InventoryItem{
+		{InternalName: "weapon_missile", PublicName: "missile", Quantity: 2},
+		{InternalName: "lootbox_tier0", Quantity: 1},
+	}}
+	body, err := json.Marshal(inv)
+	require.NoError(t, err)
+	assert.Equal(t, "missile x2, lootbox_tier0 x1", inventoryReply(body))
+
+	inv.Items = nil
+	for i := 0; i < ChatInventoryReplyMaxItems+3; i++ {
+		inv.Items = append(inv.Items, user.InventoryItem{PublicName: fmt.Sprintf("item%d", i), Quantity: 1})
+	}
+	body, err = json.Marshal(inv)
+	require.NoError(t, err)
+	assert.Contains(t, inventoryReply(body), fmt.Sprintf(ChatReplyInventoryMore, 3))
+}
@@ -1,9 +1,13 @@
 package server
 
+import "time"
+
 // HTTP error messages for middleware responses
 const (
-	ErrMsgUnauthorized    = "Unauthorized"
-	ErrMsgTooManyRequests = "Too Many Requests"
+	ErrMsgUnauthorized     = "Unauthorized"
+	ErrMsgForbidden        = "Forbidden"
+	ErrMsgTooManyRequests  = "Too Many Requests"
+	ErrMsgUnknownCommunity = "Unknown community"
 )
 
 // Security alert message templates
@@ -14,13 +18,45 @@ const (
 
 // Log messages for server lifecycle and request handling
 const (
-	LogMsgServerStarting   = "Server starting"
-	LogMsgRequestStarted   = "Request started"
-	LogMsgRequestCompleted = "Request completed"
-	LogMsgRequestHeaders   = "Request headers"
-	LogMsgAuthFailed       = "Authentication failed"
+	LogMsgServerStarting       = "Server starting"
+	LogMsgRequestStarted       = "Request started"
+	LogMsgRequestCompleted     = "Request completed"
+	LogMsgRequestHeaders       = "Request headers"
+	LogMsgAuthFailed           = "Authentication failed"
+	LogMsgScopedKeyOutOfScope  = "Scoped API key used outside its scope"
+	LogMsgAPITokenOutOfScope   = "Guild API token used outside its scope"
+	LogMsgCommunityKeyAdmin    = "Community API key used on an admin path"
+	LogMsgHTTPAccess           = "HTTP access"
+	LogMsgFrozenCheckFailed    = "Failed to check account freeze"
+	LogMsgFrozenRequestRefused = "Refused economy request from frozen account"
+	LogMsgTimeoutCheckFailed   = "Failed to check user timeout"
+	LogMsgTimedOutRequest      = "Refused request from timed out user"
+)
+
+// Access log fields and values
+const (
+	AccessLogFieldUsername   = "username"
+	AccessLogFieldPlatform   = "platform"
+	AccessLogFieldPlatformID = "platform_id"
+	AccessLogUnmatchedRoute  = "unmatched"
+	AccessLogBodyOmitted     = "[non-JSON body omitted]"
 )
 
+// Request fields naming the giver on give requests
+const (
+	IdentityFieldOwner           = "owner"
+	IdentityFieldOwnerPlatform   = "owner_platform"
+	IdentityFieldOwnerPlatformID = "owner_platform_id"
+)
+
+// IdentityMaxBodyBytes caps how much of a request body the freeze and
+// timeout checks read to find the caller
+const IdentityMaxBodyBytes = 64 * 1024
+
+// AccessLogSensitiveKeys are substrings of JSON keys whose values are redacted
+// from sampled request bodies
+var AccessLogSensitiveKeys = []string{"password", "secret", "token", "api_key", "apikey", "authorization", "code"}
+
 // HTTP header names
 const (
 	HeaderAPIKey         = "X-API-Key" // #nosec G101
@@ -30,6 +66,21 @@ const (
 	HeaderFrameOptions   = "X-Frame-Options"
 	HeaderXSSProtection  = "X-XSS-Protection"
 	HeaderReferrerPolicy = "Referrer-Policy"
+	HeaderCacheControl   = "Cache-Control"
+	HeaderDataVersion    = "X-Data-Version"
+	HeaderCommunityID    = "X-Community-ID"
+)
+
+// Cache-Control value for semi-static endpoints. Responses are private because
+// they are served behind API key authentication.
+const HeaderValueCacheControlFormat = "private, max-age=%d"
+
+// Client cache lifetimes for semi-static endpoints. Clients should also watch
+// the data_versions counters on /version to refetch early after admin changes.
+const (
+	CacheMaxAgePrices  = 60 * time.Second
+	CacheMaxAgeRecipes = 5 * time.Minute
+	CacheMaxAgeTree    = 5 * time.Minute
 )
 
 // Security header values
@@ -40,6 +91,39 @@ const (
 	HeaderValueReferrerStrictOrigin = "strict-origin-when-cross-origin"
 )
 
+// ScopedKeyPaths are the only paths scoped API keys may call
+var ScopedKeyPaths = []string{
+	"/api/v1/progression/contribution/external",
+}
+
+// CommunityKeyDeniedPrefix is the path prefix community API keys may not
+// call. Admin endpoints manage the whole deployment, not one community.
+const CommunityKeyDeniedPrefix = "/api/v1/admin"
+
+// APITokenPaths are the only paths guild API tokens may call, and only with
+// GET. They serve community-wide data; per-user reads are left out because
+// they can register users.
+var APITokenPaths = []string{
+	"/api/v1/info",
+	"/api/v1/recipes",
+	"/api/v1/prices",
+	"/api/v1/prices/buy",
+	"/api/v1/prices/history",
+	"/api/v1/items/balance-changes",
+	"/api/v1/community/pool",
+	"/api/v1/community/donors",
+	"/api/v1/jobs",
+	"/api/v1/quests/active",
+	"/api/v1/stats/system",
+	"/api/v1/stats/leaderboard",
+	"/api/v1/progression/tree",
+	"/api/v1/progression/status",
+	"/api/v1/progression/leaderboard",
+	"/api/v1/progression/session",
+	"/api/v1/progression/sessions/history",
+	"/api/v1/progression/unlock-progress",
+}
+
 // Public path prefixes that bypass authentication
 var PublicPaths = []string{
 	"/swagger/",
@@ -61,3 +145,12 @@ var QuietPaths = []string{
 const (
 	RedactedValue = "[REDACTED]"
 )
+
+// Chat command replies for endpoints whose responses carry no message
+const (
+	ChatReplyInventoryEmpty    = "Your inventory is empty"
+	ChatReplyInventoryMore     = "+%d more"
+	ChatReplyDonatedFormat     = "Donated %dx %s to the community pool for %d contribution points"
+	ChatReplyUndone            = "Undone"
+	ChatInventoryReplyMaxItems = 15
+)
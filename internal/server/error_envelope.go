@@ -0,0 +1,83 @@
+package server
+
+import (
+	"bytes"
+	"net/http"
+	"strings"
+
+	"github.com/osse101/BrandishBot_Go/internal/handler"
+	"github.com/osse101/BrandishBot_Go/internal/logger"
+)
+
+// RequestIDMiddleware gives every request an ID. The ID is stored in the
+// context for logging and sent back in the X-Request-ID header, where error
+// responses pick it up for their request_id field.
+func RequestIDMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requestID := logger.GenerateRequestID()
+		w.Header().Set(handler.HeaderRequestID, requestID)
+		next.ServeHTTP(w, r.WithContext(logger.WithRequestID(r.Context(), requestID)))
+	})
+}
+
+// envelopeWriter holds back non-JSON error bodies so they can be replaced
+// with the standard error envelope
+type envelopeWriter struct {
+	http.ResponseWriter
+	status      int
+	wroteHeader bool
+	rewrite     bool
+	body        bytes.Buffer
+}
+
+func (w *envelopeWriter) WriteHeader(status int) {
+	if w.wroteHeader {
+		return
+	}
+	w.wroteHeader = true
+	w.status = status
+	if status >= http.StatusBadRequest && !strings.HasPrefix(w.Header().Get("Content-Type"), "application/json") {
+		w.rewrite = true
+		return
+	}
+	w.ResponseWriter.WriteHeader(status)
+}
+
+func (w *envelopeWriter) Write(b []byte) (int, error) {
+	if !w.wroteHeader {
+		w.WriteHeader(http.StatusOK)
+	}
+	if w.rewrite {
+		return w.body.Write(b)
+	}
+	return w.ResponseWriter.Write(b)
+}
+
+func (w *envelopeWriter) Flush() {
+	if w.rewrite {
+		return
+	}
+	if f, ok := w.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+// ErrorEnvelopeMiddleware converts plain-text error responses, such as those
+// written by http.Error in middleware or libraries, into the JSON error
+// envelope so clients only ever have to parse one error format
+func ErrorEnvelopeMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ew := &envelopeWriter{ResponseWriter: w}
+		next.ServeHTTP(ew, r)
+		if !ew.rewrite {
+			return
+		}
+
+		message := strings.TrimSpace(ew.body.String())
+		if message == "" {
+			message = http.StatusText(ew.status)
+		}
+		w.Header().Del("Content-Length")
+		handler.RespondError(w, ew.status, message)
+	})
+}
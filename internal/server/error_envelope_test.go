@@ -0,0 +1,86 @@
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/osse101/BrandishBot_Go/internal/handler"
+	"github.com/osse101/BrandishBot_Go/internal/logger"
+)
+
+func TestRequestIDMiddleware(t *testing.T) {
+	var ctxID string
+	h := RequestIDMiddleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctxID = logger.GetRequestID(r.Context())
+	}))
+
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/v1/prices", nil))
+
+	headerID := rec.Header().Get(handler.HeaderRequestID)
+	if headerID == "" {
+		t.Fatal("expected request ID header")
+	}
+	if ctxID != headerID {
+		t.Errorf("context request ID %q does not match header %q", ctxID, headerID)
+	}
+}
+
+func TestErrorEnvelopeMiddleware_WrapsPlainTextErrors(t *testing.T) {
+	h := RequestIDMiddleware(ErrorEnvelopeMiddleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "SSE not supported", http.StatusInternalServerError)
+	})))
+
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/v1/events", nil))
+
+	if rec.Code != http.StatusInternalServerError {
+		t.Errorf("expected status 500, got %d", rec.Code)
+	}
+	if got := rec.Header().Get("Content-Type"); got != "application/json" {
+		t.Errorf("expected JSON content type, got %q", got)
+	}
+	var body handler.ErrorResponse
+	if err := json.Unmarshal(rec.Body.Bytes(), &body); err != nil {
+		t.Fatalf("expected JSON body, got %q: %v", rec.Body.String(), err)
+	}
+	if body.Code != handler.ErrCodeInternal || body.Message != "SSE not supported" {
+		t.Errorf("unexpected body %+v", body)
+	}
+	if body.RequestID == "" || body.RequestID != rec.Header().Get(handler.HeaderRequestID) {
+		t.Errorf("expected request_id to match header, got %q", body.RequestID)
+	}
+}
+
+func TestErrorEnvelopeMiddleware_PassesThroughJSON(t *testing.T) {
+	h := ErrorEnvelopeMiddleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		handler.RespondErrorCode(w, http.StatusBadRequest, handler.ErrCodeItemNotFound, handler.ErrMsgItemNotFoundError, nil)
+	}))
+
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/api/v1/user/item/sell", nil))
+
+	var body handler.ErrorResponse
+	if err := json.Unmarshal(rec.Body.Bytes(), &body); err != nil {
+		t.Fatalf("expected JSON body, got %q: %v", rec.Body.String(), err)
+	}
+	if body.Code != handler.ErrCodeItemNotFound {
+		t.Errorf("expected code to be kept, got %q", body.Code)
+	}
+}
+
+func TestErrorEnvelopeMiddleware_PassesThroughSuccess(t *testing.T) {
+	h := ErrorEnvelopeMiddleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/plain")
+		_, _ = w.Write([]byte("ok"))
+	}))
+
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/healthz", nil))
+
+	if rec.Code != http.StatusOK || rec.Body.String() != "ok" {
+		t.Errorf("expected untouched response, got %d %q", rec.Code, rec.Body.String())
+	}
+}
@@ -0,0 +1,23 @@
+package server
+
+import (
+	"net/http"
+
+	"github.com/osse101/BrandishBot_Go/internal/handler"
+)
+
+// FeatureFlagMiddleware hides a route behind an admin feature flag. The
+// caller is read like FrozenAccountMiddleware reads it, so percentage
+// rollouts pick the same users on every request. A flag that is off, or
+// unknown, answers 403.
+func FeatureFlagMiddleware(flags handler.FeatureFlagChecker, key string, maxBodyBytes int) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			platform, platformID := requestIdentity(r, captureRequestBody(r, maxBodyBytes), platformIDIdentityFields)
+			if handler.CheckFeatureFlagDisabled(w, r, flags, key, platform, platformID) {
+				return
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
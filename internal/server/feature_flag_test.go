@@ -0,0 +1,63 @@
+package server
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+type fakeFlagChecker struct {
+	enabled map[string]bool
+}
+
+func (f *fakeFlagChecker) IsEnabled(_ context.Context, key, platform, platformID string) bool {
+	return f.enabled[key+":"+platform+":"+platformID]
+}
+
+func TestFeatureFlagMiddleware(t *testing.T) {
+	flags := &fakeFlagChecker{enabled: map[string]bool{"new_mode:twitch:123": true}}
+
+	serve := func(req *http.Request) (*httptest.ResponseRecorder, string) {
+		var gotBody string
+		h := FeatureFlagMiddleware(flags, "new_mode", IdentityMaxBodyBytes)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			data, _ := io.ReadAll(r.Body)
+			gotBody = string(data)
+			w.WriteHeader(http.StatusOK)
+		}))
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, req)
+		return rec, gotBody
+	}
+
+	t.Run("lets a user with the flag on through with the body intact", func(t *testing.T) {
+		body := `{"platform":"twitch","platform_id":"123"}`
+		rec, got := serve(httptest.NewRequest(http.MethodPost, "/api/v1/gamble/start", strings.NewReader(body)))
+
+		if rec.Code != http.StatusOK {
+			t.Errorf("status = %d, want %d", rec.Code, http.StatusOK)
+		}
+		if got != body {
+			t.Errorf("body = %q, want %q", got, body)
+		}
+	})
+
+	t.Run("refuses a user with the flag off", func(t *testing.T) {
+		rec, _ := serve(httptest.NewRequest(http.MethodPost, "/api/v1/gamble/start",
+			strings.NewReader(`{"platform":"twitch","platform_id":"456"}`)))
+
+		if rec.Code != http.StatusForbidden {
+			t.Errorf("status = %d, want %d", rec.Code, http.StatusForbidden)
+		}
+	})
+
+	t.Run("reads the caller from the query", func(t *testing.T) {
+		rec, _ := serve(httptest.NewRequest(http.MethodGet, "/api/v1/gamble/get?platform=twitch&platform_id=123", nil))
+
+		if rec.Code != http.StatusOK {
+			t.Errorf("status = %d, want %d", rec.Code, http.StatusOK)
+		}
+	})
+}
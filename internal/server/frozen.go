@@ -0,0 +1,75 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/osse101/BrandishBot_Go/internal/handler"
+	"github.com/osse101/BrandishBot_Go/internal/logger"
+)
+
+// FrozenChecker reports whether a platform identity belongs to a frozen account
+type FrozenChecker interface {
+	IsFrozen(ctx context.Context, platform, platformID string) (bool, error)
+}
+
+// platformIDIdentityFields are the platform/ID field pairs that name the acting
+// user, checked in order. Gives name the giver as the owner.
+var platformIDIdentityFields = [][2]string{
+	{AccessLogFieldPlatform, AccessLogFieldPlatformID},
+	{IdentityFieldOwnerPlatform, IdentityFieldOwnerPlatformID},
+}
+
+// FrozenAccountMiddleware refuses economy requests from frozen accounts with
+// 403. The caller is read from query parameters or the JSON body. A failed
+// lookup is logged and the request let through, so an outage in the freeze
+// check does not take the economy down with it.
+func FrozenAccountMiddleware(checker FrozenChecker, maxBodyBytes int) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			platform, platformID := requestIdentity(r, captureRequestBody(r, maxBodyBytes), platformIDIdentityFields)
+			if platform == "" || platformID == "" {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			frozen, err := checker.IsFrozen(r.Context(), platform, platformID)
+			if err != nil {
+				logger.FromContext(r.Context()).Error(LogMsgFrozenCheckFailed, "error", err, "platform", platform, "platform_id", platformID)
+				next.ServeHTTP(w, r)
+				return
+			}
+			if frozen {
+				logger.FromContext(r.Context()).Info(LogMsgFrozenRequestRefused, "platform", platform, "platform_id", platformID, "path", r.URL.Path)
+				handler.RespondError(w, http.StatusForbidden, handler.ErrMsgAccountFrozenError)
+				return
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// requestIdentity returns the first platform/identifier field pair set in
+// the query or JSON body
+func requestIdentity(r *http.Request, body []byte, fields [][2]string) (string, string) {
+	query := r.URL.Query()
+	var payload map[string]any
+	if len(body) > 0 {
+		_ = json.Unmarshal(body, &payload)
+	}
+	field := func(key string) string {
+		if v := query.Get(key); v != "" {
+			return v
+		}
+		v, _ := payload[key].(string)
+		return v
+	}
+
+	for _, pair := range fields {
+		if platform, id := field(pair[0]), field(pair[1]); platform != "" && id != "" {
+			return platform, id
+		}
+	}
+	return "", ""
+}
@@ -0,0 +1,103 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+type fakeFrozenChecker struct {
+	frozen map[string]bool
+	err    error
+	calls  int
+}
+
+func (f *fakeFrozenChecker) IsFrozen(_ context.Context, platform, platformID string) (bool, error) {
+	f.calls++
+	return f.frozen[platform+":"+platformID], f.err
+}
+
+func serveFrozen(checker FrozenChecker, req *http.Request) (*httptest.ResponseRecorder, string) {
+	var gotBody string
+	h := FrozenAccountMiddleware(checker, IdentityMaxBodyBytes)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		data, _ := io.ReadAll(r.Body)
+		gotBody = string(data)
+		w.WriteHeader(http.StatusOK)
+	}))
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	return rec, gotBody
+}
+
+func TestFrozenAccountMiddleware(t *testing.T) {
+	checker := &fakeFrozenChecker{frozen: map[string]bool{"twitch:123": true}}
+
+	t.Run("refuses a frozen caller named in the body", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/user/item/sell",
+			strings.NewReader(`{"platform":"twitch","platform_id":"123","item_name":"money"}`))
+		rec, _ := serveFrozen(checker, req)
+
+		if rec.Code != http.StatusForbidden {
+			t.Errorf("status = %d, want %d", rec.Code, http.StatusForbidden)
+		}
+	})
+
+	t.Run("refuses a frozen giver", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/user/item/give",
+			strings.NewReader(`{"owner_platform":"twitch","owner_platform_id":"123","receiver_platform":"twitch","receiver":"bob"}`))
+		rec, _ := serveFrozen(checker, req)
+
+		if rec.Code != http.StatusForbidden {
+			t.Errorf("status = %d, want %d", rec.Code, http.StatusForbidden)
+		}
+	})
+
+	t.Run("lets other callers through with the body intact", func(t *testing.T) {
+		body := `{"platform":"twitch","platform_id":"456"}`
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/user/item/sell", strings.NewReader(body))
+		rec, gotBody := serveFrozen(checker, req)
+
+		if rec.Code != http.StatusOK {
+			t.Errorf("status = %d, want %d", rec.Code, http.StatusOK)
+		}
+		if gotBody != body {
+			t.Errorf("handler body = %q, want %q", gotBody, body)
+		}
+	})
+
+	t.Run("reads the caller from the query", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/harvest?platform=twitch&platform_id=123", nil)
+		rec, _ := serveFrozen(checker, req)
+
+		if rec.Code != http.StatusForbidden {
+			t.Errorf("status = %d, want %d", rec.Code, http.StatusForbidden)
+		}
+	})
+
+	t.Run("skips the check without an identity", func(t *testing.T) {
+		counting := &fakeFrozenChecker{}
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/harvest", strings.NewReader(`{"username":"alice"}`))
+		rec, _ := serveFrozen(counting, req)
+
+		if rec.Code != http.StatusOK {
+			t.Errorf("status = %d, want %d", rec.Code, http.StatusOK)
+		}
+		if counting.calls != 0 {
+			t.Errorf("checker called %d times, want 0", counting.calls)
+		}
+	})
+
+	t.Run("fails open when the check errors", func(t *testing.T) {
+		failing := &fakeFrozenChecker{err: errors.New("db down")}
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/harvest?platform=twitch&platform_id=123", nil)
+		rec, _ := serveFrozen(failing, req)
+
+		if rec.Code != http.StatusOK {
+			t.Errorf("status = %d, want %d", rec.Code, http.StatusOK)
+		}
+	})
+}
@@ -1,6 +1,7 @@
 package server
 
 import (
+	"context"
 	"crypto/subtle"
 	"log/slog"
 	"net"
@@ -9,11 +10,46 @@ import (
 	"sync"
 	"time"
 
+	"github.com/osse101/BrandishBot_Go/internal/community"
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/handler"
 	"github.com/osse101/BrandishBot_Go/internal/logger"
 )
 
-// AuthMiddleware validates API key
-func AuthMiddleware(apiKey string, trustedProxies []string, detector *SuspiciousActivityDetector) func(http.Handler) http.Handler {
+// ScopedAPIKey is an API key issued to an external system. It is only accepted
+// on ScopedKeyPaths and binds the caller to one contribution source.
+type ScopedAPIKey struct {
+	Key       string
+	Source    string
+	HourlyCap int // 0 means uncapped
+}
+
+// CommunityAPIKey is an API key pinned to one community. It may call every
+// non-admin endpoint, and only sees that community's state.
+type CommunityAPIKey struct {
+	Key       string
+	Community string
+}
+
+// TokenAuthenticator resolves guild API tokens
+type TokenAuthenticator interface {
+	// Authenticate returns the active token matching key, or nil if there is none
+	Authenticate(ctx context.Context, key string) (*domain.APIToken, error)
+}
+
+// AuthMiddleware validates the main API key, a community key, a scoped key on
+// the paths scoped keys may use, or a guild API token on APITokenPaths. tokens
+// may be nil.
+//
+// Community keys scope the request to their community. Main key callers may
+// pick any served community with the X-Community-ID header; everyone else
+// uses the default community.
+func AuthMiddleware(apiKey string, trustedProxies []string, detector *SuspiciousActivityDetector, tokens TokenAuthenticator, communityKeys []CommunityAPIKey, scopedKeys ...ScopedAPIKey) func(http.Handler) http.Handler {
+	communities := map[string]bool{community.Default: true}
+	for _, k := range communityKeys {
+		communities[k.Community] = true
+	}
+
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			// Allow public access to documentation and health check endpoints
@@ -28,26 +64,136 @@ func AuthMiddleware(apiKey string, trustedProxies []string, detector *Suspicious
 			providedKey := r.Header.Get(HeaderAPIKey)
 
 			// Use constant time comparison to prevent timing attacks
-			if subtle.ConstantTimeCompare([]byte(providedKey), []byte(apiKey)) != 1 {
-				ip := extractIP(r, trustedProxies)
-				detector.RecordFailedAuth(ip)
-
-				log := logger.FromContext(r.Context())
-				log.Warn(LogMsgAuthFailed,
-					"remote_addr", r.RemoteAddr,
-					"path", r.URL.Path,
-					"has_key", providedKey != "",
-					"ip", ip)
-
-				http.Error(w, ErrMsgUnauthorized, http.StatusUnauthorized)
+			if subtle.ConstantTimeCompare([]byte(providedKey), []byte(apiKey)) == 1 {
+				id := r.Header.Get(HeaderCommunityID)
+				if id == "" {
+					next.ServeHTTP(w, r)
+					return
+				}
+				if !communities[id] {
+					handler.RespondError(w, http.StatusBadRequest, ErrMsgUnknownCommunity)
+					return
+				}
+				next.ServeHTTP(w, r.WithContext(community.WithID(r.Context(), id)))
 				return
 			}
 
-			next.ServeHTTP(w, r)
+			if key := matchCommunityKey(providedKey, communityKeys); key != nil {
+				if strings.HasPrefix(r.URL.Path, CommunityKeyDeniedPrefix) {
+					logger.FromContext(r.Context()).Warn(LogMsgCommunityKeyAdmin,
+						"path", r.URL.Path,
+						"community", key.Community)
+					handler.RespondError(w, http.StatusForbidden, ErrMsgForbidden)
+					return
+				}
+				next.ServeHTTP(w, r.WithContext(community.WithID(r.Context(), key.Community)))
+				return
+			}
+
+			if scoped := matchScopedKey(providedKey, scopedKeys); scoped != nil {
+				if !isScopedKeyPath(r.URL.Path) {
+					logger.FromContext(r.Context()).Warn(LogMsgScopedKeyOutOfScope,
+						"path", r.URL.Path,
+						"source", scoped.Source)
+					handler.RespondError(w, http.StatusForbidden, ErrMsgForbidden)
+					return
+				}
+				ctx := handler.WithAPIKeyScope(r.Context(), handler.APIKeyScope{
+					Source:    scoped.Source,
+					HourlyCap: scoped.HourlyCap,
+				})
+				next.ServeHTTP(w, r.WithContext(ctx))
+				return
+			}
+
+			if tokens != nil && providedKey != "" {
+				token, err := tokens.Authenticate(r.Context(), providedKey)
+				if err != nil {
+					handler.RespondError(w, http.StatusServiceUnavailable, http.StatusText(http.StatusServiceUnavailable))
+					return
+				}
+				if token != nil {
+					if r.Method != http.MethodGet || !isAPITokenPath(r.URL.Path) {
+						logger.FromContext(r.Context()).Warn(LogMsgAPITokenOutOfScope,
+							"path", r.URL.Path,
+							"method", r.Method,
+							"guild_id", token.GuildID)
+						handler.RespondError(w, http.StatusForbidden, ErrMsgForbidden)
+						return
+					}
+					ctx := handler.WithAPITokenScope(r.Context(), handler.APITokenScope{
+						TokenID: token.ID,
+						GuildID: token.GuildID,
+					})
+					next.ServeHTTP(w, r.WithContext(ctx))
+					return
+				}
+			}
+
+			ip := extractIP(r, trustedProxies)
+			detector.RecordFailedAuth(ip)
+
+			log := logger.FromContext(r.Context())
+			log.Warn(LogMsgAuthFailed,
+				"remote_addr", r.RemoteAddr,
+				"path", r.URL.Path,
+				"has_key", providedKey != "",
+				"ip", ip)
+
+			handler.RespondError(w, http.StatusUnauthorized, ErrMsgUnauthorized)
 		})
 	}
 }
 
+// matchScopedKey returns the scoped key matching provided, comparing every
+// key in constant time
+func matchScopedKey(provided string, keys []ScopedAPIKey) *ScopedAPIKey {
+	if provided == "" {
+		return nil
+	}
+	var match *ScopedAPIKey
+	for i := range keys {
+		if subtle.ConstantTimeCompare([]byte(provided), []byte(keys[i].Key)) == 1 {
+			match = &keys[i]
+		}
+	}
+	return match
+}
+
+// matchCommunityKey returns the community key matching provided, comparing
+// every key in constant time
+func matchCommunityKey(provided string, keys []CommunityAPIKey) *CommunityAPIKey {
+	if provided == "" {
+		return nil
+	}
+	var match *CommunityAPIKey
+	for i := range keys {
+		if subtle.ConstantTimeCompare([]byte(provided), []byte(keys[i].Key)) == 1 {
+			match = &keys[i]
+		}
+	}
+	return match
+}
+
+func isScopedKeyPath(path string) bool {
+	for _, p := range ScopedKeyPaths {
+		if path == p {
+			return true
+		}
+	}
+	return false
+}
+
+func isAPITokenPath(path string) bool {
+	path = strings.TrimSuffix(path, "/")
+	for _, p := range APITokenPaths {
+		if path == p {
+			return true
+		}
+	}
+	return false
+}
+
 // RequestSizeLimitMiddleware limits request body size
 func RequestSizeLimitMiddleware(maxBytes int64) func(http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
@@ -129,7 +275,7 @@ func SecurityLoggingMiddleware(trustedProxies []string, detector *SuspiciousActi
 
 			// Record request and check rate limit
 			if !detector.RecordRequest(ip) {
-				http.Error(w, ErrMsgTooManyRequests, http.StatusTooManyRequests)
+				handler.RespondError(w, http.StatusTooManyRequests, ErrMsgTooManyRequests)
 				return
 			}
 
@@ -1,15 +1,21 @@
 package server
 
 import (
+	"context"
+	"encoding/json"
 	"net/http"
 	"net/http/httptest"
 	"testing"
+
+	"github.com/osse101/BrandishBot_Go/internal/community"
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/handler"
 )
 
 func TestAuthMiddleware(t *testing.T) {
 	apiKey := "secret-key"
 	detector := NewSuspiciousActivityDetector()
-	middleware := AuthMiddleware(apiKey, nil, detector)
+	middleware := AuthMiddleware(apiKey, nil, detector, nil, nil)
 
 	tests := []struct {
 		name           string
@@ -70,10 +76,32 @@ func TestAuthMiddleware(t *testing.T) {
 	}
 }
 
+func TestAuthMiddleware_JSONErrorEnvelope(t *testing.T) {
+	detector := NewSuspiciousActivityDetector()
+	middleware := AuthMiddleware("secret-key", nil, detector, nil, nil)
+
+	req := httptest.NewRequest("GET", "/api/v1/prices", nil)
+	rec := httptest.NewRecorder()
+	middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})).ServeHTTP(rec, req)
+
+	if got := rec.Header().Get("Content-Type"); got != "application/json" {
+		t.Errorf("expected JSON content type, got %q", got)
+	}
+	var body handler.ErrorResponse
+	if err := json.Unmarshal(rec.Body.Bytes(), &body); err != nil {
+		t.Fatalf("expected JSON body, got %q: %v", rec.Body.String(), err)
+	}
+	if body.Message != ErrMsgUnauthorized || body.Code != handler.ErrCodeUnauthorized {
+		t.Errorf("unexpected body %+v", body)
+	}
+}
+
 func TestAuthMiddleware_RecordsFailures(t *testing.T) {
 	apiKey := "secret-key"
 	detector := NewSuspiciousActivityDetector()
-	middleware := AuthMiddleware(apiKey, nil, detector)
+	middleware := AuthMiddleware(apiKey, nil, detector, nil, nil)
 
 	// Create request with specific IP
 	req := httptest.NewRequest("GET", "/api/test", nil)
@@ -168,3 +196,238 @@ func TestExtractIP(t *testing.T) {
 		})
 	}
 }
+
+func TestAuthMiddleware_ScopedKeys(t *testing.T) {
+	apiKey := "secret-key"
+	scoped := ScopedAPIKey{Key: "donations-key", Source: "donations", HourlyCap: 500}
+	detector := NewSuspiciousActivityDetector()
+	middleware := AuthMiddleware(apiKey, nil, detector, nil, nil, scoped)
+
+	var gotScope handler.APIKeyScope
+	var hasScope bool
+	next := middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotScope, hasScope = handler.APIKeyScopeFromContext(r.Context())
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	tests := []struct {
+		name           string
+		providedKey    string
+		path           string
+		expectedStatus int
+		expectScope    bool
+	}{
+		{
+			name:           "Scoped key on allowed path",
+			providedKey:    scoped.Key,
+			path:           "/api/v1/progression/contribution/external",
+			expectedStatus: http.StatusOK,
+			expectScope:    true,
+		},
+		{
+			name:           "Scoped key on other path",
+			providedKey:    scoped.Key,
+			path:           "/api/v1/progression/admin/unlock",
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:           "Main key on scoped path has no scope",
+			providedKey:    apiKey,
+			path:           "/api/v1/progression/contribution/external",
+			expectedStatus: http.StatusOK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hasScope = false
+			req := httptest.NewRequest("POST", tt.path, nil)
+			req.Header.Set("X-API-Key", tt.providedKey)
+			rec := httptest.NewRecorder()
+
+			next.ServeHTTP(rec, req)
+
+			if rec.Code != tt.expectedStatus {
+				t.Errorf("expected status %d, got %d", tt.expectedStatus, rec.Code)
+			}
+			if hasScope != tt.expectScope {
+				t.Errorf("expected scope present=%v, got %v", tt.expectScope, hasScope)
+			}
+			if tt.expectScope && (gotScope.Source != scoped.Source || gotScope.HourlyCap != scoped.HourlyCap) {
+				t.Errorf("unexpected scope %+v", gotScope)
+			}
+		})
+	}
+}
+
+func TestAuthMiddleware_CommunityKeys(t *testing.T) {
+	apiKey := "secret-key"
+	keys := []CommunityAPIKey{{Key: "alpha-key", Community: "alpha"}}
+	middleware := AuthMiddleware(apiKey, nil, NewSuspiciousActivityDetector(), nil, keys)
+
+	var gotCommunity string
+	next := middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotCommunity = community.FromContext(r.Context())
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	tests := []struct {
+		name              string
+		providedKey       string
+		communityHeader   string
+		path              string
+		expectedStatus    int
+		expectedCommunity string
+	}{
+		{
+			name:              "Community key is pinned to its community",
+			providedKey:       "alpha-key",
+			path:              "/api/v1/user/inventory",
+			expectedStatus:    http.StatusOK,
+			expectedCommunity: "alpha",
+		},
+		{
+			name:              "Community key ignores the community header",
+			providedKey:       "alpha-key",
+			communityHeader:   "default",
+			path:              "/api/v1/user/inventory",
+			expectedStatus:    http.StatusOK,
+			expectedCommunity: "alpha",
+		},
+		{
+			name:           "Community key on admin path",
+			providedKey:    "alpha-key",
+			path:           "/api/v1/admin/snapshots",
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:              "Main key defaults to the default community",
+			providedKey:       apiKey,
+			path:              "/api/v1/user/inventory",
+			expectedStatus:    http.StatusOK,
+			expectedCommunity: community.Default,
+		},
+		{
+			name:              "Main key selects a community by header",
+			providedKey:       apiKey,
+			communityHeader:   "alpha",
+			path:              "/api/v1/admin/snapshots",
+			expectedStatus:    http.StatusOK,
+			expectedCommunity: "alpha",
+		},
+		{
+			name:            "Main key with an unknown community",
+			providedKey:     apiKey,
+			communityHeader: "beta",
+			path:            "/api/v1/user/inventory",
+			expectedStatus:  http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotCommunity = ""
+			req := httptest.NewRequest("GET", tt.path, nil)
+			req.Header.Set("X-API-Key", tt.providedKey)
+			if tt.communityHeader != "" {
+				req.Header.Set(HeaderCommunityID, tt.communityHeader)
+			}
+			rec := httptest.NewRecorder()
+
+			next.ServeHTTP(rec, req)
+
+			if rec.Code != tt.expectedStatus {
+				t.Errorf("expected status %d, got %d", tt.expectedStatus, rec.Code)
+			}
+			if gotCommunity != tt.expectedCommunity {
+				t.Errorf("expected community %q, got %q", tt.expectedCommunity, gotCommunity)
+			}
+		})
+	}
+}
+
+type stubTokenAuthenticator map[string]*domain.APIToken
+
+func (s stubTokenAuthenticator) Authenticate(_ context.Context, key string) (*domain.APIToken, error) {
+	return s[key], nil
+}
+
+func TestAuthMiddleware_APITokens(t *testing.T) {
+	tokens := stubTokenAuthenticator{"bbt_valid": {ID: 7, GuildID: "g1"}}
+	middleware := AuthMiddleware("secret-key", nil, NewSuspiciousActivityDetector(), tokens, nil)
+
+	var gotScope handler.APITokenScope
+	var hasScope bool
+	next := middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotScope, hasScope = handler.APITokenScopeFromContext(r.Context())
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	tests := []struct {
+		name           string
+		providedKey    string
+		method         string
+		path           string
+		expectedStatus int
+		expectScope    bool
+	}{
+		{
+			name:           "Token reads a community path",
+			providedKey:    "bbt_valid",
+			method:         http.MethodGet,
+			path:           "/api/v1/progression/tree",
+			expectedStatus: http.StatusOK,
+			expectScope:    true,
+		},
+		{
+			name:           "Trailing slash is allowed",
+			providedKey:    "bbt_valid",
+			method:         http.MethodGet,
+			path:           "/api/v1/stats/leaderboard/",
+			expectedStatus: http.StatusOK,
+			expectScope:    true,
+		},
+		{
+			name:           "Token cannot write",
+			providedKey:    "bbt_valid",
+			method:         http.MethodPost,
+			path:           "/api/v1/progression/tree",
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:           "Token cannot read user data",
+			providedKey:    "bbt_valid",
+			method:         http.MethodGet,
+			path:           "/api/v1/user/inventory",
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:           "Unknown token",
+			providedKey:    "bbt_revoked",
+			method:         http.MethodGet,
+			path:           "/api/v1/progression/tree",
+			expectedStatus: http.StatusUnauthorized,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hasScope = false
+			req := httptest.NewRequest(tt.method, tt.path, nil)
+			req.Header.Set("X-API-Key", tt.providedKey)
+			rec := httptest.NewRecorder()
+
+			next.ServeHTTP(rec, req)
+
+			if rec.Code != tt.expectedStatus {
+				t.Errorf("expected status %d, got %d", tt.expectedStatus, rec.Code)
+			}
+			if hasScope != tt.expectScope {
+				t.Errorf("expected scope present=%v, got %v", tt.expectScope, hasScope)
+			}
+			if tt.expectScope && (gotScope.TokenID != 7 || gotScope.GuildID != "g1") {
+				t.Errorf("unexpected scope %+v", gotScope)
+			}
+		})
+	}
+}
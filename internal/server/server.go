@@ -14,34 +14,72 @@ import (
 	httpSwagger "github.com/swaggo/http-swagger"
 
 	"github.com/osse101/BrandishBot_Go/internal/admin"
+	"github.com/osse101/BrandishBot_Go/internal/announce"
+	"github.com/osse101/BrandishBot_Go/internal/apitoken"
+	"github.com/osse101/BrandishBot_Go/internal/balance"
+	"github.com/osse101/BrandishBot_Go/internal/bank"
+	"github.com/osse101/BrandishBot_Go/internal/boss"
+	"github.com/osse101/BrandishBot_Go/internal/brigade"
+	"github.com/osse101/BrandishBot_Go/internal/celebration"
+	"github.com/osse101/BrandishBot_Go/internal/chatcommand"
+	"github.com/osse101/BrandishBot_Go/internal/chatdrop"
+	"github.com/osse101/BrandishBot_Go/internal/communitypool"
 	"github.com/osse101/BrandishBot_Go/internal/compost"
+	"github.com/osse101/BrandishBot_Go/internal/cooldown"
 	"github.com/osse101/BrandishBot_Go/internal/crafting"
 	"github.com/osse101/BrandishBot_Go/internal/database"
+	"github.com/osse101/BrandishBot_Go/internal/dataversion"
+	"github.com/osse101/BrandishBot_Go/internal/digging"
 	"github.com/osse101/BrandishBot_Go/internal/economy"
+	"github.com/osse101/BrandishBot_Go/internal/effects"
 	"github.com/osse101/BrandishBot_Go/internal/event"
+	"github.com/osse101/BrandishBot_Go/internal/eventdlq"
 	"github.com/osse101/BrandishBot_Go/internal/eventlog"
 	"github.com/osse101/BrandishBot_Go/internal/expedition"
+	"github.com/osse101/BrandishBot_Go/internal/featureflag"
+	"github.com/osse101/BrandishBot_Go/internal/fishing"
 	"github.com/osse101/BrandishBot_Go/internal/gamble"
+	"github.com/osse101/BrandishBot_Go/internal/gameevent"
+	"github.com/osse101/BrandishBot_Go/internal/garden"
+	"github.com/osse101/BrandishBot_Go/internal/gift"
+	"github.com/osse101/BrandishBot_Go/internal/giveguard"
 	"github.com/osse101/BrandishBot_Go/internal/handler"
 	adminHandlers "github.com/osse101/BrandishBot_Go/internal/handler/admin"
 	"github.com/osse101/BrandishBot_Go/internal/harvest"
+	"github.com/osse101/BrandishBot_Go/internal/inbox"
 	"github.com/osse101/BrandishBot_Go/internal/info"
+	"github.com/osse101/BrandishBot_Go/internal/itemalias"
+	"github.com/osse101/BrandishBot_Go/internal/itemflags"
+	"github.com/osse101/BrandishBot_Go/internal/jackpot"
 	"github.com/osse101/BrandishBot_Go/internal/job"
 	"github.com/osse101/BrandishBot_Go/internal/linking"
+	"github.com/osse101/BrandishBot_Go/internal/loan"
 	"github.com/osse101/BrandishBot_Go/internal/logger"
 	"github.com/osse101/BrandishBot_Go/internal/metrics"
+	"github.com/osse101/BrandishBot_Go/internal/moderation"
+	"github.com/osse101/BrandishBot_Go/internal/monetization"
 	"github.com/osse101/BrandishBot_Go/internal/naming"
+	"github.com/osse101/BrandishBot_Go/internal/personaltrack"
+	"github.com/osse101/BrandishBot_Go/internal/playershop"
 	"github.com/osse101/BrandishBot_Go/internal/prediction"
 	"github.com/osse101/BrandishBot_Go/internal/progression"
+	"github.com/osse101/BrandishBot_Go/internal/progressionbulk"
 	"github.com/osse101/BrandishBot_Go/internal/quest"
+	"github.com/osse101/BrandishBot_Go/internal/raid"
+	"github.com/osse101/BrandishBot_Go/internal/reminder"
 	"github.com/osse101/BrandishBot_Go/internal/repository"
 	"github.com/osse101/BrandishBot_Go/internal/scenario"
 	"github.com/osse101/BrandishBot_Go/internal/search"
 	"github.com/osse101/BrandishBot_Go/internal/slots"
+	"github.com/osse101/BrandishBot_Go/internal/snapshot"
 	"github.com/osse101/BrandishBot_Go/internal/sse"
 	"github.com/osse101/BrandishBot_Go/internal/stats"
+	"github.com/osse101/BrandishBot_Go/internal/stream"
 	"github.com/osse101/BrandishBot_Go/internal/subscription"
+	"github.com/osse101/BrandishBot_Go/internal/undo"
 	"github.com/osse101/BrandishBot_Go/internal/user"
+	"github.com/osse101/BrandishBot_Go/internal/usersettings"
+	"github.com/osse101/BrandishBot_Go/internal/webhook"
 )
 
 type Server struct {
@@ -70,83 +108,228 @@ type Server struct {
 }
 
 // NewServer creates a new Server instance
-func NewServer(port int, apiKey string, trustedProxies []string, dbPool database.Pool, userService user.Service, economyService economy.Service, craftingService crafting.Service, statsService stats.Service, progressionService progression.Service, searchService search.Service, gambleService gamble.Service, jobService job.Service, linkingService linking.Service, harvestService harvest.Service, predictionService prediction.Service, expeditionService expedition.Service, questService quest.Service, subscriptionService subscription.Service, slotsService slots.Service, compostService compost.Service, namingResolver naming.Resolver, eventBus event.Bus, sseHub *sse.Hub, userRepo repository.User, scenarioEngine *scenario.Engine, eventlogService eventlog.Service) *Server {
+func NewServer(port int, apiKey string, scopedKeys []ScopedAPIKey, communityKeys []CommunityAPIKey, trustedProxies []string, dbPool database.Pool, userService user.Service, economyService economy.Service, craftingService crafting.Service, statsService stats.Service, progressionService progression.Service, searchService search.Service, gambleService gamble.Service, jobService job.Service, linkingService linking.Service, harvestService harvest.Service, predictionService prediction.Service, expeditionService expedition.Service, questService quest.Service, subscriptionService subscription.Service, monetizationService monetization.Service, celebrationService celebration.Service, userSettingsService usersettings.Service, reminderService reminder.Service, loanService loan.Service, playerShopService playershop.Service, communityPoolService communitypool.Service, itemFlagsService itemflags.Service, undoService undo.Service, effectsService effects.Service, cooldownService cooldown.Service, slotsService slots.Service, compostService compost.Service, namingResolver naming.Resolver, eventBus event.Bus, sseHub *sse.Hub, userRepo repository.User, scenarioEngine *scenario.Engine, eventlogService eventlog.Service, deadLetterService eventdlq.Service, voteReviewService brigade.Service, giveGuardService giveguard.Service, moderationService moderation.Service, progressionBulkService progressionbulk.Service, balanceService balance.Service, apiTokenService apitoken.Service, featureFlagService featureflag.Service, itemAliasService itemalias.Service, personalTrackService personaltrack.Service, webhookService webhook.Service, snapshotService snapshot.Service, raidService raid.Service, streamService stream.Service, announceService announce.Service, jackpotService jackpot.Service, gameEventService gameevent.Service, diggingService digging.Service, fishingService fishing.Service, bossService boss.Service, gardenService garden.Service, bankService bank.Service, giftService gift.Service, inboxService inbox.Service, chatDropService chatdrop.Service, configReloader adminHandlers.ConfigReloader, chatCommandPrefix string, accessLog AccessLogConfig) *Server {
 	r := chi.NewRouter()
 
 	// Middleware stack
 	// Chi middleware executes in order defined (outermost to innermost)
 	detector := NewSuspiciousActivityDetector()
 
+	r.Use(RequestIDMiddleware)
+	r.Use(ErrorEnvelopeMiddleware)
 	r.Use(SecurityHeadersMiddleware())
-	r.Use(AuthMiddleware(apiKey, trustedProxies, detector))
+	r.Use(AuthMiddleware(apiKey, trustedProxies, detector, apiTokenService, communityKeys, scopedKeys...))
 	r.Use(SecurityLoggingMiddleware(trustedProxies, detector))
 	r.Use(RequestSizeLimitMiddleware(1 << 20)) // 1MB limit
 	r.Use(metrics.Middleware)
 	r.Use(loggingMiddleware)
+	r.Use(AccessLogMiddleware(accessLog))
+
+	// Unmatched routes use the same JSON error envelope as handlers
+	r.NotFound(func(w http.ResponseWriter, _ *http.Request) {
+		handler.RespondError(w, http.StatusNotFound, http.StatusText(http.StatusNotFound))
+	})
+	r.MethodNotAllowed(func(w http.ResponseWriter, _ *http.Request) {
+		handler.RespondError(w, http.StatusMethodNotAllowed, handler.ErrMsgMethodNotAllowed)
+	})
 
 	// Health check routes (unversioned)
 	r.Get("/healthz", handler.HandleHealthz())
 	r.Get("/readyz", handler.HandleReadyz(dbPool))
 
+	// Data version counters let clients know when cached semi-static data is stale
+	dataVersions := dataversion.NewTracker()
+	dataVersions.Subscribe(eventBus)
+
 	// Version endpoint (public, for deployment verification)
-	r.Get("/version", handler.HandleVersion())
+	r.Get("/version", handler.HandleVersion(dataVersions))
 
 	// Metrics endpoint (public, for Prometheus scraping)
 	r.Handle("/metrics", promhttp.Handler())
 
+	// Chat text commands are served through this router so they pass the same
+	// auth, guards and handlers as direct API calls. An empty prefix disables them.
+	var chatCommandDispatcher handler.CommandDispatcher
+	if chatCommandPrefix != "" {
+		chatCommandDispatcher = chatcommand.NewDispatcher(chatCommandPrefix, r, progressionService, chatCommands())
+	}
+
 	// API v1 routes
 	r.Route("/api/v1", func(r chi.Router) {
 		// Info endpoint
 		infoLoader := info.NewLoader("configs/info")
 		r.Get("/info", handler.HandleGetInfo(infoLoader))
 
+		// Economy commands are refused for accounts frozen by a moderator and
+		// for users who are timed out
+		commandGuards := chi.Middlewares{
+			FrozenAccountMiddleware(moderationService, IdentityMaxBodyBytes),
+			TimeoutMiddleware(userService, IdentityMaxBodyBytes),
+		}
+
 		// User routes
+		userSettingsHandler := handler.NewUserSettingsHandler(userSettingsService)
+		reminderHandler := handler.NewReminderHandler(reminderService)
+		loanHandler := handler.NewLoanHandler(loanService)
+		gardenHandler := handler.NewGardenHandler(gardenService)
+		bankHandler := handler.NewBankHandler(bankService)
+		giftHandler := handler.NewGiftHandler(giftService)
+		inboxHandler := handler.NewInboxHandler(inboxService)
+		effectsHandler := handler.NewEffectsHandler(effectsService)
+		cooldownsHandler := handler.NewCooldownsHandler(userService, cooldownService)
+		personalTrackHandler := handler.NewPersonalTrackHandler(personalTrackService)
+		itemFlagsHandler := handler.NewItemFlagsHandler(itemFlagsService)
+		undoHandler := handler.NewUndoHandler(undoService)
+		craftingHandler := handler.NewCraftingHandler(craftingService, userRepo)
 		r.Route("/user", func(r chi.Router) {
 			r.Post("/register", handler.HandleRegisterUser(userService))
 			r.Get("/timeout", handler.HandleGetTimeout(userService))
 			r.Put("/timeout", handler.HandleSetTimeout(userService))
+			r.Get("/settings", userSettingsHandler.HandleGetSettings)
+			r.Put("/settings", userSettingsHandler.HandleUpdateSettings)
+			r.Get("/preferences", userSettingsHandler.HandleGetPreferences)
+			r.Put("/preferences", userSettingsHandler.HandleUpdatePreferences)
+			r.Get("/theme", userSettingsHandler.HandleGetTheme)
+			r.Put("/theme", userSettingsHandler.HandleUpdateTheme)
+			r.Get("/reminders", reminderHandler.HandleGetReminders)
+			r.Post("/reminders", reminderHandler.HandleCreateReminder)
+			r.Delete("/reminders/{id}", reminderHandler.HandleCancelReminder)
+			r.Get("/loans", loanHandler.HandleGetLoans)
+			r.With(commandGuards...).Post("/loans", loanHandler.HandleLendItem)
+			r.Post("/loans/{id}/return", loanHandler.HandleReturnLoan)
+			r.Get("/garden", gardenHandler.HandleGetGarden)
+			r.With(commandGuards...).Post("/garden/plant", gardenHandler.HandlePlant)
+			r.With(commandGuards...).Post("/garden/harvest", gardenHandler.HandleHarvestGarden)
+			r.Get("/bank", bankHandler.HandleGetBank)
+			r.With(commandGuards...).Post("/bank/deposit", bankHandler.HandleDeposit)
+			r.With(commandGuards...).Post("/bank/withdraw", bankHandler.HandleWithdraw)
+			r.With(commandGuards...).Put("/bank/goal", bankHandler.HandleSetBankGoal)
+			r.Get("/gifts", giftHandler.HandleGetGifts)
+			r.With(commandGuards...).Post("/gifts", giftHandler.HandleWrapGift)
+			r.Delete("/gifts/{id}", giftHandler.HandleCancelGift)
+			r.Get("/inbox", inboxHandler.HandleGetInbox)
+			r.Post("/inbox/read", inboxHandler.HandleMarkInboxRead)
+			r.Get("/effects", effectsHandler.HandleGetEffects)
+			r.Get("/cooldowns", cooldownsHandler.HandleGetCooldowns)
+			r.Get("/progression", personalTrackHandler.HandleGetProgression)
+			r.Get("/crafting/mastery", craftingHandler.HandleGetRecipeMastery())
+			r.With(commandGuards...).Post("/undo", undoHandler.HandleUndo)
 			r.Get("/inventory", handler.HandleGetInventory(userService, progressionService))
 			r.Get("/inventory-by-username", handler.HandleGetInventoryByUsername(userService, progressionService))
-			r.Post("/search", handler.HandleSearch(searchService, userService, progressionService, eventBus))
+			r.With(commandGuards...).Post("/search", handler.HandleSearch(searchService, userService, progressionService, eventBus))
+			r.Get("/search/locations", handler.HandleGetSearchLocations(searchService, progressionService))
 
 			r.Route("/item", func(r chi.Router) {
 				r.Post("/add", handler.HandleAddItemByUsername(userService))
 				r.Post("/remove", handler.HandleRemoveItemByUsername(userService))
-				r.Post("/give", handler.HandleGiveItem(userService))
-				r.Post("/sell", handler.HandleSellItem(economyService, userService, progressionService, eventBus))
-				r.Post("/buy", handler.HandleBuyItem(economyService, userService, progressionService, eventBus))
-				r.Post("/use", handler.HandleUseItem(userService, progressionService, eventBus))
-				r.Post("/upgrade", handler.HandleUpgradeItem(craftingService, userService, progressionService, eventBus))
-				r.Post("/disassemble", handler.HandleDisassembleItem(craftingService, userService, progressionService, eventBus))
+				r.With(commandGuards...).Post("/give", handler.HandleGiveItem(userService))
+				r.With(commandGuards...).Post("/sell", handler.HandleSellItem(economyService, userService, progressionService, eventBus))
+				r.With(commandGuards...).Post("/buy", handler.HandleBuyItem(economyService, userService, progressionService, eventBus))
+				r.With(commandGuards...).Post("/use", handler.HandleUseItem(userService, progressionService, eventBus))
+				r.With(commandGuards...).Post("/upgrade", handler.HandleUpgradeItem(craftingService, userService, progressionService, eventBus))
+				r.Post("/upgrade/preview", handler.HandlePreviewUpgrade(craftingService, progressionService))
+				r.With(commandGuards...).Post("/disassemble", handler.HandleDisassembleItem(craftingService, userService, progressionService, eventBus))
+				r.Post("/lock", itemFlagsHandler.HandleSetLock)
+				r.Post("/favorite", itemFlagsHandler.HandleSetFavorite)
 			})
 		})
 
-		r.Post("/message/handle", handler.HandleMessageHandler(userService, progressionService, eventBus))
+		r.Post("/message/handle", handler.HandleMessageHandler(userService, progressionService, eventBus, chatCommandDispatcher, chatDropService))
 		r.Post("/test", handler.HandleTest(userService))
 
 		// Crafting routes
-		craftingHandler := handler.NewCraftingHandler(craftingService, userRepo)
-		r.Get("/recipes", craftingHandler.HandleGetRecipes())
+		r.With(CacheControlMiddleware(CacheMaxAgeRecipes, dataVersions, dataversion.ResourceRecipes)).
+			Get("/recipes", craftingHandler.HandleGetRecipes())
 
 		r.Route("/prices", func(r chi.Router) {
+			r.Use(CacheControlMiddleware(CacheMaxAgePrices, dataVersions, dataversion.ResourcePrices))
 			r.Get("/", handler.HandleGetPrices(economyService))
 			r.Get("/buy", handler.HandleGetBuyPrices(economyService))
+			r.Get("/history", handler.HandleGetPriceHistory(economyService))
+		})
+
+		// Item balance changelog
+		balanceHandler := handler.NewBalanceHandler(balanceService)
+		r.Get("/items/balance-changes", balanceHandler.HandleGetChangelog)
+
+		// Player shop routes
+		playerShopHandler := handler.NewPlayerShopHandler(playerShopService)
+		r.Route("/shop/player", func(r chi.Router) {
+			r.Get("/", playerShopHandler.HandleGetListings)
+			r.With(commandGuards...).Post("/", playerShopHandler.HandleListItem)
+			r.Get("/pool", playerShopHandler.HandleGetCommunityPool)
+			r.With(commandGuards...).Post("/{id}/buy", playerShopHandler.HandleBuyListing)
+			r.With(commandGuards...).Delete("/{id}", playerShopHandler.HandleCancelListing)
+		})
+
+		// Market routes: the player shop with listing IDs in the body
+		r.Route("/market", func(r chi.Router) {
+			r.Use(commandGuards...)
+			r.Post("/list", playerShopHandler.HandleListItem)
+			r.Post("/buy", playerShopHandler.HandleMarketBuy)
+			r.Post("/cancel", playerShopHandler.HandleMarketCancel)
+		})
+
+		// Community pool donation routes
+		communityPoolHandler := handler.NewCommunityPoolHandler(communityPoolService)
+		r.Route("/community", func(r chi.Router) {
+			r.With(commandGuards...).Post("/donate", communityPoolHandler.HandleDonate)
+			r.Get("/pool", communityPoolHandler.HandleGetStatus)
+			r.Get("/donors", communityPoolHandler.HandleGetDonors)
+		})
+
+		// Progressive jackpot status
+		r.Get("/jackpot", handler.NewJackpotHandler(jackpotService).HandleGetStatus)
+
+		// Running game events and their combined modifiers
+		r.Get("/game-events", handler.NewGameEventHandler(gameEventService).HandleGetActive)
+
+		// Community dig site routes
+		diggingHandler := handler.NewDiggingHandler(diggingService)
+		r.Route("/digging", func(r chi.Router) {
+			r.With(commandGuards...).Post("/dig", diggingHandler.HandleDig)
+			r.Get("/site", diggingHandler.HandleGetSite)
+		})
+
+		// Fishing routes
+		fishingHandler := handler.NewFishingHandler(fishingService)
+		r.Route("/fishing", func(r chi.Router) {
+			r.With(commandGuards...).Post("/cast", fishingHandler.HandleCast)
+			r.With(commandGuards...).Post("/reel", fishingHandler.HandleReel)
+		})
+
+		// Boss raid routes
+		bossHandler := handler.NewBossHandler(bossService)
+		r.Route("/boss", func(r chi.Router) {
+			r.Get("/", bossHandler.HandleGetStatus)
+			r.With(commandGuards...).Post("/attack", bossHandler.HandleAttack)
 		})
 
 		// Gamble routes
-		gambleHandler := handler.NewGambleHandler(gambleService, userService, progressionService, eventBus)
+		gambleWatcher := gamble.NewWatcher()
+		gambleWatcher.Subscribe(eventBus)
+		gambleHandler := handler.NewGambleHandler(gambleService, userService, progressionService, eventBus, gambleWatcher)
 		r.Route("/gamble", func(r chi.Router) {
-			r.Post("/start", gambleHandler.HandleStartGamble)
-			r.Post("/join", gambleHandler.HandleJoinGamble)
+			r.With(commandGuards...).Post("/start", gambleHandler.HandleStartGamble)
+			r.With(commandGuards...).Post("/join", gambleHandler.HandleJoinGamble)
 			r.Get("/get", gambleHandler.HandleGetGamble)
 			r.Get("/active", gambleHandler.HandleGetActiveGamble)
+			r.Get("/{id}/wait", gambleHandler.HandleWaitGamble)
+			r.With(commandGuards...).Post("/{id}/side-bet", gambleHandler.HandlePlaceSideBet)
+			r.Get("/{id}/odds", gambleHandler.HandleGetGambleOdds)
+		})
+
+		// Bot routes
+		botHandler := handler.NewBotHandler(gambleService, progressionService, economyService)
+		r.Route("/bot", func(r chi.Router) {
+			r.Get("/bootstrap", botHandler.HandleBootstrap)
 		})
 
 		// Expedition routes
 		expeditionHandler := handler.NewExpeditionHandler(expeditionService, progressionService)
 		r.Route("/expedition", func(r chi.Router) {
-			r.Post("/start", expeditionHandler.HandleStart)
-			r.Post("/join", expeditionHandler.HandleJoin)
+			r.With(commandGuards...).Post("/start", expeditionHandler.HandleStart)
+			r.With(commandGuards...).Post("/join", expeditionHandler.HandleJoin)
 			r.Get("/get", expeditionHandler.HandleGet)
 			r.Get("/active", expeditionHandler.HandleGetActive)
 			r.Get("/journal", expeditionHandler.HandleGetJournal)
@@ -156,26 +339,30 @@ func NewServer(port int, apiKey string, trustedProxies []string, dbPool database
 		// Slots routes
 		slotsHandler := handler.NewSlotsHandler(slotsService, progressionService)
 		r.Route("/slots", func(r chi.Router) {
-			r.Post("/spin", slotsHandler.HandleSpinSlots)
+			r.With(commandGuards...).Post("/spin", slotsHandler.HandleSpinSlots)
 		})
 
 		// Harvest routes
 		harvestHandler := handler.NewHarvestHandler(harvestService)
-		r.Post("/harvest", harvestHandler.Harvest)
+		r.With(commandGuards...).Post("/harvest", harvestHandler.Harvest)
 
 		// Compost routes
 		compostHandler := handler.NewCompostHandler(compostService, progressionService)
 		r.Route("/compost", func(r chi.Router) {
-			r.Post("/deposit", compostHandler.HandleDeposit)
-			r.Post("/harvest", compostHandler.HandleHarvest)
+			r.With(commandGuards...).Post("/deposit", compostHandler.HandleDeposit)
+			r.With(commandGuards...).Post("/harvest", compostHandler.HandleHarvest)
 			r.Get("/status", compostHandler.HandleStatus)
 		})
 
 		// Job routes
 		jobHandler := handler.NewJobHandler(jobService, userRepo)
 		r.Route("/jobs", func(r chi.Router) {
+			r.Get("/", jobHandler.HandleGetJobs)
 			r.Get("/user", jobHandler.HandleGetUserJobs)
 			r.Post("/award-xp", jobHandler.HandleAwardXP)
+			r.Post("/active", jobHandler.HandleSwitchActiveJob)
+			r.Post("/prestige", jobHandler.HandlePrestigeJob)
+			r.Get("/{job_key}/perks", jobHandler.HandleGetJobPerks)
 		})
 
 		// Stats routes
@@ -184,7 +371,8 @@ func NewServer(port int, apiKey string, trustedProxies []string, dbPool database
 			r.Post("/event", handler.HandleRecordEvent(statsService))
 			r.Get("/user", statsHandler.HandleGetUserStats())
 			r.Get("/system", handler.HandleGetSystemStats(statsService))
-			r.Get("/leaderboard", handler.HandleGetLeaderboard(statsService))
+			r.Get("/leaderboard", statsHandler.HandleGetLeaderboard())
+			r.Get("/query", handler.HandleQueryStats(statsService))
 		})
 
 		// Quest routes
@@ -192,33 +380,42 @@ func NewServer(port int, apiKey string, trustedProxies []string, dbPool database
 		r.Route("/quests", func(r chi.Router) {
 			r.Get("/active", questHandler.GetActiveQuests)
 			r.Get("/progress", questHandler.GetUserQuestProgress)
-			r.Post("/claim", questHandler.ClaimQuestReward)
+			r.With(commandGuards...).Post("/claim", questHandler.ClaimQuestReward)
 		})
 
 		// Progression routes
 		progressionHandlers := handler.NewProgressionHandlers(progressionService)
 		r.Route("/progression", func(r chi.Router) {
-			r.Get("/tree", progressionHandlers.HandleGetTree())
+			r.With(CacheControlMiddleware(CacheMaxAgeTree, dataVersions, dataversion.ResourceTree)).
+				Get("/tree", progressionHandlers.HandleGetTree())
 			r.Get("/available", progressionHandlers.HandleGetAvailable())
 			r.Post("/vote", progressionHandlers.HandleVote())
+			r.Post("/vote/delegate", progressionHandlers.HandleDelegateVote())
+			r.Delete("/vote/delegate", progressionHandlers.HandleRevokeVoteDelegation())
+			r.Get("/vote/delegate", progressionHandlers.HandleGetVoteDelegation())
 			r.Get("/status", progressionHandlers.HandleGetStatus())
 			r.Get("/engagement", progressionHandlers.HandleGetEngagement())
 			r.Get("/engagement-by-username", progressionHandlers.HandleGetEngagementByUsername())
 			r.Get("/leaderboard", progressionHandlers.HandleGetContributionLeaderboard())
 			r.Get("/session", progressionHandlers.HandleGetVotingSession())
+			r.Get("/sessions/history", progressionHandlers.HandleGetSessionHistory())
 			r.Get("/unlock-progress", progressionHandlers.HandleGetUnlockProgress())
 			r.Get("/estimate/{nodeKey}", progressionHandlers.HandleGetEstimate())
+			r.Post("/contribution/external", progressionHandlers.HandleExternalContribution())
 
 			r.Route("/admin", func(r chi.Router) {
-				r.Post("/unlock", progressionHandlers.HandleAdminUnlock())
-				r.Post("/unlock-all", progressionHandlers.HandleAdminUnlockAll())
-				r.Post("/relock", progressionHandlers.HandleAdminRelock())
-				r.Post("/instant-unlock", progressionHandlers.HandleAdminInstantUnlock())
+				bumpAll := DataVersionBumpMiddleware(dataVersions, dataversion.AllResources...)
+				r.With(bumpAll).Post("/unlock", progressionHandlers.HandleAdminUnlock())
+				r.With(bumpAll).Post("/unlock-all", progressionHandlers.HandleAdminUnlockAll())
+				r.With(bumpAll).Post("/relock", progressionHandlers.HandleAdminRelock())
+				r.With(bumpAll).Post("/instant-unlock", progressionHandlers.HandleAdminInstantUnlock())
 				r.Post("/start-voting", progressionHandlers.HandleAdminStartVoting())
 				r.Post("/end-voting", progressionHandlers.HandleAdminEndVoting())            // Freezes vote
 				r.Post("/force-end-voting", progressionHandlers.HandleAdminForceEndVoting()) // Ends vote immediately
-				r.Post("/reset", progressionHandlers.HandleAdminReset())
+				r.With(bumpAll).Post("/reset", progressionHandlers.HandleAdminReset())
 				r.Post("/contribution", progressionHandlers.HandleAdminAddContribution())
+				r.With(bumpAll).Put("/tree", progressionHandlers.HandleAdminReplaceTree())
+				r.With(bumpAll).Patch("/tree", progressionHandlers.HandleAdminPatchTree())
 			})
 		})
 
@@ -239,6 +436,41 @@ func NewServer(port int, apiKey string, trustedProxies []string, dbPool database
 			r.Get("/user", subscriptionHandler.HandleGetUserSubscription)
 		})
 
+		// Monetization routes
+		monetizationHandler := handler.NewMonetizationHandler(monetizationService)
+		r.Post("/monetization/event", monetizationHandler.HandleEvent)
+
+		// Celebration routes
+		celebrationHandler := handler.NewCelebrationHandler(celebrationService)
+		r.Route("/celebrations", func(r chi.Router) {
+			r.Get("/birthday", celebrationHandler.HandleGetBirthday)
+			r.Post("/birthday", celebrationHandler.HandleSetBirthday)
+			r.Delete("/birthday", celebrationHandler.HandleClearBirthday)
+			r.Get("/guilds/{guildID}", celebrationHandler.HandleGetGuild)
+			r.Put("/guilds/{guildID}", celebrationHandler.HandleSetGuild)
+		})
+
+		// Raid and host routes, called by platform adapters
+		raidHandler := handler.NewRaidHandler(raidService)
+		r.Post("/events/raid", raidHandler.HandleRaid)
+		r.Post("/events/host", raidHandler.HandleHost)
+		r.Get("/events/bonuses", raidHandler.HandleGetBonuses)
+
+		// Stream session routes, called by platform adapters and the Discord bot
+		streamHandler := handler.NewStreamHandler(streamService)
+		r.Route("/stream", func(r chi.Router) {
+			r.Post("/start", streamHandler.HandleStart)
+			r.Post("/end", streamHandler.HandleEnd)
+			r.Get("/{id}/summary", streamHandler.HandleGetSummary)
+			r.Get("/{id}/recap", streamHandler.HandleGetRecap)
+		})
+
+		// Announcement routing, read by the Discord bot
+		announcementHandler := handler.NewAnnouncementHandler(announceService)
+		r.Route("/announcements", func(r chi.Router) {
+			r.Get("/discord-events", announcementHandler.HandleGetDiscordEvents)
+		})
+
 		// Prediction routes
 		predictionHandlers := handler.NewPredictionHandlers(predictionService)
 		r.Post("/prediction", predictionHandlers.HandleProcessOutcome())
@@ -255,7 +487,23 @@ func NewServer(port int, apiKey string, trustedProxies []string, dbPool database
 		adminMetricsHandler := adminHandlers.NewMetricsHandler(sseHub)
 		adminUserHandler := adminHandlers.NewUserHandler(userRepo, userService)
 		adminEventsHandler := adminHandlers.NewEventsHandler(eventlogService)
+		adminDeadLetterHandler := adminHandlers.NewDeadLetterHandler(deadLetterService)
 		adminSSEHandler := adminHandlers.NewSSEHandler(sseHub)
+		adminVoteReviewHandler := adminHandlers.NewVoteReviewHandler(voteReviewService)
+		adminGiveFlagsHandler := adminHandlers.NewGiveFlagsHandler(giveGuardService)
+		adminModerationHandler := adminHandlers.NewModerationHandler(moderationService)
+		adminSearchDifficultyHandler := adminHandlers.NewSearchDifficultyHandler(searchService)
+		adminProgressionBulkHandler := adminHandlers.NewProgressionBulkHandler(progressionBulkService)
+		adminBalanceHandler := adminHandlers.NewBalanceHandler(balanceService)
+		adminAPITokenHandler := adminHandlers.NewAPITokenHandler(apiTokenService)
+		adminFeatureFlagHandler := adminHandlers.NewFeatureFlagHandler(featureFlagService)
+		adminGameEventHandler := adminHandlers.NewGameEventHandler(gameEventService)
+		adminBossHandler := adminHandlers.NewBossHandler(bossService)
+		adminItemAliasHandler := adminHandlers.NewItemAliasHandler(itemAliasService)
+		adminWebhookHandler := adminHandlers.NewWebhookHandler(webhookService)
+		adminAnnouncementHandler := adminHandlers.NewAnnouncementHandler(announceService)
+		adminSnapshotHandler := adminHandlers.NewSnapshotHandler(snapshotService)
+		adminCommunityPoolHandler := adminHandlers.NewCommunityPoolHandler(communityPoolService)
 		r.Route("/admin", func(r chi.Router) {
 			r.Get("/metrics", adminMetricsHandler.HandleGetMetrics)
 			r.Post("/sse/broadcast", adminSSEHandler.HandleBroadcast)
@@ -265,15 +513,120 @@ func NewServer(port int, apiKey string, trustedProxies []string, dbPool database
 				r.Get("/lookup", adminUserHandler.HandleUserLookup)
 				r.Get("/recent", adminUserHandler.HandleGetRecentUsers)
 				r.Get("/active", adminUserHandler.HandleGetActiveChatters)
+
+				// Moderation
+				r.Post("/{id}/freeze", adminModerationHandler.HandleFreeze)
+				r.Post("/{id}/unfreeze", adminModerationHandler.HandleUnfreeze)
+				r.Post("/{id}/wipe-inventory", adminModerationHandler.HandleWipeInventory)
+				r.Get("/{id}/inspect", adminModerationHandler.HandleInspect)
 			})
 
 			// Autocomplete lists
 			r.Get("/items", adminUserHandler.HandleGetItems)
+
+			// Scheduled item balance changes
+			r.Post("/items/balance-changes", adminBalanceHandler.HandleSchedule)
+			r.Delete("/items/balance-changes/{id}", adminBalanceHandler.HandleCancel)
+
+			// Guild API tokens
+			r.Route("/api-tokens", func(r chi.Router) {
+				r.Post("/", adminAPITokenHandler.HandleIssue)
+				r.Get("/", adminAPITokenHandler.HandleList)
+				r.Delete("/{id}", adminAPITokenHandler.HandleRevoke)
+			})
+
+			// Feature flags, checked with FeatureFlagMiddleware or handler.CheckFeatureFlagDisabled
+			r.Route("/feature-flags", func(r chi.Router) {
+				r.Get("/", adminFeatureFlagHandler.HandleList)
+				r.Get("/{key}", adminFeatureFlagHandler.HandleGet)
+				r.Put("/{key}", adminFeatureFlagHandler.HandleSet)
+				r.Delete("/{key}", adminFeatureFlagHandler.HandleDelete)
+			})
+
+			// Game events: time-boxed XP, drop rate and shop discount boosts
+			r.Route("/game-events", func(r chi.Router) {
+				r.Get("/", adminGameEventHandler.HandleList)
+				r.Post("/", adminGameEventHandler.HandleCreate)
+				r.Delete("/{id}", adminGameEventHandler.HandleEnd)
+			})
+
+			// Boss raids: spawn a roster boss, or a random one without a key
+			r.Post("/boss", adminBossHandler.HandleSpawn)
+
+			// Item aliases, laid over the aliases config file
+			r.Route("/naming/aliases", func(r chi.Router) {
+				r.Get("/", adminItemAliasHandler.HandleList)
+				r.Get("/{item}", adminItemAliasHandler.HandleGet)
+				r.Put("/{item}", adminItemAliasHandler.HandleSet)
+				r.Delete("/{item}", adminItemAliasHandler.HandleDelete)
+			})
+
+			// Outgoing webhooks
+			r.Route("/webhooks", func(r chi.Router) {
+				r.Post("/", adminWebhookHandler.HandleRegister)
+				r.Get("/", adminWebhookHandler.HandleList)
+				r.Delete("/{id}", adminWebhookHandler.HandleDelete)
+				r.Get("/{id}/deliveries", adminWebhookHandler.HandleGetDeliveries)
+			})
+
+			// Announcement routes, which replace the Discord bot's built-in announcements
+			r.Route("/announcements/routes", func(r chi.Router) {
+				r.Post("/", adminAnnouncementHandler.HandleCreate)
+				r.Get("/", adminAnnouncementHandler.HandleList)
+				r.Put("/{id}", adminAnnouncementHandler.HandleUpdate)
+				r.Delete("/{id}", adminAnnouncementHandler.HandleDelete)
+			})
+
+			// Game state snapshots
+			r.Post("/snapshot", adminSnapshotHandler.HandleCreate)
+			r.Post("/restore", adminSnapshotHandler.HandleRestore)
+			r.Route("/snapshots", func(r chi.Router) {
+				r.Get("/", adminSnapshotHandler.HandleList)
+				r.Get("/{id}", adminSnapshotHandler.HandleExport)
+				r.Delete("/{id}", adminSnapshotHandler.HandleDelete)
+			})
+
+			// Community pool
+			r.Route("/community-pool", func(r chi.Router) {
+				r.Get("/", adminCommunityPoolHandler.HandleGetBalance)
+				r.Post("/fund-progression", adminCommunityPoolHandler.HandleFundProgression)
+			})
 			r.Get("/jobs", adminUserHandler.HandleGetJobs)
 
 			// Event log
 			r.Get("/events", adminEventsHandler.HandleGetEvents)
-			r.Post("/reload-aliases", adminHandlers.HandleReloadAliases(namingResolver))
+
+			// Event handler dead-letter queue
+			r.Route("/events/dlq", func(r chi.Router) {
+				r.Get("/", adminDeadLetterHandler.HandleList)
+				r.Post("/{id}/redrive", adminDeadLetterHandler.HandleRedrive)
+				r.Post("/{id}/discard", adminDeadLetterHandler.HandleDiscard)
+			})
+
+			// Vote brigading review
+			r.Route("/votes/sessions/{sessionID}", func(r chi.Router) {
+				r.Get("/suspects", adminVoteReviewHandler.HandleListSuspects)
+				r.Post("/scan", adminVoteReviewHandler.HandleScan)
+				r.Get("/audit", adminVoteReviewHandler.HandleGetAudit)
+				r.Post("/exclude", adminVoteReviewHandler.HandleExclude)
+				r.Post("/restore", adminVoteReviewHandler.HandleRestore)
+			})
+
+			// Give funneling review
+			r.Route("/gives/flags", func(r chi.Router) {
+				r.Get("/", adminGiveFlagsHandler.HandleList)
+				r.Post("/{id}/review", adminGiveFlagsHandler.HandleReview)
+			})
+
+			// Population-based search difficulty
+			r.Route("/search/difficulty", func(r chi.Router) {
+				r.Get("/", adminSearchDifficultyHandler.HandleGetDifficulty)
+				r.Put("/", adminSearchDifficultyHandler.HandleSetDifficulty)
+			})
+			r.With(DataVersionBumpMiddleware(dataVersions, dataversion.ResourcePrices, dataversion.ResourceRecipes)).
+				Post("/reload-aliases", adminHandlers.HandleReloadAliases(namingResolver))
+			r.With(DataVersionBumpMiddleware(dataVersions, dataversion.AllResources...)).
+				Post("/config/reload", adminHandlers.HandleReloadConfig(configReloader))
 
 			// Admin timeout routes
 			r.Route("/timeout", func(r chi.Router) {
@@ -283,6 +636,7 @@ func NewServer(port int, apiKey string, trustedProxies []string, dbPool database
 			// Admin job routes
 			r.Route("/jobs", func(r chi.Router) {
 				r.Post("/award-xp", adminJobHandler.HandleAwardXP)
+				r.Get("/xp-awards", adminJobHandler.HandleGetXPAwards)
 				r.Post("/reset-daily-xp", adminDailyResetHandler.HandleManualReset)
 				r.Get("/reset-status", adminDailyResetHandler.HandleGetResetStatus)
 			})
@@ -290,6 +644,11 @@ func NewServer(port int, apiKey string, trustedProxies []string, dbPool database
 			// Admin progression routes
 			r.Route("/progression", func(r chi.Router) {
 				r.Post("/reload-weights", progressionHandlers.HandleAdminReloadWeights())
+
+				// Bulk grants and revokes of user progressions
+				r.Post("/bulk", adminProgressionBulkHandler.HandleStart)
+				r.Get("/bulk/{operationID}", adminProgressionBulkHandler.HandleGetOperation)
+				r.Get("/bulk/{operationID}/audit", adminProgressionBulkHandler.HandleGetAudit)
 			})
 
 			// Admin cache routes
@@ -413,12 +772,13 @@ func loggingMiddleware(next http.Handler) http.Handler {
 			}
 		}
 
-		// Generate unique request ID
-		requestID := logger.GenerateRequestID()
-
-		// Add request ID to context
-		ctx := logger.WithRequestID(r.Context(), requestID)
-		r = r.WithContext(ctx)
+		// Reuse the request ID from RequestIDMiddleware, generating one
+		// when the middleware is not installed
+		ctx := r.Context()
+		if logger.GetRequestID(ctx) == "" {
+			ctx = logger.WithRequestID(ctx, logger.GenerateRequestID())
+			r = r.WithContext(ctx)
+		}
 
 		// Get scoped logger
 		log := logger.FromContext(ctx)
@@ -0,0 +1,52 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"net/http"
+	"time"
+
+	"github.com/osse101/BrandishBot_Go/internal/handler"
+	"github.com/osse101/BrandishBot_Go/internal/logger"
+)
+
+// TimeoutChecker reports how long a platform user remains timed out
+type TimeoutChecker interface {
+	GetTimeoutPlatform(ctx context.Context, platform, username string) (time.Duration, error)
+}
+
+// timeoutIdentityFields are the platform/username field pairs that name the
+// acting user, checked in order. Gives name the giver as the owner.
+var timeoutIdentityFields = [][2]string{
+	{AccessLogFieldPlatform, AccessLogFieldUsername},
+	{IdentityFieldOwnerPlatform, IdentityFieldOwner},
+}
+
+// TimeoutMiddleware refuses commands from timed out users with 403 until the
+// timeout runs out. Like FrozenAccountMiddleware it fails open.
+func TimeoutMiddleware(checker TimeoutChecker, maxBodyBytes int) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			platform, username := requestIdentity(r, captureRequestBody(r, maxBodyBytes), timeoutIdentityFields)
+			if platform == "" || username == "" {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			remaining, err := checker.GetTimeoutPlatform(r.Context(), platform, username)
+			if err != nil {
+				logger.FromContext(r.Context()).Error(LogMsgTimeoutCheckFailed, "error", err, "platform", platform, "username", username)
+				next.ServeHTTP(w, r)
+				return
+			}
+			if remaining > 0 {
+				seconds := int(math.Ceil(remaining.Seconds()))
+				logger.FromContext(r.Context()).Info(LogMsgTimedOutRequest, "platform", platform, "username", username, "remaining_seconds", seconds, "path", r.URL.Path)
+				handler.RespondError(w, http.StatusForbidden, fmt.Sprintf(handler.ErrMsgTimedOutFormat, seconds))
+				return
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
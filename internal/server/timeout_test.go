@@ -0,0 +1,84 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+type fakeTimeoutChecker struct {
+	timeouts map[string]time.Duration
+	err      error
+}
+
+func (f *fakeTimeoutChecker) GetTimeoutPlatform(_ context.Context, platform, username string) (time.Duration, error) {
+	return f.timeouts[platform+":"+username], f.err
+}
+
+func serveTimeout(checker TimeoutChecker, req *http.Request) *httptest.ResponseRecorder {
+	h := TimeoutMiddleware(checker, IdentityMaxBodyBytes)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestTimeoutMiddleware(t *testing.T) {
+	checker := &fakeTimeoutChecker{timeouts: map[string]time.Duration{"twitch:alice": 90 * time.Second}}
+
+	tests := []struct {
+		name    string
+		req     *http.Request
+		checker TimeoutChecker
+		want    int
+	}{
+		{
+			name:    "refuses a timed out caller",
+			req:     httptest.NewRequest(http.MethodPost, "/api/v1/user/item/use", strings.NewReader(`{"platform":"twitch","platform_id":"1","username":"alice"}`)),
+			checker: checker,
+			want:    http.StatusForbidden,
+		},
+		{
+			name:    "refuses a timed out giver",
+			req:     httptest.NewRequest(http.MethodPost, "/api/v1/user/item/give", strings.NewReader(`{"owner_platform":"twitch","owner":"alice","receiver":"bob"}`)),
+			checker: checker,
+			want:    http.StatusForbidden,
+		},
+		{
+			name:    "lets other callers through",
+			req:     httptest.NewRequest(http.MethodPost, "/api/v1/user/item/use", strings.NewReader(`{"platform":"twitch","username":"bob"}`)),
+			checker: checker,
+			want:    http.StatusOK,
+		},
+		{
+			name:    "fails open when the check errors",
+			req:     httptest.NewRequest(http.MethodPost, "/api/v1/harvest?platform=twitch&username=alice", nil),
+			checker: &fakeTimeoutChecker{err: errors.New("db down")},
+			want:    http.StatusOK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := serveTimeout(tt.checker, tt.req)
+
+			if rec.Code != tt.want {
+				t.Errorf("status = %d, want %d", rec.Code, tt.want)
+			}
+		})
+	}
+
+	t.Run("reports the time left", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/harvest?platform=twitch&username=alice", nil)
+		rec := serveTimeout(checker, req)
+
+		if !strings.Contains(rec.Body.String(), "90s") {
+			t.Errorf("body = %q, want the remaining seconds", rec.Body.String())
+		}
+	})
+}
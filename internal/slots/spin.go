@@ -38,6 +38,7 @@ func (s *service) SpinSlots(ctx context.Context, platform, platformID, username
 
 	var result *domain.SlotsResult
 
+	ctx = event.WithInventoryReason(ctx, domain.ActionSlots)
 	err = s.cooldownSvc.EnforceCooldown(ctx, user.ID, domain.ActionSlots, func() error {
 		var spinErr error
 		result, spinErr = s.executeSpin(ctx, user, username, betAmount)
@@ -0,0 +1,20 @@
+package snapshot
+
+// JobType is the worker pool job type for scheduled snapshots
+const JobType = "game_snapshot"
+
+// Defaults
+const (
+	// DefaultRetention is how many scheduled snapshots are kept
+	DefaultRetention = 7
+	// MaxNoteLength caps the admin note stored with a snapshot
+	MaxNoteLength = 200
+)
+
+// Log messages
+const (
+	LogMsgSnapshotCreated  = "Game snapshot created"
+	LogMsgSnapshotDeleted  = "Game snapshot deleted"
+	LogMsgSnapshotRestored = "Game state restored from snapshot"
+	LogMsgSnapshotsPruned  = "Pruned old scheduled snapshots"
+)
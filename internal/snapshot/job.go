@@ -0,0 +1,31 @@
+package snapshot
+
+import (
+	"context"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+)
+
+// Job takes a scheduled snapshot and prunes old ones
+type Job struct {
+	service Service
+}
+
+// NewJob creates a scheduled snapshot job
+func NewJob(service Service) *Job {
+	return &Job{service: service}
+}
+
+// JobType returns the worker pool job type
+func (j *Job) JobType() string {
+	return JobType
+}
+
+// Process snapshots the game state, then applies the retention limit
+func (j *Job) Process(ctx context.Context) error {
+	if _, err := j.service.Create(ctx, CreateRequest{Trigger: domain.SnapshotTriggerScheduled, CreatedBy: "scheduler"}); err != nil {
+		return err
+	}
+	_, err := j.service.Prune(ctx)
+	return err
+}
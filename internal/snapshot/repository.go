@@ -0,0 +1,41 @@
+package snapshot
+
+import (
+	"context"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+)
+
+// Repository reads and writes game state snapshots
+type Repository interface {
+	// SchemaVersion returns the latest applied migration version
+	SchemaVersion(ctx context.Context) (int64, error)
+
+	// ExportState reads every snapshot table in a single read-only
+	// transaction, so the copy is consistent, and returns the row count per
+	// table
+	ExportState(ctx context.Context) (*domain.SnapshotData, map[string]int, error)
+
+	// ImportState replaces the snapshot tables with the document's rows in a
+	// single transaction and returns the row count per table. Users are
+	// merged rather than replaced, since other tables reference them.
+	ImportState(ctx context.Context, data *domain.SnapshotData) (map[string]int, error)
+
+	// SaveSnapshot stores a snapshot's compressed document and returns it with
+	// its ID and creation time set
+	SaveSnapshot(ctx context.Context, snap domain.GameSnapshot, payload []byte) (*domain.GameSnapshot, error)
+
+	// ListSnapshots returns every snapshot, newest first
+	ListSnapshots(ctx context.Context) ([]domain.GameSnapshot, error)
+
+	// GetSnapshotPayload returns a snapshot's compressed document, or nil if
+	// there is no snapshot with the ID
+	GetSnapshotPayload(ctx context.Context, id int64) ([]byte, error)
+
+	// DeleteSnapshot removes a snapshot and returns false if there was none
+	DeleteSnapshot(ctx context.Context, id int64) (bool, error)
+
+	// PruneSnapshots deletes all but the newest keep snapshots with the
+	// trigger and returns how many were deleted
+	PruneSnapshots(ctx context.Context, trigger domain.SnapshotTrigger, keep int) (int64, error)
+}
@@ -0,0 +1,252 @@
+package snapshot
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sync"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/event"
+	"github.com/osse101/BrandishBot_Go/internal/logger"
+)
+
+// Service takes, stores and restores consistent copies of player state
+type Service interface {
+	// Create exports the current game state and stores it
+	Create(ctx context.Context, req CreateRequest) (*domain.GameSnapshot, error)
+
+	// List returns every stored snapshot, newest first
+	List(ctx context.Context) ([]domain.GameSnapshot, error)
+
+	// Export returns a stored snapshot's document. It returns
+	// domain.ErrSnapshotNotFound for unknown IDs.
+	Export(ctx context.Context, id int64) (*domain.SnapshotData, error)
+
+	// Delete removes a stored snapshot. It returns domain.ErrSnapshotNotFound
+	// for unknown IDs.
+	Delete(ctx context.Context, id int64) error
+
+	// Restore replaces game state with a stored snapshot
+	Restore(ctx context.Context, id int64, restoredBy string) (*RestoreResult, error)
+
+	// Import replaces game state with an uploaded snapshot document
+	Import(ctx context.Context, data *domain.SnapshotData, restoredBy string) (*RestoreResult, error)
+
+	// Prune deletes scheduled snapshots beyond the retention count
+	Prune(ctx context.Context) (int64, error)
+}
+
+// CreateRequest describes a snapshot to take
+type CreateRequest struct {
+	Trigger   domain.SnapshotTrigger
+	Note      string
+	CreatedBy string
+}
+
+// RestoreResult reports a completed restore
+type RestoreResult struct {
+	// SafetySnapshotID is the snapshot of the state that was replaced, so a
+	// mistaken restore can itself be undone
+	SafetySnapshotID int64          `json:"safety_snapshot_id"`
+	RowCounts        map[string]int `json:"row_counts"`
+}
+
+// Publisher publishes snapshot events
+type Publisher interface {
+	PublishWithRetry(ctx context.Context, evt event.Event)
+}
+
+// Config tunes snapshots
+type Config struct {
+	// Retention is how many scheduled snapshots Prune keeps
+	Retention int
+}
+
+type service struct {
+	repo      Repository
+	publisher Publisher
+	cfg       Config
+
+	// restoreMu serialises restores so two cannot interleave their safety
+	// snapshots and imports
+	restoreMu sync.Mutex
+}
+
+// NewService creates a snapshot service
+func NewService(repo Repository, publisher Publisher, cfg Config) Service {
+	if cfg.Retention <= 0 {
+		cfg.Retention = DefaultRetention
+	}
+	return &service{
+		repo:      repo,
+		publisher: publisher,
+		cfg:       cfg,
+	}
+}
+
+// Create exports the state, compresses it and stores it with its row counts
+func (s *service) Create(ctx context.Context, req CreateRequest) (*domain.GameSnapshot, error) {
+	if len(req.Note) > MaxNoteLength {
+		return nil, fmt.Errorf("%w: note must be at most %d characters", domain.ErrInvalidInput, MaxNoteLength)
+	}
+
+	data, counts, err := s.repo.ExportState(ctx)
+	if err != nil {
+		return nil, err
+	}
+	payload, err := encode(data)
+	if err != nil {
+		return nil, err
+	}
+
+	snap, err := s.repo.SaveSnapshot(ctx, domain.GameSnapshot{
+		Trigger:       req.Trigger,
+		Note:          req.Note,
+		CreatedBy:     req.CreatedBy,
+		SchemaVersion: data.SchemaVersion,
+		RowCounts:     counts,
+		SizeBytes:     int64(len(payload)),
+	}, payload)
+	if err != nil {
+		return nil, err
+	}
+
+	logger.FromContext(ctx).Info(LogMsgSnapshotCreated, "snapshot_id", snap.ID, "trigger", snap.Trigger, "created_by", snap.CreatedBy, "size_bytes", snap.SizeBytes)
+	return snap, nil
+}
+
+// List returns snapshots straight from the repository
+func (s *service) List(ctx context.Context) ([]domain.GameSnapshot, error) {
+	return s.repo.ListSnapshots(ctx)
+}
+
+// Export loads and decompresses a stored document
+func (s *service) Export(ctx context.Context, id int64) (*domain.SnapshotData, error) {
+	payload, err := s.repo.GetSnapshotPayload(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if payload == nil {
+		return nil, domain.ErrSnapshotNotFound
+	}
+	return decode(payload)
+}
+
+// Delete removes a stored snapshot
+func (s *service) Delete(ctx context.Context, id int64) error {
+	deleted, err := s.repo.DeleteSnapshot(ctx, id)
+	if err != nil {
+		return err
+	}
+	if !deleted {
+		return domain.ErrSnapshotNotFound
+	}
+
+	logger.FromContext(ctx).Info(LogMsgSnapshotDeleted, "snapshot_id", id)
+	return nil
+}
+
+// Restore loads a stored document and restores it
+func (s *service) Restore(ctx context.Context, id int64, restoredBy string) (*RestoreResult, error) {
+	data, err := s.Export(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return s.restore(ctx, id, data, restoredBy)
+}
+
+// Import restores an uploaded document
+func (s *service) Import(ctx context.Context, data *domain.SnapshotData, restoredBy string) (*RestoreResult, error) {
+	if data == nil || len(data.Tables) == 0 {
+		return nil, fmt.Errorf("%w: snapshot has no tables", domain.ErrInvalidInput)
+	}
+	return s.restore(ctx, 0, data, restoredBy)
+}
+
+// Prune keeps the newest scheduled snapshots. Manual and pre-restore
+// snapshots are only removed by an admin.
+func (s *service) Prune(ctx context.Context) (int64, error) {
+	pruned, err := s.repo.PruneSnapshots(ctx, domain.SnapshotTriggerScheduled, s.cfg.Retention)
+	if err != nil {
+		return 0, err
+	}
+	if pruned > 0 {
+		logger.FromContext(ctx).Info(LogMsgSnapshotsPruned, "pruned", pruned, "retention", s.cfg.Retention)
+	}
+	return pruned, nil
+}
+
+// restore checks the document matches the current schema, snapshots the state
+// it is about to replace, then imports the document and tells in-memory caches
+// to reload
+func (s *service) restore(ctx context.Context, snapshotID int64, data *domain.SnapshotData, restoredBy string) (*RestoreResult, error) {
+	if restoredBy == "" {
+		return nil, fmt.Errorf("%w: restored_by is required", domain.ErrInvalidInput)
+	}
+
+	s.restoreMu.Lock()
+	defer s.restoreMu.Unlock()
+
+	current, err := s.repo.SchemaVersion(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if data.SchemaVersion != current {
+		return nil, fmt.Errorf("%w: snapshot is at version %d, database is at %d", domain.ErrSnapshotSchemaMismatch, data.SchemaVersion, current)
+	}
+
+	safety, err := s.Create(ctx, CreateRequest{
+		Trigger:   domain.SnapshotTriggerPreRestore,
+		Note:      fmt.Sprintf("before restore of snapshot %d", snapshotID),
+		CreatedBy: restoredBy,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to take safety snapshot: %w", err)
+	}
+
+	counts, err := s.repo.ImportState(ctx, data)
+	if err != nil {
+		return nil, err
+	}
+
+	if s.publisher != nil {
+		s.publisher.PublishWithRetry(ctx, event.NewSnapshotRestoredEvent(snapshotID, restoredBy))
+	}
+
+	logger.FromContext(ctx).Warn(LogMsgSnapshotRestored, "snapshot_id", snapshotID, "safety_snapshot_id", safety.ID, "restored_by", restoredBy, "row_counts", counts)
+	return &RestoreResult{SafetySnapshotID: safety.ID, RowCounts: counts}, nil
+}
+
+func encode(data *domain.SnapshotData) ([]byte, error) {
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	if err := json.NewEncoder(zw).Encode(data); err != nil {
+		return nil, fmt.Errorf("failed to encode snapshot: %w", err)
+	}
+	if err := zw.Close(); err != nil {
+		return nil, fmt.Errorf("failed to compress snapshot: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+func decode(payload []byte) (*domain.SnapshotData, error) {
+	zr, err := gzip.NewReader(bytes.NewReader(payload))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decompress snapshot: %w", err)
+	}
+	defer zr.Close()
+
+	raw, err := io.ReadAll(zr)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decompress snapshot: %w", err)
+	}
+	var data domain.SnapshotData
+	if err := json.Unmarshal(raw, &data); err != nil {
+		return nil, fmt.Errorf("failed to decode snapshot: %w", err)
+	}
+	return &data, nil
+}
@@ -0,0 +1,208 @@
+package snapshot_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/event"
+	"github.com/osse101/BrandishBot_Go/internal/snapshot"
+	"github.com/osse101/BrandishBot_Go/internal/snapshot/mocks"
+)
+
+func testData(version int64) *domain.SnapshotData {
+	return &domain.SnapshotData{
+		SchemaVersion: version,
+		TakenAt:       time.Date(2026, 1, 2, 3, 0, 0, 0, time.UTC),
+		Tables: map[string]json.RawMessage{
+			"users":          json.RawMessage(`[{"user_id":"u1","username":"alice"}]`),
+			"user_inventory": json.RawMessage(`[]`),
+		},
+	}
+}
+
+// expectSave stores the snapshot with the given ID and hands back its payload
+func expectSave(mockRepo *mocks.MockRepository, id int64, payload *[]byte) {
+	mockRepo.On("SaveSnapshot", mock.Anything, mock.Anything, mock.Anything).
+		Return(func(_ context.Context, snap domain.GameSnapshot, p []byte) (*domain.GameSnapshot, error) {
+			if payload != nil {
+				*payload = p
+			}
+			snap.ID = id
+			return &snap, nil
+		}).Once()
+}
+
+func TestCreate(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("stores a compressed export that reads back", func(t *testing.T) {
+		mockRepo := mocks.NewMockRepository(t)
+		mockPublisher := mocks.NewMockPublisher(t)
+		svc := snapshot.NewService(mockRepo, mockPublisher, snapshot.Config{Retention: 3})
+
+		data := testData(69)
+		counts := map[string]int{"users": 1, "user_inventory": 0}
+		mockRepo.On("ExportState", ctx).Return(data, counts, nil)
+		var payload []byte
+		expectSave(mockRepo, 5, &payload)
+
+		snap, err := svc.Create(ctx, snapshot.CreateRequest{Trigger: domain.SnapshotTriggerManual, Note: "before patch", CreatedBy: "admin"})
+
+		require.NoError(t, err)
+		assert.Equal(t, int64(5), snap.ID)
+		assert.Equal(t, int64(69), snap.SchemaVersion)
+		assert.Equal(t, counts, snap.RowCounts)
+		assert.Equal(t, int64(len(payload)), snap.SizeBytes)
+
+		mockRepo.On("GetSnapshotPayload", ctx, int64(5)).Return(payload, nil)
+		exported, err := svc.Export(ctx, 5)
+		require.NoError(t, err)
+		assert.Equal(t, data.SchemaVersion, exported.SchemaVersion)
+		assert.Equal(t, data.TakenAt, exported.TakenAt)
+		assert.JSONEq(t, string(data.Tables["users"]), string(exported.Tables["users"]))
+	})
+
+	t.Run("rejects a long note", func(t *testing.T) {
+		mockRepo := mocks.NewMockRepository(t)
+		mockPublisher := mocks.NewMockPublisher(t)
+		svc := snapshot.NewService(mockRepo, mockPublisher, snapshot.Config{Retention: 3})
+
+		_, err := svc.Create(ctx, snapshot.CreateRequest{Trigger: domain.SnapshotTriggerManual, Note: string(make([]byte, snapshot.MaxNoteLength+1)), CreatedBy: "admin"})
+
+		assert.ErrorIs(t, err, domain.ErrInvalidInput)
+	})
+}
+
+func TestExport_Unknown(t *testing.T) {
+	ctx := context.Background()
+	mockRepo := mocks.NewMockRepository(t)
+	mockPublisher := mocks.NewMockPublisher(t)
+	svc := snapshot.NewService(mockRepo, mockPublisher, snapshot.Config{Retention: 3})
+
+	mockRepo.On("GetSnapshotPayload", ctx, int64(9)).Return(nil, nil)
+
+	_, err := svc.Export(ctx, 9)
+
+	assert.ErrorIs(t, err, domain.ErrSnapshotNotFound)
+}
+
+func TestRestore(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("takes a safety snapshot, imports and publishes", func(t *testing.T) {
+		mockRepo := mocks.NewMockRepository(t)
+		mockPublisher := mocks.NewMockPublisher(t)
+		svc := snapshot.NewService(mockRepo, mockPublisher, snapshot.Config{Retention: 3})
+
+		data := testData(69)
+
+		// Store the snapshot being restored so Restore can load it
+		mockRepo.On("ExportState", ctx).Return(data, map[string]int{}, nil)
+		var payload []byte
+		expectSave(mockRepo, 5, &payload)
+		_, err := svc.Create(ctx, snapshot.CreateRequest{Trigger: domain.SnapshotTriggerManual, CreatedBy: "admin"})
+		require.NoError(t, err)
+
+		mockRepo.On("GetSnapshotPayload", ctx, int64(5)).Return(payload, nil)
+		mockRepo.On("SchemaVersion", ctx).Return(int64(69), nil)
+		mockRepo.On("ExportState", ctx).Return(testData(69), map[string]int{}, nil)
+		mockRepo.On("SaveSnapshot", ctx, mock.MatchedBy(func(s domain.GameSnapshot) bool {
+			return s.Trigger == domain.SnapshotTriggerPreRestore && s.CreatedBy == "ops"
+		}), mock.Anything).Return(&domain.GameSnapshot{ID: 6}, nil)
+		mockRepo.On("ImportState", ctx, mock.MatchedBy(func(got *domain.SnapshotData) bool {
+			return got.SchemaVersion == 69 && len(got.Tables) == 2
+		})).Return(map[string]int{"users": 1}, nil)
+		mockPublisher.On("PublishWithRetry", ctx, mock.MatchedBy(func(e event.Event) bool {
+			p, ok := e.Payload.(event.SnapshotRestoredPayloadV1)
+			return e.Type == event.SnapshotRestored && ok && p.SnapshotID == 5 && p.RestoredBy == "ops"
+		}))
+
+		result, err := svc.Restore(ctx, 5, "ops")
+
+		require.NoError(t, err)
+		assert.Equal(t, int64(6), result.SafetySnapshotID)
+		assert.Equal(t, map[string]int{"users": 1}, result.RowCounts)
+	})
+
+	t.Run("refuses a snapshot from another schema version", func(t *testing.T) {
+		mockRepo := mocks.NewMockRepository(t)
+		mockPublisher := mocks.NewMockPublisher(t)
+		svc := snapshot.NewService(mockRepo, mockPublisher, snapshot.Config{Retention: 3})
+
+		mockRepo.On("SchemaVersion", ctx).Return(int64(70), nil)
+
+		_, err := svc.Import(ctx, testData(69), "ops")
+
+		assert.ErrorIs(t, err, domain.ErrSnapshotSchemaMismatch)
+	})
+
+	t.Run("leaves state alone when the safety snapshot fails", func(t *testing.T) {
+		mockRepo := mocks.NewMockRepository(t)
+		mockPublisher := mocks.NewMockPublisher(t)
+		svc := snapshot.NewService(mockRepo, mockPublisher, snapshot.Config{Retention: 3})
+
+		mockRepo.On("SchemaVersion", ctx).Return(int64(69), nil)
+		mockRepo.On("ExportState", ctx).Return(nil, nil, assert.AnError)
+
+		_, err := svc.Import(ctx, testData(69), "ops")
+
+		assert.ErrorIs(t, err, assert.AnError)
+	})
+
+	t.Run("rejects an empty upload", func(t *testing.T) {
+		mockRepo := mocks.NewMockRepository(t)
+		mockPublisher := mocks.NewMockPublisher(t)
+		svc := snapshot.NewService(mockRepo, mockPublisher, snapshot.Config{Retention: 3})
+
+		_, err := svc.Import(ctx, &domain.SnapshotData{SchemaVersion: 69}, "ops")
+
+		assert.ErrorIs(t, err, domain.ErrInvalidInput)
+	})
+
+	t.Run("requires who restored", func(t *testing.T) {
+		mockRepo := mocks.NewMockRepository(t)
+		mockPublisher := mocks.NewMockPublisher(t)
+		svc := snapshot.NewService(mockRepo, mockPublisher, snapshot.Config{Retention: 3})
+
+		_, err := svc.Import(ctx, testData(69), "")
+
+		assert.ErrorIs(t, err, domain.ErrInvalidInput)
+	})
+}
+
+func TestDelete_Unknown(t *testing.T) {
+	ctx := context.Background()
+	mockRepo := mocks.NewMockRepository(t)
+	mockPublisher := mocks.NewMockPublisher(t)
+	svc := snapshot.NewService(mockRepo, mockPublisher, snapshot.Config{Retention: 3})
+
+	mockRepo.On("DeleteSnapshot", ctx, int64(9)).Return(false, nil)
+
+	err := svc.Delete(ctx, 9)
+
+	assert.ErrorIs(t, err, domain.ErrSnapshotNotFound)
+}
+
+func TestJob_SnapshotsThenPrunes(t *testing.T) {
+	ctx := context.Background()
+	mockRepo := mocks.NewMockRepository(t)
+	mockPublisher := mocks.NewMockPublisher(t)
+	svc := snapshot.NewService(mockRepo, mockPublisher, snapshot.Config{Retention: 3})
+
+	mockRepo.On("ExportState", ctx).Return(testData(69), map[string]int{}, nil)
+	mockRepo.On("SaveSnapshot", ctx, mock.MatchedBy(func(s domain.GameSnapshot) bool {
+		return s.Trigger == domain.SnapshotTriggerScheduled
+	}), mock.Anything).Return(&domain.GameSnapshot{ID: 1}, nil)
+	mockRepo.On("PruneSnapshots", ctx, domain.SnapshotTriggerScheduled, 3).Return(int64(2), nil)
+
+	err := snapshot.NewJob(svc).Process(ctx)
+
+	require.NoError(t, err)
+}
@@ -60,6 +60,99 @@ const (
 
 	// EventTypeSubscription is sent for subscription lifecycle events
 	EventTypeSubscription = "subscription"
+
+	// EventTypeCelebration is sent when a user is rewarded for a birthday or account anniversary
+	EventTypeCelebration = "celebration"
+
+	// EventTypeGambleRecovered is sent when a stale gamble is resumed or refunded by the recovery job
+	EventTypeGambleRecovered = "gamble.recovered"
+
+	// EventTypeVotesFlagged is sent when brigading detection flags votes for admin review
+	EventTypeVotesFlagged = "progression.votes_flagged"
+
+	// EventTypeGiveFlagged is sent when a give is flagged for admin review as likely funneling
+	EventTypeGiveFlagged = "give.flagged"
+
+	// EventTypeReminderDue is sent when a user's reminder falls due. Clients
+	// deliver it on the reminder's platform.
+	EventTypeReminderDue = "reminder.due"
+
+	// EventTypeItemLoan is sent when items are lent and when a loan is
+	// returned or falls due
+	EventTypeItemLoan = "item_loan"
+
+	// EventTypePlayerShopSold is sent when items are bought from a player
+	// shop listing, so the seller can be told
+	EventTypePlayerShopSold = "player_shop_sold"
+
+	// EventTypeInventoryChanged is sent after every inventory write with the
+	// per-item diff, so overlays can mirror inventories without polling
+	EventTypeInventoryChanged = "inventory_changed"
+
+	// EventTypeChatDrop is sent when chat activity drops items to active
+	// chatters, so chat bots and overlays can announce the winners
+	EventTypeChatDrop = "chat_drop"
+
+	// EventTypeKeywordDrop is sent when a chat keyword drops an item to its
+	// sender during an event theme
+	EventTypeKeywordDrop = "keyword_drop"
+
+	// EventTypeCommunityBonus is sent when a raid or host grants the
+	// community timed bonuses, so overlays can show them
+	EventTypeCommunityBonus = "community_bonus"
+
+	// EventTypeJackpotWon is sent when an opening wins the progressive
+	// jackpot, so chat bots and overlays can celebrate the winner
+	EventTypeJackpotWon = "jackpot_won"
+
+	// EventTypeDigMilestone is sent when the community dig site reaches a
+	// level for the first time, so the Discord bot can announce it in the
+	// digging game channel
+	EventTypeDigMilestone = "digging.milestone"
+
+	// EventTypeFishingBite is sent when a fish bites a user's line. Clients
+	// prompt the user to reel in on the cast's platform before reel_by.
+	EventTypeFishingBite = "fishing.bite"
+
+	// EventTypeBossSpawned is sent when a boss appears for the community to
+	// fight, so the Discord bot can rally attackers
+	EventTypeBossSpawned = "boss.spawned"
+
+	// EventTypeBossDamaged is sent after every attack on the boss, so
+	// overlays can show its HP bar going down
+	EventTypeBossDamaged = "boss.damaged"
+
+	// EventTypeBossDefeated is sent when the boss falls, with each
+	// attacker's share of its lootboxes
+	EventTypeBossDefeated = "boss.defeated"
+
+	// EventTypeBossEscaped is sent when a boss's time runs out before it
+	// falls
+	EventTypeBossEscaped = "boss.escaped"
+
+	// EventTypeGiftDelivered is sent when a wrapped gift reaches its
+	// recipient. Clients announce it on the gift's platform.
+	EventTypeGiftDelivered = "gift.delivered"
+
+	// EventTypeStreamStarted is sent when a stream session opens
+	EventTypeStreamStarted = "stream.started"
+
+	// EventTypeStreamEnded is sent when a stream session closes
+	EventTypeStreamEnded = "stream.ended"
+
+	// EventTypeStreamRecap is sent with the recap of a stream that just
+	// ended. The Discord bot posts it as an embed.
+	EventTypeStreamRecap = "stream.recap"
+
+	// EventTypeAnnouncement is sent with a message an announcement route
+	// rendered for a Discord channel. Messages routed to an SSE topic are
+	// sent with the topic as their event type instead.
+	EventTypeAnnouncement = "announcement"
+
+	// EventTypeAnnouncementRoutesChanged is sent when an admin changes the
+	// announcement routes, so the Discord bot can reload which events it
+	// announces itself
+	EventTypeAnnouncementRoutesChanged = "announcement.routes_changed"
 )
 
 // Log messages
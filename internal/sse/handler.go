@@ -31,10 +31,11 @@ func Handler(hub *Hub) http.HandlerFunc {
 		}
 
 		// Register client
-		client := hub.Register(eventTypes)
+		client := hub.Register(r.Context(), eventTypes)
 		slog.Info(LogMsgClientConnected,
 			"client_id", client.ID,
 			"filters", eventTypes,
+			"community", client.Community,
 			"total_clients", hub.ClientCount())
 
 		// Ensure cleanup on disconnect
@@ -53,6 +54,7 @@ func Handler(hub *Hub) http.HandlerFunc {
 			Payload: map[string]interface{}{
 				"client_id": client.ID,
 				"filters":   eventTypes,
+				"community": client.Community,
 			},
 		}
 		if msg, err := FormatSSEMessage(connectEvent); err == nil {
@@ -1,11 +1,14 @@
 package sse
 
 import (
+	"context"
 	"encoding/json"
 	"sync"
 	"time"
 
 	"github.com/google/uuid"
+
+	"github.com/osse101/BrandishBot_Go/internal/community"
 )
 
 // Event represents an event sent over SSE
@@ -14,6 +17,7 @@ type Event struct {
 	Type      string      `json:"type"`
 	Timestamp int64       `json:"timestamp"`
 	Payload   interface{} `json:"payload"`
+	Community string      `json:"community"`
 }
 
 // Client represents a connected SSE client
@@ -21,6 +25,7 @@ type Client struct {
 	ID           string
 	EventChannel chan Event
 	EventFilter  map[string]bool // nil means all events, otherwise only specified types
+	Community    string          // Only events from this community are sent
 	done         chan struct{}
 }
 
@@ -89,6 +94,11 @@ func (h *Hub) run() {
 		case event := <-h.broadcast:
 			h.mu.RLock()
 			for _, client := range h.clients {
+				// Clients only see their own community's events
+				if client.Community != event.Community {
+					continue
+				}
+
 				// Check if client wants this event type
 				if client.EventFilter != nil && !client.EventFilter[event.Type] {
 					continue
@@ -110,11 +120,13 @@ func (h *Hub) run() {
 	}
 }
 
-// Register adds a new client to the hub
-func (h *Hub) Register(eventTypes []string) *Client {
+// Register adds a new client to the hub, subscribed to the community ctx
+// is scoped to
+func (h *Hub) Register(ctx context.Context, eventTypes []string) *Client {
 	client := &Client{
 		ID:           uuid.New().String(),
 		EventChannel: make(chan Event, ClientEventBuffer),
+		Community:    community.FromContext(ctx),
 		done:         make(chan struct{}),
 	}
 
@@ -138,13 +150,15 @@ func (h *Hub) Unregister(clientID string) {
 	}
 }
 
-// Broadcast sends an event to all interested clients
-func (h *Hub) Broadcast(eventType string, payload interface{}) {
+// Broadcast sends an event to all interested clients in the community ctx
+// is scoped to
+func (h *Hub) Broadcast(ctx context.Context, eventType string, payload interface{}) {
 	event := Event{
 		ID:        uuid.New().String(),
 		Type:      eventType,
 		Timestamp: time.Now().Unix(),
 		Payload:   payload,
+		Community: community.FromContext(ctx),
 	}
 
 	select {
@@ -4,8 +4,11 @@ import (
 	"context"
 	"log/slog"
 
+	"github.com/google/uuid"
+
 	"github.com/osse101/BrandishBot_Go/internal/domain"
 	"github.com/osse101/BrandishBot_Go/internal/event"
+	"github.com/osse101/BrandishBot_Go/internal/ids"
 )
 
 // Subscriber bridges the internal event bus to the SSE hub
@@ -25,39 +28,96 @@ func NewSubscriber(hub *Hub, bus event.Bus) *Subscriber {
 // Subscribe registers handlers for all relevant event types
 func (s *Subscriber) Subscribe() {
 	// Subscribe to job level up events
-	s.bus.Subscribe(event.Type(domain.EventTypeJobLevelUp), s.handleJobLevelUp)
+	event.SubscribeShared(s.bus, event.Type(domain.EventTypeJobLevelUp), s.handleJobLevelUp)
 
 	// Subscribe to progression cycle completed events
-	s.bus.Subscribe(event.ProgressionCycleCompleted, s.handleCycleCompleted)
+	event.SubscribeShared(s.bus, event.ProgressionCycleCompleted, s.handleCycleCompleted)
 
 	// Subscribe to progression voting started events
-	s.bus.Subscribe(event.ProgressionVotingStarted, s.handleVotingStarted)
+	event.SubscribeShared(s.bus, event.ProgressionVotingStarted, s.handleVotingStarted)
 
 	// Subscribe to progression target set (can indicate auto-selected voting)
-	s.bus.Subscribe(event.ProgressionTargetSet, s.handleTargetSet)
+	event.SubscribeShared(s.bus, event.ProgressionTargetSet, s.handleTargetSet)
 
 	// Subscribe to progression all unlocked events
-	s.bus.Subscribe(event.ProgressionAllUnlocked, s.handleAllUnlocked)
+	event.SubscribeShared(s.bus, event.ProgressionAllUnlocked, s.handleAllUnlocked)
 
 	// Subscribe to timeout events
-	s.bus.Subscribe(event.TimeoutApplied, s.handleTimeoutApplied)
-	s.bus.Subscribe(event.TimeoutCleared, s.handleTimeoutCleared)
+	event.SubscribeShared(s.bus, event.TimeoutApplied, s.handleTimeoutApplied)
+	event.SubscribeShared(s.bus, event.TimeoutCleared, s.handleTimeoutCleared)
 
 	// Subscribe to gamble completed events
-	s.bus.Subscribe(event.Type(domain.EventGambleCompleted), s.handleGambleCompleted)
+	event.SubscribeShared(s.bus, event.Type(domain.EventGambleCompleted), s.handleGambleCompleted)
 
 	// Subscribe to expedition events
-	s.bus.Subscribe(event.Type(domain.EventExpeditionStarted), s.handleExpeditionStarted)
-	s.bus.Subscribe(event.Type(domain.EventExpeditionTurn), s.handleExpeditionTurn)
-	s.bus.Subscribe(event.Type(domain.EventExpeditionCompleted), s.handleExpeditionCompleted)
+	event.SubscribeShared(s.bus, event.Type(domain.EventExpeditionStarted), s.handleExpeditionStarted)
+	event.SubscribeShared(s.bus, event.Type(domain.EventExpeditionTurn), s.handleExpeditionTurn)
+	event.SubscribeShared(s.bus, event.Type(domain.EventExpeditionCompleted), s.handleExpeditionCompleted)
 
 	// Subscribe to subscription events
-	s.bus.Subscribe(event.SubscriptionActivated, s.handleSubscriptionEvent)
-	s.bus.Subscribe(event.SubscriptionRenewed, s.handleSubscriptionEvent)
-	s.bus.Subscribe(event.SubscriptionUpgraded, s.handleSubscriptionEvent)
-	s.bus.Subscribe(event.SubscriptionDowngraded, s.handleSubscriptionEvent)
-	s.bus.Subscribe(event.SubscriptionExpired, s.handleSubscriptionEvent)
-	s.bus.Subscribe(event.SubscriptionCancelled, s.handleSubscriptionEvent)
+	event.SubscribeShared(s.bus, event.SubscriptionActivated, s.handleSubscriptionEvent)
+	event.SubscribeShared(s.bus, event.SubscriptionRenewed, s.handleSubscriptionEvent)
+	event.SubscribeShared(s.bus, event.SubscriptionUpgraded, s.handleSubscriptionEvent)
+	event.SubscribeShared(s.bus, event.SubscriptionDowngraded, s.handleSubscriptionEvent)
+	event.SubscribeShared(s.bus, event.SubscriptionExpired, s.handleSubscriptionEvent)
+	event.SubscribeShared(s.bus, event.SubscriptionCancelled, s.handleSubscriptionEvent)
+
+	// Subscribe to birthday and anniversary rewards
+	event.SubscribeShared(s.bus, event.CelebrationGranted, s.handleCelebrationGranted)
+
+	// Subscribe to stale gamble recoveries
+	event.SubscribeShared(s.bus, event.GambleRecovered, s.handleGambleRecovered)
+
+	// Subscribe to votes flagged by brigading detection
+	event.SubscribeShared(s.bus, event.ProgressionVotesFlagged, s.handleVotesFlagged)
+
+	// Subscribe to gives flagged as likely funneling
+	event.SubscribeShared(s.bus, event.GiveFlagged, s.handleGiveFlagged)
+
+	// Subscribe to reminders falling due
+	event.SubscribeShared(s.bus, event.ReminderDue, s.handleReminderDue)
+
+	// Subscribe to item loans being made and closed
+	event.SubscribeShared(s.bus, event.ItemLoaned, s.handleItemLoan)
+	event.SubscribeShared(s.bus, event.ItemLoanReturned, s.handleItemLoan)
+
+	// Subscribe to player shop sales
+	event.SubscribeShared(s.bus, event.PlayerShopSold, s.handlePlayerShopSold)
+
+	// Subscribe to inventory diffs so external systems can mirror inventories
+	event.SubscribeShared(s.bus, event.InventoryChanged, s.handleInventoryChanged)
+
+	// Subscribe to chat activity drops
+	event.SubscribeShared(s.bus, event.ChatDropped, s.handleChatDropped)
+	event.SubscribeShared(s.bus, event.KeywordDropped, s.handleKeywordDropped)
+
+	// Subscribe to raid and host bonuses
+	event.SubscribeShared(s.bus, event.CommunityBonusGranted, s.handleCommunityBonusGranted)
+	event.SubscribeShared(s.bus, event.StreamStarted, s.handleStreamSession)
+	event.SubscribeShared(s.bus, event.StreamEnded, s.handleStreamSession)
+	event.SubscribeShared(s.bus, event.StreamRecapGenerated, s.handleStreamRecap)
+
+	// Subscribe to progressive jackpot wins
+	event.SubscribeShared(s.bus, event.JackpotWon, s.handleJackpotWon)
+
+	// Subscribe to dig site milestones
+	event.SubscribeShared(s.bus, event.DigMilestoneReached, s.handleDigMilestone)
+
+	// Subscribe to fish biting
+	event.SubscribeShared(s.bus, event.FishingBite, s.handleFishingBite)
+
+	// Subscribe to boss raids
+	event.SubscribeShared(s.bus, event.BossSpawned, s.handleBossSpawned)
+	event.SubscribeShared(s.bus, event.BossDamaged, s.handleBossDamaged)
+	event.SubscribeShared(s.bus, event.BossDefeated, s.handleBossDefeated)
+	event.SubscribeShared(s.bus, event.BossEscaped, s.handleBossEscaped)
+
+	// Subscribe to gift deliveries
+	event.SubscribeShared(s.bus, event.GiftDelivered, s.handleGiftDelivered)
+
+	// Subscribe to routed announcements and changes to the routes
+	event.SubscribeShared(s.bus, event.AnnouncementRouted, s.handleAnnouncement)
+	event.SubscribeShared(s.bus, event.AnnouncementRoutesChanged, s.handleAnnouncementRoutesChanged)
 
 	slog.Info("SSE subscriber registered for event types",
 		"types", []string{
@@ -76,11 +136,36 @@ func (s *Subscriber) Subscribe() {
 			string(event.SubscriptionRenewed),
 			string(event.SubscriptionExpired),
 			string(event.SubscriptionCancelled),
+			string(event.CelebrationGranted),
+			string(event.GambleRecovered),
+			string(event.ProgressionVotesFlagged),
+			string(event.GiveFlagged),
+			string(event.ReminderDue),
+			string(event.ItemLoaned),
+			string(event.ItemLoanReturned),
+			string(event.PlayerShopSold),
+			string(event.InventoryChanged),
+			string(event.ChatDropped),
+			string(event.KeywordDropped),
+			string(event.CommunityBonusGranted),
+			string(event.StreamStarted),
+			string(event.StreamEnded),
+			string(event.StreamRecapGenerated),
+			string(event.JackpotWon),
+			string(event.DigMilestoneReached),
+			string(event.FishingBite),
+			string(event.BossSpawned),
+			string(event.BossDamaged),
+			string(event.BossDefeated),
+			string(event.BossEscaped),
+			string(event.GiftDelivered),
+			string(event.AnnouncementRouted),
+			string(event.AnnouncementRoutesChanged),
 		})
 }
 
 // handleJobLevelUp processes job level up events and broadcasts to SSE clients
-func (s *Subscriber) handleJobLevelUp(_ context.Context, evt event.Event) error {
+func (s *Subscriber) handleJobLevelUp(ctx context.Context, evt event.Event) error {
 	payload, err := event.DecodePayload[event.JobLevelUpPayloadV1](evt.Payload)
 	if err != nil {
 		slog.Warn("Invalid job level up event payload type", "error", err)
@@ -106,7 +191,7 @@ func (s *Subscriber) handleJobLevelUp(_ context.Context, evt event.Event) error
 		Source:   source,
 	}
 
-	s.hub.Broadcast(EventTypeJobLevelUp, ssePayload)
+	s.hub.Broadcast(ctx, EventTypeJobLevelUp, ssePayload)
 
 	slog.Debug(LogMsgEventBroadcast,
 		"event_type", EventTypeJobLevelUp,
@@ -118,7 +203,7 @@ func (s *Subscriber) handleJobLevelUp(_ context.Context, evt event.Event) error
 }
 
 // handleCycleCompleted processes progression cycle completed events
-func (s *Subscriber) handleCycleCompleted(_ context.Context, evt event.Event) error {
+func (s *Subscriber) handleCycleCompleted(ctx context.Context, evt event.Event) error {
 	payload, err := event.DecodePayload[event.ProgressionCycleCompletedPayloadV1](evt.Payload)
 	if err != nil {
 		slog.Warn("Invalid cycle completed event payload type", "error", err)
@@ -134,7 +219,7 @@ func (s *Subscriber) handleCycleCompleted(_ context.Context, evt event.Event) er
 		},
 	}
 
-	s.hub.Broadcast(EventTypeCycleCompleted, ssePayload)
+	s.hub.Broadcast(ctx, EventTypeCycleCompleted, ssePayload)
 
 	slog.Debug(LogMsgEventBroadcast,
 		"event_type", EventTypeCycleCompleted,
@@ -144,7 +229,7 @@ func (s *Subscriber) handleCycleCompleted(_ context.Context, evt event.Event) er
 }
 
 // handleVotingStarted processes voting session started events
-func (s *Subscriber) handleVotingStarted(_ context.Context, evt event.Event) error {
+func (s *Subscriber) handleVotingStarted(ctx context.Context, evt event.Event) error {
 	payload, err := event.DecodePayload[event.ProgressionVotingStartedPayloadV1](evt.Payload)
 	if err != nil {
 		slog.Warn("Invalid voting started event payload type", "error", err)
@@ -167,7 +252,7 @@ func (s *Subscriber) handleVotingStarted(_ context.Context, evt event.Event) err
 		})
 	}
 
-	s.hub.Broadcast(EventTypeVotingStarted, ssePayload)
+	s.hub.Broadcast(ctx, EventTypeVotingStarted, ssePayload)
 
 	slog.Debug(LogMsgEventBroadcast,
 		"event_type", EventTypeVotingStarted,
@@ -177,7 +262,7 @@ func (s *Subscriber) handleVotingStarted(_ context.Context, evt event.Event) err
 }
 
 // handleTargetSet processes progression target set events (voting started)
-func (s *Subscriber) handleTargetSet(_ context.Context, evt event.Event) error {
+func (s *Subscriber) handleTargetSet(ctx context.Context, evt event.Event) error {
 	payload, err := event.DecodePayload[event.ProgressionTargetSetPayloadV1](evt.Payload)
 	if err != nil {
 		slog.Warn("Invalid target set event payload type", "error", err)
@@ -193,7 +278,7 @@ func (s *Subscriber) handleTargetSet(_ context.Context, evt event.Event) error {
 			PreviousUnlock: "",
 		}
 
-		s.hub.Broadcast(EventTypeVotingStarted, ssePayload)
+		s.hub.Broadcast(ctx, EventTypeVotingStarted, ssePayload)
 
 		slog.Debug(LogMsgEventBroadcast,
 			"event_type", EventTypeVotingStarted,
@@ -205,7 +290,7 @@ func (s *Subscriber) handleTargetSet(_ context.Context, evt event.Event) error {
 }
 
 // handleAllUnlocked processes progression all unlocked events
-func (s *Subscriber) handleAllUnlocked(_ context.Context, evt event.Event) error {
+func (s *Subscriber) handleAllUnlocked(ctx context.Context, evt event.Event) error {
 	payload, err := event.DecodePayload[event.ProgressionAllUnlockedPayloadV1](evt.Payload)
 	if err != nil {
 		slog.Warn("Invalid all unlocked event payload type", "error", err)
@@ -216,7 +301,7 @@ func (s *Subscriber) handleAllUnlocked(_ context.Context, evt event.Event) error
 		Message: payload.Message,
 	}
 
-	s.hub.Broadcast(EventTypeAllUnlocked, ssePayload)
+	s.hub.Broadcast(ctx, EventTypeAllUnlocked, ssePayload)
 
 	slog.Debug(LogMsgEventBroadcast,
 		"event_type", EventTypeAllUnlocked)
@@ -254,7 +339,7 @@ func getIntFromMap(m map[string]interface{}, key string) int {
 }
 
 // handleTimeoutApplied processes timeout applied events
-func (s *Subscriber) handleTimeoutApplied(_ context.Context, evt event.Event) error {
+func (s *Subscriber) handleTimeoutApplied(ctx context.Context, evt event.Event) error {
 	// Try typed payload first
 	if payload, ok := evt.Payload.(event.TimeoutPayloadV1); ok {
 		ssePayload := TimeoutPayload{
@@ -265,7 +350,7 @@ func (s *Subscriber) handleTimeoutApplied(_ context.Context, evt event.Event) er
 			Reason:          payload.Reason,
 		}
 
-		s.hub.Broadcast(EventTypeTimeoutApplied, ssePayload)
+		s.hub.Broadcast(ctx, EventTypeTimeoutApplied, ssePayload)
 
 		slog.Debug(LogMsgEventBroadcast,
 			"event_type", EventTypeTimeoutApplied,
@@ -291,7 +376,7 @@ func (s *Subscriber) handleTimeoutApplied(_ context.Context, evt event.Event) er
 		Reason:          getStringFromMap(payload, "reason"),
 	}
 
-	s.hub.Broadcast(EventTypeTimeoutApplied, ssePayload)
+	s.hub.Broadcast(ctx, EventTypeTimeoutApplied, ssePayload)
 
 	slog.Debug(LogMsgEventBroadcast,
 		"event_type", EventTypeTimeoutApplied,
@@ -302,7 +387,7 @@ func (s *Subscriber) handleTimeoutApplied(_ context.Context, evt event.Event) er
 }
 
 // handleTimeoutCleared processes timeout cleared events
-func (s *Subscriber) handleTimeoutCleared(_ context.Context, evt event.Event) error {
+func (s *Subscriber) handleTimeoutCleared(ctx context.Context, evt event.Event) error {
 	// Try typed payload first
 	if payload, ok := evt.Payload.(event.TimeoutPayloadV1); ok {
 		ssePayload := TimeoutPayload{
@@ -312,7 +397,7 @@ func (s *Subscriber) handleTimeoutCleared(_ context.Context, evt event.Event) er
 			DurationSeconds: 0,
 		}
 
-		s.hub.Broadcast(EventTypeTimeoutCleared, ssePayload)
+		s.hub.Broadcast(ctx, EventTypeTimeoutCleared, ssePayload)
 
 		slog.Debug(LogMsgEventBroadcast,
 			"event_type", EventTypeTimeoutCleared,
@@ -336,7 +421,7 @@ func (s *Subscriber) handleTimeoutCleared(_ context.Context, evt event.Event) er
 		DurationSeconds: 0,
 	}
 
-	s.hub.Broadcast(EventTypeTimeoutCleared, ssePayload)
+	s.hub.Broadcast(ctx, EventTypeTimeoutCleared, ssePayload)
 
 	slog.Debug(LogMsgEventBroadcast,
 		"event_type", EventTypeTimeoutCleared,
@@ -347,13 +432,13 @@ func (s *Subscriber) handleTimeoutCleared(_ context.Context, evt event.Event) er
 }
 
 // handleExpeditionStarted processes expedition start events
-func (s *Subscriber) handleExpeditionStarted(_ context.Context, evt event.Event) error {
+func (s *Subscriber) handleExpeditionStarted(ctx context.Context, evt event.Event) error {
 	if exp, ok := evt.Payload.(*domain.Expedition); ok {
 		ssePayload := ExpeditionStartedPayload{
 			ExpeditionID: exp.ID.String(),
 			JoinDeadline: exp.JoinDeadline.Format("2006-01-02 15:04:05"),
 		}
-		s.hub.Broadcast(EventTypeExpeditionStarted, ssePayload)
+		s.hub.Broadcast(ctx, EventTypeExpeditionStarted, ssePayload)
 		slog.Debug(LogMsgEventBroadcast, "event_type", EventTypeExpeditionStarted, "expedition_id", exp.ID)
 		return nil
 	}
@@ -368,12 +453,12 @@ func (s *Subscriber) handleExpeditionStarted(_ context.Context, evt event.Event)
 		ExpeditionID: getStringFromMap(payload, "expedition_id"),
 		JoinDeadline: getStringFromMap(payload, "join_deadline"),
 	}
-	s.hub.Broadcast(EventTypeExpeditionStarted, ssePayload)
+	s.hub.Broadcast(ctx, EventTypeExpeditionStarted, ssePayload)
 	return nil
 }
 
 // handleExpeditionTurn processes expedition turn events
-func (s *Subscriber) handleExpeditionTurn(_ context.Context, evt event.Event) error {
+func (s *Subscriber) handleExpeditionTurn(ctx context.Context, evt event.Event) error {
 	payload, ok := evt.Payload.(map[string]interface{})
 	if !ok {
 		slog.Warn("Invalid expedition turn event payload type")
@@ -388,12 +473,12 @@ func (s *Subscriber) handleExpeditionTurn(_ context.Context, evt event.Event) er
 		Purse:        getIntFromMap(payload, "purse"),
 	}
 
-	s.hub.Broadcast(EventTypeExpeditionTurn, ssePayload)
+	s.hub.Broadcast(ctx, EventTypeExpeditionTurn, ssePayload)
 	return nil
 }
 
 // handleExpeditionCompleted processes expedition completion events
-func (s *Subscriber) handleExpeditionCompleted(_ context.Context, evt event.Event) error {
+func (s *Subscriber) handleExpeditionCompleted(ctx context.Context, evt event.Event) error {
 	payload, ok := evt.Payload.(map[string]interface{})
 	if !ok {
 		slog.Warn("Invalid expedition completed event payload type")
@@ -407,51 +492,48 @@ func (s *Subscriber) handleExpeditionCompleted(_ context.Context, evt event.Even
 		AllKO:        getBoolFromMap(payload, "all_ko"),
 	}
 
-	s.hub.Broadcast(EventTypeExpeditionCompleted, ssePayload)
+	s.hub.Broadcast(ctx, EventTypeExpeditionCompleted, ssePayload)
 	slog.Debug(LogMsgEventBroadcast, "event_type", EventTypeExpeditionCompleted, "expedition_id", ssePayload.ExpeditionID)
 	return nil
 }
 
 // handleGambleCompleted processes gamble completion events
-func (s *Subscriber) handleGambleCompleted(_ context.Context, evt event.Event) error {
-	var totalValue int64
-	var participantCount int
-	var timestamp int64
-	var WinnerUsername string
-	var PrizePool []string
-	payloadMap, ok := evt.Payload.(map[string]interface{})
-	if !ok {
-		slog.Warn("Invalid gamble completed event payload type")
+func (s *Subscriber) handleGambleCompleted(ctx context.Context, evt event.Event) error {
+	payload, err := event.DecodePayload[domain.GambleCompletedPayloadV2](evt.Payload)
+	if err != nil {
+		slog.Warn("Invalid gamble completed event payload type", "error", err)
 		return nil
 	}
-	WinnerUsername = getStringFromMap(payloadMap, "winner_username")
-	totalValue = int64(getIntFromMap(payloadMap, "total_value"))
-	participantCount = getIntFromMap(payloadMap, "participant_count")
-	timestamp = int64(getIntFromMap(payloadMap, "timestamp"))
+
+	var shortCode string
+	if id, err := uuid.Parse(payload.GambleID); err == nil {
+		shortCode = ids.ShortCode(ids.PrefixGamble, id)
+	}
 
 	ssePayload := GambleCompletedPayload{
-		WinnerUsername:   WinnerUsername,
-		TotalValue:       totalValue,
-		ParticipantCount: participantCount,
-		PrizePool:        PrizePool,
-		Timestamp:        timestamp,
+		GambleID:         payload.GambleID,
+		ShortCode:        shortCode,
+		WinnerUsername:   payload.WinnerUsername,
+		TotalValue:       payload.TotalValue,
+		ParticipantCount: payload.ParticipantCount,
+		Timestamp:        payload.Timestamp,
 	}
 
-	s.hub.Broadcast(EventTypeGambleCompleted, ssePayload)
+	s.hub.Broadcast(ctx, EventTypeGambleCompleted, ssePayload)
 
 	slog.Debug(LogMsgEventBroadcast,
 		"event_type", EventTypeGambleCompleted,
+		"short_code", ssePayload.ShortCode,
 		"winner_username", ssePayload.WinnerUsername,
 		"total_value", ssePayload.TotalValue,
 		"participant_count", ssePayload.ParticipantCount,
-		"prize_pool", ssePayload.PrizePool,
 		"timestamp", ssePayload.Timestamp)
 
 	return nil
 }
 
 // handleSubscriptionEvent processes subscription lifecycle events
-func (s *Subscriber) handleSubscriptionEvent(_ context.Context, evt event.Event) error {
+func (s *Subscriber) handleSubscriptionEvent(ctx context.Context, evt event.Event) error {
 	// Try typed payload first
 	if payload, ok := evt.Payload.(event.SubscriptionPayloadV1); ok {
 		ssePayload := SubscriptionPayload{
@@ -462,7 +544,7 @@ func (s *Subscriber) handleSubscriptionEvent(_ context.Context, evt event.Event)
 			Timestamp: payload.Timestamp,
 		}
 
-		s.hub.Broadcast(EventTypeSubscription, ssePayload)
+		s.hub.Broadcast(ctx, EventTypeSubscription, ssePayload)
 
 		slog.Debug(LogMsgEventBroadcast,
 			"event_type", EventTypeSubscription,
@@ -489,7 +571,7 @@ func (s *Subscriber) handleSubscriptionEvent(_ context.Context, evt event.Event)
 		Timestamp: int64(getIntFromMap(payload, "timestamp")),
 	}
 
-	s.hub.Broadcast(EventTypeSubscription, ssePayload)
+	s.hub.Broadcast(ctx, EventTypeSubscription, ssePayload)
 
 	slog.Debug(LogMsgEventBroadcast,
 		"event_type", EventTypeSubscription,
@@ -499,3 +581,549 @@ func (s *Subscriber) handleSubscriptionEvent(_ context.Context, evt event.Event)
 
 	return nil
 }
+
+// handleCelebrationGranted broadcasts birthday and anniversary rewards
+func (s *Subscriber) handleCelebrationGranted(ctx context.Context, evt event.Event) error {
+	payload, err := event.DecodePayload[event.CelebrationPayloadV1](evt.Payload)
+	if err != nil {
+		slog.Warn("Invalid celebration event payload type", "error", err)
+		return nil
+	}
+
+	ssePayload := CelebrationPayload{
+		UserID:    payload.UserID,
+		Username:  payload.Username,
+		Kind:      payload.Kind,
+		Years:     payload.Years,
+		ItemName:  payload.ItemName,
+		Quantity:  payload.Quantity,
+		Timestamp: payload.Timestamp,
+	}
+
+	s.hub.Broadcast(ctx, EventTypeCelebration, ssePayload)
+
+	slog.Debug(LogMsgEventBroadcast,
+		"event_type", EventTypeCelebration,
+		"user_id", payload.UserID,
+		"kind", payload.Kind)
+
+	return nil
+}
+
+// handleGambleRecovered broadcasts gambles resolved by the stale gamble recovery job
+func (s *Subscriber) handleGambleRecovered(ctx context.Context, evt event.Event) error {
+	payload, err := event.DecodePayload[event.GambleRecoveredPayloadV1](evt.Payload)
+	if err != nil {
+		slog.Warn("Invalid gamble recovered event payload type", "error", err)
+		return nil
+	}
+
+	ssePayload := GambleRecoveredPayload{
+		GambleID:      payload.GambleID,
+		ShortCode:     payload.ShortCode,
+		PreviousState: payload.PreviousState,
+		Action:        payload.Action,
+		Participants:  payload.Participants,
+		Reason:        payload.Reason,
+		Timestamp:     payload.Timestamp,
+	}
+
+	s.hub.Broadcast(ctx, EventTypeGambleRecovered, ssePayload)
+
+	slog.Debug(LogMsgEventBroadcast,
+		"event_type", EventTypeGambleRecovered,
+		"gamble_id", payload.GambleID,
+		"action", payload.Action)
+
+	return nil
+}
+
+// handleVotesFlagged broadcasts votes flagged by brigading detection
+func (s *Subscriber) handleVotesFlagged(ctx context.Context, evt event.Event) error {
+	payload, err := event.DecodePayload[event.VotesFlaggedPayloadV1](evt.Payload)
+	if err != nil {
+		slog.Warn("Invalid votes flagged event payload type", "error", err)
+		return nil
+	}
+
+	ssePayload := VotesFlaggedPayload{
+		SessionID: payload.SessionID,
+		Flagged:   payload.Flagged,
+		Excluded:  payload.Excluded,
+		Reason:    payload.Reason,
+		Timestamp: payload.Timestamp,
+	}
+
+	s.hub.Broadcast(ctx, EventTypeVotesFlagged, ssePayload)
+
+	slog.Debug(LogMsgEventBroadcast,
+		"event_type", EventTypeVotesFlagged,
+		"session_id", payload.SessionID,
+		"flagged", payload.Flagged)
+
+	return nil
+}
+
+// handleGiveFlagged broadcasts a give flagged as likely funneling
+func (s *Subscriber) handleGiveFlagged(ctx context.Context, evt event.Event) error {
+	payload, err := event.DecodePayload[event.GiveFlaggedPayloadV1](evt.Payload)
+	if err != nil {
+		slog.Warn("Invalid give flagged event payload type", "error", err)
+		return nil
+	}
+
+	ssePayload := GiveFlaggedPayload{
+		FlagID:     payload.FlagID,
+		GiverID:    payload.GiverID,
+		ReceiverID: payload.ReceiverID,
+		ItemName:   payload.ItemName,
+		Quantity:   payload.Quantity,
+		Reason:     payload.Reason,
+		Timestamp:  payload.Timestamp,
+	}
+
+	s.hub.Broadcast(ctx, EventTypeGiveFlagged, ssePayload)
+
+	slog.Debug(LogMsgEventBroadcast,
+		"event_type", EventTypeGiveFlagged,
+		"flag_id", payload.FlagID)
+
+	return nil
+}
+
+// handleReminderDue broadcasts a reminder that has fallen due
+func (s *Subscriber) handleReminderDue(ctx context.Context, evt event.Event) error {
+	payload, err := event.DecodePayload[event.ReminderDuePayloadV1](evt.Payload)
+	if err != nil {
+		slog.Warn("Invalid reminder due event payload type", "error", err)
+		return nil
+	}
+
+	ssePayload := ReminderDuePayload{
+		ReminderID: payload.ReminderID,
+		UserID:     payload.UserID,
+		Platform:   payload.Platform,
+		PlatformID: payload.PlatformID,
+		Kind:       payload.Kind,
+		Target:     payload.Target,
+		Message:    payload.Message,
+		ChannelID:  payload.ChannelID,
+		Timestamp:  payload.Timestamp,
+	}
+
+	s.hub.Broadcast(ctx, EventTypeReminderDue, ssePayload)
+
+	slog.Debug(LogMsgEventBroadcast,
+		"event_type", EventTypeReminderDue,
+		"reminder_id", payload.ReminderID,
+		"platform", payload.Platform)
+
+	return nil
+}
+
+// handleItemLoan broadcasts an item loan being made or closed
+func (s *Subscriber) handleItemLoan(ctx context.Context, evt event.Event) error {
+	payload, err := event.DecodePayload[event.ItemLoanPayloadV1](evt.Payload)
+	if err != nil {
+		slog.Warn("Invalid item loan event payload type", "error", err)
+		return nil
+	}
+
+	ssePayload := ItemLoanPayload{
+		LoanID:           payload.LoanID,
+		EventType:        string(evt.Type),
+		LenderID:         payload.LenderID,
+		BorrowerID:       payload.BorrowerID,
+		ItemName:         payload.ItemName,
+		Quantity:         payload.Quantity,
+		ReturnedQuantity: payload.ReturnedQuantity,
+		Status:           payload.Status,
+		DueAt:            payload.DueAt,
+		Timestamp:        payload.Timestamp,
+	}
+
+	s.hub.Broadcast(ctx, EventTypeItemLoan, ssePayload)
+
+	slog.Debug(LogMsgEventBroadcast,
+		"event_type", EventTypeItemLoan,
+		"sub_event", evt.Type,
+		"loan_id", payload.LoanID)
+
+	return nil
+}
+
+// handlePlayerShopSold broadcasts a sale from a player shop listing
+func (s *Subscriber) handlePlayerShopSold(ctx context.Context, evt event.Event) error {
+	payload, err := event.DecodePayload[event.PlayerShopSoldPayloadV1](evt.Payload)
+	if err != nil {
+		slog.Warn("Invalid player shop sold event payload type", "error", err)
+		return nil
+	}
+
+	ssePayload := PlayerShopSoldPayload{
+		ListingID:  payload.ListingID,
+		SellerID:   payload.SellerID,
+		BuyerID:    payload.BuyerID,
+		ItemName:   payload.ItemName,
+		Quantity:   payload.Quantity,
+		TotalPrice: payload.TotalPrice,
+		Fee:        payload.Fee,
+		Remaining:  payload.Remaining,
+		Timestamp:  payload.Timestamp,
+	}
+
+	s.hub.Broadcast(ctx, EventTypePlayerShopSold, ssePayload)
+
+	slog.Debug(LogMsgEventBroadcast,
+		"event_type", EventTypePlayerShopSold,
+		"listing_id", payload.ListingID)
+
+	return nil
+}
+
+// handleInventoryChanged broadcasts the per-item diff of an inventory write
+func (s *Subscriber) handleInventoryChanged(ctx context.Context, evt event.Event) error {
+	payload, err := event.DecodePayload[event.InventoryChangedPayloadV1](evt.Payload)
+	if err != nil {
+		slog.Warn("Invalid inventory changed event payload type", "error", err)
+		return nil
+	}
+
+	changes := make([]InventoryChange, 0, len(payload.Changes))
+	for _, c := range payload.Changes {
+		changes = append(changes, InventoryChange{
+			ItemID:   c.ItemID,
+			Quality:  c.Quality,
+			Delta:    c.Delta,
+			Quantity: c.Quantity,
+		})
+	}
+
+	ssePayload := InventoryChangedPayload{
+		UserID:    payload.UserID,
+		Reason:    payload.Reason,
+		Changes:   changes,
+		Timestamp: payload.Timestamp,
+	}
+
+	s.hub.Broadcast(ctx, EventTypeInventoryChanged, ssePayload)
+
+	slog.Debug(LogMsgEventBroadcast,
+		"event_type", EventTypeInventoryChanged,
+		"user_id", payload.UserID,
+		"changes", len(changes))
+
+	return nil
+}
+
+// handleChatDropped broadcasts the items a round of chat activity dropped
+func (s *Subscriber) handleChatDropped(ctx context.Context, evt event.Event) error {
+	payload, err := event.DecodePayload[event.ChatDroppedPayloadV1](evt.Payload)
+	if err != nil {
+		slog.Warn("Invalid chat drop event payload type", "error", err)
+		return nil
+	}
+
+	ssePayload := ChatDropPayload{
+		Drops:     make([]ChatDrop, len(payload.Drops)),
+		Chatters:  payload.Chatters,
+		Timestamp: payload.Timestamp,
+	}
+	for i, drop := range payload.Drops {
+		ssePayload.Drops[i] = ChatDrop(drop)
+	}
+
+	s.hub.Broadcast(ctx, EventTypeChatDrop, ssePayload)
+
+	slog.Debug(LogMsgEventBroadcast,
+		"event_type", EventTypeChatDrop,
+		"drops", len(payload.Drops))
+
+	return nil
+}
+
+// handleKeywordDropped broadcasts the item a chat keyword dropped
+func (s *Subscriber) handleKeywordDropped(ctx context.Context, evt event.Event) error {
+	payload, err := event.DecodePayload[event.KeywordDroppedPayloadV1](evt.Payload)
+	if err != nil {
+		slog.Warn("Invalid keyword drop event payload type", "error", err)
+		return nil
+	}
+
+	s.hub.Broadcast(ctx, EventTypeKeywordDrop, KeywordDropPayload{
+		Drop:      ChatDrop(payload.Drop),
+		Keyword:   payload.Keyword,
+		Theme:     payload.Theme,
+		Timestamp: payload.Timestamp,
+	})
+
+	slog.Debug(LogMsgEventBroadcast,
+		"event_type", EventTypeKeywordDrop,
+		"keyword", payload.Keyword)
+
+	return nil
+}
+
+// handleCommunityBonusGranted broadcasts the bonuses a raid or host granted
+func (s *Subscriber) handleCommunityBonusGranted(ctx context.Context, evt event.Event) error {
+	payload, err := event.DecodePayload[event.CommunityBonusGrantedPayloadV1](evt.Payload)
+	if err != nil {
+		slog.Warn("Invalid community bonus event payload type", "error", err)
+		return nil
+	}
+
+	ssePayload := CommunityBonusPayload{
+		Kind:              payload.Kind,
+		Platform:          payload.Platform,
+		FromChannel:       payload.FromChannel,
+		Viewers:           payload.Viewers,
+		Bonuses:           make([]CommunityBonus, len(payload.Effects)),
+		BonusContribution: payload.BonusContribution,
+		Timestamp:         payload.Timestamp,
+	}
+	for i, effect := range payload.Effects {
+		ssePayload.Bonuses[i] = CommunityBonus(effect)
+	}
+
+	s.hub.Broadcast(ctx, EventTypeCommunityBonus, ssePayload)
+
+	slog.Debug(LogMsgEventBroadcast,
+		"event_type", EventTypeCommunityBonus,
+		"kind", payload.Kind)
+
+	return nil
+}
+
+// handleJackpotWon broadcasts a progressive jackpot win
+func (s *Subscriber) handleJackpotWon(ctx context.Context, evt event.Event) error {
+	payload, err := event.DecodePayload[event.JackpotWonPayloadV1](evt.Payload)
+	if err != nil {
+		slog.Warn("Invalid jackpot won event payload type", "error", err)
+		return nil
+	}
+
+	s.hub.Broadcast(ctx, EventTypeJackpotWon, JackpotWonPayload(payload))
+
+	slog.Debug(LogMsgEventBroadcast,
+		"event_type", EventTypeJackpotWon,
+		"user_id", payload.UserID,
+		"amount", payload.Amount)
+
+	return nil
+}
+
+// handleDigMilestone broadcasts the dig site reaching a new level
+func (s *Subscriber) handleDigMilestone(ctx context.Context, evt event.Event) error {
+	payload, err := event.DecodePayload[event.DigMilestonePayloadV1](evt.Payload)
+	if err != nil {
+		slog.Warn("Invalid dig milestone event payload type", "error", err)
+		return nil
+	}
+
+	s.hub.Broadcast(ctx, EventTypeDigMilestone, DigMilestonePayload(payload))
+
+	slog.Debug(LogMsgEventBroadcast,
+		"event_type", EventTypeDigMilestone,
+		"level", payload.Level,
+		"depth", payload.Depth)
+
+	return nil
+}
+
+// handleFishingBite broadcasts a fish biting a user's line
+func (s *Subscriber) handleFishingBite(ctx context.Context, evt event.Event) error {
+	payload, err := event.DecodePayload[event.FishingBitePayloadV1](evt.Payload)
+	if err != nil {
+		slog.Warn("Invalid fishing bite event payload type", "error", err)
+		return nil
+	}
+
+	s.hub.Broadcast(ctx, EventTypeFishingBite, FishingBitePayload(payload))
+
+	slog.Debug(LogMsgEventBroadcast,
+		"event_type", EventTypeFishingBite,
+		"user_id", payload.UserID,
+		"platform", payload.Platform)
+
+	return nil
+}
+
+// handleBossSpawned broadcasts a boss appearing
+func (s *Subscriber) handleBossSpawned(ctx context.Context, evt event.Event) error {
+	payload, err := event.DecodePayload[event.BossSpawnedPayloadV1](evt.Payload)
+	if err != nil {
+		slog.Warn("Invalid boss spawned event payload type", "error", err)
+		return nil
+	}
+
+	s.hub.Broadcast(ctx, EventTypeBossSpawned, BossSpawnedPayload(payload))
+
+	slog.Debug(LogMsgEventBroadcast,
+		"event_type", EventTypeBossSpawned,
+		"boss_id", payload.BossID,
+		"max_hp", payload.MaxHP)
+
+	return nil
+}
+
+// handleBossDamaged broadcasts an attack on the boss
+func (s *Subscriber) handleBossDamaged(ctx context.Context, evt event.Event) error {
+	payload, err := event.DecodePayload[event.BossDamagedPayloadV1](evt.Payload)
+	if err != nil {
+		slog.Warn("Invalid boss damaged event payload type", "error", err)
+		return nil
+	}
+
+	s.hub.Broadcast(ctx, EventTypeBossDamaged, BossDamagedPayload(payload))
+
+	slog.Debug(LogMsgEventBroadcast,
+		"event_type", EventTypeBossDamaged,
+		"boss_id", payload.BossID,
+		"hp", payload.HP)
+
+	return nil
+}
+
+// handleGiftDelivered broadcasts a gift reaching its recipient
+func (s *Subscriber) handleGiftDelivered(ctx context.Context, evt event.Event) error {
+	payload, err := event.DecodePayload[event.GiftDeliveredPayloadV1](evt.Payload)
+	if err != nil {
+		slog.Warn("Invalid gift delivered event payload type", "error", err)
+		return nil
+	}
+
+	s.hub.Broadcast(ctx, EventTypeGiftDelivered, GiftDeliveredPayload(payload))
+
+	slog.Debug(LogMsgEventBroadcast,
+		"event_type", EventTypeGiftDelivered,
+		"gift_id", payload.GiftID,
+		"platform", payload.Platform)
+
+	return nil
+}
+
+// handleBossDefeated broadcasts the boss falling and how its loot was shared
+func (s *Subscriber) handleBossDefeated(ctx context.Context, evt event.Event) error {
+	payload, err := event.DecodePayload[event.BossDefeatedPayloadV1](evt.Payload)
+	if err != nil {
+		slog.Warn("Invalid boss defeated event payload type", "error", err)
+		return nil
+	}
+
+	ssePayload := BossDefeatedPayload{
+		BossID:        payload.BossID,
+		Name:          payload.Name,
+		MaxHP:         payload.MaxHP,
+		RewardLootbox: payload.RewardLootbox,
+		KillerID:      payload.KillerID,
+		KillerName:    payload.KillerName,
+		Shares:        make([]BossShare, len(payload.Shares)),
+		Timestamp:     payload.Timestamp,
+	}
+	for i, share := range payload.Shares {
+		ssePayload.Shares[i] = BossShare(share)
+	}
+
+	s.hub.Broadcast(ctx, EventTypeBossDefeated, ssePayload)
+
+	slog.Debug(LogMsgEventBroadcast,
+		"event_type", EventTypeBossDefeated,
+		"boss_id", payload.BossID,
+		"attackers", len(payload.Shares))
+
+	return nil
+}
+
+// handleBossEscaped broadcasts a boss escaping
+func (s *Subscriber) handleBossEscaped(ctx context.Context, evt event.Event) error {
+	payload, err := event.DecodePayload[event.BossEscapedPayloadV1](evt.Payload)
+	if err != nil {
+		slog.Warn("Invalid boss escaped event payload type", "error", err)
+		return nil
+	}
+
+	s.hub.Broadcast(ctx, EventTypeBossEscaped, BossEscapedPayload(payload))
+
+	slog.Debug(LogMsgEventBroadcast,
+		"event_type", EventTypeBossEscaped,
+		"boss_id", payload.BossID,
+		"hp", payload.HP)
+
+	return nil
+}
+
+// handleStreamSession broadcasts a stream session opening or closing
+func (s *Subscriber) handleStreamSession(ctx context.Context, evt event.Event) error {
+	payload, err := event.DecodePayload[event.StreamSessionPayloadV1](evt.Payload)
+	if err != nil {
+		slog.Warn("Invalid stream session event payload type", "error", err)
+		return nil
+	}
+
+	eventType := EventTypeStreamStarted
+	if evt.Type == event.StreamEnded {
+		eventType = EventTypeStreamEnded
+	}
+	s.hub.Broadcast(ctx, eventType, StreamSessionPayload(payload))
+
+	slog.Debug(LogMsgEventBroadcast,
+		"event_type", eventType,
+		"session_id", payload.SessionID)
+
+	return nil
+}
+
+// handleStreamRecap broadcasts the recap of a stream that just ended
+func (s *Subscriber) handleStreamRecap(ctx context.Context, evt event.Event) error {
+	payload, err := event.DecodePayload[event.StreamRecapPayloadV1](evt.Payload)
+	if err != nil {
+		slog.Warn("Invalid stream recap event payload type", "error", err)
+		return nil
+	}
+
+	s.hub.Broadcast(ctx, EventTypeStreamRecap, StreamRecapPayload(payload))
+
+	slog.Debug(LogMsgEventBroadcast,
+		"event_type", EventTypeStreamRecap,
+		"session_id", payload.Recap.Summary.Session.ID)
+
+	return nil
+}
+
+// handleAnnouncement broadcasts a routed announcement. Messages for an SSE
+// topic go out under the topic; Discord messages go out as announcements
+// for the Discord bot to post.
+func (s *Subscriber) handleAnnouncement(ctx context.Context, evt event.Event) error {
+	payload, err := event.DecodePayload[event.AnnouncementPayloadV1](evt.Payload)
+	if err != nil {
+		slog.Warn("Invalid announcement event payload type", "error", err)
+		return nil
+	}
+
+	eventType := EventTypeAnnouncement
+	if payload.Destination == domain.AnnouncementDestinationSSE {
+		eventType = payload.Target
+	}
+	s.hub.Broadcast(ctx, eventType, AnnouncementPayload(payload))
+
+	slog.Debug(LogMsgEventBroadcast,
+		"event_type", eventType,
+		"route_id", payload.RouteID)
+
+	return nil
+}
+
+// handleAnnouncementRoutesChanged tells clients the announcement routes changed
+func (s *Subscriber) handleAnnouncementRoutesChanged(ctx context.Context, evt event.Event) error {
+	payload, err := event.DecodePayload[event.AnnouncementRoutesChangedPayloadV1](evt.Payload)
+	if err != nil {
+		slog.Warn("Invalid announcement routes changed event payload type", "error", err)
+		return nil
+	}
+
+	s.hub.Broadcast(ctx, EventTypeAnnouncementRoutesChanged, AnnouncementRoutesChangedPayload(payload))
+
+	slog.Debug(LogMsgEventBroadcast, "event_type", EventTypeAnnouncementRoutesChanged)
+
+	return nil
+}
@@ -1,5 +1,7 @@
 package sse
 
+import "github.com/osse101/BrandishBot_Go/internal/domain"
+
 // JobLevelUpPayload represents the SSE payload for job level up events
 type JobLevelUpPayload struct {
 	UserID   string `json:"user_id"`
@@ -47,6 +49,8 @@ type AllUnlockedPayload struct {
 
 // GambleCompletedPayload represents the SSE payload for gamble completion events
 type GambleCompletedPayload struct {
+	GambleID         string   `json:"gamble_id,omitempty"`
+	ShortCode        string   `json:"short_code,omitempty"`
 	WinnerUsername   string   `json:"winner_username,omitempty"`
 	TotalValue       int64    `json:"total_value"`
 	PrizePool        []string `json:"prize_pool"`
@@ -94,3 +98,284 @@ type SubscriptionPayload struct {
 	EventType string `json:"event_type"` // "subscription.activated", "subscription.renewed", etc.
 	Timestamp int64  `json:"timestamp"`
 }
+
+// CelebrationPayload represents the SSE payload for birthday and anniversary rewards
+type CelebrationPayload struct {
+	UserID    string `json:"user_id"`
+	Username  string `json:"username"`
+	Kind      string `json:"kind"` // "birthday" or "anniversary"
+	Years     int    `json:"years,omitempty"`
+	ItemName  string `json:"item_name"`
+	Quantity  int    `json:"quantity"`
+	Timestamp int64  `json:"timestamp"`
+}
+
+// VotesFlaggedPayload represents the SSE payload for votes flagged by brigading detection
+type VotesFlaggedPayload struct {
+	SessionID int    `json:"session_id"`
+	Flagged   int    `json:"flagged"`
+	Excluded  int    `json:"excluded"` // Flagged votes already removed from the tally by auto-exclusion
+	Reason    string `json:"reason"`
+	Timestamp int64  `json:"timestamp"`
+}
+
+// GiveFlaggedPayload represents the SSE payload for a give flagged as likely funneling
+type GiveFlaggedPayload struct {
+	FlagID     int64  `json:"flag_id"`
+	GiverID    string `json:"giver_id"`
+	ReceiverID string `json:"receiver_id"`
+	ItemName   string `json:"item_name"`
+	Quantity   int    `json:"quantity"`
+	Reason     string `json:"reason"`
+	Timestamp  int64  `json:"timestamp"`
+}
+
+// ReminderDuePayload represents the SSE payload for a reminder that has fallen due
+type ReminderDuePayload struct {
+	ReminderID int64  `json:"reminder_id"`
+	UserID     string `json:"user_id"`
+	Platform   string `json:"platform"`
+	PlatformID string `json:"platform_id"`
+	Kind       string `json:"kind"`
+	Target     string `json:"target,omitempty"`
+	Message    string `json:"message"`
+	ChannelID  string `json:"channel_id,omitempty"` // Where to post; empty means message the user directly
+	Timestamp  int64  `json:"timestamp"`
+}
+
+// ItemLoanPayload represents the SSE payload for an item loan being made or closed
+type ItemLoanPayload struct {
+	LoanID           int64  `json:"loan_id"`
+	EventType        string `json:"event_type"` // item.loaned or item.loan_returned
+	LenderID         string `json:"lender_id"`
+	BorrowerID       string `json:"borrower_id,omitempty"`
+	ItemName         string `json:"item_name"`
+	Quantity         int    `json:"quantity"`
+	ReturnedQuantity int    `json:"returned_quantity"`
+	Status           string `json:"status"`
+	DueAt            int64  `json:"due_at"`
+	Timestamp        int64  `json:"timestamp"`
+}
+
+// PlayerShopSoldPayload represents the SSE payload for a player shop sale
+type PlayerShopSoldPayload struct {
+	ListingID  int64  `json:"listing_id"`
+	SellerID   string `json:"seller_id"`
+	BuyerID    string `json:"buyer_id"`
+	ItemName   string `json:"item_name"`
+	Quantity   int    `json:"quantity"`
+	TotalPrice int    `json:"total_price"`
+	Fee        int    `json:"fee"`
+	Remaining  int    `json:"remaining"`
+	Timestamp  int64  `json:"timestamp"`
+}
+
+// ChatDrop is one item dropped to a chatter
+type ChatDrop struct {
+	UserID   string `json:"user_id"`
+	Username string `json:"username"`
+	Platform string `json:"platform"`
+	ItemName string `json:"item_name"`
+	Quantity int    `json:"quantity"`
+}
+
+// ChatDropPayload represents the SSE payload for items dropped by chat activity
+type ChatDropPayload struct {
+	Drops     []ChatDrop `json:"drops"`
+	Chatters  int        `json:"chatters"`
+	Timestamp int64      `json:"timestamp"`
+}
+
+// KeywordDropPayload represents the SSE payload for an item a chat keyword dropped
+type KeywordDropPayload struct {
+	Drop      ChatDrop `json:"drop"`
+	Keyword   string   `json:"keyword"`
+	Theme     string   `json:"theme"`
+	Timestamp int64    `json:"timestamp"`
+}
+
+// CommunityBonus is one timed community bonus
+type CommunityBonus struct {
+	Type      string  `json:"type"`
+	Magnitude float64 `json:"magnitude"`
+	ExpiresAt int64   `json:"expires_at"`
+}
+
+// CommunityBonusPayload represents the SSE payload for the bonuses a raid or
+// host granted
+type CommunityBonusPayload struct {
+	Kind              string           `json:"kind"`
+	Platform          string           `json:"platform"`
+	FromChannel       string           `json:"from_channel"`
+	Viewers           int              `json:"viewers"`
+	Bonuses           []CommunityBonus `json:"bonuses"`
+	BonusContribution int              `json:"bonus_contribution"`
+	Timestamp         int64            `json:"timestamp"`
+}
+
+// JackpotWonPayload represents the SSE payload for a progressive jackpot win
+type JackpotWonPayload struct {
+	UserID    string `json:"user_id"`
+	Username  string `json:"username"`
+	Amount    int64  `json:"amount"`
+	Source    string `json:"source"`
+	Timestamp int64  `json:"timestamp"`
+}
+
+// DigMilestonePayload represents the SSE payload for the dig site reaching a
+// new level
+type DigMilestonePayload struct {
+	Level        int    `json:"level"`
+	Name         string `json:"name"`
+	Depth        int    `json:"depth"`
+	Contribution int    `json:"contribution"`
+	UserID       string `json:"user_id"`
+	Username     string `json:"username"`
+	Timestamp    int64  `json:"timestamp"`
+}
+
+// FishingBitePayload represents the SSE payload for a fish biting a user's
+// line
+type FishingBitePayload struct {
+	UserID     string `json:"user_id"`
+	Username   string `json:"username"`
+	Platform   string `json:"platform"`
+	PlatformID string `json:"platform_id"`
+	ChannelID  string `json:"channel_id,omitempty"` // Where to post; empty means message the user directly
+	ReelBy     int64  `json:"reel_by"`
+	Timestamp  int64  `json:"timestamp"`
+}
+
+// BossSpawnedPayload represents the SSE payload for a boss appearing
+type BossSpawnedPayload struct {
+	BossID        string `json:"boss_id"`
+	Key           string `json:"key"`
+	Name          string `json:"name"`
+	MaxHP         int    `json:"max_hp"`
+	RewardLootbox string `json:"reward_lootbox"`
+	RewardBoxes   int    `json:"reward_boxes"`
+	SpawnedBy     string `json:"spawned_by"`
+	ExpiresAt     int64  `json:"expires_at"`
+	Timestamp     int64  `json:"timestamp"`
+}
+
+// BossDamagedPayload represents the SSE payload for an attack on the boss
+type BossDamagedPayload struct {
+	BossID    string `json:"boss_id"`
+	Name      string `json:"name"`
+	UserID    string `json:"user_id"`
+	Username  string `json:"username"`
+	Damage    int    `json:"damage"`
+	Weapon    string `json:"weapon,omitempty"`
+	HP        int    `json:"hp"`
+	MaxHP     int    `json:"max_hp"`
+	Timestamp int64  `json:"timestamp"`
+}
+
+// BossShare is an attacker's part in a defeated boss
+type BossShare struct {
+	UserID   string `json:"user_id"`
+	Username string `json:"username"`
+	Damage   int    `json:"damage"`
+	Boxes    int    `json:"boxes"`
+}
+
+// BossDefeatedPayload represents the SSE payload for the boss falling
+type BossDefeatedPayload struct {
+	BossID        string      `json:"boss_id"`
+	Name          string      `json:"name"`
+	MaxHP         int         `json:"max_hp"`
+	RewardLootbox string      `json:"reward_lootbox"`
+	KillerID      string      `json:"killer_id"`
+	KillerName    string      `json:"killer_name"`
+	Shares        []BossShare `json:"shares"`
+	Timestamp     int64       `json:"timestamp"`
+}
+
+// BossEscapedPayload represents the SSE payload for a boss escaping
+type BossEscapedPayload struct {
+	BossID    string `json:"boss_id"`
+	Name      string `json:"name"`
+	HP        int    `json:"hp"`
+	MaxHP     int    `json:"max_hp"`
+	Timestamp int64  `json:"timestamp"`
+}
+
+// GiftDeliveredPayload represents the SSE payload for a gift reaching its
+// recipient
+type GiftDeliveredPayload struct {
+	GiftID              int64  `json:"gift_id"`
+	SenderID            string `json:"sender_id"`
+	SenderName          string `json:"sender_name"`
+	RecipientID         string `json:"recipient_id"`
+	RecipientName       string `json:"recipient_name"`
+	Platform            string `json:"platform"`
+	RecipientPlatformID string `json:"recipient_platform_id"`
+	ChannelID           string `json:"channel_id,omitempty"` // Where to post; empty means message the recipient directly
+	ItemName            string `json:"item_name"`
+	Quantity            int    `json:"quantity"`
+	Message             string `json:"message,omitempty"`
+	Timestamp           int64  `json:"timestamp"`
+}
+
+// StreamSessionPayload represents the SSE payload for a stream session
+// opening or closing. EndedAt is zero while the session is live.
+type StreamSessionPayload struct {
+	SessionID int64  `json:"session_id"`
+	Platform  string `json:"platform"`
+	Title     string `json:"title"`
+	StartedAt int64  `json:"started_at"`
+	EndedAt   int64  `json:"ended_at,omitempty"`
+	Timestamp int64  `json:"timestamp"`
+}
+
+// StreamRecapPayload represents the SSE payload for the recap of a stream
+// that just ended
+type StreamRecapPayload struct {
+	Recap     domain.StreamRecap `json:"recap"`
+	Timestamp int64              `json:"timestamp"`
+}
+
+// AnnouncementPayload represents the SSE payload for a message rendered by
+// an announcement route
+type AnnouncementPayload struct {
+	RouteID     int64                          `json:"route_id"`
+	EventType   string                         `json:"event_type"`
+	Destination domain.AnnouncementDestination `json:"destination"`
+	Target      string                         `json:"target"`
+	Message     string                         `json:"message"`
+	Timestamp   int64                          `json:"timestamp"`
+}
+
+// AnnouncementRoutesChangedPayload represents the SSE payload for a change
+// to the announcement routes
+type AnnouncementRoutesChangedPayload struct {
+	Timestamp int64 `json:"timestamp"`
+}
+
+// InventoryChangedPayload represents the SSE payload for an inventory diff
+type InventoryChangedPayload struct {
+	UserID    string            `json:"user_id"`
+	Reason    string            `json:"reason"`
+	Changes   []InventoryChange `json:"changes"`
+	Timestamp int64             `json:"timestamp"`
+}
+
+// InventoryChange is one item's entry in an inventory diff
+type InventoryChange struct {
+	ItemID   int    `json:"item_id"`
+	Quality  string `json:"quality"`
+	Delta    int    `json:"delta"`
+	Quantity int    `json:"quantity"`
+}
+
+// GambleRecoveredPayload represents the SSE payload for a recovered stale gamble
+type GambleRecoveredPayload struct {
+	GambleID      string `json:"gamble_id"`
+	ShortCode     string `json:"short_code"`
+	PreviousState string `json:"previous_state"`
+	Action        string `json:"action"` // "resumed" or "refunded"
+	Participants  int    `json:"participants"`
+	Reason        string `json:"reason,omitempty"`
+	Timestamp     int64  `json:"timestamp"`
+}
@@ -79,7 +79,7 @@ func (m *ThreadSafeMockRepository) GetUserEventsByType(ctx context.Context, user
 	return filtered, nil
 }
 
-func (m *ThreadSafeMockRepository) GetTopUsers(ctx context.Context, eventType domain.EventType, startTime, endTime time.Time, limit int) ([]domain.LeaderboardEntry, error) {
+func (m *ThreadSafeMockRepository) GetTopUsers(ctx context.Context, eventType domain.EventType, startTime, endTime time.Time, limit, offset int) ([]domain.LeaderboardEntry, error) {
 	m.mu.Lock()
 	defer m.mu.Unlock()
 
@@ -102,6 +102,10 @@ func (m *ThreadSafeMockRepository) GetTopUsers(ctx context.Context, eventType do
 	return entries, nil
 }
 
+func (m *ThreadSafeMockRepository) GetUserLeaderboardRank(ctx context.Context, eventType domain.EventType, userID string, startTime, endTime time.Time) (int, error) {
+	return 0, nil
+}
+
 func (m *ThreadSafeMockRepository) GetEventCounts(ctx context.Context, startTime, endTime time.Time) (map[domain.EventType]int, error) {
 	m.mu.Lock()
 	defer m.mu.Unlock()
@@ -128,6 +132,10 @@ func (m *ThreadSafeMockRepository) GetUserEventCounts(ctx context.Context, userI
 	return counts, nil
 }
 
+func (m *ThreadSafeMockRepository) GetUserRecipeCraftCounts(ctx context.Context, userID string) (map[string]int, error) {
+	return map[string]int{}, nil
+}
+
 func (m *ThreadSafeMockRepository) GetTotalEventCount(ctx context.Context, startTime, endTime time.Time) (int, error) {
 	m.mu.Lock()
 	defer m.mu.Unlock()
@@ -24,10 +24,36 @@ const (
 // leaderboard queries when no limit is specified or limit <= 0
 const DefaultLeaderboardLimit = 10
 
+// MaxLeaderboardLimit caps the page size of leaderboard queries
+const MaxLeaderboardLimit = 100
+
 // StreakEventQueryLimit is the number of recent streak events to fetch when
 // checking or calculating daily streaks
 const StreakEventQueryLimit = 1
 
+// ============================================================================
+// Rollups and Queries
+// ============================================================================
+
+// JobType identifies stats rollup runs in the worker pool and scheduler
+const JobType = "stats_rollup"
+
+// RollupSettleDelay is how long after a bucket ends before it is rolled up,
+// so events recorded as the bucket closed are counted
+const RollupSettleDelay = time.Minute
+
+// MaxRollupBucketsPerRun caps how many buckets of each size one run rolls
+// up, so catching up on a large backlog is spread over several runs
+const MaxRollupBucketsPerRun = 168
+
+// Query limits
+const (
+	DefaultQueryLimit = 100
+	MaxQueryLimit     = 1000
+	// MaxQueryBuckets caps how many buckets a query range can span
+	MaxQueryBuckets = 1000
+)
+
 // ============================================================================
 // Streak Calculation
 // ============================================================================
@@ -59,17 +85,22 @@ const MetadataKeyStreak = "streak"
 
 // Validation error messages
 const (
-	ErrMsgUserIDRequired = "user ID is required"
+	ErrMsgUserIDRequired   = "user ID is required"
+	ErrMsgQueryUnavailable = "stats queries are not enabled"
 )
 
 // Database operation error messages
 const (
-	ErrMsgGetStreakEventsFailed    = "failed to get streak events: %w"
-	ErrMsgRecordStreakEventFailed  = "failed to record streak event: %w"
-	ErrMsgGetUserEventCountsFailed = "failed to get user event counts: %w"
-	ErrMsgGetTotalEventCountFailed = "failed to get total event count: %w"
-	ErrMsgGetEventCountsFailed     = "failed to get event counts: %w"
-	ErrMsgGetLeaderboardFailed     = "failed to get leaderboard: %w"
+	ErrMsgGetStreakEventsFailed      = "failed to get streak events: %w"
+	ErrMsgRecordStreakEventFailed    = "failed to record streak event: %w"
+	ErrMsgGetUserEventCountsFailed   = "failed to get user event counts: %w"
+	ErrMsgGetTotalEventCountFailed   = "failed to get total event count: %w"
+	ErrMsgGetEventCountsFailed       = "failed to get event counts: %w"
+	ErrMsgGetLeaderboardFailed       = "failed to get leaderboard: %w"
+	ErrMsgGetLeaderboardRankFailed   = "failed to get leaderboard rank: %w"
+	ErrMsgQueryStatsFailed           = "failed to query stats: %w"
+	ErrMsgRollUpFailed               = "failed to roll up %s stats: %w"
+	ErrMsgGetRecipeCraftCountsFailed = "failed to get recipe craft counts: %w"
 )
 
 // General operation error messages
@@ -87,14 +118,16 @@ const (
 	LogMsgRetrievedUserStats   = "Retrieved user stats"
 	LogMsgRetrievedSystemStats = "Retrieved system stats"
 	LogMsgRetrievedLeaderboard = "Retrieved leaderboard"
+	LogMsgStatsRolledUp        = "Rolled up stats"
 )
 
 // Error log messages
 const (
-	LogMsgFailedToRecordEvent        = "Failed to record event"
-	LogMsgFailedToCheckDailyStreak   = "Failed to check daily streak"
-	LogMsgFailedToGetUserEventCounts = "Failed to get user event counts"
-	LogMsgFailedToGetTotalEventCount = "Failed to get total event count"
-	LogMsgFailedToGetEventCounts     = "Failed to get event counts"
-	LogMsgFailedToGetLeaderboard     = "Failed to get leaderboard"
+	LogMsgFailedToRecordEvent          = "Failed to record event"
+	LogMsgFailedToCheckDailyStreak     = "Failed to check daily streak"
+	LogMsgFailedToGetUserEventCounts   = "Failed to get user event counts"
+	LogMsgFailedToGetTotalEventCount   = "Failed to get total event count"
+	LogMsgFailedToGetEventCounts       = "Failed to get event counts"
+	LogMsgFailedToGetLeaderboard       = "Failed to get leaderboard"
+	LogMsgFailedToGetRecipeCraftCounts = "Failed to get recipe craft counts"
 )
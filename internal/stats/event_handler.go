@@ -100,6 +100,17 @@ func (h *EventHandler) HandleItemUpgraded(ctx context.Context, evt event.Event)
 		return fmt.Errorf("failed to decode item upgraded payload: %w", err)
 	}
 
+	if payload.RecipeKey != "" && payload.Quantity > 0 {
+		err := h.service.RecordUserEvent(ctx, payload.UserID, domain.StatsEventRecipeCrafted, domain.CraftingMetadata{
+			ItemName:  payload.ItemName,
+			Quantity:  payload.Quantity,
+			RecipeKey: payload.RecipeKey,
+		})
+		if err != nil {
+			log.Warn("Failed to record recipe crafted stat", "error", err, "user_id", payload.UserID)
+		}
+	}
+
 	if payload.IsMasterwork {
 		err := h.service.RecordUserEvent(ctx, payload.UserID, domain.EventTypeCraftingCriticalSuccess, domain.CraftingMetadata{
 			ItemName:         payload.ItemName,
@@ -256,6 +267,16 @@ func (h *EventHandler) HandleGambleCompleted(ctx context.Context, evt event.Even
 		return fmt.Errorf("failed to decode gamble completed v2 payload: %w", err)
 	}
 
+	if payload.WinnerID != "" {
+		if err := h.service.RecordUserEvent(ctx, payload.WinnerID, domain.StatsEventGambleWon, domain.GambleWinMetadata{
+			GambleID:     payload.GambleID,
+			TotalValue:   payload.TotalValue,
+			Participants: payload.ParticipantCount,
+		}); err != nil {
+			log.Warn("Failed to record gamble win stat", "error", err, "user_id", payload.WinnerID)
+		}
+	}
+
 	for _, p := range payload.Participants {
 		if p.IsCritFail {
 			_ = h.service.RecordUserEvent(ctx, p.UserID, domain.StatsEventGambleCriticalFail, domain.GambleMetadata{
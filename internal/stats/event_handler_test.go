@@ -319,8 +319,10 @@ func TestEventHandler_HandleGambleCompleted(t *testing.T) {
 	handler := stats.NewEventHandler(mockSvc)
 
 	payload := domain.GambleCompletedPayloadV2{
-		GambleID:   "gamble-1",
-		TotalValue: 100,
+		GambleID:         "gamble-1",
+		WinnerID:         "user-4",
+		TotalValue:       100,
+		ParticipantCount: 4,
 		Participants: []domain.GambleParticipantOutcome{
 			{
 				UserID:     "user-1",
@@ -345,6 +347,12 @@ func TestEventHandler_HandleGambleCompleted(t *testing.T) {
 		Payload: payload,
 	}
 
+	mockSvc.On("RecordUserEvent", ctx, "user-4", domain.StatsEventGambleWon, domain.GambleWinMetadata{
+		GambleID:     "gamble-1",
+		TotalValue:   100,
+		Participants: 4,
+	}).Return(nil)
+
 	mockSvc.On("RecordUserEvent", ctx, "user-1", domain.StatsEventGambleNearMiss, domain.GambleMetadata{
 		GambleID:    "gamble-1",
 		Score:       95,
@@ -396,6 +404,23 @@ func TestEventHandler_HandleItemUpgraded(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestEventHandler_HandleItemUpgraded_RecordsRecipeCrafts(t *testing.T) {
+	ctx := context.Background()
+	mockSvc := mocks.NewMockStatsService(t)
+	handler := stats.NewEventHandler(mockSvc)
+
+	evt := crafting.NewItemUpgradedEvent("user-1", "lootbox1", 3, "lootbox_tier0", false, 0, nil)
+
+	mockSvc.On("RecordUserEvent", ctx, "user-1", domain.StatsEventRecipeCrafted, domain.CraftingMetadata{
+		ItemName:  "lootbox1",
+		Quantity:  3,
+		RecipeKey: "lootbox_tier0",
+	}).Return(nil)
+
+	err := handler.HandleItemUpgraded(ctx, evt)
+	require.NoError(t, err)
+}
+
 func TestEventHandler_HandleItemDisassembled(t *testing.T) {
 	ctx := context.Background()
 	mockSvc := mocks.NewMockStatsService(t)
@@ -0,0 +1,24 @@
+package stats
+
+import "context"
+
+// Job rolls up stats buckets that have closed
+type Job struct {
+	service Service
+}
+
+// NewJob creates a stats rollup job
+func NewJob(service Service) *Job {
+	return &Job{service: service}
+}
+
+// JobType returns the worker pool job type
+func (j *Job) JobType() string {
+	return JobType
+}
+
+// Process rolls up every closed bucket of each configured size
+func (j *Job) Process(ctx context.Context) error {
+	_, err := j.service.RollUpDue(ctx)
+	return err
+}
@@ -390,9 +390,9 @@ func (_c *MockRepository_GetSlotsLeaderboardByWinRate_Call) RunAndReturn(run fun
 	return _c
 }
 
-// GetTopUsers provides a mock function with given fields: ctx, eventType, startTime, endTime, limit
-func (_m *MockRepository) GetTopUsers(ctx context.Context, eventType domain.EventType, startTime time.Time, endTime time.Time, limit int) ([]domain.LeaderboardEntry, error) {
-	ret := _m.Called(ctx, eventType, startTime, endTime, limit)
+// GetTopUsers provides a mock function with given fields: ctx, eventType, startTime, endTime, limit, offset
+func (_m *MockRepository) GetTopUsers(ctx context.Context, eventType domain.EventType, startTime time.Time, endTime time.Time, limit int, offset int) ([]domain.LeaderboardEntry, error) {
+	ret := _m.Called(ctx, eventType, startTime, endTime, limit, offset)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetTopUsers")
@@ -400,19 +400,19 @@ func (_m *MockRepository) GetTopUsers(ctx context.Context, eventType domain.Even
 
 	var r0 []domain.LeaderboardEntry
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, domain.EventType, time.Time, time.Time, int) ([]domain.LeaderboardEntry, error)); ok {
-		return rf(ctx, eventType, startTime, endTime, limit)
+	if rf, ok := ret.Get(0).(func(context.Context, domain.EventType, time.Time, time.Time, int, int) ([]domain.LeaderboardEntry, error)); ok {
+		return rf(ctx, eventType, startTime, endTime, limit, offset)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, domain.EventType, time.Time, time.Time, int) []domain.LeaderboardEntry); ok {
-		r0 = rf(ctx, eventType, startTime, endTime, limit)
+	if rf, ok := ret.Get(0).(func(context.Context, domain.EventType, time.Time, time.Time, int, int) []domain.LeaderboardEntry); ok {
+		r0 = rf(ctx, eventType, startTime, endTime, limit, offset)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]domain.LeaderboardEntry)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, domain.EventType, time.Time, time.Time, int) error); ok {
-		r1 = rf(ctx, eventType, startTime, endTime, limit)
+	if rf, ok := ret.Get(1).(func(context.Context, domain.EventType, time.Time, time.Time, int, int) error); ok {
+		r1 = rf(ctx, eventType, startTime, endTime, limit, offset)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -431,13 +431,14 @@ type MockRepository_GetTopUsers_Call struct {
 //   - startTime time.Time
 //   - endTime time.Time
 //   - limit int
-func (_e *MockRepository_Expecter) GetTopUsers(ctx interface{}, eventType interface{}, startTime interface{}, endTime interface{}, limit interface{}) *MockRepository_GetTopUsers_Call {
-	return &MockRepository_GetTopUsers_Call{Call: _e.mock.On("GetTopUsers", ctx, eventType, startTime, endTime, limit)}
+//   - offset int
+func (_e *MockRepository_Expecter) GetTopUsers(ctx interface{}, eventType interface{}, startTime interface{}, endTime interface{}, limit interface{}, offset interface{}) *MockRepository_GetTopUsers_Call {
+	return &MockRepository_GetTopUsers_Call{Call: _e.mock.On("GetTopUsers", ctx, eventType, startTime, endTime, limit, offset)}
 }
 
-func (_c *MockRepository_GetTopUsers_Call) Run(run func(ctx context.Context, eventType domain.EventType, startTime time.Time, endTime time.Time, limit int)) *MockRepository_GetTopUsers_Call {
+func (_c *MockRepository_GetTopUsers_Call) Run(run func(ctx context.Context, eventType domain.EventType, startTime time.Time, endTime time.Time, limit int, offset int)) *MockRepository_GetTopUsers_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(domain.EventType), args[2].(time.Time), args[3].(time.Time), args[4].(int))
+		run(args[0].(context.Context), args[1].(domain.EventType), args[2].(time.Time), args[3].(time.Time), args[4].(int), args[5].(int))
 	})
 	return _c
 }
@@ -447,7 +448,7 @@ func (_c *MockRepository_GetTopUsers_Call) Return(_a0 []domain.LeaderboardEntry,
 	return _c
 }
 
-func (_c *MockRepository_GetTopUsers_Call) RunAndReturn(run func(context.Context, domain.EventType, time.Time, time.Time, int) ([]domain.LeaderboardEntry, error)) *MockRepository_GetTopUsers_Call {
+func (_c *MockRepository_GetTopUsers_Call) RunAndReturn(run func(context.Context, domain.EventType, time.Time, time.Time, int, int) ([]domain.LeaderboardEntry, error)) *MockRepository_GetTopUsers_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -632,6 +633,125 @@ func (_c *MockRepository_GetUserEventsByType_Call) RunAndReturn(run func(context
 	return _c
 }
 
+// GetUserLeaderboardRank provides a mock function with given fields: ctx, eventType, userID, startTime, endTime
+func (_m *MockRepository) GetUserLeaderboardRank(ctx context.Context, eventType domain.EventType, userID string, startTime time.Time, endTime time.Time) (int, error) {
+	ret := _m.Called(ctx, eventType, userID, startTime, endTime)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUserLeaderboardRank")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.EventType, string, time.Time, time.Time) (int, error)); ok {
+		return rf(ctx, eventType, userID, startTime, endTime)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.EventType, string, time.Time, time.Time) int); ok {
+		r0 = rf(ctx, eventType, userID, startTime, endTime)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.EventType, string, time.Time, time.Time) error); ok {
+		r1 = rf(ctx, eventType, userID, startTime, endTime)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRepository_GetUserLeaderboardRank_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserLeaderboardRank'
+type MockRepository_GetUserLeaderboardRank_Call struct {
+	*mock.Call
+}
+
+// GetUserLeaderboardRank is a helper method to define mock.On call
+//   - ctx context.Context
+//   - eventType domain.EventType
+//   - userID string
+//   - startTime time.Time
+//   - endTime time.Time
+func (_e *MockRepository_Expecter) GetUserLeaderboardRank(ctx interface{}, eventType interface{}, userID interface{}, startTime interface{}, endTime interface{}) *MockRepository_GetUserLeaderboardRank_Call {
+	return &MockRepository_GetUserLeaderboardRank_Call{Call: _e.mock.On("GetUserLeaderboardRank", ctx, eventType, userID, startTime, endTime)}
+}
+
+func (_c *MockRepository_GetUserLeaderboardRank_Call) Run(run func(ctx context.Context, eventType domain.EventType, userID string, startTime time.Time, endTime time.Time)) *MockRepository_GetUserLeaderboardRank_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.EventType), args[2].(string), args[3].(time.Time), args[4].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockRepository_GetUserLeaderboardRank_Call) Return(_a0 int, _a1 error) *MockRepository_GetUserLeaderboardRank_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRepository_GetUserLeaderboardRank_Call) RunAndReturn(run func(context.Context, domain.EventType, string, time.Time, time.Time) (int, error)) *MockRepository_GetUserLeaderboardRank_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUserRecipeCraftCounts provides a mock function with given fields: ctx, userID
+func (_m *MockRepository) GetUserRecipeCraftCounts(ctx context.Context, userID string) (map[string]int, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUserRecipeCraftCounts")
+	}
+
+	var r0 map[string]int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (map[string]int, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) map[string]int); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]int)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRepository_GetUserRecipeCraftCounts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserRecipeCraftCounts'
+type MockRepository_GetUserRecipeCraftCounts_Call struct {
+	*mock.Call
+}
+
+// GetUserRecipeCraftCounts is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+func (_e *MockRepository_Expecter) GetUserRecipeCraftCounts(ctx interface{}, userID interface{}) *MockRepository_GetUserRecipeCraftCounts_Call {
+	return &MockRepository_GetUserRecipeCraftCounts_Call{Call: _e.mock.On("GetUserRecipeCraftCounts", ctx, userID)}
+}
+
+func (_c *MockRepository_GetUserRecipeCraftCounts_Call) Run(run func(ctx context.Context, userID string)) *MockRepository_GetUserRecipeCraftCounts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockRepository_GetUserRecipeCraftCounts_Call) Return(_a0 map[string]int, _a1 error) *MockRepository_GetUserRecipeCraftCounts_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRepository_GetUserRecipeCraftCounts_Call) RunAndReturn(run func(context.Context, string) (map[string]int, error)) *MockRepository_GetUserRecipeCraftCounts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetUserSlotsStats provides a mock function with given fields: ctx, userID, startTime, endTime
 func (_m *MockRepository) GetUserSlotsStats(ctx context.Context, userID string, startTime time.Time, endTime time.Time) (*domain.SlotsStats, error) {
 	ret := _m.Called(ctx, userID, startTime, endTime)
@@ -1,6 +1,10 @@
 package stats
 
 import (
+	"context"
+	"time"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
 	"github.com/osse101/BrandishBot_Go/internal/repository"
 )
 
@@ -10,3 +14,25 @@ import (
 type Repository interface {
 	repository.Stats
 }
+
+// AggregateRepository maintains the rolled-up event counts and answers
+// grouped range queries from them
+type AggregateRepository interface {
+	// GetRollupProgress returns how far a bucket size has been rolled up, and
+	// false if it never has been
+	GetRollupProgress(ctx context.Context, bucket domain.StatsBucket) (time.Time, bool, error)
+
+	// GetEarliestEventTime returns when the first recorded event happened,
+	// and false if there are no events
+	GetEarliestEventTime(ctx context.Context) (time.Time, bool, error)
+
+	// RollUp recounts the buckets in [from, to) and records to as the new
+	// progress, atomically
+	RollUp(ctx context.Context, bucket domain.StatsBucket, from, to time.Time) error
+
+	// QueryRollups answers a query from rolled-up counts
+	QueryRollups(ctx context.Context, query domain.StatsQuery) ([]domain.StatsQueryRow, error)
+
+	// QueryEvents answers a query from raw events
+	QueryEvents(ctx context.Context, query domain.StatsQuery) ([]domain.StatsQueryRow, error)
+}
@@ -0,0 +1,237 @@
+package stats
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/logger"
+)
+
+// RollUpDue rolls each configured bucket size forward to the start of the
+// current bucket. The first run starts from the earliest recorded event.
+func (s *service) RollUpDue(ctx context.Context) (int, error) {
+	if s.aggregates == nil {
+		return 0, nil
+	}
+
+	rolled := 0
+	for _, bucket := range s.rollupBuckets {
+		n, err := s.rollUpBucket(ctx, bucket)
+		if err != nil {
+			return rolled, fmt.Errorf(ErrMsgRollUpFailed, bucket, err)
+		}
+		rolled += n
+	}
+
+	if rolled > 0 {
+		logger.FromContext(ctx).Info(LogMsgStatsRolledUp, "buckets", rolled)
+	}
+	return rolled, nil
+}
+
+func (s *service) rollUpBucket(ctx context.Context, bucket domain.StatsBucket) (int, error) {
+	from, ok, err := s.aggregates.GetRollupProgress(ctx, bucket)
+	if err != nil {
+		return 0, err
+	}
+	if !ok {
+		earliest, found, err := s.aggregates.GetEarliestEventTime(ctx)
+		if err != nil || !found {
+			return 0, err
+		}
+		from = bucketStart(bucket, earliest)
+	}
+
+	closed := bucketStart(bucket, s.now().Add(-RollupSettleDelay))
+	to := from
+	count := 0
+	for to.Before(closed) && count < MaxRollupBucketsPerRun {
+		to = nextBucket(bucket, to)
+		count++
+	}
+	if count == 0 {
+		return 0, nil
+	}
+
+	if err := s.aggregates.RollUp(ctx, bucket, from, to); err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// QueryStats validates the query, widens its start to a bucket boundary and
+// splits the range at the bucket size's rollup progress
+func (s *service) QueryStats(ctx context.Context, query domain.StatsQuery) (*domain.StatsQueryResult, error) {
+	if s.aggregates == nil {
+		return nil, errors.New(ErrMsgQueryUnavailable)
+	}
+	if err := s.normalizeQuery(&query); err != nil {
+		return nil, err
+	}
+
+	split := query.Start
+	if slices.Contains(s.rollupBuckets, query.Bucket) {
+		progress, ok, err := s.aggregates.GetRollupProgress(ctx, query.Bucket)
+		if err != nil {
+			return nil, fmt.Errorf(ErrMsgQueryStatsFailed, err)
+		}
+		if ok && progress.After(query.Start) {
+			split = progress
+			if split.After(query.End) {
+				split = query.End
+			}
+		}
+	}
+
+	var rows []domain.StatsQueryRow
+	if split.After(query.Start) {
+		rolled := query
+		rolled.End = split
+		found, err := s.aggregates.QueryRollups(ctx, rolled)
+		if err != nil {
+			return nil, fmt.Errorf(ErrMsgQueryStatsFailed, err)
+		}
+		rows = append(rows, found...)
+	}
+	if query.End.After(split) {
+		raw := query
+		raw.Start = split
+		found, err := s.aggregates.QueryEvents(ctx, raw)
+		if err != nil {
+			return nil, fmt.Errorf(ErrMsgQueryStatsFailed, err)
+		}
+		rows = append(rows, found...)
+	}
+
+	rows = mergeQueryRows(rows)
+	sortQueryRows(rows, query.GroupByTime)
+
+	result := &domain.StatsQueryResult{
+		Bucket:    query.Bucket,
+		StartTime: query.Start,
+		EndTime:   query.End,
+		Rows:      rows,
+	}
+	if len(rows) > query.Limit {
+		result.Rows = rows[:query.Limit]
+		result.Truncated = true
+	}
+	return result, nil
+}
+
+// normalizeQuery fills in defaults and rejects queries that are malformed or
+// span too many buckets
+func (s *service) normalizeQuery(query *domain.StatsQuery) error {
+	switch query.Bucket {
+	case "":
+		query.Bucket = domain.StatsBucketDay
+	case domain.StatsBucketHour, domain.StatsBucketDay, domain.StatsBucketWeek:
+	default:
+		return fmt.Errorf("%w: bucket must be hour, day or week", domain.ErrInvalidInput)
+	}
+
+	if query.End.IsZero() {
+		query.End = s.now()
+	}
+	if query.Start.IsZero() {
+		query.Start = query.End.AddDate(0, 0, -7)
+	}
+	if !query.End.After(query.Start) {
+		return fmt.Errorf("%w: end must be after start", domain.ErrInvalidInput)
+	}
+	query.Start = bucketStart(query.Bucket, query.Start)
+
+	if query.UserID != "" {
+		if err := uuid.Validate(query.UserID); err != nil {
+			return fmt.Errorf("%w: user_id must be a user ID", domain.ErrInvalidInput)
+		}
+	}
+
+	spanned := 0
+	for b := query.Start; b.Before(query.End); b = nextBucket(query.Bucket, b) {
+		if spanned++; spanned > MaxQueryBuckets {
+			return fmt.Errorf("%w: range spans more than %d %s buckets", domain.ErrInvalidInput, MaxQueryBuckets, query.Bucket)
+		}
+	}
+
+	if query.Limit <= 0 {
+		query.Limit = DefaultQueryLimit
+	}
+	query.Limit = min(query.Limit, MaxQueryLimit)
+	return nil
+}
+
+// mergeQueryRows sums rows for the same group, which happens when a bucket
+// straddles the rollup progress
+func mergeQueryRows(rows []domain.StatsQueryRow) []domain.StatsQueryRow {
+	type key struct {
+		bucket    time.Time
+		userID    string
+		eventType domain.EventType
+	}
+	index := make(map[key]int, len(rows))
+	merged := make([]domain.StatsQueryRow, 0, len(rows))
+	for _, row := range rows {
+		k := key{userID: row.UserID, eventType: row.EventType}
+		if row.BucketStart != nil {
+			k.bucket = *row.BucketStart
+		}
+		if i, ok := index[k]; ok {
+			merged[i].Count += row.Count
+			continue
+		}
+		index[k] = len(merged)
+		merged = append(merged, row)
+	}
+	return merged
+}
+
+// sortQueryRows orders rows by time when grouped by time, then by count
+// descending, then by user and event type so results are stable
+func sortQueryRows(rows []domain.StatsQueryRow, byTime bool) {
+	slices.SortFunc(rows, func(a, b domain.StatsQueryRow) int {
+		if byTime && a.BucketStart != nil && b.BucketStart != nil {
+			if c := a.BucketStart.Compare(*b.BucketStart); c != 0 {
+				return c
+			}
+		}
+		return cmp.Or(
+			cmp.Compare(b.Count, a.Count),
+			cmp.Compare(a.UserID, b.UserID),
+			cmp.Compare(a.EventType, b.EventType),
+		)
+	})
+}
+
+// bucketStart truncates t to the start of its bucket the way Postgres
+// date_trunc does, with weeks starting on Monday
+func bucketStart(bucket domain.StatsBucket, t time.Time) time.Time {
+	switch bucket {
+	case domain.StatsBucketHour:
+		return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), 0, 0, 0, t.Location())
+	case domain.StatsBucketWeek:
+		day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+		return day.AddDate(0, 0, -((int(day.Weekday()) + 6) % 7))
+	default:
+		return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+	}
+}
+
+// nextBucket returns the start of the bucket after the one starting at start
+func nextBucket(bucket domain.StatsBucket, start time.Time) time.Time {
+	switch bucket {
+	case domain.StatsBucketHour:
+		return start.Add(time.Hour)
+	case domain.StatsBucketWeek:
+		return start.AddDate(0, 0, 7)
+	default:
+		return start.AddDate(0, 0, 1)
+	}
+}
@@ -0,0 +1,185 @@
+package stats
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/stats/mocks"
+)
+
+// 2026-03-11 is a Wednesday
+var rollupNow = time.Date(2026, 3, 11, 14, 30, 0, 0, time.UTC)
+
+func newRollupService(t *testing.T, buckets ...domain.StatsBucket) (*service, *mocks.MockAggregateRepository) {
+	t.Helper()
+	repo := mocks.NewMockAggregateRepository(t)
+	svc := NewService(&mockStatsRepository{}, WithRollups(repo, buckets)).(*service)
+	svc.now = func() time.Time { return rollupNow }
+	return svc, repo
+}
+
+func TestBucketStart(t *testing.T) {
+	ts := time.Date(2026, 3, 11, 14, 30, 15, 0, time.UTC)
+
+	assert.Equal(t, time.Date(2026, 3, 11, 14, 0, 0, 0, time.UTC), bucketStart(domain.StatsBucketHour, ts))
+	assert.Equal(t, time.Date(2026, 3, 11, 0, 0, 0, 0, time.UTC), bucketStart(domain.StatsBucketDay, ts))
+	assert.Equal(t, time.Date(2026, 3, 9, 0, 0, 0, 0, time.UTC), bucketStart(domain.StatsBucketWeek, ts))
+	// Sunday belongs to the week that started the Monday before
+	assert.Equal(t, time.Date(2026, 3, 9, 0, 0, 0, 0, time.UTC), bucketStart(domain.StatsBucketWeek, time.Date(2026, 3, 15, 23, 0, 0, 0, time.UTC)))
+}
+
+func TestRollUpDue(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("rolls up closed buckets since the last progress", func(t *testing.T) {
+		svc, repo := newRollupService(t, domain.StatsBucketHour)
+		repo.On("GetRollupProgress", ctx, domain.StatsBucketHour).Return(time.Date(2026, 3, 11, 11, 0, 0, 0, time.UTC), true, nil)
+		repo.On("RollUp", ctx, domain.StatsBucketHour, time.Date(2026, 3, 11, 11, 0, 0, 0, time.UTC), time.Date(2026, 3, 11, 14, 0, 0, 0, time.UTC)).Return(nil)
+
+		rolled, err := svc.RollUpDue(ctx)
+
+		require.NoError(t, err)
+		assert.Equal(t, 3, rolled)
+	})
+
+	t.Run("starts from the earliest event on the first run", func(t *testing.T) {
+		svc, repo := newRollupService(t, domain.StatsBucketDay)
+		repo.On("GetRollupProgress", ctx, domain.StatsBucketDay).Return(time.Time{}, false, nil)
+		repo.On("GetEarliestEventTime", ctx).Return(time.Date(2026, 3, 9, 18, 12, 0, 0, time.UTC), true, nil)
+		repo.On("RollUp", ctx, domain.StatsBucketDay, time.Date(2026, 3, 9, 0, 0, 0, 0, time.UTC), time.Date(2026, 3, 11, 0, 0, 0, 0, time.UTC)).Return(nil)
+
+		rolled, err := svc.RollUpDue(ctx)
+
+		require.NoError(t, err)
+		assert.Equal(t, 2, rolled)
+	})
+
+	t.Run("does nothing while the current bucket is open", func(t *testing.T) {
+		svc, repo := newRollupService(t, domain.StatsBucketWeek)
+		repo.On("GetRollupProgress", ctx, domain.StatsBucketWeek).Return(time.Date(2026, 3, 9, 0, 0, 0, 0, time.UTC), true, nil)
+
+		rolled, err := svc.RollUpDue(ctx)
+
+		require.NoError(t, err)
+		assert.Zero(t, rolled)
+	})
+
+	t.Run("caps how far one run catches up", func(t *testing.T) {
+		svc, repo := newRollupService(t, domain.StatsBucketHour)
+		from := rollupNow.AddDate(0, -1, 0).Truncate(time.Hour)
+		repo.On("GetRollupProgress", ctx, domain.StatsBucketHour).Return(from, true, nil)
+		repo.On("RollUp", ctx, domain.StatsBucketHour, from, from.Add(MaxRollupBucketsPerRun*time.Hour)).Return(nil)
+
+		rolled, err := svc.RollUpDue(ctx)
+
+		require.NoError(t, err)
+		assert.Equal(t, MaxRollupBucketsPerRun, rolled)
+	})
+
+	t.Run("without rollups configured", func(t *testing.T) {
+		rolled, err := NewService(&mockStatsRepository{}).RollUpDue(ctx)
+
+		require.NoError(t, err)
+		assert.Zero(t, rolled)
+	})
+}
+
+func TestQueryStats(t *testing.T) {
+	ctx := context.Background()
+	day := func(d int) time.Time { return time.Date(2026, 3, d, 0, 0, 0, 0, time.UTC) }
+	at := func(t time.Time) *time.Time { return &t }
+
+	t.Run("reads rollups up to the progress and raw events after it", func(t *testing.T) {
+		svc, repo := newRollupService(t, domain.StatsBucketDay)
+		repo.On("GetRollupProgress", ctx, domain.StatsBucketDay).Return(day(10), true, nil)
+		repo.On("QueryRollups", ctx, mock.MatchedBy(func(q domain.StatsQuery) bool {
+			return q.Start.Equal(day(8)) && q.End.Equal(day(10))
+		})).Return([]domain.StatsQueryRow{
+			{BucketStart: at(day(8)), Count: 4},
+			{BucketStart: at(day(9)), Count: 7},
+		}, nil)
+		repo.On("QueryEvents", ctx, mock.MatchedBy(func(q domain.StatsQuery) bool {
+			return q.Start.Equal(day(10)) && q.End.Equal(rollupNow)
+		})).Return([]domain.StatsQueryRow{
+			{BucketStart: at(day(11)), Count: 1},
+			{BucketStart: at(day(10)), Count: 2},
+		}, nil)
+
+		result, err := svc.QueryStats(ctx, domain.StatsQuery{
+			Bucket:      domain.StatsBucketDay,
+			Start:       day(8).Add(5 * time.Hour),
+			GroupByTime: true,
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, day(8), result.StartTime)
+		require.Len(t, result.Rows, 4)
+		for i, want := range []int{4, 7, 2, 1} {
+			assert.Equal(t, want, result.Rows[i].Count)
+		}
+	})
+
+	t.Run("merges groups split across rollups and raw events", func(t *testing.T) {
+		svc, repo := newRollupService(t, domain.StatsBucketHour)
+		repo.On("GetRollupProgress", ctx, domain.StatsBucketHour).Return(day(11), true, nil)
+		repo.On("QueryRollups", ctx, mock.Anything).Return([]domain.StatsQueryRow{
+			{UserID: "a", Count: 5},
+			{UserID: "b", Count: 3},
+		}, nil)
+		repo.On("QueryEvents", ctx, mock.Anything).Return([]domain.StatsQueryRow{
+			{UserID: "b", Count: 4},
+		}, nil)
+
+		result, err := svc.QueryStats(ctx, domain.StatsQuery{
+			Bucket:      domain.StatsBucketHour,
+			Start:       day(10),
+			GroupByUser: true,
+			Limit:       1,
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, []domain.StatsQueryRow{{UserID: "b", Count: 7}}, result.Rows)
+		assert.True(t, result.Truncated)
+	})
+
+	t.Run("counts raw events for bucket sizes that are not rolled up", func(t *testing.T) {
+		svc, repo := newRollupService(t, domain.StatsBucketHour)
+		repo.On("QueryEvents", ctx, mock.Anything).Return(nil, nil)
+
+		result, err := svc.QueryStats(ctx, domain.StatsQuery{Bucket: domain.StatsBucketWeek})
+
+		require.NoError(t, err)
+		assert.Empty(t, result.Rows)
+	})
+
+	for name, query := range map[string]domain.StatsQuery{
+		"unknown bucket":    {Bucket: "minute"},
+		"end before start":  {Start: day(10), End: day(9)},
+		"too many buckets":  {Bucket: domain.StatsBucketHour, Start: day(1).AddDate(0, -3, 0)},
+		"malformed user ID": {UserID: "alice"},
+	} {
+		t.Run("rejects "+name, func(t *testing.T) {
+			svc, _ := newRollupService(t, domain.StatsBucketDay)
+
+			_, err := svc.QueryStats(ctx, query)
+
+			assert.ErrorIs(t, err, domain.ErrInvalidInput)
+		})
+	}
+
+	t.Run("returns repository errors", func(t *testing.T) {
+		svc, repo := newRollupService(t, domain.StatsBucketDay)
+		repo.On("GetRollupProgress", ctx, domain.StatsBucketDay).Return(time.Time{}, false, errors.New("db down"))
+
+		_, err := svc.QueryStats(ctx, domain.StatsQuery{})
+
+		assert.Error(t, err)
+	})
+}
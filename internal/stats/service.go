@@ -18,24 +18,56 @@ type Service interface {
 	GetUserStats(ctx context.Context, userID string, period string) (*domain.StatsSummary, error)
 	GetUserCurrentStreak(ctx context.Context, userID string) (int, error)
 	GetSystemStats(ctx context.Context, period string) (*domain.StatsSummary, error)
-	GetLeaderboard(ctx context.Context, eventType domain.EventType, period string, limit int) ([]domain.LeaderboardEntry, error)
+	// GetUserRecipeCraftCounts returns how many items the user has crafted
+	// with each recipe, by recipe key
+	GetUserRecipeCraftCounts(ctx context.Context, userID string) (map[string]int, error)
+	// GetLeaderboard returns one page of the leaderboard. With AroundUserID set
+	// the page is centred on that user instead of starting at Offset.
+	GetLeaderboard(ctx context.Context, query domain.LeaderboardQuery) (*domain.LeaderboardPage, error)
 	// Slots-specific stats
 	GetUserSlotsStats(ctx context.Context, userID string, period string) (*domain.SlotsStats, error)
 	GetSlotsLeaderboardByProfit(ctx context.Context, period string, limit int) ([]domain.SlotsStats, error)
 	GetSlotsLeaderboardByWinRate(ctx context.Context, period string, minSpins, limit int) ([]domain.SlotsStats, error)
 	GetSlotsLeaderboardByMegaJackpots(ctx context.Context, period string, limit int) ([]domain.SlotsStats, error)
+
+	// QueryStats counts events over a range, grouped as the query asks.
+	// Closed buckets are read from rollups and the rest from raw events.
+	QueryStats(ctx context.Context, query domain.StatsQuery) (*domain.StatsQueryResult, error)
+	// RollUpDue rolls up buckets that have closed since the last run and
+	// reports how many were rolled up
+	RollUpDue(ctx context.Context) (int, error)
+}
+
+// Option configures the stats service
+type Option func(*service)
+
+// WithRollups enables QueryStats and rolling up the given bucket sizes.
+// Queries by other bucket sizes are answered from raw events.
+func WithRollups(repo AggregateRepository, buckets []domain.StatsBucket) Option {
+	return func(s *service) {
+		s.aggregates = repo
+		s.rollupBuckets = buckets
+	}
 }
 
 // service implements the Service interface
 type service struct {
-	repo repository.Stats
+	repo          repository.Stats
+	aggregates    AggregateRepository // nil disables QueryStats and rollups
+	rollupBuckets []domain.StatsBucket
+	now           func() time.Time
 }
 
 // NewService creates a new stats service
-func NewService(repo repository.Stats) Service {
-	return &service{
+func NewService(repo repository.Stats, opts ...Option) Service {
+	s := &service{
 		repo: repo,
+		now:  time.Now,
+	}
+	for _, opt := range opts {
+		opt(s)
 	}
+	return s
 }
 
 // RecordUserEvent records a user event with the provided metadata
@@ -198,6 +230,20 @@ func (s *service) GetUserStats(ctx context.Context, userID string, period string
 	return summary, nil
 }
 
+// GetUserRecipeCraftCounts retrieves a user's all-time craft count for each recipe
+func (s *service) GetUserRecipeCraftCounts(ctx context.Context, userID string) (map[string]int, error) {
+	if userID == "" {
+		return nil, errors.New(ErrMsgUserIDRequired)
+	}
+
+	counts, err := s.repo.GetUserRecipeCraftCounts(ctx, userID)
+	if err != nil {
+		logger.FromContext(ctx).Error(LogMsgFailedToGetRecipeCraftCounts, "error", err, "user_id", userID)
+		return nil, fmt.Errorf(ErrMsgGetRecipeCraftCountsFailed, err)
+	}
+	return counts, nil
+}
+
 // GetSystemStats retrieves system-wide statistics for a time period
 func (s *service) GetSystemStats(ctx context.Context, period string) (*domain.StatsSummary, error) {
 	log := logger.FromContext(ctx)
@@ -228,24 +274,57 @@ func (s *service) GetSystemStats(ctx context.Context, period string) (*domain.St
 	return summary, nil
 }
 
-// GetLeaderboard retrieves the leaderboard for a specific event type and time period
-func (s *service) GetLeaderboard(ctx context.Context, eventType domain.EventType, period string, limit int) ([]domain.LeaderboardEntry, error) {
+// GetLeaderboard retrieves a page of the leaderboard for a specific event type and time period
+func (s *service) GetLeaderboard(ctx context.Context, query domain.LeaderboardQuery) (*domain.LeaderboardPage, error) {
 	log := logger.FromContext(ctx)
 
+	limit := query.Limit
 	if limit <= 0 {
 		limit = DefaultLeaderboardLimit
 	}
+	limit = min(limit, MaxLeaderboardLimit)
+	offset := max(query.Offset, 0)
 
-	startTime, endTime := getPeriodRange(period)
+	startTime, endTime := getPeriodRange(query.Period)
 
-	entries, err := s.repo.GetTopUsers(ctx, eventType, startTime, endTime, limit)
+	page := &domain.LeaderboardPage{
+		EventType: string(query.EventType),
+		Period:    query.Period,
+		Limit:     limit,
+	}
+
+	if query.AroundUserID != "" {
+		rank, err := s.repo.GetUserLeaderboardRank(ctx, query.EventType, query.AroundUserID, startTime, endTime)
+		if err != nil {
+			log.Error(LogMsgFailedToGetLeaderboard, "error", err, "event_type", query.EventType, "user_id", query.AroundUserID)
+			return nil, fmt.Errorf(ErrMsgGetLeaderboardRankFailed, err)
+		}
+		page.UserRank = rank
+		// Unranked users get the top of the board
+		offset = 0
+		if rank > 0 {
+			offset = max(rank-1-limit/2, 0)
+		}
+	}
+	page.Offset = offset
+
+	// Fetch one extra row to learn whether another page follows
+	entries, err := s.repo.GetTopUsers(ctx, query.EventType, startTime, endTime, limit+1, offset)
 	if err != nil {
-		log.Error(LogMsgFailedToGetLeaderboard, "error", err, "event_type", eventType)
+		log.Error(LogMsgFailedToGetLeaderboard, "error", err, "event_type", query.EventType)
 		return nil, fmt.Errorf(ErrMsgGetLeaderboardFailed, err)
 	}
+	if len(entries) > limit {
+		page.HasMore = true
+		entries = entries[:limit]
+	}
+	for i := range entries {
+		entries[i].Rank = offset + i + 1
+	}
+	page.Entries = entries
 
-	log.Debug(LogMsgRetrievedLeaderboard, "event_type", eventType, "period", period, "entries", len(entries))
-	return entries, nil
+	log.Debug(LogMsgRetrievedLeaderboard, "event_type", query.EventType, "period", query.Period, "offset", offset, "entries", len(entries))
+	return page, nil
 }
 
 // getPeriodRange calculates the start and end time for a given period
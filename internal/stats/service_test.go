@@ -3,6 +3,7 @@ package stats
 import (
 	"context"
 	"errors"
+	"fmt"
 	"sort"
 	"testing"
 	"time"
@@ -77,10 +78,31 @@ func (m *mockStatsRepository) GetUserEventsByType(ctx context.Context, userID st
 	return filtered, nil
 }
 
-func (m *mockStatsRepository) GetTopUsers(ctx context.Context, eventType domain.EventType, startTime, endTime time.Time, limit int) ([]domain.LeaderboardEntry, error) {
+func (m *mockStatsRepository) GetTopUsers(ctx context.Context, eventType domain.EventType, startTime, endTime time.Time, limit, offset int) ([]domain.LeaderboardEntry, error) {
 	if m.getTopUsersError != nil {
 		return nil, m.getTopUsersError
 	}
+	entries := m.rankedEntries(eventType, startTime, endTime)
+	if offset >= len(entries) {
+		return []domain.LeaderboardEntry{}, nil
+	}
+	return entries[offset:min(offset+limit, len(entries))], nil
+}
+
+func (m *mockStatsRepository) GetUserLeaderboardRank(ctx context.Context, eventType domain.EventType, userID string, startTime, endTime time.Time) (int, error) {
+	if m.getTopUsersError != nil {
+		return 0, m.getTopUsersError
+	}
+	for i, entry := range m.rankedEntries(eventType, startTime, endTime) {
+		if entry.UserID == userID {
+			return i + 1, nil
+		}
+	}
+	return 0, nil
+}
+
+// rankedEntries orders users like GetTopUsers: by count descending, then user ID
+func (m *mockStatsRepository) rankedEntries(eventType domain.EventType, startTime, endTime time.Time) []domain.LeaderboardEntry {
 	counts := make(map[string]int)
 	for _, event := range m.events {
 		if event.EventType == eventType && event.CreatedAt.After(startTime) && event.CreatedAt.Before(endTime) {
@@ -96,8 +118,13 @@ func (m *mockStatsRepository) GetTopUsers(ctx context.Context, eventType domain.
 			EventType: string(eventType),
 		})
 	}
-
-	return entries, nil
+	sort.Slice(entries, func(i, j int) bool {
+		if entries[i].Count != entries[j].Count {
+			return entries[i].Count > entries[j].Count
+		}
+		return entries[i].UserID < entries[j].UserID
+	})
+	return entries
 }
 
 func (m *mockStatsRepository) GetEventCounts(ctx context.Context, startTime, endTime time.Time) (map[domain.EventType]int, error) {
@@ -126,6 +153,17 @@ func (m *mockStatsRepository) GetUserEventCounts(ctx context.Context, userID str
 	return counts, nil
 }
 
+func (m *mockStatsRepository) GetUserRecipeCraftCounts(ctx context.Context, userID string) (map[string]int, error) {
+	counts := make(map[string]int)
+	for _, event := range m.events {
+		meta, ok := event.EventData.(domain.CraftingMetadata)
+		if event.UserID == userID && event.EventType == domain.StatsEventRecipeCrafted && ok {
+			counts[meta.RecipeKey] += meta.Quantity
+		}
+	}
+	return counts, nil
+}
+
 func (m *mockStatsRepository) GetTotalEventCount(ctx context.Context, startTime, endTime time.Time) (int, error) {
 	if m.getTotalEventCountError != nil {
 		return 0, m.getTotalEventCountError
@@ -198,6 +236,27 @@ func TestRecordUserEvent(t *testing.T) {
 	}
 }
 
+func TestGetUserRecipeCraftCounts(t *testing.T) {
+	repo := &mockStatsRepository{}
+	svc := NewService(repo)
+	ctx := context.Background()
+
+	for _, meta := range []domain.CraftingMetadata{
+		{RecipeKey: "lootbox_tier0", Quantity: 3},
+		{RecipeKey: "lootbox_tier0", Quantity: 2},
+		{RecipeKey: "lootbox_tier1", Quantity: 1},
+	} {
+		require.NoError(t, svc.RecordUserEvent(ctx, "user-crafter", domain.StatsEventRecipeCrafted, meta))
+	}
+
+	counts, err := svc.GetUserRecipeCraftCounts(ctx, "user-crafter")
+	require.NoError(t, err)
+	require.Equal(t, map[string]int{"lootbox_tier0": 5, "lootbox_tier1": 1}, counts)
+
+	_, err = svc.GetUserRecipeCraftCounts(ctx, "")
+	require.Error(t, err)
+}
+
 func TestRecordUserEvent_DailyStreak(t *testing.T) {
 	repo := &mockStatsRepository{}
 	svc := NewService(repo)
@@ -379,29 +438,93 @@ func TestGetLeaderboard(t *testing.T) {
 	svc := NewService(repo)
 	ctx := context.Background()
 
-	leaderboard, err := svc.GetLeaderboard(ctx, domain.StatsEventItemSold, "daily", 10)
+	leaderboard, err := svc.GetLeaderboard(ctx, domain.LeaderboardQuery{EventType: domain.StatsEventItemSold, Period: "daily", Limit: 10})
 	if err != nil {
 		t.Fatalf("Expected no error, got %v", err)
 	}
 
-	if len(leaderboard) != 2 {
-		t.Fatalf("Expected 2 leaderboard entries, got %d", len(leaderboard))
+	if len(leaderboard.Entries) != 2 {
+		t.Fatalf("Expected 2 leaderboard entries, got %d", len(leaderboard.Entries))
 	}
 
-	// Check that users are counted correctly
-	userCounts := make(map[string]int)
-	for _, entry := range leaderboard {
-		userCounts[entry.UserID] = entry.Count
+	// Check that users are counted and ranked correctly
+	if leaderboard.Entries[0].UserID != "user-1" || leaderboard.Entries[0].Count != 2 || leaderboard.Entries[0].Rank != 1 {
+		t.Errorf("Expected user-1 ranked 1st with 2 events, got %+v", leaderboard.Entries[0])
 	}
-
-	if userCounts["user-1"] != 2 {
-		t.Errorf("Expected user-1 to have 2 events, got %d", userCounts["user-1"])
+	if leaderboard.Entries[1].UserID != "user-2" || leaderboard.Entries[1].Count != 1 || leaderboard.Entries[1].Rank != 2 {
+		t.Errorf("Expected user-2 ranked 2nd with 1 event, got %+v", leaderboard.Entries[1])
 	}
-	if userCounts["user-2"] != 1 {
-		t.Errorf("Expected user-2 to have 1 event, got %d", userCounts["user-2"])
+	if leaderboard.HasMore {
+		t.Error("Expected no further pages")
 	}
 }
 
+// leaderboardRepo returns a repository where user-NN has NN item_sold events,
+// so user-10 ranks 1st and user-01 ranks 10th
+func leaderboardRepo() *mockStatsRepository {
+	repo := &mockStatsRepository{}
+	for n := 1; n <= 10; n++ {
+		for range n {
+			repo.events = append(repo.events, domain.StatsEvent{
+				UserID:    fmt.Sprintf("user-%02d", n),
+				EventType: domain.StatsEventItemSold,
+				CreatedAt: time.Now().Add(-time.Minute),
+			})
+		}
+	}
+	return repo
+}
+
+func TestGetLeaderboard_Pagination(t *testing.T) {
+	svc := NewService(leaderboardRepo())
+	ctx := context.Background()
+
+	page, err := svc.GetLeaderboard(ctx, domain.LeaderboardQuery{EventType: domain.StatsEventItemSold, Period: "daily", Limit: 3, Offset: 3})
+	require.NoError(t, err)
+	require.Len(t, page.Entries, 3)
+	require.Equal(t, 3, page.Offset)
+	require.True(t, page.HasMore)
+	require.Equal(t, 4, page.Entries[0].Rank)
+	require.Equal(t, "user-07", page.Entries[0].UserID)
+
+	last, err := svc.GetLeaderboard(ctx, domain.LeaderboardQuery{EventType: domain.StatsEventItemSold, Period: "daily", Limit: 3, Offset: 9})
+	require.NoError(t, err)
+	require.Len(t, last.Entries, 1)
+	require.False(t, last.HasMore)
+	require.Equal(t, 10, last.Entries[0].Rank)
+}
+
+func TestGetLeaderboard_AroundUser(t *testing.T) {
+	svc := NewService(leaderboardRepo())
+	ctx := context.Background()
+
+	t.Run("centres on the user", func(t *testing.T) {
+		page, err := svc.GetLeaderboard(ctx, domain.LeaderboardQuery{EventType: domain.StatsEventItemSold, Period: "daily", Limit: 5, Offset: 8, AroundUserID: "user-05"})
+		require.NoError(t, err)
+		require.Equal(t, 6, page.UserRank)
+		require.Equal(t, 3, page.Offset)
+		require.Len(t, page.Entries, 5)
+		require.Equal(t, "user-05", page.Entries[2].UserID)
+		require.Equal(t, 6, page.Entries[2].Rank)
+	})
+
+	t.Run("clamps at the top", func(t *testing.T) {
+		page, err := svc.GetLeaderboard(ctx, domain.LeaderboardQuery{EventType: domain.StatsEventItemSold, Period: "daily", Limit: 5, AroundUserID: "user-10"})
+		require.NoError(t, err)
+		require.Equal(t, 1, page.UserRank)
+		require.Equal(t, 0, page.Offset)
+		require.Equal(t, "user-10", page.Entries[0].UserID)
+	})
+
+	t.Run("unranked user gets the top page", func(t *testing.T) {
+		page, err := svc.GetLeaderboard(ctx, domain.LeaderboardQuery{EventType: domain.StatsEventItemSold, Period: "daily", Limit: 5, Offset: 5, AroundUserID: "user-99"})
+		require.NoError(t, err)
+		require.Equal(t, 0, page.UserRank)
+		require.Equal(t, 0, page.Offset)
+		require.Equal(t, 1, page.Entries[0].Rank)
+	})
+}
+
 func TestService_GetSystemStats_ErrorCounts(t *testing.T) {
 	ctx := context.Background()
 	mockRepo := &mockStatsRepository{
@@ -435,7 +558,7 @@ func TestService_GetLeaderboard_Error(t *testing.T) {
 	}
 	svc := NewService(mockRepo)
 
-	_, err := svc.GetLeaderboard(ctx, domain.StatsEventItemSold, "daily", 10)
+	_, err := svc.GetLeaderboard(ctx, domain.LeaderboardQuery{EventType: domain.StatsEventItemSold, Period: "daily", Limit: 10})
 	if err == nil {
 		t.Fatal("expected error, got nil")
 	}
@@ -618,7 +741,7 @@ func TestService_GetLeaderboard(t *testing.T) {
 	mockRepo := &mockStatsRepository{}
 	svc := NewService(mockRepo)
 
-	stats, err := svc.GetLeaderboard(ctx, domain.StatsEventItemSold, "daily", 10)
+	stats, err := svc.GetLeaderboard(ctx, domain.LeaderboardQuery{EventType: domain.StatsEventItemSold, Period: "daily", Limit: 10})
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
@@ -797,5 +920,5 @@ func TestService_GetLeaderboard_Error2(t *testing.T) {
 		getTopUsersError: errors.New("db error"),
 	}
 	svc := NewService(mockRepo)
-	svc.GetLeaderboard(ctx, domain.StatsEventItemSold, "daily", 10)
+	svc.GetLeaderboard(ctx, domain.LeaderboardQuery{EventType: domain.StatsEventItemSold, Period: "daily", Limit: 10})
 }
@@ -0,0 +1,13 @@
+package stream
+
+// DefaultTopUsers is how many of the most active users a summary lists
+const DefaultTopUsers = 5
+
+// Log messages
+const (
+	LogMsgStreamStarted     = "Stream session started"
+	LogMsgStreamAlreadyLive = "Stream already live, keeping the current session"
+	LogMsgStreamEnded       = "Stream session ended"
+	LogMsgRecapGenerated    = "Stream recap generated"
+	LogWarnRecapFailed      = "Failed to generate stream recap"
+)
@@ -0,0 +1,34 @@
+package stream
+
+import (
+	"context"
+	"time"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+)
+
+// Repository stores the current community's stream sessions
+type Repository interface {
+	// StartSession opens a session and returns nil if the community already
+	// has a live one
+	StartSession(ctx context.Context, platform, title string) (*domain.StreamSession, error)
+
+	// GetLiveSession returns the live session, or nil if there is none
+	GetLiveSession(ctx context.Context) (*domain.StreamSession, error)
+
+	// EndSession closes the live session and returns it, or nil if there was
+	// none
+	EndSession(ctx context.Context) (*domain.StreamSession, error)
+
+	// GetSession returns a session, or nil if there is no session with the ID
+	GetSession(ctx context.Context, id int64) (*domain.StreamSession, error)
+
+	// GetSessionSummary totals the stats events and gambles tagged with the
+	// session. The returned summary has its session and duration unset.
+	GetSessionSummary(ctx context.Context, id int64, topUsers int) (*domain.StreamSummary, error)
+
+	// GetSessionHighlights finds a session's notable moments up to until: the
+	// biggest gamble win, the top contributor and the nodes unlocked. The
+	// returned recap has its summary unset.
+	GetSessionHighlights(ctx context.Context, session *domain.StreamSession, until time.Time) (*domain.StreamRecap, error)
+}
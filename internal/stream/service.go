@@ -0,0 +1,164 @@
+// Package stream tracks stream sessions. While a session is live, stats
+// events and gambles are tagged with its ID when they are recorded, so a
+// summary of each stream can be put together, and a recap of its notable
+// moments is published once it ends.
+package stream
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/event"
+	"github.com/osse101/BrandishBot_Go/internal/logger"
+)
+
+// Service opens, closes and summarises stream sessions
+type Service interface {
+	// Start opens a session. If the community is already live it returns the
+	// live session and false instead, so adapters can report a stream going
+	// live more than once.
+	Start(ctx context.Context, req domain.StartStreamRequest) (*domain.StreamSession, bool, error)
+
+	// End closes the live session. It returns domain.ErrNoLiveStream when no
+	// stream is live.
+	End(ctx context.Context) (*domain.StreamSession, error)
+
+	// GetSummary totals a session's activity. It returns
+	// domain.ErrStreamSessionNotFound for unknown IDs.
+	GetSummary(ctx context.Context, id int64) (*domain.StreamSummary, error)
+
+	// GetRecap builds a session's recap: its summary plus the biggest gamble
+	// win, top contributor and unlocks. It returns
+	// domain.ErrStreamSessionNotFound for unknown IDs.
+	GetRecap(ctx context.Context, id int64) (*domain.StreamRecap, error)
+}
+
+// Publisher publishes stream session events
+type Publisher interface {
+	PublishWithRetry(ctx context.Context, evt event.Event)
+}
+
+type service struct {
+	repo      Repository
+	publisher Publisher
+	now       func() time.Time
+}
+
+// NewService creates a stream session service. publisher may be nil.
+func NewService(repo Repository, publisher Publisher) Service {
+	return &service{
+		repo:      repo,
+		publisher: publisher,
+		now:       time.Now,
+	}
+}
+
+func (s *service) Start(ctx context.Context, req domain.StartStreamRequest) (*domain.StreamSession, bool, error) {
+	log := logger.FromContext(ctx)
+	session, err := s.repo.StartSession(ctx, req.Platform, req.Title)
+	if err != nil {
+		return nil, false, err
+	}
+	if session == nil {
+		live, err := s.repo.GetLiveSession(ctx)
+		if err != nil {
+			return nil, false, err
+		}
+		if live == nil {
+			// The live session ended between the insert and the lookup
+			return nil, false, fmt.Errorf("failed to start stream session: live session ended concurrently")
+		}
+		log.Info(LogMsgStreamAlreadyLive, "session_id", live.ID, "platform", req.Platform)
+		return live, false, nil
+	}
+
+	log.Info(LogMsgStreamStarted, "session_id", session.ID, "platform", session.Platform)
+	if s.publisher != nil {
+		s.publisher.PublishWithRetry(ctx, event.NewStreamStartedEvent(session))
+	}
+	return session, true, nil
+}
+
+func (s *service) End(ctx context.Context) (*domain.StreamSession, error) {
+	session, err := s.repo.EndSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if session == nil {
+		return nil, domain.ErrNoLiveStream
+	}
+
+	log := logger.FromContext(ctx)
+	log.Info(LogMsgStreamEnded, "session_id", session.ID, "platform", session.Platform)
+	if s.publisher == nil {
+		return session, nil
+	}
+	s.publisher.PublishWithRetry(ctx, event.NewStreamEndedEvent(session))
+
+	// The session is closed either way, so a failed recap is only logged;
+	// it can still be fetched from the recap endpoint
+	recap, err := s.GetRecap(ctx, session.ID)
+	if err != nil {
+		log.Warn(LogWarnRecapFailed, "session_id", session.ID, "error", err)
+		return session, nil
+	}
+	log.Info(LogMsgRecapGenerated, "session_id", session.ID, "unlocks", len(recap.Unlocks))
+	s.publisher.PublishWithRetry(ctx, event.NewStreamRecapEvent(recap))
+	return session, nil
+}
+
+func (s *service) GetSummary(ctx context.Context, id int64) (*domain.StreamSummary, error) {
+	session, err := s.getSession(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return s.summarise(ctx, session)
+}
+
+func (s *service) GetRecap(ctx context.Context, id int64) (*domain.StreamRecap, error) {
+	session, err := s.getSession(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	summary, err := s.summarise(ctx, session)
+	if err != nil {
+		return nil, err
+	}
+	recap, err := s.repo.GetSessionHighlights(ctx, session, s.sessionEnd(session))
+	if err != nil {
+		return nil, err
+	}
+	recap.Summary = *summary
+	return recap, nil
+}
+
+func (s *service) getSession(ctx context.Context, id int64) (*domain.StreamSession, error) {
+	session, err := s.repo.GetSession(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if session == nil {
+		return nil, domain.ErrStreamSessionNotFound
+	}
+	return session, nil
+}
+
+func (s *service) summarise(ctx context.Context, session *domain.StreamSession) (*domain.StreamSummary, error) {
+	summary, err := s.repo.GetSessionSummary(ctx, session.ID, DefaultTopUsers)
+	if err != nil {
+		return nil, err
+	}
+	summary.Session = *session
+	summary.DurationSeconds = int64(s.sessionEnd(session).Sub(session.StartedAt).Seconds())
+	return summary, nil
+}
+
+// sessionEnd is when the session ended, or now while it is live
+func (s *service) sessionEnd(session *domain.StreamSession) time.Time {
+	if session.EndedAt != nil {
+		return *session.EndedAt
+	}
+	return s.now()
+}
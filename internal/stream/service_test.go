@@ -0,0 +1,200 @@
+package stream
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/event"
+	"github.com/osse101/BrandishBot_Go/internal/stream/mocks"
+)
+
+// testNow is the fixed time the service under test sees
+var testNow = time.Date(2026, 10, 15, 20, 0, 0, 0, time.UTC)
+
+func setupServiceTest(t *testing.T) (*service, *mocks.MockRepository, *mocks.MockPublisher) {
+	mockRepo := mocks.NewMockRepository(t)
+	mockPublisher := mocks.NewMockPublisher(t)
+	svc := NewService(mockRepo, mockPublisher).(*service)
+	svc.now = func() time.Time { return testNow }
+	return svc, mockRepo, mockPublisher
+}
+
+func TestStart(t *testing.T) {
+	ctx := context.Background()
+	req := domain.StartStreamRequest{Platform: domain.PlatformTwitch, Title: "Friday crafting"}
+
+	t.Run("opens a session and publishes it", func(t *testing.T) {
+		svc, mockRepo, mockPublisher := setupServiceTest(t)
+		session := &domain.StreamSession{ID: 7, Platform: req.Platform, Title: req.Title, StartedAt: testNow}
+		mockRepo.On("StartSession", mock.Anything, req.Platform, req.Title).Return(session, nil)
+		mockPublisher.On("PublishWithRetry", mock.Anything, mock.MatchedBy(func(evt event.Event) bool {
+			payload, ok := evt.Payload.(event.StreamSessionPayloadV1)
+			return evt.Type == event.StreamStarted && ok && payload.SessionID == 7
+		})).Once()
+
+		got, created, err := svc.Start(ctx, req)
+
+		require.NoError(t, err)
+		assert.True(t, created)
+		assert.Equal(t, session, got)
+	})
+
+	t.Run("returns the live session when already live", func(t *testing.T) {
+		svc, mockRepo, _ := setupServiceTest(t)
+		live := &domain.StreamSession{ID: 3, Platform: domain.PlatformYoutube, StartedAt: testNow.Add(-time.Hour)}
+		mockRepo.On("StartSession", mock.Anything, req.Platform, req.Title).Return(nil, nil)
+		mockRepo.On("GetLiveSession", mock.Anything).Return(live, nil)
+
+		got, created, err := svc.Start(ctx, req)
+
+		require.NoError(t, err)
+		assert.False(t, created)
+		assert.Equal(t, live, got)
+	})
+
+	t.Run("repository errors are returned", func(t *testing.T) {
+		svc, mockRepo, _ := setupServiceTest(t)
+		mockRepo.On("StartSession", mock.Anything, req.Platform, req.Title).Return(nil, errors.New("db down"))
+
+		_, _, err := svc.Start(ctx, req)
+
+		require.Error(t, err)
+	})
+}
+
+func TestEnd(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("closes the live session and publishes it with its recap", func(t *testing.T) {
+		svc, mockRepo, mockPublisher := setupServiceTest(t)
+		ended := testNow
+		session := &domain.StreamSession{ID: 7, Platform: domain.PlatformTwitch, StartedAt: testNow.Add(-2 * time.Hour), EndedAt: &ended}
+		mockRepo.On("EndSession", mock.Anything).Return(session, nil)
+		mockRepo.On("GetSession", mock.Anything, int64(7)).Return(session, nil)
+		mockRepo.On("GetSessionSummary", mock.Anything, int64(7), DefaultTopUsers).Return(&domain.StreamSummary{TotalEvents: 42}, nil)
+		mockRepo.On("GetSessionHighlights", mock.Anything, session, ended).Return(&domain.StreamRecap{
+			TopContributor: &domain.StreamContributor{Username: "alice", Score: 120},
+		}, nil)
+		mockPublisher.On("PublishWithRetry", mock.Anything, mock.MatchedBy(func(evt event.Event) bool {
+			payload, ok := evt.Payload.(event.StreamSessionPayloadV1)
+			return evt.Type == event.StreamEnded && ok && payload.SessionID == 7 && payload.EndedAt == ended.Unix()
+		})).Once()
+		mockPublisher.On("PublishWithRetry", mock.Anything, mock.MatchedBy(func(evt event.Event) bool {
+			payload, ok := evt.Payload.(event.StreamRecapPayloadV1)
+			return evt.Type == event.StreamRecapGenerated && ok && payload.Recap.Summary.TotalEvents == 42 &&
+				payload.Recap.TopContributor.Username == "alice"
+		})).Once()
+
+		got, err := svc.End(ctx)
+
+		require.NoError(t, err)
+		assert.Equal(t, session, got)
+	})
+
+	t.Run("a failed recap still ends the session", func(t *testing.T) {
+		svc, mockRepo, mockPublisher := setupServiceTest(t)
+		ended := testNow
+		session := &domain.StreamSession{ID: 7, StartedAt: testNow.Add(-time.Hour), EndedAt: &ended}
+		mockRepo.On("EndSession", mock.Anything).Return(session, nil)
+		mockRepo.On("GetSession", mock.Anything, int64(7)).Return(nil, errors.New("db down"))
+		mockPublisher.On("PublishWithRetry", mock.Anything, mock.MatchedBy(func(evt event.Event) bool {
+			return evt.Type == event.StreamEnded
+		})).Once()
+
+		got, err := svc.End(ctx)
+
+		require.NoError(t, err)
+		assert.Equal(t, session, got)
+	})
+
+	t.Run("no live stream", func(t *testing.T) {
+		svc, mockRepo, _ := setupServiceTest(t)
+		mockRepo.On("EndSession", mock.Anything).Return(nil, nil)
+
+		_, err := svc.End(ctx)
+
+		assert.ErrorIs(t, err, domain.ErrNoLiveStream)
+	})
+}
+
+func TestGetSummary(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("ended sessions last until they ended", func(t *testing.T) {
+		svc, mockRepo, _ := setupServiceTest(t)
+		ended := testNow.Add(-time.Hour)
+		session := &domain.StreamSession{ID: 7, StartedAt: ended.Add(-90 * time.Minute), EndedAt: &ended}
+		mockRepo.On("GetSession", mock.Anything, int64(7)).Return(session, nil)
+		mockRepo.On("GetSessionSummary", mock.Anything, int64(7), DefaultTopUsers).Return(&domain.StreamSummary{TotalEvents: 42, Drops: 3}, nil)
+
+		summary, err := svc.GetSummary(ctx, 7)
+
+		require.NoError(t, err)
+		assert.Equal(t, *session, summary.Session)
+		assert.Equal(t, int64(90*60), summary.DurationSeconds)
+		assert.Equal(t, 42, summary.TotalEvents)
+		assert.Equal(t, 3, summary.Drops)
+	})
+
+	t.Run("live sessions last until now", func(t *testing.T) {
+		svc, mockRepo, _ := setupServiceTest(t)
+		session := &domain.StreamSession{ID: 8, StartedAt: testNow.Add(-10 * time.Minute)}
+		mockRepo.On("GetSession", mock.Anything, int64(8)).Return(session, nil)
+		mockRepo.On("GetSessionSummary", mock.Anything, int64(8), DefaultTopUsers).Return(&domain.StreamSummary{}, nil)
+
+		summary, err := svc.GetSummary(ctx, 8)
+
+		require.NoError(t, err)
+		assert.Equal(t, int64(600), summary.DurationSeconds)
+	})
+
+	t.Run("unknown session", func(t *testing.T) {
+		svc, mockRepo, _ := setupServiceTest(t)
+		mockRepo.On("GetSession", mock.Anything, int64(9)).Return(nil, nil)
+
+		_, err := svc.GetSummary(ctx, 9)
+
+		assert.ErrorIs(t, err, domain.ErrStreamSessionNotFound)
+	})
+}
+
+func TestGetRecap(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("combines the summary and highlights", func(t *testing.T) {
+		svc, mockRepo, _ := setupServiceTest(t)
+		session := &domain.StreamSession{ID: 8, StartedAt: testNow.Add(-30 * time.Minute)}
+		win := &domain.StreamGambleWin{GambleID: "g-1", Username: "bob", TotalValue: 5000}
+		mockRepo.On("GetSession", mock.Anything, int64(8)).Return(session, nil)
+		mockRepo.On("GetSessionSummary", mock.Anything, int64(8), DefaultTopUsers).Return(&domain.StreamSummary{Gambles: 2}, nil)
+		// A live session's highlights run up to now
+		mockRepo.On("GetSessionHighlights", mock.Anything, session, testNow).Return(&domain.StreamRecap{
+			BiggestGambleWin: win,
+			Unlocks:          []domain.StreamUnlock{{NodeKey: "feature_slots", Level: 1}},
+		}, nil)
+
+		recap, err := svc.GetRecap(ctx, 8)
+
+		require.NoError(t, err)
+		assert.Equal(t, int64(1800), recap.Summary.DurationSeconds)
+		assert.Equal(t, 2, recap.Summary.Gambles)
+		assert.Equal(t, win, recap.BiggestGambleWin)
+		assert.Len(t, recap.Unlocks, 1)
+	})
+
+	t.Run("unknown session", func(t *testing.T) {
+		svc, mockRepo, _ := setupServiceTest(t)
+		mockRepo.On("GetSession", mock.Anything, int64(9)).Return(nil, nil)
+
+		_, err := svc.GetRecap(ctx, 9)
+
+		assert.ErrorIs(t, err, domain.ErrStreamSessionNotFound)
+	})
+}
@@ -51,6 +51,10 @@ const (
 	ActionTimeoutUpdate      = "BrandishBot_TimeoutUpdate"
 	ActionSubscriptionUpdate = "BrandishBot_SubscriptionUpdate"
 	ActionItemUsed           = "BrandishBot_ItemUsed"
+	ActionCelebration        = "BrandishBot_Celebration"
+	ActionChatDrop           = "BrandishBot_ChatDrop"
+	ActionCommunityBonus     = "BrandishBot_CommunityBonus"
+	ActionJackpotWon         = "BrandishBot_JackpotWon"
 )
 
 // Response status values
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"strings"
 
 	"github.com/osse101/BrandishBot_Go/internal/domain"
 	"github.com/osse101/BrandishBot_Go/internal/event"
@@ -55,6 +56,18 @@ func (s *Subscriber) Subscribe() {
 	// Subscribe to item used events
 	s.bus.Subscribe(event.Type(domain.EventTypeItemUsed), s.handleItemUsed)
 
+	// Subscribe to birthday and anniversary rewards
+	s.bus.Subscribe(event.CelebrationGranted, s.handleCelebration)
+
+	// Subscribe to chat activity drops
+	s.bus.Subscribe(event.ChatDropped, s.handleChatDrop)
+
+	// Subscribe to raid and host bonuses
+	s.bus.Subscribe(event.CommunityBonusGranted, s.handleCommunityBonus)
+
+	// Subscribe to progressive jackpot wins
+	s.bus.Subscribe(event.JackpotWon, s.handleJackpotWon)
+
 	slog.Info("Streamer.bot subscriber registered for event types",
 		"types", []string{
 			string(domain.EventTypeJobLevelUp),
@@ -71,6 +84,10 @@ func (s *Subscriber) Subscribe() {
 			string(event.SubscriptionExpired),
 			string(event.SubscriptionCancelled),
 			string(domain.EventTypeItemUsed),
+			string(event.CelebrationGranted),
+			string(event.ChatDropped),
+			string(event.CommunityBonusGranted),
+			string(event.JackpotWon),
 		})
 }
 
@@ -372,3 +389,130 @@ func getIntFromMap(m map[string]interface{}, key string) int {
 		return 0
 	}
 }
+
+// handleCelebration sends a DoAction when a birthday or anniversary is rewarded
+func (s *Subscriber) handleCelebration(_ context.Context, evt event.Event) error {
+	payload, err := event.DecodePayload[event.CelebrationPayloadV1](evt.Payload)
+	if err != nil {
+		slog.Warn("Invalid celebration event payload type", "error", err)
+		return nil
+	}
+
+	args := map[string]string{
+		"user_id":   payload.UserID,
+		"username":  payload.Username,
+		"kind":      payload.Kind,
+		"years":     fmt.Sprintf("%d", payload.Years),
+		"item_name": payload.ItemName,
+		"quantity":  fmt.Sprintf("%d", payload.Quantity),
+	}
+
+	slog.Debug(LogMsgEventReceived, "event_type", evt.Type, "args", args)
+
+	if err := s.client.DoAction(ActionCelebration, args); err != nil {
+		// Streamer.bot being offline is expected, use debug level
+		slog.Debug("Failed to send celebration to Streamer.bot", "error", err)
+	}
+
+	return nil
+}
+
+// handleChatDrop sends a DoAction announcing the Twitch chatters who got a chat drop
+func (s *Subscriber) handleChatDrop(_ context.Context, evt event.Event) error {
+	payload, err := event.DecodePayload[event.ChatDroppedPayloadV1](evt.Payload)
+	if err != nil {
+		slog.Warn("Invalid chat drop event payload type", "error", err)
+		return nil
+	}
+
+	args := map[string]string{}
+	winners := make([]string, 0, len(payload.Drops))
+	for _, drop := range payload.Drops {
+		if drop.Platform != "" && drop.Platform != domain.PlatformTwitch {
+			continue
+		}
+		idx := len(winners) + 1
+		args[fmt.Sprintf("username_%d", idx)] = drop.Username
+		args[fmt.Sprintf("item_name_%d", idx)] = drop.ItemName
+		args[fmt.Sprintf("quantity_%d", idx)] = fmt.Sprintf("%d", drop.Quantity)
+		winners = append(winners, fmt.Sprintf("%s (%dx %s)", drop.Username, drop.Quantity, drop.ItemName))
+	}
+	if len(winners) == 0 {
+		return nil
+	}
+	args["count"] = fmt.Sprintf("%d", len(winners))
+	args["winners"] = strings.Join(winners, ", ")
+	args["chatters"] = fmt.Sprintf("%d", payload.Chatters)
+
+	slog.Debug(LogMsgEventReceived, "event_type", evt.Type, "args", args)
+
+	if err := s.client.DoAction(ActionChatDrop, args); err != nil {
+		// Streamer.bot being offline is expected, use debug level
+		slog.Debug("Failed to send chat drop to Streamer.bot", "error", err)
+	}
+
+	return nil
+}
+
+// handleCommunityBonus sends a DoAction announcing the bonuses a Twitch raid
+// or host granted
+func (s *Subscriber) handleCommunityBonus(_ context.Context, evt event.Event) error {
+	payload, err := event.DecodePayload[event.CommunityBonusGrantedPayloadV1](evt.Payload)
+	if err != nil {
+		slog.Warn("Invalid community bonus event payload type", "error", err)
+		return nil
+	}
+	if payload.Platform != domain.PlatformTwitch {
+		return nil
+	}
+
+	bonuses := make([]string, 0, len(payload.Effects))
+	var expiresAt int64
+	for _, effect := range payload.Effects {
+		bonuses = append(bonuses, fmt.Sprintf("%s x%g", effect.Type, effect.Magnitude))
+		expiresAt = max(expiresAt, effect.ExpiresAt)
+	}
+
+	args := map[string]string{
+		"kind":               payload.Kind,
+		"from_channel":       payload.FromChannel,
+		"viewers":            fmt.Sprintf("%d", payload.Viewers),
+		"bonuses":            strings.Join(bonuses, ", "),
+		"bonus_contribution": fmt.Sprintf("%d", payload.BonusContribution),
+		"expires_at":         fmt.Sprintf("%d", expiresAt),
+	}
+
+	slog.Debug(LogMsgEventReceived, "event_type", evt.Type, "args", args)
+
+	if err := s.client.DoAction(ActionCommunityBonus, args); err != nil {
+		// Streamer.bot being offline is expected, use debug level
+		slog.Debug("Failed to send community bonus to Streamer.bot", "error", err)
+	}
+
+	return nil
+}
+
+// handleJackpotWon sends a DoAction announcing a progressive jackpot win
+func (s *Subscriber) handleJackpotWon(_ context.Context, evt event.Event) error {
+	payload, err := event.DecodePayload[event.JackpotWonPayloadV1](evt.Payload)
+	if err != nil {
+		slog.Warn("Invalid jackpot won event payload type", "error", err)
+		return nil
+	}
+
+	args := map[string]string{
+		"user_id":  payload.UserID,
+		"username": payload.Username,
+		"amount":   fmt.Sprintf("%d", payload.Amount),
+		"source":   payload.Source,
+	}
+
+	slog.Debug(LogMsgEventReceived, "event_type", evt.Type, "args", args)
+
+	if err := s.client.DoAction(ActionJackpotWon, args); err != nil {
+		// Streamer.bot being offline is expected, use debug level
+		slog.Debug("Failed to send jackpot win to Streamer.bot", "error", err)
+	}
+
+	return nil
+}
@@ -76,6 +76,10 @@ func TestSubscriber_Subscribe(t *testing.T) {
 		event.SubscriptionExpired,
 		event.SubscriptionCancelled,
 		event.Type(domain.EventTypeItemUsed),
+		event.CelebrationGranted,
+		event.ChatDropped,
+		event.CommunityBonusGranted,
+		event.JackpotWon,
 	}
 
 	assert.ElementsMatch(t, expectedSubscriptions, bus.subscribedTypes)
@@ -107,6 +111,9 @@ func TestSubscriber_Handlers_InvalidPayloads(t *testing.T) {
 		{"handleTimeoutUpdate", sub.handleTimeoutUpdate},
 		{"handleSubscriptionUpdate", sub.handleSubscriptionUpdate},
 		{"handleItemUsed", sub.handleItemUsed},
+		{"handleChatDrop", sub.handleChatDrop},
+		{"handleCommunityBonus", sub.handleCommunityBonus},
+		{"handleJackpotWon", sub.handleJackpotWon},
 	}
 
 	for _, h := range handlersToTest {
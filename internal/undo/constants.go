@@ -0,0 +1,8 @@
+package undo
+
+// Log messages
+const (
+	LogMsgUndoRecorded     = "Undo recorded"
+	LogMsgUndoRecordFailed = "Failed to record undo"
+	LogMsgUndone           = "Operation undone"
+)
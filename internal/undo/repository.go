@@ -0,0 +1,29 @@
+package undo
+
+import (
+	"context"
+	"time"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/repository"
+)
+
+// Repository stores undo entries
+type Repository interface {
+	BeginTx(ctx context.Context) (Tx, error)
+
+	// RecordUndo stores an entry and clears the user's expired entries
+	RecordUndo(ctx context.Context, entry domain.UndoEntry) error
+}
+
+// Tx reverses an entry's inventory changes and removes it in one transaction
+type Tx interface {
+	repository.Tx
+	repository.InventoryAdjuster
+
+	// GetLatestUndoForUpdate locks and returns the user's newest entry that
+	// has not expired by now, or nil if there is none
+	GetLatestUndoForUpdate(ctx context.Context, userID string, now time.Time) (*domain.UndoEntry, error)
+
+	DeleteUndo(ctx context.Context, id int64) error
+}
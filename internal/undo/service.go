@@ -0,0 +1,210 @@
+package undo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/logger"
+	"github.com/osse101/BrandishBot_Go/internal/repository"
+)
+
+// Service keeps a short-lived ledger of sells, disassembles and gives so a
+// user can reverse their latest one while its window lasts
+type Service interface {
+	// Record stores the inventory changes an operation made. Recording
+	// happens after the operation has committed, so a failure is logged
+	// rather than returned.
+	Record(ctx context.Context, userID string, action domain.UndoAction, changes []domain.InventoryChange)
+
+	// Undo reverses the user's latest operation that has not expired. It
+	// fails with domain.ErrUndoNoLongerValid when the items or money it
+	// would take back have since been spent, or domain.ErrInventoryFull when
+	// an item it would give back no longer fits, and leaves the entry in place.
+	// Taxes paid on the operation are not refunded.
+	Undo(ctx context.Context, platform, platformID string) (*domain.UndoEntry, error)
+}
+
+// UserLookup finds users by platform ID
+type UserLookup interface {
+	GetUserByPlatformID(ctx context.Context, platform, platformID string) (*domain.User, error)
+}
+
+// ItemLookup finds items by ID
+type ItemLookup interface {
+	GetItemsByIDs(ctx context.Context, itemIDs []int) ([]domain.Item, error)
+}
+
+// CooldownService rate limits undo
+type CooldownService interface {
+	EnforceCooldown(ctx context.Context, userID, action string, fn func() error) error
+}
+
+// CapacityChecker refuses credits that would overfill an inventory
+type CapacityChecker interface {
+	EnsureInventoryRoom(ctx context.Context, userID string, itemID int, qualityLevel domain.QualityLevel) error
+}
+
+// CapacityFunc adapts a function to CapacityChecker, for a user service that
+// is created after undo because it records into it
+type CapacityFunc func(ctx context.Context, userID string, itemID int, qualityLevel domain.QualityLevel) error
+
+// EnsureInventoryRoom calls f
+func (f CapacityFunc) EnsureInventoryRoom(ctx context.Context, userID string, itemID int, qualityLevel domain.QualityLevel) error {
+	return f(ctx, userID, itemID, qualityLevel)
+}
+
+// Option configures optional undo service dependencies
+type Option func(*service)
+
+// WithCapacity refuses undos that would give an item back to a full inventory
+func WithCapacity(capacity CapacityChecker) Option {
+	return func(s *service) {
+		s.capacity = capacity
+	}
+}
+
+type service struct {
+	repo      Repository
+	users     UserLookup
+	items     ItemLookup
+	cooldowns CooldownService
+	capacity  CapacityChecker // nil ignores slot limits
+	window    time.Duration
+	now       func() time.Time
+}
+
+// NewService creates an undo service. Operations can be undone for window
+// after they happen; a window of zero turns undo off.
+func NewService(repo Repository, users UserLookup, items ItemLookup, cooldowns CooldownService, window time.Duration, opts ...Option) Service {
+	s := &service{
+		repo:      repo,
+		users:     users,
+		items:     items,
+		cooldowns: cooldowns,
+		window:    window,
+		now:       time.Now,
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+// Record stores an entry that expires at the end of the window
+func (s *service) Record(ctx context.Context, userID string, action domain.UndoAction, changes []domain.InventoryChange) {
+	if s.window <= 0 || len(changes) == 0 {
+		return
+	}
+	now := s.now()
+	err := s.repo.RecordUndo(ctx, domain.UndoEntry{
+		UserID:    userID,
+		Action:    action,
+		Changes:   changes,
+		CreatedAt: now,
+		ExpiresAt: now.Add(s.window),
+	})
+	if err != nil {
+		logger.FromContext(ctx).Warn(LogMsgUndoRecordFailed, "user_id", userID, "action", action, "error", err)
+		return
+	}
+	logger.FromContext(ctx).Debug(LogMsgUndoRecorded, "user_id", userID, "action", action)
+}
+
+// Undo applies the latest entry's changes in reverse and deletes it
+func (s *service) Undo(ctx context.Context, platform, platformID string) (*domain.UndoEntry, error) {
+	user, err := s.users.GetUserByPlatformID(ctx, platform, platformID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user: %w", err)
+	}
+	if user == nil {
+		return nil, domain.ErrUserNotFound
+	}
+
+	var undone *domain.UndoEntry
+	err = s.cooldowns.EnforceCooldown(ctx, user.ID, domain.ActionUndo, func() error {
+		entry, err := s.undoLatest(ctx, user.ID)
+		undone = entry
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.nameItems(ctx, undone.Changes)
+	logger.FromContext(ctx).Info(LogMsgUndone, "user_id", user.ID, "action", undone.Action, "entry_id", undone.ID)
+	return undone, nil
+}
+
+func (s *service) undoLatest(ctx context.Context, userID string) (*domain.UndoEntry, error) {
+	tx, err := s.repo.BeginTx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer repository.SafeRollback(ctx, tx)
+
+	entry, err := tx.GetLatestUndoForUpdate(ctx, userID, s.now())
+	if err != nil {
+		return nil, err
+	}
+	if entry == nil {
+		return nil, domain.ErrNothingToUndo
+	}
+
+	// Adjust rows in user ID order so concurrent undos cannot deadlock
+	reversals := slices.Clone(entry.Changes)
+	slices.SortStableFunc(reversals, func(a, b domain.InventoryChange) int { return strings.Compare(a.UserID, b.UserID) })
+	for _, change := range reversals {
+		if err := s.ensureRoom(ctx, change); err != nil {
+			return nil, err
+		}
+		if _, err := tx.AdjustItemQuantity(ctx, change.UserID, change.ItemID, change.QualityLevel, -change.Delta); err != nil {
+			if errors.Is(err, domain.ErrInsufficientQuantity) {
+				return nil, fmt.Errorf("%w: the %s has been changed since", domain.ErrUndoNoLongerValid, entry.Action)
+			}
+			return nil, fmt.Errorf("failed to reverse inventory change: %w", err)
+		}
+	}
+
+	if err := tx.DeleteUndo(ctx, entry.ID); err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit undo: %w", err)
+	}
+	return entry, nil
+}
+
+// ensureRoom refuses a reversal that would give back an item its owner no
+// longer has a slot for
+func (s *service) ensureRoom(ctx context.Context, change domain.InventoryChange) error {
+	if s.capacity == nil || change.Delta >= 0 {
+		return nil
+	}
+	return s.capacity.EnsureInventoryRoom(ctx, change.UserID, change.ItemID, change.QualityLevel)
+}
+
+// nameItems fills in item names for the response. Names are cosmetic, so a
+// failed lookup leaves them blank.
+func (s *service) nameItems(ctx context.Context, changes []domain.InventoryChange) {
+	ids := make([]int, 0, len(changes))
+	for _, change := range changes {
+		ids = append(ids, change.ItemID)
+	}
+	items, err := s.items.GetItemsByIDs(ctx, ids)
+	if err != nil {
+		logger.FromContext(ctx).Warn("Failed to get undone items", "error", err)
+		return
+	}
+	names := make(map[int]string, len(items))
+	for _, item := range items {
+		names[item.ID] = item.InternalName
+	}
+	for i := range changes {
+		changes[i].ItemName = names[changes[i].ItemID]
+	}
+}
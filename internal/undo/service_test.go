@@ -0,0 +1,119 @@
+package undo_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/undo"
+	"github.com/osse101/BrandishBot_Go/internal/undo/mocks"
+)
+
+// noCooldown runs every action immediately
+type noCooldown struct{}
+
+func (noCooldown) EnforceCooldown(_ context.Context, _, _ string, fn func() error) error {
+	return fn()
+}
+
+func TestRecord(t *testing.T) {
+	ctx := context.Background()
+	changes := []domain.InventoryChange{{UserID: "user-1", ItemID: 7, Delta: -2}}
+
+	t.Run("stores an entry that expires after the window", func(t *testing.T) {
+		repo := mocks.NewMockRepository(t)
+		repo.On("RecordUndo", ctx, mock.MatchedBy(func(e domain.UndoEntry) bool {
+			return e.UserID == "user-1" && e.Action == domain.UndoActionSell &&
+				e.ExpiresAt.Sub(e.CreatedAt) == 5*time.Minute && len(e.Changes) == 1
+		})).Return(nil)
+
+		undo.NewService(repo, nil, nil, noCooldown{}, 5*time.Minute).Record(ctx, "user-1", domain.UndoActionSell, changes)
+	})
+
+	t.Run("a zero window records nothing", func(t *testing.T) {
+		repo := mocks.NewMockRepository(t)
+
+		undo.NewService(repo, nil, nil, noCooldown{}, 0).Record(ctx, "user-1", domain.UndoActionSell, changes)
+	})
+}
+
+func TestUndo(t *testing.T) {
+	ctx := context.Background()
+	entry := &domain.UndoEntry{
+		ID:     3,
+		UserID: "user-b",
+		Action: domain.UndoActionGive,
+		Changes: []domain.InventoryChange{
+			{UserID: "user-b", ItemID: 7, QualityLevel: domain.QualityCommon, Delta: -2},
+			{UserID: "user-a", ItemID: 7, QualityLevel: domain.QualityCommon, Delta: 2},
+		},
+	}
+
+	setup := func(t *testing.T, opts ...undo.Option) (*mocks.MockRepository, *mocks.MockTx, undo.Service) {
+		repo := mocks.NewMockRepository(t)
+		tx := mocks.NewMockTx(t)
+		users := mocks.NewMockUserLookup(t)
+		items := mocks.NewMockItemLookup(t)
+		users.On("GetUserByPlatformID", ctx, domain.PlatformDiscord, "d-1").Return(&domain.User{ID: "user-b"}, nil)
+		items.On("GetItemsByIDs", ctx, mock.Anything).Return([]domain.Item{{ID: 7, InternalName: domain.ItemLootbox1}}, nil).Maybe()
+		repo.On("BeginTx", ctx).Return(tx, nil)
+		tx.On("Rollback", ctx).Return(nil).Maybe()
+		return repo, tx, undo.NewService(repo, users, items, noCooldown{}, 5*time.Minute, opts...)
+	}
+
+	t.Run("reverses the changes and deletes the entry", func(t *testing.T) {
+		_, tx, svc := setup(t)
+		tx.On("GetLatestUndoForUpdate", ctx, "user-b", mock.Anything).Return(entry, nil)
+		reversed := tx.On("AdjustItemQuantity", ctx, "user-a", 7, domain.QualityCommon, -2).Return(0, nil)
+		tx.On("AdjustItemQuantity", ctx, "user-b", 7, domain.QualityCommon, 2).Return(2, nil).NotBefore(reversed)
+		tx.On("DeleteUndo", ctx, int64(3)).Return(nil)
+		tx.On("Commit", ctx).Return(nil)
+
+		undone, err := svc.Undo(ctx, domain.PlatformDiscord, "d-1")
+
+		require.NoError(t, err)
+		assert.Equal(t, domain.UndoActionGive, undone.Action)
+		assert.Equal(t, domain.ItemLootbox1, undone.Changes[0].ItemName)
+	})
+
+	t.Run("nothing to undo", func(t *testing.T) {
+		_, tx, svc := setup(t)
+		tx.On("GetLatestUndoForUpdate", ctx, "user-b", mock.Anything).Return(nil, nil)
+
+		_, err := svc.Undo(ctx, domain.PlatformDiscord, "d-1")
+
+		assert.ErrorIs(t, err, domain.ErrNothingToUndo)
+	})
+
+	t.Run("items already spent", func(t *testing.T) {
+		_, tx, svc := setup(t)
+		tx.On("GetLatestUndoForUpdate", ctx, "user-b", mock.Anything).Return(entry, nil)
+		tx.On("AdjustItemQuantity", ctx, "user-a", 7, domain.QualityCommon, -2).Return(0, domain.ErrInsufficientQuantity)
+
+		_, err := svc.Undo(ctx, domain.PlatformDiscord, "d-1")
+
+		assert.ErrorIs(t, err, domain.ErrUndoNoLongerValid)
+	})
+
+	t.Run("refuses to give back an item that no longer fits", func(t *testing.T) {
+		full := undo.CapacityFunc(func(_ context.Context, userID string, _ int, _ domain.QualityLevel) error {
+			if userID == "user-b" {
+				return domain.ErrInventoryFull
+			}
+			return nil
+		})
+		_, tx, svc := setup(t, undo.WithCapacity(full))
+		tx.On("GetLatestUndoForUpdate", ctx, "user-b", mock.Anything).Return(entry, nil)
+		tx.On("AdjustItemQuantity", ctx, "user-a", 7, domain.QualityCommon, -2).Return(0, nil)
+
+		_, err := svc.Undo(ctx, domain.PlatformDiscord, "d-1")
+
+		assert.ErrorIs(t, err, domain.ErrInventoryFull)
+		tx.AssertNotCalled(t, "DeleteUndo", mock.Anything, mock.Anything)
+	})
+}
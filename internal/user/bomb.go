@@ -83,8 +83,10 @@ func (s *service) detonateBomb(platform string) {
 			continue
 		}
 
-		// Apply timeout
-		if err := s.AddTimeout(ctx, platform, user.Username, activeBomb.Timeout, "Caught in a Bomb burst!"); err != nil {
+		// Apply timeout, unless the user is immune
+		if s.HasEffect(ctx, user.ID, domain.EffectTimeoutImmunity) {
+			log.Info("Bomb target is immune to timeouts", "username", user.Username)
+		} else if err := s.AddTimeout(ctx, platform, user.Username, activeBomb.Timeout, "Caught in a Bomb burst!"); err != nil {
 			log.Error("Failed to apply bomb timeout", "username", user.Username, "error", err)
 		} else {
 			hitUsernames = append(hitUsernames, user.Username)
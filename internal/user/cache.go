@@ -60,12 +60,18 @@ func newUserCache(config CacheConfig) *userCache {
 	return c
 }
 
+// cacheKey identifies a platform account within a community. The same account
+// is a different user in each community it plays in.
+func cacheKey(communityID, platform, platformID string) string {
+	return communityID + ":" + platform + ":" + platformID
+}
+
 // Get retrieves a user from the cache.
 // Returns (user, true) if found and version matches.
 // Returns (nil, false) if not in cache, expired, or version mismatch.
 // Automatically invalidates entries with mismatched versions.
-func (c *userCache) Get(platform, platformID string) (*domain.User, bool) {
-	key := platform + ":" + platformID
+func (c *userCache) Get(communityID, platform, platformID string) (*domain.User, bool) {
+	key := cacheKey(communityID, platform, platformID)
 	entry, found := c.lru.Get(key)
 	if !found {
 		c.misses.Add(1)
@@ -84,8 +90,8 @@ func (c *userCache) Get(platform, platformID string) (*domain.User, bool) {
 }
 
 // Set stores a user in the cache with current schema version.
-func (c *userCache) Set(platform, platformID string, user *domain.User) {
-	key := platform + ":" + platformID
+func (c *userCache) Set(communityID, platform, platformID string, user *domain.User) {
+	key := cacheKey(communityID, platform, platformID)
 	entry := &cachedUserEntry{
 		Version:  domain.CacheSchemaVersion,
 		User:     user,
@@ -96,8 +102,8 @@ func (c *userCache) Set(platform, platformID string, user *domain.User) {
 
 // Invalidate removes a user from the cache.
 // Useful when user data is updated.
-func (c *userCache) Invalidate(platform, platformID string) {
-	key := platform + ":" + platformID
+func (c *userCache) Invalidate(communityID, platform, platformID string) {
+	key := cacheKey(communityID, platform, platformID)
 	c.lru.Remove(key)
 }
 
@@ -6,6 +6,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 
+	"github.com/osse101/BrandishBot_Go/internal/community"
 	"github.com/osse101/BrandishBot_Go/internal/domain"
 )
 
@@ -21,22 +22,34 @@ func TestCacheInvalidation(t *testing.T) {
 	}
 
 	// 1. Set user in cache
-	cache.Set(domain.PlatformTwitch, "twitch-123", user)
+	cache.Set(community.Default, domain.PlatformTwitch, "twitch-123", user)
 
 	// 2. Verify retrieval
-	retrieved, found := cache.Get(domain.PlatformTwitch, "twitch-123")
+	retrieved, found := cache.Get(community.Default, domain.PlatformTwitch, "twitch-123")
 	assert.True(t, found)
 	assert.Equal(t, user, retrieved)
 
 	// 3. Invalidate
-	cache.Invalidate(domain.PlatformTwitch, "twitch-123")
+	cache.Invalidate(community.Default, domain.PlatformTwitch, "twitch-123")
 
 	// 4. Verify miss
-	retrieved, found = cache.Get(domain.PlatformTwitch, "twitch-123")
+	retrieved, found = cache.Get(community.Default, domain.PlatformTwitch, "twitch-123")
 	assert.False(t, found)
 	assert.Nil(t, retrieved)
 }
 
+func TestCacheSeparatesCommunities(t *testing.T) {
+	cache := newUserCache(CacheConfig{Size: 10, TTL: 1 * time.Minute})
+	user := &domain.User{ID: "user-1", TwitchID: "twitch-123"}
+
+	cache.Set(community.Default, domain.PlatformTwitch, "twitch-123", user)
+
+	_, found := cache.Get("alpha", domain.PlatformTwitch, "twitch-123")
+	assert.False(t, found, "the same account is a different user in another community")
+	_, found = cache.Get(community.Default, domain.PlatformTwitch, "twitch-123")
+	assert.True(t, found)
+}
+
 func TestCacheStats(t *testing.T) {
 	config := CacheConfig{Size: 10, TTL: 1 * time.Minute}
 	cache := newUserCache(config)
@@ -53,14 +66,14 @@ func TestCacheStats(t *testing.T) {
 	assert.Equal(t, 0, stats.Size)
 
 	// Miss
-	cache.Get("platform", "id")
+	cache.Get(community.Default, "platform", "id")
 	stats = cache.GetStats()
 	assert.Equal(t, int64(0), stats.Hits)
 	assert.Equal(t, int64(1), stats.Misses)
 
 	// Set and Hit
-	cache.Set("platform", "id", user)
-	cache.Get("platform", "id")
+	cache.Set(community.Default, "platform", "id", user)
+	cache.Get(community.Default, "platform", "id")
 	stats = cache.GetStats()
 	assert.Equal(t, int64(1), stats.Hits)
 	assert.Equal(t, int64(1), stats.Misses)
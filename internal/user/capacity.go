@@ -0,0 +1,193 @@
+package user
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/osse101/BrandishBot_Go/internal/database"
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/logger"
+	"github.com/osse101/BrandishBot_Go/internal/utils"
+)
+
+// featureInventorySlotLimit is the progression modifier the stash upgrades
+// add slots to
+const featureInventorySlotLimit = "inventory_slot_limit"
+
+// msgInventoryOverflowFmt is appended to an item use whose results did not fit
+const msgInventoryOverflowFmt = "Your inventory is full, so %d new item slot(s) were sold for %d money."
+
+// slotLimit returns how many inventory slots a user may fill, 0 for no limit.
+// Stash upgrades raise the configured base for everyone.
+func (s *service) slotLimit(ctx context.Context) int {
+	if s.baseSlotLimit <= 0 {
+		return 0
+	}
+	if s.progressionSvc == nil {
+		return s.baseSlotLimit
+	}
+	limit, err := s.progressionSvc.GetModifiedValue(ctx, "", featureInventorySlotLimit, float64(s.baseSlotLimit))
+	if err != nil {
+		logger.FromContext(ctx).Warn("Failed to get inventory slot limit modifier", "error", err)
+		return s.baseSlotLimit
+	}
+	return int(limit)
+}
+
+// moneyItemID returns the ID of the money item, which never takes a slot
+func (s *service) moneyItemID(ctx context.Context) (int, error) {
+	money, err := s.getItemByNameCached(ctx, domain.ItemMoney)
+	if err != nil {
+		return 0, domain.ErrFailedToGetItem
+	}
+	if money == nil {
+		return 0, domain.ErrItemNotFound
+	}
+	return money.ID, nil
+}
+
+// usedSlots counts the inventory's filled slots other than money
+func usedSlots(inventory *domain.Inventory, moneyID int) int {
+	used := 0
+	for _, slot := range inventory.Slots {
+		if slot.Quantity > 0 && slot.ItemID != moneyID {
+			used++
+		}
+	}
+	return used
+}
+
+// ensureSlotFor returns domain.ErrInventoryFull when adding the item would
+// open a new slot in an inventory that is already at its limit
+func (s *service) ensureSlotFor(ctx context.Context, inventory *domain.Inventory, itemID int, qualityLevel domain.QualityLevel) error {
+	limit := s.slotLimit(ctx)
+	if limit == 0 {
+		return nil
+	}
+	moneyID, err := s.moneyItemID(ctx)
+	if err != nil {
+		return err
+	}
+	if itemID == moneyID {
+		return nil
+	}
+	if i, _ := utils.FindSlotWithQuality(inventory, itemID, qualityLevel); i != -1 {
+		return nil
+	}
+	if usedSlots(inventory, moneyID) >= limit {
+		return domain.ErrInventoryFull
+	}
+	return nil
+}
+
+// settleOverflow sells any slots opened since before was taken that leave
+// the inventory past its limit, newest first, for their base value. It
+// returns how many slots were sold and the money credited in their place.
+func (s *service) settleOverflow(ctx context.Context, before map[utils.SlotKey]int, inventory *domain.Inventory) (int, int, error) {
+	limit := s.slotLimit(ctx)
+	if limit == 0 {
+		return 0, 0, nil
+	}
+	moneyID, err := s.moneyItemID(ctx)
+	if err != nil {
+		return 0, 0, err
+	}
+	excess := usedSlots(inventory, moneyID) - limit
+	if excess <= 0 {
+		return 0, 0, nil
+	}
+
+	itemMap, err := s.ensureItemsInCache(ctx, inventory)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	sold := make(map[int]bool, excess)
+	money := 0
+	for i := len(inventory.Slots) - 1; i >= 0 && len(sold) < excess; i-- {
+		slot := inventory.Slots[i]
+		if slot.ItemID == moneyID || slot.Quantity <= 0 {
+			continue
+		}
+		if _, existed := before[utils.SlotKey{ItemID: slot.ItemID, QualityLevel: slot.QualityLevel}]; existed {
+			continue
+		}
+		sold[i] = true
+		money += itemMap[slot.ItemID].BaseValue * slot.Quantity
+	}
+	if len(sold) == 0 {
+		return 0, 0, nil
+	}
+
+	kept := make([]domain.InventorySlot, 0, len(inventory.Slots)-len(sold))
+	for i, slot := range inventory.Slots {
+		if !sold[i] {
+			kept = append(kept, slot)
+		}
+	}
+	inventory.Slots = kept
+	if money > 0 {
+		if _, err := utils.AdjustSlotQuantity(inventory, moneyID, domain.QualityCommon, money); err != nil {
+			return 0, 0, fmt.Errorf("failed to credit overflow money: %w", err)
+		}
+	}
+
+	logger.FromContext(ctx).Info("Inventory overflow sold", "slots", len(sold), "money", money, "limit", limit)
+	return len(sold), money, nil
+}
+
+// EnsureInventoryRoom checks ensureSlotFor against the user's current
+// inventory, for services that credit items through their own transactions.
+// The read is not locked, so a concurrent credit can still take the last slot.
+func (s *service) EnsureInventoryRoom(ctx context.Context, userID string, itemID int, qualityLevel domain.QualityLevel) error {
+	if s.slotLimit(ctx) == 0 {
+		return nil
+	}
+	inventory, err := s.repo.GetInventory(database.WithPrimaryReads(ctx), userID)
+	if err != nil {
+		logger.FromContext(ctx).Error("Failed to get inventory", "error", err, "userID", userID)
+		return domain.ErrFailedToGetInventory
+	}
+	return s.ensureSlotFor(ctx, inventory, itemID, qualityLevel)
+}
+
+// SettleInventoryOverflow sells the slots opened since before was taken that
+// leave the inventory past its limit, for services that credit items by
+// writing the whole inventory in their own transactions. It returns how many
+// slots were sold and the money credited in their place.
+func (s *service) SettleInventoryOverflow(ctx context.Context, before map[utils.SlotKey]int, inventory *domain.Inventory) (int, int, error) {
+	return s.settleOverflow(ctx, before, inventory)
+}
+
+// inventoryCapacityInternal reports the user's filled and available slots
+func (s *service) inventoryCapacityInternal(ctx context.Context, user *domain.User) (*domain.InventoryCapacity, error) {
+	log := logger.FromContext(ctx)
+
+	inventory, err := s.repo.GetInventory(ctx, user.ID)
+	if err != nil {
+		log.Error("Failed to get inventory", "error", err, "userID", user.ID)
+		return nil, domain.ErrFailedToGetInventory
+	}
+	moneyID, err := s.moneyItemID(ctx)
+	if err != nil {
+		log.Error("Failed to get money item", "error", err)
+		return nil, err
+	}
+
+	return &domain.InventoryCapacity{
+		UsedSlots: usedSlots(inventory, moneyID),
+		MaxSlots:  s.slotLimit(ctx),
+	}, nil
+}
+
+// GetInventoryCapacity reports how many inventory slots the user has filled
+// and how many they may fill
+func (s *service) GetInventoryCapacity(ctx context.Context, platform, platformID, username string) (*domain.InventoryCapacity, error) {
+	user, err := s.getUserOrRegister(ctx, platform, platformID, username)
+	if err != nil {
+		logger.FromContext(ctx).Error("Failed to get user or register", "error", err)
+		return nil, domain.ErrFailedToGetUser
+	}
+
+	return s.inventoryCapacityInternal(ctx, user)
+}
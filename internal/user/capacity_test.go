@@ -0,0 +1,157 @@
+package user
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+)
+
+// stashProgression adds a fixed number of stash slots to the slot limit
+type stashProgression struct {
+	bonus float64
+}
+
+func (p stashProgression) GetModifiedValue(_ context.Context, _ string, featureKey string, baseValue float64) (float64, error) {
+	if featureKey == featureInventorySlotLimit {
+		return baseValue + p.bonus, nil
+	}
+	return baseValue, nil
+}
+
+func TestSlotLimit_AddItem(t *testing.T) {
+	repo := NewFakeRepository()
+	setupTestData(repo)
+	svc := NewService(repo, repo, nil, nil, nil, NewMockNamingResolver(), nil, nil, nil, nil, false, WithSlotLimit(1))
+	ctx := context.Background()
+
+	require.NoError(t, svc.AddItemByUsername(ctx, domain.PlatformTwitch, "alice", domain.ItemLootbox1, 1))
+
+	t.Run("new slot past the limit is refused", func(t *testing.T) {
+		err := svc.AddItemByUsername(ctx, domain.PlatformTwitch, "alice", domain.ItemLootbox2, 1)
+		assert.ErrorIs(t, err, domain.ErrInventoryFull)
+		assert.Zero(t, inventoryQuantity(t, repo, "user-alice", domain.ItemLootbox2))
+	})
+
+	t.Run("existing slot still stacks", func(t *testing.T) {
+		require.NoError(t, svc.AddItemByUsername(ctx, domain.PlatformTwitch, "alice", domain.ItemLootbox1, 4))
+		assert.Equal(t, 5, inventoryQuantity(t, repo, "user-alice", domain.ItemLootbox1))
+	})
+
+	t.Run("money takes no slot", func(t *testing.T) {
+		require.NoError(t, svc.AddItemByUsername(ctx, domain.PlatformTwitch, "alice", domain.ItemMoney, 100))
+		assert.Equal(t, 100, inventoryQuantity(t, repo, "user-alice", domain.ItemMoney))
+	})
+}
+
+func TestSlotLimit_GiveItem(t *testing.T) {
+	repo := NewFakeRepository()
+	setupTestData(repo)
+	svc := NewService(repo, repo, nil, nil, nil, NewMockNamingResolver(), nil, nil, nil, nil, false, WithSlotLimit(1))
+	ctx := context.Background()
+
+	require.NoError(t, svc.AddItemByUsername(ctx, domain.PlatformTwitch, "alice", domain.ItemLootbox1, 5))
+	require.NoError(t, svc.AddItemByUsername(ctx, domain.PlatformTwitch, "bob", domain.ItemLootbox2, 1))
+
+	err := svc.GiveItem(ctx, domain.PlatformTwitch, "alice123", "alice", domain.PlatformTwitch, "bob", domain.ItemLootbox1, 2)
+
+	assert.ErrorIs(t, err, domain.ErrInventoryFull)
+	assert.Equal(t, 5, inventoryQuantity(t, repo, "user-alice", domain.ItemLootbox1))
+	assert.Zero(t, inventoryQuantity(t, repo, "user-bob", domain.ItemLootbox1))
+}
+
+func TestSlotLimit_AddItemsOverflow(t *testing.T) {
+	repo := NewFakeRepository()
+	setupTestData(repo)
+	svc := NewService(repo, repo, nil, nil, nil, NewMockNamingResolver(), nil, nil, nil, nil, false, WithSlotLimit(1)).(*service)
+	ctx := context.Background()
+
+	require.NoError(t, svc.AddItemByUsername(ctx, domain.PlatformTwitch, "alice", domain.ItemLootbox1, 1))
+
+	err := svc.AddItems(ctx, domain.PlatformTwitch, "alice123", "alice", map[string]int{
+		domain.ItemLootbox1: 2,
+		domain.ItemLootbox2: 3,
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, 3, inventoryQuantity(t, repo, "user-alice", domain.ItemLootbox1))
+	assert.Zero(t, inventoryQuantity(t, repo, "user-alice", domain.ItemLootbox2))
+	// Three goldboxes sold at a base value of 100
+	assert.Equal(t, 300, inventoryQuantity(t, repo, "user-alice", domain.ItemMoney))
+}
+
+func TestSlotLimit_GrantItemRewardOverflow(t *testing.T) {
+	repo := NewFakeRepository()
+	setupTestData(repo)
+	svc := NewService(repo, repo, nil, nil, nil, NewMockNamingResolver(), nil, nil, nil, nil, false, WithSlotLimit(1))
+	ctx := context.Background()
+
+	require.NoError(t, svc.AddItemByUsername(ctx, domain.PlatformTwitch, "alice", domain.ItemLootbox1, 1))
+	alice, err := repo.GetUserByPlatformID(ctx, domain.PlatformTwitch, "alice123")
+	require.NoError(t, err)
+
+	err = svc.GrantItemReward(ctx, alice, repo.items[domain.ItemLootbox2], 2, domain.QualityCommon)
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, inventoryQuantity(t, repo, "user-alice", domain.ItemLootbox1))
+	assert.Zero(t, inventoryQuantity(t, repo, "user-alice", domain.ItemLootbox2))
+	// Two goldboxes sold at a base value of 100
+	assert.Equal(t, 200, inventoryQuantity(t, repo, "user-alice", domain.ItemMoney))
+}
+
+func TestEnsureInventoryRoom(t *testing.T) {
+	repo := NewFakeRepository()
+	setupTestData(repo)
+	svc := NewService(repo, repo, nil, nil, nil, NewMockNamingResolver(), nil, nil, nil, nil, false, WithSlotLimit(1))
+	ctx := context.Background()
+
+	require.NoError(t, svc.AddItemByUsername(ctx, domain.PlatformTwitch, "alice", domain.ItemLootbox1, 1))
+	inv, err := repo.GetInventory(ctx, "user-alice")
+	require.NoError(t, err)
+	held := inv.Slots[0]
+
+	t.Run("existing slot has room", func(t *testing.T) {
+		assert.NoError(t, svc.EnsureInventoryRoom(ctx, "user-alice", held.ItemID, held.QualityLevel))
+	})
+
+	t.Run("new slot past the limit is refused", func(t *testing.T) {
+		err := svc.EnsureInventoryRoom(ctx, "user-alice", repo.items[domain.ItemLootbox2].ID, held.QualityLevel)
+		assert.ErrorIs(t, err, domain.ErrInventoryFull)
+	})
+
+	t.Run("money takes no slot", func(t *testing.T) {
+		assert.NoError(t, svc.EnsureInventoryRoom(ctx, "user-alice", repo.items[domain.ItemMoney].ID, domain.QualityCommon))
+	})
+}
+
+func TestGetInventoryCapacity(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("stash upgrades raise the limit", func(t *testing.T) {
+		repo := NewFakeRepository()
+		setupTestData(repo)
+		svc := NewService(repo, repo, nil, nil, nil, NewMockNamingResolver(), nil, stashProgression{bonus: 10}, nil, nil, false, WithSlotLimit(50))
+
+		require.NoError(t, svc.AddItemByUsername(ctx, domain.PlatformTwitch, "alice", domain.ItemLootbox1, 2))
+		require.NoError(t, svc.AddItemByUsername(ctx, domain.PlatformTwitch, "alice", domain.ItemMoney, 100))
+
+		capacity, err := svc.GetInventoryCapacity(ctx, domain.PlatformTwitch, "alice123", "alice")
+
+		require.NoError(t, err)
+		assert.Equal(t, &domain.InventoryCapacity{UsedSlots: 1, MaxSlots: 60}, capacity)
+	})
+
+	t.Run("no limit configured", func(t *testing.T) {
+		repo := NewFakeRepository()
+		setupTestData(repo)
+		svc := NewService(repo, repo, nil, nil, nil, NewMockNamingResolver(), nil, stashProgression{bonus: 10}, nil, nil, false)
+
+		capacity, err := svc.GetInventoryCapacity(ctx, domain.PlatformTwitch, "alice123", "alice")
+
+		require.NoError(t, err)
+		assert.Zero(t, capacity.MaxSlots)
+	})
+}
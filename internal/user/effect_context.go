@@ -2,6 +2,7 @@ package user
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	"github.com/google/uuid"
@@ -9,14 +10,20 @@ import (
 	"github.com/osse101/BrandishBot_Go/internal/domain"
 	"github.com/osse101/BrandishBot_Go/internal/event"
 	"github.com/osse101/BrandishBot_Go/internal/itemhandler"
+	"github.com/osse101/BrandishBot_Go/internal/logger"
 	"github.com/osse101/BrandishBot_Go/internal/lootbox"
+	"github.com/osse101/BrandishBot_Go/internal/naming"
 )
 
 // Compile-time check: service implements itemhandler.EffectContext
 var _ itemhandler.EffectContext = (*service)(nil)
 
-// GetDisplayName returns a display name for an item with quality prefix.
-func (s *service) GetDisplayName(itemName string, quality domain.QualityLevel) string {
+// GetDisplayName returns a display name for an item with quality prefix, in
+// the theme the acting user picked if any.
+func (s *service) GetDisplayName(ctx context.Context, itemName string, quality domain.QualityLevel) string {
+	if theme := naming.ThemeFromContext(ctx); theme != "" {
+		return s.namingResolver.GetDisplayNameForTheme(itemName, quality, theme)
+	}
 	return s.namingResolver.GetDisplayName(itemName, quality)
 }
 
@@ -25,11 +32,6 @@ func (s *service) Pluralize(name string, quantity int) string {
 	return itemhandler.Pluralize(name, quantity)
 }
 
-// GetRandomTarget returns a single random active chatter.
-func (s *service) GetRandomTarget(platform string) (username, userID string, err error) {
-	return s.activeChatterTracker.GetRandomTarget(platform)
-}
-
 // GetRandomTargets returns multiple random active chatters.
 func (s *service) GetRandomTargets(platform string, count int) ([]itemhandler.ActiveTarget, error) {
 	targets, err := s.activeChatterTracker.GetRandomTargets(platform, count)
@@ -51,6 +53,61 @@ func (s *service) RemoveActiveChatter(platform, userID string) {
 	s.activeChatterTracker.Remove(platform, userID)
 }
 
+// IsTargetingOptedOut reports whether the user refuses targeted items.
+func (s *service) IsTargetingOptedOut(ctx context.Context, userID string) (bool, error) {
+	if s.settings == nil || userID == "" {
+		return false, nil
+	}
+	settings, err := s.settings.GetSettings(ctx, userID)
+	if err != nil {
+		return false, err
+	}
+	return settings.TargetingOptOut, nil
+}
+
+// withNameTheme scopes ctx to the item name theme the user picked, so the
+// names in their messages use it.
+func (s *service) withNameTheme(ctx context.Context, userID string) context.Context {
+	if s.settings == nil || userID == "" {
+		return ctx
+	}
+	settings, err := s.settings.GetSettings(ctx, userID)
+	if err != nil {
+		logger.FromContext(ctx).Warn("Failed to read name theme, using the dated theme", "error", err, "userID", userID)
+		return ctx
+	}
+	if settings.NameTheme == "" {
+		return ctx
+	}
+	return naming.WithTheme(ctx, settings.NameTheme)
+}
+
+// GrantEffect gives a user a timed effect from an item.
+func (s *service) GrantEffect(ctx context.Context, userID string, effectType domain.EffectType, magnitude float64, duration time.Duration) (*domain.Effect, error) {
+	if s.effects == nil {
+		return nil, fmt.Errorf("%w: effects are not available", domain.ErrInvalidInput)
+	}
+	return s.effects.Grant(ctx, userID, effectType, magnitude, duration)
+}
+
+// HasEffect reports whether a user has an active effect of the type.
+func (s *service) HasEffect(ctx context.Context, userID string, effectType domain.EffectType) bool {
+	if s.effects == nil || userID == "" {
+		return false
+	}
+	return s.effects.IsActive(ctx, userID, effectType)
+}
+
+// PublishTargetedEffect publishes the attacker and defender events for an item used on another user.
+func (s *service) PublishTargetedEffect(ctx context.Context, effect domain.TargetedEffect) {
+	if s.publisher == nil {
+		return
+	}
+	attacked, defended := event.NewTargetedItemEvents(effect)
+	s.publisher.PublishWithRetry(ctx, attacked)
+	s.publisher.PublishWithRetry(ctx, defended)
+}
+
 // GetItemByName returns an item by its internal name.
 func (s *service) GetItemByName(ctx context.Context, name string) (*domain.Item, error) {
 	return s.getItemByNameCached(ctx, name)
@@ -82,8 +139,8 @@ func (s *service) PublishItemUsedEvent(ctx context.Context, userID, itemName str
 }
 
 // OpenLootbox opens a lootbox via the lootbox service.
-func (s *service) OpenLootbox(ctx context.Context, lootboxName string, quantity int, boxQuality domain.QualityLevel) ([]lootbox.DroppedItem, error) {
-	return s.lootboxService.OpenLootbox(ctx, lootboxName, quantity, boxQuality)
+func (s *service) OpenLootbox(ctx context.Context, userID, lootboxName string, quantity int, boxQuality domain.QualityLevel) ([]lootbox.DroppedItem, error) {
+	return s.lootboxService.OpenLootbox(ctx, userID, lootboxName, quantity, boxQuality)
 }
 
 // GetUserByPlatformUsername is already declared in registration.go
@@ -9,6 +9,7 @@ import (
 
 	"github.com/osse101/BrandishBot_Go/internal/domain"
 	"github.com/osse101/BrandishBot_Go/internal/repository"
+	"github.com/osse101/BrandishBot_Go/internal/utils"
 )
 
 // FakeRepository is a stateful "fake" implementation of Repository for testing.
@@ -25,6 +26,7 @@ type FakeRepository struct {
 	unlockedRecipes map[string]map[int]bool
 	cooldowns       map[string]map[string]*time.Time // userID -> action -> timestamp
 	traps           map[uuid.UUID]*domain.Trap
+	communityPool   int64
 }
 
 func NewFakeRepository() *FakeRepository {
@@ -202,6 +204,23 @@ func (mt *MockTx) UpdateInventory(ctx context.Context, userID string, inventory
 	return mt.repo.UpdateInventory(ctx, userID, inventory)
 }
 
+func (mt *MockTx) AdjustItemQuantity(ctx context.Context, userID string, itemID int, quality domain.QualityLevel, delta int) (int, error) {
+	inventory, err := mt.repo.GetInventory(ctx, userID)
+	if err != nil {
+		return 0, err
+	}
+	qty, err := utils.AdjustSlotQuantity(inventory, itemID, quality, delta)
+	if err != nil {
+		return 0, err
+	}
+	return qty, mt.repo.UpdateInventory(ctx, userID, *inventory)
+}
+
+func (mt *MockTx) AddToCommunityPool(ctx context.Context, amount int) error {
+	mt.repo.communityPool += int64(amount)
+	return nil
+}
+
 func (mt *MockTx) Commit(ctx context.Context) error {
 	return nil // No-op for mock
 }
@@ -11,7 +11,9 @@ import (
 
 	"github.com/osse101/BrandishBot_Go/internal/domain"
 	"github.com/osse101/BrandishBot_Go/internal/lootbox"
+	"github.com/osse101/BrandishBot_Go/internal/naming"
 	"github.com/osse101/BrandishBot_Go/internal/repository"
+	"github.com/osse101/BrandishBot_Go/internal/utils"
 )
 
 func init() {
@@ -197,6 +199,22 @@ func (f *fakeBenchTx) UpdateInventory(ctx context.Context, userID string, invent
 	return f.repo.UpdateInventory(ctx, userID, inventory)
 }
 
+func (f *fakeBenchTx) AdjustItemQuantity(ctx context.Context, userID string, itemID int, quality domain.QualityLevel, delta int) (int, error) {
+	inventory, err := f.repo.GetInventory(ctx, userID)
+	if err != nil {
+		return 0, err
+	}
+	qty, err := utils.AdjustSlotQuantity(inventory, itemID, quality, delta)
+	if err != nil {
+		return 0, err
+	}
+	return qty, f.repo.UpdateInventory(ctx, userID, *inventory)
+}
+
+func (f *fakeBenchTx) AddToCommunityPool(ctx context.Context, amount int) error {
+	return nil
+}
+
 func (f *fakeBenchTx) Commit(ctx context.Context) error {
 	return nil
 }
@@ -220,11 +238,15 @@ func (f *fakeBenchStatsService) GetUserCurrentStreak(ctx context.Context, userID
 	return 0, nil
 }
 
+func (f *fakeBenchStatsService) GetUserRecipeCraftCounts(ctx context.Context, userID string) (map[string]int, error) {
+	return nil, nil
+}
+
 func (f *fakeBenchStatsService) GetSystemStats(ctx context.Context, period string) (*domain.StatsSummary, error) {
 	return nil, nil
 }
 
-func (f *fakeBenchStatsService) GetLeaderboard(ctx context.Context, eventType domain.EventType, period string, limit int) ([]domain.LeaderboardEntry, error) {
+func (f *fakeBenchStatsService) GetLeaderboard(ctx context.Context, query domain.LeaderboardQuery) (*domain.LeaderboardPage, error) {
 	return nil, nil
 }
 
@@ -244,15 +266,27 @@ func (f *fakeBenchStatsService) GetSlotsLeaderboardByMegaJackpots(ctx context.Co
 	return nil, nil
 }
 
+func (f *fakeBenchStatsService) QueryStats(ctx context.Context, query domain.StatsQuery) (*domain.StatsQueryResult, error) {
+	return nil, nil
+}
+
+func (f *fakeBenchStatsService) RollUpDue(ctx context.Context) (int, error) {
+	return 0, nil
+}
+
 // Mock lootbox service
 type fakeBenchLootboxService struct{}
 
-func (f *fakeBenchLootboxService) OpenLootbox(ctx context.Context, lootboxName string, quantity int, boxQuality domain.QualityLevel) ([]lootbox.DroppedItem, error) {
+func (f *fakeBenchLootboxService) OpenLootbox(ctx context.Context, userID, lootboxName string, quantity int, boxQuality domain.QualityLevel) ([]lootbox.DroppedItem, error) {
 	return []lootbox.DroppedItem{
 		{ItemID: 1, ItemName: "money", Quantity: 10, Value: 10, QualityLevel: domain.QualityCommon},
 	}, nil
 }
 
+func (f *fakeBenchLootboxService) Reload(ctx context.Context) error {
+	return nil
+}
+
 // Mock naming resolver
 type fakeBenchNamingResolver struct{}
 
@@ -268,10 +302,22 @@ func (f *fakeBenchNamingResolver) GetDisplayName(internalName string, qualityLev
 	return internalName
 }
 
+func (f *fakeBenchNamingResolver) GetDisplayNameForTheme(internalName string, qualityLevel domain.QualityLevel, theme string) string {
+	return internalName
+}
+
 func (f *fakeBenchNamingResolver) GetActiveTheme() string {
 	return ""
 }
 
+func (f *fakeBenchNamingResolver) GetThemes() map[string]naming.ThemePeriod {
+	return nil
+}
+
+func (f *fakeBenchNamingResolver) SuggestNames(name string) []string {
+	return nil
+}
+
 func (f *fakeBenchNamingResolver) Reload() error {
 	return nil
 }
@@ -299,6 +345,10 @@ func (f *fakeBenchCooldownService) GetLastUsed(ctx context.Context, userID, acti
 	return nil, nil
 }
 
+func (f *fakeBenchCooldownService) GetCooldowns(ctx context.Context, userID string) ([]domain.CooldownTimer, error) {
+	return nil, nil
+}
+
 // BenchmarkService_HandleIncomingMessage benchmarks user lookup/creation
 func BenchmarkService_HandleIncomingMessage(b *testing.B) {
 	repo := &fakeBenchRepository{}
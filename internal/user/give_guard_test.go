@@ -0,0 +1,55 @@
+package user
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+)
+
+// fakeGiveGuard refuses gives with err and keeps the gives it is told about
+type fakeGiveGuard struct {
+	err      error
+	recorded []string
+}
+
+func (f *fakeGiveGuard) CheckGive(_ context.Context, _ string) error {
+	return f.err
+}
+
+func (f *fakeGiveGuard) RecordGive(_ context.Context, giverID, receiverID string, item *domain.Item, _ int) {
+	f.recorded = append(f.recorded, giverID+">"+receiverID+":"+item.InternalName)
+}
+
+func TestGiveItem_GiveGuard(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("records a completed give", func(t *testing.T) {
+		repo := NewFakeRepository()
+		setupTestData(repo)
+		guard := &fakeGiveGuard{}
+		svc := NewService(repo, repo, nil, nil, nil, NewMockNamingResolver(), nil, nil, nil, nil, false, WithGiveGuard(guard))
+
+		require.NoError(t, svc.AddItemByUsername(ctx, domain.PlatformTwitch, "alice", domain.ItemLootbox1, 3))
+		require.NoError(t, svc.GiveItem(ctx, domain.PlatformTwitch, "alice123", "alice", domain.PlatformTwitch, "bob", domain.ItemLootbox1, 1))
+
+		assert.Equal(t, []string{"user-alice>user-bob:" + domain.ItemLootbox1}, guard.recorded)
+	})
+
+	t.Run("a refused give moves nothing", func(t *testing.T) {
+		repo := NewFakeRepository()
+		setupTestData(repo)
+		guard := &fakeGiveGuard{err: domain.ErrDailyGiveLimitReached}
+		svc := NewService(repo, repo, nil, nil, nil, NewMockNamingResolver(), nil, nil, nil, nil, false, WithGiveGuard(guard))
+
+		require.NoError(t, svc.AddItemByUsername(ctx, domain.PlatformTwitch, "alice", domain.ItemLootbox1, 3))
+		err := svc.GiveItem(ctx, domain.PlatformTwitch, "alice123", "alice", domain.PlatformTwitch, "bob", domain.ItemLootbox1, 1)
+
+		assert.ErrorIs(t, err, domain.ErrDailyGiveLimitReached)
+		assert.Equal(t, 3, inventoryQuantity(t, repo, "user-alice", domain.ItemLootbox1))
+		assert.Empty(t, guard.recorded)
+	})
+}
@@ -6,6 +6,8 @@ import (
 
 	"github.com/osse101/BrandishBot_Go/internal/activechatter"
 	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/itemhandler"
+	"github.com/osse101/BrandishBot_Go/internal/utils"
 )
 
 // InventoryItem represents an item in a user's inventory with display information
@@ -14,13 +16,22 @@ type InventoryItem struct {
 	PublicName   string `json:"public_name"`
 	Quantity     int    `json:"quantity"`
 	QualityLevel string `json:"quality_level"`
+	Favorite     bool   `json:"favorite,omitempty"`
+	Locked       bool   `json:"locked,omitempty"`
 }
 
 // InventoryService handles inventory operations
 type InventoryService interface {
 	// Inventory operations by platform ID
 	UseItem(ctx context.Context, platform, platformID, username, itemName string, quantity int, targetUsername string) (string, error)
+	// UseItemDetailed is UseItem plus structured results such as lootbox reveal metadata
+	UseItemDetailed(ctx context.Context, platform, platformID, username, itemName string, quantity int, targetUsername string) (*itemhandler.UseResult, error)
 	GetInventory(ctx context.Context, platform, platformID, username, filter string) ([]InventoryItem, error)
+	GetInventoryCapacity(ctx context.Context, platform, platformID, username string) (*domain.InventoryCapacity, error)
+	// EnsureInventoryRoom returns domain.ErrInventoryFull when crediting the item would open a slot past the limit
+	EnsureInventoryRoom(ctx context.Context, userID string, itemID int, qualityLevel domain.QualityLevel) error
+	// SettleInventoryOverflow sells the slots opened since before that do not fit, returning the slots sold and money paid
+	SettleInventoryOverflow(ctx context.Context, before map[utils.SlotKey]int, inventory *domain.Inventory) (int, int, error)
 	GiveItem(ctx context.Context, ownerPlatform, ownerPlatformID, ownerUsername, receiverPlatform, receiverUsername, itemName string, quantity int) error
 
 	// Inventory operations by username
@@ -61,6 +72,7 @@ type GameplayService interface {
 	ClearTimeout(ctx context.Context, platform, username string) error
 	GetTimeoutPlatform(ctx context.Context, platform, username string) (time.Duration, error)
 	ReduceTimeoutPlatform(ctx context.Context, platform, username string, reduction time.Duration) error
+	RestoreTimeouts(ctx context.Context) error
 
 	// Legacy timeout methods (default to "twitch" platform for backward compatibility)
 	TimeoutUser(ctx context.Context, username string, duration time.Duration, reason string) error
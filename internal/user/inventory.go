@@ -2,8 +2,10 @@ package user
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
+	"github.com/osse101/BrandishBot_Go/internal/database"
 	"github.com/osse101/BrandishBot_Go/internal/domain"
 	"github.com/osse101/BrandishBot_Go/internal/event"
 	"github.com/osse101/BrandishBot_Go/internal/logger"
@@ -46,6 +48,11 @@ func (s *service) addItemToUserInternal(ctx context.Context, user *domain.User,
 		// Admin adds default to COMMON quality
 		qualityLevel := domain.QualityCommon
 
+		if err := s.ensureSlotFor(txCtx, inventory, item.ID, qualityLevel); err != nil {
+			log.Warn("No inventory slot for item", "error", err, "userID", user.ID, "itemName", itemName)
+			return err
+		}
+
 		// Find slot with matching ItemID AND QualityLevel
 		i, _ := utils.FindSlotWithQuality(inventory, item.ID, qualityLevel)
 		if i != -1 {
@@ -206,6 +213,7 @@ func (s *service) getInventoryInternal(ctx context.Context, user *domain.User, f
 	}
 
 	// Convert back to array in order of first appearance
+	flags := s.itemFlagsByID(ctx, user.ID)
 	items := make([]InventoryItem, 0, len(itemsMap))
 	for _, key := range itemOrder {
 		item := itemMap[key.ItemID]
@@ -219,6 +227,8 @@ func (s *service) getInventoryInternal(ctx context.Context, user *domain.User, f
 			PublicName:   item.PublicName,
 			Quantity:     itemsMap[key],
 			QualityLevel: quality,
+			Favorite:     flags[key.ItemID].Favorite,
+			Locked:       flags[key.ItemID].Locked,
 		})
 	}
 
@@ -307,8 +317,14 @@ func (s *service) AddItems(ctx context.Context, platform, platformID, username s
 			return domain.ErrFailedToGetInventory
 		}
 
-		// Add all items to inventory using optimized helper
+		// Add all items to inventory using optimized helper, then sell
+		// whatever does not fit
+		before := utils.BuildSlotMap(inventory)
 		utils.AddItemsToInventory(inventory, slotsToAdd, nil)
+		if _, _, err := s.settleOverflow(txCtx, before, inventory); err != nil {
+			log.Error("Failed to settle inventory overflow", "error", err, "userID", user.ID)
+			return err
+		}
 
 		// Single inventory update
 		if err := tx.UpdateInventory(txCtx, user.ID, *inventory); err != nil {
@@ -386,70 +402,96 @@ func (s *service) GiveItem(ctx context.Context, ownerPlatform, ownerPlatformID,
 	item, err := s.validateItem(ctx, itemName)
 	if err != nil {
 		log.Error("Failed to get item", "error", err)
-		return domain.ErrItemNotFound
+		return err
+	}
+	if err := s.ensureUnlocked(ctx, owner.ID, item); err != nil {
+		return err
+	}
+	if s.giveGuard != nil {
+		if err := s.giveGuard.CheckGive(ctx, owner.ID); err != nil {
+			log.Warn("Give refused by give guard", "error", err, "owner", owner.Username)
+			return err
+		}
 	}
 
-	return s.executeGiveItemTx(ctx, owner, receiver, item, quantity)
+	ctx = event.WithInventoryReason(ctx, domain.ActionGive)
+	return s.withCooldown(ctx, owner.ID, domain.ActionGive, func() error {
+		return s.executeGiveItemTx(ctx, owner, receiver, item, quantity)
+	})
 }
 
 func (s *service) executeGiveItemTx(ctx context.Context, owner, receiver *domain.User, item *domain.Item, quantity int) error {
 	log := logger.FromContext(ctx)
 
-	var eventToPublish func()
-
-	err := s.withTx(ctx, func(txCtx context.Context, tx repository.UserTx) error {
-		ownerInventory, err := tx.GetInventory(txCtx, owner.ID)
-		if err != nil {
-			log.Error("Failed to get owner inventory", "error", err)
-			return domain.ErrFailedToGetInventory
-		}
+	// The inventory is only read to choose which quality slot to give from;
+	// the transfer itself is two atomic adjustments, and the debit fails if
+	// a concurrent change left the slot short
+	ownerInventory, err := s.repo.GetInventory(database.WithPrimaryReads(ctx), owner.ID)
+	if err != nil {
+		log.Error("Failed to get owner inventory", "error", err)
+		return domain.ErrFailedToGetInventory
+	}
 
-		// Find item in owner's inventory using random selection (in case multiple slots with different quality levels exist)
-		ownerSlotIndex, ownerSlotQty := utils.FindRandomSlot(ownerInventory, item.ID, s.rnd)
-		if ownerSlotIndex == -1 {
-			log.Warn("Item not found in owner's inventory", "item", item.InternalName)
-			return domain.ErrNotInInventory
-		}
-		if ownerSlotQty < quantity {
-			log.Warn("Insufficient quantity in owner's inventory", "item", item.InternalName, "quantity", quantity)
-			return domain.ErrInsufficientQuantity
-		}
+	// Find item in owner's inventory using random selection (in case multiple slots with different quality levels exist)
+	ownerSlotIndex, ownerSlotQty := utils.FindRandomSlot(ownerInventory, item.ID, s.rnd)
+	if ownerSlotIndex == -1 {
+		log.Warn("Item not found in owner's inventory", "item", item.InternalName)
+		return domain.ErrNotInInventory
+	}
+	if ownerSlotQty < quantity {
+		log.Warn("Insufficient quantity in owner's inventory", "item", item.InternalName, "quantity", quantity)
+		return domain.ErrInsufficientQuantity
+	}
 
-		// Capture the quality level being transferred
-		transferredQuality := ownerInventory.Slots[ownerSlotIndex].QualityLevel
+	// Capture the quality level being transferred
+	transferredQuality := ownerInventory.Slots[ownerSlotIndex].QualityLevel
 
-		receiverInventory, err := tx.GetInventory(txCtx, receiver.ID)
+	// The receiver must have room for a new slot
+	if s.slotLimit(ctx) > 0 {
+		receiverInventory, err := s.repo.GetInventory(database.WithPrimaryReads(ctx), receiver.ID)
 		if err != nil {
 			log.Error("Failed to get receiver inventory", "error", err)
 			return domain.ErrFailedToGetInventory
 		}
-
-		// Remove from owner
-		if ownerSlotQty == quantity {
-			ownerInventory.Slots = append(ownerInventory.Slots[:ownerSlotIndex], ownerInventory.Slots[ownerSlotIndex+1:]...)
-		} else {
-			ownerInventory.Slots[ownerSlotIndex].Quantity -= quantity
+		if err := s.ensureSlotFor(ctx, receiverInventory, item.ID, transferredQuality); err != nil {
+			log.Warn("No inventory slot for item", "error", err, "receiver", receiver.Username, "item", item.InternalName)
+			return err
 		}
+	}
 
-		// Add to receiver - must match BOTH ItemID and QualityLevel to preserve exact item quality
-		receiverSlotIndex, _ := utils.FindSlotWithQuality(receiverInventory, item.ID, transferredQuality)
-		if receiverSlotIndex != -1 {
-			receiverInventory.Slots[receiverSlotIndex].Quantity += quantity
-		} else {
-			receiverInventory.Slots = append(receiverInventory.Slots, domain.InventorySlot{
-				ItemID:       item.ID,
-				Quantity:     quantity,
-				QualityLevel: transferredQuality,
-			})
-		}
+	tax := s.giveTax(item, quantity)
+	received := quantity - tax
 
-		if err := tx.UpdateInventory(txCtx, owner.ID, *ownerInventory); err != nil {
-			log.Error("Failed to update owner inventory", "error", err)
-			return domain.ErrFailedToUpdateInventory
+	// Adjust the two rows in user ID order so opposing gives cannot deadlock
+	adjustments := []struct {
+		userID string
+		delta  int
+	}{
+		{owner.ID, -quantity},
+		{receiver.ID, received},
+	}
+	if receiver.ID < owner.ID {
+		adjustments[0], adjustments[1] = adjustments[1], adjustments[0]
+	}
+
+	var eventToPublish func()
+
+	err = s.withTx(ctx, func(txCtx context.Context, tx repository.UserTx) error {
+		for _, adj := range adjustments {
+			if _, err := tx.AdjustItemQuantity(txCtx, adj.userID, item.ID, transferredQuality, adj.delta); err != nil {
+				if errors.Is(err, domain.ErrInsufficientQuantity) {
+					log.Warn("Insufficient quantity in owner's inventory", "item", item.InternalName, "quantity", quantity)
+					return domain.ErrInsufficientQuantity
+				}
+				log.Error("Failed to update inventory", "error", err, "userID", adj.userID)
+				return domain.ErrFailedToUpdateInventory
+			}
 		}
-		if err := tx.UpdateInventory(txCtx, receiver.ID, *receiverInventory); err != nil {
-			log.Error("Failed to update receiver inventory", "error", err)
-			return domain.ErrFailedToUpdateInventory
+		if tax > 0 {
+			if err := tx.AddToCommunityPool(txCtx, tax); err != nil {
+				log.Error("Failed to credit give tax to community pool", "error", err)
+				return fmt.Errorf("failed to credit give tax: %w", err)
+			}
 		}
 
 		eventToPublish = func() {
@@ -458,22 +500,49 @@ func (s *service) executeGiveItemTx(ctx context.Context, owner, receiver *domain
 					owner.ID,
 					receiver.ID,
 					item.InternalName,
-					quantity,
+					received,
 				))
 			}
 		}
 
-		log.Info("Item transferred", "owner", owner.Username, "receiver", receiver.Username, "item", item.InternalName, "quantity", quantity)
+		log.Info("Item transferred", "owner", owner.Username, "receiver", receiver.Username, "item", item.InternalName, "quantity", quantity, "tax", tax)
 		return nil
 	})
 
 	if err == nil && eventToPublish != nil {
 		eventToPublish()
 	}
+	if err == nil {
+		s.recordGiveUndo(ctx, owner.ID, receiver.ID, item.ID, transferredQuality, received)
+		if s.giveGuard != nil {
+			s.giveGuard.RecordGive(ctx, owner.ID, receiver.ID, item, quantity)
+		}
+	}
 
 	return err
 }
 
+// recordGiveUndo lets the owner take back what the receiver got. The tax
+// stays in the community pool.
+func (s *service) recordGiveUndo(ctx context.Context, ownerID, receiverID string, itemID int, quality domain.QualityLevel, received int) {
+	if s.undo == nil || received <= 0 {
+		return
+	}
+	s.undo.Record(ctx, ownerID, domain.UndoActionGive, []domain.InventoryChange{
+		{UserID: ownerID, ItemID: itemID, QualityLevel: quality, Delta: -received},
+		{UserID: receiverID, ItemID: itemID, QualityLevel: quality, Delta: received},
+	})
+}
+
+// giveTax is the cut of a give that goes to the community pool. Only money
+// is taxed, and only the part of the give above the threshold.
+func (s *service) giveTax(item *domain.Item, quantity int) int {
+	if s.giveTaxPercent <= 0 || item.InternalName != domain.ItemMoney || quantity <= s.giveTaxThreshold {
+		return 0
+	}
+	return (quantity - s.giveTaxThreshold) * s.giveTaxPercent / 100
+}
+
 func (s *service) GetInventory(ctx context.Context, platform, platformID, username, filter string) ([]InventoryItem, error) {
 	log := logger.FromContext(ctx)
 	log.Info("GetInventory called", "platform", platform, "platformID", platformID, "username", username)
@@ -502,30 +571,35 @@ func (s *service) GetInventoryByUsername(ctx context.Context, platform, username
 	return s.getInventoryInternal(ctx, user, filter)
 }
 
-// addItemToTx adds an item to an inventory within a transaction
+// addItemToTx adds an item to an inventory within a transaction. With a slot
+// limit, a new slot that does not fit is sold like any other overflow, so a
+// reward is never lost or refused.
 func (s *service) addItemToTx(ctx context.Context, tx repository.UserTx, userID string, itemID int, quantity int, qualityLevel domain.QualityLevel) error {
 	log := logger.FromContext(ctx)
+	if s.slotLimit(ctx) == 0 {
+		if _, err := tx.AdjustItemQuantity(ctx, userID, itemID, qualityLevel, quantity); err != nil {
+			log.Error("Failed to update inventory", "error", err, "userID", userID)
+			return fmt.Errorf("failed to update inventory: %w", err)
+		}
+		return nil
+	}
+
 	inventory, err := tx.GetInventory(ctx, userID)
 	if err != nil {
 		log.Error("Failed to get inventory", "error", err, "userID", userID)
-		return fmt.Errorf("failed to get inventory: %w", err)
+		return domain.ErrFailedToGetInventory
 	}
-
-	// Find slot with matching ItemID AND QualityLevel to prevent quality corruption
-	i, _ := utils.FindSlotWithQuality(inventory, itemID, qualityLevel)
-	if i != -1 {
-		inventory.Slots[i].Quantity += quantity
-	} else {
-		inventory.Slots = append(inventory.Slots, domain.InventorySlot{
-			ItemID:       itemID,
-			Quantity:     quantity,
-			QualityLevel: qualityLevel,
-		})
+	before := utils.BuildSlotMap(inventory)
+	if _, err := utils.AdjustSlotQuantity(inventory, itemID, qualityLevel, quantity); err != nil {
+		return fmt.Errorf("failed to update inventory: %w", err)
+	}
+	if _, _, err := s.settleOverflow(ctx, before, inventory); err != nil {
+		log.Error("Failed to settle inventory overflow", "error", err, "userID", userID)
+		return err
 	}
-
 	if err := tx.UpdateInventory(ctx, userID, *inventory); err != nil {
 		log.Error("Failed to update inventory", "error", err, "userID", userID)
-		return fmt.Errorf("failed to update inventory: %w", err)
+		return domain.ErrFailedToUpdateInventory
 	}
 	return nil
 }
@@ -0,0 +1,43 @@
+package user
+
+import (
+	"context"
+	"errors"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/itemflags"
+	"github.com/osse101/BrandishBot_Go/internal/logger"
+)
+
+// ensureUnlocked returns domain.ErrItemLockedByUser when the user has locked the item
+func (s *service) ensureUnlocked(ctx context.Context, userID string, item *domain.Item) error {
+	if s.itemFlags == nil {
+		return nil
+	}
+	err := itemflags.EnsureUnlocked(ctx, s.itemFlags, userID, item)
+	switch {
+	case errors.Is(err, domain.ErrItemLockedByUser):
+		logger.FromContext(ctx).Warn("Item is locked", "userID", userID, "itemName", item.InternalName)
+	case err != nil:
+		logger.FromContext(ctx).Error("Failed to check item lock", "error", err, "userID", userID, "itemName", item.InternalName)
+	}
+	return err
+}
+
+// itemFlagsByID returns the user's item flags keyed by item ID. They only
+// decorate the inventory listing, so a failed read is logged and skipped.
+func (s *service) itemFlagsByID(ctx context.Context, userID string) map[int]domain.ItemFlags {
+	if s.itemFlags == nil {
+		return nil
+	}
+	flags, err := s.itemFlags.GetItemFlags(ctx, userID)
+	if err != nil {
+		logger.FromContext(ctx).Warn("Failed to get item flags", "error", err, "userID", userID)
+		return nil
+	}
+	byID := make(map[int]domain.ItemFlags, len(flags))
+	for _, f := range flags {
+		byID[f.ItemID] = f
+	}
+	return byID
+}
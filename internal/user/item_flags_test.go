@@ -0,0 +1,70 @@
+package user
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+)
+
+// fakeItemFlags is an in-memory ItemFlagReader keyed by user then item ID
+type fakeItemFlags map[string]map[int]domain.ItemFlags
+
+func (f fakeItemFlags) GetItemFlags(_ context.Context, userID string) ([]domain.ItemFlags, error) {
+	flags := make([]domain.ItemFlags, 0, len(f[userID]))
+	for _, flag := range f[userID] {
+		flags = append(flags, flag)
+	}
+	return flags, nil
+}
+
+func (f fakeItemFlags) IsItemLocked(_ context.Context, userID string, itemID int) (bool, error) {
+	return f[userID][itemID].Locked, nil
+}
+
+func TestItemLock_BlocksUseAndGive(t *testing.T) {
+	repo := NewFakeRepository()
+	setupTestData(repo)
+	flags := fakeItemFlags{"user-alice": {1: {ItemID: 1, ItemName: domain.ItemLootbox1, Locked: true}}}
+	svc := NewService(repo, repo, nil, nil, nil, NewMockNamingResolver(), nil, nil, nil, nil, false, WithItemFlags(flags))
+	ctx := context.Background()
+
+	require.NoError(t, svc.AddItemByUsername(ctx, domain.PlatformTwitch, "alice", domain.ItemLootbox1, 3))
+
+	t.Run("use", func(t *testing.T) {
+		_, err := svc.UseItem(ctx, domain.PlatformTwitch, "alice123", "alice", domain.ItemLootbox1, 1, "")
+		assert.ErrorIs(t, err, domain.ErrItemLockedByUser)
+	})
+
+	t.Run("give", func(t *testing.T) {
+		err := svc.GiveItem(ctx, domain.PlatformTwitch, "alice123", "alice", domain.PlatformTwitch, "bob", domain.ItemLootbox1, 1)
+		assert.ErrorIs(t, err, domain.ErrItemLockedByUser)
+	})
+
+	assert.Equal(t, 3, inventoryQuantity(t, repo, "user-alice", domain.ItemLootbox1))
+	assert.Zero(t, inventoryQuantity(t, repo, "user-bob", domain.ItemLootbox1))
+}
+
+func TestGetInventory_ItemFlags(t *testing.T) {
+	repo := NewFakeRepository()
+	setupTestData(repo)
+	flags := fakeItemFlags{"user-alice": {1: {ItemID: 1, ItemName: domain.ItemLootbox1, Favorite: true, Locked: true}}}
+	svc := NewService(repo, repo, nil, nil, nil, NewMockNamingResolver(), nil, nil, nil, nil, false, WithItemFlags(flags))
+	ctx := context.Background()
+
+	require.NoError(t, svc.AddItemByUsername(ctx, domain.PlatformTwitch, "alice", domain.ItemLootbox1, 1))
+	require.NoError(t, svc.AddItemByUsername(ctx, domain.PlatformTwitch, "alice", domain.ItemLootbox2, 1))
+
+	items, err := svc.GetInventory(ctx, domain.PlatformTwitch, "alice123", "alice", "")
+
+	require.NoError(t, err)
+	require.Len(t, items, 2)
+	for _, item := range items {
+		locked := item.InternalName == domain.ItemLootbox1
+		assert.Equal(t, locked, item.Favorite, item.InternalName)
+		assert.Equal(t, locked, item.Locked, item.InternalName)
+	}
+}
@@ -2,10 +2,13 @@ package user
 
 import (
 	"context"
+	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/osse101/BrandishBot_Go/internal/domain"
 	"github.com/osse101/BrandishBot_Go/internal/itemhandler"
@@ -193,6 +196,11 @@ func TestHandlerRegistry_NewHandlers(t *testing.T) {
 			itemName: domain.ItemTNT,
 			wantNil:  false,
 		},
+		{
+			name:     "Aegis has handler",
+			itemName: domain.ItemAegis,
+			wantNil:  false,
+		},
 	}
 
 	for _, tt := range tests {
@@ -483,3 +491,176 @@ func TestVideoFilterHandler(t *testing.T) {
 		})
 	}
 }
+
+// fakeSettingsReader serves targeting preferences from a map of opted-out user IDs
+type fakeSettingsReader map[string]bool
+
+func (f fakeSettingsReader) GetSettings(_ context.Context, userID string) (*domain.UserSettings, error) {
+	return &domain.UserSettings{TargetingOptOut: f[userID]}, nil
+}
+
+// TestWeaponHandler_Targeting tests consent and counter-items for weapons aimed at other users
+func TestWeaponHandler_Targeting(t *testing.T) {
+	ctx := context.Background()
+	missile := &domain.Item{ID: 1, InternalName: domain.ItemMissile}
+	alice := &domain.User{ID: "user-alice", Username: "alice", TwitchID: "alice123"}
+	bob := &domain.User{ID: "user-bob", Username: "bob", TwitchID: "bob456"}
+
+	setup := func(optedOut fakeSettingsReader, opts ...Option) (*service, *domain.Inventory) {
+		repo := NewFakeRepository()
+		repo.UpsertUser(ctx, alice)
+		repo.UpsertUser(ctx, bob)
+		opts = append(opts, WithTargetingPreferences(optedOut))
+		svc := NewService(repo, repo, nil, nil, nil, NewMockNamingResolver(), nil, nil, nil, nil, false, opts...).(*service)
+		inventory := &domain.Inventory{Slots: []domain.InventorySlot{{ItemID: missile.ID, Quantity: 2}}}
+		return svc, inventory
+	}
+	fire := func(svc *service, inventory *domain.Inventory) (string, error) {
+		args := itemhandler.HandlerArgs{Username: alice.Username, Platform: domain.PlatformTwitch, TargetUsername: bob.Username}
+		return (&itemhandler.WeaponHandler{}).Handle(ctx, svc, alice, inventory, missile, 1, args)
+	}
+
+	t.Run("opted-out targets cannot be hit", func(t *testing.T) {
+		svc, inventory := setup(fakeSettingsReader{bob.ID: true})
+
+		_, err := fire(svc, inventory)
+
+		assert.ErrorIs(t, err, domain.ErrTargetOptedOut)
+		assert.Equal(t, 2, inventory.Slots[0].Quantity, "nothing should be consumed")
+	})
+
+	t.Run("opted-out users cannot attack", func(t *testing.T) {
+		svc, inventory := setup(fakeSettingsReader{alice.ID: true})
+
+		_, err := fire(svc, inventory)
+
+		assert.ErrorIs(t, err, domain.ErrTargetingDisabled)
+	})
+
+	t.Run("a shield blocks one attack", func(t *testing.T) {
+		svc, inventory := setup(fakeSettingsReader{})
+		assert.NoError(t, svc.ApplyShield(ctx, bob, 1, false))
+
+		msg, err := fire(svc, inventory)
+		assert.NoError(t, err)
+		assert.Contains(t, msg, "shield blocks")
+		remaining, _ := svc.GetTimeout(ctx, bob.Username)
+		assert.Zero(t, remaining)
+
+		msg, err = fire(svc, inventory)
+		assert.NoError(t, err)
+		assert.Contains(t, msg, "hits bob")
+		remaining, _ = svc.GetTimeout(ctx, bob.Username)
+		assert.Positive(t, remaining)
+	})
+
+	t.Run("a mirror shield reflects the attack", func(t *testing.T) {
+		svc, inventory := setup(fakeSettingsReader{})
+		assert.NoError(t, svc.ApplyShield(ctx, bob, 1, true))
+
+		msg, err := fire(svc, inventory)
+
+		assert.NoError(t, err)
+		assert.Contains(t, msg, "reflects")
+		remaining, _ := svc.GetTimeout(ctx, alice.Username)
+		assert.Positive(t, remaining)
+	})
+	t.Run("timeout immunity stops the attack without using a shield", func(t *testing.T) {
+		effects := fakeEffects{}
+		svc, inventory := setup(fakeSettingsReader{}, WithEffects(effects))
+		assert.NoError(t, svc.ApplyShield(ctx, bob, 1, false))
+		_, err := svc.GrantEffect(ctx, bob.ID, domain.EffectTimeoutImmunity, 1, time.Minute)
+		assert.NoError(t, err)
+
+		msg, err := fire(svc, inventory)
+
+		assert.NoError(t, err)
+		assert.Contains(t, msg, "immune")
+		remaining, _ := svc.GetTimeout(ctx, bob.Username)
+		assert.Zero(t, remaining)
+		delete(effects, bob.ID)
+		msg, _ = fire(svc, inventory)
+		assert.Contains(t, msg, "shield blocks", "the shield should still be up")
+	})
+}
+
+// fakeEffects keeps the effect type each user has active
+type fakeEffects map[string]domain.EffectType
+
+func (f fakeEffects) Grant(_ context.Context, userID string, effectType domain.EffectType, magnitude float64, duration time.Duration) (*domain.Effect, error) {
+	f[userID] = effectType
+	return &domain.Effect{UserID: userID, Type: effectType, Magnitude: magnitude, ExpiresAt: time.Now().Add(duration)}, nil
+}
+
+func (f fakeEffects) IsActive(_ context.Context, userID string, effectType domain.EffectType) bool {
+	return f[userID] == effectType
+}
+
+// TestScriptedHandler runs a config-defined consumable through UseItem
+func TestScriptedHandler(t *testing.T) {
+	const energyDrink = "soda"
+
+	repo := NewFakeRepository()
+	setupTestData(repo)
+	repo.items[energyDrink] = &domain.Item{ID: 50, InternalName: energyDrink, PublicName: "soda", BaseValue: 20}
+
+	table, err := itemhandler.NewScriptTableFromConfig(itemhandler.ScriptConfig{Items: []itemhandler.ScriptedItem{{
+		Item:    energyDrink,
+		Message: "{user} chugged {quantity} {item}",
+		Actions: []itemhandler.ScriptAction{
+			{Type: itemhandler.ActionGrantItem, Item: domain.ItemMoney, Quantity: 5},
+			{Type: itemhandler.ActionTimeout, Seconds: 30},
+			{Type: itemhandler.ActionStartEvent, Event: itemhandler.ScriptEventBomb, Seconds: 60},
+		},
+	}}})
+	require.NoError(t, err)
+
+	svc := NewService(repo, repo, nil, nil, nil, NewMockNamingResolver(), nil, nil, nil, nil, false, WithScriptedItems(table)).(*service)
+	ctx := context.Background()
+	alice := repo.users["alice"]
+	require.NoError(t, repo.UpdateInventory(ctx, alice.ID, domain.Inventory{Slots: []domain.InventorySlot{{ItemID: 50, Quantity: 3}}}))
+
+	msg, err := svc.UseItem(ctx, domain.PlatformTwitch, alice.TwitchID, alice.Username, energyDrink, 2, "")
+
+	require.NoError(t, err)
+	assert.Equal(t, "alice chugged 2 sodas", msg)
+	assert.Equal(t, 1, inventoryQuantity(t, repo, alice.ID, energyDrink))
+	assert.Equal(t, 10, inventoryQuantity(t, repo, alice.ID, domain.ItemMoney))
+	remaining, err := svc.GetTimeout(ctx, alice.Username)
+	require.NoError(t, err)
+	assert.Greater(t, remaining, 50*time.Second)
+	assert.Len(t, svc.bombQueues[domain.PlatformTwitch], 2)
+}
+
+func TestScriptConfig_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		action  itemhandler.ScriptAction
+		wantErr bool
+	}{
+		{"grant item", itemhandler.ScriptAction{Type: itemhandler.ActionGrantItem, Item: domain.ItemStick, Quantity: 1}, false},
+		{"grant item without quantity", itemhandler.ScriptAction{Type: itemhandler.ActionGrantItem, Item: domain.ItemStick}, true},
+		{"grant item with unknown quality", itemhandler.ScriptAction{Type: itemhandler.ActionGrantItem, Item: domain.ItemStick, Quantity: 1, Quality: "SHINY"}, true},
+		{"grant xp", itemhandler.ScriptAction{Type: itemhandler.ActionGrantXP, Job: "explorer", XP: 50}, false},
+		{"grant xp without job", itemhandler.ScriptAction{Type: itemhandler.ActionGrantXP, XP: 50}, true},
+		{"timeout without seconds", itemhandler.ScriptAction{Type: itemhandler.ActionTimeout}, true},
+		{"unknown event", itemhandler.ScriptAction{Type: itemhandler.ActionStartEvent, Event: "parade", Seconds: 10}, true},
+		{"unknown action", itemhandler.ScriptAction{Type: "teleport"}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := itemhandler.ScriptConfig{Items: []itemhandler.ScriptedItem{{Item: "potion", Actions: []itemhandler.ScriptAction{tt.action}}}}
+			err := config.Validate()
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+
+	t.Run("the shipped config is valid", func(t *testing.T) {
+		_, err := itemhandler.LoadScriptConfig(filepath.Join("..", "..", "configs", "items", "effects.json"))
+		assert.NoError(t, err)
+	})
+}
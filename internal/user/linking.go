@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/osse101/BrandishBot_Go/internal/community"
+	"github.com/osse101/BrandishBot_Go/internal/database"
 	"github.com/osse101/BrandishBot_Go/internal/domain"
 	"github.com/osse101/BrandishBot_Go/internal/logger"
 )
@@ -16,6 +18,9 @@ func (s *service) MergeUsers(ctx context.Context, primaryUserID, secondaryUserID
 	log := logger.FromContext(ctx)
 	log.Info("Merging users", "primary", primaryUserID, "secondary", secondaryUserID)
 
+	// The merged inventory is written back, so it must not be built from replica data
+	ctx = database.WithPrimaryReads(ctx)
+
 	primaryInv, secondaryInv, err := s.getInventoriesForMerge(ctx, primaryUserID, secondaryUserID)
 	if err != nil {
 		return err
@@ -42,7 +47,7 @@ func (s *service) MergeUsers(ctx context.Context, primaryUserID, secondaryUserID
 		return fmt.Errorf("failed to merge users in transaction: %w", err)
 	}
 
-	s.invalidateUserCaches(primary, secondary, mergedUser)
+	s.invalidateUserCaches(ctx, primary, secondary, mergedUser)
 
 	log.Info("Users merged successfully", "primary", primaryUserID)
 	return nil
@@ -122,13 +127,13 @@ func (s *service) mergeUserProfiles(primary, secondary *domain.User) *domain.Use
 	return &merged
 }
 
-func (s *service) invalidateUserCaches(users ...*domain.User) {
+func (s *service) invalidateUserCaches(ctx context.Context, users ...*domain.User) {
 	for _, u := range users {
 		if u == nil {
 			continue
 		}
 		for platform, platformID := range getPlatformKeysFromUser(*u) {
-			s.userCache.Invalidate(platform, platformID)
+			s.userCache.Invalidate(community.FromContext(ctx), platform, platformID)
 		}
 	}
 }
@@ -164,13 +169,13 @@ func (s *service) UnlinkPlatform(ctx context.Context, userID, platform string) e
 
 	// Invalidate cache
 	if platformID != "" {
-		s.userCache.Invalidate(platform, platformID)
+		s.userCache.Invalidate(community.FromContext(ctx), platform, platformID)
 	}
 
 	// Also invalidate other keys as user object changed
 	keys := getPlatformKeysFromUser(*user)
 	for p, id := range keys {
-		s.userCache.Invalidate(p, id)
+		s.userCache.Invalidate(community.FromContext(ctx), p, id)
 	}
 
 	log.Info("Platform unlinked", "user_id", userID, "platform", platform)
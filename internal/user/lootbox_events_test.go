@@ -10,6 +10,7 @@ import (
 	"github.com/osse101/BrandishBot_Go/internal/domain"
 	"github.com/osse101/BrandishBot_Go/internal/itemhandler"
 	"github.com/osse101/BrandishBot_Go/internal/lootbox"
+	"github.com/osse101/BrandishBot_Go/internal/naming"
 )
 
 // MockStatsServiceForLootboxTests - distinct name to avoid conflicts if any
@@ -35,12 +36,12 @@ func (m *MockStatsServiceForLootboxTests) GetUserCurrentStreak(ctx context.Conte
 	return args.Int(0), args.Error(1)
 }
 
-func (m *MockStatsServiceForLootboxTests) GetLeaderboard(ctx context.Context, eventType domain.EventType, period string, limit int) ([]domain.LeaderboardEntry, error) {
-	args := m.Called(ctx, eventType, period, limit)
+func (m *MockStatsServiceForLootboxTests) GetLeaderboard(ctx context.Context, query domain.LeaderboardQuery) (*domain.LeaderboardPage, error) {
+	args := m.Called(ctx, query)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
 	}
-	return args.Get(0).([]domain.LeaderboardEntry), args.Error(1)
+	return args.Get(0).(*domain.LeaderboardPage), args.Error(1)
 }
 
 func (m *MockStatsServiceForLootboxTests) GetTotalMetric(ctx context.Context, userID string, metric string) (float64, error) {
@@ -48,6 +49,14 @@ func (m *MockStatsServiceForLootboxTests) GetTotalMetric(ctx context.Context, us
 	return args.Get(0).(float64), args.Error(1)
 }
 
+func (m *MockStatsServiceForLootboxTests) GetUserRecipeCraftCounts(ctx context.Context, userID string) (map[string]int, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(map[string]int), args.Error(1)
+}
+
 func (m *MockStatsServiceForLootboxTests) GetSystemStats(ctx context.Context, period string) (*domain.StatsSummary, error) {
 	args := m.Called(ctx, period)
 	if args.Get(0) == nil {
@@ -88,19 +97,37 @@ func (m *MockStatsServiceForLootboxTests) GetSlotsLeaderboardByMegaJackpots(ctx
 	return args.Get(0).([]domain.SlotsStats), args.Error(1)
 }
 
+func (m *MockStatsServiceForLootboxTests) QueryStats(ctx context.Context, query domain.StatsQuery) (*domain.StatsQueryResult, error) {
+	args := m.Called(ctx, query)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.StatsQueryResult), args.Error(1)
+}
+
+func (m *MockStatsServiceForLootboxTests) RollUpDue(ctx context.Context) (int, error) {
+	args := m.Called(ctx)
+	return args.Int(0), args.Error(1)
+}
+
 // MockLootboxServiceForLootboxTests
 type MockLootboxServiceForLootboxTests struct {
 	mock.Mock
 }
 
-func (m *MockLootboxServiceForLootboxTests) OpenLootbox(ctx context.Context, lootboxName string, quantity int, boxQuality domain.QualityLevel) ([]lootbox.DroppedItem, error) {
-	args := m.Called(ctx, lootboxName, quantity, boxQuality)
+func (m *MockLootboxServiceForLootboxTests) OpenLootbox(ctx context.Context, userID, lootboxName string, quantity int, boxQuality domain.QualityLevel) ([]lootbox.DroppedItem, error) {
+	args := m.Called(ctx, userID, lootboxName, quantity, boxQuality)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
 	}
 	return args.Get(0).([]lootbox.DroppedItem), args.Error(1)
 }
 
+func (m *MockLootboxServiceForLootboxTests) Reload(ctx context.Context) error {
+	args := m.Called(ctx)
+	return args.Error(0)
+}
+
 // MockNamingResolverForLootboxTests - using testify/mock
 type MockNamingResolverForLootboxTests struct {
 	mock.Mock
@@ -121,11 +148,26 @@ func (m *MockNamingResolverForLootboxTests) GetDisplayName(internalName string,
 	return args.String(0)
 }
 
+func (m *MockNamingResolverForLootboxTests) GetDisplayNameForTheme(internalName string, qualityLevel domain.QualityLevel, theme string) string {
+	args := m.Called(internalName, qualityLevel, theme)
+	return args.String(0)
+}
+
 func (m *MockNamingResolverForLootboxTests) GetActiveTheme() string {
 	args := m.Called()
 	return args.String(0)
 }
 
+func (m *MockNamingResolverForLootboxTests) GetThemes() map[string]naming.ThemePeriod {
+	args := m.Called()
+	themes, _ := args.Get(0).(map[string]naming.ThemePeriod)
+	return themes
+}
+
+func (m *MockNamingResolverForLootboxTests) SuggestNames(name string) []string {
+	return nil
+}
+
 func (m *MockNamingResolverForLootboxTests) Reload() error {
 	args := m.Called()
 	return args.Error(0)
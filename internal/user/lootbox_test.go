@@ -195,14 +195,19 @@ type MockLootboxService struct {
 	mock.Mock
 }
 
-func (m *MockLootboxService) OpenLootbox(ctx context.Context, lootboxName string, quantity int, boxQuality domain.QualityLevel) ([]lootbox.DroppedItem, error) {
-	args := m.Called(ctx, lootboxName, quantity, boxQuality)
+func (m *MockLootboxService) OpenLootbox(ctx context.Context, userID, lootboxName string, quantity int, boxQuality domain.QualityLevel) ([]lootbox.DroppedItem, error) {
+	args := m.Called(ctx, userID, lootboxName, quantity, boxQuality)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
 	}
 	return args.Get(0).([]lootbox.DroppedItem), args.Error(1)
 }
 
+func (m *MockLootboxService) Reload(ctx context.Context) error {
+	args := m.Called(ctx)
+	return args.Error(0)
+}
+
 // Helper to create a service with a mock repo and lootbox service
 func createTestService(repo *MockRepo, lootboxSvc *MockLootboxService) *service {
 	namingResolver := NewMockNamingResolver()
@@ -234,7 +239,7 @@ func TestProcessLootbox(t *testing.T) {
 		drops := []lootbox.DroppedItem{
 			{ItemID: money.ID, ItemName: domain.ItemMoney, Quantity: 5, Value: 50, QualityLevel: domain.QualityCommon},
 		}
-		lootboxSvc.On("OpenLootbox", ctx, domain.ItemLootbox0, 1, domain.QualityLevel("")).Return(drops, nil)
+		lootboxSvc.On("OpenLootbox", ctx, mock.Anything, domain.ItemLootbox0, 1, domain.QualityLevel("")).Return(drops, nil)
 
 		// Setup inventory with lootbox0
 		inventory := &domain.Inventory{
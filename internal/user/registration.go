@@ -7,6 +7,7 @@ import (
 
 	"github.com/google/uuid"
 
+	"github.com/osse101/BrandishBot_Go/internal/community"
 	"github.com/osse101/BrandishBot_Go/internal/domain"
 	"github.com/osse101/BrandishBot_Go/internal/logger"
 )
@@ -23,7 +24,7 @@ func (s *service) RegisterUser(ctx context.Context, user domain.User) (domain.Us
 	// Cache the newly registered user for all their platforms
 	keys := getPlatformKeysFromUser(user)
 	for platform, platformID := range keys {
-		s.userCache.Set(platform, platformID, &user)
+		s.userCache.Set(community.FromContext(ctx), platform, platformID, &user)
 	}
 
 	log.Info(domain.LogMsgUserRegistered, "user_id", user.ID, "username", user.Username)
@@ -41,7 +42,7 @@ func (s *service) UpdateUser(ctx context.Context, user domain.User) error {
 	// Invalidate cache for all platforms to force refresh on next lookup
 	keys := getPlatformKeysFromUser(user)
 	for platform, platformID := range keys {
-		s.userCache.Invalidate(platform, platformID)
+		s.userCache.Invalidate(community.FromContext(ctx), platform, platformID)
 	}
 
 	return nil
@@ -66,7 +67,7 @@ func (s *service) FindUserByPlatformID(ctx context.Context, platform, platformID
 // It checks the in-memory userCache first; only falls back to the DB on a miss.
 // Returns ("", nil) if the user is not yet registered (no auto-registration).
 func (s *service) GetUserIDByPlatformID(ctx context.Context, platform, platformID string) (string, error) {
-	if user, ok := s.userCache.Get(platform, platformID); ok {
+	if user, ok := s.userCache.Get(community.FromContext(ctx), platform, platformID); ok {
 		return user.ID, nil
 	}
 
@@ -79,7 +80,7 @@ func (s *service) GetUserIDByPlatformID(ctx context.Context, platform, platformI
 	}
 
 	// Warm the cache for subsequent lookups
-	s.userCache.Set(platform, platformID, user)
+	s.userCache.Set(community.FromContext(ctx), platform, platformID, user)
 	return user.ID, nil
 }
 
@@ -131,7 +132,7 @@ func (s *service) HandleIncomingMessage(ctx context.Context, platform, platformI
 			s.wg.Add(1)
 			go func() {
 				defer s.wg.Done()
-				asyncCtx := context.Background() // New context for async operation
+				asyncCtx := context.WithoutCancel(ctx) // Outlives the request, keeps its community
 				if err := s.triggerTrap(asyncCtx, trap, user); err != nil {
 					log.Error(domain.LogMsgTrapTriggered, "trap_id", trap.ID, "error", err)
 				}
@@ -162,7 +163,7 @@ func (s *service) getUserOrRegister(ctx context.Context, platform, platformID, u
 	}
 
 	// Try cache first
-	if user, ok := s.userCache.Get(platform, platformID); ok {
+	if user, ok := s.userCache.Get(community.FromContext(ctx), platform, platformID); ok {
 		log.Debug("User cache hit", "userID", user.ID, "platform", platform)
 		return user, nil
 	}
@@ -177,7 +178,7 @@ func (s *service) getUserOrRegister(ctx context.Context, platform, platformID, u
 	if user != nil {
 		log.Debug("Found existing user", "userID", user.ID, "platform", platform)
 		// Cache the user for future lookups
-		s.userCache.Set(platform, platformID, user)
+		s.userCache.Set(community.FromContext(ctx), platform, platformID, user)
 		return user, nil
 	}
 
@@ -29,19 +29,14 @@ var validPlatforms = map[string]bool{
 	domain.PlatformDiscord: true,
 }
 
-// timeoutInfo tracks active timeouts
-type timeoutInfo struct {
-	timer     *time.Timer
-	expiresAt time.Time
-}
-
 // service implements the Service interface
 type service struct {
 	repo            repository.User
 	trapRepo        repository.TrapRepository
 	handlerRegistry *itemhandler.Registry
-	timeoutMu       sync.Mutex
-	timeouts        map[string]*timeoutInfo // Keyed by "platform:username"
+	timeoutStore    TimeoutStore           // Active timeouts; in memory unless WithTimeoutStore is used
+	timeoutMu       sync.Mutex             // Protects timeoutTimers
+	timeoutTimers   map[string]*time.Timer // Expiry cleanup, keyed by "platform:username"
 	lootboxService  lootbox.Service
 	publisher       *event.ResilientPublisher
 	statsService    stats.Service
@@ -69,6 +64,31 @@ type service struct {
 	// Bomb system
 	bombQueues map[string][]*pendingBomb // Platform -> Queue of bombs
 
+	// Targeting consent and counter-items
+	settings SettingsReader          // Nil means every user accepts targeted items and sees dated themes
+	shieldMu sync.Mutex              // Protects shields
+	shields  map[string]*shieldState // Keyed by user ID
+
+	// Timed effects
+	effects EffectManager // Nil disables effect items and timeout immunity
+
+	// Item flags
+	itemFlags ItemFlagReader // Nil ignores item locks and favorites
+
+	// Undo ledger
+	undo UndoRecorder // Nil records no gives to undo
+
+	// Give limits and funneling detection
+	giveGuard GiveGuard // Nil leaves gives unlimited and unmonitored
+
+	// Money gives above giveTaxThreshold lose giveTaxPercent of the excess
+	// to the community pool
+	giveTaxPercent   int
+	giveTaxThreshold int
+
+	// Inventory slots a user may fill before stash upgrades; 0 means no limit
+	baseSlotLimit int
+
 	rnd func() float64 // For RNG - allows deterministic testing
 
 	wg sync.WaitGroup // Track background tasks for graceful shutdown
@@ -123,13 +143,141 @@ type ProgressionService interface {
 	GetModifiedValue(ctx context.Context, userID string, featureKey string, baseValue float64) (float64, error)
 }
 
+// SettingsReader reads the per-user settings that govern targeted items and
+// the item name theme
+type SettingsReader interface {
+	GetSettings(ctx context.Context, userID string) (*domain.UserSettings, error)
+}
+
+// EffectManager grants and checks timed effects such as timeout immunity
+type EffectManager interface {
+	Grant(ctx context.Context, userID string, effectType domain.EffectType, magnitude float64, duration time.Duration) (*domain.Effect, error)
+	IsActive(ctx context.Context, userID string, effectType domain.EffectType) bool
+}
+
+// ItemFlagReader reads the favorite and locked flags users put on items
+type ItemFlagReader interface {
+	GetItemFlags(ctx context.Context, userID string) ([]domain.ItemFlags, error)
+	IsItemLocked(ctx context.Context, userID string, itemID int) (bool, error)
+}
+
+// UndoRecorder records operations the user can undo for a short while
+type UndoRecorder interface {
+	Record(ctx context.Context, userID string, action domain.UndoAction, changes []domain.InventoryChange)
+}
+
+// GiveGuard limits who may give items and watches gives for funneling
+type GiveGuard interface {
+	CheckGive(ctx context.Context, giverID string) error
+	RecordGive(ctx context.Context, giverID, receiverID string, item *domain.Item, quantity int)
+}
+
+// TimeoutStore keeps user timeouts. Timeouts past their expiry read as absent.
+type TimeoutStore interface {
+	// AddTimeout extends a timeout by duration from the later of now and its
+	// current expiry, and returns the new expiry
+	AddTimeout(ctx context.Context, platform, username string, duration time.Duration, reason string) (time.Time, error)
+
+	// GetTimeout returns the expiry of an active timeout, or the zero time
+	GetTimeout(ctx context.Context, platform, username string) (time.Time, error)
+
+	// ReduceTimeout moves an active timeout's expiry earlier and returns the
+	// new expiry, which may be in the past. It returns the zero time when
+	// there is no active timeout.
+	ReduceTimeout(ctx context.Context, platform, username string, reduction time.Duration) (time.Time, error)
+
+	// ClearTimeout removes a timeout and reports whether it was still active
+	ClearTimeout(ctx context.Context, platform, username string) (bool, error)
+
+	// DeleteExpiredTimeout removes a timeout once it has expired
+	DeleteExpiredTimeout(ctx context.Context, platform, username string) error
+
+	// GetActiveTimeouts removes expired timeouts and returns the active ones
+	GetActiveTimeouts(ctx context.Context) ([]domain.UserTimeout, error)
+}
+
+// Option configures optional user service dependencies
+type Option func(*service)
+
+// WithTargetingPreferences lets users opt out of weapons and traps
+func WithTargetingPreferences(settings SettingsReader) Option {
+	return func(s *service) {
+		s.settings = settings
+	}
+}
+
+// WithEffects lets effect items grant timed buffs and honours timeout immunity
+func WithEffects(effects EffectManager) Option {
+	return func(s *service) {
+		s.effects = effects
+	}
+}
+
+// WithScriptedItems lets items defined in the scripted effect table be used,
+// ahead of the built-in item handlers
+func WithScriptedItems(table *itemhandler.ScriptTable) Option {
+	return func(s *service) {
+		s.handlerRegistry = itemhandler.NewRegistry(itemhandler.NewScriptedHandler(table))
+	}
+}
+
+// WithItemFlags stops users using or giving items they have locked, and
+// marks favorite and locked items in their inventory
+func WithItemFlags(flags ItemFlagReader) Option {
+	return func(s *service) {
+		s.itemFlags = flags
+	}
+}
+
+// WithUndo lets users take back their recent gives while the receiver still
+// holds the items
+func WithUndo(undo UndoRecorder) Option {
+	return func(s *service) {
+		s.undo = undo
+	}
+}
+
+// WithGiveGuard applies daily give limits and the minimum account age to
+// gives, and reports each completed give for funneling detection
+func WithGiveGuard(guard GiveGuard) Option {
+	return func(s *service) {
+		s.giveGuard = guard
+	}
+}
+
+// WithTimeoutStore keeps timeouts in store instead of in memory, so they
+// survive a restart
+func WithTimeoutStore(store TimeoutStore) Option {
+	return func(s *service) {
+		s.timeoutStore = store
+	}
+}
+
+// WithGiveTax takes percent of any money given beyond threshold in a single
+// give into the community pool
+func WithGiveTax(percent, threshold int) Option {
+	return func(s *service) {
+		s.giveTaxPercent = percent
+		s.giveTaxThreshold = threshold
+	}
+}
+
+// WithSlotLimit caps the distinct item slots a user can hold. Stash upgrades
+// in the progression tree add to limit; 0 leaves inventories unlimited.
+func WithSlotLimit(limit int) Option {
+	return func(s *service) {
+		s.baseSlotLimit = limit
+	}
+}
+
 // NewService creates a new user service
-func NewService(repo repository.User, trapRepo repository.TrapRepository, statsService stats.Service, publisher *event.ResilientPublisher, lootboxService lootbox.Service, namingResolver naming.Resolver, cooldownService cooldown.Service, progressionSvc ProgressionService, jobService job.Service, eventBus event.Bus, devMode bool) Service {
+func NewService(repo repository.User, trapRepo repository.TrapRepository, statsService stats.Service, publisher *event.ResilientPublisher, lootboxService lootbox.Service, namingResolver naming.Resolver, cooldownService cooldown.Service, progressionSvc ProgressionService, jobService job.Service, eventBus event.Bus, devMode bool, opts ...Option) Service {
 	svc := &service{
 		repo:                 repo,
 		trapRepo:             trapRepo,
 		handlerRegistry:      itemhandler.NewRegistry(),
-		timeouts:             make(map[string]*timeoutInfo),
+		timeoutStore:         newMemoryTimeoutStore(),
+		timeoutTimers:        make(map[string]*time.Timer),
 		lootboxService:       lootboxService,
 		publisher:            publisher,
 		statsService:         statsService,
@@ -145,10 +293,14 @@ func NewService(repo repository.User, trapRepo repository.TrapRepository, statsS
 		userCache:            newUserCache(loadCacheConfig()),
 		activeChatterTracker: activechatter.NewTracker(),
 		bombQueues:           make(map[string][]*pendingBomb),
+		shields:              make(map[string]*shieldState),
 		recentChatterWindow:  make(map[string]map[string]bool),
 		recentChatterTicker:  time.NewTicker(2 * time.Second),
 		rnd:                  utils.RandomFloat,
 	}
+	for _, opt := range opts {
+		opt(svc)
+	}
 
 	// Start recent chatter pulse
 	go svc.pulseRecentChatters()
@@ -220,3 +372,12 @@ func (s *service) RemoveTemporaryStringRule(pattern string) {
 func (s *service) ReloadStringRules() error {
 	return s.stringFinder.LoadRules("configs/string_finder_rules.json")
 }
+
+// withCooldown runs fn under the user's cooldown for the action. A failed
+// action does not use up the cooldown.
+func (s *service) withCooldown(ctx context.Context, userID, action string, fn func() error) error {
+	if s.cooldownService == nil {
+		return fn()
+	}
+	return s.cooldownService.EnforceCooldown(ctx, userID, action, fn)
+}
@@ -3,15 +3,19 @@ package user
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
+	"github.com/osse101/BrandishBot_Go/internal/cooldown"
 	"github.com/osse101/BrandishBot_Go/internal/domain"
 	"github.com/osse101/BrandishBot_Go/internal/job"
 	"github.com/osse101/BrandishBot_Go/internal/lootbox"
+	"github.com/osse101/BrandishBot_Go/internal/naming"
 	"github.com/osse101/BrandishBot_Go/internal/repository"
+	"github.com/osse101/BrandishBot_Go/internal/utils"
 )
 
 // MockNamingResolver implements naming.Resolver interface for testing
@@ -39,10 +43,22 @@ func (m *MockNamingResolver) GetDisplayName(internalName string, qualityLevel do
 	return internalName
 }
 
+func (m *MockNamingResolver) GetDisplayNameForTheme(internalName string, qualityLevel domain.QualityLevel, theme string) string {
+	return m.GetDisplayName(internalName, qualityLevel)
+}
+
 func (m *MockNamingResolver) GetActiveTheme() string {
 	return ""
 }
 
+func (m *MockNamingResolver) GetThemes() map[string]naming.ThemePeriod {
+	return nil
+}
+
+func (m *MockNamingResolver) SuggestNames(name string) []string {
+	return nil
+}
+
 func (m *MockNamingResolver) Reload() error {
 	return nil
 }
@@ -258,6 +274,73 @@ func TestGiveItem(t *testing.T) {
 	})
 }
 
+func TestGiveItem_GiveTax(t *testing.T) {
+	repo := NewFakeRepository()
+	setupTestData(repo)
+	svc := NewService(repo, repo, nil, nil, nil, NewMockNamingResolver(), nil, nil, nil, nil, false, WithGiveTax(10, 100))
+	ctx := context.Background()
+
+	require.NoError(t, svc.AddItemByUsername(ctx, domain.PlatformTwitch, "alice", domain.ItemMoney, 1000))
+	require.NoError(t, svc.AddItemByUsername(ctx, domain.PlatformTwitch, "alice", domain.ItemLootbox1, 500))
+
+	t.Run("money at the threshold is untaxed", func(t *testing.T) {
+		require.NoError(t, svc.GiveItem(ctx, domain.PlatformTwitch, "alice123", "alice", domain.PlatformTwitch, "bob", domain.ItemMoney, 100))
+		assert.Equal(t, 100, inventoryQuantity(t, repo, "user-bob", domain.ItemMoney))
+		assert.Zero(t, repo.communityPool)
+	})
+
+	t.Run("money above the threshold is taxed on the excess", func(t *testing.T) {
+		require.NoError(t, svc.GiveItem(ctx, domain.PlatformTwitch, "alice123", "alice", domain.PlatformTwitch, "bob", domain.ItemMoney, 600))
+		assert.Equal(t, 300, inventoryQuantity(t, repo, "user-alice", domain.ItemMoney))
+		// 600 less 10% of the 500 over the threshold
+		assert.Equal(t, 650, inventoryQuantity(t, repo, "user-bob", domain.ItemMoney))
+		assert.Equal(t, int64(50), repo.communityPool)
+	})
+
+	t.Run("other items are untaxed", func(t *testing.T) {
+		require.NoError(t, svc.GiveItem(ctx, domain.PlatformTwitch, "alice123", "alice", domain.PlatformTwitch, "bob", domain.ItemLootbox1, 500))
+		assert.Equal(t, 500, inventoryQuantity(t, repo, "user-bob", domain.ItemLootbox1))
+		assert.Equal(t, int64(50), repo.communityPool)
+	})
+}
+
+// inventoryQuantity totals a user's quantity of an item in the fake repository
+func inventoryQuantity(t *testing.T, repo *FakeRepository, userID, itemName string) int {
+	t.Helper()
+	inv, err := repo.GetInventory(context.Background(), userID)
+	require.NoError(t, err)
+	return utils.GetTotalQuantity(inv, repo.items[itemName].ID)
+}
+
+// onCooldownService reports every action as on cooldown
+type onCooldownService struct {
+	fakeBenchCooldownService
+	actions []string
+}
+
+func (c *onCooldownService) EnforceCooldown(ctx context.Context, userID, action string, fn func() error) error {
+	c.actions = append(c.actions, userID+":"+action)
+	return cooldown.ErrOnCooldown{Action: action, Remaining: time.Minute}
+}
+
+func TestGiveItem_OnCooldown(t *testing.T) {
+	repo := NewFakeRepository()
+	setupTestData(repo)
+	cooldowns := &onCooldownService{}
+	svc := NewService(repo, repo, nil, nil, nil, NewMockNamingResolver(), cooldowns, nil, nil, nil, false)
+	ctx := context.Background()
+
+	require.NoError(t, repo.UpdateInventory(ctx, "user-alice", domain.Inventory{Slots: []domain.InventorySlot{{ItemID: 1, Quantity: 5}}}))
+
+	err := svc.GiveItem(ctx, domain.PlatformTwitch, "alice123", "alice", domain.PlatformTwitch, "bob", domain.ItemLootbox1, 1)
+
+	require.ErrorIs(t, err, domain.ErrOnCooldown)
+	assert.Equal(t, []string{"user-alice:" + domain.ActionGive}, cooldowns.actions)
+	bobInv, err := repo.GetInventory(ctx, "user-bob")
+	require.NoError(t, err)
+	assert.Empty(t, bobInv.Slots, "nothing is given while on cooldown")
+}
+
 func TestGiveItem_Comprehensive(t *testing.T) {
 	tests := []struct {
 		name          string
@@ -516,7 +599,7 @@ func TestUseItem(t *testing.T) {
 	drops := []lootbox.DroppedItem{
 		{ItemID: 4, ItemName: domain.ItemLootbox0, Quantity: 1, Value: 10, QualityLevel: domain.QualityCommon},
 	}
-	lootboxSvc.On("OpenLootbox", mock.Anything, domain.ItemLootbox1, 1, mock.Anything).Return(drops, nil)
+	lootboxSvc.On("OpenLootbox", mock.Anything, mock.Anything, domain.ItemLootbox1, 1, mock.Anything).Return(drops, nil)
 
 	svc := NewService(repo, repo, nil, nil, lootboxSvc, NewMockNamingResolver(), nil, nil, nil, nil, false).(*service)
 
@@ -797,7 +880,7 @@ func TestUseItem_Lootbox0(t *testing.T) {
 	drops := []lootbox.DroppedItem{
 		{ItemID: 3, ItemName: domain.ItemMoney, Quantity: 5, Value: 5, QualityLevel: "COMMON"},
 	}
-	lootboxSvc.On("OpenLootbox", mock.Anything, domain.ItemLootbox0, 1, mock.Anything).Return(drops, nil)
+	lootboxSvc.On("OpenLootbox", mock.Anything, mock.Anything, domain.ItemLootbox0, 1, mock.Anything).Return(drops, nil)
 
 	svc := NewService(repo, repo, nil, nil, lootboxSvc, NewMockNamingResolver(), nil, nil, nil, nil, false).(*service)
 
@@ -835,7 +918,7 @@ func TestUseItem_Lootbox2(t *testing.T) {
 	drops := []lootbox.DroppedItem{
 		{ItemID: 1, ItemName: domain.ItemLootbox1, Quantity: 1, Value: 50, QualityLevel: "COMMON"},
 	}
-	lootboxSvc.On("OpenLootbox", mock.Anything, domain.ItemLootbox2, 1, mock.Anything).Return(drops, nil)
+	lootboxSvc.On("OpenLootbox", mock.Anything, mock.Anything, domain.ItemLootbox2, 1, mock.Anything).Return(drops, nil)
 
 	svc := NewService(repo, repo, nil, nil, lootboxSvc, NewMockNamingResolver(), nil, nil, nil, nil, false).(*service)
 
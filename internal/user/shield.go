@@ -7,20 +7,66 @@ import (
 	"github.com/osse101/BrandishBot_Go/internal/logger"
 )
 
+// shieldState counts a user's active shield charges
+type shieldState struct {
+	standard int
+	mirror   int
+}
+
 // ApplyShield activates shield protection for a user (blocks next weapon attacks)
 // Note: Shield count is stored in-memory and will be lost on server restart
 func (s *service) ApplyShield(ctx context.Context, user *domain.User, quantity int, isMirror bool) error {
 	log := logger.FromContext(ctx)
 	log.Info("ApplyShield called", "userID", user.ID, "quantity", quantity, "is_mirror", isMirror)
 
-	// Placeholder: shields currently transient. Needs persistent storage and weapon handler integration.
+	s.shieldMu.Lock()
+	defer s.shieldMu.Unlock()
 
-	// TODO: Implement persistent shield storage
-	// For now, just log and return success
-	shieldType := "standard"
+	if s.shields == nil {
+		s.shields = make(map[string]*shieldState)
+	}
+	state, ok := s.shields[user.ID]
+	if !ok {
+		state = &shieldState{}
+		s.shields[user.ID] = state
+	}
 	if isMirror {
-		shieldType = "mirror"
+		state.mirror += quantity
+	} else {
+		state.standard += quantity
 	}
-	log.Info("Shield applied (placeholder implementation)", "userID", user.ID, "quantity", quantity, "type", shieldType)
+
+	log.Info("Shield applied", "userID", user.ID, "standard", state.standard, "mirror", state.mirror)
 	return nil
 }
+
+// ConsumeShield spends one of the user's shield charges against an incoming
+// attack, mirror charges first, and reports which counter-item it was.
+func (s *service) ConsumeShield(ctx context.Context, userID string) (string, bool) {
+	s.shieldMu.Lock()
+	defer s.shieldMu.Unlock()
+
+	state, ok := s.shields[userID]
+	if !ok {
+		return "", false
+	}
+
+	var counterItem string
+	switch {
+	case state.mirror > 0:
+		state.mirror--
+		counterItem = domain.ItemMirrorShield
+	case state.standard > 0:
+		state.standard--
+		counterItem = domain.ItemShield
+	}
+	if state.mirror == 0 && state.standard == 0 {
+		delete(s.shields, userID)
+	}
+	if counterItem == "" {
+		return "", false
+	}
+
+	logger.FromContext(ctx).Info("Shield charge consumed", "userID", userID, "item", counterItem)
+	return counterItem, true
+}
@@ -11,57 +11,27 @@ import (
 	"github.com/osse101/BrandishBot_Go/internal/logger"
 )
 
-// timeoutKey generates a platform-aware key for the timeout map
+// timeoutKey generates a platform-aware key for the timeout timers
 func timeoutKey(platform, username string) string {
 	return fmt.Sprintf("%s:%s", platform, username)
 }
 
 // AddTimeout applies or extends a timeout for a user (accumulating).
 // If the user already has a timeout, the new duration is ADDED to the remaining time.
-// Note: Timeouts are in-memory and will be lost on server restart.
 func (s *service) AddTimeout(ctx context.Context, platform, username string, duration time.Duration, reason string) error {
 	log := logger.FromContext(ctx)
-	key := timeoutKey(platform, username)
 	log.Info("AddTimeout called", "platform", platform, "username", username, "duration", duration, "reason", reason)
 
-	s.timeoutMu.Lock()
-	defer s.timeoutMu.Unlock()
-
-	var newExpiresAt time.Time
-	now := time.Now()
-
-	// Check if user already has a timeout - accumulate if so
-	if info, exists := s.timeouts[key]; exists {
-		info.timer.Stop()
-		remaining := time.Until(info.expiresAt)
-		if remaining < 0 {
-			remaining = 0
-		}
-		// Accumulate: new expiry = now + remaining + new duration
-		newExpiresAt = now.Add(remaining + duration)
-		log.Info("Timeout accumulated", "platform", platform, "username", username, "previousRemaining", remaining, "added", duration, "newTotal", time.Until(newExpiresAt))
-	} else {
-		// No existing timeout
-		newExpiresAt = now.Add(duration)
-		log.Info("New timeout created", "platform", platform, "username", username, "duration", duration)
-	}
-
-	// Create timer for expiry
-	timer := time.AfterFunc(time.Until(newExpiresAt), func() {
-		s.timeoutMu.Lock()
-		delete(s.timeouts, key)
-		s.timeoutMu.Unlock()
-		slog.Default().Info("User timeout expired", "platform", platform, "username", username, "reason", reason)
-	})
-
-	s.timeouts[key] = &timeoutInfo{
-		timer:     timer,
-		expiresAt: newExpiresAt,
+	expiresAt, err := s.timeoutStore.AddTimeout(ctx, platform, username, duration, reason)
+	if err != nil {
+		return fmt.Errorf("failed to store timeout: %w", err)
 	}
+	s.scheduleTimeoutExpiry(platform, username, expiresAt)
+	log.Info("Timeout applied", "platform", platform, "username", username, "added", duration, "newTotal", time.Until(expiresAt))
 
 	// Publish timeout event
 	if s.eventBus != nil {
-		totalSeconds := int(time.Until(newExpiresAt).Seconds())
+		totalSeconds := int(time.Until(expiresAt).Seconds())
 		evt := event.NewTimeoutAppliedEvent(platform, username, totalSeconds, reason)
 		if err := s.eventBus.Publish(ctx, evt); err != nil {
 			log.Warn("Failed to publish timeout applied event", "error", err)
@@ -74,46 +44,34 @@ func (s *service) AddTimeout(ctx context.Context, platform, username string, dur
 // ClearTimeout removes a user's timeout (admin action).
 func (s *service) ClearTimeout(ctx context.Context, platform, username string) error {
 	log := logger.FromContext(ctx)
-	key := timeoutKey(platform, username)
 	log.Info("ClearTimeout called", "platform", platform, "username", username)
 
-	s.timeoutMu.Lock()
-	defer s.timeoutMu.Unlock()
-
-	info, exists := s.timeouts[key]
-	if !exists {
+	cleared, err := s.timeoutStore.ClearTimeout(ctx, platform, username)
+	if err != nil {
+		return fmt.Errorf("failed to clear timeout: %w", err)
+	}
+	s.cancelTimeoutExpiry(platform, username)
+	if !cleared {
 		log.Info("No timeout to clear", "platform", platform, "username", username)
 		return nil
 	}
-
-	info.timer.Stop()
-	delete(s.timeouts, key)
 	log.Info("Timeout cleared", "platform", platform, "username", username)
 
-	// Publish timeout cleared event
-	if s.eventBus != nil {
-		evt := event.NewTimeoutClearedEvent(platform, username)
-		if err := s.eventBus.Publish(ctx, evt); err != nil {
-			log.Warn("Failed to publish timeout cleared event", "error", err)
-		}
-	}
-
+	s.publishTimeoutCleared(ctx, platform, username)
 	return nil
 }
 
 // GetTimeoutPlatform returns the remaining duration of a user's timeout for a specific platform.
 func (s *service) GetTimeoutPlatform(ctx context.Context, platform, username string) (time.Duration, error) {
-	key := timeoutKey(platform, username)
-
-	s.timeoutMu.Lock()
-	defer s.timeoutMu.Unlock()
-
-	info, exists := s.timeouts[key]
-	if !exists {
+	expiresAt, err := s.timeoutStore.GetTimeout(ctx, platform, username)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get timeout: %w", err)
+	}
+	if expiresAt.IsZero() {
 		return 0, nil
 	}
 
-	remaining := time.Until(info.expiresAt)
+	remaining := time.Until(expiresAt)
 	if remaining < 0 {
 		return 0, nil
 	}
@@ -123,52 +81,51 @@ func (s *service) GetTimeoutPlatform(ctx context.Context, platform, username str
 // ReduceTimeoutPlatform reduces a user's timeout by the specified duration for a specific platform.
 func (s *service) ReduceTimeoutPlatform(ctx context.Context, platform, username string, reduction time.Duration) error {
 	log := logger.FromContext(ctx)
-	key := timeoutKey(platform, username)
 	log.Info("ReduceTimeoutPlatform called", "platform", platform, "username", username, "reduction", reduction)
 
-	s.timeoutMu.Lock()
-	defer s.timeoutMu.Unlock()
-
-	info, exists := s.timeouts[key]
-	if !exists {
+	expiresAt, err := s.timeoutStore.ReduceTimeout(ctx, platform, username, reduction)
+	if err != nil {
+		return fmt.Errorf("failed to reduce timeout: %w", err)
+	}
+	if expiresAt.IsZero() {
 		log.Info("User not timed out, nothing to reduce", "platform", platform, "username", username)
 		return nil
 	}
 
-	// Calculate new expiry time
-	newExpiresAt := info.expiresAt.Add(-reduction)
-	remaining := time.Until(newExpiresAt)
-
+	remaining := time.Until(expiresAt)
 	if remaining <= 0 {
 		// Timeout is fully reduced, remove it
-		info.timer.Stop()
-		delete(s.timeouts, key)
+		if err := s.timeoutStore.DeleteExpiredTimeout(ctx, platform, username); err != nil {
+			log.Warn("Failed to delete expired timeout", "platform", platform, "username", username, "error", err)
+		}
+		s.cancelTimeoutExpiry(platform, username)
 		log.Info("Timeout fully removed via reduction", "platform", platform, "username", username)
 
 		// Publish cleared event since timeout is gone
-		if s.eventBus != nil {
-			evt := event.NewTimeoutClearedEvent(platform, username)
-			if err := s.eventBus.Publish(ctx, evt); err != nil {
-				log.Warn("Failed to publish timeout cleared event", "error", err)
-			}
-		}
+		s.publishTimeoutCleared(ctx, platform, username)
 		return nil
 	}
 
-	// Update the timer with new duration
-	info.timer.Stop()
-	info.expiresAt = newExpiresAt
-	info.timer = time.AfterFunc(remaining, func() {
-		s.timeoutMu.Lock()
-		delete(s.timeouts, key)
-		s.timeoutMu.Unlock()
-		slog.Default().Info("User timeout expired", "platform", platform, "username", username)
-	})
-
+	s.scheduleTimeoutExpiry(platform, username, expiresAt)
 	log.Info("Timeout reduced", "platform", platform, "username", username, "newRemaining", remaining)
 	return nil
 }
 
+// RestoreTimeouts re-arms expiry cleanup for the timeouts still active in the
+// store. Call it once on startup.
+func (s *service) RestoreTimeouts(ctx context.Context) error {
+	timeouts, err := s.timeoutStore.GetActiveTimeouts(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to load active timeouts: %w", err)
+	}
+	for _, t := range timeouts {
+		s.scheduleTimeoutExpiry(t.Platform, t.Username, t.ExpiresAt)
+	}
+
+	logger.FromContext(ctx).Info("Restored active timeouts", "count", len(timeouts))
+	return nil
+}
+
 // TimeoutUser times out a user for a specified duration.
 // Note: This method REPLACES the existing timeout (does not accumulate).
 // For accumulating timeouts, use AddTimeout.
@@ -186,3 +143,54 @@ func (s *service) GetTimeout(ctx context.Context, username string) (time.Duratio
 func (s *service) ReduceTimeout(ctx context.Context, username string, reduction time.Duration) error {
 	return s.ReduceTimeoutPlatform(ctx, domain.PlatformTwitch, username, reduction)
 }
+
+// scheduleTimeoutExpiry (re)arms the timer that removes a timeout from the
+// store once it expires
+func (s *service) scheduleTimeoutExpiry(platform, username string, expiresAt time.Time) {
+	key := timeoutKey(platform, username)
+
+	s.timeoutMu.Lock()
+	defer s.timeoutMu.Unlock()
+
+	if existing, ok := s.timeoutTimers[key]; ok {
+		existing.Stop()
+	}
+	var timer *time.Timer
+	timer = time.AfterFunc(time.Until(expiresAt), func() {
+		s.timeoutMu.Lock()
+		if s.timeoutTimers[key] == timer {
+			delete(s.timeoutTimers, key)
+		}
+		s.timeoutMu.Unlock()
+
+		if err := s.timeoutStore.DeleteExpiredTimeout(context.Background(), platform, username); err != nil {
+			slog.Default().Warn("Failed to delete expired timeout", "platform", platform, "username", username, "error", err)
+			return
+		}
+		slog.Default().Info("User timeout expired", "platform", platform, "username", username)
+	})
+	s.timeoutTimers[key] = timer
+}
+
+// cancelTimeoutExpiry stops a timeout's expiry timer
+func (s *service) cancelTimeoutExpiry(platform, username string) {
+	key := timeoutKey(platform, username)
+
+	s.timeoutMu.Lock()
+	defer s.timeoutMu.Unlock()
+
+	if timer, ok := s.timeoutTimers[key]; ok {
+		timer.Stop()
+		delete(s.timeoutTimers, key)
+	}
+}
+
+func (s *service) publishTimeoutCleared(ctx context.Context, platform, username string) {
+	if s.eventBus == nil {
+		return
+	}
+	evt := event.NewTimeoutClearedEvent(platform, username)
+	if err := s.eventBus.Publish(ctx, evt); err != nil {
+		logger.FromContext(ctx).Warn("Failed to publish timeout cleared event", "error", err)
+	}
+}
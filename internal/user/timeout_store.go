@@ -0,0 +1,101 @@
+package user
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+)
+
+// memoryTimeoutStore is the default TimeoutStore. Its timeouts are lost on restart.
+type memoryTimeoutStore struct {
+	mu       sync.Mutex
+	timeouts map[string]domain.UserTimeout // Keyed by "platform:username"
+}
+
+func newMemoryTimeoutStore() *memoryTimeoutStore {
+	return &memoryTimeoutStore{timeouts: make(map[string]domain.UserTimeout)}
+}
+
+func (m *memoryTimeoutStore) AddTimeout(_ context.Context, platform, username string, duration time.Duration, reason string) (time.Time, error) {
+	key := timeoutKey(platform, username)
+	now := time.Now()
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	base := now
+	if t, ok := m.timeouts[key]; ok && t.ExpiresAt.After(now) {
+		base = t.ExpiresAt
+	}
+	t := domain.UserTimeout{Platform: platform, Username: username, Reason: reason, ExpiresAt: base.Add(duration)}
+	m.timeouts[key] = t
+	return t.ExpiresAt, nil
+}
+
+func (m *memoryTimeoutStore) GetTimeout(_ context.Context, platform, username string) (time.Time, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	t, ok := m.timeouts[timeoutKey(platform, username)]
+	if !ok || !t.ExpiresAt.After(time.Now()) {
+		return time.Time{}, nil
+	}
+	return t.ExpiresAt, nil
+}
+
+func (m *memoryTimeoutStore) ReduceTimeout(_ context.Context, platform, username string, reduction time.Duration) (time.Time, error) {
+	key := timeoutKey(platform, username)
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	t, ok := m.timeouts[key]
+	if !ok || !t.ExpiresAt.After(time.Now()) {
+		return time.Time{}, nil
+	}
+	t.ExpiresAt = t.ExpiresAt.Add(-reduction)
+	m.timeouts[key] = t
+	return t.ExpiresAt, nil
+}
+
+func (m *memoryTimeoutStore) ClearTimeout(_ context.Context, platform, username string) (bool, error) {
+	key := timeoutKey(platform, username)
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	t, ok := m.timeouts[key]
+	delete(m.timeouts, key)
+	return ok && t.ExpiresAt.After(time.Now()), nil
+}
+
+func (m *memoryTimeoutStore) DeleteExpiredTimeout(_ context.Context, platform, username string) error {
+	key := timeoutKey(platform, username)
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if t, ok := m.timeouts[key]; ok && !t.ExpiresAt.After(time.Now()) {
+		delete(m.timeouts, key)
+	}
+	return nil
+}
+
+func (m *memoryTimeoutStore) GetActiveTimeouts(_ context.Context) ([]domain.UserTimeout, error) {
+	now := time.Now()
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	active := make([]domain.UserTimeout, 0, len(m.timeouts))
+	for key, t := range m.timeouts {
+		if !t.ExpiresAt.After(now) {
+			delete(m.timeouts, key)
+			continue
+		}
+		active = append(active, t)
+	}
+	return active, nil
+}
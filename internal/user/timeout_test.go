@@ -2,6 +2,7 @@ package user
 
 import (
 	"context"
+	"errors"
 	"sync"
 	"testing"
 	"time"
@@ -389,3 +390,46 @@ func TestHandleBlaster_Timeout(t *testing.T) {
 		assert.Greater(t, timeout, time.Duration(0))
 	})
 }
+
+func TestTimeoutStore_SurvivesRestart(t *testing.T) {
+	ctx := context.Background()
+	store := newMemoryTimeoutStore()
+	repo := NewFakeRepository()
+	setupTestData(repo)
+
+	before := NewService(repo, repo, nil, nil, nil, NewMockNamingResolver(), nil, nil, nil, nil, false, WithTimeoutStore(store))
+	require.NoError(t, before.AddTimeout(ctx, domain.PlatformTwitch, "erin", time.Hour, "Bomb"))
+	require.NoError(t, before.AddTimeout(ctx, domain.PlatformTwitch, "frank", -time.Second, "Already over"))
+
+	// A new service over the same store stands in for a restarted server
+	after := NewService(repo, repo, nil, nil, nil, NewMockNamingResolver(), nil, nil, nil, nil, false, WithTimeoutStore(store))
+	require.NoError(t, after.RestoreTimeouts(ctx))
+
+	timeout, err := after.GetTimeoutPlatform(ctx, domain.PlatformTwitch, "erin")
+	require.NoError(t, err)
+	assert.Greater(t, timeout, 59*time.Minute)
+
+	active, err := store.GetActiveTimeouts(ctx)
+	require.NoError(t, err)
+	require.Len(t, active, 1)
+	assert.Equal(t, "erin", active[0].Username)
+	assert.Equal(t, "Bomb", active[0].Reason)
+}
+
+type failingTimeoutStore struct {
+	*memoryTimeoutStore
+}
+
+func (failingTimeoutStore) GetTimeout(context.Context, string, string) (time.Time, error) {
+	return time.Time{}, errors.New("db down")
+}
+
+func TestGetTimeoutPlatform_StoreError(t *testing.T) {
+	repo := NewFakeRepository()
+	svc := NewService(repo, repo, nil, nil, nil, NewMockNamingResolver(), nil, nil, nil, nil, false,
+		WithTimeoutStore(failingTimeoutStore{newMemoryTimeoutStore()}))
+
+	_, err := svc.GetTimeoutPlatform(context.Background(), domain.PlatformTwitch, "erin")
+
+	assert.Error(t, err)
+}
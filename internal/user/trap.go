@@ -18,9 +18,11 @@ func (s *service) triggerTrap(ctx context.Context, trap *domain.Trap, victim *do
 		return fmt.Errorf("failed to mark trap as triggered: %w", err)
 	}
 
-	// 2. Apply timeout
+	// 2. Apply timeout, unless the victim is immune
 	timeout := time.Duration(trap.CalculateTimeout()) * time.Second
-	if err := s.TimeoutUser(ctx, victim.Username, timeout, "BOOM! Stepped on a trap!"); err != nil {
+	if s.HasEffect(ctx, victim.ID, domain.EffectTimeoutImmunity) {
+		log.Info("Trap victim is immune to timeouts", "victim", victim.Username, "trap_id", trap.ID)
+	} else if err := s.TimeoutUser(ctx, victim.Username, timeout, "BOOM! Stepped on a trap!"); err != nil {
 		return fmt.Errorf("failed to timeout user: %w", err)
 	}
 
@@ -0,0 +1,38 @@
+package user
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+)
+
+// fakeUndo keeps the entries recorded for each user
+type fakeUndo map[string][]domain.UndoEntry
+
+func (f fakeUndo) Record(_ context.Context, userID string, action domain.UndoAction, changes []domain.InventoryChange) {
+	f[userID] = append(f[userID], domain.UndoEntry{UserID: userID, Action: action, Changes: changes})
+}
+
+func TestGiveItem_RecordsUndo(t *testing.T) {
+	repo := NewFakeRepository()
+	setupTestData(repo)
+	recorded := fakeUndo{}
+	svc := NewService(repo, repo, nil, nil, nil, NewMockNamingResolver(), nil, nil, nil, nil, false, WithUndo(recorded))
+	ctx := context.Background()
+
+	require.NoError(t, svc.AddItemByUsername(ctx, domain.PlatformTwitch, "alice", domain.ItemLootbox1, 5))
+	require.NoError(t, svc.GiveItem(ctx, domain.PlatformTwitch, "alice123", "alice", domain.PlatformTwitch, "bob", domain.ItemLootbox1, 2))
+
+	require.Len(t, recorded["user-alice"], 1)
+	entry := recorded["user-alice"][0]
+	assert.Equal(t, domain.UndoActionGive, entry.Action)
+	assert.ElementsMatch(t, []domain.InventoryChange{
+		{UserID: "user-alice", ItemID: 1, QualityLevel: domain.QualityCommon, Delta: -2},
+		{UserID: "user-bob", ItemID: 1, QualityLevel: domain.QualityCommon, Delta: 2},
+	}, entry.Changes)
+	assert.Empty(t, recorded["user-bob"])
+}
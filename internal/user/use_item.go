@@ -2,30 +2,36 @@ package user
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/osse101/BrandishBot_Go/internal/domain"
 	"github.com/osse101/BrandishBot_Go/internal/event"
 	"github.com/osse101/BrandishBot_Go/internal/itemhandler"
 	"github.com/osse101/BrandishBot_Go/internal/logger"
+	"github.com/osse101/BrandishBot_Go/internal/naming"
 	"github.com/osse101/BrandishBot_Go/internal/repository"
 	"github.com/osse101/BrandishBot_Go/internal/utils"
 )
 
 // useItemInternal handles item usage logic within a transaction
-func (s *service) useItemInternal(ctx context.Context, user *domain.User, platform, itemName string, quantity int, targetName string) (string, error) {
+func (s *service) useItemInternal(ctx context.Context, user *domain.User, platform, itemName string, quantity int, targetName string) (*itemhandler.UseResult, error) {
 	log := logger.FromContext(ctx)
 
 	itemToUse, err := s.getItemByNameCached(ctx, itemName)
 	if err != nil {
 		log.Error("Failed to get item", "error", err, "itemName", itemName)
-		return "", domain.ErrFailedToGetItem
+		return nil, domain.ErrFailedToGetItem
 	}
 	if itemToUse == nil {
 		log.Warn("Item not found", "itemName", itemName)
-		return "", domain.ErrItemNotFound
+		return nil, domain.ErrItemNotFound
+	}
+	if err := s.ensureUnlocked(ctx, user.ID, itemToUse); err != nil {
+		return nil, err
 	}
+	ctx = s.withNameTheme(ctx, user.ID)
 
-	var message string
+	result := &itemhandler.UseResult{}
 	var eventToPublish func()
 
 	err = s.withTx(ctx, func(txCtx context.Context, tx repository.UserTx) error {
@@ -54,29 +60,50 @@ func (s *service) useItemInternal(ctx context.Context, user *domain.User, platfo
 		handlerArgs := itemhandler.HandlerArgs{
 			Username: user.Username,
 			Platform: platform,
+			Result:   result,
 		}
 		if targetName != "" {
 			handlerArgs.TargetUsername = targetName
 			handlerArgs.JobName = targetName
 		}
-		message, err = handler.Handle(ctx, s, user, inventory, itemToUse, quantity, handlerArgs)
+		before := utils.BuildSlotMap(inventory)
+		result.Message, err = handler.Handle(ctx, s, user, inventory, itemToUse, quantity, handlerArgs)
 		if err != nil {
 			log.Error("Handler error", "error", err, "itemName", itemName)
 			return err
 		}
 
+		// Sell whatever the item produced that does not fit
+		soldSlots, soldFor, err := s.settleOverflow(txCtx, before, inventory)
+		if err != nil {
+			log.Error("Failed to settle inventory overflow", "error", err, "userID", user.ID)
+			return err
+		}
+		if soldSlots > 0 {
+			result.Message += " " + fmt.Sprintf(msgInventoryOverflowFmt, soldSlots, soldFor)
+		}
+
 		if err := tx.UpdateInventory(ctx, user.ID, *inventory); err != nil {
 			log.Error("Failed to update inventory after use", "error", err, "userID", user.ID)
 			return domain.ErrFailedToUpdateInventory
 		}
 
+		metadata := map[string]interface{}{"target": targetName}
+		if result.Drops != nil {
+			// Lootbox openings carry what they dropped, which feeds the jackpot
+			value := 0
+			for _, drop := range result.Drops {
+				value += drop.Value
+			}
+			metadata[domain.MetadataKeyLootboxValue] = value
+		}
 		eventToPublish = func() {
 			if s.publisher != nil {
 				s.publisher.PublishWithRetry(ctx, event.NewItemUsedEvent(
 					user.ID,
 					itemToUse.InternalName,
 					quantity,
-					map[string]interface{}{"target": targetName},
+					metadata,
 				))
 			}
 		}
@@ -88,10 +115,22 @@ func (s *service) useItemInternal(ctx context.Context, user *domain.User, platfo
 		eventToPublish()
 	}
 
-	return message, err
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
 }
 
 func (s *service) UseItem(ctx context.Context, platform, platformID, username, itemName string, quantity int, targetName string) (string, error) {
+	result, err := s.UseItemDetailed(ctx, platform, platformID, username, itemName, quantity, targetName)
+	if err != nil {
+		return "", err
+	}
+	return result.Message, nil
+}
+
+// UseItemDetailed uses an item and returns the message together with structured results
+func (s *service) UseItemDetailed(ctx context.Context, platform, platformID, username, itemName string, quantity int, targetName string) (*itemhandler.UseResult, error) {
 	log := logger.FromContext(ctx)
 	log.Info("UseItem called",
 		"platform", platform, "platformID", platformID, "username", username,
@@ -100,22 +139,33 @@ func (s *service) UseItem(ctx context.Context, platform, platformID, username, i
 	user, err := s.getUserOrRegister(ctx, platform, platformID, username)
 	if err != nil {
 		log.Error("Failed to get user or register", "error", err)
-		return "", domain.ErrFailedToGetUser
+		return nil, domain.ErrFailedToGetUser
 	}
 
 	// Resolve public name to internal name
 	resolvedName, err := s.resolveItemName(ctx, itemName)
 	if err != nil {
 		log.Error("Failed to resolve item name", "error", err)
-		return "", domain.ErrInvalidInput
+		return nil, err
 	}
 
-	return s.useItemInternal(ctx, user, platform, resolvedName, quantity, targetName)
+	var result *itemhandler.UseResult
+	ctx = event.WithInventoryReason(ctx, domain.ActionUseItem)
+	err = s.withCooldown(ctx, user.ID, domain.ActionUseItem, func() error {
+		var useErr error
+		result, useErr = s.useItemInternal(ctx, user, platform, resolvedName, quantity, targetName)
+		return useErr
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
 }
 
 // resolveItemName attempts to resolve a user-provided item name to its internal name.
 // It first tries the naming resolver, then falls back to using the input as-is.
 // This allows users to use either public names ("junkbox") or internal names ("lootbox_tier0").
+// Unknown names get an error carrying "did you mean" suggestions.
 func (s *service) resolveItemName(ctx context.Context, itemName string) (string, error) {
 	log := logger.FromContext(ctx)
 	// Try naming resolver first (handles public names)
@@ -133,7 +183,7 @@ func (s *service) resolveItemName(ctx context.Context, itemName string) (string,
 	}
 	if item == nil {
 		log.Warn("Item not found", "itemName", itemName)
-		return "", domain.ErrItemNotFound
+		return "", naming.ItemNotFound(s.namingResolver, itemName)
 	}
 
 	return itemName, nil
@@ -150,7 +200,7 @@ func (s *service) validateItem(ctx context.Context, itemName string) (*domain.It
 	}
 	if item == nil {
 		log.Error("Item not found", "itemName", itemName)
-		return nil, domain.ErrItemNotFound
+		return nil, naming.ItemNotFound(s.namingResolver, itemName)
 	}
 	return item, nil
 }
@@ -0,0 +1,8 @@
+package usersettings
+
+// Log messages
+const (
+	LogMsgLeaderboardPrivacySet = "Leaderboard privacy updated"
+	LogMsgTargetingOptOutSet    = "Targeting opt-out updated"
+	LogMsgNameThemeSet          = "Name theme updated"
+)
@@ -0,0 +1,22 @@
+package usersettings
+
+import (
+	"context"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+)
+
+// Repository stores per-user settings
+type Repository interface {
+	// GetSettings returns a user's settings, or the defaults if none are stored
+	GetSettings(ctx context.Context, userID string) (*domain.UserSettings, error)
+
+	// SetLeaderboardPrivate hides or shows the user on public rankings
+	SetLeaderboardPrivate(ctx context.Context, userID string, private bool) error
+
+	// SetTargetingOptOut opts the user out of targeted items, or back in
+	SetTargetingOptOut(ctx context.Context, userID string, optOut bool) error
+
+	// SetNameTheme stores the item name theme the user picked; empty clears it
+	SetNameTheme(ctx context.Context, userID, theme string) error
+}
@@ -0,0 +1,181 @@
+package usersettings
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/logger"
+	"github.com/osse101/BrandishBot_Go/internal/naming"
+)
+
+// Service reads and updates per-user settings. Leaderboard privacy is
+// enforced by the stats and progression read paths, and the targeting
+// opt-out by the item handlers, not here.
+type Service interface {
+	GetSettings(ctx context.Context, platform, platformID string) (*domain.UserSettings, error)
+	SetLeaderboardPrivate(ctx context.Context, platform, platformID, username string, private bool) (*domain.UserSettings, error)
+	SetTargetingOptOut(ctx context.Context, platform, platformID, username string, optOut bool) (*domain.UserSettings, error)
+	GetNameThemes(ctx context.Context, platform, platformID string) (*domain.NameThemes, error)
+	SetNameTheme(ctx context.Context, platform, platformID, username, theme string) (*domain.UserSettings, error)
+}
+
+// UserService defines the user operations needed by the settings service
+type UserService interface {
+	GetUserOrRegister(ctx context.Context, platform, platformID, username string) (*domain.User, error)
+	GetUserIDByPlatformID(ctx context.Context, platform, platformID string) (string, error)
+}
+
+// ThemeSource lists the configured item name themes
+type ThemeSource interface {
+	GetThemes() map[string]naming.ThemePeriod
+	GetActiveTheme() string
+}
+
+// FeatureChecker reports whether a progression feature is unlocked
+type FeatureChecker interface {
+	IsFeatureUnlocked(ctx context.Context, featureKey string) (bool, error)
+}
+
+type service struct {
+	repo     Repository
+	users    UserService
+	themes   ThemeSource    // Nil offers no themes to pick
+	features FeatureChecker // Nil treats every theme as unlocked
+}
+
+// Option configures optional settings service dependencies
+type Option func(*service)
+
+// WithNameThemes lets users pick an item name theme. Themes with a feature
+// key can only be picked once progression unlocks that feature.
+func WithNameThemes(themes ThemeSource, features FeatureChecker) Option {
+	return func(s *service) {
+		s.themes = themes
+		s.features = features
+	}
+}
+
+// NewService creates a user settings service
+func NewService(repo Repository, users UserService, opts ...Option) Service {
+	s := &service{repo: repo, users: users}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+// GetSettings returns the user's settings
+func (s *service) GetSettings(ctx context.Context, platform, platformID string) (*domain.UserSettings, error) {
+	userID, err := s.users.GetUserIDByPlatformID(ctx, platform, platformID)
+	if err != nil {
+		return nil, err
+	}
+	if userID == "" {
+		return nil, domain.ErrUserNotFound
+	}
+	return s.repo.GetSettings(ctx, userID)
+}
+
+// SetLeaderboardPrivate hides or shows the user on public rankings, registering the user if needed
+func (s *service) SetLeaderboardPrivate(ctx context.Context, platform, platformID, username string, private bool) (*domain.UserSettings, error) {
+	user, err := s.users.GetUserOrRegister(ctx, platform, platformID, username)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.repo.SetLeaderboardPrivate(ctx, user.ID, private); err != nil {
+		return nil, err
+	}
+	logger.FromContext(ctx).Info(LogMsgLeaderboardPrivacySet, "user_id", user.ID, "private", private)
+	return s.repo.GetSettings(ctx, user.ID)
+}
+
+// SetTargetingOptOut opts the user out of targeted items, or back in, registering the user if needed
+func (s *service) SetTargetingOptOut(ctx context.Context, platform, platformID, username string, optOut bool) (*domain.UserSettings, error) {
+	user, err := s.users.GetUserOrRegister(ctx, platform, platformID, username)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.repo.SetTargetingOptOut(ctx, user.ID, optOut); err != nil {
+		return nil, err
+	}
+	logger.FromContext(ctx).Info(LogMsgTargetingOptOutSet, "user_id", user.ID, "opt_out", optOut)
+	return s.repo.GetSettings(ctx, user.ID)
+}
+
+// GetNameThemes lists the item name themes, marking the ones progression has
+// unlocked, and the user's pick. Unregistered users have no pick yet.
+func (s *service) GetNameThemes(ctx context.Context, platform, platformID string) (*domain.NameThemes, error) {
+	result := &domain.NameThemes{Themes: []domain.NameTheme{}}
+	if s.themes == nil {
+		return result, nil
+	}
+
+	userID, err := s.users.GetUserIDByPlatformID(ctx, platform, platformID)
+	if err != nil {
+		return nil, err
+	}
+	if userID != "" {
+		settings, err := s.repo.GetSettings(ctx, userID)
+		if err != nil {
+			return nil, err
+		}
+		result.Selected = settings.NameTheme
+	}
+	result.Active = s.themes.GetActiveTheme()
+
+	for name, period := range s.themes.GetThemes() {
+		unlocked, err := s.themeUnlocked(ctx, period)
+		if err != nil {
+			return nil, err
+		}
+		result.Themes = append(result.Themes, domain.NameTheme{
+			Name:     name,
+			Start:    period.Start,
+			End:      period.End,
+			Unlocked: unlocked,
+		})
+	}
+	sort.Slice(result.Themes, func(i, j int) bool { return result.Themes[i].Name < result.Themes[j].Name })
+	return result, nil
+}
+
+// SetNameTheme stores the user's item name theme, registering the user if
+// needed. An empty theme goes back to following the calendar.
+func (s *service) SetNameTheme(ctx context.Context, platform, platformID, username, theme string) (*domain.UserSettings, error) {
+	if theme != "" {
+		if s.themes == nil {
+			return nil, fmt.Errorf("%w: %s", domain.ErrUnknownNameTheme, theme)
+		}
+		period, ok := s.themes.GetThemes()[theme]
+		if !ok {
+			return nil, fmt.Errorf("%w: %s", domain.ErrUnknownNameTheme, theme)
+		}
+		unlocked, err := s.themeUnlocked(ctx, period)
+		if err != nil {
+			return nil, err
+		}
+		if !unlocked {
+			return nil, fmt.Errorf("%w: theme %s needs %s", domain.ErrFeatureLocked, theme, period.FeatureKey)
+		}
+	}
+
+	user, err := s.users.GetUserOrRegister(ctx, platform, platformID, username)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.repo.SetNameTheme(ctx, user.ID, theme); err != nil {
+		return nil, err
+	}
+	logger.FromContext(ctx).Info(LogMsgNameThemeSet, "user_id", user.ID, "theme", theme)
+	return s.repo.GetSettings(ctx, user.ID)
+}
+
+// themeUnlocked reports whether progression lets users pick the theme
+func (s *service) themeUnlocked(ctx context.Context, period naming.ThemePeriod) (bool, error) {
+	if period.FeatureKey == "" || s.features == nil {
+		return true, nil
+	}
+	return s.features.IsFeatureUnlocked(ctx, period.FeatureKey)
+}
@@ -0,0 +1,126 @@
+package usersettings_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/naming"
+	"github.com/osse101/BrandishBot_Go/internal/usersettings"
+	"github.com/osse101/BrandishBot_Go/internal/usersettings/mocks"
+)
+
+func TestSetLeaderboardPrivate(t *testing.T) {
+	ctx := context.Background()
+	repo := mocks.NewMockRepository(t)
+	users := mocks.NewMockUserService(t)
+	users.On("GetUserOrRegister", ctx, domain.PlatformDiscord, "d-1", "alice").Return(&domain.User{ID: "user-1"}, nil)
+	repo.On("SetLeaderboardPrivate", ctx, "user-1", true).Return(nil)
+	repo.On("GetSettings", ctx, "user-1").Return(&domain.UserSettings{LeaderboardPrivate: true}, nil)
+
+	settings, err := usersettings.NewService(repo, users).SetLeaderboardPrivate(ctx, domain.PlatformDiscord, "d-1", "alice", true)
+
+	require.NoError(t, err)
+	assert.True(t, settings.LeaderboardPrivate)
+}
+
+func TestSetTargetingOptOut(t *testing.T) {
+	ctx := context.Background()
+	repo := mocks.NewMockRepository(t)
+	users := mocks.NewMockUserService(t)
+	users.On("GetUserOrRegister", ctx, domain.PlatformDiscord, "d-1", "alice").Return(&domain.User{ID: "user-1"}, nil)
+	repo.On("SetTargetingOptOut", ctx, "user-1", true).Return(nil)
+	repo.On("GetSettings", ctx, "user-1").Return(&domain.UserSettings{TargetingOptOut: true}, nil)
+
+	settings, err := usersettings.NewService(repo, users).SetTargetingOptOut(ctx, domain.PlatformDiscord, "d-1", "alice", true)
+
+	require.NoError(t, err)
+	assert.True(t, settings.TargetingOptOut)
+}
+
+func TestGetSettings_UnknownUser(t *testing.T) {
+	ctx := context.Background()
+	repo := mocks.NewMockRepository(t)
+	users := mocks.NewMockUserService(t)
+	users.On("GetUserIDByPlatformID", ctx, domain.PlatformDiscord, "d-1").Return("", nil)
+
+	_, err := usersettings.NewService(repo, users).GetSettings(ctx, domain.PlatformDiscord, "d-1")
+
+	assert.ErrorIs(t, err, domain.ErrUserNotFound)
+}
+
+func TestGetNameThemes(t *testing.T) {
+	ctx := context.Background()
+	repo := mocks.NewMockRepository(t)
+	users := mocks.NewMockUserService(t)
+	themes := mocks.NewMockThemeSource(t)
+	features := mocks.NewMockFeatureChecker(t)
+	users.On("GetUserIDByPlatformID", ctx, domain.PlatformDiscord, "d-1").Return("user-1", nil)
+	repo.On("GetSettings", ctx, "user-1").Return(&domain.UserSettings{NameTheme: "christmas"}, nil)
+	themes.On("GetActiveTheme").Return("")
+	themes.On("GetThemes").Return(map[string]naming.ThemePeriod{
+		"halloween": {Start: "10-15", End: "11-02", FeatureKey: "feature_events"},
+		"christmas": {Start: "12-15", End: "01-05"},
+	})
+	features.On("IsFeatureUnlocked", ctx, "feature_events").Return(false, nil)
+
+	svc := usersettings.NewService(repo, users, usersettings.WithNameThemes(themes, features))
+	result, err := svc.GetNameThemes(ctx, domain.PlatformDiscord, "d-1")
+
+	require.NoError(t, err)
+	assert.Equal(t, "christmas", result.Selected)
+	assert.Equal(t, []domain.NameTheme{
+		{Name: "christmas", Start: "12-15", End: "01-05", Unlocked: true},
+		{Name: "halloween", Start: "10-15", End: "11-02", Unlocked: false},
+	}, result.Themes)
+}
+
+func TestSetNameTheme(t *testing.T) {
+	ctx := context.Background()
+	periods := map[string]naming.ThemePeriod{
+		"halloween": {Start: "10-15", End: "11-02", FeatureKey: "feature_events"},
+	}
+
+	t.Run("stores an unlocked theme", func(t *testing.T) {
+		repo := mocks.NewMockRepository(t)
+		users := mocks.NewMockUserService(t)
+		themes := mocks.NewMockThemeSource(t)
+		features := mocks.NewMockFeatureChecker(t)
+		themes.On("GetThemes").Return(periods)
+		features.On("IsFeatureUnlocked", ctx, "feature_events").Return(true, nil)
+		users.On("GetUserOrRegister", ctx, domain.PlatformDiscord, "d-1", "alice").Return(&domain.User{ID: "user-1"}, nil)
+		repo.On("SetNameTheme", ctx, "user-1", "halloween").Return(nil)
+		repo.On("GetSettings", ctx, "user-1").Return(&domain.UserSettings{NameTheme: "halloween"}, nil)
+
+		svc := usersettings.NewService(repo, users, usersettings.WithNameThemes(themes, features))
+		settings, err := svc.SetNameTheme(ctx, domain.PlatformDiscord, "d-1", "alice", "halloween")
+
+		require.NoError(t, err)
+		assert.Equal(t, "halloween", settings.NameTheme)
+	})
+
+	t.Run("rejects a locked theme", func(t *testing.T) {
+		themes := mocks.NewMockThemeSource(t)
+		features := mocks.NewMockFeatureChecker(t)
+		themes.On("GetThemes").Return(periods)
+		features.On("IsFeatureUnlocked", ctx, "feature_events").Return(false, nil)
+
+		svc := usersettings.NewService(mocks.NewMockRepository(t), mocks.NewMockUserService(t), usersettings.WithNameThemes(themes, features))
+		_, err := svc.SetNameTheme(ctx, domain.PlatformDiscord, "d-1", "alice", "halloween")
+
+		assert.ErrorIs(t, err, domain.ErrFeatureLocked)
+	})
+
+	t.Run("rejects an unknown theme", func(t *testing.T) {
+		themes := mocks.NewMockThemeSource(t)
+		themes.On("GetThemes").Return(periods)
+
+		svc := usersettings.NewService(mocks.NewMockRepository(t), mocks.NewMockUserService(t), usersettings.WithNameThemes(themes, nil))
+		_, err := svc.SetNameTheme(ctx, domain.PlatformDiscord, "d-1", "alice", "easter")
+
+		assert.ErrorIs(t, err, domain.ErrUnknownNameTheme)
+	})
+}
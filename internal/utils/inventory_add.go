@@ -47,3 +47,29 @@ func AddItemsToInventory(inventory *domain.Inventory, items []domain.InventorySl
 		}
 	}
 }
+
+// AdjustSlotQuantity applies delta to the (item, quality) slot the same way
+// the database's atomic adjustment does: the slot is created when missing,
+// removed at zero, and a result below zero leaves the inventory unchanged
+// and returns domain.ErrInsufficientQuantity.
+func AdjustSlotQuantity(inventory *domain.Inventory, itemID int, qualityLevel domain.QualityLevel, delta int) (int, error) {
+	slotIndex, current := FindSlotWithQuality(inventory, itemID, qualityLevel)
+	newQuantity := current + delta
+	if newQuantity < 0 {
+		return 0, domain.ErrInsufficientQuantity
+	}
+
+	switch {
+	case slotIndex == -1 && newQuantity > 0:
+		inventory.Slots = append(inventory.Slots, domain.InventorySlot{
+			ItemID:       itemID,
+			Quantity:     newQuantity,
+			QualityLevel: qualityLevel,
+		})
+	case slotIndex != -1 && newQuantity == 0:
+		inventory.Slots = append(inventory.Slots[:slotIndex], inventory.Slots[slotIndex+1:]...)
+	case slotIndex != -1:
+		inventory.Slots[slotIndex].Quantity = newQuantity
+	}
+	return newQuantity, nil
+}
@@ -322,3 +322,54 @@ func BenchmarkAddItemsWithPrebuiltMap(b *testing.B) {
 		AddItemsToInventory(testInv, items, slotMap)
 	}
 }
+
+func TestAdjustSlotQuantity(t *testing.T) {
+	newInventory := func() *domain.Inventory {
+		return &domain.Inventory{Slots: []domain.InventorySlot{
+			{ItemID: 10, Quantity: 5, QualityLevel: domain.QualityCommon},
+			{ItemID: 10, Quantity: 2, QualityLevel: domain.QualityRare},
+			{ItemID: 20, Quantity: 1},
+		}}
+	}
+
+	t.Run("adds to the slot with the matching quality", func(t *testing.T) {
+		inventory := newInventory()
+
+		qty, err := AdjustSlotQuantity(inventory, 10, domain.QualityRare, 3)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 5, qty)
+		assert.Equal(t, 5, inventory.Slots[0].Quantity)
+		assert.Equal(t, 5, inventory.Slots[1].Quantity)
+	})
+
+	t.Run("creates a missing slot", func(t *testing.T) {
+		inventory := newInventory()
+
+		qty, err := AdjustSlotQuantity(inventory, 30, domain.QualityEpic, 4)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 4, qty)
+		assert.Equal(t, domain.InventorySlot{ItemID: 30, Quantity: 4, QualityLevel: domain.QualityEpic}, inventory.Slots[3])
+	})
+
+	t.Run("removes a slot that reaches zero", func(t *testing.T) {
+		inventory := newInventory()
+
+		qty, err := AdjustSlotQuantity(inventory, 10, domain.QualityCommon, -5)
+
+		assert.NoError(t, err)
+		assert.Zero(t, qty)
+		assert.Len(t, inventory.Slots, 2)
+		assert.Equal(t, domain.QualityRare, inventory.Slots[0].QualityLevel)
+	})
+
+	t.Run("rejects going below zero without changing anything", func(t *testing.T) {
+		inventory := newInventory()
+
+		_, err := AdjustSlotQuantity(inventory, 10, domain.QualityRare, -3)
+
+		assert.ErrorIs(t, err, domain.ErrInsufficientQuantity)
+		assert.Equal(t, newInventory(), inventory)
+	})
+}
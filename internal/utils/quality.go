@@ -58,6 +58,19 @@ func GetQualityMultiplier(q domain.QualityLevel) float64 {
 	}
 }
 
+// ShiftQuality moves a quality level up (or down) by steps, staying within
+// CURSED..LEGENDARY. An unset quality counts as COMMON.
+func ShiftQuality(q domain.QualityLevel, steps int) domain.QualityLevel {
+	v := GetQualityValue(q) + steps
+	if v < 0 {
+		v = 0
+	}
+	if v >= len(valueToQuality) {
+		v = len(valueToQuality) - 1
+	}
+	return valueToQuality[v]
+}
+
 func CalculateAverageQuality(materials []domain.InventorySlot) domain.QualityLevel {
 	if len(materials) == 0 {
 		return domain.QualityCommon
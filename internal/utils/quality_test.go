@@ -123,6 +123,28 @@ func TestCalculateAverageQuality(t *testing.T) {
 }
 
 // TestConsumeItemsWithTracking verifies consumption tracking with quality levels
+func TestShiftQuality(t *testing.T) {
+	tests := []struct {
+		name    string
+		quality domain.QualityLevel
+		steps   int
+		want    domain.QualityLevel
+	}{
+		{"up one step", domain.QualityCommon, 1, domain.QualityUncommon},
+		{"up three steps", domain.QualityUncommon, 3, domain.QualityLegendary},
+		{"caps at legendary", domain.QualityEpic, 3, domain.QualityLegendary},
+		{"down one step", domain.QualityCommon, -1, domain.QualityPoor},
+		{"floors at cursed", domain.QualityJunk, -5, domain.QualityCursed},
+		{"unset counts as common", "", 2, domain.QualityRare},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, ShiftQuality(tt.quality, tt.steps))
+		})
+	}
+}
+
 func TestConsumeItemsWithTracking(t *testing.T) {
 	t.Run("tracks consumed items with quality", func(t *testing.T) {
 		inventory := &domain.Inventory{
@@ -0,0 +1,63 @@
+package webhook
+
+import (
+	"time"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/event"
+)
+
+// JobType identifies webhook delivery runs in the worker pool and scheduler
+const JobType = "webhook_delivery"
+
+// SupportedEventTypes are the events webhooks can subscribe to
+var SupportedEventTypes = []event.Type{
+	event.ProgressionNodeUnlocked,
+	event.Type(domain.EventGambleCompleted),
+	event.Type(domain.EventTypeJobLevelUp),
+}
+
+// Defaults, used when the configured values are not positive
+const (
+	// DefaultMaxAttempts is how many times a delivery is tried before it is
+	// marked failed
+	DefaultMaxAttempts = 6
+	// DefaultBatchSize caps how many deliveries one run sends
+	DefaultBatchSize = 20
+	// DefaultRetryBaseDelay is the wait after the first failed attempt; it
+	// doubles with each further failure
+	DefaultRetryBaseDelay = 30 * time.Second
+	// DefaultRequestTimeout bounds one POST to a webhook endpoint
+	DefaultRequestTimeout = 10 * time.Second
+)
+
+// MaxRetryDelay caps the wait between attempts
+const MaxRetryDelay = time.Hour
+
+// Delivery history limits
+const (
+	DefaultHistoryLimit = 50
+	MaxHistoryLimit     = 500
+)
+
+// SecretBytes is how many random bytes make up a signing secret
+const SecretBytes = 32
+
+// MaxErrorLength caps the error text stored with a failed attempt
+const MaxErrorLength = 500
+
+// Request headers. Deliveries are signed the same way as the inventory sync
+// webhook, so receivers can share verification code.
+const (
+	// DeliveryHeader carries the delivery ID, which stays the same across
+	// retries so receivers can drop duplicates
+	DeliveryHeader = "X-BrandishBot-Delivery"
+)
+
+// Log messages
+const (
+	LogMsgWebhookRegistered = "Webhook registered"
+	LogMsgWebhookDeleted    = "Webhook deleted"
+	LogMsgDeliveriesSent    = "Sent due webhook deliveries"
+	LogMsgDeliveryFailed    = "Webhook delivery failed"
+)
@@ -0,0 +1,24 @@
+package webhook
+
+import "context"
+
+// Job sends webhook deliveries that are due
+type Job struct {
+	service Service
+}
+
+// NewJob creates a webhook delivery job
+func NewJob(service Service) *Job {
+	return &Job{service: service}
+}
+
+// JobType returns the worker pool job type
+func (j *Job) JobType() string {
+	return JobType
+}
+
+// Process sends one batch of due deliveries
+func (j *Job) Process(ctx context.Context) error {
+	_, err := j.service.DeliverDue(ctx)
+	return err
+}
@@ -0,0 +1,60 @@
+package webhook
+
+import (
+	"context"
+	"time"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+)
+
+// Repository stores webhooks and their delivery history
+type Repository interface {
+	// CreateWebhook stores a webhook with its signing secret
+	CreateWebhook(ctx context.Context, webhook domain.Webhook, secret string) (*domain.Webhook, error)
+
+	// ListWebhooks returns every webhook, oldest first, without secrets
+	ListWebhooks(ctx context.Context) ([]domain.Webhook, error)
+
+	// DeleteWebhook removes a webhook and its delivery history, and returns
+	// false if there is no webhook with that ID
+	DeleteWebhook(ctx context.Context, id int64) (bool, error)
+
+	WebhookExists(ctx context.Context, id int64) (bool, error)
+
+	// EnqueueDeliveries queues body for every webhook subscribed to
+	// eventType and returns how many deliveries were queued
+	EnqueueDeliveries(ctx context.Context, eventType string, body []byte) (int, error)
+
+	// ClaimDueDeliveries returns up to limit pending deliveries due at or
+	// before now, and holds them until leaseUntil so no other caller claims
+	// them in the meantime
+	ClaimDueDeliveries(ctx context.Context, now, leaseUntil time.Time, limit int) ([]PendingDelivery, error)
+
+	// RecordAttempt stores the outcome of one delivery attempt
+	RecordAttempt(ctx context.Context, id int64, result AttemptResult) error
+
+	// ListDeliveries returns a webhook's most recent deliveries, newest first
+	ListDeliveries(ctx context.Context, webhookID int64, limit int) ([]domain.WebhookDelivery, error)
+}
+
+// PendingDelivery is a claimed delivery along with where to send it
+type PendingDelivery struct {
+	ID        int64
+	WebhookID int64
+	EventType string
+	Body      []byte
+	// Attempts made before this one
+	Attempts int
+	URL      string
+	Secret   string
+}
+
+// AttemptResult is the outcome of one delivery attempt
+type AttemptResult struct {
+	Status domain.WebhookDeliveryStatus
+	// NextAttemptAt is when a pending delivery is retried
+	NextAttemptAt time.Time
+	// StatusCode is the endpoint's response status, or 0 without a response
+	StatusCode int
+	Error      string
+}
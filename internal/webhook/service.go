@@ -0,0 +1,320 @@
+// Package webhook sends selected events to URLs registered by admins. Each
+// event is stored as one delivery per subscribed webhook and POSTed by a
+// scheduled job, which retries failures with backoff and keeps the history.
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/event"
+	"github.com/osse101/BrandishBot_Go/internal/inventorysync"
+	"github.com/osse101/BrandishBot_Go/internal/logger"
+)
+
+// Service manages webhooks and delivers events to them
+type Service interface {
+	// Register adds a webhook and returns it with its signing secret, which
+	// cannot be shown again
+	Register(ctx context.Context, req RegisterRequest) (*RegisteredWebhook, error)
+
+	// ListWebhooks returns every webhook without its secret
+	ListWebhooks(ctx context.Context) ([]domain.Webhook, error)
+
+	// DeleteWebhook removes a webhook and its delivery history. It returns
+	// domain.ErrWebhookNotFound for unknown webhooks.
+	DeleteWebhook(ctx context.Context, id int64) error
+
+	// GetDeliveries returns a webhook's most recent deliveries, newest first
+	GetDeliveries(ctx context.Context, webhookID int64, limit int) ([]domain.WebhookDelivery, error)
+
+	// Subscribe queues a delivery for every supported event published on
+	// this instance
+	Subscribe(bus event.Bus)
+
+	// DeliverDue sends pending deliveries that are due and reports how many
+	// succeeded
+	DeliverDue(ctx context.Context) (int, error)
+}
+
+// RegisterRequest asks for a webhook
+type RegisterRequest struct {
+	URL        string
+	EventTypes []string
+	// Description says what the webhook is for
+	Description string
+	CreatedBy   string
+}
+
+// RegisteredWebhook is a new webhook along with its signing secret
+type RegisteredWebhook struct {
+	domain.Webhook
+	Secret string `json:"secret"`
+}
+
+// Config tunes webhook delivery
+type Config struct {
+	// MaxAttempts is how many times a delivery is tried before it fails
+	MaxAttempts int
+	// BatchSize caps how many deliveries one run sends
+	BatchSize int
+	// RetryBaseDelay is the wait after the first failure, doubled after each
+	// further one
+	RetryBaseDelay time.Duration
+	// RequestTimeout bounds each POST
+	RequestTimeout time.Duration
+}
+
+type service struct {
+	repo   Repository
+	client *http.Client
+	cfg    Config
+	now    func() time.Time
+}
+
+// NewService creates a webhook service
+func NewService(repo Repository, cfg Config) Service {
+	if cfg.MaxAttempts <= 0 {
+		cfg.MaxAttempts = DefaultMaxAttempts
+	}
+	if cfg.BatchSize <= 0 {
+		cfg.BatchSize = DefaultBatchSize
+	}
+	if cfg.RetryBaseDelay <= 0 {
+		cfg.RetryBaseDelay = DefaultRetryBaseDelay
+	}
+	if cfg.RequestTimeout <= 0 {
+		cfg.RequestTimeout = DefaultRequestTimeout
+	}
+	return &service{
+		repo:   repo,
+		client: &http.Client{Timeout: cfg.RequestTimeout},
+		cfg:    cfg,
+		now:    time.Now,
+	}
+}
+
+// Register validates the URL and event types and generates a secret
+func (s *service) Register(ctx context.Context, req RegisterRequest) (*RegisteredWebhook, error) {
+	if req.CreatedBy == "" {
+		return nil, fmt.Errorf("%w: creator is required", domain.ErrInvalidInput)
+	}
+	parsed, err := url.Parse(req.URL)
+	if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+		return nil, fmt.Errorf("%w: url must be an absolute http or https URL", domain.ErrInvalidInput)
+	}
+	if len(req.EventTypes) == 0 {
+		return nil, fmt.Errorf("%w: at least one event type is required", domain.ErrInvalidInput)
+	}
+
+	var eventTypes []string
+	for _, eventType := range req.EventTypes {
+		if !slices.Contains(SupportedEventTypes, event.Type(eventType)) {
+			return nil, fmt.Errorf("%w: unsupported event type %q", domain.ErrInvalidInput, eventType)
+		}
+		if !slices.Contains(eventTypes, eventType) {
+			eventTypes = append(eventTypes, eventType)
+		}
+	}
+
+	secret, err := generateSecret()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate webhook secret: %w", err)
+	}
+
+	webhook, err := s.repo.CreateWebhook(ctx, domain.Webhook{
+		URL:         req.URL,
+		EventTypes:  eventTypes,
+		Description: req.Description,
+		CreatedBy:   req.CreatedBy,
+	}, secret)
+	if err != nil {
+		return nil, err
+	}
+
+	logger.FromContext(ctx).Info(LogMsgWebhookRegistered, "id", webhook.ID, "url", webhook.URL, "event_types", webhook.EventTypes, "created_by", webhook.CreatedBy)
+	return &RegisteredWebhook{Webhook: *webhook, Secret: secret}, nil
+}
+
+// ListWebhooks returns webhooks without their secrets
+func (s *service) ListWebhooks(ctx context.Context) ([]domain.Webhook, error) {
+	return s.repo.ListWebhooks(ctx)
+}
+
+// DeleteWebhook deletes a webhook; its queued deliveries go with it
+func (s *service) DeleteWebhook(ctx context.Context, id int64) error {
+	deleted, err := s.repo.DeleteWebhook(ctx, id)
+	if err != nil {
+		return err
+	}
+	if !deleted {
+		return domain.ErrWebhookNotFound
+	}
+
+	logger.FromContext(ctx).Info(LogMsgWebhookDeleted, "id", id)
+	return nil
+}
+
+// GetDeliveries clamps limit and checks the webhook exists, so an unknown ID
+// is reported rather than returning an empty history
+func (s *service) GetDeliveries(ctx context.Context, webhookID int64, limit int) ([]domain.WebhookDelivery, error) {
+	if limit <= 0 {
+		limit = DefaultHistoryLimit
+	}
+	limit = min(limit, MaxHistoryLimit)
+
+	exists, err := s.repo.WebhookExists(ctx, webhookID)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, domain.ErrWebhookNotFound
+	}
+	return s.repo.ListDeliveries(ctx, webhookID, limit)
+}
+
+// Subscribe registers a handler per supported event type. It subscribes
+// locally so that with several instances each event is queued once, by the
+// instance that published it.
+func (s *service) Subscribe(bus event.Bus) {
+	for _, eventType := range SupportedEventTypes {
+		bus.Subscribe(eventType, s.handleEvent)
+	}
+	slog.Info("Webhook event handlers registered", "event_types", SupportedEventTypes)
+}
+
+// handleEvent stores the event as a delivery for each subscribed webhook
+func (s *service) handleEvent(ctx context.Context, evt event.Event) error {
+	body, err := json.Marshal(event.Event{
+		Version: evt.Version,
+		Type:    evt.Type,
+		Payload: evt.Payload,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to encode %s event for webhooks: %w", evt.Type, err)
+	}
+
+	if _, err := s.repo.EnqueueDeliveries(ctx, string(evt.Type), body); err != nil {
+		return fmt.Errorf("failed to queue %s webhook deliveries: %w", evt.Type, err)
+	}
+	return nil
+}
+
+// DeliverDue claims a batch and sends it one delivery at a time. The claim is
+// held long enough for every request in the batch to time out, so a
+// concurrent run never sends the same delivery twice.
+func (s *service) DeliverDue(ctx context.Context) (int, error) {
+	now := s.now()
+	leaseUntil := now.Add(time.Duration(s.cfg.BatchSize+1) * s.cfg.RequestTimeout)
+
+	due, err := s.repo.ClaimDueDeliveries(ctx, now, leaseUntil, s.cfg.BatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	log := logger.FromContext(ctx)
+	delivered := 0
+	for _, delivery := range due {
+		statusCode, sendErr := s.send(ctx, delivery)
+		result := s.attemptResult(delivery, statusCode, sendErr)
+		if sendErr != nil {
+			log.Warn(LogMsgDeliveryFailed, "id", delivery.ID, "webhook_id", delivery.WebhookID, "attempt", delivery.Attempts+1, "status", result.Status, "error", sendErr)
+		} else {
+			delivered++
+		}
+
+		if err := s.repo.RecordAttempt(ctx, delivery.ID, result); err != nil {
+			return delivered, err
+		}
+	}
+
+	if len(due) > 0 {
+		log.Info(LogMsgDeliveriesSent, "claimed", len(due), "delivered", delivered)
+	}
+	return delivered, nil
+}
+
+// attemptResult decides what happens to a delivery after an attempt
+func (s *service) attemptResult(delivery PendingDelivery, statusCode int, sendErr error) AttemptResult {
+	now := s.now()
+	if sendErr == nil {
+		return AttemptResult{Status: domain.WebhookDeliveryDelivered, NextAttemptAt: now, StatusCode: statusCode}
+	}
+
+	result := AttemptResult{
+		Status:        domain.WebhookDeliveryPending,
+		NextAttemptAt: now.Add(s.retryDelay(delivery.Attempts)),
+		StatusCode:    statusCode,
+		Error:         truncate(sendErr.Error(), MaxErrorLength),
+	}
+	if delivery.Attempts+1 >= s.cfg.MaxAttempts {
+		result.Status = domain.WebhookDeliveryFailed
+		result.NextAttemptAt = now
+	}
+	return result
+}
+
+// retryDelay doubles the base delay for each earlier failure, up to MaxRetryDelay
+func (s *service) retryDelay(previousAttempts int) time.Duration {
+	delay := s.cfg.RetryBaseDelay
+	for i := 0; i < previousAttempts && delay < MaxRetryDelay; i++ {
+		delay *= 2
+	}
+	return min(delay, MaxRetryDelay)
+}
+
+// send POSTs one delivery. It returns the response status, or 0 when there
+// was no response, and an error unless the endpoint answered 2xx.
+func (s *service) send(ctx context.Context, delivery PendingDelivery) (int, error) {
+	ctx, cancel := context.WithTimeout(ctx, s.cfg.RequestTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, delivery.URL, bytes.NewReader(delivery.Body))
+	if err != nil {
+		return 0, fmt.Errorf("failed to build webhook request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(inventorysync.EventTypeHeader, delivery.EventType)
+	req.Header.Set(DeliveryHeader, strconv.FormatInt(delivery.ID, 10))
+	req.Header.Set(inventorysync.SignatureHeader, inventorysync.SignaturePrefix+inventorysync.Sign(delivery.Secret, delivery.Body))
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("failed to post webhook: %w", err)
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return resp.StatusCode, fmt.Errorf("webhook returned status %d", resp.StatusCode)
+	}
+	return resp.StatusCode, nil
+}
+
+// generateSecret returns random hex for signing deliveries
+func generateSecret() (string, error) {
+	bytes := make([]byte, SecretBytes)
+	if _, err := rand.Read(bytes); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(bytes), nil
+}
+
+func truncate(s string, limit int) string {
+	if len(s) <= limit {
+		return s
+	}
+	return s[:limit]
+}
@@ -0,0 +1,225 @@
+package webhook_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/osse101/BrandishBot_Go/internal/domain"
+	"github.com/osse101/BrandishBot_Go/internal/event"
+	"github.com/osse101/BrandishBot_Go/internal/inventorysync"
+	"github.com/osse101/BrandishBot_Go/internal/webhook"
+	"github.com/osse101/BrandishBot_Go/internal/webhook/mocks"
+)
+
+func TestRegister(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("stores the webhook with deduplicated event types and returns its secret", func(t *testing.T) {
+		repo := mocks.NewMockRepository(t)
+		svc := webhook.NewService(repo, webhook.Config{})
+
+		var storedSecret string
+		repo.On("CreateWebhook", ctx, mock.MatchedBy(func(h domain.Webhook) bool {
+			return h.URL == "https://example.com/hook" &&
+				assert.ObjectsAreEqual([]string{"progression.node_unlocked", "job_level_up"}, h.EventTypes)
+		}), mock.Anything).Return(func(_ context.Context, h domain.Webhook, secret string) (*domain.Webhook, error) {
+			storedSecret = secret
+			h.ID = 7
+			return &h, nil
+		})
+
+		registered, err := svc.Register(ctx, webhook.RegisterRequest{
+			URL:        "https://example.com/hook",
+			EventTypes: []string{"progression.node_unlocked", "job_level_up", "progression.node_unlocked"},
+			CreatedBy:  "admin",
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, int64(7), registered.ID)
+		assert.Len(t, registered.Secret, 2*webhook.SecretBytes)
+		assert.Equal(t, storedSecret, registered.Secret)
+	})
+
+	for name, req := range map[string]webhook.RegisterRequest{
+		"relative url":       {URL: "/hook", EventTypes: []string{"job_level_up"}, CreatedBy: "admin"},
+		"unsupported scheme": {URL: "ftp://example.com", EventTypes: []string{"job_level_up"}, CreatedBy: "admin"},
+		"no event types":     {URL: "https://example.com", CreatedBy: "admin"},
+		"unsupported event":  {URL: "https://example.com", EventTypes: []string{"inventory.changed"}, CreatedBy: "admin"},
+		"missing created_by": {URL: "https://example.com", EventTypes: []string{"job_level_up"}},
+	} {
+		t.Run("rejects "+name, func(t *testing.T) {
+			svc := webhook.NewService(mocks.NewMockRepository(t), webhook.Config{})
+
+			_, err := svc.Register(ctx, req)
+
+			assert.ErrorIs(t, err, domain.ErrInvalidInput)
+		})
+	}
+}
+
+func TestDeleteWebhook_NotFound(t *testing.T) {
+	ctx := context.Background()
+	repo := mocks.NewMockRepository(t)
+	svc := webhook.NewService(repo, webhook.Config{})
+	repo.On("DeleteWebhook", ctx, int64(3)).Return(false, nil)
+
+	err := svc.DeleteWebhook(ctx, 3)
+
+	assert.ErrorIs(t, err, domain.ErrWebhookNotFound)
+}
+
+func TestGetDeliveries(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("clamps the limit", func(t *testing.T) {
+		repo := mocks.NewMockRepository(t)
+		svc := webhook.NewService(repo, webhook.Config{})
+		repo.On("WebhookExists", ctx, int64(1)).Return(true, nil)
+		repo.On("ListDeliveries", ctx, int64(1), webhook.MaxHistoryLimit).Return([]domain.WebhookDelivery{{ID: 9}}, nil)
+
+		deliveries, err := svc.GetDeliveries(ctx, 1, 10000)
+
+		require.NoError(t, err)
+		assert.Len(t, deliveries, 1)
+	})
+
+	t.Run("reports unknown webhooks", func(t *testing.T) {
+		repo := mocks.NewMockRepository(t)
+		svc := webhook.NewService(repo, webhook.Config{})
+		repo.On("WebhookExists", ctx, int64(2)).Return(false, nil)
+
+		_, err := svc.GetDeliveries(ctx, 2, 0)
+
+		assert.ErrorIs(t, err, domain.ErrWebhookNotFound)
+	})
+}
+
+func TestSubscribe_QueuesSupportedEvents(t *testing.T) {
+	ctx := context.Background()
+	repo := mocks.NewMockRepository(t)
+	svc := webhook.NewService(repo, webhook.Config{})
+	bus := event.NewMemoryBus()
+	svc.Subscribe(bus)
+
+	repo.On("EnqueueDeliveries", mock.Anything, "job_level_up", mock.MatchedBy(func(body []byte) bool {
+		var decoded map[string]interface{}
+		return json.Unmarshal(body, &decoded) == nil &&
+			decoded["type"] == "job_level_up" &&
+			decoded["payload"].(map[string]interface{})["job_key"] == "blacksmith"
+	})).Return(1, nil)
+
+	err := bus.Publish(ctx, event.NewJobLevelUpEvent("user-1", "alice", "twitch", "blacksmith", 1, 2, "craft"))
+
+	require.NoError(t, err)
+}
+
+type received struct {
+	body      []byte
+	signature string
+	delivery  string
+	eventType string
+}
+
+func newEndpoint(t *testing.T, status int) (*httptest.Server, chan received) {
+	t.Helper()
+	got := make(chan received, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		got <- received{
+			body:      body,
+			signature: r.Header.Get(inventorysync.SignatureHeader),
+			delivery:  r.Header.Get(webhook.DeliveryHeader),
+			eventType: r.Header.Get(inventorysync.EventTypeHeader),
+		}
+		w.WriteHeader(status)
+	}))
+	t.Cleanup(server.Close)
+	return server, got
+}
+
+func TestDeliverDue(t *testing.T) {
+	ctx := context.Background()
+	body := []byte(`{"version":"1.0","type":"job_level_up","payload":{}}`)
+
+	t.Run("signs and sends deliveries and records success", func(t *testing.T) {
+		server, got := newEndpoint(t, http.StatusNoContent)
+		repo := mocks.NewMockRepository(t)
+		svc := webhook.NewService(repo, webhook.Config{})
+
+		repo.On("ClaimDueDeliveries", ctx, mock.Anything, mock.Anything, webhook.DefaultBatchSize).Return([]webhook.PendingDelivery{
+			{ID: 42, WebhookID: 1, EventType: "job_level_up", Body: body, URL: server.URL, Secret: "s3cret"},
+		}, nil)
+		repo.On("RecordAttempt", ctx, int64(42), mock.MatchedBy(func(r webhook.AttemptResult) bool {
+			return r.Status == domain.WebhookDeliveryDelivered && r.StatusCode == http.StatusNoContent && r.Error == ""
+		})).Return(nil)
+
+		delivered, err := svc.DeliverDue(ctx)
+
+		require.NoError(t, err)
+		assert.Equal(t, 1, delivered)
+		req := <-got
+		assert.Equal(t, body, req.body)
+		assert.Equal(t, inventorysync.SignaturePrefix+inventorysync.Sign("s3cret", body), req.signature)
+		assert.Equal(t, "42", req.delivery)
+		assert.Equal(t, "job_level_up", req.eventType)
+	})
+
+	t.Run("schedules a retry with backoff after a failure", func(t *testing.T) {
+		server, _ := newEndpoint(t, http.StatusInternalServerError)
+		repo := mocks.NewMockRepository(t)
+		svc := webhook.NewService(repo, webhook.Config{RetryBaseDelay: time.Minute})
+		before := time.Now()
+
+		repo.On("ClaimDueDeliveries", ctx, mock.Anything, mock.Anything, mock.Anything).Return([]webhook.PendingDelivery{
+			{ID: 5, Body: body, Attempts: 2, URL: server.URL, Secret: "s"},
+		}, nil)
+		repo.On("RecordAttempt", ctx, int64(5), mock.MatchedBy(func(r webhook.AttemptResult) bool {
+			return r.Status == domain.WebhookDeliveryPending &&
+				r.StatusCode == http.StatusInternalServerError &&
+				r.Error != "" &&
+				!r.NextAttemptAt.Before(before.Add(4*time.Minute))
+		})).Return(nil)
+
+		delivered, err := svc.DeliverDue(ctx)
+
+		require.NoError(t, err)
+		assert.Zero(t, delivered)
+	})
+
+	t.Run("marks the delivery failed on its last attempt", func(t *testing.T) {
+		server, _ := newEndpoint(t, http.StatusBadGateway)
+		repo := mocks.NewMockRepository(t)
+		svc := webhook.NewService(repo, webhook.Config{MaxAttempts: 3})
+
+		repo.On("ClaimDueDeliveries", ctx, mock.Anything, mock.Anything, mock.Anything).Return([]webhook.PendingDelivery{
+			{ID: 6, Body: body, Attempts: 2, URL: server.URL, Secret: "s"},
+		}, nil)
+		repo.On("RecordAttempt", ctx, int64(6), mock.MatchedBy(func(r webhook.AttemptResult) bool {
+			return r.Status == domain.WebhookDeliveryFailed && r.StatusCode == http.StatusBadGateway
+		})).Return(nil)
+
+		_, err := svc.DeliverDue(ctx)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("returns claim errors", func(t *testing.T) {
+		repo := mocks.NewMockRepository(t)
+		svc := webhook.NewService(repo, webhook.Config{})
+		repo.On("ClaimDueDeliveries", ctx, mock.Anything, mock.Anything, mock.Anything).Return(nil, errors.New("db down"))
+
+		_, err := svc.DeliverDue(ctx)
+
+		assert.Error(t, err)
+	})
+}
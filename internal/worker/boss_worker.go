@@ -0,0 +1,118 @@
+package worker
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/osse101/BrandishBot_Go/internal/boss"
+	"github.com/osse101/BrandishBot_Go/internal/community"
+	"github.com/osse101/BrandishBot_Go/internal/event"
+	"github.com/osse101/BrandishBot_Go/internal/logger"
+)
+
+// BossWorker lets bosses escape when their time runs out
+type BossWorker struct {
+	BaseWorker
+	service     boss.Service
+	communities []string
+}
+
+// NewBossWorker creates a new BossWorker. Start looks for an active boss in
+// each of the given communities, or only the default one if none are given.
+func NewBossWorker(service boss.Service, communities ...string) *BossWorker {
+	if len(communities) == 0 {
+		communities = []string{community.Default}
+	}
+	w := &BossWorker{
+		service:     service,
+		communities: communities,
+	}
+	w.init()
+	return w
+}
+
+// Start checks each community for an active boss on startup and schedules
+// its expiry
+func (w *BossWorker) Start() {
+	for _, ctx := range community.Each(context.Background(), w.communities) {
+		active, err := w.service.GetActiveBoss(ctx)
+		if err != nil {
+			logger.FromContext(ctx).Error(LogMsgFailedToCheckActiveBossOnStartup, "community", community.FromContext(ctx), "error", err)
+			continue
+		}
+		if active != nil {
+			w.scheduleExpiry(community.FromContext(ctx), active.ID, active.ExpiresAt)
+		}
+	}
+}
+
+// Subscribe subscribes the worker to relevant events
+func (w *BossWorker) Subscribe(bus event.Bus) {
+	bus.Subscribe(event.BossSpawned, w.handleBossSpawned)
+}
+
+func (w *BossWorker) handleBossSpawned(ctx context.Context, e event.Event) error {
+	payload, err := event.DecodePayload[event.BossSpawnedPayloadV1](e.Payload)
+	if err != nil {
+		return nil
+	}
+	id, err := uuid.Parse(payload.BossID)
+	if err != nil {
+		return nil
+	}
+	w.scheduleExpiry(community.FromContext(ctx), id, time.Unix(payload.ExpiresAt, 0))
+	return nil
+}
+
+func (w *BossWorker) scheduleExpiry(communityID string, bossID uuid.UUID, expiresAt time.Time) {
+	// Add 1 second buffer to ensure we are past the expiry
+	duration := time.Until(expiresAt) + time.Second
+
+	log := logger.FromContext(context.Background())
+	log.Info(LogMsgSchedulingBossExpiry, "bossID", bossID, "duration", duration)
+
+	if duration <= 0 {
+		w.expireBoss(communityID, bossID)
+		return
+	}
+
+	w.stopTimer(bossID)
+
+	timer := time.AfterFunc(duration, func() {
+		select {
+		case <-w.shutdown:
+			return
+		default:
+		}
+
+		w.expireBoss(communityID, bossID)
+		w.removeTimer(bossID)
+	})
+
+	w.registerTimer(bossID, timer)
+}
+
+// expireBoss lets a boss of the given community escape in a tracked
+// goroutine. A boss defeated in the meantime is left alone.
+func (w *BossWorker) expireBoss(communityID string, bossID uuid.UUID) {
+	w.wg.Add(1)
+	go func() {
+		defer w.wg.Done()
+
+		ctx := community.WithID(context.Background(), communityID)
+		log := logger.FromContext(ctx)
+		log.Info(LogMsgExpiringScheduledBoss, "bossID", bossID)
+
+		if _, err := w.service.Expire(ctx, bossID); err != nil {
+			log.Error(LogMsgFailedToExpireBoss, "bossID", bossID, "error", err)
+		}
+	}()
+}
+
+// Shutdown gracefully shuts down the boss worker, canceling all pending timers
+// and waiting for any in-flight expiries to complete
+func (w *BossWorker) Shutdown(ctx context.Context) error {
+	return w.shutdownInternal(ctx, "boss worker")
+}
@@ -4,8 +4,15 @@ package worker
 // Log Messages - Worker Pool
 // ============================================================================
 
-// LogMsgWorkerJobFailed is logged when a worker fails to process a job
-const LogMsgWorkerJobFailed = "Worker job failed"
+// Log messages for worker pool operations
+const (
+	LogMsgWorkerJobFailed         = "Worker job failed"
+	LogMsgWorkerJobRejected       = "Worker queue full, job rejected"
+	LogMsgWorkerQueuedJobsDropped = "Worker pool stopped with queued jobs, dropping them"
+)
+
+// JobTypeDefault is the job type for jobs that do not implement TypedJob
+const JobTypeDefault = "default"
 
 // ============================================================================
 // Log Messages - Gamble Worker
@@ -31,6 +38,18 @@ const (
 	LogMsgFailedToExecuteExpedition              = "Failed to execute expedition"
 )
 
+// ============================================================================
+// Log Messages - Boss Worker
+// ============================================================================
+
+// Log messages for boss worker operations
+const (
+	LogMsgFailedToCheckActiveBossOnStartup = "Failed to check active boss on startup"
+	LogMsgSchedulingBossExpiry             = "Scheduling boss expiry"
+	LogMsgExpiringScheduledBoss            = "Expiring scheduled boss"
+	LogMsgFailedToExpireBoss               = "Failed to expire boss"
+)
+
 // ============================================================================
 // Log Messages - Daily Reset Worker
 // ============================================================================
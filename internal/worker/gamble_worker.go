@@ -6,6 +6,7 @@ import (
 
 	"github.com/google/uuid"
 
+	"github.com/osse101/BrandishBot_Go/internal/community"
 	"github.com/osse101/BrandishBot_Go/internal/domain"
 	"github.com/osse101/BrandishBot_Go/internal/event"
 	"github.com/osse101/BrandishBot_Go/internal/gamble"
@@ -15,31 +16,42 @@ import (
 // GambleWorker checks for expired gambles and executes them
 type GambleWorker struct {
 	BaseWorker
-	service gamble.Service
+	service     gamble.Service
+	communities []string
 }
 
-// NewGambleWorker creates a new GambleWorker
-func NewGambleWorker(service gamble.Service) *GambleWorker {
+// NewGambleWorker creates a new GambleWorker. Start looks for an active gamble
+// in each of the given communities, or only the default one if none are given.
+func NewGambleWorker(service gamble.Service, communities ...string) *GambleWorker {
+	if len(communities) == 0 {
+		communities = []string{community.Default}
+	}
 	w := &GambleWorker{
-		service: service,
+		service:     service,
+		communities: communities,
 	}
 	w.init()
 	return w
 }
 
-// Start checks for any existing active gamble on startup and schedules it
+// Start checks each community for an existing active gamble on startup and
+// schedules it
 func (w *GambleWorker) Start() {
-	ctx := context.Background()
-	log := logger.FromContext(ctx)
+	for _, ctx := range community.Each(context.Background(), w.communities) {
+		log := logger.FromContext(ctx)
 
-	active, err := w.service.GetActiveGamble(ctx)
-	if err != nil {
-		log.Error(LogMsgFailedToCheckActiveGambleOnStartup, "error", err)
-		return
-	}
+		active, err := w.service.GetActiveGamble(ctx)
+		if err != nil {
+			log.Error(LogMsgFailedToCheckActiveGambleOnStartup, "community", community.FromContext(ctx), "error", err)
+			continue
+		}
 
-	if active != nil && (active.State == domain.GambleStateJoining || active.State == domain.GambleStateOpening) {
-		w.scheduleExecution(active)
+		if active != nil && (active.State == domain.GambleStateJoining || active.State == domain.GambleStateOpening) {
+			if active.CommunityID == "" {
+				active.CommunityID = community.FromContext(ctx)
+			}
+			w.scheduleExecution(active)
+		}
 	}
 }
 
@@ -53,6 +65,9 @@ func (w *GambleWorker) handleGambleStarted(ctx context.Context, e event.Event) e
 	if !ok {
 		return nil
 	}
+	if gamble.CommunityID == "" {
+		gamble.CommunityID = community.FromContext(ctx)
+	}
 	w.scheduleExecution(gamble)
 	return nil
 }
@@ -66,7 +81,7 @@ func (w *GambleWorker) scheduleExecution(g *domain.Gamble) {
 
 	// If deadline has already passed, execute immediately in a goroutine
 	if duration <= 0 {
-		w.executeGamble(g.ID)
+		w.executeGamble(g.CommunityID, g.ID)
 		return
 	}
 
@@ -83,7 +98,7 @@ func (w *GambleWorker) scheduleExecution(g *domain.Gamble) {
 		}
 
 		// Execute the gamble
-		w.executeGamble(g.ID)
+		w.executeGamble(g.CommunityID, g.ID)
 
 		// Clean up timer reference
 		w.removeTimer(g.ID)
@@ -92,13 +107,13 @@ func (w *GambleWorker) scheduleExecution(g *domain.Gamble) {
 	w.registerTimer(g.ID, timer)
 }
 
-// executeGamble executes a gamble in a tracked goroutine
-func (w *GambleWorker) executeGamble(gambleID uuid.UUID) {
+// executeGamble executes a gamble of the given community in a tracked goroutine
+func (w *GambleWorker) executeGamble(communityID string, gambleID uuid.UUID) {
 	w.wg.Add(1)
 	go func() {
 		defer w.wg.Done()
 
-		ctx := context.Background()
+		ctx := community.WithID(context.Background(), communityID)
 		log := logger.FromContext(ctx)
 		log.Info(LogMsgExecutingScheduledGamble, "gambleID", gambleID)
 
@@ -2,9 +2,14 @@ package worker
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"sync"
+	"time"
 
+	"github.com/osse101/BrandishBot_Go/internal/community"
 	"github.com/osse101/BrandishBot_Go/internal/logger"
+	"github.com/osse101/BrandishBot_Go/internal/metrics"
 )
 
 // Job represents a task to be executed by a worker
@@ -12,21 +17,147 @@ type Job interface {
 	Process(ctx context.Context) error
 }
 
-// Pool represents a worker pool
+// Priority orders queued jobs. Higher priorities are always dequeued first.
+type Priority int
+
+// Job priorities
+const (
+	PriorityLow Priority = iota
+	PriorityNormal
+	PriorityHigh
+)
+
+// String returns the priority name used in logs and metric labels
+func (p Priority) String() string {
+	switch p {
+	case PriorityLow:
+		return "low"
+	case PriorityHigh:
+		return "high"
+	default:
+		return "normal"
+	}
+}
+
+// PrioritizedJob is a Job that declares its own priority. Jobs that do not
+// implement it run at PriorityNormal.
+type PrioritizedJob interface {
+	Job
+	Priority() Priority
+}
+
+// TypedJob is a Job that declares its type for per-type concurrency limits
+// and metrics. Jobs that do not implement it use JobTypeDefault.
+type TypedJob interface {
+	Job
+	JobType() string
+}
+
+// Errors returned when the pool cannot accept a job
+var (
+	// ErrQueueFull is returned by TryEnqueue when the queue has no free slot
+	ErrQueueFull = errors.New("worker queue is full")
+	// ErrPoolStopped is returned once Stop has been called. It wraps
+	// context.Canceled so callers checking for cancellation still match.
+	ErrPoolStopped = fmt.Errorf("worker pool stopped: %w", context.Canceled)
+)
+
+// WithPriority wraps a job so it is queued at the given priority
+func WithPriority(job Job, priority Priority) Job {
+	return &prioritizedJob{Job: job, priority: priority}
+}
+
+type prioritizedJob struct {
+	Job
+	priority Priority
+}
+
+func (j *prioritizedJob) Priority() Priority { return j.priority }
+
+// JobType forwards the wrapped job's type so wrapping keeps its concurrency limit
+func (j *prioritizedJob) JobType() string { return jobTypeOf(j.Job) }
+
+// PerCommunity wraps a job that maintains per-community state so each run
+// processes every given community in turn, with the context scoped to it. A
+// failing community does not stop the others; the errors are joined.
+func PerCommunity(job Job, communities []string) Job {
+	return &communityJob{Job: job, communities: communities}
+}
+
+type communityJob struct {
+	Job
+	communities []string
+}
+
+func (j *communityJob) Process(ctx context.Context) error {
+	var errs []error
+	for _, id := range j.communities {
+		if err := j.Job.Process(community.WithID(ctx, id)); err != nil {
+			errs = append(errs, fmt.Errorf("community %s: %w", id, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// JobType forwards the wrapped job's type so wrapping keeps its concurrency limit
+func (j *communityJob) JobType() string { return jobTypeOf(j.Job) }
+
+// queuedJob is a job waiting in the pool together with its resolved metadata
+type queuedJob struct {
+	job      Job
+	jobType  string
+	priority Priority
+	queuedAt time.Time
+}
+
+// Pool represents a worker pool with priority queues, per-type concurrency
+// limits, and a bounded capacity.
+//
+// Capacity counts queued and running jobs together, so a pool created with
+// NewPool(w, q) holds at most w+q jobs before Enqueue blocks and TryEnqueue
+// rejects.
 type Pool struct {
-	workers  int
-	jobQueue chan Job
-	wg       sync.WaitGroup
-	quit     chan struct{}
+	workers int
+
+	// slots bounds the number of queued plus running jobs
+	slots chan struct{}
+
+	mu         sync.Mutex
+	cond       *sync.Cond
+	queues     [PriorityHigh + 1][]queuedJob
+	typeLimits map[string]int
+	running    map[string]int
+	stopped    bool
+
+	wg   sync.WaitGroup
+	quit chan struct{}
 }
 
 // NewPool creates a new worker pool
 func NewPool(workers int, queueSize int) *Pool {
-	return &Pool{
-		workers:  workers,
-		jobQueue: make(chan Job, queueSize),
-		quit:     make(chan struct{}),
+	p := &Pool{
+		workers:    workers,
+		slots:      make(chan struct{}, workers+queueSize),
+		typeLimits: make(map[string]int),
+		running:    make(map[string]int),
+		quit:       make(chan struct{}),
+	}
+	p.cond = sync.NewCond(&p.mu)
+	return p
+}
+
+// SetTypeLimit caps how many jobs of the given type run at once. A limit of
+// zero or less removes the cap. Queued jobs of a saturated type wait while
+// other jobs are picked up.
+func (p *Pool) SetTypeLimit(jobType string, limit int) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if limit <= 0 {
+		delete(p.typeLimits, jobType)
+	} else {
+		p.typeLimits[jobType] = limit
 	}
+	p.cond.Broadcast()
 }
 
 // Start starts the workers
@@ -41,43 +172,192 @@ func (p *Pool) Start() {
 func (p *Pool) worker() {
 	defer p.wg.Done()
 	for {
-		select {
-		case job := <-p.jobQueue:
-			// Create a background context for the job
-			// In a real app, we might want to pass a context with timeout
-			ctx := context.Background()
-			if err := job.Process(ctx); err != nil {
-				// Log job error but continue worker loop; ideally inject logger into Pool.
-				logger.FromContext(ctx).Error(LogMsgWorkerJobFailed, "error", err)
-			}
-		case <-p.quit:
+		qj, ok := p.next()
+		if !ok {
 			return
 		}
+
+		metrics.WorkerJobWait.WithLabelValues(qj.priority.String()).Observe(time.Since(qj.queuedAt).Seconds())
+		metrics.WorkerJobsRunning.WithLabelValues(qj.jobType).Inc()
+
+		// Create a background context for the job
+		ctx := context.Background()
+		if err := qj.job.Process(ctx); err != nil {
+			// Log job error but continue worker loop
+			logger.FromContext(ctx).Error(LogMsgWorkerJobFailed, "job_type", qj.jobType, "error", err)
+		}
+
+		metrics.WorkerJobsRunning.WithLabelValues(qj.jobType).Dec()
+		p.finish(qj.jobType)
 	}
 }
 
-// Enqueue adds a job to the queue
-// It returns true if the job was enqueued, false if the queue is full (non-blocking)
-// or we could make it blocking. For now, let's make it blocking but with a select to avoid deadlocks on stop?
-// Actually, for simplicity, let's just send to channel.
+// next blocks until a runnable job is available or the pool stops
+func (p *Pool) next() (queuedJob, bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for {
+		if p.stopped {
+			return queuedJob{}, false
+		}
+		if qj, ok := p.dequeueLocked(); ok {
+			p.running[qj.jobType]++
+			return qj, true
+		}
+		p.cond.Wait()
+	}
+}
+
+// dequeueLocked removes the oldest job of the highest priority whose type is
+// below its concurrency limit
+func (p *Pool) dequeueLocked() (queuedJob, bool) {
+	for prio := PriorityHigh; prio >= PriorityLow; prio-- {
+		queue := p.queues[prio]
+		for i, qj := range queue {
+			if limit, ok := p.typeLimits[qj.jobType]; ok && p.running[qj.jobType] >= limit {
+				continue
+			}
+			p.queues[prio] = append(queue[:i], queue[i+1:]...)
+			metrics.WorkerQueueDepth.WithLabelValues(prio.String()).Dec()
+			return qj, true
+		}
+	}
+	return queuedJob{}, false
+}
+
+// finish releases the job's type and capacity slots
+func (p *Pool) finish(jobType string) {
+	p.mu.Lock()
+	p.running[jobType]--
+	// A freed type slot may unblock a job that another worker skipped
+	p.cond.Broadcast()
+	p.mu.Unlock()
+	<-p.slots
+}
+
+// Enqueue adds a job to the queue, blocking while the pool is at capacity.
+// Jobs enqueued after Stop are dropped.
 func (p *Pool) Enqueue(job Job) {
-	p.jobQueue <- job
+	_ = p.EnqueueContext(context.Background(), job)
 }
 
-// EnqueueContext adds a job to the queue with context cancellation support
+// EnqueueContext adds a job to the queue, blocking while the pool is at
+// capacity until ctx is done or the pool stops
 func (p *Pool) EnqueueContext(ctx context.Context, job Job) error {
 	select {
-	case p.jobQueue <- job:
-		return nil
+	case <-p.quit:
+		return ErrPoolStopped
+	default:
+	}
+
+	select {
+	case p.slots <- struct{}{}:
+		return p.push(job)
 	case <-ctx.Done():
 		return ctx.Err()
 	case <-p.quit:
-		return context.Canceled
+		return ErrPoolStopped
 	}
 }
 
-// Stop stops the workers and waits for them to finish
+// TryEnqueue adds a job without blocking. It returns ErrQueueFull when the
+// pool is at capacity so callers can shed load or report backpressure.
+func (p *Pool) TryEnqueue(job Job) error {
+	select {
+	case <-p.quit:
+		return ErrPoolStopped
+	default:
+	}
+
+	select {
+	case p.slots <- struct{}{}:
+		return p.push(job)
+	default:
+		jobType := jobTypeOf(job)
+		metrics.WorkerJobsRejected.WithLabelValues(jobType).Inc()
+		logger.FromContext(context.Background()).Warn(LogMsgWorkerJobRejected, "job_type", jobType)
+		return ErrQueueFull
+	}
+}
+
+// push places a job that already holds a capacity slot on its priority queue
+func (p *Pool) push(job Job) error {
+	qj := queuedJob{
+		job:      job,
+		jobType:  jobTypeOf(job),
+		priority: priorityOf(job),
+		queuedAt: time.Now(),
+	}
+
+	p.mu.Lock()
+	if p.stopped {
+		p.mu.Unlock()
+		<-p.slots
+		return ErrPoolStopped
+	}
+	p.queues[qj.priority] = append(p.queues[qj.priority], qj)
+	metrics.WorkerQueueDepth.WithLabelValues(qj.priority.String()).Inc()
+	p.cond.Signal()
+	p.mu.Unlock()
+	return nil
+}
+
+// QueueDepth returns the number of queued jobs per priority
+func (p *Pool) QueueDepth() map[Priority]int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	depth := make(map[Priority]int, len(p.queues))
+	for prio, queue := range p.queues {
+		depth[Priority(prio)] = len(queue)
+	}
+	return depth
+}
+
+// Stop stops the workers and waits for running jobs to finish. Jobs still
+// queued are dropped.
 func (p *Pool) Stop() {
+	p.mu.Lock()
+	if p.stopped {
+		p.mu.Unlock()
+		return
+	}
+	p.stopped = true
 	close(p.quit)
+
+	dropped := 0
+	for prio, queue := range p.queues {
+		dropped += len(queue)
+		metrics.WorkerQueueDepth.WithLabelValues(Priority(prio).String()).Sub(float64(len(queue)))
+		p.queues[prio] = nil
+	}
+	p.cond.Broadcast()
+	p.mu.Unlock()
+
+	if dropped > 0 {
+		logger.FromContext(context.Background()).Warn(LogMsgWorkerQueuedJobsDropped, "count", dropped)
+	}
 	p.wg.Wait()
 }
+
+func priorityOf(job Job) Priority {
+	if pj, ok := job.(PrioritizedJob); ok {
+		prio := pj.Priority()
+		if prio < PriorityLow {
+			return PriorityLow
+		}
+		if prio > PriorityHigh {
+			return PriorityHigh
+		}
+		return prio
+	}
+	return PriorityNormal
+}
+
+func jobTypeOf(job Job) string {
+	if tj, ok := job.(TypedJob); ok {
+		if jobType := tj.JobType(); jobType != "" {
+			return jobType
+		}
+	}
+	return JobTypeDefault
+}
@@ -10,6 +10,8 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	"github.com/osse101/BrandishBot_Go/internal/community"
 )
 
 type testJob struct {
@@ -184,3 +186,153 @@ func TestPool_EnqueueContext(t *testing.T) {
 		close(bJob.block)
 	})
 }
+
+type typedJob struct {
+	jobType  string
+	priority Priority
+	run      func()
+}
+
+func (j *typedJob) Process(ctx context.Context) error {
+	j.run()
+	return nil
+}
+
+func (j *typedJob) JobType() string    { return j.jobType }
+func (j *typedJob) Priority() Priority { return j.priority }
+
+func TestPool_Priority(t *testing.T) {
+	t.Parallel()
+
+	pool := NewPool(1, 10)
+
+	var mu sync.Mutex
+	var order []string
+	var wg sync.WaitGroup
+	record := func(name string) func() {
+		return func() {
+			mu.Lock()
+			order = append(order, name)
+			mu.Unlock()
+			wg.Done()
+		}
+	}
+
+	// Enqueue before starting so all jobs compete for the single worker
+	wg.Add(3)
+	pool.Enqueue(&typedJob{priority: PriorityLow, run: record("low")})
+	pool.Enqueue(&typedJob{priority: PriorityNormal, run: record("normal")})
+	// WithPriority overrides the job's own priority
+	pool.Enqueue(WithPriority(&typedJob{priority: PriorityLow, run: record("high")}, PriorityHigh))
+
+	assert.Equal(t, 1, pool.QueueDepth()[PriorityHigh])
+
+	pool.Start()
+	wg.Wait()
+	pool.Stop()
+
+	assert.Equal(t, []string{"high", "normal", "low"}, order)
+}
+
+func TestPool_TypeLimit(t *testing.T) {
+	t.Parallel()
+
+	pool := NewPool(3, 10)
+	pool.SetTypeLimit("cleanup", 1)
+	pool.Start()
+	defer pool.Stop()
+
+	var running, maxRunning int32
+	var wg sync.WaitGroup
+	release := make(chan struct{})
+
+	wg.Add(3)
+	for i := 0; i < 3; i++ {
+		pool.Enqueue(&typedJob{jobType: "cleanup", run: func() {
+			n := atomic.AddInt32(&running, 1)
+			for {
+				cur := atomic.LoadInt32(&maxRunning)
+				if n <= cur || atomic.CompareAndSwapInt32(&maxRunning, cur, n) {
+					break
+				}
+			}
+			<-release
+			atomic.AddInt32(&running, -1)
+			wg.Done()
+		}})
+	}
+
+	// An untyped job still runs while cleanup jobs are saturated
+	var executed int32
+	var otherWg sync.WaitGroup
+	otherWg.Add(1)
+	pool.Enqueue(&testJob{executed: &executed, wg: &otherWg})
+	otherWg.Wait()
+
+	close(release)
+	wg.Wait()
+
+	assert.Equal(t, int32(1), atomic.LoadInt32(&maxRunning))
+	assert.Equal(t, int32(1), atomic.LoadInt32(&executed))
+}
+
+func TestPool_TryEnqueue(t *testing.T) {
+	t.Parallel()
+
+	pool := NewPool(1, 1)
+	pool.Start()
+
+	bJob := &blockingJob{
+		started: make(chan struct{}),
+		block:   make(chan struct{}),
+	}
+	require.NoError(t, pool.TryEnqueue(bJob))
+	<-bJob.started
+
+	// One slot left in the queue
+	var executed int32
+	var wg sync.WaitGroup
+	wg.Add(1)
+	require.NoError(t, pool.TryEnqueue(&testJob{executed: &executed, wg: &wg}))
+
+	// Pool is at capacity: rejected without blocking
+	assert.ErrorIs(t, pool.TryEnqueue(&testJob{executed: &executed}), ErrQueueFull)
+
+	close(bJob.block)
+	wg.Wait()
+	pool.Stop()
+
+	assert.ErrorIs(t, pool.TryEnqueue(&testJob{executed: &executed}), ErrPoolStopped)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&executed))
+}
+
+type communityRecordingJob struct {
+	seen []string
+	fail string
+}
+
+func (j *communityRecordingJob) Process(ctx context.Context) error {
+	id := community.FromContext(ctx)
+	j.seen = append(j.seen, id)
+	if id == j.fail {
+		return errors.New("boom")
+	}
+	return nil
+}
+
+func (j *communityRecordingJob) JobType() string { return "recording" }
+
+func TestPerCommunity(t *testing.T) {
+	t.Parallel()
+
+	inner := &communityRecordingJob{fail: "alpha"}
+	job := PerCommunity(inner, []string{community.Default, "alpha", "beta"})
+
+	err := job.Process(context.Background())
+
+	// Every community runs even after one fails
+	assert.Equal(t, []string{community.Default, "alpha", "beta"}, inner.seen)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "community alpha")
+	assert.Equal(t, "recording", jobTypeOf(job))
+}
@@ -0,0 +1,18 @@
+-- +goose Up
+-- Dead-letter store for event handlers that kept failing after retries
+CREATE TABLE event_dead_letters (
+    id BIGSERIAL PRIMARY KEY,
+    event_type VARCHAR(100) NOT NULL,
+    handler_name VARCHAR(255) NOT NULL,
+    event JSONB NOT NULL,       -- Full event envelope (version, type, payload, metadata)
+    attempts INT NOT NULL,
+    last_error TEXT NOT NULL DEFAULT '',
+    status VARCHAR(20) NOT NULL DEFAULT 'pending', -- 'pending', 'redriven', 'discarded'
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_event_dead_letters_status ON event_dead_letters(status, created_at DESC);
+
+-- +goose Down
+DROP TABLE IF EXISTS event_dead_letters;
@@ -0,0 +1,11 @@
+-- +goose Up
+-- Next-run bookkeeping for cron and one-shot scheduler jobs so schedules survive restarts
+CREATE TABLE scheduled_jobs (
+    name VARCHAR(100) PRIMARY KEY,
+    next_run_at TIMESTAMP WITH TIME ZONE, -- NULL once a one-shot job has run
+    last_run_at TIMESTAMP WITH TIME ZONE,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+-- +goose Down
+DROP TABLE IF EXISTS scheduled_jobs;
@@ -0,0 +1,10 @@
+-- +goose Up
+-- Per-job leases so only one API instance runs each scheduled job execution
+CREATE TABLE scheduler_leases (
+    name VARCHAR(100) PRIMARY KEY,
+    holder VARCHAR(255) NOT NULL,       -- Instance that owns the lease (hostname:pid:random)
+    expires_at TIMESTAMP WITH TIME ZONE NOT NULL
+);
+
+-- +goose Down
+DROP TABLE IF EXISTS scheduler_leases;
@@ -0,0 +1,10 @@
+-- +goose Up
+-- Timed per-user job XP multipliers (e.g. granted for subscriptions)
+CREATE TABLE job_xp_boosts (
+    user_id UUID PRIMARY KEY REFERENCES users(user_id) ON DELETE CASCADE,
+    multiplier DOUBLE PRECISION NOT NULL CHECK (multiplier > 1),
+    expires_at TIMESTAMP WITH TIME ZONE NOT NULL
+);
+
+-- +goose Down
+DROP TABLE IF EXISTS job_xp_boosts;
@@ -0,0 +1,18 @@
+-- +goose Up
+-- Processed subscription/donation webhooks; the primary key deduplicates
+-- redelivered events so rewards are granted at most once
+CREATE TABLE monetization_events (
+    source VARCHAR(20) NOT NULL,
+    event_id VARCHAR(128) NOT NULL,
+    kind VARCHAR(20) NOT NULL,
+    user_id UUID REFERENCES users(user_id) ON DELETE SET NULL,
+    payload JSONB NOT NULL,
+    result JSONB,
+    received_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (source, event_id)
+);
+
+CREATE INDEX idx_monetization_events_user ON monetization_events(user_id);
+
+-- +goose Down
+DROP TABLE IF EXISTS monetization_events;
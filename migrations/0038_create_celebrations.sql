@@ -0,0 +1,31 @@
+-- +goose Up
+-- Opt-in birthdays. Only month and day are stored, never the year.
+CREATE TABLE user_birthdays (
+    user_id UUID PRIMARY KEY REFERENCES users(user_id) ON DELETE CASCADE,
+    birth_month SMALLINT NOT NULL CHECK (birth_month BETWEEN 1 AND 12),
+    birth_day SMALLINT NOT NULL CHECK (birth_day BETWEEN 1 AND 31),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_user_birthdays_date ON user_birthdays(birth_month, birth_day);
+
+-- One row per user, celebration kind and year so reruns never double-grant
+CREATE TABLE celebration_grants (
+    user_id UUID NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+    kind VARCHAR(20) NOT NULL,
+    year INTEGER NOT NULL,
+    granted_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (user_id, kind, year)
+);
+
+-- Discord guilds that want celebration announcements (off unless enabled)
+CREATE TABLE celebration_guilds (
+    guild_id VARCHAR(32) PRIMARY KEY,
+    enabled BOOLEAN NOT NULL DEFAULT FALSE,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+-- +goose Down
+DROP TABLE IF EXISTS celebration_guilds;
+DROP TABLE IF EXISTS celebration_grants;
+DROP TABLE IF EXISTS user_birthdays;
@@ -0,0 +1,6 @@
+-- +goose Up
+-- skip-destructive-check
+ALTER TABLE crafting_recipes ADD COLUMN event_theme TEXT NOT NULL DEFAULT '';
+
+-- +goose Down
+ALTER TABLE crafting_recipes DROP COLUMN event_theme;
@@ -0,0 +1,10 @@
+-- +goose Up
+-- Per-user preferences. A missing row means every setting is at its default.
+CREATE TABLE user_settings (
+    user_id UUID PRIMARY KEY REFERENCES users(user_id) ON DELETE CASCADE,
+    leaderboard_private BOOLEAN NOT NULL DEFAULT FALSE,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+-- +goose Down
+DROP TABLE IF EXISTS user_settings;
@@ -0,0 +1,13 @@
+-- +goose Up
+-- Consecutive lootbox openings per user and lootbox type without a RARE or better drop.
+-- A missing row means the counter is zero.
+CREATE TABLE lootbox_pity (
+    user_id UUID NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+    lootbox_name TEXT NOT NULL,
+    openings_since_rare INTEGER NOT NULL DEFAULT 0 CHECK (openings_since_rare >= 0),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (user_id, lootbox_name)
+);
+
+-- +goose Down
+DROP TABLE IF EXISTS lootbox_pity;
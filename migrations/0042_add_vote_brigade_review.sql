@@ -0,0 +1,31 @@
+-- +goose Up
+-- Votes flagged by brigading detection keep the reason they were flagged.
+-- Excluded votes stay recorded but no longer count towards their option.
+ALTER TABLE user_votes
+    ADD COLUMN suspect_reason TEXT,
+    ADD COLUMN flagged_at TIMESTAMP WITH TIME ZONE,
+    ADD COLUMN excluded_at TIMESTAMP WITH TIME ZONE;
+
+CREATE INDEX idx_user_votes_suspect ON user_votes (session_id) WHERE suspect_reason IS NOT NULL;
+
+-- Every exclusion and restoration of a vote, by the system or an admin.
+CREATE TABLE vote_review_audit (
+    id BIGSERIAL PRIMARY KEY,
+    session_id INTEGER NOT NULL REFERENCES progression_voting_sessions(id) ON DELETE CASCADE,
+    user_id VARCHAR(255) NOT NULL,
+    option_id INTEGER,
+    action TEXT NOT NULL CHECK (action IN ('excluded', 'restored')),
+    actor TEXT NOT NULL,
+    reason TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_vote_review_audit_session ON vote_review_audit (session_id, created_at);
+
+-- +goose Down
+DROP TABLE IF EXISTS vote_review_audit;
+DROP INDEX IF EXISTS idx_user_votes_suspect;
+ALTER TABLE user_votes
+    DROP COLUMN IF EXISTS excluded_at,
+    DROP COLUMN IF EXISTS flagged_at,
+    DROP COLUMN IF EXISTS suspect_reason;
@@ -0,0 +1,22 @@
+-- +goose Up
+-- Pending reminders. Rows are deleted once the reminder has been dispatched.
+-- A user has at most one pending reminder per kind and target; asking again
+-- moves the existing one.
+CREATE TABLE reminders (
+    id BIGSERIAL PRIMARY KEY,
+    user_id UUID NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+    platform VARCHAR(50) NOT NULL,
+    platform_id VARCHAR(255) NOT NULL,
+    kind TEXT NOT NULL CHECK (kind IN ('cooldown', 'vote', 'compost')),
+    target TEXT NOT NULL DEFAULT '',
+    message TEXT NOT NULL,
+    channel_id TEXT NOT NULL DEFAULT '',
+    remind_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    UNIQUE (user_id, kind, target)
+);
+
+CREATE INDEX idx_reminders_remind_at ON reminders (remind_at);
+
+-- +goose Down
+DROP TABLE IF EXISTS reminders;
@@ -0,0 +1,14 @@
+-- +goose Up
+-- Search streaks and mastery. A missing row means the user has never searched.
+CREATE TABLE user_search_progress (
+    user_id UUID PRIMARY KEY REFERENCES users(user_id) ON DELETE CASCADE,
+    current_streak INT NOT NULL DEFAULT 0,
+    best_streak INT NOT NULL DEFAULT 0,
+    last_search_date DATE NOT NULL,
+    total_searches INT NOT NULL DEFAULT 0,
+    mastery_level INT NOT NULL DEFAULT 0,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+-- +goose Down
+DROP TABLE IF EXISTS user_search_progress;
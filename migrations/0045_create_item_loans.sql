@@ -0,0 +1,26 @@
+-- +goose Up
+-- Items lent between users. The items move to the borrower when the loan is
+-- made and back to the lender when the borrower returns them or the loan
+-- falls due. Rows are kept once closed as a record of the loan.
+-- A borrower deleted mid-loan leaves borrower_id NULL, and the next sweep
+-- restores the lender's items in full.
+CREATE TABLE item_loans (
+    id BIGSERIAL PRIMARY KEY,
+    lender_id UUID NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+    borrower_id UUID REFERENCES users(user_id) ON DELETE SET NULL,
+    item_id INTEGER NOT NULL REFERENCES items(item_id) ON DELETE CASCADE,
+    quality_level TEXT NOT NULL,
+    quantity INTEGER NOT NULL CHECK (quantity > 0),
+    returned_quantity INTEGER NOT NULL DEFAULT 0,
+    status TEXT NOT NULL DEFAULT 'active' CHECK (status IN ('active', 'returned', 'defaulted')),
+    due_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    closed_at TIMESTAMP WITH TIME ZONE
+);
+
+CREATE INDEX idx_item_loans_due_at ON item_loans (due_at) WHERE status = 'active';
+CREATE INDEX idx_item_loans_borrower ON item_loans (borrower_id, item_id) WHERE status = 'active';
+CREATE INDEX idx_item_loans_lender ON item_loans (lender_id) WHERE status = 'active';
+
+-- +goose Down
+DROP TABLE IF EXISTS item_loans;
@@ -0,0 +1,6 @@
+-- +goose Up
+-- Users who opt out can neither be targeted by nor use weapons and traps.
+ALTER TABLE user_settings ADD COLUMN targeting_opt_out BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- +goose Down
+ALTER TABLE user_settings DROP COLUMN IF EXISTS targeting_opt_out;
@@ -0,0 +1,32 @@
+-- +goose Up
+-- Items users put up for sale to each other at a fixed unit price. The items
+-- are held by the listing until bought or the listing is cancelled; quantity
+-- is what remains for sale. Rows are kept once closed as a record of the sale.
+CREATE TABLE player_shop_listings (
+    id BIGSERIAL PRIMARY KEY,
+    seller_id UUID NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+    item_id INTEGER NOT NULL REFERENCES items(item_id) ON DELETE CASCADE,
+    quality_level TEXT NOT NULL,
+    quantity INTEGER NOT NULL CHECK (quantity >= 0),
+    unit_price INTEGER NOT NULL CHECK (unit_price > 0),
+    status TEXT NOT NULL DEFAULT 'active' CHECK (status IN ('active', 'sold', 'cancelled')),
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    closed_at TIMESTAMP WITH TIME ZONE
+);
+
+CREATE INDEX idx_player_shop_listings_item ON player_shop_listings (item_id, unit_price) WHERE status = 'active';
+CREATE INDEX idx_player_shop_listings_seller ON player_shop_listings (seller_id) WHERE status = 'active';
+
+-- Money collected by the community, such as the player shop's sale fees.
+-- There is only ever one row.
+CREATE TABLE community_pool (
+    id SMALLINT PRIMARY KEY DEFAULT 1 CHECK (id = 1),
+    balance BIGINT NOT NULL DEFAULT 0,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+INSERT INTO community_pool (id) VALUES (1);
+
+-- +goose Down
+DROP TABLE IF EXISTS community_pool;
+DROP TABLE IF EXISTS player_shop_listings;
@@ -0,0 +1,18 @@
+-- +goose Up
+-- Timed buffs and debuffs on users, such as better search luck or timeout
+-- immunity. A user holds at most one effect of each type; granting it again
+-- keeps the stronger magnitude and extends the expiry. Expired rows are
+-- ignored by reads and swept on a schedule.
+CREATE TABLE user_effects (
+    user_id UUID NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+    effect_type TEXT NOT NULL,
+    magnitude DOUBLE PRECISION NOT NULL CHECK (magnitude > 0),
+    expires_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (user_id, effect_type)
+);
+
+CREATE INDEX idx_user_effects_expires_at ON user_effects (expires_at);
+
+-- +goose Down
+DROP TABLE IF EXISTS user_effects;
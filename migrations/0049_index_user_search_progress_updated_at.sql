@@ -0,0 +1,7 @@
+-- +goose Up
+-- Every search touches updated_at, so counting recent rows gives the number
+-- of users who searched lately. Search difficulty reads this on a short cache.
+CREATE INDEX idx_user_search_progress_updated_at ON user_search_progress (updated_at);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_user_search_progress_updated_at;
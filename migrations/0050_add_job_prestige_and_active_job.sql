@@ -0,0 +1,15 @@
+-- +goose Up
+-- Players pick one active job, which earns bonus XP, and can prestige a job
+-- at the level cap to reset it for a permanent XP bonus. A user has at most
+-- one active job.
+ALTER TABLE user_jobs
+    ADD COLUMN prestige INTEGER NOT NULL DEFAULT 0 CHECK (prestige >= 0),
+    ADD COLUMN is_active BOOLEAN NOT NULL DEFAULT FALSE;
+
+CREATE UNIQUE INDEX idx_user_jobs_one_active ON user_jobs (user_id) WHERE is_active;
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_user_jobs_one_active;
+ALTER TABLE user_jobs
+    DROP COLUMN IF EXISTS is_active,
+    DROP COLUMN IF EXISTS prestige;
@@ -0,0 +1,38 @@
+-- +goose Up
+-- Admin operations that grant or revoke one user progression (such as a
+-- recipe) for many users at once. They run in batches on the worker pool and
+-- record their progress here as each batch commits.
+CREATE TABLE progression_bulk_operations (
+    id BIGSERIAL PRIMARY KEY,
+    action TEXT NOT NULL CHECK (action IN ('grant', 'revoke')),
+    progression_type VARCHAR(50) NOT NULL,
+    progression_key VARCHAR(100) NOT NULL,
+    actor TEXT NOT NULL,
+    reason TEXT NOT NULL DEFAULT '',
+    status TEXT NOT NULL DEFAULT 'queued' CHECK (status IN ('queued', 'running', 'completed', 'failed')),
+    total_users INTEGER NOT NULL,
+    processed_users INTEGER NOT NULL DEFAULT 0,
+    affected_users INTEGER NOT NULL DEFAULT 0,
+    total_batches INTEGER NOT NULL,
+    completed_batches INTEGER NOT NULL DEFAULT 0,
+    error TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    completed_at TIMESTAMP WITH TIME ZONE
+);
+
+-- One row per committed batch, with the users it covered and how many of
+-- them actually changed.
+CREATE TABLE progression_bulk_audit (
+    id BIGSERIAL PRIMARY KEY,
+    operation_id BIGINT NOT NULL REFERENCES progression_bulk_operations(id) ON DELETE CASCADE,
+    batch_number INTEGER NOT NULL,
+    user_ids TEXT[] NOT NULL,
+    affected_users INTEGER NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    UNIQUE (operation_id, batch_number)
+);
+
+-- +goose Down
+DROP TABLE IF EXISTS progression_bulk_audit;
+DROP TABLE IF EXISTS progression_bulk_operations;
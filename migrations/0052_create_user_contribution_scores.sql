@@ -0,0 +1,22 @@
+-- +goose Up
+-- Running contribution score per user. Engagement adds its weighted points
+-- here and a scheduled job shrinks every score by a fixed share, so the
+-- contribution leaderboard reflects recent activity rather than lifetime totals.
+CREATE TABLE user_contribution_scores (
+    user_id TEXT PRIMARY KEY,
+    score DOUBLE PRECISION NOT NULL DEFAULT 0,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_user_contribution_scores_score ON user_contribution_scores (score DESC);
+
+-- Seed from the recorded engagement so the leaderboard keeps its order
+INSERT INTO user_contribution_scores (user_id, score)
+SELECT em.user_id, SUM(em.metric_value * COALESCE(ew.weight, 1.0))::double precision
+FROM engagement_metrics em
+LEFT JOIN engagement_weights ew ON ew.metric_type = em.metric_type
+GROUP BY em.user_id
+HAVING SUM(em.metric_value * COALESCE(ew.weight, 1.0)) > 0;
+
+-- +goose Down
+DROP TABLE IF EXISTS user_contribution_scores;
@@ -0,0 +1,25 @@
+-- +goose Up
+-- A user may hand their session vote to another user. When the delegate
+-- votes, a vote is cast for each delegator who has not voted yet. Chains are
+-- not followed: a delegate's own delegation is ignored.
+CREATE TABLE vote_delegations (
+    delegator_id TEXT PRIMARY KEY,
+    delegate_id TEXT NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    CHECK (delegator_id <> delegate_id)
+);
+
+CREATE INDEX idx_vote_delegations_delegate ON vote_delegations (delegate_id);
+
+-- weight is how much the vote added to the option's vote_count; cast_by is
+-- the delegate who cast it, NULL for votes the user cast themselves.
+ALTER TABLE user_votes
+    ADD COLUMN weight INTEGER NOT NULL DEFAULT 1,
+    ADD COLUMN cast_by TEXT;
+
+-- +goose Down
+ALTER TABLE user_votes
+    DROP COLUMN IF EXISTS cast_by,
+    DROP COLUMN IF EXISTS weight;
+
+DROP TABLE IF EXISTS vote_delegations;
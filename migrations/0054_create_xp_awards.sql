@@ -0,0 +1,23 @@
+-- +goose Up
+-- Audit trail of every job XP award, used to enforce per-source daily caps
+-- and to investigate suspicious level gains
+CREATE TABLE xp_awards (
+    id BIGSERIAL PRIMARY KEY,
+    user_id UUID NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+    job_key TEXT NOT NULL,
+    source TEXT NOT NULL,
+    base_xp INTEGER NOT NULL,
+    xp_awarded INTEGER NOT NULL,
+    multiplier DOUBLE PRECISION NOT NULL,
+    capped_by TEXT,
+    old_level INTEGER NOT NULL,
+    new_level INTEGER NOT NULL,
+    metadata JSONB,
+    awarded_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_xp_awards_user_awarded ON xp_awards (user_id, awarded_at DESC);
+CREATE INDEX idx_xp_awards_user_source ON xp_awards (user_id, source, awarded_at);
+
+-- +goose Down
+DROP TABLE IF EXISTS xp_awards;
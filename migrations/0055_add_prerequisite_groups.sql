@@ -0,0 +1,18 @@
+-- +goose Up
+-- Group a node's static prerequisites so alternatives can share a group.
+-- A node needs at least one unlocked prerequisite from every group: rows in
+-- the same group are OR'ed, groups are AND'ed. Existing rows each get their
+-- own group, which keeps their AND behaviour.
+ALTER TABLE progression_prerequisites ADD COLUMN prerequisite_group INTEGER NOT NULL DEFAULT 0;
+
+UPDATE progression_prerequisites p
+SET prerequisite_group = g.grp
+FROM (
+    SELECT node_id, prerequisite_node_id,
+           (ROW_NUMBER() OVER (PARTITION BY node_id ORDER BY prerequisite_node_id) - 1)::int AS grp
+    FROM progression_prerequisites
+) g
+WHERE p.node_id = g.node_id AND p.prerequisite_node_id = g.prerequisite_node_id;
+
+-- +goose Down
+ALTER TABLE progression_prerequisites DROP COLUMN IF EXISTS prerequisite_group;
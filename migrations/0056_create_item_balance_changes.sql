@@ -0,0 +1,23 @@
+-- +goose Up
+-- Scheduled item balance changes. Rows are never updated: the change with the
+-- latest effective_at at or before now is the one in force, which keeps the
+-- full history for the public changelog.
+CREATE TABLE item_balance_changes (
+    id BIGSERIAL PRIMARY KEY,
+    item_name TEXT NOT NULL,
+    field TEXT NOT NULL CHECK (field IN ('base_value', 'loot_weight')),
+    -- Loot pool the weight applies to; empty for base value changes
+    pool_name TEXT NOT NULL DEFAULT '',
+    value INTEGER NOT NULL CHECK (value >= 0),
+    effective_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    reason TEXT NOT NULL DEFAULT '',
+    created_by TEXT NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    CHECK ((field = 'loot_weight') = (pool_name <> ''))
+);
+
+CREATE INDEX idx_item_balance_changes_effective ON item_balance_changes (field, item_name, pool_name, effective_at DESC);
+CREATE INDEX idx_item_balance_changes_item ON item_balance_changes (item_name, effective_at DESC);
+
+-- +goose Down
+DROP TABLE IF EXISTS item_balance_changes;
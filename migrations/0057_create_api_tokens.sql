@@ -0,0 +1,21 @@
+-- +goose Up
+-- Read-only API tokens issued to a guild's streamer for community tools.
+-- Only a SHA-256 hash of each token is stored; the token itself is shown once
+-- when it is issued.
+CREATE TABLE api_tokens (
+    id BIGSERIAL PRIMARY KEY,
+    guild_id VARCHAR(32) NOT NULL,
+    name TEXT NOT NULL,
+    token_hash TEXT NOT NULL UNIQUE,
+    -- Leading characters of the token, so owners can tell their tokens apart
+    token_prefix TEXT NOT NULL,
+    created_by TEXT NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    last_used_at TIMESTAMP WITH TIME ZONE,
+    revoked_at TIMESTAMP WITH TIME ZONE
+);
+
+CREATE INDEX idx_api_tokens_guild ON api_tokens (guild_id, created_at DESC);
+
+-- +goose Down
+DROP TABLE IF EXISTS api_tokens;
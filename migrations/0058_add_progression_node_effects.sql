@@ -0,0 +1,11 @@
+-- +goose Up
+-- Machine-readable effects of unlocking a node: the feature flags it turns
+-- on, the items it enables and config deltas, applied when the node unlocks.
+ALTER TABLE progression_nodes
+ADD COLUMN IF NOT EXISTS effects JSONB NOT NULL DEFAULT '{}'::jsonb;
+
+COMMENT ON COLUMN progression_nodes.effects IS
+'Effects of unlocking the node as JSON: {"features":["feature_gamble"],"items":["money"],"config_deltas":[{"key":"search_daily_limit","delta":5}]}';
+
+-- +goose Down
+ALTER TABLE progression_nodes DROP COLUMN IF EXISTS effects;
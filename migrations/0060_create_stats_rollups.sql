@@ -0,0 +1,27 @@
+-- +goose Up
+-- Per-user event counts rolled up from stats_events into hour, day and week
+-- buckets, so range queries read a few rows per bucket instead of every raw
+-- event. Timestamps are without time zone to match stats_events.created_at.
+CREATE TABLE stats_rollups (
+    -- hour, day or week; buckets start where date_trunc puts them
+    bucket VARCHAR(8) NOT NULL,
+    bucket_start TIMESTAMP NOT NULL,
+    user_id UUID NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+    event_type VARCHAR(100) NOT NULL,
+    event_count INTEGER NOT NULL,
+    PRIMARY KEY (bucket, bucket_start, user_id, event_type)
+);
+
+CREATE INDEX idx_stats_rollups_user ON stats_rollups (bucket, user_id, bucket_start);
+CREATE INDEX idx_stats_rollups_event_type ON stats_rollups (bucket, event_type, bucket_start);
+
+-- How far each bucket size has been rolled up. Buckets before rolled_through
+-- are complete; queries read raw events from there on.
+CREATE TABLE stats_rollup_progress (
+    bucket VARCHAR(8) PRIMARY KEY,
+    rolled_through TIMESTAMP NOT NULL
+);
+
+-- +goose Down
+DROP TABLE IF EXISTS stats_rollup_progress;
+DROP TABLE IF EXISTS stats_rollups;
@@ -0,0 +1,26 @@
+-- +goose Up
+-- Net trade pressure per item: units bought minus units sold. The economy
+-- service decays it toward zero with a configurable half-life and turns it
+-- into a buy/sell price multiplier.
+CREATE TABLE item_market_pressure (
+    item_id INTEGER PRIMARY KEY REFERENCES items(item_id) ON DELETE CASCADE,
+    pressure DOUBLE PRECISION NOT NULL DEFAULT 0,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- Snapshots of dynamic item prices, written when an item's multiplier moves
+CREATE TABLE item_price_history (
+    id BIGSERIAL PRIMARY KEY,
+    item_id INTEGER NOT NULL REFERENCES items(item_id) ON DELETE CASCADE,
+    multiplier DOUBLE PRECISION NOT NULL,
+    buy_price INTEGER NOT NULL,
+    sell_price INTEGER NOT NULL,
+    recorded_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_item_price_history_item ON item_price_history (item_id, recorded_at);
+CREATE INDEX idx_item_price_history_recorded ON item_price_history (recorded_at);
+
+-- +goose Down
+DROP TABLE IF EXISTS item_price_history;
+DROP TABLE IF EXISTS item_market_pressure;
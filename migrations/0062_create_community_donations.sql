@@ -0,0 +1,18 @@
+-- +goose Up
+-- Money and items users give to the community pool. value is what the
+-- donation was worth in money when it was made; points is the progression
+-- contribution it bought, with any remainder left in the pool.
+CREATE TABLE community_donations (
+    id BIGSERIAL PRIMARY KEY,
+    user_id UUID NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+    item_id INTEGER NOT NULL REFERENCES items(item_id) ON DELETE CASCADE,
+    quantity INTEGER NOT NULL CHECK (quantity > 0),
+    value INTEGER NOT NULL CHECK (value > 0),
+    points INTEGER NOT NULL CHECK (points >= 0),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_community_donations_user ON community_donations (user_id);
+
+-- +goose Down
+DROP TABLE IF EXISTS community_donations;
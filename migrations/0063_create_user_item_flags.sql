@@ -0,0 +1,15 @@
+-- +goose Up
+-- Flags users put on items they hold. A locked item cannot be used, sold,
+-- given or disassembled until it is unlocked; a favorite is only marked in
+-- the inventory. A missing row means neither flag is set.
+CREATE TABLE user_item_flags (
+    user_id UUID NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+    item_id INTEGER NOT NULL REFERENCES items(item_id) ON DELETE CASCADE,
+    favorite BOOLEAN NOT NULL DEFAULT FALSE,
+    locked BOOLEAN NOT NULL DEFAULT FALSE,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (user_id, item_id)
+);
+
+-- +goose Down
+DROP TABLE IF EXISTS user_item_flags;
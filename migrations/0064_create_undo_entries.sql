@@ -0,0 +1,18 @@
+-- +goose Up
+-- Reversible records of recent sells, disassembles and gives. changes holds
+-- the inventory adjustments the operation made; undoing applies them in
+-- reverse and deletes the row. Rows past expires_at can no longer be undone
+-- and are cleared the next time the user records one.
+CREATE TABLE undo_entries (
+    id BIGSERIAL PRIMARY KEY,
+    user_id UUID NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+    action TEXT NOT NULL,
+    changes JSONB NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    expires_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX idx_undo_entries_user ON undo_entries (user_id, id DESC);
+
+-- +goose Down
+DROP TABLE IF EXISTS undo_entries;
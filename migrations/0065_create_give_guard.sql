@@ -0,0 +1,35 @@
+-- +goose Up
+-- Recent gives, read to enforce the daily give limit and to spot items
+-- passed back and forth between accounts. Rows older than a day and the
+-- circular transfer window are cleared as the giver makes new gives.
+CREATE TABLE give_log (
+    id BIGSERIAL PRIMARY KEY,
+    giver_id UUID NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+    receiver_id UUID NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+    item_id INTEGER NOT NULL REFERENCES items(item_id) ON DELETE CASCADE,
+    quantity INTEGER NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_give_log_giver ON give_log (giver_id, created_at);
+CREATE INDEX idx_give_log_pair ON give_log (giver_id, receiver_id, created_at);
+
+-- Gives flagged by anomaly detection, waiting for or after admin review
+CREATE TABLE give_flags (
+    id BIGSERIAL PRIMARY KEY,
+    giver_id UUID NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+    receiver_id UUID NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+    item_id INTEGER NOT NULL REFERENCES items(item_id) ON DELETE CASCADE,
+    quantity INTEGER NOT NULL,
+    reason TEXT NOT NULL,
+    status TEXT NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'dismissed', 'confirmed')),
+    reviewed_by TEXT,
+    reviewed_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_give_flags_status ON give_flags (status, id DESC);
+
+-- +goose Down
+DROP TABLE IF EXISTS give_flags;
+DROP TABLE IF EXISTS give_log;
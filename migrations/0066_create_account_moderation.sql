@@ -0,0 +1,25 @@
+-- +goose Up
+-- Accounts frozen by moderators. A frozen account cannot take economy
+-- actions until the row is removed.
+CREATE TABLE user_freezes (
+    user_id UUID PRIMARY KEY REFERENCES users(user_id) ON DELETE CASCADE,
+    frozen_by TEXT NOT NULL,
+    reason TEXT NOT NULL DEFAULT '',
+    frozen_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- Every freeze, unfreeze and inventory wipe, for the account dossier
+CREATE TABLE moderation_actions (
+    id BIGSERIAL PRIMARY KEY,
+    user_id UUID NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+    action TEXT NOT NULL,
+    actor TEXT NOT NULL,
+    reason TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_moderation_actions_user ON moderation_actions (user_id, id DESC);
+
+-- +goose Down
+DROP TABLE IF EXISTS moderation_actions;
+DROP TABLE IF EXISTS user_freezes;
@@ -0,0 +1,17 @@
+-- +goose Up
+-- Active user timeouts, keyed by platform and username like the chat
+-- commands that apply them. Rows past expires_at are inactive and are
+-- removed when they expire or when the server starts.
+CREATE TABLE user_timeouts (
+    platform TEXT NOT NULL,
+    username TEXT NOT NULL,
+    expires_at TIMESTAMPTZ NOT NULL,
+    reason TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (platform, username)
+);
+
+CREATE INDEX idx_user_timeouts_expires_at ON user_timeouts (expires_at);
+
+-- +goose Down
+DROP TABLE IF EXISTS user_timeouts;
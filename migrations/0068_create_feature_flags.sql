@@ -0,0 +1,18 @@
+-- +goose Up
+-- Admin-managed feature flags, separate from the progression tree, for
+-- dark-launching features. platform_overrides maps a platform to true or
+-- false and replaces enabled for that platform. rollout_percent then limits
+-- the flag to a stable share of users.
+CREATE TABLE feature_flags (
+    key TEXT PRIMARY KEY,
+    description TEXT NOT NULL DEFAULT '',
+    enabled BOOLEAN NOT NULL DEFAULT FALSE,
+    rollout_percent INTEGER NOT NULL DEFAULT 100 CHECK (rollout_percent BETWEEN 0 AND 100),
+    platform_overrides JSONB NOT NULL DEFAULT '{}',
+    updated_by TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- +goose Down
+DROP TABLE IF EXISTS feature_flags;
@@ -0,0 +1,21 @@
+-- +goose Up
+-- Point-in-time copies of player state (users, inventories, progression and
+-- stats aggregates) taken from the admin API or on a schedule. data holds the
+-- gzipped JSON document; schema_version is the goose version it was taken at,
+-- since a snapshot can only be restored into the same schema.
+CREATE TABLE game_snapshots (
+    id BIGSERIAL PRIMARY KEY,
+    trigger TEXT NOT NULL CHECK (trigger IN ('manual', 'scheduled', 'pre_restore')),
+    note TEXT NOT NULL DEFAULT '',
+    created_by TEXT NOT NULL DEFAULT '',
+    schema_version BIGINT NOT NULL,
+    row_counts JSONB NOT NULL DEFAULT '{}',
+    size_bytes BIGINT NOT NULL,
+    data BYTEA NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_game_snapshots_trigger_created ON game_snapshots (trigger, created_at DESC);
+
+-- +goose Down
+DROP TABLE IF EXISTS game_snapshots;
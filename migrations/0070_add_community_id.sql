@@ -0,0 +1,80 @@
+-- +goose Up
+-- Let one deployment serve several streamer communities. Users, their
+-- platform links, gambles, progression state and the item market are scoped
+-- to a community; everything that exists today belongs to 'default'. Item,
+-- recipe and progression tree definitions stay shared.
+ALTER TABLE users ADD COLUMN community_id TEXT NOT NULL DEFAULT 'default';
+CREATE INDEX idx_users_community ON users (community_id);
+
+-- The same platform account can play in several communities, once in each
+ALTER TABLE user_platform_links ADD COLUMN community_id TEXT NOT NULL DEFAULT 'default';
+ALTER TABLE user_platform_links DROP CONSTRAINT user_platform_links_platform_id_platform_user_id_key;
+ALTER TABLE user_platform_links ADD CONSTRAINT user_platform_links_community_platform_user_key
+    UNIQUE (community_id, platform_id, platform_user_id);
+
+-- One joining and one opening gamble per community
+ALTER TABLE gambles ADD COLUMN community_id TEXT NOT NULL DEFAULT 'default';
+DROP INDEX IF EXISTS idx_gambles_single_active;
+CREATE UNIQUE INDEX idx_gambles_single_active ON gambles (community_id, state)
+    WHERE state IN ('Joining', 'Opening');
+
+ALTER TABLE progression_unlocks ADD COLUMN community_id TEXT NOT NULL DEFAULT 'default';
+ALTER TABLE progression_unlocks DROP CONSTRAINT progression_unlocks_node_id_current_level_key;
+ALTER TABLE progression_unlocks ADD CONSTRAINT progression_unlocks_community_node_level_key
+    UNIQUE (community_id, node_id, current_level);
+
+ALTER TABLE progression_unlock_progress ADD COLUMN community_id TEXT NOT NULL DEFAULT 'default';
+CREATE INDEX idx_progression_unlock_progress_community ON progression_unlock_progress (community_id, unlocked_at);
+
+ALTER TABLE progression_voting_sessions ADD COLUMN community_id TEXT NOT NULL DEFAULT 'default';
+CREATE INDEX idx_progression_voting_sessions_community ON progression_voting_sessions (community_id, status);
+
+ALTER TABLE item_market_pressure ADD COLUMN community_id TEXT NOT NULL DEFAULT 'default';
+ALTER TABLE item_market_pressure DROP CONSTRAINT item_market_pressure_pkey;
+ALTER TABLE item_market_pressure ADD PRIMARY KEY (community_id, item_id);
+
+ALTER TABLE item_price_history ADD COLUMN community_id TEXT NOT NULL DEFAULT 'default';
+DROP INDEX IF EXISTS idx_item_price_history_item;
+CREATE INDEX idx_item_price_history_item ON item_price_history (community_id, item_id, recorded_at);
+
+-- +goose Down
+-- Rows outside the default community cannot be represented without the
+-- column and are dropped
+DELETE FROM item_price_history WHERE community_id <> 'default';
+DROP INDEX IF EXISTS idx_item_price_history_item;
+CREATE INDEX idx_item_price_history_item ON item_price_history (item_id, recorded_at);
+ALTER TABLE item_price_history DROP COLUMN community_id;
+
+DELETE FROM item_market_pressure WHERE community_id <> 'default';
+ALTER TABLE item_market_pressure DROP CONSTRAINT item_market_pressure_pkey;
+ALTER TABLE item_market_pressure ADD PRIMARY KEY (item_id);
+ALTER TABLE item_market_pressure DROP COLUMN community_id;
+
+DELETE FROM progression_voting_sessions WHERE community_id <> 'default';
+DROP INDEX IF EXISTS idx_progression_voting_sessions_community;
+ALTER TABLE progression_voting_sessions DROP COLUMN community_id;
+
+DELETE FROM progression_unlock_progress WHERE community_id <> 'default';
+DROP INDEX IF EXISTS idx_progression_unlock_progress_community;
+ALTER TABLE progression_unlock_progress DROP COLUMN community_id;
+
+DELETE FROM progression_unlocks WHERE community_id <> 'default';
+ALTER TABLE progression_unlocks DROP CONSTRAINT progression_unlocks_community_node_level_key;
+ALTER TABLE progression_unlocks ADD CONSTRAINT progression_unlocks_node_id_current_level_key
+    UNIQUE (node_id, current_level);
+ALTER TABLE progression_unlocks DROP COLUMN community_id;
+
+DELETE FROM gambles WHERE community_id <> 'default';
+DROP INDEX IF EXISTS idx_gambles_single_active;
+CREATE UNIQUE INDEX idx_gambles_single_active ON gambles (state)
+    WHERE state IN ('Joining', 'Opening');
+ALTER TABLE gambles DROP COLUMN community_id;
+
+DELETE FROM users WHERE community_id <> 'default';
+ALTER TABLE user_platform_links DROP CONSTRAINT user_platform_links_community_platform_user_key;
+ALTER TABLE user_platform_links ADD CONSTRAINT user_platform_links_platform_id_platform_user_id_key
+    UNIQUE (platform_id, platform_user_id);
+ALTER TABLE user_platform_links DROP COLUMN community_id;
+
+DROP INDEX IF EXISTS idx_users_community;
+ALTER TABLE users DROP COLUMN community_id;
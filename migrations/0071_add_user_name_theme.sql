@@ -0,0 +1,6 @@
+-- +goose Up
+-- The item name theme a user picked. Empty follows the calendar.
+ALTER TABLE user_settings ADD COLUMN name_theme TEXT NOT NULL DEFAULT '';
+
+-- +goose Down
+ALTER TABLE user_settings DROP COLUMN IF EXISTS name_theme;
@@ -0,0 +1,15 @@
+-- +goose Up
+-- Item display aliases managed through the admin API. A row replaces the
+-- item's pool from configs/items/aliases.json, so nicknames can be added
+-- without a deploy. theme_aliases maps a theme name to its aliases.
+CREATE TABLE item_aliases (
+    internal_name TEXT PRIMARY KEY,
+    default_aliases TEXT[] NOT NULL,
+    theme_aliases JSONB NOT NULL DEFAULT '{}',
+    updated_by TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- +goose Down
+DROP TABLE IF EXISTS item_aliases;
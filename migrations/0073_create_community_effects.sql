@@ -0,0 +1,20 @@
+-- +goose Up
+-- Timed bonuses that apply to a whole community, such as the XP and
+-- contribution boosts granted when the channel is raided or hosted. A
+-- community holds at most one effect of each type; granting it again keeps
+-- the stronger magnitude and extends the expiry. source records what granted
+-- it most recently.
+CREATE TABLE community_effects (
+    community_id TEXT NOT NULL DEFAULT 'default',
+    effect_type TEXT NOT NULL,
+    magnitude DOUBLE PRECISION NOT NULL CHECK (magnitude > 0),
+    source TEXT NOT NULL,
+    expires_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (community_id, effect_type)
+);
+
+CREATE INDEX idx_community_effects_expires_at ON community_effects (expires_at);
+
+-- +goose Down
+DROP TABLE IF EXISTS community_effects;
@@ -0,0 +1,31 @@
+-- +goose Up
+-- A stream session runs from a platform adapter's /stream/start to its
+-- /stream/end. A community has at most one live session. Stats events and
+-- gambles created while one is live are tagged with it, so each stream can be
+-- summarised afterwards.
+CREATE TABLE stream_sessions (
+    id BIGSERIAL PRIMARY KEY,
+    community_id TEXT NOT NULL DEFAULT 'default',
+    platform TEXT NOT NULL,
+    title TEXT NOT NULL DEFAULT '',
+    started_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    ended_at TIMESTAMP WITH TIME ZONE
+);
+
+CREATE UNIQUE INDEX idx_stream_sessions_single_live ON stream_sessions (community_id)
+    WHERE ended_at IS NULL;
+
+ALTER TABLE stats_events ADD COLUMN stream_session_id BIGINT REFERENCES stream_sessions(id) ON DELETE SET NULL;
+CREATE INDEX idx_stats_events_stream_session ON stats_events (stream_session_id)
+    WHERE stream_session_id IS NOT NULL;
+
+ALTER TABLE gambles ADD COLUMN stream_session_id BIGINT REFERENCES stream_sessions(id) ON DELETE SET NULL;
+CREATE INDEX idx_gambles_stream_session ON gambles (stream_session_id)
+    WHERE stream_session_id IS NOT NULL;
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_gambles_stream_session;
+ALTER TABLE gambles DROP COLUMN IF EXISTS stream_session_id;
+DROP INDEX IF EXISTS idx_stats_events_stream_session;
+ALTER TABLE stats_events DROP COLUMN IF EXISTS stream_session_id;
+DROP TABLE IF EXISTS stream_sessions;
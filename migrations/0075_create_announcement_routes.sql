@@ -0,0 +1,24 @@
+-- +goose Up
+-- Admin-configured rules that turn events into announcements. Each route
+-- renders its template with the event payload and sends the text to one
+-- destination: a Discord channel, a webhook URL or an SSE topic.
+CREATE TABLE announcement_routes (
+    id BIGSERIAL PRIMARY KEY,
+    event_type TEXT NOT NULL,
+    -- discord, webhook or sse
+    destination VARCHAR(16) NOT NULL,
+    -- Discord channel ID, webhook URL or SSE topic, depending on destination
+    target TEXT NOT NULL,
+    -- Go text/template rendered with the event's type and payload
+    template TEXT NOT NULL,
+    enabled BOOLEAN NOT NULL DEFAULT TRUE,
+    created_by TEXT NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    CONSTRAINT announcement_routes_destination_check CHECK (destination IN ('discord', 'webhook', 'sse'))
+);
+
+CREATE INDEX idx_announcement_routes_event_type ON announcement_routes (event_type) WHERE enabled;
+
+-- +goose Down
+DROP TABLE IF EXISTS announcement_routes;
@@ -0,0 +1,19 @@
+-- +goose Up
+-- Spectator side-bets on which participant wins a gamble. Stakes are money
+-- and form a pool separate from the lootbox pot; backers of the winner split
+-- it in proportion to their stakes.
+CREATE TABLE gamble_side_bets (
+    id BIGSERIAL PRIMARY KEY,
+    gamble_id UUID NOT NULL REFERENCES gambles(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES users(user_id),
+    backed_user_id UUID NOT NULL REFERENCES users(user_id),
+    amount INT NOT NULL,
+    -- NULL until the gamble is settled; stakes refunded record their amount
+    payout INT,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    CONSTRAINT gamble_side_bets_amount_check CHECK (amount > 0),
+    CONSTRAINT gamble_side_bets_one_per_user UNIQUE (gamble_id, user_id)
+);
+
+-- +goose Down
+DROP TABLE IF EXISTS gamble_side_bets;
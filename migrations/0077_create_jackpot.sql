@@ -0,0 +1,25 @@
+-- +goose Up
+-- Progressive jackpot: a pool fed by a cut of every lootbox opening and
+-- gamble, paid out whole to whoever triggers it
+CREATE TABLE jackpot (
+    id SMALLINT PRIMARY KEY DEFAULT 1 CHECK (id = 1),
+    balance BIGINT NOT NULL DEFAULT 0,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+INSERT INTO jackpot (id) VALUES (1);
+
+CREATE TABLE jackpot_wins (
+    id BIGSERIAL PRIMARY KEY,
+    user_id UUID NOT NULL REFERENCES users(user_id),
+    amount BIGINT NOT NULL,
+    -- What triggered the win: a lootbox opening or a gamble
+    source TEXT NOT NULL,
+    won_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_jackpot_wins_won_at ON jackpot_wins (won_at DESC);
+
+-- +goose Down
+DROP TABLE IF EXISTS jackpot_wins;
+DROP TABLE IF EXISTS jackpot;
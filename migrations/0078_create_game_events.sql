@@ -0,0 +1,22 @@
+-- +goose Up
+-- Time-boxed global events, such as a double XP weekend, created by admins.
+-- An event runs from starts_at until ends_at; one scheduled for later waits.
+-- A multiplier of 1 and a discount of 0 leave that part of the game alone.
+CREATE TABLE game_events (
+    id BIGSERIAL PRIMARY KEY,
+    name TEXT NOT NULL,
+    description TEXT NOT NULL DEFAULT '',
+    xp_multiplier DOUBLE PRECISION NOT NULL DEFAULT 1 CHECK (xp_multiplier >= 1),
+    drop_rate_multiplier DOUBLE PRECISION NOT NULL DEFAULT 1 CHECK (drop_rate_multiplier >= 1),
+    shop_discount_percent INTEGER NOT NULL DEFAULT 0 CHECK (shop_discount_percent BETWEEN 0 AND 100),
+    starts_at TIMESTAMPTZ NOT NULL,
+    -- Ending an event early moves ends_at to now, and starts_at too if it had not begun
+    ends_at TIMESTAMPTZ NOT NULL CHECK (ends_at >= starts_at),
+    created_by TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_game_events_ends_at ON game_events (ends_at);
+
+-- +goose Down
+DROP TABLE IF EXISTS game_events;
@@ -0,0 +1,27 @@
+-- +goose Up
+-- The community dig site: a single shaft every user digs deeper together.
+-- milestone_level is the deepest level whose progression contribution has
+-- been granted, so each level pays out once.
+CREATE TABLE dig_site (
+    id SMALLINT PRIMARY KEY DEFAULT 1 CHECK (id = 1),
+    depth INTEGER NOT NULL DEFAULT 0 CHECK (depth >= 0),
+    total_digs INTEGER NOT NULL DEFAULT 0,
+    milestone_level INTEGER NOT NULL DEFAULT 0,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+INSERT INTO dig_site (id) VALUES (1);
+
+-- Each user's share of the digging, for the site's leaderboard
+CREATE TABLE dig_site_diggers (
+    user_id UUID PRIMARY KEY REFERENCES users(user_id),
+    meters INTEGER NOT NULL DEFAULT 0,
+    digs INTEGER NOT NULL DEFAULT 0,
+    last_dug_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_dig_site_diggers_meters ON dig_site_diggers (meters DESC);
+
+-- +goose Down
+DROP TABLE IF EXISTS dig_site_diggers;
+DROP TABLE IF EXISTS dig_site;
@@ -0,0 +1,19 @@
+-- +goose Up
+-- A user's line in the water. The bite lands at bite_at and must be reeled in
+-- by reel_by; a cast past reel_by is stale and a new cast replaces it.
+CREATE TABLE fishing_casts (
+    user_id UUID PRIMARY KEY REFERENCES users(user_id) ON DELETE CASCADE,
+    tackle VARCHAR(100) NOT NULL DEFAULT '',
+    cast_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    bite_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    reel_by TIMESTAMP WITH TIME ZONE NOT NULL,
+    CHECK (reel_by > bite_at)
+);
+
+INSERT INTO jobs (job_key, display_name, description, associated_features) VALUES
+    ('job_fisher', 'Fisher', 'Anglers with quick hands and rare catches', ARRAY['fish'])
+ON CONFLICT (job_key) DO NOTHING;
+
+-- +goose Down
+DELETE FROM jobs WHERE job_key = 'job_fisher';
+DROP TABLE IF EXISTS fishing_casts;
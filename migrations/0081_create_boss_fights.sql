@@ -0,0 +1,37 @@
+-- +goose Up
+-- Community boss fights. Each community has at most one active boss; it is
+-- defeated when attacks bring hp to 0, or escapes at expires_at.
+CREATE TABLE boss_fights (
+    id UUID PRIMARY KEY,
+    community_id TEXT NOT NULL DEFAULT 'default',
+    boss_key VARCHAR(100) NOT NULL,
+    name TEXT NOT NULL,
+    max_hp INTEGER NOT NULL CHECK (max_hp > 0),
+    hp INTEGER NOT NULL CHECK (hp >= 0),
+    reward_lootbox VARCHAR(100) NOT NULL,
+    reward_boxes INTEGER NOT NULL CHECK (reward_boxes >= 0),
+    state VARCHAR(20) NOT NULL DEFAULT 'active' CHECK (state IN ('active', 'defeated', 'escaped')),
+    spawned_by TEXT NOT NULL,
+    spawned_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    expires_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    ended_at TIMESTAMP WITH TIME ZONE
+);
+
+CREATE UNIQUE INDEX idx_boss_fights_single_active ON boss_fights (community_id)
+    WHERE state = 'active';
+
+-- Each user's damage against a boss. reward_boxes is their share of the
+-- boss's lootboxes, set when it is defeated.
+CREATE TABLE boss_attackers (
+    boss_id UUID NOT NULL REFERENCES boss_fights(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+    damage INTEGER NOT NULL DEFAULT 0,
+    attacks INTEGER NOT NULL DEFAULT 0,
+    reward_boxes INTEGER NOT NULL DEFAULT 0,
+    last_attack_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (boss_id, user_id)
+);
+
+-- +goose Down
+DROP TABLE IF EXISTS boss_attackers;
+DROP TABLE IF EXISTS boss_fights;
@@ -0,0 +1,16 @@
+-- +goose Up
+-- A seed growing in one of a user's garden plots. It is ready to harvest
+-- from ready_at; readiness is worked out on access, nothing ticks it along.
+CREATE TABLE garden_plots (
+    user_id UUID NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+    slot INTEGER NOT NULL CHECK (slot > 0),
+    seed VARCHAR(100) NOT NULL,
+    crop VARCHAR(100) NOT NULL,
+    quantity INTEGER NOT NULL CHECK (quantity > 0),
+    planted_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    ready_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    PRIMARY KEY (user_id, slot)
+);
+
+-- +goose Down
+DROP TABLE IF EXISTS garden_plots;
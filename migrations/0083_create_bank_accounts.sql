@@ -0,0 +1,22 @@
+-- +goose Up
+-- A user's savings in the bank. Interest is paid onto the balance by a
+-- scheduled job; withdrawals are counted per UTC day against a limit.
+CREATE TABLE bank_accounts (
+    user_id UUID PRIMARY KEY REFERENCES users(user_id) ON DELETE CASCADE,
+    balance BIGINT NOT NULL DEFAULT 0 CHECK (balance >= 0),
+    goal BIGINT CHECK (goal > 0),
+    goal_set_at TIMESTAMP WITH TIME ZONE,
+    goals_reached INTEGER NOT NULL DEFAULT 0,
+    interest_earned BIGINT NOT NULL DEFAULT 0,
+    last_interest_at TIMESTAMP WITH TIME ZONE,
+    withdrawn_today BIGINT NOT NULL DEFAULT 0,
+    withdrawn_on DATE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+-- The interest job only visits accounts with money in them
+CREATE INDEX idx_bank_accounts_balance ON bank_accounts (user_id) WHERE balance > 0;
+
+-- +goose Down
+DROP TABLE IF EXISTS bank_accounts;
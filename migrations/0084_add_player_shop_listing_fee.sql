@@ -0,0 +1,9 @@
+-- +goose Up
+-- The fee a seller paid to post a listing. It is taken out of circulation
+-- rather than paid to anyone, and is not refunded when the listing is
+-- cancelled.
+ALTER TABLE player_shop_listings
+    ADD COLUMN listing_fee INTEGER NOT NULL DEFAULT 0 CHECK (listing_fee >= 0);
+
+-- +goose Down
+ALTER TABLE player_shop_listings DROP COLUMN IF EXISTS listing_fee;
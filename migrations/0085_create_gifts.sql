@@ -0,0 +1,28 @@
+-- +goose Up
+-- Wrapped gifts waiting to be delivered. The items leave the sender's
+-- inventory when the gift is wrapped and reach the recipient once deliver_at
+-- passes. Rows are kept once closed as a record of the gift.
+-- A recipient deleted before delivery leaves recipient_id NULL, and the next
+-- sweep returns the items to the sender.
+CREATE TABLE gifts (
+    id BIGSERIAL PRIMARY KEY,
+    sender_id UUID NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+    recipient_id UUID REFERENCES users(user_id) ON DELETE SET NULL,
+    item_id INTEGER NOT NULL REFERENCES items(item_id) ON DELETE CASCADE,
+    quality_level TEXT NOT NULL,
+    quantity INTEGER NOT NULL CHECK (quantity > 0),
+    message TEXT NOT NULL DEFAULT '',
+    platform TEXT NOT NULL,
+    recipient_platform_id TEXT NOT NULL DEFAULT '',
+    channel_id TEXT NOT NULL DEFAULT '',
+    status TEXT NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'delivered', 'cancelled')),
+    deliver_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    closed_at TIMESTAMP WITH TIME ZONE
+);
+
+CREATE INDEX idx_gifts_deliver_at ON gifts (deliver_at) WHERE status = 'pending';
+CREATE INDEX idx_gifts_sender ON gifts (sender_id) WHERE status = 'pending';
+
+-- +goose Down
+DROP TABLE IF EXISTS gifts;
@@ -0,0 +1,19 @@
+-- +goose Up
+-- Per-user notifications written from event bus subscriptions. Only the
+-- newest messages are kept per user; older ones are dropped as new ones
+-- arrive.
+CREATE TABLE inbox_messages (
+    id BIGSERIAL PRIMARY KEY,
+    user_id UUID NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+    kind TEXT NOT NULL CHECK (kind IN ('reward', 'gift', 'achievement')),
+    title TEXT NOT NULL,
+    body TEXT NOT NULL DEFAULT '',
+    read_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_inbox_messages_user ON inbox_messages (user_id, id DESC);
+CREATE INDEX idx_inbox_messages_unread ON inbox_messages (user_id) WHERE read_at IS NULL;
+
+-- +goose Down
+DROP TABLE IF EXISTS inbox_messages;
@@ -0,0 +1,11 @@
+-- +goose Up
+-- The balance a savings goal was set from. The goal bonus is paid on what was
+-- saved since then, not on the whole goal, so a goal just above the balance
+-- pays next to nothing. Goals set before this column existed count from the
+-- balance they have now.
+ALTER TABLE bank_accounts ADD COLUMN goal_start BIGINT;
+
+UPDATE bank_accounts SET goal_start = LEAST(balance, goal) WHERE goal IS NOT NULL;
+
+-- +goose Down
+ALTER TABLE bank_accounts DROP COLUMN IF EXISTS goal_start;
@@ -0,0 +1,39 @@
+-- +goose Up
+-- Scope the player shop, the community pool and its donations to a
+-- community, like the tables in 0070. Listings and donations take the
+-- community of the user who made them; the single pool row becomes the
+-- default community's pool, and other communities get theirs on first credit.
+ALTER TABLE player_shop_listings ADD COLUMN community_id TEXT NOT NULL DEFAULT 'default';
+UPDATE player_shop_listings l SET community_id = u.community_id
+FROM users u WHERE u.user_id = l.seller_id;
+DROP INDEX IF EXISTS idx_player_shop_listings_item;
+CREATE INDEX idx_player_shop_listings_item ON player_shop_listings (community_id, item_id, unit_price) WHERE status = 'active';
+
+ALTER TABLE community_donations ADD COLUMN community_id TEXT NOT NULL DEFAULT 'default';
+UPDATE community_donations d SET community_id = u.community_id
+FROM users u WHERE u.user_id = d.user_id;
+CREATE INDEX idx_community_donations_community ON community_donations (community_id);
+
+ALTER TABLE community_pool ADD COLUMN community_id TEXT NOT NULL DEFAULT 'default';
+ALTER TABLE community_pool DROP CONSTRAINT community_pool_pkey;
+ALTER TABLE community_pool DROP COLUMN id;
+ALTER TABLE community_pool ADD PRIMARY KEY (community_id);
+
+-- +goose Down
+-- Rows outside the default community cannot be represented without the
+-- column and are dropped
+DELETE FROM community_pool WHERE community_id <> 'default';
+ALTER TABLE community_pool DROP CONSTRAINT community_pool_pkey;
+ALTER TABLE community_pool ADD COLUMN id SMALLINT NOT NULL DEFAULT 1 CHECK (id = 1);
+ALTER TABLE community_pool ADD PRIMARY KEY (id);
+ALTER TABLE community_pool DROP COLUMN community_id;
+INSERT INTO community_pool (id) VALUES (1) ON CONFLICT (id) DO NOTHING;
+
+DELETE FROM community_donations WHERE community_id <> 'default';
+DROP INDEX IF EXISTS idx_community_donations_community;
+ALTER TABLE community_donations DROP COLUMN community_id;
+
+DELETE FROM player_shop_listings WHERE community_id <> 'default';
+DROP INDEX IF EXISTS idx_player_shop_listings_item;
+CREATE INDEX idx_player_shop_listings_item ON player_shop_listings (item_id, unit_price) WHERE status = 'active';
+ALTER TABLE player_shop_listings DROP COLUMN community_id;
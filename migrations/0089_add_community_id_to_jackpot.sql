@@ -0,0 +1,29 @@
+-- +goose Up
+-- One jackpot per community, like the community pool in 0088. The single row
+-- becomes the default community's jackpot; other communities get theirs on
+-- the first opening. Wins take the community of their winner.
+ALTER TABLE jackpot ADD COLUMN community_id TEXT NOT NULL DEFAULT 'default';
+ALTER TABLE jackpot DROP CONSTRAINT jackpot_pkey;
+ALTER TABLE jackpot DROP COLUMN id;
+ALTER TABLE jackpot ADD PRIMARY KEY (community_id);
+
+ALTER TABLE jackpot_wins ADD COLUMN community_id TEXT NOT NULL DEFAULT 'default';
+UPDATE jackpot_wins w SET community_id = u.community_id
+FROM users u WHERE u.user_id = w.user_id;
+DROP INDEX IF EXISTS idx_jackpot_wins_won_at;
+CREATE INDEX idx_jackpot_wins_won_at ON jackpot_wins (community_id, won_at DESC);
+
+-- +goose Down
+-- Rows outside the default community cannot be represented without the
+-- column and are dropped
+DELETE FROM jackpot_wins WHERE community_id <> 'default';
+DROP INDEX IF EXISTS idx_jackpot_wins_won_at;
+CREATE INDEX idx_jackpot_wins_won_at ON jackpot_wins (won_at DESC);
+ALTER TABLE jackpot_wins DROP COLUMN community_id;
+
+DELETE FROM jackpot WHERE community_id <> 'default';
+ALTER TABLE jackpot DROP CONSTRAINT jackpot_pkey;
+ALTER TABLE jackpot ADD COLUMN id SMALLINT NOT NULL DEFAULT 1 CHECK (id = 1);
+ALTER TABLE jackpot ADD PRIMARY KEY (id);
+ALTER TABLE jackpot DROP COLUMN community_id;
+INSERT INTO jackpot (id) VALUES (1) ON CONFLICT (id) DO NOTHING;
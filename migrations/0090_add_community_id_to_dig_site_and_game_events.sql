@@ -0,0 +1,29 @@
+-- +goose Up
+-- One dig site per community, like the jackpot in 0089. The single row
+-- becomes the default community's site; other communities start theirs on
+-- the first dig. Diggers already belong to a community through their user.
+ALTER TABLE dig_site ADD COLUMN community_id TEXT NOT NULL DEFAULT 'default';
+ALTER TABLE dig_site DROP CONSTRAINT dig_site_pkey;
+ALTER TABLE dig_site DROP COLUMN id;
+ALTER TABLE dig_site ADD PRIMARY KEY (community_id);
+
+-- Game events only change the game of the community that created them.
+-- Existing events belong to the default community.
+ALTER TABLE game_events ADD COLUMN community_id TEXT NOT NULL DEFAULT 'default';
+DROP INDEX IF EXISTS idx_game_events_ends_at;
+CREATE INDEX idx_game_events_ends_at ON game_events (community_id, ends_at);
+
+-- +goose Down
+-- Rows outside the default community cannot be represented without the
+-- column and are dropped
+DELETE FROM game_events WHERE community_id <> 'default';
+DROP INDEX IF EXISTS idx_game_events_ends_at;
+CREATE INDEX idx_game_events_ends_at ON game_events (ends_at);
+ALTER TABLE game_events DROP COLUMN community_id;
+
+DELETE FROM dig_site WHERE community_id <> 'default';
+ALTER TABLE dig_site DROP CONSTRAINT dig_site_pkey;
+ALTER TABLE dig_site ADD COLUMN id SMALLINT NOT NULL DEFAULT 1 CHECK (id = 1);
+ALTER TABLE dig_site ADD PRIMARY KEY (id);
+ALTER TABLE dig_site DROP COLUMN community_id;
+INSERT INTO dig_site (id) VALUES (1) ON CONFLICT (id) DO NOTHING;
@@ -0,0 +1,7 @@
+-- +goose Up
+-- A game event can run an event theme, which opens the crafting recipes and
+-- chat keyword drops tagged with it while the event lasts
+ALTER TABLE game_events ADD COLUMN theme TEXT NOT NULL DEFAULT '';
+
+-- +goose Down
+ALTER TABLE game_events DROP COLUMN theme;
@@ -5,6 +5,8 @@ package mocks
 import (
 	context "context"
 
+	domain "github.com/osse101/BrandishBot_Go/internal/domain"
+
 	mock "github.com/stretchr/testify/mock"
 
 	time "time"
@@ -137,6 +139,65 @@ func (_c *MockCooldownService_EnforceCooldown_Call) RunAndReturn(run func(contex
 	return _c
 }
 
+// GetCooldowns provides a mock function with given fields: ctx, userID
+func (_m *MockCooldownService) GetCooldowns(ctx context.Context, userID string) ([]domain.CooldownTimer, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCooldowns")
+	}
+
+	var r0 []domain.CooldownTimer
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]domain.CooldownTimer, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []domain.CooldownTimer); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.CooldownTimer)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCooldownService_GetCooldowns_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCooldowns'
+type MockCooldownService_GetCooldowns_Call struct {
+	*mock.Call
+}
+
+// GetCooldowns is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+func (_e *MockCooldownService_Expecter) GetCooldowns(ctx interface{}, userID interface{}) *MockCooldownService_GetCooldowns_Call {
+	return &MockCooldownService_GetCooldowns_Call{Call: _e.mock.On("GetCooldowns", ctx, userID)}
+}
+
+func (_c *MockCooldownService_GetCooldowns_Call) Run(run func(ctx context.Context, userID string)) *MockCooldownService_GetCooldowns_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockCooldownService_GetCooldowns_Call) Return(_a0 []domain.CooldownTimer, _a1 error) *MockCooldownService_GetCooldowns_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCooldownService_GetCooldowns_Call) RunAndReturn(run func(context.Context, string) ([]domain.CooldownTimer, error)) *MockCooldownService_GetCooldowns_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetLastUsed provides a mock function with given fields: ctx, userID, action
 func (_m *MockCooldownService) GetLastUsed(ctx context.Context, userID string, action string) (*time.Time, error) {
 	ret := _m.Called(ctx, userID, action)
@@ -207,6 +207,67 @@ func (_c *MockCraftingService_GetRecipe_Call) RunAndReturn(run func(context.Cont
 	return _c
 }
 
+// GetRecipeMastery provides a mock function with given fields: ctx, platform, platformID, username
+func (_m *MockCraftingService) GetRecipeMastery(ctx context.Context, platform string, platformID string, username string) ([]crafting.RecipeMastery, error) {
+	ret := _m.Called(ctx, platform, platformID, username)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRecipeMastery")
+	}
+
+	var r0 []crafting.RecipeMastery
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) ([]crafting.RecipeMastery, error)); ok {
+		return rf(ctx, platform, platformID, username)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) []crafting.RecipeMastery); ok {
+		r0 = rf(ctx, platform, platformID, username)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]crafting.RecipeMastery)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string) error); ok {
+		r1 = rf(ctx, platform, platformID, username)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCraftingService_GetRecipeMastery_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRecipeMastery'
+type MockCraftingService_GetRecipeMastery_Call struct {
+	*mock.Call
+}
+
+// GetRecipeMastery is a helper method to define mock.On call
+//   - ctx context.Context
+//   - platform string
+//   - platformID string
+//   - username string
+func (_e *MockCraftingService_Expecter) GetRecipeMastery(ctx interface{}, platform interface{}, platformID interface{}, username interface{}) *MockCraftingService_GetRecipeMastery_Call {
+	return &MockCraftingService_GetRecipeMastery_Call{Call: _e.mock.On("GetRecipeMastery", ctx, platform, platformID, username)}
+}
+
+func (_c *MockCraftingService_GetRecipeMastery_Call) Run(run func(ctx context.Context, platform string, platformID string, username string)) *MockCraftingService_GetRecipeMastery_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *MockCraftingService_GetRecipeMastery_Call) Return(_a0 []crafting.RecipeMastery, _a1 error) *MockCraftingService_GetRecipeMastery_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCraftingService_GetRecipeMastery_Call) RunAndReturn(run func(context.Context, string, string, string) ([]crafting.RecipeMastery, error)) *MockCraftingService_GetRecipeMastery_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetUnlockedRecipes provides a mock function with given fields: ctx, platform, platformID, username
 func (_m *MockCraftingService) GetUnlockedRecipes(ctx context.Context, platform string, platformID string, username string) ([]repository.UnlockedRecipeInfo, error) {
 	ret := _m.Called(ctx, platform, platformID, username)
@@ -268,6 +329,69 @@ func (_c *MockCraftingService_GetUnlockedRecipes_Call) RunAndReturn(run func(con
 	return _c
 }
 
+// PreviewUpgrade provides a mock function with given fields: ctx, platform, platformID, username, itemName, quantity
+func (_m *MockCraftingService) PreviewUpgrade(ctx context.Context, platform string, platformID string, username string, itemName string, quantity int) (*crafting.UpgradePreview, error) {
+	ret := _m.Called(ctx, platform, platformID, username, itemName, quantity)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PreviewUpgrade")
+	}
+
+	var r0 *crafting.UpgradePreview
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, string, int) (*crafting.UpgradePreview, error)); ok {
+		return rf(ctx, platform, platformID, username, itemName, quantity)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, string, int) *crafting.UpgradePreview); ok {
+		r0 = rf(ctx, platform, platformID, username, itemName, quantity)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*crafting.UpgradePreview)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string, string, int) error); ok {
+		r1 = rf(ctx, platform, platformID, username, itemName, quantity)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCraftingService_PreviewUpgrade_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PreviewUpgrade'
+type MockCraftingService_PreviewUpgrade_Call struct {
+	*mock.Call
+}
+
+// PreviewUpgrade is a helper method to define mock.On call
+//   - ctx context.Context
+//   - platform string
+//   - platformID string
+//   - username string
+//   - itemName string
+//   - quantity int
+func (_e *MockCraftingService_Expecter) PreviewUpgrade(ctx interface{}, platform interface{}, platformID interface{}, username interface{}, itemName interface{}, quantity interface{}) *MockCraftingService_PreviewUpgrade_Call {
+	return &MockCraftingService_PreviewUpgrade_Call{Call: _e.mock.On("PreviewUpgrade", ctx, platform, platformID, username, itemName, quantity)}
+}
+
+func (_c *MockCraftingService_PreviewUpgrade_Call) Run(run func(ctx context.Context, platform string, platformID string, username string, itemName string, quantity int)) *MockCraftingService_PreviewUpgrade_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string), args[4].(string), args[5].(int))
+	})
+	return _c
+}
+
+func (_c *MockCraftingService_PreviewUpgrade_Call) Return(_a0 *crafting.UpgradePreview, _a1 error) *MockCraftingService_PreviewUpgrade_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCraftingService_PreviewUpgrade_Call) RunAndReturn(run func(context.Context, string, string, string, string, int) (*crafting.UpgradePreview, error)) *MockCraftingService_PreviewUpgrade_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Shutdown provides a mock function with given fields: ctx
 func (_m *MockCraftingService) Shutdown(ctx context.Context) error {
 	ret := _m.Called(ctx)
@@ -8,6 +8,8 @@ import (
 	domain "github.com/osse101/BrandishBot_Go/internal/domain"
 
 	mock "github.com/stretchr/testify/mock"
+
+	time "time"
 )
 
 // MockEconomyService is an autogenerated mock type for the Service type
@@ -142,6 +144,125 @@ func (_c *MockEconomyService_GetBuyablePrices_Call) RunAndReturn(run func(contex
 	return _c
 }
 
+// GetCurrentWeeklySale provides a mock function with given fields: ctx
+func (_m *MockEconomyService) GetCurrentWeeklySale(ctx context.Context) (*domain.WeeklySale, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCurrentWeeklySale")
+	}
+
+	var r0 *domain.WeeklySale
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (*domain.WeeklySale, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) *domain.WeeklySale); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.WeeklySale)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockEconomyService_GetCurrentWeeklySale_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCurrentWeeklySale'
+type MockEconomyService_GetCurrentWeeklySale_Call struct {
+	*mock.Call
+}
+
+// GetCurrentWeeklySale is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockEconomyService_Expecter) GetCurrentWeeklySale(ctx interface{}) *MockEconomyService_GetCurrentWeeklySale_Call {
+	return &MockEconomyService_GetCurrentWeeklySale_Call{Call: _e.mock.On("GetCurrentWeeklySale", ctx)}
+}
+
+func (_c *MockEconomyService_GetCurrentWeeklySale_Call) Run(run func(ctx context.Context)) *MockEconomyService_GetCurrentWeeklySale_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockEconomyService_GetCurrentWeeklySale_Call) Return(_a0 *domain.WeeklySale, _a1 error) *MockEconomyService_GetCurrentWeeklySale_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockEconomyService_GetCurrentWeeklySale_Call) RunAndReturn(run func(context.Context) (*domain.WeeklySale, error)) *MockEconomyService_GetCurrentWeeklySale_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPriceHistory provides a mock function with given fields: ctx, itemName, since, limit
+func (_m *MockEconomyService) GetPriceHistory(ctx context.Context, itemName string, since time.Time, limit int) (*domain.PriceHistory, error) {
+	ret := _m.Called(ctx, itemName, since, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPriceHistory")
+	}
+
+	var r0 *domain.PriceHistory
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Time, int) (*domain.PriceHistory, error)); ok {
+		return rf(ctx, itemName, since, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Time, int) *domain.PriceHistory); ok {
+		r0 = rf(ctx, itemName, since, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.PriceHistory)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, time.Time, int) error); ok {
+		r1 = rf(ctx, itemName, since, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockEconomyService_GetPriceHistory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPriceHistory'
+type MockEconomyService_GetPriceHistory_Call struct {
+	*mock.Call
+}
+
+// GetPriceHistory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - itemName string
+//   - since time.Time
+//   - limit int
+func (_e *MockEconomyService_Expecter) GetPriceHistory(ctx interface{}, itemName interface{}, since interface{}, limit interface{}) *MockEconomyService_GetPriceHistory_Call {
+	return &MockEconomyService_GetPriceHistory_Call{Call: _e.mock.On("GetPriceHistory", ctx, itemName, since, limit)}
+}
+
+func (_c *MockEconomyService_GetPriceHistory_Call) Run(run func(ctx context.Context, itemName string, since time.Time, limit int)) *MockEconomyService_GetPriceHistory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(time.Time), args[3].(int))
+	})
+	return _c
+}
+
+func (_c *MockEconomyService_GetPriceHistory_Call) Return(_a0 *domain.PriceHistory, _a1 error) *MockEconomyService_GetPriceHistory_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockEconomyService_GetPriceHistory_Call) RunAndReturn(run func(context.Context, string, time.Time, int) (*domain.PriceHistory, error)) *MockEconomyService_GetPriceHistory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetSellablePrices provides a mock function with given fields: ctx
 func (_m *MockEconomyService) GetSellablePrices(ctx context.Context) ([]domain.Item, error) {
 	ret := _m.Called(ctx)
@@ -200,6 +321,62 @@ func (_c *MockEconomyService_GetSellablePrices_Call) RunAndReturn(run func(conte
 	return _c
 }
 
+// RecordPriceSnapshot provides a mock function with given fields: ctx
+func (_m *MockEconomyService) RecordPriceSnapshot(ctx context.Context) (int, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecordPriceSnapshot")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (int, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) int); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockEconomyService_RecordPriceSnapshot_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecordPriceSnapshot'
+type MockEconomyService_RecordPriceSnapshot_Call struct {
+	*mock.Call
+}
+
+// RecordPriceSnapshot is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockEconomyService_Expecter) RecordPriceSnapshot(ctx interface{}) *MockEconomyService_RecordPriceSnapshot_Call {
+	return &MockEconomyService_RecordPriceSnapshot_Call{Call: _e.mock.On("RecordPriceSnapshot", ctx)}
+}
+
+func (_c *MockEconomyService_RecordPriceSnapshot_Call) Run(run func(ctx context.Context)) *MockEconomyService_RecordPriceSnapshot_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockEconomyService_RecordPriceSnapshot_Call) Return(_a0 int, _a1 error) *MockEconomyService_RecordPriceSnapshot_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockEconomyService_RecordPriceSnapshot_Call) RunAndReturn(run func(context.Context) (int, error)) *MockEconomyService_RecordPriceSnapshot_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SellItem provides a mock function with given fields: ctx, platform, platformID, username, itemName, quantity
 func (_m *MockEconomyService) SellItem(ctx context.Context, platform string, platformID string, username string, itemName string, quantity int) (int, int, error) {
 	ret := _m.Called(ctx, platform, platformID, username, itemName, quantity)
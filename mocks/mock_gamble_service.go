@@ -9,6 +9,8 @@ import (
 
 	mock "github.com/stretchr/testify/mock"
 
+	time "time"
+
 	uuid "github.com/google/uuid"
 )
 
@@ -201,6 +203,65 @@ func (_c *MockGambleService_GetGamble_Call) RunAndReturn(run func(context.Contex
 	return _c
 }
 
+// GetOdds provides a mock function with given fields: ctx, id
+func (_m *MockGambleService) GetOdds(ctx context.Context, id uuid.UUID) (*domain.GambleOdds, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOdds")
+	}
+
+	var r0 *domain.GambleOdds
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*domain.GambleOdds, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *domain.GambleOdds); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.GambleOdds)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGambleService_GetOdds_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOdds'
+type MockGambleService_GetOdds_Call struct {
+	*mock.Call
+}
+
+// GetOdds is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *MockGambleService_Expecter) GetOdds(ctx interface{}, id interface{}) *MockGambleService_GetOdds_Call {
+	return &MockGambleService_GetOdds_Call{Call: _e.mock.On("GetOdds", ctx, id)}
+}
+
+func (_c *MockGambleService_GetOdds_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockGambleService_GetOdds_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockGambleService_GetOdds_Call) Return(_a0 *domain.GambleOdds, _a1 error) *MockGambleService_GetOdds_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGambleService_GetOdds_Call) RunAndReturn(run func(context.Context, uuid.UUID) (*domain.GambleOdds, error)) *MockGambleService_GetOdds_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // JoinActiveGamble provides a mock function with given fields: ctx, platform, platformID, username
 func (_m *MockGambleService) JoinActiveGamble(ctx context.Context, platform string, platformID string, username string) error {
 	ret := _m.Called(ctx, platform, platformID, username)
@@ -300,6 +361,129 @@ func (_c *MockGambleService_JoinGamble_Call) RunAndReturn(run func(context.Conte
 	return _c
 }
 
+// PlaceSideBet provides a mock function with given fields: ctx, gambleID, platform, platformID, username, backedUsername, amount
+func (_m *MockGambleService) PlaceSideBet(ctx context.Context, gambleID uuid.UUID, platform string, platformID string, username string, backedUsername string, amount int) (*domain.SideBet, error) {
+	ret := _m.Called(ctx, gambleID, platform, platformID, username, backedUsername, amount)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PlaceSideBet")
+	}
+
+	var r0 *domain.SideBet
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, string, string, string, int) (*domain.SideBet, error)); ok {
+		return rf(ctx, gambleID, platform, platformID, username, backedUsername, amount)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, string, string, string, int) *domain.SideBet); ok {
+		r0 = rf(ctx, gambleID, platform, platformID, username, backedUsername, amount)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.SideBet)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, string, string, string, string, int) error); ok {
+		r1 = rf(ctx, gambleID, platform, platformID, username, backedUsername, amount)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGambleService_PlaceSideBet_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PlaceSideBet'
+type MockGambleService_PlaceSideBet_Call struct {
+	*mock.Call
+}
+
+// PlaceSideBet is a helper method to define mock.On call
+//   - ctx context.Context
+//   - gambleID uuid.UUID
+//   - platform string
+//   - platformID string
+//   - username string
+//   - backedUsername string
+//   - amount int
+func (_e *MockGambleService_Expecter) PlaceSideBet(ctx interface{}, gambleID interface{}, platform interface{}, platformID interface{}, username interface{}, backedUsername interface{}, amount interface{}) *MockGambleService_PlaceSideBet_Call {
+	return &MockGambleService_PlaceSideBet_Call{Call: _e.mock.On("PlaceSideBet", ctx, gambleID, platform, platformID, username, backedUsername, amount)}
+}
+
+func (_c *MockGambleService_PlaceSideBet_Call) Run(run func(ctx context.Context, gambleID uuid.UUID, platform string, platformID string, username string, backedUsername string, amount int)) *MockGambleService_PlaceSideBet_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string), args[3].(string), args[4].(string), args[5].(string), args[6].(int))
+	})
+	return _c
+}
+
+func (_c *MockGambleService_PlaceSideBet_Call) Return(_a0 *domain.SideBet, _a1 error) *MockGambleService_PlaceSideBet_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGambleService_PlaceSideBet_Call) RunAndReturn(run func(context.Context, uuid.UUID, string, string, string, string, int) (*domain.SideBet, error)) *MockGambleService_PlaceSideBet_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RecoverStaleGambles provides a mock function with given fields: ctx, staleAfter
+func (_m *MockGambleService) RecoverStaleGambles(ctx context.Context, staleAfter time.Duration) ([]domain.GambleRecovery, error) {
+	ret := _m.Called(ctx, staleAfter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecoverStaleGambles")
+	}
+
+	var r0 []domain.GambleRecovery
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Duration) ([]domain.GambleRecovery, error)); ok {
+		return rf(ctx, staleAfter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Duration) []domain.GambleRecovery); ok {
+		r0 = rf(ctx, staleAfter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.GambleRecovery)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Duration) error); ok {
+		r1 = rf(ctx, staleAfter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGambleService_RecoverStaleGambles_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecoverStaleGambles'
+type MockGambleService_RecoverStaleGambles_Call struct {
+	*mock.Call
+}
+
+// RecoverStaleGambles is a helper method to define mock.On call
+//   - ctx context.Context
+//   - staleAfter time.Duration
+func (_e *MockGambleService_Expecter) RecoverStaleGambles(ctx interface{}, staleAfter interface{}) *MockGambleService_RecoverStaleGambles_Call {
+	return &MockGambleService_RecoverStaleGambles_Call{Call: _e.mock.On("RecoverStaleGambles", ctx, staleAfter)}
+}
+
+func (_c *MockGambleService_RecoverStaleGambles_Call) Run(run func(ctx context.Context, staleAfter time.Duration)) *MockGambleService_RecoverStaleGambles_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Duration))
+	})
+	return _c
+}
+
+func (_c *MockGambleService_RecoverStaleGambles_Call) Return(_a0 []domain.GambleRecovery, _a1 error) *MockGambleService_RecoverStaleGambles_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGambleService_RecoverStaleGambles_Call) RunAndReturn(run func(context.Context, time.Duration) ([]domain.GambleRecovery, error)) *MockGambleService_RecoverStaleGambles_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // StartGamble provides a mock function with given fields: ctx, platform, platformID, username, bets
 func (_m *MockGambleService) StartGamble(ctx context.Context, platform string, platformID string, username string, bets []domain.LootboxBet) (*domain.Gamble, error) {
 	ret := _m.Called(ctx, platform, platformID, username, bets)
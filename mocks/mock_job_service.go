@@ -8,6 +8,8 @@ import (
 	domain "github.com/osse101/BrandishBot_Go/internal/domain"
 
 	mock "github.com/stretchr/testify/mock"
+
+	time "time"
 )
 
 // MockJobService is an autogenerated mock type for the Service type
@@ -370,6 +372,67 @@ func (_c *MockJobService_GetJobLevel_Call) RunAndReturn(run func(context.Context
 	return _c
 }
 
+// GetJobPerks provides a mock function with given fields: ctx, jobKey, platform, platformID
+func (_m *MockJobService) GetJobPerks(ctx context.Context, jobKey string, platform string, platformID string) (*domain.JobPerks, error) {
+	ret := _m.Called(ctx, jobKey, platform, platformID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetJobPerks")
+	}
+
+	var r0 *domain.JobPerks
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) (*domain.JobPerks, error)); ok {
+		return rf(ctx, jobKey, platform, platformID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) *domain.JobPerks); ok {
+		r0 = rf(ctx, jobKey, platform, platformID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.JobPerks)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string) error); ok {
+		r1 = rf(ctx, jobKey, platform, platformID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockJobService_GetJobPerks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetJobPerks'
+type MockJobService_GetJobPerks_Call struct {
+	*mock.Call
+}
+
+// GetJobPerks is a helper method to define mock.On call
+//   - ctx context.Context
+//   - jobKey string
+//   - platform string
+//   - platformID string
+func (_e *MockJobService_Expecter) GetJobPerks(ctx interface{}, jobKey interface{}, platform interface{}, platformID interface{}) *MockJobService_GetJobPerks_Call {
+	return &MockJobService_GetJobPerks_Call{Call: _e.mock.On("GetJobPerks", ctx, jobKey, platform, platformID)}
+}
+
+func (_c *MockJobService_GetJobPerks_Call) Run(run func(ctx context.Context, jobKey string, platform string, platformID string)) *MockJobService_GetJobPerks_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *MockJobService_GetJobPerks_Call) Return(_a0 *domain.JobPerks, _a1 error) *MockJobService_GetJobPerks_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockJobService_GetJobPerks_Call) RunAndReturn(run func(context.Context, string, string, string) (*domain.JobPerks, error)) *MockJobService_GetJobPerks_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetPrimaryJob provides a mock function with given fields: ctx, platform, platformID
 func (_m *MockJobService) GetPrimaryJob(ctx context.Context, platform string, platformID string) (*domain.UserJobInfo, error) {
 	ret := _m.Called(ctx, platform, platformID)
@@ -430,6 +493,66 @@ func (_c *MockJobService_GetPrimaryJob_Call) RunAndReturn(run func(context.Conte
 	return _c
 }
 
+// GetRecentXPAwards provides a mock function with given fields: ctx, userID, limit
+func (_m *MockJobService) GetRecentXPAwards(ctx context.Context, userID string, limit int) ([]domain.XPAward, error) {
+	ret := _m.Called(ctx, userID, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRecentXPAwards")
+	}
+
+	var r0 []domain.XPAward
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int) ([]domain.XPAward, error)); ok {
+		return rf(ctx, userID, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int) []domain.XPAward); ok {
+		r0 = rf(ctx, userID, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.XPAward)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int) error); ok {
+		r1 = rf(ctx, userID, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockJobService_GetRecentXPAwards_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRecentXPAwards'
+type MockJobService_GetRecentXPAwards_Call struct {
+	*mock.Call
+}
+
+// GetRecentXPAwards is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - limit int
+func (_e *MockJobService_Expecter) GetRecentXPAwards(ctx interface{}, userID interface{}, limit interface{}) *MockJobService_GetRecentXPAwards_Call {
+	return &MockJobService_GetRecentXPAwards_Call{Call: _e.mock.On("GetRecentXPAwards", ctx, userID, limit)}
+}
+
+func (_c *MockJobService_GetRecentXPAwards_Call) Run(run func(ctx context.Context, userID string, limit int)) *MockJobService_GetRecentXPAwards_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *MockJobService_GetRecentXPAwards_Call) Return(_a0 []domain.XPAward, _a1 error) *MockJobService_GetRecentXPAwards_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockJobService_GetRecentXPAwards_Call) RunAndReturn(run func(context.Context, string, int) ([]domain.XPAward, error)) *MockJobService_GetRecentXPAwards_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetUserByPlatformID provides a mock function with given fields: ctx, platform, platformID
 func (_m *MockJobService) GetUserByPlatformID(ctx context.Context, platform string, platformID string) (*domain.User, error) {
 	ret := _m.Called(ctx, platform, platformID)
@@ -725,6 +848,55 @@ func (_c *MockJobService_GetXPProgress_Call) RunAndReturn(run func(int64) (int,
 	return _c
 }
 
+// GrantXPBoost provides a mock function with given fields: ctx, userID, multiplier, duration
+func (_m *MockJobService) GrantXPBoost(ctx context.Context, userID string, multiplier float64, duration time.Duration) error {
+	ret := _m.Called(ctx, userID, multiplier, duration)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GrantXPBoost")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, float64, time.Duration) error); ok {
+		r0 = rf(ctx, userID, multiplier, duration)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockJobService_GrantXPBoost_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GrantXPBoost'
+type MockJobService_GrantXPBoost_Call struct {
+	*mock.Call
+}
+
+// GrantXPBoost is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - multiplier float64
+//   - duration time.Duration
+func (_e *MockJobService_Expecter) GrantXPBoost(ctx interface{}, userID interface{}, multiplier interface{}, duration interface{}) *MockJobService_GrantXPBoost_Call {
+	return &MockJobService_GrantXPBoost_Call{Call: _e.mock.On("GrantXPBoost", ctx, userID, multiplier, duration)}
+}
+
+func (_c *MockJobService_GrantXPBoost_Call) Run(run func(ctx context.Context, userID string, multiplier float64, duration time.Duration)) *MockJobService_GrantXPBoost_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(float64), args[3].(time.Duration))
+	})
+	return _c
+}
+
+func (_c *MockJobService_GrantXPBoost_Call) Return(_a0 error) *MockJobService_GrantXPBoost_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockJobService_GrantXPBoost_Call) RunAndReturn(run func(context.Context, string, float64, time.Duration) error) *MockJobService_GrantXPBoost_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // IsJobFeatureUnlocked provides a mock function with given fields: ctx, userID, featureKey
 func (_m *MockJobService) IsJobFeatureUnlocked(ctx context.Context, userID string, featureKey string) (bool, error) {
 	ret := _m.Called(ctx, userID, featureKey)
@@ -783,6 +955,67 @@ func (_c *MockJobService_IsJobFeatureUnlocked_Call) RunAndReturn(run func(contex
 	return _c
 }
 
+// PrestigeJob provides a mock function with given fields: ctx, platform, platformID, jobKey
+func (_m *MockJobService) PrestigeJob(ctx context.Context, platform string, platformID string, jobKey string) (*domain.JobPrestigeResult, error) {
+	ret := _m.Called(ctx, platform, platformID, jobKey)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PrestigeJob")
+	}
+
+	var r0 *domain.JobPrestigeResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) (*domain.JobPrestigeResult, error)); ok {
+		return rf(ctx, platform, platformID, jobKey)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) *domain.JobPrestigeResult); ok {
+		r0 = rf(ctx, platform, platformID, jobKey)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.JobPrestigeResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string) error); ok {
+		r1 = rf(ctx, platform, platformID, jobKey)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockJobService_PrestigeJob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PrestigeJob'
+type MockJobService_PrestigeJob_Call struct {
+	*mock.Call
+}
+
+// PrestigeJob is a helper method to define mock.On call
+//   - ctx context.Context
+//   - platform string
+//   - platformID string
+//   - jobKey string
+func (_e *MockJobService_Expecter) PrestigeJob(ctx interface{}, platform interface{}, platformID interface{}, jobKey interface{}) *MockJobService_PrestigeJob_Call {
+	return &MockJobService_PrestigeJob_Call{Call: _e.mock.On("PrestigeJob", ctx, platform, platformID, jobKey)}
+}
+
+func (_c *MockJobService_PrestigeJob_Call) Run(run func(ctx context.Context, platform string, platformID string, jobKey string)) *MockJobService_PrestigeJob_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *MockJobService_PrestigeJob_Call) Return(_a0 *domain.JobPrestigeResult, _a1 error) *MockJobService_PrestigeJob_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockJobService_PrestigeJob_Call) RunAndReturn(run func(context.Context, string, string, string) (*domain.JobPrestigeResult, error)) *MockJobService_PrestigeJob_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ResetDailyJobXP provides a mock function with given fields: ctx
 func (_m *MockJobService) ResetDailyJobXP(ctx context.Context) (int64, error) {
 	ret := _m.Called(ctx)
@@ -885,6 +1118,67 @@ func (_c *MockJobService_Shutdown_Call) RunAndReturn(run func(context.Context) e
 	return _c
 }
 
+// SwitchActiveJob provides a mock function with given fields: ctx, platform, platformID, jobKey
+func (_m *MockJobService) SwitchActiveJob(ctx context.Context, platform string, platformID string, jobKey string) (*domain.UserJobInfo, error) {
+	ret := _m.Called(ctx, platform, platformID, jobKey)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SwitchActiveJob")
+	}
+
+	var r0 *domain.UserJobInfo
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) (*domain.UserJobInfo, error)); ok {
+		return rf(ctx, platform, platformID, jobKey)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) *domain.UserJobInfo); ok {
+		r0 = rf(ctx, platform, platformID, jobKey)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.UserJobInfo)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string) error); ok {
+		r1 = rf(ctx, platform, platformID, jobKey)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockJobService_SwitchActiveJob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SwitchActiveJob'
+type MockJobService_SwitchActiveJob_Call struct {
+	*mock.Call
+}
+
+// SwitchActiveJob is a helper method to define mock.On call
+//   - ctx context.Context
+//   - platform string
+//   - platformID string
+//   - jobKey string
+func (_e *MockJobService_Expecter) SwitchActiveJob(ctx interface{}, platform interface{}, platformID interface{}, jobKey interface{}) *MockJobService_SwitchActiveJob_Call {
+	return &MockJobService_SwitchActiveJob_Call{Call: _e.mock.On("SwitchActiveJob", ctx, platform, platformID, jobKey)}
+}
+
+func (_c *MockJobService_SwitchActiveJob_Call) Run(run func(ctx context.Context, platform string, platformID string, jobKey string)) *MockJobService_SwitchActiveJob_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *MockJobService_SwitchActiveJob_Call) Return(_a0 *domain.UserJobInfo, _a1 error) *MockJobService_SwitchActiveJob_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockJobService_SwitchActiveJob_Call) RunAndReturn(run func(context.Context, string, string, string) (*domain.UserJobInfo, error)) *MockJobService_SwitchActiveJob_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockJobService creates a new instance of MockJobService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockJobService(t interface {
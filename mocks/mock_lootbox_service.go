@@ -24,9 +24,9 @@ func (_m *MockLootboxService) EXPECT() *MockLootboxService_Expecter {
 	return &MockLootboxService_Expecter{mock: &_m.Mock}
 }
 
-// OpenLootbox provides a mock function with given fields: ctx, lootboxName, quantity, boxQuality
-func (_m *MockLootboxService) OpenLootbox(ctx context.Context, lootboxName string, quantity int, boxQuality domain.QualityLevel) ([]lootbox.DroppedItem, error) {
-	ret := _m.Called(ctx, lootboxName, quantity, boxQuality)
+// OpenLootbox provides a mock function with given fields: ctx, userID, lootboxName, quantity, boxQuality
+func (_m *MockLootboxService) OpenLootbox(ctx context.Context, userID string, lootboxName string, quantity int, boxQuality domain.QualityLevel) ([]lootbox.DroppedItem, error) {
+	ret := _m.Called(ctx, userID, lootboxName, quantity, boxQuality)
 
 	if len(ret) == 0 {
 		panic("no return value specified for OpenLootbox")
@@ -34,19 +34,19 @@ func (_m *MockLootboxService) OpenLootbox(ctx context.Context, lootboxName strin
 
 	var r0 []lootbox.DroppedItem
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, int, domain.QualityLevel) ([]lootbox.DroppedItem, error)); ok {
-		return rf(ctx, lootboxName, quantity, boxQuality)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, int, domain.QualityLevel) ([]lootbox.DroppedItem, error)); ok {
+		return rf(ctx, userID, lootboxName, quantity, boxQuality)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string, int, domain.QualityLevel) []lootbox.DroppedItem); ok {
-		r0 = rf(ctx, lootboxName, quantity, boxQuality)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, int, domain.QualityLevel) []lootbox.DroppedItem); ok {
+		r0 = rf(ctx, userID, lootboxName, quantity, boxQuality)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]lootbox.DroppedItem)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string, int, domain.QualityLevel) error); ok {
-		r1 = rf(ctx, lootboxName, quantity, boxQuality)
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, int, domain.QualityLevel) error); ok {
+		r1 = rf(ctx, userID, lootboxName, quantity, boxQuality)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -61,16 +61,17 @@ type MockLootboxService_OpenLootbox_Call struct {
 
 // OpenLootbox is a helper method to define mock.On call
 //   - ctx context.Context
+//   - userID string
 //   - lootboxName string
 //   - quantity int
 //   - boxQuality domain.QualityLevel
-func (_e *MockLootboxService_Expecter) OpenLootbox(ctx interface{}, lootboxName interface{}, quantity interface{}, boxQuality interface{}) *MockLootboxService_OpenLootbox_Call {
-	return &MockLootboxService_OpenLootbox_Call{Call: _e.mock.On("OpenLootbox", ctx, lootboxName, quantity, boxQuality)}
+func (_e *MockLootboxService_Expecter) OpenLootbox(ctx interface{}, userID interface{}, lootboxName interface{}, quantity interface{}, boxQuality interface{}) *MockLootboxService_OpenLootbox_Call {
+	return &MockLootboxService_OpenLootbox_Call{Call: _e.mock.On("OpenLootbox", ctx, userID, lootboxName, quantity, boxQuality)}
 }
 
-func (_c *MockLootboxService_OpenLootbox_Call) Run(run func(ctx context.Context, lootboxName string, quantity int, boxQuality domain.QualityLevel)) *MockLootboxService_OpenLootbox_Call {
+func (_c *MockLootboxService_OpenLootbox_Call) Run(run func(ctx context.Context, userID string, lootboxName string, quantity int, boxQuality domain.QualityLevel)) *MockLootboxService_OpenLootbox_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string), args[2].(int), args[3].(domain.QualityLevel))
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(int), args[4].(domain.QualityLevel))
 	})
 	return _c
 }
@@ -80,7 +81,53 @@ func (_c *MockLootboxService_OpenLootbox_Call) Return(_a0 []lootbox.DroppedItem,
 	return _c
 }
 
-func (_c *MockLootboxService_OpenLootbox_Call) RunAndReturn(run func(context.Context, string, int, domain.QualityLevel) ([]lootbox.DroppedItem, error)) *MockLootboxService_OpenLootbox_Call {
+func (_c *MockLootboxService_OpenLootbox_Call) RunAndReturn(run func(context.Context, string, string, int, domain.QualityLevel) ([]lootbox.DroppedItem, error)) *MockLootboxService_OpenLootbox_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Reload provides a mock function with given fields: ctx
+func (_m *MockLootboxService) Reload(ctx context.Context) error {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Reload")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockLootboxService_Reload_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Reload'
+type MockLootboxService_Reload_Call struct {
+	*mock.Call
+}
+
+// Reload is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockLootboxService_Expecter) Reload(ctx interface{}) *MockLootboxService_Reload_Call {
+	return &MockLootboxService_Reload_Call{Call: _e.mock.On("Reload", ctx)}
+}
+
+func (_c *MockLootboxService_Reload_Call) Run(run func(ctx context.Context)) *MockLootboxService_Reload_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockLootboxService_Reload_Call) Return(_a0 error) *MockLootboxService_Reload_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockLootboxService_Reload_Call) RunAndReturn(run func(context.Context) error) *MockLootboxService_Reload_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -5,6 +5,8 @@ package mocks
 import (
 	domain "github.com/osse101/BrandishBot_Go/internal/domain"
 	mock "github.com/stretchr/testify/mock"
+
+	naming "github.com/osse101/BrandishBot_Go/internal/naming"
 )
 
 // MockNamingResolver is an autogenerated mock type for the Resolver type
@@ -112,6 +114,101 @@ func (_c *MockNamingResolver_GetDisplayName_Call) RunAndReturn(run func(string,
 	return _c
 }
 
+// GetDisplayNameForTheme provides a mock function with given fields: internalName, qualityLevel, theme
+func (_m *MockNamingResolver) GetDisplayNameForTheme(internalName string, qualityLevel domain.QualityLevel, theme string) string {
+	ret := _m.Called(internalName, qualityLevel, theme)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDisplayNameForTheme")
+	}
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(string, domain.QualityLevel, string) string); ok {
+		r0 = rf(internalName, qualityLevel, theme)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// MockNamingResolver_GetDisplayNameForTheme_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDisplayNameForTheme'
+type MockNamingResolver_GetDisplayNameForTheme_Call struct {
+	*mock.Call
+}
+
+// GetDisplayNameForTheme is a helper method to define mock.On call
+//   - internalName string
+//   - qualityLevel domain.QualityLevel
+//   - theme string
+func (_e *MockNamingResolver_Expecter) GetDisplayNameForTheme(internalName interface{}, qualityLevel interface{}, theme interface{}) *MockNamingResolver_GetDisplayNameForTheme_Call {
+	return &MockNamingResolver_GetDisplayNameForTheme_Call{Call: _e.mock.On("GetDisplayNameForTheme", internalName, qualityLevel, theme)}
+}
+
+func (_c *MockNamingResolver_GetDisplayNameForTheme_Call) Run(run func(internalName string, qualityLevel domain.QualityLevel, theme string)) *MockNamingResolver_GetDisplayNameForTheme_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(domain.QualityLevel), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockNamingResolver_GetDisplayNameForTheme_Call) Return(_a0 string) *MockNamingResolver_GetDisplayNameForTheme_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockNamingResolver_GetDisplayNameForTheme_Call) RunAndReturn(run func(string, domain.QualityLevel, string) string) *MockNamingResolver_GetDisplayNameForTheme_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetThemes provides a mock function with no fields
+func (_m *MockNamingResolver) GetThemes() map[string]naming.ThemePeriod {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetThemes")
+	}
+
+	var r0 map[string]naming.ThemePeriod
+	if rf, ok := ret.Get(0).(func() map[string]naming.ThemePeriod); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]naming.ThemePeriod)
+		}
+	}
+
+	return r0
+}
+
+// MockNamingResolver_GetThemes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetThemes'
+type MockNamingResolver_GetThemes_Call struct {
+	*mock.Call
+}
+
+// GetThemes is a helper method to define mock.On call
+func (_e *MockNamingResolver_Expecter) GetThemes() *MockNamingResolver_GetThemes_Call {
+	return &MockNamingResolver_GetThemes_Call{Call: _e.mock.On("GetThemes")}
+}
+
+func (_c *MockNamingResolver_GetThemes_Call) Run(run func()) *MockNamingResolver_GetThemes_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockNamingResolver_GetThemes_Call) Return(_a0 map[string]naming.ThemePeriod) *MockNamingResolver_GetThemes_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockNamingResolver_GetThemes_Call) RunAndReturn(run func() map[string]naming.ThemePeriod) *MockNamingResolver_GetThemes_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // RegisterItem provides a mock function with given fields: internalName, publicName
 func (_m *MockNamingResolver) RegisterItem(internalName string, publicName string) {
 	_m.Called(internalName, publicName)
@@ -303,6 +400,54 @@ func (_c *MockNamingResolver_ResolvePublicName_Call) RunAndReturn(run func(strin
 	return _c
 }
 
+// SuggestNames provides a mock function with given fields: name
+func (_m *MockNamingResolver) SuggestNames(name string) []string {
+	ret := _m.Called(name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SuggestNames")
+	}
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func(string) []string); ok {
+		r0 = rf(name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	return r0
+}
+
+// MockNamingResolver_SuggestNames_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SuggestNames'
+type MockNamingResolver_SuggestNames_Call struct {
+	*mock.Call
+}
+
+// SuggestNames is a helper method to define mock.On call
+//   - name string
+func (_e *MockNamingResolver_Expecter) SuggestNames(name interface{}) *MockNamingResolver_SuggestNames_Call {
+	return &MockNamingResolver_SuggestNames_Call{Call: _e.mock.On("SuggestNames", name)}
+}
+
+func (_c *MockNamingResolver_SuggestNames_Call) Run(run func(name string)) *MockNamingResolver_SuggestNames_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockNamingResolver_SuggestNames_Call) Return(_a0 []string) *MockNamingResolver_SuggestNames_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockNamingResolver_SuggestNames_Call) RunAndReturn(run func(string) []string) *MockNamingResolver_SuggestNames_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockNamingResolver creates a new instance of MockNamingResolver. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockNamingResolver(t interface {
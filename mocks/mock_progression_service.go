@@ -7,6 +7,8 @@ import (
 
 	domain "github.com/osse101/BrandishBot_Go/internal/domain"
 	mock "github.com/stretchr/testify/mock"
+
+	progression "github.com/osse101/BrandishBot_Go/internal/progression"
 )
 
 // MockProgressionService is an autogenerated mock type for the Service type
@@ -69,6 +71,65 @@ func (_c *MockProgressionService_AddContribution_Call) RunAndReturn(run func(con
 	return _c
 }
 
+// AddExternalContribution provides a mock function with given fields: ctx, contribution
+func (_m *MockProgressionService) AddExternalContribution(ctx context.Context, contribution domain.ExternalContribution) (*domain.ExternalContributionResult, error) {
+	ret := _m.Called(ctx, contribution)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddExternalContribution")
+	}
+
+	var r0 *domain.ExternalContributionResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ExternalContribution) (*domain.ExternalContributionResult, error)); ok {
+		return rf(ctx, contribution)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ExternalContribution) *domain.ExternalContributionResult); ok {
+		r0 = rf(ctx, contribution)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.ExternalContributionResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.ExternalContribution) error); ok {
+		r1 = rf(ctx, contribution)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProgressionService_AddExternalContribution_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddExternalContribution'
+type MockProgressionService_AddExternalContribution_Call struct {
+	*mock.Call
+}
+
+// AddExternalContribution is a helper method to define mock.On call
+//   - ctx context.Context
+//   - contribution domain.ExternalContribution
+func (_e *MockProgressionService_Expecter) AddExternalContribution(ctx interface{}, contribution interface{}) *MockProgressionService_AddExternalContribution_Call {
+	return &MockProgressionService_AddExternalContribution_Call{Call: _e.mock.On("AddExternalContribution", ctx, contribution)}
+}
+
+func (_c *MockProgressionService_AddExternalContribution_Call) Run(run func(ctx context.Context, contribution domain.ExternalContribution)) *MockProgressionService_AddExternalContribution_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.ExternalContribution))
+	})
+	return _c
+}
+
+func (_c *MockProgressionService_AddExternalContribution_Call) Return(_a0 *domain.ExternalContributionResult, _a1 error) *MockProgressionService_AddExternalContribution_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProgressionService_AddExternalContribution_Call) RunAndReturn(run func(context.Context, domain.ExternalContribution) (*domain.ExternalContributionResult, error)) *MockProgressionService_AddExternalContribution_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // AdminFreezeVoting provides a mock function with given fields: ctx
 func (_m *MockProgressionService) AdminFreezeVoting(ctx context.Context) error {
 	ret := _m.Called(ctx)
@@ -478,6 +539,114 @@ func (_c *MockProgressionService_CheckAndUnlockNode_Call) RunAndReturn(run func(
 	return _c
 }
 
+// DecayContributions provides a mock function with given fields: ctx
+func (_m *MockProgressionService) DecayContributions(ctx context.Context) (*domain.ContributionDecayResult, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DecayContributions")
+	}
+
+	var r0 *domain.ContributionDecayResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (*domain.ContributionDecayResult, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) *domain.ContributionDecayResult); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.ContributionDecayResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProgressionService_DecayContributions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DecayContributions'
+type MockProgressionService_DecayContributions_Call struct {
+	*mock.Call
+}
+
+// DecayContributions is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockProgressionService_Expecter) DecayContributions(ctx interface{}) *MockProgressionService_DecayContributions_Call {
+	return &MockProgressionService_DecayContributions_Call{Call: _e.mock.On("DecayContributions", ctx)}
+}
+
+func (_c *MockProgressionService_DecayContributions_Call) Run(run func(ctx context.Context)) *MockProgressionService_DecayContributions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockProgressionService_DecayContributions_Call) Return(_a0 *domain.ContributionDecayResult, _a1 error) *MockProgressionService_DecayContributions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProgressionService_DecayContributions_Call) RunAndReturn(run func(context.Context) (*domain.ContributionDecayResult, error)) *MockProgressionService_DecayContributions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DelegateVote provides a mock function with given fields: ctx, platform, platformID, username, delegateUsername
+func (_m *MockProgressionService) DelegateVote(ctx context.Context, platform string, platformID string, username string, delegateUsername string) error {
+	ret := _m.Called(ctx, platform, platformID, username, delegateUsername)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DelegateVote")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, string) error); ok {
+		r0 = rf(ctx, platform, platformID, username, delegateUsername)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockProgressionService_DelegateVote_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DelegateVote'
+type MockProgressionService_DelegateVote_Call struct {
+	*mock.Call
+}
+
+// DelegateVote is a helper method to define mock.On call
+//   - ctx context.Context
+//   - platform string
+//   - platformID string
+//   - username string
+//   - delegateUsername string
+func (_e *MockProgressionService_Expecter) DelegateVote(ctx interface{}, platform interface{}, platformID interface{}, username interface{}, delegateUsername interface{}) *MockProgressionService_DelegateVote_Call {
+	return &MockProgressionService_DelegateVote_Call{Call: _e.mock.On("DelegateVote", ctx, platform, platformID, username, delegateUsername)}
+}
+
+func (_c *MockProgressionService_DelegateVote_Call) Run(run func(ctx context.Context, platform string, platformID string, username string, delegateUsername string)) *MockProgressionService_DelegateVote_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string), args[4].(string))
+	})
+	return _c
+}
+
+func (_c *MockProgressionService_DelegateVote_Call) Return(_a0 error) *MockProgressionService_DelegateVote_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockProgressionService_DelegateVote_Call) RunAndReturn(run func(context.Context, string, string, string, string) error) *MockProgressionService_DelegateVote_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // EndVoting provides a mock function with given fields: ctx
 func (_m *MockProgressionService) EndVoting(ctx context.Context) (*domain.ProgressionVotingOption, error) {
 	ret := _m.Called(ctx)
@@ -1531,6 +1700,126 @@ func (_c *MockProgressionService_GetUserEngagementByUsername_Call) RunAndReturn(
 	return _c
 }
 
+// GetVoteDelegation provides a mock function with given fields: ctx, platform, platformID
+func (_m *MockProgressionService) GetVoteDelegation(ctx context.Context, platform string, platformID string) (*domain.VoteDelegation, error) {
+	ret := _m.Called(ctx, platform, platformID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetVoteDelegation")
+	}
+
+	var r0 *domain.VoteDelegation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*domain.VoteDelegation, error)); ok {
+		return rf(ctx, platform, platformID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *domain.VoteDelegation); ok {
+		r0 = rf(ctx, platform, platformID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.VoteDelegation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, platform, platformID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProgressionService_GetVoteDelegation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetVoteDelegation'
+type MockProgressionService_GetVoteDelegation_Call struct {
+	*mock.Call
+}
+
+// GetVoteDelegation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - platform string
+//   - platformID string
+func (_e *MockProgressionService_Expecter) GetVoteDelegation(ctx interface{}, platform interface{}, platformID interface{}) *MockProgressionService_GetVoteDelegation_Call {
+	return &MockProgressionService_GetVoteDelegation_Call{Call: _e.mock.On("GetVoteDelegation", ctx, platform, platformID)}
+}
+
+func (_c *MockProgressionService_GetVoteDelegation_Call) Run(run func(ctx context.Context, platform string, platformID string)) *MockProgressionService_GetVoteDelegation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockProgressionService_GetVoteDelegation_Call) Return(_a0 *domain.VoteDelegation, _a1 error) *MockProgressionService_GetVoteDelegation_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProgressionService_GetVoteDelegation_Call) RunAndReturn(run func(context.Context, string, string) (*domain.VoteDelegation, error)) *MockProgressionService_GetVoteDelegation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetVotingSessionHistory provides a mock function with given fields: ctx, limit, offset
+func (_m *MockProgressionService) GetVotingSessionHistory(ctx context.Context, limit int, offset int) (*domain.VotingSessionHistory, error) {
+	ret := _m.Called(ctx, limit, offset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetVotingSessionHistory")
+	}
+
+	var r0 *domain.VotingSessionHistory
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, int) (*domain.VotingSessionHistory, error)); ok {
+		return rf(ctx, limit, offset)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, int) *domain.VotingSessionHistory); ok {
+		r0 = rf(ctx, limit, offset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.VotingSessionHistory)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, int) error); ok {
+		r1 = rf(ctx, limit, offset)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProgressionService_GetVotingSessionHistory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetVotingSessionHistory'
+type MockProgressionService_GetVotingSessionHistory_Call struct {
+	*mock.Call
+}
+
+// GetVotingSessionHistory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - limit int
+//   - offset int
+func (_e *MockProgressionService_Expecter) GetVotingSessionHistory(ctx interface{}, limit interface{}, offset interface{}) *MockProgressionService_GetVotingSessionHistory_Call {
+	return &MockProgressionService_GetVotingSessionHistory_Call{Call: _e.mock.On("GetVotingSessionHistory", ctx, limit, offset)}
+}
+
+func (_c *MockProgressionService_GetVotingSessionHistory_Call) Run(run func(ctx context.Context, limit int, offset int)) *MockProgressionService_GetVotingSessionHistory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *MockProgressionService_GetVotingSessionHistory_Call) Return(_a0 *domain.VotingSessionHistory, _a1 error) *MockProgressionService_GetVotingSessionHistory_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProgressionService_GetVotingSessionHistory_Call) RunAndReturn(run func(context.Context, int, int) (*domain.VotingSessionHistory, error)) *MockProgressionService_GetVotingSessionHistory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // InitializeProgressionState provides a mock function with given fields: ctx
 func (_m *MockProgressionService) InitializeProgressionState(ctx context.Context) error {
 	ret := _m.Called(ctx)
@@ -1813,6 +2102,66 @@ func (_c *MockProgressionService_IsNodeUnlocked_Call) RunAndReturn(run func(cont
 	return _c
 }
 
+// PatchTree provides a mock function with given fields: ctx, data, dryRun
+func (_m *MockProgressionService) PatchTree(ctx context.Context, data []byte, dryRun bool) (*progression.TreeDiff, error) {
+	ret := _m.Called(ctx, data, dryRun)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PatchTree")
+	}
+
+	var r0 *progression.TreeDiff
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []byte, bool) (*progression.TreeDiff, error)); ok {
+		return rf(ctx, data, dryRun)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []byte, bool) *progression.TreeDiff); ok {
+		r0 = rf(ctx, data, dryRun)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*progression.TreeDiff)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []byte, bool) error); ok {
+		r1 = rf(ctx, data, dryRun)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProgressionService_PatchTree_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PatchTree'
+type MockProgressionService_PatchTree_Call struct {
+	*mock.Call
+}
+
+// PatchTree is a helper method to define mock.On call
+//   - ctx context.Context
+//   - data []byte
+//   - dryRun bool
+func (_e *MockProgressionService_Expecter) PatchTree(ctx interface{}, data interface{}, dryRun interface{}) *MockProgressionService_PatchTree_Call {
+	return &MockProgressionService_PatchTree_Call{Call: _e.mock.On("PatchTree", ctx, data, dryRun)}
+}
+
+func (_c *MockProgressionService_PatchTree_Call) Run(run func(ctx context.Context, data []byte, dryRun bool)) *MockProgressionService_PatchTree_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]byte), args[2].(bool))
+	})
+	return _c
+}
+
+func (_c *MockProgressionService_PatchTree_Call) Return(_a0 *progression.TreeDiff, _a1 error) *MockProgressionService_PatchTree_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProgressionService_PatchTree_Call) RunAndReturn(run func(context.Context, []byte, bool) (*progression.TreeDiff, error)) *MockProgressionService_PatchTree_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // RecordEngagement provides a mock function with given fields: ctx, userID, metricType, value
 func (_m *MockProgressionService) RecordEngagement(ctx context.Context, userID string, metricType string, value int) error {
 	ret := _m.Called(ctx, userID, metricType, value)
@@ -1862,6 +2211,66 @@ func (_c *MockProgressionService_RecordEngagement_Call) RunAndReturn(run func(co
 	return _c
 }
 
+// ReplaceTree provides a mock function with given fields: ctx, data, dryRun
+func (_m *MockProgressionService) ReplaceTree(ctx context.Context, data []byte, dryRun bool) (*progression.TreeDiff, error) {
+	ret := _m.Called(ctx, data, dryRun)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReplaceTree")
+	}
+
+	var r0 *progression.TreeDiff
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []byte, bool) (*progression.TreeDiff, error)); ok {
+		return rf(ctx, data, dryRun)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []byte, bool) *progression.TreeDiff); ok {
+		r0 = rf(ctx, data, dryRun)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*progression.TreeDiff)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []byte, bool) error); ok {
+		r1 = rf(ctx, data, dryRun)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProgressionService_ReplaceTree_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReplaceTree'
+type MockProgressionService_ReplaceTree_Call struct {
+	*mock.Call
+}
+
+// ReplaceTree is a helper method to define mock.On call
+//   - ctx context.Context
+//   - data []byte
+//   - dryRun bool
+func (_e *MockProgressionService_Expecter) ReplaceTree(ctx interface{}, data interface{}, dryRun interface{}) *MockProgressionService_ReplaceTree_Call {
+	return &MockProgressionService_ReplaceTree_Call{Call: _e.mock.On("ReplaceTree", ctx, data, dryRun)}
+}
+
+func (_c *MockProgressionService_ReplaceTree_Call) Run(run func(ctx context.Context, data []byte, dryRun bool)) *MockProgressionService_ReplaceTree_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]byte), args[2].(bool))
+	})
+	return _c
+}
+
+func (_c *MockProgressionService_ReplaceTree_Call) Return(_a0 *progression.TreeDiff, _a1 error) *MockProgressionService_ReplaceTree_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProgressionService_ReplaceTree_Call) RunAndReturn(run func(context.Context, []byte, bool) (*progression.TreeDiff, error)) *MockProgressionService_ReplaceTree_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ResetProgressionTree provides a mock function with given fields: ctx, resetBy, reason, preserveUserData
 func (_m *MockProgressionService) ResetProgressionTree(ctx context.Context, resetBy string, reason string, preserveUserData bool) error {
 	ret := _m.Called(ctx, resetBy, reason, preserveUserData)
@@ -1911,6 +2320,54 @@ func (_c *MockProgressionService_ResetProgressionTree_Call) RunAndReturn(run fun
 	return _c
 }
 
+// RevokeVoteDelegation provides a mock function with given fields: ctx, platform, platformID
+func (_m *MockProgressionService) RevokeVoteDelegation(ctx context.Context, platform string, platformID string) error {
+	ret := _m.Called(ctx, platform, platformID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeVoteDelegation")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, platform, platformID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockProgressionService_RevokeVoteDelegation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeVoteDelegation'
+type MockProgressionService_RevokeVoteDelegation_Call struct {
+	*mock.Call
+}
+
+// RevokeVoteDelegation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - platform string
+//   - platformID string
+func (_e *MockProgressionService_Expecter) RevokeVoteDelegation(ctx interface{}, platform interface{}, platformID interface{}) *MockProgressionService_RevokeVoteDelegation_Call {
+	return &MockProgressionService_RevokeVoteDelegation_Call{Call: _e.mock.On("RevokeVoteDelegation", ctx, platform, platformID)}
+}
+
+func (_c *MockProgressionService_RevokeVoteDelegation_Call) Run(run func(ctx context.Context, platform string, platformID string)) *MockProgressionService_RevokeVoteDelegation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockProgressionService_RevokeVoteDelegation_Call) Return(_a0 error) *MockProgressionService_RevokeVoteDelegation_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockProgressionService_RevokeVoteDelegation_Call) RunAndReturn(run func(context.Context, string, string) error) *MockProgressionService_RevokeVoteDelegation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Shutdown provides a mock function with given fields: ctx
 func (_m *MockProgressionService) Shutdown(ctx context.Context) error {
 	ret := _m.Called(ctx)
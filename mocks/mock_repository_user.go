@@ -9,8 +9,6 @@ import (
 	mock "github.com/stretchr/testify/mock"
 
 	repository "github.com/osse101/BrandishBot_Go/internal/repository"
-
-	time "time"
 )
 
 // MockRepositoryUser is an autogenerated mock type for the User type
@@ -531,66 +529,6 @@ func (_c *MockRepositoryUser_GetItemsByNames_Call) RunAndReturn(run func(context
 	return _c
 }
 
-// GetLastCooldown provides a mock function with given fields: ctx, userID, action
-func (_m *MockRepositoryUser) GetLastCooldown(ctx context.Context, userID string, action string) (*time.Time, error) {
-	ret := _m.Called(ctx, userID, action)
-
-	if len(ret) == 0 {
-		panic("no return value specified for GetLastCooldown")
-	}
-
-	var r0 *time.Time
-	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*time.Time, error)); ok {
-		return rf(ctx, userID, action)
-	}
-	if rf, ok := ret.Get(0).(func(context.Context, string, string) *time.Time); ok {
-		r0 = rf(ctx, userID, action)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*time.Time)
-		}
-	}
-
-	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
-		r1 = rf(ctx, userID, action)
-	} else {
-		r1 = ret.Error(1)
-	}
-
-	return r0, r1
-}
-
-// MockRepositoryUser_GetLastCooldown_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLastCooldown'
-type MockRepositoryUser_GetLastCooldown_Call struct {
-	*mock.Call
-}
-
-// GetLastCooldown is a helper method to define mock.On call
-//   - ctx context.Context
-//   - userID string
-//   - action string
-func (_e *MockRepositoryUser_Expecter) GetLastCooldown(ctx interface{}, userID interface{}, action interface{}) *MockRepositoryUser_GetLastCooldown_Call {
-	return &MockRepositoryUser_GetLastCooldown_Call{Call: _e.mock.On("GetLastCooldown", ctx, userID, action)}
-}
-
-func (_c *MockRepositoryUser_GetLastCooldown_Call) Run(run func(ctx context.Context, userID string, action string)) *MockRepositoryUser_GetLastCooldown_Call {
-	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string), args[2].(string))
-	})
-	return _c
-}
-
-func (_c *MockRepositoryUser_GetLastCooldown_Call) Return(_a0 *time.Time, _a1 error) *MockRepositoryUser_GetLastCooldown_Call {
-	_c.Call.Return(_a0, _a1)
-	return _c
-}
-
-func (_c *MockRepositoryUser_GetLastCooldown_Call) RunAndReturn(run func(context.Context, string, string) (*time.Time, error)) *MockRepositoryUser_GetLastCooldown_Call {
-	_c.Call.Return(run)
-	return _c
-}
-
 // GetRecentlyActiveUsers provides a mock function with given fields: ctx, limit
 func (_m *MockRepositoryUser) GetRecentlyActiveUsers(ctx context.Context, limit int) ([]domain.User, error) {
 	ret := _m.Called(ctx, limit)
@@ -879,55 +817,6 @@ func (_c *MockRepositoryUser_MergeUsersInTransaction_Call) RunAndReturn(run func
 	return _c
 }
 
-// UpdateCooldown provides a mock function with given fields: ctx, userID, action, timestamp
-func (_m *MockRepositoryUser) UpdateCooldown(ctx context.Context, userID string, action string, timestamp time.Time) error {
-	ret := _m.Called(ctx, userID, action, timestamp)
-
-	if len(ret) == 0 {
-		panic("no return value specified for UpdateCooldown")
-	}
-
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, string, time.Time) error); ok {
-		r0 = rf(ctx, userID, action, timestamp)
-	} else {
-		r0 = ret.Error(0)
-	}
-
-	return r0
-}
-
-// MockRepositoryUser_UpdateCooldown_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateCooldown'
-type MockRepositoryUser_UpdateCooldown_Call struct {
-	*mock.Call
-}
-
-// UpdateCooldown is a helper method to define mock.On call
-//   - ctx context.Context
-//   - userID string
-//   - action string
-//   - timestamp time.Time
-func (_e *MockRepositoryUser_Expecter) UpdateCooldown(ctx interface{}, userID interface{}, action interface{}, timestamp interface{}) *MockRepositoryUser_UpdateCooldown_Call {
-	return &MockRepositoryUser_UpdateCooldown_Call{Call: _e.mock.On("UpdateCooldown", ctx, userID, action, timestamp)}
-}
-
-func (_c *MockRepositoryUser_UpdateCooldown_Call) Run(run func(ctx context.Context, userID string, action string, timestamp time.Time)) *MockRepositoryUser_UpdateCooldown_Call {
-	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(time.Time))
-	})
-	return _c
-}
-
-func (_c *MockRepositoryUser_UpdateCooldown_Call) Return(_a0 error) *MockRepositoryUser_UpdateCooldown_Call {
-	_c.Call.Return(_a0)
-	return _c
-}
-
-func (_c *MockRepositoryUser_UpdateCooldown_Call) RunAndReturn(run func(context.Context, string, string, time.Time) error) *MockRepositoryUser_UpdateCooldown_Call {
-	_c.Call.Return(run)
-	return _c
-}
-
 // UpdateInventory provides a mock function with given fields: ctx, userID, inventory
 func (_m *MockRepositoryUser) UpdateInventory(ctx context.Context, userID string, inventory domain.Inventory) error {
 	ret := _m.Called(ctx, userID, inventory)
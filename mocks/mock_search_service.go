@@ -5,6 +5,7 @@ package mocks
 import (
 	context "context"
 
+	search "github.com/osse101/BrandishBot_Go/internal/search"
 	mock "github.com/stretchr/testify/mock"
 )
 
@@ -21,6 +22,186 @@ func (_m *MockSearchService) EXPECT() *MockSearchService_Expecter {
 	return &MockSearchService_Expecter{mock: &_m.Mock}
 }
 
+// GetDifficulty provides a mock function with given fields: ctx
+func (_m *MockSearchService) GetDifficulty(ctx context.Context) (*search.DifficultyStatus, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDifficulty")
+	}
+
+	var r0 *search.DifficultyStatus
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (*search.DifficultyStatus, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) *search.DifficultyStatus); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*search.DifficultyStatus)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockSearchService_GetDifficulty_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDifficulty'
+type MockSearchService_GetDifficulty_Call struct {
+	*mock.Call
+}
+
+// GetDifficulty is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockSearchService_Expecter) GetDifficulty(ctx interface{}) *MockSearchService_GetDifficulty_Call {
+	return &MockSearchService_GetDifficulty_Call{Call: _e.mock.On("GetDifficulty", ctx)}
+}
+
+func (_c *MockSearchService_GetDifficulty_Call) Run(run func(ctx context.Context)) *MockSearchService_GetDifficulty_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockSearchService_GetDifficulty_Call) Return(_a0 *search.DifficultyStatus, _a1 error) *MockSearchService_GetDifficulty_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockSearchService_GetDifficulty_Call) RunAndReturn(run func(context.Context) (*search.DifficultyStatus, error)) *MockSearchService_GetDifficulty_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetLocations provides a mock function with given fields: ctx, platform, platformID, username
+func (_m *MockSearchService) GetLocations(ctx context.Context, platform string, platformID string, username string) ([]search.LocationStatus, error) {
+	ret := _m.Called(ctx, platform, platformID, username)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLocations")
+	}
+
+	var r0 []search.LocationStatus
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) ([]search.LocationStatus, error)); ok {
+		return rf(ctx, platform, platformID, username)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) []search.LocationStatus); ok {
+		r0 = rf(ctx, platform, platformID, username)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]search.LocationStatus)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string) error); ok {
+		r1 = rf(ctx, platform, platformID, username)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockSearchService_GetLocations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLocations'
+type MockSearchService_GetLocations_Call struct {
+	*mock.Call
+}
+
+// GetLocations is a helper method to define mock.On call
+//   - ctx context.Context
+//   - platform string
+//   - platformID string
+//   - username string
+func (_e *MockSearchService_Expecter) GetLocations(ctx interface{}, platform interface{}, platformID interface{}, username interface{}) *MockSearchService_GetLocations_Call {
+	return &MockSearchService_GetLocations_Call{Call: _e.mock.On("GetLocations", ctx, platform, platformID, username)}
+}
+
+func (_c *MockSearchService_GetLocations_Call) Run(run func(ctx context.Context, platform string, platformID string, username string)) *MockSearchService_GetLocations_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *MockSearchService_GetLocations_Call) Return(_a0 []search.LocationStatus, _a1 error) *MockSearchService_GetLocations_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockSearchService_GetLocations_Call) RunAndReturn(run func(context.Context, string, string, string) ([]search.LocationStatus, error)) *MockSearchService_GetLocations_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetProgress provides a mock function with given fields: ctx, platform, platformID, username
+func (_m *MockSearchService) GetProgress(ctx context.Context, platform string, platformID string, username string) (*search.ProgressStatus, error) {
+	ret := _m.Called(ctx, platform, platformID, username)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetProgress")
+	}
+
+	var r0 *search.ProgressStatus
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) (*search.ProgressStatus, error)); ok {
+		return rf(ctx, platform, platformID, username)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) *search.ProgressStatus); ok {
+		r0 = rf(ctx, platform, platformID, username)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*search.ProgressStatus)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string) error); ok {
+		r1 = rf(ctx, platform, platformID, username)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockSearchService_GetProgress_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetProgress'
+type MockSearchService_GetProgress_Call struct {
+	*mock.Call
+}
+
+// GetProgress is a helper method to define mock.On call
+//   - ctx context.Context
+//   - platform string
+//   - platformID string
+//   - username string
+func (_e *MockSearchService_Expecter) GetProgress(ctx interface{}, platform interface{}, platformID interface{}, username interface{}) *MockSearchService_GetProgress_Call {
+	return &MockSearchService_GetProgress_Call{Call: _e.mock.On("GetProgress", ctx, platform, platformID, username)}
+}
+
+func (_c *MockSearchService_GetProgress_Call) Run(run func(ctx context.Context, platform string, platformID string, username string)) *MockSearchService_GetProgress_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *MockSearchService_GetProgress_Call) Return(_a0 *search.ProgressStatus, _a1 error) *MockSearchService_GetProgress_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockSearchService_GetProgress_Call) RunAndReturn(run func(context.Context, string, string, string) (*search.ProgressStatus, error)) *MockSearchService_GetProgress_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // HandleSearch provides a mock function with given fields: ctx, platform, platformID, username, itemHint
 func (_m *MockSearchService) HandleSearch(ctx context.Context, platform string, platformID string, username string, itemHint string) (string, error) {
 	ret := _m.Called(ctx, platform, platformID, username, itemHint)
@@ -81,6 +262,125 @@ func (_c *MockSearchService_HandleSearch_Call) RunAndReturn(run func(context.Con
 	return _c
 }
 
+// SearchLocation provides a mock function with given fields: ctx, platform, platformID, username, location
+func (_m *MockSearchService) SearchLocation(ctx context.Context, platform string, platformID string, username string, location string) (string, error) {
+	ret := _m.Called(ctx, platform, platformID, username, location)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SearchLocation")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, string) (string, error)); ok {
+		return rf(ctx, platform, platformID, username, location)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, string) string); ok {
+		r0 = rf(ctx, platform, platformID, username, location)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string, string) error); ok {
+		r1 = rf(ctx, platform, platformID, username, location)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockSearchService_SearchLocation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SearchLocation'
+type MockSearchService_SearchLocation_Call struct {
+	*mock.Call
+}
+
+// SearchLocation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - platform string
+//   - platformID string
+//   - username string
+//   - location string
+func (_e *MockSearchService_Expecter) SearchLocation(ctx interface{}, platform interface{}, platformID interface{}, username interface{}, location interface{}) *MockSearchService_SearchLocation_Call {
+	return &MockSearchService_SearchLocation_Call{Call: _e.mock.On("SearchLocation", ctx, platform, platformID, username, location)}
+}
+
+func (_c *MockSearchService_SearchLocation_Call) Run(run func(ctx context.Context, platform string, platformID string, username string, location string)) *MockSearchService_SearchLocation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string), args[4].(string))
+	})
+	return _c
+}
+
+func (_c *MockSearchService_SearchLocation_Call) Return(_a0 string, _a1 error) *MockSearchService_SearchLocation_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockSearchService_SearchLocation_Call) RunAndReturn(run func(context.Context, string, string, string, string) (string, error)) *MockSearchService_SearchLocation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetDifficulty provides a mock function with given fields: ctx, config
+func (_m *MockSearchService) SetDifficulty(ctx context.Context, config search.DifficultyConfig) (*search.DifficultyStatus, error) {
+	ret := _m.Called(ctx, config)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetDifficulty")
+	}
+
+	var r0 *search.DifficultyStatus
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, search.DifficultyConfig) (*search.DifficultyStatus, error)); ok {
+		return rf(ctx, config)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, search.DifficultyConfig) *search.DifficultyStatus); ok {
+		r0 = rf(ctx, config)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*search.DifficultyStatus)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, search.DifficultyConfig) error); ok {
+		r1 = rf(ctx, config)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockSearchService_SetDifficulty_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetDifficulty'
+type MockSearchService_SetDifficulty_Call struct {
+	*mock.Call
+}
+
+// SetDifficulty is a helper method to define mock.On call
+//   - ctx context.Context
+//   - config search.DifficultyConfig
+func (_e *MockSearchService_Expecter) SetDifficulty(ctx interface{}, config interface{}) *MockSearchService_SetDifficulty_Call {
+	return &MockSearchService_SetDifficulty_Call{Call: _e.mock.On("SetDifficulty", ctx, config)}
+}
+
+func (_c *MockSearchService_SetDifficulty_Call) Run(run func(ctx context.Context, config search.DifficultyConfig)) *MockSearchService_SetDifficulty_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(search.DifficultyConfig))
+	})
+	return _c
+}
+
+func (_c *MockSearchService_SetDifficulty_Call) Return(_a0 *search.DifficultyStatus, _a1 error) *MockSearchService_SetDifficulty_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockSearchService_SetDifficulty_Call) RunAndReturn(run func(context.Context, search.DifficultyConfig) (*search.DifficultyStatus, error)) *MockSearchService_SetDifficulty_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockSearchService creates a new instance of MockSearchService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockSearchService(t interface {
@@ -22,29 +22,29 @@ func (_m *MockStatsService) EXPECT() *MockStatsService_Expecter {
 	return &MockStatsService_Expecter{mock: &_m.Mock}
 }
 
-// GetLeaderboard provides a mock function with given fields: ctx, eventType, period, limit
-func (_m *MockStatsService) GetLeaderboard(ctx context.Context, eventType domain.EventType, period string, limit int) ([]domain.LeaderboardEntry, error) {
-	ret := _m.Called(ctx, eventType, period, limit)
+// GetLeaderboard provides a mock function with given fields: ctx, query
+func (_m *MockStatsService) GetLeaderboard(ctx context.Context, query domain.LeaderboardQuery) (*domain.LeaderboardPage, error) {
+	ret := _m.Called(ctx, query)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetLeaderboard")
 	}
 
-	var r0 []domain.LeaderboardEntry
+	var r0 *domain.LeaderboardPage
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, domain.EventType, string, int) ([]domain.LeaderboardEntry, error)); ok {
-		return rf(ctx, eventType, period, limit)
+	if rf, ok := ret.Get(0).(func(context.Context, domain.LeaderboardQuery) (*domain.LeaderboardPage, error)); ok {
+		return rf(ctx, query)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, domain.EventType, string, int) []domain.LeaderboardEntry); ok {
-		r0 = rf(ctx, eventType, period, limit)
+	if rf, ok := ret.Get(0).(func(context.Context, domain.LeaderboardQuery) *domain.LeaderboardPage); ok {
+		r0 = rf(ctx, query)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]domain.LeaderboardEntry)
+			r0 = ret.Get(0).(*domain.LeaderboardPage)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, domain.EventType, string, int) error); ok {
-		r1 = rf(ctx, eventType, period, limit)
+	if rf, ok := ret.Get(1).(func(context.Context, domain.LeaderboardQuery) error); ok {
+		r1 = rf(ctx, query)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -59,26 +59,24 @@ type MockStatsService_GetLeaderboard_Call struct {
 
 // GetLeaderboard is a helper method to define mock.On call
 //   - ctx context.Context
-//   - eventType domain.EventType
-//   - period string
-//   - limit int
-func (_e *MockStatsService_Expecter) GetLeaderboard(ctx interface{}, eventType interface{}, period interface{}, limit interface{}) *MockStatsService_GetLeaderboard_Call {
-	return &MockStatsService_GetLeaderboard_Call{Call: _e.mock.On("GetLeaderboard", ctx, eventType, period, limit)}
+//   - query domain.LeaderboardQuery
+func (_e *MockStatsService_Expecter) GetLeaderboard(ctx interface{}, query interface{}) *MockStatsService_GetLeaderboard_Call {
+	return &MockStatsService_GetLeaderboard_Call{Call: _e.mock.On("GetLeaderboard", ctx, query)}
 }
 
-func (_c *MockStatsService_GetLeaderboard_Call) Run(run func(ctx context.Context, eventType domain.EventType, period string, limit int)) *MockStatsService_GetLeaderboard_Call {
+func (_c *MockStatsService_GetLeaderboard_Call) Run(run func(ctx context.Context, query domain.LeaderboardQuery)) *MockStatsService_GetLeaderboard_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(domain.EventType), args[2].(string), args[3].(int))
+		run(args[0].(context.Context), args[1].(domain.LeaderboardQuery))
 	})
 	return _c
 }
 
-func (_c *MockStatsService_GetLeaderboard_Call) Return(_a0 []domain.LeaderboardEntry, _a1 error) *MockStatsService_GetLeaderboard_Call {
+func (_c *MockStatsService_GetLeaderboard_Call) Return(_a0 *domain.LeaderboardPage, _a1 error) *MockStatsService_GetLeaderboard_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockStatsService_GetLeaderboard_Call) RunAndReturn(run func(context.Context, domain.EventType, string, int) ([]domain.LeaderboardEntry, error)) *MockStatsService_GetLeaderboard_Call {
+func (_c *MockStatsService_GetLeaderboard_Call) RunAndReturn(run func(context.Context, domain.LeaderboardQuery) (*domain.LeaderboardPage, error)) *MockStatsService_GetLeaderboard_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -380,6 +378,65 @@ func (_c *MockStatsService_GetUserCurrentStreak_Call) RunAndReturn(run func(cont
 	return _c
 }
 
+// GetUserRecipeCraftCounts provides a mock function with given fields: ctx, userID
+func (_m *MockStatsService) GetUserRecipeCraftCounts(ctx context.Context, userID string) (map[string]int, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUserRecipeCraftCounts")
+	}
+
+	var r0 map[string]int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (map[string]int, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) map[string]int); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]int)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStatsService_GetUserRecipeCraftCounts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserRecipeCraftCounts'
+type MockStatsService_GetUserRecipeCraftCounts_Call struct {
+	*mock.Call
+}
+
+// GetUserRecipeCraftCounts is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+func (_e *MockStatsService_Expecter) GetUserRecipeCraftCounts(ctx interface{}, userID interface{}) *MockStatsService_GetUserRecipeCraftCounts_Call {
+	return &MockStatsService_GetUserRecipeCraftCounts_Call{Call: _e.mock.On("GetUserRecipeCraftCounts", ctx, userID)}
+}
+
+func (_c *MockStatsService_GetUserRecipeCraftCounts_Call) Run(run func(ctx context.Context, userID string)) *MockStatsService_GetUserRecipeCraftCounts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockStatsService_GetUserRecipeCraftCounts_Call) Return(_a0 map[string]int, _a1 error) *MockStatsService_GetUserRecipeCraftCounts_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStatsService_GetUserRecipeCraftCounts_Call) RunAndReturn(run func(context.Context, string) (map[string]int, error)) *MockStatsService_GetUserRecipeCraftCounts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetUserSlotsStats provides a mock function with given fields: ctx, userID, period
 func (_m *MockStatsService) GetUserSlotsStats(ctx context.Context, userID string, period string) (*domain.SlotsStats, error) {
 	ret := _m.Called(ctx, userID, period)
@@ -500,6 +557,65 @@ func (_c *MockStatsService_GetUserStats_Call) RunAndReturn(run func(context.Cont
 	return _c
 }
 
+// QueryStats provides a mock function with given fields: ctx, query
+func (_m *MockStatsService) QueryStats(ctx context.Context, query domain.StatsQuery) (*domain.StatsQueryResult, error) {
+	ret := _m.Called(ctx, query)
+
+	if len(ret) == 0 {
+		panic("no return value specified for QueryStats")
+	}
+
+	var r0 *domain.StatsQueryResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.StatsQuery) (*domain.StatsQueryResult, error)); ok {
+		return rf(ctx, query)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.StatsQuery) *domain.StatsQueryResult); ok {
+		r0 = rf(ctx, query)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.StatsQueryResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.StatsQuery) error); ok {
+		r1 = rf(ctx, query)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStatsService_QueryStats_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'QueryStats'
+type MockStatsService_QueryStats_Call struct {
+	*mock.Call
+}
+
+// QueryStats is a helper method to define mock.On call
+//   - ctx context.Context
+//   - query domain.StatsQuery
+func (_e *MockStatsService_Expecter) QueryStats(ctx interface{}, query interface{}) *MockStatsService_QueryStats_Call {
+	return &MockStatsService_QueryStats_Call{Call: _e.mock.On("QueryStats", ctx, query)}
+}
+
+func (_c *MockStatsService_QueryStats_Call) Run(run func(ctx context.Context, query domain.StatsQuery)) *MockStatsService_QueryStats_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.StatsQuery))
+	})
+	return _c
+}
+
+func (_c *MockStatsService_QueryStats_Call) Return(_a0 *domain.StatsQueryResult, _a1 error) *MockStatsService_QueryStats_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStatsService_QueryStats_Call) RunAndReturn(run func(context.Context, domain.StatsQuery) (*domain.StatsQueryResult, error)) *MockStatsService_QueryStats_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // RecordUserEvent provides a mock function with given fields: ctx, userID, eventType, metadata
 func (_m *MockStatsService) RecordUserEvent(ctx context.Context, userID string, eventType domain.EventType, metadata interface{}) error {
 	ret := _m.Called(ctx, userID, eventType, metadata)
@@ -549,6 +665,62 @@ func (_c *MockStatsService_RecordUserEvent_Call) RunAndReturn(run func(context.C
 	return _c
 }
 
+// RollUpDue provides a mock function with given fields: ctx
+func (_m *MockStatsService) RollUpDue(ctx context.Context) (int, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RollUpDue")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (int, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) int); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStatsService_RollUpDue_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RollUpDue'
+type MockStatsService_RollUpDue_Call struct {
+	*mock.Call
+}
+
+// RollUpDue is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockStatsService_Expecter) RollUpDue(ctx interface{}) *MockStatsService_RollUpDue_Call {
+	return &MockStatsService_RollUpDue_Call{Call: _e.mock.On("RollUpDue", ctx)}
+}
+
+func (_c *MockStatsService_RollUpDue_Call) Run(run func(ctx context.Context)) *MockStatsService_RollUpDue_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockStatsService_RollUpDue_Call) Return(_a0 int, _a1 error) *MockStatsService_RollUpDue_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStatsService_RollUpDue_Call) RunAndReturn(run func(context.Context) (int, error)) *MockStatsService_RollUpDue_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockStatsService creates a new instance of MockStatsService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockStatsService(t interface {
@@ -6,11 +6,15 @@ import (
 	context "context"
 
 	domain "github.com/osse101/BrandishBot_Go/internal/domain"
+	itemhandler "github.com/osse101/BrandishBot_Go/internal/itemhandler"
+
 	mock "github.com/stretchr/testify/mock"
 
 	time "time"
 
 	user "github.com/osse101/BrandishBot_Go/internal/user"
+
+	utils "github.com/osse101/BrandishBot_Go/internal/utils"
 )
 
 // MockUserService is an autogenerated mock type for the Service type
@@ -270,6 +274,55 @@ func (_c *MockUserService_ClearTimeout_Call) RunAndReturn(run func(context.Conte
 	return _c
 }
 
+// EnsureInventoryRoom provides a mock function with given fields: ctx, userID, itemID, qualityLevel
+func (_m *MockUserService) EnsureInventoryRoom(ctx context.Context, userID string, itemID int, qualityLevel domain.QualityLevel) error {
+	ret := _m.Called(ctx, userID, itemID, qualityLevel)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EnsureInventoryRoom")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int, domain.QualityLevel) error); ok {
+		r0 = rf(ctx, userID, itemID, qualityLevel)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockUserService_EnsureInventoryRoom_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EnsureInventoryRoom'
+type MockUserService_EnsureInventoryRoom_Call struct {
+	*mock.Call
+}
+
+// EnsureInventoryRoom is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - itemID int
+//   - qualityLevel domain.QualityLevel
+func (_e *MockUserService_Expecter) EnsureInventoryRoom(ctx interface{}, userID interface{}, itemID interface{}, qualityLevel interface{}) *MockUserService_EnsureInventoryRoom_Call {
+	return &MockUserService_EnsureInventoryRoom_Call{Call: _e.mock.On("EnsureInventoryRoom", ctx, userID, itemID, qualityLevel)}
+}
+
+func (_c *MockUserService_EnsureInventoryRoom_Call) Run(run func(ctx context.Context, userID string, itemID int, qualityLevel domain.QualityLevel)) *MockUserService_EnsureInventoryRoom_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int), args[3].(domain.QualityLevel))
+	})
+	return _c
+}
+
+func (_c *MockUserService_EnsureInventoryRoom_Call) Return(_a0 error) *MockUserService_EnsureInventoryRoom_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockUserService_EnsureInventoryRoom_Call) RunAndReturn(run func(context.Context, string, int, domain.QualityLevel) error) *MockUserService_EnsureInventoryRoom_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // FindUserByPlatformID provides a mock function with given fields: ctx, platform, platformID
 func (_m *MockUserService) FindUserByPlatformID(ctx context.Context, platform string, platformID string) (*domain.User, error) {
 	ret := _m.Called(ctx, platform, platformID)
@@ -545,6 +598,67 @@ func (_c *MockUserService_GetInventoryByUsername_Call) RunAndReturn(run func(con
 	return _c
 }
 
+// GetInventoryCapacity provides a mock function with given fields: ctx, platform, platformID, username
+func (_m *MockUserService) GetInventoryCapacity(ctx context.Context, platform string, platformID string, username string) (*domain.InventoryCapacity, error) {
+	ret := _m.Called(ctx, platform, platformID, username)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetInventoryCapacity")
+	}
+
+	var r0 *domain.InventoryCapacity
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) (*domain.InventoryCapacity, error)); ok {
+		return rf(ctx, platform, platformID, username)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) *domain.InventoryCapacity); ok {
+		r0 = rf(ctx, platform, platformID, username)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.InventoryCapacity)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string) error); ok {
+		r1 = rf(ctx, platform, platformID, username)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockUserService_GetInventoryCapacity_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetInventoryCapacity'
+type MockUserService_GetInventoryCapacity_Call struct {
+	*mock.Call
+}
+
+// GetInventoryCapacity is a helper method to define mock.On call
+//   - ctx context.Context
+//   - platform string
+//   - platformID string
+//   - username string
+func (_e *MockUserService_Expecter) GetInventoryCapacity(ctx interface{}, platform interface{}, platformID interface{}, username interface{}) *MockUserService_GetInventoryCapacity_Call {
+	return &MockUserService_GetInventoryCapacity_Call{Call: _e.mock.On("GetInventoryCapacity", ctx, platform, platformID, username)}
+}
+
+func (_c *MockUserService_GetInventoryCapacity_Call) Run(run func(ctx context.Context, platform string, platformID string, username string)) *MockUserService_GetInventoryCapacity_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *MockUserService_GetInventoryCapacity_Call) Return(_a0 *domain.InventoryCapacity, _a1 error) *MockUserService_GetInventoryCapacity_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockUserService_GetInventoryCapacity_Call) RunAndReturn(run func(context.Context, string, string, string) (*domain.InventoryCapacity, error)) *MockUserService_GetInventoryCapacity_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetItemByName provides a mock function with given fields: ctx, name
 func (_m *MockUserService) GetItemByName(ctx context.Context, name string) (*domain.Item, error) {
 	ret := _m.Called(ctx, name)
@@ -1434,6 +1548,117 @@ func (_c *MockUserService_RemoveItemByUsername_Call) RunAndReturn(run func(conte
 	return _c
 }
 
+// RestoreTimeouts provides a mock function with given fields: ctx
+func (_m *MockUserService) RestoreTimeouts(ctx context.Context) error {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RestoreTimeouts")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockUserService_RestoreTimeouts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RestoreTimeouts'
+type MockUserService_RestoreTimeouts_Call struct {
+	*mock.Call
+}
+
+// RestoreTimeouts is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockUserService_Expecter) RestoreTimeouts(ctx interface{}) *MockUserService_RestoreTimeouts_Call {
+	return &MockUserService_RestoreTimeouts_Call{Call: _e.mock.On("RestoreTimeouts", ctx)}
+}
+
+func (_c *MockUserService_RestoreTimeouts_Call) Run(run func(ctx context.Context)) *MockUserService_RestoreTimeouts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockUserService_RestoreTimeouts_Call) Return(_a0 error) *MockUserService_RestoreTimeouts_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockUserService_RestoreTimeouts_Call) RunAndReturn(run func(context.Context) error) *MockUserService_RestoreTimeouts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SettleInventoryOverflow provides a mock function with given fields: ctx, before, inventory
+func (_m *MockUserService) SettleInventoryOverflow(ctx context.Context, before map[utils.SlotKey]int, inventory *domain.Inventory) (int, int, error) {
+	ret := _m.Called(ctx, before, inventory)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SettleInventoryOverflow")
+	}
+
+	var r0 int
+	var r1 int
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, map[utils.SlotKey]int, *domain.Inventory) (int, int, error)); ok {
+		return rf(ctx, before, inventory)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, map[utils.SlotKey]int, *domain.Inventory) int); ok {
+		r0 = rf(ctx, before, inventory)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, map[utils.SlotKey]int, *domain.Inventory) int); ok {
+		r1 = rf(ctx, before, inventory)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, map[utils.SlotKey]int, *domain.Inventory) error); ok {
+		r2 = rf(ctx, before, inventory)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockUserService_SettleInventoryOverflow_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SettleInventoryOverflow'
+type MockUserService_SettleInventoryOverflow_Call struct {
+	*mock.Call
+}
+
+// SettleInventoryOverflow is a helper method to define mock.On call
+//   - ctx context.Context
+//   - before map[utils.SlotKey]int
+//   - inventory *domain.Inventory
+func (_e *MockUserService_Expecter) SettleInventoryOverflow(ctx interface{}, before interface{}, inventory interface{}) *MockUserService_SettleInventoryOverflow_Call {
+	return &MockUserService_SettleInventoryOverflow_Call{Call: _e.mock.On("SettleInventoryOverflow", ctx, before, inventory)}
+}
+
+func (_c *MockUserService_SettleInventoryOverflow_Call) Run(run func(ctx context.Context, before map[utils.SlotKey]int, inventory *domain.Inventory)) *MockUserService_SettleInventoryOverflow_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(map[utils.SlotKey]int), args[2].(*domain.Inventory))
+	})
+	return _c
+}
+
+func (_c *MockUserService_SettleInventoryOverflow_Call) Return(_a0 int, _a1 int, _a2 error) *MockUserService_SettleInventoryOverflow_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockUserService_SettleInventoryOverflow_Call) RunAndReturn(run func(context.Context, map[utils.SlotKey]int, *domain.Inventory) (int, int, error)) *MockUserService_SettleInventoryOverflow_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Shutdown provides a mock function with given fields: ctx
 func (_m *MockUserService) Shutdown(ctx context.Context) error {
 	ret := _m.Called(ctx)
@@ -1686,6 +1911,70 @@ func (_c *MockUserService_UseItem_Call) RunAndReturn(run func(context.Context, s
 	return _c
 }
 
+// UseItemDetailed provides a mock function with given fields: ctx, platform, platformID, username, itemName, quantity, targetUsername
+func (_m *MockUserService) UseItemDetailed(ctx context.Context, platform string, platformID string, username string, itemName string, quantity int, targetUsername string) (*itemhandler.UseResult, error) {
+	ret := _m.Called(ctx, platform, platformID, username, itemName, quantity, targetUsername)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UseItemDetailed")
+	}
+
+	var r0 *itemhandler.UseResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, string, int, string) (*itemhandler.UseResult, error)); ok {
+		return rf(ctx, platform, platformID, username, itemName, quantity, targetUsername)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, string, int, string) *itemhandler.UseResult); ok {
+		r0 = rf(ctx, platform, platformID, username, itemName, quantity, targetUsername)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*itemhandler.UseResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string, string, int, string) error); ok {
+		r1 = rf(ctx, platform, platformID, username, itemName, quantity, targetUsername)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockUserService_UseItemDetailed_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UseItemDetailed'
+type MockUserService_UseItemDetailed_Call struct {
+	*mock.Call
+}
+
+// UseItemDetailed is a helper method to define mock.On call
+//   - ctx context.Context
+//   - platform string
+//   - platformID string
+//   - username string
+//   - itemName string
+//   - quantity int
+//   - targetUsername string
+func (_e *MockUserService_Expecter) UseItemDetailed(ctx interface{}, platform interface{}, platformID interface{}, username interface{}, itemName interface{}, quantity interface{}, targetUsername interface{}) *MockUserService_UseItemDetailed_Call {
+	return &MockUserService_UseItemDetailed_Call{Call: _e.mock.On("UseItemDetailed", ctx, platform, platformID, username, itemName, quantity, targetUsername)}
+}
+
+func (_c *MockUserService_UseItemDetailed_Call) Run(run func(ctx context.Context, platform string, platformID string, username string, itemName string, quantity int, targetUsername string)) *MockUserService_UseItemDetailed_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string), args[4].(string), args[5].(int), args[6].(string))
+	})
+	return _c
+}
+
+func (_c *MockUserService_UseItemDetailed_Call) Return(_a0 *itemhandler.UseResult, _a1 error) *MockUserService_UseItemDetailed_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockUserService_UseItemDetailed_Call) RunAndReturn(run func(context.Context, string, string, string, string, int, string) (*itemhandler.UseResult, error)) *MockUserService_UseItemDetailed_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockUserService creates a new instance of MockUserService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockUserService(t interface {
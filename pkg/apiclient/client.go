@@ -0,0 +1,133 @@
+// Package apiclient is a typed client for the BrandishBot Core API.
+//
+// Models and operation methods in zz_generated.go are generated from the
+// swagger spec (docs/swagger/swagger.json) by cmd/gen-apiclient. Endpoints
+// the spec does not describe yet can be called through Client.Do.
+package apiclient
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"time"
+)
+
+// Defaults used by New
+const (
+	DefaultTimeout    = 10 * time.Second
+	DefaultMaxRetries = 3
+	DefaultRetryDelay = 500 * time.Millisecond
+)
+
+// HeaderAPIKey is the header the API key is sent in
+const HeaderAPIKey = "X-API-Key"
+
+// Client sends requests to the Core API. Requests that fail to connect or get
+// a 5xx response are retried with exponential backoff.
+type Client struct {
+	BaseURL    string
+	APIKey     string
+	HTTPClient *http.Client
+	MaxRetries int
+	RetryDelay time.Duration
+}
+
+// New creates a client with the default timeout and retry policy
+func New(baseURL, apiKey string) *Client {
+	return &Client{
+		BaseURL:    baseURL,
+		APIKey:     apiKey,
+		HTTPClient: &http.Client{Timeout: DefaultTimeout},
+		MaxRetries: DefaultMaxRetries,
+		RetryDelay: DefaultRetryDelay,
+	}
+}
+
+// Do sends a request with body encoded as JSON and decodes a 2xx response
+// into out. Non-2xx responses are returned as *Error. Either body or out may
+// be nil.
+func (c *Client) Do(ctx context.Context, method, path string, body, out interface{}) error {
+	resp, err := c.Send(ctx, method, path, body)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return DecodeError(resp)
+	}
+
+	if out != nil {
+		if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+			return fmt.Errorf("failed to decode response: %w", err)
+		}
+	}
+	return nil
+}
+
+// Send sends a request with retries and returns the raw response, whatever
+// its status. The caller must close the response body.
+func (c *Client) Send(ctx context.Context, method, path string, body interface{}) (*http.Response, error) {
+	var reqBody []byte
+	if body != nil {
+		var err error
+		reqBody, err = json.Marshal(body)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal body: %w", err)
+		}
+	}
+
+	url := c.BaseURL + path
+
+	var lastErr error
+	for attempt := 0; attempt <= c.MaxRetries; attempt++ {
+		if attempt > 0 {
+			// Exponential backoff with jitter
+			jitter := time.Duration(time.Now().UnixNano()%100) * time.Millisecond
+			delay := c.RetryDelay*time.Duration(1<<uint(attempt-1)) + jitter
+			select {
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			case <-time.After(delay):
+			}
+			slog.Info("Retrying API request", "attempt", attempt, "path", path, "delay", delay)
+		}
+
+		req, err := http.NewRequestWithContext(ctx, method, url, bytes.NewReader(reqBody))
+		if err != nil {
+			return nil, fmt.Errorf("failed to create request: %w", err)
+		}
+
+		req.Header.Set("Content-Type", "application/json")
+		if c.APIKey != "" {
+			req.Header.Set(HeaderAPIKey, c.APIKey)
+		}
+
+		resp, err := c.HTTPClient.Do(req)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil, ctx.Err()
+			}
+			lastErr = err
+			slog.Warn("API request failed", "error", err, "attempt", attempt)
+			continue
+		}
+
+		// Success or non-retryable error
+		if resp.StatusCode < 500 {
+			return resp, nil
+		}
+
+		// Server error - retry
+		_, _ = io.Copy(io.Discard, resp.Body)
+		resp.Body.Close()
+		lastErr = &Error{StatusCode: resp.StatusCode}
+		slog.Warn("Server error, will retry", "status", resp.StatusCode, "attempt", attempt)
+	}
+
+	return nil, fmt.Errorf("max retries exceeded: %w", lastErr)
+}
@@ -0,0 +1,123 @@
+package apiclient
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestClient(t *testing.T, h http.HandlerFunc) *Client {
+	t.Helper()
+	srv := httptest.NewServer(h)
+	t.Cleanup(srv.Close)
+
+	c := New(srv.URL, "test-key")
+	c.RetryDelay = time.Millisecond
+	return c
+}
+
+func TestClient_GeneratedOperation(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/api/v1/user/search", r.URL.Path)
+		assert.Equal(t, "forest", r.URL.Query().Get("location"))
+		assert.Equal(t, "test-key", r.Header.Get(HeaderAPIKey))
+
+		var req SearchRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, "discord", req.Platform)
+		assert.Equal(t, "123", req.PlatformID)
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"message":"You found a stick"}`))
+	})
+
+	resp, err := c.PostUserSearch(context.Background(),
+		PostUserSearchParams{Location: "forest"},
+		&SearchRequest{Platform: "discord", PlatformID: "123", Username: "tester"})
+
+	require.NoError(t, err)
+	assert.Equal(t, "You found a stick", resp.Message)
+}
+
+func TestClient_PathParams(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodDelete, r.Method)
+		assert.Equal(t, "/api/v1/user/reminders/42", r.URL.Path)
+		_, _ = w.Write([]byte(`{"message":"Reminder cancelled"}`))
+	})
+
+	resp, err := c.DeleteUserRemindersByID(context.Background(), 42, DeleteUserRemindersByIDParams{Platform: "discord", PlatformID: "123"})
+
+	require.NoError(t, err)
+	assert.Equal(t, "Reminder cancelled", resp.Message)
+}
+
+func TestClient_ErrorResponse(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"code":"USER_NOT_FOUND","message":"User not found","error":"User not found"}`))
+	})
+
+	_, err := c.GetUserCooldowns(context.Background(), GetUserCooldownsParams{Platform: "discord", PlatformID: "123"})
+
+	var apiErr *Error
+	require.ErrorAs(t, err, &apiErr)
+	assert.Equal(t, http.StatusNotFound, apiErr.StatusCode)
+	assert.Equal(t, ErrCodeUserNotFound, apiErr.Code)
+	assert.Equal(t, "User not found", apiErr.Message)
+}
+
+func TestClient_RetriesServerErrors(t *testing.T) {
+	var calls atomic.Int32
+	c := newTestClient(t, func(w http.ResponseWriter, _ *http.Request) {
+		if calls.Add(1) < 3 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		_, _ = w.Write([]byte(`{"message":"ok"}`))
+	})
+
+	var out SuccessResponse
+	err := c.Do(context.Background(), http.MethodGet, "/anything", nil, &out)
+
+	require.NoError(t, err)
+	assert.Equal(t, "ok", out.Message)
+	assert.Equal(t, int32(3), calls.Load())
+}
+
+func TestClient_GivesUpAfterMaxRetries(t *testing.T) {
+	var calls atomic.Int32
+	c := newTestClient(t, func(w http.ResponseWriter, _ *http.Request) {
+		calls.Add(1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+
+	err := c.Do(context.Background(), http.MethodGet, "/anything", nil, nil)
+
+	var apiErr *Error
+	require.ErrorAs(t, err, &apiErr)
+	assert.Equal(t, http.StatusServiceUnavailable, apiErr.StatusCode)
+	assert.Equal(t, int32(DefaultMaxRetries+1), calls.Load())
+}
+
+func TestClient_DoesNotRetryClientErrors(t *testing.T) {
+	var calls atomic.Int32
+	c := newTestClient(t, func(w http.ResponseWriter, _ *http.Request) {
+		calls.Add(1)
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"error":"Invalid request body","code":"BAD_REQUEST"}`))
+	})
+
+	err := c.Do(context.Background(), http.MethodPost, "/anything", map[string]string{"a": "b"}, nil)
+
+	require.Error(t, err)
+	assert.Equal(t, int32(1), calls.Load())
+}
@@ -0,0 +1,73 @@
+package apiclient
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// CooldownInfo describes when a cooldown-blocked action can be retried
+type CooldownInfo struct {
+	Action            string    `json:"action"`
+	RetryAfterSeconds int       `json:"retry_after_seconds"`
+	NextAvailableAt   time.Time `json:"next_available_at"`
+}
+
+// Error is an error response returned by the Core API
+type Error struct {
+	StatusCode int
+	Message    string
+	Code       ErrorCode         // Generated from the API's handler.ErrorCode values
+	RequestID  string            // Quote this when reporting a problem
+	Details    json.RawMessage   // Structured context, shape depends on Code
+	Fields     map[string]string // Set for validation errors
+	Cooldown   *CooldownInfo     // Set when the action is on cooldown
+}
+
+func (e *Error) Error() string {
+	if e.Message == "" {
+		return fmt.Sprintf("API returned status: %d", e.StatusCode)
+	}
+	return "API error: " + e.Message
+}
+
+// DecodeError reads an error response body into an *Error. Bodies without an
+// error message give an *Error with only the status code set.
+func DecodeError(resp *http.Response) error {
+	var errResp struct {
+		Code      ErrorCode         `json:"code"`
+		Message   string            `json:"message"`
+		Error     string            `json:"error"` // Older servers only send error
+		Details   json.RawMessage   `json:"details"`
+		RequestID string            `json:"request_id"`
+		Fields    map[string]string `json:"fields"`
+		CooldownInfo
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&errResp); err != nil {
+		return &Error{StatusCode: resp.StatusCode}
+	}
+	if errResp.Message == "" {
+		errResp.Message = errResp.Error
+	}
+	if errResp.Message == "" {
+		return &Error{StatusCode: resp.StatusCode, RequestID: errResp.RequestID}
+	}
+
+	apiErr := &Error{
+		StatusCode: resp.StatusCode,
+		Message:    errResp.Message,
+		Code:       errResp.Code,
+		RequestID:  errResp.RequestID,
+		Details:    errResp.Details,
+		Fields:     errResp.Fields,
+	}
+	if errResp.Code == ErrCodeOnCooldown && errResp.RetryAfterSeconds > 0 {
+		info := errResp.CooldownInfo
+		if info.NextAvailableAt.IsZero() {
+			info.NextAvailableAt = time.Now().Add(time.Duration(info.RetryAfterSeconds) * time.Second)
+		}
+		apiErr.Cooldown = &info
+	}
+	return apiErr
+}
@@ -0,0 +1,83 @@
+package apiclient
+
+import (
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func errorResponse(status int, body string) *http.Response {
+	return &http.Response{StatusCode: status, Body: io.NopCloser(strings.NewReader(body))}
+}
+
+func TestDecodeError(t *testing.T) {
+	t.Run("cooldown response carries retry info", func(t *testing.T) {
+		err := DecodeError(errorResponse(http.StatusTooManyRequests,
+			`{"error":"You can search again in 1m 30s","code":"ON_COOLDOWN","action":"search","retry_after_seconds":90,"next_available_at":"2026-03-01T12:01:30Z"}`))
+
+		var apiErr *Error
+		require.ErrorAs(t, err, &apiErr)
+		assert.Equal(t, "API error: You can search again in 1m 30s", err.Error())
+		assert.Equal(t, ErrCodeOnCooldown, apiErr.Code)
+		require.NotNil(t, apiErr.Cooldown)
+		assert.Equal(t, "search", apiErr.Cooldown.Action)
+		assert.Equal(t, 90, apiErr.Cooldown.RetryAfterSeconds)
+		assert.Equal(t, time.Date(2026, 3, 1, 12, 1, 30, 0, time.UTC), apiErr.Cooldown.NextAvailableAt.UTC())
+	})
+
+	t.Run("missing next_available_at is derived from retry_after_seconds", func(t *testing.T) {
+		err := DecodeError(errorResponse(http.StatusTooManyRequests,
+			`{"error":"on cooldown","code":"ON_COOLDOWN","retry_after_seconds":60}`))
+
+		var apiErr *Error
+		require.ErrorAs(t, err, &apiErr)
+		require.NotNil(t, apiErr.Cooldown)
+		assert.WithinDuration(t, time.Now().Add(time.Minute), apiErr.Cooldown.NextAvailableAt, 5*time.Second)
+	})
+
+	t.Run("plain error has no cooldown", func(t *testing.T) {
+		err := DecodeError(errorResponse(http.StatusBadRequest, `{"error":"insufficient funds","code":"BAD_REQUEST"}`))
+
+		var apiErr *Error
+		require.ErrorAs(t, err, &apiErr)
+		assert.Nil(t, apiErr.Cooldown)
+		assert.Equal(t, ErrCodeBadRequest, apiErr.Code)
+		assert.Equal(t, "API error: insufficient funds", err.Error())
+	})
+
+	t.Run("validation error keeps field messages", func(t *testing.T) {
+		err := DecodeError(errorResponse(http.StatusBadRequest,
+			`{"error":"Invalid request","code":"VALIDATION_FAILED","fields":{"quantity":"must be at least 1"}}`))
+
+		var apiErr *Error
+		require.ErrorAs(t, err, &apiErr)
+		assert.Equal(t, ErrCodeValidation, apiErr.Code)
+		assert.Equal(t, map[string]string{"quantity": "must be at least 1"}, apiErr.Fields)
+	})
+
+	t.Run("envelope with code, details and request id", func(t *testing.T) {
+		err := DecodeError(errorResponse(http.StatusForbidden,
+			`{"code":"FEATURE_LOCKED","message":"Requires: Crafting","details":{"feature":"feature_upgrade"},"request_id":"req-1","error":"Requires: Crafting"}`))
+
+		var apiErr *Error
+		require.ErrorAs(t, err, &apiErr)
+		assert.Equal(t, ErrCodeFeatureLocked, apiErr.Code)
+		assert.Equal(t, "Requires: Crafting", apiErr.Message)
+		assert.Equal(t, "req-1", apiErr.RequestID)
+		assert.JSONEq(t, `{"feature":"feature_upgrade"}`, string(apiErr.Details))
+	})
+
+	t.Run("body without an error message", func(t *testing.T) {
+		err := DecodeError(errorResponse(http.StatusBadGateway, `not json`))
+
+		var apiErr *Error
+		require.ErrorAs(t, err, &apiErr)
+		assert.Equal(t, http.StatusBadGateway, apiErr.StatusCode)
+		assert.EqualError(t, err, "API returned status: 502")
+	})
+}